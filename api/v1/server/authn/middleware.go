@@ -13,6 +13,7 @@ import (
 	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/redirect"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 )
 
 type AuthN struct {
@@ -184,7 +185,7 @@ func (a *AuthN) handleBearerAuth(c echo.Context) error {
 	}
 
 	// Validate the token.
-	tenantId, _, err := a.config.Auth.JWTManager.ValidateTenantToken(c.Request().Context(), token)
+	tenantId, tokenId, scope, err := a.config.Auth.JWTManager.ValidateScopedTenantToken(c.Request().Context(), token)
 
 	if err != nil {
 		a.l.Debug().Err(err).Msg("error validating tenant token")
@@ -200,6 +201,22 @@ func (a *AuthN) handleBearerAuth(c echo.Context) error {
 		return forbidden
 	}
 
+	// worker tokens can only call the engine, and read-only tokens can only call the read endpoints
+	switch scope {
+	case dbsqlc.APITokenScopeWORKER:
+		a.l.Debug().Msgf("worker token cannot call the REST API")
+
+		return forbidden
+	case dbsqlc.APITokenScopeREADONLY:
+		if method := c.Request().Method; method != http.MethodGet && method != http.MethodHead {
+			a.l.Debug().Msgf("read-only token cannot call %s endpoints", method)
+
+			return forbidden
+		}
+	}
+
+	c.Set("api-token-id", tokenId)
+
 	return nil
 }
 
@@ -0,0 +1,115 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/auth/token"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// scopedJWTManager accepts the token "valid" as a token of the tenant with the scope
+type scopedJWTManager struct {
+	token.JWTManager
+
+	tenantId string
+	scope    dbsqlc.APITokenScope
+}
+
+func (m *scopedJWTManager) ValidateScopedTenantToken(ctx context.Context, t string) (string, string, dbsqlc.APITokenScope, error) {
+	if t != "valid" {
+		return "", "", "", errors.New("invalid token")
+	}
+
+	return m.tenantId, uuid.New().String(), m.scope, nil
+}
+
+func TestBearerAuthScopes(t *testing.T) {
+	tenantId := uuid.New().String()
+
+	tests := []struct {
+		name     string
+		scope    dbsqlc.APITokenScope
+		method   string
+		token    string
+		tenantId string
+		allowed  bool
+	}{
+		{name: "admin token can read", scope: dbsqlc.APITokenScopeADMIN, method: http.MethodGet, allowed: true},
+		{name: "admin token can write", scope: dbsqlc.APITokenScopeADMIN, method: http.MethodPost, allowed: true},
+		{name: "worker token can't read", scope: dbsqlc.APITokenScopeWORKER, method: http.MethodGet},
+		{name: "worker token can't write", scope: dbsqlc.APITokenScopeWORKER, method: http.MethodPost},
+		{name: "read-only token can get", scope: dbsqlc.APITokenScopeREADONLY, method: http.MethodGet, allowed: true},
+		{name: "read-only token can head", scope: dbsqlc.APITokenScopeREADONLY, method: http.MethodHead, allowed: true},
+		{name: "read-only token can't post", scope: dbsqlc.APITokenScopeREADONLY, method: http.MethodPost},
+		{name: "read-only token can't patch", scope: dbsqlc.APITokenScopeREADONLY, method: http.MethodPatch},
+		{name: "read-only token can't delete", scope: dbsqlc.APITokenScopeREADONLY, method: http.MethodDelete},
+		{name: "invalid token", scope: dbsqlc.APITokenScopeADMIN, method: http.MethodGet, token: "invalid"},
+		{name: "token of another tenant", scope: dbsqlc.APITokenScopeADMIN, method: http.MethodGet, tenantId: uuid.New().String()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokenTenantId := tenantId
+
+			if tt.tenantId != "" {
+				tokenTenantId = tt.tenantId
+			}
+
+			bearer := "valid"
+
+			if tt.token != "" {
+				bearer = tt.token
+			}
+
+			l := zerolog.Nop()
+
+			a := NewAuthN(&server.ServerConfig{
+				Auth: server.AuthConfig{
+					JWTManager: &scopedJWTManager{
+						tenantId: tokenTenantId,
+						scope:    tt.scope,
+					},
+				},
+				Logger: &l,
+			})
+
+			req := httptest.NewRequest(tt.method, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+bearer)
+
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+			c.Set("tenant", &db.TenantModel{
+				InnerTenant: db.InnerTenant{
+					ID: tenantId,
+				},
+			})
+
+			err := a.handleBearerAuth(c)
+
+			if tt.allowed {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, c.Get("api-token-id"))
+
+				return
+			}
+
+			var httpErr *echo.HTTPError
+
+			if assert.ErrorAs(t, err, &httpErr) {
+				assert.Equal(t, http.StatusForbidden, httpErr.Code)
+			}
+
+			assert.Nil(t, c.Get("api-token-id"))
+		})
+	}
+}
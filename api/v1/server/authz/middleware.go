@@ -152,6 +152,8 @@ var adminAndOwnerOnly = []string{
 	"ApiTokenList",
 	"ApiTokenCreate",
 	"ApiTokenUpdateRevoke",
+	// the audit log includes the calls of every member of the tenant
+	"AuditLogList",
 }
 
 func (a *AuthZ) authorizeTenantOperations(tenant *db.TenantModel, tenantMember *db.TenantMemberModel, r *middleware.RouteInfo) error {
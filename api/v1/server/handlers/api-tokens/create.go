@@ -1,6 +1,7 @@
 package apitokens
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -8,6 +9,7 @@ import (
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 )
 
 func (a *APITokenService) ApiTokenCreate(ctx echo.Context, request gen.ApiTokenCreateRequestObject) (gen.ApiTokenCreateResponseObject, error) {
@@ -34,7 +36,20 @@ func (a *APITokenService) ApiTokenCreate(ctx echo.Context, request gen.ApiTokenC
 		expiresAt = &e
 	}
 
-	token, err := a.config.Auth.JWTManager.GenerateTenantToken(ctx.Request().Context(), tenant.ID, request.Body.Name, false, expiresAt)
+	scope := dbsqlc.APITokenScopeADMIN
+
+	if request.Body.Scope != nil {
+		switch s := dbsqlc.APITokenScope(*request.Body.Scope); s {
+		case dbsqlc.APITokenScopeADMIN, dbsqlc.APITokenScopeWORKER, dbsqlc.APITokenScopeREADONLY:
+			scope = s
+		default:
+			return gen.ApiTokenCreate400JSONResponse(
+				apierrors.NewAPIErrors(fmt.Sprintf("unknown API token scope %s", s), "scope"),
+			), nil
+		}
+	}
+
+	token, err := a.config.Auth.JWTManager.GenerateScopedTenantToken(ctx.Request().Context(), tenant.ID, request.Body.Name, scope, expiresAt)
 
 	if err != nil {
 		return nil, err
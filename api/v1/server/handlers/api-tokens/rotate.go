@@ -0,0 +1,83 @@
+package apitokens
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+// the time which a rotated token stays valid for when the request doesn't set a grace period
+const defaultRotationGracePeriod = 24 * time.Hour
+
+func (a *APITokenService) ApiTokenUpdateRotate(ctx echo.Context, request gen.ApiTokenUpdateRotateRequestObject) (gen.ApiTokenUpdateRotateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	apiToken := ctx.Get("api-token").(*db.APITokenModel)
+
+	if apiToken.Internal {
+		return gen.ApiTokenUpdateRotate403JSONResponse(
+			apierrors.NewAPIErrors("Cannot rotate internal API tokens"),
+		), nil
+	}
+
+	// validate the request
+	if apiErrors, err := a.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.ApiTokenUpdateRotate400JSONResponse(*apiErrors), nil
+	}
+
+	gracePeriod := defaultRotationGracePeriod
+
+	if request.Body.GracePeriod != nil {
+		var err error
+
+		gracePeriod, err = time.ParseDuration(*request.Body.GracePeriod)
+
+		if err != nil || gracePeriod < 0 {
+			return gen.ApiTokenUpdateRotate400JSONResponse(apierrors.NewAPIErrors("invalid grace period duration")), nil
+		}
+	}
+
+	var expiresAt *time.Time
+
+	if request.Body.ExpiresIn != nil {
+		expiresIn, err := time.ParseDuration(*request.Body.ExpiresIn)
+
+		if err != nil || expiresIn <= 0 {
+			return gen.ApiTokenUpdateRotate400JSONResponse(apierrors.NewAPIErrors("invalid expiration duration")), nil
+		}
+
+		e := time.Now().UTC().Add(expiresIn)
+
+		expiresAt = &e
+	}
+
+	token, err := a.config.Auth.JWTManager.RotateTenantToken(
+		ctx.Request().Context(),
+		tenant.ID,
+		apiToken.ID,
+		time.Now().UTC().Add(gracePeriod),
+		expiresAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return gen.ApiTokenUpdateRotate400JSONResponse(
+				apierrors.NewAPIErrors("API token has already been rotated or revoked"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	// This is the only time the token is sent over the API
+	return gen.ApiTokenUpdateRotate200JSONResponse{
+		Token: token.Token,
+	}, nil
+}
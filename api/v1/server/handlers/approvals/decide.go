@@ -0,0 +1,125 @@
+package approvals
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *ApprovalService) ApprovalUpdateDecide(ctx echo.Context, request gen.ApprovalUpdateDecideRequestObject) (gen.ApprovalUpdateDecideResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	approval := ctx.Get("approval").(*dbsqlc.StepRunApproval)
+
+	decided, err := t.decide(ctx.Request().Context(), tenant.ID, approval, request.Body)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrApprovalNotPending) {
+			return gen.ApprovalUpdateDecide400JSONResponse(
+				apierrors.NewAPIErrors("approval is not pending"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	return gen.ApprovalUpdateDecide200JSONResponse(
+		*transformers.ToStepRunApprovalFromSQLC(decided),
+	), nil
+}
+
+func (t *ApprovalService) ApprovalUpdateDecideWithToken(ctx echo.Context, request gen.ApprovalUpdateDecideWithTokenRequestObject) (gen.ApprovalUpdateDecideWithTokenResponseObject, error) {
+	approval, err := t.config.EngineRepository.Approval().GetApprovalByToken(ctx.Request().Context(), request.ApprovalToken)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return gen.ApprovalUpdateDecideWithToken404JSONResponse(
+				apierrors.NewAPIErrors("approval not found"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	_, err = t.decide(ctx.Request().Context(), sqlchelpers.UUIDToStr(approval.TenantId), approval, request.Body)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrApprovalNotPending) {
+			return gen.ApprovalUpdateDecideWithToken400JSONResponse(
+				apierrors.NewAPIErrors("approval is not pending"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	return gen.ApprovalUpdateDecideWithToken200Response{}, nil
+}
+
+// decide approves or rejects a pending approval and resumes its step run. Approved step runs succeed with the
+// payload of the approver as their output, while rejected step runs fail.
+func (t *ApprovalService) decide(ctx context.Context, tenantId string, approval *dbsqlc.StepRunApproval, body *gen.DecideStepRunApprovalRequest) (*dbsqlc.StepRunApproval, error) {
+	opts := &repository.DecideApprovalOpts{
+		Approved: body.Approved,
+	}
+
+	if body.Payload != nil {
+		payload, err := json.Marshal(body.Payload)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal approval payload: %w", err)
+		}
+
+		opts.Payload = payload
+	}
+
+	engineStepRun, err := t.config.EngineRepository.StepRun().GetStepRunForEngine(ctx, tenantId, sqlchelpers.UUIDToStr(approval.StepRunId))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get step run for engine: %w", err)
+	}
+
+	decided, err := t.config.EngineRepository.Approval().DecideApproval(ctx, tenantId, sqlchelpers.UUIDToStr(approval.ID), opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	var task *msgqueue.Message
+
+	if body.Approved {
+		output := opts.Payload
+
+		if output == nil {
+			output = []byte("{}")
+		}
+
+		task = tasktypes.StepRunFinishedToTask(engineStepRun, output, &now)
+	} else {
+		task = tasktypes.StepRunFailedToTask(engineStepRun, "Step run was rejected", &now)
+	}
+
+	err = t.config.MessageQueue.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, task)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not add approval decision task to task queue: %w", err)
+	}
+
+	return decided, nil
+}
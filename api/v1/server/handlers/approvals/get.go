@@ -0,0 +1,17 @@
+package approvals
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func (t *ApprovalService) ApprovalGet(ctx echo.Context, request gen.ApprovalGetRequestObject) (gen.ApprovalGetResponseObject, error) {
+	approval := ctx.Get("approval").(*dbsqlc.StepRunApproval)
+
+	return gen.ApprovalGet200JSONResponse(
+		*transformers.ToStepRunApprovalFromSQLC(approval),
+	), nil
+}
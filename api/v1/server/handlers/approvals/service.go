@@ -0,0 +1,15 @@
+package approvals
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type ApprovalService struct {
+	config *server.ServerConfig
+}
+
+func NewApprovalService(config *server.ServerConfig) *ApprovalService {
+	return &ApprovalService{
+		config: config,
+	}
+}
@@ -0,0 +1,76 @@
+package auditlogs
+
+import (
+	"math"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (a *AuditLogService) AuditLogList(ctx echo.Context, request gen.AuditLogListRequestObject) (gen.AuditLogListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	limit := 50
+	offset := 0
+
+	listOpts := &repository.ListAuditLogsOpts{
+		Limit:        &limit,
+		Offset:       &offset,
+		Operation:    request.Params.Operation,
+		ResourceType: request.Params.ResourceType,
+		ResourceId:   request.Params.ResourceId,
+		Since:        request.Params.Since,
+		Until:        request.Params.Until,
+	}
+
+	if request.Params.Limit != nil {
+		limit = int(*request.Params.Limit)
+		listOpts.Limit = &limit
+	}
+
+	if request.Params.Offset != nil {
+		offset = int(*request.Params.Offset)
+		listOpts.Offset = &offset
+	}
+
+	if request.Params.ActorId != nil {
+		actorId := request.Params.ActorId.String()
+		listOpts.ActorId = &actorId
+	}
+
+	listRes, err := a.config.EngineRepository.AuditLog().ListAuditLogs(ctx.Request().Context(), tenant.ID, listOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.AuditLog, len(listRes.Rows))
+
+	for i, entry := range listRes.Rows {
+		rows[i] = *transformers.ToAuditLogFromSQLC(entry)
+	}
+
+	// use the total rows and limit to calculate the total pages
+	totalPages := int64(math.Ceil(float64(listRes.Count) / float64(limit)))
+	currPage := 1 + int64(math.Ceil(float64(offset)/float64(limit)))
+	nextPage := currPage + 1
+
+	if currPage == totalPages {
+		nextPage = currPage
+	}
+
+	return gen.AuditLogList200JSONResponse(
+		gen.AuditLogList{
+			Rows: &rows,
+			Pagination: &gen.PaginationResponse{
+				NumPages:    &totalPages,
+				NextPage:    &nextPage,
+				CurrentPage: &currPage,
+			},
+		},
+	), nil
+}
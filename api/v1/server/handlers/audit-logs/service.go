@@ -0,0 +1,15 @@
+package auditlogs
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type AuditLogService struct {
+	config *server.ServerConfig
+}
+
+func NewAuditLogService(config *server.ServerConfig) *AuditLogService {
+	return &AuditLogService{
+		config: config,
+	}
+}
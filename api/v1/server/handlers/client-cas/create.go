@@ -0,0 +1,73 @@
+package clientcas
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/auth/clientcert"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (c *ClientCAService) ClientCaCreate(ctx echo.Context, request gen.ClientCaCreateRequestObject) (gen.ClientCaCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	if apiErrors, err := c.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.ClientCaCreate400JSONResponse(*apiErrors), nil
+	}
+
+	if err := clientcert.ValidateSpiffeIdPrefix(request.Body.SpiffeIdPrefix); err != nil {
+		return gen.ClientCaCreate400JSONResponse(
+			apierrors.NewAPIErrors(err.Error(), "spiffeIdPrefix"),
+		), nil
+	}
+
+	cert, err := clientcert.ParseCA(request.Body.Certificate)
+
+	if err != nil {
+		return gen.ClientCaCreate400JSONResponse(
+			apierrors.NewAPIErrors(fmt.Sprintf("invalid certificate: %s", err.Error()), "certificate"),
+		), nil
+	}
+
+	if cert.NotAfter.Before(time.Now()) {
+		return gen.ClientCaCreate400JSONResponse(
+			apierrors.NewAPIErrors("the certificate has expired", "certificate"),
+		), nil
+	}
+
+	ca, err := c.config.EngineRepository.ClientCA().CreateClientCA(ctx.Request().Context(), tenant.ID, &repository.CreateClientCAOpts{
+		Name:           request.Body.Name,
+		Certificate:    request.Body.Certificate,
+		SpiffeIdPrefix: request.Body.SpiffeIdPrefix,
+		ExpiresAt:      cert.NotAfter,
+	})
+
+	if errors.Is(err, repository.ErrSpiffeIdPrefixTaken) {
+		return gen.ClientCaCreate400JSONResponse(
+			apierrors.NewAPIErrors("The SPIFFE ID prefix overlaps with the prefix of a client CA of another tenant.", "spiffeIdPrefix"),
+		), nil
+	}
+
+	if errors.Is(err, repository.ErrDuplicateKey) {
+		return gen.ClientCaCreate400JSONResponse(
+			apierrors.NewAPIErrors("A client CA with the same name already exists.", "name"),
+		), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ClientCaCreate200JSONResponse(
+		*transformers.ToClientCAFromSQLC(ca),
+	), nil
+}
@@ -0,0 +1,21 @@
+package clientcas
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (c *ClientCAService) ClientCaDelete(ctx echo.Context, request gen.ClientCaDeleteRequestObject) (gen.ClientCaDeleteResponseObject, error) {
+	ca := ctx.Get("client-ca").(*dbsqlc.TenantClientCA)
+
+	err := c.config.EngineRepository.ClientCA().DeleteClientCA(ctx.Request().Context(), sqlchelpers.UUIDToStr(ca.ID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.ClientCaDelete204Response{}, nil
+}
@@ -0,0 +1,31 @@
+package clientcas
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (c *ClientCAService) ClientCaList(ctx echo.Context, request gen.ClientCaListRequestObject) (gen.ClientCaListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	cas, err := c.config.EngineRepository.ClientCA().ListClientCAs(ctx.Request().Context(), tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.ClientCA, len(cas))
+
+	for i, ca := range cas {
+		rows[i] = *transformers.ToClientCAFromSQLC(ca)
+	}
+
+	return gen.ClientCaList200JSONResponse(
+		gen.ClientCAList{
+			Rows: &rows,
+		},
+	), nil
+}
@@ -0,0 +1,15 @@
+package clientcas
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type ClientCAService struct {
+	config *server.ServerConfig
+}
+
+func NewClientCAService(config *server.ServerConfig) *ClientCAService {
+	return &ClientCAService{
+		config: config,
+	}
+}
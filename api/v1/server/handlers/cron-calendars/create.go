@@ -0,0 +1,56 @@
+package croncalendars
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (t *CronCalendarService) CronCalendarCreate(ctx echo.Context, request gen.CronCalendarCreateRequestObject) (gen.CronCalendarCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.CronCalendarCreate400JSONResponse(*apiErrors), nil
+	}
+
+	opts := &repository.CreateCronExclusionCalendarOpts{
+		Name:    request.Body.Name,
+		ICalURL: request.Body.IcalUrl,
+	}
+
+	if request.Body.Dates != nil {
+		for _, date := range *request.Body.Dates {
+			opts.Dates = append(opts.Dates, date.Time)
+		}
+	}
+
+	if len(opts.Dates) == 0 && opts.ICalURL == nil {
+		return gen.CronCalendarCreate400JSONResponse(
+			apierrors.NewAPIErrors("either dates or an ical url is required"),
+		), nil
+	}
+
+	calendar, err := t.config.EngineRepository.CronCalendar().CreateCalendar(ctx.Request().Context(), tenant.ID, opts)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "unique constraint") {
+			return gen.CronCalendarCreate400JSONResponse(
+				apierrors.NewAPIErrors("an exclusion calendar with that name already exists"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	return gen.CronCalendarCreate200JSONResponse(
+		*transformers.ToCronExclusionCalendarFromSQLC(calendar),
+	), nil
+}
@@ -0,0 +1,21 @@
+package croncalendars
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *CronCalendarService) CronCalendarDelete(ctx echo.Context, request gen.CronCalendarDeleteRequestObject) (gen.CronCalendarDeleteResponseObject, error) {
+	calendar := ctx.Get("cron-calendar").(*dbsqlc.CronExclusionCalendar)
+
+	err := t.config.EngineRepository.CronCalendar().DeleteCalendar(ctx.Request().Context(), sqlchelpers.UUIDToStr(calendar.ID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.CronCalendarDelete204Response{}, nil
+}
@@ -0,0 +1,31 @@
+package croncalendars
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (t *CronCalendarService) CronCalendarList(ctx echo.Context, request gen.CronCalendarListRequestObject) (gen.CronCalendarListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	calendars, err := t.config.EngineRepository.CronCalendar().ListCalendars(ctx.Request().Context(), tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.CronExclusionCalendar, len(calendars))
+
+	for i, calendar := range calendars {
+		rows[i] = *transformers.ToCronExclusionCalendarFromSQLC(calendar)
+	}
+
+	return gen.CronCalendarList200JSONResponse(
+		gen.CronExclusionCalendarList{
+			Rows: &rows,
+		},
+	), nil
+}
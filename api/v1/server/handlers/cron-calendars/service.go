@@ -0,0 +1,15 @@
+package croncalendars
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type CronCalendarService struct {
+	config *server.ServerConfig
+}
+
+func NewCronCalendarService(config *server.ServerConfig) *CronCalendarService {
+	return &CronCalendarService{
+		config: config,
+	}
+}
@@ -0,0 +1,71 @@
+package deadletterqueue
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (t *DeadLetterQueueService) DeadLetterQueueList(ctx echo.Context, request gen.DeadLetterQueueListRequestObject) (gen.DeadLetterQueueListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	limit := 50
+	offset := 0
+
+	listOpts := &repository.ListDeadLetterQueueItemsOpts{
+		Limit:  &limit,
+		Offset: &offset,
+	}
+
+	if request.Params.Limit != nil {
+		limit = int(*request.Params.Limit)
+		listOpts.Limit = &limit
+	}
+
+	if request.Params.Offset != nil {
+		offset = int(*request.Params.Offset)
+		listOpts.Offset = &offset
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx.Request().Context(), 30*time.Second)
+	defer cancel()
+
+	listRes, err := t.config.EngineRepository.DeadLetterQueue().ListDeadLetterQueueItems(dbCtx, tenant.ID, listOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.DeadLetterQueueItem, len(listRes.Rows))
+
+	for i, item := range listRes.Rows {
+		rows[i] = *transformers.ToDeadLetterQueueItemFromSQLC(item)
+	}
+
+	// use the total rows and limit to calculate the total pages
+	totalPages := int64(math.Ceil(float64(listRes.Count) / float64(limit)))
+	currPage := 1 + int64(math.Ceil(float64(offset)/float64(limit)))
+	nextPage := currPage + 1
+
+	if currPage == totalPages {
+		nextPage = currPage
+	}
+
+	return gen.DeadLetterQueueList200JSONResponse(
+		gen.DeadLetterQueueItemList{
+			Rows: &rows,
+			Pagination: &gen.PaginationResponse{
+				NumPages:    &totalPages,
+				NextPage:    &nextPage,
+				CurrentPage: &currPage,
+			},
+		},
+	), nil
+}
@@ -0,0 +1,41 @@
+package deadletterqueue
+
+import (
+	"context"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (t *DeadLetterQueueService) DeadLetterQueueDelete(ctx echo.Context, request gen.DeadLetterQueueDeleteRequestObject) (gen.DeadLetterQueueDeleteResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	opts := &repository.DeleteDeadLetterQueueItemsOpts{}
+
+	if request.Body.Ids != nil {
+		opts.Ids = make([]string, len(*request.Body.Ids))
+
+		for i, id := range *request.Body.Ids {
+			opts.Ids[i] = id.String()
+		}
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx.Request().Context(), 30*time.Second)
+	defer cancel()
+
+	purged, err := t.config.EngineRepository.DeadLetterQueue().DeleteDeadLetterQueueItems(dbCtx, tenant.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.DeadLetterQueueDelete200JSONResponse(
+		gen.PurgeDeadLetterQueueItemsResponse{
+			Purged: purged,
+		},
+	), nil
+}
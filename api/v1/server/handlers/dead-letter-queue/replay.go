@@ -0,0 +1,131 @@
+package deadletterqueue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *DeadLetterQueueService) DeadLetterQueueUpdateReplay(ctx echo.Context, request gen.DeadLetterQueueUpdateReplayRequestObject) (gen.DeadLetterQueueUpdateReplayResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	ids := make([]string, len(request.Body.Ids))
+
+	for i := range request.Body.Ids {
+		ids[i] = request.Body.Ids[i].String()
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx.Request().Context(), 60*time.Second)
+	defer cancel()
+
+	// make sure all items belong to the tenant
+	items, err := t.config.EngineRepository.DeadLetterQueue().GetDeadLetterQueueItemsByIds(dbCtx, tenant.ID, ids)
+
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[string]bool, len(items))
+	replayed := make([]string, 0, len(items))
+	failed := make([]gen.DeadLetterQueueItemReplayFailure, 0)
+
+	for _, item := range items {
+		itemId := sqlchelpers.UUIDToStr(item.ID)
+		found[itemId] = true
+
+		if reason := t.replayItem(dbCtx, tenant.ID, item); reason != "" {
+			failed = append(failed, gen.DeadLetterQueueItemReplayFailure{
+				Id:     itemId,
+				Reason: reason,
+			})
+
+			continue
+		}
+
+		replayed = append(replayed, itemId)
+	}
+
+	for _, id := range ids {
+		if !found[id] {
+			failed = append(failed, gen.DeadLetterQueueItemReplayFailure{
+				Id:     id,
+				Reason: "Item not found.",
+			})
+		}
+	}
+
+	if len(replayed) > 0 {
+		_, err = t.config.EngineRepository.DeadLetterQueue().DeleteDeadLetterQueueItems(dbCtx, tenant.ID, &repository.DeleteDeadLetterQueueItemsOpts{
+			Ids: replayed,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not remove replayed items from dead-letter queue: %w", err)
+		}
+	}
+
+	return gen.DeadLetterQueueUpdateReplay200JSONResponse(
+		gen.ReplayDeadLetterQueueItemsResponse{
+			Replayed: replayed,
+			Failed:   failed,
+		},
+	), nil
+}
+
+// replayItem replays the step run of a dead-letter queue item with its original input. It returns the reason
+// the item could not be replayed, or an empty string if the replay was successful.
+func (t *DeadLetterQueueService) replayItem(ctx context.Context, tenantId string, item *dbsqlc.DeadLetterQueueItem) string {
+	stepRunId := sqlchelpers.UUIDToStr(item.StepRunId)
+
+	// preflight check to verify step run status and worker availability
+	err := t.config.EngineRepository.StepRun().PreflightCheckReplayStepRun(ctx, tenantId, stepRunId)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNoWorkerAvailable):
+			return "There are no workers available to execute this step run."
+		case errors.Is(err, repository.ErrPreflightReplayStepRunNotInFinalState):
+			return "Step run cannot be replayed because it is not finished running yet."
+		case errors.Is(err, repository.ErrPreflightReplayChildStepRunNotInFinalState):
+			return "Step run cannot be replayed because it has child step runs that are not finished running yet."
+		}
+
+		t.config.Logger.Err(err).Msgf("could not run preflight check for step run %s", stepRunId)
+
+		return "Could not replay step run."
+	}
+
+	engineStepRun, err := t.config.EngineRepository.StepRun().GetStepRunForEngine(ctx, tenantId, stepRunId)
+
+	if err != nil {
+		t.config.Logger.Err(err).Msgf("could not get step run %s for engine", stepRunId)
+
+		return "Could not replay step run."
+	}
+
+	// send a task to the taskqueue
+	err = t.config.MessageQueue.AddMessage(
+		ctx,
+		msgqueue.JOB_PROCESSING_QUEUE,
+		tasktypes.StepRunReplayToTask(engineStepRun, item.Input),
+	)
+
+	if err != nil {
+		t.config.Logger.Err(err).Msgf("could not add step run %s replay to task queue", stepRunId)
+
+		return "Could not replay step run."
+	}
+
+	return ""
+}
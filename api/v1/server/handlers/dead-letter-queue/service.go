@@ -0,0 +1,15 @@
+package deadletterqueue
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type DeadLetterQueueService struct {
+	config *server.ServerConfig
+}
+
+func NewDeadLetterQueueService(config *server.ServerConfig) *DeadLetterQueueService {
+	return &DeadLetterQueueService{
+		config: config,
+	}
+}
@@ -0,0 +1,21 @@
+package eventdeduprules
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (e *EventDedupRuleService) EventDedupRuleDelete(ctx echo.Context, request gen.EventDedupRuleDeleteRequestObject) (gen.EventDedupRuleDeleteResponseObject, error) {
+	rule := ctx.Get("event-dedup-rule").(*dbsqlc.EventDedupRule)
+
+	err := e.config.EngineRepository.EventDedup().DeleteEventDedupRule(ctx.Request().Context(), sqlchelpers.UUIDToStr(rule.ID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.EventDedupRuleDelete204Response{}, nil
+}
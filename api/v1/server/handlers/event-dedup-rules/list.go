@@ -0,0 +1,31 @@
+package eventdeduprules
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (e *EventDedupRuleService) EventDedupRuleList(ctx echo.Context, request gen.EventDedupRuleListRequestObject) (gen.EventDedupRuleListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	rules, err := e.config.EngineRepository.EventDedup().ListEventDedupRules(ctx.Request().Context(), tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.EventDedupRule, len(rules))
+
+	for i, rule := range rules {
+		rows[i] = *transformers.ToEventDedupRuleFromSQLC(rule)
+	}
+
+	return gen.EventDedupRuleList200JSONResponse(
+		gen.EventDedupRuleList{
+			Rows: &rows,
+		},
+	), nil
+}
@@ -0,0 +1,18 @@
+package eventdeduprules
+
+import (
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type EventDedupRuleService struct {
+	config    *server.ServerConfig
+	celParser *cel.CELParser
+}
+
+func NewEventDedupRuleService(config *server.ServerConfig) *EventDedupRuleService {
+	return &EventDedupRuleService{
+		config:    config,
+		celParser: cel.NewCELParser(),
+	}
+}
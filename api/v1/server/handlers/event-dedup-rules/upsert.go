@@ -0,0 +1,43 @@
+package eventdeduprules
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (e *EventDedupRuleService) EventDedupRuleUpsert(ctx echo.Context, request gen.EventDedupRuleUpsertRequestObject) (gen.EventDedupRuleUpsertResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	if apiErrors, err := e.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.EventDedupRuleUpsert400JSONResponse(*apiErrors), nil
+	}
+
+	if _, err := e.celParser.ParseWorkflowString(request.Body.Expression); err != nil {
+		return gen.EventDedupRuleUpsert400JSONResponse(
+			apierrors.NewAPIErrors(fmt.Sprintf("invalid expression: %s", err.Error()), "expression"),
+		), nil
+	}
+
+	rule, err := e.config.EngineRepository.EventDedup().UpsertEventDedupRule(ctx.Request().Context(), tenant.ID, &repository.UpsertEventDedupRuleOpts{
+		EventKey:      request.Body.EventKey,
+		Expression:    request.Body.Expression,
+		WindowSeconds: int32(request.Body.WindowSeconds), // nolint: gosec
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.EventDedupRuleUpsert200JSONResponse(
+		*transformers.ToEventDedupRuleFromSQLC(rule),
+	), nil
+}
@@ -0,0 +1,21 @@
+package eventroutingrules
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (e *EventRoutingRuleService) EventRoutingRuleDelete(ctx echo.Context, request gen.EventRoutingRuleDeleteRequestObject) (gen.EventRoutingRuleDeleteResponseObject, error) {
+	rule := ctx.Get("event-routing-rule").(*dbsqlc.EventRoutingRule)
+
+	err := e.config.EngineRepository.EventRouting().DeleteEventRoutingRule(ctx.Request().Context(), sqlchelpers.UUIDToStr(rule.ID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.EventRoutingRuleDelete204Response{}, nil
+}
@@ -0,0 +1,32 @@
+package eventroutingrules
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (e *EventRoutingRuleService) EventRoutingRuleList(ctx echo.Context, request gen.EventRoutingRuleListRequestObject) (gen.EventRoutingRuleListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	rules, err := e.config.EngineRepository.EventRouting().ListEventRoutingRules(ctx.Request().Context(), tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.EventRoutingRule, len(rules))
+
+	for i, rule := range rules {
+		workflowName := rule.WorkflowName
+		rows[i] = *transformers.ToEventRoutingRuleFromSQLC(&rule.EventRoutingRule, &workflowName)
+	}
+
+	return gen.EventRoutingRuleList200JSONResponse(
+		gen.EventRoutingRuleList{
+			Rows: &rows,
+		},
+	), nil
+}
@@ -0,0 +1,18 @@
+package eventroutingrules
+
+import (
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type EventRoutingRuleService struct {
+	config    *server.ServerConfig
+	celParser *cel.CELParser
+}
+
+func NewEventRoutingRuleService(config *server.ServerConfig) *EventRoutingRuleService {
+	return &EventRoutingRuleService{
+		config:    config,
+		celParser: cel.NewCELParser(),
+	}
+}
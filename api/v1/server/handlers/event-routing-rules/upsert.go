@@ -0,0 +1,62 @@
+package eventroutingrules
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (e *EventRoutingRuleService) EventRoutingRuleUpsert(ctx echo.Context, request gen.EventRoutingRuleUpsertRequestObject) (gen.EventRoutingRuleUpsertResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	if apiErrors, err := e.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.EventRoutingRuleUpsert400JSONResponse(*apiErrors), nil
+	}
+
+	if _, err := e.celParser.ParseEventRoutingExpression(request.Body.Expression); err != nil {
+		return gen.EventRoutingRuleUpsert400JSONResponse(
+			apierrors.NewAPIErrors(fmt.Sprintf("invalid expression: %s", err.Error()), "expression"),
+		), nil
+	}
+
+	workflowId := request.Body.WorkflowId.String()
+
+	// the workflow must belong to the tenant of the rule
+	workflow, err := e.config.APIRepository.Workflow().GetWorkflowById(ctx.Request().Context(), workflowId)
+
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	if workflow == nil || sqlchelpers.UUIDToStr(workflow.Workflow.TenantId) != tenant.ID {
+		return gen.EventRoutingRuleUpsert400JSONResponse(
+			apierrors.NewAPIErrors("workflow not found", "workflowId"),
+		), nil
+	}
+
+	rule, err := e.config.EngineRepository.EventRouting().UpsertEventRoutingRule(ctx.Request().Context(), tenant.ID, &repository.UpsertEventRoutingRuleOpts{
+		Name:       request.Body.Name,
+		EventKey:   request.Body.EventKey,
+		Expression: request.Body.Expression,
+		WorkflowId: workflowId,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.EventRoutingRuleUpsert200JSONResponse(
+		*transformers.ToEventRoutingRuleFromSQLC(rule, &workflow.Workflow.Name),
+	), nil
+}
@@ -0,0 +1,209 @@
+package eventsinks
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/integrations/secrets"
+	sinks "github.com/hatchet-dev/hatchet/internal/services/eventsinks"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+const defaultMaxAttempts = 10
+
+func (e *EventSinkService) EventSinkCreate(ctx echo.Context, request gen.EventSinkCreateRequestObject) (gen.EventSinkCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	if apiErrors, err := e.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.EventSinkCreate400JSONResponse(*apiErrors), nil
+	}
+
+	body := request.Body
+	kind := dbsqlc.EventSinkKind(body.Kind)
+
+	if apiErrors, ok := validateTarget(kind, body); !ok {
+		return gen.EventSinkCreate400JSONResponse(apiErrors), nil
+	}
+
+	opts := &repository.CreateEventSinkOpts{
+		Name:           body.Name,
+		Kind:           kind,
+		MaxAttempts:    defaultMaxAttempts,
+		Format:         dbsqlc.EventSinkFormatHATCHET,
+		UsernameSecret: body.UsernameSecret,
+		PasswordSecret: body.PasswordSecret,
+	}
+
+	if body.Format != nil {
+		switch format := dbsqlc.EventSinkFormat(*body.Format); format {
+		case dbsqlc.EventSinkFormatHATCHET, dbsqlc.EventSinkFormatCLOUDEVENTS:
+			opts.Format = format
+		default:
+			return gen.EventSinkCreate400JSONResponse(
+				apierrors.NewAPIErrors(fmt.Sprintf("unknown event sink format %s", format), "format"),
+			), nil
+		}
+	}
+
+	if body.MaxAttempts != nil {
+		opts.MaxAttempts = int32(*body.MaxAttempts) // nolint: gosec
+	}
+
+	if body.EventTypes != nil {
+		for _, eventType := range *body.EventTypes {
+			if !slices.Contains(sinks.RecordTypes, string(eventType)) {
+				return gen.EventSinkCreate400JSONResponse(
+					apierrors.NewAPIErrors(fmt.Sprintf("unknown event type %s", eventType), "eventTypes"),
+				), nil
+			}
+
+			if !slices.Contains(opts.EventTypes, string(eventType)) {
+				opts.EventTypes = append(opts.EventTypes, string(eventType))
+			}
+		}
+	}
+
+	if body.UsernameSecret != nil || body.PasswordSecret != nil {
+		// the credentials of the sink are resolved from the secrets of the tenant by the engine
+		if e.config.SecretResolver == nil {
+			return gen.EventSinkCreate400JSONResponse(
+				apierrors.NewAPIErrors("secrets are not enabled on this instance, so event sinks with credentials can't be created"),
+			), nil
+		}
+
+		for _, name := range []*string{body.UsernameSecret, body.PasswordSecret} {
+			if name == nil {
+				continue
+			}
+
+			if err := secrets.ValidateName(*name); err != nil {
+				return gen.EventSinkCreate400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+			}
+		}
+	}
+
+	var secret string
+
+	switch kind {
+	case dbsqlc.EventSinkKindWEBHOOK:
+		s, err := random.GenerateWebhookSecret()
+
+		if err != nil {
+			return nil, err
+		}
+
+		secret = s
+
+		encSecret, err := e.config.Encryption.EncryptString(secret, tenant.ID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		opts.URL = body.Url
+		opts.Secret = &encSecret
+	case dbsqlc.EventSinkKindKAFKA:
+		opts.Brokers = *body.Brokers
+		opts.Topic = body.Topic
+		opts.TLS = body.Tls != nil && *body.Tls
+	case dbsqlc.EventSinkKindNATS:
+		opts.URL = body.Url
+		opts.Topic = body.Topic
+	}
+
+	sink, err := e.config.EngineRepository.EventSink().CreateEventSink(ctx.Request().Context(), tenant.ID, opts)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "unique constraint") {
+			return gen.EventSinkCreate400JSONResponse(
+				apierrors.NewAPIErrors("an event sink with that name already exists", "name"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	resp := transformers.ToEventSinkFromSQLC(sink, nil)
+
+	if secret != "" {
+		// the secret is only returned once, so it can be configured in the receiver of the webhook
+		resp.Secret = &secret
+	}
+
+	return gen.EventSinkCreate200JSONResponse(*resp), nil
+}
+
+// validateTarget checks that the fields which are required by the kind of the sink are set.
+func validateTarget(kind dbsqlc.EventSinkKind, body *gen.CreateEventSinkRequest) (gen.APIErrors, bool) {
+	switch kind {
+	case dbsqlc.EventSinkKindWEBHOOK:
+		if body.Url == nil || !hasScheme(*body.Url, "http", "https") {
+			return apierrors.NewAPIErrors("an http or https url is required for WEBHOOK sinks", "url"), false
+		}
+
+		if body.UsernameSecret != nil || body.PasswordSecret != nil {
+			return apierrors.NewAPIErrors("credentials aren't supported for WEBHOOK sinks, requests are signed instead"), false
+		}
+	case dbsqlc.EventSinkKindKAFKA:
+		if body.Brokers == nil || len(*body.Brokers) == 0 {
+			return apierrors.NewAPIErrors("brokers are required for KAFKA sinks", "brokers"), false
+		}
+
+		if body.Topic == nil {
+			return apierrors.NewAPIErrors("a topic is required for KAFKA sinks", "topic"), false
+		}
+
+		if body.PasswordSecret != nil && body.UsernameSecret == nil {
+			return apierrors.NewAPIErrors("a username secret is required with a password secret for KAFKA sinks", "usernameSecret"), false
+		}
+	case dbsqlc.EventSinkKindNATS:
+		if body.Url == nil || !hasScheme(*body.Url, "nats", "tls") {
+			return apierrors.NewAPIErrors("a nats or tls url is required for NATS sinks", "url"), false
+		}
+
+		if body.Topic == nil || !isValidSubject(*body.Topic) {
+			return apierrors.NewAPIErrors("a subject prefix without spaces or wildcards is required for NATS sinks", "topic"), false
+		}
+	default:
+		return apierrors.NewAPIErrors(fmt.Sprintf("unknown event sink kind %s", kind), "kind"), false
+	}
+
+	return gen.APIErrors{}, true
+}
+
+func hasScheme(rawURL string, schemes ...string) bool {
+	u, err := url.Parse(rawURL)
+
+	if err != nil || u.Host == "" {
+		return false
+	}
+
+	return slices.Contains(schemes, u.Scheme)
+}
+
+// isValidSubject returns whether the prefix can be used in the subjects which records are published to.
+func isValidSubject(subject string) bool {
+	if strings.ContainsAny(subject, " \t\r\n*>") {
+		return false
+	}
+
+	for _, token := range strings.Split(subject, ".") {
+		if token == "" {
+			return false
+		}
+	}
+
+	return true
+}
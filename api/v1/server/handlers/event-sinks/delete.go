@@ -0,0 +1,21 @@
+package eventsinks
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (e *EventSinkService) EventSinkDelete(ctx echo.Context, request gen.EventSinkDeleteRequestObject) (gen.EventSinkDeleteResponseObject, error) {
+	sink := ctx.Get("event-sink").(*dbsqlc.EventSink)
+
+	err := e.config.EngineRepository.EventSink().DeleteEventSink(ctx.Request().Context(), sqlchelpers.UUIDToStr(sink.ID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.EventSinkDelete204Response{}, nil
+}
@@ -0,0 +1,37 @@
+package eventsinks
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (e *EventSinkService) EventSinkList(ctx echo.Context, request gen.EventSinkListRequestObject) (gen.EventSinkListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	sinks, err := e.config.EngineRepository.EventSink().ListEventSinks(ctx.Request().Context(), tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := e.config.EngineRepository.EventSink().CountEventSinkDeliveries(ctx.Request().Context(), tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.EventSink, len(sinks))
+
+	for i, sink := range sinks {
+		rows[i] = *transformers.ToEventSinkFromSQLC(sink, counts)
+	}
+
+	return gen.EventSinkList200JSONResponse(
+		gen.EventSinkList{
+			Rows: &rows,
+		},
+	), nil
+}
@@ -0,0 +1,32 @@
+package eventsinks
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (e *EventSinkService) EventSinkReplay(ctx echo.Context, request gen.EventSinkReplayRequestObject) (gen.EventSinkReplayResponseObject, error) {
+	sink := ctx.Get("event-sink").(*dbsqlc.EventSink)
+	tenantId := sqlchelpers.UUIDToStr(sink.TenantId)
+
+	// the dead-lettered deliveries are moved back to pending, and are delivered by the engine within a few seconds
+	_, err := e.config.EngineRepository.EventSink().ReplayEventSinkDeliveries(ctx.Request().Context(), sqlchelpers.UUIDToStr(sink.ID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := e.config.EngineRepository.EventSink().CountEventSinkDeliveries(ctx.Request().Context(), tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.EventSinkReplay200JSONResponse(
+		*transformers.ToEventSinkFromSQLC(sink, counts),
+	), nil
+}
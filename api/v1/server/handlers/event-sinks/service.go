@@ -0,0 +1,15 @@
+package eventsinks
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type EventSinkService struct {
+	config *server.ServerConfig
+}
+
+func NewEventSinkService(config *server.ServerConfig) *EventSinkService {
+	return &EventSinkService{
+		config: config,
+	}
+}
@@ -0,0 +1,92 @@
+package events
+
+import (
+	"encoding/json"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (t *EventService) EventCreateBatch(ctx echo.Context, request gen.EventCreateBatchRequestObject) (gen.EventCreateBatchResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.EventCreateBatch400JSONResponse(*apiErrors), nil
+	}
+
+	eventOpts := make([]*repository.CreateEventOpts, len(request.Body.Events))
+
+	for i, event := range request.Body.Events {
+		dataBytes, err := json.Marshal(event.Data)
+
+		if err != nil {
+			return nil, err
+		}
+
+		var additionalMetadata []byte
+
+		if event.AdditionalMetadata != nil {
+			additionalMetadata, err = json.Marshal(event.AdditionalMetadata)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		eventOpts[i] = &repository.CreateEventOpts{
+			TenantId:           tenant.ID,
+			Key:                event.Key,
+			Data:               dataBytes,
+			AdditionalMetadata: additionalMetadata,
+			DeliverAfter:       event.DeliverAfter,
+		}
+	}
+
+	results, err := t.config.Ingestor.IngestEvents(ctx.Request().Context(), tenant.ID, eventOpts)
+
+	if err != nil {
+		if err == metered.ErrResourceExhausted {
+			return gen.EventCreateBatch429JSONResponse(
+				apierrors.NewAPIErrors("Event limit exceeded"),
+			), nil
+		}
+
+		if apiErrors, ok := apierrors.NewQuotaExceededAPIErrors(err); ok {
+			return gen.EventCreateBatch429JSONResponse(apiErrors), nil
+		}
+
+		return nil, err
+	}
+
+	resp := gen.BatchCreateEventResponse{
+		Results: make([]gen.BatchCreateEventResult, len(results)),
+	}
+
+	for i, result := range results {
+		resp.Results[i] = gen.BatchCreateEventResult{
+			Index: i,
+		}
+
+		if result.Err != nil {
+			errMsg := result.Err.Error()
+			resp.Results[i].Error = &errMsg
+			resp.Failed++
+
+			continue
+		}
+
+		resp.Results[i].Event = transformers.ToEventFromSQLCEvent(result.Event)
+		resp.Succeeded++
+	}
+
+	return gen.EventCreateBatch200JSONResponse(resp), nil
+}
@@ -40,6 +40,7 @@ func (t *EventService) EventCreateBulk(ctx echo.Context, request gen.EventCreate
 			Key:                event.Key,
 			Data:               dataBytes,
 			AdditionalMetadata: additionalMetadata,
+			DeliverAfter:       event.DeliverAfter,
 		}
 	}
 	events, err := t.config.Ingestor.BulkIngestEvent(ctx.Request().Context(), tenant.ID, eventOpts)
@@ -52,6 +53,14 @@ func (t *EventService) EventCreateBulk(ctx echo.Context, request gen.EventCreate
 			), nil
 		}
 
+		if apiErrors, ok := apierrors.NewQuotaExceededAPIErrors(err); ok {
+			return gen.EventCreateBulk429JSONResponse(apiErrors), nil
+		}
+
+		if apiErrors, ok := apierrors.NewInputValidationAPIErrors(err); ok {
+			return gen.EventCreateBulk400JSONResponse(apiErrors), nil
+		}
+
 		return gen.EventCreateBulk400JSONResponse{}, err
 
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
@@ -33,7 +34,15 @@ func (t *EventService) EventCreate(ctx echo.Context, request gen.EventCreateRequ
 		}
 	}
 
-	newEvent, err := t.config.Ingestor.IngestEvent(ctx.Request().Context(), tenant.ID, request.Body.Key, dataBytes, additionalMetadata)
+	events, err := t.config.Ingestor.BulkIngestEvent(ctx.Request().Context(), tenant.ID, []*repository.CreateEventOpts{
+		{
+			TenantId:           tenant.ID,
+			Key:                request.Body.Key,
+			Data:               dataBytes,
+			AdditionalMetadata: additionalMetadata,
+			DeliverAfter:       request.Body.DeliverAfter,
+		},
+	})
 
 	if err != nil {
 		if err == metered.ErrResourceExhausted {
@@ -42,10 +51,18 @@ func (t *EventService) EventCreate(ctx echo.Context, request gen.EventCreateRequ
 			), nil
 		}
 
+		if apiErrors, ok := apierrors.NewQuotaExceededAPIErrors(err); ok {
+			return gen.EventCreate429JSONResponse(apiErrors), nil
+		}
+
+		if apiErrors, ok := apierrors.NewInputValidationAPIErrors(err); ok {
+			return gen.EventCreate400JSONResponse(apiErrors), nil
+		}
+
 		return nil, err
 	}
 
-	dbNewEvent, err := t.config.APIRepository.Event().GetEventById(sqlchelpers.UUIDToStr(newEvent.ID))
+	dbNewEvent, err := t.config.APIRepository.Event().GetEventById(sqlchelpers.UUIDToStr(events[0].ID))
 
 	if err != nil {
 		return nil, err
@@ -0,0 +1,139 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/integrations/cloudevents"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// maxCloudEventSize is the maximum size of the body of a CloudEvent request
+const maxCloudEventSize = 1 << 20
+
+func (t *EventService) EventCreateCloudEvent(ctx echo.Context, request gen.EventCreateCloudEventRequestObject) (gen.EventCreateCloudEventResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	body, err := io.ReadAll(io.LimitReader(ctx.Request().Body, maxCloudEventSize+1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(body) > maxCloudEventSize {
+		return gen.EventCreateCloudEvent400JSONResponse(
+			apierrors.NewAPIErrors(fmt.Sprintf("request body exceeds the maximum size of %d bytes", maxCloudEventSize)),
+		), nil
+	}
+
+	cloudEvent, err := cloudevents.ParseHTTP(ctx.Request().Header, body)
+
+	if err != nil {
+		return gen.EventCreateCloudEvent400JSONResponse(
+			apierrors.NewAPIErrors(fmt.Sprintf("invalid CloudEvent: %s", err.Error())),
+		), nil
+	}
+
+	opts, err := cloudEventToEvent(tenant.ID, cloudEvent)
+
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := t.config.Ingestor.BulkIngestEvent(ctx.Request().Context(), tenant.ID, []*repository.CreateEventOpts{opts})
+
+	if err != nil {
+		if err == metered.ErrResourceExhausted {
+			return gen.EventCreateCloudEvent429JSONResponse(
+				apierrors.NewAPIErrors("Event limit exceeded"),
+			), nil
+		}
+
+		if apiErrors, ok := apierrors.NewQuotaExceededAPIErrors(err); ok {
+			return gen.EventCreateCloudEvent429JSONResponse(apiErrors), nil
+		}
+
+		if apiErrors, ok := apierrors.NewInputValidationAPIErrors(err); ok {
+			return gen.EventCreateCloudEvent400JSONResponse(apiErrors), nil
+		}
+
+		return nil, err
+	}
+
+	dbNewEvent, err := t.config.APIRepository.Event().GetEventById(sqlchelpers.UUIDToStr(events[0].ID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.EventCreateCloudEvent200JSONResponse(
+		*transformers.ToEvent(dbNewEvent),
+	), nil
+}
+
+// cloudEventToEvent maps a CloudEvent to an event. The type of the CloudEvent is the key of the event, and its
+// extensions and its id, source and subject are the additional metadata of the event. Data which isn't a JSON object
+// is wrapped in an object with a data field, since the data of events must be an object.
+func cloudEventToEvent(tenantId string, cloudEvent *cloudevents.Event) (*repository.CreateEventOpts, error) {
+	var data interface{}
+
+	if len(cloudEvent.Data) > 0 {
+		if err := json.Unmarshal(cloudEvent.Data, &data); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := data.(map[string]interface{}); !ok {
+		if data == nil {
+			data = map[string]interface{}{}
+		} else {
+			data = map[string]interface{}{"data": data}
+		}
+	}
+
+	dataBytes, err := json.Marshal(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := make(map[string]interface{}, len(cloudEvent.Extensions)+3)
+
+	for name, value := range cloudEvent.Extensions {
+		metadata[name] = value
+	}
+
+	metadata["cloudevent_id"] = cloudEvent.ID
+	metadata["cloudevent_source"] = cloudEvent.Source
+
+	if cloudEvent.Subject != "" {
+		metadata["cloudevent_subject"] = cloudEvent.Subject
+	}
+
+	additionalMetadata, err := json.Marshal(metadata)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the source and id of a CloudEvent identify it, so producers which send an event again don't trigger duplicate
+	// workflow runs
+	idempotencyKey := fmt.Sprintf("cloudevent:%s:%s", cloudEvent.Source, cloudEvent.ID)
+
+	return &repository.CreateEventOpts{
+		TenantId:           tenantId,
+		Key:                cloudEvent.Type,
+		Data:               dataBytes,
+		AdditionalMetadata: additionalMetadata,
+		IdempotencyKey:     &idempotencyKey,
+	}, nil
+}
@@ -42,6 +42,10 @@ func (t *EventService) EventUpdateReplay(ctx echo.Context, request gen.EventUpda
 			), nil
 		}
 
+		if apiErrors, ok := apierrors.NewQuotaExceededAPIErrors(err); ok {
+			return gen.EventUpdateReplay429JSONResponse(apiErrors), nil
+		}
+
 		if err != nil {
 			allErrs = multierror.Append(allErrs, err)
 		}
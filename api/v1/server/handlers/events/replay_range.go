@@ -0,0 +1,201 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+const (
+	// defaultReplayRangeLimit is the number of events which are replayed if the request doesn't set a limit
+	defaultReplayRangeLimit = 100
+
+	// maxReplayRangeLimit is the maximum number of events which are replayed in a single request
+	maxReplayRangeLimit = 1000
+)
+
+func (t *EventService) EventUpdateReplayRange(ctx echo.Context, request gen.EventUpdateReplayRangeRequestObject) (gen.EventUpdateReplayRangeResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.EventUpdateReplayRange400JSONResponse(*apiErrors), nil
+	}
+
+	opts := &repository.ListEventsForReplayOpts{
+		Since: request.Body.Since,
+		Until: time.Now().UTC(),
+		Limit: defaultReplayRangeLimit,
+	}
+
+	if request.Body.Until != nil {
+		opts.Until = *request.Body.Until
+	}
+
+	if !opts.Since.Before(opts.Until) {
+		return gen.EventUpdateReplayRange400JSONResponse(
+			apierrors.NewAPIErrors("since must be before until"),
+		), nil
+	}
+
+	if request.Body.Limit != nil {
+		if *request.Body.Limit < 1 || *request.Body.Limit > maxReplayRangeLimit {
+			return gen.EventUpdateReplayRange400JSONResponse(
+				apierrors.NewAPIErrors(fmt.Sprintf("limit must be between 1 and %d", maxReplayRangeLimit), "limit"),
+			), nil
+		}
+
+		opts.Limit = *request.Body.Limit
+	}
+
+	if request.Body.Keys != nil && len(*request.Body.Keys) > 0 {
+		opts.Keys = *request.Body.Keys
+	}
+
+	if request.Body.AdditionalMetadata != nil {
+		additionalMetadata, err := json.Marshal(*request.Body.AdditionalMetadata)
+
+		if err != nil {
+			return gen.EventUpdateReplayRange400JSONResponse(
+				apierrors.NewAPIErrors("additionalMetadata must be a JSON object", "additionalMetadata"),
+			), nil
+		}
+
+		opts.AdditionalMetadata = additionalMetadata
+	}
+
+	dryRun := request.Body.DryRun != nil && *request.Body.DryRun
+
+	// fetch one more event than the limit to find out whether there are more events in the window
+	limit := opts.Limit
+	opts.Limit++
+
+	events, err := t.config.EngineRepository.Event().ListEventsForReplay(ctx.Request().Context(), tenant.ID, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := gen.ReplayEventRangeResponse{
+		DryRun:    dryRun,
+		EventIds:  []uuid.UUID{},
+		Workflows: []gen.ReplayEventRangeWorkflow{},
+	}
+
+	if len(events) > limit {
+		events = events[:limit]
+
+		// the events are ordered by their creation time, so the next page starts right after the last event
+		nextSince := events[len(events)-1].CreatedAt.Time.Add(time.Microsecond)
+
+		resp.HasMore = true
+		resp.NextSince = &nextSince
+	}
+
+	resp.EventCount = len(events)
+
+	workflows, err := t.matchReplayedEvents(ctx.Request().Context(), tenant.ID, events)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Workflows = workflows
+
+	if dryRun {
+		return gen.EventUpdateReplayRange200JSONResponse(resp), nil
+	}
+
+	for _, event := range events {
+		newEvent, err := t.config.Ingestor.IngestReplayedEvent(ctx.Request().Context(), tenant.ID, event)
+
+		if err == metered.ErrResourceExhausted {
+			return gen.EventUpdateReplayRange429JSONResponse(
+				apierrors.NewAPIErrors("Event limit exceeded"),
+			), nil
+		}
+
+		if apiErrors, ok := apierrors.NewQuotaExceededAPIErrors(err); ok {
+			return gen.EventUpdateReplayRange429JSONResponse(apiErrors), nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		resp.EventIds = append(resp.EventIds, uuid.MustParse(sqlchelpers.UUIDToStr(newEvent.ID)))
+	}
+
+	return gen.EventUpdateReplayRange200JSONResponse(resp), nil
+}
+
+// matchReplayedEvents returns the current versions of the workflows which the events trigger, with the number of
+// events which trigger each workflow and the number of events whose data isn't valid input for it.
+func (t *EventService) matchReplayedEvents(ctx context.Context, tenantId string, events []*dbsqlc.Event) ([]gen.ReplayEventRangeWorkflow, error) {
+	workflowsForKeys := make(map[string][]*dbsqlc.GetWorkflowVersionForEngineRow)
+	workflowIndexes := make(map[string]int)
+	res := []gen.ReplayEventRangeWorkflow{}
+
+	for _, event := range events {
+		workflowVersions, ok := workflowsForKeys[event.Key]
+
+		if !ok {
+			var err error
+
+			workflowVersions, err = t.config.EngineRepository.Workflow().ListWorkflowsForEvent(ctx, tenantId, event.Key)
+
+			if err != nil {
+				return nil, err
+			}
+
+			workflowsForKeys[event.Key] = workflowVersions
+		}
+
+		for _, workflowVersion := range workflowVersions {
+			workflowVersionId := sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.ID)
+
+			i, ok := workflowIndexes[workflowVersionId]
+
+			if !ok {
+				i = len(res)
+				workflowIndexes[workflowVersionId] = i
+
+				res = append(res, gen.ReplayEventRangeWorkflow{
+					WorkflowId:        uuid.MustParse(sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.WorkflowId)),
+					WorkflowName:      workflowVersion.WorkflowName,
+					WorkflowVersionId: uuid.MustParse(workflowVersionId),
+				})
+			}
+
+			if err := repository.ValidateWorkflowRunInput(workflowVersion, event.Data); err != nil {
+				var validationErr *repository.WorkflowRunInputValidationError
+
+				if !errors.As(err, &validationErr) {
+					return nil, err
+				}
+
+				res[i].SkippedCount++
+				continue
+			}
+
+			res[i].EventCount++
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,102 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+type eventWorkflowRepository struct {
+	repository.WorkflowEngineRepository
+
+	// the workflow versions which are triggered by each event key
+	workflowsForKeys map[string][]*dbsqlc.GetWorkflowVersionForEngineRow
+
+	// the number of times the workflows of each event key were listed
+	calls map[string]int
+}
+
+func (r *eventWorkflowRepository) ListWorkflowsForEvent(ctx context.Context, tenantId, eventKey string) ([]*dbsqlc.GetWorkflowVersionForEngineRow, error) {
+	r.calls[eventKey]++
+	return r.workflowsForKeys[eventKey], nil
+}
+
+type eventEngineRepository struct {
+	repository.EngineRepository
+
+	workflows *eventWorkflowRepository
+}
+
+func (r *eventEngineRepository) Workflow() repository.WorkflowEngineRepository {
+	return r.workflows
+}
+
+func newWorkflowVersion(name string, inputSchema []byte) *dbsqlc.GetWorkflowVersionForEngineRow {
+	return &dbsqlc.GetWorkflowVersionForEngineRow{
+		WorkflowVersion: dbsqlc.WorkflowVersion{
+			ID:          sqlchelpers.UUIDFromStr(uuid.New().String()),
+			WorkflowId:  sqlchelpers.UUIDFromStr(uuid.New().String()),
+			InputSchema: inputSchema,
+		},
+		WorkflowName: name,
+	}
+}
+
+func TestMatchReplayedEvents(t *testing.T) {
+	orders := newWorkflowVersion("orders", []byte(`{"type":"object","required":["orderId"]}`))
+	audit := newWorkflowVersion("audit", nil)
+
+	workflows := &eventWorkflowRepository{
+		workflowsForKeys: map[string][]*dbsqlc.GetWorkflowVersionForEngineRow{
+			"order:created": {orders, audit},
+			"user:created":  {audit},
+		},
+		calls: map[string]int{},
+	}
+
+	svc := NewEventService(&server.ServerConfig{
+		Config: &database.Config{
+			EngineRepository: &eventEngineRepository{workflows: workflows},
+		},
+	})
+
+	events := []*dbsqlc.Event{
+		{Key: "order:created", Data: []byte(`{"orderId":"1"}`)},
+		// the data isn't valid input for the orders workflow, so the event would be skipped for it
+		{Key: "order:created", Data: []byte(`{}`)},
+		{Key: "user:created", Data: []byte(`{}`)},
+		{Key: "unknown", Data: []byte(`{}`)},
+	}
+
+	res, err := svc.matchReplayedEvents(context.Background(), uuid.New().String(), events)
+	require.NoError(t, err)
+
+	assert.Equal(t, []gen.ReplayEventRangeWorkflow{
+		{
+			WorkflowId:        uuid.MustParse(sqlchelpers.UUIDToStr(orders.WorkflowVersion.WorkflowId)),
+			WorkflowName:      "orders",
+			WorkflowVersionId: uuid.MustParse(sqlchelpers.UUIDToStr(orders.WorkflowVersion.ID)),
+			EventCount:        1,
+			SkippedCount:      1,
+		},
+		{
+			WorkflowId:        uuid.MustParse(sqlchelpers.UUIDToStr(audit.WorkflowVersion.WorkflowId)),
+			WorkflowName:      "audit",
+			WorkflowVersionId: uuid.MustParse(sqlchelpers.UUIDToStr(audit.WorkflowVersion.ID)),
+			EventCount:        3,
+		},
+	}, res)
+
+	// the workflows of an event key are only listed once
+	assert.Equal(t, map[string]int{"order:created": 1, "user:created": 1, "unknown": 1}, workflows.calls)
+}
@@ -0,0 +1,120 @@
+package inboundwebhooks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/integrations/ingestors/webhook"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func (i *InboundWebhookService) InboundWebhookCreate(ctx echo.Context, request gen.InboundWebhookCreateRequestObject) (gen.InboundWebhookCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	if apiErrors, err := i.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.InboundWebhookCreate400JSONResponse(*apiErrors), nil
+	}
+
+	scheme := dbsqlc.InboundWebhookSignatureScheme(request.Body.SignatureScheme)
+
+	if (request.Body.EventKey == nil) == (request.Body.EventKeyExpression == nil) {
+		return gen.InboundWebhookCreate400JSONResponse(
+			apierrors.NewAPIErrors("exactly one of the event key and the event key expression is required"),
+		), nil
+	}
+
+	if scheme == dbsqlc.InboundWebhookSignatureSchemeHMACSHA256 && request.Body.SignatureHeader == nil {
+		return gen.InboundWebhookCreate400JSONResponse(
+			apierrors.NewAPIErrors("a signature header is required for the HMAC_SHA256 scheme", "signatureHeader"),
+		), nil
+	}
+
+	expressions := []struct {
+		field string
+		expr  *string
+	}{
+		{"eventKeyExpression", request.Body.EventKeyExpression},
+		{"dataExpression", request.Body.DataExpression},
+	}
+
+	for _, e := range expressions {
+		if e.expr == nil {
+			continue
+		}
+
+		if _, err := i.celParser.ParseInboundWebhookExpression(*e.expr); err != nil {
+			return gen.InboundWebhookCreate400JSONResponse(
+				apierrors.NewAPIErrors(fmt.Sprintf("invalid expression: %s", err.Error()), e.field),
+			), nil
+		}
+	}
+
+	var secret string
+
+	switch {
+	case request.Body.Secret != nil:
+		secret = *request.Body.Secret
+	case scheme == dbsqlc.InboundWebhookSignatureSchemeSTRIPE || scheme == dbsqlc.InboundWebhookSignatureSchemeSVIX:
+		// these providers generate the signing secret, so it can't be generated by us
+		return gen.InboundWebhookCreate400JSONResponse(
+			apierrors.NewAPIErrors(fmt.Sprintf("a secret is required for the %s scheme", scheme), "secret"),
+		), nil
+	default:
+		s, err := random.GenerateWebhookSecret()
+
+		if err != nil {
+			return nil, err
+		}
+
+		secret = s
+	}
+
+	if err := webhook.ValidateSecret(scheme, secret); err != nil {
+		return gen.InboundWebhookCreate400JSONResponse(
+			apierrors.NewAPIErrors(err.Error(), "secret"),
+		), nil
+	}
+
+	encSecret, err := i.config.Encryption.EncryptString(secret, tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	inboundWebhook, err := i.config.EngineRepository.InboundWebhook().CreateInboundWebhook(ctx.Request().Context(), tenant.ID, &repository.CreateInboundWebhookOpts{
+		Name:               request.Body.Name,
+		SignatureScheme:    scheme,
+		SignatureHeader:    request.Body.SignatureHeader,
+		Secret:             encSecret,
+		EventKey:           request.Body.EventKey,
+		EventKeyExpression: request.Body.EventKeyExpression,
+		DataExpression:     request.Body.DataExpression,
+	})
+
+	if err != nil {
+		if strings.Contains(err.Error(), "unique constraint") {
+			return gen.InboundWebhookCreate400JSONResponse(
+				apierrors.NewAPIErrors("an inbound webhook with that name already exists", "name"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	resp := transformers.ToInboundWebhookFromSQLC(inboundWebhook, i.config.Runtime.ServerURL)
+
+	// the secret is only returned once, so it can be configured in the provider
+	resp.Secret = &secret
+
+	return gen.InboundWebhookCreate200JSONResponse(*resp), nil
+}
@@ -0,0 +1,21 @@
+package inboundwebhooks
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (i *InboundWebhookService) InboundWebhookDelete(ctx echo.Context, request gen.InboundWebhookDeleteRequestObject) (gen.InboundWebhookDeleteResponseObject, error) {
+	webhook := ctx.Get("inbound-webhook").(*dbsqlc.InboundWebhook)
+
+	err := i.config.EngineRepository.InboundWebhook().DeleteInboundWebhook(ctx.Request().Context(), sqlchelpers.UUIDToStr(webhook.ID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.InboundWebhookDelete204Response{}, nil
+}
@@ -0,0 +1,31 @@
+package inboundwebhooks
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (i *InboundWebhookService) InboundWebhookList(ctx echo.Context, request gen.InboundWebhookListRequestObject) (gen.InboundWebhookListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	webhooks, err := i.config.EngineRepository.InboundWebhook().ListInboundWebhooks(ctx.Request().Context(), tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.InboundWebhook, len(webhooks))
+
+	for j, webhook := range webhooks {
+		rows[j] = *transformers.ToInboundWebhookFromSQLC(webhook, i.config.Runtime.ServerURL)
+	}
+
+	return gen.InboundWebhookList200JSONResponse(
+		gen.InboundWebhookList{
+			Rows: &rows,
+		},
+	), nil
+}
@@ -0,0 +1,182 @@
+package inboundwebhooks
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/internal/integrations/ingestors/webhook"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// maxBodySize is the maximum size of the body of a request to an inbound webhook
+const maxBodySize = 1 << 20
+
+func (i *InboundWebhookService) InboundWebhookReceive(ctx echo.Context, request gen.InboundWebhookReceiveRequestObject) (gen.InboundWebhookReceiveResponseObject, error) {
+	inboundWebhook, err := i.config.EngineRepository.InboundWebhook().GetInboundWebhookById(ctx.Request().Context(), request.InboundWebhook.String())
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return gen.InboundWebhookReceive404JSONResponse(
+				apierrors.NewAPIErrors("inbound webhook not found"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	body, err := io.ReadAll(io.LimitReader(ctx.Request().Body, maxBodySize+1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(body) > maxBodySize {
+		return gen.InboundWebhookReceive400JSONResponse(
+			apierrors.NewAPIErrors(fmt.Sprintf("request body exceeds the maximum size of %d bytes", maxBodySize)),
+		), nil
+	}
+
+	tenantId := sqlchelpers.UUIDToStr(inboundWebhook.TenantId)
+
+	secret, err := i.config.Encryption.DecryptString(inboundWebhook.Secret, tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	header := ctx.Request().Header
+
+	if err := webhook.Verify(inboundWebhook.SignatureScheme, secret, inboundWebhook.SignatureHeader.String, header, body); err != nil {
+		if errors.Is(err, webhook.ErrInvalidSignature) {
+			return gen.InboundWebhookReceive401JSONResponse(
+				apierrors.NewAPIErrors(err.Error()),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	event, err := i.requestToEvent(inboundWebhook, ctx.Request(), body)
+
+	if err != nil {
+		return gen.InboundWebhookReceive400JSONResponse(
+			apierrors.NewAPIErrors(err.Error()),
+		), nil
+	}
+
+	_, err = i.config.Ingestor.BulkIngestEvent(ctx.Request().Context(), tenantId, []*repository.CreateEventOpts{event})
+
+	var validationErr *repository.WorkflowRunInputValidationError
+
+	if errors.As(err, &validationErr) {
+		return gen.InboundWebhookReceive400JSONResponse(
+			apierrors.NewAPIErrors(err.Error()),
+		), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.InboundWebhookReceive200Response{}, nil
+}
+
+// requestToEvent maps a verified request to an event with the expressions of the webhook. If the webhook has no data
+// expression, the body of the request must be a JSON object, which is used as the data of the event.
+func (i *InboundWebhookService) requestToEvent(inboundWebhook *dbsqlc.InboundWebhook, r *http.Request, body []byte) (*repository.CreateEventOpts, error) {
+	var payload interface{}
+
+	if err := json.Unmarshal(body, &payload); err != nil {
+		payload = string(body)
+	}
+
+	headers := make(map[string]string, len(r.Header))
+
+	for name, values := range r.Header {
+		headers[strings.ToLower(name)] = strings.Join(values, ", ")
+	}
+
+	query := make(map[string]string)
+
+	for name, values := range r.URL.Query() {
+		query[name] = strings.Join(values, ",")
+	}
+
+	in := cel.NewInput(cel.WithInboundWebhookRequest(payload, headers, query))
+
+	key := inboundWebhook.EventKey.String
+
+	if inboundWebhook.EventKeyExpression.Valid {
+		prg, err := i.celParser.ParseInboundWebhookExpression(inboundWebhook.EventKeyExpression.String)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not parse event key expression: %w", err)
+		}
+
+		key, err = cel.EvalInboundWebhookEventKey(prg, in)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not evaluate event key expression: %w", err)
+		}
+	}
+
+	var data []byte
+
+	if inboundWebhook.DataExpression.Valid {
+		prg, err := i.celParser.ParseInboundWebhookExpression(inboundWebhook.DataExpression.String)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not parse data expression: %w", err)
+		}
+
+		data, err = cel.EvalInboundWebhookData(prg, in)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not evaluate data expression: %w", err)
+		}
+	} else {
+		if _, ok := payload.(map[string]interface{}); !ok {
+			return nil, fmt.Errorf("request body must be a JSON object if the webhook has no data expression")
+		}
+
+		data = body
+	}
+
+	webhookId := sqlchelpers.UUIDToStr(inboundWebhook.ID)
+
+	metadata, err := json.Marshal(map[string]string{
+		"inbound_webhook_id":   webhookId,
+		"inbound_webhook_name": inboundWebhook.Name,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	event := &repository.CreateEventOpts{
+		TenantId:           sqlchelpers.UUIDToStr(inboundWebhook.TenantId),
+		Key:                key,
+		Data:               data,
+		AdditionalMetadata: metadata,
+	}
+
+	// providers retry deliveries which time out or fail, so the workflow runs are deduplicated on the delivery id
+	if deliveryId := webhook.DeliveryId(inboundWebhook.SignatureScheme, r.Header, body); deliveryId != "" {
+		idempotencyKey := fmt.Sprintf("inbound-webhook:%s:%s", webhookId, deliveryId)
+		event.IdempotencyKey = &idempotencyKey
+	}
+
+	return event, nil
+}
@@ -0,0 +1,18 @@
+package inboundwebhooks
+
+import (
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type InboundWebhookService struct {
+	config    *server.ServerConfig
+	celParser *cel.CELParser
+}
+
+func NewInboundWebhookService(config *server.ServerConfig) *InboundWebhookService {
+	return &InboundWebhookService{
+		config:    config,
+		celParser: cel.NewCELParser(),
+	}
+}
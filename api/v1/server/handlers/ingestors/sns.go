@@ -10,6 +10,7 @@ import (
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 
 	"github.com/hatchet-dev/hatchet/internal/integrations/ingestors/sns"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
 )
 
 func (i *IngestorsService) SnsUpdate(ctx echo.Context, req gen.SnsUpdateRequestObject) (gen.SnsUpdateResponseObject, error) {
@@ -58,7 +59,27 @@ func (i *IngestorsService) SnsUpdate(ctx echo.Context, req gen.SnsUpdateRequestO
 			return nil, err
 		}
 	default:
-		_, err := i.config.Ingestor.IngestEvent(ctx.Request().Context(), req.Tenant.String(), req.Event, body, nil)
+		metadata, err := json.Marshal(map[string]string{
+			"sns_message_id": payload.MessageId,
+			"sns_topic_arn":  payload.TopicArn,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		// SNS may deliver a message more than once, so the workflow runs are deduplicated on the message id
+		idempotencyKey := fmt.Sprintf("sns:%s:%s", payload.TopicArn, payload.MessageId)
+
+		_, err = i.config.Ingestor.BulkIngestEvent(ctx.Request().Context(), tenantId, []*repository.CreateEventOpts{
+			{
+				TenantId:           tenantId,
+				Key:                req.Event,
+				Data:               body,
+				AdditionalMetadata: metadata,
+				IdempotencyKey:     &idempotencyKey,
+			},
+		})
 
 		if err != nil {
 			return nil, err
@@ -0,0 +1,70 @@
+package ingestors
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/integrations/secrets"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (i *IngestorsService) SqsCreate(ctx echo.Context, req gen.SqsCreateRequestObject) (gen.SqsCreateResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	// validate the request
+	if apiErrors, err := i.config.Validator.ValidateAPI(req.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.SqsCreate400JSONResponse(*apiErrors), nil
+	}
+
+	// the credentials of the queue are resolved from the secrets of the tenant by the engine
+	if i.config.SecretResolver == nil {
+		return gen.SqsCreate400JSONResponse(
+			apierrors.NewAPIErrors("secrets are not enabled on this instance, so SQS integrations can't be created"),
+		), nil
+	}
+
+	secretNames := []string{req.Body.AccessKeyIdSecret, req.Body.SecretAccessKeySecret}
+
+	if req.Body.SessionTokenSecret != nil {
+		secretNames = append(secretNames, *req.Body.SessionTokenSecret)
+	}
+
+	for _, name := range secretNames {
+		if err := secrets.ValidateName(name); err != nil {
+			return gen.SqsCreate400JSONResponse(apierrors.NewAPIErrors(err.Error())), nil
+		}
+	}
+
+	opts := &repository.CreateSQSIntegrationOpts{
+		QueueUrl:              req.Body.QueueUrl,
+		Region:                req.Body.Region,
+		EventKey:              req.Body.EventKey,
+		AccessKeyIdSecret:     req.Body.AccessKeyIdSecret,
+		SecretAccessKeySecret: req.Body.SecretAccessKeySecret,
+		SessionTokenSecret:    req.Body.SessionTokenSecret,
+	}
+
+	// create the SQS integration
+	sqsIntegration, err := i.config.EngineRepository.SQSIntegration().CreateSQSIntegration(ctx.Request().Context(), tenant.ID, opts)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "unique constraint") {
+			return gen.SqsCreate400JSONResponse(
+				apierrors.NewAPIErrors("an SQS integration for that queue already exists"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	return gen.SqsCreate201JSONResponse(
+		*transformers.ToSQSIntegrationFromSQLC(sqsIntegration),
+	), nil
+}
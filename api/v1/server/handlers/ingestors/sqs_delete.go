@@ -0,0 +1,22 @@
+package ingestors
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (i *IngestorsService) SqsDelete(ctx echo.Context, req gen.SqsDeleteRequestObject) (gen.SqsDeleteResponseObject, error) {
+	sqs := ctx.Get("sqs").(*dbsqlc.SQSIntegration)
+
+	// the engine stops polling the queue once the integration is deleted
+	err := i.config.EngineRepository.SQSIntegration().DeleteSQSIntegration(ctx.Request().Context(), sqlchelpers.UUIDToStr(sqs.ID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.SqsDelete204Response{}, nil
+}
@@ -0,0 +1,32 @@
+package ingestors
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (i *IngestorsService) SqsList(ctx echo.Context, req gen.SqsListRequestObject) (gen.SqsListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	sqsIntegrations, err := i.config.EngineRepository.SQSIntegration().ListSQSIntegrations(ctx.Request().Context(), tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.SQSIntegration, len(sqsIntegrations))
+
+	for i := range sqsIntegrations {
+		rows[i] = *transformers.ToSQSIntegrationFromSQLC(sqsIntegrations[i])
+	}
+
+	return gen.SqsList200JSONResponse(
+		gen.ListSQSIntegrations{
+			Rows: rows,
+		},
+	), nil
+}
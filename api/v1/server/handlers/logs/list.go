@@ -42,6 +42,10 @@ func (t *LogService) LogLineList(ctx echo.Context, request gen.LogLineListReques
 		listOpts.Levels = levels
 	}
 
+	if request.Params.AfterId != nil {
+		listOpts.AfterId = request.Params.AfterId
+	}
+
 	if request.Params.OrderByField != nil {
 		listOpts.OrderBy = repository.StringPtr(string(*request.Params.OrderByField))
 	}
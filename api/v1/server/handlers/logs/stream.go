@@ -0,0 +1,179 @@
+package logs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+const (
+	// pollInterval is how often new log lines are listed while a step run is tailed.
+	pollInterval = time.Second
+
+	// keepAliveInterval is how often a comment is sent on idle streams, so proxies don't close them.
+	keepAliveInterval = 15 * time.Second
+)
+
+func (t *LogService) LogLineStream(ctx echo.Context, request gen.LogLineStreamRequestObject) (gen.LogLineStreamResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	stepRun := ctx.Get("step-run").(*repository.GetStepRunFull)
+
+	var lastEventId int64
+
+	// browsers send the id of the last log line they received when they reconnect
+	if header := ctx.Request().Header.Get("Last-Event-ID"); header != "" {
+		id, err := strconv.ParseInt(header, 10, 64)
+
+		if err != nil || id < 0 {
+			return gen.LogLineStream400JSONResponse(
+				apierrors.NewAPIErrors("invalid Last-Event-ID header"),
+			), nil
+		}
+
+		lastEventId = id
+	}
+
+	var levels []string
+
+	if request.Params.Levels != nil {
+		for _, level := range *request.Params.Levels {
+			levels = append(levels, string(level))
+		}
+	}
+
+	return &logLineStream{
+		t:           t,
+		ctx:         ctx.Request().Context(),
+		tenantId:    tenant.ID,
+		stepRunId:   sqlchelpers.UUIDToStr(stepRun.ID),
+		levels:      levels,
+		lastEventId: lastEventId,
+	}, nil
+}
+
+// logLineStream writes the log lines of a step run as server-sent events. Log lines are polled for until the step
+// run has finished, and the id of every event is the id of its log line, so clients can resume after it.
+type logLineStream struct {
+	t *LogService
+
+	ctx         context.Context
+	tenantId    string
+	stepRunId   string
+	levels      []string
+	lastEventId int64
+}
+
+func (s *logLineStream) VisitLogLineStreamResponse(w http.ResponseWriter) error {
+	flusher, ok := w.(http.Flusher)
+
+	if !ok {
+		return fmt.Errorf("response writer does not support streaming")
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	poll := time.NewTicker(pollInterval)
+	defer poll.Stop()
+
+	lastWrite := time.Now()
+
+	for {
+		// the status is read before the log lines are listed, so the log lines which were stored before the step run
+		// finished are written before the stream ends
+		stepRun, err := s.t.config.APIRepository.StepRun().GetStepRunById(s.stepRunId)
+
+		if err != nil {
+			return fmt.Errorf("could not get step run: %w", err)
+		}
+
+		written, err := s.writeNewLogLines(w)
+
+		if err != nil {
+			return err
+		}
+
+		if repository.IsFinalStepRunStatus(stepRun.Status) {
+			_, err := fmt.Fprint(w, "event: end\ndata: {}\n\n")
+			flusher.Flush()
+
+			return err
+		}
+
+		switch {
+		case written > 0:
+			lastWrite = time.Now()
+		case time.Since(lastWrite) >= keepAliveInterval:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return err
+			}
+
+			lastWrite = time.Now()
+		}
+
+		flusher.Flush()
+
+		select {
+		case <-s.ctx.Done():
+			return nil
+		case <-poll.C:
+		}
+	}
+}
+
+// writeNewLogLines writes the log lines which were stored after the last log line which was written, and returns the
+// number of log lines which were written.
+func (s *logLineStream) writeNewLogLines(w http.ResponseWriter) (int, error) {
+	limit := 1000
+	written := 0
+
+	for {
+		afterId := s.lastEventId
+
+		res, err := s.t.config.APIRepository.Log().ListLogLines(s.tenantId, &repository.ListLogsOpts{
+			StepRunId: &s.stepRunId,
+			Levels:    s.levels,
+			AfterId:   &afterId,
+			Limit:     &limit,
+		})
+
+		if err != nil {
+			return written, fmt.Errorf("could not list log lines: %w", err)
+		}
+
+		for _, row := range res.Rows {
+			data, err := json.Marshal(transformers.ToLogFromSQLC(row))
+
+			if err != nil {
+				return written, fmt.Errorf("could not marshal log line: %w", err)
+			}
+
+			if _, err := fmt.Fprintf(w, "id: %d\ndata: %s\n\n", row.ID, data); err != nil {
+				return written, err
+			}
+
+			s.lastEventId = row.ID
+			written++
+		}
+
+		if len(res.Rows) < limit {
+			return written, nil
+		}
+	}
+}
@@ -21,6 +21,13 @@ func (u *MetadataService) MetadataGet(ctx echo.Context, request gen.MetadataGetR
 		authTypes = append(authTypes, "github")
 	}
 
+	var oidcProviderName *string
+
+	if u.config.Auth.OIDCProvider != nil {
+		authTypes = append(authTypes, "oidc")
+		oidcProviderName = &u.config.Auth.ConfigFile.OIDC.Name
+	}
+
 	pylonAppID := u.config.Pylon.AppID
 
 	var posthogConfig *gen.APIMetaPosthog
@@ -34,7 +41,8 @@ func (u *MetadataService) MetadataGet(ctx echo.Context, request gen.MetadataGetR
 
 	meta := gen.APIMeta{
 		Auth: &gen.APIMetaAuth{
-			Schemes: &authTypes,
+			Schemes:          &authTypes,
+			OidcProviderName: oidcProviderName,
 		},
 		PylonAppId:          &pylonAppID,
 		Posthog:             posthogConfig,
@@ -6,6 +6,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/services/health"
 )
 
 func (u *MetadataService) LivenessGet(ctx echo.Context, request gen.LivenessGetRequestObject) (gen.LivenessGetResponseObject, error) {
@@ -39,3 +40,44 @@ func (u *MetadataService) ReadinessGet(ctx echo.Context, request gen.ReadinessGe
 
 	return gen.ReadinessGet200Response{}, nil
 }
+
+func (u *MetadataService) HealthzGet(ctx echo.Context, request gen.HealthzGetRequestObject) (gen.HealthzGetResponseObject, error) {
+	report := toAPIHealthReport(health.Run(ctx.Request().Context(), u.probes, true))
+
+	if !report.Healthy {
+		return gen.HealthzGet503JSONResponse(report), nil
+	}
+
+	return gen.HealthzGet200JSONResponse(report), nil
+}
+
+func (u *MetadataService) ReadyzGet(ctx echo.Context, request gen.ReadyzGetRequestObject) (gen.ReadyzGetResponseObject, error) {
+	report := toAPIHealthReport(health.Run(ctx.Request().Context(), u.probes, false))
+
+	if !report.Healthy {
+		return gen.ReadyzGet503JSONResponse(report), nil
+	}
+
+	return gen.ReadyzGet200JSONResponse(report), nil
+}
+
+func toAPIHealthReport(report *health.Report) gen.HealthReport {
+	res := gen.HealthReport{
+		Healthy: report.Healthy,
+		Probes:  make([]gen.HealthProbe, len(report.Probes)),
+	}
+
+	for i, probe := range report.Probes {
+		res.Probes[i] = gen.HealthProbe{
+			Name:       probe.Name,
+			Healthy:    probe.Healthy,
+			DurationMs: probe.DurationMs,
+		}
+
+		if probe.Error != "" {
+			res.Probes[i].Error = &probe.Error
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,33 @@
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/services/health"
+)
+
+func TestToAPIHealthReport(t *testing.T) {
+	report := toAPIHealthReport(&health.Report{
+		Healthy: false,
+		Probes: []health.ProbeResult{
+			{Name: "database", Healthy: true, DurationMs: 2},
+			{Name: "migrations", Healthy: false, Error: "1 migrations weren't applied: 20250124084512_v0.52.59.sql", DurationMs: 5},
+		},
+	})
+
+	assert.False(t, report.Healthy)
+	require.Len(t, report.Probes, 2)
+
+	assert.Equal(t, "database", report.Probes[0].Name)
+	assert.True(t, report.Probes[0].Healthy)
+	assert.Equal(t, int64(2), report.Probes[0].DurationMs)
+	assert.Nil(t, report.Probes[0].Error, "healthy probes don't have an error")
+
+	assert.Equal(t, "migrations", report.Probes[1].Name)
+	assert.False(t, report.Probes[1].Healthy)
+	require.NotNil(t, report.Probes[1].Error)
+	assert.Equal(t, "1 migrations weren't applied: 20250124084512_v0.52.59.sql", *report.Probes[1].Error)
+}
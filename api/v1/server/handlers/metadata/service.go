@@ -1,15 +1,18 @@
 package metadata
 
 import (
+	"github.com/hatchet-dev/hatchet/internal/services/health"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
 )
 
 type MetadataService struct {
 	config *server.ServerConfig
+	probes []health.Probe
 }
 
 func NewMetadataService(config *server.ServerConfig) *MetadataService {
 	return &MetadataService{
 		config: config,
+		probes: health.Probes(config.EngineRepository, config.MessageQueue),
 	}
 }
@@ -0,0 +1,31 @@
+package queues
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (t *QueueService) QueueUpdateResume(ctx echo.Context, request gen.QueueUpdateResumeRequestObject) (gen.QueueUpdateResumeResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	queue, err := t.config.APIRepository.Queue().SetQueuePaused(ctx.Request().Context(), tenant.ID, request.Queue, false)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return gen.QueueUpdateResume404JSONResponse(
+				apierrors.NewAPIErrors("queue not found"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	return gen.QueueUpdateResume200JSONResponse(*transformers.ToQueue(queue)), nil
+}
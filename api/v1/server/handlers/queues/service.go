@@ -0,0 +1,15 @@
+package queues
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+type QueueService struct {
+	config *server.ServerConfig
+}
+
+func NewQueueService(config *server.ServerConfig) *QueueService {
+	return &QueueService{
+		config: config,
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/runarchive"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
 )
 
@@ -19,6 +20,38 @@ func (t *StepRunService) WorkflowRunListStepRunEvents(ctx echo.Context, request
 
 	lastId := request.Params.LastId
 
+	// the events of archived runs are read from the blob storage
+	archived, err := runarchive.Load(
+		reqCtx,
+		t.config.EngineRepository.WorkflowRunArchive(),
+		t.config.BlobOffloader,
+		tenant.ID,
+		request.WorkflowRun.String(),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if archived != nil {
+		rows := make([]gen.StepRunEvent, 0, len(archived.StepRunEvents))
+
+		for _, e := range archived.StepRunEvents {
+			// only the events of step runs are listed, like for runs which weren't archived
+			if !e.StepRunId.Valid || (lastId != nil && e.ID <= int64(*lastId)) {
+				continue
+			}
+
+			rows = append(rows, *transformers.ToStepRunEvent(e))
+		}
+
+		return gen.WorkflowRunListStepRunEvents200JSONResponse(
+			gen.StepRunEventList{
+				Rows: &rows,
+			},
+		), nil
+	}
+
 	listRes, err := t.config.APIRepository.StepRun().ListStepRunEventsByWorkflowRunId(
 		reqCtx,
 		tenant.ID,
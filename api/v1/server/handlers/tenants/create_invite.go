@@ -40,7 +40,7 @@ func (t *TenantService) TenantInviteCreate(ctx echo.Context, request gen.TenantI
 	}
 
 	// if user is not an owner, they cannot change a role to owner
-	if tenantMember.Role != db.TenantMemberRoleOwner && request.Body.Role == gen.OWNER {
+	if tenantMember.Role != db.TenantMemberRoleOwner && request.Body.Role == gen.TenantMemberRoleOWNER {
 		return gen.TenantInviteCreate400JSONResponse(
 			apierrors.NewAPIErrors("only an owner can change a role to owner"),
 		), nil
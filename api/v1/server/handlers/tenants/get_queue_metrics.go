@@ -3,11 +3,13 @@ package tenants
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
 )
@@ -38,6 +40,12 @@ func (t *TenantService) TenantGetQueueMetrics(ctx echo.Context, request gen.Tena
 		opts.WorkflowIds = *request.Params.Workflows
 	}
 
+	window, reason := parseQueueMetricsWindow(request.Params.Window)
+
+	if reason != "" {
+		return gen.TenantGetQueueMetrics400JSONResponse(apierrors.NewAPIErrors(reason)), nil
+	}
+
 	metrics, err := t.config.APIRepository.Tenant().GetQueueMetrics(ctx.Request().Context(), tenant.ID, &opts)
 
 	if err != nil {
@@ -70,5 +78,37 @@ func (t *TenantService) TenantGetQueueMetrics(ctx echo.Context, request gen.Tena
 		Queues:   &stepRunQueueCounts,
 	}
 
+	if window > 0 {
+		snapshots, err := t.config.EngineRepository.QueueMetrics().ListQueueMetricsSnapshots(ctx.Request().Context(), tenant.ID, time.Now().Add(-window))
+
+		if err != nil {
+			return nil, err
+		}
+
+		history := transformers.ToQueueMetricsHistory(snapshots)
+
+		resp.History = &history
+	}
+
 	return gen.TenantGetQueueMetrics200JSONResponse(resp), nil
 }
+
+// parseQueueMetricsWindow parses the window of the queue metrics history, which is 0 if the history isn't requested.
+// If the window is invalid, the reason is returned.
+func parseQueueMetricsWindow(param *string) (time.Duration, string) {
+	if param == nil {
+		return 0, ""
+	}
+
+	window, err := time.ParseDuration(*param)
+
+	if err != nil || window <= 0 {
+		return 0, "The window must be a positive duration, like 5m or 1h."
+	}
+
+	if window > repository.QueueMetricsRetention {
+		return 0, fmt.Sprintf("The window can be at most %s.", repository.QueueMetricsRetention)
+	}
+
+	return window, ""
+}
@@ -0,0 +1,62 @@
+package tenants
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+func TestParseQueueMetricsWindow(t *testing.T) {
+	tests := []struct {
+		name     string
+		param    *string
+		expected time.Duration
+		invalid  bool
+	}{
+		{
+			name: "no window",
+		},
+		{
+			name:     "window",
+			param:    repository.StringPtr("5m"),
+			expected: 5 * time.Minute,
+		},
+		{
+			name:     "window of the retention period",
+			param:    repository.StringPtr(repository.QueueMetricsRetention.String()),
+			expected: repository.QueueMetricsRetention,
+		},
+		{
+			name:    "window which is longer than the retention period",
+			param:   repository.StringPtr((repository.QueueMetricsRetention + time.Minute).String()),
+			invalid: true,
+		},
+		{
+			name:    "negative window",
+			param:   repository.StringPtr("-5m"),
+			invalid: true,
+		},
+		{
+			name:    "invalid window",
+			param:   repository.StringPtr("5 minutes"),
+			invalid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			window, reason := parseQueueMetricsWindow(tt.param)
+
+			if tt.invalid {
+				assert.NotEmpty(t, reason)
+				return
+			}
+
+			assert.Empty(t, reason)
+			assert.Equal(t, tt.expected, window)
+		})
+	}
+}
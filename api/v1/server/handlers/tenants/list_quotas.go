@@ -0,0 +1,23 @@
+package tenants
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (t *TenantService) TenantQuotaList(ctx echo.Context, request gen.TenantQuotaListRequestObject) (gen.TenantQuotaListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	usages, err := t.config.EngineRepository.TenantQuota().ListQuotaUsage(ctx.Request().Context(), tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.TenantQuotaList200JSONResponse(
+		*transformers.ToTenantQuotaUsageList(usages),
+	), nil
+}
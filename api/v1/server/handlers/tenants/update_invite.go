@@ -22,7 +22,7 @@ func (t *TenantService) TenantInviteUpdate(ctx echo.Context, request gen.TenantI
 	}
 
 	// if user is not an owner, they cannot change a role to owner
-	if tenantMember.Role != db.TenantMemberRoleOwner && request.Body.Role == gen.OWNER {
+	if tenantMember.Role != db.TenantMemberRoleOwner && request.Body.Role == gen.TenantMemberRoleOWNER {
 		return gen.TenantInviteUpdate400JSONResponse(
 			apierrors.NewAPIErrors("only an owner can change a role to owner"),
 		), nil
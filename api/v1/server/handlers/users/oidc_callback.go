@@ -0,0 +1,150 @@
+package users
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/authn"
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/redirect"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/auth/oidc"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+var errOIDCUserNotProvisioned = errors.New("user does not exist and just-in-time provisioning is disabled")
+
+// Note: we want all errors to redirect, otherwise the user will be greeted with raw JSON in the middle of the login flow.
+func (u *UserService) UserUpdateOidcCallback(ctx echo.Context, _ gen.UserUpdateOidcCallbackRequestObject) (gen.UserUpdateOidcCallbackResponseObject, error) {
+	if u.config.Auth.OIDCProvider == nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, nil, "SSO is not enabled.")
+	}
+
+	isValid, _, err := authn.NewSessionHelpers(u.config).ValidateOAuthState(ctx, "oidc")
+
+	if err != nil || !isValid {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Could not log in. Please try again and make sure cookies are enabled.")
+	}
+
+	query := ctx.Request().URL.Query()
+
+	if errCode := query.Get("error"); errCode != "" {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, fmt.Errorf("oidc provider returned %s: %s", errCode, query.Get("error_description")), "Forbidden")
+	}
+
+	claims, err := u.config.Auth.OIDCProvider.Exchange(ctx.Request().Context(), query.Get("code"), query.Get("state"))
+
+	if err != nil {
+		if errors.Is(err, oidc.ErrEmailNotVerified) {
+			return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Your email is not verified by the SSO provider.")
+		}
+
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Forbidden")
+	}
+
+	user, err := u.upsertOIDCUser(claims)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrNotInRestrictedDomain):
+			return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Email is not in the restricted domain group.")
+		case errors.Is(err, errOIDCUserNotProvisioned):
+			return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "No user exists for this email. Please ask an admin to invite you.")
+		}
+
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Internal error.")
+	}
+
+	if err := u.applyOIDCGroupMappings(user.ID, claims.Groups); err != nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Internal error.")
+	}
+
+	err = authn.NewSessionHelpers(u.config).SaveAuthenticated(ctx, user)
+
+	if err != nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Internal error.")
+	}
+
+	return gen.UserUpdateOidcCallback302Response{
+		Headers: gen.UserUpdateOidcCallback302ResponseHeaders{
+			Location: u.config.Runtime.ServerURL,
+		},
+	}, nil
+}
+
+func (u *UserService) upsertOIDCUser(claims *oidc.Claims) (*db.UserModel, error) {
+	if err := u.checkUserRestrictionsForEmail(u.config, claims.Email); err != nil {
+		return nil, err
+	}
+
+	opts := &repository.UpdateUserOpts{
+		EmailVerified: repository.BoolPtr(claims.EmailVerified),
+	}
+
+	if claims.Name != "" {
+		opts.Name = repository.StringPtr(claims.Name)
+	}
+
+	user, err := u.config.APIRepository.User().GetUserByEmail(claims.Email)
+
+	switch err {
+	case nil:
+		user, err = u.config.APIRepository.User().UpdateUser(user.ID, opts)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to update user: %s", err.Error())
+		}
+	case db.ErrNotFound:
+		if !u.config.Auth.ConfigFile.OIDC.JITProvisioning || !u.config.Runtime.AllowSignup {
+			return nil, errOIDCUserNotProvisioned
+		}
+
+		user, err = u.config.APIRepository.User().CreateUser(&repository.CreateUserOpts{
+			Email:         claims.Email,
+			EmailVerified: opts.EmailVerified,
+			Name:          opts.Name,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to create user: %s", err.Error())
+		}
+	default:
+		return nil, fmt.Errorf("failed to get user: %s", err.Error())
+	}
+
+	return user, nil
+}
+
+// applyOIDCGroupMappings grants the user the tenant roles which their groups are mapped to. Memberships are only
+// created or upgraded, so roles which were granted in Hatchet are kept.
+func (u *UserService) applyOIDCGroupMappings(userId string, groups []string) error {
+	for tenantId, role := range oidc.TenantRoles(u.config.Auth.OIDCGroupMappings, groups) {
+		member, err := u.config.APIRepository.Tenant().GetTenantMemberByUserID(tenantId, userId)
+
+		switch {
+		case errors.Is(err, db.ErrNotFound):
+			_, err = u.config.APIRepository.Tenant().CreateTenantMember(tenantId, &repository.CreateTenantMemberOpts{
+				Role:   role,
+				UserId: userId,
+			})
+
+			if err != nil {
+				return fmt.Errorf("failed to create tenant member: %w", err)
+			}
+		case err != nil:
+			return fmt.Errorf("failed to get tenant member: %w", err)
+		case oidc.IsMorePrivileged(role, string(member.Role)):
+			_, err = u.config.APIRepository.Tenant().UpdateTenantMember(member.ID, &repository.UpdateTenantMemberOpts{
+				Role: &role,
+			})
+
+			if err != nil {
+				return fmt.Errorf("failed to update tenant member: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package users
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/authn"
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/redirect"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+)
+
+// Note: we want all errors to redirect, otherwise the user will be greeted with raw JSON in the middle of the login flow.
+func (u *UserService) UserUpdateOidcStart(ctx echo.Context, _ gen.UserUpdateOidcStartRequestObject) (gen.UserUpdateOidcStartResponseObject, error) {
+	if u.config.Auth.OIDCProvider == nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, nil, "SSO is not enabled.")
+	}
+
+	state, err := authn.NewSessionHelpers(u.config).SaveOAuthState(ctx, "oidc")
+
+	if err != nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Could not get cookie. Please make sure cookies are enabled.")
+	}
+
+	url, err := u.config.Auth.OIDCProvider.AuthCodeURL(ctx.Request().Context(), state)
+
+	if err != nil {
+		return nil, redirect.GetRedirectWithError(ctx, u.config.Logger, err, "Could not reach the SSO provider. Please try again later.")
+	}
+
+	return gen.UserUpdateOidcStart302Response{
+		Headers: gen.UserUpdateOidcStart302ResponseHeaders{
+			Location: url,
+		},
+	}, nil
+}
@@ -0,0 +1,31 @@
+package workers
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func (t *WorkerService) WorkerPoolList(ctx echo.Context, request gen.WorkerPoolListRequestObject) (gen.WorkerPoolListResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+
+	pools, err := t.config.APIRepository.Worker().ListWorkerPools(tenant.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.WorkerPool, len(pools))
+
+	for i, pool := range pools {
+		rows[i] = transformers.ToWorkerPool(pool)
+	}
+
+	return gen.WorkerPoolList200JSONResponse(
+		gen.WorkerPoolList{
+			Rows: &rows,
+		},
+	), nil
+}
@@ -1,13 +1,43 @@
 package workflowruns
 
 import (
+	"encoding/json"
+
 	"github.com/labstack/echo/v4"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/runarchive"
 )
 
 func (t *WorkflowRunsService) WorkflowRunGetInput(ctx echo.Context, request gen.WorkflowRunGetInputRequestObject) (gen.WorkflowRunGetInputResponseObject, error) {
 
+	// the input of archived runs is read from the blob storage
+	archived, err := runarchive.Load(
+		ctx.Request().Context(),
+		t.config.EngineRepository.WorkflowRunArchive(),
+		t.config.BlobOffloader,
+		request.Tenant.String(),
+		request.WorkflowRun.String(),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if archived != nil {
+		input := map[string]interface{}{}
+
+		if archived.Input != nil {
+			if err := json.Unmarshal(archived.Input, &input); err != nil {
+				return nil, err
+			}
+		}
+
+		return gen.WorkflowRunGetInput200JSONResponse(
+			input,
+		), nil
+	}
+
 	input, err := t.config.EngineRepository.WorkflowRun().GetWorkflowRunInputData(request.Tenant.String(), request.WorkflowRun.String())
 
 	if err != nil {
@@ -0,0 +1,47 @@
+package workflowruns
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *WorkflowRunsService) WorkflowRunUpdatePause(ctx echo.Context, request gen.WorkflowRunUpdatePauseRequestObject) (gen.WorkflowRunUpdatePauseResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	run := ctx.Get("workflow-run").(*dbsqlc.GetWorkflowRunByIdRow)
+	runId := sqlchelpers.UUIDToStr(run.ID)
+
+	err := t.config.APIRepository.WorkflowRun().PauseWorkflowRun(ctx.Request().Context(), tenant.ID, runId)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrWorkflowRunNotRunning) {
+			return gen.WorkflowRunUpdatePause400JSONResponse(
+				apierrors.NewAPIErrors("only running workflow runs can be paused"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	run, err = t.config.APIRepository.WorkflowRun().GetWorkflowRunById(ctx.Request().Context(), tenant.ID, runId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := transformers.ToWorkflowRun(run, nil, nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowRunUpdatePause200JSONResponse(*resp), nil
+}
@@ -59,7 +59,8 @@ func (t *WorkflowRunsService) WorkflowRunUpdateReplay(ctx echo.Context, request
 	dbCtx, cancel := context.WithTimeout(ctx.Request().Context(), 60*time.Second)
 	defer cancel()
 
-	newWorkflowRuns, err := t.config.APIRepository.WorkflowRun().ListWorkflowRuns(dbCtx, tenant.ID, &repository.ListWorkflowRunsOpts{
+	// read from the primary, the runs which were just replayed may not be on the read replica yet
+	newWorkflowRuns, err := t.config.EngineRepository.WorkflowRun().ListWorkflowRuns(dbCtx, tenant.ID, &repository.ListWorkflowRunsOpts{
 		Ids:   workflowRunIds,
 		Limit: &limit,
 	})
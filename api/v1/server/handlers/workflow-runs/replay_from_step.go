@@ -0,0 +1,177 @@
+package workflowruns
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *WorkflowRunsService) WorkflowRunUpdateReplayFromStep(ctx echo.Context, request gen.WorkflowRunUpdateReplayFromStepRequestObject) (gen.WorkflowRunUpdateReplayFromStepResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	run := ctx.Get("workflow-run").(*dbsqlc.GetWorkflowRunByIdRow)
+	runId := sqlchelpers.UUIDToStr(run.ID)
+	stepId := request.Body.StepId.String()
+
+	if !repository.IsFinalWorkflowRunStatus(run.Status) {
+		return gen.WorkflowRunUpdateReplayFromStep400JSONResponse(
+			apierrors.NewAPIErrors("Workflow run cannot be replayed because it is not finished running yet."),
+		), nil
+	}
+
+	// preflight check to verify that the step is part of the workflow run and its upstream steps succeeded
+	err := t.config.APIRepository.WorkflowRun().PreflightCheckReplayFromStep(ctx.Request().Context(), tenant.ID, runId, stepId)
+
+	if err != nil {
+		if errors.Is(err, repository.ErrPreflightReplayStepNotInWorkflowRun) {
+			return gen.WorkflowRunUpdateReplayFromStep400JSONResponse(
+				apierrors.NewAPIErrors("The step is not part of the workflow run."),
+			), nil
+		}
+
+		if errors.Is(err, repository.ErrPreflightReplayUpstreamStepRunNotSucceeded) {
+			return gen.WorkflowRunUpdateReplayFromStep400JSONResponse(
+				apierrors.NewAPIErrors("Workflow run cannot be replayed from this step because not all of its upstream step runs succeeded."),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	var stepInputBytes []byte
+
+	// make sure the step input can be marshalled and unmarshalled to the step run input type
+	if request.Body.Input != nil {
+		stepInputBytes, err = json.Marshal(request.Body.Input)
+
+		if err != nil {
+			return gen.WorkflowRunUpdateReplayFromStep400JSONResponse(
+				apierrors.NewAPIErrors("Invalid input"),
+			), nil
+		}
+
+		data := &datautils.StepRunData{}
+
+		if err := json.Unmarshal(stepInputBytes, data); err != nil {
+			return gen.WorkflowRunUpdateReplayFromStep400JSONResponse(
+				apierrors.NewAPIErrors("Invalid input"),
+			), nil
+		}
+
+		stepInputBytes, err = json.Marshal(data)
+
+		if err != nil {
+			return gen.WorkflowRunUpdateReplayFromStep400JSONResponse(
+				apierrors.NewAPIErrors("Invalid input"),
+			), nil
+		}
+	}
+
+	workflowVersion, err := t.config.EngineRepository.Workflow().GetWorkflowVersionById(
+		ctx.Request().Context(),
+		tenant.ID,
+		sqlchelpers.UUIDToStr(run.WorkflowVersionId),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get workflow version: %w", err)
+	}
+
+	// the replayed workflow run is triggered with the input and additional metadata of the workflow run it replays
+	input, err := t.config.EngineRepository.WorkflowRun().GetWorkflowRunInputData(tenant.ID, runId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get workflow run input: %w", err)
+	}
+
+	inputBytes, err := json.Marshal(input)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal workflow run input: %w", err)
+	}
+
+	var additionalMetadata map[string]interface{}
+
+	if run.AdditionalMetadata != nil {
+		if err := json.Unmarshal(run.AdditionalMetadata, &additionalMetadata); err != nil {
+			return nil, fmt.Errorf("could not unmarshal additional metadata: %w", err)
+		}
+	}
+
+	createOpts, err := repository.GetCreateWorkflowRunOptsFromManual(workflowVersion, inputBytes, additionalMetadata)
+
+	if err != nil {
+		if apiErrors, ok := apierrors.NewInputValidationAPIErrors(err); ok {
+			return gen.WorkflowRunUpdateReplayFromStep400JSONResponse(apiErrors), nil
+		}
+
+		return nil, err
+	}
+
+	createOpts.ReplayedFromId = &runId
+	createOpts.ReplayedFromStepId = &stepId
+	createOpts.ReplayedFromStepInput = stepInputBytes
+
+	err = t.config.EngineRepository.TenantQuota().CheckQuota(ctx.Request().Context(), tenant.ID, repository.TenantQuotaResourceQueuedRuns, 1)
+
+	if apiErrors, ok := apierrors.NewQuotaExceededAPIErrors(err); ok {
+		return gen.WorkflowRunUpdateReplayFromStep429JSONResponse(apiErrors), nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not check queued runs quota: %w", err)
+	}
+
+	createdWorkflowRun, err := t.config.APIRepository.WorkflowRun().CreateNewWorkflowRun(ctx.Request().Context(), tenant.ID, createOpts)
+
+	if err == metered.ErrResourceExhausted {
+		return gen.WorkflowRunUpdateReplayFromStep429JSONResponse(
+			apierrors.NewAPIErrors("Workflow Run limit exceeded"),
+		), nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create replayed workflow run: %w", err)
+	}
+
+	// send to workflow processing queue
+	err = t.config.MessageQueue.AddMessage(
+		ctx.Request().Context(),
+		msgqueue.WORKFLOW_PROCESSING_QUEUE,
+		tasktypes.WorkflowRunQueuedToTask(
+			sqlchelpers.UUIDToStr(createdWorkflowRun.TenantId),
+			sqlchelpers.UUIDToStr(createdWorkflowRun.ID),
+		),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not add workflow run to queue: %w", err)
+	}
+
+	replayedRun, err := t.config.APIRepository.WorkflowRun().GetWorkflowRunById(ctx.Request().Context(), tenant.ID, sqlchelpers.UUIDToStr(createdWorkflowRun.ID))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get workflow run: %w", err)
+	}
+
+	resp, err := transformers.ToWorkflowRun(replayedRun, nil, nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowRunUpdateReplayFromStep200JSONResponse(*resp), nil
+}
@@ -1,15 +1,18 @@
 package workflowruns
 
 import (
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
 )
 
 type WorkflowRunsService struct {
-	config *server.ServerConfig
+	config       *server.ServerConfig
+	sharedReader *msgqueue.SharedTenantReader
 }
 
 func NewWorkflowRunsService(config *server.ServerConfig) *WorkflowRunsService {
 	return &WorkflowRunsService{
-		config: config,
+		config:       config,
+		sharedReader: msgqueue.NewSharedTenantReader(config.MessageQueue),
 	}
 }
@@ -0,0 +1,366 @@
+package workflowruns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// keepAliveInterval is how often a comment is sent on idle streams, so proxies don't close them.
+const keepAliveInterval = 15 * time.Second
+
+func (t *WorkflowRunsService) WorkflowRunGetStream(ctx echo.Context, request gen.WorkflowRunGetStreamRequestObject) (gen.WorkflowRunGetStreamResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	run := ctx.Get("workflow-run").(*dbsqlc.GetWorkflowRunByIdRow)
+
+	var lastEventId int64
+
+	// browsers send the id of the last event they received when they reconnect
+	if header := ctx.Request().Header.Get("Last-Event-ID"); header != "" {
+		id, err := strconv.ParseInt(header, 10, 64)
+
+		if err != nil || id < 0 {
+			return gen.WorkflowRunGetStream400JSONResponse(
+				apierrors.NewAPIErrors("invalid Last-Event-ID header"),
+			), nil
+		}
+
+		lastEventId = id
+	}
+
+	return &workflowRunStream{
+		t:             t,
+		ctx:           ctx.Request().Context(),
+		tenantId:      tenant.ID,
+		workflowRunId: sqlchelpers.UUIDToStr(run.ID),
+		finished:      repository.IsFinalWorkflowRunStatus(run.Status),
+		lastEventId:   lastEventId,
+	}, nil
+}
+
+// workflowRunStream writes the events of a workflow run as server-sent events. The id of every event is its
+// sequence in the event log of workflow runs, so events which a client missed can be replayed when it reconnects.
+type workflowRunStream struct {
+	t *WorkflowRunsService
+
+	ctx           context.Context
+	tenantId      string
+	workflowRunId string
+	finished      bool
+	lastEventId   int64
+}
+
+func (s *workflowRunStream) VisitWorkflowRunGetStreamResponse(w http.ResponseWriter) error {
+	flusher, ok := w.(http.Flusher)
+
+	if !ok {
+		return fmt.Errorf("response writer does not support streaming")
+	}
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	tasks := make(chan *msgqueue.Message, 100)
+
+	// subscribe before replaying, so no events are lost in between
+	if !s.finished {
+		cleanup, err := s.t.sharedReader.Subscribe(s.tenantId, func(task *msgqueue.Message) error {
+			select {
+			case tasks <- task:
+			case <-ctx.Done():
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return fmt.Errorf("could not subscribe to workflow run events: %w", err)
+		}
+
+		defer func() {
+			if err := cleanup(); err != nil {
+				s.t.config.Logger.Error().Err(err).Msg("could not clean up workflow run event subscription")
+			}
+		}()
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	// send the events which the client missed since the last event it received
+	if s.lastEventId > 0 {
+		hangup, err := s.replay(ctx, w)
+
+		if err != nil || hangup {
+			flusher.Flush()
+			return err
+		}
+	}
+
+	// the workflow run finished before the stream started, so the client is told to stop reconnecting
+	if s.finished {
+		err := s.write(w, 0, &gen.WorkflowRunStreamEvent{
+			WorkflowRunId:  uuid.MustParse(s.workflowRunId),
+			ResourceType:   gen.WorkflowRunStreamResourceTypeWORKFLOWRUN,
+			ResourceId:     s.workflowRunId,
+			EventType:      gen.WorkflowRunStreamEventTypeCOMPLETED,
+			EventTimestamp: time.Now().UTC(),
+			Hangup:         true,
+		})
+
+		flusher.Flush()
+
+		return err
+	}
+
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return err
+			}
+
+			flusher.Flush()
+		case task := <-tasks:
+			e, err := s.toStreamEvent(ctx, task, time.Now().UTC())
+
+			if err != nil {
+				s.t.config.Logger.Error().Err(err).Msgf("could not convert task %s to workflow run event", task.ID)
+				continue
+			} else if e == nil {
+				continue
+			}
+
+			seq, err := tasktypes.AppendWorkflowRunEvent(ctx, s.t.config.EngineRepository.WorkflowRunEventLog(), s.tenantId, task)
+
+			if err != nil {
+				s.t.config.Logger.Error().Err(err).Msg("could not append workflow run event")
+			}
+
+			// the event was already sent during the replay
+			if seq > 0 && seq <= s.lastEventId {
+				continue
+			}
+
+			if err := s.write(w, seq, e); err != nil {
+				return err
+			}
+
+			flusher.Flush()
+
+			if e.Hangup {
+				return nil
+			}
+		}
+	}
+}
+
+// replay writes the events of the event log after the last event id, and returns whether an event hung up.
+func (s *workflowRunStream) replay(ctx context.Context, w http.ResponseWriter) (bool, error) {
+	limit := 1000
+
+	for {
+		entries, err := s.t.config.EngineRepository.WorkflowRunEventLog().ListWorkflowRunEvents(ctx, s.tenantId, &repository.ListWorkflowRunEventLogsOpts{
+			AfterId:        s.lastEventId,
+			WorkflowRunIds: []string{s.workflowRunId},
+			Limit:          &limit,
+		})
+
+		if err != nil {
+			return false, fmt.Errorf("could not list workflow run events: %w", err)
+		}
+
+		for _, entry := range entries {
+			task := &msgqueue.Message{}
+
+			if err := json.Unmarshal(entry.Message, task); err != nil {
+				return false, fmt.Errorf("could not unmarshal workflow run event %d: %w", entry.ID, err)
+			}
+
+			e, err := s.toStreamEvent(ctx, task, entry.CreatedAt.Time)
+
+			if err != nil {
+				return false, err
+			} else if e == nil {
+				continue
+			}
+
+			if err := s.write(w, entry.ID, e); err != nil {
+				return false, err
+			}
+
+			if e.Hangup {
+				return true, nil
+			}
+		}
+
+		if len(entries) < limit {
+			return false, nil
+		}
+	}
+}
+
+// write writes a server-sent event. Events without a sequence are written without an id, so the id which the client
+// reconnects with is left unchanged.
+func (s *workflowRunStream) write(w http.ResponseWriter, seq int64, e *gen.WorkflowRunStreamEvent) error {
+	data, err := json.Marshal(e)
+
+	if err != nil {
+		return fmt.Errorf("could not marshal workflow run event: %w", err)
+	}
+
+	if seq > 0 {
+		if _, err := fmt.Fprintf(w, "id: %d\n", seq); err != nil {
+			return err
+		}
+
+		s.lastEventId = seq
+	}
+
+	_, err = fmt.Fprintf(w, "data: %s\n\n", data)
+
+	return err
+}
+
+// workflowRunStreamPayload contains the fields of the payloads of workflow run events.
+type workflowRunStreamPayload struct {
+	WorkflowRunId  string  `json:"workflow_run_id"`
+	StepRunId      string  `json:"step_run_id"`
+	StepOutputData string  `json:"step_output_data"`
+	Error          string  `json:"error"`
+	StepRetries    *int32  `json:"step_retries,omitempty"`
+	RetryCount     *int32  `json:"retry_count,omitempty"`
+	Message        *string `json:"message,omitempty"`
+}
+
+// toStreamEvent converts a tenant message to an event of the workflow run, in the same way as the dispatcher converts
+// messages for run status subscriptions. It returns nil if the message isn't an event of the workflow run.
+func (s *workflowRunStream) toStreamEvent(ctx context.Context, task *msgqueue.Message, timestamp time.Time) (*gen.WorkflowRunStreamEvent, error) {
+	data, err := json.Marshal(task.Payload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	payload := workflowRunStreamPayload{}
+
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+
+	if payload.WorkflowRunId != s.workflowRunId {
+		return nil, nil
+	}
+
+	e := &gen.WorkflowRunStreamEvent{
+		WorkflowRunId:  uuid.MustParse(s.workflowRunId),
+		ResourceType:   gen.WorkflowRunStreamResourceTypeSTEPRUN,
+		ResourceId:     payload.StepRunId,
+		EventTimestamp: timestamp,
+	}
+
+	if payload.StepRetries != nil {
+		stepRetries := int(*payload.StepRetries)
+		e.StepRetries = &stepRetries
+	}
+
+	if payload.RetryCount != nil {
+		retryCount := int(*payload.RetryCount)
+		e.RetryCount = &retryCount
+	}
+
+	switch task.ID {
+	case "step-run-started":
+		e.EventType = gen.WorkflowRunStreamEventTypeSTARTED
+	case "step-run-finished":
+		e.EventType = gen.WorkflowRunStreamEventTypeCOMPLETED
+		e.EventPayload = &payload.StepOutputData
+	case "step-run-failed":
+		e.EventType = gen.WorkflowRunStreamEventTypeFAILED
+		e.EventPayload = &payload.Error
+	case "step-run-cancelled":
+		e.EventType = gen.WorkflowRunStreamEventTypeCANCELLED
+	case "step-run-timed-out":
+		e.EventType = gen.WorkflowRunStreamEventTypeTIMEDOUT
+	case "step-run-stream-event":
+		e.EventType = gen.WorkflowRunStreamEventTypeSTREAM
+
+		// stream chunks are sent inline instead of being stored as stream events
+		if payload.Message != nil {
+			e.EventPayload = payload.Message
+			break
+		}
+
+		streamEventIdStr, ok := task.Metadata["stream_event_id"].(string)
+
+		if !ok {
+			return nil, fmt.Errorf("stream event task has no stream event id")
+		}
+
+		streamEventId, err := strconv.ParseInt(streamEventIdStr, 10, 64)
+
+		if err != nil {
+			return nil, err
+		}
+
+		streamEvent, err := s.t.config.EngineRepository.StreamEvent().GetStreamEvent(ctx, s.tenantId, streamEventId)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not get stream event: %w", err)
+		}
+
+		message := string(streamEvent.Message)
+		e.EventPayload = &message
+	case "step-run-progress":
+		e.EventType = gen.WorkflowRunStreamEventTypePROGRESS
+
+		progress := tasktypes.StepRunProgressTaskPayload{}
+
+		if err := json.Unmarshal(data, &progress); err != nil {
+			return nil, err
+		}
+
+		eventPayload, err := progress.EventPayload()
+
+		if err != nil {
+			return nil, err
+		}
+
+		e.EventPayload = &eventPayload
+	case "workflow-run-finished":
+		e.ResourceType = gen.WorkflowRunStreamResourceTypeWORKFLOWRUN
+		e.ResourceId = s.workflowRunId
+		e.EventType = gen.WorkflowRunStreamEventTypeCOMPLETED
+		e.Hangup = true
+	default:
+		return nil, nil
+	}
+
+	return e, nil
+}
@@ -0,0 +1,274 @@
+package workflowruns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+type streamEventLogRepository struct {
+	repository.WorkflowRunEventLogRepository
+
+	entries []*dbsqlc.WorkflowRunEventLog
+}
+
+func (r *streamEventLogRepository) ListWorkflowRunEvents(ctx context.Context, tenantId string, opts *repository.ListWorkflowRunEventLogsOpts) ([]*dbsqlc.WorkflowRunEventLog, error) {
+	res := make([]*dbsqlc.WorkflowRunEventLog, 0)
+
+	for _, entry := range r.entries {
+		if entry.ID > opts.AfterId {
+			res = append(res, entry)
+		}
+	}
+
+	return res, nil
+}
+
+type streamEngineRepository struct {
+	repository.EngineRepository
+
+	eventLog *streamEventLogRepository
+}
+
+func (r *streamEngineRepository) WorkflowRunEventLog() repository.WorkflowRunEventLogRepository {
+	return r.eventLog
+}
+
+// sentEvent is a server-sent event which was written to the client
+type sentEvent struct {
+	id string
+	e  *gen.WorkflowRunStreamEvent
+}
+
+func parseSentEvents(t *testing.T, body string) []sentEvent {
+	res := make([]sentEvent, 0)
+
+	for _, chunk := range strings.Split(strings.TrimSpace(body), "\n\n") {
+		sent := sentEvent{}
+
+		for _, line := range strings.Split(chunk, "\n") {
+			switch {
+			case strings.HasPrefix(line, "id: "):
+				sent.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "data: "):
+				sent.e = &gen.WorkflowRunStreamEvent{}
+				require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), sent.e))
+			}
+		}
+
+		res = append(res, sent)
+	}
+
+	return res
+}
+
+func newStreamEventLogEntry(t *testing.T, id int64, taskId string, payload map[string]interface{}) *dbsqlc.WorkflowRunEventLog {
+	message, err := json.Marshal(&msgqueue.Message{
+		ID:      taskId,
+		Payload: payload,
+	})
+
+	require.NoError(t, err)
+
+	return &dbsqlc.WorkflowRunEventLog{
+		ID:        id,
+		Message:   message,
+		CreatedAt: sqlchelpers.TimestampFromTime(time.Now().UTC()),
+	}
+}
+
+func TestWorkflowRunStreamFinishedRun(t *testing.T) {
+	workflowRunId := uuid.New().String()
+	stepRunId := uuid.New().String()
+
+	eventLog := &streamEventLogRepository{
+		entries: []*dbsqlc.WorkflowRunEventLog{
+			newStreamEventLogEntry(t, 1, "step-run-started", map[string]interface{}{"workflow_run_id": workflowRunId, "step_run_id": stepRunId}),
+			newStreamEventLogEntry(t, 2, "step-run-finished", map[string]interface{}{"workflow_run_id": workflowRunId, "step_run_id": stepRunId, "step_output_data": `{"ok":true}`}),
+			newStreamEventLogEntry(t, 3, "workflow-run-finished", map[string]interface{}{"workflow_run_id": workflowRunId}),
+		},
+	}
+
+	svc := &WorkflowRunsService{
+		config: &server.ServerConfig{
+			Config: &database.Config{
+				EngineRepository: &streamEngineRepository{eventLog: eventLog},
+			},
+		},
+	}
+
+	stream := func(lastEventId int64) []sentEvent {
+		w := httptest.NewRecorder()
+
+		s := &workflowRunStream{
+			t:             svc,
+			ctx:           context.Background(),
+			tenantId:      uuid.New().String(),
+			workflowRunId: workflowRunId,
+			finished:      true,
+			lastEventId:   lastEventId,
+		}
+
+		require.NoError(t, s.VisitWorkflowRunGetStreamResponse(w))
+		assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+
+		return parseSentEvents(t, w.Body.String())
+	}
+
+	// the client reconnects after the first event, so it receives the events which it missed until the run finished
+	sent := stream(1)
+	require.Len(t, sent, 2)
+
+	assert.Equal(t, "2", sent[0].id)
+	assert.Equal(t, gen.WorkflowRunStreamEventTypeCOMPLETED, sent[0].e.EventType)
+	assert.Equal(t, gen.WorkflowRunStreamResourceTypeSTEPRUN, sent[0].e.ResourceType)
+	assert.Equal(t, stepRunId, sent[0].e.ResourceId)
+	require.NotNil(t, sent[0].e.EventPayload)
+	assert.Equal(t, `{"ok":true}`, *sent[0].e.EventPayload)
+	assert.False(t, sent[0].e.Hangup)
+
+	assert.Equal(t, "3", sent[1].id)
+	assert.Equal(t, gen.WorkflowRunStreamResourceTypeWORKFLOWRUN, sent[1].e.ResourceType)
+	assert.True(t, sent[1].e.Hangup)
+
+	// a new client of a finished run only receives the event which tells it to stop reconnecting
+	sent = stream(0)
+	require.Len(t, sent, 1)
+
+	assert.Empty(t, sent[0].id)
+	assert.Equal(t, gen.WorkflowRunStreamEventTypeCOMPLETED, sent[0].e.EventType)
+	assert.True(t, sent[0].e.Hangup)
+}
+
+func TestWorkflowRunStreamToStreamEvent(t *testing.T) {
+	workflowRunId := uuid.New().String()
+	stepRunId := uuid.New().String()
+
+	s := &workflowRunStream{workflowRunId: workflowRunId}
+
+	tests := []struct {
+		name    string
+		taskId  string
+		payload map[string]interface{}
+
+		// nil if the task isn't an event of the workflow run
+		expected *gen.WorkflowRunStreamEvent
+	}{
+		{
+			name:    "step run started",
+			taskId:  "step-run-started",
+			payload: map[string]interface{}{"workflow_run_id": workflowRunId, "step_run_id": stepRunId, "retry_count": 1},
+			expected: &gen.WorkflowRunStreamEvent{
+				EventType:    gen.WorkflowRunStreamEventTypeSTARTED,
+				ResourceType: gen.WorkflowRunStreamResourceTypeSTEPRUN,
+				ResourceId:   stepRunId,
+				RetryCount:   intPtr(1),
+			},
+		},
+		{
+			name:    "step run failed",
+			taskId:  "step-run-failed",
+			payload: map[string]interface{}{"workflow_run_id": workflowRunId, "step_run_id": stepRunId, "error": "boom"},
+			expected: &gen.WorkflowRunStreamEvent{
+				EventType:    gen.WorkflowRunStreamEventTypeFAILED,
+				ResourceType: gen.WorkflowRunStreamResourceTypeSTEPRUN,
+				ResourceId:   stepRunId,
+				EventPayload: repository.StringPtr("boom"),
+			},
+		},
+		{
+			name:    "inline stream chunk",
+			taskId:  "step-run-stream-event",
+			payload: map[string]interface{}{"workflow_run_id": workflowRunId, "step_run_id": stepRunId, "message": "chunk"},
+			expected: &gen.WorkflowRunStreamEvent{
+				EventType:    gen.WorkflowRunStreamEventTypeSTREAM,
+				ResourceType: gen.WorkflowRunStreamResourceTypeSTEPRUN,
+				ResourceId:   stepRunId,
+				EventPayload: repository.StringPtr("chunk"),
+			},
+		},
+		{
+			name:    "workflow run finished",
+			taskId:  "workflow-run-finished",
+			payload: map[string]interface{}{"workflow_run_id": workflowRunId},
+			expected: &gen.WorkflowRunStreamEvent{
+				EventType:    gen.WorkflowRunStreamEventTypeCOMPLETED,
+				ResourceType: gen.WorkflowRunStreamResourceTypeWORKFLOWRUN,
+				ResourceId:   workflowRunId,
+				Hangup:       true,
+			},
+		},
+		{
+			name:    "event of another workflow run",
+			taskId:  "step-run-started",
+			payload: map[string]interface{}{"workflow_run_id": uuid.New().String(), "step_run_id": stepRunId},
+		},
+		{
+			name:    "task which isn't a workflow run event",
+			taskId:  "step-run-assigned",
+			payload: map[string]interface{}{"workflow_run_id": workflowRunId, "step_run_id": stepRunId},
+		},
+	}
+
+	timestamp := time.Now().UTC()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := s.toStreamEvent(context.Background(), &msgqueue.Message{ID: tt.taskId, Payload: tt.payload}, timestamp)
+			require.NoError(t, err)
+
+			if tt.expected == nil {
+				assert.Nil(t, e)
+				return
+			}
+
+			tt.expected.WorkflowRunId = uuid.MustParse(workflowRunId)
+			tt.expected.EventTimestamp = timestamp
+
+			assert.Equal(t, tt.expected, e)
+		})
+	}
+}
+
+func TestWorkflowRunGetStreamInvalidLastEventId(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Last-Event-ID", "not a number")
+
+	c := echo.New().NewContext(req, httptest.NewRecorder())
+	c.Set("tenant", &db.TenantModel{
+		InnerTenant: db.InnerTenant{
+			ID: uuid.New().String(),
+		},
+	})
+	c.Set("workflow-run", &dbsqlc.GetWorkflowRunByIdRow{
+		ID:     sqlchelpers.UUIDFromStr(uuid.New().String()),
+		Status: dbsqlc.WorkflowRunStatusRUNNING,
+	})
+
+	res, err := (&WorkflowRunsService{}).WorkflowRunGetStream(c, gen.WorkflowRunGetStreamRequestObject{})
+	require.NoError(t, err)
+
+	assert.IsType(t, gen.WorkflowRunGetStream400JSONResponse{}, res)
+}
+
+func intPtr(i int) *int {
+	return &i
+}
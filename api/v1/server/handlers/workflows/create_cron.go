@@ -20,19 +20,54 @@ func (t *WorkflowService) CronWorkflowTriggerCreate(ctx echo.Context, request ge
 		return gen.CronWorkflowTriggerCreate400JSONResponse(apierrors.NewAPIErrors("cron name is required")), nil
 	}
 
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.CronWorkflowTriggerCreate400JSONResponse(*apiErrors), nil
+	}
+
 	workflow, err := t.config.EngineRepository.Workflow().GetWorkflowByName(ctx.Request().Context(), tenant.ID, request.Workflow)
 
 	if err != nil {
 		return gen.CronWorkflowTriggerCreate400JSONResponse(apierrors.NewAPIErrors("workflow not found")), nil
 	}
 
+	if apiErrors, err := t.validateTriggerInput(ctx, tenant.ID, sqlchelpers.UUIDToStr(workflow.ID), request.Body.Input); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.CronWorkflowTriggerCreate400JSONResponse(*apiErrors), nil
+	}
+
+	var jitterSeconds *int32
+
+	if request.Body.JitterSeconds != nil {
+		j := int32(*request.Body.JitterSeconds) // nolint: gosec
+		jitterSeconds = &j
+	}
+
+	var exclusionCalendarId *string
+
+	if request.Body.ExclusionCalendarId != nil {
+		calendar, err := t.config.EngineRepository.CronCalendar().GetCalendarById(ctx.Request().Context(), request.Body.ExclusionCalendarId.String())
+
+		if err != nil || sqlchelpers.UUIDToStr(calendar.TenantId) != tenant.ID {
+			return gen.CronWorkflowTriggerCreate400JSONResponse(apierrors.NewAPIErrors("exclusion calendar not found")), nil
+		}
+
+		id := sqlchelpers.UUIDToStr(calendar.ID)
+		exclusionCalendarId = &id
+	}
+
 	cronTrigger, err := t.config.APIRepository.Workflow().CreateCronWorkflow(
 		ctx.Request().Context(), tenant.ID, &repository.CreateCronWorkflowTriggerOpts{
-			Name:               request.Body.CronName,
-			Cron:               request.Body.CronExpression,
-			Input:              request.Body.Input,
-			AdditionalMetadata: request.Body.AdditionalMetadata,
-			WorkflowId:         sqlchelpers.UUIDToStr(workflow.ID),
+			Name:                request.Body.CronName,
+			Cron:                request.Body.CronExpression,
+			Timezone:            request.Body.Timezone,
+			JitterSeconds:       jitterSeconds,
+			ExclusionCalendarId: exclusionCalendarId,
+			Input:               request.Body.Input,
+			AdditionalMetadata:  request.Body.AdditionalMetadata,
+			WorkflowId:          sqlchelpers.UUIDToStr(workflow.ID),
 		},
 	)
 
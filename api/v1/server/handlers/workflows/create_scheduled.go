@@ -20,6 +20,12 @@ func (t *WorkflowService) ScheduledWorkflowRunCreate(ctx echo.Context, request g
 		return gen.ScheduledWorkflowRunCreate400JSONResponse(apierrors.NewAPIErrors("workflow not found")), nil
 	}
 
+	if apiErrors, err := t.validateTriggerInput(ctx, tenant.ID, sqlchelpers.UUIDToStr(workflow.ID), request.Body.Input); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.ScheduledWorkflowRunCreate400JSONResponse(*apiErrors), nil
+	}
+
 	scheduled, err := t.config.APIRepository.Workflow().CreateScheduledWorkflow(ctx.Request().Context(), tenant.ID, &repository.CreateScheduledWorkflowRunForWorkflowOpts{
 		ScheduledTrigger:   request.Body.TriggerAt,
 		Input:              request.Body.Input,
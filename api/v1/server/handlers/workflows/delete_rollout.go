@@ -0,0 +1,31 @@
+package workflows
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *WorkflowService) WorkflowDeleteRollout(ctx echo.Context, request gen.WorkflowDeleteRolloutRequestObject) (gen.WorkflowDeleteRolloutResponseObject, error) {
+	workflow := ctx.Get("workflow").(*dbsqlc.GetWorkflowByIdRow)
+
+	err := t.config.APIRepository.Workflow().DeleteWorkflowRollout(ctx.Request().Context(), sqlchelpers.UUIDToStr(workflow.Workflow.ID))
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return gen.WorkflowDeleteRollout404JSONResponse(
+				apierrors.NewAPIErrors("workflow has no rollout"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	return gen.WorkflowDeleteRollout204Response{}, nil
+}
@@ -0,0 +1,34 @@
+package workflows
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *WorkflowService) WorkflowGetRollout(ctx echo.Context, request gen.WorkflowGetRolloutRequestObject) (gen.WorkflowGetRolloutResponseObject, error) {
+	workflow := ctx.Get("workflow").(*dbsqlc.GetWorkflowByIdRow)
+
+	rollout, err := t.config.APIRepository.Workflow().GetWorkflowRollout(ctx.Request().Context(), sqlchelpers.UUIDToStr(workflow.Workflow.ID))
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return gen.WorkflowGetRollout404JSONResponse(
+				apierrors.NewAPIErrors("workflow has no rollout"),
+			), nil
+		}
+
+		return nil, err
+	}
+
+	return gen.WorkflowGetRollout200JSONResponse(
+		*transformers.ToWorkflowRollout(rollout),
+	), nil
+}
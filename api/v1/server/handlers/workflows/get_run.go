@@ -5,6 +5,8 @@ import (
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/runarchive"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 )
@@ -12,8 +14,11 @@ import (
 func (t *WorkflowService) WorkflowRunGet(ctx echo.Context, request gen.WorkflowRunGetRequestObject) (gen.WorkflowRunGetResponseObject, error) {
 	run := ctx.Get("workflow-run").(*dbsqlc.GetWorkflowRunByIdRow)
 
-	jobs, err := t.config.APIRepository.JobRun().ListJobRunByWorkflowRunId(
+	// the details of archived runs are read from the blob storage, which is slower than the database
+	archived, err := runarchive.Load(
 		ctx.Request().Context(),
+		t.config.EngineRepository.WorkflowRunArchive(),
+		t.config.BlobOffloader,
 		sqlchelpers.UUIDToStr(run.TenantId),
 		sqlchelpers.UUIDToStr(run.ID),
 	)
@@ -21,6 +26,27 @@ func (t *WorkflowService) WorkflowRunGet(ctx echo.Context, request gen.WorkflowR
 	if err != nil {
 		return nil, err
 	}
+
+	var jobs []*dbsqlc.ListJobRunsForWorkflowRunFullRow
+
+	if archived != nil {
+		runCp := *run
+		runCp.Output = archived.Output
+		run = &runCp
+
+		jobs = archived.JobRuns
+	} else {
+		jobs, err = t.config.APIRepository.JobRun().ListJobRunByWorkflowRunId(
+			ctx.Request().Context(),
+			sqlchelpers.UUIDToStr(run.TenantId),
+			sqlchelpers.UUIDToStr(run.ID),
+		)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	jobIds := make([]string, len(jobs))
 
 	for i, job := range jobs {
@@ -35,13 +61,19 @@ func (t *WorkflowService) WorkflowRunGet(ctx echo.Context, request gen.WorkflowR
 		return nil, err
 	}
 
-	stepRuns, err := t.config.APIRepository.WorkflowRun().GetStepRunsForJobRuns(
-		ctx.Request().Context(),
-		sqlchelpers.UUIDToStr(run.TenantId),
-		jobIds)
+	var stepRuns []*repository.StepRunForJobRun
 
-	if err != nil {
-		return nil, err
+	if archived != nil {
+		stepRuns = archived.StepRuns
+	} else {
+		stepRuns, err = t.config.APIRepository.WorkflowRun().GetStepRunsForJobRuns(
+			ctx.Request().Context(),
+			sqlchelpers.UUIDToStr(run.TenantId),
+			jobIds)
+
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	resp, err := transformers.ToWorkflowRun(run, jobs, steps, stepRuns)
@@ -50,6 +82,10 @@ func (t *WorkflowService) WorkflowRunGet(ctx echo.Context, request gen.WorkflowR
 		return nil, err
 	}
 
+	if archived != nil {
+		resp.Archived = repository.BoolPtr(true)
+	}
+
 	return gen.WorkflowRunGet200JSONResponse(
 		*resp,
 	), nil
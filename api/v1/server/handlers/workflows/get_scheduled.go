@@ -10,7 +10,7 @@ import (
 )
 
 func (t *WorkflowService) WorkflowScheduledGet(ctx echo.Context, request gen.WorkflowScheduledGetRequestObject) (gen.WorkflowScheduledGetResponseObject, error) {
-	scheduled := ctx.Get("scheduled").(*dbsqlc.ListScheduledWorkflowsRow)
+	scheduled := ctx.Get("scheduled-workflow-run").(*dbsqlc.ListScheduledWorkflowsRow)
 
 	if scheduled == nil {
 		return gen.WorkflowScheduledGet404JSONResponse(apierrors.NewAPIErrors("Scheduled workflow not found.")), nil
@@ -8,6 +8,8 @@ import (
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/internal/runarchive"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 )
@@ -18,8 +20,11 @@ func (t *WorkflowService) WorkflowRunGetShape(ctx echo.Context, request gen.Work
 	reqCtx, cancel := context.WithTimeout(ctx.Request().Context(), 5*time.Second)
 	defer cancel()
 
-	jobRuns, err := t.config.APIRepository.JobRun().ListJobRunByWorkflowRunId(
+	// the details of archived runs are read from the blob storage, which is slower than the database
+	archived, err := runarchive.Load(
 		reqCtx,
+		t.config.EngineRepository.WorkflowRunArchive(),
+		t.config.BlobOffloader,
 		sqlchelpers.UUIDToStr(run.TenantId),
 		sqlchelpers.UUIDToStr(run.ID),
 	)
@@ -28,6 +33,22 @@ func (t *WorkflowService) WorkflowRunGetShape(ctx echo.Context, request gen.Work
 		return nil, err
 	}
 
+	var jobRuns []*dbsqlc.ListJobRunsForWorkflowRunFullRow
+
+	if archived != nil {
+		jobRuns = archived.JobRuns
+	} else {
+		jobRuns, err = t.config.APIRepository.JobRun().ListJobRunByWorkflowRunId(
+			reqCtx,
+			sqlchelpers.UUIDToStr(run.TenantId),
+			sqlchelpers.UUIDToStr(run.ID),
+		)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	workflowVersion, _, _, _, err := t.config.APIRepository.Workflow().GetWorkflowVersionById(
 		sqlchelpers.UUIDToStr(run.TenantId),
 		sqlchelpers.UUIDToStr(run.WorkflowVersionId),
@@ -58,23 +79,35 @@ func (t *WorkflowService) WorkflowRunGetShape(ctx echo.Context, request gen.Work
 
 	// step runs
 
-	stepRuns, err := t.config.APIRepository.WorkflowRun().GetStepRunsForJobRuns(
-		reqCtx,
-		sqlchelpers.UUIDToStr(run.TenantId),
-		jobRunIds,
+	var stepRuns []*repository.StepRunForJobRun
+
+	if archived != nil {
+		stepRuns = archived.StepRuns
+	} else {
+		stepRuns, err = t.config.APIRepository.WorkflowRun().GetStepRunsForJobRuns(
+			reqCtx,
+			sqlchelpers.UUIDToStr(run.TenantId),
+			jobRunIds,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := transformers.ToWorkflowRunShape(
+		run,
+		workflowVersion,
+		jobRuns,
+		steps,
+		stepRuns,
 	)
 
-	if err != nil {
-		return nil, err
+	if archived != nil {
+		resp.Archived = repository.BoolPtr(true)
 	}
 
 	return gen.WorkflowRunGetShape200JSONResponse(
-		*transformers.ToWorkflowRunShape(
-			run,
-			workflowVersion,
-			jobRuns,
-			steps,
-			stepRuns,
-		),
+		*resp,
 	), nil
 }
@@ -0,0 +1,44 @@
+package workflows
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *WorkflowService) WorkflowListConcurrencyGroups(ctx echo.Context, request gen.WorkflowListConcurrencyGroupsRequestObject) (gen.WorkflowListConcurrencyGroupsResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	workflow := ctx.Get("workflow").(*dbsqlc.GetWorkflowByIdRow)
+
+	var limit *int
+
+	if request.Params.Limit != nil {
+		l := int(*request.Params.Limit)
+		limit = &l
+	}
+
+	groups, err := t.config.APIRepository.Workflow().ListWorkflowConcurrencyGroups(
+		ctx.Request().Context(),
+		tenant.ID,
+		sqlchelpers.UUIDToStr(workflow.Workflow.ID),
+		limit,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]gen.WorkflowConcurrencyGroup, len(groups))
+
+	for i, group := range groups {
+		rows[i] = *transformers.ToWorkflowConcurrencyGroup(group)
+	}
+
+	return gen.WorkflowListConcurrencyGroups200JSONResponse(gen.WorkflowConcurrencyGroupList{
+		Rows: &rows,
+	}), nil
+}
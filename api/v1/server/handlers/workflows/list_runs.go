@@ -2,8 +2,9 @@ package workflows
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"math"
+	"net/url"
 	"strings"
 	"time"
 
@@ -16,6 +17,9 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
 )
 
+// metadataQueryParamPrefix is the prefix of the query params which filter workflow runs by a single metadata key
+const metadataQueryParamPrefix = "metadata."
+
 func (t *WorkflowService) WorkflowRunList(ctx echo.Context, request gen.WorkflowRunListRequestObject) (gen.WorkflowRunListResponseObject, error) {
 	tenant := ctx.Get("tenant").(*db.TenantModel)
 
@@ -67,16 +71,34 @@ func (t *WorkflowService) WorkflowRunList(ctx echo.Context, request gen.Workflow
 		listOpts.Offset = &offset
 	}
 
+	if request.Params.Cursor != nil {
+		listOpts.Cursor = request.Params.Cursor
+	}
+
 	if request.Params.WorkflowId != nil {
 		workflowIdStr := request.Params.WorkflowId.String()
 		listOpts.WorkflowId = &workflowIdStr
 	}
 
+	if request.Params.WorkflowIds != nil {
+		workflowIds := make([]string, len(*request.Params.WorkflowIds))
+
+		for i, workflowId := range *request.Params.WorkflowIds {
+			workflowIds[i] = workflowId.String()
+		}
+
+		listOpts.WorkflowIds = workflowIds
+	}
+
 	if request.Params.EventId != nil {
 		eventIdStr := request.Params.EventId.String()
 		listOpts.EventId = &eventIdStr
 	}
 
+	if request.Params.EventKey != nil {
+		listOpts.EventKey = request.Params.EventKey
+	}
+
 	if request.Params.ParentWorkflowRunId != nil {
 		parentWorkflowRunIdStr := request.Params.ParentWorkflowRunId.String()
 		listOpts.ParentId = &parentWorkflowRunIdStr
@@ -97,20 +119,13 @@ func (t *WorkflowService) WorkflowRunList(ctx echo.Context, request gen.Workflow
 		listOpts.Statuses = &statuses
 	}
 
-	if request.Params.AdditionalMetadata != nil {
-		additionalMetadata := make(map[string]interface{}, len(*request.Params.AdditionalMetadata))
-
-		for _, v := range *request.Params.AdditionalMetadata {
-			splitValue := strings.Split(fmt.Sprintf("%v", v), ":")
-
-			if len(splitValue) == 2 {
-				additionalMetadata[splitValue[0]] = splitValue[1]
-			} else {
-				return gen.WorkflowRunList400JSONResponse(apierrors.NewAPIErrors("Additional metadata filters must be in the format key:value.")), nil
+	additionalMetadata, reason := parseMetadataFilters(request.Params.AdditionalMetadata, ctx.QueryParams())
 
-			}
-		}
+	if reason != "" {
+		return gen.WorkflowRunList400JSONResponse(apierrors.NewAPIErrors(reason)), nil
+	}
 
+	if len(additionalMetadata) > 0 {
 		listOpts.AdditionalMetadata = additionalMetadata
 	}
 
@@ -119,6 +134,10 @@ func (t *WorkflowService) WorkflowRunList(ctx echo.Context, request gen.Workflow
 
 	workflowRuns, err := t.config.APIRepository.WorkflowRun().ListWorkflowRuns(dbCtx, tenant.ID, listOpts)
 
+	if errors.Is(err, repository.ErrInvalidWorkflowRunCursor) {
+		return gen.WorkflowRunList400JSONResponse(apierrors.NewAPIErrors("The cursor is invalid, or was returned for a different order.")), nil
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -130,8 +149,18 @@ func (t *WorkflowService) WorkflowRunList(ctx echo.Context, request gen.Workflow
 		rows[i] = *transformers.ToWorkflowRunFromSQLC(workflowCp)
 	}
 
+	// the runs aren't counted when paging with a cursor, so only the next cursor is returned
+	if workflowRuns.Count == nil {
+		return gen.WorkflowRunList200JSONResponse(
+			gen.WorkflowRunList{
+				Rows:       &rows,
+				NextCursor: workflowRuns.NextCursor,
+			},
+		), nil
+	}
+
 	// use the total rows and limit to calculate the total pages
-	totalPages := int64(math.Ceil(float64(workflowRuns.Count) / float64(limit)))
+	totalPages := int64(math.Ceil(float64(*workflowRuns.Count) / float64(limit)))
 	currPage := 1 + int64(math.Ceil(float64(offset)/float64(limit)))
 	nextPage := currPage + 1
 
@@ -141,7 +170,8 @@ func (t *WorkflowService) WorkflowRunList(ctx echo.Context, request gen.Workflow
 
 	return gen.WorkflowRunList200JSONResponse(
 		gen.WorkflowRunList{
-			Rows: &rows,
+			Rows:       &rows,
+			NextCursor: workflowRuns.NextCursor,
 			Pagination: &gen.PaginationResponse{
 				NumPages:    &totalPages,
 				CurrentPage: &currPage,
@@ -150,3 +180,39 @@ func (t *WorkflowService) WorkflowRunList(ctx echo.Context, request gen.Workflow
 		},
 	), nil
 }
+
+// parseMetadataFilters merges the key:value pairs of the additionalMetadata query param and the metadata.key=value
+// query params into a single metadata filter. It returns the reason the filters are invalid, or an empty string if
+// they are valid.
+func parseMetadataFilters(additionalMetadataParams *[]string, queryParams url.Values) (map[string]interface{}, string) {
+	additionalMetadata := make(map[string]interface{})
+
+	if additionalMetadataParams != nil {
+		for _, v := range *additionalMetadataParams {
+			splitValue := strings.Split(v, ":")
+
+			if len(splitValue) != 2 {
+				return nil, "Additional metadata filters must be in the format key:value."
+			}
+
+			additionalMetadata[splitValue[0]] = splitValue[1]
+		}
+	}
+
+	// metadata can also be filtered with a query param per key, for example ?metadata.order_id=123
+	for param, values := range queryParams {
+		key, ok := strings.CutPrefix(param, metadataQueryParamPrefix)
+
+		if !ok {
+			continue
+		}
+
+		if key == "" || len(values) != 1 {
+			return nil, "Metadata filters must be in the format metadata.key=value."
+		}
+
+		additionalMetadata[key] = values[0]
+	}
+
+	return additionalMetadata, ""
+}
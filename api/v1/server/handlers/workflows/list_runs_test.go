@@ -0,0 +1,154 @@
+package workflows
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+)
+
+func TestParseMetadataFilters(t *testing.T) {
+	tests := []struct {
+		name               string
+		additionalMetadata *[]string
+		query              string
+		want               map[string]interface{}
+		wantInvalid        bool
+	}{
+		{
+			name: "no filters",
+			want: map[string]interface{}{},
+		},
+		{
+			name:               "additional metadata param",
+			additionalMetadata: &[]string{"order_id:123", "region:eu"},
+			want:               map[string]interface{}{"order_id": "123", "region": "eu"},
+		},
+		{
+			name:  "metadata query params",
+			query: "metadata.order_id=123&metadata.region=eu&limit=10",
+			want:  map[string]interface{}{"order_id": "123", "region": "eu"},
+		},
+		{
+			name:               "both kinds of filters are merged",
+			additionalMetadata: &[]string{"order_id:123"},
+			query:              "metadata.region=eu",
+			want:               map[string]interface{}{"order_id": "123", "region": "eu"},
+		},
+		{
+			name:               "additional metadata without a value",
+			additionalMetadata: &[]string{"order_id"},
+			wantInvalid:        true,
+		},
+		{
+			name:        "metadata query param without a key",
+			query:       "metadata.=123",
+			wantInvalid: true,
+		},
+		{
+			name:        "metadata query param with multiple values",
+			query:       "metadata.order_id=123&metadata.order_id=456",
+			wantInvalid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := url.ParseQuery(tt.query)
+			assert.NoError(t, err)
+
+			got, reason := parseMetadataFilters(tt.additionalMetadata, query)
+
+			if tt.wantInvalid {
+				assert.NotEmpty(t, reason)
+				assert.Nil(t, got)
+
+				return
+			}
+
+			assert.Empty(t, reason)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// workflowRunAPIRepository counts the runs unless they're listed with a cursor, like the repository
+type workflowRunAPIRepository struct {
+	repository.WorkflowRunAPIRepository
+
+	count int
+}
+
+func (r *workflowRunAPIRepository) ListWorkflowRuns(ctx context.Context, tenantId string, opts *repository.ListWorkflowRunsOpts) (*repository.ListWorkflowRunsResult, error) {
+	res := &repository.ListWorkflowRunsResult{
+		NextCursor: repository.StringPtr("next"),
+	}
+
+	if opts.Cursor == nil {
+		res.Count = &r.count
+	}
+
+	return res, nil
+}
+
+type workflowRunsAPIRepository struct {
+	repository.APIRepository
+
+	workflowRuns *workflowRunAPIRepository
+}
+
+func (r *workflowRunsAPIRepository) WorkflowRun() repository.WorkflowRunAPIRepository {
+	return r.workflowRuns
+}
+
+func TestWorkflowRunListPagination(t *testing.T) {
+	s := NewWorkflowService(&server.ServerConfig{
+		Config: &database.Config{
+			APIRepository: &workflowRunsAPIRepository{
+				workflowRuns: &workflowRunAPIRepository{count: 120},
+			},
+		},
+	})
+
+	list := func(params gen.WorkflowRunListParams) gen.WorkflowRunList {
+		c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+		c.Set("tenant", &db.TenantModel{
+			InnerTenant: db.InnerTenant{
+				ID: uuid.New().String(),
+			},
+		})
+
+		res, err := s.WorkflowRunList(c, gen.WorkflowRunListRequestObject{Params: params})
+		require.NoError(t, err)
+		require.IsType(t, gen.WorkflowRunList200JSONResponse{}, res)
+
+		return gen.WorkflowRunList(res.(gen.WorkflowRunList200JSONResponse))
+	}
+
+	offset := int64(50)
+
+	page := list(gen.WorkflowRunListParams{Offset: &offset})
+
+	require.NotNil(t, page.Pagination)
+	assert.Equal(t, int64(3), *page.Pagination.NumPages)
+	assert.Equal(t, int64(2), *page.Pagination.CurrentPage)
+	assert.Equal(t, "next", *page.NextCursor)
+
+	// the runs aren't counted when paging with a cursor, so there's no pagination rather than empty pagination
+	page = list(gen.WorkflowRunListParams{Offset: &offset, Cursor: page.NextCursor})
+
+	assert.Nil(t, page.Pagination)
+	assert.Equal(t, "next", *page.NextCursor)
+}
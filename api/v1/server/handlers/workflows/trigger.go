@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/labstack/echo/v4"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
@@ -23,10 +25,21 @@ func (t *WorkflowService) WorkflowRunCreate(ctx echo.Context, request gen.Workfl
 	tenant := ctx.Get("tenant").(*db.TenantModel)
 	workflow := ctx.Get("workflow").(*dbsqlc.GetWorkflowByIdRow)
 
-	var workflowVersionId string
+	var workflowVersion *dbsqlc.GetWorkflowVersionForEngineRow
+	var err error
 
 	if request.Params.Version != nil {
-		workflowVersionId = request.Params.Version.String()
+		workflowVersion, err = t.config.EngineRepository.Workflow().GetWorkflowVersionById(ctx.Request().Context(), tenant.ID, request.Params.Version.String())
+
+		if err != nil {
+			if errors.Is(err, db.ErrNotFound) {
+				return gen.WorkflowRunCreate400JSONResponse(
+					apierrors.NewAPIErrors("version not found"),
+				), nil
+			}
+
+			return nil, err
+		}
 	} else {
 
 		if !workflow.WorkflowVersionId.Valid {
@@ -35,19 +48,18 @@ func (t *WorkflowService) WorkflowRunCreate(ctx echo.Context, request gen.Workfl
 			), nil
 		}
 
-		workflowVersionId = sqlchelpers.UUIDToStr(workflow.WorkflowVersionId)
-	}
-
-	workflowVersion, err := t.config.EngineRepository.Workflow().GetWorkflowVersionById(ctx.Request().Context(), tenant.ID, workflowVersionId)
+		// without an explicit version, the run is triggered on the version the workflow's rollout resolves to
+		workflowVersions, err := t.config.EngineRepository.Workflow().GetWorkflowVersionsForTrigger(
+			ctx.Request().Context(),
+			tenant.ID,
+			[]string{sqlchelpers.UUIDToStr(workflow.Workflow.ID)},
+		)
 
-	if err != nil {
-		if errors.Is(err, db.ErrNotFound) {
-			return gen.WorkflowRunCreate400JSONResponse(
-				apierrors.NewAPIErrors("version not found"),
-			), nil
+		if err != nil {
+			return nil, err
 		}
 
-		return nil, err
+		workflowVersion = workflowVersions[0]
 	}
 
 	// make sure input can be marshalled and unmarshalled to input type
@@ -80,11 +92,53 @@ func (t *WorkflowService) WorkflowRunCreate(ctx echo.Context, request gen.Workfl
 
 	createOpts, err := repository.GetCreateWorkflowRunOptsFromManual(workflowVersion, inputBytes, additionalMetadata)
 	if err != nil {
+		if apiErrors, ok := apierrors.NewInputValidationAPIErrors(err); ok {
+			return gen.WorkflowRunCreate400JSONResponse(apiErrors), nil
+		}
+
 		return nil, err
 	}
 
+	err = t.config.EngineRepository.TenantQuota().CheckQuota(ctx.Request().Context(), tenant.ID, repository.TenantQuotaResourceQueuedRuns, 1)
+
+	if apiErrors, ok := apierrors.NewQuotaExceededAPIErrors(err); ok {
+		return gen.WorkflowRunCreate429JSONResponse(apiErrors), nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not check queued runs quota: %w", err)
+	}
+
+	if request.Body.IdempotencyKey != nil {
+		workflowRunId := uuid.New().String()
+
+		existingWorkflowRunId, err := t.config.EngineRepository.WorkflowRun().ClaimIdempotencyKey(
+			ctx.Request().Context(),
+			tenant.ID,
+			sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.ID),
+			*request.Body.IdempotencyKey,
+			workflowRunId,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not claim idempotency key: %w", err)
+		}
+
+		// the workflow was already triggered with this key, so return the existing workflow run
+		if existingWorkflowRunId != nil {
+			return t.getTriggeredWorkflowRun(ctx, tenant.ID, *existingWorkflowRunId)
+		}
+
+		createOpts.Id = &workflowRunId
+	}
+
 	createdWorkflowRun, err := t.config.APIRepository.WorkflowRun().CreateNewWorkflowRun(ctx.Request().Context(), tenant.ID, createOpts)
 
+	if err != nil && createOpts.Id != nil {
+		// release the key so the trigger can be retried
+		t.config.EngineRepository.WorkflowRun().ReleaseIdempotencyKeys(ctx.Request().Context(), tenant.ID, []string{*createOpts.Id}) // nolint: errcheck
+	}
+
 	if err == metered.ErrResourceExhausted {
 		return gen.WorkflowRunCreate429JSONResponse(
 			apierrors.NewAPIErrors("Workflow Run limit exceeded"),
@@ -109,7 +163,11 @@ func (t *WorkflowService) WorkflowRunCreate(ctx echo.Context, request gen.Workfl
 		return nil, fmt.Errorf("could not add workflow run to queue: %w", err)
 	}
 
-	workflowRun, err := t.config.APIRepository.WorkflowRun().GetWorkflowRunById(ctx.Request().Context(), tenant.ID, sqlchelpers.UUIDToStr(createdWorkflowRun.ID))
+	return t.getTriggeredWorkflowRun(ctx, tenant.ID, sqlchelpers.UUIDToStr(createdWorkflowRun.ID))
+}
+
+func (t *WorkflowService) getTriggeredWorkflowRun(ctx echo.Context, tenantId, workflowRunId string) (gen.WorkflowRunCreateResponseObject, error) {
+	workflowRun, err := t.config.APIRepository.WorkflowRun().GetWorkflowRunById(ctx.Request().Context(), tenantId, workflowRunId)
 
 	if err != nil {
 		return nil, fmt.Errorf("could not get workflow run: %w", err)
@@ -125,3 +183,38 @@ func (t *WorkflowService) WorkflowRunCreate(ctx echo.Context, request gen.Workfl
 		*res,
 	), nil
 }
+
+// validateTriggerInput validates the input of a scheduled or cron-triggered run against the input schema of the
+// latest version of the workflow, and returns the API errors if it doesn't match.
+func (t *WorkflowService) validateTriggerInput(ctx echo.Context, tenantId, workflowId string, input map[string]interface{}) (*gen.APIErrors, error) {
+	workflowVersion, err := t.config.EngineRepository.Workflow().GetLatestWorkflowVersion(ctx.Request().Context(), tenantId, workflowId)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("could not get latest workflow version: %w", err)
+	}
+
+	if input == nil {
+		input = map[string]interface{}{}
+	}
+
+	inputBytes, err := json.Marshal(input)
+
+	if err != nil {
+		apiErrors := apierrors.NewAPIErrors("Invalid input")
+		return &apiErrors, nil
+	}
+
+	if err := repository.ValidateWorkflowRunInput(workflowVersion, inputBytes); err != nil {
+		if apiErrors, ok := apierrors.NewInputValidationAPIErrors(err); ok {
+			return &apiErrors, nil
+		}
+
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,59 @@
+package workflows
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *WorkflowService) WorkflowUpdateRollout(ctx echo.Context, request gen.WorkflowUpdateRolloutRequestObject) (gen.WorkflowUpdateRolloutResponseObject, error) {
+	tenant := ctx.Get("tenant").(*db.TenantModel)
+	workflow := ctx.Get("workflow").(*dbsqlc.GetWorkflowByIdRow)
+
+	if apiErrors, err := t.config.Validator.ValidateAPI(request.Body); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return gen.WorkflowUpdateRollout400JSONResponse(*apiErrors), nil
+	}
+
+	stableVersionId := request.Body.StableVersionId.String()
+
+	// the stable version must be a version of this workflow which hasn't been deleted
+	stableVersion, _, _, _, err := t.config.APIRepository.Workflow().GetWorkflowVersionById(tenant.ID, stableVersionId)
+
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	if err != nil || stableVersion.WorkflowVersion.WorkflowId != workflow.Workflow.ID || stableVersion.WorkflowVersion.DeletedAt.Valid {
+		return gen.WorkflowUpdateRollout400JSONResponse(
+			apierrors.NewAPIErrors("stable version is not a version of the workflow"),
+		), nil
+	}
+
+	rollout, err := t.config.APIRepository.Workflow().UpsertWorkflowRollout(
+		ctx.Request().Context(),
+		sqlchelpers.UUIDToStr(workflow.Workflow.ID),
+		&repository.UpsertWorkflowRolloutOpts{
+			StableVersionId:  stableVersionId,
+			CanaryPercentage: request.Body.CanaryPercentage,
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.WorkflowUpdateRollout200JSONResponse(
+		*transformers.ToWorkflowRollout(rollout),
+	), nil
+}
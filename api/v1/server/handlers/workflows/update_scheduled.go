@@ -0,0 +1,56 @@
+package workflows
+
+import (
+	"context"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/apierrors"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/transformers"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *WorkflowService) WorkflowScheduledUpdate(ctx echo.Context, request gen.WorkflowScheduledUpdateRequestObject) (gen.WorkflowScheduledUpdateResponseObject, error) {
+	scheduled := ctx.Get("scheduled-workflow-run").(*dbsqlc.ListScheduledWorkflowsRow)
+
+	if scheduled.WorkflowRunId.Valid {
+		return gen.WorkflowScheduledUpdate400JSONResponse(
+			apierrors.NewAPIErrors("scheduled workflow run has already been triggered"),
+		), nil
+	}
+
+	if !request.Body.TriggerAt.After(time.Now()) {
+		return gen.WorkflowScheduledUpdate400JSONResponse(
+			apierrors.NewAPIErrors("triggerAt must be in the future"),
+		), nil
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx.Request().Context(), 30*time.Second)
+	defer cancel()
+
+	tenantId := sqlchelpers.UUIDToStr(scheduled.TenantId)
+	scheduledWorkflowId := sqlchelpers.UUIDToStr(scheduled.ID)
+
+	err := t.config.APIRepository.WorkflowRun().UpdateScheduledWorkflow(dbCtx, tenantId, scheduledWorkflowId, request.Body.TriggerAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := t.config.APIRepository.WorkflowRun().GetScheduledWorkflow(dbCtx, tenantId, scheduledWorkflowId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if updated == nil {
+		return gen.WorkflowScheduledUpdate404JSONResponse(apierrors.NewAPIErrors("Scheduled workflow not found.")), nil
+	}
+
+	return gen.WorkflowScheduledUpdate200JSONResponse(
+		*transformers.ToScheduledWorkflowsFromSQLC(updated),
+	), nil
+}
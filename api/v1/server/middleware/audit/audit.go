@@ -0,0 +1,170 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// the operations which ingest events are called by applications rather than by users, and are high volume, so they
+// aren't audited
+var skippedOperations = map[string]bool{
+	"EventCreate":           true,
+	"EventCreateBatch":      true,
+	"EventCreateBulk":       true,
+	"EventCreateCloudEvent": true,
+}
+
+// SetTenant sets the tenant which a call to an operation outside of a tenant is audited in, like accepting an invite.
+func SetTenant(c echo.Context, tenantId string) {
+	c.Set("audit-tenant-id", tenantId)
+}
+
+// Audit appends an entry to the audit log of the tenant for every mutating API call, after the call completes. Failures
+// to write the entry are logged and don't fail the call.
+type Audit struct {
+	config *server.ServerConfig
+}
+
+func NewAudit(config *server.ServerConfig) *Audit {
+	return &Audit{
+		config: config,
+	}
+}
+
+func (a *Audit) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		err := next(c)
+
+		switch c.Request().Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+			a.record(c, err)
+		}
+
+		return err
+	}
+}
+
+func (a *Audit) record(c echo.Context, handlerErr error) {
+	routeInfo, ok := c.Get("route-info").(*middleware.RouteInfo)
+
+	if !ok || skippedOperations[routeInfo.OperationID] {
+		return
+	}
+
+	var tenantId string
+
+	if tenant, ok := c.Get("tenant").(*db.TenantModel); ok {
+		tenantId = tenant.ID
+	} else if id, ok := c.Get("audit-tenant-id").(string); ok {
+		tenantId = id
+	} else {
+		return
+	}
+
+	// the entry is written after the response, so it uses its own context in case the request was cancelled
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	opts, ok := a.actor(ctx, c)
+
+	if !ok {
+		return
+	}
+
+	req := c.Request()
+
+	opts.Operation = routeInfo.OperationID
+	opts.Method = req.Method
+	opts.Path = req.URL.Path
+	opts.StatusCode = int32(statusCode(c, handlerErr))
+
+	// the last resource of the path is the resource which the call operated on
+	for i := len(routeInfo.Resources) - 1; i >= 0; i-- {
+		resourceType := routeInfo.Resources[i]
+
+		if resourceId := c.Param(resourceType); resourceId != "" {
+			opts.ResourceType = &resourceType
+			opts.ResourceId = &resourceId
+			break
+		}
+	}
+
+	if ip := c.RealIP(); ip != "" {
+		opts.IpAddress = &ip
+	}
+
+	if userAgent := req.UserAgent(); userAgent != "" {
+		opts.UserAgent = &userAgent
+	}
+
+	entry, err := a.config.EngineRepository.AuditLog().CreateAuditLog(ctx, tenantId, opts)
+
+	if err != nil {
+		a.config.Logger.Error().Err(err).Msgf("could not write audit log for %s", routeInfo.OperationID)
+		return
+	}
+
+	err = a.config.MessageQueue.AddMessage(ctx, msgqueue.TenantEventConsumerQueue(tenantId), tasktypes.AuditLogCreatedToTask(entry))
+
+	if err != nil {
+		a.config.Logger.Error().Err(err).Msg("could not add audit log created message to queue")
+	}
+}
+
+// actor returns the user or API token which called the API, it returns false for unauthenticated calls.
+func (a *Audit) actor(ctx context.Context, c echo.Context) (*repository.CreateAuditLogOpts, bool) {
+	if user, ok := c.Get("user").(*db.UserModel); ok {
+		return &repository.CreateAuditLogOpts{
+			ActorKind: dbsqlc.AuditLogActorKindUSER,
+			ActorId:   user.ID,
+			ActorName: &user.Email,
+		}, true
+	}
+
+	tokenId, ok := c.Get("api-token-id").(string)
+
+	if !ok {
+		return nil, false
+	}
+
+	opts := &repository.CreateAuditLogOpts{
+		ActorKind: dbsqlc.AuditLogActorKindAPITOKEN,
+		ActorId:   tokenId,
+	}
+
+	token, err := a.config.EngineRepository.APIToken().GetAPITokenById(ctx, tokenId)
+
+	if err != nil {
+		a.config.Logger.Warn().Err(err).Msgf("could not get api token %s for audit log", tokenId)
+	} else if token.Name.Valid {
+		opts.ActorName = &token.Name.String
+	}
+
+	return opts, true
+}
+
+func statusCode(c echo.Context, err error) int {
+	if err == nil {
+		return c.Response().Status
+	}
+
+	var httpErr *echo.HTTPError
+
+	if errors.As(err, &httpErr) {
+		return httpErr.Code
+	}
+
+	return http.StatusInternalServerError
+}
@@ -176,6 +176,9 @@ func (m *MiddlewareHandler) Middleware() (echo.MiddlewareFunc, error) {
 				m.cache.Add(getCacheKey(req), routeInfo)
 			}
 
+			// the route info is read by echo middlewares which run around the handler, like the audit log
+			c.Set("route-info", routeInfo)
+
 			for _, middlewareFunc := range m.mws {
 				if err := middlewareFunc(routeInfo)(c); err != nil {
 					// in the case of a redirect, we don't want to return an error but we want to stop the
@@ -0,0 +1,32 @@
+package staleness
+
+import (
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// Header is the response header which is set if some of the data of the response was read from the read replica. Its
+// value is how far the replica lagged behind the primary in milliseconds, so the data can be that much out of date.
+const Header = "X-Hatchet-Data-Staleness-Ms"
+
+// Middleware records the reads of the request which are served by the read replica, and annotates the response with
+// the staleness of the data.
+func Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx, staleness := repository.WithReadStaleness(c.Request().Context())
+
+		c.SetRequest(c.Request().WithContext(ctx))
+
+		// the headers are set just before they're written, once the handler has made its reads
+		c.Response().Before(func() {
+			if lag, ok := staleness.Lag(); ok {
+				c.Response().Header().Set(Header, strconv.FormatInt(lag.Milliseconds(), 10))
+			}
+		})
+
+		return next(c)
+	}
+}
@@ -0,0 +1,60 @@
+package staleness
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name     string
+		reads    []time.Duration
+		expected string
+	}{
+		{
+			name: "reads from the primary",
+		},
+		{
+			name:     "reads from the replica",
+			reads:    []time.Duration{250 * time.Millisecond},
+			expected: "250",
+		},
+		{
+			// the response is as stale as its most stale read
+			name:     "multiple reads from the replica",
+			reads:    []time.Duration{250 * time.Millisecond, 2 * time.Second, 0},
+			expected: "2000",
+		},
+		{
+			name:     "reads from a replica without lag",
+			reads:    []time.Duration{0},
+			expected: "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+
+			err := Middleware(func(c echo.Context) error {
+				for _, lag := range tt.reads {
+					repository.RecordReplicaRead(c.Request().Context(), lag)
+				}
+
+				return c.NoContent(http.StatusOK)
+			})(c)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rec.Header().Get(Header))
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package tracing
+
+import (
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/propagation"
+
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+)
+
+// Middleware continues the trace of the caller, which is passed as a W3C trace context in the traceparent and
+// tracestate headers of the request, so workflow runs which are triggered by the request are part of it.
+func Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+
+		ctx := telemetry.ExtractTraceContext(req.Context(), propagation.HeaderCarrier(req.Header))
+
+		c.SetRequest(req.WithContext(ctx))
+
+		return next(c)
+	}
+}
@@ -0,0 +1,33 @@
+package tracing
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMiddleware(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.Header.Set("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+
+	c := echo.New().NewContext(req, httptest.NewRecorder())
+
+	var spanContext trace.SpanContext
+
+	err := Middleware(func(c echo.Context) error {
+		spanContext = trace.SpanContextFromContext(c.Request().Context())
+		return nil
+	})(c)
+
+	require.NoError(t, err)
+
+	// the handler continues the trace of the caller
+	assert.True(t, spanContext.IsRemote())
+	assert.Equal(t, "0af7651916cd43dd8448eb211c80319c", spanContext.TraceID().String())
+	assert.Equal(t, "b7ad6b7169203331", spanContext.SpanID().String())
+}
@@ -1,6 +1,11 @@
 package apierrors
 
-import "github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+import (
+	"errors"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
 
 func NewAPIErrors(description string, field ...string) gen.APIErrors {
 	apiError := gen.APIError{
@@ -13,3 +18,44 @@ func NewAPIErrors(description string, field ...string) gen.APIErrors {
 		Errors: []gen.APIError{apiError},
 	}
 }
+
+// NewInputValidationAPIErrors returns an API error for each violation of the input schema of a workflow, with the
+// path of the invalid value as the field. It returns false if err isn't caused by an invalid workflow run input.
+func NewInputValidationAPIErrors(err error) (gen.APIErrors, bool) {
+	var target *repository.WorkflowRunInputValidationError
+
+	if !errors.As(err, &target) {
+		return gen.APIErrors{}, false
+	}
+
+	apiErrors := make([]gen.APIError, 0, len(target.Errors))
+
+	for _, e := range target.Errors {
+		apiError := gen.APIError{
+			Description: e.Message,
+		}
+
+		if e.Path != "" {
+			path := e.Path
+			apiError.Field = &path
+		}
+
+		apiErrors = append(apiErrors, apiError)
+	}
+
+	return gen.APIErrors{
+		Errors: apiErrors,
+	}, true
+}
+
+// NewQuotaExceededAPIErrors returns an API error which describes the quota of the tenant which was exceeded. It returns
+// false if err isn't caused by an exceeded quota.
+func NewQuotaExceededAPIErrors(err error) (gen.APIErrors, bool) {
+	var target repository.ErrQuotaExceeded
+
+	if !errors.As(err, &target) {
+		return gen.APIErrors{}, false
+	}
+
+	return NewAPIErrors(target.Error()), true
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -26,6 +27,19 @@ const (
 	CookieAuthScopes = "cookieAuth.Scopes"
 )
 
+// Defines values for APITokenScope.
+const (
+	APITokenScopeADMIN    APITokenScope = "ADMIN"
+	APITokenScopeREADONLY APITokenScope = "READ_ONLY"
+	APITokenScopeWORKER   APITokenScope = "WORKER"
+)
+
+// Defines values for AuditLogActorKind.
+const (
+	AuditLogActorKindAPITOKEN AuditLogActorKind = "API_TOKEN"
+	AuditLogActorKindUSER     AuditLogActorKind = "USER"
+)
+
 // Defines values for CronWorkflowsMethod.
 const (
 	CronWorkflowsMethodAPI     CronWorkflowsMethod = "API"
@@ -49,6 +63,42 @@ const (
 	EventOrderByFieldCreatedAt EventOrderByField = "createdAt"
 )
 
+// Defines values for EventSinkFormat.
+const (
+	EventSinkFormatCLOUDEVENTS EventSinkFormat = "CLOUDEVENTS"
+	EventSinkFormatHATCHET     EventSinkFormat = "HATCHET"
+)
+
+// Defines values for EventSinkKind.
+const (
+	EventSinkKindKAFKA   EventSinkKind = "KAFKA"
+	EventSinkKindNATS    EventSinkKind = "NATS"
+	EventSinkKindWEBHOOK EventSinkKind = "WEBHOOK"
+)
+
+// Defines values for EventSinkRecordType.
+const (
+	EventSinkRecordTypeAuditLogCreated      EventSinkRecordType = "audit_log.created"
+	EventSinkRecordTypeEventCreated         EventSinkRecordType = "event.created"
+	EventSinkRecordTypeStepRunCancelled     EventSinkRecordType = "step_run.cancelled"
+	EventSinkRecordTypeStepRunCompleted     EventSinkRecordType = "step_run.completed"
+	EventSinkRecordTypeStepRunFailed        EventSinkRecordType = "step_run.failed"
+	EventSinkRecordTypeStepRunStarted       EventSinkRecordType = "step_run.started"
+	EventSinkRecordTypeStepRunTimedOut      EventSinkRecordType = "step_run.timed_out"
+	EventSinkRecordTypeWorkflowRunCancelled EventSinkRecordType = "workflow_run.cancelled"
+	EventSinkRecordTypeWorkflowRunFailed    EventSinkRecordType = "workflow_run.failed"
+	EventSinkRecordTypeWorkflowRunQueued    EventSinkRecordType = "workflow_run.queued"
+	EventSinkRecordTypeWorkflowRunSucceeded EventSinkRecordType = "workflow_run.succeeded"
+)
+
+// Defines values for InboundWebhookSignatureScheme.
+const (
+	InboundWebhookSignatureSchemeGITHUB     InboundWebhookSignatureScheme = "GITHUB"
+	InboundWebhookSignatureSchemeHMACSHA256 InboundWebhookSignatureScheme = "HMAC_SHA256"
+	InboundWebhookSignatureSchemeSTRIPE     InboundWebhookSignatureScheme = "STRIPE"
+	InboundWebhookSignatureSchemeSVIX       InboundWebhookSignatureScheme = "SVIX"
+)
+
 // Defines values for JobRunStatus.
 const (
 	JobRunStatusCANCELLED JobRunStatus = "CANCELLED"
@@ -113,6 +163,21 @@ const (
 	ScheduledWorkflowsOrderByFieldTriggerAt ScheduledWorkflowsOrderByField = "triggerAt"
 )
 
+// Defines values for SchedulingDecisionOutcome.
+const (
+	SchedulingDecisionOutcomeASSIGNED    SchedulingDecisionOutcome = "ASSIGNED"
+	SchedulingDecisionOutcomeNOSLOTS     SchedulingDecisionOutcome = "NO_SLOTS"
+	SchedulingDecisionOutcomeRATELIMITED SchedulingDecisionOutcome = "RATE_LIMITED"
+)
+
+// Defines values for StepRunApprovalStatus.
+const (
+	StepRunApprovalStatusAPPROVED StepRunApprovalStatus = "APPROVED"
+	StepRunApprovalStatusPENDING  StepRunApprovalStatus = "PENDING"
+	StepRunApprovalStatusREJECTED StepRunApprovalStatus = "REJECTED"
+	StepRunApprovalStatusTIMEDOUT StepRunApprovalStatus = "TIMED_OUT"
+)
+
 // Defines values for StepRunEventReason.
 const (
 	StepRunEventReasonACKNOWLEDGED                 StepRunEventReason = "ACKNOWLEDGED"
@@ -127,6 +192,7 @@ const (
 	StepRunEventReasonRETRYING                     StepRunEventReason = "RETRYING"
 	StepRunEventReasonSCHEDULINGTIMEDOUT           StepRunEventReason = "SCHEDULING_TIMED_OUT"
 	StepRunEventReasonSLOTRELEASED                 StepRunEventReason = "SLOT_RELEASED"
+	StepRunEventReasonSPECULATIVEATTEMPT           StepRunEventReason = "SPECULATIVE_ATTEMPT"
 	StepRunEventReasonSTARTED                      StepRunEventReason = "STARTED"
 	StepRunEventReasonTIMEDOUT                     StepRunEventReason = "TIMED_OUT"
 	StepRunEventReasonTIMEOUTREFRESHED             StepRunEventReason = "TIMEOUT_REFRESHED"
@@ -150,30 +216,39 @@ const (
 	StepRunStatusPENDING           StepRunStatus = "PENDING"
 	StepRunStatusPENDINGASSIGNMENT StepRunStatus = "PENDING_ASSIGNMENT"
 	StepRunStatusRUNNING           StepRunStatus = "RUNNING"
+	StepRunStatusSKIPPED           StepRunStatus = "SKIPPED"
 	StepRunStatusSUCCEEDED         StepRunStatus = "SUCCEEDED"
 )
 
 // Defines values for TenantMemberRole.
 const (
-	ADMIN  TenantMemberRole = "ADMIN"
-	MEMBER TenantMemberRole = "MEMBER"
-	OWNER  TenantMemberRole = "OWNER"
+	TenantMemberRoleADMIN  TenantMemberRole = "ADMIN"
+	TenantMemberRoleMEMBER TenantMemberRole = "MEMBER"
+	TenantMemberRoleOWNER  TenantMemberRole = "OWNER"
+)
+
+// Defines values for TenantQuotaResource.
+const (
+	TenantQuotaResourceEVENTSPERMINUTE  TenantQuotaResource = "EVENTS_PER_MINUTE"
+	TenantQuotaResourceQUEUEDRUNS       TenantQuotaResource = "QUEUED_RUNS"
+	TenantQuotaResourceWORKERS          TenantQuotaResource = "WORKERS"
+	TenantQuotaResourceWORKFLOWVERSIONS TenantQuotaResource = "WORKFLOW_VERSIONS"
 )
 
 // Defines values for TenantResource.
 const (
-	CRON        TenantResource = "CRON"
-	EVENT       TenantResource = "EVENT"
-	SCHEDULE    TenantResource = "SCHEDULE"
-	WORKER      TenantResource = "WORKER"
-	WORKFLOWRUN TenantResource = "WORKFLOW_RUN"
+	TenantResourceCRON        TenantResource = "CRON"
+	TenantResourceEVENT       TenantResource = "EVENT"
+	TenantResourceSCHEDULE    TenantResource = "SCHEDULE"
+	TenantResourceWORKER      TenantResource = "WORKER"
+	TenantResourceWORKFLOWRUN TenantResource = "WORKFLOW_RUN"
 )
 
 // Defines values for WorkerStatus.
 const (
-	ACTIVE   WorkerStatus = "ACTIVE"
-	INACTIVE WorkerStatus = "INACTIVE"
-	PAUSED   WorkerStatus = "PAUSED"
+	WorkerStatusACTIVE   WorkerStatus = "ACTIVE"
+	WorkerStatusINACTIVE WorkerStatus = "INACTIVE"
+	WorkerStatusPAUSED   WorkerStatus = "PAUSED"
 )
 
 // Defines values for WorkerType.
@@ -196,6 +271,7 @@ const (
 	DROPNEWEST       WorkflowConcurrencyLimitStrategy = "DROP_NEWEST"
 	GROUPROUNDROBIN  WorkflowConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
 	QUEUENEWEST      WorkflowConcurrencyLimitStrategy = "QUEUE_NEWEST"
+	QUEUENEWESTONLY  WorkflowConcurrencyLimitStrategy = "QUEUE_NEWEST_ONLY"
 )
 
 // Defines values for WorkflowKind.
@@ -223,12 +299,30 @@ const (
 const (
 	CANCELLED WorkflowRunStatus = "CANCELLED"
 	FAILED    WorkflowRunStatus = "FAILED"
+	PAUSED    WorkflowRunStatus = "PAUSED"
 	PENDING   WorkflowRunStatus = "PENDING"
 	QUEUED    WorkflowRunStatus = "QUEUED"
 	RUNNING   WorkflowRunStatus = "RUNNING"
 	SUCCEEDED WorkflowRunStatus = "SUCCEEDED"
 )
 
+// Defines values for WorkflowRunStreamEventType.
+const (
+	WorkflowRunStreamEventTypeCANCELLED WorkflowRunStreamEventType = "CANCELLED"
+	WorkflowRunStreamEventTypeCOMPLETED WorkflowRunStreamEventType = "COMPLETED"
+	WorkflowRunStreamEventTypeFAILED    WorkflowRunStreamEventType = "FAILED"
+	WorkflowRunStreamEventTypePROGRESS  WorkflowRunStreamEventType = "PROGRESS"
+	WorkflowRunStreamEventTypeSTARTED   WorkflowRunStreamEventType = "STARTED"
+	WorkflowRunStreamEventTypeSTREAM    WorkflowRunStreamEventType = "STREAM"
+	WorkflowRunStreamEventTypeTIMEDOUT  WorkflowRunStreamEventType = "TIMED_OUT"
+)
+
+// Defines values for WorkflowRunStreamResourceType.
+const (
+	WorkflowRunStreamResourceTypeSTEPRUN     WorkflowRunStreamResourceType = "STEP_RUN"
+	WorkflowRunStreamResourceTypeWORKFLOWRUN WorkflowRunStreamResourceType = "WORKFLOW_RUN"
+)
+
 // APIError defines model for APIError.
 type APIError struct {
 	// Code a custom Hatchet error code
@@ -271,6 +365,9 @@ type APIMeta struct {
 
 // APIMetaAuth defines model for APIMetaAuth.
 type APIMetaAuth struct {
+	// OidcProviderName the name of the single sign-on provider, if the oidc scheme is supported
+	OidcProviderName *string `json:"oidcProviderName,omitempty"`
+
 	// Schemes the supported types of authentication
 	Schemes *[]string `json:"schemes,omitempty"`
 }
@@ -313,13 +410,92 @@ type APIToken struct {
 
 	// Name The name of the API token.
 	Name string `json:"name"`
+
+	// RotatedAt When the API token was rotated, the token stays valid until it expires.
+	RotatedAt *time.Time    `json:"rotatedAt,omitempty"`
+	Scope     APITokenScope `json:"scope"`
 }
 
+// APITokenScope What the API token can be used for. Admin tokens can call the REST API and the engine, worker tokens can only call the engine and read-only tokens can only read from the REST API.
+type APITokenScope string
+
 // AcceptInviteRequest defines model for AcceptInviteRequest.
 type AcceptInviteRequest struct {
 	Invite string `json:"invite" validate:"required,uuid"`
 }
 
+// AuditLog defines model for AuditLog.
+type AuditLog struct {
+	// ActorId The id of the user or API token which called the API.
+	ActorId   openapi_types.UUID `json:"actorId"`
+	ActorKind AuditLogActorKind  `json:"actorKind"`
+
+	// ActorName The email of the user or the name of the API token when the API was called.
+	ActorName *string `json:"actorName,omitempty"`
+
+	// IpAddress The IP address of the client.
+	IpAddress *string         `json:"ipAddress,omitempty"`
+	Metadata  APIResourceMeta `json:"metadata"`
+
+	// Method The HTTP method of the request.
+	Method string `json:"method"`
+
+	// Operation The operation id of the API call.
+	Operation string `json:"operation"`
+
+	// Path The path of the request.
+	Path string `json:"path"`
+
+	// ResourceId The id of the resource of the request path which the call operated on.
+	ResourceId *string `json:"resourceId,omitempty"`
+
+	// ResourceType The type of the resource of the request path which the call operated on, like workflow or step-run.
+	ResourceType *string `json:"resourceType,omitempty"`
+
+	// StatusCode The status code of the response.
+	StatusCode int `json:"statusCode"`
+
+	// UserAgent The user agent of the client.
+	UserAgent *string `json:"userAgent,omitempty"`
+}
+
+// AuditLogActorKind defines model for AuditLogActorKind.
+type AuditLogActorKind string
+
+// AuditLogList defines model for AuditLogList.
+type AuditLogList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]AuditLog         `json:"rows,omitempty"`
+}
+
+// BatchCreateEventRequest defines model for BatchCreateEventRequest.
+type BatchCreateEventRequest struct {
+	// Events The events to create, at most 1000 events can be created in a single request.
+	Events []CreateEventRequest `json:"events" validate:"required,min=1,max=1000"`
+}
+
+// BatchCreateEventResponse defines model for BatchCreateEventResponse.
+type BatchCreateEventResponse struct {
+	// Failed The number of events which failed.
+	Failed int `json:"failed"`
+
+	// Results The results of the events, in the order of the request.
+	Results []BatchCreateEventResult `json:"results"`
+
+	// Succeeded The number of events which were created.
+	Succeeded int `json:"succeeded"`
+}
+
+// BatchCreateEventResult defines model for BatchCreateEventResult.
+type BatchCreateEventResult struct {
+	// Error The reason the event wasn't created, if it failed.
+	Error *string `json:"error,omitempty"`
+	Event *Event  `json:"event,omitempty"`
+
+	// Index The index of the event in the request.
+	Index int `json:"index"`
+}
+
 // BulkCreateEventRequest defines model for BulkCreateEventRequest.
 type BulkCreateEventRequest struct {
 	Events []CreateEventRequest `json:"events"`
@@ -337,13 +513,35 @@ type CancelEventRequest struct {
 	EventIds []openapi_types.UUID `json:"eventIds"`
 }
 
+// ClientCA defines model for ClientCA.
+type ClientCA struct {
+	// Certificate The PEM encoded CA certificate which signs the client certificates of the workers.
+	Certificate string `json:"certificate"`
+
+	// ExpiresAt When the CA certificate expires, client certificates aren't accepted after this time.
+	ExpiresAt time.Time       `json:"expiresAt"`
+	Metadata  APIResourceMeta `json:"metadata"`
+
+	// Name The name of the client CA.
+	Name string `json:"name"`
+
+	// SpiffeIdPrefix The prefix of the SPIFFE IDs of the client certificates which are verified against this CA, like spiffe://example.org/workers/.
+	SpiffeIdPrefix string `json:"spiffeIdPrefix"`
+}
+
+// ClientCAList defines model for ClientCAList.
+type ClientCAList struct {
+	Rows *[]ClientCA `json:"rows,omitempty"`
+}
+
 // CreateAPITokenRequest defines model for CreateAPITokenRequest.
 type CreateAPITokenRequest struct {
 	// ExpiresIn The duration for which the token is valid.
 	ExpiresIn *string `json:"expiresIn,omitempty" validate:"omitnil,duration"`
 
 	// Name A name for the API token.
-	Name string `json:"name"`
+	Name  string         `json:"name"`
+	Scope *APITokenScope `json:"scope,omitempty"`
 }
 
 // CreateAPITokenResponse defines model for CreateAPITokenResponse.
@@ -352,12 +550,45 @@ type CreateAPITokenResponse struct {
 	Token string `json:"token"`
 }
 
+// CreateClientCARequest defines model for CreateClientCARequest.
+type CreateClientCARequest struct {
+	// Certificate The PEM encoded CA certificate which signs the client certificates of the workers.
+	Certificate string `json:"certificate" validate:"required"`
+
+	// Name A name for the client CA.
+	Name string `json:"name" validate:"required,hatchetName"`
+
+	// SpiffeIdPrefix The prefix of the SPIFFE IDs of the client certificates which are verified against this CA. Prefixes can't overlap with the prefixes of the client CAs of other tenants.
+	SpiffeIdPrefix string `json:"spiffeIdPrefix" validate:"required,startswith=spiffe://"`
+}
+
+// CreateCronExclusionCalendarRequest defines model for CreateCronExclusionCalendarRequest.
+type CreateCronExclusionCalendarRequest struct {
+	// Dates The dates on which crons which reference the calendar don't trigger.
+	Dates *[]openapi_types.Date `json:"dates,omitempty"`
+
+	// IcalUrl An iCal feed whose events are excluded, in addition to the dates.
+	IcalUrl *string `json:"icalUrl,omitempty" validate:"omitnil,url"`
+
+	// Name The name of the calendar, which is unique within the tenant.
+	Name string `json:"name" validate:"required,hatchetName"`
+}
+
 // CreateCronWorkflowTriggerRequest defines model for CreateCronWorkflowTriggerRequest.
 type CreateCronWorkflowTriggerRequest struct {
 	AdditionalMetadata map[string]interface{} `json:"additionalMetadata"`
 	CronExpression     string                 `json:"cronExpression"`
 	CronName           string                 `json:"cronName"`
-	Input              map[string]interface{} `json:"input"`
+
+	// ExclusionCalendarId The ID of an exclusion calendar whose dates the cron skips, for example holidays
+	ExclusionCalendarId *openapi_types.UUID    `json:"exclusionCalendarId,omitempty"`
+	Input               map[string]interface{} `json:"input"`
+
+	// JitterSeconds The maximum number of seconds each trigger is randomly delayed by
+	JitterSeconds *int `json:"jitterSeconds,omitempty" validate:"omitnil,min=0,max=3600"`
+
+	// Timezone The IANA timezone the cron expression is evaluated in, defaults to UTC
+	Timezone *string `json:"timezone,omitempty" validate:"omitnil,timezone"`
 }
 
 // CreateEventRequest defines model for CreateEventRequest.
@@ -368,16 +599,94 @@ type CreateEventRequest struct {
 	// Data The data for the event.
 	Data map[string]interface{} `json:"data"`
 
+	// DeliverAfter The time after which the event triggers workflows. The event is stored right away, but it is only delivered once this time has passed.
+	DeliverAfter *time.Time `json:"deliverAfter,omitempty"`
+
 	// Key The key for the event.
 	Key string `json:"key"`
 }
 
+// CreateEventSinkRequest defines model for CreateEventSinkRequest.
+type CreateEventSinkRequest struct {
+	// Brokers The brokers of the Kafka cluster, like kafka.example.com:9092. They're required for KAFKA sinks.
+	Brokers *[]string `json:"brokers,omitempty" validate:"omitempty,max=16,dive,hostname_port"`
+
+	// EventTypes The types of the records which are delivered to the sink. All records except audit log records are delivered if it's not set.
+	EventTypes *[]EventSinkRecordType `json:"eventTypes,omitempty"`
+	Format     *EventSinkFormat       `json:"format,omitempty"`
+	Kind       EventSinkKind          `json:"kind"`
+
+	// MaxAttempts The number of attempts after which a delivery is dead-lettered, defaults to 10.
+	MaxAttempts *int `json:"maxAttempts,omitempty" validate:"omitnil,min=1,max=100"`
+
+	// Name The name of the sink, which is unique within the tenant.
+	Name string `json:"name" validate:"required,hatchetName"`
+
+	// PasswordSecret The name of the tenant secret which stores the password of the Kafka or NATS connection, or the NATS token if no username is set.
+	PasswordSecret *string `json:"passwordSecret,omitempty" validate:"omitnil,min=1,max=255"`
+
+	// Tls Whether the connections to the Kafka brokers use TLS.
+	Tls *bool `json:"tls,omitempty"`
+
+	// Topic The Kafka topic, or the prefix of the NATS subjects, which records are published to. It's required for the KAFKA and NATS sinks.
+	Topic *string `json:"topic,omitempty" validate:"omitnil,min=1,max=249"`
+
+	// Url The URL of the webhook, or of the NATS server like nats://nats.example.com:4222. It's required for the WEBHOOK and NATS sinks.
+	Url *string `json:"url,omitempty" validate:"omitnil,url,max=2048"`
+
+	// UsernameSecret The name of the tenant secret which stores the username of the Kafka or NATS connection.
+	UsernameSecret *string `json:"usernameSecret,omitempty" validate:"omitnil,min=1,max=255"`
+}
+
+// CreateInboundWebhookRequest defines model for CreateInboundWebhookRequest.
+type CreateInboundWebhookRequest struct {
+	// DataExpression A CEL expression which evaluates to the data of the events. The body of the request is used if it's not set.
+	DataExpression *string `json:"dataExpression,omitempty" validate:"omitnil,min=1,max=4096"`
+
+	// EventKey The key of the events which requests are ingested as. Exactly one of the event key and the event key expression must be set.
+	EventKey *string `json:"eventKey,omitempty" validate:"omitnil,min=1,max=255"`
+
+	// EventKeyExpression A CEL expression which evaluates to the key of the events which requests are ingested as, like `"github:" + headers["x-github-event"]`.
+	EventKeyExpression *string `json:"eventKeyExpression,omitempty" validate:"omitnil,min=1,max=1024"`
+
+	// Name The name of the webhook, which is unique within the tenant.
+	Name string `json:"name" validate:"required,hatchetName"`
+
+	// Secret The signing secret. It's required for the STRIPE and SVIX schemes, a random secret is generated for the other schemes if it's not set.
+	Secret *string `json:"secret,omitempty" validate:"omitnil,min=1,max=1024"`
+
+	// SignatureHeader The header which contains the signature, required for the HMAC_SHA256 scheme.
+	SignatureHeader *string                       `json:"signatureHeader,omitempty" validate:"omitnil,min=1,max=255"`
+	SignatureScheme InboundWebhookSignatureScheme `json:"signatureScheme"`
+}
+
 // CreateSNSIntegrationRequest defines model for CreateSNSIntegrationRequest.
 type CreateSNSIntegrationRequest struct {
 	// TopicArn The Amazon Resource Name (ARN) of the SNS topic.
 	TopicArn string `json:"topicArn" validate:"required,min=1,max=256"`
 }
 
+// CreateSQSIntegrationRequest defines model for CreateSQSIntegrationRequest.
+type CreateSQSIntegrationRequest struct {
+	// AccessKeyIdSecret The name of the tenant secret which stores the AWS access key ID.
+	AccessKeyIdSecret string `json:"accessKeyIdSecret" validate:"required,min=1,max=255"`
+
+	// EventKey The key of the events which messages are ingested as.
+	EventKey string `json:"eventKey" validate:"required,min=1,max=255"`
+
+	// QueueUrl The URL of the SQS queue.
+	QueueUrl string `json:"queueUrl" validate:"required,url,max=1024"`
+
+	// Region The AWS region of the SQS queue.
+	Region string `json:"region" validate:"required,min=1,max=64"`
+
+	// SecretAccessKeySecret The name of the tenant secret which stores the AWS secret access key.
+	SecretAccessKeySecret string `json:"secretAccessKeySecret" validate:"required,min=1,max=255"`
+
+	// SessionTokenSecret The name of the tenant secret which stores an AWS session token, only required for temporary credentials.
+	SessionTokenSecret *string `json:"sessionTokenSecret,omitempty" validate:"omitnil,min=1,max=255"`
+}
+
 // CreateTenantAlertEmailGroupRequest defines model for CreateTenantAlertEmailGroupRequest.
 type CreateTenantAlertEmailGroupRequest struct {
 	// Emails A list of emails for users
@@ -400,19 +709,49 @@ type CreateTenantRequest struct {
 	Slug string `json:"slug" validate:"required,hatchetName"`
 }
 
+// CronExclusionCalendar defines model for CronExclusionCalendar.
+type CronExclusionCalendar struct {
+	// Dates The dates on which crons which reference the calendar don't trigger.
+	Dates []openapi_types.Date `json:"dates"`
+
+	// IcalUrl An iCal feed whose events are excluded, in addition to the dates.
+	IcalUrl  *string         `json:"icalUrl,omitempty"`
+	Metadata APIResourceMeta `json:"metadata"`
+
+	// Name The name of the calendar, which is unique within the tenant.
+	Name string `json:"name"`
+
+	// TenantId The ID of the tenant associated with this calendar.
+	TenantId string `json:"tenantId"`
+}
+
+// CronExclusionCalendarList defines model for CronExclusionCalendarList.
+type CronExclusionCalendarList struct {
+	Rows *[]CronExclusionCalendar `json:"rows,omitempty"`
+}
+
 // CronWorkflows defines model for CronWorkflows.
 type CronWorkflows struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
 	Cron               string                  `json:"cron"`
 	Enabled            bool                    `json:"enabled"`
-	Input              *map[string]interface{} `json:"input,omitempty"`
-	Metadata           APIResourceMeta         `json:"metadata"`
-	Method             CronWorkflowsMethod     `json:"method"`
-	Name               *string                 `json:"name,omitempty"`
-	TenantId           string                  `json:"tenantId"`
-	WorkflowId         string                  `json:"workflowId"`
-	WorkflowName       string                  `json:"workflowName"`
-	WorkflowVersionId  string                  `json:"workflowVersionId"`
+
+	// ExclusionCalendarId The ID of the exclusion calendar whose dates the cron skips
+	ExclusionCalendarId *string                 `json:"exclusionCalendarId,omitempty"`
+	Input               *map[string]interface{} `json:"input,omitempty"`
+
+	// JitterSeconds The maximum number of seconds each trigger is randomly delayed by
+	JitterSeconds int                 `json:"jitterSeconds"`
+	Metadata      APIResourceMeta     `json:"metadata"`
+	Method        CronWorkflowsMethod `json:"method"`
+	Name          *string             `json:"name,omitempty"`
+	TenantId      string              `json:"tenantId"`
+
+	// Timezone The IANA timezone the cron expression is evaluated in
+	Timezone          string `json:"timezone"`
+	WorkflowId        string `json:"workflowId"`
+	WorkflowName      string `json:"workflowName"`
+	WorkflowVersionId string `json:"workflowVersionId"`
 }
 
 // CronWorkflowsMethod defines model for CronWorkflows.Method.
@@ -427,6 +766,58 @@ type CronWorkflowsList struct {
 // CronWorkflowsOrderByField defines model for CronWorkflowsOrderByField.
 type CronWorkflowsOrderByField string
 
+// DeadLetterQueueItem defines model for DeadLetterQueueItem.
+type DeadLetterQueueItem struct {
+	// Error The error of the final attempt.
+	Error string `json:"error"`
+
+	// ErrorChain The errors of the previous attempts, oldest first.
+	ErrorChain []string `json:"errorChain"`
+
+	// Input The input of the step run.
+	Input    *string         `json:"input,omitempty"`
+	Metadata APIResourceMeta `json:"metadata"`
+
+	// RetryCount The number of retries which were attempted before the step run was added to the queue.
+	RetryCount int `json:"retryCount"`
+
+	// StepId The ID of the step of the step run.
+	StepId string `json:"stepId"`
+
+	// StepRunId The ID of the step run which exhausted its retries.
+	StepRunId string `json:"stepRunId"`
+
+	// TenantId The ID of the tenant associated with this item.
+	TenantId string `json:"tenantId"`
+
+	// WorkflowRunId The ID of the workflow run of the step run.
+	WorkflowRunId string `json:"workflowRunId"`
+}
+
+// DeadLetterQueueItemList defines model for DeadLetterQueueItemList.
+type DeadLetterQueueItemList struct {
+	Pagination *PaginationResponse    `json:"pagination,omitempty"`
+	Rows       *[]DeadLetterQueueItem `json:"rows,omitempty"`
+}
+
+// DeadLetterQueueItemReplayFailure defines model for DeadLetterQueueItemReplayFailure.
+type DeadLetterQueueItemReplayFailure struct {
+	// Id The id of the item.
+	Id string `json:"id"`
+
+	// Reason The reason the item could not be replayed.
+	Reason string `json:"reason"`
+}
+
+// DecideStepRunApprovalRequest defines model for DecideStepRunApprovalRequest.
+type DecideStepRunApprovalRequest struct {
+	// Approved Whether the step run is approved. Rejected step runs fail.
+	Approved bool `json:"approved"`
+
+	// Payload The payload of the approver, which becomes the output of the step run when approved.
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+}
+
 // Event defines model for Event.
 type Event struct {
 	// AdditionalMetadata Additional metadata for the event.
@@ -448,6 +839,27 @@ type EventData struct {
 	Data string `json:"data"`
 }
 
+// EventDedupRule defines model for EventDedupRule.
+type EventDedupRule struct {
+	// EventKey The key of the events which are deduplicated.
+	EventKey string `json:"eventKey"`
+
+	// Expression The CEL expression which evaluates to the dedup key of an event, with the data of the event as `input` and its additional metadata as `additional_metadata`.
+	Expression string          `json:"expression"`
+	Metadata   APIResourceMeta `json:"metadata"`
+
+	// TenantId The ID of the tenant associated with this rule.
+	TenantId string `json:"tenantId"`
+
+	// WindowSeconds The number of seconds after the first event with a dedup key in which events with the same dedup key are duplicates.
+	WindowSeconds int `json:"windowSeconds"`
+}
+
+// EventDedupRuleList defines model for EventDedupRuleList.
+type EventDedupRuleList struct {
+	Rows *[]EventDedupRule `json:"rows,omitempty"`
+}
+
 // EventKey The key for the event.
 type EventKey = string
 
@@ -469,9 +881,95 @@ type EventOrderByDirection string
 // EventOrderByField defines model for EventOrderByField.
 type EventOrderByField string
 
+// EventRoutingRule defines model for EventRoutingRule.
+type EventRoutingRule struct {
+	// EventKey The key of the events the rule applies to. The rule applies to events with any key if not set.
+	EventKey *string `json:"eventKey,omitempty"`
+
+	// Expression The CEL predicate which events must match, with the data of the event as `input`, its additional metadata as `additional_metadata` and its key as `event_key`.
+	Expression string          `json:"expression"`
+	Metadata   APIResourceMeta `json:"metadata"`
+
+	// Name The name of the rule, which is unique within the tenant.
+	Name string `json:"name"`
+
+	// TenantId The ID of the tenant associated with this rule.
+	TenantId string `json:"tenantId"`
+
+	// WorkflowId The ID of the workflow which is triggered by the events which match the predicate.
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+
+	// WorkflowName The name of the workflow which is triggered by the events which match the predicate.
+	WorkflowName *string `json:"workflowName,omitempty"`
+}
+
+// EventRoutingRuleList defines model for EventRoutingRuleList.
+type EventRoutingRuleList struct {
+	Rows *[]EventRoutingRule `json:"rows,omitempty"`
+}
+
 // EventSearch defines model for EventSearch.
 type EventSearch = string
 
+// EventSink defines model for EventSink.
+type EventSink struct {
+	// Brokers The brokers of the Kafka cluster.
+	Brokers *[]string `json:"brokers,omitempty"`
+
+	// DeadLetteredCount The number of records which were dead-lettered after the maximum number of attempts.
+	DeadLetteredCount int `json:"deadLetteredCount"`
+
+	// EventTypes The types of the records which are delivered to the sink. All records except audit log records are delivered if it's empty.
+	EventTypes []EventSinkRecordType `json:"eventTypes"`
+	Format     EventSinkFormat       `json:"format"`
+	Kind       EventSinkKind         `json:"kind"`
+
+	// MaxAttempts The number of attempts after which a delivery is dead-lettered.
+	MaxAttempts int             `json:"maxAttempts"`
+	Metadata    APIResourceMeta `json:"metadata"`
+
+	// Name The name of the sink, which is unique within the tenant.
+	Name string `json:"name"`
+
+	// PasswordSecret The name of the tenant secret which stores the password of the Kafka or NATS connection.
+	PasswordSecret *string `json:"passwordSecret,omitempty"`
+
+	// PendingCount The number of records which are waiting to be delivered.
+	PendingCount int `json:"pendingCount"`
+
+	// Secret The secret which webhook requests are signed with, which is only returned when the sink is created.
+	Secret *string `json:"secret,omitempty"`
+
+	// TenantId The ID of the tenant associated with this sink.
+	TenantId string `json:"tenantId"`
+
+	// Tls Whether the connections to the Kafka brokers use TLS.
+	Tls bool `json:"tls"`
+
+	// Topic The Kafka topic, or the prefix of the NATS subjects, which records are published to.
+	Topic *string `json:"topic,omitempty"`
+
+	// Url The URL of the webhook or the NATS server.
+	Url *string `json:"url,omitempty"`
+
+	// UsernameSecret The name of the tenant secret which stores the username of the Kafka or NATS connection.
+	UsernameSecret *string `json:"usernameSecret,omitempty"`
+}
+
+// EventSinkFormat The format of the records which are delivered to a sink. CLOUDEVENTS records are wrapped in CloudEvents 1.0 in the structured JSON format.
+type EventSinkFormat string
+
+// EventSinkKind defines model for EventSinkKind.
+type EventSinkKind string
+
+// EventSinkList defines model for EventSinkList.
+type EventSinkList struct {
+	Rows *[]EventSink `json:"rows,omitempty"`
+}
+
+// EventSinkRecordType defines model for EventSinkRecordType.
+type EventSinkRecordType string
+
 // EventWorkflowRunSummary defines model for EventWorkflowRunSummary.
 type EventWorkflowRunSummary struct {
 	// Failed The number of failed runs.
@@ -490,6 +988,65 @@ type EventWorkflowRunSummary struct {
 	Succeeded *int64 `json:"succeeded,omitempty"`
 }
 
+// HealthProbe defines model for HealthProbe.
+type HealthProbe struct {
+	// DurationMs the duration of the probe in milliseconds
+	DurationMs int64 `json:"durationMs"`
+
+	// Error the error of the probe, if the dependency is unhealthy
+	Error *string `json:"error,omitempty"`
+
+	// Healthy whether the dependency is healthy
+	Healthy bool `json:"healthy"`
+
+	// Name the name of the dependency
+	Name string `json:"name"`
+}
+
+// HealthReport defines model for HealthReport.
+type HealthReport struct {
+	// Healthy whether all the probes are healthy
+	Healthy bool          `json:"healthy"`
+	Probes  []HealthProbe `json:"probes"`
+}
+
+// InboundWebhook defines model for InboundWebhook.
+type InboundWebhook struct {
+	// DataExpression A CEL expression which evaluates to the data of the events. The body of the request is used if it's not set.
+	DataExpression *string `json:"dataExpression,omitempty"`
+
+	// EventKey The key of the events which requests are ingested as.
+	EventKey *string `json:"eventKey,omitempty"`
+
+	// EventKeyExpression A CEL expression which evaluates to the key of the events which requests are ingested as.
+	EventKeyExpression *string         `json:"eventKeyExpression,omitempty"`
+	Metadata           APIResourceMeta `json:"metadata"`
+
+	// Name The name of the webhook, which is unique within the tenant.
+	Name string `json:"name"`
+
+	// Secret The signing secret, which is only returned when the webhook is created.
+	Secret *string `json:"secret,omitempty"`
+
+	// SignatureHeader The header which contains the signature, for the HMAC_SHA256 scheme.
+	SignatureHeader *string                       `json:"signatureHeader,omitempty"`
+	SignatureScheme InboundWebhookSignatureScheme `json:"signatureScheme"`
+
+	// TenantId The ID of the tenant associated with this webhook.
+	TenantId string `json:"tenantId"`
+
+	// Url The URL which the provider sends requests to.
+	Url string `json:"url"`
+}
+
+// InboundWebhookList defines model for InboundWebhookList.
+type InboundWebhookList struct {
+	Rows *[]InboundWebhook `json:"rows,omitempty"`
+}
+
+// InboundWebhookSignatureScheme defines model for InboundWebhookSignatureScheme.
+type InboundWebhookSignatureScheme string
+
 // Job defines model for Job.
 type Job struct {
 	// Description The description of the job.
@@ -542,6 +1099,12 @@ type ListSNSIntegrations struct {
 	Rows       []SNSIntegration   `json:"rows"`
 }
 
+// ListSQSIntegrations defines model for ListSQSIntegrations.
+type ListSQSIntegrations struct {
+	Pagination PaginationResponse `json:"pagination"`
+	Rows       []SQSIntegration   `json:"rows"`
+}
+
 // ListSlackWebhooks defines model for ListSlackWebhooks.
 type ListSlackWebhooks struct {
 	Pagination PaginationResponse `json:"pagination"`
@@ -553,11 +1116,18 @@ type LogLine struct {
 	// CreatedAt The creation date of the log line.
 	CreatedAt time.Time `json:"createdAt"`
 
+	// Id The id of the log line, which increases in the order log lines are stored.
+	Id    int64        `json:"id"`
+	Level LogLineLevel `json:"level"`
+
 	// Message The log message.
 	Message string `json:"message"`
 
 	// Metadata The log metadata.
 	Metadata map[string]interface{} `json:"metadata"`
+
+	// StepRunId The id of the step run which the log line belongs to.
+	StepRunId openapi_types.UUID `json:"stepRunId"`
 }
 
 // LogLineLevel defines model for LogLineLevel.
@@ -593,6 +1163,30 @@ type PaginationResponse struct {
 	NumPages *int64 `json:"num_pages,omitempty"`
 }
 
+// PurgeDeadLetterQueueItemsRequest defines model for PurgeDeadLetterQueueItemsRequest.
+type PurgeDeadLetterQueueItemsRequest struct {
+	// Ids The ids of the items to purge. If not set, all items in the queue are purged.
+	Ids *[]openapi_types.UUID `json:"ids,omitempty"`
+}
+
+// PurgeDeadLetterQueueItemsResponse defines model for PurgeDeadLetterQueueItemsResponse.
+type PurgeDeadLetterQueueItemsResponse struct {
+	// Purged The number of purged items.
+	Purged int `json:"purged"`
+}
+
+// Queue defines model for Queue.
+type Queue struct {
+	// IsPaused Whether the queue is paused.
+	IsPaused bool `json:"isPaused"`
+
+	// LastActive The last time the queue was active.
+	LastActive *time.Time `json:"lastActive,omitempty"`
+
+	// Name The name of the queue.
+	Name string `json:"name"`
+}
+
 // QueueMetrics defines model for QueueMetrics.
 type QueueMetrics struct {
 	// NumPending The number of items pending.
@@ -605,6 +1199,45 @@ type QueueMetrics struct {
 	NumRunning int `json:"numRunning"`
 }
 
+// QueueMetricsHistory defines model for QueueMetricsHistory.
+type QueueMetricsHistory struct {
+	// AvgDepth The average number of queued items over the window.
+	AvgDepth float32 `json:"avgDepth"`
+
+	// AvgLatencyMs The average time in milliseconds which the oldest queued item had waited over the window.
+	AvgLatencyMs float32 `json:"avgLatencyMs"`
+
+	// AvgSlotUtilization The average ratio of used to total worker slots of the queue over the window, between 0 and 1.
+	AvgSlotUtilization float32 `json:"avgSlotUtilization"`
+
+	// MaxDepth The maximum number of queued items over the window.
+	MaxDepth int `json:"maxDepth"`
+
+	// MaxLatencyMs The maximum time in milliseconds which the oldest queued item had waited over the window.
+	MaxLatencyMs int64 `json:"maxLatencyMs"`
+
+	// Snapshots The snapshots of the queue in the window, ordered by the time they were taken.
+	Snapshots []QueueMetricsSnapshot `json:"snapshots"`
+}
+
+// QueueMetricsSnapshot defines model for QueueMetricsSnapshot.
+type QueueMetricsSnapshot struct {
+	// CreatedAt The time the snapshot was taken.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Depth The number of queued items.
+	Depth int `json:"depth"`
+
+	// LatencyMs The time in milliseconds which the oldest queued item had waited.
+	LatencyMs int64 `json:"latencyMs"`
+
+	// Slots The number of slots of the active workers which can run the items of the queue.
+	Slots int `json:"slots"`
+
+	// UsedSlots The number of used slots of the active workers which can run the items of the queue.
+	UsedSlots int `json:"usedSlots"`
+}
+
 // RateLimit defines model for RateLimit.
 type RateLimit struct {
 	// Key The key for the rate limit.
@@ -655,27 +1288,112 @@ type RejectInviteRequest struct {
 	Invite string `json:"invite" validate:"required,uuid"`
 }
 
-// ReplayEventRequest defines model for ReplayEventRequest.
-type ReplayEventRequest struct {
-	EventIds []openapi_types.UUID `json:"eventIds"`
+// ReplayDeadLetterQueueItemsRequest defines model for ReplayDeadLetterQueueItemsRequest.
+type ReplayDeadLetterQueueItemsRequest struct {
+	Ids []openapi_types.UUID `json:"ids"`
 }
 
-// ReplayWorkflowRunsRequest defines model for ReplayWorkflowRunsRequest.
-type ReplayWorkflowRunsRequest struct {
-	WorkflowRunIds []openapi_types.UUID `json:"workflowRunIds"`
-}
+// ReplayDeadLetterQueueItemsResponse defines model for ReplayDeadLetterQueueItemsResponse.
+type ReplayDeadLetterQueueItemsResponse struct {
+	// Failed The items which could not be replayed.
+	Failed []DeadLetterQueueItemReplayFailure `json:"failed"`
 
-// ReplayWorkflowRunsResponse defines model for ReplayWorkflowRunsResponse.
-type ReplayWorkflowRunsResponse struct {
-	WorkflowRuns []WorkflowRun `json:"workflowRuns"`
+	// Replayed The ids of the items which were replayed and removed from the queue.
+	Replayed []string `json:"replayed"`
 }
 
-// RerunStepRunRequest defines model for RerunStepRunRequest.
-type RerunStepRunRequest struct {
-	Input map[string]interface{} `json:"input"`
-}
+// ReplayEventRangeRequest defines model for ReplayEventRangeRequest.
+type ReplayEventRangeRequest struct {
+	// AdditionalMetadata The additional metadata which the events must contain.
+	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
 
-// SNSIntegration defines model for SNSIntegration.
+	// DryRun Whether to only report which workflows would be triggered, without replaying the events.
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Keys The keys of the events to replay. All keys are replayed if empty.
+	Keys *[]string `json:"keys,omitempty"`
+
+	// Limit The maximum number of events to replay. Defaults to 100.
+	Limit *int `json:"limit,omitempty"`
+
+	// Since The start of the time window, inclusive.
+	Since time.Time `json:"since"`
+
+	// Until The end of the time window, exclusive. Defaults to the current time.
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// ReplayEventRangeResponse defines model for ReplayEventRangeResponse.
+type ReplayEventRangeResponse struct {
+	DryRun bool `json:"dryRun"`
+
+	// EventCount The number of events in the range.
+	EventCount int `json:"eventCount"`
+
+	// EventIds The ids of the replayed events. Empty in a dry run.
+	EventIds []openapi_types.UUID `json:"eventIds"`
+
+	// HasMore Whether there are more events in the time window than the limit.
+	HasMore bool `json:"hasMore"`
+
+	// NextSince The since of the next page of events, if there are more events.
+	NextSince *time.Time                 `json:"nextSince,omitempty"`
+	Workflows []ReplayEventRangeWorkflow `json:"workflows"`
+}
+
+// ReplayEventRangeWorkflow defines model for ReplayEventRangeWorkflow.
+type ReplayEventRangeWorkflow struct {
+	// EventCount The number of events which trigger the workflow.
+	EventCount int `json:"eventCount"`
+
+	// SkippedCount The number of events which match the workflow but whose data isn't valid input for it.
+	SkippedCount int                `json:"skippedCount"`
+	WorkflowId   openapi_types.UUID `json:"workflowId"`
+	WorkflowName string             `json:"workflowName"`
+
+	// WorkflowVersionId The current version of the workflow, which the events are replayed against.
+	WorkflowVersionId openapi_types.UUID `json:"workflowVersionId"`
+}
+
+// ReplayEventRequest defines model for ReplayEventRequest.
+type ReplayEventRequest struct {
+	EventIds []openapi_types.UUID `json:"eventIds"`
+}
+
+// ReplayWorkflowRunFromStepRequest defines model for ReplayWorkflowRunFromStepRequest.
+type ReplayWorkflowRunFromStepRequest struct {
+	// Input The input of the step, which overrides the input built from the outputs of the upstream steps.
+	Input *map[string]interface{} `json:"input,omitempty"`
+
+	// StepId The id of the step to replay the workflow run from. The outputs of the steps upstream of it are reused.
+	StepId openapi_types.UUID `json:"stepId"`
+}
+
+// ReplayWorkflowRunsRequest defines model for ReplayWorkflowRunsRequest.
+type ReplayWorkflowRunsRequest struct {
+	WorkflowRunIds []openapi_types.UUID `json:"workflowRunIds"`
+}
+
+// ReplayWorkflowRunsResponse defines model for ReplayWorkflowRunsResponse.
+type ReplayWorkflowRunsResponse struct {
+	WorkflowRuns []WorkflowRun `json:"workflowRuns"`
+}
+
+// RerunStepRunRequest defines model for RerunStepRunRequest.
+type RerunStepRunRequest struct {
+	Input map[string]interface{} `json:"input"`
+}
+
+// RotateAPITokenRequest defines model for RotateAPITokenRequest.
+type RotateAPITokenRequest struct {
+	// ExpiresIn The duration for which the new token is valid.
+	ExpiresIn *string `json:"expiresIn,omitempty" validate:"omitnil,duration"`
+
+	// GracePeriod The duration for which the rotated token stays valid, defaults to 24 hours.
+	GracePeriod *string `json:"gracePeriod,omitempty" validate:"omitnil,duration"`
+}
+
+// SNSIntegration defines model for SNSIntegration.
 type SNSIntegration struct {
 	// IngestUrl The URL to send SNS messages to.
 	IngestUrl *string         `json:"ingestUrl,omitempty"`
@@ -688,6 +1406,31 @@ type SNSIntegration struct {
 	TopicArn string `json:"topicArn"`
 }
 
+// SQSIntegration defines model for SQSIntegration.
+type SQSIntegration struct {
+	// AccessKeyIdSecret The name of the tenant secret which stores the AWS access key ID.
+	AccessKeyIdSecret string `json:"accessKeyIdSecret"`
+
+	// EventKey The key of the events which messages are ingested as.
+	EventKey string          `json:"eventKey"`
+	Metadata APIResourceMeta `json:"metadata"`
+
+	// QueueUrl The URL of the SQS queue.
+	QueueUrl string `json:"queueUrl"`
+
+	// Region The AWS region of the SQS queue.
+	Region string `json:"region"`
+
+	// SecretAccessKeySecret The name of the tenant secret which stores the AWS secret access key.
+	SecretAccessKeySecret string `json:"secretAccessKeySecret"`
+
+	// SessionTokenSecret The name of the tenant secret which stores an AWS session token.
+	SessionTokenSecret *string `json:"sessionTokenSecret,omitempty"`
+
+	// TenantId The unique identifier for the tenant that the SQS integration belongs to.
+	TenantId openapi_types.UUID `json:"tenantId"`
+}
+
 // ScheduleWorkflowRunRequest defines model for ScheduleWorkflowRunRequest.
 type ScheduleWorkflowRunRequest struct {
 	AdditionalMetadata map[string]interface{} `json:"additionalMetadata"`
@@ -727,6 +1470,27 @@ type ScheduledWorkflowsList struct {
 // ScheduledWorkflowsOrderByField defines model for ScheduledWorkflowsOrderByField.
 type ScheduledWorkflowsOrderByField string
 
+// SchedulingDecision defines model for SchedulingDecision.
+type SchedulingDecision struct {
+	CreatedAt time.Time                 `json:"createdAt"`
+	Data      *map[string]interface{}   `json:"data,omitempty"`
+	Id        int                       `json:"id"`
+	Message   string                    `json:"message"`
+	Outcome   SchedulingDecisionOutcome `json:"outcome"`
+	Queue     string                    `json:"queue"`
+	StepRunId string                    `json:"stepRunId"`
+	WorkerId  *string                   `json:"workerId,omitempty"`
+}
+
+// SchedulingDecisionList defines model for SchedulingDecisionList.
+type SchedulingDecisionList struct {
+	Pagination *PaginationResponse   `json:"pagination,omitempty"`
+	Rows       *[]SchedulingDecision `json:"rows,omitempty"`
+}
+
+// SchedulingDecisionOutcome defines model for SchedulingDecisionOutcome.
+type SchedulingDecisionOutcome string
+
 // SemaphoreSlots defines model for SemaphoreSlots.
 type SemaphoreSlots struct {
 	// ActionId The action id.
@@ -783,34 +1547,70 @@ type Step struct {
 
 // StepRun defines model for StepRun.
 type StepRun struct {
-	CancelledAt         *time.Time              `json:"cancelledAt,omitempty"`
-	CancelledAtEpoch    *int                    `json:"cancelledAtEpoch,omitempty"`
-	CancelledError      *string                 `json:"cancelledError,omitempty"`
-	CancelledReason     *string                 `json:"cancelledReason,omitempty"`
-	ChildWorkflowRuns   *[]string               `json:"childWorkflowRuns,omitempty"`
-	ChildWorkflowsCount *int                    `json:"childWorkflowsCount,omitempty"`
-	Error               *string                 `json:"error,omitempty"`
-	FinishedAt          *time.Time              `json:"finishedAt,omitempty"`
-	FinishedAtEpoch     *int                    `json:"finishedAtEpoch,omitempty"`
-	Input               *string                 `json:"input,omitempty"`
-	JobRun              *JobRun                 `json:"jobRun,omitempty"`
-	JobRunId            string                  `json:"jobRunId"`
-	Metadata            APIResourceMeta         `json:"metadata"`
-	Output              *string                 `json:"output,omitempty"`
-	Parents             *[]string               `json:"parents,omitempty"`
-	RequeueAfter        *time.Time              `json:"requeueAfter,omitempty"`
-	Result              *map[string]interface{} `json:"result,omitempty"`
-	StartedAt           *time.Time              `json:"startedAt,omitempty"`
-	StartedAtEpoch      *int                    `json:"startedAtEpoch,omitempty"`
-	Status              StepRunStatus           `json:"status"`
-	Step                *Step                   `json:"step,omitempty"`
-	StepId              string                  `json:"stepId"`
-	TenantId            string                  `json:"tenantId"`
-	TimeoutAt           *time.Time              `json:"timeoutAt,omitempty"`
-	TimeoutAtEpoch      *int                    `json:"timeoutAtEpoch,omitempty"`
-	WorkerId            *string                 `json:"workerId,omitempty"`
+	CancelledAt         *time.Time      `json:"cancelledAt,omitempty"`
+	CancelledAtEpoch    *int            `json:"cancelledAtEpoch,omitempty"`
+	CancelledError      *string         `json:"cancelledError,omitempty"`
+	CancelledReason     *string         `json:"cancelledReason,omitempty"`
+	ChildWorkflowRuns   *[]string       `json:"childWorkflowRuns,omitempty"`
+	ChildWorkflowsCount *int            `json:"childWorkflowsCount,omitempty"`
+	Error               *string         `json:"error,omitempty"`
+	FinishedAt          *time.Time      `json:"finishedAt,omitempty"`
+	FinishedAtEpoch     *int            `json:"finishedAtEpoch,omitempty"`
+	Input               *string         `json:"input,omitempty"`
+	JobRun              *JobRun         `json:"jobRun,omitempty"`
+	JobRunId            string          `json:"jobRunId"`
+	Metadata            APIResourceMeta `json:"metadata"`
+
+	// NextRetryAt The time of the next retry, if the step run is waiting on a retry backoff.
+	NextRetryAt    *time.Time              `json:"nextRetryAt,omitempty"`
+	Output         *string                 `json:"output,omitempty"`
+	Parents        *[]string               `json:"parents,omitempty"`
+	Progress       *StepRunProgress        `json:"progress,omitempty"`
+	RequeueAfter   *time.Time              `json:"requeueAfter,omitempty"`
+	Result         *map[string]interface{} `json:"result,omitempty"`
+	StartedAt      *time.Time              `json:"startedAt,omitempty"`
+	StartedAtEpoch *int                    `json:"startedAtEpoch,omitempty"`
+	Status         StepRunStatus           `json:"status"`
+	Step           *Step                   `json:"step,omitempty"`
+	StepId         string                  `json:"stepId"`
+	TenantId       string                  `json:"tenantId"`
+	TimeoutAt      *time.Time              `json:"timeoutAt,omitempty"`
+	TimeoutAtEpoch *int                    `json:"timeoutAtEpoch,omitempty"`
+	WorkerId       *string                 `json:"workerId,omitempty"`
+}
+
+// StepRunApproval defines model for StepRunApproval.
+type StepRunApproval struct {
+	// DecidedAt The time the approval was decided or timed out.
+	DecidedAt *time.Time      `json:"decidedAt,omitempty"`
+	Metadata  APIResourceMeta `json:"metadata"`
+
+	// Payload The payload of the approver.
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+	Status  StepRunApprovalStatus   `json:"status"`
+
+	// StepRunId The ID of the approval step run.
+	StepRunId string `json:"stepRunId"`
+
+	// TenantId The ID of the tenant associated with this approval.
+	TenantId string `json:"tenantId"`
+
+	// Token The token which allows the approval to be decided through the approval webhook, without an API token.
+	Token string `json:"token"`
+
+	// WorkflowRunId The ID of the workflow run of the step run.
+	WorkflowRunId string `json:"workflowRunId"`
+}
+
+// StepRunApprovalList defines model for StepRunApprovalList.
+type StepRunApprovalList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]StepRunApproval  `json:"rows,omitempty"`
 }
 
+// StepRunApprovalStatus defines model for StepRunApprovalStatus.
+type StepRunApprovalStatus string
+
 // StepRunArchive defines model for StepRunArchive.
 type StepRunArchive struct {
 	CancelledAt      *time.Time `json:"cancelledAt,omitempty"`
@@ -864,6 +1664,19 @@ type StepRunEventReason string
 // StepRunEventSeverity defines model for StepRunEventSeverity.
 type StepRunEventSeverity string
 
+// StepRunProgress The latest progress which a long-running step run reported.
+type StepRunProgress struct {
+	// Data Arbitrary data which the step run reported along with its progress.
+	Data *map[string]interface{} `json:"data,omitempty"`
+
+	// Message A message which describes the progress.
+	Message *string `json:"message,omitempty"`
+
+	// Percent The percentage of the step run which is done, between 0 and 100.
+	Percent   float64   `json:"percent"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
 // StepRunStatus defines model for StepRunStatus.
 type StepRunStatus string
 
@@ -960,9 +1773,29 @@ type TenantMemberRole string
 
 // TenantQueueMetrics defines model for TenantQueueMetrics.
 type TenantQueueMetrics struct {
-	Queues   *map[string]int          `json:"queues,omitempty"`
-	Total    *QueueMetrics            `json:"total,omitempty"`
-	Workflow *map[string]QueueMetrics `json:"workflow,omitempty"`
+	// History The metrics history of the queues over the requested window, keyed by queue. Only set when a window is requested.
+	History  *map[string]QueueMetricsHistory `json:"history,omitempty"`
+	Queues   *map[string]int                 `json:"queues,omitempty"`
+	Total    *QueueMetrics                   `json:"total,omitempty"`
+	Workflow *map[string]QueueMetrics        `json:"workflow,omitempty"`
+}
+
+// TenantQuotaResource defines model for TenantQuotaResource.
+type TenantQuotaResource string
+
+// TenantQuotaUsage defines model for TenantQuotaUsage.
+type TenantQuotaUsage struct {
+	// Limit The quota which applies to the tenant. Not set if the resource is unlimited.
+	Limit    *int                `json:"limit,omitempty"`
+	Resource TenantQuotaResource `json:"resource"`
+
+	// Usage The current usage of the resource.
+	Usage int `json:"usage"`
+}
+
+// TenantQuotaUsageList defines model for TenantQuotaUsageList.
+type TenantQuotaUsageList struct {
+	Rows *[]TenantQuotaUsage `json:"rows,omitempty"`
 }
 
 // TenantResource defines model for TenantResource.
@@ -1002,7 +1835,16 @@ type TenantStepRunQueueMetrics struct {
 // TriggerWorkflowRunRequest defines model for TriggerWorkflowRunRequest.
 type TriggerWorkflowRunRequest struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
-	Input              map[string]interface{}  `json:"input"`
+
+	// IdempotencyKey If a workflow run of the workflow was triggered with the same idempotency key within the retention window, the existing workflow run is returned instead of a new one.
+	IdempotencyKey *string                `json:"idempotencyKey,omitempty"`
+	Input          map[string]interface{} `json:"input"`
+}
+
+// UpdateScheduledWorkflowRunRequest defines model for UpdateScheduledWorkflowRunRequest.
+type UpdateScheduledWorkflowRunRequest struct {
+	// TriggerAt The new time to trigger the scheduled workflow run at
+	TriggerAt time.Time `json:"triggerAt"`
 }
 
 // UpdateTenantAlertEmailGroupRequest defines model for UpdateTenantAlertEmailGroupRequest.
@@ -1046,6 +1888,42 @@ type UpdateWorkerRequest struct {
 	IsPaused *bool `json:"isPaused,omitempty"`
 }
 
+// UpdateWorkflowRolloutRequest defines model for UpdateWorkflowRolloutRequest.
+type UpdateWorkflowRolloutRequest struct {
+	// CanaryPercentage The percentage of new runs which are triggered on the latest version of the workflow. Set to 0 to pin new runs to the stable version.
+	CanaryPercentage int `json:"canaryPercentage" validate:"min=0,max=100"`
+
+	// StableVersionId The ID of the version which new runs are triggered on, unless they're part of the canary.
+	StableVersionId openapi_types.UUID `json:"stableVersionId"`
+}
+
+// UpsertEventDedupRuleRequest defines model for UpsertEventDedupRuleRequest.
+type UpsertEventDedupRuleRequest struct {
+	// EventKey The key of the events which are deduplicated.
+	EventKey string `json:"eventKey" validate:"required"`
+
+	// Expression The CEL expression which evaluates to the dedup key of an event, with the data of the event as `input` and its additional metadata as `additional_metadata`.
+	Expression string `json:"expression" validate:"required"`
+
+	// WindowSeconds The number of seconds after the first event with a dedup key in which events with the same dedup key are duplicates.
+	WindowSeconds int `json:"windowSeconds" validate:"required,min=1,max=604800"`
+}
+
+// UpsertEventRoutingRuleRequest defines model for UpsertEventRoutingRuleRequest.
+type UpsertEventRoutingRuleRequest struct {
+	// EventKey The key of the events the rule applies to. The rule applies to events with any key if not set.
+	EventKey *string `json:"eventKey,omitempty"`
+
+	// Expression The CEL predicate which events must match, with the data of the event as `input`, its additional metadata as `additional_metadata` and its key as `event_key`.
+	Expression string `json:"expression" validate:"required"`
+
+	// Name The name of the rule, which is unique within the tenant.
+	Name string `json:"name" validate:"required,hatchetName"`
+
+	// WorkflowId The ID of the workflow which is triggered by the events which match the predicate.
+	WorkflowId openapi_types.UUID `json:"workflowId" validate:"required"`
+}
+
 // User defines model for User.
 type User struct {
 	// Email The email address of the user.
@@ -1182,6 +2060,7 @@ type Worker struct {
 
 	// DispatcherId the id of the assigned dispatcher, in UUID format
 	DispatcherId *openapi_types.UUID `json:"dispatcherId,omitempty"`
+	Health       *WorkerHealth       `json:"health,omitempty"`
 
 	// Labels The current label state of the worker.
 	Labels *[]WorkerLabel `json:"labels,omitempty"`
@@ -1199,9 +2078,15 @@ type Worker struct {
 	// Name The name of the worker.
 	Name string `json:"name"`
 
+	// Pool The worker pool the worker joined.
+	Pool *string `json:"pool,omitempty"`
+
 	// RecentStepRuns The recent step runs for the worker.
-	RecentStepRuns *[]RecentStepRuns  `json:"recentStepRuns,omitempty"`
-	RuntimeInfo    *WorkerRuntimeInfo `json:"runtimeInfo,omitempty"`
+	RecentStepRuns *[]RecentStepRuns `json:"recentStepRuns,omitempty"`
+
+	// Region The region the worker runs in.
+	Region      *string            `json:"region,omitempty"`
+	RuntimeInfo *WorkerRuntimeInfo `json:"runtimeInfo,omitempty"`
 
 	// Slots The semaphore slot state for the worker.
 	Slots *[]SemaphoreSlots `json:"slots,omitempty"`
@@ -1223,6 +2108,24 @@ type WorkerStatus string
 // WorkerType defines model for Worker.Type.
 type WorkerType string
 
+// WorkerHealth defines model for WorkerHealth.
+type WorkerHealth struct {
+	// ActiveSlots The number of slots of the worker which are running a step, as reported by the worker.
+	ActiveSlots *int `json:"activeSlots,omitempty"`
+
+	// CpuUtilization The cpu utilization of the worker process, between 0 and 1.
+	CpuUtilization *float32 `json:"cpuUtilization,omitempty"`
+
+	// Gauges Custom gauges which the worker reports, keyed by name.
+	Gauges *map[string]float32 `json:"gauges,omitempty"`
+
+	// MemoryUtilization The memory utilization of the worker process relative to its memory limit, between 0 and 1.
+	MemoryUtilization *float32 `json:"memoryUtilization,omitempty"`
+
+	// ReportedAt The time of the heartbeat which the health was reported with.
+	ReportedAt time.Time `json:"reportedAt"`
+}
+
 // WorkerLabel defines model for WorkerLabel.
 type WorkerLabel struct {
 	// Key The key of the label.
@@ -1239,6 +2142,26 @@ type WorkerList struct {
 	Rows       *[]Worker           `json:"rows,omitempty"`
 }
 
+// WorkerPool defines model for WorkerPool.
+type WorkerPool struct {
+	// Name The name of the worker pool.
+	Name string `json:"name"`
+
+	// Slots The total number of slots of the active workers in the pool.
+	Slots int `json:"slots"`
+
+	// UsedSlots The number of slots of the active workers in the pool which are running a step.
+	UsedSlots int `json:"usedSlots"`
+
+	// Workers The number of active workers in the pool.
+	Workers int `json:"workers"`
+}
+
+// WorkerPoolList defines model for WorkerPoolList.
+type WorkerPoolList struct {
+	Rows *[]WorkerPool `json:"rows,omitempty"`
+}
+
 // WorkerRuntimeInfo defines model for WorkerRuntimeInfo.
 type WorkerRuntimeInfo struct {
 	Language        *WorkerRuntimeSDKs `json:"language,omitempty"`
@@ -1286,6 +2209,23 @@ type WorkflowConcurrency struct {
 // WorkflowConcurrencyLimitStrategy The strategy to use when the concurrency limit is reached.
 type WorkflowConcurrencyLimitStrategy string
 
+// WorkflowConcurrencyGroup defines model for WorkflowConcurrencyGroup.
+type WorkflowConcurrencyGroup struct {
+	// GroupKey The concurrency group key.
+	GroupKey string `json:"groupKey"`
+
+	// QueuedCount The number of queued workflow runs in the group.
+	QueuedCount int `json:"queuedCount"`
+
+	// RunningCount The number of running workflow runs in the group.
+	RunningCount int `json:"runningCount"`
+}
+
+// WorkflowConcurrencyGroupList defines model for WorkflowConcurrencyGroupList.
+type WorkflowConcurrencyGroupList struct {
+	Rows *[]WorkflowConcurrencyGroup `json:"rows,omitempty"`
+}
+
 // WorkflowID A workflow ID.
 type WorkflowID = string
 
@@ -1311,28 +2251,55 @@ type WorkflowMetrics struct {
 	GroupKeyRunsCount *int `json:"groupKeyRunsCount,omitempty"`
 }
 
+// WorkflowRollout defines model for WorkflowRollout.
+type WorkflowRollout struct {
+	// CanaryPercentage The percentage of new runs which are triggered on the latest version of the workflow.
+	CanaryPercentage int             `json:"canaryPercentage"`
+	Metadata         APIResourceMeta `json:"metadata"`
+
+	// StableVersionId The ID of the version which new runs are triggered on, unless they're part of the canary.
+	StableVersionId openapi_types.UUID `json:"stableVersionId"`
+
+	// WorkflowId The ID of the workflow the rollout belongs to.
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+}
+
 // WorkflowRun defines model for WorkflowRun.
 type WorkflowRun struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
-	DisplayName        *string                 `json:"displayName,omitempty"`
-	Duration           *int                    `json:"duration,omitempty"`
-	Error              *string                 `json:"error,omitempty"`
-	FinishedAt         *time.Time              `json:"finishedAt,omitempty"`
-	Input              *map[string]interface{} `json:"input,omitempty"`
-	JobRuns            *[]JobRun               `json:"jobRuns,omitempty"`
-	Metadata           APIResourceMeta         `json:"metadata"`
-	ParentId           *openapi_types.UUID     `json:"parentId,omitempty"`
-	ParentStepRunId    *openapi_types.UUID     `json:"parentStepRunId,omitempty"`
-	StartedAt          *time.Time              `json:"startedAt,omitempty"`
-	Status             WorkflowRunStatus       `json:"status"`
-	TenantId           string                  `json:"tenantId"`
-	TriggeredBy        WorkflowRunTriggeredBy  `json:"triggeredBy"`
-	WorkflowVersion    *WorkflowVersion        `json:"workflowVersion,omitempty"`
-	WorkflowVersionId  string                  `json:"workflowVersionId"`
+
+	// Archived Whether the job runs, step runs and events of the workflow run were moved to the archive. The details of archived runs are read from the blob storage, which is slower.
+	Archived    *bool                   `json:"archived,omitempty"`
+	DisplayName *string                 `json:"displayName,omitempty"`
+	Duration    *int                    `json:"duration,omitempty"`
+	Error       *string                 `json:"error,omitempty"`
+	FinishedAt  *time.Time              `json:"finishedAt,omitempty"`
+	Input       *map[string]interface{} `json:"input,omitempty"`
+	JobRuns     *[]JobRun               `json:"jobRuns,omitempty"`
+	Metadata    APIResourceMeta         `json:"metadata"`
+
+	// Output The output of the workflow run as JSON, if the workflow declares an output expression.
+	Output          *string             `json:"output,omitempty"`
+	ParentId        *openapi_types.UUID `json:"parentId,omitempty"`
+	ParentStepRunId *openapi_types.UUID `json:"parentStepRunId,omitempty"`
+
+	// ReplayedFromId The id of the workflow run which this workflow run replays from a step.
+	ReplayedFromId *openapi_types.UUID `json:"replayedFromId,omitempty"`
+
+	// ReplayedFromStepId The id of the step which this workflow run is replayed from.
+	ReplayedFromStepId *openapi_types.UUID    `json:"replayedFromStepId,omitempty"`
+	StartedAt          *time.Time             `json:"startedAt,omitempty"`
+	Status             WorkflowRunStatus      `json:"status"`
+	TenantId           string                 `json:"tenantId"`
+	TriggeredBy        WorkflowRunTriggeredBy `json:"triggeredBy"`
+	WorkflowVersion    *WorkflowVersion       `json:"workflowVersion,omitempty"`
+	WorkflowVersionId  string                 `json:"workflowVersionId"`
 }
 
 // WorkflowRunList defines model for WorkflowRunList.
 type WorkflowRunList struct {
+	// NextCursor The cursor to pass to get the next page of runs. Only set when there may be more runs. Pages which were requested with a cursor have no pagination, since the runs aren't counted.
+	NextCursor *string             `json:"nextCursor,omitempty"`
 	Pagination *PaginationResponse `json:"pagination,omitempty"`
 	Rows       *[]WorkflowRun      `json:"rows,omitempty"`
 }
@@ -1346,22 +2313,25 @@ type WorkflowRunOrderByField string
 // WorkflowRunShape defines model for WorkflowRunShape.
 type WorkflowRunShape struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
-	DisplayName        *string                 `json:"displayName,omitempty"`
-	Duration           *int                    `json:"duration,omitempty"`
-	Error              *string                 `json:"error,omitempty"`
-	FinishedAt         *time.Time              `json:"finishedAt,omitempty"`
-	Input              *map[string]interface{} `json:"input,omitempty"`
-	JobRuns            *[]JobRun               `json:"jobRuns,omitempty"`
-	Metadata           APIResourceMeta         `json:"metadata"`
-	ParentId           *openapi_types.UUID     `json:"parentId,omitempty"`
-	ParentStepRunId    *openapi_types.UUID     `json:"parentStepRunId,omitempty"`
-	StartedAt          *time.Time              `json:"startedAt,omitempty"`
-	Status             WorkflowRunStatus       `json:"status"`
-	TenantId           string                  `json:"tenantId"`
-	TriggeredBy        WorkflowRunTriggeredBy  `json:"triggeredBy"`
-	WorkflowId         *string                 `json:"workflowId,omitempty"`
-	WorkflowVersion    *WorkflowVersion        `json:"workflowVersion,omitempty"`
-	WorkflowVersionId  string                  `json:"workflowVersionId"`
+
+	// Archived Whether the job runs, step runs and events of the workflow run were moved to the archive. The details of archived runs are read from the blob storage, which is slower.
+	Archived          *bool                   `json:"archived,omitempty"`
+	DisplayName       *string                 `json:"displayName,omitempty"`
+	Duration          *int                    `json:"duration,omitempty"`
+	Error             *string                 `json:"error,omitempty"`
+	FinishedAt        *time.Time              `json:"finishedAt,omitempty"`
+	Input             *map[string]interface{} `json:"input,omitempty"`
+	JobRuns           *[]JobRun               `json:"jobRuns,omitempty"`
+	Metadata          APIResourceMeta         `json:"metadata"`
+	ParentId          *openapi_types.UUID     `json:"parentId,omitempty"`
+	ParentStepRunId   *openapi_types.UUID     `json:"parentStepRunId,omitempty"`
+	StartedAt         *time.Time              `json:"startedAt,omitempty"`
+	Status            WorkflowRunStatus       `json:"status"`
+	TenantId          string                  `json:"tenantId"`
+	TriggeredBy       WorkflowRunTriggeredBy  `json:"triggeredBy"`
+	WorkflowId        *string                 `json:"workflowId,omitempty"`
+	WorkflowVersion   *WorkflowVersion        `json:"workflowVersion,omitempty"`
+	WorkflowVersionId string                  `json:"workflowVersionId"`
 }
 
 // WorkflowRunStatus defines model for WorkflowRunStatus.
@@ -1370,6 +2340,30 @@ type WorkflowRunStatus string
 // WorkflowRunStatusList defines model for WorkflowRunStatusList.
 type WorkflowRunStatusList = []WorkflowRunStatus
 
+// WorkflowRunStreamEvent An event of a workflow run, which is sent as the data of a server-sent event.
+type WorkflowRunStreamEvent struct {
+	// EventPayload The output of a completed step run, the error of a failed step run, the data of a stream event, or the progress of a step run as a JSON-encoded StepRunProgress without its update time.
+	EventPayload   *string                    `json:"eventPayload,omitempty"`
+	EventTimestamp time.Time                  `json:"eventTimestamp"`
+	EventType      WorkflowRunStreamEventType `json:"eventType"`
+
+	// Hangup Whether this is the last event of the stream.
+	Hangup bool `json:"hangup"`
+
+	// ResourceId The id of the step run or workflow run which the event belongs to.
+	ResourceId    string                        `json:"resourceId"`
+	ResourceType  WorkflowRunStreamResourceType `json:"resourceType"`
+	RetryCount    *int                          `json:"retryCount,omitempty"`
+	StepRetries   *int                          `json:"stepRetries,omitempty"`
+	WorkflowRunId openapi_types.UUID            `json:"workflowRunId"`
+}
+
+// WorkflowRunStreamEventType defines model for WorkflowRunStreamEventType.
+type WorkflowRunStreamEventType string
+
+// WorkflowRunStreamResourceType defines model for WorkflowRunStreamResourceType.
+type WorkflowRunStreamResourceType string
+
 // WorkflowRunTriggeredBy defines model for WorkflowRunTriggeredBy.
 type WorkflowRunTriggeredBy struct {
 	CronParentId        *string         `json:"cronParentId,omitempty"`
@@ -1439,11 +2433,17 @@ type WorkflowVersion struct {
 	Concurrency *WorkflowConcurrency `json:"concurrency,omitempty"`
 
 	// DefaultPriority The default priority of the workflow.
-	DefaultPriority *int32          `json:"defaultPriority,omitempty"`
-	Jobs            *[]Job          `json:"jobs,omitempty"`
-	Metadata        APIResourceMeta `json:"metadata"`
-	Order           int32           `json:"order"`
-	ScheduleTimeout *string         `json:"scheduleTimeout,omitempty"`
+	DefaultPriority *int32 `json:"defaultPriority,omitempty"`
+
+	// InputSchema The JSON schema of the input of the workflow runs, which can be used to generate a form for triggering the workflow.
+	InputSchema *map[string]interface{} `json:"inputSchema,omitempty"`
+	Jobs        *[]Job                  `json:"jobs,omitempty"`
+	Metadata    APIResourceMeta         `json:"metadata"`
+	Order       int32                   `json:"order"`
+
+	// ReservedSlots The number of slots which the workflow runs reserve up front, so that a DAG isn't starved halfway through.
+	ReservedSlots   *int32  `json:"reservedSlots,omitempty"`
+	ScheduleTimeout *string `json:"scheduleTimeout,omitempty"`
 
 	// Sticky The sticky strategy of the workflow.
 	Sticky   *string           `json:"sticky,omitempty"`
@@ -1505,6 +2505,9 @@ type LogLineListParams struct {
 	// Search The search query to filter for
 	Search *LogLineSearch `form:"search,omitempty" json:"search,omitempty"`
 
+	// AfterId Only return log lines with an id greater than this id, in the order they were stored. This is used to tail the log lines of a step run.
+	AfterId *int64 `form:"afterId,omitempty" json:"afterId,omitempty"`
+
 	// OrderByField What to order by
 	OrderByField *LogLineOrderByField `form:"orderByField,omitempty" json:"orderByField,omitempty"`
 
@@ -1512,6 +2515,69 @@ type LogLineListParams struct {
 	OrderByDirection *LogLineOrderByDirection `form:"orderByDirection,omitempty" json:"orderByDirection,omitempty"`
 }
 
+// LogLineStreamParams defines parameters for LogLineStream.
+type LogLineStreamParams struct {
+	// Levels A list of levels to filter by
+	Levels *LogLineLevelField `form:"levels,omitempty" json:"levels,omitempty"`
+}
+
+// StepRunListSchedulingDecisionsParams defines parameters for StepRunListSchedulingDecisions.
+type StepRunListSchedulingDecisionsParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ApprovalListParams defines parameters for ApprovalList.
+type ApprovalListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Statuses A list of approval statuses to filter by
+	Statuses *[]StepRunApprovalStatus `form:"statuses,omitempty" json:"statuses,omitempty"`
+}
+
+// AuditLogListParams defines parameters for AuditLogList.
+type AuditLogListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// ActorId Only list the API calls of a user or API token
+	ActorId *openapi_types.UUID `form:"actorId,omitempty" json:"actorId,omitempty"`
+
+	// Operation Only list the API calls of an operation
+	Operation *string `form:"operation,omitempty" json:"operation,omitempty"`
+
+	// ResourceType Only list the API calls which operated on a resource type
+	ResourceType *string `form:"resourceType,omitempty" json:"resourceType,omitempty"`
+
+	// ResourceId Only list the API calls which operated on a resource
+	ResourceId *string `form:"resourceId,omitempty" json:"resourceId,omitempty"`
+
+	// Since Only list the API calls which were made at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only list the API calls which were made before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
+
+// DeadLetterQueueListParams defines parameters for DeadLetterQueueList.
+type DeadLetterQueueListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // EventListParams defines parameters for EventList.
 type EventListParams struct {
 	// Offset The number to skip
@@ -1552,6 +2618,9 @@ type TenantGetQueueMetricsParams struct {
 
 	// AdditionalMetadata A list of metadata key value pairs to filter by
 	AdditionalMetadata *[]string `form:"additionalMetadata,omitempty" json:"additionalMetadata,omitempty"`
+
+	// Window The window of the queue metrics history to return, as a duration like 5m or 1h. If set, the history of every queue over the window is returned. The window can be at most 24h.
+	Window *string `form:"window,omitempty" json:"window,omitempty"`
 }
 
 // RateLimitListParams defines parameters for RateLimitList.
@@ -1601,18 +2670,27 @@ type CronWorkflowListParams struct {
 
 // WorkflowRunListParams defines parameters for WorkflowRunList.
 type WorkflowRunListParams struct {
-	// Offset The number to skip
+	// Offset The number to skip, which is ignored when a cursor is set. Deprecated in favor of the cursor, which is faster on large tenants.
 	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
 
 	// Limit The number to limit by
 	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
 
+	// Cursor The cursor returned as nextCursor by the previous page. The order by field and direction must match the previous page. The runs aren't counted when a cursor is set, so the response has no pagination.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
 	// EventId The event id to get runs for.
 	EventId *openapi_types.UUID `form:"eventId,omitempty" json:"eventId,omitempty"`
 
+	// EventKey The key of the event which triggered the runs.
+	EventKey *string `form:"eventKey,omitempty" json:"eventKey,omitempty"`
+
 	// WorkflowId The workflow id to get runs for.
 	WorkflowId *openapi_types.UUID `form:"workflowId,omitempty" json:"workflowId,omitempty"`
 
+	// WorkflowIds A list of workflow ids to get runs for.
+	WorkflowIds *[]openapi_types.UUID `form:"workflowIds,omitempty" json:"workflowIds,omitempty"`
+
 	// ParentWorkflowRunId The parent workflow run id
 	ParentWorkflowRunId *openapi_types.UUID `form:"parentWorkflowRunId,omitempty" json:"parentWorkflowRunId,omitempty"`
 
@@ -1701,6 +2779,12 @@ type WorkflowScheduledListParams struct {
 	Statuses *[]ScheduledRunStatus `form:"statuses,omitempty" json:"statuses,omitempty"`
 }
 
+// WorkflowListConcurrencyGroupsParams defines parameters for WorkflowListConcurrencyGroups.
+type WorkflowListConcurrencyGroupsParams struct {
+	// Limit The maximum number of groups to return
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // WorkflowGetMetricsParams defines parameters for WorkflowGetMetrics.
 type WorkflowGetMetricsParams struct {
 	// Status A status of workflow run statuses to filter by
@@ -1725,6 +2809,12 @@ type WorkflowVersionGetParams struct {
 // AlertEmailGroupUpdateJSONRequestBody defines body for AlertEmailGroupUpdate for application/json ContentType.
 type AlertEmailGroupUpdateJSONRequestBody = UpdateTenantAlertEmailGroupRequest
 
+// ApprovalUpdateDecideWithTokenJSONRequestBody defines body for ApprovalUpdateDecideWithToken for application/json ContentType.
+type ApprovalUpdateDecideWithTokenJSONRequestBody = DecideStepRunApprovalRequest
+
+// ApprovalUpdateDecideJSONRequestBody defines body for ApprovalUpdateDecide for application/json ContentType.
+type ApprovalUpdateDecideJSONRequestBody = DecideStepRunApprovalRequest
+
 // TenantCreateJSONRequestBody defines body for TenantCreate for application/json ContentType.
 type TenantCreateJSONRequestBody = CreateTenantRequest
 
@@ -1737,9 +2827,36 @@ type AlertEmailGroupCreateJSONRequestBody = CreateTenantAlertEmailGroupRequest
 // ApiTokenCreateJSONRequestBody defines body for ApiTokenCreate for application/json ContentType.
 type ApiTokenCreateJSONRequestBody = CreateAPITokenRequest
 
+// ApiTokenUpdateRotateJSONRequestBody defines body for ApiTokenUpdateRotate for application/json ContentType.
+type ApiTokenUpdateRotateJSONRequestBody = RotateAPITokenRequest
+
+// ClientCaCreateJSONRequestBody defines body for ClientCaCreate for application/json ContentType.
+type ClientCaCreateJSONRequestBody = CreateClientCARequest
+
+// CronCalendarCreateJSONRequestBody defines body for CronCalendarCreate for application/json ContentType.
+type CronCalendarCreateJSONRequestBody = CreateCronExclusionCalendarRequest
+
+// DeadLetterQueueDeleteJSONRequestBody defines body for DeadLetterQueueDelete for application/json ContentType.
+type DeadLetterQueueDeleteJSONRequestBody = PurgeDeadLetterQueueItemsRequest
+
+// DeadLetterQueueUpdateReplayJSONRequestBody defines body for DeadLetterQueueUpdateReplay for application/json ContentType.
+type DeadLetterQueueUpdateReplayJSONRequestBody = ReplayDeadLetterQueueItemsRequest
+
+// EventDedupRuleUpsertJSONRequestBody defines body for EventDedupRuleUpsert for application/json ContentType.
+type EventDedupRuleUpsertJSONRequestBody = UpsertEventDedupRuleRequest
+
+// EventRoutingRuleUpsertJSONRequestBody defines body for EventRoutingRuleUpsert for application/json ContentType.
+type EventRoutingRuleUpsertJSONRequestBody = UpsertEventRoutingRuleRequest
+
+// EventSinkCreateJSONRequestBody defines body for EventSinkCreate for application/json ContentType.
+type EventSinkCreateJSONRequestBody = CreateEventSinkRequest
+
 // EventCreateJSONRequestBody defines body for EventCreate for application/json ContentType.
 type EventCreateJSONRequestBody = CreateEventRequest
 
+// EventCreateBatchJSONRequestBody defines body for EventCreateBatch for application/json ContentType.
+type EventCreateBatchJSONRequestBody = BatchCreateEventRequest
+
 // EventCreateBulkJSONRequestBody defines body for EventCreateBulk for application/json ContentType.
 type EventCreateBulkJSONRequestBody = BulkCreateEventRequest
 
@@ -1749,6 +2866,12 @@ type EventUpdateCancelJSONRequestBody = CancelEventRequest
 // EventUpdateReplayJSONRequestBody defines body for EventUpdateReplay for application/json ContentType.
 type EventUpdateReplayJSONRequestBody = ReplayEventRequest
 
+// EventUpdateReplayRangeJSONRequestBody defines body for EventUpdateReplayRange for application/json ContentType.
+type EventUpdateReplayRangeJSONRequestBody = ReplayEventRangeRequest
+
+// InboundWebhookCreateJSONRequestBody defines body for InboundWebhookCreate for application/json ContentType.
+type InboundWebhookCreateJSONRequestBody = CreateInboundWebhookRequest
+
 // TenantInviteCreateJSONRequestBody defines body for TenantInviteCreate for application/json ContentType.
 type TenantInviteCreateJSONRequestBody = CreateTenantInviteRequest
 
@@ -1758,6 +2881,9 @@ type TenantInviteUpdateJSONRequestBody = UpdateTenantInviteRequest
 // SnsCreateJSONRequestBody defines body for SnsCreate for application/json ContentType.
 type SnsCreateJSONRequestBody = CreateSNSIntegrationRequest
 
+// SqsCreateJSONRequestBody defines body for SqsCreate for application/json ContentType.
+type SqsCreateJSONRequestBody = CreateSQSIntegrationRequest
+
 // StepRunUpdateRerunJSONRequestBody defines body for StepRunUpdateRerun for application/json ContentType.
 type StepRunUpdateRerunJSONRequestBody = RerunStepRunRequest
 
@@ -1767,9 +2893,15 @@ type WebhookCreateJSONRequestBody = WebhookWorkerCreateRequest
 // WorkflowRunUpdateReplayJSONRequestBody defines body for WorkflowRunUpdateReplay for application/json ContentType.
 type WorkflowRunUpdateReplayJSONRequestBody = ReplayWorkflowRunsRequest
 
+// WorkflowRunUpdateReplayFromStepJSONRequestBody defines body for WorkflowRunUpdateReplayFromStep for application/json ContentType.
+type WorkflowRunUpdateReplayFromStepJSONRequestBody = ReplayWorkflowRunFromStepRequest
+
 // WorkflowRunCancelJSONRequestBody defines body for WorkflowRunCancel for application/json ContentType.
 type WorkflowRunCancelJSONRequestBody = WorkflowRunsCancelRequest
 
+// WorkflowScheduledUpdateJSONRequestBody defines body for WorkflowScheduledUpdate for application/json ContentType.
+type WorkflowScheduledUpdateJSONRequestBody = UpdateScheduledWorkflowRunRequest
+
 // CronWorkflowTriggerCreateJSONRequestBody defines body for CronWorkflowTriggerCreate for application/json ContentType.
 type CronWorkflowTriggerCreateJSONRequestBody = CreateCronWorkflowTriggerRequest
 
@@ -1797,17 +2929,26 @@ type WorkerUpdateJSONRequestBody = UpdateWorkerRequest
 // WorkflowUpdateJSONRequestBody defines body for WorkflowUpdate for application/json ContentType.
 type WorkflowUpdateJSONRequestBody = WorkflowUpdateRequest
 
+// WorkflowUpdateRolloutJSONRequestBody defines body for WorkflowUpdateRollout for application/json ContentType.
+type WorkflowUpdateRolloutJSONRequestBody = UpdateWorkflowRolloutRequest
+
 // WorkflowRunCreateJSONRequestBody defines body for WorkflowRunCreate for application/json ContentType.
 type WorkflowRunCreateJSONRequestBody = TriggerWorkflowRunRequest
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get health
+	// (GET /api/healthz)
+	HealthzGet(ctx echo.Context) error
 	// Get liveness
 	// (GET /api/live)
 	LivenessGet(ctx echo.Context) error
 	// Get readiness
 	// (GET /api/ready)
 	ReadinessGet(ctx echo.Context) error
+	// Get readiness of dependencies
+	// (GET /api/readyz)
+	ReadyzGet(ctx echo.Context) error
 	// Delete tenant alert email group
 	// (DELETE /api/v1/alerting-email-groups/{alert-email-group})
 	AlertEmailGroupDelete(ctx echo.Context, alertEmailGroup openapi_types.UUID) error
@@ -1817,15 +2958,48 @@ type ServerInterface interface {
 	// Revoke API Token
 	// (POST /api/v1/api-tokens/{api-token})
 	ApiTokenUpdateRevoke(ctx echo.Context, apiToken openapi_types.UUID) error
+	// Rotate API Token
+	// (POST /api/v1/api-tokens/{api-token}/rotate)
+	ApiTokenUpdateRotate(ctx echo.Context, apiToken openapi_types.UUID) error
+	// Decide approval with token
+	// (POST /api/v1/approval-tokens/{approval-token}/decide)
+	ApprovalUpdateDecideWithToken(ctx echo.Context, approvalToken string) error
+	// Get approval
+	// (GET /api/v1/approvals/{approval})
+	ApprovalGet(ctx echo.Context, approval openapi_types.UUID) error
+	// Decide approval
+	// (POST /api/v1/approvals/{approval}/decide)
+	ApprovalUpdateDecide(ctx echo.Context, approval openapi_types.UUID) error
+	// Delete client CA
+	// (DELETE /api/v1/client-cas/{client-ca})
+	ClientCaDelete(ctx echo.Context, clientCa openapi_types.UUID) error
 	// Get cloud metadata
 	// (GET /api/v1/cloud/metadata)
 	CloudMetadataGet(ctx echo.Context) error
+	// Delete event dedup rule
+	// (DELETE /api/v1/event-dedup-rules/{event-dedup-rule})
+	EventDedupRuleDelete(ctx echo.Context, eventDedupRule openapi_types.UUID) error
+	// Delete event routing rule
+	// (DELETE /api/v1/event-routing-rules/{event-routing-rule})
+	EventRoutingRuleDelete(ctx echo.Context, eventRoutingRule openapi_types.UUID) error
+	// Delete event sink
+	// (DELETE /api/v1/event-sinks/{event-sink})
+	EventSinkDelete(ctx echo.Context, eventSink openapi_types.UUID) error
+	// Replay dead-lettered records
+	// (POST /api/v1/event-sinks/{event-sink}/replay)
+	EventSinkReplay(ctx echo.Context, eventSink openapi_types.UUID) error
 	// Get event data
 	// (GET /api/v1/events/{event})
 	EventGet(ctx echo.Context, event openapi_types.UUID) error
 	// Get event data
 	// (GET /api/v1/events/{event}/data)
 	EventDataGet(ctx echo.Context, event openapi_types.UUID) error
+	// Delete inbound webhook
+	// (DELETE /api/v1/inbound-webhooks/{inbound-webhook})
+	InboundWebhookDelete(ctx echo.Context, inboundWebhook openapi_types.UUID) error
+	// Receive inbound webhook request
+	// (POST /api/v1/inbound-webhooks/{inbound-webhook})
+	InboundWebhookReceive(ctx echo.Context, inboundWebhook openapi_types.UUID) error
 	// Get metadata
 	// (GET /api/v1/meta)
 	MetadataGet(ctx echo.Context) error
@@ -1841,6 +3015,9 @@ type ServerInterface interface {
 	// Github app tenant webhook
 	// (POST /api/v1/sns/{tenant}/{event})
 	SnsUpdate(ctx echo.Context, tenant openapi_types.UUID, event string) error
+	// Delete SQS integration
+	// (DELETE /api/v1/sqs/{sqs})
+	SqsDelete(ctx echo.Context, sqs openapi_types.UUID) error
 	// List archives for step run
 	// (GET /api/v1/step-runs/{step-run}/archives)
 	StepRunListArchives(ctx echo.Context, stepRun openapi_types.UUID, params StepRunListArchivesParams) error
@@ -1850,6 +3027,12 @@ type ServerInterface interface {
 	// List log lines
 	// (GET /api/v1/step-runs/{step-run}/logs)
 	LogLineList(ctx echo.Context, stepRun openapi_types.UUID, params LogLineListParams) error
+	// Tail log lines
+	// (GET /api/v1/step-runs/{step-run}/logs/stream)
+	LogLineStream(ctx echo.Context, stepRun openapi_types.UUID, params LogLineStreamParams) error
+	// List scheduling decisions for step run
+	// (GET /api/v1/step-runs/{step-run}/scheduling-decisions)
+	StepRunListSchedulingDecisions(ctx echo.Context, stepRun openapi_types.UUID, params StepRunListSchedulingDecisionsParams) error
 	// Create tenant
 	// (POST /api/v1/tenants)
 	TenantCreate(ctx echo.Context) error
@@ -1871,24 +3054,87 @@ type ServerInterface interface {
 	// Create API Token
 	// (POST /api/v1/tenants/{tenant}/api-tokens)
 	ApiTokenCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// List approvals
+	// (GET /api/v1/tenants/{tenant}/approvals)
+	ApprovalList(ctx echo.Context, tenant openapi_types.UUID, params ApprovalListParams) error
+	// List audit log
+	// (GET /api/v1/tenants/{tenant}/audit-logs)
+	AuditLogList(ctx echo.Context, tenant openapi_types.UUID, params AuditLogListParams) error
+	// List client CAs
+	// (GET /api/v1/tenants/{tenant}/client-cas)
+	ClientCaList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create client CA
+	// (POST /api/v1/tenants/{tenant}/client-cas)
+	ClientCaCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// List cron exclusion calendars
+	// (GET /api/v1/tenants/{tenant}/cron-calendars)
+	CronCalendarList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create cron exclusion calendar
+	// (POST /api/v1/tenants/{tenant}/cron-calendars)
+	CronCalendarCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// Delete cron exclusion calendar
+	// (DELETE /api/v1/tenants/{tenant}/cron-calendars/{cron-calendar})
+	CronCalendarDelete(ctx echo.Context, tenant openapi_types.UUID, cronCalendar openapi_types.UUID) error
+	// List dead-letter queue items
+	// (GET /api/v1/tenants/{tenant}/dead-letter-queue)
+	DeadLetterQueueList(ctx echo.Context, tenant openapi_types.UUID, params DeadLetterQueueListParams) error
+	// Purge dead-letter queue items
+	// (POST /api/v1/tenants/{tenant}/dead-letter-queue/purge)
+	DeadLetterQueueDelete(ctx echo.Context, tenant openapi_types.UUID) error
+	// Replay dead-letter queue items
+	// (POST /api/v1/tenants/{tenant}/dead-letter-queue/replay)
+	DeadLetterQueueUpdateReplay(ctx echo.Context, tenant openapi_types.UUID) error
+	// List event dedup rules
+	// (GET /api/v1/tenants/{tenant}/event-dedup-rules)
+	EventDedupRuleList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create or replace event dedup rule
+	// (POST /api/v1/tenants/{tenant}/event-dedup-rules)
+	EventDedupRuleUpsert(ctx echo.Context, tenant openapi_types.UUID) error
+	// List event routing rules
+	// (GET /api/v1/tenants/{tenant}/event-routing-rules)
+	EventRoutingRuleList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create or replace event routing rule
+	// (POST /api/v1/tenants/{tenant}/event-routing-rules)
+	EventRoutingRuleUpsert(ctx echo.Context, tenant openapi_types.UUID) error
+	// List event sinks
+	// (GET /api/v1/tenants/{tenant}/event-sinks)
+	EventSinkList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create event sink
+	// (POST /api/v1/tenants/{tenant}/event-sinks)
+	EventSinkCreate(ctx echo.Context, tenant openapi_types.UUID) error
 	// List events
 	// (GET /api/v1/tenants/{tenant}/events)
 	EventList(ctx echo.Context, tenant openapi_types.UUID, params EventListParams) error
 	// Create event
 	// (POST /api/v1/tenants/{tenant}/events)
 	EventCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// Batch create events
+	// (POST /api/v1/tenants/{tenant}/events/batch)
+	EventCreateBatch(ctx echo.Context, tenant openapi_types.UUID) error
 	// Bulk Create events
 	// (POST /api/v1/tenants/{tenant}/events/bulk)
 	EventCreateBulk(ctx echo.Context, tenant openapi_types.UUID) error
 	// Replay events
 	// (POST /api/v1/tenants/{tenant}/events/cancel)
 	EventUpdateCancel(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create event from CloudEvent
+	// (POST /api/v1/tenants/{tenant}/events/cloudevents)
+	EventCreateCloudEvent(ctx echo.Context, tenant openapi_types.UUID) error
 	// List event keys
 	// (GET /api/v1/tenants/{tenant}/events/keys)
 	EventKeyList(ctx echo.Context, tenant openapi_types.UUID) error
 	// Replay events
 	// (POST /api/v1/tenants/{tenant}/events/replay)
 	EventUpdateReplay(ctx echo.Context, tenant openapi_types.UUID) error
+	// Replay a range of events
+	// (POST /api/v1/tenants/{tenant}/events/replay-range)
+	EventUpdateReplayRange(ctx echo.Context, tenant openapi_types.UUID) error
+	// List inbound webhooks
+	// (GET /api/v1/tenants/{tenant}/inbound-webhooks)
+	InboundWebhookList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create inbound webhook
+	// (POST /api/v1/tenants/{tenant}/inbound-webhooks)
+	InboundWebhookCreate(ctx echo.Context, tenant openapi_types.UUID) error
 	// List tenant invites
 	// (GET /api/v1/tenants/{tenant}/invites)
 	TenantInviteList(ctx echo.Context, tenant openapi_types.UUID) error
@@ -1910,6 +3156,15 @@ type ServerInterface interface {
 	// Get workflow metrics
 	// (GET /api/v1/tenants/{tenant}/queue-metrics)
 	TenantGetQueueMetrics(ctx echo.Context, tenant openapi_types.UUID, params TenantGetQueueMetricsParams) error
+	// Pause queue
+	// (POST /api/v1/tenants/{tenant}/queues/{queue}/pause)
+	QueueUpdatePause(ctx echo.Context, tenant openapi_types.UUID, queue string) error
+	// Resume queue
+	// (POST /api/v1/tenants/{tenant}/queues/{queue}/resume)
+	QueueUpdateResume(ctx echo.Context, tenant openapi_types.UUID, queue string) error
+	// List tenant quotas
+	// (GET /api/v1/tenants/{tenant}/quotas)
+	TenantQuotaList(ctx echo.Context, tenant openapi_types.UUID) error
 	// List rate limits
 	// (GET /api/v1/tenants/{tenant}/rate-limits)
 	RateLimitList(ctx echo.Context, tenant openapi_types.UUID, params RateLimitListParams) error
@@ -1928,6 +3183,12 @@ type ServerInterface interface {
 	// Create SNS integration
 	// (POST /api/v1/tenants/{tenant}/sns)
 	SnsCreate(ctx echo.Context, tenant openapi_types.UUID) error
+	// List SQS integrations
+	// (GET /api/v1/tenants/{tenant}/sqs)
+	SqsList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Create SQS integration
+	// (POST /api/v1/tenants/{tenant}/sqs)
+	SqsCreate(ctx echo.Context, tenant openapi_types.UUID) error
 	// Get step run metrics
 	// (GET /api/v1/tenants/{tenant}/step-run-queue-metrics)
 	TenantGetStepRunQueueMetrics(ctx echo.Context, tenant openapi_types.UUID) error
@@ -1952,6 +3213,9 @@ type ServerInterface interface {
 	// Get workers
 	// (GET /api/v1/tenants/{tenant}/worker)
 	WorkerList(ctx echo.Context, tenant openapi_types.UUID) error
+	// Get worker pools
+	// (GET /api/v1/tenants/{tenant}/worker-pools)
+	WorkerPoolList(ctx echo.Context, tenant openapi_types.UUID) error
 	// Replay workflow runs
 	// (POST /api/v1/tenants/{tenant}/workflow-runs/replay)
 	WorkflowRunUpdateReplay(ctx echo.Context, tenant openapi_types.UUID) error
@@ -1961,12 +3225,24 @@ type ServerInterface interface {
 	// Get workflow run input
 	// (GET /api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/input)
 	WorkflowRunGetInput(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error
+	// Pause workflow run
+	// (POST /api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/pause)
+	WorkflowRunUpdatePause(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error
+	// Replay workflow run from step
+	// (POST /api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/replay-from-step)
+	WorkflowRunUpdateReplayFromStep(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error
+	// Resume workflow run
+	// (POST /api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/resume)
+	WorkflowRunUpdateResume(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error
 	// Get workflow run
 	// (GET /api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/shape)
 	WorkflowRunGetShape(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error
 	// List events for all step runs for a workflow run
 	// (GET /api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/step-run-events)
 	WorkflowRunListStepRunEvents(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params WorkflowRunListStepRunEventsParams) error
+	// Stream workflow run events
+	// (GET /api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/stream)
+	WorkflowRunGetStream(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error
 	// Get workflows
 	// (GET /api/v1/tenants/{tenant}/workflows)
 	WorkflowList(ctx echo.Context, tenant openapi_types.UUID) error
@@ -1997,6 +3273,9 @@ type ServerInterface interface {
 	// Get scheduled workflow run
 	// (GET /api/v1/tenants/{tenant}/workflows/scheduled/{scheduled-workflow-run})
 	WorkflowScheduledGet(ctx echo.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID) error
+	// Reschedule scheduled workflow run
+	// (PATCH /api/v1/tenants/{tenant}/workflows/scheduled/{scheduled-workflow-run})
+	WorkflowScheduledUpdate(ctx echo.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID) error
 	// Create cron job workflow trigger
 	// (POST /api/v1/tenants/{tenant}/workflows/{workflow}/crons)
 	CronWorkflowTriggerCreate(ctx echo.Context, tenant openapi_types.UUID, workflow string) error
@@ -2039,6 +3318,12 @@ type ServerInterface interface {
 	// List tenant memberships
 	// (GET /api/v1/users/memberships)
 	TenantMembershipsList(ctx echo.Context) error
+	// Complete OpenID Connect flow
+	// (GET /api/v1/users/oidc/callback)
+	UserUpdateOidcCallback(ctx echo.Context) error
+	// Start OpenID Connect flow
+	// (GET /api/v1/users/oidc/start)
+	UserUpdateOidcStart(ctx echo.Context) error
 	// Change user password
 	// (POST /api/v1/users/password)
 	UserUpdatePassword(ctx echo.Context) error
@@ -2069,9 +3354,21 @@ type ServerInterface interface {
 	// Update workflow
 	// (PATCH /api/v1/workflows/{workflow})
 	WorkflowUpdate(ctx echo.Context, workflow openapi_types.UUID) error
+	// List concurrency groups
+	// (GET /api/v1/workflows/{workflow}/concurrency-groups)
+	WorkflowListConcurrencyGroups(ctx echo.Context, workflow openapi_types.UUID, params WorkflowListConcurrencyGroupsParams) error
 	// Get workflow metrics
 	// (GET /api/v1/workflows/{workflow}/metrics)
 	WorkflowGetMetrics(ctx echo.Context, workflow openapi_types.UUID, params WorkflowGetMetricsParams) error
+	// Delete workflow rollout
+	// (DELETE /api/v1/workflows/{workflow}/rollout)
+	WorkflowDeleteRollout(ctx echo.Context, workflow openapi_types.UUID) error
+	// Get workflow rollout
+	// (GET /api/v1/workflows/{workflow}/rollout)
+	WorkflowGetRollout(ctx echo.Context, workflow openapi_types.UUID) error
+	// Update workflow rollout
+	// (PUT /api/v1/workflows/{workflow}/rollout)
+	WorkflowUpdateRollout(ctx echo.Context, workflow openapi_types.UUID) error
 	// Trigger workflow run
 	// (POST /api/v1/workflows/{workflow}/trigger)
 	WorkflowRunCreate(ctx echo.Context, workflow openapi_types.UUID, params WorkflowRunCreateParams) error
@@ -2085,6 +3382,15 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// HealthzGet converts echo context to params.
+func (w *ServerInterfaceWrapper) HealthzGet(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HealthzGet(ctx)
+	return err
+}
+
 // LivenessGet converts echo context to params.
 func (w *ServerInterfaceWrapper) LivenessGet(ctx echo.Context) error {
 	var err error
@@ -2103,6 +3409,15 @@ func (w *ServerInterfaceWrapper) ReadinessGet(ctx echo.Context) error {
 	return err
 }
 
+// ReadyzGet converts echo context to params.
+func (w *ServerInterfaceWrapper) ReadyzGet(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReadyzGet(ctx)
+	return err
+}
+
 // AlertEmailGroupDelete converts echo context to params.
 func (w *ServerInterfaceWrapper) AlertEmailGroupDelete(ctx echo.Context) error {
 	var err error
@@ -2163,24 +3478,51 @@ func (w *ServerInterfaceWrapper) ApiTokenUpdateRevoke(ctx echo.Context) error {
 	return err
 }
 
-// CloudMetadataGet converts echo context to params.
-func (w *ServerInterfaceWrapper) CloudMetadataGet(ctx echo.Context) error {
+// ApiTokenUpdateRotate converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiTokenUpdateRotate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "api-token" -------------
+	var apiToken openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "api-token", runtime.ParamLocationPath, ctx.Param("api-token"), &apiToken)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter api-token: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiTokenUpdateRotate(ctx, apiToken)
+	return err
+}
+
+// ApprovalUpdateDecideWithToken converts echo context to params.
+func (w *ServerInterfaceWrapper) ApprovalUpdateDecideWithToken(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "approval-token" -------------
+	var approvalToken string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "approval-token", runtime.ParamLocationPath, ctx.Param("approval-token"), &approvalToken)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter approval-token: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CloudMetadataGet(ctx)
+	err = w.Handler.ApprovalUpdateDecideWithToken(ctx, approvalToken)
 	return err
 }
 
-// EventGet converts echo context to params.
-func (w *ServerInterfaceWrapper) EventGet(ctx echo.Context) error {
+// ApprovalGet converts echo context to params.
+func (w *ServerInterfaceWrapper) ApprovalGet(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "event" -------------
-	var event openapi_types.UUID
+	// ------------- Path parameter "approval" -------------
+	var approval openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "event", runtime.ParamLocationPath, ctx.Param("event"), &event)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "approval", runtime.ParamLocationPath, ctx.Param("approval"), &approval)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter approval: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -2188,19 +3530,19 @@ func (w *ServerInterfaceWrapper) EventGet(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventGet(ctx, event)
+	err = w.Handler.ApprovalGet(ctx, approval)
 	return err
 }
 
-// EventDataGet converts echo context to params.
-func (w *ServerInterfaceWrapper) EventDataGet(ctx echo.Context) error {
+// ApprovalUpdateDecide converts echo context to params.
+func (w *ServerInterfaceWrapper) ApprovalUpdateDecide(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "event" -------------
-	var event openapi_types.UUID
+	// ------------- Path parameter "approval" -------------
+	var approval openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "event", runtime.ParamLocationPath, ctx.Param("event"), &event)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "approval", runtime.ParamLocationPath, ctx.Param("approval"), &approval)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter approval: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -2208,16 +3550,201 @@ func (w *ServerInterfaceWrapper) EventDataGet(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventDataGet(ctx, event)
+	err = w.Handler.ApprovalUpdateDecide(ctx, approval)
 	return err
 }
 
-// MetadataGet converts echo context to params.
-func (w *ServerInterfaceWrapper) MetadataGet(ctx echo.Context) error {
+// ClientCaDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) ClientCaDelete(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "client-ca" -------------
+	var clientCa openapi_types.UUID
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.MetadataGet(ctx)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "client-ca", runtime.ParamLocationPath, ctx.Param("client-ca"), &clientCa)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter client-ca: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ClientCaDelete(ctx, clientCa)
+	return err
+}
+
+// CloudMetadataGet converts echo context to params.
+func (w *ServerInterfaceWrapper) CloudMetadataGet(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CloudMetadataGet(ctx)
+	return err
+}
+
+// EventDedupRuleDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) EventDedupRuleDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "event-dedup-rule" -------------
+	var eventDedupRule openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "event-dedup-rule", runtime.ParamLocationPath, ctx.Param("event-dedup-rule"), &eventDedupRule)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event-dedup-rule: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EventDedupRuleDelete(ctx, eventDedupRule)
+	return err
+}
+
+// EventRoutingRuleDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) EventRoutingRuleDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "event-routing-rule" -------------
+	var eventRoutingRule openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "event-routing-rule", runtime.ParamLocationPath, ctx.Param("event-routing-rule"), &eventRoutingRule)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event-routing-rule: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EventRoutingRuleDelete(ctx, eventRoutingRule)
+	return err
+}
+
+// EventSinkDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) EventSinkDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "event-sink" -------------
+	var eventSink openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "event-sink", runtime.ParamLocationPath, ctx.Param("event-sink"), &eventSink)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event-sink: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EventSinkDelete(ctx, eventSink)
+	return err
+}
+
+// EventSinkReplay converts echo context to params.
+func (w *ServerInterfaceWrapper) EventSinkReplay(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "event-sink" -------------
+	var eventSink openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "event-sink", runtime.ParamLocationPath, ctx.Param("event-sink"), &eventSink)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event-sink: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EventSinkReplay(ctx, eventSink)
+	return err
+}
+
+// EventGet converts echo context to params.
+func (w *ServerInterfaceWrapper) EventGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "event" -------------
+	var event openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "event", runtime.ParamLocationPath, ctx.Param("event"), &event)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EventGet(ctx, event)
+	return err
+}
+
+// EventDataGet converts echo context to params.
+func (w *ServerInterfaceWrapper) EventDataGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "event" -------------
+	var event openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "event", runtime.ParamLocationPath, ctx.Param("event"), &event)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EventDataGet(ctx, event)
+	return err
+}
+
+// InboundWebhookDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) InboundWebhookDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "inbound-webhook" -------------
+	var inboundWebhook openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "inbound-webhook", runtime.ParamLocationPath, ctx.Param("inbound-webhook"), &inboundWebhook)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter inbound-webhook: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.InboundWebhookDelete(ctx, inboundWebhook)
+	return err
+}
+
+// InboundWebhookReceive converts echo context to params.
+func (w *ServerInterfaceWrapper) InboundWebhookReceive(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "inbound-webhook" -------------
+	var inboundWebhook openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "inbound-webhook", runtime.ParamLocationPath, ctx.Param("inbound-webhook"), &inboundWebhook)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter inbound-webhook: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.InboundWebhookReceive(ctx, inboundWebhook)
+	return err
+}
+
+// MetadataGet converts echo context to params.
+func (w *ServerInterfaceWrapper) MetadataGet(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MetadataGet(ctx)
 	return err
 }
 
@@ -2298,6 +3825,26 @@ func (w *ServerInterfaceWrapper) SnsUpdate(ctx echo.Context) error {
 	return err
 }
 
+// SqsDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) SqsDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sqs" -------------
+	var sqs openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "sqs", runtime.ParamLocationPath, ctx.Param("sqs"), &sqs)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sqs: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SqsDelete(ctx, sqs)
+	return err
+}
+
 // StepRunListArchives converts echo context to params.
 func (w *ServerInterfaceWrapper) StepRunListArchives(ctx echo.Context) error {
 	var err error
@@ -2415,6 +3962,13 @@ func (w *ServerInterfaceWrapper) LogLineList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
 	}
 
+	// ------------- Optional query parameter "afterId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "afterId", ctx.QueryParams(), &params.AfterId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter afterId: %s", err))
+	}
+
 	// ------------- Optional query parameter "orderByField" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
@@ -2434,6 +3988,71 @@ func (w *ServerInterfaceWrapper) LogLineList(ctx echo.Context) error {
 	return err
 }
 
+// LogLineStream converts echo context to params.
+func (w *ServerInterfaceWrapper) LogLineStream(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LogLineStreamParams
+	// ------------- Optional query parameter "levels" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "levels", ctx.QueryParams(), &params.Levels)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter levels: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LogLineStream(ctx, stepRun, params)
+	return err
+}
+
+// StepRunListSchedulingDecisions converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunListSchedulingDecisions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StepRunListSchedulingDecisionsParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StepRunListSchedulingDecisions(ctx, stepRun, params)
+	return err
+}
+
 // TenantCreate converts echo context to params.
 func (w *ServerInterfaceWrapper) TenantCreate(ctx echo.Context) error {
 	var err error
@@ -2567,8 +4186,8 @@ func (w *ServerInterfaceWrapper) ApiTokenCreate(ctx echo.Context) error {
 	return err
 }
 
-// EventList converts echo context to params.
-func (w *ServerInterfaceWrapper) EventList(ctx echo.Context) error {
+// ApprovalList converts echo context to params.
+func (w *ServerInterfaceWrapper) ApprovalList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -2583,7 +4202,7 @@ func (w *ServerInterfaceWrapper) EventList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params EventListParams
+	var params ApprovalListParams
 	// ------------- Optional query parameter "offset" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
@@ -2598,69 +4217,98 @@ func (w *ServerInterfaceWrapper) EventList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Optional query parameter "keys" -------------
+	// ------------- Optional query parameter "statuses" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "keys", ctx.QueryParams(), &params.Keys)
+	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keys: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
 	}
 
-	// ------------- Optional query parameter "workflows" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApprovalList(ctx, tenant, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "workflows", ctx.QueryParams(), &params.Workflows)
+// AuditLogList converts echo context to params.
+func (w *ServerInterfaceWrapper) AuditLogList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflows: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "statuses" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AuditLogListParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Optional query parameter "search" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Optional query parameter "orderByField" -------------
+	// ------------- Optional query parameter "actorId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
+	err = runtime.BindQueryParameter("form", true, false, "actorId", ctx.QueryParams(), &params.ActorId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter actorId: %s", err))
 	}
 
-	// ------------- Optional query parameter "orderByDirection" -------------
+	// ------------- Optional query parameter "operation" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
+	err = runtime.BindQueryParameter("form", true, false, "operation", ctx.QueryParams(), &params.Operation)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter operation: %s", err))
 	}
 
-	// ------------- Optional query parameter "additionalMetadata" -------------
+	// ------------- Optional query parameter "resourceType" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	err = runtime.BindQueryParameter("form", true, false, "resourceType", ctx.QueryParams(), &params.ResourceType)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceType: %s", err))
 	}
 
-	// ------------- Optional query parameter "eventIds" -------------
+	// ------------- Optional query parameter "resourceId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "eventIds", ctx.QueryParams(), &params.EventIds)
+	err = runtime.BindQueryParameter("form", true, false, "resourceId", ctx.QueryParams(), &params.ResourceId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventIds: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resourceId: %s", err))
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventList(ctx, tenant, params)
+	err = w.Handler.AuditLogList(ctx, tenant, params)
 	return err
 }
 
-// EventCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) EventCreate(ctx echo.Context) error {
+// ClientCaList converts echo context to params.
+func (w *ServerInterfaceWrapper) ClientCaList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -2675,12 +4323,12 @@ func (w *ServerInterfaceWrapper) EventCreate(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventCreate(ctx, tenant)
+	err = w.Handler.ClientCaList(ctx, tenant)
 	return err
 }
 
-// EventCreateBulk converts echo context to params.
-func (w *ServerInterfaceWrapper) EventCreateBulk(ctx echo.Context) error {
+// ClientCaCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ClientCaCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -2695,12 +4343,12 @@ func (w *ServerInterfaceWrapper) EventCreateBulk(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventCreateBulk(ctx, tenant)
+	err = w.Handler.ClientCaCreate(ctx, tenant)
 	return err
 }
 
-// EventUpdateCancel converts echo context to params.
-func (w *ServerInterfaceWrapper) EventUpdateCancel(ctx echo.Context) error {
+// CronCalendarList converts echo context to params.
+func (w *ServerInterfaceWrapper) CronCalendarList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -2715,12 +4363,12 @@ func (w *ServerInterfaceWrapper) EventUpdateCancel(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventUpdateCancel(ctx, tenant)
+	err = w.Handler.CronCalendarList(ctx, tenant)
 	return err
 }
 
-// EventKeyList converts echo context to params.
-func (w *ServerInterfaceWrapper) EventKeyList(ctx echo.Context) error {
+// CronCalendarCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) CronCalendarCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -2735,12 +4383,12 @@ func (w *ServerInterfaceWrapper) EventKeyList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventKeyList(ctx, tenant)
+	err = w.Handler.CronCalendarCreate(ctx, tenant)
 	return err
 }
 
-// EventUpdateReplay converts echo context to params.
-func (w *ServerInterfaceWrapper) EventUpdateReplay(ctx echo.Context) error {
+// CronCalendarDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) CronCalendarDelete(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -2750,17 +4398,25 @@ func (w *ServerInterfaceWrapper) EventUpdateReplay(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	// ------------- Path parameter "cron-calendar" -------------
+	var cronCalendar openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "cron-calendar", runtime.ParamLocationPath, ctx.Param("cron-calendar"), &cronCalendar)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cron-calendar: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EventUpdateReplay(ctx, tenant)
+	err = w.Handler.CronCalendarDelete(ctx, tenant, cronCalendar)
 	return err
 }
 
-// TenantInviteList converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteList(ctx echo.Context) error {
+// DeadLetterQueueList converts echo context to params.
+func (w *ServerInterfaceWrapper) DeadLetterQueueList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -2774,13 +4430,29 @@ func (w *ServerInterfaceWrapper) TenantInviteList(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeadLetterQueueListParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteList(ctx, tenant)
+	err = w.Handler.DeadLetterQueueList(ctx, tenant, params)
 	return err
 }
 
-// TenantInviteCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteCreate(ctx echo.Context) error {
+// DeadLetterQueueDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) DeadLetterQueueDelete(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -2795,12 +4467,12 @@ func (w *ServerInterfaceWrapper) TenantInviteCreate(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteCreate(ctx, tenant)
+	err = w.Handler.DeadLetterQueueDelete(ctx, tenant)
 	return err
 }
 
-// TenantInviteDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteDelete(ctx echo.Context) error {
+// DeadLetterQueueUpdateReplay converts echo context to params.
+func (w *ServerInterfaceWrapper) DeadLetterQueueUpdateReplay(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -2810,25 +4482,17 @@ func (w *ServerInterfaceWrapper) TenantInviteDelete(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "tenant-invite" -------------
-	var tenantInvite openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, ctx.Param("tenant-invite"), &tenantInvite)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-invite: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteDelete(ctx, tenant, tenantInvite)
+	err = w.Handler.DeadLetterQueueUpdateReplay(ctx, tenant)
 	return err
 }
 
-// TenantInviteUpdate converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteUpdate(ctx echo.Context) error {
+// EventDedupRuleList converts echo context to params.
+func (w *ServerInterfaceWrapper) EventDedupRuleList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -2838,25 +4502,17 @@ func (w *ServerInterfaceWrapper) TenantInviteUpdate(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "tenant-invite" -------------
-	var tenantInvite openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, ctx.Param("tenant-invite"), &tenantInvite)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-invite: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteUpdate(ctx, tenant, tenantInvite)
+	err = w.Handler.EventDedupRuleList(ctx, tenant)
 	return err
 }
 
-// TenantMemberList converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantMemberList(ctx echo.Context) error {
+// EventDedupRuleUpsert converts echo context to params.
+func (w *ServerInterfaceWrapper) EventDedupRuleUpsert(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -2871,12 +4527,12 @@ func (w *ServerInterfaceWrapper) TenantMemberList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantMemberList(ctx, tenant)
+	err = w.Handler.EventDedupRuleUpsert(ctx, tenant)
 	return err
 }
 
-// TenantMemberDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantMemberDelete(ctx echo.Context) error {
+// EventRoutingRuleList converts echo context to params.
+func (w *ServerInterfaceWrapper) EventRoutingRuleList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -2886,12 +4542,24 @@ func (w *ServerInterfaceWrapper) TenantMemberDelete(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "member" -------------
-	var member openapi_types.UUID
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "member", runtime.ParamLocationPath, ctx.Param("member"), &member)
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EventRoutingRuleList(ctx, tenant)
+	return err
+}
+
+// EventRoutingRuleUpsert converts echo context to params.
+func (w *ServerInterfaceWrapper) EventRoutingRuleUpsert(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter member: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -2899,12 +4567,12 @@ func (w *ServerInterfaceWrapper) TenantMemberDelete(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantMemberDelete(ctx, tenant, member)
+	err = w.Handler.EventRoutingRuleUpsert(ctx, tenant)
 	return err
 }
 
-// TenantGetQueueMetrics converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantGetQueueMetrics(ctx echo.Context) error {
+// EventSinkList converts echo context to params.
+func (w *ServerInterfaceWrapper) EventSinkList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -2918,29 +4586,33 @@ func (w *ServerInterfaceWrapper) TenantGetQueueMetrics(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params TenantGetQueueMetricsParams
-	// ------------- Optional query parameter "workflows" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EventSinkList(ctx, tenant)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "workflows", ctx.QueryParams(), &params.Workflows)
+// EventSinkCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) EventSinkCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflows: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "additionalMetadata" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantGetQueueMetrics(ctx, tenant, params)
+	err = w.Handler.EventSinkCreate(ctx, tenant)
 	return err
 }
 
-// RateLimitList converts echo context to params.
-func (w *ServerInterfaceWrapper) RateLimitList(ctx echo.Context) error {
+// EventList converts echo context to params.
+func (w *ServerInterfaceWrapper) EventList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -2955,7 +4627,7 @@ func (w *ServerInterfaceWrapper) RateLimitList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RateLimitListParams
+	var params EventListParams
 	// ------------- Optional query parameter "offset" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
@@ -2970,6 +4642,27 @@ func (w *ServerInterfaceWrapper) RateLimitList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
+	// ------------- Optional query parameter "keys" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "keys", ctx.QueryParams(), &params.Keys)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keys: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflows" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflows", ctx.QueryParams(), &params.Workflows)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflows: %s", err))
+	}
+
+	// ------------- Optional query parameter "statuses" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
+	}
+
 	// ------------- Optional query parameter "search" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
@@ -2991,13 +4684,27 @@ func (w *ServerInterfaceWrapper) RateLimitList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
 	}
 
+	// ------------- Optional query parameter "additionalMetadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+	}
+
+	// ------------- Optional query parameter "eventIds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "eventIds", ctx.QueryParams(), &params.EventIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventIds: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RateLimitList(ctx, tenant, params)
+	err = w.Handler.EventList(ctx, tenant, params)
 	return err
 }
 
-// TenantResourcePolicyGet converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantResourcePolicyGet(ctx echo.Context) error {
+// EventCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) EventCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3012,12 +4719,12 @@ func (w *ServerInterfaceWrapper) TenantResourcePolicyGet(ctx echo.Context) error
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantResourcePolicyGet(ctx, tenant)
+	err = w.Handler.EventCreate(ctx, tenant)
 	return err
 }
 
-// SlackWebhookList converts echo context to params.
-func (w *ServerInterfaceWrapper) SlackWebhookList(ctx echo.Context) error {
+// EventCreateBatch converts echo context to params.
+func (w *ServerInterfaceWrapper) EventCreateBatch(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3032,12 +4739,12 @@ func (w *ServerInterfaceWrapper) SlackWebhookList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SlackWebhookList(ctx, tenant)
+	err = w.Handler.EventCreateBatch(ctx, tenant)
 	return err
 }
 
-// UserUpdateSlackOauthStart converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateSlackOauthStart(ctx echo.Context) error {
+// EventCreateBulk converts echo context to params.
+func (w *ServerInterfaceWrapper) EventCreateBulk(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3047,15 +4754,17 @@ func (w *ServerInterfaceWrapper) UserUpdateSlackOauthStart(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateSlackOauthStart(ctx, tenant)
+	err = w.Handler.EventCreateBulk(ctx, tenant)
 	return err
 }
 
-// SnsList converts echo context to params.
-func (w *ServerInterfaceWrapper) SnsList(ctx echo.Context) error {
+// EventUpdateCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) EventUpdateCancel(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3070,12 +4779,12 @@ func (w *ServerInterfaceWrapper) SnsList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SnsList(ctx, tenant)
+	err = w.Handler.EventUpdateCancel(ctx, tenant)
 	return err
 }
 
-// SnsCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) SnsCreate(ctx echo.Context) error {
+// EventCreateCloudEvent converts echo context to params.
+func (w *ServerInterfaceWrapper) EventCreateCloudEvent(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3090,12 +4799,12 @@ func (w *ServerInterfaceWrapper) SnsCreate(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SnsCreate(ctx, tenant)
+	err = w.Handler.EventCreateCloudEvent(ctx, tenant)
 	return err
 }
 
-// TenantGetStepRunQueueMetrics converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantGetStepRunQueueMetrics(ctx echo.Context) error {
+// EventKeyList converts echo context to params.
+func (w *ServerInterfaceWrapper) EventKeyList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3110,12 +4819,12 @@ func (w *ServerInterfaceWrapper) TenantGetStepRunQueueMetrics(ctx echo.Context)
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantGetStepRunQueueMetrics(ctx, tenant)
+	err = w.Handler.EventKeyList(ctx, tenant)
 	return err
 }
 
-// StepRunGet converts echo context to params.
-func (w *ServerInterfaceWrapper) StepRunGet(ctx echo.Context) error {
+// EventUpdateReplay converts echo context to params.
+func (w *ServerInterfaceWrapper) EventUpdateReplay(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3125,25 +4834,17 @@ func (w *ServerInterfaceWrapper) StepRunGet(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "step-run" -------------
-	var stepRun openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.StepRunGet(ctx, tenant, stepRun)
+	err = w.Handler.EventUpdateReplay(ctx, tenant)
 	return err
 }
 
-// StepRunUpdateCancel converts echo context to params.
-func (w *ServerInterfaceWrapper) StepRunUpdateCancel(ctx echo.Context) error {
+// EventUpdateReplayRange converts echo context to params.
+func (w *ServerInterfaceWrapper) EventUpdateReplayRange(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3153,25 +4854,17 @@ func (w *ServerInterfaceWrapper) StepRunUpdateCancel(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "step-run" -------------
-	var stepRun openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.StepRunUpdateCancel(ctx, tenant, stepRun)
+	err = w.Handler.EventUpdateReplayRange(ctx, tenant)
 	return err
 }
 
-// StepRunUpdateRerun converts echo context to params.
-func (w *ServerInterfaceWrapper) StepRunUpdateRerun(ctx echo.Context) error {
+// InboundWebhookList converts echo context to params.
+func (w *ServerInterfaceWrapper) InboundWebhookList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3181,25 +4874,17 @@ func (w *ServerInterfaceWrapper) StepRunUpdateRerun(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "step-run" -------------
-	var stepRun openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.StepRunUpdateRerun(ctx, tenant, stepRun)
+	err = w.Handler.InboundWebhookList(ctx, tenant)
 	return err
 }
 
-// StepRunGetSchema converts echo context to params.
-func (w *ServerInterfaceWrapper) StepRunGetSchema(ctx echo.Context) error {
+// InboundWebhookCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) InboundWebhookCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3209,25 +4894,17 @@ func (w *ServerInterfaceWrapper) StepRunGetSchema(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "step-run" -------------
-	var stepRun openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.StepRunGetSchema(ctx, tenant, stepRun)
+	err = w.Handler.InboundWebhookCreate(ctx, tenant)
 	return err
 }
 
-// WebhookList converts echo context to params.
-func (w *ServerInterfaceWrapper) WebhookList(ctx echo.Context) error {
+// TenantInviteList converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3242,12 +4919,12 @@ func (w *ServerInterfaceWrapper) WebhookList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WebhookList(ctx, tenant)
+	err = w.Handler.TenantInviteList(ctx, tenant)
 	return err
 }
 
-// WebhookCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) WebhookCreate(ctx echo.Context) error {
+// TenantInviteCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3262,12 +4939,12 @@ func (w *ServerInterfaceWrapper) WebhookCreate(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WebhookCreate(ctx, tenant)
+	err = w.Handler.TenantInviteCreate(ctx, tenant)
 	return err
 }
 
-// WorkerList converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkerList(ctx echo.Context) error {
+// TenantInviteDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteDelete(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3277,17 +4954,25 @@ func (w *ServerInterfaceWrapper) WorkerList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	// ------------- Path parameter "tenant-invite" -------------
+	var tenantInvite openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, ctx.Param("tenant-invite"), &tenantInvite)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-invite: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkerList(ctx, tenant)
+	err = w.Handler.TenantInviteDelete(ctx, tenant, tenantInvite)
 	return err
 }
 
-// WorkflowRunUpdateReplay converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunUpdateReplay(ctx echo.Context) error {
+// TenantInviteUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteUpdate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3297,17 +4982,25 @@ func (w *ServerInterfaceWrapper) WorkflowRunUpdateReplay(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	// ------------- Path parameter "tenant-invite" -------------
+	var tenantInvite openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, ctx.Param("tenant-invite"), &tenantInvite)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant-invite: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunUpdateReplay(ctx, tenant)
+	err = w.Handler.TenantInviteUpdate(ctx, tenant, tenantInvite)
 	return err
 }
 
-// WorkflowRunGet converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunGet(ctx echo.Context) error {
+// TenantMemberList converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantMemberList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3317,25 +5010,17 @@ func (w *ServerInterfaceWrapper) WorkflowRunGet(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "workflow-run" -------------
-	var workflowRun openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunGet(ctx, tenant, workflowRun)
+	err = w.Handler.TenantMemberList(ctx, tenant)
 	return err
 }
 
-// WorkflowRunGetInput converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunGetInput(ctx echo.Context) error {
+// TenantMemberDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantMemberDelete(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3345,12 +5030,12 @@ func (w *ServerInterfaceWrapper) WorkflowRunGetInput(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "workflow-run" -------------
-	var workflowRun openapi_types.UUID
+	// ------------- Path parameter "member" -------------
+	var member openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "member", runtime.ParamLocationPath, ctx.Param("member"), &member)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter member: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -3358,12 +5043,12 @@ func (w *ServerInterfaceWrapper) WorkflowRunGetInput(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunGetInput(ctx, tenant, workflowRun)
+	err = w.Handler.TenantMemberDelete(ctx, tenant, member)
 	return err
 }
 
-// WorkflowRunGetShape converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunGetShape(ctx echo.Context) error {
+// TenantGetQueueMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantGetQueueMetrics(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3373,25 +5058,40 @@ func (w *ServerInterfaceWrapper) WorkflowRunGetShape(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "workflow-run" -------------
-	var workflowRun openapi_types.UUID
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TenantGetQueueMetricsParams
+	// ------------- Optional query parameter "workflows" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflows", ctx.QueryParams(), &params.Workflows)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflows: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "additionalMetadata" -------------
 
-	ctx.Set(CookieAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+	}
+
+	// ------------- Optional query parameter "window" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "window", ctx.QueryParams(), &params.Window)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter window: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunGetShape(ctx, tenant, workflowRun)
+	err = w.Handler.TenantGetQueueMetrics(ctx, tenant, params)
 	return err
 }
 
-// WorkflowRunListStepRunEvents converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunListStepRunEvents(ctx echo.Context) error {
+// QueueUpdatePause converts echo context to params.
+func (w *ServerInterfaceWrapper) QueueUpdatePause(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3401,34 +5101,25 @@ func (w *ServerInterfaceWrapper) WorkflowRunListStepRunEvents(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "workflow-run" -------------
-	var workflowRun openapi_types.UUID
+	// ------------- Path parameter "queue" -------------
+	var queue string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "queue", runtime.ParamLocationPath, ctx.Param("queue"), &queue)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter queue: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowRunListStepRunEventsParams
-	// ------------- Optional query parameter "lastId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "lastId", ctx.QueryParams(), &params.LastId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lastId: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunListStepRunEvents(ctx, tenant, workflowRun, params)
+	err = w.Handler.QueueUpdatePause(ctx, tenant, queue)
 	return err
 }
 
-// WorkflowList converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowList(ctx echo.Context) error {
+// QueueUpdateResume converts echo context to params.
+func (w *ServerInterfaceWrapper) QueueUpdateResume(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3438,17 +5129,25 @@ func (w *ServerInterfaceWrapper) WorkflowList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
+	// ------------- Path parameter "queue" -------------
+	var queue string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "queue", runtime.ParamLocationPath, ctx.Param("queue"), &queue)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter queue: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowList(ctx, tenant)
+	err = w.Handler.QueueUpdateResume(ctx, tenant, queue)
 	return err
 }
 
-// WorkflowRunCancel converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunCancel(ctx echo.Context) error {
+// TenantQuotaList converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantQuotaList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3463,12 +5162,12 @@ func (w *ServerInterfaceWrapper) WorkflowRunCancel(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunCancel(ctx, tenant)
+	err = w.Handler.TenantQuotaList(ctx, tenant)
 	return err
 }
 
-// CronWorkflowList converts echo context to params.
-func (w *ServerInterfaceWrapper) CronWorkflowList(ctx echo.Context) error {
+// RateLimitList converts echo context to params.
+func (w *ServerInterfaceWrapper) RateLimitList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3483,7 +5182,7 @@ func (w *ServerInterfaceWrapper) CronWorkflowList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params CronWorkflowListParams
+	var params RateLimitListParams
 	// ------------- Optional query parameter "offset" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
@@ -3498,18 +5197,11 @@ func (w *ServerInterfaceWrapper) CronWorkflowList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Optional query parameter "workflowId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
-	}
-
-	// ------------- Optional query parameter "additionalMetadata" -------------
+	// ------------- Optional query parameter "search" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	err = runtime.BindQueryParameter("form", true, false, "search", ctx.QueryParams(), &params.Search)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
 	}
 
 	// ------------- Optional query parameter "orderByField" -------------
@@ -3527,12 +5219,12 @@ func (w *ServerInterfaceWrapper) CronWorkflowList(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CronWorkflowList(ctx, tenant, params)
+	err = w.Handler.RateLimitList(ctx, tenant, params)
 	return err
 }
 
-// WorkflowCronDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowCronDelete(ctx echo.Context) error {
+// TenantResourcePolicyGet converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantResourcePolicyGet(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3542,12 +5234,24 @@ func (w *ServerInterfaceWrapper) WorkflowCronDelete(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "cron-workflow" -------------
-	var cronWorkflow openapi_types.UUID
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, ctx.Param("cron-workflow"), &cronWorkflow)
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantResourcePolicyGet(ctx, tenant)
+	return err
+}
+
+// SlackWebhookList converts echo context to params.
+func (w *ServerInterfaceWrapper) SlackWebhookList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cron-workflow: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -3555,12 +5259,12 @@ func (w *ServerInterfaceWrapper) WorkflowCronDelete(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowCronDelete(ctx, tenant, cronWorkflow)
+	err = w.Handler.SlackWebhookList(ctx, tenant)
 	return err
 }
 
-// WorkflowCronGet converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowCronGet(ctx echo.Context) error {
+// UserUpdateSlackOauthStart converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateSlackOauthStart(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3570,12 +5274,22 @@ func (w *ServerInterfaceWrapper) WorkflowCronGet(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "cron-workflow" -------------
-	var cronWorkflow openapi_types.UUID
+	ctx.Set(CookieAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, ctx.Param("cron-workflow"), &cronWorkflow)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateSlackOauthStart(ctx, tenant)
+	return err
+}
+
+// SnsList converts echo context to params.
+func (w *ServerInterfaceWrapper) SnsList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cron-workflow: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -3583,12 +5297,12 @@ func (w *ServerInterfaceWrapper) WorkflowCronGet(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowCronGet(ctx, tenant, cronWorkflow)
+	err = w.Handler.SnsList(ctx, tenant)
 	return err
 }
 
-// WorkflowRunList converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunList(ctx echo.Context) error {
+// SnsCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) SnsCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3602,120 +5316,129 @@ func (w *ServerInterfaceWrapper) WorkflowRunList(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowRunListParams
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SnsCreate(ctx, tenant)
+	return err
+}
 
-	// ------------- Optional query parameter "limit" -------------
+// SqsList converts echo context to params.
+func (w *ServerInterfaceWrapper) SqsList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "eventId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "eventId", ctx.QueryParams(), &params.EventId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventId: %s", err))
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
-	// ------------- Optional query parameter "workflowId" -------------
+	ctx.Set(CookieAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SqsList(ctx, tenant)
+	return err
+}
 
-	// ------------- Optional query parameter "parentWorkflowRunId" -------------
+// SqsCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) SqsCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "parentWorkflowRunId", ctx.QueryParams(), &params.ParentWorkflowRunId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentWorkflowRunId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "parentStepRunId" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "parentStepRunId", ctx.QueryParams(), &params.ParentStepRunId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentStepRunId: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
-	// ------------- Optional query parameter "statuses" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SqsCreate(ctx, tenant)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
+// TenantGetStepRunQueueMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantGetStepRunQueueMetrics(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "kinds" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "kinds", ctx.QueryParams(), &params.Kinds)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kinds: %s", err))
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
-	// ------------- Optional query parameter "additionalMetadata" -------------
+	ctx.Set(CookieAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantGetStepRunQueueMetrics(ctx, tenant)
+	return err
+}
 
-	// ------------- Optional query parameter "createdAfter" -------------
+// StepRunGet converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "createdAfter", ctx.QueryParams(), &params.CreatedAfter)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdAfter: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "createdBefore" -------------
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "createdBefore", ctx.QueryParams(), &params.CreatedBefore)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdBefore: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
 	}
 
-	// ------------- Optional query parameter "finishedAfter" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "finishedAfter", ctx.QueryParams(), &params.FinishedAfter)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter finishedAfter: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
-	// ------------- Optional query parameter "finishedBefore" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StepRunGet(ctx, tenant, stepRun)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "finishedBefore", ctx.QueryParams(), &params.FinishedBefore)
+// StepRunUpdateCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunUpdateCancel(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter finishedBefore: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "orderByField" -------------
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
 	}
 
-	// ------------- Optional query parameter "orderByDirection" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunList(ctx, tenant, params)
+	err = w.Handler.StepRunUpdateCancel(ctx, tenant, stepRun)
 	return err
 }
 
-// WorkflowRunGetMetrics converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunGetMetrics(ctx echo.Context) error {
+// StepRunUpdateRerun converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunUpdateRerun(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3725,68 +5448,53 @@ func (w *ServerInterfaceWrapper) WorkflowRunGetMetrics(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
-	ctx.Set(CookieAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowRunGetMetricsParams
-	// ------------- Optional query parameter "eventId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "eventId", ctx.QueryParams(), &params.EventId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventId: %s", err))
-	}
-
-	// ------------- Optional query parameter "workflowId" -------------
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
 	}
 
-	// ------------- Optional query parameter "parentWorkflowRunId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "parentWorkflowRunId", ctx.QueryParams(), &params.ParentWorkflowRunId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentWorkflowRunId: %s", err))
-	}
+	ctx.Set(BearerAuthScopes, []string{})
 
-	// ------------- Optional query parameter "parentStepRunId" -------------
+	ctx.Set(CookieAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "parentStepRunId", ctx.QueryParams(), &params.ParentStepRunId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentStepRunId: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StepRunUpdateRerun(ctx, tenant, stepRun)
+	return err
+}
 
-	// ------------- Optional query parameter "additionalMetadata" -------------
+// StepRunGetSchema converts echo context to params.
+func (w *ServerInterfaceWrapper) StepRunGetSchema(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Optional query parameter "createdAfter" -------------
+	// ------------- Path parameter "step-run" -------------
+	var stepRun openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "createdAfter", ctx.QueryParams(), &params.CreatedAfter)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "step-run", runtime.ParamLocationPath, ctx.Param("step-run"), &stepRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdAfter: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step-run: %s", err))
 	}
 
-	// ------------- Optional query parameter "createdBefore" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "createdBefore", ctx.QueryParams(), &params.CreatedBefore)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdBefore: %s", err))
-	}
+	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunGetMetrics(ctx, tenant, params)
+	err = w.Handler.StepRunGetSchema(ctx, tenant, stepRun)
 	return err
 }
 
-// WorkflowScheduledList converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowScheduledList(ctx echo.Context) error {
+// WebhookList converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3800,78 +5508,13 @@ func (w *ServerInterfaceWrapper) WorkflowScheduledList(ctx echo.Context) error {
 
 	ctx.Set(CookieAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowScheduledListParams
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
-	}
-
-	// ------------- Optional query parameter "orderByField" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
-	}
-
-	// ------------- Optional query parameter "orderByDirection" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
-	}
-
-	// ------------- Optional query parameter "workflowId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
-	}
-
-	// ------------- Optional query parameter "parentWorkflowRunId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "parentWorkflowRunId", ctx.QueryParams(), &params.ParentWorkflowRunId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentWorkflowRunId: %s", err))
-	}
-
-	// ------------- Optional query parameter "parentStepRunId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "parentStepRunId", ctx.QueryParams(), &params.ParentStepRunId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentStepRunId: %s", err))
-	}
-
-	// ------------- Optional query parameter "additionalMetadata" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
-	}
-
-	// ------------- Optional query parameter "statuses" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowScheduledList(ctx, tenant, params)
+	err = w.Handler.WebhookList(ctx, tenant)
 	return err
 }
 
-// WorkflowScheduledDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowScheduledDelete(ctx echo.Context) error {
+// WebhookCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookCreate(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3881,25 +5524,17 @@ func (w *ServerInterfaceWrapper) WorkflowScheduledDelete(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "scheduled-workflow-run" -------------
-	var scheduledWorkflowRun openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, ctx.Param("scheduled-workflow-run"), &scheduledWorkflowRun)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scheduled-workflow-run: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowScheduledDelete(ctx, tenant, scheduledWorkflowRun)
+	err = w.Handler.WebhookCreate(ctx, tenant)
 	return err
 }
 
-// WorkflowScheduledGet converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowScheduledGet(ctx echo.Context) error {
+// WorkerList converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkerList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3909,25 +5544,17 @@ func (w *ServerInterfaceWrapper) WorkflowScheduledGet(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "scheduled-workflow-run" -------------
-	var scheduledWorkflowRun openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, ctx.Param("scheduled-workflow-run"), &scheduledWorkflowRun)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scheduled-workflow-run: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowScheduledGet(ctx, tenant, scheduledWorkflowRun)
+	err = w.Handler.WorkerList(ctx, tenant)
 	return err
 }
 
-// CronWorkflowTriggerCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) CronWorkflowTriggerCreate(ctx echo.Context) error {
+// WorkerPoolList converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkerPoolList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3937,25 +5564,17 @@ func (w *ServerInterfaceWrapper) CronWorkflowTriggerCreate(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "workflow" -------------
-	var workflow string
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
-	}
-
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CronWorkflowTriggerCreate(ctx, tenant, workflow)
+	err = w.Handler.WorkerPoolList(ctx, tenant)
 	return err
 }
 
-// ScheduledWorkflowRunCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) ScheduledWorkflowRunCreate(ctx echo.Context) error {
+// WorkflowRunUpdateReplay converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunUpdateReplay(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3965,25 +5584,17 @@ func (w *ServerInterfaceWrapper) ScheduledWorkflowRunCreate(ctx echo.Context) er
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "workflow" -------------
-	var workflow string
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
-	}
-
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ScheduledWorkflowRunCreate(ctx, tenant, workflow)
+	err = w.Handler.WorkflowRunUpdateReplay(ctx, tenant)
 	return err
 }
 
-// WorkflowGetWorkersCount converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowGetWorkersCount(ctx echo.Context) error {
+// WorkflowRunGet converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunGet(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "tenant" -------------
 	var tenant openapi_types.UUID
@@ -3993,12 +5604,12 @@ func (w *ServerInterfaceWrapper) WorkflowGetWorkersCount(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
-	// ------------- Path parameter "workflow" -------------
-	var workflow openapi_types.UUID
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -4006,185 +5617,204 @@ func (w *ServerInterfaceWrapper) WorkflowGetWorkersCount(ctx echo.Context) error
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowGetWorkersCount(ctx, tenant, workflow)
+	err = w.Handler.WorkflowRunGet(ctx, tenant, workflowRun)
 	return err
 }
 
-// UserGetCurrent converts echo context to params.
-func (w *ServerInterfaceWrapper) UserGetCurrent(ctx echo.Context) error {
+// WorkflowRunGetInput converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunGetInput(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	ctx.Set(CookieAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserGetCurrent(ctx)
-	return err
-}
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
 
-// UserUpdateGithubOauthCallback converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateGithubOauthCallback(ctx echo.Context) error {
-	var err error
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateGithubOauthCallback(ctx)
-	return err
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-// UserUpdateGithubOauthStart converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateGithubOauthStart(ctx echo.Context) error {
-	var err error
+	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateGithubOauthStart(ctx)
+	err = w.Handler.WorkflowRunGetInput(ctx, tenant, workflowRun)
 	return err
 }
 
-// UserUpdateGoogleOauthCallback converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateGoogleOauthCallback(ctx echo.Context) error {
+// WorkflowRunUpdatePause converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunUpdatePause(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateGoogleOauthCallback(ctx)
-	return err
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
 
-// UserUpdateGoogleOauthStart converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateGoogleOauthStart(ctx echo.Context) error {
-	var err error
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateGoogleOauthStart(ctx)
-	return err
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+	}
 
-// UserListTenantInvites converts echo context to params.
-func (w *ServerInterfaceWrapper) UserListTenantInvites(ctx echo.Context) error {
-	var err error
+	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserListTenantInvites(ctx)
+	err = w.Handler.WorkflowRunUpdatePause(ctx, tenant, workflowRun)
 	return err
 }
 
-// TenantInviteAccept converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteAccept(ctx echo.Context) error {
+// WorkflowRunUpdateReplayFromStep converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunUpdateReplayFromStep(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	ctx.Set(BearerAuthScopes, []string{})
-
-	ctx.Set(CookieAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteAccept(ctx)
-	return err
-}
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
 
-// TenantInviteReject converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantInviteReject(ctx echo.Context) error {
-	var err error
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantInviteReject(ctx)
+	err = w.Handler.WorkflowRunUpdateReplayFromStep(ctx, tenant, workflowRun)
 	return err
 }
 
-// UserUpdateLogin converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateLogin(ctx echo.Context) error {
+// WorkflowRunUpdateResume converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunUpdateResume(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateLogin(ctx)
-	return err
-}
-
-// UserUpdateLogout converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateLogout(ctx echo.Context) error {
-	var err error
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
 
-	ctx.Set(CookieAuthScopes, []string{})
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateLogout(ctx)
-	return err
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+	}
 
-// TenantMembershipsList converts echo context to params.
-func (w *ServerInterfaceWrapper) TenantMembershipsList(ctx echo.Context) error {
-	var err error
+	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TenantMembershipsList(ctx)
+	err = w.Handler.WorkflowRunUpdateResume(ctx, tenant, workflowRun)
 	return err
 }
 
-// UserUpdatePassword converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdatePassword(ctx echo.Context) error {
+// WorkflowRunGetShape converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunGetShape(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	ctx.Set(CookieAuthScopes, []string{})
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdatePassword(ctx)
-	return err
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
 
-// UserCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) UserCreate(ctx echo.Context) error {
-	var err error
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserCreate(ctx)
-	return err
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
+	}
 
-// UserUpdateSlackOauthCallback converts echo context to params.
-func (w *ServerInterfaceWrapper) UserUpdateSlackOauthCallback(ctx echo.Context) error {
-	var err error
+	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UserUpdateSlackOauthCallback(ctx)
+	err = w.Handler.WorkflowRunGetShape(ctx, tenant, workflowRun)
 	return err
 }
 
-// WebhookDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) WebhookDelete(ctx echo.Context) error {
+// WorkflowRunListStepRunEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunListStepRunEvents(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "webhook" -------------
-	var webhook openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook", runtime.ParamLocationPath, ctx.Param("webhook"), &webhook)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhook: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowRunListStepRunEventsParams
+	// ------------- Optional query parameter "lastId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastId", ctx.QueryParams(), &params.LastId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lastId: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WebhookDelete(ctx, webhook)
+	err = w.Handler.WorkflowRunListStepRunEvents(ctx, tenant, workflowRun, params)
 	return err
 }
 
-// WebhookRequestsList converts echo context to params.
-func (w *ServerInterfaceWrapper) WebhookRequestsList(ctx echo.Context) error {
+// WorkflowRunGetStream converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunGetStream(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "webhook" -------------
-	var webhook openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook", runtime.ParamLocationPath, ctx.Param("webhook"), &webhook)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhook: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow-run" -------------
+	var workflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, ctx.Param("workflow-run"), &workflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow-run: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -4192,19 +5822,19 @@ func (w *ServerInterfaceWrapper) WebhookRequestsList(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WebhookRequestsList(ctx, webhook)
+	err = w.Handler.WorkflowRunGetStream(ctx, tenant, workflowRun)
 	return err
 }
 
-// WorkerGet converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkerGet(ctx echo.Context) error {
+// WorkflowList converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowList(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "worker" -------------
-	var worker openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "worker", runtime.ParamLocationPath, ctx.Param("worker"), &worker)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -4212,19 +5842,19 @@ func (w *ServerInterfaceWrapper) WorkerGet(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkerGet(ctx, worker)
+	err = w.Handler.WorkflowList(ctx, tenant)
 	return err
 }
 
-// WorkerUpdate converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkerUpdate(ctx echo.Context) error {
+// WorkflowRunCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunCancel(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "worker" -------------
-	var worker openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "worker", runtime.ParamLocationPath, ctx.Param("worker"), &worker)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -4232,39 +5862,91 @@ func (w *ServerInterfaceWrapper) WorkerUpdate(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkerUpdate(ctx, worker)
+	err = w.Handler.WorkflowRunCancel(ctx, tenant)
 	return err
 }
 
-// WorkflowDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowDelete(ctx echo.Context) error {
+// CronWorkflowList converts echo context to params.
+func (w *ServerInterfaceWrapper) CronWorkflowList(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "workflow" -------------
-	var workflow openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	ctx.Set(CookieAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CronWorkflowListParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflowId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
+	}
+
+	// ------------- Optional query parameter "additionalMetadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderByField" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderByDirection" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowDelete(ctx, workflow)
+	err = w.Handler.CronWorkflowList(ctx, tenant, params)
 	return err
 }
 
-// WorkflowGet converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowGet(ctx echo.Context) error {
+// WorkflowCronDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowCronDelete(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "workflow" -------------
-	var workflow openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "cron-workflow" -------------
+	var cronWorkflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, ctx.Param("cron-workflow"), &cronWorkflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cron-workflow: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -4272,19 +5954,27 @@ func (w *ServerInterfaceWrapper) WorkflowGet(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowGet(ctx, workflow)
+	err = w.Handler.WorkflowCronDelete(ctx, tenant, cronWorkflow)
 	return err
 }
 
-// WorkflowUpdate converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowUpdate(ctx echo.Context) error {
+// WorkflowCronGet converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowCronGet(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "workflow" -------------
-	var workflow openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "cron-workflow" -------------
+	var cronWorkflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, ctx.Param("cron-workflow"), &cronWorkflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cron-workflow: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -4292,19 +5982,19 @@ func (w *ServerInterfaceWrapper) WorkflowUpdate(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowUpdate(ctx, workflow)
+	err = w.Handler.WorkflowCronGet(ctx, tenant, cronWorkflow)
 	return err
 }
 
-// WorkflowGetMetrics converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowGetMetrics(ctx echo.Context) error {
+// WorkflowRunList converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunList(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "workflow" -------------
-	var workflow openapi_types.UUID
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -4312,141 +6002,1043 @@ func (w *ServerInterfaceWrapper) WorkflowGetMetrics(ctx echo.Context) error {
 	ctx.Set(CookieAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowGetMetricsParams
-	// ------------- Optional query parameter "status" -------------
+	var params WorkflowRunListParams
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Optional query parameter "groupKey" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "groupKey", ctx.QueryParams(), &params.GroupKey)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupKey: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowGetMetrics(ctx, workflow, params)
-	return err
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-// WorkflowRunCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowRunCreate(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "workflow" -------------
-	var workflow openapi_types.UUID
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	// ------------- Optional query parameter "eventId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "eventId", ctx.QueryParams(), &params.EventId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventId: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "eventKey" -------------
 
-	ctx.Set(CookieAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "eventKey", ctx.QueryParams(), &params.EventKey)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventKey: %s", err))
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowRunCreateParams
-	// ------------- Optional query parameter "version" -------------
+	// ------------- Optional query parameter "workflowId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowRunCreate(ctx, workflow, params)
-	return err
-}
+	// ------------- Optional query parameter "workflowIds" -------------
 
-// WorkflowVersionGet converts echo context to params.
-func (w *ServerInterfaceWrapper) WorkflowVersionGet(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "workflow" -------------
-	var workflow openapi_types.UUID
+	err = runtime.BindQueryParameter("form", true, false, "workflowIds", ctx.QueryParams(), &params.WorkflowIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowIds: %s", err))
+	}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	// ------------- Optional query parameter "parentWorkflowRunId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parentWorkflowRunId", ctx.QueryParams(), &params.ParentWorkflowRunId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentWorkflowRunId: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "parentStepRunId" -------------
 
-	ctx.Set(CookieAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "parentStepRunId", ctx.QueryParams(), &params.ParentStepRunId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentStepRunId: %s", err))
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params WorkflowVersionGetParams
-	// ------------- Optional query parameter "version" -------------
+	// ------------- Optional query parameter "statuses" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.WorkflowVersionGet(ctx, workflow, params)
-	return err
-}
+	// ------------- Optional query parameter "kinds" -------------
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-}
+	err = runtime.BindQueryParameter("form", true, false, "kinds", ctx.QueryParams(), &params.Kinds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kinds: %s", err))
+	}
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
-}
+	// ------------- Optional query parameter "additionalMetadata" -------------
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+	}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", ctx.QueryParams(), &params.CreatedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdAfter: %s", err))
 	}
 
-	router.GET(baseURL+"/api/live", wrapper.LivenessGet)
-	router.GET(baseURL+"/api/ready", wrapper.ReadinessGet)
-	router.DELETE(baseURL+"/api/v1/alerting-email-groups/:alert-email-group", wrapper.AlertEmailGroupDelete)
-	router.PATCH(baseURL+"/api/v1/alerting-email-groups/:alert-email-group", wrapper.AlertEmailGroupUpdate)
-	router.POST(baseURL+"/api/v1/api-tokens/:api-token", wrapper.ApiTokenUpdateRevoke)
-	router.GET(baseURL+"/api/v1/cloud/metadata", wrapper.CloudMetadataGet)
-	router.GET(baseURL+"/api/v1/events/:event", wrapper.EventGet)
-	router.GET(baseURL+"/api/v1/events/:event/data", wrapper.EventDataGet)
-	router.GET(baseURL+"/api/v1/meta", wrapper.MetadataGet)
-	router.GET(baseURL+"/api/v1/meta/integrations", wrapper.MetadataListIntegrations)
-	router.DELETE(baseURL+"/api/v1/slack/:slack", wrapper.SlackWebhookDelete)
-	router.DELETE(baseURL+"/api/v1/sns/:sns", wrapper.SnsDelete)
-	router.POST(baseURL+"/api/v1/sns/:tenant/:event", wrapper.SnsUpdate)
-	router.GET(baseURL+"/api/v1/step-runs/:step-run/archives", wrapper.StepRunListArchives)
-	router.GET(baseURL+"/api/v1/step-runs/:step-run/events", wrapper.StepRunListEvents)
-	router.GET(baseURL+"/api/v1/step-runs/:step-run/logs", wrapper.LogLineList)
-	router.POST(baseURL+"/api/v1/tenants", wrapper.TenantCreate)
-	router.PATCH(baseURL+"/api/v1/tenants/:tenant", wrapper.TenantUpdate)
-	router.GET(baseURL+"/api/v1/tenants/:tenant/alerting-email-groups", wrapper.AlertEmailGroupList)
-	router.POST(baseURL+"/api/v1/tenants/:tenant/alerting-email-groups", wrapper.AlertEmailGroupCreate)
-	router.GET(baseURL+"/api/v1/tenants/:tenant/alerting/settings", wrapper.TenantAlertingSettingsGet)
-	router.GET(baseURL+"/api/v1/tenants/:tenant/api-tokens", wrapper.ApiTokenList)
-	router.POST(baseURL+"/api/v1/tenants/:tenant/api-tokens", wrapper.ApiTokenCreate)
+	// ------------- Optional query parameter "createdBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdBefore", ctx.QueryParams(), &params.CreatedBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdBefore: %s", err))
+	}
+
+	// ------------- Optional query parameter "finishedAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "finishedAfter", ctx.QueryParams(), &params.FinishedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter finishedAfter: %s", err))
+	}
+
+	// ------------- Optional query parameter "finishedBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "finishedBefore", ctx.QueryParams(), &params.FinishedBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter finishedBefore: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderByField" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderByDirection" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunList(ctx, tenant, params)
+	return err
+}
+
+// WorkflowRunGetMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunGetMetrics(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowRunGetMetricsParams
+	// ------------- Optional query parameter "eventId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "eventId", ctx.QueryParams(), &params.EventId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventId: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflowId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
+	}
+
+	// ------------- Optional query parameter "parentWorkflowRunId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parentWorkflowRunId", ctx.QueryParams(), &params.ParentWorkflowRunId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentWorkflowRunId: %s", err))
+	}
+
+	// ------------- Optional query parameter "parentStepRunId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parentStepRunId", ctx.QueryParams(), &params.ParentStepRunId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentStepRunId: %s", err))
+	}
+
+	// ------------- Optional query parameter "additionalMetadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+	}
+
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", ctx.QueryParams(), &params.CreatedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdAfter: %s", err))
+	}
+
+	// ------------- Optional query parameter "createdBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdBefore", ctx.QueryParams(), &params.CreatedBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter createdBefore: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunGetMetrics(ctx, tenant, params)
+	return err
+}
+
+// WorkflowScheduledList converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowScheduledList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowScheduledListParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderByField" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByField", ctx.QueryParams(), &params.OrderByField)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByField: %s", err))
+	}
+
+	// ------------- Optional query parameter "orderByDirection" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "orderByDirection", ctx.QueryParams(), &params.OrderByDirection)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orderByDirection: %s", err))
+	}
+
+	// ------------- Optional query parameter "workflowId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workflowId", ctx.QueryParams(), &params.WorkflowId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflowId: %s", err))
+	}
+
+	// ------------- Optional query parameter "parentWorkflowRunId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parentWorkflowRunId", ctx.QueryParams(), &params.ParentWorkflowRunId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentWorkflowRunId: %s", err))
+	}
+
+	// ------------- Optional query parameter "parentStepRunId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parentStepRunId", ctx.QueryParams(), &params.ParentStepRunId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentStepRunId: %s", err))
+	}
+
+	// ------------- Optional query parameter "additionalMetadata" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "additionalMetadata", ctx.QueryParams(), &params.AdditionalMetadata)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter additionalMetadata: %s", err))
+	}
+
+	// ------------- Optional query parameter "statuses" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "statuses", ctx.QueryParams(), &params.Statuses)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter statuses: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowScheduledList(ctx, tenant, params)
+	return err
+}
+
+// WorkflowScheduledDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowScheduledDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "scheduled-workflow-run" -------------
+	var scheduledWorkflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, ctx.Param("scheduled-workflow-run"), &scheduledWorkflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scheduled-workflow-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowScheduledDelete(ctx, tenant, scheduledWorkflowRun)
+	return err
+}
+
+// WorkflowScheduledGet converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowScheduledGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "scheduled-workflow-run" -------------
+	var scheduledWorkflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, ctx.Param("scheduled-workflow-run"), &scheduledWorkflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scheduled-workflow-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowScheduledGet(ctx, tenant, scheduledWorkflowRun)
+	return err
+}
+
+// WorkflowScheduledUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowScheduledUpdate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "scheduled-workflow-run" -------------
+	var scheduledWorkflowRun openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, ctx.Param("scheduled-workflow-run"), &scheduledWorkflowRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scheduled-workflow-run: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowScheduledUpdate(ctx, tenant, scheduledWorkflowRun)
+	return err
+}
+
+// CronWorkflowTriggerCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) CronWorkflowTriggerCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow" -------------
+	var workflow string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CronWorkflowTriggerCreate(ctx, tenant, workflow)
+	return err
+}
+
+// ScheduledWorkflowRunCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduledWorkflowRunCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow" -------------
+	var workflow string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduledWorkflowRunCreate(ctx, tenant, workflow)
+	return err
+}
+
+// WorkflowGetWorkersCount converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowGetWorkersCount(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenant" -------------
+	var tenant openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tenant", runtime.ParamLocationPath, ctx.Param("tenant"), &tenant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenant: %s", err))
+	}
+
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowGetWorkersCount(ctx, tenant, workflow)
+	return err
+}
+
+// UserGetCurrent converts echo context to params.
+func (w *ServerInterfaceWrapper) UserGetCurrent(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserGetCurrent(ctx)
+	return err
+}
+
+// UserUpdateGithubOauthCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateGithubOauthCallback(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateGithubOauthCallback(ctx)
+	return err
+}
+
+// UserUpdateGithubOauthStart converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateGithubOauthStart(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateGithubOauthStart(ctx)
+	return err
+}
+
+// UserUpdateGoogleOauthCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateGoogleOauthCallback(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateGoogleOauthCallback(ctx)
+	return err
+}
+
+// UserUpdateGoogleOauthStart converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateGoogleOauthStart(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateGoogleOauthStart(ctx)
+	return err
+}
+
+// UserListTenantInvites converts echo context to params.
+func (w *ServerInterfaceWrapper) UserListTenantInvites(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserListTenantInvites(ctx)
+	return err
+}
+
+// TenantInviteAccept converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteAccept(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantInviteAccept(ctx)
+	return err
+}
+
+// TenantInviteReject converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantInviteReject(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantInviteReject(ctx)
+	return err
+}
+
+// UserUpdateLogin converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateLogin(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateLogin(ctx)
+	return err
+}
+
+// UserUpdateLogout converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateLogout(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateLogout(ctx)
+	return err
+}
+
+// TenantMembershipsList converts echo context to params.
+func (w *ServerInterfaceWrapper) TenantMembershipsList(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TenantMembershipsList(ctx)
+	return err
+}
+
+// UserUpdateOidcCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateOidcCallback(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateOidcCallback(ctx)
+	return err
+}
+
+// UserUpdateOidcStart converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateOidcStart(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateOidcStart(ctx)
+	return err
+}
+
+// UserUpdatePassword converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdatePassword(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdatePassword(ctx)
+	return err
+}
+
+// UserCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) UserCreate(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserCreate(ctx)
+	return err
+}
+
+// UserUpdateSlackOauthCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) UserUpdateSlackOauthCallback(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UserUpdateSlackOauthCallback(ctx)
+	return err
+}
+
+// WebhookDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "webhook" -------------
+	var webhook openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook", runtime.ParamLocationPath, ctx.Param("webhook"), &webhook)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhook: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookDelete(ctx, webhook)
+	return err
+}
+
+// WebhookRequestsList converts echo context to params.
+func (w *ServerInterfaceWrapper) WebhookRequestsList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "webhook" -------------
+	var webhook openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook", runtime.ParamLocationPath, ctx.Param("webhook"), &webhook)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhook: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WebhookRequestsList(ctx, webhook)
+	return err
+}
+
+// WorkerGet converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkerGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "worker" -------------
+	var worker openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "worker", runtime.ParamLocationPath, ctx.Param("worker"), &worker)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkerGet(ctx, worker)
+	return err
+}
+
+// WorkerUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkerUpdate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "worker" -------------
+	var worker openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "worker", runtime.ParamLocationPath, ctx.Param("worker"), &worker)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkerUpdate(ctx, worker)
+	return err
+}
+
+// WorkflowDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowDelete(ctx, workflow)
+	return err
+}
+
+// WorkflowGet converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowGet(ctx, workflow)
+	return err
+}
+
+// WorkflowUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowUpdate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowUpdate(ctx, workflow)
+	return err
+}
+
+// WorkflowListConcurrencyGroups converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowListConcurrencyGroups(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowListConcurrencyGroupsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowListConcurrencyGroups(ctx, workflow, params)
+	return err
+}
+
+// WorkflowGetMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowGetMetrics(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowGetMetricsParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "groupKey" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "groupKey", ctx.QueryParams(), &params.GroupKey)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupKey: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowGetMetrics(ctx, workflow, params)
+	return err
+}
+
+// WorkflowDeleteRollout converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowDeleteRollout(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowDeleteRollout(ctx, workflow)
+	return err
+}
+
+// WorkflowGetRollout converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowGetRollout(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowGetRollout(ctx, workflow)
+	return err
+}
+
+// WorkflowUpdateRollout converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowUpdateRollout(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowUpdateRollout(ctx, workflow)
+	return err
+}
+
+// WorkflowRunCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowRunCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowRunCreateParams
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowRunCreate(ctx, workflow, params)
+	return err
+}
+
+// WorkflowVersionGet converts echo context to params.
+func (w *ServerInterfaceWrapper) WorkflowVersionGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workflow" -------------
+	var workflow openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "workflow", runtime.ParamLocationPath, ctx.Param("workflow"), &workflow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workflow: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(CookieAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WorkflowVersionGetParams
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WorkflowVersionGet(ctx, workflow, params)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/api/healthz", wrapper.HealthzGet)
+	router.GET(baseURL+"/api/live", wrapper.LivenessGet)
+	router.GET(baseURL+"/api/ready", wrapper.ReadinessGet)
+	router.GET(baseURL+"/api/readyz", wrapper.ReadyzGet)
+	router.DELETE(baseURL+"/api/v1/alerting-email-groups/:alert-email-group", wrapper.AlertEmailGroupDelete)
+	router.PATCH(baseURL+"/api/v1/alerting-email-groups/:alert-email-group", wrapper.AlertEmailGroupUpdate)
+	router.POST(baseURL+"/api/v1/api-tokens/:api-token", wrapper.ApiTokenUpdateRevoke)
+	router.POST(baseURL+"/api/v1/api-tokens/:api-token/rotate", wrapper.ApiTokenUpdateRotate)
+	router.POST(baseURL+"/api/v1/approval-tokens/:approval-token/decide", wrapper.ApprovalUpdateDecideWithToken)
+	router.GET(baseURL+"/api/v1/approvals/:approval", wrapper.ApprovalGet)
+	router.POST(baseURL+"/api/v1/approvals/:approval/decide", wrapper.ApprovalUpdateDecide)
+	router.DELETE(baseURL+"/api/v1/client-cas/:client-ca", wrapper.ClientCaDelete)
+	router.GET(baseURL+"/api/v1/cloud/metadata", wrapper.CloudMetadataGet)
+	router.DELETE(baseURL+"/api/v1/event-dedup-rules/:event-dedup-rule", wrapper.EventDedupRuleDelete)
+	router.DELETE(baseURL+"/api/v1/event-routing-rules/:event-routing-rule", wrapper.EventRoutingRuleDelete)
+	router.DELETE(baseURL+"/api/v1/event-sinks/:event-sink", wrapper.EventSinkDelete)
+	router.POST(baseURL+"/api/v1/event-sinks/:event-sink/replay", wrapper.EventSinkReplay)
+	router.GET(baseURL+"/api/v1/events/:event", wrapper.EventGet)
+	router.GET(baseURL+"/api/v1/events/:event/data", wrapper.EventDataGet)
+	router.DELETE(baseURL+"/api/v1/inbound-webhooks/:inbound-webhook", wrapper.InboundWebhookDelete)
+	router.POST(baseURL+"/api/v1/inbound-webhooks/:inbound-webhook", wrapper.InboundWebhookReceive)
+	router.GET(baseURL+"/api/v1/meta", wrapper.MetadataGet)
+	router.GET(baseURL+"/api/v1/meta/integrations", wrapper.MetadataListIntegrations)
+	router.DELETE(baseURL+"/api/v1/slack/:slack", wrapper.SlackWebhookDelete)
+	router.DELETE(baseURL+"/api/v1/sns/:sns", wrapper.SnsDelete)
+	router.POST(baseURL+"/api/v1/sns/:tenant/:event", wrapper.SnsUpdate)
+	router.DELETE(baseURL+"/api/v1/sqs/:sqs", wrapper.SqsDelete)
+	router.GET(baseURL+"/api/v1/step-runs/:step-run/archives", wrapper.StepRunListArchives)
+	router.GET(baseURL+"/api/v1/step-runs/:step-run/events", wrapper.StepRunListEvents)
+	router.GET(baseURL+"/api/v1/step-runs/:step-run/logs", wrapper.LogLineList)
+	router.GET(baseURL+"/api/v1/step-runs/:step-run/logs/stream", wrapper.LogLineStream)
+	router.GET(baseURL+"/api/v1/step-runs/:step-run/scheduling-decisions", wrapper.StepRunListSchedulingDecisions)
+	router.POST(baseURL+"/api/v1/tenants", wrapper.TenantCreate)
+	router.PATCH(baseURL+"/api/v1/tenants/:tenant", wrapper.TenantUpdate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/alerting-email-groups", wrapper.AlertEmailGroupList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/alerting-email-groups", wrapper.AlertEmailGroupCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/alerting/settings", wrapper.TenantAlertingSettingsGet)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/api-tokens", wrapper.ApiTokenList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/api-tokens", wrapper.ApiTokenCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/approvals", wrapper.ApprovalList)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/audit-logs", wrapper.AuditLogList)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/client-cas", wrapper.ClientCaList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/client-cas", wrapper.ClientCaCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/cron-calendars", wrapper.CronCalendarList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/cron-calendars", wrapper.CronCalendarCreate)
+	router.DELETE(baseURL+"/api/v1/tenants/:tenant/cron-calendars/:cron-calendar", wrapper.CronCalendarDelete)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/dead-letter-queue", wrapper.DeadLetterQueueList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/dead-letter-queue/purge", wrapper.DeadLetterQueueDelete)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/dead-letter-queue/replay", wrapper.DeadLetterQueueUpdateReplay)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/event-dedup-rules", wrapper.EventDedupRuleList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/event-dedup-rules", wrapper.EventDedupRuleUpsert)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/event-routing-rules", wrapper.EventRoutingRuleList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/event-routing-rules", wrapper.EventRoutingRuleUpsert)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/event-sinks", wrapper.EventSinkList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/event-sinks", wrapper.EventSinkCreate)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/events", wrapper.EventList)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/events", wrapper.EventCreate)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/events/batch", wrapper.EventCreateBatch)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/events/bulk", wrapper.EventCreateBulk)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/events/cancel", wrapper.EventUpdateCancel)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/events/cloudevents", wrapper.EventCreateCloudEvent)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/events/keys", wrapper.EventKeyList)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/events/replay", wrapper.EventUpdateReplay)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/events/replay-range", wrapper.EventUpdateReplayRange)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/inbound-webhooks", wrapper.InboundWebhookList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/inbound-webhooks", wrapper.InboundWebhookCreate)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/invites", wrapper.TenantInviteList)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/invites", wrapper.TenantInviteCreate)
 	router.DELETE(baseURL+"/api/v1/tenants/:tenant/invites/:tenant-invite", wrapper.TenantInviteDelete)
@@ -4454,12 +7046,17 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/v1/tenants/:tenant/members", wrapper.TenantMemberList)
 	router.DELETE(baseURL+"/api/v1/tenants/:tenant/members/:member", wrapper.TenantMemberDelete)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/queue-metrics", wrapper.TenantGetQueueMetrics)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/queues/:queue/pause", wrapper.QueueUpdatePause)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/queues/:queue/resume", wrapper.QueueUpdateResume)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/quotas", wrapper.TenantQuotaList)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/rate-limits", wrapper.RateLimitList)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/resource-policy", wrapper.TenantResourcePolicyGet)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/slack", wrapper.SlackWebhookList)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/slack/start", wrapper.UserUpdateSlackOauthStart)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/sns", wrapper.SnsList)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/sns", wrapper.SnsCreate)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/sqs", wrapper.SqsList)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/sqs", wrapper.SqsCreate)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/step-run-queue-metrics", wrapper.TenantGetStepRunQueueMetrics)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/step-runs/:step-run", wrapper.StepRunGet)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/step-runs/:step-run/cancel", wrapper.StepRunUpdateCancel)
@@ -4468,11 +7065,16 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/v1/tenants/:tenant/webhook-workers", wrapper.WebhookList)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/webhook-workers", wrapper.WebhookCreate)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/worker", wrapper.WorkerList)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/worker-pools", wrapper.WorkerPoolList)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/workflow-runs/replay", wrapper.WorkflowRunUpdateReplay)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run", wrapper.WorkflowRunGet)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run/input", wrapper.WorkflowRunGetInput)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run/pause", wrapper.WorkflowRunUpdatePause)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run/replay-from-step", wrapper.WorkflowRunUpdateReplayFromStep)
+	router.POST(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run/resume", wrapper.WorkflowRunUpdateResume)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run/shape", wrapper.WorkflowRunGetShape)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run/step-run-events", wrapper.WorkflowRunListStepRunEvents)
+	router.GET(baseURL+"/api/v1/tenants/:tenant/workflow-runs/:workflow-run/stream", wrapper.WorkflowRunGetStream)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows", wrapper.WorkflowList)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/workflows/cancel", wrapper.WorkflowRunCancel)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/crons", wrapper.CronWorkflowList)
@@ -4483,6 +7085,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/scheduled", wrapper.WorkflowScheduledList)
 	router.DELETE(baseURL+"/api/v1/tenants/:tenant/workflows/scheduled/:scheduled-workflow-run", wrapper.WorkflowScheduledDelete)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/scheduled/:scheduled-workflow-run", wrapper.WorkflowScheduledGet)
+	router.PATCH(baseURL+"/api/v1/tenants/:tenant/workflows/scheduled/:scheduled-workflow-run", wrapper.WorkflowScheduledUpdate)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/workflows/:workflow/crons", wrapper.CronWorkflowTriggerCreate)
 	router.POST(baseURL+"/api/v1/tenants/:tenant/workflows/:workflow/scheduled", wrapper.ScheduledWorkflowRunCreate)
 	router.GET(baseURL+"/api/v1/tenants/:tenant/workflows/:workflow/worker-count", wrapper.WorkflowGetWorkersCount)
@@ -4497,6 +7100,8 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/api/v1/users/login", wrapper.UserUpdateLogin)
 	router.POST(baseURL+"/api/v1/users/logout", wrapper.UserUpdateLogout)
 	router.GET(baseURL+"/api/v1/users/memberships", wrapper.TenantMembershipsList)
+	router.GET(baseURL+"/api/v1/users/oidc/callback", wrapper.UserUpdateOidcCallback)
+	router.GET(baseURL+"/api/v1/users/oidc/start", wrapper.UserUpdateOidcStart)
 	router.POST(baseURL+"/api/v1/users/password", wrapper.UserUpdatePassword)
 	router.POST(baseURL+"/api/v1/users/register", wrapper.UserCreate)
 	router.GET(baseURL+"/api/v1/users/slack/callback", wrapper.UserUpdateSlackOauthCallback)
@@ -4507,4276 +7112,7341 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/api/v1/workflows/:workflow", wrapper.WorkflowDelete)
 	router.GET(baseURL+"/api/v1/workflows/:workflow", wrapper.WorkflowGet)
 	router.PATCH(baseURL+"/api/v1/workflows/:workflow", wrapper.WorkflowUpdate)
+	router.GET(baseURL+"/api/v1/workflows/:workflow/concurrency-groups", wrapper.WorkflowListConcurrencyGroups)
 	router.GET(baseURL+"/api/v1/workflows/:workflow/metrics", wrapper.WorkflowGetMetrics)
+	router.DELETE(baseURL+"/api/v1/workflows/:workflow/rollout", wrapper.WorkflowDeleteRollout)
+	router.GET(baseURL+"/api/v1/workflows/:workflow/rollout", wrapper.WorkflowGetRollout)
+	router.PUT(baseURL+"/api/v1/workflows/:workflow/rollout", wrapper.WorkflowUpdateRollout)
 	router.POST(baseURL+"/api/v1/workflows/:workflow/trigger", wrapper.WorkflowRunCreate)
 	router.GET(baseURL+"/api/v1/workflows/:workflow/versions", wrapper.WorkflowVersionGet)
 
 }
 
-type LivenessGetRequestObject struct {
+type HealthzGetRequestObject struct {
+}
+
+type HealthzGetResponseObject interface {
+	VisitHealthzGetResponse(w http.ResponseWriter) error
+}
+
+type HealthzGet200JSONResponse HealthReport
+
+func (response HealthzGet200JSONResponse) VisitHealthzGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HealthzGet503JSONResponse HealthReport
+
+func (response HealthzGet503JSONResponse) VisitHealthzGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LivenessGetRequestObject struct {
+}
+
+type LivenessGetResponseObject interface {
+	VisitLivenessGetResponse(w http.ResponseWriter) error
+}
+
+type LivenessGet200Response struct {
+}
+
+func (response LivenessGet200Response) VisitLivenessGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type LivenessGet500Response struct {
+}
+
+func (response LivenessGet500Response) VisitLivenessGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ReadinessGetRequestObject struct {
+}
+
+type ReadinessGetResponseObject interface {
+	VisitReadinessGetResponse(w http.ResponseWriter) error
+}
+
+type ReadinessGet200Response struct {
+}
+
+func (response ReadinessGet200Response) VisitReadinessGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ReadinessGet500Response struct {
+}
+
+func (response ReadinessGet500Response) VisitReadinessGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ReadyzGetRequestObject struct {
+}
+
+type ReadyzGetResponseObject interface {
+	VisitReadyzGetResponse(w http.ResponseWriter) error
+}
+
+type ReadyzGet200JSONResponse HealthReport
+
+func (response ReadyzGet200JSONResponse) VisitReadyzGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadyzGet503JSONResponse HealthReport
+
+func (response ReadyzGet503JSONResponse) VisitReadyzGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertEmailGroupDeleteRequestObject struct {
+	AlertEmailGroup openapi_types.UUID `json:"alert-email-group"`
+}
+
+type AlertEmailGroupDeleteResponseObject interface {
+	VisitAlertEmailGroupDeleteResponse(w http.ResponseWriter) error
+}
+
+type AlertEmailGroupDelete204Response struct {
+}
+
+func (response AlertEmailGroupDelete204Response) VisitAlertEmailGroupDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type AlertEmailGroupDelete400JSONResponse APIErrors
+
+func (response AlertEmailGroupDelete400JSONResponse) VisitAlertEmailGroupDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertEmailGroupDelete403JSONResponse APIError
+
+func (response AlertEmailGroupDelete403JSONResponse) VisitAlertEmailGroupDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertEmailGroupUpdateRequestObject struct {
+	AlertEmailGroup openapi_types.UUID `json:"alert-email-group"`
+	Body            *AlertEmailGroupUpdateJSONRequestBody
+}
+
+type AlertEmailGroupUpdateResponseObject interface {
+	VisitAlertEmailGroupUpdateResponse(w http.ResponseWriter) error
+}
+
+type AlertEmailGroupUpdate200JSONResponse TenantAlertEmailGroup
+
+func (response AlertEmailGroupUpdate200JSONResponse) VisitAlertEmailGroupUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertEmailGroupUpdate400JSONResponse APIErrors
+
+func (response AlertEmailGroupUpdate400JSONResponse) VisitAlertEmailGroupUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertEmailGroupUpdate403JSONResponse APIError
+
+func (response AlertEmailGroupUpdate403JSONResponse) VisitAlertEmailGroupUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenUpdateRevokeRequestObject struct {
+	ApiToken openapi_types.UUID `json:"api-token"`
+}
+
+type ApiTokenUpdateRevokeResponseObject interface {
+	VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error
+}
+
+type ApiTokenUpdateRevoke204Response struct {
+}
+
+func (response ApiTokenUpdateRevoke204Response) VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ApiTokenUpdateRevoke400JSONResponse APIErrors
+
+func (response ApiTokenUpdateRevoke400JSONResponse) VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenUpdateRevoke403JSONResponse APIErrors
+
+func (response ApiTokenUpdateRevoke403JSONResponse) VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenUpdateRotateRequestObject struct {
+	ApiToken openapi_types.UUID `json:"api-token"`
+	Body     *ApiTokenUpdateRotateJSONRequestBody
+}
+
+type ApiTokenUpdateRotateResponseObject interface {
+	VisitApiTokenUpdateRotateResponse(w http.ResponseWriter) error
+}
+
+type ApiTokenUpdateRotate200JSONResponse CreateAPITokenResponse
+
+func (response ApiTokenUpdateRotate200JSONResponse) VisitApiTokenUpdateRotateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenUpdateRotate400JSONResponse APIErrors
+
+func (response ApiTokenUpdateRotate400JSONResponse) VisitApiTokenUpdateRotateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenUpdateRotate403JSONResponse APIErrors
+
+func (response ApiTokenUpdateRotate403JSONResponse) VisitApiTokenUpdateRotateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovalUpdateDecideWithTokenRequestObject struct {
+	ApprovalToken string `json:"approval-token"`
+	Body          *ApprovalUpdateDecideWithTokenJSONRequestBody
+}
+
+type ApprovalUpdateDecideWithTokenResponseObject interface {
+	VisitApprovalUpdateDecideWithTokenResponse(w http.ResponseWriter) error
+}
+
+type ApprovalUpdateDecideWithToken200Response struct {
+}
+
+func (response ApprovalUpdateDecideWithToken200Response) VisitApprovalUpdateDecideWithTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ApprovalUpdateDecideWithToken400JSONResponse APIErrors
+
+func (response ApprovalUpdateDecideWithToken400JSONResponse) VisitApprovalUpdateDecideWithTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovalUpdateDecideWithToken404JSONResponse APIErrors
+
+func (response ApprovalUpdateDecideWithToken404JSONResponse) VisitApprovalUpdateDecideWithTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovalGetRequestObject struct {
+	Approval openapi_types.UUID `json:"approval"`
+}
+
+type ApprovalGetResponseObject interface {
+	VisitApprovalGetResponse(w http.ResponseWriter) error
+}
+
+type ApprovalGet200JSONResponse StepRunApproval
+
+func (response ApprovalGet200JSONResponse) VisitApprovalGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovalGet400JSONResponse APIErrors
+
+func (response ApprovalGet400JSONResponse) VisitApprovalGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovalGet403JSONResponse APIErrors
+
+func (response ApprovalGet403JSONResponse) VisitApprovalGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovalGet404JSONResponse APIErrors
+
+func (response ApprovalGet404JSONResponse) VisitApprovalGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovalUpdateDecideRequestObject struct {
+	Approval openapi_types.UUID `json:"approval"`
+	Body     *ApprovalUpdateDecideJSONRequestBody
+}
+
+type ApprovalUpdateDecideResponseObject interface {
+	VisitApprovalUpdateDecideResponse(w http.ResponseWriter) error
+}
+
+type ApprovalUpdateDecide200JSONResponse StepRunApproval
+
+func (response ApprovalUpdateDecide200JSONResponse) VisitApprovalUpdateDecideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovalUpdateDecide400JSONResponse APIErrors
+
+func (response ApprovalUpdateDecide400JSONResponse) VisitApprovalUpdateDecideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovalUpdateDecide403JSONResponse APIErrors
+
+func (response ApprovalUpdateDecide403JSONResponse) VisitApprovalUpdateDecideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovalUpdateDecide404JSONResponse APIErrors
+
+func (response ApprovalUpdateDecide404JSONResponse) VisitApprovalUpdateDecideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClientCaDeleteRequestObject struct {
+	ClientCa openapi_types.UUID `json:"client-ca"`
+}
+
+type ClientCaDeleteResponseObject interface {
+	VisitClientCaDeleteResponse(w http.ResponseWriter) error
+}
+
+type ClientCaDelete204Response struct {
+}
+
+func (response ClientCaDelete204Response) VisitClientCaDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ClientCaDelete400JSONResponse APIErrors
+
+func (response ClientCaDelete400JSONResponse) VisitClientCaDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClientCaDelete403JSONResponse APIErrors
+
+func (response ClientCaDelete403JSONResponse) VisitClientCaDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloudMetadataGetRequestObject struct {
+}
+
+type CloudMetadataGetResponseObject interface {
+	VisitCloudMetadataGetResponse(w http.ResponseWriter) error
+}
+
+type CloudMetadataGet200JSONResponse APIErrors
+
+func (response CloudMetadataGet200JSONResponse) VisitCloudMetadataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloudMetadataGet400JSONResponse APIErrors
+
+func (response CloudMetadataGet400JSONResponse) VisitCloudMetadataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventDedupRuleDeleteRequestObject struct {
+	EventDedupRule openapi_types.UUID `json:"event-dedup-rule"`
+}
+
+type EventDedupRuleDeleteResponseObject interface {
+	VisitEventDedupRuleDeleteResponse(w http.ResponseWriter) error
+}
+
+type EventDedupRuleDelete204Response struct {
+}
+
+func (response EventDedupRuleDelete204Response) VisitEventDedupRuleDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type EventDedupRuleDelete400JSONResponse APIErrors
+
+func (response EventDedupRuleDelete400JSONResponse) VisitEventDedupRuleDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventDedupRuleDelete403JSONResponse APIErrors
+
+func (response EventDedupRuleDelete403JSONResponse) VisitEventDedupRuleDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventRoutingRuleDeleteRequestObject struct {
+	EventRoutingRule openapi_types.UUID `json:"event-routing-rule"`
+}
+
+type EventRoutingRuleDeleteResponseObject interface {
+	VisitEventRoutingRuleDeleteResponse(w http.ResponseWriter) error
+}
+
+type EventRoutingRuleDelete204Response struct {
+}
+
+func (response EventRoutingRuleDelete204Response) VisitEventRoutingRuleDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type EventRoutingRuleDelete400JSONResponse APIErrors
+
+func (response EventRoutingRuleDelete400JSONResponse) VisitEventRoutingRuleDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventRoutingRuleDelete403JSONResponse APIErrors
+
+func (response EventRoutingRuleDelete403JSONResponse) VisitEventRoutingRuleDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSinkDeleteRequestObject struct {
+	EventSink openapi_types.UUID `json:"event-sink"`
+}
+
+type EventSinkDeleteResponseObject interface {
+	VisitEventSinkDeleteResponse(w http.ResponseWriter) error
+}
+
+type EventSinkDelete204Response struct {
+}
+
+func (response EventSinkDelete204Response) VisitEventSinkDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type EventSinkDelete400JSONResponse APIErrors
+
+func (response EventSinkDelete400JSONResponse) VisitEventSinkDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSinkDelete403JSONResponse APIErrors
+
+func (response EventSinkDelete403JSONResponse) VisitEventSinkDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSinkReplayRequestObject struct {
+	EventSink openapi_types.UUID `json:"event-sink"`
+}
+
+type EventSinkReplayResponseObject interface {
+	VisitEventSinkReplayResponse(w http.ResponseWriter) error
+}
+
+type EventSinkReplay200JSONResponse EventSink
+
+func (response EventSinkReplay200JSONResponse) VisitEventSinkReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSinkReplay400JSONResponse APIErrors
+
+func (response EventSinkReplay400JSONResponse) VisitEventSinkReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSinkReplay403JSONResponse APIErrors
+
+func (response EventSinkReplay403JSONResponse) VisitEventSinkReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventGetRequestObject struct {
+	Event openapi_types.UUID `json:"event"`
+}
+
+type EventGetResponseObject interface {
+	VisitEventGetResponse(w http.ResponseWriter) error
+}
+
+type EventGet200JSONResponse Event
+
+func (response EventGet200JSONResponse) VisitEventGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventGet400JSONResponse APIErrors
+
+func (response EventGet400JSONResponse) VisitEventGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventGet403JSONResponse APIErrors
+
+func (response EventGet403JSONResponse) VisitEventGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventDataGetRequestObject struct {
+	Event openapi_types.UUID `json:"event"`
+}
+
+type EventDataGetResponseObject interface {
+	VisitEventDataGetResponse(w http.ResponseWriter) error
+}
+
+type EventDataGet200JSONResponse EventData
+
+func (response EventDataGet200JSONResponse) VisitEventDataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventDataGet400JSONResponse APIErrors
+
+func (response EventDataGet400JSONResponse) VisitEventDataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventDataGet403JSONResponse APIErrors
+
+func (response EventDataGet403JSONResponse) VisitEventDataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InboundWebhookDeleteRequestObject struct {
+	InboundWebhook openapi_types.UUID `json:"inbound-webhook"`
+}
+
+type InboundWebhookDeleteResponseObject interface {
+	VisitInboundWebhookDeleteResponse(w http.ResponseWriter) error
+}
+
+type InboundWebhookDelete204Response struct {
+}
+
+func (response InboundWebhookDelete204Response) VisitInboundWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type InboundWebhookDelete400JSONResponse APIErrors
+
+func (response InboundWebhookDelete400JSONResponse) VisitInboundWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InboundWebhookDelete403JSONResponse APIErrors
+
+func (response InboundWebhookDelete403JSONResponse) VisitInboundWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InboundWebhookReceiveRequestObject struct {
+	InboundWebhook openapi_types.UUID `json:"inbound-webhook"`
+}
+
+type InboundWebhookReceiveResponseObject interface {
+	VisitInboundWebhookReceiveResponse(w http.ResponseWriter) error
+}
+
+type InboundWebhookReceive200Response struct {
+}
+
+func (response InboundWebhookReceive200Response) VisitInboundWebhookReceiveResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type InboundWebhookReceive400JSONResponse APIErrors
+
+func (response InboundWebhookReceive400JSONResponse) VisitInboundWebhookReceiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InboundWebhookReceive401JSONResponse APIErrors
+
+func (response InboundWebhookReceive401JSONResponse) VisitInboundWebhookReceiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InboundWebhookReceive404JSONResponse APIErrors
+
+func (response InboundWebhookReceive404JSONResponse) VisitInboundWebhookReceiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MetadataGetRequestObject struct {
+}
+
+type MetadataGetResponseObject interface {
+	VisitMetadataGetResponse(w http.ResponseWriter) error
+}
+
+type MetadataGet200JSONResponse APIMeta
+
+func (response MetadataGet200JSONResponse) VisitMetadataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MetadataGet400JSONResponse APIErrors
+
+func (response MetadataGet400JSONResponse) VisitMetadataGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MetadataListIntegrationsRequestObject struct {
+}
+
+type MetadataListIntegrationsResponseObject interface {
+	VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error
+}
+
+type MetadataListIntegrations200JSONResponse ListAPIMetaIntegration
+
+func (response MetadataListIntegrations200JSONResponse) VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MetadataListIntegrations400JSONResponse APIErrors
+
+func (response MetadataListIntegrations400JSONResponse) VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SlackWebhookDeleteRequestObject struct {
+	Slack openapi_types.UUID `json:"slack"`
+}
+
+type SlackWebhookDeleteResponseObject interface {
+	VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error
+}
+
+type SlackWebhookDelete204Response struct {
+}
+
+func (response SlackWebhookDelete204Response) VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type SlackWebhookDelete400JSONResponse APIErrors
+
+func (response SlackWebhookDelete400JSONResponse) VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SlackWebhookDelete401JSONResponse APIErrors
+
+func (response SlackWebhookDelete401JSONResponse) VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SlackWebhookDelete405JSONResponse APIErrors
+
+func (response SlackWebhookDelete405JSONResponse) VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsDeleteRequestObject struct {
+	Sns openapi_types.UUID `json:"sns"`
+}
+
+type SnsDeleteResponseObject interface {
+	VisitSnsDeleteResponse(w http.ResponseWriter) error
+}
+
+type SnsDelete204Response struct {
+}
+
+func (response SnsDelete204Response) VisitSnsDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type SnsDelete400JSONResponse APIErrors
+
+func (response SnsDelete400JSONResponse) VisitSnsDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsDelete401JSONResponse APIErrors
+
+func (response SnsDelete401JSONResponse) VisitSnsDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsDelete405JSONResponse APIErrors
+
+func (response SnsDelete405JSONResponse) VisitSnsDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsUpdateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Event  string             `json:"event"`
+}
+
+type SnsUpdateResponseObject interface {
+	VisitSnsUpdateResponse(w http.ResponseWriter) error
+}
+
+type SnsUpdate200Response struct {
+}
+
+func (response SnsUpdate200Response) VisitSnsUpdateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type SnsUpdate400JSONResponse APIErrors
+
+func (response SnsUpdate400JSONResponse) VisitSnsUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsUpdate401JSONResponse APIErrors
+
+func (response SnsUpdate401JSONResponse) VisitSnsUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsUpdate405JSONResponse APIErrors
+
+func (response SnsUpdate405JSONResponse) VisitSnsUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SqsDeleteRequestObject struct {
+	Sqs openapi_types.UUID `json:"sqs"`
+}
+
+type SqsDeleteResponseObject interface {
+	VisitSqsDeleteResponse(w http.ResponseWriter) error
+}
+
+type SqsDelete204Response struct {
+}
+
+func (response SqsDelete204Response) VisitSqsDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type SqsDelete400JSONResponse APIErrors
+
+func (response SqsDelete400JSONResponse) VisitSqsDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SqsDelete401JSONResponse APIErrors
+
+func (response SqsDelete401JSONResponse) VisitSqsDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SqsDelete405JSONResponse APIErrors
+
+func (response SqsDelete405JSONResponse) VisitSqsDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListArchivesRequestObject struct {
+	StepRun openapi_types.UUID `json:"step-run"`
+	Params  StepRunListArchivesParams
+}
+
+type StepRunListArchivesResponseObject interface {
+	VisitStepRunListArchivesResponse(w http.ResponseWriter) error
+}
+
+type StepRunListArchives200JSONResponse StepRunArchiveList
+
+func (response StepRunListArchives200JSONResponse) VisitStepRunListArchivesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListArchives400JSONResponse APIErrors
+
+func (response StepRunListArchives400JSONResponse) VisitStepRunListArchivesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListArchives403JSONResponse APIErrors
+
+func (response StepRunListArchives403JSONResponse) VisitStepRunListArchivesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListArchives404JSONResponse APIErrors
+
+func (response StepRunListArchives404JSONResponse) VisitStepRunListArchivesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListEventsRequestObject struct {
+	StepRun openapi_types.UUID `json:"step-run"`
+	Params  StepRunListEventsParams
+}
+
+type StepRunListEventsResponseObject interface {
+	VisitStepRunListEventsResponse(w http.ResponseWriter) error
+}
+
+type StepRunListEvents200JSONResponse StepRunEventList
+
+func (response StepRunListEvents200JSONResponse) VisitStepRunListEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListEvents400JSONResponse APIErrors
+
+func (response StepRunListEvents400JSONResponse) VisitStepRunListEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListEvents403JSONResponse APIErrors
+
+func (response StepRunListEvents403JSONResponse) VisitStepRunListEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListEvents404JSONResponse APIErrors
+
+func (response StepRunListEvents404JSONResponse) VisitStepRunListEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogLineListRequestObject struct {
+	StepRun openapi_types.UUID `json:"step-run"`
+	Params  LogLineListParams
+}
+
+type LogLineListResponseObject interface {
+	VisitLogLineListResponse(w http.ResponseWriter) error
+}
+
+type LogLineList200JSONResponse LogLineList
+
+func (response LogLineList200JSONResponse) VisitLogLineListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogLineList400JSONResponse APIErrors
+
+func (response LogLineList400JSONResponse) VisitLogLineListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogLineList403JSONResponse APIErrors
+
+func (response LogLineList403JSONResponse) VisitLogLineListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogLineStreamRequestObject struct {
+	StepRun openapi_types.UUID `json:"step-run"`
+	Params  LogLineStreamParams
+}
+
+type LogLineStreamResponseObject interface {
+	VisitLogLineStreamResponse(w http.ResponseWriter) error
+}
+
+type LogLineStream200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response LogLineStream200TexteventStreamResponse) VisitLogLineStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type LogLineStream400JSONResponse APIErrors
+
+func (response LogLineStream400JSONResponse) VisitLogLineStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogLineStream403JSONResponse APIErrors
+
+func (response LogLineStream403JSONResponse) VisitLogLineStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListSchedulingDecisionsRequestObject struct {
+	StepRun openapi_types.UUID `json:"step-run"`
+	Params  StepRunListSchedulingDecisionsParams
+}
+
+type StepRunListSchedulingDecisionsResponseObject interface {
+	VisitStepRunListSchedulingDecisionsResponse(w http.ResponseWriter) error
+}
+
+type StepRunListSchedulingDecisions200JSONResponse SchedulingDecisionList
+
+func (response StepRunListSchedulingDecisions200JSONResponse) VisitStepRunListSchedulingDecisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListSchedulingDecisions400JSONResponse APIErrors
+
+func (response StepRunListSchedulingDecisions400JSONResponse) VisitStepRunListSchedulingDecisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListSchedulingDecisions403JSONResponse APIErrors
+
+func (response StepRunListSchedulingDecisions403JSONResponse) VisitStepRunListSchedulingDecisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StepRunListSchedulingDecisions404JSONResponse APIErrors
+
+func (response StepRunListSchedulingDecisions404JSONResponse) VisitStepRunListSchedulingDecisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantCreateRequestObject struct {
+	Body *TenantCreateJSONRequestBody
+}
+
+type TenantCreateResponseObject interface {
+	VisitTenantCreateResponse(w http.ResponseWriter) error
+}
+
+type TenantCreate200JSONResponse Tenant
+
+func (response TenantCreate200JSONResponse) VisitTenantCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantCreate400JSONResponse APIErrors
+
+func (response TenantCreate400JSONResponse) VisitTenantCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantCreate403JSONResponse APIError
+
+func (response TenantCreate403JSONResponse) VisitTenantCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantUpdateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *TenantUpdateJSONRequestBody
+}
+
+type TenantUpdateResponseObject interface {
+	VisitTenantUpdateResponse(w http.ResponseWriter) error
+}
+
+type TenantUpdate200JSONResponse Tenant
+
+func (response TenantUpdate200JSONResponse) VisitTenantUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantUpdate400JSONResponse APIErrors
+
+func (response TenantUpdate400JSONResponse) VisitTenantUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantUpdate403JSONResponse APIError
+
+func (response TenantUpdate403JSONResponse) VisitTenantUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertEmailGroupListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type AlertEmailGroupListResponseObject interface {
+	VisitAlertEmailGroupListResponse(w http.ResponseWriter) error
+}
+
+type AlertEmailGroupList200JSONResponse TenantAlertEmailGroupList
+
+func (response AlertEmailGroupList200JSONResponse) VisitAlertEmailGroupListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertEmailGroupList400JSONResponse APIErrors
+
+func (response AlertEmailGroupList400JSONResponse) VisitAlertEmailGroupListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertEmailGroupList403JSONResponse APIError
+
+func (response AlertEmailGroupList403JSONResponse) VisitAlertEmailGroupListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertEmailGroupCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *AlertEmailGroupCreateJSONRequestBody
+}
+
+type AlertEmailGroupCreateResponseObject interface {
+	VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error
+}
+
+type AlertEmailGroupCreate201JSONResponse TenantAlertEmailGroup
+
+func (response AlertEmailGroupCreate201JSONResponse) VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertEmailGroupCreate400JSONResponse APIErrors
+
+func (response AlertEmailGroupCreate400JSONResponse) VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AlertEmailGroupCreate403JSONResponse APIError
+
+func (response AlertEmailGroupCreate403JSONResponse) VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantAlertingSettingsGetRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type TenantAlertingSettingsGetResponseObject interface {
+	VisitTenantAlertingSettingsGetResponse(w http.ResponseWriter) error
+}
+
+type TenantAlertingSettingsGet200JSONResponse TenantAlertingSettings
+
+func (response TenantAlertingSettingsGet200JSONResponse) VisitTenantAlertingSettingsGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantAlertingSettingsGet400JSONResponse APIErrors
+
+func (response TenantAlertingSettingsGet400JSONResponse) VisitTenantAlertingSettingsGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantAlertingSettingsGet403JSONResponse APIError
+
+func (response TenantAlertingSettingsGet403JSONResponse) VisitTenantAlertingSettingsGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type ApiTokenListResponseObject interface {
+	VisitApiTokenListResponse(w http.ResponseWriter) error
+}
+
+type ApiTokenList200JSONResponse ListAPITokensResponse
+
+func (response ApiTokenList200JSONResponse) VisitApiTokenListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenList400JSONResponse APIErrors
+
+func (response ApiTokenList400JSONResponse) VisitApiTokenListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenList403JSONResponse APIErrors
+
+func (response ApiTokenList403JSONResponse) VisitApiTokenListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *ApiTokenCreateJSONRequestBody
+}
+
+type ApiTokenCreateResponseObject interface {
+	VisitApiTokenCreateResponse(w http.ResponseWriter) error
+}
+
+type ApiTokenCreate200JSONResponse CreateAPITokenResponse
+
+func (response ApiTokenCreate200JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenCreate400JSONResponse APIErrors
+
+func (response ApiTokenCreate400JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApiTokenCreate403JSONResponse APIErrors
+
+func (response ApiTokenCreate403JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovalListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params ApprovalListParams
+}
+
+type ApprovalListResponseObject interface {
+	VisitApprovalListResponse(w http.ResponseWriter) error
+}
+
+type ApprovalList200JSONResponse StepRunApprovalList
+
+func (response ApprovalList200JSONResponse) VisitApprovalListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovalList400JSONResponse APIErrors
+
+func (response ApprovalList400JSONResponse) VisitApprovalListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApprovalList403JSONResponse APIErrors
+
+func (response ApprovalList403JSONResponse) VisitApprovalListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuditLogListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params AuditLogListParams
+}
+
+type AuditLogListResponseObject interface {
+	VisitAuditLogListResponse(w http.ResponseWriter) error
+}
+
+type AuditLogList200JSONResponse AuditLogList
+
+func (response AuditLogList200JSONResponse) VisitAuditLogListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuditLogList400JSONResponse APIErrors
+
+func (response AuditLogList400JSONResponse) VisitAuditLogListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuditLogList403JSONResponse APIErrors
+
+func (response AuditLogList403JSONResponse) VisitAuditLogListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClientCaListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type ClientCaListResponseObject interface {
+	VisitClientCaListResponse(w http.ResponseWriter) error
+}
+
+type ClientCaList200JSONResponse ClientCAList
+
+func (response ClientCaList200JSONResponse) VisitClientCaListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClientCaList400JSONResponse APIErrors
+
+func (response ClientCaList400JSONResponse) VisitClientCaListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClientCaList403JSONResponse APIErrors
+
+func (response ClientCaList403JSONResponse) VisitClientCaListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClientCaCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *ClientCaCreateJSONRequestBody
+}
+
+type ClientCaCreateResponseObject interface {
+	VisitClientCaCreateResponse(w http.ResponseWriter) error
+}
+
+type ClientCaCreate200JSONResponse ClientCA
+
+func (response ClientCaCreate200JSONResponse) VisitClientCaCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClientCaCreate400JSONResponse APIErrors
+
+func (response ClientCaCreate400JSONResponse) VisitClientCaCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClientCaCreate403JSONResponse APIErrors
+
+func (response ClientCaCreate403JSONResponse) VisitClientCaCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CronCalendarListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type CronCalendarListResponseObject interface {
+	VisitCronCalendarListResponse(w http.ResponseWriter) error
+}
+
+type CronCalendarList200JSONResponse CronExclusionCalendarList
+
+func (response CronCalendarList200JSONResponse) VisitCronCalendarListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CronCalendarList400JSONResponse APIErrors
+
+func (response CronCalendarList400JSONResponse) VisitCronCalendarListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CronCalendarList403JSONResponse APIErrors
+
+func (response CronCalendarList403JSONResponse) VisitCronCalendarListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CronCalendarCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *CronCalendarCreateJSONRequestBody
+}
+
+type CronCalendarCreateResponseObject interface {
+	VisitCronCalendarCreateResponse(w http.ResponseWriter) error
+}
+
+type CronCalendarCreate200JSONResponse CronExclusionCalendar
+
+func (response CronCalendarCreate200JSONResponse) VisitCronCalendarCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CronCalendarCreate400JSONResponse APIErrors
+
+func (response CronCalendarCreate400JSONResponse) VisitCronCalendarCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CronCalendarCreate403JSONResponse APIErrors
+
+func (response CronCalendarCreate403JSONResponse) VisitCronCalendarCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CronCalendarDeleteRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	CronCalendar openapi_types.UUID `json:"cron-calendar"`
+}
+
+type CronCalendarDeleteResponseObject interface {
+	VisitCronCalendarDeleteResponse(w http.ResponseWriter) error
+}
+
+type CronCalendarDelete204Response struct {
+}
+
+func (response CronCalendarDelete204Response) VisitCronCalendarDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type CronCalendarDelete400JSONResponse APIErrors
+
+func (response CronCalendarDelete400JSONResponse) VisitCronCalendarDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CronCalendarDelete403JSONResponse APIErrors
+
+func (response CronCalendarDelete403JSONResponse) VisitCronCalendarDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CronCalendarDelete404JSONResponse APIErrors
+
+func (response CronCalendarDelete404JSONResponse) VisitCronCalendarDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeadLetterQueueListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params DeadLetterQueueListParams
+}
+
+type DeadLetterQueueListResponseObject interface {
+	VisitDeadLetterQueueListResponse(w http.ResponseWriter) error
+}
+
+type DeadLetterQueueList200JSONResponse DeadLetterQueueItemList
+
+func (response DeadLetterQueueList200JSONResponse) VisitDeadLetterQueueListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeadLetterQueueList400JSONResponse APIErrors
+
+func (response DeadLetterQueueList400JSONResponse) VisitDeadLetterQueueListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeadLetterQueueList403JSONResponse APIErrors
+
+func (response DeadLetterQueueList403JSONResponse) VisitDeadLetterQueueListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeadLetterQueueDeleteRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *DeadLetterQueueDeleteJSONRequestBody
+}
+
+type DeadLetterQueueDeleteResponseObject interface {
+	VisitDeadLetterQueueDeleteResponse(w http.ResponseWriter) error
+}
+
+type DeadLetterQueueDelete200JSONResponse PurgeDeadLetterQueueItemsResponse
+
+func (response DeadLetterQueueDelete200JSONResponse) VisitDeadLetterQueueDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeadLetterQueueDelete400JSONResponse APIErrors
+
+func (response DeadLetterQueueDelete400JSONResponse) VisitDeadLetterQueueDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeadLetterQueueDelete403JSONResponse APIErrors
+
+func (response DeadLetterQueueDelete403JSONResponse) VisitDeadLetterQueueDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeadLetterQueueUpdateReplayRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *DeadLetterQueueUpdateReplayJSONRequestBody
+}
+
+type DeadLetterQueueUpdateReplayResponseObject interface {
+	VisitDeadLetterQueueUpdateReplayResponse(w http.ResponseWriter) error
+}
+
+type DeadLetterQueueUpdateReplay200JSONResponse ReplayDeadLetterQueueItemsResponse
+
+func (response DeadLetterQueueUpdateReplay200JSONResponse) VisitDeadLetterQueueUpdateReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeadLetterQueueUpdateReplay400JSONResponse APIErrors
+
+func (response DeadLetterQueueUpdateReplay400JSONResponse) VisitDeadLetterQueueUpdateReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeadLetterQueueUpdateReplay403JSONResponse APIErrors
+
+func (response DeadLetterQueueUpdateReplay403JSONResponse) VisitDeadLetterQueueUpdateReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventDedupRuleListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type EventDedupRuleListResponseObject interface {
+	VisitEventDedupRuleListResponse(w http.ResponseWriter) error
+}
+
+type EventDedupRuleList200JSONResponse EventDedupRuleList
+
+func (response EventDedupRuleList200JSONResponse) VisitEventDedupRuleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventDedupRuleList400JSONResponse APIErrors
+
+func (response EventDedupRuleList400JSONResponse) VisitEventDedupRuleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventDedupRuleList403JSONResponse APIErrors
+
+func (response EventDedupRuleList403JSONResponse) VisitEventDedupRuleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventDedupRuleUpsertRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *EventDedupRuleUpsertJSONRequestBody
+}
+
+type EventDedupRuleUpsertResponseObject interface {
+	VisitEventDedupRuleUpsertResponse(w http.ResponseWriter) error
+}
+
+type EventDedupRuleUpsert200JSONResponse EventDedupRule
+
+func (response EventDedupRuleUpsert200JSONResponse) VisitEventDedupRuleUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventDedupRuleUpsert400JSONResponse APIErrors
+
+func (response EventDedupRuleUpsert400JSONResponse) VisitEventDedupRuleUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventDedupRuleUpsert403JSONResponse APIErrors
+
+func (response EventDedupRuleUpsert403JSONResponse) VisitEventDedupRuleUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventRoutingRuleListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type EventRoutingRuleListResponseObject interface {
+	VisitEventRoutingRuleListResponse(w http.ResponseWriter) error
+}
+
+type EventRoutingRuleList200JSONResponse EventRoutingRuleList
+
+func (response EventRoutingRuleList200JSONResponse) VisitEventRoutingRuleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventRoutingRuleList400JSONResponse APIErrors
+
+func (response EventRoutingRuleList400JSONResponse) VisitEventRoutingRuleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventRoutingRuleList403JSONResponse APIErrors
+
+func (response EventRoutingRuleList403JSONResponse) VisitEventRoutingRuleListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventRoutingRuleUpsertRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *EventRoutingRuleUpsertJSONRequestBody
+}
+
+type EventRoutingRuleUpsertResponseObject interface {
+	VisitEventRoutingRuleUpsertResponse(w http.ResponseWriter) error
+}
+
+type EventRoutingRuleUpsert200JSONResponse EventRoutingRule
+
+func (response EventRoutingRuleUpsert200JSONResponse) VisitEventRoutingRuleUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventRoutingRuleUpsert400JSONResponse APIErrors
+
+func (response EventRoutingRuleUpsert400JSONResponse) VisitEventRoutingRuleUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventRoutingRuleUpsert403JSONResponse APIErrors
+
+func (response EventRoutingRuleUpsert403JSONResponse) VisitEventRoutingRuleUpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSinkListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type EventSinkListResponseObject interface {
+	VisitEventSinkListResponse(w http.ResponseWriter) error
+}
+
+type EventSinkList200JSONResponse EventSinkList
+
+func (response EventSinkList200JSONResponse) VisitEventSinkListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSinkList400JSONResponse APIErrors
+
+func (response EventSinkList400JSONResponse) VisitEventSinkListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSinkList403JSONResponse APIErrors
+
+func (response EventSinkList403JSONResponse) VisitEventSinkListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSinkCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *EventSinkCreateJSONRequestBody
+}
+
+type EventSinkCreateResponseObject interface {
+	VisitEventSinkCreateResponse(w http.ResponseWriter) error
+}
+
+type EventSinkCreate200JSONResponse EventSink
+
+func (response EventSinkCreate200JSONResponse) VisitEventSinkCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSinkCreate400JSONResponse APIErrors
+
+func (response EventSinkCreate400JSONResponse) VisitEventSinkCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventSinkCreate403JSONResponse APIErrors
+
+func (response EventSinkCreate403JSONResponse) VisitEventSinkCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params EventListParams
+}
+
+type EventListResponseObject interface {
+	VisitEventListResponse(w http.ResponseWriter) error
+}
+
+type EventList200JSONResponse EventList
+
+func (response EventList200JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventList400JSONResponse APIErrors
+
+func (response EventList400JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventList403JSONResponse APIErrors
+
+func (response EventList403JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *EventCreateJSONRequestBody
+}
+
+type EventCreateResponseObject interface {
+	VisitEventCreateResponse(w http.ResponseWriter) error
+}
+
+type EventCreate200JSONResponse Event
+
+func (response EventCreate200JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventCreate400JSONResponse APIErrors
+
+func (response EventCreate400JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventCreate403JSONResponse APIErrors
+
+func (response EventCreate403JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventCreate429JSONResponse APIErrors
+
+func (response EventCreate429JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventCreateBatchRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *EventCreateBatchJSONRequestBody
+}
+
+type EventCreateBatchResponseObject interface {
+	VisitEventCreateBatchResponse(w http.ResponseWriter) error
+}
+
+type EventCreateBatch200JSONResponse BatchCreateEventResponse
+
+func (response EventCreateBatch200JSONResponse) VisitEventCreateBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventCreateBatch400JSONResponse APIErrors
+
+func (response EventCreateBatch400JSONResponse) VisitEventCreateBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventCreateBatch403JSONResponse APIErrors
+
+func (response EventCreateBatch403JSONResponse) VisitEventCreateBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventCreateBatch429JSONResponse APIErrors
+
+func (response EventCreateBatch429JSONResponse) VisitEventCreateBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventCreateBulkRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *EventCreateBulkJSONRequestBody
+}
+
+type EventCreateBulkResponseObject interface {
+	VisitEventCreateBulkResponse(w http.ResponseWriter) error
+}
+
+type EventCreateBulk200JSONResponse BulkCreateEventResponse
+
+func (response EventCreateBulk200JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type LivenessGetResponseObject interface {
-	VisitLivenessGetResponse(w http.ResponseWriter) error
+type EventCreateBulk400JSONResponse APIErrors
+
+func (response EventCreateBulk400JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type LivenessGet200Response struct {
+type EventCreateBulk403JSONResponse APIErrors
+
+func (response EventCreateBulk403JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response LivenessGet200Response) VisitLivenessGetResponse(w http.ResponseWriter) error {
+type EventCreateBulk429JSONResponse APIErrors
+
+func (response EventCreateBulk429JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventUpdateCancelRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *EventUpdateCancelJSONRequestBody
+}
+
+type EventUpdateCancelResponseObject interface {
+	VisitEventUpdateCancelResponse(w http.ResponseWriter) error
+}
+
+type EventUpdateCancel200JSONResponse struct {
+	WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+}
+
+func (response EventUpdateCancel200JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type LivenessGet500Response struct {
+type EventUpdateCancel400JSONResponse APIErrors
+
+func (response EventUpdateCancel400JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response LivenessGet500Response) VisitLivenessGetResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
+type EventUpdateCancel403JSONResponse APIErrors
+
+func (response EventUpdateCancel403JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadinessGetRequestObject struct {
+type EventUpdateCancel429JSONResponse APIErrors
+
+func (response EventUpdateCancel429JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadinessGetResponseObject interface {
-	VisitReadinessGetResponse(w http.ResponseWriter) error
+type EventCreateCloudEventRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
 }
 
-type ReadinessGet200Response struct {
+type EventCreateCloudEventResponseObject interface {
+	VisitEventCreateCloudEventResponse(w http.ResponseWriter) error
 }
 
-func (response ReadinessGet200Response) VisitReadinessGetResponse(w http.ResponseWriter) error {
+type EventCreateCloudEvent200JSONResponse Event
+
+func (response EventCreateCloudEvent200JSONResponse) VisitEventCreateCloudEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadinessGet500Response struct {
+type EventCreateCloudEvent400JSONResponse APIErrors
+
+func (response EventCreateCloudEvent400JSONResponse) VisitEventCreateCloudEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response ReadinessGet500Response) VisitReadinessGetResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
+type EventCreateCloudEvent403JSONResponse APIErrors
+
+func (response EventCreateCloudEvent403JSONResponse) VisitEventCreateCloudEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupDeleteRequestObject struct {
-	AlertEmailGroup openapi_types.UUID `json:"alert-email-group"`
+type EventCreateCloudEvent429JSONResponse APIErrors
+
+func (response EventCreateCloudEvent429JSONResponse) VisitEventCreateCloudEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupDeleteResponseObject interface {
-	VisitAlertEmailGroupDeleteResponse(w http.ResponseWriter) error
+type EventKeyListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
 }
 
-type AlertEmailGroupDelete204Response struct {
+type EventKeyListResponseObject interface {
+	VisitEventKeyListResponse(w http.ResponseWriter) error
+}
+
+type EventKeyList200JSONResponse EventKeyList
+
+func (response EventKeyList200JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response AlertEmailGroupDelete204Response) VisitAlertEmailGroupDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type EventKeyList400JSONResponse APIErrors
+
+func (response EventKeyList400JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventKeyList403JSONResponse APIErrors
+
+func (response EventKeyList403JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventUpdateReplayRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *EventUpdateReplayJSONRequestBody
+}
+
+type EventUpdateReplayResponseObject interface {
+	VisitEventUpdateReplayResponse(w http.ResponseWriter) error
+}
+
+type EventUpdateReplay200JSONResponse EventList
+
+func (response EventUpdateReplay200JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventUpdateReplay400JSONResponse APIErrors
+
+func (response EventUpdateReplay400JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventUpdateReplay403JSONResponse APIErrors
+
+func (response EventUpdateReplay403JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventUpdateReplay429JSONResponse APIErrors
+
+func (response EventUpdateReplay429JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventUpdateReplayRangeRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *EventUpdateReplayRangeJSONRequestBody
+}
+
+type EventUpdateReplayRangeResponseObject interface {
+	VisitEventUpdateReplayRangeResponse(w http.ResponseWriter) error
+}
+
+type EventUpdateReplayRange200JSONResponse ReplayEventRangeResponse
+
+func (response EventUpdateReplayRange200JSONResponse) VisitEventUpdateReplayRangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventUpdateReplayRange400JSONResponse APIErrors
+
+func (response EventUpdateReplayRange400JSONResponse) VisitEventUpdateReplayRangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventUpdateReplayRange403JSONResponse APIErrors
+
+func (response EventUpdateReplayRange403JSONResponse) VisitEventUpdateReplayRangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EventUpdateReplayRange429JSONResponse APIErrors
+
+func (response EventUpdateReplayRange429JSONResponse) VisitEventUpdateReplayRangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InboundWebhookListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type InboundWebhookListResponseObject interface {
+	VisitInboundWebhookListResponse(w http.ResponseWriter) error
+}
+
+type InboundWebhookList200JSONResponse InboundWebhookList
+
+func (response InboundWebhookList200JSONResponse) VisitInboundWebhookListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InboundWebhookList400JSONResponse APIErrors
+
+func (response InboundWebhookList400JSONResponse) VisitInboundWebhookListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InboundWebhookList403JSONResponse APIErrors
+
+func (response InboundWebhookList403JSONResponse) VisitInboundWebhookListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InboundWebhookCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *InboundWebhookCreateJSONRequestBody
+}
+
+type InboundWebhookCreateResponseObject interface {
+	VisitInboundWebhookCreateResponse(w http.ResponseWriter) error
+}
+
+type InboundWebhookCreate200JSONResponse InboundWebhook
+
+func (response InboundWebhookCreate200JSONResponse) VisitInboundWebhookCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupDelete400JSONResponse APIErrors
+type InboundWebhookCreate400JSONResponse APIErrors
 
-func (response AlertEmailGroupDelete400JSONResponse) VisitAlertEmailGroupDeleteResponse(w http.ResponseWriter) error {
+func (response InboundWebhookCreate400JSONResponse) VisitInboundWebhookCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupDelete403JSONResponse APIError
+type InboundWebhookCreate403JSONResponse APIErrors
 
-func (response AlertEmailGroupDelete403JSONResponse) VisitAlertEmailGroupDeleteResponse(w http.ResponseWriter) error {
+func (response InboundWebhookCreate403JSONResponse) VisitInboundWebhookCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupUpdateRequestObject struct {
-	AlertEmailGroup openapi_types.UUID `json:"alert-email-group"`
-	Body            *AlertEmailGroupUpdateJSONRequestBody
+type TenantInviteListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
 }
 
-type AlertEmailGroupUpdateResponseObject interface {
-	VisitAlertEmailGroupUpdateResponse(w http.ResponseWriter) error
+type TenantInviteListResponseObject interface {
+	VisitTenantInviteListResponse(w http.ResponseWriter) error
 }
 
-type AlertEmailGroupUpdate200JSONResponse TenantAlertEmailGroup
+type TenantInviteList200JSONResponse TenantInviteList
 
-func (response AlertEmailGroupUpdate200JSONResponse) VisitAlertEmailGroupUpdateResponse(w http.ResponseWriter) error {
+func (response TenantInviteList200JSONResponse) VisitTenantInviteListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupUpdate400JSONResponse APIErrors
+type TenantInviteList400JSONResponse APIErrors
 
-func (response AlertEmailGroupUpdate400JSONResponse) VisitAlertEmailGroupUpdateResponse(w http.ResponseWriter) error {
+func (response TenantInviteList400JSONResponse) VisitTenantInviteListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupUpdate403JSONResponse APIError
+type TenantInviteList403JSONResponse APIError
 
-func (response AlertEmailGroupUpdate403JSONResponse) VisitAlertEmailGroupUpdateResponse(w http.ResponseWriter) error {
+func (response TenantInviteList403JSONResponse) VisitTenantInviteListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenUpdateRevokeRequestObject struct {
-	ApiToken openapi_types.UUID `json:"api-token"`
+type TenantInviteCreateRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *TenantInviteCreateJSONRequestBody
 }
 
-type ApiTokenUpdateRevokeResponseObject interface {
-	VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error
+type TenantInviteCreateResponseObject interface {
+	VisitTenantInviteCreateResponse(w http.ResponseWriter) error
 }
 
-type ApiTokenUpdateRevoke204Response struct {
-}
+type TenantInviteCreate201JSONResponse TenantInvite
 
-func (response ApiTokenUpdateRevoke204Response) VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response TenantInviteCreate201JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenUpdateRevoke400JSONResponse APIErrors
+type TenantInviteCreate400JSONResponse APIErrors
 
-func (response ApiTokenUpdateRevoke400JSONResponse) VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error {
+func (response TenantInviteCreate400JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenUpdateRevoke403JSONResponse APIErrors
+type TenantInviteCreate403JSONResponse APIError
 
-func (response ApiTokenUpdateRevoke403JSONResponse) VisitApiTokenUpdateRevokeResponse(w http.ResponseWriter) error {
+func (response TenantInviteCreate403JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CloudMetadataGetRequestObject struct {
+type TenantInviteDeleteRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	TenantInvite openapi_types.UUID `json:"tenant-invite"`
 }
 
-type CloudMetadataGetResponseObject interface {
-	VisitCloudMetadataGetResponse(w http.ResponseWriter) error
+type TenantInviteDeleteResponseObject interface {
+	VisitTenantInviteDeleteResponse(w http.ResponseWriter) error
 }
 
-type CloudMetadataGet200JSONResponse APIErrors
+type TenantInviteDelete200JSONResponse TenantInvite
 
-func (response CloudMetadataGet200JSONResponse) VisitCloudMetadataGetResponse(w http.ResponseWriter) error {
+func (response TenantInviteDelete200JSONResponse) VisitTenantInviteDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CloudMetadataGet400JSONResponse APIErrors
+type TenantInviteDelete400JSONResponse APIErrors
 
-func (response CloudMetadataGet400JSONResponse) VisitCloudMetadataGetResponse(w http.ResponseWriter) error {
+func (response TenantInviteDelete400JSONResponse) VisitTenantInviteDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventGetRequestObject struct {
-	Event openapi_types.UUID `json:"event"`
+type TenantInviteUpdateRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	TenantInvite openapi_types.UUID `json:"tenant-invite"`
+	Body         *TenantInviteUpdateJSONRequestBody
 }
 
-type EventGetResponseObject interface {
-	VisitEventGetResponse(w http.ResponseWriter) error
+type TenantInviteUpdateResponseObject interface {
+	VisitTenantInviteUpdateResponse(w http.ResponseWriter) error
 }
 
-type EventGet200JSONResponse Event
+type TenantInviteUpdate200JSONResponse TenantInvite
 
-func (response EventGet200JSONResponse) VisitEventGetResponse(w http.ResponseWriter) error {
+func (response TenantInviteUpdate200JSONResponse) VisitTenantInviteUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventGet400JSONResponse APIErrors
+type TenantInviteUpdate400JSONResponse APIErrors
 
-func (response EventGet400JSONResponse) VisitEventGetResponse(w http.ResponseWriter) error {
+func (response TenantInviteUpdate400JSONResponse) VisitTenantInviteUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventGet403JSONResponse APIErrors
-
-func (response EventGet403JSONResponse) VisitEventGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type EventDataGetRequestObject struct {
-	Event openapi_types.UUID `json:"event"`
+type TenantMemberListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
 }
 
-type EventDataGetResponseObject interface {
-	VisitEventDataGetResponse(w http.ResponseWriter) error
+type TenantMemberListResponseObject interface {
+	VisitTenantMemberListResponse(w http.ResponseWriter) error
 }
 
-type EventDataGet200JSONResponse EventData
+type TenantMemberList200JSONResponse TenantMemberList
 
-func (response EventDataGet200JSONResponse) VisitEventDataGetResponse(w http.ResponseWriter) error {
+func (response TenantMemberList200JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventDataGet400JSONResponse APIErrors
+type TenantMemberList400JSONResponse APIErrors
 
-func (response EventDataGet400JSONResponse) VisitEventDataGetResponse(w http.ResponseWriter) error {
+func (response TenantMemberList400JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventDataGet403JSONResponse APIErrors
+type TenantMemberList403JSONResponse APIError
 
-func (response EventDataGet403JSONResponse) VisitEventDataGetResponse(w http.ResponseWriter) error {
+func (response TenantMemberList403JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MetadataGetRequestObject struct {
+type TenantMemberDeleteRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Member openapi_types.UUID `json:"member"`
 }
 
-type MetadataGetResponseObject interface {
-	VisitMetadataGetResponse(w http.ResponseWriter) error
+type TenantMemberDeleteResponseObject interface {
+	VisitTenantMemberDeleteResponse(w http.ResponseWriter) error
 }
 
-type MetadataGet200JSONResponse APIMeta
+type TenantMemberDelete204JSONResponse TenantMember
 
-func (response MetadataGet200JSONResponse) VisitMetadataGetResponse(w http.ResponseWriter) error {
+func (response TenantMemberDelete204JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(204)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MetadataGet400JSONResponse APIErrors
+type TenantMemberDelete400JSONResponse APIErrors
 
-func (response MetadataGet400JSONResponse) VisitMetadataGetResponse(w http.ResponseWriter) error {
+func (response TenantMemberDelete400JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MetadataListIntegrationsRequestObject struct {
-}
-
-type MetadataListIntegrationsResponseObject interface {
-	VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error
-}
-
-type MetadataListIntegrations200JSONResponse ListAPIMetaIntegration
+type TenantMemberDelete403JSONResponse APIErrors
 
-func (response MetadataListIntegrations200JSONResponse) VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error {
+func (response TenantMemberDelete403JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MetadataListIntegrations400JSONResponse APIErrors
+type TenantMemberDelete404JSONResponse APIErrors
 
-func (response MetadataListIntegrations400JSONResponse) VisitMetadataListIntegrationsResponse(w http.ResponseWriter) error {
+func (response TenantMemberDelete404JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SlackWebhookDeleteRequestObject struct {
-	Slack openapi_types.UUID `json:"slack"`
+type TenantGetQueueMetricsRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params TenantGetQueueMetricsParams
 }
 
-type SlackWebhookDeleteResponseObject interface {
-	VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error
+type TenantGetQueueMetricsResponseObject interface {
+	VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error
 }
 
-type SlackWebhookDelete204Response struct {
-}
+type TenantGetQueueMetrics200JSONResponse TenantQueueMetrics
 
-func (response SlackWebhookDelete204Response) VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response TenantGetQueueMetrics200JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type SlackWebhookDelete400JSONResponse APIErrors
+type TenantGetQueueMetrics400JSONResponse APIErrors
 
-func (response SlackWebhookDelete400JSONResponse) VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error {
+func (response TenantGetQueueMetrics400JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SlackWebhookDelete401JSONResponse APIErrors
+type TenantGetQueueMetrics403JSONResponse APIErrors
 
-func (response SlackWebhookDelete401JSONResponse) VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error {
+func (response TenantGetQueueMetrics403JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SlackWebhookDelete405JSONResponse APIErrors
+type TenantGetQueueMetrics404JSONResponse APIErrors
 
-func (response SlackWebhookDelete405JSONResponse) VisitSlackWebhookDeleteResponse(w http.ResponseWriter) error {
+func (response TenantGetQueueMetrics404JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsDeleteRequestObject struct {
-	Sns openapi_types.UUID `json:"sns"`
+type QueueUpdatePauseRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Queue  string             `json:"queue"`
 }
 
-type SnsDeleteResponseObject interface {
-	VisitSnsDeleteResponse(w http.ResponseWriter) error
+type QueueUpdatePauseResponseObject interface {
+	VisitQueueUpdatePauseResponse(w http.ResponseWriter) error
 }
 
-type SnsDelete204Response struct {
-}
+type QueueUpdatePause200JSONResponse Queue
 
-func (response SnsDelete204Response) VisitSnsDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response QueueUpdatePause200JSONResponse) VisitQueueUpdatePauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsDelete400JSONResponse APIErrors
+type QueueUpdatePause400JSONResponse APIErrors
 
-func (response SnsDelete400JSONResponse) VisitSnsDeleteResponse(w http.ResponseWriter) error {
+func (response QueueUpdatePause400JSONResponse) VisitQueueUpdatePauseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsDelete401JSONResponse APIErrors
+type QueueUpdatePause403JSONResponse APIErrors
 
-func (response SnsDelete401JSONResponse) VisitSnsDeleteResponse(w http.ResponseWriter) error {
+func (response QueueUpdatePause403JSONResponse) VisitQueueUpdatePauseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsDelete405JSONResponse APIErrors
+type QueueUpdatePause404JSONResponse APIErrors
 
-func (response SnsDelete405JSONResponse) VisitSnsDeleteResponse(w http.ResponseWriter) error {
+func (response QueueUpdatePause404JSONResponse) VisitQueueUpdatePauseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsUpdateRequestObject struct {
+type QueueUpdateResumeRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Event  string             `json:"event"`
+	Queue  string             `json:"queue"`
 }
 
-type SnsUpdateResponseObject interface {
-	VisitSnsUpdateResponse(w http.ResponseWriter) error
+type QueueUpdateResumeResponseObject interface {
+	VisitQueueUpdateResumeResponse(w http.ResponseWriter) error
 }
 
-type SnsUpdate200Response struct {
-}
+type QueueUpdateResume200JSONResponse Queue
 
-func (response SnsUpdate200Response) VisitSnsUpdateResponse(w http.ResponseWriter) error {
+func (response QueueUpdateResume200JSONResponse) VisitQueueUpdateResumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsUpdate400JSONResponse APIErrors
+type QueueUpdateResume400JSONResponse APIErrors
 
-func (response SnsUpdate400JSONResponse) VisitSnsUpdateResponse(w http.ResponseWriter) error {
+func (response QueueUpdateResume400JSONResponse) VisitQueueUpdateResumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsUpdate401JSONResponse APIErrors
+type QueueUpdateResume403JSONResponse APIErrors
 
-func (response SnsUpdate401JSONResponse) VisitSnsUpdateResponse(w http.ResponseWriter) error {
+func (response QueueUpdateResume403JSONResponse) VisitQueueUpdateResumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsUpdate405JSONResponse APIErrors
+type QueueUpdateResume404JSONResponse APIErrors
 
-func (response SnsUpdate405JSONResponse) VisitSnsUpdateResponse(w http.ResponseWriter) error {
+func (response QueueUpdateResume404JSONResponse) VisitQueueUpdateResumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunListArchivesRequestObject struct {
-	StepRun openapi_types.UUID `json:"step-run"`
-	Params  StepRunListArchivesParams
-}
-
-type StepRunListArchivesResponseObject interface {
-	VisitStepRunListArchivesResponse(w http.ResponseWriter) error
+type TenantQuotaListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
 }
 
-type StepRunListArchives200JSONResponse StepRunArchiveList
-
-func (response StepRunListArchives200JSONResponse) VisitStepRunListArchivesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+type TenantQuotaListResponseObject interface {
+	VisitTenantQuotaListResponse(w http.ResponseWriter) error
 }
 
-type StepRunListArchives400JSONResponse APIErrors
+type TenantQuotaList200JSONResponse TenantQuotaUsageList
 
-func (response StepRunListArchives400JSONResponse) VisitStepRunListArchivesResponse(w http.ResponseWriter) error {
+func (response TenantQuotaList200JSONResponse) VisitTenantQuotaListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunListArchives403JSONResponse APIErrors
+type TenantQuotaList400JSONResponse APIErrors
 
-func (response StepRunListArchives403JSONResponse) VisitStepRunListArchivesResponse(w http.ResponseWriter) error {
+func (response TenantQuotaList400JSONResponse) VisitTenantQuotaListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunListArchives404JSONResponse APIErrors
+type TenantQuotaList403JSONResponse APIErrors
 
-func (response StepRunListArchives404JSONResponse) VisitStepRunListArchivesResponse(w http.ResponseWriter) error {
+func (response TenantQuotaList403JSONResponse) VisitTenantQuotaListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunListEventsRequestObject struct {
-	StepRun openapi_types.UUID `json:"step-run"`
-	Params  StepRunListEventsParams
+type RateLimitListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params RateLimitListParams
 }
 
-type StepRunListEventsResponseObject interface {
-	VisitStepRunListEventsResponse(w http.ResponseWriter) error
+type RateLimitListResponseObject interface {
+	VisitRateLimitListResponse(w http.ResponseWriter) error
 }
 
-type StepRunListEvents200JSONResponse StepRunEventList
+type RateLimitList200JSONResponse RateLimitList
 
-func (response StepRunListEvents200JSONResponse) VisitStepRunListEventsResponse(w http.ResponseWriter) error {
+func (response RateLimitList200JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunListEvents400JSONResponse APIErrors
+type RateLimitList400JSONResponse APIErrors
 
-func (response StepRunListEvents400JSONResponse) VisitStepRunListEventsResponse(w http.ResponseWriter) error {
+func (response RateLimitList400JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunListEvents403JSONResponse APIErrors
+type RateLimitList403JSONResponse APIErrors
 
-func (response StepRunListEvents403JSONResponse) VisitStepRunListEventsResponse(w http.ResponseWriter) error {
+func (response RateLimitList403JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunListEvents404JSONResponse APIErrors
-
-func (response StepRunListEvents404JSONResponse) VisitStepRunListEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type LogLineListRequestObject struct {
-	StepRun openapi_types.UUID `json:"step-run"`
-	Params  LogLineListParams
+type TenantResourcePolicyGetRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
 }
 
-type LogLineListResponseObject interface {
-	VisitLogLineListResponse(w http.ResponseWriter) error
+type TenantResourcePolicyGetResponseObject interface {
+	VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error
 }
 
-type LogLineList200JSONResponse LogLineList
+type TenantResourcePolicyGet200JSONResponse TenantResourcePolicy
 
-func (response LogLineList200JSONResponse) VisitLogLineListResponse(w http.ResponseWriter) error {
+func (response TenantResourcePolicyGet200JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type LogLineList400JSONResponse APIErrors
+type TenantResourcePolicyGet400JSONResponse APIErrors
 
-func (response LogLineList400JSONResponse) VisitLogLineListResponse(w http.ResponseWriter) error {
+func (response TenantResourcePolicyGet400JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type LogLineList403JSONResponse APIErrors
+type TenantResourcePolicyGet403JSONResponse APIError
 
-func (response LogLineList403JSONResponse) VisitLogLineListResponse(w http.ResponseWriter) error {
+func (response TenantResourcePolicyGet403JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantCreateRequestObject struct {
-	Body *TenantCreateJSONRequestBody
+type SlackWebhookListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
 }
 
-type TenantCreateResponseObject interface {
-	VisitTenantCreateResponse(w http.ResponseWriter) error
+type SlackWebhookListResponseObject interface {
+	VisitSlackWebhookListResponse(w http.ResponseWriter) error
 }
 
-type TenantCreate200JSONResponse Tenant
+type SlackWebhookList200JSONResponse ListSlackWebhooks
 
-func (response TenantCreate200JSONResponse) VisitTenantCreateResponse(w http.ResponseWriter) error {
+func (response SlackWebhookList200JSONResponse) VisitSlackWebhookListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantCreate400JSONResponse APIErrors
+type SlackWebhookList400JSONResponse APIErrors
 
-func (response TenantCreate400JSONResponse) VisitTenantCreateResponse(w http.ResponseWriter) error {
+func (response SlackWebhookList400JSONResponse) VisitSlackWebhookListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantCreate403JSONResponse APIError
+type SlackWebhookList401JSONResponse APIErrors
 
-func (response TenantCreate403JSONResponse) VisitTenantCreateResponse(w http.ResponseWriter) error {
+func (response SlackWebhookList401JSONResponse) VisitSlackWebhookListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantUpdateRequestObject struct {
+type SlackWebhookList405JSONResponse APIErrors
+
+func (response SlackWebhookList405JSONResponse) VisitSlackWebhookListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UserUpdateSlackOauthStartRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *TenantUpdateJSONRequestBody
 }
 
-type TenantUpdateResponseObject interface {
-	VisitTenantUpdateResponse(w http.ResponseWriter) error
+type UserUpdateSlackOauthStartResponseObject interface {
+	VisitUserUpdateSlackOauthStartResponse(w http.ResponseWriter) error
 }
 
-type TenantUpdate200JSONResponse Tenant
+type UserUpdateSlackOauthStart302ResponseHeaders struct {
+	Location string
+}
 
-func (response TenantUpdate200JSONResponse) VisitTenantUpdateResponse(w http.ResponseWriter) error {
+type UserUpdateSlackOauthStart302Response struct {
+	Headers UserUpdateSlackOauthStart302ResponseHeaders
+}
+
+func (response UserUpdateSlackOauthStart302Response) VisitUserUpdateSlackOauthStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type SnsListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
+
+type SnsListResponseObject interface {
+	VisitSnsListResponse(w http.ResponseWriter) error
+}
+
+type SnsList200JSONResponse ListSNSIntegrations
+
+func (response SnsList200JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantUpdate400JSONResponse APIErrors
+type SnsList400JSONResponse APIErrors
 
-func (response TenantUpdate400JSONResponse) VisitTenantUpdateResponse(w http.ResponseWriter) error {
+func (response SnsList400JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantUpdate403JSONResponse APIError
+type SnsList401JSONResponse APIErrors
 
-func (response TenantUpdate403JSONResponse) VisitTenantUpdateResponse(w http.ResponseWriter) error {
+func (response SnsList401JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupListRequestObject struct {
+type SnsList405JSONResponse APIErrors
+
+func (response SnsList405JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SnsCreateRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *SnsCreateJSONRequestBody
 }
 
-type AlertEmailGroupListResponseObject interface {
-	VisitAlertEmailGroupListResponse(w http.ResponseWriter) error
+type SnsCreateResponseObject interface {
+	VisitSnsCreateResponse(w http.ResponseWriter) error
 }
 
-type AlertEmailGroupList200JSONResponse TenantAlertEmailGroupList
+type SnsCreate201JSONResponse SNSIntegration
 
-func (response AlertEmailGroupList200JSONResponse) VisitAlertEmailGroupListResponse(w http.ResponseWriter) error {
+func (response SnsCreate201JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupList400JSONResponse APIErrors
+type SnsCreate400JSONResponse APIErrors
 
-func (response AlertEmailGroupList400JSONResponse) VisitAlertEmailGroupListResponse(w http.ResponseWriter) error {
+func (response SnsCreate400JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupList403JSONResponse APIError
+type SnsCreate401JSONResponse APIErrors
 
-func (response AlertEmailGroupList403JSONResponse) VisitAlertEmailGroupListResponse(w http.ResponseWriter) error {
+func (response SnsCreate401JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupCreateRequestObject struct {
+type SnsCreate405JSONResponse APIErrors
+
+func (response SnsCreate405JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SqsListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *AlertEmailGroupCreateJSONRequestBody
 }
 
-type AlertEmailGroupCreateResponseObject interface {
-	VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error
+type SqsListResponseObject interface {
+	VisitSqsListResponse(w http.ResponseWriter) error
 }
 
-type AlertEmailGroupCreate201JSONResponse TenantAlertEmailGroup
+type SqsList200JSONResponse ListSQSIntegrations
 
-func (response AlertEmailGroupCreate201JSONResponse) VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error {
+func (response SqsList200JSONResponse) VisitSqsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupCreate400JSONResponse APIErrors
+type SqsList400JSONResponse APIErrors
 
-func (response AlertEmailGroupCreate400JSONResponse) VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error {
+func (response SqsList400JSONResponse) VisitSqsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AlertEmailGroupCreate403JSONResponse APIError
+type SqsList401JSONResponse APIErrors
 
-func (response AlertEmailGroupCreate403JSONResponse) VisitAlertEmailGroupCreateResponse(w http.ResponseWriter) error {
+func (response SqsList401JSONResponse) VisitSqsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantAlertingSettingsGetRequestObject struct {
+type SqsList405JSONResponse APIErrors
+
+func (response SqsList405JSONResponse) VisitSqsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SqsCreateRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *SqsCreateJSONRequestBody
 }
 
-type TenantAlertingSettingsGetResponseObject interface {
-	VisitTenantAlertingSettingsGetResponse(w http.ResponseWriter) error
+type SqsCreateResponseObject interface {
+	VisitSqsCreateResponse(w http.ResponseWriter) error
 }
 
-type TenantAlertingSettingsGet200JSONResponse TenantAlertingSettings
+type SqsCreate201JSONResponse SQSIntegration
 
-func (response TenantAlertingSettingsGet200JSONResponse) VisitTenantAlertingSettingsGetResponse(w http.ResponseWriter) error {
+func (response SqsCreate201JSONResponse) VisitSqsCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantAlertingSettingsGet400JSONResponse APIErrors
+type SqsCreate400JSONResponse APIErrors
 
-func (response TenantAlertingSettingsGet400JSONResponse) VisitTenantAlertingSettingsGetResponse(w http.ResponseWriter) error {
+func (response SqsCreate400JSONResponse) VisitSqsCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantAlertingSettingsGet403JSONResponse APIError
+type SqsCreate401JSONResponse APIErrors
 
-func (response TenantAlertingSettingsGet403JSONResponse) VisitTenantAlertingSettingsGetResponse(w http.ResponseWriter) error {
+func (response SqsCreate401JSONResponse) VisitSqsCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenListRequestObject struct {
+type SqsCreate405JSONResponse APIErrors
+
+func (response SqsCreate405JSONResponse) VisitSqsCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TenantGetStepRunQueueMetricsRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 }
 
-type ApiTokenListResponseObject interface {
-	VisitApiTokenListResponse(w http.ResponseWriter) error
+type TenantGetStepRunQueueMetricsResponseObject interface {
+	VisitTenantGetStepRunQueueMetricsResponse(w http.ResponseWriter) error
 }
 
-type ApiTokenList200JSONResponse ListAPITokensResponse
+type TenantGetStepRunQueueMetrics200JSONResponse TenantStepRunQueueMetrics
 
-func (response ApiTokenList200JSONResponse) VisitApiTokenListResponse(w http.ResponseWriter) error {
+func (response TenantGetStepRunQueueMetrics200JSONResponse) VisitTenantGetStepRunQueueMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenList400JSONResponse APIErrors
+type TenantGetStepRunQueueMetrics400JSONResponse APIErrors
 
-func (response ApiTokenList400JSONResponse) VisitApiTokenListResponse(w http.ResponseWriter) error {
+func (response TenantGetStepRunQueueMetrics400JSONResponse) VisitTenantGetStepRunQueueMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenList403JSONResponse APIErrors
+type TenantGetStepRunQueueMetrics403JSONResponse APIErrors
 
-func (response ApiTokenList403JSONResponse) VisitApiTokenListResponse(w http.ResponseWriter) error {
+func (response TenantGetStepRunQueueMetrics403JSONResponse) VisitTenantGetStepRunQueueMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenCreateRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *ApiTokenCreateJSONRequestBody
+type TenantGetStepRunQueueMetrics404JSONResponse APIErrors
+
+func (response TenantGetStepRunQueueMetrics404JSONResponse) VisitTenantGetStepRunQueueMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenCreateResponseObject interface {
-	VisitApiTokenCreateResponse(w http.ResponseWriter) error
+type StepRunGetRequestObject struct {
+	Tenant  openapi_types.UUID `json:"tenant"`
+	StepRun openapi_types.UUID `json:"step-run"`
+}
+
+type StepRunGetResponseObject interface {
+	VisitStepRunGetResponse(w http.ResponseWriter) error
 }
 
-type ApiTokenCreate200JSONResponse CreateAPITokenResponse
+type StepRunGet200JSONResponse StepRun
 
-func (response ApiTokenCreate200JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
+func (response StepRunGet200JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenCreate400JSONResponse APIErrors
+type StepRunGet400JSONResponse APIErrors
 
-func (response ApiTokenCreate400JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
+func (response StepRunGet400JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApiTokenCreate403JSONResponse APIErrors
+type StepRunGet403JSONResponse APIErrors
 
-func (response ApiTokenCreate403JSONResponse) VisitApiTokenCreateResponse(w http.ResponseWriter) error {
+func (response StepRunGet403JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Params EventListParams
+type StepRunGet404JSONResponse APIErrors
+
+func (response StepRunGet404JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type EventListResponseObject interface {
-	VisitEventListResponse(w http.ResponseWriter) error
+type StepRunUpdateCancelRequestObject struct {
+	Tenant  openapi_types.UUID `json:"tenant"`
+	StepRun openapi_types.UUID `json:"step-run"`
 }
 
-type EventList200JSONResponse EventList
+type StepRunUpdateCancelResponseObject interface {
+	VisitStepRunUpdateCancelResponse(w http.ResponseWriter) error
+}
 
-func (response EventList200JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
+type StepRunUpdateCancel200JSONResponse StepRun
+
+func (response StepRunUpdateCancel200JSONResponse) VisitStepRunUpdateCancelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventList400JSONResponse APIErrors
+type StepRunUpdateCancel400JSONResponse APIErrors
 
-func (response EventList400JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
+func (response StepRunUpdateCancel400JSONResponse) VisitStepRunUpdateCancelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventList403JSONResponse APIErrors
+type StepRunUpdateCancel403JSONResponse APIErrors
 
-func (response EventList403JSONResponse) VisitEventListResponse(w http.ResponseWriter) error {
+func (response StepRunUpdateCancel403JSONResponse) VisitStepRunUpdateCancelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreateRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *EventCreateJSONRequestBody
+type StepRunUpdateRerunRequestObject struct {
+	Tenant  openapi_types.UUID `json:"tenant"`
+	StepRun openapi_types.UUID `json:"step-run"`
+	Body    *StepRunUpdateRerunJSONRequestBody
 }
 
-type EventCreateResponseObject interface {
-	VisitEventCreateResponse(w http.ResponseWriter) error
+type StepRunUpdateRerunResponseObject interface {
+	VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error
 }
 
-type EventCreate200JSONResponse Event
+type StepRunUpdateRerun200JSONResponse StepRun
 
-func (response EventCreate200JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
+func (response StepRunUpdateRerun200JSONResponse) VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreate400JSONResponse APIErrors
+type StepRunUpdateRerun400JSONResponse APIErrors
 
-func (response EventCreate400JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
+func (response StepRunUpdateRerun400JSONResponse) VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreate403JSONResponse APIErrors
+type StepRunUpdateRerun403JSONResponse APIErrors
 
-func (response EventCreate403JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
+func (response StepRunUpdateRerun403JSONResponse) VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreate429JSONResponse APIErrors
-
-func (response EventCreate429JSONResponse) VisitEventCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type EventCreateBulkRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *EventCreateBulkJSONRequestBody
+type StepRunGetSchemaRequestObject struct {
+	Tenant  openapi_types.UUID `json:"tenant"`
+	StepRun openapi_types.UUID `json:"step-run"`
 }
 
-type EventCreateBulkResponseObject interface {
-	VisitEventCreateBulkResponse(w http.ResponseWriter) error
+type StepRunGetSchemaResponseObject interface {
+	VisitStepRunGetSchemaResponse(w http.ResponseWriter) error
 }
 
-type EventCreateBulk200JSONResponse BulkCreateEventResponse
+type StepRunGetSchema200JSONResponse map[string]interface{}
 
-func (response EventCreateBulk200JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
+func (response StepRunGetSchema200JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreateBulk400JSONResponse APIErrors
+type StepRunGetSchema400JSONResponse APIErrors
 
-func (response EventCreateBulk400JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
+func (response StepRunGetSchema400JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreateBulk403JSONResponse APIErrors
+type StepRunGetSchema403JSONResponse APIErrors
 
-func (response EventCreateBulk403JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
+func (response StepRunGetSchema403JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventCreateBulk429JSONResponse APIErrors
+type StepRunGetSchema404JSONResponse APIErrors
 
-func (response EventCreateBulk429JSONResponse) VisitEventCreateBulkResponse(w http.ResponseWriter) error {
+func (response StepRunGetSchema404JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateCancelRequestObject struct {
+type WebhookListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *EventUpdateCancelJSONRequestBody
 }
 
-type EventUpdateCancelResponseObject interface {
-	VisitEventUpdateCancelResponse(w http.ResponseWriter) error
+type WebhookListResponseObject interface {
+	VisitWebhookListResponse(w http.ResponseWriter) error
 }
 
-type EventUpdateCancel200JSONResponse struct {
-	WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
-}
+type WebhookList200JSONResponse WebhookWorkerListResponse
 
-func (response EventUpdateCancel200JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
+func (response WebhookList200JSONResponse) VisitWebhookListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateCancel400JSONResponse APIErrors
+type WebhookList400JSONResponse APIErrors
 
-func (response EventUpdateCancel400JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
+func (response WebhookList400JSONResponse) VisitWebhookListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateCancel403JSONResponse APIErrors
+type WebhookList401JSONResponse APIErrors
 
-func (response EventUpdateCancel403JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
+func (response WebhookList401JSONResponse) VisitWebhookListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateCancel429JSONResponse APIErrors
+type WebhookList405JSONResponse APIErrors
 
-func (response EventUpdateCancel429JSONResponse) VisitEventUpdateCancelResponse(w http.ResponseWriter) error {
+func (response WebhookList405JSONResponse) VisitWebhookListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
+	w.WriteHeader(405)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventKeyListRequestObject struct {
+type WebhookCreateRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
+	Body   *WebhookCreateJSONRequestBody
 }
 
-type EventKeyListResponseObject interface {
-	VisitEventKeyListResponse(w http.ResponseWriter) error
+type WebhookCreateResponseObject interface {
+	VisitWebhookCreateResponse(w http.ResponseWriter) error
 }
 
-type EventKeyList200JSONResponse EventKeyList
+type WebhookCreate200JSONResponse WebhookWorkerCreated
 
-func (response EventKeyList200JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
+func (response WebhookCreate200JSONResponse) VisitWebhookCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventKeyList400JSONResponse APIErrors
+type WebhookCreate400JSONResponse APIErrors
 
-func (response EventKeyList400JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
+func (response WebhookCreate400JSONResponse) VisitWebhookCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventKeyList403JSONResponse APIErrors
+type WebhookCreate401JSONResponse APIErrors
 
-func (response EventKeyList403JSONResponse) VisitEventKeyListResponse(w http.ResponseWriter) error {
+func (response WebhookCreate401JSONResponse) VisitWebhookCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateReplayRequestObject struct {
+type WebhookCreate405JSONResponse APIErrors
+
+func (response WebhookCreate405JSONResponse) VisitWebhookCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WorkerListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *EventUpdateReplayJSONRequestBody
 }
 
-type EventUpdateReplayResponseObject interface {
-	VisitEventUpdateReplayResponse(w http.ResponseWriter) error
+type WorkerListResponseObject interface {
+	VisitWorkerListResponse(w http.ResponseWriter) error
 }
 
-type EventUpdateReplay200JSONResponse EventList
+type WorkerList200JSONResponse WorkerList
 
-func (response EventUpdateReplay200JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+func (response WorkerList200JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateReplay400JSONResponse APIErrors
+type WorkerList400JSONResponse APIErrors
 
-func (response EventUpdateReplay400JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+func (response WorkerList400JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateReplay403JSONResponse APIErrors
+type WorkerList403JSONResponse APIErrors
 
-func (response EventUpdateReplay403JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
+func (response WorkerList403JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EventUpdateReplay429JSONResponse APIErrors
-
-func (response EventUpdateReplay429JSONResponse) VisitEventUpdateReplayResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type TenantInviteListRequestObject struct {
+type WorkerPoolListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
 }
 
-type TenantInviteListResponseObject interface {
-	VisitTenantInviteListResponse(w http.ResponseWriter) error
+type WorkerPoolListResponseObject interface {
+	VisitWorkerPoolListResponse(w http.ResponseWriter) error
 }
 
-type TenantInviteList200JSONResponse TenantInviteList
+type WorkerPoolList200JSONResponse WorkerPoolList
 
-func (response TenantInviteList200JSONResponse) VisitTenantInviteListResponse(w http.ResponseWriter) error {
+func (response WorkerPoolList200JSONResponse) VisitWorkerPoolListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteList400JSONResponse APIErrors
+type WorkerPoolList400JSONResponse APIErrors
 
-func (response TenantInviteList400JSONResponse) VisitTenantInviteListResponse(w http.ResponseWriter) error {
+func (response WorkerPoolList400JSONResponse) VisitWorkerPoolListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteList403JSONResponse APIError
+type WorkerPoolList403JSONResponse APIErrors
 
-func (response TenantInviteList403JSONResponse) VisitTenantInviteListResponse(w http.ResponseWriter) error {
+func (response WorkerPoolList403JSONResponse) VisitWorkerPoolListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteCreateRequestObject struct {
+type WorkflowRunUpdateReplayRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *TenantInviteCreateJSONRequestBody
+	Body   *WorkflowRunUpdateReplayJSONRequestBody
 }
 
-type TenantInviteCreateResponseObject interface {
-	VisitTenantInviteCreateResponse(w http.ResponseWriter) error
+type WorkflowRunUpdateReplayResponseObject interface {
+	VisitWorkflowRunUpdateReplayResponse(w http.ResponseWriter) error
 }
 
-type TenantInviteCreate201JSONResponse TenantInvite
+type WorkflowRunUpdateReplay200JSONResponse ReplayWorkflowRunsResponse
 
-func (response TenantInviteCreate201JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowRunUpdateReplay200JSONResponse) VisitWorkflowRunUpdateReplayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteCreate400JSONResponse APIErrors
+type WorkflowRunUpdateReplay400JSONResponse APIErrors
 
-func (response TenantInviteCreate400JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowRunUpdateReplay400JSONResponse) VisitWorkflowRunUpdateReplayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteCreate403JSONResponse APIError
+type WorkflowRunUpdateReplay403JSONResponse APIErrors
 
-func (response TenantInviteCreate403JSONResponse) VisitTenantInviteCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowRunUpdateReplay403JSONResponse) VisitWorkflowRunUpdateReplayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteDeleteRequestObject struct {
-	Tenant       openapi_types.UUID `json:"tenant"`
-	TenantInvite openapi_types.UUID `json:"tenant-invite"`
-}
-
-type TenantInviteDeleteResponseObject interface {
-	VisitTenantInviteDeleteResponse(w http.ResponseWriter) error
-}
-
-type TenantInviteDelete200JSONResponse TenantInvite
-
-func (response TenantInviteDelete200JSONResponse) VisitTenantInviteDeleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type TenantInviteDelete400JSONResponse APIErrors
+type WorkflowRunUpdateReplay429JSONResponse APIErrors
 
-func (response TenantInviteDelete400JSONResponse) VisitTenantInviteDeleteResponse(w http.ResponseWriter) error {
+func (response WorkflowRunUpdateReplay429JSONResponse) VisitWorkflowRunUpdateReplayResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(429)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteUpdateRequestObject struct {
-	Tenant       openapi_types.UUID `json:"tenant"`
-	TenantInvite openapi_types.UUID `json:"tenant-invite"`
-	Body         *TenantInviteUpdateJSONRequestBody
+type WorkflowRunGetRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
 }
-
-type TenantInviteUpdateResponseObject interface {
-	VisitTenantInviteUpdateResponse(w http.ResponseWriter) error
+
+type WorkflowRunGetResponseObject interface {
+	VisitWorkflowRunGetResponse(w http.ResponseWriter) error
 }
 
-type TenantInviteUpdate200JSONResponse TenantInvite
+type WorkflowRunGet200JSONResponse WorkflowRun
 
-func (response TenantInviteUpdate200JSONResponse) VisitTenantInviteUpdateResponse(w http.ResponseWriter) error {
+func (response WorkflowRunGet200JSONResponse) VisitWorkflowRunGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteUpdate400JSONResponse APIErrors
+type WorkflowRunGet400JSONResponse APIErrors
 
-func (response TenantInviteUpdate400JSONResponse) VisitTenantInviteUpdateResponse(w http.ResponseWriter) error {
+func (response WorkflowRunGet400JSONResponse) VisitWorkflowRunGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
+type WorkflowRunGet403JSONResponse APIErrors
+
+func (response WorkflowRunGet403JSONResponse) VisitWorkflowRunGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberListResponseObject interface {
-	VisitTenantMemberListResponse(w http.ResponseWriter) error
+type WorkflowRunGetInputRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
 }
 
-type TenantMemberList200JSONResponse TenantMemberList
+type WorkflowRunGetInputResponseObject interface {
+	VisitWorkflowRunGetInputResponse(w http.ResponseWriter) error
+}
 
-func (response TenantMemberList200JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
+type WorkflowRunGetInput200JSONResponse map[string]interface{}
+
+func (response WorkflowRunGetInput200JSONResponse) VisitWorkflowRunGetInputResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberList400JSONResponse APIErrors
+type WorkflowRunGetInput400JSONResponse APIErrors
 
-func (response TenantMemberList400JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
+func (response WorkflowRunGetInput400JSONResponse) VisitWorkflowRunGetInputResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberList403JSONResponse APIError
+type WorkflowRunGetInput403JSONResponse APIErrors
 
-func (response TenantMemberList403JSONResponse) VisitTenantMemberListResponse(w http.ResponseWriter) error {
+func (response WorkflowRunGetInput403JSONResponse) VisitWorkflowRunGetInputResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberDeleteRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Member openapi_types.UUID `json:"member"`
+type WorkflowRunGetInput404JSONResponse APIErrors
+
+func (response WorkflowRunGetInput404JSONResponse) VisitWorkflowRunGetInputResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberDeleteResponseObject interface {
-	VisitTenantMemberDeleteResponse(w http.ResponseWriter) error
+type WorkflowRunUpdatePauseRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
 }
 
-type TenantMemberDelete204JSONResponse TenantMember
+type WorkflowRunUpdatePauseResponseObject interface {
+	VisitWorkflowRunUpdatePauseResponse(w http.ResponseWriter) error
+}
 
-func (response TenantMemberDelete204JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+type WorkflowRunUpdatePause200JSONResponse WorkflowRun
+
+func (response WorkflowRunUpdatePause200JSONResponse) VisitWorkflowRunUpdatePauseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(204)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberDelete400JSONResponse APIErrors
+type WorkflowRunUpdatePause400JSONResponse APIErrors
 
-func (response TenantMemberDelete400JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+func (response WorkflowRunUpdatePause400JSONResponse) VisitWorkflowRunUpdatePauseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberDelete403JSONResponse APIErrors
+type WorkflowRunUpdatePause403JSONResponse APIErrors
 
-func (response TenantMemberDelete403JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+func (response WorkflowRunUpdatePause403JSONResponse) VisitWorkflowRunUpdatePauseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMemberDelete404JSONResponse APIErrors
+type WorkflowRunUpdatePause404JSONResponse APIErrors
 
-func (response TenantMemberDelete404JSONResponse) VisitTenantMemberDeleteResponse(w http.ResponseWriter) error {
+func (response WorkflowRunUpdatePause404JSONResponse) VisitWorkflowRunUpdatePauseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetQueueMetricsRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Params TenantGetQueueMetricsParams
+type WorkflowRunUpdateReplayFromStepRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
+	Body        *WorkflowRunUpdateReplayFromStepJSONRequestBody
 }
 
-type TenantGetQueueMetricsResponseObject interface {
-	VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error
+type WorkflowRunUpdateReplayFromStepResponseObject interface {
+	VisitWorkflowRunUpdateReplayFromStepResponse(w http.ResponseWriter) error
 }
 
-type TenantGetQueueMetrics200JSONResponse TenantQueueMetrics
+type WorkflowRunUpdateReplayFromStep200JSONResponse WorkflowRun
 
-func (response TenantGetQueueMetrics200JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+func (response WorkflowRunUpdateReplayFromStep200JSONResponse) VisitWorkflowRunUpdateReplayFromStepResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetQueueMetrics400JSONResponse APIErrors
+type WorkflowRunUpdateReplayFromStep400JSONResponse APIErrors
 
-func (response TenantGetQueueMetrics400JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+func (response WorkflowRunUpdateReplayFromStep400JSONResponse) VisitWorkflowRunUpdateReplayFromStepResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetQueueMetrics403JSONResponse APIErrors
+type WorkflowRunUpdateReplayFromStep403JSONResponse APIErrors
 
-func (response TenantGetQueueMetrics403JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+func (response WorkflowRunUpdateReplayFromStep403JSONResponse) VisitWorkflowRunUpdateReplayFromStepResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetQueueMetrics404JSONResponse APIErrors
+type WorkflowRunUpdateReplayFromStep404JSONResponse APIErrors
 
-func (response TenantGetQueueMetrics404JSONResponse) VisitTenantGetQueueMetricsResponse(w http.ResponseWriter) error {
+func (response WorkflowRunUpdateReplayFromStep404JSONResponse) VisitWorkflowRunUpdateReplayFromStepResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RateLimitListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Params RateLimitListParams
+type WorkflowRunUpdateReplayFromStep429JSONResponse APIErrors
+
+func (response WorkflowRunUpdateReplayFromStep429JSONResponse) VisitWorkflowRunUpdateReplayFromStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RateLimitListResponseObject interface {
-	VisitRateLimitListResponse(w http.ResponseWriter) error
+type WorkflowRunUpdateResumeRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
 }
 
-type RateLimitList200JSONResponse RateLimitList
+type WorkflowRunUpdateResumeResponseObject interface {
+	VisitWorkflowRunUpdateResumeResponse(w http.ResponseWriter) error
+}
 
-func (response RateLimitList200JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+type WorkflowRunUpdateResume200JSONResponse WorkflowRun
+
+func (response WorkflowRunUpdateResume200JSONResponse) VisitWorkflowRunUpdateResumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RateLimitList400JSONResponse APIErrors
+type WorkflowRunUpdateResume400JSONResponse APIErrors
 
-func (response RateLimitList400JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+func (response WorkflowRunUpdateResume400JSONResponse) VisitWorkflowRunUpdateResumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RateLimitList403JSONResponse APIErrors
+type WorkflowRunUpdateResume403JSONResponse APIErrors
 
-func (response RateLimitList403JSONResponse) VisitRateLimitListResponse(w http.ResponseWriter) error {
+func (response WorkflowRunUpdateResume403JSONResponse) VisitWorkflowRunUpdateResumeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantResourcePolicyGetRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
+type WorkflowRunUpdateResume404JSONResponse APIErrors
+
+func (response WorkflowRunUpdateResume404JSONResponse) VisitWorkflowRunUpdateResumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantResourcePolicyGetResponseObject interface {
-	VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error
+type WorkflowRunGetShapeRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
 }
 
-type TenantResourcePolicyGet200JSONResponse TenantResourcePolicy
+type WorkflowRunGetShapeResponseObject interface {
+	VisitWorkflowRunGetShapeResponse(w http.ResponseWriter) error
+}
 
-func (response TenantResourcePolicyGet200JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
+type WorkflowRunGetShape200JSONResponse WorkflowRunShape
+
+func (response WorkflowRunGetShape200JSONResponse) VisitWorkflowRunGetShapeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantResourcePolicyGet400JSONResponse APIErrors
+type WorkflowRunGetShape400JSONResponse APIErrors
 
-func (response TenantResourcePolicyGet400JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
+func (response WorkflowRunGetShape400JSONResponse) VisitWorkflowRunGetShapeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantResourcePolicyGet403JSONResponse APIError
+type WorkflowRunGetShape403JSONResponse APIErrors
 
-func (response TenantResourcePolicyGet403JSONResponse) VisitTenantResourcePolicyGetResponse(w http.ResponseWriter) error {
+func (response WorkflowRunGetShape403JSONResponse) VisitWorkflowRunGetShapeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SlackWebhookListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
+type WorkflowRunListStepRunEventsRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
+	Params      WorkflowRunListStepRunEventsParams
 }
 
-type SlackWebhookListResponseObject interface {
-	VisitSlackWebhookListResponse(w http.ResponseWriter) error
+type WorkflowRunListStepRunEventsResponseObject interface {
+	VisitWorkflowRunListStepRunEventsResponse(w http.ResponseWriter) error
 }
 
-type SlackWebhookList200JSONResponse ListSlackWebhooks
+type WorkflowRunListStepRunEvents200JSONResponse StepRunEventList
 
-func (response SlackWebhookList200JSONResponse) VisitSlackWebhookListResponse(w http.ResponseWriter) error {
+func (response WorkflowRunListStepRunEvents200JSONResponse) VisitWorkflowRunListStepRunEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SlackWebhookList400JSONResponse APIErrors
+type WorkflowRunListStepRunEvents400JSONResponse APIErrors
 
-func (response SlackWebhookList400JSONResponse) VisitSlackWebhookListResponse(w http.ResponseWriter) error {
+func (response WorkflowRunListStepRunEvents400JSONResponse) VisitWorkflowRunListStepRunEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SlackWebhookList401JSONResponse APIErrors
+type WorkflowRunListStepRunEvents403JSONResponse APIErrors
 
-func (response SlackWebhookList401JSONResponse) VisitSlackWebhookListResponse(w http.ResponseWriter) error {
+func (response WorkflowRunListStepRunEvents403JSONResponse) VisitWorkflowRunListStepRunEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SlackWebhookList405JSONResponse APIErrors
+type WorkflowRunListStepRunEvents404JSONResponse APIErrors
 
-func (response SlackWebhookList405JSONResponse) VisitSlackWebhookListResponse(w http.ResponseWriter) error {
+func (response WorkflowRunListStepRunEvents404JSONResponse) VisitWorkflowRunListStepRunEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateSlackOauthStartRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
+type WorkflowRunGetStreamRequestObject struct {
+	Tenant      openapi_types.UUID `json:"tenant"`
+	WorkflowRun openapi_types.UUID `json:"workflow-run"`
 }
 
-type UserUpdateSlackOauthStartResponseObject interface {
-	VisitUserUpdateSlackOauthStartResponse(w http.ResponseWriter) error
+type WorkflowRunGetStreamResponseObject interface {
+	VisitWorkflowRunGetStreamResponse(w http.ResponseWriter) error
 }
 
-type UserUpdateSlackOauthStart302ResponseHeaders struct {
-	Location string
+type WorkflowRunGetStream200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
 }
 
-type UserUpdateSlackOauthStart302Response struct {
-	Headers UserUpdateSlackOauthStart302ResponseHeaders
-}
+func (response WorkflowRunGetStream200TexteventStreamResponse) VisitWorkflowRunGetStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
 
-func (response UserUpdateSlackOauthStart302Response) VisitUserUpdateSlackOauthStartResponse(w http.ResponseWriter) error {
-	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(302)
-	return nil
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type SnsListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
+type WorkflowRunGetStream400JSONResponse APIErrors
+
+func (response WorkflowRunGetStream400JSONResponse) VisitWorkflowRunGetStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsListResponseObject interface {
-	VisitSnsListResponse(w http.ResponseWriter) error
+type WorkflowRunGetStream403JSONResponse APIErrors
+
+func (response WorkflowRunGetStream403JSONResponse) VisitWorkflowRunGetStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsList200JSONResponse ListSNSIntegrations
+type WorkflowRunGetStream404JSONResponse APIErrors
 
-func (response SnsList200JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
+func (response WorkflowRunGetStream404JSONResponse) VisitWorkflowRunGetStreamResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsList400JSONResponse APIErrors
+type WorkflowListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+}
 
-func (response SnsList400JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
+type WorkflowListResponseObject interface {
+	VisitWorkflowListResponse(w http.ResponseWriter) error
+}
+
+type WorkflowList200JSONResponse WorkflowList
+
+func (response WorkflowList200JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsList401JSONResponse APIErrors
+type WorkflowList400JSONResponse APIErrors
 
-func (response SnsList401JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
+func (response WorkflowList400JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsList405JSONResponse APIErrors
+type WorkflowList403JSONResponse APIErrors
 
-func (response SnsList405JSONResponse) VisitSnsListResponse(w http.ResponseWriter) error {
+func (response WorkflowList403JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsCreateRequestObject struct {
+type WorkflowRunCancelRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *SnsCreateJSONRequestBody
+	Body   *WorkflowRunCancelJSONRequestBody
 }
 
-type SnsCreateResponseObject interface {
-	VisitSnsCreateResponse(w http.ResponseWriter) error
+type WorkflowRunCancelResponseObject interface {
+	VisitWorkflowRunCancelResponse(w http.ResponseWriter) error
 }
 
-type SnsCreate201JSONResponse SNSIntegration
-
-func (response SnsCreate201JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
-
-	return json.NewEncoder(w).Encode(response)
+type WorkflowRunCancel200JSONResponse struct {
+	WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
 }
 
-type SnsCreate400JSONResponse APIErrors
-
-func (response SnsCreate400JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowRunCancel200JSONResponse) VisitWorkflowRunCancelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsCreate401JSONResponse APIErrors
+type WorkflowRunCancel400JSONResponse APIErrors
 
-func (response SnsCreate401JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowRunCancel400JSONResponse) VisitWorkflowRunCancelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SnsCreate405JSONResponse APIErrors
+type WorkflowRunCancel403JSONResponse APIErrors
 
-func (response SnsCreate405JSONResponse) VisitSnsCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowRunCancel403JSONResponse) VisitWorkflowRunCancelResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetStepRunQueueMetricsRequestObject struct {
+type CronWorkflowListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
+	Params CronWorkflowListParams
 }
 
-type TenantGetStepRunQueueMetricsResponseObject interface {
-	VisitTenantGetStepRunQueueMetricsResponse(w http.ResponseWriter) error
+type CronWorkflowListResponseObject interface {
+	VisitCronWorkflowListResponse(w http.ResponseWriter) error
 }
 
-type TenantGetStepRunQueueMetrics200JSONResponse TenantStepRunQueueMetrics
+type CronWorkflowList200JSONResponse CronWorkflowsList
 
-func (response TenantGetStepRunQueueMetrics200JSONResponse) VisitTenantGetStepRunQueueMetricsResponse(w http.ResponseWriter) error {
+func (response CronWorkflowList200JSONResponse) VisitCronWorkflowListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetStepRunQueueMetrics400JSONResponse APIErrors
+type CronWorkflowList400JSONResponse APIErrors
 
-func (response TenantGetStepRunQueueMetrics400JSONResponse) VisitTenantGetStepRunQueueMetricsResponse(w http.ResponseWriter) error {
+func (response CronWorkflowList400JSONResponse) VisitCronWorkflowListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetStepRunQueueMetrics403JSONResponse APIErrors
+type CronWorkflowList403JSONResponse APIErrors
 
-func (response TenantGetStepRunQueueMetrics403JSONResponse) VisitTenantGetStepRunQueueMetricsResponse(w http.ResponseWriter) error {
+func (response CronWorkflowList403JSONResponse) VisitCronWorkflowListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantGetStepRunQueueMetrics404JSONResponse APIErrors
-
-func (response TenantGetStepRunQueueMetrics404JSONResponse) VisitTenantGetStepRunQueueMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
+type WorkflowCronDeleteRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	CronWorkflow openapi_types.UUID `json:"cron-workflow"`
 }
 
-type StepRunGetRequestObject struct {
-	Tenant  openapi_types.UUID `json:"tenant"`
-	StepRun openapi_types.UUID `json:"step-run"`
+type WorkflowCronDeleteResponseObject interface {
+	VisitWorkflowCronDeleteResponse(w http.ResponseWriter) error
 }
 
-type StepRunGetResponseObject interface {
-	VisitStepRunGetResponse(w http.ResponseWriter) error
+type WorkflowCronDelete204Response struct {
 }
 
-type StepRunGet200JSONResponse StepRun
-
-func (response StepRunGet200JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+func (response WorkflowCronDelete204Response) VisitWorkflowCronDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type StepRunGet400JSONResponse APIErrors
+type WorkflowCronDelete400JSONResponse APIErrors
 
-func (response StepRunGet400JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
+func (response WorkflowCronDelete400JSONResponse) VisitWorkflowCronDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunGet403JSONResponse APIErrors
+type WorkflowCronDelete403JSONResponse APIError
 
-func (response StepRunGet403JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
+func (response WorkflowCronDelete403JSONResponse) VisitWorkflowCronDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunGet404JSONResponse APIErrors
-
-func (response StepRunGet404JSONResponse) VisitStepRunGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type StepRunUpdateCancelRequestObject struct {
-	Tenant  openapi_types.UUID `json:"tenant"`
-	StepRun openapi_types.UUID `json:"step-run"`
+type WorkflowCronGetRequestObject struct {
+	Tenant       openapi_types.UUID `json:"tenant"`
+	CronWorkflow openapi_types.UUID `json:"cron-workflow"`
 }
 
-type StepRunUpdateCancelResponseObject interface {
-	VisitStepRunUpdateCancelResponse(w http.ResponseWriter) error
+type WorkflowCronGetResponseObject interface {
+	VisitWorkflowCronGetResponse(w http.ResponseWriter) error
 }
 
-type StepRunUpdateCancel200JSONResponse StepRun
+type WorkflowCronGet200JSONResponse CronWorkflows
 
-func (response StepRunUpdateCancel200JSONResponse) VisitStepRunUpdateCancelResponse(w http.ResponseWriter) error {
+func (response WorkflowCronGet200JSONResponse) VisitWorkflowCronGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunUpdateCancel400JSONResponse APIErrors
+type WorkflowCronGet400JSONResponse APIErrors
 
-func (response StepRunUpdateCancel400JSONResponse) VisitStepRunUpdateCancelResponse(w http.ResponseWriter) error {
+func (response WorkflowCronGet400JSONResponse) VisitWorkflowCronGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunUpdateCancel403JSONResponse APIErrors
+type WorkflowCronGet403JSONResponse APIErrors
 
-func (response StepRunUpdateCancel403JSONResponse) VisitStepRunUpdateCancelResponse(w http.ResponseWriter) error {
+func (response WorkflowCronGet403JSONResponse) VisitWorkflowCronGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunUpdateRerunRequestObject struct {
-	Tenant  openapi_types.UUID `json:"tenant"`
-	StepRun openapi_types.UUID `json:"step-run"`
-	Body    *StepRunUpdateRerunJSONRequestBody
+type WorkflowCronGet404JSONResponse APIErrors
+
+func (response WorkflowCronGet404JSONResponse) VisitWorkflowCronGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunUpdateRerunResponseObject interface {
-	VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error
+type WorkflowRunListRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params WorkflowRunListParams
 }
 
-type StepRunUpdateRerun200JSONResponse StepRun
+type WorkflowRunListResponseObject interface {
+	VisitWorkflowRunListResponse(w http.ResponseWriter) error
+}
 
-func (response StepRunUpdateRerun200JSONResponse) VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error {
+type WorkflowRunList200JSONResponse WorkflowRunList
+
+func (response WorkflowRunList200JSONResponse) VisitWorkflowRunListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunUpdateRerun400JSONResponse APIErrors
+type WorkflowRunList400JSONResponse APIErrors
 
-func (response StepRunUpdateRerun400JSONResponse) VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error {
+func (response WorkflowRunList400JSONResponse) VisitWorkflowRunListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunUpdateRerun403JSONResponse APIErrors
+type WorkflowRunList403JSONResponse APIErrors
 
-func (response StepRunUpdateRerun403JSONResponse) VisitStepRunUpdateRerunResponse(w http.ResponseWriter) error {
+func (response WorkflowRunList403JSONResponse) VisitWorkflowRunListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunGetSchemaRequestObject struct {
-	Tenant  openapi_types.UUID `json:"tenant"`
-	StepRun openapi_types.UUID `json:"step-run"`
+type WorkflowRunGetMetricsRequestObject struct {
+	Tenant openapi_types.UUID `json:"tenant"`
+	Params WorkflowRunGetMetricsParams
 }
 
-type StepRunGetSchemaResponseObject interface {
-	VisitStepRunGetSchemaResponse(w http.ResponseWriter) error
+type WorkflowRunGetMetricsResponseObject interface {
+	VisitWorkflowRunGetMetricsResponse(w http.ResponseWriter) error
 }
 
-type StepRunGetSchema200JSONResponse map[string]interface{}
+type WorkflowRunGetMetrics200JSONResponse WorkflowRunsMetrics
 
-func (response StepRunGetSchema200JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
+func (response WorkflowRunGetMetrics200JSONResponse) VisitWorkflowRunGetMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunGetSchema400JSONResponse APIErrors
+type WorkflowRunGetMetrics400JSONResponse APIErrors
 
-func (response StepRunGetSchema400JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
+func (response WorkflowRunGetMetrics400JSONResponse) VisitWorkflowRunGetMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunGetSchema403JSONResponse APIErrors
+type WorkflowRunGetMetrics403JSONResponse APIErrors
 
-func (response StepRunGetSchema403JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
+func (response WorkflowRunGetMetrics403JSONResponse) VisitWorkflowRunGetMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StepRunGetSchema404JSONResponse APIErrors
-
-func (response StepRunGetSchema404JSONResponse) VisitStepRunGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type WebhookListRequestObject struct {
+type WorkflowScheduledListRequestObject struct {
 	Tenant openapi_types.UUID `json:"tenant"`
+	Params WorkflowScheduledListParams
 }
 
-type WebhookListResponseObject interface {
-	VisitWebhookListResponse(w http.ResponseWriter) error
+type WorkflowScheduledListResponseObject interface {
+	VisitWorkflowScheduledListResponse(w http.ResponseWriter) error
 }
 
-type WebhookList200JSONResponse WebhookWorkerListResponse
+type WorkflowScheduledList200JSONResponse ScheduledWorkflowsList
 
-func (response WebhookList200JSONResponse) VisitWebhookListResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledList200JSONResponse) VisitWorkflowScheduledListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WebhookList400JSONResponse APIErrors
+type WorkflowScheduledList400JSONResponse APIErrors
 
-func (response WebhookList400JSONResponse) VisitWebhookListResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledList400JSONResponse) VisitWorkflowScheduledListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WebhookList401JSONResponse APIErrors
+type WorkflowScheduledList403JSONResponse APIErrors
 
-func (response WebhookList401JSONResponse) VisitWebhookListResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledList403JSONResponse) VisitWorkflowScheduledListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WebhookList405JSONResponse APIErrors
-
-func (response WebhookList405JSONResponse) VisitWebhookListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
-
-	return json.NewEncoder(w).Encode(response)
+type WorkflowScheduledDeleteRequestObject struct {
+	Tenant               openapi_types.UUID `json:"tenant"`
+	ScheduledWorkflowRun openapi_types.UUID `json:"scheduled-workflow-run"`
 }
 
-type WebhookCreateRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *WebhookCreateJSONRequestBody
+type WorkflowScheduledDeleteResponseObject interface {
+	VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error
 }
 
-type WebhookCreateResponseObject interface {
-	VisitWebhookCreateResponse(w http.ResponseWriter) error
+type WorkflowScheduledDelete204Response struct {
 }
 
-type WebhookCreate200JSONResponse WebhookWorkerCreated
-
-func (response WebhookCreate200JSONResponse) VisitWebhookCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+func (response WorkflowScheduledDelete204Response) VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type WebhookCreate400JSONResponse APIErrors
+type WorkflowScheduledDelete400JSONResponse APIErrors
 
-func (response WebhookCreate400JSONResponse) VisitWebhookCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledDelete400JSONResponse) VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WebhookCreate401JSONResponse APIErrors
-
-func (response WebhookCreate401JSONResponse) VisitWebhookCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type WebhookCreate405JSONResponse APIErrors
+type WorkflowScheduledDelete403JSONResponse APIError
 
-func (response WebhookCreate405JSONResponse) VisitWebhookCreateResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledDelete403JSONResponse) VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkerListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
+type WorkflowScheduledGetRequestObject struct {
+	Tenant               openapi_types.UUID `json:"tenant"`
+	ScheduledWorkflowRun openapi_types.UUID `json:"scheduled-workflow-run"`
 }
 
-type WorkerListResponseObject interface {
-	VisitWorkerListResponse(w http.ResponseWriter) error
+type WorkflowScheduledGetResponseObject interface {
+	VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error
 }
 
-type WorkerList200JSONResponse WorkerList
+type WorkflowScheduledGet200JSONResponse ScheduledWorkflows
 
-func (response WorkerList200JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledGet200JSONResponse) VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkerList400JSONResponse APIErrors
+type WorkflowScheduledGet400JSONResponse APIErrors
 
-func (response WorkerList400JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledGet400JSONResponse) VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkerList403JSONResponse APIErrors
+type WorkflowScheduledGet403JSONResponse APIErrors
 
-func (response WorkerList403JSONResponse) VisitWorkerListResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledGet403JSONResponse) VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunUpdateReplayRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *WorkflowRunUpdateReplayJSONRequestBody
+type WorkflowScheduledGet404JSONResponse APIErrors
+
+func (response WorkflowScheduledGet404JSONResponse) VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunUpdateReplayResponseObject interface {
-	VisitWorkflowRunUpdateReplayResponse(w http.ResponseWriter) error
+type WorkflowScheduledUpdateRequestObject struct {
+	Tenant               openapi_types.UUID `json:"tenant"`
+	ScheduledWorkflowRun openapi_types.UUID `json:"scheduled-workflow-run"`
+	Body                 *WorkflowScheduledUpdateJSONRequestBody
+}
+
+type WorkflowScheduledUpdateResponseObject interface {
+	VisitWorkflowScheduledUpdateResponse(w http.ResponseWriter) error
 }
 
-type WorkflowRunUpdateReplay200JSONResponse ReplayWorkflowRunsResponse
+type WorkflowScheduledUpdate200JSONResponse ScheduledWorkflows
 
-func (response WorkflowRunUpdateReplay200JSONResponse) VisitWorkflowRunUpdateReplayResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledUpdate200JSONResponse) VisitWorkflowScheduledUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunUpdateReplay400JSONResponse APIErrors
+type WorkflowScheduledUpdate400JSONResponse APIErrors
 
-func (response WorkflowRunUpdateReplay400JSONResponse) VisitWorkflowRunUpdateReplayResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledUpdate400JSONResponse) VisitWorkflowScheduledUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunUpdateReplay403JSONResponse APIErrors
+type WorkflowScheduledUpdate403JSONResponse APIErrors
 
-func (response WorkflowRunUpdateReplay403JSONResponse) VisitWorkflowRunUpdateReplayResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledUpdate403JSONResponse) VisitWorkflowScheduledUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunUpdateReplay429JSONResponse APIErrors
+type WorkflowScheduledUpdate404JSONResponse APIErrors
 
-func (response WorkflowRunUpdateReplay429JSONResponse) VisitWorkflowRunUpdateReplayResponse(w http.ResponseWriter) error {
+func (response WorkflowScheduledUpdate404JSONResponse) VisitWorkflowScheduledUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetRequestObject struct {
-	Tenant      openapi_types.UUID `json:"tenant"`
-	WorkflowRun openapi_types.UUID `json:"workflow-run"`
+type CronWorkflowTriggerCreateRequestObject struct {
+	Tenant   openapi_types.UUID `json:"tenant"`
+	Workflow string             `json:"workflow"`
+	Body     *CronWorkflowTriggerCreateJSONRequestBody
 }
 
-type WorkflowRunGetResponseObject interface {
-	VisitWorkflowRunGetResponse(w http.ResponseWriter) error
+type CronWorkflowTriggerCreateResponseObject interface {
+	VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error
 }
 
-type WorkflowRunGet200JSONResponse WorkflowRun
+type CronWorkflowTriggerCreate200JSONResponse CronWorkflows
 
-func (response WorkflowRunGet200JSONResponse) VisitWorkflowRunGetResponse(w http.ResponseWriter) error {
+func (response CronWorkflowTriggerCreate200JSONResponse) VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGet400JSONResponse APIErrors
+type CronWorkflowTriggerCreate400JSONResponse APIErrors
 
-func (response WorkflowRunGet400JSONResponse) VisitWorkflowRunGetResponse(w http.ResponseWriter) error {
+func (response CronWorkflowTriggerCreate400JSONResponse) VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGet403JSONResponse APIErrors
+type CronWorkflowTriggerCreate403JSONResponse APIErrors
 
-func (response WorkflowRunGet403JSONResponse) VisitWorkflowRunGetResponse(w http.ResponseWriter) error {
+func (response CronWorkflowTriggerCreate403JSONResponse) VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetInputRequestObject struct {
-	Tenant      openapi_types.UUID `json:"tenant"`
-	WorkflowRun openapi_types.UUID `json:"workflow-run"`
+type CronWorkflowTriggerCreate404JSONResponse APIErrors
+
+func (response CronWorkflowTriggerCreate404JSONResponse) VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetInputResponseObject interface {
-	VisitWorkflowRunGetInputResponse(w http.ResponseWriter) error
+type CronWorkflowTriggerCreate429JSONResponse APIErrors
+
+func (response CronWorkflowTriggerCreate429JSONResponse) VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetInput200JSONResponse map[string]interface{}
+type ScheduledWorkflowRunCreateRequestObject struct {
+	Tenant   openapi_types.UUID `json:"tenant"`
+	Workflow string             `json:"workflow"`
+	Body     *ScheduledWorkflowRunCreateJSONRequestBody
+}
 
-func (response WorkflowRunGetInput200JSONResponse) VisitWorkflowRunGetInputResponse(w http.ResponseWriter) error {
+type ScheduledWorkflowRunCreateResponseObject interface {
+	VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error
+}
+
+type ScheduledWorkflowRunCreate200JSONResponse ScheduledWorkflows
+
+func (response ScheduledWorkflowRunCreate200JSONResponse) VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetInput400JSONResponse APIErrors
+type ScheduledWorkflowRunCreate400JSONResponse APIErrors
 
-func (response WorkflowRunGetInput400JSONResponse) VisitWorkflowRunGetInputResponse(w http.ResponseWriter) error {
+func (response ScheduledWorkflowRunCreate400JSONResponse) VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetInput403JSONResponse APIErrors
+type ScheduledWorkflowRunCreate403JSONResponse APIErrors
 
-func (response WorkflowRunGetInput403JSONResponse) VisitWorkflowRunGetInputResponse(w http.ResponseWriter) error {
+func (response ScheduledWorkflowRunCreate403JSONResponse) VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetInput404JSONResponse APIErrors
+type ScheduledWorkflowRunCreate404JSONResponse APIErrors
 
-func (response WorkflowRunGetInput404JSONResponse) VisitWorkflowRunGetInputResponse(w http.ResponseWriter) error {
+func (response ScheduledWorkflowRunCreate404JSONResponse) VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetShapeRequestObject struct {
-	Tenant      openapi_types.UUID `json:"tenant"`
-	WorkflowRun openapi_types.UUID `json:"workflow-run"`
+type ScheduledWorkflowRunCreate429JSONResponse APIErrors
+
+func (response ScheduledWorkflowRunCreate429JSONResponse) VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetShapeResponseObject interface {
-	VisitWorkflowRunGetShapeResponse(w http.ResponseWriter) error
+type WorkflowGetWorkersCountRequestObject struct {
+	Tenant   openapi_types.UUID `json:"tenant"`
+	Workflow openapi_types.UUID `json:"workflow"`
 }
 
-type WorkflowRunGetShape200JSONResponse WorkflowRunShape
+type WorkflowGetWorkersCountResponseObject interface {
+	VisitWorkflowGetWorkersCountResponse(w http.ResponseWriter) error
+}
 
-func (response WorkflowRunGetShape200JSONResponse) VisitWorkflowRunGetShapeResponse(w http.ResponseWriter) error {
+type WorkflowGetWorkersCount200JSONResponse WorkflowWorkersCount
+
+func (response WorkflowGetWorkersCount200JSONResponse) VisitWorkflowGetWorkersCountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetShape400JSONResponse APIErrors
+type WorkflowGetWorkersCount400JSONResponse APIErrors
 
-func (response WorkflowRunGetShape400JSONResponse) VisitWorkflowRunGetShapeResponse(w http.ResponseWriter) error {
+func (response WorkflowGetWorkersCount400JSONResponse) VisitWorkflowGetWorkersCountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetShape403JSONResponse APIErrors
+type WorkflowGetWorkersCount403JSONResponse APIErrors
 
-func (response WorkflowRunGetShape403JSONResponse) VisitWorkflowRunGetShapeResponse(w http.ResponseWriter) error {
+func (response WorkflowGetWorkersCount403JSONResponse) VisitWorkflowGetWorkersCountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunListStepRunEventsRequestObject struct {
-	Tenant      openapi_types.UUID `json:"tenant"`
-	WorkflowRun openapi_types.UUID `json:"workflow-run"`
-	Params      WorkflowRunListStepRunEventsParams
+type UserGetCurrentRequestObject struct {
 }
 
-type WorkflowRunListStepRunEventsResponseObject interface {
-	VisitWorkflowRunListStepRunEventsResponse(w http.ResponseWriter) error
+type UserGetCurrentResponseObject interface {
+	VisitUserGetCurrentResponse(w http.ResponseWriter) error
 }
 
-type WorkflowRunListStepRunEvents200JSONResponse StepRunEventList
+type UserGetCurrent200JSONResponse User
 
-func (response WorkflowRunListStepRunEvents200JSONResponse) VisitWorkflowRunListStepRunEventsResponse(w http.ResponseWriter) error {
+func (response UserGetCurrent200JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunListStepRunEvents400JSONResponse APIErrors
+type UserGetCurrent400JSONResponse APIErrors
 
-func (response WorkflowRunListStepRunEvents400JSONResponse) VisitWorkflowRunListStepRunEventsResponse(w http.ResponseWriter) error {
+func (response UserGetCurrent400JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunListStepRunEvents403JSONResponse APIErrors
+type UserGetCurrent401JSONResponse APIErrors
 
-func (response WorkflowRunListStepRunEvents403JSONResponse) VisitWorkflowRunListStepRunEventsResponse(w http.ResponseWriter) error {
+func (response UserGetCurrent401JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunListStepRunEvents404JSONResponse APIErrors
+type UserGetCurrent405JSONResponse APIErrors
 
-func (response WorkflowRunListStepRunEvents404JSONResponse) VisitWorkflowRunListStepRunEventsResponse(w http.ResponseWriter) error {
+func (response UserGetCurrent405JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(405)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
+type UserUpdateGithubOauthCallbackRequestObject struct {
 }
 
-type WorkflowListResponseObject interface {
-	VisitWorkflowListResponse(w http.ResponseWriter) error
+type UserUpdateGithubOauthCallbackResponseObject interface {
+	VisitUserUpdateGithubOauthCallbackResponse(w http.ResponseWriter) error
 }
 
-type WorkflowList200JSONResponse WorkflowList
+type UserUpdateGithubOauthCallback302ResponseHeaders struct {
+	Location string
+}
 
-func (response WorkflowList200JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type UserUpdateGithubOauthCallback302Response struct {
+	Headers UserUpdateGithubOauthCallback302ResponseHeaders
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response UserUpdateGithubOauthCallback302Response) VisitUserUpdateGithubOauthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
 }
 
-type WorkflowList400JSONResponse APIErrors
+type UserUpdateGithubOauthStartRequestObject struct {
+}
 
-func (response WorkflowList400JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+type UserUpdateGithubOauthStartResponseObject interface {
+	VisitUserUpdateGithubOauthStartResponse(w http.ResponseWriter) error
+}
 
-	return json.NewEncoder(w).Encode(response)
+type UserUpdateGithubOauthStart302ResponseHeaders struct {
+	Location string
 }
 
-type WorkflowList403JSONResponse APIErrors
+type UserUpdateGithubOauthStart302Response struct {
+	Headers UserUpdateGithubOauthStart302ResponseHeaders
+}
 
-func (response WorkflowList403JSONResponse) VisitWorkflowListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+func (response UserUpdateGithubOauthStart302Response) VisitUserUpdateGithubOauthStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
 
-	return json.NewEncoder(w).Encode(response)
+type UserUpdateGoogleOauthCallbackRequestObject struct {
 }
 
-type WorkflowRunCancelRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Body   *WorkflowRunCancelJSONRequestBody
+type UserUpdateGoogleOauthCallbackResponseObject interface {
+	VisitUserUpdateGoogleOauthCallbackResponse(w http.ResponseWriter) error
 }
 
-type WorkflowRunCancelResponseObject interface {
-	VisitWorkflowRunCancelResponse(w http.ResponseWriter) error
+type UserUpdateGoogleOauthCallback302ResponseHeaders struct {
+	Location string
 }
 
-type WorkflowRunCancel200JSONResponse struct {
-	WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+type UserUpdateGoogleOauthCallback302Response struct {
+	Headers UserUpdateGoogleOauthCallback302ResponseHeaders
 }
 
-func (response WorkflowRunCancel200JSONResponse) VisitWorkflowRunCancelResponse(w http.ResponseWriter) error {
+func (response UserUpdateGoogleOauthCallback302Response) VisitUserUpdateGoogleOauthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type UserUpdateGoogleOauthStartRequestObject struct {
+}
+
+type UserUpdateGoogleOauthStartResponseObject interface {
+	VisitUserUpdateGoogleOauthStartResponse(w http.ResponseWriter) error
+}
+
+type UserUpdateGoogleOauthStart302ResponseHeaders struct {
+	Location string
+}
+
+type UserUpdateGoogleOauthStart302Response struct {
+	Headers UserUpdateGoogleOauthStart302ResponseHeaders
+}
+
+func (response UserUpdateGoogleOauthStart302Response) VisitUserUpdateGoogleOauthStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type UserListTenantInvitesRequestObject struct {
+}
+
+type UserListTenantInvitesResponseObject interface {
+	VisitUserListTenantInvitesResponse(w http.ResponseWriter) error
+}
+
+type UserListTenantInvites200JSONResponse TenantInviteList
+
+func (response UserListTenantInvites200JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunCancel400JSONResponse APIErrors
+type UserListTenantInvites400JSONResponse APIErrors
 
-func (response WorkflowRunCancel400JSONResponse) VisitWorkflowRunCancelResponse(w http.ResponseWriter) error {
+func (response UserListTenantInvites400JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunCancel403JSONResponse APIErrors
+type UserListTenantInvites403JSONResponse APIErrors
 
-func (response WorkflowRunCancel403JSONResponse) VisitWorkflowRunCancelResponse(w http.ResponseWriter) error {
+func (response UserListTenantInvites403JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CronWorkflowListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Params CronWorkflowListParams
+type TenantInviteAcceptRequestObject struct {
+	Body *TenantInviteAcceptJSONRequestBody
 }
 
-type CronWorkflowListResponseObject interface {
-	VisitCronWorkflowListResponse(w http.ResponseWriter) error
+type TenantInviteAcceptResponseObject interface {
+	VisitTenantInviteAcceptResponse(w http.ResponseWriter) error
 }
 
-type CronWorkflowList200JSONResponse CronWorkflowsList
+type TenantInviteAccept200Response struct {
+}
 
-func (response CronWorkflowList200JSONResponse) VisitCronWorkflowListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response TenantInviteAccept200Response) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+	return nil
 }
 
-type CronWorkflowList400JSONResponse APIErrors
+type TenantInviteAccept400JSONResponse APIErrors
 
-func (response CronWorkflowList400JSONResponse) VisitCronWorkflowListResponse(w http.ResponseWriter) error {
+func (response TenantInviteAccept400JSONResponse) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CronWorkflowList403JSONResponse APIErrors
+type TenantInviteAccept403JSONResponse APIError
 
-func (response CronWorkflowList403JSONResponse) VisitCronWorkflowListResponse(w http.ResponseWriter) error {
+func (response TenantInviteAccept403JSONResponse) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowCronDeleteRequestObject struct {
-	Tenant       openapi_types.UUID `json:"tenant"`
-	CronWorkflow openapi_types.UUID `json:"cron-workflow"`
+type TenantInviteRejectRequestObject struct {
+	Body *TenantInviteRejectJSONRequestBody
 }
 
-type WorkflowCronDeleteResponseObject interface {
-	VisitWorkflowCronDeleteResponse(w http.ResponseWriter) error
+type TenantInviteRejectResponseObject interface {
+	VisitTenantInviteRejectResponse(w http.ResponseWriter) error
 }
 
-type WorkflowCronDelete204Response struct {
+type TenantInviteReject200Response struct {
 }
 
-func (response WorkflowCronDelete204Response) VisitWorkflowCronDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
+func (response TenantInviteReject200Response) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
 	return nil
 }
 
-type WorkflowCronDelete400JSONResponse APIErrors
+type TenantInviteReject400JSONResponse APIErrors
 
-func (response WorkflowCronDelete400JSONResponse) VisitWorkflowCronDeleteResponse(w http.ResponseWriter) error {
+func (response TenantInviteReject400JSONResponse) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowCronDelete403JSONResponse APIError
+type TenantInviteReject403JSONResponse APIError
 
-func (response WorkflowCronDelete403JSONResponse) VisitWorkflowCronDeleteResponse(w http.ResponseWriter) error {
+func (response TenantInviteReject403JSONResponse) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowCronGetRequestObject struct {
-	Tenant       openapi_types.UUID `json:"tenant"`
-	CronWorkflow openapi_types.UUID `json:"cron-workflow"`
+type UserUpdateLoginRequestObject struct {
+	Body *UserUpdateLoginJSONRequestBody
 }
 
-type WorkflowCronGetResponseObject interface {
-	VisitWorkflowCronGetResponse(w http.ResponseWriter) error
+type UserUpdateLoginResponseObject interface {
+	VisitUserUpdateLoginResponse(w http.ResponseWriter) error
 }
 
-type WorkflowCronGet200JSONResponse CronWorkflows
+type UserUpdateLogin200JSONResponse User
 
-func (response WorkflowCronGet200JSONResponse) VisitWorkflowCronGetResponse(w http.ResponseWriter) error {
+func (response UserUpdateLogin200JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowCronGet400JSONResponse APIErrors
+type UserUpdateLogin400JSONResponse APIErrors
 
-func (response WorkflowCronGet400JSONResponse) VisitWorkflowCronGetResponse(w http.ResponseWriter) error {
+func (response UserUpdateLogin400JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowCronGet403JSONResponse APIErrors
+type UserUpdateLogin401JSONResponse APIErrors
 
-func (response WorkflowCronGet403JSONResponse) VisitWorkflowCronGetResponse(w http.ResponseWriter) error {
+func (response UserUpdateLogin401JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowCronGet404JSONResponse APIErrors
+type UserUpdateLogin405JSONResponse APIErrors
 
-func (response WorkflowCronGet404JSONResponse) VisitWorkflowCronGetResponse(w http.ResponseWriter) error {
+func (response UserUpdateLogin405JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(405)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Params WorkflowRunListParams
+type UserUpdateLogoutRequestObject struct {
 }
 
-type WorkflowRunListResponseObject interface {
-	VisitWorkflowRunListResponse(w http.ResponseWriter) error
+type UserUpdateLogoutResponseObject interface {
+	VisitUserUpdateLogoutResponse(w http.ResponseWriter) error
 }
 
-type WorkflowRunList200JSONResponse WorkflowRunList
+type UserUpdateLogout200JSONResponse User
 
-func (response WorkflowRunList200JSONResponse) VisitWorkflowRunListResponse(w http.ResponseWriter) error {
+func (response UserUpdateLogout200JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunList400JSONResponse APIErrors
+type UserUpdateLogout400JSONResponse APIErrors
 
-func (response WorkflowRunList400JSONResponse) VisitWorkflowRunListResponse(w http.ResponseWriter) error {
+func (response UserUpdateLogout400JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunList403JSONResponse APIErrors
+type UserUpdateLogout401JSONResponse APIErrors
 
-func (response WorkflowRunList403JSONResponse) VisitWorkflowRunListResponse(w http.ResponseWriter) error {
+func (response UserUpdateLogout401JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetMetricsRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Params WorkflowRunGetMetricsParams
+type UserUpdateLogout405JSONResponse APIErrors
+
+func (response UserUpdateLogout405JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetMetricsResponseObject interface {
-	VisitWorkflowRunGetMetricsResponse(w http.ResponseWriter) error
+type TenantMembershipsListRequestObject struct {
 }
 
-type WorkflowRunGetMetrics200JSONResponse WorkflowRunsMetrics
+type TenantMembershipsListResponseObject interface {
+	VisitTenantMembershipsListResponse(w http.ResponseWriter) error
+}
 
-func (response WorkflowRunGetMetrics200JSONResponse) VisitWorkflowRunGetMetricsResponse(w http.ResponseWriter) error {
+type TenantMembershipsList200JSONResponse UserTenantMembershipsList
+
+func (response TenantMembershipsList200JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetMetrics400JSONResponse APIErrors
+type TenantMembershipsList400JSONResponse APIErrors
 
-func (response WorkflowRunGetMetrics400JSONResponse) VisitWorkflowRunGetMetricsResponse(w http.ResponseWriter) error {
+func (response TenantMembershipsList400JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowRunGetMetrics403JSONResponse APIErrors
+type TenantMembershipsList403JSONResponse APIErrors
 
-func (response WorkflowRunGetMetrics403JSONResponse) VisitWorkflowRunGetMetricsResponse(w http.ResponseWriter) error {
+func (response TenantMembershipsList403JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledListRequestObject struct {
-	Tenant openapi_types.UUID `json:"tenant"`
-	Params WorkflowScheduledListParams
+type UserUpdateOidcCallbackRequestObject struct {
 }
 
-type WorkflowScheduledListResponseObject interface {
-	VisitWorkflowScheduledListResponse(w http.ResponseWriter) error
+type UserUpdateOidcCallbackResponseObject interface {
+	VisitUserUpdateOidcCallbackResponse(w http.ResponseWriter) error
 }
 
-type WorkflowScheduledList200JSONResponse ScheduledWorkflowsList
+type UserUpdateOidcCallback302ResponseHeaders struct {
+	Location string
+}
 
-func (response WorkflowScheduledList200JSONResponse) VisitWorkflowScheduledListResponse(w http.ResponseWriter) error {
+type UserUpdateOidcCallback302Response struct {
+	Headers UserUpdateOidcCallback302ResponseHeaders
+}
+
+func (response UserUpdateOidcCallback302Response) VisitUserUpdateOidcCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type UserUpdateOidcStartRequestObject struct {
+}
+
+type UserUpdateOidcStartResponseObject interface {
+	VisitUserUpdateOidcStartResponse(w http.ResponseWriter) error
+}
+
+type UserUpdateOidcStart302ResponseHeaders struct {
+	Location string
+}
+
+type UserUpdateOidcStart302Response struct {
+	Headers UserUpdateOidcStart302ResponseHeaders
+}
+
+func (response UserUpdateOidcStart302Response) VisitUserUpdateOidcStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type UserUpdatePasswordRequestObject struct {
+	Body *UserUpdatePasswordJSONRequestBody
+}
+
+type UserUpdatePasswordResponseObject interface {
+	VisitUserUpdatePasswordResponse(w http.ResponseWriter) error
+}
+
+type UserUpdatePassword200JSONResponse User
+
+func (response UserUpdatePassword200JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledList400JSONResponse APIErrors
+type UserUpdatePassword400JSONResponse APIErrors
 
-func (response WorkflowScheduledList400JSONResponse) VisitWorkflowScheduledListResponse(w http.ResponseWriter) error {
+func (response UserUpdatePassword400JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledList403JSONResponse APIErrors
+type UserUpdatePassword401JSONResponse APIErrors
 
-func (response WorkflowScheduledList403JSONResponse) VisitWorkflowScheduledListResponse(w http.ResponseWriter) error {
+func (response UserUpdatePassword401JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledDeleteRequestObject struct {
-	Tenant               openapi_types.UUID `json:"tenant"`
-	ScheduledWorkflowRun openapi_types.UUID `json:"scheduled-workflow-run"`
+type UserUpdatePassword405JSONResponse APIErrors
+
+func (response UserUpdatePassword405JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledDeleteResponseObject interface {
-	VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error
+type UserCreateRequestObject struct {
+	Body *UserCreateJSONRequestBody
 }
 
-type WorkflowScheduledDelete204Response struct {
+type UserCreateResponseObject interface {
+	VisitUserCreateResponse(w http.ResponseWriter) error
 }
 
-func (response WorkflowScheduledDelete204Response) VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type UserCreate200JSONResponse User
+
+func (response UserCreate200JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledDelete400JSONResponse APIErrors
+type UserCreate400JSONResponse APIErrors
 
-func (response WorkflowScheduledDelete400JSONResponse) VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error {
+func (response UserCreate400JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledDelete403JSONResponse APIError
+type UserCreate401JSONResponse APIErrors
 
-func (response WorkflowScheduledDelete403JSONResponse) VisitWorkflowScheduledDeleteResponse(w http.ResponseWriter) error {
+func (response UserCreate401JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledGetRequestObject struct {
-	Tenant               openapi_types.UUID `json:"tenant"`
-	ScheduledWorkflowRun openapi_types.UUID `json:"scheduled-workflow-run"`
+type UserCreate405JSONResponse APIErrors
+
+func (response UserCreate405JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledGetResponseObject interface {
-	VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error
+type UserUpdateSlackOauthCallbackRequestObject struct {
 }
 
-type WorkflowScheduledGet200JSONResponse ScheduledWorkflows
+type UserUpdateSlackOauthCallbackResponseObject interface {
+	VisitUserUpdateSlackOauthCallbackResponse(w http.ResponseWriter) error
+}
 
-func (response WorkflowScheduledGet200JSONResponse) VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type UserUpdateSlackOauthCallback302ResponseHeaders struct {
+	Location string
+}
 
-	return json.NewEncoder(w).Encode(response)
+type UserUpdateSlackOauthCallback302Response struct {
+	Headers UserUpdateSlackOauthCallback302ResponseHeaders
 }
 
-type WorkflowScheduledGet400JSONResponse APIErrors
+func (response UserUpdateSlackOauthCallback302Response) VisitUserUpdateSlackOauthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type WebhookDeleteRequestObject struct {
+	Webhook openapi_types.UUID `json:"webhook"`
+}
+
+type WebhookDeleteResponseObject interface {
+	VisitWebhookDeleteResponse(w http.ResponseWriter) error
+}
+
+type WebhookDelete200Response struct {
+}
+
+func (response WebhookDelete200Response) VisitWebhookDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type WebhookDelete400JSONResponse APIErrors
 
-func (response WorkflowScheduledGet400JSONResponse) VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error {
+func (response WebhookDelete400JSONResponse) VisitWebhookDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledGet403JSONResponse APIErrors
+type WebhookDelete401JSONResponse APIErrors
 
-func (response WorkflowScheduledGet403JSONResponse) VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error {
+func (response WebhookDelete401JSONResponse) VisitWebhookDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowScheduledGet404JSONResponse APIErrors
+type WebhookDelete405JSONResponse APIErrors
 
-func (response WorkflowScheduledGet404JSONResponse) VisitWorkflowScheduledGetResponse(w http.ResponseWriter) error {
+func (response WebhookDelete405JSONResponse) VisitWebhookDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(405)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CronWorkflowTriggerCreateRequestObject struct {
-	Tenant   openapi_types.UUID `json:"tenant"`
-	Workflow string             `json:"workflow"`
-	Body     *CronWorkflowTriggerCreateJSONRequestBody
+type WebhookRequestsListRequestObject struct {
+	Webhook openapi_types.UUID `json:"webhook"`
 }
 
-type CronWorkflowTriggerCreateResponseObject interface {
-	VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error
+type WebhookRequestsListResponseObject interface {
+	VisitWebhookRequestsListResponse(w http.ResponseWriter) error
 }
 
-type CronWorkflowTriggerCreate200JSONResponse CronWorkflows
+type WebhookRequestsList200JSONResponse WebhookWorkerRequestListResponse
 
-func (response CronWorkflowTriggerCreate200JSONResponse) VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error {
+func (response WebhookRequestsList200JSONResponse) VisitWebhookRequestsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CronWorkflowTriggerCreate400JSONResponse APIErrors
+type WebhookRequestsList400JSONResponse APIErrors
 
-func (response CronWorkflowTriggerCreate400JSONResponse) VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error {
+func (response WebhookRequestsList400JSONResponse) VisitWebhookRequestsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CronWorkflowTriggerCreate403JSONResponse APIErrors
-
-func (response CronWorkflowTriggerCreate403JSONResponse) VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type CronWorkflowTriggerCreate404JSONResponse APIErrors
+type WebhookRequestsList401JSONResponse APIErrors
 
-func (response CronWorkflowTriggerCreate404JSONResponse) VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error {
+func (response WebhookRequestsList401JSONResponse) VisitWebhookRequestsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CronWorkflowTriggerCreate429JSONResponse APIErrors
+type WebhookRequestsList405JSONResponse APIErrors
 
-func (response CronWorkflowTriggerCreate429JSONResponse) VisitCronWorkflowTriggerCreateResponse(w http.ResponseWriter) error {
+func (response WebhookRequestsList405JSONResponse) VisitWebhookRequestsListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
+	w.WriteHeader(405)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ScheduledWorkflowRunCreateRequestObject struct {
-	Tenant   openapi_types.UUID `json:"tenant"`
-	Workflow string             `json:"workflow"`
-	Body     *ScheduledWorkflowRunCreateJSONRequestBody
+type WorkerGetRequestObject struct {
+	Worker openapi_types.UUID `json:"worker"`
 }
 
-type ScheduledWorkflowRunCreateResponseObject interface {
-	VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error
+type WorkerGetResponseObject interface {
+	VisitWorkerGetResponse(w http.ResponseWriter) error
 }
 
-type ScheduledWorkflowRunCreate200JSONResponse ScheduledWorkflows
+type WorkerGet200JSONResponse Worker
 
-func (response ScheduledWorkflowRunCreate200JSONResponse) VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error {
+func (response WorkerGet200JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ScheduledWorkflowRunCreate400JSONResponse APIErrors
+type WorkerGet400JSONResponse APIErrors
 
-func (response ScheduledWorkflowRunCreate400JSONResponse) VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error {
+func (response WorkerGet400JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ScheduledWorkflowRunCreate403JSONResponse APIErrors
+type WorkerGet403JSONResponse APIErrors
 
-func (response ScheduledWorkflowRunCreate403JSONResponse) VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error {
+func (response WorkerGet403JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ScheduledWorkflowRunCreate404JSONResponse APIErrors
-
-func (response ScheduledWorkflowRunCreate404JSONResponse) VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ScheduledWorkflowRunCreate429JSONResponse APIErrors
-
-func (response ScheduledWorkflowRunCreate429JSONResponse) VisitScheduledWorkflowRunCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type WorkflowGetWorkersCountRequestObject struct {
-	Tenant   openapi_types.UUID `json:"tenant"`
-	Workflow openapi_types.UUID `json:"workflow"`
+type WorkerUpdateRequestObject struct {
+	Worker openapi_types.UUID `json:"worker"`
+	Body   *WorkerUpdateJSONRequestBody
 }
 
-type WorkflowGetWorkersCountResponseObject interface {
-	VisitWorkflowGetWorkersCountResponse(w http.ResponseWriter) error
+type WorkerUpdateResponseObject interface {
+	VisitWorkerUpdateResponse(w http.ResponseWriter) error
 }
 
-type WorkflowGetWorkersCount200JSONResponse WorkflowWorkersCount
+type WorkerUpdate200JSONResponse Worker
 
-func (response WorkflowGetWorkersCount200JSONResponse) VisitWorkflowGetWorkersCountResponse(w http.ResponseWriter) error {
+func (response WorkerUpdate200JSONResponse) VisitWorkerUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowGetWorkersCount400JSONResponse APIErrors
+type WorkerUpdate400JSONResponse APIErrors
 
-func (response WorkflowGetWorkersCount400JSONResponse) VisitWorkflowGetWorkersCountResponse(w http.ResponseWriter) error {
+func (response WorkerUpdate400JSONResponse) VisitWorkerUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WorkflowGetWorkersCount403JSONResponse APIErrors
+type WorkerUpdate403JSONResponse APIErrors
 
-func (response WorkflowGetWorkersCount403JSONResponse) VisitWorkflowGetWorkersCountResponse(w http.ResponseWriter) error {
+func (response WorkerUpdate403JSONResponse) VisitWorkerUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserGetCurrentRequestObject struct {
+type WorkflowDeleteRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
 }
 
-type UserGetCurrentResponseObject interface {
-	VisitUserGetCurrentResponse(w http.ResponseWriter) error
+type WorkflowDeleteResponseObject interface {
+	VisitWorkflowDeleteResponse(w http.ResponseWriter) error
 }
 
-type UserGetCurrent200JSONResponse User
-
-func (response UserGetCurrent200JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type WorkflowDelete204Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response WorkflowDelete204Response) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type UserGetCurrent400JSONResponse APIErrors
+type WorkflowDelete400JSONResponse APIErrors
 
-func (response UserGetCurrent400JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
+func (response WorkflowDelete400JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserGetCurrent401JSONResponse APIErrors
+type WorkflowDelete403JSONResponse APIErrors
 
-func (response UserGetCurrent401JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
+func (response WorkflowDelete403JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserGetCurrent405JSONResponse APIErrors
+type WorkflowDelete404JSONResponse APIErrors
 
-func (response UserGetCurrent405JSONResponse) VisitUserGetCurrentResponse(w http.ResponseWriter) error {
+func (response WorkflowDelete404JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateGithubOauthCallbackRequestObject struct {
+type WorkflowGetRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
 }
 
-type UserUpdateGithubOauthCallbackResponseObject interface {
-	VisitUserUpdateGithubOauthCallbackResponse(w http.ResponseWriter) error
+type WorkflowGetResponseObject interface {
+	VisitWorkflowGetResponse(w http.ResponseWriter) error
 }
 
-type UserUpdateGithubOauthCallback302ResponseHeaders struct {
-	Location string
-}
+type WorkflowGet200JSONResponse Workflow
 
-type UserUpdateGithubOauthCallback302Response struct {
-	Headers UserUpdateGithubOauthCallback302ResponseHeaders
-}
+func (response WorkflowGet200JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-func (response UserUpdateGithubOauthCallback302Response) VisitUserUpdateGithubOauthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(302)
-	return nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateGithubOauthStartRequestObject struct {
-}
+type WorkflowGet400JSONResponse APIErrors
 
-type UserUpdateGithubOauthStartResponseObject interface {
-	VisitUserUpdateGithubOauthStartResponse(w http.ResponseWriter) error
-}
+func (response WorkflowGet400JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-type UserUpdateGithubOauthStart302ResponseHeaders struct {
-	Location string
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateGithubOauthStart302Response struct {
-	Headers UserUpdateGithubOauthStart302ResponseHeaders
-}
+type WorkflowGet403JSONResponse APIErrors
 
-func (response UserUpdateGithubOauthStart302Response) VisitUserUpdateGithubOauthStartResponse(w http.ResponseWriter) error {
-	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(302)
-	return nil
-}
+func (response WorkflowGet403JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-type UserUpdateGoogleOauthCallbackRequestObject struct {
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateGoogleOauthCallbackResponseObject interface {
-	VisitUserUpdateGoogleOauthCallbackResponse(w http.ResponseWriter) error
-}
+type WorkflowGet404JSONResponse APIErrors
 
-type UserUpdateGoogleOauthCallback302ResponseHeaders struct {
-	Location string
-}
+func (response WorkflowGet404JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-type UserUpdateGoogleOauthCallback302Response struct {
-	Headers UserUpdateGoogleOauthCallback302ResponseHeaders
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response UserUpdateGoogleOauthCallback302Response) VisitUserUpdateGoogleOauthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(302)
-	return nil
+type WorkflowUpdateRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Body     *WorkflowUpdateJSONRequestBody
 }
 
-type UserUpdateGoogleOauthStartRequestObject struct {
+type WorkflowUpdateResponseObject interface {
+	VisitWorkflowUpdateResponse(w http.ResponseWriter) error
 }
 
-type UserUpdateGoogleOauthStartResponseObject interface {
-	VisitUserUpdateGoogleOauthStartResponse(w http.ResponseWriter) error
-}
+type WorkflowUpdate200JSONResponse Workflow
 
-type UserUpdateGoogleOauthStart302ResponseHeaders struct {
-	Location string
-}
+func (response WorkflowUpdate200JSONResponse) VisitWorkflowUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-type UserUpdateGoogleOauthStart302Response struct {
-	Headers UserUpdateGoogleOauthStart302ResponseHeaders
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response UserUpdateGoogleOauthStart302Response) VisitUserUpdateGoogleOauthStartResponse(w http.ResponseWriter) error {
-	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(302)
-	return nil
+type WorkflowUpdate400JSONResponse APIErrors
+
+func (response WorkflowUpdate400JSONResponse) VisitWorkflowUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserListTenantInvitesRequestObject struct {
+type WorkflowUpdate403JSONResponse APIErrors
+
+func (response WorkflowUpdate403JSONResponse) VisitWorkflowUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserListTenantInvitesResponseObject interface {
-	VisitUserListTenantInvitesResponse(w http.ResponseWriter) error
+type WorkflowListConcurrencyGroupsRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Params   WorkflowListConcurrencyGroupsParams
+}
+
+type WorkflowListConcurrencyGroupsResponseObject interface {
+	VisitWorkflowListConcurrencyGroupsResponse(w http.ResponseWriter) error
 }
 
-type UserListTenantInvites200JSONResponse TenantInviteList
+type WorkflowListConcurrencyGroups200JSONResponse WorkflowConcurrencyGroupList
 
-func (response UserListTenantInvites200JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
+func (response WorkflowListConcurrencyGroups200JSONResponse) VisitWorkflowListConcurrencyGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserListTenantInvites400JSONResponse APIErrors
+type WorkflowListConcurrencyGroups400JSONResponse APIErrors
 
-func (response UserListTenantInvites400JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
+func (response WorkflowListConcurrencyGroups400JSONResponse) VisitWorkflowListConcurrencyGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserListTenantInvites403JSONResponse APIErrors
+type WorkflowListConcurrencyGroups403JSONResponse APIErrors
 
-func (response UserListTenantInvites403JSONResponse) VisitUserListTenantInvitesResponse(w http.ResponseWriter) error {
+func (response WorkflowListConcurrencyGroups403JSONResponse) VisitWorkflowListConcurrencyGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteAcceptRequestObject struct {
-	Body *TenantInviteAcceptJSONRequestBody
+type WorkflowListConcurrencyGroups404JSONResponse APIErrors
+
+func (response WorkflowListConcurrencyGroups404JSONResponse) VisitWorkflowListConcurrencyGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteAcceptResponseObject interface {
-	VisitTenantInviteAcceptResponse(w http.ResponseWriter) error
+type WorkflowGetMetricsRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Params   WorkflowGetMetricsParams
 }
 
-type TenantInviteAccept200Response struct {
+type WorkflowGetMetricsResponseObject interface {
+	VisitWorkflowGetMetricsResponse(w http.ResponseWriter) error
 }
 
-func (response TenantInviteAccept200Response) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
+type WorkflowGetMetrics200JSONResponse WorkflowMetrics
+
+func (response WorkflowGetMetrics200JSONResponse) VisitWorkflowGetMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteAccept400JSONResponse APIErrors
+type WorkflowGetMetrics400JSONResponse APIErrors
 
-func (response TenantInviteAccept400JSONResponse) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
+func (response WorkflowGetMetrics400JSONResponse) VisitWorkflowGetMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteAccept403JSONResponse APIError
+type WorkflowGetMetrics403JSONResponse APIErrors
 
-func (response TenantInviteAccept403JSONResponse) VisitTenantInviteAcceptResponse(w http.ResponseWriter) error {
+func (response WorkflowGetMetrics403JSONResponse) VisitWorkflowGetMetricsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteRejectRequestObject struct {
-	Body *TenantInviteRejectJSONRequestBody
+type WorkflowGetMetrics404JSONResponse APIErrors
+
+func (response WorkflowGetMetrics404JSONResponse) VisitWorkflowGetMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteRejectResponseObject interface {
-	VisitTenantInviteRejectResponse(w http.ResponseWriter) error
+type WorkflowDeleteRolloutRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
 }
 
-type TenantInviteReject200Response struct {
+type WorkflowDeleteRolloutResponseObject interface {
+	VisitWorkflowDeleteRolloutResponse(w http.ResponseWriter) error
 }
 
-func (response TenantInviteReject200Response) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+type WorkflowDeleteRollout204Response struct {
+}
+
+func (response WorkflowDeleteRollout204Response) VisitWorkflowDeleteRolloutResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
 	return nil
 }
 
-type TenantInviteReject400JSONResponse APIErrors
+type WorkflowDeleteRollout400JSONResponse APIErrors
 
-func (response TenantInviteReject400JSONResponse) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
+func (response WorkflowDeleteRollout400JSONResponse) VisitWorkflowDeleteRolloutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantInviteReject403JSONResponse APIError
+type WorkflowDeleteRollout403JSONResponse APIErrors
 
-func (response TenantInviteReject403JSONResponse) VisitTenantInviteRejectResponse(w http.ResponseWriter) error {
+func (response WorkflowDeleteRollout403JSONResponse) VisitWorkflowDeleteRolloutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLoginRequestObject struct {
-	Body *UserUpdateLoginJSONRequestBody
+type WorkflowDeleteRollout404JSONResponse APIErrors
+
+func (response WorkflowDeleteRollout404JSONResponse) VisitWorkflowDeleteRolloutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLoginResponseObject interface {
-	VisitUserUpdateLoginResponse(w http.ResponseWriter) error
+type WorkflowGetRolloutRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
 }
 
-type UserUpdateLogin200JSONResponse User
+type WorkflowGetRolloutResponseObject interface {
+	VisitWorkflowGetRolloutResponse(w http.ResponseWriter) error
+}
 
-func (response UserUpdateLogin200JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+type WorkflowGetRollout200JSONResponse WorkflowRollout
+
+func (response WorkflowGetRollout200JSONResponse) VisitWorkflowGetRolloutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogin400JSONResponse APIErrors
+type WorkflowGetRollout400JSONResponse APIErrors
 
-func (response UserUpdateLogin400JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+func (response WorkflowGetRollout400JSONResponse) VisitWorkflowGetRolloutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogin401JSONResponse APIErrors
+type WorkflowGetRollout403JSONResponse APIErrors
 
-func (response UserUpdateLogin401JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+func (response WorkflowGetRollout403JSONResponse) VisitWorkflowGetRolloutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogin405JSONResponse APIErrors
+type WorkflowGetRollout404JSONResponse APIErrors
 
-func (response UserUpdateLogin405JSONResponse) VisitUserUpdateLoginResponse(w http.ResponseWriter) error {
+func (response WorkflowGetRollout404JSONResponse) VisitWorkflowGetRolloutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogoutRequestObject struct {
+type WorkflowUpdateRolloutRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Body     *WorkflowUpdateRolloutJSONRequestBody
 }
 
-type UserUpdateLogoutResponseObject interface {
-	VisitUserUpdateLogoutResponse(w http.ResponseWriter) error
+type WorkflowUpdateRolloutResponseObject interface {
+	VisitWorkflowUpdateRolloutResponse(w http.ResponseWriter) error
 }
 
-type UserUpdateLogout200JSONResponse User
+type WorkflowUpdateRollout200JSONResponse WorkflowRollout
 
-func (response UserUpdateLogout200JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateRollout200JSONResponse) VisitWorkflowUpdateRolloutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogout400JSONResponse APIErrors
+type WorkflowUpdateRollout400JSONResponse APIErrors
 
-func (response UserUpdateLogout400JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateRollout400JSONResponse) VisitWorkflowUpdateRolloutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogout401JSONResponse APIErrors
+type WorkflowUpdateRollout403JSONResponse APIErrors
 
-func (response UserUpdateLogout401JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateRollout403JSONResponse) VisitWorkflowUpdateRolloutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdateLogout405JSONResponse APIErrors
+type WorkflowUpdateRollout404JSONResponse APIErrors
 
-func (response UserUpdateLogout405JSONResponse) VisitUserUpdateLogoutResponse(w http.ResponseWriter) error {
+func (response WorkflowUpdateRollout404JSONResponse) VisitWorkflowUpdateRolloutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMembershipsListRequestObject struct {
+type WorkflowRunCreateRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Params   WorkflowRunCreateParams
+	Body     *WorkflowRunCreateJSONRequestBody
 }
 
-type TenantMembershipsListResponseObject interface {
-	VisitTenantMembershipsListResponse(w http.ResponseWriter) error
+type WorkflowRunCreateResponseObject interface {
+	VisitWorkflowRunCreateResponse(w http.ResponseWriter) error
 }
 
-type TenantMembershipsList200JSONResponse UserTenantMembershipsList
+type WorkflowRunCreate200JSONResponse WorkflowRun
 
-func (response TenantMembershipsList200JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+func (response WorkflowRunCreate200JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMembershipsList400JSONResponse APIErrors
+type WorkflowRunCreate400JSONResponse APIErrors
 
-func (response TenantMembershipsList400JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+func (response WorkflowRunCreate400JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TenantMembershipsList403JSONResponse APIErrors
+type WorkflowRunCreate403JSONResponse APIErrors
 
-func (response TenantMembershipsList403JSONResponse) VisitTenantMembershipsListResponse(w http.ResponseWriter) error {
+func (response WorkflowRunCreate403JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdatePasswordRequestObject struct {
-	Body *UserUpdatePasswordJSONRequestBody
+type WorkflowRunCreate404JSONResponse APIErrors
+
+func (response WorkflowRunCreate404JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdatePasswordResponseObject interface {
-	VisitUserUpdatePasswordResponse(w http.ResponseWriter) error
+type WorkflowRunCreate429JSONResponse APIErrors
+
+func (response WorkflowRunCreate429JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdatePassword200JSONResponse User
+type WorkflowVersionGetRequestObject struct {
+	Workflow openapi_types.UUID `json:"workflow"`
+	Params   WorkflowVersionGetParams
+}
 
-func (response UserUpdatePassword200JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+type WorkflowVersionGetResponseObject interface {
+	VisitWorkflowVersionGetResponse(w http.ResponseWriter) error
+}
+
+type WorkflowVersionGet200JSONResponse WorkflowVersion
+
+func (response WorkflowVersionGet200JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdatePassword400JSONResponse APIErrors
+type WorkflowVersionGet400JSONResponse APIErrors
 
-func (response UserUpdatePassword400JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+func (response WorkflowVersionGet400JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdatePassword401JSONResponse APIErrors
+type WorkflowVersionGet403JSONResponse APIErrors
 
-func (response UserUpdatePassword401JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+func (response WorkflowVersionGet403JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserUpdatePassword405JSONResponse APIErrors
+type WorkflowVersionGet404JSONResponse APIErrors
 
-func (response UserUpdatePassword405JSONResponse) VisitUserUpdatePasswordResponse(w http.ResponseWriter) error {
+func (response WorkflowVersionGet404JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UserCreateRequestObject struct {
-	Body *UserCreateJSONRequestBody
-}
+type StrictServerInterface interface {
+	HealthzGet(ctx echo.Context, request HealthzGetRequestObject) (HealthzGetResponseObject, error)
 
-type UserCreateResponseObject interface {
-	VisitUserCreateResponse(w http.ResponseWriter) error
-}
+	LivenessGet(ctx echo.Context, request LivenessGetRequestObject) (LivenessGetResponseObject, error)
 
-type UserCreate200JSONResponse User
+	ReadinessGet(ctx echo.Context, request ReadinessGetRequestObject) (ReadinessGetResponseObject, error)
 
-func (response UserCreate200JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ReadyzGet(ctx echo.Context, request ReadyzGetRequestObject) (ReadyzGetResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	AlertEmailGroupDelete(ctx echo.Context, request AlertEmailGroupDeleteRequestObject) (AlertEmailGroupDeleteResponseObject, error)
 
-type UserCreate400JSONResponse APIErrors
+	AlertEmailGroupUpdate(ctx echo.Context, request AlertEmailGroupUpdateRequestObject) (AlertEmailGroupUpdateResponseObject, error)
+
+	ApiTokenUpdateRevoke(ctx echo.Context, request ApiTokenUpdateRevokeRequestObject) (ApiTokenUpdateRevokeResponseObject, error)
+
+	ApiTokenUpdateRotate(ctx echo.Context, request ApiTokenUpdateRotateRequestObject) (ApiTokenUpdateRotateResponseObject, error)
+
+	ApprovalUpdateDecideWithToken(ctx echo.Context, request ApprovalUpdateDecideWithTokenRequestObject) (ApprovalUpdateDecideWithTokenResponseObject, error)
+
+	ApprovalGet(ctx echo.Context, request ApprovalGetRequestObject) (ApprovalGetResponseObject, error)
+
+	ApprovalUpdateDecide(ctx echo.Context, request ApprovalUpdateDecideRequestObject) (ApprovalUpdateDecideResponseObject, error)
+
+	ClientCaDelete(ctx echo.Context, request ClientCaDeleteRequestObject) (ClientCaDeleteResponseObject, error)
+
+	CloudMetadataGet(ctx echo.Context, request CloudMetadataGetRequestObject) (CloudMetadataGetResponseObject, error)
+
+	EventDedupRuleDelete(ctx echo.Context, request EventDedupRuleDeleteRequestObject) (EventDedupRuleDeleteResponseObject, error)
+
+	EventRoutingRuleDelete(ctx echo.Context, request EventRoutingRuleDeleteRequestObject) (EventRoutingRuleDeleteResponseObject, error)
+
+	EventSinkDelete(ctx echo.Context, request EventSinkDeleteRequestObject) (EventSinkDeleteResponseObject, error)
+
+	EventSinkReplay(ctx echo.Context, request EventSinkReplayRequestObject) (EventSinkReplayResponseObject, error)
+
+	EventGet(ctx echo.Context, request EventGetRequestObject) (EventGetResponseObject, error)
+
+	EventDataGet(ctx echo.Context, request EventDataGetRequestObject) (EventDataGetResponseObject, error)
+
+	InboundWebhookDelete(ctx echo.Context, request InboundWebhookDeleteRequestObject) (InboundWebhookDeleteResponseObject, error)
+
+	InboundWebhookReceive(ctx echo.Context, request InboundWebhookReceiveRequestObject) (InboundWebhookReceiveResponseObject, error)
+
+	MetadataGet(ctx echo.Context, request MetadataGetRequestObject) (MetadataGetResponseObject, error)
+
+	MetadataListIntegrations(ctx echo.Context, request MetadataListIntegrationsRequestObject) (MetadataListIntegrationsResponseObject, error)
+
+	SlackWebhookDelete(ctx echo.Context, request SlackWebhookDeleteRequestObject) (SlackWebhookDeleteResponseObject, error)
+
+	SnsDelete(ctx echo.Context, request SnsDeleteRequestObject) (SnsDeleteResponseObject, error)
+
+	SnsUpdate(ctx echo.Context, request SnsUpdateRequestObject) (SnsUpdateResponseObject, error)
+
+	SqsDelete(ctx echo.Context, request SqsDeleteRequestObject) (SqsDeleteResponseObject, error)
+
+	StepRunListArchives(ctx echo.Context, request StepRunListArchivesRequestObject) (StepRunListArchivesResponseObject, error)
+
+	StepRunListEvents(ctx echo.Context, request StepRunListEventsRequestObject) (StepRunListEventsResponseObject, error)
+
+	LogLineList(ctx echo.Context, request LogLineListRequestObject) (LogLineListResponseObject, error)
+
+	LogLineStream(ctx echo.Context, request LogLineStreamRequestObject) (LogLineStreamResponseObject, error)
+
+	StepRunListSchedulingDecisions(ctx echo.Context, request StepRunListSchedulingDecisionsRequestObject) (StepRunListSchedulingDecisionsResponseObject, error)
+
+	TenantCreate(ctx echo.Context, request TenantCreateRequestObject) (TenantCreateResponseObject, error)
+
+	TenantUpdate(ctx echo.Context, request TenantUpdateRequestObject) (TenantUpdateResponseObject, error)
+
+	AlertEmailGroupList(ctx echo.Context, request AlertEmailGroupListRequestObject) (AlertEmailGroupListResponseObject, error)
+
+	AlertEmailGroupCreate(ctx echo.Context, request AlertEmailGroupCreateRequestObject) (AlertEmailGroupCreateResponseObject, error)
+
+	TenantAlertingSettingsGet(ctx echo.Context, request TenantAlertingSettingsGetRequestObject) (TenantAlertingSettingsGetResponseObject, error)
+
+	ApiTokenList(ctx echo.Context, request ApiTokenListRequestObject) (ApiTokenListResponseObject, error)
+
+	ApiTokenCreate(ctx echo.Context, request ApiTokenCreateRequestObject) (ApiTokenCreateResponseObject, error)
+
+	ApprovalList(ctx echo.Context, request ApprovalListRequestObject) (ApprovalListResponseObject, error)
+
+	AuditLogList(ctx echo.Context, request AuditLogListRequestObject) (AuditLogListResponseObject, error)
+
+	ClientCaList(ctx echo.Context, request ClientCaListRequestObject) (ClientCaListResponseObject, error)
+
+	ClientCaCreate(ctx echo.Context, request ClientCaCreateRequestObject) (ClientCaCreateResponseObject, error)
+
+	CronCalendarList(ctx echo.Context, request CronCalendarListRequestObject) (CronCalendarListResponseObject, error)
+
+	CronCalendarCreate(ctx echo.Context, request CronCalendarCreateRequestObject) (CronCalendarCreateResponseObject, error)
+
+	CronCalendarDelete(ctx echo.Context, request CronCalendarDeleteRequestObject) (CronCalendarDeleteResponseObject, error)
+
+	DeadLetterQueueList(ctx echo.Context, request DeadLetterQueueListRequestObject) (DeadLetterQueueListResponseObject, error)
+
+	DeadLetterQueueDelete(ctx echo.Context, request DeadLetterQueueDeleteRequestObject) (DeadLetterQueueDeleteResponseObject, error)
+
+	DeadLetterQueueUpdateReplay(ctx echo.Context, request DeadLetterQueueUpdateReplayRequestObject) (DeadLetterQueueUpdateReplayResponseObject, error)
+
+	EventDedupRuleList(ctx echo.Context, request EventDedupRuleListRequestObject) (EventDedupRuleListResponseObject, error)
+
+	EventDedupRuleUpsert(ctx echo.Context, request EventDedupRuleUpsertRequestObject) (EventDedupRuleUpsertResponseObject, error)
+
+	EventRoutingRuleList(ctx echo.Context, request EventRoutingRuleListRequestObject) (EventRoutingRuleListResponseObject, error)
+
+	EventRoutingRuleUpsert(ctx echo.Context, request EventRoutingRuleUpsertRequestObject) (EventRoutingRuleUpsertResponseObject, error)
+
+	EventSinkList(ctx echo.Context, request EventSinkListRequestObject) (EventSinkListResponseObject, error)
+
+	EventSinkCreate(ctx echo.Context, request EventSinkCreateRequestObject) (EventSinkCreateResponseObject, error)
+
+	EventList(ctx echo.Context, request EventListRequestObject) (EventListResponseObject, error)
 
-func (response UserCreate400JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	EventCreate(ctx echo.Context, request EventCreateRequestObject) (EventCreateResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	EventCreateBatch(ctx echo.Context, request EventCreateBatchRequestObject) (EventCreateBatchResponseObject, error)
 
-type UserCreate401JSONResponse APIErrors
+	EventCreateBulk(ctx echo.Context, request EventCreateBulkRequestObject) (EventCreateBulkResponseObject, error)
 
-func (response UserCreate401JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	EventUpdateCancel(ctx echo.Context, request EventUpdateCancelRequestObject) (EventUpdateCancelResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	EventCreateCloudEvent(ctx echo.Context, request EventCreateCloudEventRequestObject) (EventCreateCloudEventResponseObject, error)
 
-type UserCreate405JSONResponse APIErrors
+	EventKeyList(ctx echo.Context, request EventKeyListRequestObject) (EventKeyListResponseObject, error)
 
-func (response UserCreate405JSONResponse) VisitUserCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	EventUpdateReplay(ctx echo.Context, request EventUpdateReplayRequestObject) (EventUpdateReplayResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	EventUpdateReplayRange(ctx echo.Context, request EventUpdateReplayRangeRequestObject) (EventUpdateReplayRangeResponseObject, error)
 
-type UserUpdateSlackOauthCallbackRequestObject struct {
-}
+	InboundWebhookList(ctx echo.Context, request InboundWebhookListRequestObject) (InboundWebhookListResponseObject, error)
 
-type UserUpdateSlackOauthCallbackResponseObject interface {
-	VisitUserUpdateSlackOauthCallbackResponse(w http.ResponseWriter) error
-}
+	InboundWebhookCreate(ctx echo.Context, request InboundWebhookCreateRequestObject) (InboundWebhookCreateResponseObject, error)
 
-type UserUpdateSlackOauthCallback302ResponseHeaders struct {
-	Location string
-}
+	TenantInviteList(ctx echo.Context, request TenantInviteListRequestObject) (TenantInviteListResponseObject, error)
 
-type UserUpdateSlackOauthCallback302Response struct {
-	Headers UserUpdateSlackOauthCallback302ResponseHeaders
-}
+	TenantInviteCreate(ctx echo.Context, request TenantInviteCreateRequestObject) (TenantInviteCreateResponseObject, error)
 
-func (response UserUpdateSlackOauthCallback302Response) VisitUserUpdateSlackOauthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(302)
-	return nil
-}
+	TenantInviteDelete(ctx echo.Context, request TenantInviteDeleteRequestObject) (TenantInviteDeleteResponseObject, error)
 
-type WebhookDeleteRequestObject struct {
-	Webhook openapi_types.UUID `json:"webhook"`
-}
+	TenantInviteUpdate(ctx echo.Context, request TenantInviteUpdateRequestObject) (TenantInviteUpdateResponseObject, error)
 
-type WebhookDeleteResponseObject interface {
-	VisitWebhookDeleteResponse(w http.ResponseWriter) error
-}
+	TenantMemberList(ctx echo.Context, request TenantMemberListRequestObject) (TenantMemberListResponseObject, error)
 
-type WebhookDelete200Response struct {
-}
+	TenantMemberDelete(ctx echo.Context, request TenantMemberDeleteRequestObject) (TenantMemberDeleteResponseObject, error)
 
-func (response WebhookDelete200Response) VisitWebhookDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	TenantGetQueueMetrics(ctx echo.Context, request TenantGetQueueMetricsRequestObject) (TenantGetQueueMetricsResponseObject, error)
 
-type WebhookDelete400JSONResponse APIErrors
+	QueueUpdatePause(ctx echo.Context, request QueueUpdatePauseRequestObject) (QueueUpdatePauseResponseObject, error)
 
-func (response WebhookDelete400JSONResponse) VisitWebhookDeleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	QueueUpdateResume(ctx echo.Context, request QueueUpdateResumeRequestObject) (QueueUpdateResumeResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	TenantQuotaList(ctx echo.Context, request TenantQuotaListRequestObject) (TenantQuotaListResponseObject, error)
 
-type WebhookDelete401JSONResponse APIErrors
+	RateLimitList(ctx echo.Context, request RateLimitListRequestObject) (RateLimitListResponseObject, error)
 
-func (response WebhookDelete401JSONResponse) VisitWebhookDeleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	TenantResourcePolicyGet(ctx echo.Context, request TenantResourcePolicyGetRequestObject) (TenantResourcePolicyGetResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	SlackWebhookList(ctx echo.Context, request SlackWebhookListRequestObject) (SlackWebhookListResponseObject, error)
 
-type WebhookDelete405JSONResponse APIErrors
+	UserUpdateSlackOauthStart(ctx echo.Context, request UserUpdateSlackOauthStartRequestObject) (UserUpdateSlackOauthStartResponseObject, error)
 
-func (response WebhookDelete405JSONResponse) VisitWebhookDeleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	SnsList(ctx echo.Context, request SnsListRequestObject) (SnsListResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	SnsCreate(ctx echo.Context, request SnsCreateRequestObject) (SnsCreateResponseObject, error)
 
-type WebhookRequestsListRequestObject struct {
-	Webhook openapi_types.UUID `json:"webhook"`
-}
+	SqsList(ctx echo.Context, request SqsListRequestObject) (SqsListResponseObject, error)
 
-type WebhookRequestsListResponseObject interface {
-	VisitWebhookRequestsListResponse(w http.ResponseWriter) error
-}
+	SqsCreate(ctx echo.Context, request SqsCreateRequestObject) (SqsCreateResponseObject, error)
 
-type WebhookRequestsList200JSONResponse WebhookWorkerRequestListResponse
+	TenantGetStepRunQueueMetrics(ctx echo.Context, request TenantGetStepRunQueueMetricsRequestObject) (TenantGetStepRunQueueMetricsResponseObject, error)
 
-func (response WebhookRequestsList200JSONResponse) VisitWebhookRequestsListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	StepRunGet(ctx echo.Context, request StepRunGetRequestObject) (StepRunGetResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	StepRunUpdateCancel(ctx echo.Context, request StepRunUpdateCancelRequestObject) (StepRunUpdateCancelResponseObject, error)
 
-type WebhookRequestsList400JSONResponse APIErrors
+	StepRunUpdateRerun(ctx echo.Context, request StepRunUpdateRerunRequestObject) (StepRunUpdateRerunResponseObject, error)
 
-func (response WebhookRequestsList400JSONResponse) VisitWebhookRequestsListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	StepRunGetSchema(ctx echo.Context, request StepRunGetSchemaRequestObject) (StepRunGetSchemaResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WebhookList(ctx echo.Context, request WebhookListRequestObject) (WebhookListResponseObject, error)
 
-type WebhookRequestsList401JSONResponse APIErrors
+	WebhookCreate(ctx echo.Context, request WebhookCreateRequestObject) (WebhookCreateResponseObject, error)
 
-func (response WebhookRequestsList401JSONResponse) VisitWebhookRequestsListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	WorkerList(ctx echo.Context, request WorkerListRequestObject) (WorkerListResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WorkerPoolList(ctx echo.Context, request WorkerPoolListRequestObject) (WorkerPoolListResponseObject, error)
 
-type WebhookRequestsList405JSONResponse APIErrors
+	WorkflowRunUpdateReplay(ctx echo.Context, request WorkflowRunUpdateReplayRequestObject) (WorkflowRunUpdateReplayResponseObject, error)
 
-func (response WebhookRequestsList405JSONResponse) VisitWebhookRequestsListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(405)
+	WorkflowRunGet(ctx echo.Context, request WorkflowRunGetRequestObject) (WorkflowRunGetResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WorkflowRunGetInput(ctx echo.Context, request WorkflowRunGetInputRequestObject) (WorkflowRunGetInputResponseObject, error)
 
-type WorkerGetRequestObject struct {
-	Worker openapi_types.UUID `json:"worker"`
-}
+	WorkflowRunUpdatePause(ctx echo.Context, request WorkflowRunUpdatePauseRequestObject) (WorkflowRunUpdatePauseResponseObject, error)
 
-type WorkerGetResponseObject interface {
-	VisitWorkerGetResponse(w http.ResponseWriter) error
-}
+	WorkflowRunUpdateReplayFromStep(ctx echo.Context, request WorkflowRunUpdateReplayFromStepRequestObject) (WorkflowRunUpdateReplayFromStepResponseObject, error)
 
-type WorkerGet200JSONResponse Worker
+	WorkflowRunUpdateResume(ctx echo.Context, request WorkflowRunUpdateResumeRequestObject) (WorkflowRunUpdateResumeResponseObject, error)
 
-func (response WorkerGet200JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	WorkflowRunGetShape(ctx echo.Context, request WorkflowRunGetShapeRequestObject) (WorkflowRunGetShapeResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WorkflowRunListStepRunEvents(ctx echo.Context, request WorkflowRunListStepRunEventsRequestObject) (WorkflowRunListStepRunEventsResponseObject, error)
+	// Stream workflow run events
+	// (GET /api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/stream)
+	WorkflowRunGetStream(ctx echo.Context, request WorkflowRunGetStreamRequestObject) (WorkflowRunGetStreamResponseObject, error)
 
-type WorkerGet400JSONResponse APIErrors
+	WorkflowList(ctx echo.Context, request WorkflowListRequestObject) (WorkflowListResponseObject, error)
 
-func (response WorkerGet400JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	WorkflowRunCancel(ctx echo.Context, request WorkflowRunCancelRequestObject) (WorkflowRunCancelResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	CronWorkflowList(ctx echo.Context, request CronWorkflowListRequestObject) (CronWorkflowListResponseObject, error)
 
-type WorkerGet403JSONResponse APIErrors
+	WorkflowCronDelete(ctx echo.Context, request WorkflowCronDeleteRequestObject) (WorkflowCronDeleteResponseObject, error)
 
-func (response WorkerGet403JSONResponse) VisitWorkerGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	WorkflowCronGet(ctx echo.Context, request WorkflowCronGetRequestObject) (WorkflowCronGetResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WorkflowRunList(ctx echo.Context, request WorkflowRunListRequestObject) (WorkflowRunListResponseObject, error)
 
-type WorkerUpdateRequestObject struct {
-	Worker openapi_types.UUID `json:"worker"`
-	Body   *WorkerUpdateJSONRequestBody
-}
+	WorkflowRunGetMetrics(ctx echo.Context, request WorkflowRunGetMetricsRequestObject) (WorkflowRunGetMetricsResponseObject, error)
 
-type WorkerUpdateResponseObject interface {
-	VisitWorkerUpdateResponse(w http.ResponseWriter) error
-}
+	WorkflowScheduledList(ctx echo.Context, request WorkflowScheduledListRequestObject) (WorkflowScheduledListResponseObject, error)
 
-type WorkerUpdate200JSONResponse Worker
+	WorkflowScheduledDelete(ctx echo.Context, request WorkflowScheduledDeleteRequestObject) (WorkflowScheduledDeleteResponseObject, error)
 
-func (response WorkerUpdate200JSONResponse) VisitWorkerUpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	WorkflowScheduledGet(ctx echo.Context, request WorkflowScheduledGetRequestObject) (WorkflowScheduledGetResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WorkflowScheduledUpdate(ctx echo.Context, request WorkflowScheduledUpdateRequestObject) (WorkflowScheduledUpdateResponseObject, error)
 
-type WorkerUpdate400JSONResponse APIErrors
+	CronWorkflowTriggerCreate(ctx echo.Context, request CronWorkflowTriggerCreateRequestObject) (CronWorkflowTriggerCreateResponseObject, error)
 
-func (response WorkerUpdate400JSONResponse) VisitWorkerUpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ScheduledWorkflowRunCreate(ctx echo.Context, request ScheduledWorkflowRunCreateRequestObject) (ScheduledWorkflowRunCreateResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WorkflowGetWorkersCount(ctx echo.Context, request WorkflowGetWorkersCountRequestObject) (WorkflowGetWorkersCountResponseObject, error)
 
-type WorkerUpdate403JSONResponse APIErrors
+	UserGetCurrent(ctx echo.Context, request UserGetCurrentRequestObject) (UserGetCurrentResponseObject, error)
 
-func (response WorkerUpdate403JSONResponse) VisitWorkerUpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	UserUpdateGithubOauthCallback(ctx echo.Context, request UserUpdateGithubOauthCallbackRequestObject) (UserUpdateGithubOauthCallbackResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	UserUpdateGithubOauthStart(ctx echo.Context, request UserUpdateGithubOauthStartRequestObject) (UserUpdateGithubOauthStartResponseObject, error)
 
-type WorkflowDeleteRequestObject struct {
-	Workflow openapi_types.UUID `json:"workflow"`
-}
+	UserUpdateGoogleOauthCallback(ctx echo.Context, request UserUpdateGoogleOauthCallbackRequestObject) (UserUpdateGoogleOauthCallbackResponseObject, error)
 
-type WorkflowDeleteResponseObject interface {
-	VisitWorkflowDeleteResponse(w http.ResponseWriter) error
-}
+	UserUpdateGoogleOauthStart(ctx echo.Context, request UserUpdateGoogleOauthStartRequestObject) (UserUpdateGoogleOauthStartResponseObject, error)
 
-type WorkflowDelete204Response struct {
-}
+	UserListTenantInvites(ctx echo.Context, request UserListTenantInvitesRequestObject) (UserListTenantInvitesResponseObject, error)
 
-func (response WorkflowDelete204Response) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	TenantInviteAccept(ctx echo.Context, request TenantInviteAcceptRequestObject) (TenantInviteAcceptResponseObject, error)
 
-type WorkflowDelete400JSONResponse APIErrors
+	TenantInviteReject(ctx echo.Context, request TenantInviteRejectRequestObject) (TenantInviteRejectResponseObject, error)
 
-func (response WorkflowDelete400JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	UserUpdateLogin(ctx echo.Context, request UserUpdateLoginRequestObject) (UserUpdateLoginResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	UserUpdateLogout(ctx echo.Context, request UserUpdateLogoutRequestObject) (UserUpdateLogoutResponseObject, error)
 
-type WorkflowDelete403JSONResponse APIErrors
+	TenantMembershipsList(ctx echo.Context, request TenantMembershipsListRequestObject) (TenantMembershipsListResponseObject, error)
 
-func (response WorkflowDelete403JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	UserUpdateOidcCallback(ctx echo.Context, request UserUpdateOidcCallbackRequestObject) (UserUpdateOidcCallbackResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	UserUpdateOidcStart(ctx echo.Context, request UserUpdateOidcStartRequestObject) (UserUpdateOidcStartResponseObject, error)
 
-type WorkflowDelete404JSONResponse APIErrors
+	UserUpdatePassword(ctx echo.Context, request UserUpdatePasswordRequestObject) (UserUpdatePasswordResponseObject, error)
 
-func (response WorkflowDelete404JSONResponse) VisitWorkflowDeleteResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	UserCreate(ctx echo.Context, request UserCreateRequestObject) (UserCreateResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	UserUpdateSlackOauthCallback(ctx echo.Context, request UserUpdateSlackOauthCallbackRequestObject) (UserUpdateSlackOauthCallbackResponseObject, error)
 
-type WorkflowGetRequestObject struct {
-	Workflow openapi_types.UUID `json:"workflow"`
-}
+	WebhookDelete(ctx echo.Context, request WebhookDeleteRequestObject) (WebhookDeleteResponseObject, error)
 
-type WorkflowGetResponseObject interface {
-	VisitWorkflowGetResponse(w http.ResponseWriter) error
-}
+	WebhookRequestsList(ctx echo.Context, request WebhookRequestsListRequestObject) (WebhookRequestsListResponseObject, error)
 
-type WorkflowGet200JSONResponse Workflow
+	WorkerGet(ctx echo.Context, request WorkerGetRequestObject) (WorkerGetResponseObject, error)
 
-func (response WorkflowGet200JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	WorkerUpdate(ctx echo.Context, request WorkerUpdateRequestObject) (WorkerUpdateResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WorkflowDelete(ctx echo.Context, request WorkflowDeleteRequestObject) (WorkflowDeleteResponseObject, error)
 
-type WorkflowGet400JSONResponse APIErrors
+	WorkflowGet(ctx echo.Context, request WorkflowGetRequestObject) (WorkflowGetResponseObject, error)
 
-func (response WorkflowGet400JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	WorkflowUpdate(ctx echo.Context, request WorkflowUpdateRequestObject) (WorkflowUpdateResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WorkflowListConcurrencyGroups(ctx echo.Context, request WorkflowListConcurrencyGroupsRequestObject) (WorkflowListConcurrencyGroupsResponseObject, error)
 
-type WorkflowGet403JSONResponse APIErrors
+	WorkflowGetMetrics(ctx echo.Context, request WorkflowGetMetricsRequestObject) (WorkflowGetMetricsResponseObject, error)
 
-func (response WorkflowGet403JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	WorkflowDeleteRollout(ctx echo.Context, request WorkflowDeleteRolloutRequestObject) (WorkflowDeleteRolloutResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	WorkflowGetRollout(ctx echo.Context, request WorkflowGetRolloutRequestObject) (WorkflowGetRolloutResponseObject, error)
 
-type WorkflowGet404JSONResponse APIErrors
+	WorkflowUpdateRollout(ctx echo.Context, request WorkflowUpdateRolloutRequestObject) (WorkflowUpdateRolloutResponseObject, error)
 
-func (response WorkflowGet404JSONResponse) VisitWorkflowGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	WorkflowRunCreate(ctx echo.Context, request WorkflowRunCreateRequestObject) (WorkflowRunCreateResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
+	WorkflowVersionGet(ctx echo.Context, request WorkflowVersionGetRequestObject) (WorkflowVersionGetResponseObject, error)
 }
+type StrictHandlerFunc func(ctx echo.Context, args interface{}) (interface{}, error)
+type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
 
-type WorkflowUpdateRequestObject struct {
-	Workflow openapi_types.UUID `json:"workflow"`
-	Body     *WorkflowUpdateJSONRequestBody
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
 }
 
-type WorkflowUpdateResponseObject interface {
-	VisitWorkflowUpdateResponse(w http.ResponseWriter) error
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
 }
 
-type WorkflowUpdate200JSONResponse Workflow
+// HealthzGet operation middleware
+func (sh *strictHandler) HealthzGet(ctx echo.Context) error {
+	var request HealthzGetRequestObject
 
-func (response WorkflowUpdate200JSONResponse) VisitWorkflowUpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.HealthzGet(ctx, request.(HealthzGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HealthzGet")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(HealthzGetResponseObject); ok {
+		return validResponse.VisitHealthzGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowUpdate400JSONResponse APIErrors
+// LivenessGet operation middleware
+func (sh *strictHandler) LivenessGet(ctx echo.Context) error {
+	var request LivenessGetRequestObject
 
-func (response WorkflowUpdate400JSONResponse) VisitWorkflowUpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.LivenessGet(ctx, request.(LivenessGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LivenessGet")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(LivenessGetResponseObject); ok {
+		return validResponse.VisitLivenessGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowUpdate403JSONResponse APIErrors
+// ReadinessGet operation middleware
+func (sh *strictHandler) ReadinessGet(ctx echo.Context) error {
+	var request ReadinessGetRequestObject
 
-func (response WorkflowUpdate403JSONResponse) VisitWorkflowUpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReadinessGet(ctx, request.(ReadinessGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReadinessGet")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type WorkflowGetMetricsRequestObject struct {
-	Workflow openapi_types.UUID `json:"workflow"`
-	Params   WorkflowGetMetricsParams
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ReadinessGetResponseObject); ok {
+		return validResponse.VisitReadinessGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowGetMetricsResponseObject interface {
-	VisitWorkflowGetMetricsResponse(w http.ResponseWriter) error
-}
+// ReadyzGet operation middleware
+func (sh *strictHandler) ReadyzGet(ctx echo.Context) error {
+	var request ReadyzGetRequestObject
 
-type WorkflowGetMetrics200JSONResponse WorkflowMetrics
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReadyzGet(ctx, request.(ReadyzGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReadyzGet")
+	}
 
-func (response WorkflowGetMetrics200JSONResponse) VisitWorkflowGetMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ReadyzGetResponseObject); ok {
+		return validResponse.VisitReadyzGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowGetMetrics400JSONResponse APIErrors
+// AlertEmailGroupDelete operation middleware
+func (sh *strictHandler) AlertEmailGroupDelete(ctx echo.Context, alertEmailGroup openapi_types.UUID) error {
+	var request AlertEmailGroupDeleteRequestObject
 
-func (response WorkflowGetMetrics400JSONResponse) VisitWorkflowGetMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.AlertEmailGroup = alertEmailGroup
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AlertEmailGroupDelete(ctx, request.(AlertEmailGroupDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AlertEmailGroupDelete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AlertEmailGroupDeleteResponseObject); ok {
+		return validResponse.VisitAlertEmailGroupDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowGetMetrics403JSONResponse APIErrors
+// AlertEmailGroupUpdate operation middleware
+func (sh *strictHandler) AlertEmailGroupUpdate(ctx echo.Context, alertEmailGroup openapi_types.UUID) error {
+	var request AlertEmailGroupUpdateRequestObject
 
-func (response WorkflowGetMetrics403JSONResponse) VisitWorkflowGetMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.AlertEmailGroup = alertEmailGroup
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body AlertEmailGroupUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type WorkflowGetMetrics404JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AlertEmailGroupUpdate(ctx, request.(AlertEmailGroupUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AlertEmailGroupUpdate")
+	}
 
-func (response WorkflowGetMetrics404JSONResponse) VisitWorkflowGetMetricsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AlertEmailGroupUpdateResponseObject); ok {
+		return validResponse.VisitAlertEmailGroupUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowRunCreateRequestObject struct {
-	Workflow openapi_types.UUID `json:"workflow"`
-	Params   WorkflowRunCreateParams
-	Body     *WorkflowRunCreateJSONRequestBody
-}
+// ApiTokenUpdateRevoke operation middleware
+func (sh *strictHandler) ApiTokenUpdateRevoke(ctx echo.Context, apiToken openapi_types.UUID) error {
+	var request ApiTokenUpdateRevokeRequestObject
 
-type WorkflowRunCreateResponseObject interface {
-	VisitWorkflowRunCreateResponse(w http.ResponseWriter) error
-}
+	request.ApiToken = apiToken
 
-type WorkflowRunCreate200JSONResponse WorkflowRun
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ApiTokenUpdateRevoke(ctx, request.(ApiTokenUpdateRevokeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApiTokenUpdateRevoke")
+	}
 
-func (response WorkflowRunCreate200JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ApiTokenUpdateRevokeResponseObject); ok {
+		return validResponse.VisitApiTokenUpdateRevokeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowRunCreate400JSONResponse APIErrors
+// ApiTokenUpdateRotate operation middleware
+func (sh *strictHandler) ApiTokenUpdateRotate(ctx echo.Context, apiToken openapi_types.UUID) error {
+	var request ApiTokenUpdateRotateRequestObject
 
-func (response WorkflowRunCreate400JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.ApiToken = apiToken
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body ApiTokenUpdateRotateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type WorkflowRunCreate403JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ApiTokenUpdateRotate(ctx, request.(ApiTokenUpdateRotateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApiTokenUpdateRotate")
+	}
 
-func (response WorkflowRunCreate403JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ApiTokenUpdateRotateResponseObject); ok {
+		return validResponse.VisitApiTokenUpdateRotateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowRunCreate404JSONResponse APIErrors
+// ApprovalUpdateDecideWithToken operation middleware
+func (sh *strictHandler) ApprovalUpdateDecideWithToken(ctx echo.Context, approvalToken string) error {
+	var request ApprovalUpdateDecideWithTokenRequestObject
 
-func (response WorkflowRunCreate404JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.ApprovalToken = approvalToken
 
-	return json.NewEncoder(w).Encode(response)
+	var body ApprovalUpdateDecideWithTokenJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ApprovalUpdateDecideWithToken(ctx, request.(ApprovalUpdateDecideWithTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApprovalUpdateDecideWithToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ApprovalUpdateDecideWithTokenResponseObject); ok {
+		return validResponse.VisitApprovalUpdateDecideWithTokenResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowRunCreate429JSONResponse APIErrors
+// ApprovalGet operation middleware
+func (sh *strictHandler) ApprovalGet(ctx echo.Context, approval openapi_types.UUID) error {
+	var request ApprovalGetRequestObject
 
-func (response WorkflowRunCreate429JSONResponse) VisitWorkflowRunCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
+	request.Approval = approval
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ApprovalGet(ctx, request.(ApprovalGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApprovalGet")
+	}
 
-type WorkflowVersionGetRequestObject struct {
-	Workflow openapi_types.UUID `json:"workflow"`
-	Params   WorkflowVersionGetParams
-}
+	response, err := handler(ctx, request)
 
-type WorkflowVersionGetResponseObject interface {
-	VisitWorkflowVersionGetResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ApprovalGetResponseObject); ok {
+		return validResponse.VisitApprovalGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowVersionGet200JSONResponse WorkflowVersion
+// ApprovalUpdateDecide operation middleware
+func (sh *strictHandler) ApprovalUpdateDecide(ctx echo.Context, approval openapi_types.UUID) error {
+	var request ApprovalUpdateDecideRequestObject
 
-func (response WorkflowVersionGet200JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Approval = approval
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body ApprovalUpdateDecideJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type WorkflowVersionGet400JSONResponse APIErrors
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ApprovalUpdateDecide(ctx, request.(ApprovalUpdateDecideRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApprovalUpdateDecide")
+	}
 
-func (response WorkflowVersionGet400JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ApprovalUpdateDecideResponseObject); ok {
+		return validResponse.VisitApprovalUpdateDecideResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowVersionGet403JSONResponse APIErrors
+// ClientCaDelete operation middleware
+func (sh *strictHandler) ClientCaDelete(ctx echo.Context, clientCa openapi_types.UUID) error {
+	var request ClientCaDeleteRequestObject
 
-func (response WorkflowVersionGet403JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.ClientCa = clientCa
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ClientCaDelete(ctx, request.(ClientCaDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ClientCaDelete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ClientCaDeleteResponseObject); ok {
+		return validResponse.VisitClientCaDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type WorkflowVersionGet404JSONResponse APIErrors
+// CloudMetadataGet operation middleware
+func (sh *strictHandler) CloudMetadataGet(ctx echo.Context) error {
+	var request CloudMetadataGetRequestObject
 
-func (response WorkflowVersionGet404JSONResponse) VisitWorkflowVersionGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CloudMetadataGet(ctx, request.(CloudMetadataGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CloudMetadataGet")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type StrictServerInterface interface {
-	LivenessGet(ctx echo.Context, request LivenessGetRequestObject) (LivenessGetResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CloudMetadataGetResponseObject); ok {
+		return validResponse.VisitCloudMetadataGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	ReadinessGet(ctx echo.Context, request ReadinessGetRequestObject) (ReadinessGetResponseObject, error)
+// EventDedupRuleDelete operation middleware
+func (sh *strictHandler) EventDedupRuleDelete(ctx echo.Context, eventDedupRule openapi_types.UUID) error {
+	var request EventDedupRuleDeleteRequestObject
 
-	AlertEmailGroupDelete(ctx echo.Context, request AlertEmailGroupDeleteRequestObject) (AlertEmailGroupDeleteResponseObject, error)
+	request.EventDedupRule = eventDedupRule
 
-	AlertEmailGroupUpdate(ctx echo.Context, request AlertEmailGroupUpdateRequestObject) (AlertEmailGroupUpdateResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventDedupRuleDelete(ctx, request.(EventDedupRuleDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventDedupRuleDelete")
+	}
 
-	ApiTokenUpdateRevoke(ctx echo.Context, request ApiTokenUpdateRevokeRequestObject) (ApiTokenUpdateRevokeResponseObject, error)
+	response, err := handler(ctx, request)
 
-	CloudMetadataGet(ctx echo.Context, request CloudMetadataGetRequestObject) (CloudMetadataGetResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventDedupRuleDeleteResponseObject); ok {
+		return validResponse.VisitEventDedupRuleDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	EventGet(ctx echo.Context, request EventGetRequestObject) (EventGetResponseObject, error)
+// EventRoutingRuleDelete operation middleware
+func (sh *strictHandler) EventRoutingRuleDelete(ctx echo.Context, eventRoutingRule openapi_types.UUID) error {
+	var request EventRoutingRuleDeleteRequestObject
 
-	EventDataGet(ctx echo.Context, request EventDataGetRequestObject) (EventDataGetResponseObject, error)
+	request.EventRoutingRule = eventRoutingRule
 
-	MetadataGet(ctx echo.Context, request MetadataGetRequestObject) (MetadataGetResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventRoutingRuleDelete(ctx, request.(EventRoutingRuleDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventRoutingRuleDelete")
+	}
 
-	MetadataListIntegrations(ctx echo.Context, request MetadataListIntegrationsRequestObject) (MetadataListIntegrationsResponseObject, error)
+	response, err := handler(ctx, request)
 
-	SlackWebhookDelete(ctx echo.Context, request SlackWebhookDeleteRequestObject) (SlackWebhookDeleteResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventRoutingRuleDeleteResponseObject); ok {
+		return validResponse.VisitEventRoutingRuleDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	SnsDelete(ctx echo.Context, request SnsDeleteRequestObject) (SnsDeleteResponseObject, error)
+// EventSinkDelete operation middleware
+func (sh *strictHandler) EventSinkDelete(ctx echo.Context, eventSink openapi_types.UUID) error {
+	var request EventSinkDeleteRequestObject
 
-	SnsUpdate(ctx echo.Context, request SnsUpdateRequestObject) (SnsUpdateResponseObject, error)
+	request.EventSink = eventSink
 
-	StepRunListArchives(ctx echo.Context, request StepRunListArchivesRequestObject) (StepRunListArchivesResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventSinkDelete(ctx, request.(EventSinkDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventSinkDelete")
+	}
 
-	StepRunListEvents(ctx echo.Context, request StepRunListEventsRequestObject) (StepRunListEventsResponseObject, error)
+	response, err := handler(ctx, request)
 
-	LogLineList(ctx echo.Context, request LogLineListRequestObject) (LogLineListResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventSinkDeleteResponseObject); ok {
+		return validResponse.VisitEventSinkDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	TenantCreate(ctx echo.Context, request TenantCreateRequestObject) (TenantCreateResponseObject, error)
+// EventSinkReplay operation middleware
+func (sh *strictHandler) EventSinkReplay(ctx echo.Context, eventSink openapi_types.UUID) error {
+	var request EventSinkReplayRequestObject
 
-	TenantUpdate(ctx echo.Context, request TenantUpdateRequestObject) (TenantUpdateResponseObject, error)
+	request.EventSink = eventSink
 
-	AlertEmailGroupList(ctx echo.Context, request AlertEmailGroupListRequestObject) (AlertEmailGroupListResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventSinkReplay(ctx, request.(EventSinkReplayRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventSinkReplay")
+	}
 
-	AlertEmailGroupCreate(ctx echo.Context, request AlertEmailGroupCreateRequestObject) (AlertEmailGroupCreateResponseObject, error)
+	response, err := handler(ctx, request)
 
-	TenantAlertingSettingsGet(ctx echo.Context, request TenantAlertingSettingsGetRequestObject) (TenantAlertingSettingsGetResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventSinkReplayResponseObject); ok {
+		return validResponse.VisitEventSinkReplayResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	ApiTokenList(ctx echo.Context, request ApiTokenListRequestObject) (ApiTokenListResponseObject, error)
+// EventGet operation middleware
+func (sh *strictHandler) EventGet(ctx echo.Context, event openapi_types.UUID) error {
+	var request EventGetRequestObject
 
-	ApiTokenCreate(ctx echo.Context, request ApiTokenCreateRequestObject) (ApiTokenCreateResponseObject, error)
+	request.Event = event
 
-	EventList(ctx echo.Context, request EventListRequestObject) (EventListResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventGet(ctx, request.(EventGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventGet")
+	}
 
-	EventCreate(ctx echo.Context, request EventCreateRequestObject) (EventCreateResponseObject, error)
+	response, err := handler(ctx, request)
 
-	EventCreateBulk(ctx echo.Context, request EventCreateBulkRequestObject) (EventCreateBulkResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventGetResponseObject); ok {
+		return validResponse.VisitEventGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	EventUpdateCancel(ctx echo.Context, request EventUpdateCancelRequestObject) (EventUpdateCancelResponseObject, error)
+// EventDataGet operation middleware
+func (sh *strictHandler) EventDataGet(ctx echo.Context, event openapi_types.UUID) error {
+	var request EventDataGetRequestObject
 
-	EventKeyList(ctx echo.Context, request EventKeyListRequestObject) (EventKeyListResponseObject, error)
+	request.Event = event
 
-	EventUpdateReplay(ctx echo.Context, request EventUpdateReplayRequestObject) (EventUpdateReplayResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventDataGet(ctx, request.(EventDataGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventDataGet")
+	}
 
-	TenantInviteList(ctx echo.Context, request TenantInviteListRequestObject) (TenantInviteListResponseObject, error)
+	response, err := handler(ctx, request)
 
-	TenantInviteCreate(ctx echo.Context, request TenantInviteCreateRequestObject) (TenantInviteCreateResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventDataGetResponseObject); ok {
+		return validResponse.VisitEventDataGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	TenantInviteDelete(ctx echo.Context, request TenantInviteDeleteRequestObject) (TenantInviteDeleteResponseObject, error)
+// InboundWebhookDelete operation middleware
+func (sh *strictHandler) InboundWebhookDelete(ctx echo.Context, inboundWebhook openapi_types.UUID) error {
+	var request InboundWebhookDeleteRequestObject
 
-	TenantInviteUpdate(ctx echo.Context, request TenantInviteUpdateRequestObject) (TenantInviteUpdateResponseObject, error)
+	request.InboundWebhook = inboundWebhook
 
-	TenantMemberList(ctx echo.Context, request TenantMemberListRequestObject) (TenantMemberListResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.InboundWebhookDelete(ctx, request.(InboundWebhookDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "InboundWebhookDelete")
+	}
 
-	TenantMemberDelete(ctx echo.Context, request TenantMemberDeleteRequestObject) (TenantMemberDeleteResponseObject, error)
+	response, err := handler(ctx, request)
 
-	TenantGetQueueMetrics(ctx echo.Context, request TenantGetQueueMetricsRequestObject) (TenantGetQueueMetricsResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(InboundWebhookDeleteResponseObject); ok {
+		return validResponse.VisitInboundWebhookDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	RateLimitList(ctx echo.Context, request RateLimitListRequestObject) (RateLimitListResponseObject, error)
+// InboundWebhookReceive operation middleware
+func (sh *strictHandler) InboundWebhookReceive(ctx echo.Context, inboundWebhook openapi_types.UUID) error {
+	var request InboundWebhookReceiveRequestObject
 
-	TenantResourcePolicyGet(ctx echo.Context, request TenantResourcePolicyGetRequestObject) (TenantResourcePolicyGetResponseObject, error)
+	request.InboundWebhook = inboundWebhook
 
-	SlackWebhookList(ctx echo.Context, request SlackWebhookListRequestObject) (SlackWebhookListResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.InboundWebhookReceive(ctx, request.(InboundWebhookReceiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "InboundWebhookReceive")
+	}
 
-	UserUpdateSlackOauthStart(ctx echo.Context, request UserUpdateSlackOauthStartRequestObject) (UserUpdateSlackOauthStartResponseObject, error)
+	response, err := handler(ctx, request)
 
-	SnsList(ctx echo.Context, request SnsListRequestObject) (SnsListResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(InboundWebhookReceiveResponseObject); ok {
+		return validResponse.VisitInboundWebhookReceiveResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	SnsCreate(ctx echo.Context, request SnsCreateRequestObject) (SnsCreateResponseObject, error)
+// MetadataGet operation middleware
+func (sh *strictHandler) MetadataGet(ctx echo.Context) error {
+	var request MetadataGetRequestObject
 
-	TenantGetStepRunQueueMetrics(ctx echo.Context, request TenantGetStepRunQueueMetricsRequestObject) (TenantGetStepRunQueueMetricsResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MetadataGet(ctx, request.(MetadataGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MetadataGet")
+	}
 
-	StepRunGet(ctx echo.Context, request StepRunGetRequestObject) (StepRunGetResponseObject, error)
+	response, err := handler(ctx, request)
 
-	StepRunUpdateCancel(ctx echo.Context, request StepRunUpdateCancelRequestObject) (StepRunUpdateCancelResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(MetadataGetResponseObject); ok {
+		return validResponse.VisitMetadataGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	StepRunUpdateRerun(ctx echo.Context, request StepRunUpdateRerunRequestObject) (StepRunUpdateRerunResponseObject, error)
+// MetadataListIntegrations operation middleware
+func (sh *strictHandler) MetadataListIntegrations(ctx echo.Context) error {
+	var request MetadataListIntegrationsRequestObject
 
-	StepRunGetSchema(ctx echo.Context, request StepRunGetSchemaRequestObject) (StepRunGetSchemaResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MetadataListIntegrations(ctx, request.(MetadataListIntegrationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MetadataListIntegrations")
+	}
 
-	WebhookList(ctx echo.Context, request WebhookListRequestObject) (WebhookListResponseObject, error)
+	response, err := handler(ctx, request)
 
-	WebhookCreate(ctx echo.Context, request WebhookCreateRequestObject) (WebhookCreateResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(MetadataListIntegrationsResponseObject); ok {
+		return validResponse.VisitMetadataListIntegrationsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	WorkerList(ctx echo.Context, request WorkerListRequestObject) (WorkerListResponseObject, error)
+// SlackWebhookDelete operation middleware
+func (sh *strictHandler) SlackWebhookDelete(ctx echo.Context, slack openapi_types.UUID) error {
+	var request SlackWebhookDeleteRequestObject
 
-	WorkflowRunUpdateReplay(ctx echo.Context, request WorkflowRunUpdateReplayRequestObject) (WorkflowRunUpdateReplayResponseObject, error)
+	request.Slack = slack
 
-	WorkflowRunGet(ctx echo.Context, request WorkflowRunGetRequestObject) (WorkflowRunGetResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SlackWebhookDelete(ctx, request.(SlackWebhookDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SlackWebhookDelete")
+	}
 
-	WorkflowRunGetInput(ctx echo.Context, request WorkflowRunGetInputRequestObject) (WorkflowRunGetInputResponseObject, error)
+	response, err := handler(ctx, request)
 
-	WorkflowRunGetShape(ctx echo.Context, request WorkflowRunGetShapeRequestObject) (WorkflowRunGetShapeResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SlackWebhookDeleteResponseObject); ok {
+		return validResponse.VisitSlackWebhookDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	WorkflowRunListStepRunEvents(ctx echo.Context, request WorkflowRunListStepRunEventsRequestObject) (WorkflowRunListStepRunEventsResponseObject, error)
+// SnsDelete operation middleware
+func (sh *strictHandler) SnsDelete(ctx echo.Context, sns openapi_types.UUID) error {
+	var request SnsDeleteRequestObject
 
-	WorkflowList(ctx echo.Context, request WorkflowListRequestObject) (WorkflowListResponseObject, error)
+	request.Sns = sns
 
-	WorkflowRunCancel(ctx echo.Context, request WorkflowRunCancelRequestObject) (WorkflowRunCancelResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SnsDelete(ctx, request.(SnsDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SnsDelete")
+	}
 
-	CronWorkflowList(ctx echo.Context, request CronWorkflowListRequestObject) (CronWorkflowListResponseObject, error)
+	response, err := handler(ctx, request)
 
-	WorkflowCronDelete(ctx echo.Context, request WorkflowCronDeleteRequestObject) (WorkflowCronDeleteResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SnsDeleteResponseObject); ok {
+		return validResponse.VisitSnsDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	WorkflowCronGet(ctx echo.Context, request WorkflowCronGetRequestObject) (WorkflowCronGetResponseObject, error)
+// SnsUpdate operation middleware
+func (sh *strictHandler) SnsUpdate(ctx echo.Context, tenant openapi_types.UUID, event string) error {
+	var request SnsUpdateRequestObject
 
-	WorkflowRunList(ctx echo.Context, request WorkflowRunListRequestObject) (WorkflowRunListResponseObject, error)
+	request.Tenant = tenant
+	request.Event = event
 
-	WorkflowRunGetMetrics(ctx echo.Context, request WorkflowRunGetMetricsRequestObject) (WorkflowRunGetMetricsResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SnsUpdate(ctx, request.(SnsUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SnsUpdate")
+	}
 
-	WorkflowScheduledList(ctx echo.Context, request WorkflowScheduledListRequestObject) (WorkflowScheduledListResponseObject, error)
+	response, err := handler(ctx, request)
 
-	WorkflowScheduledDelete(ctx echo.Context, request WorkflowScheduledDeleteRequestObject) (WorkflowScheduledDeleteResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SnsUpdateResponseObject); ok {
+		return validResponse.VisitSnsUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	WorkflowScheduledGet(ctx echo.Context, request WorkflowScheduledGetRequestObject) (WorkflowScheduledGetResponseObject, error)
+// SqsDelete operation middleware
+func (sh *strictHandler) SqsDelete(ctx echo.Context, sqs openapi_types.UUID) error {
+	var request SqsDeleteRequestObject
 
-	CronWorkflowTriggerCreate(ctx echo.Context, request CronWorkflowTriggerCreateRequestObject) (CronWorkflowTriggerCreateResponseObject, error)
+	request.Sqs = sqs
 
-	ScheduledWorkflowRunCreate(ctx echo.Context, request ScheduledWorkflowRunCreateRequestObject) (ScheduledWorkflowRunCreateResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SqsDelete(ctx, request.(SqsDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SqsDelete")
+	}
 
-	WorkflowGetWorkersCount(ctx echo.Context, request WorkflowGetWorkersCountRequestObject) (WorkflowGetWorkersCountResponseObject, error)
+	response, err := handler(ctx, request)
 
-	UserGetCurrent(ctx echo.Context, request UserGetCurrentRequestObject) (UserGetCurrentResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SqsDeleteResponseObject); ok {
+		return validResponse.VisitSqsDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	UserUpdateGithubOauthCallback(ctx echo.Context, request UserUpdateGithubOauthCallbackRequestObject) (UserUpdateGithubOauthCallbackResponseObject, error)
+// StepRunListArchives operation middleware
+func (sh *strictHandler) StepRunListArchives(ctx echo.Context, stepRun openapi_types.UUID, params StepRunListArchivesParams) error {
+	var request StepRunListArchivesRequestObject
 
-	UserUpdateGithubOauthStart(ctx echo.Context, request UserUpdateGithubOauthStartRequestObject) (UserUpdateGithubOauthStartResponseObject, error)
+	request.StepRun = stepRun
+	request.Params = params
 
-	UserUpdateGoogleOauthCallback(ctx echo.Context, request UserUpdateGoogleOauthCallbackRequestObject) (UserUpdateGoogleOauthCallbackResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StepRunListArchives(ctx, request.(StepRunListArchivesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StepRunListArchives")
+	}
 
-	UserUpdateGoogleOauthStart(ctx echo.Context, request UserUpdateGoogleOauthStartRequestObject) (UserUpdateGoogleOauthStartResponseObject, error)
+	response, err := handler(ctx, request)
 
-	UserListTenantInvites(ctx echo.Context, request UserListTenantInvitesRequestObject) (UserListTenantInvitesResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunListArchivesResponseObject); ok {
+		return validResponse.VisitStepRunListArchivesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	TenantInviteAccept(ctx echo.Context, request TenantInviteAcceptRequestObject) (TenantInviteAcceptResponseObject, error)
+// StepRunListEvents operation middleware
+func (sh *strictHandler) StepRunListEvents(ctx echo.Context, stepRun openapi_types.UUID, params StepRunListEventsParams) error {
+	var request StepRunListEventsRequestObject
 
-	TenantInviteReject(ctx echo.Context, request TenantInviteRejectRequestObject) (TenantInviteRejectResponseObject, error)
+	request.StepRun = stepRun
+	request.Params = params
 
-	UserUpdateLogin(ctx echo.Context, request UserUpdateLoginRequestObject) (UserUpdateLoginResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StepRunListEvents(ctx, request.(StepRunListEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StepRunListEvents")
+	}
 
-	UserUpdateLogout(ctx echo.Context, request UserUpdateLogoutRequestObject) (UserUpdateLogoutResponseObject, error)
+	response, err := handler(ctx, request)
 
-	TenantMembershipsList(ctx echo.Context, request TenantMembershipsListRequestObject) (TenantMembershipsListResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunListEventsResponseObject); ok {
+		return validResponse.VisitStepRunListEventsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	UserUpdatePassword(ctx echo.Context, request UserUpdatePasswordRequestObject) (UserUpdatePasswordResponseObject, error)
+// LogLineList operation middleware
+func (sh *strictHandler) LogLineList(ctx echo.Context, stepRun openapi_types.UUID, params LogLineListParams) error {
+	var request LogLineListRequestObject
 
-	UserCreate(ctx echo.Context, request UserCreateRequestObject) (UserCreateResponseObject, error)
+	request.StepRun = stepRun
+	request.Params = params
 
-	UserUpdateSlackOauthCallback(ctx echo.Context, request UserUpdateSlackOauthCallbackRequestObject) (UserUpdateSlackOauthCallbackResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.LogLineList(ctx, request.(LogLineListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LogLineList")
+	}
 
-	WebhookDelete(ctx echo.Context, request WebhookDeleteRequestObject) (WebhookDeleteResponseObject, error)
+	response, err := handler(ctx, request)
 
-	WebhookRequestsList(ctx echo.Context, request WebhookRequestsListRequestObject) (WebhookRequestsListResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(LogLineListResponseObject); ok {
+		return validResponse.VisitLogLineListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	WorkerGet(ctx echo.Context, request WorkerGetRequestObject) (WorkerGetResponseObject, error)
+// LogLineStream operation middleware
+func (sh *strictHandler) LogLineStream(ctx echo.Context, stepRun openapi_types.UUID, params LogLineStreamParams) error {
+	var request LogLineStreamRequestObject
 
-	WorkerUpdate(ctx echo.Context, request WorkerUpdateRequestObject) (WorkerUpdateResponseObject, error)
+	request.StepRun = stepRun
+	request.Params = params
 
-	WorkflowDelete(ctx echo.Context, request WorkflowDeleteRequestObject) (WorkflowDeleteResponseObject, error)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.LogLineStream(ctx, request.(LogLineStreamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LogLineStream")
+	}
 
-	WorkflowGet(ctx echo.Context, request WorkflowGetRequestObject) (WorkflowGetResponseObject, error)
+	response, err := handler(ctx, request)
 
-	WorkflowUpdate(ctx echo.Context, request WorkflowUpdateRequestObject) (WorkflowUpdateResponseObject, error)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(LogLineStreamResponseObject); ok {
+		return validResponse.VisitLogLineStreamResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	WorkflowGetMetrics(ctx echo.Context, request WorkflowGetMetricsRequestObject) (WorkflowGetMetricsResponseObject, error)
+// StepRunListSchedulingDecisions operation middleware
+func (sh *strictHandler) StepRunListSchedulingDecisions(ctx echo.Context, stepRun openapi_types.UUID, params StepRunListSchedulingDecisionsParams) error {
+	var request StepRunListSchedulingDecisionsRequestObject
 
-	WorkflowRunCreate(ctx echo.Context, request WorkflowRunCreateRequestObject) (WorkflowRunCreateResponseObject, error)
+	request.StepRun = stepRun
+	request.Params = params
 
-	WorkflowVersionGet(ctx echo.Context, request WorkflowVersionGetRequestObject) (WorkflowVersionGetResponseObject, error)
-}
-type StrictHandlerFunc func(ctx echo.Context, args interface{}) (interface{}, error)
-type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StepRunListSchedulingDecisions(ctx, request.(StepRunListSchedulingDecisionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StepRunListSchedulingDecisions")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	response, err := handler(ctx, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(StepRunListSchedulingDecisionsResponseObject); ok {
+		return validResponse.VisitStepRunListSchedulingDecisionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// LivenessGet operation middleware
-func (sh *strictHandler) LivenessGet(ctx echo.Context) error {
-	var request LivenessGetRequestObject
+// TenantCreate operation middleware
+func (sh *strictHandler) TenantCreate(ctx echo.Context) error {
+	var request TenantCreateRequestObject
+
+	var body TenantCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.LivenessGet(ctx, request.(LivenessGetRequestObject))
+		return sh.ssi.TenantCreate(ctx, request.(TenantCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "LivenessGet")
+		handler = middleware(handler, "TenantCreate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(LivenessGetResponseObject); ok {
-		return validResponse.VisitLivenessGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(TenantCreateResponseObject); ok {
+		return validResponse.VisitTenantCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ReadinessGet operation middleware
-func (sh *strictHandler) ReadinessGet(ctx echo.Context) error {
-	var request ReadinessGetRequestObject
+// TenantUpdate operation middleware
+func (sh *strictHandler) TenantUpdate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantUpdateRequestObject
+
+	request.Tenant = tenant
+
+	var body TenantUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ReadinessGet(ctx, request.(ReadinessGetRequestObject))
+		return sh.ssi.TenantUpdate(ctx, request.(TenantUpdateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ReadinessGet")
+		handler = middleware(handler, "TenantUpdate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ReadinessGetResponseObject); ok {
-		return validResponse.VisitReadinessGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(TenantUpdateResponseObject); ok {
+		return validResponse.VisitTenantUpdateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// AlertEmailGroupDelete operation middleware
-func (sh *strictHandler) AlertEmailGroupDelete(ctx echo.Context, alertEmailGroup openapi_types.UUID) error {
-	var request AlertEmailGroupDeleteRequestObject
+// AlertEmailGroupList operation middleware
+func (sh *strictHandler) AlertEmailGroupList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request AlertEmailGroupListRequestObject
 
-	request.AlertEmailGroup = alertEmailGroup
+	request.Tenant = tenant
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AlertEmailGroupDelete(ctx, request.(AlertEmailGroupDeleteRequestObject))
+		return sh.ssi.AlertEmailGroupList(ctx, request.(AlertEmailGroupListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AlertEmailGroupDelete")
+		handler = middleware(handler, "AlertEmailGroupList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(AlertEmailGroupDeleteResponseObject); ok {
-		return validResponse.VisitAlertEmailGroupDeleteResponse(ctx.Response())
+	} else if validResponse, ok := response.(AlertEmailGroupListResponseObject); ok {
+		return validResponse.VisitAlertEmailGroupListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// AlertEmailGroupUpdate operation middleware
-func (sh *strictHandler) AlertEmailGroupUpdate(ctx echo.Context, alertEmailGroup openapi_types.UUID) error {
-	var request AlertEmailGroupUpdateRequestObject
+// AlertEmailGroupCreate operation middleware
+func (sh *strictHandler) AlertEmailGroupCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request AlertEmailGroupCreateRequestObject
 
-	request.AlertEmailGroup = alertEmailGroup
+	request.Tenant = tenant
 
-	var body AlertEmailGroupUpdateJSONRequestBody
+	var body AlertEmailGroupCreateJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AlertEmailGroupUpdate(ctx, request.(AlertEmailGroupUpdateRequestObject))
+		return sh.ssi.AlertEmailGroupCreate(ctx, request.(AlertEmailGroupCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AlertEmailGroupUpdate")
+		handler = middleware(handler, "AlertEmailGroupCreate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(AlertEmailGroupUpdateResponseObject); ok {
-		return validResponse.VisitAlertEmailGroupUpdateResponse(ctx.Response())
+	} else if validResponse, ok := response.(AlertEmailGroupCreateResponseObject); ok {
+		return validResponse.VisitAlertEmailGroupCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ApiTokenUpdateRevoke operation middleware
-func (sh *strictHandler) ApiTokenUpdateRevoke(ctx echo.Context, apiToken openapi_types.UUID) error {
-	var request ApiTokenUpdateRevokeRequestObject
+// TenantAlertingSettingsGet operation middleware
+func (sh *strictHandler) TenantAlertingSettingsGet(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantAlertingSettingsGetRequestObject
 
-	request.ApiToken = apiToken
+	request.Tenant = tenant
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ApiTokenUpdateRevoke(ctx, request.(ApiTokenUpdateRevokeRequestObject))
+		return sh.ssi.TenantAlertingSettingsGet(ctx, request.(TenantAlertingSettingsGetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ApiTokenUpdateRevoke")
+		handler = middleware(handler, "TenantAlertingSettingsGet")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ApiTokenUpdateRevokeResponseObject); ok {
-		return validResponse.VisitApiTokenUpdateRevokeResponse(ctx.Response())
+	} else if validResponse, ok := response.(TenantAlertingSettingsGetResponseObject); ok {
+		return validResponse.VisitTenantAlertingSettingsGetResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// CloudMetadataGet operation middleware
-func (sh *strictHandler) CloudMetadataGet(ctx echo.Context) error {
-	var request CloudMetadataGetRequestObject
+// ApiTokenList operation middleware
+func (sh *strictHandler) ApiTokenList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request ApiTokenListRequestObject
+
+	request.Tenant = tenant
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CloudMetadataGet(ctx, request.(CloudMetadataGetRequestObject))
+		return sh.ssi.ApiTokenList(ctx, request.(ApiTokenListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CloudMetadataGet")
+		handler = middleware(handler, "ApiTokenList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(CloudMetadataGetResponseObject); ok {
-		return validResponse.VisitCloudMetadataGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(ApiTokenListResponseObject); ok {
+		return validResponse.VisitApiTokenListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// EventGet operation middleware
-func (sh *strictHandler) EventGet(ctx echo.Context, event openapi_types.UUID) error {
-	var request EventGetRequestObject
+// ApiTokenCreate operation middleware
+func (sh *strictHandler) ApiTokenCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request ApiTokenCreateRequestObject
 
-	request.Event = event
+	request.Tenant = tenant
+
+	var body ApiTokenCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.EventGet(ctx, request.(EventGetRequestObject))
+		return sh.ssi.ApiTokenCreate(ctx, request.(ApiTokenCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "EventGet")
+		handler = middleware(handler, "ApiTokenCreate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(EventGetResponseObject); ok {
-		return validResponse.VisitEventGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(ApiTokenCreateResponseObject); ok {
+		return validResponse.VisitApiTokenCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// EventDataGet operation middleware
-func (sh *strictHandler) EventDataGet(ctx echo.Context, event openapi_types.UUID) error {
-	var request EventDataGetRequestObject
+// ApprovalList operation middleware
+func (sh *strictHandler) ApprovalList(ctx echo.Context, tenant openapi_types.UUID, params ApprovalListParams) error {
+	var request ApprovalListRequestObject
 
-	request.Event = event
+	request.Tenant = tenant
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.EventDataGet(ctx, request.(EventDataGetRequestObject))
+		return sh.ssi.ApprovalList(ctx, request.(ApprovalListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "EventDataGet")
+		handler = middleware(handler, "ApprovalList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(EventDataGetResponseObject); ok {
-		return validResponse.VisitEventDataGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(ApprovalListResponseObject); ok {
+		return validResponse.VisitApprovalListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// MetadataGet operation middleware
-func (sh *strictHandler) MetadataGet(ctx echo.Context) error {
-	var request MetadataGetRequestObject
+// AuditLogList operation middleware
+func (sh *strictHandler) AuditLogList(ctx echo.Context, tenant openapi_types.UUID, params AuditLogListParams) error {
+	var request AuditLogListRequestObject
+
+	request.Tenant = tenant
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.MetadataGet(ctx, request.(MetadataGetRequestObject))
+		return sh.ssi.AuditLogList(ctx, request.(AuditLogListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "MetadataGet")
+		handler = middleware(handler, "AuditLogList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(MetadataGetResponseObject); ok {
-		return validResponse.VisitMetadataGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(AuditLogListResponseObject); ok {
+		return validResponse.VisitAuditLogListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// MetadataListIntegrations operation middleware
-func (sh *strictHandler) MetadataListIntegrations(ctx echo.Context) error {
-	var request MetadataListIntegrationsRequestObject
+// ClientCaList operation middleware
+func (sh *strictHandler) ClientCaList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request ClientCaListRequestObject
+
+	request.Tenant = tenant
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.MetadataListIntegrations(ctx, request.(MetadataListIntegrationsRequestObject))
+		return sh.ssi.ClientCaList(ctx, request.(ClientCaListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "MetadataListIntegrations")
+		handler = middleware(handler, "ClientCaList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(MetadataListIntegrationsResponseObject); ok {
-		return validResponse.VisitMetadataListIntegrationsResponse(ctx.Response())
+	} else if validResponse, ok := response.(ClientCaListResponseObject); ok {
+		return validResponse.VisitClientCaListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// SlackWebhookDelete operation middleware
-func (sh *strictHandler) SlackWebhookDelete(ctx echo.Context, slack openapi_types.UUID) error {
-	var request SlackWebhookDeleteRequestObject
+// ClientCaCreate operation middleware
+func (sh *strictHandler) ClientCaCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request ClientCaCreateRequestObject
 
-	request.Slack = slack
+	request.Tenant = tenant
+
+	var body ClientCaCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SlackWebhookDelete(ctx, request.(SlackWebhookDeleteRequestObject))
+		return sh.ssi.ClientCaCreate(ctx, request.(ClientCaCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SlackWebhookDelete")
+		handler = middleware(handler, "ClientCaCreate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SlackWebhookDeleteResponseObject); ok {
-		return validResponse.VisitSlackWebhookDeleteResponse(ctx.Response())
+	} else if validResponse, ok := response.(ClientCaCreateResponseObject); ok {
+		return validResponse.VisitClientCaCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// SnsDelete operation middleware
-func (sh *strictHandler) SnsDelete(ctx echo.Context, sns openapi_types.UUID) error {
-	var request SnsDeleteRequestObject
+// CronCalendarList operation middleware
+func (sh *strictHandler) CronCalendarList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request CronCalendarListRequestObject
 
-	request.Sns = sns
+	request.Tenant = tenant
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SnsDelete(ctx, request.(SnsDeleteRequestObject))
+		return sh.ssi.CronCalendarList(ctx, request.(CronCalendarListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SnsDelete")
+		handler = middleware(handler, "CronCalendarList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SnsDeleteResponseObject); ok {
-		return validResponse.VisitSnsDeleteResponse(ctx.Response())
+	} else if validResponse, ok := response.(CronCalendarListResponseObject); ok {
+		return validResponse.VisitCronCalendarListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// SnsUpdate operation middleware
-func (sh *strictHandler) SnsUpdate(ctx echo.Context, tenant openapi_types.UUID, event string) error {
-	var request SnsUpdateRequestObject
+// CronCalendarCreate operation middleware
+func (sh *strictHandler) CronCalendarCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request CronCalendarCreateRequestObject
 
 	request.Tenant = tenant
-	request.Event = event
+
+	var body CronCalendarCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SnsUpdate(ctx, request.(SnsUpdateRequestObject))
+		return sh.ssi.CronCalendarCreate(ctx, request.(CronCalendarCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SnsUpdate")
+		handler = middleware(handler, "CronCalendarCreate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SnsUpdateResponseObject); ok {
-		return validResponse.VisitSnsUpdateResponse(ctx.Response())
+	} else if validResponse, ok := response.(CronCalendarCreateResponseObject); ok {
+		return validResponse.VisitCronCalendarCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// StepRunListArchives operation middleware
-func (sh *strictHandler) StepRunListArchives(ctx echo.Context, stepRun openapi_types.UUID, params StepRunListArchivesParams) error {
-	var request StepRunListArchivesRequestObject
+// CronCalendarDelete operation middleware
+func (sh *strictHandler) CronCalendarDelete(ctx echo.Context, tenant openapi_types.UUID, cronCalendar openapi_types.UUID) error {
+	var request CronCalendarDeleteRequestObject
 
-	request.StepRun = stepRun
-	request.Params = params
+	request.Tenant = tenant
+	request.CronCalendar = cronCalendar
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.StepRunListArchives(ctx, request.(StepRunListArchivesRequestObject))
+		return sh.ssi.CronCalendarDelete(ctx, request.(CronCalendarDeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StepRunListArchives")
+		handler = middleware(handler, "CronCalendarDelete")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(StepRunListArchivesResponseObject); ok {
-		return validResponse.VisitStepRunListArchivesResponse(ctx.Response())
+	} else if validResponse, ok := response.(CronCalendarDeleteResponseObject); ok {
+		return validResponse.VisitCronCalendarDeleteResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// StepRunListEvents operation middleware
-func (sh *strictHandler) StepRunListEvents(ctx echo.Context, stepRun openapi_types.UUID, params StepRunListEventsParams) error {
-	var request StepRunListEventsRequestObject
+// DeadLetterQueueList operation middleware
+func (sh *strictHandler) DeadLetterQueueList(ctx echo.Context, tenant openapi_types.UUID, params DeadLetterQueueListParams) error {
+	var request DeadLetterQueueListRequestObject
 
-	request.StepRun = stepRun
+	request.Tenant = tenant
 	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.StepRunListEvents(ctx, request.(StepRunListEventsRequestObject))
+		return sh.ssi.DeadLetterQueueList(ctx, request.(DeadLetterQueueListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "StepRunListEvents")
+		handler = middleware(handler, "DeadLetterQueueList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(StepRunListEventsResponseObject); ok {
-		return validResponse.VisitStepRunListEventsResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeadLetterQueueListResponseObject); ok {
+		return validResponse.VisitDeadLetterQueueListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// LogLineList operation middleware
-func (sh *strictHandler) LogLineList(ctx echo.Context, stepRun openapi_types.UUID, params LogLineListParams) error {
-	var request LogLineListRequestObject
+// DeadLetterQueueDelete operation middleware
+func (sh *strictHandler) DeadLetterQueueDelete(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request DeadLetterQueueDeleteRequestObject
 
-	request.StepRun = stepRun
-	request.Params = params
+	request.Tenant = tenant
+
+	var body DeadLetterQueueDeleteJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.LogLineList(ctx, request.(LogLineListRequestObject))
+		return sh.ssi.DeadLetterQueueDelete(ctx, request.(DeadLetterQueueDeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "LogLineList")
+		handler = middleware(handler, "DeadLetterQueueDelete")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(LogLineListResponseObject); ok {
-		return validResponse.VisitLogLineListResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeadLetterQueueDeleteResponseObject); ok {
+		return validResponse.VisitDeadLetterQueueDeleteResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantCreate operation middleware
-func (sh *strictHandler) TenantCreate(ctx echo.Context) error {
-	var request TenantCreateRequestObject
+// DeadLetterQueueUpdateReplay operation middleware
+func (sh *strictHandler) DeadLetterQueueUpdateReplay(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request DeadLetterQueueUpdateReplayRequestObject
 
-	var body TenantCreateJSONRequestBody
+	request.Tenant = tenant
+
+	var body DeadLetterQueueUpdateReplayJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantCreate(ctx, request.(TenantCreateRequestObject))
+		return sh.ssi.DeadLetterQueueUpdateReplay(ctx, request.(DeadLetterQueueUpdateReplayRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantCreate")
+		handler = middleware(handler, "DeadLetterQueueUpdateReplay")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantCreateResponseObject); ok {
-		return validResponse.VisitTenantCreateResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeadLetterQueueUpdateReplayResponseObject); ok {
+		return validResponse.VisitDeadLetterQueueUpdateReplayResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantUpdate operation middleware
-func (sh *strictHandler) TenantUpdate(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request TenantUpdateRequestObject
+// EventDedupRuleList operation middleware
+func (sh *strictHandler) EventDedupRuleList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request EventDedupRuleListRequestObject
 
 	request.Tenant = tenant
 
-	var body TenantUpdateJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
-
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantUpdate(ctx, request.(TenantUpdateRequestObject))
+		return sh.ssi.EventDedupRuleList(ctx, request.(EventDedupRuleListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantUpdate")
+		handler = middleware(handler, "EventDedupRuleList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantUpdateResponseObject); ok {
-		return validResponse.VisitTenantUpdateResponse(ctx.Response())
+	} else if validResponse, ok := response.(EventDedupRuleListResponseObject); ok {
+		return validResponse.VisitEventDedupRuleListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// AlertEmailGroupList operation middleware
-func (sh *strictHandler) AlertEmailGroupList(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request AlertEmailGroupListRequestObject
+// EventDedupRuleUpsert operation middleware
+func (sh *strictHandler) EventDedupRuleUpsert(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request EventDedupRuleUpsertRequestObject
 
 	request.Tenant = tenant
 
+	var body EventDedupRuleUpsertJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AlertEmailGroupList(ctx, request.(AlertEmailGroupListRequestObject))
+		return sh.ssi.EventDedupRuleUpsert(ctx, request.(EventDedupRuleUpsertRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AlertEmailGroupList")
+		handler = middleware(handler, "EventDedupRuleUpsert")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(AlertEmailGroupListResponseObject); ok {
-		return validResponse.VisitAlertEmailGroupListResponse(ctx.Response())
+	} else if validResponse, ok := response.(EventDedupRuleUpsertResponseObject); ok {
+		return validResponse.VisitEventDedupRuleUpsertResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// AlertEmailGroupCreate operation middleware
-func (sh *strictHandler) AlertEmailGroupCreate(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request AlertEmailGroupCreateRequestObject
+// EventRoutingRuleList operation middleware
+func (sh *strictHandler) EventRoutingRuleList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request EventRoutingRuleListRequestObject
 
 	request.Tenant = tenant
 
-	var body AlertEmailGroupCreateJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
-
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AlertEmailGroupCreate(ctx, request.(AlertEmailGroupCreateRequestObject))
+		return sh.ssi.EventRoutingRuleList(ctx, request.(EventRoutingRuleListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AlertEmailGroupCreate")
+		handler = middleware(handler, "EventRoutingRuleList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(AlertEmailGroupCreateResponseObject); ok {
-		return validResponse.VisitAlertEmailGroupCreateResponse(ctx.Response())
+	} else if validResponse, ok := response.(EventRoutingRuleListResponseObject); ok {
+		return validResponse.VisitEventRoutingRuleListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TenantAlertingSettingsGet operation middleware
-func (sh *strictHandler) TenantAlertingSettingsGet(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request TenantAlertingSettingsGetRequestObject
+// EventRoutingRuleUpsert operation middleware
+func (sh *strictHandler) EventRoutingRuleUpsert(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request EventRoutingRuleUpsertRequestObject
 
 	request.Tenant = tenant
 
+	var body EventRoutingRuleUpsertJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TenantAlertingSettingsGet(ctx, request.(TenantAlertingSettingsGetRequestObject))
+		return sh.ssi.EventRoutingRuleUpsert(ctx, request.(EventRoutingRuleUpsertRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TenantAlertingSettingsGet")
+		handler = middleware(handler, "EventRoutingRuleUpsert")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TenantAlertingSettingsGetResponseObject); ok {
-		return validResponse.VisitTenantAlertingSettingsGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(EventRoutingRuleUpsertResponseObject); ok {
+		return validResponse.VisitEventRoutingRuleUpsertResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ApiTokenList operation middleware
-func (sh *strictHandler) ApiTokenList(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request ApiTokenListRequestObject
+// EventSinkList operation middleware
+func (sh *strictHandler) EventSinkList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request EventSinkListRequestObject
 
 	request.Tenant = tenant
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ApiTokenList(ctx, request.(ApiTokenListRequestObject))
+		return sh.ssi.EventSinkList(ctx, request.(EventSinkListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ApiTokenList")
+		handler = middleware(handler, "EventSinkList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ApiTokenListResponseObject); ok {
-		return validResponse.VisitApiTokenListResponse(ctx.Response())
+	} else if validResponse, ok := response.(EventSinkListResponseObject); ok {
+		return validResponse.VisitEventSinkListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ApiTokenCreate operation middleware
-func (sh *strictHandler) ApiTokenCreate(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request ApiTokenCreateRequestObject
+// EventSinkCreate operation middleware
+func (sh *strictHandler) EventSinkCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request EventSinkCreateRequestObject
 
 	request.Tenant = tenant
 
-	var body ApiTokenCreateJSONRequestBody
+	var body EventSinkCreateJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ApiTokenCreate(ctx, request.(ApiTokenCreateRequestObject))
+		return sh.ssi.EventSinkCreate(ctx, request.(EventSinkCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ApiTokenCreate")
+		handler = middleware(handler, "EventSinkCreate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ApiTokenCreateResponseObject); ok {
-		return validResponse.VisitApiTokenCreateResponse(ctx.Response())
+	} else if validResponse, ok := response.(EventSinkCreateResponseObject); ok {
+		return validResponse.VisitEventSinkCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
@@ -8840,6 +14510,37 @@ func (sh *strictHandler) EventCreate(ctx echo.Context, tenant openapi_types.UUID
 	return nil
 }
 
+// EventCreateBatch operation middleware
+func (sh *strictHandler) EventCreateBatch(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request EventCreateBatchRequestObject
+
+	request.Tenant = tenant
+
+	var body EventCreateBatchJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventCreateBatch(ctx, request.(EventCreateBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventCreateBatch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventCreateBatchResponseObject); ok {
+		return validResponse.VisitEventCreateBatchResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // EventCreateBulk operation middleware
 func (sh *strictHandler) EventCreateBulk(ctx echo.Context, tenant openapi_types.UUID) error {
 	var request EventCreateBulkRequestObject
@@ -8902,6 +14603,31 @@ func (sh *strictHandler) EventUpdateCancel(ctx echo.Context, tenant openapi_type
 	return nil
 }
 
+// EventCreateCloudEvent operation middleware
+func (sh *strictHandler) EventCreateCloudEvent(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request EventCreateCloudEventRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventCreateCloudEvent(ctx, request.(EventCreateCloudEventRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventCreateCloudEvent")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventCreateCloudEventResponseObject); ok {
+		return validResponse.VisitEventCreateCloudEventResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // EventKeyList operation middleware
 func (sh *strictHandler) EventKeyList(ctx echo.Context, tenant openapi_types.UUID) error {
 	var request EventKeyListRequestObject
@@ -8958,6 +14684,93 @@ func (sh *strictHandler) EventUpdateReplay(ctx echo.Context, tenant openapi_type
 	return nil
 }
 
+// EventUpdateReplayRange operation middleware
+func (sh *strictHandler) EventUpdateReplayRange(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request EventUpdateReplayRangeRequestObject
+
+	request.Tenant = tenant
+
+	var body EventUpdateReplayRangeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.EventUpdateReplayRange(ctx, request.(EventUpdateReplayRangeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EventUpdateReplayRange")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(EventUpdateReplayRangeResponseObject); ok {
+		return validResponse.VisitEventUpdateReplayRangeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// InboundWebhookList operation middleware
+func (sh *strictHandler) InboundWebhookList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request InboundWebhookListRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.InboundWebhookList(ctx, request.(InboundWebhookListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "InboundWebhookList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(InboundWebhookListResponseObject); ok {
+		return validResponse.VisitInboundWebhookListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// InboundWebhookCreate operation middleware
+func (sh *strictHandler) InboundWebhookCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request InboundWebhookCreateRequestObject
+
+	request.Tenant = tenant
+
+	var body InboundWebhookCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.InboundWebhookCreate(ctx, request.(InboundWebhookCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "InboundWebhookCreate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(InboundWebhookCreateResponseObject); ok {
+		return validResponse.VisitInboundWebhookCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // TenantInviteList operation middleware
 func (sh *strictHandler) TenantInviteList(ctx echo.Context, tenant openapi_types.UUID) error {
 	var request TenantInviteListRequestObject
@@ -9149,6 +14962,83 @@ func (sh *strictHandler) TenantGetQueueMetrics(ctx echo.Context, tenant openapi_
 	return nil
 }
 
+// QueueUpdatePause operation middleware
+func (sh *strictHandler) QueueUpdatePause(ctx echo.Context, tenant openapi_types.UUID, queue string) error {
+	var request QueueUpdatePauseRequestObject
+
+	request.Tenant = tenant
+	request.Queue = queue
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.QueueUpdatePause(ctx, request.(QueueUpdatePauseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QueueUpdatePause")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(QueueUpdatePauseResponseObject); ok {
+		return validResponse.VisitQueueUpdatePauseResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// QueueUpdateResume operation middleware
+func (sh *strictHandler) QueueUpdateResume(ctx echo.Context, tenant openapi_types.UUID, queue string) error {
+	var request QueueUpdateResumeRequestObject
+
+	request.Tenant = tenant
+	request.Queue = queue
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.QueueUpdateResume(ctx, request.(QueueUpdateResumeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "QueueUpdateResume")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(QueueUpdateResumeResponseObject); ok {
+		return validResponse.VisitQueueUpdateResumeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TenantQuotaList operation middleware
+func (sh *strictHandler) TenantQuotaList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request TenantQuotaListRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TenantQuotaList(ctx, request.(TenantQuotaListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TenantQuotaList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TenantQuotaListResponseObject); ok {
+		return validResponse.VisitTenantQuotaListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // RateLimitList operation middleware
 func (sh *strictHandler) RateLimitList(ctx echo.Context, tenant openapi_types.UUID, params RateLimitListParams) error {
 	var request RateLimitListRequestObject
@@ -9235,71 +15125,127 @@ func (sh *strictHandler) UserUpdateSlackOauthStart(ctx echo.Context, tenant open
 		return sh.ssi.UserUpdateSlackOauthStart(ctx, request.(UserUpdateSlackOauthStartRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UserUpdateSlackOauthStart")
+		handler = middleware(handler, "UserUpdateSlackOauthStart")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UserUpdateSlackOauthStartResponseObject); ok {
+		return validResponse.VisitUserUpdateSlackOauthStartResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SnsList operation middleware
+func (sh *strictHandler) SnsList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request SnsListRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SnsList(ctx, request.(SnsListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SnsList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SnsListResponseObject); ok {
+		return validResponse.VisitSnsListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SnsCreate operation middleware
+func (sh *strictHandler) SnsCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request SnsCreateRequestObject
+
+	request.Tenant = tenant
+
+	var body SnsCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SnsCreate(ctx, request.(SnsCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SnsCreate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(UserUpdateSlackOauthStartResponseObject); ok {
-		return validResponse.VisitUserUpdateSlackOauthStartResponse(ctx.Response())
+	} else if validResponse, ok := response.(SnsCreateResponseObject); ok {
+		return validResponse.VisitSnsCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// SnsList operation middleware
-func (sh *strictHandler) SnsList(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request SnsListRequestObject
+// SqsList operation middleware
+func (sh *strictHandler) SqsList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request SqsListRequestObject
 
 	request.Tenant = tenant
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SnsList(ctx, request.(SnsListRequestObject))
+		return sh.ssi.SqsList(ctx, request.(SqsListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SnsList")
+		handler = middleware(handler, "SqsList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SnsListResponseObject); ok {
-		return validResponse.VisitSnsListResponse(ctx.Response())
+	} else if validResponse, ok := response.(SqsListResponseObject); ok {
+		return validResponse.VisitSqsListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// SnsCreate operation middleware
-func (sh *strictHandler) SnsCreate(ctx echo.Context, tenant openapi_types.UUID) error {
-	var request SnsCreateRequestObject
+// SqsCreate operation middleware
+func (sh *strictHandler) SqsCreate(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request SqsCreateRequestObject
 
 	request.Tenant = tenant
 
-	var body SnsCreateJSONRequestBody
+	var body SqsCreateJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SnsCreate(ctx, request.(SnsCreateRequestObject))
+		return sh.ssi.SqsCreate(ctx, request.(SqsCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SnsCreate")
+		handler = middleware(handler, "SqsCreate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SnsCreateResponseObject); ok {
-		return validResponse.VisitSnsCreateResponse(ctx.Response())
+	} else if validResponse, ok := response.(SqsCreateResponseObject); ok {
+		return validResponse.VisitSqsCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("Unexpected response type: %T", response)
 	}
@@ -9522,6 +15468,31 @@ func (sh *strictHandler) WorkerList(ctx echo.Context, tenant openapi_types.UUID)
 	return nil
 }
 
+// WorkerPoolList operation middleware
+func (sh *strictHandler) WorkerPoolList(ctx echo.Context, tenant openapi_types.UUID) error {
+	var request WorkerPoolListRequestObject
+
+	request.Tenant = tenant
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkerPoolList(ctx, request.(WorkerPoolListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkerPoolList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkerPoolListResponseObject); ok {
+		return validResponse.VisitWorkerPoolListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // WorkflowRunUpdateReplay operation middleware
 func (sh *strictHandler) WorkflowRunUpdateReplay(ctx echo.Context, tenant openapi_types.UUID) error {
 	var request WorkflowRunUpdateReplayRequestObject
@@ -9605,6 +15576,90 @@ func (sh *strictHandler) WorkflowRunGetInput(ctx echo.Context, tenant openapi_ty
 	return nil
 }
 
+// WorkflowRunUpdatePause operation middleware
+func (sh *strictHandler) WorkflowRunUpdatePause(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error {
+	var request WorkflowRunUpdatePauseRequestObject
+
+	request.Tenant = tenant
+	request.WorkflowRun = workflowRun
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowRunUpdatePause(ctx, request.(WorkflowRunUpdatePauseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowRunUpdatePause")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowRunUpdatePauseResponseObject); ok {
+		return validResponse.VisitWorkflowRunUpdatePauseResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WorkflowRunUpdateReplayFromStep operation middleware
+func (sh *strictHandler) WorkflowRunUpdateReplayFromStep(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error {
+	var request WorkflowRunUpdateReplayFromStepRequestObject
+
+	request.Tenant = tenant
+	request.WorkflowRun = workflowRun
+
+	var body WorkflowRunUpdateReplayFromStepJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowRunUpdateReplayFromStep(ctx, request.(WorkflowRunUpdateReplayFromStepRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowRunUpdateReplayFromStep")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowRunUpdateReplayFromStepResponseObject); ok {
+		return validResponse.VisitWorkflowRunUpdateReplayFromStepResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WorkflowRunUpdateResume operation middleware
+func (sh *strictHandler) WorkflowRunUpdateResume(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error {
+	var request WorkflowRunUpdateResumeRequestObject
+
+	request.Tenant = tenant
+	request.WorkflowRun = workflowRun
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowRunUpdateResume(ctx, request.(WorkflowRunUpdateResumeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowRunUpdateResume")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowRunUpdateResumeResponseObject); ok {
+		return validResponse.VisitWorkflowRunUpdateResumeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // WorkflowRunGetShape operation middleware
 func (sh *strictHandler) WorkflowRunGetShape(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error {
 	var request WorkflowRunGetShapeRequestObject
@@ -9658,6 +15713,32 @@ func (sh *strictHandler) WorkflowRunListStepRunEvents(ctx echo.Context, tenant o
 	return nil
 }
 
+// WorkflowRunGetStream operation middleware
+func (sh *strictHandler) WorkflowRunGetStream(ctx echo.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID) error {
+	var request WorkflowRunGetStreamRequestObject
+
+	request.Tenant = tenant
+	request.WorkflowRun = workflowRun
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowRunGetStream(ctx, request.(WorkflowRunGetStreamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowRunGetStream")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowRunGetStreamResponseObject); ok {
+		return validResponse.VisitWorkflowRunGetStreamResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // WorkflowList operation middleware
 func (sh *strictHandler) WorkflowList(ctx echo.Context, tenant openapi_types.UUID) error {
 	var request WorkflowListRequestObject
@@ -9922,6 +16003,38 @@ func (sh *strictHandler) WorkflowScheduledGet(ctx echo.Context, tenant openapi_t
 	return nil
 }
 
+// WorkflowScheduledUpdate operation middleware
+func (sh *strictHandler) WorkflowScheduledUpdate(ctx echo.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID) error {
+	var request WorkflowScheduledUpdateRequestObject
+
+	request.Tenant = tenant
+	request.ScheduledWorkflowRun = scheduledWorkflowRun
+
+	var body WorkflowScheduledUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowScheduledUpdate(ctx, request.(WorkflowScheduledUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowScheduledUpdate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowScheduledUpdateResponseObject); ok {
+		return validResponse.VisitWorkflowScheduledUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // CronWorkflowTriggerCreate operation middleware
 func (sh *strictHandler) CronWorkflowTriggerCreate(ctx echo.Context, tenant openapi_types.UUID, workflow string) error {
 	var request CronWorkflowTriggerCreateRequestObject
@@ -10283,6 +16396,52 @@ func (sh *strictHandler) TenantMembershipsList(ctx echo.Context) error {
 	return nil
 }
 
+// UserUpdateOidcCallback operation middleware
+func (sh *strictHandler) UserUpdateOidcCallback(ctx echo.Context) error {
+	var request UserUpdateOidcCallbackRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UserUpdateOidcCallback(ctx, request.(UserUpdateOidcCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UserUpdateOidcCallback")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UserUpdateOidcCallbackResponseObject); ok {
+		return validResponse.VisitUserUpdateOidcCallbackResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UserUpdateOidcStart operation middleware
+func (sh *strictHandler) UserUpdateOidcStart(ctx echo.Context) error {
+	var request UserUpdateOidcStartRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UserUpdateOidcStart(ctx, request.(UserUpdateOidcStartRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UserUpdateOidcStart")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(UserUpdateOidcStartResponseObject); ok {
+		return validResponse.VisitUserUpdateOidcStartResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // UserUpdatePassword operation middleware
 func (sh *strictHandler) UserUpdatePassword(ctx echo.Context) error {
 	var request UserUpdatePasswordRequestObject
@@ -10551,6 +16710,32 @@ func (sh *strictHandler) WorkflowUpdate(ctx echo.Context, workflow openapi_types
 	return nil
 }
 
+// WorkflowListConcurrencyGroups operation middleware
+func (sh *strictHandler) WorkflowListConcurrencyGroups(ctx echo.Context, workflow openapi_types.UUID, params WorkflowListConcurrencyGroupsParams) error {
+	var request WorkflowListConcurrencyGroupsRequestObject
+
+	request.Workflow = workflow
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowListConcurrencyGroups(ctx, request.(WorkflowListConcurrencyGroupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowListConcurrencyGroups")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowListConcurrencyGroupsResponseObject); ok {
+		return validResponse.VisitWorkflowListConcurrencyGroupsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // WorkflowGetMetrics operation middleware
 func (sh *strictHandler) WorkflowGetMetrics(ctx echo.Context, workflow openapi_types.UUID, params WorkflowGetMetricsParams) error {
 	var request WorkflowGetMetricsRequestObject
@@ -10577,6 +16762,87 @@ func (sh *strictHandler) WorkflowGetMetrics(ctx echo.Context, workflow openapi_t
 	return nil
 }
 
+// WorkflowDeleteRollout operation middleware
+func (sh *strictHandler) WorkflowDeleteRollout(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request WorkflowDeleteRolloutRequestObject
+
+	request.Workflow = workflow
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowDeleteRollout(ctx, request.(WorkflowDeleteRolloutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowDeleteRollout")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowDeleteRolloutResponseObject); ok {
+		return validResponse.VisitWorkflowDeleteRolloutResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WorkflowGetRollout operation middleware
+func (sh *strictHandler) WorkflowGetRollout(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request WorkflowGetRolloutRequestObject
+
+	request.Workflow = workflow
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowGetRollout(ctx, request.(WorkflowGetRolloutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowGetRollout")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowGetRolloutResponseObject); ok {
+		return validResponse.VisitWorkflowGetRolloutResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WorkflowUpdateRollout operation middleware
+func (sh *strictHandler) WorkflowUpdateRollout(ctx echo.Context, workflow openapi_types.UUID) error {
+	var request WorkflowUpdateRolloutRequestObject
+
+	request.Workflow = workflow
+
+	var body WorkflowUpdateRolloutJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WorkflowUpdateRollout(ctx, request.(WorkflowUpdateRolloutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WorkflowUpdateRollout")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WorkflowUpdateRolloutResponseObject); ok {
+		return validResponse.VisitWorkflowUpdateRolloutResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("Unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // WorkflowRunCreate operation middleware
 func (sh *strictHandler) WorkflowRunCreate(ctx echo.Context, workflow openapi_types.UUID, params WorkflowRunCreateParams) error {
 	var request WorkflowRunCreateRequestObject
@@ -10637,201 +16903,402 @@ func (sh *strictHandler) WorkflowVersionGet(ctx echo.Context, workflow openapi_t
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-
-	"H4sIAAAAAAAC/+x9a2/jOLLoXxF0L3B3AefZ3XNmGzgf0om729vpJGsnE8wZNAJaom1OZEkjUnmcRv77",
-	"BV8SJZES5VfsiYDFTtrio1isKhaL9fjpetE8jkIYEux+/OlibwbngP15cjXoJ0mU0L/jJIphQhBkX7zI",
-	"h/S/PsRegmKCotD96ALHSzGJ5s5XQLwZJA6kvR3WuOfCJzCPA+h+PHp/eNhzJ1EyB8T96KYoJL+8d3su",
-	"eY6h+9FFIYFTmLgvveLw1dmUfzuTKHHIDGE+pzqde5I3fIACpjnEGExhPismCQqnbNLIw3cBCu91U9Lf",
-	"HRI5ZAYdP/LSOQwJ0ADQc9DEQcSBTwgTXABnisgsHe970fxgxvG058MH+bcOogmCgV+FhsLAPjlkBogy",
-	"uYOwAzCOPAQI9J1HRGYMHhDHAfLAOChshxuCuQYRLz03gX+lKIG++/GPwtQ/ssbR+E/oEQqjpBVcJRaY",
-	"/Y4InLM//m8CJ+5H9/8c5LR3IAjvIKO6l2wakCTguQKSGNcAzXdIQBUWEATR4+kMhFN4BTB+jBINYh9n",
-	"kMxg4kSJE0bESTFMsOOB0PFYR7r5KHFi2V/BJUlSmIEzjqIAgpDCw6dNICDwGoYgJG0mZd2cED46hPXF",
-	"1jMOwgdE+MItJ0OshxOxr/xnRu0IOyjEBIQetJ59hKZhGreYHKNp6KRxzkqtpkzJzIK0KFmc0KYvPTeO",
-	"MJlFU8teV6I17fgcROFJHA8MXHlFv1N2cwZnbDUphqwP5XpKRcTBaRxHCSkw4tHxu/cffvmvX/foH6X/",
-	"o7//6/DoWMuoJvo/ETgp8gBbl44qKOgCLug7dFDsRBOHYhaGBHlM0KkQ/+GOAUae23OnUTQNIOXFjMcr",
-	"YqzCzCawB/QESIAU+yVpElIBVsO1gnKyIag0FJ2cKGSSW6GrKiExcajFDf1CEcKHyGGsSvdGcSpkrlxM",
-	"jQy7yom0JMpi9DXCxECBESZfo6lzcjVwZrSVCuOMkBh/PDgQ9L8vvlDi1B0/IEbf4HPzPPfwuTBNPLu/",
-	"y0kXjD0fTqzJdwhxlCYe1ItxLhP9E8PqCZpD5VBMxFjOI8BCnBaktnt8eHy8d3S8d/Tu+vjw4+EvH9//",
-	"uv/rr7/+j6uoKT4gcI8OrEMRMggC5HN6UYDoOSh0bm64YKBDq4CMx8dH7389/K+94/e/wL3378CHPXD8",
-	"wd97f/Rfvxz5R95k8i86/xw8ncNwSpn73S8acNLYXxQ9AcDEEf1XiaMS/SM6eL6LKsgGXriO7qFOHDzF",
-	"KIFYt9TbGeTsTomT0O6OaL1vvbFzSIAPOAk2nBEFijXKkeuSHMlg2y/u6/GHD004zGDrZeIkQ4YWiZ4H",
-	"Y8J1giH8K4VceBTxyRUAjtnlqHKOQjOR9tynvQjEaI9eDqYw3INPJAF7BEwZFA8gQHRf3I/Zintpinz3",
-	"pUJIHF7dej+lwT3XufoPMCTGJcMHefex0k81QzZqqnyGHzqgcByFGNZBVSUg/o1SjBXEbKYqkEtQt5kS",
-	"laWe0iM2sMD9wC9ivzXl5XfJlAmWNpRotXcUQrYktnNSGplXxflwEOq3z0+T/M74OEPejIkCLqIQdhj1",
-	"77uL80w0RyREQU9OxBall0cnXBpxlXspccTG1/FhGWkmiidSwlcxVgCrHgw+ihmO0yQKb6PkfhJEj9cJ",
-	"mk5hYtxH4PuIQgGC7wqnVAb2kijsP8UJxFiorBUSo00uxAZUtYcwTolm5Iqoo816OqiUCSrg5HRbz4r6",
-	"xZaoJWvjSJ7PSIcxirI/OX70YzFOsBvgXqd+0v738NnY3UAfXEtlIOWYGV2MlEuHEUUkipF3kpiIdA7+",
-	"NwodKSkduh3OP06GF/+Uh/3oYuSwMZZh7uxAnKPwv496c/D038cffqmejBmwZl7gtoiTACakPwco+JJE",
-	"aWyWarQJ1omQAGFC18hbyBtvgl3r6+ACy/fRA+yxGatrF6A2rbxBF+KD609g+kluK12rQyJhPFnJ3sp1",
-	"9dwkCmDT+cxX8x3OxzAZ0vZafLhisCasGPFhp9FyI9UqsMCWgYN0qp+Ufln9pD1hiGXC9MVwb2dA6fGY",
-	"ny7YVsbmv14prQuGruJho+UnxTBSNWpkR0yruZa4/cwhmUU+N9ikc4q6s/7nk5vza5fd5hTk5SsITQck",
-	"392Br/34KNDd8Nl4+soGv8GEnpfaYcz6bgaabqDS7AVYxU7m+5bhrJGuzpGOO2MwRWFmNKvbrqusZaaM",
-	"MUHz2OYSpNK5lXGv0OUy8WHy6fmzfNmQRBJKFQZWrAH5jvH7zAYVmKX0j6XYiGSvBc3yv8wpVXAHZ0V5",
-	"WX4lEm9IxoVI8h2m4Sidz0HybHX1vK12q+EorqBlC/khN/wM6CyBbXRL5x//Hl1eOONnAvE/mzXFTEdk",
-	"039bjgbkGFvAu9lyqmwrAd0WKGtAFBLkDCXQkyBJKQKw5/LXY7P8MEkgC9EzgiDxZtrDxETvFVxOANK+",
-	"YjBtKqWKHGVV3spJ0rBoszQ/mccw9CksDQOLZm1G/iuFaTPEvFWbcZM0DC0gFs3ajIxTz4PQbwY6a2g/",
-	"OqXDf0djjUCq81lgcknxWhDS+M9ovL8m63NlTExgbM+FIwJjneWwViMjaA6jlOiXLz42Lf1hWW3sQdHC",
-	"pNbOlq5Tr/4djYep5nXBYybMQD6l2L0ZZJ0y5xlzkyEE2KDPT1CI8Kzd1H9yiqzbUUq0vKVh95YgugTi",
-	"NCBaGxkmICHtFoMJICm2WA+Vs7ytoO9hGrYjcbr57ancu4dJPQu0Wa6iXDWBrBwwpZ7L3174IJJAsl0w",
-	"c80o2yZ5hF71L84GF1/cnju8ubjgf41uTk/7/bP+mdtzP58MztkfpycXp/1z+rfurKVKiN4jwNaPqNxV",
-	"s8ViEmaaxmbb9EZVn+y1U6v9UIiL9kr8yvAWoWl8UVFgExPpiIstMwDe/S0cz6Lo/tUXqcCyqiVG03MU",
-	"wlbuDfQIZZ+p+kDliTxIg2jqBCiEbd62uQ+kdg46nGjQqJqYevMWmht1CVuqH0DumJnN8CNH1Tl8gEHR",
-	"qPTphoqXwcXnS7fn3p4ML9ye2x8OL4d6maKMk6n+VvtfgEAnSMT31785SbLSSw/+cYnbU3GElvcn0bnm",
-	"BqVBgPok/NP10iSBIbmLGe0e99wQPsl/veu5YTpn/8Dux6NDeiEqclahs845RrRwYk6F2cTHVlcOBRat",
-	"Bxl8qo78zm7kfF1an56IgEC94NGmzC4RIEy4KTz3wD60ueFoJNZ/6O3uOyQJ8jTyOEznV3bXT0bH8hK6",
-	"b1rvf6xunHwsxF192PXTOODQ7qrJRxQXzn09agqPAhmohVl6KkJ08n8ICDxHc6QRGFYWx4SK/4AOoBXR",
-	"AcBkCCcoMLxhMVcv4QumDsb8wBLWETI3hBU6yrEJfgNBajh25uAJzdO5eu/nr1HYYT7FwlApdvsRhX70",
-	"qN/uVVhCGxD8YF6HlCKadcyBD20Xwb/pp+Df2DLoHqJQcSXJ0cy9XydR4kHf9nFcuRUo+yXXm0FVoLAf",
-	"Kj1vwSGY85b2GMw+L3EQlseoHIUcmxJrCiq1o0EPhmSk3F5LrxsMPBM986+Ozm1INTe0uY8uYn9Ywnaw",
-	"NgOBQGluIahcl8vuY/U8km1ET71JC1jKo2vFPqR/vR0/zCGMA/D8t/ID5EtSzDDYuLICPbzu+pTmHw4P",
-	"G9Zbgtu0apPBROluL7RLdi1b+CR0CeVyxuw1bNXC1Y6OWrJtaAacQkxuEoOOdTM8d0jkYBj6zPtLXG+x",
-	"Q6L1PBWbDog0RH9RbcCHIUETBJNMixQKkIgH4E5qavjMGAZROJUQN8jK3jp95OwMmbV+byNvBv00gAql",
-	"Lev9aSKpnku4e6n9kdbG4TMf/IeyLn9VBtme+5+b/g37Y3T6tX92Y7LSZjOv1+1pJxyY6t8L2lLD6lyb",
-	"hml4qpoUWz9IcAA2fV4pANgscWSlDt5WOrymD1hOFLXuX1Um24IrlobzrRzBqv1MFygVO/V2xRGcg3gW",
-	"JXAURGTFt6fCzUT/oM3NBTiIuPFE9LA3xS94kxFvnaZl0c9OksqFNR/d6qNl80JREMjXfPuVVoSKxrAh",
-	"mtiDXmLNHC099bZWfuGUL5uUfNTHnepzzAyEIQxM8IrPDvL1ViRMB3ce+ej6+zkf4cLoXy2nYH7WC06y",
-	"lGoJ5qbV029LLJ12N6+bDb7MordCKbZTWyUiMnQX6aKnkKH2iCAwNsk9vQ/KDAV+AosP6g134jX5jcQg",
-	"qYRgNkKSQOCDcQBNmyu/Z0HYXCA2kslS7kyGGcwUoKyiQA7S/UJsIH9Zqtn6NbgvnZB+HBVe6RTL9Iqc",
-	"nBgR3ppsBY00UOiOT6M0JHpwoRHKRcyceZ8aDJXvhQUvLQsnH+GTlrVfPdtFKTGBuCBHsue3kwmBiT0y",
-	"V+40xrvU7MwS2patvyRtaxInFrKmzYqzLjUrpqqPwVfN6nDKKDBbWa1jmEDdSeLN0APcSbnU/rq8VSIm",
-	"ojcqfacark8gSZ5rpOja+FG5xmyGJWpuDAoSJB71t08TvW/D1bzIgNonUNHGEEzlmanAbAn19R0URzMN",
-	"yUketFiPeENiPSjdwAeYIPLcpvdI9rGiu88owWQEuZJsT3vnoG2vli68/JZRALA0c4ZZBU2qdx3f3xpi",
-	"3pY4oAKZNhJyLtKlDWnY54bsu4vLu9vL4bf+0O3lPw5Prvt354Pvg+vc0D24+HJ3PfjeP7u7vGHW3tFo",
-	"8OWCm8KvT4bX7K+T028Xl7fn/bMv3II+uBiMvhaN6cP+9fB3bmxX7ep06Mub67th//OwL/oM+8ok6tyj",
-	"80va8rx/MsrGHPTP7j79fnczYkuha/p8fnl7N7y5uPsyvLy5uvvW//1ONe8bmghAteY0HccoSFXcLcUC",
-	"h4PrwenJed1ode8S4q87jobv/YsS4lu8W4i/aWsdMHn+xXJmSJiIEPq+IdHBrcwwFzmstbQSzFkvvK9N",
-	"JwdCEDwT5OHLmFympGbU3OwwA9iJYgJ9R1wts0H0c6w9S5UpvH7p+PzmnFbGUHtt8orNZq1YUwolc/IK",
-	"7Zq3QEjr90KX5GMa7XGSc4fsweCluCoUTkeQ0P/gzbEoj8DvP8WI7jILvWDA1I/Pe/FpsPPIks2xKBIH",
-	"JNABcZxEwJuhcMqzzjEE180vk29wImGOZQtCwZcs0/pV4WGeaLW4UCwynwEK0gRagMKcHFRAVEM+ZrGs",
-	"+jkDgPlSzY8sua8qCMXOsocWEW5t6Z0GniSRfWa2itB7NrqhOhPZxAFEulYKqlqtfd0sCbQAm+XCIPMZ",
-	"W08em5csw2DtA5HMKylyCW8y5+JiyXKangkEQ5keOeRnM9Z4i7pnDjZCIRPbAidmIctPvldqMoUG2tma",
-	"o0SQcrsThO9pFf5XIyj7vB2U9Zpa32CY8B5X6ThAXh0psPFq8j2pMG/Npov9W2TTh2Kf5M3i8vaC3Y5O",
-	"zr4PLtye+73//VN/WHMhqI9sYXZtbHY/0lk9qu5gEQFBEyYKcCiGgbq524xX9nvMECApX8Vidl/u/8Zv",
-	"ZOpNkt36Li8UB7Ea9BbUGp1mB5J5TVgI++4wT3q9DOaBKyRyHkHC0ipU9B3eWx9m0S5SRh8ks5r4Fz62",
-	"eYl6+JcL2c+2vZlDMyKxi35p2rD2QS9zSGAiQ1/kUcnHcv6B9uG+c+T44LnnHDmPEN7T/86jkMz+ueCr",
-	"fIYebSiMWbJKRF1FAfI06V+4Cl53K82ycvOmGr2ghWQtsl+Ta7UAzrw6YdBZu8xk0on7gG3AYdfoA37D",
-	"cpO/xWSZ6sobAlZWkqfSqK+ogJj3f4dNeJ0N4nVtEGu0Dawlb7e1hfbFyE23zCnAHCqDr0CKdfHXKrlz",
-	"zwIHYSdmrR0Q+o4HwjAiDmAFB1jlIplXq4x4LXRYd4lrNGIA308gxqoxo6CXydtx1aZBP3wFeKaT1jOA",
-	"Z+qQ/w+XphPym6s2vPDPiNfQcU5ngBgn/A0maIKa0MtMMlSWPIjmovhUAQY9Rc8ANpe40s4BsppWDoZk",
-	"g08NPsJxAJ4LBC33r7X1o4jdHwYCK9YAM6c8ho9mJDIehI851qSOpod9gWM7qzH2wnyy6gDJgKjF33Iw",
-	"VLLcZBXQVDyZUH4eTVG4eLLtxfh7qdzbW4dxuca4CddDOEWY1Ej3bUS33UlnEAxbuFuyKo/tpqnqMZ6h",
-	"GO+qZa5iqdzgab6OU4ZPpts2ETPCVamVWp7tmEHEPgg1TMsWqSk2WfZNk2CRh3k6biNKeNjhkhUFLBaJ",
-	"oZdAw9sh/5ZlzhE8TG9CzmDCSlDGSfSAfOj3HOAkIPSjuezEgpzG0JnCECZAxHSpcYvHa8N4ezT720mA",
-	"i+3Npkk5g7MR2VQqb0mmyKL4sYq+LHQxMqbwd70DxJhcHbKrXp4/ig+l1lhs9eQrgq2tVytA/857Zl79",
-	"p9rK1BTkr9fXVw5vxEpSSwpOBPItEn0pWMlgLkz8wxLh9SQkU0WZngi4/VDSvGxtbRLWUsDCtPO9Eif/",
-	"pX/t9tyryxH7z801s6GaTkge34Tr4nIxfzEQlgYPhE4ME0pX+608tcADQAEYB1CGGTVkG69OC5+glxLo",
-	"eFEoXjiCZ/0TBlU1WBmZZNBQGZQ9j6BpCH0n77SKGqFLxt4HYAwDXP+8w9owlsqPg+wYsE74ApNzOo5u",
-	"ywKAyVcIEjKGwCIsWWwVe63DFEDgzGTvVeexA5yJqVrQxwSMAxatsUUQzsGTmdA1afaWI/j16xlm/SKp",
-	"ZE7TBYPSNllEfP6c1pJgS1nadEFwaUi3ZBBOIjvqHyodmD9tZJL8WCY54AH4nPEWXEgpYYJmIXmUnC6z",
-	"ADtGK3sjj4CT0+vBb32Wlzf78+rkZmRwN+c/5CfIqH/++evliHvafz+5OOFO9rf9T18vL79phxCnoTGn",
-	"gDgsRT32ItSNiRFE75sm9fNmeK4Zvq02ytprNQlFWrbLGCpzRNOuq04OUOMHwN//GyavrwRUg4fXN44Y",
-	"9e4MyGFRGpScAEA4TUVglLWcGJ19w/wE4p1Fphl9FKBeMxIiqv9EEqCvEeLfm4etLI5BpOp/l+cnPKjj",
-	"9+uvzEHo+ver/uh0OLi61vLureLjtHwxFfnip6Vz+0ct9miYPWvpH0P+jMYGAUm/6ACyIitRomNloQZt",
-	"zlgj5qQJVKPegOnia81K8QKt0i6quLRPISjoN8tgUufvUhbBJplDxz2VqpDOq2cKifI9C0gpvSmGMt8Q",
-	"fzieQoIZ7ry8qzOlfbOzRHkK3zd6lY1IAgicPptObP7VIRF/rpTF8dVZufcZwk4CgTcTyZ8FV/OwqrvB",
-	"xd3V8PLLsD8auT33bHh5dXfRv+2zux6Lqcv/ySPNhpc3F2d3w8tPgwst87fUU3NVtPi0Xy7a9O64+Tov",
-	"py4jsKfdyDqqGJzp3o4zAAdn2m2Tvb+hsHCB/nxzcXo9YJLz7GZ48umcqk9nJ19qhScdRB6JrTiFza5h",
-	"Pfldf84ule1lw0c0O1vsDByitdGpjfHlN5gHyWvEYSkTf5Wt7+Ez1l+j5PCULGumKF3bqJgADo6hhybI",
-	"yydx/hEDjKHvPCDgTFBAYPJPy0T/t8ViRCtP5yjeYoxp/TIPGDXt4NGhkrV2bcldFss0yTNk2NNlnt1l",
-	"hcc8z9ryOska+dwjNaR+0yCsLYW4NmekTbJP6H96bjH4tdKrmpWypeqz9ryWWcpzdbE/6oXJllza6pJM",
-	"14FfVy3gZHRKj+n+6LT2nM5HqSmdo9JyQYopkrFhktEMxLCT3Z3s7mT3a8ruhtTNfyPRvtq0403SjU22",
-	"0H2nSAiGS09pQzXv41F4pXCsJoFYFMpEx9oGop7EelJp3i5YEbRhi/EpS522SK2LdZbmKJeqaFiE8XLH",
-	"ciK1oSM51Cnv2KQ9lJpX5hf8oI2Mkryk/Sh4RvtNsp72Y86N+hxpxtVcg6kOfwE/y5e30y5tsNQ7U3EI",
-	"6whEcP1pQjXMiZ7xazJm3iEDuzVNKLJXTQylce7E886qp8X6FbbXpkt404hWto6FB87ws1qti5+DevTl",
-	"R+OdMEO3RzOPtFlBjE3zc0QdGIqaUWbZgjnbZkNUCzjV9uEEpAG5SlAks4Tp2J81cmLRSsfAjdbb/L3l",
-	"lV5RsqSaFqBicfZf58mjNQos8u6N9nr6LTfbWz3RKDzdgrWw8tBieMflH62AUBMW2Bpma5VlsxIrYc7T",
-	"dCoD/WhmB7avq7RstyGQN4Vw/l6cm7SLGJ8kkLmh1GSenYOnhhYtM2ia8l9yf+WUCimqvs85hGMIEpic",
-	"pITFCDKMMtnLfs43ZUYIy3rmRdE9grI5orvKf5IvjB/dGfP0U8IDQYy+QeE7gIS7gMaJlXdzTq4GLOMn",
-	"YVf04q8ZZblH+4f7h4wwYxiCGLkf3Xf7R/uHLBiFzNjSDkCMDgKRpnmq89P+Ih8oaasQYuxk10O6i0BW",
-	"VnHPxfcvbF3Sr5bNcnx4WB34KwQBmTGp/EH3/SIi2ZyFnXE//vGj5+J0PgfJM4cwbyifqv8Q43sz6N27",
-	"P2h/ttYEAv+5ebG0Gapb7VA2WOVyGXAslpjHzpIETCYi1U/d6jNoG5f/cHQARKDzHotr2WPvRfjgJ/tZ",
-	"/e2FwxhAotHFz9jv2AFZBV0WT8+jd1j3CsZKuRP4CIwWE8ASe1Cwa/JjVWZw2FWS8Rel55y7KktxVe7n",
-	"ZkAuF5e+m778qOz9+yq2RqnnQYwnaRA8OxylfqH8cAV5Lz33PacSLwqJyNEM4jhAHsPowZ8i0W2+jobT",
-	"imVEFxFa5afqOQgoFqDvRIkzBr70KudgvFs5GDooPkfJGPk+5LpsTt+cTurITFK8yKf1o+c+7WWpB1gh",
-	"Kf6hpyGMH+wSRTxN9DdX3pchcT7C34PEGT18irjsXAkxWORV0ZBJLbZI5KQS50VsvOhF9EoWYkh/WoW9",
-	"IAY4oJ0YsBQDnFrWJwbUAzJGezyPysHP7G92GsYR1igNQ/gQ3bPUpCdXA56BRThlZDOWxESMWIoXaR6g",
-	"3W2kRDa8QSZIWLfquEvY8gSdM+j+3kSN21C1IB26sddi5yQZ57/VUXK25QUK9oIo9Q/Uq6xZ25WtMndD",
-	"eZ1ggzgoxASELAVakYhP6Wf5imxWgtePWwaIk4ZZhNfWEFiD1s4RrD7Lia3/rjzIPO3JIfaimL9pixNN",
-	"2W9uXD34yf77UrffVEqxVvuVDWU2Vr6RjZKIDWFUTtjXjQqh1W22qDTRcHgnkCQIPgixxrHBdqyTbQUS",
-	"VzCTkzdHcY1U4/Tzw0zhB01ijW1LJtUaaP4sE2Bvne7PGAl3tL9dtD+HC5/hxtN7cwe3yHLfhqayI3FH",
-	"DvJVHOF0jAOlxiw27vg5wvQCFDiF1qYNpq0HxYZr2206l9hxZcqWmy8THBRWt02EkG0924jSJlT3X91k",
-	"VrH44Cf7j4V51RmpFY4rW6yWrba3phbGNB5lDMStNJsWcbJNZ87RZsC4CUFKZlGC/hf6fOIPm5mY5/pg",
-	"KZNAEESP0NebastUK3mC/V539nGiK3JMiA9+4hBbcUuxSneVX0Lcgk1KJb+NjCJE6taxSQkZHaNsIaNU",
-	"CDZjlYtRLaOEWMMm/POLagbQGyzpvPKuUmGR1o8WJs7IoF0Xc/TMN7R7+LzoFU2B4fjDhwIQR9ZXshoG",
-	"jZOI/gP63Rm2Raxp0u4RmaVjB8SxpPbqscbblPiRwHgvSdnhJf58OQC8Hm+TZi9aybhOkbOmyqo8XoPp",
-	"3HJgC6aV45kPNAHvphlXRLWSyMH3KJaw/ZXC5DkHLppMMLuxakBBIfnlvTbAtX46HvY+fjZMyT63nHGd",
-	"hhpNtekFLDb4jVtr6KzvNzNrgeseAWbCZxKloa+7TxbYX2H+TDOgPw3T2lehjIWbZVLulm2WSLxNC3nU",
-	"54N20ujNSKO8XHgni/4+skhh/PVLoiCa1ssh7ATR1AlQWNGNqu8659H0HIX8dOzE0HaIoZ65GFYAH2CA",
-	"6bw8T0nNxKxlYeZai7SgA9qLB9wbVo4hPXgdNpsCxyRKDIDwDm0BGfFeGiBuWeHcyGGu9eb1R2rygJaT",
-	"FxIPGPDAp/ezDAe1UJwpzRaBJO+/3kNKlQZN5xMlye5wMjxrslMhk8LKWXAeTdsfA/wzNtupeF577ABW",
-	"Z8fgTMfd/XhTdz2eqnzwYvm5etdUEoks6K/giNpI4iLRiOJ52vmZZiTO9zontiavUh1FZ6ZYnt2mxruc",
-	"uaY8IUxQOK0n8N0xy27AXdyOCfMws1d1DO/4cWV+3y28vGv5Uh8DVe9jAzJt1eSDjpviQWyvI1vBwZsM",
-	"lljAcmDehI53CupaHbXaM1OvhYrWPlAq097e6uGmapiri4WyVkGPXjkWqnoCdrFQtjrqUrFQdqfkAYaE",
-	"/hc3x03LLo7sUh8JpZALCqcj0cfSGfuNHJMKYpY4I9U96Vip4L5rRNPK+CgLKKx/aMvi+7Bd/GCnT2Y+",
-	"xwwfOM8K24pPZPKQztZXVh6zIETcLjKxSWFcIFi20xEZAiStK2rhOk0Y5Uk7/loVfwlGWDD0t/7AsfDq",
-	"wCyEpODawXsbguR25ax5y8+o9/DZ6hGVtivMapVRj5EBS1JVTchqhkmpgmIFWy4rWgOolGNZDMQkDUW6",
-	"J2gFq2xr/fypT2H8Sk/SbD9f50GaTb0Fz9EqHOpjdA2xZKGW9/BZVLiLAUoq9JJlUP+DstvRR9b0iJe1",
-	"O+b/OqbiXbceTZZ+LTM05kk2L0MGMlvRuUhWbWDJ1eZ2XnuMc+cFsJKbAZQ+npaRzbYm5LpA/e4KwBAg",
-	"kiHXmoU5f7+OG4JdCg3V5sujct68F+jxvzYzq0xcK9RT+ORB6FeC1MQFRUZMWfN588XkYJwG92a3n09p",
-	"cC/IA+cyAdcKBdrnDQsGuvyWwgG/knSogGppUqjIi85tfMsEBuNbVWrgFYsNj9UfqfEXZN+5ZUMpjVjQ",
-	"eU1ihPuZ8BHesobBEGCvYYgbRALjADyvXI68Wn2ZclrwBtHEkAb9nOg6IbWtQmrIKHU98onZ1SyNrtxY",
-	"Z2F4/Qafu3e+3Pq40PWdIbu7wuuu8I4wBq+SD8RpUJMwl37H7Y7moTxi3urRzBGwLUfzauxsHLhOq39r",
-	"ByYKHxCBbT2uZS+9F9mAfe3OSuk8puBjIbcxie3OWUznT53T4pqcqPkEtbTe2cMVt2mOEjtvaY7bV3WR",
-	"5uAu4hktCKNjS707dMY3q/HdFHwuf9jj/25XG8mClVtXQ9ouB5siX9XDtpehY9fP1kbu1ZR62jLu1aUl",
-	"zPbHFM5d3Mc2JZQsOGHH8w9uISesNxZ3sXP31aJxLTlXU51pmzlXRMm25ty6k28O52NRTrbFHU320rP4",
-	"d/a1u6NJalTwsdAdTWK7UwZ1d7ScFlejC4rxDn7yP2xyUgMBhDNJonlTHBynhr+HKiiWbYKNf9585uyV",
-	"8+4iOuDb4NotSnt3YchylzFpYWNWJi/+SmEK9+ZUcHu4sVwRa+2I1tkrcq3A+ALJf2iv72KKXZQZOxUq",
-	"sEve3+vXXgq0t1hIWFbrXnJJJxNfWSZScZTtzjwTLFIiSs5ZVCYmgMA99uBk4ypBW/PnqSZfiSEg8Jw2",
-	"7ALVtjVQbVVBTY2YXGfoUkZnWxC+VIZlU/k0i7zWwhlHYefOG6d0Z1Vxk4tbimrnnP+6qMQVPfbiKEDe",
-	"c3MOF9nB4R1sMrhIV4Ir1qPL33KgQ8tiJp7SbnSmno2nQeJlyWoztxRKnuHaSn2d8ZMnbVFx0ub2UEJ1",
-	"Vzxpi+qaKbxgqIvZUAPQghEPMAEJMbLjiH7l59jlSUpmDruslBnyBsOEv5kwgC4pQlnPXeTMd4fHDTXH",
-	"GMrEsVLAygwCX7zxBBEnmCKtlOd+KVXLomQX3SNIB2XZkAvlsxhKizNKQqA7sDAdNCXSKhXWw7o6d50c",
-	"FnL4YlSoD9xCEpex3MnirZPFVUawKjHZmL/LotZq553IEFDkr9q0Xauj2eKk1l6GXdHYLWZoI+dZcnTt",
-	"iSoKdOxt4slK1AzbtZer9ZsLdIhpZzPIClkVdqZ7VNmGR5Vsb6qPKkvaJzTl1GpZN6+c5oyfOUNpaznu",
-	"iB2vt60l3TZQeHFB+dBJhK2ruKiKiJVUWbSSE405NU4IgfNYZIthbS2KwO5aMo1OgtQ5sCHM3PuFCOFE",
-	"EGzfBeGVH/GaGGVTDJ1A2rEm9p4lKbHlYda8Y+FtzAaQpKHYqobgCxTGKfOH4I+7uuW+bIWm0uUCqJEv",
-	"bMNfQ6Dka6q1BfBmllXiv0Ay4sN2ouX1tIN2Wa4MlgYxXHeh2OYLhdyltUgN8Ra/9xgl93UBY7lbp9FR",
-	"ovORyF3UOSpuGVIpQuoyZVJkZG70vKMjt6Mz4m/bq5xC/ounChGDmFjozb++FfiHY2NDNXM0M/utEn3I",
-	"re04d/ue31TGW8RYz6VyvXmenpBceNf73uZnw5s/LHNMdKWplr5qyhCgYuw0x/Gij1QS0fx62T5DpFqk",
-	"R5MoUqms06WLVNJFKnjBDWaiQhmk10seqYPbuuqcYkEqEEx3Pd3KpJLFPaoGGdZfUNsInJ/qP5texwuc",
-	"0HgCCzLd5cfyEuvrQVMxuMNqgtiuReOVu8dzc7Rw0S7dHCncK9LU4vx8wJ44Gk3U/CGEM7QK9H4DXw/Y",
-	"6B1zvz5z57kRrpTSEBzGZazZRRyx7e4M2hsyaN+quA9tshLkm9RWZVidxMEzEMM16REjNnYnb3ZGmeAb",
-	"1mkUfyONIvOIt6ilXSijHQTZqxvW6Bp1rM/CsfgDeV+m2+9kwMoBPAeYOIMzlrRyBp0AyB00JT8BmAx8",
-	"Y/aTd8e67Ccb8NxrU2ZDlTydb82WvtgvIEvsn/PtZCG2eplgLe00mu51ItcUuveJ1asIq8xNlo1pWWnS",
-	"Ac4YEG9WeZ+oO+TffIlJ1bTPkWHrvyrcqqvW/TdddzLoHjwa8gRxstnEYwM+8JIobD5EaSvnz2icA0US",
-	"NJ02vvifJlG4ayfr20x0mG0s8um0U0gyLW6/IZ+t6a6x6ny7u5TMtia94vjZmYgUjivL8qjyGbbP9Dh+",
-	"Xl+yR+XY3HC6xwIyltBhu4NJo8dWToI1KbT0WDr4Sf+zJ3+1q19QPaqsrdmUcHa8mkG2ehNYBYxuvp6B",
-	"ZeEB7SZ2qSTLhQD0aGpngC4SxI+XXt0L0ZLMtcs+J1vMWWs6Ortjcxesta0O6xXIB7vzm9GArWlWtRc3",
-	"Pzh398htvkfKWu62l0jWfr03yK2+3lLgYpBQpBkeIUtg8ca3qo1vQ/BpQoi1sInnvk2ZBQpowwSQFEOr",
-	"ejyy7SJX2hHrKy6XNsDdo9C3goo1bA3SNxT6zdDsvAWFoDl0wIQCWnGDewRYRqWpS3CPD4+P9g7p/64P",
-	"Dz+y//2PAfei+wmdQE+8PiBwj0Lh2paXoxCP4SRK4DpB/sRmWCXMNVieoBDh2eIwy/4bxfOqgF4pptdn",
-	"Eaya396sPbCsO3bXmrU4vq3HEMh83WzyuwJHgEYPuiL7qwlfLV1ad7lCYaeGd2r45tXwTrfsdMtXcWbH",
-	"S1b0ZAKoyzzdfL6vobpmfs5TUP00oMdjg9Uwa7mI/XAkO3dWxG22Iq7vXpQRwE65S3TKVKdM7YwylS8j",
-	"F9Ursc1alUrPGDyz0m641nhVwnRWh9VqJQYNYL16ycHP7M+9SnKORq8kPcgtdZYd903S4MCYjFaL6q11",
-	"V9LvbuevVPZXMuCpnUOCgTYaPJdWwoA7XWBmp7hvncdxdxTvul/TeuWInWKQxd+/5DE0tSUogRPCR3Mk",
-	"jX0gzTXvsDsZc+tvrwyK2oD7WtA2WhxTsw1tilkYN3+jGQvbOXmqiX7N8HdicfMV+7YuS6IQdHVUvp4g",
-	"RkUWF+zIenksNQIhke31wYoqMUzDTgpvUgrLHVA2oI38NeoNG6wu1F4dVSXwm7xpduLXSvwKhaRJJ7bN",
-	"OLeI9OVZuPe8KA1Jg7cOayNzGsn08eABoACMA8gEsSJ59BfzL5DwLN/4lM2481K4KfXUjieUKWzWgrdw",
-	"TiqcfDrDuOG5voCkxRLSFdk/xTDBB16aJLCeszG/KPCGDu1W4d4bDJMvkJyKwdZId3SmlnTGIO4Kmbx+",
-	"IRPopQkiz0yMe1F0j+BJSmXXHz+oqCrFuRXJTZI7234NGU8RmaXjAw8EwRh490ZyPo3mcQAJ5DR9Sed3",
-	"tOcRnYiXcfjChr6kuDyVw5cI/N3hccPTgifm9avzziDwRc2yIOKboa2Rl4n1lxIyC7iTCyzOYYk+TEBi",
-	"FgUj+nUxxLGu7bHG4Fk/zhh0LREWRdMArofe2NB/c3rj6FsxveWI+9vRGwofEIE2hQ2lNsw7MKXb6vim",
-	"I1yzvgMx1xpPcXUiK1eKAGG5McUFdvqi9bHKcnuWsJdT3rXGPlegvQPgeTAmNXX62XecGdvEJBVqUzef",
-	"93HXY1rig/OJmgvv1VAfX7mO/jqHgLz6PENSZe/t6SuBLOVgTUUu+r0dffE+7rrqW9HBV0BffOUdfTVU",
-	"H6dIWoC+gmiKQjNZnUdT7KDQAexs3K9RMM7ZQOuhJXYE0/E3VCHU6h4dRNMp9B0Udtfnrbo+F491SjW2",
-	"9+QgmkYpaWCGKCV23BClr2/rETQabVm9nI5IG5RRRj22ZDuH8zFM8AzFLa5ASie7axA/Qr7n3URE0VoJ",
-	"XD9p+/uQiqLuTrTInUjFYDNJxgDjxyipcUrgYlJIUke2rxOpV3LM9ekYpzMQTrOJtknZ8BhkfoaoTpzv",
-	"kDjnZFWkdAsmSuCUCrKk7tLHW+BajSRz2VkX20gwtolhJPK6Z66d0NMlCdnqPDgA3v1aXhhGdOQtfmBo",
-	"EDUtXxwe4XgWRfd7wiHl4Kf4wSLKiwod0brqsMJ/tw/gEgOZHUKyiTbsD2IZESXh60TM64uYchSWSqZG",
-	"LxDRwo45DgSebe5bsqmsD1bPMeIIxbbpGraWb1bjR8Wh525UAjUUM0MxockJNstGKbCTbVfHnlvEnux6",
-	"Wdmitjya8Sb748Wi5K/GuMEpzDLcUTib1fkuaiJcdsdzsbUPmVhxZ1ipOCdWYkCo/lXvi8g0NEqFxJvV",
-	"mE1qCZm32hlaXsOtlCGgcG6YzgqBgVSibHOhEZa8xiHrOE3PaYIhlmG20mlSdvK3yneReSJbBdi3uBdt",
-	"pad8m1wRGYBdzM7mY3Z01yGFYhb0k+81aVj2nNBC5XoLASMLBol0vPXavKVGoyzDWDZqnz13tdMDt4LB",
-	"1lfPmCPDNnyWa11FLtu0cmglEcrqYScPjAricszZoCZaJW2nm1TMzp4x3gNMME+gaTwpWyRp3wZ+1iRK",
-	"5GkOV1DFZvEaNnrApkmUxiz7ZA6C3CgjKKzTN/jsNmYGWLOQWDIjtCC9Lin0NmoTC2WhbiW4ZLYSo5uB",
-	"DLRvmz9kobQhWym5rjXssu8MJsy6jVNKHdDvMa4KAIGYZDyFsDOBxJtB35SjOBf8W65ICTJYMBfJq2Ug",
-	"UeBtlXqkSzjSJRzZYMIRrWgWsgFbvGoVTnIrsfwbb7xDJpi/g1xes5QTm7qkKtjJu61SAXNSXFQFLPuQ",
-	"jSFIYJL5kPW0XmUweZDyIE0C96Prvvx4+f8BAAD//2pOBlCS/AEA",
+	"H4sIAAAAAAACA+19a3PcNrLoX2H53qo9p+7oYcfJ2U3VfphIcqy1LMkaKT579ri01BDSMOKQE5IjW0n5",
+	"v190NwCCJECC89IoZtXWRh7i2egXGv3448U4mc6SmMV59uLHP15k4wmb+vjn8Pz4KE2TFP6epcmMpXnI",
+	"8Ms4CRj8N2DZOA1neZjEL3584XvjeZYnU++tn/NRco9Bbw8bD16wL/50FvFuL1/v7w9e3Cbp1M95r3kY",
+	"5z+85g3yxxn/+oL/k92x9MXXQXn4+mzavz0+nJdPwozm1Kd7MSwaPjCxpinLMv+OFbNmeRrGdzhpMs6u",
+	"ozC+N00Jv3t5wqdiHm84n3Kw+YYFDLzw1gs5BL6EGYervpy7MJ/Mb3Y51PcmBKedgD3Iv00rug1ZFNRX",
+	"A2vAT3xeP9cm9/gffpYl49DPWeB95hPievzZLArH/k1UOo4XsT81AILPm7Lf5mHK+NT/Kk39STVObn5l",
+	"4xzWKHElqyMLU7+HOZviH/83Zbe8+//ZK3BvTyDensK6r2oaP039x9qSxLiW1bxnuV9fix9FyeeDiR/f",
+	"sXMOos9JagDsZ34OE5Z6HJJxknvzjKWZN/Zjb4wd4fDD1JvJ/hos83TO1HJukiRifgzroWlTxs/jksV+",
+	"nHeZFLt5Mfvs5dg3c57xOH7gIM86TBZiDy/Br/QzYjvHqDDOcj8eM+fZR+FdPJ91mDzjHbz5rCClTlPO",
+	"84kDagFaDKEp7zJLsnyS3Dn2OhetoeNjlMTD2ezYQpXn8B3IzTs+xN3wPWIfoHrAotzL5rNZkuYlQnz5",
+	"6rvX3//wX3/dgT8q/we//23/5SsjodrwfyhgUqaBJAzG52nyEAYsPQXaN+4BuIKX3CKny/hEEcPz2eEb",
+	"m4nOyFTgO4zoIbgY8B6xN1YijRdn95wgDdyN+mXmVaihPOiXwYLgoPn5cEaG7bQp/vXixs/CMf/pLkn4",
+	"goE1KJZTm7fGW2xQPAaBlPpSClWYWwz8tIGJCERWQwCARCeP/ws2qaF5Ha9jpxPSJjAIm1buLkSA3EwD",
+	"Sz0vaKbCWWfhW/7NQhD8y9vkzuODeBNopa9xkuez7Me9PUGOu+IL0IoJX/hE79hj+zz3vJE+zWxyf11Q",
+	"kn8zDjjJu1LTBcuSeTpmZqlCLDoYWnafh1OmyehUjOV99jPB3cuU8mr/1StO9Dsvv7t8tf/j/g8/vv7r",
+	"7l//+tf/eaFpTQHvtQMDm0AUWvhSGBC+aIvgNBx7V1fEp2BofSE3N69evv7r/n/tvHr9A9t5/Z3//Y7/",
+	"6vtg5/XL//rhZfByfHv7N5h/6n85YfEd8JrvfjAsZz4LFgVP5GdcQlD/VcKogv8hDF6cor5kCy1cJvfM",
+	"xA6+zPiYmWmrHznXQloF5Myhuyda7zof7JSjH2/gO4isEsZa+chlhY+ote2Wz/XV998blpMmue1gDbuF",
+	"8xQ9BviJfua87zHzHvyIY+ec8/WI9OaOkMnGyYw5gAXPbYSNq0iggDtQ/FCdppygCRtGcglVSCBm65AA",
+	"ZeeGgVYQANntesNgyskQPwqlj2tQ2OniaHSJPf04wB/4gYQxJ1uuet6DeCn6JHH0WHSkdtiN43Wwg1+r",
+	"reGLd5typUSfaxdFwXwKQBkevj8+5f/+eHbx7uiC/3FxNDy8Pjs9+acGi+IYhuMxm+Wkd15w2DKSCGUi",
+	"ISWTyGU5VsOhZuc8gxdfdhIuLXbgAnrH4h32JU/9ndy/w1UgxvmwDoUFg/mcc4KvNe5A6zWe/TwI8xOj",
+	"PBznSWpSEC81Row4gJqwRiaTcDzBc2SBxJsSHeAiTYIRZnwXxkErGYhFD1UH2fvUyiR4R06ZlUXnNu7B",
+	"N6GRP8o53M+uUVrNhkHAySwzz3x87vn0XU4zjkK+n90VM0jedZJYzuvt5eW5Rw3kIlLCbuMqAA98s9UC",
+	"RlOfNTwAOAGMjOPNfNLj60PBF5cVSaHajpBK/JZHpZkIN/EMgM/QRlCRbZz18nFmQSvosuTMAy8K7xny",
+	"w1t+6wS8zHI220nn5kVxeZPPswOjBQuWRN/RaqWtjCNQxnaNViqghiHnL7l5PCQWH7634q9dIBW0PVCc",
+	"Rcczhb4CWUrbbGJcQ51pSKZ/NUJWz3Hy+vLs3dGpmdWLEU5CE4+f+Vz8KBpoIsVz1fJCgJlUi88dTEaS",
+	"Cztd634CSxsZY44e+DhWOcUepFHUwA/xGxhHSG0ceFzKT/n1w3u5v78vPwtBLzRLULV9eZnWqNVpj4YF",
+	"f0V5eEy9YVoUiPLfFVAsIA35YH9/OeBT/B0Gr8tFAZ9PRpiKo6wB9ZbLEWbhQhz9bkCy3Er4EdVTFzPx",
+	"cdKcR7ZDEh8l3dGgeONBq0Ua0GQV5ul0HIYN86nqCMgJcc51IhZ03PNnliq8Me28chQSDPp0AwlrywHB",
+	"es3GWhs0/UxYLHCpINfjv+RyldLkXTutgmVgtzbI4vJQL4gD9sUiruBT6VjlodaFoA1kNDzCZh7dd+MH",
+	"S1Bss0lbo6jqomwE1c6lnJFagb6KwwurVXaJpm31AIxfkQPsj4My9DtfH6o6dJfrhNPZwQpxSyjiD4YG",
+	"WxH8eQvWS4v+cX70nl/egEsH3sHQ09oL1gBm2ExTJPQmitfR9TAzk6GDkaIys+gyME7ppwz4gI93P75q",
+	"/zaXhk+4rm+ReUOs/mBo1gxn4e0tV5HP+dShhfPM8Jscb3R+/ObNkXd8WLmblOFDx8ah5D2wlP8KILrz",
+	"wepLQDoYCh2WFvDj3p40gSbp3Z44yb1O+qIwYOjIVtufjggmFVHisFnB66SgKXJwUtCI7Umzip0l0OqP",
+	"LbesYJ4WL7PF9YHup6GwONWB6q4nJdMwj8NoICfCzZjxcEhYeCvuzN2sbCswbeGqPjmA2iZkcmnurMO5",
+	"tJlmBKVR7OuQaGI98qdmnt2VaHekaOBNC+juwp0ADTpfn5S37Xo0J8PrEJcTCW8Z+TP0S8BxZ/J7lUvj",
+	"L/QWLZ6+VwIbfjVO8wym/7viuPXbjQsLbUDlNImPvoyjecbhe+BHLA781IrXsEKLChcQYiq7IB9XQp2v",
+	"gd8SuO4k7SI4iRckAGUOnTuu9pZ0v5IUftGq4PCefNCrNDLgLmehfFfeLQMHk0mSqRsxoAKDjQd4JYjB",
+	"eBciH5ZuM7ChVfDdOV/YV3fRL8AzEMDjuDmPQ34eiIfi9kBYtgYC7MaPAXk+CnvWJZ2jFXUkeP3ovaY9",
+	"1QYeIz7OwIwqbDK1w4cm0gBs0BkruGyzJB4f4iN97KkeBWYSohBG45nwGb3sPpxxxRLYoFB7vEkC8HzM",
+	"XKzeYTyb58Yt/xrmXA8dsXESBxbi4rI3nM6n2iU8o+Ye80FfINgDrqR+HCTT6NELWOQ/cqS/eSTZDf3h",
+	"uiCsL/TP/drtszt6g/VlH60vMDhpSlxv/p0Lfgvkh6dDTzYp4MvUqaPrAZ9pLoxRA76ZWx8tJJw2ry4P",
+	"VkCUaomGVxQ4qIEJYTXcq2HqJ0UVzRdEMx1UuJZq40ldWYlfZF8a5Rd4ZB5L8GanAVgUcpk3hGuRxQwO",
+	"T+B0bSo0VbJrCBzMlIE72/UuC6tH5mV5wiHs8WYTfgf77D8OvJt5DpaYMKNXPjE/WstRWIiLmTfxM3Rj",
+	"I3ON2x3t3uR7AQviH6ywsOiD5KKB8K0c8yiM761HfZMmoJ6ZlyE+Srb/zr+99z3gRDk4LOE16x5+091M",
+	"fvzb/t9eIVgf/5KSDQmWiNt5N3zzbghG2/uyHaVZdnYjHDad5Y9kaP1hEPCzGoCTDAiJa3QS+yptZ/CQ",
+	"ktlfUrLCmjlO0kDXzgocEGIYdrTrDaNINeYMm9/fPR+M6V6U3KkP5f5o5ftLhr57Gcu7GZfoXGFYfBMy",
+	"qBwSCx0He0PNATEdXj5VN/nqyWE+zBH+WZt51hftSnTqS8A8ArUF8NAeMRA7oPzo3PXlvsEkuZhQUCb5",
+	"DtoPnPbTaD7wIEWOslwSpyxvXystBSQxby6vbcDkSGmQw5UpnFPq6fBy5HHpHXO2G8K7oGBG+Lu49t9y",
+	"tMUXOZwQuCfLV6GLFufC7/Akq6PMaF4TToFMW2kmaZK2IjkYX6Z3eTLaNboF5sksHJthSaNgAwWD8tUO",
+	"IZLNUUBlA3WbKKh9Nr+JwmyC3GLXOwZyLzFFXCwyRvAvoeEkh1whKF//DUE5N91AYKdXFyfqys5uJkly",
+	"jxsu7ZKlnDqJ78d+Dn6G8J8S93/96tUr2y4/Hv309uzs3Rr2yXdFu9x//VfapkDLFdGJwvIWOlkH+puv",
+	"0sih7bee4/gmmcfBRzrJpruyX77JVM0qB0cnutZLkJGKb6bdQ/3yyyApVjdJ8Fh1QQgzctYyyL7Vge71",
+	"/t9+KET9uyY9q7RsRb+4ViJgvhb+Nxhj+K6OvvjjnOuBcC0ovZnBUMqvTP2igW7K1SZ4wF4bl5RbXcWB",
+	"dgWM0Ab//b/CS/rH/33h/T9vwmU4577/+l++O/p9B0f73xef/r1aGLzcf/W6gwhXHO5ppHjWwJXAqMqn",
+	"EazIxkpHlxfH50eIcKNfjv9bBAzwY/DF7VqyMr41vkbh4CN7kxlQ9FkvIaqDgX35+TxlbxEpzJsnhJHm",
+	"uSTOwfoplC7RfVAHxtv3w4Pr0dvhq+9/EJtaD4GpNYxwkjYFucyDR5XOFr5encPO4kenIy2awsriUXcZ",
+	"prYHh6n/O+cC8gXQAxT1/mN4cfqfynR9OiL9ZyWUoAP1h7pwU4tt2PYHp23DA2qWcW54vCpdefhx5NGo",
+	"yB2PD1cOkO+XkFgiCLMusdazSA71Obty0CX5cXnYeCULkXqe4iopu7O6hsKB0ff1LKaAyg+vNbY+lJi3",
+	"QrwT3wr0W9OxZqQV0Ovn8sv3Y7F60jXw3jiQ3vI6E2eceaY+v/jzMQIISPOjbAO6tMJihUga+Q0MPMR2",
+	"xnZ2RSGqw4hzpiNw+/45TeYz+zM8NMlMahu/QqLPK7WQgZBptg4zmkIQNJ/hjAaHRVpq285bwhdocEcv",
+	"ea6bUvjASrBf7gv8Y6NWYU67ec/AgnUB7Y3weCEGa4OKFR5u6uvqVFOi+mh+Z1FG+ZfVT+rwnicWZYaj",
+	"4TW4fwbOdp/GC2yZp2AAD3GJxgdQTcpUUjHQ+4tcQyevLjWxcvAijHFGuVW4chlR2dGvq3jWzlxf8Ipf",
+	"z7XWpSwA5Vdu8/N1EaZdt6V2fNtGPbbL43bjs3Wn/W3gSbvuYr+SmCoZWnJ49GZ4dXJJ0SXGuJLY5oKg",
+	"k13942qfxk1HJh9gLSuQn60uFLLBL1wF4lMah3Gi/fpAldlLaxVUUdCAFiukoFZFrU9t5LsFUT9ldtKd",
+	"A51B/MdPj29kdh2JotLxS4WDm7D0kPnBCb70fQCFHIJuOgVTUKIewU1uQ3BJEA+MZpdtaH4w8cO4YTj1",
+	"/Mux+iFM5pl6s+QXmCgAQ/ZtmFaCXNqFv+RUpjAM/kk9MeZs5tmC7pbgIfyukj4eJHNbfF3B6KBlyEoR",
+	"NAIAwNwYV3tYaaUYmMr5b/EoXr1oa1wQOrXLBRzaBSDw8WIeO46IiyWr95eJP0c7SZhncsdr01IAS3ab",
+	"eKHTFlRoJmyjHThOPLCAnzqa6qpKqCNI6EWJlD6ZCXkLeJuJvdQ5nHn5F2zG5fkbfrWbpwZX7rA1BNh6",
+	"6hQB1hodBv29cTKPArTP38DHGaoYu26ZOMQ8dDzjMGAjOu3hDFIP+ZHdgooNTKF2+su7IinIkyZ67HoX",
+	"DKQER3/5OcNQNvPz+8x/jBI/aFPgTAHb2FGCWkyvriI3XP5OhQKZzHMDe6XQerXsur9ZBaAKJADNIxmA",
+	"tynfuaW8xZYSG7nKs9ZuIlkVu7RvRONMo/l06qePTq5KH+vdGtgjudOpjagDP/RNSYu6uDV6//GP0dkp",
+	"vyDwy81/thOx8uij6Vkw59Qb2WIXO78bkD8aHzRCt/zAFuRmfVGGwR2dBGAauRBwaoZ1DIrQhZoTAUcM",
+	"79+oF/0bXzpBSvsG0oFmxe/X8vd/r4cMlkPrlJ+dGatDfoP83HgdrV9DZXQgI2VUxhLDbL4G77A4Ezp6",
+	"CfIMLDlFO0QGiQqZQ9yvWZ/QTOga5lS3aLoWlXF8BRaWCtE4XWyOWgmpndnKMbZA/VHbMeo8+HVbVtmw",
+	"RHHBPOSYN5ZLkpdMP4M8hXBUxuul3r92QW2+mZKbPFce+L9Xw3XRE4qPRAlkkTmSq1TlxxKp+vEj0fGt",
+	"3UnDkU3zFoEewyemQeekKZjlHRnyoDM3ViwcOQ1vhENe83/9+2lM1wDxpzRb20VByTjmdBtUuxA2SbRA",
+	"GrwE4ISlWYPwwCkdVtUe1+JdtZpVLWHKL4udApxWmaPR+Kqkjs423OXOiPnpeGI0JimH+xVGcXQzXwXq",
+	"gswCRyuSHjuBVqSSX7+mwNRt7dLgZrYhbV0MBwae9BEclgiO3VU/h6w5VuPpYi3Ma2FxwP9cgOgAWT/7",
+	"IbAiQPUbDXUtxtkm51R9b8KJtuwTDP6LKk++grtw+cnnKX6UiVHgdOBzPTfTaiUt0rZx8D9NYIlpdx1i",
+	"PUrxPRTnYR5yW+IqOqkD95TsT2VlhmMvSZAy66uQm0nufdLl8RvFpOvAoDkd5ZAvpNDBydnV4dEvR6eX",
+	"o9KJf075BYFy3x1EyTw4Ii3q5e6+TNnFoTMfg/tw4KGViabXk9C+HV4evD2CR2NtFvvlR4kH7c6E0UqQ",
+	"x5biefhfcFzNY6xKq0L9p4M6VZaw2ibo7l6kDJda4jU8pODbVe1XPR1c6YPIDVf5dYypuOgDWJxpDMiX",
+	"Uf4JtsrFZPlHNWTRzDQcPDoH1wkFg4Oics0VFbUr64l8NJpQF0kuSK3QxF+6SdgL0QjaahtYNOsysji1",
+	"loGpVZdxedPYYcWiWZeRnfMZqobuowNRvGV+lE/O0+TGYLqQuZbeW+pFqKRP6iWcDwOMZhpGXPAIM57T",
+	"Pi2P93n18R6nUKUwAgZIwOLxI+luE9zNo0kuyU8NpSOq49VG61woohivlE4fxNCNnzHXShHFQrQTMd1U",
+	"6TQvGMax146zFQQymzkCmQRKEwyomTN31lGtLb1fMa2YxLTbcvjMM4tdXH3kYdMUTxHx9zQ2u2UC9zoF",
+	"3rVfXqTm3HJ/WV3cW5dwt5VHq63mIiZg1v22UmRykQWT+DnBC5hCT+MdqMslYY5xILX4uxonWoEmW2Ft",
+	"Tups8/loiq2GHnw/Px9fvr36if9BEaPwxy/H/23UDP+R3Bi4bFPZQHzg1goHCgz4NblZF3cw+n65Ax58",
+	"X0xmtlZ32MTmtic+tm39YVk/1QfNP1VGSODWTaKTnyTX7A0pH+UVgtLVuiUpUp1U/Up7kwvl1GQovBij",
+	"saLL1L8SRjadKCAttbSc3lLekjKvdw3C4hrXZTNURMBhP3Apo7aFb2M3FIfD747l43uWNpNAl+1qXjpt",
+	"S9Zuo0Z/yGX8uqX7IiGIOgU71YzUMUmGen50enh8+jPU67k6PaW/RlcHB0dHh0eH/O83w+MT/ONgeMp1",
+	"LfjbxF5Bbpir4LmW8qx2NRyxmAQDODN7CtrNVpSQFb6Mr/uw4nIoe/bE6y2vpvUyo61NTGRCLtzmh63a",
+	"5od1bTPyx/dCT3nyTWprWdUWoUxLzDpVLryciAIUoCUB25T6AjwrRny03aXqEZZdjuWY6goTw9QZZPrQ",
+	"i3XIZuLdBjMfOpqtIn5DjNpAL+B0gm1REFOZaOPqYTGiQavqaOtNLYyusy0RAgXsKhECOji9GxYl8Z28",
+	"aSxV/qCtZKLukU/ALuCnAeNTgY4n8kiK4LCfrkBSHZ++OQNb/fACSs8dXVycXZjFkzaO8pJyorHqQddk",
+	"kvj+9E5mknTNgog+LuFoVh6ho6uZ6Nzg/2EAgF63gzOgeZqCS9UMyewVvyiwL/Jf3/F/zaf4DyxwRAY+",
+	"nXuVOptMn6KFNyMsVBO/cuIZ2lqMdlX+uTbyd24jF/sylkRNcj/SHxYwTQoYUyCnAkWwqxlf7rtY1g0c",
+	"5nye3jFDAElmr9xoc/PlH/ToEbTQzWD4Xe9YOQAO0JxL3wVbx4cN8TLMWwclX5RNl3fRmn+/v2+itwaI",
+	"WdVW3FfryxG2Itg4+DCLQYGX4hoMJ5Wd+2D2bXYVIPCHkAV4npWMgZpRHWrwDjlfebDJQajRK4r4yiEx",
+	"sg777K62tLGbydWSMMfymKFgpeD5HuLqxgZFkJ/YudtbIKG5eBHctTGBD07Pf3WSsQ544fbuRyOK1z8H",
+	"hCuWWpploAPEpHjq0HwbgsJmeLX1H+4O2cxW4tJ/YClnf/XXUNoDRE+RbRs99bW9UAesbfpwd8KRKh4/",
+	"vs+aJ0E0rjwXanqViKLVFuBN/AB9lSC7tttSRlGSX+VhFP7eUCNULghvOrBpfMOBdw8UDaL6b8aHykpI",
+	"X13EgKuA+WfGYm8fnZlfGlfFOV/DCdTdHB1PQPea47y1+QjkLKs+Apcn7difZZPE5kGoPpchLehRwhlv",
+	"KIW3sGSIj+RCmvuiQo6T0qeTzUjM3noVVGSknWcF9yvnYERHHRxtJK3W1vViqcSFnAslhgKSmywI7Chr",
+	"RlUzakbNeLkMPrriX5S0e6+WaJ1kqyxUpGpGx3gPLLQwizgsF68NRi7TI/9ZxxoqWKxfKQOBw5GGsQQr",
+	"feEmJL3gPU7CaWjATKeQVMg9ynVtPoDxag8qzwW75RjhohIVgyGWp9iRkGOFmhFO8IsfzZkrFy+eyaMo",
+	"+SwePUtczYwzKwkkaQbwg30f8kpn2MfUD5jrJuibeQr6htsQxK+ZVhSYMWdMzA9n7BLYXgnOLZ2X3K9a",
+	"VQnDPun4vAUWiYK2jDYJ9XkJq0R1jJpdgqApoaaB0jgaG4NbpfYqVc27mqt3ToMmNhal2ncbXxq7vDMt",
+	"8q64xJvg2h7+BEiLl7/aM1hLlFZVg5EHMdBfyMRaqqMb2T7mcGjJ30j5GBcyMHQxKCySKhaAZCichOs1",
+	"7xfyaixiwtlWI0vVyEzldJv2uUihcdJMpOuSJVHJoolayvlXDMZlOYmjKU0LRJM98SqXsikk9vBu02Ra",
+	"VrFco+NqRcTFukqVw2k7FB7ox3dsqQpcyFANAbiVqlciuFd4lZmLaqWP4pneYt5KpBMcuJ5KIMrcX/wv",
+	"OHV+4irMk4KIwS+G4IDBR6Ua2nXTGBdDmVWZzKph1IkYmUL2sIWvH2p4awjIaw1wjKSa66Ly1ZdyWCpS",
+	"tL+rV7Xj/9ar2r003l1CLgAt92aQO0oZBE1K3pV5H0if+NDhDXEe59asu3FgnEUkaeSzlHapvwt0qU9d",
+	"oRbauJlCbDypQFpDFkro7xQsJw5RVryHKRviTY8dbPYKBaUz8RHgIaZt9fiaZaKuegbZVdRRH7yY+Nn7",
+	"JGWNtuqUngmmkMStDADtzPm//bhQ081EC882owashU8SMOqJp4C7dPWvL8gdmT/rWVDdlO4Kjkn/o1am",
+	"LlCuhF8FxPWlDMol7K0zmvNJdMFcwe1FKlI9/N4S3nkfQgxZ9ymKKH0V3w+1EVWqVp/f4SBLMipjIp0h",
+	"mABKqKNfGktZDpakgsUShtovxMLdsprRYFAXriWpI8o1r9o/oJSB1JKdVE9gWkLP0oFXkdGal13jdk+m",
+	"3jbSooG+ND/CN1yPwxuV/eLinolTnjoYxNMwELGr1OxmHkZ5oTZSnjklC+YzvhvmT3GYzOqa4uiXojSN",
+	"eiZIWACFrVRWgBMX68DnKoGz8pVys8dalfy0feMh2i9epdvrM7qDVdZt27VN5dG6u0u7inet6/rk6lKw",
+	"SaBpop2WmrMnUjMcNcm5RJd+oXYm9GXG+2bHtsgDGZ8IUqbgyzH7LCtUZiSMVlFJRE6Gu7pL/TE7Z2mY",
+	"BJ2WluK+A7E8rtI/ihWW65u+eu3xu1OarXjdJreViq+r4Wgh4Kux0A9fMITiYKEoVYXIkpFgbcn3RBRY",
+	"iMVjbkOuvsjXB2E458psrupZhcWWW7z66jJpfeW03BzbG0tkVXx6t6Mq1gZqWi2HWqsoZrXKWlRPXUpq",
+	"M4WgFsj20oHKPyxB5U50uNbaURDVF8wjpsnujqbC2pA2Ic1np7uj+5OGUa4PTAvRB/+k7StYVaDN4MWH",
+	"q6Mr/GN08Pbo8MoWfaNmXm/RlMVKkWy4KkhzHFhXbFhdMQ+OFAe6q0vnQDNawKZvANoCXLY4cnoO/Fjr",
+	"8JRVTwqkUBjXxLaCbSptYqB8p4Drej/bA7oOnWYnfzEm/xfk4s+MOtq4OwnYmb6OIaW8eyoWpzZYMs8h",
+	"b74jWLWtnImOUqOyxmvbAjsJ6SxBqF1CZmj6YivFhhuwVtvJ9mCtjilGDxH7MWgIOhyNjn8+RSl5enY9",
+	"Ojm7HIGQHV4eXZ8cvz++tMlMvpLZhCtwyqltdb4eJT8Kq08j5O6LhEOj6OH+MLCg30VLrFhR8CJwuzDq",
+	"odPtGw0hSQ916f4E0rDskrXQaekGQ52kL823pBpnLeOrAX302Ms6m5v4ccwiqyGePoMJ1JzSEQZvzCci",
+	"RrCnLJZT4B1mwUmWMmj4U9vu4dsSW4fu9n3j4MtseitMMW7GEgkIBe4yXgw0NDSKBsgXYuF75kwYkzAK",
+	"UlYO6299s11L9oqZDy9ZWbeVcIEaQGU72+HK75WniVY0WSqpimUGOwZouyihg0wCIQ6Qnq8bjn4NSVSG",
+	"+dEsKQV4amrZilKtIBJ+tL0VtOJAqXumHoobsvqtxCmz6NMAoaoVo5QrxiHViMiMo9qvnuwApS6gVlqj",
+	"xNddIrCymkp6qBfUknmbE/AdwWbeDWfOye2tu25A74HGXS7EITgx3EEqOUe96lw2F9TKFfMhZAx3x4uV",
+	"Z+GhLg1ItoTi6JqAqnj1XYhtdtmx6tKwY/d7l1nOKmLSCgg2ZNqplKAzZR2DSnXtEU++GAEvCKITprKG",
+	"tLheJx16KUm72gJ2Fj+BDkgpIet8qykCTRRIG0turiJsRc5kngAzBplPH19PRQrtCD1QSwuXWe4JG/JJ",
+	"mszvJhV0UWkkhZsqPFCcHzc8TGxVkc7qpYuAVb58lRFhGywaFaI3mzOM+Gt8Ixien1+c/YJGjYujfxwd",
+	"XOKfl8fvjw6vz64uzRYNMXzKdZwH9ix1u+7Wwa1S0zCy19ypQVMp1y02Suz1KAJNxsq1yOIGq0upAi/B",
+	"0WxvrgtawvctYgKCAJt4gKW+6tiOBSs1gxf1eR32I5wpsQe+nnMpHuaPXXqPZB8nvHsD5R5HjESkO+6d",
+	"+F17dUzGSJaa0gIrMyvIamDSk1vR+TYg87ZULCyhaSsiFyxdSrKLI3q6vj49u/54dvHu6AIlmfixMM4X",
+	"T9tc7l0X8m2gm/VHl8MLEoDDg3enZx9Pjg5/pjfz49Pj0dvy8/nF0eXFP0mI6i/pMDQf+Pri6M3Fkehz",
+	"caRNos8Njwi85Qn/rsY85l9/+uf11Qi3Ant6c3L28fri6vT654uzq/Prd0f/vNYf9C1N1EJH50cHVyfD",
+	"y+Nfjq6Hl5dH788bxXqZjjRQaznQxLYvji+PD4YnTaOdaxddU1R6DqkK5G1YFcACC+aOrASh7vIUOEWP",
+	"Fy6Vg4fpTZinPr/vVyK6aiNyDZjPSFo1FFeUCzLeIawp+IbS10pMRV9vZOWq+ph6Zap0zGyhBOKjiPkw",
+	"JNiDMmEJpCqsZFah4KmCRSXzm4iZsq3MZ0E3faia/UksXx+pgfk0KaTir2uimPdHpxUa7eDUIv4Wrd8d",
+	"n59bnuguVWXuiok64n+/ZwClo6kfNpa5SjxsLe9qU+xlCdLz+c32MQ/H2dksPzOZb/WMWGLACb+bJ7Oc",
+	"LuRU+1kMYp5j7UUEmgoERHNLvif40jqA/SYnc3bD+Cb8ooMcwkHggf3Mb62GpwdmOcwhJrPDmB1sgW8u",
+	"UF0r6xT/uDDo7RsXK3be8xZIdvNZVMEFvth3yQ6h3IsL9Cv5Wt4Vh/KI5fCfbHMkyptwXnkEvvN8YvTg",
+	"xMU0j0+9aJpMFLnAJNLofIuGE5/r7FzUoFe+X6nKVpufoCCRBPNPLLgK2nIqRqqvB6MTG2GhxwVRJLnD",
+	"UtCnXV+IbtTJsO5VQ4o/6Ge3XxYpbfxYnCx6OIhq4o4GS/+LRLI3aFmHOkS20GXvVjbxfOmbK7FqtQ/b",
+	"dk5gXLCdLxyr1BIGFmgJXoZPKvYqo4OklA+2gtkQYNJoZMa36jATw3iiy0bsymkSMTdeRWzkIokcKrUI",
+	"grJ5F8jPdqhRiyb/AhwBJW756b+TxKRzFlAozkrbXhvubI0oEajcTYLQmdbX/2QI5dZTVglta33F21CP",
+	"c6hjOm5CBRxPLN9+6LTmrTl0cX6LHPqFOCd5xzj7eIpX6uHh+2PI4v3+6P1PRxcNF4LmxKuTInmo+b3K",
+	"PaGizEP61fS8NaU2npivlL1OS3UpUo4hF6JkE/fskZI/UoCMdwbJRzIMKmHwFC4SFIRZ0dd436WZmvZp",
+	"SMhWC4+AJKFdYKKbzVYBY8OqvmonzZcn6VzHGWlHujodCUvL0QX8RQVfr8+PLq45Ml1dHul2mF94m+Oz",
+	"01EjavEJr6QZoYxYDelTfoNu0koym0VhUXlOyAbvlDJbS0cIqfFRkTcc2layOtX2306YZZAhu7LWJZD5",
+	"ALBJkeGD+jpkYFQrk7PY2VcB2BVUGKudlZPLe1lp17FJGSgRfSqmOzSonZ1qMTgN+FO6EphuRX46bci8",
+	"iN89TFZn1l8oNyRHrc9+io+vtbsC9bblL+2SjNKch3I1KSZpbPsWLamBl6p21YGIdPpxSDDZdmDd80ry",
+	"nULFRJIEUs2ksbz/CHe51HjpBf7jgP/nM2P38N9pEueT/1zw/V2jZEO2yU+t1HSecCXn0cIzGy06ihFS",
+	"U4NO3YEllMmvLR+AWJx9d8Is2qxvrEAMo8CjMJtVxkQGjIMJ8+Eag5OPb0HZMDhzqN8w07LMeiaRmnkZ",
+	"3H200THEWUummnLkjdHnWao8GPz8hQpSVJz1s6L4KCSXYeQw5GOigyQ239E6p2S4Qut3LeapCcilSEGD",
+	"xRXyMCCnTErpiTI5R3mbfr5Y6rBygCltw2jAs6eZWKNNdYHElUH4wAZ0+a2lr2wwpuo7b0naudg1sKrZ",
+	"2C5j+kLsBPqM3yd6A+vTGljXaPhcMi/Mks9PX63U9BH9c+0JeJwK1Ig6F6pCDb60jv0Y0rVCuoJZjjwb",
+	"oG8CfPPq8PiTKOIkZF0mn8tPH8/Vm7DLm7Fckbw6plqWUfCLz4u3eEvOtl1vxPBCsI+FlLgEVmOKC2iW",
+	"I1GI/tWsnVrSzv2akuqOMHyQv+8P+MB/52MiNGnaloR0hVOp3B4BQu2hCpEBXJbBIwHqZfwFikFpiUPp",
+	"BBYJ/isvdVA/S7MoyEAEg1vGIZf5XFOMWHPKuc45YmD7AYzNtWtzDfbuIhi3z7lwSulKzAs6ODrxijZi",
+	"NQxuA4CNErVwaXLxfkxrHxSKIrp36PvidyTv36iW/RvpExR+U5JfaFb8fi1///fK9k+K6YhqdLTW0xCl",
+	"PHwI7cDN3IL/l9gS7tbXYBEW8KKjLOnNRTs8XHm0WYkwf9h//deWhLoL6F5ApC+RSGl8gwZWpHjRMKQK",
+	"rhZiuOBckh/NSskBrxXziGnWNUoIWPmxBHM/pqtJqOrK2d7JWmmBtwjwnMpHi7mnMXWoI9YPOqO8ohNE",
+	"Gd4Ih7zm/1odNbhJdgD1oPBsEpGw2r3PJvoXQNUJwJTRM9zXWhIWp+gEtdBCfoiCS5bEr+qM2yXIQnA2",
+	"l5Qr01mxTSORZaYns9YnY45W6MWnPR2XtijfIuuUAR/e+tnEdH3kF4uJPuRfssp04kJJhHH+GHE5MprP",
+	"MMf6wQQvxOYJuSCGyOoWfQ8fwOFy8yCaw69hWl6DWcXmvc79LOPAdp3D53oGdahwkXU7dgVhhilRdTqU",
+	"59f5rbkMXRuC8bOJ75gEkJWDcxXNDkRpIVFQk1Y989oXYBByZNz3rHEhahGN8FtuDbVa2+LLoAQnG8hP",
+	"krswbrbgrJ6+F9iwtNtsIcTlHmdtsL5gd2GWN1w3txHcbgLawhi28LSk7HM9NN1el03CWfZc/SBqfiEb",
+	"lObrkDI0menYRGocsu2s1M/HjRhEEKywCxnJYm5Liir78gaLuEHDuK0goVyAdvG6qk1mDflNRTJTWc5Q",
+	"0DBYiWQtbghUhUDjAaRo4DeRZKoyoEIupxvmcVbAUmmb0JMJvlobxLuDOdhOBFzsbDaNymqdrcAGrtxQ",
+	"33yT3LnMfpz8Q0pd7GZeQqhr33JuIATxzl4U9aSh8DVV9O7kYCsyoDrvViz9PfVUyRUOuNw2L/nt5eW5",
+	"R408kO6aJxAC370W67Wv5dAsTfzJEeDNKCTrd9qcSuhBU+K8bO3sRGDEgIVx530tee3PR+BcdH42wv9A",
+	"FD90tUhISuOUNaUfzMjHRDx9QEFd3h/wqltdMP+BC3EwgctsSk3GUHpaqEzLvrDxnOP9OImFT0z0aHZ6",
+	"AVUDbTupyZSTl8qCcK0wvAPHgKITFATzrq6ODz1BPoONJ8SdMD+iYtJtGW5Z+pbaouvVDYuyZjcibIOE",
+	"yHRrFgkP52oYnA3DOMYCcH6W8yWl+Q2n1vacjeKA0SssQzumN5G9V12S2CfSB2XiCN9jMA3DFq2QY4md",
+	"PAwVk5cjk/VrJ3atZJYkkT3NJt8KNNDfXH9NwpgFlqoB1YK6pqx70EbFzhYuYB2Rv1K815j/z17DQNQv",
+	"0LaFawnN+lYKRQan7Di+Tdxo8kLr0FzHPZN5aSlnKrGDBUFSyXFrAEmReMlYlRFUghrGqMS7BxC7zn84",
+	"PlV/ng+vRpZoXvqhkIajo5M3b7ksxJjg98PTIcX0fzz66e3Z2TvjEEKyW9PACsFP4qGy6tZctqL3VZsq",
+	"DSUz6sN31ayxvVEr0iWHUSt4KFIWNz5bQqPy6WnPyjKK3xdFwNDNV0Td3zyaN6cn/JnNr/IwCn9XCr1B",
+	"rs3m3rxoVFkK3xeUiqgFx++aAuHv/Pmdk5Nl0aW8noN5lvPLK42jpRyQtI5bz7TgDDgmS5qBaZI+tm6e",
+	"mrXvn08d+XCmGM0Hb4rUEf2S3IAjz80lJ6MSjxoMSKMRnt4CBeDRZqUStF5BWK7ZTgSkyNRo4N7h3Rh1",
+	"qVUnNW5wBScX8JbJ7UwBttQAh6e3dlov0mqR50JtWMiOVSgWlsQFVn6HAUw2rkfcUgyvysBWptG4GjiO",
+	"deet9lms/NZeNBQccFsm77Yrs/FMTiVhq+/djohwxiuI39EQxu12XVOh6oEGfnw3F153ztrY6PBdRrcP",
+	"6ixcwMyp3cx3aaEIHsFjiLkkRHBvH/ZrdXO4It1icHYypPQr/7x8i0FIl/88PxodXBxbkgXZa++WMMpo",
+	"uSp+qXkZGr3wnf0y0R9DeWaan89/TW4siA9fTAtyQrV/JDcrTQXS5X5lhZx8NDNwM/5l4b3Ks7/0jWYe",
+	"4WLZvbamwF+V2r8ppqaq6Np4CYx7IK/BpsihO5Zr31XCmIoXSiwLcRCj5Z3IV2xcdPXuoK/S2DVv7l1r",
+	"5NoohyeNu0fbvYi+gsaGDi7oaF6ZlSLcMLzFh5gQ/eJECZCuj0+vzy/Ofr44GkHo6uHF2fn16dHHI7QO",
+	"YoBr8U9KH8b/7/SQ//9PGCetN7k+Oz35p5EhdLRbFKaJssd66QbFZct3r9pFjZy6CtSB8XAdMcWSOggP",
+	"2epQWEcHW2lAjOZyqx9OTSuO/UIY4ySWcF5SBJymkEpDtzkqx6BAU5m7vNku4F+RCmA8WWeFAP3kDk2e",
+	"aQpalnqdsve7MC6Z599cnR5cHqOUPby6GP50AgaNw+HPjYIWBpHw6LRznN3ApuV3M5CXSuS94fsC6iGd",
+	"ztMaZClxuIFqqhcBI81nZpqUwwO7ciVMslNydX7GxuFtOC4m8f4DHFo4a3gIfe82jHKW/qeZTK2AEMEm",
+	"WxNlsmoj9TZHhyzkbUwFsPHQVlXpp1SjcMEYFb08+joqgvqU6rjlDsA1eDywgWbkB3OWcMQ25ZX/zI/U",
+	"myYPWE+cbtk0Fbn9B3zBELQKt2GxhAIloECOd5smU+x3E/HZIUULh5Hmvs6vvZ9Lxk3tLiL8oaz1LlVY",
+	"nF6P8+X+/v5g7XVkFivBShUs3Ll3UUhmhRenIgN6naLomxET/Mz7x+jsVJWQUR8DNo58UX9Z9C+c6S1+",
+	"kKDYPk0dVZp7pOe+3vQSUgZYzYI3nDRsrK14fS+TozAWi/dM9TsNmRG1Faatp9zZSBWfadodMiLbrvDW",
+	"RiPizp5iS4sl/V+09q5L0WQW/PTYYfBLrVe9um9H28Pa6wML2JU32yJRzRo6VL06mKcZMX2jt0eWYIw4",
+	"aInw3zuWF/WyZkJpw0t3JTcYCFS4tD+C4+U0Iasyb8X1dfWohYJTTzqG0Yli0on/wKeBmaWCz0VyGI+F",
+	"o5oQoPFfcg8T1Vse9Z/kImGUR1+bD0iUND7k6KCKGhZVYw/gdnfE/9N0vStGqRVGLtfnldRaEuuaqtAy",
+	"yWjiz1ivovUq2pOqaN+4gvRnlbkN9cj/ZCK5qYJBhwoFlF6y2Z2pNutCVsAyRlhMgdiIs8ypqhpUewah",
+	"QPOkmtJL56YiEl0PUecaO0sfWLqDH3GMehEP/Pm8qALYdHvjmkYCJAuqhxQkIv0X8FhqAslvat+1JeFW",
+	"ZTIJ8WyjSpGIJqLOBsbmwvVwh8Xguh54lfomqgYeuNZQEQz0hzFnAoApL/lXjnrTWYcaZNhPuLc5n7o6",
+	"UOyJccnxnemVq5DTkKk7E/Y6lYBC3WhgQLO0lOmPHO9FmAsuNd8AZU6DspHLVNcUZ1wIKhd6Z6cCafzM",
+	"wXRsy7dXq/O0lKCocLRqvcLS1kuw1zGlhm3q/FtZXBlxdI9KVSfp4Oz9+cnRpZ3DlaodXV4cDd8Dt5Ov",
+	"ka38rnZKpVUcnYvEqaU8qi2DXpYlXjXqJonPNeXEUDkwiWV+P2MDhPa66pB/7FZHTM3XctTZAdZMtMYi",
+	"lTCvrDouqQk1vvFXpm3bhPVRB++YXSSlHOqAOrZd/yrNa/MLwjByDKk2GD8K9cD4TWoZxo+F4mEujmjd",
+	"Dbh0GOAX2YwMXX15lnZqMXuZ0QqbEERQ/UEKJoJbM+E3lPe+Di3k1jahKFt3a0lNdC18TFc9bWbeYXdz",
+	"SAVuBuWRbu6LDqzgs9oLJqn8ZvAVYvpavPd1BzOl7FtBKsF2l7WmZWg3qirJllyeOnpIkFv7rT+P8vM0",
+	"TGQhQBP5YyOuNVMrEwG3evMIS8YI19O9/Ddo5R5tRk6P45lsSZm8p0CU1A0mHwjIMkrB5XBp4MslJy5C",
+	"UHCLsfCkklGlk0Vl1S9eshywA6y5mghXsS7ux+UohsI/SAzlgdcb5w/8EpUlVOvH9w6HP3O0BgsvGDvA",
+	"Jjfxo9vP/qMsJe6IGjLP8SXV/DW7vubh+N7qQwffClc6J7dJjYd2YGWZ5vxocd5v9Xqo86guDjCNdhi7",
+	"fUSuuaiH3JIfzOSwuUoPoi74/E0BnHy4C9ehMsRvU4YRWw03WK6et7ToWKrYVmiYsk7MQSggY6cV3jCu",
+	"1qTDOUWcIURR1uHPxaFM8hwd9MZJch8y2TyEU6WfpNcvb0oJ/Iq+/iwEH0R0eQ+FC78hFQF18zjyYWnl",
+	"HK2/5V8VZr14ubu/u4+IOeN6xSzkP323y3/ElEL5BLe2x3/fowin34VfcX3qgwkb3wvTGONjBVzahupJ",
+	"TcAVUqAwNBFLvOXrEQY09T73iNatge5qJYxbN34mEkNgdBgVpEUPzBe4AXqvgLN9QcF/v/+M8JNZGHA3",
+	"r/b3hRqRC0sg5r4cY9+9X0UR5kyJ7CaCoVkuMBCLzqUMFPqOMvD7/e82Nu2wOIJHyjM5kQvRkJfjOEfu",
+	"bD6d+lDr6QWHlYhjeyH96/8ldjCGw33xCXojMkRQm92GCT9LD3JoFYMBUZmhy2d0Ir7bD6kBmobvUB9I",
+	"ztm6U9Wwba/wzvbYvlloFjbt9kI2WOV2cXGYr5zyc3Nd4PZW1Epr2r1ardv2W8nej6I66ddI3EbTgzpB",
+	"D0RYGGcbWSjv4PzWkLGs4ADhHcFXTSUHNAL/8am5wYXtsJ4LdyhwnMNbP+pWLHp4ueeLlPw7mPBsB119",
+	"s70/8Gf9t6+EZPAAUke3Q/wdXixEVQKq/EBp3bB77egrVT5oBBRvqY81gmDZDWUqazN4aA1EkQ0ishDY",
+	"ta280BUKuuQVR7ic/fxTDY9f16E1mo8hPvp2HkWPHoE00Atb1IHHz+v1CimCk/4RPFplZjyc8nsThwI4",
+	"HqfejR9IpxtaxncrX4ZpFW+S9CYMOB4T9it8JzxpQjOJ8aKs5SdIWKiKZGDlGfowMCDGJ7SDcZWsfmhk",
+	"f1kGxWmEPweKIz78lJAEXgkyOFQAMqBJI7QgiE3CvAyNr2sUN5Yq5PW1l9gALbRnA45s4Eq8d6+LDegC",
+	"chbuUMUfLhXl3ygNZ0lmUL0u2ANvAa7boGFhaxFPo2assIlZiMWIpIUXurtwCTW8hSfItW6VuEtxewLP",
+	"cXV/bqTOumC1QB042EtxchKNi9+aMFkduQMG76VJ7otC7WZExu92RN71hlQsDr+Ua4Hg2xpcCLIxR3YP",
+	"vTuyOWZbFbcEmj0Qvfnl7DGjVKBiDn6HGGO4V5gEYOWVOSGEHb3knVleBfjEBMnnWDrDNFIbweAZUdvq",
+	"JS/BgO8P4aIJ23VKScpeW0wqfahbxKTCmp59GNgHEezq2QfkSvYjjYfoP3zdC9g4DBoYyRDbg+UB0lGB",
+	"tRbrTvBrKqaNEaN58wz+Cd5sOO5A2Sj5Dnln+KC/BoEvNucrfJRZEsZA8gxff5RNU+Nb+E4U5qVHOHSw",
+	"E5nPTGyCVkVs4hB3+JEzFwnYVn6httXINHRANnIOjVO8+v77Eqt4uTFOQWAQfogSQi3aOSCHeHpw0sEb",
+	"r8owPRG/BN1W0f/rzSwDLIy3yTwOGi1DdFgFHpKArvIFCUYjxWu0/rXJ1Aq0puahgmnqn9Lt0k5iZP5z",
+	"JyjrJVjuZZP67uowr0JW7aIQ3UIftpkeNi8Pn44KSxZZDRXrlNYkgF2pcUUyt1XGOsnFZ0W9z1MqPgmH",
+	"2Xp5+03yl4pcXwmLGUchX9jOmK/pD/W344sPtfcOhrveAf05hhelWyr+qT0tipT3IgXvwRB/jBMPoi1Y",
+	"Kl7fyCOxzHNo2APf/Y1IrcnKbtQut/YxSO2hv+LWnn8K2BTYf6B+a0D/4tgr+J/Mgz3df8zuVaDqmsq8",
+	"e9KHBwfxwjjLIbbBgMX8s4x1XvOTdyNscSHePFbFMbYGwVreuQnAepilOPr3WtTJlx05xE4yI59eIVm1",
+	"80YP8h0sWLwDxV8526v+5Mb9ZHgi1T6GfrvekVaoV5W3LbG6auHrMqKU6267M73qSqy8r7rVrWWB1R31",
+	"nLDGCWsgKqgC8chDRPIAk5r4Yg0lPtXJJaXq12WC0X/sRjKiZ4VotMrFQDtFdeqCeoSzdOmyYqEirWB3",
+	"VzrSl9dCSToMtpyW9F311GShphKQqvQkUMqRokqoYaCpLIzvFS3BP7rREPQoXtTYOEkDPVsOmMP5WOED",
+	"ZhHEKgwWQhnxgbpSCE7eTBnQZMspApfYU4KZEsT5lSkAcKUd87GrE8bvUUYyuy3rkFBYerH7wQ5fYo44",
+	"LXGejM46Udz5YdyA7Bc057NG9tUhrAKLg9VZZI+znkVPTLpDB1YfNsNppXQlSar1nUalQjEQhuNLDFFE",
+	"IzE8Zzro+PIiNHCfAsl6zC/u6xpkKrjeiuZNGL7XZqMpsu6gK1Ezzh8qa8y3jveHiMI97m8X7ofxDTwC",
+	"7AhPFU4FlV9cbwyim3R5ES4xGRb9y/JklgkLPN58tPrCZZo5plFEVWD3K0NldisVVTa3tZeHyn569K/d",
+	"IKoQKshA4JAnkKiJIKrogAEaFrfrMQsf8EVKliUXqZslysHDVxiwlJJ4wmuUn89TrSY49QozCOIOb0OZ",
+	"YzYvatbLqG5JQsXVW/XlyEX5af2sQfSUyUis/c9CR21+XApEGui2ioBebmYZbWgYxugQvdFXZxOOQfnM",
+	"2MnnTCBybQQJ3QYeoMu8KVv4Ec76/La5lzfKKdFJj1JvWs/kJW4Vb3Awxh6mgRDRwtYTh/SjGMpcam07",
+	"YGh9XG64ttOGucSJa1N2PPwItsepv7S7bUIEdfR4EJVDqJ+/fshZ5I/v9/7A/zgoqt4IGmoqQ/mI8Wtn",
+	"1bM0plVg4hK3Ut0sw+QblJNXsT/PJ0ka/s6EMPx+MxNznJ4kAco+zn6Szywwq7pVrJU0gb83qbeEdGWK",
+	"gQgL/n9O1HI60smxTi9x1oFMyoPZCUWw1K0jkwowekLZQkKpIawildNRI6FwpKuTCX3+qpu+zZdDmFfa",
+	"52ok0jku30YZarXrIo6B3Sp5j1UwFzJLLhBa1Om6x+/d8A8W9DJsi0jTpt2H+WR+A97FEtvrYo3aVOjx",
+	"NxBbv7mJrQ8tYuu3LmLrg6PY+m1LxdaHXmxtvdj6YBVbH5rF1m9VsZUzcLBDHU/8+XVPFBFquwCLVrI4",
+	"q4irq1MPRXng1VQO7EBHqjyClYDEejct30Ru2DzxsvtwJtfGsTR9LBaX3N5maNgxLIWf3A+vjVVqm6ej",
+	"Ouc3j5Yp8XPHGTcRP0hnjiVbFnjMy/oAn02aWhXVGWysZbNLifw14lecCH6CullN7EiScDtPKnKs2zmS",
+	"qKDmzo/IybfnRt8ON8IT73nRn4wXaYS/fk4UJXfNfCjzeBNOH3FNN6q/u54kdye8IWJkz4a2gw3VZhyq",
+	"J5GIY1qElWRvw4ifU8PE2LI0c+PDjcAD6EXlTy07zxgIXg9n09bBd2VZCHXoupAR9TIsAuvkcuY4T2MN",
+	"z6n4LeCld4eZlOAV1I9FIbVgwK8ryEcx2ztl78aiplCKlAUidQ4kmRXlKKCgKT1GqSlK5eh2Lbv1b/nU",
+	"VNNwmRP/CCUc+DJoudZDTvR6tR0hXKp1azlsmj5QRXUbV3GoNVtkJUX/9UpineW1CWGgu14CW/yaUPQp",
+	"+tAEHofwCmXdHpU7tIq8S6w93ECr4HlUK36ZidLFohYl/43zM+HQCj5TAksKlyYqnFhpl5cqKsr5ZeB/",
+	"UVWAH1LMkbvwnzrxs3wHlcGd40NIZw+ElgpvkfJetGLfxK0kL5syn3L6eVAVVFZwVgVAE1nsW8FhwgEh",
+	"yxzjvsCHAB7aSyoDzWT00uKNdqDRj+JInqvG8EQCtZ2r5exLLuOCFNZ3mrCVpeVUkrWEYz1XK7gasJM1",
+	"czVRUQniIGXemxbLApKx6qWy5VT1+4HGcNKA/+OxzADgIgPVXsV9xs9EChLI9S8SijaZK0ZqBYdq2f2l",
+	"4RuwXdTOfQELhgl9e3vGdtozrKxmpdYN+pzZvRQoJy5oY5jQ2JwtnPKZU9MX60loRoPTRG659zmNj/UV",
+	"bTLTfitd0sr01Pp9In2F/3TWBbK1pc03YbRyxEHUbiqfgRERXzjJYY3LJgR/Pk45G6iH4UaERWm+J618",
+	"0dPjygpbdChj0UiX5iJPzREWvroz2opsZG0Fb1yt7FtBwZusBrOAOmk/hJ52SrpcE7a6E9Ogg4rWvRKU",
+	"0t6+VeGma5irK/bkrIK+fOJiT3UJ2Bd7ctVRlyr25CYl9zKWw3+z9vKisosnuzSXetLQhTceiT6O6Se+",
+	"ETGpAWYJGamfSU9KpeBNK5hWRkeq3lSzlVeVZ8ncCqT1+qSKOEV4ZO5lk0p0IpNm9u8gVeVRlUnKutVO",
+	"alMYF6gG2OuIlQphW12WrKcvRyVuweJkbQJHVEhp8RPUC1mQ00S1SpFwpRAFDq3VT56LJPqWfQe1s/Xz",
+	"ecacvB5k29IywpxNs47FVUY4EFCeWLOfpv7jZktIdXX5Ksio51uV4AsFmS7VVlqY1jwI8x0H72Y8GmiM",
+	"bhoafyq7P1DD6ZwjHmj1wFTHfkR8Dr5wtE4zdIPS9G7xTY4Xs8+YOCtMMxP3g0Wg3803w/2Kpd3y02dP",
+	"wQ5XuwR0J46kj00JSXxEEaDBWq3bqtfvOE/I69dlcas5u6aFx55CVNuBat8b1rzwKogQaRbgYzGmoSOW",
+	"4OGo5nXJNpfUZNNLa1lV2xEvuSb0vJz6UMwqB7RDZ3JyYQc/T8iH+cWfziKY49X+q5c7+/C/y/39H/F/",
+	"/2OT4SHl/3LBTXhs2xGTrWw3N4yPz5bfyDzOw2gFG1mnylGSCV10DSnQel2jqmsoyGi6Bv7W6JHZrGwU",
+	"Bd4clI2DocRprQ5ZqaibzIMparJrOglWOlL5MzELv0mVkCXdepNetidgMexKQqoIWq+vV2lIA02nAnF2",
+	"O94wCODV92BIzsPCQOB9FBTweZJkJlLB6IRa3UOug0+w/Pjo/PjNmyPv+LCIlJhxgIRfJIVB6zn/g0sC",
+	"HM7PNH3dTla98RABICmr5U25qBb5NH6Mcpmd3o378pB2I+OC5SFbRGiaxFyARiwO/NRFjLIv42gOvsSe",
+	"6mW6vcO4sqZZxpvGHKK3ULwJbg50S4WwLWZIxl5a0o9RL0s53SfxkYT7gYBM59DH+sH1RFaVsIC1JkAV",
+	"FPdRPIMs5WdlmgSoKCA9FMt7hPygvVvGgi4kNUmiMPAfcYypDxaTGPJGc0EcB8nnVmIb9xIWJayJ3lrE",
+	"reFAn0jumhbfSQjXt9Iziro0NrOKjpzCXTTv/VH6t3NFxdoKdz3AkCKqWbEQUL8k6pYr++IggTCzxY/I",
+	"ptpYSeCc63A784/WydladF3f9/bWheyJ+sni9k4tYXqy7vsKWMmggobNrEUr3bfDD2HOHBR/ozOB4CLs",
+	"y8SfS00zTIXniEHdOOQTn+C8H2Da3uPgGwg8rpz5cc6mXe8uGr56iK8eeS/0akn5/mKDU8FJ4DA8Og0P",
+	"j2NR7aTGQvZm8/SONda7RaXEska0FSbzXNRixVBKDo5WFnL4bPSMNV1ZzgHsBhrLWi4sYaBePOgAOGeh",
+	"I9zkbaVh9Y5eirjmnk04sgmE99PyibbC2FRe2M4o4KEhZdME0sZSbTFRvJk+Y308+B37tPIPCk11L5z9",
+	"J+UiBIAVsZFUQnNzfKRp/c7uzuYi4D0rcS0Dvm5eQjm1AhbMIRFJxFzeK7C1h60lnqraIi1+0VQ/GPpf",
+	"8O79o76sqFyCSNfki/qB9NRkSkFcglClzLKHwPcA+ss8QJQJQ3gbKrIYAHyQG46ZfPQT2U1kc/iRt9z1",
+	"KPl58dB/DzlZ0W2Axsd/g1dAxKcOHr2MsRhbi/SH9DChyoTC8GB75F0j8sqh3I1BEv8ll48fRShKG81e",
+	"zTKW5t90vhUAQBkobQ8ZFRwsnjE0tNiobC8v3/kpo1itmf303Kf2qFGArA6txThRuzhP+aUfkri4CnTR",
+	"vhDpLQL8gtr3IlwjpipMOgvx0iH0hGQU42UYVclHHMHSotwvzdMmuovS7xyVPcDnQoSjJX8Kic6ky15A",
+	"7nm6cJYiGLINSzGMedn9IAixVBnkXdfDRcXAINVFZ+U2GKaoRLTSbS/GCzGugcVJkOvYsSWiXNvCksJc",
+	"31zPhdrFeQlei3KkdpGehfG9ky8hzoutKz6Ekk2JRy/+ccbiAFaHt4HC5MDgX1EIvvmkDTCfMxsY0sJW",
+	"RvxTrwcISlTA6KwA0BH3JGcS/ASbKnkBrJd1GlTDD7RQlii8ZePHcSSrWxTyuhoKS1drIhdMGN5AI72z",
+	"PQJAwcNJ2MLRPJHbn1poN1c/teyelmviUwNOV2JulZFt4hGKepQqETbecXs/mu3P3IHvDQ7JOqBd90Qd",
+	"iAbv2KMhN0fDmuQlzTs+dFpbkQOo8wKlC9vx4YJLhJz3Syc9cVnhxTymPCdCM3qSCmrEzq3109ZZWgyn",
+	"3oLCYvo69LJiDcjCmZ+PxaDg2eHBj+bMm/lhWsMXFbj+LyC3lz9i05f8A//XK/rXK2DvxjQRwtDhR+/F",
+	"bGZiqPC+LjgvSkAFTniOjY8DC0kuxa83mubHvahqX8/N6R5Su4Isnxoax7XoIP2FobgwOF0WnvCe0P2O",
+	"0AcLvPrbZma9kAl2SD1lX8aMBSxouKJ0ofP2i8nejax10sYRsKGSVxm+AsB16Q7fCfw481FkV14YwAYR",
+	"xlzGhgH+nbJZkuZFQUS+7nmUk5sfhSHlEh3R6hFRqFLC/y+VM0M70aaRO/2EW/t2WRTuvyOfyp6IUdXX",
+	"avfju9TwRnfy6muTbRnbwkOVb1DdtRQX7jWP7u3M6yf+VUyfFRpN1sw0YMRvmGfw7T8XllFdqqPnb03b",
+	"6fnGtvENoNuDNbKNMaRJiBq0HvxOdll8WCGrbOnGbmMjFGJAI3zL9yMEgPv9SNg/1hRMUNSVg399Lmx/",
+	"YEpZnwVF/ZDc/MrGDhcxBBpnDArpeia1rUxKBESshz9FyTwoXo4cn4oxFsr3DqAzPVyJSxbHz/k4n6d0",
+	"mPDLTRjzXXhvLy/PvWkSsF0PWRFHVpW1TRslU87euro9QLcwNMCKFvh3uQnc66AZ+8K3SEVp4frmBwHV",
+	"sMY8msrGWph09VEa9bVinb2PR2/r+ZPZeoikSyi+SjaDj4+OL9P0ounwOv2OPfYeV8UT7WIOV3gy/TuH",
+	"yd9KvJivkg5cY5Q73QD6IGMEwLbcAFbzGFmKGu718m9NL6fj30n9uCn3iWQXBY7o6fWlAobvJ5DtXkZF",
+	"ikTjlJptnqZAEw+ca6DSrGUr1+Ms+G+PMtBCvbxAssgbJlwJKPwCdHd8Yqmr2rveMawk4NcBfusc6Kvm",
+	"mjoEYiqkhxHEow0SopfERBNJWuyxWGEyjwJYiAoEceCXFwjanmkC0wRQtHBOxETtUe4p0zHoi14kCUPP",
+	"TreYnfpVVFsVZw3jG8iVt/OZ3UySxCmQRHTxZJfmsNBjav2RGvdXk2zPAJEOF5Qq9PtrSuWaUgNQQSkC",
+	"8p4A/ZIBIpWJZJSIqFwi8jiGd7EPpr8iUITglXkhSPYxCx8YuV9wxGOCxKZQpMF2xymjT+8IhgAoA6Ut",
+	"i1L54J7o/bS85E6Gw8oGehZQM99VIbQQD2iWmw9h3pBC4WeW6wYLSXyiV5WmqVj6MX7tRWS2V4NHt+rJ",
+	"FWj/ucmjs4Cs4aIkjUuB+Ms6LpcmaMT1XnohAHSQtMiuEmw7Sa6Xa6HOTnKrjBg9WVaEVpVu3OnSQVLJ",
+	"H3bo324lDTqQ8uHzLkFQpqvmte0ocDx32dpKvXpRg+2kXlOOf3U+tvT95XNEuSb98Mszk220GyVQn54S",
+	"tjvHT7Ck3J3LU97cjbET5dL6ng3l0oF0p9wmyTdlEHze9Y4me5lJ/D1+7e9oEhs1eCx0R5PQ7pVB0x2t",
+	"wMXV6IJivL0/6A8HJZDTB7WV7o0KZ+3U8edQBcW2bWujz5uvQLVy2l1EB/w2qPb5FLXyywezMn6BKeZ3",
+	"psC4x41ytKgB4YnWyn2+kWHwrpik/r2Y4jnyjGeV4eVZJ+24rOUwL6PchG8yoRQxXNmYp/HAwxrpwZwQ",
+	"j0PhnnnfT4F/vJzsese3XsZy8rmRfemFnf9BQycPLNVzp4eZGJoF5LQvfhdOPz4nwYRD+tXrya4OxRff",
+	"T204gP1LANq8FleiwW56nMJ54SslD6OXDU8tG4Atq9OZKga7qoqpSB/83/jfr3szf541OOWd+5hKyheF",
+	"gryRKnCIjnjYOxA0JxMR+Bk8n1OgCmwEKjHP4zyMNNJHeuR7Nnm3aSWHcPpnq5HSVnEJxlVRVcmmRW2S",
+	"pVDVmdZKYnTi6iR7fvHU/AJpxJO4JNnEUjWEKjyCKLXJcxe+ZxV+0EjY1KWn7C2ibMGPe9LeHtImKlk1",
+	"bSe57+IvSg1lQiK+cVTQ1ZVVerVLn/t55gtHVx9zPvLOu5bb4wf42NtiCy2eg+MKwNfVqVTARhxp705m",
+	"NMYK6KzKtsKxme2g77dLECi0Jk/xtijQCx98pnjDPk/xtuYpXlVO21ZIrjNzrcKzLcheW12LnsF2nXy3",
+	"TGsdGK5Gzj27rbBbHTYFswVQeyf066IcV/TYmSV8U4/N78Ii0I5CdKhDifFaVBIZ1XOOPX5mvWpiAMti",
+	"T8WV0+ifjC3+g37E0tzjg4WRd5cm89nKVJYs8sf3jcqKN4ImeuxNmUjwcx8Lpk4bYKDDpIv1vQLqbSKH",
+	"l5tZxlXsz/NJkoa/Q9wkTPz9ZiZ+z/i0ARmpoyj5XAvb1GgB9UAiAV2e4celCHEvy/00t5LjCL6SHDsb",
+	"cjB5aOyvEuRVxlKypOGCzgCg2PM5UuZ3+68McNCpB0EmxEoJKhPmB8JXLEoIYcq4Up0bsSJj43ka5o8I",
+	"nzEnw5DBoPyfn2BxBT4gSMszSkSAE1gYD+KshR2fjqoIWGHIcdbzYcGHT0fHOqg6cOIqlHtevHW8uE4I",
+	"ihOfjhaPeaoObCKwPsoJAVCmL83fep2xSuVJnaOVqqfaE/QWEbSV8hwpulGi/tYmUT+0SdTfeokqJeqH",
+	"hSXqh16ibrtE/WCXqB+WkqgfWiTqb71EFRL1w1NI1A+LSdQPvUTdeon6wSpRPywuUXM220nn8c4mnMnB",
+	"rfBiHj83n/L1G+BNgOlmhc+Ex2b5ZHrfnm1w81VnU3fzXdLiL4iX/yT//NpIun6xlptHIqiK9CZEfCYv",
+	"Y+ane7lD27IkqJ4pxxBHtCB/6DnCpjhCCRc/+xkK+DYWoQt1+AkO+pM93lqhcnc+0VrmZ5jnbDoTBayw",
+	"rcY+bIzjudX36TlIU2hpmGHiDcFCCAmi7bsgPLFbTBuhbIqgUwYdG9z3MaDHlYaxeU/C25gEmy9bHFVr",
+	"Ks3ZPJeFhFJm2u7XrdBU+kTXDfwFD/wpGEqxp0ZbADUT7ndtzAWsADRsz1qeTjvoVnjPYmkQw/UXim2+",
+	"UMhTWgvXEN5tOyL81yFQwup62HsdFskjCBQfEagAkLZy3yrBhciYLY+jN+Jv26uchv6LJ/EtsmYbSeib",
+	"f30r0Q9Bo/HxbX+dMwedUvBuY8r4/vmNnt90wlvEWE9cudk8DxJSpNJojGYpZMM3LywLSCyWGae/ahqS",
+	"0pSzGhKMF32kovF2ZkkStb8rU2MPG+uVi7zPYT4RVsiZPw5zWW055FQyzsMHpq3bRCvnfMSeXiS9KGh0",
+	"pxl5Oj3ZmMhGwGaVtANMikwz3SuxqgRT0H/XSBrwWTP69mVZOQA0uGQtJlYdwk9YpNW07kXKDJYQpjft",
+	"bGW1wfIZ1VPGNRt3ujCcP/R/tnmWlCihVXsVaPqcHU0qpG9emg7BZ6wyiONaNPtk73hiz/1YftNpz/s4",
+	"KOPU4vS8h8+DrSo5PSISQeuL3m2h62McvSfupyfuIuPveQonlocwDq1xmZegMozwuPvHoA09Bn3UYR+7",
+	"5JgtDqmryrA6juOahpY3jjnul/mNlpVWZKtLmedHKfODR9XjNozDbDLwbjjPihMseJepbtihMW1tGVoN",
+	"2WtrV6fnncO212Uac+D2isz2pcJtUaA2xdLo+rwD9Vd2gNO4mGeISfEjLd+ZqIQLsitMuw3Mq8wBMVnb",
+	"POdMXFWshuacg804+TB/qv+K7C5lgMQDlceTPvB/wFtD5ENSOxoBG/NV+Hd+GDubit7wNQNb7hnfM7Jp",
+	"yUNrMW0hqihzVl08Ar5u1MjVhXnrr6rKwtWz8Sdm48/BpEZ8OCOm9nRSpWMudueb+Z8iMXuvrjYndu8Z",
+	"3Rbmd98ShTWb+DO2Jlv+CMfuucqz4Sp0YL1V/09k1VcZHUQkTWO+JGpDJM4vhIWprG7vbyJ9TCdEAR5H",
+	"NGvPA9awwBOfH9nxobzkR748QVs6fN7gOLDmw//ulSkf/gYiTxFHFvA/6mPDtjTiZAFe4h6OshQvBKNY",
+	"QxJe+JxpuEU+hyUmwLedsfSBpTsZFMChdgPtCYLMan4+z7zxxI/vmLLNlceJAw/qgyi4FEY5LPlJAxZQ",
+	"o7WTzQ8bqNqXuAR4nfA9XZRjeyQt3dyHVkEWB7z1bS6qZdIANCGsGOyHu95BFCII6PeUcfyK2VhzvQT2",
+	"s4MT7HAmRDl5oR0D90sNhjQA/+HRm4YZ3E7DGL9PGT+ycMqw8GeUxHfwX60jQDPLQyiownI/jFseXjCj",
+	"EB5wL202oXHm7Eu+h0e1UxBWd5WzwNNW9p/Nb+DbDT3Xlcm0V0W3+u2ZjrmM4Uzqhhu4+2ZOkRbY0u2G",
+	"23uPF2Tcx1us/sq4yuq/aszWLD8HMmHJDWR6qfmMN4nf55PlZ12Bhpq7NQHDNR+HSBNT97he9XPaTHP4",
+	"+kOxUb7g4yArVXtfCsD12vAd/cpEaqHeCb2lkhChzSYcwDnnSJO4XYhCK+/X5KZYFMeJu7vWCMYD3u+5",
+	"SdZvsxSiOtgQlXCODepWv2uZW3ax2Z5WA/2hCnPiWOPjFfmeXzkf/GjOvJkfpplWuRHhxL7401nEgBh4",
+	"y5c/YtOX/AP/1yv61ysgHNOeCifa92K20t4UI21ljfYCjDePfLVU5HFldSB1Osvca0HePK6vHKQmNjdc",
+	"ELIEjCV02F4wGfTYmiRYk0ILYmnvD/jPjvz1K8mniAuPuqQ6xN+5JKqLKufXTUAcGufZyim1e9uyShDd",
+	"6P30dUtZMDpZkYTSdIh9sUlFiQLbzWDq9iBZRgjITNPgMbAkcT3nOMAtpqw1ic5ebD4HM20nYb0C/uAm",
+	"vxEHXE2z+vthuwPSs7pHzrjKi/mhxOBtN0v54hhmXngXJyn4fE4YhAmM52nGIRTCO2W+6x2qoeHZ7dZ/",
+	"4N/Ecwm11Ea69TO4HHFciPz0TkIm2/0TXmMFlDhnmqcQ/gWv2exLfkA/35B/O4fcQ5jMM359vGMi3KJ0",
+	"NcOHVXUz8qZzfgGdouXU0l3GncV/4USXzOPccm4DL5NpeomDexN8b4ehwhjR3XYqNE4JRk5ySzwjO9/q",
+	"sf16r/SwLLjDC3zV36mFWUm69It8JtZ1vmOP3SHyDEwd2hKz7mvMzDaLFRt/TaCdAQ3ktgfvyoKp8Ufd",
+	"Wr1urBPrMyT3Na5NODJt/NQpEzT4mrCadcu0Wtl2EePMCPsKM4nL4u7DOHBaFTbsvKR3vFf7ap69LRAc",
+	"ZDRfndLRgw+WiGzSt8A1/Vcvd/bhf5f7+z/i//7HJi2o+xAmMCMvxIfswCpeONIOrviG8QHYOpf8E86w",
+	"yjU3QFmGaC66Ztl/o3Be1aJXCun12bbrhuRv1rJdvQX1F/S1uPSvx6SNnqsulRd9TywNBF2Z/PVSjI7B",
+	"Os+oAuNzur9s7SWiV8O/+XfmXrdcsW65IZmeLVYUtmxG7WvCtst3Q4nW1cl5WGowj0A8tti/VctFLOEj",
+	"2bn3q9pmg/T67kUKAZ6V40+vTPXK1LNRpoptFKx6JbZZtSQnAldWWsOa1xrHW+MwvdVhtVqJRQNYr16y",
+	"94f6c6eW+rvVv8685I46yzP3sjPAwFom0gjqrXW8M59u73lX9byzwKmba40FN1p88FZCgM/ZE+95Ud86",
+	"xXEvip+7h966+cgMvJaMiQVFHztDkYkjKB/IDWOx5p3zyByYDCUh7PnM84l1pROrMZr2GvSQxFhgBxlz",
+	"ZYZiG35vsD79ImyzWHefgGILEy1K5rVe9ul2r1LJiL4WwbRNxX1Fym9rSK17RO0ldXg+pYCbjX+4isbc",
+	"OI1L2xCLJGgbjsE1K4A5vkkc/kY5Y7doDz3Xtn39PXfs821LRteE5evJZqDx4tIznJkfjwoduFqEoZkJ",
+	"mxSkngtvkgvLE3DXUEv893mqpToH/iYNdT37dWK/QiFZVSryRbivKAeOsVAtzo7YRk/1B1ll/Ac/jPwb",
+	"zpuBEWucx2xy4CNRCebsAGd89ly4LUvkM88sVzqsBY2YohQ3oVj/rmj2dioBabFM5WXyn2f83PbG8zRl",
+	"zZSdyVhU9E6AbjXqveI/8pYHYrA14h3M1BHPcMXbhFYvN7OMq9if55MkDX9nJNv2v9/MxO8ZnzZAY7Mf",
+	"cbyTYo1xHArzR2Tj4yS5D9lwDrzrX5+AVVUC3svoJtEdj9+AxndhPpnf7I35fDf++N6KzgcJ+KbkjHD6",
+	"DOb3jPIIJiIb6s849BnA8kAOX0Hw7/ZftbzMjsW8QX1eysuM40QJHUb5HKps/WsFmCXYyQ2W53AEX5b7",
+	"ad6QfZt/XQxw2LU71HA964cZrq4jwJLkLmLrwTcc+k+ObwS+FeNbAbg/Hb6F8UOYs+biIBm6IkttmDqg",
+	"0u0kvmGES+x7LOZaoxTXJ3LyRAPvPXEw5Q32+qKzWMWiDxXoFZh3abDPlXBvz+fnMcvtRrghfs+UsU1M",
+	"UsM2/fCpz4v1mJZocJpIsylZbEEN2Ec7N+Ff70+l0IugXTt7d/xKGeYebqihCN+74Rf1ebGuQq0w+Arw",
+	"i3be41cjfhG0F8CvKLkLYztanSR3GdVvhua7DQrGCQ60Jn8NEMEwfjsibe4ezSF3h2mp+uvzVl2fy2Id",
+	"sMb1nsxPNJnnLcTAW7hRAwy1JTgKS+mR9PnYeAh7XNF2yiDaL5uEsw5XIK2T2zWIRMj7opsIyFwrgpsn",
+	"7X4f0kHU34kWuRPpEGxHySQMxp3tPzMWHx96B6JQG9n1ZbW2LIzvwBEuvIt3ktibpclDCBGe4j0tjLMc",
+	"ykk0cOQzvqSVmYzqS12j7cg4mdMJuJuQ1g/7VVidNgB2YX5aCOYzP8s+J2mDKw6BQ+gPnmzfpEicyzHX",
+	"p1kfYJ1HOdE2qdhUgTJQgOqVmGekxBBalTHdgYhSdgfiO20ydVCLrFEPV45q6yIbuYxtIhgJvP5x91nc",
+	"TiUKuWr6WcSVl7W8q41g5C1+VmthNR3f2T6zmwkfbke4Ye39IX5wCA0HpiNa19206Hf3qG8xkN0NSk20",
+	"YS8oxzBqub6exTw9i6mGbutoavV9Ei3ciGNPwNnFyiCbynLpzRQjRGjmmuNpa+lmNd6DtHpyHhSgAchc",
+	"iAltrt8qhbWAjjqunjy3iDzRqFI7oq40qmgT//ja4ntMrYxuxeia6ERz5GLZ5LFriOt6Pv66nT0nxY57",
+	"c2LNJbcW+QT6V7MHLmpo1jh+ZTZpRGT3OPytwOV1hbWX5IZNVggIzCXINhcQ5EhrtLKe0syUJghiGWKr",
+	"SJNqaItTkizlf++UlafDvWgr40O6JJhSC+wj1Z44jYJAVg1jFowOGbRpWO6U0EHl+hbCpBYMjepp66lp",
+	"S4/BWoawXNQ+d+rqpgduBYGtXhcsA8M1aJy0rjKVbVo5dOIIVfWw5wdWBXE54mxRE/mCY3IbGj/u3KXJ",
+	"vMUHifyMij4e9QGzlUbmMifbA9ZGjDmhAIw5dOeUmTgbeH6U8F+Vj4LIeM6HwYzaYewxnw8BWYmZlVHA",
+	"gg6KtfxMy38mfMMYWM2HCKfzqQYOAV9O21QsdIWJ4DehGlSPp6v/Vx3Veq3hqbUG5AOGg1kbj3KpRgXI",
+	"Ui47pYj8gTMCqgxg1eY7VJ/aSt4xFLneV1Cec/HinOaFIXJgWv1iCfKgrEvBTm1VczfBv5YsdSNQr692",
+	"s403noXK63RiXGkSRSIiocUWh2WkqXVZlxKluP0cc3/JAt5ailuOXdA54ooSZ8ttvI6muxDr+iZMefIQ",
+	"etrbLkueOph1WPQa6IkuJxnfep4VRHXD8s+QO9oHkQkJnSTr9uOgC4HxyZ89da2hapykwU5itKfcbZSa",
+	"KyDb2dyedjhJdSuWkYZ3vdMOsrAIj/JjqFbLyXbMQeLfMWluGCCRi84V8k/4b+nnMGO7kIcu000bfsRX",
+	"HDxWc87zAR7FYGEqx9ltsXY+R56xzgdwjWm02D41DHlys6crm9Otnz2T2xImVzG5Ls/n2m4HMsuwNVBC",
+	"Jsjsmvd3oXS/W2sTrV6md73jW/TPy+aAICwYmJh+mHm3LIfss7bSjIUmt+VcUaDBgjmEnyxzsLbeTimD",
+	"+0TBfaLgDSYKNrJmwRsyB7/ckp3PiS3/Qo2fkRPJn4Evr5nLiUNd0lDc87utuuoWqLioCliNgrth/Maa",
+	"qii4gTEujqUPkh/M04gv6sXXT1//P6o4x7Q2cgMA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -2,20 +2,20 @@ package transformers
 
 import (
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
-	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 )
 
-func ToAPIToken(token *db.APITokenModel) *gen.APIToken {
+func ToAPIToken(token *dbsqlc.APIToken) *gen.APIToken {
 	res := &gen.APIToken{
-		Metadata: *toAPIMetadata(token.ID, token.CreatedAt, token.UpdatedAt),
+		Metadata:  *toAPIMetadata(sqlchelpers.UUIDToStr(token.ID), token.CreatedAt.Time, token.UpdatedAt.Time),
+		ExpiresAt: token.ExpiresAt.Time,
+		Name:      token.Name.String,
+		Scope:     gen.APITokenScope(token.Scope),
 	}
 
-	if expiresAt, ok := token.ExpiresAt(); ok {
-		res.ExpiresAt = expiresAt
-	}
-
-	if name, ok := token.Name(); ok {
-		res.Name = name
+	if token.RotatedAt.Valid {
+		res.RotatedAt = &token.RotatedAt.Time
 	}
 
 	return res
@@ -0,0 +1,33 @@
+package transformers
+
+import (
+	"encoding/json"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func ToStepRunApprovalFromSQLC(approval *dbsqlc.StepRunApproval) *gen.StepRunApproval {
+	res := &gen.StepRunApproval{
+		Metadata:      *toAPIMetadata(pgUUIDToStr(approval.ID), approval.CreatedAt.Time, approval.UpdatedAt.Time),
+		TenantId:      pgUUIDToStr(approval.TenantId),
+		StepRunId:     pgUUIDToStr(approval.StepRunId),
+		WorkflowRunId: pgUUIDToStr(approval.WorkflowRunId),
+		Token:         approval.Token,
+		Status:        gen.StepRunApprovalStatus(approval.Status),
+	}
+
+	if len(approval.Payload) > 0 {
+		payload := map[string]interface{}{}
+
+		if err := json.Unmarshal(approval.Payload, &payload); err == nil {
+			res.Payload = &payload
+		}
+	}
+
+	if approval.DecidedAt.Valid {
+		res.DecidedAt = &approval.DecidedAt.Time
+	}
+
+	return res
+}
@@ -0,0 +1,43 @@
+package transformers
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func ToAuditLogFromSQLC(entry *dbsqlc.AuditLog) *gen.AuditLog {
+	res := &gen.AuditLog{
+		// entries are never updated, so they were last updated when they were created
+		Metadata:   *toAPIMetadata(pgUUIDToStr(entry.ID), entry.CreatedAt.Time, entry.CreatedAt.Time),
+		ActorKind:  gen.AuditLogActorKind(entry.ActorKind),
+		ActorId:    uuid.MustParse(pgUUIDToStr(entry.ActorId)),
+		Operation:  entry.Operation,
+		Method:     entry.Method,
+		Path:       entry.Path,
+		StatusCode: int(entry.StatusCode),
+	}
+
+	if entry.ActorName.Valid {
+		res.ActorName = &entry.ActorName.String
+	}
+
+	if entry.ResourceType.Valid {
+		res.ResourceType = &entry.ResourceType.String
+	}
+
+	if entry.ResourceId.Valid {
+		res.ResourceId = &entry.ResourceId.String
+	}
+
+	if entry.IpAddress.Valid {
+		res.IpAddress = &entry.IpAddress.String
+	}
+
+	if entry.UserAgent.Valid {
+		res.UserAgent = &entry.UserAgent.String
+	}
+
+	return res
+}
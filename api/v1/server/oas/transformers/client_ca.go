@@ -0,0 +1,16 @@
+package transformers
+
+import (
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func ToClientCAFromSQLC(ca *dbsqlc.TenantClientCA) *gen.ClientCA {
+	return &gen.ClientCA{
+		Metadata:       *toAPIMetadata(pgUUIDToStr(ca.ID), ca.CreatedAt.Time, ca.UpdatedAt.Time),
+		Name:           ca.Name,
+		Certificate:    ca.Certificate,
+		SpiffeIdPrefix: ca.SpiffeIdPrefix,
+		ExpiresAt:      ca.ExpiresAt.Time,
+	}
+}
@@ -0,0 +1,31 @@
+package transformers
+
+import (
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func ToCronExclusionCalendarFromSQLC(calendar *dbsqlc.CronExclusionCalendar) *gen.CronExclusionCalendar {
+	dates := make([]types.Date, 0, len(calendar.Dates))
+
+	for _, date := range calendar.Dates {
+		if date.Valid {
+			dates = append(dates, types.Date{Time: date.Time})
+		}
+	}
+
+	res := &gen.CronExclusionCalendar{
+		Metadata: *toAPIMetadata(pgUUIDToStr(calendar.ID), calendar.CreatedAt.Time, calendar.UpdatedAt.Time),
+		TenantId: pgUUIDToStr(calendar.TenantId),
+		Name:     calendar.Name,
+		Dates:    dates,
+	}
+
+	if calendar.IcalUrl.Valid {
+		res.IcalUrl = &calendar.IcalUrl.String
+	}
+
+	return res
+}
@@ -0,0 +1,30 @@
+package transformers
+
+import (
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func ToDeadLetterQueueItemFromSQLC(item *dbsqlc.DeadLetterQueueItem) *gen.DeadLetterQueueItem {
+	res := &gen.DeadLetterQueueItem{
+		Metadata:      *toAPIMetadata(pgUUIDToStr(item.ID), item.CreatedAt.Time, item.CreatedAt.Time),
+		TenantId:      pgUUIDToStr(item.TenantId),
+		StepRunId:     pgUUIDToStr(item.StepRunId),
+		StepId:        pgUUIDToStr(item.StepId),
+		WorkflowRunId: pgUUIDToStr(item.WorkflowRunId),
+		RetryCount:    int(item.RetryCount),
+		Error:         item.Error.String,
+		ErrorChain:    item.ErrorChain,
+	}
+
+	if res.ErrorChain == nil {
+		res.ErrorChain = []string{}
+	}
+
+	if len(item.Input) > 0 {
+		input := string(item.Input)
+		res.Input = &input
+	}
+
+	return res
+}
@@ -32,6 +32,13 @@ func ToEventList(events []*dbsqlc.Event) []gen.Event {
 	return res
 }
 
+// ToEventFromSQLCEvent converts a created event, which doesn't have a workflow run summary yet.
+func ToEventFromSQLCEvent(event *dbsqlc.Event) *gen.Event {
+	res := dbslqEventToEvent(event)
+
+	return &res
+}
+
 func dbslqEventToEvent(event *dbsqlc.Event) gen.Event {
 	return gen.Event{
 		Metadata: *toAPIMetadata(sqlchelpers.UUIDToStr(event.ID), event.CreatedAt.Time, event.UpdatedAt.Time),
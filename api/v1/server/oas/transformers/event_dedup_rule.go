@@ -0,0 +1,16 @@
+package transformers
+
+import (
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func ToEventDedupRuleFromSQLC(rule *dbsqlc.EventDedupRule) *gen.EventDedupRule {
+	return &gen.EventDedupRule{
+		Metadata:      *toAPIMetadata(pgUUIDToStr(rule.ID), rule.CreatedAt.Time, rule.UpdatedAt.Time),
+		TenantId:      pgUUIDToStr(rule.TenantId),
+		EventKey:      rule.EventKey,
+		Expression:    rule.Expression,
+		WindowSeconds: int(rule.WindowSeconds),
+	}
+}
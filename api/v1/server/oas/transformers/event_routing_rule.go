@@ -0,0 +1,25 @@
+package transformers
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func ToEventRoutingRuleFromSQLC(rule *dbsqlc.EventRoutingRule, workflowName *string) *gen.EventRoutingRule {
+	res := &gen.EventRoutingRule{
+		Metadata:     *toAPIMetadata(pgUUIDToStr(rule.ID), rule.CreatedAt.Time, rule.UpdatedAt.Time),
+		TenantId:     pgUUIDToStr(rule.TenantId),
+		Name:         rule.Name,
+		Expression:   rule.Expression,
+		WorkflowId:   uuid.MustParse(pgUUIDToStr(rule.WorkflowId)),
+		WorkflowName: workflowName,
+	}
+
+	if rule.EventKey.Valid {
+		res.EventKey = &rule.EventKey.String
+	}
+
+	return res
+}
@@ -0,0 +1,62 @@
+package transformers
+
+import (
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// ToEventSinkFromSQLC converts an event sink, with the pending and dead-lettered deliveries of the counts which belong
+// to the sink.
+func ToEventSinkFromSQLC(sink *dbsqlc.EventSink, counts []*dbsqlc.CountEventSinkDeliveriesRow) *gen.EventSink {
+	eventTypes := make([]gen.EventSinkRecordType, len(sink.EventTypes))
+
+	for i, eventType := range sink.EventTypes {
+		eventTypes[i] = gen.EventSinkRecordType(eventType)
+	}
+
+	res := &gen.EventSink{
+		Metadata:    *toAPIMetadata(pgUUIDToStr(sink.ID), sink.CreatedAt.Time, sink.UpdatedAt.Time),
+		TenantId:    pgUUIDToStr(sink.TenantId),
+		Name:        sink.Name,
+		Kind:        gen.EventSinkKind(sink.Kind),
+		Format:      gen.EventSinkFormat(sink.Format),
+		Tls:         sink.Tls,
+		EventTypes:  eventTypes,
+		MaxAttempts: int(sink.MaxAttempts),
+	}
+
+	for _, count := range counts {
+		if count.SinkId != sink.ID {
+			continue
+		}
+
+		switch count.Status {
+		case dbsqlc.EventSinkDeliveryStatusPENDING:
+			res.PendingCount = int(count.Count)
+		case dbsqlc.EventSinkDeliveryStatusDEAD:
+			res.DeadLetteredCount = int(count.Count)
+		}
+	}
+
+	if len(sink.Brokers) > 0 {
+		res.Brokers = &sink.Brokers
+	}
+
+	if sink.Url.Valid {
+		res.Url = &sink.Url.String
+	}
+
+	if sink.Topic.Valid {
+		res.Topic = &sink.Topic.String
+	}
+
+	if sink.UsernameSecret.Valid {
+		res.UsernameSecret = &sink.UsernameSecret.String
+	}
+
+	if sink.PasswordSecret.Valid {
+		res.PasswordSecret = &sink.PasswordSecret.String
+	}
+
+	return res
+}
@@ -0,0 +1,38 @@
+package transformers
+
+import (
+	"fmt"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func ToInboundWebhookFromSQLC(webhook *dbsqlc.InboundWebhook, serverUrl string) *gen.InboundWebhook {
+	id := pgUUIDToStr(webhook.ID)
+
+	res := &gen.InboundWebhook{
+		Metadata:        *toAPIMetadata(id, webhook.CreatedAt.Time, webhook.UpdatedAt.Time),
+		TenantId:        pgUUIDToStr(webhook.TenantId),
+		Name:            webhook.Name,
+		Url:             fmt.Sprintf("%s/api/v1/inbound-webhooks/%s", serverUrl, id),
+		SignatureScheme: gen.InboundWebhookSignatureScheme(webhook.SignatureScheme),
+	}
+
+	if webhook.SignatureHeader.Valid {
+		res.SignatureHeader = &webhook.SignatureHeader.String
+	}
+
+	if webhook.EventKey.Valid {
+		res.EventKey = &webhook.EventKey.String
+	}
+
+	if webhook.EventKeyExpression.Valid {
+		res.EventKeyExpression = &webhook.EventKeyExpression.String
+	}
+
+	if webhook.DataExpression.Valid {
+		res.DataExpression = &webhook.DataExpression.String
+	}
+
+	return res
+}
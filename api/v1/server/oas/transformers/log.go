@@ -3,16 +3,25 @@ package transformers
 import (
 	"encoding/json"
 
+	"github.com/google/uuid"
+
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 )
 
 func ToLogFromSQLC(log *dbsqlc.LogLine) *gen.LogLine {
 	res := &gen.LogLine{
+		Id:        log.ID,
 		CreatedAt: log.CreatedAt.Time,
+		Level:     gen.LogLineLevel(log.Level),
 		Message:   log.Message,
 	}
 
+	if log.StepRunId.Valid {
+		res.StepRunId = uuid.MustParse(sqlchelpers.UUIDToStr(log.StepRunId))
+	}
+
 	if log.Metadata != nil {
 		meta := map[string]interface{}{}
 
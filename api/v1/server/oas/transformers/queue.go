@@ -0,0 +1,76 @@
+package transformers
+
+import (
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func ToQueue(queue *dbsqlc.Queue) *gen.Queue {
+	res := &gen.Queue{
+		Name:     queue.Name,
+		IsPaused: queue.IsPaused,
+	}
+
+	if queue.LastActive.Valid {
+		res.LastActive = &queue.LastActive.Time
+	}
+
+	return res
+}
+
+// ToQueueMetricsHistory groups snapshots which are ordered by queue and time by their queue, and aggregates the
+// snapshots of every queue over the window which they were listed for.
+func ToQueueMetricsHistory(snapshots []*dbsqlc.QueueMetricsSnapshot) map[string]gen.QueueMetricsHistory {
+	res := make(map[string]gen.QueueMetricsHistory)
+
+	for _, snapshot := range snapshots {
+		history := res[snapshot.Queue]
+
+		history.Snapshots = append(history.Snapshots, gen.QueueMetricsSnapshot{
+			CreatedAt: snapshot.CreatedAt.Time,
+			Depth:     int(snapshot.Depth),
+			LatencyMs: snapshot.LatencyMs,
+			Slots:     int(snapshot.Slots),
+			UsedSlots: int(snapshot.UsedSlots),
+		})
+
+		if depth := int(snapshot.Depth); depth > history.MaxDepth {
+			history.MaxDepth = depth
+		}
+
+		if snapshot.LatencyMs > history.MaxLatencyMs {
+			history.MaxLatencyMs = snapshot.LatencyMs
+		}
+
+		res[snapshot.Queue] = history
+	}
+
+	for queue, history := range res {
+		var depthSum, latencySum, utilizationSum float64
+		var utilizationCount int
+
+		for _, snapshot := range history.Snapshots {
+			depthSum += float64(snapshot.Depth)
+			latencySum += float64(snapshot.LatencyMs)
+
+			// snapshots without any workers don't count towards the utilization
+			if snapshot.Slots > 0 {
+				utilizationSum += float64(snapshot.UsedSlots) / float64(snapshot.Slots)
+				utilizationCount++
+			}
+		}
+
+		count := float64(len(history.Snapshots))
+
+		history.AvgDepth = float32(depthSum / count)
+		history.AvgLatencyMs = float32(latencySum / count)
+
+		if utilizationCount > 0 {
+			history.AvgSlotUtilization = float32(utilizationSum / float64(utilizationCount))
+		}
+
+		res[queue] = history
+	}
+
+	return res
+}
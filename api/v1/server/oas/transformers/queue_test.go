@@ -0,0 +1,52 @@
+package transformers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestToQueueMetricsHistory(t *testing.T) {
+	now := time.Now().UTC()
+
+	snapshot := func(queue string, depth int32, latencyMs int64, slots, usedSlots int32) *dbsqlc.QueueMetricsSnapshot {
+		return &dbsqlc.QueueMetricsSnapshot{
+			Queue:     queue,
+			Depth:     depth,
+			LatencyMs: latencyMs,
+			Slots:     slots,
+			UsedSlots: usedSlots,
+			CreatedAt: sqlchelpers.TimestampFromTime(now),
+		}
+	}
+
+	history := ToQueueMetricsHistory([]*dbsqlc.QueueMetricsSnapshot{
+		snapshot("a", 10, 1000, 4, 4),
+		snapshot("a", 2, 200, 4, 2),
+		// the queue had no workers, so the snapshot doesn't count towards the utilization
+		snapshot("a", 0, 0, 0, 0),
+		snapshot("b", 1, 50, 0, 0),
+	})
+
+	require.Len(t, history, 2)
+
+	a := history["a"]
+	assert.Len(t, a.Snapshots, 3)
+	assert.Equal(t, 10, a.MaxDepth)
+	assert.Equal(t, int64(1000), a.MaxLatencyMs)
+	assert.InDelta(t, 4, a.AvgDepth, 0.001)
+	assert.InDelta(t, 400, a.AvgLatencyMs, 0.001)
+	assert.InDelta(t, 0.75, a.AvgSlotUtilization, 0.001)
+
+	b := history["b"]
+	assert.Len(t, b.Snapshots, 1)
+	assert.Equal(t, 1, b.MaxDepth)
+	assert.Zero(t, b.AvgSlotUtilization)
+
+	assert.Empty(t, ToQueueMetricsHistory(nil))
+}
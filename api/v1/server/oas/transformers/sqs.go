@@ -0,0 +1,26 @@
+package transformers
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func ToSQSIntegrationFromSQLC(integration *dbsqlc.SQSIntegration) *gen.SQSIntegration {
+	res := &gen.SQSIntegration{
+		Metadata:              *toAPIMetadata(pgUUIDToStr(integration.ID), integration.CreatedAt.Time, integration.UpdatedAt.Time),
+		TenantId:              uuid.MustParse(pgUUIDToStr(integration.TenantId)),
+		QueueUrl:              integration.QueueUrl,
+		Region:                integration.Region,
+		EventKey:              integration.EventKey,
+		AccessKeyIdSecret:     integration.AccessKeyIdSecret,
+		SecretAccessKeySecret: integration.SecretAccessKeySecret,
+	}
+
+	if integration.SessionTokenSecret.Valid {
+		res.SessionTokenSecret = &integration.SessionTokenSecret.String
+	}
+
+	return res
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
@@ -85,3 +86,24 @@ func ToTenantResourcePolicy(_limits []*dbsqlc.TenantResourceLimit) *gen.TenantRe
 		Limits: limits,
 	}
 }
+
+func ToTenantQuotaUsageList(usages []*repository.TenantQuotaUsage) *gen.TenantQuotaUsageList {
+	rows := make([]gen.TenantQuotaUsage, len(usages))
+
+	for i, usage := range usages {
+		rows[i] = gen.TenantQuotaUsage{
+			Resource: gen.TenantQuotaResource(usage.Resource),
+			Usage:    int(usage.Usage),
+		}
+
+		// a quota of 0 is unlimited
+		if usage.Limit > 0 {
+			limit := int(usage.Limit)
+			rows[i].Limit = &limit
+		}
+	}
+
+	return &gen.TenantQuotaUsageList{
+		Rows: &rows,
+	}
+}
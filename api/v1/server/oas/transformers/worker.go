@@ -1,6 +1,7 @@
 package transformers
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -94,20 +95,51 @@ func ToWorkerRuntimeInfo(worker *dbsqlc.Worker) *gen.WorkerRuntimeInfo {
 	return runtime
 }
 
+func ToWorkerHealth(worker *dbsqlc.Worker) *gen.WorkerHealth {
+	health := &gen.WorkerHealth{
+		ReportedAt: worker.LastHealthReportAt.Time,
+	}
+
+	if worker.CpuUtilization.Valid {
+		cpuUtilization := float32(worker.CpuUtilization.Float64)
+		health.CpuUtilization = &cpuUtilization
+	}
+
+	if worker.MemoryUtilization.Valid {
+		memoryUtilization := float32(worker.MemoryUtilization.Float64)
+		health.MemoryUtilization = &memoryUtilization
+	}
+
+	if worker.ActiveSlots.Valid {
+		activeSlots := int(worker.ActiveSlots.Int32)
+		health.ActiveSlots = &activeSlots
+	}
+
+	if len(worker.Gauges) > 0 {
+		gauges := make(map[string]float32)
+
+		if err := json.Unmarshal(worker.Gauges, &gauges); err == nil {
+			health.Gauges = &gauges
+		}
+	}
+
+	return health
+}
+
 func ToWorkerSqlc(worker *dbsqlc.Worker, remainingSlots *int, webhookUrl *string, actions []pgtype.Text) *gen.Worker {
 
 	dispatcherId := uuid.MustParse(pgUUIDToStr(worker.DispatcherId))
 
 	maxRuns := int(worker.MaxRuns)
 
-	status := gen.ACTIVE
+	status := gen.WorkerStatusACTIVE
 
 	if worker.IsPaused {
-		status = gen.PAUSED
+		status = gen.WorkerStatusPAUSED
 	}
 
 	if worker.LastHeartbeatAt.Time.Add(5 * time.Second).Before(time.Now()) {
-		status = gen.INACTIVE
+		status = gen.WorkerStatusINACTIVE
 	}
 
 	var availableRuns int
@@ -133,10 +165,22 @@ func ToWorkerSqlc(worker *dbsqlc.Worker, remainingSlots *int, webhookUrl *string
 		res.WebhookId = &wid
 	}
 
+	if worker.Region.Valid {
+		res.Region = &worker.Region.String
+	}
+
+	if worker.Pool.Valid {
+		res.Pool = &worker.Pool.String
+	}
+
 	if !worker.LastHeartbeatAt.Time.IsZero() {
 		res.LastHeartbeatAt = &worker.LastHeartbeatAt.Time
 	}
 
+	if worker.LastHealthReportAt.Valid {
+		res.Health = ToWorkerHealth(worker)
+	}
+
 	if actions != nil {
 		apiActions := make([]string, len(actions))
 
@@ -149,3 +193,12 @@ func ToWorkerSqlc(worker *dbsqlc.Worker, remainingSlots *int, webhookUrl *string
 
 	return res
 }
+
+func ToWorkerPool(pool *dbsqlc.ListWorkerPoolsRow) gen.WorkerPool {
+	return gen.WorkerPool{
+		Name:      pool.Pool,
+		Workers:   int(pool.Workers),
+		Slots:     int(pool.Slots),
+		UsedSlots: int(pool.UsedSlots),
+	}
+}
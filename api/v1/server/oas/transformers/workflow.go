@@ -2,7 +2,9 @@ package transformers
 
 import (
 	"context"
+	"encoding/json"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
@@ -94,6 +96,19 @@ func ToWorkflowVersion(
 		res.Sticky = &stickyStrategy
 	}
 
+	if version.InputSchema != nil {
+		inputSchema := make(map[string]interface{})
+		err := json.Unmarshal(version.InputSchema, &inputSchema)
+
+		if err == nil {
+			res.InputSchema = &inputSchema
+		}
+	}
+
+	if version.ReservedSlots.Valid {
+		res.ReservedSlots = &version.ReservedSlots.Int32
+	}
+
 	if version.WorkflowId.Valid {
 		res.Workflow = ToWorkflowFromSQLC(workflow)
 	}
@@ -155,6 +170,23 @@ func ToWorkflowVersionConcurrency(concurrency *WorkflowConcurrency) *gen.Workflo
 	return res
 }
 
+func ToWorkflowConcurrencyGroup(group *dbsqlc.ListWorkflowConcurrencyGroupsRow) *gen.WorkflowConcurrencyGroup {
+	return &gen.WorkflowConcurrencyGroup{
+		GroupKey:     group.GroupKey,
+		RunningCount: int(group.RunningCount),
+		QueuedCount:  int(group.QueuedCount),
+	}
+}
+
+func ToWorkflowRollout(rollout *dbsqlc.WorkflowRollout) *gen.WorkflowRollout {
+	return &gen.WorkflowRollout{
+		Metadata:         *toAPIMetadata(pgUUIDToStr(rollout.ID), rollout.CreatedAt.Time, rollout.UpdatedAt.Time),
+		WorkflowId:       uuid.MustParse(pgUUIDToStr(rollout.WorkflowId)),
+		StableVersionId:  uuid.MustParse(pgUUIDToStr(rollout.StableVersionId)),
+		CanaryPercentage: int(rollout.CanaryPercentage),
+	}
+}
+
 func ToWorkflowYAMLBytes(workflow *db.WorkflowModel, version *db.WorkflowVersionModel) ([]byte, error) {
 	res := &types.Workflow{
 		Name: workflow.Name,
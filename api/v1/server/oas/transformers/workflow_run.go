@@ -130,6 +130,21 @@ func ToWorkflowRun(
 		res.AdditionalMetadata = &additionalMetadata
 	}
 
+	if run.Output != nil {
+		output := string(run.Output)
+		res.Output = &output
+	}
+
+	if run.ReplayedFromId.Valid {
+		replayedFromId := uuid.MustParse(sqlchelpers.UUIDToStr(run.ReplayedFromId))
+		res.ReplayedFromId = &replayedFromId
+	}
+
+	if run.ReplayedFromStepId.Valid {
+		replayedFromStepId := uuid.MustParse(sqlchelpers.UUIDToStr(run.ReplayedFromStepId))
+		res.ReplayedFromStepId = &replayedFromStepId
+	}
+
 	return res, nil
 }
 
@@ -229,6 +244,12 @@ func ToStepRunFull(stepRun *repository.GetStepRunFull) *gen.StepRun {
 		res.ChildWorkflowRuns = &stepRun.ChildWorkflowRuns
 	}
 
+	res.NextRetryAt = stepRun.NextRetryAt
+
+	if stepRun.Progress != nil {
+		res.Progress = ToStepRunProgress(stepRun.Progress)
+	}
+
 	if stepRun.WorkerId.Valid {
 		workerId := sqlchelpers.UUIDToStr(stepRun.WorkerId)
 		res.WorkerId = &workerId
@@ -247,6 +268,28 @@ func ToStepRunFull(stepRun *repository.GetStepRunFull) *gen.StepRun {
 	return res
 }
 
+func ToStepRunProgress(progress *dbsqlc.StepRunProgress) *gen.StepRunProgress {
+	res := &gen.StepRunProgress{
+		Percent:   progress.Percent,
+		UpdatedAt: progress.UpdatedAt.Time,
+	}
+
+	if progress.Message.Valid {
+		message := progress.Message.String
+		res.Message = &message
+	}
+
+	if len(progress.Data) > 0 {
+		data := map[string]interface{}{}
+
+		if err := json.Unmarshal(progress.Data, &data); err == nil {
+			res.Data = &data
+		}
+	}
+
+	return res
+}
+
 func ToStepRun(stepRun *repository.StepRunForJobRun) *gen.StepRun {
 	res := &gen.StepRun{
 		Metadata: *toAPIMetadata(
@@ -398,6 +441,32 @@ func ToStepRunArchive(stepRunArchive *dbsqlc.StepRunResultArchive) *gen.StepRunA
 	return res
 }
 
+func ToSchedulingDecision(decision *dbsqlc.SchedulingDecision) *gen.SchedulingDecision {
+	res := &gen.SchedulingDecision{
+		Id:        int(decision.ID),
+		CreatedAt: decision.CreatedAt.Time,
+		StepRunId: sqlchelpers.UUIDToStr(decision.StepRunId),
+		Queue:     decision.Queue,
+		Outcome:   gen.SchedulingDecisionOutcome(decision.Outcome),
+		Message:   decision.Message,
+	}
+
+	if decision.WorkerId.Valid {
+		workerId := sqlchelpers.UUIDToStr(decision.WorkerId)
+		res.WorkerId = &workerId
+	}
+
+	if decision.Data != nil {
+		data := make(map[string]interface{})
+
+		json.Unmarshal(decision.Data, &data) // nolint:errcheck
+
+		res.Data = &data
+	}
+
+	return res
+}
+
 func byteSliceToStringPointer(b []byte) *string {
 	if b == nil {
 		return nil
@@ -502,6 +571,21 @@ func ToWorkflowRunFromSQLC(row *dbsqlc.ListWorkflowRunsRow) *gen.WorkflowRun {
 		AdditionalMetadata: &additionalMetadata,
 	}
 
+	if run.Output != nil {
+		output := string(run.Output)
+		res.Output = &output
+	}
+
+	if run.ReplayedFromId.Valid {
+		replayedFromId := uuid.MustParse(sqlchelpers.UUIDToStr(run.ReplayedFromId))
+		res.ReplayedFromId = &replayedFromId
+	}
+
+	if run.ReplayedFromStepId.Valid {
+		replayedFromStepId := uuid.MustParse(sqlchelpers.UUIDToStr(run.ReplayedFromStepId))
+		res.ReplayedFromStepId = &replayedFromStepId
+	}
+
 	return res
 }
 
@@ -568,6 +652,13 @@ func ToCronWorkflowsFromSQLC(cron *dbsqlc.ListCronWorkflowsRow) *gen.CronWorkflo
 		Name:               &cron.Name.String,
 		Enabled:            cron.Enabled,
 		Method:             gen.CronWorkflowsMethod(cron.Method),
+		Timezone:           cron.Timezone,
+		JitterSeconds:      int(cron.JitterSeconds),
+	}
+
+	if cron.ExclusionCalendarId.Valid {
+		exclusionCalendarId := sqlchelpers.UUIDToStr(cron.ExclusionCalendarId)
+		res.ExclusionCalendarId = &exclusionCalendarId
 	}
 
 	return res
@@ -14,10 +14,20 @@ import (
 	"github.com/hatchet-dev/hatchet/api/v1/server/authn"
 	"github.com/hatchet-dev/hatchet/api/v1/server/authz"
 	apitokens "github.com/hatchet-dev/hatchet/api/v1/server/handlers/api-tokens"
+	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/approvals"
+	auditlogs "github.com/hatchet-dev/hatchet/api/v1/server/handlers/audit-logs"
+	clientcas "github.com/hatchet-dev/hatchet/api/v1/server/handlers/client-cas"
+	croncalendars "github.com/hatchet-dev/hatchet/api/v1/server/handlers/cron-calendars"
+	deadletterqueue "github.com/hatchet-dev/hatchet/api/v1/server/handlers/dead-letter-queue"
+	eventdeduprules "github.com/hatchet-dev/hatchet/api/v1/server/handlers/event-dedup-rules"
+	eventroutingrules "github.com/hatchet-dev/hatchet/api/v1/server/handlers/event-routing-rules"
+	eventsinks "github.com/hatchet-dev/hatchet/api/v1/server/handlers/event-sinks"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/events"
+	inboundwebhooks "github.com/hatchet-dev/hatchet/api/v1/server/handlers/inbound-webhooks"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/ingestors"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/logs"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/metadata"
+	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/queues"
 	rate_limits "github.com/hatchet-dev/hatchet/api/v1/server/handlers/rate-limits"
 	slackapp "github.com/hatchet-dev/hatchet/api/v1/server/handlers/slack-app"
 	stepruns "github.com/hatchet-dev/hatchet/api/v1/server/handlers/step-runs"
@@ -28,7 +38,10 @@ import (
 	workflowruns "github.com/hatchet-dev/hatchet/api/v1/server/handlers/workflow-runs"
 	"github.com/hatchet-dev/hatchet/api/v1/server/handlers/workflows"
 	hatchetmiddleware "github.com/hatchet-dev/hatchet/api/v1/server/middleware"
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/audit"
 	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/populator"
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/staleness"
+	"github.com/hatchet-dev/hatchet/api/v1/server/middleware/tracing"
 	"github.com/hatchet-dev/hatchet/api/v1/server/oas/gen"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
@@ -49,24 +62,44 @@ type apiService struct {
 	*slackapp.SlackAppService
 	*webhookworker.WebhookWorkersService
 	*workflowruns.WorkflowRunsService
+	*queues.QueueService
+	*deadletterqueue.DeadLetterQueueService
+	*approvals.ApprovalService
+	*croncalendars.CronCalendarService
+	*inboundwebhooks.InboundWebhookService
+	*eventsinks.EventSinkService
+	*eventdeduprules.EventDedupRuleService
+	*eventroutingrules.EventRoutingRuleService
+	*clientcas.ClientCAService
+	*auditlogs.AuditLogService
 }
 
 func newAPIService(config *server.ServerConfig) *apiService {
 	return &apiService{
-		UserService:           users.NewUserService(config),
-		TenantService:         tenants.NewTenantService(config),
-		EventService:          events.NewEventService(config),
-		RateLimitService:      rate_limits.NewRateLimitService(config),
-		LogService:            logs.NewLogService(config),
-		WorkflowService:       workflows.NewWorkflowService(config),
-		WorkflowRunsService:   workflowruns.NewWorkflowRunsService(config),
-		WorkerService:         workers.NewWorkerService(config),
-		MetadataService:       metadata.NewMetadataService(config),
-		APITokenService:       apitokens.NewAPITokenService(config),
-		StepRunService:        stepruns.NewStepRunService(config),
-		IngestorsService:      ingestors.NewIngestorsService(config),
-		SlackAppService:       slackapp.NewSlackAppService(config),
-		WebhookWorkersService: webhookworker.NewWebhookWorkersService(config),
+		UserService:             users.NewUserService(config),
+		TenantService:           tenants.NewTenantService(config),
+		EventService:            events.NewEventService(config),
+		RateLimitService:        rate_limits.NewRateLimitService(config),
+		LogService:              logs.NewLogService(config),
+		WorkflowService:         workflows.NewWorkflowService(config),
+		WorkflowRunsService:     workflowruns.NewWorkflowRunsService(config),
+		WorkerService:           workers.NewWorkerService(config),
+		MetadataService:         metadata.NewMetadataService(config),
+		APITokenService:         apitokens.NewAPITokenService(config),
+		StepRunService:          stepruns.NewStepRunService(config),
+		IngestorsService:        ingestors.NewIngestorsService(config),
+		SlackAppService:         slackapp.NewSlackAppService(config),
+		WebhookWorkersService:   webhookworker.NewWebhookWorkersService(config),
+		QueueService:            queues.NewQueueService(config),
+		DeadLetterQueueService:  deadletterqueue.NewDeadLetterQueueService(config),
+		ApprovalService:         approvals.NewApprovalService(config),
+		CronCalendarService:     croncalendars.NewCronCalendarService(config),
+		InboundWebhookService:   inboundwebhooks.NewInboundWebhookService(config),
+		EventSinkService:        eventsinks.NewEventSinkService(config),
+		EventDedupRuleService:   eventdeduprules.NewEventDedupRuleService(config),
+		EventRoutingRuleService: eventroutingrules.NewEventRoutingRuleService(config),
+		ClientCAService:         clientcas.NewClientCAService(config),
+		AuditLogService:         auditlogs.NewAuditLogService(config),
 	}
 }
 
@@ -232,6 +265,16 @@ func (t *APIServer) registerSpec(g *echo.Group, spec *openapi3.T) (*populator.Po
 		return snsIntegration, snsIntegration.TenantID, nil
 	})
 
+	populatorMW.RegisterGetter("sqs", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		sqsIntegration, err := config.EngineRepository.SQSIntegration().GetSQSIntegrationById(context.Background(), id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return sqsIntegration, sqlchelpers.UUIDToStr(sqsIntegration.TenantId), nil
+	})
+
 	populatorMW.RegisterGetter("workflow", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
 		workflow, err := config.APIRepository.Workflow().GetWorkflowById(context.Background(), id)
 
@@ -259,6 +302,10 @@ func (t *APIServer) registerSpec(g *echo.Group, spec *openapi3.T) (*populator.Po
 			return nil, "", err
 		}
 
+		if scheduled == nil {
+			return nil, "", fmt.Errorf("scheduled workflow run %s not found", id)
+		}
+
 		return scheduled, sqlchelpers.UUIDToStr(scheduled.TenantId), nil
 	})
 
@@ -272,6 +319,66 @@ func (t *APIServer) registerSpec(g *echo.Group, spec *openapi3.T) (*populator.Po
 		return scheduled, sqlchelpers.UUIDToStr(scheduled.TenantId), nil
 	})
 
+	populatorMW.RegisterGetter("cron-calendar", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		calendar, err := config.EngineRepository.CronCalendar().GetCalendarById(context.Background(), id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return calendar, sqlchelpers.UUIDToStr(calendar.TenantId), nil
+	})
+
+	populatorMW.RegisterGetter("inbound-webhook", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		inboundWebhook, err := config.EngineRepository.InboundWebhook().GetInboundWebhookById(context.Background(), id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return inboundWebhook, sqlchelpers.UUIDToStr(inboundWebhook.TenantId), nil
+	})
+
+	populatorMW.RegisterGetter("event-sink", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		eventSink, err := config.EngineRepository.EventSink().GetEventSinkById(context.Background(), id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return eventSink, sqlchelpers.UUIDToStr(eventSink.TenantId), nil
+	})
+
+	populatorMW.RegisterGetter("event-dedup-rule", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		rule, err := config.EngineRepository.EventDedup().GetEventDedupRuleById(context.Background(), id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return rule, sqlchelpers.UUIDToStr(rule.TenantId), nil
+	})
+
+	populatorMW.RegisterGetter("event-routing-rule", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		rule, err := config.EngineRepository.EventRouting().GetEventRoutingRuleById(context.Background(), id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return rule, sqlchelpers.UUIDToStr(rule.TenantId), nil
+	})
+
+	populatorMW.RegisterGetter("client-ca", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		ca, err := config.EngineRepository.ClientCA().GetClientCAById(context.Background(), id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return ca, sqlchelpers.UUIDToStr(ca.TenantId), nil
+	})
+
 	populatorMW.RegisterGetter("step-run", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
 		stepRun, err := config.APIRepository.StepRun().GetStepRunById(id)
 
@@ -286,6 +393,16 @@ func (t *APIServer) registerSpec(g *echo.Group, spec *openapi3.T) (*populator.Po
 		return stepRun, sqlchelpers.UUIDToStr(stepRun.TenantId), nil
 	})
 
+	populatorMW.RegisterGetter("approval", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
+		approval, err := config.EngineRepository.Approval().GetApprovalById(context.Background(), id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return approval, sqlchelpers.UUIDToStr(approval.TenantId), nil
+	})
+
 	populatorMW.RegisterGetter("event", func(config *server.ServerConfig, parentId, id string) (result interface{}, uniqueParentId string, err error) {
 		event, err := config.APIRepository.Event().GetEventById(id)
 
@@ -326,6 +443,7 @@ func (t *APIServer) registerSpec(g *echo.Group, spec *openapi3.T) (*populator.Po
 
 	authnMW := authn.NewAuthN(t.config)
 	authzMW := authz.NewAuthZ(t.config)
+	auditMW := audit.NewAudit(t.config)
 
 	mw, err := hatchetmiddleware.NewMiddlewareHandler(spec)
 
@@ -391,6 +509,9 @@ func (t *APIServer) registerSpec(g *echo.Group, spec *openapi3.T) (*populator.Po
 	g.Use(
 		loggerMiddleware,
 		middleware.Recover(),
+		tracing.Middleware,
+		staleness.Middleware,
+		auditMW.Middleware,
 		allHatchetMiddleware,
 	)
 
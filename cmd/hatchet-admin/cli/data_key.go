@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/pkg/config/loader"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+var dataKeyTenantId string
+
+var dataKeyCmd = &cobra.Command{
+	Use:   "data-key",
+	Short: "command for managing the data keys which payloads are encrypted with.",
+}
+
+var dataKeyRotateCmd = &cobra.Command{
+	Use:   "rotate",
+	Short: "rotate the data key of a tenant, or of all tenants if no tenant is given.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		err := runRotateDataKeys(configLoader)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [data-key rotate] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(dataKeyCmd)
+	dataKeyCmd.AddCommand(dataKeyRotateCmd)
+
+	dataKeyRotateCmd.PersistentFlags().StringVar(
+		&dataKeyTenantId,
+		"tenant-id",
+		"",
+		"the tenant ID to rotate the data key for",
+	)
+}
+
+func runRotateDataKeys(cf *loader.ConfigLoader) error {
+	cleanup, serverConf, err := cf.LoadServerConfig("", func(scf *server.ServerConfigFile) {
+		// disable rabbitmq since it's not needed to rotate data keys
+		scf.MessageQueue.Enabled = false
+
+		// disable security checks since we're not running the server
+		scf.SecurityCheck.Enabled = false
+	})
+
+	if err != nil {
+		return err
+	}
+
+	defer cleanup() // nolint:errcheck
+
+	defer serverConf.Disconnect() // nolint:errcheck
+
+	if serverConf.PayloadEncryption == nil {
+		return fmt.Errorf("payload encryption is not enabled, set SERVER_ENCRYPTION_PAYLOADS_ENABLED=true")
+	}
+
+	ctx := context.Background()
+
+	tenantIds := []string{dataKeyTenantId}
+
+	if dataKeyTenantId == "" {
+		tenants, err := serverConf.EngineRepository.Tenant().ListTenants(ctx)
+
+		if err != nil {
+			return fmt.Errorf("could not list tenants: %w", err)
+		}
+
+		tenantIds = make([]string, len(tenants))
+
+		for i, tenant := range tenants {
+			tenantIds[i] = sqlchelpers.UUIDToStr(tenant.ID)
+		}
+	}
+
+	for _, tenantId := range tenantIds {
+		if err := serverConf.PayloadEncryption.RotateDataKey(ctx, tenantId); err != nil {
+			return fmt.Errorf("could not rotate data key for tenant %s: %w", tenantId, err)
+		}
+
+		fmt.Printf("rotated data key for tenant %s\n", tenantId)
+	}
+
+	return nil
+}
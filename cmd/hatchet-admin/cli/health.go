@@ -0,0 +1,167 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/pkg/config/loader"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// partitionInactiveAfter is the time after which the engines consider a partition inactive, and move its tenants to
+// the active partitions.
+const partitionInactiveAfter = time.Minute
+
+var (
+	healthFollow   bool
+	healthInterval time.Duration
+)
+
+var healthCmd = &cobra.Command{
+	Use:   "health",
+	Short: "print the partitions, dispatchers and leases of the engines along with the number of queued step runs.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		err := runHealth(configLoader)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [health] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(healthCmd)
+
+	healthCmd.PersistentFlags().BoolVarP(
+		&healthFollow,
+		"follow",
+		"f",
+		false,
+		"print the health of the engines until interrupted",
+	)
+
+	healthCmd.PersistentFlags().DurationVar(
+		&healthInterval,
+		"interval",
+		5*time.Second,
+		"the interval at which the health is printed with --follow",
+	)
+}
+
+func runHealth(cf *loader.ConfigLoader) error {
+	dc, err := cf.LoadDatabaseConfig()
+
+	if err != nil {
+		return err
+	}
+
+	defer dc.Disconnect() // nolint: errcheck
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	for {
+		if err := printHealth(ctx, dc.EngineRepository); err != nil {
+			return err
+		}
+
+		if !healthFollow {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(healthInterval):
+		}
+
+		fmt.Println()
+	}
+}
+
+func printHealth(ctx context.Context, repo repository.EngineRepository) error {
+	health, err := repo.Operations().GetEngineHealth(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	queueCounts, err := repo.StepRun().ListQueueCountsForAllTenants(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	var queued int
+
+	for _, counts := range queueCounts {
+		for _, count := range counts {
+			queued += count
+		}
+	}
+
+	fmt.Printf("%s: %d queued step runs across %d tenants\n\n", time.Now().Format(time.RFC3339), queued, len(queueCounts))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "PARTITION\tKIND\tNAME\tTENANTS\tLAST HEARTBEAT\tSTATUS") // nolint: errcheck
+
+	for _, p := range health.Partitions {
+		since := time.Since(p.LastHeartbeat.Time)
+		status := "active"
+
+		if since > partitionInactiveAfter {
+			status = "inactive"
+		}
+
+		fmt.Fprintf( // nolint: errcheck
+			w, "%s\t%s\t%s\t%d\t%s ago\t%s\n",
+			p.ID, p.Kind, p.Name.String, p.Tenants, since.Round(time.Second), status,
+		)
+	}
+
+	w.Flush() // nolint: errcheck
+
+	fmt.Println()
+
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "DISPATCHER\tACTIVE\tWORKERS\tLAST HEARTBEAT") // nolint: errcheck
+
+	for _, d := range health.Dispatchers {
+		fmt.Fprintf( // nolint: errcheck
+			w, "%s\t%t\t%d\t%s ago\n",
+			sqlchelpers.UUIDToStr(d.ID), d.IsActive, d.Workers, time.Since(d.LastHeartbeatAt.Time).Round(time.Second),
+		)
+	}
+
+	w.Flush() // nolint: errcheck
+
+	if len(health.Leases) == 0 {
+		return nil
+	}
+
+	fmt.Println()
+
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "ENGINE LEASE\tHOLDER\tEXPIRES") // nolint: errcheck
+
+	for _, l := range health.Leases {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", l.Name, l.HolderId, formatExpiry(l.ExpiresAt.Time)) // nolint: errcheck
+	}
+
+	return w.Flush()
+}
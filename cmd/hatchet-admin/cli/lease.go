@@ -0,0 +1,218 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/pkg/config/loader"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+var (
+	leaseTenantId    string
+	leaseKind        string
+	leaseIds         []int64
+	leaseEngineLease string
+)
+
+var leaseCmd = &cobra.Command{
+	Use:   "lease",
+	Short: "command for managing the leases which the schedulers and engines hold on queues, workers and maintenance jobs.",
+}
+
+var leaseListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list the leases of the schedulers on queues and workers, and the leases of the engines.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		err := runListLeases(configLoader)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [lease list] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var leaseReleaseCmd = &cobra.Command{
+	Use:   "release",
+	Short: "release leases which are held by a scheduler or engine which is stuck, so they're acquired by another one.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		err := runReleaseLeases(configLoader)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [lease release] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(leaseCmd)
+	leaseCmd.AddCommand(leaseListCmd)
+	leaseCmd.AddCommand(leaseReleaseCmd)
+
+	leaseListCmd.PersistentFlags().StringVar(
+		&leaseTenantId,
+		"tenant-id",
+		"",
+		"only list the leases of a tenant",
+	)
+
+	leaseListCmd.PersistentFlags().StringVar(
+		&leaseKind,
+		"kind",
+		"",
+		"only list the leases of a kind, one of QUEUE or WORKER",
+	)
+
+	leaseReleaseCmd.PersistentFlags().Int64SliceVar(
+		&leaseIds,
+		"id",
+		nil,
+		"the IDs of the scheduler leases to release",
+	)
+
+	leaseReleaseCmd.PersistentFlags().StringVar(
+		&leaseEngineLease,
+		"engine-lease",
+		"",
+		"the name of the engine lease to release",
+	)
+}
+
+func runListLeases(cf *loader.ConfigLoader) error {
+	dc, err := cf.LoadDatabaseConfig()
+
+	if err != nil {
+		return err
+	}
+
+	defer dc.Disconnect() // nolint: errcheck
+
+	ctx := context.Background()
+
+	opts := &repository.ListLeasesOpts{}
+
+	if leaseTenantId != "" {
+		opts.TenantId = &leaseTenantId
+	}
+
+	if leaseKind != "" {
+		kind := dbsqlc.LeaseKind(leaseKind)
+		opts.Kind = &kind
+	}
+
+	leases, err := dc.EngineRepository.Operations().ListLeases(ctx, opts)
+
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "ID\tTENANT\tKIND\tRESOURCE\tFENCING TOKEN\tEXPIRES") // nolint: errcheck
+
+	for _, l := range leases {
+		fmt.Fprintf( // nolint: errcheck
+			w, "%d\t%s\t%s\t%s\t%d\t%s\n",
+			l.ID, sqlchelpers.UUIDToStr(l.TenantId), l.Kind, l.ResourceId, l.FencingToken, formatExpiry(l.ExpiresAt.Time),
+		)
+	}
+
+	w.Flush() // nolint: errcheck
+
+	// engine leases aren't held by tenants
+	if leaseTenantId != "" || leaseKind != "" {
+		return nil
+	}
+
+	engineLeases, err := dc.EngineRepository.Operations().ListEngineLeases(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "ENGINE LEASE\tHOLDER\tEXPIRES") // nolint: errcheck
+
+	for _, l := range engineLeases {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", l.Name, l.HolderId, formatExpiry(l.ExpiresAt.Time)) // nolint: errcheck
+	}
+
+	return w.Flush()
+}
+
+func runReleaseLeases(cf *loader.ConfigLoader) error {
+	if len(leaseIds) == 0 && leaseEngineLease == "" {
+		return errors.New("either --id or --engine-lease must be set")
+	}
+
+	dc, err := cf.LoadDatabaseConfig()
+
+	if err != nil {
+		return err
+	}
+
+	defer dc.Disconnect() // nolint: errcheck
+
+	ctx := context.Background()
+
+	if len(leaseIds) > 0 {
+		released, err := dc.EngineRepository.Operations().ReleaseLeases(ctx, leaseIds)
+
+		if err != nil {
+			return err
+		}
+
+		for _, l := range released {
+			fmt.Printf("released %s lease %d on %s of tenant %s\n", l.Kind, l.ID, l.ResourceId, sqlchelpers.UUIDToStr(l.TenantId))
+		}
+
+		if len(released) < len(leaseIds) {
+			fmt.Printf("%d leases weren't found\n", len(leaseIds)-len(released))
+		}
+	}
+
+	if leaseEngineLease != "" {
+		released, err := dc.EngineRepository.Operations().ReleaseEngineLease(ctx, leaseEngineLease)
+
+		if err != nil {
+			return err
+		}
+
+		if !released {
+			return fmt.Errorf("engine lease %s isn't held", leaseEngineLease)
+		}
+
+		fmt.Printf("released engine lease %s\n", leaseEngineLease)
+	}
+
+	return nil
+}
+
+// formatExpiry formats the expiry of a lease relative to now.
+func formatExpiry(expiresAt time.Time) string {
+	d := time.Until(expiresAt).Round(time.Second)
+
+	if d < 0 {
+		return fmt.Sprintf("expired %s ago", -d)
+	}
+
+	return fmt.Sprintf("in %s", d)
+}
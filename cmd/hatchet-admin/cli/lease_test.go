@@ -0,0 +1,13 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatExpiry(t *testing.T) {
+	assert.Equal(t, "in 1m0s", formatExpiry(time.Now().Add(time.Minute+100*time.Millisecond)))
+	assert.Equal(t, "expired 1h0m0s ago", formatExpiry(time.Now().Add(-time.Hour)))
+}
@@ -0,0 +1,242 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/internal/migrate"
+	"github.com/hatchet-dev/hatchet/pkg/logger"
+)
+
+var (
+	migrateDatabaseUrl   string
+	migrateDir           string
+	migrateLockTimeout   time.Duration
+	migrateMaxRetries    int
+	migrateBackfillPause time.Duration
+	migrateMaxDowntime   time.Duration
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "command for applying the database migrations without blocking the tables for longer than necessary.",
+}
+
+var migrateCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "list the pending migrations along with the tables which they lock and the estimated downtime, without applying them.",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := runMigrateCheck()
+
+		if err != nil {
+			log.Printf("Fatal: could not run [migrate check] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var migrateApplyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "apply the pending migrations. Statements which time out waiting for a lock are retried, and migrations which failed are resumed from the failed statement.",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := runMigrateApply()
+
+		if err != nil {
+			log.Printf("Fatal: could not run [migrate apply] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(migrateCmd)
+	migrateCmd.AddCommand(migrateCheckCmd)
+	migrateCmd.AddCommand(migrateApplyCmd)
+
+	defaults := migrate.DefaultOptions()
+
+	migrateCmd.PersistentFlags().StringVar(
+		&migrateDatabaseUrl,
+		"url",
+		os.Getenv("DATABASE_URL"),
+		"the url of the database, which must be a direct connection rather than a connection through PgBouncer. Defaults to DATABASE_URL",
+	)
+
+	migrateCmd.PersistentFlags().StringVar(
+		&migrateDir,
+		"dir",
+		"sql/migrations",
+		"the directory of the migrations",
+	)
+
+	migrateApplyCmd.PersistentFlags().DurationVar(
+		&migrateLockTimeout,
+		"lock-timeout",
+		defaults.LockTimeout,
+		"the time a statement waits for the locks of its tables before it's cancelled and retried",
+	)
+
+	migrateApplyCmd.PersistentFlags().IntVar(
+		&migrateMaxRetries,
+		"max-retries",
+		defaults.MaxRetries,
+		"the number of times a statement which timed out waiting for a lock is retried",
+	)
+
+	migrateApplyCmd.PersistentFlags().DurationVar(
+		&migrateBackfillPause,
+		"backfill-pause",
+		defaults.BackfillPause,
+		"the time between the batches of a backfill",
+	)
+
+	migrateApplyCmd.PersistentFlags().DurationVar(
+		&migrateMaxDowntime,
+		"max-downtime",
+		0,
+		"refuse to apply the migrations if the estimated downtime is longer than this, 0 is unlimited",
+	)
+}
+
+func runMigrateCheck() error {
+	ctx := context.Background()
+
+	runner, migrations, closeConn, err := newMigrateRunner(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer closeConn()
+
+	report, err := runner.Check(ctx, migrations)
+
+	if err != nil {
+		return err
+	}
+
+	printMigrateReport(report)
+
+	return nil
+}
+
+func runMigrateApply() error {
+	ctx := context.Background()
+
+	runner, migrations, closeConn, err := newMigrateRunner(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer closeConn()
+
+	if migrateMaxDowntime > 0 {
+		report, err := runner.Check(ctx, migrations)
+
+		if err != nil {
+			return err
+		}
+
+		if report.Downtime > migrateMaxDowntime {
+			printMigrateReport(report)
+
+			return fmt.Errorf("the estimated downtime of %s is longer than the max downtime of %s", report.Downtime.Round(time.Second), migrateMaxDowntime)
+		}
+	}
+
+	applied, err := runner.Apply(ctx, migrations)
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("applied %d migrations\n", applied)
+
+	return nil
+}
+
+func newMigrateRunner(ctx context.Context) (*migrate.Runner, []*migrate.Migration, func(), error) {
+	if migrateDatabaseUrl == "" {
+		return nil, nil, nil, errors.New("the database url must be set with --url or DATABASE_URL")
+	}
+
+	migrations, err := migrate.Load(migrateDir)
+
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	conn, err := pgx.Connect(ctx, migrateDatabaseUrl)
+
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not connect to database: %w", err)
+	}
+
+	l := logger.NewDefaultLogger("migrate")
+
+	runner := migrate.NewRunner(conn, &l, migrate.Options{
+		LockTimeout:   migrateLockTimeout,
+		MaxRetries:    migrateMaxRetries,
+		BackfillPause: migrateBackfillPause,
+	})
+
+	closeConn := func() {
+		conn.Close(context.Background()) // nolint: errcheck
+	}
+
+	return runner, migrations, closeConn, nil
+}
+
+func printMigrateReport(report *migrate.Report) {
+	if len(report.Pending) == 0 {
+		fmt.Println("no pending migrations")
+		return
+	}
+
+	fmt.Printf("%d pending migrations\n\n", len(report.Pending))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "MIGRATION\tOPERATION\tTABLE\tROWS\tSIZE\tIMPACT\tESTIMATE") // nolint: errcheck
+
+	for _, s := range report.Statements {
+		if s.Impact == migrate.ImpactNone {
+			continue
+		}
+
+		fmt.Fprintf( // nolint: errcheck
+			w, "%s\t%s\t%s\t%d\t%s\t%s\t%s\n",
+			s.Migration, s.Operation, s.Table, s.Rows, formatBytes(s.Bytes), s.Impact, s.Duration.Round(time.Second),
+		)
+	}
+
+	w.Flush() // nolint: errcheck
+
+	fmt.Printf("\nestimated downtime: %s\n", report.Downtime.Round(time.Second))
+}
+
+func formatBytes(b int64) string {
+	const unit = 1024
+
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+
+	div, exp := int64(unit), 0
+
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
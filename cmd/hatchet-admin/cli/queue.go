@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/pkg/config/loader"
+)
+
+var (
+	queueTenantId string
+	queueName     string
+)
+
+var queueCmd = &cobra.Command{
+	Use:   "queue",
+	Short: "command for pausing and resuming the queues of tenants.",
+}
+
+var queueListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list the queues of a tenant which were active in the last day.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		err := runListQueues(configLoader)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [queue list] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var queuePauseCmd = &cobra.Command{
+	Use:   "pause",
+	Short: "pause a queue. Step runs stay queued, but aren't assigned to workers until the queue is resumed.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		err := runSetQueuePaused(configLoader, true)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [queue pause] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var queueResumeCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "resume a paused queue.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		err := runSetQueuePaused(configLoader, false)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [queue resume] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(queueCmd)
+	queueCmd.AddCommand(queueListCmd)
+	queueCmd.AddCommand(queuePauseCmd)
+	queueCmd.AddCommand(queueResumeCmd)
+
+	queueCmd.PersistentFlags().StringVar(
+		&queueTenantId,
+		"tenant-id",
+		"",
+		"the tenant ID",
+	)
+
+	queueCmd.MarkPersistentFlagRequired("tenant-id") // nolint: errcheck
+
+	for _, cmd := range []*cobra.Command{queuePauseCmd, queueResumeCmd} {
+		cmd.PersistentFlags().StringVar(
+			&queueName,
+			"queue",
+			"",
+			"the name of the queue, which is the action ID of its steps, like my-workflow:step-one",
+		)
+
+		cmd.MarkPersistentFlagRequired("queue") // nolint: errcheck
+	}
+}
+
+func runListQueues(cf *loader.ConfigLoader) error {
+	dc, err := cf.LoadDatabaseConfig()
+
+	if err != nil {
+		return err
+	}
+
+	defer dc.Disconnect() // nolint: errcheck
+
+	ctx := context.Background()
+
+	queues, err := dc.APIRepository.Queue().ListQueues(ctx, queueTenantId)
+
+	if err != nil {
+		return err
+	}
+
+	counts, err := dc.EngineRepository.StepRun().GetQueueCounts(ctx, queueTenantId)
+
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "QUEUE\tQUEUED\tPAUSED\tLAST ACTIVE") // nolint: errcheck
+
+	for _, q := range queues {
+		fmt.Fprintf(w, "%s\t%d\t%t\t%s\n", q.Name, counts[q.Name], q.IsPaused, q.LastActive.Time.Format("2006-01-02 15:04:05")) // nolint: errcheck
+	}
+
+	return w.Flush()
+}
+
+func runSetQueuePaused(cf *loader.ConfigLoader, isPaused bool) error {
+	dc, err := cf.LoadDatabaseConfig()
+
+	if err != nil {
+		return err
+	}
+
+	defer dc.Disconnect() // nolint: errcheck
+
+	queue, err := dc.APIRepository.Queue().SetQueuePaused(context.Background(), queueTenantId, queueName, isPaused)
+
+	if err != nil {
+		return fmt.Errorf("could not update queue %s: %w", queueName, err)
+	}
+
+	if queue.IsPaused {
+		fmt.Printf("queue %s of tenant %s paused\n", queue.Name, queueTenantId)
+	} else {
+		fmt.Printf("queue %s of tenant %s resumed\n", queue.Name, queueTenantId)
+	}
+
+	return nil
+}
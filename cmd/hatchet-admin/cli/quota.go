@@ -0,0 +1,204 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/pkg/config/loader"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+var (
+	quotaTenantId            string
+	quotaMaxQueuedRuns       int
+	quotaMaxWorkers          int
+	quotaMaxEventsPerMinute  int
+	quotaMaxWorkflowVersions int
+)
+
+var quotaCmd = &cobra.Command{
+	Use:   "quota",
+	Short: "command for managing the quotas of tenants.",
+}
+
+var quotaSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "set the quotas of a tenant. Quotas which aren't passed are unchanged, -1 resets a quota to the server default and 0 is unlimited.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		err := runSetQuota(cmd, configLoader)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [quota set] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var quotaGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "get the quotas which apply to a tenant and their current usage.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		err := runGetQuota(configLoader)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [quota get] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(quotaCmd)
+	quotaCmd.AddCommand(quotaSetCmd)
+	quotaCmd.AddCommand(quotaGetCmd)
+
+	quotaCmd.PersistentFlags().StringVar(
+		&quotaTenantId,
+		"tenant-id",
+		"",
+		"the tenant ID",
+	)
+
+	quotaCmd.MarkPersistentFlagRequired("tenant-id") // nolint: errcheck
+
+	quotaSetCmd.PersistentFlags().IntVar(
+		&quotaMaxQueuedRuns,
+		"max-queued-runs",
+		0,
+		"the max number of workflow runs which are pending or queued at a time",
+	)
+
+	quotaSetCmd.PersistentFlags().IntVar(
+		&quotaMaxWorkers,
+		"max-workers",
+		0,
+		"the max number of active workers",
+	)
+
+	quotaSetCmd.PersistentFlags().IntVar(
+		&quotaMaxEventsPerMinute,
+		"max-events-per-minute",
+		0,
+		"the max number of events which are ingested in a minute",
+	)
+
+	quotaSetCmd.PersistentFlags().IntVar(
+		&quotaMaxWorkflowVersions,
+		"max-workflow-versions",
+		0,
+		"the max number of workflow versions across the workflows of the tenant",
+	)
+}
+
+func runSetQuota(cmd *cobra.Command, cf *loader.ConfigLoader) error {
+	dc, err := cf.LoadDatabaseConfig()
+
+	if err != nil {
+		return err
+	}
+
+	defer dc.Disconnect() // nolint: errcheck
+
+	ctx := context.Background()
+
+	opts := &repository.UpsertTenantQuotaOpts{}
+
+	// start from the quotas which are set, so the quotas which aren't passed are unchanged
+	quota, err := dc.EngineRepository.TenantQuota().GetTenantQuota(ctx, quotaTenantId)
+
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	if err == nil {
+		opts.MaxQueuedRuns = int32Ptr(quota.MaxQueuedRuns)
+		opts.MaxWorkers = int32Ptr(quota.MaxWorkers)
+		opts.MaxEventsPerMinute = int32Ptr(quota.MaxEventsPerMinute)
+		opts.MaxWorkflowVersions = int32Ptr(quota.MaxWorkflowVersions)
+	}
+
+	flags := map[string]struct {
+		value int
+		opt   **int32
+	}{
+		"max-queued-runs":       {quotaMaxQueuedRuns, &opts.MaxQueuedRuns},
+		"max-workers":           {quotaMaxWorkers, &opts.MaxWorkers},
+		"max-events-per-minute": {quotaMaxEventsPerMinute, &opts.MaxEventsPerMinute},
+		"max-workflow-versions": {quotaMaxWorkflowVersions, &opts.MaxWorkflowVersions},
+	}
+
+	for name, flag := range flags {
+		if !cmd.Flags().Changed(name) {
+			continue
+		}
+
+		if flag.value < 0 {
+			*flag.opt = nil
+			continue
+		}
+
+		value := int32(flag.value) // nolint: gosec
+		*flag.opt = &value
+	}
+
+	_, err = dc.EngineRepository.TenantQuota().UpsertTenantQuota(ctx, quotaTenantId, opts)
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("quotas of tenant %s updated, they can take a few seconds to apply\n", quotaTenantId)
+
+	return printQuotaUsage(ctx, dc.EngineRepository)
+}
+
+func runGetQuota(cf *loader.ConfigLoader) error {
+	dc, err := cf.LoadDatabaseConfig()
+
+	if err != nil {
+		return err
+	}
+
+	defer dc.Disconnect() // nolint: errcheck
+
+	return printQuotaUsage(context.Background(), dc.EngineRepository)
+}
+
+func printQuotaUsage(ctx context.Context, repo repository.EngineRepository) error {
+	usages, err := repo.TenantQuota().ListQuotaUsage(ctx, quotaTenantId)
+
+	if err != nil {
+		return err
+	}
+
+	for _, usage := range usages {
+		limit := "unlimited"
+
+		if usage.Limit > 0 {
+			limit = fmt.Sprintf("%d", usage.Limit)
+		}
+
+		fmt.Printf("%s: %d used, quota %s\n", usage.Resource, usage.Usage, limit)
+	}
+
+	return nil
+}
+
+func int32Ptr(i pgtype.Int4) *int32 {
+	if !i.Valid {
+		return nil
+	}
+
+	return &i.Int32
+}
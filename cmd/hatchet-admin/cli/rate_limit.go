@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/pkg/config/loader"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+var (
+	globalRateLimitKey      string
+	globalRateLimitLimit    int
+	globalRateLimitDuration string
+)
+
+var rateLimitCmd = &cobra.Command{
+	Use:   "rate-limit",
+	Short: "command for managing global rate limits.",
+}
+
+var rateLimitPutGlobalCmd = &cobra.Command{
+	Use:   "put-global",
+	Short: "create or update a rate limit which is shared by all tenants.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		err := runPutGlobalRateLimit(configLoader)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [rate-limit put-global] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var rateLimitListGlobalCmd = &cobra.Command{
+	Use:   "list-global",
+	Short: "list the rate limits which are shared by all tenants.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		err := runListGlobalRateLimits(configLoader)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [rate-limit list-global] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(rateLimitCmd)
+	rateLimitCmd.AddCommand(rateLimitPutGlobalCmd)
+	rateLimitCmd.AddCommand(rateLimitListGlobalCmd)
+
+	rateLimitPutGlobalCmd.PersistentFlags().StringVar(
+		&globalRateLimitKey,
+		"key",
+		"",
+		"the key of the global rate limit",
+	)
+
+	rateLimitPutGlobalCmd.MarkPersistentFlagRequired("key") // nolint: errcheck
+
+	rateLimitPutGlobalCmd.PersistentFlags().IntVar(
+		&globalRateLimitLimit,
+		"limit",
+		0,
+		"the max number of units per window",
+	)
+
+	rateLimitPutGlobalCmd.MarkPersistentFlagRequired("limit") // nolint: errcheck
+
+	rateLimitPutGlobalCmd.PersistentFlags().StringVar(
+		&globalRateLimitDuration,
+		"duration",
+		"MINUTE",
+		"the rate limit window, one of SECOND, MINUTE, HOUR, DAY, WEEK, MONTH or YEAR",
+	)
+}
+
+func runPutGlobalRateLimit(cf *loader.ConfigLoader) error {
+	dc, err := cf.LoadDatabaseConfig()
+
+	if err != nil {
+		return err
+	}
+
+	defer dc.Disconnect() // nolint: errcheck
+
+	rl, err := dc.EngineRepository.RateLimit().UpsertGlobalRateLimit(context.Background(), globalRateLimitKey, &repository.UpsertRateLimitOpts{
+		Limit:    globalRateLimitLimit,
+		Duration: &globalRateLimitDuration,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("global rate limit %s set to %d per %s\n", rl.Key, rl.LimitValue, rl.Window)
+
+	return nil
+}
+
+func runListGlobalRateLimits(cf *loader.ConfigLoader) error {
+	dc, err := cf.LoadDatabaseConfig()
+
+	if err != nil {
+		return err
+	}
+
+	defer dc.Disconnect() // nolint: errcheck
+
+	rls, err := dc.EngineRepository.RateLimit().ListGlobalRateLimits(context.Background())
+
+	if err != nil {
+		return err
+	}
+
+	for _, rl := range rls {
+		fmt.Printf("%s\t%d/%d per %s\n", rl.Key, rl.Value, rl.LimitValue, rl.Window)
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/pkg/config/loader"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+var (
+	retentionTenantId     string
+	retentionWorkflowRuns string
+	retentionEvents       string
+)
+
+var retentionCmd = &cobra.Command{
+	Use:   "retention",
+	Short: "command for managing the retention policies of tenants.",
+}
+
+var retentionSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "set the retention policy of a tenant. Periods which aren't passed are unchanged and an empty period resets it to the data retention period of the tenant.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		err := runSetRetention(cmd, configLoader)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [retention set] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var retentionGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "get the retention periods which apply to a tenant.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		err := runGetRetention(configLoader)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [retention get] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(retentionCmd)
+	retentionCmd.AddCommand(retentionSetCmd)
+	retentionCmd.AddCommand(retentionGetCmd)
+
+	retentionCmd.PersistentFlags().StringVar(
+		&retentionTenantId,
+		"tenant-id",
+		"",
+		"the tenant ID",
+	)
+
+	retentionCmd.MarkPersistentFlagRequired("tenant-id") // nolint: errcheck
+
+	retentionSetCmd.PersistentFlags().StringVar(
+		&retentionWorkflowRuns,
+		"workflow-runs",
+		"",
+		"the retention period of workflow runs and their step runs, like 720h",
+	)
+
+	retentionSetCmd.PersistentFlags().StringVar(
+		&retentionEvents,
+		"events",
+		"",
+		"the retention period of events, like 168h",
+	)
+}
+
+func runSetRetention(cmd *cobra.Command, cf *loader.ConfigLoader) error {
+	dc, err := cf.LoadDatabaseConfig()
+
+	if err != nil {
+		return err
+	}
+
+	defer dc.Disconnect() // nolint: errcheck
+
+	ctx := context.Background()
+
+	opts := &repository.UpsertRetentionPolicyOpts{}
+
+	// start from the periods which are set, so the periods which aren't passed are unchanged
+	policy, err := dc.EngineRepository.RetentionPolicy().GetRetentionPolicy(ctx, retentionTenantId)
+
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	if err == nil {
+		opts.WorkflowRunRetentionPeriod = stringPtr(policy.WorkflowRunRetentionPeriod)
+		opts.EventRetentionPeriod = stringPtr(policy.EventRetentionPeriod)
+	}
+
+	flags := map[string]struct {
+		value string
+		opt   **string
+	}{
+		"workflow-runs": {retentionWorkflowRuns, &opts.WorkflowRunRetentionPeriod},
+		"events":        {retentionEvents, &opts.EventRetentionPeriod},
+	}
+
+	for name, flag := range flags {
+		if !cmd.Flags().Changed(name) {
+			continue
+		}
+
+		if flag.value == "" {
+			*flag.opt = nil
+			continue
+		}
+
+		value := flag.value
+		*flag.opt = &value
+	}
+
+	_, err = dc.EngineRepository.RetentionPolicy().UpsertRetentionPolicy(ctx, retentionTenantId, opts)
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("retention policy of tenant %s updated\n", retentionTenantId)
+
+	return printRetentionPeriods(ctx, dc.EngineRepository)
+}
+
+func runGetRetention(cf *loader.ConfigLoader) error {
+	dc, err := cf.LoadDatabaseConfig()
+
+	if err != nil {
+		return err
+	}
+
+	defer dc.Disconnect() // nolint: errcheck
+
+	return printRetentionPeriods(context.Background(), dc.EngineRepository)
+}
+
+func printRetentionPeriods(ctx context.Context, repo repository.EngineRepository) error {
+	tenant, err := repo.Tenant().GetTenantByID(ctx, retentionTenantId)
+
+	if err != nil {
+		return err
+	}
+
+	workflowRuns := tenant.DataRetentionPeriod
+	events := tenant.DataRetentionPeriod
+
+	policy, err := repo.RetentionPolicy().GetRetentionPolicy(ctx, retentionTenantId)
+
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	if err == nil {
+		if policy.WorkflowRunRetentionPeriod.Valid {
+			workflowRuns = policy.WorkflowRunRetentionPeriod.String
+		}
+
+		if policy.EventRetentionPeriod.Valid {
+			events = policy.EventRetentionPeriod.String
+		}
+	}
+
+	fmt.Printf("data retention period: %s\n", tenant.DataRetentionPeriod)
+	fmt.Printf("workflow runs: %s\n", workflowRuns)
+	fmt.Printf("events: %s\n", events)
+
+	return nil
+}
+
+func stringPtr(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+
+	return &t.String
+}
@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/secrets"
+	"github.com/hatchet-dev/hatchet/pkg/config/loader"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+)
+
+var (
+	secretTenantId string
+	secretName     string
+	secretValue    string
+)
+
+var secretCmd = &cobra.Command{
+	Use:   "secret",
+	Short: "command for managing the secrets which step run inputs can reference.",
+}
+
+var secretPutCmd = &cobra.Command{
+	Use:   "put",
+	Short: "set a secret of a tenant. The value is read from stdin if --value is not given.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		err := runSecretCommand(configLoader, func(ctx context.Context, store secrets.SecretStore) error {
+			value := secretValue
+
+			if value == "" {
+				stdin, err := io.ReadAll(os.Stdin)
+
+				if err != nil {
+					return fmt.Errorf("could not read secret value from stdin: %w", err)
+				}
+
+				value = strings.TrimRight(string(stdin), "\r\n")
+			}
+
+			if err := store.PutSecret(ctx, secretTenantId, secretName, value); err != nil {
+				return err
+			}
+
+			fmt.Printf("set secret %s for tenant %s\n", secretName, secretTenantId)
+
+			return nil
+		})
+
+		if err != nil {
+			log.Printf("Fatal: could not run [secret put] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var secretDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "delete a secret of a tenant.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		err := runSecretCommand(configLoader, func(ctx context.Context, store secrets.SecretStore) error {
+			if err := store.DeleteSecret(ctx, secretTenantId, secretName); err != nil {
+				return err
+			}
+
+			fmt.Printf("deleted secret %s for tenant %s\n", secretName, secretTenantId)
+
+			return nil
+		})
+
+		if err != nil {
+			log.Printf("Fatal: could not run [secret delete] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(secretCmd)
+	secretCmd.AddCommand(secretPutCmd)
+	secretCmd.AddCommand(secretDeleteCmd)
+
+	secretCmd.PersistentFlags().StringVar(
+		&secretTenantId,
+		"tenant-id",
+		"",
+		"the tenant ID which the secret belongs to",
+	)
+
+	secretCmd.PersistentFlags().StringVar(
+		&secretName,
+		"name",
+		"",
+		"the name of the secret, as referenced by {{ secrets.NAME }}",
+	)
+
+	secretPutCmd.PersistentFlags().StringVar(
+		&secretValue,
+		"value",
+		"",
+		"the value of the secret",
+	)
+}
+
+func runSecretCommand(cf *loader.ConfigLoader, f func(ctx context.Context, store secrets.SecretStore) error) error {
+	if secretTenantId == "" {
+		return fmt.Errorf("--tenant-id is required")
+	}
+
+	if err := secrets.ValidateName(secretName); err != nil {
+		return err
+	}
+
+	cleanup, serverConf, err := cf.LoadServerConfig("", func(scf *server.ServerConfigFile) {
+		// disable rabbitmq since it's not needed to manage secrets
+		scf.MessageQueue.Enabled = false
+
+		// disable security checks since we're not running the server
+		scf.SecurityCheck.Enabled = false
+	})
+
+	if err != nil {
+		return err
+	}
+
+	defer cleanup() // nolint:errcheck
+
+	defer serverConf.Disconnect() // nolint:errcheck
+
+	if serverConf.SecretResolver == nil {
+		return fmt.Errorf("secrets are not enabled, set SERVER_SECRETS_DRIVER")
+	}
+
+	return f(context.Background(), serverConf.SecretResolver.Store)
+}
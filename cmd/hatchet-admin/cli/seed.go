@@ -37,6 +37,12 @@ func init() {
 	rootCmd.AddCommand(seedCmd)
 }
 
+// Seed creates the initial data in the database of the config, like the default tenant. It's used by Hatchet Lite,
+// which seeds its database when it starts.
+func Seed(cf *loader.ConfigLoader) error {
+	return runSeed(cf)
+}
+
 func runSeed(cf *loader.ConfigLoader) error {
 	// load the config
 	dc, err := cf.LoadDatabaseConfig()
@@ -0,0 +1,208 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/pkg/config/loader"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+var (
+	stepRunTenantId  string
+	stepRunOlderThan time.Duration
+	stepRunWorkerId  string
+	stepRunLimit     int
+	stepRunIds       []string
+)
+
+var stepRunCmd = &cobra.Command{
+	Use:   "step-run",
+	Short: "command for finding and requeueing step runs which are stuck before they started.",
+}
+
+var stepRunListStuckCmd = &cobra.Command{
+	Use:   "list-stuck",
+	Short: "list the step runs which are pending assignment or assigned, and weren't updated for a while.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		err := runListStuckStepRuns(configLoader)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [step-run list-stuck] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var stepRunRequeueCmd = &cobra.Command{
+	Use:   "requeue",
+	Short: "move step runs which are pending assignment or assigned back to their queue, either by ID or all the step runs which list-stuck lists with the same flags.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		err := runRequeueStepRuns(cmd, configLoader)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [step-run requeue] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(stepRunCmd)
+	stepRunCmd.AddCommand(stepRunListStuckCmd)
+	stepRunCmd.AddCommand(stepRunRequeueCmd)
+
+	stepRunCmd.PersistentFlags().StringVar(
+		&stepRunTenantId,
+		"tenant-id",
+		"",
+		"the tenant ID",
+	)
+
+	stepRunCmd.MarkPersistentFlagRequired("tenant-id") // nolint: errcheck
+
+	stepRunCmd.PersistentFlags().DurationVar(
+		&stepRunOlderThan,
+		"older-than",
+		10*time.Minute,
+		"only include step runs which weren't updated for this long",
+	)
+
+	stepRunCmd.PersistentFlags().StringVar(
+		&stepRunWorkerId,
+		"worker-id",
+		"",
+		"only include step runs which are assigned to a worker",
+	)
+
+	stepRunCmd.PersistentFlags().IntVar(
+		&stepRunLimit,
+		"limit",
+		1000,
+		"the max number of step runs to include",
+	)
+
+	stepRunRequeueCmd.PersistentFlags().StringSliceVar(
+		&stepRunIds,
+		"step-run-id",
+		nil,
+		"the IDs of the step runs to requeue, instead of the step runs which are stuck",
+	)
+}
+
+func runListStuckStepRuns(cf *loader.ConfigLoader) error {
+	dc, err := cf.LoadDatabaseConfig()
+
+	if err != nil {
+		return err
+	}
+
+	defer dc.Disconnect() // nolint: errcheck
+
+	stepRuns, err := listStuckStepRuns(context.Background(), dc.EngineRepository)
+
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "STEP RUN\tSTATUS\tACTION\tWORKER\tQUEUED\tRETRY\tLAST UPDATED") // nolint: errcheck
+
+	for _, sr := range stepRuns {
+		worker := "-"
+
+		if sr.WorkerId.Valid {
+			worker = sqlchelpers.UUIDToStr(sr.WorkerId)
+		}
+
+		fmt.Fprintf( // nolint: errcheck
+			w, "%s\t%s\t%s\t%s\t%t\t%d\t%s ago\n",
+			sqlchelpers.UUIDToStr(sr.ID), sr.Status, sr.ActionId, worker, sr.IsQueued, sr.RetryCount,
+			time.Since(sr.UpdatedAt.Time).Round(time.Second),
+		)
+	}
+
+	return w.Flush()
+}
+
+func runRequeueStepRuns(cmd *cobra.Command, cf *loader.ConfigLoader) error {
+	if len(stepRunIds) > 0 && (cmd.Flags().Changed("older-than") || cmd.Flags().Changed("worker-id")) {
+		return errors.New("--step-run-id can't be combined with --older-than or --worker-id")
+	}
+
+	dc, err := cf.LoadDatabaseConfig()
+
+	if err != nil {
+		return err
+	}
+
+	defer dc.Disconnect() // nolint: errcheck
+
+	ctx := context.Background()
+
+	ids := stepRunIds
+
+	if len(ids) == 0 {
+		stepRuns, err := listStuckStepRuns(ctx, dc.EngineRepository)
+
+		if err != nil {
+			return err
+		}
+
+		for _, sr := range stepRuns {
+			ids = append(ids, sqlchelpers.UUIDToStr(sr.ID))
+		}
+	}
+
+	if len(ids) == 0 {
+		fmt.Println("no step runs to requeue")
+		return nil
+	}
+
+	requeued, err := dc.EngineRepository.Operations().RequeueStepRuns(ctx, stepRunTenantId, ids)
+
+	if err != nil {
+		return err
+	}
+
+	for _, sr := range requeued {
+		if sr.WorkerId != nil {
+			fmt.Printf("requeued step run %s from worker %s\n", sr.StepRunId, *sr.WorkerId)
+		} else {
+			fmt.Printf("requeued step run %s\n", sr.StepRunId)
+		}
+	}
+
+	if skipped := len(ids) - len(requeued); skipped > 0 {
+		fmt.Printf("skipped %d step runs which aren't pending assignment or assigned\n", skipped)
+	}
+
+	return nil
+}
+
+func listStuckStepRuns(ctx context.Context, repo repository.EngineRepository) ([]*dbsqlc.ListStuckStepRunsRow, error) {
+	opts := &repository.ListStuckStepRunsOpts{
+		UpdatedBefore: time.Now().UTC().Add(-stepRunOlderThan),
+		Limit:         &stepRunLimit,
+	}
+
+	if stepRunWorkerId != "" {
+		opts.WorkerId = &stepRunWorkerId
+	}
+
+	return repo.Operations().ListStuckStepRuns(ctx, stepRunTenantId, opts)
+}
@@ -0,0 +1,341 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hatchet-dev/hatchet/internal/tenantexport"
+	"github.com/hatchet-dev/hatchet/pkg/config/loader"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+var (
+	tenantTenantId    string
+	tenantOutput      string
+	tenantInput       string
+	tenantIncludeRuns bool
+
+	tenantName            string
+	tenantSlug            string
+	tenantOwnerEmail      string
+	tenantRetentionPeriod string
+)
+
+var tenantCmd = &cobra.Command{
+	Use:   "tenant",
+	Short: "command for creating tenants and exporting and importing their data.",
+}
+
+var tenantCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "create a tenant, optionally with an existing user as its owner.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		err := runTenantCreate(configLoader)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [tenant create] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var tenantExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "export the workflows, crons, scheduled runs, rate limits and exclusion calendars of a tenant to an archive.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		err := runTenantExport(configLoader)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [tenant export] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var tenantImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "import an archive which was exported from a tenant into a tenant, which can be on another Hatchet instance.",
+	Run: func(cmd *cobra.Command, args []string) {
+		configLoader := loader.NewConfigLoader(configDirectory)
+
+		err := runTenantImport(configLoader)
+
+		if err != nil {
+			log.Printf("Fatal: could not run [tenant import] command: %v", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(tenantCmd)
+	tenantCmd.AddCommand(tenantCreateCmd)
+	tenantCmd.AddCommand(tenantExportCmd)
+	tenantCmd.AddCommand(tenantImportCmd)
+
+	tenantCmd.PersistentFlags().StringVar(
+		&tenantTenantId,
+		"tenant-id",
+		"",
+		"the tenant ID, which is generated for new tenants if it isn't set",
+	)
+
+	// the tenant id is required unless a tenant is created
+	tenantExportCmd.PreRunE = requireTenantId
+	tenantImportCmd.PreRunE = requireTenantId
+
+	tenantCreateCmd.PersistentFlags().StringVar(
+		&tenantName,
+		"name",
+		"",
+		"the name of the tenant",
+	)
+
+	tenantCreateCmd.MarkPersistentFlagRequired("name") // nolint: errcheck
+
+	tenantCreateCmd.PersistentFlags().StringVar(
+		&tenantSlug,
+		"slug",
+		"",
+		"the slug of the tenant",
+	)
+
+	tenantCreateCmd.MarkPersistentFlagRequired("slug") // nolint: errcheck
+
+	tenantCreateCmd.PersistentFlags().StringVar(
+		&tenantOwnerEmail,
+		"owner-email",
+		"",
+		"the email of an existing user to add as the owner of the tenant",
+	)
+
+	tenantCreateCmd.PersistentFlags().StringVar(
+		&tenantRetentionPeriod,
+		"data-retention-period",
+		"",
+		"the data retention period of the tenant, like 720h",
+	)
+
+	tenantExportCmd.PersistentFlags().StringVar(
+		&tenantOutput,
+		"output",
+		"",
+		"the path of the archive to write",
+	)
+
+	tenantExportCmd.MarkPersistentFlagRequired("output") // nolint: errcheck
+
+	tenantExportCmd.PersistentFlags().BoolVar(
+		&tenantIncludeRuns,
+		"include-runs",
+		false,
+		"include the run history of the tenant in the archive",
+	)
+
+	tenantImportCmd.PersistentFlags().StringVar(
+		&tenantInput,
+		"input",
+		"",
+		"the path of the archive to import",
+	)
+
+	tenantImportCmd.MarkPersistentFlagRequired("input") // nolint: errcheck
+}
+
+func requireTenantId(cmd *cobra.Command, args []string) error {
+	if tenantTenantId == "" {
+		return errors.New(`required flag(s) "tenant-id" not set`)
+	}
+
+	return nil
+}
+
+func runTenantCreate(cf *loader.ConfigLoader) error {
+	dc, err := cf.LoadDatabaseConfig()
+
+	if err != nil {
+		return err
+	}
+
+	defer dc.Disconnect() // nolint: errcheck
+
+	existing, err := dc.APIRepository.Tenant().GetTenantBySlug(tenantSlug)
+
+	if err != nil && !errors.Is(err, db.ErrNotFound) {
+		return err
+	}
+
+	if existing != nil {
+		return fmt.Errorf("tenant with the slug %s already exists", tenantSlug)
+	}
+
+	var ownerId string
+
+	if tenantOwnerEmail != "" {
+		user, err := dc.APIRepository.User().GetUserByEmail(tenantOwnerEmail)
+
+		if err != nil {
+			return fmt.Errorf("could not find user %s: %w", tenantOwnerEmail, err)
+		}
+
+		ownerId = user.ID
+	}
+
+	opts := &repository.CreateTenantOpts{
+		Name: tenantName,
+		Slug: tenantSlug,
+	}
+
+	if tenantTenantId != "" {
+		opts.ID = &tenantTenantId
+	}
+
+	if tenantRetentionPeriod != "" {
+		opts.DataRetentionPeriod = &tenantRetentionPeriod
+	}
+
+	tenant, err := dc.APIRepository.Tenant().CreateTenant(opts)
+
+	if err != nil {
+		return err
+	}
+
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	err = dc.EntitlementRepository.TenantLimit().SelectOrInsertTenantLimits(context.Background(), tenantId, nil)
+
+	if err != nil {
+		return err
+	}
+
+	if ownerId != "" {
+		_, err = dc.APIRepository.Tenant().CreateTenantMember(tenantId, &repository.CreateTenantMemberOpts{
+			UserId: ownerId,
+			Role:   "OWNER",
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("created tenant %s\n", tenantId)
+
+	return nil
+}
+
+func runTenantExport(cf *loader.ConfigLoader) error {
+	dc, err := cf.LoadDatabaseConfig()
+
+	if err != nil {
+		return err
+	}
+
+	defer dc.Disconnect() // nolint: errcheck
+
+	f, err := os.Create(tenantOutput)
+
+	if err != nil {
+		return fmt.Errorf("could not create archive: %w", err)
+	}
+
+	defer f.Close() // nolint: errcheck
+
+	manifest, err := tenantexport.Export(context.Background(), dc.EngineRepository, tenantTenantId, f, tenantexport.ExportOpts{
+		IncludeRuns: tenantIncludeRuns,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("could not write archive: %w", err)
+	}
+
+	fmt.Printf("exported tenant %s to %s\n", tenantTenantId, tenantOutput)
+
+	for _, file := range []string{
+		tenantexport.RateLimitsFile,
+		tenantexport.CronCalendarsFile,
+		tenantexport.WorkflowsFile,
+		tenantexport.CronsFile,
+		tenantexport.ScheduledRunsFile,
+		tenantexport.WorkflowRunsFile,
+	} {
+		if count, ok := manifest.Counts[file]; ok {
+			fmt.Printf("%s: %d\n", file, count)
+		}
+	}
+
+	for _, workflow := range manifest.SkippedWorkflows {
+		fmt.Printf("skipped workflow %s: it was registered before workflow definitions were stored, register it again to export it\n", workflow)
+	}
+
+	return nil
+}
+
+func runTenantImport(cf *loader.ConfigLoader) error {
+	dc, err := cf.LoadDatabaseConfig()
+
+	if err != nil {
+		return err
+	}
+
+	defer dc.Disconnect() // nolint: errcheck
+
+	f, err := os.Open(tenantInput)
+
+	if err != nil {
+		return fmt.Errorf("could not open archive: %w", err)
+	}
+
+	defer f.Close() // nolint: errcheck
+
+	stat, err := f.Stat()
+
+	if err != nil {
+		return err
+	}
+
+	r, err := tenantexport.NewReader(f, stat.Size())
+
+	if err != nil {
+		return err
+	}
+
+	res, err := tenantexport.Import(context.Background(), dc.APIRepository, dc.EngineRepository, tenantTenantId, r)
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("imported archive of tenant %s into tenant %s\n", r.Manifest().TenantId, tenantTenantId)
+
+	for _, file := range []string{
+		tenantexport.RateLimitsFile,
+		tenantexport.CronCalendarsFile,
+		tenantexport.WorkflowsFile,
+		tenantexport.CronsFile,
+		tenantexport.ScheduledRunsFile,
+	} {
+		fmt.Printf("%s: %d of %d imported\n", file, res.Imported[file], r.Manifest().Counts[file])
+	}
+
+	for _, skipped := range res.Skipped {
+		fmt.Printf("skipped %s\n", skipped)
+	}
+
+	return nil
+}
@@ -11,11 +11,13 @@ import (
 
 	"github.com/hatchet-dev/hatchet/pkg/config/loader"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 )
 
 var (
 	tokenTenantId string
 	tokenName     string
+	tokenScope    string
 	expiresIn     time.Duration
 )
 
@@ -66,6 +68,12 @@ func init() {
 		"Expiration duration for the API token",
 	)
 
+	tokenCreateAPICmd.PersistentFlags().StringVar(
+		&tokenScope,
+		"scope",
+		string(dbsqlc.APITokenScopeADMIN),
+		"the scope of the token, one of ADMIN, WORKER or READ_ONLY",
+	)
 }
 
 func runCreateAPIToken(expiresIn time.Duration) error {
@@ -88,6 +96,14 @@ func runCreateAPIToken(expiresIn time.Duration) error {
 
 	defer serverConf.Disconnect() // nolint:errcheck
 
+	scope := dbsqlc.APITokenScope(tokenScope)
+
+	switch scope {
+	case dbsqlc.APITokenScopeADMIN, dbsqlc.APITokenScopeWORKER, dbsqlc.APITokenScopeREADONLY:
+	default:
+		return fmt.Errorf("unknown token scope %s", tokenScope)
+	}
+
 	expiresAt := time.Now().UTC().Add(expiresIn)
 
 	tenantId := tokenTenantId
@@ -96,7 +112,7 @@ func runCreateAPIToken(expiresIn time.Duration) error {
 		tenantId = serverConf.Seed.DefaultTenantID
 	}
 
-	defaultTok, err := serverConf.Auth.JWTManager.GenerateTenantToken(context.Background(), tenantId, tokenName, false, &expiresAt)
+	defaultTok, err := serverConf.Auth.JWTManager.GenerateScopedTenantToken(context.Background(), tenantId, tokenName, scope, &expiresAt)
 
 	if err != nil {
 		return err
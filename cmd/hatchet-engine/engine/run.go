@@ -2,8 +2,10 @@ package engine
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -13,10 +15,15 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/services/controllers/retention"
 	"github.com/hatchet-dev/hatchet/internal/services/controllers/workflows"
 	"github.com/hatchet-dev/hatchet/internal/services/dispatcher"
+	"github.com/hatchet-dev/hatchet/internal/services/eventsinks"
 	"github.com/hatchet-dev/hatchet/internal/services/grpc"
 	"github.com/hatchet-dev/hatchet/internal/services/health"
 	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
+	"github.com/hatchet-dev/hatchet/internal/services/ingestor/kafkaconsumer"
+	"github.com/hatchet-dev/hatchet/internal/services/ingestor/sqspoller"
+	"github.com/hatchet-dev/hatchet/internal/services/metrics"
 	"github.com/hatchet-dev/hatchet/internal/services/partition"
+	"github.com/hatchet-dev/hatchet/internal/services/scaler"
 	"github.com/hatchet-dev/hatchet/internal/services/scheduler"
 	"github.com/hatchet-dev/hatchet/internal/services/ticker"
 	"github.com/hatchet-dev/hatchet/internal/services/webhooks"
@@ -25,14 +32,40 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
 	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
 
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
 	"golang.org/x/sync/errgroup"
 )
 
+// TeardownPhase orders the teardown of the services of the engine on shutdown. Teardowns run in the order of their
+// phase, and in the order they were added within a phase.
+type TeardownPhase int
+
+const (
+	// TeardownPhaseIntake stops the services which accept new work, like the grpc server and the ingestors. The
+	// dispatcher finishes dispatching the step runs which it already received.
+	TeardownPhaseIntake TeardownPhase = iota
+
+	// TeardownPhaseDrain waits for the controllers and the scheduler to finish the messages which they're handling.
+	TeardownPhaseDrain
+
+	// TeardownPhaseRelease releases the partitions and leases of the engine, so other engines take over its tenants
+	// without waiting for them to expire.
+	TeardownPhaseRelease
+
+	// TeardownPhaseFlush flushes telemetry and stops the health and metrics servers.
+	TeardownPhaseFlush
+)
+
 type Teardown struct {
-	Name string
-	Fn   func() error
+	Name  string
+	Phase TeardownPhase
+	Fn    func() error
 }
 
+// telemetryFlushTimeout is the time in which spans which weren't exported are flushed on shutdown.
+const telemetryFlushTimeout = 10 * time.Second
+
 func init() {
 	svcName := os.Getenv("SERVER_OTEL_SERVICE_NAME")
 	collectorURL := os.Getenv("SERVER_OTEL_COLLECTOR_URL")
@@ -74,13 +107,15 @@ func Run(ctx context.Context, cf *loader.ConfigLoader, version string) error {
 	}
 
 	teardown = append(teardown, Teardown{
-		Name: "server",
+		Name:  "server",
+		Phase: TeardownPhaseRelease,
 		Fn: func() error {
 			return serverCleanup()
 		},
 	})
 	teardown = append(teardown, Teardown{
-		Name: "database",
+		Name:  "database",
+		Phase: TeardownPhaseFlush,
 		Fn: func() error {
 			return sc.Disconnect()
 		},
@@ -91,20 +126,82 @@ func Run(ctx context.Context, cf *loader.ConfigLoader, version string) error {
 	l.Debug().Msgf("interrupt received, shutting down")
 
 	l.Debug().Msgf("waiting for all other services to gracefully exit...")
+
+	if err := runTeardown(l, teardown, sc.Runtime.ShutdownDrainTimeout); err != nil {
+		return err
+	}
+
+	l.Debug().Msgf("all services have successfully gracefully exited")
+
+	l.Debug().Msgf("successfully shutdown")
+
+	return nil
+}
+
+// runTeardown runs the teardowns in the order of their phase. The teardowns of the intake and drain phases are
+// abandoned once the drain timeout passed, but the leases of the engine are still released and telemetry is still
+// flushed, so other engines can take over right away. A failed teardown doesn't stop the teardowns after it.
+func runTeardown(l *zerolog.Logger, teardown []Teardown, drainTimeout time.Duration) error {
+	sort.SliceStable(teardown, func(i, j int) bool {
+		return teardown[i].Phase < teardown[j].Phase
+	})
+
+	deadline := time.Now().Add(drainTimeout)
+
+	var errs []error
+
 	for i, t := range teardown {
 		l.Debug().Msgf("shutting down %s (%d/%d)", t.Name, i+1, len(teardown))
-		err := t.Fn()
+
+		var err error
+
+		if t.Phase <= TeardownPhaseDrain {
+			err = runUntil(t.Fn, deadline)
+		} else {
+			err = t.Fn()
+		}
+
+		if errors.Is(err, errDrainTimeout) {
+			l.Warn().Msgf("could not shut down %s within the drain timeout of %s, abandoning its work", t.Name, drainTimeout)
+			continue
+		}
 
 		if err != nil {
-			return fmt.Errorf("could not teardown %s: %w", t.Name, err)
+			l.Error().Err(err).Msgf("could not teardown %s", t.Name)
+			errs = append(errs, fmt.Errorf("could not teardown %s: %w", t.Name, err))
+			continue
 		}
+
 		l.Debug().Msgf("successfully shutdown %s (%d/%d)", t.Name, i+1, len(teardown))
 	}
-	l.Debug().Msgf("all services have successfully gracefully exited")
 
-	l.Debug().Msgf("successfully shutdown")
+	return errors.Join(errs...)
+}
 
-	return nil
+var errDrainTimeout = errors.New("drain timeout exceeded")
+
+// runUntil runs a teardown, and returns errDrainTimeout if it doesn't return before the deadline. The teardown keeps
+// running in the background until the engine exits.
+func runUntil(fn func() error, deadline time.Time) error {
+	if time.Now().After(deadline) {
+		return errDrainTimeout
+	}
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- fn()
+	}()
+
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+
+	select {
+	case err := <-done:
+		return err
+	case <-timer.C:
+		return errDrainTimeout
+	}
 }
 
 func RunWithConfig(ctx context.Context, sc *server.ServerConfig) ([]Teardown, error) {
@@ -117,6 +214,28 @@ func RunWithConfig(ctx context.Context, sc *server.ServerConfig) ([]Teardown, er
 	return runV0Config(ctx, sc)
 }
 
+func startMetrics(sc *server.ServerConfig) (func() error, error) {
+	pools := map[string]*pgxpool.Pool{
+		"default": sc.Pool,
+	}
+
+	if sc.EssentialPool != nil && sc.EssentialPool != sc.Pool {
+		pools["essential"] = sc.EssentialPool
+	}
+
+	if sc.QueuePool != nil && sc.QueuePool != sc.Pool {
+		pools["queue"] = sc.QueuePool
+	}
+
+	if sc.ReadReplicaPool != nil {
+		pools["read_replica"] = sc.ReadReplicaPool
+	}
+
+	m := metrics.New(sc.EngineRepository, pools, sc.Prometheus.Address, sc.Prometheus.Path, sc.Logger)
+
+	return m.Start()
+}
+
 func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, error) {
 	var l = sc.Logger
 
@@ -130,7 +249,7 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		return nil, fmt.Errorf("could not initialize tracer: %w", err)
 	}
 
-	p, err := partition.NewPartition(l, sc.EngineRepository.Tenant())
+	p, err := partition.NewPartition(l, sc.EngineRepository.Tenant(), partition.WithTenantPartitions(sc.Runtime.TenantPartitions))
 
 	if err != nil {
 		return nil, fmt.Errorf("could not create partitioner: %w", err)
@@ -139,22 +258,51 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 	teardown := []Teardown{}
 
 	teardown = append(teardown, Teardown{
-		Name: "partitioner",
-		Fn:   p.Shutdown,
+		Name:  "partitioner",
+		Phase: TeardownPhaseRelease,
+		Fn:    p.Shutdown,
 	})
 
 	var h *health.Health
 	healthProbes := sc.HasService("health")
 	if healthProbes {
 		h = health.New(sc.EngineRepository, sc.MessageQueue)
+		h.HandleFunc("/partitions", p.ServeTenantPartitionMap)
 		cleanup, err := h.Start()
 		if err != nil {
 			return nil, fmt.Errorf("could not start health: %w", err)
 		}
 
 		teardown = append(teardown, Teardown{
-			Name: "health",
-			Fn:   cleanup,
+			Name:  "health",
+			Phase: TeardownPhaseFlush,
+			Fn:    cleanup,
+		})
+	}
+
+	if sc.Prometheus.Enabled {
+		cleanup, err := startMetrics(sc)
+		if err != nil {
+			return nil, fmt.Errorf("could not start metrics: %w", err)
+		}
+
+		teardown = append(teardown, Teardown{
+			Name:  "metrics",
+			Phase: TeardownPhaseFlush,
+			Fn:    cleanup,
+		})
+	}
+
+	if sc.KedaScaler.Enabled {
+		cleanup, err := scaler.New(sc, sc.KedaScaler.Address, sc.Logger).Start()
+		if err != nil {
+			return nil, fmt.Errorf("could not start keda scaler: %w", err)
+		}
+
+		teardown = append(teardown, Teardown{
+			Name:  "keda scaler",
+			Phase: TeardownPhaseFlush,
+			Fn:    cleanup,
 		})
 	}
 
@@ -174,8 +322,9 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			return nil, fmt.Errorf("could not start events controller: %w", err)
 		}
 		teardown = append(teardown, Teardown{
-			Name: "events controller",
-			Fn:   cleanup,
+			Name:  "events controller",
+			Phase: TeardownPhaseDrain,
+			Fn:    cleanup,
 		})
 	}
 
@@ -188,8 +337,9 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		}
 
 		teardown = append(teardown, Teardown{
-			Name: "controller partition",
-			Fn:   partitionCleanup,
+			Name:  "controller partition",
+			Phase: TeardownPhaseRelease,
+			Fn:    partitionCleanup,
 		})
 
 		schedulePartitionCleanup, err := p.StartSchedulerPartition(ctx)
@@ -199,8 +349,9 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		}
 
 		teardown = append(teardown, Teardown{
-			Name: "scheduler partition",
-			Fn:   schedulePartitionCleanup,
+			Name:  "scheduler partition",
+			Phase: TeardownPhaseRelease,
+			Fn:    schedulePartitionCleanup,
 		})
 
 		// create the dispatcher
@@ -225,8 +376,9 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		}
 
 		teardown = append(teardown, Teardown{
-			Name: "scheduler",
-			Fn:   cleanup,
+			Name:  "scheduler",
+			Phase: TeardownPhaseDrain,
+			Fn:    cleanup,
 		})
 	}
 
@@ -249,8 +401,9 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			return nil, fmt.Errorf("could not start ticker: %w", err)
 		}
 		teardown = append(teardown, Teardown{
-			Name: "ticker",
-			Fn:   cleanup,
+			Name:  "ticker",
+			Phase: TeardownPhaseIntake,
+			Fn:    cleanup,
 		})
 	}
 
@@ -263,6 +416,7 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			jobs.WithPartition(p),
 			jobs.WithQueueLoggerConfig(&sc.AdditionalLoggers.Queue),
 			jobs.WithPgxStatsLoggerConfig(&sc.AdditionalLoggers.PgxStats),
+			jobs.WithBlobOffloader(sc.BlobOffloader),
 		)
 
 		if err != nil {
@@ -274,8 +428,9 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			return nil, fmt.Errorf("could not start jobs controller: %w", err)
 		}
 		teardown = append(teardown, Teardown{
-			Name: "jobs controller",
-			Fn:   cleanupJobs,
+			Name:  "jobs controller",
+			Phase: TeardownPhaseDrain,
+			Fn:    cleanupJobs,
 		})
 
 		wc, err := workflows.New(
@@ -295,8 +450,9 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			return nil, fmt.Errorf("could not start workflows controller: %w", err)
 		}
 		teardown = append(teardown, Teardown{
-			Name: "workflows controller",
-			Fn:   cleanupWorkflows,
+			Name:  "workflows controller",
+			Phase: TeardownPhaseDrain,
+			Fn:    cleanupWorkflows,
 		})
 	}
 
@@ -309,6 +465,8 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			retention.WithPartition(p),
 			retention.WithDataRetention(sc.EnableDataRetention),
 			retention.WithWorkerRetention(sc.EnableWorkerRetention),
+			retention.WithRetentionConfig(sc.Retention),
+			retention.WithBlobOffloader(sc.BlobOffloader),
 		)
 
 		if err != nil {
@@ -320,8 +478,9 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			return nil, fmt.Errorf("could not start retention controller: %w", err)
 		}
 		teardown = append(teardown, Teardown{
-			Name: "retention controller",
-			Fn:   cleanupRetention,
+			Name:  "retention controller",
+			Phase: TeardownPhaseDrain,
+			Fn:    cleanupRetention,
 		})
 	}
 
@@ -336,6 +495,8 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			dispatcher.WithLogger(sc.Logger),
 			dispatcher.WithEntitlementsRepository(sc.EntitlementRepository),
 			dispatcher.WithCache(cacheInstance),
+			dispatcher.WithBlobOffloader(sc.BlobOffloader),
+			dispatcher.WithSecretResolver(sc.SecretResolver),
 		)
 
 		if err != nil {
@@ -358,6 +519,15 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			ingestor.WithLogRepository(
 				sc.EngineRepository.Log(),
 			),
+			ingestor.WithWorkflowRepository(
+				sc.EngineRepository.Workflow(),
+			),
+			ingestor.WithEventRoutingRepository(
+				sc.EngineRepository.EventRouting(),
+			),
+			ingestor.WithTenantQuotaRepository(
+				sc.EngineRepository.TenantQuota(),
+			),
 			ingestor.WithMessageQueue(sc.MessageQueue),
 			ingestor.WithEntitlementsRepository(sc.EntitlementRepository),
 		)
@@ -366,6 +536,32 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			return nil, fmt.Errorf("could not create ingestor: %w", err)
 		}
 
+		if sc.KafkaClient != nil {
+			kc, err := kafkaconsumer.New(
+				kafkaconsumer.WithClient(sc.KafkaClient),
+				kafkaconsumer.WithRepository(sc.EngineRepository.KafkaOffset()),
+				kafkaconsumer.WithIngestor(ei),
+				kafkaconsumer.WithLogger(sc.Logger),
+				kafkaconsumer.WithConfig(&sc.Kafka),
+			)
+
+			if err != nil {
+				return nil, fmt.Errorf("could not create kafka consumer: %w", err)
+			}
+
+			kafkaConsumerCleanup, err := kc.Start()
+
+			if err != nil {
+				return nil, fmt.Errorf("could not start kafka consumer: %w", err)
+			}
+
+			teardown = append(teardown, Teardown{
+				Name:  "kafka consumer",
+				Phase: TeardownPhaseIntake,
+				Fn:    kafkaConsumerCleanup,
+			})
+		}
+
 		adminSvc, err := admin.NewAdminService(
 			admin.WithRepository(sc.EngineRepository),
 			admin.WithMessageQueue(sc.MessageQueue),
@@ -433,8 +629,9 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		}
 
 		teardown = append(teardown, Teardown{
-			Name: "grpc",
-			Fn:   cleanup,
+			Name:  "grpc",
+			Phase: TeardownPhaseIntake,
+			Fn:    cleanup,
 		})
 	}
 
@@ -446,8 +643,9 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		}
 
 		teardown = append(teardown, Teardown{
-			Name: "tenant worker partition",
-			Fn:   cleanup1,
+			Name:  "tenant worker partition",
+			Phase: TeardownPhaseRelease,
+			Fn:    cleanup1,
 		})
 
 		wh := webhooks.New(sc, p)
@@ -458,15 +656,46 @@ func runV0Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		}
 
 		teardown = append(teardown, Teardown{
-			Name: "webhook worker",
-			Fn:   cleanup2,
+			Name:  "webhook worker",
+			Phase: TeardownPhaseIntake,
+			Fn:    cleanup2,
+		})
+
+		sqsPoller := sqspoller.New(sc, p)
+
+		cleanup3, err := sqsPoller.Start()
+		if err != nil {
+			return nil, fmt.Errorf("could not create sqs poller: %w", err)
+		}
+
+		teardown = append(teardown, Teardown{
+			Name:  "sqs poller",
+			Phase: TeardownPhaseIntake,
+			Fn:    cleanup3,
+		})
+
+		eventSinks := eventsinks.New(sc, p)
+
+		cleanup4, err := eventSinks.Start()
+		if err != nil {
+			return nil, fmt.Errorf("could not create event sinks: %w", err)
+		}
+
+		teardown = append(teardown, Teardown{
+			Name:  "event sinks",
+			Phase: TeardownPhaseDrain,
+			Fn:    cleanup4,
 		})
 	}
 
 	teardown = append(teardown, Teardown{
-		Name: "telemetry",
+		Name:  "telemetry",
+		Phase: TeardownPhaseFlush,
 		Fn: func() error {
-			return shutdown(ctx)
+			flushCtx, cancel := context.WithTimeout(context.Background(), telemetryFlushTimeout)
+			defer cancel()
+
+			return shutdown(flushCtx)
 		},
 	})
 
@@ -498,7 +727,7 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		return nil, fmt.Errorf("could not initialize tracer: %w", err)
 	}
 
-	p, err := partition.NewPartition(l, sc.EngineRepository.Tenant())
+	p, err := partition.NewPartition(l, sc.EngineRepository.Tenant(), partition.WithTenantPartitions(sc.Runtime.TenantPartitions))
 
 	if err != nil {
 		return nil, fmt.Errorf("could not create partitioner: %w", err)
@@ -507,8 +736,9 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 	teardown := []Teardown{}
 
 	teardown = append(teardown, Teardown{
-		Name: "partitioner",
-		Fn:   p.Shutdown,
+		Name:  "partitioner",
+		Phase: TeardownPhaseRelease,
+		Fn:    p.Shutdown,
 	})
 
 	healthProbes := sc.Runtime.Healthcheck
@@ -516,6 +746,7 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 
 	if healthProbes {
 		h = health.New(sc.EngineRepository, sc.MessageQueue)
+		h.HandleFunc("/partitions", p.ServeTenantPartitionMap)
 
 		cleanup, err := h.Start()
 
@@ -524,8 +755,35 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		}
 
 		teardown = append(teardown, Teardown{
-			Name: "health",
-			Fn:   cleanup,
+			Name:  "health",
+			Phase: TeardownPhaseFlush,
+			Fn:    cleanup,
+		})
+	}
+
+	if sc.Prometheus.Enabled {
+		cleanup, err := startMetrics(sc)
+		if err != nil {
+			return nil, fmt.Errorf("could not start metrics: %w", err)
+		}
+
+		teardown = append(teardown, Teardown{
+			Name:  "metrics",
+			Phase: TeardownPhaseFlush,
+			Fn:    cleanup,
+		})
+	}
+
+	if sc.KedaScaler.Enabled {
+		cleanup, err := scaler.New(sc, sc.KedaScaler.Address, sc.Logger).Start()
+		if err != nil {
+			return nil, fmt.Errorf("could not start keda scaler: %w", err)
+		}
+
+		teardown = append(teardown, Teardown{
+			Name:  "keda scaler",
+			Phase: TeardownPhaseFlush,
+			Fn:    cleanup,
 		})
 	}
 
@@ -537,8 +795,9 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		}
 
 		teardown = append(teardown, Teardown{
-			Name: "controller partition",
-			Fn:   partitionCleanup,
+			Name:  "controller partition",
+			Phase: TeardownPhaseRelease,
+			Fn:    partitionCleanup,
 		})
 
 		ec, err := events.New(
@@ -557,8 +816,9 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			return nil, fmt.Errorf("could not start events controller: %w", err)
 		}
 		teardown = append(teardown, Teardown{
-			Name: "events controller",
-			Fn:   cleanup,
+			Name:  "events controller",
+			Phase: TeardownPhaseDrain,
+			Fn:    cleanup,
 		})
 
 		t, err := ticker.New(
@@ -581,8 +841,9 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		}
 
 		teardown = append(teardown, Teardown{
-			Name: "ticker",
-			Fn:   cleanup,
+			Name:  "ticker",
+			Phase: TeardownPhaseIntake,
+			Fn:    cleanup,
 		})
 
 		jc, err := jobs.New(
@@ -593,6 +854,7 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			jobs.WithPartition(p),
 			jobs.WithQueueLoggerConfig(&sc.AdditionalLoggers.Queue),
 			jobs.WithPgxStatsLoggerConfig(&sc.AdditionalLoggers.PgxStats),
+			jobs.WithBlobOffloader(sc.BlobOffloader),
 		)
 
 		if err != nil {
@@ -606,8 +868,9 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		}
 
 		teardown = append(teardown, Teardown{
-			Name: "jobs controller",
-			Fn:   cleanupJobs,
+			Name:  "jobs controller",
+			Phase: TeardownPhaseDrain,
+			Fn:    cleanupJobs,
 		})
 
 		wc, err := workflows.New(
@@ -630,8 +893,9 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		}
 
 		teardown = append(teardown, Teardown{
-			Name: "workflows controller",
-			Fn:   cleanupWorkflows,
+			Name:  "workflows controller",
+			Phase: TeardownPhaseDrain,
+			Fn:    cleanupWorkflows,
 		})
 
 		rc, err := retention.New(
@@ -642,6 +906,8 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			retention.WithPartition(p),
 			retention.WithDataRetention(sc.EnableDataRetention),
 			retention.WithWorkerRetention(sc.EnableWorkerRetention),
+			retention.WithRetentionConfig(sc.Retention),
+			retention.WithBlobOffloader(sc.BlobOffloader),
 		)
 
 		if err != nil {
@@ -655,8 +921,9 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		}
 
 		teardown = append(teardown, Teardown{
-			Name: "retention controller",
-			Fn:   cleanupRetention,
+			Name:  "retention controller",
+			Phase: TeardownPhaseDrain,
+			Fn:    cleanupRetention,
 		})
 
 		cleanup1, err := p.StartTenantWorkerPartition(ctx)
@@ -666,8 +933,9 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		}
 
 		teardown = append(teardown, Teardown{
-			Name: "tenant worker partition",
-			Fn:   cleanup1,
+			Name:  "tenant worker partition",
+			Phase: TeardownPhaseRelease,
+			Fn:    cleanup1,
 		})
 
 		wh := webhooks.New(sc, p)
@@ -679,8 +947,35 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		}
 
 		teardown = append(teardown, Teardown{
-			Name: "webhook worker",
-			Fn:   cleanup2,
+			Name:  "webhook worker",
+			Phase: TeardownPhaseIntake,
+			Fn:    cleanup2,
+		})
+
+		sqsPoller := sqspoller.New(sc, p)
+
+		cleanup3, err := sqsPoller.Start()
+		if err != nil {
+			return nil, fmt.Errorf("could not create sqs poller: %w", err)
+		}
+
+		teardown = append(teardown, Teardown{
+			Name:  "sqs poller",
+			Phase: TeardownPhaseIntake,
+			Fn:    cleanup3,
+		})
+
+		eventSinks := eventsinks.New(sc, p)
+
+		cleanup4, err := eventSinks.Start()
+		if err != nil {
+			return nil, fmt.Errorf("could not create event sinks: %w", err)
+		}
+
+		teardown = append(teardown, Teardown{
+			Name:  "event sinks",
+			Phase: TeardownPhaseDrain,
+			Fn:    cleanup4,
 		})
 	}
 
@@ -695,6 +990,8 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			dispatcher.WithLogger(sc.Logger),
 			dispatcher.WithEntitlementsRepository(sc.EntitlementRepository),
 			dispatcher.WithCache(cacheInstance),
+			dispatcher.WithBlobOffloader(sc.BlobOffloader),
+			dispatcher.WithSecretResolver(sc.SecretResolver),
 		)
 
 		if err != nil {
@@ -718,6 +1015,15 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			ingestor.WithLogRepository(
 				sc.EngineRepository.Log(),
 			),
+			ingestor.WithWorkflowRepository(
+				sc.EngineRepository.Workflow(),
+			),
+			ingestor.WithEventRoutingRepository(
+				sc.EngineRepository.EventRouting(),
+			),
+			ingestor.WithTenantQuotaRepository(
+				sc.EngineRepository.TenantQuota(),
+			),
 			ingestor.WithMessageQueue(sc.MessageQueue),
 			ingestor.WithEntitlementsRepository(sc.EntitlementRepository),
 		)
@@ -726,6 +1032,32 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 			return nil, fmt.Errorf("could not create ingestor: %w", err)
 		}
 
+		if sc.KafkaClient != nil {
+			kc, err := kafkaconsumer.New(
+				kafkaconsumer.WithClient(sc.KafkaClient),
+				kafkaconsumer.WithRepository(sc.EngineRepository.KafkaOffset()),
+				kafkaconsumer.WithIngestor(ei),
+				kafkaconsumer.WithLogger(sc.Logger),
+				kafkaconsumer.WithConfig(&sc.Kafka),
+			)
+
+			if err != nil {
+				return nil, fmt.Errorf("could not create kafka consumer: %w", err)
+			}
+
+			kafkaConsumerCleanup, err := kc.Start()
+
+			if err != nil {
+				return nil, fmt.Errorf("could not start kafka consumer: %w", err)
+			}
+
+			teardown = append(teardown, Teardown{
+				Name:  "kafka consumer",
+				Phase: TeardownPhaseIntake,
+				Fn:    kafkaConsumerCleanup,
+			})
+		}
+
 		adminSvc, err := admin.NewAdminService(
 			admin.WithRepository(sc.EngineRepository),
 			admin.WithMessageQueue(sc.MessageQueue),
@@ -794,8 +1126,9 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		}
 
 		teardown = append(teardown, Teardown{
-			Name: "grpc",
-			Fn:   cleanup,
+			Name:  "grpc",
+			Phase: TeardownPhaseIntake,
+			Fn:    cleanup,
 		})
 	}
 
@@ -807,8 +1140,9 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		}
 
 		teardown = append(teardown, Teardown{
-			Name: "scheduler partition",
-			Fn:   partitionCleanup,
+			Name:  "scheduler partition",
+			Phase: TeardownPhaseRelease,
+			Fn:    partitionCleanup,
 		})
 
 		// create the dispatcher
@@ -833,15 +1167,20 @@ func runV1Config(ctx context.Context, sc *server.ServerConfig) ([]Teardown, erro
 		}
 
 		teardown = append(teardown, Teardown{
-			Name: "scheduler",
-			Fn:   cleanup,
+			Name:  "scheduler",
+			Phase: TeardownPhaseDrain,
+			Fn:    cleanup,
 		})
 	}
 
 	teardown = append(teardown, Teardown{
-		Name: "telemetry",
+		Name:  "telemetry",
+		Phase: TeardownPhaseFlush,
 		Fn: func() error {
-			return shutdown(ctx)
+			flushCtx, cancel := context.WithTimeout(context.Background(), telemetryFlushTimeout)
+			defer cancel()
+
+			return shutdown(flushCtx)
 		},
 	})
 
@@ -0,0 +1,152 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunTeardownOrdersByPhase(t *testing.T) {
+	l := zerolog.Nop()
+
+	var mu sync.Mutex
+	var order []string
+
+	record := func(name string) func() error {
+		return func() error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			order = append(order, name)
+			return nil
+		}
+	}
+
+	// teardowns are added in the order the services are started, which isn't the order they're torn down in
+	teardown := []Teardown{
+		{Name: "database", Phase: TeardownPhaseFlush, Fn: record("database")},
+		{Name: "partitioner", Phase: TeardownPhaseRelease, Fn: record("partitioner")},
+		{Name: "jobs controller", Phase: TeardownPhaseDrain, Fn: record("jobs controller")},
+		{Name: "grpc", Phase: TeardownPhaseIntake, Fn: record("grpc")},
+		{Name: "workflows controller", Phase: TeardownPhaseDrain, Fn: record("workflows controller")},
+		{Name: "ticker", Phase: TeardownPhaseIntake, Fn: record("ticker")},
+		{Name: "telemetry", Phase: TeardownPhaseFlush, Fn: record("telemetry")},
+	}
+
+	require.NoError(t, runTeardown(&l, teardown, time.Minute))
+
+	assert.Equal(t, []string{
+		"grpc",
+		"ticker",
+		"jobs controller",
+		"workflows controller",
+		"partitioner",
+		"database",
+		"telemetry",
+	}, order, "teardowns must run in the order of their phase, and in the order they were added within a phase")
+}
+
+func TestRunTeardownDrainTimeout(t *testing.T) {
+	l := zerolog.Nop()
+
+	var mu sync.Mutex
+	var ran []string
+
+	record := func(name string) func() error {
+		return func() error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			ran = append(ran, name)
+			return nil
+		}
+	}
+
+	block := make(chan struct{})
+	defer close(block)
+
+	teardown := []Teardown{
+		{Name: "stuck controller", Phase: TeardownPhaseDrain, Fn: func() error {
+			<-block
+			return nil
+		}},
+		{Name: "events controller", Phase: TeardownPhaseDrain, Fn: record("events controller")},
+		{Name: "partitioner", Phase: TeardownPhaseRelease, Fn: record("partitioner")},
+		{Name: "telemetry", Phase: TeardownPhaseFlush, Fn: record("telemetry")},
+	}
+
+	start := time.Now()
+
+	require.NoError(t, runTeardown(&l, teardown, 50*time.Millisecond), "abandoned teardowns aren't errors")
+
+	assert.Less(t, time.Since(start), 5*time.Second, "the stuck teardown must be abandoned")
+
+	// the drain teardowns after the deadline are skipped, but leases are still released and telemetry is still flushed
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Equal(t, []string{"partitioner", "telemetry"}, ran)
+}
+
+func TestRunTeardownJoinsErrors(t *testing.T) {
+	l := zerolog.Nop()
+
+	errGrpc := errors.New("grpc failed")
+	errDatabase := errors.New("database failed")
+
+	var telemetryRan bool
+
+	teardown := []Teardown{
+		{Name: "grpc", Phase: TeardownPhaseIntake, Fn: func() error { return errGrpc }},
+		{Name: "database", Phase: TeardownPhaseFlush, Fn: func() error { return errDatabase }},
+		{Name: "telemetry", Phase: TeardownPhaseFlush, Fn: func() error {
+			telemetryRan = true
+			return nil
+		}},
+	}
+
+	err := runTeardown(&l, teardown, time.Minute)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errGrpc)
+	assert.ErrorIs(t, err, errDatabase)
+	assert.True(t, telemetryRan, "a failed teardown must not stop the teardowns after it")
+}
+
+func TestRunUntil(t *testing.T) {
+	t.Run("returns the error of the teardown", func(t *testing.T) {
+		errTeardown := errors.New("teardown failed")
+
+		err := runUntil(func() error { return errTeardown }, time.Now().Add(time.Minute))
+		assert.ErrorIs(t, err, errTeardown)
+	})
+
+	t.Run("times out a teardown which doesn't return", func(t *testing.T) {
+		block := make(chan struct{})
+		defer close(block)
+
+		err := runUntil(func() error {
+			<-block
+			return nil
+		}, time.Now().Add(10*time.Millisecond))
+
+		assert.ErrorIs(t, err, errDrainTimeout)
+	})
+
+	t.Run("doesn't start a teardown after the deadline", func(t *testing.T) {
+		started := false
+
+		err := runUntil(func() error {
+			started = true
+			return nil
+		}, time.Now().Add(-time.Second))
+
+		assert.ErrorIs(t, err, errDrainTimeout)
+		assert.False(t, started)
+	})
+}
@@ -0,0 +1,93 @@
+// Package embeddeddb runs the Postgres database of Hatchet Lite as a child process, so Hatchet Lite doesn't need an
+// external database for local development and CI.
+package embeddeddb
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+)
+
+const (
+	username = "hatchet"
+	password = "hatchet"
+	database = "hatchet"
+)
+
+type Opts struct {
+	// Dir is the directory which stores the data of the database, along with the Postgres binaries which are
+	// downloaded on the first start
+	Dir string
+
+	Port uint32
+
+	// Logs receives the logs of Postgres, defaults to stdout
+	Logs io.Writer
+}
+
+// Database is an embedded Postgres database.
+type Database struct {
+	pg   *embeddedpostgres.EmbeddedPostgres
+	port uint32
+}
+
+// Start starts the database, and creates it if the directory doesn't contain a database yet. Postgres refuses to run
+// as root, so the process must run as another user.
+func Start(opts Opts) (*Database, error) {
+	if opts.Dir == "" {
+		return nil, fmt.Errorf("directory is required")
+	}
+
+	if opts.Port == 0 {
+		opts.Port = 5432
+	}
+
+	if opts.Logs == nil {
+		opts.Logs = os.Stdout
+	}
+
+	if os.Geteuid() == 0 {
+		return nil, fmt.Errorf("the embedded database can't run as root")
+	}
+
+	// the runtime directory is cleared on every start, so the data is stored next to it rather than in it
+	cfg := embeddedpostgres.DefaultConfig().
+		Version(embeddedpostgres.V15).
+		Port(opts.Port).
+		Username(username).
+		Password(password).
+		Database(database).
+		DataPath(filepath.Join(opts.Dir, "data")).
+		RuntimePath(filepath.Join(opts.Dir, "runtime")).
+		CachePath(filepath.Join(opts.Dir, "cache")).
+		StartParameters(map[string]string{
+			// the database is only reachable from the same host
+			"listen_addresses": "localhost",
+			"max_connections":  "200",
+		}).
+		Logger(opts.Logs)
+
+	pg := embeddedpostgres.NewDatabase(cfg)
+
+	if err := pg.Start(); err != nil {
+		return nil, fmt.Errorf("could not start embedded database: %w", err)
+	}
+
+	return &Database{
+		pg:   pg,
+		port: opts.Port,
+	}, nil
+}
+
+// URL returns the connection url of the database.
+func (d *Database) URL() string {
+	return fmt.Sprintf("postgresql://%s:%s@localhost:%d/%s?sslmode=disable", username, password, d.port, database)
+}
+
+// Stop stops the database after a fast shutdown, which rolls back the open transactions.
+func (d *Database) Stop() error {
+	return d.pg.Stop()
+}
@@ -1,22 +1,30 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/spf13/cobra"
 
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-admin/cli"
 	"github.com/hatchet-dev/hatchet/cmd/hatchet-api/api"
 	"github.com/hatchet-dev/hatchet/cmd/hatchet-engine/engine"
+	"github.com/hatchet-dev/hatchet/cmd/hatchet-lite/embeddeddb"
 	"github.com/hatchet-dev/hatchet/cmd/hatchet-lite/staticfileserver"
+	"github.com/hatchet-dev/hatchet/internal/migrate"
 	"github.com/hatchet-dev/hatchet/pkg/cmdutils"
 	"github.com/hatchet-dev/hatchet/pkg/config/loader"
+	"github.com/hatchet-dev/hatchet/pkg/logger"
+	"github.com/hatchet-dev/hatchet/sql/migrations"
 )
 
 var printVersion bool
@@ -66,12 +74,15 @@ func main() {
 	}
 }
 
-// runs a static file server, api and engine in the same process.
+// runs a static file server, api and engine in the same process. Without a DATABASE_URL, the database is embedded
+// when LITE_EMBEDDED_POSTGRES_DIR is set.
 func start(cf *loader.ConfigLoader, interruptCh <-chan interface{}, version string) error {
 	// read static asset directory and frontend URL from the environment
 	staticAssetDir := os.Getenv("LITE_STATIC_ASSET_DIR")
 	frontendPort := os.Getenv("LITE_FRONTEND_PORT")
 	runtimePort := os.Getenv("LITE_RUNTIME_PORT")
+	embeddedDBDir := os.Getenv("LITE_EMBEDDED_POSTGRES_DIR")
+	embeddedDBPort := os.Getenv("LITE_EMBEDDED_POSTGRES_PORT")
 
 	if staticAssetDir == "" {
 		return fmt.Errorf("LITE_STATIC_ASSET_DIR environment variable is required")
@@ -91,6 +102,42 @@ func start(cf *loader.ConfigLoader, interruptCh <-chan interface{}, version stri
 		return fmt.Errorf("error parsing frontend URL: %w", err)
 	}
 
+	if embeddedDBDir != "" && os.Getenv("DATABASE_URL") == "" {
+		port := uint64(5432)
+
+		if embeddedDBPort != "" {
+			port, err = strconv.ParseUint(embeddedDBPort, 10, 32)
+
+			if err != nil {
+				return fmt.Errorf("error parsing LITE_EMBEDDED_POSTGRES_PORT: %w", err)
+			}
+		}
+
+		db, err := embeddeddb.Start(embeddeddb.Opts{
+			Dir:  embeddedDBDir,
+			Port: uint32(port),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		defer func() {
+			if err := db.Stop(); err != nil {
+				log.Printf("could not stop embedded database: %s", err.Error())
+			}
+		}()
+
+		_ = os.Setenv("DATABASE_URL", db.URL())
+	}
+
+	// the database is prepared when its url is known, which is always the case in the docker image
+	if databaseURL := os.Getenv("DATABASE_URL"); databaseURL != "" {
+		if err := prepareDatabase(cf, databaseURL); err != nil {
+			return err
+		}
+	}
+
 	_, sc, err := cf.LoadServerConfig(version)
 
 	if err != nil {
@@ -127,7 +174,11 @@ func start(cf *loader.ConfigLoader, interruptCh <-chan interface{}, version stri
 	ctx, cancel := cmdutils.NewInterruptContext()
 	defer cancel()
 
+	engineDone := make(chan struct{})
+
 	go func() {
+		defer close(engineDone)
+
 		if err := engine.Run(ctx, cf, version); err != nil {
 			log.Printf("engine failure: %s", err.Error())
 			os.Exit(1)
@@ -157,5 +208,43 @@ func start(cf *loader.ConfigLoader, interruptCh <-chan interface{}, version stri
 
 	<-interruptCh
 
+	// the engine stops before the embedded database
+	<-engineDone
+
+	return nil
+}
+
+// prepareDatabase applies the pending migrations and creates the initial data, like the default tenant.
+func prepareDatabase(cf *loader.ConfigLoader, databaseURL string) error {
+	ctx := context.Background()
+
+	all, err := migrate.LoadFS(migrations.FS)
+
+	if err != nil {
+		return err
+	}
+
+	conn, err := pgx.Connect(ctx, databaseURL)
+
+	if err != nil {
+		return fmt.Errorf("could not connect to database: %w", err)
+	}
+
+	defer conn.Close(context.Background()) // nolint: errcheck
+
+	l := logger.NewDefaultLogger("migrate")
+
+	applied, err := migrate.NewRunner(conn, &l, migrate.DefaultOptions()).Apply(ctx, all)
+
+	if err != nil {
+		return fmt.Errorf("could not apply migrations: %w", err)
+	}
+
+	log.Printf("applied %d migrations", applied)
+
+	if err := cli.Seed(cf); err != nil {
+		return fmt.Errorf("could not seed database: %w", err)
+	}
+
 	return nil
 }
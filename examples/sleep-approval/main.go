@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/joho/godotenv"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+	"github.com/hatchet-dev/hatchet/pkg/client/rest"
+	"github.com/hatchet-dev/hatchet/pkg/cmdutils"
+	"github.com/hatchet-dev/hatchet/pkg/worker"
+)
+
+type userCreateEvent struct {
+	Username string            `json:"username"`
+	UserID   string            `json:"user_id"`
+	Data     map[string]string `json:"data"`
+}
+
+type stepOneOutput struct {
+	Message string `json:"message"`
+}
+
+type approvalOutput struct {
+	Approver string `json:"approver"`
+}
+
+func main() {
+	err := godotenv.Load()
+	if err != nil {
+		panic(err)
+	}
+
+	events := make(chan string, 50)
+	interrupt := cmdutils.InterruptChan()
+
+	cleanup, err := run(events)
+	if err != nil {
+		panic(err)
+	}
+
+	<-interrupt
+
+	if err := cleanup(); err != nil {
+		panic(fmt.Errorf("error cleaning up: %w", err))
+	}
+}
+
+func run(events chan<- string) (func() error, error) {
+	c, err := client.New()
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating client: %w", err)
+	}
+
+	w, err := worker.NewWorker(
+		worker.WithClient(
+			c,
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating worker: %w", err)
+	}
+
+	workflowRunIds := make(chan string, 1)
+
+	err = w.RegisterWorkflow(
+		&worker.WorkflowJob{
+			On:          worker.Events("user:create:sleep-approval"),
+			Name:        "sleep-approval",
+			Description: "This sleeps and waits for an approval before it creates the user.",
+			Steps: []*worker.WorkflowStep{
+				worker.Fn(func(ctx worker.HatchetContext) (result *stepOneOutput, err error) {
+					input := &userCreateEvent{}
+
+					err = ctx.WorkflowInput(input)
+
+					if err != nil {
+						return nil, err
+					}
+
+					log.Printf("step-one")
+					events <- "step-one"
+					workflowRunIds <- ctx.WorkflowRunId()
+
+					return &stepOneOutput{
+						Message: "Username is: " + input.Username,
+					}, nil
+				},
+				).SetName("step-one"),
+				// the sleep and approval steps don't run on the worker
+				worker.Sleep("2s").SetName("sleep").AddParents("step-one"),
+				worker.Approval().SetName("approval").SetTimeout("1m").AddParents("sleep"),
+				worker.Fn(func(ctx worker.HatchetContext) (result *stepOneOutput, err error) {
+					input := &approvalOutput{}
+					err = ctx.StepOutput("approval", input)
+
+					if err != nil {
+						return nil, err
+					}
+
+					log.Printf("step-two")
+					events <- "step-two"
+
+					return &stepOneOutput{
+						Message: "Approved by: " + input.Approver,
+					}, nil
+				}).SetName("step-two").AddParents("approval"),
+			},
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error registering workflow: %w", err)
+	}
+
+	go func() {
+		testEvent := userCreateEvent{
+			Username: "echo-test",
+			UserID:   "1234",
+			Data: map[string]string{
+				"test": "test",
+			},
+		}
+
+		log.Printf("pushing event user:create:sleep-approval")
+		// push an event
+		err := c.Event().Push(
+			context.Background(),
+			"user:create:sleep-approval",
+			testEvent,
+		)
+		if err != nil {
+			panic(fmt.Errorf("error pushing event: %w", err))
+		}
+
+		err = approve(c, <-workflowRunIds)
+		if err != nil {
+			panic(fmt.Errorf("error approving step run: %w", err))
+		}
+	}()
+
+	cleanup, err := w.Start()
+	if err != nil {
+		panic(err)
+	}
+
+	return cleanup, nil
+}
+
+// approve waits for the pending approval of the workflow run, which is created once the sleep step finished, and
+// approves it.
+func approve(c client.Client, workflowRunId string) error {
+	pending := []rest.StepRunApprovalStatus{rest.StepRunApprovalStatusPENDING}
+
+	for i := 0; i < 30; i++ {
+		time.Sleep(time.Second)
+
+		res, err := c.API().ApprovalListWithResponse(context.Background(), uuid.MustParse(c.TenantId()), &rest.ApprovalListParams{
+			Statuses: &pending,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if res.JSON200 == nil || res.JSON200.Rows == nil {
+			continue
+		}
+
+		for _, approval := range *res.JSON200.Rows {
+			if approval.WorkflowRunId != workflowRunId {
+				continue
+			}
+
+			log.Printf("approving %s", approval.Metadata.Id)
+
+			_, err := c.API().ApprovalUpdateDecideWithResponse(context.Background(), uuid.MustParse(approval.Metadata.Id), rest.DecideStepRunApprovalRequest{
+				Approved: true,
+				Payload: &map[string]interface{}{
+					"approver": "e2e",
+				},
+			})
+
+			return err
+		}
+	}
+
+	return fmt.Errorf("no pending approval for workflow run %s", workflowRunId)
+}
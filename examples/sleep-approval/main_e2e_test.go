@@ -0,0 +1,50 @@
+//go:build e2e
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+)
+
+// TestSleepApproval runs a sleep step and an approval step, which don't run on a worker, between two steps which do.
+// The second step only runs if both steps started and finished.
+func TestSleepApproval(t *testing.T) {
+	testutils.Prepare(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 40*time.Second)
+	defer cancel()
+
+	events := make(chan string, 50)
+
+	cleanup, err := run(events)
+	if err != nil {
+		t.Fatalf("/run() error = %v", err)
+	}
+
+	var items []string
+
+outer:
+	for {
+		select {
+		case item := <-events:
+			items = append(items, item)
+		case <-ctx.Done():
+			break outer
+		}
+	}
+
+	assert.Equal(t, []string{
+		"step-one",
+		"step-two",
+	}, items)
+
+	if err := cleanup(); err != nil {
+		t.Fatalf("cleanup() error = %v", err)
+	}
+}
@@ -14,52 +14,91 @@ import {
   APIError,
   APIErrors,
   APIMeta,
+  AuditLogList,
+  BatchCreateEventRequest,
+  BatchCreateEventResponse,
   BulkCreateEventRequest,
   BulkCreateEventResponse,
   CancelEventRequest,
+  ClientCA,
+  ClientCAList,
   CreateAPITokenRequest,
   CreateAPITokenResponse,
+  CreateClientCARequest,
+  CreateCronExclusionCalendarRequest,
   CreateCronWorkflowTriggerRequest,
   CreateEventRequest,
+  CreateEventSinkRequest,
+  CreateInboundWebhookRequest,
   CreateSNSIntegrationRequest,
+  CreateSQSIntegrationRequest,
   CreateTenantAlertEmailGroupRequest,
   CreateTenantInviteRequest,
   CreateTenantRequest,
+  CronExclusionCalendar,
+  CronExclusionCalendarList,
   CronWorkflows,
   CronWorkflowsList,
   CronWorkflowsOrderByField,
+  DeadLetterQueueItemList,
+  DecideStepRunApprovalRequest,
   Event,
   EventData,
+  EventDedupRule,
+  EventDedupRuleList,
   EventKey,
   EventKeyList,
   EventList,
   EventOrderByDirection,
   EventOrderByField,
+  EventRoutingRule,
+  EventRoutingRuleList,
   EventSearch,
+  EventSink,
+  EventSinkList,
+  HealthReport,
+  InboundWebhook,
+  InboundWebhookList,
   ListAPIMetaIntegration,
   ListAPITokensResponse,
   ListSlackWebhooks,
   ListSNSIntegrations,
+  ListSQSIntegrations,
+  LogLine,
   LogLineLevelField,
   LogLineList,
   LogLineOrderByDirection,
   LogLineOrderByField,
   LogLineSearch,
+  PurgeDeadLetterQueueItemsRequest,
+  PurgeDeadLetterQueueItemsResponse,
+  Queue,
   RateLimitList,
   RateLimitOrderByDirection,
   RateLimitOrderByField,
   RejectInviteRequest,
+  ReplayDeadLetterQueueItemsRequest,
+  ReplayDeadLetterQueueItemsResponse,
+  ReplayEventRangeRequest,
+  ReplayEventRangeResponse,
   ReplayEventRequest,
+  ReplayWorkflowRunFromStepRequest,
   ReplayWorkflowRunsRequest,
   ReplayWorkflowRunsResponse,
   RerunStepRunRequest,
+  RotateAPITokenRequest,
   ScheduledRunStatus,
   ScheduledWorkflows,
   ScheduledWorkflowsList,
   ScheduledWorkflowsOrderByField,
   ScheduleWorkflowRunRequest,
+  SchedulingDecisionList,
   SNSIntegration,
+  SQSIntegration,
   StepRun,
+  StepRunApproval,
+  StepRunApprovalList,
+  StepRunApprovalStatus,
   StepRunArchiveList,
   StepRunEventList,
   Tenant,
@@ -71,13 +110,18 @@ import {
   TenantMember,
   TenantMemberList,
   TenantQueueMetrics,
+  TenantQuotaUsageList,
   TenantResourcePolicy,
   TenantStepRunQueueMetrics,
   TriggerWorkflowRunRequest,
+  UpdateScheduledWorkflowRunRequest,
   UpdateTenantAlertEmailGroupRequest,
   UpdateTenantInviteRequest,
   UpdateTenantRequest,
   UpdateWorkerRequest,
+  UpdateWorkflowRolloutRequest,
+  UpsertEventDedupRuleRequest,
+  UpsertEventRoutingRuleRequest,
   User,
   UserChangePasswordRequest,
   UserLoginRequest,
@@ -89,11 +133,14 @@ import {
   WebhookWorkerRequestListResponse,
   Worker,
   WorkerList,
+  WorkerPoolList,
   Workflow,
   WorkflowID,
   WorkflowKindList,
   WorkflowList,
+  WorkflowConcurrencyGroupList,
   WorkflowMetrics,
+  WorkflowRollout,
   WorkflowRun,
   WorkflowRunList,
   WorkflowRunOrderByDirection,
@@ -103,6 +150,7 @@ import {
   WorkflowRunsMetrics,
   WorkflowRunStatus,
   WorkflowRunStatusList,
+  WorkflowRunStreamEvent,
   WorkflowUpdateRequest,
   WorkflowVersion,
   WorkflowWorkersCount,
@@ -138,6 +186,36 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       method: 'GET',
       ...params,
     });
+  /**
+   * @description Checks the dependencies which require a restart of the API server when they fail, which are the database and the message queue
+   *
+   * @tags Healthcheck
+   * @name HealthzGet
+   * @summary Get health
+   * @request GET:/api/healthz
+   */
+  healthzGet = (params: RequestParams = {}) =>
+    this.request<HealthReport, HealthReport>({
+      path: `/api/healthz`,
+      method: 'GET',
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Checks all the dependencies of the API server, which are the database, the message queue, the acquisition of leases and the migrations of the database
+   *
+   * @tags Healthcheck
+   * @name ReadyzGet
+   * @summary Get readiness of dependencies
+   * @request GET:/api/readyz
+   */
+  readyzGet = (params: RequestParams = {}) =>
+    this.request<HealthReport, HealthReport>({
+      path: `/api/readyz`,
+      method: 'GET',
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Gets metadata for the Hatchet instance
    *
@@ -258,6 +336,34 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       method: 'GET',
       ...params,
     });
+  /**
+   * @description Starts the OpenID Connect flow with the single sign-on provider of the instance
+   *
+   * @tags User
+   * @name UserUpdateOidcStart
+   * @summary Start OpenID Connect flow
+   * @request GET:/api/v1/users/oidc/start
+   */
+  userUpdateOidcStart = (params: RequestParams = {}) =>
+    this.request<any, void>({
+      path: `/api/v1/users/oidc/start`,
+      method: 'GET',
+      ...params,
+    });
+  /**
+   * @description Completes the OpenID Connect flow with the single sign-on provider of the instance
+   *
+   * @tags User
+   * @name UserUpdateOidcCallback
+   * @summary Complete OpenID Connect flow
+   * @request GET:/api/v1/users/oidc/callback
+   */
+  userUpdateOidcCallback = (params: RequestParams = {}) =>
+    this.request<any, void>({
+      path: `/api/v1/users/oidc/callback`,
+      method: 'GET',
+      ...params,
+    });
   /**
    * @description Starts the OAuth flow
    *
@@ -340,6 +446,42 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description List SQS integrations
+   *
+   * @tags SQS
+   * @name SqsList
+   * @summary List SQS integrations
+   * @request GET:/api/v1/tenants/{tenant}/sqs
+   * @secure
+   */
+  sqsList = (tenant: string, params: RequestParams = {}) =>
+    this.request<ListSQSIntegrations, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/sqs`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Create SQS integration
+   *
+   * @tags SQS
+   * @name SqsCreate
+   * @summary Create SQS integration
+   * @request POST:/api/v1/tenants/{tenant}/sqs
+   * @secure
+   */
+  sqsCreate = (tenant: string, data: CreateSQSIntegrationRequest, params: RequestParams = {}) =>
+    this.request<SQSIntegration, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/sqs`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Creates a new tenant alert email group
    *
@@ -448,6 +590,22 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       secure: true,
       ...params,
     });
+  /**
+   * @description Delete SQS integration
+   *
+   * @tags SQS
+   * @name SqsDelete
+   * @summary Delete SQS integration
+   * @request DELETE:/api/v1/sqs/{sqs}
+   * @secure
+   */
+  sqsDelete = (sqs: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/sqs/${sqs}`,
+      method: 'DELETE',
+      secure: true,
+      ...params,
+    });
   /**
    * @description List Slack webhooks
    *
@@ -803,6 +961,152 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       secure: true,
       ...params,
     });
+  /**
+   * @description Rotate an API token for a tenant. A new token with the same name and scope is issued, and the rotated token stays valid for a grace period so workers can be moved to the new token without downtime.
+   *
+   * @tags API Token
+   * @name ApiTokenUpdateRotate
+   * @summary Rotate API Token
+   * @request POST:/api/v1/api-tokens/{api-token}/rotate
+   * @secure
+   */
+  apiTokenUpdateRotate = (apiToken: string, data: RotateAPITokenRequest, params: RequestParams = {}) =>
+    this.request<CreateAPITokenResponse, APIErrors>({
+      path: `/api/v1/api-tokens/${apiToken}/rotate`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Lists the CAs which the client certificates of the workers of a tenant are verified against.
+   *
+   * @tags Client CA
+   * @name ClientCaList
+   * @summary List client CAs
+   * @request GET:/api/v1/tenants/{tenant}/client-cas
+   * @secure
+   */
+  clientCaList = (tenant: string, params: RequestParams = {}) =>
+    this.request<ClientCAList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/client-cas`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Adds a CA to a tenant. Workers whose client certificate is signed by the CA and has a SPIFFE ID with the prefix of the CA authenticate as the tenant.
+   *
+   * @tags Client CA
+   * @name ClientCaCreate
+   * @summary Create client CA
+   * @request POST:/api/v1/tenants/{tenant}/client-cas
+   * @secure
+   */
+  clientCaCreate = (tenant: string, data: CreateClientCARequest, params: RequestParams = {}) =>
+    this.request<ClientCA, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/client-cas`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Deletes a client CA. Client certificates which are signed by the CA are no longer accepted.
+   *
+   * @tags Client CA
+   * @name ClientCaDelete
+   * @summary Delete client CA
+   * @request DELETE:/api/v1/client-cas/{client-ca}
+   * @secure
+   */
+  clientCaDelete = (clientCa: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/client-cas/${clientCa}`,
+      method: 'DELETE',
+      secure: true,
+      ...params,
+    });
+  /**
+   * @description Lists the audit log of a tenant, which records the mutating API calls of the users and API tokens of the tenant, newest first.
+   *
+   * @tags Audit Log
+   * @name AuditLogList
+   * @summary List audit log
+   * @request GET:/api/v1/tenants/{tenant}/audit-logs
+   * @secure
+   */
+  auditLogList = (
+    tenant: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+      /**
+       * Only list the API calls of a user or API token
+       * @format uuid
+       * @minLength 36
+       * @maxLength 36
+       */
+      actorId?: string;
+      /** Only list the API calls of an operation */
+      operation?: string;
+      /** Only list the API calls which operated on a resource type */
+      resourceType?: string;
+      /** Only list the API calls which operated on a resource */
+      resourceId?: string;
+      /**
+       * Only list the API calls which were made at or after this time
+       * @format date-time
+       * @example "2021-01-01T00:00:00Z"
+       */
+      since?: string;
+      /**
+       * Only list the API calls which were made before this time
+       * @format date-time
+       * @example "2021-01-01T00:00:00Z"
+       */
+      until?: string;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<AuditLogList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/audit-logs`,
+      method: 'GET',
+      query: query,
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Lists the quotas which apply to a tenant and the current usage of each quota.
+   *
+   * @tags Tenant
+   * @name TenantQuotaList
+   * @summary List tenant quotas
+   * @request GET:/api/v1/tenants/{tenant}/quotas
+   * @secure
+   */
+  tenantQuotaList = (tenant: string, params: RequestParams = {}) =>
+    this.request<TenantQuotaUsageList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/quotas`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Get the queue metrics for the tenant
    *
@@ -822,6 +1126,11 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
        * @example ["key1:value1","key2:value2"]
        */
       additionalMetadata?: string[];
+      /**
+       * The window of the queue metrics history to return, as a duration like 5m or 1h. If set, the history of every queue over the window is returned. The window can be at most 24h.
+       * @example "5m"
+       */
+      window?: string;
     },
     params: RequestParams = {},
   ) =>
@@ -850,6 +1159,40 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description Pauses a queue. Step runs in a paused queue are not assigned to workers until the queue is resumed.
+   *
+   * @tags Queue
+   * @name QueueUpdatePause
+   * @summary Pause queue
+   * @request POST:/api/v1/tenants/{tenant}/queues/{queue}/pause
+   * @secure
+   */
+  queueUpdatePause = (tenant: string, queue: string, params: RequestParams = {}) =>
+    this.request<Queue, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/queues/${queue}/pause`,
+      method: 'POST',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Resumes a paused queue.
+   *
+   * @tags Queue
+   * @name QueueUpdateResume
+   * @summary Resume queue
+   * @request POST:/api/v1/tenants/{tenant}/queues/{queue}/resume
+   * @secure
+   */
+  queueUpdateResume = (tenant: string, queue: string, params: RequestParams = {}) =>
+    this.request<Queue, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/queues/${queue}/resume`,
+      method: 'POST',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Lists all events for a tenant.
    *
@@ -911,9 +1254,533 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
    * @request POST:/api/v1/tenants/{tenant}/events
    * @secure
    */
-  eventCreate = (tenant: string, data: CreateEventRequest, params: RequestParams = {}) =>
-    this.request<Event, APIErrors>({
-      path: `/api/v1/tenants/${tenant}/events`,
+  eventCreate = (tenant: string, data: CreateEventRequest, params: RequestParams = {}) =>
+    this.request<Event, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/events`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Creates a batch of events in a single transaction. Events which are invalid are reported in the results and are not created, while the other events are created.
+   *
+   * @tags Event
+   * @name EventCreateBatch
+   * @summary Batch create events
+   * @request POST:/api/v1/tenants/{tenant}/events/batch
+   * @secure
+   */
+  eventCreateBatch = (tenant: string, data: BatchCreateEventRequest, params: RequestParams = {}) =>
+    this.request<BatchCreateEventResponse, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/events/batch`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Bulk creates new events.
+   *
+   * @tags Event
+   * @name EventCreateBulk
+   * @summary Bulk Create events
+   * @request POST:/api/v1/tenants/{tenant}/events/bulk
+   * @secure
+   */
+  eventCreateBulk = (tenant: string, data: BulkCreateEventRequest, params: RequestParams = {}) =>
+    this.request<BulkCreateEventResponse, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/events/bulk`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Creates an event from a CloudEvent in the structured or the binary HTTP mode. The type of the CloudEvent is the key of the event, its data is the data of the event, and its extensions are added to the additional metadata of the event.
+   *
+   * @tags Event
+   * @name EventCreateCloudEvent
+   * @summary Create event from CloudEvent
+   * @request POST:/api/v1/tenants/{tenant}/events/cloudevents
+   * @secure
+   */
+  eventCreateCloudEvent = (tenant: string, params: RequestParams = {}) =>
+    this.request<Event, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/events/cloudevents`,
+      method: 'POST',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Replays a list of events.
+   *
+   * @tags Event
+   * @name EventUpdateReplay
+   * @summary Replay events
+   * @request POST:/api/v1/tenants/{tenant}/events/replay
+   * @secure
+   */
+  eventUpdateReplay = (tenant: string, data: ReplayEventRequest, params: RequestParams = {}) =>
+    this.request<EventList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/events/replay`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Replays the events which were created in a time window against the current versions of the workflows which they trigger. Events can be filtered by key and additional metadata. In a dry run, the events aren't replayed and the response only reports which workflows would be triggered.
+   *
+   * @tags Event
+   * @name EventUpdateReplayRange
+   * @summary Replay a range of events
+   * @request POST:/api/v1/tenants/{tenant}/events/replay-range
+   * @secure
+   */
+  eventUpdateReplayRange = (tenant: string, data: ReplayEventRangeRequest, params: RequestParams = {}) =>
+    this.request<ReplayEventRangeResponse, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/events/replay-range`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Cancels all runs for a list of events.
+   *
+   * @tags Event
+   * @name EventUpdateCancel
+   * @summary Replay events
+   * @request POST:/api/v1/tenants/{tenant}/events/cancel
+   * @secure
+   */
+  eventUpdateCancel = (tenant: string, data: CancelEventRequest, params: RequestParams = {}) =>
+    this.request<
+      {
+        workflowRunIds?: string[];
+      },
+      APIErrors
+    >({
+      path: `/api/v1/tenants/${tenant}/events/cancel`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Lists all rate limits for a tenant.
+   *
+   * @tags Rate Limits
+   * @name RateLimitList
+   * @summary List rate limits
+   * @request GET:/api/v1/tenants/{tenant}/rate-limits
+   * @secure
+   */
+  rateLimitList = (
+    tenant: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+      /** The search query to filter for */
+      search?: string;
+      /** What to order by */
+      orderByField?: RateLimitOrderByField;
+      /** The order direction */
+      orderByDirection?: RateLimitOrderByDirection;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<RateLimitList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/rate-limits`,
+      method: 'GET',
+      query: query,
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Lists the step runs of a tenant which exhausted their retries.
+   *
+   * @tags Dead Letter Queue
+   * @name DeadLetterQueueList
+   * @summary List dead-letter queue items
+   * @request GET:/api/v1/tenants/{tenant}/dead-letter-queue
+   * @secure
+   */
+  deadLetterQueueList = (
+    tenant: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<DeadLetterQueueItemList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/dead-letter-queue`,
+      method: 'GET',
+      query: query,
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Replays dead-letter queue items and removes the replayed items from the queue.
+   *
+   * @tags Dead Letter Queue
+   * @name DeadLetterQueueUpdateReplay
+   * @summary Replay dead-letter queue items
+   * @request POST:/api/v1/tenants/{tenant}/dead-letter-queue/replay
+   * @secure
+   */
+  deadLetterQueueUpdateReplay = (tenant: string, data: ReplayDeadLetterQueueItemsRequest, params: RequestParams = {}) =>
+    this.request<ReplayDeadLetterQueueItemsResponse, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/dead-letter-queue/replay`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Deletes dead-letter queue items without replaying them.
+   *
+   * @tags Dead Letter Queue
+   * @name DeadLetterQueueDelete
+   * @summary Purge dead-letter queue items
+   * @request POST:/api/v1/tenants/{tenant}/dead-letter-queue/purge
+   * @secure
+   */
+  deadLetterQueueDelete = (tenant: string, data: PurgeDeadLetterQueueItemsRequest, params: RequestParams = {}) =>
+    this.request<PurgeDeadLetterQueueItemsResponse, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/dead-letter-queue/purge`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Lists the approvals of approval step runs of a tenant.
+   *
+   * @tags Approval
+   * @name ApprovalList
+   * @summary List approvals
+   * @request GET:/api/v1/tenants/{tenant}/approvals
+   * @secure
+   */
+  approvalList = (
+    tenant: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+      /** A list of approval statuses to filter by */
+      statuses?: StepRunApprovalStatus[];
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<StepRunApprovalList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/approvals`,
+      method: 'GET',
+      query: query,
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Get an approval of an approval step run.
+   *
+   * @tags Approval
+   * @name ApprovalGet
+   * @summary Get approval
+   * @request GET:/api/v1/approvals/{approval}
+   * @secure
+   */
+  approvalGet = (approval: string, params: RequestParams = {}) =>
+    this.request<StepRunApproval, APIErrors>({
+      path: `/api/v1/approvals/${approval}`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Approves or rejects a pending approval, which resumes its workflow run.
+   *
+   * @tags Approval
+   * @name ApprovalUpdateDecide
+   * @summary Decide approval
+   * @request POST:/api/v1/approvals/{approval}/decide
+   * @secure
+   */
+  approvalUpdateDecide = (approval: string, data: DecideStepRunApprovalRequest, params: RequestParams = {}) =>
+    this.request<StepRunApproval, APIErrors>({
+      path: `/api/v1/approvals/${approval}/decide`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Approves or rejects a pending approval using its token, which resumes its workflow run. This endpoint doesn't require an API token, so it can be used as a webhook.
+   *
+   * @tags Approval
+   * @name ApprovalUpdateDecideWithToken
+   * @summary Decide approval with token
+   * @request POST:/api/v1/approval-tokens/{approval-token}/decide
+   */
+  approvalUpdateDecideWithToken = (
+    approvalToken: string,
+    data: DecideStepRunApprovalRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/approval-tokens/${approvalToken}/decide`,
+      method: 'POST',
+      body: data,
+      type: ContentType.Json,
+      ...params,
+    });
+  /**
+   * @description Lists the exclusion calendars of a tenant, which cron triggers can reference to skip dates.
+   *
+   * @tags Workflow
+   * @name CronCalendarList
+   * @summary List cron exclusion calendars
+   * @request GET:/api/v1/tenants/{tenant}/cron-calendars
+   * @secure
+   */
+  cronCalendarList = (tenant: string, params: RequestParams = {}) =>
+    this.request<CronExclusionCalendarList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/cron-calendars`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Creates an exclusion calendar of dates or an iCal feed, which cron triggers can reference to skip holidays or maintenance windows.
+   *
+   * @tags Workflow
+   * @name CronCalendarCreate
+   * @summary Create cron exclusion calendar
+   * @request POST:/api/v1/tenants/{tenant}/cron-calendars
+   * @secure
+   */
+  cronCalendarCreate = (tenant: string, data: CreateCronExclusionCalendarRequest, params: RequestParams = {}) =>
+    this.request<CronExclusionCalendar, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/cron-calendars`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Deletes an exclusion calendar. Crons which reference the calendar are no longer excluded on any date.
+   *
+   * @tags Workflow
+   * @name CronCalendarDelete
+   * @summary Delete cron exclusion calendar
+   * @request DELETE:/api/v1/tenants/{tenant}/cron-calendars/{cron-calendar}
+   * @secure
+   */
+  cronCalendarDelete = (tenant: string, cronCalendar: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/cron-calendars/${cronCalendar}`,
+      method: 'DELETE',
+      secure: true,
+      ...params,
+    });
+  /**
+   * @description Lists the inbound webhooks of a tenant.
+   *
+   * @tags Inbound Webhook
+   * @name InboundWebhookList
+   * @summary List inbound webhooks
+   * @request GET:/api/v1/tenants/{tenant}/inbound-webhooks
+   * @secure
+   */
+  inboundWebhookList = (tenant: string, params: RequestParams = {}) =>
+    this.request<InboundWebhookList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/inbound-webhooks`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Creates an inbound webhook, which verifies the signatures of the requests it receives and ingests them as events.
+   *
+   * @tags Inbound Webhook
+   * @name InboundWebhookCreate
+   * @summary Create inbound webhook
+   * @request POST:/api/v1/tenants/{tenant}/inbound-webhooks
+   * @secure
+   */
+  inboundWebhookCreate = (tenant: string, data: CreateInboundWebhookRequest, params: RequestParams = {}) =>
+    this.request<InboundWebhook, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/inbound-webhooks`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Deletes an inbound webhook, so its URL stops accepting requests.
+   *
+   * @tags Inbound Webhook
+   * @name InboundWebhookDelete
+   * @summary Delete inbound webhook
+   * @request DELETE:/api/v1/inbound-webhooks/{inbound-webhook}
+   * @secure
+   */
+  inboundWebhookDelete = (inboundWebhook: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/inbound-webhooks/${inboundWebhook}`,
+      method: 'DELETE',
+      secure: true,
+      ...params,
+    });
+  /**
+   * @description Receives a request from a webhook provider. The signature of the request is verified with the secret of the webhook, and the request is ingested as an event.
+   *
+   * @tags Inbound Webhook
+   * @name InboundWebhookReceive
+   * @summary Receive inbound webhook request
+   * @request POST:/api/v1/inbound-webhooks/{inbound-webhook}
+   */
+  inboundWebhookReceive = (inboundWebhook: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/inbound-webhooks/${inboundWebhook}`,
+      method: 'POST',
+      ...params,
+    });
+  /**
+   * @description Lists the dedup rules of the event keys of a tenant.
+   *
+   * @tags Event Dedup Rule
+   * @name EventDedupRuleList
+   * @summary List event dedup rules
+   * @request GET:/api/v1/tenants/{tenant}/event-dedup-rules
+   * @secure
+   */
+  eventDedupRuleList = (tenant: string, params: RequestParams = {}) =>
+    this.request<EventDedupRuleList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/event-dedup-rules`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Creates the dedup rule of an event key, or replaces the existing rule of the key. Events with the key whose dedup key was already seen within the window of the rule are duplicates, and don't trigger workflows.
+   *
+   * @tags Event Dedup Rule
+   * @name EventDedupRuleUpsert
+   * @summary Create or replace event dedup rule
+   * @request POST:/api/v1/tenants/{tenant}/event-dedup-rules
+   * @secure
+   */
+  eventDedupRuleUpsert = (tenant: string, data: UpsertEventDedupRuleRequest, params: RequestParams = {}) =>
+    this.request<EventDedupRule, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/event-dedup-rules`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Deletes an event dedup rule. Events with its key are no longer deduplicated.
+   *
+   * @tags Event Dedup Rule
+   * @name EventDedupRuleDelete
+   * @summary Delete event dedup rule
+   * @request DELETE:/api/v1/event-dedup-rules/{event-dedup-rule}
+   * @secure
+   */
+  eventDedupRuleDelete = (eventDedupRule: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/event-dedup-rules/${eventDedupRule}`,
+      method: 'DELETE',
+      secure: true,
+      ...params,
+    });
+  /**
+   * @description Lists the routing rules of a tenant.
+   *
+   * @tags Event Routing Rule
+   * @name EventRoutingRuleList
+   * @summary List event routing rules
+   * @request GET:/api/v1/tenants/{tenant}/event-routing-rules
+   * @secure
+   */
+  eventRoutingRuleList = (tenant: string, params: RequestParams = {}) =>
+    this.request<EventRoutingRuleList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/event-routing-rules`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Creates a routing rule, or replaces the existing rule with the same name. Events which match the predicate of the rule trigger its workflow, in addition to the workflows which are triggered by their key.
+   *
+   * @tags Event Routing Rule
+   * @name EventRoutingRuleUpsert
+   * @summary Create or replace event routing rule
+   * @request POST:/api/v1/tenants/{tenant}/event-routing-rules
+   * @secure
+   */
+  eventRoutingRuleUpsert = (tenant: string, data: UpsertEventRoutingRuleRequest, params: RequestParams = {}) =>
+    this.request<EventRoutingRule, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/event-routing-rules`,
       method: 'POST',
       body: data,
       secure: true,
@@ -922,60 +1789,50 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       ...params,
     });
   /**
-   * @description Bulk creates new events.
+   * @description Deletes an event routing rule. Events which match its predicate no longer trigger its workflow.
    *
-   * @tags Event
-   * @name EventCreateBulk
-   * @summary Bulk Create events
-   * @request POST:/api/v1/tenants/{tenant}/events/bulk
+   * @tags Event Routing Rule
+   * @name EventRoutingRuleDelete
+   * @summary Delete event routing rule
+   * @request DELETE:/api/v1/event-routing-rules/{event-routing-rule}
    * @secure
    */
-  eventCreateBulk = (tenant: string, data: BulkCreateEventRequest, params: RequestParams = {}) =>
-    this.request<BulkCreateEventResponse, APIErrors>({
-      path: `/api/v1/tenants/${tenant}/events/bulk`,
-      method: 'POST',
-      body: data,
+  eventRoutingRuleDelete = (eventRoutingRule: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/event-routing-rules/${eventRoutingRule}`,
+      method: 'DELETE',
       secure: true,
-      type: ContentType.Json,
-      format: 'json',
       ...params,
     });
   /**
-   * @description Replays a list of events.
+   * @description Lists the event sinks of a tenant, with the number of pending and dead-lettered deliveries of each sink.
    *
-   * @tags Event
-   * @name EventUpdateReplay
-   * @summary Replay events
-   * @request POST:/api/v1/tenants/{tenant}/events/replay
+   * @tags Event Sink
+   * @name EventSinkList
+   * @summary List event sinks
+   * @request GET:/api/v1/tenants/{tenant}/event-sinks
    * @secure
    */
-  eventUpdateReplay = (tenant: string, data: ReplayEventRequest, params: RequestParams = {}) =>
-    this.request<EventList, APIErrors>({
-      path: `/api/v1/tenants/${tenant}/events/replay`,
-      method: 'POST',
-      body: data,
+  eventSinkList = (tenant: string, params: RequestParams = {}) =>
+    this.request<EventSinkList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/event-sinks`,
+      method: 'GET',
       secure: true,
-      type: ContentType.Json,
       format: 'json',
       ...params,
     });
   /**
-   * @description Cancels all runs for a list of events.
+   * @description Creates an event sink, which the lifecycle events of the runs of the tenant are delivered to.
    *
-   * @tags Event
-   * @name EventUpdateCancel
-   * @summary Replay events
-   * @request POST:/api/v1/tenants/{tenant}/events/cancel
+   * @tags Event Sink
+   * @name EventSinkCreate
+   * @summary Create event sink
+   * @request POST:/api/v1/tenants/{tenant}/event-sinks
    * @secure
    */
-  eventUpdateCancel = (tenant: string, data: CancelEventRequest, params: RequestParams = {}) =>
-    this.request<
-      {
-        workflowRunIds?: string[];
-      },
-      APIErrors
-    >({
-      path: `/api/v1/tenants/${tenant}/events/cancel`,
+  eventSinkCreate = (tenant: string, data: CreateEventSinkRequest, params: RequestParams = {}) =>
+    this.request<EventSink, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/event-sinks`,
       method: 'POST',
       body: data,
       secure: true,
@@ -984,40 +1841,34 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       ...params,
     });
   /**
-   * @description Lists all rate limits for a tenant.
+   * @description Deletes an event sink and the records which weren't delivered to it.
    *
-   * @tags Rate Limits
-   * @name RateLimitList
-   * @summary List rate limits
-   * @request GET:/api/v1/tenants/{tenant}/rate-limits
+   * @tags Event Sink
+   * @name EventSinkDelete
+   * @summary Delete event sink
+   * @request DELETE:/api/v1/event-sinks/{event-sink}
    * @secure
    */
-  rateLimitList = (
-    tenant: string,
-    query?: {
-      /**
-       * The number to skip
-       * @format int64
-       */
-      offset?: number;
-      /**
-       * The number to limit by
-       * @format int64
-       */
-      limit?: number;
-      /** The search query to filter for */
-      search?: string;
-      /** What to order by */
-      orderByField?: RateLimitOrderByField;
-      /** The order direction */
-      orderByDirection?: RateLimitOrderByDirection;
-    },
-    params: RequestParams = {},
-  ) =>
-    this.request<RateLimitList, APIErrors>({
-      path: `/api/v1/tenants/${tenant}/rate-limits`,
-      method: 'GET',
-      query: query,
+  eventSinkDelete = (eventSink: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/event-sinks/${eventSink}`,
+      method: 'DELETE',
+      secure: true,
+      ...params,
+    });
+  /**
+   * @description Delivers the dead-lettered records of an event sink again.
+   *
+   * @tags Event Sink
+   * @name EventSinkReplay
+   * @summary Replay dead-lettered records
+   * @request POST:/api/v1/event-sinks/{event-sink}/replay
+   * @secure
+   */
+  eventSinkReplay = (eventSink: string, params: RequestParams = {}) =>
+    this.request<EventSink, APIErrors>({
+      path: `/api/v1/event-sinks/${eventSink}/replay`,
+      method: 'POST',
       secure: true,
       format: 'json',
       ...params,
@@ -1246,6 +2097,30 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       secure: true,
       ...params,
     });
+  /**
+   * @description Reschedule a scheduled workflow run which has not been triggered yet
+   *
+   * @tags Workflow
+   * @name WorkflowScheduledUpdate
+   * @summary Reschedule scheduled workflow run
+   * @request PATCH:/api/v1/tenants/{tenant}/workflows/scheduled/{scheduled-workflow-run}
+   * @secure
+   */
+  workflowScheduledUpdate = (
+    tenant: string,
+    scheduledWorkflowRun: string,
+    data: UpdateScheduledWorkflowRunRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<ScheduledWorkflows, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflows/scheduled/${scheduledWorkflowRun}`,
+      method: 'PATCH',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Create a new cron job workflow trigger for a tenant
    *
@@ -1518,6 +2393,86 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description List the concurrency groups of a workflow which have running or queued runs, along with the number of runs in each state
+   *
+   * @tags Workflow
+   * @name WorkflowListConcurrencyGroups
+   * @summary List concurrency groups
+   * @request GET:/api/v1/workflows/{workflow}/concurrency-groups
+   * @secure
+   */
+  workflowListConcurrencyGroups = (
+    workflow: string,
+    query?: {
+      /**
+       * The maximum number of groups to return
+       * @format int64
+       */
+      limit?: number;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<WorkflowConcurrencyGroupList, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/concurrency-groups`,
+      method: 'GET',
+      query: query,
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Get the rollout of a workflow, which splits new runs between a stable version and the latest version
+   *
+   * @tags Workflow
+   * @name WorkflowGetRollout
+   * @summary Get workflow rollout
+   * @request GET:/api/v1/workflows/{workflow}/rollout
+   * @secure
+   */
+  workflowGetRollout = (workflow: string, params: RequestParams = {}) =>
+    this.request<WorkflowRollout, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/rollout`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Create or update the rollout of a workflow. New runs are triggered on the latest version for the canary percentage of runs, and on the stable version otherwise. Runs which have already been triggered stay on their version.
+   *
+   * @tags Workflow
+   * @name WorkflowUpdateRollout
+   * @summary Update workflow rollout
+   * @request PUT:/api/v1/workflows/{workflow}/rollout
+   * @secure
+   */
+  workflowUpdateRollout = (workflow: string, data: UpdateWorkflowRolloutRequest, params: RequestParams = {}) =>
+    this.request<WorkflowRollout, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/rollout`,
+      method: 'PUT',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Delete the rollout of a workflow, so that new runs are triggered on the latest version
+   *
+   * @tags Workflow
+   * @name WorkflowDeleteRollout
+   * @summary Delete workflow rollout
+   * @request DELETE:/api/v1/workflows/{workflow}/rollout
+   * @secure
+   */
+  workflowDeleteRollout = (workflow: string, params: RequestParams = {}) =>
+    this.request<void, APIErrors>({
+      path: `/api/v1/workflows/${workflow}/rollout`,
+      method: 'DELETE',
+      secure: true,
+      ...params,
+    });
   /**
    * @description Lists log lines for a step run.
    *
@@ -1544,6 +2499,11 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       levels?: LogLineLevelField;
       /** The search query to filter for */
       search?: LogLineSearch;
+      /**
+       * Only return log lines with an id greater than this id, in the order they were stored. This is used to tail the log lines of a step run.
+       * @format int64
+       */
+      afterId?: number;
       /** What to order by */
       orderByField?: LogLineOrderByField;
       /** The order direction */
@@ -1559,6 +2519,30 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description Tails the log lines of a step run as server-sent events. The data of every event is a LogLine, and the id of every event is the id of the log line. Clients which reconnect with the Last-Event-ID header receive the log lines which were stored in the meantime. An end event is sent once the step run has finished and all of its log lines were sent.
+   *
+   * @tags Log
+   * @name LogLineStream
+   * @summary Tail log lines
+   * @request GET:/api/v1/step-runs/{step-run}/logs/stream
+   * @secure
+   */
+  logLineStream = (
+    stepRun: string,
+    query?: {
+      /** A list of levels to filter by */
+      levels?: LogLineLevelField;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<LogLine, APIErrors>({
+      path: `/api/v1/step-runs/${stepRun}/logs/stream`,
+      method: 'GET',
+      query: query,
+      secure: true,
+      ...params,
+    });
   /**
    * @description List events for a step run
    *
@@ -1654,6 +2638,39 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description List the scheduling decisions for a step run, which record why the step run was or was not assigned to a worker
+   *
+   * @tags Step Run
+   * @name StepRunListSchedulingDecisions
+   * @summary List scheduling decisions for step run
+   * @request GET:/api/v1/step-runs/{step-run}/scheduling-decisions
+   * @secure
+   */
+  stepRunListSchedulingDecisions = (
+    stepRun: string,
+    query?: {
+      /**
+       * The number to skip
+       * @format int64
+       */
+      offset?: number;
+      /**
+       * The number to limit by
+       * @format int64
+       */
+      limit?: number;
+    },
+    params: RequestParams = {},
+  ) =>
+    this.request<SchedulingDecisionList, APIErrors>({
+      path: `/api/v1/step-runs/${stepRun}/scheduling-decisions`,
+      method: 'GET',
+      query: query,
+      secure: true,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Get a count of the workers available for workflow
    *
@@ -1684,7 +2701,8 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
     tenant: string,
     query?: {
       /**
-       * The number to skip
+       * The number to skip, which is ignored when a cursor is set. Deprecated in favor of the cursor, which is faster on large tenants.
+       * @deprecated
        * @format int64
        */
       offset?: number;
@@ -1693,6 +2711,8 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
        * @format int64
        */
       limit?: number;
+      /** The cursor returned as nextCursor by the previous page. The order by field and direction must match the previous page. The runs aren't counted when a cursor is set, so the response has no pagination. */
+      cursor?: string;
       /**
        * The event id to get runs for.
        * @format uuid
@@ -1700,6 +2720,8 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
        * @maxLength 36
        */
       eventId?: string;
+      /** The key of the event which triggered the runs. */
+      eventKey?: string;
       /**
        * The workflow id to get runs for.
        * @format uuid
@@ -1707,6 +2729,8 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
        * @maxLength 36
        */
       workflowId?: string;
+      /** A list of workflow ids to get runs for. */
+      workflowIds?: string[];
       /**
        * The parent workflow run id
        * @format uuid
@@ -1977,6 +3001,23 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description Get the worker pools of a tenant with the capacity of their active workers
+   *
+   * @tags Worker
+   * @name WorkerPoolList
+   * @summary Get worker pools
+   * @request GET:/api/v1/tenants/{tenant}/worker-pools
+   * @secure
+   */
+  workerPoolList = (tenant: string, params: RequestParams = {}) =>
+    this.request<WorkerPoolList, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/worker-pools`,
+      method: 'GET',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
   /**
    * @description Update a worker
    *
@@ -2095,4 +3136,78 @@ export class Api<SecurityDataType = unknown> extends HttpClient<SecurityDataType
       format: 'json',
       ...params,
     });
+  /**
+   * @description Pauses a running workflow run. Step runs which are already running finish, but no new step runs are assigned to workers until the workflow run is resumed.
+   *
+   * @tags Workflow Run
+   * @name WorkflowRunUpdatePause
+   * @summary Pause workflow run
+   * @request POST:/api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/pause
+   * @secure
+   */
+  workflowRunUpdatePause = (tenant: string, workflowRun: string, params: RequestParams = {}) =>
+    this.request<WorkflowRun, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflow-runs/${workflowRun}/pause`,
+      method: 'POST',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Replays a finished workflow run from a step in a new workflow run. The outputs of the steps upstream of the step are reused, and the step and all later steps are run again.
+   *
+   * @tags Workflow Run
+   * @name WorkflowRunUpdateReplayFromStep
+   * @summary Replay workflow run from step
+   * @request POST:/api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/replay-from-step
+   * @secure
+   */
+  workflowRunUpdateReplayFromStep = (
+    tenant: string,
+    workflowRun: string,
+    data: ReplayWorkflowRunFromStepRequest,
+    params: RequestParams = {},
+  ) =>
+    this.request<WorkflowRun, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflow-runs/${workflowRun}/replay-from-step`,
+      method: 'POST',
+      body: data,
+      secure: true,
+      type: ContentType.Json,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Resumes a paused workflow run.
+   *
+   * @tags Workflow Run
+   * @name WorkflowRunUpdateResume
+   * @summary Resume workflow run
+   * @request POST:/api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/resume
+   * @secure
+   */
+  workflowRunUpdateResume = (tenant: string, workflowRun: string, params: RequestParams = {}) =>
+    this.request<WorkflowRun, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflow-runs/${workflowRun}/resume`,
+      method: 'POST',
+      secure: true,
+      format: 'json',
+      ...params,
+    });
+  /**
+   * @description Streams the events of a workflow run as server-sent events, which are the status changes of the workflow run and its step runs, and the data which step runs stream. The data of every event is a WorkflowRunStreamEvent, and the stream ends after the event which hangs up. Clients which reconnect with the Last-Event-ID header receive the events which they missed in the meantime, as long as the events are still retained.
+   *
+   * @tags Workflow Run
+   * @name WorkflowRunGetStream
+   * @summary Stream workflow run events
+   * @request GET:/api/v1/tenants/{tenant}/workflow-runs/{workflow-run}/stream
+   * @secure
+   */
+  workflowRunGetStream = (tenant: string, workflowRun: string, params: RequestParams = {}) =>
+    this.request<WorkflowRunStreamEvent, APIErrors>({
+      path: `/api/v1/tenants/${tenant}/workflow-runs/${workflowRun}/stream`,
+      method: 'GET',
+      secure: true,
+      ...params,
+    });
 }
@@ -45,6 +45,11 @@ export interface APIMetaAuth {
    * @example ["basic","google"]
    */
   schemes?: string[];
+  /**
+   * the name of the single sign-on provider, if the oidc scheme is supported
+   * @example "Okta"
+   */
+  oidcProviderName?: string;
 }
 
 export interface APIMetaPosthog {
@@ -72,6 +77,29 @@ export interface APIMetaIntegration {
   enabled: boolean;
 }
 
+export interface HealthReport {
+  /** whether all the probes are healthy */
+  healthy: boolean;
+  probes: HealthProbe[];
+}
+
+export interface HealthProbe {
+  /**
+   * the name of the dependency
+   * @example "database"
+   */
+  name: string;
+  /** whether the dependency is healthy */
+  healthy: boolean;
+  /** the error of the probe, if the dependency is unhealthy */
+  error?: string;
+  /**
+   * the duration of the probe in milliseconds
+   * @format int64
+   */
+  durationMs: number;
+}
+
 export interface APIErrors {
   errors: APIError[];
 }
@@ -358,12 +386,64 @@ export interface TenantQueueMetrics {
   total?: QueueMetrics;
   workflow?: Record<string, QueueMetrics>;
   queues?: Record<string, number>;
+  /** The metrics history of the queues over the requested window, keyed by queue. Only set when a window is requested. */
+  history?: Record<string, QueueMetricsHistory>;
+}
+
+export interface QueueMetricsHistory {
+  /** The average number of queued items over the window. */
+  avgDepth: number;
+  /** The maximum number of queued items over the window. */
+  maxDepth: number;
+  /** The average time in milliseconds which the oldest queued item had waited over the window. */
+  avgLatencyMs: number;
+  /**
+   * The maximum time in milliseconds which the oldest queued item had waited over the window.
+   * @format int64
+   */
+  maxLatencyMs: number;
+  /** The average ratio of used to total worker slots of the queue over the window, between 0 and 1. */
+  avgSlotUtilization: number;
+  /** The snapshots of the queue in the window, ordered by the time they were taken. */
+  snapshots: QueueMetricsSnapshot[];
+}
+
+export interface QueueMetricsSnapshot {
+  /**
+   * The time the snapshot was taken.
+   * @format date-time
+   */
+  createdAt: string;
+  /** The number of queued items. */
+  depth: number;
+  /**
+   * The time in milliseconds which the oldest queued item had waited.
+   * @format int64
+   */
+  latencyMs: number;
+  /** The number of slots of the active workers which can run the items of the queue. */
+  slots: number;
+  /** The number of used slots of the active workers which can run the items of the queue. */
+  usedSlots: number;
 }
 
 export interface TenantStepRunQueueMetrics {
   queues?: Record<string, number>;
 }
 
+export interface Queue {
+  /** The name of the queue. */
+  name: string;
+  /** Whether the queue is paused. */
+  isPaused: boolean;
+  /**
+   * The last time the queue was active.
+   * @format date-time
+   * @example "2022-12-13T20:06:48.888Z"
+   */
+  lastActive?: string;
+}
+
 export interface AcceptInviteRequest {
   /**
    * @minLength 36
@@ -437,6 +517,11 @@ export interface CreateEventRequest {
   data: object;
   /** Additional metadata for the event. */
   additionalMetadata?: object;
+  /**
+   * The time after which the event triggers workflows. The event is stored right away, but it is only delivered once this time has passed.
+   * @format date-time
+   */
+  deliverAfter?: string;
 }
 
 export interface BulkCreateEventRequest {
@@ -449,6 +534,32 @@ export interface BulkCreateEventResponse {
   events: Event[];
 }
 
+export interface BatchCreateEventRequest {
+  /**
+   * The events to create, at most 1000 events can be created in a single request.
+   * @minItems 1
+   * @maxItems 1000
+   */
+  events: CreateEventRequest[];
+}
+
+export interface BatchCreateEventResult {
+  /** The index of the event in the request. */
+  index: number;
+  event?: Event;
+  /** The reason the event wasn't created, if it failed. */
+  error?: string;
+}
+
+export interface BatchCreateEventResponse {
+  /** The results of the events, in the order of the request. */
+  results: BatchCreateEventResult[];
+  /** The number of events which were created. */
+  succeeded: number;
+  /** The number of events which failed. */
+  failed: number;
+}
+
 export interface EventWorkflowRunSummary {
   /**
    * The number of pending runs.
@@ -539,10 +650,451 @@ export enum RateLimitOrderByDirection {
   Desc = 'desc',
 }
 
+export interface DeadLetterQueueItem {
+  metadata: APIResourceMeta;
+  /** The ID of the tenant associated with this item. */
+  tenantId: string;
+  /** The ID of the step run which exhausted its retries. */
+  stepRunId: string;
+  /** The ID of the step of the step run. */
+  stepId: string;
+  /** The ID of the workflow run of the step run. */
+  workflowRunId: string;
+  /** The number of retries which were attempted before the step run was added to the queue. */
+  retryCount: number;
+  /** The input of the step run. */
+  input?: string;
+  /** The error of the final attempt. */
+  error: string;
+  /** The errors of the previous attempts, oldest first. */
+  errorChain: string[];
+}
+
+export interface DeadLetterQueueItemList {
+  pagination?: PaginationResponse;
+  rows?: DeadLetterQueueItem[];
+}
+
+export interface ReplayDeadLetterQueueItemsRequest {
+  /** @maxLength 500 */
+  ids: string[];
+}
+
+export interface ReplayDeadLetterQueueItemsResponse {
+  /** The ids of the items which were replayed and removed from the queue. */
+  replayed: string[];
+  /** The items which could not be replayed. */
+  failed: DeadLetterQueueItemReplayFailure[];
+}
+
+export interface DeadLetterQueueItemReplayFailure {
+  /** The id of the item. */
+  id: string;
+  /** The reason the item could not be replayed. */
+  reason: string;
+}
+
+export interface PurgeDeadLetterQueueItemsRequest {
+  /**
+   * The ids of the items to purge. If not set, all items in the queue are purged.
+   * @maxLength 500
+   */
+  ids?: string[];
+}
+
+export interface PurgeDeadLetterQueueItemsResponse {
+  /** The number of purged items. */
+  purged: number;
+}
+
+export enum StepRunApprovalStatus {
+  PENDING = 'PENDING',
+  APPROVED = 'APPROVED',
+  REJECTED = 'REJECTED',
+  TIMED_OUT = 'TIMED_OUT',
+}
+
+export interface StepRunApproval {
+  metadata: APIResourceMeta;
+  /** The ID of the tenant associated with this approval. */
+  tenantId: string;
+  /** The ID of the approval step run. */
+  stepRunId: string;
+  /** The ID of the workflow run of the step run. */
+  workflowRunId: string;
+  /** The token which allows the approval to be decided through the approval webhook, without an API token. */
+  token: string;
+  status: StepRunApprovalStatus;
+  /** The payload of the approver. */
+  payload?: Record<string, any>;
+  /**
+   * The time the approval was decided or timed out.
+   * @format date-time
+   */
+  decidedAt?: string;
+}
+
+export interface StepRunApprovalList {
+  pagination?: PaginationResponse;
+  rows?: StepRunApproval[];
+}
+
+export interface DecideStepRunApprovalRequest {
+  /** Whether the step run is approved. Rejected step runs fail. */
+  approved: boolean;
+  /** The payload of the approver, which becomes the output of the step run when approved. */
+  payload?: Record<string, any>;
+}
+
+export interface CronExclusionCalendar {
+  metadata: APIResourceMeta;
+  /** The ID of the tenant associated with this calendar. */
+  tenantId: string;
+  /** The name of the calendar, which is unique within the tenant. */
+  name: string;
+  /** The dates on which crons which reference the calendar don't trigger. */
+  dates: string[];
+  /** An iCal feed whose events are excluded, in addition to the dates. */
+  icalUrl?: string;
+}
+
+export interface CronExclusionCalendarList {
+  rows?: CronExclusionCalendar[];
+}
+
+export interface CreateCronExclusionCalendarRequest {
+  /** The name of the calendar, which is unique within the tenant. */
+  name: string;
+  /** The dates on which crons which reference the calendar don't trigger. */
+  dates?: string[];
+  /** An iCal feed whose events are excluded, in addition to the dates. */
+  icalUrl?: string;
+}
+
+export enum InboundWebhookSignatureScheme {
+  HMAC_SHA256 = 'HMAC_SHA256',
+  GITHUB = 'GITHUB',
+  STRIPE = 'STRIPE',
+  SVIX = 'SVIX',
+}
+
+export interface InboundWebhook {
+  metadata: APIResourceMeta;
+  /** The ID of the tenant associated with this webhook. */
+  tenantId: string;
+  /** The name of the webhook, which is unique within the tenant. */
+  name: string;
+  /** The URL which the provider sends requests to. */
+  url: string;
+  signatureScheme: InboundWebhookSignatureScheme;
+  /** The header which contains the signature, for the HMAC_SHA256 scheme. */
+  signatureHeader?: string;
+  /** The key of the events which requests are ingested as. */
+  eventKey?: string;
+  /** A CEL expression which evaluates to the key of the events which requests are ingested as. */
+  eventKeyExpression?: string;
+  /** A CEL expression which evaluates to the data of the events. The body of the request is used if it's not set. */
+  dataExpression?: string;
+  /** The signing secret, which is only returned when the webhook is created. */
+  secret?: string;
+}
+
+export interface InboundWebhookList {
+  rows?: InboundWebhook[];
+}
+
+export interface CreateInboundWebhookRequest {
+  /** The name of the webhook, which is unique within the tenant. */
+  name: string;
+  signatureScheme: InboundWebhookSignatureScheme;
+  /** The header which contains the signature, required for the HMAC_SHA256 scheme. */
+  signatureHeader?: string;
+  /** The signing secret. It's required for the STRIPE and SVIX schemes, a random secret is generated for the other schemes if it's not set. */
+  secret?: string;
+  /** The key of the events which requests are ingested as. Exactly one of the event key and the event key expression must be set. */
+  eventKey?: string;
+  /** A CEL expression which evaluates to the key of the events which requests are ingested as, like `"github:" + headers["x-github-event"]`. */
+  eventKeyExpression?: string;
+  /** A CEL expression which evaluates to the data of the events. The body of the request is used if it's not set. */
+  dataExpression?: string;
+}
+
+export enum EventSinkKind {
+  KAFKA = 'KAFKA',
+  WEBHOOK = 'WEBHOOK',
+  NATS = 'NATS',
+}
+
+/** The format of the records which are delivered to a sink. CLOUDEVENTS records are wrapped in CloudEvents 1.0 in the structured JSON format. */
+export enum EventSinkFormat {
+  HATCHET = 'HATCHET',
+  CLOUDEVENTS = 'CLOUDEVENTS',
+}
+
+export enum EventSinkRecordType {
+  EventCreated = 'event.created',
+  WorkflowRunQueued = 'workflow_run.queued',
+  WorkflowRunSucceeded = 'workflow_run.succeeded',
+  WorkflowRunFailed = 'workflow_run.failed',
+  WorkflowRunCancelled = 'workflow_run.cancelled',
+  StepRunStarted = 'step_run.started',
+  StepRunCompleted = 'step_run.completed',
+  StepRunFailed = 'step_run.failed',
+  StepRunCancelled = 'step_run.cancelled',
+  StepRunTimedOut = 'step_run.timed_out',
+  AuditLogCreated = 'audit_log.created',
+}
+
+export interface EventSink {
+  metadata: APIResourceMeta;
+  /** The ID of the tenant associated with this sink. */
+  tenantId: string;
+  /** The name of the sink, which is unique within the tenant. */
+  name: string;
+  kind: EventSinkKind;
+  /** The format of the records which are delivered to a sink. CLOUDEVENTS records are wrapped in CloudEvents 1.0 in the structured JSON format. */
+  format: EventSinkFormat;
+  /** The URL of the webhook or the NATS server. */
+  url?: string;
+  /** The brokers of the Kafka cluster. */
+  brokers?: string[];
+  /** The Kafka topic, or the prefix of the NATS subjects, which records are published to. */
+  topic?: string;
+  /** Whether the connections to the Kafka brokers use TLS. */
+  tls: boolean;
+  /** The name of the tenant secret which stores the username of the Kafka or NATS connection. */
+  usernameSecret?: string;
+  /** The name of the tenant secret which stores the password of the Kafka or NATS connection. */
+  passwordSecret?: string;
+  /** The types of the records which are delivered to the sink. All records except audit log records are delivered if it's empty. */
+  eventTypes: EventSinkRecordType[];
+  /** The number of attempts after which a delivery is dead-lettered. */
+  maxAttempts: number;
+  /** The number of records which are waiting to be delivered. */
+  pendingCount: number;
+  /** The number of records which were dead-lettered after the maximum number of attempts. */
+  deadLetteredCount: number;
+  /** The secret which webhook requests are signed with, which is only returned when the sink is created. */
+  secret?: string;
+}
+
+export interface EventSinkList {
+  rows?: EventSink[];
+}
+
+export interface CreateEventSinkRequest {
+  /** The name of the sink, which is unique within the tenant. */
+  name: string;
+  kind: EventSinkKind;
+  /** The format of the records which are delivered to a sink. CLOUDEVENTS records are wrapped in CloudEvents 1.0 in the structured JSON format. */
+  format?: EventSinkFormat;
+  /** The URL of the webhook, or of the NATS server like nats://nats.example.com:4222. It's required for the WEBHOOK and NATS sinks. */
+  url?: string;
+  /** The brokers of the Kafka cluster, like kafka.example.com:9092. They're required for KAFKA sinks. */
+  brokers?: string[];
+  /** The Kafka topic, or the prefix of the NATS subjects, which records are published to. It's required for the KAFKA and NATS sinks. */
+  topic?: string;
+  /** Whether the connections to the Kafka brokers use TLS. */
+  tls?: boolean;
+  /** The name of the tenant secret which stores the username of the Kafka or NATS connection. */
+  usernameSecret?: string;
+  /** The name of the tenant secret which stores the password of the Kafka or NATS connection, or the NATS token if no username is set. */
+  passwordSecret?: string;
+  /** The types of the records which are delivered to the sink. All records except audit log records are delivered if it's not set. */
+  eventTypes?: EventSinkRecordType[];
+  /** The number of attempts after which a delivery is dead-lettered, defaults to 10. */
+  maxAttempts?: number;
+}
+
+export interface EventDedupRule {
+  metadata: APIResourceMeta;
+  /** The ID of the tenant associated with this rule. */
+  tenantId: string;
+  /** The key of the events which are deduplicated. */
+  eventKey: string;
+  /** The CEL expression which evaluates to the dedup key of an event, with the data of the event as `input` and its additional metadata as `additional_metadata`. */
+  expression: string;
+  /** The number of seconds after the first event with a dedup key in which events with the same dedup key are duplicates. */
+  windowSeconds: number;
+}
+
+export interface EventDedupRuleList {
+  rows?: EventDedupRule[];
+}
+
+export interface UpsertEventDedupRuleRequest {
+  /** The key of the events which are deduplicated. */
+  eventKey: string;
+  /** The CEL expression which evaluates to the dedup key of an event, with the data of the event as `input` and its additional metadata as `additional_metadata`. */
+  expression: string;
+  /**
+   * The number of seconds after the first event with a dedup key in which events with the same dedup key are duplicates.
+   * @min 1
+   * @max 604800
+   */
+  windowSeconds: number;
+}
+
+export interface EventRoutingRule {
+  metadata: APIResourceMeta;
+  /** The ID of the tenant associated with this rule. */
+  tenantId: string;
+  /** The name of the rule, which is unique within the tenant. */
+  name: string;
+  /** The key of the events the rule applies to. The rule applies to events with any key if not set. */
+  eventKey?: string;
+  /** The CEL predicate which events must match, with the data of the event as `input`, its additional metadata as `additional_metadata` and its key as `event_key`. */
+  expression: string;
+  /**
+   * The ID of the workflow which is triggered by the events which match the predicate.
+   * @format uuid
+   */
+  workflowId: string;
+  /** The name of the workflow which is triggered by the events which match the predicate. */
+  workflowName?: string;
+}
+
+export interface EventRoutingRuleList {
+  rows?: EventRoutingRule[];
+}
+
+export interface UpsertEventRoutingRuleRequest {
+  /** The name of the rule, which is unique within the tenant. */
+  name: string;
+  /** The key of the events the rule applies to. The rule applies to events with any key if not set. */
+  eventKey?: string;
+  /** The CEL predicate which events must match, with the data of the event as `input`, its additional metadata as `additional_metadata` and its key as `event_key`. */
+  expression: string;
+  /**
+   * The ID of the workflow which is triggered by the events which match the predicate.
+   * @format uuid
+   */
+  workflowId: string;
+}
+
+export enum AuditLogActorKind {
+  USER = 'USER',
+  API_TOKEN = 'API_TOKEN',
+}
+
+export interface AuditLog {
+  metadata: APIResourceMeta;
+  actorKind: AuditLogActorKind;
+  /**
+   * The id of the user or API token which called the API.
+   * @format uuid
+   */
+  actorId: string;
+  /** The email of the user or the name of the API token when the API was called. */
+  actorName?: string;
+  /** The operation id of the API call. */
+  operation: string;
+  /** The HTTP method of the request. */
+  method: string;
+  /** The path of the request. */
+  path: string;
+  /** The type of the resource of the request path which the call operated on, like workflow or step-run. */
+  resourceType?: string;
+  /** The id of the resource of the request path which the call operated on. */
+  resourceId?: string;
+  /** The status code of the response. */
+  statusCode: number;
+  /** The IP address of the client. */
+  ipAddress?: string;
+  /** The user agent of the client. */
+  userAgent?: string;
+}
+
+export interface AuditLogList {
+  pagination?: PaginationResponse;
+  rows?: AuditLog[];
+}
+
+export enum TenantQuotaResource {
+  QUEUED_RUNS = 'QUEUED_RUNS',
+  WORKERS = 'WORKERS',
+  EVENTS_PER_MINUTE = 'EVENTS_PER_MINUTE',
+  WORKFLOW_VERSIONS = 'WORKFLOW_VERSIONS',
+}
+
+export interface TenantQuotaUsage {
+  resource: TenantQuotaResource;
+  /** The quota which applies to the tenant. Not set if the resource is unlimited. */
+  limit?: number;
+  /** The current usage of the resource. */
+  usage: number;
+}
+
+export interface TenantQuotaUsageList {
+  rows?: TenantQuotaUsage[];
+}
+
 export interface ReplayEventRequest {
   eventIds: string[];
 }
 
+export interface ReplayEventRangeRequest {
+  /**
+   * The start of the time window, inclusive.
+   * @format date-time
+   */
+  since: string;
+  /**
+   * The end of the time window, exclusive. Defaults to the current time.
+   * @format date-time
+   */
+  until?: string;
+  /** The keys of the events to replay. All keys are replayed if empty. */
+  keys?: string[];
+  /** The additional metadata which the events must contain. */
+  additionalMetadata?: object;
+  /**
+   * The maximum number of events to replay. Defaults to 100.
+   * @min 1
+   * @max 1000
+   */
+  limit?: number;
+  /** Whether to only report which workflows would be triggered, without replaying the events. */
+  dryRun?: boolean;
+}
+
+export interface ReplayEventRangeWorkflow {
+  /**
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowId: string;
+  workflowName: string;
+  /**
+   * The current version of the workflow, which the events are replayed against.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowVersionId: string;
+  /** The number of events which trigger the workflow. */
+  eventCount: number;
+  /** The number of events which match the workflow but whose data isn't valid input for it. */
+  skippedCount: number;
+}
+
+export interface ReplayEventRangeResponse {
+  dryRun: boolean;
+  /** The number of events in the range. */
+  eventCount: number;
+  /** Whether there are more events in the time window than the limit. */
+  hasMore: boolean;
+  /**
+   * The since of the next page of events, if there are more events.
+   * @format date-time
+   */
+  nextSince?: string;
+  workflows: ReplayEventRangeWorkflow[];
+  /** The ids of the replayed events. Empty in a dry run. */
+  eventIds: string[];
+}
+
 export interface CancelEventRequest {
   eventIds: string[];
 }
@@ -574,7 +1126,7 @@ export interface WorkflowConcurrency {
    */
   maxRuns: number;
   /** The strategy to use when the concurrency limit is reached. */
-  limitStrategy: 'CANCEL_IN_PROGRESS' | 'DROP_NEWEST' | 'QUEUE_NEWEST' | 'GROUP_ROUND_ROBIN';
+  limitStrategy: 'CANCEL_IN_PROGRESS' | 'DROP_NEWEST' | 'QUEUE_NEWEST' | 'GROUP_ROUND_ROBIN' | 'QUEUE_NEWEST_ONLY';
   /** An action which gets the concurrency group for the WorkflowRun. */
   getConcurrencyGroup: string;
 }
@@ -608,6 +1160,13 @@ export interface WorkflowVersion {
   triggers?: WorkflowTriggers;
   scheduleTimeout?: string;
   jobs?: Job[];
+  /** The JSON schema of the input of the workflow runs, which can be used to generate a form for triggering the workflow. */
+  inputSchema?: Record<string, any>;
+  /**
+   * The number of slots which the workflow runs reserve up front, so that a DAG isn't starved halfway through.
+   * @format int32
+   */
+  reservedSlots?: number;
 }
 
 export interface WorkflowVersionDefinition {
@@ -709,6 +1268,26 @@ export interface WorkflowRun {
    */
   parentStepRunId?: string;
   additionalMetadata?: Record<string, any>;
+  /** The output of the workflow run as JSON, if the workflow declares an output expression. */
+  output?: string;
+  /**
+   * The id of the workflow run which this workflow run replays from a step.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   * @example "bb214807-246e-43a5-a25d-41761d1cff9e"
+   */
+  replayedFromId?: string;
+  /**
+   * The id of the step which this workflow run is replayed from.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   * @example "bb214807-246e-43a5-a25d-41761d1cff9e"
+   */
+  replayedFromStepId?: string;
+  /** Whether the job runs, step runs and events of the workflow run were moved to the archive. The details of archived runs are read from the blob storage, which is slower. */
+  archived?: boolean;
 }
 
 export interface WorkflowRunShape {
@@ -744,6 +1323,8 @@ export interface WorkflowRunShape {
    */
   parentStepRunId?: string;
   additionalMetadata?: Record<string, any>;
+  /** Whether the job runs, step runs and events of the workflow run were moved to the archive. The details of archived runs are read from the blob storage, which is slower. */
+  archived?: boolean;
 }
 
 export interface ReplayWorkflowRunsRequest {
@@ -755,9 +1336,24 @@ export interface ReplayWorkflowRunsResponse {
   workflowRuns: WorkflowRun[];
 }
 
+export interface ReplayWorkflowRunFromStepRequest {
+  /**
+   * The id of the step to replay the workflow run from. The outputs of the steps upstream of it are reused.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   * @example "bb214807-246e-43a5-a25d-41761d1cff9e"
+   */
+  stepId: string;
+  /** The input of the step, which overrides the input built from the outputs of the upstream steps. */
+  input?: object;
+}
+
 export interface WorkflowRunList {
   rows?: WorkflowRun[];
   pagination?: PaginationResponse;
+  /** The cursor to pass to get the next page of runs. Only set when there may be more runs. Pages which were requested with a cursor have no pagination, since the runs aren't counted. */
+  nextCursor?: string;
 }
 
 export interface ScheduledWorkflows {
@@ -816,6 +1412,12 @@ export interface CronWorkflows {
   additionalMetadata?: Record<string, any>;
   enabled: boolean;
   method: 'DEFAULT' | 'API';
+  /** The IANA timezone the cron expression is evaluated in */
+  timezone: string;
+  /** The maximum number of seconds each trigger is randomly delayed by */
+  jitterSeconds: number;
+  /** The ID of the exclusion calendar whose dates the cron skips */
+  exclusionCalendarId?: string;
 }
 
 export interface CronWorkflowsList {
@@ -859,6 +1461,7 @@ export enum WorkflowRunStatus {
   FAILED = 'FAILED',
   CANCELLED = 'CANCELLED',
   QUEUED = 'QUEUED',
+  PAUSED = 'PAUSED',
 }
 
 export type WorkflowRunStatusList = WorkflowRunStatus[];
@@ -892,6 +1495,7 @@ export enum StepRunStatus {
   FAILED = 'FAILED',
   CANCELLED = 'CANCELLED',
   CANCELLING = 'CANCELLING',
+  SKIPPED = 'SKIPPED',
 }
 
 export interface JobRun {
@@ -957,6 +1561,28 @@ export interface StepRun {
   cancelledAtEpoch?: number;
   cancelledReason?: string;
   cancelledError?: string;
+  /**
+   * The time of the next retry, if the step run is waiting on a retry backoff.
+   * @format date-time
+   */
+  nextRetryAt?: string;
+  /** The latest progress which a long-running step run reported. */
+  progress?: StepRunProgress;
+}
+
+/** The latest progress which a long-running step run reported. */
+export interface StepRunProgress {
+  /**
+   * The percentage of the step run which is done, between 0 and 100.
+   * @format double
+   */
+  percent: number;
+  /** A message which describes the progress. */
+  message?: string;
+  /** Arbitrary data which the step run reported along with its progress. */
+  data?: object;
+  /** @format date-time */
+  updatedAt: string;
 }
 
 export enum StepRunEventReason {
@@ -977,6 +1603,7 @@ export enum StepRunEventReason {
   RETRIED_BY_USER = 'RETRIED_BY_USER',
   WORKFLOW_RUN_GROUP_KEY_SUCCEEDED = 'WORKFLOW_RUN_GROUP_KEY_SUCCEEDED',
   WORKFLOW_RUN_GROUP_KEY_FAILED = 'WORKFLOW_RUN_GROUP_KEY_FAILED',
+  SPECULATIVE_ATTEMPT = 'SPECULATIVE_ATTEMPT',
 }
 
 export enum StepRunEventSeverity {
@@ -1035,6 +1662,29 @@ export interface StepRunArchiveList {
   rows?: StepRunArchive[];
 }
 
+export enum SchedulingDecisionOutcome {
+  ASSIGNED = 'ASSIGNED',
+  NO_SLOTS = 'NO_SLOTS',
+  RATE_LIMITED = 'RATE_LIMITED',
+}
+
+export interface SchedulingDecision {
+  id: number;
+  /** @format date-time */
+  createdAt: string;
+  stepRunId: string;
+  queue: string;
+  outcome: SchedulingDecisionOutcome;
+  workerId?: string;
+  message: string;
+  data?: object;
+}
+
+export interface SchedulingDecisionList {
+  pagination?: PaginationResponse;
+  rows?: SchedulingDecision[];
+}
+
 export interface WorkerRuntimeInfo {
   sdkVersion?: string;
   language?: WorkerRuntimeSDKs;
@@ -1054,6 +1704,21 @@ export interface WorkerList {
   rows?: Worker[];
 }
 
+export interface WorkerPool {
+  /** The name of the worker pool. */
+  name: string;
+  /** The number of active workers in the pool. */
+  workers: number;
+  /** The total number of slots of the active workers in the pool. */
+  slots: number;
+  /** The number of slots of the active workers in the pool which are running a step. */
+  usedSlots: number;
+}
+
+export interface WorkerPoolList {
+  rows?: WorkerPool[];
+}
+
 export interface SemaphoreSlots {
   /**
    * The step run id.
@@ -1142,6 +1807,28 @@ export interface Worker {
    */
   webhookId?: string;
   runtimeInfo?: WorkerRuntimeInfo;
+  /** The region the worker runs in. */
+  region?: string;
+  /** The worker pool the worker joined. */
+  pool?: string;
+  health?: WorkerHealth;
+}
+
+export interface WorkerHealth {
+  /** The cpu utilization of the worker process, between 0 and 1. */
+  cpuUtilization?: number;
+  /** The memory utilization of the worker process relative to its memory limit, between 0 and 1. */
+  memoryUtilization?: number;
+  /** The number of slots of the worker which are running a step, as reported by the worker. */
+  activeSlots?: number;
+  /** Custom gauges which the worker reports, keyed by name. */
+  gauges?: Record<string, number>;
+  /**
+   * The time of the heartbeat which the health was reported with.
+   * @format date-time
+   * @example "2022-12-13T20:06:48.888Z"
+   */
+  reportedAt: string;
 }
 
 export interface WorkerLabel {
@@ -1169,6 +1856,20 @@ export interface APIToken {
    * @format date-time
    */
   expiresAt: string;
+  /** What the API token can be used for. Admin tokens can call the REST API and the engine, worker tokens can only call the engine and read-only tokens can only read from the REST API. */
+  scope: APITokenScope;
+  /**
+   * When the API token was rotated, the token stays valid until it expires.
+   * @format date-time
+   */
+  rotatedAt?: string;
+}
+
+/** What the API token can be used for. Admin tokens can call the REST API and the engine, worker tokens can only call the engine and read-only tokens can only read from the REST API. */
+export enum APITokenScope {
+  ADMIN = 'ADMIN',
+  WORKER = 'WORKER',
+  READ_ONLY = 'READ_ONLY',
 }
 
 export interface CreateAPITokenRequest {
@@ -1179,6 +1880,8 @@ export interface CreateAPITokenRequest {
   name: string;
   /** The duration for which the token is valid. */
   expiresIn?: string;
+  /** What the API token can be used for. Admin tokens can call the REST API and the engine, worker tokens can only call the engine and read-only tokens can only read from the REST API. */
+  scope?: APITokenScope;
 }
 
 export interface CreateAPITokenResponse {
@@ -1186,11 +1889,46 @@ export interface CreateAPITokenResponse {
   token: string;
 }
 
+export interface RotateAPITokenRequest {
+  /** The duration for which the new token is valid. */
+  expiresIn?: string;
+  /** The duration for which the rotated token stays valid, defaults to 24 hours. */
+  gracePeriod?: string;
+}
+
 export interface ListAPITokensResponse {
   pagination?: PaginationResponse;
   rows?: APIToken[];
 }
 
+export interface ClientCA {
+  metadata: APIResourceMeta;
+  /** The name of the client CA. */
+  name: string;
+  /** The PEM encoded CA certificate which signs the client certificates of the workers. */
+  certificate: string;
+  /** The prefix of the SPIFFE IDs of the client certificates which are verified against this CA, like spiffe://example.org/workers/. */
+  spiffeIdPrefix: string;
+  /**
+   * When the CA certificate expires, client certificates aren't accepted after this time.
+   * @format date-time
+   */
+  expiresAt: string;
+}
+
+export interface ClientCAList {
+  rows?: ClientCA[];
+}
+
+export interface CreateClientCARequest {
+  /** A name for the client CA. */
+  name: string;
+  /** The PEM encoded CA certificate which signs the client certificates of the workers. */
+  certificate: string;
+  /** The prefix of the SPIFFE IDs of the client certificates which are verified against this CA. Prefixes can't overlap with the prefixes of the client CAs of other tenants. */
+  spiffeIdPrefix: string;
+}
+
 export interface RerunStepRunRequest {
   input: object;
 }
@@ -1198,6 +1936,8 @@ export interface RerunStepRunRequest {
 export interface TriggerWorkflowRunRequest {
   input: object;
   additionalMetadata?: object;
+  /** If a workflow run of the workflow was triggered with the same idempotency key within the retention window, the existing workflow run is returned instead of a new one. */
+  idempotencyKey?: string;
 }
 
 export interface ScheduleWorkflowRunRequest {
@@ -1207,11 +1947,32 @@ export interface ScheduleWorkflowRunRequest {
   triggerAt: string;
 }
 
+export interface UpdateScheduledWorkflowRunRequest {
+  /**
+   * The new time to trigger the scheduled workflow run at
+   * @format date-time
+   */
+  triggerAt: string;
+}
+
 export interface CreateCronWorkflowTriggerRequest {
   input: object;
   additionalMetadata: object;
   cronName: string;
   cronExpression: string;
+  /** The IANA timezone the cron expression is evaluated in, defaults to UTC */
+  timezone?: string;
+  /**
+   * The maximum number of seconds each trigger is randomly delayed by
+   * @min 0
+   * @max 3600
+   */
+  jitterSeconds?: number;
+  /**
+   * The ID of an exclusion calendar whose dates the cron skips, for example holidays
+   * @format uuid
+   */
+  exclusionCalendarId?: string;
 }
 
 export interface CreatePullRequestFromStepRun {
@@ -1248,12 +2009,62 @@ export enum PullRequestState {
   Closed = 'closed',
 }
 
+export enum WorkflowRunStreamResourceType {
+  STEP_RUN = 'STEP_RUN',
+  WORKFLOW_RUN = 'WORKFLOW_RUN',
+}
+
+export enum WorkflowRunStreamEventType {
+  STARTED = 'STARTED',
+  COMPLETED = 'COMPLETED',
+  FAILED = 'FAILED',
+  CANCELLED = 'CANCELLED',
+  TIMED_OUT = 'TIMED_OUT',
+  STREAM = 'STREAM',
+  PROGRESS = 'PROGRESS',
+}
+
+/** An event of a workflow run, which is sent as the data of a server-sent event. */
+export interface WorkflowRunStreamEvent {
+  /**
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  workflowRunId: string;
+  resourceType: WorkflowRunStreamResourceType;
+  /** The id of the step run or workflow run which the event belongs to. */
+  resourceId: string;
+  eventType: WorkflowRunStreamEventType;
+  /** The output of a completed step run, the error of a failed step run, the data of a stream event, or the progress of a step run as a JSON-encoded StepRunProgress without its update time. */
+  eventPayload?: string;
+  /** @format date-time */
+  eventTimestamp: string;
+  retryCount?: number;
+  stepRetries?: number;
+  /** Whether this is the last event of the stream. */
+  hangup: boolean;
+}
+
 export interface LogLine {
+  /**
+   * The id of the log line, which increases in the order log lines are stored.
+   * @format int64
+   */
+  id: number;
   /**
    * The creation date of the log line.
    * @format date-time
    */
   createdAt: string;
+  /**
+   * The id of the step run which the log line belongs to.
+   * @format uuid
+   * @minLength 36
+   * @maxLength 36
+   */
+  stepRunId: string;
+  level: LogLineLevel;
   /** The log message. */
   message: string;
   /** The log metadata. */
@@ -1330,6 +2141,47 @@ export interface CreateSNSIntegrationRequest {
   topicArn: string;
 }
 
+export interface SQSIntegration {
+  metadata: APIResourceMeta;
+  /**
+   * The unique identifier for the tenant that the SQS integration belongs to.
+   * @format uuid
+   */
+  tenantId: string;
+  /** The URL of the SQS queue. */
+  queueUrl: string;
+  /** The AWS region of the SQS queue. */
+  region: string;
+  /** The key of the events which messages are ingested as. */
+  eventKey: string;
+  /** The name of the tenant secret which stores the AWS access key ID. */
+  accessKeyIdSecret: string;
+  /** The name of the tenant secret which stores the AWS secret access key. */
+  secretAccessKeySecret: string;
+  /** The name of the tenant secret which stores an AWS session token. */
+  sessionTokenSecret?: string;
+}
+
+export interface CreateSQSIntegrationRequest {
+  /** The URL of the SQS queue. */
+  queueUrl: string;
+  /** The AWS region of the SQS queue. */
+  region: string;
+  /** The key of the events which messages are ingested as. */
+  eventKey: string;
+  /** The name of the tenant secret which stores the AWS access key ID. */
+  accessKeyIdSecret: string;
+  /** The name of the tenant secret which stores the AWS secret access key. */
+  secretAccessKeySecret: string;
+  /** The name of the tenant secret which stores an AWS session token, only required for temporary credentials. */
+  sessionTokenSecret?: string;
+}
+
+export interface ListSQSIntegrations {
+  pagination: PaginationResponse;
+  rows: SQSIntegration[];
+}
+
 export interface WorkflowMetrics {
   /** The number of runs for a specific group key (passed via filter) */
   groupKeyRunsCount?: number;
@@ -1337,6 +2189,49 @@ export interface WorkflowMetrics {
   groupKeyCount?: number;
 }
 
+export interface WorkflowConcurrencyGroup {
+  /** The concurrency group key. */
+  groupKey: string;
+  /** The number of running workflow runs in the group. */
+  runningCount: number;
+  /** The number of queued workflow runs in the group. */
+  queuedCount: number;
+}
+
+export interface WorkflowConcurrencyGroupList {
+  rows?: WorkflowConcurrencyGroup[];
+}
+
+export interface WorkflowRollout {
+  metadata: APIResourceMeta;
+  /**
+   * The ID of the workflow the rollout belongs to.
+   * @format uuid
+   */
+  workflowId: string;
+  /**
+   * The ID of the version which new runs are triggered on, unless they're part of the canary.
+   * @format uuid
+   */
+  stableVersionId: string;
+  /** The percentage of new runs which are triggered on the latest version of the workflow. */
+  canaryPercentage: number;
+}
+
+export interface UpdateWorkflowRolloutRequest {
+  /**
+   * The ID of the version which new runs are triggered on, unless they're part of the canary.
+   * @format uuid
+   */
+  stableVersionId: string;
+  /**
+   * The percentage of new runs which are triggered on the latest version of the workflow. Set to 0 to pin new runs to the stable version.
+   * @min 0
+   * @max 100
+   */
+  canaryPercentage: number;
+}
+
 export interface WebhookWorker {
   metadata: APIResourceMeta;
   /** The name of the webhook worker. */
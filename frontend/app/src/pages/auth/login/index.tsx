@@ -8,6 +8,7 @@ import { useApiError } from '@/lib/hooks';
 import useApiMeta from '../hooks/use-api-meta';
 import { Loading } from '@/components/ui/loading';
 import { Icons } from '@/components/ui/icons';
+import { LockClosedIcon } from '@radix-ui/react-icons';
 import useErrorParam from '../hooks/use-error-param';
 import React from 'react';
 
@@ -23,13 +24,15 @@ export default function Login() {
   const basicEnabled = schemes.includes('basic');
   const googleEnabled = schemes.includes('google');
   const githubEnabled = schemes.includes('github');
+  const oidcEnabled = schemes.includes('oidc');
+  const providerEnabled = googleEnabled || githubEnabled || oidcEnabled;
 
   let prompt = 'Enter your email and password below.';
 
-  if (basicEnabled && (googleEnabled || githubEnabled)) {
+  if (basicEnabled && providerEnabled) {
     prompt =
       'Enter your email and password below, or continue with a supported provider.';
-  } else if (googleEnabled || githubEnabled) {
+  } else if (providerEnabled) {
     prompt = 'Continue with a supported provider.';
   } else if (basicEnabled) {
     prompt = 'Enter your email and password below.';
@@ -41,6 +44,7 @@ export default function Login() {
     basicEnabled && <BasicLogin />,
     googleEnabled && <GoogleLogin />,
     githubEnabled && <GithubLogin />,
+    oidcEnabled && <OidcLogin name={meta.data?.auth?.oidcProviderName} />,
   ].filter(Boolean);
 
   return (
@@ -159,3 +163,14 @@ export function GithubLogin() {
     </a>
   );
 }
+
+export function OidcLogin({ name }: { name?: string }) {
+  return (
+    <a href="/api/v1/users/oidc/start" className="w-full">
+      <Button variant="outline" type="button" className="w-full py-2">
+        <LockClosedIcon className="mr-2 h-4 w-4" />
+        {name || 'SSO'}
+      </Button>
+    </a>
+  );
+}
@@ -19,6 +19,14 @@ export const columns = ({
       enableSorting: false,
       enableHiding: false,
     },
+    {
+      accessorKey: 'scope',
+      header: ({ column }) => (
+        <DataTableColumnHeader column={column} title="Scope" />
+      ),
+      cell: ({ row }) => <div>{row.original.scope}</div>,
+      enableSorting: false,
+    },
     {
       accessorKey: 'created',
       header: ({ column }) => (
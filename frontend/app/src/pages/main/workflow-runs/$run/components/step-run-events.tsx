@@ -209,6 +209,7 @@ const REASON_TO_TITLE: Record<StepRunEventReason, string> = {
   [StepRunEventReason.WORKFLOW_RUN_GROUP_KEY_SUCCEEDED]:
     'Successfully got group key',
   [StepRunEventReason.WORKFLOW_RUN_GROUP_KEY_FAILED]: 'Failed to get group key',
+  [StepRunEventReason.SPECULATIVE_ATTEMPT]: 'Speculative attempt',
 };
 
 function getTitleFromReason(reason: StepRunEventReason, message: string) {
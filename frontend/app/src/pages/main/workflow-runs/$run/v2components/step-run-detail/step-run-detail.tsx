@@ -43,6 +43,7 @@ export const STEP_RUN_TERMINAL_STATUSES = [
   StepRunStatus.CANCELLED,
   StepRunStatus.FAILED,
   StepRunStatus.SUCCEEDED,
+  StepRunStatus.SKIPPED,
 ];
 
 const StepRunDetail: React.FC<StepRunDetailProps> = ({
@@ -1,31 +1,42 @@
 #!/bin/bash
 
-rabbitmq-server &
+# Without a DATABASE_URL, Hatchet Lite runs an embedded Postgres which stores its data in LITE_EMBEDDED_POSTGRES_DIR.
+# Postgres refuses to run as root, so Hatchet Lite runs as the hatchet user.
+RUN_AS=""
 
-# Wait up to 60 seconds for RabbitMQ to be ready
-echo "Waiting for RabbitMQ to be ready..."
+if [ -z "$DATABASE_URL" ] && [ -n "$LITE_EMBEDDED_POSTGRES_DIR" ] && [ "$(id -u)" = "0" ]; then
+  mkdir -p "$LITE_EMBEDDED_POSTGRES_DIR"
+  chown -R hatchet:hatchet "$LITE_EMBEDDED_POSTGRES_DIR"
 
-timeout 60s bash -c '
-until rabbitmqctl status; do
-  sleep 2
-  echo "Waiting for RabbitMQ to start..."
-done
-'
-
-if [ $? -eq 124 ]; then
-  echo "Timed out waiting for the database to be ready"
-  exit 1
+  RUN_AS="su-exec hatchet"
 fi
 
-# Run migration script
-./atlas-apply.sh
-if [ $? -ne 0 ]; then
-  echo "Migration script failed. Exiting..."
-  exit 1
+# The postgres message queue stores the messages in the database, so RabbitMQ isn't needed.
+if [ "$SERVER_MSGQUEUE_KIND" != "postgres" ] && [ "$SERVER_TASKQUEUE_KIND" != "postgres" ]; then
+  rabbitmq-server &
+
+  # Wait up to 60 seconds for RabbitMQ to be ready
+  echo "Waiting for RabbitMQ to be ready..."
+
+  timeout 60s bash -c '
+  until rabbitmqctl status; do
+    sleep 2
+    echo "Waiting for RabbitMQ to start..."
+  done
+  '
+
+  if [ $? -eq 124 ]; then
+    echo "Timed out waiting for RabbitMQ to be ready"
+    exit 1
+  fi
 fi
 
-# Generate config files
-./hatchet-admin quickstart --skip certs --generated-config-dir ./config --overwrite=false
+# Generate config files. Hatchet Lite applies the migrations and seeds the database when it starts.
+./hatchet-admin quickstart --skip certs --skip seed --generated-config-dir ./config --overwrite=false
+
+if [ -n "$RUN_AS" ]; then
+  chown -R hatchet:hatchet ./config
+fi
 
-# Run the Go binary
-./hatchet-lite --config ./config
+# Run the Go binary, which stops the embedded database when it exits
+exec $RUN_AS ./hatchet-lite --config ./config
@@ -2,7 +2,10 @@ package cel
 
 import (
 	"crypto/sha256"
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"strconv"
 
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/checker/decls"
@@ -12,11 +15,16 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 
 	expr "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type CELParser struct {
-	workflowStrEnv *cel.Env
-	stepRunEnv     *cel.Env
+	workflowStrEnv       *cel.Env
+	stepRunEnv           *cel.Env
+	workflowRunOutputEnv *cel.Env
+	kafkaMessageEnv      *cel.Env
+	inboundWebhookEnv    *cel.Env
+	eventRoutingEnv      *cel.Env
 }
 
 var checksumDecl = decls.NewFunction("checksum",
@@ -66,9 +74,57 @@ func NewCELParser() *CELParser {
 		checksum,
 	)
 
+	workflowRunOutputEnv, _ := cel.NewEnv(
+		cel.Declarations(
+			decls.NewVar("input", decls.NewMapType(decls.String, decls.Dyn)),
+			decls.NewVar("additional_metadata", decls.NewMapType(decls.String, decls.Dyn)),
+			decls.NewVar("steps", decls.NewMapType(decls.String, decls.NewMapType(decls.String, decls.Dyn))),
+			decls.NewVar("workflow_run_id", decls.String),
+			checksumDecl,
+		),
+		checksum,
+	)
+
+	kafkaMessageEnv, _ := cel.NewEnv(
+		cel.Declarations(
+			decls.NewVar("payload", decls.Dyn),
+			decls.NewVar("key", decls.String),
+			decls.NewVar("headers", decls.NewMapType(decls.String, decls.String)),
+			decls.NewVar("topic", decls.String),
+			decls.NewVar("partition", decls.Int),
+			decls.NewVar("offset", decls.Int),
+			checksumDecl,
+		),
+		checksum,
+	)
+
+	inboundWebhookEnv, _ := cel.NewEnv(
+		cel.Declarations(
+			decls.NewVar("body", decls.Dyn),
+			decls.NewVar("headers", decls.NewMapType(decls.String, decls.String)),
+			decls.NewVar("query", decls.NewMapType(decls.String, decls.String)),
+			checksumDecl,
+		),
+		checksum,
+	)
+
+	eventRoutingEnv, _ := cel.NewEnv(
+		cel.Declarations(
+			decls.NewVar("input", decls.NewMapType(decls.String, decls.Dyn)),
+			decls.NewVar("additional_metadata", decls.NewMapType(decls.String, decls.Dyn)),
+			decls.NewVar("event_key", decls.String),
+			checksumDecl,
+		),
+		checksum,
+	)
+
 	return &CELParser{
-		workflowStrEnv: workflowStrEnv,
-		stepRunEnv:     stepRunEnv,
+		workflowStrEnv:       workflowStrEnv,
+		stepRunEnv:           stepRunEnv,
+		workflowRunOutputEnv: workflowRunOutputEnv,
+		kafkaMessageEnv:      kafkaMessageEnv,
+		inboundWebhookEnv:    inboundWebhookEnv,
+		eventRoutingEnv:      eventRoutingEnv,
 	}
 }
 
@@ -88,6 +144,12 @@ func WithParents(parents map[string]map[string]interface{}) InputOpts {
 	}
 }
 
+func WithSteps(steps map[string]map[string]interface{}) InputOpts {
+	return func(w Input) {
+		w["steps"] = steps
+	}
+}
+
 func WithAdditionalMetadata(metadata map[string]interface{}) InputOpts {
 	return func(w Input) {
 		w["additional_metadata"] = metadata
@@ -100,6 +162,35 @@ func WithWorkflowRunID(workflowRunID string) InputOpts {
 	}
 }
 
+// WithKafkaMessage sets the variables of a Kafka message. The payload is the decoded JSON value of the message, or
+// the message as a string if it isn't JSON.
+func WithKafkaMessage(topic string, partition int32, offset int64, key string, payload interface{}, headers map[string]string) InputOpts {
+	return func(w Input) {
+		w["topic"] = topic
+		w["partition"] = int64(partition)
+		w["offset"] = offset
+		w["key"] = key
+		w["payload"] = payload
+		w["headers"] = headers
+	}
+}
+
+// WithInboundWebhookRequest sets the variables of a request to an inbound webhook. The body is the decoded JSON
+// value of the request body, or the body as a string if it isn't JSON. Header names are lowercase.
+func WithInboundWebhookRequest(body interface{}, headers map[string]string, query map[string]string) InputOpts {
+	return func(w Input) {
+		w["body"] = body
+		w["headers"] = headers
+		w["query"] = query
+	}
+}
+
+func WithEventKey(eventKey string) InputOpts {
+	return func(w Input) {
+		w["event_key"] = eventKey
+	}
+}
+
 func NewInput(opts ...InputOpts) Input {
 	res := make(map[string]interface{})
 
@@ -133,10 +224,15 @@ func (p *CELParser) ParseAndEvalWorkflowString(workflowExp string, in Input) (st
 		return "", err
 	}
 
-	// Switch on the type of the output.
+	// Switch on the type of the output. Scalar values are converted to strings so that keys can be
+	// read directly from payload fields, such as numeric ids which are decoded from JSON as doubles.
 	switch out.Type() {
 	case types.StringType:
 		return out.Value().(string), nil
+	case types.IntType, types.UintType, types.BoolType:
+		return fmt.Sprintf("%v", out.Value()), nil
+	case types.DoubleType:
+		return strconv.FormatFloat(out.Value().(float64), 'f', -1, 64), nil
 	default:
 		return "", fmt.Errorf("output must evaluate to a string: got %s", out.Type().TypeName())
 	}
@@ -200,6 +296,200 @@ func (p *CELParser) ParseAndEvalStepRun(stepRunExpr string, in Input) (*StepRunO
 	return res, nil
 }
 
+// ParseAndEvalStepRunCondition evaluates the condition of a step run, which must return a boolean.
+func (p *CELParser) ParseAndEvalStepRunCondition(conditionExpr string, in Input) (bool, error) {
+	prg, err := p.ParseStepRun(conditionExpr)
+	if err != nil {
+		return false, err
+	}
+
+	var inMap map[string]interface{} = in
+
+	out, _, err := prg.Eval(inMap)
+	if err != nil {
+		return false, err
+	}
+
+	if out.Type() != types.BoolType {
+		return false, fmt.Errorf("condition must evaluate to a boolean: got %s", out.Type().TypeName())
+	}
+
+	return out.Value().(bool), nil
+}
+
+// ParseAndEvalStepRunList evaluates the expression of a map step, which must evaluate to a list. The elements
+// of the list are converted to their JSON representation.
+func (p *CELParser) ParseAndEvalStepRunList(listExpr string, in Input) ([]interface{}, error) {
+	prg, err := p.ParseStepRun(listExpr)
+	if err != nil {
+		return nil, err
+	}
+
+	var inMap map[string]interface{} = in
+
+	out, _, err := prg.Eval(inMap)
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Type() != types.ListType {
+		return nil, fmt.Errorf("expression must evaluate to a list: got %s", out.Type().TypeName())
+	}
+
+	native, err := out.ConvertToNative(reflect.TypeOf(&structpb.ListValue{}))
+	if err != nil {
+		return nil, fmt.Errorf("could not convert list: %w", err)
+	}
+
+	return native.(*structpb.ListValue).AsSlice(), nil
+}
+
+func (p *CELParser) ParseWorkflowRunOutput(outputExpr string) (cel.Program, error) {
+	ast, issues := p.workflowRunOutputEnv.Compile(outputExpr)
+
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	return p.workflowRunOutputEnv.Program(ast)
+}
+
+// ParseAndEvalWorkflowRunOutput evaluates the output expression of a workflow against the outputs of its steps,
+// and returns the result as JSON.
+func (p *CELParser) ParseAndEvalWorkflowRunOutput(outputExpr string, in Input) ([]byte, error) {
+	prg, err := p.ParseWorkflowRunOutput(outputExpr)
+	if err != nil {
+		return nil, err
+	}
+
+	var inMap map[string]interface{} = in
+
+	out, _, err := prg.Eval(inMap)
+	if err != nil {
+		return nil, err
+	}
+
+	native, err := out.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, fmt.Errorf("could not convert output: %w", err)
+	}
+
+	return json.Marshal(native.(*structpb.Value).AsInterface())
+}
+
+// ParseKafkaMessageTransform parses the expression which transforms Kafka messages into the data of events.
+func (p *CELParser) ParseKafkaMessageTransform(transformExpr string) (cel.Program, error) {
+	ast, issues := p.kafkaMessageEnv.Compile(transformExpr)
+
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	return p.kafkaMessageEnv.Program(ast)
+}
+
+// EvalKafkaMessageTransform evaluates a parsed transform against a Kafka message, and returns the data of the event
+// as JSON. The transform must evaluate to a map.
+func EvalKafkaMessageTransform(prg cel.Program, in Input) ([]byte, error) {
+	return evalMap(prg, in, "transform")
+}
+
+// ParseInboundWebhookExpression parses an expression which maps requests to inbound webhooks to the key or the data
+// of events.
+func (p *CELParser) ParseInboundWebhookExpression(expr string) (cel.Program, error) {
+	ast, issues := p.inboundWebhookEnv.Compile(expr)
+
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	return p.inboundWebhookEnv.Program(ast)
+}
+
+// EvalInboundWebhookEventKey evaluates a parsed event key expression against a request. The expression must evaluate
+// to a non-empty string.
+func EvalInboundWebhookEventKey(prg cel.Program, in Input) (string, error) {
+	var inMap map[string]interface{} = in
+
+	out, _, err := prg.Eval(inMap)
+	if err != nil {
+		return "", err
+	}
+
+	key, ok := out.Value().(string)
+
+	if !ok {
+		return "", fmt.Errorf("event key expression must evaluate to a string: got %s", out.Type().TypeName())
+	}
+
+	if key == "" {
+		return "", fmt.Errorf("event key expression evaluated to an empty string")
+	}
+
+	return key, nil
+}
+
+// EvalInboundWebhookData evaluates a parsed data expression against a request, and returns the data of the event as
+// JSON. The expression must evaluate to a map.
+func EvalInboundWebhookData(prg cel.Program, in Input) ([]byte, error) {
+	return evalMap(prg, in, "data expression")
+}
+
+// ParseEventRoutingExpression parses the predicate of an event routing rule, which has the data of an event as
+// input, its additional metadata as additional_metadata and its key as event_key. The predicate must evaluate to a
+// boolean.
+func (p *CELParser) ParseEventRoutingExpression(expr string) (cel.Program, error) {
+	ast, issues := p.eventRoutingEnv.Compile(expr)
+
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if outType := ast.OutputType(); !outType.IsExactType(types.BoolType) && !outType.IsExactType(types.DynType) {
+		return nil, fmt.Errorf("predicate must evaluate to a boolean: got %s", outType.String())
+	}
+
+	return p.eventRoutingEnv.Program(ast)
+}
+
+// EvalEventRoutingExpression evaluates a parsed routing predicate against an event.
+func EvalEventRoutingExpression(prg cel.Program, in Input) (bool, error) {
+	var inMap map[string]interface{} = in
+
+	out, _, err := prg.Eval(inMap)
+	if err != nil {
+		return false, err
+	}
+
+	if out.Type() != types.BoolType {
+		return false, fmt.Errorf("predicate must evaluate to a boolean: got %s", out.Type().TypeName())
+	}
+
+	return out.Value().(bool), nil
+}
+
+func evalMap(prg cel.Program, in Input, name string) ([]byte, error) {
+	var inMap map[string]interface{} = in
+
+	out, _, err := prg.Eval(inMap)
+	if err != nil {
+		return nil, err
+	}
+
+	native, err := out.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, fmt.Errorf("could not convert output: %w", err)
+	}
+
+	data, ok := native.(*structpb.Value).AsInterface().(map[string]interface{})
+
+	if !ok {
+		return nil, fmt.Errorf("%s must evaluate to a map: got %s", name, out.Type().TypeName())
+	}
+
+	return json.Marshal(data)
+}
+
 func (p *CELParser) CheckStepRunOutAgainstKnown(out *StepRunOut, knownType dbsqlc.StepExpressionKind) error {
 	switch knownType {
 	case dbsqlc.StepExpressionKindDYNAMICRATELIMITKEY:
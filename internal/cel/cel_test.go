@@ -64,6 +64,36 @@ func TestCELParser(t *testing.T) {
 			expected:    "concatenate 1234",
 			expectError: false,
 		},
+		{
+			expression: `input.customer_id`,
+			input: cel.NewInput(
+				cel.WithInput(map[string]interface{}{
+					"customer_id": float64(42),
+				}),
+			),
+			expected:    "42",
+			expectError: false,
+		},
+		{
+			expression: `additional_metadata.priority`,
+			input: cel.NewInput(
+				cel.WithAdditionalMetadata(map[string]interface{}{
+					"priority": int64(3),
+				}),
+			),
+			expected:    "3",
+			expectError: false,
+		},
+		{
+			expression: `input.customer`, // Maps can't be used as keys, expecting error
+			input: cel.NewInput(
+				cel.WithInput(map[string]interface{}{
+					"customer": map[string]interface{}{},
+				}),
+			),
+			expected:    "",
+			expectError: true,
+		},
 		{
 			expression:  `checksum(input.missing_key)`, // Should throw an error due to missing key
 			input:       cel.NewInput(),
@@ -91,3 +121,257 @@ func TestCELParser(t *testing.T) {
 		})
 	}
 }
+
+func TestStepRunCondition(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	tests := []struct {
+		expression  string
+		input       cel.Input
+		expected    bool
+		expectError bool
+	}{
+		{
+			expression: `parents.check.approved == true`,
+			input: cel.NewInput(
+				cel.WithParents(map[string]map[string]interface{}{
+					"check": {
+						"approved": true,
+					},
+				}),
+			),
+			expected: true,
+		},
+		{
+			expression: `"check" in parents && parents.check.amount > 100.0`,
+			input: cel.NewInput(
+				cel.WithParents(map[string]map[string]interface{}{}),
+			),
+			expected: false,
+		},
+		{
+			expression: `input.kind == "refund"`,
+			input: cel.NewInput(
+				cel.WithInput(map[string]interface{}{
+					"kind": "order",
+				}),
+				cel.WithParents(map[string]map[string]interface{}{}),
+			),
+			expected: false,
+		},
+		{
+			expression: `input.kind`, // Not a boolean, expecting error
+			input: cel.NewInput(
+				cel.WithInput(map[string]interface{}{
+					"kind": "order",
+				}),
+				cel.WithParents(map[string]map[string]interface{}{}),
+			),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			result, err := parser.ParseAndEvalStepRunCondition(tt.expression, tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err, "Expected error but got none")
+			} else {
+				assert.NoError(t, err, "Did not expect error but got one")
+				assert.Equal(t, tt.expected, result, "Unexpected result")
+			}
+		})
+	}
+}
+
+func TestStepRunList(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	result, err := parser.ParseAndEvalStepRunList(`parents.fetch.items`, cel.NewInput(
+		cel.WithParents(map[string]map[string]interface{}{
+			"fetch": {
+				"items": []interface{}{"a", float64(2), map[string]interface{}{"id": "c"}},
+			},
+		}),
+	))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"a", float64(2), map[string]interface{}{"id": "c"}}, result)
+
+	result, err = parser.ParseAndEvalStepRunList(`input.ids.map(id, "user:" + id)`, cel.NewInput(
+		cel.WithInput(map[string]interface{}{
+			"ids": []interface{}{"1", "2"},
+		}),
+		cel.WithParents(map[string]map[string]interface{}{}),
+	))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"user:1", "user:2"}, result)
+
+	_, err = parser.ParseAndEvalStepRunList(`input.kind`, cel.NewInput(
+		cel.WithInput(map[string]interface{}{
+			"kind": "order",
+		}),
+		cel.WithParents(map[string]map[string]interface{}{}),
+	))
+
+	assert.Error(t, err)
+}
+
+func TestWorkflowRunOutput(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	steps := map[string]map[string]interface{}{
+		"charge": {
+			"amount": float64(42),
+		},
+		"ship": {
+			"tracking": "abc",
+		},
+	}
+
+	result, err := parser.ParseAndEvalWorkflowRunOutput(`steps.ship`, cel.NewInput(
+		cel.WithSteps(steps),
+	))
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"tracking": "abc"}`, string(result))
+
+	result, err = parser.ParseAndEvalWorkflowRunOutput(`{"amount": steps.charge.amount, "tracking": steps.ship.tracking, "order": input.order_id}`, cel.NewInput(
+		cel.WithInput(map[string]interface{}{
+			"order_id": "123",
+		}),
+		cel.WithSteps(steps),
+	))
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"amount": 42, "tracking": "abc", "order": "123"}`, string(result))
+
+	_, err = parser.ParseAndEvalWorkflowRunOutput(`steps.missing.value`, cel.NewInput(
+		cel.WithSteps(steps),
+	))
+
+	assert.Error(t, err)
+}
+
+func TestTemplateToExpr(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	expr, isTemplate, err := cel.TemplateToExpr("user:{{ input.user_id }}")
+
+	assert.NoError(t, err)
+	assert.True(t, isTemplate)
+	assert.Equal(t, `"user:" + string(input.user_id)`, expr)
+
+	result, err := parser.ParseAndEvalWorkflowString(expr, cel.NewInput(
+		cel.WithInput(map[string]interface{}{
+			"user_id": 123,
+		}),
+	))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "user:123", result)
+
+	_, isTemplate, err = cel.TemplateToExpr("static-key")
+
+	assert.NoError(t, err)
+	assert.False(t, isTemplate)
+
+	_, _, err = cel.TemplateToExpr("user:{{ input.user_id")
+
+	assert.Error(t, err)
+}
+
+func TestKafkaMessageTransform(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	prg, err := parser.ParseKafkaMessageTransform(`{"order": payload.order, "source": topic, "key": key, "tenant": headers["tenant"], "offset": offset}`)
+	assert.NoError(t, err)
+
+	result, err := cel.EvalKafkaMessageTransform(prg, cel.NewInput(
+		cel.WithKafkaMessage("orders", 1, 42, "order-1", map[string]interface{}{
+			"order": map[string]interface{}{"id": "order-1"},
+		}, map[string]string{"tenant": "acme"}),
+	))
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"order": {"id": "order-1"}, "source": "orders", "key": "order-1", "tenant": "acme", "offset": 42}`, string(result))
+
+	prg, err = parser.ParseKafkaMessageTransform(`payload`)
+	assert.NoError(t, err)
+
+	_, err = cel.EvalKafkaMessageTransform(prg, cel.NewInput(
+		cel.WithKafkaMessage("orders", 0, 0, "", "not json", map[string]string{}),
+	))
+
+	assert.Error(t, err)
+
+	_, err = parser.ParseKafkaMessageTransform(`payload.`)
+	assert.Error(t, err)
+}
+
+func TestInboundWebhook(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	in := cel.NewInput(
+		cel.WithInboundWebhookRequest(map[string]interface{}{
+			"type": "invoice.paid",
+			"data": map[string]interface{}{"object": map[string]interface{}{"id": "in_1"}},
+		}, map[string]string{"x-github-event": "push"}, map[string]string{"source": "stripe"}),
+	)
+
+	prg, err := parser.ParseInboundWebhookExpression(`query["source"] + ":" + body.type`)
+	assert.NoError(t, err)
+
+	key, err := cel.EvalInboundWebhookEventKey(prg, in)
+	assert.NoError(t, err)
+	assert.Equal(t, "stripe:invoice.paid", key)
+
+	prg, err = parser.ParseInboundWebhookExpression(`{"invoiceId": body.data.object.id, "event": headers["x-github-event"]}`)
+	assert.NoError(t, err)
+
+	data, err := cel.EvalInboundWebhookData(prg, in)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"invoiceId": "in_1", "event": "push"}`, string(data))
+
+	prg, err = parser.ParseInboundWebhookExpression(`body.data`)
+	assert.NoError(t, err)
+
+	_, err = cel.EvalInboundWebhookEventKey(prg, in)
+	assert.Error(t, err)
+}
+
+func TestEventRoutingExpression(t *testing.T) {
+	parser := cel.NewCELParser()
+
+	in := cel.NewInput(
+		cel.WithInput(map[string]interface{}{"amount": float64(1500), "country": "DE"}),
+		cel.WithAdditionalMetadata(map[string]interface{}{"source": "checkout"}),
+		cel.WithEventKey("order:created"),
+	)
+
+	prg, err := parser.ParseEventRoutingExpression(`input.amount > 1000.0 && input.country in ["DE", "FR"] && event_key.startsWith("order:")`)
+	assert.NoError(t, err)
+
+	matches, err := cel.EvalEventRoutingExpression(prg, in)
+	assert.NoError(t, err)
+	assert.True(t, matches)
+
+	prg, err = parser.ParseEventRoutingExpression(`additional_metadata.source == "api"`)
+	assert.NoError(t, err)
+
+	matches, err = cel.EvalEventRoutingExpression(prg, in)
+	assert.NoError(t, err)
+	assert.False(t, matches)
+
+	// dynamic values are only known to be booleans when the predicate is evaluated
+	prg, err = parser.ParseEventRoutingExpression(`input.country`)
+	assert.NoError(t, err)
+
+	_, err = cel.EvalEventRoutingExpression(prg, in)
+	assert.Error(t, err)
+
+	_, err = parser.ParseEventRoutingExpression(`event_key + "x"`)
+	assert.Error(t, err)
+}
@@ -1,6 +1,10 @@
 package cel
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
 
 func Str(s string) string {
 	return fmt.Sprintf(`"%s"`, s)
@@ -9,3 +13,44 @@ func Str(s string) string {
 func Int(i int) string {
 	return fmt.Sprintf("%d", i)
 }
+
+// TemplateToExpr converts a key template like `user:{{ input.user_id }}` into an equivalent CEL string
+// expression. The returned bool is false if the string doesn't contain any template placeholders.
+func TemplateToExpr(tmpl string) (string, bool, error) {
+	if !strings.Contains(tmpl, "{{") {
+		return "", false, nil
+	}
+
+	parts := make([]string, 0)
+	rest := tmpl
+
+	for rest != "" {
+		start := strings.Index(rest, "{{")
+
+		if start == -1 {
+			parts = append(parts, strconv.Quote(rest))
+			break
+		}
+
+		if start > 0 {
+			parts = append(parts, strconv.Quote(rest[:start]))
+		}
+
+		end := strings.Index(rest[start:], "}}")
+
+		if end == -1 {
+			return "", true, fmt.Errorf("unterminated placeholder in template %q", tmpl)
+		}
+
+		expr := strings.TrimSpace(rest[start+2 : start+end])
+
+		if expr == "" {
+			return "", true, fmt.Errorf("empty placeholder in template %q", tmpl)
+		}
+
+		parts = append(parts, fmt.Sprintf("string(%s)", expr))
+		rest = rest[start+end+2:]
+	}
+
+	return strings.Join(parts, " + "), true, nil
+}
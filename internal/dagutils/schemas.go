@@ -0,0 +1,208 @@
+package dagutils
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// SchemaMismatch is a difference between the JSON schema which a step expects the output of a parent to match and
+// the JSON schema of the output of the parent.
+type SchemaMismatch struct {
+	// the path of the value in the output, for example items[].id
+	Path string
+
+	Message string
+
+	// true if the output of the parent may still match the expected schema, for example because the parent
+	// schema allows additional properties
+	Possible bool
+}
+
+// ParseSchema parses a JSON schema, which must be a JSON object.
+func ParseSchema(schema string) (map[string]interface{}, error) {
+	res := map[string]interface{}{}
+
+	if err := json.Unmarshal([]byte(schema), &res); err != nil {
+		return nil, fmt.Errorf("schema is not a JSON object: %w", err)
+	}
+
+	return res, nil
+}
+
+// CompareSchemas checks if values which match the actual schema also match the expected schema. Only the type,
+// properties, required, items and additionalProperties keywords are compared.
+func CompareSchemas(expected, actual map[string]interface{}) []SchemaMismatch {
+	return compareSchemas("", expected, actual)
+}
+
+func compareSchemas(path string, expected, actual map[string]interface{}) []SchemaMismatch {
+	res := make([]SchemaMismatch, 0)
+
+	expectedTypes := schemaTypes(expected)
+	actualTypes := schemaTypes(actual)
+
+	if len(expectedTypes) > 0 && len(actualTypes) > 0 {
+		allowed := 0
+
+		for _, t := range actualTypes {
+			if typeAllowed(t, expectedTypes) {
+				allowed++
+			}
+		}
+
+		if allowed < len(actualTypes) {
+			res = append(res, SchemaMismatch{
+				Path:     displayPath(path),
+				Message:  fmt.Sprintf("expected %s, but the output is %s", joinTypes(expectedTypes), joinTypes(actualTypes)),
+				Possible: allowed > 0,
+			})
+
+			// the properties and items of values with different types can't be compared
+			if allowed == 0 {
+				return res
+			}
+		}
+	}
+
+	expectedProps, _ := expected["properties"].(map[string]interface{})
+	actualProps, hasActualProps := actual["properties"].(map[string]interface{})
+
+	// additional properties are allowed unless additionalProperties is false
+	closed := false
+
+	if additional, ok := actual["additionalProperties"].(bool); ok && !additional {
+		closed = true
+	}
+
+	required := schemaStrings(expected["required"])
+	actualRequired := make(map[string]bool)
+
+	for _, key := range schemaStrings(actual["required"]) {
+		actualRequired[key] = true
+	}
+
+	for _, key := range required {
+		if _, ok := actualProps[key]; ok {
+			if !actualRequired[key] {
+				res = append(res, SchemaMismatch{
+					Path:     joinPath(path, key),
+					Message:  "expected a required property, but the property is optional in the output",
+					Possible: true,
+				})
+			}
+
+			continue
+		}
+
+		if !hasActualProps {
+			continue
+		}
+
+		res = append(res, SchemaMismatch{
+			Path:     joinPath(path, key),
+			Message:  "expected a required property, but the property is not declared in the output",
+			Possible: !closed,
+		})
+	}
+
+	keys := make([]string, 0, len(expectedProps))
+
+	for key := range expectedProps {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		expectedProp, ok := expectedProps[key].(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		actualProp, ok := actualProps[key].(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		res = append(res, compareSchemas(joinPath(path, key), expectedProp, actualProp)...)
+	}
+
+	expectedItems, ok := expected["items"].(map[string]interface{})
+
+	if !ok {
+		return res
+	}
+
+	actualItems, ok := actual["items"].(map[string]interface{})
+
+	if !ok {
+		return res
+	}
+
+	return append(res, compareSchemas(path+"[]", expectedItems, actualItems)...)
+}
+
+func schemaTypes(schema map[string]interface{}) []string {
+	switch t := schema["type"].(type) {
+	case string:
+		return []string{t}
+	default:
+		return schemaStrings(t)
+	}
+}
+
+func schemaStrings(v interface{}) []string {
+	list, ok := v.([]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	res := make([]string, 0, len(list))
+
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			res = append(res, s)
+		}
+	}
+
+	return res
+}
+
+func typeAllowed(t string, allowed []string) bool {
+	for _, a := range allowed {
+		// every integer is a number
+		if a == t || (a == "number" && t == "integer") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func joinTypes(types []string) string {
+	if len(types) == 1 {
+		return types[0]
+	}
+
+	return fmt.Sprintf("%v", types)
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func displayPath(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+
+	return path
+}
@@ -0,0 +1,181 @@
+package dagutils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+const (
+	DiagnosticDuplicateStep   = "DUPLICATE_STEP"
+	DiagnosticUndefinedParent = "UNDEFINED_PARENT"
+	DiagnosticCycle           = "CYCLE"
+	DiagnosticUnreachableStep = "UNREACHABLE_STEP"
+)
+
+// Diagnostic is a problem with the steps of a job, which prevents the job from being registered.
+type Diagnostic struct {
+	Code    string
+	Step    string
+	Message string
+}
+
+// ValidateSteps checks the steps of a job for duplicate step names, parents which are not steps of the job, cycles,
+// and steps which can never run because one of their ancestors can never run. Diagnostics are returned in the
+// order of the steps.
+func ValidateSteps(steps []repository.CreateWorkflowStepOpts) []Diagnostic {
+	res := make([]Diagnostic, 0)
+
+	parents := make(map[string][]string, len(steps))
+	order := make([]string, 0, len(steps))
+
+	for _, step := range steps {
+		if _, ok := parents[step.ReadableId]; ok {
+			res = append(res, Diagnostic{
+				Code:    DiagnosticDuplicateStep,
+				Step:    step.ReadableId,
+				Message: fmt.Sprintf("step %s is defined more than once", step.ReadableId),
+			})
+
+			continue
+		}
+
+		parents[step.ReadableId] = step.Parents
+		order = append(order, step.ReadableId)
+	}
+
+	// steps which have an undefined parent or are part of a cycle are reported directly, and their descendants
+	// are reported as unreachable
+	broken := make(map[string]bool)
+
+	for _, step := range order {
+		for _, parent := range parents[step] {
+			if _, ok := parents[parent]; !ok {
+				res = append(res, Diagnostic{
+					Code:    DiagnosticUndefinedParent,
+					Step:    step,
+					Message: fmt.Sprintf("step %s has parent %s, which is not a step of the job", step, parent),
+				})
+
+				broken[step] = true
+			}
+		}
+	}
+
+	for _, cycle := range findCycles(order, parents) {
+		res = append(res, Diagnostic{
+			Code:    DiagnosticCycle,
+			Step:    cycle[0],
+			Message: fmt.Sprintf("steps depend on each other in a cycle: %s", strings.Join(cycle, " -> ")),
+		})
+
+		for _, step := range cycle {
+			broken[step] = true
+		}
+	}
+
+	reachable := make(map[string]bool, len(order))
+
+	var isReachable func(step string, visiting map[string]bool) bool
+
+	isReachable = func(step string, visiting map[string]bool) bool {
+		if r, ok := reachable[step]; ok {
+			return r
+		}
+
+		if broken[step] || visiting[step] {
+			return false
+		}
+
+		visiting[step] = true
+
+		r := true
+
+		for _, parent := range parents[step] {
+			if !isReachable(parent, visiting) {
+				r = false
+			}
+		}
+
+		reachable[step] = r
+
+		return r
+	}
+
+	for _, step := range order {
+		if broken[step] || isReachable(step, map[string]bool{}) {
+			continue
+		}
+
+		for _, parent := range parents[step] {
+			if !reachable[parent] {
+				res = append(res, Diagnostic{
+					Code:    DiagnosticUnreachableStep,
+					Step:    step,
+					Message: fmt.Sprintf("step %s can never run because its parent %s can never run", step, parent),
+				})
+
+				break
+			}
+		}
+	}
+
+	return res
+}
+
+// findCycles returns the cycles of the graph, each starting and ending with the same step. Every cycle is returned
+// once, starting at the step which comes first in the order.
+func findCycles(order []string, parents map[string][]string) [][]string {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+
+	state := make(map[string]int, len(order))
+	stack := make([]string, 0)
+	cycles := make([][]string, 0)
+
+	var visit func(step string)
+
+	visit = func(step string) {
+		state[step] = visiting
+		stack = append(stack, step)
+
+		for _, parent := range parents[step] {
+			if _, ok := parents[parent]; !ok {
+				continue
+			}
+
+			switch state[parent] {
+			case unvisited:
+				visit(parent)
+			case visiting:
+				// the parent is on the stack, so the steps from the parent to the current step form a cycle
+				start := len(stack) - 1
+
+				for stack[start] != parent {
+					start--
+				}
+
+				cycle := make([]string, 0, len(stack)-start+1)
+				cycle = append(cycle, stack[start:]...)
+				cycle = append(cycle, parent)
+
+				cycles = append(cycles, cycle)
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[step] = done
+	}
+
+	for _, step := range order {
+		if state[step] == unvisited {
+			visit(step)
+		}
+	}
+
+	return cycles
+}
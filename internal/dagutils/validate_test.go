@@ -0,0 +1,120 @@
+package dagutils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/internal/dagutils"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+func TestValidateSteps(t *testing.T) {
+	tests := []struct {
+		name     string
+		steps    []repository.CreateWorkflowStepOpts
+		expected []dagutils.Diagnostic
+	}{
+		{
+			name: "Valid DAG",
+			steps: []repository.CreateWorkflowStepOpts{
+				{ReadableId: "fetch"},
+				{ReadableId: "parse", Parents: []string{"fetch"}},
+				{ReadableId: "store", Parents: []string{"fetch", "parse"}},
+			},
+			expected: []dagutils.Diagnostic{},
+		},
+		{
+			name: "Duplicate step",
+			steps: []repository.CreateWorkflowStepOpts{
+				{ReadableId: "fetch"},
+				{ReadableId: "fetch"},
+			},
+			expected: []dagutils.Diagnostic{
+				{Code: dagutils.DiagnosticDuplicateStep, Step: "fetch", Message: "step fetch is defined more than once"},
+			},
+		},
+		{
+			name: "Undefined parent with descendant",
+			steps: []repository.CreateWorkflowStepOpts{
+				{ReadableId: "parse", Parents: []string{"fetch"}},
+				{ReadableId: "store", Parents: []string{"parse"}},
+			},
+			expected: []dagutils.Diagnostic{
+				{Code: dagutils.DiagnosticUndefinedParent, Step: "parse", Message: "step parse has parent fetch, which is not a step of the job"},
+				{Code: dagutils.DiagnosticUnreachableStep, Step: "store", Message: "step store can never run because its parent parse can never run"},
+			},
+		},
+		{
+			name: "Cycle with descendant",
+			steps: []repository.CreateWorkflowStepOpts{
+				{ReadableId: "a", Parents: []string{"b"}},
+				{ReadableId: "b", Parents: []string{"a"}},
+				{ReadableId: "c", Parents: []string{"b"}},
+				{ReadableId: "d"},
+			},
+			expected: []dagutils.Diagnostic{
+				{Code: dagutils.DiagnosticCycle, Step: "a", Message: "steps depend on each other in a cycle: a -> b -> a"},
+				{Code: dagutils.DiagnosticUnreachableStep, Step: "c", Message: "step c can never run because its parent b can never run"},
+			},
+		},
+		{
+			name: "Self referential cycle",
+			steps: []repository.CreateWorkflowStepOpts{
+				{ReadableId: "a", Parents: []string{"a"}},
+			},
+			expected: []dagutils.Diagnostic{
+				{Code: dagutils.DiagnosticCycle, Step: "a", Message: "steps depend on each other in a cycle: a -> a"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, dagutils.ValidateSteps(tt.steps))
+		})
+	}
+}
+
+func TestCompareSchemas(t *testing.T) {
+	expected, err := dagutils.ParseSchema(`{
+		"type": "object",
+		"required": ["id", "items"],
+		"properties": {
+			"id": {"type": "number"},
+			"items": {"type": "array", "items": {"type": "object", "properties": {"name": {"type": "string"}}}}
+		}
+	}`)
+	assert.NoError(t, err)
+
+	compatible, err := dagutils.ParseSchema(`{
+		"type": "object",
+		"required": ["id", "items", "extra"],
+		"properties": {
+			"id": {"type": "integer"},
+			"items": {"type": "array", "items": {"type": "object", "properties": {"name": {"type": "string"}}}},
+			"extra": {"type": "boolean"}
+		}
+	}`)
+	assert.NoError(t, err)
+
+	assert.Empty(t, dagutils.CompareSchemas(expected, compatible))
+
+	mismatched, err := dagutils.ParseSchema(`{
+		"type": "object",
+		"additionalProperties": false,
+		"required": ["items"],
+		"properties": {
+			"items": {"type": "array", "items": {"type": "object", "properties": {"name": {"type": "integer"}}}}
+		}
+	}`)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []dagutils.SchemaMismatch{
+		{Path: "id", Message: "expected a required property, but the property is not declared in the output"},
+		{Path: "items[].name", Message: "expected string, but the output is integer"},
+	}, dagutils.CompareSchemas(expected, mismatched))
+
+	_, err = dagutils.ParseSchema(`[]`)
+	assert.Error(t, err)
+}
@@ -0,0 +1,32 @@
+package datautils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// HashStepRunInput returns the sha256 hash of the input of a step run, which is used as the cache key of the
+// output of the step run. How the workflow run was triggered isn't part of the hash, and the hash doesn't depend
+// on the order of the keys in the input.
+func HashStepRunInput(input []byte) (string, error) {
+	data := &StepRunData{}
+
+	if err := json.Unmarshal(input, data); err != nil {
+		return "", fmt.Errorf("could not unmarshal step run input: %w", err)
+	}
+
+	data.TriggeredBy = ""
+
+	// maps are marshalled with sorted keys
+	canonical, err := json.Marshal(data)
+
+	if err != nil {
+		return "", fmt.Errorf("could not marshal step run input: %w", err)
+	}
+
+	sum := sha256.Sum256(canonical)
+
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,37 @@
+package datautils
+
+import (
+	"testing"
+)
+
+func TestHashStepRunInput(t *testing.T) {
+	a, err := HashStepRunInput([]byte(`{"input":{"a":1,"b":{"c":"d","e":[1,2]}},"triggered_by":"event","parents":{}}`))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	b, err := HashStepRunInput([]byte(`{"parents":{},"triggered_by":"manual","input":{"b":{"e":[1,2],"c":"d"},"a":1}}`))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if a != b {
+		t.Errorf("expected equal hashes for inputs which only differ in key order and trigger, got %s and %s", a, b)
+	}
+
+	c, err := HashStepRunInput([]byte(`{"input":{"a":2,"b":{"c":"d","e":[1,2]}},"triggered_by":"event","parents":{}}`))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if a == c {
+		t.Errorf("expected different hashes for different inputs")
+	}
+
+	if _, err := HashStepRunInput([]byte(`not json`)); err == nil {
+		t.Errorf("expected an error for invalid input")
+	}
+}
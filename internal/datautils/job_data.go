@@ -26,4 +26,10 @@ type StepRunData struct {
 
 	// overrides set from the playground
 	Overrides map[string]interface{} `json:"overrides"`
+
+	// the element of the list which a map step run was created for
+	MapItem interface{} `json:"map_item,omitempty"`
+
+	// the index of the element of the list which a map step run was created for
+	MapIndex *int `json:"map_index,omitempty"`
 }
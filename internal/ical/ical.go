@@ -0,0 +1,260 @@
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	dateLayout     = "20060102"
+	dateTimeLayout = "20060102T150405"
+)
+
+// Event is the time span of a VEVENT in an iCal feed. The end of the event is exclusive.
+type Event struct {
+	Start time.Time
+	End   time.Time
+
+	// AllDay is true for events whose start and end are dates instead of times. The start and end of all-day
+	// events are stored as midnight UTC, and are matched against the date in the timezone of the checked time.
+	AllDay bool
+}
+
+// Covers returns true if the time falls within the event.
+func (e Event) Covers(t time.Time) bool {
+	if e.AllDay {
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+
+	return !t.Before(e.Start) && t.Before(e.End)
+}
+
+// Parse reads the events of an iCal feed (RFC 5545). Only the DTSTART, DTEND and DURATION properties of events are
+// read, and recurrence rules are not expanded.
+func Parse(r io.Reader) ([]Event, error) {
+	lines, err := unfold(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var events []Event
+	var props map[string]property
+
+	for _, line := range lines {
+		name, prop := parseProperty(line)
+
+		switch {
+		case name == "BEGIN" && strings.EqualFold(prop.value, "VEVENT"):
+			props = map[string]property{}
+		case name == "END" && strings.EqualFold(prop.value, "VEVENT"):
+			if props == nil {
+				continue
+			}
+
+			event, err := toEvent(props)
+
+			if err != nil {
+				return nil, err
+			}
+
+			events = append(events, event)
+			props = nil
+		case props != nil:
+			props[name] = prop
+		}
+	}
+
+	return events, nil
+}
+
+type property struct {
+	params map[string]string
+	value  string
+}
+
+// unfold joins the content lines which were folded onto continuation lines starting with a space or tab.
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		if len(line) > 0 && (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read ical feed: %w", err)
+	}
+
+	return lines, nil
+}
+
+// parseProperty splits a content line like DTSTART;TZID=Europe/Berlin:20240101T090000 into its name, parameters
+// and value.
+func parseProperty(line string) (string, property) {
+	prop := property{
+		params: map[string]string{},
+	}
+
+	nameAndParams, value, _ := strings.Cut(line, ":")
+	prop.value = value
+
+	parts := strings.Split(nameAndParams, ";")
+
+	for _, param := range parts[1:] {
+		if key, val, ok := strings.Cut(param, "="); ok {
+			prop.params[strings.ToUpper(key)] = strings.Trim(val, `"`)
+		}
+	}
+
+	return strings.ToUpper(parts[0]), prop
+}
+
+func toEvent(props map[string]property) (Event, error) {
+	dtStart, ok := props["DTSTART"]
+
+	if !ok {
+		return Event{}, fmt.Errorf("event is missing DTSTART")
+	}
+
+	start, allDay, err := parseTime(dtStart)
+
+	if err != nil {
+		return Event{}, fmt.Errorf("could not parse DTSTART: %w", err)
+	}
+
+	event := Event{
+		Start:  start,
+		AllDay: allDay,
+	}
+
+	if dtEnd, ok := props["DTEND"]; ok {
+		end, _, err := parseTime(dtEnd)
+
+		if err != nil {
+			return Event{}, fmt.Errorf("could not parse DTEND: %w", err)
+		}
+
+		event.End = end
+	} else if duration, ok := props["DURATION"]; ok {
+		d, err := parseDuration(duration.value)
+
+		if err != nil {
+			return Event{}, fmt.Errorf("could not parse DURATION: %w", err)
+		}
+
+		event.End = start.Add(d)
+	} else if allDay {
+		// all-day events without an end last for the day of their start
+		event.End = start.AddDate(0, 0, 1)
+	} else {
+		event.End = start
+	}
+
+	return event, nil
+}
+
+func parseTime(prop property) (time.Time, bool, error) {
+	if strings.EqualFold(prop.params["VALUE"], "DATE") || len(prop.value) == len(dateLayout) {
+		t, err := time.ParseInLocation(dateLayout, prop.value, time.UTC)
+		return t, true, err
+	}
+
+	if strings.HasSuffix(prop.value, "Z") {
+		t, err := time.ParseInLocation(dateTimeLayout, strings.TrimSuffix(prop.value, "Z"), time.UTC)
+		return t, false, err
+	}
+
+	// times without a timezone are evaluated in UTC
+	location := time.UTC
+
+	if tzid, ok := prop.params["TZID"]; ok {
+		var err error
+
+		location, err = time.LoadLocation(tzid)
+
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("could not load timezone %s: %w", tzid, err)
+		}
+	}
+
+	t, err := time.ParseInLocation(dateTimeLayout, prop.value, location)
+
+	return t, false, err
+}
+
+// parseDuration parses durations like P1D, PT1H30M or P1W. Durations of days and weeks are exact multiples of 24
+// hours.
+func parseDuration(s string) (time.Duration, error) {
+	orig := s
+	s = strings.TrimPrefix(s, "+")
+
+	if !strings.HasPrefix(s, "P") {
+		return 0, fmt.Errorf("invalid duration %s", orig)
+	}
+
+	s = s[1:]
+
+	var d time.Duration
+	inTime := false
+
+	for s != "" {
+		if s[0] == 'T' {
+			inTime = true
+			s = s[1:]
+			continue
+		}
+
+		i := 0
+
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+
+		if i == 0 || i == len(s) {
+			return 0, fmt.Errorf("invalid duration %s", orig)
+		}
+
+		var n int
+
+		if _, err := fmt.Sscanf(s[:i], "%d", &n); err != nil {
+			return 0, fmt.Errorf("invalid duration %s", orig)
+		}
+
+		unit := time.Duration(0)
+
+		switch {
+		case s[i] == 'W' && !inTime:
+			unit = 7 * 24 * time.Hour
+		case s[i] == 'D' && !inTime:
+			unit = 24 * time.Hour
+		case s[i] == 'H' && inTime:
+			unit = time.Hour
+		case s[i] == 'M' && inTime:
+			unit = time.Minute
+		case s[i] == 'S' && inTime:
+			unit = time.Second
+		default:
+			return 0, fmt.Errorf("invalid duration %s", orig)
+		}
+
+		d += time.Duration(n) * unit
+		s = s[i+1:]
+	}
+
+	return d, nil
+}
@@ -0,0 +1,78 @@
+package ical_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/ical"
+)
+
+const feed = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"SUMMARY:New Year's Day\r\n" +
+	"DTSTART;VALUE=DATE:20250101\r\n" +
+	"DTEND;VALUE=DATE:20250102\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"SUMMARY:Database maint\r\n" +
+	" enance\r\n" +
+	"DTSTART;TZID=Europe/Berlin:20250110T220000\r\n" +
+	"DURATION:PT2H\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART:20250115T120000Z\r\n" +
+	"DTEND:20250115T130000Z\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParse(t *testing.T) {
+	events, err := ical.Parse(strings.NewReader(feed))
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	assert.True(t, events[0].AllDay)
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), events[0].Start)
+	assert.Equal(t, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), events[0].End)
+
+	assert.False(t, events[1].AllDay)
+	assert.True(t, events[1].Start.Equal(time.Date(2025, 1, 10, 22, 0, 0, 0, berlin)))
+	assert.True(t, events[1].End.Equal(time.Date(2025, 1, 11, 0, 0, 0, 0, berlin)))
+
+	assert.True(t, events[2].Start.Equal(time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)))
+	assert.True(t, events[2].End.Equal(time.Date(2025, 1, 15, 13, 0, 0, 0, time.UTC)))
+}
+
+func TestEventCovers(t *testing.T) {
+	events, err := ical.Parse(strings.NewReader(feed))
+	require.NoError(t, err)
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	// all-day events match the date in the timezone of the checked time
+	assert.True(t, events[0].Covers(time.Date(2025, 1, 1, 23, 30, 0, 0, newYork)))
+	assert.False(t, events[0].Covers(time.Date(2024, 12, 31, 23, 30, 0, 0, newYork)))
+	assert.False(t, events[0].Covers(time.Date(2025, 1, 2, 0, 0, 0, 0, newYork)))
+
+	assert.True(t, events[1].Covers(time.Date(2025, 1, 10, 21, 0, 0, 0, time.UTC)))
+	assert.False(t, events[1].Covers(time.Date(2025, 1, 10, 23, 0, 0, 0, time.UTC)))
+
+	assert.True(t, events[2].Covers(time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)))
+	assert.False(t, events[2].Covers(time.Date(2025, 1, 15, 13, 0, 0, 0, time.UTC)))
+}
+
+func TestParseInvalid(t *testing.T) {
+	_, err := ical.Parse(strings.NewReader("BEGIN:VEVENT\nSUMMARY:No start\nEND:VEVENT\n"))
+	assert.Error(t, err)
+
+	_, err = ical.Parse(strings.NewReader("BEGIN:VEVENT\nDTSTART:20250101T090000Z\nDURATION:1H\nEND:VEVENT\n"))
+	assert.Error(t, err)
+}
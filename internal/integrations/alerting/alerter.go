@@ -117,7 +117,10 @@ func (t *TenantAlertManager) sendWorkflowRunAlert(ctx context.Context, tenantAle
 		return err
 	}
 
-	if failedWorkflowRuns.Count == 0 {
+	// the runs are counted, since they aren't listed with a cursor
+	failedCount := *failedWorkflowRuns.Count
+
+	if failedCount == 0 {
 		return nil
 	}
 
@@ -129,13 +132,13 @@ func (t *TenantAlertManager) sendWorkflowRunAlert(ctx context.Context, tenantAle
 
 	// iterate through possible alerters
 	for _, slackWebhook := range tenantAlerting.SlackWebhooks {
-		if innerErr := t.sendSlackWorkflowRunAlert(slackWebhook, failedWorkflowRuns.Count, failedItems); innerErr != nil {
+		if innerErr := t.sendSlackWorkflowRunAlert(slackWebhook, failedCount, failedItems); innerErr != nil {
 			err = multierror.Append(err, innerErr)
 		}
 	}
 
 	for _, emailGroup := range tenantAlerting.EmailGroups {
-		if innerErr := t.sendEmailWorkflowRunAlert(tenantAlerting.Tenant, emailGroup, failedWorkflowRuns.Count, failedItems); innerErr != nil {
+		if innerErr := t.sendEmailWorkflowRunAlert(tenantAlerting.Tenant, emailGroup, failedCount, failedItems); innerErr != nil {
 			err = multierror.Append(err, innerErr)
 		}
 	}
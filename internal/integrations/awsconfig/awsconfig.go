@@ -0,0 +1,67 @@
+// Package awsconfig loads the configuration of the AWS SDK clients which the engine uses for its own integrations,
+// like the s3 blob store and the aws secrets store.
+package awsconfig
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+)
+
+// Opts configures the region and the credentials of the clients.
+type Opts struct {
+	// Region defaults to the region of the environment or the shared config file of the SDK
+	Region string
+
+	// AccessKeyID and SecretAccessKey are static credentials. If they aren't set, the default credential chain of the
+	// SDK is used, which reads the credentials from the environment, the shared config and credentials files, web
+	// identity tokens like the ones of IAM roles for service accounts, and the ECS task and EC2 instance roles.
+	AccessKeyID string
+
+	SecretAccessKey string
+
+	// SessionToken is only required for temporary static credentials
+	SessionToken string
+
+	// HTTPClient defaults to the HTTP client of the SDK
+	HTTPClient *http.Client
+}
+
+// Load loads the configuration of the SDK clients.
+func Load(ctx context.Context, opts Opts) (aws.Config, error) {
+	if (opts.AccessKeyID == "") != (opts.SecretAccessKey == "") {
+		return aws.Config{}, fmt.Errorf("access key id and secret access key must be set together")
+	}
+
+	loadOpts := []func(*config.LoadOptions) error{}
+
+	if opts.Region != "" {
+		loadOpts = append(loadOpts, config.WithRegion(opts.Region))
+	}
+
+	if opts.AccessKeyID != "" {
+		loadOpts = append(loadOpts, config.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(opts.AccessKeyID, opts.SecretAccessKey, opts.SessionToken),
+		))
+	}
+
+	if opts.HTTPClient != nil {
+		loadOpts = append(loadOpts, config.WithHTTPClient(opts.HTTPClient))
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, loadOpts...)
+
+	if err != nil {
+		return aws.Config{}, fmt.Errorf("could not load aws config: %w", err)
+	}
+
+	if cfg.Region == "" {
+		return aws.Config{}, fmt.Errorf("region is required")
+	}
+
+	return cfg, nil
+}
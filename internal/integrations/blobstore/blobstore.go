@@ -0,0 +1,228 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotFound is returned by a BlobStore when there is no blob for a key.
+var ErrNotFound = errors.New("blob not found")
+
+// BlobStore stores payloads which are too large to be persisted in the database.
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	Delete(ctx context.Context, key string) error
+}
+
+// RefField is the only field of the JSON object which replaces a payload that was moved to a blob store.
+const RefField = "__hatchet_blob"
+
+// Ref references a payload which was moved to a blob store.
+type Ref struct {
+	Key string `json:"key"`
+
+	// Size is the size of the payload in bytes
+	Size int `json:"size"`
+}
+
+type refPayload struct {
+	Ref *Ref `json:"__hatchet_blob"`
+}
+
+// NewKey returns a new blob key for a tenant. Keys are prefixed with the tenant id, so a tenant can only read its
+// own blobs.
+func NewKey(tenantId string) string {
+	return fmt.Sprintf("%s/%s", tenantId, uuid.New().String())
+}
+
+// KeyBelongsToTenant returns true if the key was created for the tenant.
+func KeyBelongsToTenant(key, tenantId string) bool {
+	return strings.HasPrefix(key, tenantId+"/") && !strings.Contains(key, "..")
+}
+
+// MarshalRef returns the JSON payload which replaces a payload that was moved to a blob store.
+func MarshalRef(ref *Ref) ([]byte, error) {
+	return json.Marshal(refPayload{Ref: ref})
+}
+
+// ParseRef returns the reference if the payload was moved to a blob store.
+func ParseRef(data []byte) (*Ref, bool) {
+	if !bytes.Contains(data, []byte(RefField)) {
+		return nil, false
+	}
+
+	var v interface{}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, false
+	}
+
+	return RefFromValue(v)
+}
+
+// RefFromValue returns the reference if a decoded JSON value is a reference to a payload in a blob store.
+func RefFromValue(v interface{}) (*Ref, bool) {
+	m, ok := v.(map[string]interface{})
+
+	if !ok || len(m) != 1 {
+		return nil, false
+	}
+
+	refMap, ok := m[RefField].(map[string]interface{})
+
+	if !ok {
+		return nil, false
+	}
+
+	key, ok := refMap["key"].(string)
+
+	if !ok || key == "" {
+		return nil, false
+	}
+
+	ref := &Ref{
+		Key: key,
+	}
+
+	if size, ok := refMap["size"].(float64); ok {
+		ref.Size = int(size)
+	}
+
+	return ref, true
+}
+
+// Offloader moves step run inputs and outputs which are larger than the configured thresholds to a blob store, and
+// resolves them when the engine needs their contents. A nil Offloader keeps all payloads in the database.
+type Offloader struct {
+	Store BlobStore
+
+	// MaxInputSize is the size in bytes above which step run inputs are moved to the blob store. Inputs are never
+	// moved if it's 0.
+	MaxInputSize int
+
+	// MaxOutputSize is the size in bytes above which step run outputs are moved to the blob store. Outputs are
+	// never moved if it's 0.
+	MaxOutputSize int
+}
+
+// OffloadInput moves the input of a step run to the blob store if it's larger than MaxInputSize, and returns the
+// payload which should be persisted instead.
+func (o *Offloader) OffloadInput(ctx context.Context, tenantId string, data []byte) ([]byte, error) {
+	if o == nil {
+		return data, nil
+	}
+
+	return o.offload(ctx, tenantId, data, o.MaxInputSize)
+}
+
+// OffloadOutput moves the output of a step run to the blob store if it's larger than MaxOutputSize, and returns the
+// payload which should be persisted instead.
+func (o *Offloader) OffloadOutput(ctx context.Context, tenantId string, data []byte) ([]byte, error) {
+	if o == nil {
+		return data, nil
+	}
+
+	return o.offload(ctx, tenantId, data, o.MaxOutputSize)
+}
+
+func (o *Offloader) offload(ctx context.Context, tenantId string, data []byte, maxSize int) ([]byte, error) {
+	if o.Store == nil || maxSize <= 0 || len(data) <= maxSize {
+		return data, nil
+	}
+
+	ref := &Ref{
+		Key:  NewKey(tenantId),
+		Size: len(data),
+	}
+
+	if err := o.Store.Put(ctx, ref.Key, data); err != nil {
+		return nil, fmt.Errorf("could not store payload in blob store: %w", err)
+	}
+
+	return MarshalRef(ref)
+}
+
+// Get returns a payload from the blob store. Only the blobs of the tenant can be read.
+func (o *Offloader) Get(ctx context.Context, tenantId, key string) ([]byte, error) {
+	if o == nil || o.Store == nil || !KeyBelongsToTenant(key, tenantId) {
+		return nil, ErrNotFound
+	}
+
+	return o.Store.Get(ctx, key)
+}
+
+// Resolve replaces a payload which is a reference with the payload from the blob store, along with any references in
+// its JSON objects and arrays, for example the outputs of the parents in the input of a step run.
+func (o *Offloader) Resolve(ctx context.Context, data []byte) ([]byte, error) {
+	if o == nil || o.Store == nil || !bytes.Contains(data, []byte(RefField)) {
+		return data, nil
+	}
+
+	var v interface{}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+
+	resolved, err := o.resolveValue(ctx, v)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(resolved)
+}
+
+func (o *Offloader) resolveValue(ctx context.Context, v interface{}) (interface{}, error) {
+	if ref, ok := RefFromValue(v); ok {
+		data, err := o.Store.Get(ctx, ref.Key)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not get payload %s from blob store: %w", ref.Key, err)
+		}
+
+		var payload interface{}
+
+		if err := json.Unmarshal(data, &payload); err != nil {
+			return nil, fmt.Errorf("could not unmarshal payload %s from blob store: %w", ref.Key, err)
+		}
+
+		// the payload may contain references itself, for example when the input of a step run was moved
+		return o.resolveValue(ctx, payload)
+	}
+
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, child := range t {
+			resolved, err := o.resolveValue(ctx, child)
+
+			if err != nil {
+				return nil, err
+			}
+
+			t[k] = resolved
+		}
+	case []interface{}:
+		// the outputs of map step runs are lists of the outputs of their elements
+		for i, child := range t {
+			resolved, err := o.resolveValue(ctx, child)
+
+			if err != nil {
+				return nil, err
+			}
+
+			t[i] = resolved
+		}
+	}
+
+	return v, nil
+}
@@ -0,0 +1,130 @@
+package blobstore
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryStore struct {
+	blobs map[string][]byte
+}
+
+func (m *memoryStore) Put(ctx context.Context, key string, data []byte) error {
+	m.blobs[key] = data
+	return nil
+}
+
+func (m *memoryStore) Get(ctx context.Context, key string) ([]byte, error) {
+	data, ok := m.blobs[key]
+
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return data, nil
+}
+
+func (m *memoryStore) Delete(ctx context.Context, key string) error {
+	delete(m.blobs, key)
+	return nil
+}
+
+const tenantId = "707d0855-80ab-4e1f-a156-f1c4546cbf52"
+
+func TestOffloadBelowThreshold(t *testing.T) {
+	store := &memoryStore{blobs: map[string][]byte{}}
+	o := &Offloader{Store: store, MaxOutputSize: 100}
+
+	data := []byte(`{"small": true}`)
+
+	res, err := o.OffloadOutput(context.Background(), tenantId, data)
+
+	require.NoError(t, err)
+	assert.Equal(t, data, res)
+	assert.Empty(t, store.blobs)
+}
+
+func TestOffloadAndResolve(t *testing.T) {
+	store := &memoryStore{blobs: map[string][]byte{}}
+	o := &Offloader{Store: store, MaxInputSize: 10, MaxOutputSize: 10}
+
+	output := []byte(`{"items": ["a", "b", "c"]}`)
+
+	outputRef, err := o.OffloadOutput(context.Background(), tenantId, output)
+
+	require.NoError(t, err)
+
+	ref, ok := ParseRef(outputRef)
+
+	require.True(t, ok)
+	assert.True(t, strings.HasPrefix(ref.Key, tenantId+"/"))
+	assert.Equal(t, len(output), ref.Size)
+
+	// the output is referenced in the input of a child step, which is moved to the blob store as well
+	input := []byte(`{"input": {"id": 1}, "parents": {"fetch": ` + string(outputRef) + `}}`)
+
+	inputRef, err := o.OffloadInput(context.Background(), tenantId, input)
+
+	require.NoError(t, err)
+	require.Len(t, store.blobs, 2)
+
+	resolved, err := o.Resolve(context.Background(), inputRef)
+
+	require.NoError(t, err)
+
+	var res map[string]interface{}
+
+	require.NoError(t, json.Unmarshal(resolved, &res))
+	assert.Equal(t, []interface{}{"a", "b", "c"}, res["parents"].(map[string]interface{})["fetch"].(map[string]interface{})["items"])
+	assert.Equal(t, float64(1), res["input"].(map[string]interface{})["id"])
+}
+
+func TestNilOffloader(t *testing.T) {
+	var o *Offloader
+
+	data := []byte(`{"large": "payload"}`)
+
+	res, err := o.OffloadInput(context.Background(), tenantId, data)
+
+	require.NoError(t, err)
+	assert.Equal(t, data, res)
+
+	res, err = o.Resolve(context.Background(), data)
+
+	require.NoError(t, err)
+	assert.Equal(t, data, res)
+
+	_, err = o.Get(context.Background(), tenantId, tenantId+"/key")
+
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestGetChecksTenant(t *testing.T) {
+	store := &memoryStore{blobs: map[string][]byte{
+		"other-tenant/key": []byte(`{}`),
+	}}
+
+	o := &Offloader{Store: store}
+
+	_, err := o.Get(context.Background(), tenantId, "other-tenant/key")
+
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestParseRef(t *testing.T) {
+	_, ok := ParseRef([]byte(`{"key": "value"}`))
+	assert.False(t, ok)
+
+	_, ok = ParseRef([]byte(`{"__hatchet_blob": {"key": "a/b"}, "other": 1}`))
+	assert.False(t, ok)
+
+	ref, ok := ParseRef([]byte(`{"__hatchet_blob": {"key": "a/b", "size": 20}}`))
+	require.True(t, ok)
+	assert.Equal(t, "a/b", ref.Key)
+	assert.Equal(t, 20, ref.Size)
+}
@@ -0,0 +1,93 @@
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/blobstore"
+)
+
+// FilesystemStore stores blobs as files in a directory. It's meant for single-node deployments and development,
+// since the directory has to be shared by every engine instance.
+type FilesystemStore struct {
+	dir string
+}
+
+func NewFilesystemStore(dir string) (*FilesystemStore, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("blob storage directory is required")
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("could not create blob storage directory: %w", err)
+	}
+
+	return &FilesystemStore{
+		dir: dir,
+	}, nil
+}
+
+func (s *FilesystemStore) Put(ctx context.Context, key string, data []byte) error {
+	path, err := s.path(key)
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("could not create blob directory: %w", err)
+	}
+
+	// write to a temporary file first, so readers never see a partially written blob
+	tmp := path + ".tmp"
+
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("could not write blob: %w", err)
+	}
+
+	return os.Rename(tmp, path)
+}
+
+func (s *FilesystemStore) Get(ctx context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, blobstore.ErrNotFound
+	}
+
+	return data, err
+}
+
+func (s *FilesystemStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+func (s *FilesystemStore) path(key string) (string, error) {
+	if key == "" || strings.Contains(key, "..") || filepath.IsAbs(key) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
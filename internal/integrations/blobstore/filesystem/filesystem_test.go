@@ -0,0 +1,45 @@
+package filesystem
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/blobstore"
+)
+
+func TestFilesystemStore(t *testing.T) {
+	store, err := NewFilesystemStore(t.TempDir())
+
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	require.NoError(t, store.Put(ctx, "tenant/key", []byte(`{"a": 1}`)))
+
+	data, err := store.Get(ctx, "tenant/key")
+
+	require.NoError(t, err)
+	assert.Equal(t, `{"a": 1}`, string(data))
+
+	require.NoError(t, store.Delete(ctx, "tenant/key"))
+
+	_, err = store.Get(ctx, "tenant/key")
+
+	assert.ErrorIs(t, err, blobstore.ErrNotFound)
+
+	// deleting a missing blob is not an error
+	assert.NoError(t, store.Delete(ctx, "tenant/key"))
+}
+
+func TestFilesystemStoreInvalidKey(t *testing.T) {
+	store, err := NewFilesystemStore(t.TempDir())
+
+	require.NoError(t, err)
+
+	_, err = store.Get(context.Background(), "../secret")
+
+	assert.Error(t, err)
+}
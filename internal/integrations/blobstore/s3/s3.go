@@ -0,0 +1,142 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/awsconfig"
+	"github.com/hatchet-dev/hatchet/internal/integrations/blobstore"
+)
+
+// S3StoreOpts configures an S3Store. Any service with an S3-compatible API can be used, for example Google Cloud
+// Storage with HMAC keys and the https://storage.googleapis.com endpoint, or MinIO.
+type S3StoreOpts struct {
+	// Endpoint is the URL of the service, defaults to the endpoint of the region on AWS
+	Endpoint string
+
+	Region string
+
+	Bucket string
+
+	// Prefix is prepended to the keys of the blobs
+	Prefix string
+
+	// AccessKeyID, SecretAccessKey and SessionToken are optional static credentials, the default credential chain of
+	// the AWS SDK is used if they aren't set
+	AccessKeyID string
+
+	SecretAccessKey string
+
+	SessionToken string
+
+	// PathStyle addresses the bucket in the path of the URL instead of the host, which is required by some
+	// S3-compatible services
+	PathStyle bool
+
+	HTTPClient *http.Client
+}
+
+// S3Store stores blobs as objects in a bucket.
+type S3Store struct {
+	client *s3.Client
+	bucket string
+	prefix string
+}
+
+func NewS3Store(ctx context.Context, opts S3StoreOpts) (*S3Store, error) {
+	if opts.Bucket == "" {
+		return nil, fmt.Errorf("bucket is required")
+	}
+
+	cfg, err := awsconfig.Load(ctx, awsconfig.Opts{
+		Region:          opts.Region,
+		AccessKeyID:     opts.AccessKeyID,
+		SecretAccessKey: opts.SecretAccessKey,
+		SessionToken:    opts.SessionToken,
+		HTTPClient:      opts.HTTPClient,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if opts.Endpoint != "" {
+			o.BaseEndpoint = aws.String(opts.Endpoint)
+		}
+
+		o.UsePathStyle = opts.PathStyle
+
+		// S3-compatible services don't necessarily support the checksums which the SDK adds to requests by default
+		o.RequestChecksumCalculation = aws.RequestChecksumCalculationWhenRequired
+		o.ResponseChecksumValidation = aws.ResponseChecksumValidationWhenRequired
+	})
+
+	return &S3Store{
+		client: client,
+		bucket: opts.Bucket,
+		prefix: opts.Prefix,
+	}, nil
+}
+
+func (s *S3Store) Put(ctx context.Context, key string, data []byte) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.prefix + key),
+		Body:   bytes.NewReader(data),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not put blob: %w", err)
+	}
+
+	return nil
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) ([]byte, error) {
+	res, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.prefix + key),
+	})
+
+	if isNotFound(err) {
+		return nil, blobstore.ErrNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get blob: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	return io.ReadAll(res.Body)
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.prefix + key),
+	})
+
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("could not delete blob: %w", err)
+	}
+
+	return nil
+}
+
+// isNotFound checks the status code of the response instead of the NoSuchKey error code, since not all S3-compatible
+// services return an error body for missing objects.
+func isNotFound(err error) bool {
+	var respErr *awshttp.ResponseError
+
+	return errors.As(err, &respErr) && respErr.HTTPStatusCode() == http.StatusNotFound
+}
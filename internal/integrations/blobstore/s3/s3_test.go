@@ -0,0 +1,122 @@
+package s3
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/blobstore"
+)
+
+func newTestServer(t *testing.T, accessKeyId string) (*httptest.Server, map[string][]byte) {
+	var mu sync.Mutex
+	objects := map[string][]byte{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+
+		if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential="+accessKeyId+"/") || r.Header.Get("x-amz-date") == "" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			objects[r.URL.EscapedPath()] = body
+		case http.MethodGet:
+			body, ok := objects[r.URL.EscapedPath()]
+
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			w.Write(body) // nolint: errcheck
+		case http.MethodDelete:
+			delete(objects, r.URL.EscapedPath())
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server, objects
+}
+
+func TestS3Store(t *testing.T) {
+	server, objects := newTestServer(t, "key-id")
+
+	ctx := context.Background()
+
+	store, err := NewS3Store(ctx, S3StoreOpts{
+		Endpoint:        server.URL,
+		Region:          "us-east-1",
+		Bucket:          "hatchet",
+		Prefix:          "payloads/",
+		AccessKeyID:     "key-id",
+		SecretAccessKey: "secret",
+		PathStyle:       true,
+	})
+
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(ctx, "tenant/key", []byte(`{"a": 1}`)))
+
+	_, ok := objects["/hatchet/payloads/tenant/key"]
+	assert.True(t, ok)
+
+	data, err := store.Get(ctx, "tenant/key")
+
+	require.NoError(t, err)
+	assert.Equal(t, `{"a": 1}`, string(data))
+
+	require.NoError(t, store.Delete(ctx, "tenant/key"))
+	require.NoError(t, store.Delete(ctx, "tenant/key"))
+
+	_, err = store.Get(ctx, "tenant/key")
+
+	assert.ErrorIs(t, err, blobstore.ErrNotFound)
+}
+
+func TestS3StoreDefaultCredentials(t *testing.T) {
+	server, objects := newTestServer(t, "env-key-id")
+
+	// without static credentials the credentials are read from the environment
+	t.Setenv("AWS_ACCESS_KEY_ID", "env-key-id")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	t.Setenv("AWS_SESSION_TOKEN", "token")
+	t.Setenv("AWS_REGION", "eu-west-1")
+
+	ctx := context.Background()
+
+	store, err := NewS3Store(ctx, S3StoreOpts{
+		Endpoint:  server.URL,
+		Bucket:    "hatchet",
+		PathStyle: true,
+	})
+
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(ctx, "key", []byte(`{}`)))
+
+	_, ok := objects["/hatchet/key"]
+	assert.True(t, ok)
+
+	_, err = NewS3Store(ctx, S3StoreOpts{
+		Bucket:      "hatchet",
+		AccessKeyID: "key-id",
+	})
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,383 @@
+// Package cloudevents reads and writes CloudEvents 1.0 in the JSON event format and the structured and binary modes
+// of the HTTP protocol binding.
+package cloudevents
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// SpecVersion is the version of the CloudEvents specification which is supported.
+	SpecVersion = "1.0"
+
+	// ContentType is the content type of events in the structured mode.
+	ContentType = "application/cloudevents+json"
+
+	batchContentType = "application/cloudevents-batch+json"
+
+	// headerPrefix is the prefix of the headers which contain the attributes of events in the binary mode.
+	headerPrefix = "Ce-"
+)
+
+// attributeNameRegex matches the names of attributes. Names should be at most 20 characters long, but longer names
+// are accepted.
+var attributeNameRegex = regexp.MustCompile(`^[a-z0-9]+$`)
+
+// contextAttributes are the attributes which are defined by the specification, the other attributes of an event are
+// extensions.
+var contextAttributes = map[string]bool{
+	"specversion":     true,
+	"id":              true,
+	"source":          true,
+	"type":            true,
+	"subject":         true,
+	"time":            true,
+	"datacontenttype": true,
+	"dataschema":      true,
+	"data":            true,
+	"data_base64":     true,
+}
+
+// Event is a CloudEvent. The data of an event is always JSON: data which isn't JSON is stored as a JSON string.
+type Event struct {
+	ID              string
+	Source          string
+	Type            string
+	Subject         string
+	Time            *time.Time
+	DataContentType string
+	DataSchema      string
+	Data            json.RawMessage
+
+	// Extensions are the extension attributes of the event, whose values are strings, numbers or booleans.
+	Extensions map[string]interface{}
+}
+
+// Validate returns an error if the event is missing a required attribute or has an invalid extension.
+func (e *Event) Validate() error {
+	switch {
+	case e.ID == "":
+		return fmt.Errorf("id is required")
+	case e.Source == "":
+		return fmt.Errorf("source is required")
+	case e.Type == "":
+		return fmt.Errorf("type is required")
+	}
+
+	for name, value := range e.Extensions {
+		if !attributeNameRegex.MatchString(name) {
+			return fmt.Errorf("invalid extension name %q, names must only contain lowercase letters and digits", name)
+		}
+
+		switch value.(type) {
+		case string, bool, float64, int, int32, int64:
+		default:
+			return fmt.Errorf("extension %s must be a string, number or boolean", name)
+		}
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the event in the JSON event format, which is used by the structured mode.
+func (e Event) MarshalJSON() ([]byte, error) {
+	attributes := make(map[string]interface{}, len(e.Extensions)+9)
+
+	for name, value := range e.Extensions {
+		attributes[name] = value
+	}
+
+	attributes["specversion"] = SpecVersion
+	attributes["id"] = e.ID
+	attributes["source"] = e.Source
+	attributes["type"] = e.Type
+
+	if e.Subject != "" {
+		attributes["subject"] = e.Subject
+	}
+
+	if e.Time != nil {
+		attributes["time"] = e.Time.UTC().Format(time.RFC3339Nano)
+	}
+
+	if e.DataContentType != "" {
+		attributes["datacontenttype"] = e.DataContentType
+	}
+
+	if e.DataSchema != "" {
+		attributes["dataschema"] = e.DataSchema
+	}
+
+	if len(e.Data) > 0 {
+		attributes["data"] = e.Data
+	}
+
+	return json.Marshal(attributes)
+}
+
+// UnmarshalJSON decodes an event in the JSON event format.
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var attributes map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		return fmt.Errorf("event must be a JSON object: %w", err)
+	}
+
+	var specVersion string
+
+	stringAttributes := map[string]*string{
+		"specversion":     &specVersion,
+		"id":              &e.ID,
+		"source":          &e.Source,
+		"type":            &e.Type,
+		"subject":         &e.Subject,
+		"datacontenttype": &e.DataContentType,
+		"dataschema":      &e.DataSchema,
+	}
+
+	for name, dest := range stringAttributes {
+		value, ok := attributes[name]
+
+		if !ok || string(value) == "null" {
+			continue
+		}
+
+		if err := json.Unmarshal(value, dest); err != nil {
+			return fmt.Errorf("%s must be a string", name)
+		}
+	}
+
+	if specVersion != SpecVersion {
+		return fmt.Errorf("unsupported specversion %q, only %s is supported", specVersion, SpecVersion)
+	}
+
+	if value, ok := attributes["time"]; ok && string(value) != "null" {
+		var timestamp string
+
+		if err := json.Unmarshal(value, &timestamp); err != nil {
+			return fmt.Errorf("time must be a string")
+		}
+
+		if err := e.setTime(timestamp); err != nil {
+			return err
+		}
+	}
+
+	data, hasData := attributes["data"]
+	dataBase64, hasDataBase64 := attributes["data_base64"]
+
+	switch {
+	case hasData && hasDataBase64:
+		return fmt.Errorf("only one of data and data_base64 may be set")
+	case hasData && string(data) != "null":
+		// JSON data is stored as a JSON value, and other data as a JSON string
+		e.Data = data
+
+		if !isJSONContentType(e.DataContentType) {
+			var text string
+
+			if err := json.Unmarshal(data, &text); err != nil {
+				return fmt.Errorf("data must be a string if the datacontenttype isn't JSON")
+			}
+
+			encoded, err := encodeData(e.DataContentType, []byte(text))
+
+			if err != nil {
+				return err
+			}
+
+			e.Data = encoded
+		}
+	case hasDataBase64 && string(dataBase64) != "null":
+		var encoded string
+
+		if err := json.Unmarshal(dataBase64, &encoded); err != nil {
+			return fmt.Errorf("data_base64 must be a string")
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+
+		if err != nil {
+			return fmt.Errorf("could not decode data_base64: %w", err)
+		}
+
+		e.Data, err = encodeData(e.DataContentType, decoded)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for name, value := range attributes {
+		if contextAttributes[name] {
+			continue
+		}
+
+		var extension interface{}
+
+		if err := json.Unmarshal(value, &extension); err != nil {
+			return err
+		}
+
+		if extension == nil {
+			continue
+		}
+
+		if e.Extensions == nil {
+			e.Extensions = make(map[string]interface{})
+		}
+
+		e.Extensions[name] = extension
+	}
+
+	return e.Validate()
+}
+
+// ParseHTTP reads an event from a request in the structured or the binary mode. Events in the structured mode have
+// the application/cloudevents+json content type, and events in the binary mode have their attributes in Ce- headers
+// and their data in the body.
+func ParseHTTP(header http.Header, body []byte) (*Event, error) {
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+
+	if err != nil {
+		mediaType = ""
+	}
+
+	switch {
+	case mediaType == ContentType:
+		e := &Event{}
+
+		if err := json.Unmarshal(body, e); err != nil {
+			return nil, err
+		}
+
+		return e, nil
+	case mediaType == batchContentType:
+		return nil, fmt.Errorf("batched events aren't supported, events must be sent one at a time")
+	case header.Get(headerPrefix+"Specversion") != "":
+		return parseBinary(header, body)
+	default:
+		return nil, fmt.Errorf("request is not a CloudEvent, it must have the %s content type or the %sspecversion header", ContentType, strings.ToLower(headerPrefix))
+	}
+}
+
+func parseBinary(header http.Header, body []byte) (*Event, error) {
+	if specVersion := header.Get(headerPrefix + "Specversion"); specVersion != SpecVersion {
+		return nil, fmt.Errorf("unsupported specversion %q, only %s is supported", specVersion, SpecVersion)
+	}
+
+	e := &Event{
+		DataContentType: header.Get("Content-Type"),
+	}
+
+	for name, values := range header {
+		if !strings.HasPrefix(name, headerPrefix) || len(values) == 0 {
+			continue
+		}
+
+		attribute := strings.ToLower(strings.TrimPrefix(name, headerPrefix))
+
+		// the values of headers are percent-encoded
+		value, err := url.PathUnescape(values[0])
+
+		if err != nil {
+			value = values[0]
+		}
+
+		switch attribute {
+		case "specversion":
+		case "id":
+			e.ID = value
+		case "source":
+			e.Source = value
+		case "type":
+			e.Type = value
+		case "subject":
+			e.Subject = value
+		case "dataschema":
+			e.DataSchema = value
+		case "time":
+			if err := e.setTime(value); err != nil {
+				return nil, err
+			}
+		case "datacontenttype", "data", "data_base64":
+			return nil, fmt.Errorf("the %s attribute can't be set in a header", attribute)
+		default:
+			if e.Extensions == nil {
+				e.Extensions = make(map[string]interface{})
+			}
+
+			e.Extensions[attribute] = value
+		}
+	}
+
+	if len(body) > 0 {
+		data, err := encodeData(e.DataContentType, body)
+
+		if err != nil {
+			return nil, err
+		}
+
+		e.Data = data
+	}
+
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func (e *Event) setTime(timestamp string) error {
+	t, err := time.Parse(time.RFC3339Nano, timestamp)
+
+	if err != nil {
+		return fmt.Errorf("time must be an RFC 3339 timestamp: %w", err)
+	}
+
+	e.Time = &t
+
+	return nil
+}
+
+// encodeData returns data with a JSON content type as is, and encodes other data as a JSON string.
+func encodeData(contentType string, data []byte) (json.RawMessage, error) {
+	if isJSONContentType(contentType) {
+		if !json.Valid(data) {
+			return nil, fmt.Errorf("data is not valid JSON")
+		}
+
+		return bytes.TrimSpace(data), nil
+	}
+
+	if !utf8.Valid(data) {
+		return nil, fmt.Errorf("binary data isn't supported, data must be JSON or text")
+	}
+
+	return json.Marshal(string(data))
+}
+
+// isJSONContentType returns whether data with the content type is JSON. Data without a content type is JSON.
+func isJSONContentType(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/json" || mediaType == "text/json" || strings.HasSuffix(mediaType, "+json")
+}
@@ -0,0 +1,141 @@
+package cloudevents
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHTTP(t *testing.T) {
+	t.Run("structured", func(t *testing.T) {
+		header := http.Header{}
+		header.Set("Content-Type", "application/cloudevents+json; charset=utf-8")
+
+		e, err := ParseHTTP(header, []byte(`{
+			"specversion": "1.0",
+			"id": "A234-1234-1234",
+			"source": "https://github.com/cloudevents/spec/pull",
+			"type": "com.github.pull_request.opened",
+			"subject": "123",
+			"time": "2018-04-05T17:31:00Z",
+			"datacontenttype": "application/json",
+			"comexampleextension1": "value",
+			"comexampleothervalue": 5,
+			"data": {"number": 123}
+		}`))
+
+		require.NoError(t, err)
+
+		assert.Equal(t, "A234-1234-1234", e.ID)
+		assert.Equal(t, "https://github.com/cloudevents/spec/pull", e.Source)
+		assert.Equal(t, "com.github.pull_request.opened", e.Type)
+		assert.Equal(t, "123", e.Subject)
+		assert.Equal(t, time.Date(2018, 4, 5, 17, 31, 0, 0, time.UTC), *e.Time)
+		assert.JSONEq(t, `{"number": 123}`, string(e.Data))
+		assert.Equal(t, map[string]interface{}{"comexampleextension1": "value", "comexampleothervalue": float64(5)}, e.Extensions)
+	})
+
+	t.Run("structured with base64 data", func(t *testing.T) {
+		header := http.Header{}
+		header.Set("Content-Type", ContentType)
+
+		e, err := ParseHTTP(header, []byte(`{"specversion":"1.0","id":"1","source":"/s","type":"t","datacontenttype":"text/plain","data_base64":"aGVsbG8="}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, `"hello"`, string(e.Data))
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		header := http.Header{}
+		header.Set("Content-Type", "application/json")
+		header.Set("ce-specversion", "1.0")
+		header.Set("ce-id", "1")
+		header.Set("ce-source", "/orders")
+		header.Set("ce-type", "order.created")
+		header.Set("ce-traceparent", "00-abc%20def-01")
+
+		e, err := ParseHTTP(header, []byte(`{"id": 42}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, "order.created", e.Type)
+		assert.Equal(t, "application/json", e.DataContentType)
+		assert.JSONEq(t, `{"id": 42}`, string(e.Data))
+		assert.Equal(t, map[string]interface{}{"traceparent": "00-abc def-01"}, e.Extensions)
+	})
+
+	t.Run("binary with text data", func(t *testing.T) {
+		header := http.Header{}
+		header.Set("Content-Type", "text/plain")
+		header.Set("ce-specversion", "1.0")
+		header.Set("ce-id", "1")
+		header.Set("ce-source", "/orders")
+		header.Set("ce-type", "order.created")
+
+		e, err := ParseHTTP(header, []byte(`hello`))
+		require.NoError(t, err)
+
+		assert.Equal(t, `"hello"`, string(e.Data))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		structured := http.Header{}
+		structured.Set("Content-Type", ContentType)
+
+		tests := []struct {
+			name   string
+			header http.Header
+			body   string
+		}{
+			{"not a cloudevent", http.Header{"Content-Type": []string{"application/json"}}, `{}`},
+			{"batch", http.Header{"Content-Type": []string{"application/cloudevents-batch+json"}}, `[]`},
+			{"missing type", structured, `{"specversion":"1.0","id":"1","source":"/s"}`},
+			{"unsupported version", structured, `{"specversion":"0.3","id":"1","source":"/s","type":"t"}`},
+			{"invalid extension name", structured, `{"specversion":"1.0","id":"1","source":"/s","type":"t","my-ext":"x"}`},
+			{"object extension", structured, `{"specversion":"1.0","id":"1","source":"/s","type":"t","ext":{}}`},
+			{"invalid json data", http.Header{"Ce-Specversion": []string{"1.0"}, "Ce-Id": []string{"1"}, "Ce-Source": []string{"/s"}, "Ce-Type": []string{"t"}}, `not json`},
+		}
+
+		for _, tt := range tests {
+			_, err := ParseHTTP(tt.header, []byte(tt.body))
+			assert.Error(t, err, tt.name)
+		}
+	})
+}
+
+func TestMarshalJSON(t *testing.T) {
+	now := time.Date(2025, 1, 2, 9, 35, 12, 0, time.UTC)
+
+	e := Event{
+		ID:              "1",
+		Source:          "/tenants/707d0855-80ab-4e1f-a156-f1c4546cbf52",
+		Type:            "run.hatchet.workflow_run.succeeded",
+		Time:            &now,
+		DataContentType: "application/json",
+		Data:            json.RawMessage(`{"id":"1"}`),
+		Extensions:      map[string]interface{}{"partitionkey": "abc"},
+	}
+
+	data, err := json.Marshal(e)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"specversion": "1.0",
+		"id": "1",
+		"source": "/tenants/707d0855-80ab-4e1f-a156-f1c4546cbf52",
+		"type": "run.hatchet.workflow_run.succeeded",
+		"time": "2025-01-02T09:35:12Z",
+		"datacontenttype": "application/json",
+		"partitionkey": "abc",
+		"data": {"id": "1"}
+	}`, string(data))
+
+	decoded := &Event{}
+	require.NoError(t, json.Unmarshal(data, decoded))
+
+	assert.Equal(t, e.Extensions, decoded.Extensions)
+	assert.JSONEq(t, string(e.Data), string(decoded.Data))
+}
@@ -0,0 +1,179 @@
+// Package sqs provides a client for receiving and deleting the messages of an Amazon SQS queue.
+package sqs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+)
+
+// MaxMessages is the maximum number of messages which can be received or deleted in a single request.
+const MaxMessages = 10
+
+// ClientOpts configures a Client.
+type ClientOpts struct {
+	// QueueURL is the url of the queue, like https://sqs.us-east-1.amazonaws.com/123456789012/my-queue. Requests are
+	// sent to the host of the queue url.
+	QueueURL string
+
+	Region string
+
+	AccessKeyID string
+
+	SecretAccessKey string
+
+	// SessionToken is only required for temporary credentials
+	SessionToken string
+
+	HTTPClient *http.Client
+}
+
+// Client receives and deletes the messages of a queue with the AWS SDK. The credentials of a queue belong to a
+// tenant, so the client only uses the static credentials of its options and never the default credential chain of
+// the SDK, which would authenticate with the credentials of the engine.
+type Client struct {
+	queueURL string
+	client   *sqs.Client
+}
+
+// Message is a message which was received from a queue.
+type Message struct {
+	MessageId string
+
+	ReceiptHandle string
+
+	Body string
+
+	// Attributes are the system attributes of the message, like SentTimestamp
+	Attributes map[string]string
+
+	MessageAttributes map[string]MessageAttribute
+}
+
+type MessageAttribute struct {
+	DataType string
+
+	StringValue string
+}
+
+func NewClient(opts ClientOpts) (*Client, error) {
+	if opts.Region == "" {
+		return nil, fmt.Errorf("region is required")
+	}
+
+	if opts.AccessKeyID == "" || opts.SecretAccessKey == "" {
+		return nil, fmt.Errorf("access key id and secret access key are required")
+	}
+
+	queueURL, err := url.Parse(opts.QueueURL)
+
+	if err != nil || queueURL.Host == "" || (queueURL.Scheme != "https" && queueURL.Scheme != "http") {
+		return nil, fmt.Errorf("invalid queue url %q", opts.QueueURL)
+	}
+
+	sqsOpts := sqs.Options{
+		Region: opts.Region,
+		Credentials: aws.NewCredentialsCache(
+			credentials.NewStaticCredentialsProvider(opts.AccessKeyID, opts.SecretAccessKey, opts.SessionToken),
+		),
+		BaseEndpoint: aws.String(queueURL.Scheme + "://" + queueURL.Host),
+	}
+
+	if opts.HTTPClient != nil {
+		sqsOpts.HTTPClient = opts.HTTPClient
+	}
+
+	return &Client{
+		queueURL: opts.QueueURL,
+		client:   sqs.New(sqsOpts),
+	}, nil
+}
+
+// ReceiveMessages long polls the queue for up to waitTime, and returns up to maxMessages messages. The messages are
+// hidden from other consumers for the visibility timeout of the queue, and are delivered again if they aren't deleted
+// before it expires.
+func (c *Client) ReceiveMessages(ctx context.Context, maxMessages int, waitTime time.Duration) ([]*Message, error) {
+	output, err := c.client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:                    aws.String(c.queueURL),
+		MaxNumberOfMessages:         int32(min(max(maxMessages, 1), MaxMessages)),
+		WaitTimeSeconds:             int32(waitTime.Seconds()),
+		MessageSystemAttributeNames: []types.MessageSystemAttributeName{types.MessageSystemAttributeNameAll},
+		MessageAttributeNames:       []string{"All"},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not receive sqs messages: %w", err)
+	}
+
+	messages := make([]*Message, len(output.Messages))
+
+	for i, message := range output.Messages {
+		attributes := make(map[string]MessageAttribute, len(message.MessageAttributes))
+
+		for name, attribute := range message.MessageAttributes {
+			attributes[name] = MessageAttribute{
+				DataType:    aws.ToString(attribute.DataType),
+				StringValue: aws.ToString(attribute.StringValue),
+			}
+		}
+
+		messages[i] = &Message{
+			MessageId:         aws.ToString(message.MessageId),
+			ReceiptHandle:     aws.ToString(message.ReceiptHandle),
+			Body:              aws.ToString(message.Body),
+			Attributes:        message.Attributes,
+			MessageAttributes: attributes,
+		}
+	}
+
+	return messages, nil
+}
+
+// DeleteMessages deletes up to MaxMessages messages by their receipt handles, so they aren't delivered again.
+func (c *Client) DeleteMessages(ctx context.Context, receiptHandles []string) error {
+	if len(receiptHandles) == 0 {
+		return nil
+	}
+
+	if len(receiptHandles) > MaxMessages {
+		return fmt.Errorf("at most %d messages can be deleted at once", MaxMessages)
+	}
+
+	entries := make([]types.DeleteMessageBatchRequestEntry, len(receiptHandles))
+
+	for i, handle := range receiptHandles {
+		entries[i] = types.DeleteMessageBatchRequestEntry{
+			Id:            aws.String(strconv.Itoa(i)),
+			ReceiptHandle: aws.String(handle),
+		}
+	}
+
+	output, err := c.client.DeleteMessageBatch(ctx, &sqs.DeleteMessageBatchInput{
+		QueueUrl: aws.String(c.queueURL),
+		Entries:  entries,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not delete sqs messages: %w", err)
+	}
+
+	if len(output.Failed) > 0 {
+		return fmt.Errorf(
+			"could not delete %d of %d messages: %s: %s",
+			len(output.Failed),
+			len(receiptHandles),
+			aws.ToString(output.Failed[0].Code),
+			aws.ToString(output.Failed[0].Message),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package sqs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/aws/smithy-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient(t *testing.T) {
+	var mu sync.Mutex
+	queue := []*Message{
+		{MessageId: "m1", ReceiptHandle: "r1", Body: `{"id":1}`},
+		{MessageId: "m2", ReceiptHandle: "r2", Body: `{"id":2}`},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+
+		if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=key-id/") || !strings.Contains(auth, "/us-east-1/sqs/aws4_request") {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"__type":"com.amazonaws.sqs#InvalidClientTokenId","message":"invalid token"}`))
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		body := map[string]interface{}{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		if !strings.HasSuffix(body["QueueUrl"].(string), "/123456789012/orders") {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"com.amazonaws.sqs#QueueDoesNotExist","message":"no queue"}`))
+			return
+		}
+
+		switch r.Header.Get("X-Amz-Target") {
+		case "AmazonSQS.ReceiveMessage":
+			n := min(int(body["MaxNumberOfMessages"].(float64)), len(queue))
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"Messages": queue[:n]})
+		case "AmazonSQS.DeleteMessageBatch":
+			for _, e := range body["Entries"].([]interface{}) {
+				handle := e.(map[string]interface{})["ReceiptHandle"].(string)
+
+				for i, m := range queue {
+					if m.ReceiptHandle == handle {
+						queue = append(queue[:i], queue[i+1:]...)
+						break
+					}
+				}
+			}
+
+			_, _ = w.Write([]byte(`{"Successful":[]}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+
+	defer server.Close()
+
+	newClient := func(accessKeyId, queue string) *Client {
+		c, err := NewClient(ClientOpts{
+			QueueURL:        server.URL + "/123456789012/" + queue,
+			Region:          "us-east-1",
+			AccessKeyID:     accessKeyId,
+			SecretAccessKey: "secret",
+		})
+
+		require.NoError(t, err)
+
+		return c
+	}
+
+	ctx := context.Background()
+	c := newClient("key-id", "orders")
+
+	messages, err := c.ReceiveMessages(ctx, 1, time.Second)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.Equal(t, "m1", messages[0].MessageId)
+	assert.Equal(t, `{"id":1}`, messages[0].Body)
+
+	require.NoError(t, c.DeleteMessages(ctx, []string{messages[0].ReceiptHandle}))
+
+	messages, err = c.ReceiveMessages(ctx, MaxMessages, time.Second)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.Equal(t, "m2", messages[0].MessageId)
+
+	_, err = newClient("other", "orders").ReceiveMessages(ctx, MaxMessages, time.Second)
+
+	var apiErr smithy.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "InvalidClientTokenId", apiErr.ErrorCode())
+
+	var respErr *awshttp.ResponseError
+	require.True(t, errors.As(err, &respErr))
+	assert.Equal(t, http.StatusForbidden, respErr.HTTPStatusCode())
+
+	_, err = newClient("key-id", "missing").ReceiveMessages(ctx, MaxMessages, time.Second)
+
+	var notFound *types.QueueDoesNotExist
+	assert.True(t, errors.As(err, &notFound))
+}
@@ -0,0 +1,229 @@
+// Package webhook verifies the signatures of requests to inbound webhooks.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// ErrInvalidSignature is returned when the signature of a request is missing or doesn't match the request.
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// Tolerance is how far the timestamp of a signed request may be from the current time, for the schemes which sign
+// a timestamp. Older requests are rejected so that captured requests can't be replayed.
+const Tolerance = 5 * time.Minute
+
+const svixSecretPrefix = "whsec_"
+
+// ValidateSecret returns an error if the secret can't be used with the scheme.
+func ValidateSecret(scheme dbsqlc.InboundWebhookSignatureScheme, secret string) error {
+	if secret == "" {
+		return fmt.Errorf("secret is required")
+	}
+
+	if scheme == dbsqlc.InboundWebhookSignatureSchemeSVIX {
+		if _, err := svixKey(secret); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Verify verifies the signature of a request with the scheme of a webhook. The signature header is only used by the
+// HMAC_SHA256 scheme, the other schemes read the headers set by their providers.
+func Verify(scheme dbsqlc.InboundWebhookSignatureScheme, secret, signatureHeader string, header http.Header, body []byte) error {
+	return verify(scheme, secret, signatureHeader, header, body, time.Now())
+}
+
+func verify(scheme dbsqlc.InboundWebhookSignatureScheme, secret, signatureHeader string, header http.Header, body []byte, now time.Time) error {
+	switch scheme {
+	case dbsqlc.InboundWebhookSignatureSchemeHMACSHA256:
+		return verifyHMAC(secret, header.Get(signatureHeader), body)
+	case dbsqlc.InboundWebhookSignatureSchemeGITHUB:
+		signature := header.Get("X-Hub-Signature-256")
+
+		if !strings.HasPrefix(signature, "sha256=") {
+			return ErrInvalidSignature
+		}
+
+		return verifyHMAC(secret, signature, body)
+	case dbsqlc.InboundWebhookSignatureSchemeSTRIPE:
+		return verifyStripe(secret, header.Get("Stripe-Signature"), body, now)
+	case dbsqlc.InboundWebhookSignatureSchemeSVIX:
+		return verifySvix(secret, header, body, now)
+	default:
+		return fmt.Errorf("unknown signature scheme %s", scheme)
+	}
+}
+
+// DeliveryId returns the id which the provider of a scheme sets on a delivery, so that a request which is retried
+// doesn't trigger duplicate workflow runs. It returns an empty string if the delivery has no id.
+func DeliveryId(scheme dbsqlc.InboundWebhookSignatureScheme, header http.Header, body []byte) string {
+	switch scheme {
+	case dbsqlc.InboundWebhookSignatureSchemeGITHUB:
+		return header.Get("X-GitHub-Delivery")
+	case dbsqlc.InboundWebhookSignatureSchemeSTRIPE:
+		// Stripe retries deliveries of the same event, which has a unique id
+		event := struct {
+			Id string `json:"id"`
+		}{}
+
+		if err := json.Unmarshal(body, &event); err != nil {
+			return ""
+		}
+
+		return event.Id
+	case dbsqlc.InboundWebhookSignatureSchemeSVIX:
+		if id := header.Get("svix-id"); id != "" {
+			return id
+		}
+
+		return header.Get("webhook-id")
+	default:
+		return ""
+	}
+}
+
+// verifyHMAC verifies a hex or base64 encoded HMAC-SHA256 signature of the body, which may be prefixed with sha256=.
+func verifyHMAC(secret, signature string, body []byte) error {
+	signature = strings.TrimPrefix(strings.TrimSpace(signature), "sha256=")
+
+	if signature == "" {
+		return ErrInvalidSignature
+	}
+
+	expected := sign([]byte(secret), body)
+
+	if decoded, err := hex.DecodeString(signature); err == nil && hmac.Equal(decoded, expected) {
+		return nil
+	}
+
+	if decoded, err := base64.StdEncoding.DecodeString(signature); err == nil && hmac.Equal(decoded, expected) {
+		return nil
+	}
+
+	return ErrInvalidSignature
+}
+
+// verifyStripe verifies a Stripe-Signature header, like t=1492774577,v1=5257a869...
+func verifyStripe(secret, header string, body []byte, now time.Time) error {
+	var timestamp string
+	var signatures []string
+
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+
+		if !ok {
+			continue
+		}
+
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+
+	if err := checkTimestamp(timestamp, now); err != nil {
+		return err
+	}
+
+	expected := sign([]byte(secret), []byte(timestamp+"."+string(body)))
+
+	for _, signature := range signatures {
+		if decoded, err := hex.DecodeString(signature); err == nil && hmac.Equal(decoded, expected) {
+			return nil
+		}
+	}
+
+	return ErrInvalidSignature
+}
+
+// verifySvix verifies the signature headers which Svix and other Standard Webhooks providers set. The headers are
+// prefixed with svix- or webhook-.
+func verifySvix(secret string, header http.Header, body []byte, now time.Time) error {
+	prefix := "svix-"
+
+	if header.Get("svix-id") == "" {
+		prefix = "webhook-"
+	}
+
+	id := header.Get(prefix + "id")
+	timestamp := header.Get(prefix + "timestamp")
+
+	if id == "" {
+		return ErrInvalidSignature
+	}
+
+	if err := checkTimestamp(timestamp, now); err != nil {
+		return err
+	}
+
+	key, err := svixKey(secret)
+
+	if err != nil {
+		return err
+	}
+
+	expected := sign(key, []byte(id+"."+timestamp+"."+string(body)))
+
+	// the header contains space separated signatures, like v1,g0hM9SsE+OTPJTGt/tmIKtSyZlE3uFJELVlNIOLJ1OE=
+	for _, signature := range strings.Fields(header.Get(prefix + "signature")) {
+		version, value, ok := strings.Cut(signature, ",")
+
+		if !ok || version != "v1" {
+			continue
+		}
+
+		if decoded, err := base64.StdEncoding.DecodeString(value); err == nil && hmac.Equal(decoded, expected) {
+			return nil
+		}
+	}
+
+	return ErrInvalidSignature
+}
+
+func svixKey(secret string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(secret, svixSecretPrefix))
+
+	if err != nil {
+		return nil, fmt.Errorf("svix secrets must be base64 encoded and prefixed with %s", svixSecretPrefix)
+	}
+
+	return key, nil
+}
+
+// checkTimestamp checks that a unix timestamp in seconds is within the tolerance of the current time.
+func checkTimestamp(timestamp string, now time.Time) error {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	if math.Abs(now.Sub(time.Unix(seconds, 0)).Seconds()) > Tolerance.Seconds() {
+		return fmt.Errorf("%w: timestamp is outside of the tolerance", ErrInvalidSignature)
+	}
+
+	return nil
+}
+
+func sign(key, data []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(data) // nolint: errcheck
+	return h.Sum(nil)
+}
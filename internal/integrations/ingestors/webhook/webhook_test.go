@@ -0,0 +1,86 @@
+package webhook
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(1735632000, 0)
+	body := []byte(`{"id":"evt_1","type":"invoice.paid"}`)
+
+	signature := func(key []byte, data string) []byte {
+		return sign(key, []byte(data))
+	}
+
+	t.Run("hmac sha256", func(t *testing.T) {
+		header := http.Header{}
+		header.Set("X-Signature", hex.EncodeToString(signature([]byte("secret"), string(body))))
+
+		assert.NoError(t, verify(dbsqlc.InboundWebhookSignatureSchemeHMACSHA256, "secret", "X-Signature", header, body, now))
+
+		header.Set("X-Signature", base64.StdEncoding.EncodeToString(signature([]byte("secret"), string(body))))
+
+		assert.NoError(t, verify(dbsqlc.InboundWebhookSignatureSchemeHMACSHA256, "secret", "X-Signature", header, body, now))
+
+		err := verify(dbsqlc.InboundWebhookSignatureSchemeHMACSHA256, "other", "X-Signature", header, body, now)
+		assert.True(t, errors.Is(err, ErrInvalidSignature))
+
+		err = verify(dbsqlc.InboundWebhookSignatureSchemeHMACSHA256, "secret", "X-Missing", header, body, now)
+		assert.True(t, errors.Is(err, ErrInvalidSignature))
+	})
+
+	t.Run("github", func(t *testing.T) {
+		header := http.Header{}
+		header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(signature([]byte("secret"), string(body))))
+		header.Set("X-GitHub-Delivery", "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+
+		assert.NoError(t, verify(dbsqlc.InboundWebhookSignatureSchemeGITHUB, "secret", "", header, body, now))
+		assert.Error(t, verify(dbsqlc.InboundWebhookSignatureSchemeGITHUB, "secret", "", header, []byte(`{}`), now))
+		assert.Equal(t, "72d3162e-cc78-11e3-81ab-4c9367dc0958", DeliveryId(dbsqlc.InboundWebhookSignatureSchemeGITHUB, header, body))
+	})
+
+	t.Run("stripe", func(t *testing.T) {
+		timestamp := fmt.Sprint(now.Unix())
+		valid := hex.EncodeToString(signature([]byte("whsec_test"), timestamp+"."+string(body)))
+
+		header := http.Header{}
+		header.Set("Stripe-Signature", fmt.Sprintf("t=%s,v1=%s,v1=%s", timestamp, "00", valid))
+
+		assert.NoError(t, verify(dbsqlc.InboundWebhookSignatureSchemeSTRIPE, "whsec_test", "", header, body, now))
+		assert.Equal(t, "evt_1", DeliveryId(dbsqlc.InboundWebhookSignatureSchemeSTRIPE, header, body))
+
+		// replayed requests are rejected
+		err := verify(dbsqlc.InboundWebhookSignatureSchemeSTRIPE, "whsec_test", "", header, body, now.Add(10*time.Minute))
+		assert.True(t, errors.Is(err, ErrInvalidSignature))
+	})
+
+	t.Run("svix", func(t *testing.T) {
+		key := []byte("svix-signing-key")
+		secret := svixSecretPrefix + base64.StdEncoding.EncodeToString(key)
+		timestamp := fmt.Sprint(now.Unix())
+
+		header := http.Header{}
+		header.Set("svix-id", "msg_1")
+		header.Set("svix-timestamp", timestamp)
+		header.Set("svix-signature", "v1,"+base64.StdEncoding.EncodeToString(signature(key, "msg_1."+timestamp+"."+string(body))))
+
+		assert.NoError(t, ValidateSecret(dbsqlc.InboundWebhookSignatureSchemeSVIX, secret))
+		assert.NoError(t, verify(dbsqlc.InboundWebhookSignatureSchemeSVIX, secret, "", header, body, now))
+		assert.Equal(t, "msg_1", DeliveryId(dbsqlc.InboundWebhookSignatureSchemeSVIX, header, body))
+
+		header.Set("svix-id", "msg_2")
+		assert.Error(t, verify(dbsqlc.InboundWebhookSignatureSchemeSVIX, secret, "", header, body, now))
+
+		assert.Error(t, ValidateSecret(dbsqlc.InboundWebhookSignatureSchemeSVIX, "whsec_not base64!"))
+	})
+}
@@ -0,0 +1,157 @@
+package awssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/awsconfig"
+	"github.com/hatchet-dev/hatchet/internal/integrations/secrets"
+)
+
+// AWSSecretsManagerStoreOpts configures an AWSSecretsManagerStore.
+type AWSSecretsManagerStoreOpts struct {
+	// Endpoint is the URL of the service, defaults to the endpoint of the region
+	Endpoint string
+
+	Region string
+
+	// Prefix is prepended to the names of the secrets, which are stored as <prefix><tenant id>/<name>
+	Prefix string
+
+	// AccessKeyID, SecretAccessKey and SessionToken are optional static credentials, the default credential chain of
+	// the AWS SDK is used if they aren't set
+	AccessKeyID string
+
+	SecretAccessKey string
+
+	SessionToken string
+
+	HTTPClient *http.Client
+}
+
+// AWSSecretsManagerStore stores secrets in AWS Secrets Manager.
+type AWSSecretsManagerStore struct {
+	client *secretsmanager.Client
+	prefix string
+}
+
+func NewAWSSecretsManagerStore(ctx context.Context, opts AWSSecretsManagerStoreOpts) (*AWSSecretsManagerStore, error) {
+	cfg, err := awsconfig.Load(ctx, awsconfig.Opts{
+		Region:          opts.Region,
+		AccessKeyID:     opts.AccessKeyID,
+		SecretAccessKey: opts.SecretAccessKey,
+		SessionToken:    opts.SessionToken,
+		HTTPClient:      opts.HTTPClient,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	client := secretsmanager.NewFromConfig(cfg, func(o *secretsmanager.Options) {
+		if opts.Endpoint != "" {
+			o.BaseEndpoint = aws.String(opts.Endpoint)
+		}
+	})
+
+	return &AWSSecretsManagerStore{
+		client: client,
+		prefix: opts.Prefix,
+	}, nil
+}
+
+func (s *AWSSecretsManagerStore) GetSecret(ctx context.Context, tenantId, name string) (string, error) {
+	secretId, err := s.secretId(tenantId, name)
+
+	if err != nil {
+		return "", err
+	}
+
+	res, err := s.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(secretId),
+	})
+
+	var notFound *types.ResourceNotFoundException
+
+	if errors.As(err, &notFound) {
+		return "", secrets.ErrNotFound
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("could not get secret: %w", err)
+	}
+
+	if res.SecretString == nil {
+		return "", fmt.Errorf("secret %s is not a string", name)
+	}
+
+	return *res.SecretString, nil
+}
+
+// PutSecret creates the secret, or stores a new version of the secret if it exists.
+func (s *AWSSecretsManagerStore) PutSecret(ctx context.Context, tenantId, name, value string) error {
+	secretId, err := s.secretId(tenantId, name)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.CreateSecret(ctx, &secretsmanager.CreateSecretInput{
+		Name:               aws.String(secretId),
+		SecretString:       aws.String(value),
+		ClientRequestToken: aws.String(uuid.New().String()),
+	})
+
+	var exists *types.ResourceExistsException
+
+	if errors.As(err, &exists) {
+		_, err = s.client.PutSecretValue(ctx, &secretsmanager.PutSecretValueInput{
+			SecretId:           aws.String(secretId),
+			SecretString:       aws.String(value),
+			ClientRequestToken: aws.String(uuid.New().String()),
+		})
+	}
+
+	if err != nil {
+		return fmt.Errorf("could not put secret: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSecret deletes the secret right away, without a recovery window.
+func (s *AWSSecretsManagerStore) DeleteSecret(ctx context.Context, tenantId, name string) error {
+	secretId, err := s.secretId(tenantId, name)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.DeleteSecret(ctx, &secretsmanager.DeleteSecretInput{
+		SecretId:                   aws.String(secretId),
+		ForceDeleteWithoutRecovery: aws.Bool(true),
+	})
+
+	var notFound *types.ResourceNotFoundException
+
+	if err != nil && !errors.As(err, &notFound) {
+		return fmt.Errorf("could not delete secret: %w", err)
+	}
+
+	return nil
+}
+
+func (s *AWSSecretsManagerStore) secretId(tenantId, name string) (string, error) {
+	if err := secrets.ValidateName(name); err != nil {
+		return "", err
+	}
+
+	return s.prefix + tenantId + "/" + name, nil
+}
@@ -0,0 +1,103 @@
+package awssm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/secrets"
+)
+
+func TestAWSSecretsManagerStore(t *testing.T) {
+	var mu sync.Mutex
+	values := map[string]string{}
+
+	writeErr := func(w http.ResponseWriter, errType string) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]string{"__type": errType, "message": "error"})
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+
+		if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=key-id/") || !strings.Contains(auth, "/secretsmanager/aws4_request") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		body := map[string]interface{}{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		switch r.Header.Get("X-Amz-Target") {
+		case "secretsmanager.CreateSecret":
+			name := body["Name"].(string)
+
+			if _, ok := values[name]; ok {
+				writeErr(w, "ResourceExistsException")
+				return
+			}
+
+			values[name] = body["SecretString"].(string)
+			_, _ = w.Write([]byte(`{}`))
+		case "secretsmanager.PutSecretValue":
+			values[body["SecretId"].(string)] = body["SecretString"].(string)
+			_, _ = w.Write([]byte(`{}`))
+		case "secretsmanager.GetSecretValue":
+			value, ok := values[body["SecretId"].(string)]
+
+			if !ok {
+				writeErr(w, "ResourceNotFoundException")
+				return
+			}
+
+			_ = json.NewEncoder(w).Encode(map[string]string{"SecretString": value})
+		case "secretsmanager.DeleteSecret":
+			delete(values, body["SecretId"].(string))
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+
+	defer server.Close()
+
+	ctx := context.Background()
+
+	store, err := NewAWSSecretsManagerStore(ctx, AWSSecretsManagerStoreOpts{
+		Endpoint:        server.URL,
+		Region:          "us-east-1",
+		Prefix:          "hatchet/",
+		AccessKeyID:     "key-id",
+		SecretAccessKey: "secret",
+	})
+
+	require.NoError(t, err)
+
+	require.NoError(t, store.PutSecret(ctx, "tenant-1", "API_KEY", "v1"))
+	require.NoError(t, store.PutSecret(ctx, "tenant-1", "API_KEY", "v2"))
+	assert.Equal(t, "v2", values["hatchet/tenant-1/API_KEY"])
+
+	value, err := store.GetSecret(ctx, "tenant-1", "API_KEY")
+	require.NoError(t, err)
+	assert.Equal(t, "v2", value)
+
+	_, err = store.GetSecret(ctx, "tenant-2", "API_KEY")
+	assert.ErrorIs(t, err, secrets.ErrNotFound)
+
+	require.NoError(t, store.DeleteSecret(ctx, "tenant-1", "API_KEY"))
+	require.NoError(t, store.DeleteSecret(ctx, "tenant-1", "API_KEY"))
+
+	_, err = store.GetSecret(ctx, "tenant-1", "API_KEY")
+	assert.ErrorIs(t, err, secrets.ErrNotFound)
+}
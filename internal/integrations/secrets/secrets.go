@@ -0,0 +1,164 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/cache"
+)
+
+// ErrNotFound is returned by a SecretStore when a tenant has no secret with a name.
+var ErrNotFound = errors.New("secret not found")
+
+// SecretStore stores the secrets of tenants. Secrets are scoped to a tenant, so a tenant can only read its own
+// secrets.
+type SecretStore interface {
+	GetSecret(ctx context.Context, tenantId, name string) (string, error)
+
+	PutSecret(ctx context.Context, tenantId, name, value string) error
+
+	DeleteSecret(ctx context.Context, tenantId, name string) error
+}
+
+var nameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ValidateName returns an error if the name can't be used for a secret. Names may only contain letters, digits and
+// underscores, and may not start with a digit.
+func ValidateName(name string) error {
+	if !nameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid secret name %q: names may only contain letters, digits and underscores", name)
+	}
+
+	return nil
+}
+
+// templateRegexp matches a reference to a secret, like {{ secrets.API_KEY }}
+var templateRegexp = regexp.MustCompile(`\{\{\s*secrets\.([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// resolvedFields are the fields of the input of a step run in which references to secrets are resolved: the input
+// of the workflow run and the user data of the step. Outputs of parent steps are never resolved, so that a secret
+// can't be read back through the output of a step.
+var resolvedFields = []string{"input", "user_data"}
+
+// Resolver replaces references to secrets in the input of a step run with the values of the secrets, right before
+// the input is sent to a worker. A nil Resolver leaves the input as is.
+type Resolver struct {
+	Store SecretStore
+
+	// CacheTTL is how long the value of a secret is cached in memory. Secrets are not cached if it's 0.
+	CacheTTL time.Duration
+
+	cache *cache.TTLCache[string, string]
+}
+
+func NewResolver(store SecretStore, cacheTTL time.Duration) *Resolver {
+	return &Resolver{
+		Store:    store,
+		CacheTTL: cacheTTL,
+		cache:    cache.NewTTL[string, string](),
+	}
+}
+
+// Resolve returns the input of a step run with all references to secrets replaced. The result must only be sent to
+// the worker, and never persisted.
+func (r *Resolver) Resolve(ctx context.Context, tenantId string, input []byte) ([]byte, error) {
+	if r == nil || r.Store == nil || !bytes.Contains(input, []byte("secrets.")) {
+		return input, nil
+	}
+
+	data := map[string]json.RawMessage{}
+
+	if err := json.Unmarshal(input, &data); err != nil {
+		// payloads which were moved to a blob store are not objects with these fields, and are sent as is
+		return input, nil
+	}
+
+	changed := false
+
+	for _, field := range resolvedFields {
+		v, ok := data[field]
+
+		if !ok || !templateRegexp.Match(v) {
+			continue
+		}
+
+		resolved, err := r.resolveValue(ctx, tenantId, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		data[field] = resolved
+		changed = true
+	}
+
+	if !changed {
+		return input, nil
+	}
+
+	return json.Marshal(data)
+}
+
+// resolveValue replaces the references in a JSON value. References can only appear in JSON strings, so the values
+// of the secrets are escaped as JSON string contents.
+func (r *Resolver) resolveValue(ctx context.Context, tenantId string, v []byte) ([]byte, error) {
+	escaped := map[string][]byte{}
+
+	for _, match := range templateRegexp.FindAllSubmatch(v, -1) {
+		name := string(match[1])
+
+		if _, ok := escaped[name]; ok {
+			continue
+		}
+
+		value, err := r.GetSecret(ctx, tenantId, name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		quoted, err := json.Marshal(value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		escaped[name] = quoted[1 : len(quoted)-1]
+	}
+
+	return templateRegexp.ReplaceAllFunc(v, func(match []byte) []byte {
+		return escaped[string(templateRegexp.FindSubmatch(match)[1])]
+	}), nil
+}
+
+// GetSecret returns the value of a secret of a tenant, which is cached for the cache TTL of the resolver.
+func (r *Resolver) GetSecret(ctx context.Context, tenantId, name string) (string, error) {
+	cacheKey := tenantId + "/" + name
+
+	if r.CacheTTL > 0 && r.cache != nil {
+		if value, ok := r.cache.Get(cacheKey); ok {
+			return value, nil
+		}
+	}
+
+	value, err := r.Store.GetSecret(ctx, tenantId, name)
+
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return "", fmt.Errorf("secret %s is not set: %w", name, err)
+		}
+
+		return "", fmt.Errorf("could not get secret %s: %w", name, err)
+	}
+
+	if r.CacheTTL > 0 && r.cache != nil {
+		r.cache.Set(cacheKey, value, r.CacheTTL)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,128 @@
+package secrets
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryStore struct {
+	mu      sync.Mutex
+	secrets map[string]string
+	gets    int
+}
+
+func (s *memoryStore) GetSecret(ctx context.Context, tenantId, name string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.gets++
+
+	value, ok := s.secrets[tenantId+"/"+name]
+
+	if !ok {
+		return "", ErrNotFound
+	}
+
+	return value, nil
+}
+
+func (s *memoryStore) PutSecret(ctx context.Context, tenantId, name, value string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.secrets[tenantId+"/"+name] = value
+
+	return nil
+}
+
+func (s *memoryStore) DeleteSecret(ctx context.Context, tenantId, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.secrets, tenantId+"/"+name)
+
+	return nil
+}
+
+func TestResolve(t *testing.T) {
+	store := &memoryStore{
+		secrets: map[string]string{
+			"tenant-1/API_KEY":  `sk-"quoted"`,
+			"tenant-1/PASSWORD": "hunter2",
+		},
+	}
+
+	r := NewResolver(store, 0)
+
+	input := []byte(`{
+		"input": {"auth": "Bearer {{ secrets.API_KEY }}", "password": "{{secrets.PASSWORD}}"},
+		"user_data": {"key": "{{ secrets.API_KEY }}"},
+		"parents": {"step-1": {"leaked": "{{ secrets.API_KEY }}"}},
+		"triggered_by": "manual"
+	}`)
+
+	resolved, err := r.Resolve(context.Background(), "tenant-1", input)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"input": {"auth": "Bearer sk-\"quoted\"", "password": "hunter2"},
+		"user_data": {"key": "sk-\"quoted\""},
+		"parents": {"step-1": {"leaked": "{{ secrets.API_KEY }}"}},
+		"triggered_by": "manual"
+	}`, string(resolved))
+
+	// each secret is only fetched once per input
+	assert.Equal(t, 3, store.gets)
+}
+
+func TestResolveMissingSecret(t *testing.T) {
+	r := NewResolver(&memoryStore{secrets: map[string]string{"tenant-2/API_KEY": "secret"}}, 0)
+
+	_, err := r.Resolve(context.Background(), "tenant-1", []byte(`{"input": {"key": "{{ secrets.API_KEY }}"}}`))
+
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestResolveCache(t *testing.T) {
+	store := &memoryStore{secrets: map[string]string{"tenant-1/API_KEY": "secret"}}
+	r := NewResolver(store, time.Minute)
+
+	input := []byte(`{"input": {"key": "{{ secrets.API_KEY }}"}}`)
+
+	for i := 0; i < 3; i++ {
+		resolved, err := r.Resolve(context.Background(), "tenant-1", input)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"input": {"key": "secret"}}`, string(resolved))
+	}
+
+	assert.Equal(t, 1, store.gets)
+}
+
+func TestResolveWithoutReferences(t *testing.T) {
+	var nilResolver *Resolver
+
+	input := []byte(`{"input": {"key": "value"}}`)
+
+	resolved, err := nilResolver.Resolve(context.Background(), "tenant-1", input)
+	require.NoError(t, err)
+	assert.Equal(t, input, resolved)
+
+	r := NewResolver(&memoryStore{}, 0)
+
+	resolved, err = r.Resolve(context.Background(), "tenant-1", input)
+	require.NoError(t, err)
+	assert.Equal(t, input, resolved)
+}
+
+func TestValidateName(t *testing.T) {
+	assert.NoError(t, ValidateName("API_KEY"))
+	assert.NoError(t, ValidateName("_key2"))
+	assert.Error(t, ValidateName("2KEY"))
+	assert.Error(t, ValidateName("../KEY"))
+	assert.Error(t, ValidateName(""))
+}
@@ -0,0 +1,153 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/secrets"
+)
+
+// VaultStoreOpts configures a VaultStore.
+type VaultStoreOpts struct {
+	// Address is the URL of the Vault server, for example https://vault.example.com:8200
+	Address string
+
+	// Token defaults to the VAULT_TOKEN environment variable
+	Token string
+
+	// Namespace is the Vault Enterprise namespace, if any
+	Namespace string
+
+	// Mount is the path which the KV version 2 secrets engine is mounted at, defaults to secret
+	Mount string
+
+	// Prefix is prepended to the paths of the secrets, which are stored at <prefix><tenant id>/<name>
+	Prefix string
+
+	HTTPClient *http.Client
+}
+
+// VaultStore stores secrets in the KV version 2 secrets engine of HashiCorp Vault. Each secret is stored at its own
+// path, with the value in the "value" key.
+type VaultStore struct {
+	kv     *api.KVv2
+	prefix string
+}
+
+func NewVaultStore(opts VaultStoreOpts) (*VaultStore, error) {
+	if opts.Address == "" {
+		return nil, fmt.Errorf("address is required")
+	}
+
+	if opts.Mount == "" {
+		opts.Mount = "secret"
+	}
+
+	config := api.DefaultConfig()
+
+	if config.Error != nil {
+		return nil, fmt.Errorf("could not load vault config: %w", config.Error)
+	}
+
+	config.Address = opts.Address
+
+	if opts.HTTPClient != nil {
+		config.HttpClient = opts.HTTPClient
+	}
+
+	client, err := api.NewClient(config)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create vault client: %w", err)
+	}
+
+	if opts.Token != "" {
+		client.SetToken(opts.Token)
+	}
+
+	if client.Token() == "" {
+		return nil, fmt.Errorf("token is required")
+	}
+
+	if opts.Namespace != "" {
+		client.SetNamespace(opts.Namespace)
+	}
+
+	return &VaultStore{
+		kv:     client.KVv2(strings.Trim(opts.Mount, "/")),
+		prefix: opts.Prefix,
+	}, nil
+}
+
+func (s *VaultStore) GetSecret(ctx context.Context, tenantId, name string) (string, error) {
+	path, err := s.path(tenantId, name)
+
+	if err != nil {
+		return "", err
+	}
+
+	secret, err := s.kv.Get(ctx, path)
+
+	if errors.Is(err, api.ErrSecretNotFound) {
+		return "", secrets.ErrNotFound
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("could not get secret: %w", err)
+	}
+
+	// the data is empty if the latest version of the secret was deleted
+	value, ok := secret.Data["value"].(string)
+
+	if !ok {
+		return "", secrets.ErrNotFound
+	}
+
+	return value, nil
+}
+
+func (s *VaultStore) PutSecret(ctx context.Context, tenantId, name, value string) error {
+	path, err := s.path(tenantId, name)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = s.kv.Put(ctx, path, map[string]interface{}{
+		"value": value,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not put secret: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSecret deletes all versions of the secret.
+func (s *VaultStore) DeleteSecret(ctx context.Context, tenantId, name string) error {
+	path, err := s.path(tenantId, name)
+
+	if err != nil {
+		return err
+	}
+
+	if err := s.kv.DeleteMetadata(ctx, path); err != nil {
+		return fmt.Errorf("could not delete secret: %w", err)
+	}
+
+	return nil
+}
+
+func (s *VaultStore) path(tenantId, name string) (string, error) {
+	if err := secrets.ValidateName(name); err != nil {
+		return "", err
+	}
+
+	return s.prefix + tenantId + "/" + name, nil
+}
@@ -0,0 +1,101 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/secrets"
+)
+
+func TestVaultStore(t *testing.T) {
+	var mu sync.Mutex
+	values := map[string]string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		path := r.URL.Path
+
+		metadata := map[string]interface{}{
+			"created_time":  "2024-01-01T00:00:00Z",
+			"deletion_time": "",
+			"destroyed":     false,
+			"version":       1,
+		}
+
+		switch {
+		case r.Method == http.MethodPut && strings.HasPrefix(path, "/v1/kv/data/"):
+			body := struct {
+				Data map[string]string `json:"data"`
+			}{}
+
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			values[strings.TrimPrefix(path, "/v1/kv/data/")] = body.Data["value"]
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": metadata})
+		case r.Method == http.MethodGet && strings.HasPrefix(path, "/v1/kv/data/"):
+			value, ok := values[strings.TrimPrefix(path, "/v1/kv/data/")]
+
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]interface{}{
+					"data":     map[string]string{"value": value},
+					"metadata": metadata,
+				},
+			})
+		case r.Method == http.MethodDelete && strings.HasPrefix(path, "/v1/kv/metadata/"):
+			delete(values, strings.TrimPrefix(path, "/v1/kv/metadata/"))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+
+	defer server.Close()
+
+	store, err := NewVaultStore(VaultStoreOpts{
+		Address: server.URL,
+		Token:   "token",
+		Mount:   "kv",
+		Prefix:  "hatchet/",
+	})
+
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	require.NoError(t, store.PutSecret(ctx, "tenant-1", "API_KEY", "secret"))
+	assert.Equal(t, "secret", values["hatchet/tenant-1/API_KEY"])
+
+	value, err := store.GetSecret(ctx, "tenant-1", "API_KEY")
+	require.NoError(t, err)
+	assert.Equal(t, "secret", value)
+
+	_, err = store.GetSecret(ctx, "tenant-2", "API_KEY")
+	assert.ErrorIs(t, err, secrets.ErrNotFound)
+
+	require.NoError(t, store.DeleteSecret(ctx, "tenant-1", "API_KEY"))
+
+	_, err = store.GetSecret(ctx, "tenant-1", "API_KEY")
+	assert.ErrorIs(t, err, secrets.ErrNotFound)
+
+	_, err = store.GetSecret(ctx, "tenant-1", "../other-tenant/API_KEY")
+	assert.Error(t, err)
+}
@@ -0,0 +1,124 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var factory = promauto.With(DefaultRegistry)
+
+// The engine metrics are registered in the default registry and updated by the services which own the
+// corresponding state.
+var (
+	// QueueDepth is the number of queued step runs per tenant and queue, it's refreshed on every scrape
+	QueueDepth = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "hatchet_queue_depth",
+			Help: "The number of step runs which are waiting in a queue to be assigned.",
+		},
+		[]string{"tenant_id", "queue"},
+	)
+
+	// AssignmentDuration is the time between the scheduler reading queue items and their assignments being written,
+	// which is observed once per batch of assignments
+	AssignmentDuration = factory.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "hatchet_assignment_duration_seconds",
+			Help:    "The time it took the scheduler to assign a batch of step runs after reading them from the queue.",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"tenant_id"},
+	)
+
+	// DispatchErrors counts the step run actions which could not be sent to a worker
+	DispatchErrors = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hatchet_dispatch_errors_total",
+			Help: "The number of step run actions which could not be sent to a worker.",
+		},
+		[]string{"tenant_id"},
+	)
+
+	// Leases is the number of worker and queue leases which this engine holds per tenant
+	Leases = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "hatchet_leases",
+			Help: "The number of leases held by the scheduler of this engine.",
+		},
+		[]string{"tenant_id", "kind"},
+	)
+
+	// StepRunTransitions counts the status changes of step runs which were written by this engine
+	StepRunTransitions = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hatchet_step_run_transitions_total",
+			Help: "The number of step run status transitions, by the status which the step run transitioned to.",
+		},
+		[]string{"status"},
+	)
+
+	// RejectedTransitions counts the status updates of runs which were rejected, since the state machine doesn't allow
+	// the run to transition from its current status to the new status
+	RejectedTransitions = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hatchet_rejected_transitions_total",
+			Help: "The number of status transitions of runs which were rejected since they're not allowed, by kind of run and status.",
+		},
+		[]string{"kind", "from", "to"},
+	)
+
+	// RecoveredItems counts the inconsistent step runs, worker slots and queue items which were repaired by the
+	// recovery pass on startup
+	RecoveredItems = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hatchet_recovered_items_total",
+			Help: "The number of inconsistent step runs, worker slots and queue items which were repaired by the recovery pass on startup, by kind.",
+		},
+		[]string{"tenant_id", "kind"},
+	)
+
+	// RetentionPrunedRows counts the soft-deleted rows which were permanently deleted by the retention pruner
+	RetentionPrunedRows = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hatchet_retention_pruned_rows_total",
+			Help: "The number of soft-deleted rows which were permanently deleted by the retention pruner, by table.",
+		},
+		[]string{"tenant_id", "table"},
+	)
+
+	// RetentionArchivedRows counts the rows which were written to the blob storage before they were pruned
+	RetentionArchivedRows = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hatchet_retention_archived_rows_total",
+			Help: "The number of rows which were archived to the blob storage by the retention pruner, by table.",
+		},
+		[]string{"tenant_id", "table"},
+	)
+
+	// RetentionArchivedWorkflowRuns counts the finished workflow runs whose details were moved to the blob storage
+	RetentionArchivedWorkflowRuns = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hatchet_retention_archived_workflow_runs_total",
+			Help: "The number of finished workflow runs whose job runs, step runs, events and payloads were moved to the blob storage.",
+		},
+		[]string{"tenant_id"},
+	)
+
+	// RetentionDroppedPartitions counts the partitions which were dropped by the partition maintenance
+	RetentionDroppedPartitions = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hatchet_retention_dropped_partitions_total",
+			Help: "The number of partitions which were dropped once their rows were pruned, by table.",
+		},
+		[]string{"table"},
+	)
+
+	// DBPoolConnections is the number of connections of the database pools by state, it's refreshed on every scrape
+	DBPoolConnections = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "hatchet_db_pool_connections",
+			Help: "The number of connections of a database pool, by state. The max state is the size limit of the pool.",
+		},
+		[]string{"pool", "state"},
+	)
+)
@@ -0,0 +1,27 @@
+// Package metrics defines the Prometheus metrics which the engine exposes.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// DefaultRegistry is the registry which the engine metrics are registered in, along with the metrics of the Go
+// runtime and the process. It's separate from the global registry of the Prometheus client, so that dependencies
+// can't add metrics to the engine's endpoint.
+var DefaultRegistry = prometheus.NewRegistry()
+
+func init() {
+	DefaultRegistry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// Handler returns an http handler which serves the metrics of the default registry
+func Handler() http.Handler {
+	return promhttp.HandlerFor(DefaultRegistry, promhttp.HandlerOpts{})
+}
@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func scrape(t *testing.T) string {
+	t.Helper()
+
+	srv := httptest.NewServer(Handler())
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL)
+	require.NoError(t, err)
+
+	defer res.Body.Close()
+
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Contains(t, res.Header.Get("Content-Type"), "text/plain")
+
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	return string(body)
+}
+
+func TestHandler(t *testing.T) {
+	StepRunTransitions.WithLabelValues("SUCCEEDED").Add(3)
+	QueueDepth.WithLabelValues("a", `quoted "queue"`).Set(5)
+	AssignmentDuration.WithLabelValues("a").Observe(0.05)
+
+	body := scrape(t)
+
+	assert.Contains(t, body, "# TYPE hatchet_step_run_transitions_total counter\n")
+	assert.Contains(t, body, `hatchet_step_run_transitions_total{status="SUCCEEDED"} 3`)
+	assert.Contains(t, body, `hatchet_queue_depth{queue="quoted \"queue\"",tenant_id="a"} 5`)
+	assert.Contains(t, body, `hatchet_assignment_duration_seconds_bucket{tenant_id="a",le="0.05"} 1`)
+	assert.Contains(t, body, `hatchet_assignment_duration_seconds_count{tenant_id="a"} 1`)
+
+	// the runtime metrics are registered along with the engine metrics
+	assert.Contains(t, body, "go_goroutines")
+
+	QueueDepth.DeleteLabelValues("a", `quoted "queue"`)
+
+	assert.NotContains(t, scrape(t), "hatchet_queue_depth{")
+}
+
+func TestLabelValues(t *testing.T) {
+	assert.Panics(t, func() { StepRunTransitions.WithLabelValues().Inc() })
+	assert.Panics(t, func() { RetentionPrunedRows.WithLabelValues("a").Inc() })
+}
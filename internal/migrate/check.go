@@ -0,0 +1,243 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Impact is how a statement affects the queries on its table while it runs.
+type Impact string
+
+const (
+	// ImpactNone is a catalog-only change, which only holds its lock for a moment
+	ImpactNone Impact = "none"
+
+	// ImpactOnline is a change which runs for a while but doesn't block reads or writes
+	ImpactOnline Impact = "online"
+
+	// ImpactBlocksWrites blocks the writes to the table until the statement is done
+	ImpactBlocksWrites Impact = "blocks writes"
+
+	// ImpactBlocksAll blocks the reads and writes of the table until the statement is done
+	ImpactBlocksAll Impact = "blocks reads and writes"
+)
+
+// work is the work which a statement does on the rows of its table, which is used to estimate its duration.
+type work int
+
+const (
+	workNone work = iota
+	workScan
+	workWrite
+)
+
+// Rough throughputs which are used to estimate how long statements take. They're conservative, since the actual
+// throughputs depend on the hardware and load of the database.
+const (
+	scanBytesPerSecond  = 200 << 20
+	writeBytesPerSecond = 50 << 20
+)
+
+// Analysis describes what a statement does to its table.
+type Analysis struct {
+	// Operation is a short description of the statement, like "create index"
+	Operation string
+
+	// Table is the table which is locked by the statement, it's empty if the statement doesn't lock a table
+	Table  string
+	Impact Impact
+
+	work work
+}
+
+type StatementReport struct {
+	Migration string
+	Statement string
+	Analysis
+
+	// Rows and Bytes are the estimated size of the table, they're 0 if the table doesn't exist yet
+	Rows  int64
+	Bytes int64
+
+	// Duration is the estimated time the statement runs for
+	Duration time.Duration
+}
+
+type Report struct {
+	Pending    []*Migration
+	Statements []*StatementReport
+
+	// Downtime is the estimated time for which the statements which block reads or writes hold their locks
+	Downtime time.Duration
+}
+
+var (
+	tableName          = `(?:IF\s+EXISTS\s+)?(?:ONLY\s+)?(?:"?[A-Za-z_][A-Za-z0-9_$]*"?\.)?("(?:[^"]|"")+"|[A-Za-z_][A-Za-z0-9_$]*)`
+	createIndexRegexp  = regexp.MustCompile(`(?is)^CREATE\s+(?:UNIQUE\s+)?INDEX\s+(CONCURRENTLY\s+)?.*?\sON\s+` + tableName)
+	alterTableRegexp   = regexp.MustCompile(`(?is)^ALTER\s+TABLE\s+` + tableName + `\s+(.*)$`)
+	updateRegexp       = regexp.MustCompile(`(?is)^(UPDATE|DELETE\s+FROM|INSERT\s+INTO)\s+` + tableName)
+	volatileDefault    = regexp.MustCompile(`(?is)\bDEFAULT\s+[^,]*\b(gen_random_uuid|uuid_generate_v4|random|clock_timestamp)\s*\(`)
+	alterColumnType    = regexp.MustCompile(`(?is)\bALTER\s+(?:COLUMN\s+)?\S+\s+(?:SET\s+DATA\s+)?TYPE\b`)
+	setNotNull         = regexp.MustCompile(`(?is)\bSET\s+NOT\s+NULL\b`)
+	addForeignKey      = regexp.MustCompile(`(?is)\bADD\s+(?:CONSTRAINT\s+\S+\s+)?FOREIGN\s+KEY\b`)
+	addCheck           = regexp.MustCompile(`(?is)\bADD\s+(?:CONSTRAINT\s+\S+\s+)?CHECK\b`)
+	addUnique          = regexp.MustCompile(`(?is)\bADD\s+(?:CONSTRAINT\s+\S+\s+)?(?:UNIQUE|PRIMARY\s+KEY)\b`)
+	usingIndex         = regexp.MustCompile(`(?is)\bUSING\s+INDEX\b`)
+	notValid           = regexp.MustCompile(`(?is)\bNOT\s+VALID\b`)
+	validateConstraint = regexp.MustCompile(`(?is)\bVALIDATE\s+CONSTRAINT\b`)
+)
+
+// Analyze estimates what a statement does to its table. The analysis is based on the locks which Postgres takes for
+// the statement, and is conservative when a statement could go either way.
+func Analyze(stmt *Statement) Analysis {
+	sql := strings.TrimSpace(stmt.SQL)
+
+	if m := createIndexRegexp.FindStringSubmatch(sql); m != nil {
+		if m[1] != "" {
+			return Analysis{Operation: "create index concurrently", Table: identifier(m[2]), Impact: ImpactOnline, work: workWrite}
+		}
+
+		return Analysis{Operation: "create index", Table: identifier(m[2]), Impact: ImpactBlocksWrites, work: workWrite}
+	}
+
+	if m := updateRegexp.FindStringSubmatch(sql); m != nil {
+		operation := strings.ToLower(strings.Fields(m[1])[0])
+
+		if stmt.Backfill {
+			return Analysis{Operation: "batched " + operation, Table: identifier(m[2]), Impact: ImpactOnline, work: workWrite}
+		}
+
+		// the rows are locked until the statement is committed
+		return Analysis{Operation: operation, Table: identifier(m[2]), Impact: ImpactBlocksWrites, work: workWrite}
+	}
+
+	if m := alterTableRegexp.FindStringSubmatch(sql); m != nil {
+		return analyzeAlterTable(identifier(m[1]), m[2])
+	}
+
+	return Analysis{Operation: operation(sql), Impact: ImpactNone}
+}
+
+// analyzeAlterTable returns the analysis of the most expensive action of an ALTER TABLE statement.
+func analyzeAlterTable(table, actions string) Analysis {
+	res := Analysis{Operation: "alter table", Table: table, Impact: ImpactNone}
+
+	switch {
+	case alterColumnType.MatchString(actions) || volatileDefault.MatchString(actions):
+		res.Operation = "rewrite table"
+		res.Impact = ImpactBlocksAll
+		res.work = workWrite
+	case addUnique.MatchString(actions) && !usingIndex.MatchString(actions):
+		res.Operation = "build constraint index"
+		res.Impact = ImpactBlocksAll
+		res.work = workWrite
+	case setNotNull.MatchString(actions):
+		res.Operation = "validate not null"
+		res.Impact = ImpactBlocksAll
+		res.work = workScan
+	case addCheck.MatchString(actions) && !notValid.MatchString(actions):
+		res.Operation = "validate check constraint"
+		res.Impact = ImpactBlocksAll
+		res.work = workScan
+	case addForeignKey.MatchString(actions) && !notValid.MatchString(actions):
+		res.Operation = "validate foreign key"
+		res.Impact = ImpactBlocksWrites
+		res.work = workScan
+	case validateConstraint.MatchString(actions):
+		res.Operation = "validate constraint"
+		res.Impact = ImpactOnline
+		res.work = workScan
+	}
+
+	return res
+}
+
+// operation returns the first words of a statement which doesn't lock a table, like "create table".
+func operation(sql string) string {
+	words := strings.Fields(sql)
+
+	if len(words) > 2 {
+		words = words[:2]
+	}
+
+	return strings.ToLower(strings.TrimSuffix(strings.Join(words, " "), ";"))
+}
+
+// Check estimates the impact of the pending migrations on the tables of the database, without applying them.
+func (r *Runner) Check(ctx context.Context, migrations []*Migration) (*Report, error) {
+	toApply, _, err := r.pending(ctx, migrations)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Report{}
+	sizes := make(map[string][2]int64)
+
+	for _, m := range toApply {
+		res.Pending = append(res.Pending, m.Migration)
+
+		for _, stmt := range m.Statements[m.Applied:] {
+			report := &StatementReport{
+				Migration: m.File,
+				Statement: summarize(stmt.SQL),
+				Analysis:  Analyze(stmt),
+			}
+
+			if report.Table != "" {
+				size, ok := sizes[report.Table]
+
+				if !ok {
+					size, err = r.tableSize(ctx, report.Table)
+
+					if err != nil {
+						return nil, err
+					}
+
+					sizes[report.Table] = size
+				}
+
+				report.Rows, report.Bytes = size[0], size[1]
+			}
+
+			switch report.work {
+			case workScan:
+				report.Duration = time.Duration(float64(report.Bytes) / scanBytesPerSecond * float64(time.Second))
+			case workWrite:
+				report.Duration = time.Duration(float64(report.Bytes) / writeBytesPerSecond * float64(time.Second))
+			}
+
+			if report.Impact == ImpactBlocksWrites || report.Impact == ImpactBlocksAll {
+				res.Downtime += report.Duration
+			}
+
+			res.Statements = append(res.Statements, report)
+		}
+	}
+
+	return res, nil
+}
+
+// tableSize returns the estimated number of rows and the size of a table, including the partitions of partitioned
+// tables. Tables which don't exist have no rows.
+func (r *Runner) tableSize(ctx context.Context, table string) ([2]int64, error) {
+	var rows, bytes int64
+
+	err := r.conn.QueryRow(ctx, `
+SELECT
+    COALESCE(SUM(GREATEST(c.reltuples, 0)), 0)::bigint,
+    COALESCE(SUM(pg_table_size(c.oid)), 0)::bigint
+FROM
+    pg_partition_tree(to_regclass(quote_ident($1))) t
+JOIN
+    pg_class c ON c.oid = t.relid`, table).Scan(&rows, &bytes)
+
+	if err != nil {
+		return [2]int64{}, fmt.Errorf("could not get size of %s: %w", table, err)
+	}
+
+	return [2]int64{rows, bytes}, nil
+}
@@ -0,0 +1,396 @@
+// Package migrate applies the migrations of sql/migrations without blocking the tables of large installations for
+// longer than necessary, and estimates the impact of the pending migrations before they're applied. Applied
+// migrations are recorded in the revisions table of Atlas, so the runner and `atlas migrate apply` can be used
+// interchangeably.
+package migrate
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+type TxMode string
+
+const (
+	// TxModeFile runs all the statements of a migration in a single transaction, which is the default of Atlas
+	TxModeFile TxMode = "file"
+
+	// TxModeNone runs every statement of a migration in its own transaction, which is required by concurrent index
+	// builds and batched backfills
+	TxModeNone TxMode = "none"
+)
+
+const (
+	txModeDirective   = "atlas:txmode"
+	backfillDirective = "hatchet:backfill"
+	sumFile           = "atlas.sum"
+)
+
+type Statement struct {
+	SQL string
+
+	// Backfill is true if the statement is preceded by a `-- hatchet:backfill` comment. Backfills are run
+	// repeatedly, each time in a new transaction, until they don't affect any rows, so they must limit the number of
+	// rows which they update at a time.
+	Backfill bool
+}
+
+type Migration struct {
+	// Version is the timestamp prefix of the file name, like 20250125091530
+	Version string
+
+	// Description is the rest of the file name, like v0.52.60
+	Description string
+
+	File       string
+	TxMode     TxMode
+	Statements []*Statement
+
+	// Hash is the hash of the file in atlas.sum, which is recorded in the revisions table
+	Hash string
+}
+
+// Load reads the migrations of a directory, ordered by version. The files are checked against atlas.sum, so
+// migrations which were modified after they were hashed aren't applied.
+func Load(dir string) ([]*Migration, error) {
+	return LoadFS(os.DirFS(dir))
+}
+
+// LoadFS reads the migrations at the root of a file system like Load, which is used for the migrations which are
+// embedded in the binaries.
+func LoadFS(fsys fs.FS) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read migrations: %w", err)
+	}
+
+	names := make([]string, 0, len(entries))
+
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".sql") {
+			names = append(names, entry.Name())
+		}
+	}
+
+	sort.Strings(names)
+
+	contents := make([][]byte, len(names))
+
+	for i, name := range names {
+		contents[i], err = fs.ReadFile(fsys, name)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not read migration %s: %w", name, err)
+		}
+	}
+
+	hashes, err := checkSum(fsys, names, contents)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*Migration, len(names))
+
+	for i, name := range names {
+		m, err := Parse(name, string(contents[i]))
+
+		if err != nil {
+			return nil, fmt.Errorf("could not parse migration %s: %w", name, err)
+		}
+
+		m.Hash = hashes[name]
+		res[i] = m
+	}
+
+	return res, nil
+}
+
+// Parse parses a migration file.
+func Parse(name, src string) (*Migration, error) {
+	version, description, ok := strings.Cut(strings.TrimSuffix(name, ".sql"), "_")
+
+	if !ok || version == "" {
+		return nil, fmt.Errorf("file name %q doesn't start with a version", name)
+	}
+
+	stmts, header, err := splitStatements(src)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Migration{
+		Version:     version,
+		Description: description,
+		File:        name,
+		TxMode:      TxModeFile,
+		Statements:  stmts,
+	}
+
+	// like Atlas, the transaction mode is set by a comment at the top of the file
+	for _, comment := range header {
+		if mode, ok := directive(comment, txModeDirective); ok {
+			switch TxMode(mode) {
+			case TxModeFile, TxModeNone:
+				m.TxMode = TxMode(mode)
+			default:
+				return nil, fmt.Errorf("unknown transaction mode %q", mode)
+			}
+		}
+	}
+
+	for _, stmt := range m.Statements {
+		if stmt.Backfill && m.TxMode != TxModeNone {
+			return nil, errors.New("backfills must run in their own transactions, add `-- atlas:txmode none` to the top of the file")
+		}
+	}
+
+	return m, nil
+}
+
+// directive returns the argument of a directive comment like `-- atlas:txmode none`.
+func directive(comment, name string) (string, bool) {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(strings.TrimPrefix(comment, "--")), name)
+
+	if !ok || (rest != "" && rest[0] != ' ') {
+		return "", false
+	}
+
+	return strings.TrimSpace(rest), true
+}
+
+var dollarTagRegexp = regexp.MustCompile(`^\$([A-Za-z_][A-Za-z0-9_]*)?\$`)
+
+// splitStatements splits a migration into its statements, along with the comments at the top of the file. Semicolons
+// in strings, quoted identifiers, comments and dollar-quoted bodies don't end a statement.
+func splitStatements(src string) ([]*Statement, []string, error) {
+	var (
+		stmts    []*Statement
+		header   []string
+		comments []string
+		buf      strings.Builder
+		inStmt   bool
+	)
+
+	flush := func() {
+		if inStmt {
+			stmt := &Statement{
+				SQL: strings.TrimSpace(buf.String()),
+			}
+
+			for _, comment := range comments {
+				if _, ok := directive(comment, backfillDirective); ok {
+					stmt.Backfill = true
+				}
+			}
+
+			if len(stmts) == 0 {
+				header = comments
+			}
+
+			stmts = append(stmts, stmt)
+		}
+
+		buf.Reset()
+		comments = nil
+		inStmt = false
+	}
+
+	for i := 0; i < len(src); {
+		c := src[i]
+
+		switch {
+		case strings.HasPrefix(src[i:], "--"):
+			end := strings.IndexByte(src[i:], '\n')
+
+			if end == -1 {
+				end = len(src) - i
+			}
+
+			if inStmt {
+				buf.WriteString(src[i : i+end])
+			} else {
+				comments = append(comments, strings.TrimSpace(src[i:i+end]))
+			}
+
+			i += end
+		case strings.HasPrefix(src[i:], "/*"):
+			end, err := blockCommentEnd(src, i)
+
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if inStmt {
+				buf.WriteString(src[i:end])
+			}
+
+			i = end
+		case c == '\'' || c == '"':
+			// E'...' strings allow backslash escapes
+			escapes := c == '\'' && i > 0 && (src[i-1] == 'E' || src[i-1] == 'e')
+
+			end, err := quoteEnd(src, i, escapes)
+
+			if err != nil {
+				return nil, nil, err
+			}
+
+			buf.WriteString(src[i:end])
+			inStmt = true
+			i = end
+		case c == '$' && dollarTagRegexp.MatchString(src[i:]):
+			tag := dollarTagRegexp.FindString(src[i:])
+			end := strings.Index(src[i+len(tag):], tag)
+
+			if end == -1 {
+				return nil, nil, fmt.Errorf("unterminated dollar-quoted string %s", tag)
+			}
+
+			end = i + len(tag) + end + len(tag)
+
+			buf.WriteString(src[i:end])
+			inStmt = true
+			i = end
+		case c == ';':
+			if inStmt {
+				buf.WriteByte(c)
+			}
+
+			flush()
+			i++
+		default:
+			if !inStmt && (c == ' ' || c == '\t' || c == '\n' || c == '\r') {
+				i++
+				continue
+			}
+
+			buf.WriteByte(c)
+			inStmt = true
+			i++
+		}
+	}
+
+	if len(stmts) == 0 && !inStmt {
+		header = comments
+	}
+
+	flush()
+
+	return stmts, header, nil
+}
+
+// blockCommentEnd returns the end of the block comment which starts at i. Block comments can be nested.
+func blockCommentEnd(src string, i int) (int, error) {
+	depth := 0
+
+	for j := i; j < len(src)-1; j++ {
+		switch src[j : j+2] {
+		case "/*":
+			depth++
+			j++
+		case "*/":
+			depth--
+			j++
+
+			if depth == 0 {
+				return j + 1, nil
+			}
+		}
+	}
+
+	return 0, errors.New("unterminated block comment")
+}
+
+// quoteEnd returns the end of the string or quoted identifier which starts at i. Quotes are escaped by doubling them.
+func quoteEnd(src string, i int, escapes bool) (int, error) {
+	quote := src[i]
+
+	for j := i + 1; j < len(src); j++ {
+		switch {
+		case escapes && src[j] == '\\':
+			j++
+		case src[j] == quote:
+			if j+1 < len(src) && src[j+1] == quote {
+				j++
+				continue
+			}
+
+			return j + 1, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unterminated quote %c", quote)
+}
+
+// checkSum checks the migrations against atlas.sum like Atlas, and returns the hash of every file. The hash of a file
+// covers the files before it, so a migration can't be modified or inserted once later migrations were hashed.
+func checkSum(fsys fs.FS, names []string, contents [][]byte) (map[string]string, error) {
+	f, err := fsys.Open(sumFile)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s: %w", sumFile, err)
+	}
+
+	defer f.Close() // nolint: errcheck
+
+	expected := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+
+	var total string
+
+	for first := true; scanner.Scan(); first = false {
+		line := scanner.Text()
+
+		if first {
+			total = strings.TrimPrefix(line, "h1:")
+			continue
+		}
+
+		name, hash, ok := strings.Cut(line, " ")
+
+		if ok {
+			expected[name] = strings.TrimPrefix(hash, "h1:")
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read %s: %w", sumFile, err)
+	}
+
+	res := make(map[string]string, len(names))
+	h := sha256.New()
+	sum := sha256.New()
+
+	for i, name := range names {
+		h.Write([]byte(name))
+		h.Write(contents[i])
+
+		hash := base64.StdEncoding.EncodeToString(h.Sum(nil))
+
+		if expected[name] != hash {
+			return nil, fmt.Errorf("migration %s doesn't match %s, the migrations were modified after they were hashed", name, sumFile)
+		}
+
+		res[name] = hash
+
+		sum.Write([]byte(name))
+		sum.Write([]byte(hash))
+	}
+
+	if total != base64.StdEncoding.EncodeToString(sum.Sum(nil)) {
+		return nil, fmt.Errorf("%s doesn't match the migrations", sumFile)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,182 @@
+package migrate
+
+import (
+	"io/fs"
+	"os"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/sql/migrations"
+)
+
+func TestSplitStatements(t *testing.T) {
+	src := `-- atlas:txmode none
+
+-- Add value to enum type: "StepRunEventReason"
+ALTER TYPE "StepRunEventReason" ADD VALUE 'A;B';
+/* a comment; with a semicolon */
+CREATE OR REPLACE FUNCTION f() RETURNS trigger AS $$
+BEGIN
+    RAISE NOTICE 'done;';
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- hatchet:backfill
+UPDATE "StepRun" SET "x" = E'\';' WHERE "id" IN (SELECT "id" FROM "StepRun" WHERE "x" IS NULL LIMIT 1000);
+CREATE INDEX CONCURRENTLY IF NOT EXISTS "a;b" ON "StepRun" ("x")`
+
+	stmts, header, err := splitStatements(src)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"-- atlas:txmode none", `-- Add value to enum type: "StepRunEventReason"`}, header)
+
+	require.Len(t, stmts, 4)
+	assert.Equal(t, `ALTER TYPE "StepRunEventReason" ADD VALUE 'A;B';`, stmts[0].SQL)
+	assert.Contains(t, stmts[1].SQL, "RAISE NOTICE 'done;';")
+	assert.Contains(t, stmts[1].SQL, "$$ LANGUAGE plpgsql;")
+	assert.False(t, stmts[1].Backfill)
+	assert.True(t, stmts[2].Backfill)
+	assert.Contains(t, stmts[2].SQL, "LIMIT 1000);")
+	assert.Equal(t, `CREATE INDEX CONCURRENTLY IF NOT EXISTS "a;b" ON "StepRun" ("x")`, stmts[3].SQL)
+}
+
+func TestSplitStatementsUnterminated(t *testing.T) {
+	_, _, err := splitStatements(`SELECT 'a;`)
+	assert.Error(t, err)
+
+	_, _, err = splitStatements(`SELECT $body$ a; $$`)
+	assert.Error(t, err)
+}
+
+func TestParse(t *testing.T) {
+	m, err := Parse("20250125091530_v0.52.60.sql", "CREATE TABLE a (id int);\nCREATE TABLE b (id int);\n")
+	require.NoError(t, err)
+
+	assert.Equal(t, "20250125091530", m.Version)
+	assert.Equal(t, "v0.52.60", m.Description)
+	assert.Equal(t, TxModeFile, m.TxMode)
+	assert.Len(t, m.Statements, 2)
+
+	m, err = Parse("20250125091530_v0.52.60.sql", "-- atlas:txmode none\n\nCREATE INDEX CONCURRENTLY a ON b (id);\n")
+	require.NoError(t, err)
+	assert.Equal(t, TxModeNone, m.TxMode)
+
+	_, err = Parse("20250125091530_v0.52.60.sql", "-- hatchet:backfill\nUPDATE a SET id = 1 WHERE id IS NULL;\n")
+	assert.Error(t, err)
+
+	_, err = Parse("v0.52.60.sql", "SELECT 1;")
+	assert.Error(t, err)
+}
+
+func TestConcurrentIndexName(t *testing.T) {
+	name, ok := concurrentIndexName(`CREATE INDEX CONCURRENTLY IF NOT EXISTS "StepRun_status_idx" ON "StepRun" ("status")`)
+	assert.True(t, ok)
+	assert.Equal(t, "StepRun_status_idx", name)
+
+	name, ok = concurrentIndexName(`create unique index concurrently step_run_idx on "StepRun" ("id")`)
+	assert.True(t, ok)
+	assert.Equal(t, "step_run_idx", name)
+
+	_, ok = concurrentIndexName(`CREATE INDEX "StepRun_status_idx" ON "StepRun" ("status")`)
+	assert.False(t, ok)
+}
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		sql      string
+		backfill bool
+		table    string
+		impact   Impact
+	}{
+		{`CREATE INDEX CONCURRENTLY "a" ON "StepRun" ("status")`, false, "StepRun", ImpactOnline},
+		{`CREATE INDEX "a" ON "public"."StepRun" ("status")`, false, "StepRun", ImpactBlocksWrites},
+		{`UPDATE "StepRun" SET "x" = 1 WHERE "id" IN (SELECT "id" FROM "StepRun" LIMIT 1000)`, true, "StepRun", ImpactOnline},
+		{`DELETE FROM "Event" WHERE "createdAt" < now()`, false, "Event", ImpactBlocksWrites},
+		{`ALTER TABLE "StepRun" ALTER COLUMN "input" TYPE JSONB`, false, "StepRun", ImpactBlocksAll},
+		{`ALTER TABLE "StepRun" ADD COLUMN "key" UUID NOT NULL DEFAULT gen_random_uuid()`, false, "StepRun", ImpactBlocksAll},
+		{`ALTER TABLE "StepRun" ADD COLUMN "retries" INTEGER NOT NULL DEFAULT 0`, false, "StepRun", ImpactNone},
+		{`ALTER TABLE "StepRun" ALTER COLUMN "retries" SET NOT NULL`, false, "StepRun", ImpactBlocksAll},
+		{`ALTER TABLE "StepRun" ADD CONSTRAINT "fk" FOREIGN KEY ("jobRunId") REFERENCES "JobRun"("id")`, false, "StepRun", ImpactBlocksWrites},
+		{`ALTER TABLE "StepRun" ADD CONSTRAINT "fk" FOREIGN KEY ("jobRunId") REFERENCES "JobRun"("id") NOT VALID`, false, "StepRun", ImpactNone},
+		{`ALTER TABLE "StepRun" VALIDATE CONSTRAINT "fk"`, false, "StepRun", ImpactOnline},
+		{`ALTER TABLE "StepRun" ADD CONSTRAINT "uq" UNIQUE USING INDEX "uq_idx"`, false, "StepRun", ImpactNone},
+		{`ALTER TABLE IF EXISTS ONLY "StepRun" ADD CONSTRAINT "pk" PRIMARY KEY ("id")`, false, "StepRun", ImpactBlocksAll},
+		{`CREATE TABLE "Foo" ("id" UUID NOT NULL)`, false, "", ImpactNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sql, func(t *testing.T) {
+			res := Analyze(&Statement{SQL: tt.sql, Backfill: tt.backfill})
+
+			assert.Equal(t, tt.table, res.Table)
+			assert.Equal(t, tt.impact, res.Impact)
+		})
+	}
+}
+
+func TestLoadEmbeddedMigrations(t *testing.T) {
+	all, err := LoadFS(migrations.FS)
+	require.NoError(t, err)
+	require.NotEmpty(t, all)
+
+	// the migrations which are embedded in the binaries are the migrations of sql/migrations, in order
+	entries, err := os.ReadDir("../../sql/migrations")
+	require.NoError(t, err)
+
+	var files []string
+
+	for _, entry := range entries {
+		if strings.HasSuffix(entry.Name(), ".sql") {
+			files = append(files, entry.Name())
+		}
+	}
+
+	loaded := make([]string, len(all))
+
+	for i, m := range all {
+		loaded[i] = m.File
+		assert.NotEmpty(t, m.Hash)
+	}
+
+	assert.Equal(t, files, loaded)
+}
+
+func TestLoadFSModifiedMigration(t *testing.T) {
+	fsys := fstest.MapFS{}
+
+	err := fs.WalkDir(migrations.FS, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		data, err := fs.ReadFile(migrations.FS, path)
+
+		if err != nil {
+			return err
+		}
+
+		fsys[path] = &fstest.MapFile{Data: data}
+
+		return nil
+	})
+
+	require.NoError(t, err)
+
+	_, err = LoadFS(fsys)
+	require.NoError(t, err)
+
+	// migrations which were modified after they were hashed aren't loaded
+	for path, f := range fsys {
+		if strings.HasSuffix(path, ".sql") {
+			f.Data = append(f.Data, []byte("\nSELECT 1;\n")...)
+			break
+		}
+	}
+
+	_, err = LoadFS(fsys)
+	assert.Error(t, err)
+}
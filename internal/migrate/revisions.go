@@ -0,0 +1,231 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// The types of the revisions of Atlas, which are a bitmask.
+const (
+	revisionTypeBaseline = 1 << 0
+	revisionTypeExecute  = 1 << 1
+	revisionTypeResolved = 1 << 2
+)
+
+// operatorVersion is recorded as the operator of the revisions which were applied by the runner.
+const operatorVersion = "hatchet-admin migrate"
+
+type dbtx interface {
+	Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error)
+	Query(context.Context, string, ...interface{}) (pgx.Rows, error)
+	QueryRow(context.Context, string, ...interface{}) pgx.Row
+}
+
+type revision struct {
+	Version string
+	Type    int
+	Applied int
+	Total   int
+}
+
+func (r *revision) done() bool {
+	return r.Type&(revisionTypeBaseline|revisionTypeResolved) != 0 || r.Applied >= r.Total
+}
+
+// createRevisionsTable creates the revisions table of Atlas if it doesn't exist, so the runner can be used on an
+// empty database.
+func createRevisionsTable(ctx context.Context, db dbtx) error {
+	_, err := db.Exec(ctx, `CREATE SCHEMA IF NOT EXISTS "atlas_schema_revisions"`)
+
+	if err != nil {
+		return fmt.Errorf("could not create revisions schema: %w", err)
+	}
+
+	_, err = db.Exec(ctx, `
+CREATE TABLE IF NOT EXISTS "atlas_schema_revisions"."atlas_schema_revisions" (
+    "version" character varying NOT NULL,
+    "description" character varying NOT NULL,
+    "type" bigint NOT NULL DEFAULT 2,
+    "applied" bigint NOT NULL DEFAULT 0,
+    "total" bigint NOT NULL DEFAULT 0,
+    "executed_at" timestamptz NOT NULL,
+    "execution_time" bigint NOT NULL,
+    "error" text NULL,
+    "error_stmt" text NULL,
+    "hash" character varying NOT NULL,
+    "partial_hashes" jsonb NULL,
+    "operator_version" character varying NOT NULL,
+    PRIMARY KEY ("version")
+);`)
+
+	if err != nil {
+		return fmt.Errorf("could not create revisions table: %w", err)
+	}
+
+	return nil
+}
+
+// listRevisions returns the revisions which were recorded by Atlas or the runner, by version. It returns no
+// revisions if the revisions table doesn't exist.
+func listRevisions(ctx context.Context, db dbtx) (map[string]*revision, error) {
+	var exists bool
+
+	err := db.QueryRow(ctx, `SELECT to_regclass('"atlas_schema_revisions"."atlas_schema_revisions"') IS NOT NULL`).Scan(&exists)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not check revisions table: %w", err)
+	}
+
+	res := make(map[string]*revision)
+
+	if !exists {
+		return res, nil
+	}
+
+	rows, err := db.Query(ctx, `SELECT "version", "type", "applied", "total" FROM "atlas_schema_revisions"."atlas_schema_revisions"`)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list revisions: %w", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		r := &revision{}
+
+		if err := rows.Scan(&r.Version, &r.Type, &r.Applied, &r.Total); err != nil {
+			return nil, err
+		}
+
+		res[r.Version] = r
+	}
+
+	return res, rows.Err()
+}
+
+// prismaBaseline returns the version of the last migration which was applied by Prisma, on installations which were
+// migrated with Prisma before the migrations were moved to Atlas. The migrations up to this version are applied.
+func prismaBaseline(ctx context.Context, db dbtx) (string, error) {
+	var exists bool
+
+	err := db.QueryRow(ctx, `SELECT to_regclass('"_prisma_migrations"') IS NOT NULL`).Scan(&exists)
+
+	if err != nil || !exists {
+		return "", err
+	}
+
+	var name string
+
+	err = db.QueryRow(ctx, `SELECT "migration_name" FROM "_prisma_migrations" ORDER BY "started_at" DESC LIMIT 1`).Scan(&name)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("could not read prisma migrations: %w", err)
+	}
+
+	version, _, _ := strings.Cut(name, "_")
+
+	return version, nil
+}
+
+// pendingMigration is a migration which wasn't applied, or was partially applied if Applied is greater than 0.
+type pendingMigration struct {
+	*Migration
+
+	Applied int
+}
+
+// pending returns the migrations which weren't applied, in order. Like Atlas, the migrations up to the latest
+// baseline are considered applied.
+func pending(migrations []*Migration, revisions map[string]*revision, baseline string) []*pendingMigration {
+	for _, r := range revisions {
+		if r.Type&revisionTypeBaseline != 0 && r.Version > baseline {
+			baseline = r.Version
+		}
+	}
+
+	var res []*pendingMigration
+
+	for _, m := range migrations {
+		if m.Version <= baseline {
+			continue
+		}
+
+		r, ok := revisions[m.Version]
+
+		if !ok {
+			res = append(res, &pendingMigration{Migration: m})
+			continue
+		}
+
+		if !r.done() {
+			res = append(res, &pendingMigration{Migration: m, Applied: r.Applied})
+		}
+	}
+
+	return res
+}
+
+// writeBaseline records the baseline of an installation which was migrated with Prisma, like `atlas migrate apply
+// --baseline`.
+func writeBaseline(ctx context.Context, db dbtx, migrations []*Migration, baseline string) error {
+	for _, m := range migrations {
+		if m.Version != baseline {
+			continue
+		}
+
+		_, err := db.Exec(ctx, `
+INSERT INTO "atlas_schema_revisions"."atlas_schema_revisions" (
+    "version", "description", "type", "applied", "total", "executed_at", "execution_time", "hash", "operator_version"
+) VALUES (
+    $1, $2, $3, 0, 0, now(), 0, $4, $5
+) ON CONFLICT ("version") DO NOTHING`,
+			m.Version, m.Description, revisionTypeBaseline, m.Hash, operatorVersion,
+		)
+
+		return err
+	}
+
+	return nil
+}
+
+// writeRevision records how many statements of a migration were applied, along with the error which stopped it.
+func writeRevision(ctx context.Context, db dbtx, m *Migration, applied int, executionTime time.Duration, runErr error, errStmt string) error {
+	var errText, errStmtText *string
+
+	if runErr != nil {
+		text := runErr.Error()
+		errText = &text
+		errStmtText = &errStmt
+	}
+
+	_, err := db.Exec(ctx, `
+INSERT INTO "atlas_schema_revisions"."atlas_schema_revisions" (
+    "version", "description", "type", "applied", "total", "executed_at", "execution_time", "error", "error_stmt",
+    "hash", "operator_version"
+) VALUES (
+    $1, $2, $3, $4, $5, now(), $6, $7, $8, $9, $10
+) ON CONFLICT ("version") DO UPDATE SET
+    "applied" = EXCLUDED."applied",
+    "executed_at" = EXCLUDED."executed_at",
+    "execution_time" = "atlas_schema_revisions"."execution_time" + EXCLUDED."execution_time",
+    "error" = EXCLUDED."error",
+    "error_stmt" = EXCLUDED."error_stmt",
+    "operator_version" = EXCLUDED."operator_version"`,
+		m.Version, m.Description, revisionTypeExecute, applied, len(m.Statements), executionTime.Nanoseconds(),
+		errText, errStmtText, m.Hash, operatorVersion,
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not record revision %s: %w", m.Version, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,415 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/rs/zerolog"
+)
+
+// advisoryLockKey is the key of the advisory lock which is held while migrations are applied, so migrations are only
+// applied by one runner at a time. The key is arbitrary.
+const advisoryLockKey int64 = 724311870011
+
+// maxRetryBackoff is the maximum time between the attempts of a statement which timed out waiting for a lock.
+const maxRetryBackoff = 30 * time.Second
+
+type Options struct {
+	// LockTimeout is the time a statement waits for the locks of its tables before it's cancelled and retried, so a
+	// statement which waits for a long-running transaction doesn't block the queries which queue up behind it
+	LockTimeout time.Duration
+
+	// MaxRetries is the number of times a statement which timed out waiting for a lock is retried
+	MaxRetries int
+
+	// BackfillPause is the time between the batches of a backfill, which gives autovacuum and the read replicas time
+	// to catch up
+	BackfillPause time.Duration
+}
+
+func DefaultOptions() Options {
+	return Options{
+		LockTimeout: 5 * time.Second,
+		MaxRetries:  20,
+	}
+}
+
+// Runner applies migrations on a single connection. The connection must be a direct connection to the database,
+// since the runner relies on session settings and advisory locks which don't work through PgBouncer in transaction
+// mode.
+type Runner struct {
+	conn *pgx.Conn
+	opts Options
+	l    *zerolog.Logger
+}
+
+func NewRunner(conn *pgx.Conn, l *zerolog.Logger, opts Options) *Runner {
+	return &Runner{
+		conn: conn,
+		opts: opts,
+		l:    l,
+	}
+}
+
+// Pending returns the migrations which weren't applied, in order.
+func (r *Runner) Pending(ctx context.Context, migrations []*Migration) ([]*Migration, error) {
+	return ListPending(ctx, r.conn, migrations)
+}
+
+func (r *Runner) pending(ctx context.Context, migrations []*Migration) ([]*pendingMigration, string, error) {
+	return listPending(ctx, r.conn, migrations)
+}
+
+// ListPending returns the migrations which weren't applied to the database of a connection or pool, in order. Unlike
+// the runner, it doesn't take a connection of its own, so it's used to check the schema of running engines.
+func ListPending(ctx context.Context, db dbtx, migrations []*Migration) ([]*Migration, error) {
+	p, _, err := listPending(ctx, db, migrations)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*Migration, len(p))
+
+	for i := range p {
+		res[i] = p[i].Migration
+	}
+
+	return res, nil
+}
+
+func listPending(ctx context.Context, db dbtx, migrations []*Migration) ([]*pendingMigration, string, error) {
+	revisions, err := listRevisions(ctx, db)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	var baseline string
+
+	if len(revisions) == 0 {
+		baseline, err = prismaBaseline(ctx, db)
+
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	return pending(migrations, revisions, baseline), baseline, nil
+}
+
+// Apply applies the pending migrations in order, and returns the number of applied migrations. Migrations which were
+// partially applied, because a statement of a migration without a transaction failed, are resumed from the failed
+// statement.
+func (r *Runner) Apply(ctx context.Context, migrations []*Migration) (int, error) {
+	r.l.Info().Msg("waiting for other migrations to finish")
+
+	if _, err := r.conn.Exec(ctx, "SELECT pg_advisory_lock($1)", advisoryLockKey); err != nil {
+		return 0, fmt.Errorf("could not acquire migration lock: %w", err)
+	}
+
+	defer func() {
+		// the lock is released when the connection is closed if the context is done
+		_, _ = r.conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", advisoryLockKey) // nolint: errcheck
+	}()
+
+	if err := createRevisionsTable(ctx, r.conn); err != nil {
+		return 0, err
+	}
+
+	toApply, baseline, err := r.pending(ctx, migrations)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if baseline != "" {
+		r.l.Info().Msgf("using the prisma migration %s as the baseline", baseline)
+
+		if err := writeBaseline(ctx, r.conn, migrations, baseline); err != nil {
+			return 0, fmt.Errorf("could not record baseline: %w", err)
+		}
+	}
+
+	if _, err := r.conn.Exec(ctx, "SET statement_timeout = 0"); err != nil {
+		return 0, err
+	}
+
+	if err := r.setLockTimeout(ctx, r.opts.LockTimeout); err != nil {
+		return 0, err
+	}
+
+	for i, m := range toApply {
+		if m.Applied > 0 {
+			r.l.Info().Msgf("resuming migration %s from statement %d of %d", m.File, m.Applied+1, len(m.Statements))
+		} else {
+			r.l.Info().Msgf("applying migration %s", m.File)
+		}
+
+		var err error
+
+		if m.TxMode == TxModeNone {
+			err = r.applyWithoutTx(ctx, m)
+		} else {
+			err = r.applyInTx(ctx, m)
+		}
+
+		if err != nil {
+			return i, fmt.Errorf("could not apply migration %s: %w", m.File, err)
+		}
+	}
+
+	return len(toApply), nil
+}
+
+// applyInTx applies all the statements of a migration in a single transaction, which is retried from the start if a
+// statement times out waiting for a lock.
+func (r *Runner) applyInTx(ctx context.Context, m *pendingMigration) error {
+	for attempt := 0; ; attempt++ {
+		start := time.Now()
+		failed, err := r.runTx(ctx, m)
+
+		if err == nil {
+			return nil
+		}
+
+		if isLockTimeout(err) && failed != nil && attempt < r.opts.MaxRetries {
+			r.waitForRetry(ctx, attempt, failed, err)
+			continue
+		}
+
+		var failedSQL string
+
+		if failed != nil {
+			failedSQL = failed.SQL
+		}
+
+		if revErr := writeRevision(ctx, r.conn, m.Migration, 0, time.Since(start), err, failedSQL); revErr != nil {
+			r.l.Err(revErr).Msg("could not record failed migration")
+		}
+
+		return err
+	}
+}
+
+// runTx runs the statements of a migration in a transaction, and returns the statement which failed.
+func (r *Runner) runTx(ctx context.Context, m *pendingMigration) (*Statement, error) {
+	start := time.Now()
+
+	tx, err := r.conn.Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback(context.Background()) // nolint: errcheck
+
+	for _, stmt := range m.Statements {
+		if _, err := tx.Exec(ctx, stmt.SQL); err != nil {
+			return stmt, err
+		}
+	}
+
+	if err := writeRevision(ctx, tx, m.Migration, len(m.Statements), time.Since(start), nil, ""); err != nil {
+		return nil, err
+	}
+
+	return nil, tx.Commit(ctx)
+}
+
+// applyWithoutTx applies the statements of a migration one at a time, and records the progress after every
+// statement, so a migration which failed is resumed from the failed statement.
+func (r *Runner) applyWithoutTx(ctx context.Context, m *pendingMigration) error {
+	for i := m.Applied; i < len(m.Statements); i++ {
+		stmt := m.Statements[i]
+		start := time.Now()
+
+		var err error
+
+		if stmt.Backfill {
+			err = r.backfill(ctx, stmt)
+		} else {
+			_, err = r.exec(ctx, stmt)
+		}
+
+		if err != nil {
+			if revErr := writeRevision(ctx, r.conn, m.Migration, i, time.Since(start), err, stmt.SQL); revErr != nil {
+				r.l.Err(revErr).Msg("could not record failed migration")
+			}
+
+			return err
+		}
+
+		if err := writeRevision(ctx, r.conn, m.Migration, i+1, time.Since(start), nil, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// backfill runs a statement until it doesn't affect any rows. Every batch runs in its own transaction, so the rows of
+// a batch are only locked until the batch is committed.
+func (r *Runner) backfill(ctx context.Context, stmt *Statement) error {
+	var total int64
+
+	for batch := 1; ; batch++ {
+		tag, err := r.exec(ctx, stmt)
+
+		if err != nil {
+			return err
+		}
+
+		total += tag.RowsAffected()
+
+		if tag.RowsAffected() == 0 {
+			r.l.Info().Msgf("backfill done after %d batches, %d rows", batch, total)
+			return nil
+		}
+
+		if batch%100 == 0 {
+			r.l.Info().Msgf("backfilled %d rows", total)
+		}
+
+		if r.opts.BackfillPause > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(r.opts.BackfillPause):
+			}
+		}
+	}
+}
+
+// exec runs a statement outside of a transaction, and retries it if it times out waiting for a lock.
+func (r *Runner) exec(ctx context.Context, stmt *Statement) (pgconn.CommandTag, error) {
+	index, concurrently := concurrentIndexName(stmt.SQL)
+
+	if concurrently {
+		// concurrent index builds don't block reads or writes, but wait for the transactions which are running
+		// when they start, so they aren't cancelled by the lock timeout
+		if err := r.setLockTimeout(ctx, 0); err != nil {
+			return pgconn.CommandTag{}, err
+		}
+
+		defer r.setLockTimeout(context.Background(), r.opts.LockTimeout) // nolint: errcheck
+	}
+
+	for attempt := 0; ; attempt++ {
+		if concurrently {
+			if err := r.dropInvalidIndex(ctx, index); err != nil {
+				return pgconn.CommandTag{}, err
+			}
+		}
+
+		tag, err := r.conn.Exec(ctx, stmt.SQL)
+
+		if err == nil {
+			return tag, nil
+		}
+
+		if !isLockTimeout(err) || attempt >= r.opts.MaxRetries {
+			return tag, err
+		}
+
+		r.waitForRetry(ctx, attempt, stmt, err)
+	}
+}
+
+// dropInvalidIndex drops an index which was left invalid by a concurrent index build which failed, since `CREATE
+// INDEX CONCURRENTLY IF NOT EXISTS` would skip it and leave the index unusable.
+func (r *Runner) dropInvalidIndex(ctx context.Context, index string) error {
+	var invalid bool
+
+	err := r.conn.QueryRow(ctx, `
+SELECT EXISTS (
+    SELECT 1
+    FROM pg_index i
+    JOIN pg_class c ON c.oid = i.indexrelid
+    JOIN pg_namespace n ON n.oid = c.relnamespace
+    WHERE c.relname = $1 AND n.nspname = current_schema() AND NOT i.indisvalid
+)`, index).Scan(&invalid)
+
+	if err != nil || !invalid {
+		return err
+	}
+
+	r.l.Warn().Msgf("dropping invalid index %s which was left by a failed build", index)
+
+	_, err = r.conn.Exec(ctx, fmt.Sprintf("DROP INDEX CONCURRENTLY IF EXISTS %s", pgx.Identifier{index}.Sanitize()))
+
+	return err
+}
+
+func (r *Runner) setLockTimeout(ctx context.Context, timeout time.Duration) error {
+	_, err := r.conn.Exec(ctx, fmt.Sprintf("SET lock_timeout = %d", timeout.Milliseconds()))
+
+	return err
+}
+
+func (r *Runner) waitForRetry(ctx context.Context, attempt int, stmt *Statement, err error) {
+	backoff := time.Second << attempt
+
+	if backoff > maxRetryBackoff || backoff <= 0 {
+		backoff = maxRetryBackoff
+	}
+
+	r.l.Warn().Err(err).Msgf("statement timed out waiting for a lock, retrying in %s: %s", backoff, summarize(stmt.SQL))
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(backoff):
+	}
+}
+
+// isLockTimeout returns true if a statement was cancelled because it couldn't acquire its locks, in which case it
+// can be retried.
+func isLockTimeout(err error) bool {
+	var pgErr *pgconn.PgError
+
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	// lock_not_available and deadlock_detected
+	return pgErr.Code == "55P03" || pgErr.Code == "40P01"
+}
+
+var concurrentIndexRegexp = regexp.MustCompile(`(?is)^CREATE\s+(?:UNIQUE\s+)?INDEX\s+CONCURRENTLY\s+(?:IF\s+NOT\s+EXISTS\s+)?("(?:[^"]|"")+"|[A-Za-z_][A-Za-z0-9_$]*)`)
+
+// concurrentIndexName returns the name of the index which is built by a `CREATE INDEX CONCURRENTLY` statement.
+func concurrentIndexName(sql string) (string, bool) {
+	m := concurrentIndexRegexp.FindStringSubmatch(sql)
+
+	if m == nil {
+		return "", false
+	}
+
+	return identifier(m[1]), true
+}
+
+// identifier returns the name of a quoted or unquoted identifier.
+func identifier(s string) string {
+	if strings.HasPrefix(s, `"`) {
+		return strings.ReplaceAll(s[1:len(s)-1], `""`, `"`)
+	}
+
+	return strings.ToLower(s)
+}
+
+// summarize returns the first line of a statement.
+func summarize(sql string) string {
+	line, _, _ := strings.Cut(sql, "\n")
+
+	if len(line) > 100 {
+		line = line[:100] + "..."
+	}
+
+	return line
+}
@@ -0,0 +1,92 @@
+package msgqueue
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// MessageQueueDriver creates the message queue of the server. Drivers are registered by name with Register,
+// and the driver of the server is selected with the msgQueue.kind config option.
+type MessageQueueDriver interface {
+	// New creates a message queue. It returns a cleanup function that should be called when the message queue
+	// is no longer needed.
+	New(opts *DriverOpts) (func() error, MessageQueue, error)
+}
+
+// MessageQueueDriverFunc is a function which implements MessageQueueDriver.
+type MessageQueueDriverFunc func(opts *DriverOpts) (func() error, MessageQueue, error)
+
+func (f MessageQueueDriverFunc) New(opts *DriverOpts) (func() error, MessageQueue, error) {
+	return f(opts)
+}
+
+// DriverOpts are the options which a driver creates a message queue with.
+type DriverOpts struct {
+	// Logger is the logger of the server.
+	Logger *zerolog.Logger
+
+	// Config decodes the config of the driver, which is set under msgQueue.<kind> in the server config, into
+	// the given struct. Fields are matched by their json tags.
+	Config func(v interface{}) error
+
+	// DisableTenantPubs is true if messages shouldn't be published to the pub/sub queues of their tenants.
+	DisableTenantPubs bool
+
+	// Repository is the engine repository of the server, for drivers which store messages in the database.
+	Repository repository.EngineRepository
+}
+
+var (
+	driversMu sync.RWMutex
+	drivers   = map[string]MessageQueueDriver{}
+)
+
+// Register registers a driver under the given name, so that it can be selected with the msgQueue.kind config
+// option. It is meant to be called from an init function of the driver package, which is compiled into the
+// server with a blank import.
+func Register(name string, d MessageQueueDriver) {
+	driversMu.Lock()
+	defer driversMu.Unlock()
+
+	drivers[name] = d
+}
+
+// Drivers returns the sorted names of the registered drivers.
+func Drivers() []string {
+	driversMu.RLock()
+	defer driversMu.RUnlock()
+
+	names := make([]string, 0, len(drivers))
+
+	for name := range drivers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// New creates a message queue with the driver registered under the given name.
+func New(name string, opts *DriverOpts) (func() error, MessageQueue, error) {
+	driversMu.RLock()
+	d, ok := drivers[name]
+	driversMu.RUnlock()
+
+	if !ok {
+		return nil, nil, fmt.Errorf("message queue driver %s is not registered, registered drivers are %v", name, Drivers())
+	}
+
+	cleanup, mq, err := d.New(opts)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create message queue with driver %s: %w", name, err)
+	}
+
+	return cleanup, mq, nil
+}
@@ -0,0 +1,48 @@
+package msgqueue
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testDriverConfig struct {
+	URL string `json:"url"`
+}
+
+func TestDriverRegistry(t *testing.T) {
+	var decoded testDriverConfig
+
+	Register("test", MessageQueueDriverFunc(func(opts *DriverOpts) (func() error, MessageQueue, error) {
+		if err := opts.Config(&decoded); err != nil {
+			return nil, nil, err
+		}
+
+		return func() error { return nil }, nil, nil
+	}))
+
+	Register("failing", MessageQueueDriverFunc(func(opts *DriverOpts) (func() error, MessageQueue, error) {
+		return nil, nil, fmt.Errorf("could not connect")
+	}))
+
+	assert.Contains(t, Drivers(), "test")
+
+	cleanup, _, err := New("test", &DriverOpts{
+		Config: func(v interface{}) error {
+			return json.Unmarshal([]byte(`{"url":"test://localhost"}`), v)
+		},
+	})
+
+	require.NoError(t, err)
+	require.NoError(t, cleanup())
+	assert.Equal(t, "test://localhost", decoded.URL)
+
+	_, _, err = New("failing", &DriverOpts{})
+	assert.ErrorContains(t, err, "could not connect")
+
+	_, _, err = New("unknown", &DriverOpts{})
+	assert.ErrorContains(t, err, "message queue driver unknown is not registered")
+}
@@ -0,0 +1,42 @@
+package jetstream
+
+import (
+	"fmt"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+)
+
+func init() {
+	msgqueue.Register("nats", msgqueue.MessageQueueDriverFunc(newDriver))
+}
+
+// Config is the config of the driver, which is set under msgQueue.nats in the server config.
+type Config struct {
+	URL      string `json:"url"`
+	Qos      int    `json:"qos"`
+	Prefix   string `json:"prefix"`
+	Replicas int    `json:"replicas"`
+}
+
+func newDriver(opts *msgqueue.DriverOpts) (func() error, msgqueue.MessageQueue, error) {
+	cf := Config{}
+
+	if err := opts.Config(&cf); err != nil {
+		return nil, nil, fmt.Errorf("could not decode nats config: %w", err)
+	}
+
+	cleanup, mq := New(
+		WithURL(cf.URL),
+		WithLogger(opts.Logger),
+		WithQos(cf.Qos),
+		WithPrefix(cf.Prefix),
+		WithReplicas(cf.Replicas),
+		WithDisableTenantExchangePubs(opts.DisableTenantPubs),
+	)
+
+	if mq == nil {
+		return nil, nil, fmt.Errorf("could not connect to nats")
+	}
+
+	return cleanup, mq, nil
+}
@@ -0,0 +1,686 @@
+// Package jetstream implements the message queue with NATS JetStream.
+//
+// Durable queues are work queue streams with a durable pull consumer, which every engine fetches messages from.
+// Messages whose pre-ack hook fails are delivered again after a delay, and messages which fail more often than they're
+// retried are moved to a dead letter stream. Consumer queues and tenant pub/sub queues don't need to survive restarts,
+// so they're core NATS subjects.
+package jetstream
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/logger"
+)
+
+const MAX_RETRY_COUNT = 15
+const RETRY_INTERVAL = 2 * time.Second
+
+// NAK_DELAY is how long a message whose pre-ack hook failed waits before it's delivered again
+const NAK_DELAY = 5 * time.Second
+
+// ACK_WAIT is how long the server waits for a message to be acknowledged before it delivers it again
+const ACK_WAIT = 30 * time.Second
+
+// FETCH_EXPIRES is how long a pull request of a consumer waits for messages
+const FETCH_EXPIRES = 5 * time.Second
+
+// DEFAULT_QOS is the number of messages which a subscriber handles at once if the qos isn't set
+const DEFAULT_QOS = 100
+
+// DEAD_LETTER_MAX_AGE is how long messages are kept in the dead letter stream
+const DEAD_LETTER_MAX_AGE = 7 * 24 * time.Hour
+
+// MessageQueueImpl implements MessageQueue interface using NATS JetStream.
+type MessageQueueImpl struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	identity string
+	configFs []MessageQueueImplOpt
+
+	url      string
+	prefix   string
+	replicas int
+	qos      int
+
+	l *zerolog.Logger
+
+	disableTenantExchangePubs bool
+
+	nc *nats.Conn
+	js jetstream.JetStream
+
+	// streams are the consumers of the queues whose streams and consumers have been created, by the name of the queue
+	streams sync.Map
+}
+
+func (t *MessageQueueImpl) IsReady() bool {
+	return t.nc.IsConnected()
+}
+
+type MessageQueueImplOpt func(*MessageQueueImplOpts)
+
+type MessageQueueImplOpts struct {
+	l                         *zerolog.Logger
+	url                       string
+	prefix                    string
+	replicas                  int
+	qos                       int
+	disableTenantExchangePubs bool
+}
+
+func defaultMessageQueueImplOpts() *MessageQueueImplOpts {
+	l := logger.NewDefaultLogger("jetstream")
+
+	return &MessageQueueImplOpts{
+		l:                         &l,
+		prefix:                    "hatchet",
+		replicas:                  1,
+		disableTenantExchangePubs: false,
+	}
+}
+
+func WithLogger(l *zerolog.Logger) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.l = l
+	}
+}
+
+func WithURL(url string) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.url = url
+	}
+}
+
+// WithPrefix sets the prefix of the subjects and streams of the queues, so several Hatchet instances can share a
+// server.
+func WithPrefix(prefix string) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.prefix = prefix
+	}
+}
+
+// WithReplicas sets the number of replicas of the streams in a clustered server.
+func WithReplicas(replicas int) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.replicas = replicas
+	}
+}
+
+func WithQos(qos int) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.qos = qos
+	}
+}
+
+func WithDisableTenantExchangePubs(disable bool) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.disableTenantExchangePubs = disable
+	}
+}
+
+// New creates a new MessageQueueImpl.
+func New(fs ...MessageQueueImplOpt) (func() error, *MessageQueueImpl) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	opts := defaultMessageQueueImplOpts()
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	newLogger := opts.l.With().Str("service", "events-controller").Logger()
+	opts.l = &newLogger
+
+	t := &MessageQueueImpl{
+		ctx:                       ctx,
+		cancel:                    cancel,
+		identity:                  identity(),
+		configFs:                  fs,
+		url:                       opts.url,
+		prefix:                    opts.prefix,
+		replicas:                  opts.replicas,
+		qos:                       opts.qos,
+		l:                         opts.l,
+		disableTenantExchangePubs: opts.disableTenantExchangePubs,
+	}
+
+	// connect and init the queues in a blocking fashion
+	nc, js, err := t.dial(ctx)
+
+	if err != nil {
+		t.l.Error().Err(err).Msg("cannot connect to nats")
+		cancel()
+		return nil, nil
+	}
+
+	t.nc = nc
+	t.js = js
+
+	cleanup := func() error {
+		cancel()
+		t.nc.Close()
+
+		return nil
+	}
+
+	return cleanup, t
+}
+
+func (t *MessageQueueImpl) Clone() (func() error, msgqueue.MessageQueue) {
+	return New(t.configFs...)
+}
+
+func (t *MessageQueueImpl) SetQOS(prefetchCount int) {
+	t.qos = prefetchCount
+}
+
+// AddMessage adds a msg to the queue. Messages of durable queues are only added once the stream has stored them.
+func (t *MessageQueueImpl) AddMessage(ctx context.Context, q msgqueue.Queue, msg *msgqueue.Message) error {
+	ctx, span := telemetry.NewSpan(ctx, "add-message")
+	defer span.End()
+
+	// inject otel carrier into the message
+	if msg.OtelCarrier == nil {
+		msg.OtelCarrier = telemetry.GetCarrier(ctx)
+	}
+
+	body, err := json.Marshal(msg)
+
+	if err != nil {
+		return fmt.Errorf("error marshaling msg: %w", err)
+	}
+
+	t.l.Debug().Msgf("publishing msg %s to queue %s", msg.ID, q.Name())
+
+	if q.Durable() {
+		if _, err := t.initQueue(ctx, t.js, q); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+
+		if _, err := t.js.Publish(ctx, t.queueSubject(q), body); err != nil {
+			return fmt.Errorf("error publishing msg %s to queue %s: %w", msg.ID, q.Name(), err)
+		}
+	} else if err := t.nc.Publish(t.consumerSubject(q), body); err != nil {
+		return fmt.Errorf("error publishing msg %s to queue %s: %w", msg.ID, q.Name(), err)
+	}
+
+	// if this is a tenant msg, publish to the tenant subject
+	if !t.disableTenantExchangePubs && msg.TenantID() != "" {
+		t.l.Debug().Msgf("publishing tenant msg %s to tenant %s", msg.ID, msg.TenantID())
+
+		if err := t.nc.Publish(t.tenantSubject(msg.TenantID()), body); err != nil {
+			t.l.Error().Msgf("error publishing tenant msg: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// Subscribe subscribes to the msg queue.
+func (t *MessageQueueImpl) Subscribe(
+	q msgqueue.Queue,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+) (func() error, error) {
+	t.l.Debug().Msgf("subscribing to queue: %s", q.Name())
+
+	ctx, cancel := context.WithCancel(t.ctx)
+
+	wg := &sync.WaitGroup{}
+
+	go func() {
+		for {
+			t.l.Debug().Msgf("starting subscriber %s on queue %s", t.identity, q.Name())
+
+			var err error
+
+			if q.Durable() {
+				err = t.consumeStream(ctx, q, preAck, postAck, wg)
+			} else {
+				err = t.consumeSubject(ctx, q, preAck, postAck, wg)
+			}
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			if err != nil {
+				t.l.Error().Msgf("subscriber of queue %s stopped: %v", q.Name(), err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(RETRY_INTERVAL):
+			}
+		}
+	}()
+
+	cleanup := func() error {
+		cancel()
+
+		t.l.Debug().Msgf("shutting down subscriber: %s", t.identity)
+		wg.Wait()
+		t.l.Debug().Msgf("successfully shut down subscriber: %s", t.identity)
+		return nil
+	}
+
+	return cleanup, nil
+}
+
+// RegisterTenant is a no-op, since tenant messages are published to core NATS subjects which don't need to be
+// declared.
+func (t *MessageQueueImpl) RegisterTenant(ctx context.Context, tenantId string) error {
+	return nil
+}
+
+// consumeSubject subscribes to the core NATS subject of a consumer queue or a tenant until the context is canceled.
+// The client subscribes again when its connection is opened again. Messages which are published while nobody is
+// subscribed are dropped, like the messages of exclusive, auto-deleted RabbitMQ queues.
+func (t *MessageQueueImpl) consumeSubject(
+	ctx context.Context,
+	q msgqueue.Queue,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+	wg *sync.WaitGroup,
+) error {
+	subject := t.consumerSubject(q)
+
+	// every subscriber of a tenant receives all messages of the tenant
+	if q.FanoutExchangeKey() != "" {
+		subject = t.tenantSubject(q.FanoutExchangeKey())
+	}
+
+	sub, err := t.nc.Subscribe(subject, func(natsMsg *nats.Msg) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			msg, err := decode(natsMsg.Data)
+
+			if err != nil {
+				t.l.Error().Msgf("error unmarshalling message: %v", err)
+				return
+			}
+
+			if err := preAck(msg); err != nil {
+				t.l.Error().Msgf("error in pre-ack: %v", err)
+				return
+			}
+
+			if err := postAck(msg); err != nil {
+				t.l.Error().Msgf("error in post-ack: %v", err)
+			}
+		}()
+	})
+
+	if err != nil {
+		return err
+	}
+
+	defer sub.Unsubscribe() // nolint: errcheck
+
+	<-ctx.Done()
+
+	return nil
+}
+
+// consumeStream consumes the messages of a durable queue until the context is canceled or the consumer of the queue
+// is deleted. At most qos messages are handled at once.
+func (t *MessageQueueImpl) consumeStream(
+	ctx context.Context,
+	q msgqueue.Queue,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+	wg *sync.WaitGroup,
+) error {
+	qos := t.qos
+
+	if qos <= 0 {
+		qos = DEFAULT_QOS
+	}
+
+	consumer, err := t.initQueue(ctx, t.js, q)
+
+	if err != nil {
+		return err
+	}
+
+	// the handler blocks while qos messages are being handled, so no more messages are pulled
+	sem := make(chan struct{}, qos)
+	deleted := make(chan error, 1)
+
+	cc, err := consumer.Consume(
+		func(natsMsg jetstream.Msg) {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				// the message is delivered again once its ack wait is over
+				return
+			}
+
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				t.handleStreamMsg(q, natsMsg, preAck, postAck)
+			}()
+		},
+		jetstream.PullMaxMessages(qos),
+		jetstream.PullExpiry(FETCH_EXPIRES),
+		jetstream.ConsumeErrHandler(func(_ jetstream.ConsumeContext, err error) {
+			if errors.Is(err, jetstream.ErrConsumerDeleted) || errors.Is(err, jetstream.ErrConsumerNotFound) {
+				select {
+				case deleted <- err:
+				default:
+				}
+
+				return
+			}
+
+			t.l.Error().Msgf("error consuming queue %s: %v", q.Name(), err)
+		}),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	defer cc.Stop()
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-deleted:
+		// the stream and the consumer are created again when the queue is consumed again
+		t.streams.Delete(q.Name())
+
+		return err
+	}
+}
+
+func (t *MessageQueueImpl) handleStreamMsg(
+	q msgqueue.Queue,
+	natsMsg jetstream.Msg,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+) {
+	msg, err := decode(natsMsg.Data())
+
+	if err != nil {
+		t.l.Error().Msgf("error unmarshalling message: %v", err)
+
+		// the message can never be handled, so it's dead lettered right away
+		t.deadLetter(q, natsMsg, 1)
+		return
+	}
+
+	meta, err := natsMsg.Metadata()
+
+	if err != nil {
+		t.l.Error().Msgf("error reading delivery count of message %s: %v", msg.ID, err)
+		return
+	}
+
+	delivered := int(meta.NumDelivered) // nolint: gosec
+
+	// determine if we've hit the max number of retries
+	if delivered-1 > msg.Retries {
+		t.l.Debug().Msgf("message %s has been rejected %d times, moving it to the dead letter queue", msg.ID, delivered-1)
+
+		t.deadLetter(q, natsMsg, delivered)
+		return
+	}
+
+	t.l.Debug().Msgf("got msg: %v", msg.ID)
+
+	if err := preAck(msg); err != nil {
+		t.l.Error().Msgf("error in pre-ack: %v", err)
+
+		if err := natsMsg.NakWithDelay(NAK_DELAY); err != nil {
+			t.l.Error().Msgf("error rejecting message: %v", err)
+		}
+
+		return
+	}
+
+	if err := natsMsg.Ack(); err != nil {
+		t.l.Error().Msgf("error acknowledging message: %v", err)
+		return
+	}
+
+	if err := postAck(msg); err != nil {
+		t.l.Error().Msgf("error in post-ack: %v", err)
+		return
+	}
+}
+
+// deadLetter moves a message to the dead letter stream, and removes it from its queue.
+func (t *MessageQueueImpl) deadLetter(q msgqueue.Queue, natsMsg jetstream.Msg, delivered int) {
+	ctx, cancel := context.WithTimeout(t.ctx, 5*time.Second)
+	defer cancel()
+
+	dlq := nats.NewMsg(t.deadLetterSubject(q))
+	dlq.Header.Set("Hatchet-Queue", q.Name())
+	dlq.Header.Set("Hatchet-Delivered", strconv.Itoa(delivered))
+	dlq.Data = natsMsg.Data()
+
+	if _, err := t.js.PublishMsg(ctx, dlq); err != nil {
+		t.l.Error().Msgf("error moving message to the dead letter queue: %v", err)
+
+		// the message is delivered again, and moved to the dead letter queue on the next attempt
+		if err := natsMsg.NakWithDelay(NAK_DELAY); err != nil {
+			t.l.Error().Msgf("error rejecting message: %v", err)
+		}
+
+		return
+	}
+
+	if err := natsMsg.Term(); err != nil {
+		t.l.Error().Msgf("error terminating message: %v", err)
+	}
+}
+
+// initQueue creates the stream and the consumer of a durable queue, and returns the consumer.
+func (t *MessageQueueImpl) initQueue(ctx context.Context, js jetstream.JetStream, q msgqueue.Queue) (jetstream.Consumer, error) {
+	if consumer, ok := t.streams.Load(q.Name()); ok {
+		return consumer.(jetstream.Consumer), nil
+	}
+
+	_, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:      t.streamName(q),
+		Subjects:  []string{t.queueSubject(q)},
+		Retention: jetstream.WorkQueuePolicy,
+		Storage:   jetstream.FileStorage,
+		Replicas:  t.replicas,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot create stream of queue %s: %w", q.Name(), err)
+	}
+
+	consumer, err := js.CreateOrUpdateConsumer(ctx, t.streamName(q), jetstream.ConsumerConfig{
+		Durable:       q.Name(),
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       ACK_WAIT,
+		MaxAckPending: -1,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot create consumer of queue %s: %w", q.Name(), err)
+	}
+
+	t.streams.Store(q.Name(), consumer)
+
+	return consumer, nil
+}
+
+func (t *MessageQueueImpl) initDeadLetterStream(ctx context.Context, js jetstream.JetStream) error {
+	_, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:      t.prefix + "_dead_letter",
+		Subjects:  []string{t.prefix + ".dlq.>"},
+		Retention: jetstream.LimitsPolicy,
+		Storage:   jetstream.FileStorage,
+		Replicas:  t.replicas,
+		MaxAge:    DEAD_LETTER_MAX_AGE,
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot create dead letter stream: %w", err)
+	}
+
+	return nil
+}
+
+// dial connects to the server and inits the static queues, and retries MAX_RETRY_COUNT times. Once connected, the
+// client opens the connection again whenever it's lost.
+func (t *MessageQueueImpl) dial(ctx context.Context) (*nats.Conn, jetstream.JetStream, error) {
+	var err error
+
+	for i := 0; i < MAX_RETRY_COUNT; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, nil, ctx.Err()
+			case <-time.After(RETRY_INTERVAL):
+			}
+		}
+
+		var nc *nats.Conn
+		var js jetstream.JetStream
+
+		nc, js, err = t.connect(ctx)
+
+		if err == nil {
+			if i > 0 {
+				t.l.Info().Msgf("re-established connection after %d attempts", i)
+			}
+
+			return nc, js, nil
+		}
+
+		t.l.Error().Msgf("error connecting to nats (attempt %d): %v", i+1, err)
+	}
+
+	return nil, nil, fmt.Errorf("failed to connect to nats after %d attempts: %w", MAX_RETRY_COUNT, err)
+}
+
+func (t *MessageQueueImpl) connect(ctx context.Context) (*nats.Conn, jetstream.JetStream, error) {
+	nc, err := nats.Connect(
+		t.url,
+		nats.Name("hatchet-"+t.identity[:8]),
+		nats.MaxReconnects(-1),
+		nats.ReconnectWait(RETRY_INTERVAL),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			if err != nil {
+				t.l.Error().Msgf("lost connection to nats, reconnecting: %v", err)
+			}
+		}),
+		nats.ReconnectHandler(func(_ *nats.Conn) {
+			t.l.Info().Msg("re-established connection to nats")
+
+			// the streams may have been deleted while the connection was lost
+			t.streams.Range(func(key, _ interface{}) bool {
+				t.streams.Delete(key)
+				return true
+			})
+		}),
+	)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	js, err := jetstream.New(nc)
+
+	if err != nil {
+		nc.Close()
+		return nil, nil, err
+	}
+
+	initErr := t.initDeadLetterStream(ctx, js)
+
+	for _, q := range []msgqueue.Queue{
+		msgqueue.EVENT_PROCESSING_QUEUE,
+		msgqueue.JOB_PROCESSING_QUEUE,
+		msgqueue.WORKFLOW_PROCESSING_QUEUE,
+	} {
+		if initErr != nil {
+			break
+		}
+
+		_, initErr = t.initQueue(ctx, js, q)
+	}
+
+	if initErr != nil {
+		nc.Close()
+		return nil, nil, initErr
+	}
+
+	return nc, js, nil
+}
+
+func (t *MessageQueueImpl) streamName(q msgqueue.Queue) string {
+	return fmt.Sprintf("%s_%s", t.prefix, q.Name())
+}
+
+func (t *MessageQueueImpl) queueSubject(q msgqueue.Queue) string {
+	return fmt.Sprintf("%s.queue.%s", t.prefix, q.Name())
+}
+
+func (t *MessageQueueImpl) deadLetterSubject(q msgqueue.Queue) string {
+	return fmt.Sprintf("%s.dlq.%s", t.prefix, q.Name())
+}
+
+func (t *MessageQueueImpl) consumerSubject(q msgqueue.Queue) string {
+	return fmt.Sprintf("%s.consumer.%s", t.prefix, q.Name())
+}
+
+func (t *MessageQueueImpl) tenantSubject(tenantId string) string {
+	return fmt.Sprintf("%s.tenant.%s", t.prefix, tenantId)
+}
+
+func decode(data []byte) (*msgqueue.Message, error) {
+	if len(data) == 0 {
+		return nil, errors.New("empty message body")
+	}
+
+	msg := &msgqueue.Message{}
+
+	if err := json.Unmarshal(data, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// identity returns the same host/process unique string for the lifetime of
+// this process.
+func identity() string {
+	hostname, err := os.Hostname()
+	h := sha256.New()
+	_, _ = fmt.Fprint(h, hostname)
+	_, _ = fmt.Fprint(h, err)
+	_, _ = fmt.Fprint(h, os.Getpid())
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
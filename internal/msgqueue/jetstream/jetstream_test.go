@@ -0,0 +1,161 @@
+//go:build integration
+
+package jetstream_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue/jetstream"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue/msgqueuetest"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+)
+
+const url = "nats://localhost:4222"
+
+func TestMessageQueueIntegration(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2) // we wait for 2 messages here
+
+	cleanup, tq := jetstream.New(
+		jetstream.WithURL(url),
+		jetstream.WithQos(100),
+	)
+	defer cleanup() // nolint: errcheck
+
+	require.NotNil(t, tq, "task queue implementation should not be nil")
+
+	id, _ := random.Generate(8) // nolint: errcheck
+
+	staticQueue := msgqueue.EVENT_PROCESSING_QUEUE
+	task := &msgqueue.Message{
+		ID:         id,
+		Payload:    map[string]interface{}{"key": "value"},
+		Metadata:   map[string]interface{}{"tenant_id": "test-tenant"},
+		Retries:    1,
+		RetryDelay: 5,
+	}
+
+	err := tq.AddMessage(ctx, staticQueue, task)
+	assert.NoError(t, err, "adding task to static queue should not error")
+
+	preAck := func(receivedMessage *msgqueue.Message) error {
+		if receivedMessage.ID != task.ID {
+			return nil
+		}
+
+		defer wg.Done()
+		return nil
+	}
+
+	cleanupQueue, err := tq.Subscribe(staticQueue, preAck, msgqueue.NoOpHook)
+	require.NoError(t, err, "subscribing to static queue should not error")
+
+	tenantQueue := msgqueue.TenantEventConsumerQueue("test-tenant")
+
+	tqAck := func(receivedMessage *msgqueue.Message) error {
+		defer wg.Done()
+		assert.Equal(t, task.ID, receivedMessage.ID, "received tenant task ID should match sent task ID")
+		return nil
+	}
+
+	cleanupTenantQueue, err := tq.Subscribe(tenantQueue, tqAck, msgqueue.NoOpHook)
+	require.NoError(t, err, "subscribing to tenant-specific queue should not error")
+
+	// send task to tenant-specific queue after 1 second to give time for subscriber
+	go func() {
+		time.Sleep(1 * time.Second)
+		err := tq.AddMessage(ctx, tenantQueue, task)
+		assert.NoError(t, err, "adding task to tenant-specific queue should not error")
+	}()
+
+	wg.Wait()
+
+	require.NoError(t, cleanupQueue())
+	require.NoError(t, cleanupTenantQueue())
+}
+
+func TestDeadLetteringSuccess(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var mu sync.Mutex
+	var attempts int
+
+	done := make(chan struct{})
+
+	cleanup, tq := jetstream.New(
+		jetstream.WithURL(url),
+		jetstream.WithQos(100),
+	)
+	defer cleanup() // nolint: errcheck
+
+	require.NotNil(t, tq, "task queue implementation should not be nil")
+
+	id, _ := random.Generate(8) // nolint: errcheck
+
+	staticQueue := msgqueue.EVENT_PROCESSING_QUEUE
+	task := &msgqueue.Message{
+		ID:         id,
+		Payload:    map[string]interface{}{"key": "value"},
+		Metadata:   map[string]interface{}{"tenant_id": "test-tenant"},
+		Retries:    2, // Allow up to 2 retries
+		RetryDelay: 5,
+	}
+
+	err := tq.AddMessage(ctx, staticQueue, task)
+	assert.NoError(t, err, "adding task to static queue should not error")
+
+	preAck := func(receivedMessage *msgqueue.Message) error {
+		if receivedMessage.ID != task.ID {
+			return nil
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		attempts++
+
+		if attempts <= 2 {
+			return fmt.Errorf("intentional error on attempt %d", attempts)
+		}
+
+		close(done)
+		return nil
+	}
+
+	cleanupQueue, err := tq.Subscribe(staticQueue, preAck, msgqueue.NoOpHook)
+	require.NoError(t, err, "subscribing to static queue should not error")
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		t.Fatal("message was not processed after retries")
+	}
+
+	require.NoError(t, cleanupQueue())
+}
+
+func TestConformance(t *testing.T) {
+	msgqueuetest.RunConformanceTests(t, func(t *testing.T) msgqueue.MessageQueue {
+		cleanup, mq := jetstream.New(
+			jetstream.WithURL(url),
+			jetstream.WithQos(100),
+		)
+		require.NotNil(t, mq, "message queue should not be nil")
+
+		t.Cleanup(func() { _ = cleanup() })
+
+		return mq
+	})
+}
@@ -0,0 +1,237 @@
+// Package msgqueuetest contains conformance tests for message queue drivers. Driver authors run them against
+// their message queue with RunConformanceTests:
+//
+//	func TestConformance(t *testing.T) {
+//		msgqueuetest.RunConformanceTests(t, func(t *testing.T) msgqueue.MessageQueue {
+//			cleanup, mq := mydriver.New(...)
+//			t.Cleanup(func() { _ = cleanup() })
+//			return mq
+//		})
+//	}
+//
+// The tests share the static queues, so messages which other tests add to them are ignored, and a message queue
+// which is running elsewhere shouldn't be subscribed to the same static queues while the tests run.
+package msgqueuetest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+)
+
+// Timeout is how long the tests wait for a message. Messages which fail their pre-ack hook may be delayed by the
+// driver before they're delivered again, so this is longer than a driver should take to deliver a message.
+var Timeout = 30 * time.Second
+
+// SubscribeDelay is how long the tests wait after subscribing to queues which drop messages without subscribers,
+// since drivers may subscribe asynchronously.
+var SubscribeDelay = time.Second
+
+// RunConformanceTests runs the conformance tests against the message queues created by newMessageQueue. A new
+// message queue is created for each test, and it should be cleaned up with t.Cleanup.
+func RunConformanceTests(t *testing.T, newMessageQueue func(t *testing.T) msgqueue.MessageQueue) {
+	t.Run("ready", func(t *testing.T) {
+		mq := newMessageQueue(t)
+
+		require.NotNil(t, mq, "message queue should not be nil")
+
+		assert.Eventually(t, mq.IsReady, Timeout, 10*time.Millisecond, "message queue should be ready")
+	})
+
+	t.Run("static queue", func(t *testing.T) {
+		mq := newMessageQueue(t)
+		received := subscribe(t, mq, msgqueue.EVENT_PROCESSING_QUEUE, nil)
+
+		msg := newMessage(t, "")
+		require.NoError(t, mq.AddMessage(context.Background(), msgqueue.EVENT_PROCESSING_QUEUE, msg))
+
+		receivedMsg := receive(t, received, msg.ID)
+		assert.Equal(t, msg.Payload, receivedMsg.Payload, "received payload should match sent payload")
+
+		expectNone(t, received, msg.ID)
+	})
+
+	t.Run("consumer queue", func(t *testing.T) {
+		mq := newMessageQueue(t)
+		queue := msgqueue.QueueTypeFromTickerID(newId(t))
+		received := subscribe(t, mq, queue, nil)
+
+		time.Sleep(SubscribeDelay)
+
+		msg := newMessage(t, "")
+		require.NoError(t, mq.AddMessage(context.Background(), queue, msg))
+
+		receive(t, received, msg.ID)
+	})
+
+	t.Run("redelivers messages which fail pre-ack", func(t *testing.T) {
+		mq := newMessageQueue(t)
+
+		var mu sync.Mutex
+		attempts := map[string]int{}
+
+		received := subscribe(t, mq, msgqueue.JOB_PROCESSING_QUEUE, func(msg *msgqueue.Message) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			attempts[msg.ID]++
+
+			if attempts[msg.ID] == 1 {
+				return fmt.Errorf("intentional error on attempt %d", attempts[msg.ID])
+			}
+
+			return nil
+		})
+
+		msg := newMessage(t, "")
+		msg.Retries = 1
+
+		require.NoError(t, mq.AddMessage(context.Background(), msgqueue.JOB_PROCESSING_QUEUE, msg))
+
+		receive(t, received, msg.ID)
+		receive(t, received, msg.ID)
+
+		expectNone(t, received, msg.ID)
+	})
+
+	t.Run("tenant queue", func(t *testing.T) {
+		mq := newMessageQueue(t)
+		tenantId := newId(t)
+
+		require.NoError(t, mq.RegisterTenant(context.Background(), tenantId))
+
+		queue := msgqueue.TenantEventConsumerQueue(tenantId)
+
+		// every subscriber of the tenant receives its messages
+		received1 := subscribe(t, mq, queue, nil)
+		received2 := subscribe(t, mq, queue, nil)
+
+		time.Sleep(SubscribeDelay)
+
+		msg := newMessage(t, tenantId)
+		require.NoError(t, mq.AddMessage(context.Background(), msgqueue.WORKFLOW_PROCESSING_QUEUE, msg))
+
+		receive(t, received1, msg.ID)
+		receive(t, received2, msg.ID)
+	})
+
+	t.Run("stops delivering after cleanup", func(t *testing.T) {
+		mq := newMessageQueue(t)
+		queue := msgqueue.QueueTypeFromDispatcherID(newId(t))
+
+		stopped := make(chan *msgqueue.Message, 10)
+
+		cleanup, err := mq.Subscribe(queue, func(msg *msgqueue.Message) error {
+			stopped <- msg
+			return nil
+		}, msgqueue.NoOpHook)
+
+		require.NoError(t, err, "subscribing should not error")
+		require.NoError(t, cleanup(), "cleaning up the subscription should not error")
+
+		received := subscribe(t, mq, queue, nil)
+
+		time.Sleep(SubscribeDelay)
+
+		msg := newMessage(t, "")
+		require.NoError(t, mq.AddMessage(context.Background(), queue, msg))
+
+		receive(t, received, msg.ID)
+		expectNone(t, stopped, msg.ID)
+	})
+}
+
+// subscribe subscribes to the queue, and sends every delivery to the returned channel before the pre-ack hook runs.
+// The subscription is cleaned up when the test finishes.
+func subscribe(t *testing.T, mq msgqueue.MessageQueue, queue msgqueue.Queue, preAck msgqueue.AckHook) chan *msgqueue.Message {
+	t.Helper()
+
+	received := make(chan *msgqueue.Message, 100)
+
+	cleanup, err := mq.Subscribe(queue, func(msg *msgqueue.Message) error {
+		select {
+		case received <- msg:
+		default:
+		}
+
+		if preAck != nil {
+			return preAck(msg)
+		}
+
+		return nil
+	}, msgqueue.NoOpHook)
+
+	require.NoError(t, err, "subscribing to %s should not error", queue.Name())
+
+	t.Cleanup(func() {
+		assert.NoError(t, cleanup(), "cleaning up the subscription to %s should not error", queue.Name())
+	})
+
+	return received
+}
+
+// receive waits for the message with the given id, and ignores other messages.
+func receive(t *testing.T, received chan *msgqueue.Message, id string) *msgqueue.Message {
+	t.Helper()
+
+	timeout := time.After(Timeout)
+
+	for {
+		select {
+		case msg := <-received:
+			if msg.ID == id {
+				return msg
+			}
+		case <-timeout:
+			require.FailNow(t, "message was not received", "message %s was not received within %s", id, Timeout)
+		}
+	}
+}
+
+// expectNone checks that the message with the given id isn't received again.
+func expectNone(t *testing.T, received chan *msgqueue.Message, id string) {
+	t.Helper()
+
+	timeout := time.After(time.Second)
+
+	for {
+		select {
+		case msg := <-received:
+			if msg.ID == id {
+				assert.Fail(t, "message was received again", "message %s was received again", id)
+				return
+			}
+		case <-timeout:
+			return
+		}
+	}
+}
+
+func newMessage(t *testing.T, tenantId string) *msgqueue.Message {
+	msg := &msgqueue.Message{
+		ID:       newId(t),
+		Payload:  map[string]interface{}{"key": "value"},
+		Metadata: map[string]interface{}{},
+	}
+
+	if tenantId != "" {
+		msg.Metadata["tenant_id"] = tenantId
+	}
+
+	return msg
+}
+
+func newId(t *testing.T) string {
+	id, err := random.Generate(8)
+	require.NoError(t, err)
+
+	return id
+}
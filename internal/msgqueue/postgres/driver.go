@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+)
+
+func init() {
+	msgqueue.Register("postgres", msgqueue.MessageQueueDriverFunc(newDriver))
+}
+
+// Config is the config of the driver, which is set under msgQueue.postgres in the server config.
+type Config struct {
+	Qos int `json:"qos"`
+}
+
+func newDriver(opts *msgqueue.DriverOpts) (func() error, msgqueue.MessageQueue, error) {
+	cf := Config{}
+
+	if err := opts.Config(&cf); err != nil {
+		return nil, nil, fmt.Errorf("could not decode postgres config: %w", err)
+	}
+
+	if opts.Repository == nil {
+		return nil, nil, fmt.Errorf("the postgres message queue requires a repository")
+	}
+
+	cleanup, mq := New(
+		WithRepository(opts.Repository.MessageQueue()),
+		WithLogger(opts.Logger),
+		WithQos(cf.Qos),
+		WithDisableTenantExchangePubs(opts.DisableTenantPubs),
+	)
+
+	if mq == nil {
+		return nil, nil, fmt.Errorf("could not create postgres message queue")
+	}
+
+	return cleanup, mq, nil
+}
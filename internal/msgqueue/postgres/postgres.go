@@ -0,0 +1,569 @@
+// Package postgres implements the message queue with Postgres, so Hatchet can run without a separate message broker.
+//
+// Messages are rows of the MessageQueueItem table. Subscribers read the messages of a queue with FOR UPDATE SKIP
+// LOCKED, which hides them for a visibility timeout, and delete them once they're handled, so messages are delivered
+// at least once. Subscribers are woken up with LISTEN/NOTIFY when a message is added, and also poll the table in case
+// a notification is missed. Messages of tenants are read by every subscriber of the tenant, and expire shortly after
+// they're added.
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/logger"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+const RETRY_INTERVAL = 2 * time.Second
+
+// NACK_DELAY is how long a message whose pre-ack hook failed waits before it's read again
+const NACK_DELAY = 5 * time.Second
+
+// VISIBILITY_TIMEOUT is how long a message is hidden from other subscribers after it's read. Messages which aren't
+// handled before the timeout are read again.
+const VISIBILITY_TIMEOUT = 60 * time.Second
+
+// POLL_INTERVAL is how often subscribers read their queue if they aren't notified
+const POLL_INTERVAL = time.Second
+
+// NON_DURABLE_MESSAGE_TTL is how long the messages of queues which don't survive restarts are kept
+const NON_DURABLE_MESSAGE_TTL = 5 * time.Minute
+
+// TENANT_MESSAGE_TTL is how long the messages of tenants are kept after they're added
+const TENANT_MESSAGE_TTL = time.Minute
+
+// CLEANUP_INTERVAL is how often expired messages are deleted
+const CLEANUP_INTERVAL = time.Minute
+
+// DEFAULT_QOS is the number of messages which a subscriber handles at once if the qos isn't set
+const DEFAULT_QOS = 100
+
+// MessageQueueImpl implements MessageQueue interface using Postgres.
+type MessageQueueImpl struct {
+	ctx      context.Context
+	repo     repository.MessageQueueRepository
+	configFs []MessageQueueImplOpt
+
+	qos int
+
+	l *zerolog.Logger
+
+	disableTenantExchangePubs bool
+
+	mu    sync.Mutex
+	ready bool
+
+	// listeners are notified when a message is added to their queue, and whether dropped notifications are logged
+	listeners map[string]map[chan *repository.MessageQueueNotification]bool
+}
+
+func (t *MessageQueueImpl) IsReady() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.ready
+}
+
+type MessageQueueImplOpt func(*MessageQueueImplOpts)
+
+type MessageQueueImplOpts struct {
+	l                         *zerolog.Logger
+	repo                      repository.MessageQueueRepository
+	qos                       int
+	disableTenantExchangePubs bool
+}
+
+func defaultMessageQueueImplOpts() *MessageQueueImplOpts {
+	l := logger.NewDefaultLogger("postgres-msgqueue")
+
+	return &MessageQueueImplOpts{
+		l:                         &l,
+		disableTenantExchangePubs: false,
+	}
+}
+
+func WithLogger(l *zerolog.Logger) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.l = l
+	}
+}
+
+func WithRepository(repo repository.MessageQueueRepository) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.repo = repo
+	}
+}
+
+func WithQos(qos int) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.qos = qos
+	}
+}
+
+func WithDisableTenantExchangePubs(disable bool) MessageQueueImplOpt {
+	return func(opts *MessageQueueImplOpts) {
+		opts.disableTenantExchangePubs = disable
+	}
+}
+
+// New creates a new MessageQueueImpl.
+func New(fs ...MessageQueueImplOpt) (func() error, *MessageQueueImpl) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	opts := defaultMessageQueueImplOpts()
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	newLogger := opts.l.With().Str("service", "events-controller").Logger()
+	opts.l = &newLogger
+
+	if opts.repo == nil {
+		opts.l.Error().Msg("a message queue repository is required")
+		cancel()
+		return nil, nil
+	}
+
+	t := &MessageQueueImpl{
+		ctx:                       ctx,
+		repo:                      opts.repo,
+		configFs:                  fs,
+		qos:                       opts.qos,
+		l:                         opts.l,
+		disableTenantExchangePubs: opts.disableTenantExchangePubs,
+		listeners:                 make(map[string]map[chan *repository.MessageQueueNotification]bool),
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		t.listen(ctx)
+	}()
+
+	go func() {
+		defer wg.Done()
+		t.cleanup(ctx)
+	}()
+
+	cleanup := func() error {
+		cancel()
+		wg.Wait()
+
+		return nil
+	}
+
+	return cleanup, t
+}
+
+func (t *MessageQueueImpl) Clone() (func() error, msgqueue.MessageQueue) {
+	return New(t.configFs...)
+}
+
+func (t *MessageQueueImpl) SetQOS(prefetchCount int) {
+	t.qos = prefetchCount
+}
+
+// AddMessage adds a msg to the queue. The msg is stored once this returns.
+func (t *MessageQueueImpl) AddMessage(ctx context.Context, q msgqueue.Queue, msg *msgqueue.Message) error {
+	ctx, span := telemetry.NewSpan(ctx, "add-message")
+	defer span.End()
+
+	// inject otel carrier into the message
+	if msg.OtelCarrier == nil {
+		msg.OtelCarrier = telemetry.GetCarrier(ctx)
+	}
+
+	body, err := json.Marshal(msg)
+
+	if err != nil {
+		return fmt.Errorf("error marshaling msg: %w", err)
+	}
+
+	// messages which are added to a tenant pub/sub queue directly are read by all subscribers of the tenant
+	if q.FanoutExchangeKey() != "" {
+		return t.addTenantMessage(ctx, q.FanoutExchangeKey(), msg.ID, body)
+	}
+
+	var expiresAt *time.Time
+
+	if !q.Durable() || msg.ImmediatelyExpire {
+		expires := time.Now().UTC().Add(NON_DURABLE_MESSAGE_TTL)
+		expiresAt = &expires
+	}
+
+	t.l.Debug().Msgf("publishing msg %s to queue %s", msg.ID, q.Name())
+
+	if _, err := t.repo.AddMessage(ctx, q.Name(), body, expiresAt); err != nil {
+		return fmt.Errorf("error publishing msg %s to queue %s: %w", msg.ID, q.Name(), err)
+	}
+
+	// if this is a tenant msg, publish to the tenant queue
+	if !t.disableTenantExchangePubs && msg.TenantID() != "" {
+		if err := t.addTenantMessage(ctx, msg.TenantID(), msg.ID, body); err != nil {
+			t.l.Error().Msgf("error publishing tenant msg: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// Subscribe subscribes to the msg queue.
+func (t *MessageQueueImpl) Subscribe(
+	q msgqueue.Queue,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+) (func() error, error) {
+	t.l.Debug().Msgf("subscribing to queue: %s", q.Name())
+
+	ctx, cancel := context.WithCancel(t.ctx)
+
+	wg := &sync.WaitGroup{}
+
+	if q.FanoutExchangeKey() != "" {
+		t.subscribeTenant(ctx, q.FanoutExchangeKey(), preAck, postAck, wg)
+	} else {
+		t.subscribeQueue(ctx, q, preAck, postAck, wg)
+	}
+
+	cleanup := func() error {
+		cancel()
+
+		t.l.Debug().Msgf("shutting down subscriber of queue: %s", q.Name())
+		wg.Wait()
+		t.l.Debug().Msgf("successfully shut down subscriber of queue: %s", q.Name())
+		return nil
+	}
+
+	return cleanup, nil
+}
+
+// RegisterTenant is a no-op, since the messages of tenants are stored in the same table as all other messages.
+func (t *MessageQueueImpl) RegisterTenant(ctx context.Context, tenantId string) error {
+	return nil
+}
+
+func (t *MessageQueueImpl) addTenantMessage(ctx context.Context, tenantId, msgId string, body []byte) error {
+	t.l.Debug().Msgf("publishing tenant msg %s to tenant %s", msgId, tenantId)
+
+	expiresAt := time.Now().UTC().Add(TENANT_MESSAGE_TTL)
+
+	_, err := t.repo.AddMessage(ctx, tenantQueueName(tenantId), body, &expiresAt)
+
+	return err
+}
+
+// subscribeQueue reads the messages of a queue, and handles at most qos messages at once.
+func (t *MessageQueueImpl) subscribeQueue(
+	ctx context.Context,
+	q msgqueue.Queue,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+	wg *sync.WaitGroup,
+) {
+	qos := t.qos
+
+	if qos <= 0 {
+		qos = DEFAULT_QOS
+	}
+
+	// notifications only wake up the subscriber, so they're dropped while it has a pending notification
+	notifications := t.addListener(q.Name(), 1, false)
+
+	var mu sync.Mutex
+	inFlight := 0
+
+	// done is notified whenever a message was handled
+	done := make(chan struct{}, 1)
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		defer t.removeListener(q.Name(), notifications)
+
+		ticker := time.NewTicker(POLL_INTERVAL)
+		defer ticker.Stop()
+
+		for {
+			mu.Lock()
+			free := qos - inFlight
+			mu.Unlock()
+
+			// messages are read again right away if the queue may have more messages than were read
+			more := false
+
+			if free > 0 {
+				items, err := t.repo.ReadMessages(ctx, q.Name(), free, VISIBILITY_TIMEOUT)
+
+				if err != nil && ctx.Err() == nil {
+					t.l.Error().Msgf("error reading messages of queue %s: %v", q.Name(), err)
+				}
+
+				more = len(items) == free
+
+				for _, item := range items {
+					mu.Lock()
+					inFlight++
+					mu.Unlock()
+
+					wg.Add(1)
+
+					go func(item *dbsqlc.MessageQueueItem) {
+						defer wg.Done()
+
+						defer func() {
+							mu.Lock()
+							inFlight--
+							mu.Unlock()
+
+							select {
+							case done <- struct{}{}:
+							default:
+							}
+						}()
+
+						t.handleMessage(ctx, item, preAck, postAck)
+					}(item)
+				}
+			}
+
+			if more {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-notifications:
+			case <-done:
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (t *MessageQueueImpl) handleMessage(
+	ctx context.Context,
+	item *dbsqlc.MessageQueueItem,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+) {
+	// the message is acknowledged after the subscriber has been shut down, so it isn't handled again
+	ackCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	msg := &msgqueue.Message{}
+
+	if err := json.Unmarshal(item.Payload, msg); err != nil {
+		t.l.Error().Msgf("error unmarshalling message: %v", err)
+
+		// the message can never be handled, so it's removed
+		if err := t.repo.AckMessage(ackCtx, item.ID); err != nil {
+			t.l.Error().Msgf("error acknowledging message: %v", err)
+		}
+
+		return
+	}
+
+	// determine if we've hit the max number of retries
+	if int(item.Attempts)-1 > msg.Retries {
+		t.l.Debug().Msgf("message %s has been rejected %d times, not requeuing", msg.ID, item.Attempts-1)
+
+		// acknowledge so it's removed from the queue
+		if err := t.repo.AckMessage(ackCtx, item.ID); err != nil {
+			t.l.Error().Msgf("error acknowledging message: %v", err)
+		}
+
+		return
+	}
+
+	t.l.Debug().Msgf("got msg: %v", msg.ID)
+
+	if err := preAck(msg); err != nil {
+		t.l.Error().Msgf("error in pre-ack: %v", err)
+
+		if err := t.repo.NackMessage(ackCtx, item.ID, NACK_DELAY); err != nil {
+			t.l.Error().Msgf("error rejecting message: %v", err)
+		}
+
+		return
+	}
+
+	if err := t.repo.AckMessage(ackCtx, item.ID); err != nil {
+		t.l.Error().Msgf("error acknowledging message: %v", err)
+		return
+	}
+
+	if err := postAck(msg); err != nil {
+		t.l.Error().Msgf("error in post-ack: %v", err)
+		return
+	}
+}
+
+// subscribeTenant reads the messages of a tenant when they're added. The messages aren't removed, since every
+// subscriber of the tenant reads them, so messages which are added while the subscriber isn't listening are missed.
+func (t *MessageQueueImpl) subscribeTenant(
+	ctx context.Context,
+	tenantId string,
+	preAck msgqueue.AckHook,
+	postAck msgqueue.AckHook,
+	wg *sync.WaitGroup,
+) {
+	queueName := tenantQueueName(tenantId)
+	notifications := t.addListener(queueName, 1000, true)
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		defer t.removeListener(queueName, notifications)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case notification := <-notifications:
+				items, err := t.repo.ListMessagesByIds(ctx, []int64{notification.MessageId})
+
+				if err != nil {
+					if ctx.Err() == nil {
+						t.l.Error().Msgf("error reading tenant message: %v", err)
+					}
+
+					continue
+				}
+
+				for _, item := range items {
+					msg := &msgqueue.Message{}
+
+					if err := json.Unmarshal(item.Payload, msg); err != nil {
+						t.l.Error().Msgf("error unmarshalling message: %v", err)
+						continue
+					}
+
+					if err := preAck(msg); err != nil {
+						t.l.Error().Msgf("error in pre-ack: %v", err)
+						continue
+					}
+
+					if err := postAck(msg); err != nil {
+						t.l.Error().Msgf("error in post-ack: %v", err)
+					}
+				}
+			}
+		}
+	}()
+}
+
+// listen passes the notifications of the message queue to the listeners of their queues, and listens again after
+// the connection fails.
+func (t *MessageQueueImpl) listen(ctx context.Context) {
+	for {
+		t.setReady(true)
+
+		err := t.repo.Listen(ctx, func(notification *repository.MessageQueueNotification) {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+
+			for listener, logDropped := range t.listeners[notification.QueueName] {
+				select {
+				case listener <- notification:
+				default:
+					if logDropped {
+						t.l.Warn().Msgf("dropping notification of queue %s, the subscriber is busy", notification.QueueName)
+					}
+				}
+			}
+		})
+
+		t.setReady(false)
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		t.l.Error().Msgf("error listening to message queue notifications, listening again: %v", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(RETRY_INTERVAL):
+		}
+	}
+}
+
+// cleanup deletes the expired messages.
+func (t *MessageQueueImpl) cleanup(ctx context.Context) {
+	ticker := time.NewTicker(CLEANUP_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := t.repo.DeleteExpiredMessages(ctx)
+
+			if err != nil {
+				if ctx.Err() == nil {
+					t.l.Error().Msgf("error deleting expired messages: %v", err)
+				}
+
+				continue
+			}
+
+			if deleted > 0 {
+				t.l.Debug().Msgf("deleted %d expired messages", deleted)
+			}
+		}
+	}
+}
+
+func (t *MessageQueueImpl) addListener(queueName string, size int, logDropped bool) chan *repository.MessageQueueNotification {
+	listener := make(chan *repository.MessageQueueNotification, size)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.listeners[queueName] == nil {
+		t.listeners[queueName] = make(map[chan *repository.MessageQueueNotification]bool)
+	}
+
+	t.listeners[queueName][listener] = logDropped
+
+	return listener
+}
+
+func (t *MessageQueueImpl) removeListener(queueName string, listener chan *repository.MessageQueueNotification) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.listeners[queueName], listener)
+
+	if len(t.listeners[queueName]) == 0 {
+		delete(t.listeners, queueName)
+	}
+}
+
+func (t *MessageQueueImpl) setReady(ready bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.ready = ready
+}
+
+func tenantQueueName(tenantId string) string {
+	return fmt.Sprintf("tenant:%s", tenantId)
+}
@@ -0,0 +1,259 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue/msgqueuetest"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// fakeRepository stores messages in memory. Messages which are rejected are visible again right away.
+type fakeRepository struct {
+	mu        sync.Mutex
+	nextId    int64
+	items     map[int64]*dbsqlc.MessageQueueItem
+	hidden    map[int64]bool
+	listeners []func(notification *repository.MessageQueueNotification)
+}
+
+func newFakeRepository() *fakeRepository {
+	return &fakeRepository{
+		items:  make(map[int64]*dbsqlc.MessageQueueItem),
+		hidden: make(map[int64]bool),
+	}
+}
+
+func (r *fakeRepository) AddMessage(ctx context.Context, queueName string, payload []byte, expiresAt *time.Time) (int64, error) {
+	r.mu.Lock()
+	r.nextId++
+	id := r.nextId
+	r.items[id] = &dbsqlc.MessageQueueItem{ID: id, QueueName: queueName, Payload: payload}
+	listeners := r.listeners
+	r.mu.Unlock()
+
+	for _, listener := range listeners {
+		listener(&repository.MessageQueueNotification{QueueName: queueName, MessageId: id})
+	}
+
+	return id, nil
+}
+
+func (r *fakeRepository) ReadMessages(ctx context.Context, queueName string, limit int, visibilityTimeout time.Duration) ([]*dbsqlc.MessageQueueItem, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var res []*dbsqlc.MessageQueueItem
+
+	for id := int64(1); id <= r.nextId && len(res) < limit; id++ {
+		item, ok := r.items[id]
+
+		if !ok || item.QueueName != queueName || r.hidden[id] {
+			continue
+		}
+
+		item.Attempts++
+		r.hidden[id] = true
+
+		copied := *item
+		res = append(res, &copied)
+	}
+
+	return res, nil
+}
+
+func (r *fakeRepository) ListMessagesByIds(ctx context.Context, ids []int64) ([]*dbsqlc.MessageQueueItem, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var res []*dbsqlc.MessageQueueItem
+
+	for _, id := range ids {
+		if item, ok := r.items[id]; ok {
+			res = append(res, item)
+		}
+	}
+
+	return res, nil
+}
+
+func (r *fakeRepository) AckMessage(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.items, id)
+
+	return nil
+}
+
+func (r *fakeRepository) NackMessage(ctx context.Context, id int64, delay time.Duration) error {
+	r.mu.Lock()
+	r.hidden[id] = false
+	listeners := r.listeners
+	queueName := r.items[id].QueueName
+	r.mu.Unlock()
+
+	for _, listener := range listeners {
+		listener(&repository.MessageQueueNotification{QueueName: queueName, MessageId: id})
+	}
+
+	return nil
+}
+
+func (r *fakeRepository) DeleteExpiredMessages(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+func (r *fakeRepository) Listen(ctx context.Context, handler func(notification *repository.MessageQueueNotification)) error {
+	r.mu.Lock()
+	r.listeners = append(r.listeners, handler)
+	r.mu.Unlock()
+
+	<-ctx.Done()
+
+	return nil
+}
+
+func (r *fakeRepository) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.items)
+}
+
+func newTestQueue(t *testing.T, repo *fakeRepository) *MessageQueueImpl {
+	cleanup, mq := New(WithRepository(repo), WithQos(10))
+	require.NotNil(t, mq)
+
+	t.Cleanup(func() { _ = cleanup() })
+
+	// wait for the message queue to listen for notifications
+	require.Eventually(t, func() bool {
+		repo.mu.Lock()
+		defer repo.mu.Unlock()
+
+		return len(repo.listeners) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return mq
+}
+
+func TestMessageQueue(t *testing.T) {
+	repo := newFakeRepository()
+	mq := newTestQueue(t, repo)
+
+	received := make(chan *msgqueue.Message, 1)
+
+	cleanupQueue, err := mq.Subscribe(msgqueue.EVENT_PROCESSING_QUEUE, func(msg *msgqueue.Message) error {
+		received <- msg
+		return nil
+	}, msgqueue.NoOpHook)
+
+	require.NoError(t, err)
+	defer cleanupQueue() // nolint: errcheck
+
+	err = mq.AddMessage(context.Background(), msgqueue.EVENT_PROCESSING_QUEUE, &msgqueue.Message{
+		ID:      "event",
+		Payload: map[string]interface{}{"key": "value"},
+	})
+
+	require.NoError(t, err)
+
+	select {
+	case msg := <-received:
+		assert.Equal(t, "event", msg.ID)
+		assert.Equal(t, "value", msg.Payload["key"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("message was not received")
+	}
+
+	// the message is deleted once it's acknowledged
+	assert.Eventually(t, func() bool { return repo.count() == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestMessageQueueRetries(t *testing.T) {
+	repo := newFakeRepository()
+	mq := newTestQueue(t, repo)
+
+	var mu sync.Mutex
+	attempts := 0
+
+	cleanupQueue, err := mq.Subscribe(msgqueue.JOB_PROCESSING_QUEUE, func(msg *msgqueue.Message) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		attempts++
+
+		return fmt.Errorf("intentional error on attempt %d", attempts)
+	}, msgqueue.NoOpHook)
+
+	require.NoError(t, err)
+	defer cleanupQueue() // nolint: errcheck
+
+	err = mq.AddMessage(context.Background(), msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{
+		ID:      "job",
+		Retries: 1,
+	})
+
+	require.NoError(t, err)
+
+	// the message is dropped after it has been retried once
+	assert.Eventually(t, func() bool { return repo.count() == 0 }, 5*time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	assert.Equal(t, 2, attempts)
+	mu.Unlock()
+}
+
+func TestMessageQueueTenantFanout(t *testing.T) {
+	repo := newFakeRepository()
+	mq := newTestQueue(t, repo)
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+
+	for i := 0; i < 2; i++ {
+		cleanupQueue, err := mq.Subscribe(msgqueue.TenantEventConsumerQueue("tenant"), func(msg *msgqueue.Message) error {
+			assert.Equal(t, "workflow", msg.ID)
+			wg.Done()
+			return nil
+		}, msgqueue.NoOpHook)
+
+		require.NoError(t, err)
+		defer cleanupQueue() // nolint: errcheck
+	}
+
+	err := mq.AddMessage(context.Background(), msgqueue.WORKFLOW_PROCESSING_QUEUE, &msgqueue.Message{
+		ID:       "workflow",
+		Metadata: map[string]interface{}{"tenant_id": "tenant"},
+	})
+
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("tenant message was not received by all subscribers")
+	}
+}
+
+func TestConformance(t *testing.T) {
+	msgqueuetest.RunConformanceTests(t, func(t *testing.T) msgqueue.MessageQueue {
+		return newTestQueue(t, newFakeRepository())
+	})
+}
@@ -0,0 +1,38 @@
+package rabbitmq
+
+import (
+	"fmt"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+)
+
+func init() {
+	msgqueue.Register("rabbitmq", msgqueue.MessageQueueDriverFunc(newDriver))
+}
+
+// Config is the config of the driver, which is set under msgQueue.rabbitmq in the server config.
+type Config struct {
+	URL string `json:"url"`
+	Qos int    `json:"qos"`
+}
+
+func newDriver(opts *msgqueue.DriverOpts) (func() error, msgqueue.MessageQueue, error) {
+	cf := Config{}
+
+	if err := opts.Config(&cf); err != nil {
+		return nil, nil, fmt.Errorf("could not decode rabbitmq config: %w", err)
+	}
+
+	cleanup, mq := New(
+		WithURL(cf.URL),
+		WithLogger(opts.Logger),
+		WithQos(cf.Qos),
+		WithDisableTenantExchangePubs(opts.DisableTenantPubs),
+	)
+
+	if mq == nil {
+		return nil, nil, fmt.Errorf("could not connect to rabbitmq")
+	}
+
+	return cleanup, mq, nil
+}
@@ -0,0 +1,475 @@
+// Package runarchive writes the details of finished workflow runs to the blob storage, and reads them back when an
+// archived run is returned by the API.
+package runarchive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/blobstore"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// FormatVersion is the version of the archive format. Archives with a newer version can't be read.
+const FormatVersion = 1
+
+// The kinds of the records of an archive. An archive is a gzip-compressed JSONL file, which starts with the workflow
+// run and is followed by its job runs, step runs and step run events.
+const (
+	KindWorkflowRun  = "workflowRun"
+	KindJobRun       = "jobRun"
+	KindStepRun      = "stepRun"
+	KindStepRunEvent = "stepRunEvent"
+)
+
+// Key returns the key of the archive of a workflow run. Keys are prefixed with the tenant id like the keys of other
+// blobs, so a tenant can only read its own archives.
+func Key(tenantId, workflowRunId string) string {
+	return fmt.Sprintf("%s/archive/workflow-runs/%s.jsonl.gz", tenantId, workflowRunId)
+}
+
+type line struct {
+	Kind   string          `json:"kind"`
+	Record json.RawMessage `json:"record"`
+}
+
+type WorkflowRun struct {
+	Version  int             `json:"version"`
+	Id       string          `json:"id"`
+	TenantId string          `json:"tenantId"`
+	Input    json.RawMessage `json:"input,omitempty"`
+	Output   json.RawMessage `json:"output,omitempty"`
+}
+
+type Job struct {
+	Id                string     `json:"id"`
+	WorkflowVersionId string     `json:"workflowVersionId"`
+	Name              string     `json:"name"`
+	Description       *string    `json:"description,omitempty"`
+	Timeout           *string    `json:"timeout,omitempty"`
+	Kind              string     `json:"kind"`
+	CreatedAt         *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt         *time.Time `json:"updatedAt,omitempty"`
+	DeletedAt         *time.Time `json:"deletedAt,omitempty"`
+}
+
+type JobRun struct {
+	Id              string          `json:"id"`
+	JobId           string          `json:"jobId"`
+	TickerId        *string         `json:"tickerId,omitempty"`
+	Status          string          `json:"status"`
+	Result          json.RawMessage `json:"result,omitempty"`
+	CreatedAt       *time.Time      `json:"createdAt,omitempty"`
+	UpdatedAt       *time.Time      `json:"updatedAt,omitempty"`
+	DeletedAt       *time.Time      `json:"deletedAt,omitempty"`
+	StartedAt       *time.Time      `json:"startedAt,omitempty"`
+	FinishedAt      *time.Time      `json:"finishedAt,omitempty"`
+	TimeoutAt       *time.Time      `json:"timeoutAt,omitempty"`
+	CancelledAt     *time.Time      `json:"cancelledAt,omitempty"`
+	CancelledReason *string         `json:"cancelledReason,omitempty"`
+	CancelledError  *string         `json:"cancelledError,omitempty"`
+	Job             Job             `json:"job"`
+}
+
+type StepRun struct {
+	Id                  string          `json:"id"`
+	JobRunId            string          `json:"jobRunId"`
+	StepId              string          `json:"stepId"`
+	WorkerId            *string         `json:"workerId,omitempty"`
+	Status              string          `json:"status"`
+	Output              json.RawMessage `json:"output,omitempty"`
+	Error               *string         `json:"error,omitempty"`
+	CreatedAt           *time.Time      `json:"createdAt,omitempty"`
+	UpdatedAt           *time.Time      `json:"updatedAt,omitempty"`
+	StartedAt           *time.Time      `json:"startedAt,omitempty"`
+	FinishedAt          *time.Time      `json:"finishedAt,omitempty"`
+	TimeoutAt           *time.Time      `json:"timeoutAt,omitempty"`
+	CancelledAt         *time.Time      `json:"cancelledAt,omitempty"`
+	CancelledReason     *string         `json:"cancelledReason,omitempty"`
+	CancelledError      *string         `json:"cancelledError,omitempty"`
+	ChildWorkflowsCount int             `json:"childWorkflowsCount"`
+}
+
+type StepRunEvent struct {
+	Id            int64           `json:"id"`
+	StepRunId     *string         `json:"stepRunId,omitempty"`
+	WorkflowRunId *string         `json:"workflowRunId,omitempty"`
+	Reason        string          `json:"reason"`
+	Severity      string          `json:"severity"`
+	Message       string          `json:"message"`
+	Count         int32           `json:"count"`
+	Data          json.RawMessage `json:"data,omitempty"`
+	TimeFirstSeen *time.Time      `json:"timeFirstSeen,omitempty"`
+	TimeLastSeen  *time.Time      `json:"timeLastSeen,omitempty"`
+}
+
+// Encode writes the archive of the details of a workflow run to w.
+func Encode(w io.Writer, details *repository.WorkflowRunDetails) error {
+	zw := gzip.NewWriter(w)
+	enc := json.NewEncoder(zw)
+
+	write := func(kind string, record any) error {
+		data, err := json.Marshal(record)
+
+		if err != nil {
+			return fmt.Errorf("could not marshal %s: %w", kind, err)
+		}
+
+		return enc.Encode(&line{Kind: kind, Record: data})
+	}
+
+	err := write(KindWorkflowRun, &WorkflowRun{
+		Version:  FormatVersion,
+		Id:       details.WorkflowRunId,
+		TenantId: details.TenantId,
+		Input:    details.Input,
+		Output:   details.Output,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, jobRun := range details.JobRuns {
+		if err := write(KindJobRun, jobRunFromRow(jobRun)); err != nil {
+			return err
+		}
+	}
+
+	for _, stepRun := range details.StepRuns {
+		if err := write(KindStepRun, stepRunFromRow(stepRun)); err != nil {
+			return err
+		}
+	}
+
+	for _, event := range details.StepRunEvents {
+		if err := write(KindStepRunEvent, stepRunEventFromRow(event)); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+// Decode reads an archive which was written by Encode.
+func Decode(r io.Reader) (*repository.WorkflowRunDetails, error) {
+	zr, err := gzip.NewReader(r)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read archive: %w", err)
+	}
+
+	defer zr.Close() // nolint: errcheck
+
+	dec := json.NewDecoder(bufio.NewReader(zr))
+
+	var res *repository.WorkflowRunDetails
+
+	for {
+		var l line
+
+		err := dec.Decode(&l)
+
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("could not read record: %w", err)
+		}
+
+		if res == nil && l.Kind != KindWorkflowRun {
+			return nil, errors.New("archive doesn't start with a workflow run")
+		}
+
+		switch l.Kind {
+		case KindWorkflowRun:
+			var record WorkflowRun
+
+			if err := json.Unmarshal(l.Record, &record); err != nil {
+				return nil, fmt.Errorf("could not read workflow run: %w", err)
+			}
+
+			if record.Version > FormatVersion {
+				return nil, fmt.Errorf("archive version %d is newer than the supported version %d", record.Version, FormatVersion)
+			}
+
+			res = &repository.WorkflowRunDetails{
+				WorkflowRunId: record.Id,
+				TenantId:      record.TenantId,
+				Input:         record.Input,
+				Output:        record.Output,
+			}
+		case KindJobRun:
+			var record JobRun
+
+			if err := json.Unmarshal(l.Record, &record); err != nil {
+				return nil, fmt.Errorf("could not read job run: %w", err)
+			}
+
+			res.JobRuns = append(res.JobRuns, record.toRow(res.TenantId, res.WorkflowRunId))
+		case KindStepRun:
+			var record StepRun
+
+			if err := json.Unmarshal(l.Record, &record); err != nil {
+				return nil, fmt.Errorf("could not read step run: %w", err)
+			}
+
+			res.StepRuns = append(res.StepRuns, record.toRow(res.TenantId))
+		case KindStepRunEvent:
+			var record StepRunEvent
+
+			if err := json.Unmarshal(l.Record, &record); err != nil {
+				return nil, fmt.Errorf("could not read step run event: %w", err)
+			}
+
+			res.StepRunEvents = append(res.StepRunEvents, record.toRow())
+		}
+	}
+
+	if res == nil {
+		return nil, errors.New("archive is empty")
+	}
+
+	return res, nil
+}
+
+// Write writes the archive of the details of a workflow run to the blob storage, and returns its key and its
+// compressed size.
+func Write(ctx context.Context, store blobstore.BlobStore, details *repository.WorkflowRunDetails) (string, int, error) {
+	buf := &bytes.Buffer{}
+
+	if err := Encode(buf, details); err != nil {
+		return "", 0, err
+	}
+
+	key := Key(details.TenantId, details.WorkflowRunId)
+
+	if err := store.Put(ctx, key, buf.Bytes()); err != nil {
+		return "", 0, fmt.Errorf("could not store archive: %w", err)
+	}
+
+	return key, buf.Len(), nil
+}
+
+// Load returns the details of an archived workflow run from the blob storage. It returns nil if the workflow run
+// wasn't archived.
+func Load(ctx context.Context, repo repository.WorkflowRunArchiveRepository, blobs *blobstore.Offloader, tenantId, workflowRunId string) (*repository.WorkflowRunDetails, error) {
+	archive, err := repo.GetWorkflowRunArchive(ctx, tenantId, workflowRunId)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get archive: %w", err)
+	}
+
+	data, err := blobs.Get(ctx, tenantId, archive.Key)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read archive %s: %w", archive.Key, err)
+	}
+
+	return Decode(bytes.NewReader(data))
+}
+
+func jobRunFromRow(row *dbsqlc.ListJobRunsForWorkflowRunFullRow) *JobRun {
+	return &JobRun{
+		Id:              sqlchelpers.UUIDToStr(row.ID),
+		JobId:           sqlchelpers.UUIDToStr(row.JobId),
+		TickerId:        uuidPtr(row.TickerId),
+		Status:          string(row.Status),
+		Result:          row.Result,
+		CreatedAt:       timePtr(row.CreatedAt),
+		UpdatedAt:       timePtr(row.UpdatedAt),
+		DeletedAt:       timePtr(row.DeletedAt),
+		StartedAt:       timePtr(row.StartedAt),
+		FinishedAt:      timePtr(row.FinishedAt),
+		TimeoutAt:       timePtr(row.TimeoutAt),
+		CancelledAt:     timePtr(row.CancelledAt),
+		CancelledReason: textPtr(row.CancelledReason),
+		CancelledError:  textPtr(row.CancelledError),
+		Job: Job{
+			Id:                sqlchelpers.UUIDToStr(row.Job.ID),
+			WorkflowVersionId: sqlchelpers.UUIDToStr(row.Job.WorkflowVersionId),
+			Name:              row.Job.Name,
+			Description:       textPtr(row.Job.Description),
+			Timeout:           textPtr(row.Job.Timeout),
+			Kind:              string(row.Job.Kind),
+			CreatedAt:         timePtr(row.Job.CreatedAt),
+			UpdatedAt:         timePtr(row.Job.UpdatedAt),
+			DeletedAt:         timePtr(row.Job.DeletedAt),
+		},
+	}
+}
+
+func (r *JobRun) toRow(tenantId, workflowRunId string) *dbsqlc.ListJobRunsForWorkflowRunFullRow {
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	return &dbsqlc.ListJobRunsForWorkflowRunFullRow{
+		ID:              sqlchelpers.UUIDFromStr(r.Id),
+		CreatedAt:       timestamp(r.CreatedAt),
+		UpdatedAt:       timestamp(r.UpdatedAt),
+		DeletedAt:       timestamp(r.DeletedAt),
+		TenantId:        pgTenantId,
+		JobId:           sqlchelpers.UUIDFromStr(r.JobId),
+		TickerId:        uuid(r.TickerId),
+		Status:          dbsqlc.JobRunStatus(r.Status),
+		Result:          r.Result,
+		StartedAt:       timestamp(r.StartedAt),
+		FinishedAt:      timestamp(r.FinishedAt),
+		TimeoutAt:       timestamp(r.TimeoutAt),
+		CancelledAt:     timestamp(r.CancelledAt),
+		CancelledReason: text(r.CancelledReason),
+		CancelledError:  text(r.CancelledError),
+		WorkflowRunId:   sqlchelpers.UUIDFromStr(workflowRunId),
+		Job: dbsqlc.Job{
+			ID:                sqlchelpers.UUIDFromStr(r.Job.Id),
+			CreatedAt:         timestamp(r.Job.CreatedAt),
+			UpdatedAt:         timestamp(r.Job.UpdatedAt),
+			DeletedAt:         timestamp(r.Job.DeletedAt),
+			TenantId:          pgTenantId,
+			WorkflowVersionId: sqlchelpers.UUIDFromStr(r.Job.WorkflowVersionId),
+			Name:              r.Job.Name,
+			Description:       text(r.Job.Description),
+			Timeout:           text(r.Job.Timeout),
+			Kind:              dbsqlc.JobKind(r.Job.Kind),
+		},
+	}
+}
+
+func stepRunFromRow(row *repository.StepRunForJobRun) *StepRun {
+	return &StepRun{
+		Id:                  sqlchelpers.UUIDToStr(row.ID),
+		JobRunId:            sqlchelpers.UUIDToStr(row.JobRunId),
+		StepId:              sqlchelpers.UUIDToStr(row.StepId),
+		WorkerId:            uuidPtr(row.WorkerId),
+		Status:              string(row.Status),
+		Output:              row.Output,
+		Error:               textPtr(row.Error),
+		CreatedAt:           timePtr(row.CreatedAt),
+		UpdatedAt:           timePtr(row.UpdatedAt),
+		StartedAt:           timePtr(row.StartedAt),
+		FinishedAt:          timePtr(row.FinishedAt),
+		TimeoutAt:           timePtr(row.TimeoutAt),
+		CancelledAt:         timePtr(row.CancelledAt),
+		CancelledReason:     textPtr(row.CancelledReason),
+		CancelledError:      textPtr(row.CancelledError),
+		ChildWorkflowsCount: row.ChildWorkflowsCount,
+	}
+}
+
+func (r *StepRun) toRow(tenantId string) *repository.StepRunForJobRun {
+	return &repository.StepRunForJobRun{
+		GetStepRunsForJobRunsWithOutputRow: &dbsqlc.GetStepRunsForJobRunsWithOutputRow{
+			ID:              sqlchelpers.UUIDFromStr(r.Id),
+			CreatedAt:       timestamp(r.CreatedAt),
+			UpdatedAt:       timestamp(r.UpdatedAt),
+			Status:          dbsqlc.StepRunStatus(r.Status),
+			JobRunId:        sqlchelpers.UUIDFromStr(r.JobRunId),
+			StepId:          sqlchelpers.UUIDFromStr(r.StepId),
+			TenantId:        sqlchelpers.UUIDFromStr(tenantId),
+			StartedAt:       timestamp(r.StartedAt),
+			FinishedAt:      timestamp(r.FinishedAt),
+			CancelledAt:     timestamp(r.CancelledAt),
+			CancelledError:  text(r.CancelledError),
+			CancelledReason: text(r.CancelledReason),
+			TimeoutAt:       timestamp(r.TimeoutAt),
+			Error:           text(r.Error),
+			WorkerId:        uuid(r.WorkerId),
+			Output:          r.Output,
+		},
+		ChildWorkflowsCount: r.ChildWorkflowsCount,
+	}
+}
+
+func stepRunEventFromRow(row *dbsqlc.StepRunEvent) *StepRunEvent {
+	return &StepRunEvent{
+		Id:            row.ID,
+		StepRunId:     uuidPtr(row.StepRunId),
+		WorkflowRunId: uuidPtr(row.WorkflowRunId),
+		Reason:        string(row.Reason),
+		Severity:      string(row.Severity),
+		Message:       row.Message,
+		Count:         row.Count,
+		Data:          row.Data,
+		TimeFirstSeen: timePtr(row.TimeFirstSeen),
+		TimeLastSeen:  timePtr(row.TimeLastSeen),
+	}
+}
+
+func (r *StepRunEvent) toRow() *dbsqlc.StepRunEvent {
+	return &dbsqlc.StepRunEvent{
+		ID:            r.Id,
+		TimeFirstSeen: timestamp(r.TimeFirstSeen),
+		TimeLastSeen:  timestamp(r.TimeLastSeen),
+		StepRunId:     uuid(r.StepRunId),
+		Reason:        dbsqlc.StepRunEventReason(r.Reason),
+		Severity:      dbsqlc.StepRunEventSeverity(r.Severity),
+		Message:       r.Message,
+		Count:         r.Count,
+		Data:          r.Data,
+		WorkflowRunId: uuid(r.WorkflowRunId),
+	}
+}
+
+func uuidPtr(v pgtype.UUID) *string {
+	if !v.Valid {
+		return nil
+	}
+
+	s := sqlchelpers.UUIDToStr(v)
+
+	return &s
+}
+
+func uuid(v *string) pgtype.UUID {
+	if v == nil {
+		return pgtype.UUID{}
+	}
+
+	return sqlchelpers.UUIDFromStr(*v)
+}
+
+func textPtr(v pgtype.Text) *string {
+	if !v.Valid {
+		return nil
+	}
+
+	return &v.String
+}
+
+func text(v *string) pgtype.Text {
+	if v == nil {
+		return pgtype.Text{}
+	}
+
+	return sqlchelpers.TextFromStr(*v)
+}
+
+func timePtr(v pgtype.Timestamp) *time.Time {
+	if !v.Valid {
+		return nil
+	}
+
+	t := v.Time.UTC()
+
+	return &t
+}
+
+func timestamp(v *time.Time) pgtype.Timestamp {
+	if v == nil {
+		return pgtype.Timestamp{}
+	}
+
+	return sqlchelpers.TimestampFromTime(*v)
+}
@@ -0,0 +1,117 @@
+package runarchive_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/runarchive"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+const (
+	tenantId      = "707d0855-80ab-4e1f-a156-f1c4546cbf52"
+	workflowRunId = "5a8f0a2f-44b3-4b0c-9d0c-2f3a6f0e3a11"
+	jobRunId      = "c1b0c7a4-58a5-4bb8-8f4d-3b9a1b4c8f20"
+	stepRunId     = "0d6c9a3e-6e1f-4b47-9d2a-0f8e1c2b3a45"
+)
+
+func TestArchiveRoundTrip(t *testing.T) {
+	finishedAt := time.Date(2025, 1, 20, 10, 30, 0, 0, time.UTC)
+
+	details := &repository.WorkflowRunDetails{
+		WorkflowRunId: workflowRunId,
+		TenantId:      tenantId,
+		Input:         []byte(`{"user":"alice"}`),
+		Output:        []byte(`{"step":{"ok":true}}`),
+		JobRuns: []*dbsqlc.ListJobRunsForWorkflowRunFullRow{
+			{
+				ID:         sqlchelpers.UUIDFromStr(jobRunId),
+				Status:     dbsqlc.JobRunStatusSUCCEEDED,
+				FinishedAt: sqlchelpers.TimestampFromTime(finishedAt),
+				Job: dbsqlc.Job{
+					Name:        "default",
+					Description: sqlchelpers.TextFromStr("the default job"),
+					Kind:        dbsqlc.JobKindDEFAULT,
+				},
+			},
+		},
+		StepRuns: []*repository.StepRunForJobRun{
+			{
+				GetStepRunsForJobRunsWithOutputRow: &dbsqlc.GetStepRunsForJobRunsWithOutputRow{
+					ID:       sqlchelpers.UUIDFromStr(stepRunId),
+					JobRunId: sqlchelpers.UUIDFromStr(jobRunId),
+					Status:   dbsqlc.StepRunStatusSUCCEEDED,
+					Output:   []byte(`{"ok":true}`),
+				},
+				ChildWorkflowsCount: 2,
+			},
+		},
+		StepRunEvents: []*dbsqlc.StepRunEvent{
+			{
+				ID:        42,
+				StepRunId: sqlchelpers.UUIDFromStr(stepRunId),
+				Reason:    dbsqlc.StepRunEventReasonFINISHED,
+				Severity:  dbsqlc.StepRunEventSeverityINFO,
+				Message:   "Step run finished",
+				Count:     1,
+			},
+		},
+	}
+
+	buf := &bytes.Buffer{}
+
+	require.NoError(t, runarchive.Encode(buf, details))
+
+	res, err := runarchive.Decode(buf)
+	require.NoError(t, err)
+
+	assert.Equal(t, workflowRunId, res.WorkflowRunId)
+	assert.Equal(t, tenantId, res.TenantId)
+	assert.JSONEq(t, `{"user":"alice"}`, string(res.Input))
+	assert.JSONEq(t, `{"step":{"ok":true}}`, string(res.Output))
+
+	require.Len(t, res.JobRuns, 1)
+	assert.Equal(t, jobRunId, sqlchelpers.UUIDToStr(res.JobRuns[0].ID))
+	assert.Equal(t, workflowRunId, sqlchelpers.UUIDToStr(res.JobRuns[0].WorkflowRunId))
+	assert.Equal(t, tenantId, sqlchelpers.UUIDToStr(res.JobRuns[0].TenantId))
+	assert.Equal(t, dbsqlc.JobRunStatusSUCCEEDED, res.JobRuns[0].Status)
+	assert.True(t, finishedAt.Equal(res.JobRuns[0].FinishedAt.Time))
+	assert.False(t, res.JobRuns[0].StartedAt.Valid)
+	assert.Equal(t, "the default job", res.JobRuns[0].Job.Description.String)
+	assert.False(t, res.JobRuns[0].Job.Timeout.Valid)
+
+	require.Len(t, res.StepRuns, 1)
+	assert.Equal(t, stepRunId, sqlchelpers.UUIDToStr(res.StepRuns[0].ID))
+	assert.Equal(t, 2, res.StepRuns[0].ChildWorkflowsCount)
+	assert.False(t, res.StepRuns[0].WorkerId.Valid)
+	assert.JSONEq(t, `{"ok":true}`, string(res.StepRuns[0].Output))
+
+	require.Len(t, res.StepRunEvents, 1)
+	assert.Equal(t, int64(42), res.StepRunEvents[0].ID)
+	assert.Equal(t, dbsqlc.StepRunEventReasonFINISHED, res.StepRunEvents[0].Reason)
+	assert.False(t, res.StepRunEvents[0].WorkflowRunId.Valid)
+}
+
+func TestArchiveWithoutDetails(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	require.NoError(t, runarchive.Encode(buf, &repository.WorkflowRunDetails{
+		WorkflowRunId: workflowRunId,
+		TenantId:      tenantId,
+	}))
+
+	res, err := runarchive.Decode(buf)
+	require.NoError(t, err)
+	assert.Nil(t, res.Input)
+	assert.Empty(t, res.JobRuns)
+}
+
+func TestKey(t *testing.T) {
+	assert.Equal(t, tenantId+"/archive/workflow-runs/"+workflowRunId+".jsonl.gz", runarchive.Key(tenantId, workflowRunId))
+}
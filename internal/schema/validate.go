@@ -0,0 +1,126 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// ValidationError is a single violation of a JSON schema by a value.
+type ValidationError struct {
+	// Path is the JSON pointer to the invalid value, for example "/user/email". It is empty when the
+	// value at the root is invalid.
+	Path string `json:"path"`
+
+	Message string `json:"message"`
+}
+
+func (e ValidationError) String() string {
+	if e.Path == "" {
+		return e.Message
+	}
+
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// FormatValidationErrors joins validation errors into a single human-readable message.
+func FormatValidationErrors(errs []ValidationError) string {
+	msgs := make([]string, 0, len(errs))
+
+	for _, e := range errs {
+		msgs = append(msgs, e.String())
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// ParseJSONSchema parses a JSON schema and checks that it's well-formed.
+func ParseJSONSchema(schemaBytes []byte) (*openapi3.Schema, error) {
+	s := &openapi3.Schema{}
+
+	if err := json.Unmarshal(schemaBytes, s); err != nil {
+		return nil, fmt.Errorf("schema is not valid JSON: %w", err)
+	}
+
+	if err := s.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("schema is invalid: %w", err)
+	}
+
+	return s, nil
+}
+
+// ValidateJSON validates JSON data against a JSON schema. It returns every violation of the schema, sorted by
+// path, or an error if the schema or the data can't be parsed.
+func ValidateJSON(schemaBytes []byte, data []byte) ([]ValidationError, error) {
+	s, err := ParseJSONSchema(schemaBytes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &value); err != nil {
+			return []ValidationError{{Message: "value is not valid JSON"}}, nil
+		}
+	}
+
+	err = s.VisitJSON(value, openapi3.MultiErrors())
+
+	if err == nil {
+		return nil, nil
+	}
+
+	res := make([]ValidationError, 0)
+
+	for _, e := range flattenErrors(err) {
+		var schemaErr *openapi3.SchemaError
+
+		if errors.As(e, &schemaErr) {
+			path := ""
+
+			if pointer := schemaErr.JSONPointer(); len(pointer) > 0 {
+				path = "/" + strings.Join(pointer, "/")
+			}
+
+			res = append(res, ValidationError{
+				Path:    path,
+				Message: schemaErr.Reason,
+			})
+
+			continue
+		}
+
+		res = append(res, ValidationError{
+			Message: e.Error(),
+		})
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Path < res[j].Path
+	})
+
+	return res, nil
+}
+
+func flattenErrors(err error) []error {
+	var multi openapi3.MultiError
+
+	if !errors.As(err, &multi) {
+		return []error{err}
+	}
+
+	res := make([]error, 0, len(multi))
+
+	for _, e := range multi {
+		res = append(res, flattenErrors(e)...)
+	}
+
+	return res
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const userSchema = `{
+	"type": "object",
+	"required": ["name", "email"],
+	"properties": {
+		"name": {"type": "string", "minLength": 1},
+		"email": {"type": "string"},
+		"age": {"type": "integer", "minimum": 0}
+	}
+}`
+
+func TestValidateJSON(t *testing.T) {
+	errs, err := ValidateJSON([]byte(userSchema), []byte(`{"name": "alice", "email": "alice@example.com", "age": 30}`))
+
+	require.NoError(t, err)
+	assert.Empty(t, errs)
+}
+
+func TestValidateJSONReturnsAllErrors(t *testing.T) {
+	errs, err := ValidateJSON([]byte(userSchema), []byte(`{"name": "", "age": -1}`))
+
+	require.NoError(t, err)
+	require.Len(t, errs, 3)
+
+	assert.Equal(t, "/age", errs[0].Path)
+	assert.Equal(t, "/email", errs[1].Path)
+	assert.Contains(t, errs[1].Message, "missing")
+	assert.Equal(t, "/name", errs[2].Path)
+
+	assert.Contains(t, FormatValidationErrors(errs), "/age: ")
+}
+
+func TestValidateJSONInvalidData(t *testing.T) {
+	errs, err := ValidateJSON([]byte(userSchema), []byte(`{"name":`))
+
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "value is not valid JSON", errs[0].Message)
+}
+
+func TestParseJSONSchemaInvalid(t *testing.T) {
+	_, err := ParseJSONSchema([]byte(`{"type": "object", "properties": {"name": {"type": "strng"}}}`))
+	assert.Error(t, err)
+
+	_, err = ParseJSONSchema([]byte(`not json`))
+	assert.Error(t, err)
+}
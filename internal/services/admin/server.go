@@ -8,12 +8,15 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/hatchet-dev/hatchet/internal/cel"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/schema"
 	"github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/pkg/client/types"
@@ -27,17 +30,23 @@ func (a *AdminServiceImpl) TriggerWorkflow(ctx context.Context, req *contracts.T
 	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
 
-	createContext, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	// the workflow runs are created even if the caller goes away, but in the caller's trace
+	createContext, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
 	defer cancel()
 
+	// workflow runs with a trigger time in the past are triggered immediately
+	if req.TriggerAt != nil && req.TriggerAt.AsTime().After(time.Now()) {
+		return a.scheduleWorkflowRun(createContext, tenantId, req)
+	}
+
 	createOpts, existingWorkflows, err := getOpts(ctx, []*contracts.TriggerWorkflowRequest{req}, a)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(existingWorkflows) > 0 {
+	if existingWorkflowRunId, ok := getExistingWorkflowRunId(req, existingWorkflows); ok {
 		return &contracts.TriggerWorkflowResponse{
-			WorkflowRunId: existingWorkflows[0],
+			WorkflowRunId: existingWorkflowRunId,
 		}, nil
 	}
 
@@ -49,8 +58,17 @@ func (a *AdminServiceImpl) TriggerWorkflow(ctx context.Context, req *contracts.T
 	}
 	createOpt := createOpts[0]
 
+	if err := a.checkQuota(createContext, tenantId, repository.TenantQuotaResourceQueuedRuns, 1); err != nil {
+		a.releaseIdempotencyKeys(tenantId, createOpts)
+		return nil, err
+	}
+
 	workflowRun, err := a.repo.WorkflowRun().CreateNewWorkflowRun(createContext, tenantId, createOpt)
 
+	if err != nil {
+		a.releaseIdempotencyKeys(tenantId, createOpts)
+	}
+
 	dedupeTarget := repository.ErrDedupeValueExists{}
 
 	if errors.As(err, &dedupeTarget) {
@@ -72,7 +90,7 @@ func (a *AdminServiceImpl) TriggerWorkflow(ctx context.Context, req *contracts.T
 
 	// send to workflow processing queue
 	err = a.mq.AddMessage(
-		context.Background(),
+		createContext,
 		msgqueue.WORKFLOW_PROCESSING_QUEUE,
 		tasktypes.WorkflowRunQueuedToTask(tenantId, workflowRunId),
 	)
@@ -86,15 +104,81 @@ func (a *AdminServiceImpl) TriggerWorkflow(ctx context.Context, req *contracts.T
 	}, nil
 }
 
+// scheduleWorkflowRun stores a one-off scheduled run of the latest version of the workflow, which the ticker
+// triggers at the requested time.
+func (a *AdminServiceImpl) scheduleWorkflowRun(ctx context.Context, tenantId string, req *contracts.TriggerWorkflowRequest) (*contracts.TriggerWorkflowResponse, error) {
+	if req.ParentId != nil || req.IdempotencyKey != nil || req.DesiredWorkerId != nil || req.Priority != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"parent, idempotency key, desired worker and priority are not supported when scheduling a workflow run",
+		)
+	}
+
+	workflow, err := a.repo.Workflow().GetWorkflowByName(ctx, tenantId, req.Name)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "workflow %s not found", req.Name)
+		}
+
+		return nil, fmt.Errorf("could not get workflow by name: %w", err)
+	}
+
+	workflowVersions, err := a.repo.Workflow().GetWorkflowVersionsForTrigger(ctx, tenantId, []string{sqlchelpers.UUIDToStr(workflow.ID)})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get workflow version: %w", err)
+	}
+
+	workflowVersion := workflowVersions[0]
+
+	if err := repository.ValidateWorkflowRunInput(workflowVersion, []byte(req.Input)); err != nil {
+		if st := inputValidationStatus(err); st != nil {
+			return nil, st
+		}
+
+		return nil, err
+	}
+
+	var additionalMetadata []byte
+
+	if req.AdditionalMetadata != nil {
+		additionalMetadata = []byte(*req.AdditionalMetadata)
+	}
+
+	scheduledRefs, err := a.repo.Workflow().CreateSchedules(
+		ctx,
+		tenantId,
+		sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.ID),
+		&repository.CreateWorkflowSchedulesOpts{
+			ScheduledTriggers:  []time.Time{req.TriggerAt.AsTime()},
+			Input:              []byte(req.Input),
+			AdditionalMetadata: additionalMetadata,
+		},
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not schedule workflow run: %w", err)
+	}
+
+	if len(scheduledRefs) != 1 {
+		return nil, status.Error(codes.Internal, "could not schedule workflow run")
+	}
+
+	scheduledWorkflowRunId := sqlchelpers.UUIDToStr(scheduledRefs[0].ID)
+
+	return &contracts.TriggerWorkflowResponse{
+		ScheduledWorkflowRunId: &scheduledWorkflowRunId,
+	}, nil
+}
+
 func (a *AdminServiceImpl) BulkTriggerWorkflow(ctx context.Context, req *contracts.BulkTriggerWorkflowRequest) (*contracts.BulkTriggerWorkflowResponse, error) {
 	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
 
-	createContext, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	// the workflow runs are created even if the caller goes away, but in the caller's trace
+	createContext, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
 	defer cancel()
-	var opts []*repository.CreateWorkflowRunOpts
-	var existingWorkflows []string
-
 	if len(req.Workflows) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "no workflows provided")
 	}
@@ -103,6 +187,12 @@ func (a *AdminServiceImpl) BulkTriggerWorkflow(ctx context.Context, req *contrac
 		return nil, status.Error(codes.InvalidArgument, "maximum of 1000 workflows can be triggered at once")
 	}
 
+	for _, workflow := range req.Workflows {
+		if workflow.TriggerAt != nil {
+			return nil, status.Error(codes.InvalidArgument, "trigger at is not supported when bulk triggering workflows")
+		}
+	}
+
 	opts, existingWorkflows, err := getOpts(ctx, req.Workflows, a)
 	if err != nil {
 		return nil, err
@@ -112,12 +202,21 @@ func (a *AdminServiceImpl) BulkTriggerWorkflow(ctx context.Context, req *contrac
 		if len(existingWorkflows) == 0 {
 			return nil, status.Error(codes.InvalidArgument, "no suitable new workflows provided")
 		}
-		return &contracts.BulkTriggerWorkflowResponse{WorkflowRunIds: existingWorkflows}, nil
 
+		return &contracts.BulkTriggerWorkflowResponse{WorkflowRunIds: orderWorkflowRunIds(req.Workflows, existingWorkflows, nil)}, nil
+	}
+
+	if err := a.checkQuota(createContext, tenantId, repository.TenantQuotaResourceQueuedRuns, len(opts)); err != nil {
+		a.releaseIdempotencyKeys(tenantId, opts)
+		return nil, err
 	}
 
 	workflowRuns, err := a.repo.WorkflowRun().CreateNewWorkflowRuns(createContext, tenantId, opts)
 
+	if err != nil {
+		a.releaseIdempotencyKeys(tenantId, opts)
+	}
+
 	if err == metered.ErrResourceExhausted {
 		return nil, status.Errorf(codes.ResourceExhausted, "resource exhausted: workflow run limit exceeded for tenant")
 	}
@@ -125,16 +224,11 @@ func (a *AdminServiceImpl) BulkTriggerWorkflow(ctx context.Context, req *contrac
 		return nil, fmt.Errorf("could not create workflow runs: %w", err)
 	}
 
-	var workflowRunIds []string
 	for _, workflowRun := range workflowRuns {
-		workflowRunIds = append(workflowRunIds, sqlchelpers.UUIDToStr(workflowRun.ID))
-	}
-
-	for _, workflowRunId := range workflowRunIds {
 		err = a.mq.AddMessage(
-			context.Background(),
+			createContext,
 			msgqueue.WORKFLOW_PROCESSING_QUEUE,
-			tasktypes.WorkflowRunQueuedToTask(tenantId, workflowRunId),
+			tasktypes.WorkflowRunQueuedToTask(tenantId, sqlchelpers.UUIDToStr(workflowRun.ID)),
 		)
 
 		if err != nil {
@@ -142,9 +236,8 @@ func (a *AdminServiceImpl) BulkTriggerWorkflow(ctx context.Context, req *contrac
 		}
 	}
 
-	// adding in the pre-existing workflows to the response.
-
-	workflowRunIds = append(workflowRunIds, existingWorkflows...)
+	// the response contains the pre-existing workflow runs as well, in the order of the requests
+	workflowRunIds := orderWorkflowRunIds(req.Workflows, existingWorkflows, workflowRuns)
 
 	if len(workflowRunIds) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "no workflows created")
@@ -189,6 +282,10 @@ func (a *AdminServiceImpl) PutWorkflow(ctx context.Context, req *contracts.PutWo
 			return nil, err
 		}
 
+		if err := a.checkQuota(ctx, tenantId, repository.TenantQuotaResourceWorkflowVersions, 1); err != nil {
+			return nil, err
+		}
+
 		// workflow does not exist, create it
 		workflowVersion, err = a.repo.Workflow().CreateNewWorkflow(
 			ctx,
@@ -226,6 +323,10 @@ func (a *AdminServiceImpl) PutWorkflow(ctx context.Context, req *contracts.PutWo
 		}
 
 		if oldWorkflowVersion.WorkflowVersion.Checksum != newCS {
+			if err := a.checkQuota(ctx, tenantId, repository.TenantQuotaResourceWorkflowVersions, 1); err != nil {
+				return nil, err
+			}
+
 			workflowVersion, err = a.repo.Workflow().CreateWorkflowVersion(
 				ctx,
 				tenantId,
@@ -333,6 +434,14 @@ func (a *AdminServiceImpl) ScheduleWorkflow(ctx context.Context, req *contracts.
 		dbSchedules[i] = scheduledTrigger.AsTime()
 	}
 
+	if err := repository.ValidateWorkflowRunInput(currWorkflow, []byte(req.Input)); err != nil {
+		if st := inputValidationStatus(err); st != nil {
+			return nil, st
+		}
+
+		return nil, err
+	}
+
 	workflowVersionId := sqlchelpers.UUIDToStr(currWorkflow.WorkflowVersion.ID)
 
 	var additionalMetadata []byte
@@ -380,6 +489,16 @@ func (a *AdminServiceImpl) PutRateLimit(ctx context.Context, req *contracts.PutR
 		Duration: &duration,
 	}
 
+	if req.Algorithm != nil {
+		algorithm := req.Algorithm.String()
+		createOpts.Algorithm = &algorithm
+	}
+
+	if req.Burst != nil {
+		burst := int(*req.Burst)
+		createOpts.Burst = &burst
+	}
+
 	_, err := a.repo.RateLimit().UpsertRateLimit(ctx, tenantId, req.Key, createOpts)
 
 	if err != nil {
@@ -472,21 +591,53 @@ func getCreateWorkflowOpts(req *contracts.PutWorkflowRequest) (*repository.Creat
 		kind = repository.StringPtr(req.Opts.Kind.String())
 	}
 
+	var regionStrategy *string
+
+	if req.Opts.RegionStrategy != nil {
+		regionStrategy = repository.StringPtr(req.Opts.RegionStrategy.String())
+	}
+
+	var inputSchema []byte
+
+	if req.Opts.InputSchema != nil {
+		inputSchema = []byte(*req.Opts.InputSchema)
+	}
+
+	// the cron input is the input of every cron-triggered run, so it's checked when the workflow is registered
+	if inputSchema != nil && cronInput != nil {
+		if errs, err := schema.ValidateJSON(inputSchema, cronInput); err == nil && len(errs) > 0 {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"cron input does not match the input schema: %s",
+				schema.FormatValidationErrors(errs),
+			)
+		}
+	}
+
 	return &repository.CreateWorkflowVersionOpts{
-		Name:              req.Opts.Name,
-		Concurrency:       concurrency,
-		Description:       &req.Opts.Description,
-		Version:           &req.Opts.Version,
-		EventTriggers:     req.Opts.EventTriggers,
-		CronTriggers:      req.Opts.CronTriggers,
-		CronInput:         cronInput,
-		ScheduledTriggers: scheduledTriggers,
-		Jobs:              jobs,
-		OnFailureJob:      onFailureJob,
-		ScheduleTimeout:   req.Opts.ScheduleTimeout,
-		Sticky:            sticky,
-		Kind:              kind,
-		DefaultPriority:   req.Opts.DefaultPriority,
+		Name:                  req.Opts.Name,
+		Concurrency:           concurrency,
+		Description:           &req.Opts.Description,
+		Version:               &req.Opts.Version,
+		EventTriggers:         req.Opts.EventTriggers,
+		CronTriggers:          req.Opts.CronTriggers,
+		CronInput:             cronInput,
+		CronTimezone:          req.Opts.CronTimezone,
+		CronJitterSeconds:     req.Opts.CronJitterSeconds,
+		CronExclusionCalendar: req.Opts.CronExclusionCalendar,
+		ScheduledTriggers:     scheduledTriggers,
+		Jobs:                  jobs,
+		OnFailureJob:          onFailureJob,
+		ScheduleTimeout:       req.Opts.ScheduleTimeout,
+		Sticky:                sticky,
+		Kind:                  kind,
+		DefaultPriority:       req.Opts.DefaultPriority,
+		Region:                req.Opts.Region,
+		RegionStrategy:        regionStrategy,
+		Pool:                  req.Opts.Pool,
+		Output:                req.Opts.Output,
+		InputSchema:           inputSchema,
+		ReservedSlots:         req.Opts.ReservedSlots,
 	}, nil
 }
 
@@ -498,7 +649,35 @@ func getCreateJobOpts(req *contracts.CreateWorkflowJobOpts, kind string) (*repos
 	for j, step := range req.Steps {
 		stepCp := step
 
-		parsedAction, err := types.ParseActionID(step.Action)
+		action := stepCp.Action
+
+		// sleep, wait-for-event and approval steps are not run on a worker, so they don't need an action
+		if action == "" && (stepCp.SleepFor != nil || stepCp.SleepUntil != nil) {
+			action = repository.SleepStepAction
+		} else if action == "" && stepCp.WaitForEvent != nil {
+			action = repository.WaitForEventStepAction
+		}
+
+		// approval steps are identified by their action, so other actions can't be approval steps
+		if stepCp.GetApproval() {
+			if action != "" && action != repository.ApprovalStepAction {
+				return nil, status.Errorf(codes.InvalidArgument, "approval step %s can't have an action or sleep or wait for an event", stepCp.ReadableId)
+			}
+
+			action = repository.ApprovalStepAction
+		}
+
+		// the elements of map steps run on a worker, so map steps can't be any of the steps above
+		if stepCp.MapOver != nil && (stepCp.SleepFor != nil || stepCp.SleepUntil != nil || stepCp.WaitForEvent != nil || action == repository.ApprovalStepAction) {
+			return nil, status.Errorf(codes.InvalidArgument, "map step %s can't sleep, wait for an event or be an approval step", stepCp.ReadableId)
+		}
+
+		// only the outputs of steps which run on a worker are cached
+		if stepCp.CacheTtl != nil && (stepCp.SleepFor != nil || stepCp.SleepUntil != nil || stepCp.WaitForEvent != nil || action == repository.ApprovalStepAction || stepCp.MapOver != nil) {
+			return nil, status.Errorf(codes.InvalidArgument, "step %s can't be cached because it doesn't run on a worker", stepCp.ReadableId)
+		}
+
+		parsedAction, err := types.ParseActionID(action)
 
 		if err != nil {
 			return nil, err
@@ -540,10 +719,26 @@ func getCreateJobOpts(req *contracts.CreateWorkflowJobOpts, kind string) (*repos
 			DesiredWorkerLabels: affinity,
 		}
 
-		if stepCp.BackoffFactor != nil {
-			f64 := float64(*stepCp.BackoffFactor)
+		if stepCp.BackoffFactor != nil || stepCp.BackoffInitialSeconds != nil {
+			// an initial delay without a factor doubles the delay for each retry
+			f64 := float64(2)
+
+			if stepCp.BackoffFactor != nil {
+				f64 = float64(*stepCp.BackoffFactor)
+			}
+
 			steps[j].RetryBackoffFactor = &f64
 
+			if stepCp.BackoffInitialSeconds != nil {
+				initialInt := int(*stepCp.BackoffInitialSeconds)
+				steps[j].RetryBackoffInitialSeconds = &initialInt
+			}
+
+			if stepCp.BackoffJitter != nil {
+				jitter := float64(*stepCp.BackoffJitter)
+				steps[j].RetryBackoffJitter = &jitter
+			}
+
 			if stepCp.BackoffMaxSeconds != nil {
 				maxInt := int(*stepCp.BackoffMaxSeconds)
 				steps[j].RetryBackoffMaxSeconds = &maxInt
@@ -557,6 +752,58 @@ func getCreateJobOpts(req *contracts.CreateWorkflowJobOpts, kind string) (*repos
 			steps[j].Timeout = &stepCp.Timeout
 		}
 
+		if stepCp.SpeculativePercentile != nil {
+			steps[j].SpeculativePercentile = stepCp.SpeculativePercentile
+		}
+
+		if stepCp.SlotType != nil {
+			steps[j].SlotType = stepCp.SlotType
+		}
+
+		if stepCp.ScheduleTimeout != nil {
+			steps[j].ScheduleTimeout = stepCp.ScheduleTimeout
+		}
+
+		if stepCp.HeartbeatTimeout != nil {
+			steps[j].HeartbeatTimeout = stepCp.HeartbeatTimeout
+		}
+
+		if stepCp.SleepFor != nil {
+			steps[j].SleepFor = stepCp.SleepFor
+		}
+
+		if stepCp.SleepUntil != nil {
+			steps[j].SleepUntil = stepCp.SleepUntil
+		}
+
+		if stepCp.WaitForEvent != nil {
+			steps[j].WaitForEvent = stepCp.WaitForEvent
+		}
+
+		if stepCp.WaitForEventCorrelation != nil {
+			steps[j].WaitForEventCorrelation = stepCp.WaitForEventCorrelation
+		}
+
+		if stepCp.Condition != nil {
+			steps[j].Condition = stepCp.Condition
+		}
+
+		if stepCp.MapOver != nil {
+			steps[j].MapOver = stepCp.MapOver
+		}
+
+		if stepCp.MapConcurrency != nil {
+			steps[j].MapConcurrency = stepCp.MapConcurrency
+		}
+
+		if stepCp.CacheTtl != nil {
+			steps[j].CacheTTL = stepCp.CacheTtl
+		}
+
+		if stepCp.Pool != nil {
+			steps[j].Pool = stepCp.Pool
+		}
+
 		for _, rateLimit := range stepCp.RateLimits {
 			opt := repository.CreateWorkflowStepRateLimitOpts{
 				Key:       rateLimit.Key,
@@ -565,6 +812,23 @@ func getCreateJobOpts(req *contracts.CreateWorkflowJobOpts, kind string) (*repos
 				UnitsExpr: rateLimit.UnitsExpr,
 			}
 
+			if opt.KeyExpr == nil {
+				keyExpr, isTemplate, err := cel.TemplateToExpr(rateLimit.Key)
+
+				if err != nil {
+					return nil, status.Errorf(
+						codes.InvalidArgument,
+						"invalid rate limit key template %s: %v",
+						rateLimit.Key,
+						err,
+					)
+				}
+
+				if isTemplate {
+					opt.KeyExpr = &keyExpr
+				}
+			}
+
 			if rateLimit.Duration != nil {
 				dur := rateLimit.Duration.String()
 				opt.Duration = &dur
@@ -575,6 +839,19 @@ func getCreateJobOpts(req *contracts.CreateWorkflowJobOpts, kind string) (*repos
 				opt.Units = &units
 			}
 
+			if rateLimit.Scope != nil {
+				scope := rateLimit.Scope.String()
+				opt.Scope = &scope
+
+				if *rateLimit.Scope == contracts.RateLimitScope_GLOBAL && (rateLimit.KeyExpr != nil || rateLimit.LimitValuesExpr != nil || rateLimit.UnitsExpr != nil) {
+					return nil, status.Errorf(
+						codes.InvalidArgument,
+						"global rate limit %s cannot use expressions",
+						rateLimit.Key,
+					)
+				}
+			}
+
 			steps[j].RateLimits = append(steps[j].RateLimits, opt)
 		}
 
@@ -626,12 +903,14 @@ func toWorkflowVersion(workflowVersion *dbsqlc.GetWorkflowVersionForEngineRow, s
 	return version
 }
 
-func getOpts(ctx context.Context, requests []*contracts.TriggerWorkflowRequest, a *AdminServiceImpl) ([]*repository.CreateWorkflowRunOpts, []string, error) {
+// getOpts returns the options of the workflow runs which should be created, and the ids of child workflow runs
+// which already exist, keyed by their child key.
+func getOpts(ctx context.Context, requests []*contracts.TriggerWorkflowRequest, a *AdminServiceImpl) ([]*repository.CreateWorkflowRunOpts, map[string]string, error) {
 	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
 
 	results := make([]*repository.CreateWorkflowRunOpts, 0)
-	existingWorkflowRuns := make([]string, 0)
+	existingWorkflowRuns := make(map[string]string)
 
 	nonParentWorkflows := make([]*contracts.TriggerWorkflowRequest, 0)
 
@@ -700,7 +979,7 @@ func getOpts(ctx context.Context, requests []*contracts.TriggerWorkflowRequest,
 			workflowRun := childWorkflowMap[key]
 
 			if workflowRun != nil {
-				existingWorkflowRuns = append(existingWorkflowRuns, sqlchelpers.UUIDToStr(workflowRun.ID))
+				existingWorkflowRuns[key] = sqlchelpers.UUIDToStr(workflowRun.ID)
 			} else {
 				// can't find the child workflow run, so we need to trigger it
 				nonParentWorkflows = append(nonParentWorkflows, req)
@@ -716,22 +995,23 @@ func getOpts(ctx context.Context, requests []*contracts.TriggerWorkflowRequest,
 		return nil, nil, err
 	}
 
-	var workflowIds []string
+	workflowIds := make([]string, len(nonParentWorkflows))
 
-	for _, w := range workflowMap {
-		workflowIds = append(workflowIds, sqlchelpers.UUIDToStr(w.ID))
-	}
+	for i, req := range nonParentWorkflows {
+		workflow := workflowMap[req.Name]
 
-	workflowVersions, err := a.repo.Workflow().GetLatestWorkflowVersions(createContext, tenantId, workflowIds)
+		if workflow == nil {
+			return nil, nil, status.Errorf(codes.NotFound, "workflow %s not found", req.Name)
+		}
 
-	if err != nil {
-		return nil, nil, fmt.Errorf("could not get latest workflow versions: %w", err)
+		workflowIds[i] = sqlchelpers.UUIDToStr(workflow.ID)
 	}
 
-	workflowVersionMap := make(map[string]*dbsqlc.GetWorkflowVersionForEngineRow)
+	// the version is resolved per run, so runs of the same workflow can be split between the versions of a rollout
+	workflowVersions, err := a.repo.Workflow().GetWorkflowVersionsForTrigger(createContext, tenantId, workflowIds)
 
-	for _, w := range workflowVersions {
-		workflowVersionMap[sqlchelpers.UUIDToStr(w.WorkflowVersion.WorkflowId)] = w
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get workflow versions: %w", err)
 	}
 
 	parentTriggeredWorkflowRuns := make(map[string]*dbsqlc.GetWorkflowRunRow)
@@ -755,15 +1035,9 @@ func getOpts(ctx context.Context, requests []*contracts.TriggerWorkflowRequest,
 		parentTriggeredWorkflowRuns[sqlchelpers.UUIDToStr(wfr.WorkflowRun.ID)] = wfr
 	}
 
-	for _, req := range nonParentWorkflows {
-
-		workflow := workflowMap[req.Name]
-
-		if workflow == nil {
-			return nil, nil, status.Errorf(codes.NotFound, "workflow %s not found", req.Name)
-		}
+	for i, req := range nonParentWorkflows {
 
-		latestVersion := workflowVersionMap[sqlchelpers.UUIDToStr(workflow.ID)]
+		workflowVersion := workflowVersions[i]
 
 		var createOpts *repository.CreateWorkflowRunOpts
 
@@ -795,7 +1069,7 @@ func getOpts(ctx context.Context, requests []*contracts.TriggerWorkflowRequest,
 			}
 
 			createOpts, err = repository.GetCreateWorkflowRunOptsFromParent(
-				latestVersion,
+				workflowVersion,
 				[]byte(req.Input),
 				*req.ParentId,
 				*req.ParentStepRunId,
@@ -806,31 +1080,63 @@ func getOpts(ctx context.Context, requests []*contracts.TriggerWorkflowRequest,
 			)
 
 			if err != nil {
+				if st := inputValidationStatus(err); st != nil {
+					return nil, nil, st
+				}
+
 				return nil, nil, fmt.Errorf("Trigger Workflow could not create workflow run opts: %w", err)
 			}
 		} else {
-			createOpts, err = repository.GetCreateWorkflowRunOptsFromManual(latestVersion, []byte(req.Input), additionalMetadata)
+			createOpts, err = repository.GetCreateWorkflowRunOptsFromManual(workflowVersion, []byte(req.Input), additionalMetadata)
 			if err != nil {
+				if st := inputValidationStatus(err); st != nil {
+					return nil, nil, st
+				}
+
 				return nil, nil, fmt.Errorf("Trigger Workflow not after parent triggered check could not create workflow run opts: %w", err)
 			}
 		}
 
 		if req.DesiredWorkerId != nil {
-			if !latestVersion.WorkflowVersion.Sticky.Valid {
-				return nil, nil, status.Errorf(codes.Canceled, "workflow version %s does not have sticky enabled", latestVersion.WorkflowName)
+			if !workflowVersion.WorkflowVersion.Sticky.Valid {
+				return nil, nil, status.Errorf(codes.Canceled, "workflow version %s does not have sticky enabled", workflowVersion.WorkflowName)
 			}
 
 			createOpts.DesiredWorkerId = req.DesiredWorkerId
 		}
 
-		if latestVersion.WorkflowVersion.DefaultPriority.Valid {
-			createOpts.Priority = &latestVersion.WorkflowVersion.DefaultPriority.Int32
+		if workflowVersion.WorkflowVersion.DefaultPriority.Valid {
+			createOpts.Priority = &workflowVersion.WorkflowVersion.DefaultPriority.Int32
 		}
 
 		if req.Priority != nil {
 			createOpts.Priority = req.Priority
 		}
 
+		if req.IdempotencyKey != nil {
+			// the workflow run id is generated up front, so it can be returned for duplicate triggers
+			workflowRunId := uuid.New().String()
+
+			existingWorkflowRunId, err := a.repo.WorkflowRun().ClaimIdempotencyKey(
+				createContext,
+				tenantId,
+				createOpts.WorkflowVersionId,
+				*req.IdempotencyKey,
+				workflowRunId,
+			)
+
+			if err != nil {
+				return nil, nil, fmt.Errorf("could not claim idempotency key: %w", err)
+			}
+
+			if existingWorkflowRunId != nil {
+				existingWorkflowRuns[getIdempotencyKey(req.Name, *req.IdempotencyKey)] = *existingWorkflowRunId
+				continue
+			}
+
+			createOpts.Id = &workflowRunId
+		}
+
 		results = append(results, createOpts)
 
 	}
@@ -870,6 +1176,116 @@ func getWorkflowsForWorkflowNames(ctx context.Context, tenantId string, reqs []*
 
 }
 
+// orderWorkflowRunIds returns the ids of the workflow runs of a bulk trigger in the order of the requests, so that
+// callers can match each id to the workflow they requested. Child workflow runs are matched by their child key,
+// existing workflow runs for an idempotency key by the key, and the remaining workflow runs in the order they were
+// created.
+func orderWorkflowRunIds(requests []*contracts.TriggerWorkflowRequest, existingWorkflowRuns map[string]string, createdWorkflowRuns []*dbsqlc.WorkflowRun) []string {
+	childWorkflowRuns := make(map[string]string, len(existingWorkflowRuns)+len(createdWorkflowRuns))
+	nonChildWorkflowRuns := make([]string, 0)
+
+	for key, workflowRunId := range existingWorkflowRuns {
+		childWorkflowRuns[key] = workflowRunId
+	}
+
+	for _, wfr := range createdWorkflowRuns {
+		if !wfr.ParentStepRunId.Valid || !wfr.ChildIndex.Valid {
+			nonChildWorkflowRuns = append(nonChildWorkflowRuns, sqlchelpers.UUIDToStr(wfr.ID))
+			continue
+		}
+
+		var childKey *string
+
+		if wfr.ChildKey.Valid {
+			childKey = &wfr.ChildKey.String
+		}
+
+		childWorkflowRuns[getChildKey(sqlchelpers.UUIDToStr(wfr.ParentStepRunId), int(wfr.ChildIndex.Int32), childKey)] = sqlchelpers.UUIDToStr(wfr.ID)
+	}
+
+	workflowRunIds := make([]string, 0, len(requests))
+
+	for _, req := range requests {
+		if workflowRunId, ok := getExistingWorkflowRunId(req, childWorkflowRuns); ok {
+			workflowRunIds = append(workflowRunIds, workflowRunId)
+			continue
+		}
+
+		if len(nonChildWorkflowRuns) > 0 {
+			workflowRunIds = append(workflowRunIds, nonChildWorkflowRuns[0])
+			nonChildWorkflowRuns = nonChildWorkflowRuns[1:]
+		}
+	}
+
+	return workflowRunIds
+}
+
+// getExistingWorkflowRunId returns the id of the workflow run which a request resolves to without creating a new one,
+// which is either the child workflow run with its child key or the workflow run which claimed its idempotency key.
+func getExistingWorkflowRunId(req *contracts.TriggerWorkflowRequest, workflowRuns map[string]string) (string, bool) {
+	if req.ParentId != nil {
+		if workflowRunId, ok := workflowRuns[getChildKey(*req.ParentStepRunId, int(*req.ChildIndex), req.ChildKey)]; ok {
+			return workflowRunId, true
+		}
+	}
+
+	if req.IdempotencyKey != nil {
+		if workflowRunId, ok := workflowRuns[getIdempotencyKey(req.Name, *req.IdempotencyKey)]; ok {
+			return workflowRunId, true
+		}
+	}
+
+	return "", false
+}
+
+func getIdempotencyKey(workflowName, idempotencyKey string) string {
+	return fmt.Sprintf("idempotency-%s-%s", workflowName, idempotencyKey)
+}
+
+// inputValidationStatus returns an InvalidArgument status which lists the schema violations if err is caused by
+// an input that doesn't match the input schema of the workflow, and nil otherwise.
+func inputValidationStatus(err error) error {
+	var target *repository.WorkflowRunInputValidationError
+
+	if errors.As(err, &target) {
+		return status.Error(codes.InvalidArgument, target.Error())
+	}
+
+	return nil
+}
+
+// releaseIdempotencyKeys releases the idempotency keys claimed for workflow runs which could not be created, so the
+// trigger can be retried with the same key.
+func (a *AdminServiceImpl) releaseIdempotencyKeys(tenantId string, opts []*repository.CreateWorkflowRunOpts) {
+	workflowRunIds := make([]string, 0)
+
+	for _, opt := range opts {
+		if opt.Id != nil {
+			workflowRunIds = append(workflowRunIds, *opt.Id)
+		}
+	}
+
+	// if the keys can't be released, they still expire after the retention window
+	a.repo.WorkflowRun().ReleaseIdempotencyKeys(context.Background(), tenantId, workflowRunIds) // nolint: errcheck
+}
+
+// checkQuota returns a ResourceExhausted status if creating n of the resource would exceed the quota of the tenant
+func (a *AdminServiceImpl) checkQuota(ctx context.Context, tenantId string, resource repository.TenantQuotaResource, n int) error {
+	err := a.repo.TenantQuota().CheckQuota(ctx, tenantId, resource, int32(n)) // nolint: gosec
+
+	var quotaErr repository.ErrQuotaExceeded
+
+	if errors.As(err, &quotaErr) {
+		return status.Error(codes.ResourceExhausted, quotaErr.Error())
+	}
+
+	if err != nil {
+		return fmt.Errorf("could not check %s quota: %w", resource, err)
+	}
+
+	return nil
+}
+
 func getChildKey(parentStepRunId string, childIndex int, childKey *string) string {
 	if childKey != nil {
 		return fmt.Sprintf("%s-%s", parentStepRunId, *childKey)
@@ -0,0 +1,550 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func newChildWorkflowRun(id, parentStepRunId string, childIndex int32, childKey *string) *dbsqlc.WorkflowRun {
+	wfr := &dbsqlc.WorkflowRun{
+		ID:              sqlchelpers.UUIDFromStr(id),
+		ParentStepRunId: sqlchelpers.UUIDFromStr(parentStepRunId),
+		ChildIndex:      pgtype.Int4{Int32: childIndex, Valid: true},
+	}
+
+	if childKey != nil {
+		wfr.ChildKey = sqlchelpers.TextFromStr(*childKey)
+	}
+
+	return wfr
+}
+
+func TestOrderWorkflowRunIds(t *testing.T) {
+	parentId := uuid.New().String()
+	parentStepRunId := uuid.New().String()
+	childKey := "child"
+	idempotencyKey := "key"
+
+	manualRunA := uuid.New().String()
+	manualRunB := uuid.New().String()
+	childRun := uuid.New().String()
+	keyedChildRun := uuid.New().String()
+	existingRun := uuid.New().String()
+
+	childIndex0 := int32(0)
+	childIndex1 := int32(1)
+
+	tests := []struct {
+		name     string
+		requests []*contracts.TriggerWorkflowRequest
+		existing map[string]string
+		created  []*dbsqlc.WorkflowRun
+		expected []string
+	}{
+		{
+			name: "created runs are matched to their requests",
+			requests: []*contracts.TriggerWorkflowRequest{
+				{Name: "a"},
+				{Name: "child", ParentId: &parentId, ParentStepRunId: &parentStepRunId, ChildIndex: &childIndex0},
+				{Name: "keyed-child", ParentId: &parentId, ParentStepRunId: &parentStepRunId, ChildIndex: &childIndex1, ChildKey: &childKey},
+				{Name: "b"},
+			},
+			// the child workflow runs are created in another order than requested
+			created: []*dbsqlc.WorkflowRun{
+				newChildWorkflowRun(keyedChildRun, parentStepRunId, 1, &childKey),
+				{ID: sqlchelpers.UUIDFromStr(manualRunA)},
+				newChildWorkflowRun(childRun, parentStepRunId, 0, nil),
+				{ID: sqlchelpers.UUIDFromStr(manualRunB)},
+			},
+			expected: []string{manualRunA, childRun, keyedChildRun, manualRunB},
+		},
+		{
+			name: "existing runs are matched to their requests",
+			requests: []*contracts.TriggerWorkflowRequest{
+				{Name: "a"},
+				{Name: "deduped", IdempotencyKey: &idempotencyKey},
+				{Name: "child", ParentId: &parentId, ParentStepRunId: &parentStepRunId, ChildIndex: &childIndex0},
+			},
+			existing: map[string]string{
+				getIdempotencyKey("deduped", idempotencyKey):   existingRun,
+				getChildKey(parentStepRunId, 0, nil):           childRun,
+				getChildKey(parentStepRunId, 1, &childKey):     keyedChildRun,
+				getIdempotencyKey("unrelated", idempotencyKey): uuid.New().String(),
+			},
+			created: []*dbsqlc.WorkflowRun{
+				{ID: sqlchelpers.UUIDFromStr(manualRunA)},
+			},
+			expected: []string{manualRunA, existingRun, childRun},
+		},
+		{
+			name: "only existing runs",
+			requests: []*contracts.TriggerWorkflowRequest{
+				{Name: "deduped", IdempotencyKey: &idempotencyKey},
+				{Name: "keyed-child", ParentId: &parentId, ParentStepRunId: &parentStepRunId, ChildIndex: &childIndex1, ChildKey: &childKey},
+			},
+			existing: map[string]string{
+				getIdempotencyKey("deduped", idempotencyKey): existingRun,
+				getChildKey(parentStepRunId, 1, &childKey):   keyedChildRun,
+			},
+			expected: []string{existingRun, keyedChildRun},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, orderWorkflowRunIds(tt.requests, tt.existing, tt.created))
+		})
+	}
+}
+
+type workflowEngineRepository struct {
+	repository.WorkflowEngineRepository
+
+	workflow *dbsqlc.Workflow
+
+	// the schedules which were created for the workflow
+	schedules []*repository.CreateWorkflowSchedulesOpts
+}
+
+func (r *workflowEngineRepository) GetWorkflowsByNames(ctx context.Context, tenantId string, workflowNames []string) ([]*dbsqlc.Workflow, error) {
+	return []*dbsqlc.Workflow{r.workflow}, nil
+}
+
+func (r *workflowEngineRepository) GetWorkflowVersionsForTrigger(ctx context.Context, tenantId string, workflowIds []string) ([]*dbsqlc.GetWorkflowVersionForEngineRow, error) {
+	versions := make([]*dbsqlc.GetWorkflowVersionForEngineRow, len(workflowIds))
+
+	for i := range workflowIds {
+		versions[i] = &dbsqlc.GetWorkflowVersionForEngineRow{
+			WorkflowVersion: dbsqlc.WorkflowVersion{
+				ID:         sqlchelpers.UUIDFromStr(uuid.New().String()),
+				WorkflowId: r.workflow.ID,
+			},
+			WorkflowName: r.workflow.Name,
+		}
+	}
+
+	return versions, nil
+}
+
+// workflowRunEngineRepository resolves triggers to existing workflow runs, and fails the test if a workflow run is
+// created
+func (r *workflowEngineRepository) GetWorkflowByName(ctx context.Context, tenantId, workflowName string) (*dbsqlc.Workflow, error) {
+	if workflowName != r.workflow.Name {
+		return nil, pgx.ErrNoRows
+	}
+
+	return r.workflow, nil
+}
+
+func (r *workflowEngineRepository) GetLatestWorkflowVersion(ctx context.Context, tenantId, workflowId string) (*dbsqlc.GetWorkflowVersionForEngineRow, error) {
+	return &dbsqlc.GetWorkflowVersionForEngineRow{
+		WorkflowVersion: dbsqlc.WorkflowVersion{
+			ID:         sqlchelpers.UUIDFromStr(uuid.New().String()),
+			WorkflowId: r.workflow.ID,
+		},
+		WorkflowName: r.workflow.Name,
+	}, nil
+}
+
+func (r *workflowEngineRepository) CreateSchedules(ctx context.Context, tenantId, workflowVersionId string, opts *repository.CreateWorkflowSchedulesOpts) ([]*dbsqlc.WorkflowTriggerScheduledRef, error) {
+	r.schedules = append(r.schedules, opts)
+
+	refs := make([]*dbsqlc.WorkflowTriggerScheduledRef, len(opts.ScheduledTriggers))
+
+	for i, triggerAt := range opts.ScheduledTriggers {
+		refs[i] = &dbsqlc.WorkflowTriggerScheduledRef{
+			ID:        sqlchelpers.UUIDFromStr(uuid.New().String()),
+			TriggerAt: sqlchelpers.TimestampFromTime(triggerAt),
+		}
+	}
+
+	return refs, nil
+}
+
+type workflowRunEngineRepository struct {
+	repository.WorkflowRunEngineRepository
+
+	t *testing.T
+
+	childWorkflowRuns []*dbsqlc.WorkflowRun
+	claimedBy         *string
+
+	// the workflow runs whose idempotency keys were released
+	released []string
+}
+
+func (r *workflowRunEngineRepository) GetChildWorkflowRuns(ctx context.Context, childWorkflowRuns []repository.ChildWorkflowRun) ([]*dbsqlc.WorkflowRun, error) {
+	return r.childWorkflowRuns, nil
+}
+
+func (r *workflowRunEngineRepository) GetWorkflowRunByIds(ctx context.Context, tenantId string, runIds []string) ([]*dbsqlc.GetWorkflowRunRow, error) {
+	return nil, nil
+}
+
+func (r *workflowRunEngineRepository) ClaimIdempotencyKey(ctx context.Context, tenantId, workflowVersionId, key, workflowRunId string) (*string, error) {
+	return r.claimedBy, nil
+}
+
+func (r *workflowRunEngineRepository) CreateNewWorkflowRun(ctx context.Context, tenantId string, opts *repository.CreateWorkflowRunOpts) (*dbsqlc.WorkflowRun, error) {
+	r.t.Fatal("a workflow run was created for a duplicate trigger")
+	return nil, nil
+}
+
+func (r *workflowRunEngineRepository) ReleaseIdempotencyKeys(ctx context.Context, tenantId string, workflowRunIds []string) error {
+	r.released = append(r.released, workflowRunIds...)
+	return nil
+}
+
+// tenantQuotaRepository allows creating resources until the usage of a resource reaches its limit
+type tenantQuotaRepository struct {
+	repository.TenantQuotaRepository
+
+	limits map[repository.TenantQuotaResource]int32
+	usage  map[repository.TenantQuotaResource]int32
+}
+
+func (r *tenantQuotaRepository) CheckQuota(ctx context.Context, tenantId string, resource repository.TenantQuotaResource, n int32) error {
+	limit, ok := r.limits[resource]
+
+	if !ok || r.usage[resource]+n <= limit {
+		return nil
+	}
+
+	return repository.ErrQuotaExceeded{
+		Resource: resource,
+		Limit:    limit,
+		Usage:    r.usage[resource],
+	}
+}
+
+type engineRepository struct {
+	repository.EngineRepository
+
+	workflows    *workflowEngineRepository
+	workflowRuns *workflowRunEngineRepository
+	tenantQuota  *tenantQuotaRepository
+}
+
+func (r *engineRepository) Workflow() repository.WorkflowEngineRepository {
+	return r.workflows
+}
+
+func (r *engineRepository) WorkflowRun() repository.WorkflowRunEngineRepository {
+	return r.workflowRuns
+}
+
+func (r *engineRepository) TenantQuota() repository.TenantQuotaRepository {
+	if r.tenantQuota == nil {
+		return &tenantQuotaRepository{}
+	}
+
+	return r.tenantQuota
+}
+
+func TestTriggerWorkflowDedupe(t *testing.T) {
+	parentId := uuid.New().String()
+	parentStepRunId := uuid.New().String()
+	childKey := "child"
+	idempotencyKey := "key"
+	childIndex := int32(0)
+
+	existingRun := uuid.New().String()
+
+	tests := []struct {
+		name              string
+		req               *contracts.TriggerWorkflowRequest
+		childWorkflowRuns []*dbsqlc.WorkflowRun
+		claimedBy         *string
+	}{
+		{
+			name:      "idempotency key which was claimed by another run",
+			req:       &contracts.TriggerWorkflowRequest{Name: "workflow", IdempotencyKey: &idempotencyKey},
+			claimedBy: &existingRun,
+		},
+		{
+			name: "child workflow run which exists",
+			req: &contracts.TriggerWorkflowRequest{
+				Name:            "workflow",
+				ParentId:        &parentId,
+				ParentStepRunId: &parentStepRunId,
+				ChildIndex:      &childIndex,
+				ChildKey:        &childKey,
+			},
+			childWorkflowRuns: []*dbsqlc.WorkflowRun{
+				newChildWorkflowRun(existingRun, parentStepRunId, childIndex, &childKey),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &AdminServiceImpl{
+				repo: &engineRepository{
+					workflows: &workflowEngineRepository{
+						workflow: &dbsqlc.Workflow{
+							ID:   sqlchelpers.UUIDFromStr(uuid.New().String()),
+							Name: "workflow",
+						},
+					},
+					workflowRuns: &workflowRunEngineRepository{
+						t:                 t,
+						childWorkflowRuns: tt.childWorkflowRuns,
+						claimedBy:         tt.claimedBy,
+					},
+				},
+			}
+
+			ctx := context.WithValue(context.Background(), "tenant", &dbsqlc.Tenant{ // nolint: staticcheck
+				ID: sqlchelpers.UUIDFromStr(uuid.New().String()),
+			})
+
+			res, err := a.TriggerWorkflow(ctx, tt.req)
+
+			require.NoError(t, err)
+			assert.Equal(t, existingRun, res.WorkflowRunId)
+		})
+	}
+}
+
+func TestGetCreateJobOptsTimeouts(t *testing.T) {
+	scheduleTimeout := "30s"
+	heartbeatTimeout := "10s"
+
+	opts, err := getCreateJobOpts(&contracts.CreateWorkflowJobOpts{
+		Name: "job",
+		Steps: []*contracts.CreateWorkflowStepOpts{
+			{
+				ReadableId:       "with-timeouts",
+				Action:           "test:with-timeouts",
+				ScheduleTimeout:  &scheduleTimeout,
+				HeartbeatTimeout: &heartbeatTimeout,
+			},
+			{
+				ReadableId: "without-timeouts",
+				Action:     "test:without-timeouts",
+			},
+		},
+	}, "DEFAULT")
+
+	require.NoError(t, err)
+	require.Len(t, opts.Steps, 2)
+
+	assert.Equal(t, &scheduleTimeout, opts.Steps[0].ScheduleTimeout)
+	assert.Equal(t, &heartbeatTimeout, opts.Steps[0].HeartbeatTimeout)
+
+	// steps without a schedule timeout use the schedule timeout of the workflow
+	assert.Nil(t, opts.Steps[1].ScheduleTimeout)
+	assert.Nil(t, opts.Steps[1].HeartbeatTimeout)
+}
+
+func newTestAdminService(t *testing.T, workflows *workflowEngineRepository) *AdminServiceImpl {
+	return &AdminServiceImpl{
+		repo: &engineRepository{
+			workflows:    workflows,
+			workflowRuns: &workflowRunEngineRepository{t: t},
+		},
+	}
+}
+
+func newTestTenantContext() context.Context {
+	return context.WithValue(context.Background(), "tenant", &dbsqlc.Tenant{ // nolint: staticcheck
+		ID: sqlchelpers.UUIDFromStr(uuid.New().String()),
+	})
+}
+
+func TestTriggerWorkflowAt(t *testing.T) {
+	workflows := &workflowEngineRepository{
+		workflow: &dbsqlc.Workflow{
+			ID:   sqlchelpers.UUIDFromStr(uuid.New().String()),
+			Name: "workflow",
+		},
+	}
+
+	a := newTestAdminService(t, workflows)
+
+	triggerAt := time.Now().Add(time.Hour).UTC().Truncate(time.Millisecond)
+	additionalMetadata := `{"key":"value"}`
+
+	// the workflow run repository fails the test if the workflow run is created instead of scheduled
+	res, err := a.TriggerWorkflow(newTestTenantContext(), &contracts.TriggerWorkflowRequest{
+		Name:               "workflow",
+		Input:              `{"hello":"world"}`,
+		AdditionalMetadata: &additionalMetadata,
+		TriggerAt:          timestamppb.New(triggerAt),
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, res.ScheduledWorkflowRunId)
+	assert.Empty(t, res.WorkflowRunId)
+
+	require.Len(t, workflows.schedules, 1)
+	assert.Equal(t, &repository.CreateWorkflowSchedulesOpts{
+		ScheduledTriggers:  []time.Time{triggerAt},
+		Input:              []byte(`{"hello":"world"}`),
+		AdditionalMetadata: []byte(additionalMetadata),
+	}, workflows.schedules[0])
+}
+
+func TestTriggerWorkflowAtInvalid(t *testing.T) {
+	parentId := uuid.New().String()
+	idempotencyKey := "key"
+	triggerAt := timestamppb.New(time.Now().Add(time.Hour))
+
+	tests := []struct {
+		name string
+		req  *contracts.TriggerWorkflowRequest
+		code codes.Code
+	}{
+		{
+			name: "workflow which doesn't exist",
+			req:  &contracts.TriggerWorkflowRequest{Name: "missing", TriggerAt: triggerAt},
+			code: codes.NotFound,
+		},
+		{
+			name: "child workflow run",
+			req:  &contracts.TriggerWorkflowRequest{Name: "workflow", TriggerAt: triggerAt, ParentId: &parentId},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "idempotency key",
+			req:  &contracts.TriggerWorkflowRequest{Name: "workflow", TriggerAt: triggerAt, IdempotencyKey: &idempotencyKey},
+			code: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workflows := &workflowEngineRepository{
+				workflow: &dbsqlc.Workflow{
+					ID:   sqlchelpers.UUIDFromStr(uuid.New().String()),
+					Name: "workflow",
+				},
+			}
+
+			_, err := newTestAdminService(t, workflows).TriggerWorkflow(newTestTenantContext(), tt.req)
+
+			require.Error(t, err)
+			assert.Equal(t, tt.code, status.Code(err))
+			assert.Empty(t, workflows.schedules)
+		})
+	}
+}
+
+func TestBulkTriggerWorkflowAt(t *testing.T) {
+	workflows := &workflowEngineRepository{
+		workflow: &dbsqlc.Workflow{
+			ID:   sqlchelpers.UUIDFromStr(uuid.New().String()),
+			Name: "workflow",
+		},
+	}
+
+	_, err := newTestAdminService(t, workflows).BulkTriggerWorkflow(newTestTenantContext(), &contracts.BulkTriggerWorkflowRequest{
+		Workflows: []*contracts.TriggerWorkflowRequest{
+			{Name: "workflow"},
+			{Name: "workflow", TriggerAt: timestamppb.New(time.Now().Add(time.Hour))},
+		},
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Empty(t, workflows.schedules)
+}
+
+func TestTriggerWorkflowQuotaExceeded(t *testing.T) {
+	idempotencyKey := "key"
+
+	tests := []struct {
+		name    string
+		trigger func(a *AdminServiceImpl) error
+	}{
+		{
+			name: "trigger",
+			trigger: func(a *AdminServiceImpl) error {
+				_, err := a.TriggerWorkflow(newTestTenantContext(), &contracts.TriggerWorkflowRequest{
+					Name:           "workflow",
+					IdempotencyKey: &idempotencyKey,
+				})
+
+				return err
+			},
+		},
+		{
+			name: "bulk trigger",
+			trigger: func(a *AdminServiceImpl) error {
+				_, err := a.BulkTriggerWorkflow(newTestTenantContext(), &contracts.BulkTriggerWorkflowRequest{
+					Workflows: []*contracts.TriggerWorkflowRequest{
+						{Name: "workflow", IdempotencyKey: &idempotencyKey},
+						{Name: "workflow"},
+					},
+				})
+
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workflowRuns := &workflowRunEngineRepository{t: t}
+
+			a := &AdminServiceImpl{
+				repo: &engineRepository{
+					workflows: &workflowEngineRepository{
+						workflow: &dbsqlc.Workflow{
+							ID:   sqlchelpers.UUIDFromStr(uuid.New().String()),
+							Name: "workflow",
+						},
+					},
+					workflowRuns: workflowRuns,
+					tenantQuota: &tenantQuotaRepository{
+						limits: map[repository.TenantQuotaResource]int32{repository.TenantQuotaResourceQueuedRuns: 10},
+						usage:  map[repository.TenantQuotaResource]int32{repository.TenantQuotaResourceQueuedRuns: 10},
+					},
+				},
+			}
+
+			// the workflow runs aren't created, and the idempotency keys which were claimed for them are released
+			err := tt.trigger(a)
+
+			require.Error(t, err)
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+			assert.Len(t, workflowRuns.released, 1)
+		})
+	}
+}
+
+func TestCheckQuota(t *testing.T) {
+	a := &AdminServiceImpl{
+		repo: &engineRepository{
+			tenantQuota: &tenantQuotaRepository{
+				limits: map[repository.TenantQuotaResource]int32{repository.TenantQuotaResourceWorkflowVersions: 5},
+				usage:  map[repository.TenantQuotaResource]int32{repository.TenantQuotaResourceWorkflowVersions: 4},
+			},
+		},
+	}
+
+	tenantId := uuid.New().String()
+
+	assert.NoError(t, a.checkQuota(context.Background(), tenantId, repository.TenantQuotaResourceWorkflowVersions, 1))
+
+	err := a.checkQuota(context.Background(), tenantId, repository.TenantQuotaResourceWorkflowVersions, 2)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// resources without a quota are unlimited
+	assert.NoError(t, a.checkQuota(context.Background(), tenantId, repository.TenantQuotaResourceQueuedRuns, 1000))
+}
@@ -0,0 +1,296 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/internal/dagutils"
+	"github.com/hatchet-dev/hatchet/internal/schema"
+	"github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+const (
+	diagnosticInvalidExpression = "INVALID_EXPRESSION"
+	diagnosticInvalidSchema     = "INVALID_SCHEMA"
+	diagnosticSchemaMismatch    = "SCHEMA_MISMATCH"
+	diagnosticInvalidOptions    = "INVALID_OPTIONS"
+)
+
+var validateCELParser = cel.NewCELParser()
+
+// ValidateWorkflow checks a workflow definition without persisting it, and returns the problems which PutWorkflow
+// would reject or which would prevent steps from running as expected.
+func (a *AdminServiceImpl) ValidateWorkflow(ctx context.Context, req *contracts.ValidateWorkflowRequest) (*contracts.ValidateWorkflowResponse, error) {
+	if req.Opts == nil {
+		return nil, status.Error(codes.InvalidArgument, "opts is required")
+	}
+
+	diagnostics, err := a.validateWorkflow(req.Opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	valid := true
+
+	for _, d := range diagnostics {
+		if d.Severity == contracts.WorkflowDiagnosticSeverity_ERROR {
+			valid = false
+			break
+		}
+	}
+
+	return &contracts.ValidateWorkflowResponse{
+		Valid:       valid,
+		Diagnostics: diagnostics,
+	}, nil
+}
+
+func (a *AdminServiceImpl) validateWorkflow(opts *contracts.CreateWorkflowVersionOpts) ([]*contracts.WorkflowDiagnostic, error) {
+	res := make([]*contracts.WorkflowDiagnostic, 0)
+
+	jobs := make([]*contracts.CreateWorkflowJobOpts, 0, len(opts.Jobs)+1)
+	jobs = append(jobs, opts.Jobs...)
+
+	if opts.OnFailureJob != nil {
+		jobs = append(jobs, opts.OnFailureJob)
+	}
+
+	for _, job := range jobs {
+		res = append(res, validateJobGraph(job)...)
+		res = append(res, validateJobExpressions(job)...)
+		res = append(res, validateJobSchemas(job)...)
+	}
+
+	if opts.Output != nil {
+		if _, err := validateCELParser.ParseWorkflowRunOutput(*opts.Output); err != nil {
+			res = append(res, newDiagnostic(diagnosticInvalidExpression, fmt.Sprintf("invalid output expression: %s", err.Error()), nil, nil, "output"))
+		}
+	}
+
+	if opts.InputSchema != nil {
+		if _, err := schema.ParseJSONSchema([]byte(*opts.InputSchema)); err != nil {
+			res = append(res, newDiagnostic(diagnosticInvalidSchema, fmt.Sprintf("invalid input schema: %s", err.Error()), nil, nil, "input_schema"))
+		}
+	}
+
+	if opts.Concurrency != nil && opts.Concurrency.Expression != nil {
+		if _, err := validateCELParser.ParseWorkflowString(*opts.Concurrency.Expression); err != nil {
+			res = append(res, newDiagnostic(diagnosticInvalidExpression, fmt.Sprintf("invalid concurrency expression: %s", err.Error()), nil, nil, "concurrency.expression"))
+		}
+	}
+
+	for _, d := range res {
+		if d.Severity == contracts.WorkflowDiagnosticSeverity_ERROR {
+			// the remaining options are only checked once the steps are valid, so problems aren't reported twice
+			return res, nil
+		}
+	}
+
+	createOpts, err := getCreateWorkflowOpts(&contracts.PutWorkflowRequest{Opts: opts})
+
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.InvalidArgument {
+			return append(res, newDiagnostic(diagnosticInvalidOptions, s.Message(), nil, nil, "")), nil
+		}
+
+		return nil, err
+	}
+
+	apiErrors, err := a.v.ValidateAPI(createOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if apiErrors != nil {
+		for _, apiErr := range apiErrors.Errors {
+			field := ""
+
+			if apiErr.Field != nil {
+				field = *apiErr.Field
+			}
+
+			res = append(res, newDiagnostic(diagnosticInvalidOptions, apiErr.Description, nil, nil, field))
+		}
+	}
+
+	return res, nil
+}
+
+func validateJobGraph(job *contracts.CreateWorkflowJobOpts) []*contracts.WorkflowDiagnostic {
+	steps := make([]repository.CreateWorkflowStepOpts, len(job.Steps))
+
+	for i, step := range job.Steps {
+		steps[i] = repository.CreateWorkflowStepOpts{
+			ReadableId: step.ReadableId,
+			Parents:    step.Parents,
+		}
+	}
+
+	res := make([]*contracts.WorkflowDiagnostic, 0)
+
+	for _, d := range dagutils.ValidateSteps(steps) {
+		step := d.Step
+		res = append(res, newDiagnostic(d.Code, d.Message, &job.Name, &step, "parents"))
+	}
+
+	return res
+}
+
+func validateJobExpressions(job *contracts.CreateWorkflowJobOpts) []*contracts.WorkflowDiagnostic {
+	res := make([]*contracts.WorkflowDiagnostic, 0)
+
+	for _, step := range job.Steps {
+		stepId := step.ReadableId
+
+		check := func(field string, expr *string, parse func(string) error) {
+			if expr == nil {
+				return
+			}
+
+			if err := parse(*expr); err != nil {
+				res = append(res, newDiagnostic(diagnosticInvalidExpression, fmt.Sprintf("invalid %s expression: %s", field, err.Error()), &job.Name, &stepId, field))
+			}
+		}
+
+		parseStepRun := func(expr string) error {
+			_, err := validateCELParser.ParseStepRun(expr)
+			return err
+		}
+
+		parseWorkflowString := func(expr string) error {
+			_, err := validateCELParser.ParseWorkflowString(expr)
+			return err
+		}
+
+		check("condition", step.Condition, parseStepRun)
+		check("map_over", step.MapOver, parseStepRun)
+		check("sleep_until", step.SleepUntil, parseStepRun)
+		check("wait_for_event_correlation", step.WaitForEventCorrelation, parseWorkflowString)
+
+		for _, rateLimit := range step.RateLimits {
+			check("rate_limits.key_expr", rateLimit.KeyExpr, parseStepRun)
+			check("rate_limits.units_expr", rateLimit.UnitsExpr, parseStepRun)
+			check("rate_limits.limit_values_expr", rateLimit.LimitValuesExpr, parseStepRun)
+		}
+	}
+
+	return res
+}
+
+// validateJobSchemas checks that the outputs of the parents of each step match what the input schema of the step
+// declares under parents.<parent>.
+func validateJobSchemas(job *contracts.CreateWorkflowJobOpts) []*contracts.WorkflowDiagnostic {
+	res := make([]*contracts.WorkflowDiagnostic, 0)
+
+	outputSchemas := make(map[string]map[string]interface{})
+
+	for _, step := range job.Steps {
+		if step.OutputSchema == nil {
+			continue
+		}
+
+		stepId := step.ReadableId
+
+		schema, err := dagutils.ParseSchema(*step.OutputSchema)
+
+		if err != nil {
+			res = append(res, newDiagnostic(diagnosticInvalidSchema, fmt.Sprintf("invalid output schema: %s", err.Error()), &job.Name, &stepId, "output_schema"))
+			continue
+		}
+
+		outputSchemas[stepId] = schema
+	}
+
+	for _, step := range job.Steps {
+		if step.InputSchema == nil {
+			continue
+		}
+
+		stepId := step.ReadableId
+
+		schema, err := dagutils.ParseSchema(*step.InputSchema)
+
+		if err != nil {
+			res = append(res, newDiagnostic(diagnosticInvalidSchema, fmt.Sprintf("invalid input schema: %s", err.Error()), &job.Name, &stepId, "input_schema"))
+			continue
+		}
+
+		props, _ := schema["properties"].(map[string]interface{})
+		parentsSchema, _ := props["parents"].(map[string]interface{})
+		expectedParents, _ := parentsSchema["properties"].(map[string]interface{})
+
+		isParent := make(map[string]bool, len(step.Parents))
+
+		for _, parent := range step.Parents {
+			isParent[parent] = true
+		}
+
+		for _, parent := range sortedKeys(expectedParents) {
+			if !isParent[parent] {
+				res = append(res, newDiagnostic(diagnosticSchemaMismatch, fmt.Sprintf("input schema expects the output of step %s, which is not a parent of the step", parent), &job.Name, &stepId, "input_schema"))
+			}
+		}
+
+		for _, parent := range step.Parents {
+			expected, ok := expectedParents[parent].(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			actual, ok := outputSchemas[parent]
+
+			if !ok {
+				continue
+			}
+
+			for _, mismatch := range dagutils.CompareSchemas(expected, actual) {
+				d := newDiagnostic(diagnosticSchemaMismatch, fmt.Sprintf("output of parent %s at %s: %s", parent, mismatch.Path, mismatch.Message), &job.Name, &stepId, "input_schema")
+
+				if mismatch.Possible {
+					d.Severity = contracts.WorkflowDiagnosticSeverity_WARNING
+				}
+
+				res = append(res, d)
+			}
+		}
+	}
+
+	return res
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func newDiagnostic(code, message string, job, step *string, field string) *contracts.WorkflowDiagnostic {
+	d := &contracts.WorkflowDiagnostic{
+		Severity: contracts.WorkflowDiagnosticSeverity_ERROR,
+		Code:     code,
+		Message:  message,
+		Job:      job,
+		Step:     step,
+	}
+
+	if field != "" {
+		d.Field = &field
+	}
+
+	return d
+}
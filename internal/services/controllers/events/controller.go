@@ -3,13 +3,16 @@ package events
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
 
+	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/hatchet-dev/hatchet/internal/cel"
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
@@ -29,8 +32,9 @@ type EventsControllerImpl struct {
 
 	entitlements repository.EntitlementsRepository
 
-	repo repository.EngineRepository
-	dv   datautils.DataDecoderValidator
+	repo      repository.EngineRepository
+	dv        datautils.DataDecoderValidator
+	celParser *cel.CELParser
 }
 
 type EventsControllerOpt func(*EventsControllerOpts)
@@ -109,6 +113,7 @@ func New(fs ...EventsControllerOpt) (*EventsControllerImpl, error) {
 		repo:         opts.repo,
 		entitlements: opts.entitlements,
 		dv:           opts.dv,
+		celParser:    cel.NewCELParser(),
 	}, nil
 }
 
@@ -149,6 +154,9 @@ func (ec *EventsControllerImpl) Start() (func() error, error) {
 }
 
 func (ec *EventsControllerImpl) handleTask(ctx context.Context, task *msgqueue.Message) error {
+	ctx, span := telemetry.NewSpanWithCarrier(ctx, "handle-event", task.OtelCarrier)
+	defer span.End()
+
 	payload := tasktypes.EventTaskPayload{}
 	metadata := tasktypes.EventTaskMetadata{}
 
@@ -174,7 +182,27 @@ func (ec *EventsControllerImpl) handleTask(ctx context.Context, task *msgqueue.M
 		}
 	}
 
-	return ec.processEvent(ctx, metadata.TenantId, payload.EventId, payload.EventKey, []byte(payload.EventData), additionalMetadata)
+	// replayed events are never duplicates, since they were replayed on purpose
+	if !payload.EventReplayed {
+		duplicate, err := ec.isDuplicateEvent(ctx, metadata.TenantId, payload.EventId, payload.EventKey, []byte(payload.EventData), additionalMetadata)
+
+		if err != nil {
+			return fmt.Errorf("could not check for duplicate event: %w", err)
+		}
+
+		if duplicate {
+			ec.l.Debug().Msgf("event %s is a duplicate of an event with the same dedup key, skipping", payload.EventId)
+			return nil
+		}
+	}
+
+	var idempotencyKey *string
+
+	if payload.EventIdempotencyKey != "" {
+		idempotencyKey = &payload.EventIdempotencyKey
+	}
+
+	return ec.processEvent(ctx, metadata.TenantId, payload.EventId, payload.EventKey, []byte(payload.EventData), additionalMetadata, idempotencyKey, payload.EventRoutedWorkflowIds)
 }
 
 func cleanAdditionalMetadata(additionalMetadata map[string]interface{}) map[string]interface{} {
@@ -190,7 +218,7 @@ func cleanAdditionalMetadata(additionalMetadata map[string]interface{}) map[stri
 	return additionalMetadata
 }
 
-func (ec *EventsControllerImpl) processEvent(ctx context.Context, tenantId, eventId, eventKey string, data []byte, additionalMetadata map[string]interface{}) error {
+func (ec *EventsControllerImpl) processEvent(ctx context.Context, tenantId, eventId, eventKey string, data []byte, additionalMetadata map[string]interface{}, idempotencyKey *string, routedWorkflowIds []string) error {
 	ctx, span := telemetry.NewSpan(ctx, "process-event")
 	defer span.End()
 
@@ -207,6 +235,13 @@ func (ec *EventsControllerImpl) processEvent(ctx context.Context, tenantId, even
 		return fmt.Errorf("could not query workflows for event: %w", err)
 	}
 
+	// add the workflows which the event was routed to by the routing rules of the tenant
+	workflowVersions, err = ec.addRoutedWorkflows(ctx, tenantId, eventId, workflowVersions, routedWorkflowIds)
+
+	if err != nil {
+		return fmt.Errorf("could not query routed workflows for event: %w", err)
+	}
+
 	// create a new workflow run in the database
 	var g = new(errgroup.Group)
 
@@ -218,13 +253,43 @@ func (ec *EventsControllerImpl) processEvent(ctx context.Context, tenantId, even
 			// create a new workflow run in the database
 			createOpts, err := repository.GetCreateWorkflowRunOptsFromEvent(eventId, workflowCp, data, additionalMetadata)
 
+			var inputErr *repository.WorkflowRunInputValidationError
+
+			if errors.As(err, &inputErr) {
+				// the event was ingested before the input schema of the workflow changed, so the workflow is skipped
+				ec.l.Warn().Msgf("event %s does not trigger workflow: %s", eventId, inputErr.Error())
+				return nil
+			}
+
 			if err != nil {
 				return fmt.Errorf("could not get create workflow run opts: %w", err)
 			}
 
+			if idempotencyKey != nil {
+				workflowRunId := uuid.New().String()
+
+				existingWorkflowRunId, err := ec.repo.WorkflowRun().ClaimIdempotencyKey(ctx, tenantId, createOpts.WorkflowVersionId, *idempotencyKey, workflowRunId)
+
+				if err != nil {
+					return fmt.Errorf("could not claim idempotency key: %w", err)
+				}
+
+				// the workflow was already triggered with this key
+				if existingWorkflowRunId != nil {
+					return nil
+				}
+
+				createOpts.Id = &workflowRunId
+			}
+
 			workflowRun, err := ec.repo.WorkflowRun().CreateNewWorkflowRun(ctx, tenantId, createOpts)
 
 			if err != nil {
+				if createOpts.Id != nil {
+					// release the key so the event can be retried
+					ec.repo.WorkflowRun().ReleaseIdempotencyKeys(ctx, tenantId, []string{*createOpts.Id}) // nolint: errcheck
+				}
+
 				return fmt.Errorf("processEvent: could not create workflow run: %w", err)
 			}
 
@@ -252,5 +317,6 @@ func (ec *EventsControllerImpl) processEvent(ctx context.Context, tenantId, even
 		return err
 	}
 
-	return nil
+	// resume any step runs which are waiting for this event
+	return ec.resumeSignalWaits(ctx, tenantId, eventKey, data, additionalMetadata)
 }
@@ -0,0 +1,51 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+)
+
+// isDuplicateEvent returns whether an event is a duplicate under the dedup rule of its key, in which case it doesn't
+// trigger workflows. The first event with a dedup key claims the key for the window of the rule, and the other
+// events with the key in the window are duplicates. Events whose dedup key can't be evaluated are never duplicates.
+func (ec *EventsControllerImpl) isDuplicateEvent(ctx context.Context, tenantId, eventId, eventKey string, data []byte, additionalMetadata map[string]interface{}) (bool, error) {
+	rule, err := ec.repo.EventDedup().GetEventDedupRuleForKey(ctx, tenantId, eventKey)
+
+	if err != nil {
+		return false, fmt.Errorf("could not get event dedup rule: %w", err)
+	}
+
+	if rule == nil {
+		return false, nil
+	}
+
+	eventData := map[string]interface{}{}
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &eventData); err != nil {
+			ec.l.Debug().Err(err).Msgf("event payload for %s is not an object", eventKey)
+		}
+	}
+
+	dedupKey, err := ec.celParser.ParseAndEvalWorkflowString(rule.Expression, cel.NewInput(
+		cel.WithAdditionalMetadata(additionalMetadata),
+		cel.WithInput(eventData),
+	))
+
+	if err != nil {
+		ec.l.Warn().Err(err).Msgf("could not evaluate dedup key of event %s, the event is not deduplicated", eventId)
+		return false, nil
+	}
+
+	claimed, err := ec.repo.EventDedup().ClaimEventDedupKey(ctx, tenantId, eventKey, dedupKey, eventId, time.Duration(rule.WindowSeconds)*time.Second)
+
+	if err != nil {
+		return false, err
+	}
+
+	return !claimed, nil
+}
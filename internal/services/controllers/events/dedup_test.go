@@ -0,0 +1,126 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type mockEventDedupRepo struct {
+	repository.EventDedupRepository
+	mock.Mock
+}
+
+func (m *mockEventDedupRepo) GetEventDedupRuleForKey(ctx context.Context, tenantId, eventKey string) (*dbsqlc.EventDedupRule, error) {
+	args := m.Called(ctx, tenantId, eventKey)
+	rule, _ := args.Get(0).(*dbsqlc.EventDedupRule)
+	return rule, args.Error(1)
+}
+
+func (m *mockEventDedupRepo) ClaimEventDedupKey(ctx context.Context, tenantId, eventKey, dedupKey, eventId string, window time.Duration) (bool, error) {
+	args := m.Called(ctx, tenantId, eventKey, dedupKey, eventId, window)
+	return args.Bool(0), args.Error(1)
+}
+
+type mockDedupEngineRepo struct {
+	repository.EngineRepository
+
+	eventDedup *mockEventDedupRepo
+}
+
+func (m *mockDedupEngineRepo) EventDedup() repository.EventDedupRepository {
+	return m.eventDedup
+}
+
+func TestIsDuplicateEvent(t *testing.T) {
+	tenantId := uuid.New().String()
+	eventId := uuid.New().String()
+
+	rule := &dbsqlc.EventDedupRule{
+		EventKey:      "order:created",
+		Expression:    `input.orderId + "-" + additional_metadata.region`,
+		WindowSeconds: 60,
+	}
+
+	tests := []struct {
+		name     string
+		eventKey string
+		data     string
+		rule     *dbsqlc.EventDedupRule
+
+		// the dedup key which is claimed, if any
+		dedupKey string
+		claimed  bool
+
+		duplicate bool
+	}{
+		{
+			name:     "event key without a dedup rule",
+			eventKey: "user:created",
+			data:     `{"orderId":"1"}`,
+		},
+		{
+			name:     "first event with the dedup key",
+			eventKey: "order:created",
+			data:     `{"orderId":"1"}`,
+			rule:     rule,
+			dedupKey: "1-eu",
+			claimed:  true,
+		},
+		{
+			name:      "event with a dedup key which was claimed in the window",
+			eventKey:  "order:created",
+			data:      `{"orderId":"1"}`,
+			rule:      rule,
+			dedupKey:  "1-eu",
+			claimed:   false,
+			duplicate: true,
+		},
+		{
+			name:     "event whose dedup key can't be evaluated",
+			eventKey: "order:created",
+			data:     `{"customerId":"1"}`,
+			rule:     rule,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dedup := &mockEventDedupRepo{}
+			dedup.On("GetEventDedupRuleForKey", mock.Anything, tenantId, tt.eventKey).Return(tt.rule, nil)
+
+			if tt.dedupKey != "" {
+				dedup.On("ClaimEventDedupKey", mock.Anything, tenantId, tt.eventKey, tt.dedupKey, eventId, time.Minute).Return(tt.claimed, nil).Once()
+			}
+
+			l := zerolog.Nop()
+
+			ec := &EventsControllerImpl{
+				l:         &l,
+				repo:      &mockDedupEngineRepo{eventDedup: dedup},
+				celParser: cel.NewCELParser(),
+			}
+
+			duplicate, err := ec.isDuplicateEvent(context.Background(), tenantId, eventId, tt.eventKey, []byte(tt.data), map[string]interface{}{"region": "eu"})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.duplicate, duplicate)
+
+			dedup.AssertExpectations(t)
+
+			if tt.dedupKey == "" {
+				dedup.AssertNotCalled(t, "ClaimEventDedupKey", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
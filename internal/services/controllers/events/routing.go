@@ -0,0 +1,52 @@
+package events
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// addRoutedWorkflows adds the latest versions of the workflows which an event was routed to by routing rules to the
+// workflow versions which the key of the event triggers. A workflow which the key of the event already triggers is
+// only triggered once, and workflows which were deleted after the event was routed are skipped.
+func (ec *EventsControllerImpl) addRoutedWorkflows(ctx context.Context, tenantId, eventId string, workflowVersions []*dbsqlc.GetWorkflowVersionForEngineRow, routedWorkflowIds []string) ([]*dbsqlc.GetWorkflowVersionForEngineRow, error) {
+	if len(routedWorkflowIds) == 0 {
+		return workflowVersions, nil
+	}
+
+	triggered := make(map[string]bool, len(workflowVersions))
+
+	for _, workflowVersion := range workflowVersions {
+		triggered[sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.WorkflowId)] = true
+	}
+
+	// the workflow versions for event keys are cached, so they're copied instead of appended to
+	res := make([]*dbsqlc.GetWorkflowVersionForEngineRow, len(workflowVersions), len(workflowVersions)+len(routedWorkflowIds))
+	copy(res, workflowVersions)
+
+	for _, workflowId := range routedWorkflowIds {
+		if triggered[workflowId] {
+			continue
+		}
+
+		workflowVersion, err := ec.repo.Workflow().GetLatestWorkflowVersion(ctx, tenantId, workflowId)
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			ec.l.Warn().Msgf("event %s was routed to workflow %s which no longer exists", eventId, workflowId)
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		triggered[workflowId] = true
+		res = append(res, workflowVersion)
+	}
+
+	return res, nil
+}
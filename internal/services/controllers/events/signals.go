@@ -0,0 +1,105 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// resumeSignalWaits resumes the wait-for-event step runs which are waiting for an event with this key. The event
+// payload becomes the output of the step run.
+func (ec *EventsControllerImpl) resumeSignalWaits(ctx context.Context, tenantId, eventKey string, data []byte, additionalMetadata map[string]interface{}) error {
+	waits, err := ec.repo.Signal().ListSignalWaitsForEvent(ctx, tenantId, eventKey)
+
+	if err != nil {
+		return fmt.Errorf("could not list signal waits for event: %w", err)
+	}
+
+	if len(waits) == 0 {
+		return nil
+	}
+
+	eventData := map[string]interface{}{}
+
+	if len(data) > 0 {
+		// events which don't have an object payload can only resume waits without a correlation expression
+		if err := json.Unmarshal(data, &eventData); err != nil {
+			ec.l.Debug().Err(err).Msgf("event payload for %s is not an object", eventKey)
+		}
+	}
+
+	input := cel.NewInput(
+		cel.WithAdditionalMetadata(additionalMetadata),
+		cel.WithInput(eventData),
+	)
+
+	output := string(data)
+
+	if output == "" {
+		output = "{}"
+	}
+
+	for _, wait := range waits {
+		stepRunId := sqlchelpers.UUIDToStr(wait.StepRunId)
+
+		if wait.CorrelationExpr.Valid {
+			value, err := ec.celParser.ParseAndEvalWorkflowString(wait.CorrelationExpr.String, input)
+
+			if err != nil || value != wait.CorrelationValue.String {
+				continue
+			}
+		}
+
+		claimed, err := ec.repo.Signal().ClaimSignalWait(ctx, tenantId, stepRunId)
+
+		if err != nil {
+			ec.l.Err(err).Msgf("could not claim signal wait for step run %s", stepRunId)
+			continue
+		}
+
+		// another event or the timeout claimed the wait first
+		if !claimed {
+			continue
+		}
+
+		err = ec.mq.AddMessage(
+			ctx,
+			msgqueue.JOB_PROCESSING_QUEUE,
+			taskStepRunSignalled(tenantId, wait, output),
+		)
+
+		if err != nil {
+			ec.l.Err(err).Msgf("could not add step run finished task for step run %s", stepRunId)
+		}
+	}
+
+	return nil
+}
+
+func taskStepRunSignalled(tenantId string, wait *dbsqlc.ListStepRunSignalWaitsForEventRow, output string) *msgqueue.Message {
+	payload, _ := datautils.ToJSONMap(tasktypes.StepRunFinishedTaskPayload{
+		WorkflowRunId:  sqlchelpers.UUIDToStr(wait.WorkflowRunId),
+		StepRunId:      sqlchelpers.UUIDToStr(wait.StepRunId),
+		FinishedAt:     time.Now().UTC().Format(time.RFC3339),
+		StepOutputData: output,
+	})
+
+	metadata, _ := datautils.ToJSONMap(tasktypes.StepRunFinishedTaskMetadata{
+		TenantId: tenantId,
+	})
+
+	return &msgqueue.Message{
+		ID:       "step-run-finished",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
@@ -0,0 +1,46 @@
+package jobs
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// defaultMaxBackoffSeconds caps retry delays for steps which don't set a max backoff.
+const defaultMaxBackoffSeconds = 24 * 60 * 60
+
+// getRetryBackoff returns the delay before the given retry of the step run, and false if the step doesn't
+// have retry backoff configured. If the step sets an initial backoff, the delay is
+// initial * factor^(retryCount - 1), otherwise it's factor^retryCount seconds. The delay is capped at the
+// max backoff, and the jitter fraction of the delay is randomized.
+func getRetryBackoff(stepRun *dbsqlc.GetStepRunForEngineRow, retryCount int) (time.Duration, bool) {
+	if !stepRun.StepRetryBackoffFactor.Valid {
+		return 0, false
+	}
+
+	backoffFactor := stepRun.StepRetryBackoffFactor.Float64
+
+	var backoffSeconds float64
+
+	if stepRun.StepRetryInitialBackoff.Valid {
+		backoffSeconds = float64(stepRun.StepRetryInitialBackoff.Int32) * math.Pow(backoffFactor, float64(retryCount-1))
+	} else {
+		backoffSeconds = math.Pow(backoffFactor, float64(retryCount))
+	}
+
+	maxBackoffSeconds := float64(defaultMaxBackoffSeconds)
+
+	if stepRun.StepRetryMaxBackoff.Valid {
+		maxBackoffSeconds = float64(stepRun.StepRetryMaxBackoff.Int32)
+	}
+
+	backoffSeconds = min(maxBackoffSeconds, backoffSeconds)
+
+	if stepRun.StepRetryJitter.Valid && stepRun.StepRetryJitter.Float64 > 0 {
+		backoffSeconds -= backoffSeconds * stepRun.StepRetryJitter.Float64 * rand.Float64() // nolint: gosec
+	}
+
+	return time.Duration(backoffSeconds*1000) * time.Millisecond, true
+}
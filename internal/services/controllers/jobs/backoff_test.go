@@ -0,0 +1,48 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func TestGetRetryBackoff(t *testing.T) {
+	stepRun := &dbsqlc.GetStepRunForEngineRow{}
+
+	_, ok := getRetryBackoff(stepRun, 1)
+	assert.False(t, ok)
+
+	// legacy backoff is factor^retryCount
+	stepRun.StepRetryBackoffFactor = pgtype.Float8{Float64: 2, Valid: true}
+	stepRun.StepRetryMaxBackoff = pgtype.Int4{Int32: 10, Valid: true}
+
+	dur, ok := getRetryBackoff(stepRun, 3)
+	assert.True(t, ok)
+	assert.Equal(t, 8*time.Second, dur)
+
+	dur, _ = getRetryBackoff(stepRun, 5)
+	assert.Equal(t, 10*time.Second, dur)
+
+	// initial backoff is multiplied by the factor for each subsequent retry
+	stepRun.StepRetryInitialBackoff = pgtype.Int4{Int32: 3, Valid: true}
+	stepRun.StepRetryMaxBackoff = pgtype.Int4{}
+
+	dur, _ = getRetryBackoff(stepRun, 1)
+	assert.Equal(t, 3*time.Second, dur)
+
+	dur, _ = getRetryBackoff(stepRun, 3)
+	assert.Equal(t, 12*time.Second, dur)
+
+	// jitter only shortens the delay, by at most the jitter fraction
+	stepRun.StepRetryJitter = pgtype.Float8{Float64: 0.5, Valid: true}
+
+	for i := 0; i < 100; i++ {
+		dur, _ = getRetryBackoff(stepRun, 3)
+		assert.LessOrEqual(t, dur, 12*time.Second)
+		assert.GreaterOrEqual(t, dur, 6*time.Second)
+	}
+}
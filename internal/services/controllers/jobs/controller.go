@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"math"
 	"sync"
 	"time"
 
@@ -17,6 +16,7 @@ import (
 	"github.com/hatchet-dev/hatchet/internal/cel"
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/datautils/merge"
+	"github.com/hatchet-dev/hatchet/internal/integrations/blobstore"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/queueutils"
 	"github.com/hatchet-dev/hatchet/internal/services/partition"
@@ -47,6 +47,7 @@ type JobsControllerImpl struct {
 	a              *hatcheterrors.Wrapped
 	p              *partition.Partition
 	celParser      *cel.CELParser
+	blobs          *blobstore.Offloader
 
 	reassignMutexes sync.Map
 }
@@ -62,6 +63,7 @@ type JobsControllerOpts struct {
 	p              *partition.Partition
 	queueLogger    *zerolog.Logger
 	pgxStatsLogger *zerolog.Logger
+	blobs          *blobstore.Offloader
 }
 
 func defaultJobsControllerOpts() *JobsControllerOpts {
@@ -130,6 +132,13 @@ func WithDataDecoderValidator(dv datautils.DataDecoderValidator) JobsControllerO
 	}
 }
 
+// WithBlobOffloader moves step run inputs which are larger than the configured threshold to a blob store.
+func WithBlobOffloader(blobs *blobstore.Offloader) JobsControllerOpt {
+	return func(opts *JobsControllerOpts) {
+		opts.blobs = blobs
+	}
+}
+
 func New(fs ...JobsControllerOpt) (*JobsControllerImpl, error) {
 	opts := defaultJobsControllerOpts()
 
@@ -172,6 +181,7 @@ func New(fs ...JobsControllerOpt) (*JobsControllerImpl, error) {
 		a:              a,
 		p:              opts.p,
 		celParser:      cel.NewCELParser(),
+		blobs:          opts.blobs,
 	}, nil
 }
 
@@ -206,6 +216,20 @@ func (jc *JobsControllerImpl) Start() (func() error, error) {
 		return nil, fmt.Errorf("could not schedule step run reassign: %w", err)
 	}
 
+	_, err = jc.s.NewJob(
+		gocron.OneTimeJob(
+			gocron.OneTimeJobStartDateTime(startedAt.Add(reassignGracePeriod)),
+		),
+		gocron.NewTask(
+			jc.runRecovery(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not schedule recovery: %w", err)
+	}
+
 	jc.s.Start()
 
 	f := func(task *msgqueue.Message) error {
@@ -228,7 +252,7 @@ func (jc *JobsControllerImpl) Start() (func() error, error) {
 		return nil, fmt.Errorf("could not subscribe to job processing queue: %w", err)
 	}
 
-	q, err := newQueue(jc.mq, jc.l, jc.queueLogger, jc.repo, jc.dv, jc.a, jc.p)
+	q, err := newQueue(jc.mq, jc.l, jc.queueLogger, jc.repo, jc.dv, jc.a, jc.p, jc.blobs)
 
 	if err != nil {
 		cancel()
@@ -450,13 +474,7 @@ func (ec *JobsControllerImpl) handleStepRunRetry(ctx context.Context, task *msgq
 	eventMessage := fmt.Sprintf("Retrying step run. This is retry %d / %d", retryCount, stepRun.StepRetries)
 	var retryAfter *time.Time
 
-	if stepRun.StepRetryMaxBackoff.Valid && stepRun.StepRetryBackoffFactor.Valid {
-		maxBackoffSeconds := int(stepRun.StepRetryMaxBackoff.Int32)
-		backoffFactor := stepRun.StepRetryBackoffFactor.Float64
-
-		// compute the backoff duration
-		durationMilliseconds := 1000 * min(float64(maxBackoffSeconds), math.Pow(backoffFactor, float64(retryCount)))
-		retryDur := time.Duration(int(durationMilliseconds)) * time.Millisecond
+	if retryDur, ok := getRetryBackoff(stepRun, retryCount); ok {
 		retryTime := time.Now().Add(retryDur)
 		retryAfter = &retryTime
 
@@ -633,7 +651,7 @@ func (jc *JobsControllerImpl) runPgStat() func() {
 func (jc *JobsControllerImpl) runStepRunReassign(ctx context.Context, startedAt time.Time) func() {
 	return func() {
 		// if we are within 15 seconds of the started time, then we should not reassign step runs
-		if time.Since(startedAt) < 15*time.Second {
+		if time.Since(startedAt) < reassignGracePeriod {
 			return
 		}
 
@@ -669,16 +687,18 @@ func (jc *JobsControllerImpl) runStepRunReassign(ctx context.Context, startedAt
 	}
 }
 
+// reassignBatchSize is the maximum number of step runs reassigned in a single statement.
+const reassignBatchSize = 1000
+
+// reassignGracePeriod is the time after startup during which step runs aren't reassigned, so that workers can send
+// heartbeats after they missed them while the engine was down.
+const reassignGracePeriod = 15 * time.Second
+
 // runStepRunReassignTenant looks for step runs that have been assigned to a worker but have not started,
-// or have been running but the worker has become inactive.
+// or have been running but the worker has become inactive or the step run has missed its heartbeats.
 func (ec *JobsControllerImpl) runStepRunReassignTenant(ctx context.Context, tenantId string) error {
 	// we want only one requeue running at a time for a tenant
-	if _, ok := ec.reassignMutexes.Load(tenantId); !ok {
-		ec.reassignMutexes.Store(tenantId, &sync.Mutex{})
-	}
-
-	muInt, _ := ec.reassignMutexes.Load(tenantId)
-	mu := muInt.(*sync.Mutex)
+	mu := ec.reassignMutex(tenantId)
 
 	if !mu.TryLock() {
 		return nil
@@ -689,31 +709,107 @@ func (ec *JobsControllerImpl) runStepRunReassignTenant(ctx context.Context, tena
 	ctx, span := telemetry.NewSpan(ctx, "handle-step-run-reassign")
 	defer span.End()
 
-	_, stepRunsToFail, err := ec.repo.StepRun().ListStepRunsToReassign(ctx, tenantId)
+	_, _, err := ec.reassignTenantStepRuns(ctx, tenantId)
+
+	return err
+}
+
+// reassignMutex returns the mutex which is held while the step runs of the tenant are reassigned.
+func (ec *JobsControllerImpl) reassignMutex(tenantId string) *sync.Mutex {
+	mu, _ := ec.reassignMutexes.LoadOrStore(tenantId, &sync.Mutex{})
+
+	return mu.(*sync.Mutex)
+}
+
+// reassignTenantStepRuns reassigns the step runs of the tenant on inactive workers and the step runs which have missed
+// their heartbeats, and returns the number of reassigned and failed step runs.
+func (ec *JobsControllerImpl) reassignTenantStepRuns(ctx context.Context, tenantId string) (int, int, error) {
+	reassigned, failed, err := ec.reassignStepRuns(
+		ctx,
+		tenantId,
+		ec.repo.StepRun().BulkReassignStepRuns,
+		"reassigned %d step runs from inactive workers",
+		"Worker has become inactive, and we exhausted all retries.",
+	)
 
 	if err != nil {
-		return fmt.Errorf("could not list step runs to reassign for tenant %s: %w", tenantId, err)
+		return reassigned, failed, err
 	}
 
-	return queueutils.BatchConcurrent(50, stepRunsToFail, func(stepRuns []*dbsqlc.GetStepRunForEngineRow) error {
-		var innerErr error
+	// step runs can be lost while their worker stays active, for example when the worker was partitioned from the
+	// engine after the step run started, so they are reassigned when they miss their heartbeats
+	lostReassigned, lostFailed, err := ec.reassignStepRuns(
+		ctx,
+		tenantId,
+		ec.repo.StepRun().ReassignLostStepRuns,
+		"reassigned %d step runs which missed their heartbeats",
+		"Step run has missed its heartbeats, and we exhausted all retries.",
+	)
 
-		for _, stepRun := range stepRuns {
-			err := ec.failStepRun(
-				ctx,
-				tenantId,
-				sqlchelpers.UUIDToStr(stepRun.SRID),
-				"Worker has become inactive, and we exhausted all retries.",
-				time.Now(),
-			)
+	return reassigned + lostReassigned, failed + lostFailed, err
+}
 
-			if err != nil {
-				innerErr = multierror.Append(innerErr, err)
+// reassignStepRuns calls reassign until all step runs have been reassigned, and fails the step runs which have
+// exhausted their internal retries. It returns the number of reassigned and failed step runs.
+func (ec *JobsControllerImpl) reassignStepRuns(
+	ctx context.Context,
+	tenantId string,
+	reassign func(ctx context.Context, tenantId string, opts *repository.BulkReassignStepRunsOpts) (*repository.BulkReassignStepRunsResult, error),
+	reassignedMsg, failedReason string,
+) (int, int, error) {
+	var reassigned, failed int
+
+	// reassign in batches, so that a mass worker failure doesn't result in a single unbounded statement
+	limit := reassignBatchSize
+	var cursor *string
+
+	for {
+		res, err := reassign(ctx, tenantId, &repository.BulkReassignStepRunsOpts{
+			Limit:  &limit,
+			Cursor: cursor,
+		})
+
+		if err != nil {
+			return reassigned, failed, fmt.Errorf("could not reassign step runs for tenant %s: %w", tenantId, err)
+		}
+
+		if num := len(res.ReassignedStepRunIds); num > 0 {
+			ec.l.Info().Msgf(reassignedMsg, num)
+		}
+
+		reassigned += len(res.ReassignedStepRunIds)
+		failed += len(res.FailedStepRuns)
+
+		err = queueutils.BatchConcurrent(50, res.FailedStepRuns, func(stepRuns []*dbsqlc.GetStepRunForEngineRow) error {
+			var innerErr error
+
+			for _, stepRun := range stepRuns {
+				err := ec.failStepRun(
+					ctx,
+					tenantId,
+					sqlchelpers.UUIDToStr(stepRun.SRID),
+					failedReason,
+					time.Now(),
+				)
+
+				if err != nil {
+					innerErr = multierror.Append(innerErr, err)
+				}
 			}
+
+			return innerErr
+		})
+
+		if err != nil {
+			return reassigned, failed, err
 		}
 
-		return innerErr
-	})
+		if res.NextCursor == nil || ctx.Err() != nil {
+			return reassigned, failed, nil
+		}
+
+		cursor = res.NextCursor
+	}
 }
 
 func (ec *JobsControllerImpl) queueStepRun(ctx context.Context, tenantId, stepId, stepRunId string, isRetry bool) error {
@@ -787,7 +883,80 @@ func (ec *JobsControllerImpl) queueStepRun(ctx context.Context, tenantId, stepId
 				return ec.a.WrapErr(fmt.Errorf("could not convert input data to json: %w", err), errData)
 			}
 
-			queueOpts.Input = inputDataBytes
+			// large inputs are moved to the blob store, and only a reference is persisted
+			queueOpts.Input, err = ec.blobs.OffloadInput(ctx, tenantId, inputDataBytes)
+
+			if err != nil {
+				return ec.a.WrapErr(fmt.Errorf("could not offload input data: %w", err), errData)
+			}
+		}
+	}
+
+	// conditions and expressions are evaluated against the full input, so any payloads in the blob store are
+	// fetched, including parent outputs which were moved to the blob store
+	inputDataBytes, err = ec.blobs.Resolve(ctx, inputDataBytes)
+
+	if err != nil {
+		return ec.a.WrapErr(fmt.Errorf("could not resolve input data: %w", err), errData)
+	}
+
+	// the elements of a map step run share the step of the map step run, but they are queued like regular steps
+	isMapItem := false
+
+	if stepRun.StepMapOver.Valid {
+		isMapItem, err = ec.repo.Map().IsMapItem(ctx, tenantId, stepRunId)
+
+		if err != nil {
+			return ec.a.WrapErr(fmt.Errorf("could not check map item: %w", err), errData)
+		}
+	}
+
+	// conditional steps are skipped instead of queued when their condition evaluates to false. retries and replays
+	// of a step run always run the step.
+	if stepRun.StepCondition.Valid && !isRetry && !isMapItem {
+		ok, err := ec.evalStepRunCondition(stepRun, data, inputDataBytes)
+
+		if err != nil {
+			return ec.failStepRun(ctx, tenantId, stepRunId, fmt.Sprintf("Could not evaluate step condition: %s", err.Error()), time.Now())
+		}
+
+		if !ok {
+			return ec.skipStepRun(ctx, tenantId, stepRunId, fmt.Sprintf("condition %s evaluated to false", stepRun.StepCondition.String))
+		}
+	}
+
+	// map steps don't run on a worker, they fan out into a step run for each element of a list
+	if stepRun.StepMapOver.Valid && !isMapItem {
+		return ec.mapStepRun(ctx, stepRun, data, inputDataBytes)
+	}
+
+	// sleep steps don't run on a worker, so we start a timer instead of queueing them
+	if stepRun.StepSleepFor.Valid || stepRun.StepSleepUntil.Valid {
+		return ec.sleepStepRun(ctx, stepRun, data, inputDataBytes)
+	}
+
+	// wait-for-event steps don't run on a worker either, so we register a signal wait which is resumed by the
+	// events controller
+	if stepRun.StepWaitForEvent.Valid {
+		return ec.waitForEventStepRun(ctx, stepRun, data, inputDataBytes)
+	}
+
+	// approval steps wait until they are decided through the API
+	if stepRun.ActionId == repository.ApprovalStepAction {
+		return ec.approvalStepRun(ctx, stepRun)
+	}
+
+	// cached steps reuse the output of a previous step run with the same input instead of running. retries and
+	// replays of a step run always run the step.
+	if stepRun.StepCacheTtl.Valid && !isRetry {
+		restored, err := ec.restoreCachedStepRun(ctx, stepRun, inputDataBytes)
+
+		if err != nil {
+			return ec.a.WrapErr(err, errData)
+		}
+
+		if restored {
+			return nil
 		}
 	}
 
@@ -867,177 +1036,873 @@ func (ec *JobsControllerImpl) queueStepRun(ctx context.Context, tenantId, stepId
 	return nil
 }
 
-func (ec *JobsControllerImpl) checkTenantQueue(ctx context.Context, tenantId, queueName string, isStepQueued bool, isSlotReleased bool) {
-	// send a message to the tenant partition queue that a step run is ready to be scheduled
-	tenant, err := ec.repo.Tenant().GetTenantByID(ctx, tenantId)
-
-	if err != nil {
-		ec.l.Err(err).Msg("could not add message to tenant partition queue")
-		return
-	}
+// evalStepRunCondition evaluates the condition of a step run against the input and the outputs of the parent
+// step runs.
+func (ec *JobsControllerImpl) evalStepRunCondition(stepRun *dbsqlc.GetStepRunForEngineRow, data *dbsqlc.GetStepRunDataForEngineRow, inputDataBytes []byte) (bool, error) {
+	additionalMeta := map[string]interface{}{}
 
-	if tenant.ControllerPartitionId.Valid {
-		err = ec.mq.AddMessage(
-			ctx,
-			msgqueue.QueueTypeFromPartitionIDAndController(tenant.ControllerPartitionId.String, msgqueue.JobController),
-			tasktypes.CheckTenantQueueToTask(tenantId, queueName, isStepQueued, isSlotReleased),
-		)
+	if data.AdditionalMetadata != nil {
+		err := json.Unmarshal(data.AdditionalMetadata, &additionalMeta)
 
 		if err != nil {
-			ec.l.Err(err).Msg("could not add message to controller partition queue")
+			return false, fmt.Errorf("could not unmarshal additional metadata: %w", err)
 		}
 	}
 
-	if tenant.SchedulerPartitionId.Valid {
-		err = ec.mq.AddMessage(
-			ctx,
-			msgqueue.QueueTypeFromPartitionIDAndController(tenant.SchedulerPartitionId.String, msgqueue.Scheduler),
-			tasktypes.CheckTenantQueueToTask(tenantId, queueName, isStepQueued, isSlotReleased),
-		)
+	parsedInputData := datautils.StepRunData{}
 
-		if err != nil {
-			ec.l.Err(err).Msg("could not add message to scheduler partition queue")
-		}
+	err := json.Unmarshal(inputDataBytes, &parsedInputData)
+
+	if err != nil {
+		return false, fmt.Errorf("could not unmarshal input data: %w", err)
 	}
-}
 
-func (ec *JobsControllerImpl) handleStepRunStarted(ctx context.Context, task *msgqueue.Message) error {
-	ctx, span := telemetry.NewSpanWithCarrier(ctx, "handle-step-run-started", task.OtelCarrier)
-	defer span.End()
+	// parents which have been skipped have no output, so we always pass a map for the parents
+	parents := parsedInputData.Parents
 
-	payload := tasktypes.StepRunStartedTaskPayload{}
-	metadata := tasktypes.StepRunStartedTaskMetadata{}
+	if parents == nil {
+		parents = map[string]map[string]interface{}{}
+	}
 
-	err := ec.dv.DecodeAndValidate(task.Payload, &payload)
+	return ec.celParser.ParseAndEvalStepRunCondition(stepRun.StepCondition.String, cel.NewInput(
+		cel.WithAdditionalMetadata(additionalMeta),
+		cel.WithInput(parsedInputData.Input),
+		cel.WithParents(parents),
+		cel.WithWorkflowRunID(sqlchelpers.UUIDToStr(stepRun.WorkflowRunId)),
+	))
+}
+
+// skipStepRun marks a step run as skipped and starts its child step runs. Skips propagate transitively: child step
+// runs whose parents have all been skipped are skipped as well, while child step runs with at least one parent which
+// succeeded are queued as usual.
+func (ec *JobsControllerImpl) skipStepRun(ctx context.Context, tenantId, stepRunId, reason string) error {
+	skipped, err := ec.repo.StepRun().StepRunSkipped(ctx, tenantId, stepRunId, time.Now().UTC())
 
 	if err != nil {
-		return fmt.Errorf("could not decode step run started task payload: %w", err)
+		return fmt.Errorf("could not skip step run: %w", err)
 	}
 
-	err = ec.dv.DecodeAndValidate(task.Metadata, &metadata)
+	if !skipped {
+		ec.l.Debug().Msgf("step run %s is no longer pending, not skipping", stepRunId)
+		return nil
+	}
+
+	defer ec.repo.StepRun().DeferredStepRunEvent(tenantId, repository.CreateStepRunEventOpts{
+		StepRunId:     stepRunId,
+		EventReason:   repository.StepRunEventReasonPtr(dbsqlc.StepRunEventReasonFINISHED),
+		EventMessage:  repository.StringPtr(fmt.Sprintf("Step run was skipped: %s", reason)),
+		EventSeverity: repository.StepRunEventSeverityPtr(dbsqlc.StepRunEventSeverityINFO),
+	})
+
+	// children with a single parent and children with many parents are listed separately
+	singleParentStepRuns, err := ec.repo.StepRun().ListStartableStepRuns(ctx, tenantId, stepRunId, true)
 
 	if err != nil {
-		return fmt.Errorf("could not decode step run started task metadata: %w", err)
+		return fmt.Errorf("could not list startable step runs: %w", err)
 	}
 
-	// update the step run in the database
-	startedAt, err := time.Parse(time.RFC3339, payload.StartedAt)
+	manyParentStepRuns, err := ec.repo.StepRun().ListStartableStepRuns(ctx, tenantId, stepRunId, false)
 
 	if err != nil {
-		return fmt.Errorf("could not parse started at: %w", err)
+		return fmt.Errorf("could not list startable step runs: %w", err)
 	}
 
-	err = ec.repo.StepRun().StepRunStarted(ctx, metadata.TenantId, payload.WorkflowRunId, payload.StepRunId, startedAt)
+	for _, nextStepRun := range append(singleParentStepRuns, manyParentStepRuns...) {
+		nextStepRunId := sqlchelpers.UUIDToStr(nextStepRun.SRID)
 
-	if err != nil {
-		return fmt.Errorf("could not update step run: %w", err)
+		onlySkippedParents, err := ec.repo.StepRun().HasOnlySkippedParents(ctx, nextStepRunId)
+
+		if err != nil {
+			return fmt.Errorf("could not check parents of step run %s: %w", nextStepRunId, err)
+		}
+
+		if onlySkippedParents {
+			err = ec.skipStepRun(ctx, tenantId, nextStepRunId, "all parent steps were skipped")
+
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		err = ec.mq.AddMessage(
+			context.Background(),
+			msgqueue.JOB_PROCESSING_QUEUE,
+			tasktypes.StepRunQueuedToTask(nextStepRun),
+		)
+
+		if err != nil {
+			ec.l.Error().Err(err).Msg("could not queue next step run")
+		}
 	}
 
 	return nil
 }
 
-func (ec *JobsControllerImpl) handleStepRunAcked(ctx context.Context, task *msgqueue.Message) error {
-	ctx, span := telemetry.NewSpanWithCarrier(ctx, "handle-step-run-acked", task.OtelCarrier)
-	defer span.End()
+// sleepStepRun starts the timer of a sleep step run. The step run is marked as running until the ticker wakes
+// it up, without occupying a worker slot.
+func (ec *JobsControllerImpl) sleepStepRun(ctx context.Context, stepRun *dbsqlc.GetStepRunForEngineRow, data *dbsqlc.GetStepRunDataForEngineRow, inputDataBytes []byte) error {
+	tenantId := sqlchelpers.UUIDToStr(stepRun.SRTenantId)
+	stepRunId := sqlchelpers.UUIDToStr(stepRun.SRID)
+	now := time.Now().UTC()
 
-	payload := tasktypes.StepRunStartedTaskPayload{}
-	metadata := tasktypes.StepRunStartedTaskMetadata{}
+	var wakeAt time.Time
 
-	err := ec.dv.DecodeAndValidate(task.Payload, &payload)
+	if stepRun.StepSleepFor.Valid {
+		sleepFor, err := time.ParseDuration(stepRun.StepSleepFor.String)
 
-	if err != nil {
-		return fmt.Errorf("could not decode step run started task payload: %w", err)
-	}
+		if err != nil {
+			return ec.failStepRun(ctx, tenantId, stepRunId, fmt.Sprintf("Could not parse sleep duration: %s", err.Error()), now)
+		}
 
-	err = ec.dv.DecodeAndValidate(task.Metadata, &metadata)
+		wakeAt = now.Add(sleepFor)
+	} else {
+		additionalMeta := map[string]interface{}{}
 
-	if err != nil {
-		return fmt.Errorf("could not decode step run started task metadata: %w", err)
-	}
+		if data.AdditionalMetadata != nil {
+			err := json.Unmarshal(data.AdditionalMetadata, &additionalMeta)
 
-	// update the step run in the database
-	startedAt, err := time.Parse(time.RFC3339, payload.StartedAt)
+			if err != nil {
+				return fmt.Errorf("could not unmarshal additional metadata: %w", err)
+			}
+		}
 
-	if err != nil {
-		return fmt.Errorf("could not parse started at: %w", err)
-	}
+		parsedInputData := datautils.StepRunData{}
 
-	err = ec.repo.StepRun().StepRunAcked(ctx, metadata.TenantId, payload.WorkflowRunId, payload.StepRunId, startedAt)
+		err := json.Unmarshal(inputDataBytes, &parsedInputData)
 
-	if err != nil {
-		return fmt.Errorf("could not update step run: %w", err)
-	}
+		if err != nil {
+			return fmt.Errorf("could not unmarshal input data: %w", err)
+		}
 
-	return nil
-}
+		input := cel.NewInput(
+			cel.WithAdditionalMetadata(additionalMeta),
+			cel.WithInput(parsedInputData.Input),
+			cel.WithParents(parsedInputData.Parents),
+		)
 
-func (ec *JobsControllerImpl) handleStepRunFinished(ctx context.Context, task *msgqueue.Message) error {
-	ctx, span := telemetry.NewSpanWithCarrier(ctx, "handle-step-run-finished", task.OtelCarrier)
-	defer span.End()
+		res, err := ec.celParser.ParseAndEvalStepRun(stepRun.StepSleepUntil.String, input)
 
-	payload := tasktypes.StepRunFinishedTaskPayload{}
-	metadata := tasktypes.StepRunFinishedTaskMetadata{}
+		if err != nil {
+			return ec.failStepRun(ctx, tenantId, stepRunId, fmt.Sprintf("Could not parse sleep until expression: %s", err.Error()), now)
+		}
 
-	err := ec.dv.DecodeAndValidate(task.Payload, &payload)
+		if res.String == nil {
+			return ec.failStepRun(ctx, tenantId, stepRunId, "Sleep until expression must return an RFC3339 timestamp string", now)
+		}
 
-	if err != nil {
-		return fmt.Errorf("could not decode step run finished task payload: %w", err)
+		wakeAt, err = time.Parse(time.RFC3339, *res.String)
+
+		if err != nil {
+			return ec.failStepRun(ctx, tenantId, stepRunId, fmt.Sprintf("Could not parse sleep until timestamp: %s", err.Error()), now)
+		}
+
+		// timestamps in the past wake up the step run immediately
+		if wakeAt.Before(now) {
+			wakeAt = now
+		}
 	}
 
-	err = ec.dv.DecodeAndValidate(task.Metadata, &metadata)
+	err := ec.repo.StepRun().QueueWorkerlessStepRun(ctx, tenantId, stepRunId)
 
 	if err != nil {
-		return fmt.Errorf("could not decode step run finished task metadata: %w", err)
+		return fmt.Errorf("could not queue sleep step run: %w", err)
 	}
 
-	// update the step run in the database
-	finishedAt, err := time.Parse(time.RFC3339, payload.FinishedAt)
+	err = ec.repo.StepRun().StepRunSleeping(ctx, tenantId, sqlchelpers.UUIDToStr(stepRun.WorkflowRunId), stepRunId, now, wakeAt)
 
 	if err != nil {
-		return fmt.Errorf("could not parse started at: %w", err)
+		return fmt.Errorf("could not start sleep step run: %w", err)
 	}
 
-	var stepOutput []byte
+	defer ec.repo.StepRun().DeferredStepRunEvent(tenantId, repository.CreateStepRunEventOpts{
+		StepRunId:     stepRunId,
+		EventReason:   repository.StepRunEventReasonPtr(dbsqlc.StepRunEventReasonSTARTED),
+		EventMessage:  repository.StringPtr(fmt.Sprintf("Step run sleeping until %s", wakeAt.Format(time.RFC1123))),
+		EventSeverity: repository.StepRunEventSeverityPtr(dbsqlc.StepRunEventSeverityINFO),
+	})
 
-	if payload.StepOutputData != "" {
-		stepOutput = []byte(payload.StepOutputData)
-	}
+	return nil
+}
 
-	err = ec.repo.StepRun().StepRunSucceeded(ctx, metadata.TenantId, payload.WorkflowRunId, payload.StepRunId, finishedAt, stepOutput)
+// waitForEventStepRun registers a wait-for-event step run as waiting for its event. The step run is marked as
+// running until a matching event is pushed. If the step has a timeout, a timer fails the step run once the timeout
+// is reached.
+func (ec *JobsControllerImpl) waitForEventStepRun(ctx context.Context, stepRun *dbsqlc.GetStepRunForEngineRow, data *dbsqlc.GetStepRunDataForEngineRow, inputDataBytes []byte) error {
+	tenantId := sqlchelpers.UUIDToStr(stepRun.SRTenantId)
+	stepRunId := sqlchelpers.UUIDToStr(stepRun.SRID)
+	workflowRunId := sqlchelpers.UUIDToStr(stepRun.WorkflowRunId)
+	now := time.Now().UTC()
 
-	if err != nil {
-		return fmt.Errorf("could not update step run: %w", err)
+	waitOpts := &repository.CreateSignalWaitOpts{
+		EventKey: stepRun.StepWaitForEvent.String,
 	}
 
-	nextStepRuns, err := ec.repo.StepRun().ListStartableStepRuns(ctx, metadata.TenantId, payload.StepRunId, true)
+	if stepRun.StepWaitForEventCorrelation.Valid {
+		additionalMeta := map[string]interface{}{}
 
-	if err != nil {
-		ec.l.Error().Err(err).Msg("could not list startable step runs")
-	} else {
-		for _, nextStepRun := range nextStepRuns {
-			err := ec.mq.AddMessage(
-				context.Background(),
-				msgqueue.JOB_PROCESSING_QUEUE,
-				tasktypes.StepRunQueuedToTask(nextStepRun),
-			)
+		if data.AdditionalMetadata != nil {
+			err := json.Unmarshal(data.AdditionalMetadata, &additionalMeta)
 
 			if err != nil {
-				ec.l.Error().Err(err).Msg("could not queue next step run")
+				return fmt.Errorf("could not unmarshal additional metadata: %w", err)
 			}
 		}
-	}
 
-	// recheck the tenant queue
-	sr, err := ec.repo.StepRun().GetStepRunForEngine(ctx, metadata.TenantId, payload.StepRunId)
+		parsedInputData := datautils.StepRunData{}
 
-	if err != nil {
-		return fmt.Errorf("could not get step run: %w", err)
-	}
+		err := json.Unmarshal(inputDataBytes, &parsedInputData)
 
-	ec.checkTenantQueue(ctx, metadata.TenantId, sr.SRQueue, false, true)
+		if err != nil {
+			return fmt.Errorf("could not unmarshal input data: %w", err)
+		}
 
-	return nil
-}
+		correlationValue, err := ec.celParser.ParseAndEvalWorkflowString(stepRun.StepWaitForEventCorrelation.String, cel.NewInput(
+			cel.WithAdditionalMetadata(additionalMeta),
+			cel.WithInput(parsedInputData.Input),
+		))
+
+		if err != nil {
+			return ec.failStepRun(ctx, tenantId, stepRunId, fmt.Sprintf("Could not evaluate event correlation expression: %s", err.Error()), now)
+		}
+
+		waitOpts.CorrelationExpr = &stepRun.StepWaitForEventCorrelation.String
+		waitOpts.CorrelationValue = &correlationValue
+	}
+
+	err := ec.repo.Signal().CreateSignalWait(ctx, tenantId, stepRunId, waitOpts)
+
+	if err != nil {
+		return fmt.Errorf("could not create signal wait: %w", err)
+	}
+
+	err = ec.repo.StepRun().QueueWorkerlessStepRun(ctx, tenantId, stepRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not queue wait-for-event step run: %w", err)
+	}
+
+	if stepRun.StepTimeout.Valid && stepRun.StepTimeout.String != "" {
+		timeout, err := time.ParseDuration(stepRun.StepTimeout.String)
+
+		if err != nil {
+			return ec.failStepRun(ctx, tenantId, stepRunId, fmt.Sprintf("Could not parse step timeout: %s", err.Error()), now)
+		}
+
+		err = ec.repo.StepRun().StepRunSleeping(ctx, tenantId, workflowRunId, stepRunId, now, now.Add(timeout))
+
+		if err != nil {
+			return fmt.Errorf("could not start wait-for-event step run: %w", err)
+		}
+	} else {
+		err = ec.repo.StepRun().StepRunStarted(ctx, tenantId, workflowRunId, stepRunId, now)
+
+		if err != nil {
+			return fmt.Errorf("could not start wait-for-event step run: %w", err)
+		}
+	}
+
+	defer ec.repo.StepRun().DeferredStepRunEvent(tenantId, repository.CreateStepRunEventOpts{
+		StepRunId:     stepRunId,
+		EventReason:   repository.StepRunEventReasonPtr(dbsqlc.StepRunEventReasonSTARTED),
+		EventMessage:  repository.StringPtr(fmt.Sprintf("Step run waiting for event %s", waitOpts.EventKey)),
+		EventSeverity: repository.StepRunEventSeverityPtr(dbsqlc.StepRunEventSeverityINFO),
+	})
+
+	return nil
+}
+
+// approvalStepRun creates the pending approval of an approval step run. The step run is marked as running until
+// the approval is decided. If the step has a timeout, a timer fails the step run once the timeout is reached.
+func (ec *JobsControllerImpl) approvalStepRun(ctx context.Context, stepRun *dbsqlc.GetStepRunForEngineRow) error {
+	tenantId := sqlchelpers.UUIDToStr(stepRun.SRTenantId)
+	stepRunId := sqlchelpers.UUIDToStr(stepRun.SRID)
+	workflowRunId := sqlchelpers.UUIDToStr(stepRun.WorkflowRunId)
+	now := time.Now().UTC()
+
+	var timeout time.Duration
+
+	if stepRun.StepTimeout.Valid && stepRun.StepTimeout.String != "" {
+		var err error
+
+		timeout, err = time.ParseDuration(stepRun.StepTimeout.String)
+
+		if err != nil {
+			return ec.failStepRun(ctx, tenantId, stepRunId, fmt.Sprintf("Could not parse step timeout: %s", err.Error()), now)
+		}
+	}
+
+	approval, err := ec.repo.Approval().CreateApproval(ctx, tenantId, workflowRunId, stepRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not create approval: %w", err)
+	}
+
+	err = ec.repo.StepRun().QueueWorkerlessStepRun(ctx, tenantId, stepRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not queue approval step run: %w", err)
+	}
+
+	if timeout > 0 {
+		err = ec.repo.StepRun().StepRunSleeping(ctx, tenantId, workflowRunId, stepRunId, now, now.Add(timeout))
+	} else {
+		err = ec.repo.StepRun().StepRunStarted(ctx, tenantId, workflowRunId, stepRunId, now)
+	}
+
+	if err != nil {
+		return fmt.Errorf("could not start approval step run: %w", err)
+	}
+
+	defer ec.repo.StepRun().DeferredStepRunEvent(tenantId, repository.CreateStepRunEventOpts{
+		StepRunId:     stepRunId,
+		EventReason:   repository.StepRunEventReasonPtr(dbsqlc.StepRunEventReasonSTARTED),
+		EventMessage:  repository.StringPtr(fmt.Sprintf("Step run waiting for approval %s", sqlchelpers.UUIDToStr(approval.ID))),
+		EventSeverity: repository.StepRunEventSeverityPtr(dbsqlc.StepRunEventSeverityINFO),
+	})
+
+	return nil
+}
+
+// restoreCachedStepRun finishes a step run with the cached output of a previous step run of the same action with
+// the same input. It returns false if there is no cached output.
+func (ec *JobsControllerImpl) restoreCachedStepRun(ctx context.Context, stepRun *dbsqlc.GetStepRunForEngineRow, inputDataBytes []byte) (bool, error) {
+	tenantId := sqlchelpers.UUIDToStr(stepRun.SRTenantId)
+	stepRunId := sqlchelpers.UUIDToStr(stepRun.SRID)
+	now := time.Now().UTC()
+
+	inputHash, err := datautils.HashStepRunInput(inputDataBytes)
+
+	if err != nil {
+		return false, fmt.Errorf("could not hash step run input: %w", err)
+	}
+
+	output, err := ec.repo.StepRunCache().GetCachedOutput(ctx, tenantId, stepRun.ActionId, inputHash)
+
+	if err != nil {
+		return false, err
+	}
+
+	if output == nil {
+		return false, nil
+	}
+
+	err = ec.repo.StepRun().QueueWorkerlessStepRun(ctx, tenantId, stepRunId)
+
+	if err != nil {
+		return false, fmt.Errorf("could not queue cached step run: %w", err)
+	}
+
+	err = ec.repo.StepRun().StepRunStarted(ctx, tenantId, sqlchelpers.UUIDToStr(stepRun.WorkflowRunId), stepRunId, now)
+
+	if err != nil {
+		return false, fmt.Errorf("could not start cached step run: %w", err)
+	}
+
+	defer ec.repo.StepRun().DeferredStepRunEvent(tenantId, repository.CreateStepRunEventOpts{
+		StepRunId:     stepRunId,
+		EventReason:   repository.StepRunEventReasonPtr(dbsqlc.StepRunEventReasonFINISHED),
+		EventMessage:  repository.StringPtr("Step run output restored from cache"),
+		EventSeverity: repository.StepRunEventSeverityPtr(dbsqlc.StepRunEventSeverityINFO),
+		EventData:     map[string]interface{}{"input_hash": inputHash},
+	})
+
+	err = ec.mq.AddMessage(
+		ctx,
+		msgqueue.JOB_PROCESSING_QUEUE,
+		tasktypes.StepRunFinishedToTask(stepRun, output, &now),
+	)
+
+	if err != nil {
+		return false, fmt.Errorf("could not add cached step run finished task to job processing queue: %w", err)
+	}
+
+	return true, nil
+}
+
+// cacheStepRunOutput caches the output of a step run which succeeded, keyed by its action and the hash of its
+// input.
+func (ec *JobsControllerImpl) cacheStepRunOutput(ctx context.Context, tenantId string, stepRun *dbsqlc.GetStepRunForEngineRow, output []byte) error {
+	ttl, err := time.ParseDuration(stepRun.StepCacheTtl.String)
+
+	if err != nil {
+		return fmt.Errorf("could not parse step cache ttl: %w", err)
+	}
+
+	stepRunId := sqlchelpers.UUIDToStr(stepRun.SRID)
+
+	data, err := ec.repo.StepRun().GetStepRunDataForEngine(ctx, tenantId, stepRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not get step run data: %w", err)
+	}
+
+	// step runs which were restored from the cache are finished without storing their input
+	if len(data.Input) == 0 || string(data.Input) == "{}" || len(output) == 0 {
+		return nil
+	}
+
+	inputHash, err := datautils.HashStepRunInput(data.Input)
+
+	if err != nil {
+		return fmt.Errorf("could not hash step run input: %w", err)
+	}
+
+	return ec.repo.StepRunCache().CacheOutput(ctx, tenantId, &repository.CacheStepRunOutputOpts{
+		ActionId:  stepRun.ActionId,
+		InputHash: inputHash,
+		StepRunId: stepRunId,
+		Output:    output,
+		TTL:       ttl,
+	})
+}
+
+// maxMapItems is the maximum number of elements which a map step run can fan out into
+const maxMapItems = 1000
+
+// mapStepRun fans a map step run out into a step run for each element of the list which its expression evaluates
+// to. The map step run is marked as running until all elements have succeeded, and its output is the list of the
+// outputs of the elements.
+func (ec *JobsControllerImpl) mapStepRun(ctx context.Context, stepRun *dbsqlc.GetStepRunForEngineRow, data *dbsqlc.GetStepRunDataForEngineRow, inputDataBytes []byte) error {
+	tenantId := sqlchelpers.UUIDToStr(stepRun.SRTenantId)
+	stepRunId := sqlchelpers.UUIDToStr(stepRun.SRID)
+	workflowRunId := sqlchelpers.UUIDToStr(stepRun.WorkflowRunId)
+	now := time.Now().UTC()
+
+	additionalMeta := map[string]interface{}{}
+
+	if data.AdditionalMetadata != nil {
+		err := json.Unmarshal(data.AdditionalMetadata, &additionalMeta)
+
+		if err != nil {
+			return fmt.Errorf("could not unmarshal additional metadata: %w", err)
+		}
+	}
+
+	parsedInputData := datautils.StepRunData{}
+
+	err := json.Unmarshal(inputDataBytes, &parsedInputData)
+
+	if err != nil {
+		return fmt.Errorf("could not unmarshal input data: %w", err)
+	}
+
+	parents := parsedInputData.Parents
+
+	if parents == nil {
+		parents = map[string]map[string]interface{}{}
+	}
+
+	items, err := ec.celParser.ParseAndEvalStepRunList(stepRun.StepMapOver.String, cel.NewInput(
+		cel.WithAdditionalMetadata(additionalMeta),
+		cel.WithInput(parsedInputData.Input),
+		cel.WithParents(parents),
+		cel.WithWorkflowRunID(workflowRunId),
+	))
+
+	if err != nil {
+		return ec.failStepRun(ctx, tenantId, stepRunId, fmt.Sprintf("Could not evaluate map expression: %s", err.Error()), now)
+	}
+
+	if len(items) > maxMapItems {
+		return ec.failStepRun(ctx, tenantId, stepRunId, fmt.Sprintf("Map expression evaluated to %d elements, the maximum is %d", len(items), maxMapItems), now)
+	}
+
+	// each element gets the input of the map step run, along with the element and its index
+	inputs := make([][]byte, len(items))
+
+	for i, item := range items {
+		index := i
+		itemData := parsedInputData
+		itemData.MapItem = item
+		itemData.MapIndex = &index
+
+		inputs[i], err = json.Marshal(itemData)
+
+		if err != nil {
+			return fmt.Errorf("could not convert map item input to json: %w", err)
+		}
+	}
+
+	err = ec.repo.StepRun().QueueWorkerlessStepRun(ctx, tenantId, stepRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not queue map step run: %w", err)
+	}
+
+	err = ec.repo.StepRun().StepRunStarted(ctx, tenantId, workflowRunId, stepRunId, now)
+
+	if err != nil {
+		return fmt.Errorf("could not start map step run: %w", err)
+	}
+
+	// an empty list finishes the map step run right away
+	if len(items) == 0 {
+		return ec.mq.AddMessage(
+			ctx,
+			msgqueue.JOB_PROCESSING_QUEUE,
+			tasktypes.StepRunFinishedToTask(stepRun, []byte(`{"results":[]}`), &now),
+		)
+	}
+
+	concurrency := 0
+
+	if stepRun.StepMapConcurrency.Valid {
+		concurrency = int(stepRun.StepMapConcurrency.Int32)
+	}
+
+	queued, err := ec.repo.Map().CreateMapItems(ctx, tenantId, stepRunId, &repository.CreateMapItemsOpts{
+		Inputs:      inputs,
+		Concurrency: concurrency,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not create map items: %w", err)
+	}
+
+	defer ec.repo.StepRun().DeferredStepRunEvent(tenantId, repository.CreateStepRunEventOpts{
+		StepRunId:     stepRunId,
+		EventReason:   repository.StepRunEventReasonPtr(dbsqlc.StepRunEventReasonSTARTED),
+		EventMessage:  repository.StringPtr(fmt.Sprintf("Step run mapping over %d elements", len(items))),
+		EventSeverity: repository.StepRunEventSeverityPtr(dbsqlc.StepRunEventSeverityINFO),
+	})
+
+	ec.queueMapItems(queued)
+
+	return nil
+}
+
+// finishMapItem records the output of a step run which is an element of a map step run. It finishes the map step
+// run once all elements have succeeded, and otherwise queues the next pending elements.
+func (ec *JobsControllerImpl) finishMapItem(ctx context.Context, tenantId string, stepRun *dbsqlc.GetStepRunForEngineRow, output []byte) error {
+	concurrency := 0
+
+	if stepRun.StepMapConcurrency.Valid {
+		concurrency = int(stepRun.StepMapConcurrency.Int32)
+	}
+
+	res, err := ec.repo.Map().FinishMapItem(ctx, tenantId, sqlchelpers.UUIDToStr(stepRun.SRID), output, concurrency)
+
+	if err != nil {
+		return fmt.Errorf("could not finish map item: %w", err)
+	}
+
+	// the step run is the map step run itself, or an element which was already finished
+	if res == nil {
+		return nil
+	}
+
+	if res.Output == nil {
+		ec.queueMapItems(res.QueuedStepRuns)
+		return nil
+	}
+
+	mapStepRun, err := ec.repo.StepRun().GetStepRunForEngine(ctx, tenantId, res.MapStepRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not get map step run: %w", err)
+	}
+
+	now := time.Now().UTC()
+
+	return ec.mq.AddMessage(
+		ctx,
+		msgqueue.JOB_PROCESSING_QUEUE,
+		tasktypes.StepRunFinishedToTask(mapStepRun, res.Output, &now),
+	)
+}
+
+// failMapItem fails the map step run which a failed step run belongs to, and cancels the elements which have not
+// finished yet. The map step run is not retried, as its elements have already used up their own retries.
+func (ec *JobsControllerImpl) failMapItem(ctx context.Context, tenantId, stepRunId string, failedAt time.Time) error {
+	res, err := ec.repo.Map().FailMapItem(ctx, tenantId, stepRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not fail map item: %w", err)
+	}
+
+	if res == nil {
+		return nil
+	}
+
+	for _, unfinishedStepRunId := range res.UnfinishedStepRunIds {
+		err = ec.cancelStepRun(ctx, tenantId, unfinishedStepRunId, "MAP_ITEM_FAILED", false)
+
+		if err != nil {
+			ec.l.Error().Err(err).Msgf("could not cancel map item %s", unfinishedStepRunId)
+		}
+	}
+
+	mapStepRun, err := ec.repo.StepRun().GetStepRunForEngine(ctx, tenantId, res.MapStepRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not get map step run: %w", err)
+	}
+
+	err = ec.repo.StepRun().StepRunFailed(
+		ctx,
+		tenantId,
+		sqlchelpers.UUIDToStr(mapStepRun.WorkflowRunId),
+		res.MapStepRunId,
+		failedAt,
+		fmt.Sprintf("map item %d failed", res.Index),
+		int(mapStepRun.SRRetryCount),
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not fail map step run: %w", err)
+	}
+
+	return nil
+}
+
+func (ec *JobsControllerImpl) queueMapItems(stepRuns []*dbsqlc.GetStepRunForEngineRow) {
+	for _, stepRun := range stepRuns {
+		err := ec.mq.AddMessage(
+			context.Background(),
+			msgqueue.JOB_PROCESSING_QUEUE,
+			tasktypes.StepRunQueuedToTask(stepRun),
+		)
+
+		if err != nil {
+			ec.l.Error().Err(err).Msg("could not queue map item")
+		}
+	}
+}
+
+func (ec *JobsControllerImpl) checkTenantQueue(ctx context.Context, tenantId, queueName string, isStepQueued bool, isSlotReleased bool) {
+	// send a message to the tenant partition queue that a step run is ready to be scheduled
+	tenant, err := ec.repo.Tenant().GetTenantByID(ctx, tenantId)
+
+	if err != nil {
+		ec.l.Err(err).Msg("could not add message to tenant partition queue")
+		return
+	}
+
+	if tenant.ControllerPartitionId.Valid {
+		err = ec.mq.AddMessage(
+			ctx,
+			msgqueue.QueueTypeFromPartitionIDAndController(tenant.ControllerPartitionId.String, msgqueue.JobController),
+			tasktypes.CheckTenantQueueToTask(tenantId, queueName, isStepQueued, isSlotReleased),
+		)
+
+		if err != nil {
+			ec.l.Err(err).Msg("could not add message to controller partition queue")
+		}
+	}
+
+	if tenant.SchedulerPartitionId.Valid {
+		err = ec.mq.AddMessage(
+			ctx,
+			msgqueue.QueueTypeFromPartitionIDAndController(tenant.SchedulerPartitionId.String, msgqueue.Scheduler),
+			tasktypes.CheckTenantQueueToTask(tenantId, queueName, isStepQueued, isSlotReleased),
+		)
+
+		if err != nil {
+			ec.l.Err(err).Msg("could not add message to scheduler partition queue")
+		}
+	}
+}
+
+// tombstoneStepRuns notifies the scheduler that queued step runs were cancelled.
+func (ec *JobsControllerImpl) tombstoneStepRuns(ctx context.Context, tenantId, queueName string, stepRunIds ...string) {
+	tenant, err := ec.repo.Tenant().GetTenantByID(ctx, tenantId)
+
+	if err != nil {
+		ec.l.Err(err).Msg("could not get tenant to send step run tombstones")
+		return
+	}
+
+	if !tenant.SchedulerPartitionId.Valid {
+		return
+	}
+
+	err = ec.mq.AddMessage(
+		ctx,
+		msgqueue.QueueTypeFromPartitionIDAndController(tenant.SchedulerPartitionId.String, msgqueue.Scheduler),
+		tasktypes.StepRunTombstoneToTask(tenantId, queueName, stepRunIds...),
+	)
+
+	if err != nil {
+		ec.l.Err(err).Msg("could not add step run tombstones to scheduler partition queue")
+	}
+}
+
+func (ec *JobsControllerImpl) handleStepRunStarted(ctx context.Context, task *msgqueue.Message) error {
+	ctx, span := telemetry.NewSpanWithCarrier(ctx, "handle-step-run-started", task.OtelCarrier)
+	defer span.End()
+
+	payload := tasktypes.StepRunStartedTaskPayload{}
+	metadata := tasktypes.StepRunStartedTaskMetadata{}
+
+	err := ec.dv.DecodeAndValidate(task.Payload, &payload)
+
+	if err != nil {
+		return fmt.Errorf("could not decode step run started task payload: %w", err)
+	}
+
+	err = ec.dv.DecodeAndValidate(task.Metadata, &metadata)
+
+	if err != nil {
+		return fmt.Errorf("could not decode step run started task metadata: %w", err)
+	}
+
+	// update the step run in the database
+	startedAt, err := time.Parse(time.RFC3339, payload.StartedAt)
+
+	if err != nil {
+		return fmt.Errorf("could not parse started at: %w", err)
+	}
+
+	err = ec.repo.StepRun().StepRunStarted(ctx, metadata.TenantId, payload.WorkflowRunId, payload.StepRunId, startedAt)
+
+	if err != nil {
+		return fmt.Errorf("could not update step run: %w", err)
+	}
+
+	return nil
+}
+
+func (ec *JobsControllerImpl) handleStepRunAcked(ctx context.Context, task *msgqueue.Message) error {
+	ctx, span := telemetry.NewSpanWithCarrier(ctx, "handle-step-run-acked", task.OtelCarrier)
+	defer span.End()
+
+	payload := tasktypes.StepRunStartedTaskPayload{}
+	metadata := tasktypes.StepRunStartedTaskMetadata{}
+
+	err := ec.dv.DecodeAndValidate(task.Payload, &payload)
+
+	if err != nil {
+		return fmt.Errorf("could not decode step run started task payload: %w", err)
+	}
+
+	err = ec.dv.DecodeAndValidate(task.Metadata, &metadata)
+
+	if err != nil {
+		return fmt.Errorf("could not decode step run started task metadata: %w", err)
+	}
+
+	// update the step run in the database
+	startedAt, err := time.Parse(time.RFC3339, payload.StartedAt)
+
+	if err != nil {
+		return fmt.Errorf("could not parse started at: %w", err)
+	}
+
+	err = ec.repo.StepRun().StepRunAcked(ctx, metadata.TenantId, payload.WorkflowRunId, payload.StepRunId, startedAt)
+
+	if err != nil {
+		return fmt.Errorf("could not update step run: %w", err)
+	}
+
+	return nil
+}
+
+func (ec *JobsControllerImpl) handleStepRunFinished(ctx context.Context, task *msgqueue.Message) error {
+	ctx, span := telemetry.NewSpanWithCarrier(ctx, "handle-step-run-finished", task.OtelCarrier)
+	defer span.End()
+
+	payload := tasktypes.StepRunFinishedTaskPayload{}
+	metadata := tasktypes.StepRunFinishedTaskMetadata{}
+
+	err := ec.dv.DecodeAndValidate(task.Payload, &payload)
+
+	if err != nil {
+		return fmt.Errorf("could not decode step run finished task payload: %w", err)
+	}
+
+	err = ec.dv.DecodeAndValidate(task.Metadata, &metadata)
+
+	if err != nil {
+		return fmt.Errorf("could not decode step run finished task metadata: %w", err)
+	}
+
+	// update the step run in the database
+	finishedAt, err := time.Parse(time.RFC3339, payload.FinishedAt)
+
+	if err != nil {
+		return fmt.Errorf("could not parse started at: %w", err)
+	}
+
+	var stepOutput []byte
+
+	if payload.StepOutputData != "" {
+		stepOutput = []byte(payload.StepOutputData)
+	}
+
+	speculative, err := ec.resolveSpeculativeAttempt(ctx, metadata.TenantId, payload.StepRunId, payload.RetryCount, payload.WorkerId, true)
+
+	if err != nil {
+		return err
+	}
+
+	if speculative.Resolution == repository.SpeculativeAttemptLost {
+		ec.l.Debug().Msgf("step run %s already succeeded on another worker, ignoring result from worker %s", payload.StepRunId, payload.WorkerId)
+		return nil
+	}
+
+	err = ec.repo.StepRun().StepRunSucceeded(ctx, metadata.TenantId, payload.WorkflowRunId, payload.StepRunId, finishedAt, stepOutput)
+
+	if err != nil {
+		return fmt.Errorf("could not update step run: %w", err)
+	}
+
+	if speculative.Resolution == repository.SpeculativeAttemptWon {
+		err = ec.cancelSpeculativeLoser(ctx, metadata.TenantId, payload.StepRunId, payload.WorkerId, speculative.LoserWorkerId)
+
+		if err != nil {
+			// this is not a fatal error, the step run has already succeeded
+			ec.l.Error().Err(err).Msgf("could not cancel losing attempt of step run %s", payload.StepRunId)
+		}
+	}
+
+	nextStepRuns, err := ec.repo.StepRun().ListStartableStepRuns(ctx, metadata.TenantId, payload.StepRunId, true)
+
+	if err != nil {
+		ec.l.Error().Err(err).Msg("could not list startable step runs")
+	} else {
+		for _, nextStepRun := range nextStepRuns {
+			err := ec.mq.AddMessage(
+				context.Background(),
+				msgqueue.JOB_PROCESSING_QUEUE,
+				tasktypes.StepRunQueuedToTask(nextStepRun),
+			)
+
+			if err != nil {
+				ec.l.Error().Err(err).Msg("could not queue next step run")
+			}
+		}
+	}
+
+	// recheck the tenant queue
+	sr, err := ec.repo.StepRun().GetStepRunForEngine(ctx, metadata.TenantId, payload.StepRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not get step run: %w", err)
+	}
+
+	if sr.StepMapOver.Valid {
+		err = ec.finishMapItem(ctx, metadata.TenantId, sr, stepOutput)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if sr.StepCacheTtl.Valid {
+		err = ec.cacheStepRunOutput(ctx, metadata.TenantId, sr, stepOutput)
+
+		if err != nil {
+			// this is not a fatal error, the step run has already succeeded
+			ec.l.Error().Err(err).Msgf("could not cache output of step run %s", payload.StepRunId)
+		}
+	}
+
+	ec.checkTenantQueue(ctx, metadata.TenantId, sr.SRQueue, false, true)
+
+	return nil
+}
 
 func (ec *JobsControllerImpl) handleStepRunFailed(ctx context.Context, task *msgqueue.Message) error {
 	ctx, span := telemetry.NewSpanWithCarrier(ctx, "handle-step-run-failed", task.OtelCarrier)
@@ -1063,9 +1928,89 @@ func (ec *JobsControllerImpl) handleStepRunFailed(ctx context.Context, task *msg
 		return fmt.Errorf("could not parse failed at: %w", err)
 	}
 
+	speculative, err := ec.resolveSpeculativeAttempt(ctx, metadata.TenantId, payload.StepRunId, payload.RetryCount, payload.WorkerId, false)
+
+	if err != nil {
+		return err
+	}
+
+	switch speculative.Resolution {
+	case repository.SpeculativeAttemptLost:
+		ec.l.Debug().Msgf("step run %s already succeeded on another worker, ignoring failure from worker %s", payload.StepRunId, payload.WorkerId)
+		return nil
+	case repository.SpeculativeAttemptPending:
+		defer ec.repo.StepRun().DeferredStepRunEvent(metadata.TenantId, repository.CreateStepRunEventOpts{
+			StepRunId:     payload.StepRunId,
+			EventMessage:  repository.StringPtr("Attempt failed while another attempt is still running"),
+			EventReason:   repository.StepRunEventReasonPtr(dbsqlc.StepRunEventReasonSPECULATIVEATTEMPT),
+			EventSeverity: repository.StepRunEventSeverityPtr(dbsqlc.StepRunEventSeverityWARNING),
+			Timestamp:     &failedAt,
+			EventData:     map[string]interface{}{"worker_id": payload.WorkerId, "error": payload.Error},
+		})
+
+		return nil
+	}
+
 	return ec.failStepRun(ctx, metadata.TenantId, payload.StepRunId, payload.Error, failedAt)
 }
 
+// resolveSpeculativeAttempt resolves the result of a step run attempt on a worker against any speculative
+// attempt of the same step run. Results which don't identify the worker are processed as usual.
+func (ec *JobsControllerImpl) resolveSpeculativeAttempt(ctx context.Context, tenantId, stepRunId string, retryCount *int32, workerId string, succeeded bool) (*repository.ResolveSpeculativeAttemptResult, error) {
+	if workerId == "" || retryCount == nil {
+		return &repository.ResolveSpeculativeAttemptResult{
+			Resolution: repository.SpeculativeAttemptNone,
+		}, nil
+	}
+
+	res, err := ec.repo.StepRun().ResolveSpeculativeAttempt(ctx, tenantId, stepRunId, *retryCount, workerId, succeeded)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve speculative attempt: %w", err)
+	}
+
+	return res, nil
+}
+
+// cancelSpeculativeLoser cancels the attempt of a step run which is still running after another attempt
+// succeeded.
+func (ec *JobsControllerImpl) cancelSpeculativeLoser(ctx context.Context, tenantId, stepRunId, winnerWorkerId, loserWorkerId string) error {
+	stepRun, err := ec.repo.StepRun().GetStepRunForEngine(ctx, tenantId, stepRunId)
+
+	if err != nil {
+		return fmt.Errorf("could not get step run: %w", err)
+	}
+
+	now := time.Now().UTC()
+
+	defer ec.repo.StepRun().DeferredStepRunEvent(tenantId, repository.CreateStepRunEventOpts{
+		StepRunId:     stepRunId,
+		EventMessage:  repository.StringPtr("Attempt succeeded first, cancelling the attempt on the other worker"),
+		EventReason:   repository.StepRunEventReasonPtr(dbsqlc.StepRunEventReasonSPECULATIVEATTEMPT),
+		EventSeverity: repository.StepRunEventSeverityPtr(dbsqlc.StepRunEventSeverityINFO),
+		Timestamp:     &now,
+		EventData:     map[string]interface{}{"worker_id": winnerWorkerId, "cancelled_worker_id": loserWorkerId},
+	})
+
+	worker, err := ec.repo.Worker().GetWorkerForEngine(ctx, tenantId, loserWorkerId)
+
+	if err != nil {
+		return fmt.Errorf("could not get worker: %w", err)
+	} else if !worker.DispatcherId.Valid {
+		return fmt.Errorf("worker has no dispatcher id")
+	}
+
+	dispatcherId := sqlchelpers.UUIDToStr(worker.DispatcherId)
+
+	return ec.mq.AddMessage(
+		ctx,
+		msgqueue.QueueTypeFromDispatcherID(dispatcherId),
+		stepRunCancelledTask(tenantId, stepRunId, loserWorkerId, dispatcherId, "SPECULATIVE_ATTEMPT_LOST",
+			sqlchelpers.UUIDToStr(stepRun.WorkflowRunId), &stepRun.StepRetries, &stepRun.SRRetryCount,
+		),
+	)
+}
+
 func (ec *JobsControllerImpl) failStepRun(ctx context.Context, tenantId, stepRunId, errorReason string, failedAt time.Time) error {
 	oldStepRun, err := ec.repo.StepRun().GetStepRunForEngine(ctx, tenantId, stepRunId)
 
@@ -1084,9 +2029,13 @@ func (ec *JobsControllerImpl) failStepRun(ctx context.Context, tenantId, stepRun
 
 		eventReason := dbsqlc.StepRunEventReasonFAILED
 
-		if errorReason == "TIMED_OUT" {
+		switch errorReason {
+		case "TIMED_OUT":
 			eventReason = dbsqlc.StepRunEventReasonTIMEDOUT
 			eventMessage = fmt.Sprintf("Step exceeded timeout duration (%s)", oldStepRun.StepTimeout.String)
+		case "HEARTBEAT_TIMED_OUT":
+			eventReason = dbsqlc.StepRunEventReasonTIMEDOUT
+			eventMessage = fmt.Sprintf("Step exceeded heartbeat timeout duration (%s)", oldStepRun.StepHeartbeatTimeout.String)
 		}
 
 		eventMessage += ", and will be retried."
@@ -1116,9 +2065,26 @@ func (ec *JobsControllerImpl) failStepRun(ctx context.Context, tenantId, stepRun
 		return fmt.Errorf("could not fail step run: %w", err)
 	}
 
+	// a failed element fails its map step run
+	if oldStepRun.StepMapOver.Valid {
+		err = ec.failMapItem(ctx, tenantId, stepRunId, failedAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	// the step run has exhausted its retries, so we add it to the dead-letter queue. failures here should not
+	// block the rest of the failure handling, so we only log the error.
+	err = ec.repo.DeadLetterQueue().CreateDeadLetterQueueItem(ctx, tenantId, stepRunId, errorReason, int(oldStepRun.SRRetryCount))
+
+	if err != nil {
+		ec.l.Err(err).Msgf("could not add step run %s to dead-letter queue", stepRunId)
+	}
+
 	attemptCancel := false
 
-	if errorReason == "TIMED_OUT" {
+	if errorReason == "TIMED_OUT" || errorReason == "HEARTBEAT_TIMED_OUT" {
 		attemptCancel = true
 	}
 
@@ -1232,6 +2198,11 @@ func (ec *JobsControllerImpl) cancelStepRun(ctx context.Context, tenantId, stepR
 	}
 
 	if !oldStepRun.SRWorkerId.Valid {
+		// the step run may still be in the scheduler's queues, so we tell the scheduler to drop it
+		if oldStepRun.SRStatus == dbsqlc.StepRunStatusPENDINGASSIGNMENT {
+			ec.tombstoneStepRuns(ctx, tenantId, oldStepRun.SRQueue, stepRunId)
+		}
+
 		// this is not a fatal error
 		ec.l.Debug().Msgf("[cancelStepRun] step run %s has no worker id, skipping send of cancellation", stepRunId)
 
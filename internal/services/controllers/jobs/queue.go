@@ -2,6 +2,8 @@ package jobs
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -9,7 +11,9 @@ import (
 	"github.com/go-co-op/gocron/v2"
 	"github.com/rs/zerolog"
 
+	"github.com/hatchet-dev/hatchet/internal/cel"
 	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/integrations/blobstore"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/queueutils"
 	"github.com/hatchet-dev/hatchet/internal/services/partition"
@@ -22,6 +26,9 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 )
 
+// queueMetricsSnapshotInterval is how often the metrics of the queues of a tenant are snapshotted
+const queueMetricsSnapshotInterval = 15 * time.Second
+
 type queue struct {
 	mq   msgqueue.MessageQueue
 	l    *zerolog.Logger
@@ -31,13 +38,19 @@ type queue struct {
 	a    *hatcheterrors.Wrapped
 	p    *partition.Partition
 
+	celParser *cel.CELParser
+	blobs     *blobstore.Offloader
+
 	// a custom queue logger
 	ql *zerolog.Logger
 
-	updateStepRunOperations   *queueutils.OperationPool
-	updateStepRunV2Operations *queueutils.OperationPool
-	timeoutStepRunOperations  *queueutils.OperationPool
-	retryStepRunOperations    *queueutils.OperationPool
+	updateStepRunOperations           *queueutils.OperationPool
+	updateStepRunV2Operations         *queueutils.OperationPool
+	timeoutStepRunOperations          *queueutils.OperationPool
+	heartbeatTimeoutStepRunOperations *queueutils.OperationPool
+	retryStepRunOperations            *queueutils.OperationPool
+	speculateStepRunOperations        *queueutils.OperationPool
+	snapshotQueueMetricsOperations    *queueutils.OperationPool
 }
 
 func newQueue(
@@ -48,6 +61,7 @@ func newQueue(
 	dv datautils.DataDecoderValidator,
 	a *hatcheterrors.Wrapped,
 	p *partition.Partition,
+	blobs *blobstore.Offloader,
 ) (*queue, error) {
 	s, err := gocron.NewScheduler(gocron.WithLocation(time.UTC))
 
@@ -64,12 +78,18 @@ func newQueue(
 		a:    a,
 		p:    p,
 		ql:   ql,
+
+		celParser: cel.NewCELParser(),
+		blobs:     blobs,
 	}
 
 	q.updateStepRunOperations = queueutils.NewOperationPool(ql, time.Second*30, "update step runs", q.processStepRunUpdates)
 	q.updateStepRunV2Operations = queueutils.NewOperationPool(ql, time.Second*30, "update step runs (v2)", q.processStepRunUpdatesV2)
 	q.timeoutStepRunOperations = queueutils.NewOperationPool(ql, time.Second*30, "timeout step runs", q.processStepRunTimeouts)
+	q.heartbeatTimeoutStepRunOperations = queueutils.NewOperationPool(ql, time.Second*30, "heartbeat timeout step runs", q.processStepRunHeartbeatTimeouts)
 	q.retryStepRunOperations = queueutils.NewOperationPool(ql, time.Second*30, "retry step runs", q.processStepRunRetries)
+	q.speculateStepRunOperations = queueutils.NewOperationPool(ql, time.Second*30, "speculate step runs", q.processStepRunSpeculation)
+	q.snapshotQueueMetricsOperations = queueutils.NewOperationPool(ql, time.Second*30, "snapshot queue metrics", q.processQueueMetricsSnapshot)
 
 	return q, nil
 }
@@ -104,6 +124,18 @@ func (q *queue) Start() (func() error, error) {
 		return nil, fmt.Errorf("could not schedule step run timeout: %w", err)
 	}
 
+	_, err = q.s.NewJob(
+		gocron.DurationJob(time.Second*1),
+		gocron.NewTask(
+			q.runTenantHeartbeatTimeoutStepRuns(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not schedule step run heartbeat timeout: %w", err)
+	}
+
 	_, err = q.s.NewJob(
 		gocron.DurationJob(time.Second*1),
 		gocron.NewTask(
@@ -116,6 +148,18 @@ func (q *queue) Start() (func() error, error) {
 		return nil, fmt.Errorf("could not schedule step run retry: %w", err)
 	}
 
+	_, err = q.s.NewJob(
+		gocron.DurationJob(time.Second*1),
+		gocron.NewTask(
+			q.runTenantSpeculateStepRuns(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not schedule step run speculation: %w", err)
+	}
+
 	_, err = q.s.NewJob(
 		gocron.DurationJob(time.Second*1),
 		gocron.NewTask(
@@ -128,6 +172,18 @@ func (q *queue) Start() (func() error, error) {
 		return nil, fmt.Errorf("could not schedule step run update (v2): %w", err)
 	}
 
+	_, err = q.s.NewJob(
+		gocron.DurationJob(queueMetricsSnapshotInterval),
+		gocron.NewTask(
+			q.runTenantSnapshotQueueMetrics(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not schedule queue metrics snapshot: %w", err)
+	}
+
 	q.s.Start()
 
 	postAck := func(task *msgqueue.Message) error {
@@ -295,6 +351,8 @@ func (q *queue) processStepRunUpdates(ctx context.Context, tenantId string) (boo
 		return false, fmt.Errorf("could not process succeeded step runs: %w", err)
 	}
 
+	q.setWorkflowRunOutputs(ctx, tenantId, res.CompletedWorkflowRuns)
+
 	// for all finished workflow runs, send a message
 	for _, finished := range res.CompletedWorkflowRuns {
 		workflowRunId := sqlchelpers.UUIDToStr(finished.ID)
@@ -391,6 +449,8 @@ func (q *queue) processStepRunUpdatesV2(ctx context.Context, tenantId string) (b
 		return false, fmt.Errorf("could not process succeeded step runs: %w", err)
 	}
 
+	q.setWorkflowRunOutputs(ctx, tenantId, res.CompletedWorkflowRuns)
+
 	// for all finished workflow runs, send a message
 	for _, finished := range res.CompletedWorkflowRuns {
 		workflowRunId := sqlchelpers.UUIDToStr(finished.ID)
@@ -414,6 +474,100 @@ func (q *queue) processStepRunUpdatesV2(ctx context.Context, tenantId string) (b
 	return res.Continue, nil
 }
 
+// setWorkflowRunOutputs evaluates the output expressions of the succeeded workflow runs and stores the results. This
+// happens before the workflow runs are marked as finished, so that subscribers can read the output when notified.
+func (q *queue) setWorkflowRunOutputs(ctx context.Context, tenantId string, completedWorkflowRuns []*dbsqlc.ResolveWorkflowRunStatusRow) {
+	workflowRunIds := make([]string, 0, len(completedWorkflowRuns))
+
+	for _, finished := range completedWorkflowRuns {
+		if finished.Status == dbsqlc.WorkflowRunStatusSUCCEEDED {
+			workflowRunIds = append(workflowRunIds, sqlchelpers.UUIDToStr(finished.ID))
+		}
+	}
+
+	if len(workflowRunIds) == 0 {
+		return
+	}
+
+	workflowRuns, err := q.repo.WorkflowRun().ListWorkflowRunsForOutput(ctx, tenantId, workflowRunIds)
+
+	if err != nil {
+		q.l.Error().Err(err).Msg("could not list workflow runs for output")
+		return
+	}
+
+	outputs := make(map[string][]byte, len(workflowRuns))
+
+	for _, workflowRun := range workflowRuns {
+		workflowRunId := sqlchelpers.UUIDToStr(workflowRun.WorkflowRunId)
+
+		output, err := q.evalWorkflowRunOutput(ctx, workflowRunId, workflowRun)
+
+		if err != nil {
+			q.l.Error().Err(err).Msgf("could not evaluate output of workflow run %s", workflowRunId)
+			continue
+		}
+
+		outputs[workflowRunId] = output
+	}
+
+	if len(outputs) == 0 {
+		return
+	}
+
+	err = q.repo.WorkflowRun().UpdateWorkflowRunOutputs(ctx, tenantId, outputs)
+
+	if err != nil {
+		q.l.Error().Err(err).Msg("could not update workflow run outputs")
+	}
+}
+
+func (q *queue) evalWorkflowRunOutput(ctx context.Context, workflowRunId string, workflowRun *repository.WorkflowRunForOutput) ([]byte, error) {
+	input := map[string]interface{}{}
+
+	if len(workflowRun.Input) > 0 {
+		if err := json.Unmarshal(workflowRun.Input, &input); err != nil {
+			return nil, fmt.Errorf("could not unmarshal input: %w", err)
+		}
+	}
+
+	additionalMeta := map[string]interface{}{}
+
+	if len(workflowRun.AdditionalMetadata) > 0 {
+		if err := json.Unmarshal(workflowRun.AdditionalMetadata, &additionalMeta); err != nil {
+			return nil, fmt.Errorf("could not unmarshal additional metadata: %w", err)
+		}
+	}
+
+	steps := make(map[string]map[string]interface{}, len(workflowRun.StepOutputs))
+
+	for stepReadableId, stepOutput := range workflowRun.StepOutputs {
+		output := map[string]interface{}{}
+
+		// outputs which were moved to the blob store are fetched, so that expressions can reference their fields
+		stepOutput, err := q.blobs.Resolve(ctx, stepOutput)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not resolve output of step %s: %w", stepReadableId, err)
+		}
+
+		if len(stepOutput) > 0 {
+			if err := json.Unmarshal(stepOutput, &output); err != nil {
+				return nil, fmt.Errorf("could not unmarshal output of step %s: %w", stepReadableId, err)
+			}
+		}
+
+		steps[stepReadableId] = output
+	}
+
+	return q.celParser.ParseAndEvalWorkflowRunOutput(workflowRun.OutputExpression.String, cel.NewInput(
+		cel.WithInput(input),
+		cel.WithAdditionalMetadata(additionalMeta),
+		cel.WithSteps(steps),
+		cel.WithWorkflowRunID(workflowRunId),
+	))
+}
+
 func (q *queue) runTenantTimeoutStepRuns(ctx context.Context) func() {
 	return func() {
 		q.l.Debug().Msgf("partition: running timeout for step runs")
@@ -436,6 +590,28 @@ func (q *queue) runTenantTimeoutStepRuns(ctx context.Context) func() {
 	}
 }
 
+func (q *queue) runTenantHeartbeatTimeoutStepRuns(ctx context.Context) func() {
+	return func() {
+		q.l.Debug().Msgf("partition: running heartbeat timeout for step runs")
+
+		// list all tenants
+		tenants, err := q.repo.Tenant().ListTenantsByControllerPartition(ctx, q.p.GetControllerPartitionId())
+
+		if err != nil {
+			q.l.Err(err).Msg("could not list tenants")
+			return
+		}
+
+		q.heartbeatTimeoutStepRunOperations.SetTenants(tenants)
+
+		for i := range tenants {
+			tenantId := sqlchelpers.UUIDToStr(tenants[i].ID)
+
+			q.heartbeatTimeoutStepRunOperations.RunOrContinue(tenantId)
+		}
+	}
+}
+
 func (q *queue) runTenantRetryStepRuns(ctx context.Context) func() {
 	return func() {
 		q.l.Debug().Msgf("partition: running retry for step runs")
@@ -458,6 +634,61 @@ func (q *queue) runTenantRetryStepRuns(ctx context.Context) func() {
 	}
 }
 
+func (q *queue) runTenantSpeculateStepRuns(ctx context.Context) func() {
+	return func() {
+		q.l.Debug().Msgf("partition: running speculation for step runs")
+
+		// list all tenants
+		tenants, err := q.repo.Tenant().ListTenantsByControllerPartition(ctx, q.p.GetControllerPartitionId())
+
+		if err != nil {
+			q.l.Err(err).Msg("could not list tenants")
+			return
+		}
+
+		q.speculateStepRunOperations.SetTenants(tenants)
+
+		for i := range tenants {
+			tenantId := sqlchelpers.UUIDToStr(tenants[i].ID)
+
+			q.speculateStepRunOperations.RunOrContinue(tenantId)
+		}
+	}
+}
+
+func (q *queue) runTenantSnapshotQueueMetrics(ctx context.Context) func() {
+	return func() {
+		q.l.Debug().Msgf("partition: snapshotting queue metrics")
+
+		// list all tenants
+		tenants, err := q.repo.Tenant().ListTenantsByControllerPartition(ctx, q.p.GetControllerPartitionId())
+
+		if err != nil {
+			q.l.Err(err).Msg("could not list tenants")
+			return
+		}
+
+		q.snapshotQueueMetricsOperations.SetTenants(tenants)
+
+		for i := range tenants {
+			tenantId := sqlchelpers.UUIDToStr(tenants[i].ID)
+
+			q.snapshotQueueMetricsOperations.RunOrContinue(tenantId)
+		}
+	}
+}
+
+func (q *queue) processQueueMetricsSnapshot(ctx context.Context, tenantId string) (bool, error) {
+	ctx, span := telemetry.NewSpan(ctx, "snapshot-queue-metrics")
+	defer span.End()
+
+	if err := q.repo.QueueMetrics().SnapshotQueueMetrics(ctx, tenantId); err != nil {
+		return false, fmt.Errorf("could not snapshot queue metrics for tenant %s: %w", tenantId, err)
+	}
+
+	return false, nil
+}
+
 func (q *queue) processStepRunTimeouts(ctx context.Context, tenantId string) (bool, error) {
 	ctx, span := telemetry.NewSpan(ctx, "handle-step-run-timeout")
 	defer span.End()
@@ -507,6 +738,43 @@ func (q *queue) processStepRunTimeouts(ctx context.Context, tenantId string) (bo
 	return shouldContinue, nil
 }
 
+// processStepRunHeartbeatTimeouts fails running step runs whose worker has been silent for longer than the
+// step's heartbeat timeout. Unlike schedule timeouts, these failures are eligible for the step's retries.
+func (q *queue) processStepRunHeartbeatTimeouts(ctx context.Context, tenantId string) (bool, error) {
+	ctx, span := telemetry.NewSpan(ctx, "handle-step-run-heartbeat-timeout")
+	defer span.End()
+
+	shouldContinue, stepRuns, err := q.repo.StepRun().ListStepRunsToHeartbeatTimeout(ctx, tenantId)
+
+	if err != nil {
+		return false, fmt.Errorf("could not list step runs to heartbeat timeout for tenant %s: %w", tenantId, err)
+	}
+
+	if num := len(stepRuns); num > 0 {
+		q.l.Info().Msgf("heartbeat timing out %d step runs", num)
+	}
+
+	failedAt := time.Now().UTC()
+
+	for i := range stepRuns {
+		stepRunCp := stepRuns[i]
+
+		if err := q.mq.AddMessage(
+			ctx,
+			msgqueue.JOB_PROCESSING_QUEUE,
+			tasktypes.StepRunFailedToTask(
+				stepRunCp,
+				"HEARTBEAT_TIMED_OUT",
+				&failedAt,
+			),
+		); err != nil {
+			q.l.Error().Err(err).Msg("could not add step run failed task to task queue")
+		}
+	}
+
+	return shouldContinue, nil
+}
+
 func (q *queue) processStepRunRetries(ctx context.Context, tenantId string) (bool, error) {
 	ctx, span := telemetry.NewSpan(ctx, "handle-step-run-timeout")
 	defer span.End()
@@ -552,3 +820,93 @@ func (q *queue) processStepRunRetries(ctx context.Context, tenantId string) (boo
 
 	return shouldContinue, nil
 }
+
+// processStepRunSpeculation launches a second attempt of step runs which have been running for longer than
+// their step's speculative percentile, on a different worker. Whichever attempt finishes first wins.
+func (q *queue) processStepRunSpeculation(ctx context.Context, tenantId string) (bool, error) {
+	ctx, span := telemetry.NewSpan(ctx, "handle-step-run-speculation")
+	defer span.End()
+
+	shouldContinue, stepRuns, err := q.repo.StepRun().ListStepRunsToSpeculate(ctx, tenantId)
+
+	if err != nil {
+		return false, fmt.Errorf("could not list step runs to speculate for tenant %s: %w", tenantId, err)
+	}
+
+	if num := len(stepRuns); num > 0 {
+		q.l.Info().Msgf("speculating %d step runs", num)
+	}
+
+	err = queueutils.BatchConcurrent(10, stepRuns, func(group []*dbsqlc.ListStepRunsToSpeculateRow) error {
+		scheduleCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		scheduleCtx, span := telemetry.NewSpan(scheduleCtx, "handle-step-run-speculation-step-run")
+		defer span.End()
+
+		for i := range group {
+			stepRunCp := group[i]
+			stepRunId := sqlchelpers.UUIDToStr(stepRunCp.StepRunId)
+
+			attempt, err := q.repo.StepRun().CreateSpeculativeAttempt(scheduleCtx, tenantId, stepRunCp)
+
+			if err != nil {
+				if !errors.Is(err, repository.ErrNoSpeculativeWorker) {
+					q.l.Error().Err(err).Msgf("could not create speculative attempt for step run %s", stepRunId)
+				}
+
+				continue
+			}
+
+			now := time.Now().UTC()
+
+			defer q.repo.StepRun().DeferredStepRunEvent(tenantId, repository.CreateStepRunEventOpts{
+				StepRunId:     stepRunId,
+				EventMessage:  repository.StringPtr("Step run exceeded its speculative threshold, starting a second attempt on another worker"),
+				EventReason:   repository.StepRunEventReasonPtr(dbsqlc.StepRunEventReasonSPECULATIVEATTEMPT),
+				EventSeverity: repository.StepRunEventSeverityPtr(dbsqlc.StepRunEventSeverityINFO),
+				Timestamp:     &now,
+				EventData: map[string]interface{}{
+					"worker_id":         attempt.WorkerId,
+					"threshold_seconds": stepRunCp.Threshold,
+				},
+			})
+
+			if err := q.mq.AddMessage(
+				scheduleCtx,
+				msgqueue.QueueTypeFromDispatcherID(attempt.DispatcherId),
+				speculativeStepRunAssignedTask(tenantId, stepRunId, attempt.WorkerId, attempt.DispatcherId),
+			); err != nil {
+				q.l.Error().Err(err).Msg("could not add speculative step run assigned task to dispatcher queue")
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not process step run speculation: %w", err)
+	}
+
+	return shouldContinue, nil
+}
+
+func speculativeStepRunAssignedTask(tenantId, stepRunId, workerId, dispatcherId string) *msgqueue.Message {
+	payload, _ := datautils.ToJSONMap(tasktypes.StepRunAssignedTaskPayload{
+		StepRunId:   stepRunId,
+		WorkerId:    workerId,
+		Speculative: true,
+	})
+
+	metadata, _ := datautils.ToJSONMap(tasktypes.StepRunAssignedTaskMetadata{
+		TenantId:     tenantId,
+		DispatcherId: dispatcherId,
+	})
+
+	return &msgqueue.Message{
+		ID:       "step-run-assigned",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
@@ -0,0 +1,151 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/hatchet-dev/hatchet/internal/metrics"
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// recoveryReport is the result of the recovery pass for a tenant.
+type recoveryReport struct {
+	// the step runs on inactive workers, or which missed their heartbeats, which were reassigned
+	ReassignedStepRuns int
+
+	// the step runs on inactive workers, or which missed their heartbeats, which were failed since they exhausted
+	// their internal retries
+	FailedStepRuns int
+
+	// the worker slots which were released since their step run is finished or no longer exists
+	ReleasedSlots int64
+
+	// the queue items which were removed since their step run is finished or no longer exists
+	DequeuedQueueItems int64
+}
+
+func (r *recoveryReport) add(other *recoveryReport) {
+	r.ReassignedStepRuns += other.ReassignedStepRuns
+	r.FailedStepRuns += other.FailedStepRuns
+	r.ReleasedSlots += other.ReleasedSlots
+	r.DequeuedQueueItems += other.DequeuedQueueItems
+}
+
+// runRecovery repairs the step runs, worker slots and queue items which were left in an inconsistent state by a
+// crash of the engine, instead of waiting for them to time out. It runs once after startup, when workers have had
+// the chance to send heartbeats, and reports what it repaired.
+func (jc *JobsControllerImpl) runRecovery(ctx context.Context) func() {
+	return func() {
+		start := time.Now()
+
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+		defer cancel()
+
+		ctx, span := telemetry.NewSpan(ctx, "run-recovery")
+		defer span.End()
+
+		tenants, err := jc.repo.Tenant().ListTenantsByControllerPartition(ctx, jc.p.GetControllerPartitionId())
+
+		if err != nil {
+			jc.l.Err(err).Msg("recovery: could not list tenants")
+			return
+		}
+
+		var (
+			mu    sync.Mutex
+			total recoveryReport
+		)
+
+		g := new(errgroup.Group)
+		g.SetLimit(10)
+
+		for i := range tenants {
+			tenantId := sqlchelpers.UUIDToStr(tenants[i].ID)
+
+			g.Go(func() error {
+				report, err := jc.runRecoveryTenant(ctx, tenantId)
+
+				mu.Lock()
+				total.add(report)
+				mu.Unlock()
+
+				if err != nil {
+					return fmt.Errorf("could not run recovery for tenant %s: %w", tenantId, err)
+				}
+
+				return nil
+			})
+		}
+
+		err = g.Wait()
+
+		if err != nil {
+			jc.l.Err(err).Msg("recovery: could not recover all tenants")
+		}
+
+		jc.l.Info().
+			Int("tenants", len(tenants)).
+			Int("reassigned_step_runs", total.ReassignedStepRuns).
+			Int("failed_step_runs", total.FailedStepRuns).
+			Int64("released_slots", total.ReleasedSlots).
+			Int64("dequeued_queue_items", total.DequeuedQueueItems).
+			Dur("duration", time.Since(start)).
+			Msg("recovery: finished recovery pass")
+	}
+}
+
+// runRecoveryTenant runs the recovery pass for a tenant. The report contains what was repaired before an error.
+func (jc *JobsControllerImpl) runRecoveryTenant(ctx context.Context, tenantId string) (*recoveryReport, error) {
+	report := &recoveryReport{}
+
+	// wait for a reassign which is already running, so that the step runs which it reassigns are reported
+	mu := jc.reassignMutex(tenantId)
+	mu.Lock()
+
+	var err error
+
+	report.ReassignedStepRuns, report.FailedStepRuns, err = jc.reassignTenantStepRuns(ctx, tenantId)
+
+	mu.Unlock()
+
+	var result *multierror.Error
+
+	if err != nil {
+		result = multierror.Append(result, fmt.Errorf("could not reassign step runs: %w", err))
+	}
+
+	report.ReleasedSlots, err = jc.repo.StepRun().ReleaseDanglingSemaphoreSlots(ctx, tenantId)
+
+	if err != nil {
+		result = multierror.Append(result, fmt.Errorf("could not release dangling slots: %w", err))
+	}
+
+	report.DequeuedQueueItems, err = jc.repo.StepRun().DequeueOrphanedQueueItems(ctx, tenantId)
+
+	if err != nil {
+		result = multierror.Append(result, fmt.Errorf("could not dequeue orphaned queue items: %w", err))
+	}
+
+	metrics.RecoveredItems.WithLabelValues(tenantId, "reassigned_step_run").Add(float64(report.ReassignedStepRuns))
+	metrics.RecoveredItems.WithLabelValues(tenantId, "failed_step_run").Add(float64(report.FailedStepRuns))
+	metrics.RecoveredItems.WithLabelValues(tenantId, "released_slot").Add(float64(report.ReleasedSlots))
+	metrics.RecoveredItems.WithLabelValues(tenantId, "dequeued_queue_item").Add(float64(report.DequeuedQueueItems))
+
+	if report.ReassignedStepRuns > 0 || report.FailedStepRuns > 0 || report.ReleasedSlots > 0 || report.DequeuedQueueItems > 0 {
+		jc.l.Warn().
+			Str("tenant_id", tenantId).
+			Int("reassigned_step_runs", report.ReassignedStepRuns).
+			Int("failed_step_runs", report.FailedStepRuns).
+			Int64("released_slots", report.ReleasedSlots).
+			Int64("dequeued_queue_items", report.DequeuedQueueItems).
+			Msg("recovery: repaired inconsistent state")
+	}
+
+	return report, result.ErrorOrNil()
+}
@@ -0,0 +1,170 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// mockRecoveryRepo only implements the step run repository, which repairs the state of the step runs
+type mockRecoveryRepo struct {
+	repository.EngineRepository
+
+	stepRuns *mockRecoveryStepRunRepo
+}
+
+func (m *mockRecoveryRepo) StepRun() repository.StepRunEngineRepository {
+	return m.stepRuns
+}
+
+type mockRecoveryStepRunRepo struct {
+	repository.StepRunEngineRepository
+	mock.Mock
+}
+
+func (m *mockRecoveryStepRunRepo) BulkReassignStepRuns(ctx context.Context, tenantId string, opts *repository.BulkReassignStepRunsOpts) (*repository.BulkReassignStepRunsResult, error) {
+	args := m.Called(ctx, tenantId, opts)
+	res, _ := args.Get(0).(*repository.BulkReassignStepRunsResult)
+	return res, args.Error(1)
+}
+
+func (m *mockRecoveryStepRunRepo) ReassignLostStepRuns(ctx context.Context, tenantId string, opts *repository.BulkReassignStepRunsOpts) (*repository.BulkReassignStepRunsResult, error) {
+	args := m.Called(ctx, tenantId, opts)
+	res, _ := args.Get(0).(*repository.BulkReassignStepRunsResult)
+	return res, args.Error(1)
+}
+
+func (m *mockRecoveryStepRunRepo) ReleaseDanglingSemaphoreSlots(ctx context.Context, tenantId string) (int64, error) {
+	args := m.Called(ctx, tenantId)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockRecoveryStepRunRepo) DequeueOrphanedQueueItems(ctx context.Context, tenantId string) (int64, error) {
+	args := m.Called(ctx, tenantId)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func newTestRecoveryController(stepRuns *mockRecoveryStepRunRepo) *JobsControllerImpl {
+	l := zerolog.Nop()
+
+	return &JobsControllerImpl{
+		l:    &l,
+		repo: &mockRecoveryRepo{stepRuns: stepRuns},
+	}
+}
+
+func TestRunRecoveryTenant(t *testing.T) {
+	tenantId := uuid.New().String()
+	cursor := uuid.New().String()
+
+	stepRuns := &mockRecoveryStepRunRepo{}
+
+	// the reassign of inactive workers takes two batches, and the reassign of lost step runs a single one
+	stepRuns.On("BulkReassignStepRuns", mock.Anything, tenantId, mock.MatchedBy(func(opts *repository.BulkReassignStepRunsOpts) bool {
+		return opts.Cursor == nil
+	})).Return(&repository.BulkReassignStepRunsResult{
+		ReassignedStepRunIds: []string{uuid.New().String(), uuid.New().String()},
+		NextCursor:           &cursor,
+	}, nil).Once()
+
+	stepRuns.On("BulkReassignStepRuns", mock.Anything, tenantId, mock.MatchedBy(func(opts *repository.BulkReassignStepRunsOpts) bool {
+		return opts.Cursor != nil && *opts.Cursor == cursor
+	})).Return(&repository.BulkReassignStepRunsResult{
+		ReassignedStepRunIds: []string{uuid.New().String()},
+	}, nil).Once()
+
+	stepRuns.On("ReassignLostStepRuns", mock.Anything, tenantId, mock.Anything).Return(&repository.BulkReassignStepRunsResult{
+		ReassignedStepRunIds: []string{uuid.New().String()},
+	}, nil).Once()
+
+	stepRuns.On("ReleaseDanglingSemaphoreSlots", mock.Anything, tenantId).Return(int64(5), nil).Once()
+	stepRuns.On("DequeueOrphanedQueueItems", mock.Anything, tenantId).Return(int64(2), nil).Once()
+
+	jc := newTestRecoveryController(stepRuns)
+
+	report, err := jc.runRecoveryTenant(context.Background(), tenantId)
+	require.NoError(t, err)
+
+	assert.Equal(t, &recoveryReport{
+		ReassignedStepRuns: 4,
+		ReleasedSlots:      5,
+		DequeuedQueueItems: 2,
+	}, report)
+
+	stepRuns.AssertExpectations(t)
+}
+
+func TestRunRecoveryTenantContinuesAfterErrors(t *testing.T) {
+	tenantId := uuid.New().String()
+
+	errReassign := errors.New("reassign failed")
+	errDequeue := errors.New("dequeue failed")
+
+	stepRuns := &mockRecoveryStepRunRepo{}
+
+	stepRuns.On("BulkReassignStepRuns", mock.Anything, tenantId, mock.Anything).Return(nil, errReassign).Once()
+	stepRuns.On("ReleaseDanglingSemaphoreSlots", mock.Anything, tenantId).Return(int64(3), nil).Once()
+	stepRuns.On("DequeueOrphanedQueueItems", mock.Anything, tenantId).Return(int64(0), errDequeue).Once()
+
+	jc := newTestRecoveryController(stepRuns)
+
+	report, err := jc.runRecoveryTenant(context.Background(), tenantId)
+
+	// a failed repair doesn't stop the repairs after it, and what was repaired is still reported
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errReassign)
+	assert.ErrorIs(t, err, errDequeue)
+	assert.Equal(t, int64(3), report.ReleasedSlots)
+	assert.Zero(t, report.ReassignedStepRuns)
+
+	stepRuns.AssertExpectations(t)
+	stepRuns.AssertNotCalled(t, "ReassignLostStepRuns", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRunRecoveryTenantWaitsForReassign(t *testing.T) {
+	tenantId := uuid.New().String()
+
+	stepRuns := &mockRecoveryStepRunRepo{}
+
+	stepRuns.On("BulkReassignStepRuns", mock.Anything, tenantId, mock.Anything).Return(&repository.BulkReassignStepRunsResult{}, nil)
+	stepRuns.On("ReassignLostStepRuns", mock.Anything, tenantId, mock.Anything).Return(&repository.BulkReassignStepRunsResult{}, nil)
+	stepRuns.On("ReleaseDanglingSemaphoreSlots", mock.Anything, tenantId).Return(int64(0), nil)
+	stepRuns.On("DequeueOrphanedQueueItems", mock.Anything, tenantId).Return(int64(0), nil)
+
+	jc := newTestRecoveryController(stepRuns)
+
+	// a periodic reassign is running for the tenant, so the recovery pass waits for it instead of skipping the tenant
+	mu := jc.reassignMutex(tenantId)
+	mu.Lock()
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		_, err := jc.runRecoveryTenant(context.Background(), tenantId)
+		assert.NoError(t, err)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("recovery must wait for the reassign which is running")
+	default:
+	}
+
+	stepRuns.AssertNotCalled(t, "BulkReassignStepRuns", mock.Anything, mock.Anything, mock.Anything)
+
+	mu.Unlock()
+	<-done
+
+	stepRuns.AssertCalled(t, "BulkReassignStepRuns", mock.Anything, tenantId, mock.Anything)
+	assert.True(t, mu.TryLock(), "the reassign mutex must be released after the recovery pass")
+}
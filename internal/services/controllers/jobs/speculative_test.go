@@ -0,0 +1,92 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// mockSpeculativeRepo only implements the step run repository, which resolves the speculative attempts
+type mockSpeculativeRepo struct {
+	repository.EngineRepository
+
+	stepRuns *mockSpeculativeStepRunRepo
+}
+
+func (m *mockSpeculativeRepo) StepRun() repository.StepRunEngineRepository {
+	return m.stepRuns
+}
+
+type mockSpeculativeStepRunRepo struct {
+	repository.StepRunEngineRepository
+	mock.Mock
+}
+
+func (m *mockSpeculativeStepRunRepo) ResolveSpeculativeAttempt(ctx context.Context, tenantId, stepRunId string, retryCount int32, workerId string, succeeded bool) (*repository.ResolveSpeculativeAttemptResult, error) {
+	args := m.Called(ctx, tenantId, stepRunId, retryCount, workerId, succeeded)
+	res, _ := args.Get(0).(*repository.ResolveSpeculativeAttemptResult)
+	return res, args.Error(1)
+}
+
+func TestResolveSpeculativeAttempt(t *testing.T) {
+	tenantId := uuid.New().String()
+	stepRunId := uuid.New().String()
+	workerId := uuid.New().String()
+	retryCount := int32(2)
+
+	t.Run("results which don't identify the attempt are processed as usual", func(t *testing.T) {
+		stepRuns := &mockSpeculativeStepRunRepo{}
+		jc := &JobsControllerImpl{repo: &mockSpeculativeRepo{stepRuns: stepRuns}}
+
+		for _, tt := range []struct {
+			workerId   string
+			retryCount *int32
+		}{
+			{workerId: "", retryCount: &retryCount},
+			{workerId: workerId, retryCount: nil},
+		} {
+			res, err := jc.resolveSpeculativeAttempt(context.Background(), tenantId, stepRunId, tt.retryCount, tt.workerId, true)
+			require.NoError(t, err)
+			assert.Equal(t, repository.SpeculativeAttemptNone, res.Resolution)
+		}
+
+		stepRuns.AssertNotCalled(t, "ResolveSpeculativeAttempt", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("results of an attempt are resolved by the repository", func(t *testing.T) {
+		stepRuns := &mockSpeculativeStepRunRepo{}
+		jc := &JobsControllerImpl{repo: &mockSpeculativeRepo{stepRuns: stepRuns}}
+
+		expected := &repository.ResolveSpeculativeAttemptResult{
+			Resolution:    repository.SpeculativeAttemptWon,
+			LoserWorkerId: uuid.New().String(),
+		}
+
+		stepRuns.On("ResolveSpeculativeAttempt", mock.Anything, tenantId, stepRunId, retryCount, workerId, false).Return(expected, nil).Once()
+
+		res, err := jc.resolveSpeculativeAttempt(context.Background(), tenantId, stepRunId, &retryCount, workerId, false)
+		require.NoError(t, err)
+		assert.Equal(t, expected, res)
+
+		stepRuns.AssertExpectations(t)
+	})
+
+	t.Run("errors are returned so the result is retried", func(t *testing.T) {
+		stepRuns := &mockSpeculativeStepRunRepo{}
+		jc := &JobsControllerImpl{repo: &mockSpeculativeRepo{stepRuns: stepRuns}}
+
+		errResolve := errors.New("resolve failed")
+
+		stepRuns.On("ResolveSpeculativeAttempt", mock.Anything, tenantId, stepRunId, retryCount, workerId, true).Return(nil, errResolve).Once()
+
+		_, err := jc.resolveSpeculativeAttempt(context.Background(), tenantId, stepRunId, &retryCount, workerId, true)
+		assert.ErrorIs(t, err, errResolve)
+	})
+}
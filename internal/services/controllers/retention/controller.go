@@ -10,7 +10,9 @@ import (
 
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/integrations/alerting"
+	"github.com/hatchet-dev/hatchet/internal/integrations/blobstore"
 	"github.com/hatchet-dev/hatchet/internal/services/partition"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
 	hatcheterrors "github.com/hatchet-dev/hatchet/pkg/errors"
 	"github.com/hatchet-dev/hatchet/pkg/logger"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
@@ -31,6 +33,9 @@ type RetentionControllerImpl struct {
 	dataRetention   bool
 	workerRetention bool
 	queueRetention  bool
+	retention       server.RetentionConfigFile
+	pruneWindow     *pruneWindow
+	blobs           *blobstore.Offloader
 }
 
 type RetentionControllerOpt func(*RetentionControllerOpts)
@@ -45,6 +50,8 @@ type RetentionControllerOpts struct {
 	dataRetention   bool
 	workerRetention bool
 	queueRetention  bool
+	retention       server.RetentionConfigFile
+	blobs           *blobstore.Offloader
 }
 
 func defaultRetentionControllerOpts() *RetentionControllerOpts {
@@ -58,6 +65,11 @@ func defaultRetentionControllerOpts() *RetentionControllerOpts {
 		dataRetention:   true,
 		queueRetention:  true,
 		workerRetention: false,
+		retention: server.RetentionConfigFile{
+			PruneAfter:       24 * time.Hour,
+			PruneBatchSize:   1000,
+			ArchiveBatchSize: 100,
+		},
 	}
 }
 
@@ -109,6 +121,18 @@ func WithWorkerRetention(b bool) RetentionControllerOpt {
 	}
 }
 
+func WithRetentionConfig(cf server.RetentionConfigFile) RetentionControllerOpt {
+	return func(opts *RetentionControllerOpts) {
+		opts.retention = cf
+	}
+}
+
+func WithBlobOffloader(blobs *blobstore.Offloader) RetentionControllerOpt {
+	return func(opts *RetentionControllerOpts) {
+		opts.blobs = blobs
+	}
+}
+
 func New(fs ...RetentionControllerOpt) (*RetentionControllerImpl, error) {
 	opts := defaultRetentionControllerOpts()
 
@@ -128,6 +152,30 @@ func New(fs ...RetentionControllerOpt) (*RetentionControllerImpl, error) {
 		return nil, fmt.Errorf("partition is required. use WithPartition")
 	}
 
+	if opts.retention.PruneBatchSize <= 0 {
+		return nil, fmt.Errorf("prune batch size must be greater than 0")
+	}
+
+	if opts.retention.Archive && opts.blobs == nil {
+		return nil, fmt.Errorf("archiving pruned data requires blob storage. use WithBlobOffloader")
+	}
+
+	if opts.retention.ArchiveRunsAfter > 0 {
+		if opts.blobs == nil {
+			return nil, fmt.Errorf("archiving workflow runs requires blob storage. use WithBlobOffloader")
+		}
+
+		if opts.retention.ArchiveBatchSize <= 0 {
+			return nil, fmt.Errorf("archive batch size must be greater than 0")
+		}
+	}
+
+	window, err := parsePruneWindow(opts.retention.PruneWindow)
+
+	if err != nil {
+		return nil, err
+	}
+
 	s, err := gocron.NewScheduler(gocron.WithLocation(time.UTC))
 
 	if err != nil {
@@ -151,6 +199,9 @@ func New(fs ...RetentionControllerOpt) (*RetentionControllerImpl, error) {
 		dataRetention:   opts.dataRetention,
 		workerRetention: opts.workerRetention,
 		queueRetention:  opts.queueRetention,
+		retention:       opts.retention,
+		pruneWindow:     window,
+		blobs:           opts.blobs,
 	}, nil
 }
 
@@ -159,6 +210,24 @@ func (rc *RetentionControllerImpl) Start() (func() error, error) {
 
 	ctx, cancel := context.WithCancel(context.Background())
 
+	// the partitions of the next days are created even if retention is disabled, otherwise the rows of these days
+	// can't be inserted
+	partitionInterval := time.Hour
+
+	_, err := rc.s.NewJob(
+		gocron.DurationJob(partitionInterval),
+		gocron.NewTask(
+			rc.runMaintainPartitions(ctx),
+		),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+		gocron.WithStartAt(gocron.WithStartImmediately()),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not set up runMaintainPartitions: %w", err)
+	}
+
 	if rc.dataRetention {
 		dataInterval := time.Second * 60 // run every 60 seconds
 
@@ -209,6 +278,60 @@ func (rc *RetentionControllerImpl) Start() (func() error, error) {
 			cancel()
 			return nil, fmt.Errorf("could not set up runDeleteExpiredJobRuns: %w", err)
 		}
+
+		_, err = rc.s.NewJob(
+			gocron.DurationJob(dataInterval),
+			gocron.NewTask(
+				rc.runDeleteExpiredDeadLetterQueueItems(ctx),
+			),
+		)
+
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not set up runDeleteExpiredDeadLetterQueueItems: %w", err)
+		}
+
+		_, err = rc.s.NewJob(
+			gocron.DurationJob(dataInterval),
+			gocron.NewTask(
+				rc.runDeleteExpiredLogLines(ctx),
+			),
+		)
+
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not set up runDeleteExpiredLogLines: %w", err)
+		}
+
+		pruneInterval := time.Minute * 5
+
+		_, err = rc.s.NewJob(
+			gocron.DurationJob(pruneInterval),
+			gocron.NewTask(
+				rc.runPruneDeletedData(ctx),
+			),
+			gocron.WithSingletonMode(gocron.LimitModeReschedule),
+		)
+
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not set up runPruneDeletedData: %w", err)
+		}
+
+		if rc.retention.ArchiveRunsAfter > 0 {
+			_, err = rc.s.NewJob(
+				gocron.DurationJob(pruneInterval),
+				gocron.NewTask(
+					rc.runArchiveWorkflowRuns(ctx),
+				),
+				gocron.WithSingletonMode(gocron.LimitModeReschedule),
+			)
+
+			if err != nil {
+				cancel()
+				return nil, fmt.Errorf("could not set up runArchiveWorkflowRuns: %w", err)
+			}
+		}
 	}
 
 	if rc.workerRetention {
@@ -265,6 +388,102 @@ func (rc *RetentionControllerImpl) Start() (func() error, error) {
 			cancel()
 			return nil, fmt.Errorf("could not set up runDeleteRetryQueueItems: %w", err)
 		}
+
+		_, err = rc.s.NewJob(
+			gocron.DurationJob(queueInterval),
+			gocron.NewTask(
+				rc.runDeleteExpiredRateLimits(ctx),
+			),
+		)
+
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not set up runDeleteExpiredRateLimits: %w", err)
+		}
+
+		_, err = rc.s.NewJob(
+			gocron.DurationJob(queueInterval),
+			gocron.NewTask(
+				rc.runDeleteExpiredIdempotencyKeys(ctx),
+			),
+		)
+
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not set up runDeleteExpiredIdempotencyKeys: %w", err)
+		}
+
+		_, err = rc.s.NewJob(
+			gocron.DurationJob(queueInterval),
+			gocron.NewTask(
+				rc.runDeleteExpiredEventDedupKeys(ctx),
+			),
+		)
+
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not set up runDeleteExpiredEventDedupKeys: %w", err)
+		}
+
+		_, err = rc.s.NewJob(
+			gocron.DurationJob(queueInterval),
+			gocron.NewTask(
+				rc.runDeleteExpiredStepRunCache(ctx),
+			),
+		)
+
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not set up runDeleteExpiredStepRunCache: %w", err)
+		}
+
+		_, err = rc.s.NewJob(
+			gocron.DurationJob(queueInterval),
+			gocron.NewTask(
+				rc.runDeleteExpiredWorkflowRunEventLogs(ctx),
+			),
+		)
+
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not set up runDeleteExpiredWorkflowRunEventLogs: %w", err)
+		}
+
+		_, err = rc.s.NewJob(
+			gocron.DurationJob(queueInterval),
+			gocron.NewTask(
+				rc.runDeleteExpiredQueueMetricsSnapshots(ctx),
+			),
+		)
+
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not set up runDeleteExpiredQueueMetricsSnapshots: %w", err)
+		}
+
+		_, err = rc.s.NewJob(
+			gocron.DurationJob(queueInterval),
+			gocron.NewTask(
+				rc.runDeleteExpiredStepRunAcks(ctx),
+			),
+		)
+
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not set up runDeleteExpiredStepRunAcks: %w", err)
+		}
+
+		_, err = rc.s.NewJob(
+			gocron.DurationJob(queueInterval),
+			gocron.NewTask(
+				rc.runDeleteExpiredStepRunHeartbeats(ctx),
+			),
+		)
+
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("could not set up runDeleteExpiredStepRunHeartbeats: %w", err)
+		}
 	}
 
 	rc.s.Start()
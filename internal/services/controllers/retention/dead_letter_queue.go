@@ -0,0 +1,46 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (rc *RetentionControllerImpl) runDeleteExpiredDeadLetterQueueItems(ctx context.Context) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+		defer cancel()
+
+		rc.l.Debug().Msgf("retention controller: deleting expired dead-letter queue items")
+
+		err := rc.ForTenants(ctx, rc.runDeleteExpiredDeadLetterQueueItemsTenant)
+
+		if err != nil {
+			rc.l.Err(err).Msg("could not run delete expired dead-letter queue items")
+		}
+	}
+}
+
+func (rc *RetentionControllerImpl) runDeleteExpiredDeadLetterQueueItemsTenant(ctx context.Context, tenant dbsqlc.Tenant) error {
+	ctx, span := telemetry.NewSpan(ctx, "delete-expired-dead-letter-queue-items-tenant")
+	defer span.End()
+
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	createdBefore, err := GetDataRetentionExpiredTime(tenant.DataRetentionPeriod)
+
+	if err != nil {
+		return fmt.Errorf("could not get data retention expired time: %w", err)
+	}
+
+	_, err = rc.repo.DeadLetterQueue().DeleteDeadLetterQueueItems(ctx, tenantId, &repository.DeleteDeadLetterQueueItemsOpts{
+		CreatedBefore: &createdBefore,
+	})
+
+	return err
+}
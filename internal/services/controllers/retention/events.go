@@ -45,7 +45,13 @@ func (wc *RetentionControllerImpl) runDeleteExpiredEventsTenant(ctx context.Cont
 
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
 
-	createdBefore, err := GetDataRetentionExpiredTime(tenant.DataRetentionPeriod)
+	_, retentionPeriod, err := wc.retentionPeriods(ctx, tenant)
+
+	if err != nil {
+		return err
+	}
+
+	createdBefore, err := GetDataRetentionExpiredTime(retentionPeriod)
 
 	if err != nil {
 		return fmt.Errorf("could not get data retention expired time: %w", err)
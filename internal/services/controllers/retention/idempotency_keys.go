@@ -0,0 +1,33 @@
+package retention
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (rc *RetentionControllerImpl) runDeleteExpiredIdempotencyKeys(ctx context.Context) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+		defer cancel()
+
+		rc.l.Debug().Msgf("retention controller: deleting expired idempotency keys")
+
+		err := rc.ForTenants(ctx, rc.runDeleteExpiredIdempotencyKeysTenant)
+
+		if err != nil {
+			rc.l.Err(err).Msg("could not run delete expired idempotency keys")
+		}
+	}
+}
+
+func (rc *RetentionControllerImpl) runDeleteExpiredIdempotencyKeysTenant(ctx context.Context, tenant dbsqlc.Tenant) error {
+	ctx, span := telemetry.NewSpan(ctx, "delete-expired-idempotency-keys-tenant")
+	defer span.End()
+
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+	return rc.repo.WorkflowRun().DeleteExpiredIdempotencyKeys(ctx, tenantId)
+}
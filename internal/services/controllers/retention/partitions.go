@@ -0,0 +1,227 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/internal/metrics"
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// partitionDaysAhead is the number of days after today for which partitions are created, so that rows can still be
+// inserted if the engines don't run for a while
+const partitionDaysAhead = 7
+
+// the table labels of the partitioned tables in the metrics
+var partitionedTableLabels = map[repository.PartitionedTable]string{
+	repository.PartitionedTableStepRun:   prunedStepRuns,
+	repository.PartitionedTableEvent:     prunedEvents,
+	repository.PartitionedTableQueueItem: "queue_items",
+}
+
+func (rc *RetentionControllerImpl) runMaintainPartitions(ctx context.Context) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(ctx, 30*time.Minute)
+		defer cancel()
+
+		rc.l.Debug().Msgf("retention controller: maintaining partitions")
+
+		acquired, err := rc.repo.TablePartition().WithMaintenanceLock(ctx, rc.maintainPartitions)
+
+		if err != nil {
+			rc.l.Err(err).Msg("could not maintain partitions")
+			return
+		}
+
+		if !acquired {
+			rc.l.Debug().Msg("partitions are maintained by another engine")
+		}
+	}
+}
+
+func (rc *RetentionControllerImpl) maintainPartitions(ctx context.Context) error {
+	ctx, span := telemetry.NewSpan(ctx, "maintain-partitions")
+	defer span.End()
+
+	for _, table := range repository.PartitionedTables {
+		partitions, err := rc.repo.TablePartition().ListPartitions(ctx, table)
+
+		if err != nil {
+			return err
+		}
+
+		if err := rc.createPartitions(ctx, table, partitions); err != nil {
+			return err
+		}
+
+		if !rc.dropsPartitions(table) || !rc.inPruneWindow() {
+			continue
+		}
+
+		if err := rc.dropPartitions(ctx, table, partitions); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dropsPartitions returns whether the partitions of the table are dropped, which only happens if the retention of the
+// data in the table is enabled.
+func (rc *RetentionControllerImpl) dropsPartitions(table repository.PartitionedTable) bool {
+	if table == repository.PartitionedTableQueueItem {
+		return rc.queueRetention
+	}
+
+	return rc.dataRetention
+}
+
+// createPartitions creates the daily partitions of the table which don't exist, up to partitionDaysAhead days after
+// today.
+func (rc *RetentionControllerImpl) createPartitions(ctx context.Context, table repository.PartitionedTable, partitions []*repository.TablePartition) error {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	day := today
+
+	if len(partitions) > 0 && partitions[len(partitions)-1].RangeEnd.After(day) {
+		day = partitions[len(partitions)-1].RangeEnd
+	}
+
+	for ; !day.After(today.AddDate(0, 0, partitionDaysAhead)); day = day.AddDate(0, 0, 1) {
+		if err := rc.repo.TablePartition().CreatePartition(ctx, table, day); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dropPartitions drops the partitions of the table which have ended and have no live rows, oldest first. It stops at
+// the first partition which can't be dropped, so that the rows created before the end of a partition are always in
+// the partition.
+func (rc *RetentionControllerImpl) dropPartitions(ctx context.Context, table repository.PartitionedTable, partitions []*repository.TablePartition) error {
+	now := time.Now().UTC()
+	deletedBefore := now.Add(-rc.retention.PruneAfter)
+
+	for _, partition := range partitions {
+		if partition.RangeEnd.After(now) {
+			return nil
+		}
+
+		// queue items aren't soft-deleted, so their partitions are kept for the prune delay after they end
+		if table == repository.PartitionedTableQueueItem && partition.RangeEnd.After(deletedBefore) {
+			return nil
+		}
+
+		live, err := rc.repo.TablePartition().HasLiveRows(ctx, table, partition.RangeEnd, deletedBefore)
+
+		if err != nil {
+			return fmt.Errorf("could not check the rows of partition %s: %w", partition.Name, err)
+		}
+
+		if live {
+			return nil
+		}
+
+		var counts map[string]int
+
+		switch table {
+		case repository.PartitionedTableStepRun:
+			counts, err = rc.deleteStepRunDependencies(ctx, partition)
+		case repository.PartitionedTableEvent:
+			counts, err = rc.deleteEventDependencies(ctx, partition)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := rc.repo.TablePartition().DropPartition(ctx, table, partition.Name); err != nil {
+			return err
+		}
+
+		rc.l.Info().Msgf("retention controller: dropped partition %s", partition.Name)
+
+		metrics.RetentionDroppedPartitions.WithLabelValues(partitionedTableLabels[table]).Inc()
+
+		for tenantId, count := range counts {
+			metrics.RetentionPrunedRows.WithLabelValues(tenantId, partitionedTableLabels[table]).Add(float64(count))
+		}
+	}
+
+	return nil
+}
+
+// deleteStepRunDependencies deletes the rows which reference the step runs of the partition in batches, and returns
+// the number of step runs of the partition per tenant.
+func (rc *RetentionControllerImpl) deleteStepRunDependencies(ctx context.Context, partition *repository.TablePartition) (map[string]int, error) {
+	counts := make(map[string]int)
+	afterId := uuid.Nil.String()
+
+	for {
+		stepRuns, err := rc.repo.TablePartition().DeleteStepRunDependencies(ctx, partition.RangeEnd, afterId, rc.retention.PruneBatchSize)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not delete the dependencies of the step runs of partition %s: %w", partition.Name, err)
+		}
+
+		for _, stepRun := range stepRuns {
+			counts[sqlchelpers.UUIDToStr(stepRun.TenantId)]++
+		}
+
+		if len(stepRuns) < rc.retention.PruneBatchSize {
+			return counts, nil
+		}
+
+		afterId = sqlchelpers.UUIDToStr(stepRuns[len(stepRuns)-1].ID)
+	}
+}
+
+// deleteEventDependencies deletes the rows which reference the events of the partition in batches and archives the
+// events if archiving is enabled, and returns the number of events of the partition per tenant.
+func (rc *RetentionControllerImpl) deleteEventDependencies(ctx context.Context, partition *repository.TablePartition) (map[string]int, error) {
+	counts := make(map[string]int)
+	afterId := uuid.Nil.String()
+
+	for {
+		events, err := rc.repo.TablePartition().DeleteEventDependencies(ctx, partition.RangeEnd, afterId, rc.retention.PruneBatchSize)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not delete the dependencies of the events of partition %s: %w", partition.Name, err)
+		}
+
+		records := make(map[string][]*archivedEvent)
+
+		for _, event := range events {
+			tenantId := sqlchelpers.UUIDToStr(event.TenantId)
+
+			counts[tenantId]++
+
+			records[tenantId] = append(records[tenantId], &archivedEvent{
+				Id:                 sqlchelpers.UUIDToStr(event.ID),
+				Key:                event.Key,
+				AdditionalMetadata: event.AdditionalMetadata,
+				CreatedAt:          event.CreatedAt.Time.UTC(),
+				DeletedAt:          event.DeletedAt.Time.UTC(),
+			})
+		}
+
+		if rc.retention.Archive {
+			for tenantId, tenantRecords := range records {
+				if err := archive(ctx, rc, tenantId, prunedEvents, tenantRecords); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if len(events) < rc.retention.PruneBatchSize {
+			return counts, nil
+		}
+
+		afterId = sqlchelpers.UUIDToStr(events[len(events)-1].ID)
+	}
+}
@@ -0,0 +1,265 @@
+package retention
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/blobstore"
+	"github.com/hatchet-dev/hatchet/internal/metrics"
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// the tables which are pruned, which are used as the table label of the metrics and in the keys of the archives. Step
+// runs and events are pruned by dropping the partitions of the tables.
+const (
+	prunedStepRuns     = "step_runs"
+	prunedWorkflowRuns = "workflow_runs"
+	prunedEvents       = "events"
+)
+
+// pruneWindow is a daily window in UTC in which soft-deleted data is pruned. The window wraps around midnight if it
+// ends before it starts.
+type pruneWindow struct {
+	start time.Duration
+	end   time.Duration
+}
+
+// parsePruneWindow parses a window like 01:00-05:00, it returns nil if the window is empty.
+func parsePruneWindow(window string) (*pruneWindow, error) {
+	if window == "" {
+		return nil, nil
+	}
+
+	start, end, ok := strings.Cut(window, "-")
+
+	if !ok {
+		return nil, fmt.Errorf("invalid prune window %q: expected a window like 01:00-05:00", window)
+	}
+
+	res := &pruneWindow{}
+
+	for _, part := range []struct {
+		value string
+		dest  *time.Duration
+	}{
+		{start, &res.start},
+		{end, &res.end},
+	} {
+		t, err := time.Parse("15:04", strings.TrimSpace(part.value))
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid prune window %q: %w", window, err)
+		}
+
+		*part.dest = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	}
+
+	if res.start == res.end {
+		return nil, fmt.Errorf("invalid prune window %q: the window is empty", window)
+	}
+
+	return res, nil
+}
+
+func (w *pruneWindow) contains(t time.Time) bool {
+	t = t.UTC()
+	sinceMidnight := t.Sub(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC))
+
+	if w.start < w.end {
+		return sinceMidnight >= w.start && sinceMidnight < w.end
+	}
+
+	return sinceMidnight >= w.start || sinceMidnight < w.end
+}
+
+func (rc *RetentionControllerImpl) inPruneWindow() bool {
+	return rc.pruneWindow == nil || rc.pruneWindow.contains(time.Now())
+}
+
+func (rc *RetentionControllerImpl) runPruneDeletedData(ctx context.Context) func() {
+	return func() {
+		if !rc.inPruneWindow() {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, 4*time.Minute)
+		defer cancel()
+
+		rc.l.Debug().Msgf("retention controller: pruning deleted data")
+
+		err := rc.ForTenants(ctx, rc.runPruneDeletedDataTenant)
+
+		if err != nil {
+			rc.l.Err(err).Msg("could not prune deleted data")
+		}
+	}
+}
+
+func (rc *RetentionControllerImpl) runPruneDeletedDataTenant(ctx context.Context, tenant dbsqlc.Tenant) error {
+	ctx, span := telemetry.NewSpan(ctx, "prune-deleted-data")
+	defer span.End()
+
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+	deletedBefore := time.Now().UTC().Add(-rc.retention.PruneAfter)
+	limit := rc.retention.PruneBatchSize
+
+	var onPruned func(runs []*dbsqlc.PruneWorkflowRunsRow) error
+
+	if rc.retention.Archive {
+		onPruned = func(runs []*dbsqlc.PruneWorkflowRunsRow) error {
+			records := make([]*archivedWorkflowRun, 0, len(runs))
+
+			for _, run := range runs {
+				records = append(records, &archivedWorkflowRun{
+					Id:                 sqlchelpers.UUIDToStr(run.ID),
+					WorkflowVersionId:  sqlchelpers.UUIDToStr(run.WorkflowVersionId),
+					Status:             string(run.Status),
+					DisplayName:        textPtr(run.DisplayName),
+					Input:              run.Input,
+					Output:             run.Output,
+					Error:              textPtr(run.Error),
+					AdditionalMetadata: run.AdditionalMetadata,
+					CreatedAt:          run.CreatedAt.Time.UTC(),
+					StartedAt:          timePtr(run.StartedAt),
+					FinishedAt:         timePtr(run.FinishedAt),
+					DeletedAt:          run.DeletedAt.Time.UTC(),
+					DetailsKey:         textPtr(run.ArchiveKey),
+				})
+			}
+
+			return archive(ctx, rc, tenantId, prunedWorkflowRuns, records)
+		}
+	} else if rc.blobs != nil {
+		// the archives of the details of the runs are deleted with the runs. The runs were soft-deleted, so an
+		// archive which is deleted before the deletion of its run is rolled back is never read.
+		onPruned = func(runs []*dbsqlc.PruneWorkflowRunsRow) error {
+			for _, run := range runs {
+				if !run.ArchiveKey.Valid {
+					continue
+				}
+
+				err := rc.blobs.Store.Delete(ctx, run.ArchiveKey.String)
+
+				if err != nil && !errors.Is(err, blobstore.ErrNotFound) {
+					return fmt.Errorf("could not delete archive %s: %w", run.ArchiveKey.String, err)
+				}
+			}
+
+			return nil
+		}
+	}
+
+	return rc.prune(ctx, tenantId, prunedWorkflowRuns, func(ctx context.Context) (int, error) {
+		return rc.repo.RetentionPolicy().PruneWorkflowRuns(ctx, tenantId, deletedBefore, limit, onPruned)
+	})
+}
+
+// prune deletes batches of a table until there are no rows left to delete, the context is done or the prune window
+// has ended.
+func (rc *RetentionControllerImpl) prune(ctx context.Context, tenantId, table string, pruneBatch func(ctx context.Context) (int, error)) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		if !rc.inPruneWindow() {
+			return nil
+		}
+
+		count, err := pruneBatch(ctx)
+
+		if err != nil {
+			return fmt.Errorf("could not prune %s: %w", table, err)
+		}
+
+		if count > 0 {
+			metrics.RetentionPrunedRows.WithLabelValues(tenantId, table).Add(float64(count))
+		}
+
+		if count < rc.retention.PruneBatchSize {
+			return nil
+		}
+	}
+}
+
+// archivedWorkflowRun is a line of an archive of pruned workflow runs.
+type archivedWorkflowRun struct {
+	Id                 string          `json:"id"`
+	WorkflowVersionId  string          `json:"workflowVersionId"`
+	Status             string          `json:"status"`
+	DisplayName        *string         `json:"displayName,omitempty"`
+	Input              json.RawMessage `json:"input,omitempty"`
+	Output             json.RawMessage `json:"output,omitempty"`
+	Error              *string         `json:"error,omitempty"`
+	AdditionalMetadata json.RawMessage `json:"additionalMetadata,omitempty"`
+	CreatedAt          time.Time       `json:"createdAt"`
+	StartedAt          *time.Time      `json:"startedAt,omitempty"`
+	FinishedAt         *time.Time      `json:"finishedAt,omitempty"`
+	DeletedAt          time.Time       `json:"deletedAt"`
+
+	// DetailsKey is the key of the archive of the job runs, step runs and events of the run, if the run was archived
+	// before it was deleted
+	DetailsKey *string `json:"detailsKey,omitempty"`
+}
+
+// archivedEvent is a line of an archive of the events of a dropped partition. The payloads of events are cleared when
+// they're soft-deleted, so they aren't archived.
+type archivedEvent struct {
+	Id                 string          `json:"id"`
+	Key                string          `json:"key"`
+	AdditionalMetadata json.RawMessage `json:"additionalMetadata,omitempty"`
+	CreatedAt          time.Time       `json:"createdAt"`
+	DeletedAt          time.Time       `json:"deletedAt"`
+}
+
+// archive writes a batch of pruned rows to the blob storage as a JSONL file, with one row per line.
+func archive[T any](ctx context.Context, rc *RetentionControllerImpl, tenantId, table string, records []T) error {
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+
+	for _, record := range records {
+		if err := enc.Encode(record); err != nil {
+			return fmt.Errorf("could not encode archived row: %w", err)
+		}
+	}
+
+	key := fmt.Sprintf("%s/retention/%s/%s-%s.jsonl", tenantId, table, time.Now().UTC().Format("20060102T150405Z"), uuid.New().String())
+
+	if err := rc.blobs.Store.Put(ctx, key, buf.Bytes()); err != nil {
+		return fmt.Errorf("could not write archive %s: %w", key, err)
+	}
+
+	metrics.RetentionArchivedRows.WithLabelValues(tenantId, table).Add(float64(len(records)))
+
+	return nil
+}
+
+func textPtr(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+
+	return &t.String
+}
+
+func timePtr(t pgtype.Timestamp) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+
+	res := t.Time.UTC()
+
+	return &res
+}
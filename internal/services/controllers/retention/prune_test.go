@@ -0,0 +1,361 @@
+package retention
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/blobstore"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+type memoryStore struct {
+	blobs map[string][]byte
+}
+
+func (m *memoryStore) Put(ctx context.Context, key string, data []byte) error {
+	m.blobs[key] = data
+	return nil
+}
+
+func (m *memoryStore) Get(ctx context.Context, key string) ([]byte, error) {
+	data, ok := m.blobs[key]
+
+	if !ok {
+		return nil, blobstore.ErrNotFound
+	}
+
+	return data, nil
+}
+
+func (m *memoryStore) Delete(ctx context.Context, key string) error {
+	if _, ok := m.blobs[key]; !ok {
+		return blobstore.ErrNotFound
+	}
+
+	delete(m.blobs, key)
+	return nil
+}
+
+type failingStore struct {
+	blobstore.BlobStore
+}
+
+func (s *failingStore) Put(ctx context.Context, key string, data []byte) error {
+	return errors.New("unavailable")
+}
+
+// retentionPolicyRepository prunes the soft-deleted runs in batches, and keeps the runs of a batch if the batch
+// couldn't be archived
+type retentionPolicyRepository struct {
+	repository.RetentionPolicyRepository
+
+	policy  *dbsqlc.TenantRetentionPolicy
+	deleted []*dbsqlc.PruneWorkflowRunsRow
+	batches []int
+}
+
+func (r *retentionPolicyRepository) GetRetentionPolicy(ctx context.Context, tenantId string) (*dbsqlc.TenantRetentionPolicy, error) {
+	if r.policy == nil {
+		return nil, pgx.ErrNoRows
+	}
+
+	return r.policy, nil
+}
+
+func (r *retentionPolicyRepository) PruneWorkflowRuns(ctx context.Context, tenantId string, deletedBefore time.Time, limit int, archive func(runs []*dbsqlc.PruneWorkflowRunsRow) error) (int, error) {
+	batch := r.deleted[:min(limit, len(r.deleted))]
+
+	if archive != nil && len(batch) > 0 {
+		if err := archive(batch); err != nil {
+			return 0, err
+		}
+	}
+
+	r.deleted = r.deleted[len(batch):]
+	r.batches = append(r.batches, len(batch))
+
+	return len(batch), nil
+}
+
+type retentionEngineRepository struct {
+	repository.EngineRepository
+
+	retentionPolicies *retentionPolicyRepository
+}
+
+func (r *retentionEngineRepository) RetentionPolicy() repository.RetentionPolicyRepository {
+	return r.retentionPolicies
+}
+
+func newTestRetentionController(policies *retentionPolicyRepository, retention server.RetentionConfigFile, blobs *blobstore.Offloader) *RetentionControllerImpl {
+	l := zerolog.Nop()
+
+	return &RetentionControllerImpl{
+		l:         &l,
+		repo:      &retentionEngineRepository{retentionPolicies: policies},
+		retention: retention,
+		blobs:     blobs,
+	}
+}
+
+func deletedWorkflowRun(archiveKey string) *dbsqlc.PruneWorkflowRunsRow {
+	now := time.Now().UTC()
+
+	run := &dbsqlc.PruneWorkflowRunsRow{
+		ID:                sqlchelpers.UUIDFromStr(uuid.New().String()),
+		WorkflowVersionId: sqlchelpers.UUIDFromStr(uuid.New().String()),
+		Status:            dbsqlc.WorkflowRunStatusSUCCEEDED,
+		Input:             []byte(`{"orderId":"1"}`),
+		CreatedAt:         sqlchelpers.TimestampFromTime(now.Add(-time.Hour)),
+		FinishedAt:        sqlchelpers.TimestampFromTime(now.Add(-time.Minute)),
+		DeletedAt:         sqlchelpers.TimestampFromTime(now.Add(-48 * time.Hour)),
+	}
+
+	if archiveKey != "" {
+		run.ArchiveKey = sqlchelpers.TextFromStr(archiveKey)
+	}
+
+	return run
+}
+
+func TestParsePruneWindow(t *testing.T) {
+	tests := []struct {
+		name     string
+		window   string
+		expected *pruneWindow
+		invalid  bool
+	}{
+		{
+			name: "no window",
+		},
+		{
+			name:     "window",
+			window:   "01:00-05:30",
+			expected: &pruneWindow{start: time.Hour, end: 5*time.Hour + 30*time.Minute},
+		},
+		{
+			name:     "window around midnight",
+			window:   "22:00 - 02:00",
+			expected: &pruneWindow{start: 22 * time.Hour, end: 2 * time.Hour},
+		},
+		{
+			name:    "window without an end",
+			window:  "01:00",
+			invalid: true,
+		},
+		{
+			name:    "invalid time",
+			window:  "25:00-02:00",
+			invalid: true,
+		},
+		{
+			name:    "empty window",
+			window:  "01:00-01:00",
+			invalid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			window, err := parsePruneWindow(tt.window)
+
+			if tt.invalid {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, window)
+		})
+	}
+}
+
+func TestPruneWindowContains(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2025, 1, 20, hour, minute, 0, 0, time.UTC)
+	}
+
+	window := &pruneWindow{start: time.Hour, end: 5 * time.Hour}
+
+	assert.True(t, window.contains(at(1, 0)))
+	assert.True(t, window.contains(at(4, 59)))
+	assert.False(t, window.contains(at(5, 0)))
+	assert.False(t, window.contains(at(0, 59)))
+
+	// the window is in UTC
+	assert.True(t, window.contains(at(2, 0).In(time.FixedZone("UTC+8", 8*60*60))))
+
+	aroundMidnight := &pruneWindow{start: 22 * time.Hour, end: 2 * time.Hour}
+
+	assert.True(t, aroundMidnight.contains(at(23, 0)))
+	assert.True(t, aroundMidnight.contains(at(0, 0)))
+	assert.True(t, aroundMidnight.contains(at(1, 59)))
+	assert.False(t, aroundMidnight.contains(at(2, 0)))
+	assert.False(t, aroundMidnight.contains(at(12, 0)))
+}
+
+func TestRunPruneDeletedDataTenant(t *testing.T) {
+	tenant := dbsqlc.Tenant{ID: sqlchelpers.UUIDFromStr(uuid.New().String())}
+	retention := server.RetentionConfigFile{PruneAfter: 24 * time.Hour, PruneBatchSize: 2}
+
+	t.Run("prunes in batches", func(t *testing.T) {
+		policies := &retentionPolicyRepository{
+			deleted: []*dbsqlc.PruneWorkflowRunsRow{deletedWorkflowRun(""), deletedWorkflowRun(""), deletedWorkflowRun("")},
+		}
+
+		rc := newTestRetentionController(policies, retention, nil)
+
+		require.NoError(t, rc.runPruneDeletedDataTenant(context.Background(), tenant))
+
+		assert.Equal(t, []int{2, 1}, policies.batches)
+		assert.Empty(t, policies.deleted)
+	})
+
+	t.Run("archives the pruned runs", func(t *testing.T) {
+		policies := &retentionPolicyRepository{
+			deleted: []*dbsqlc.PruneWorkflowRunsRow{deletedWorkflowRun("details"), deletedWorkflowRun("")},
+		}
+
+		store := &memoryStore{blobs: map[string][]byte{}}
+		archiveRetention := retention
+		archiveRetention.Archive = true
+
+		rc := newTestRetentionController(policies, archiveRetention, &blobstore.Offloader{Store: store})
+
+		require.NoError(t, rc.runPruneDeletedDataTenant(context.Background(), tenant))
+		require.Len(t, store.blobs, 1)
+
+		for key, data := range store.blobs {
+			assert.Contains(t, key, sqlchelpers.UUIDToStr(tenant.ID)+"/retention/workflow_runs/")
+
+			// the archive has a line per run
+			var records []archivedWorkflowRun
+
+			scanner := bufio.NewScanner(bytes.NewReader(data))
+
+			for scanner.Scan() {
+				var record archivedWorkflowRun
+				require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+				records = append(records, record)
+			}
+
+			require.Len(t, records, 2)
+			assert.Equal(t, "SUCCEEDED", records[0].Status)
+			assert.JSONEq(t, `{"orderId":"1"}`, string(records[0].Input))
+			assert.Equal(t, "details", *records[0].DetailsKey)
+			assert.NotNil(t, records[0].FinishedAt)
+			assert.Nil(t, records[0].StartedAt)
+			assert.Nil(t, records[1].DetailsKey)
+		}
+	})
+
+	t.Run("keeps the runs which couldn't be archived", func(t *testing.T) {
+		policies := &retentionPolicyRepository{
+			deleted: []*dbsqlc.PruneWorkflowRunsRow{deletedWorkflowRun("")},
+		}
+
+		archiveRetention := retention
+		archiveRetention.Archive = true
+
+		rc := newTestRetentionController(policies, archiveRetention, &blobstore.Offloader{Store: &failingStore{}})
+
+		assert.Error(t, rc.runPruneDeletedDataTenant(context.Background(), tenant))
+		assert.Len(t, policies.deleted, 1)
+	})
+
+	t.Run("deletes the archived details of the pruned runs", func(t *testing.T) {
+		store := &memoryStore{blobs: map[string][]byte{"details": []byte("{}"), "other": []byte("{}")}}
+
+		policies := &retentionPolicyRepository{
+			// the archive of the second run was already deleted
+			deleted: []*dbsqlc.PruneWorkflowRunsRow{deletedWorkflowRun("details"), deletedWorkflowRun("missing"), deletedWorkflowRun("")},
+		}
+
+		rc := newTestRetentionController(policies, retention, &blobstore.Offloader{Store: store})
+
+		require.NoError(t, rc.runPruneDeletedDataTenant(context.Background(), tenant))
+
+		assert.Empty(t, policies.deleted)
+		assert.Equal(t, map[string][]byte{"other": []byte("{}")}, store.blobs)
+	})
+
+	t.Run("doesn't prune outside of the prune window", func(t *testing.T) {
+		policies := &retentionPolicyRepository{
+			deleted: []*dbsqlc.PruneWorkflowRunsRow{deletedWorkflowRun("")},
+		}
+
+		rc := newTestRetentionController(policies, retention, nil)
+
+		// the window is the hour after the current hour, so it doesn't contain the current time
+		start := time.Duration(time.Now().UTC().Hour()+1) * time.Hour % (24 * time.Hour)
+		rc.pruneWindow = &pruneWindow{start: start, end: (start + time.Hour) % (24 * time.Hour)}
+
+		require.NoError(t, rc.runPruneDeletedDataTenant(context.Background(), tenant))
+
+		assert.Empty(t, policies.batches)
+		assert.Len(t, policies.deleted, 1)
+	})
+}
+
+func TestRetentionPeriods(t *testing.T) {
+	tenant := dbsqlc.Tenant{
+		ID:                  sqlchelpers.UUIDFromStr(uuid.New().String()),
+		DataRetentionPeriod: "720h",
+	}
+
+	tests := []struct {
+		name         string
+		policy       *dbsqlc.TenantRetentionPolicy
+		workflowRuns string
+		events       string
+	}{
+		{
+			name:         "no retention policy",
+			workflowRuns: "720h",
+			events:       "720h",
+		},
+		{
+			name: "retention policy",
+			policy: &dbsqlc.TenantRetentionPolicy{
+				WorkflowRunRetentionPeriod: sqlchelpers.TextFromStr("2160h"),
+				EventRetentionPeriod:       sqlchelpers.TextFromStr("24h"),
+			},
+			workflowRuns: "2160h",
+			events:       "24h",
+		},
+		{
+			name: "retention policy which only sets the retention period of events",
+			policy: &dbsqlc.TenantRetentionPolicy{
+				EventRetentionPeriod: sqlchelpers.TextFromStr("24h"),
+			},
+			workflowRuns: "720h",
+			events:       "24h",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rc := newTestRetentionController(&retentionPolicyRepository{policy: tt.policy}, server.RetentionConfigFile{}, nil)
+
+			workflowRuns, events, err := rc.retentionPeriods(context.Background(), tenant)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.workflowRuns, workflowRuns)
+			assert.Equal(t, tt.events, events)
+		})
+	}
+}
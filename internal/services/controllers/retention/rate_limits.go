@@ -0,0 +1,33 @@
+package retention
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (rc *RetentionControllerImpl) runDeleteExpiredRateLimits(ctx context.Context) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+		defer cancel()
+
+		rc.l.Debug().Msgf("retention controller: deleting expired rate limits")
+
+		err := rc.ForTenants(ctx, rc.runDeleteExpiredRateLimitsTenant)
+
+		if err != nil {
+			rc.l.Err(err).Msg("could not run delete expired rate limits")
+		}
+	}
+}
+
+func (rc *RetentionControllerImpl) runDeleteExpiredRateLimitsTenant(ctx context.Context, tenant dbsqlc.Tenant) error {
+	ctx, span := telemetry.NewSpan(ctx, "delete-expired-rate-limits-tenant")
+	defer span.End()
+
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+	return rc.repo.RateLimit().DeleteExpiredRateLimits(ctx, tenantId)
+}
@@ -2,12 +2,15 @@ package retention
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 )
 
 func GetDataRetentionExpiredTime(duration string) (time.Time, error) {
@@ -20,6 +23,31 @@ func GetDataRetentionExpiredTime(duration string) (time.Time, error) {
 	return time.Now().UTC().Add(-d), nil
 }
 
+// retentionPeriods returns the retention periods of the workflow runs and events of a tenant. The retention policy of
+// the tenant overrides the data retention period of the tenant.
+func (wc *RetentionControllerImpl) retentionPeriods(ctx context.Context, tenant dbsqlc.Tenant) (workflowRuns string, events string, err error) {
+	workflowRuns = tenant.DataRetentionPeriod
+	events = tenant.DataRetentionPeriod
+
+	policy, err := wc.repo.RetentionPolicy().GetRetentionPolicy(ctx, sqlchelpers.UUIDToStr(tenant.ID))
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return workflowRuns, events, nil
+	} else if err != nil {
+		return "", "", fmt.Errorf("could not get retention policy: %w", err)
+	}
+
+	if policy.WorkflowRunRetentionPeriod.Valid {
+		workflowRuns = policy.WorkflowRunRetentionPeriod.String
+	}
+
+	if policy.EventRetentionPeriod.Valid {
+		events = policy.EventRetentionPeriod.String
+	}
+
+	return workflowRuns, events, nil
+}
+
 func (wc *RetentionControllerImpl) ForTenants(ctx context.Context, f func(ctx context.Context, tenant dbsqlc.Tenant) error) error {
 
 	// list all tenants
@@ -0,0 +1,35 @@
+package retention
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (rc *RetentionControllerImpl) runDeleteExpiredStepRunHeartbeats(ctx context.Context) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+		defer cancel()
+
+		rc.l.Debug().Msgf("retention controller: deleting expired step run heartbeats")
+
+		err := rc.ForTenants(ctx, rc.runDeleteExpiredStepRunHeartbeatsTenant)
+
+		if err != nil {
+			rc.l.Err(err).Msg("could not run delete expired step run heartbeats")
+		}
+	}
+}
+
+func (rc *RetentionControllerImpl) runDeleteExpiredStepRunHeartbeatsTenant(ctx context.Context, tenant dbsqlc.Tenant) error {
+	ctx, span := telemetry.NewSpan(ctx, "delete-expired-step-run-heartbeats-tenant")
+	defer span.End()
+
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	return rc.repo.StepRun().DeleteExpiredStepRunHeartbeats(ctx, tenantId, time.Now().UTC().Add(-repository.StepRunHeartbeatRetention))
+}
@@ -0,0 +1,76 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/metrics"
+	"github.com/hatchet-dev/hatchet/internal/runarchive"
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (rc *RetentionControllerImpl) runArchiveWorkflowRuns(ctx context.Context) func() {
+	return func() {
+		// archiving runs in the prune window as well, since it deletes a lot of rows
+		if !rc.inPruneWindow() {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, 4*time.Minute)
+		defer cancel()
+
+		rc.l.Debug().Msgf("retention controller: archiving finished workflow runs")
+
+		err := rc.ForTenants(ctx, rc.runArchiveWorkflowRunsTenant)
+
+		if err != nil {
+			rc.l.Err(err).Msg("could not archive workflow runs")
+		}
+	}
+}
+
+func (rc *RetentionControllerImpl) runArchiveWorkflowRunsTenant(ctx context.Context, tenant dbsqlc.Tenant) error {
+	ctx, span := telemetry.NewSpan(ctx, "archive-workflow-runs")
+	defer span.End()
+
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+	finishedBefore := time.Now().UTC().Add(-rc.retention.ArchiveRunsAfter)
+
+	workflowRunIds, err := rc.repo.WorkflowRunArchive().ListWorkflowRunsToArchive(ctx, tenantId, finishedBefore, rc.retention.ArchiveBatchSize)
+
+	if err != nil {
+		return fmt.Errorf("could not list workflow runs to archive: %w", err)
+	}
+
+	write := func(details *repository.WorkflowRunDetails) (string, int, error) {
+		return runarchive.Write(ctx, rc.blobs.Store, details)
+	}
+
+	for _, workflowRunId := range workflowRunIds {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		if !rc.inPruneWindow() {
+			return nil
+		}
+
+		archived, err := rc.repo.WorkflowRunArchive().ArchiveWorkflowRun(ctx, tenantId, workflowRunId, write)
+
+		if err != nil {
+			return fmt.Errorf("could not archive workflow run %s: %w", workflowRunId, err)
+		}
+
+		if archived {
+			metrics.RetentionArchivedWorkflowRuns.WithLabelValues(tenantId).Inc()
+		}
+	}
+
+	return nil
+}
@@ -31,7 +31,13 @@ func (wc *RetentionControllerImpl) runDeleteExpiredWorkflowRunsTenant(ctx contex
 
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
 
-	createdBefore, err := GetDataRetentionExpiredTime(tenant.DataRetentionPeriod)
+	retentionPeriod, _, err := wc.retentionPeriods(ctx, tenant)
+
+	if err != nil {
+		return err
+	}
+
+	createdBefore, err := GetDataRetentionExpiredTime(retentionPeriod)
 
 	if err != nil {
 		return fmt.Errorf("could not get data retention expired time: %w", err)
@@ -563,6 +563,11 @@ func (wc *WorkflowsControllerImpl) bumpQueue(ctx context.Context, tenantId strin
 			err = wc.queueByCancelInProgress(ctx, tenantId, *groupKey, workflowVersion)
 		case dbsqlc.ConcurrencyLimitStrategyGROUPROUNDROBIN:
 			err = wc.queueByGroupRoundRobin(ctx, tenantId, workflowVersion)
+		case dbsqlc.ConcurrencyLimitStrategyQUEUENEWESTONLY:
+			if groupKey == nil {
+				return fmt.Errorf("group key is required for queue newest only strategy")
+			}
+			err = wc.queueByNewestOnly(ctx, tenantId, *groupKey, workflowVersion)
 		default:
 			return fmt.Errorf("unimplemented concurrency limit strategy: %s", workflowVersion.ConcurrencyLimitStrategy.ConcurrencyLimitStrategy)
 		}
@@ -736,6 +736,110 @@ func (wc *WorkflowsControllerImpl) queueByCancelInProgress(ctx context.Context,
 	return nil
 }
 
+// queueByNewestOnly keeps only the newest queued workflow run in a group, cancelling older queued runs
+// which were superseded by it. Unlike CANCEL_IN_PROGRESS, running workflow runs are never cancelled: the
+// newest run is queued once the group has fewer than maxRuns runs in progress.
+func (wc *WorkflowsControllerImpl) queueByNewestOnly(ctx context.Context, tenantId, groupKey string, workflowVersion *dbsqlc.GetWorkflowVersionForEngineRow) error {
+	ctx, span := telemetry.NewSpan(ctx, "queue-by-newest-only")
+	defer span.End()
+
+	wc.l.Info().Msgf("handling queue with strategy QUEUE_NEWEST_ONLY for %s", groupKey)
+
+	mutex := wc.getLock(fmt.Sprintf("%s:%s", tenantId, groupKey))
+
+	if ok := mutex.TryLock(); !ok {
+		return nil
+	}
+
+	defer mutex.Unlock()
+
+	running := db.WorkflowRunStatusRunning
+	queued := db.WorkflowRunStatusQueued
+	workflowVersionId := sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.ID)
+	maxRuns := int(workflowVersion.ConcurrencyMaxRuns.Int32)
+
+	runningWorkflowRuns, err := wc.repo.WorkflowRun().ListWorkflowRuns(ctx, tenantId, &repository.ListWorkflowRunsOpts{
+		WorkflowVersionId: &workflowVersionId,
+		GroupKey:          &groupKey,
+		Statuses:          &[]db.WorkflowRunStatus{running},
+	})
+	if err != nil {
+		return fmt.Errorf("could not list running workflow runs: %w", err)
+	}
+
+	queuedWorkflowRuns, err := wc.repo.WorkflowRun().ListWorkflowRuns(ctx, tenantId, &repository.ListWorkflowRunsOpts{
+		WorkflowVersionId: &workflowVersionId,
+		GroupKey:          &groupKey,
+		Statuses:          &[]db.WorkflowRunStatus{queued},
+		OrderBy:           repository.StringPtr("createdAt"),
+		OrderDirection:    repository.StringPtr("DESC"),
+	})
+	if err != nil {
+		return fmt.Errorf("could not list queued workflow runs: %w", err)
+	}
+
+	queuedIds := make([]string, 0, len(queuedWorkflowRuns.Rows))
+
+	for _, row := range queuedWorkflowRuns.Rows {
+		queuedIds = append(queuedIds, sqlchelpers.UUIDToStr(row.WorkflowRun.ID))
+	}
+
+	cancelIds, workflowRunId := planNewestOnly(queuedIds, len(runningWorkflowRuns.Rows), maxRuns)
+
+	errGroup := new(errgroup.Group)
+
+	for _, cancelId := range cancelIds {
+		errGroup.Go(func() error {
+			workflowRun, err := wc.repo.WorkflowRun().GetWorkflowRunById(ctx, tenantId, cancelId)
+			if err != nil {
+				return err
+			}
+			return wc.cancelWorkflowRunJobs(ctx, workflowRun, "QUEUE_NEWEST_ONLY")
+		})
+	}
+
+	if err := errGroup.Wait(); err != nil {
+		return fmt.Errorf("could not cancel workflow runs: %w", err)
+	}
+
+	if workflowRunId == "" {
+		return nil
+	}
+
+	workflowRun, err := wc.repo.WorkflowRun().GetWorkflowRunById(ctx, tenantId, workflowRunId)
+	if err != nil {
+		return fmt.Errorf("could not get workflow run: %w", err)
+	}
+
+	isPaused := workflowRun.IsPaused.Valid && workflowRun.IsPaused.Bool
+	if isPaused {
+		return nil
+	}
+
+	if err := wc.queueWorkflowRunJobs(ctx, workflowRun, isPaused); err != nil {
+		return fmt.Errorf("could not queue workflow run: %w", err)
+	}
+
+	return nil
+}
+
+// planNewestOnly returns the queued workflow runs of a group which are superseded by the newest one and are
+// cancelled, and the newest workflow run if it can be queued since the group has fewer than maxRuns runs in progress.
+// The queued workflow runs are ordered from newest to oldest.
+func planNewestOnly(queuedIds []string, runningCount, maxRuns int) (cancelIds []string, queueId string) {
+	if len(queuedIds) == 0 {
+		return nil, ""
+	}
+
+	cancelIds = queuedIds[1:]
+
+	if runningCount >= maxRuns {
+		return cancelIds, ""
+	}
+
+	return cancelIds, queuedIds[0]
+}
+
 func (wc *WorkflowsControllerImpl) queueByGroupRoundRobin(ctx context.Context, tenantId string, workflowVersion *dbsqlc.GetWorkflowVersionForEngineRow) error {
 	ctx, span := telemetry.NewSpan(ctx, "queue-by-group-round-robin")
 	defer span.End()
@@ -0,0 +1,66 @@
+package workflows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanNewestOnly(t *testing.T) {
+	tests := []struct {
+		name         string
+		queuedIds    []string
+		runningCount int
+		maxRuns      int
+		cancelIds    []string
+		queueId      string
+	}{
+		{
+			name:    "no queued workflow runs",
+			maxRuns: 1,
+		},
+		{
+			name:      "single queued workflow run is queued",
+			queuedIds: []string{"c"},
+			maxRuns:   1,
+			queueId:   "c",
+		},
+		{
+			name:      "older queued workflow runs are cancelled",
+			queuedIds: []string{"c", "b", "a"},
+			maxRuns:   1,
+			cancelIds: []string{"b", "a"},
+			queueId:   "c",
+		},
+		{
+			// running workflow runs are never cancelled, so the newest workflow run waits for them
+			name:         "newest workflow run waits while the group is full",
+			queuedIds:    []string{"c", "b", "a"},
+			runningCount: 2,
+			maxRuns:      2,
+			cancelIds:    []string{"b", "a"},
+		},
+		{
+			name:         "newest workflow run is queued while the group has room",
+			queuedIds:    []string{"c", "b"},
+			runningCount: 1,
+			maxRuns:      2,
+			cancelIds:    []string{"b"},
+			queueId:      "c",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cancelIds, queueId := planNewestOnly(tt.queuedIds, tt.runningCount, tt.maxRuns)
+
+			if len(tt.cancelIds) == 0 {
+				assert.Empty(t, cancelIds)
+			} else {
+				assert.Equal(t, tt.cancelIds, cancelIds)
+			}
+
+			assert.Equal(t, tt.queueId, queueId)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package dispatcher
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+)
+
+// newAttemptId returns the id of a dispatch of a step run, which the worker sends back with the completion or failure
+// of the step run. The id is opaque to the worker. It carries the retry count of the step run, so an event of an
+// earlier retry is detected without storing the dispatches.
+func newAttemptId(retryCount int32) string {
+	return fmt.Sprintf("%s.%d", uuid.New().String(), retryCount)
+}
+
+// attemptRetryCount returns the retry count of the step run which an attempt id was dispatched for.
+func attemptRetryCount(attemptId string) (int32, bool) {
+	id, retryCount, ok := strings.Cut(attemptId, ".")
+
+	if !ok {
+		return 0, false
+	}
+
+	if _, err := uuid.Parse(id); err != nil {
+		return 0, false
+	}
+
+	res, err := strconv.ParseInt(retryCount, 10, 32)
+
+	if err != nil {
+		return 0, false
+	}
+
+	return int32(res), true
+}
+
+// ackStepRunEvent processes the completion or failure event of a step run once per attempt, so a worker can send the
+// event again when it doesn't receive the response. Events of an earlier retry of the step run are ignored, since they
+// would overwrite the state of the current retry. Events without an attempt id, which are sent by older SDKs, are
+// processed every time.
+//
+// The ack of the attempt is committed before the event is processed, so a duplicate of the event is never processed
+// and no transaction is held open while the event is processed. If the event can't be processed, the ack is deleted
+// again, so the worker can retry it.
+func (s *DispatcherImpl) ackStepRunEvent(ctx context.Context, tenantId string, request *contracts.StepActionEvent, currentRetryCount int32, process func() error) error {
+	if request.AttemptId == nil {
+		return process()
+	}
+
+	attemptId := *request.AttemptId
+
+	retryCount, ok := attemptRetryCount(attemptId)
+
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "invalid attempt id %s", attemptId)
+	}
+
+	if retryCount != currentRetryCount {
+		s.l.Warn().Msgf(
+			"ignoring %s event of step run %s for retry %d, the step run is on retry %d",
+			request.EventType, request.StepRunId, retryCount, currentRetryCount,
+		)
+
+		return nil
+	}
+
+	eventType := strings.TrimPrefix(request.EventType.String(), "STEP_EVENT_TYPE_")
+
+	acked, err := s.repo.StepRun().AckStepRunAttempt(ctx, tenantId, request.StepRunId, attemptId, eventType)
+
+	if err != nil {
+		return err
+	}
+
+	if !acked {
+		s.l.Debug().Msgf("ignoring duplicate %s event of step run %s for attempt %s", request.EventType, request.StepRunId, attemptId)
+		return nil
+	}
+
+	if err := process(); err != nil {
+		if unackErr := s.repo.StepRun().UnackStepRunAttempt(ctx, tenantId, request.StepRunId, attemptId); unackErr != nil {
+			s.l.Error().Err(unackErr).Msgf("could not unack %s event of step run %s for attempt %s", request.EventType, request.StepRunId, attemptId)
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// stepRunEngineRepository records the acks of step run attempts in memory
+type stepRunEngineRepository struct {
+	repository.StepRunEngineRepository
+
+	mu   sync.Mutex
+	acks map[string]bool
+}
+
+func (r *stepRunEngineRepository) AckStepRunAttempt(ctx context.Context, tenantId, stepRunId, attemptId, eventType string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := stepRunId + "/" + attemptId
+
+	if r.acks[key] {
+		return false, nil
+	}
+
+	r.acks[key] = true
+
+	return true, nil
+}
+
+func (r *stepRunEngineRepository) UnackStepRunAttempt(ctx context.Context, tenantId, stepRunId, attemptId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.acks, stepRunId+"/"+attemptId)
+
+	return nil
+}
+
+type engineRepository struct {
+	repository.EngineRepository
+
+	stepRuns *stepRunEngineRepository
+}
+
+func (r *engineRepository) StepRun() repository.StepRunEngineRepository {
+	return r.stepRuns
+}
+
+func newTestDispatcher() *DispatcherImpl {
+	l := zerolog.Nop()
+
+	return &DispatcherImpl{
+		repo: &engineRepository{
+			stepRuns: &stepRunEngineRepository{acks: make(map[string]bool)},
+		},
+		l: &l,
+	}
+}
+
+func newTestStepActionEvent(attemptId *string) *contracts.StepActionEvent {
+	return &contracts.StepActionEvent{
+		StepRunId: uuid.New().String(),
+		EventType: contracts.StepActionEventType_STEP_EVENT_TYPE_COMPLETED,
+		AttemptId: attemptId,
+	}
+}
+
+func TestAttemptId(t *testing.T) {
+	retryCount, ok := attemptRetryCount(newAttemptId(3))
+
+	assert.True(t, ok)
+	assert.Equal(t, int32(3), retryCount)
+
+	for _, attemptId := range []string{"", "3", uuid.New().String(), "not-a-uuid.3", uuid.New().String() + ".three"} {
+		_, ok := attemptRetryCount(attemptId)
+		assert.False(t, ok, "attempt id %q is valid", attemptId)
+	}
+}
+
+func TestAckStepRunEvent_Duplicate(t *testing.T) {
+	s := newTestDispatcher()
+
+	attemptId := newAttemptId(0)
+	request := newTestStepActionEvent(&attemptId)
+
+	processed := 0
+
+	for i := 0; i < 3; i++ {
+		err := s.ackStepRunEvent(context.Background(), uuid.New().String(), request, 0, func() error {
+			processed++
+			return nil
+		})
+
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 1, processed)
+
+	// a new attempt of the same step run is processed
+	nextAttemptId := newAttemptId(0)
+	request.AttemptId = &nextAttemptId
+
+	err := s.ackStepRunEvent(context.Background(), uuid.New().String(), request, 0, func() error {
+		processed++
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, processed)
+}
+
+func TestAckStepRunEvent_Concurrent(t *testing.T) {
+	s := newTestDispatcher()
+
+	attemptId := newAttemptId(1)
+	request := newTestStepActionEvent(&attemptId)
+
+	var processed atomic.Int32
+	var wg sync.WaitGroup
+
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			err := s.ackStepRunEvent(context.Background(), uuid.New().String(), request, 1, func() error {
+				processed.Add(1)
+				return nil
+			})
+
+			assert.NoError(t, err)
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int32(1), processed.Load())
+}
+
+func TestAckStepRunEvent_ProcessingFailed(t *testing.T) {
+	s := newTestDispatcher()
+
+	attemptId := newAttemptId(0)
+	request := newTestStepActionEvent(&attemptId)
+
+	errPublish := errors.New("could not publish")
+
+	err := s.ackStepRunEvent(context.Background(), uuid.New().String(), request, 0, func() error {
+		return errPublish
+	})
+
+	assert.ErrorIs(t, err, errPublish)
+
+	// the ack was deleted, so the event is processed when the worker sends it again
+	processed := 0
+
+	err = s.ackStepRunEvent(context.Background(), uuid.New().String(), request, 0, func() error {
+		processed++
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+}
+
+func TestAckStepRunEvent_Ignored(t *testing.T) {
+	s := newTestDispatcher()
+
+	processed := 0
+
+	process := func() error {
+		processed++
+		return nil
+	}
+
+	// events of an earlier retry are ignored
+	earlierAttemptId := newAttemptId(0)
+	require.NoError(t, s.ackStepRunEvent(context.Background(), uuid.New().String(), newTestStepActionEvent(&earlierAttemptId), 1, process))
+	assert.Equal(t, 0, processed)
+
+	// events without an attempt id are processed every time
+	request := newTestStepActionEvent(nil)
+
+	require.NoError(t, s.ackStepRunEvent(context.Background(), uuid.New().String(), request, 0, process))
+	require.NoError(t, s.ackStepRunEvent(context.Background(), uuid.New().String(), request, 0, process))
+	assert.Equal(t, 2, processed)
+
+	invalidAttemptId := "invalid"
+	err := s.ackStepRunEvent(context.Background(), uuid.New().String(), newTestStepActionEvent(&invalidAttemptId), 0, process)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
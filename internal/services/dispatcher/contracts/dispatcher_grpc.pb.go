@@ -38,6 +38,14 @@ type DispatcherClient interface {
 	RefreshTimeout(ctx context.Context, in *RefreshTimeoutRequest, opts ...grpc.CallOption) (*RefreshTimeoutResponse, error)
 	ReleaseSlot(ctx context.Context, in *ReleaseSlotRequest, opts ...grpc.CallOption) (*ReleaseSlotResponse, error)
 	UpsertWorkerLabels(ctx context.Context, in *UpsertWorkerLabelsRequest, opts ...grpc.CallOption) (*UpsertWorkerLabelsResponse, error)
+	DrainWorker(ctx context.Context, in *WorkerDrainRequest, opts ...grpc.CallOption) (*WorkerDrainResponse, error)
+	GetBlob(ctx context.Context, in *GetBlobRequest, opts ...grpc.CallOption) (*GetBlobResponse, error)
+	// PutStreamChunks streams the incremental output of step runs, which is sent to the clients subscribed to
+	// their workflow runs in order
+	PutStreamChunks(ctx context.Context, opts ...grpc.CallOption) (Dispatcher_PutStreamChunksClient, error)
+	// ReportProgress stores the progress of a long-running step run, which is sent to the clients subscribed to its
+	// workflow run
+	ReportProgress(ctx context.Context, in *ReportProgressRequest, opts ...grpc.CallOption) (*ReportProgressResponse, error)
 }
 
 type dispatcherClient struct {
@@ -256,6 +264,67 @@ func (c *dispatcherClient) UpsertWorkerLabels(ctx context.Context, in *UpsertWor
 	return out, nil
 }
 
+func (c *dispatcherClient) DrainWorker(ctx context.Context, in *WorkerDrainRequest, opts ...grpc.CallOption) (*WorkerDrainResponse, error) {
+	out := new(WorkerDrainResponse)
+	err := c.cc.Invoke(ctx, "/Dispatcher/DrainWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherClient) GetBlob(ctx context.Context, in *GetBlobRequest, opts ...grpc.CallOption) (*GetBlobResponse, error) {
+	out := new(GetBlobResponse)
+	err := c.cc.Invoke(ctx, "/Dispatcher/GetBlob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dispatcherClient) PutStreamChunks(ctx context.Context, opts ...grpc.CallOption) (Dispatcher_PutStreamChunksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Dispatcher_ServiceDesc.Streams[4], "/Dispatcher/PutStreamChunks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dispatcherPutStreamChunksClient{stream}
+	return x, nil
+}
+
+type Dispatcher_PutStreamChunksClient interface {
+	Send(*StreamChunk) error
+	CloseAndRecv() (*PutStreamChunksResponse, error)
+	grpc.ClientStream
+}
+
+type dispatcherPutStreamChunksClient struct {
+	grpc.ClientStream
+}
+
+func (x *dispatcherPutStreamChunksClient) Send(m *StreamChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *dispatcherPutStreamChunksClient) CloseAndRecv() (*PutStreamChunksResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(PutStreamChunksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dispatcherClient) ReportProgress(ctx context.Context, in *ReportProgressRequest, opts ...grpc.CallOption) (*ReportProgressResponse, error) {
+	out := new(ReportProgressResponse)
+	err := c.cc.Invoke(ctx, "/Dispatcher/ReportProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DispatcherServer is the server API for Dispatcher service.
 // All implementations must embed UnimplementedDispatcherServer
 // for forward compatibility
@@ -276,6 +345,14 @@ type DispatcherServer interface {
 	RefreshTimeout(context.Context, *RefreshTimeoutRequest) (*RefreshTimeoutResponse, error)
 	ReleaseSlot(context.Context, *ReleaseSlotRequest) (*ReleaseSlotResponse, error)
 	UpsertWorkerLabels(context.Context, *UpsertWorkerLabelsRequest) (*UpsertWorkerLabelsResponse, error)
+	DrainWorker(context.Context, *WorkerDrainRequest) (*WorkerDrainResponse, error)
+	GetBlob(context.Context, *GetBlobRequest) (*GetBlobResponse, error)
+	// PutStreamChunks streams the incremental output of step runs, which is sent to the clients subscribed to
+	// their workflow runs in order
+	PutStreamChunks(Dispatcher_PutStreamChunksServer) error
+	// ReportProgress stores the progress of a long-running step run, which is sent to the clients subscribed to its
+	// workflow run
+	ReportProgress(context.Context, *ReportProgressRequest) (*ReportProgressResponse, error)
 	mustEmbedUnimplementedDispatcherServer()
 }
 
@@ -322,6 +399,18 @@ func (UnimplementedDispatcherServer) ReleaseSlot(context.Context, *ReleaseSlotRe
 func (UnimplementedDispatcherServer) UpsertWorkerLabels(context.Context, *UpsertWorkerLabelsRequest) (*UpsertWorkerLabelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpsertWorkerLabels not implemented")
 }
+func (UnimplementedDispatcherServer) DrainWorker(context.Context, *WorkerDrainRequest) (*WorkerDrainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainWorker not implemented")
+}
+func (UnimplementedDispatcherServer) GetBlob(context.Context, *GetBlobRequest) (*GetBlobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlob not implemented")
+}
+func (UnimplementedDispatcherServer) PutStreamChunks(Dispatcher_PutStreamChunksServer) error {
+	return status.Errorf(codes.Unimplemented, "method PutStreamChunks not implemented")
+}
+func (UnimplementedDispatcherServer) ReportProgress(context.Context, *ReportProgressRequest) (*ReportProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportProgress not implemented")
+}
 func (UnimplementedDispatcherServer) mustEmbedUnimplementedDispatcherServer() {}
 
 // UnsafeDispatcherServer may be embedded to opt out of forward compatibility for this service.
@@ -586,6 +675,86 @@ func _Dispatcher_UpsertWorkerLabels_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dispatcher_DrainWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkerDrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).DrainWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dispatcher/DrainWorker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).DrainWorker(ctx, req.(*WorkerDrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dispatcher_GetBlob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).GetBlob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dispatcher/GetBlob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).GetBlob(ctx, req.(*GetBlobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dispatcher_PutStreamChunks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DispatcherServer).PutStreamChunks(&dispatcherPutStreamChunksServer{stream})
+}
+
+type Dispatcher_PutStreamChunksServer interface {
+	SendAndClose(*PutStreamChunksResponse) error
+	Recv() (*StreamChunk, error)
+	grpc.ServerStream
+}
+
+type dispatcherPutStreamChunksServer struct {
+	grpc.ServerStream
+}
+
+func (x *dispatcherPutStreamChunksServer) SendAndClose(m *PutStreamChunksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *dispatcherPutStreamChunksServer) Recv() (*StreamChunk, error) {
+	m := new(StreamChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Dispatcher_ReportProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DispatcherServer).ReportProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Dispatcher/ReportProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DispatcherServer).ReportProgress(ctx, req.(*ReportProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Dispatcher_ServiceDesc is the grpc.ServiceDesc for Dispatcher service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -629,6 +798,18 @@ var Dispatcher_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpsertWorkerLabels",
 			Handler:    _Dispatcher_UpsertWorkerLabels_Handler,
 		},
+		{
+			MethodName: "DrainWorker",
+			Handler:    _Dispatcher_DrainWorker_Handler,
+		},
+		{
+			MethodName: "GetBlob",
+			Handler:    _Dispatcher_GetBlob_Handler,
+		},
+		{
+			MethodName: "ReportProgress",
+			Handler:    _Dispatcher_ReportProgress_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -652,6 +833,11 @@ var Dispatcher_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "PutStreamChunks",
+			Handler:       _Dispatcher_PutStreamChunks_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "dispatcher.proto",
 }
@@ -14,6 +14,9 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/integrations/blobstore"
+	"github.com/hatchet-dev/hatchet/internal/integrations/secrets"
+	"github.com/hatchet-dev/hatchet/internal/metrics"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/recoveryutils"
@@ -41,11 +44,14 @@ type DispatcherImpl struct {
 	s            gocron.Scheduler
 	mq           msgqueue.MessageQueue
 	sharedReader *msgqueue.SharedTenantReader
+	eventLog     *eventLogWriter
 	l            *zerolog.Logger
 	dv           datautils.DataDecoderValidator
 	v            validator.Validator
 	repo         repository.EngineRepository
 	cache        cache.Cacheable
+	blobs        *blobstore.Offloader
+	secrets      *secrets.Resolver
 
 	entitlements repository.EntitlementsRepository
 
@@ -126,6 +132,8 @@ type DispatcherOpts struct {
 	dispatcherId string
 	alerter      hatcheterrors.Alerter
 	cache        cache.Cacheable
+	blobs        *blobstore.Offloader
+	secrets      *secrets.Resolver
 }
 
 func defaultDispatcherOpts() *DispatcherOpts {
@@ -188,6 +196,20 @@ func WithCache(cache cache.Cacheable) DispatcherOpt {
 	}
 }
 
+// WithBlobOffloader moves step run outputs which are larger than the configured threshold to a blob store.
+func WithBlobOffloader(blobs *blobstore.Offloader) DispatcherOpt {
+	return func(opts *DispatcherOpts) {
+		opts.blobs = blobs
+	}
+}
+
+// WithSecretResolver resolves secret references in step run inputs before they're sent to workers.
+func WithSecretResolver(secrets *secrets.Resolver) DispatcherOpt {
+	return func(opts *DispatcherOpts) {
+		opts.secrets = secrets
+	}
+}
+
 func New(fs ...DispatcherOpt) (*DispatcherImpl, error) {
 	opts := defaultDispatcherOpts()
 
@@ -236,6 +258,8 @@ func New(fs ...DispatcherOpt) (*DispatcherImpl, error) {
 		s:            s,
 		a:            a,
 		cache:        opts.cache,
+		blobs:        opts.blobs,
+		secrets:      opts.secrets,
 	}, nil
 }
 
@@ -245,6 +269,7 @@ func (d *DispatcherImpl) Start() (func() error, error) {
 	heavyReadMQ.SetQOS(1000)
 
 	d.sharedReader = msgqueue.NewSharedTenantReader(heavyReadMQ)
+	d.eventLog = newEventLogWriter(ctx, d)
 
 	// register the dispatcher by creating a new dispatcher in the database
 	dispatcher, err := d.repo.Dispatcher().CreateNewDispatcher(ctx, &repository.CreateDispatcherOpts{
@@ -298,6 +323,8 @@ func (d *DispatcherImpl) Start() (func() error, error) {
 		d.l.Debug().Msgf("dispatcher is shutting down...")
 		cancel()
 
+		d.eventLog.close()
+
 		if err := mqCleanup(); err != nil {
 			return fmt.Errorf("could not cleanup queue: %w", err)
 		}
@@ -426,6 +453,7 @@ func (d *DispatcherImpl) handleGroupKeyActionAssignedTask(ctx context.Context, t
 
 		if err != nil {
 			multiErr = multierror.Append(multiErr, fmt.Errorf("could not send group key action to worker: %w", err))
+			metrics.DispatchErrors.WithLabelValues(metadata.TenantId).Inc()
 		} else {
 			success = true
 		}
@@ -464,6 +492,12 @@ func (d *DispatcherImpl) handleStepRunAssignedTask(ctx context.Context, task *ms
 		return fmt.Errorf("could not get step run: %w", err)
 	}
 
+	// a speculative attempt doesn't hold the step run's semaphore, so there's nothing to release
+	if payload.Speculative && (repository.IsFinalJobRunStatus(stepRun.JobRunStatus) || repository.IsFinalStepRunStatus(stepRun.SRStatus)) {
+		d.l.Debug().Msgf("step run %s is in a final state %s, ignoring speculative attempt", payload.StepRunId, string(stepRun.SRStatus))
+		return nil
+	}
+
 	// if the step run has a job run in a non-running state, we should not send it to the worker
 	if repository.IsFinalJobRunStatus(stepRun.JobRunStatus) {
 		d.l.Debug().Msgf("job run %s is in a final state %s, ignoring", sqlchelpers.UUIDToStr(stepRun.JobRunId), string(stepRun.JobRunStatus))
@@ -485,6 +519,22 @@ func (d *DispatcherImpl) handleStepRunAssignedTask(ctx context.Context, task *ms
 		return fmt.Errorf("could not get step run data: %w", err)
 	}
 
+	// secrets are only resolved in the copy which is sent to the worker, so they're never persisted
+	input, err := d.secrets.Resolve(ctx, metadata.TenantId, data.Input)
+
+	if err != nil {
+		if payload.Speculative {
+			d.l.Warn().Err(err).Msgf("could not resolve secrets for speculative attempt of step run %s", payload.StepRunId)
+			return nil
+		}
+
+		return d.failStepRun(ctx, metadata.TenantId, sqlchelpers.UUIDToStr(stepRun.WorkflowRunId), payload.StepRunId, stepRun.StepRetries, stepRun.SRRetryCount, err)
+	}
+
+	resolvedData := *data
+	resolvedData.Input = input
+	data = &resolvedData
+
 	servertel.WithStepRunModel(span, stepRun)
 
 	var multiErr error
@@ -502,6 +552,7 @@ func (d *DispatcherImpl) handleStepRunAssignedTask(ctx context.Context, task *ms
 
 		if err != nil {
 			multiErr = multierror.Append(multiErr, fmt.Errorf("could not send step action to worker (%d): %w", i, err))
+			metrics.DispatchErrors.WithLabelValues(metadata.TenantId).Inc()
 		} else {
 			success = true
 			break
@@ -510,6 +561,15 @@ func (d *DispatcherImpl) handleStepRunAssignedTask(ctx context.Context, task *ms
 
 	now := time.Now().UTC()
 
+	if payload.Speculative {
+		// the step run is still running on its assigned worker, so a speculative attempt is never requeued
+		if !success {
+			d.l.Warn().Err(multiErr).Msgf("could not send speculative attempt of step run %s to worker %s", payload.StepRunId, payload.WorkerId)
+		}
+
+		return nil
+	}
+
 	if success {
 		defer d.repo.StepRun().DeferredStepRunEvent(
 			metadata.TenantId,
@@ -650,14 +710,24 @@ func (d *DispatcherImpl) handleStepRunBulkAssignedTask(ctx context.Context, task
 						return d.repo.StepRun().ReleaseStepRunSemaphore(ctx, metadata.TenantId, stepRunId, false)
 					}
 
+					input, err := d.secrets.Resolve(ctx, metadata.TenantId, stepRun.Input)
+
+					if err != nil {
+						return d.failStepRun(ctx, metadata.TenantId, sqlchelpers.UUIDToStr(stepRun.WorkflowRunId), stepRunId, stepRun.StepRetries, stepRun.SRRetryCount, err)
+					}
+
+					resolvedStepRun := *stepRun
+					resolvedStepRun.Input = input
+
 					var multiErr error
 					var success bool
 
 					for i, w := range workers {
-						err := w.StartStepRunFromBulk(ctx, metadata.TenantId, stepRun)
+						err := w.StartStepRunFromBulk(ctx, metadata.TenantId, &resolvedStepRun)
 
 						if err != nil {
 							multiErr = multierror.Append(multiErr, fmt.Errorf("could not send step action to worker (%d): %w", i, err))
+							metrics.DispatchErrors.WithLabelValues(metadata.TenantId).Inc()
 						} else {
 							success = true
 							break
@@ -709,6 +779,36 @@ func (d *DispatcherImpl) handleStepRunBulkAssignedTask(ctx context.Context, task
 	return outerEg.Wait()
 }
 
+// failStepRun fails a step run which can't be sent to a worker, for example because a secret referenced in its input
+// is not set.
+func (d *DispatcherImpl) failStepRun(ctx context.Context, tenantId, workflowRunId, stepRunId string, stepRetries, retryCount int32, reason error) error {
+	payload, _ := datautils.ToJSONMap(tasktypes.StepRunFailedTaskPayload{
+		WorkflowRunId: workflowRunId,
+		StepRunId:     stepRunId,
+		FailedAt:      time.Now().UTC().Format(time.RFC3339),
+		Error:         fmt.Sprintf("could not resolve secrets: %s", reason.Error()),
+		StepRetries:   &stepRetries,
+		RetryCount:    &retryCount,
+	})
+
+	metadata, _ := datautils.ToJSONMap(tasktypes.StepRunFailedTaskMetadata{
+		TenantId: tenantId,
+	})
+
+	err := d.mq.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{
+		ID:       "step-run-failed",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not fail step run %s: %w", stepRunId, err)
+	}
+
+	return nil
+}
+
 func (d *DispatcherImpl) handleStepRunCancelled(ctx context.Context, task *msgqueue.Message) error {
 	ctx, span := telemetry.NewSpanWithCarrier(ctx, "step-run-cancelled", task.OtelCarrier)
 	defer span.End()
@@ -0,0 +1,336 @@
+package dispatcher
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// appendWorkflowEvent stores a tenant message in the event log of its workflow run, and returns the sequence of the
+// event. It returns 0 if the message isn't a workflow event or can't be stored, so the event is still sent but can't
+// be resumed from.
+func (s *DispatcherImpl) appendWorkflowEvent(ctx context.Context, tenantId string, task *msgqueue.Message) int64 {
+	seq, err := tasktypes.AppendWorkflowRunEvent(ctx, s.repo.WorkflowRunEventLog(), tenantId, task)
+
+	if err != nil {
+		s.l.Error().Err(err).Msg("could not append workflow event")
+		return 0
+	}
+
+	return seq
+}
+
+// eventLogWriter stores the workflow events of the tenants which have run status subscriptions on this dispatcher.
+// It keeps storing the events of a tenant for the retention period of the event log after the last subscription of
+// the tenant ended, so clients which reconnect receive the events which they missed in the meantime.
+type eventLogWriter struct {
+	d *DispatcherImpl
+
+	ctx context.Context
+
+	mu      sync.Mutex
+	tenants map[string]*eventLogTenant
+}
+
+type eventLogTenant struct {
+	subscriptions int
+	cleanup       func() error
+
+	// stop stops storing the events of the tenant once it has had no subscriptions for the retention period
+	stop *time.Timer
+}
+
+func newEventLogWriter(ctx context.Context, d *DispatcherImpl) *eventLogWriter {
+	return &eventLogWriter{
+		d:       d,
+		ctx:     ctx,
+		tenants: make(map[string]*eventLogTenant),
+	}
+}
+
+// acquire stores the events of the tenant until the returned release function is called, and for the retention
+// period of the event log afterwards.
+func (w *eventLogWriter) acquire(tenantId string) (func(), error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	t, ok := w.tenants[tenantId]
+
+	if !ok {
+		cleanup, err := w.d.sharedReader.Subscribe(tenantId, func(task *msgqueue.Message) error {
+			w.d.appendWorkflowEvent(w.ctx, tenantId, task)
+			return nil
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not subscribe to workflow events of tenant %s: %w", tenantId, err)
+		}
+
+		t = &eventLogTenant{
+			cleanup: cleanup,
+		}
+
+		w.tenants[tenantId] = t
+	}
+
+	if t.stop != nil {
+		t.stop.Stop()
+		t.stop = nil
+	}
+
+	t.subscriptions++
+
+	release := func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+
+		t.subscriptions--
+
+		if t.subscriptions > 0 {
+			return
+		}
+
+		var stop *time.Timer
+
+		stop = time.AfterFunc(repository.WorkflowRunEventLogRetention, func() {
+			w.mu.Lock()
+			defer w.mu.Unlock()
+
+			// the tenant was subscribed to again in the meantime
+			if t.stop != stop {
+				return
+			}
+
+			w.remove(tenantId, t)
+		})
+
+		t.stop = stop
+	}
+
+	return release, nil
+}
+
+// close stops storing the events of all tenants.
+func (w *eventLogWriter) close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for tenantId, t := range w.tenants {
+		if t.stop != nil {
+			t.stop.Stop()
+		}
+
+		w.remove(tenantId, t)
+	}
+}
+
+func (w *eventLogWriter) remove(tenantId string, t *eventLogTenant) {
+	if err := t.cleanup(); err != nil {
+		w.d.l.Error().Err(err).Msgf("could not stop storing workflow events of tenant %s", tenantId)
+	}
+
+	delete(w.tenants, tenantId)
+}
+
+// resumeToken is the sequence of the last event which was sent to a client for each workflow run. It's opaque to
+// clients, which send the token of the last event they received when they reconnect.
+type resumeToken map[string]int64
+
+func decodeResumeToken(s string) (resumeToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid resume token: %w", err)
+	}
+
+	token := resumeToken{}
+
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, fmt.Errorf("invalid resume token: %w", err)
+	}
+
+	return token, nil
+}
+
+func (t resumeToken) encode() string {
+	data, _ := json.Marshal(t) // nolint: errcheck - a map of strings to integers always marshals
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// minSeq returns the lowest sequence of the token, which events are replayed after.
+func (t resumeToken) minSeq() int64 {
+	var res int64 = -1
+
+	for _, seq := range t {
+		if res == -1 || seq < res {
+			res = seq
+		}
+	}
+
+	return max(res, 0)
+}
+
+// workflowEventStream sends the workflow events of a subscription to the client, and skips the events which were
+// already sent. Events which are received while the missed events are replayed from the event log are held back
+// until the replay finished, so the events of a workflow run are sent in the order of the event log.
+type workflowEventStream struct {
+	stream contracts.Dispatcher_SubscribeToWorkflowEventsServer
+
+	mu        sync.Mutex
+	token     resumeToken
+	replaying bool
+	pending   []*pendingWorkflowEvent
+}
+
+type pendingWorkflowEvent struct {
+	e   *contracts.WorkflowEvent
+	seq int64
+}
+
+func newWorkflowEventStream(stream contracts.Dispatcher_SubscribeToWorkflowEventsServer, token resumeToken) *workflowEventStream {
+	replaying := token != nil
+
+	if token == nil {
+		token = resumeToken{}
+	}
+
+	return &workflowEventStream{
+		stream:    stream,
+		token:     token,
+		replaying: replaying,
+	}
+}
+
+// send sends an event with the given sequence, or holds it back while events are replayed. It returns whether the
+// event which was sent hangs up the subscription.
+func (w *workflowEventStream) send(e *contracts.WorkflowEvent, seq int64) (bool, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.replaying {
+		w.pending = append(w.pending, &pendingWorkflowEvent{e: e, seq: seq})
+		return false, nil
+	}
+
+	return w.sendLocked(e, seq)
+}
+
+// replay sends an event of the event log.
+func (w *workflowEventStream) replay(e *contracts.WorkflowEvent, seq int64) (bool, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.sendLocked(e, seq)
+}
+
+// finishReplay sends the events which were held back during the replay.
+func (w *workflowEventStream) finishReplay() (bool, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.replaying = false
+
+	for _, p := range w.pending {
+		hangup, err := w.sendLocked(p.e, p.seq)
+
+		if err != nil || hangup {
+			return hangup, err
+		}
+	}
+
+	w.pending = nil
+
+	return false, nil
+}
+
+func (w *workflowEventStream) sendLocked(e *contracts.WorkflowEvent, seq int64) (bool, error) {
+	if seq > 0 {
+		if seq <= w.token[e.WorkflowRunId] {
+			return false, nil
+		}
+
+		w.token[e.WorkflowRunId] = seq
+	}
+
+	e.ResumeToken = w.token.encode()
+
+	if err := w.stream.Send(e); err != nil {
+		return false, err
+	}
+
+	return e.Hangup, nil
+}
+
+// replayWorkflowEvents sends the events of the event log which the client missed according to its resume token,
+// followed by the events which were held back in the meantime. The events are filtered by the given workflow runs,
+// or by convert if no workflow runs are given. It returns whether an event which was sent hangs up the
+// subscription.
+func (s *DispatcherImpl) replayWorkflowEvents(
+	ctx context.Context,
+	tenantId string,
+	workflowRunIds []string,
+	token resumeToken,
+	es *workflowEventStream,
+	convert func(task *msgqueue.Message) (*contracts.WorkflowEvent, error),
+) (bool, error) {
+	// an empty token means that the client didn't receive any events yet, so it doesn't miss any
+	if len(token) == 0 {
+		return es.finishReplay()
+	}
+
+	afterId := token.minSeq()
+	limit := 1000
+
+	for {
+		entries, err := s.repo.WorkflowRunEventLog().ListWorkflowRunEvents(ctx, tenantId, &repository.ListWorkflowRunEventLogsOpts{
+			AfterId:        afterId,
+			WorkflowRunIds: workflowRunIds,
+			Limit:          &limit,
+		})
+
+		if err != nil {
+			return false, fmt.Errorf("could not list workflow events: %w", err)
+		}
+
+		for _, entry := range entries {
+			afterId = entry.ID
+
+			task := &msgqueue.Message{}
+
+			if err := json.Unmarshal(entry.Message, task); err != nil {
+				s.l.Error().Err(err).Msgf("could not unmarshal workflow event %d", entry.ID)
+				continue
+			}
+
+			e, err := convert(task)
+
+			if err != nil {
+				s.l.Error().Err(err).Msgf("could not convert task to workflow event")
+				continue
+			} else if e == nil || e.WorkflowRunId == "" {
+				continue
+			}
+
+			hangup, err := es.replay(e, entry.ID)
+
+			if err != nil || hangup {
+				return hangup, err
+			}
+		}
+
+		if len(entries) < limit {
+			break
+		}
+	}
+
+	return es.finishReplay()
+}
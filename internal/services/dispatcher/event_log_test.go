@@ -0,0 +1,198 @@
+package dispatcher
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// fakeWorkflowEventsServer records the workflow events which are sent to the client
+type fakeWorkflowEventsServer struct {
+	contracts.Dispatcher_SubscribeToWorkflowEventsServer
+
+	sent []*contracts.WorkflowEvent
+}
+
+func (s *fakeWorkflowEventsServer) Send(e *contracts.WorkflowEvent) error {
+	s.sent = append(s.sent, e)
+	return nil
+}
+
+func (s *fakeWorkflowEventsServer) sentIds() []string {
+	ids := make([]string, len(s.sent))
+
+	for i, e := range s.sent {
+		ids[i] = e.EventPayload
+	}
+
+	return ids
+}
+
+// fakeEventLogRepository lists the events of an in memory event log
+type fakeEventLogRepository struct {
+	repository.WorkflowRunEventLogRepository
+
+	entries []*dbsqlc.WorkflowRunEventLog
+}
+
+func (r *fakeEventLogRepository) ListWorkflowRunEvents(ctx context.Context, tenantId string, opts *repository.ListWorkflowRunEventLogsOpts) ([]*dbsqlc.WorkflowRunEventLog, error) {
+	res := make([]*dbsqlc.WorkflowRunEventLog, 0)
+
+	for _, entry := range r.entries {
+		if entry.ID > opts.AfterId && len(res) < *opts.Limit {
+			res = append(res, entry)
+		}
+	}
+
+	return res, nil
+}
+
+type eventLogEngineRepository struct {
+	repository.EngineRepository
+
+	eventLog *fakeEventLogRepository
+}
+
+func (r *eventLogEngineRepository) WorkflowRunEventLog() repository.WorkflowRunEventLogRepository {
+	return r.eventLog
+}
+
+func newWorkflowEvent(workflowRunId, id string) *contracts.WorkflowEvent {
+	return &contracts.WorkflowEvent{
+		WorkflowRunId: workflowRunId,
+		EventPayload:  id,
+	}
+}
+
+func TestResumeToken(t *testing.T) {
+	token := resumeToken{"run-a": 3, "run-b": 1}
+
+	decoded, err := decodeResumeToken(token.encode())
+	require.NoError(t, err)
+	assert.Equal(t, token, decoded)
+
+	assert.Equal(t, int64(1), token.minSeq())
+	assert.Equal(t, int64(0), resumeToken{}.minSeq())
+
+	_, err = decodeResumeToken("not base64!")
+	assert.Error(t, err)
+
+	_, err = decodeResumeToken(base64.RawURLEncoding.EncodeToString([]byte("not json")))
+	assert.Error(t, err)
+}
+
+func TestWorkflowEventStream(t *testing.T) {
+	stream := &fakeWorkflowEventsServer{}
+	es := newWorkflowEventStream(stream, nil)
+
+	_, err := es.send(newWorkflowEvent("run-a", "1"), 1)
+	require.NoError(t, err)
+
+	// the event was already sent to the client
+	_, err = es.send(newWorkflowEvent("run-a", "1 again"), 1)
+	require.NoError(t, err)
+
+	// events which aren't stored in the event log are always sent
+	_, err = es.send(newWorkflowEvent("run-a", "not stored"), 0)
+	require.NoError(t, err)
+
+	hangup := newWorkflowEvent("run-b", "2")
+	hangup.Hangup = true
+
+	done, err := es.send(hangup, 2)
+	require.NoError(t, err)
+	assert.True(t, done)
+
+	assert.Equal(t, []string{"1", "not stored", "2"}, stream.sentIds())
+
+	// the resume token of each event contains the sequences of the events which were sent until then
+	token, err := decodeResumeToken(stream.sent[2].ResumeToken)
+	require.NoError(t, err)
+	assert.Equal(t, resumeToken{"run-a": 1, "run-b": 2}, token)
+}
+
+func TestReplayWorkflowEvents(t *testing.T) {
+	tenantId := uuid.New().String()
+
+	entry := func(id int64, workflowRunId string) *dbsqlc.WorkflowRunEventLog {
+		message, err := json.Marshal(&msgqueue.Message{
+			ID: "workflow-run-finished",
+			Payload: map[string]interface{}{
+				"workflow_run_id": workflowRunId,
+			},
+		})
+
+		require.NoError(t, err)
+
+		return &dbsqlc.WorkflowRunEventLog{
+			ID:      id,
+			Message: message,
+		}
+	}
+
+	l := zerolog.Nop()
+
+	d := &DispatcherImpl{
+		l: &l,
+		repo: &eventLogEngineRepository{
+			eventLog: &fakeEventLogRepository{
+				entries: []*dbsqlc.WorkflowRunEventLog{
+					entry(1, "run-a"),
+					entry(2, "run-a"),
+					entry(3, "run-b"),
+					entry(4, "run-a"),
+				},
+			},
+		},
+	}
+
+	convert := func(task *msgqueue.Message) (*contracts.WorkflowEvent, error) {
+		workflowRunId, _ := task.Payload["workflow_run_id"].(string)
+		return newWorkflowEvent(workflowRunId, ""), nil
+	}
+
+	// the client received the events until 3 of run-a and until 1 of run-b before it reconnected
+	token := resumeToken{"run-a": 3, "run-b": 1}
+
+	stream := &fakeWorkflowEventsServer{}
+	es := newWorkflowEventStream(stream, token)
+
+	// the events which are received during the replay are held back
+	_, err := es.send(newWorkflowEvent("run-a", "live 4"), 4)
+	require.NoError(t, err)
+
+	_, err = es.send(newWorkflowEvent("run-b", "live 5"), 5)
+	require.NoError(t, err)
+
+	assert.Empty(t, stream.sent)
+
+	hangup, err := d.replayWorkflowEvents(context.Background(), tenantId, nil, token, es, convert)
+	require.NoError(t, err)
+	assert.False(t, hangup)
+
+	sent := make([]string, len(stream.sent))
+
+	for i, e := range stream.sent {
+		sent[i] = e.WorkflowRunId + ":" + e.EventPayload
+	}
+
+	// the missed events are replayed in order, and the held back events are only sent if they weren't replayed
+	assert.Equal(t, []string{"run-b:", "run-a:", "run-b:live 5"}, sent)
+
+	// events which are received after the replay are sent right away
+	_, err = es.send(newWorkflowEvent("run-a", "live 6"), 6)
+	require.NoError(t, err)
+
+	assert.Equal(t, "live 6", stream.sent[len(stream.sent)-1].EventPayload)
+}
@@ -22,6 +22,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/integrations/blobstore"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
@@ -49,7 +50,8 @@ func (worker *subscribedWorker) StartStepRun(
 	stepRun *dbsqlc.GetStepRunForEngineRow,
 	stepRunData *dbsqlc.GetStepRunDataForEngineRow,
 ) error {
-	ctx, span := telemetry.NewSpan(ctx, "start-step-run") // nolint:ineffassign
+	// the step run is sent in the trace of its workflow run, which is linked to the trace which assigned it
+	ctx, span := telemetry.NewSpanWithLinkedCarrier(ctx, "start-step-run", unmarshalTraceContext(stepRunData.TraceContext))
 	defer span.End()
 
 	inputBytes := []byte{}
@@ -75,6 +77,9 @@ func (worker *subscribedWorker) StartStepRun(
 		RetryCount:    stepRun.SRRetryCount,
 	}
 
+	attemptId := newAttemptId(stepRun.SRRetryCount)
+	action.AttemptId = &attemptId
+
 	if stepRunData.AdditionalMetadata != nil {
 		metadataStr := string(stepRunData.AdditionalMetadata)
 		action.AdditionalMetadata = &metadataStr
@@ -93,6 +98,8 @@ func (worker *subscribedWorker) StartStepRun(
 		action.ParentWorkflowRunId = &parentId
 	}
 
+	action.TraceContext = telemetry.GetTraceContext(ctx)
+
 	worker.sendMu.Lock()
 	defer worker.sendMu.Unlock()
 
@@ -104,7 +111,8 @@ func (worker *subscribedWorker) StartStepRunFromBulk(
 	tenantId string,
 	stepRun *dbsqlc.GetStepRunBulkDataForEngineRow,
 ) error {
-	ctx, span := telemetry.NewSpan(ctx, "start-step-run-from-bulk") // nolint:ineffassign
+	// the step run is sent in the trace of its workflow run, which is linked to the trace which assigned it
+	ctx, span := telemetry.NewSpanWithLinkedCarrier(ctx, "start-step-run-from-bulk", unmarshalTraceContext(stepRun.TraceContext))
 	defer span.End()
 
 	inputBytes := []byte{}
@@ -130,6 +138,9 @@ func (worker *subscribedWorker) StartStepRunFromBulk(
 		RetryCount:    stepRun.SRRetryCount,
 	}
 
+	attemptId := newAttemptId(stepRun.SRRetryCount)
+	action.AttemptId = &attemptId
+
 	if stepRun.AdditionalMetadata != nil {
 		metadataStr := string(stepRun.AdditionalMetadata)
 		action.AdditionalMetadata = &metadataStr
@@ -148,12 +159,30 @@ func (worker *subscribedWorker) StartStepRunFromBulk(
 		action.ParentWorkflowRunId = &parentId
 	}
 
+	action.TraceContext = telemetry.GetTraceContext(ctx)
+
 	worker.sendMu.Lock()
 	defer worker.sendMu.Unlock()
 
 	return worker.stream.Send(action)
 }
 
+// unmarshalTraceContext returns the stored trace context of a workflow run, or nil if the workflow run wasn't
+// triggered in a trace.
+func unmarshalTraceContext(data []byte) map[string]string {
+	if len(data) == 0 {
+		return nil
+	}
+
+	traceContext := map[string]string{}
+
+	if err := json.Unmarshal(data, &traceContext); err != nil {
+		return nil
+	}
+
+	return traceContext
+}
+
 func (worker *subscribedWorker) StartGroupKeyAction(
 	ctx context.Context,
 	tenantId string,
@@ -222,6 +251,8 @@ func (s *DispatcherImpl) Register(ctx context.Context, request *contracts.Worker
 		Actions:      request.Actions,
 		Services:     svcs,
 		WebhookId:    request.WebhookId,
+		Region:       request.Region,
+		Pool:         request.Pool,
 	}
 
 	if request.RuntimeInfo != nil {
@@ -239,12 +270,32 @@ func (s *DispatcherImpl) Register(ctx context.Context, request *contracts.Worker
 		opts.MaxRuns = &mr
 	}
 
+	if len(request.SlotPools) > 0 {
+		opts.SlotPools = make(map[string]int, len(request.SlotPools))
+
+		for slotType, size := range request.SlotPools {
+			opts.SlotPools[slotType] = int(size)
+		}
+	}
+
 	if apiErrors, err := s.v.ValidateAPI(opts); err != nil {
 		return nil, err
 	} else if apiErrors != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %s", apiErrors.String())
 	}
 
+	err := s.repo.TenantQuota().CheckQuota(ctx, tenantId, repository.TenantQuotaResourceWorkers, 1)
+
+	var quotaErr repository.ErrQuotaExceeded
+
+	if errors.As(err, &quotaErr) {
+		return nil, status.Error(codes.ResourceExhausted, quotaErr.Error())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not check worker quota: %w", err)
+	}
+
 	// create a worker in the database
 	worker, err := s.repo.Worker().CreateNewWorker(ctx, tenantId, opts)
 
@@ -540,6 +591,12 @@ func (s *DispatcherImpl) Heartbeat(ctx context.Context, req *contracts.Heartbeat
 		s.l.Warn().Msgf("heartbeat time is greater than expected heartbeat interval")
 	}
 
+	for _, stepRunId := range req.StepRunIds {
+		if _, err := uuid.Parse(stepRunId); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Heartbeat rejected: invalid step run id: %s", stepRunId)
+		}
+	}
+
 	worker, err := s.repo.Worker().GetWorkerForEngine(ctx, tenantId, req.WorkerId)
 
 	if err != nil {
@@ -560,7 +617,7 @@ func (s *DispatcherImpl) Heartbeat(ctx context.Context, req *contracts.Heartbeat
 		return nil, status.Errorf(codes.FailedPrecondition, "Heartbeat rejected: worker stream is not active: %s", req.WorkerId)
 	}
 
-	err = s.repo.Worker().UpdateWorkerHeartbeat(ctx, tenantId, req.WorkerId, heartbeatAt)
+	err = s.repo.Worker().UpdateWorkerHeartbeat(ctx, tenantId, req.WorkerId, heartbeatAt, toWorkerHealth(req.Health))
 
 	if err != nil {
 		span.RecordError(err)
@@ -573,9 +630,36 @@ func (s *DispatcherImpl) Heartbeat(ctx context.Context, req *contracts.Heartbeat
 		return nil, err
 	}
 
+	err = s.repo.StepRun().UpdateStepRunHeartbeats(ctx, tenantId, req.WorkerId, req.StepRunIds, heartbeatAt)
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(telemetry_codes.Error, "could not update step run heartbeats")
+		return nil, err
+	}
+
 	return &contracts.HeartbeatResponse{}, nil
 }
 
+func toWorkerHealth(health *contracts.WorkerHealth) *repository.WorkerHealth {
+	if health == nil {
+		return nil
+	}
+
+	res := &repository.WorkerHealth{
+		CpuUtilization:    health.CpuUtilization,
+		MemoryUtilization: health.MemoryUtilization,
+		Gauges:            health.Gauges,
+	}
+
+	if health.ActiveSlots != nil {
+		activeSlots := int(*health.ActiveSlots)
+		res.ActiveSlots = &activeSlots
+	}
+
+	return res
+}
+
 func (s *DispatcherImpl) ReleaseSlot(ctx context.Context, req *contracts.ReleaseSlotRequest) (*contracts.ReleaseSlotResponse, error) {
 	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
@@ -593,18 +677,54 @@ func (s *DispatcherImpl) ReleaseSlot(ctx context.Context, req *contracts.Release
 	return &contracts.ReleaseSlotResponse{}, nil
 }
 
+// GetBlob returns a step run input or output which was moved to the blob store because of its size.
+func (s *DispatcherImpl) GetBlob(ctx context.Context, req *contracts.GetBlobRequest) (*contracts.GetBlobResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	if s.blobs == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "blob storage is not configured")
+	}
+
+	data, err := s.blobs.Get(ctx, tenantId, req.Key)
+
+	if err != nil {
+		if errors.Is(err, blobstore.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "blob %s not found", req.Key)
+		}
+
+		return nil, err
+	}
+
+	return &contracts.GetBlobResponse{
+		Data: data,
+	}, nil
+}
+
 func (s *DispatcherImpl) SubscribeToWorkflowEvents(request *contracts.SubscribeToWorkflowEventsRequest, stream contracts.Dispatcher_SubscribeToWorkflowEventsServer) error {
+	var token resumeToken
+
+	if request.ResumeToken != nil && *request.ResumeToken != "" {
+		var err error
+
+		token, err = decodeResumeToken(*request.ResumeToken)
+
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	if request.WorkflowRunId != nil {
-		return s.subscribeToWorkflowEventsByWorkflowRunId(*request.WorkflowRunId, stream)
+		return s.subscribeToWorkflowEventsByWorkflowRunId(*request.WorkflowRunId, token, stream)
 	} else if request.AdditionalMetaKey != nil && request.AdditionalMetaValue != nil {
-		return s.subscribeToWorkflowEventsByAdditionalMeta(*request.AdditionalMetaKey, *request.AdditionalMetaValue, stream)
+		return s.subscribeToWorkflowEventsByAdditionalMeta(*request.AdditionalMetaKey, *request.AdditionalMetaValue, token, stream)
 	}
 
 	return status.Errorf(codes.InvalidArgument, "either workflow run id or additional meta key-value must be provided")
 }
 
 // SubscribeToWorkflowEvents registers workflow events with the dispatcher
-func (s *DispatcherImpl) subscribeToWorkflowEventsByAdditionalMeta(key string, value string, stream contracts.Dispatcher_SubscribeToWorkflowEventsServer) error {
+func (s *DispatcherImpl) subscribeToWorkflowEventsByAdditionalMeta(key string, value string, token resumeToken, stream contracts.Dispatcher_SubscribeToWorkflowEventsServer) error {
 	tenant := stream.Context().Value("tenant").(*dbsqlc.Tenant)
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
 
@@ -613,16 +733,23 @@ func (s *DispatcherImpl) subscribeToWorkflowEventsByAdditionalMeta(key string, v
 
 	wg := sync.WaitGroup{}
 
+	// keep storing the events of the tenant, so the client can resume the subscription
+	release, err := s.eventLog.acquire(tenantId)
+
+	if err != nil {
+		return err
+	}
+
+	defer release()
+
+	es := newWorkflowEventStream(stream, token)
+
 	// Keep track of active workflow run IDs
 	activeRunIds := make(map[string]struct{})
-	var mu sync.Mutex     // Mutex to protect activeRunIds
-	var sendMu sync.Mutex // Mutex to protect sending messages
-
-	f := func(task *msgqueue.Message) error {
-		wg.Add(1)
-		defer wg.Done()
+	var mu sync.Mutex // Mutex to protect activeRunIds
 
-		e, err := s.tenantTaskToWorkflowEventByAdditionalMeta(
+	convert := func(task *msgqueue.Message) (*contracts.WorkflowEvent, error) {
+		return s.tenantTaskToWorkflowEventByAdditionalMeta(
 			task, tenantId, key, value,
 			func(e *contracts.WorkflowEvent) (bool, error) {
 				mu.Lock()
@@ -650,6 +777,13 @@ func (s *DispatcherImpl) subscribeToWorkflowEventsByAdditionalMeta(key string, v
 
 				return false, nil
 			})
+	}
+
+	f := func(task *msgqueue.Message) error {
+		wg.Add(1)
+		defer wg.Done()
+
+		e, err := convert(task)
 
 		if err != nil {
 			s.l.Error().Err(err).Msgf("could not convert task to workflow event")
@@ -659,9 +793,7 @@ func (s *DispatcherImpl) subscribeToWorkflowEventsByAdditionalMeta(key string, v
 		}
 
 		// send the task to the client
-		sendMu.Lock()
-		err = stream.Send(e)
-		sendMu.Unlock()
+		hangup, err := es.send(e, s.appendWorkflowEvent(ctx, tenantId, task))
 
 		if err != nil {
 			cancel() // FIXME is this necessary?
@@ -669,7 +801,7 @@ func (s *DispatcherImpl) subscribeToWorkflowEventsByAdditionalMeta(key string, v
 			return nil
 		}
 
-		if e.Hangup {
+		if hangup {
 			cancel()
 		}
 
@@ -683,6 +815,18 @@ func (s *DispatcherImpl) subscribeToWorkflowEventsByAdditionalMeta(key string, v
 		return err
 	}
 
+	// send the events which the client missed since it received the resume token
+	if token != nil {
+		hangup, err := s.replayWorkflowEvents(ctx, tenantId, nil, token, es, convert)
+
+		if err != nil {
+			s.l.Error().Err(err).Msgf("could not replay workflow events to client")
+			cancel()
+		} else if hangup {
+			cancel()
+		}
+	}
+
 	<-ctx.Done()
 	if err := cleanupQueue(); err != nil {
 		return fmt.Errorf("could not cleanup queue: %w", err)
@@ -694,7 +838,7 @@ func (s *DispatcherImpl) subscribeToWorkflowEventsByAdditionalMeta(key string, v
 }
 
 // SubscribeToWorkflowEvents registers workflow events with the dispatcher
-func (s *DispatcherImpl) subscribeToWorkflowEventsByWorkflowRunId(workflowRunId string, stream contracts.Dispatcher_SubscribeToWorkflowEventsServer) error {
+func (s *DispatcherImpl) subscribeToWorkflowEventsByWorkflowRunId(workflowRunId string, token resumeToken, stream contracts.Dispatcher_SubscribeToWorkflowEventsServer) error {
 	tenant := stream.Context().Value("tenant").(*dbsqlc.Tenant)
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
 
@@ -714,19 +858,39 @@ func (s *DispatcherImpl) subscribeToWorkflowEventsByWorkflowRunId(workflowRunId
 		return err
 	}
 
+	convert := func(task *msgqueue.Message) (*contracts.WorkflowEvent, error) {
+		return s.tenantTaskToWorkflowEventByRunId(task, tenantId, workflowRunId)
+	}
+
+	// if the workflow run is in a final state, send the events which the client missed before hanging up
 	if repository.IsFinalWorkflowRunStatus(workflowRun.WorkflowRun.Status) {
-		return nil
+		if token == nil {
+			return nil
+		}
+
+		_, err := s.replayWorkflowEvents(ctx, tenantId, []string{workflowRunId}, token, newWorkflowEventStream(stream, token), convert)
+
+		return err
 	}
 
-	wg := sync.WaitGroup{}
+	// keep storing the events of the tenant, so the client can resume the subscription
+	release, err := s.eventLog.acquire(tenantId)
 
-	sendMu := sync.Mutex{}
+	if err != nil {
+		return err
+	}
+
+	defer release()
+
+	es := newWorkflowEventStream(stream, token)
+
+	wg := sync.WaitGroup{}
 
 	f := func(task *msgqueue.Message) error {
 		wg.Add(1)
 		defer wg.Done()
 
-		e, err := s.tenantTaskToWorkflowEventByRunId(task, tenantId, workflowRunId)
+		e, err := convert(task)
 
 		if err != nil {
 			s.l.Error().Err(err).Msgf("could not convert task to workflow event")
@@ -736,9 +900,7 @@ func (s *DispatcherImpl) subscribeToWorkflowEventsByWorkflowRunId(workflowRunId
 		}
 
 		// send the task to the client
-		sendMu.Lock()
-		err = stream.Send(e)
-		sendMu.Unlock()
+		hangup, err := es.send(e, s.appendWorkflowEvent(ctx, tenantId, task))
 
 		if err != nil {
 			cancel() // FIXME is this necessary?
@@ -746,7 +908,7 @@ func (s *DispatcherImpl) subscribeToWorkflowEventsByWorkflowRunId(workflowRunId
 			return nil
 		}
 
-		if e.Hangup {
+		if hangup {
 			cancel()
 		}
 
@@ -760,6 +922,18 @@ func (s *DispatcherImpl) subscribeToWorkflowEventsByWorkflowRunId(workflowRunId
 		return err
 	}
 
+	// send the events which the client missed since it received the resume token
+	if token != nil {
+		hangup, err := s.replayWorkflowEvents(ctx, tenantId, []string{workflowRunId}, token, es, convert)
+
+		if err != nil {
+			s.l.Error().Err(err).Msgf("could not replay workflow events to client")
+			cancel()
+		} else if hangup {
+			cancel()
+		}
+	}
+
 	<-ctx.Done()
 	if err := cleanupQueue(); err != nil {
 		return fmt.Errorf("could not cleanup queue: %w", err)
@@ -1089,6 +1263,40 @@ func (s *DispatcherImpl) Unsubscribe(ctx context.Context, request *contracts.Wor
 	}, nil
 }
 
+// DrainWorker marks a worker as draining, so that the scheduler stops assigning new runs to it
+// while the runs which are already assigned to the worker can finish.
+func (s *DispatcherImpl) DrainWorker(ctx context.Context, request *contracts.WorkerDrainRequest) (*contracts.WorkerDrainResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	// make sure the worker belongs to the tenant before updating it
+	_, err := s.repo.Worker().GetWorkerForEngine(ctx, tenantId, request.WorkerId)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "worker %s not found", request.WorkerId)
+		}
+
+		return nil, err
+	}
+
+	isDraining := true
+
+	_, err = s.repo.Worker().UpdateWorker(ctx, tenantId, request.WorkerId, &repository.UpdateWorkerOpts{
+		IsDraining: &isDraining,
+	})
+
+	if err != nil {
+		s.l.Error().Err(err).Msgf("could not mark worker %s as draining", request.WorkerId)
+		return nil, err
+	}
+
+	return &contracts.WorkerDrainResponse{
+		TenantId: tenantId,
+		WorkerId: request.WorkerId,
+	}, nil
+}
+
 func (d *DispatcherImpl) RefreshTimeout(ctx context.Context, request *contracts.RefreshTimeoutRequest) (*contracts.RefreshTimeoutResponse, error) {
 	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
@@ -1120,6 +1328,58 @@ func (d *DispatcherImpl) RefreshTimeout(ctx context.Context, request *contracts.
 
 }
 
+// ReportProgress stores the progress of a step run, and sends it to the clients which are subscribed to its workflow
+// run.
+func (d *DispatcherImpl) ReportProgress(ctx context.Context, request *contracts.ReportProgressRequest) (*contracts.ReportProgressResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	opts := &repository.ReportStepRunProgressOpts{
+		StepRunId: request.StepRunId,
+		Percent:   request.Percent,
+		Message:   request.Message,
+	}
+
+	if request.Data != nil {
+		if !json.Valid([]byte(*request.Data)) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid request: progress data is not valid JSON")
+		}
+
+		opts.Data = []byte(*request.Data)
+	}
+
+	if apiErrors, err := d.v.ValidateAPI(opts); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %s", apiErrors.String())
+	}
+
+	// the meta of the step run is looked up by tenant, so step runs of other tenants can't be reported on
+	meta, err := d.repo.StreamEvent().GetStreamEventMeta(ctx, tenantId, request.StepRunId)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "step run %s not found", request.StepRunId)
+		}
+
+		return nil, err
+	}
+
+	progress, err := d.repo.StepRunProgress().ReportProgress(ctx, tenantId, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.mq.AddMessage(ctx, msgqueue.TenantEventConsumerQueue(tenantId), tasktypes.StepRunProgressToTask(progress, meta))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not publish step run progress: %w", err)
+	}
+
+	return &contracts.ReportProgressResponse{}, nil
+}
+
 func (s *DispatcherImpl) handleStepRunStarted(inputCtx context.Context, request *contracts.StepActionEvent) (*contracts.ActionEventResponse, error) {
 	tenant := inputCtx.Value("tenant").(*dbsqlc.Tenant)
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
@@ -1138,6 +1398,14 @@ func (s *DispatcherImpl) handleStepRunStarted(inputCtx context.Context, request
 		return nil, err
 	}
 
+	// a speculative attempt on another worker doesn't change the state of the step run
+	if isSpeculativeAttempt(sr, request.WorkerId) {
+		return &contracts.ActionEventResponse{
+			TenantId: tenantId,
+			WorkerId: request.WorkerId,
+		}, nil
+	}
+
 	err = s.repo.StepRun().StepRunStarted(ctx, tenantId, sqlchelpers.UUIDToStr(sr.WorkflowRunId), request.StepRunId, startedAt)
 
 	if err != nil {
@@ -1192,6 +1460,13 @@ func (s *DispatcherImpl) handleStepRunAcked(inputCtx context.Context, request *c
 		return nil, err
 	}
 
+	if isSpeculativeAttempt(sr, request.WorkerId) {
+		return &contracts.ActionEventResponse{
+			TenantId: tenantId,
+			WorkerId: request.WorkerId,
+		}, nil
+	}
+
 	payload, _ := datautils.ToJSONMap(tasktypes.StepRunStartedTaskPayload{
 		StepRunId:     request.StepRunId,
 		StartedAt:     startedAt.Format(time.RFC3339),
@@ -1230,13 +1505,6 @@ func (s *DispatcherImpl) handleStepRunCompleted(inputCtx context.Context, reques
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	err := s.repo.StepRun().ReleaseStepRunSemaphore(ctx, tenantId, request.StepRunId, false)
-
-	if err != nil {
-		s.l.Error().Err(err).Msgf("could not release semaphore for step run %s", request.StepRunId)
-		return nil, err
-	}
-
 	s.l.Debug().Msgf("Received step completed event for step run %s", request.StepRunId)
 
 	// verify that the event payload can be unmarshalled into a map type
@@ -1266,25 +1534,43 @@ func (s *DispatcherImpl) handleStepRunCompleted(inputCtx context.Context, reques
 		return nil, err
 	}
 
-	payload, _ := datautils.ToJSONMap(tasktypes.StepRunFinishedTaskPayload{
-		WorkflowRunId:  sqlchelpers.UUIDToStr(meta.WorkflowRunId),
-		StepRunId:      request.StepRunId,
-		FinishedAt:     finishedAt.Format(time.RFC3339),
-		StepOutputData: request.EventPayload,
-		StepRetries:    &meta.Retries,
-		RetryCount:     &meta.RetryCount,
-	})
+	err = s.ackStepRunEvent(ctx, tenantId, request, meta.RetryCount, func() error {
+		// large outputs are moved to the blob store, and only a reference is persisted. Duplicate events are
+		// acked before this, so they don't write the output again.
+		outputData, err := s.blobs.OffloadOutput(ctx, tenantId, []byte(request.EventPayload))
 
-	metadata, _ := datautils.ToJSONMap(tasktypes.StepRunFinishedTaskMetadata{
-		TenantId: tenantId,
-	})
+		if err != nil {
+			return fmt.Errorf("could not offload step run output: %w", err)
+		}
 
-	// send the event to the jobs queue
-	err = s.mq.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{
-		ID:       "step-run-finished",
-		Payload:  payload,
-		Metadata: metadata,
-		Retries:  3,
+		err = s.repo.StepRun().ReleaseStepRunSemaphore(ctx, tenantId, request.StepRunId, false)
+
+		if err != nil {
+			s.l.Error().Err(err).Msgf("could not release semaphore for step run %s", request.StepRunId)
+			return err
+		}
+
+		payload, _ := datautils.ToJSONMap(tasktypes.StepRunFinishedTaskPayload{
+			WorkflowRunId:  sqlchelpers.UUIDToStr(meta.WorkflowRunId),
+			StepRunId:      request.StepRunId,
+			FinishedAt:     finishedAt.Format(time.RFC3339),
+			StepOutputData: string(outputData),
+			StepRetries:    &meta.Retries,
+			RetryCount:     &meta.RetryCount,
+			WorkerId:       request.WorkerId,
+		})
+
+		metadata, _ := datautils.ToJSONMap(tasktypes.StepRunFinishedTaskMetadata{
+			TenantId: tenantId,
+		})
+
+		// send the event to the jobs queue
+		return s.mq.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{
+			ID:       "step-run-finished",
+			Payload:  payload,
+			Metadata: metadata,
+			Retries:  3,
+		})
 	})
 
 	if err != nil {
@@ -1305,13 +1591,6 @@ func (s *DispatcherImpl) handleStepRunFailed(inputCtx context.Context, request *
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	err := s.repo.StepRun().ReleaseStepRunSemaphore(ctx, tenantId, request.StepRunId, false)
-
-	if err != nil {
-		s.l.Error().Err(err).Msgf("could not release semaphore for step run %s", request.StepRunId)
-		return nil, err
-	}
-
 	s.l.Debug().Msgf("Received step failed event for step run %s", request.StepRunId)
 
 	failedAt := request.EventTimestamp.AsTime()
@@ -1322,25 +1601,35 @@ func (s *DispatcherImpl) handleStepRunFailed(inputCtx context.Context, request *
 		return nil, err
 	}
 
-	payload, _ := datautils.ToJSONMap(tasktypes.StepRunFailedTaskPayload{
-		WorkflowRunId: sqlchelpers.UUIDToStr(meta.WorkflowRunId),
-		StepRunId:     request.StepRunId,
-		FailedAt:      failedAt.Format(time.RFC3339),
-		Error:         request.EventPayload,
-		StepRetries:   &meta.Retries,
-		RetryCount:    &meta.RetryCount,
-	})
+	err = s.ackStepRunEvent(ctx, tenantId, request, meta.RetryCount, func() error {
+		err := s.repo.StepRun().ReleaseStepRunSemaphore(ctx, tenantId, request.StepRunId, false)
 
-	metadata, _ := datautils.ToJSONMap(tasktypes.StepRunFailedTaskMetadata{
-		TenantId: tenantId,
-	})
+		if err != nil {
+			s.l.Error().Err(err).Msgf("could not release semaphore for step run %s", request.StepRunId)
+			return err
+		}
 
-	// send the event to the jobs queue
-	err = s.mq.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{
-		ID:       "step-run-failed",
-		Payload:  payload,
-		Metadata: metadata,
-		Retries:  3,
+		payload, _ := datautils.ToJSONMap(tasktypes.StepRunFailedTaskPayload{
+			WorkflowRunId: sqlchelpers.UUIDToStr(meta.WorkflowRunId),
+			StepRunId:     request.StepRunId,
+			FailedAt:      failedAt.Format(time.RFC3339),
+			Error:         request.EventPayload,
+			StepRetries:   &meta.Retries,
+			RetryCount:    &meta.RetryCount,
+			WorkerId:      request.WorkerId,
+		})
+
+		metadata, _ := datautils.ToJSONMap(tasktypes.StepRunFailedTaskMetadata{
+			TenantId: tenantId,
+		})
+
+		// send the event to the jobs queue
+		return s.mq.AddMessage(ctx, msgqueue.JOB_PROCESSING_QUEUE, &msgqueue.Message{
+			ID:       "step-run-failed",
+			Payload:  payload,
+			Metadata: metadata,
+			Retries:  3,
+		})
 	})
 
 	if err != nil {
@@ -1353,6 +1642,12 @@ func (s *DispatcherImpl) handleStepRunFailed(inputCtx context.Context, request *
 	}, nil
 }
 
+// isSpeculativeAttempt returns true if the event was sent by a worker running a speculative attempt of the
+// step run, rather than the worker the step run was assigned to.
+func isSpeculativeAttempt(sr *dbsqlc.GetStepRunForEngineRow, workerId string) bool {
+	return sr.SRWorkerId.Valid && workerId != "" && sqlchelpers.UUIDToStr(sr.SRWorkerId) != workerId
+}
+
 func (s *DispatcherImpl) handleGetGroupKeyRunStarted(inputCtx context.Context, request *contracts.GroupKeyActionEvent) (*contracts.ActionEventResponse, error) {
 	tenant := inputCtx.Value("tenant").(*dbsqlc.Tenant)
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
@@ -1495,6 +1790,9 @@ func (s *DispatcherImpl) taskToWorkflowEvent(task *msgqueue.Message, tenantId st
 
 	var stepRunId string
 
+	// the message of a stream chunk, which is sent inline instead of being stored as a stream event
+	var streamMessage *string
+
 	switch task.ID {
 	case "step-run-started":
 		payload, err := UnmarshalPayload[tasktypes.StepRunStartedTaskPayload](task.Payload)
@@ -1574,6 +1872,25 @@ func (s *DispatcherImpl) taskToWorkflowEvent(task *msgqueue.Message, tenantId st
 
 		workflowEvent.StepRetries = payload.StepRetries
 		workflowEvent.RetryCount = payload.RetryCount
+
+		streamMessage = payload.Message
+	case "step-run-progress":
+		payload, err := UnmarshalPayload[tasktypes.StepRunProgressTaskPayload](task.Payload)
+		if err != nil {
+			return nil, err
+		}
+		workflowEvent.WorkflowRunId = payload.WorkflowRunId
+		stepRunId = payload.StepRunId
+		workflowEvent.ResourceType = contracts.ResourceType_RESOURCE_TYPE_STEP_RUN
+		workflowEvent.ResourceId = stepRunId
+		workflowEvent.EventType = contracts.ResourceEventType_RESOURCE_EVENT_TYPE_PROGRESS
+		workflowEvent.StepRetries = payload.StepRetries
+		workflowEvent.RetryCount = payload.RetryCount
+
+		workflowEvent.EventPayload, err = payload.EventPayload()
+		if err != nil {
+			return nil, err
+		}
 	case "workflow-run-finished":
 		payload, err := UnmarshalPayload[tasktypes.WorkflowRunFinishedTask](task.Payload)
 		if err != nil {
@@ -1609,7 +1926,9 @@ func (s *DispatcherImpl) taskToWorkflowEvent(task *msgqueue.Message, tenantId st
 	}
 
 	if workflowEvent.ResourceType == contracts.ResourceType_RESOURCE_TYPE_STEP_RUN {
-		if workflowEvent.EventType == contracts.ResourceEventType_RESOURCE_EVENT_TYPE_STREAM {
+		if workflowEvent.EventType == contracts.ResourceEventType_RESOURCE_EVENT_TYPE_STREAM && streamMessage != nil {
+			workflowEvent.EventPayload = *streamMessage
+		} else if workflowEvent.EventType == contracts.ResourceEventType_RESOURCE_EVENT_TYPE_STREAM {
 			streamEventId, err := strconv.ParseInt(task.Metadata["stream_event_id"].(string), 10, 64)
 			if err != nil {
 				return nil, err
@@ -1754,12 +2073,21 @@ func (s *DispatcherImpl) toWorkflowRunEvent(tenantId string, workflowRuns []*dbs
 		return nil, err
 	}
 
+	workflowRunOutputs := make(map[string]*string)
+
+	for _, workflowRun := range finalWorkflowRuns {
+		if workflowRun.WorkflowRun.Output != nil {
+			workflowRunOutputs[sqlchelpers.UUIDToStr(workflowRun.WorkflowRun.ID)] = repository.StringPtr(string(workflowRun.WorkflowRun.Output))
+		}
+	}
+
 	for workflowRunId, stepRunResults := range mappedStepRunResults {
 		res = append(res, &contracts.WorkflowRunEvent{
 			WorkflowRunId:  workflowRunId,
 			EventType:      contracts.WorkflowRunEventType_WORKFLOW_RUN_EVENT_TYPE_FINISHED,
 			EventTimestamp: timestamppb.Now(),
 			Results:        stepRunResults,
+			Output:         workflowRunOutputs[workflowRunId],
 		})
 	}
 
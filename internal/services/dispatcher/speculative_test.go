@@ -0,0 +1,55 @@
+package dispatcher
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestIsSpeculativeAttempt(t *testing.T) {
+	assignedWorkerId := uuid.New().String()
+
+	assigned := &dbsqlc.GetStepRunForEngineRow{
+		SRWorkerId: sqlchelpers.UUIDFromStr(assignedWorkerId),
+	}
+
+	tests := []struct {
+		name     string
+		stepRun  *dbsqlc.GetStepRunForEngineRow
+		workerId string
+		expected bool
+	}{
+		{
+			name:     "event from the assigned worker",
+			stepRun:  assigned,
+			workerId: assignedWorkerId,
+		},
+		{
+			name:     "event from another worker",
+			stepRun:  assigned,
+			workerId: uuid.New().String(),
+			expected: true,
+		},
+		{
+			// older workers don't send their id with step run events
+			name:    "event without a worker",
+			stepRun: assigned,
+		},
+		{
+			name:     "step run which isn't assigned",
+			stepRun:  &dbsqlc.GetStepRunForEngineRow{SRWorkerId: pgtype.UUID{}},
+			workerId: uuid.New().String(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isSpeculativeAttempt(tt.stepRun, tt.workerId))
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package dispatcher
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+const (
+	// maxStreamChunkSize is the size limit of the message of a stream chunk.
+	maxStreamChunkSize = 64 * 1024
+
+	// maxStreamLogLineLength is the length limit of a log line, persisted output which is longer is split into
+	// several log lines.
+	maxStreamLogLineLength = 10000
+)
+
+// PutStreamChunks receives the incremental output of step runs. The chunks are published to the clients which are
+// subscribed to the workflow runs of the step runs in the order they're received, and chunks which should be
+// persisted are stored as log lines of their step runs. Chunks are received one at a time, so a client which sends
+// chunks faster than they can be published is slowed down by the flow control of the stream.
+func (s *DispatcherImpl) PutStreamChunks(stream contracts.Dispatcher_PutStreamChunksServer) error {
+	tenant := stream.Context().Value("tenant").(*dbsqlc.Tenant)
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	w := &streamChunkWriter{
+		d:        s,
+		tenantId: tenantId,
+		stepRuns: make(map[string]*streamChunkStepRun),
+	}
+
+	// persist the output which doesn't end with a newline, also if the stream breaks
+	defer w.flush(context.Background())
+
+	var received int64
+
+	for {
+		chunk, err := stream.Recv()
+
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&contracts.PutStreamChunksResponse{
+				Received: received,
+			})
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := w.put(stream.Context(), chunk); err != nil {
+			return err
+		}
+
+		received++
+	}
+}
+
+// streamChunkWriter publishes and persists the stream chunks of a single stream.
+type streamChunkWriter struct {
+	d        *DispatcherImpl
+	tenantId string
+
+	stepRuns map[string]*streamChunkStepRun
+}
+
+type streamChunkStepRun struct {
+	meta *dbsqlc.GetStreamEventMetaRow
+
+	// lastIndex is the index of the last chunk which was published
+	lastIndex int64
+
+	// line is the persisted output which doesn't end with a newline yet, and lineCreatedAt is when its first chunk
+	// was created
+	line          []byte
+	lineCreatedAt time.Time
+}
+
+func (w *streamChunkWriter) put(ctx context.Context, chunk *contracts.StreamChunk) error {
+	if _, err := uuid.Parse(chunk.StepRunId); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid step run id %s", chunk.StepRunId)
+	}
+
+	if len(chunk.Message) > maxStreamChunkSize {
+		return status.Errorf(codes.InvalidArgument, "stream chunk of %d bytes exceeds the limit of %d bytes", len(chunk.Message), maxStreamChunkSize)
+	}
+
+	sr, err := w.stepRun(ctx, chunk.StepRunId)
+
+	if err != nil {
+		return err
+	}
+
+	// chunks are published in the order of their index, so chunks which arrive late are dropped
+	if chunk.Index <= sr.lastIndex {
+		return nil
+	}
+
+	sr.lastIndex = chunk.Index
+
+	createdAt := time.Now().UTC()
+
+	if chunk.CreatedAt != nil {
+		createdAt = chunk.CreatedAt.AsTime().UTC()
+	}
+
+	err = w.d.mq.AddMessage(ctx, msgqueue.TenantEventConsumerQueue(w.tenantId), streamChunkToTask(w.tenantId, chunk, sr.meta, createdAt))
+
+	if err != nil {
+		return fmt.Errorf("could not publish stream chunk: %w", err)
+	}
+
+	if chunk.Persist {
+		w.persist(ctx, chunk.StepRunId, sr, chunk.Message, createdAt)
+	}
+
+	return nil
+}
+
+func (w *streamChunkWriter) stepRun(ctx context.Context, stepRunId string) (*streamChunkStepRun, error) {
+	if sr, ok := w.stepRuns[stepRunId]; ok {
+		return sr, nil
+	}
+
+	meta, err := w.d.repo.StreamEvent().GetStreamEventMeta(ctx, w.tenantId, stepRunId)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "step run %s not found", stepRunId)
+		}
+
+		return nil, fmt.Errorf("could not get step run %s: %w", stepRunId, err)
+	}
+
+	sr := &streamChunkStepRun{
+		meta:      meta,
+		lastIndex: -1,
+	}
+
+	w.stepRuns[stepRunId] = sr
+
+	return sr, nil
+}
+
+// persist stores the complete lines of the output of a step run as log lines, and holds back the rest until it's
+// completed by a later chunk or the stream ends.
+func (w *streamChunkWriter) persist(ctx context.Context, stepRunId string, sr *streamChunkStepRun, message []byte, createdAt time.Time) {
+	if len(sr.line) == 0 {
+		sr.lineCreatedAt = createdAt
+	}
+
+	sr.line = append(sr.line, message...)
+
+	for {
+		i := bytes.IndexByte(sr.line, '\n')
+
+		switch {
+		case i >= 0 && i <= maxStreamLogLineLength:
+			w.putLog(ctx, stepRunId, sr.line[:i], sr.lineCreatedAt)
+			sr.line = sr.line[i+1:]
+		case len(sr.line) > maxStreamLogLineLength:
+			w.putLog(ctx, stepRunId, sr.line[:maxStreamLogLineLength], sr.lineCreatedAt)
+			sr.line = sr.line[maxStreamLogLineLength:]
+		default:
+			sr.line = bytes.Clone(sr.line)
+			return
+		}
+
+		sr.lineCreatedAt = createdAt
+	}
+}
+
+// flush stores the output of the step runs which doesn't end with a newline.
+func (w *streamChunkWriter) flush(ctx context.Context) {
+	for stepRunId, sr := range w.stepRuns {
+		if len(sr.line) > 0 {
+			w.putLog(ctx, stepRunId, sr.line, sr.lineCreatedAt)
+			sr.line = nil
+		}
+	}
+}
+
+func (w *streamChunkWriter) putLog(ctx context.Context, stepRunId string, line []byte, createdAt time.Time) {
+	_, err := w.d.repo.Log().PutLog(ctx, w.tenantId, &repository.CreateLogLineOpts{
+		StepRunId: stepRunId,
+		CreatedAt: &createdAt,
+		Message:   string(line),
+	})
+
+	if err != nil {
+		w.d.l.Error().Err(err).Msgf("could not persist stream chunk of step run %s", stepRunId)
+	}
+}
+
+func streamChunkToTask(tenantId string, chunk *contracts.StreamChunk, meta *dbsqlc.GetStreamEventMetaRow, createdAt time.Time) *msgqueue.Message {
+	message := string(chunk.Message)
+	index := chunk.Index
+
+	payload, _ := datautils.ToJSONMap(tasktypes.StepRunStreamEventTaskPayload{
+		WorkflowRunId: sqlchelpers.UUIDToStr(meta.WorkflowRunId),
+		StepRunId:     chunk.StepRunId,
+		CreatedAt:     createdAt.String(),
+		StepRetries:   &meta.Retries,
+		RetryCount:    &meta.RetryCount,
+		Message:       &message,
+		ChunkIndex:    &index,
+	})
+
+	metadata, _ := datautils.ToJSONMap(tasktypes.StepRunStreamEventTaskMetadata{
+		TenantId: tenantId,
+	})
+
+	return &msgqueue.Message{
+		ID:       "step-run-stream-event",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
@@ -0,0 +1,450 @@
+package eventsinks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/cloudevents"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/partition"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+const (
+	// batchSize is the maximum number of deliveries which are sent to a sink at once
+	batchSize = 100
+
+	// pollInterval is how often a sink checks for deliveries which are due for a retry
+	pollInterval = 5 * time.Second
+
+	maxBackoff = time.Minute
+)
+
+// EventSinks mirrors the event stream of the tenants in the worker partition to their event sinks. Records are
+// stored as deliveries before they're sent, and deleted once the sink accepted them, so they're delivered at least
+// once. Deliveries which fail are retried with an exponential backoff, and dead-lettered after the maximum number of
+// attempts of their sink.
+type EventSinks struct {
+	sc     *server.ServerConfig
+	p      *partition.Partition
+	reader *msgqueue.SharedTenantReader
+
+	mu      sync.Mutex
+	sinks   map[string]*sinkRunner
+	tenants map[string]func() error
+	wg      sync.WaitGroup
+}
+
+type sinkRunner struct {
+	sink   *dbsqlc.EventSink
+	cancel context.CancelFunc
+
+	// notify wakes up the delivery loop of the sink when new deliveries were created
+	notify chan struct{}
+}
+
+func New(sc *server.ServerConfig, p *partition.Partition) *EventSinks {
+	return &EventSinks{
+		sc:      sc,
+		p:       p,
+		reader:  msgqueue.NewSharedTenantReader(sc.MessageQueue),
+		sinks:   map[string]*sinkRunner{},
+		tenants: map[string]func() error{},
+	}
+}
+
+func (e *EventSinks) Start() (func() error, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ticker := time.NewTicker(10 * time.Second)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				if err := e.check(ctx); err != nil {
+					e.sc.Logger.Warn().Err(err).Msgf("error checking event sinks")
+				}
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+
+	return func() error {
+		cancel()
+
+		e.mu.Lock()
+		for tenantId, unsubscribe := range e.tenants {
+			if err := unsubscribe(); err != nil {
+				e.sc.Logger.Warn().Err(err).Msgf("could not unsubscribe from events of tenant %s", tenantId)
+			}
+		}
+
+		for _, runner := range e.sinks {
+			runner.cancel()
+		}
+		e.mu.Unlock()
+
+		e.wg.Wait()
+
+		return nil
+	}, nil
+}
+
+// check starts delivering to new sinks and stops delivering to deleted sinks, and subscribes to the events of the
+// tenants which have sinks.
+func (e *EventSinks) check(ctx context.Context) error {
+	sinks, err := e.sc.EngineRepository.EventSink().ListEventSinksByPartitionId(ctx, e.p.GetWorkerPartitionId())
+
+	if err != nil {
+		return fmt.Errorf("could not list event sinks: %w", err)
+	}
+
+	current := make(map[string]*dbsqlc.EventSink, len(sinks))
+	tenants := make(map[string]bool)
+
+	for _, sink := range sinks {
+		current[sqlchelpers.UUIDToStr(sink.ID)] = sink
+		tenants[sqlchelpers.UUIDToStr(sink.TenantId)] = true
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for id, runner := range e.sinks {
+		if _, ok := current[id]; !ok {
+			runner.cancel()
+			delete(e.sinks, id)
+		}
+	}
+
+	for id, sink := range current {
+		if _, ok := e.sinks[id]; ok || ctx.Err() != nil {
+			continue
+		}
+
+		runCtx, cancel := context.WithCancel(ctx)
+
+		runner := &sinkRunner{
+			sink:   sink,
+			cancel: cancel,
+			notify: make(chan struct{}, 1),
+		}
+
+		e.sinks[id] = runner
+
+		e.wg.Add(1)
+
+		go func() {
+			defer e.wg.Done()
+			e.deliver(runCtx, runner)
+		}()
+	}
+
+	for tenantId, unsubscribe := range e.tenants {
+		if !tenants[tenantId] {
+			if err := unsubscribe(); err != nil {
+				e.sc.Logger.Warn().Err(err).Msgf("could not unsubscribe from events of tenant %s", tenantId)
+			}
+
+			delete(e.tenants, tenantId)
+		}
+	}
+
+	for tenantId := range tenants {
+		if _, ok := e.tenants[tenantId]; ok || ctx.Err() != nil {
+			continue
+		}
+
+		// the fanout exchange of the tenant may not exist yet if no messages were published for the tenant
+		if err := e.sc.MessageQueue.RegisterTenant(ctx, tenantId); err != nil {
+			return fmt.Errorf("could not register tenant %s: %w", tenantId, err)
+		}
+
+		unsubscribe, err := e.reader.Subscribe(tenantId, e.handleMessage)
+
+		if err != nil {
+			return fmt.Errorf("could not subscribe to events of tenant %s: %w", tenantId, err)
+		}
+
+		e.tenants[tenantId] = unsubscribe
+	}
+
+	return nil
+}
+
+// handleMessage stores a delivery of the record of a message for each sink of the tenant which the record matches.
+// The message was already acknowledged, so the record is lost if the deliveries can't be stored.
+func (e *EventSinks) handleMessage(msg *msgqueue.Message) error {
+	record, err := messageToRecord(msg, time.Now())
+
+	if err != nil {
+		return fmt.Errorf("could not convert message %s to event sink record: %w", msg.ID, err)
+	}
+
+	if record == nil {
+		return nil
+	}
+
+	payload, err := json.Marshal(record)
+
+	if err != nil {
+		return err
+	}
+
+	var opts []*repository.CreateEventSinkDeliveryOpts
+	var notify []chan struct{}
+
+	e.mu.Lock()
+	for id, runner := range e.sinks {
+		if sqlchelpers.UUIDToStr(runner.sink.TenantId) != record.TenantId || !matches(runner.sink.EventTypes, record.Type) {
+			continue
+		}
+
+		opts = append(opts, &repository.CreateEventSinkDeliveryOpts{
+			SinkId:  id,
+			Payload: payload,
+		})
+
+		notify = append(notify, runner.notify)
+	}
+	e.mu.Unlock()
+
+	if len(opts) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := e.sc.EngineRepository.EventSink().CreateEventSinkDeliveries(ctx, record.TenantId, opts); err != nil {
+		return fmt.Errorf("could not create event sink deliveries: %w", err)
+	}
+
+	for _, ch := range notify {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+// deliver sends the deliveries of a sink until the context is cancelled.
+func (e *EventSinks) deliver(ctx context.Context, runner *sinkRunner) {
+	sink := runner.sink
+	sinkId := sqlchelpers.UUIDToStr(sink.ID)
+	failures := 0
+
+	var s sender
+
+	defer func() {
+		if s != nil {
+			_ = s.close()
+		}
+	}()
+
+	for ctx.Err() == nil {
+		var err error
+
+		if s == nil {
+			s, err = e.newSender(ctx, sink)
+		}
+
+		var count int
+
+		if err == nil {
+			count, err = e.deliverBatch(ctx, sink, s)
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			failures++
+			e.sc.Logger.Warn().Err(err).Msgf("could not deliver records to event sink %s", sinkId)
+
+			// connections are opened again after a failure, which also picks up rotated credentials
+			if s != nil {
+				_ = s.close()
+				s = nil
+			}
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(min(time.Second<<min(failures-1, 6), maxBackoff)):
+			}
+
+			continue
+		}
+
+		failures = 0
+
+		if count == batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-runner.notify:
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// deliverBatch sends the due deliveries of a sink, deletes the deliveries which were delivered and schedules the
+// retries of the deliveries which failed. It returns the number of deliveries which were due.
+func (e *EventSinks) deliverBatch(ctx context.Context, sink *dbsqlc.EventSink, s sender) (int, error) {
+	repo := e.sc.EngineRepository.EventSink()
+
+	deliveries, err := repo.ListDueEventSinkDeliveries(ctx, sqlchelpers.UUIDToStr(sink.ID), batchSize)
+
+	if err != nil {
+		return 0, fmt.Errorf("could not list event sink deliveries: %w", err)
+	}
+
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+
+	records := make([]*outgoing, 0, len(deliveries))
+	ids := make([]int64, 0, len(deliveries))
+
+	for _, delivery := range deliveries {
+		record := &Record{}
+
+		if err := json.Unmarshal(delivery.Payload, record); err != nil {
+			return 0, fmt.Errorf("could not decode event sink delivery %d: %w", delivery.ID, err)
+		}
+
+		out := &outgoing{
+			record:      record,
+			payload:     delivery.Payload,
+			contentType: "application/json",
+		}
+
+		if sink.Format == dbsqlc.EventSinkFormatCLOUDEVENTS {
+			out.payload, err = toCloudEvent(record, delivery.Payload, fmt.Sprintf("%s/tenants/%s", e.sc.Runtime.ServerURL, record.TenantId))
+
+			if err != nil {
+				return 0, fmt.Errorf("could not convert event sink delivery %d to a CloudEvent: %w", delivery.ID, err)
+			}
+
+			out.contentType = cloudevents.ContentType
+		}
+
+		records = append(records, out)
+		ids = append(ids, delivery.ID)
+	}
+
+	errs := s.send(ctx, records)
+
+	delivered := make([]int64, 0, len(errs))
+	failed := make(map[string][]int64)
+
+	var firstErr error
+
+	for i, err := range errs {
+		if err == nil {
+			delivered = append(delivered, ids[i])
+			continue
+		}
+
+		if firstErr == nil {
+			firstErr = err
+		}
+
+		failed[err.Error()] = append(failed[err.Error()], ids[i])
+	}
+
+	// the deliveries are deleted with a new context, so deliveries which were sent aren't sent again on shutdown
+	dbCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := repo.DeleteEventSinkDeliveries(dbCtx, delivered); err != nil {
+		return 0, fmt.Errorf("could not delete delivered event sink deliveries: %w", err)
+	}
+
+	for lastError, failedIds := range failed {
+		if err := repo.FailEventSinkDeliveries(dbCtx, failedIds, sink.MaxAttempts, lastError); err != nil {
+			return 0, fmt.Errorf("could not fail event sink deliveries: %w", err)
+		}
+	}
+
+	if firstErr != nil {
+		return 0, firstErr
+	}
+
+	return len(deliveries), nil
+}
+
+// newSender creates the sender of a sink with the credentials which are stored in the secrets of the tenant.
+func (e *EventSinks) newSender(ctx context.Context, sink *dbsqlc.EventSink) (sender, error) {
+	tenantId := sqlchelpers.UUIDToStr(sink.TenantId)
+
+	if sink.Kind == dbsqlc.EventSinkKindWEBHOOK {
+		secret, err := e.sc.Encryption.DecryptString(sink.Secret.String, tenantId)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not decrypt webhook secret: %w", err)
+		}
+
+		return newWebhookSender(sink.Url.String, secret), nil
+	}
+
+	var username, password string
+
+	if sink.UsernameSecret.Valid || sink.PasswordSecret.Valid {
+		if e.sc.SecretResolver == nil {
+			return nil, fmt.Errorf("secrets are disabled, so the credentials of the sink can't be resolved")
+		}
+
+		var err error
+
+		if sink.UsernameSecret.Valid {
+			username, err = e.sc.SecretResolver.GetSecret(ctx, tenantId, sink.UsernameSecret.String)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if sink.PasswordSecret.Valid {
+			password, err = e.sc.SecretResolver.GetSecret(ctx, tenantId, sink.PasswordSecret.String)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	switch sink.Kind {
+	case dbsqlc.EventSinkKindKAFKA:
+		s, err := newKafkaSender(sink.Brokers, sink.Topic.String, sink.Tls, username, password)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return s, nil
+	case dbsqlc.EventSinkKindNATS:
+		s, err := newNATSSender(sink.Url.String, sink.Topic.String, username, password)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return s, nil
+	default:
+		return nil, fmt.Errorf("unknown event sink kind %s", sink.Kind)
+	}
+}
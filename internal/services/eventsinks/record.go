@@ -0,0 +1,299 @@
+package eventsinks
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/cloudevents"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+)
+
+const (
+	RecordTypeEventCreated         = "event.created"
+	RecordTypeWorkflowRunQueued    = "workflow_run.queued"
+	RecordTypeWorkflowRunSucceeded = "workflow_run.succeeded"
+	RecordTypeWorkflowRunFailed    = "workflow_run.failed"
+	RecordTypeWorkflowRunCancelled = "workflow_run.cancelled"
+	RecordTypeStepRunStarted       = "step_run.started"
+	RecordTypeStepRunCompleted     = "step_run.completed"
+	RecordTypeStepRunFailed        = "step_run.failed"
+	RecordTypeStepRunCancelled     = "step_run.cancelled"
+	RecordTypeStepRunTimedOut      = "step_run.timed_out"
+	RecordTypeAuditLogCreated      = "audit_log.created"
+	recordTypeWorkflowRunPrefix    = "workflow_run."
+
+	// cloudEventTypePrefix prefixes the types of records in the types of CloudEvents, so the types are in reverse DNS
+	// notation like run.hatchet.workflow_run.succeeded
+	cloudEventTypePrefix = "run.hatchet."
+)
+
+// RecordTypes are the types of the records which are delivered to event sinks.
+var RecordTypes = []string{
+	RecordTypeEventCreated,
+	RecordTypeWorkflowRunQueued,
+	RecordTypeWorkflowRunSucceeded,
+	RecordTypeWorkflowRunFailed,
+	RecordTypeWorkflowRunCancelled,
+	RecordTypeStepRunStarted,
+	RecordTypeStepRunCompleted,
+	RecordTypeStepRunFailed,
+	RecordTypeStepRunCancelled,
+	RecordTypeStepRunTimedOut,
+	RecordTypeAuditLogCreated,
+}
+
+// optInRecordTypes are only delivered to sinks which list them in their event types, so existing sinks don't start
+// receiving them.
+var optInRecordTypes = []string{
+	RecordTypeAuditLogCreated,
+}
+
+// Record is the JSON document which is delivered to event sinks. The id of a record is the same for every attempt
+// to deliver it, so consumers can deduplicate records which are delivered more than once.
+type Record struct {
+	Id                 string          `json:"id"`
+	Type               string          `json:"type"`
+	TenantId           string          `json:"tenantId"`
+	Timestamp          time.Time       `json:"timestamp"`
+	WorkflowRunId      string          `json:"workflowRunId,omitempty"`
+	StepRunId          string          `json:"stepRunId,omitempty"`
+	RetryCount         *int32          `json:"retryCount,omitempty"`
+	EventId            string          `json:"eventId,omitempty"`
+	EventKey           string          `json:"eventKey,omitempty"`
+	AdditionalMetadata json.RawMessage `json:"additionalMetadata,omitempty"`
+	Data               json.RawMessage `json:"data,omitempty"`
+	Error              string          `json:"error,omitempty"`
+}
+
+// key returns the key which records are partitioned by, so the records of a workflow run are delivered in order.
+func (r *Record) key() string {
+	if r.WorkflowRunId != "" {
+		return r.WorkflowRunId
+	}
+
+	if r.EventId != "" {
+		return r.EventId
+	}
+
+	return r.Id
+}
+
+// toCloudEvent wraps the JSON encoded record in a CloudEvent in the structured JSON format. The source identifies the
+// tenant of the record, and the partitionkey extension is the key which the record is partitioned by.
+func toCloudEvent(record *Record, payload []byte, source string) ([]byte, error) {
+	e := cloudevents.Event{
+		ID:              record.Id,
+		Source:          source,
+		Type:            cloudEventTypePrefix + record.Type,
+		Time:            &record.Timestamp,
+		DataContentType: "application/json",
+		Data:            payload,
+		Extensions: map[string]interface{}{
+			"partitionkey": record.key(),
+		},
+	}
+
+	switch {
+	case record.StepRunId != "":
+		e.Subject = record.StepRunId
+	case record.WorkflowRunId != "":
+		e.Subject = record.WorkflowRunId
+	case record.EventId != "":
+		e.Subject = record.EventId
+	}
+
+	return json.Marshal(e)
+}
+
+// matches returns whether a record is delivered to a sink with the event types, all records except the opt-in records
+// are delivered to sinks without event types.
+func matches(eventTypes []string, recordType string) bool {
+	if len(eventTypes) == 0 {
+		return !slices.Contains(optInRecordTypes, recordType)
+	}
+
+	return slices.Contains(eventTypes, recordType)
+}
+
+// messageToRecord converts a message of the tenant event stream into a record. It returns nil for messages which
+// aren't delivered to event sinks.
+func messageToRecord(msg *msgqueue.Message, now time.Time) (*Record, error) {
+	record := &Record{
+		Id:        uuid.New().String(),
+		TenantId:  msg.TenantID(),
+		Timestamp: now.UTC(),
+	}
+
+	switch msg.ID {
+	case "event":
+		payload, err := unmarshalPayload[tasktypes.EventTaskPayload](msg.Payload)
+
+		if err != nil {
+			return nil, err
+		}
+
+		record.Type = RecordTypeEventCreated
+		record.EventId = payload.EventId
+		record.EventKey = payload.EventKey
+		record.Data = rawJSON(payload.EventData)
+		record.AdditionalMetadata = rawJSON(payload.EventAdditionalMetadata)
+	case "workflow-run-queued":
+		payload, err := unmarshalPayload[tasktypes.WorkflowRunQueuedTaskPayload](msg.Payload)
+
+		if err != nil {
+			return nil, err
+		}
+
+		record.Type = RecordTypeWorkflowRunQueued
+		record.WorkflowRunId = payload.WorkflowRunId
+	case "workflow-run-finished":
+		payload, err := unmarshalPayload[tasktypes.WorkflowRunFinishedTask](msg.Payload)
+
+		if err != nil {
+			return nil, err
+		}
+
+		record.Type = recordTypeWorkflowRunPrefix + strings.ToLower(payload.Status)
+
+		if !slices.Contains(RecordTypes, record.Type) {
+			return nil, nil
+		}
+
+		record.WorkflowRunId = payload.WorkflowRunId
+
+		if len(payload.AdditionalMetadata) > 0 {
+			record.AdditionalMetadata, err = json.Marshal(payload.AdditionalMetadata)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+	case "step-run-started":
+		payload, err := unmarshalPayload[tasktypes.StepRunStartedTaskPayload](msg.Payload)
+
+		if err != nil {
+			return nil, err
+		}
+
+		record.Type = RecordTypeStepRunStarted
+		record.WorkflowRunId = payload.WorkflowRunId
+		record.StepRunId = payload.StepRunId
+		record.RetryCount = payload.RetryCount
+		record.Timestamp = parseTimestamp(payload.StartedAt, record.Timestamp)
+	case "step-run-finished":
+		payload, err := unmarshalPayload[tasktypes.StepRunFinishedTaskPayload](msg.Payload)
+
+		if err != nil {
+			return nil, err
+		}
+
+		record.Type = RecordTypeStepRunCompleted
+		record.WorkflowRunId = payload.WorkflowRunId
+		record.StepRunId = payload.StepRunId
+		record.RetryCount = payload.RetryCount
+		record.Timestamp = parseTimestamp(payload.FinishedAt, record.Timestamp)
+		record.Data = rawJSON(payload.StepOutputData)
+	case "step-run-failed":
+		payload, err := unmarshalPayload[tasktypes.StepRunFailedTaskPayload](msg.Payload)
+
+		if err != nil {
+			return nil, err
+		}
+
+		record.Type = RecordTypeStepRunFailed
+		record.WorkflowRunId = payload.WorkflowRunId
+		record.StepRunId = payload.StepRunId
+		record.RetryCount = payload.RetryCount
+		record.Timestamp = parseTimestamp(payload.FailedAt, record.Timestamp)
+		record.Error = payload.Error
+	case "step-run-cancelled":
+		payload, err := unmarshalPayload[tasktypes.StepRunCancelledTaskPayload](msg.Payload)
+
+		if err != nil {
+			return nil, err
+		}
+
+		record.Type = RecordTypeStepRunCancelled
+		record.WorkflowRunId = payload.WorkflowRunId
+		record.StepRunId = payload.StepRunId
+		record.RetryCount = payload.RetryCount
+		record.Error = payload.CancelledReason
+	case "step-run-timed-out":
+		payload, err := unmarshalPayload[tasktypes.StepRunTimedOutTaskPayload](msg.Payload)
+
+		if err != nil {
+			return nil, err
+		}
+
+		record.Type = RecordTypeStepRunTimedOut
+		record.WorkflowRunId = payload.WorkflowRunId
+		record.StepRunId = payload.StepRunId
+		record.RetryCount = payload.RetryCount
+	case "audit-log-created":
+		payload, err := unmarshalPayload[tasktypes.AuditLogCreatedTaskPayload](msg.Payload)
+
+		if err != nil {
+			return nil, err
+		}
+
+		record.Type = RecordTypeAuditLogCreated
+		record.Timestamp = parseTimestamp(payload.CreatedAt, record.Timestamp)
+
+		record.Data, err = json.Marshal(payload)
+
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, nil
+	}
+
+	return record, nil
+}
+
+func unmarshalPayload[T any](payload map[string]interface{}) (T, error) {
+	var result T
+
+	data, err := json.Marshal(payload)
+
+	if err != nil {
+		return result, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &result); err != nil {
+		return result, fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	return result, nil
+}
+
+// rawJSON returns the data if it's valid JSON, and encodes it as a JSON string otherwise.
+func rawJSON(data string) json.RawMessage {
+	if data == "" {
+		return nil
+	}
+
+	if json.Valid([]byte(data)) {
+		return json.RawMessage(data)
+	}
+
+	encoded, _ := json.Marshal(data)
+
+	return encoded
+}
+
+func parseTimestamp(timestamp string, fallback time.Time) time.Time {
+	t, err := time.Parse(time.RFC3339, timestamp)
+
+	if err != nil {
+		return fallback
+	}
+
+	return t.UTC()
+}
@@ -0,0 +1,212 @@
+package eventsinks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/internal/signature"
+)
+
+const (
+	tenantId      = "707d0855-80ab-4e1f-a156-f1c4546cbf52"
+	workflowRunId = "5e8a7b4e-0c5f-4b8e-9f0b-1d2c3b4a5f6e"
+)
+
+func TestMessageToRecord(t *testing.T) {
+	now := time.Unix(1735632000, 0)
+
+	t.Run("workflow run finished", func(t *testing.T) {
+		record, err := messageToRecord(tasktypes.WorkflowRunFinishedToTask(tenantId, workflowRunId, "SUCCEEDED"), now)
+		require.NoError(t, err)
+		require.NotNil(t, record)
+
+		assert.Equal(t, RecordTypeWorkflowRunSucceeded, record.Type)
+		assert.Equal(t, tenantId, record.TenantId)
+		assert.Equal(t, workflowRunId, record.WorkflowRunId)
+		assert.Equal(t, workflowRunId, record.key())
+		assert.Equal(t, now.UTC(), record.Timestamp)
+		assert.NotEmpty(t, record.Id)
+
+		// runs which finish with other statuses aren't delivered
+		record, err = messageToRecord(tasktypes.WorkflowRunFinishedToTask(tenantId, workflowRunId, "RUNNING"), now)
+		require.NoError(t, err)
+		assert.Nil(t, record)
+	})
+
+	t.Run("step run finished", func(t *testing.T) {
+		record, err := messageToRecord(&msgqueue.Message{
+			ID: "step-run-finished",
+			Payload: map[string]interface{}{
+				"workflow_run_id":  workflowRunId,
+				"step_run_id":      "9f3e4b5a-1c2d-4e6f-8a7b-0c1d2e3f4a5b",
+				"finished_at":      "2025-01-01T10:00:00Z",
+				"step_output_data": `{"result":42}`,
+				"retry_count":      1,
+			},
+			Metadata: map[string]interface{}{
+				"tenant_id": tenantId,
+			},
+		}, now)
+
+		require.NoError(t, err)
+		require.NotNil(t, record)
+
+		assert.Equal(t, RecordTypeStepRunCompleted, record.Type)
+		assert.Equal(t, "9f3e4b5a-1c2d-4e6f-8a7b-0c1d2e3f4a5b", record.StepRunId)
+		assert.Equal(t, int32(1), *record.RetryCount)
+		assert.Equal(t, time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC), record.Timestamp)
+		assert.JSONEq(t, `{"result":42}`, string(record.Data))
+	})
+
+	t.Run("event", func(t *testing.T) {
+		record, err := messageToRecord(&msgqueue.Message{
+			ID: "event",
+			Payload: map[string]interface{}{
+				"event_id":   "c0a80101-0000-4000-8000-000000000001",
+				"event_key":  "order:created",
+				"event_data": `not json`,
+			},
+			Metadata: map[string]interface{}{
+				"tenant_id": tenantId,
+			},
+		}, now)
+
+		require.NoError(t, err)
+		require.NotNil(t, record)
+
+		assert.Equal(t, RecordTypeEventCreated, record.Type)
+		assert.Equal(t, "order:created", record.EventKey)
+		assert.Equal(t, "c0a80101-0000-4000-8000-000000000001", record.key())
+		assert.JSONEq(t, `"not json"`, string(record.Data))
+	})
+
+	t.Run("audit log created", func(t *testing.T) {
+		record, err := messageToRecord(&msgqueue.Message{
+			ID: "audit-log-created",
+			Payload: map[string]interface{}{
+				"audit_log_id": "3c1d2e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f",
+				"created_at":   "2025-01-01T10:00:00.5Z",
+				"actor_kind":   "API_TOKEN",
+				"actor_id":     "6f5e4d3c-2b1a-4098-8765-4321fedcba98",
+				"operation":    "WorkflowDelete",
+				"method":       "DELETE",
+				"path":         "/api/v1/workflows/5e8a7b4e-0c5f-4b8e-9f0b-1d2c3b4a5f6e",
+				"status_code":  204,
+			},
+			Metadata: map[string]interface{}{
+				"tenant_id": tenantId,
+			},
+		}, now)
+
+		require.NoError(t, err)
+		require.NotNil(t, record)
+
+		assert.Equal(t, RecordTypeAuditLogCreated, record.Type)
+		assert.Equal(t, tenantId, record.TenantId)
+		assert.Equal(t, time.Date(2025, 1, 1, 10, 0, 0, 500000000, time.UTC), record.Timestamp)
+		assert.Equal(t, record.Id, record.key())
+
+		var data map[string]interface{}
+		require.NoError(t, json.Unmarshal(record.Data, &data))
+
+		assert.Equal(t, "WorkflowDelete", data["operation"])
+		assert.Equal(t, float64(204), data["status_code"])
+	})
+
+	t.Run("ignored messages", func(t *testing.T) {
+		record, err := messageToRecord(&msgqueue.Message{ID: "step-run-stream-event"}, now)
+		require.NoError(t, err)
+		assert.Nil(t, record)
+	})
+}
+
+func TestMatches(t *testing.T) {
+	assert.True(t, matches(nil, RecordTypeStepRunFailed))
+	assert.True(t, matches([]string{RecordTypeStepRunFailed, RecordTypeWorkflowRunFailed}, RecordTypeStepRunFailed))
+	assert.False(t, matches([]string{RecordTypeWorkflowRunFailed}, RecordTypeStepRunFailed))
+
+	// audit log records are only delivered to sinks which opt in to them
+	assert.False(t, matches(nil, RecordTypeAuditLogCreated))
+	assert.True(t, matches([]string{RecordTypeAuditLogCreated}, RecordTypeAuditLogCreated))
+}
+
+func TestToCloudEvent(t *testing.T) {
+	record := &Record{
+		Id:            "0b6f8f3c-3f5e-4f54-9a55-3d1c6f2b4a7e",
+		Type:          RecordTypeWorkflowRunSucceeded,
+		TenantId:      tenantId,
+		Timestamp:     time.Date(2025, 1, 2, 9, 35, 12, 0, time.UTC),
+		WorkflowRunId: workflowRunId,
+	}
+
+	payload, err := json.Marshal(record)
+	require.NoError(t, err)
+
+	data, err := toCloudEvent(record, payload, "http://localhost:8080/tenants/"+tenantId)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"specversion": "1.0",
+		"id": "0b6f8f3c-3f5e-4f54-9a55-3d1c6f2b4a7e",
+		"source": "http://localhost:8080/tenants/`+tenantId+`",
+		"type": "run.hatchet.workflow_run.succeeded",
+		"subject": "`+workflowRunId+`",
+		"time": "2025-01-02T09:35:12Z",
+		"datacontenttype": "application/json",
+		"partitionkey": "`+workflowRunId+`",
+		"data": `+string(payload)+`
+	}`, string(data))
+}
+
+func TestWebhookSender(t *testing.T) {
+	var received []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		expected, _ := signature.Sign(string(body), "secret")
+
+		if r.Header.Get("X-Hatchet-Signature") != expected {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+
+		if r.Header.Get("X-Hatchet-Record-Id") == "fail" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		received = append(received, string(body))
+	}))
+
+	defer server.Close()
+
+	s := newWebhookSender(server.URL, "secret")
+
+	errs := s.send(context.Background(), []*outgoing{
+		{record: &Record{Id: "1", Type: RecordTypeWorkflowRunSucceeded}, payload: []byte(`{"id":"1"}`), contentType: "application/json"},
+		{record: &Record{Id: "fail", Type: RecordTypeWorkflowRunSucceeded}, payload: []byte(`{"id":"fail"}`), contentType: "application/json"},
+		{record: &Record{Id: "3", Type: RecordTypeWorkflowRunSucceeded}, payload: []byte(`{"id":"3"}`), contentType: "application/json"},
+	})
+
+	// the sender stops at the first record which wasn't delivered
+	require.Len(t, errs, 2)
+	assert.NoError(t, errs[0])
+	assert.ErrorContains(t, errs[1], "503")
+	assert.Equal(t, []string{`{"id":"1"}`}, received)
+}
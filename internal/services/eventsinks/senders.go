@@ -0,0 +1,251 @@
+package eventsinks
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+
+	"github.com/hatchet-dev/hatchet/internal/signature"
+)
+
+// outgoing is a record which is delivered to a sink.
+type outgoing struct {
+	record      *Record
+	payload     []byte
+	contentType string
+}
+
+// sender delivers records to a sink.
+type sender interface {
+	// send delivers records, and returns the error of each record which it attempted to deliver. Records after the
+	// returned errors weren't attempted.
+	send(ctx context.Context, records []*outgoing) []error
+
+	close() error
+}
+
+// webhookSender posts records to a URL, and signs them with the secret of the sink.
+type webhookSender struct {
+	client *http.Client
+	url    string
+	secret string
+}
+
+func newWebhookSender(url, secret string) *webhookSender {
+	return &webhookSender{
+		client: &http.Client{Timeout: 10 * time.Second},
+		url:    url,
+		secret: secret,
+	}
+}
+
+// send posts the records one by one, and stops at the first record which wasn't delivered so the endpoint isn't
+// flooded with requests while it's unavailable.
+func (s *webhookSender) send(ctx context.Context, records []*outgoing) []error {
+	errs := make([]error, 0, len(records))
+
+	for _, record := range records {
+		err := s.post(ctx, record)
+		errs = append(errs, err)
+
+		if err != nil {
+			break
+		}
+	}
+
+	return errs
+}
+
+func (s *webhookSender) post(ctx context.Context, record *outgoing) error {
+	sig, err := signature.Sign(string(record.payload), s.secret)
+
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(record.payload))
+
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", record.contentType)
+	req.Header.Set("X-Hatchet-Signature", sig)
+	req.Header.Set("X-Hatchet-Record-Id", record.record.Id)
+	req.Header.Set("X-Hatchet-Record-Type", record.record.Type)
+
+	resp, err := s.client.Do(req)
+
+	if err != nil {
+		return fmt.Errorf("could not post record to webhook: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (s *webhookSender) close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+// kafkaSender produces records to a topic. Records with the same key are produced to the same partition, so the
+// records of a workflow run are consumed in order.
+type kafkaSender struct {
+	client *kgo.Client
+	topic  string
+}
+
+func newKafkaSender(brokers []string, topic string, useTLS bool, username, password string) (*kafkaSender, error) {
+	opts := []kgo.Opt{
+		kgo.SeedBrokers(brokers...),
+		kgo.ClientID("hatchet-event-sinks"),
+		kgo.DefaultProduceTopic(topic),
+	}
+
+	if useTLS {
+		// many brokers don't support TLS 1.3 yet
+		opts = append(opts, kgo.DialTLSConfig(&tls.Config{MinVersion: tls.VersionTLS12}))
+	}
+
+	if username != "" {
+		opts = append(opts, kgo.SASL(plain.Auth{User: username, Pass: password}.AsMechanism()))
+	}
+
+	client, err := kgo.NewClient(opts...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &kafkaSender{
+		client: client,
+		topic:  topic,
+	}, nil
+}
+
+func (s *kafkaSender) send(ctx context.Context, records []*outgoing) []error {
+	batch := make([]*kgo.Record, len(records))
+	indexes := make(map[*kgo.Record]int, len(records))
+
+	for i, record := range records {
+		// the default partitioner hashes the key of a record to pick its partition
+		batch[i] = &kgo.Record{
+			Topic:     s.topic,
+			Timestamp: record.record.Timestamp,
+			Key:       []byte(record.record.key()),
+			Value:     record.payload,
+			Headers: []kgo.RecordHeader{
+				{Key: "type", Value: []byte(record.record.Type)},
+				{Key: "id", Value: []byte(record.record.Id)},
+				{Key: "content-type", Value: []byte(record.contentType)},
+			},
+		}
+
+		indexes[batch[i]] = i
+	}
+
+	errs := make([]error, len(records))
+
+	// the results are in the order which the records were produced in, not in the order of the batch
+	for _, res := range s.client.ProduceSync(ctx, batch...) {
+		errs[indexes[res.Record]] = res.Err
+	}
+
+	return errs
+}
+
+func (s *kafkaSender) close() error {
+	s.client.Close()
+	return nil
+}
+
+// natsSender publishes records to subjects which are prefixed with the topic of the sink, like
+// hatchet.workflow_run.succeeded.
+type natsSender struct {
+	nc     *nats.Conn
+	prefix string
+}
+
+func newNATSSender(url, prefix, username, password string) (*natsSender, error) {
+	opts := []nats.Option{
+		nats.Name("hatchet-event-sinks"),
+	}
+
+	switch {
+	case username != "":
+		opts = append(opts, nats.UserInfo(username, password))
+	case password != "":
+		opts = append(opts, nats.Token(password))
+	}
+
+	nc, err := nats.Connect(url, opts...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &natsSender{
+		nc:     nc,
+		prefix: prefix,
+	}, nil
+}
+
+func (s *natsSender) send(ctx context.Context, records []*outgoing) []error {
+	err := s.publish(ctx, records)
+
+	errs := make([]error, len(records))
+
+	for i := range errs {
+		errs[i] = err
+	}
+
+	return errs
+}
+
+// publish publishes the records, and waits until the server has processed them. Records are only stored if their
+// subject is bound to a JetStream stream.
+func (s *natsSender) publish(ctx context.Context, records []*outgoing) error {
+	for _, record := range records {
+		msg := nats.NewMsg(s.prefix + "." + record.record.Type)
+
+		// JetStream deduplicates messages with the same id within its duplicate window
+		msg.Header.Set("Nats-Msg-Id", record.record.Id)
+		msg.Header.Set("Content-Type", record.contentType)
+		msg.Data = record.payload
+
+		if err := s.nc.PublishMsg(msg); err != nil {
+			return fmt.Errorf("could not publish to nats: %w", err)
+		}
+	}
+
+	// the server answers the flush after it has processed the messages
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	if err := s.nc.FlushWithContext(ctx); err != nil {
+		return fmt.Errorf("could not publish to nats: %w", err)
+	}
+
+	return nil
+}
+
+func (s *natsSender) close() error {
+	s.nc.Close()
+	return nil
+}
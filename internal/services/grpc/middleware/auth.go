@@ -2,13 +2,19 @@ package middleware
 
 import (
 	"context"
+	"time"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
+	"github.com/hatchet-dev/hatchet/pkg/auth/clientcert"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 )
 
 type GRPCAuthN struct {
@@ -29,11 +35,16 @@ func (a *GRPCAuthN) Middleware(ctx context.Context) (context.Context, error) {
 	token, err := auth.AuthFromMD(ctx, "bearer")
 
 	if err != nil {
+		// workers without an api token can authenticate with a client certificate
+		if a.config.Runtime.GRPCClientCertAuth && !a.config.Runtime.GRPCInsecure {
+			return a.clientCertMiddleware(ctx)
+		}
+
 		a.l.Debug().Err(err).Msgf("error getting bearer token from request: %s", err)
 		return nil, forbidden
 	}
 
-	tenantId, tokenUUID, err := a.config.Auth.JWTManager.ValidateTenantToken(ctx, token)
+	tenantId, tokenUUID, scope, err := a.config.Auth.JWTManager.ValidateScopedTenantToken(ctx, token)
 
 	if err != nil {
 		a.l.Debug().Err(err).Msgf("error validating tenant token: %s", err)
@@ -41,6 +52,11 @@ func (a *GRPCAuthN) Middleware(ctx context.Context) (context.Context, error) {
 		return nil, forbidden
 	}
 
+	// read-only tokens can only call the read endpoints of the REST API
+	if scope == dbsqlc.APITokenScopeREADONLY {
+		return nil, status.Errorf(codes.PermissionDenied, "read-only tokens cannot call the engine")
+	}
+
 	ctx = context.WithValue(ctx, "rate_limit_token", tokenUUID)
 
 	// get the tenant id
@@ -54,3 +70,74 @@ func (a *GRPCAuthN) Middleware(ctx context.Context) (context.Context, error) {
 	return context.WithValue(ctx, "tenant", queriedTenant), nil
 
 }
+
+// clientCertMiddleware authenticates a request by the client certificate of the connection. The SPIFFE ID of the
+// certificate selects the client CAs whose prefix it starts with, and the tenant of the CA which signed the
+// certificate is the tenant of the request.
+func (a *GRPCAuthN) clientCertMiddleware(ctx context.Context) (context.Context, error) {
+	forbidden := status.Errorf(codes.Unauthenticated, "invalid auth token or client certificate")
+
+	p, ok := peer.FromContext(ctx)
+
+	if !ok {
+		return nil, forbidden
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		a.l.Debug().Msg("request has neither a bearer token nor a client certificate")
+		return nil, forbidden
+	}
+
+	chain := tlsInfo.State.PeerCertificates
+
+	spiffeId, err := clientcert.SpiffeId(chain[0])
+
+	if err != nil {
+		a.l.Debug().Err(err).Msgf("error getting spiffe id of client certificate: %s", err)
+		return nil, forbidden
+	}
+
+	cas, err := a.config.EngineRepository.ClientCA().ListClientCAsForSpiffeId(ctx, spiffeId)
+
+	if err != nil {
+		a.l.Err(err).Msgf("error listing client cas for spiffe id %s", spiffeId)
+		return nil, status.Errorf(codes.Internal, "could not authenticate client certificate")
+	}
+
+	if len(cas) == 0 {
+		a.l.Debug().Msgf("no client ca for spiffe id %s", spiffeId)
+		return nil, forbidden
+	}
+
+	// spiffe id prefixes can't overlap across tenants. If they still do, the certificate could be signed by the ca
+	// of any of the tenants, so it's rejected instead of trusting one of them.
+	tenantId := cas[0].TenantId
+	caPEMs := make([]string, 0, len(cas))
+
+	for _, ca := range cas {
+		if ca.TenantId != tenantId {
+			a.l.Error().Msgf("client cas of multiple tenants match spiffe id %s", spiffeId)
+			return nil, forbidden
+		}
+
+		caPEMs = append(caPEMs, ca.Certificate)
+	}
+
+	if err := clientcert.Verify(chain, caPEMs, time.Now()); err != nil {
+		a.l.Debug().Err(err).Msgf("error verifying client certificate of %s: %s", spiffeId, err)
+		return nil, forbidden
+	}
+
+	ctx = context.WithValue(ctx, "rate_limit_token", spiffeId)
+
+	queriedTenant, err := a.config.EngineRepository.Tenant().GetTenantByID(ctx, sqlchelpers.UUIDToStr(tenantId))
+
+	if err != nil {
+		a.l.Debug().Err(err).Msgf("error getting tenant by id: %s", err)
+		return nil, forbidden
+	}
+
+	return context.WithValue(ctx, "tenant", queriedTenant), nil
+}
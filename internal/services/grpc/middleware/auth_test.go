@@ -0,0 +1,297 @@
+package middleware
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/pkg/auth/token"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// scopedJWTManager accepts the token "valid" as a token of the tenant with the scope
+type scopedJWTManager struct {
+	token.JWTManager
+
+	tenantId string
+	scope    dbsqlc.APITokenScope
+}
+
+func (m *scopedJWTManager) ValidateScopedTenantToken(ctx context.Context, t string) (string, string, dbsqlc.APITokenScope, error) {
+	if t != "valid" {
+		return "", "", "", errors.New("invalid token")
+	}
+
+	return m.tenantId, uuid.New().String(), m.scope, nil
+}
+
+type tenantEngineRepository struct {
+	repository.TenantEngineRepository
+}
+
+func (r *tenantEngineRepository) GetTenantByID(ctx context.Context, tenantId string) (*dbsqlc.Tenant, error) {
+	return &dbsqlc.Tenant{
+		ID: sqlchelpers.UUIDFromStr(tenantId),
+	}, nil
+}
+
+// clientCARepository returns its client CAs for every SPIFFE ID
+type clientCARepository struct {
+	repository.ClientCARepository
+
+	cas []*dbsqlc.TenantClientCA
+}
+
+func (r *clientCARepository) ListClientCAsForSpiffeId(ctx context.Context, spiffeId string) ([]*dbsqlc.TenantClientCA, error) {
+	return r.cas, nil
+}
+
+type engineRepository struct {
+	repository.EngineRepository
+
+	clientCAs []*dbsqlc.TenantClientCA
+}
+
+func (r *engineRepository) Tenant() repository.TenantEngineRepository {
+	return &tenantEngineRepository{}
+}
+
+func (r *engineRepository) ClientCA() repository.ClientCARepository {
+	return &clientCARepository{cas: r.clientCAs}
+}
+
+func TestAuthNScopes(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope dbsqlc.APITokenScope
+		token string
+		code  codes.Code
+	}{
+		{name: "admin token", scope: dbsqlc.APITokenScopeADMIN, code: codes.OK},
+		{name: "worker token", scope: dbsqlc.APITokenScopeWORKER, code: codes.OK},
+		{name: "read-only token", scope: dbsqlc.APITokenScopeREADONLY, code: codes.PermissionDenied},
+		{name: "invalid token", scope: dbsqlc.APITokenScopeADMIN, token: "invalid", code: codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenantId := uuid.New().String()
+
+			bearer := "valid"
+
+			if tt.token != "" {
+				bearer = tt.token
+			}
+
+			l := zerolog.Nop()
+
+			a := NewAuthN(&server.ServerConfig{
+				Config: &database.Config{
+					EngineRepository: &engineRepository{},
+				},
+				Auth: server.AuthConfig{
+					JWTManager: &scopedJWTManager{
+						tenantId: tenantId,
+						scope:    tt.scope,
+					},
+				},
+				Logger: &l,
+			})
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "bearer "+bearer))
+
+			ctx, err := a.Middleware(ctx)
+
+			assert.Equal(t, tt.code, status.Code(err))
+
+			if tt.code != codes.OK {
+				assert.Nil(t, ctx)
+
+				return
+			}
+
+			tenant, ok := ctx.Value("tenant").(*dbsqlc.Tenant)
+
+			if assert.True(t, ok) {
+				assert.Equal(t, tenantId, sqlchelpers.UUIDToStr(tenant.ID))
+			}
+		})
+	}
+}
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  string
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	}
+}
+
+func (ca *testCA) issue(t *testing.T, spiffeId string) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	u, err := url.Parse(spiffeId)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		URIs:         []*url.URL{u},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert
+}
+
+func TestClientCertTenants(t *testing.T) {
+	tenantA := uuid.New().String()
+	tenantB := uuid.New().String()
+
+	caA := newTestCA(t)
+	caB := newTestCA(t)
+
+	clientCA := func(tenantId string, ca *testCA, prefix string) *dbsqlc.TenantClientCA {
+		return &dbsqlc.TenantClientCA{
+			TenantId:       sqlchelpers.UUIDFromStr(tenantId),
+			Certificate:    ca.pem,
+			SpiffeIdPrefix: prefix,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		cas      []*dbsqlc.TenantClientCA
+		issuer   *testCA
+		tenantId string
+		code     codes.Code
+	}{
+		{
+			name:     "certificate of the ca of the tenant",
+			cas:      []*dbsqlc.TenantClientCA{clientCA(tenantA, caA, "spiffe://example.org/a/")},
+			issuer:   caA,
+			tenantId: tenantA,
+			code:     codes.OK,
+		},
+		{
+			name:   "certificate of a ca which doesn't match the spiffe id",
+			cas:    []*dbsqlc.TenantClientCA{clientCA(tenantA, caA, "spiffe://example.org/a/")},
+			issuer: caB,
+			code:   codes.Unauthenticated,
+		},
+		{
+			// a certificate of tenant b's ca must not authenticate as tenant a, whose ca is listed first
+			name: "cas of multiple tenants",
+			cas: []*dbsqlc.TenantClientCA{
+				clientCA(tenantA, caA, "spiffe://example.org/"),
+				clientCA(tenantB, caB, "spiffe://example.org/a/"),
+			},
+			issuer: caB,
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "no matching ca",
+			issuer: caA,
+			code:   codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := zerolog.Nop()
+
+			a := NewAuthN(&server.ServerConfig{
+				Config: &database.Config{
+					EngineRepository: &engineRepository{clientCAs: tt.cas},
+				},
+				Runtime: server.ConfigFileRuntime{
+					GRPCClientCertAuth: true,
+				},
+				Logger: &l,
+			})
+
+			ctx := peer.NewContext(context.Background(), &peer.Peer{
+				AuthInfo: credentials.TLSInfo{
+					State: tls.ConnectionState{
+						PeerCertificates: []*x509.Certificate{tt.issuer.issue(t, "spiffe://example.org/a/worker")},
+					},
+				},
+			})
+
+			ctx, err := a.Middleware(ctx)
+
+			assert.Equal(t, tt.code, status.Code(err))
+
+			if tt.code != codes.OK {
+				return
+			}
+
+			tenant, ok := ctx.Value("tenant").(*dbsqlc.Tenant)
+
+			if assert.True(t, ok) {
+				assert.Equal(t, tt.tenantId, sqlchelpers.UUIDToStr(tenant.ID))
+			}
+		})
+	}
+}
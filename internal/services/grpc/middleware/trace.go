@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+)
+
+// TraceContextUnaryInterceptor continues the trace of the caller, which is passed as a W3C trace context in the
+// metadata of the request.
+func TraceContextUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	return handler(extractTraceContext(ctx), req)
+}
+
+// TraceContextStreamInterceptor continues the trace of the caller for streams.
+func TraceContextStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	wrappedStream := &wrappedServerStream{
+		ServerStream: ss,
+		ctx:          extractTraceContext(ss.Context()),
+	}
+
+	return handler(srv, wrappedStream)
+}
+
+func extractTraceContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+
+	if !ok {
+		return ctx
+	}
+
+	return telemetry.ExtractTraceContext(ctx, telemetry.MetadataCarrier(md))
+}
@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type traceServerStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *traceServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestTraceContextInterceptors(t *testing.T) {
+	const traceparent = "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+
+	tests := []struct {
+		name string
+		md   metadata.MD
+
+		// the trace id of the context which the handler is called with, if any
+		traceId string
+	}{
+		{
+			name:    "request with a trace context",
+			md:      metadata.Pairs("traceparent", traceparent),
+			traceId: "0af7651916cd43dd8448eb211c80319c",
+		},
+		{
+			name: "request without a trace context",
+			md:   metadata.Pairs("authorization", "Bearer token"),
+		},
+		{
+			name: "request with an invalid trace context",
+			md:   metadata.Pairs("traceparent", "invalid"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+
+			assertTrace := func(ctx context.Context) {
+				spanContext := trace.SpanContextFromContext(ctx)
+
+				if tt.traceId == "" {
+					assert.False(t, spanContext.IsValid())
+					return
+				}
+
+				assert.True(t, spanContext.IsRemote())
+				assert.Equal(t, tt.traceId, spanContext.TraceID().String())
+			}
+
+			_, err := TraceContextUnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				assertTrace(ctx)
+				return nil, nil
+			})
+
+			require.NoError(t, err)
+
+			err = TraceContextStreamInterceptor(nil, &traceServerStream{ctx: ctx}, &grpc.StreamServerInfo{}, func(srv interface{}, stream grpc.ServerStream) error {
+				assertTrace(stream.Context())
+				return nil
+			})
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -200,7 +200,16 @@ func (s *Server) startGRPC() (func() error, error) {
 	if s.insecure {
 		serverOpts = append(serverOpts, grpc.Creds(insecure.NewCredentials()))
 	} else {
-		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(s.tls)))
+		tlsConfig := s.tls
+
+		// client certificates are verified against the CAs of the tenant in the auth middleware, so the handshake
+		// only requests them unless the certificates are required to be signed by the global CA
+		if s.config.Runtime.GRPCClientCertAuth && (tlsConfig.ClientAuth == tls.NoClientCert || tlsConfig.ClientAuth == tls.VerifyClientCertIfGiven) {
+			tlsConfig = tlsConfig.Clone()
+			tlsConfig.ClientAuth = tls.RequestClientCert
+		}
+
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
 	}
 
 	authMiddleware := middleware.NewAuthN(s.config)
@@ -243,6 +252,7 @@ func (s *Server) startGRPC() (func() error, error) {
 		logging.StreamServerInterceptor(middleware.InterceptorLogger(s.l), opts...),
 		auth.StreamServerInterceptor(authMiddleware.Middleware),
 		middleware.ServerNameStreamingInterceptor,
+		middleware.TraceContextStreamInterceptor,
 		ratelimit.StreamServerInterceptor(limiter),
 		errorInterceptor.ErrorStreamServerInterceptor(),
 		recovery.StreamServerInterceptor(recovery.WithRecoveryHandler(grpcPanicRecoveryHandler)),
@@ -252,6 +262,7 @@ func (s *Server) startGRPC() (func() error, error) {
 		logging.UnaryServerInterceptor(middleware.InterceptorLogger(s.l), opts...),
 		auth.UnaryServerInterceptor(authMiddleware.Middleware),
 		middleware.AttachServerNameInterceptor,
+		middleware.TraceContextUnaryInterceptor,
 		ratelimit.UnaryServerInterceptor(limiter),
 		errorInterceptor.ErrorUnaryServerInterceptor(),
 		recovery.UnaryServerInterceptor(recovery.WithRecoveryHandler(grpcPanicRecoveryHandler)),
@@ -2,6 +2,7 @@ package health
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
@@ -17,19 +18,43 @@ type Health struct {
 
 	repository repository.EngineRepository
 	queue      msgqueue.MessageQueue
+	probes     []Probe
+	handlers   map[string]http.HandlerFunc
 }
 
 func New(prisma repository.EngineRepository, queue msgqueue.MessageQueue) *Health {
-	return &Health{
+	h := &Health{
 		repository: prisma,
 		queue:      queue,
 	}
+
+	h.probes = append(Probes(prisma, queue), Probe{
+		Name: "engine",
+		Check: func(ctx context.Context) error {
+			if !h.ready {
+				return errors.New("engine is starting or shutting down")
+			}
+
+			return nil
+		},
+	})
+
+	return h
 }
 
 func (h *Health) SetReady(ready bool) {
 	h.ready = ready
 }
 
+// HandleFunc registers an additional handler on the health server, which must be called before Start.
+func (h *Health) HandleFunc(pattern string, handler http.HandlerFunc) {
+	if h.handlers == nil {
+		h.handlers = make(map[string]http.HandlerFunc)
+	}
+
+	h.handlers[pattern] = handler
+}
+
 func (h *Health) Start() (func() error, error) {
 	mux := http.NewServeMux()
 
@@ -51,6 +76,18 @@ func (h *Health) Start() (func() error, error) {
 		w.WriteHeader(http.StatusOK)
 	})
 
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, Run(r.Context(), h.probes, true))
+	})
+
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, Run(r.Context(), h.probes, false))
+	})
+
+	for pattern, handler := range h.handlers {
+		mux.HandleFunc(pattern, handler)
+	}
+
 	server := &http.Server{
 		Addr:         ":8733",
 		Handler:      mux,
@@ -79,3 +116,14 @@ func (h *Health) Start() (func() error, error) {
 
 	return cleanup, nil
 }
+
+// writeReport writes the report of the probes, with a 503 status if a probe failed.
+func writeReport(w http.ResponseWriter, report *Report) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if !report.Healthy {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	json.NewEncoder(w).Encode(report) // nolint: errcheck
+}
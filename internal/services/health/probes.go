@@ -0,0 +1,142 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// probeTimeout is the time after which a probe fails, which is below the default timeout of the probes of
+// Kubernetes.
+const probeTimeout = 3 * time.Second
+
+// Probe checks a dependency of the engine or API server.
+type Probe struct {
+	Name string
+
+	// Liveness is true if the probe is checked by /healthz. The other probes are only checked by /readyz, since a
+	// restart doesn't fix them.
+	Liveness bool
+
+	Check func(ctx context.Context) error
+}
+
+type ProbeResult struct {
+	Name       string `json:"name"`
+	Healthy    bool   `json:"healthy"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"durationMs"`
+}
+
+type Report struct {
+	Healthy bool          `json:"healthy"`
+	Probes  []ProbeResult `json:"probes"`
+}
+
+// Probes returns the probes of the database, the message queue, lease acquisition and the migrations of the
+// database.
+func Probes(repo repository.EngineRepository, mq msgqueue.MessageQueue) []Probe {
+	// the lease of the probe is only held by this process
+	holderId := uuid.New().String()
+
+	return []Probe{
+		{
+			Name:     "database",
+			Liveness: true,
+			Check:    repo.Health().Ping,
+		},
+		{
+			Name:     "message_queue",
+			Liveness: true,
+			Check: func(ctx context.Context) error {
+				if !mq.IsReady() {
+					return fmt.Errorf("message queue isn't connected")
+				}
+
+				return nil
+			},
+		},
+		{
+			Name: "leases",
+			Check: func(ctx context.Context) error {
+				return repo.Health().CheckLease(ctx, holderId)
+			},
+		},
+		{
+			Name: "migrations",
+			Check: func(ctx context.Context) error {
+				pending, err := repo.Health().ListPendingMigrations(ctx)
+
+				if err != nil {
+					return err
+				}
+
+				if len(pending) > 0 {
+					return fmt.Errorf("%d migrations weren't applied: %s", len(pending), strings.Join(pending, ", "))
+				}
+
+				return nil
+			},
+		},
+	}
+}
+
+// Run runs the probes concurrently. If liveness is true, only the liveness probes are run.
+func Run(ctx context.Context, probes []Probe, liveness bool) *Report {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	report := &Report{
+		Healthy: true,
+		Probes:  make([]ProbeResult, 0, len(probes)),
+	}
+
+	results := make([]*ProbeResult, len(probes))
+
+	var wg sync.WaitGroup
+
+	for i, probe := range probes {
+		if liveness && !probe.Liveness {
+			continue
+		}
+
+		wg.Add(1)
+
+		go func(i int, probe Probe) {
+			defer wg.Done()
+
+			start := time.Now()
+			err := probe.Check(ctx)
+
+			results[i] = &ProbeResult{
+				Name:       probe.Name,
+				Healthy:    err == nil,
+				DurationMs: time.Since(start).Milliseconds(),
+			}
+
+			if err != nil {
+				results[i].Error = err.Error()
+			}
+		}(i, probe)
+	}
+
+	wg.Wait()
+
+	for _, res := range results {
+		if res == nil {
+			continue
+		}
+
+		report.Probes = append(report.Probes, *res)
+		report.Healthy = report.Healthy && res.Healthy
+	}
+
+	return report
+}
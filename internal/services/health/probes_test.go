@@ -0,0 +1,186 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+type healthRepository struct {
+	repository.HealthRepository
+
+	pingErr  error
+	leaseErr error
+	pending  []string
+}
+
+func (r *healthRepository) Ping(ctx context.Context) error {
+	return r.pingErr
+}
+
+func (r *healthRepository) CheckLease(ctx context.Context, holderId string) error {
+	return r.leaseErr
+}
+
+func (r *healthRepository) ListPendingMigrations(ctx context.Context) ([]string, error) {
+	return r.pending, nil
+}
+
+type healthEngineRepository struct {
+	repository.EngineRepository
+
+	health *healthRepository
+}
+
+func (r *healthEngineRepository) Health() repository.HealthRepository {
+	return r.health
+}
+
+type messageQueue struct {
+	msgqueue.MessageQueue
+
+	ready bool
+}
+
+func (q *messageQueue) IsReady() bool {
+	return q.ready
+}
+
+func probeResults(report *Report) map[string]ProbeResult {
+	res := make(map[string]ProbeResult, len(report.Probes))
+
+	for _, probe := range report.Probes {
+		res[probe.Name] = probe
+	}
+
+	return res
+}
+
+func TestProbes(t *testing.T) {
+	tests := []struct {
+		name   string
+		health *healthRepository
+		ready  bool
+
+		liveness  bool
+		unhealthy map[string]string
+	}{
+		{
+			name:   "healthy",
+			health: &healthRepository{},
+			ready:  true,
+		},
+		{
+			name:      "message queue isn't connected",
+			health:    &healthRepository{},
+			unhealthy: map[string]string{"message_queue": "message queue isn't connected"},
+		},
+		{
+			name:      "database is down",
+			health:    &healthRepository{pingErr: errors.New("connection refused")},
+			ready:     true,
+			liveness:  true,
+			unhealthy: map[string]string{"database": "connection refused"},
+		},
+		{
+			name:      "pending migrations",
+			health:    &healthRepository{pending: []string{"20250124084512_v0.52.59.sql", "20250125091530_v0.52.60.sql"}},
+			ready:     true,
+			unhealthy: map[string]string{"migrations": "2 migrations weren't applied: 20250124084512_v0.52.59.sql, 20250125091530_v0.52.60.sql"},
+		},
+		{
+			// readiness probes don't fail the liveness probes, since a restart doesn't fix them
+			name:     "read-only database",
+			health:   &healthRepository{leaseErr: errors.New("read-only transaction")},
+			ready:    true,
+			liveness: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			probes := Probes(&healthEngineRepository{health: tt.health}, &messageQueue{ready: tt.ready})
+
+			report := Run(context.Background(), probes, tt.liveness)
+			results := probeResults(report)
+
+			if tt.liveness {
+				assert.Len(t, results, 2)
+				assert.NotContains(t, results, "leases")
+			} else {
+				assert.Len(t, results, len(probes))
+			}
+
+			assert.Equal(t, len(tt.unhealthy) == 0, report.Healthy)
+
+			for name, res := range results {
+				if expected, ok := tt.unhealthy[name]; ok {
+					assert.False(t, res.Healthy, name)
+					assert.Equal(t, expected, res.Error)
+				} else {
+					assert.True(t, res.Healthy, name)
+					assert.Empty(t, res.Error)
+				}
+			}
+		})
+	}
+}
+
+func TestRunTimeout(t *testing.T) {
+	report := Run(context.Background(), []Probe{
+		{
+			Name: "hanging",
+			Check: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+		},
+	}, false)
+
+	require.Len(t, report.Probes, 1)
+	assert.False(t, report.Healthy)
+	assert.Equal(t, context.DeadlineExceeded.Error(), report.Probes[0].Error)
+	assert.GreaterOrEqual(t, report.Probes[0].DurationMs, probeTimeout.Milliseconds())
+}
+
+func TestWriteReport(t *testing.T) {
+	for _, healthy := range []bool{true, false} {
+		rec := httptest.NewRecorder()
+
+		writeReport(rec, &Report{Healthy: healthy, Probes: []ProbeResult{{Name: "database", Healthy: healthy}}})
+
+		if healthy {
+			assert.Equal(t, http.StatusOK, rec.Code)
+		} else {
+			assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		}
+
+		assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+		var report Report
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+		assert.Equal(t, healthy, report.Healthy)
+	}
+}
+
+func TestEngineProbe(t *testing.T) {
+	h := New(&healthEngineRepository{health: &healthRepository{}}, &messageQueue{ready: true})
+
+	// the engine isn't ready until it's started
+	report := Run(context.Background(), h.probes, false)
+	assert.False(t, report.Healthy)
+	assert.Equal(t, "engine is starting or shutting down", probeResults(report)["engine"].Error)
+
+	h.SetReady(true)
+
+	assert.True(t, Run(context.Background(), h.probes, false).Healthy)
+}
@@ -24,8 +24,10 @@ const _ = grpc.SupportPackageIsVersion7
 type EventsServiceClient interface {
 	Push(ctx context.Context, in *PushEventRequest, opts ...grpc.CallOption) (*Event, error)
 	BulkPush(ctx context.Context, in *BulkPushEventRequest, opts ...grpc.CallOption) (*Events, error)
+	PushEvents(ctx context.Context, in *PushEventsRequest, opts ...grpc.CallOption) (*PushEventsResponse, error)
 	ReplaySingleEvent(ctx context.Context, in *ReplayEventRequest, opts ...grpc.CallOption) (*Event, error)
 	PutLog(ctx context.Context, in *PutLogRequest, opts ...grpc.CallOption) (*PutLogResponse, error)
+	PutLogs(ctx context.Context, in *PutLogsRequest, opts ...grpc.CallOption) (*PutLogsResponse, error)
 	PutStreamEvent(ctx context.Context, in *PutStreamEventRequest, opts ...grpc.CallOption) (*PutStreamEventResponse, error)
 }
 
@@ -55,6 +57,15 @@ func (c *eventsServiceClient) BulkPush(ctx context.Context, in *BulkPushEventReq
 	return out, nil
 }
 
+func (c *eventsServiceClient) PushEvents(ctx context.Context, in *PushEventsRequest, opts ...grpc.CallOption) (*PushEventsResponse, error) {
+	out := new(PushEventsResponse)
+	err := c.cc.Invoke(ctx, "/EventsService/PushEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventsServiceClient) ReplaySingleEvent(ctx context.Context, in *ReplayEventRequest, opts ...grpc.CallOption) (*Event, error) {
 	out := new(Event)
 	err := c.cc.Invoke(ctx, "/EventsService/ReplaySingleEvent", in, out, opts...)
@@ -73,6 +84,15 @@ func (c *eventsServiceClient) PutLog(ctx context.Context, in *PutLogRequest, opt
 	return out, nil
 }
 
+func (c *eventsServiceClient) PutLogs(ctx context.Context, in *PutLogsRequest, opts ...grpc.CallOption) (*PutLogsResponse, error) {
+	out := new(PutLogsResponse)
+	err := c.cc.Invoke(ctx, "/EventsService/PutLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventsServiceClient) PutStreamEvent(ctx context.Context, in *PutStreamEventRequest, opts ...grpc.CallOption) (*PutStreamEventResponse, error) {
 	out := new(PutStreamEventResponse)
 	err := c.cc.Invoke(ctx, "/EventsService/PutStreamEvent", in, out, opts...)
@@ -88,8 +108,10 @@ func (c *eventsServiceClient) PutStreamEvent(ctx context.Context, in *PutStreamE
 type EventsServiceServer interface {
 	Push(context.Context, *PushEventRequest) (*Event, error)
 	BulkPush(context.Context, *BulkPushEventRequest) (*Events, error)
+	PushEvents(context.Context, *PushEventsRequest) (*PushEventsResponse, error)
 	ReplaySingleEvent(context.Context, *ReplayEventRequest) (*Event, error)
 	PutLog(context.Context, *PutLogRequest) (*PutLogResponse, error)
+	PutLogs(context.Context, *PutLogsRequest) (*PutLogsResponse, error)
 	PutStreamEvent(context.Context, *PutStreamEventRequest) (*PutStreamEventResponse, error)
 	mustEmbedUnimplementedEventsServiceServer()
 }
@@ -104,12 +126,18 @@ func (UnimplementedEventsServiceServer) Push(context.Context, *PushEventRequest)
 func (UnimplementedEventsServiceServer) BulkPush(context.Context, *BulkPushEventRequest) (*Events, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BulkPush not implemented")
 }
+func (UnimplementedEventsServiceServer) PushEvents(context.Context, *PushEventsRequest) (*PushEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushEvents not implemented")
+}
 func (UnimplementedEventsServiceServer) ReplaySingleEvent(context.Context, *ReplayEventRequest) (*Event, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReplaySingleEvent not implemented")
 }
 func (UnimplementedEventsServiceServer) PutLog(context.Context, *PutLogRequest) (*PutLogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PutLog not implemented")
 }
+func (UnimplementedEventsServiceServer) PutLogs(context.Context, *PutLogsRequest) (*PutLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutLogs not implemented")
+}
 func (UnimplementedEventsServiceServer) PutStreamEvent(context.Context, *PutStreamEventRequest) (*PutStreamEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PutStreamEvent not implemented")
 }
@@ -162,6 +190,24 @@ func _EventsService_BulkPush_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventsService_PushEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).PushEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/EventsService/PushEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).PushEvents(ctx, req.(*PushEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventsService_ReplaySingleEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReplayEventRequest)
 	if err := dec(in); err != nil {
@@ -198,6 +244,24 @@ func _EventsService_PutLog_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventsService_PutLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServiceServer).PutLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/EventsService/PutLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServiceServer).PutLogs(ctx, req.(*PutLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventsService_PutStreamEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PutStreamEventRequest)
 	if err := dec(in); err != nil {
@@ -231,6 +295,10 @@ var EventsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BulkPush",
 			Handler:    _EventsService_BulkPush_Handler,
 		},
+		{
+			MethodName: "PushEvents",
+			Handler:    _EventsService_PushEvents_Handler,
+		},
 		{
 			MethodName: "ReplaySingleEvent",
 			Handler:    _EventsService_ReplaySingleEvent_Handler,
@@ -239,6 +307,10 @@ var EventsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PutLog",
 			Handler:    _EventsService_PutLog_Handler,
 		},
+		{
+			MethodName: "PutLogs",
+			Handler:    _EventsService_PutLogs_Handler,
+		},
 		{
 			MethodName: "PutStreamEvent",
 			Handler:    _EventsService_PutStreamEvent_Handler,
@@ -2,12 +2,17 @@ package ingestor
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
-	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/google/cel-go/cel"
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	hatchetcel "github.com/hatchet-dev/hatchet/internal/cel"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/services/ingestor/contracts"
-	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
@@ -20,9 +25,22 @@ type Ingestor interface {
 	contracts.EventsServiceServer
 	IngestEvent(ctx context.Context, tenantId, eventName string, data []byte, metadata []byte) (*dbsqlc.Event, error)
 	BulkIngestEvent(ctx context.Context, tenantID string, eventOpts []*repository.CreateEventOpts) ([]*dbsqlc.Event, error)
+	IngestEvents(ctx context.Context, tenantId string, eventOpts []*repository.CreateEventOpts) ([]*IngestEventResult, error)
 	IngestReplayedEvent(ctx context.Context, tenantId string, replayedEvent *dbsqlc.Event) (*dbsqlc.Event, error)
 }
 
+// MaxIngestEventsBatchSize is the maximum number of events which can be ingested in a single batch
+const MaxIngestEventsBatchSize = 1000
+
+// MaxPutLogsBatchSize is the maximum number of log lines which can be put in a single batch
+const MaxPutLogsBatchSize = 1000
+
+// IngestEventResult is the result of ingesting an event of a batch. Either the event or the error is set.
+type IngestEventResult struct {
+	Event *dbsqlc.Event
+	Err   error
+}
+
 type IngestorOptFunc func(*IngestorOpts)
 
 type IngestorOpts struct {
@@ -30,6 +48,9 @@ type IngestorOpts struct {
 	streamEventRepository  repository.StreamEventsEngineRepository
 	logRepository          repository.LogsEngineRepository
 	entitlementsRepository repository.EntitlementsRepository
+	workflowRepository     repository.WorkflowEngineRepository
+	eventRoutingRepository repository.EventRoutingRepository
+	tenantQuotaRepository  repository.TenantQuotaRepository
 	mq                     msgqueue.MessageQueue
 }
 
@@ -57,6 +78,30 @@ func WithEntitlementsRepository(r repository.EntitlementsRepository) IngestorOpt
 	}
 }
 
+// WithWorkflowRepository sets the workflow repository, which is used to validate the data of events against the
+// input schemas of the workflows they trigger. Events aren't validated if it isn't set.
+func WithWorkflowRepository(r repository.WorkflowEngineRepository) IngestorOptFunc {
+	return func(opts *IngestorOpts) {
+		opts.workflowRepository = r
+	}
+}
+
+// WithEventRoutingRepository sets the event routing repository, which is used to route events to workflows with the
+// routing rules of their tenant. Events aren't routed if it isn't set.
+func WithEventRoutingRepository(r repository.EventRoutingRepository) IngestorOptFunc {
+	return func(opts *IngestorOpts) {
+		opts.eventRoutingRepository = r
+	}
+}
+
+// WithTenantQuotaRepository sets the tenant quota repository, which is used to enforce the events per minute quotas of
+// tenants. Quotas aren't enforced if it isn't set.
+func WithTenantQuotaRepository(r repository.TenantQuotaRepository) IngestorOptFunc {
+	return func(opts *IngestorOpts) {
+		opts.tenantQuotaRepository = r
+	}
+}
+
 func WithMessageQueue(mq msgqueue.MessageQueue) IngestorOptFunc {
 	return func(opts *IngestorOpts) {
 		opts.mq = mq
@@ -74,6 +119,14 @@ type IngestorImpl struct {
 	logRepository          repository.LogsEngineRepository
 	streamEventRepository  repository.StreamEventsEngineRepository
 	entitlementsRepository repository.EntitlementsRepository
+	workflowRepository     repository.WorkflowEngineRepository
+	eventRoutingRepository repository.EventRoutingRepository
+	tenantQuotaRepository  repository.TenantQuotaRepository
+
+	celParser *hatchetcel.CELParser
+
+	// routingPrograms caches the compiled predicates of routing rules by their expression
+	routingPrograms *lru.Cache[string, cel.Program]
 
 	mq msgqueue.MessageQueue
 	v  validator.Validator
@@ -102,10 +155,21 @@ func NewIngestor(fs ...IngestorOptFunc) (Ingestor, error) {
 		return nil, fmt.Errorf("task queue is required. use WithMessageQueue")
 	}
 
+	routingPrograms, err := lru.New[string, cel.Program](routingProgramCacheSize)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create routing program cache: %w", err)
+	}
+
 	return &IngestorImpl{
 		eventRepository:        opts.eventRepository,
 		streamEventRepository:  opts.streamEventRepository,
 		entitlementsRepository: opts.entitlementsRepository,
+		workflowRepository:     opts.workflowRepository,
+		eventRoutingRepository: opts.eventRoutingRepository,
+		tenantQuotaRepository:  opts.tenantQuotaRepository,
+		celParser:              hatchetcel.NewCELParser(),
+		routingPrograms:        routingPrograms,
 
 		logRepository: opts.logRepository,
 		mq:            opts.mq,
@@ -114,15 +178,27 @@ func NewIngestor(fs ...IngestorOptFunc) (Ingestor, error) {
 }
 
 func (i *IngestorImpl) IngestEvent(ctx context.Context, tenantId, key string, data []byte, metadata []byte) (*dbsqlc.Event, error) {
-	ctx, span := telemetry.NewSpan(ctx, "ingest-event")
-	defer span.End()
-
-	event, err := i.eventRepository.CreateEvent(ctx, &repository.CreateEventOpts{
+	return i.ingestEvent(ctx, &repository.CreateEventOpts{
 		TenantId:           tenantId,
 		Key:                key,
 		Data:               data,
 		AdditionalMetadata: metadata,
 	})
+}
+
+func (i *IngestorImpl) ingestEvent(ctx context.Context, opts *repository.CreateEventOpts) (*dbsqlc.Event, error) {
+	ctx, span := telemetry.NewSpan(ctx, "ingest-event")
+	defer span.End()
+
+	if err := i.validateEventData(ctx, opts.TenantId, opts.Key, opts.Data); err != nil {
+		return nil, err
+	}
+
+	if err := i.checkEventQuota(ctx, opts.TenantId, 1); err != nil {
+		return nil, err
+	}
+
+	event, err := i.eventRepository.CreateEvent(ctx, opts)
 
 	if err == metered.ErrResourceExhausted {
 		return nil, metered.ErrResourceExhausted
@@ -137,10 +213,10 @@ func (i *IngestorImpl) IngestEvent(ctx context.Context, tenantId, key string, da
 		Value: event.ID,
 	})
 
-	err = i.mq.AddMessage(context.Background(), msgqueue.EVENT_PROCESSING_QUEUE, eventToTask(event))
-	if err != nil {
-		return nil, fmt.Errorf("could not add event to task queue: %w", err)
+	err = i.deliverEvents(ctx, opts.TenantId, []*dbsqlc.Event{event}, []*repository.CreateEventOpts{opts})
 
+	if err != nil {
+		return nil, err
 	}
 
 	return event, nil
@@ -150,6 +226,16 @@ func (i *IngestorImpl) BulkIngestEvent(ctx context.Context, tenantId string, eve
 	ctx, span := telemetry.NewSpan(ctx, "bulk-ingest-event")
 	defer span.End()
 
+	for _, opts := range eventOpts {
+		if err := i.validateEventData(ctx, tenantId, opts.Key, opts.Data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := i.checkEventQuota(ctx, tenantId, len(eventOpts)); err != nil {
+		return nil, err
+	}
+
 	events, err := i.eventRepository.BulkCreateEvent(ctx, &repository.BulkCreateEventOpts{
 		Events:   eventOpts,
 		TenantId: tenantId,
@@ -170,20 +256,95 @@ func (i *IngestorImpl) BulkIngestEvent(ctx context.Context, tenantId string, eve
 	// 	Value: event.ID,
 	// })
 
-	for _, event := range events.Events {
-		err = i.mq.AddMessage(context.Background(), msgqueue.EVENT_PROCESSING_QUEUE, eventToTask(event))
-		if err != nil {
-			return nil, fmt.Errorf("could not add event to task queue: %w", err)
-		}
+	err = i.deliverEvents(ctx, tenantId, events.Events, eventOpts)
+
+	if err != nil {
+		return nil, err
 	}
 
 	return events.Events, nil
 }
 
+// IngestEvents ingests a batch of events, and returns a result for each event in the order of the options. Events
+// which are invalid are reported in their results, and the other events are created in a single transaction, so
+// either all of them are created or an error is returned.
+func (i *IngestorImpl) IngestEvents(ctx context.Context, tenantId string, eventOpts []*repository.CreateEventOpts) ([]*IngestEventResult, error) {
+	ctx, span := telemetry.NewSpan(ctx, "ingest-events")
+	defer span.End()
+
+	if len(eventOpts) > MaxIngestEventsBatchSize {
+		return nil, fmt.Errorf("too many events - %d is over maximum (%d)", len(eventOpts), MaxIngestEventsBatchSize)
+	}
+
+	results := make([]*IngestEventResult, len(eventOpts))
+	validOpts := make([]*repository.CreateEventOpts, 0, len(eventOpts))
+	validIndexes := make([]int, 0, len(eventOpts))
+
+	for idx, opts := range eventOpts {
+		results[idx] = &IngestEventResult{}
+
+		if err := i.validateEventOpts(ctx, tenantId, opts); err != nil {
+			var validationErr *repository.WorkflowRunInputValidationError
+
+			if errors.As(err, &validationErr) || errors.Is(err, errInvalidEvent) {
+				results[idx].Err = err
+				continue
+			}
+
+			return nil, err
+		}
+
+		validOpts = append(validOpts, opts)
+		validIndexes = append(validIndexes, idx)
+	}
+
+	if len(validOpts) == 0 {
+		return results, nil
+	}
+
+	if err := i.checkEventQuota(ctx, tenantId, len(validOpts)); err != nil {
+		return nil, err
+	}
+
+	events, err := i.eventRepository.BulkCreateEvent(ctx, &repository.BulkCreateEventOpts{
+		Events:   validOpts,
+		TenantId: tenantId,
+	})
+
+	if err == metered.ErrResourceExhausted {
+		return nil, metered.ErrResourceExhausted
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create events: %w", err)
+	}
+
+	// events are returned in the order of the options
+	if len(events.Events) != len(validOpts) {
+		return nil, fmt.Errorf("expected %d events to be created, but %d were created", len(validOpts), len(events.Events))
+	}
+
+	for j, event := range events.Events {
+		results[validIndexes[j]].Event = event
+	}
+
+	err = i.deliverEvents(ctx, tenantId, events.Events, validOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
 func (i *IngestorImpl) IngestReplayedEvent(ctx context.Context, tenantId string, replayedEvent *dbsqlc.Event) (*dbsqlc.Event, error) {
 	ctx, span := telemetry.NewSpan(ctx, "ingest-replayed-event")
 	defer span.End()
 
+	if err := i.checkEventQuota(ctx, tenantId, 1); err != nil {
+		return nil, err
+	}
+
 	replayedId := sqlchelpers.UUIDToStr(replayedEvent.ID)
 
 	event, err := i.eventRepository.CreateEvent(ctx, &repository.CreateEventOpts{
@@ -202,7 +363,13 @@ func (i *IngestorImpl) IngestReplayedEvent(ctx context.Context, tenantId string,
 		return nil, fmt.Errorf("could not create event: %w", err)
 	}
 
-	err = i.mq.AddMessage(context.Background(), msgqueue.EVENT_PROCESSING_QUEUE, eventToTask(event))
+	task, err := i.eventTask(ctx, event, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.mq.AddMessage(context.Background(), msgqueue.EVENT_PROCESSING_QUEUE, task)
 
 	if err != nil {
 		return nil, fmt.Errorf("could not add event to task queue: %w", err)
@@ -211,28 +378,119 @@ func (i *IngestorImpl) IngestReplayedEvent(ctx context.Context, tenantId string,
 	return event, nil
 }
 
-func eventToTask(e *dbsqlc.Event) *msgqueue.Message {
-	eventId := sqlchelpers.UUIDToStr(e.ID)
-	tenantId := sqlchelpers.UUIDToStr(e.TenantId)
+// checkEventQuota returns a repository.ErrQuotaExceeded if ingesting n events would exceed the events per minute quota
+// of the tenant
+func (i *IngestorImpl) checkEventQuota(ctx context.Context, tenantId string, n int) error {
+	if i.tenantQuotaRepository == nil {
+		return nil
+	}
+
+	return i.tenantQuotaRepository.CheckQuota(ctx, tenantId, repository.TenantQuotaResourceEventsPerMinute, int32(n)) // nolint: gosec
+}
+
+// deliverEvents sends the events which are due to the event queue. Events whose delivery time is in the future are
+// stored instead, and the ticker sends them to the event queue once their delivery time has passed. The events must be
+// in the order of their options, otherwise the options are ignored.
+func (i *IngestorImpl) deliverEvents(ctx context.Context, tenantId string, events []*dbsqlc.Event, eventOpts []*repository.CreateEventOpts) error {
+	now := time.Now().UTC()
+	delayed := make([]*repository.CreateDelayedEventOpts, 0)
+
+	for idx, event := range events {
+		var idempotencyKey *string
+		var deliverAfter *time.Time
+
+		if len(events) == len(eventOpts) {
+			idempotencyKey = eventOpts[idx].IdempotencyKey
+			deliverAfter = eventOpts[idx].DeliverAfter
+		}
+
+		if deliverAfter != nil && deliverAfter.After(now) {
+			// the event is routed when it is ingested, so the ticker doesn't need to evaluate routing rules
+			routedWorkflowIds, err := i.routeEvent(ctx, event)
+
+			if err != nil {
+				return fmt.Errorf("could not route event: %w", err)
+			}
+
+			delayed = append(delayed, &repository.CreateDelayedEventOpts{
+				EventId:           sqlchelpers.UUIDToStr(event.ID),
+				DeliverAfter:      *deliverAfter,
+				IdempotencyKey:    idempotencyKey,
+				RoutedWorkflowIds: routedWorkflowIds,
+			})
+
+			continue
+		}
 
-	payloadTyped := tasktypes.EventTaskPayload{
-		EventId:                 eventId,
-		EventKey:                e.Key,
-		EventData:               string(e.Data),
-		EventAdditionalMetadata: string(e.AdditionalMetadata),
+		task, err := i.eventTask(ctx, event, idempotencyKey)
+
+		if err != nil {
+			return err
+		}
+
+		err = i.mq.AddMessage(context.Background(), msgqueue.EVENT_PROCESSING_QUEUE, task)
+
+		if err != nil {
+			return fmt.Errorf("could not add event to task queue: %w", err)
+		}
 	}
 
-	payload, _ := datautils.ToJSONMap(payloadTyped)
+	if len(delayed) == 0 {
+		return nil
+	}
 
-	metadata, _ := datautils.ToJSONMap(tasktypes.EventTaskMetadata{
-		EventKey: e.Key,
-		TenantId: tenantId,
-	})
+	err := i.eventRepository.CreateDelayedEvents(ctx, tenantId, delayed)
+
+	if err != nil {
+		return fmt.Errorf("could not create delayed events: %w", err)
+	}
+
+	return nil
+}
+
+// errInvalidEvent is wrapped by the errors of events which can't be created
+var errInvalidEvent = errors.New("invalid event")
+
+// validateEventOpts validates the options of an event of a batch, so that an invalid event doesn't fail the
+// transaction which creates the other events of the batch.
+func (i *IngestorImpl) validateEventOpts(ctx context.Context, tenantId string, opts *repository.CreateEventOpts) error {
+	if opts.TenantId != tenantId {
+		return fmt.Errorf("%w: event belongs to a different tenant", errInvalidEvent)
+	}
+
+	if err := i.v.Validate(opts); err != nil {
+		return fmt.Errorf("%w: %s", errInvalidEvent, err.Error())
+	}
+
+	if len(opts.Data) > 0 && !json.Valid(opts.Data) {
+		return fmt.Errorf("%w: payload is not valid JSON", errInvalidEvent)
+	}
+
+	if len(opts.AdditionalMetadata) > 0 && !json.Valid(opts.AdditionalMetadata) {
+		return fmt.Errorf("%w: additional metadata is not valid JSON", errInvalidEvent)
+	}
+
+	return i.validateEventData(ctx, tenantId, opts.Key, opts.Data)
+}
 
-	return &msgqueue.Message{
-		ID:       "event",
-		Payload:  payload,
-		Metadata: metadata,
-		Retries:  3,
+// validateEventData validates the data of an event against the input schemas of the workflows the event triggers,
+// and returns a *repository.WorkflowRunInputValidationError for the first workflow whose schema it doesn't match.
+func (i *IngestorImpl) validateEventData(ctx context.Context, tenantId, key string, data []byte) error {
+	if i.workflowRepository == nil {
+		return nil
 	}
+
+	workflowVersions, err := i.workflowRepository.ListWorkflowsForEvent(ctx, tenantId, key)
+
+	if err != nil {
+		return fmt.Errorf("could not list workflows for event: %w", err)
+	}
+
+	for _, workflowVersion := range workflowVersions {
+		if err := repository.ValidateWorkflowRunInput(workflowVersion, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
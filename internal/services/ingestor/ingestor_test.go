@@ -0,0 +1,294 @@
+package ingestor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+// fakeEventRepository creates events in memory
+type fakeEventRepository struct {
+	repository.EventEngineRepository
+
+	bulkCreateErr error
+
+	created []*repository.CreateEventOpts
+	delayed []*repository.CreateDelayedEventOpts
+}
+
+func (r *fakeEventRepository) BulkCreateEvent(ctx context.Context, opts *repository.BulkCreateEventOpts) (*repository.BulkCreateEventResult, error) {
+	if r.bulkCreateErr != nil {
+		return nil, r.bulkCreateErr
+	}
+
+	events := make([]*dbsqlc.Event, len(opts.Events))
+
+	for i, opt := range opts.Events {
+		r.created = append(r.created, opt)
+
+		events[i] = &dbsqlc.Event{
+			ID:                 sqlchelpers.UUIDFromStr(uuid.New().String()),
+			TenantId:           sqlchelpers.UUIDFromStr(opts.TenantId),
+			Key:                opt.Key,
+			Data:               opt.Data,
+			AdditionalMetadata: opt.AdditionalMetadata,
+		}
+	}
+
+	return &repository.BulkCreateEventResult{Events: events}, nil
+}
+
+func (r *fakeEventRepository) CreateDelayedEvents(ctx context.Context, tenantId string, opts []*repository.CreateDelayedEventOpts) error {
+	r.delayed = append(r.delayed, opts...)
+	return nil
+}
+
+// fakeWorkflowRepository returns the workflows which are triggered by each event key
+type fakeWorkflowRepository struct {
+	repository.WorkflowEngineRepository
+
+	workflowsForKeys map[string][]*dbsqlc.GetWorkflowVersionForEngineRow
+}
+
+func (r *fakeWorkflowRepository) ListWorkflowsForEvent(ctx context.Context, tenantId, eventKey string) ([]*dbsqlc.GetWorkflowVersionForEngineRow, error) {
+	return r.workflowsForKeys[eventKey], nil
+}
+
+// fakeMessageQueue records the messages which are added to it
+type fakeMessageQueue struct {
+	msgqueue.MessageQueue
+
+	mu       sync.Mutex
+	messages []*msgqueue.Message
+}
+
+func (q *fakeMessageQueue) AddMessage(ctx context.Context, queue msgqueue.Queue, task *msgqueue.Message) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.messages = append(q.messages, task)
+
+	return nil
+}
+
+func (q *fakeMessageQueue) eventIds() []string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	ids := make([]string, len(q.messages))
+
+	for i, msg := range q.messages {
+		ids[i], _ = msg.Payload["event_id"].(string)
+	}
+
+	return ids
+}
+
+func newTestIngestor(events *fakeEventRepository, mq *fakeMessageQueue) *IngestorImpl {
+	return &IngestorImpl{
+		eventRepository: events,
+		workflowRepository: &fakeWorkflowRepository{
+			workflowsForKeys: map[string][]*dbsqlc.GetWorkflowVersionForEngineRow{
+				"order:created": {
+					{
+						WorkflowVersion: dbsqlc.WorkflowVersion{
+							InputSchema: []byte(`{"type":"object","required":["orderId"]}`),
+						},
+						WorkflowName: "orders",
+					},
+				},
+			},
+		},
+		mq: mq,
+		v:  validator.NewDefaultValidator(),
+	}
+}
+
+func TestIngestEvents(t *testing.T) {
+	tenantId := uuid.New().String()
+
+	events := &fakeEventRepository{}
+	mq := &fakeMessageQueue{}
+	i := newTestIngestor(events, mq)
+
+	results, err := i.IngestEvents(context.Background(), tenantId, []*repository.CreateEventOpts{
+		{TenantId: tenantId, Key: "user:created", Data: []byte(`{}`)},
+		{TenantId: tenantId, Key: "", Data: []byte(`{}`)},
+		{TenantId: tenantId, Key: "order:created", Data: []byte(`{"orderId":"1"}`)},
+		{TenantId: tenantId, Key: "user:created", Data: []byte(`{`)},
+		{TenantId: tenantId, Key: "user:created", Data: []byte(`{}`), AdditionalMetadata: []byte(`{`)},
+		// the data isn't valid input for the workflow which the event triggers
+		{TenantId: tenantId, Key: "order:created", Data: []byte(`{}`)},
+		{TenantId: uuid.New().String(), Key: "user:created", Data: []byte(`{}`)},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, results, 7)
+
+	// the results are in the order of the events, and only the valid events are created
+	succeeded := []int{0, 2}
+
+	for idx, result := range results {
+		if idx == succeeded[0] || idx == succeeded[1] {
+			assert.NoError(t, result.Err, "event %d", idx)
+			assert.NotNil(t, result.Event, "event %d", idx)
+			continue
+		}
+
+		assert.Error(t, result.Err, "event %d", idx)
+		assert.Nil(t, result.Event, "event %d", idx)
+	}
+
+	var validationErr *repository.WorkflowRunInputValidationError
+	assert.ErrorAs(t, results[5].Err, &validationErr)
+
+	for _, idx := range []int{1, 3, 4, 6} {
+		assert.ErrorIs(t, results[idx].Err, errInvalidEvent, "event %d", idx)
+	}
+
+	require.Len(t, events.created, 2)
+	assert.Equal(t, "user:created", events.created[0].Key)
+	assert.Equal(t, "order:created", events.created[1].Key)
+
+	// the created events are sent to the event queue
+	assert.Equal(t, []string{
+		sqlchelpers.UUIDToStr(results[0].Event.ID),
+		sqlchelpers.UUIDToStr(results[2].Event.ID),
+	}, mq.eventIds())
+}
+
+func TestIngestEventsWithoutValidEvents(t *testing.T) {
+	tenantId := uuid.New().String()
+
+	events := &fakeEventRepository{bulkCreateErr: errors.New("no events should be created")}
+	mq := &fakeMessageQueue{}
+
+	results, err := newTestIngestor(events, mq).IngestEvents(context.Background(), tenantId, []*repository.CreateEventOpts{
+		{TenantId: tenantId, Key: ""},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Error(t, results[0].Err)
+	assert.Empty(t, mq.eventIds())
+}
+
+func TestIngestEventsErrors(t *testing.T) {
+	tenantId := uuid.New().String()
+
+	tooMany := make([]*repository.CreateEventOpts, MaxIngestEventsBatchSize+1)
+
+	for idx := range tooMany {
+		tooMany[idx] = &repository.CreateEventOpts{TenantId: tenantId, Key: "user:created"}
+	}
+
+	_, err := newTestIngestor(&fakeEventRepository{}, &fakeMessageQueue{}).IngestEvents(context.Background(), tenantId, tooMany)
+	assert.Error(t, err)
+
+	// the events are created in a single transaction, so the batch fails if the tenant is out of events
+	mq := &fakeMessageQueue{}
+
+	_, err = newTestIngestor(&fakeEventRepository{bulkCreateErr: metered.ErrResourceExhausted}, mq).IngestEvents(context.Background(), tenantId, []*repository.CreateEventOpts{
+		{TenantId: tenantId, Key: "user:created", Data: []byte(`{}`)},
+	})
+
+	assert.ErrorIs(t, err, metered.ErrResourceExhausted)
+	assert.Empty(t, mq.eventIds())
+}
+
+func TestIngestEventsWithDeliverAfter(t *testing.T) {
+	tenantId := uuid.New().String()
+
+	events := &fakeEventRepository{}
+	mq := &fakeMessageQueue{}
+
+	past := time.Now().UTC().Add(-time.Minute)
+	future := time.Now().UTC().Add(time.Hour)
+
+	results, err := newTestIngestor(events, mq).IngestEvents(context.Background(), tenantId, []*repository.CreateEventOpts{
+		{TenantId: tenantId, Key: "user:created", Data: []byte(`{}`)},
+		{TenantId: tenantId, Key: "user:created", Data: []byte(`{}`), DeliverAfter: &past},
+		{TenantId: tenantId, Key: "user:created", Data: []byte(`{}`), DeliverAfter: &future, IdempotencyKey: repository.StringPtr("key")},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	for idx, result := range results {
+		require.NoError(t, result.Err, "event %d", idx)
+	}
+
+	// the events are stored right away, but events whose delivery time is in the future aren't sent to the event queue
+	assert.Len(t, events.created, 3)
+
+	assert.Equal(t, []string{
+		sqlchelpers.UUIDToStr(results[0].Event.ID),
+		sqlchelpers.UUIDToStr(results[1].Event.ID),
+	}, mq.eventIds())
+
+	require.Len(t, events.delayed, 1)
+	assert.Equal(t, sqlchelpers.UUIDToStr(results[2].Event.ID), events.delayed[0].EventId)
+	assert.True(t, future.Equal(events.delayed[0].DeliverAfter))
+	assert.Equal(t, repository.StringPtr("key"), events.delayed[0].IdempotencyKey)
+}
+
+// fakeTenantQuotaRepository allows ingesting events until the usage reaches the limit
+type fakeTenantQuotaRepository struct {
+	repository.TenantQuotaRepository
+
+	limit int32
+	usage int32
+}
+
+func (r *fakeTenantQuotaRepository) CheckQuota(ctx context.Context, tenantId string, resource repository.TenantQuotaResource, n int32) error {
+	if resource != repository.TenantQuotaResourceEventsPerMinute || r.usage+n <= r.limit {
+		r.usage += n
+		return nil
+	}
+
+	return repository.ErrQuotaExceeded{Resource: resource, Limit: r.limit, Usage: r.usage}
+}
+
+func TestIngestEventsQuotaExceeded(t *testing.T) {
+	tenantId := uuid.New().String()
+
+	events := &fakeEventRepository{}
+	mq := &fakeMessageQueue{}
+
+	i := newTestIngestor(events, mq)
+	i.tenantQuotaRepository = &fakeTenantQuotaRepository{limit: 2}
+
+	// only the valid events count towards the quota
+	_, err := i.IngestEvents(context.Background(), tenantId, []*repository.CreateEventOpts{
+		{TenantId: tenantId, Key: "user:created", Data: []byte(`{}`)},
+		{TenantId: tenantId, Key: "", Data: []byte(`{}`)},
+		{TenantId: tenantId, Key: "user:created", Data: []byte(`{}`)},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, events.created, 2)
+
+	_, err = i.IngestEvents(context.Background(), tenantId, []*repository.CreateEventOpts{
+		{TenantId: tenantId, Key: "user:created", Data: []byte(`{}`)},
+	})
+
+	var quotaErr repository.ErrQuotaExceeded
+
+	require.ErrorAs(t, err, &quotaErr)
+	assert.Equal(t, repository.TenantQuotaResourceEventsPerMinute, quotaErr.Resource)
+	assert.Len(t, events.created, 2)
+	assert.Len(t, mq.eventIds(), 2)
+}
@@ -0,0 +1,569 @@
+package kafkaconsumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	celgo "github.com/google/cel-go/cel"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/logger"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+const (
+	// leaseDuration is how long a consumer holds the lease on a partition after its last rebalance
+	leaseDuration = 30 * time.Second
+
+	rebalanceInterval = 10 * time.Second
+
+	maxBackoff = 30 * time.Second
+)
+
+// Consumer ingests the messages of Kafka topics as events. The partitions of the topics are shared between the
+// engines in the same consumer group, and the offset of each partition is committed after its messages were
+// ingested, so messages are ingested at least once. Messages which are ingested more than once don't trigger
+// duplicate workflow runs, since their events have an idempotency key.
+type Consumer struct {
+	client   *kgo.Client
+	admin    *kadm.Client
+	repo     repository.KafkaOffsetRepository
+	ingestor ingestor.Ingestor
+	l        *zerolog.Logger
+
+	consumerGroup string
+	consumerId    string
+	startFrom     string
+	fetchMaxBytes int32
+
+	topics map[string]*topic
+
+	mu      sync.Mutex
+	running map[repository.KafkaPartition]*partitionConsumer
+	wg      sync.WaitGroup
+}
+
+type topic struct {
+	name      string
+	tenantId  string
+	eventKey  string
+	transform celgo.Program
+}
+
+type partitionConsumer struct {
+	cancel context.CancelFunc
+}
+
+type ConsumerOpt func(*ConsumerOpts)
+
+type ConsumerOpts struct {
+	client   *kgo.Client
+	repo     repository.KafkaOffsetRepository
+	ingestor ingestor.Ingestor
+	l        *zerolog.Logger
+	config   *server.ConfigFileKafka
+}
+
+func defaultConsumerOpts() *ConsumerOpts {
+	logger := logger.NewDefaultLogger("kafka-consumer")
+	return &ConsumerOpts{
+		l: &logger,
+	}
+}
+
+// WithClient sets the client which the partitions of the topics are listed with. The partitions are consumed with
+// clients which are created with the options of this client.
+func WithClient(c *kgo.Client) ConsumerOpt {
+	return func(opts *ConsumerOpts) {
+		opts.client = c
+	}
+}
+
+func WithRepository(r repository.KafkaOffsetRepository) ConsumerOpt {
+	return func(opts *ConsumerOpts) {
+		opts.repo = r
+	}
+}
+
+func WithIngestor(i ingestor.Ingestor) ConsumerOpt {
+	return func(opts *ConsumerOpts) {
+		opts.ingestor = i
+	}
+}
+
+func WithLogger(l *zerolog.Logger) ConsumerOpt {
+	return func(opts *ConsumerOpts) {
+		opts.l = l
+	}
+}
+
+func WithConfig(cf *server.ConfigFileKafka) ConsumerOpt {
+	return func(opts *ConsumerOpts) {
+		opts.config = cf
+	}
+}
+
+func New(fs ...ConsumerOpt) (*Consumer, error) {
+	opts := defaultConsumerOpts()
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	if opts.client == nil {
+		return nil, fmt.Errorf("kafka client is required. use WithClient")
+	}
+
+	if opts.repo == nil {
+		return nil, fmt.Errorf("repository is required. use WithRepository")
+	}
+
+	if opts.ingestor == nil {
+		return nil, fmt.Errorf("ingestor is required. use WithIngestor")
+	}
+
+	if opts.config == nil {
+		return nil, fmt.Errorf("config is required. use WithConfig")
+	}
+
+	newLogger := opts.l.With().Str("service", "kafka-consumer").Logger()
+	opts.l = &newLogger
+
+	parser := cel.NewCELParser()
+	topics := make(map[string]*topic, len(opts.config.Topics))
+
+	for _, t := range opts.config.Topics {
+		tp := &topic{
+			name:     t.Topic,
+			tenantId: t.TenantId,
+			eventKey: t.EventKey,
+		}
+
+		if t.Transform != "" {
+			prg, err := parser.ParseKafkaMessageTransform(t.Transform)
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid transform for topic %s: %w", t.Topic, err)
+			}
+
+			tp.transform = prg
+		}
+
+		topics[t.Topic] = tp
+	}
+
+	return &Consumer{
+		client:        opts.client,
+		admin:         kadm.NewClient(opts.client),
+		repo:          opts.repo,
+		ingestor:      opts.ingestor,
+		l:             opts.l,
+		consumerGroup: opts.config.ConsumerGroup,
+		consumerId:    uuid.New().String(),
+		startFrom:     opts.config.StartFrom,
+		fetchMaxBytes: opts.config.FetchMaxBytes,
+		topics:        topics,
+		running:       make(map[repository.KafkaPartition]*partitionConsumer),
+	}, nil
+}
+
+func (c *Consumer) Start() (func() error, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c.wg.Add(1)
+
+	go func() {
+		defer c.wg.Done()
+
+		ticker := time.NewTicker(rebalanceInterval)
+		defer ticker.Stop()
+
+		for {
+			c.rebalance(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	cleanup := func() error {
+		cancel()
+		c.wg.Wait()
+
+		releaseCtx, releaseCancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer releaseCancel()
+
+		if err := c.repo.ReleasePartitions(releaseCtx, c.consumerGroup, c.consumerId); err != nil {
+			return fmt.Errorf("could not release kafka partitions: %w", err)
+		}
+
+		c.client.Close()
+
+		return nil
+	}
+
+	return cleanup, nil
+}
+
+// rebalance acquires the leases on the partitions of the topics which aren't held by other consumers and extends
+// the leases which the consumer already holds. It starts consuming the partitions which were acquired, and stops
+// consuming the partitions which are no longer held.
+func (c *Consumer) rebalance(ctx context.Context) {
+	var partitions []repository.KafkaPartition
+
+	names := make([]string, 0, len(c.topics))
+
+	for name := range c.topics {
+		names = append(names, name)
+	}
+
+	details, listErr := c.admin.ListTopics(ctx, names...)
+
+	for _, t := range c.topics {
+		err := listErr
+
+		if err == nil {
+			if detail, ok := details[t.name]; !ok {
+				err = fmt.Errorf("topic %s was not found", t.name)
+			} else {
+				err = detail.Err
+			}
+		}
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			c.l.Error().Err(err).Msgf("could not list partitions of topic %s", t.name)
+
+			// keep the partitions of the topic which are already being consumed
+			c.mu.Lock()
+
+			for p := range c.running {
+				if p.Topic == t.name {
+					partitions = append(partitions, p)
+				}
+			}
+
+			c.mu.Unlock()
+
+			continue
+		}
+
+		for _, id := range details[t.name].Partitions.Numbers() {
+			partitions = append(partitions, repository.KafkaPartition{
+				Topic:     t.name,
+				Partition: id,
+			})
+		}
+	}
+
+	if len(partitions) == 0 {
+		return
+	}
+
+	held, err := c.repo.AcquirePartitions(ctx, &repository.AcquireKafkaPartitionsOpts{
+		ConsumerGroup: c.consumerGroup,
+		ConsumerId:    c.consumerId,
+		Partitions:    partitions,
+		LeaseDuration: leaseDuration,
+	})
+
+	if err != nil {
+		if ctx.Err() == nil {
+			// partitions which are being consumed stop once their leases expire, since their offsets can't be committed
+			c.l.Error().Err(err).Msg("could not acquire kafka partitions")
+		}
+
+		return
+	}
+
+	heldPartitions := make(map[repository.KafkaPartition]*dbsqlc.KafkaPartitionOffset, len(held))
+
+	for _, row := range held {
+		heldPartitions[repository.KafkaPartition{Topic: row.Topic, Partition: row.Partition}] = row
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for p, pc := range c.running {
+		if _, ok := heldPartitions[p]; !ok {
+			c.l.Info().Msgf("lease on partition %d of topic %s was lost, stopping consumer", p.Partition, p.Topic)
+
+			pc.cancel()
+			delete(c.running, p)
+		}
+	}
+
+	for p, row := range heldPartitions {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if _, ok := c.running[p]; ok {
+			continue
+		}
+
+		pctx, cancel := context.WithCancel(ctx)
+		pc := &partitionConsumer{cancel: cancel}
+
+		c.running[p] = pc
+
+		c.wg.Add(1)
+
+		go func(p repository.KafkaPartition, row *dbsqlc.KafkaPartitionOffset) {
+			defer c.wg.Done()
+			defer cancel()
+
+			c.consumePartition(pctx, p, row)
+
+			c.mu.Lock()
+			defer c.mu.Unlock()
+
+			if c.running[p] == pc {
+				delete(c.running, p)
+			}
+		}(p, row)
+	}
+}
+
+// consumePartition ingests the messages of a partition until the context is cancelled or the lease on the partition
+// is lost. Each partition is consumed with its own client, which is closed once the partition is no longer held.
+func (c *Consumer) consumePartition(ctx context.Context, p repository.KafkaPartition, row *dbsqlc.KafkaPartitionOffset) {
+	t := c.topics[p.Topic]
+
+	start := c.startOffset()
+
+	if row.Offset.Valid {
+		start = kgo.NewOffset().At(row.Offset.Int64)
+	}
+
+	client, err := kgo.NewClient(append(
+		c.client.Opts(),
+		kgo.ConsumePartitions(map[string]map[int32]kgo.Offset{
+			p.Topic: {p.Partition: start},
+		}),
+		// the stored offset is reset if its messages were deleted by the retention of the topic before they were
+		// ingested
+		kgo.ConsumeResetOffset(c.startOffset()),
+		kgo.FetchIsolationLevel(kgo.ReadCommitted()),
+		kgo.FetchMaxBytes(c.fetchMaxBytes),
+		kgo.FetchMaxPartitionBytes(c.fetchMaxBytes),
+	)...)
+
+	if err != nil {
+		c.l.Error().Err(err).Msgf("could not create client for partition %d of topic %s", p.Partition, p.Topic)
+		return
+	}
+
+	defer client.Close()
+
+	c.l.Info().Msgf("consuming partition %d of topic %s", p.Partition, p.Topic)
+
+	failures := 0
+
+	for ctx.Err() == nil {
+		fetches := client.PollFetches(ctx)
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		fetchFailed := false
+
+		fetches.EachError(func(_ string, _ int32, err error) {
+			fetchFailed = true
+			c.l.Error().Err(err).Msgf("could not fetch partition %d of topic %s", p.Partition, p.Topic)
+		})
+
+		records := fetches.Records()
+
+		if len(records) == 0 {
+			if fetchFailed {
+				failures++
+				sleep(ctx, backoff(failures))
+			}
+
+			continue
+		}
+
+		if err := c.ingest(ctx, t, p, records); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			// the offset is rewound, so the messages are fetched and ingested again
+			client.SetOffsets(map[string]map[int32]kgo.EpochOffset{
+				p.Topic: {p.Partition: {Epoch: -1, Offset: records[0].Offset}},
+			})
+
+			failures++
+			c.l.Error().Err(err).Msgf("could not ingest messages of partition %d of topic %s", p.Partition, p.Topic)
+			sleep(ctx, backoff(failures))
+
+			continue
+		}
+
+		failures = 0
+
+		offset := records[len(records)-1].Offset + 1
+
+		err = c.repo.CommitOffset(ctx, c.consumerGroup, c.consumerId, p.Topic, p.Partition, offset)
+
+		if errors.Is(err, repository.ErrKafkaPartitionLeaseLost) {
+			c.l.Info().Msgf("lease on partition %d of topic %s was lost, stopping consumer", p.Partition, p.Topic)
+			return
+		}
+
+		if err != nil && ctx.Err() == nil {
+			// the next commit includes the messages which were ingested
+			c.l.Error().Err(err).Msgf("could not commit offset of partition %d of topic %s", p.Partition, p.Topic)
+		}
+	}
+}
+
+// startOffset returns the offset which partitions without a stored offset are consumed from.
+func (c *Consumer) startOffset() kgo.Offset {
+	if c.startFrom == "earliest" {
+		return kgo.NewOffset().AtStart()
+	}
+
+	return kgo.NewOffset().AtEnd()
+}
+
+// ingest ingests records as events. Records which can't be transformed into events, or whose data doesn't match the
+// input schema of a workflow they trigger, are logged and skipped, since retrying them would block the partition.
+func (c *Consumer) ingest(ctx context.Context, t *topic, p repository.KafkaPartition, records []*kgo.Record) error {
+	events := make([]*repository.CreateEventOpts, 0, len(records))
+	offsets := make([]int64, 0, len(records))
+
+	for _, record := range records {
+		event, err := c.toEvent(t, p, record)
+
+		if err != nil {
+			c.l.Error().Err(err).Msgf("skipping message at offset %d of partition %d of topic %s", record.Offset, p.Partition, p.Topic)
+			continue
+		}
+
+		events = append(events, event)
+		offsets = append(offsets, record.Offset)
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	_, err := c.ingestor.BulkIngestEvent(ctx, t.tenantId, events)
+
+	var validationErr *repository.WorkflowRunInputValidationError
+
+	if !errors.As(err, &validationErr) {
+		return err
+	}
+
+	// ingest the events one by one, so only the invalid events are skipped
+	for i, event := range events {
+		_, err := c.ingestor.BulkIngestEvent(ctx, t.tenantId, []*repository.CreateEventOpts{event})
+
+		if errors.As(err, &validationErr) {
+			c.l.Error().Err(err).Msgf("skipping message at offset %d of partition %d of topic %s", offsets[i], p.Partition, p.Topic)
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Consumer) toEvent(t *topic, p repository.KafkaPartition, record *kgo.Record) (*repository.CreateEventOpts, error) {
+	var payload interface{}
+
+	if err := json.Unmarshal(record.Value, &payload); err != nil {
+		payload = string(record.Value)
+	}
+
+	var data []byte
+
+	if t.transform != nil {
+		headers := make(map[string]string, len(record.Headers))
+
+		for _, h := range record.Headers {
+			headers[h.Key] = string(h.Value)
+		}
+
+		in := cel.NewInput(cel.WithKafkaMessage(p.Topic, p.Partition, record.Offset, string(record.Key), payload, headers))
+
+		var err error
+
+		data, err = cel.EvalKafkaMessageTransform(t.transform, in)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not transform message: %w", err)
+		}
+	} else {
+		if _, ok := payload.(map[string]interface{}); !ok {
+			return nil, fmt.Errorf("message is not a JSON object, configure a transform for the topic")
+		}
+
+		data = record.Value
+	}
+
+	metadata := map[string]interface{}{
+		"kafka_topic":     p.Topic,
+		"kafka_partition": strconv.Itoa(int(p.Partition)),
+		"kafka_offset":    strconv.FormatInt(record.Offset, 10),
+	}
+
+	if len(record.Key) > 0 {
+		metadata["kafka_key"] = string(record.Key)
+	}
+
+	metadataBytes, err := json.Marshal(metadata)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal additional metadata: %w", err)
+	}
+
+	idempotencyKey := fmt.Sprintf("kafka:%s:%s:%d:%d", c.consumerGroup, p.Topic, p.Partition, record.Offset)
+
+	return &repository.CreateEventOpts{
+		TenantId:           t.tenantId,
+		Key:                t.eventKey,
+		Data:               data,
+		AdditionalMetadata: metadataBytes,
+		IdempotencyKey:     &idempotencyKey,
+	}, nil
+}
+
+func backoff(failures int) time.Duration {
+	d := time.Second << min(failures-1, 5)
+
+	return min(d, maxBackoff)
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(d):
+	}
+}
@@ -0,0 +1,65 @@
+package kafkaconsumer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+func TestToEvent(t *testing.T) {
+	c := &Consumer{consumerGroup: "hatchet"}
+	p := repository.KafkaPartition{Topic: "orders", Partition: 2}
+
+	tp := &topic{
+		name:     "orders",
+		tenantId: "707d0855-80ab-4e1f-a156-f1c4546cbf52",
+		eventKey: "order:created",
+	}
+
+	t.Run("payload is used as data", func(t *testing.T) {
+		event, err := c.toEvent(tp, p, &kgo.Record{
+			Offset: 42,
+			Key:    []byte("order-1"),
+			Value:  []byte(`{"id":"order-1"}`),
+		})
+
+		require.NoError(t, err)
+
+		assert.Equal(t, "order:created", event.Key)
+		assert.JSONEq(t, `{"id":"order-1"}`, string(event.Data))
+		assert.JSONEq(t, `{"kafka_topic":"orders","kafka_partition":"2","kafka_offset":"42","kafka_key":"order-1"}`, string(event.AdditionalMetadata))
+		assert.Equal(t, "kafka:hatchet:orders:2:42", *event.IdempotencyKey)
+	})
+
+	t.Run("payload which isn't an object is rejected", func(t *testing.T) {
+		_, err := c.toEvent(tp, p, &kgo.Record{
+			Offset: 43,
+			Value:  []byte(`not json`),
+		})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("transform", func(t *testing.T) {
+		prg, err := cel.NewCELParser().ParseKafkaMessageTransform(`{"body": payload, "source": headers["source"], "offset": offset}`)
+		require.NoError(t, err)
+
+		transformed := *tp
+		transformed.transform = prg
+
+		event, err := c.toEvent(&transformed, p, &kgo.Record{
+			Offset:  44,
+			Value:   []byte(`plain text`),
+			Headers: []kgo.RecordHeader{{Key: "source", Value: []byte("shop")}},
+		})
+
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{"body":"plain text","source":"shop","offset":44}`, string(event.Data))
+	})
+}
@@ -0,0 +1,127 @@
+package ingestor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+
+	hatchetcel "github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// routingProgramCacheSize is the number of compiled routing predicates which are cached
+const routingProgramCacheSize = 2000
+
+// eventTask returns the task which processes an event, with the workflows the event is routed to.
+func (i *IngestorImpl) eventTask(ctx context.Context, e *dbsqlc.Event, idempotencyKey *string) (*msgqueue.Message, error) {
+	routedWorkflowIds, err := i.routeEvent(ctx, e)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not route event: %w", err)
+	}
+
+	task := tasktypes.EventToTask(e, idempotencyKey, routedWorkflowIds)
+
+	// the task is added to the queue without the context of the request, so the trace context of the caller is set
+	// here, which makes the workflow runs of the event part of the caller's trace
+	task.OtelCarrier = telemetry.GetCarrier(ctx)
+
+	return task, nil
+}
+
+// routeEvent evaluates the routing rules of the tenant of an event, and returns the ids of the workflows of the rules
+// whose predicate the event matches. A rule whose predicate can't be evaluated against the event doesn't match it.
+func (i *IngestorImpl) routeEvent(ctx context.Context, e *dbsqlc.Event) ([]string, error) {
+	if i.eventRoutingRepository == nil {
+		return nil, nil
+	}
+
+	tenantId := sqlchelpers.UUIDToStr(e.TenantId)
+
+	rules, err := i.eventRoutingRepository.ListEventRoutingRulesForEngine(ctx, tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var in hatchetcel.Input
+	var routedWorkflowIds []string
+	seen := make(map[string]bool)
+
+	for _, rule := range rules {
+		if rule.EventKey.Valid && rule.EventKey.String != e.Key {
+			continue
+		}
+
+		workflowId := sqlchelpers.UUIDToStr(rule.WorkflowId)
+
+		if seen[workflowId] {
+			continue
+		}
+
+		prg, err := i.routingProgram(rule.Expression)
+
+		if err != nil {
+			continue
+		}
+
+		// the input is only decoded if the event has a rule which applies to it
+		if in == nil {
+			in = eventRoutingInput(e)
+		}
+
+		matches, err := hatchetcel.EvalEventRoutingExpression(prg, in)
+
+		if err != nil || !matches {
+			continue
+		}
+
+		seen[workflowId] = true
+		routedWorkflowIds = append(routedWorkflowIds, workflowId)
+	}
+
+	return routedWorkflowIds, nil
+}
+
+// routingProgram returns the compiled predicate of a routing rule. Predicates are cached by their expression, so
+// each expression is only compiled once.
+func (i *IngestorImpl) routingProgram(expr string) (cel.Program, error) {
+	if prg, ok := i.routingPrograms.Get(expr); ok {
+		return prg, nil
+	}
+
+	prg, err := i.celParser.ParseEventRoutingExpression(expr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	i.routingPrograms.Add(expr, prg)
+
+	return prg, nil
+}
+
+func eventRoutingInput(e *dbsqlc.Event) hatchetcel.Input {
+	data := map[string]interface{}{}
+	additionalMetadata := map[string]interface{}{}
+
+	if len(e.Data) > 0 {
+		_ = json.Unmarshal(e.Data, &data)
+	}
+
+	if len(e.AdditionalMetadata) > 0 {
+		_ = json.Unmarshal(e.AdditionalMetadata, &additionalMetadata)
+	}
+
+	return hatchetcel.NewInput(
+		hatchetcel.WithInput(data),
+		hatchetcel.WithAdditionalMetadata(additionalMetadata),
+		hatchetcel.WithEventKey(e.Key),
+	)
+}
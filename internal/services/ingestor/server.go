@@ -2,6 +2,8 @@ package ingestor
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"strconv"
 	"time"
 
@@ -29,12 +31,27 @@ func (i *IngestorImpl) Push(ctx context.Context, req *contracts.PushEventRequest
 	if req.AdditionalMetadata != nil {
 		additionalMeta = []byte(*req.AdditionalMetadata)
 	}
-	event, err := i.IngestEvent(ctx, tenantId, req.Key, []byte(req.Payload), additionalMeta)
+	event, err := i.ingestEvent(ctx, &repository.CreateEventOpts{
+		TenantId:           tenantId,
+		Key:                req.Key,
+		Data:               []byte(req.Payload),
+		AdditionalMetadata: additionalMeta,
+		IdempotencyKey:     req.IdempotencyKey,
+		DeliverAfter:       toDeliverAfter(req.DeliverAfter),
+	})
 
 	if err == metered.ErrResourceExhausted {
 		return nil, status.Errorf(codes.ResourceExhausted, "resource exhausted: event limit exceeded for tenant")
 	}
 
+	if st := quotaExceededStatus(err); st != nil {
+		return nil, st
+	}
+
+	if st := inputValidationStatus(err); st != nil {
+		return nil, st
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +91,8 @@ func (i *IngestorImpl) BulkPush(ctx context.Context, req *contracts.BulkPushEven
 			Key:                e.Key,
 			Data:               []byte(e.Payload),
 			AdditionalMetadata: additionalMeta,
+			IdempotencyKey:     e.IdempotencyKey,
+			DeliverAfter:       toDeliverAfter(e.DeliverAfter),
 		})
 	}
 
@@ -98,6 +117,12 @@ func (i *IngestorImpl) BulkPush(ctx context.Context, req *contracts.BulkPushEven
 	if err == metered.ErrResourceExhausted {
 		return nil, status.Errorf(codes.ResourceExhausted, "resource exhausted: event limit exceeded for tenant")
 	}
+	if st := quotaExceededStatus(err); st != nil {
+		return nil, st
+	}
+	if st := inputValidationStatus(err); st != nil {
+		return nil, st
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -118,6 +143,81 @@ func (i *IngestorImpl) BulkPush(ctx context.Context, req *contracts.BulkPushEven
 	return &contracts.Events{Events: contractEvents}, nil
 }
 
+func (i *IngestorImpl) PushEvents(ctx context.Context, req *contracts.PushEventsRequest) (*contracts.PushEventsResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	if len(req.Events) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "No events to ingest")
+	}
+
+	if len(req.Events) > MaxIngestEventsBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: too many events - %d is over maximum (%d)", len(req.Events), MaxIngestEventsBatchSize)
+	}
+
+	events := make([]*repository.CreateEventOpts, len(req.Events))
+
+	for idx, e := range req.Events {
+		var additionalMeta []byte
+
+		if e.AdditionalMetadata != nil {
+			additionalMeta = []byte(*e.AdditionalMetadata)
+		}
+
+		events[idx] = &repository.CreateEventOpts{
+			TenantId:           tenantId,
+			Key:                e.Key,
+			Data:               []byte(e.Payload),
+			AdditionalMetadata: additionalMeta,
+			IdempotencyKey:     e.IdempotencyKey,
+			DeliverAfter:       toDeliverAfter(e.DeliverAfter),
+		}
+	}
+
+	results, err := i.IngestEvents(ctx, tenantId, events)
+
+	if err == metered.ErrResourceExhausted {
+		return nil, status.Errorf(codes.ResourceExhausted, "resource exhausted: event limit exceeded for tenant")
+	}
+
+	if st := quotaExceededStatus(err); st != nil {
+		return nil, st
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &contracts.PushEventsResponse{
+		Results: make([]*contracts.PushEventResult, len(results)),
+	}
+
+	for idx, result := range results {
+		res := &contracts.PushEventResult{
+			Index: int32(idx), // nolint: gosec
+		}
+
+		if result.Err != nil {
+			errMsg := result.Err.Error()
+			res.Error = &errMsg
+			resp.Failed++
+		} else {
+			res.Event, err = toEvent(result.Event)
+
+			if err != nil {
+				return nil, err
+			}
+
+			resp.Succeeded++
+		}
+
+		resp.Results[idx] = res
+	}
+
+	return resp, nil
+}
+
 func (i *IngestorImpl) ReplaySingleEvent(ctx context.Context, req *contracts.ReplayEventRequest) (*contracts.Event, error) {
 	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
 
@@ -202,10 +302,71 @@ func (i *IngestorImpl) PutLog(ctx context.Context, req *contracts.PutLogRequest)
 
 	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
 
+	opts := toCreateLogLineOpts(req)
+
+	if apiErrors, err := i.v.ValidateAPI(opts); err != nil {
+		return nil, err
+	} else if apiErrors != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %s", apiErrors.String())
+	}
+
+	_, err := i.logRepository.PutLog(ctx, tenantId, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &contracts.PutLogResponse{}, nil
+}
+
+func (i *IngestorImpl) PutLogs(ctx context.Context, req *contracts.PutLogsRequest) (*contracts.PutLogsResponse, error) {
+	tenant := ctx.Value("tenant").(*dbsqlc.Tenant)
+
+	tenantId := sqlchelpers.UUIDToStr(tenant.ID)
+
+	if len(req.Logs) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "No log lines to put")
+	}
+
+	if len(req.Logs) > MaxPutLogsBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: too many log lines - %d is over maximum (%d)", len(req.Logs), MaxPutLogsBatchSize)
+	}
+
+	opts := make([]*repository.CreateLogLineOpts, len(req.Logs))
+
+	for idx, l := range req.Logs {
+		opts[idx] = toCreateLogLineOpts(l)
+
+		if apiErrors, err := i.v.ValidateAPI(opts[idx]); err != nil {
+			return nil, err
+		} else if apiErrors != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid request: log line %d: %s", idx, apiErrors.String())
+		}
+
+		if opts[idx].Metadata != nil && !json.Valid(opts[idx].Metadata) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid request: log line %d: metadata is not valid JSON", idx)
+		}
+	}
+
+	stored, err := i.logRepository.PutLogs(ctx, tenantId, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &contracts.PutLogsResponse{
+		Stored:  int32(stored),             // nolint: gosec
+		Dropped: int32(len(opts) - stored), // nolint: gosec
+	}, nil
+}
+
+func toCreateLogLineOpts(req *contracts.PutLogRequest) *repository.CreateLogLineOpts {
 	var createdAt *time.Time
 
-	if t := req.CreatedAt.AsTime(); !t.IsZero() {
-		createdAt = &t
+	if req.CreatedAt != nil {
+		if t := req.CreatedAt.AsTime(); !t.IsZero() {
+			createdAt = &t
+		}
 	}
 
 	var metadata []byte
@@ -214,27 +375,13 @@ func (i *IngestorImpl) PutLog(ctx context.Context, req *contracts.PutLogRequest)
 		metadata = []byte(req.Metadata)
 	}
 
-	opts := &repository.CreateLogLineOpts{
+	return &repository.CreateLogLineOpts{
 		StepRunId: req.StepRunId,
 		CreatedAt: createdAt,
 		Message:   req.Message,
 		Level:     req.Level,
 		Metadata:  metadata,
 	}
-
-	if apiErrors, err := i.v.ValidateAPI(opts); err != nil {
-		return nil, err
-	} else if apiErrors != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %s", apiErrors.String())
-	}
-
-	_, err := i.logRepository.PutLog(ctx, tenantId, opts)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return &contracts.PutLogResponse{}, nil
 }
 
 func toEvent(e *dbsqlc.Event) (*contracts.Event, error) {
@@ -250,6 +397,17 @@ func toEvent(e *dbsqlc.Event) (*contracts.Event, error) {
 	}, nil
 }
 
+// toDeliverAfter returns the delivery time of a pushed event, or nil if the event is delivered right away.
+func toDeliverAfter(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	deliverAfter := ts.AsTime()
+
+	return &deliverAfter
+}
+
 func streamEventToTask(e *dbsqlc.StreamEvent, workflowRunId string, retryCount *int32, retries *int32) *msgqueue.Message {
 	tenantId := sqlchelpers.UUIDToStr(e.TenantId)
 
@@ -276,3 +434,27 @@ func streamEventToTask(e *dbsqlc.StreamEvent, workflowRunId string, retryCount *
 		Retries:  3,
 	}
 }
+
+// inputValidationStatus returns an InvalidArgument status which lists the schema violations if err is caused by
+// event data that doesn't match the input schema of a workflow the event triggers, and nil otherwise.
+func inputValidationStatus(err error) error {
+	var target *repository.WorkflowRunInputValidationError
+
+	if errors.As(err, &target) {
+		return status.Errorf(codes.InvalidArgument, "event data is invalid: %s", target.Error())
+	}
+
+	return nil
+}
+
+// quotaExceededStatus returns a ResourceExhausted status if err is caused by a quota of the tenant being exceeded, and
+// nil otherwise.
+func quotaExceededStatus(err error) error {
+	var target repository.ErrQuotaExceeded
+
+	if errors.As(err, &target) {
+		return status.Error(codes.ResourceExhausted, target.Error())
+	}
+
+	return nil
+}
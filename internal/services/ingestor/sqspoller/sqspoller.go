@@ -0,0 +1,301 @@
+package sqspoller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/ingestors/sqs"
+	"github.com/hatchet-dev/hatchet/internal/services/partition"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+const (
+	// waitTime is how long a receive request long polls the queue for
+	waitTime = 20 * time.Second
+
+	maxBackoff = time.Minute
+)
+
+// SQSPoller polls the queues of the SQS integrations of the tenants in the worker partition, and ingests their
+// messages as events. Messages are deleted from the queue after they were ingested, so they're ingested at least once.
+// Messages which are delivered more than once don't trigger duplicate workflow runs, since their events have an
+// idempotency key derived from the message id.
+type SQSPoller struct {
+	sc *server.ServerConfig
+	p  *partition.Partition
+
+	mu      sync.Mutex
+	pollers map[string]context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+func New(sc *server.ServerConfig, p *partition.Partition) *SQSPoller {
+	return &SQSPoller{
+		sc:      sc,
+		p:       p,
+		pollers: map[string]context.CancelFunc{},
+	}
+}
+
+func (c *SQSPoller) Start() (func() error, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ticker := time.NewTicker(10 * time.Second)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				if err := c.check(ctx); err != nil {
+					c.sc.Logger.Warn().Err(err).Msgf("error checking sqs integrations")
+				}
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+
+	return func() error {
+		cancel()
+
+		c.mu.Lock()
+		for _, cancelPoller := range c.pollers {
+			cancelPoller()
+		}
+		c.mu.Unlock()
+
+		c.wg.Wait()
+
+		return nil
+	}, nil
+}
+
+// check starts polling the queues of new integrations, and stops polling the queues of deleted integrations.
+func (c *SQSPoller) check(ctx context.Context) error {
+	integrations, err := c.sc.EngineRepository.SQSIntegration().ListSQSIntegrationsByPartitionId(ctx, c.p.GetWorkerPartitionId())
+
+	if err != nil {
+		return fmt.Errorf("could not list sqs integrations: %w", err)
+	}
+
+	current := make(map[string]*dbsqlc.SQSIntegration, len(integrations))
+
+	for _, integration := range integrations {
+		current[sqlchelpers.UUIDToStr(integration.ID)] = integration
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for id, cancel := range c.pollers {
+		if _, ok := current[id]; !ok {
+			cancel()
+			delete(c.pollers, id)
+		}
+	}
+
+	for id, integration := range current {
+		if _, ok := c.pollers[id]; ok || ctx.Err() != nil {
+			continue
+		}
+
+		pollCtx, cancel := context.WithCancel(ctx)
+		c.pollers[id] = cancel
+
+		c.wg.Add(1)
+
+		go func() {
+			defer c.wg.Done()
+			c.poll(pollCtx, integration)
+		}()
+	}
+
+	return nil
+}
+
+// poll ingests the messages of the queue of an integration until the context is cancelled.
+func (c *SQSPoller) poll(ctx context.Context, integration *dbsqlc.SQSIntegration) {
+	tenantId := sqlchelpers.UUIDToStr(integration.TenantId)
+	failures := 0
+
+	for ctx.Err() == nil {
+		err := c.receive(ctx, integration)
+
+		if err == nil {
+			failures = 0
+			continue
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		failures++
+		c.sc.Logger.Error().Err(err).Msgf("could not poll sqs queue %s of tenant %s", integration.QueueUrl, tenantId)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(min(time.Second<<min(failures-1, 6), maxBackoff)):
+		}
+	}
+}
+
+// receive receives a batch of messages, ingests them and deletes them from the queue. Messages which aren't deleted
+// are delivered again once their visibility timeout expires, or moved to the dead-letter queue of the queue.
+func (c *SQSPoller) receive(ctx context.Context, integration *dbsqlc.SQSIntegration) error {
+	tenantId := sqlchelpers.UUIDToStr(integration.TenantId)
+
+	client, err := c.newClient(ctx, integration)
+
+	if err != nil {
+		return err
+	}
+
+	messages, err := client.ReceiveMessages(ctx, sqs.MaxMessages, waitTime)
+
+	if err != nil {
+		return err
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	events := make([]*repository.CreateEventOpts, len(messages))
+
+	for i, message := range messages {
+		events[i], err = messageToEvent(integration, message)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	ingested := make([]string, 0, len(messages))
+
+	_, err = c.sc.Ingestor.BulkIngestEvent(ctx, tenantId, events)
+
+	var validationErr *repository.WorkflowRunInputValidationError
+
+	switch {
+	case err == nil:
+		for _, message := range messages {
+			ingested = append(ingested, message.ReceiptHandle)
+		}
+	case errors.As(err, &validationErr):
+		// ingest the messages one by one, so only the invalid messages are left on the queue
+		for i, event := range events {
+			_, err := c.sc.Ingestor.BulkIngestEvent(ctx, tenantId, []*repository.CreateEventOpts{event})
+
+			if errors.As(err, &validationErr) {
+				c.sc.Logger.Error().Err(err).Msgf("could not ingest sqs message %s of tenant %s", messages[i].MessageId, tenantId)
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("could not ingest sqs messages: %w", err)
+			}
+
+			ingested = append(ingested, messages[i].ReceiptHandle)
+		}
+	default:
+		return fmt.Errorf("could not ingest sqs messages: %w", err)
+	}
+
+	if err := client.DeleteMessages(ctx, ingested); err != nil {
+		return fmt.Errorf("could not delete ingested sqs messages: %w", err)
+	}
+
+	return nil
+}
+
+// newClient creates a client with the credentials which are stored in the secrets of the tenant. The secrets are
+// resolved for every batch, so rotated credentials are picked up once the secrets cache expires.
+func (c *SQSPoller) newClient(ctx context.Context, integration *dbsqlc.SQSIntegration) (*sqs.Client, error) {
+	if c.sc.SecretResolver == nil {
+		return nil, fmt.Errorf("secrets are disabled, so the credentials of the queue can't be resolved")
+	}
+
+	tenantId := sqlchelpers.UUIDToStr(integration.TenantId)
+
+	accessKeyId, err := c.sc.SecretResolver.GetSecret(ctx, tenantId, integration.AccessKeyIdSecret)
+
+	if err != nil {
+		return nil, err
+	}
+
+	secretAccessKey, err := c.sc.SecretResolver.GetSecret(ctx, tenantId, integration.SecretAccessKeySecret)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var sessionToken string
+
+	if integration.SessionTokenSecret.Valid {
+		sessionToken, err = c.sc.SecretResolver.GetSecret(ctx, tenantId, integration.SessionTokenSecret.String)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sqs.NewClient(sqs.ClientOpts{
+		QueueURL:        integration.QueueUrl,
+		Region:          integration.Region,
+		AccessKeyID:     accessKeyId,
+		SecretAccessKey: secretAccessKey,
+		SessionToken:    sessionToken,
+	})
+}
+
+// messageToEvent converts a message into an event. If the body of the message is a JSON object it's used as the data
+// of the event, otherwise the body is set as the message field of the data.
+func messageToEvent(integration *dbsqlc.SQSIntegration, message *sqs.Message) (*repository.CreateEventOpts, error) {
+	var data []byte
+	var payload interface{}
+
+	if err := json.Unmarshal([]byte(message.Body), &payload); err == nil {
+		if _, ok := payload.(map[string]interface{}); ok {
+			data = []byte(message.Body)
+		}
+	}
+
+	if data == nil {
+		var err error
+
+		data, err = json.Marshal(map[string]string{
+			"message": message.Body,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	metadata, err := json.Marshal(map[string]string{
+		"sqs_message_id": message.MessageId,
+		"sqs_queue_url":  integration.QueueUrl,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	idempotencyKey := fmt.Sprintf("sqs:%s:%s", sqlchelpers.UUIDToStr(integration.ID), message.MessageId)
+
+	return &repository.CreateEventOpts{
+		TenantId:           sqlchelpers.UUIDToStr(integration.TenantId),
+		Key:                integration.EventKey,
+		Data:               data,
+		AdditionalMetadata: metadata,
+		IdempotencyKey:     &idempotencyKey,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package sqspoller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/ingestors/sqs"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestMessageToEvent(t *testing.T) {
+	integration := &dbsqlc.SQSIntegration{
+		ID:       sqlchelpers.UUIDFromStr("5e8a7b4e-0c5f-4b8e-9f0b-1d2c3b4a5f6e"),
+		TenantId: sqlchelpers.UUIDFromStr("707d0855-80ab-4e1f-a156-f1c4546cbf52"),
+		QueueUrl: "https://sqs.us-east-1.amazonaws.com/123456789012/orders",
+		EventKey: "order:created",
+	}
+
+	event, err := messageToEvent(integration, &sqs.Message{
+		MessageId: "m1",
+		Body:      `{"id":"order-1"}`,
+	})
+
+	require.NoError(t, err)
+
+	assert.Equal(t, "707d0855-80ab-4e1f-a156-f1c4546cbf52", event.TenantId)
+	assert.Equal(t, "order:created", event.Key)
+	assert.JSONEq(t, `{"id":"order-1"}`, string(event.Data))
+	assert.JSONEq(t, `{"sqs_message_id":"m1","sqs_queue_url":"https://sqs.us-east-1.amazonaws.com/123456789012/orders"}`, string(event.AdditionalMetadata))
+	assert.Equal(t, "sqs:5e8a7b4e-0c5f-4b8e-9f0b-1d2c3b4a5f6e:m1", *event.IdempotencyKey)
+
+	event, err = messageToEvent(integration, &sqs.Message{
+		MessageId: "m2",
+		Body:      `order-2`,
+	})
+
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"message":"order-2"}`, string(event.Data))
+}
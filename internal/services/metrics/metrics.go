@@ -0,0 +1,109 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/metrics"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+// Metrics serves the engine metrics in the Prometheus text format. The metrics which are read from the database are
+// refreshed on every scrape.
+type Metrics struct {
+	repository repository.EngineRepository
+	pools      map[string]*pgxpool.Pool
+
+	address string
+	path    string
+
+	l *zerolog.Logger
+}
+
+func New(repo repository.EngineRepository, pools map[string]*pgxpool.Pool, address, path string, l *zerolog.Logger) *Metrics {
+	return &Metrics{
+		repository: repo,
+		pools:      pools,
+		address:    address,
+		path:       path,
+		l:          l,
+	}
+}
+
+func (m *Metrics) Start() (func() error, error) {
+	mux := http.NewServeMux()
+
+	handler := metrics.Handler()
+
+	mux.HandleFunc(m.path, func(w http.ResponseWriter, r *http.Request) {
+		m.refresh(r.Context())
+
+		handler.ServeHTTP(w, r)
+	})
+
+	server := &http.Server{
+		Addr:         m.address,
+		Handler:      mux,
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: 10 * time.Second,
+	}
+
+	l, err := net.Listen("tcp", server.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("could not listen on %s: %w", server.Addr, err)
+	}
+	go func() {
+		if err := server.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panic(err)
+		}
+	}()
+
+	cleanup := func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := server.Shutdown(ctx); err != nil {
+			return fmt.Errorf("could not shutdown server: %w", err)
+		}
+		return nil
+	}
+
+	return cleanup, nil
+}
+
+func (m *Metrics) refresh(ctx context.Context) {
+	for name, pool := range m.pools {
+		stat := pool.Stat()
+
+		metrics.DBPoolConnections.WithLabelValues(name, "acquired").Set(float64(stat.AcquiredConns()))
+		metrics.DBPoolConnections.WithLabelValues(name, "idle").Set(float64(stat.IdleConns()))
+		metrics.DBPoolConnections.WithLabelValues(name, "total").Set(float64(stat.TotalConns()))
+		metrics.DBPoolConnections.WithLabelValues(name, "max").Set(float64(stat.MaxConns()))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	counts, err := m.repository.StepRun().ListQueueCountsForAllTenants(ctx)
+
+	if err != nil {
+		// keep the last queue depths, so a slow database doesn't fail the scrape
+		m.l.Err(err).Msg("could not list queue counts for metrics")
+		return
+	}
+
+	// queues which were drained since the last scrape don't have a row anymore
+	metrics.QueueDepth.Reset()
+
+	for tenantId, queues := range counts {
+		for queue, count := range queues {
+			metrics.QueueDepth.WithLabelValues(tenantId, queue).Set(float64(count))
+		}
+	}
+}
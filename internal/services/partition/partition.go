@@ -32,9 +32,40 @@ type Partition struct {
 	controllerMu sync.Mutex
 	workerMu     sync.Mutex
 	schedulerMu  sync.Mutex
+
+	// tenantPartitionCount is the number of tenant partitions which the controller partitions claim. If 0, tenants
+	// are spread across the controller partitions by the rebalance jobs instead.
+	tenantPartitionCount int32
+
+	tenantPartitionMu sync.Mutex
+	tenantPartitions  []int32
+}
+
+type PartitionOpt func(*PartitionOpts)
+
+type PartitionOpts struct {
+	tenantPartitionCount int32
 }
 
-func NewPartition(l *zerolog.Logger, repo repository.TenantEngineRepository) (*Partition, error) {
+// WithTenantPartitions splits the tenants into a fixed number of tenant partitions, which the controller partitions
+// claim through leases.
+func WithTenantPartitions(count int) PartitionOpt {
+	return func(opts *PartitionOpts) {
+		opts.tenantPartitionCount = int32(count) // nolint: gosec
+	}
+}
+
+func NewPartition(l *zerolog.Logger, repo repository.TenantEngineRepository, fs ...PartitionOpt) (*Partition, error) {
+	opts := &PartitionOpts{}
+
+	for _, f := range fs {
+		f(opts)
+	}
+
+	if opts.tenantPartitionCount < 0 {
+		return nil, fmt.Errorf("tenant partition count must not be negative")
+	}
+
 	s1, err := gocron.NewScheduler(gocron.WithLocation(time.UTC))
 
 	if err != nil {
@@ -59,6 +90,8 @@ func NewPartition(l *zerolog.Logger, repo repository.TenantEngineRepository) (*P
 		controllerCron: s1,
 		workerCron:     s2,
 		schedulerCron:  s3,
+
+		tenantPartitionCount: opts.tenantPartitionCount,
 	}, nil
 }
 
@@ -110,12 +143,25 @@ func (p *Partition) StartControllerPartition(ctx context.Context) (func() error,
 		deleteCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
+		if p.tenantPartitionCount > 0 {
+			err = p.releaseTenantPartitions(deleteCtx)
+
+			if err != nil {
+				return fmt.Errorf("could not release tenant partitions: %w", err)
+			}
+		}
+
 		err = p.repo.DeleteControllerPartition(deleteCtx, p.GetControllerPartitionId())
 
 		if err != nil {
 			return fmt.Errorf("could not delete controller partition: %w", err)
 		}
 
+		if p.tenantPartitionCount > 0 {
+			// the tenants of the partition are moved to the active partitions until their tenant partitions are claimed
+			return p.repo.RebalanceInactiveControllerPartitions(deleteCtx)
+		}
+
 		return p.repo.RebalanceAllControllerPartitions(deleteCtx)
 	}
 
@@ -133,20 +179,35 @@ func (p *Partition) StartControllerPartition(ctx context.Context) (func() error,
 		return nil, fmt.Errorf("could not create controller partition heartbeat job: %w", err)
 	}
 
-	// rebalance partitions 10 seconds after startup
-	_, err = p.controllerCron.NewJob(
-		gocron.OneTimeJob(
-			gocron.OneTimeJobStartDateTime(time.Now().Add(time.Second*10)),
-		),
-		gocron.NewTask(
-			func() {
-				rebalanceAllControllerPartitions(ctx, p.l, p.repo) // nolint: errcheck
-			},
-		),
-	)
+	if p.tenantPartitionCount > 0 {
+		// the tenants are assigned by the claimed tenant partitions, so they aren't rebalanced on startup
+		_, err = p.controllerCron.NewJob(
+			gocron.DurationJob(tenantPartitionClaimInterval),
+			gocron.NewTask(
+				p.runClaimTenantPartitions(ctx),
+			),
+			gocron.WithStartAt(gocron.WithStartImmediately()),
+		)
 
-	if err != nil {
-		return nil, fmt.Errorf("could not create rebalance all controller partitions job: %w", err)
+		if err != nil {
+			return nil, fmt.Errorf("could not create claim tenant partitions job: %w", err)
+		}
+	} else {
+		// rebalance partitions 10 seconds after startup
+		_, err = p.controllerCron.NewJob(
+			gocron.OneTimeJob(
+				gocron.OneTimeJobStartDateTime(time.Now().Add(time.Second*10)),
+			),
+			gocron.NewTask(
+				func() {
+					rebalanceAllControllerPartitions(ctx, p.l, p.repo) // nolint: errcheck
+				},
+			),
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not create rebalance all controller partitions job: %w", err)
+		}
 	}
 
 	_, err = p.controllerCron.NewJob(
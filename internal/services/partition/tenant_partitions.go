@@ -0,0 +1,218 @@
+package partition
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+)
+
+const (
+	// tenantPartitionClaimInterval is the interval at which the controller partition extends the leases on its tenant
+	// partitions and claims the tenant partitions which aren't held.
+	tenantPartitionClaimInterval = time.Second * 5
+
+	// tenantPartitionLeaseDuration is the time after which the tenant partitions of a controller partition which
+	// stopped extending its leases are claimed by the other controller partitions.
+	tenantPartitionLeaseDuration = time.Second * 30
+)
+
+// TenantPartition is a tenant partition along with the controller partition which holds its lease.
+type TenantPartition struct {
+	Partition int32 `json:"partition"`
+
+	ControllerPartitionId   string `json:"controllerPartitionId,omitempty"`
+	ControllerPartitionName string `json:"controllerPartitionName,omitempty"`
+
+	LeaseExpiresAt *time.Time `json:"leaseExpiresAt,omitempty"`
+
+	Tenants int64 `json:"tenants"`
+}
+
+// TenantPartitionMap is the assignment of the tenant partitions to the controller partitions of the engines.
+type TenantPartitionMap struct {
+	// ControllerPartitionId is the controller partition of this engine.
+	ControllerPartitionId string `json:"controllerPartitionId"`
+
+	Partitions []*TenantPartition `json:"partitions"`
+}
+
+// GetTenantPartitionMap returns the tenant partitions along with the controller partitions which hold them.
+func (p *Partition) GetTenantPartitionMap(ctx context.Context) (*TenantPartitionMap, error) {
+	res := &TenantPartitionMap{
+		ControllerPartitionId: p.GetControllerPartitionId(),
+		Partitions:            []*TenantPartition{},
+	}
+
+	if p.tenantPartitionCount == 0 {
+		return res, nil
+	}
+
+	rows, err := p.repo.ListTenantPartitions(ctx, p.tenantPartitionCount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		tp := &TenantPartition{
+			Partition:               row.Partition,
+			ControllerPartitionId:   row.ControllerPartitionId.String,
+			ControllerPartitionName: row.ControllerPartitionName.String,
+			Tenants:                 row.Tenants,
+		}
+
+		if row.ExpiresAt.Valid {
+			tp.LeaseExpiresAt = &row.ExpiresAt.Time
+		}
+
+		res.Partitions = append(res.Partitions, tp)
+	}
+
+	return res, nil
+}
+
+// ServeTenantPartitionMap writes the tenant partition map as JSON.
+func (p *Partition) ServeTenantPartitionMap(w http.ResponseWriter, r *http.Request) {
+	res, err := p.GetTenantPartitionMap(r.Context())
+
+	if err != nil {
+		p.l.Err(err).Msg("could not get tenant partition map")
+		http.Error(w, "could not get tenant partition map", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	json.NewEncoder(w).Encode(res) // nolint: errcheck
+}
+
+func (p *Partition) runClaimTenantPartitions(ctx context.Context) func() {
+	return func() {
+		if !p.tenantPartitionMu.TryLock() {
+			p.l.Warn().Msg("could not acquire lock on tenant partitions")
+			return
+		}
+
+		defer p.tenantPartitionMu.Unlock()
+
+		ctx, cancel := context.WithTimeout(ctx, heartbeatTimeout)
+		defer cancel()
+
+		ctx, span := telemetry.NewSpan(ctx, "claim-tenant-partitions")
+		defer span.End()
+
+		partitionId := p.GetControllerPartitionId()
+
+		replicas, err := p.repo.CountActiveControllerPartitions(ctx)
+
+		if err != nil {
+			p.l.Err(err).Msg("could not count active controller partitions")
+			return
+		}
+
+		rows, err := p.repo.ListTenantPartitions(ctx, p.tenantPartitionCount)
+
+		if err != nil {
+			p.l.Err(err).Msg("could not list tenant partitions")
+			return
+		}
+
+		held := make([]int32, 0)
+		free := make([]int32, 0)
+
+		for _, row := range rows {
+			switch {
+			case !row.ControllerPartitionId.Valid:
+				free = append(free, row.Partition)
+			case row.ControllerPartitionId.String == partitionId:
+				held = append(held, row.Partition)
+			}
+		}
+
+		// controller partitions which start at the same time try to claim different tenant partitions
+		rand.Shuffle(len(free), func(i, j int) {
+			free[i], free[j] = free[j], free[i]
+		})
+
+		claim, release := planTenantPartitions(p.tenantPartitionCount, replicas, held, free)
+
+		if err := p.repo.ReleaseTenantPartitions(ctx, partitionId, release); err != nil {
+			p.l.Err(err).Msg("could not release tenant partitions")
+			return
+		}
+
+		acquired, err := p.repo.AcquireTenantPartitions(ctx, partitionId, claim, tenantPartitionLeaseDuration)
+
+		if err != nil {
+			p.l.Err(err).Msg("could not acquire tenant partitions")
+			return
+		}
+
+		slices.Sort(acquired)
+		p.tenantPartitions = acquired
+
+		moved, err := p.repo.AssignTenantPartitions(ctx, partitionId, p.tenantPartitionCount, acquired)
+
+		if err != nil {
+			p.l.Err(err).Msg("could not assign tenants of tenant partitions")
+			return
+		}
+
+		if moved > 0 || len(release) > 0 {
+			p.l.Info().Msgf("holding tenant partitions %v, released %v, moved %d tenants to this engine", acquired, release, moved)
+		}
+	}
+}
+
+// releaseTenantPartitions releases the leases on the tenant partitions of the controller partition, so the other
+// controller partitions claim them without waiting for the leases to expire.
+func (p *Partition) releaseTenantPartitions(ctx context.Context) error {
+	p.tenantPartitionMu.Lock()
+	defer p.tenantPartitionMu.Unlock()
+
+	err := p.repo.ReleaseTenantPartitions(ctx, p.GetControllerPartitionId(), p.tenantPartitions)
+
+	if err != nil {
+		return err
+	}
+
+	p.tenantPartitions = nil
+
+	return nil
+}
+
+// planTenantPartitions returns the tenant partitions which a controller partition claims and releases, given the
+// tenant partitions which it holds and the tenant partitions which aren't held. Every controller partition claims up
+// to its share of the tenant partitions, and releases the tenant partitions over its share, so they're claimed by the
+// controller partitions which joined.
+func planTenantPartitions(count int32, replicas int64, held, free []int32) (claim, release []int32) {
+	if replicas < 1 {
+		replicas = 1
+	}
+
+	share := int((int64(count) + replicas - 1) / replicas)
+
+	held = slices.Clone(held)
+	slices.Sort(held)
+
+	if len(held) > share {
+		return held[:share], held[share:]
+	}
+
+	claim = held
+
+	for _, partition := range free {
+		if len(claim) >= share {
+			break
+		}
+
+		claim = append(claim, partition)
+	}
+
+	return claim, nil
+}
@@ -0,0 +1,176 @@
+package partition
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanTenantPartitions(t *testing.T) {
+	tests := []struct {
+		name     string
+		count    int32
+		replicas int64
+		held     []int32
+		free     []int32
+		claim    []int32
+		release  []int32
+	}{
+		{
+			name:     "no tenant partitions",
+			count:    0,
+			replicas: 1,
+		},
+		{
+			name:     "no free tenant partitions",
+			count:    4,
+			replicas: 2,
+		},
+		{
+			name:     "single controller partition claims all tenant partitions",
+			count:    4,
+			replicas: 1,
+			free:     []int32{3, 1, 0, 2},
+			claim:    []int32{3, 1, 0, 2},
+		},
+		{
+			name:     "no controller partitions are counted as one",
+			count:    2,
+			replicas: 0,
+			free:     []int32{0, 1},
+			claim:    []int32{0, 1},
+		},
+		{
+			name:     "controller partition claims up to its share",
+			count:    4,
+			replicas: 2,
+			free:     []int32{2, 0, 3, 1},
+			claim:    []int32{2, 0},
+		},
+		{
+			name:     "held tenant partitions count towards the share",
+			count:    4,
+			replicas: 2,
+			held:     []int32{3},
+			free:     []int32{0, 1},
+			claim:    []int32{3, 0},
+		},
+		{
+			name:     "share is rounded up",
+			count:    5,
+			replicas: 2,
+			free:     []int32{0, 1, 2, 3, 4},
+			claim:    []int32{0, 1, 2},
+		},
+		{
+			name:     "controller partition which holds its share claims nothing",
+			count:    4,
+			replicas: 2,
+			held:     []int32{1, 0},
+			free:     []int32{2},
+			claim:    []int32{0, 1},
+		},
+		{
+			// a controller partition joined, so the tenant partitions over the share are released for it
+			name:     "controller partition added releases the tenant partitions over its share",
+			count:    4,
+			replicas: 2,
+			held:     []int32{3, 0, 2, 1},
+			claim:    []int32{0, 1},
+			release:  []int32{2, 3},
+		},
+		{
+			name:     "more controller partitions than tenant partitions",
+			count:    2,
+			replicas: 4,
+			held:     []int32{1, 0},
+			claim:    []int32{0},
+			release:  []int32{1},
+		},
+		{
+			// a controller partition left, so the tenant partitions it released are claimed by the others
+			name:     "controller partition removed claims the released tenant partitions",
+			count:    4,
+			replicas: 1,
+			held:     []int32{0, 1},
+			free:     []int32{3, 2},
+			claim:    []int32{0, 1, 3, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			held := slices.Clone(tt.held)
+
+			claim, release := planTenantPartitions(tt.count, tt.replicas, tt.held, tt.free)
+
+			if len(tt.claim) == 0 {
+				assert.Empty(t, claim)
+			} else {
+				assert.Equal(t, tt.claim, claim)
+			}
+
+			if len(tt.release) == 0 {
+				assert.Empty(t, release)
+			} else {
+				assert.Equal(t, tt.release, release)
+			}
+
+			assert.Equal(t, held, tt.held, "the held tenant partitions must not be modified")
+		})
+	}
+}
+
+func TestPlanTenantPartitionsRebalance(t *testing.T) {
+	const count = 8
+
+	// every controller partition plans with the tenant partitions which are free after the previous ones released and
+	// claimed theirs, so the tenant partitions end up spread evenly across the controller partitions
+	holders := map[string][]int32{
+		"a": {0, 1, 2, 3, 4, 5, 6, 7},
+		"b": nil,
+		"c": nil,
+	}
+
+	for round := 0; round < 3; round++ {
+		for _, id := range []string{"a", "b", "c"} {
+			taken := make(map[int32]bool)
+
+			for other, partitions := range holders {
+				if other == id {
+					continue
+				}
+
+				for _, partition := range partitions {
+					taken[partition] = true
+				}
+			}
+
+			free := make([]int32, 0)
+
+			for partition := int32(0); partition < count; partition++ {
+				if !taken[partition] && !slices.Contains(holders[id], partition) {
+					free = append(free, partition)
+				}
+			}
+
+			claim, _ := planTenantPartitions(count, int64(len(holders)), holders[id], free)
+			holders[id] = claim
+		}
+	}
+
+	seen := make(map[int32]string)
+
+	for id, partitions := range holders {
+		assert.LessOrEqual(t, len(partitions), 3, "%s must hold at most its share", id)
+		assert.GreaterOrEqual(t, len(partitions), 2, "%s must hold tenant partitions", id)
+
+		for _, partition := range partitions {
+			assert.Empty(t, seen[partition], "tenant partition %d is held by %s and %s", partition, seen[partition], id)
+			seen[partition] = id
+		}
+	}
+
+	assert.Len(t, seen, count, "every tenant partition must be held")
+}
@@ -0,0 +1,274 @@
+package scaler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/internal/services/scaler/contracts"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+const (
+	// the interval which the backlog is checked on for KEDA scalers which stream their active state
+	streamIsActiveInterval = 5 * time.Second
+
+	defaultTargetBacklog = 10
+)
+
+var invalidMetricNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// Scaler implements the KEDA external scaler contract, which scales worker deployments on the backlog of a queue or
+// of a workflow of a tenant.
+type Scaler struct {
+	contracts.UnimplementedExternalScalerServer
+
+	config  *server.ServerConfig
+	address string
+
+	l *zerolog.Logger
+}
+
+func New(config *server.ServerConfig, address string, l *zerolog.Logger) *Scaler {
+	return &Scaler{
+		config:  config,
+		address: address,
+		l:       l,
+	}
+}
+
+func (s *Scaler) Start() (func() error, error) {
+	lis, err := net.Listen("tcp", s.address)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not listen on %s: %w", s.address, err)
+	}
+
+	grpcServer := grpc.NewServer()
+
+	contracts.RegisterExternalScalerServer(grpcServer, s)
+
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			panic(fmt.Errorf("failed to serve: %w", err))
+		}
+	}()
+
+	cleanup := func() error {
+		grpcServer.GracefulStop()
+		return nil
+	}
+
+	return cleanup, nil
+}
+
+// target is the queue or workflow which a scaled object scales on, it's parsed from the scaler metadata
+type target struct {
+	tenantId string
+
+	queue    string
+	workflow string
+
+	targetBacklog     int64
+	activationBacklog int64
+}
+
+func (t *target) metricName() string {
+	var name string
+
+	if t.queue != "" {
+		name = "hatchet-queue-" + t.queue
+	} else {
+		name = "hatchet-workflow-" + t.workflow
+	}
+
+	return strings.Trim(invalidMetricNameChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+func (s *Scaler) parseTarget(ctx context.Context, ref *contracts.ScaledObjectRef) (*target, error) {
+	metadata := ref.GetScalerMetadata()
+
+	token := metadata["token"]
+
+	if token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required in the scaler metadata")
+	}
+
+	tenantId, _, err := s.config.Auth.JWTManager.ValidateTenantToken(ctx, token)
+
+	if err != nil {
+		s.l.Debug().Err(err).Msgf("error validating tenant token of scaled object %s/%s", ref.GetNamespace(), ref.GetName())
+		return nil, status.Error(codes.Unauthenticated, "invalid auth token")
+	}
+
+	t := &target{
+		tenantId:      tenantId,
+		queue:         metadata["queue"],
+		workflow:      metadata["workflow"],
+		targetBacklog: defaultTargetBacklog,
+	}
+
+	if (t.queue == "") == (t.workflow == "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of queue or workflow is required in the scaler metadata")
+	}
+
+	if v, ok := metadata["targetBacklog"]; ok {
+		t.targetBacklog, err = strconv.ParseInt(v, 10, 64)
+
+		if err != nil || t.targetBacklog <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "targetBacklog must be a positive integer, got %q", v)
+		}
+	}
+
+	if v, ok := metadata["activationBacklog"]; ok {
+		t.activationBacklog, err = strconv.ParseInt(v, 10, 64)
+
+		if err != nil || t.activationBacklog < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "activationBacklog must be a non-negative integer, got %q", v)
+		}
+	}
+
+	return t, nil
+}
+
+// backlog returns the number of step runs which are waiting in the queue, or the number of workflow runs which are
+// waiting to start for a workflow
+func (s *Scaler) backlog(ctx context.Context, t *target) (int64, error) {
+	if t.queue != "" {
+		counts, err := s.config.EngineRepository.StepRun().GetQueueCounts(ctx, t.tenantId)
+
+		if err != nil {
+			return 0, fmt.Errorf("could not get queue counts: %w", err)
+		}
+
+		return int64(counts[t.queue]), nil
+	}
+
+	workflow, err := s.config.EngineRepository.Workflow().GetWorkflowByName(ctx, t.tenantId, t.workflow)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, status.Errorf(codes.NotFound, "workflow %s not found", t.workflow)
+		}
+
+		return 0, fmt.Errorf("could not get workflow: %w", err)
+	}
+
+	workflowId := sqlchelpers.UUIDToStr(workflow.ID)
+
+	metrics, err := s.config.APIRepository.Tenant().GetQueueMetrics(ctx, t.tenantId, &repository.GetQueueMetricsOpts{
+		WorkflowIds: []string{workflowId},
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("could not get queue metrics: %w", err)
+	}
+
+	m := metrics.ByWorkflowId[workflowId]
+
+	return int64(m.Pending + m.PendingAssignment), nil
+}
+
+func (s *Scaler) isActive(ctx context.Context, ref *contracts.ScaledObjectRef) (bool, error) {
+	t, err := s.parseTarget(ctx, ref)
+
+	if err != nil {
+		return false, err
+	}
+
+	backlog, err := s.backlog(ctx, t)
+
+	if err != nil {
+		return false, err
+	}
+
+	return backlog > t.activationBacklog, nil
+}
+
+func (s *Scaler) IsActive(ctx context.Context, ref *contracts.ScaledObjectRef) (*contracts.IsActiveResponse, error) {
+	active, err := s.isActive(ctx, ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &contracts.IsActiveResponse{
+		Result: active,
+	}, nil
+}
+
+func (s *Scaler) StreamIsActive(ref *contracts.ScaledObjectRef, stream contracts.ExternalScaler_StreamIsActiveServer) error {
+	ticker := time.NewTicker(streamIsActiveInterval)
+	defer ticker.Stop()
+
+	for {
+		active, err := s.isActive(stream.Context(), ref)
+
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(&contracts.IsActiveResponse{Result: active}); err != nil {
+			return err
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scaler) GetMetricSpec(ctx context.Context, ref *contracts.ScaledObjectRef) (*contracts.GetMetricSpecResponse, error) {
+	t, err := s.parseTarget(ctx, ref)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &contracts.GetMetricSpecResponse{
+		MetricSpecs: []*contracts.MetricSpec{
+			{
+				MetricName: t.metricName(),
+				TargetSize: t.targetBacklog,
+			},
+		},
+	}, nil
+}
+
+func (s *Scaler) GetMetrics(ctx context.Context, req *contracts.GetMetricsRequest) (*contracts.GetMetricsResponse, error) {
+	t, err := s.parseTarget(ctx, req.GetScaledObjectRef())
+
+	if err != nil {
+		return nil, err
+	}
+
+	backlog, err := s.backlog(ctx, t)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &contracts.GetMetricsResponse{
+		MetricValues: []*contracts.MetricValue{
+			{
+				MetricName:  t.metricName(),
+				MetricValue: backlog,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,273 @@
+package scaler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hatchet-dev/hatchet/internal/services/scaler/contracts"
+	"github.com/hatchet-dev/hatchet/pkg/auth/token"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// tenantJWTManager accepts the token "valid" as a token of the tenant
+type tenantJWTManager struct {
+	token.JWTManager
+
+	tenantId string
+}
+
+func (m *tenantJWTManager) ValidateTenantToken(ctx context.Context, t string) (string, string, error) {
+	if t != "valid" {
+		return "", "", errors.New("invalid token")
+	}
+
+	return m.tenantId, uuid.New().String(), nil
+}
+
+type scalerStepRunRepository struct {
+	repository.StepRunEngineRepository
+
+	queueCounts map[string]int
+}
+
+func (r *scalerStepRunRepository) GetQueueCounts(ctx context.Context, tenantId string) (map[string]int, error) {
+	return r.queueCounts, nil
+}
+
+type scalerWorkflowRepository struct {
+	repository.WorkflowEngineRepository
+
+	workflows map[string]*dbsqlc.Workflow
+}
+
+func (r *scalerWorkflowRepository) GetWorkflowByName(ctx context.Context, tenantId, workflowName string) (*dbsqlc.Workflow, error) {
+	workflow, ok := r.workflows[workflowName]
+
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+
+	return workflow, nil
+}
+
+type scalerEngineRepository struct {
+	repository.EngineRepository
+
+	stepRuns  *scalerStepRunRepository
+	workflows *scalerWorkflowRepository
+}
+
+func (r *scalerEngineRepository) StepRun() repository.StepRunEngineRepository {
+	return r.stepRuns
+}
+
+func (r *scalerEngineRepository) Workflow() repository.WorkflowEngineRepository {
+	return r.workflows
+}
+
+type scalerTenantRepository struct {
+	repository.TenantAPIRepository
+
+	byWorkflowId map[string]repository.QueueMetric
+}
+
+func (r *scalerTenantRepository) GetQueueMetrics(ctx context.Context, tenantId string, opts *repository.GetQueueMetricsOpts) (*repository.GetQueueMetricsResponse, error) {
+	res := &repository.GetQueueMetricsResponse{
+		ByWorkflowId: make(map[string]repository.QueueMetric),
+	}
+
+	for _, workflowId := range opts.WorkflowIds {
+		if m, ok := r.byWorkflowId[workflowId]; ok {
+			res.ByWorkflowId[workflowId] = m
+		}
+	}
+
+	return res, nil
+}
+
+type scalerAPIRepository struct {
+	repository.APIRepository
+
+	tenants *scalerTenantRepository
+}
+
+func (r *scalerAPIRepository) Tenant() repository.TenantAPIRepository {
+	return r.tenants
+}
+
+func newTestScaler() *Scaler {
+	workflowId := uuid.New().String()
+	l := zerolog.Nop()
+
+	return New(&server.ServerConfig{
+		Config: &database.Config{
+			EngineRepository: &scalerEngineRepository{
+				stepRuns: &scalerStepRunRepository{
+					queueCounts: map[string]int{"process:order": 25},
+				},
+				workflows: &scalerWorkflowRepository{
+					workflows: map[string]*dbsqlc.Workflow{
+						"orders": {ID: sqlchelpers.UUIDFromStr(workflowId)},
+					},
+				},
+			},
+			APIRepository: &scalerAPIRepository{
+				tenants: &scalerTenantRepository{
+					byWorkflowId: map[string]repository.QueueMetric{
+						workflowId: {Pending: 3, PendingAssignment: 4, Running: 100},
+					},
+				},
+			},
+		},
+		Auth: server.AuthConfig{
+			JWTManager: &tenantJWTManager{tenantId: uuid.New().String()},
+		},
+	}, "", &l)
+}
+
+func TestGetMetrics(t *testing.T) {
+	s := newTestScaler()
+
+	tests := []struct {
+		name     string
+		metadata map[string]string
+
+		metricName string
+		targetSize int64
+		value      int64
+		code       codes.Code
+	}{
+		{
+			name:       "queue",
+			metadata:   map[string]string{"token": "valid", "queue": "process:order"},
+			metricName: "hatchet-queue-process-order",
+			targetSize: defaultTargetBacklog,
+			value:      25,
+		},
+		{
+			name:       "queue without a backlog",
+			metadata:   map[string]string{"token": "valid", "queue": "other", "targetBacklog": "5"},
+			metricName: "hatchet-queue-other",
+			targetSize: 5,
+		},
+		{
+			// running workflow runs aren't part of the backlog
+			name:       "workflow",
+			metadata:   map[string]string{"token": "valid", "workflow": "orders"},
+			metricName: "hatchet-workflow-orders",
+			targetSize: defaultTargetBacklog,
+			value:      7,
+		},
+		{
+			name:     "unknown workflow",
+			metadata: map[string]string{"token": "valid", "workflow": "unknown"},
+			code:     codes.NotFound,
+		},
+		{
+			name:     "invalid token",
+			metadata: map[string]string{"token": "invalid", "queue": "process:order"},
+			code:     codes.Unauthenticated,
+		},
+		{
+			name:     "missing token",
+			metadata: map[string]string{"queue": "process:order"},
+			code:     codes.InvalidArgument,
+		},
+		{
+			name:     "queue and workflow",
+			metadata: map[string]string{"token": "valid", "queue": "process:order", "workflow": "orders"},
+			code:     codes.InvalidArgument,
+		},
+		{
+			name:     "neither queue nor workflow",
+			metadata: map[string]string{"token": "valid"},
+			code:     codes.InvalidArgument,
+		},
+		{
+			name:     "invalid target backlog",
+			metadata: map[string]string{"token": "valid", "queue": "process:order", "targetBacklog": "0"},
+			code:     codes.InvalidArgument,
+		},
+		{
+			name:     "invalid activation backlog",
+			metadata: map[string]string{"token": "valid", "queue": "process:order", "activationBacklog": "-1"},
+			code:     codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref := &contracts.ScaledObjectRef{Name: "workers", Namespace: "default", ScalerMetadata: tt.metadata}
+
+			res, err := s.GetMetrics(context.Background(), &contracts.GetMetricsRequest{ScaledObjectRef: ref})
+
+			if tt.code != codes.OK {
+				assert.Equal(t, tt.code, status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, res.MetricValues, 1)
+			assert.Equal(t, tt.metricName, res.MetricValues[0].MetricName)
+			assert.Equal(t, tt.value, res.MetricValues[0].MetricValue)
+
+			// the metric name of the spec matches the metric name of the values
+			spec, err := s.GetMetricSpec(context.Background(), ref)
+			require.NoError(t, err)
+			require.Len(t, spec.MetricSpecs, 1)
+			assert.Equal(t, tt.metricName, spec.MetricSpecs[0].MetricName)
+			assert.Equal(t, tt.targetSize, spec.MetricSpecs[0].TargetSize)
+		})
+	}
+}
+
+func TestIsActive(t *testing.T) {
+	s := newTestScaler()
+
+	tests := []struct {
+		name     string
+		metadata map[string]string
+		active   bool
+	}{
+		{
+			name:     "queue with a backlog",
+			metadata: map[string]string{"token": "valid", "queue": "process:order"},
+			active:   true,
+		},
+		{
+			name:     "queue without a backlog",
+			metadata: map[string]string{"token": "valid", "queue": "other"},
+		},
+		{
+			name:     "backlog which is at the activation backlog",
+			metadata: map[string]string{"token": "valid", "queue": "process:order", "activationBacklog": "25"},
+		},
+		{
+			name:     "backlog which is above the activation backlog",
+			metadata: map[string]string{"token": "valid", "workflow": "orders", "activationBacklog": "6"},
+			active:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := s.IsActive(context.Background(), &contracts.ScaledObjectRef{ScalerMetadata: tt.metadata})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.active, res.Result)
+		})
+	}
+}
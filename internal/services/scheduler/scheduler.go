@@ -20,6 +20,7 @@ import (
 	hatcheterrors "github.com/hatchet-dev/hatchet/pkg/errors"
 	"github.com/hatchet-dev/hatchet/pkg/logger"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 	v2 "github.com/hatchet-dev/hatchet/pkg/scheduling/v2"
 )
@@ -199,7 +200,7 @@ func (s *Scheduler) Start() (func() error, error) {
 
 	cleanupQueue, err := s.mq.Subscribe(
 		msgqueue.QueueTypeFromPartitionIDAndController(s.p.GetSchedulerPartitionId(), msgqueue.Scheduler),
-		msgqueue.NoOpHook, // the only handler is to check the queue, so we acknowledge immediately with the NoOpHook
+		msgqueue.NoOpHook, // the handlers only update in-memory scheduler state, so we acknowledge immediately with the NoOpHook
 		postAck,
 	)
 
@@ -265,6 +266,10 @@ func (s *Scheduler) handleTask(ctx context.Context, task *msgqueue.Message) (err
 		return s.handleCheckQueue(ctx, task)
 	}
 
+	if task.ID == "step-run-tombstone" {
+		return s.handleStepRunTombstone(ctx, task)
+	}
+
 	return fmt.Errorf("unknown task: %s", task.ID)
 }
 
@@ -302,12 +307,43 @@ func (s *Scheduler) handleCheckQueue(ctx context.Context, task *msgqueue.Message
 	return nil
 }
 
+func (s *Scheduler) handleStepRunTombstone(ctx context.Context, task *msgqueue.Message) error {
+	ctx, span := telemetry.NewSpanWithCarrier(ctx, "handle-step-run-tombstone", task.OtelCarrier)
+	defer span.End()
+
+	payload := tasktypes.StepRunTombstoneTaskPayload{}
+
+	if err := s.dv.DecodeAndValidate(task.Payload, &payload); err != nil {
+		return fmt.Errorf("could not decode step run tombstone payload: %w", err)
+	}
+
+	metadata := tasktypes.StepRunTombstoneTaskMetadata{}
+
+	if err := s.dv.DecodeAndValidate(task.Metadata, &metadata); err != nil {
+		return fmt.Errorf("could not decode step run tombstone metadata: %w", err)
+	}
+
+	s.pool.Tombstone(ctx, metadata.TenantId, payload.QueueName, payload.StepRunIds...)
+
+	// the released slots may be used by other queues
+	s.pool.RefreshAll(ctx, metadata.TenantId)
+
+	return nil
+}
+
 func (s *Scheduler) runTenantSetQueues(ctx context.Context) func() {
 	return func() {
 		s.l.Debug().Msgf("partition: checking step run requeue")
 
-		// list all tenants
-		tenants, err := s.repo.Tenant().ListTenantsBySchedulerPartition(ctx, s.p.GetSchedulerPartitionId())
+		var tenants []*dbsqlc.Tenant
+		var err error
+
+		// a warm standby loads all tenants, and relies on leases to determine which tenants it is active for
+		if s.pool.IsStandby() {
+			tenants, err = s.repo.Tenant().ListTenants(ctx)
+		} else {
+			tenants, err = s.repo.Tenant().ListTenantsBySchedulerPartition(ctx, s.p.GetSchedulerPartitionId())
+		}
 
 		if err != nil {
 			s.l.Err(err).Msg("could not list tenants")
@@ -410,6 +446,9 @@ func (s *Scheduler) scheduleStepRuns(ctx context.Context, tenantId string, res *
 }
 
 func (s *Scheduler) internalRetry(ctx context.Context, tenantId string, assigned ...*v2.AssignedQueueItem) {
+	// the step runs were not dispatched, so the rate limit units they consumed can be used by other step runs
+	s.pool.ReturnRateLimits(ctx, tenantId, assigned...)
+
 	for _, a := range assigned {
 		stepRunId := sqlchelpers.UUIDToStr(a.QueueItem.StepRunId)
 
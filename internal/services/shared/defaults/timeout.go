@@ -7,4 +7,6 @@ const (
 	DefaultStepRunTimeout = "300s"
 
 	DefaultScheduleTimeout = 5 * time.Minute
+
+	DefaultSlotReservationTimeout = 60 * time.Minute
 )
@@ -0,0 +1,78 @@
+package tasktypes
+
+import (
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+type AuditLogCreatedTaskPayload struct {
+	AuditLogId   string  `json:"audit_log_id" validate:"required,uuid"`
+	CreatedAt    string  `json:"created_at" validate:"required"`
+	ActorKind    string  `json:"actor_kind" validate:"required"`
+	ActorId      string  `json:"actor_id" validate:"required,uuid"`
+	ActorName    *string `json:"actor_name,omitempty"`
+	Operation    string  `json:"operation" validate:"required"`
+	Method       string  `json:"method" validate:"required"`
+	Path         string  `json:"path" validate:"required"`
+	ResourceType *string `json:"resource_type,omitempty"`
+	ResourceId   *string `json:"resource_id,omitempty"`
+	StatusCode   int32   `json:"status_code"`
+	IpAddress    *string `json:"ip_address,omitempty"`
+	UserAgent    *string `json:"user_agent,omitempty"`
+}
+
+type AuditLogCreatedTaskMetadata struct {
+	TenantId string `json:"tenant_id" validate:"required,uuid"`
+}
+
+// AuditLogCreatedToTask returns the message which is sent to the event queue of the tenant when an entry is appended
+// to its audit log, so the entry can be exported to event sinks.
+func AuditLogCreatedToTask(entry *dbsqlc.AuditLog) *msgqueue.Message {
+	payloadTyped := AuditLogCreatedTaskPayload{
+		AuditLogId: sqlchelpers.UUIDToStr(entry.ID),
+		CreatedAt:  entry.CreatedAt.Time.UTC().Format(time.RFC3339Nano),
+		ActorKind:  string(entry.ActorKind),
+		ActorId:    sqlchelpers.UUIDToStr(entry.ActorId),
+		Operation:  entry.Operation,
+		Method:     entry.Method,
+		Path:       entry.Path,
+		StatusCode: entry.StatusCode,
+	}
+
+	if entry.ActorName.Valid {
+		payloadTyped.ActorName = &entry.ActorName.String
+	}
+
+	if entry.ResourceType.Valid {
+		payloadTyped.ResourceType = &entry.ResourceType.String
+	}
+
+	if entry.ResourceId.Valid {
+		payloadTyped.ResourceId = &entry.ResourceId.String
+	}
+
+	if entry.IpAddress.Valid {
+		payloadTyped.IpAddress = &entry.IpAddress.String
+	}
+
+	if entry.UserAgent.Valid {
+		payloadTyped.UserAgent = &entry.UserAgent.String
+	}
+
+	payload, _ := datautils.ToJSONMap(payloadTyped)
+
+	metadata, _ := datautils.ToJSONMap(AuditLogCreatedTaskMetadata{
+		TenantId: sqlchelpers.UUIDToStr(entry.TenantId),
+	})
+
+	return &msgqueue.Message{
+		ID:       "audit-log-created",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
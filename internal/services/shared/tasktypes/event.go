@@ -1,13 +1,58 @@
 package tasktypes
 
+import (
+	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
 type EventTaskPayload struct {
 	EventId                 string `json:"event_id" validate:"required,uuid"`
 	EventKey                string `json:"event_key" validate:"required"`
 	EventData               string `json:"event_data" validate:"required"`
 	EventAdditionalMetadata string `json:"event_additional_metadata"`
+	EventIdempotencyKey     string `json:"event_idempotency_key,omitempty"`
+	EventReplayed           bool   `json:"event_replayed,omitempty"`
+
+	// the workflows which the event triggers because it matches the routing rules of the tenant, in addition to the
+	// workflows which are triggered by the key of the event
+	EventRoutedWorkflowIds []string `json:"event_routed_workflow_ids,omitempty"`
 }
 
 type EventTaskMetadata struct {
 	EventKey string `json:"event_key" validate:"required"`
 	TenantId string `json:"tenant_id" validate:"required,uuid"`
 }
+
+func EventToTask(e *dbsqlc.Event, idempotencyKey *string, routedWorkflowIds []string) *msgqueue.Message {
+	eventId := sqlchelpers.UUIDToStr(e.ID)
+	tenantId := sqlchelpers.UUIDToStr(e.TenantId)
+
+	payloadTyped := EventTaskPayload{
+		EventId:                 eventId,
+		EventKey:                e.Key,
+		EventData:               string(e.Data),
+		EventAdditionalMetadata: string(e.AdditionalMetadata),
+		EventReplayed:           e.ReplayedFromId.Valid,
+		EventRoutedWorkflowIds:  routedWorkflowIds,
+	}
+
+	if idempotencyKey != nil {
+		payloadTyped.EventIdempotencyKey = *idempotencyKey
+	}
+
+	payload, _ := datautils.ToJSONMap(payloadTyped)
+
+	metadata, _ := datautils.ToJSONMap(EventTaskMetadata{
+		EventKey: e.Key,
+		TenantId: tenantId,
+	})
+
+	return &msgqueue.Message{
+		ID:       "event",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
@@ -60,6 +60,35 @@ func CheckTenantQueueToTask(tenantId, queueName string, isStepQueued bool, isSlo
 	}
 }
 
+type StepRunTombstoneTaskPayload struct {
+	QueueName  string   `json:"queue_name"`
+	StepRunIds []string `json:"step_run_ids" validate:"required,min=1,dive,uuid"`
+}
+
+type StepRunTombstoneTaskMetadata struct {
+	TenantId string `json:"tenant_id" validate:"required,uuid"`
+}
+
+// StepRunTombstoneToTask notifies the scheduler that queued step runs were cancelled, so it can drop them
+// from its in-memory queues and release any slots or rate limit units held for them.
+func StepRunTombstoneToTask(tenantId, queueName string, stepRunIds ...string) *msgqueue.Message {
+	payload, _ := datautils.ToJSONMap(StepRunTombstoneTaskPayload{
+		QueueName:  queueName,
+		StepRunIds: stepRunIds,
+	})
+
+	metadata, _ := datautils.ToJSONMap(StepRunTombstoneTaskMetadata{
+		TenantId: tenantId,
+	})
+
+	return &msgqueue.Message{
+		ID:       "step-run-tombstone",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
+
 type JobRunCancelledTaskPayload struct {
 	JobRunId string  `json:"job_run_id" validate:"required,uuid"`
 	Reason   *string `json:"reason,omitempty"`
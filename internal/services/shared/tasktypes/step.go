@@ -1,6 +1,7 @@
 package tasktypes
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/hatchet-dev/hatchet/internal/datautils"
@@ -29,6 +30,9 @@ type StepRunTaskMetadata struct {
 type StepRunAssignedTaskPayload struct {
 	StepRunId string `json:"step_run_id" validate:"required,uuid"`
 	WorkerId  string `json:"worker_id" validate:"required,uuid"`
+
+	// (optional) whether this is a speculative attempt alongside the worker the step run is assigned to
+	Speculative bool `json:"speculative,omitempty"`
 }
 
 type StepRunAssignedTaskMetadata struct {
@@ -98,6 +102,9 @@ type StepRunFinishedTaskPayload struct {
 	StepOutputData string `json:"step_output_data"`
 	StepRetries    *int32 `json:"step_retries,omitempty"`
 	RetryCount     *int32 `json:"retry_count,omitempty"`
+
+	// (optional) the worker which ran the step run, used to resolve speculative attempts
+	WorkerId string `json:"worker_id,omitempty"`
 }
 
 type StepRunFinishedTaskMetadata struct {
@@ -111,11 +118,57 @@ type StepRunStreamEventTaskPayload struct {
 	StreamEventId string `json:"stream_event_id"`
 	StepRetries   *int32 `json:"step_retries,omitempty"`
 	RetryCount    *int32 `json:"retry_count,omitempty"`
+
+	// (optional) the message of a stream chunk, which is sent inline instead of being stored as a stream event
+	Message *string `json:"message,omitempty"`
+
+	// (optional) the index of a stream chunk within its step run
+	ChunkIndex *int64 `json:"chunk_index,omitempty"`
 }
 
 type StepRunStreamEventTaskMetadata struct {
-	TenantId      string `json:"tenant_id" validate:"required,uuid"`
-	StreamEventId string `json:"stream_event_id" validate:"required,integer"`
+	TenantId string `json:"tenant_id" validate:"required,uuid"`
+
+	// (optional) the id of the stream event, which is empty for stream chunks
+	StreamEventId string `json:"stream_event_id,omitempty" validate:"omitempty,integer"`
+}
+
+type StepRunProgressTaskPayload struct {
+	WorkflowRunId string          `json:"workflow_run_id" validate:"required,uuid"`
+	StepRunId     string          `json:"step_run_id" validate:"required,uuid"`
+	UpdatedAt     string          `json:"updated_at" validate:"required"`
+	Percent       float64         `json:"percent"`
+	Message       *string         `json:"message,omitempty"`
+	Data          json.RawMessage `json:"data,omitempty"`
+	StepRetries   *int32          `json:"step_retries,omitempty"`
+	RetryCount    *int32          `json:"retry_count,omitempty"`
+}
+
+type StepRunProgressTaskMetadata struct {
+	TenantId string `json:"tenant_id" validate:"required,uuid"`
+}
+
+// StepRunProgressEvent is the payload of the progress events which are sent to the clients subscribed to a workflow
+// run.
+type StepRunProgressEvent struct {
+	Percent float64         `json:"percent"`
+	Message *string         `json:"message,omitempty"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// EventPayload returns the payload of the progress event which is sent to clients.
+func (p StepRunProgressTaskPayload) EventPayload() (string, error) {
+	data, err := json.Marshal(StepRunProgressEvent{
+		Percent: p.Percent,
+		Message: p.Message,
+		Data:    p.Data,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
 }
 
 type StepRunFailedTaskPayload struct {
@@ -125,6 +178,9 @@ type StepRunFailedTaskPayload struct {
 	Error         string `json:"error" validate:"required"`
 	StepRetries   *int32 `json:"step_retries,omitempty"`
 	RetryCount    *int32 `json:"retry_count,omitempty"`
+
+	// (optional) the worker which ran the step run, used to resolve speculative attempts
+	WorkerId string `json:"worker_id,omitempty"`
 }
 
 type StepRunFailedTaskMetadata struct {
@@ -230,6 +286,30 @@ func StepRunReplayToTask(stepRun *dbsqlc.GetStepRunForEngineRow, inputData []byt
 	}
 }
 
+func StepRunFinishedToTask(stepRun *dbsqlc.GetStepRunForEngineRow, output []byte, finishedAt *time.Time) *msgqueue.Message {
+	tenantId := sqlchelpers.UUIDToStr(stepRun.SRTenantId)
+
+	payload, _ := datautils.ToJSONMap(StepRunFinishedTaskPayload{
+		WorkflowRunId:  sqlchelpers.UUIDToStr(stepRun.WorkflowRunId),
+		StepRunId:      sqlchelpers.UUIDToStr(stepRun.SRID),
+		FinishedAt:     finishedAt.Format(time.RFC3339),
+		StepOutputData: string(output),
+		StepRetries:    &stepRun.StepRetries,
+		RetryCount:     &stepRun.SRRetryCount,
+	})
+
+	metadata, _ := datautils.ToJSONMap(StepRunFinishedTaskMetadata{
+		TenantId: tenantId,
+	})
+
+	return &msgqueue.Message{
+		ID:       "step-run-finished",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
+
 func StepRunFailedToTask(stepRun *dbsqlc.GetStepRunForEngineRow, errorReason string, failedAt *time.Time) *msgqueue.Message {
 	stepRunId := sqlchelpers.UUIDToStr(stepRun.SRID)
 	workflowRunId := sqlchelpers.UUIDToStr(stepRun.WorkflowRunId)
@@ -305,3 +385,32 @@ func StepRunQueuedToTask(stepRun *dbsqlc.GetStepRunForEngineRow) *msgqueue.Messa
 		Retries:  3,
 	}
 }
+
+func StepRunProgressToTask(progress *dbsqlc.StepRunProgress, meta *dbsqlc.GetStreamEventMetaRow) *msgqueue.Message {
+	payloadTyped := StepRunProgressTaskPayload{
+		WorkflowRunId: sqlchelpers.UUIDToStr(meta.WorkflowRunId),
+		StepRunId:     sqlchelpers.UUIDToStr(progress.StepRunId),
+		UpdatedAt:     progress.UpdatedAt.Time.Format(time.RFC3339Nano),
+		Percent:       progress.Percent,
+		Data:          progress.Data,
+		StepRetries:   &meta.Retries,
+		RetryCount:    &meta.RetryCount,
+	}
+
+	if progress.Message.Valid {
+		payloadTyped.Message = &progress.Message.String
+	}
+
+	payload, _ := datautils.ToJSONMap(payloadTyped)
+
+	metadata, _ := datautils.ToJSONMap(StepRunProgressTaskMetadata{
+		TenantId: sqlchelpers.UUIDToStr(progress.TenantId),
+	})
+
+	return &msgqueue.Message{
+		ID:       "step-run-progress",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
@@ -1,5 +1,16 @@
 package tasktypes
 
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
 type WorkflowRunFailedTask struct {
 	WorkflowRunId string `json:"workflow_run_id" validate:"required,uuid"`
 	FailedAt      string `json:"failed_at" validate:"required"`
@@ -9,3 +20,47 @@ type WorkflowRunFailedTask struct {
 type WorkflowRunFailedTaskMetadata struct {
 	TenantId string `json:"tenant_id" validate:"required,uuid"`
 }
+
+// workflowRunEventTaskIds are the ids of the tenant messages which are events of workflow runs, which are sent to
+// run status subscriptions and stored in the event log of workflow runs.
+var workflowRunEventTaskIds = map[string]bool{
+	"step-run-started":      true,
+	"step-run-finished":     true,
+	"step-run-failed":       true,
+	"step-run-cancelled":    true,
+	"step-run-timed-out":    true,
+	"step-run-stream-event": true,
+	"step-run-progress":     true,
+	"workflow-run-finished": true,
+}
+
+// AppendWorkflowRunEvent stores a tenant message in the event log of its workflow run, and returns the sequence of
+// the event. Every subscriber of the tenant receives the same message, so the message is identified by its hash. It
+// returns 0 if the message isn't an event of a workflow run.
+func AppendWorkflowRunEvent(ctx context.Context, repo repository.WorkflowRunEventLogRepository, tenantId string, task *msgqueue.Message) (int64, error) {
+	if !workflowRunEventTaskIds[task.ID] {
+		return 0, nil
+	}
+
+	workflowRunId, ok := task.Payload["workflow_run_id"].(string)
+
+	if !ok || workflowRunId == "" {
+		return 0, nil
+	}
+
+	message, err := json.Marshal(task)
+
+	if err != nil {
+		return 0, fmt.Errorf("could not marshal workflow run event %s: %w", task.ID, err)
+	}
+
+	hash := sha256.Sum256(message)
+
+	seq, err := repo.AppendWorkflowRunEvent(ctx, tenantId, workflowRunId, hex.EncodeToString(hash[:]), message)
+
+	if err != nil {
+		return 0, fmt.Errorf("could not append workflow run event %s of workflow run %s: %w", task.ID, workflowRunId, err)
+	}
+
+	return seq, nil
+}
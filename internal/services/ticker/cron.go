@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"time"
 
 	"github.com/go-co-op/gocron/v2"
@@ -41,9 +42,11 @@ func (t *TickerImpl) runPollCronSchedules(ctx context.Context) func() {
 
 			t.l.Debug().Msgf("ticker: handling cron %s for version %s", cron.Cron, workflowVersionId)
 
+			key := getCronKey(workflowVersionId, cron.Cron, cron.Timezone)
+
 			// if the cron is already scheduled, mark it as existing
-			if _, ok := existingCrons[getCronKey(workflowVersionId, cron.Cron)]; ok {
-				existingCrons[getCronKey(workflowVersionId, cron.Cron)] = true
+			if _, ok := existingCrons[key]; ok {
+				existingCrons[key] = true
 				continue
 			}
 
@@ -52,7 +55,7 @@ func (t *TickerImpl) runPollCronSchedules(ctx context.Context) func() {
 				t.l.Err(err).Msg("could not schedule cron")
 			}
 
-			existingCrons[getCronKey(workflowVersionId, cron.Cron)] = true
+			existingCrons[key] = true
 		}
 
 		// cancel any crons that are no longer assigned to this ticker
@@ -69,8 +72,15 @@ func (t *TickerImpl) runPollCronSchedules(ctx context.Context) func() {
 func (t *TickerImpl) handleScheduleCron(ctx context.Context, cron *dbsqlc.PollCronSchedulesRow) error {
 	t.l.Debug().Msg("ticker: scheduling cron")
 
+	// crons are evaluated in their timezone, which defaults to UTC
+	location, err := time.LoadLocation(cron.Timezone)
+
+	if err != nil {
+		return fmt.Errorf("could not load timezone %s: %w", cron.Timezone, err)
+	}
+
 	// create a new scheduler
-	s, err := gocron.NewScheduler(gocron.WithLocation(time.UTC))
+	s, err := gocron.NewScheduler(gocron.WithLocation(location))
 
 	if err != nil {
 		return fmt.Errorf("could not create scheduler: %w", err)
@@ -80,6 +90,12 @@ func (t *TickerImpl) handleScheduleCron(ctx context.Context, cron *dbsqlc.PollCr
 	workflowVersionId := sqlchelpers.UUIDToStr(cron.WorkflowVersionId)
 	cronParentId := sqlchelpers.UUIDToStr(cron.ParentId)
 
+	var exclusionCalendarId string
+
+	if cron.ExclusionCalendarId.Valid {
+		exclusionCalendarId = sqlchelpers.UUIDToStr(cron.ExclusionCalendarId)
+	}
+
 	var additionalMetadata map[string]interface{}
 
 	if cron.AdditionalMetadata != nil {
@@ -92,7 +108,7 @@ func (t *TickerImpl) handleScheduleCron(ctx context.Context, cron *dbsqlc.PollCr
 	_, err = s.NewJob(
 		gocron.CronJob(cron.Cron, false),
 		gocron.NewTask(
-			t.runCronWorkflow(tenantId, workflowVersionId, cron.Cron, cronParentId, &cron.Name.String, cron.Input, additionalMetadata),
+			t.runCronWorkflow(tenantId, workflowVersionId, cron.Cron, cronParentId, &cron.Name.String, cron.Input, additionalMetadata, time.Duration(cron.JitterSeconds)*time.Second, location, exclusionCalendarId),
 		),
 	)
 
@@ -101,15 +117,36 @@ func (t *TickerImpl) handleScheduleCron(ctx context.Context, cron *dbsqlc.PollCr
 	}
 
 	// store the schedule in the cron map
-	t.crons.Store(getCronKey(workflowVersionId, cron.Cron), s)
+	t.crons.Store(getCronKey(workflowVersionId, cron.Cron, cron.Timezone), s)
 
 	s.Start()
 
 	return nil
 }
 
-func (t *TickerImpl) runCronWorkflow(tenantId, workflowVersionId, cron, cronParentId string, cronName *string, input []byte, additionalMetadata map[string]interface{}) func() {
+func (t *TickerImpl) runCronWorkflow(tenantId, workflowVersionId, cron, cronParentId string, cronName *string, input []byte, additionalMetadata map[string]interface{}, jitter time.Duration, location *time.Location, exclusionCalendarId string) func() {
 	return func() {
+		// skip the run if the scheduled time is excluded by the calendar of the cron. If the calendar can't be
+		// evaluated, the run is triggered anyway.
+		if exclusionCalendarId != "" {
+			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			excluded, err := t.isExcludedByCalendar(ctx, exclusionCalendarId, time.Now().In(location))
+			cancel()
+
+			if err != nil {
+				t.l.Err(err).Msgf("could not evaluate exclusion calendar of cron %s for workflow version %s", cron, workflowVersionId)
+			} else if excluded {
+				t.l.Debug().Msgf("ticker: skipping cron %s for workflow version %s, which is excluded by its calendar", cron, workflowVersionId)
+				return
+			}
+		}
+
+		// delay the run by a random duration within the jitter window, so crons on the same schedule don't all
+		// trigger at once
+		if jitter > 0 {
+			time.Sleep(time.Duration(rand.Int63n(int64(jitter)))) // nolint: gosec
+		}
+
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
@@ -178,6 +215,6 @@ func (t *TickerImpl) handleCancelCron(ctx context.Context, key string) error {
 	return nil
 }
 
-func getCronKey(workflowVersionId, schedule string) string {
-	return fmt.Sprintf("%s-%s", workflowVersionId, schedule)
+func getCronKey(workflowVersionId, schedule, timezone string) string {
+	return fmt.Sprintf("%s-%s-%s", workflowVersionId, schedule, timezone)
 }
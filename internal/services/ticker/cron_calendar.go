@@ -0,0 +1,117 @@
+package ticker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/hatchet-dev/hatchet/internal/ical"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// icalFeedTTL is how long the events of an iCal feed are used before the feed is fetched again
+const icalFeedTTL = time.Hour
+
+type icalFeed struct {
+	events    []ical.Event
+	fetchedAt time.Time
+}
+
+var icalHTTPClient = &http.Client{
+	Timeout: 10 * time.Second,
+}
+
+// isExcludedByCalendar returns true if the time is on a date of the exclusion calendar, or within an event of the
+// iCal feed of the calendar. Dates are matched in the location of the time, which is the timezone of the cron.
+func (t *TickerImpl) isExcludedByCalendar(ctx context.Context, calendarId string, now time.Time) (bool, error) {
+	calendar, err := t.repo.CronCalendar().GetCalendarById(ctx, calendarId)
+
+	if err != nil {
+		// the calendar was deleted after the cron was scheduled
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("could not get cron exclusion calendar: %w", err)
+	}
+
+	for _, date := range calendar.Dates {
+		if date.Valid && date.Time.Year() == now.Year() && date.Time.Month() == now.Month() && date.Time.Day() == now.Day() {
+			return true, nil
+		}
+	}
+
+	if !calendar.IcalUrl.Valid {
+		return false, nil
+	}
+
+	events, err := t.getICalEvents(ctx, calendar)
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, event := range events {
+		if event.Covers(now) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// getICalEvents returns the events of the iCal feed of a calendar, which are cached for icalFeedTTL. If the feed
+// can't be fetched, the previously fetched events are used.
+func (t *TickerImpl) getICalEvents(ctx context.Context, calendar *dbsqlc.CronExclusionCalendar) ([]ical.Event, error) {
+	url := calendar.IcalUrl.String
+
+	cached, hasCached := t.icalFeeds.Load(url)
+
+	if hasCached && time.Since(cached.(*icalFeed).fetchedAt) < icalFeedTTL {
+		return cached.(*icalFeed).events, nil
+	}
+
+	events, err := fetchICalEvents(ctx, url)
+
+	if err != nil {
+		if hasCached {
+			t.l.Warn().Err(err).Msgf("could not refresh ical feed of calendar %s, using previously fetched events", calendar.Name)
+			return cached.(*icalFeed).events, nil
+		}
+
+		return nil, err
+	}
+
+	t.icalFeeds.Store(url, &icalFeed{
+		events:    events,
+		fetchedAt: time.Now(),
+	})
+
+	return events, nil
+}
+
+func fetchICalEvents(ctx context.Context, url string) ([]ical.Event, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create ical feed request: %w", err)
+	}
+
+	resp, err := icalHTTPClient.Do(req)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch ical feed: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not fetch ical feed: status code %d", resp.StatusCode)
+	}
+
+	return ical.Parse(resp.Body)
+}
@@ -0,0 +1,114 @@
+package ticker
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *TickerImpl) runPollDelayedEvents(ctx context.Context) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+
+		t.l.Debug().Msgf("ticker: polling delayed events")
+
+		delayedEvents, err := t.repo.Ticker().PollDelayedEvents(ctx, t.tickerId)
+
+		if err != nil {
+			t.l.Err(err).Msg("could not poll delayed events")
+			return
+		}
+
+		if len(delayedEvents) == 0 {
+			return
+		}
+
+		// the events are read through the event repository, which decrypts their data
+		eventIdsByTenant := make(map[string][]string)
+
+		for _, delayedEvent := range delayedEvents {
+			tenantId := sqlchelpers.UUIDToStr(delayedEvent.TenantId)
+			eventIdsByTenant[tenantId] = append(eventIdsByTenant[tenantId], sqlchelpers.UUIDToStr(delayedEvent.EventId))
+		}
+
+		events := make(map[string]*dbsqlc.Event, len(delayedEvents))
+		failedTenants := make(map[string]bool)
+
+		for tenantId, eventIds := range eventIdsByTenant {
+			tenantEvents, err := t.repo.Event().ListEventsByIds(ctx, tenantId, eventIds)
+
+			if err != nil {
+				// the delayed events stay assigned to this ticker, so they will be picked up again if the ticker goes down
+				t.l.Err(err).Msgf("could not list delayed events for tenant %s", tenantId)
+				failedTenants[tenantId] = true
+				continue
+			}
+
+			for _, event := range tenantEvents {
+				events[sqlchelpers.UUIDToStr(event.ID)] = event
+			}
+		}
+
+		deliveredEventIds := make([]string, 0, len(delayedEvents))
+
+		for _, delayedEvent := range delayedEvents {
+			eventId := sqlchelpers.UUIDToStr(delayedEvent.EventId)
+
+			if failedTenants[sqlchelpers.UUIDToStr(delayedEvent.TenantId)] {
+				continue
+			}
+
+			event, ok := events[eventId]
+
+			// the event was deleted before it was delivered, so it is dropped
+			if !ok {
+				deliveredEventIds = append(deliveredEventIds, eventId)
+				continue
+			}
+
+			err := t.mq.AddMessage(
+				ctx,
+				msgqueue.EVENT_PROCESSING_QUEUE,
+				taskDelayedEvent(delayedEvent, event),
+			)
+
+			if err != nil {
+				t.l.Err(err).Msgf("could not add delayed event %s to task queue", eventId)
+				continue
+			}
+
+			deliveredEventIds = append(deliveredEventIds, eventId)
+		}
+
+		if len(deliveredEventIds) == 0 {
+			return
+		}
+
+		err = t.repo.Ticker().DeleteDelayedEvents(ctx, deliveredEventIds)
+
+		if err != nil {
+			t.l.Err(err).Msg("could not delete delayed events")
+		}
+	}
+}
+
+func taskDelayedEvent(delayedEvent *dbsqlc.DelayedEvent, event *dbsqlc.Event) *msgqueue.Message {
+	var idempotencyKey *string
+
+	if delayedEvent.IdempotencyKey.Valid {
+		idempotencyKey = &delayedEvent.IdempotencyKey.String
+	}
+
+	routedWorkflowIds := make([]string, len(delayedEvent.RoutedWorkflowIds))
+
+	for i, workflowId := range delayedEvent.RoutedWorkflowIds {
+		routedWorkflowIds[i] = sqlchelpers.UUIDToStr(workflowId)
+	}
+
+	return tasktypes.EventToTask(event, idempotencyKey, routedWorkflowIds)
+}
@@ -0,0 +1,140 @@
+package ticker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+type delayedEventsTickerRepository struct {
+	repository.TickerEngineRepository
+
+	due     []*dbsqlc.DelayedEvent
+	deleted []string
+}
+
+func (r *delayedEventsTickerRepository) PollDelayedEvents(ctx context.Context, tickerId string) ([]*dbsqlc.DelayedEvent, error) {
+	return r.due, nil
+}
+
+func (r *delayedEventsTickerRepository) DeleteDelayedEvents(ctx context.Context, eventIds []string) error {
+	r.deleted = append(r.deleted, eventIds...)
+	return nil
+}
+
+type delayedEventsEventRepository struct {
+	repository.EventEngineRepository
+
+	// the events of each tenant, a tenant without events fails to list them
+	events map[string][]*dbsqlc.Event
+}
+
+func (r *delayedEventsEventRepository) ListEventsByIds(ctx context.Context, tenantId string, ids []string) ([]*dbsqlc.Event, error) {
+	events, ok := r.events[tenantId]
+
+	if !ok {
+		return nil, errors.New("could not list events")
+	}
+
+	return events, nil
+}
+
+type delayedEventsEngineRepository struct {
+	repository.EngineRepository
+
+	ticker *delayedEventsTickerRepository
+	events *delayedEventsEventRepository
+}
+
+func (r *delayedEventsEngineRepository) Ticker() repository.TickerEngineRepository {
+	return r.ticker
+}
+
+func (r *delayedEventsEngineRepository) Event() repository.EventEngineRepository {
+	return r.events
+}
+
+type delayedEventsMessageQueue struct {
+	msgqueue.MessageQueue
+
+	payloads []map[string]interface{}
+}
+
+func (q *delayedEventsMessageQueue) AddMessage(ctx context.Context, queue msgqueue.Queue, task *msgqueue.Message) error {
+	q.payloads = append(q.payloads, task.Payload)
+	return nil
+}
+
+func TestRunPollDelayedEvents(t *testing.T) {
+	tenantId := uuid.New().String()
+	failingTenantId := uuid.New().String()
+
+	event := &dbsqlc.Event{
+		ID:       sqlchelpers.UUIDFromStr(uuid.New().String()),
+		TenantId: sqlchelpers.UUIDFromStr(tenantId),
+		Key:      "user:created",
+		Data:     []byte(`{}`),
+	}
+
+	routedWorkflowId := uuid.New().String()
+	deletedEventId := uuid.New().String()
+	failingEventId := uuid.New().String()
+
+	tickerRepo := &delayedEventsTickerRepository{
+		due: []*dbsqlc.DelayedEvent{
+			{
+				EventId:           event.ID,
+				TenantId:          event.TenantId,
+				IdempotencyKey:    sqlchelpers.TextFromStr("key"),
+				RoutedWorkflowIds: []pgtype.UUID{sqlchelpers.UUIDFromStr(routedWorkflowId)},
+			},
+			// the event was deleted before it was delivered
+			{
+				EventId:  sqlchelpers.UUIDFromStr(deletedEventId),
+				TenantId: event.TenantId,
+			},
+			// the events of the tenant can't be listed, so the event is delivered later
+			{
+				EventId:  sqlchelpers.UUIDFromStr(failingEventId),
+				TenantId: sqlchelpers.UUIDFromStr(failingTenantId),
+			},
+		},
+	}
+
+	mq := &delayedEventsMessageQueue{}
+	l := zerolog.Nop()
+
+	ticker := &TickerImpl{
+		mq: mq,
+		l:  &l,
+		repo: &delayedEventsEngineRepository{
+			ticker: tickerRepo,
+			events: &delayedEventsEventRepository{
+				events: map[string][]*dbsqlc.Event{
+					tenantId: {event},
+				},
+			},
+		},
+		tickerId: uuid.New().String(),
+	}
+
+	ticker.runPollDelayedEvents(context.Background())()
+
+	if assert.Len(t, mq.payloads, 1) {
+		assert.Equal(t, sqlchelpers.UUIDToStr(event.ID), mq.payloads[0]["event_id"])
+		assert.Equal(t, "key", mq.payloads[0]["event_idempotency_key"])
+		assert.Equal(t, []interface{}{routedWorkflowId}, mq.payloads[0]["event_routed_workflow_ids"])
+	}
+
+	assert.ElementsMatch(t, []string{sqlchelpers.UUIDToStr(event.ID), deletedEventId}, tickerRepo.deleted)
+}
@@ -42,9 +42,12 @@ func (t *TickerImpl) runPollSchedules(ctx context.Context) func() {
 
 			t.l.Debug().Msgf("ticker: handling scheduled workflow %s for version %s", scheduledWorkflowId, workflowVersionId)
 
+			// the key includes the trigger time, so a rescheduled workflow is canceled and scheduled again
+			key := getScheduledWorkflowKey(workflowVersionId, scheduledWorkflowId, scheduledWorkflow.TriggerAt.Time)
+
 			// if the cron is already scheduled, mark it as existing
-			if _, ok := existingSchedules[getScheduledWorkflowKey(workflowVersionId, scheduledWorkflowId)]; ok {
-				existingSchedules[getScheduledWorkflowKey(workflowVersionId, scheduledWorkflowId)] = true
+			if _, ok := existingSchedules[key]; ok {
+				existingSchedules[key] = true
 				continue
 			}
 
@@ -53,7 +56,7 @@ func (t *TickerImpl) runPollSchedules(ctx context.Context) func() {
 				t.l.Err(err).Msg("could not schedule cron")
 			}
 
-			existingSchedules[getScheduledWorkflowKey(workflowVersionId, scheduledWorkflowId)] = true
+			existingSchedules[key] = true
 		}
 
 		// cancel any crons that are no longer assigned to this ticker
@@ -106,7 +109,7 @@ func (t *TickerImpl) handleScheduleWorkflow(ctx context.Context, scheduledWorkfl
 	}
 
 	// store the schedule in the cron map
-	t.scheduledWorkflows.Store(getScheduledWorkflowKey(workflowVersionId, scheduledWorkflowId), s)
+	t.scheduledWorkflows.Store(getScheduledWorkflowKey(workflowVersionId, scheduledWorkflowId, triggerAt), s)
 
 	s.Start()
 
@@ -208,14 +211,16 @@ func (t *TickerImpl) runScheduledWorkflow(tenantId, workflowVersionId, scheduled
 		}
 
 		// get the scheduler
-		schedulerVal, ok := t.scheduledWorkflows.Load(getScheduledWorkflowKey(workflowVersionId, scheduledWorkflowId))
+		key := getScheduledWorkflowKey(workflowVersionId, scheduledWorkflowId, scheduled.TriggerAt.Time)
+
+		schedulerVal, ok := t.scheduledWorkflows.Load(key)
 
 		if !ok {
 			t.l.Error().Msgf("could not find scheduled workflow %s", workflowVersionId)
 			return
 		}
 
-		defer t.scheduledWorkflows.Delete(workflowVersionId)
+		defer t.scheduledWorkflows.Delete(key)
 
 		scheduler := schedulerVal.(gocron.Scheduler)
 
@@ -251,6 +256,6 @@ func (t *TickerImpl) handleCancelWorkflow(ctx context.Context, key string) error
 	return scheduler.Shutdown()
 }
 
-func getScheduledWorkflowKey(workflowVersionId, scheduledWorkflowId string) string {
-	return fmt.Sprintf("%s-%s", workflowVersionId, scheduledWorkflowId)
+func getScheduledWorkflowKey(workflowVersionId, scheduledWorkflowId string, triggerAt time.Time) string {
+	return fmt.Sprintf("%s-%s-%d", workflowVersionId, scheduledWorkflowId, triggerAt.UnixMilli())
 }
@@ -0,0 +1,170 @@
+package ticker
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/msgqueue"
+	"github.com/hatchet-dev/hatchet/internal/services/shared/tasktypes"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (t *TickerImpl) runPollStepRunTimers(ctx context.Context) func() {
+	return func() {
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+
+		t.l.Debug().Msgf("ticker: polling step run timers")
+
+		timers, err := t.repo.Ticker().PollStepRunTimers(ctx, t.tickerId)
+
+		if err != nil {
+			t.l.Err(err).Msg("could not poll step run timers")
+			return
+		}
+
+		wokenStepRunIds := make([]string, 0, len(timers))
+
+		for _, timer := range timers {
+			stepRunId := sqlchelpers.UUIDToStr(timer.StepRunId)
+
+			// the timer of a wait-for-event step run is its timeout, so the step run times out unless an event
+			// has already claimed it
+			if timer.IsEventWait {
+				t.timeOutEventWait(ctx, timer)
+				wokenStepRunIds = append(wokenStepRunIds, stepRunId)
+				continue
+			}
+
+			// likewise, the timer of an approval step run is its timeout
+			if timer.ActionId == repository.ApprovalStepAction {
+				t.timeOutApproval(ctx, timer)
+				wokenStepRunIds = append(wokenStepRunIds, stepRunId)
+				continue
+			}
+
+			err := t.mq.AddMessage(
+				ctx,
+				msgqueue.JOB_PROCESSING_QUEUE,
+				taskStepRunWoken(timer),
+			)
+
+			if err != nil {
+				// the timer stays assigned to this ticker, so it will be picked up again if the ticker goes down
+				t.l.Err(err).Msgf("could not add step run finished task for sleeping step run %s", stepRunId)
+				continue
+			}
+
+			wokenStepRunIds = append(wokenStepRunIds, stepRunId)
+		}
+
+		if len(wokenStepRunIds) == 0 {
+			return
+		}
+
+		err = t.repo.Ticker().DeleteStepRunTimers(ctx, wokenStepRunIds)
+
+		if err != nil {
+			t.l.Err(err).Msg("could not delete step run timers")
+		}
+	}
+}
+
+func (t *TickerImpl) timeOutEventWait(ctx context.Context, timer *dbsqlc.PollStepRunTimersRow) {
+	tenantId := sqlchelpers.UUIDToStr(timer.TenantId)
+	stepRunId := sqlchelpers.UUIDToStr(timer.StepRunId)
+
+	claimed, err := t.repo.Signal().ClaimSignalWait(ctx, tenantId, stepRunId)
+
+	if err != nil {
+		t.l.Err(err).Msgf("could not claim signal wait for step run %s", stepRunId)
+		return
+	}
+
+	if !claimed {
+		return
+	}
+
+	err = t.mq.AddMessage(
+		ctx,
+		msgqueue.JOB_PROCESSING_QUEUE,
+		taskStepRunTimedOut(timer),
+	)
+
+	if err != nil {
+		t.l.Err(err).Msgf("could not add step run timed out task for step run %s", stepRunId)
+	}
+}
+
+func (t *TickerImpl) timeOutApproval(ctx context.Context, timer *dbsqlc.PollStepRunTimersRow) {
+	tenantId := sqlchelpers.UUIDToStr(timer.TenantId)
+	stepRunId := sqlchelpers.UUIDToStr(timer.StepRunId)
+
+	timedOut, err := t.repo.Approval().TimeOutApproval(ctx, tenantId, stepRunId)
+
+	if err != nil {
+		t.l.Err(err).Msgf("could not time out approval for step run %s", stepRunId)
+		return
+	}
+
+	// the approval was decided first
+	if !timedOut {
+		return
+	}
+
+	err = t.mq.AddMessage(
+		ctx,
+		msgqueue.JOB_PROCESSING_QUEUE,
+		taskStepRunTimedOut(timer),
+	)
+
+	if err != nil {
+		t.l.Err(err).Msgf("could not add step run timed out task for step run %s", stepRunId)
+	}
+}
+
+func taskStepRunTimedOut(timer *dbsqlc.PollStepRunTimersRow) *msgqueue.Message {
+	payload, _ := datautils.ToJSONMap(tasktypes.StepRunTimedOutTaskPayload{
+		WorkflowRunId: sqlchelpers.UUIDToStr(timer.WorkflowRunId),
+		StepRunId:     sqlchelpers.UUIDToStr(timer.StepRunId),
+	})
+
+	metadata, _ := datautils.ToJSONMap(tasktypes.StepRunTimedOutTaskMetadata{
+		TenantId: sqlchelpers.UUIDToStr(timer.TenantId),
+	})
+
+	return &msgqueue.Message{
+		ID:       "step-run-timed-out",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
+
+func taskStepRunWoken(timer *dbsqlc.PollStepRunTimersRow) *msgqueue.Message {
+	output, _ := json.Marshal(map[string]interface{}{
+		"sleptUntil": timer.WakeAt.Time.UTC().Format(time.RFC3339),
+	})
+
+	payload, _ := datautils.ToJSONMap(tasktypes.StepRunFinishedTaskPayload{
+		WorkflowRunId:  sqlchelpers.UUIDToStr(timer.WorkflowRunId),
+		StepRunId:      sqlchelpers.UUIDToStr(timer.StepRunId),
+		FinishedAt:     time.Now().UTC().Format(time.RFC3339),
+		StepOutputData: string(output),
+	})
+
+	metadata, _ := datautils.ToJSONMap(tasktypes.StepRunFinishedTaskMetadata{
+		TenantId: sqlchelpers.UUIDToStr(timer.TenantId),
+	})
+
+	return &msgqueue.Message{
+		ID:       "step-run-finished",
+		Payload:  payload,
+		Metadata: metadata,
+		Retries:  3,
+	}
+}
@@ -35,6 +35,9 @@ type TickerImpl struct {
 	crons              sync.Map
 	scheduledWorkflows sync.Map
 
+	// icalFeeds caches the fetched iCal feeds of cron exclusion calendars by their url
+	icalFeeds sync.Map
+
 	dv datautils.DataDecoderValidator
 
 	tickerId string
@@ -191,6 +194,30 @@ func (t *TickerImpl) Start() (func() error, error) {
 		return nil, fmt.Errorf("could not create update heartbeat job: %w", err)
 	}
 
+	_, err = t.s.NewJob(
+		gocron.DurationJob(time.Second*1),
+		gocron.NewTask(
+			t.runPollStepRunTimers(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not create poll step run timers job: %w", err)
+	}
+
+	_, err = t.s.NewJob(
+		gocron.DurationJob(time.Second*1),
+		gocron.NewTask(
+			t.runPollDelayedEvents(ctx),
+		),
+	)
+
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not create poll delayed events job: %w", err)
+	}
+
 	_, err = t.s.NewJob(
 		// crons only have a resolution of 1 minute, so only poll every 15 seconds
 		gocron.DurationJob(time.Second*15),
@@ -0,0 +1,218 @@
+// Package statemachine defines the status transitions which step runs, job runs and workflow runs are allowed to
+// make. The repository only writes a status to runs which are in one of the source statuses of the new status, in the
+// same statement as the update, so a late or duplicated event can't move a run out of a status it already left.
+// Statuses which are resolved from the statuses of the child runs are only written if the transition from the current
+// status is in the transition pairs.
+//
+// Replays are the exception: they reset the runs which are replayed to their initial status regardless of their
+// current status, since a user explicitly asked to run them again.
+package statemachine
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// stepRunTransitions maps every step run status to the statuses it's allowed to transition to.
+var stepRunTransitions = map[dbsqlc.StepRunStatus][]dbsqlc.StepRunStatus{
+	dbsqlc.StepRunStatusPENDING: {
+		// step runs which don't run on a worker, like sleep and approval step runs, are also moved to pending
+		// assignment before they start, so they are never started from pending
+		dbsqlc.StepRunStatusPENDINGASSIGNMENT,
+		dbsqlc.StepRunStatusFAILED,
+		dbsqlc.StepRunStatusCANCELLED,
+		dbsqlc.StepRunStatusSKIPPED,
+	},
+	dbsqlc.StepRunStatusPENDINGASSIGNMENT: {
+		// requeued step runs stay pending assignment
+		dbsqlc.StepRunStatusPENDINGASSIGNMENT,
+		dbsqlc.StepRunStatusASSIGNED,
+		// step runs which weren't assigned before their schedule timeout are cancelled
+		dbsqlc.StepRunStatusCANCELLING,
+		// the start and finish events of a step run which was reassigned can be written before its assignment
+		dbsqlc.StepRunStatusRUNNING,
+		dbsqlc.StepRunStatusSUCCEEDED,
+		dbsqlc.StepRunStatusFAILED,
+		dbsqlc.StepRunStatusCANCELLED,
+	},
+	dbsqlc.StepRunStatusASSIGNED: {
+		dbsqlc.StepRunStatusPENDINGASSIGNMENT,
+		dbsqlc.StepRunStatusRUNNING,
+		dbsqlc.StepRunStatusSUCCEEDED,
+		dbsqlc.StepRunStatusFAILED,
+		dbsqlc.StepRunStatusCANCELLING,
+		dbsqlc.StepRunStatusCANCELLED,
+	},
+	dbsqlc.StepRunStatusRUNNING: {
+		// a redelivered start event updates the start time
+		dbsqlc.StepRunStatusRUNNING,
+		dbsqlc.StepRunStatusPENDINGASSIGNMENT,
+		dbsqlc.StepRunStatusSUCCEEDED,
+		dbsqlc.StepRunStatusFAILED,
+		dbsqlc.StepRunStatusCANCELLING,
+		dbsqlc.StepRunStatusCANCELLED,
+	},
+	dbsqlc.StepRunStatusCANCELLING: {
+		// the worker can finish the step run before it receives the cancellation
+		dbsqlc.StepRunStatusSUCCEEDED,
+		dbsqlc.StepRunStatusFAILED,
+		dbsqlc.StepRunStatusCANCELLED,
+	},
+	// final step runs are only retried and replayed
+	dbsqlc.StepRunStatusSUCCEEDED: {
+		dbsqlc.StepRunStatusPENDING,
+		dbsqlc.StepRunStatusPENDINGASSIGNMENT,
+	},
+	dbsqlc.StepRunStatusFAILED: {
+		dbsqlc.StepRunStatusPENDING,
+		dbsqlc.StepRunStatusPENDINGASSIGNMENT,
+	},
+	dbsqlc.StepRunStatusCANCELLED: {
+		dbsqlc.StepRunStatusPENDING,
+		dbsqlc.StepRunStatusPENDINGASSIGNMENT,
+	},
+	dbsqlc.StepRunStatusSKIPPED: {
+		dbsqlc.StepRunStatusPENDING,
+		dbsqlc.StepRunStatusPENDINGASSIGNMENT,
+	},
+}
+
+// jobRunTransitions maps every job run status to the statuses it's allowed to transition to.
+var jobRunTransitions = map[dbsqlc.JobRunStatus][]dbsqlc.JobRunStatus{
+	dbsqlc.JobRunStatusPENDING: {
+		dbsqlc.JobRunStatusRUNNING,
+		// job runs whose step runs are all skipped, failed or cancelled before they start finish without running
+		dbsqlc.JobRunStatusSUCCEEDED,
+		dbsqlc.JobRunStatusFAILED,
+		dbsqlc.JobRunStatusCANCELLED,
+	},
+	dbsqlc.JobRunStatusRUNNING: {
+		dbsqlc.JobRunStatusRUNNING,
+		dbsqlc.JobRunStatusSUCCEEDED,
+		dbsqlc.JobRunStatusFAILED,
+		dbsqlc.JobRunStatusCANCELLED,
+	},
+	// final job runs are only replayed
+	dbsqlc.JobRunStatusSUCCEEDED: {
+		dbsqlc.JobRunStatusPENDING,
+	},
+	dbsqlc.JobRunStatusFAILED: {
+		dbsqlc.JobRunStatusPENDING,
+	},
+	dbsqlc.JobRunStatusCANCELLED: {
+		dbsqlc.JobRunStatusPENDING,
+	},
+}
+
+// workflowRunTransitions maps every workflow run status to the statuses it's allowed to transition to.
+var workflowRunTransitions = map[dbsqlc.WorkflowRunStatus][]dbsqlc.WorkflowRunStatus{
+	dbsqlc.WorkflowRunStatusPENDING: {
+		dbsqlc.WorkflowRunStatusQUEUED,
+		dbsqlc.WorkflowRunStatusRUNNING,
+		dbsqlc.WorkflowRunStatusSUCCEEDED,
+		dbsqlc.WorkflowRunStatusFAILED,
+	},
+	dbsqlc.WorkflowRunStatusQUEUED: {
+		dbsqlc.WorkflowRunStatusRUNNING,
+		dbsqlc.WorkflowRunStatusFAILED,
+	},
+	dbsqlc.WorkflowRunStatusRUNNING: {
+		// job runs set their workflow run to running when they start
+		dbsqlc.WorkflowRunStatusRUNNING,
+		dbsqlc.WorkflowRunStatusPAUSED,
+		dbsqlc.WorkflowRunStatusSUCCEEDED,
+		dbsqlc.WorkflowRunStatusFAILED,
+	},
+	dbsqlc.WorkflowRunStatusPAUSED: {
+		dbsqlc.WorkflowRunStatusRUNNING,
+		dbsqlc.WorkflowRunStatusSUCCEEDED,
+		dbsqlc.WorkflowRunStatusFAILED,
+	},
+	// final workflow runs are only replayed
+	dbsqlc.WorkflowRunStatusSUCCEEDED: {
+		dbsqlc.WorkflowRunStatusPENDING,
+	},
+	dbsqlc.WorkflowRunStatusFAILED: {
+		dbsqlc.WorkflowRunStatusPENDING,
+	},
+}
+
+var (
+	stepRunSources     = sources(stepRunTransitions)
+	jobRunSources      = sources(jobRunTransitions)
+	workflowRunSources = sources(workflowRunTransitions)
+)
+
+func sources[S comparable](transitions map[S][]S) map[S][]S {
+	res := make(map[S][]S)
+
+	for from, tos := range transitions {
+		for _, to := range tos {
+			res[to] = append(res[to], from)
+		}
+	}
+
+	return res
+}
+
+func pairs[S comparable](transitions map[S][]S) (from, to []S) {
+	for f, tos := range transitions {
+		for _, t := range tos {
+			from = append(from, f)
+			to = append(to, t)
+		}
+	}
+
+	return from, to
+}
+
+func allowed[S comparable](transitions map[S][]S, from, to S) bool {
+	for _, t := range transitions[from] {
+		if t == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CanTransitionStepRun returns true if a step run is allowed to transition from one status to the other.
+func CanTransitionStepRun(from, to dbsqlc.StepRunStatus) bool {
+	return allowed(stepRunTransitions, from, to)
+}
+
+// StepRunSources returns the statuses from which a step run is allowed to transition to the status.
+func StepRunSources(to dbsqlc.StepRunStatus) []dbsqlc.StepRunStatus {
+	return stepRunSources[to]
+}
+
+// CanTransitionJobRun returns true if a job run is allowed to transition from one status to the other.
+func CanTransitionJobRun(from, to dbsqlc.JobRunStatus) bool {
+	return allowed(jobRunTransitions, from, to)
+}
+
+// JobRunSources returns the statuses from which a job run is allowed to transition to the status.
+func JobRunSources(to dbsqlc.JobRunStatus) []dbsqlc.JobRunStatus {
+	return jobRunSources[to]
+}
+
+// JobRunTransitionPairs returns all allowed job run transitions as pairs of the from and to statuses at the same
+// index.
+func JobRunTransitionPairs() (from, to []dbsqlc.JobRunStatus) {
+	return pairs(jobRunTransitions)
+}
+
+// CanTransitionWorkflowRun returns true if a workflow run is allowed to transition from one status to the other.
+func CanTransitionWorkflowRun(from, to dbsqlc.WorkflowRunStatus) bool {
+	return allowed(workflowRunTransitions, from, to)
+}
+
+// WorkflowRunSources returns the statuses from which a workflow run is allowed to transition to the status.
+func WorkflowRunSources(to dbsqlc.WorkflowRunStatus) []dbsqlc.WorkflowRunStatus {
+	return workflowRunSources[to]
+}
+
+// WorkflowRunTransitionPairs returns all allowed workflow run transitions as pairs of the from and to statuses at the
+// same index.
+func WorkflowRunTransitionPairs() (from, to []dbsqlc.WorkflowRunStatus) {
+	return pairs(workflowRunTransitions)
+}
@@ -0,0 +1,137 @@
+package statemachine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func TestCanTransitionStepRun(t *testing.T) {
+	assert.True(t, CanTransitionStepRun(dbsqlc.StepRunStatusASSIGNED, dbsqlc.StepRunStatusRUNNING))
+	assert.True(t, CanTransitionStepRun(dbsqlc.StepRunStatusRUNNING, dbsqlc.StepRunStatusSUCCEEDED))
+	assert.True(t, CanTransitionStepRun(dbsqlc.StepRunStatusFAILED, dbsqlc.StepRunStatusPENDINGASSIGNMENT))
+
+	assert.False(t, CanTransitionStepRun(dbsqlc.StepRunStatusRUNNING, dbsqlc.StepRunStatusPENDING))
+	assert.False(t, CanTransitionStepRun(dbsqlc.StepRunStatusCANCELLING, dbsqlc.StepRunStatusRUNNING))
+	assert.False(t, CanTransitionStepRun(dbsqlc.StepRunStatusSUCCEEDED, dbsqlc.StepRunStatusFAILED))
+	assert.False(t, CanTransitionStepRun(dbsqlc.StepRunStatusCANCELLED, dbsqlc.StepRunStatusRUNNING))
+}
+
+func TestStepRunSources(t *testing.T) {
+	assert.ElementsMatch(t, []dbsqlc.StepRunStatus{
+		dbsqlc.StepRunStatusPENDINGASSIGNMENT,
+		dbsqlc.StepRunStatusASSIGNED,
+		dbsqlc.StepRunStatusRUNNING,
+	}, StepRunSources(dbsqlc.StepRunStatusRUNNING))
+
+	assert.ElementsMatch(t, []dbsqlc.StepRunStatus{
+		dbsqlc.StepRunStatusPENDINGASSIGNMENT,
+		dbsqlc.StepRunStatusASSIGNED,
+		dbsqlc.StepRunStatusRUNNING,
+		dbsqlc.StepRunStatusCANCELLING,
+	}, StepRunSources(dbsqlc.StepRunStatusSUCCEEDED))
+
+	assert.ElementsMatch(t, []dbsqlc.StepRunStatus{
+		dbsqlc.StepRunStatusPENDINGASSIGNMENT,
+		dbsqlc.StepRunStatusASSIGNED,
+		dbsqlc.StepRunStatusRUNNING,
+	}, StepRunSources(dbsqlc.StepRunStatusCANCELLING))
+}
+
+func TestJobRunTransitions(t *testing.T) {
+	assert.True(t, CanTransitionJobRun(dbsqlc.JobRunStatusPENDING, dbsqlc.JobRunStatusRUNNING))
+	assert.True(t, CanTransitionJobRun(dbsqlc.JobRunStatusRUNNING, dbsqlc.JobRunStatusFAILED))
+	assert.True(t, CanTransitionJobRun(dbsqlc.JobRunStatusCANCELLED, dbsqlc.JobRunStatusPENDING))
+
+	assert.False(t, CanTransitionJobRun(dbsqlc.JobRunStatusSUCCEEDED, dbsqlc.JobRunStatusRUNNING))
+	assert.False(t, CanTransitionJobRun(dbsqlc.JobRunStatusCANCELLED, dbsqlc.JobRunStatusFAILED))
+	assert.False(t, CanTransitionJobRun(dbsqlc.JobRunStatusRUNNING, dbsqlc.JobRunStatusPENDING))
+
+	assert.ElementsMatch(t, []dbsqlc.JobRunStatus{
+		dbsqlc.JobRunStatusPENDING,
+		dbsqlc.JobRunStatusRUNNING,
+	}, JobRunSources(dbsqlc.JobRunStatusRUNNING))
+}
+
+func TestWorkflowRunTransitions(t *testing.T) {
+	assert.True(t, CanTransitionWorkflowRun(dbsqlc.WorkflowRunStatusQUEUED, dbsqlc.WorkflowRunStatusRUNNING))
+	assert.True(t, CanTransitionWorkflowRun(dbsqlc.WorkflowRunStatusFAILED, dbsqlc.WorkflowRunStatusPENDING))
+
+	assert.False(t, CanTransitionWorkflowRun(dbsqlc.WorkflowRunStatusSUCCEEDED, dbsqlc.WorkflowRunStatusRUNNING))
+	assert.False(t, CanTransitionWorkflowRun(dbsqlc.WorkflowRunStatusRUNNING, dbsqlc.WorkflowRunStatusQUEUED))
+	assert.False(t, CanTransitionWorkflowRun(dbsqlc.WorkflowRunStatusQUEUED, dbsqlc.WorkflowRunStatusSUCCEEDED))
+
+	assert.ElementsMatch(t, []dbsqlc.WorkflowRunStatus{
+		dbsqlc.WorkflowRunStatusPENDING,
+		dbsqlc.WorkflowRunStatusQUEUED,
+		dbsqlc.WorkflowRunStatusRUNNING,
+		dbsqlc.WorkflowRunStatusPAUSED,
+	}, WorkflowRunSources(dbsqlc.WorkflowRunStatusRUNNING))
+}
+
+func TestTransitionPairs(t *testing.T) {
+	jobFroms, jobTos := JobRunTransitionPairs()
+
+	assert.Len(t, jobTos, len(jobFroms))
+
+	for i := range jobFroms {
+		assert.True(t, CanTransitionJobRun(jobFroms[i], jobTos[i]), "%s -> %s", jobFroms[i], jobTos[i])
+	}
+
+	assert.Len(t, jobFroms, 11)
+
+	workflowFroms, workflowTos := WorkflowRunTransitionPairs()
+
+	assert.Len(t, workflowTos, len(workflowFroms))
+
+	for i := range workflowFroms {
+		assert.True(t, CanTransitionWorkflowRun(workflowFroms[i], workflowTos[i]), "%s -> %s", workflowFroms[i], workflowTos[i])
+		assert.False(
+			t,
+			workflowFroms[i] == dbsqlc.WorkflowRunStatusQUEUED && workflowTos[i] == dbsqlc.WorkflowRunStatusSUCCEEDED,
+			"queued workflow runs can't succeed without running",
+		)
+	}
+
+	assert.Len(t, workflowFroms, 15)
+}
+
+// every status has transitions, so a status which is added to the enums must be added to the state machine
+func TestAllStatuses(t *testing.T) {
+	for _, status := range []dbsqlc.StepRunStatus{
+		dbsqlc.StepRunStatusPENDING,
+		dbsqlc.StepRunStatusPENDINGASSIGNMENT,
+		dbsqlc.StepRunStatusASSIGNED,
+		dbsqlc.StepRunStatusRUNNING,
+		dbsqlc.StepRunStatusSUCCEEDED,
+		dbsqlc.StepRunStatusFAILED,
+		dbsqlc.StepRunStatusCANCELLED,
+		dbsqlc.StepRunStatusCANCELLING,
+		dbsqlc.StepRunStatusSKIPPED,
+	} {
+		assert.NotEmpty(t, stepRunTransitions[status], status)
+	}
+
+	for _, status := range []dbsqlc.WorkflowRunStatus{
+		dbsqlc.WorkflowRunStatusPENDING,
+		dbsqlc.WorkflowRunStatusRUNNING,
+		dbsqlc.WorkflowRunStatusSUCCEEDED,
+		dbsqlc.WorkflowRunStatusFAILED,
+		dbsqlc.WorkflowRunStatusQUEUED,
+		dbsqlc.WorkflowRunStatusPAUSED,
+	} {
+		assert.NotEmpty(t, workflowRunTransitions[status], status)
+	}
+
+	for _, status := range []dbsqlc.JobRunStatus{
+		dbsqlc.JobRunStatusPENDING,
+		dbsqlc.JobRunStatusRUNNING,
+		dbsqlc.JobRunStatusSUCCEEDED,
+		dbsqlc.JobRunStatusFAILED,
+		dbsqlc.JobRunStatusCANCELLED,
+	} {
+		assert.NotEmpty(t, jobRunTransitions[status], status)
+	}
+}
@@ -96,9 +96,11 @@ func NewSpan(ctx context.Context, name string) (context.Context, trace.Span) {
 	return ctx, span
 }
 
-func NewSpanWithCarrier(ctx context.Context, name string, carrier map[string]string) (context.Context, trace.Span) {
-	propagator := propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+// propagator reads and writes W3C trace contexts and baggage, which is how trace contexts are passed between
+// clients, engine services and workers.
+var propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
 
+func NewSpanWithCarrier(ctx context.Context, name string, carrier map[string]string) (context.Context, trace.Span) {
 	otelCarrier := propagation.MapCarrier(carrier)
 	parentCtx := propagator.Extract(ctx, otelCarrier)
 
@@ -106,16 +108,52 @@ func NewSpanWithCarrier(ctx context.Context, name string, carrier map[string]str
 	return ctx, span
 }
 
-func GetCarrier(ctx context.Context) map[string]string {
-	propgator := propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+// NewSpanWithLinkedCarrier starts a span in the trace of the carrier, and links it to the span of ctx. This is used
+// for work which is picked up from a queue in a different trace than the one which it belongs to. If the carrier
+// doesn't contain a trace context, the span is started in the trace of ctx.
+func NewSpanWithLinkedCarrier(ctx context.Context, name string, carrier map[string]string) (context.Context, trace.Span) {
+	parentCtx := propagator.Extract(ctx, propagation.MapCarrier(carrier))
+
+	current := trace.SpanContextFromContext(ctx)
+	parent := trace.SpanContextFromContext(parentCtx)
+
+	var opts []trace.SpanStartOption
+
+	if current.IsValid() && parent.IsValid() && current.TraceID() != parent.TraceID() {
+		opts = append(opts, trace.WithLinks(trace.Link{SpanContext: current}))
+	}
+
+	return otel.Tracer("").Start(parentCtx, prefixSpanKey(name), opts...)
+}
 
+// ExtractTraceContext returns a copy of ctx which contains the trace context of the carrier, so spans which are
+// started from it are part of the caller's trace.
+func ExtractTraceContext(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	return propagator.Extract(ctx, carrier)
+}
+
+// InjectTraceContext writes the trace context of ctx to the carrier.
+func InjectTraceContext(ctx context.Context, carrier propagation.TextMapCarrier) {
+	propagator.Inject(ctx, carrier)
+}
+
+func GetCarrier(ctx context.Context) map[string]string {
 	// Serialize the context into carrier
 	carrier := propagation.MapCarrier{}
-	propgator.Inject(ctx, carrier)
+	propagator.Inject(ctx, carrier)
 
 	return carrier
 }
 
+// GetTraceContext returns the carrier of the trace context of ctx, or nil if ctx isn't part of a trace.
+func GetTraceContext(ctx context.Context) map[string]string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return nil
+	}
+
+	return GetCarrier(ctx)
+}
+
 type AttributeKey string
 
 // AttributeKV is a wrapper for otel attributes KV
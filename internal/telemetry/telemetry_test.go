@@ -0,0 +1,93 @@
+package telemetry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+func newRemoteContext(t *testing.T, traceId, spanId string) context.Context {
+	tid, err := trace.TraceIDFromHex(traceId)
+	require.NoError(t, err)
+
+	sid, err := trace.SpanIDFromHex(spanId)
+	require.NoError(t, err)
+
+	return trace.ContextWithRemoteSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    tid,
+		SpanID:     sid,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	}))
+}
+
+func TestGetTraceContext(t *testing.T) {
+	assert.Nil(t, GetTraceContext(context.Background()), "a context which isn't part of a trace has no trace context")
+
+	ctx := newRemoteContext(t, "0af7651916cd43dd8448eb211c80319c", "b7ad6b7169203331")
+
+	traceContext := GetTraceContext(ctx)
+	assert.Equal(t, "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01", traceContext["traceparent"])
+
+	extracted := ExtractTraceContext(context.Background(), propagation.MapCarrier(traceContext))
+	assert.Equal(t, trace.SpanContextFromContext(ctx).TraceID(), trace.SpanContextFromContext(extracted).TraceID())
+}
+
+func TestMetadataCarrier(t *testing.T) {
+	ctx := newRemoteContext(t, "0af7651916cd43dd8448eb211c80319c", "b7ad6b7169203331")
+
+	md := metadata.MD{}
+	InjectTraceContext(ctx, MetadataCarrier(md))
+
+	assert.Equal(t, []string{"00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"}, md.Get("traceparent"))
+	assert.Contains(t, MetadataCarrier(md).Keys(), "traceparent")
+
+	extracted := ExtractTraceContext(context.Background(), MetadataCarrier(md))
+	assert.Equal(t, trace.SpanContextFromContext(ctx), trace.SpanContextFromContext(extracted))
+}
+
+func TestNewSpanWithLinkedCarrier(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prev)
+	})
+
+	// the trace which the work was picked up in
+	current := newRemoteContext(t, "11111111111111111111111111111111", "1111111111111111")
+
+	// the trace which the work belongs to
+	carrier := GetTraceContext(newRemoteContext(t, "22222222222222222222222222222222", "2222222222222222"))
+
+	_, span := NewSpanWithLinkedCarrier(current, "linked", carrier)
+	span.End()
+
+	_, span = NewSpanWithLinkedCarrier(current, "without-carrier", nil)
+	span.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	linked := spans[0]
+	assert.Equal(t, "22222222222222222222222222222222", linked.SpanContext().TraceID().String())
+	assert.Equal(t, "2222222222222222", linked.Parent().SpanID().String())
+
+	require.Len(t, linked.Links(), 1)
+	assert.Equal(t, trace.SpanContextFromContext(current), linked.Links()[0].SpanContext)
+
+	// the span is started in the current trace if the carrier doesn't contain a trace context
+	withoutCarrier := spans[1]
+	assert.Equal(t, "11111111111111111111111111111111", withoutCarrier.SpanContext().TraceID().String())
+	assert.Empty(t, withoutCarrier.Links())
+}
@@ -0,0 +1,256 @@
+package tenantexport
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"time"
+)
+
+// FormatVersion is the version of the archive format. Archives with a newer version can't be imported.
+const FormatVersion = 1
+
+const manifestFile = "manifest.json"
+
+// The JSONL files of an archive, with one record per line. The files are imported in this order, since the records
+// of later files reference the records of earlier files.
+const (
+	RateLimitsFile    = "rate_limits.jsonl"
+	CronCalendarsFile = "cron_calendars.jsonl"
+	WorkflowsFile     = "workflows.jsonl"
+	CronsFile         = "crons.jsonl"
+	ScheduledRunsFile = "scheduled_runs.jsonl"
+	WorkflowRunsFile  = "workflow_runs.jsonl"
+)
+
+// Manifest describes the contents of an archive.
+type Manifest struct {
+	Version    int       `json:"version"`
+	TenantId   string    `json:"tenantId"`
+	ExportedAt time.Time `json:"exportedAt"`
+
+	// IncludesRuns is true if the archive includes the run history of the tenant
+	IncludesRuns bool `json:"includesRuns"`
+
+	// Counts is the number of records in each file of the archive
+	Counts map[string]int `json:"counts"`
+
+	// SkippedWorkflows are the workflows which aren't in the archive, because their latest version was registered
+	// before the definitions of workflow versions were stored. They're exported once they're registered again.
+	SkippedWorkflows []string `json:"skippedWorkflows,omitempty"`
+}
+
+type RateLimit struct {
+	Key   string `json:"key"`
+	Limit int    `json:"limit"`
+
+	// Duration is the window of the rate limit, like MINUTE
+	Duration  string `json:"duration"`
+	Algorithm string `json:"algorithm"`
+	Burst     *int   `json:"burst,omitempty"`
+}
+
+type CronCalendar struct {
+	Name string `json:"name"`
+
+	// Dates are the excluded dates, formatted as YYYY-MM-DD
+	Dates   []string `json:"dates"`
+	ICalURL *string  `json:"icalUrl,omitempty"`
+}
+
+type Workflow struct {
+	Name     string `json:"name"`
+	IsPaused bool   `json:"isPaused"`
+
+	// Definition is the definition which the latest version of the workflow was registered with
+	Definition json.RawMessage `json:"definition"`
+}
+
+type Cron struct {
+	WorkflowName       string          `json:"workflowName"`
+	Name               string          `json:"name"`
+	Cron               string          `json:"cron"`
+	Timezone           string          `json:"timezone"`
+	JitterSeconds      int32           `json:"jitterSeconds"`
+	ExclusionCalendar  *string         `json:"exclusionCalendar,omitempty"`
+	Input              json.RawMessage `json:"input,omitempty"`
+	AdditionalMetadata json.RawMessage `json:"additionalMetadata,omitempty"`
+}
+
+type ScheduledRun struct {
+	WorkflowName       string          `json:"workflowName"`
+	TriggerAt          time.Time       `json:"triggerAt"`
+	Input              json.RawMessage `json:"input,omitempty"`
+	AdditionalMetadata json.RawMessage `json:"additionalMetadata,omitempty"`
+}
+
+// WorkflowRun is a run in the run history of the tenant. Runs are exported for archival and aren't recreated on
+// import.
+type WorkflowRun struct {
+	Id                 string          `json:"id"`
+	WorkflowName       string          `json:"workflowName"`
+	WorkflowVersion    *string         `json:"workflowVersion,omitempty"`
+	Status             string          `json:"status"`
+	DisplayName        *string         `json:"displayName,omitempty"`
+	Input              json.RawMessage `json:"input,omitempty"`
+	Output             json.RawMessage `json:"output,omitempty"`
+	Error              *string         `json:"error,omitempty"`
+	AdditionalMetadata json.RawMessage `json:"additionalMetadata,omitempty"`
+	CreatedAt          time.Time       `json:"createdAt"`
+	StartedAt          *time.Time      `json:"startedAt,omitempty"`
+	FinishedAt         *time.Time      `json:"finishedAt,omitempty"`
+}
+
+// Writer writes an archive, which is a zip file of JSONL files and a manifest.
+type Writer struct {
+	zw       *zip.Writer
+	manifest *Manifest
+	curr     *json.Encoder
+	currFile string
+}
+
+func NewWriter(w io.Writer, tenantId string, includesRuns bool) *Writer {
+	return &Writer{
+		zw: zip.NewWriter(w),
+		manifest: &Manifest{
+			Version:      FormatVersion,
+			TenantId:     tenantId,
+			ExportedAt:   time.Now().UTC(),
+			IncludesRuns: includesRuns,
+			Counts:       map[string]int{},
+		},
+	}
+}
+
+// Create starts a file of the archive, the records which are written after it are written to the file. Files
+// must be written one at a time.
+func (w *Writer) Create(name string) error {
+	f, err := w.zw.Create(name)
+
+	if err != nil {
+		return fmt.Errorf("could not create %s: %w", name, err)
+	}
+
+	w.curr = json.NewEncoder(f)
+	w.currFile = name
+	w.manifest.Counts[name] = 0
+
+	return nil
+}
+
+// Write writes a record to the current file of the archive.
+func (w *Writer) Write(record any) error {
+	if w.curr == nil {
+		return errors.New("no file was created")
+	}
+
+	if err := w.curr.Encode(record); err != nil {
+		return fmt.Errorf("could not write record to %s: %w", w.currFile, err)
+	}
+
+	w.manifest.Counts[w.currFile]++
+
+	return nil
+}
+
+// SkipWorkflow records a workflow which isn't in the archive in the manifest.
+func (w *Writer) SkipWorkflow(name string) {
+	w.manifest.SkippedWorkflows = append(w.manifest.SkippedWorkflows, name)
+}
+
+// Close writes the manifest and finishes the archive. It doesn't close the underlying writer.
+func (w *Writer) Close() (*Manifest, error) {
+	f, err := w.zw.Create(manifestFile)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create manifest: %w", err)
+	}
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(w.manifest); err != nil {
+		return nil, fmt.Errorf("could not write manifest: %w", err)
+	}
+
+	if err := w.zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return w.manifest, nil
+}
+
+// Reader reads an archive which was written by a Writer.
+type Reader struct {
+	zr       *zip.Reader
+	manifest *Manifest
+}
+
+func NewReader(r io.ReaderAt, size int64) (*Reader, error) {
+	zr, err := zip.NewReader(r, size)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read archive: %w", err)
+	}
+
+	res := &Reader{
+		zr: zr,
+	}
+
+	f, err := zr.Open(manifestFile)
+
+	if err != nil {
+		return nil, fmt.Errorf("archive has no manifest: %w", err)
+	}
+
+	defer f.Close() // nolint: errcheck
+
+	if err := json.NewDecoder(f).Decode(&res.manifest); err != nil {
+		return nil, fmt.Errorf("could not read manifest: %w", err)
+	}
+
+	if res.manifest.Version > FormatVersion {
+		return nil, fmt.Errorf("archive version %d is newer than the supported version %d", res.manifest.Version, FormatVersion)
+	}
+
+	return res, nil
+}
+
+func (r *Reader) Manifest() *Manifest {
+	return r.manifest
+}
+
+// ReadRecords calls fn with every record of a file of the archive, in order. Files which aren't in the archive have
+// no records.
+func ReadRecords[T any](r *Reader, name string, fn func(record *T) error) error {
+	f, err := r.zr.Open(name)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("could not open %s: %w", name, err)
+	}
+
+	defer f.Close() // nolint: errcheck
+
+	dec := json.NewDecoder(f)
+
+	for {
+		var record T
+
+		err := dec.Decode(&record)
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("could not read record of %s: %w", name, err)
+		}
+
+		if err := fn(&record); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package tenantexport_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/tenantexport"
+)
+
+func TestArchiveRoundTrip(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	w := tenantexport.NewWriter(buf, "707d0855-80ab-4e1f-a156-f1c4546cbf52", false)
+
+	require.NoError(t, w.Create(tenantexport.RateLimitsFile))
+	require.NoError(t, w.Write(&tenantexport.RateLimit{Key: "api", Limit: 10, Duration: "MINUTE", Algorithm: "FIXED_WINDOW"}))
+	require.NoError(t, w.Write(&tenantexport.RateLimit{Key: "db", Limit: 5, Duration: "SECOND", Algorithm: "FIXED_WINDOW"}))
+
+	require.NoError(t, w.Create(tenantexport.WorkflowsFile))
+	require.NoError(t, w.Write(&tenantexport.Workflow{Name: "etl", Definition: json.RawMessage(`{"Name":"etl"}`)}))
+
+	w.SkipWorkflow("legacy")
+
+	manifest, err := w.Close()
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]int{tenantexport.RateLimitsFile: 2, tenantexport.WorkflowsFile: 1}, manifest.Counts)
+
+	r, err := tenantexport.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	assert.Equal(t, tenantexport.FormatVersion, r.Manifest().Version)
+	assert.Equal(t, "707d0855-80ab-4e1f-a156-f1c4546cbf52", r.Manifest().TenantId)
+	assert.False(t, r.Manifest().IncludesRuns)
+	assert.Equal(t, []string{"legacy"}, r.Manifest().SkippedWorkflows)
+
+	var keys []string
+
+	err = tenantexport.ReadRecords(r, tenantexport.RateLimitsFile, func(record *tenantexport.RateLimit) error {
+		keys = append(keys, record.Key)
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"api", "db"}, keys)
+
+	var workflows []*tenantexport.Workflow
+
+	err = tenantexport.ReadRecords(r, tenantexport.WorkflowsFile, func(record *tenantexport.Workflow) error {
+		workflows = append(workflows, record)
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Len(t, workflows, 1)
+	assert.Equal(t, "etl", workflows[0].Name)
+	assert.JSONEq(t, `{"Name":"etl"}`, string(workflows[0].Definition))
+
+	// files which aren't in the archive have no records
+	err = tenantexport.ReadRecords(r, tenantexport.WorkflowRunsFile, func(record *tenantexport.WorkflowRun) error {
+		t.Fatal("unexpected record")
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestArchiveNewerVersion(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	zw := zip.NewWriter(buf)
+
+	f, err := zw.Create("manifest.json")
+	require.NoError(t, err)
+
+	err = json.NewEncoder(f).Encode(&tenantexport.Manifest{
+		Version:    tenantexport.FormatVersion + 1,
+		ExportedAt: time.Now(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	_, err = tenantexport.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.ErrorContains(t, err, "newer than the supported version")
+}
+
+func TestArchiveWithoutManifest(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	require.NoError(t, zip.NewWriter(buf).Close())
+
+	_, err := tenantexport.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.ErrorContains(t, err, "archive has no manifest")
+}
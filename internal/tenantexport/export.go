@@ -0,0 +1,274 @@
+package tenantexport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+const runsPageSize = 1000
+
+type ExportOpts struct {
+	// IncludeRuns exports the run history of the tenant, which can be large
+	IncludeRuns bool
+}
+
+// Export writes the workflows, crons, scheduled runs, rate limits and exclusion calendars of a tenant to an archive,
+// and optionally its run history.
+func Export(ctx context.Context, repo repository.EngineRepository, tenantId string, w io.Writer, opts ExportOpts) (*Manifest, error) {
+	aw := NewWriter(w, tenantId, opts.IncludeRuns)
+
+	if err := exportRateLimits(ctx, repo, tenantId, aw); err != nil {
+		return nil, err
+	}
+
+	if err := exportCronCalendars(ctx, repo, tenantId, aw); err != nil {
+		return nil, err
+	}
+
+	if err := exportWorkflows(ctx, repo, tenantId, aw); err != nil {
+		return nil, err
+	}
+
+	if err := exportCrons(ctx, repo, tenantId, aw); err != nil {
+		return nil, err
+	}
+
+	if err := exportScheduledRuns(ctx, repo, tenantId, aw); err != nil {
+		return nil, err
+	}
+
+	if opts.IncludeRuns {
+		if err := exportWorkflowRuns(ctx, repo, tenantId, aw); err != nil {
+			return nil, err
+		}
+	}
+
+	return aw.Close()
+}
+
+func exportRateLimits(ctx context.Context, repo repository.EngineRepository, tenantId string, aw *Writer) error {
+	rateLimits, err := repo.TenantExport().ListRateLimitsForExport(ctx, tenantId)
+
+	if err != nil {
+		return fmt.Errorf("could not list rate limits: %w", err)
+	}
+
+	if err := aw.Create(RateLimitsFile); err != nil {
+		return err
+	}
+
+	for _, rateLimit := range rateLimits {
+		record := &RateLimit{
+			Key:   rateLimit.Key,
+			Limit: int(rateLimit.LimitValue),
+			// windows are stored as intervals of a single unit, like "1 MINUTE"
+			Duration:  strings.ToUpper(strings.TrimPrefix(rateLimit.Window, "1 ")),
+			Algorithm: string(rateLimit.Algorithm),
+		}
+
+		if rateLimit.Burst.Valid {
+			burst := int(rateLimit.Burst.Int32)
+			record.Burst = &burst
+		}
+
+		if err := aw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func exportCronCalendars(ctx context.Context, repo repository.EngineRepository, tenantId string, aw *Writer) error {
+	calendars, err := repo.CronCalendar().ListCalendars(ctx, tenantId)
+
+	if err != nil {
+		return fmt.Errorf("could not list cron calendars: %w", err)
+	}
+
+	if err := aw.Create(CronCalendarsFile); err != nil {
+		return err
+	}
+
+	for _, calendar := range calendars {
+		record := &CronCalendar{
+			Name:    calendar.Name,
+			Dates:   make([]string, 0, len(calendar.Dates)),
+			ICalURL: textPtr(calendar.IcalUrl),
+		}
+
+		for _, date := range calendar.Dates {
+			record.Dates = append(record.Dates, date.Time.Format(time.DateOnly))
+		}
+
+		if err := aw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func exportWorkflows(ctx context.Context, repo repository.EngineRepository, tenantId string, aw *Writer) error {
+	workflows, err := repo.TenantExport().ListWorkflowDefinitions(ctx, tenantId)
+
+	if err != nil {
+		return fmt.Errorf("could not list workflows: %w", err)
+	}
+
+	if err := aw.Create(WorkflowsFile); err != nil {
+		return err
+	}
+
+	for _, workflow := range workflows {
+		if len(workflow.Definition) == 0 {
+			aw.SkipWorkflow(workflow.WorkflowName)
+			continue
+		}
+
+		err := aw.Write(&Workflow{
+			Name:       workflow.WorkflowName,
+			IsPaused:   workflow.IsPaused.Valid && workflow.IsPaused.Bool,
+			Definition: workflow.Definition,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func exportCrons(ctx context.Context, repo repository.EngineRepository, tenantId string, aw *Writer) error {
+	crons, err := repo.TenantExport().ListCronsForExport(ctx, tenantId)
+
+	if err != nil {
+		return fmt.Errorf("could not list crons: %w", err)
+	}
+
+	if err := aw.Create(CronsFile); err != nil {
+		return err
+	}
+
+	for _, cron := range crons {
+		err := aw.Write(&Cron{
+			WorkflowName:       cron.WorkflowName,
+			Name:               cron.Name.String,
+			Cron:               cron.Cron,
+			Timezone:           cron.Timezone,
+			JitterSeconds:      cron.JitterSeconds,
+			ExclusionCalendar:  textPtr(cron.ExclusionCalendarName),
+			Input:              cron.Input,
+			AdditionalMetadata: cron.AdditionalMetadata,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func exportScheduledRuns(ctx context.Context, repo repository.EngineRepository, tenantId string, aw *Writer) error {
+	scheduledRuns, err := repo.TenantExport().ListScheduledRunsForExport(ctx, tenantId)
+
+	if err != nil {
+		return fmt.Errorf("could not list scheduled runs: %w", err)
+	}
+
+	if err := aw.Create(ScheduledRunsFile); err != nil {
+		return err
+	}
+
+	for _, scheduledRun := range scheduledRuns {
+		err := aw.Write(&ScheduledRun{
+			WorkflowName:       scheduledRun.WorkflowName,
+			TriggerAt:          scheduledRun.TriggerAt.Time.UTC(),
+			Input:              scheduledRun.Input,
+			AdditionalMetadata: scheduledRun.AdditionalMetadata,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func exportWorkflowRuns(ctx context.Context, repo repository.EngineRepository, tenantId string, aw *Writer) error {
+	if err := aw.Create(WorkflowRunsFile); err != nil {
+		return err
+	}
+
+	opts := &repository.ListWorkflowRunsForExportOpts{
+		Limit: runsPageSize,
+	}
+
+	for {
+		runs, err := repo.TenantExport().ListWorkflowRunsForExport(ctx, tenantId, opts)
+
+		if err != nil {
+			return err
+		}
+
+		for _, run := range runs {
+			err := aw.Write(&WorkflowRun{
+				Id:                 sqlchelpers.UUIDToStr(run.ID),
+				WorkflowName:       run.WorkflowName,
+				WorkflowVersion:    textPtr(run.WorkflowVersion),
+				Status:             string(run.Status),
+				DisplayName:        textPtr(run.DisplayName),
+				Input:              run.Input,
+				Output:             run.Output,
+				Error:              textPtr(run.Error),
+				AdditionalMetadata: run.AdditionalMetadata,
+				CreatedAt:          run.CreatedAt.Time.UTC(),
+				StartedAt:          timePtr(run.StartedAt),
+				FinishedAt:         timePtr(run.FinishedAt),
+			})
+
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(runs) < runsPageSize {
+			return nil
+		}
+
+		last := runs[len(runs)-1]
+		lastId := sqlchelpers.UUIDToStr(last.ID)
+
+		opts.AfterCreatedAt = &last.CreatedAt.Time
+		opts.AfterId = &lastId
+	}
+}
+
+func textPtr(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+
+	return &t.String
+}
+
+func timePtr(t pgtype.Timestamp) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+
+	res := t.Time.UTC()
+
+	return &res
+}
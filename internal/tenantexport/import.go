@@ -0,0 +1,335 @@
+package tenantexport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// ImportResult is the outcome of an import. Records which can't be imported are skipped, so that an archive can be
+// imported into a tenant which already has some of its records.
+type ImportResult struct {
+	// Imported is the number of records of each file which were created or updated
+	Imported map[string]int
+
+	// Skipped are the records which weren't imported, with the reason
+	Skipped []string
+}
+
+type importer struct {
+	api      repository.APIRepository
+	engine   repository.EngineRepository
+	tenantId string
+	res      *ImportResult
+}
+
+// Import recreates the workflows, crons, scheduled runs, rate limits and exclusion calendars of an archive in a
+// tenant. Workflows are registered with the definition of the archive, which creates a new version if the tenant
+// already has a workflow with the same name and a different definition. The run history of the archive isn't
+// imported.
+func Import(ctx context.Context, api repository.APIRepository, engine repository.EngineRepository, tenantId string, r *Reader) (*ImportResult, error) {
+	i := &importer{
+		api:      api,
+		engine:   engine,
+		tenantId: tenantId,
+		res: &ImportResult{
+			Imported: map[string]int{},
+		},
+	}
+
+	if err := ReadRecords(r, RateLimitsFile, func(record *RateLimit) error {
+		return i.importRateLimit(ctx, record)
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ReadRecords(r, CronCalendarsFile, func(record *CronCalendar) error {
+		return i.importCronCalendar(ctx, record)
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ReadRecords(r, WorkflowsFile, func(record *Workflow) error {
+		return i.importWorkflow(ctx, record)
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ReadRecords(r, CronsFile, func(record *Cron) error {
+		return i.importCron(ctx, record)
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := ReadRecords(r, ScheduledRunsFile, func(record *ScheduledRun) error {
+		return i.importScheduledRun(ctx, record)
+	}); err != nil {
+		return nil, err
+	}
+
+	return i.res, nil
+}
+
+func (i *importer) skip(format string, args ...any) {
+	i.res.Skipped = append(i.res.Skipped, fmt.Sprintf(format, args...))
+}
+
+func (i *importer) importRateLimit(ctx context.Context, rateLimit *RateLimit) error {
+	opts := &repository.UpsertRateLimitOpts{
+		Limit:    rateLimit.Limit,
+		Duration: &rateLimit.Duration,
+		Burst:    rateLimit.Burst,
+	}
+
+	if rateLimit.Algorithm != "" {
+		opts.Algorithm = &rateLimit.Algorithm
+	}
+
+	_, err := i.engine.RateLimit().UpsertRateLimit(ctx, i.tenantId, rateLimit.Key, opts)
+
+	if err != nil {
+		i.skip("rate limit %s: %v", rateLimit.Key, err)
+		return nil
+	}
+
+	i.res.Imported[RateLimitsFile]++
+
+	return nil
+}
+
+func (i *importer) importCronCalendar(ctx context.Context, calendar *CronCalendar) error {
+	_, err := i.engine.CronCalendar().GetCalendarByName(ctx, i.tenantId, calendar.Name)
+
+	if err == nil {
+		i.skip("cron calendar %s: a calendar with the same name exists", calendar.Name)
+		return nil
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("could not get cron calendar %s: %w", calendar.Name, err)
+	}
+
+	opts := &repository.CreateCronExclusionCalendarOpts{
+		Name:    calendar.Name,
+		ICalURL: calendar.ICalURL,
+	}
+
+	for _, date := range calendar.Dates {
+		d, err := time.Parse(time.DateOnly, date)
+
+		if err != nil {
+			i.skip("cron calendar %s: invalid date %s", calendar.Name, date)
+			return nil
+		}
+
+		opts.Dates = append(opts.Dates, d)
+	}
+
+	if _, err := i.engine.CronCalendar().CreateCalendar(ctx, i.tenantId, opts); err != nil {
+		i.skip("cron calendar %s: %v", calendar.Name, err)
+		return nil
+	}
+
+	i.res.Imported[CronCalendarsFile]++
+
+	return nil
+}
+
+func (i *importer) importWorkflow(ctx context.Context, workflow *Workflow) error {
+	opts := &repository.CreateWorkflowVersionOpts{}
+
+	if err := json.Unmarshal(workflow.Definition, opts); err != nil {
+		i.skip("workflow %s: invalid definition: %v", workflow.Name, err)
+		return nil
+	}
+
+	// scheduled triggers which have passed would run immediately
+	scheduledTriggers := opts.ScheduledTriggers[:0]
+
+	for _, trigger := range opts.ScheduledTriggers {
+		if trigger.After(time.Now()) {
+			scheduledTriggers = append(scheduledTriggers, trigger)
+		}
+	}
+
+	opts.ScheduledTriggers = scheduledTriggers
+
+	var workflowId string
+
+	curr, err := i.engine.Workflow().GetWorkflowByName(ctx, i.tenantId, opts.Name)
+
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		version, err := i.engine.Workflow().CreateNewWorkflow(ctx, i.tenantId, opts)
+
+		if err != nil {
+			i.skip("workflow %s: %v", workflow.Name, err)
+			return nil
+		}
+
+		workflowId = sqlchelpers.UUIDToStr(version.WorkflowVersion.WorkflowId)
+	case err != nil:
+		return fmt.Errorf("could not get workflow %s: %w", workflow.Name, err)
+	default:
+		workflowId = sqlchelpers.UUIDToStr(curr.ID)
+
+		latest, err := i.engine.Workflow().GetLatestWorkflowVersion(ctx, i.tenantId, workflowId)
+
+		if err != nil {
+			return fmt.Errorf("could not get latest version of workflow %s: %w", workflow.Name, err)
+		}
+
+		cs, err := opts.Checksum()
+
+		if err != nil {
+			return err
+		}
+
+		if latest.WorkflowVersion.Checksum != cs {
+			if _, err := i.engine.Workflow().CreateWorkflowVersion(ctx, i.tenantId, opts, latest); err != nil {
+				i.skip("workflow %s: %v", workflow.Name, err)
+				return nil
+			}
+		}
+	}
+
+	if workflow.IsPaused {
+		isPaused := true
+
+		_, err := i.api.Workflow().UpdateWorkflow(ctx, i.tenantId, workflowId, &repository.UpdateWorkflowOpts{
+			IsPaused: &isPaused,
+		})
+
+		if err != nil {
+			return fmt.Errorf("could not pause workflow %s: %w", workflow.Name, err)
+		}
+	}
+
+	i.res.Imported[WorkflowsFile]++
+
+	return nil
+}
+
+func (i *importer) importCron(ctx context.Context, cron *Cron) error {
+	workflowId, ok, err := i.workflowId(ctx, cron.WorkflowName)
+
+	if err != nil {
+		return err
+	} else if !ok {
+		i.skip("cron %s of workflow %s: the workflow doesn't exist", cron.Name, cron.WorkflowName)
+		return nil
+	}
+
+	opts := &repository.CreateCronWorkflowTriggerOpts{
+		WorkflowId:    workflowId,
+		Name:          cron.Name,
+		Cron:          cron.Cron,
+		Timezone:      &cron.Timezone,
+		JitterSeconds: &cron.JitterSeconds,
+	}
+
+	if cron.ExclusionCalendar != nil {
+		calendar, err := i.engine.CronCalendar().GetCalendarByName(ctx, i.tenantId, *cron.ExclusionCalendar)
+
+		if err != nil {
+			i.skip("cron %s of workflow %s: could not get exclusion calendar %s: %v", cron.Name, cron.WorkflowName, *cron.ExclusionCalendar, err)
+			return nil
+		}
+
+		calendarId := sqlchelpers.UUIDToStr(calendar.ID)
+		opts.ExclusionCalendarId = &calendarId
+	}
+
+	if err := unmarshalMap(cron.Input, &opts.Input); err != nil {
+		i.skip("cron %s of workflow %s: invalid input: %v", cron.Name, cron.WorkflowName, err)
+		return nil
+	}
+
+	if err := unmarshalMap(cron.AdditionalMetadata, &opts.AdditionalMetadata); err != nil {
+		i.skip("cron %s of workflow %s: invalid additional metadata: %v", cron.Name, cron.WorkflowName, err)
+		return nil
+	}
+
+	_, err = i.api.Workflow().CreateCronWorkflow(ctx, i.tenantId, opts)
+
+	var pgErr *pgconn.PgError
+
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		i.skip("cron %s of workflow %s: a cron with the same name and expression exists", cron.Name, cron.WorkflowName)
+		return nil
+	} else if err != nil {
+		i.skip("cron %s of workflow %s: %v", cron.Name, cron.WorkflowName, err)
+		return nil
+	}
+
+	i.res.Imported[CronsFile]++
+
+	return nil
+}
+
+func (i *importer) importScheduledRun(ctx context.Context, scheduledRun *ScheduledRun) error {
+	if !scheduledRun.TriggerAt.After(time.Now()) {
+		i.skip("scheduled run of workflow %s at %s: the trigger time has passed", scheduledRun.WorkflowName, scheduledRun.TriggerAt)
+		return nil
+	}
+
+	workflowId, ok, err := i.workflowId(ctx, scheduledRun.WorkflowName)
+
+	if err != nil {
+		return err
+	} else if !ok {
+		i.skip("scheduled run of workflow %s at %s: the workflow doesn't exist", scheduledRun.WorkflowName, scheduledRun.TriggerAt)
+		return nil
+	}
+
+	opts := &repository.CreateScheduledWorkflowRunForWorkflowOpts{
+		WorkflowId:       workflowId,
+		ScheduledTrigger: scheduledRun.TriggerAt,
+	}
+
+	if err := unmarshalMap(scheduledRun.Input, &opts.Input); err != nil {
+		i.skip("scheduled run of workflow %s at %s: invalid input: %v", scheduledRun.WorkflowName, scheduledRun.TriggerAt, err)
+		return nil
+	}
+
+	if err := unmarshalMap(scheduledRun.AdditionalMetadata, &opts.AdditionalMetadata); err != nil {
+		i.skip("scheduled run of workflow %s at %s: invalid additional metadata: %v", scheduledRun.WorkflowName, scheduledRun.TriggerAt, err)
+		return nil
+	}
+
+	if _, err := i.api.Workflow().CreateScheduledWorkflow(ctx, i.tenantId, opts); err != nil {
+		i.skip("scheduled run of workflow %s at %s: %v", scheduledRun.WorkflowName, scheduledRun.TriggerAt, err)
+		return nil
+	}
+
+	i.res.Imported[ScheduledRunsFile]++
+
+	return nil
+}
+
+// workflowId returns the id of the workflow of the tenant with the name, it returns false if there's no workflow.
+func (i *importer) workflowId(ctx context.Context, name string) (string, bool, error) {
+	workflow, err := i.engine.Workflow().GetWorkflowByName(ctx, i.tenantId, name)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", false, nil
+	} else if err != nil {
+		return "", false, fmt.Errorf("could not get workflow %s: %w", name, err)
+	}
+
+	return sqlchelpers.UUIDToStr(workflow.ID), true, nil
+}
+
+func unmarshalMap(data json.RawMessage, m *map[string]interface{}) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(data, m)
+}
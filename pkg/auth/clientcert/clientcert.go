@@ -0,0 +1,108 @@
+// Package clientcert authenticates clients of the engine with X.509 client certificates which carry a SPIFFE ID.
+package clientcert
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const spiffeScheme = "spiffe"
+
+// ParseCA parses a PEM encoded CA certificate.
+func ParseCA(certPEM string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certPEM))
+
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("certificate is not a PEM encoded certificate")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not parse certificate: %w", err)
+	}
+
+	if !cert.IsCA {
+		return nil, fmt.Errorf("certificate is not a CA certificate")
+	}
+
+	return cert, nil
+}
+
+// ValidateSpiffeIdPrefix checks that a prefix of SPIFFE IDs contains the trust domain, like spiffe://example.org/ or
+// spiffe://example.org/ns/workers/.
+func ValidateSpiffeIdPrefix(prefix string) error {
+	u, err := url.Parse(prefix)
+
+	if err != nil {
+		return fmt.Errorf("invalid spiffe id prefix: %w", err)
+	}
+
+	if u.Scheme != spiffeScheme || u.Host == "" {
+		return fmt.Errorf("spiffe id prefix must start with spiffe:// and a trust domain")
+	}
+
+	if u.User != nil || u.Port() != "" || u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("spiffe id prefix can't contain a user, port, query or fragment")
+	}
+
+	return nil
+}
+
+// SpiffeId returns the SPIFFE ID of a certificate. Like X509-SVIDs, the certificate must have exactly one URI SAN,
+// which is the SPIFFE ID.
+func SpiffeId(cert *x509.Certificate) (string, error) {
+	if len(cert.URIs) != 1 {
+		return "", fmt.Errorf("certificate must have exactly one uri san, got %d", len(cert.URIs))
+	}
+
+	id := cert.URIs[0]
+
+	if id.Scheme != spiffeScheme || id.Host == "" {
+		return "", fmt.Errorf("uri san %s is not a spiffe id", id.String())
+	}
+
+	return id.String(), nil
+}
+
+// Verify verifies a client certificate chain, which starts with the leaf certificate, against the PEM encoded CA
+// certificates.
+func Verify(chain []*x509.Certificate, caPEMs []string, now time.Time) error {
+	if len(chain) == 0 {
+		return fmt.Errorf("no client certificate")
+	}
+
+	roots := x509.NewCertPool()
+
+	for _, caPEM := range caPEMs {
+		ca, err := ParseCA(caPEM)
+
+		if err != nil {
+			return err
+		}
+
+		roots.AddCert(ca)
+	}
+
+	intermediates := x509.NewCertPool()
+
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	_, err := chain[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not verify client certificate: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package clientcert
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  string
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	}
+}
+
+func (ca *testCA) issue(t *testing.T, uris ...string) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	for _, uri := range uris {
+		u, err := url.Parse(uri)
+		require.NoError(t, err)
+
+		tmpl.URIs = append(tmpl.URIs, u)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert
+}
+
+func TestVerify(t *testing.T) {
+	ca := newTestCA(t)
+	other := newTestCA(t)
+
+	cert := ca.issue(t, "spiffe://example.org/workers/a")
+
+	assert.NoError(t, Verify([]*x509.Certificate{cert}, []string{other.pem, ca.pem}, time.Now()))
+	assert.Error(t, Verify([]*x509.Certificate{cert}, []string{other.pem}, time.Now()))
+	assert.Error(t, Verify([]*x509.Certificate{cert}, []string{ca.pem}, time.Now().Add(2*time.Hour)))
+	assert.Error(t, Verify(nil, []string{ca.pem}, time.Now()))
+}
+
+func TestSpiffeId(t *testing.T) {
+	ca := newTestCA(t)
+
+	id, err := SpiffeId(ca.issue(t, "spiffe://example.org/workers/a"))
+	require.NoError(t, err)
+	assert.Equal(t, "spiffe://example.org/workers/a", id)
+
+	_, err = SpiffeId(ca.issue(t))
+	assert.Error(t, err)
+
+	_, err = SpiffeId(ca.issue(t, "https://example.org/workers/a"))
+	assert.Error(t, err)
+
+	_, err = SpiffeId(ca.issue(t, "spiffe://example.org/a", "spiffe://example.org/b"))
+	assert.Error(t, err)
+}
+
+func TestParseCA(t *testing.T) {
+	ca := newTestCA(t)
+
+	_, err := ParseCA(ca.pem)
+	assert.NoError(t, err)
+
+	leaf := ca.issue(t, "spiffe://example.org/a")
+
+	_, err = ParseCA(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf.Raw})))
+	assert.ErrorContains(t, err, "not a CA")
+
+	_, err = ParseCA("not a certificate")
+	assert.Error(t, err)
+}
+
+func TestValidateSpiffeIdPrefix(t *testing.T) {
+	assert.NoError(t, ValidateSpiffeIdPrefix("spiffe://example.org/"))
+	assert.NoError(t, ValidateSpiffeIdPrefix("spiffe://example.org/ns/workers/"))
+
+	assert.Error(t, ValidateSpiffeIdPrefix("https://example.org/"))
+	assert.Error(t, ValidateSpiffeIdPrefix("spiffe:///workers"))
+	assert.Error(t, ValidateSpiffeIdPrefix("spiffe://example.org:8443/"))
+	assert.Error(t, ValidateSpiffeIdPrefix("spiffe://example.org/?a=b"))
+}
@@ -0,0 +1,91 @@
+package oidc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// the tenant member roles, ordered from least to most privileged
+var roleRanks = map[string]int{
+	"MEMBER": 1,
+	"ADMIN":  2,
+	"OWNER":  3,
+}
+
+// GroupMapping grants a role in a tenant to the members of a group of the provider
+type GroupMapping struct {
+	Group    string
+	TenantId string
+	Role     string
+}
+
+// ParseGroupMappings parses group mappings in the format <group>=<tenant id>:<role>
+func ParseGroupMappings(mappings []string) ([]GroupMapping, error) {
+	res := make([]GroupMapping, 0, len(mappings))
+
+	for _, m := range mappings {
+		m = strings.TrimSpace(m)
+
+		if m == "" {
+			continue
+		}
+
+		// groups may contain an = or a : themselves, the tenant id and role don't
+		eq := strings.LastIndex(m, "=")
+
+		if eq <= 0 {
+			return nil, fmt.Errorf("invalid group mapping %q, expected <group>=<tenant id>:<role>", m)
+		}
+
+		group, target := m[:eq], m[eq+1:]
+
+		colon := strings.LastIndex(target, ":")
+
+		if colon <= 0 {
+			return nil, fmt.Errorf("invalid group mapping %q, expected <group>=<tenant id>:<role>", m)
+		}
+
+		tenantId, role := target[:colon], strings.ToUpper(target[colon+1:])
+
+		if _, ok := roleRanks[role]; !ok {
+			return nil, fmt.Errorf("invalid role %q in group mapping %q, expected OWNER, ADMIN or MEMBER", role, m)
+		}
+
+		res = append(res, GroupMapping{
+			Group:    group,
+			TenantId: tenantId,
+			Role:     role,
+		})
+	}
+
+	return res, nil
+}
+
+// TenantRoles returns the role in every tenant which the groups are mapped to. When several groups are mapped to the
+// same tenant, the most privileged role is returned.
+func TenantRoles(mappings []GroupMapping, groups []string) map[string]string {
+	isMember := make(map[string]bool, len(groups))
+
+	for _, g := range groups {
+		isMember[g] = true
+	}
+
+	roles := make(map[string]string)
+
+	for _, m := range mappings {
+		if !isMember[m.Group] {
+			continue
+		}
+
+		if current, ok := roles[m.TenantId]; !ok || IsMorePrivileged(m.Role, current) {
+			roles[m.TenantId] = m.Role
+		}
+	}
+
+	return roles
+}
+
+// IsMorePrivileged returns whether role a grants more than role b
+func IsMorePrivileged(a, b string) bool {
+	return roleRanks[a] > roleRanks[b]
+}
@@ -0,0 +1,95 @@
+package oidc
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/tink-crypto/tink-go/jwt"
+)
+
+// the fields of a JWK which tink reads, other fields like x5c are dropped
+var jwkFields = []string{"kty", "alg", "kid", "use", "n", "e", "crv", "x", "y"}
+
+// verifierFromJWKS returns a verifier for the signing keys of a JWK set. Tink requires the alg of every key, which
+// providers may omit, so it's derived from the key type. Keys which aren't signing keys or have an unsupported
+// algorithm are skipped.
+func verifierFromJWKS(data []byte) (jwt.Verifier, error) {
+	var set struct {
+		Keys []map[string]any `json:"keys"`
+	}
+
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("could not parse jwks: %w", err)
+	}
+
+	keys := make([]map[string]any, 0, len(set.Keys))
+
+	for _, key := range set.Keys {
+		if use, ok := key["use"]; ok && use != "sig" {
+			continue
+		}
+
+		k := make(map[string]any, len(jwkFields))
+
+		for _, field := range jwkFields {
+			if v, ok := key[field]; ok {
+				k[field] = v
+			}
+		}
+
+		if _, ok := k["alg"]; !ok {
+			k["alg"] = defaultAlg(k)
+		}
+
+		if !supportedAlg(k["alg"]) {
+			continue
+		}
+
+		keys = append(keys, k)
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("jwks does not contain a supported signing key")
+	}
+
+	filtered, err := json.Marshal(map[string]any{"keys": keys})
+
+	if err != nil {
+		return nil, err
+	}
+
+	handle, err := jwt.JWKSetToPublicKeysetHandle(filtered)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read jwks: %w", err)
+	}
+
+	return jwt.NewVerifier(handle)
+}
+
+func defaultAlg(key map[string]any) string {
+	switch key["kty"] {
+	case "RSA":
+		return "RS256"
+	case "EC":
+		switch key["crv"] {
+		case "P-256":
+			return "ES256"
+		case "P-384":
+			return "ES384"
+		case "P-521":
+			return "ES512"
+		}
+	}
+
+	return ""
+}
+
+func supportedAlg(alg any) bool {
+	switch alg {
+	case "RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512":
+		return true
+	}
+
+	return false
+}
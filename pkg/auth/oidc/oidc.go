@@ -0,0 +1,351 @@
+// Package oidc signs in users with the authorization code flow of an OpenID Connect provider.
+package oidc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tink-crypto/tink-go/jwt"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// the interval after which the signing keys of the provider are fetched again
+	keysRefreshInterval = time.Hour
+
+	// the minimum interval between fetches of the signing keys, so tokens with unknown keys don't trigger a fetch
+	// on every request
+	keysMinRefreshInterval = time.Minute
+)
+
+// ErrEmailNotVerified is returned when the provider hasn't verified the email of a user
+var ErrEmailNotVerified = errors.New("email is not verified by the provider")
+
+type Opts struct {
+	// IssuerURL is the issuer of the provider, its discovery document is served at
+	// <IssuerURL>/.well-known/openid-configuration
+	IssuerURL string
+
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	RedirectURL  string
+
+	// GroupsClaim is the claim of the ID token which contains the groups of the user, it can be an array of strings
+	// or a string
+	GroupsClaim string
+
+	// AllowUnverifiedEmail signs in users whose ID token doesn't have a true email_verified claim
+	AllowUnverifiedEmail bool
+
+	// HTTPClient is the client which requests to the provider are sent with, defaults to a client with a 10 second
+	// timeout
+	HTTPClient *http.Client
+}
+
+// Claims are the claims of a verified ID token
+type Claims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Groups        []string
+}
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider is an OpenID Connect provider. The discovery document and signing keys of the provider are fetched on
+// first use, so the API server starts when the provider is unreachable.
+type Provider struct {
+	opts   *Opts
+	client *http.Client
+
+	mu          sync.Mutex
+	discovery   *discoveryDocument
+	verifier    jwt.Verifier
+	keysFetched time.Time
+}
+
+func NewProvider(opts *Opts) *Provider {
+	client := opts.HTTPClient
+
+	if client == nil {
+		client = &http.Client{
+			Timeout: 10 * time.Second,
+		}
+	}
+
+	return &Provider{
+		opts:   opts,
+		client: client,
+	}
+}
+
+// Nonce returns the nonce of an authorization request with the state, which binds the ID token to the session which
+// stores the state
+func Nonce(state string) string {
+	sum := sha256.Sum256([]byte(state))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// AuthCodeURL returns the URL of the provider which the user is redirected to for signing in
+func (p *Provider) AuthCodeURL(ctx context.Context, state string) (string, error) {
+	d, err := p.discover(ctx)
+
+	if err != nil {
+		return "", err
+	}
+
+	return p.oauth2Config(d).AuthCodeURL(state, oauth2.SetAuthURLParam("nonce", Nonce(state))), nil
+}
+
+// Exchange exchanges the authorization code of the callback for an ID token and returns its verified claims
+func (p *Provider) Exchange(ctx context.Context, code, state string) (*Claims, error) {
+	d, err := p.discover(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tok, err := p.oauth2Config(d).Exchange(context.WithValue(ctx, oauth2.HTTPClient, p.client), code)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not exchange code: %w", err)
+	}
+
+	rawIDToken, ok := tok.Extra("id_token").(string)
+
+	if !ok || rawIDToken == "" {
+		return nil, fmt.Errorf("token response does not contain an id token")
+	}
+
+	return p.Verify(ctx, rawIDToken, Nonce(state))
+}
+
+// Verify verifies the signature, issuer, audience, expiry and nonce of an ID token and returns its claims
+func (p *Provider) Verify(ctx context.Context, rawIDToken, nonce string) (*Claims, error) {
+	d, err := p.discover(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := jwt.NewValidator(&jwt.ValidatorOpts{
+		ExpectedIssuer:   &d.Issuer,
+		ExpectedAudience: &p.opts.ClientID,
+		IgnoreTypeHeader: true,
+		ClockSkew:        time.Minute,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	verifier, err := p.getVerifier(ctx, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	verified, err := verifier.VerifyAndDecode(rawIDToken, validator)
+
+	if err != nil {
+		// the provider may have rotated its signing keys
+		if verifier, refreshErr := p.getVerifier(ctx, true); refreshErr == nil {
+			verified, err = verifier.VerifyAndDecode(rawIDToken, validator)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("could not verify id token: %w", err)
+		}
+	}
+
+	if tokenNonce, err := verified.StringClaim("nonce"); err != nil || tokenNonce != nonce {
+		return nil, fmt.Errorf("id token nonce does not match")
+	}
+
+	claims := &Claims{}
+
+	if claims.Subject, err = verified.Subject(); err != nil {
+		return nil, fmt.Errorf("id token does not have a subject: %w", err)
+	}
+
+	if claims.Email, err = verified.StringClaim("email"); err != nil || claims.Email == "" {
+		return nil, fmt.Errorf("id token does not have an email claim, make sure the email scope is requested")
+	}
+
+	switch {
+	case verified.HasBooleanClaim("email_verified"):
+		claims.EmailVerified, _ = verified.BooleanClaim("email_verified")
+	case verified.HasStringClaim("email_verified"):
+		// some providers send the claim as a string
+		v, _ := verified.StringClaim("email_verified")
+		claims.EmailVerified = v == "true"
+	}
+
+	if !claims.EmailVerified && !p.opts.AllowUnverifiedEmail {
+		return nil, ErrEmailNotVerified
+	}
+
+	if verified.HasStringClaim("name") {
+		claims.Name, _ = verified.StringClaim("name")
+	}
+
+	claims.Groups = groupsFromToken(verified, p.opts.GroupsClaim)
+
+	return claims, nil
+}
+
+func groupsFromToken(verified *jwt.VerifiedJWT, claim string) []string {
+	if claim == "" {
+		return nil
+	}
+
+	if verified.HasStringClaim(claim) {
+		group, _ := verified.StringClaim(claim)
+		return []string{group}
+	}
+
+	if !verified.HasArrayClaim(claim) {
+		return nil
+	}
+
+	values, err := verified.ArrayClaim(claim)
+
+	if err != nil {
+		return nil
+	}
+
+	groups := make([]string, 0, len(values))
+
+	for _, v := range values {
+		if group, ok := v.(string); ok {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups
+}
+
+func (p *Provider) oauth2Config(d *discoveryDocument) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     p.opts.ClientID,
+		ClientSecret: p.opts.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  d.AuthorizationEndpoint,
+			TokenURL: d.TokenEndpoint,
+		},
+		RedirectURL: p.opts.RedirectURL,
+		Scopes:      p.opts.Scopes,
+	}
+}
+
+func (p *Provider) discover(ctx context.Context) (*discoveryDocument, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	issuer := strings.TrimSuffix(p.opts.IssuerURL, "/")
+
+	d := &discoveryDocument{}
+
+	if err := p.getJSON(ctx, issuer+"/.well-known/openid-configuration", d); err != nil {
+		return nil, fmt.Errorf("could not get discovery document: %w", err)
+	}
+
+	if strings.TrimSuffix(d.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("issuer of the discovery document %q does not match %q", d.Issuer, p.opts.IssuerURL)
+	}
+
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" || d.JWKSURI == "" {
+		return nil, fmt.Errorf("discovery document is missing the authorization, token or jwks endpoint")
+	}
+
+	p.discovery = d
+
+	return d, nil
+}
+
+func (p *Provider) getVerifier(ctx context.Context, refresh bool) (jwt.Verifier, error) {
+	d, err := p.discover(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	age := time.Since(p.keysFetched)
+
+	if p.verifier != nil && age < keysRefreshInterval && (!refresh || age < keysMinRefreshInterval) {
+		return p.verifier, nil
+	}
+
+	var jwks json.RawMessage
+
+	if err := p.getJSON(ctx, d.JWKSURI, &jwks); err != nil {
+		// keep verifying with the previous keys if the provider is unreachable
+		if p.verifier != nil {
+			return p.verifier, nil
+		}
+
+		return nil, fmt.Errorf("could not get signing keys: %w", err)
+	}
+
+	verifier, err := verifierFromJWKS(jwks)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p.verifier = verifier
+	p.keysFetched = time.Now()
+
+	return verifier, nil
+}
+
+func (p *Provider) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+
+	if err != nil {
+		return err
+	}
+
+	resp, err := p.client.Do(req)
+
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d", url, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, v)
+}
@@ -0,0 +1,199 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tink-crypto/tink-go/jwt"
+	"github.com/tink-crypto/tink-go/keyset"
+)
+
+type testProvider struct {
+	server *httptest.Server
+	signer jwt.Signer
+}
+
+func newTestProvider(t *testing.T) *testProvider {
+	t.Helper()
+
+	handle, err := keyset.NewHandle(jwt.RS256_2048_F4_Key_Template())
+	require.NoError(t, err)
+
+	public, err := handle.Public()
+	require.NoError(t, err)
+
+	jwks, err := jwt.JWKSetFromPublicKeysetHandle(public)
+	require.NoError(t, err)
+
+	signer, err := jwt.NewSigner(handle)
+	require.NoError(t, err)
+
+	p := &testProvider{signer: signer}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 p.server.URL,
+			"authorization_endpoint": p.server.URL + "/authorize",
+			"token_endpoint":         p.server.URL + "/token",
+			"jwks_uri":               p.server.URL + "/jwks",
+		})
+	})
+
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(jwks)
+	})
+
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+
+	return p
+}
+
+func (p *testProvider) sign(t *testing.T, audience string, claims map[string]any) string {
+	t.Helper()
+
+	subject := "user-1"
+	expiresAt := time.Now().Add(time.Hour)
+
+	raw, err := jwt.NewRawJWT(&jwt.RawJWTOptions{
+		Issuer:       &p.server.URL,
+		Audience:     &audience,
+		Subject:      &subject,
+		ExpiresAt:    &expiresAt,
+		CustomClaims: claims,
+	})
+	require.NoError(t, err)
+
+	token, err := p.signer.SignAndEncode(raw)
+	require.NoError(t, err)
+
+	return token
+}
+
+func TestVerify(t *testing.T) {
+	p := newTestProvider(t)
+
+	provider := NewProvider(&Opts{
+		IssuerURL:   p.server.URL,
+		ClientID:    "client",
+		GroupsClaim: "groups",
+	})
+
+	ctx := context.Background()
+	nonce := Nonce("state")
+
+	claims, err := provider.Verify(ctx, p.sign(t, "client", map[string]any{
+		"nonce":          nonce,
+		"email":          "user@example.com",
+		"email_verified": true,
+		"name":           "User",
+		"groups":         []any{"engineering", "oncall"},
+	}), nonce)
+	require.NoError(t, err)
+
+	assert.Equal(t, &Claims{
+		Subject:       "user-1",
+		Email:         "user@example.com",
+		EmailVerified: true,
+		Name:          "User",
+		Groups:        []string{"engineering", "oncall"},
+	}, claims)
+
+	// email_verified as a string
+	_, err = provider.Verify(ctx, p.sign(t, "client", map[string]any{
+		"nonce":          nonce,
+		"email":          "user@example.com",
+		"email_verified": "true",
+	}), nonce)
+	assert.NoError(t, err)
+
+	_, err = provider.Verify(ctx, p.sign(t, "client", map[string]any{
+		"nonce": nonce,
+		"email": "user@example.com",
+	}), nonce)
+	assert.ErrorIs(t, err, ErrEmailNotVerified)
+
+	_, err = provider.Verify(ctx, p.sign(t, "client", map[string]any{
+		"nonce":          Nonce("other"),
+		"email":          "user@example.com",
+		"email_verified": true,
+	}), nonce)
+	assert.ErrorContains(t, err, "nonce")
+
+	_, err = provider.Verify(ctx, p.sign(t, "other-client", map[string]any{
+		"nonce":          nonce,
+		"email":          "user@example.com",
+		"email_verified": true,
+	}), nonce)
+	assert.Error(t, err)
+
+	// a token signed by a key which isn't in the jwks
+	other := newTestProvider(t)
+	other.server.URL = p.server.URL
+
+	_, err = provider.Verify(ctx, other.sign(t, "client", map[string]any{
+		"nonce":          nonce,
+		"email":          "user@example.com",
+		"email_verified": true,
+	}), nonce)
+	assert.Error(t, err)
+}
+
+func TestVerifierFromJWKS(t *testing.T) {
+	// keys without an alg and encryption keys, as served by some providers
+	jwks := `{"keys":[
+		{"kty":"RSA","use":"enc","kid":"enc","n":"sXchDaQebHnPiGvyDOAT4saGEUetSyo9MKLOoWFsueri23bOdgWp4Dy1WlUzewbgBHod5pcM9H95GQRV3JDXboIRROSBigeC5yjU1hGzHHyXss8UDprecbAYxknTcQkhslANGRUZmdTOQ5qTRsLAt6BTYuyvVRdhS8exSZEy_c4gs_7svlJJQ4H9_NxsiIoLwAEk7-Q3UXERGYw_75IDrGA84-lA_-Ct4eTlXHBIY2EaV7t7LjJaynVJCpkv4LKjTTAumiGUIuQhrNhZLuF_RJLqHpM2kgWFLU7-VTdL1VbC2tejvcI2BlMkEpk1BzBZI0KQB0GaDWFLN-aEAw3vRw","e":"AQAB"},
+		{"kty":"RSA","use":"sig","kid":"sig","n":"sXchDaQebHnPiGvyDOAT4saGEUetSyo9MKLOoWFsueri23bOdgWp4Dy1WlUzewbgBHod5pcM9H95GQRV3JDXboIRROSBigeC5yjU1hGzHHyXss8UDprecbAYxknTcQkhslANGRUZmdTOQ5qTRsLAt6BTYuyvVRdhS8exSZEy_c4gs_7svlJJQ4H9_NxsiIoLwAEk7-Q3UXERGYw_75IDrGA84-lA_-Ct4eTlXHBIY2EaV7t7LjJaynVJCpkv4LKjTTAumiGUIuQhrNhZLuF_RJLqHpM2kgWFLU7-VTdL1VbC2tejvcI2BlMkEpk1BzBZI0KQB0GaDWFLN-aEAw3vRw","e":"AQAB","x5t":"ignored"}
+	]}`
+
+	_, err := verifierFromJWKS([]byte(jwks))
+	assert.NoError(t, err)
+
+	_, err = verifierFromJWKS([]byte(`{"keys":[{"kty":"oct","k":"c2VjcmV0"}]}`))
+	assert.Error(t, err)
+}
+
+func TestParseGroupMappings(t *testing.T) {
+	mappings, err := ParseGroupMappings([]string{
+		"engineering=tenant-a:member",
+		" team=admins=tenant-a:ADMIN ",
+		"",
+		"ops=tenant-b:OWNER",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []GroupMapping{
+		{Group: "engineering", TenantId: "tenant-a", Role: "MEMBER"},
+		{Group: "team=admins", TenantId: "tenant-a", Role: "ADMIN"},
+		{Group: "ops", TenantId: "tenant-b", Role: "OWNER"},
+	}, mappings)
+
+	_, err = ParseGroupMappings([]string{"engineering"})
+	assert.Error(t, err)
+
+	_, err = ParseGroupMappings([]string{"engineering=tenant-a"})
+	assert.Error(t, err)
+
+	_, err = ParseGroupMappings([]string{"engineering=tenant-a:VIEWER"})
+	assert.ErrorContains(t, err, "invalid role")
+}
+
+func TestTenantRoles(t *testing.T) {
+	mappings := []GroupMapping{
+		{Group: "engineering", TenantId: "tenant-a", Role: "MEMBER"},
+		{Group: "admins", TenantId: "tenant-a", Role: "ADMIN"},
+		{Group: "ops", TenantId: "tenant-b", Role: "OWNER"},
+	}
+
+	assert.Equal(t, map[string]string{"tenant-a": "ADMIN"}, TenantRoles(mappings, []string{"admins", "engineering"}))
+	assert.Equal(t, map[string]string{"tenant-a": "MEMBER", "tenant-b": "OWNER"}, TenantRoles(mappings, []string{"engineering", "ops"}))
+	assert.Empty(t, TenantRoles(mappings, []string{"sales"}))
+}
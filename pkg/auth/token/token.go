@@ -10,13 +10,25 @@ import (
 
 	"github.com/hatchet-dev/hatchet/pkg/encryption"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 )
 
 type JWTManager interface {
 	GenerateTenantToken(ctx context.Context, tenantId, name string, internal bool, expires *time.Time) (*Token, error)
+
+	// GenerateScopedTenantToken generates a token which can only call the APIs of the scope
+	GenerateScopedTenantToken(ctx context.Context, tenantId, name string, scope dbsqlc.APITokenScope, expires *time.Time) (*Token, error)
+
+	// RotateTenantToken issues the token which replaces the token, which stays valid until gracePeriodEnd. The new
+	// token has the name and scope of the rotated token.
+	RotateTenantToken(ctx context.Context, tenantId, tokenId string, gracePeriodEnd time.Time, expires *time.Time) (*Token, error)
+
 	UpsertTenantToken(ctx context.Context, tenantId, name, id string, internal bool, expires *time.Time) (string, error)
 	ValidateTenantToken(ctx context.Context, token string) (string, string, error)
+
+	// ValidateScopedTenantToken validates a token like ValidateTenantToken, and returns the scope of the token as well
+	ValidateScopedTenantToken(ctx context.Context, token string) (string, string, dbsqlc.APITokenScope, error)
 }
 
 type TokenOpts struct {
@@ -84,6 +96,14 @@ func (j *jwtManagerImpl) createToken(ctx context.Context, tenantId, name string,
 }
 
 func (j *jwtManagerImpl) GenerateTenantToken(ctx context.Context, tenantId, name string, internal bool, expires *time.Time) (*Token, error) {
+	return j.generateTenantToken(ctx, tenantId, name, internal, nil, expires)
+}
+
+func (j *jwtManagerImpl) GenerateScopedTenantToken(ctx context.Context, tenantId, name string, scope dbsqlc.APITokenScope, expires *time.Time) (*Token, error) {
+	return j.generateTenantToken(ctx, tenantId, name, false, repository.StringPtr(string(scope)), expires)
+}
+
+func (j *jwtManagerImpl) generateTenantToken(ctx context.Context, tenantId, name string, internal bool, scope *string, expires *time.Time) (*Token, error) {
 	token, err := j.createToken(ctx, tenantId, name, nil, expires)
 	if err != nil {
 		return nil, err
@@ -96,6 +116,7 @@ func (j *jwtManagerImpl) GenerateTenantToken(ctx context.Context, tenantId, name
 		TenantId:  &tenantId,
 		Name:      &name,
 		Internal:  internal,
+		Scope:     scope,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to write token to database: %v", err)
@@ -104,6 +125,25 @@ func (j *jwtManagerImpl) GenerateTenantToken(ctx context.Context, tenantId, name
 	return token, nil
 }
 
+func (j *jwtManagerImpl) RotateTenantToken(ctx context.Context, tenantId, tokenId string, gracePeriodEnd time.Time, expires *time.Time) (*Token, error) {
+	token, err := j.createToken(ctx, tenantId, "", nil, expires)
+	if err != nil {
+		return nil, err
+	}
+
+	// the new token is written and the old token rotated in one transaction
+	_, err = j.tokenRepo.RotateAPIToken(ctx, tokenId, &repository.RotateAPITokenOpts{
+		ExpiresAt:    gracePeriodEnd,
+		NewID:        token.TokenId,
+		NewExpiresAt: token.ExpiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate token in database: %w", err)
+	}
+
+	return token, nil
+}
+
 func (j *jwtManagerImpl) UpsertTenantToken(ctx context.Context, tenantId, name, id string, internal bool, expires *time.Time) (string, error) {
 	token, err := j.createToken(ctx, tenantId, name, &id, expires)
 	if err != nil {
@@ -126,6 +166,12 @@ func (j *jwtManagerImpl) UpsertTenantToken(ctx context.Context, tenantId, name,
 }
 
 func (j *jwtManagerImpl) ValidateTenantToken(ctx context.Context, token string) (tenantId string, tokenUUID string, err error) {
+	tenantId, tokenUUID, _, err = j.ValidateScopedTenantToken(ctx, token)
+
+	return tenantId, tokenUUID, err
+}
+
+func (j *jwtManagerImpl) ValidateScopedTenantToken(ctx context.Context, token string) (tenantId string, tokenUUID string, scope dbsqlc.APITokenScope, err error) {
 	// Verify the signed token.
 	audience := j.opts.Audience
 
@@ -137,24 +183,24 @@ func (j *jwtManagerImpl) ValidateTenantToken(ctx context.Context, token string)
 	})
 
 	if err != nil {
-		return "", "", fmt.Errorf("failed to create JWT Validator: %v", err)
+		return "", "", "", fmt.Errorf("failed to create JWT Validator: %v", err)
 	}
 
 	verifiedJwt, err := j.verifier.VerifyAndDecode(token, validator)
 
 	if err != nil {
-		return "", "", fmt.Errorf("failed to verify and decode JWT: %v", err)
+		return "", "", "", fmt.Errorf("failed to verify and decode JWT: %v", err)
 	}
 
 	// Read the token from the database and make sure it's not revoked
 	if hasTokenId := verifiedJwt.HasStringClaim("token_id"); !hasTokenId {
-		return "", "", fmt.Errorf("token does not have token_id claim")
+		return "", "", "", fmt.Errorf("token does not have token_id claim")
 	}
 
 	tokenId, err := verifiedJwt.StringClaim("token_id")
 
 	if err != nil {
-		return "", "", fmt.Errorf("failed to read token_id claim: %v", err)
+		return "", "", "", fmt.Errorf("failed to read token_id claim: %v", err)
 	}
 
 	// ensure the current server url matches the token, if present
@@ -162,11 +208,11 @@ func (j *jwtManagerImpl) ValidateTenantToken(ctx context.Context, token string)
 		serverURL, err := verifiedJwt.StringClaim("server_url")
 
 		if err != nil {
-			return "", "", fmt.Errorf("failed to read server_url claim: %v", err)
+			return "", "", "", fmt.Errorf("failed to read server_url claim: %v", err)
 		}
 
 		if serverURL != j.opts.ServerURL {
-			return "", "", fmt.Errorf("server_url claim does not match")
+			return "", "", "", fmt.Errorf("server_url claim does not match")
 		}
 	}
 
@@ -174,29 +220,29 @@ func (j *jwtManagerImpl) ValidateTenantToken(ctx context.Context, token string)
 	dbToken, err := j.tokenRepo.GetAPITokenById(ctx, tokenId)
 
 	if err != nil {
-		return "", "", fmt.Errorf("failed to read token from database: %v", err)
+		return "", "", "", fmt.Errorf("failed to read token from database: %v", err)
 	}
 
 	if dbToken.Revoked {
-		return "", "", fmt.Errorf("token has been revoked")
+		return "", "", "", fmt.Errorf("token has been revoked")
 	}
 
 	if expiresAt := dbToken.ExpiresAt.Time; expiresAt.Before(time.Now()) {
-		return "", "", fmt.Errorf("token has expired")
+		return "", "", "", fmt.Errorf("token has expired")
 	}
 
 	// ensure the subject of the token matches the tenantId
 	if hasSubject := verifiedJwt.HasSubject(); !hasSubject {
-		return "", "", fmt.Errorf("token does not have subject claim")
+		return "", "", "", fmt.Errorf("token does not have subject claim")
 	}
 
 	subject, err := verifiedJwt.Subject()
 
 	if err != nil {
-		return "", "", fmt.Errorf("failed to read subject claim: %v", err)
+		return "", "", "", fmt.Errorf("failed to read subject claim: %v", err)
 	}
 
-	return subject, sqlchelpers.UUIDToStr(dbToken.ID), nil
+	return subject, sqlchelpers.UUIDToStr(dbToken.ID), dbToken.Scope, nil
 }
 
 func (j *jwtManagerImpl) getJWTOptionsForTenant(tenantId string, id *string, expires *time.Time) (tokenId string, expiresAt time.Time, opts *jwt.RawJWTOptions) {
@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/hatchet-dev/hatchet/internal/testutils"
@@ -17,6 +19,7 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/encryption"
 	"github.com/hatchet-dev/hatchet/pkg/random"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 )
 
 func TestCreateTenantToken(t *testing.T) { // make sure no cache is used for tests
@@ -178,6 +181,103 @@ func TestRevokeTenantTokenCache(t *testing.T) {
 	})
 }
 
+func TestRotateTenantToken(t *testing.T) {
+	_ = os.Setenv("CACHE_DURATION", "0")
+
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		jwtManager := getJWTManager(t, conf)
+
+		tenantId := uuid.New().String()
+
+		// create the tenant
+		slugSuffix, err := random.Generate(8)
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		_, err = conf.APIRepository.Tenant().CreateTenant(&repository.CreateTenantOpts{
+			ID:   &tenantId,
+			Name: "test-tenant",
+			Slug: fmt.Sprintf("test-tenant-%s", slugSuffix),
+		})
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		oldToken, err := jwtManager.GenerateScopedTenantToken(context.Background(), tenantId, "test token", dbsqlc.APITokenScopeREADONLY, nil)
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		gracePeriodEnd := time.Now().UTC().Add(time.Hour)
+
+		newToken, err := jwtManager.RotateTenantToken(context.Background(), tenantId, oldToken.TokenId, gracePeriodEnd, nil)
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		// the new token has the tenant and scope of the rotated token
+		newTenantId, _, scope, err := jwtManager.ValidateScopedTenantToken(context.Background(), newToken.Token)
+
+		assert.NoError(t, err)
+		assert.Equal(t, tenantId, newTenantId)
+		assert.Equal(t, dbsqlc.APITokenScopeREADONLY, scope)
+
+		dbNewToken, err := conf.EngineRepository.APIToken().GetAPITokenById(context.Background(), newToken.TokenId)
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		assert.Equal(t, "test token", dbNewToken.Name.String)
+		assert.False(t, dbNewToken.RotatedAt.Valid)
+
+		// the rotated token stays valid until the end of the grace period
+		_, _, err = jwtManager.ValidateTenantToken(context.Background(), oldToken.Token)
+
+		assert.NoError(t, err)
+
+		dbOldToken, err := conf.EngineRepository.APIToken().GetAPITokenById(context.Background(), oldToken.TokenId)
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		assert.True(t, dbOldToken.RotatedAt.Valid)
+		assert.WithinDuration(t, gracePeriodEnd, dbOldToken.ExpiresAt.Time, time.Second)
+
+		// a rotated token can't be rotated again, and no other token is issued
+		_, err = jwtManager.RotateTenantToken(context.Background(), tenantId, oldToken.TokenId, gracePeriodEnd, nil)
+
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		apiTokens, err := conf.APIRepository.APIToken().ListAPITokensByTenant(tenantId)
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		assert.Len(t, apiTokens, 2)
+
+		// a token rotated without a grace period is invalid right away
+		_, err = jwtManager.RotateTenantToken(context.Background(), tenantId, newToken.TokenId, time.Now().UTC(), nil)
+
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		_, _, err = jwtManager.ValidateTenantToken(context.Background(), newToken.Token)
+
+		assert.Error(t, err)
+
+		return nil
+	})
+}
+
 func getJWTManager(t *testing.T, conf *database.Config) token.JWTManager {
 	t.Helper()
 
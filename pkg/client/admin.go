@@ -9,12 +9,14 @@ import (
 	"time"
 
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/propagation"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	admincontracts "github.com/hatchet-dev/hatchet/internal/services/admin/contracts"
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
 	"github.com/hatchet-dev/hatchet/pkg/client/types"
 	"github.com/hatchet-dev/hatchet/pkg/validator"
 )
@@ -26,6 +28,9 @@ type ChildWorkflowOpts struct {
 	ChildKey           *string
 	DesiredWorkerId    *string
 	AdditionalMetadata *map[string]string
+
+	// (optional) the W3C trace context of the parent step run, which the child workflow run is part of
+	TraceContext map[string]string
 }
 
 type WorkflowRun struct {
@@ -41,12 +46,35 @@ type AdminClient interface {
 	// RunWorkflow triggers a workflow run and returns the run id
 	RunWorkflow(workflowName string, input interface{}, opts ...RunOptFunc) (*Workflow, error)
 
+	// ScheduleWorkflowRun schedules a workflow run for the given time and returns the scheduled workflow run id
+	ScheduleWorkflowRun(workflowName string, input interface{}, triggerAt time.Time, opts ...RunOptFunc) (string, error)
+
 	BulkRunWorkflow(workflows []*WorkflowRun) ([]string, error)
 
 	RunChildWorkflow(workflowName string, input interface{}, opts *ChildWorkflowOpts) (string, error)
 	RunChildWorkflows(workflows []*RunChildWorkflowsOpts) ([]string, error)
 
 	PutRateLimit(key string, opts *types.RateLimitOpts) error
+
+	// ValidateWorkflow checks a workflow definition without registering it. The workflow is valid if none of the
+	// returned diagnostics is an error.
+	ValidateWorkflow(workflow *types.Workflow) ([]WorkflowDiagnostic, error)
+}
+
+// WorkflowDiagnostic is a problem found in a workflow definition by ValidateWorkflow.
+type WorkflowDiagnostic struct {
+	// Warning is true if the workflow can be registered, but may not behave as expected
+	Warning bool
+
+	// Code identifies the problem, for example CYCLE or UNDEFINED_PARENT
+	Code string
+
+	Message string
+
+	// Job, Step and Field are set to where the problem was found, if it was found in a job, step or field
+	Job   string
+	Step  string
+	Field string
 }
 
 type DedupeViolationErr struct {
@@ -189,6 +217,16 @@ func WithRunMetadata(metadata interface{}) RunOptFunc {
 	}
 }
 
+// WithIdempotencyKey sets the idempotency key of the workflow run. If the workflow was already triggered with the same
+// key within the retention window, the existing workflow run is returned instead of a new one.
+func WithIdempotencyKey(key string) RunOptFunc {
+	return func(r *admincontracts.TriggerWorkflowRequest) error {
+		r.IdempotencyKey = &key
+
+		return nil
+	}
+}
+
 func (a *adminClientImpl) RunWorkflow(workflowName string, input interface{}, options ...RunOptFunc) (*Workflow, error) {
 	inputBytes, err := json.Marshal(input)
 
@@ -236,6 +274,47 @@ func (a *adminClientImpl) RunWorkflow(workflowName string, input interface{}, op
 	}, nil
 }
 
+func (a *adminClientImpl) ScheduleWorkflowRun(workflowName string, input interface{}, triggerAt time.Time, options ...RunOptFunc) (string, error) {
+	if !triggerAt.After(time.Now()) {
+		return "", fmt.Errorf("trigger time must be in the future")
+	}
+
+	inputBytes, err := json.Marshal(input)
+
+	if err != nil {
+		return "", fmt.Errorf("could not marshal input: %w", err)
+	}
+
+	if a.namespace != "" && !strings.HasPrefix(workflowName, a.namespace) {
+		workflowName = fmt.Sprintf("%s%s", a.namespace, workflowName)
+	}
+
+	request := admincontracts.TriggerWorkflowRequest{
+		Name:      workflowName,
+		Input:     string(inputBytes),
+		TriggerAt: timestamppb.New(triggerAt),
+	}
+
+	for _, optionFunc := range options {
+		err = optionFunc(&request)
+		if err != nil {
+			return "", fmt.Errorf("could not apply run option: %w", err)
+		}
+	}
+
+	res, err := a.client.TriggerWorkflow(a.ctx.newContext(context.Background()), &request)
+
+	if err != nil {
+		return "", fmt.Errorf("could not schedule workflow run: %w", err)
+	}
+
+	if res.ScheduledWorkflowRunId == nil {
+		return "", fmt.Errorf("workflow run %s was triggered instead of scheduled", res.WorkflowRunId)
+	}
+
+	return *res.ScheduledWorkflowRunId, nil
+}
+
 func (a *adminClientImpl) BulkRunWorkflow(workflows []*WorkflowRun) ([]string, error) {
 
 	triggerWorkflowRequests := make([]*admincontracts.TriggerWorkflowRequest, len(workflows))
@@ -292,7 +371,9 @@ func (a *adminClientImpl) RunChildWorkflow(workflowName string, input interface{
 	}
 	metadata := string(metadataBytes)
 
-	res, err := a.client.TriggerWorkflow(a.ctx.newContext(context.Background()), &admincontracts.TriggerWorkflowRequest{
+	ctx := telemetry.ExtractTraceContext(context.Background(), propagation.MapCarrier(opts.TraceContext))
+
+	res, err := a.client.TriggerWorkflow(a.ctx.newContext(ctx), &admincontracts.TriggerWorkflowRequest{
 		Name:               workflowName,
 		Input:              string(inputBytes),
 		ParentId:           &opts.ParentId,
@@ -368,7 +449,14 @@ func (a *adminClientImpl) RunChildWorkflows(workflows []*RunChildWorkflowsOpts)
 
 	}
 
-	res, err := a.client.BulkTriggerWorkflow(a.ctx.newContext(context.Background()), &admincontracts.BulkTriggerWorkflowRequest{
+	ctx := context.Background()
+
+	// the child workflow runs are spawned by the same step run, so they're part of the same trace
+	if len(workflows) > 0 {
+		ctx = telemetry.ExtractTraceContext(ctx, propagation.MapCarrier(workflows[0].Opts.TraceContext))
+	}
+
+	res, err := a.client.BulkTriggerWorkflow(a.ctx.newContext(ctx), &admincontracts.BulkTriggerWorkflowRequest{
 		Workflows: triggerWorkflowRequests,
 	})
 
@@ -401,6 +489,28 @@ func (a *adminClientImpl) PutRateLimit(key string, opts *types.RateLimitOpts) er
 		putParams.Duration = admincontracts.RateLimitDuration_SECOND
 	}
 
+	if opts.Algorithm != "" {
+		var algorithm admincontracts.RateLimitAlgorithm
+
+		switch opts.Algorithm {
+		case types.SlidingWindow:
+			algorithm = admincontracts.RateLimitAlgorithm_SLIDING_WINDOW
+		case types.TokenBucket:
+			algorithm = admincontracts.RateLimitAlgorithm_TOKEN_BUCKET
+		case types.LeakyBucket:
+			algorithm = admincontracts.RateLimitAlgorithm_LEAKY_BUCKET
+		default:
+			algorithm = admincontracts.RateLimitAlgorithm_FIXED_WINDOW
+		}
+
+		putParams.Algorithm = &algorithm
+	}
+
+	if opts.Burst != nil {
+		burst := int32(*opts.Burst) // nolint: gosec
+		putParams.Burst = &burst
+	}
+
 	_, err := a.client.PutRateLimit(a.ctx.newContext(context.Background()), putParams)
 
 	if err != nil {
@@ -410,6 +520,37 @@ func (a *adminClientImpl) PutRateLimit(key string, opts *types.RateLimitOpts) er
 	return nil
 }
 
+func (a *adminClientImpl) ValidateWorkflow(workflow *types.Workflow) ([]WorkflowDiagnostic, error) {
+	req, err := a.getPutRequest(workflow)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get put opts: %w", err)
+	}
+
+	res, err := a.client.ValidateWorkflow(a.ctx.newContext(context.Background()), &admincontracts.ValidateWorkflowRequest{
+		Opts: req.Opts,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not validate workflow %s: %w", workflow.Name, err)
+	}
+
+	diagnostics := make([]WorkflowDiagnostic, len(res.Diagnostics))
+
+	for i, d := range res.Diagnostics {
+		diagnostics[i] = WorkflowDiagnostic{
+			Warning: d.Severity == admincontracts.WorkflowDiagnosticSeverity_WARNING,
+			Code:    d.Code,
+			Message: d.Message,
+			Job:     d.GetJob(),
+			Step:    d.GetStep(),
+			Field:   d.GetField(),
+		}
+	}
+
+	return diagnostics, nil
+}
+
 func (a *adminClientImpl) getPutRequest(workflow *types.Workflow) (*admincontracts.PutWorkflowRequest, error) {
 	opts := &admincontracts.CreateWorkflowVersionOpts{
 		Name:          workflow.Name,
@@ -424,6 +565,44 @@ func (a *adminClientImpl) getPutRequest(workflow *types.Workflow) (*admincontrac
 		opts.Sticky = &s
 	}
 
+	if workflow.Region != nil {
+		opts.Region = workflow.Region
+
+		if workflow.RegionStrategy != nil {
+			s := admincontracts.RegionStrategy(*workflow.RegionStrategy)
+			opts.RegionStrategy = &s
+		}
+	}
+
+	if workflow.Pool != nil {
+		opts.Pool = workflow.Pool
+	}
+
+	if workflow.Output != nil {
+		opts.Output = workflow.Output
+	}
+
+	if workflow.InputSchema != nil {
+		opts.InputSchema = workflow.InputSchema
+	}
+
+	if workflow.ReservedSlots != nil {
+		opts.ReservedSlots = workflow.ReservedSlots
+	}
+
+	if workflow.Triggers.CronTimezone != nil {
+		opts.CronTimezone = workflow.Triggers.CronTimezone
+	}
+
+	if workflow.Triggers.CronJitter != nil {
+		jitterSeconds := int32(workflow.Triggers.CronJitter.Seconds())
+		opts.CronJitterSeconds = &jitterSeconds
+	}
+
+	if workflow.Triggers.CronExclusionCalendar != nil {
+		opts.CronExclusionCalendar = workflow.Triggers.CronExclusionCalendar
+	}
+
 	if workflow.Concurrency != nil {
 		opts.Concurrency = &admincontracts.WorkflowConcurrencyOpts{
 			Action:     workflow.Concurrency.ActionID,
@@ -437,6 +616,8 @@ func (a *adminClientImpl) getPutRequest(workflow *types.Workflow) (*admincontrac
 			limitStrat = admincontracts.ConcurrencyLimitStrategy_CANCEL_IN_PROGRESS
 		case types.GroupRoundRobin:
 			limitStrat = admincontracts.ConcurrencyLimitStrategy_GROUP_ROUND_ROBIN
+		case types.QueueNewestOnly:
+			limitStrat = admincontracts.ConcurrencyLimitStrategy_QUEUE_NEWEST_ONLY
 		default:
 			limitStrat = admincontracts.ConcurrencyLimitStrategy_CANCEL_IN_PROGRESS
 		}
@@ -507,14 +688,35 @@ func (a *adminClientImpl) getJobOpts(jobName string, job *types.WorkflowJob) (*a
 		}
 
 		stepOpt := &admincontracts.CreateWorkflowStepOpts{
-			ReadableId:        step.ID,
-			Action:            step.ActionID,
-			Timeout:           step.Timeout,
-			Inputs:            string(inputBytes),
-			Parents:           step.Parents,
-			Retries:           int32(step.Retries), // nolint: gosec
-			BackoffFactor:     step.RetryBackoffFactor,
-			BackoffMaxSeconds: step.RetryMaxBackoffSeconds,
+			ReadableId:              step.ID,
+			Action:                  step.ActionID,
+			Timeout:                 step.Timeout,
+			Inputs:                  string(inputBytes),
+			Parents:                 step.Parents,
+			Retries:                 int32(step.Retries), // nolint: gosec
+			BackoffFactor:           step.RetryBackoffFactor,
+			BackoffMaxSeconds:       step.RetryMaxBackoffSeconds,
+			BackoffInitialSeconds:   step.RetryInitialBackoffSeconds,
+			BackoffJitter:           step.RetryBackoffJitter,
+			SpeculativePercentile:   step.SpeculativePercentile,
+			SlotType:                step.SlotType,
+			ScheduleTimeout:         step.ScheduleTimeout,
+			HeartbeatTimeout:        step.HeartbeatTimeout,
+			SleepFor:                step.SleepFor,
+			SleepUntil:              step.SleepUntil,
+			WaitForEvent:            step.WaitForEvent,
+			WaitForEventCorrelation: step.WaitForEventCorrelation,
+			Condition:               step.Condition,
+			MapOver:                 step.MapOver,
+			MapConcurrency:          step.MapConcurrency,
+			CacheTtl:                step.CacheTTL,
+			InputSchema:             step.InputSchema,
+			OutputSchema:            step.OutputSchema,
+			Pool:                    step.Pool,
+		}
+
+		if step.Approval {
+			stepOpt.Approval = &step.Approval
 		}
 
 		for _, rateLimit := range step.RateLimits {
@@ -530,6 +732,11 @@ func (a *adminClientImpl) getJobOpts(jobName string, job *types.WorkflowJob) (*a
 				opt.Units = &units
 			}
 
+			if rateLimit.Global {
+				scope := admincontracts.RateLimitScope_GLOBAL
+				opt.Scope = &scope
+			}
+
 			stepOpt.RateLimits = append(stepOpt.RateLimits, opt)
 		}
 
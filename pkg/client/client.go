@@ -220,7 +220,8 @@ func NewFromConfigFile(cf *client.ClientConfigFile, fs ...ClientOpt) (Client, er
 }
 
 func newFromOpts(opts *ClientOpts) (Client, error) {
-	if opts.token == "" {
+	// workers can authenticate with a client certificate instead of a token
+	if opts.token == "" && (opts.tls == nil || len(opts.tls.Certificates) == 0) {
 		return nil, fmt.Errorf("token is required")
 	}
 
@@ -284,7 +285,10 @@ func newFromOpts(opts *ClientOpts) (Client, error) {
 	event := newEvent(conn, shared)
 
 	rest, err := rest.NewClientWithResponses(opts.serverURL, rest.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", opts.token))
+		if opts.token != "" {
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", opts.token))
+		}
+
 		return nil
 	}))
 
@@ -293,7 +297,10 @@ func newFromOpts(opts *ClientOpts) (Client, error) {
 	}
 
 	cloudrest, err := cloudrest.NewClientWithResponses(opts.serverURL, cloudrest.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", opts.token))
+		if opts.token != "" {
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", opts.token))
+		}
+
 		return nil
 	}))
 
@@ -4,6 +4,8 @@ import (
 	grpcMetadata "google.golang.org/grpc/metadata"
 
 	"context"
+
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
 )
 
 type contextLoader struct {
@@ -18,9 +20,16 @@ func newContextLoader(token string) *contextLoader {
 }
 
 func (c *contextLoader) newContext(ctx context.Context) context.Context {
-	md := grpcMetadata.New(map[string]string{
-		"authorization": "Bearer " + c.Token,
-	})
+	md := grpcMetadata.New(map[string]string{})
+
+	// workers which authenticate with a client certificate don't have a token
+	if c.Token != "" {
+		md.Set("authorization", "Bearer "+c.Token)
+	}
+
+	// the engine continues the trace of ctx, so the workflow runs and events which are created by the request are
+	// part of the caller's trace
+	telemetry.InjectTraceContext(ctx, telemetry.MetadataCarrier(md))
 
 	return grpcMetadata.NewOutgoingContext(ctx, md)
 }
@@ -30,6 +30,14 @@ type DispatcherClient interface {
 	RefreshTimeout(ctx context.Context, stepRunId string, incrementTimeoutBy string) error
 
 	UpsertWorkerLabels(ctx context.Context, workerId string, labels map[string]interface{}) error
+
+	DrainWorker(ctx context.Context, workerId string) error
+
+	GetBlob(ctx context.Context, key string) ([]byte, error)
+
+	PutStreamChunks(ctx context.Context) (StreamChunkSender, error)
+
+	ReportProgress(ctx context.Context, stepRunId string, progress *StepRunProgress) error
 }
 
 const (
@@ -45,6 +53,31 @@ type GetActionListenerRequest struct {
 	MaxRuns    *int
 	Labels     map[string]interface{}
 	WebhookId  *string
+	Region     *string
+	Pool       *string
+	SlotPools  map[string]int
+
+	// (optional) Health is called before every heartbeat, and the health which it returns is sent with the heartbeat
+	Health func() *WorkerHealth
+
+	// (optional) StepRunIds is called before every heartbeat, and returns the ids of the step runs which are running
+	// on the worker. The engine reassigns running step runs which aren't sent with the heartbeats of their worker.
+	StepRunIds func() []string
+}
+
+// WorkerHealth is the health which a worker reports with its heartbeats
+type WorkerHealth struct {
+	// (optional) the cpu utilization of the worker process, between 0 and 1
+	CpuUtilization *float64
+
+	// (optional) the memory utilization of the worker process relative to its memory limit, between 0 and 1
+	MemoryUtilization *float64
+
+	// (optional) the number of slots which are running a step
+	ActiveSlots *int
+
+	// (optional) custom gauges of the worker, keyed by name
+	Gauges map[string]float64
 }
 
 // ActionPayload unmarshals the action payload into the target. It also validates the resulting target.
@@ -112,6 +145,12 @@ type Action struct {
 
 	// the parent workflow run id
 	ParentWorkflowRunId *string
+
+	// the W3C trace context which the step run continues the trace of its workflow run in
+	TraceContext map[string]string
+
+	// the id of the dispatch of the step run, which is sent back with the completion or failure of the step run
+	AttemptId *string
 }
 
 type WorkerActionListener interface {
@@ -195,6 +234,10 @@ type actionListenerImpl struct {
 	ctx *contextLoader
 
 	listenerStrategy ListenerStrategy
+
+	health func() *WorkerHealth
+
+	stepRunIds func() []string
 }
 
 func (d *dispatcherClientImpl) newActionListener(ctx context.Context, req *GetActionListenerRequest) (*actionListenerImpl, *string, error) {
@@ -226,6 +269,8 @@ func (d *dispatcherClientImpl) newActionListener(ctx context.Context, req *GetAc
 		Actions:    req.Actions,
 		Services:   req.Services,
 		WebhookId:  req.WebhookId,
+		Region:     req.Region,
+		Pool:       req.Pool,
 		RuntimeInfo: &dispatchercontracts.RuntimeInfo{
 			Language:        dispatchercontracts.SDKS_GO.Enum(),
 			LanguageVersion: &goVersion,
@@ -244,6 +289,14 @@ func (d *dispatcherClientImpl) newActionListener(ctx context.Context, req *GetAc
 		registerReq.MaxRuns = &mr
 	}
 
+	if len(req.SlotPools) > 0 {
+		registerReq.SlotPools = make(map[string]int32, len(req.SlotPools))
+
+		for slotType, size := range req.SlotPools {
+			registerReq.SlotPools[slotType] = int32(size) // nolint: gosec
+		}
+	}
+
 	// register the worker
 	resp, err := d.client.Register(d.ctx.newContext(ctx), registerReq)
 
@@ -271,9 +324,44 @@ func (d *dispatcherClientImpl) newActionListener(ctx context.Context, req *GetAc
 		tenantId:         d.tenantId,
 		ctx:              d.ctx,
 		listenerStrategy: ListenerStrategyV2,
+		health:           req.Health,
+		stepRunIds:       req.StepRunIds,
 	}, &resp.WorkerId, nil
 }
 
+func (a *actionListenerImpl) getHealth() *dispatchercontracts.WorkerHealth {
+	if a.health == nil {
+		return nil
+	}
+
+	health := a.health()
+
+	if health == nil {
+		return nil
+	}
+
+	res := &dispatchercontracts.WorkerHealth{
+		CpuUtilization:    health.CpuUtilization,
+		MemoryUtilization: health.MemoryUtilization,
+		Gauges:            health.Gauges,
+	}
+
+	if health.ActiveSlots != nil {
+		activeSlots := int32(*health.ActiveSlots) // nolint: gosec
+		res.ActiveSlots = &activeSlots
+	}
+
+	return res
+}
+
+func (a *actionListenerImpl) getStepRunIds() []string {
+	if a.stepRunIds == nil {
+		return nil
+	}
+
+	return a.stepRunIds()
+}
+
 func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, error) {
 	ch := make(chan *Action)
 
@@ -298,6 +386,8 @@ func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, error
 					_, err := a.client.Heartbeat(a.ctx.newContext(ctx), &dispatchercontracts.HeartbeatRequest{
 						WorkerId:    a.workerId,
 						HeartbeatAt: timestamppb.New(now),
+						Health:      a.getHealth(),
+						StepRunIds:  a.getStepRunIds(),
 					})
 
 					if err != nil {
@@ -399,6 +489,8 @@ func (a *actionListenerImpl) Actions(ctx context.Context) (<-chan *Action, error
 				ChildIndex:          assignedAction.ChildWorkflowIndex,
 				ChildKey:            assignedAction.ChildWorkflowKey,
 				ParentWorkflowRunId: assignedAction.ParentWorkflowRunId,
+				TraceContext:        assignedAction.TraceContext,
+				AttemptId:           assignedAction.AttemptId,
 			}
 		}
 	}()
@@ -483,7 +575,7 @@ func (d *dispatcherClientImpl) SendStepActionEvent(ctx context.Context, in *Acti
 		actionEventType = dispatchercontracts.StepActionEventType_STEP_EVENT_TYPE_UNKNOWN
 	}
 
-	resp, err := d.client.SendStepActionEvent(d.ctx.newContext(ctx), &dispatchercontracts.StepActionEvent{
+	req := &dispatchercontracts.StepActionEvent{
 		WorkerId:       in.WorkerId,
 		JobId:          in.JobId,
 		JobRunId:       in.JobRunId,
@@ -493,10 +585,33 @@ func (d *dispatcherClientImpl) SendStepActionEvent(ctx context.Context, in *Acti
 		EventTimestamp: timestamppb.New(*in.EventTimestamp),
 		EventType:      actionEventType,
 		EventPayload:   string(payloadBytes),
-	})
+		AttemptId:      in.AttemptId,
+	}
 
-	if err != nil {
-		return nil, err
+	// the engine processes the completion or failure of an attempt once, so the event is sent again if the engine
+	// couldn't be reached, even though the engine might have received it
+	retry := in.AttemptId != nil && (in.EventType == ActionEventTypeCompleted || in.EventType == ActionEventTypeFailed)
+
+	var resp *dispatchercontracts.ActionEventResponse
+
+	for retries := 1; ; retries++ {
+		resp, err = d.client.SendStepActionEvent(d.ctx.newContext(ctx), req)
+
+		if err == nil {
+			break
+		}
+
+		if !retry || status.Code(err) != codes.Unavailable || retries >= DefaultActionListenerRetryCount {
+			return nil, err
+		}
+
+		d.l.Warn().Err(err).Msgf("could not send step action event, retrying (attempt %d/%d)", retries, DefaultActionListenerRetryCount)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(DefaultActionListenerRetryInterval):
+		}
 	}
 
 	return &ActionEventResponse{
@@ -590,6 +705,175 @@ func (a *dispatcherClientImpl) UpsertWorkerLabels(ctx context.Context, workerId
 	return nil
 }
 
+func (a *dispatcherClientImpl) DrainWorker(ctx context.Context, workerId string) error {
+	_, err := a.client.DrainWorker(a.ctx.newContext(ctx), &dispatchercontracts.WorkerDrainRequest{
+		WorkerId: workerId,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *dispatcherClientImpl) GetBlob(ctx context.Context, key string) ([]byte, error) {
+	resp, err := a.client.GetBlob(a.ctx.newContext(ctx), &dispatchercontracts.GetBlobRequest{
+		Key: key,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data, nil
+}
+
+// StepRunProgress is the progress of a long-running step run.
+type StepRunProgress struct {
+	// Percent is the percentage of the step run which is done, between 0 and 100
+	Percent float64
+
+	// (optional) Message describes the progress
+	Message *string
+
+	// (optional) Data is arbitrary data which is marshalled to JSON
+	Data interface{}
+}
+
+func (a *dispatcherClientImpl) ReportProgress(ctx context.Context, stepRunId string, progress *StepRunProgress) error {
+	req := &dispatchercontracts.ReportProgressRequest{
+		StepRunId: stepRunId,
+		Percent:   progress.Percent,
+		Message:   progress.Message,
+	}
+
+	if progress.Data != nil {
+		data, err := json.Marshal(progress.Data)
+
+		if err != nil {
+			return fmt.Errorf("could not marshal progress data: %w", err)
+		}
+
+		dataStr := string(data)
+		req.Data = &dataStr
+	}
+
+	_, err := a.client.ReportProgress(a.ctx.newContext(ctx), req)
+
+	return err
+}
+
+// StreamChunk is a piece of the incremental output of a step run.
+type StreamChunk struct {
+	StepRunId string
+
+	// Index is the position of the chunk within the step run, chunks which are sent after a chunk with a greater
+	// index are dropped by the dispatcher
+	Index int64
+
+	CreatedAt time.Time
+
+	Message []byte
+
+	// Persist stores the chunk as part of the log of the step run
+	Persist bool
+}
+
+// StreamChunkSender sends the stream chunks of step runs to the dispatcher. It's not safe for concurrent use.
+type StreamChunkSender interface {
+	// Send sends a chunk. It blocks while the dispatcher can't keep up with the chunks which were sent. If the
+	// connection to the dispatcher is lost, the chunk is sent again on a new stream.
+	Send(chunk *StreamChunk) error
+
+	// Close waits until the dispatcher received the chunks which were sent, and returns how many chunks it received
+	// on the current stream.
+	Close() (int64, error)
+}
+
+type streamChunkSenderImpl struct {
+	ctx context.Context
+	a   *dispatcherClientImpl
+
+	stream dispatchercontracts.Dispatcher_PutStreamChunksClient
+}
+
+func (a *dispatcherClientImpl) PutStreamChunks(ctx context.Context) (StreamChunkSender, error) {
+	s := &streamChunkSenderImpl{
+		ctx: ctx,
+		a:   a,
+	}
+
+	if err := s.connect(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *streamChunkSenderImpl) connect() error {
+	stream, err := s.a.client.PutStreamChunks(s.a.ctx.newContext(s.ctx))
+
+	if err != nil {
+		return fmt.Errorf("could not open stream chunk stream: %w", err)
+	}
+
+	s.stream = stream
+
+	return nil
+}
+
+func (s *streamChunkSenderImpl) Send(chunk *StreamChunk) error {
+	req := &dispatchercontracts.StreamChunk{
+		StepRunId: chunk.StepRunId,
+		Index:     chunk.Index,
+		CreatedAt: timestamppb.New(chunk.CreatedAt),
+		Message:   chunk.Message,
+		Persist:   chunk.Persist,
+	}
+
+	retries := 0
+
+	for {
+		err := s.stream.Send(req)
+
+		if err == nil {
+			return nil
+		}
+
+		// the status of a broken stream is returned when it's closed
+		_, err = s.stream.CloseAndRecv()
+
+		if status.Code(err) != codes.Unavailable || retries >= DefaultActionListenerRetryCount {
+			return fmt.Errorf("could not send stream chunk: %w", err)
+		}
+
+		retries++
+
+		s.a.l.Warn().Err(err).Msgf("lost connection to stream chunk stream, reconnecting (attempt %d/%d)", retries, DefaultActionListenerRetryCount)
+
+		select {
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		case <-time.After(DefaultActionListenerRetryInterval):
+		}
+
+		if err := s.connect(); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *streamChunkSenderImpl) Close() (int64, error) {
+	resp, err := s.stream.CloseAndRecv()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Received, nil
+}
+
 func mapLabels(req map[string]interface{}) map[string]*dispatchercontracts.WorkerLabels {
 	labels := map[string]*dispatchercontracts.WorkerLabels{}
 
@@ -3,6 +3,9 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
@@ -21,8 +24,16 @@ type EventClient interface {
 
 	BulkPush(ctx context.Context, payloads []EventWithAdditionalMetadata, options ...BulkPushOpFunc) error
 
+	// PushEvents pushes a batch of events in a single request, and returns a result for each event in the order of
+	// the payloads. Events which fail validation don't prevent the other events from being pushed.
+	PushEvents(ctx context.Context, payloads []EventWithAdditionalMetadata) ([]PushEventResult, error)
+
 	PutLog(ctx context.Context, stepRunId, msg string) error
 
+	// PutLogs puts a batch of structured log lines in a single request, and returns the number of log lines which
+	// were stored. Log lines of step runs which have reached the maximum number of log lines are dropped.
+	PutLogs(ctx context.Context, logs []*LogLine) (int, error)
+
 	PutStreamEvent(ctx context.Context, stepRunId string, message []byte) error
 }
 
@@ -30,6 +41,41 @@ type EventWithAdditionalMetadata struct {
 	Event              interface{}       `json:"event"`
 	AdditionalMetadata map[string]string `json:"metadata"`
 	Key                string            `json:"key"`
+
+	// DeliverAfter is the time after which the event triggers workflows. The event is delivered right away if it's nil.
+	DeliverAfter *time.Time `json:"deliverAfter,omitempty"`
+}
+
+// PushEventResult is the result of pushing an event with PushEvents. Either the event id or the error is set.
+type PushEventResult struct {
+	EventId string
+	Err     error
+}
+
+// LogLevel is the level of a log line.
+type LogLevel string
+
+const (
+	LogLevelDebug LogLevel = "DEBUG"
+	LogLevelInfo  LogLevel = "INFO"
+	LogLevelWarn  LogLevel = "WARN"
+	LogLevelError LogLevel = "ERROR"
+)
+
+// LogLine is a structured log line of a step run.
+type LogLine struct {
+	StepRunId string
+
+	// CreatedAt is when the log line was created. The log line is created now if it's zero.
+	CreatedAt time.Time
+
+	// Level is the level of the log line, which defaults to LogLevelInfo.
+	Level LogLevel
+
+	Message string
+
+	// Fields are stored as the metadata of the log line, and are marshalled to JSON.
+	Fields map[string]interface{}
 }
 
 type eventClientImpl struct {
@@ -72,6 +118,26 @@ func WithEventMetadata(metadata interface{}) PushOpFunc {
 	}
 }
 
+// WithEventIdempotencyKey sets the idempotency key of the event. Workflows which were already triggered by an event with
+// the same key within the retention window are not triggered again.
+func WithEventIdempotencyKey(key string) PushOpFunc {
+	return func(r *eventcontracts.PushEventRequest) error {
+		r.IdempotencyKey = &key
+
+		return nil
+	}
+}
+
+// WithEventDeliverAfter delays the delivery of the event. The event is stored right away, but it only triggers workflows
+// once the given time has passed.
+func WithEventDeliverAfter(deliverAfter time.Time) PushOpFunc {
+	return func(r *eventcontracts.PushEventRequest) error {
+		r.DeliverAfter = timestamppb.New(deliverAfter)
+
+		return nil
+	}
+}
+
 func (a *eventClientImpl) Push(ctx context.Context, eventKey string, payload interface{}, options ...PushOpFunc) error {
 
 	request := eventcontracts.PushEventRequest{
@@ -126,6 +192,7 @@ func (a *eventClientImpl) BulkPush(ctx context.Context, payload []EventWithAddit
 			EventTimestamp:     timestamppb.Now(),
 			Payload:            string(ePayload),
 			AdditionalMetadata: &eMetadataString,
+			DeliverAfter:       toDeliverAfterTimestamp(p.DeliverAfter),
 		})
 	}
 
@@ -140,6 +207,58 @@ func (a *eventClientImpl) BulkPush(ctx context.Context, payload []EventWithAddit
 	return nil
 }
 
+func (a *eventClientImpl) PushEvents(ctx context.Context, payloads []EventWithAdditionalMetadata) ([]PushEventResult, error) {
+	request := eventcontracts.PushEventsRequest{
+		Events: make([]*eventcontracts.PushEventRequest, len(payloads)),
+	}
+
+	for i, p := range payloads {
+		ePayload, err := json.Marshal(p.Event)
+
+		if err != nil {
+			return nil, err
+		}
+
+		eMetadata, err := json.Marshal(p.AdditionalMetadata)
+
+		if err != nil {
+			return nil, err
+		}
+
+		eMetadataString := string(eMetadata)
+
+		request.Events[i] = &eventcontracts.PushEventRequest{
+			Key:                a.namespace + p.Key,
+			EventTimestamp:     timestamppb.Now(),
+			Payload:            string(ePayload),
+			AdditionalMetadata: &eMetadataString,
+			DeliverAfter:       toDeliverAfterTimestamp(p.DeliverAfter),
+		}
+	}
+
+	resp, err := a.client.PushEvents(a.ctx.newContext(ctx), &request)
+
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]PushEventResult, len(payloads))
+
+	for _, r := range resp.Results {
+		if r.Index < 0 || int(r.Index) >= len(results) {
+			continue
+		}
+
+		if r.Error != nil {
+			results[r.Index].Err = errors.New(*r.Error)
+		} else if r.Event != nil {
+			results[r.Index].EventId = r.Event.EventId
+		}
+	}
+
+	return results, nil
+}
+
 func (a *eventClientImpl) PutLog(ctx context.Context, stepRunId, msg string) error {
 	_, err := a.client.PutLog(a.ctx.newContext(ctx), &eventcontracts.PutLogRequest{
 		CreatedAt: timestamppb.Now(),
@@ -150,6 +269,51 @@ func (a *eventClientImpl) PutLog(ctx context.Context, stepRunId, msg string) err
 	return err
 }
 
+func (a *eventClientImpl) PutLogs(ctx context.Context, logs []*LogLine) (int, error) {
+	req := &eventcontracts.PutLogsRequest{
+		Logs: make([]*eventcontracts.PutLogRequest, 0, len(logs)),
+	}
+
+	for _, l := range logs {
+		createdAt := l.CreatedAt
+
+		if createdAt.IsZero() {
+			createdAt = time.Now()
+		}
+
+		line := &eventcontracts.PutLogRequest{
+			CreatedAt: timestamppb.New(createdAt),
+			StepRunId: l.StepRunId,
+			Message:   l.Message,
+		}
+
+		if l.Level != "" {
+			level := string(l.Level)
+			line.Level = &level
+		}
+
+		if len(l.Fields) > 0 {
+			metadata, err := json.Marshal(l.Fields)
+
+			if err != nil {
+				return 0, fmt.Errorf("could not marshal log line fields: %w", err)
+			}
+
+			line.Metadata = string(metadata)
+		}
+
+		req.Logs = append(req.Logs, line)
+	}
+
+	resp, err := a.client.PutLogs(a.ctx.newContext(ctx), req)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.Stored), nil
+}
+
 func (a *eventClientImpl) PutStreamEvent(ctx context.Context, stepRunId string, message []byte) error {
 	_, err := a.client.PutStreamEvent(a.ctx.newContext(ctx), &eventcontracts.PutStreamEventRequest{
 		CreatedAt: timestamppb.Now(),
@@ -159,3 +323,11 @@ func (a *eventClientImpl) PutStreamEvent(ctx context.Context, stepRunId string,
 
 	return err
 }
+
+func toDeliverAfterTimestamp(deliverAfter *time.Time) *timestamppb.Timestamp {
+	if deliverAfter == nil {
+		return nil
+	}
+
+	return timestamppb.New(*deliverAfter)
+}
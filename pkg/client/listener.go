@@ -258,96 +258,93 @@ func newSubscribe(conn *grpc.ClientConn, opts *sharedClientOpts) SubscribeClient
 }
 
 func (r *subscribeClientImpl) On(ctx context.Context, workflowRunId string, handler RunHandler) error {
-	stream, err := r.client.SubscribeToWorkflowEvents(r.ctx.newContext(ctx), &dispatchercontracts.SubscribeToWorkflowEventsRequest{
+	return r.subscribeToWorkflowEvents(ctx, &dispatchercontracts.SubscribeToWorkflowEventsRequest{
 		WorkflowRunId: &workflowRunId,
-	}, grpc_retry.Disable())
-
-	if err != nil {
-		return err
-	}
-
-	for {
-		event, err := stream.Recv()
-
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return nil
-			}
-
-			return err
-		}
-
+	}, func(event *dispatchercontracts.WorkflowEvent) error {
 		if event.EventType == dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_STREAM {
-			continue
+			return nil
 		}
 
-		if err := handler(event); err != nil {
-			return err
-		}
-	}
+		return handler(event)
+	})
 }
 
 func (r *subscribeClientImpl) Stream(ctx context.Context, workflowRunId string, handler StreamHandler) error {
-	stream, err := r.client.SubscribeToWorkflowEvents(r.ctx.newContext(ctx), &dispatchercontracts.SubscribeToWorkflowEventsRequest{
+	return r.subscribeToWorkflowEvents(ctx, &dispatchercontracts.SubscribeToWorkflowEventsRequest{
 		WorkflowRunId: &workflowRunId,
-	}, grpc_retry.Disable())
-
-	if err != nil {
-		return err
-	}
-
-	for {
-		event, err := stream.Recv()
-
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return nil
-			}
+	}, streamEventHandler(handler))
+}
 
-			return err
-		}
+func (r *subscribeClientImpl) StreamByAdditionalMetadata(ctx context.Context, key string, value string, handler StreamHandler) error {
+	return r.subscribeToWorkflowEvents(ctx, &dispatchercontracts.SubscribeToWorkflowEventsRequest{
+		AdditionalMetaKey:   &key,
+		AdditionalMetaValue: &value,
+	}, streamEventHandler(handler))
+}
 
+func streamEventHandler(handler StreamHandler) func(event *dispatchercontracts.WorkflowEvent) error {
+	return func(event *dispatchercontracts.WorkflowEvent) error {
 		if event.EventType != dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_STREAM {
-			continue
+			return nil
 		}
 
-		if err := handler(StreamEvent{
+		return handler(StreamEvent{
 			Message: []byte(event.EventPayload),
-		}); err != nil {
-			return err
-		}
+		})
 	}
 }
 
-func (r *subscribeClientImpl) StreamByAdditionalMetadata(ctx context.Context, key string, value string, handler StreamHandler) error {
-	stream, err := r.client.SubscribeToWorkflowEvents(r.ctx.newContext(ctx), &dispatchercontracts.SubscribeToWorkflowEventsRequest{
-		AdditionalMetaKey:   &key,
-		AdditionalMetaValue: &value,
-	})
-
-	if err != nil {
-		return err
-	}
+// subscribeToWorkflowEvents calls the handler with the workflow events of the subscription until the server hangs
+// up. If the connection to the server is lost, it resubscribes with the resume token of the last event it received,
+// so the events which were sent in the meantime are received without duplicates.
+func (r *subscribeClientImpl) subscribeToWorkflowEvents(
+	ctx context.Context,
+	req *dispatchercontracts.SubscribeToWorkflowEventsRequest,
+	handler func(event *dispatchercontracts.WorkflowEvent) error,
+) error {
+	retries := 0
 
 	for {
-		event, err := stream.Recv()
+		stream, err := r.client.SubscribeToWorkflowEvents(r.ctx.newContext(ctx), req, grpc_retry.Disable())
+
+		if err == nil {
+			for {
+				var event *dispatchercontracts.WorkflowEvent
+
+				event, err = stream.Recv()
+
+				if err != nil {
+					break
+				}
+
+				retries = 0
+
+				if event.ResumeToken != "" {
+					req.ResumeToken = &event.ResumeToken
+				}
+
+				if err := handler(event); err != nil {
+					return err
+				}
+			}
 
-		if err != nil {
 			if errors.Is(err, io.EOF) {
 				return nil
 			}
+		}
 
+		if status.Code(err) != codes.Unavailable || retries >= DefaultActionListenerRetryCount {
 			return err
 		}
 
-		if event.EventType != dispatchercontracts.ResourceEventType_RESOURCE_EVENT_TYPE_STREAM {
-			continue
-		}
+		retries++
 
-		if err := handler(StreamEvent{
-			Message: []byte(event.EventPayload),
-		}); err != nil {
-			return err
+		r.l.Warn().Err(err).Msgf("lost connection to workflow event subscription, resubscribing (attempt %d/%d)", retries, DefaultActionListenerRetryCount)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(DefaultActionListenerRetryInterval):
 		}
 	}
 }
@@ -55,23 +55,35 @@ func GetClientConfigFromConfigFile(cf *client.ClientConfigFile) (res *client.Cli
 		return nil, fmt.Errorf("could not load config from viper: %w", err)
 	}
 
-	// if token is empty, throw an error
+	// if token is empty, throw an error unless the worker authenticates with a client certificate
 	if cf.Token == "" {
-		return nil, fmt.Errorf("API token is required. Set it via the HATCHET_CLIENT_TOKEN environment variable.")
+		if cf.TLS.Base.TLSStrategy != "mtls" {
+			return nil, fmt.Errorf("API token is required. Set it via the HATCHET_CLIENT_TOKEN environment variable.")
+		}
+
+		if cf.TenantId == "" {
+			return nil, fmt.Errorf("tenant id is required when authenticating with a client certificate. Set it via the HATCHET_CLIENT_TENANT_ID environment variable.")
+		}
 	}
 
 	grpcBroadcastAddress := cf.HostPort
 	serverURL := cf.HostPort
 
-	tokenConf, err := getConfFromJWT(cf.Token)
+	var tokenTenantId string
 
-	if err == nil {
-		if grpcBroadcastAddress == "" && tokenConf.grpcBroadcastAddress != "" {
-			grpcBroadcastAddress = tokenConf.grpcBroadcastAddress
-		}
+	if cf.Token != "" {
+		tokenConf, err := getConfFromJWT(cf.Token)
+
+		if err == nil {
+			if grpcBroadcastAddress == "" && tokenConf.grpcBroadcastAddress != "" {
+				grpcBroadcastAddress = tokenConf.grpcBroadcastAddress
+			}
+
+			if tokenConf.serverURL != "" {
+				serverURL = tokenConf.serverURL
+			}
 
-		if tokenConf.serverURL != "" {
-			serverURL = tokenConf.serverURL
+			tokenTenantId = tokenConf.tenantId
 		}
 	}
 
@@ -81,7 +93,7 @@ func GetClientConfigFromConfigFile(cf *client.ClientConfigFile) (res *client.Cli
 	}
 
 	if cf.TenantId == "" {
-		cf.TenantId = tokenConf.tenantId
+		cf.TenantId = tokenTenantId
 	}
 
 	tlsServerName := cf.TLS.TLSServerName
@@ -23,6 +23,19 @@ const (
 	CookieAuthScopes = "cookieAuth.Scopes"
 )
 
+// Defines values for APITokenScope.
+const (
+	APITokenScopeADMIN    APITokenScope = "ADMIN"
+	APITokenScopeREADONLY APITokenScope = "READ_ONLY"
+	APITokenScopeWORKER   APITokenScope = "WORKER"
+)
+
+// Defines values for AuditLogActorKind.
+const (
+	AuditLogActorKindAPITOKEN AuditLogActorKind = "API_TOKEN"
+	AuditLogActorKindUSER     AuditLogActorKind = "USER"
+)
+
 // Defines values for CronWorkflowsMethod.
 const (
 	CronWorkflowsMethodAPI     CronWorkflowsMethod = "API"
@@ -46,6 +59,42 @@ const (
 	EventOrderByFieldCreatedAt EventOrderByField = "createdAt"
 )
 
+// Defines values for EventSinkFormat.
+const (
+	EventSinkFormatCLOUDEVENTS EventSinkFormat = "CLOUDEVENTS"
+	EventSinkFormatHATCHET     EventSinkFormat = "HATCHET"
+)
+
+// Defines values for EventSinkKind.
+const (
+	EventSinkKindKAFKA   EventSinkKind = "KAFKA"
+	EventSinkKindNATS    EventSinkKind = "NATS"
+	EventSinkKindWEBHOOK EventSinkKind = "WEBHOOK"
+)
+
+// Defines values for EventSinkRecordType.
+const (
+	EventSinkRecordTypeAuditLogCreated      EventSinkRecordType = "audit_log.created"
+	EventSinkRecordTypeEventCreated         EventSinkRecordType = "event.created"
+	EventSinkRecordTypeStepRunCancelled     EventSinkRecordType = "step_run.cancelled"
+	EventSinkRecordTypeStepRunCompleted     EventSinkRecordType = "step_run.completed"
+	EventSinkRecordTypeStepRunFailed        EventSinkRecordType = "step_run.failed"
+	EventSinkRecordTypeStepRunStarted       EventSinkRecordType = "step_run.started"
+	EventSinkRecordTypeStepRunTimedOut      EventSinkRecordType = "step_run.timed_out"
+	EventSinkRecordTypeWorkflowRunCancelled EventSinkRecordType = "workflow_run.cancelled"
+	EventSinkRecordTypeWorkflowRunFailed    EventSinkRecordType = "workflow_run.failed"
+	EventSinkRecordTypeWorkflowRunQueued    EventSinkRecordType = "workflow_run.queued"
+	EventSinkRecordTypeWorkflowRunSucceeded EventSinkRecordType = "workflow_run.succeeded"
+)
+
+// Defines values for InboundWebhookSignatureScheme.
+const (
+	InboundWebhookSignatureSchemeGITHUB     InboundWebhookSignatureScheme = "GITHUB"
+	InboundWebhookSignatureSchemeHMACSHA256 InboundWebhookSignatureScheme = "HMAC_SHA256"
+	InboundWebhookSignatureSchemeSTRIPE     InboundWebhookSignatureScheme = "STRIPE"
+	InboundWebhookSignatureSchemeSVIX       InboundWebhookSignatureScheme = "SVIX"
+)
+
 // Defines values for JobRunStatus.
 const (
 	JobRunStatusCANCELLED JobRunStatus = "CANCELLED"
@@ -110,6 +159,21 @@ const (
 	ScheduledWorkflowsOrderByFieldTriggerAt ScheduledWorkflowsOrderByField = "triggerAt"
 )
 
+// Defines values for SchedulingDecisionOutcome.
+const (
+	SchedulingDecisionOutcomeASSIGNED    SchedulingDecisionOutcome = "ASSIGNED"
+	SchedulingDecisionOutcomeNOSLOTS     SchedulingDecisionOutcome = "NO_SLOTS"
+	SchedulingDecisionOutcomeRATELIMITED SchedulingDecisionOutcome = "RATE_LIMITED"
+)
+
+// Defines values for StepRunApprovalStatus.
+const (
+	StepRunApprovalStatusAPPROVED StepRunApprovalStatus = "APPROVED"
+	StepRunApprovalStatusPENDING  StepRunApprovalStatus = "PENDING"
+	StepRunApprovalStatusREJECTED StepRunApprovalStatus = "REJECTED"
+	StepRunApprovalStatusTIMEDOUT StepRunApprovalStatus = "TIMED_OUT"
+)
+
 // Defines values for StepRunEventReason.
 const (
 	StepRunEventReasonACKNOWLEDGED                 StepRunEventReason = "ACKNOWLEDGED"
@@ -124,6 +188,7 @@ const (
 	StepRunEventReasonRETRYING                     StepRunEventReason = "RETRYING"
 	StepRunEventReasonSCHEDULINGTIMEDOUT           StepRunEventReason = "SCHEDULING_TIMED_OUT"
 	StepRunEventReasonSLOTRELEASED                 StepRunEventReason = "SLOT_RELEASED"
+	StepRunEventReasonSPECULATIVEATTEMPT           StepRunEventReason = "SPECULATIVE_ATTEMPT"
 	StepRunEventReasonSTARTED                      StepRunEventReason = "STARTED"
 	StepRunEventReasonTIMEDOUT                     StepRunEventReason = "TIMED_OUT"
 	StepRunEventReasonTIMEOUTREFRESHED             StepRunEventReason = "TIMEOUT_REFRESHED"
@@ -147,30 +212,39 @@ const (
 	StepRunStatusPENDING           StepRunStatus = "PENDING"
 	StepRunStatusPENDINGASSIGNMENT StepRunStatus = "PENDING_ASSIGNMENT"
 	StepRunStatusRUNNING           StepRunStatus = "RUNNING"
+	StepRunStatusSKIPPED           StepRunStatus = "SKIPPED"
 	StepRunStatusSUCCEEDED         StepRunStatus = "SUCCEEDED"
 )
 
 // Defines values for TenantMemberRole.
 const (
-	ADMIN  TenantMemberRole = "ADMIN"
-	MEMBER TenantMemberRole = "MEMBER"
-	OWNER  TenantMemberRole = "OWNER"
+	TenantMemberRoleADMIN  TenantMemberRole = "ADMIN"
+	TenantMemberRoleMEMBER TenantMemberRole = "MEMBER"
+	TenantMemberRoleOWNER  TenantMemberRole = "OWNER"
+)
+
+// Defines values for TenantQuotaResource.
+const (
+	TenantQuotaResourceEVENTSPERMINUTE  TenantQuotaResource = "EVENTS_PER_MINUTE"
+	TenantQuotaResourceQUEUEDRUNS       TenantQuotaResource = "QUEUED_RUNS"
+	TenantQuotaResourceWORKERS          TenantQuotaResource = "WORKERS"
+	TenantQuotaResourceWORKFLOWVERSIONS TenantQuotaResource = "WORKFLOW_VERSIONS"
 )
 
 // Defines values for TenantResource.
 const (
-	CRON        TenantResource = "CRON"
-	EVENT       TenantResource = "EVENT"
-	SCHEDULE    TenantResource = "SCHEDULE"
-	WORKER      TenantResource = "WORKER"
-	WORKFLOWRUN TenantResource = "WORKFLOW_RUN"
+	TenantResourceCRON        TenantResource = "CRON"
+	TenantResourceEVENT       TenantResource = "EVENT"
+	TenantResourceSCHEDULE    TenantResource = "SCHEDULE"
+	TenantResourceWORKER      TenantResource = "WORKER"
+	TenantResourceWORKFLOWRUN TenantResource = "WORKFLOW_RUN"
 )
 
 // Defines values for WorkerStatus.
 const (
-	ACTIVE   WorkerStatus = "ACTIVE"
-	INACTIVE WorkerStatus = "INACTIVE"
-	PAUSED   WorkerStatus = "PAUSED"
+	WorkerStatusACTIVE   WorkerStatus = "ACTIVE"
+	WorkerStatusINACTIVE WorkerStatus = "INACTIVE"
+	WorkerStatusPAUSED   WorkerStatus = "PAUSED"
 )
 
 // Defines values for WorkerType.
@@ -193,6 +267,7 @@ const (
 	DROPNEWEST       WorkflowConcurrencyLimitStrategy = "DROP_NEWEST"
 	GROUPROUNDROBIN  WorkflowConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
 	QUEUENEWEST      WorkflowConcurrencyLimitStrategy = "QUEUE_NEWEST"
+	QUEUENEWESTONLY  WorkflowConcurrencyLimitStrategy = "QUEUE_NEWEST_ONLY"
 )
 
 // Defines values for WorkflowKind.
@@ -220,12 +295,30 @@ const (
 const (
 	CANCELLED WorkflowRunStatus = "CANCELLED"
 	FAILED    WorkflowRunStatus = "FAILED"
+	PAUSED    WorkflowRunStatus = "PAUSED"
 	PENDING   WorkflowRunStatus = "PENDING"
 	QUEUED    WorkflowRunStatus = "QUEUED"
 	RUNNING   WorkflowRunStatus = "RUNNING"
 	SUCCEEDED WorkflowRunStatus = "SUCCEEDED"
 )
 
+// Defines values for WorkflowRunStreamEventType.
+const (
+	WorkflowRunStreamEventTypeCANCELLED WorkflowRunStreamEventType = "CANCELLED"
+	WorkflowRunStreamEventTypeCOMPLETED WorkflowRunStreamEventType = "COMPLETED"
+	WorkflowRunStreamEventTypeFAILED    WorkflowRunStreamEventType = "FAILED"
+	WorkflowRunStreamEventTypePROGRESS  WorkflowRunStreamEventType = "PROGRESS"
+	WorkflowRunStreamEventTypeSTARTED   WorkflowRunStreamEventType = "STARTED"
+	WorkflowRunStreamEventTypeSTREAM    WorkflowRunStreamEventType = "STREAM"
+	WorkflowRunStreamEventTypeTIMEDOUT  WorkflowRunStreamEventType = "TIMED_OUT"
+)
+
+// Defines values for WorkflowRunStreamResourceType.
+const (
+	WorkflowRunStreamResourceTypeSTEPRUN     WorkflowRunStreamResourceType = "STEP_RUN"
+	WorkflowRunStreamResourceTypeWORKFLOWRUN WorkflowRunStreamResourceType = "WORKFLOW_RUN"
+)
+
 // APIError defines model for APIError.
 type APIError struct {
 	// Code a custom Hatchet error code
@@ -268,6 +361,9 @@ type APIMeta struct {
 
 // APIMetaAuth defines model for APIMetaAuth.
 type APIMetaAuth struct {
+	// OidcProviderName the name of the single sign-on provider, if the oidc scheme is supported
+	OidcProviderName *string `json:"oidcProviderName,omitempty"`
+
 	// Schemes the supported types of authentication
 	Schemes *[]string `json:"schemes,omitempty"`
 }
@@ -310,13 +406,92 @@ type APIToken struct {
 
 	// Name The name of the API token.
 	Name string `json:"name"`
+
+	// RotatedAt When the API token was rotated, the token stays valid until it expires.
+	RotatedAt *time.Time    `json:"rotatedAt,omitempty"`
+	Scope     APITokenScope `json:"scope"`
 }
 
+// APITokenScope What the API token can be used for. Admin tokens can call the REST API and the engine, worker tokens can only call the engine and read-only tokens can only read from the REST API.
+type APITokenScope string
+
 // AcceptInviteRequest defines model for AcceptInviteRequest.
 type AcceptInviteRequest struct {
 	Invite string `json:"invite" validate:"required,uuid"`
 }
 
+// AuditLog defines model for AuditLog.
+type AuditLog struct {
+	// ActorId The id of the user or API token which called the API.
+	ActorId   openapi_types.UUID `json:"actorId"`
+	ActorKind AuditLogActorKind  `json:"actorKind"`
+
+	// ActorName The email of the user or the name of the API token when the API was called.
+	ActorName *string `json:"actorName,omitempty"`
+
+	// IpAddress The IP address of the client.
+	IpAddress *string         `json:"ipAddress,omitempty"`
+	Metadata  APIResourceMeta `json:"metadata"`
+
+	// Method The HTTP method of the request.
+	Method string `json:"method"`
+
+	// Operation The operation id of the API call.
+	Operation string `json:"operation"`
+
+	// Path The path of the request.
+	Path string `json:"path"`
+
+	// ResourceId The id of the resource of the request path which the call operated on.
+	ResourceId *string `json:"resourceId,omitempty"`
+
+	// ResourceType The type of the resource of the request path which the call operated on, like workflow or step-run.
+	ResourceType *string `json:"resourceType,omitempty"`
+
+	// StatusCode The status code of the response.
+	StatusCode int `json:"statusCode"`
+
+	// UserAgent The user agent of the client.
+	UserAgent *string `json:"userAgent,omitempty"`
+}
+
+// AuditLogActorKind defines model for AuditLogActorKind.
+type AuditLogActorKind string
+
+// AuditLogList defines model for AuditLogList.
+type AuditLogList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]AuditLog         `json:"rows,omitempty"`
+}
+
+// BatchCreateEventRequest defines model for BatchCreateEventRequest.
+type BatchCreateEventRequest struct {
+	// Events The events to create, at most 1000 events can be created in a single request.
+	Events []CreateEventRequest `json:"events" validate:"required,min=1,max=1000"`
+}
+
+// BatchCreateEventResponse defines model for BatchCreateEventResponse.
+type BatchCreateEventResponse struct {
+	// Failed The number of events which failed.
+	Failed int `json:"failed"`
+
+	// Results The results of the events, in the order of the request.
+	Results []BatchCreateEventResult `json:"results"`
+
+	// Succeeded The number of events which were created.
+	Succeeded int `json:"succeeded"`
+}
+
+// BatchCreateEventResult defines model for BatchCreateEventResult.
+type BatchCreateEventResult struct {
+	// Error The reason the event wasn't created, if it failed.
+	Error *string `json:"error,omitempty"`
+	Event *Event  `json:"event,omitempty"`
+
+	// Index The index of the event in the request.
+	Index int `json:"index"`
+}
+
 // BulkCreateEventRequest defines model for BulkCreateEventRequest.
 type BulkCreateEventRequest struct {
 	Events []CreateEventRequest `json:"events"`
@@ -334,13 +509,35 @@ type CancelEventRequest struct {
 	EventIds []openapi_types.UUID `json:"eventIds"`
 }
 
+// ClientCA defines model for ClientCA.
+type ClientCA struct {
+	// Certificate The PEM encoded CA certificate which signs the client certificates of the workers.
+	Certificate string `json:"certificate"`
+
+	// ExpiresAt When the CA certificate expires, client certificates aren't accepted after this time.
+	ExpiresAt time.Time       `json:"expiresAt"`
+	Metadata  APIResourceMeta `json:"metadata"`
+
+	// Name The name of the client CA.
+	Name string `json:"name"`
+
+	// SpiffeIdPrefix The prefix of the SPIFFE IDs of the client certificates which are verified against this CA, like spiffe://example.org/workers/.
+	SpiffeIdPrefix string `json:"spiffeIdPrefix"`
+}
+
+// ClientCAList defines model for ClientCAList.
+type ClientCAList struct {
+	Rows *[]ClientCA `json:"rows,omitempty"`
+}
+
 // CreateAPITokenRequest defines model for CreateAPITokenRequest.
 type CreateAPITokenRequest struct {
 	// ExpiresIn The duration for which the token is valid.
 	ExpiresIn *string `json:"expiresIn,omitempty" validate:"omitnil,duration"`
 
 	// Name A name for the API token.
-	Name string `json:"name"`
+	Name  string         `json:"name"`
+	Scope *APITokenScope `json:"scope,omitempty"`
 }
 
 // CreateAPITokenResponse defines model for CreateAPITokenResponse.
@@ -349,12 +546,45 @@ type CreateAPITokenResponse struct {
 	Token string `json:"token"`
 }
 
+// CreateClientCARequest defines model for CreateClientCARequest.
+type CreateClientCARequest struct {
+	// Certificate The PEM encoded CA certificate which signs the client certificates of the workers.
+	Certificate string `json:"certificate" validate:"required"`
+
+	// Name A name for the client CA.
+	Name string `json:"name" validate:"required,hatchetName"`
+
+	// SpiffeIdPrefix The prefix of the SPIFFE IDs of the client certificates which are verified against this CA. Prefixes can't overlap with the prefixes of the client CAs of other tenants.
+	SpiffeIdPrefix string `json:"spiffeIdPrefix" validate:"required,startswith=spiffe://"`
+}
+
+// CreateCronExclusionCalendarRequest defines model for CreateCronExclusionCalendarRequest.
+type CreateCronExclusionCalendarRequest struct {
+	// Dates The dates on which crons which reference the calendar don't trigger.
+	Dates *[]openapi_types.Date `json:"dates,omitempty"`
+
+	// IcalUrl An iCal feed whose events are excluded, in addition to the dates.
+	IcalUrl *string `json:"icalUrl,omitempty" validate:"omitnil,url"`
+
+	// Name The name of the calendar, which is unique within the tenant.
+	Name string `json:"name" validate:"required,hatchetName"`
+}
+
 // CreateCronWorkflowTriggerRequest defines model for CreateCronWorkflowTriggerRequest.
 type CreateCronWorkflowTriggerRequest struct {
 	AdditionalMetadata map[string]interface{} `json:"additionalMetadata"`
 	CronExpression     string                 `json:"cronExpression"`
 	CronName           string                 `json:"cronName"`
-	Input              map[string]interface{} `json:"input"`
+
+	// ExclusionCalendarId The ID of an exclusion calendar whose dates the cron skips, for example holidays
+	ExclusionCalendarId *openapi_types.UUID    `json:"exclusionCalendarId,omitempty"`
+	Input               map[string]interface{} `json:"input"`
+
+	// JitterSeconds The maximum number of seconds each trigger is randomly delayed by
+	JitterSeconds *int `json:"jitterSeconds,omitempty" validate:"omitnil,min=0,max=3600"`
+
+	// Timezone The IANA timezone the cron expression is evaluated in, defaults to UTC
+	Timezone *string `json:"timezone,omitempty" validate:"omitnil,timezone"`
 }
 
 // CreateEventRequest defines model for CreateEventRequest.
@@ -365,16 +595,94 @@ type CreateEventRequest struct {
 	// Data The data for the event.
 	Data map[string]interface{} `json:"data"`
 
+	// DeliverAfter The time after which the event triggers workflows. The event is stored right away, but it is only delivered once this time has passed.
+	DeliverAfter *time.Time `json:"deliverAfter,omitempty"`
+
 	// Key The key for the event.
 	Key string `json:"key"`
 }
 
+// CreateEventSinkRequest defines model for CreateEventSinkRequest.
+type CreateEventSinkRequest struct {
+	// Brokers The brokers of the Kafka cluster, like kafka.example.com:9092. They're required for KAFKA sinks.
+	Brokers *[]string `json:"brokers,omitempty" validate:"omitempty,max=16,dive,hostname_port"`
+
+	// EventTypes The types of the records which are delivered to the sink. All records except audit log records are delivered if it's not set.
+	EventTypes *[]EventSinkRecordType `json:"eventTypes,omitempty"`
+	Format     *EventSinkFormat       `json:"format,omitempty"`
+	Kind       EventSinkKind          `json:"kind"`
+
+	// MaxAttempts The number of attempts after which a delivery is dead-lettered, defaults to 10.
+	MaxAttempts *int `json:"maxAttempts,omitempty" validate:"omitnil,min=1,max=100"`
+
+	// Name The name of the sink, which is unique within the tenant.
+	Name string `json:"name" validate:"required,hatchetName"`
+
+	// PasswordSecret The name of the tenant secret which stores the password of the Kafka or NATS connection, or the NATS token if no username is set.
+	PasswordSecret *string `json:"passwordSecret,omitempty" validate:"omitnil,min=1,max=255"`
+
+	// Tls Whether the connections to the Kafka brokers use TLS.
+	Tls *bool `json:"tls,omitempty"`
+
+	// Topic The Kafka topic, or the prefix of the NATS subjects, which records are published to. It's required for the KAFKA and NATS sinks.
+	Topic *string `json:"topic,omitempty" validate:"omitnil,min=1,max=249"`
+
+	// Url The URL of the webhook, or of the NATS server like nats://nats.example.com:4222. It's required for the WEBHOOK and NATS sinks.
+	Url *string `json:"url,omitempty" validate:"omitnil,url,max=2048"`
+
+	// UsernameSecret The name of the tenant secret which stores the username of the Kafka or NATS connection.
+	UsernameSecret *string `json:"usernameSecret,omitempty" validate:"omitnil,min=1,max=255"`
+}
+
+// CreateInboundWebhookRequest defines model for CreateInboundWebhookRequest.
+type CreateInboundWebhookRequest struct {
+	// DataExpression A CEL expression which evaluates to the data of the events. The body of the request is used if it's not set.
+	DataExpression *string `json:"dataExpression,omitempty" validate:"omitnil,min=1,max=4096"`
+
+	// EventKey The key of the events which requests are ingested as. Exactly one of the event key and the event key expression must be set.
+	EventKey *string `json:"eventKey,omitempty" validate:"omitnil,min=1,max=255"`
+
+	// EventKeyExpression A CEL expression which evaluates to the key of the events which requests are ingested as, like `"github:" + headers["x-github-event"]`.
+	EventKeyExpression *string `json:"eventKeyExpression,omitempty" validate:"omitnil,min=1,max=1024"`
+
+	// Name The name of the webhook, which is unique within the tenant.
+	Name string `json:"name" validate:"required,hatchetName"`
+
+	// Secret The signing secret. It's required for the STRIPE and SVIX schemes, a random secret is generated for the other schemes if it's not set.
+	Secret *string `json:"secret,omitempty" validate:"omitnil,min=1,max=1024"`
+
+	// SignatureHeader The header which contains the signature, required for the HMAC_SHA256 scheme.
+	SignatureHeader *string                       `json:"signatureHeader,omitempty" validate:"omitnil,min=1,max=255"`
+	SignatureScheme InboundWebhookSignatureScheme `json:"signatureScheme"`
+}
+
 // CreateSNSIntegrationRequest defines model for CreateSNSIntegrationRequest.
 type CreateSNSIntegrationRequest struct {
 	// TopicArn The Amazon Resource Name (ARN) of the SNS topic.
 	TopicArn string `json:"topicArn" validate:"required,min=1,max=256"`
 }
 
+// CreateSQSIntegrationRequest defines model for CreateSQSIntegrationRequest.
+type CreateSQSIntegrationRequest struct {
+	// AccessKeyIdSecret The name of the tenant secret which stores the AWS access key ID.
+	AccessKeyIdSecret string `json:"accessKeyIdSecret" validate:"required,min=1,max=255"`
+
+	// EventKey The key of the events which messages are ingested as.
+	EventKey string `json:"eventKey" validate:"required,min=1,max=255"`
+
+	// QueueUrl The URL of the SQS queue.
+	QueueUrl string `json:"queueUrl" validate:"required,url,max=1024"`
+
+	// Region The AWS region of the SQS queue.
+	Region string `json:"region" validate:"required,min=1,max=64"`
+
+	// SecretAccessKeySecret The name of the tenant secret which stores the AWS secret access key.
+	SecretAccessKeySecret string `json:"secretAccessKeySecret" validate:"required,min=1,max=255"`
+
+	// SessionTokenSecret The name of the tenant secret which stores an AWS session token, only required for temporary credentials.
+	SessionTokenSecret *string `json:"sessionTokenSecret,omitempty" validate:"omitnil,min=1,max=255"`
+}
+
 // CreateTenantAlertEmailGroupRequest defines model for CreateTenantAlertEmailGroupRequest.
 type CreateTenantAlertEmailGroupRequest struct {
 	// Emails A list of emails for users
@@ -397,19 +705,49 @@ type CreateTenantRequest struct {
 	Slug string `json:"slug" validate:"required,hatchetName"`
 }
 
+// CronExclusionCalendar defines model for CronExclusionCalendar.
+type CronExclusionCalendar struct {
+	// Dates The dates on which crons which reference the calendar don't trigger.
+	Dates []openapi_types.Date `json:"dates"`
+
+	// IcalUrl An iCal feed whose events are excluded, in addition to the dates.
+	IcalUrl  *string         `json:"icalUrl,omitempty"`
+	Metadata APIResourceMeta `json:"metadata"`
+
+	// Name The name of the calendar, which is unique within the tenant.
+	Name string `json:"name"`
+
+	// TenantId The ID of the tenant associated with this calendar.
+	TenantId string `json:"tenantId"`
+}
+
+// CronExclusionCalendarList defines model for CronExclusionCalendarList.
+type CronExclusionCalendarList struct {
+	Rows *[]CronExclusionCalendar `json:"rows,omitempty"`
+}
+
 // CronWorkflows defines model for CronWorkflows.
 type CronWorkflows struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
 	Cron               string                  `json:"cron"`
 	Enabled            bool                    `json:"enabled"`
-	Input              *map[string]interface{} `json:"input,omitempty"`
-	Metadata           APIResourceMeta         `json:"metadata"`
-	Method             CronWorkflowsMethod     `json:"method"`
-	Name               *string                 `json:"name,omitempty"`
-	TenantId           string                  `json:"tenantId"`
-	WorkflowId         string                  `json:"workflowId"`
-	WorkflowName       string                  `json:"workflowName"`
-	WorkflowVersionId  string                  `json:"workflowVersionId"`
+
+	// ExclusionCalendarId The ID of the exclusion calendar whose dates the cron skips
+	ExclusionCalendarId *string                 `json:"exclusionCalendarId,omitempty"`
+	Input               *map[string]interface{} `json:"input,omitempty"`
+
+	// JitterSeconds The maximum number of seconds each trigger is randomly delayed by
+	JitterSeconds int                 `json:"jitterSeconds"`
+	Metadata      APIResourceMeta     `json:"metadata"`
+	Method        CronWorkflowsMethod `json:"method"`
+	Name          *string             `json:"name,omitempty"`
+	TenantId      string              `json:"tenantId"`
+
+	// Timezone The IANA timezone the cron expression is evaluated in
+	Timezone          string `json:"timezone"`
+	WorkflowId        string `json:"workflowId"`
+	WorkflowName      string `json:"workflowName"`
+	WorkflowVersionId string `json:"workflowVersionId"`
 }
 
 // CronWorkflowsMethod defines model for CronWorkflows.Method.
@@ -424,6 +762,58 @@ type CronWorkflowsList struct {
 // CronWorkflowsOrderByField defines model for CronWorkflowsOrderByField.
 type CronWorkflowsOrderByField string
 
+// DeadLetterQueueItem defines model for DeadLetterQueueItem.
+type DeadLetterQueueItem struct {
+	// Error The error of the final attempt.
+	Error string `json:"error"`
+
+	// ErrorChain The errors of the previous attempts, oldest first.
+	ErrorChain []string `json:"errorChain"`
+
+	// Input The input of the step run.
+	Input    *string         `json:"input,omitempty"`
+	Metadata APIResourceMeta `json:"metadata"`
+
+	// RetryCount The number of retries which were attempted before the step run was added to the queue.
+	RetryCount int `json:"retryCount"`
+
+	// StepId The ID of the step of the step run.
+	StepId string `json:"stepId"`
+
+	// StepRunId The ID of the step run which exhausted its retries.
+	StepRunId string `json:"stepRunId"`
+
+	// TenantId The ID of the tenant associated with this item.
+	TenantId string `json:"tenantId"`
+
+	// WorkflowRunId The ID of the workflow run of the step run.
+	WorkflowRunId string `json:"workflowRunId"`
+}
+
+// DeadLetterQueueItemList defines model for DeadLetterQueueItemList.
+type DeadLetterQueueItemList struct {
+	Pagination *PaginationResponse    `json:"pagination,omitempty"`
+	Rows       *[]DeadLetterQueueItem `json:"rows,omitempty"`
+}
+
+// DeadLetterQueueItemReplayFailure defines model for DeadLetterQueueItemReplayFailure.
+type DeadLetterQueueItemReplayFailure struct {
+	// Id The id of the item.
+	Id string `json:"id"`
+
+	// Reason The reason the item could not be replayed.
+	Reason string `json:"reason"`
+}
+
+// DecideStepRunApprovalRequest defines model for DecideStepRunApprovalRequest.
+type DecideStepRunApprovalRequest struct {
+	// Approved Whether the step run is approved. Rejected step runs fail.
+	Approved bool `json:"approved"`
+
+	// Payload The payload of the approver, which becomes the output of the step run when approved.
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+}
+
 // Event defines model for Event.
 type Event struct {
 	// AdditionalMetadata Additional metadata for the event.
@@ -445,6 +835,27 @@ type EventData struct {
 	Data string `json:"data"`
 }
 
+// EventDedupRule defines model for EventDedupRule.
+type EventDedupRule struct {
+	// EventKey The key of the events which are deduplicated.
+	EventKey string `json:"eventKey"`
+
+	// Expression The CEL expression which evaluates to the dedup key of an event, with the data of the event as `input` and its additional metadata as `additional_metadata`.
+	Expression string          `json:"expression"`
+	Metadata   APIResourceMeta `json:"metadata"`
+
+	// TenantId The ID of the tenant associated with this rule.
+	TenantId string `json:"tenantId"`
+
+	// WindowSeconds The number of seconds after the first event with a dedup key in which events with the same dedup key are duplicates.
+	WindowSeconds int `json:"windowSeconds"`
+}
+
+// EventDedupRuleList defines model for EventDedupRuleList.
+type EventDedupRuleList struct {
+	Rows *[]EventDedupRule `json:"rows,omitempty"`
+}
+
 // EventKey The key for the event.
 type EventKey = string
 
@@ -466,9 +877,95 @@ type EventOrderByDirection string
 // EventOrderByField defines model for EventOrderByField.
 type EventOrderByField string
 
+// EventRoutingRule defines model for EventRoutingRule.
+type EventRoutingRule struct {
+	// EventKey The key of the events the rule applies to. The rule applies to events with any key if not set.
+	EventKey *string `json:"eventKey,omitempty"`
+
+	// Expression The CEL predicate which events must match, with the data of the event as `input`, its additional metadata as `additional_metadata` and its key as `event_key`.
+	Expression string          `json:"expression"`
+	Metadata   APIResourceMeta `json:"metadata"`
+
+	// Name The name of the rule, which is unique within the tenant.
+	Name string `json:"name"`
+
+	// TenantId The ID of the tenant associated with this rule.
+	TenantId string `json:"tenantId"`
+
+	// WorkflowId The ID of the workflow which is triggered by the events which match the predicate.
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+
+	// WorkflowName The name of the workflow which is triggered by the events which match the predicate.
+	WorkflowName *string `json:"workflowName,omitempty"`
+}
+
+// EventRoutingRuleList defines model for EventRoutingRuleList.
+type EventRoutingRuleList struct {
+	Rows *[]EventRoutingRule `json:"rows,omitempty"`
+}
+
 // EventSearch defines model for EventSearch.
 type EventSearch = string
 
+// EventSink defines model for EventSink.
+type EventSink struct {
+	// Brokers The brokers of the Kafka cluster.
+	Brokers *[]string `json:"brokers,omitempty"`
+
+	// DeadLetteredCount The number of records which were dead-lettered after the maximum number of attempts.
+	DeadLetteredCount int `json:"deadLetteredCount"`
+
+	// EventTypes The types of the records which are delivered to the sink. All records except audit log records are delivered if it's empty.
+	EventTypes []EventSinkRecordType `json:"eventTypes"`
+	Format     EventSinkFormat       `json:"format"`
+	Kind       EventSinkKind         `json:"kind"`
+
+	// MaxAttempts The number of attempts after which a delivery is dead-lettered.
+	MaxAttempts int             `json:"maxAttempts"`
+	Metadata    APIResourceMeta `json:"metadata"`
+
+	// Name The name of the sink, which is unique within the tenant.
+	Name string `json:"name"`
+
+	// PasswordSecret The name of the tenant secret which stores the password of the Kafka or NATS connection.
+	PasswordSecret *string `json:"passwordSecret,omitempty"`
+
+	// PendingCount The number of records which are waiting to be delivered.
+	PendingCount int `json:"pendingCount"`
+
+	// Secret The secret which webhook requests are signed with, which is only returned when the sink is created.
+	Secret *string `json:"secret,omitempty"`
+
+	// TenantId The ID of the tenant associated with this sink.
+	TenantId string `json:"tenantId"`
+
+	// Tls Whether the connections to the Kafka brokers use TLS.
+	Tls bool `json:"tls"`
+
+	// Topic The Kafka topic, or the prefix of the NATS subjects, which records are published to.
+	Topic *string `json:"topic,omitempty"`
+
+	// Url The URL of the webhook or the NATS server.
+	Url *string `json:"url,omitempty"`
+
+	// UsernameSecret The name of the tenant secret which stores the username of the Kafka or NATS connection.
+	UsernameSecret *string `json:"usernameSecret,omitempty"`
+}
+
+// EventSinkFormat The format of the records which are delivered to a sink. CLOUDEVENTS records are wrapped in CloudEvents 1.0 in the structured JSON format.
+type EventSinkFormat string
+
+// EventSinkKind defines model for EventSinkKind.
+type EventSinkKind string
+
+// EventSinkList defines model for EventSinkList.
+type EventSinkList struct {
+	Rows *[]EventSink `json:"rows,omitempty"`
+}
+
+// EventSinkRecordType defines model for EventSinkRecordType.
+type EventSinkRecordType string
+
 // EventWorkflowRunSummary defines model for EventWorkflowRunSummary.
 type EventWorkflowRunSummary struct {
 	// Failed The number of failed runs.
@@ -487,6 +984,65 @@ type EventWorkflowRunSummary struct {
 	Succeeded *int64 `json:"succeeded,omitempty"`
 }
 
+// HealthProbe defines model for HealthProbe.
+type HealthProbe struct {
+	// DurationMs the duration of the probe in milliseconds
+	DurationMs int64 `json:"durationMs"`
+
+	// Error the error of the probe, if the dependency is unhealthy
+	Error *string `json:"error,omitempty"`
+
+	// Healthy whether the dependency is healthy
+	Healthy bool `json:"healthy"`
+
+	// Name the name of the dependency
+	Name string `json:"name"`
+}
+
+// HealthReport defines model for HealthReport.
+type HealthReport struct {
+	// Healthy whether all the probes are healthy
+	Healthy bool          `json:"healthy"`
+	Probes  []HealthProbe `json:"probes"`
+}
+
+// InboundWebhook defines model for InboundWebhook.
+type InboundWebhook struct {
+	// DataExpression A CEL expression which evaluates to the data of the events. The body of the request is used if it's not set.
+	DataExpression *string `json:"dataExpression,omitempty"`
+
+	// EventKey The key of the events which requests are ingested as.
+	EventKey *string `json:"eventKey,omitempty"`
+
+	// EventKeyExpression A CEL expression which evaluates to the key of the events which requests are ingested as.
+	EventKeyExpression *string         `json:"eventKeyExpression,omitempty"`
+	Metadata           APIResourceMeta `json:"metadata"`
+
+	// Name The name of the webhook, which is unique within the tenant.
+	Name string `json:"name"`
+
+	// Secret The signing secret, which is only returned when the webhook is created.
+	Secret *string `json:"secret,omitempty"`
+
+	// SignatureHeader The header which contains the signature, for the HMAC_SHA256 scheme.
+	SignatureHeader *string                       `json:"signatureHeader,omitempty"`
+	SignatureScheme InboundWebhookSignatureScheme `json:"signatureScheme"`
+
+	// TenantId The ID of the tenant associated with this webhook.
+	TenantId string `json:"tenantId"`
+
+	// Url The URL which the provider sends requests to.
+	Url string `json:"url"`
+}
+
+// InboundWebhookList defines model for InboundWebhookList.
+type InboundWebhookList struct {
+	Rows *[]InboundWebhook `json:"rows,omitempty"`
+}
+
+// InboundWebhookSignatureScheme defines model for InboundWebhookSignatureScheme.
+type InboundWebhookSignatureScheme string
+
 // Job defines model for Job.
 type Job struct {
 	// Description The description of the job.
@@ -539,6 +1095,12 @@ type ListSNSIntegrations struct {
 	Rows       []SNSIntegration   `json:"rows"`
 }
 
+// ListSQSIntegrations defines model for ListSQSIntegrations.
+type ListSQSIntegrations struct {
+	Pagination PaginationResponse `json:"pagination"`
+	Rows       []SQSIntegration   `json:"rows"`
+}
+
 // ListSlackWebhooks defines model for ListSlackWebhooks.
 type ListSlackWebhooks struct {
 	Pagination PaginationResponse `json:"pagination"`
@@ -550,11 +1112,18 @@ type LogLine struct {
 	// CreatedAt The creation date of the log line.
 	CreatedAt time.Time `json:"createdAt"`
 
+	// Id The id of the log line, which increases in the order log lines are stored.
+	Id    int64        `json:"id"`
+	Level LogLineLevel `json:"level"`
+
 	// Message The log message.
 	Message string `json:"message"`
 
 	// Metadata The log metadata.
 	Metadata map[string]interface{} `json:"metadata"`
+
+	// StepRunId The id of the step run which the log line belongs to.
+	StepRunId openapi_types.UUID `json:"stepRunId"`
 }
 
 // LogLineLevel defines model for LogLineLevel.
@@ -590,6 +1159,30 @@ type PaginationResponse struct {
 	NumPages *int64 `json:"num_pages,omitempty"`
 }
 
+// PurgeDeadLetterQueueItemsRequest defines model for PurgeDeadLetterQueueItemsRequest.
+type PurgeDeadLetterQueueItemsRequest struct {
+	// Ids The ids of the items to purge. If not set, all items in the queue are purged.
+	Ids *[]openapi_types.UUID `json:"ids,omitempty"`
+}
+
+// PurgeDeadLetterQueueItemsResponse defines model for PurgeDeadLetterQueueItemsResponse.
+type PurgeDeadLetterQueueItemsResponse struct {
+	// Purged The number of purged items.
+	Purged int `json:"purged"`
+}
+
+// Queue defines model for Queue.
+type Queue struct {
+	// IsPaused Whether the queue is paused.
+	IsPaused bool `json:"isPaused"`
+
+	// LastActive The last time the queue was active.
+	LastActive *time.Time `json:"lastActive,omitempty"`
+
+	// Name The name of the queue.
+	Name string `json:"name"`
+}
+
 // QueueMetrics defines model for QueueMetrics.
 type QueueMetrics struct {
 	// NumPending The number of items pending.
@@ -602,6 +1195,45 @@ type QueueMetrics struct {
 	NumRunning int `json:"numRunning"`
 }
 
+// QueueMetricsHistory defines model for QueueMetricsHistory.
+type QueueMetricsHistory struct {
+	// AvgDepth The average number of queued items over the window.
+	AvgDepth float32 `json:"avgDepth"`
+
+	// AvgLatencyMs The average time in milliseconds which the oldest queued item had waited over the window.
+	AvgLatencyMs float32 `json:"avgLatencyMs"`
+
+	// AvgSlotUtilization The average ratio of used to total worker slots of the queue over the window, between 0 and 1.
+	AvgSlotUtilization float32 `json:"avgSlotUtilization"`
+
+	// MaxDepth The maximum number of queued items over the window.
+	MaxDepth int `json:"maxDepth"`
+
+	// MaxLatencyMs The maximum time in milliseconds which the oldest queued item had waited over the window.
+	MaxLatencyMs int64 `json:"maxLatencyMs"`
+
+	// Snapshots The snapshots of the queue in the window, ordered by the time they were taken.
+	Snapshots []QueueMetricsSnapshot `json:"snapshots"`
+}
+
+// QueueMetricsSnapshot defines model for QueueMetricsSnapshot.
+type QueueMetricsSnapshot struct {
+	// CreatedAt The time the snapshot was taken.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Depth The number of queued items.
+	Depth int `json:"depth"`
+
+	// LatencyMs The time in milliseconds which the oldest queued item had waited.
+	LatencyMs int64 `json:"latencyMs"`
+
+	// Slots The number of slots of the active workers which can run the items of the queue.
+	Slots int `json:"slots"`
+
+	// UsedSlots The number of used slots of the active workers which can run the items of the queue.
+	UsedSlots int `json:"usedSlots"`
+}
+
 // RateLimit defines model for RateLimit.
 type RateLimit struct {
 	// Key The key for the rate limit.
@@ -652,27 +1284,112 @@ type RejectInviteRequest struct {
 	Invite string `json:"invite" validate:"required,uuid"`
 }
 
-// ReplayEventRequest defines model for ReplayEventRequest.
-type ReplayEventRequest struct {
-	EventIds []openapi_types.UUID `json:"eventIds"`
+// ReplayDeadLetterQueueItemsRequest defines model for ReplayDeadLetterQueueItemsRequest.
+type ReplayDeadLetterQueueItemsRequest struct {
+	Ids []openapi_types.UUID `json:"ids"`
 }
 
-// ReplayWorkflowRunsRequest defines model for ReplayWorkflowRunsRequest.
-type ReplayWorkflowRunsRequest struct {
-	WorkflowRunIds []openapi_types.UUID `json:"workflowRunIds"`
-}
+// ReplayDeadLetterQueueItemsResponse defines model for ReplayDeadLetterQueueItemsResponse.
+type ReplayDeadLetterQueueItemsResponse struct {
+	// Failed The items which could not be replayed.
+	Failed []DeadLetterQueueItemReplayFailure `json:"failed"`
 
-// ReplayWorkflowRunsResponse defines model for ReplayWorkflowRunsResponse.
-type ReplayWorkflowRunsResponse struct {
-	WorkflowRuns []WorkflowRun `json:"workflowRuns"`
+	// Replayed The ids of the items which were replayed and removed from the queue.
+	Replayed []string `json:"replayed"`
 }
 
-// RerunStepRunRequest defines model for RerunStepRunRequest.
-type RerunStepRunRequest struct {
-	Input map[string]interface{} `json:"input"`
-}
+// ReplayEventRangeRequest defines model for ReplayEventRangeRequest.
+type ReplayEventRangeRequest struct {
+	// AdditionalMetadata The additional metadata which the events must contain.
+	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
 
-// SNSIntegration defines model for SNSIntegration.
+	// DryRun Whether to only report which workflows would be triggered, without replaying the events.
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Keys The keys of the events to replay. All keys are replayed if empty.
+	Keys *[]string `json:"keys,omitempty"`
+
+	// Limit The maximum number of events to replay. Defaults to 100.
+	Limit *int `json:"limit,omitempty"`
+
+	// Since The start of the time window, inclusive.
+	Since time.Time `json:"since"`
+
+	// Until The end of the time window, exclusive. Defaults to the current time.
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// ReplayEventRangeResponse defines model for ReplayEventRangeResponse.
+type ReplayEventRangeResponse struct {
+	DryRun bool `json:"dryRun"`
+
+	// EventCount The number of events in the range.
+	EventCount int `json:"eventCount"`
+
+	// EventIds The ids of the replayed events. Empty in a dry run.
+	EventIds []openapi_types.UUID `json:"eventIds"`
+
+	// HasMore Whether there are more events in the time window than the limit.
+	HasMore bool `json:"hasMore"`
+
+	// NextSince The since of the next page of events, if there are more events.
+	NextSince *time.Time                 `json:"nextSince,omitempty"`
+	Workflows []ReplayEventRangeWorkflow `json:"workflows"`
+}
+
+// ReplayEventRangeWorkflow defines model for ReplayEventRangeWorkflow.
+type ReplayEventRangeWorkflow struct {
+	// EventCount The number of events which trigger the workflow.
+	EventCount int `json:"eventCount"`
+
+	// SkippedCount The number of events which match the workflow but whose data isn't valid input for it.
+	SkippedCount int                `json:"skippedCount"`
+	WorkflowId   openapi_types.UUID `json:"workflowId"`
+	WorkflowName string             `json:"workflowName"`
+
+	// WorkflowVersionId The current version of the workflow, which the events are replayed against.
+	WorkflowVersionId openapi_types.UUID `json:"workflowVersionId"`
+}
+
+// ReplayEventRequest defines model for ReplayEventRequest.
+type ReplayEventRequest struct {
+	EventIds []openapi_types.UUID `json:"eventIds"`
+}
+
+// ReplayWorkflowRunFromStepRequest defines model for ReplayWorkflowRunFromStepRequest.
+type ReplayWorkflowRunFromStepRequest struct {
+	// Input The input of the step, which overrides the input built from the outputs of the upstream steps.
+	Input *map[string]interface{} `json:"input,omitempty"`
+
+	// StepId The id of the step to replay the workflow run from. The outputs of the steps upstream of it are reused.
+	StepId openapi_types.UUID `json:"stepId"`
+}
+
+// ReplayWorkflowRunsRequest defines model for ReplayWorkflowRunsRequest.
+type ReplayWorkflowRunsRequest struct {
+	WorkflowRunIds []openapi_types.UUID `json:"workflowRunIds"`
+}
+
+// ReplayWorkflowRunsResponse defines model for ReplayWorkflowRunsResponse.
+type ReplayWorkflowRunsResponse struct {
+	WorkflowRuns []WorkflowRun `json:"workflowRuns"`
+}
+
+// RerunStepRunRequest defines model for RerunStepRunRequest.
+type RerunStepRunRequest struct {
+	Input map[string]interface{} `json:"input"`
+}
+
+// RotateAPITokenRequest defines model for RotateAPITokenRequest.
+type RotateAPITokenRequest struct {
+	// ExpiresIn The duration for which the new token is valid.
+	ExpiresIn *string `json:"expiresIn,omitempty" validate:"omitnil,duration"`
+
+	// GracePeriod The duration for which the rotated token stays valid, defaults to 24 hours.
+	GracePeriod *string `json:"gracePeriod,omitempty" validate:"omitnil,duration"`
+}
+
+// SNSIntegration defines model for SNSIntegration.
 type SNSIntegration struct {
 	// IngestUrl The URL to send SNS messages to.
 	IngestUrl *string         `json:"ingestUrl,omitempty"`
@@ -685,6 +1402,31 @@ type SNSIntegration struct {
 	TopicArn string `json:"topicArn"`
 }
 
+// SQSIntegration defines model for SQSIntegration.
+type SQSIntegration struct {
+	// AccessKeyIdSecret The name of the tenant secret which stores the AWS access key ID.
+	AccessKeyIdSecret string `json:"accessKeyIdSecret"`
+
+	// EventKey The key of the events which messages are ingested as.
+	EventKey string          `json:"eventKey"`
+	Metadata APIResourceMeta `json:"metadata"`
+
+	// QueueUrl The URL of the SQS queue.
+	QueueUrl string `json:"queueUrl"`
+
+	// Region The AWS region of the SQS queue.
+	Region string `json:"region"`
+
+	// SecretAccessKeySecret The name of the tenant secret which stores the AWS secret access key.
+	SecretAccessKeySecret string `json:"secretAccessKeySecret"`
+
+	// SessionTokenSecret The name of the tenant secret which stores an AWS session token.
+	SessionTokenSecret *string `json:"sessionTokenSecret,omitempty"`
+
+	// TenantId The unique identifier for the tenant that the SQS integration belongs to.
+	TenantId openapi_types.UUID `json:"tenantId"`
+}
+
 // ScheduleWorkflowRunRequest defines model for ScheduleWorkflowRunRequest.
 type ScheduleWorkflowRunRequest struct {
 	AdditionalMetadata map[string]interface{} `json:"additionalMetadata"`
@@ -724,6 +1466,27 @@ type ScheduledWorkflowsList struct {
 // ScheduledWorkflowsOrderByField defines model for ScheduledWorkflowsOrderByField.
 type ScheduledWorkflowsOrderByField string
 
+// SchedulingDecision defines model for SchedulingDecision.
+type SchedulingDecision struct {
+	CreatedAt time.Time                 `json:"createdAt"`
+	Data      *map[string]interface{}   `json:"data,omitempty"`
+	Id        int                       `json:"id"`
+	Message   string                    `json:"message"`
+	Outcome   SchedulingDecisionOutcome `json:"outcome"`
+	Queue     string                    `json:"queue"`
+	StepRunId string                    `json:"stepRunId"`
+	WorkerId  *string                   `json:"workerId,omitempty"`
+}
+
+// SchedulingDecisionList defines model for SchedulingDecisionList.
+type SchedulingDecisionList struct {
+	Pagination *PaginationResponse   `json:"pagination,omitempty"`
+	Rows       *[]SchedulingDecision `json:"rows,omitempty"`
+}
+
+// SchedulingDecisionOutcome defines model for SchedulingDecisionOutcome.
+type SchedulingDecisionOutcome string
+
 // SemaphoreSlots defines model for SemaphoreSlots.
 type SemaphoreSlots struct {
 	// ActionId The action id.
@@ -780,34 +1543,70 @@ type Step struct {
 
 // StepRun defines model for StepRun.
 type StepRun struct {
-	CancelledAt         *time.Time              `json:"cancelledAt,omitempty"`
-	CancelledAtEpoch    *int                    `json:"cancelledAtEpoch,omitempty"`
-	CancelledError      *string                 `json:"cancelledError,omitempty"`
-	CancelledReason     *string                 `json:"cancelledReason,omitempty"`
-	ChildWorkflowRuns   *[]string               `json:"childWorkflowRuns,omitempty"`
-	ChildWorkflowsCount *int                    `json:"childWorkflowsCount,omitempty"`
-	Error               *string                 `json:"error,omitempty"`
-	FinishedAt          *time.Time              `json:"finishedAt,omitempty"`
-	FinishedAtEpoch     *int                    `json:"finishedAtEpoch,omitempty"`
-	Input               *string                 `json:"input,omitempty"`
-	JobRun              *JobRun                 `json:"jobRun,omitempty"`
-	JobRunId            string                  `json:"jobRunId"`
-	Metadata            APIResourceMeta         `json:"metadata"`
-	Output              *string                 `json:"output,omitempty"`
-	Parents             *[]string               `json:"parents,omitempty"`
-	RequeueAfter        *time.Time              `json:"requeueAfter,omitempty"`
-	Result              *map[string]interface{} `json:"result,omitempty"`
-	StartedAt           *time.Time              `json:"startedAt,omitempty"`
-	StartedAtEpoch      *int                    `json:"startedAtEpoch,omitempty"`
-	Status              StepRunStatus           `json:"status"`
-	Step                *Step                   `json:"step,omitempty"`
-	StepId              string                  `json:"stepId"`
-	TenantId            string                  `json:"tenantId"`
-	TimeoutAt           *time.Time              `json:"timeoutAt,omitempty"`
-	TimeoutAtEpoch      *int                    `json:"timeoutAtEpoch,omitempty"`
-	WorkerId            *string                 `json:"workerId,omitempty"`
+	CancelledAt         *time.Time      `json:"cancelledAt,omitempty"`
+	CancelledAtEpoch    *int            `json:"cancelledAtEpoch,omitempty"`
+	CancelledError      *string         `json:"cancelledError,omitempty"`
+	CancelledReason     *string         `json:"cancelledReason,omitempty"`
+	ChildWorkflowRuns   *[]string       `json:"childWorkflowRuns,omitempty"`
+	ChildWorkflowsCount *int            `json:"childWorkflowsCount,omitempty"`
+	Error               *string         `json:"error,omitempty"`
+	FinishedAt          *time.Time      `json:"finishedAt,omitempty"`
+	FinishedAtEpoch     *int            `json:"finishedAtEpoch,omitempty"`
+	Input               *string         `json:"input,omitempty"`
+	JobRun              *JobRun         `json:"jobRun,omitempty"`
+	JobRunId            string          `json:"jobRunId"`
+	Metadata            APIResourceMeta `json:"metadata"`
+
+	// NextRetryAt The time of the next retry, if the step run is waiting on a retry backoff.
+	NextRetryAt    *time.Time              `json:"nextRetryAt,omitempty"`
+	Output         *string                 `json:"output,omitempty"`
+	Parents        *[]string               `json:"parents,omitempty"`
+	Progress       *StepRunProgress        `json:"progress,omitempty"`
+	RequeueAfter   *time.Time              `json:"requeueAfter,omitempty"`
+	Result         *map[string]interface{} `json:"result,omitempty"`
+	StartedAt      *time.Time              `json:"startedAt,omitempty"`
+	StartedAtEpoch *int                    `json:"startedAtEpoch,omitempty"`
+	Status         StepRunStatus           `json:"status"`
+	Step           *Step                   `json:"step,omitempty"`
+	StepId         string                  `json:"stepId"`
+	TenantId       string                  `json:"tenantId"`
+	TimeoutAt      *time.Time              `json:"timeoutAt,omitempty"`
+	TimeoutAtEpoch *int                    `json:"timeoutAtEpoch,omitempty"`
+	WorkerId       *string                 `json:"workerId,omitempty"`
+}
+
+// StepRunApproval defines model for StepRunApproval.
+type StepRunApproval struct {
+	// DecidedAt The time the approval was decided or timed out.
+	DecidedAt *time.Time      `json:"decidedAt,omitempty"`
+	Metadata  APIResourceMeta `json:"metadata"`
+
+	// Payload The payload of the approver.
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+	Status  StepRunApprovalStatus   `json:"status"`
+
+	// StepRunId The ID of the approval step run.
+	StepRunId string `json:"stepRunId"`
+
+	// TenantId The ID of the tenant associated with this approval.
+	TenantId string `json:"tenantId"`
+
+	// Token The token which allows the approval to be decided through the approval webhook, without an API token.
+	Token string `json:"token"`
+
+	// WorkflowRunId The ID of the workflow run of the step run.
+	WorkflowRunId string `json:"workflowRunId"`
+}
+
+// StepRunApprovalList defines model for StepRunApprovalList.
+type StepRunApprovalList struct {
+	Pagination *PaginationResponse `json:"pagination,omitempty"`
+	Rows       *[]StepRunApproval  `json:"rows,omitempty"`
 }
 
+// StepRunApprovalStatus defines model for StepRunApprovalStatus.
+type StepRunApprovalStatus string
+
 // StepRunArchive defines model for StepRunArchive.
 type StepRunArchive struct {
 	CancelledAt      *time.Time `json:"cancelledAt,omitempty"`
@@ -861,6 +1660,19 @@ type StepRunEventReason string
 // StepRunEventSeverity defines model for StepRunEventSeverity.
 type StepRunEventSeverity string
 
+// StepRunProgress The latest progress which a long-running step run reported.
+type StepRunProgress struct {
+	// Data Arbitrary data which the step run reported along with its progress.
+	Data *map[string]interface{} `json:"data,omitempty"`
+
+	// Message A message which describes the progress.
+	Message *string `json:"message,omitempty"`
+
+	// Percent The percentage of the step run which is done, between 0 and 100.
+	Percent   float64   `json:"percent"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
 // StepRunStatus defines model for StepRunStatus.
 type StepRunStatus string
 
@@ -957,9 +1769,29 @@ type TenantMemberRole string
 
 // TenantQueueMetrics defines model for TenantQueueMetrics.
 type TenantQueueMetrics struct {
-	Queues   *map[string]int          `json:"queues,omitempty"`
-	Total    *QueueMetrics            `json:"total,omitempty"`
-	Workflow *map[string]QueueMetrics `json:"workflow,omitempty"`
+	// History The metrics history of the queues over the requested window, keyed by queue. Only set when a window is requested.
+	History  *map[string]QueueMetricsHistory `json:"history,omitempty"`
+	Queues   *map[string]int                 `json:"queues,omitempty"`
+	Total    *QueueMetrics                   `json:"total,omitempty"`
+	Workflow *map[string]QueueMetrics        `json:"workflow,omitempty"`
+}
+
+// TenantQuotaResource defines model for TenantQuotaResource.
+type TenantQuotaResource string
+
+// TenantQuotaUsage defines model for TenantQuotaUsage.
+type TenantQuotaUsage struct {
+	// Limit The quota which applies to the tenant. Not set if the resource is unlimited.
+	Limit    *int                `json:"limit,omitempty"`
+	Resource TenantQuotaResource `json:"resource"`
+
+	// Usage The current usage of the resource.
+	Usage int `json:"usage"`
+}
+
+// TenantQuotaUsageList defines model for TenantQuotaUsageList.
+type TenantQuotaUsageList struct {
+	Rows *[]TenantQuotaUsage `json:"rows,omitempty"`
 }
 
 // TenantResource defines model for TenantResource.
@@ -999,7 +1831,16 @@ type TenantStepRunQueueMetrics struct {
 // TriggerWorkflowRunRequest defines model for TriggerWorkflowRunRequest.
 type TriggerWorkflowRunRequest struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
-	Input              map[string]interface{}  `json:"input"`
+
+	// IdempotencyKey If a workflow run of the workflow was triggered with the same idempotency key within the retention window, the existing workflow run is returned instead of a new one.
+	IdempotencyKey *string                `json:"idempotencyKey,omitempty"`
+	Input          map[string]interface{} `json:"input"`
+}
+
+// UpdateScheduledWorkflowRunRequest defines model for UpdateScheduledWorkflowRunRequest.
+type UpdateScheduledWorkflowRunRequest struct {
+	// TriggerAt The new time to trigger the scheduled workflow run at
+	TriggerAt time.Time `json:"triggerAt"`
 }
 
 // UpdateTenantAlertEmailGroupRequest defines model for UpdateTenantAlertEmailGroupRequest.
@@ -1043,6 +1884,42 @@ type UpdateWorkerRequest struct {
 	IsPaused *bool `json:"isPaused,omitempty"`
 }
 
+// UpdateWorkflowRolloutRequest defines model for UpdateWorkflowRolloutRequest.
+type UpdateWorkflowRolloutRequest struct {
+	// CanaryPercentage The percentage of new runs which are triggered on the latest version of the workflow. Set to 0 to pin new runs to the stable version.
+	CanaryPercentage int `json:"canaryPercentage" validate:"min=0,max=100"`
+
+	// StableVersionId The ID of the version which new runs are triggered on, unless they're part of the canary.
+	StableVersionId openapi_types.UUID `json:"stableVersionId"`
+}
+
+// UpsertEventDedupRuleRequest defines model for UpsertEventDedupRuleRequest.
+type UpsertEventDedupRuleRequest struct {
+	// EventKey The key of the events which are deduplicated.
+	EventKey string `json:"eventKey" validate:"required"`
+
+	// Expression The CEL expression which evaluates to the dedup key of an event, with the data of the event as `input` and its additional metadata as `additional_metadata`.
+	Expression string `json:"expression" validate:"required"`
+
+	// WindowSeconds The number of seconds after the first event with a dedup key in which events with the same dedup key are duplicates.
+	WindowSeconds int `json:"windowSeconds" validate:"required,min=1,max=604800"`
+}
+
+// UpsertEventRoutingRuleRequest defines model for UpsertEventRoutingRuleRequest.
+type UpsertEventRoutingRuleRequest struct {
+	// EventKey The key of the events the rule applies to. The rule applies to events with any key if not set.
+	EventKey *string `json:"eventKey,omitempty"`
+
+	// Expression The CEL predicate which events must match, with the data of the event as `input`, its additional metadata as `additional_metadata` and its key as `event_key`.
+	Expression string `json:"expression" validate:"required"`
+
+	// Name The name of the rule, which is unique within the tenant.
+	Name string `json:"name" validate:"required,hatchetName"`
+
+	// WorkflowId The ID of the workflow which is triggered by the events which match the predicate.
+	WorkflowId openapi_types.UUID `json:"workflowId" validate:"required"`
+}
+
 // User defines model for User.
 type User struct {
 	// Email The email address of the user.
@@ -1179,6 +2056,7 @@ type Worker struct {
 
 	// DispatcherId the id of the assigned dispatcher, in UUID format
 	DispatcherId *openapi_types.UUID `json:"dispatcherId,omitempty"`
+	Health       *WorkerHealth       `json:"health,omitempty"`
 
 	// Labels The current label state of the worker.
 	Labels *[]WorkerLabel `json:"labels,omitempty"`
@@ -1196,9 +2074,15 @@ type Worker struct {
 	// Name The name of the worker.
 	Name string `json:"name"`
 
+	// Pool The worker pool the worker joined.
+	Pool *string `json:"pool,omitempty"`
+
 	// RecentStepRuns The recent step runs for the worker.
-	RecentStepRuns *[]RecentStepRuns  `json:"recentStepRuns,omitempty"`
-	RuntimeInfo    *WorkerRuntimeInfo `json:"runtimeInfo,omitempty"`
+	RecentStepRuns *[]RecentStepRuns `json:"recentStepRuns,omitempty"`
+
+	// Region The region the worker runs in.
+	Region      *string            `json:"region,omitempty"`
+	RuntimeInfo *WorkerRuntimeInfo `json:"runtimeInfo,omitempty"`
 
 	// Slots The semaphore slot state for the worker.
 	Slots *[]SemaphoreSlots `json:"slots,omitempty"`
@@ -1220,6 +2104,24 @@ type WorkerStatus string
 // WorkerType defines model for Worker.Type.
 type WorkerType string
 
+// WorkerHealth defines model for WorkerHealth.
+type WorkerHealth struct {
+	// ActiveSlots The number of slots of the worker which are running a step, as reported by the worker.
+	ActiveSlots *int `json:"activeSlots,omitempty"`
+
+	// CpuUtilization The cpu utilization of the worker process, between 0 and 1.
+	CpuUtilization *float32 `json:"cpuUtilization,omitempty"`
+
+	// Gauges Custom gauges which the worker reports, keyed by name.
+	Gauges *map[string]float32 `json:"gauges,omitempty"`
+
+	// MemoryUtilization The memory utilization of the worker process relative to its memory limit, between 0 and 1.
+	MemoryUtilization *float32 `json:"memoryUtilization,omitempty"`
+
+	// ReportedAt The time of the heartbeat which the health was reported with.
+	ReportedAt time.Time `json:"reportedAt"`
+}
+
 // WorkerLabel defines model for WorkerLabel.
 type WorkerLabel struct {
 	// Key The key of the label.
@@ -1236,6 +2138,26 @@ type WorkerList struct {
 	Rows       *[]Worker           `json:"rows,omitempty"`
 }
 
+// WorkerPool defines model for WorkerPool.
+type WorkerPool struct {
+	// Name The name of the worker pool.
+	Name string `json:"name"`
+
+	// Slots The total number of slots of the active workers in the pool.
+	Slots int `json:"slots"`
+
+	// UsedSlots The number of slots of the active workers in the pool which are running a step.
+	UsedSlots int `json:"usedSlots"`
+
+	// Workers The number of active workers in the pool.
+	Workers int `json:"workers"`
+}
+
+// WorkerPoolList defines model for WorkerPoolList.
+type WorkerPoolList struct {
+	Rows *[]WorkerPool `json:"rows,omitempty"`
+}
+
 // WorkerRuntimeInfo defines model for WorkerRuntimeInfo.
 type WorkerRuntimeInfo struct {
 	Language        *WorkerRuntimeSDKs `json:"language,omitempty"`
@@ -1283,6 +2205,23 @@ type WorkflowConcurrency struct {
 // WorkflowConcurrencyLimitStrategy The strategy to use when the concurrency limit is reached.
 type WorkflowConcurrencyLimitStrategy string
 
+// WorkflowConcurrencyGroup defines model for WorkflowConcurrencyGroup.
+type WorkflowConcurrencyGroup struct {
+	// GroupKey The concurrency group key.
+	GroupKey string `json:"groupKey"`
+
+	// QueuedCount The number of queued workflow runs in the group.
+	QueuedCount int `json:"queuedCount"`
+
+	// RunningCount The number of running workflow runs in the group.
+	RunningCount int `json:"runningCount"`
+}
+
+// WorkflowConcurrencyGroupList defines model for WorkflowConcurrencyGroupList.
+type WorkflowConcurrencyGroupList struct {
+	Rows *[]WorkflowConcurrencyGroup `json:"rows,omitempty"`
+}
+
 // WorkflowID A workflow ID.
 type WorkflowID = string
 
@@ -1308,28 +2247,55 @@ type WorkflowMetrics struct {
 	GroupKeyRunsCount *int `json:"groupKeyRunsCount,omitempty"`
 }
 
+// WorkflowRollout defines model for WorkflowRollout.
+type WorkflowRollout struct {
+	// CanaryPercentage The percentage of new runs which are triggered on the latest version of the workflow.
+	CanaryPercentage int             `json:"canaryPercentage"`
+	Metadata         APIResourceMeta `json:"metadata"`
+
+	// StableVersionId The ID of the version which new runs are triggered on, unless they're part of the canary.
+	StableVersionId openapi_types.UUID `json:"stableVersionId"`
+
+	// WorkflowId The ID of the workflow the rollout belongs to.
+	WorkflowId openapi_types.UUID `json:"workflowId"`
+}
+
 // WorkflowRun defines model for WorkflowRun.
 type WorkflowRun struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
-	DisplayName        *string                 `json:"displayName,omitempty"`
-	Duration           *int                    `json:"duration,omitempty"`
-	Error              *string                 `json:"error,omitempty"`
-	FinishedAt         *time.Time              `json:"finishedAt,omitempty"`
-	Input              *map[string]interface{} `json:"input,omitempty"`
-	JobRuns            *[]JobRun               `json:"jobRuns,omitempty"`
-	Metadata           APIResourceMeta         `json:"metadata"`
-	ParentId           *openapi_types.UUID     `json:"parentId,omitempty"`
-	ParentStepRunId    *openapi_types.UUID     `json:"parentStepRunId,omitempty"`
-	StartedAt          *time.Time              `json:"startedAt,omitempty"`
-	Status             WorkflowRunStatus       `json:"status"`
-	TenantId           string                  `json:"tenantId"`
-	TriggeredBy        WorkflowRunTriggeredBy  `json:"triggeredBy"`
-	WorkflowVersion    *WorkflowVersion        `json:"workflowVersion,omitempty"`
-	WorkflowVersionId  string                  `json:"workflowVersionId"`
+
+	// Archived Whether the job runs, step runs and events of the workflow run were moved to the archive. The details of archived runs are read from the blob storage, which is slower.
+	Archived    *bool                   `json:"archived,omitempty"`
+	DisplayName *string                 `json:"displayName,omitempty"`
+	Duration    *int                    `json:"duration,omitempty"`
+	Error       *string                 `json:"error,omitempty"`
+	FinishedAt  *time.Time              `json:"finishedAt,omitempty"`
+	Input       *map[string]interface{} `json:"input,omitempty"`
+	JobRuns     *[]JobRun               `json:"jobRuns,omitempty"`
+	Metadata    APIResourceMeta         `json:"metadata"`
+
+	// Output The output of the workflow run as JSON, if the workflow declares an output expression.
+	Output          *string             `json:"output,omitempty"`
+	ParentId        *openapi_types.UUID `json:"parentId,omitempty"`
+	ParentStepRunId *openapi_types.UUID `json:"parentStepRunId,omitempty"`
+
+	// ReplayedFromId The id of the workflow run which this workflow run replays from a step.
+	ReplayedFromId *openapi_types.UUID `json:"replayedFromId,omitempty"`
+
+	// ReplayedFromStepId The id of the step which this workflow run is replayed from.
+	ReplayedFromStepId *openapi_types.UUID    `json:"replayedFromStepId,omitempty"`
+	StartedAt          *time.Time             `json:"startedAt,omitempty"`
+	Status             WorkflowRunStatus      `json:"status"`
+	TenantId           string                 `json:"tenantId"`
+	TriggeredBy        WorkflowRunTriggeredBy `json:"triggeredBy"`
+	WorkflowVersion    *WorkflowVersion       `json:"workflowVersion,omitempty"`
+	WorkflowVersionId  string                 `json:"workflowVersionId"`
 }
 
 // WorkflowRunList defines model for WorkflowRunList.
 type WorkflowRunList struct {
+	// NextCursor The cursor to pass to get the next page of runs. Only set when there may be more runs. Pages which were requested with a cursor have no pagination, since the runs aren't counted.
+	NextCursor *string             `json:"nextCursor,omitempty"`
 	Pagination *PaginationResponse `json:"pagination,omitempty"`
 	Rows       *[]WorkflowRun      `json:"rows,omitempty"`
 }
@@ -1343,22 +2309,25 @@ type WorkflowRunOrderByField string
 // WorkflowRunShape defines model for WorkflowRunShape.
 type WorkflowRunShape struct {
 	AdditionalMetadata *map[string]interface{} `json:"additionalMetadata,omitempty"`
-	DisplayName        *string                 `json:"displayName,omitempty"`
-	Duration           *int                    `json:"duration,omitempty"`
-	Error              *string                 `json:"error,omitempty"`
-	FinishedAt         *time.Time              `json:"finishedAt,omitempty"`
-	Input              *map[string]interface{} `json:"input,omitempty"`
-	JobRuns            *[]JobRun               `json:"jobRuns,omitempty"`
-	Metadata           APIResourceMeta         `json:"metadata"`
-	ParentId           *openapi_types.UUID     `json:"parentId,omitempty"`
-	ParentStepRunId    *openapi_types.UUID     `json:"parentStepRunId,omitempty"`
-	StartedAt          *time.Time              `json:"startedAt,omitempty"`
-	Status             WorkflowRunStatus       `json:"status"`
-	TenantId           string                  `json:"tenantId"`
-	TriggeredBy        WorkflowRunTriggeredBy  `json:"triggeredBy"`
-	WorkflowId         *string                 `json:"workflowId,omitempty"`
-	WorkflowVersion    *WorkflowVersion        `json:"workflowVersion,omitempty"`
-	WorkflowVersionId  string                  `json:"workflowVersionId"`
+
+	// Archived Whether the job runs, step runs and events of the workflow run were moved to the archive. The details of archived runs are read from the blob storage, which is slower.
+	Archived          *bool                   `json:"archived,omitempty"`
+	DisplayName       *string                 `json:"displayName,omitempty"`
+	Duration          *int                    `json:"duration,omitempty"`
+	Error             *string                 `json:"error,omitempty"`
+	FinishedAt        *time.Time              `json:"finishedAt,omitempty"`
+	Input             *map[string]interface{} `json:"input,omitempty"`
+	JobRuns           *[]JobRun               `json:"jobRuns,omitempty"`
+	Metadata          APIResourceMeta         `json:"metadata"`
+	ParentId          *openapi_types.UUID     `json:"parentId,omitempty"`
+	ParentStepRunId   *openapi_types.UUID     `json:"parentStepRunId,omitempty"`
+	StartedAt         *time.Time              `json:"startedAt,omitempty"`
+	Status            WorkflowRunStatus       `json:"status"`
+	TenantId          string                  `json:"tenantId"`
+	TriggeredBy       WorkflowRunTriggeredBy  `json:"triggeredBy"`
+	WorkflowId        *string                 `json:"workflowId,omitempty"`
+	WorkflowVersion   *WorkflowVersion        `json:"workflowVersion,omitempty"`
+	WorkflowVersionId string                  `json:"workflowVersionId"`
 }
 
 // WorkflowRunStatus defines model for WorkflowRunStatus.
@@ -1367,6 +2336,30 @@ type WorkflowRunStatus string
 // WorkflowRunStatusList defines model for WorkflowRunStatusList.
 type WorkflowRunStatusList = []WorkflowRunStatus
 
+// WorkflowRunStreamEvent An event of a workflow run, which is sent as the data of a server-sent event.
+type WorkflowRunStreamEvent struct {
+	// EventPayload The output of a completed step run, the error of a failed step run, the data of a stream event, or the progress of a step run as a JSON-encoded StepRunProgress without its update time.
+	EventPayload   *string                    `json:"eventPayload,omitempty"`
+	EventTimestamp time.Time                  `json:"eventTimestamp"`
+	EventType      WorkflowRunStreamEventType `json:"eventType"`
+
+	// Hangup Whether this is the last event of the stream.
+	Hangup bool `json:"hangup"`
+
+	// ResourceId The id of the step run or workflow run which the event belongs to.
+	ResourceId    string                        `json:"resourceId"`
+	ResourceType  WorkflowRunStreamResourceType `json:"resourceType"`
+	RetryCount    *int                          `json:"retryCount,omitempty"`
+	StepRetries   *int                          `json:"stepRetries,omitempty"`
+	WorkflowRunId openapi_types.UUID            `json:"workflowRunId"`
+}
+
+// WorkflowRunStreamEventType defines model for WorkflowRunStreamEventType.
+type WorkflowRunStreamEventType string
+
+// WorkflowRunStreamResourceType defines model for WorkflowRunStreamResourceType.
+type WorkflowRunStreamResourceType string
+
 // WorkflowRunTriggeredBy defines model for WorkflowRunTriggeredBy.
 type WorkflowRunTriggeredBy struct {
 	CronParentId        *string         `json:"cronParentId,omitempty"`
@@ -1436,11 +2429,17 @@ type WorkflowVersion struct {
 	Concurrency *WorkflowConcurrency `json:"concurrency,omitempty"`
 
 	// DefaultPriority The default priority of the workflow.
-	DefaultPriority *int32          `json:"defaultPriority,omitempty"`
-	Jobs            *[]Job          `json:"jobs,omitempty"`
-	Metadata        APIResourceMeta `json:"metadata"`
-	Order           int32           `json:"order"`
-	ScheduleTimeout *string         `json:"scheduleTimeout,omitempty"`
+	DefaultPriority *int32 `json:"defaultPriority,omitempty"`
+
+	// InputSchema The JSON schema of the input of the workflow runs, which can be used to generate a form for triggering the workflow.
+	InputSchema *map[string]interface{} `json:"inputSchema,omitempty"`
+	Jobs        *[]Job                  `json:"jobs,omitempty"`
+	Metadata    APIResourceMeta         `json:"metadata"`
+	Order       int32                   `json:"order"`
+
+	// ReservedSlots The number of slots which the workflow runs reserve up front, so that a DAG isn't starved halfway through.
+	ReservedSlots   *int32  `json:"reservedSlots,omitempty"`
+	ScheduleTimeout *string `json:"scheduleTimeout,omitempty"`
 
 	// Sticky The sticky strategy of the workflow.
 	Sticky   *string           `json:"sticky,omitempty"`
@@ -1502,6 +2501,9 @@ type LogLineListParams struct {
 	// Search The search query to filter for
 	Search *LogLineSearch `form:"search,omitempty" json:"search,omitempty"`
 
+	// AfterId Only return log lines with an id greater than this id, in the order they were stored. This is used to tail the log lines of a step run.
+	AfterId *int64 `form:"afterId,omitempty" json:"afterId,omitempty"`
+
 	// OrderByField What to order by
 	OrderByField *LogLineOrderByField `form:"orderByField,omitempty" json:"orderByField,omitempty"`
 
@@ -1509,6 +2511,69 @@ type LogLineListParams struct {
 	OrderByDirection *LogLineOrderByDirection `form:"orderByDirection,omitempty" json:"orderByDirection,omitempty"`
 }
 
+// LogLineStreamParams defines parameters for LogLineStream.
+type LogLineStreamParams struct {
+	// Levels A list of levels to filter by
+	Levels *LogLineLevelField `form:"levels,omitempty" json:"levels,omitempty"`
+}
+
+// StepRunListSchedulingDecisionsParams defines parameters for StepRunListSchedulingDecisions.
+type StepRunListSchedulingDecisionsParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ApprovalListParams defines parameters for ApprovalList.
+type ApprovalListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Statuses A list of approval statuses to filter by
+	Statuses *[]StepRunApprovalStatus `form:"statuses,omitempty" json:"statuses,omitempty"`
+}
+
+// AuditLogListParams defines parameters for AuditLogList.
+type AuditLogListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// ActorId Only list the API calls of a user or API token
+	ActorId *openapi_types.UUID `form:"actorId,omitempty" json:"actorId,omitempty"`
+
+	// Operation Only list the API calls of an operation
+	Operation *string `form:"operation,omitempty" json:"operation,omitempty"`
+
+	// ResourceType Only list the API calls which operated on a resource type
+	ResourceType *string `form:"resourceType,omitempty" json:"resourceType,omitempty"`
+
+	// ResourceId Only list the API calls which operated on a resource
+	ResourceId *string `form:"resourceId,omitempty" json:"resourceId,omitempty"`
+
+	// Since Only list the API calls which were made at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only list the API calls which were made before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
+
+// DeadLetterQueueListParams defines parameters for DeadLetterQueueList.
+type DeadLetterQueueListParams struct {
+	// Offset The number to skip
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit The number to limit by
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // EventListParams defines parameters for EventList.
 type EventListParams struct {
 	// Offset The number to skip
@@ -1549,6 +2614,9 @@ type TenantGetQueueMetricsParams struct {
 
 	// AdditionalMetadata A list of metadata key value pairs to filter by
 	AdditionalMetadata *[]string `form:"additionalMetadata,omitempty" json:"additionalMetadata,omitempty"`
+
+	// Window The window of the queue metrics history to return, as a duration like 5m or 1h. If set, the history of every queue over the window is returned. The window can be at most 24h.
+	Window *string `form:"window,omitempty" json:"window,omitempty"`
 }
 
 // RateLimitListParams defines parameters for RateLimitList.
@@ -1598,18 +2666,27 @@ type CronWorkflowListParams struct {
 
 // WorkflowRunListParams defines parameters for WorkflowRunList.
 type WorkflowRunListParams struct {
-	// Offset The number to skip
+	// Offset The number to skip, which is ignored when a cursor is set. Deprecated in favor of the cursor, which is faster on large tenants.
 	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
 
 	// Limit The number to limit by
 	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
 
+	// Cursor The cursor returned as nextCursor by the previous page. The order by field and direction must match the previous page. The runs aren't counted when a cursor is set, so the response has no pagination.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
 	// EventId The event id to get runs for.
 	EventId *openapi_types.UUID `form:"eventId,omitempty" json:"eventId,omitempty"`
 
+	// EventKey The key of the event which triggered the runs.
+	EventKey *string `form:"eventKey,omitempty" json:"eventKey,omitempty"`
+
 	// WorkflowId The workflow id to get runs for.
 	WorkflowId *openapi_types.UUID `form:"workflowId,omitempty" json:"workflowId,omitempty"`
 
+	// WorkflowIds A list of workflow ids to get runs for.
+	WorkflowIds *[]openapi_types.UUID `form:"workflowIds,omitempty" json:"workflowIds,omitempty"`
+
 	// ParentWorkflowRunId The parent workflow run id
 	ParentWorkflowRunId *openapi_types.UUID `form:"parentWorkflowRunId,omitempty" json:"parentWorkflowRunId,omitempty"`
 
@@ -1698,6 +2775,12 @@ type WorkflowScheduledListParams struct {
 	Statuses *[]ScheduledRunStatus `form:"statuses,omitempty" json:"statuses,omitempty"`
 }
 
+// WorkflowListConcurrencyGroupsParams defines parameters for WorkflowListConcurrencyGroups.
+type WorkflowListConcurrencyGroupsParams struct {
+	// Limit The maximum number of groups to return
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // WorkflowGetMetricsParams defines parameters for WorkflowGetMetrics.
 type WorkflowGetMetricsParams struct {
 	// Status A status of workflow run statuses to filter by
@@ -1722,6 +2805,12 @@ type WorkflowVersionGetParams struct {
 // AlertEmailGroupUpdateJSONRequestBody defines body for AlertEmailGroupUpdate for application/json ContentType.
 type AlertEmailGroupUpdateJSONRequestBody = UpdateTenantAlertEmailGroupRequest
 
+// ApprovalUpdateDecideWithTokenJSONRequestBody defines body for ApprovalUpdateDecideWithToken for application/json ContentType.
+type ApprovalUpdateDecideWithTokenJSONRequestBody = DecideStepRunApprovalRequest
+
+// ApprovalUpdateDecideJSONRequestBody defines body for ApprovalUpdateDecide for application/json ContentType.
+type ApprovalUpdateDecideJSONRequestBody = DecideStepRunApprovalRequest
+
 // TenantCreateJSONRequestBody defines body for TenantCreate for application/json ContentType.
 type TenantCreateJSONRequestBody = CreateTenantRequest
 
@@ -1734,9 +2823,36 @@ type AlertEmailGroupCreateJSONRequestBody = CreateTenantAlertEmailGroupRequest
 // ApiTokenCreateJSONRequestBody defines body for ApiTokenCreate for application/json ContentType.
 type ApiTokenCreateJSONRequestBody = CreateAPITokenRequest
 
+// ApiTokenUpdateRotateJSONRequestBody defines body for ApiTokenUpdateRotate for application/json ContentType.
+type ApiTokenUpdateRotateJSONRequestBody = RotateAPITokenRequest
+
+// ClientCaCreateJSONRequestBody defines body for ClientCaCreate for application/json ContentType.
+type ClientCaCreateJSONRequestBody = CreateClientCARequest
+
+// CronCalendarCreateJSONRequestBody defines body for CronCalendarCreate for application/json ContentType.
+type CronCalendarCreateJSONRequestBody = CreateCronExclusionCalendarRequest
+
+// DeadLetterQueueDeleteJSONRequestBody defines body for DeadLetterQueueDelete for application/json ContentType.
+type DeadLetterQueueDeleteJSONRequestBody = PurgeDeadLetterQueueItemsRequest
+
+// DeadLetterQueueUpdateReplayJSONRequestBody defines body for DeadLetterQueueUpdateReplay for application/json ContentType.
+type DeadLetterQueueUpdateReplayJSONRequestBody = ReplayDeadLetterQueueItemsRequest
+
+// EventDedupRuleUpsertJSONRequestBody defines body for EventDedupRuleUpsert for application/json ContentType.
+type EventDedupRuleUpsertJSONRequestBody = UpsertEventDedupRuleRequest
+
+// EventRoutingRuleUpsertJSONRequestBody defines body for EventRoutingRuleUpsert for application/json ContentType.
+type EventRoutingRuleUpsertJSONRequestBody = UpsertEventRoutingRuleRequest
+
+// EventSinkCreateJSONRequestBody defines body for EventSinkCreate for application/json ContentType.
+type EventSinkCreateJSONRequestBody = CreateEventSinkRequest
+
 // EventCreateJSONRequestBody defines body for EventCreate for application/json ContentType.
 type EventCreateJSONRequestBody = CreateEventRequest
 
+// EventCreateBatchJSONRequestBody defines body for EventCreateBatch for application/json ContentType.
+type EventCreateBatchJSONRequestBody = BatchCreateEventRequest
+
 // EventCreateBulkJSONRequestBody defines body for EventCreateBulk for application/json ContentType.
 type EventCreateBulkJSONRequestBody = BulkCreateEventRequest
 
@@ -1746,6 +2862,12 @@ type EventUpdateCancelJSONRequestBody = CancelEventRequest
 // EventUpdateReplayJSONRequestBody defines body for EventUpdateReplay for application/json ContentType.
 type EventUpdateReplayJSONRequestBody = ReplayEventRequest
 
+// EventUpdateReplayRangeJSONRequestBody defines body for EventUpdateReplayRange for application/json ContentType.
+type EventUpdateReplayRangeJSONRequestBody = ReplayEventRangeRequest
+
+// InboundWebhookCreateJSONRequestBody defines body for InboundWebhookCreate for application/json ContentType.
+type InboundWebhookCreateJSONRequestBody = CreateInboundWebhookRequest
+
 // TenantInviteCreateJSONRequestBody defines body for TenantInviteCreate for application/json ContentType.
 type TenantInviteCreateJSONRequestBody = CreateTenantInviteRequest
 
@@ -1755,6 +2877,9 @@ type TenantInviteUpdateJSONRequestBody = UpdateTenantInviteRequest
 // SnsCreateJSONRequestBody defines body for SnsCreate for application/json ContentType.
 type SnsCreateJSONRequestBody = CreateSNSIntegrationRequest
 
+// SqsCreateJSONRequestBody defines body for SqsCreate for application/json ContentType.
+type SqsCreateJSONRequestBody = CreateSQSIntegrationRequest
+
 // StepRunUpdateRerunJSONRequestBody defines body for StepRunUpdateRerun for application/json ContentType.
 type StepRunUpdateRerunJSONRequestBody = RerunStepRunRequest
 
@@ -1764,9 +2889,15 @@ type WebhookCreateJSONRequestBody = WebhookWorkerCreateRequest
 // WorkflowRunUpdateReplayJSONRequestBody defines body for WorkflowRunUpdateReplay for application/json ContentType.
 type WorkflowRunUpdateReplayJSONRequestBody = ReplayWorkflowRunsRequest
 
+// WorkflowRunUpdateReplayFromStepJSONRequestBody defines body for WorkflowRunUpdateReplayFromStep for application/json ContentType.
+type WorkflowRunUpdateReplayFromStepJSONRequestBody = ReplayWorkflowRunFromStepRequest
+
 // WorkflowRunCancelJSONRequestBody defines body for WorkflowRunCancel for application/json ContentType.
 type WorkflowRunCancelJSONRequestBody = WorkflowRunsCancelRequest
 
+// WorkflowScheduledUpdateJSONRequestBody defines body for WorkflowScheduledUpdate for application/json ContentType.
+type WorkflowScheduledUpdateJSONRequestBody = UpdateScheduledWorkflowRunRequest
+
 // CronWorkflowTriggerCreateJSONRequestBody defines body for CronWorkflowTriggerCreate for application/json ContentType.
 type CronWorkflowTriggerCreateJSONRequestBody = CreateCronWorkflowTriggerRequest
 
@@ -1794,6 +2925,9 @@ type WorkerUpdateJSONRequestBody = UpdateWorkerRequest
 // WorkflowUpdateJSONRequestBody defines body for WorkflowUpdate for application/json ContentType.
 type WorkflowUpdateJSONRequestBody = WorkflowUpdateRequest
 
+// WorkflowUpdateRolloutJSONRequestBody defines body for WorkflowUpdateRollout for application/json ContentType.
+type WorkflowUpdateRolloutJSONRequestBody = UpdateWorkflowRolloutRequest
+
 // WorkflowRunCreateJSONRequestBody defines body for WorkflowRunCreate for application/json ContentType.
 type WorkflowRunCreateJSONRequestBody = TriggerWorkflowRunRequest
 
@@ -1870,12 +3004,18 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// HealthzGet request
+	HealthzGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// LivenessGet request
 	LivenessGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ReadinessGet request
 	ReadinessGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ReadyzGet request
+	ReadyzGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AlertEmailGroupDelete request
 	AlertEmailGroupDelete(ctx context.Context, alertEmailGroup openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1887,15 +3027,54 @@ type ClientInterface interface {
 	// ApiTokenUpdateRevoke request
 	ApiTokenUpdateRevoke(ctx context.Context, apiToken openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ApiTokenUpdateRotateWithBody request with any body
+	ApiTokenUpdateRotateWithBody(ctx context.Context, apiToken openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApiTokenUpdateRotate(ctx context.Context, apiToken openapi_types.UUID, body ApiTokenUpdateRotateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApprovalUpdateDecideWithTokenWithBody request with any body
+	ApprovalUpdateDecideWithTokenWithBody(ctx context.Context, approvalToken string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApprovalUpdateDecideWithToken(ctx context.Context, approvalToken string, body ApprovalUpdateDecideWithTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApprovalGet request
+	ApprovalGet(ctx context.Context, approval openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApprovalUpdateDecideWithBody request with any body
+	ApprovalUpdateDecideWithBody(ctx context.Context, approval openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApprovalUpdateDecide(ctx context.Context, approval openapi_types.UUID, body ApprovalUpdateDecideJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ClientCaDelete request
+	ClientCaDelete(ctx context.Context, clientCa openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CloudMetadataGet request
 	CloudMetadataGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// EventDedupRuleDelete request
+	EventDedupRuleDelete(ctx context.Context, eventDedupRule openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EventRoutingRuleDelete request
+	EventRoutingRuleDelete(ctx context.Context, eventRoutingRule openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EventSinkDelete request
+	EventSinkDelete(ctx context.Context, eventSink openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EventSinkReplay request
+	EventSinkReplay(ctx context.Context, eventSink openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// EventGet request
 	EventGet(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// EventDataGet request
 	EventDataGet(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// InboundWebhookDelete request
+	InboundWebhookDelete(ctx context.Context, inboundWebhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// InboundWebhookReceive request
+	InboundWebhookReceive(ctx context.Context, inboundWebhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// MetadataGet request
 	MetadataGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1911,6 +3090,9 @@ type ClientInterface interface {
 	// SnsUpdate request
 	SnsUpdate(ctx context.Context, tenant openapi_types.UUID, event string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// SqsDelete request
+	SqsDelete(ctx context.Context, sqs openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// StepRunListArchives request
 	StepRunListArchives(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListArchivesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1920,6 +3102,12 @@ type ClientInterface interface {
 	// LogLineList request
 	LogLineList(ctx context.Context, stepRun openapi_types.UUID, params *LogLineListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// LogLineStream request
+	LogLineStream(ctx context.Context, stepRun openapi_types.UUID, params *LogLineStreamParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StepRunListSchedulingDecisions request
+	StepRunListSchedulingDecisions(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListSchedulingDecisionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// TenantCreateWithBody request with any body
 	TenantCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1949,6 +3137,68 @@ type ClientInterface interface {
 
 	ApiTokenCreate(ctx context.Context, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ApprovalList request
+	ApprovalList(ctx context.Context, tenant openapi_types.UUID, params *ApprovalListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuditLogList request
+	AuditLogList(ctx context.Context, tenant openapi_types.UUID, params *AuditLogListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ClientCaList request
+	ClientCaList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ClientCaCreateWithBody request with any body
+	ClientCaCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ClientCaCreate(ctx context.Context, tenant openapi_types.UUID, body ClientCaCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CronCalendarList request
+	CronCalendarList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CronCalendarCreateWithBody request with any body
+	CronCalendarCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CronCalendarCreate(ctx context.Context, tenant openapi_types.UUID, body CronCalendarCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CronCalendarDelete request
+	CronCalendarDelete(ctx context.Context, tenant openapi_types.UUID, cronCalendar openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeadLetterQueueList request
+	DeadLetterQueueList(ctx context.Context, tenant openapi_types.UUID, params *DeadLetterQueueListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeadLetterQueueDeleteWithBody request with any body
+	DeadLetterQueueDeleteWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeadLetterQueueDelete(ctx context.Context, tenant openapi_types.UUID, body DeadLetterQueueDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeadLetterQueueUpdateReplayWithBody request with any body
+	DeadLetterQueueUpdateReplayWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeadLetterQueueUpdateReplay(ctx context.Context, tenant openapi_types.UUID, body DeadLetterQueueUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EventDedupRuleList request
+	EventDedupRuleList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EventDedupRuleUpsertWithBody request with any body
+	EventDedupRuleUpsertWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EventDedupRuleUpsert(ctx context.Context, tenant openapi_types.UUID, body EventDedupRuleUpsertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EventRoutingRuleList request
+	EventRoutingRuleList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EventRoutingRuleUpsertWithBody request with any body
+	EventRoutingRuleUpsertWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EventRoutingRuleUpsert(ctx context.Context, tenant openapi_types.UUID, body EventRoutingRuleUpsertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EventSinkList request
+	EventSinkList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EventSinkCreateWithBody request with any body
+	EventSinkCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EventSinkCreate(ctx context.Context, tenant openapi_types.UUID, body EventSinkCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// EventList request
 	EventList(ctx context.Context, tenant openapi_types.UUID, params *EventListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1957,6 +3207,11 @@ type ClientInterface interface {
 
 	EventCreate(ctx context.Context, tenant openapi_types.UUID, body EventCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// EventCreateBatchWithBody request with any body
+	EventCreateBatchWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EventCreateBatch(ctx context.Context, tenant openapi_types.UUID, body EventCreateBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// EventCreateBulkWithBody request with any body
 	EventCreateBulkWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1967,6 +3222,9 @@ type ClientInterface interface {
 
 	EventUpdateCancel(ctx context.Context, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// EventCreateCloudEvent request
+	EventCreateCloudEvent(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// EventKeyList request
 	EventKeyList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1975,6 +3233,19 @@ type ClientInterface interface {
 
 	EventUpdateReplay(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// EventUpdateReplayRangeWithBody request with any body
+	EventUpdateReplayRangeWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EventUpdateReplayRange(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayRangeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// InboundWebhookList request
+	InboundWebhookList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// InboundWebhookCreateWithBody request with any body
+	InboundWebhookCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	InboundWebhookCreate(ctx context.Context, tenant openapi_types.UUID, body InboundWebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// TenantInviteList request
 	TenantInviteList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2000,6 +3271,15 @@ type ClientInterface interface {
 	// TenantGetQueueMetrics request
 	TenantGetQueueMetrics(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// QueueUpdatePause request
+	QueueUpdatePause(ctx context.Context, tenant openapi_types.UUID, queue string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// QueueUpdateResume request
+	QueueUpdateResume(ctx context.Context, tenant openapi_types.UUID, queue string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TenantQuotaList request
+	TenantQuotaList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// RateLimitList request
 	RateLimitList(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2020,6 +3300,14 @@ type ClientInterface interface {
 
 	SnsCreate(ctx context.Context, tenant openapi_types.UUID, body SnsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// SqsList request
+	SqsList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SqsCreateWithBody request with any body
+	SqsCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SqsCreate(ctx context.Context, tenant openapi_types.UUID, body SqsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// TenantGetStepRunQueueMetrics request
 	TenantGetStepRunQueueMetrics(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2048,6 +3336,9 @@ type ClientInterface interface {
 	// WorkerList request
 	WorkerList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// WorkerPoolList request
+	WorkerPoolList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// WorkflowRunUpdateReplayWithBody request with any body
 	WorkflowRunUpdateReplayWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2059,12 +3350,26 @@ type ClientInterface interface {
 	// WorkflowRunGetInput request
 	WorkflowRunGetInput(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// WorkflowRunUpdatePause request
+	WorkflowRunUpdatePause(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WorkflowRunUpdateReplayFromStepWithBody request with any body
+	WorkflowRunUpdateReplayFromStepWithBody(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	WorkflowRunUpdateReplayFromStep(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, body WorkflowRunUpdateReplayFromStepJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WorkflowRunUpdateResume request
+	WorkflowRunUpdateResume(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// WorkflowRunGetShape request
 	WorkflowRunGetShape(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// WorkflowRunListStepRunEvents request
 	WorkflowRunListStepRunEvents(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// WorkflowRunGetStream request
+	WorkflowRunGetStream(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// WorkflowList request
 	WorkflowList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2097,6 +3402,11 @@ type ClientInterface interface {
 	// WorkflowScheduledGet request
 	WorkflowScheduledGet(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// WorkflowScheduledUpdateWithBody request with any body
+	WorkflowScheduledUpdateWithBody(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	WorkflowScheduledUpdate(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, body WorkflowScheduledUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CronWorkflowTriggerCreateWithBody request with any body
 	CronWorkflowTriggerCreateWithBody(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2149,6 +3459,12 @@ type ClientInterface interface {
 	// TenantMembershipsList request
 	TenantMembershipsList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UserUpdateOidcCallback request
+	UserUpdateOidcCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UserUpdateOidcStart request
+	UserUpdateOidcStart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UserUpdatePasswordWithBody request with any body
 	UserUpdatePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2187,9 +3503,23 @@ type ClientInterface interface {
 
 	WorkflowUpdate(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// WorkflowListConcurrencyGroups request
+	WorkflowListConcurrencyGroups(ctx context.Context, workflow openapi_types.UUID, params *WorkflowListConcurrencyGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// WorkflowGetMetrics request
 	WorkflowGetMetrics(ctx context.Context, workflow openapi_types.UUID, params *WorkflowGetMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// WorkflowDeleteRollout request
+	WorkflowDeleteRollout(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WorkflowGetRollout request
+	WorkflowGetRollout(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WorkflowUpdateRolloutWithBody request with any body
+	WorkflowUpdateRolloutWithBody(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	WorkflowUpdateRollout(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateRolloutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// WorkflowRunCreateWithBody request with any body
 	WorkflowRunCreateWithBody(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2199,6 +3529,18 @@ type ClientInterface interface {
 	WorkflowVersionGet(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
+func (c *Client) HealthzGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthzGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) LivenessGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewLivenessGetRequest(c.Server)
 	if err != nil {
@@ -2223,6 +3565,18 @@ func (c *Client) ReadinessGet(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
+func (c *Client) ReadyzGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReadyzGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) AlertEmailGroupDelete(ctx context.Context, alertEmailGroup openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewAlertEmailGroupDeleteRequest(c.Server, alertEmailGroup)
 	if err != nil {
@@ -2271,8 +3625,8 @@ func (c *Client) ApiTokenUpdateRevoke(ctx context.Context, apiToken openapi_type
 	return c.Client.Do(req)
 }
 
-func (c *Client) CloudMetadataGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCloudMetadataGetRequest(c.Server)
+func (c *Client) ApiTokenUpdateRotateWithBody(ctx context.Context, apiToken openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiTokenUpdateRotateRequestWithBody(c.Server, apiToken, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2283,8 +3637,8 @@ func (c *Client) CloudMetadataGet(ctx context.Context, reqEditors ...RequestEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventGet(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventGetRequest(c.Server, event)
+func (c *Client) ApiTokenUpdateRotate(ctx context.Context, apiToken openapi_types.UUID, body ApiTokenUpdateRotateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiTokenUpdateRotateRequest(c.Server, apiToken, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2295,8 +3649,8 @@ func (c *Client) EventGet(ctx context.Context, event openapi_types.UUID, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventDataGet(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventDataGetRequest(c.Server, event)
+func (c *Client) ApprovalUpdateDecideWithTokenWithBody(ctx context.Context, approvalToken string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApprovalUpdateDecideWithTokenRequestWithBody(c.Server, approvalToken, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2307,8 +3661,8 @@ func (c *Client) EventDataGet(ctx context.Context, event openapi_types.UUID, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) MetadataGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMetadataGetRequest(c.Server)
+func (c *Client) ApprovalUpdateDecideWithToken(ctx context.Context, approvalToken string, body ApprovalUpdateDecideWithTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApprovalUpdateDecideWithTokenRequest(c.Server, approvalToken, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2319,8 +3673,8 @@ func (c *Client) MetadataGet(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) MetadataListIntegrations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMetadataListIntegrationsRequest(c.Server)
+func (c *Client) ApprovalGet(ctx context.Context, approval openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApprovalGetRequest(c.Server, approval)
 	if err != nil {
 		return nil, err
 	}
@@ -2331,8 +3685,8 @@ func (c *Client) MetadataListIntegrations(ctx context.Context, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) SlackWebhookDelete(ctx context.Context, slack openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlackWebhookDeleteRequest(c.Server, slack)
+func (c *Client) ApprovalUpdateDecideWithBody(ctx context.Context, approval openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApprovalUpdateDecideRequestWithBody(c.Server, approval, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2343,8 +3697,8 @@ func (c *Client) SlackWebhookDelete(ctx context.Context, slack openapi_types.UUI
 	return c.Client.Do(req)
 }
 
-func (c *Client) SnsDelete(ctx context.Context, sns openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSnsDeleteRequest(c.Server, sns)
+func (c *Client) ApprovalUpdateDecide(ctx context.Context, approval openapi_types.UUID, body ApprovalUpdateDecideJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApprovalUpdateDecideRequest(c.Server, approval, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2355,8 +3709,8 @@ func (c *Client) SnsDelete(ctx context.Context, sns openapi_types.UUID, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) SnsUpdate(ctx context.Context, tenant openapi_types.UUID, event string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSnsUpdateRequest(c.Server, tenant, event)
+func (c *Client) ClientCaDelete(ctx context.Context, clientCa openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewClientCaDeleteRequest(c.Server, clientCa)
 	if err != nil {
 		return nil, err
 	}
@@ -2367,8 +3721,8 @@ func (c *Client) SnsUpdate(ctx context.Context, tenant openapi_types.UUID, event
 	return c.Client.Do(req)
 }
 
-func (c *Client) StepRunListArchives(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListArchivesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStepRunListArchivesRequest(c.Server, stepRun, params)
+func (c *Client) CloudMetadataGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloudMetadataGetRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -2379,8 +3733,8 @@ func (c *Client) StepRunListArchives(ctx context.Context, stepRun openapi_types.
 	return c.Client.Do(req)
 }
 
-func (c *Client) StepRunListEvents(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStepRunListEventsRequest(c.Server, stepRun, params)
+func (c *Client) EventDedupRuleDelete(ctx context.Context, eventDedupRule openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventDedupRuleDeleteRequest(c.Server, eventDedupRule)
 	if err != nil {
 		return nil, err
 	}
@@ -2391,8 +3745,8 @@ func (c *Client) StepRunListEvents(ctx context.Context, stepRun openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) LogLineList(ctx context.Context, stepRun openapi_types.UUID, params *LogLineListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewLogLineListRequest(c.Server, stepRun, params)
+func (c *Client) EventRoutingRuleDelete(ctx context.Context, eventRoutingRule openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventRoutingRuleDeleteRequest(c.Server, eventRoutingRule)
 	if err != nil {
 		return nil, err
 	}
@@ -2403,8 +3757,8 @@ func (c *Client) LogLineList(ctx context.Context, stepRun openapi_types.UUID, pa
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantCreateRequestWithBody(c.Server, contentType, body)
+func (c *Client) EventSinkDelete(ctx context.Context, eventSink openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventSinkDeleteRequest(c.Server, eventSink)
 	if err != nil {
 		return nil, err
 	}
@@ -2415,8 +3769,8 @@ func (c *Client) TenantCreateWithBody(ctx context.Context, contentType string, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantCreate(ctx context.Context, body TenantCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantCreateRequest(c.Server, body)
+func (c *Client) EventSinkReplay(ctx context.Context, eventSink openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventSinkReplayRequest(c.Server, eventSink)
 	if err != nil {
 		return nil, err
 	}
@@ -2427,8 +3781,8 @@ func (c *Client) TenantCreate(ctx context.Context, body TenantCreateJSONRequestB
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantUpdateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantUpdateRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) EventGet(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventGetRequest(c.Server, event)
 	if err != nil {
 		return nil, err
 	}
@@ -2439,8 +3793,8 @@ func (c *Client) TenantUpdateWithBody(ctx context.Context, tenant openapi_types.
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantUpdate(ctx context.Context, tenant openapi_types.UUID, body TenantUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantUpdateRequest(c.Server, tenant, body)
+func (c *Client) EventDataGet(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventDataGetRequest(c.Server, event)
 	if err != nil {
 		return nil, err
 	}
@@ -2451,8 +3805,8 @@ func (c *Client) TenantUpdate(ctx context.Context, tenant openapi_types.UUID, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) AlertEmailGroupList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAlertEmailGroupListRequest(c.Server, tenant)
+func (c *Client) InboundWebhookDelete(ctx context.Context, inboundWebhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInboundWebhookDeleteRequest(c.Server, inboundWebhook)
 	if err != nil {
 		return nil, err
 	}
@@ -2463,8 +3817,8 @@ func (c *Client) AlertEmailGroupList(ctx context.Context, tenant openapi_types.U
 	return c.Client.Do(req)
 }
 
-func (c *Client) AlertEmailGroupCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAlertEmailGroupCreateRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) InboundWebhookReceive(ctx context.Context, inboundWebhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInboundWebhookReceiveRequest(c.Server, inboundWebhook)
 	if err != nil {
 		return nil, err
 	}
@@ -2475,8 +3829,8 @@ func (c *Client) AlertEmailGroupCreateWithBody(ctx context.Context, tenant opena
 	return c.Client.Do(req)
 }
 
-func (c *Client) AlertEmailGroupCreate(ctx context.Context, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAlertEmailGroupCreateRequest(c.Server, tenant, body)
+func (c *Client) MetadataGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataGetRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -2487,8 +3841,8 @@ func (c *Client) AlertEmailGroupCreate(ctx context.Context, tenant openapi_types
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantAlertingSettingsGet(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantAlertingSettingsGetRequest(c.Server, tenant)
+func (c *Client) MetadataListIntegrations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetadataListIntegrationsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -2499,8 +3853,8 @@ func (c *Client) TenantAlertingSettingsGet(ctx context.Context, tenant openapi_t
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApiTokenList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApiTokenListRequest(c.Server, tenant)
+func (c *Client) SlackWebhookDelete(ctx context.Context, slack openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSlackWebhookDeleteRequest(c.Server, slack)
 	if err != nil {
 		return nil, err
 	}
@@ -2511,8 +3865,8 @@ func (c *Client) ApiTokenList(ctx context.Context, tenant openapi_types.UUID, re
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApiTokenCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApiTokenCreateRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) SnsDelete(ctx context.Context, sns openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSnsDeleteRequest(c.Server, sns)
 	if err != nil {
 		return nil, err
 	}
@@ -2523,8 +3877,8 @@ func (c *Client) ApiTokenCreateWithBody(ctx context.Context, tenant openapi_type
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApiTokenCreate(ctx context.Context, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApiTokenCreateRequest(c.Server, tenant, body)
+func (c *Client) SnsUpdate(ctx context.Context, tenant openapi_types.UUID, event string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSnsUpdateRequest(c.Server, tenant, event)
 	if err != nil {
 		return nil, err
 	}
@@ -2535,8 +3889,8 @@ func (c *Client) ApiTokenCreate(ctx context.Context, tenant openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventList(ctx context.Context, tenant openapi_types.UUID, params *EventListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventListRequest(c.Server, tenant, params)
+func (c *Client) SqsDelete(ctx context.Context, sqs openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSqsDeleteRequest(c.Server, sqs)
 	if err != nil {
 		return nil, err
 	}
@@ -2547,8 +3901,8 @@ func (c *Client) EventList(ctx context.Context, tenant openapi_types.UUID, param
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventCreateRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) StepRunListArchives(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListArchivesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunListArchivesRequest(c.Server, stepRun, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2559,8 +3913,8 @@ func (c *Client) EventCreateWithBody(ctx context.Context, tenant openapi_types.U
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventCreate(ctx context.Context, tenant openapi_types.UUID, body EventCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventCreateRequest(c.Server, tenant, body)
+func (c *Client) StepRunListEvents(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunListEventsRequest(c.Server, stepRun, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2571,8 +3925,8 @@ func (c *Client) EventCreate(ctx context.Context, tenant openapi_types.UUID, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventCreateBulkWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventCreateBulkRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) LogLineList(ctx context.Context, stepRun openapi_types.UUID, params *LogLineListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogLineListRequest(c.Server, stepRun, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2583,8 +3937,8 @@ func (c *Client) EventCreateBulkWithBody(ctx context.Context, tenant openapi_typ
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventCreateBulk(ctx context.Context, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventCreateBulkRequest(c.Server, tenant, body)
+func (c *Client) LogLineStream(ctx context.Context, stepRun openapi_types.UUID, params *LogLineStreamParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogLineStreamRequest(c.Server, stepRun, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2595,8 +3949,8 @@ func (c *Client) EventCreateBulk(ctx context.Context, tenant openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventUpdateCancelWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventUpdateCancelRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) StepRunListSchedulingDecisions(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListSchedulingDecisionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunListSchedulingDecisionsRequest(c.Server, stepRun, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2607,8 +3961,8 @@ func (c *Client) EventUpdateCancelWithBody(ctx context.Context, tenant openapi_t
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventUpdateCancel(ctx context.Context, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventUpdateCancelRequest(c.Server, tenant, body)
+func (c *Client) TenantCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantCreateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2619,8 +3973,8 @@ func (c *Client) EventUpdateCancel(ctx context.Context, tenant openapi_types.UUI
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventKeyList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventKeyListRequest(c.Server, tenant)
+func (c *Client) TenantCreate(ctx context.Context, body TenantCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantCreateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2631,8 +3985,8 @@ func (c *Client) EventKeyList(ctx context.Context, tenant openapi_types.UUID, re
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventUpdateReplayWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventUpdateReplayRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) TenantUpdateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantUpdateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2643,8 +3997,8 @@ func (c *Client) EventUpdateReplayWithBody(ctx context.Context, tenant openapi_t
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventUpdateReplay(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventUpdateReplayRequest(c.Server, tenant, body)
+func (c *Client) TenantUpdate(ctx context.Context, tenant openapi_types.UUID, body TenantUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantUpdateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2655,8 +4009,8 @@ func (c *Client) EventUpdateReplay(ctx context.Context, tenant openapi_types.UUI
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteListRequest(c.Server, tenant)
+func (c *Client) AlertEmailGroupList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAlertEmailGroupListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -2667,8 +4021,8 @@ func (c *Client) TenantInviteList(ctx context.Context, tenant openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteCreateRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) AlertEmailGroupCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAlertEmailGroupCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2679,8 +4033,8 @@ func (c *Client) TenantInviteCreateWithBody(ctx context.Context, tenant openapi_
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteCreate(ctx context.Context, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteCreateRequest(c.Server, tenant, body)
+func (c *Client) AlertEmailGroupCreate(ctx context.Context, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAlertEmailGroupCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2691,8 +4045,8 @@ func (c *Client) TenantInviteCreate(ctx context.Context, tenant openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteDelete(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteDeleteRequest(c.Server, tenant, tenantInvite)
+func (c *Client) TenantAlertingSettingsGet(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantAlertingSettingsGetRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -2703,8 +4057,8 @@ func (c *Client) TenantInviteDelete(ctx context.Context, tenant openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteUpdateWithBody(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteUpdateRequestWithBody(c.Server, tenant, tenantInvite, contentType, body)
+func (c *Client) ApiTokenList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiTokenListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -2715,8 +4069,8 @@ func (c *Client) TenantInviteUpdateWithBody(ctx context.Context, tenant openapi_
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteUpdate(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteUpdateRequest(c.Server, tenant, tenantInvite, body)
+func (c *Client) ApiTokenCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiTokenCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2727,8 +4081,8 @@ func (c *Client) TenantInviteUpdate(ctx context.Context, tenant openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantMemberList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantMemberListRequest(c.Server, tenant)
+func (c *Client) ApiTokenCreate(ctx context.Context, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiTokenCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2739,8 +4093,8 @@ func (c *Client) TenantMemberList(ctx context.Context, tenant openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantMemberDelete(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantMemberDeleteRequest(c.Server, tenant, member)
+func (c *Client) ApprovalList(ctx context.Context, tenant openapi_types.UUID, params *ApprovalListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApprovalListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2751,8 +4105,8 @@ func (c *Client) TenantMemberDelete(ctx context.Context, tenant openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantGetQueueMetrics(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantGetQueueMetricsRequest(c.Server, tenant, params)
+func (c *Client) AuditLogList(ctx context.Context, tenant openapi_types.UUID, params *AuditLogListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuditLogListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2763,8 +4117,8 @@ func (c *Client) TenantGetQueueMetrics(ctx context.Context, tenant openapi_types
 	return c.Client.Do(req)
 }
 
-func (c *Client) RateLimitList(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRateLimitListRequest(c.Server, tenant, params)
+func (c *Client) ClientCaList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewClientCaListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -2775,8 +4129,8 @@ func (c *Client) RateLimitList(ctx context.Context, tenant openapi_types.UUID, p
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantResourcePolicyGet(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantResourcePolicyGetRequest(c.Server, tenant)
+func (c *Client) ClientCaCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewClientCaCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2787,8 +4141,8 @@ func (c *Client) TenantResourcePolicyGet(ctx context.Context, tenant openapi_typ
 	return c.Client.Do(req)
 }
 
-func (c *Client) SlackWebhookList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSlackWebhookListRequest(c.Server, tenant)
+func (c *Client) ClientCaCreate(ctx context.Context, tenant openapi_types.UUID, body ClientCaCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewClientCaCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2799,8 +4153,8 @@ func (c *Client) SlackWebhookList(ctx context.Context, tenant openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateSlackOauthStart(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateSlackOauthStartRequest(c.Server, tenant)
+func (c *Client) CronCalendarList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCronCalendarListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -2811,8 +4165,8 @@ func (c *Client) UserUpdateSlackOauthStart(ctx context.Context, tenant openapi_t
 	return c.Client.Do(req)
 }
 
-func (c *Client) SnsList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSnsListRequest(c.Server, tenant)
+func (c *Client) CronCalendarCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCronCalendarCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2823,8 +4177,8 @@ func (c *Client) SnsList(ctx context.Context, tenant openapi_types.UUID, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) SnsCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSnsCreateRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) CronCalendarCreate(ctx context.Context, tenant openapi_types.UUID, body CronCalendarCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCronCalendarCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2835,8 +4189,8 @@ func (c *Client) SnsCreateWithBody(ctx context.Context, tenant openapi_types.UUI
 	return c.Client.Do(req)
 }
 
-func (c *Client) SnsCreate(ctx context.Context, tenant openapi_types.UUID, body SnsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSnsCreateRequest(c.Server, tenant, body)
+func (c *Client) CronCalendarDelete(ctx context.Context, tenant openapi_types.UUID, cronCalendar openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCronCalendarDeleteRequest(c.Server, tenant, cronCalendar)
 	if err != nil {
 		return nil, err
 	}
@@ -2847,8 +4201,8 @@ func (c *Client) SnsCreate(ctx context.Context, tenant openapi_types.UUID, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantGetStepRunQueueMetrics(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantGetStepRunQueueMetricsRequest(c.Server, tenant)
+func (c *Client) DeadLetterQueueList(ctx context.Context, tenant openapi_types.UUID, params *DeadLetterQueueListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeadLetterQueueListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2859,8 +4213,8 @@ func (c *Client) TenantGetStepRunQueueMetrics(ctx context.Context, tenant openap
 	return c.Client.Do(req)
 }
 
-func (c *Client) StepRunGet(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStepRunGetRequest(c.Server, tenant, stepRun)
+func (c *Client) DeadLetterQueueDeleteWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeadLetterQueueDeleteRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2871,8 +4225,8 @@ func (c *Client) StepRunGet(ctx context.Context, tenant openapi_types.UUID, step
 	return c.Client.Do(req)
 }
 
-func (c *Client) StepRunUpdateCancel(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStepRunUpdateCancelRequest(c.Server, tenant, stepRun)
+func (c *Client) DeadLetterQueueDelete(ctx context.Context, tenant openapi_types.UUID, body DeadLetterQueueDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeadLetterQueueDeleteRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2883,8 +4237,8 @@ func (c *Client) StepRunUpdateCancel(ctx context.Context, tenant openapi_types.U
 	return c.Client.Do(req)
 }
 
-func (c *Client) StepRunUpdateRerunWithBody(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStepRunUpdateRerunRequestWithBody(c.Server, tenant, stepRun, contentType, body)
+func (c *Client) DeadLetterQueueUpdateReplayWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeadLetterQueueUpdateReplayRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2895,8 +4249,8 @@ func (c *Client) StepRunUpdateRerunWithBody(ctx context.Context, tenant openapi_
 	return c.Client.Do(req)
 }
 
-func (c *Client) StepRunUpdateRerun(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStepRunUpdateRerunRequest(c.Server, tenant, stepRun, body)
+func (c *Client) DeadLetterQueueUpdateReplay(ctx context.Context, tenant openapi_types.UUID, body DeadLetterQueueUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeadLetterQueueUpdateReplayRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2907,8 +4261,8 @@ func (c *Client) StepRunUpdateRerun(ctx context.Context, tenant openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) StepRunGetSchema(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStepRunGetSchemaRequest(c.Server, tenant, stepRun)
+func (c *Client) EventDedupRuleList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventDedupRuleListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -2919,8 +4273,8 @@ func (c *Client) StepRunGetSchema(ctx context.Context, tenant openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) WebhookList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWebhookListRequest(c.Server, tenant)
+func (c *Client) EventDedupRuleUpsertWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventDedupRuleUpsertRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2931,8 +4285,8 @@ func (c *Client) WebhookList(ctx context.Context, tenant openapi_types.UUID, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) WebhookCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWebhookCreateRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) EventDedupRuleUpsert(ctx context.Context, tenant openapi_types.UUID, body EventDedupRuleUpsertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventDedupRuleUpsertRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2943,8 +4297,8 @@ func (c *Client) WebhookCreateWithBody(ctx context.Context, tenant openapi_types
 	return c.Client.Do(req)
 }
 
-func (c *Client) WebhookCreate(ctx context.Context, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWebhookCreateRequest(c.Server, tenant, body)
+func (c *Client) EventRoutingRuleList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventRoutingRuleListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -2955,8 +4309,8 @@ func (c *Client) WebhookCreate(ctx context.Context, tenant openapi_types.UUID, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkerList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkerListRequest(c.Server, tenant)
+func (c *Client) EventRoutingRuleUpsertWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventRoutingRuleUpsertRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2967,8 +4321,8 @@ func (c *Client) WorkerList(ctx context.Context, tenant openapi_types.UUID, reqE
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunUpdateReplayWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunUpdateReplayRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) EventRoutingRuleUpsert(ctx context.Context, tenant openapi_types.UUID, body EventRoutingRuleUpsertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventRoutingRuleUpsertRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2979,8 +4333,8 @@ func (c *Client) WorkflowRunUpdateReplayWithBody(ctx context.Context, tenant ope
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunUpdateReplay(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunUpdateReplayRequest(c.Server, tenant, body)
+func (c *Client) EventSinkList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventSinkListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -2991,8 +4345,8 @@ func (c *Client) WorkflowRunUpdateReplay(ctx context.Context, tenant openapi_typ
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunGet(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunGetRequest(c.Server, tenant, workflowRun)
+func (c *Client) EventSinkCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventSinkCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3003,8 +4357,8 @@ func (c *Client) WorkflowRunGet(ctx context.Context, tenant openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunGetInput(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunGetInputRequest(c.Server, tenant, workflowRun)
+func (c *Client) EventSinkCreate(ctx context.Context, tenant openapi_types.UUID, body EventSinkCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventSinkCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3015,8 +4369,8 @@ func (c *Client) WorkflowRunGetInput(ctx context.Context, tenant openapi_types.U
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunGetShape(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunGetShapeRequest(c.Server, tenant, workflowRun)
+func (c *Client) EventList(ctx context.Context, tenant openapi_types.UUID, params *EventListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3027,8 +4381,8 @@ func (c *Client) WorkflowRunGetShape(ctx context.Context, tenant openapi_types.U
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunListStepRunEvents(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunListStepRunEventsRequest(c.Server, tenant, workflowRun, params)
+func (c *Client) EventCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3039,8 +4393,8 @@ func (c *Client) WorkflowRunListStepRunEvents(ctx context.Context, tenant openap
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowListRequest(c.Server, tenant)
+func (c *Client) EventCreate(ctx context.Context, tenant openapi_types.UUID, body EventCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3051,8 +4405,8 @@ func (c *Client) WorkflowList(ctx context.Context, tenant openapi_types.UUID, re
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunCancelWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunCancelRequestWithBody(c.Server, tenant, contentType, body)
+func (c *Client) EventCreateBatchWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventCreateBatchRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3063,8 +4417,8 @@ func (c *Client) WorkflowRunCancelWithBody(ctx context.Context, tenant openapi_t
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunCancel(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunCancelRequest(c.Server, tenant, body)
+func (c *Client) EventCreateBatch(ctx context.Context, tenant openapi_types.UUID, body EventCreateBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventCreateBatchRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3075,8 +4429,8 @@ func (c *Client) WorkflowRunCancel(ctx context.Context, tenant openapi_types.UUI
 	return c.Client.Do(req)
 }
 
-func (c *Client) CronWorkflowList(ctx context.Context, tenant openapi_types.UUID, params *CronWorkflowListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCronWorkflowListRequest(c.Server, tenant, params)
+func (c *Client) EventCreateBulkWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventCreateBulkRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3087,8 +4441,8 @@ func (c *Client) CronWorkflowList(ctx context.Context, tenant openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowCronDelete(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowCronDeleteRequest(c.Server, tenant, cronWorkflow)
+func (c *Client) EventCreateBulk(ctx context.Context, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventCreateBulkRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3099,8 +4453,8 @@ func (c *Client) WorkflowCronDelete(ctx context.Context, tenant openapi_types.UU
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowCronGet(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowCronGetRequest(c.Server, tenant, cronWorkflow)
+func (c *Client) EventUpdateCancelWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventUpdateCancelRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3111,8 +4465,8 @@ func (c *Client) WorkflowCronGet(ctx context.Context, tenant openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunList(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunListRequest(c.Server, tenant, params)
+func (c *Client) EventUpdateCancel(ctx context.Context, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventUpdateCancelRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3123,8 +4477,8 @@ func (c *Client) WorkflowRunList(ctx context.Context, tenant openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunGetMetrics(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunGetMetricsRequest(c.Server, tenant, params)
+func (c *Client) EventCreateCloudEvent(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventCreateCloudEventRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3135,8 +4489,8 @@ func (c *Client) WorkflowRunGetMetrics(ctx context.Context, tenant openapi_types
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowScheduledList(ctx context.Context, tenant openapi_types.UUID, params *WorkflowScheduledListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowScheduledListRequest(c.Server, tenant, params)
+func (c *Client) EventKeyList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventKeyListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3147,8 +4501,8 @@ func (c *Client) WorkflowScheduledList(ctx context.Context, tenant openapi_types
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowScheduledDelete(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowScheduledDeleteRequest(c.Server, tenant, scheduledWorkflowRun)
+func (c *Client) EventUpdateReplayWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventUpdateReplayRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3159,8 +4513,8 @@ func (c *Client) WorkflowScheduledDelete(ctx context.Context, tenant openapi_typ
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowScheduledGet(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowScheduledGetRequest(c.Server, tenant, scheduledWorkflowRun)
+func (c *Client) EventUpdateReplay(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventUpdateReplayRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3171,8 +4525,8 @@ func (c *Client) WorkflowScheduledGet(ctx context.Context, tenant openapi_types.
 	return c.Client.Do(req)
 }
 
-func (c *Client) CronWorkflowTriggerCreateWithBody(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCronWorkflowTriggerCreateRequestWithBody(c.Server, tenant, workflow, contentType, body)
+func (c *Client) EventUpdateReplayRangeWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventUpdateReplayRangeRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3183,8 +4537,8 @@ func (c *Client) CronWorkflowTriggerCreateWithBody(ctx context.Context, tenant o
 	return c.Client.Do(req)
 }
 
-func (c *Client) CronWorkflowTriggerCreate(ctx context.Context, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCronWorkflowTriggerCreateRequest(c.Server, tenant, workflow, body)
+func (c *Client) EventUpdateReplayRange(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayRangeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventUpdateReplayRangeRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3195,8 +4549,8 @@ func (c *Client) CronWorkflowTriggerCreate(ctx context.Context, tenant openapi_t
 	return c.Client.Do(req)
 }
 
-func (c *Client) ScheduledWorkflowRunCreateWithBody(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewScheduledWorkflowRunCreateRequestWithBody(c.Server, tenant, workflow, contentType, body)
+func (c *Client) InboundWebhookList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInboundWebhookListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3207,8 +4561,8 @@ func (c *Client) ScheduledWorkflowRunCreateWithBody(ctx context.Context, tenant
 	return c.Client.Do(req)
 }
 
-func (c *Client) ScheduledWorkflowRunCreate(ctx context.Context, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewScheduledWorkflowRunCreateRequest(c.Server, tenant, workflow, body)
+func (c *Client) InboundWebhookCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInboundWebhookCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3219,8 +4573,8 @@ func (c *Client) ScheduledWorkflowRunCreate(ctx context.Context, tenant openapi_
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowGetWorkersCount(ctx context.Context, tenant openapi_types.UUID, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowGetWorkersCountRequest(c.Server, tenant, workflow)
+func (c *Client) InboundWebhookCreate(ctx context.Context, tenant openapi_types.UUID, body InboundWebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInboundWebhookCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3231,8 +4585,8 @@ func (c *Client) WorkflowGetWorkersCount(ctx context.Context, tenant openapi_typ
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserGetCurrent(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserGetCurrentRequest(c.Server)
+func (c *Client) TenantInviteList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3243,8 +4597,8 @@ func (c *Client) UserGetCurrent(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateGithubOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateGithubOauthCallbackRequest(c.Server)
+func (c *Client) TenantInviteCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3255,8 +4609,8 @@ func (c *Client) UserUpdateGithubOauthCallback(ctx context.Context, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateGithubOauthStart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateGithubOauthStartRequest(c.Server)
+func (c *Client) TenantInviteCreate(ctx context.Context, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3267,8 +4621,8 @@ func (c *Client) UserUpdateGithubOauthStart(ctx context.Context, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateGoogleOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateGoogleOauthCallbackRequest(c.Server)
+func (c *Client) TenantInviteDelete(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteDeleteRequest(c.Server, tenant, tenantInvite)
 	if err != nil {
 		return nil, err
 	}
@@ -3279,8 +4633,8 @@ func (c *Client) UserUpdateGoogleOauthCallback(ctx context.Context, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateGoogleOauthStart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateGoogleOauthStartRequest(c.Server)
+func (c *Client) TenantInviteUpdateWithBody(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteUpdateRequestWithBody(c.Server, tenant, tenantInvite, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3291,8 +4645,8 @@ func (c *Client) UserUpdateGoogleOauthStart(ctx context.Context, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserListTenantInvites(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserListTenantInvitesRequest(c.Server)
+func (c *Client) TenantInviteUpdate(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteUpdateRequest(c.Server, tenant, tenantInvite, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3303,8 +4657,8 @@ func (c *Client) UserListTenantInvites(ctx context.Context, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteAcceptWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteAcceptRequestWithBody(c.Server, contentType, body)
+func (c *Client) TenantMemberList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantMemberListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3315,8 +4669,8 @@ func (c *Client) TenantInviteAcceptWithBody(ctx context.Context, contentType str
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteAccept(ctx context.Context, body TenantInviteAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteAcceptRequest(c.Server, body)
+func (c *Client) TenantMemberDelete(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantMemberDeleteRequest(c.Server, tenant, member)
 	if err != nil {
 		return nil, err
 	}
@@ -3327,8 +4681,8 @@ func (c *Client) TenantInviteAccept(ctx context.Context, body TenantInviteAccept
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteRejectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteRejectRequestWithBody(c.Server, contentType, body)
+func (c *Client) TenantGetQueueMetrics(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantGetQueueMetricsRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3339,8 +4693,8 @@ func (c *Client) TenantInviteRejectWithBody(ctx context.Context, contentType str
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantInviteReject(ctx context.Context, body TenantInviteRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantInviteRejectRequest(c.Server, body)
+func (c *Client) QueueUpdatePause(ctx context.Context, tenant openapi_types.UUID, queue string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueueUpdatePauseRequest(c.Server, tenant, queue)
 	if err != nil {
 		return nil, err
 	}
@@ -3351,8 +4705,8 @@ func (c *Client) TenantInviteReject(ctx context.Context, body TenantInviteReject
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateLoginRequestWithBody(c.Server, contentType, body)
+func (c *Client) QueueUpdateResume(ctx context.Context, tenant openapi_types.UUID, queue string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueueUpdateResumeRequest(c.Server, tenant, queue)
 	if err != nil {
 		return nil, err
 	}
@@ -3363,8 +4717,8 @@ func (c *Client) UserUpdateLoginWithBody(ctx context.Context, contentType string
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateLogin(ctx context.Context, body UserUpdateLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateLoginRequest(c.Server, body)
+func (c *Client) TenantQuotaList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantQuotaListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3375,8 +4729,8 @@ func (c *Client) UserUpdateLogin(ctx context.Context, body UserUpdateLoginJSONRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateLogout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateLogoutRequest(c.Server)
+func (c *Client) RateLimitList(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRateLimitListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3387,8 +4741,8 @@ func (c *Client) UserUpdateLogout(ctx context.Context, reqEditors ...RequestEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) TenantMembershipsList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTenantMembershipsListRequest(c.Server)
+func (c *Client) TenantResourcePolicyGet(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantResourcePolicyGetRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3399,8 +4753,8 @@ func (c *Client) TenantMembershipsList(ctx context.Context, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdatePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdatePasswordRequestWithBody(c.Server, contentType, body)
+func (c *Client) SlackWebhookList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSlackWebhookListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3411,8 +4765,8 @@ func (c *Client) UserUpdatePasswordWithBody(ctx context.Context, contentType str
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdatePassword(ctx context.Context, body UserUpdatePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdatePasswordRequest(c.Server, body)
+func (c *Client) UserUpdateSlackOauthStart(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateSlackOauthStartRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3423,8 +4777,8 @@ func (c *Client) UserUpdatePassword(ctx context.Context, body UserUpdatePassword
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserCreateRequestWithBody(c.Server, contentType, body)
+func (c *Client) SnsList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSnsListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3435,8 +4789,8 @@ func (c *Client) UserCreateWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserCreate(ctx context.Context, body UserCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserCreateRequest(c.Server, body)
+func (c *Client) SnsCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSnsCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3447,8 +4801,8 @@ func (c *Client) UserCreate(ctx context.Context, body UserCreateJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UserUpdateSlackOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUserUpdateSlackOauthCallbackRequest(c.Server)
+func (c *Client) SnsCreate(ctx context.Context, tenant openapi_types.UUID, body SnsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSnsCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3459,8 +4813,8 @@ func (c *Client) UserUpdateSlackOauthCallback(ctx context.Context, reqEditors ..
 	return c.Client.Do(req)
 }
 
-func (c *Client) WebhookDelete(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWebhookDeleteRequest(c.Server, webhook)
+func (c *Client) SqsList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSqsListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3471,8 +4825,8 @@ func (c *Client) WebhookDelete(ctx context.Context, webhook openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) WebhookRequestsList(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWebhookRequestsListRequest(c.Server, webhook)
+func (c *Client) SqsCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSqsCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3483,8 +4837,8 @@ func (c *Client) WebhookRequestsList(ctx context.Context, webhook openapi_types.
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkerGet(ctx context.Context, worker openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkerGetRequest(c.Server, worker)
+func (c *Client) SqsCreate(ctx context.Context, tenant openapi_types.UUID, body SqsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSqsCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3495,8 +4849,8 @@ func (c *Client) WorkerGet(ctx context.Context, worker openapi_types.UUID, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkerUpdateWithBody(ctx context.Context, worker openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkerUpdateRequestWithBody(c.Server, worker, contentType, body)
+func (c *Client) TenantGetStepRunQueueMetrics(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantGetStepRunQueueMetricsRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3507,8 +4861,8 @@ func (c *Client) WorkerUpdateWithBody(ctx context.Context, worker openapi_types.
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkerUpdate(ctx context.Context, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkerUpdateRequest(c.Server, worker, body)
+func (c *Client) StepRunGet(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunGetRequest(c.Server, tenant, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -3519,8 +4873,8 @@ func (c *Client) WorkerUpdate(ctx context.Context, worker openapi_types.UUID, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowDelete(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowDeleteRequest(c.Server, workflow)
+func (c *Client) StepRunUpdateCancel(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunUpdateCancelRequest(c.Server, tenant, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -3531,8 +4885,8 @@ func (c *Client) WorkflowDelete(ctx context.Context, workflow openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowGet(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowGetRequest(c.Server, workflow)
+func (c *Client) StepRunUpdateRerunWithBody(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunUpdateRerunRequestWithBody(c.Server, tenant, stepRun, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3543,8 +4897,8 @@ func (c *Client) WorkflowGet(ctx context.Context, workflow openapi_types.UUID, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowUpdateWithBody(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowUpdateRequestWithBody(c.Server, workflow, contentType, body)
+func (c *Client) StepRunUpdateRerun(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunUpdateRerunRequest(c.Server, tenant, stepRun, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3555,8 +4909,8 @@ func (c *Client) WorkflowUpdateWithBody(ctx context.Context, workflow openapi_ty
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowUpdate(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowUpdateRequest(c.Server, workflow, body)
+func (c *Client) StepRunGetSchema(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStepRunGetSchemaRequest(c.Server, tenant, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -3567,8 +4921,8 @@ func (c *Client) WorkflowUpdate(ctx context.Context, workflow openapi_types.UUID
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowGetMetrics(ctx context.Context, workflow openapi_types.UUID, params *WorkflowGetMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowGetMetricsRequest(c.Server, workflow, params)
+func (c *Client) WebhookList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3579,8 +4933,8 @@ func (c *Client) WorkflowGetMetrics(ctx context.Context, workflow openapi_types.
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunCreateWithBody(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunCreateRequestWithBody(c.Server, workflow, params, contentType, body)
+func (c *Client) WebhookCreateWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookCreateRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3591,8 +4945,8 @@ func (c *Client) WorkflowRunCreateWithBody(ctx context.Context, workflow openapi
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowRunCreate(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowRunCreateRequest(c.Server, workflow, params, body)
+func (c *Client) WebhookCreate(ctx context.Context, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookCreateRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3603,8 +4957,8 @@ func (c *Client) WorkflowRunCreate(ctx context.Context, workflow openapi_types.U
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkflowVersionGet(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkflowVersionGetRequest(c.Server, workflow, params)
+func (c *Client) WorkerList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkerListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -3615,862 +4969,844 @@ func (c *Client) WorkflowVersionGet(ctx context.Context, workflow openapi_types.
 	return c.Client.Do(req)
 }
 
-// NewLivenessGetRequest generates requests for LivenessGet
-func NewLivenessGetRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) WorkerPoolList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkerPoolListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/live")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WorkflowRunUpdateReplayWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunUpdateReplayRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewReadinessGetRequest generates requests for ReadinessGet
-func NewReadinessGetRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) WorkflowRunUpdateReplay(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunUpdateReplayRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/ready")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WorkflowRunGet(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunGetRequest(c.Server, tenant, workflowRun)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAlertEmailGroupDeleteRequest generates requests for AlertEmailGroupDelete
-func NewAlertEmailGroupDeleteRequest(server string, alertEmailGroup openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "alert-email-group", runtime.ParamLocationPath, alertEmailGroup)
+func (c *Client) WorkflowRunGetInput(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunGetInputRequest(c.Server, tenant, workflowRun)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) WorkflowRunUpdatePause(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunUpdatePauseRequest(c.Server, tenant, workflowRun)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/alerting-email-groups/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WorkflowRunUpdateReplayFromStepWithBody(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunUpdateReplayFromStepRequestWithBody(c.Server, tenant, workflowRun, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAlertEmailGroupUpdateRequest calls the generic AlertEmailGroupUpdate builder with application/json body
-func NewAlertEmailGroupUpdateRequest(server string, alertEmailGroup openapi_types.UUID, body AlertEmailGroupUpdateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) WorkflowRunUpdateReplayFromStep(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, body WorkflowRunUpdateReplayFromStepJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunUpdateReplayFromStepRequest(c.Server, tenant, workflowRun, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAlertEmailGroupUpdateRequestWithBody(server, alertEmailGroup, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAlertEmailGroupUpdateRequestWithBody generates requests for AlertEmailGroupUpdate with any type of body
-func NewAlertEmailGroupUpdateRequestWithBody(server string, alertEmailGroup openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "alert-email-group", runtime.ParamLocationPath, alertEmailGroup)
+func (c *Client) WorkflowRunUpdateResume(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunUpdateResumeRequest(c.Server, tenant, workflowRun)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) WorkflowRunGetShape(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunGetShapeRequest(c.Server, tenant, workflowRun)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/alerting-email-groups/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WorkflowRunListStepRunEvents(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunListStepRunEventsRequest(c.Server, tenant, workflowRun, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+func (c *Client) WorkflowRunGetStream(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunGetStreamRequest(c.Server, tenant, workflowRun)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewApiTokenUpdateRevokeRequest generates requests for ApiTokenUpdateRevoke
-func NewApiTokenUpdateRevokeRequest(server string, apiToken openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "api-token", runtime.ParamLocationPath, apiToken)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) WorkflowList(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowListRequest(c.Server, tenant)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/api-tokens/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WorkflowRunCancelWithBody(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunCancelRequestWithBody(c.Server, tenant, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCloudMetadataGetRequest generates requests for CloudMetadataGet
-func NewCloudMetadataGetRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) WorkflowRunCancel(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunCancelRequest(c.Server, tenant, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/cloud/metadata")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CronWorkflowList(ctx context.Context, tenant openapi_types.UUID, params *CronWorkflowListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCronWorkflowListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewEventGetRequest generates requests for EventGet
-func NewEventGetRequest(server string, event openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
+func (c *Client) WorkflowCronDelete(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowCronDeleteRequest(c.Server, tenant, cronWorkflow)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/events/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WorkflowCronGet(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowCronGetRequest(c.Server, tenant, cronWorkflow)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewEventDataGetRequest generates requests for EventDataGet
-func NewEventDataGetRequest(server string, event openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
+func (c *Client) WorkflowRunList(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/events/%s/data", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WorkflowRunGetMetrics(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunGetMetricsRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewMetadataGetRequest generates requests for MetadataGet
-func NewMetadataGetRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) WorkflowScheduledList(ctx context.Context, tenant openapi_types.UUID, params *WorkflowScheduledListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowScheduledListRequest(c.Server, tenant, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/meta")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WorkflowScheduledDelete(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowScheduledDeleteRequest(c.Server, tenant, scheduledWorkflowRun)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewMetadataListIntegrationsRequest generates requests for MetadataListIntegrations
-func NewMetadataListIntegrationsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) WorkflowScheduledGet(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowScheduledGetRequest(c.Server, tenant, scheduledWorkflowRun)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/meta/integrations")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WorkflowScheduledUpdateWithBody(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowScheduledUpdateRequestWithBody(c.Server, tenant, scheduledWorkflowRun, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewSlackWebhookDeleteRequest generates requests for SlackWebhookDelete
-func NewSlackWebhookDeleteRequest(server string, slack openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "slack", runtime.ParamLocationPath, slack)
+func (c *Client) WorkflowScheduledUpdate(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, body WorkflowScheduledUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowScheduledUpdateRequest(c.Server, tenant, scheduledWorkflowRun, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CronWorkflowTriggerCreateWithBody(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCronWorkflowTriggerCreateRequestWithBody(c.Server, tenant, workflow, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/slack/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CronWorkflowTriggerCreate(ctx context.Context, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCronWorkflowTriggerCreateRequest(c.Server, tenant, workflow, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewSnsDeleteRequest generates requests for SnsDelete
-func NewSnsDeleteRequest(server string, sns openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sns", runtime.ParamLocationPath, sns)
+func (c *Client) ScheduledWorkflowRunCreateWithBody(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewScheduledWorkflowRunCreateRequestWithBody(c.Server, tenant, workflow, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ScheduledWorkflowRunCreate(ctx context.Context, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewScheduledWorkflowRunCreateRequest(c.Server, tenant, workflow, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/sns/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WorkflowGetWorkersCount(ctx context.Context, tenant openapi_types.UUID, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowGetWorkersCountRequest(c.Server, tenant, workflow)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewSnsUpdateRequest generates requests for SnsUpdate
-func NewSnsUpdateRequest(server string, tenant openapi_types.UUID, event string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+func (c *Client) UserGetCurrent(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserGetCurrentRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
+func (c *Client) UserUpdateGithubOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateGithubOauthCallbackRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UserUpdateGithubOauthStart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateGithubOauthStartRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/sns/%s/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UserUpdateGoogleOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateGoogleOauthCallbackRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) UserUpdateGoogleOauthStart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateGoogleOauthStartRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewStepRunListArchivesRequest generates requests for StepRunListArchives
-func NewStepRunListArchivesRequest(server string, stepRun openapi_types.UUID, params *StepRunListArchivesParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+func (c *Client) UserListTenantInvites(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserListTenantInvitesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) TenantInviteAcceptWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteAcceptRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/step-runs/%s/archives", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) TenantInviteAccept(ctx context.Context, body TenantInviteAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteAcceptRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) TenantInviteRejectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteRejectRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewStepRunListEventsRequest generates requests for StepRunListEvents
-func NewStepRunListEventsRequest(server string, stepRun openapi_types.UUID, params *StepRunListEventsParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+func (c *Client) TenantInviteReject(ctx context.Context, body TenantInviteRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantInviteRejectRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UserUpdateLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateLoginRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/step-runs/%s/events", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UserUpdateLogin(ctx context.Context, body UserUpdateLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateLoginRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) UserUpdateLogout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateLogoutRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewLogLineListRequest generates requests for LogLineList
-func NewLogLineListRequest(server string, stepRun openapi_types.UUID, params *LogLineListParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+func (c *Client) TenantMembershipsList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTenantMembershipsListRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UserUpdateOidcCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateOidcCallbackRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/step-runs/%s/logs", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UserUpdateOidcStart(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateOidcStartRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Levels != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "levels", runtime.ParamLocationQuery, *params.Levels); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Search != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderByField != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderByDirection != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) UserUpdatePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdatePasswordRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewTenantCreateRequest calls the generic TenantCreate builder with application/json body
-func NewTenantCreateRequest(server string, body TenantCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UserUpdatePassword(ctx context.Context, body UserUpdatePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdatePasswordRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewTenantCreateRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewTenantCreateRequestWithBody generates requests for TenantCreate with any type of body
-func NewTenantCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UserCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCreateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/tenants")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UserCreate(ctx context.Context, body UserCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserCreateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewTenantUpdateRequest calls the generic TenantUpdate builder with application/json body
-func NewTenantUpdateRequest(server string, tenant openapi_types.UUID, body TenantUpdateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UserUpdateSlackOauthCallback(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUserUpdateSlackOauthCallbackRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewTenantUpdateRequestWithBody(server, tenant, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewTenantUpdateRequestWithBody generates requests for TenantUpdate with any type of body
-func NewTenantUpdateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+func (c *Client) WebhookDelete(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookDeleteRequest(c.Server, webhook)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) WebhookRequestsList(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhookRequestsListRequest(c.Server, webhook)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WorkerGet(ctx context.Context, worker openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkerGetRequest(c.Server, worker)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAlertEmailGroupListRequest generates requests for AlertEmailGroupList
-func NewAlertEmailGroupListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+func (c *Client) WorkerUpdateWithBody(ctx context.Context, worker openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkerUpdateRequestWithBody(c.Server, worker, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) WorkerUpdate(ctx context.Context, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkerUpdateRequest(c.Server, worker, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/alerting-email-groups", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) WorkflowDelete(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowDeleteRequest(c.Server, workflow)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAlertEmailGroupCreateRequest calls the generic AlertEmailGroupCreate builder with application/json body
-func NewAlertEmailGroupCreateRequest(server string, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) WorkflowGet(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowGetRequest(c.Server, workflow)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAlertEmailGroupCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAlertEmailGroupCreateRequestWithBody generates requests for AlertEmailGroupCreate with any type of body
-func NewAlertEmailGroupCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (c *Client) WorkflowUpdateWithBody(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowUpdateRequestWithBody(c.Server, workflow, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) WorkflowUpdate(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowUpdateRequest(c.Server, workflow, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+func (c *Client) WorkflowListConcurrencyGroups(ctx context.Context, workflow openapi_types.UUID, params *WorkflowListConcurrencyGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowListConcurrencyGroupsRequest(c.Server, workflow, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WorkflowGetMetrics(ctx context.Context, workflow openapi_types.UUID, params *WorkflowGetMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowGetMetricsRequest(c.Server, workflow, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WorkflowDeleteRollout(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowDeleteRolloutRequest(c.Server, workflow)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WorkflowGetRollout(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowGetRolloutRequest(c.Server, workflow)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WorkflowUpdateRolloutWithBody(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowUpdateRolloutRequestWithBody(c.Server, workflow, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WorkflowUpdateRollout(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateRolloutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowUpdateRolloutRequest(c.Server, workflow, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WorkflowRunCreateWithBody(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunCreateRequestWithBody(c.Server, workflow, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WorkflowRunCreate(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowRunCreateRequest(c.Server, workflow, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WorkflowVersionGet(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkflowVersionGetRequest(c.Server, workflow, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewHealthzGetRequest generates requests for HealthzGet
+func NewHealthzGetRequest(server string) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/alerting-email-groups", pathParam0)
+	operationPath := fmt.Sprintf("/api/healthz")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4480,33 +5816,78 @@ func NewAlertEmailGroupCreateRequestWithBody(server string, tenant openapi_types
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	return req, nil
+}
+
+// NewLivenessGetRequest generates requests for LivenessGet
+func NewLivenessGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/live")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
 
-// NewTenantAlertingSettingsGetRequest generates requests for TenantAlertingSettingsGet
-func NewTenantAlertingSettingsGetRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewReadinessGetRequest generates requests for ReadinessGet
+func NewReadinessGetRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	operationPath := fmt.Sprintf("/api/ready")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReadyzGetRequest generates requests for ReadyzGet
+func NewReadyzGetRequest(server string) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/alerting/settings", pathParam0)
+	operationPath := fmt.Sprintf("/api/readyz")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4524,13 +5905,13 @@ func NewTenantAlertingSettingsGetRequest(server string, tenant openapi_types.UUI
 	return req, nil
 }
 
-// NewApiTokenListRequest generates requests for ApiTokenList
-func NewApiTokenListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewAlertEmailGroupDeleteRequest generates requests for AlertEmailGroupDelete
+func NewAlertEmailGroupDeleteRequest(server string, alertEmailGroup openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "alert-email-group", runtime.ParamLocationPath, alertEmailGroup)
 	if err != nil {
 		return nil, err
 	}
@@ -4540,7 +5921,7 @@ func NewApiTokenListRequest(server string, tenant openapi_types.UUID) (*http.Req
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/api-tokens", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/alerting-email-groups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4550,7 +5931,7 @@ func NewApiTokenListRequest(server string, tenant openapi_types.UUID) (*http.Req
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4558,24 +5939,24 @@ func NewApiTokenListRequest(server string, tenant openapi_types.UUID) (*http.Req
 	return req, nil
 }
 
-// NewApiTokenCreateRequest calls the generic ApiTokenCreate builder with application/json body
-func NewApiTokenCreateRequest(server string, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody) (*http.Request, error) {
+// NewAlertEmailGroupUpdateRequest calls the generic AlertEmailGroupUpdate builder with application/json body
+func NewAlertEmailGroupUpdateRequest(server string, alertEmailGroup openapi_types.UUID, body AlertEmailGroupUpdateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewApiTokenCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+	return NewAlertEmailGroupUpdateRequestWithBody(server, alertEmailGroup, "application/json", bodyReader)
 }
 
-// NewApiTokenCreateRequestWithBody generates requests for ApiTokenCreate with any type of body
-func NewApiTokenCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewAlertEmailGroupUpdateRequestWithBody generates requests for AlertEmailGroupUpdate with any type of body
+func NewAlertEmailGroupUpdateRequestWithBody(server string, alertEmailGroup openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "alert-email-group", runtime.ParamLocationPath, alertEmailGroup)
 	if err != nil {
 		return nil, err
 	}
@@ -4585,7 +5966,7 @@ func NewApiTokenCreateRequestWithBody(server string, tenant openapi_types.UUID,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/api-tokens", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/alerting-email-groups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4595,7 +5976,7 @@ func NewApiTokenCreateRequestWithBody(server string, tenant openapi_types.UUID,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -4605,13 +5986,13 @@ func NewApiTokenCreateRequestWithBody(server string, tenant openapi_types.UUID,
 	return req, nil
 }
 
-// NewEventListRequest generates requests for EventList
-func NewEventListRequest(server string, tenant openapi_types.UUID, params *EventListParams) (*http.Request, error) {
+// NewApiTokenUpdateRevokeRequest generates requests for ApiTokenUpdateRevoke
+func NewApiTokenUpdateRevokeRequest(server string, apiToken openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "api-token", runtime.ParamLocationPath, apiToken)
 	if err != nil {
 		return nil, err
 	}
@@ -4621,7 +6002,7 @@ func NewEventListRequest(server string, tenant openapi_types.UUID, params *Event
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/api-tokens/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4631,198 +6012,79 @@ func NewEventListRequest(server string, tenant openapi_types.UUID, params *Event
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewApiTokenUpdateRotateRequest calls the generic ApiTokenUpdateRotate builder with application/json body
+func NewApiTokenUpdateRotateRequest(server string, apiToken openapi_types.UUID, body ApiTokenUpdateRotateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApiTokenUpdateRotateRequestWithBody(server, apiToken, "application/json", bodyReader)
+}
 
-		if params.Limit != nil {
+// NewApiTokenUpdateRotateRequestWithBody generates requests for ApiTokenUpdateRotate with any type of body
+func NewApiTokenUpdateRotateRequestWithBody(server string, apiToken openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	var pathParam0 string
 
-		}
-
-		if params.Keys != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keys", runtime.ParamLocationQuery, *params.Keys); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Workflows != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflows", runtime.ParamLocationQuery, *params.Workflows); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Statuses != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Search != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderByField != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderByDirection != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.AdditionalMetadata != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.EventIds != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "api-token", runtime.ParamLocationPath, apiToken)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventIds", runtime.ParamLocationQuery, *params.EventIds); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/api/v1/api-tokens/%s/rotate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		queryURL.RawQuery = queryValues.Encode()
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewEventCreateRequest calls the generic EventCreate builder with application/json body
-func NewEventCreateRequest(server string, tenant openapi_types.UUID, body EventCreateJSONRequestBody) (*http.Request, error) {
+// NewApprovalUpdateDecideWithTokenRequest calls the generic ApprovalUpdateDecideWithToken builder with application/json body
+func NewApprovalUpdateDecideWithTokenRequest(server string, approvalToken string, body ApprovalUpdateDecideWithTokenJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewEventCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+	return NewApprovalUpdateDecideWithTokenRequestWithBody(server, approvalToken, "application/json", bodyReader)
 }
 
-// NewEventCreateRequestWithBody generates requests for EventCreate with any type of body
-func NewEventCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewApprovalUpdateDecideWithTokenRequestWithBody generates requests for ApprovalUpdateDecideWithToken with any type of body
+func NewApprovalUpdateDecideWithTokenRequestWithBody(server string, approvalToken string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "approval-token", runtime.ParamLocationPath, approvalToken)
 	if err != nil {
 		return nil, err
 	}
@@ -4832,7 +6094,7 @@ func NewEventCreateRequestWithBody(server string, tenant openapi_types.UUID, con
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/approval-tokens/%s/decide", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4852,24 +6114,13 @@ func NewEventCreateRequestWithBody(server string, tenant openapi_types.UUID, con
 	return req, nil
 }
 
-// NewEventCreateBulkRequest calls the generic EventCreateBulk builder with application/json body
-func NewEventCreateBulkRequest(server string, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewEventCreateBulkRequestWithBody(server, tenant, "application/json", bodyReader)
-}
-
-// NewEventCreateBulkRequestWithBody generates requests for EventCreateBulk with any type of body
-func NewEventCreateBulkRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewApprovalGetRequest generates requests for ApprovalGet
+func NewApprovalGetRequest(server string, approval openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "approval", runtime.ParamLocationPath, approval)
 	if err != nil {
 		return nil, err
 	}
@@ -4879,7 +6130,7 @@ func NewEventCreateBulkRequestWithBody(server string, tenant openapi_types.UUID,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/bulk", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/approvals/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4889,34 +6140,32 @@ func NewEventCreateBulkRequestWithBody(server string, tenant openapi_types.UUID,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewEventUpdateCancelRequest calls the generic EventUpdateCancel builder with application/json body
-func NewEventUpdateCancelRequest(server string, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody) (*http.Request, error) {
+// NewApprovalUpdateDecideRequest calls the generic ApprovalUpdateDecide builder with application/json body
+func NewApprovalUpdateDecideRequest(server string, approval openapi_types.UUID, body ApprovalUpdateDecideJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewEventUpdateCancelRequestWithBody(server, tenant, "application/json", bodyReader)
+	return NewApprovalUpdateDecideRequestWithBody(server, approval, "application/json", bodyReader)
 }
 
-// NewEventUpdateCancelRequestWithBody generates requests for EventUpdateCancel with any type of body
-func NewEventUpdateCancelRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewApprovalUpdateDecideRequestWithBody generates requests for ApprovalUpdateDecide with any type of body
+func NewApprovalUpdateDecideRequestWithBody(server string, approval openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "approval", runtime.ParamLocationPath, approval)
 	if err != nil {
 		return nil, err
 	}
@@ -4926,7 +6175,7 @@ func NewEventUpdateCancelRequestWithBody(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/cancel", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/approvals/%s/decide", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4946,13 +6195,13 @@ func NewEventUpdateCancelRequestWithBody(server string, tenant openapi_types.UUI
 	return req, nil
 }
 
-// NewEventKeyListRequest generates requests for EventKeyList
-func NewEventKeyListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewClientCaDeleteRequest generates requests for ClientCaDelete
+func NewClientCaDeleteRequest(server string, clientCa openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "client-ca", runtime.ParamLocationPath, clientCa)
 	if err != nil {
 		return nil, err
 	}
@@ -4962,7 +6211,7 @@ func NewEventKeyListRequest(server string, tenant openapi_types.UUID) (*http.Req
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/keys", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/client-cas/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4972,7 +6221,7 @@ func NewEventKeyListRequest(server string, tenant openapi_types.UUID) (*http.Req
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4980,34 +6229,16 @@ func NewEventKeyListRequest(server string, tenant openapi_types.UUID) (*http.Req
 	return req, nil
 }
 
-// NewEventUpdateReplayRequest calls the generic EventUpdateReplay builder with application/json body
-func NewEventUpdateReplayRequest(server string, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewEventUpdateReplayRequestWithBody(server, tenant, "application/json", bodyReader)
-}
-
-// NewEventUpdateReplayRequestWithBody generates requests for EventUpdateReplay with any type of body
-func NewEventUpdateReplayRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewCloudMetadataGetRequest generates requests for CloudMetadataGet
+func NewCloudMetadataGetRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/replay", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/cloud/metadata")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5017,23 +6248,21 @@ func NewEventUpdateReplayRequestWithBody(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewTenantInviteListRequest generates requests for TenantInviteList
-func NewTenantInviteListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewEventDedupRuleDeleteRequest generates requests for EventDedupRuleDelete
+func NewEventDedupRuleDeleteRequest(server string, eventDedupRule openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "event-dedup-rule", runtime.ParamLocationPath, eventDedupRule)
 	if err != nil {
 		return nil, err
 	}
@@ -5043,7 +6272,7 @@ func NewTenantInviteListRequest(server string, tenant openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/event-dedup-rules/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5053,7 +6282,7 @@ func NewTenantInviteListRequest(server string, tenant openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5061,24 +6290,13 @@ func NewTenantInviteListRequest(server string, tenant openapi_types.UUID) (*http
 	return req, nil
 }
 
-// NewTenantInviteCreateRequest calls the generic TenantInviteCreate builder with application/json body
-func NewTenantInviteCreateRequest(server string, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewTenantInviteCreateRequestWithBody(server, tenant, "application/json", bodyReader)
-}
-
-// NewTenantInviteCreateRequestWithBody generates requests for TenantInviteCreate with any type of body
-func NewTenantInviteCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewEventRoutingRuleDeleteRequest generates requests for EventRoutingRuleDelete
+func NewEventRoutingRuleDeleteRequest(server string, eventRoutingRule openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "event-routing-rule", runtime.ParamLocationPath, eventRoutingRule)
 	if err != nil {
 		return nil, err
 	}
@@ -5088,7 +6306,7 @@ func NewTenantInviteCreateRequestWithBody(server string, tenant openapi_types.UU
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/event-routing-rules/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5098,30 +6316,21 @@ func NewTenantInviteCreateRequestWithBody(server string, tenant openapi_types.UU
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewTenantInviteDeleteRequest generates requests for TenantInviteDelete
-func NewTenantInviteDeleteRequest(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID) (*http.Request, error) {
+// NewEventSinkDeleteRequest generates requests for EventSinkDelete
+func NewEventSinkDeleteRequest(server string, eventSink openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, tenantInvite)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "event-sink", runtime.ParamLocationPath, eventSink)
 	if err != nil {
 		return nil, err
 	}
@@ -5131,7 +6340,7 @@ func NewTenantInviteDeleteRequest(server string, tenant openapi_types.UUID, tena
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/event-sinks/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5149,31 +6358,13 @@ func NewTenantInviteDeleteRequest(server string, tenant openapi_types.UUID, tena
 	return req, nil
 }
 
-// NewTenantInviteUpdateRequest calls the generic TenantInviteUpdate builder with application/json body
-func NewTenantInviteUpdateRequest(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewTenantInviteUpdateRequestWithBody(server, tenant, tenantInvite, "application/json", bodyReader)
-}
-
-// NewTenantInviteUpdateRequestWithBody generates requests for TenantInviteUpdate with any type of body
-func NewTenantInviteUpdateRequestWithBody(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewEventSinkReplayRequest generates requests for EventSinkReplay
+func NewEventSinkReplayRequest(server string, eventSink openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, tenantInvite)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "event-sink", runtime.ParamLocationPath, eventSink)
 	if err != nil {
 		return nil, err
 	}
@@ -5183,7 +6374,7 @@ func NewTenantInviteUpdateRequestWithBody(server string, tenant openapi_types.UU
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/event-sinks/%s/replay", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5193,23 +6384,21 @@ func NewTenantInviteUpdateRequestWithBody(server string, tenant openapi_types.UU
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewTenantMemberListRequest generates requests for TenantMemberList
-func NewTenantMemberListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewEventGetRequest generates requests for EventGet
+func NewEventGetRequest(server string, event openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
 	if err != nil {
 		return nil, err
 	}
@@ -5219,7 +6408,7 @@ func NewTenantMemberListRequest(server string, tenant openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/members", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/events/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5237,20 +6426,13 @@ func NewTenantMemberListRequest(server string, tenant openapi_types.UUID) (*http
 	return req, nil
 }
 
-// NewTenantMemberDeleteRequest generates requests for TenantMemberDelete
-func NewTenantMemberDeleteRequest(server string, tenant openapi_types.UUID, member openapi_types.UUID) (*http.Request, error) {
+// NewEventDataGetRequest generates requests for EventDataGet
+func NewEventDataGetRequest(server string, event openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "member", runtime.ParamLocationPath, member)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
 	if err != nil {
 		return nil, err
 	}
@@ -5260,7 +6442,7 @@ func NewTenantMemberDeleteRequest(server string, tenant openapi_types.UUID, memb
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/members/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/events/%s/data", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5270,7 +6452,7 @@ func NewTenantMemberDeleteRequest(server string, tenant openapi_types.UUID, memb
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5278,13 +6460,13 @@ func NewTenantMemberDeleteRequest(server string, tenant openapi_types.UUID, memb
 	return req, nil
 }
 
-// NewTenantGetQueueMetricsRequest generates requests for TenantGetQueueMetrics
-func NewTenantGetQueueMetricsRequest(server string, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams) (*http.Request, error) {
+// NewInboundWebhookDeleteRequest generates requests for InboundWebhookDelete
+func NewInboundWebhookDeleteRequest(server string, inboundWebhook openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "inbound-webhook", runtime.ParamLocationPath, inboundWebhook)
 	if err != nil {
 		return nil, err
 	}
@@ -5294,7 +6476,7 @@ func NewTenantGetQueueMetricsRequest(server string, tenant openapi_types.UUID, p
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/queue-metrics", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/inbound-webhooks/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5304,45 +6486,7 @@ func NewTenantGetQueueMetricsRequest(server string, tenant openapi_types.UUID, p
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Workflows != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflows", runtime.ParamLocationQuery, *params.Workflows); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.AdditionalMetadata != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5350,13 +6494,13 @@ func NewTenantGetQueueMetricsRequest(server string, tenant openapi_types.UUID, p
 	return req, nil
 }
 
-// NewRateLimitListRequest generates requests for RateLimitList
-func NewRateLimitListRequest(server string, tenant openapi_types.UUID, params *RateLimitListParams) (*http.Request, error) {
+// NewInboundWebhookReceiveRequest generates requests for InboundWebhookReceive
+func NewInboundWebhookReceiveRequest(server string, inboundWebhook openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "inbound-webhook", runtime.ParamLocationPath, inboundWebhook)
 	if err != nil {
 		return nil, err
 	}
@@ -5366,7 +6510,7 @@ func NewRateLimitListRequest(server string, tenant openapi_types.UUID, params *R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/rate-limits", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/inbound-webhooks/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5376,90 +6520,31 @@ func NewRateLimitListRequest(server string, tenant openapi_types.UUID, params *R
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Search != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderByField != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.OrderByDirection != nil {
+// NewMetadataGetRequest generates requests for MetadataGet
+func NewMetadataGetRequest(server string) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/api/v1/meta")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		queryURL.RawQuery = queryValues.Encode()
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -5470,23 +6555,16 @@ func NewRateLimitListRequest(server string, tenant openapi_types.UUID, params *R
 	return req, nil
 }
 
-// NewTenantResourcePolicyGetRequest generates requests for TenantResourcePolicyGet
-func NewTenantResourcePolicyGetRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewMetadataListIntegrationsRequest generates requests for MetadataListIntegrations
+func NewMetadataListIntegrationsRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/resource-policy", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/meta/integrations")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5504,13 +6582,13 @@ func NewTenantResourcePolicyGetRequest(server string, tenant openapi_types.UUID)
 	return req, nil
 }
 
-// NewSlackWebhookListRequest generates requests for SlackWebhookList
-func NewSlackWebhookListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewSlackWebhookDeleteRequest generates requests for SlackWebhookDelete
+func NewSlackWebhookDeleteRequest(server string, slack openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "slack", runtime.ParamLocationPath, slack)
 	if err != nil {
 		return nil, err
 	}
@@ -5520,7 +6598,7 @@ func NewSlackWebhookListRequest(server string, tenant openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/slack", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/slack/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5530,7 +6608,7 @@ func NewSlackWebhookListRequest(server string, tenant openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5538,13 +6616,13 @@ func NewSlackWebhookListRequest(server string, tenant openapi_types.UUID) (*http
 	return req, nil
 }
 
-// NewUserUpdateSlackOauthStartRequest generates requests for UserUpdateSlackOauthStart
-func NewUserUpdateSlackOauthStartRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewSnsDeleteRequest generates requests for SnsDelete
+func NewSnsDeleteRequest(server string, sns openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sns", runtime.ParamLocationPath, sns)
 	if err != nil {
 		return nil, err
 	}
@@ -5554,7 +6632,7 @@ func NewUserUpdateSlackOauthStartRequest(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/slack/start", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/sns/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5564,7 +6642,7 @@ func NewUserUpdateSlackOauthStartRequest(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5572,8 +6650,8 @@ func NewUserUpdateSlackOauthStartRequest(server string, tenant openapi_types.UUI
 	return req, nil
 }
 
-// NewSnsListRequest generates requests for SnsList
-func NewSnsListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewSnsUpdateRequest generates requests for SnsUpdate
+func NewSnsUpdateRequest(server string, tenant openapi_types.UUID, event string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5583,12 +6661,19 @@ func NewSnsListRequest(server string, tenant openapi_types.UUID) (*http.Request,
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "event", runtime.ParamLocationPath, event)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/sns", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/sns/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5598,7 +6683,7 @@ func NewSnsListRequest(server string, tenant openapi_types.UUID) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5606,24 +6691,13 @@ func NewSnsListRequest(server string, tenant openapi_types.UUID) (*http.Request,
 	return req, nil
 }
 
-// NewSnsCreateRequest calls the generic SnsCreate builder with application/json body
-func NewSnsCreateRequest(server string, tenant openapi_types.UUID, body SnsCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSnsCreateRequestWithBody(server, tenant, "application/json", bodyReader)
-}
-
-// NewSnsCreateRequestWithBody generates requests for SnsCreate with any type of body
-func NewSnsCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewSqsDeleteRequest generates requests for SqsDelete
+func NewSqsDeleteRequest(server string, sqs openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sqs", runtime.ParamLocationPath, sqs)
 	if err != nil {
 		return nil, err
 	}
@@ -5633,7 +6707,7 @@ func NewSnsCreateRequestWithBody(server string, tenant openapi_types.UUID, conte
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/sns", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/sqs/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5643,23 +6717,21 @@ func NewSnsCreateRequestWithBody(server string, tenant openapi_types.UUID, conte
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewTenantGetStepRunQueueMetricsRequest generates requests for TenantGetStepRunQueueMetrics
-func NewTenantGetStepRunQueueMetricsRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewStepRunListArchivesRequest generates requests for StepRunListArchives
+func NewStepRunListArchivesRequest(server string, stepRun openapi_types.UUID, params *StepRunListArchivesParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -5669,7 +6741,7 @@ func NewTenantGetStepRunQueueMetricsRequest(server string, tenant openapi_types.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-run-queue-metrics", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/step-runs/%s/archives", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5679,45 +6751,42 @@ func NewTenantGetStepRunQueueMetricsRequest(server string, tenant openapi_types.
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewStepRunGetRequest generates requests for StepRunGet
-func NewStepRunGetRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID) (*http.Request, error) {
-	var err error
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	var pathParam0 string
+		if params.Offset != nil {
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	var pathParam1 string
+		}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
-	if err != nil {
-		return nil, err
-	}
+		if params.Limit != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -5728,20 +6797,13 @@ func NewStepRunGetRequest(server string, tenant openapi_types.UUID, stepRun open
 	return req, nil
 }
 
-// NewStepRunUpdateCancelRequest generates requests for StepRunUpdateCancel
-func NewStepRunUpdateCancelRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID) (*http.Request, error) {
+// NewStepRunListEventsRequest generates requests for StepRunListEvents
+func NewStepRunListEventsRequest(server string, stepRun openapi_types.UUID, params *StepRunListEventsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -5751,7 +6813,7 @@ func NewStepRunUpdateCancelRequest(server string, tenant openapi_types.UUID, ste
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s/cancel", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/step-runs/%s/events", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5761,82 +6823,59 @@ func NewStepRunUpdateCancelRequest(server string, tenant openapi_types.UUID, ste
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewStepRunUpdateRerunRequest calls the generic StepRunUpdateRerun builder with application/json body
-func NewStepRunUpdateRerunRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewStepRunUpdateRerunRequestWithBody(server, tenant, stepRun, "application/json", bodyReader)
-}
-
-// NewStepRunUpdateRerunRequestWithBody generates requests for StepRunUpdateRerun with any type of body
-func NewStepRunUpdateRerunRequestWithBody(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	var pathParam0 string
+		if params.Offset != nil {
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	var pathParam1 string
+		}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
-	if err != nil {
-		return nil, err
-	}
+		if params.Limit != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s/rerun", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewStepRunGetSchemaRequest generates requests for StepRunGetSchema
-func NewStepRunGetSchemaRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID) (*http.Request, error) {
+// NewLogLineListRequest generates requests for LogLineList
+func NewLogLineListRequest(server string, stepRun openapi_types.UUID, params *LogLineListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -5846,7 +6885,7 @@ func NewStepRunGetSchemaRequest(server string, tenant openapi_types.UUID, stepRu
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s/schema", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/step-runs/%s/logs", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5856,66 +6895,139 @@ func NewStepRunGetSchemaRequest(server string, tenant openapi_types.UUID, stepRu
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.Offset != nil {
 
-// NewWebhookListRequest generates requests for WebhookList
-func NewWebhookListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	var pathParam0 string
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
+		if params.Limit != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/webhook-workers", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+		if params.Levels != nil {
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "levels", runtime.ParamLocationQuery, *params.Levels); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	return req, nil
-}
+		}
 
-// NewWebhookCreateRequest calls the generic WebhookCreate builder with application/json body
-func NewWebhookCreateRequest(server string, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
+		if params.Search != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AfterId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "afterId", runtime.ParamLocationQuery, *params.AfterId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByField != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByDirection != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewWebhookCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// NewWebhookCreateRequestWithBody generates requests for WebhookCreate with any type of body
-func NewWebhookCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewLogLineStreamRequest generates requests for LogLineStream
+func NewLogLineStreamRequest(server string, stepRun openapi_types.UUID, params *LogLineStreamParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -5925,7 +7037,7 @@ func NewWebhookCreateRequestWithBody(server string, tenant openapi_types.UUID, c
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/webhook-workers", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/step-runs/%s/logs/stream", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5935,23 +7047,43 @@ func NewWebhookCreateRequestWithBody(server string, tenant openapi_types.UUID, c
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Levels != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "levels", runtime.ParamLocationQuery, *params.Levels); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewWorkerListRequest generates requests for WorkerList
-func NewWorkerListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewStepRunListSchedulingDecisionsRequest generates requests for StepRunListSchedulingDecisions
+func NewStepRunListSchedulingDecisionsRequest(server string, stepRun openapi_types.UUID, params *StepRunListSchedulingDecisionsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
 	if err != nil {
 		return nil, err
 	}
@@ -5961,7 +7093,7 @@ func NewWorkerListRequest(server string, tenant openapi_types.UUID) (*http.Reque
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/worker", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/step-runs/%s/scheduling-decisions", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5971,6 +7103,44 @@ func NewWorkerListRequest(server string, tenant openapi_types.UUID) (*http.Reque
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -5979,34 +7149,27 @@ func NewWorkerListRequest(server string, tenant openapi_types.UUID) (*http.Reque
 	return req, nil
 }
 
-// NewWorkflowRunUpdateReplayRequest calls the generic WorkflowRunUpdateReplay builder with application/json body
-func NewWorkflowRunUpdateReplayRequest(server string, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody) (*http.Request, error) {
+// NewTenantCreateRequest calls the generic TenantCreate builder with application/json body
+func NewTenantCreateRequest(server string, body TenantCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewWorkflowRunUpdateReplayRequestWithBody(server, tenant, "application/json", bodyReader)
+	return NewTenantCreateRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewWorkflowRunUpdateReplayRequestWithBody generates requests for WorkflowRunUpdateReplay with any type of body
-func NewWorkflowRunUpdateReplayRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewTenantCreateRequestWithBody generates requests for TenantCreate with any type of body
+func NewTenantCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/replay", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6026,20 +7189,24 @@ func NewWorkflowRunUpdateReplayRequestWithBody(server string, tenant openapi_typ
 	return req, nil
 }
 
-// NewWorkflowRunGetRequest generates requests for WorkflowRunGet
-func NewWorkflowRunGetRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+// NewTenantUpdateRequest calls the generic TenantUpdate builder with application/json body
+func NewTenantUpdateRequest(server string, tenant openapi_types.UUID, body TenantUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantUpdateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
 
-	var pathParam1 string
+// NewTenantUpdateRequestWithBody generates requests for TenantUpdate with any type of body
+func NewTenantUpdateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -6049,7 +7216,7 @@ func NewWorkflowRunGetRequest(server string, tenant openapi_types.UUID, workflow
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6059,16 +7226,18 @@ func NewWorkflowRunGetRequest(server string, tenant openapi_types.UUID, workflow
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewWorkflowRunGetInputRequest generates requests for WorkflowRunGetInput
-func NewWorkflowRunGetInputRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
+// NewAlertEmailGroupListRequest generates requests for AlertEmailGroupList
+func NewAlertEmailGroupListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6078,19 +7247,12 @@ func NewWorkflowRunGetInputRequest(server string, tenant openapi_types.UUID, wor
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/input", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/alerting-email-groups", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6108,20 +7270,24 @@ func NewWorkflowRunGetInputRequest(server string, tenant openapi_types.UUID, wor
 	return req, nil
 }
 
-// NewWorkflowRunGetShapeRequest generates requests for WorkflowRunGetShape
-func NewWorkflowRunGetShapeRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+// NewAlertEmailGroupCreateRequest calls the generic AlertEmailGroupCreate builder with application/json body
+func NewAlertEmailGroupCreateRequest(server string, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAlertEmailGroupCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
 
-	var pathParam1 string
+// NewAlertEmailGroupCreateRequestWithBody generates requests for AlertEmailGroupCreate with any type of body
+func NewAlertEmailGroupCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -6131,7 +7297,7 @@ func NewWorkflowRunGetShapeRequest(server string, tenant openapi_types.UUID, wor
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/shape", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/alerting-email-groups", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6141,16 +7307,18 @@ func NewWorkflowRunGetShapeRequest(server string, tenant openapi_types.UUID, wor
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewWorkflowRunListStepRunEventsRequest generates requests for WorkflowRunListStepRunEvents
-func NewWorkflowRunListStepRunEventsRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams) (*http.Request, error) {
+// NewTenantAlertingSettingsGetRequest generates requests for TenantAlertingSettingsGet
+func NewTenantAlertingSettingsGetRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6160,19 +7328,12 @@ func NewWorkflowRunListStepRunEventsRequest(server string, tenant openapi_types.
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/step-run-events", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/alerting/settings", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6182,38 +7343,16 @@ func NewWorkflowRunListStepRunEventsRequest(server string, tenant openapi_types.
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.LastId != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lastId", runtime.ParamLocationQuery, *params.LastId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewWorkflowListRequest generates requests for WorkflowList
-func NewWorkflowListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+// NewApiTokenListRequest generates requests for ApiTokenList
+func NewApiTokenListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6228,7 +7367,7 @@ func NewWorkflowListRequest(server string, tenant openapi_types.UUID) (*http.Req
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/api-tokens", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6246,19 +7385,19 @@ func NewWorkflowListRequest(server string, tenant openapi_types.UUID) (*http.Req
 	return req, nil
 }
 
-// NewWorkflowRunCancelRequest calls the generic WorkflowRunCancel builder with application/json body
-func NewWorkflowRunCancelRequest(server string, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody) (*http.Request, error) {
+// NewApiTokenCreateRequest calls the generic ApiTokenCreate builder with application/json body
+func NewApiTokenCreateRequest(server string, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewWorkflowRunCancelRequestWithBody(server, tenant, "application/json", bodyReader)
+	return NewApiTokenCreateRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewWorkflowRunCancelRequestWithBody generates requests for WorkflowRunCancel with any type of body
-func NewWorkflowRunCancelRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewApiTokenCreateRequestWithBody generates requests for ApiTokenCreate with any type of body
+func NewApiTokenCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6273,7 +7412,7 @@ func NewWorkflowRunCancelRequestWithBody(server string, tenant openapi_types.UUI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/cancel", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/api-tokens", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6293,8 +7432,8 @@ func NewWorkflowRunCancelRequestWithBody(server string, tenant openapi_types.UUI
 	return req, nil
 }
 
-// NewCronWorkflowListRequest generates requests for CronWorkflowList
-func NewCronWorkflowListRequest(server string, tenant openapi_types.UUID, params *CronWorkflowListParams) (*http.Request, error) {
+// NewApprovalListRequest generates requests for ApprovalList
+func NewApprovalListRequest(server string, tenant openapi_types.UUID, params *ApprovalListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6309,7 +7448,7 @@ func NewCronWorkflowListRequest(server string, tenant openapi_types.UUID, params
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/crons", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/approvals", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6354,57 +7493,9 @@ func NewCronWorkflowListRequest(server string, tenant openapi_types.UUID, params
 
 		}
 
-		if params.WorkflowId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.AdditionalMetadata != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderByField != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderByDirection != nil {
+		if params.Statuses != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -6429,90 +7520,8 @@ func NewCronWorkflowListRequest(server string, tenant openapi_types.UUID, params
 	return req, nil
 }
 
-// NewWorkflowCronDeleteRequest generates requests for WorkflowCronDelete
-func NewWorkflowCronDeleteRequest(server string, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, cronWorkflow)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/crons/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewWorkflowCronGetRequest generates requests for WorkflowCronGet
-func NewWorkflowCronGetRequest(server string, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, cronWorkflow)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/crons/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewWorkflowRunListRequest generates requests for WorkflowRunList
-func NewWorkflowRunListRequest(server string, tenant openapi_types.UUID, params *WorkflowRunListParams) (*http.Request, error) {
+// NewAuditLogListRequest generates requests for AuditLogList
+func NewAuditLogListRequest(server string, tenant openapi_types.UUID, params *AuditLogListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6527,7 +7536,7 @@ func NewWorkflowRunListRequest(server string, tenant openapi_types.UUID, params
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/runs", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/audit-logs", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6572,9 +7581,9 @@ func NewWorkflowRunListRequest(server string, tenant openapi_types.UUID, params
 
 		}
 
-		if params.EventId != nil {
+		if params.ActorId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventId", runtime.ParamLocationQuery, *params.EventId); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actorId", runtime.ParamLocationQuery, *params.ActorId); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -6588,9 +7597,9 @@ func NewWorkflowRunListRequest(server string, tenant openapi_types.UUID, params
 
 		}
 
-		if params.WorkflowId != nil {
+		if params.Operation != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "operation", runtime.ParamLocationQuery, *params.Operation); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -6604,9 +7613,9 @@ func NewWorkflowRunListRequest(server string, tenant openapi_types.UUID, params
 
 		}
 
-		if params.ParentWorkflowRunId != nil {
+		if params.ResourceType != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentWorkflowRunId", runtime.ParamLocationQuery, *params.ParentWorkflowRunId); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resourceType", runtime.ParamLocationQuery, *params.ResourceType); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -6620,9 +7629,9 @@ func NewWorkflowRunListRequest(server string, tenant openapi_types.UUID, params
 
 		}
 
-		if params.ParentStepRunId != nil {
+		if params.ResourceId != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentStepRunId", runtime.ParamLocationQuery, *params.ParentStepRunId); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resourceId", runtime.ParamLocationQuery, *params.ResourceId); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -6636,9 +7645,9 @@ func NewWorkflowRunListRequest(server string, tenant openapi_types.UUID, params
 
 		}
 
-		if params.Statuses != nil {
+		if params.Since != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -6652,9 +7661,9 @@ func NewWorkflowRunListRequest(server string, tenant openapi_types.UUID, params
 
 		}
 
-		if params.Kinds != nil {
+		if params.Until != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "kinds", runtime.ParamLocationQuery, *params.Kinds); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -6668,131 +7677,100 @@ func NewWorkflowRunListRequest(server string, tenant openapi_types.UUID, params
 
 		}
 
-		if params.AdditionalMetadata != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-		}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.CreatedAfter != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdAfter", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewClientCaListRequest generates requests for ClientCaList
+func NewClientCaListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.CreatedBefore != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdBefore", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/client-cas", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.FinishedAfter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "finishedAfter", runtime.ParamLocationQuery, *params.FinishedAfter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.FinishedBefore != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "finishedBefore", runtime.ParamLocationQuery, *params.FinishedBefore); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.OrderByField != nil {
+// NewClientCaCreateRequest calls the generic ClientCaCreate builder with application/json body
+func NewClientCaCreateRequest(server string, tenant openapi_types.UUID, body ClientCaCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewClientCaCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewClientCaCreateRequestWithBody generates requests for ClientCaCreate with any type of body
+func NewClientCaCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.OrderByDirection != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/client-cas", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		queryURL.RawQuery = queryValues.Encode()
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewWorkflowRunGetMetricsRequest generates requests for WorkflowRunGetMetrics
-func NewWorkflowRunGetMetricsRequest(server string, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams) (*http.Request, error) {
+// NewCronCalendarListRequest generates requests for CronCalendarList
+func NewCronCalendarListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6807,7 +7785,7 @@ func NewWorkflowRunGetMetricsRequest(server string, tenant openapi_types.UUID, p
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/runs/metrics", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/cron-calendars", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6817,125 +7795,95 @@ func NewWorkflowRunGetMetricsRequest(server string, tenant openapi_types.UUID, p
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.EventId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventId", runtime.ParamLocationQuery, *params.EventId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.WorkflowId != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewCronCalendarCreateRequest calls the generic CronCalendarCreate builder with application/json body
+func NewCronCalendarCreateRequest(server string, tenant openapi_types.UUID, body CronCalendarCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCronCalendarCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
 
-		}
+// NewCronCalendarCreateRequestWithBody generates requests for CronCalendarCreate with any type of body
+func NewCronCalendarCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		if params.ParentWorkflowRunId != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentWorkflowRunId", runtime.ParamLocationQuery, *params.ParentWorkflowRunId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.ParentStepRunId != nil {
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/cron-calendars", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentStepRunId", runtime.ParamLocationQuery, *params.ParentStepRunId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.AdditionalMetadata != nil {
+	req.Header.Add("Content-Type", contentType)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewCronCalendarDeleteRequest generates requests for CronCalendarDelete
+func NewCronCalendarDeleteRequest(server string, tenant openapi_types.UUID, cronCalendar openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-		if params.CreatedAfter != nil {
+	var pathParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdAfter", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	var pathParam1 string
 
-		if params.CreatedBefore != nil {
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cron-calendar", runtime.ParamLocationPath, cronCalendar)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdBefore", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/cron-calendars/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		queryURL.RawQuery = queryValues.Encode()
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -6943,8 +7891,8 @@ func NewWorkflowRunGetMetricsRequest(server string, tenant openapi_types.UUID, p
 	return req, nil
 }
 
-// NewWorkflowScheduledListRequest generates requests for WorkflowScheduledList
-func NewWorkflowScheduledListRequest(server string, tenant openapi_types.UUID, params *WorkflowScheduledListParams) (*http.Request, error) {
+// NewDeadLetterQueueListRequest generates requests for DeadLetterQueueList
+func NewDeadLetterQueueListRequest(server string, tenant openapi_types.UUID, params *DeadLetterQueueListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6959,7 +7907,7 @@ func NewWorkflowScheduledListRequest(server string, tenant openapi_types.UUID, p
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/scheduled", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/dead-letter-queue", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7004,118 +7952,6 @@ func NewWorkflowScheduledListRequest(server string, tenant openapi_types.UUID, p
 
 		}
 
-		if params.OrderByField != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderByDirection != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.WorkflowId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.ParentWorkflowRunId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentWorkflowRunId", runtime.ParamLocationQuery, *params.ParentWorkflowRunId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.ParentStepRunId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentStepRunId", runtime.ParamLocationQuery, *params.ParentStepRunId); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.AdditionalMetadata != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Statuses != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -7127,20 +7963,24 @@ func NewWorkflowScheduledListRequest(server string, tenant openapi_types.UUID, p
 	return req, nil
 }
 
-// NewWorkflowScheduledDeleteRequest generates requests for WorkflowScheduledDelete
-func NewWorkflowScheduledDeleteRequest(server string, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+// NewDeadLetterQueueDeleteRequest calls the generic DeadLetterQueueDelete builder with application/json body
+func NewDeadLetterQueueDeleteRequest(server string, tenant openapi_types.UUID, body DeadLetterQueueDeleteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeadLetterQueueDeleteRequestWithBody(server, tenant, "application/json", bodyReader)
+}
 
-	var pathParam1 string
+// NewDeadLetterQueueDeleteRequestWithBody generates requests for DeadLetterQueueDelete with any type of body
+func NewDeadLetterQueueDeleteRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, scheduledWorkflowRun)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -7150,7 +7990,7 @@ func NewWorkflowScheduledDeleteRequest(server string, tenant openapi_types.UUID,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/scheduled/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/dead-letter-queue/purge", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7160,28 +8000,34 @@ func NewWorkflowScheduledDeleteRequest(server string, tenant openapi_types.UUID,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewWorkflowScheduledGetRequest generates requests for WorkflowScheduledGet
-func NewWorkflowScheduledGetRequest(server string, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+// NewDeadLetterQueueUpdateReplayRequest calls the generic DeadLetterQueueUpdateReplay builder with application/json body
+func NewDeadLetterQueueUpdateReplayRequest(server string, tenant openapi_types.UUID, body DeadLetterQueueUpdateReplayJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeadLetterQueueUpdateReplayRequestWithBody(server, tenant, "application/json", bodyReader)
+}
 
-	var pathParam1 string
+// NewDeadLetterQueueUpdateReplayRequestWithBody generates requests for DeadLetterQueueUpdateReplay with any type of body
+func NewDeadLetterQueueUpdateReplayRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, scheduledWorkflowRun)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -7191,7 +8037,7 @@ func NewWorkflowScheduledGetRequest(server string, tenant openapi_types.UUID, sc
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/scheduled/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/dead-letter-queue/replay", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7201,27 +8047,18 @@ func NewWorkflowScheduledGetRequest(server string, tenant openapi_types.UUID, sc
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	req.Header.Add("Content-Type", contentType)
 
-// NewCronWorkflowTriggerCreateRequest calls the generic CronWorkflowTriggerCreate builder with application/json body
-func NewCronWorkflowTriggerCreateRequest(server string, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCronWorkflowTriggerCreateRequestWithBody(server, tenant, workflow, "application/json", bodyReader)
+	return req, nil
 }
 
-// NewCronWorkflowTriggerCreateRequestWithBody generates requests for CronWorkflowTriggerCreate with any type of body
-func NewCronWorkflowTriggerCreateRequestWithBody(server string, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader) (*http.Request, error) {
+// NewEventDedupRuleListRequest generates requests for EventDedupRuleList
+func NewEventDedupRuleListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7231,19 +8068,12 @@ func NewCronWorkflowTriggerCreateRequestWithBody(server string, tenant openapi_t
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/%s/crons", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/event-dedup-rules", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7253,29 +8083,27 @@ func NewCronWorkflowTriggerCreateRequestWithBody(server string, tenant openapi_t
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewScheduledWorkflowRunCreateRequest calls the generic ScheduledWorkflowRunCreate builder with application/json body
-func NewScheduledWorkflowRunCreateRequest(server string, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody) (*http.Request, error) {
+// NewEventDedupRuleUpsertRequest calls the generic EventDedupRuleUpsert builder with application/json body
+func NewEventDedupRuleUpsertRequest(server string, tenant openapi_types.UUID, body EventDedupRuleUpsertJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewScheduledWorkflowRunCreateRequestWithBody(server, tenant, workflow, "application/json", bodyReader)
+	return NewEventDedupRuleUpsertRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewScheduledWorkflowRunCreateRequestWithBody generates requests for ScheduledWorkflowRunCreate with any type of body
-func NewScheduledWorkflowRunCreateRequestWithBody(server string, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader) (*http.Request, error) {
+// NewEventDedupRuleUpsertRequestWithBody generates requests for EventDedupRuleUpsert with any type of body
+func NewEventDedupRuleUpsertRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7285,19 +8113,12 @@ func NewScheduledWorkflowRunCreateRequestWithBody(server string, tenant openapi_
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/%s/scheduled", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/event-dedup-rules", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7317,8 +8138,8 @@ func NewScheduledWorkflowRunCreateRequestWithBody(server string, tenant openapi_
 	return req, nil
 }
 
-// NewWorkflowGetWorkersCountRequest generates requests for WorkflowGetWorkersCount
-func NewWorkflowGetWorkersCountRequest(server string, tenant openapi_types.UUID, workflow openapi_types.UUID) (*http.Request, error) {
+// NewEventRoutingRuleListRequest generates requests for EventRoutingRuleList
+func NewEventRoutingRuleListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7328,19 +8149,12 @@ func NewWorkflowGetWorkersCountRequest(server string, tenant openapi_types.UUID,
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/%s/worker-count", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/event-routing-rules", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7358,16 +8172,34 @@ func NewWorkflowGetWorkersCountRequest(server string, tenant openapi_types.UUID,
 	return req, nil
 }
 
-// NewUserGetCurrentRequest generates requests for UserGetCurrent
-func NewUserGetCurrentRequest(server string) (*http.Request, error) {
+// NewEventRoutingRuleUpsertRequest calls the generic EventRoutingRuleUpsert builder with application/json body
+func NewEventRoutingRuleUpsertRequest(server string, tenant openapi_types.UUID, body EventRoutingRuleUpsertJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEventRoutingRuleUpsertRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewEventRoutingRuleUpsertRequestWithBody generates requests for EventRoutingRuleUpsert with any type of body
+func NewEventRoutingRuleUpsertRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/current")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/event-routing-rules", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7377,24 +8209,33 @@ func NewUserGetCurrentRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewUserUpdateGithubOauthCallbackRequest generates requests for UserUpdateGithubOauthCallback
-func NewUserUpdateGithubOauthCallbackRequest(server string) (*http.Request, error) {
+// NewEventSinkListRequest generates requests for EventSinkList
+func NewEventSinkListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/github/callback")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/event-sinks", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7412,16 +8253,34 @@ func NewUserUpdateGithubOauthCallbackRequest(server string) (*http.Request, erro
 	return req, nil
 }
 
-// NewUserUpdateGithubOauthStartRequest generates requests for UserUpdateGithubOauthStart
-func NewUserUpdateGithubOauthStartRequest(server string) (*http.Request, error) {
+// NewEventSinkCreateRequest calls the generic EventSinkCreate builder with application/json body
+func NewEventSinkCreateRequest(server string, tenant openapi_types.UUID, body EventSinkCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEventSinkCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewEventSinkCreateRequestWithBody generates requests for EventSinkCreate with any type of body
+func NewEventSinkCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/github/start")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/event-sinks", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7431,24 +8290,33 @@ func NewUserUpdateGithubOauthStartRequest(server string) (*http.Request, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// NewUserUpdateGoogleOauthCallbackRequest generates requests for UserUpdateGoogleOauthCallback
-func NewUserUpdateGoogleOauthCallbackRequest(server string) (*http.Request, error) {
+// NewEventListRequest generates requests for EventList
+func NewEventListRequest(server string, tenant openapi_types.UUID, params *EventListParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/google/callback")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7458,6 +8326,172 @@ func NewUserUpdateGoogleOauthCallbackRequest(server string) (*http.Request, erro
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Keys != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "keys", runtime.ParamLocationQuery, *params.Keys); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Workflows != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflows", runtime.ParamLocationQuery, *params.Workflows); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Statuses != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Search != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByField != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByDirection != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AdditionalMetadata != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EventIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventIds", runtime.ParamLocationQuery, *params.EventIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -7466,43 +8500,34 @@ func NewUserUpdateGoogleOauthCallbackRequest(server string) (*http.Request, erro
 	return req, nil
 }
 
-// NewUserUpdateGoogleOauthStartRequest generates requests for UserUpdateGoogleOauthStart
-func NewUserUpdateGoogleOauthStartRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+// NewEventCreateRequest calls the generic EventCreate builder with application/json body
+func NewEventCreateRequest(server string, tenant openapi_types.UUID, body EventCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEventCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/users/google/start")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// NewEventCreateRequestWithBody generates requests for EventCreate with any type of body
+func NewEventCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	var pathParam0 string
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewUserListTenantInvitesRequest generates requests for UserListTenantInvites
-func NewUserListTenantInvitesRequest(server string) (*http.Request, error) {
-	var err error
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/invites")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7512,35 +8537,44 @@ func NewUserListTenantInvitesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewTenantInviteAcceptRequest calls the generic TenantInviteAccept builder with application/json body
-func NewTenantInviteAcceptRequest(server string, body TenantInviteAcceptJSONRequestBody) (*http.Request, error) {
+// NewEventCreateBatchRequest calls the generic EventCreateBatch builder with application/json body
+func NewEventCreateBatchRequest(server string, tenant openapi_types.UUID, body EventCreateBatchJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewTenantInviteAcceptRequestWithBody(server, "application/json", bodyReader)
+	return NewEventCreateBatchRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewTenantInviteAcceptRequestWithBody generates requests for TenantInviteAccept with any type of body
-func NewTenantInviteAcceptRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewEventCreateBatchRequestWithBody generates requests for EventCreateBatch with any type of body
+func NewEventCreateBatchRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/invites/accept")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/batch", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7560,27 +8594,34 @@ func NewTenantInviteAcceptRequestWithBody(server string, contentType string, bod
 	return req, nil
 }
 
-// NewTenantInviteRejectRequest calls the generic TenantInviteReject builder with application/json body
-func NewTenantInviteRejectRequest(server string, body TenantInviteRejectJSONRequestBody) (*http.Request, error) {
+// NewEventCreateBulkRequest calls the generic EventCreateBulk builder with application/json body
+func NewEventCreateBulkRequest(server string, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewTenantInviteRejectRequestWithBody(server, "application/json", bodyReader)
+	return NewEventCreateBulkRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewTenantInviteRejectRequestWithBody generates requests for TenantInviteReject with any type of body
-func NewTenantInviteRejectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewEventCreateBulkRequestWithBody generates requests for EventCreateBulk with any type of body
+func NewEventCreateBulkRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/invites/reject")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/bulk", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7600,27 +8641,34 @@ func NewTenantInviteRejectRequestWithBody(server string, contentType string, bod
 	return req, nil
 }
 
-// NewUserUpdateLoginRequest calls the generic UserUpdateLogin builder with application/json body
-func NewUserUpdateLoginRequest(server string, body UserUpdateLoginJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
+// NewEventUpdateCancelRequest calls the generic EventUpdateCancel builder with application/json body
+func NewEventUpdateCancelRequest(server string, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUserUpdateLoginRequestWithBody(server, "application/json", bodyReader)
+	return NewEventUpdateCancelRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewUserUpdateLoginRequestWithBody generates requests for UserUpdateLogin with any type of body
-func NewUserUpdateLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewEventUpdateCancelRequestWithBody generates requests for EventUpdateCancel with any type of body
+func NewEventUpdateCancelRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/login")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/cancel", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7640,16 +8688,23 @@ func NewUserUpdateLoginRequestWithBody(server string, contentType string, body i
 	return req, nil
 }
 
-// NewUserUpdateLogoutRequest generates requests for UserUpdateLogout
-func NewUserUpdateLogoutRequest(server string) (*http.Request, error) {
+// NewEventCreateCloudEventRequest generates requests for EventCreateCloudEvent
+func NewEventCreateCloudEventRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/logout")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/cloudevents", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7667,16 +8722,23 @@ func NewUserUpdateLogoutRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewTenantMembershipsListRequest generates requests for TenantMembershipsList
-func NewTenantMembershipsListRequest(server string) (*http.Request, error) {
+// NewEventKeyListRequest generates requests for EventKeyList
+func NewEventKeyListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/memberships")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/keys", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7694,27 +8756,34 @@ func NewTenantMembershipsListRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUserUpdatePasswordRequest calls the generic UserUpdatePassword builder with application/json body
-func NewUserUpdatePasswordRequest(server string, body UserUpdatePasswordJSONRequestBody) (*http.Request, error) {
+// NewEventUpdateReplayRequest calls the generic EventUpdateReplay builder with application/json body
+func NewEventUpdateReplayRequest(server string, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUserUpdatePasswordRequestWithBody(server, "application/json", bodyReader)
+	return NewEventUpdateReplayRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewUserUpdatePasswordRequestWithBody generates requests for UserUpdatePassword with any type of body
-func NewUserUpdatePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewEventUpdateReplayRequestWithBody generates requests for EventUpdateReplay with any type of body
+func NewEventUpdateReplayRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/password")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/replay", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7734,27 +8803,34 @@ func NewUserUpdatePasswordRequestWithBody(server string, contentType string, bod
 	return req, nil
 }
 
-// NewUserCreateRequest calls the generic UserCreate builder with application/json body
-func NewUserCreateRequest(server string, body UserCreateJSONRequestBody) (*http.Request, error) {
+// NewEventUpdateReplayRangeRequest calls the generic EventUpdateReplayRange builder with application/json body
+func NewEventUpdateReplayRangeRequest(server string, tenant openapi_types.UUID, body EventUpdateReplayRangeJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUserCreateRequestWithBody(server, "application/json", bodyReader)
+	return NewEventUpdateReplayRangeRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// NewUserCreateRequestWithBody generates requests for UserCreate with any type of body
-func NewUserCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewEventUpdateReplayRangeRequestWithBody generates requests for EventUpdateReplayRange with any type of body
+func NewEventUpdateReplayRangeRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/register")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/events/replay-range", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7774,16 +8850,23 @@ func NewUserCreateRequestWithBody(server string, contentType string, body io.Rea
 	return req, nil
 }
 
-// NewUserUpdateSlackOauthCallbackRequest generates requests for UserUpdateSlackOauthCallback
-func NewUserUpdateSlackOauthCallbackRequest(server string) (*http.Request, error) {
+// NewInboundWebhookListRequest generates requests for InboundWebhookList
+func NewInboundWebhookListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/users/slack/callback")
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/inbound-webhooks", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7801,13 +8884,24 @@ func NewUserUpdateSlackOauthCallbackRequest(server string) (*http.Request, error
 	return req, nil
 }
 
-// NewWebhookDeleteRequest generates requests for WebhookDelete
-func NewWebhookDeleteRequest(server string, webhook openapi_types.UUID) (*http.Request, error) {
+// NewInboundWebhookCreateRequest calls the generic InboundWebhookCreate builder with application/json body
+func NewInboundWebhookCreateRequest(server string, tenant openapi_types.UUID, body InboundWebhookCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewInboundWebhookCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewInboundWebhookCreateRequestWithBody generates requests for InboundWebhookCreate with any type of body
+func NewInboundWebhookCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook", runtime.ParamLocationPath, webhook)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -7817,7 +8911,7 @@ func NewWebhookDeleteRequest(server string, webhook openapi_types.UUID) (*http.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/webhook-workers/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/inbound-webhooks", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7827,21 +8921,23 @@ func NewWebhookDeleteRequest(server string, webhook openapi_types.UUID) (*http.R
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewWebhookRequestsListRequest generates requests for WebhookRequestsList
-func NewWebhookRequestsListRequest(server string, webhook openapi_types.UUID) (*http.Request, error) {
+// NewTenantInviteListRequest generates requests for TenantInviteList
+func NewTenantInviteListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook", runtime.ParamLocationPath, webhook)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -7851,7 +8947,7 @@ func NewWebhookRequestsListRequest(server string, webhook openapi_types.UUID) (*
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/webhook-workers/%s/requests", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7869,13 +8965,24 @@ func NewWebhookRequestsListRequest(server string, webhook openapi_types.UUID) (*
 	return req, nil
 }
 
-// NewWorkerGetRequest generates requests for WorkerGet
-func NewWorkerGetRequest(server string, worker openapi_types.UUID) (*http.Request, error) {
+// NewTenantInviteCreateRequest calls the generic TenantInviteCreate builder with application/json body
+func NewTenantInviteCreateRequest(server string, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantInviteCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewTenantInviteCreateRequestWithBody generates requests for TenantInviteCreate with any type of body
+func NewTenantInviteCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker", runtime.ParamLocationPath, worker)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -7885,7 +8992,7 @@ func NewWorkerGetRequest(server string, worker openapi_types.UUID) (*http.Reques
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workers/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7895,32 +9002,30 @@ func NewWorkerGetRequest(server string, worker openapi_types.UUID) (*http.Reques
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewWorkerUpdateRequest calls the generic WorkerUpdate builder with application/json body
-func NewWorkerUpdateRequest(server string, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewTenantInviteDeleteRequest generates requests for TenantInviteDelete
+func NewTenantInviteDeleteRequest(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewWorkerUpdateRequestWithBody(server, worker, "application/json", bodyReader)
-}
-
-// NewWorkerUpdateRequestWithBody generates requests for WorkerUpdate with any type of body
-func NewWorkerUpdateRequestWithBody(server string, worker openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
-	var pathParam0 string
+	var pathParam1 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker", runtime.ParamLocationPath, worker)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, tenantInvite)
 	if err != nil {
 		return nil, err
 	}
@@ -7930,7 +9035,7 @@ func NewWorkerUpdateRequestWithBody(server string, worker openapi_types.UUID, co
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workers/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7940,23 +9045,39 @@ func NewWorkerUpdateRequestWithBody(server string, worker openapi_types.UUID, co
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewWorkflowDeleteRequest generates requests for WorkflowDelete
-func NewWorkflowDeleteRequest(server string, workflow openapi_types.UUID) (*http.Request, error) {
+// NewTenantInviteUpdateRequest calls the generic TenantInviteUpdate builder with application/json body
+func NewTenantInviteUpdateRequest(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantInviteUpdateRequestWithBody(server, tenant, tenantInvite, "application/json", bodyReader)
+}
+
+// NewTenantInviteUpdateRequestWithBody generates requests for TenantInviteUpdate with any type of body
+func NewTenantInviteUpdateRequestWithBody(server string, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tenant-invite", runtime.ParamLocationPath, tenantInvite)
 	if err != nil {
 		return nil, err
 	}
@@ -7966,7 +9087,7 @@ func NewWorkflowDeleteRequest(server string, workflow openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workflows/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/invites/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7976,21 +9097,23 @@ func NewWorkflowDeleteRequest(server string, workflow openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewWorkflowGetRequest generates requests for WorkflowGet
-func NewWorkflowGetRequest(server string, workflow openapi_types.UUID) (*http.Request, error) {
+// NewTenantMemberListRequest generates requests for TenantMemberList
+func NewTenantMemberListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -8000,7 +9123,7 @@ func NewWorkflowGetRequest(server string, workflow openapi_types.UUID) (*http.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workflows/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/members", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8018,24 +9141,20 @@ func NewWorkflowGetRequest(server string, workflow openapi_types.UUID) (*http.Re
 	return req, nil
 }
 
-// NewWorkflowUpdateRequest calls the generic WorkflowUpdate builder with application/json body
-func NewWorkflowUpdateRequest(server string, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewTenantMemberDeleteRequest generates requests for TenantMemberDelete
+func NewTenantMemberDeleteRequest(server string, tenant openapi_types.UUID, member openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewWorkflowUpdateRequestWithBody(server, workflow, "application/json", bodyReader)
-}
-
-// NewWorkflowUpdateRequestWithBody generates requests for WorkflowUpdate with any type of body
-func NewWorkflowUpdateRequestWithBody(server string, workflow openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
-	var pathParam0 string
+	var pathParam1 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "member", runtime.ParamLocationPath, member)
 	if err != nil {
 		return nil, err
 	}
@@ -8045,7 +9164,7 @@ func NewWorkflowUpdateRequestWithBody(server string, workflow openapi_types.UUID
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workflows/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/members/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8055,23 +9174,21 @@ func NewWorkflowUpdateRequestWithBody(server string, workflow openapi_types.UUID
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewWorkflowGetMetricsRequest generates requests for WorkflowGetMetrics
-func NewWorkflowGetMetricsRequest(server string, workflow openapi_types.UUID, params *WorkflowGetMetricsParams) (*http.Request, error) {
+// NewTenantGetQueueMetricsRequest generates requests for TenantGetQueueMetrics
+func NewTenantGetQueueMetricsRequest(server string, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -8081,7 +9198,7 @@ func NewWorkflowGetMetricsRequest(server string, workflow openapi_types.UUID, pa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workflows/%s/metrics", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/queue-metrics", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8094,9 +9211,9 @@ func NewWorkflowGetMetricsRequest(server string, workflow openapi_types.UUID, pa
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Status != nil {
+		if params.Workflows != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflows", runtime.ParamLocationQuery, *params.Workflows); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -8110,9 +9227,25 @@ func NewWorkflowGetMetricsRequest(server string, workflow openapi_types.UUID, pa
 
 		}
 
-		if params.GroupKey != nil {
+		if params.AdditionalMetadata != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "groupKey", runtime.ParamLocationQuery, *params.GroupKey); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Window != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "window", runtime.ParamLocationQuery, *params.Window); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -8137,24 +9270,61 @@ func NewWorkflowGetMetricsRequest(server string, workflow openapi_types.UUID, pa
 	return req, nil
 }
 
-// NewWorkflowRunCreateRequest calls the generic WorkflowRunCreate builder with application/json body
-func NewWorkflowRunCreateRequest(server string, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewQueueUpdatePauseRequest generates requests for QueueUpdatePause
+func NewQueueUpdatePauseRequest(server string, tenant openapi_types.UUID, queue string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewWorkflowRunCreateRequestWithBody(server, workflow, params, "application/json", bodyReader)
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "queue", runtime.ParamLocationPath, queue)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/queues/%s/pause", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// NewWorkflowRunCreateRequestWithBody generates requests for WorkflowRunCreate with any type of body
-func NewWorkflowRunCreateRequestWithBody(server string, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewQueueUpdateResumeRequest generates requests for QueueUpdateResume
+func NewQueueUpdateResumeRequest(server string, tenant openapi_types.UUID, queue string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "queue", runtime.ParamLocationPath, queue)
 	if err != nil {
 		return nil, err
 	}
@@ -8164,7 +9334,7 @@ func NewWorkflowRunCreateRequestWithBody(server string, workflow openapi_types.U
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workflows/%s/trigger", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/queues/%s/resume", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8174,45 +9344,55 @@ func NewWorkflowRunCreateRequestWithBody(server string, workflow openapi_types.U
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Version != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewTenantQuotaListRequest generates requests for TenantQuotaList
+func NewTenantQuotaListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		queryURL.RawQuery = queryValues.Encode()
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/quotas", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
 
-// NewWorkflowVersionGetRequest generates requests for WorkflowVersionGet
-func NewWorkflowVersionGetRequest(server string, workflow openapi_types.UUID, params *WorkflowVersionGetParams) (*http.Request, error) {
+// NewRateLimitListRequest generates requests for RateLimitList
+func NewRateLimitListRequest(server string, tenant openapi_types.UUID, params *RateLimitListParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -8222,7 +9402,7 @@ func NewWorkflowVersionGetRequest(server string, workflow openapi_types.UUID, pa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/workflows/%s/versions", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/rate-limits", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8235,9 +9415,73 @@ func NewWorkflowVersionGetRequest(server string, workflow openapi_types.UUID, pa
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Version != nil {
+		if params.Offset != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Search != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByField != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByDirection != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -8262,547 +9506,6671 @@ func NewWorkflowVersionGetRequest(server string, workflow openapi_types.UUID, pa
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewTenantResourcePolicyGetRequest generates requests for TenantResourcePolicyGet
+func NewTenantResourcePolicyGetRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/resource-policy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// LivenessGetWithResponse request
-	LivenessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LivenessGetResponse, error)
+	return req, nil
+}
 
-	// ReadinessGetWithResponse request
-	ReadinessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadinessGetResponse, error)
+// NewSlackWebhookListRequest generates requests for SlackWebhookList
+func NewSlackWebhookListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	// AlertEmailGroupDeleteWithResponse request
-	AlertEmailGroupDeleteWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupDeleteResponse, error)
+	var pathParam0 string
 
-	// AlertEmailGroupUpdateWithBodyWithResponse request with any body
-	AlertEmailGroupUpdateWithBodyWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-	AlertEmailGroupUpdateWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, body AlertEmailGroupUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// ApiTokenUpdateRevokeWithResponse request
-	ApiTokenUpdateRevokeWithResponse(ctx context.Context, apiToken openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenUpdateRevokeResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/slack", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// CloudMetadataGetWithResponse request
-	CloudMetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CloudMetadataGetResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// EventGetWithResponse request
-	EventGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventGetResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// EventDataGetWithResponse request
-	EventDataGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventDataGetResponse, error)
+	return req, nil
+}
 
-	// MetadataGetWithResponse request
-	MetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataGetResponse, error)
+// NewUserUpdateSlackOauthStartRequest generates requests for UserUpdateSlackOauthStart
+func NewUserUpdateSlackOauthStartRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	// MetadataListIntegrationsWithResponse request
-	MetadataListIntegrationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataListIntegrationsResponse, error)
+	var pathParam0 string
 
-	// SlackWebhookDeleteWithResponse request
-	SlackWebhookDeleteWithResponse(ctx context.Context, slack openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookDeleteResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-	// SnsDeleteWithResponse request
-	SnsDeleteWithResponse(ctx context.Context, sns openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsDeleteResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// SnsUpdateWithResponse request
-	SnsUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, event string, reqEditors ...RequestEditorFn) (*SnsUpdateResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/slack/start", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// StepRunListArchivesWithResponse request
-	StepRunListArchivesWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListArchivesParams, reqEditors ...RequestEditorFn) (*StepRunListArchivesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// StepRunListEventsWithResponse request
-	StepRunListEventsWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListEventsParams, reqEditors ...RequestEditorFn) (*StepRunListEventsResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// LogLineListWithResponse request
-	LogLineListWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *LogLineListParams, reqEditors ...RequestEditorFn) (*LogLineListResponse, error)
+	return req, nil
+}
 
-	// TenantCreateWithBodyWithResponse request with any body
-	TenantCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error)
+// NewSnsListRequest generates requests for SnsList
+func NewSnsListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	TenantCreateWithResponse(ctx context.Context, body TenantCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error)
+	var pathParam0 string
 
-	// TenantUpdateWithBodyWithResponse request with any body
-	TenantUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-	TenantUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// AlertEmailGroupListWithResponse request
-	AlertEmailGroupListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupListResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/sns", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// AlertEmailGroupCreateWithBodyWithResponse request with any body
-	AlertEmailGroupCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	AlertEmailGroupCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// TenantAlertingSettingsGetWithResponse request
-	TenantAlertingSettingsGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantAlertingSettingsGetResponse, error)
+	return req, nil
+}
 
-	// ApiTokenListWithResponse request
-	ApiTokenListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenListResponse, error)
+// NewSnsCreateRequest calls the generic SnsCreate builder with application/json body
+func NewSnsCreateRequest(server string, tenant openapi_types.UUID, body SnsCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSnsCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
 
-	// ApiTokenCreateWithBodyWithResponse request with any body
-	ApiTokenCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error)
+// NewSnsCreateRequestWithBody generates requests for SnsCreate with any type of body
+func NewSnsCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	ApiTokenCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error)
+	var pathParam0 string
 
-	// EventListWithResponse request
-	EventListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *EventListParams, reqEditors ...RequestEditorFn) (*EventListResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-	// EventCreateWithBodyWithResponse request with any body
-	EventCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	EventCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/sns", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// EventCreateBulkWithBodyWithResponse request with any body
-	EventCreateBulkWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	EventCreateBulkWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// EventUpdateCancelWithBodyWithResponse request with any body
-	EventUpdateCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	EventUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error)
+	return req, nil
+}
 
-	// EventKeyListWithResponse request
-	EventKeyListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventKeyListResponse, error)
+// NewSqsListRequest generates requests for SqsList
+func NewSqsListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	// EventUpdateReplayWithBodyWithResponse request with any body
-	EventUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error)
+	var pathParam0 string
 
-	EventUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-	// TenantInviteListWithResponse request
-	TenantInviteListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteListResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// TenantInviteCreateWithBodyWithResponse request with any body
-	TenantInviteCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/sqs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	TenantInviteCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// TenantInviteDeleteWithResponse request
-	TenantInviteDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteDeleteResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// TenantInviteUpdateWithBodyWithResponse request with any body
-	TenantInviteUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error)
+	return req, nil
+}
 
-	TenantInviteUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error)
+// NewSqsCreateRequest calls the generic SqsCreate builder with application/json body
+func NewSqsCreateRequest(server string, tenant openapi_types.UUID, body SqsCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSqsCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
 
-	// TenantMemberListWithResponse request
-	TenantMemberListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberListResponse, error)
+// NewSqsCreateRequestWithBody generates requests for SqsCreate with any type of body
+func NewSqsCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// TenantMemberDeleteWithResponse request
-	TenantMemberDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberDeleteResponse, error)
+	var pathParam0 string
 
-	// TenantGetQueueMetricsWithResponse request
-	TenantGetQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*TenantGetQueueMetricsResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-	// RateLimitListWithResponse request
-	RateLimitListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*RateLimitListResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// TenantResourcePolicyGetWithResponse request
-	TenantResourcePolicyGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantResourcePolicyGetResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/sqs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// SlackWebhookListWithResponse request
-	SlackWebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookListResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// UserUpdateSlackOauthStartWithResponse request
-	UserUpdateSlackOauthStartWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthStartResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// SnsListWithResponse request
-	SnsListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsListResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// SnsCreateWithBodyWithResponse request with any body
-	SnsCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error)
+	return req, nil
+}
 
-	SnsCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body SnsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error)
+// NewTenantGetStepRunQueueMetricsRequest generates requests for TenantGetStepRunQueueMetrics
+func NewTenantGetStepRunQueueMetricsRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	// TenantGetStepRunQueueMetricsWithResponse request
-	TenantGetStepRunQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantGetStepRunQueueMetricsResponse, error)
+	var pathParam0 string
 
-	// StepRunGetWithResponse request
-	StepRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-	// StepRunUpdateCancelWithResponse request
-	StepRunUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunUpdateCancelResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// StepRunUpdateRerunWithBodyWithResponse request with any body
-	StepRunUpdateRerunWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-run-queue-metrics", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	StepRunUpdateRerunWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// StepRunGetSchemaWithResponse request
-	StepRunGetSchemaWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetSchemaResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// WebhookListWithResponse request
-	WebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookListResponse, error)
+	return req, nil
+}
 
-	// WebhookCreateWithBodyWithResponse request with any body
-	WebhookCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error)
+// NewStepRunGetRequest generates requests for StepRunGet
+func NewStepRunGetRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	WebhookCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error)
+	var pathParam0 string
 
-	// WorkerListWithResponse request
-	WorkerListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerListResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowRunUpdateReplayWithBodyWithResponse request with any body
-	WorkflowRunUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error)
+	var pathParam1 string
 
-	WorkflowRunUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowRunGetWithResponse request
-	WorkflowRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowRunGetInputWithResponse request
-	WorkflowRunGetInputWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetInputResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// WorkflowRunGetShapeWithResponse request
-	WorkflowRunGetShapeWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetShapeResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowRunListStepRunEventsWithResponse request
-	WorkflowRunListStepRunEventsWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams, reqEditors ...RequestEditorFn) (*WorkflowRunListStepRunEventsResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowListWithResponse request
-	WorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowListResponse, error)
+	return req, nil
+}
 
-	// WorkflowRunCancelWithBodyWithResponse request with any body
-	WorkflowRunCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error)
+// NewStepRunUpdateCancelRequest generates requests for StepRunUpdateCancel
+func NewStepRunUpdateCancelRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	WorkflowRunCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error)
+	var pathParam0 string
 
-	// CronWorkflowListWithResponse request
-	CronWorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *CronWorkflowListParams, reqEditors ...RequestEditorFn) (*CronWorkflowListResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowCronDeleteWithResponse request
-	WorkflowCronDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronDeleteResponse, error)
+	var pathParam1 string
 
-	// WorkflowCronGetWithResponse request
-	WorkflowCronGetWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronGetResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowRunListWithResponse request
-	WorkflowRunListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunListParams, reqEditors ...RequestEditorFn) (*WorkflowRunListResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowRunGetMetricsWithResponse request
-	WorkflowRunGetMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowRunGetMetricsResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s/cancel", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// WorkflowScheduledListWithResponse request
-	WorkflowScheduledListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowScheduledListParams, reqEditors ...RequestEditorFn) (*WorkflowScheduledListResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowScheduledDeleteWithResponse request
-	WorkflowScheduledDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledDeleteResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowScheduledGetWithResponse request
-	WorkflowScheduledGetWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledGetResponse, error)
+	return req, nil
+}
 
-	// CronWorkflowTriggerCreateWithBodyWithResponse request with any body
-	CronWorkflowTriggerCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error)
+// NewStepRunUpdateRerunRequest calls the generic StepRunUpdateRerun builder with application/json body
+func NewStepRunUpdateRerunRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewStepRunUpdateRerunRequestWithBody(server, tenant, stepRun, "application/json", bodyReader)
+}
 
-	CronWorkflowTriggerCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error)
+// NewStepRunUpdateRerunRequestWithBody generates requests for StepRunUpdateRerun with any type of body
+func NewStepRunUpdateRerunRequestWithBody(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// ScheduledWorkflowRunCreateWithBodyWithResponse request with any body
-	ScheduledWorkflowRunCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error)
+	var pathParam0 string
 
-	ScheduledWorkflowRunCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowGetWorkersCountWithResponse request
-	WorkflowGetWorkersCountWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetWorkersCountResponse, error)
+	var pathParam1 string
 
-	// UserGetCurrentWithResponse request
-	UserGetCurrentWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetCurrentResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	if err != nil {
+		return nil, err
+	}
 
-	// UserUpdateGithubOauthCallbackWithResponse request
-	UserUpdateGithubOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthCallbackResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// UserUpdateGithubOauthStartWithResponse request
-	UserUpdateGithubOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthStartResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s/rerun", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// UserUpdateGoogleOauthCallbackWithResponse request
-	UserUpdateGoogleOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthCallbackResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// UserUpdateGoogleOauthStartWithResponse request
-	UserUpdateGoogleOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthStartResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// UserListTenantInvitesWithResponse request
-	UserListTenantInvitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListTenantInvitesResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// TenantInviteAcceptWithBodyWithResponse request with any body
-	TenantInviteAcceptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error)
+	return req, nil
+}
 
-	TenantInviteAcceptWithResponse(ctx context.Context, body TenantInviteAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error)
+// NewStepRunGetSchemaRequest generates requests for StepRunGetSchema
+func NewStepRunGetSchemaRequest(server string, tenant openapi_types.UUID, stepRun openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	// TenantInviteRejectWithBodyWithResponse request with any body
-	TenantInviteRejectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error)
+	var pathParam0 string
 
-	TenantInviteRejectWithResponse(ctx context.Context, body TenantInviteRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-	// UserUpdateLoginWithBodyWithResponse request with any body
-	UserUpdateLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error)
+	var pathParam1 string
 
-	UserUpdateLoginWithResponse(ctx context.Context, body UserUpdateLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step-run", runtime.ParamLocationPath, stepRun)
+	if err != nil {
+		return nil, err
+	}
 
-	// UserUpdateLogoutWithResponse request
-	UserUpdateLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateLogoutResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// TenantMembershipsListWithResponse request
-	TenantMembershipsListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TenantMembershipsListResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/step-runs/%s/schema", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// UserUpdatePasswordWithBodyWithResponse request with any body
-	UserUpdatePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	UserUpdatePasswordWithResponse(ctx context.Context, body UserUpdatePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// UserCreateWithBodyWithResponse request with any body
-	UserCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateResponse, error)
+	return req, nil
+}
 
-	UserCreateWithResponse(ctx context.Context, body UserCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateResponse, error)
+// NewWebhookListRequest generates requests for WebhookList
+func NewWebhookListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	// UserUpdateSlackOauthCallbackWithResponse request
-	UserUpdateSlackOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthCallbackResponse, error)
+	var pathParam0 string
 
-	// WebhookDeleteWithResponse request
-	WebhookDeleteWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookDeleteResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-	// WebhookRequestsListWithResponse request
-	WebhookRequestsListWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookRequestsListResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkerGetWithResponse request
-	WorkerGetWithResponse(ctx context.Context, worker openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerGetResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/webhook-workers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// WorkerUpdateWithBodyWithResponse request with any body
-	WorkerUpdateWithBodyWithResponse(ctx context.Context, worker openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	WorkerUpdateWithResponse(ctx context.Context, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowDeleteWithResponse request
-	WorkflowDeleteWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowDeleteResponse, error)
+	return req, nil
+}
 
-	// WorkflowGetWithResponse request
-	WorkflowGetWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetResponse, error)
+// NewWebhookCreateRequest calls the generic WebhookCreate builder with application/json body
+func NewWebhookCreateRequest(server string, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWebhookCreateRequestWithBody(server, tenant, "application/json", bodyReader)
+}
 
-	// WorkflowUpdateWithBodyWithResponse request with any body
-	WorkflowUpdateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error)
+// NewWebhookCreateRequestWithBody generates requests for WebhookCreate with any type of body
+func NewWebhookCreateRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	WorkflowUpdateWithResponse(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error)
+	var pathParam0 string
 
-	// WorkflowGetMetricsWithResponse request
-	WorkflowGetMetricsWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowGetMetricsResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
 
-	// WorkflowRunCreateWithBodyWithResponse request with any body
-	WorkflowRunCreateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	WorkflowRunCreateWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/webhook-workers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// WorkflowVersionGetWithResponse request
-	WorkflowVersionGetWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionGetParams, reqEditors ...RequestEditorFn) (*WorkflowVersionGetResponse, error)
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-type LivenessGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r LivenessGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewWorkerListRequest generates requests for WorkerList
+func NewWorkerListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LivenessGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ReadinessGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/worker", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ReadinessGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ReadinessGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AlertEmailGroupDeleteResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *APIErrors
-	JSON403      *APIError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r AlertEmailGroupDeleteResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewWorkerPoolListRequest generates requests for WorkerPoolList
+func NewWorkerPoolListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AlertEmailGroupDeleteResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AlertEmailGroupUpdateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TenantAlertEmailGroup
-	JSON400      *APIErrors
-	JSON403      *APIError
-}
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/worker-pools", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r AlertEmailGroupUpdateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AlertEmailGroupUpdateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ApiTokenUpdateRevokeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ApiTokenUpdateRevokeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewWorkflowRunUpdateReplayRequest calls the generic WorkflowRunUpdateReplay builder with application/json body
+func NewWorkflowRunUpdateReplayRequest(server string, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewWorkflowRunUpdateReplayRequestWithBody(server, tenant, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ApiTokenUpdateRevokeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewWorkflowRunUpdateReplayRequestWithBody generates requests for WorkflowRunUpdateReplay with any type of body
+func NewWorkflowRunUpdateReplayRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type CloudMetadataGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *APIErrors
-	JSON400      *APIErrors
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r CloudMetadataGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CloudMetadataGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type EventGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Event
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-}
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/replay", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r EventGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r EventGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-type EventDataGetResponse struct {
+// NewWorkflowRunGetRequest generates requests for WorkflowRunGet
+func NewWorkflowRunGetRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowRunGetInputRequest generates requests for WorkflowRunGetInput
+func NewWorkflowRunGetInputRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/input", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowRunUpdatePauseRequest generates requests for WorkflowRunUpdatePause
+func NewWorkflowRunUpdatePauseRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/pause", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowRunUpdateReplayFromStepRequest calls the generic WorkflowRunUpdateReplayFromStep builder with application/json body
+func NewWorkflowRunUpdateReplayFromStepRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID, body WorkflowRunUpdateReplayFromStepJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWorkflowRunUpdateReplayFromStepRequestWithBody(server, tenant, workflowRun, "application/json", bodyReader)
+}
+
+// NewWorkflowRunUpdateReplayFromStepRequestWithBody generates requests for WorkflowRunUpdateReplayFromStep with any type of body
+func NewWorkflowRunUpdateReplayFromStepRequestWithBody(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/replay-from-step", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWorkflowRunUpdateResumeRequest generates requests for WorkflowRunUpdateResume
+func NewWorkflowRunUpdateResumeRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/resume", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowRunGetShapeRequest generates requests for WorkflowRunGetShape
+func NewWorkflowRunGetShapeRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/shape", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowRunListStepRunEventsRequest generates requests for WorkflowRunListStepRunEvents
+func NewWorkflowRunListStepRunEventsRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/step-run-events", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.LastId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lastId", runtime.ParamLocationQuery, *params.LastId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowRunGetStreamRequest generates requests for WorkflowRunGetStream
+func NewWorkflowRunGetStreamRequest(server string, tenant openapi_types.UUID, workflowRun openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow-run", runtime.ParamLocationPath, workflowRun)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflow-runs/%s/stream", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowListRequest generates requests for WorkflowList
+func NewWorkflowListRequest(server string, tenant openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowRunCancelRequest calls the generic WorkflowRunCancel builder with application/json body
+func NewWorkflowRunCancelRequest(server string, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWorkflowRunCancelRequestWithBody(server, tenant, "application/json", bodyReader)
+}
+
+// NewWorkflowRunCancelRequestWithBody generates requests for WorkflowRunCancel with any type of body
+func NewWorkflowRunCancelRequestWithBody(server string, tenant openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/cancel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCronWorkflowListRequest generates requests for CronWorkflowList
+func NewCronWorkflowListRequest(server string, tenant openapi_types.UUID, params *CronWorkflowListParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/crons", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.WorkflowId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AdditionalMetadata != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByField != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByDirection != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowCronDeleteRequest generates requests for WorkflowCronDelete
+func NewWorkflowCronDeleteRequest(server string, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, cronWorkflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/crons/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowCronGetRequest generates requests for WorkflowCronGet
+func NewWorkflowCronGetRequest(server string, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cron-workflow", runtime.ParamLocationPath, cronWorkflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/crons/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowRunListRequest generates requests for WorkflowRunList
+func NewWorkflowRunListRequest(server string, tenant openapi_types.UUID, params *WorkflowRunListParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/runs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EventId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventId", runtime.ParamLocationQuery, *params.EventId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EventKey != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventKey", runtime.ParamLocationQuery, *params.EventKey); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.WorkflowId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.WorkflowIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowIds", runtime.ParamLocationQuery, *params.WorkflowIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ParentWorkflowRunId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentWorkflowRunId", runtime.ParamLocationQuery, *params.ParentWorkflowRunId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ParentStepRunId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentStepRunId", runtime.ParamLocationQuery, *params.ParentStepRunId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Statuses != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Kinds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "kinds", runtime.ParamLocationQuery, *params.Kinds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AdditionalMetadata != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdAfter", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdBefore", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FinishedAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "finishedAfter", runtime.ParamLocationQuery, *params.FinishedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FinishedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "finishedBefore", runtime.ParamLocationQuery, *params.FinishedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByField != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByDirection != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowRunGetMetricsRequest generates requests for WorkflowRunGetMetrics
+func NewWorkflowRunGetMetricsRequest(server string, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/runs/metrics", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.EventId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventId", runtime.ParamLocationQuery, *params.EventId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.WorkflowId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ParentWorkflowRunId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentWorkflowRunId", runtime.ParamLocationQuery, *params.ParentWorkflowRunId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ParentStepRunId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentStepRunId", runtime.ParamLocationQuery, *params.ParentStepRunId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AdditionalMetadata != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdAfter", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "createdBefore", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowScheduledListRequest generates requests for WorkflowScheduledList
+func NewWorkflowScheduledListRequest(server string, tenant openapi_types.UUID, params *WorkflowScheduledListParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/scheduled", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByField != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByField", runtime.ParamLocationQuery, *params.OrderByField); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderByDirection != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderByDirection", runtime.ParamLocationQuery, *params.OrderByDirection); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.WorkflowId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "workflowId", runtime.ParamLocationQuery, *params.WorkflowId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ParentWorkflowRunId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentWorkflowRunId", runtime.ParamLocationQuery, *params.ParentWorkflowRunId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ParentStepRunId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "parentStepRunId", runtime.ParamLocationQuery, *params.ParentStepRunId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AdditionalMetadata != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "additionalMetadata", runtime.ParamLocationQuery, *params.AdditionalMetadata); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Statuses != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "statuses", runtime.ParamLocationQuery, *params.Statuses); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowScheduledDeleteRequest generates requests for WorkflowScheduledDelete
+func NewWorkflowScheduledDeleteRequest(server string, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, scheduledWorkflowRun)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/scheduled/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowScheduledGetRequest generates requests for WorkflowScheduledGet
+func NewWorkflowScheduledGetRequest(server string, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, scheduledWorkflowRun)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/scheduled/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowScheduledUpdateRequest calls the generic WorkflowScheduledUpdate builder with application/json body
+func NewWorkflowScheduledUpdateRequest(server string, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, body WorkflowScheduledUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWorkflowScheduledUpdateRequestWithBody(server, tenant, scheduledWorkflowRun, "application/json", bodyReader)
+}
+
+// NewWorkflowScheduledUpdateRequestWithBody generates requests for WorkflowScheduledUpdate with any type of body
+func NewWorkflowScheduledUpdateRequestWithBody(server string, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "scheduled-workflow-run", runtime.ParamLocationPath, scheduledWorkflowRun)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/scheduled/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCronWorkflowTriggerCreateRequest calls the generic CronWorkflowTriggerCreate builder with application/json body
+func NewCronWorkflowTriggerCreateRequest(server string, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCronWorkflowTriggerCreateRequestWithBody(server, tenant, workflow, "application/json", bodyReader)
+}
+
+// NewCronWorkflowTriggerCreateRequestWithBody generates requests for CronWorkflowTriggerCreate with any type of body
+func NewCronWorkflowTriggerCreateRequestWithBody(server string, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/%s/crons", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewScheduledWorkflowRunCreateRequest calls the generic ScheduledWorkflowRunCreate builder with application/json body
+func NewScheduledWorkflowRunCreateRequest(server string, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewScheduledWorkflowRunCreateRequestWithBody(server, tenant, workflow, "application/json", bodyReader)
+}
+
+// NewScheduledWorkflowRunCreateRequestWithBody generates requests for ScheduledWorkflowRunCreate with any type of body
+func NewScheduledWorkflowRunCreateRequestWithBody(server string, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/%s/scheduled", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWorkflowGetWorkersCountRequest generates requests for WorkflowGetWorkersCount
+func NewWorkflowGetWorkersCountRequest(server string, tenant openapi_types.UUID, workflow openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenant", runtime.ParamLocationPath, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tenants/%s/workflows/%s/worker-count", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserGetCurrentRequest generates requests for UserGetCurrent
+func NewUserGetCurrentRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/current")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserUpdateGithubOauthCallbackRequest generates requests for UserUpdateGithubOauthCallback
+func NewUserUpdateGithubOauthCallbackRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/github/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserUpdateGithubOauthStartRequest generates requests for UserUpdateGithubOauthStart
+func NewUserUpdateGithubOauthStartRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/github/start")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserUpdateGoogleOauthCallbackRequest generates requests for UserUpdateGoogleOauthCallback
+func NewUserUpdateGoogleOauthCallbackRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/google/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserUpdateGoogleOauthStartRequest generates requests for UserUpdateGoogleOauthStart
+func NewUserUpdateGoogleOauthStartRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/google/start")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserListTenantInvitesRequest generates requests for UserListTenantInvites
+func NewUserListTenantInvitesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/invites")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTenantInviteAcceptRequest calls the generic TenantInviteAccept builder with application/json body
+func NewTenantInviteAcceptRequest(server string, body TenantInviteAcceptJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantInviteAcceptRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewTenantInviteAcceptRequestWithBody generates requests for TenantInviteAccept with any type of body
+func NewTenantInviteAcceptRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/invites/accept")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTenantInviteRejectRequest calls the generic TenantInviteReject builder with application/json body
+func NewTenantInviteRejectRequest(server string, body TenantInviteRejectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTenantInviteRejectRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewTenantInviteRejectRequestWithBody generates requests for TenantInviteReject with any type of body
+func NewTenantInviteRejectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/invites/reject")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserUpdateLoginRequest calls the generic UserUpdateLogin builder with application/json body
+func NewUserUpdateLoginRequest(server string, body UserUpdateLoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserUpdateLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserUpdateLoginRequestWithBody generates requests for UserUpdateLogin with any type of body
+func NewUserUpdateLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserUpdateLogoutRequest generates requests for UserUpdateLogout
+func NewUserUpdateLogoutRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTenantMembershipsListRequest generates requests for TenantMembershipsList
+func NewTenantMembershipsListRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/memberships")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserUpdateOidcCallbackRequest generates requests for UserUpdateOidcCallback
+func NewUserUpdateOidcCallbackRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/oidc/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserUpdateOidcStartRequest generates requests for UserUpdateOidcStart
+func NewUserUpdateOidcStartRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/oidc/start")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUserUpdatePasswordRequest calls the generic UserUpdatePassword builder with application/json body
+func NewUserUpdatePasswordRequest(server string, body UserUpdatePasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserUpdatePasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserUpdatePasswordRequestWithBody generates requests for UserUpdatePassword with any type of body
+func NewUserUpdatePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserCreateRequest calls the generic UserCreate builder with application/json body
+func NewUserCreateRequest(server string, body UserCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUserCreateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUserCreateRequestWithBody generates requests for UserCreate with any type of body
+func NewUserCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUserUpdateSlackOauthCallbackRequest generates requests for UserUpdateSlackOauthCallback
+func NewUserUpdateSlackOauthCallbackRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/slack/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWebhookDeleteRequest generates requests for WebhookDelete
+func NewWebhookDeleteRequest(server string, webhook openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook", runtime.ParamLocationPath, webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhook-workers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWebhookRequestsListRequest generates requests for WebhookRequestsList
+func NewWebhookRequestsListRequest(server string, webhook openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook", runtime.ParamLocationPath, webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/webhook-workers/%s/requests", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkerGetRequest generates requests for WorkerGet
+func NewWorkerGetRequest(server string, worker openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker", runtime.ParamLocationPath, worker)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkerUpdateRequest calls the generic WorkerUpdate builder with application/json body
+func NewWorkerUpdateRequest(server string, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWorkerUpdateRequestWithBody(server, worker, "application/json", bodyReader)
+}
+
+// NewWorkerUpdateRequestWithBody generates requests for WorkerUpdate with any type of body
+func NewWorkerUpdateRequestWithBody(server string, worker openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker", runtime.ParamLocationPath, worker)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWorkflowDeleteRequest generates requests for WorkflowDelete
+func NewWorkflowDeleteRequest(server string, workflow openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowGetRequest generates requests for WorkflowGet
+func NewWorkflowGetRequest(server string, workflow openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowUpdateRequest calls the generic WorkflowUpdate builder with application/json body
+func NewWorkflowUpdateRequest(server string, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWorkflowUpdateRequestWithBody(server, workflow, "application/json", bodyReader)
+}
+
+// NewWorkflowUpdateRequestWithBody generates requests for WorkflowUpdate with any type of body
+func NewWorkflowUpdateRequestWithBody(server string, workflow openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWorkflowListConcurrencyGroupsRequest generates requests for WorkflowListConcurrencyGroups
+func NewWorkflowListConcurrencyGroupsRequest(server string, workflow openapi_types.UUID, params *WorkflowListConcurrencyGroupsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s/concurrency-groups", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowGetMetricsRequest generates requests for WorkflowGetMetrics
+func NewWorkflowGetMetricsRequest(server string, workflow openapi_types.UUID, params *WorkflowGetMetricsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s/metrics", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.GroupKey != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "groupKey", runtime.ParamLocationQuery, *params.GroupKey); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowDeleteRolloutRequest generates requests for WorkflowDeleteRollout
+func NewWorkflowDeleteRolloutRequest(server string, workflow openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s/rollout", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowGetRolloutRequest generates requests for WorkflowGetRollout
+func NewWorkflowGetRolloutRequest(server string, workflow openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s/rollout", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkflowUpdateRolloutRequest calls the generic WorkflowUpdateRollout builder with application/json body
+func NewWorkflowUpdateRolloutRequest(server string, workflow openapi_types.UUID, body WorkflowUpdateRolloutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWorkflowUpdateRolloutRequestWithBody(server, workflow, "application/json", bodyReader)
+}
+
+// NewWorkflowUpdateRolloutRequestWithBody generates requests for WorkflowUpdateRollout with any type of body
+func NewWorkflowUpdateRolloutRequestWithBody(server string, workflow openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s/rollout", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWorkflowRunCreateRequest calls the generic WorkflowRunCreate builder with application/json body
+func NewWorkflowRunCreateRequest(server string, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWorkflowRunCreateRequestWithBody(server, workflow, params, "application/json", bodyReader)
+}
+
+// NewWorkflowRunCreateRequestWithBody generates requests for WorkflowRunCreate with any type of body
+func NewWorkflowRunCreateRequestWithBody(server string, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s/trigger", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Version != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWorkflowVersionGetRequest generates requests for WorkflowVersionGet
+func NewWorkflowVersionGetRequest(server string, workflow openapi_types.UUID, params *WorkflowVersionGetParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workflow", runtime.ParamLocationPath, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/workflows/%s/versions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Version != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// HealthzGetWithResponse request
+	HealthzGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthzGetResponse, error)
+
+	// LivenessGetWithResponse request
+	LivenessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LivenessGetResponse, error)
+
+	// ReadinessGetWithResponse request
+	ReadinessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadinessGetResponse, error)
+
+	// ReadyzGetWithResponse request
+	ReadyzGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadyzGetResponse, error)
+
+	// AlertEmailGroupDeleteWithResponse request
+	AlertEmailGroupDeleteWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupDeleteResponse, error)
+
+	// AlertEmailGroupUpdateWithBodyWithResponse request with any body
+	AlertEmailGroupUpdateWithBodyWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error)
+
+	AlertEmailGroupUpdateWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, body AlertEmailGroupUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error)
+
+	// ApiTokenUpdateRevokeWithResponse request
+	ApiTokenUpdateRevokeWithResponse(ctx context.Context, apiToken openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenUpdateRevokeResponse, error)
+
+	// ApiTokenUpdateRotateWithBodyWithResponse request with any body
+	ApiTokenUpdateRotateWithBodyWithResponse(ctx context.Context, apiToken openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiTokenUpdateRotateResponse, error)
+
+	ApiTokenUpdateRotateWithResponse(ctx context.Context, apiToken openapi_types.UUID, body ApiTokenUpdateRotateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiTokenUpdateRotateResponse, error)
+
+	// ApprovalUpdateDecideWithTokenWithBodyWithResponse request with any body
+	ApprovalUpdateDecideWithTokenWithBodyWithResponse(ctx context.Context, approvalToken string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApprovalUpdateDecideWithTokenResponse, error)
+
+	ApprovalUpdateDecideWithTokenWithResponse(ctx context.Context, approvalToken string, body ApprovalUpdateDecideWithTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*ApprovalUpdateDecideWithTokenResponse, error)
+
+	// ApprovalGetWithResponse request
+	ApprovalGetWithResponse(ctx context.Context, approval openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApprovalGetResponse, error)
+
+	// ApprovalUpdateDecideWithBodyWithResponse request with any body
+	ApprovalUpdateDecideWithBodyWithResponse(ctx context.Context, approval openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApprovalUpdateDecideResponse, error)
+
+	ApprovalUpdateDecideWithResponse(ctx context.Context, approval openapi_types.UUID, body ApprovalUpdateDecideJSONRequestBody, reqEditors ...RequestEditorFn) (*ApprovalUpdateDecideResponse, error)
+
+	// ClientCaDeleteWithResponse request
+	ClientCaDeleteWithResponse(ctx context.Context, clientCa openapi_types.UUID, reqEditors ...RequestEditorFn) (*ClientCaDeleteResponse, error)
+
+	// CloudMetadataGetWithResponse request
+	CloudMetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CloudMetadataGetResponse, error)
+
+	// EventDedupRuleDeleteWithResponse request
+	EventDedupRuleDeleteWithResponse(ctx context.Context, eventDedupRule openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventDedupRuleDeleteResponse, error)
+
+	// EventRoutingRuleDeleteWithResponse request
+	EventRoutingRuleDeleteWithResponse(ctx context.Context, eventRoutingRule openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventRoutingRuleDeleteResponse, error)
+
+	// EventSinkDeleteWithResponse request
+	EventSinkDeleteWithResponse(ctx context.Context, eventSink openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventSinkDeleteResponse, error)
+
+	// EventSinkReplayWithResponse request
+	EventSinkReplayWithResponse(ctx context.Context, eventSink openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventSinkReplayResponse, error)
+
+	// EventGetWithResponse request
+	EventGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventGetResponse, error)
+
+	// EventDataGetWithResponse request
+	EventDataGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventDataGetResponse, error)
+
+	// InboundWebhookDeleteWithResponse request
+	InboundWebhookDeleteWithResponse(ctx context.Context, inboundWebhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*InboundWebhookDeleteResponse, error)
+
+	// InboundWebhookReceiveWithResponse request
+	InboundWebhookReceiveWithResponse(ctx context.Context, inboundWebhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*InboundWebhookReceiveResponse, error)
+
+	// MetadataGetWithResponse request
+	MetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataGetResponse, error)
+
+	// MetadataListIntegrationsWithResponse request
+	MetadataListIntegrationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataListIntegrationsResponse, error)
+
+	// SlackWebhookDeleteWithResponse request
+	SlackWebhookDeleteWithResponse(ctx context.Context, slack openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookDeleteResponse, error)
+
+	// SnsDeleteWithResponse request
+	SnsDeleteWithResponse(ctx context.Context, sns openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsDeleteResponse, error)
+
+	// SnsUpdateWithResponse request
+	SnsUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, event string, reqEditors ...RequestEditorFn) (*SnsUpdateResponse, error)
+
+	// SqsDeleteWithResponse request
+	SqsDeleteWithResponse(ctx context.Context, sqs openapi_types.UUID, reqEditors ...RequestEditorFn) (*SqsDeleteResponse, error)
+
+	// StepRunListArchivesWithResponse request
+	StepRunListArchivesWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListArchivesParams, reqEditors ...RequestEditorFn) (*StepRunListArchivesResponse, error)
+
+	// StepRunListEventsWithResponse request
+	StepRunListEventsWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListEventsParams, reqEditors ...RequestEditorFn) (*StepRunListEventsResponse, error)
+
+	// LogLineListWithResponse request
+	LogLineListWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *LogLineListParams, reqEditors ...RequestEditorFn) (*LogLineListResponse, error)
+
+	// LogLineStreamWithResponse request
+	LogLineStreamWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *LogLineStreamParams, reqEditors ...RequestEditorFn) (*LogLineStreamResponse, error)
+
+	// StepRunListSchedulingDecisionsWithResponse request
+	StepRunListSchedulingDecisionsWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListSchedulingDecisionsParams, reqEditors ...RequestEditorFn) (*StepRunListSchedulingDecisionsResponse, error)
+
+	// TenantCreateWithBodyWithResponse request with any body
+	TenantCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error)
+
+	TenantCreateWithResponse(ctx context.Context, body TenantCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error)
+
+	// TenantUpdateWithBodyWithResponse request with any body
+	TenantUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error)
+
+	TenantUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error)
+
+	// AlertEmailGroupListWithResponse request
+	AlertEmailGroupListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupListResponse, error)
+
+	// AlertEmailGroupCreateWithBodyWithResponse request with any body
+	AlertEmailGroupCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error)
+
+	AlertEmailGroupCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error)
+
+	// TenantAlertingSettingsGetWithResponse request
+	TenantAlertingSettingsGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantAlertingSettingsGetResponse, error)
+
+	// ApiTokenListWithResponse request
+	ApiTokenListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenListResponse, error)
+
+	// ApiTokenCreateWithBodyWithResponse request with any body
+	ApiTokenCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error)
+
+	ApiTokenCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error)
+
+	// ApprovalListWithResponse request
+	ApprovalListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *ApprovalListParams, reqEditors ...RequestEditorFn) (*ApprovalListResponse, error)
+
+	// AuditLogListWithResponse request
+	AuditLogListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *AuditLogListParams, reqEditors ...RequestEditorFn) (*AuditLogListResponse, error)
+
+	// ClientCaListWithResponse request
+	ClientCaListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*ClientCaListResponse, error)
+
+	// ClientCaCreateWithBodyWithResponse request with any body
+	ClientCaCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ClientCaCreateResponse, error)
+
+	ClientCaCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body ClientCaCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ClientCaCreateResponse, error)
+
+	// CronCalendarListWithResponse request
+	CronCalendarListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*CronCalendarListResponse, error)
+
+	// CronCalendarCreateWithBodyWithResponse request with any body
+	CronCalendarCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CronCalendarCreateResponse, error)
+
+	CronCalendarCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body CronCalendarCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CronCalendarCreateResponse, error)
+
+	// CronCalendarDeleteWithResponse request
+	CronCalendarDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, cronCalendar openapi_types.UUID, reqEditors ...RequestEditorFn) (*CronCalendarDeleteResponse, error)
+
+	// DeadLetterQueueListWithResponse request
+	DeadLetterQueueListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *DeadLetterQueueListParams, reqEditors ...RequestEditorFn) (*DeadLetterQueueListResponse, error)
+
+	// DeadLetterQueueDeleteWithBodyWithResponse request with any body
+	DeadLetterQueueDeleteWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeadLetterQueueDeleteResponse, error)
+
+	DeadLetterQueueDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, body DeadLetterQueueDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*DeadLetterQueueDeleteResponse, error)
+
+	// DeadLetterQueueUpdateReplayWithBodyWithResponse request with any body
+	DeadLetterQueueUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeadLetterQueueUpdateReplayResponse, error)
+
+	DeadLetterQueueUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body DeadLetterQueueUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*DeadLetterQueueUpdateReplayResponse, error)
+
+	// EventDedupRuleListWithResponse request
+	EventDedupRuleListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventDedupRuleListResponse, error)
+
+	// EventDedupRuleUpsertWithBodyWithResponse request with any body
+	EventDedupRuleUpsertWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventDedupRuleUpsertResponse, error)
+
+	EventDedupRuleUpsertWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventDedupRuleUpsertJSONRequestBody, reqEditors ...RequestEditorFn) (*EventDedupRuleUpsertResponse, error)
+
+	// EventRoutingRuleListWithResponse request
+	EventRoutingRuleListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventRoutingRuleListResponse, error)
+
+	// EventRoutingRuleUpsertWithBodyWithResponse request with any body
+	EventRoutingRuleUpsertWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventRoutingRuleUpsertResponse, error)
+
+	EventRoutingRuleUpsertWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventRoutingRuleUpsertJSONRequestBody, reqEditors ...RequestEditorFn) (*EventRoutingRuleUpsertResponse, error)
+
+	// EventSinkListWithResponse request
+	EventSinkListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventSinkListResponse, error)
+
+	// EventSinkCreateWithBodyWithResponse request with any body
+	EventSinkCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventSinkCreateResponse, error)
+
+	EventSinkCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventSinkCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*EventSinkCreateResponse, error)
+
+	// EventListWithResponse request
+	EventListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *EventListParams, reqEditors ...RequestEditorFn) (*EventListResponse, error)
+
+	// EventCreateWithBodyWithResponse request with any body
+	EventCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateResponse, error)
+
+	EventCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateResponse, error)
+
+	// EventCreateBatchWithBodyWithResponse request with any body
+	EventCreateBatchWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateBatchResponse, error)
+
+	EventCreateBatchWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateBatchResponse, error)
+
+	// EventCreateBulkWithBodyWithResponse request with any body
+	EventCreateBulkWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error)
+
+	EventCreateBulkWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error)
+
+	// EventUpdateCancelWithBodyWithResponse request with any body
+	EventUpdateCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error)
+
+	EventUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error)
+
+	// EventCreateCloudEventWithResponse request
+	EventCreateCloudEventWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventCreateCloudEventResponse, error)
+
+	// EventKeyListWithResponse request
+	EventKeyListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventKeyListResponse, error)
+
+	// EventUpdateReplayWithBodyWithResponse request with any body
+	EventUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error)
+
+	EventUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error)
+
+	// EventUpdateReplayRangeWithBodyWithResponse request with any body
+	EventUpdateReplayRangeWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateReplayRangeResponse, error)
+
+	EventUpdateReplayRangeWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayRangeJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateReplayRangeResponse, error)
+
+	// InboundWebhookListWithResponse request
+	InboundWebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*InboundWebhookListResponse, error)
+
+	// InboundWebhookCreateWithBodyWithResponse request with any body
+	InboundWebhookCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InboundWebhookCreateResponse, error)
+
+	InboundWebhookCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body InboundWebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*InboundWebhookCreateResponse, error)
+
+	// TenantInviteListWithResponse request
+	TenantInviteListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteListResponse, error)
+
+	// TenantInviteCreateWithBodyWithResponse request with any body
+	TenantInviteCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error)
+
+	TenantInviteCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error)
+
+	// TenantInviteDeleteWithResponse request
+	TenantInviteDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteDeleteResponse, error)
+
+	// TenantInviteUpdateWithBodyWithResponse request with any body
+	TenantInviteUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error)
+
+	TenantInviteUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error)
+
+	// TenantMemberListWithResponse request
+	TenantMemberListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberListResponse, error)
+
+	// TenantMemberDeleteWithResponse request
+	TenantMemberDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberDeleteResponse, error)
+
+	// TenantGetQueueMetricsWithResponse request
+	TenantGetQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*TenantGetQueueMetricsResponse, error)
+
+	// QueueUpdatePauseWithResponse request
+	QueueUpdatePauseWithResponse(ctx context.Context, tenant openapi_types.UUID, queue string, reqEditors ...RequestEditorFn) (*QueueUpdatePauseResponse, error)
+
+	// QueueUpdateResumeWithResponse request
+	QueueUpdateResumeWithResponse(ctx context.Context, tenant openapi_types.UUID, queue string, reqEditors ...RequestEditorFn) (*QueueUpdateResumeResponse, error)
+
+	// TenantQuotaListWithResponse request
+	TenantQuotaListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantQuotaListResponse, error)
+
+	// RateLimitListWithResponse request
+	RateLimitListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*RateLimitListResponse, error)
+
+	// TenantResourcePolicyGetWithResponse request
+	TenantResourcePolicyGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantResourcePolicyGetResponse, error)
+
+	// SlackWebhookListWithResponse request
+	SlackWebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookListResponse, error)
+
+	// UserUpdateSlackOauthStartWithResponse request
+	UserUpdateSlackOauthStartWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthStartResponse, error)
+
+	// SnsListWithResponse request
+	SnsListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsListResponse, error)
+
+	// SnsCreateWithBodyWithResponse request with any body
+	SnsCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error)
+
+	SnsCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body SnsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error)
+
+	// SqsListWithResponse request
+	SqsListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SqsListResponse, error)
+
+	// SqsCreateWithBodyWithResponse request with any body
+	SqsCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SqsCreateResponse, error)
+
+	SqsCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body SqsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*SqsCreateResponse, error)
+
+	// TenantGetStepRunQueueMetricsWithResponse request
+	TenantGetStepRunQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantGetStepRunQueueMetricsResponse, error)
+
+	// StepRunGetWithResponse request
+	StepRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetResponse, error)
+
+	// StepRunUpdateCancelWithResponse request
+	StepRunUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunUpdateCancelResponse, error)
+
+	// StepRunUpdateRerunWithBodyWithResponse request with any body
+	StepRunUpdateRerunWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error)
+
+	StepRunUpdateRerunWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error)
+
+	// StepRunGetSchemaWithResponse request
+	StepRunGetSchemaWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetSchemaResponse, error)
+
+	// WebhookListWithResponse request
+	WebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookListResponse, error)
+
+	// WebhookCreateWithBodyWithResponse request with any body
+	WebhookCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error)
+
+	WebhookCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error)
+
+	// WorkerListWithResponse request
+	WorkerListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerListResponse, error)
+
+	// WorkerPoolListWithResponse request
+	WorkerPoolListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerPoolListResponse, error)
+
+	// WorkflowRunUpdateReplayWithBodyWithResponse request with any body
+	WorkflowRunUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error)
+
+	WorkflowRunUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error)
+
+	// WorkflowRunGetWithResponse request
+	WorkflowRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetResponse, error)
+
+	// WorkflowRunGetInputWithResponse request
+	WorkflowRunGetInputWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetInputResponse, error)
+
+	// WorkflowRunUpdatePauseWithResponse request
+	WorkflowRunUpdatePauseWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunUpdatePauseResponse, error)
+
+	// WorkflowRunUpdateReplayFromStepWithBodyWithResponse request with any body
+	WorkflowRunUpdateReplayFromStepWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayFromStepResponse, error)
+
+	WorkflowRunUpdateReplayFromStepWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, body WorkflowRunUpdateReplayFromStepJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayFromStepResponse, error)
+
+	// WorkflowRunUpdateResumeWithResponse request
+	WorkflowRunUpdateResumeWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateResumeResponse, error)
+
+	// WorkflowRunGetShapeWithResponse request
+	WorkflowRunGetShapeWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetShapeResponse, error)
+
+	// WorkflowRunListStepRunEventsWithResponse request
+	WorkflowRunListStepRunEventsWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams, reqEditors ...RequestEditorFn) (*WorkflowRunListStepRunEventsResponse, error)
+
+	// WorkflowRunGetStreamWithResponse request
+	WorkflowRunGetStreamWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetStreamResponse, error)
+
+	// WorkflowListWithResponse request
+	WorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowListResponse, error)
+
+	// WorkflowRunCancelWithBodyWithResponse request with any body
+	WorkflowRunCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error)
+
+	WorkflowRunCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error)
+
+	// CronWorkflowListWithResponse request
+	CronWorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *CronWorkflowListParams, reqEditors ...RequestEditorFn) (*CronWorkflowListResponse, error)
+
+	// WorkflowCronDeleteWithResponse request
+	WorkflowCronDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronDeleteResponse, error)
+
+	// WorkflowCronGetWithResponse request
+	WorkflowCronGetWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronGetResponse, error)
+
+	// WorkflowRunListWithResponse request
+	WorkflowRunListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunListParams, reqEditors ...RequestEditorFn) (*WorkflowRunListResponse, error)
+
+	// WorkflowRunGetMetricsWithResponse request
+	WorkflowRunGetMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowRunGetMetricsResponse, error)
+
+	// WorkflowScheduledListWithResponse request
+	WorkflowScheduledListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowScheduledListParams, reqEditors ...RequestEditorFn) (*WorkflowScheduledListResponse, error)
+
+	// WorkflowScheduledDeleteWithResponse request
+	WorkflowScheduledDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledDeleteResponse, error)
+
+	// WorkflowScheduledGetWithResponse request
+	WorkflowScheduledGetWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledGetResponse, error)
+
+	// WorkflowScheduledUpdateWithBodyWithResponse request with any body
+	WorkflowScheduledUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowScheduledUpdateResponse, error)
+
+	WorkflowScheduledUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, body WorkflowScheduledUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowScheduledUpdateResponse, error)
+
+	// CronWorkflowTriggerCreateWithBodyWithResponse request with any body
+	CronWorkflowTriggerCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error)
+
+	CronWorkflowTriggerCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error)
+
+	// ScheduledWorkflowRunCreateWithBodyWithResponse request with any body
+	ScheduledWorkflowRunCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error)
+
+	ScheduledWorkflowRunCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error)
+
+	// WorkflowGetWorkersCountWithResponse request
+	WorkflowGetWorkersCountWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetWorkersCountResponse, error)
+
+	// UserGetCurrentWithResponse request
+	UserGetCurrentWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetCurrentResponse, error)
+
+	// UserUpdateGithubOauthCallbackWithResponse request
+	UserUpdateGithubOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthCallbackResponse, error)
+
+	// UserUpdateGithubOauthStartWithResponse request
+	UserUpdateGithubOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthStartResponse, error)
+
+	// UserUpdateGoogleOauthCallbackWithResponse request
+	UserUpdateGoogleOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthCallbackResponse, error)
+
+	// UserUpdateGoogleOauthStartWithResponse request
+	UserUpdateGoogleOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthStartResponse, error)
+
+	// UserListTenantInvitesWithResponse request
+	UserListTenantInvitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListTenantInvitesResponse, error)
+
+	// TenantInviteAcceptWithBodyWithResponse request with any body
+	TenantInviteAcceptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error)
+
+	TenantInviteAcceptWithResponse(ctx context.Context, body TenantInviteAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error)
+
+	// TenantInviteRejectWithBodyWithResponse request with any body
+	TenantInviteRejectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error)
+
+	TenantInviteRejectWithResponse(ctx context.Context, body TenantInviteRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error)
+
+	// UserUpdateLoginWithBodyWithResponse request with any body
+	UserUpdateLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error)
+
+	UserUpdateLoginWithResponse(ctx context.Context, body UserUpdateLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error)
+
+	// UserUpdateLogoutWithResponse request
+	UserUpdateLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateLogoutResponse, error)
+
+	// TenantMembershipsListWithResponse request
+	TenantMembershipsListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TenantMembershipsListResponse, error)
+
+	// UserUpdateOidcCallbackWithResponse request
+	UserUpdateOidcCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateOidcCallbackResponse, error)
+
+	// UserUpdateOidcStartWithResponse request
+	UserUpdateOidcStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateOidcStartResponse, error)
+
+	// UserUpdatePasswordWithBodyWithResponse request with any body
+	UserUpdatePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error)
+
+	UserUpdatePasswordWithResponse(ctx context.Context, body UserUpdatePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error)
+
+	// UserCreateWithBodyWithResponse request with any body
+	UserCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateResponse, error)
+
+	UserCreateWithResponse(ctx context.Context, body UserCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateResponse, error)
+
+	// UserUpdateSlackOauthCallbackWithResponse request
+	UserUpdateSlackOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthCallbackResponse, error)
+
+	// WebhookDeleteWithResponse request
+	WebhookDeleteWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookDeleteResponse, error)
+
+	// WebhookRequestsListWithResponse request
+	WebhookRequestsListWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookRequestsListResponse, error)
+
+	// WorkerGetWithResponse request
+	WorkerGetWithResponse(ctx context.Context, worker openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerGetResponse, error)
+
+	// WorkerUpdateWithBodyWithResponse request with any body
+	WorkerUpdateWithBodyWithResponse(ctx context.Context, worker openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error)
+
+	WorkerUpdateWithResponse(ctx context.Context, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error)
+
+	// WorkflowDeleteWithResponse request
+	WorkflowDeleteWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowDeleteResponse, error)
+
+	// WorkflowGetWithResponse request
+	WorkflowGetWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetResponse, error)
+
+	// WorkflowUpdateWithBodyWithResponse request with any body
+	WorkflowUpdateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error)
+
+	WorkflowUpdateWithResponse(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error)
+
+	// WorkflowListConcurrencyGroupsWithResponse request
+	WorkflowListConcurrencyGroupsWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowListConcurrencyGroupsParams, reqEditors ...RequestEditorFn) (*WorkflowListConcurrencyGroupsResponse, error)
+
+	// WorkflowGetMetricsWithResponse request
+	WorkflowGetMetricsWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowGetMetricsResponse, error)
+
+	// WorkflowDeleteRolloutWithResponse request
+	WorkflowDeleteRolloutWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowDeleteRolloutResponse, error)
+
+	// WorkflowGetRolloutWithResponse request
+	WorkflowGetRolloutWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetRolloutResponse, error)
+
+	// WorkflowUpdateRolloutWithBodyWithResponse request with any body
+	WorkflowUpdateRolloutWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowUpdateRolloutResponse, error)
+
+	WorkflowUpdateRolloutWithResponse(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateRolloutJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowUpdateRolloutResponse, error)
+
+	// WorkflowRunCreateWithBodyWithResponse request with any body
+	WorkflowRunCreateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error)
+
+	WorkflowRunCreateWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error)
+
+	// WorkflowVersionGetWithResponse request
+	WorkflowVersionGetWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionGetParams, reqEditors ...RequestEditorFn) (*WorkflowVersionGetResponse, error)
+}
+
+type HealthzGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthReport
+	JSON503      *HealthReport
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthzGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthzGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LivenessGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r LivenessGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LivenessGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReadinessGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r ReadinessGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReadinessGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReadyzGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthReport
+	JSON503      *HealthReport
+}
+
+// Status returns HTTPResponse.Status
+func (r ReadyzGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReadyzGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AlertEmailGroupDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertEmailGroupDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertEmailGroupDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AlertEmailGroupUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantAlertEmailGroup
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertEmailGroupUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertEmailGroupUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiTokenUpdateRevokeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiTokenUpdateRevokeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiTokenUpdateRevokeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiTokenUpdateRotateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CreateAPITokenResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiTokenUpdateRotateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiTokenUpdateRotateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApprovalUpdateDecideWithTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r ApprovalUpdateDecideWithTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApprovalUpdateDecideWithTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApprovalGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StepRunApproval
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r ApprovalGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApprovalGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApprovalUpdateDecideResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StepRunApproval
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r ApprovalUpdateDecideResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApprovalUpdateDecideResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ClientCaDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r ClientCaDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ClientCaDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CloudMetadataGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *APIErrors
+	JSON400      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r CloudMetadataGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CloudMetadataGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventDedupRuleDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventDedupRuleDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventDedupRuleDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventRoutingRuleDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventRoutingRuleDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventRoutingRuleDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventSinkDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventSinkDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventSinkDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventSinkReplayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventSink
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventSinkReplayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventSinkReplayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Event
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventDataGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventData
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventDataGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventDataGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InboundWebhookDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r InboundWebhookDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InboundWebhookDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InboundWebhookReceiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r InboundWebhookReceiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InboundWebhookReceiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *APIMeta
+	JSON400      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetadataListIntegrationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListAPIMetaIntegration
+	JSON400      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r MetadataListIntegrationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetadataListIntegrationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SlackWebhookDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r SlackWebhookDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SlackWebhookDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SnsDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r SnsDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SnsDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SnsUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r SnsUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SnsUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SqsDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r SqsDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SqsDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StepRunListArchivesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StepRunArchiveList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r StepRunListArchivesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StepRunListArchivesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StepRunListEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StepRunEventList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r StepRunListEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StepRunListEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogLineListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LogLineList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r LogLineListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogLineListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogLineStreamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r LogLineStreamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogLineStreamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StepRunListSchedulingDecisionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SchedulingDecisionList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r StepRunListSchedulingDecisionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StepRunListSchedulingDecisionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Tenant
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Tenant
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AlertEmailGroupListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantAlertEmailGroupList
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertEmailGroupListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertEmailGroupListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AlertEmailGroupCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TenantAlertEmailGroup
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertEmailGroupCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertEmailGroupCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantAlertingSettingsGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantAlertingSettings
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantAlertingSettingsGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantAlertingSettingsGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiTokenListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListAPITokensResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiTokenListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiTokenListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiTokenCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CreateAPITokenResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiTokenCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiTokenCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApprovalListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StepRunApprovalList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r ApprovalListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApprovalListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuditLogListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditLogList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r AuditLogListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuditLogListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ClientCaListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClientCAList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r ClientCaListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ClientCaListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ClientCaCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClientCA
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r ClientCaCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ClientCaCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CronCalendarListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CronExclusionCalendarList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r CronCalendarListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CronCalendarListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CronCalendarCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CronExclusionCalendar
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r CronCalendarCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CronCalendarCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CronCalendarDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r CronCalendarDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CronCalendarDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeadLetterQueueListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeadLetterQueueItemList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r DeadLetterQueueListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeadLetterQueueListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeadLetterQueueDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PurgeDeadLetterQueueItemsResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r DeadLetterQueueDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeadLetterQueueDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeadLetterQueueUpdateReplayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReplayDeadLetterQueueItemsResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r DeadLetterQueueUpdateReplayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeadLetterQueueUpdateReplayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventDedupRuleListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventDedupRuleList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventDedupRuleListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventDedupRuleListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventDedupRuleUpsertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventDedupRule
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventDedupRuleUpsertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventDedupRuleUpsertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventRoutingRuleListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventRoutingRuleList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventRoutingRuleListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventRoutingRuleListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventRoutingRuleUpsertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventRoutingRule
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventRoutingRuleUpsertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventRoutingRuleUpsertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventSinkListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventSinkList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventSinkListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventSinkListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventSinkCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventSink
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventSinkCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventSinkCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Event
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON429      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventCreateBatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BatchCreateEventResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON429      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventCreateBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventCreateBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventCreateBulkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BulkCreateEventResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON429      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventCreateBulkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventCreateBulkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventUpdateCancelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+	}
+	JSON400 *APIErrors
+	JSON403 *APIErrors
+	JSON429 *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventUpdateCancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventUpdateCancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventCreateCloudEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Event
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON429      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventCreateCloudEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventCreateCloudEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventKeyListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventKeyList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventKeyListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventKeyListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventUpdateReplayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EventList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON429      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventUpdateReplayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventUpdateReplayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EventUpdateReplayRangeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReplayEventRangeResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON429      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r EventUpdateReplayRangeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EventUpdateReplayRangeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InboundWebhookListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InboundWebhookList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r InboundWebhookListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InboundWebhookListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InboundWebhookCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InboundWebhook
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r InboundWebhookCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InboundWebhookCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantInviteListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantInviteList
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantInviteListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantInviteListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantInviteCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TenantInvite
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantInviteCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantInviteCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantInviteDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantInvite
+	JSON400      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantInviteDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantInviteDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantInviteUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantInvite
+	JSON400      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantInviteUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantInviteUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantMemberListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantMemberList
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantMemberListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantMemberListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantMemberDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON204      *TenantMember
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantMemberDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantMemberDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantGetQueueMetricsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantQueueMetrics
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantGetQueueMetricsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantGetQueueMetricsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueueUpdatePauseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Queue
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r QueueUpdatePauseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueueUpdatePauseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueueUpdateResumeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Queue
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r QueueUpdateResumeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueueUpdateResumeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantQuotaListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantQuotaUsageList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantQuotaListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantQuotaListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RateLimitListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RateLimitList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r RateLimitListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RateLimitListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantResourcePolicyGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantResourcePolicy
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantResourcePolicyGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantResourcePolicyGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SlackWebhookListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListSlackWebhooks
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r SlackWebhookListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SlackWebhookListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserUpdateSlackOauthStartResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UserUpdateSlackOauthStartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserUpdateSlackOauthStartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SnsListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListSNSIntegrations
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r SnsListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SnsListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SnsCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SNSIntegration
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r SnsCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SnsCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SqsListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListSQSIntegrations
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r SqsListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SqsListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SqsCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SQSIntegration
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r SqsCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SqsCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TenantGetStepRunQueueMetricsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenantStepRunQueueMetrics
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r TenantGetStepRunQueueMetricsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TenantGetStepRunQueueMetricsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StepRunGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StepRun
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r StepRunGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StepRunGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StepRunUpdateCancelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StepRun
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r StepRunUpdateCancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StepRunUpdateCancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StepRunUpdateRerunResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StepRun
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r StepRunUpdateRerunResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StepRunUpdateRerunResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StepRunGetSchemaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r StepRunGetSchemaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StepRunGetSchemaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WebhookListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookWorkerListResponse
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r WebhookListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WebhookListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WebhookCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookWorkerCreated
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r WebhookCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WebhookCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WorkerListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkerList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r WorkerListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkerListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WorkerPoolListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkerPoolList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r WorkerPoolListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkerPoolListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WorkflowRunUpdateReplayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReplayWorkflowRunsResponse
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON429      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r WorkflowRunUpdateReplayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkflowRunUpdateReplayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WorkflowRunGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkflowRun
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r WorkflowRunGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkflowRunGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WorkflowRunGetInputResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r WorkflowRunGetInputResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkflowRunGetInputResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WorkflowRunUpdatePauseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkflowRun
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r WorkflowRunUpdatePauseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkflowRunUpdatePauseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WorkflowRunUpdateReplayFromStepResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkflowRun
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+	JSON429      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r WorkflowRunUpdateReplayFromStepResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkflowRunUpdateReplayFromStepResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WorkflowRunUpdateResumeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkflowRun
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r WorkflowRunUpdateResumeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkflowRunUpdateResumeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WorkflowRunGetShapeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkflowRunShape
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r WorkflowRunGetShapeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkflowRunGetShapeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WorkflowRunListStepRunEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StepRunEventList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r WorkflowRunListStepRunEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkflowRunListStepRunEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WorkflowRunGetStreamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r WorkflowRunGetStreamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkflowRunGetStreamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WorkflowListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkflowList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r WorkflowListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkflowListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WorkflowRunCancelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+	}
+	JSON400 *APIErrors
+	JSON403 *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r WorkflowRunCancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkflowRunCancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CronWorkflowListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CronWorkflowsList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r CronWorkflowListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CronWorkflowListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WorkflowCronDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r WorkflowCronDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkflowCronDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WorkflowCronGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CronWorkflows
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r WorkflowCronGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkflowCronGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WorkflowRunListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkflowRunList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r WorkflowRunListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkflowRunListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WorkflowRunGetMetricsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkflowRunsMetrics
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r WorkflowRunGetMetricsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkflowRunGetMetricsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WorkflowScheduledListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScheduledWorkflowsList
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r WorkflowScheduledListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkflowScheduledListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WorkflowScheduledDeleteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EventData
+	JSON400      *APIErrors
+	JSON403      *APIError
+}
+
+// Status returns HTTPResponse.Status
+func (r WorkflowScheduledDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WorkflowScheduledDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WorkflowScheduledGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScheduledWorkflows
 	JSON400      *APIErrors
 	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r EventDataGetResponse) Status() string {
+func (r WorkflowScheduledGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8810,22 +16178,24 @@ func (r EventDataGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventDataGetResponse) StatusCode() int {
+func (r WorkflowScheduledGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type MetadataGetResponse struct {
+type WorkflowScheduledUpdateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *APIMeta
+	JSON200      *ScheduledWorkflows
 	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r MetadataGetResponse) Status() string {
+func (r WorkflowScheduledUpdateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8833,22 +16203,25 @@ func (r MetadataGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r MetadataGetResponse) StatusCode() int {
+func (r WorkflowScheduledUpdateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type MetadataListIntegrationsResponse struct {
+type CronWorkflowTriggerCreateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ListAPIMetaIntegration
+	JSON200      *CronWorkflows
 	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+	JSON429      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r MetadataListIntegrationsResponse) Status() string {
+func (r CronWorkflowTriggerCreateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8856,23 +16229,25 @@ func (r MetadataListIntegrationsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r MetadataListIntegrationsResponse) StatusCode() int {
+func (r CronWorkflowTriggerCreateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SlackWebhookDeleteResponse struct {
+type ScheduledWorkflowRunCreateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *ScheduledWorkflows
 	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+	JSON429      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r SlackWebhookDeleteResponse) Status() string {
+func (r ScheduledWorkflowRunCreateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8880,23 +16255,23 @@ func (r SlackWebhookDeleteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SlackWebhookDeleteResponse) StatusCode() int {
+func (r ScheduledWorkflowRunCreateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SnsDeleteResponse struct {
+type WorkflowGetWorkersCountResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *WorkflowWorkersCount
 	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r SnsDeleteResponse) Status() string {
+func (r WorkflowGetWorkersCountResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8904,23 +16279,24 @@ func (r SnsDeleteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SnsDeleteResponse) StatusCode() int {
+func (r WorkflowGetWorkersCountResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SnsUpdateResponse struct {
+type UserGetCurrentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *User
 	JSON400      *APIErrors
 	JSON401      *APIErrors
 	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r SnsUpdateResponse) Status() string {
+func (r UserGetCurrentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8928,24 +16304,20 @@ func (r SnsUpdateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SnsUpdateResponse) StatusCode() int {
+func (r UserGetCurrentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type StepRunListArchivesResponse struct {
+type UserUpdateGithubOauthCallbackResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *StepRunArchiveList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r StepRunListArchivesResponse) Status() string {
+func (r UserUpdateGithubOauthCallbackResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8953,24 +16325,20 @@ func (r StepRunListArchivesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r StepRunListArchivesResponse) StatusCode() int {
+func (r UserUpdateGithubOauthCallbackResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type StepRunListEventsResponse struct {
+type UserUpdateGithubOauthStartResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *StepRunEventList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r StepRunListEventsResponse) Status() string {
+func (r UserUpdateGithubOauthStartResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8978,23 +16346,20 @@ func (r StepRunListEventsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r StepRunListEventsResponse) StatusCode() int {
+func (r UserUpdateGithubOauthStartResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type LogLineListResponse struct {
+type UserUpdateGoogleOauthCallbackResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *LogLineList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r LogLineListResponse) Status() string {
+func (r UserUpdateGoogleOauthCallbackResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9002,23 +16367,20 @@ func (r LogLineListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r LogLineListResponse) StatusCode() int {
+func (r UserUpdateGoogleOauthCallbackResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantCreateResponse struct {
+type UserUpdateGoogleOauthStartResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Tenant
-	JSON400      *APIErrors
-	JSON403      *APIError
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantCreateResponse) Status() string {
+func (r UserUpdateGoogleOauthStartResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9026,23 +16388,23 @@ func (r TenantCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantCreateResponse) StatusCode() int {
+func (r UserUpdateGoogleOauthStartResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantUpdateResponse struct {
+type UserListTenantInvitesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Tenant
+	JSON200      *TenantInviteList
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantUpdateResponse) Status() string {
+func (r UserListTenantInvitesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9050,23 +16412,22 @@ func (r TenantUpdateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantUpdateResponse) StatusCode() int {
+func (r UserListTenantInvitesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AlertEmailGroupListResponse struct {
+type TenantInviteAcceptResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantAlertEmailGroupList
 	JSON400      *APIErrors
 	JSON403      *APIError
 }
 
 // Status returns HTTPResponse.Status
-func (r AlertEmailGroupListResponse) Status() string {
+func (r TenantInviteAcceptResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9074,23 +16435,22 @@ func (r AlertEmailGroupListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AlertEmailGroupListResponse) StatusCode() int {
+func (r TenantInviteAcceptResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AlertEmailGroupCreateResponse struct {
+type TenantInviteRejectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *TenantAlertEmailGroup
 	JSON400      *APIErrors
 	JSON403      *APIError
 }
 
 // Status returns HTTPResponse.Status
-func (r AlertEmailGroupCreateResponse) Status() string {
+func (r TenantInviteRejectResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9098,23 +16458,24 @@ func (r AlertEmailGroupCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AlertEmailGroupCreateResponse) StatusCode() int {
+func (r TenantInviteRejectResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantAlertingSettingsGetResponse struct {
+type UserUpdateLoginResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantAlertingSettings
+	JSON200      *User
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON401      *APIErrors
+	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantAlertingSettingsGetResponse) Status() string {
+func (r UserUpdateLoginResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9122,23 +16483,24 @@ func (r TenantAlertingSettingsGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantAlertingSettingsGetResponse) StatusCode() int {
+func (r UserUpdateLoginResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ApiTokenListResponse struct {
+type UserUpdateLogoutResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ListAPITokensResponse
+	JSON200      *User
 	JSON400      *APIErrors
-	JSON403      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r ApiTokenListResponse) Status() string {
+func (r UserUpdateLogoutResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9146,23 +16508,23 @@ func (r ApiTokenListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ApiTokenListResponse) StatusCode() int {
+func (r UserUpdateLogoutResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ApiTokenCreateResponse struct {
+type TenantMembershipsListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *CreateAPITokenResponse
+	JSON200      *UserTenantMembershipsList
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r ApiTokenCreateResponse) Status() string {
+func (r TenantMembershipsListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9170,23 +16532,20 @@ func (r ApiTokenCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ApiTokenCreateResponse) StatusCode() int {
+func (r TenantMembershipsListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventListResponse struct {
+type UserUpdateOidcCallbackResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EventList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r EventListResponse) Status() string {
+func (r UserUpdateOidcCallbackResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9194,24 +16553,20 @@ func (r EventListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventListResponse) StatusCode() int {
+func (r UserUpdateOidcCallbackResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventCreateResponse struct {
+type UserUpdateOidcStartResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Event
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON429      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r EventCreateResponse) Status() string {
+func (r UserUpdateOidcStartResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9219,24 +16574,24 @@ func (r EventCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventCreateResponse) StatusCode() int {
+func (r UserUpdateOidcStartResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventCreateBulkResponse struct {
+type UserUpdatePasswordResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *BulkCreateEventResponse
+	JSON200      *User
 	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON429      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r EventCreateBulkResponse) Status() string {
+func (r UserUpdatePasswordResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9244,26 +16599,45 @@ func (r EventCreateBulkResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventCreateBulkResponse) StatusCode() int {
+func (r UserUpdatePasswordResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventUpdateCancelResponse struct {
+type UserCreateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+	JSON200      *User
+	JSON400      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
+}
+
+// Status returns HTTPResponse.Status
+func (r UserCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	JSON400 *APIErrors
-	JSON403 *APIErrors
-	JSON429 *APIErrors
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UserCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UserUpdateSlackOauthCallbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r EventUpdateCancelResponse) Status() string {
+func (r UserUpdateSlackOauthCallbackResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9271,23 +16645,23 @@ func (r EventUpdateCancelResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventUpdateCancelResponse) StatusCode() int {
+func (r UserUpdateSlackOauthCallbackResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventKeyListResponse struct {
+type WebhookDeleteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EventKeyList
 	JSON400      *APIErrors
-	JSON403      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r EventKeyListResponse) Status() string {
+func (r WebhookDeleteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9295,24 +16669,24 @@ func (r EventKeyListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventKeyListResponse) StatusCode() int {
+func (r WebhookDeleteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EventUpdateReplayResponse struct {
+type WebhookRequestsListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EventList
+	JSON200      *WebhookWorkerRequestListResponse
 	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON429      *APIErrors
+	JSON401      *APIErrors
+	JSON405      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r EventUpdateReplayResponse) Status() string {
+func (r WebhookRequestsListResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9320,23 +16694,23 @@ func (r EventUpdateReplayResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EventUpdateReplayResponse) StatusCode() int {
+func (r WebhookRequestsListResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantInviteListResponse struct {
+type WorkerGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantInviteList
+	JSON200      *Worker
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantInviteListResponse) Status() string {
+func (r WorkerGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9344,23 +16718,23 @@ func (r TenantInviteListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantInviteListResponse) StatusCode() int {
+func (r WorkerGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantInviteCreateResponse struct {
+type WorkerUpdateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *TenantInvite
+	JSON200      *Worker
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantInviteCreateResponse) Status() string {
+func (r WorkerUpdateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9368,22 +16742,23 @@ func (r TenantInviteCreateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantInviteCreateResponse) StatusCode() int {
+func (r WorkerUpdateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantInviteDeleteResponse struct {
+type WorkflowDeleteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantInvite
 	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantInviteDeleteResponse) Status() string {
+func (r WorkflowDeleteResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9391,22 +16766,24 @@ func (r TenantInviteDeleteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantInviteDeleteResponse) StatusCode() int {
+func (r WorkflowDeleteResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantInviteUpdateResponse struct {
+type WorkflowGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantInvite
+	JSON200      *Workflow
 	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantInviteUpdateResponse) Status() string {
+func (r WorkflowGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9414,23 +16791,23 @@ func (r TenantInviteUpdateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantInviteUpdateResponse) StatusCode() int {
+func (r WorkflowGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantMemberListResponse struct {
+type WorkflowUpdateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantMemberList
+	JSON200      *Workflow
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantMemberListResponse) Status() string {
+func (r WorkflowUpdateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9438,24 +16815,24 @@ func (r TenantMemberListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantMemberListResponse) StatusCode() int {
+func (r WorkflowUpdateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantMemberDeleteResponse struct {
+type WorkflowListConcurrencyGroupsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON204      *TenantMember
+	JSON200      *WorkflowConcurrencyGroupList
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantMemberDeleteResponse) Status() string {
+func (r WorkflowListConcurrencyGroupsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9463,24 +16840,24 @@ func (r TenantMemberDeleteResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantMemberDeleteResponse) StatusCode() int {
+func (r WorkflowListConcurrencyGroupsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantGetQueueMetricsResponse struct {
+type WorkflowGetMetricsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantQueueMetrics
+	JSON200      *WorkflowMetrics
 	JSON400      *APIErrors
 	JSON403      *APIErrors
 	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantGetQueueMetricsResponse) Status() string {
+func (r WorkflowGetMetricsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9488,23 +16865,23 @@ func (r TenantGetQueueMetricsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantGetQueueMetricsResponse) StatusCode() int {
+func (r WorkflowGetMetricsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type RateLimitListResponse struct {
+type WorkflowDeleteRolloutResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *RateLimitList
 	JSON400      *APIErrors
 	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r RateLimitListResponse) Status() string {
+func (r WorkflowDeleteRolloutResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9512,23 +16889,24 @@ func (r RateLimitListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r RateLimitListResponse) StatusCode() int {
+func (r WorkflowDeleteRolloutResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type TenantResourcePolicyGetResponse struct {
+type WorkflowGetRolloutResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TenantResourcePolicy
+	JSON200      *WorkflowRollout
 	JSON400      *APIErrors
-	JSON403      *APIError
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r TenantResourcePolicyGetResponse) Status() string {
+func (r WorkflowGetRolloutResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9536,24 +16914,24 @@ func (r TenantResourcePolicyGetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r TenantResourcePolicyGetResponse) StatusCode() int {
+func (r WorkflowGetRolloutResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SlackWebhookListResponse struct {
+type WorkflowUpdateRolloutResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ListSlackWebhooks
+	JSON200      *WorkflowRollout
 	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r SlackWebhookListResponse) Status() string {
+func (r WorkflowUpdateRolloutResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9561,20 +16939,25 @@ func (r SlackWebhookListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SlackWebhookListResponse) StatusCode() int {
+func (r WorkflowUpdateRolloutResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UserUpdateSlackOauthStartResponse struct {
+type WorkflowRunCreateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *WorkflowRun
+	JSON400      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
+	JSON429      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r UserUpdateSlackOauthStartResponse) Status() string {
+func (r WorkflowRunCreateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9582,24 +16965,24 @@ func (r UserUpdateSlackOauthStartResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateSlackOauthStartResponse) StatusCode() int {
+func (r WorkflowRunCreateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SnsListResponse struct {
+type WorkflowVersionGetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ListSNSIntegrations
+	JSON200      *WorkflowVersion
 	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+	JSON403      *APIErrors
+	JSON404      *APIErrors
 }
 
 // Status returns HTTPResponse.Status
-func (r SnsListResponse) Status() string {
+func (r WorkflowVersionGetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9607,2328 +16990,3141 @@ func (r SnsListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SnsListResponse) StatusCode() int {
+func (r WorkflowVersionGetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
-	return 0
+	return 0
+}
+
+// HealthzGetWithResponse request returning *HealthzGetResponse
+func (c *ClientWithResponses) HealthzGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthzGetResponse, error) {
+	rsp, err := c.HealthzGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthzGetResponse(rsp)
+}
+
+// LivenessGetWithResponse request returning *LivenessGetResponse
+func (c *ClientWithResponses) LivenessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LivenessGetResponse, error) {
+	rsp, err := c.LivenessGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLivenessGetResponse(rsp)
+}
+
+// ReadinessGetWithResponse request returning *ReadinessGetResponse
+func (c *ClientWithResponses) ReadinessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadinessGetResponse, error) {
+	rsp, err := c.ReadinessGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReadinessGetResponse(rsp)
+}
+
+// ReadyzGetWithResponse request returning *ReadyzGetResponse
+func (c *ClientWithResponses) ReadyzGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadyzGetResponse, error) {
+	rsp, err := c.ReadyzGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReadyzGetResponse(rsp)
+}
+
+// AlertEmailGroupDeleteWithResponse request returning *AlertEmailGroupDeleteResponse
+func (c *ClientWithResponses) AlertEmailGroupDeleteWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupDeleteResponse, error) {
+	rsp, err := c.AlertEmailGroupDelete(ctx, alertEmailGroup, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertEmailGroupDeleteResponse(rsp)
+}
+
+// AlertEmailGroupUpdateWithBodyWithResponse request with arbitrary body returning *AlertEmailGroupUpdateResponse
+func (c *ClientWithResponses) AlertEmailGroupUpdateWithBodyWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error) {
+	rsp, err := c.AlertEmailGroupUpdateWithBody(ctx, alertEmailGroup, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertEmailGroupUpdateResponse(rsp)
+}
+
+func (c *ClientWithResponses) AlertEmailGroupUpdateWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, body AlertEmailGroupUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error) {
+	rsp, err := c.AlertEmailGroupUpdate(ctx, alertEmailGroup, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertEmailGroupUpdateResponse(rsp)
+}
+
+// ApiTokenUpdateRevokeWithResponse request returning *ApiTokenUpdateRevokeResponse
+func (c *ClientWithResponses) ApiTokenUpdateRevokeWithResponse(ctx context.Context, apiToken openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenUpdateRevokeResponse, error) {
+	rsp, err := c.ApiTokenUpdateRevoke(ctx, apiToken, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiTokenUpdateRevokeResponse(rsp)
+}
+
+// ApiTokenUpdateRotateWithBodyWithResponse request with arbitrary body returning *ApiTokenUpdateRotateResponse
+func (c *ClientWithResponses) ApiTokenUpdateRotateWithBodyWithResponse(ctx context.Context, apiToken openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiTokenUpdateRotateResponse, error) {
+	rsp, err := c.ApiTokenUpdateRotateWithBody(ctx, apiToken, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiTokenUpdateRotateResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApiTokenUpdateRotateWithResponse(ctx context.Context, apiToken openapi_types.UUID, body ApiTokenUpdateRotateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiTokenUpdateRotateResponse, error) {
+	rsp, err := c.ApiTokenUpdateRotate(ctx, apiToken, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiTokenUpdateRotateResponse(rsp)
+}
+
+// ApprovalUpdateDecideWithTokenWithBodyWithResponse request with arbitrary body returning *ApprovalUpdateDecideWithTokenResponse
+func (c *ClientWithResponses) ApprovalUpdateDecideWithTokenWithBodyWithResponse(ctx context.Context, approvalToken string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApprovalUpdateDecideWithTokenResponse, error) {
+	rsp, err := c.ApprovalUpdateDecideWithTokenWithBody(ctx, approvalToken, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApprovalUpdateDecideWithTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApprovalUpdateDecideWithTokenWithResponse(ctx context.Context, approvalToken string, body ApprovalUpdateDecideWithTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*ApprovalUpdateDecideWithTokenResponse, error) {
+	rsp, err := c.ApprovalUpdateDecideWithToken(ctx, approvalToken, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApprovalUpdateDecideWithTokenResponse(rsp)
+}
+
+// ApprovalGetWithResponse request returning *ApprovalGetResponse
+func (c *ClientWithResponses) ApprovalGetWithResponse(ctx context.Context, approval openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApprovalGetResponse, error) {
+	rsp, err := c.ApprovalGet(ctx, approval, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApprovalGetResponse(rsp)
+}
+
+// ApprovalUpdateDecideWithBodyWithResponse request with arbitrary body returning *ApprovalUpdateDecideResponse
+func (c *ClientWithResponses) ApprovalUpdateDecideWithBodyWithResponse(ctx context.Context, approval openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApprovalUpdateDecideResponse, error) {
+	rsp, err := c.ApprovalUpdateDecideWithBody(ctx, approval, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApprovalUpdateDecideResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApprovalUpdateDecideWithResponse(ctx context.Context, approval openapi_types.UUID, body ApprovalUpdateDecideJSONRequestBody, reqEditors ...RequestEditorFn) (*ApprovalUpdateDecideResponse, error) {
+	rsp, err := c.ApprovalUpdateDecide(ctx, approval, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApprovalUpdateDecideResponse(rsp)
+}
+
+// ClientCaDeleteWithResponse request returning *ClientCaDeleteResponse
+func (c *ClientWithResponses) ClientCaDeleteWithResponse(ctx context.Context, clientCa openapi_types.UUID, reqEditors ...RequestEditorFn) (*ClientCaDeleteResponse, error) {
+	rsp, err := c.ClientCaDelete(ctx, clientCa, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseClientCaDeleteResponse(rsp)
+}
+
+// CloudMetadataGetWithResponse request returning *CloudMetadataGetResponse
+func (c *ClientWithResponses) CloudMetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CloudMetadataGetResponse, error) {
+	rsp, err := c.CloudMetadataGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloudMetadataGetResponse(rsp)
+}
+
+// EventDedupRuleDeleteWithResponse request returning *EventDedupRuleDeleteResponse
+func (c *ClientWithResponses) EventDedupRuleDeleteWithResponse(ctx context.Context, eventDedupRule openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventDedupRuleDeleteResponse, error) {
+	rsp, err := c.EventDedupRuleDelete(ctx, eventDedupRule, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventDedupRuleDeleteResponse(rsp)
+}
+
+// EventRoutingRuleDeleteWithResponse request returning *EventRoutingRuleDeleteResponse
+func (c *ClientWithResponses) EventRoutingRuleDeleteWithResponse(ctx context.Context, eventRoutingRule openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventRoutingRuleDeleteResponse, error) {
+	rsp, err := c.EventRoutingRuleDelete(ctx, eventRoutingRule, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventRoutingRuleDeleteResponse(rsp)
+}
+
+// EventSinkDeleteWithResponse request returning *EventSinkDeleteResponse
+func (c *ClientWithResponses) EventSinkDeleteWithResponse(ctx context.Context, eventSink openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventSinkDeleteResponse, error) {
+	rsp, err := c.EventSinkDelete(ctx, eventSink, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventSinkDeleteResponse(rsp)
+}
+
+// EventSinkReplayWithResponse request returning *EventSinkReplayResponse
+func (c *ClientWithResponses) EventSinkReplayWithResponse(ctx context.Context, eventSink openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventSinkReplayResponse, error) {
+	rsp, err := c.EventSinkReplay(ctx, eventSink, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventSinkReplayResponse(rsp)
+}
+
+// EventGetWithResponse request returning *EventGetResponse
+func (c *ClientWithResponses) EventGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventGetResponse, error) {
+	rsp, err := c.EventGet(ctx, event, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventGetResponse(rsp)
+}
+
+// EventDataGetWithResponse request returning *EventDataGetResponse
+func (c *ClientWithResponses) EventDataGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventDataGetResponse, error) {
+	rsp, err := c.EventDataGet(ctx, event, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventDataGetResponse(rsp)
+}
+
+// InboundWebhookDeleteWithResponse request returning *InboundWebhookDeleteResponse
+func (c *ClientWithResponses) InboundWebhookDeleteWithResponse(ctx context.Context, inboundWebhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*InboundWebhookDeleteResponse, error) {
+	rsp, err := c.InboundWebhookDelete(ctx, inboundWebhook, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInboundWebhookDeleteResponse(rsp)
 }
 
-type SnsCreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *SNSIntegration
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+// InboundWebhookReceiveWithResponse request returning *InboundWebhookReceiveResponse
+func (c *ClientWithResponses) InboundWebhookReceiveWithResponse(ctx context.Context, inboundWebhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*InboundWebhookReceiveResponse, error) {
+	rsp, err := c.InboundWebhookReceive(ctx, inboundWebhook, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInboundWebhookReceiveResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r SnsCreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// MetadataGetWithResponse request returning *MetadataGetResponse
+func (c *ClientWithResponses) MetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataGetResponse, error) {
+	rsp, err := c.MetadataGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseMetadataGetResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SnsCreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// MetadataListIntegrationsWithResponse request returning *MetadataListIntegrationsResponse
+func (c *ClientWithResponses) MetadataListIntegrationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataListIntegrationsResponse, error) {
+	rsp, err := c.MetadataListIntegrations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseMetadataListIntegrationsResponse(rsp)
 }
 
-type TenantGetStepRunQueueMetricsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TenantStepRunQueueMetrics
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+// SlackWebhookDeleteWithResponse request returning *SlackWebhookDeleteResponse
+func (c *ClientWithResponses) SlackWebhookDeleteWithResponse(ctx context.Context, slack openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookDeleteResponse, error) {
+	rsp, err := c.SlackWebhookDelete(ctx, slack, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSlackWebhookDeleteResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r TenantGetStepRunQueueMetricsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// SnsDeleteWithResponse request returning *SnsDeleteResponse
+func (c *ClientWithResponses) SnsDeleteWithResponse(ctx context.Context, sns openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsDeleteResponse, error) {
+	rsp, err := c.SnsDelete(ctx, sns, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseSnsDeleteResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r TenantGetStepRunQueueMetricsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// SnsUpdateWithResponse request returning *SnsUpdateResponse
+func (c *ClientWithResponses) SnsUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, event string, reqEditors ...RequestEditorFn) (*SnsUpdateResponse, error) {
+	rsp, err := c.SnsUpdate(ctx, tenant, event, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseSnsUpdateResponse(rsp)
 }
 
-type StepRunGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *StepRun
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+// SqsDeleteWithResponse request returning *SqsDeleteResponse
+func (c *ClientWithResponses) SqsDeleteWithResponse(ctx context.Context, sqs openapi_types.UUID, reqEditors ...RequestEditorFn) (*SqsDeleteResponse, error) {
+	rsp, err := c.SqsDelete(ctx, sqs, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSqsDeleteResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r StepRunGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// StepRunListArchivesWithResponse request returning *StepRunListArchivesResponse
+func (c *ClientWithResponses) StepRunListArchivesWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListArchivesParams, reqEditors ...RequestEditorFn) (*StepRunListArchivesResponse, error) {
+	rsp, err := c.StepRunListArchives(ctx, stepRun, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseStepRunListArchivesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StepRunGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// StepRunListEventsWithResponse request returning *StepRunListEventsResponse
+func (c *ClientWithResponses) StepRunListEventsWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListEventsParams, reqEditors ...RequestEditorFn) (*StepRunListEventsResponse, error) {
+	rsp, err := c.StepRunListEvents(ctx, stepRun, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseStepRunListEventsResponse(rsp)
 }
 
-type StepRunUpdateCancelResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *StepRun
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// LogLineListWithResponse request returning *LogLineListResponse
+func (c *ClientWithResponses) LogLineListWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *LogLineListParams, reqEditors ...RequestEditorFn) (*LogLineListResponse, error) {
+	rsp, err := c.LogLineList(ctx, stepRun, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogLineListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r StepRunUpdateCancelResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// LogLineStreamWithResponse request returning *LogLineStreamResponse
+func (c *ClientWithResponses) LogLineStreamWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *LogLineStreamParams, reqEditors ...RequestEditorFn) (*LogLineStreamResponse, error) {
+	rsp, err := c.LogLineStream(ctx, stepRun, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseLogLineStreamResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StepRunUpdateCancelResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// StepRunListSchedulingDecisionsWithResponse request returning *StepRunListSchedulingDecisionsResponse
+func (c *ClientWithResponses) StepRunListSchedulingDecisionsWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListSchedulingDecisionsParams, reqEditors ...RequestEditorFn) (*StepRunListSchedulingDecisionsResponse, error) {
+	rsp, err := c.StepRunListSchedulingDecisions(ctx, stepRun, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseStepRunListSchedulingDecisionsResponse(rsp)
 }
 
-type StepRunUpdateRerunResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *StepRun
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// TenantCreateWithBodyWithResponse request with arbitrary body returning *TenantCreateResponse
+func (c *ClientWithResponses) TenantCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error) {
+	rsp, err := c.TenantCreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r StepRunUpdateRerunResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) TenantCreateWithResponse(ctx context.Context, body TenantCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error) {
+	rsp, err := c.TenantCreate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StepRunUpdateRerunResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// TenantUpdateWithBodyWithResponse request with arbitrary body returning *TenantUpdateResponse
+func (c *ClientWithResponses) TenantUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error) {
+	rsp, err := c.TenantUpdateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantUpdateResponse(rsp)
 }
 
-type StepRunGetSchemaResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *map[string]interface{}
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+func (c *ClientWithResponses) TenantUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error) {
+	rsp, err := c.TenantUpdate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantUpdateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r StepRunGetSchemaResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// AlertEmailGroupListWithResponse request returning *AlertEmailGroupListResponse
+func (c *ClientWithResponses) AlertEmailGroupListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupListResponse, error) {
+	rsp, err := c.AlertEmailGroupList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseAlertEmailGroupListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StepRunGetSchemaResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// AlertEmailGroupCreateWithBodyWithResponse request with arbitrary body returning *AlertEmailGroupCreateResponse
+func (c *ClientWithResponses) AlertEmailGroupCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error) {
+	rsp, err := c.AlertEmailGroupCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseAlertEmailGroupCreateResponse(rsp)
 }
 
-type WebhookListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WebhookWorkerListResponse
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+func (c *ClientWithResponses) AlertEmailGroupCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error) {
+	rsp, err := c.AlertEmailGroupCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertEmailGroupCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WebhookListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantAlertingSettingsGetWithResponse request returning *TenantAlertingSettingsGetResponse
+func (c *ClientWithResponses) TenantAlertingSettingsGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantAlertingSettingsGetResponse, error) {
+	rsp, err := c.TenantAlertingSettingsGet(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantAlertingSettingsGetResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WebhookListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ApiTokenListWithResponse request returning *ApiTokenListResponse
+func (c *ClientWithResponses) ApiTokenListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenListResponse, error) {
+	rsp, err := c.ApiTokenList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseApiTokenListResponse(rsp)
 }
 
-type WebhookCreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WebhookWorkerCreated
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+// ApiTokenCreateWithBodyWithResponse request with arbitrary body returning *ApiTokenCreateResponse
+func (c *ClientWithResponses) ApiTokenCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error) {
+	rsp, err := c.ApiTokenCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiTokenCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WebhookCreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) ApiTokenCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error) {
+	rsp, err := c.ApiTokenCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiTokenCreateResponse(rsp)
+}
+
+// ApprovalListWithResponse request returning *ApprovalListResponse
+func (c *ClientWithResponses) ApprovalListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *ApprovalListParams, reqEditors ...RequestEditorFn) (*ApprovalListResponse, error) {
+	rsp, err := c.ApprovalList(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseApprovalListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WebhookCreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// AuditLogListWithResponse request returning *AuditLogListResponse
+func (c *ClientWithResponses) AuditLogListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *AuditLogListParams, reqEditors ...RequestEditorFn) (*AuditLogListResponse, error) {
+	rsp, err := c.AuditLogList(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseAuditLogListResponse(rsp)
 }
 
-type WorkerListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkerList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// ClientCaListWithResponse request returning *ClientCaListResponse
+func (c *ClientWithResponses) ClientCaListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*ClientCaListResponse, error) {
+	rsp, err := c.ClientCaList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseClientCaListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkerListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ClientCaCreateWithBodyWithResponse request with arbitrary body returning *ClientCaCreateResponse
+func (c *ClientWithResponses) ClientCaCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ClientCaCreateResponse, error) {
+	rsp, err := c.ClientCaCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseClientCaCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkerListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) ClientCaCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body ClientCaCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ClientCaCreateResponse, error) {
+	rsp, err := c.ClientCaCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseClientCaCreateResponse(rsp)
 }
 
-type WorkflowRunUpdateReplayResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ReplayWorkflowRunsResponse
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON429      *APIErrors
+// CronCalendarListWithResponse request returning *CronCalendarListResponse
+func (c *ClientWithResponses) CronCalendarListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*CronCalendarListResponse, error) {
+	rsp, err := c.CronCalendarList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCronCalendarListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowRunUpdateReplayResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CronCalendarCreateWithBodyWithResponse request with arbitrary body returning *CronCalendarCreateResponse
+func (c *ClientWithResponses) CronCalendarCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CronCalendarCreateResponse, error) {
+	rsp, err := c.CronCalendarCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCronCalendarCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunUpdateReplayResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) CronCalendarCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body CronCalendarCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CronCalendarCreateResponse, error) {
+	rsp, err := c.CronCalendarCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCronCalendarCreateResponse(rsp)
 }
 
-type WorkflowRunGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkflowRun
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// CronCalendarDeleteWithResponse request returning *CronCalendarDeleteResponse
+func (c *ClientWithResponses) CronCalendarDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, cronCalendar openapi_types.UUID, reqEditors ...RequestEditorFn) (*CronCalendarDeleteResponse, error) {
+	rsp, err := c.CronCalendarDelete(ctx, tenant, cronCalendar, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCronCalendarDeleteResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowRunGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeadLetterQueueListWithResponse request returning *DeadLetterQueueListResponse
+func (c *ClientWithResponses) DeadLetterQueueListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *DeadLetterQueueListParams, reqEditors ...RequestEditorFn) (*DeadLetterQueueListResponse, error) {
+	rsp, err := c.DeadLetterQueueList(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeadLetterQueueListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DeadLetterQueueDeleteWithBodyWithResponse request with arbitrary body returning *DeadLetterQueueDeleteResponse
+func (c *ClientWithResponses) DeadLetterQueueDeleteWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeadLetterQueueDeleteResponse, error) {
+	rsp, err := c.DeadLetterQueueDeleteWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDeadLetterQueueDeleteResponse(rsp)
 }
 
-type WorkflowRunGetInputResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *map[string]interface{}
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+func (c *ClientWithResponses) DeadLetterQueueDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, body DeadLetterQueueDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*DeadLetterQueueDeleteResponse, error) {
+	rsp, err := c.DeadLetterQueueDelete(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeadLetterQueueDeleteResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowRunGetInputResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeadLetterQueueUpdateReplayWithBodyWithResponse request with arbitrary body returning *DeadLetterQueueUpdateReplayResponse
+func (c *ClientWithResponses) DeadLetterQueueUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeadLetterQueueUpdateReplayResponse, error) {
+	rsp, err := c.DeadLetterQueueUpdateReplayWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeadLetterQueueUpdateReplayResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunGetInputResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) DeadLetterQueueUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body DeadLetterQueueUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*DeadLetterQueueUpdateReplayResponse, error) {
+	rsp, err := c.DeadLetterQueueUpdateReplay(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDeadLetterQueueUpdateReplayResponse(rsp)
 }
 
-type WorkflowRunGetShapeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkflowRunShape
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// EventDedupRuleListWithResponse request returning *EventDedupRuleListResponse
+func (c *ClientWithResponses) EventDedupRuleListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventDedupRuleListResponse, error) {
+	rsp, err := c.EventDedupRuleList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventDedupRuleListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowRunGetShapeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// EventDedupRuleUpsertWithBodyWithResponse request with arbitrary body returning *EventDedupRuleUpsertResponse
+func (c *ClientWithResponses) EventDedupRuleUpsertWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventDedupRuleUpsertResponse, error) {
+	rsp, err := c.EventDedupRuleUpsertWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseEventDedupRuleUpsertResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunGetShapeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) EventDedupRuleUpsertWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventDedupRuleUpsertJSONRequestBody, reqEditors ...RequestEditorFn) (*EventDedupRuleUpsertResponse, error) {
+	rsp, err := c.EventDedupRuleUpsert(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseEventDedupRuleUpsertResponse(rsp)
 }
 
-type WorkflowRunListStepRunEventsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *StepRunEventList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+// EventRoutingRuleListWithResponse request returning *EventRoutingRuleListResponse
+func (c *ClientWithResponses) EventRoutingRuleListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventRoutingRuleListResponse, error) {
+	rsp, err := c.EventRoutingRuleList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventRoutingRuleListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowRunListStepRunEventsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// EventRoutingRuleUpsertWithBodyWithResponse request with arbitrary body returning *EventRoutingRuleUpsertResponse
+func (c *ClientWithResponses) EventRoutingRuleUpsertWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventRoutingRuleUpsertResponse, error) {
+	rsp, err := c.EventRoutingRuleUpsertWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseEventRoutingRuleUpsertResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunListStepRunEventsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) EventRoutingRuleUpsertWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventRoutingRuleUpsertJSONRequestBody, reqEditors ...RequestEditorFn) (*EventRoutingRuleUpsertResponse, error) {
+	rsp, err := c.EventRoutingRuleUpsert(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseEventRoutingRuleUpsertResponse(rsp)
 }
 
-type WorkflowListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkflowList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// EventSinkListWithResponse request returning *EventSinkListResponse
+func (c *ClientWithResponses) EventSinkListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventSinkListResponse, error) {
+	rsp, err := c.EventSinkList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventSinkListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// EventSinkCreateWithBodyWithResponse request with arbitrary body returning *EventSinkCreateResponse
+func (c *ClientWithResponses) EventSinkCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventSinkCreateResponse, error) {
+	rsp, err := c.EventSinkCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseEventSinkCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) EventSinkCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventSinkCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*EventSinkCreateResponse, error) {
+	rsp, err := c.EventSinkCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseEventSinkCreateResponse(rsp)
 }
 
-type WorkflowRunCancelResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+// EventListWithResponse request returning *EventListResponse
+func (c *ClientWithResponses) EventListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *EventListParams, reqEditors ...RequestEditorFn) (*EventListResponse, error) {
+	rsp, err := c.EventList(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	JSON400 *APIErrors
-	JSON403 *APIErrors
+	return ParseEventListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowRunCancelResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// EventCreateWithBodyWithResponse request with arbitrary body returning *EventCreateResponse
+func (c *ClientWithResponses) EventCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateResponse, error) {
+	rsp, err := c.EventCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseEventCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunCancelResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) EventCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateResponse, error) {
+	rsp, err := c.EventCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseEventCreateResponse(rsp)
 }
 
-type CronWorkflowListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CronWorkflowsList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// EventCreateBatchWithBodyWithResponse request with arbitrary body returning *EventCreateBatchResponse
+func (c *ClientWithResponses) EventCreateBatchWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateBatchResponse, error) {
+	rsp, err := c.EventCreateBatchWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventCreateBatchResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CronWorkflowListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) EventCreateBatchWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateBatchResponse, error) {
+	rsp, err := c.EventCreateBatch(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseEventCreateBatchResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CronWorkflowListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// EventCreateBulkWithBodyWithResponse request with arbitrary body returning *EventCreateBulkResponse
+func (c *ClientWithResponses) EventCreateBulkWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error) {
+	rsp, err := c.EventCreateBulkWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseEventCreateBulkResponse(rsp)
 }
 
-type WorkflowCronDeleteResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *APIErrors
-	JSON403      *APIError
+func (c *ClientWithResponses) EventCreateBulkWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error) {
+	rsp, err := c.EventCreateBulk(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventCreateBulkResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowCronDeleteResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// EventUpdateCancelWithBodyWithResponse request with arbitrary body returning *EventUpdateCancelResponse
+func (c *ClientWithResponses) EventUpdateCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error) {
+	rsp, err := c.EventUpdateCancelWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseEventUpdateCancelResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowCronDeleteResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) EventUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error) {
+	rsp, err := c.EventUpdateCancel(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseEventUpdateCancelResponse(rsp)
 }
 
-type WorkflowCronGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CronWorkflows
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+// EventCreateCloudEventWithResponse request returning *EventCreateCloudEventResponse
+func (c *ClientWithResponses) EventCreateCloudEventWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventCreateCloudEventResponse, error) {
+	rsp, err := c.EventCreateCloudEvent(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventCreateCloudEventResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowCronGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// EventKeyListWithResponse request returning *EventKeyListResponse
+func (c *ClientWithResponses) EventKeyListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventKeyListResponse, error) {
+	rsp, err := c.EventKeyList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseEventKeyListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowCronGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// EventUpdateReplayWithBodyWithResponse request with arbitrary body returning *EventUpdateReplayResponse
+func (c *ClientWithResponses) EventUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error) {
+	rsp, err := c.EventUpdateReplayWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseEventUpdateReplayResponse(rsp)
 }
 
-type WorkflowRunListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkflowRunList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+func (c *ClientWithResponses) EventUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error) {
+	rsp, err := c.EventUpdateReplay(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEventUpdateReplayResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowRunListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// EventUpdateReplayRangeWithBodyWithResponse request with arbitrary body returning *EventUpdateReplayRangeResponse
+func (c *ClientWithResponses) EventUpdateReplayRangeWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateReplayRangeResponse, error) {
+	rsp, err := c.EventUpdateReplayRangeWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseEventUpdateReplayRangeResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) EventUpdateReplayRangeWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayRangeJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateReplayRangeResponse, error) {
+	rsp, err := c.EventUpdateReplayRange(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseEventUpdateReplayRangeResponse(rsp)
 }
 
-type WorkflowRunGetMetricsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkflowRunsMetrics
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// InboundWebhookListWithResponse request returning *InboundWebhookListResponse
+func (c *ClientWithResponses) InboundWebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*InboundWebhookListResponse, error) {
+	rsp, err := c.InboundWebhookList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInboundWebhookListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowRunGetMetricsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// InboundWebhookCreateWithBodyWithResponse request with arbitrary body returning *InboundWebhookCreateResponse
+func (c *ClientWithResponses) InboundWebhookCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InboundWebhookCreateResponse, error) {
+	rsp, err := c.InboundWebhookCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseInboundWebhookCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunGetMetricsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) InboundWebhookCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body InboundWebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*InboundWebhookCreateResponse, error) {
+	rsp, err := c.InboundWebhookCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseInboundWebhookCreateResponse(rsp)
 }
 
-type WorkflowScheduledListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ScheduledWorkflowsList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// TenantInviteListWithResponse request returning *TenantInviteListResponse
+func (c *ClientWithResponses) TenantInviteListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteListResponse, error) {
+	rsp, err := c.TenantInviteList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantInviteListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowScheduledListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantInviteCreateWithBodyWithResponse request with arbitrary body returning *TenantInviteCreateResponse
+func (c *ClientWithResponses) TenantInviteCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error) {
+	rsp, err := c.TenantInviteCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantInviteCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowScheduledListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) TenantInviteCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error) {
+	rsp, err := c.TenantInviteCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantInviteCreateResponse(rsp)
 }
 
-type WorkflowScheduledDeleteResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *APIErrors
-	JSON403      *APIError
+// TenantInviteDeleteWithResponse request returning *TenantInviteDeleteResponse
+func (c *ClientWithResponses) TenantInviteDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteDeleteResponse, error) {
+	rsp, err := c.TenantInviteDelete(ctx, tenant, tenantInvite, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantInviteDeleteResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowScheduledDeleteResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantInviteUpdateWithBodyWithResponse request with arbitrary body returning *TenantInviteUpdateResponse
+func (c *ClientWithResponses) TenantInviteUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error) {
+	rsp, err := c.TenantInviteUpdateWithBody(ctx, tenant, tenantInvite, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantInviteUpdateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowScheduledDeleteResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) TenantInviteUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error) {
+	rsp, err := c.TenantInviteUpdate(ctx, tenant, tenantInvite, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantInviteUpdateResponse(rsp)
 }
 
-type WorkflowScheduledGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ScheduledWorkflows
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+// TenantMemberListWithResponse request returning *TenantMemberListResponse
+func (c *ClientWithResponses) TenantMemberListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberListResponse, error) {
+	rsp, err := c.TenantMemberList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantMemberListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowScheduledGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantMemberDeleteWithResponse request returning *TenantMemberDeleteResponse
+func (c *ClientWithResponses) TenantMemberDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberDeleteResponse, error) {
+	rsp, err := c.TenantMemberDelete(ctx, tenant, member, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantMemberDeleteResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowScheduledGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// TenantGetQueueMetricsWithResponse request returning *TenantGetQueueMetricsResponse
+func (c *ClientWithResponses) TenantGetQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*TenantGetQueueMetricsResponse, error) {
+	rsp, err := c.TenantGetQueueMetrics(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantGetQueueMetricsResponse(rsp)
 }
 
-type CronWorkflowTriggerCreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CronWorkflows
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
-	JSON429      *APIErrors
+// QueueUpdatePauseWithResponse request returning *QueueUpdatePauseResponse
+func (c *ClientWithResponses) QueueUpdatePauseWithResponse(ctx context.Context, tenant openapi_types.UUID, queue string, reqEditors ...RequestEditorFn) (*QueueUpdatePauseResponse, error) {
+	rsp, err := c.QueueUpdatePause(ctx, tenant, queue, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueueUpdatePauseResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CronWorkflowTriggerCreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// QueueUpdateResumeWithResponse request returning *QueueUpdateResumeResponse
+func (c *ClientWithResponses) QueueUpdateResumeWithResponse(ctx context.Context, tenant openapi_types.UUID, queue string, reqEditors ...RequestEditorFn) (*QueueUpdateResumeResponse, error) {
+	rsp, err := c.QueueUpdateResume(ctx, tenant, queue, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseQueueUpdateResumeResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CronWorkflowTriggerCreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// TenantQuotaListWithResponse request returning *TenantQuotaListResponse
+func (c *ClientWithResponses) TenantQuotaListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantQuotaListResponse, error) {
+	rsp, err := c.TenantQuotaList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantQuotaListResponse(rsp)
 }
 
-type ScheduledWorkflowRunCreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ScheduledWorkflows
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
-	JSON429      *APIErrors
+// RateLimitListWithResponse request returning *RateLimitListResponse
+func (c *ClientWithResponses) RateLimitListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*RateLimitListResponse, error) {
+	rsp, err := c.RateLimitList(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRateLimitListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ScheduledWorkflowRunCreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantResourcePolicyGetWithResponse request returning *TenantResourcePolicyGetResponse
+func (c *ClientWithResponses) TenantResourcePolicyGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantResourcePolicyGetResponse, error) {
+	rsp, err := c.TenantResourcePolicyGet(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantResourcePolicyGetResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ScheduledWorkflowRunCreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// SlackWebhookListWithResponse request returning *SlackWebhookListResponse
+func (c *ClientWithResponses) SlackWebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookListResponse, error) {
+	rsp, err := c.SlackWebhookList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseSlackWebhookListResponse(rsp)
 }
 
-type WorkflowGetWorkersCountResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkflowWorkersCount
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// UserUpdateSlackOauthStartWithResponse request returning *UserUpdateSlackOauthStartResponse
+func (c *ClientWithResponses) UserUpdateSlackOauthStartWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthStartResponse, error) {
+	rsp, err := c.UserUpdateSlackOauthStart(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserUpdateSlackOauthStartResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowGetWorkersCountResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// SnsListWithResponse request returning *SnsListResponse
+func (c *ClientWithResponses) SnsListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsListResponse, error) {
+	rsp, err := c.SnsList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseSnsListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowGetWorkersCountResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// SnsCreateWithBodyWithResponse request with arbitrary body returning *SnsCreateResponse
+func (c *ClientWithResponses) SnsCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error) {
+	rsp, err := c.SnsCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseSnsCreateResponse(rsp)
 }
 
-type UserGetCurrentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+func (c *ClientWithResponses) SnsCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body SnsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error) {
+	rsp, err := c.SnsCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSnsCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserGetCurrentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// SqsListWithResponse request returning *SqsListResponse
+func (c *ClientWithResponses) SqsListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SqsListResponse, error) {
+	rsp, err := c.SqsList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseSqsListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserGetCurrentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// SqsCreateWithBodyWithResponse request with arbitrary body returning *SqsCreateResponse
+func (c *ClientWithResponses) SqsCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SqsCreateResponse, error) {
+	rsp, err := c.SqsCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseSqsCreateResponse(rsp)
 }
 
-type UserUpdateGithubOauthCallbackResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) SqsCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body SqsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*SqsCreateResponse, error) {
+	rsp, err := c.SqsCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSqsCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserUpdateGithubOauthCallbackResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantGetStepRunQueueMetricsWithResponse request returning *TenantGetStepRunQueueMetricsResponse
+func (c *ClientWithResponses) TenantGetStepRunQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantGetStepRunQueueMetricsResponse, error) {
+	rsp, err := c.TenantGetStepRunQueueMetrics(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantGetStepRunQueueMetricsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateGithubOauthCallbackResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// StepRunGetWithResponse request returning *StepRunGetResponse
+func (c *ClientWithResponses) StepRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetResponse, error) {
+	rsp, err := c.StepRunGet(ctx, tenant, stepRun, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseStepRunGetResponse(rsp)
 }
 
-type UserUpdateGithubOauthStartResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// StepRunUpdateCancelWithResponse request returning *StepRunUpdateCancelResponse
+func (c *ClientWithResponses) StepRunUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunUpdateCancelResponse, error) {
+	rsp, err := c.StepRunUpdateCancel(ctx, tenant, stepRun, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStepRunUpdateCancelResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserUpdateGithubOauthStartResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// StepRunUpdateRerunWithBodyWithResponse request with arbitrary body returning *StepRunUpdateRerunResponse
+func (c *ClientWithResponses) StepRunUpdateRerunWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error) {
+	rsp, err := c.StepRunUpdateRerunWithBody(ctx, tenant, stepRun, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseStepRunUpdateRerunResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateGithubOauthStartResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) StepRunUpdateRerunWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error) {
+	rsp, err := c.StepRunUpdateRerun(ctx, tenant, stepRun, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseStepRunUpdateRerunResponse(rsp)
 }
 
-type UserUpdateGoogleOauthCallbackResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// StepRunGetSchemaWithResponse request returning *StepRunGetSchemaResponse
+func (c *ClientWithResponses) StepRunGetSchemaWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetSchemaResponse, error) {
+	rsp, err := c.StepRunGetSchema(ctx, tenant, stepRun, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStepRunGetSchemaResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserUpdateGoogleOauthCallbackResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WebhookListWithResponse request returning *WebhookListResponse
+func (c *ClientWithResponses) WebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookListResponse, error) {
+	rsp, err := c.WebhookList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWebhookListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateGoogleOauthCallbackResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WebhookCreateWithBodyWithResponse request with arbitrary body returning *WebhookCreateResponse
+func (c *ClientWithResponses) WebhookCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error) {
+	rsp, err := c.WebhookCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWebhookCreateResponse(rsp)
 }
 
-type UserUpdateGoogleOauthStartResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) WebhookCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error) {
+	rsp, err := c.WebhookCreate(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhookCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserUpdateGoogleOauthStartResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WorkerListWithResponse request returning *WorkerListResponse
+func (c *ClientWithResponses) WorkerListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerListResponse, error) {
+	rsp, err := c.WorkerList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkerListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateGoogleOauthStartResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WorkerPoolListWithResponse request returning *WorkerPoolListResponse
+func (c *ClientWithResponses) WorkerPoolListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerPoolListResponse, error) {
+	rsp, err := c.WorkerPoolList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWorkerPoolListResponse(rsp)
 }
 
-type UserListTenantInvitesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TenantInviteList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// WorkflowRunUpdateReplayWithBodyWithResponse request with arbitrary body returning *WorkflowRunUpdateReplayResponse
+func (c *ClientWithResponses) WorkflowRunUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error) {
+	rsp, err := c.WorkflowRunUpdateReplayWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWorkflowRunUpdateReplayResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserListTenantInvitesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) WorkflowRunUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error) {
+	rsp, err := c.WorkflowRunUpdateReplay(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkflowRunUpdateReplayResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserListTenantInvitesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WorkflowRunGetWithResponse request returning *WorkflowRunGetResponse
+func (c *ClientWithResponses) WorkflowRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetResponse, error) {
+	rsp, err := c.WorkflowRunGet(ctx, tenant, workflowRun, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWorkflowRunGetResponse(rsp)
 }
 
-type TenantInviteAcceptResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *APIErrors
-	JSON403      *APIError
+// WorkflowRunGetInputWithResponse request returning *WorkflowRunGetInputResponse
+func (c *ClientWithResponses) WorkflowRunGetInputWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetInputResponse, error) {
+	rsp, err := c.WorkflowRunGetInput(ctx, tenant, workflowRun, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWorkflowRunGetInputResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r TenantInviteAcceptResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WorkflowRunUpdatePauseWithResponse request returning *WorkflowRunUpdatePauseResponse
+func (c *ClientWithResponses) WorkflowRunUpdatePauseWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunUpdatePauseResponse, error) {
+	rsp, err := c.WorkflowRunUpdatePause(ctx, tenant, workflowRun, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkflowRunUpdatePauseResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r TenantInviteAcceptResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WorkflowRunUpdateReplayFromStepWithBodyWithResponse request with arbitrary body returning *WorkflowRunUpdateReplayFromStepResponse
+func (c *ClientWithResponses) WorkflowRunUpdateReplayFromStepWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayFromStepResponse, error) {
+	rsp, err := c.WorkflowRunUpdateReplayFromStepWithBody(ctx, tenant, workflowRun, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWorkflowRunUpdateReplayFromStepResponse(rsp)
 }
 
-type TenantInviteRejectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *APIErrors
-	JSON403      *APIError
+func (c *ClientWithResponses) WorkflowRunUpdateReplayFromStepWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, body WorkflowRunUpdateReplayFromStepJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayFromStepResponse, error) {
+	rsp, err := c.WorkflowRunUpdateReplayFromStep(ctx, tenant, workflowRun, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWorkflowRunUpdateReplayFromStepResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r TenantInviteRejectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WorkflowRunUpdateResumeWithResponse request returning *WorkflowRunUpdateResumeResponse
+func (c *ClientWithResponses) WorkflowRunUpdateResumeWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateResumeResponse, error) {
+	rsp, err := c.WorkflowRunUpdateResume(ctx, tenant, workflowRun, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkflowRunUpdateResumeResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r TenantInviteRejectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WorkflowRunGetShapeWithResponse request returning *WorkflowRunGetShapeResponse
+func (c *ClientWithResponses) WorkflowRunGetShapeWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetShapeResponse, error) {
+	rsp, err := c.WorkflowRunGetShape(ctx, tenant, workflowRun, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWorkflowRunGetShapeResponse(rsp)
 }
 
-type UserUpdateLoginResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+// WorkflowRunListStepRunEventsWithResponse request returning *WorkflowRunListStepRunEventsResponse
+func (c *ClientWithResponses) WorkflowRunListStepRunEventsWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams, reqEditors ...RequestEditorFn) (*WorkflowRunListStepRunEventsResponse, error) {
+	rsp, err := c.WorkflowRunListStepRunEvents(ctx, tenant, workflowRun, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWorkflowRunListStepRunEventsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserUpdateLoginResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WorkflowRunGetStreamWithResponse request returning *WorkflowRunGetStreamResponse
+func (c *ClientWithResponses) WorkflowRunGetStreamWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetStreamResponse, error) {
+	rsp, err := c.WorkflowRunGetStream(ctx, tenant, workflowRun, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkflowRunGetStreamResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateLoginResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WorkflowListWithResponse request returning *WorkflowListResponse
+func (c *ClientWithResponses) WorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowListResponse, error) {
+	rsp, err := c.WorkflowList(ctx, tenant, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWorkflowListResponse(rsp)
 }
 
-type UserUpdateLogoutResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+// WorkflowRunCancelWithBodyWithResponse request with arbitrary body returning *WorkflowRunCancelResponse
+func (c *ClientWithResponses) WorkflowRunCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error) {
+	rsp, err := c.WorkflowRunCancelWithBody(ctx, tenant, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWorkflowRunCancelResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserUpdateLogoutResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) WorkflowRunCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error) {
+	rsp, err := c.WorkflowRunCancel(ctx, tenant, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkflowRunCancelResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateLogoutResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CronWorkflowListWithResponse request returning *CronWorkflowListResponse
+func (c *ClientWithResponses) CronWorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *CronWorkflowListParams, reqEditors ...RequestEditorFn) (*CronWorkflowListResponse, error) {
+	rsp, err := c.CronWorkflowList(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCronWorkflowListResponse(rsp)
 }
 
-type TenantMembershipsListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserTenantMembershipsList
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// WorkflowCronDeleteWithResponse request returning *WorkflowCronDeleteResponse
+func (c *ClientWithResponses) WorkflowCronDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronDeleteResponse, error) {
+	rsp, err := c.WorkflowCronDelete(ctx, tenant, cronWorkflow, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWorkflowCronDeleteResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r TenantMembershipsListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WorkflowCronGetWithResponse request returning *WorkflowCronGetResponse
+func (c *ClientWithResponses) WorkflowCronGetWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronGetResponse, error) {
+	rsp, err := c.WorkflowCronGet(ctx, tenant, cronWorkflow, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkflowCronGetResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r TenantMembershipsListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WorkflowRunListWithResponse request returning *WorkflowRunListResponse
+func (c *ClientWithResponses) WorkflowRunListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunListParams, reqEditors ...RequestEditorFn) (*WorkflowRunListResponse, error) {
+	rsp, err := c.WorkflowRunList(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWorkflowRunListResponse(rsp)
 }
 
-type UserUpdatePasswordResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+// WorkflowRunGetMetricsWithResponse request returning *WorkflowRunGetMetricsResponse
+func (c *ClientWithResponses) WorkflowRunGetMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowRunGetMetricsResponse, error) {
+	rsp, err := c.WorkflowRunGetMetrics(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWorkflowRunGetMetricsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserUpdatePasswordResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WorkflowScheduledListWithResponse request returning *WorkflowScheduledListResponse
+func (c *ClientWithResponses) WorkflowScheduledListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowScheduledListParams, reqEditors ...RequestEditorFn) (*WorkflowScheduledListResponse, error) {
+	rsp, err := c.WorkflowScheduledList(ctx, tenant, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkflowScheduledListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdatePasswordResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WorkflowScheduledDeleteWithResponse request returning *WorkflowScheduledDeleteResponse
+func (c *ClientWithResponses) WorkflowScheduledDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledDeleteResponse, error) {
+	rsp, err := c.WorkflowScheduledDelete(ctx, tenant, scheduledWorkflowRun, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWorkflowScheduledDeleteResponse(rsp)
 }
 
-type UserCreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+// WorkflowScheduledGetWithResponse request returning *WorkflowScheduledGetResponse
+func (c *ClientWithResponses) WorkflowScheduledGetWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledGetResponse, error) {
+	rsp, err := c.WorkflowScheduledGet(ctx, tenant, scheduledWorkflowRun, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWorkflowScheduledGetResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserCreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WorkflowScheduledUpdateWithBodyWithResponse request with arbitrary body returning *WorkflowScheduledUpdateResponse
+func (c *ClientWithResponses) WorkflowScheduledUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowScheduledUpdateResponse, error) {
+	rsp, err := c.WorkflowScheduledUpdateWithBody(ctx, tenant, scheduledWorkflowRun, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkflowScheduledUpdateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserCreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) WorkflowScheduledUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, body WorkflowScheduledUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowScheduledUpdateResponse, error) {
+	rsp, err := c.WorkflowScheduledUpdate(ctx, tenant, scheduledWorkflowRun, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWorkflowScheduledUpdateResponse(rsp)
 }
 
-type UserUpdateSlackOauthCallbackResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// CronWorkflowTriggerCreateWithBodyWithResponse request with arbitrary body returning *CronWorkflowTriggerCreateResponse
+func (c *ClientWithResponses) CronWorkflowTriggerCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error) {
+	rsp, err := c.CronWorkflowTriggerCreateWithBody(ctx, tenant, workflow, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCronWorkflowTriggerCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UserUpdateSlackOauthCallbackResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) CronWorkflowTriggerCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error) {
+	rsp, err := c.CronWorkflowTriggerCreate(ctx, tenant, workflow, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCronWorkflowTriggerCreateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UserUpdateSlackOauthCallbackResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ScheduledWorkflowRunCreateWithBodyWithResponse request with arbitrary body returning *ScheduledWorkflowRunCreateResponse
+func (c *ClientWithResponses) ScheduledWorkflowRunCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error) {
+	rsp, err := c.ScheduledWorkflowRunCreateWithBody(ctx, tenant, workflow, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseScheduledWorkflowRunCreateResponse(rsp)
 }
 
-type WebhookDeleteResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+func (c *ClientWithResponses) ScheduledWorkflowRunCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error) {
+	rsp, err := c.ScheduledWorkflowRunCreate(ctx, tenant, workflow, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseScheduledWorkflowRunCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WebhookDeleteResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WorkflowGetWorkersCountWithResponse request returning *WorkflowGetWorkersCountResponse
+func (c *ClientWithResponses) WorkflowGetWorkersCountWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetWorkersCountResponse, error) {
+	rsp, err := c.WorkflowGetWorkersCount(ctx, tenant, workflow, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkflowGetWorkersCountResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WebhookDeleteResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UserGetCurrentWithResponse request returning *UserGetCurrentResponse
+func (c *ClientWithResponses) UserGetCurrentWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetCurrentResponse, error) {
+	rsp, err := c.UserGetCurrent(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUserGetCurrentResponse(rsp)
 }
 
-type WebhookRequestsListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WebhookWorkerRequestListResponse
-	JSON400      *APIErrors
-	JSON401      *APIErrors
-	JSON405      *APIErrors
+// UserUpdateGithubOauthCallbackWithResponse request returning *UserUpdateGithubOauthCallbackResponse
+func (c *ClientWithResponses) UserUpdateGithubOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthCallbackResponse, error) {
+	rsp, err := c.UserUpdateGithubOauthCallback(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserUpdateGithubOauthCallbackResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WebhookRequestsListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UserUpdateGithubOauthStartWithResponse request returning *UserUpdateGithubOauthStartResponse
+func (c *ClientWithResponses) UserUpdateGithubOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthStartResponse, error) {
+	rsp, err := c.UserUpdateGithubOauthStart(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUserUpdateGithubOauthStartResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WebhookRequestsListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UserUpdateGoogleOauthCallbackWithResponse request returning *UserUpdateGoogleOauthCallbackResponse
+func (c *ClientWithResponses) UserUpdateGoogleOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthCallbackResponse, error) {
+	rsp, err := c.UserUpdateGoogleOauthCallback(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUserUpdateGoogleOauthCallbackResponse(rsp)
 }
 
-type WorkerGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Worker
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// UserUpdateGoogleOauthStartWithResponse request returning *UserUpdateGoogleOauthStartResponse
+func (c *ClientWithResponses) UserUpdateGoogleOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthStartResponse, error) {
+	rsp, err := c.UserUpdateGoogleOauthStart(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserUpdateGoogleOauthStartResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkerGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UserListTenantInvitesWithResponse request returning *UserListTenantInvitesResponse
+func (c *ClientWithResponses) UserListTenantInvitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListTenantInvitesResponse, error) {
+	rsp, err := c.UserListTenantInvites(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUserListTenantInvitesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkerGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// TenantInviteAcceptWithBodyWithResponse request with arbitrary body returning *TenantInviteAcceptResponse
+func (c *ClientWithResponses) TenantInviteAcceptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error) {
+	rsp, err := c.TenantInviteAcceptWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantInviteAcceptResponse(rsp)
 }
 
-type WorkerUpdateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Worker
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+func (c *ClientWithResponses) TenantInviteAcceptWithResponse(ctx context.Context, body TenantInviteAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error) {
+	rsp, err := c.TenantInviteAccept(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantInviteAcceptResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkerUpdateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// TenantInviteRejectWithBodyWithResponse request with arbitrary body returning *TenantInviteRejectResponse
+func (c *ClientWithResponses) TenantInviteRejectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error) {
+	rsp, err := c.TenantInviteRejectWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseTenantInviteRejectResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkerUpdateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) TenantInviteRejectWithResponse(ctx context.Context, body TenantInviteRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error) {
+	rsp, err := c.TenantInviteReject(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTenantInviteRejectResponse(rsp)
 }
 
-type WorkflowDeleteResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+// UserUpdateLoginWithBodyWithResponse request with arbitrary body returning *UserUpdateLoginResponse
+func (c *ClientWithResponses) UserUpdateLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error) {
+	rsp, err := c.UserUpdateLoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserUpdateLoginResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowDeleteResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) UserUpdateLoginWithResponse(ctx context.Context, body UserUpdateLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error) {
+	rsp, err := c.UserUpdateLogin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUserUpdateLoginResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowDeleteResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UserUpdateLogoutWithResponse request returning *UserUpdateLogoutResponse
+func (c *ClientWithResponses) UserUpdateLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateLogoutResponse, error) {
+	rsp, err := c.UserUpdateLogout(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUserUpdateLogoutResponse(rsp)
 }
 
-type WorkflowGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Workflow
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+// TenantMembershipsListWithResponse request returning *TenantMembershipsListResponse
+func (c *ClientWithResponses) TenantMembershipsListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TenantMembershipsListResponse, error) {
+	rsp, err := c.TenantMembershipsList(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTenantMembershipsListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UserUpdateOidcCallbackWithResponse request returning *UserUpdateOidcCallbackResponse
+func (c *ClientWithResponses) UserUpdateOidcCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateOidcCallbackResponse, error) {
+	rsp, err := c.UserUpdateOidcCallback(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUserUpdateOidcCallbackResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UserUpdateOidcStartWithResponse request returning *UserUpdateOidcStartResponse
+func (c *ClientWithResponses) UserUpdateOidcStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateOidcStartResponse, error) {
+	rsp, err := c.UserUpdateOidcStart(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUserUpdateOidcStartResponse(rsp)
 }
 
-type WorkflowUpdateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Workflow
-	JSON400      *APIErrors
-	JSON403      *APIErrors
+// UserUpdatePasswordWithBodyWithResponse request with arbitrary body returning *UserUpdatePasswordResponse
+func (c *ClientWithResponses) UserUpdatePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error) {
+	rsp, err := c.UserUpdatePasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserUpdatePasswordResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowUpdateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) UserUpdatePasswordWithResponse(ctx context.Context, body UserUpdatePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error) {
+	rsp, err := c.UserUpdatePassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUserUpdatePasswordResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowUpdateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UserCreateWithBodyWithResponse request with arbitrary body returning *UserCreateResponse
+func (c *ClientWithResponses) UserCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateResponse, error) {
+	rsp, err := c.UserCreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUserCreateResponse(rsp)
 }
 
-type WorkflowGetMetricsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkflowMetrics
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+func (c *ClientWithResponses) UserCreateWithResponse(ctx context.Context, body UserCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateResponse, error) {
+	rsp, err := c.UserCreate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUserCreateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowGetMetricsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UserUpdateSlackOauthCallbackWithResponse request returning *UserUpdateSlackOauthCallbackResponse
+func (c *ClientWithResponses) UserUpdateSlackOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthCallbackResponse, error) {
+	rsp, err := c.UserUpdateSlackOauthCallback(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUserUpdateSlackOauthCallbackResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowGetMetricsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WebhookDeleteWithResponse request returning *WebhookDeleteResponse
+func (c *ClientWithResponses) WebhookDeleteWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookDeleteResponse, error) {
+	rsp, err := c.WebhookDelete(ctx, webhook, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWebhookDeleteResponse(rsp)
 }
 
-type WorkflowRunCreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkflowRun
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
-	JSON429      *APIErrors
+// WebhookRequestsListWithResponse request returning *WebhookRequestsListResponse
+func (c *ClientWithResponses) WebhookRequestsListWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookRequestsListResponse, error) {
+	rsp, err := c.WebhookRequestsList(ctx, webhook, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhookRequestsListResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowRunCreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WorkerGetWithResponse request returning *WorkerGetResponse
+func (c *ClientWithResponses) WorkerGetWithResponse(ctx context.Context, worker openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerGetResponse, error) {
+	rsp, err := c.WorkerGet(ctx, worker, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkerGetResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowRunCreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WorkerUpdateWithBodyWithResponse request with arbitrary body returning *WorkerUpdateResponse
+func (c *ClientWithResponses) WorkerUpdateWithBodyWithResponse(ctx context.Context, worker openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error) {
+	rsp, err := c.WorkerUpdateWithBody(ctx, worker, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWorkerUpdateResponse(rsp)
 }
 
-type WorkflowVersionGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkflowVersion
-	JSON400      *APIErrors
-	JSON403      *APIErrors
-	JSON404      *APIErrors
+func (c *ClientWithResponses) WorkerUpdateWithResponse(ctx context.Context, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error) {
+	rsp, err := c.WorkerUpdate(ctx, worker, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWorkerUpdateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkflowVersionGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WorkflowDeleteWithResponse request returning *WorkflowDeleteResponse
+func (c *ClientWithResponses) WorkflowDeleteWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowDeleteResponse, error) {
+	rsp, err := c.WorkflowDelete(ctx, workflow, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkflowDeleteResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkflowVersionGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WorkflowGetWithResponse request returning *WorkflowGetResponse
+func (c *ClientWithResponses) WorkflowGetWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetResponse, error) {
+	rsp, err := c.WorkflowGet(ctx, workflow, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWorkflowGetResponse(rsp)
 }
 
-// LivenessGetWithResponse request returning *LivenessGetResponse
-func (c *ClientWithResponses) LivenessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LivenessGetResponse, error) {
-	rsp, err := c.LivenessGet(ctx, reqEditors...)
+// WorkflowUpdateWithBodyWithResponse request with arbitrary body returning *WorkflowUpdateResponse
+func (c *ClientWithResponses) WorkflowUpdateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error) {
+	rsp, err := c.WorkflowUpdateWithBody(ctx, workflow, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseLivenessGetResponse(rsp)
+	return ParseWorkflowUpdateResponse(rsp)
 }
 
-// ReadinessGetWithResponse request returning *ReadinessGetResponse
-func (c *ClientWithResponses) ReadinessGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadinessGetResponse, error) {
-	rsp, err := c.ReadinessGet(ctx, reqEditors...)
+func (c *ClientWithResponses) WorkflowUpdateWithResponse(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error) {
+	rsp, err := c.WorkflowUpdate(ctx, workflow, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseReadinessGetResponse(rsp)
+	return ParseWorkflowUpdateResponse(rsp)
 }
 
-// AlertEmailGroupDeleteWithResponse request returning *AlertEmailGroupDeleteResponse
-func (c *ClientWithResponses) AlertEmailGroupDeleteWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupDeleteResponse, error) {
-	rsp, err := c.AlertEmailGroupDelete(ctx, alertEmailGroup, reqEditors...)
+// WorkflowListConcurrencyGroupsWithResponse request returning *WorkflowListConcurrencyGroupsResponse
+func (c *ClientWithResponses) WorkflowListConcurrencyGroupsWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowListConcurrencyGroupsParams, reqEditors ...RequestEditorFn) (*WorkflowListConcurrencyGroupsResponse, error) {
+	rsp, err := c.WorkflowListConcurrencyGroups(ctx, workflow, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAlertEmailGroupDeleteResponse(rsp)
+	return ParseWorkflowListConcurrencyGroupsResponse(rsp)
 }
 
-// AlertEmailGroupUpdateWithBodyWithResponse request with arbitrary body returning *AlertEmailGroupUpdateResponse
-func (c *ClientWithResponses) AlertEmailGroupUpdateWithBodyWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error) {
-	rsp, err := c.AlertEmailGroupUpdateWithBody(ctx, alertEmailGroup, contentType, body, reqEditors...)
+// WorkflowGetMetricsWithResponse request returning *WorkflowGetMetricsResponse
+func (c *ClientWithResponses) WorkflowGetMetricsWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowGetMetricsResponse, error) {
+	rsp, err := c.WorkflowGetMetrics(ctx, workflow, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAlertEmailGroupUpdateResponse(rsp)
+	return ParseWorkflowGetMetricsResponse(rsp)
 }
 
-func (c *ClientWithResponses) AlertEmailGroupUpdateWithResponse(ctx context.Context, alertEmailGroup openapi_types.UUID, body AlertEmailGroupUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupUpdateResponse, error) {
-	rsp, err := c.AlertEmailGroupUpdate(ctx, alertEmailGroup, body, reqEditors...)
+// WorkflowDeleteRolloutWithResponse request returning *WorkflowDeleteRolloutResponse
+func (c *ClientWithResponses) WorkflowDeleteRolloutWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowDeleteRolloutResponse, error) {
+	rsp, err := c.WorkflowDeleteRollout(ctx, workflow, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAlertEmailGroupUpdateResponse(rsp)
+	return ParseWorkflowDeleteRolloutResponse(rsp)
 }
 
-// ApiTokenUpdateRevokeWithResponse request returning *ApiTokenUpdateRevokeResponse
-func (c *ClientWithResponses) ApiTokenUpdateRevokeWithResponse(ctx context.Context, apiToken openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenUpdateRevokeResponse, error) {
-	rsp, err := c.ApiTokenUpdateRevoke(ctx, apiToken, reqEditors...)
+// WorkflowGetRolloutWithResponse request returning *WorkflowGetRolloutResponse
+func (c *ClientWithResponses) WorkflowGetRolloutWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetRolloutResponse, error) {
+	rsp, err := c.WorkflowGetRollout(ctx, workflow, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseApiTokenUpdateRevokeResponse(rsp)
+	return ParseWorkflowGetRolloutResponse(rsp)
 }
 
-// CloudMetadataGetWithResponse request returning *CloudMetadataGetResponse
-func (c *ClientWithResponses) CloudMetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CloudMetadataGetResponse, error) {
-	rsp, err := c.CloudMetadataGet(ctx, reqEditors...)
+// WorkflowUpdateRolloutWithBodyWithResponse request with arbitrary body returning *WorkflowUpdateRolloutResponse
+func (c *ClientWithResponses) WorkflowUpdateRolloutWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowUpdateRolloutResponse, error) {
+	rsp, err := c.WorkflowUpdateRolloutWithBody(ctx, workflow, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCloudMetadataGetResponse(rsp)
+	return ParseWorkflowUpdateRolloutResponse(rsp)
 }
 
-// EventGetWithResponse request returning *EventGetResponse
-func (c *ClientWithResponses) EventGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventGetResponse, error) {
-	rsp, err := c.EventGet(ctx, event, reqEditors...)
+func (c *ClientWithResponses) WorkflowUpdateRolloutWithResponse(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateRolloutJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowUpdateRolloutResponse, error) {
+	rsp, err := c.WorkflowUpdateRollout(ctx, workflow, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventGetResponse(rsp)
+	return ParseWorkflowUpdateRolloutResponse(rsp)
 }
 
-// EventDataGetWithResponse request returning *EventDataGetResponse
-func (c *ClientWithResponses) EventDataGetWithResponse(ctx context.Context, event openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventDataGetResponse, error) {
-	rsp, err := c.EventDataGet(ctx, event, reqEditors...)
+// WorkflowRunCreateWithBodyWithResponse request with arbitrary body returning *WorkflowRunCreateResponse
+func (c *ClientWithResponses) WorkflowRunCreateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error) {
+	rsp, err := c.WorkflowRunCreateWithBody(ctx, workflow, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventDataGetResponse(rsp)
+	return ParseWorkflowRunCreateResponse(rsp)
 }
 
-// MetadataGetWithResponse request returning *MetadataGetResponse
-func (c *ClientWithResponses) MetadataGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataGetResponse, error) {
-	rsp, err := c.MetadataGet(ctx, reqEditors...)
+func (c *ClientWithResponses) WorkflowRunCreateWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error) {
+	rsp, err := c.WorkflowRunCreate(ctx, workflow, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseMetadataGetResponse(rsp)
+	return ParseWorkflowRunCreateResponse(rsp)
 }
 
-// MetadataListIntegrationsWithResponse request returning *MetadataListIntegrationsResponse
-func (c *ClientWithResponses) MetadataListIntegrationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*MetadataListIntegrationsResponse, error) {
-	rsp, err := c.MetadataListIntegrations(ctx, reqEditors...)
+// WorkflowVersionGetWithResponse request returning *WorkflowVersionGetResponse
+func (c *ClientWithResponses) WorkflowVersionGetWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionGetParams, reqEditors ...RequestEditorFn) (*WorkflowVersionGetResponse, error) {
+	rsp, err := c.WorkflowVersionGet(ctx, workflow, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseMetadataListIntegrationsResponse(rsp)
+	return ParseWorkflowVersionGetResponse(rsp)
 }
 
-// SlackWebhookDeleteWithResponse request returning *SlackWebhookDeleteResponse
-func (c *ClientWithResponses) SlackWebhookDeleteWithResponse(ctx context.Context, slack openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookDeleteResponse, error) {
-	rsp, err := c.SlackWebhookDelete(ctx, slack, reqEditors...)
+// ParseHealthzGetResponse parses an HTTP response from a HealthzGetWithResponse call
+func ParseHealthzGetResponse(rsp *http.Response) (*HealthzGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSlackWebhookDeleteResponse(rsp)
+
+	response := &HealthzGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HealthReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
 }
 
-// SnsDeleteWithResponse request returning *SnsDeleteResponse
-func (c *ClientWithResponses) SnsDeleteWithResponse(ctx context.Context, sns openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsDeleteResponse, error) {
-	rsp, err := c.SnsDelete(ctx, sns, reqEditors...)
+// ParseLivenessGetResponse parses an HTTP response from a LivenessGetWithResponse call
+func ParseLivenessGetResponse(rsp *http.Response) (*LivenessGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSnsDeleteResponse(rsp)
+
+	response := &LivenessGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// SnsUpdateWithResponse request returning *SnsUpdateResponse
-func (c *ClientWithResponses) SnsUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, event string, reqEditors ...RequestEditorFn) (*SnsUpdateResponse, error) {
-	rsp, err := c.SnsUpdate(ctx, tenant, event, reqEditors...)
+// ParseReadinessGetResponse parses an HTTP response from a ReadinessGetWithResponse call
+func ParseReadinessGetResponse(rsp *http.Response) (*ReadinessGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSnsUpdateResponse(rsp)
+
+	response := &ReadinessGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// StepRunListArchivesWithResponse request returning *StepRunListArchivesResponse
-func (c *ClientWithResponses) StepRunListArchivesWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListArchivesParams, reqEditors ...RequestEditorFn) (*StepRunListArchivesResponse, error) {
-	rsp, err := c.StepRunListArchives(ctx, stepRun, params, reqEditors...)
+// ParseReadyzGetResponse parses an HTTP response from a ReadyzGetWithResponse call
+func ParseReadyzGetResponse(rsp *http.Response) (*ReadyzGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseStepRunListArchivesResponse(rsp)
+
+	response := &ReadyzGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HealthReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
 }
 
-// StepRunListEventsWithResponse request returning *StepRunListEventsResponse
-func (c *ClientWithResponses) StepRunListEventsWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *StepRunListEventsParams, reqEditors ...RequestEditorFn) (*StepRunListEventsResponse, error) {
-	rsp, err := c.StepRunListEvents(ctx, stepRun, params, reqEditors...)
+// ParseAlertEmailGroupDeleteResponse parses an HTTP response from a AlertEmailGroupDeleteWithResponse call
+func ParseAlertEmailGroupDeleteResponse(rsp *http.Response) (*AlertEmailGroupDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseStepRunListEventsResponse(rsp)
+
+	response := &AlertEmailGroupDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
 }
 
-// LogLineListWithResponse request returning *LogLineListResponse
-func (c *ClientWithResponses) LogLineListWithResponse(ctx context.Context, stepRun openapi_types.UUID, params *LogLineListParams, reqEditors ...RequestEditorFn) (*LogLineListResponse, error) {
-	rsp, err := c.LogLineList(ctx, stepRun, params, reqEditors...)
+// ParseAlertEmailGroupUpdateResponse parses an HTTP response from a AlertEmailGroupUpdateWithResponse call
+func ParseAlertEmailGroupUpdateResponse(rsp *http.Response) (*AlertEmailGroupUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseLogLineListResponse(rsp)
+
+	response := &AlertEmailGroupUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantAlertEmailGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
 }
 
-// TenantCreateWithBodyWithResponse request with arbitrary body returning *TenantCreateResponse
-func (c *ClientWithResponses) TenantCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error) {
-	rsp, err := c.TenantCreateWithBody(ctx, contentType, body, reqEditors...)
+// ParseApiTokenUpdateRevokeResponse parses an HTTP response from a ApiTokenUpdateRevokeWithResponse call
+func ParseApiTokenUpdateRevokeResponse(rsp *http.Response) (*ApiTokenUpdateRevokeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantCreateResponse(rsp)
-}
 
-func (c *ClientWithResponses) TenantCreateWithResponse(ctx context.Context, body TenantCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantCreateResponse, error) {
-	rsp, err := c.TenantCreate(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ApiTokenUpdateRevokeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseTenantCreateResponse(rsp)
-}
 
-// TenantUpdateWithBodyWithResponse request with arbitrary body returning *TenantUpdateResponse
-func (c *ClientWithResponses) TenantUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error) {
-	rsp, err := c.TenantUpdateWithBody(ctx, tenant, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseTenantUpdateResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) TenantUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantUpdateResponse, error) {
-	rsp, err := c.TenantUpdate(ctx, tenant, body, reqEditors...)
+// ParseApiTokenUpdateRotateResponse parses an HTTP response from a ApiTokenUpdateRotateWithResponse call
+func ParseApiTokenUpdateRotateResponse(rsp *http.Response) (*ApiTokenUpdateRotateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantUpdateResponse(rsp)
-}
 
-// AlertEmailGroupListWithResponse request returning *AlertEmailGroupListResponse
-func (c *ClientWithResponses) AlertEmailGroupListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*AlertEmailGroupListResponse, error) {
-	rsp, err := c.AlertEmailGroupList(ctx, tenant, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ApiTokenUpdateRotateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAlertEmailGroupListResponse(rsp)
-}
 
-// AlertEmailGroupCreateWithBodyWithResponse request with arbitrary body returning *AlertEmailGroupCreateResponse
-func (c *ClientWithResponses) AlertEmailGroupCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error) {
-	rsp, err := c.AlertEmailGroupCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateAPITokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseAlertEmailGroupCreateResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) AlertEmailGroupCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body AlertEmailGroupCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertEmailGroupCreateResponse, error) {
-	rsp, err := c.AlertEmailGroupCreate(ctx, tenant, body, reqEditors...)
+// ParseApprovalUpdateDecideWithTokenResponse parses an HTTP response from a ApprovalUpdateDecideWithTokenWithResponse call
+func ParseApprovalUpdateDecideWithTokenResponse(rsp *http.Response) (*ApprovalUpdateDecideWithTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAlertEmailGroupCreateResponse(rsp)
-}
 
-// TenantAlertingSettingsGetWithResponse request returning *TenantAlertingSettingsGetResponse
-func (c *ClientWithResponses) TenantAlertingSettingsGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantAlertingSettingsGetResponse, error) {
-	rsp, err := c.TenantAlertingSettingsGet(ctx, tenant, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ApprovalUpdateDecideWithTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseTenantAlertingSettingsGetResponse(rsp)
-}
 
-// ApiTokenListWithResponse request returning *ApiTokenListResponse
-func (c *ClientWithResponses) ApiTokenListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApiTokenListResponse, error) {
-	rsp, err := c.ApiTokenList(ctx, tenant, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseApiTokenListResponse(rsp)
+
+	return response, nil
 }
 
-// ApiTokenCreateWithBodyWithResponse request with arbitrary body returning *ApiTokenCreateResponse
-func (c *ClientWithResponses) ApiTokenCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error) {
-	rsp, err := c.ApiTokenCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+// ParseApprovalGetResponse parses an HTTP response from a ApprovalGetWithResponse call
+func ParseApprovalGetResponse(rsp *http.Response) (*ApprovalGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseApiTokenCreateResponse(rsp)
-}
 
-func (c *ClientWithResponses) ApiTokenCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body ApiTokenCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiTokenCreateResponse, error) {
-	rsp, err := c.ApiTokenCreate(ctx, tenant, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ApprovalGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseApiTokenCreateResponse(rsp)
-}
 
-// EventListWithResponse request returning *EventListResponse
-func (c *ClientWithResponses) EventListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *EventListParams, reqEditors ...RequestEditorFn) (*EventListResponse, error) {
-	rsp, err := c.EventList(ctx, tenant, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StepRunApproval
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseEventListResponse(rsp)
+
+	return response, nil
 }
 
-// EventCreateWithBodyWithResponse request with arbitrary body returning *EventCreateResponse
-func (c *ClientWithResponses) EventCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateResponse, error) {
-	rsp, err := c.EventCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
+// ParseApprovalUpdateDecideResponse parses an HTTP response from a ApprovalUpdateDecideWithResponse call
+func ParseApprovalUpdateDecideResponse(rsp *http.Response) (*ApprovalUpdateDecideResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventCreateResponse(rsp)
-}
 
-func (c *ClientWithResponses) EventCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateResponse, error) {
-	rsp, err := c.EventCreate(ctx, tenant, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ApprovalUpdateDecideResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseEventCreateResponse(rsp)
-}
 
-// EventCreateBulkWithBodyWithResponse request with arbitrary body returning *EventCreateBulkResponse
-func (c *ClientWithResponses) EventCreateBulkWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error) {
-	rsp, err := c.EventCreateBulkWithBody(ctx, tenant, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StepRunApproval
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseEventCreateBulkResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) EventCreateBulkWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventCreateBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*EventCreateBulkResponse, error) {
-	rsp, err := c.EventCreateBulk(ctx, tenant, body, reqEditors...)
+// ParseClientCaDeleteResponse parses an HTTP response from a ClientCaDeleteWithResponse call
+func ParseClientCaDeleteResponse(rsp *http.Response) (*ClientCaDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventCreateBulkResponse(rsp)
-}
 
-// EventUpdateCancelWithBodyWithResponse request with arbitrary body returning *EventUpdateCancelResponse
-func (c *ClientWithResponses) EventUpdateCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error) {
-	rsp, err := c.EventUpdateCancelWithBody(ctx, tenant, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ClientCaDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseEventUpdateCancelResponse(rsp)
-}
 
-func (c *ClientWithResponses) EventUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateCancelResponse, error) {
-	rsp, err := c.EventUpdateCancel(ctx, tenant, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseEventUpdateCancelResponse(rsp)
+
+	return response, nil
 }
 
-// EventKeyListWithResponse request returning *EventKeyListResponse
-func (c *ClientWithResponses) EventKeyListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*EventKeyListResponse, error) {
-	rsp, err := c.EventKeyList(ctx, tenant, reqEditors...)
+// ParseCloudMetadataGetResponse parses an HTTP response from a CloudMetadataGetWithResponse call
+func ParseCloudMetadataGetResponse(rsp *http.Response) (*CloudMetadataGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseEventKeyListResponse(rsp)
-}
 
-// EventUpdateReplayWithBodyWithResponse request with arbitrary body returning *EventUpdateReplayResponse
-func (c *ClientWithResponses) EventUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error) {
-	rsp, err := c.EventUpdateReplayWithBody(ctx, tenant, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CloudMetadataGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseEventUpdateReplayResponse(rsp)
-}
 
-func (c *ClientWithResponses) EventUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body EventUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*EventUpdateReplayResponse, error) {
-	rsp, err := c.EventUpdateReplay(ctx, tenant, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseEventUpdateReplayResponse(rsp)
+
+	return response, nil
 }
 
-// TenantInviteListWithResponse request returning *TenantInviteListResponse
-func (c *ClientWithResponses) TenantInviteListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteListResponse, error) {
-	rsp, err := c.TenantInviteList(ctx, tenant, reqEditors...)
+// ParseEventDedupRuleDeleteResponse parses an HTTP response from a EventDedupRuleDeleteWithResponse call
+func ParseEventDedupRuleDeleteResponse(rsp *http.Response) (*EventDedupRuleDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantInviteListResponse(rsp)
-}
 
-// TenantInviteCreateWithBodyWithResponse request with arbitrary body returning *TenantInviteCreateResponse
-func (c *ClientWithResponses) TenantInviteCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error) {
-	rsp, err := c.TenantInviteCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &EventDedupRuleDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseTenantInviteCreateResponse(rsp)
-}
 
-func (c *ClientWithResponses) TenantInviteCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body TenantInviteCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteCreateResponse, error) {
-	rsp, err := c.TenantInviteCreate(ctx, tenant, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseTenantInviteCreateResponse(rsp)
+
+	return response, nil
 }
 
-// TenantInviteDeleteWithResponse request returning *TenantInviteDeleteResponse
-func (c *ClientWithResponses) TenantInviteDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantInviteDeleteResponse, error) {
-	rsp, err := c.TenantInviteDelete(ctx, tenant, tenantInvite, reqEditors...)
+// ParseEventRoutingRuleDeleteResponse parses an HTTP response from a EventRoutingRuleDeleteWithResponse call
+func ParseEventRoutingRuleDeleteResponse(rsp *http.Response) (*EventRoutingRuleDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantInviteDeleteResponse(rsp)
-}
 
-// TenantInviteUpdateWithBodyWithResponse request with arbitrary body returning *TenantInviteUpdateResponse
-func (c *ClientWithResponses) TenantInviteUpdateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error) {
-	rsp, err := c.TenantInviteUpdateWithBody(ctx, tenant, tenantInvite, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &EventRoutingRuleDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseTenantInviteUpdateResponse(rsp)
-}
 
-func (c *ClientWithResponses) TenantInviteUpdateWithResponse(ctx context.Context, tenant openapi_types.UUID, tenantInvite openapi_types.UUID, body TenantInviteUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteUpdateResponse, error) {
-	rsp, err := c.TenantInviteUpdate(ctx, tenant, tenantInvite, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseTenantInviteUpdateResponse(rsp)
+
+	return response, nil
 }
 
-// TenantMemberListWithResponse request returning *TenantMemberListResponse
-func (c *ClientWithResponses) TenantMemberListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberListResponse, error) {
-	rsp, err := c.TenantMemberList(ctx, tenant, reqEditors...)
+// ParseEventSinkDeleteResponse parses an HTTP response from a EventSinkDeleteWithResponse call
+func ParseEventSinkDeleteResponse(rsp *http.Response) (*EventSinkDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantMemberListResponse(rsp)
-}
 
-// TenantMemberDeleteWithResponse request returning *TenantMemberDeleteResponse
-func (c *ClientWithResponses) TenantMemberDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, member openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantMemberDeleteResponse, error) {
-	rsp, err := c.TenantMemberDelete(ctx, tenant, member, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &EventSinkDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseTenantMemberDeleteResponse(rsp)
-}
 
-// TenantGetQueueMetricsWithResponse request returning *TenantGetQueueMetricsResponse
-func (c *ClientWithResponses) TenantGetQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *TenantGetQueueMetricsParams, reqEditors ...RequestEditorFn) (*TenantGetQueueMetricsResponse, error) {
-	rsp, err := c.TenantGetQueueMetrics(ctx, tenant, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseTenantGetQueueMetricsResponse(rsp)
+
+	return response, nil
 }
 
-// RateLimitListWithResponse request returning *RateLimitListResponse
-func (c *ClientWithResponses) RateLimitListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *RateLimitListParams, reqEditors ...RequestEditorFn) (*RateLimitListResponse, error) {
-	rsp, err := c.RateLimitList(ctx, tenant, params, reqEditors...)
+// ParseEventSinkReplayResponse parses an HTTP response from a EventSinkReplayWithResponse call
+func ParseEventSinkReplayResponse(rsp *http.Response) (*EventSinkReplayResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseRateLimitListResponse(rsp)
-}
 
-// TenantResourcePolicyGetWithResponse request returning *TenantResourcePolicyGetResponse
-func (c *ClientWithResponses) TenantResourcePolicyGetWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantResourcePolicyGetResponse, error) {
-	rsp, err := c.TenantResourcePolicyGet(ctx, tenant, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &EventSinkReplayResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseTenantResourcePolicyGetResponse(rsp)
-}
 
-// SlackWebhookListWithResponse request returning *SlackWebhookListResponse
-func (c *ClientWithResponses) SlackWebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SlackWebhookListResponse, error) {
-	rsp, err := c.SlackWebhookList(ctx, tenant, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventSink
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseSlackWebhookListResponse(rsp)
+
+	return response, nil
 }
 
-// UserUpdateSlackOauthStartWithResponse request returning *UserUpdateSlackOauthStartResponse
-func (c *ClientWithResponses) UserUpdateSlackOauthStartWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthStartResponse, error) {
-	rsp, err := c.UserUpdateSlackOauthStart(ctx, tenant, reqEditors...)
+// ParseEventGetResponse parses an HTTP response from a EventGetWithResponse call
+func ParseEventGetResponse(rsp *http.Response) (*EventGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserUpdateSlackOauthStartResponse(rsp)
-}
 
-// SnsListWithResponse request returning *SnsListResponse
-func (c *ClientWithResponses) SnsListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*SnsListResponse, error) {
-	rsp, err := c.SnsList(ctx, tenant, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &EventGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSnsListResponse(rsp)
-}
 
-// SnsCreateWithBodyWithResponse request with arbitrary body returning *SnsCreateResponse
-func (c *ClientWithResponses) SnsCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error) {
-	rsp, err := c.SnsCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Event
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseSnsCreateResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) SnsCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body SnsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*SnsCreateResponse, error) {
-	rsp, err := c.SnsCreate(ctx, tenant, body, reqEditors...)
+// ParseEventDataGetResponse parses an HTTP response from a EventDataGetWithResponse call
+func ParseEventDataGetResponse(rsp *http.Response) (*EventDataGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSnsCreateResponse(rsp)
-}
 
-// TenantGetStepRunQueueMetricsWithResponse request returning *TenantGetStepRunQueueMetricsResponse
-func (c *ClientWithResponses) TenantGetStepRunQueueMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*TenantGetStepRunQueueMetricsResponse, error) {
-	rsp, err := c.TenantGetStepRunQueueMetrics(ctx, tenant, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &EventDataGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseTenantGetStepRunQueueMetricsResponse(rsp)
-}
 
-// StepRunGetWithResponse request returning *StepRunGetResponse
-func (c *ClientWithResponses) StepRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetResponse, error) {
-	rsp, err := c.StepRunGet(ctx, tenant, stepRun, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventData
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseStepRunGetResponse(rsp)
+
+	return response, nil
 }
 
-// StepRunUpdateCancelWithResponse request returning *StepRunUpdateCancelResponse
-func (c *ClientWithResponses) StepRunUpdateCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunUpdateCancelResponse, error) {
-	rsp, err := c.StepRunUpdateCancel(ctx, tenant, stepRun, reqEditors...)
+// ParseInboundWebhookDeleteResponse parses an HTTP response from a InboundWebhookDeleteWithResponse call
+func ParseInboundWebhookDeleteResponse(rsp *http.Response) (*InboundWebhookDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseStepRunUpdateCancelResponse(rsp)
-}
 
-// StepRunUpdateRerunWithBodyWithResponse request with arbitrary body returning *StepRunUpdateRerunResponse
-func (c *ClientWithResponses) StepRunUpdateRerunWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error) {
-	rsp, err := c.StepRunUpdateRerunWithBody(ctx, tenant, stepRun, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &InboundWebhookDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseStepRunUpdateRerunResponse(rsp)
-}
 
-func (c *ClientWithResponses) StepRunUpdateRerunWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, body StepRunUpdateRerunJSONRequestBody, reqEditors ...RequestEditorFn) (*StepRunUpdateRerunResponse, error) {
-	rsp, err := c.StepRunUpdateRerun(ctx, tenant, stepRun, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseStepRunUpdateRerunResponse(rsp)
+
+	return response, nil
 }
 
-// StepRunGetSchemaWithResponse request returning *StepRunGetSchemaResponse
-func (c *ClientWithResponses) StepRunGetSchemaWithResponse(ctx context.Context, tenant openapi_types.UUID, stepRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*StepRunGetSchemaResponse, error) {
-	rsp, err := c.StepRunGetSchema(ctx, tenant, stepRun, reqEditors...)
+// ParseInboundWebhookReceiveResponse parses an HTTP response from a InboundWebhookReceiveWithResponse call
+func ParseInboundWebhookReceiveResponse(rsp *http.Response) (*InboundWebhookReceiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseStepRunGetSchemaResponse(rsp)
-}
 
-// WebhookListWithResponse request returning *WebhookListResponse
-func (c *ClientWithResponses) WebhookListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookListResponse, error) {
-	rsp, err := c.WebhookList(ctx, tenant, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &InboundWebhookReceiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWebhookListResponse(rsp)
-}
 
-// WebhookCreateWithBodyWithResponse request with arbitrary body returning *WebhookCreateResponse
-func (c *ClientWithResponses) WebhookCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error) {
-	rsp, err := c.WebhookCreateWithBody(ctx, tenant, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseWebhookCreateResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) WebhookCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, body WebhookCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhookCreateResponse, error) {
-	rsp, err := c.WebhookCreate(ctx, tenant, body, reqEditors...)
+// ParseMetadataGetResponse parses an HTTP response from a MetadataGetWithResponse call
+func ParseMetadataGetResponse(rsp *http.Response) (*MetadataGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWebhookCreateResponse(rsp)
-}
 
-// WorkerListWithResponse request returning *WorkerListResponse
-func (c *ClientWithResponses) WorkerListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerListResponse, error) {
-	rsp, err := c.WorkerList(ctx, tenant, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &MetadataGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkerListResponse(rsp)
-}
 
-// WorkflowRunUpdateReplayWithBodyWithResponse request with arbitrary body returning *WorkflowRunUpdateReplayResponse
-func (c *ClientWithResponses) WorkflowRunUpdateReplayWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error) {
-	rsp, err := c.WorkflowRunUpdateReplayWithBody(ctx, tenant, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest APIMeta
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseWorkflowRunUpdateReplayResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) WorkflowRunUpdateReplayWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunUpdateReplayJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunUpdateReplayResponse, error) {
-	rsp, err := c.WorkflowRunUpdateReplay(ctx, tenant, body, reqEditors...)
+// ParseMetadataListIntegrationsResponse parses an HTTP response from a MetadataListIntegrationsWithResponse call
+func ParseMetadataListIntegrationsResponse(rsp *http.Response) (*MetadataListIntegrationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowRunUpdateReplayResponse(rsp)
-}
 
-// WorkflowRunGetWithResponse request returning *WorkflowRunGetResponse
-func (c *ClientWithResponses) WorkflowRunGetWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetResponse, error) {
-	rsp, err := c.WorkflowRunGet(ctx, tenant, workflowRun, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &MetadataListIntegrationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkflowRunGetResponse(rsp)
-}
 
-// WorkflowRunGetInputWithResponse request returning *WorkflowRunGetInputResponse
-func (c *ClientWithResponses) WorkflowRunGetInputWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetInputResponse, error) {
-	rsp, err := c.WorkflowRunGetInput(ctx, tenant, workflowRun, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListAPIMetaIntegration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseWorkflowRunGetInputResponse(rsp)
+
+	return response, nil
 }
 
-// WorkflowRunGetShapeWithResponse request returning *WorkflowRunGetShapeResponse
-func (c *ClientWithResponses) WorkflowRunGetShapeWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowRunGetShapeResponse, error) {
-	rsp, err := c.WorkflowRunGetShape(ctx, tenant, workflowRun, reqEditors...)
+// ParseSlackWebhookDeleteResponse parses an HTTP response from a SlackWebhookDeleteWithResponse call
+func ParseSlackWebhookDeleteResponse(rsp *http.Response) (*SlackWebhookDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowRunGetShapeResponse(rsp)
-}
 
-// WorkflowRunListStepRunEventsWithResponse request returning *WorkflowRunListStepRunEventsResponse
-func (c *ClientWithResponses) WorkflowRunListStepRunEventsWithResponse(ctx context.Context, tenant openapi_types.UUID, workflowRun openapi_types.UUID, params *WorkflowRunListStepRunEventsParams, reqEditors ...RequestEditorFn) (*WorkflowRunListStepRunEventsResponse, error) {
-	rsp, err := c.WorkflowRunListStepRunEvents(ctx, tenant, workflowRun, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SlackWebhookDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkflowRunListStepRunEventsResponse(rsp)
-}
 
-// WorkflowListWithResponse request returning *WorkflowListResponse
-func (c *ClientWithResponses) WorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowListResponse, error) {
-	rsp, err := c.WorkflowList(ctx, tenant, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
+
 	}
-	return ParseWorkflowListResponse(rsp)
-}
 
-// WorkflowRunCancelWithBodyWithResponse request with arbitrary body returning *WorkflowRunCancelResponse
-func (c *ClientWithResponses) WorkflowRunCancelWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error) {
-	rsp, err := c.WorkflowRunCancelWithBody(ctx, tenant, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseWorkflowRunCancelResponse(rsp)
+	return response, nil
 }
 
-func (c *ClientWithResponses) WorkflowRunCancelWithResponse(ctx context.Context, tenant openapi_types.UUID, body WorkflowRunCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCancelResponse, error) {
-	rsp, err := c.WorkflowRunCancel(ctx, tenant, body, reqEditors...)
+// ParseSnsDeleteResponse parses an HTTP response from a SnsDeleteWithResponse call
+func ParseSnsDeleteResponse(rsp *http.Response) (*SnsDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowRunCancelResponse(rsp)
-}
 
-// CronWorkflowListWithResponse request returning *CronWorkflowListResponse
-func (c *ClientWithResponses) CronWorkflowListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *CronWorkflowListParams, reqEditors ...RequestEditorFn) (*CronWorkflowListResponse, error) {
-	rsp, err := c.CronWorkflowList(ctx, tenant, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SnsDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCronWorkflowListResponse(rsp)
-}
 
-// WorkflowCronDeleteWithResponse request returning *WorkflowCronDeleteResponse
-func (c *ClientWithResponses) WorkflowCronDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronDeleteResponse, error) {
-	rsp, err := c.WorkflowCronDelete(ctx, tenant, cronWorkflow, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
+
 	}
-	return ParseWorkflowCronDeleteResponse(rsp)
+
+	return response, nil
 }
 
-// WorkflowCronGetWithResponse request returning *WorkflowCronGetResponse
-func (c *ClientWithResponses) WorkflowCronGetWithResponse(ctx context.Context, tenant openapi_types.UUID, cronWorkflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowCronGetResponse, error) {
-	rsp, err := c.WorkflowCronGet(ctx, tenant, cronWorkflow, reqEditors...)
+// ParseSnsUpdateResponse parses an HTTP response from a SnsUpdateWithResponse call
+func ParseSnsUpdateResponse(rsp *http.Response) (*SnsUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowCronGetResponse(rsp)
-}
 
-// WorkflowRunListWithResponse request returning *WorkflowRunListResponse
-func (c *ClientWithResponses) WorkflowRunListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunListParams, reqEditors ...RequestEditorFn) (*WorkflowRunListResponse, error) {
-	rsp, err := c.WorkflowRunList(ctx, tenant, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SnsUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkflowRunListResponse(rsp)
-}
 
-// WorkflowRunGetMetricsWithResponse request returning *WorkflowRunGetMetricsResponse
-func (c *ClientWithResponses) WorkflowRunGetMetricsWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowRunGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowRunGetMetricsResponse, error) {
-	rsp, err := c.WorkflowRunGetMetrics(ctx, tenant, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
+
 	}
-	return ParseWorkflowRunGetMetricsResponse(rsp)
+
+	return response, nil
 }
 
-// WorkflowScheduledListWithResponse request returning *WorkflowScheduledListResponse
-func (c *ClientWithResponses) WorkflowScheduledListWithResponse(ctx context.Context, tenant openapi_types.UUID, params *WorkflowScheduledListParams, reqEditors ...RequestEditorFn) (*WorkflowScheduledListResponse, error) {
-	rsp, err := c.WorkflowScheduledList(ctx, tenant, params, reqEditors...)
+// ParseSqsDeleteResponse parses an HTTP response from a SqsDeleteWithResponse call
+func ParseSqsDeleteResponse(rsp *http.Response) (*SqsDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowScheduledListResponse(rsp)
-}
 
-// WorkflowScheduledDeleteWithResponse request returning *WorkflowScheduledDeleteResponse
-func (c *ClientWithResponses) WorkflowScheduledDeleteWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledDeleteResponse, error) {
-	rsp, err := c.WorkflowScheduledDelete(ctx, tenant, scheduledWorkflowRun, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SqsDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkflowScheduledDeleteResponse(rsp)
-}
 
-// WorkflowScheduledGetWithResponse request returning *WorkflowScheduledGetResponse
-func (c *ClientWithResponses) WorkflowScheduledGetWithResponse(ctx context.Context, tenant openapi_types.UUID, scheduledWorkflowRun openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowScheduledGetResponse, error) {
-	rsp, err := c.WorkflowScheduledGet(ctx, tenant, scheduledWorkflowRun, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
+
 	}
-	return ParseWorkflowScheduledGetResponse(rsp)
+
+	return response, nil
 }
 
-// CronWorkflowTriggerCreateWithBodyWithResponse request with arbitrary body returning *CronWorkflowTriggerCreateResponse
-func (c *ClientWithResponses) CronWorkflowTriggerCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error) {
-	rsp, err := c.CronWorkflowTriggerCreateWithBody(ctx, tenant, workflow, contentType, body, reqEditors...)
+// ParseStepRunListArchivesResponse parses an HTTP response from a StepRunListArchivesWithResponse call
+func ParseStepRunListArchivesResponse(rsp *http.Response) (*StepRunListArchivesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCronWorkflowTriggerCreateResponse(rsp)
-}
 
-func (c *ClientWithResponses) CronWorkflowTriggerCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body CronWorkflowTriggerCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CronWorkflowTriggerCreateResponse, error) {
-	rsp, err := c.CronWorkflowTriggerCreate(ctx, tenant, workflow, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &StepRunListArchivesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCronWorkflowTriggerCreateResponse(rsp)
-}
 
-// ScheduledWorkflowRunCreateWithBodyWithResponse request with arbitrary body returning *ScheduledWorkflowRunCreateResponse
-func (c *ClientWithResponses) ScheduledWorkflowRunCreateWithBodyWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error) {
-	rsp, err := c.ScheduledWorkflowRunCreateWithBody(ctx, tenant, workflow, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StepRunArchiveList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseScheduledWorkflowRunCreateResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) ScheduledWorkflowRunCreateWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow string, body ScheduledWorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ScheduledWorkflowRunCreateResponse, error) {
-	rsp, err := c.ScheduledWorkflowRunCreate(ctx, tenant, workflow, body, reqEditors...)
+// ParseStepRunListEventsResponse parses an HTTP response from a StepRunListEventsWithResponse call
+func ParseStepRunListEventsResponse(rsp *http.Response) (*StepRunListEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseScheduledWorkflowRunCreateResponse(rsp)
-}
 
-// WorkflowGetWorkersCountWithResponse request returning *WorkflowGetWorkersCountResponse
-func (c *ClientWithResponses) WorkflowGetWorkersCountWithResponse(ctx context.Context, tenant openapi_types.UUID, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetWorkersCountResponse, error) {
-	rsp, err := c.WorkflowGetWorkersCount(ctx, tenant, workflow, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &StepRunListEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkflowGetWorkersCountResponse(rsp)
-}
 
-// UserGetCurrentWithResponse request returning *UserGetCurrentResponse
-func (c *ClientWithResponses) UserGetCurrentWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserGetCurrentResponse, error) {
-	rsp, err := c.UserGetCurrent(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StepRunEventList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseUserGetCurrentResponse(rsp)
-}
 
-// UserUpdateGithubOauthCallbackWithResponse request returning *UserUpdateGithubOauthCallbackResponse
-func (c *ClientWithResponses) UserUpdateGithubOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthCallbackResponse, error) {
-	rsp, err := c.UserUpdateGithubOauthCallback(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUserUpdateGithubOauthCallbackResponse(rsp)
+	return response, nil
 }
 
-// UserUpdateGithubOauthStartWithResponse request returning *UserUpdateGithubOauthStartResponse
-func (c *ClientWithResponses) UserUpdateGithubOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGithubOauthStartResponse, error) {
-	rsp, err := c.UserUpdateGithubOauthStart(ctx, reqEditors...)
+// ParseLogLineListResponse parses an HTTP response from a LogLineListWithResponse call
+func ParseLogLineListResponse(rsp *http.Response) (*LogLineListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserUpdateGithubOauthStartResponse(rsp)
-}
 
-// UserUpdateGoogleOauthCallbackWithResponse request returning *UserUpdateGoogleOauthCallbackResponse
-func (c *ClientWithResponses) UserUpdateGoogleOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthCallbackResponse, error) {
-	rsp, err := c.UserUpdateGoogleOauthCallback(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &LogLineListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserUpdateGoogleOauthCallbackResponse(rsp)
-}
 
-// UserUpdateGoogleOauthStartWithResponse request returning *UserUpdateGoogleOauthStartResponse
-func (c *ClientWithResponses) UserUpdateGoogleOauthStartWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateGoogleOauthStartResponse, error) {
-	rsp, err := c.UserUpdateGoogleOauthStart(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUserUpdateGoogleOauthStartResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LogLineList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// UserListTenantInvitesWithResponse request returning *UserListTenantInvitesResponse
-func (c *ClientWithResponses) UserListTenantInvitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserListTenantInvitesResponse, error) {
-	rsp, err := c.UserListTenantInvites(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUserListTenantInvitesResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-// TenantInviteAcceptWithBodyWithResponse request with arbitrary body returning *TenantInviteAcceptResponse
-func (c *ClientWithResponses) TenantInviteAcceptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error) {
-	rsp, err := c.TenantInviteAcceptWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseTenantInviteAcceptResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) TenantInviteAcceptWithResponse(ctx context.Context, body TenantInviteAcceptJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteAcceptResponse, error) {
-	rsp, err := c.TenantInviteAccept(ctx, body, reqEditors...)
+// ParseLogLineStreamResponse parses an HTTP response from a LogLineStreamWithResponse call
+func ParseLogLineStreamResponse(rsp *http.Response) (*LogLineStreamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantInviteAcceptResponse(rsp)
-}
 
-// TenantInviteRejectWithBodyWithResponse request with arbitrary body returning *TenantInviteRejectResponse
-func (c *ClientWithResponses) TenantInviteRejectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error) {
-	rsp, err := c.TenantInviteRejectWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &LogLineStreamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseTenantInviteRejectResponse(rsp)
-}
 
-func (c *ClientWithResponses) TenantInviteRejectWithResponse(ctx context.Context, body TenantInviteRejectJSONRequestBody, reqEditors ...RequestEditorFn) (*TenantInviteRejectResponse, error) {
-	rsp, err := c.TenantInviteReject(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseTenantInviteRejectResponse(rsp)
+
+	return response, nil
 }
 
-// UserUpdateLoginWithBodyWithResponse request with arbitrary body returning *UserUpdateLoginResponse
-func (c *ClientWithResponses) UserUpdateLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error) {
-	rsp, err := c.UserUpdateLoginWithBody(ctx, contentType, body, reqEditors...)
+// ParseStepRunListSchedulingDecisionsResponse parses an HTTP response from a StepRunListSchedulingDecisionsWithResponse call
+func ParseStepRunListSchedulingDecisionsResponse(rsp *http.Response) (*StepRunListSchedulingDecisionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserUpdateLoginResponse(rsp)
-}
 
-func (c *ClientWithResponses) UserUpdateLoginWithResponse(ctx context.Context, body UserUpdateLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdateLoginResponse, error) {
-	rsp, err := c.UserUpdateLogin(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &StepRunListSchedulingDecisionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserUpdateLoginResponse(rsp)
-}
 
-// UserUpdateLogoutWithResponse request returning *UserUpdateLogoutResponse
-func (c *ClientWithResponses) UserUpdateLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateLogoutResponse, error) {
-	rsp, err := c.UserUpdateLogout(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchedulingDecisionList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseUserUpdateLogoutResponse(rsp)
+
+	return response, nil
 }
 
-// TenantMembershipsListWithResponse request returning *TenantMembershipsListResponse
-func (c *ClientWithResponses) TenantMembershipsListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TenantMembershipsListResponse, error) {
-	rsp, err := c.TenantMembershipsList(ctx, reqEditors...)
+// ParseTenantCreateResponse parses an HTTP response from a TenantCreateWithResponse call
+func ParseTenantCreateResponse(rsp *http.Response) (*TenantCreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseTenantMembershipsListResponse(rsp)
-}
 
-// UserUpdatePasswordWithBodyWithResponse request with arbitrary body returning *UserUpdatePasswordResponse
-func (c *ClientWithResponses) UserUpdatePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error) {
-	rsp, err := c.UserUpdatePasswordWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &TenantCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserUpdatePasswordResponse(rsp)
-}
 
-func (c *ClientWithResponses) UserUpdatePasswordWithResponse(ctx context.Context, body UserUpdatePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UserUpdatePasswordResponse, error) {
-	rsp, err := c.UserUpdatePassword(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Tenant
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseUserUpdatePasswordResponse(rsp)
+
+	return response, nil
 }
 
-// UserCreateWithBodyWithResponse request with arbitrary body returning *UserCreateResponse
-func (c *ClientWithResponses) UserCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UserCreateResponse, error) {
-	rsp, err := c.UserCreateWithBody(ctx, contentType, body, reqEditors...)
+// ParseTenantUpdateResponse parses an HTTP response from a TenantUpdateWithResponse call
+func ParseTenantUpdateResponse(rsp *http.Response) (*TenantUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUserCreateResponse(rsp)
-}
 
-func (c *ClientWithResponses) UserCreateWithResponse(ctx context.Context, body UserCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*UserCreateResponse, error) {
-	rsp, err := c.UserCreate(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &TenantUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUserCreateResponse(rsp)
-}
 
-// UserUpdateSlackOauthCallbackWithResponse request returning *UserUpdateSlackOauthCallbackResponse
-func (c *ClientWithResponses) UserUpdateSlackOauthCallbackWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*UserUpdateSlackOauthCallbackResponse, error) {
-	rsp, err := c.UserUpdateSlackOauthCallback(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Tenant
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseUserUpdateSlackOauthCallbackResponse(rsp)
-}
 
-// WebhookDeleteWithResponse request returning *WebhookDeleteResponse
-func (c *ClientWithResponses) WebhookDeleteWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookDeleteResponse, error) {
-	rsp, err := c.WebhookDelete(ctx, webhook, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseWebhookDeleteResponse(rsp)
+	return response, nil
 }
 
-// WebhookRequestsListWithResponse request returning *WebhookRequestsListResponse
-func (c *ClientWithResponses) WebhookRequestsListWithResponse(ctx context.Context, webhook openapi_types.UUID, reqEditors ...RequestEditorFn) (*WebhookRequestsListResponse, error) {
-	rsp, err := c.WebhookRequestsList(ctx, webhook, reqEditors...)
+// ParseAlertEmailGroupListResponse parses an HTTP response from a AlertEmailGroupListWithResponse call
+func ParseAlertEmailGroupListResponse(rsp *http.Response) (*AlertEmailGroupListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWebhookRequestsListResponse(rsp)
-}
 
-// WorkerGetWithResponse request returning *WorkerGetResponse
-func (c *ClientWithResponses) WorkerGetWithResponse(ctx context.Context, worker openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkerGetResponse, error) {
-	rsp, err := c.WorkerGet(ctx, worker, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AlertEmailGroupListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkerGetResponse(rsp)
-}
 
-// WorkerUpdateWithBodyWithResponse request with arbitrary body returning *WorkerUpdateResponse
-func (c *ClientWithResponses) WorkerUpdateWithBodyWithResponse(ctx context.Context, worker openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error) {
-	rsp, err := c.WorkerUpdateWithBody(ctx, worker, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseWorkerUpdateResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantAlertEmailGroupList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-func (c *ClientWithResponses) WorkerUpdateWithResponse(ctx context.Context, worker openapi_types.UUID, body WorkerUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerUpdateResponse, error) {
-	rsp, err := c.WorkerUpdate(ctx, worker, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseWorkerUpdateResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// WorkflowDeleteWithResponse request returning *WorkflowDeleteResponse
-func (c *ClientWithResponses) WorkflowDeleteWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowDeleteResponse, error) {
-	rsp, err := c.WorkflowDelete(ctx, workflow, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseWorkflowDeleteResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-// WorkflowGetWithResponse request returning *WorkflowGetResponse
-func (c *ClientWithResponses) WorkflowGetWithResponse(ctx context.Context, workflow openapi_types.UUID, reqEditors ...RequestEditorFn) (*WorkflowGetResponse, error) {
-	rsp, err := c.WorkflowGet(ctx, workflow, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseWorkflowGetResponse(rsp)
+
+	return response, nil
 }
 
-// WorkflowUpdateWithBodyWithResponse request with arbitrary body returning *WorkflowUpdateResponse
-func (c *ClientWithResponses) WorkflowUpdateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error) {
-	rsp, err := c.WorkflowUpdateWithBody(ctx, workflow, contentType, body, reqEditors...)
+// ParseAlertEmailGroupCreateResponse parses an HTTP response from a AlertEmailGroupCreateWithResponse call
+func ParseAlertEmailGroupCreateResponse(rsp *http.Response) (*AlertEmailGroupCreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowUpdateResponse(rsp)
-}
 
-func (c *ClientWithResponses) WorkflowUpdateWithResponse(ctx context.Context, workflow openapi_types.UUID, body WorkflowUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowUpdateResponse, error) {
-	rsp, err := c.WorkflowUpdate(ctx, workflow, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AlertEmailGroupCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkflowUpdateResponse(rsp)
-}
 
-// WorkflowGetMetricsWithResponse request returning *WorkflowGetMetricsResponse
-func (c *ClientWithResponses) WorkflowGetMetricsWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowGetMetricsParams, reqEditors ...RequestEditorFn) (*WorkflowGetMetricsResponse, error) {
-	rsp, err := c.WorkflowGetMetrics(ctx, workflow, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TenantAlertEmailGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseWorkflowGetMetricsResponse(rsp)
+
+	return response, nil
 }
 
-// WorkflowRunCreateWithBodyWithResponse request with arbitrary body returning *WorkflowRunCreateResponse
-func (c *ClientWithResponses) WorkflowRunCreateWithBodyWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error) {
-	rsp, err := c.WorkflowRunCreateWithBody(ctx, workflow, params, contentType, body, reqEditors...)
+// ParseTenantAlertingSettingsGetResponse parses an HTTP response from a TenantAlertingSettingsGetWithResponse call
+func ParseTenantAlertingSettingsGetResponse(rsp *http.Response) (*TenantAlertingSettingsGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkflowRunCreateResponse(rsp)
-}
 
-func (c *ClientWithResponses) WorkflowRunCreateWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowRunCreateParams, body WorkflowRunCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkflowRunCreateResponse, error) {
-	rsp, err := c.WorkflowRunCreate(ctx, workflow, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &TenantAlertingSettingsGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkflowRunCreateResponse(rsp)
-}
 
-// WorkflowVersionGetWithResponse request returning *WorkflowVersionGetResponse
-func (c *ClientWithResponses) WorkflowVersionGetWithResponse(ctx context.Context, workflow openapi_types.UUID, params *WorkflowVersionGetParams, reqEditors ...RequestEditorFn) (*WorkflowVersionGetResponse, error) {
-	rsp, err := c.WorkflowVersionGet(ctx, workflow, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantAlertingSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseWorkflowVersionGetResponse(rsp)
+
+	return response, nil
 }
 
-// ParseLivenessGetResponse parses an HTTP response from a LivenessGetWithResponse call
-func ParseLivenessGetResponse(rsp *http.Response) (*LivenessGetResponse, error) {
+// ParseApiTokenListResponse parses an HTTP response from a ApiTokenListWithResponse call
+func ParseApiTokenListResponse(rsp *http.Response) (*ApiTokenListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &LivenessGetResponse{
+	response := &ApiTokenListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListAPITokensResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseReadinessGetResponse parses an HTTP response from a ReadinessGetWithResponse call
-func ParseReadinessGetResponse(rsp *http.Response) (*ReadinessGetResponse, error) {
+// ParseApiTokenCreateResponse parses an HTTP response from a ApiTokenCreateWithResponse call
+func ParseApiTokenCreateResponse(rsp *http.Response) (*ApiTokenCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ReadinessGetResponse{
+	response := &ApiTokenCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateAPITokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseAlertEmailGroupDeleteResponse parses an HTTP response from a AlertEmailGroupDeleteWithResponse call
-func ParseAlertEmailGroupDeleteResponse(rsp *http.Response) (*AlertEmailGroupDeleteResponse, error) {
+// ParseApprovalListResponse parses an HTTP response from a ApprovalListWithResponse call
+func ParseApprovalListResponse(rsp *http.Response) (*ApprovalListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AlertEmailGroupDeleteResponse{
+	response := &ApprovalListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StepRunApprovalList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -11937,7 +20133,7 @@ func ParseAlertEmailGroupDeleteResponse(rsp *http.Response) (*AlertEmailGroupDel
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11948,22 +20144,22 @@ func ParseAlertEmailGroupDeleteResponse(rsp *http.Response) (*AlertEmailGroupDel
 	return response, nil
 }
 
-// ParseAlertEmailGroupUpdateResponse parses an HTTP response from a AlertEmailGroupUpdateWithResponse call
-func ParseAlertEmailGroupUpdateResponse(rsp *http.Response) (*AlertEmailGroupUpdateResponse, error) {
+// ParseAuditLogListResponse parses an HTTP response from a AuditLogListWithResponse call
+func ParseAuditLogListResponse(rsp *http.Response) (*AuditLogListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AlertEmailGroupUpdateResponse{
+	response := &AuditLogListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantAlertEmailGroup
+		var dest AuditLogList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11977,7 +20173,7 @@ func ParseAlertEmailGroupUpdateResponse(rsp *http.Response) (*AlertEmailGroupUpd
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11988,20 +20184,27 @@ func ParseAlertEmailGroupUpdateResponse(rsp *http.Response) (*AlertEmailGroupUpd
 	return response, nil
 }
 
-// ParseApiTokenUpdateRevokeResponse parses an HTTP response from a ApiTokenUpdateRevokeWithResponse call
-func ParseApiTokenUpdateRevokeResponse(rsp *http.Response) (*ApiTokenUpdateRevokeResponse, error) {
+// ParseClientCaListResponse parses an HTTP response from a ClientCaListWithResponse call
+func ParseClientCaListResponse(rsp *http.Response) (*ClientCaListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApiTokenUpdateRevokeResponse{
+	response := &ClientCaListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClientCAList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -12021,22 +20224,22 @@ func ParseApiTokenUpdateRevokeResponse(rsp *http.Response) (*ApiTokenUpdateRevok
 	return response, nil
 }
 
-// ParseCloudMetadataGetResponse parses an HTTP response from a CloudMetadataGetWithResponse call
-func ParseCloudMetadataGetResponse(rsp *http.Response) (*CloudMetadataGetResponse, error) {
+// ParseClientCaCreateResponse parses an HTTP response from a ClientCaCreateWithResponse call
+func ParseClientCaCreateResponse(rsp *http.Response) (*ClientCaCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CloudMetadataGetResponse{
+	response := &ClientCaCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest APIErrors
+		var dest ClientCA
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12049,27 +20252,34 @@ func ParseCloudMetadataGetResponse(rsp *http.Response) (*CloudMetadataGetRespons
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseEventGetResponse parses an HTTP response from a EventGetWithResponse call
-func ParseEventGetResponse(rsp *http.Response) (*EventGetResponse, error) {
+// ParseCronCalendarListResponse parses an HTTP response from a CronCalendarListWithResponse call
+func ParseCronCalendarListResponse(rsp *http.Response) (*CronCalendarListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventGetResponse{
+	response := &CronCalendarListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Event
+		var dest CronExclusionCalendarList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12094,22 +20304,22 @@ func ParseEventGetResponse(rsp *http.Response) (*EventGetResponse, error) {
 	return response, nil
 }
 
-// ParseEventDataGetResponse parses an HTTP response from a EventDataGetWithResponse call
-func ParseEventDataGetResponse(rsp *http.Response) (*EventDataGetResponse, error) {
+// ParseCronCalendarCreateResponse parses an HTTP response from a CronCalendarCreateWithResponse call
+func ParseCronCalendarCreateResponse(rsp *http.Response) (*CronCalendarCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventDataGetResponse{
+	response := &CronCalendarCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventData
+		var dest CronExclusionCalendar
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12134,55 +20344,62 @@ func ParseEventDataGetResponse(rsp *http.Response) (*EventDataGetResponse, error
 	return response, nil
 }
 
-// ParseMetadataGetResponse parses an HTTP response from a MetadataGetWithResponse call
-func ParseMetadataGetResponse(rsp *http.Response) (*MetadataGetResponse, error) {
+// ParseCronCalendarDeleteResponse parses an HTTP response from a CronCalendarDeleteWithResponse call
+func ParseCronCalendarDeleteResponse(rsp *http.Response) (*CronCalendarDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MetadataGetResponse{
+	response := &CronCalendarDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest APIMeta
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseMetadataListIntegrationsResponse parses an HTTP response from a MetadataListIntegrationsWithResponse call
-func ParseMetadataListIntegrationsResponse(rsp *http.Response) (*MetadataListIntegrationsResponse, error) {
+// ParseDeadLetterQueueListResponse parses an HTTP response from a DeadLetterQueueListWithResponse call
+func ParseDeadLetterQueueListResponse(rsp *http.Response) (*DeadLetterQueueListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MetadataListIntegrationsResponse{
+	response := &DeadLetterQueueListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListAPIMetaIntegration
+		var dest DeadLetterQueueItemList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12195,147 +20412,154 @@ func ParseMetadataListIntegrationsResponse(rsp *http.Response) (*MetadataListInt
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseSlackWebhookDeleteResponse parses an HTTP response from a SlackWebhookDeleteWithResponse call
-func ParseSlackWebhookDeleteResponse(rsp *http.Response) (*SlackWebhookDeleteResponse, error) {
+// ParseDeadLetterQueueDeleteResponse parses an HTTP response from a DeadLetterQueueDeleteWithResponse call
+func ParseDeadLetterQueueDeleteResponse(rsp *http.Response) (*DeadLetterQueueDeleteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SlackWebhookDeleteResponse{
+	response := &DeadLetterQueueDeleteResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest APIErrors
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PurgeDeadLetterQueueItemsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseSnsDeleteResponse parses an HTTP response from a SnsDeleteWithResponse call
-func ParseSnsDeleteResponse(rsp *http.Response) (*SnsDeleteResponse, error) {
+// ParseDeadLetterQueueUpdateReplayResponse parses an HTTP response from a DeadLetterQueueUpdateReplayWithResponse call
+func ParseDeadLetterQueueUpdateReplayResponse(rsp *http.Response) (*DeadLetterQueueUpdateReplayResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SnsDeleteResponse{
+	response := &DeadLetterQueueUpdateReplayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest APIErrors
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReplayDeadLetterQueueItemsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseSnsUpdateResponse parses an HTTP response from a SnsUpdateWithResponse call
-func ParseSnsUpdateResponse(rsp *http.Response) (*SnsUpdateResponse, error) {
+// ParseEventDedupRuleListResponse parses an HTTP response from a EventDedupRuleListWithResponse call
+func ParseEventDedupRuleListResponse(rsp *http.Response) (*EventDedupRuleListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SnsUpdateResponse{
+	response := &EventDedupRuleListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest APIErrors
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventDedupRuleList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON405 = &dest
+		response.JSON403 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseStepRunListArchivesResponse parses an HTTP response from a StepRunListArchivesWithResponse call
-func ParseStepRunListArchivesResponse(rsp *http.Response) (*StepRunListArchivesResponse, error) {
+// ParseEventDedupRuleUpsertResponse parses an HTTP response from a EventDedupRuleUpsertWithResponse call
+func ParseEventDedupRuleUpsertResponse(rsp *http.Response) (*EventDedupRuleUpsertResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StepRunListArchivesResponse{
+	response := &EventDedupRuleUpsertResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StepRunArchiveList
+		var dest EventDedupRule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12355,34 +20579,27 @@ func ParseStepRunListArchivesResponse(rsp *http.Response) (*StepRunListArchivesR
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseStepRunListEventsResponse parses an HTTP response from a StepRunListEventsWithResponse call
-func ParseStepRunListEventsResponse(rsp *http.Response) (*StepRunListEventsResponse, error) {
+// ParseEventRoutingRuleListResponse parses an HTTP response from a EventRoutingRuleListWithResponse call
+func ParseEventRoutingRuleListResponse(rsp *http.Response) (*EventRoutingRuleListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StepRunListEventsResponse{
+	response := &EventRoutingRuleListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StepRunEventList
+		var dest EventRoutingRuleList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12402,34 +20619,27 @@ func ParseStepRunListEventsResponse(rsp *http.Response) (*StepRunListEventsRespo
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseLogLineListResponse parses an HTTP response from a LogLineListWithResponse call
-func ParseLogLineListResponse(rsp *http.Response) (*LogLineListResponse, error) {
+// ParseEventRoutingRuleUpsertResponse parses an HTTP response from a EventRoutingRuleUpsertWithResponse call
+func ParseEventRoutingRuleUpsertResponse(rsp *http.Response) (*EventRoutingRuleUpsertResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &LogLineListResponse{
+	response := &EventRoutingRuleUpsertResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LogLineList
+		var dest EventRoutingRule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12454,22 +20664,22 @@ func ParseLogLineListResponse(rsp *http.Response) (*LogLineListResponse, error)
 	return response, nil
 }
 
-// ParseTenantCreateResponse parses an HTTP response from a TenantCreateWithResponse call
-func ParseTenantCreateResponse(rsp *http.Response) (*TenantCreateResponse, error) {
+// ParseEventSinkListResponse parses an HTTP response from a EventSinkListWithResponse call
+func ParseEventSinkListResponse(rsp *http.Response) (*EventSinkListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantCreateResponse{
+	response := &EventSinkListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Tenant
+		var dest EventSinkList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12483,7 +20693,7 @@ func ParseTenantCreateResponse(rsp *http.Response) (*TenantCreateResponse, error
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12494,22 +20704,22 @@ func ParseTenantCreateResponse(rsp *http.Response) (*TenantCreateResponse, error
 	return response, nil
 }
 
-// ParseTenantUpdateResponse parses an HTTP response from a TenantUpdateWithResponse call
-func ParseTenantUpdateResponse(rsp *http.Response) (*TenantUpdateResponse, error) {
+// ParseEventSinkCreateResponse parses an HTTP response from a EventSinkCreateWithResponse call
+func ParseEventSinkCreateResponse(rsp *http.Response) (*EventSinkCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantUpdateResponse{
+	response := &EventSinkCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Tenant
+		var dest EventSink
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12523,7 +20733,7 @@ func ParseTenantUpdateResponse(rsp *http.Response) (*TenantUpdateResponse, error
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12534,22 +20744,22 @@ func ParseTenantUpdateResponse(rsp *http.Response) (*TenantUpdateResponse, error
 	return response, nil
 }
 
-// ParseAlertEmailGroupListResponse parses an HTTP response from a AlertEmailGroupListWithResponse call
-func ParseAlertEmailGroupListResponse(rsp *http.Response) (*AlertEmailGroupListResponse, error) {
+// ParseEventListResponse parses an HTTP response from a EventListWithResponse call
+func ParseEventListResponse(rsp *http.Response) (*EventListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AlertEmailGroupListResponse{
+	response := &EventListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantAlertEmailGroupList
+		var dest EventList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12563,7 +20773,7 @@ func ParseAlertEmailGroupListResponse(rsp *http.Response) (*AlertEmailGroupListR
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12574,26 +20784,26 @@ func ParseAlertEmailGroupListResponse(rsp *http.Response) (*AlertEmailGroupListR
 	return response, nil
 }
 
-// ParseAlertEmailGroupCreateResponse parses an HTTP response from a AlertEmailGroupCreateWithResponse call
-func ParseAlertEmailGroupCreateResponse(rsp *http.Response) (*AlertEmailGroupCreateResponse, error) {
+// ParseEventCreateResponse parses an HTTP response from a EventCreateWithResponse call
+func ParseEventCreateResponse(rsp *http.Response) (*EventCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AlertEmailGroupCreateResponse{
+	response := &EventCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TenantAlertEmailGroup
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Event
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
@@ -12603,33 +20813,40 @@ func ParseAlertEmailGroupCreateResponse(rsp *http.Response) (*AlertEmailGroupCre
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseTenantAlertingSettingsGetResponse parses an HTTP response from a TenantAlertingSettingsGetWithResponse call
-func ParseTenantAlertingSettingsGetResponse(rsp *http.Response) (*TenantAlertingSettingsGetResponse, error) {
+// ParseEventCreateBatchResponse parses an HTTP response from a EventCreateBatchWithResponse call
+func ParseEventCreateBatchResponse(rsp *http.Response) (*EventCreateBatchResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TenantAlertingSettingsGetResponse{
+	response := &EventCreateBatchResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TenantAlertingSettings
+		var dest BatchCreateEventResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12643,33 +20860,40 @@ func ParseTenantAlertingSettingsGetResponse(rsp *http.Response) (*TenantAlerting
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseApiTokenListResponse parses an HTTP response from a ApiTokenListWithResponse call
-func ParseApiTokenListResponse(rsp *http.Response) (*ApiTokenListResponse, error) {
+// ParseEventCreateBulkResponse parses an HTTP response from a EventCreateBulkWithResponse call
+func ParseEventCreateBulkResponse(rsp *http.Response) (*EventCreateBulkResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApiTokenListResponse{
+	response := &EventCreateBulkResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListAPITokensResponse
+		var dest BulkCreateEventResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12689,27 +20913,36 @@ func ParseApiTokenListResponse(rsp *http.Response) (*ApiTokenListResponse, error
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseApiTokenCreateResponse parses an HTTP response from a ApiTokenCreateWithResponse call
-func ParseApiTokenCreateResponse(rsp *http.Response) (*ApiTokenCreateResponse, error) {
+// ParseEventUpdateCancelResponse parses an HTTP response from a EventUpdateCancelWithResponse call
+func ParseEventUpdateCancelResponse(rsp *http.Response) (*EventUpdateCancelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApiTokenCreateResponse{
+	response := &EventUpdateCancelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CreateAPITokenResponse
+		var dest struct {
+			WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12729,27 +20962,34 @@ func ParseApiTokenCreateResponse(rsp *http.Response) (*ApiTokenCreateResponse, e
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseEventListResponse parses an HTTP response from a EventListWithResponse call
-func ParseEventListResponse(rsp *http.Response) (*EventListResponse, error) {
+// ParseEventCreateCloudEventResponse parses an HTTP response from a EventCreateCloudEventWithResponse call
+func ParseEventCreateCloudEventResponse(rsp *http.Response) (*EventCreateCloudEventResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventListResponse{
+	response := &EventCreateCloudEventResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventList
+		var dest Event
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12769,27 +21009,34 @@ func ParseEventListResponse(rsp *http.Response) (*EventListResponse, error) {
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseEventCreateResponse parses an HTTP response from a EventCreateWithResponse call
-func ParseEventCreateResponse(rsp *http.Response) (*EventCreateResponse, error) {
+// ParseEventKeyListResponse parses an HTTP response from a EventKeyListWithResponse call
+func ParseEventKeyListResponse(rsp *http.Response) (*EventKeyListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventCreateResponse{
+	response := &EventKeyListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Event
+		var dest EventKeyList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12809,34 +21056,27 @@ func ParseEventCreateResponse(rsp *http.Response) (*EventCreateResponse, error)
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON429 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseEventCreateBulkResponse parses an HTTP response from a EventCreateBulkWithResponse call
-func ParseEventCreateBulkResponse(rsp *http.Response) (*EventCreateBulkResponse, error) {
+// ParseEventUpdateReplayResponse parses an HTTP response from a EventUpdateReplayWithResponse call
+func ParseEventUpdateReplayResponse(rsp *http.Response) (*EventUpdateReplayResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventCreateBulkResponse{
+	response := &EventUpdateReplayResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BulkCreateEventResponse
+		var dest EventList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12868,24 +21108,22 @@ func ParseEventCreateBulkResponse(rsp *http.Response) (*EventCreateBulkResponse,
 	return response, nil
 }
 
-// ParseEventUpdateCancelResponse parses an HTTP response from a EventUpdateCancelWithResponse call
-func ParseEventUpdateCancelResponse(rsp *http.Response) (*EventUpdateCancelResponse, error) {
+// ParseEventUpdateReplayRangeResponse parses an HTTP response from a EventUpdateReplayRangeWithResponse call
+func ParseEventUpdateReplayRangeResponse(rsp *http.Response) (*EventUpdateReplayRangeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventUpdateCancelResponse{
+	response := &EventUpdateReplayRangeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			WorkflowRunIds *[]openapi_types.UUID `json:"workflowRunIds,omitempty"`
-		}
+		var dest ReplayEventRangeResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12917,22 +21155,22 @@ func ParseEventUpdateCancelResponse(rsp *http.Response) (*EventUpdateCancelRespo
 	return response, nil
 }
 
-// ParseEventKeyListResponse parses an HTTP response from a EventKeyListWithResponse call
-func ParseEventKeyListResponse(rsp *http.Response) (*EventKeyListResponse, error) {
+// ParseInboundWebhookListResponse parses an HTTP response from a InboundWebhookListWithResponse call
+func ParseInboundWebhookListResponse(rsp *http.Response) (*InboundWebhookListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventKeyListResponse{
+	response := &InboundWebhookListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventKeyList
+		var dest InboundWebhookList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12957,22 +21195,22 @@ func ParseEventKeyListResponse(rsp *http.Response) (*EventKeyListResponse, error
 	return response, nil
 }
 
-// ParseEventUpdateReplayResponse parses an HTTP response from a EventUpdateReplayWithResponse call
-func ParseEventUpdateReplayResponse(rsp *http.Response) (*EventUpdateReplayResponse, error) {
+// ParseInboundWebhookCreateResponse parses an HTTP response from a InboundWebhookCreateWithResponse call
+func ParseInboundWebhookCreateResponse(rsp *http.Response) (*InboundWebhookCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EventUpdateReplayResponse{
+	response := &InboundWebhookCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EventList
+		var dest InboundWebhook
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12992,13 +21230,6 @@ func ParseEventUpdateReplayResponse(rsp *http.Response) (*EventUpdateReplayRespo
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
-		var dest APIErrors
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON429 = &dest
-
 	}
 
 	return response, nil
@@ -13284,6 +21515,140 @@ func ParseTenantGetQueueMetricsResponse(rsp *http.Response) (*TenantGetQueueMetr
 	return response, nil
 }
 
+// ParseQueueUpdatePauseResponse parses an HTTP response from a QueueUpdatePauseWithResponse call
+func ParseQueueUpdatePauseResponse(rsp *http.Response) (*QueueUpdatePauseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &QueueUpdatePauseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Queue
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseQueueUpdateResumeResponse parses an HTTP response from a QueueUpdateResumeWithResponse call
+func ParseQueueUpdateResumeResponse(rsp *http.Response) (*QueueUpdateResumeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &QueueUpdateResumeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Queue
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTenantQuotaListResponse parses an HTTP response from a TenantQuotaListWithResponse call
+func ParseTenantQuotaListResponse(rsp *http.Response) (*TenantQuotaListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TenantQuotaListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenantQuotaUsageList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseRateLimitListResponse parses an HTTP response from a RateLimitListWithResponse call
 func ParseRateLimitListResponse(rsp *http.Response) (*RateLimitListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -13352,38 +21717,148 @@ func ParseTenantResourcePolicyGetResponse(rsp *http.Response) (*TenantResourcePo
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest APIError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSlackWebhookListResponse parses an HTTP response from a SlackWebhookListWithResponse call
+func ParseSlackWebhookListResponse(rsp *http.Response) (*SlackWebhookListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SlackWebhookListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListSlackWebhooks
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON405 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUserUpdateSlackOauthStartResponse parses an HTTP response from a UserUpdateSlackOauthStartWithResponse call
+func ParseUserUpdateSlackOauthStartResponse(rsp *http.Response) (*UserUpdateSlackOauthStartResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserUpdateSlackOauthStartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseSnsListResponse parses an HTTP response from a SnsListWithResponse call
+func ParseSnsListResponse(rsp *http.Response) (*SnsListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SnsListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListSNSIntegrations
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 405:
+		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON405 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseSlackWebhookListResponse parses an HTTP response from a SlackWebhookListWithResponse call
-func ParseSlackWebhookListResponse(rsp *http.Response) (*SlackWebhookListResponse, error) {
+// ParseSnsCreateResponse parses an HTTP response from a SnsCreateWithResponse call
+func ParseSnsCreateResponse(rsp *http.Response) (*SnsCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SlackWebhookListResponse{
+	response := &SnsCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListSlackWebhooks
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SNSIntegration
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
@@ -13411,38 +21886,22 @@ func ParseSlackWebhookListResponse(rsp *http.Response) (*SlackWebhookListRespons
 	return response, nil
 }
 
-// ParseUserUpdateSlackOauthStartResponse parses an HTTP response from a UserUpdateSlackOauthStartWithResponse call
-func ParseUserUpdateSlackOauthStartResponse(rsp *http.Response) (*UserUpdateSlackOauthStartResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &UserUpdateSlackOauthStartResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
-}
-
-// ParseSnsListResponse parses an HTTP response from a SnsListWithResponse call
-func ParseSnsListResponse(rsp *http.Response) (*SnsListResponse, error) {
+// ParseSqsListResponse parses an HTTP response from a SqsListWithResponse call
+func ParseSqsListResponse(rsp *http.Response) (*SqsListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SnsListResponse{
+	response := &SqsListResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListSNSIntegrations
+		var dest ListSQSIntegrations
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13474,22 +21933,22 @@ func ParseSnsListResponse(rsp *http.Response) (*SnsListResponse, error) {
 	return response, nil
 }
 
-// ParseSnsCreateResponse parses an HTTP response from a SnsCreateWithResponse call
-func ParseSnsCreateResponse(rsp *http.Response) (*SnsCreateResponse, error) {
+// ParseSqsCreateResponse parses an HTTP response from a SqsCreateWithResponse call
+func ParseSqsCreateResponse(rsp *http.Response) (*SqsCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SnsCreateResponse{
+	response := &SqsCreateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest SNSIntegration
+		var dest SQSIntegration
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -13876,6 +22335,46 @@ func ParseWorkerListResponse(rsp *http.Response) (*WorkerListResponse, error) {
 	return response, nil
 }
 
+// ParseWorkerPoolListResponse parses an HTTP response from a WorkerPoolListWithResponse call
+func ParseWorkerPoolListResponse(rsp *http.Response) (*WorkerPoolListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WorkerPoolListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkerPoolList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseWorkflowRunUpdateReplayResponse parses an HTTP response from a WorkflowRunUpdateReplayWithResponse call
 func ParseWorkflowRunUpdateReplayResponse(rsp *http.Response) (*WorkflowRunUpdateReplayResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -14010,6 +22509,154 @@ func ParseWorkflowRunGetInputResponse(rsp *http.Response) (*WorkflowRunGetInputR
 	return response, nil
 }
 
+// ParseWorkflowRunUpdatePauseResponse parses an HTTP response from a WorkflowRunUpdatePauseWithResponse call
+func ParseWorkflowRunUpdatePauseResponse(rsp *http.Response) (*WorkflowRunUpdatePauseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WorkflowRunUpdatePauseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkflowRun
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWorkflowRunUpdateReplayFromStepResponse parses an HTTP response from a WorkflowRunUpdateReplayFromStepWithResponse call
+func ParseWorkflowRunUpdateReplayFromStepResponse(rsp *http.Response) (*WorkflowRunUpdateReplayFromStepResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WorkflowRunUpdateReplayFromStepResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkflowRun
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWorkflowRunUpdateResumeResponse parses an HTTP response from a WorkflowRunUpdateResumeWithResponse call
+func ParseWorkflowRunUpdateResumeResponse(rsp *http.Response) (*WorkflowRunUpdateResumeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WorkflowRunUpdateResumeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkflowRun
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseWorkflowRunGetShapeResponse parses an HTTP response from a WorkflowRunGetShapeWithResponse call
 func ParseWorkflowRunGetShapeResponse(rsp *http.Response) (*WorkflowRunGetShapeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -14025,7 +22672,47 @@ func ParseWorkflowRunGetShapeResponse(rsp *http.Response) (*WorkflowRunGetShapeR
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkflowRunShape
+		var dest WorkflowRunShape
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWorkflowRunListStepRunEventsResponse parses an HTTP response from a WorkflowRunListStepRunEventsWithResponse call
+func ParseWorkflowRunListStepRunEventsResponse(rsp *http.Response) (*WorkflowRunListStepRunEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WorkflowRunListStepRunEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StepRunEventList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14045,32 +22732,32 @@ func ParseWorkflowRunGetShapeResponse(rsp *http.Response) (*WorkflowRunGetShapeR
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseWorkflowRunListStepRunEventsResponse parses an HTTP response from a WorkflowRunListStepRunEventsWithResponse call
-func ParseWorkflowRunListStepRunEventsResponse(rsp *http.Response) (*WorkflowRunListStepRunEventsResponse, error) {
+// ParseWorkflowRunGetStreamResponse parses an HTTP response from a WorkflowRunGetStreamWithResponse call
+func ParseWorkflowRunGetStreamResponse(rsp *http.Response) (*WorkflowRunGetStreamResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &WorkflowRunListStepRunEventsResponse{
+	response := &WorkflowRunGetStreamResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest StepRunEventList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest APIErrors
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -14499,6 +23186,53 @@ func ParseWorkflowScheduledGetResponse(rsp *http.Response) (*WorkflowScheduledGe
 	return response, nil
 }
 
+// ParseWorkflowScheduledUpdateResponse parses an HTTP response from a WorkflowScheduledUpdateWithResponse call
+func ParseWorkflowScheduledUpdateResponse(rsp *http.Response) (*WorkflowScheduledUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WorkflowScheduledUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScheduledWorkflows
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseCronWorkflowTriggerCreateResponse parses an HTTP response from a CronWorkflowTriggerCreateWithResponse call
 func ParseCronWorkflowTriggerCreateResponse(rsp *http.Response) (*CronWorkflowTriggerCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -14998,6 +23732,38 @@ func ParseTenantMembershipsListResponse(rsp *http.Response) (*TenantMembershipsL
 	return response, nil
 }
 
+// ParseUserUpdateOidcCallbackResponse parses an HTTP response from a UserUpdateOidcCallbackWithResponse call
+func ParseUserUpdateOidcCallbackResponse(rsp *http.Response) (*UserUpdateOidcCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserUpdateOidcCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUserUpdateOidcStartResponse parses an HTTP response from a UserUpdateOidcStartWithResponse call
+func ParseUserUpdateOidcStartResponse(rsp *http.Response) (*UserUpdateOidcStartResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UserUpdateOidcStartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
 // ParseUserUpdatePasswordResponse parses an HTTP response from a UserUpdatePasswordWithResponse call
 func ParseUserUpdatePasswordResponse(rsp *http.Response) (*UserUpdatePasswordResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -15402,6 +24168,53 @@ func ParseWorkflowUpdateResponse(rsp *http.Response) (*WorkflowUpdateResponse, e
 	return response, nil
 }
 
+// ParseWorkflowListConcurrencyGroupsResponse parses an HTTP response from a WorkflowListConcurrencyGroupsWithResponse call
+func ParseWorkflowListConcurrencyGroupsResponse(rsp *http.Response) (*WorkflowListConcurrencyGroupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WorkflowListConcurrencyGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkflowConcurrencyGroupList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseWorkflowGetMetricsResponse parses an HTTP response from a WorkflowGetMetricsWithResponse call
 func ParseWorkflowGetMetricsResponse(rsp *http.Response) (*WorkflowGetMetricsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -15449,6 +24262,140 @@ func ParseWorkflowGetMetricsResponse(rsp *http.Response) (*WorkflowGetMetricsRes
 	return response, nil
 }
 
+// ParseWorkflowDeleteRolloutResponse parses an HTTP response from a WorkflowDeleteRolloutWithResponse call
+func ParseWorkflowDeleteRolloutResponse(rsp *http.Response) (*WorkflowDeleteRolloutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WorkflowDeleteRolloutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWorkflowGetRolloutResponse parses an HTTP response from a WorkflowGetRolloutWithResponse call
+func ParseWorkflowGetRolloutResponse(rsp *http.Response) (*WorkflowGetRolloutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WorkflowGetRolloutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkflowRollout
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWorkflowUpdateRolloutResponse parses an HTTP response from a WorkflowUpdateRolloutWithResponse call
+func ParseWorkflowUpdateRolloutResponse(rsp *http.Response) (*WorkflowUpdateRolloutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WorkflowUpdateRolloutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkflowRollout
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest APIErrors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseWorkflowRunCreateResponse parses an HTTP response from a WorkflowRunCreateWithResponse call
 func ParseWorkflowRunCreateResponse(rsp *http.Response) (*WorkflowRunCreateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
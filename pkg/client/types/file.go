@@ -20,6 +20,17 @@ func StickyStrategyPtr(v StickyStrategy) *StickyStrategy {
 	return &v
 }
 
+type RegionStrategy int32
+
+const (
+	RegionStrategy_PREFER RegionStrategy = 0
+	RegionStrategy_PIN    RegionStrategy = 1
+)
+
+func RegionStrategyPtr(v RegionStrategy) *RegionStrategy {
+	return &v
+}
+
 type Workflow struct {
 	Name string `yaml:"name,omitempty"`
 
@@ -38,6 +49,18 @@ type Workflow struct {
 	OnFailureJob *WorkflowJob `yaml:"onFailureJob,omitempty"`
 
 	StickyStrategy *StickyStrategy `yaml:"sticky,omitempty"`
+
+	Region *string `yaml:"region,omitempty"`
+
+	RegionStrategy *RegionStrategy `yaml:"regionStrategy,omitempty"`
+
+	Pool *string `yaml:"pool,omitempty"`
+
+	Output *string `yaml:"output,omitempty"`
+
+	InputSchema *string `yaml:"inputSchema,omitempty"`
+
+	ReservedSlots *int32 `yaml:"reservedSlots,omitempty"`
 }
 
 type WorkflowConcurrencyLimitStrategy string
@@ -45,6 +68,7 @@ type WorkflowConcurrencyLimitStrategy string
 const (
 	CancelInProgress WorkflowConcurrencyLimitStrategy = "CANCEL_IN_PROGRESS"
 	GroupRoundRobin  WorkflowConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
+	QueueNewestOnly  WorkflowConcurrencyLimitStrategy = "QUEUE_NEWEST_ONLY"
 )
 
 type WorkflowConcurrency struct {
@@ -61,6 +85,15 @@ type WorkflowTriggers struct {
 	Events    []string    `yaml:"events,omitempty"`
 	Cron      []string    `yaml:"crons,omitempty"`
 	Schedules []time.Time `yaml:"schedules,omitempty"`
+
+	// (optional) the IANA timezone the crons are evaluated in, defaults to UTC
+	CronTimezone *string `yaml:"cronTimezone,omitempty"`
+
+	// (optional) the maximum duration each cron trigger is randomly delayed by
+	CronJitter *time.Duration `yaml:"cronJitter,omitempty"`
+
+	// (optional) the name of the exclusion calendar whose dates the crons skip
+	CronExclusionCalendar *string `yaml:"cronExclusionCalendar,omitempty"`
 }
 
 type RandomScheduleOpt string
@@ -108,17 +141,35 @@ type DesiredWorkerLabel struct {
 }
 
 type WorkflowStep struct {
-	Name                   string                         `yaml:"name,omitempty"`
-	ID                     string                         `yaml:"id,omitempty"`
-	ActionID               string                         `yaml:"action"`
-	Timeout                string                         `yaml:"timeout,omitempty"`
-	With                   map[string]interface{}         `yaml:"with,omitempty"`
-	Parents                []string                       `yaml:"parents,omitempty"`
-	Retries                int                            `yaml:"retries"`
-	RateLimits             []RateLimit                    `yaml:"rateLimits,omitempty"`
-	DesiredLabels          map[string]*DesiredWorkerLabel `yaml:"desiredLabels,omitempty"`
-	RetryBackoffFactor     *float32                       `yaml:"retryBackoffFactor,omitempty"`
-	RetryMaxBackoffSeconds *int32                         `yaml:"retryMaxBackoffSeconds,omitempty"`
+	Name                       string                         `yaml:"name,omitempty"`
+	ID                         string                         `yaml:"id,omitempty"`
+	ActionID                   string                         `yaml:"action"`
+	Timeout                    string                         `yaml:"timeout,omitempty"`
+	With                       map[string]interface{}         `yaml:"with,omitempty"`
+	Parents                    []string                       `yaml:"parents,omitempty"`
+	Retries                    int                            `yaml:"retries"`
+	RateLimits                 []RateLimit                    `yaml:"rateLimits,omitempty"`
+	DesiredLabels              map[string]*DesiredWorkerLabel `yaml:"desiredLabels,omitempty"`
+	RetryBackoffFactor         *float32                       `yaml:"retryBackoffFactor,omitempty"`
+	RetryMaxBackoffSeconds     *int32                         `yaml:"retryMaxBackoffSeconds,omitempty"`
+	RetryInitialBackoffSeconds *int32                         `yaml:"retryInitialBackoffSeconds,omitempty"`
+	RetryBackoffJitter         *float32                       `yaml:"retryBackoffJitter,omitempty"`
+	SpeculativePercentile      *int32                         `yaml:"speculativePercentile,omitempty"`
+	SlotType                   *string                        `yaml:"slotType,omitempty"`
+	ScheduleTimeout            *string                        `yaml:"scheduleTimeout,omitempty"`
+	HeartbeatTimeout           *string                        `yaml:"heartbeatTimeout,omitempty"`
+	SleepFor                   *string                        `yaml:"sleepFor,omitempty"`
+	SleepUntil                 *string                        `yaml:"sleepUntil,omitempty"`
+	WaitForEvent               *string                        `yaml:"waitForEvent,omitempty"`
+	WaitForEventCorrelation    *string                        `yaml:"waitForEventCorrelation,omitempty"`
+	Approval                   bool                           `yaml:"approval,omitempty"`
+	Condition                  *string                        `yaml:"condition,omitempty"`
+	MapOver                    *string                        `yaml:"mapOver,omitempty"`
+	MapConcurrency             *int32                         `yaml:"mapConcurrency,omitempty"`
+	CacheTTL                   *string                        `yaml:"cacheTtl,omitempty"`
+	InputSchema                *string                        `yaml:"inputSchema,omitempty"`
+	OutputSchema               *string                        `yaml:"outputSchema,omitempty"`
+	Pool                       *string                        `yaml:"pool,omitempty"`
 }
 
 type RateLimit struct {
@@ -127,6 +178,7 @@ type RateLimit struct {
 	Units          *int    `yaml:"units,omitempty"`
 	UnitsExpr      *string `yaml:"unitsExpr,omitempty"`
 	LimitValueExpr *string `yaml:"limitValueExpr,omitempty"`
+	Global         bool    `yaml:"global,omitempty"`
 }
 
 func ParseYAML(ctx context.Context, yamlBytes []byte) (Workflow, error) {
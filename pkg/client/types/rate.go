@@ -12,7 +12,22 @@ const (
 	Year   RateLimitDuration = "year"
 )
 
+type RateLimitAlgorithm string
+
+const (
+	FixedWindow   RateLimitAlgorithm = "fixed_window"
+	SlidingWindow RateLimitAlgorithm = "sliding_window"
+	TokenBucket   RateLimitAlgorithm = "token_bucket"
+	LeakyBucket   RateLimitAlgorithm = "leaky_bucket"
+)
+
 type RateLimitOpts struct {
 	Max      int
 	Duration RateLimitDuration
+
+	// (optional) the algorithm used to refill the rate limit, defaults to FixedWindow
+	Algorithm RateLimitAlgorithm
+
+	// (optional) the bucket capacity for TokenBucket and LeakyBucket rate limits
+	Burst *int
 }
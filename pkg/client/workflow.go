@@ -1,8 +1,10 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 
 	dispatchercontracts "github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
 )
@@ -30,6 +32,21 @@ type WorkflowResult struct {
 	workflowRun *dispatchercontracts.WorkflowRunEvent
 }
 
+func (r *WorkflowResult) WorkflowRunId() string {
+	return r.workflowRun.WorkflowRunId
+}
+
+// Err returns the error of the first failed step run of the workflow run, or nil if no step run failed.
+func (r *WorkflowResult) Err() error {
+	for _, stepRunResult := range r.workflowRun.Results {
+		if stepRunResult.Error != nil {
+			return fmt.Errorf("step %s failed: %s", stepRunResult.StepReadableId, *stepRunResult.Error)
+		}
+	}
+
+	return nil
+}
+
 func (r *WorkflowResult) StepOutput(key string, v interface{}) error {
 	var outputBytes []byte
 	for _, stepRunResult := range r.workflowRun.Results {
@@ -55,6 +72,24 @@ func (r *WorkflowResult) StepOutput(key string, v interface{}) error {
 	return nil
 }
 
+// Output unmarshals the output of the workflow run into v. The output is only set when the workflow declares an
+// output expression and the workflow run succeeded.
+func (r *WorkflowResult) Output(v interface{}) error {
+	if err := r.Err(); err != nil {
+		return err
+	}
+
+	if r.workflowRun.Output == nil {
+		return fmt.Errorf("workflow run %s has no output", r.workflowRun.WorkflowRunId)
+	}
+
+	if err := json.Unmarshal([]byte(*r.workflowRun.Output), v); err != nil {
+		return fmt.Errorf("failed to unmarshal output: %w", err)
+	}
+
+	return nil
+}
+
 func (c *Workflow) Result() (*WorkflowResult, error) {
 	resChan := make(chan *WorkflowResult)
 
@@ -81,3 +116,85 @@ func (c *Workflow) Result() (*WorkflowResult, error) {
 
 	return res, nil
 }
+
+type indexedWorkflowResult struct {
+	index  int
+	result *WorkflowResult
+}
+
+// listen registers a handler for each workflow run which sends its first result to the returned channel. The
+// channel is buffered, so handlers never block once the caller stops reading.
+func listen(workflows []*Workflow) (<-chan indexedWorkflowResult, error) {
+	resChan := make(chan indexedWorkflowResult, len(workflows))
+
+	for i, workflow := range workflows {
+		index := i
+		once := sync.Once{}
+
+		err := workflow.listener.AddWorkflowRun(
+			workflow.workflowRunId,
+			func(event WorkflowRunEvent) error {
+				once.Do(func() {
+					resChan <- indexedWorkflowResult{
+						index: index,
+						result: &WorkflowResult{
+							workflowRun: event,
+						},
+					}
+				})
+
+				return nil
+			},
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to listen for workflow events: %w", err)
+		}
+	}
+
+	return resChan, nil
+}
+
+// AwaitAll waits until all workflow runs have finished and returns their results, in the same order as the
+// workflow runs. All workflow runs are awaited over the listener they were created with, so waiting on many child
+// workflows does not poll each of them.
+func AwaitAll(ctx context.Context, workflows ...*Workflow) ([]*WorkflowResult, error) {
+	resChan, err := listen(workflows)
+
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*WorkflowResult, len(workflows))
+
+	for range workflows {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case res := <-resChan:
+			results[res.index] = res.result
+		}
+	}
+
+	return results, nil
+}
+
+// AwaitAny waits until the first of the workflow runs has finished and returns its index and result.
+func AwaitAny(ctx context.Context, workflows ...*Workflow) (int, *WorkflowResult, error) {
+	if len(workflows) == 0 {
+		return -1, nil, fmt.Errorf("no workflow runs to await")
+	}
+
+	resChan, err := listen(workflows)
+
+	if err != nil {
+		return -1, nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return -1, nil, ctx.Err()
+	case res := <-resChan:
+		return res.index, res.result, nil
+	}
+}
@@ -24,13 +24,23 @@ type ConfigFile struct {
 	MaxQueueConns int `mapstructure:"maxQueueConns" json:"maxQueueConns,omitempty" default:"50"`
 	MinQueueConns int `mapstructure:"minQueueConns" json:"minQueueConns,omitempty" default:"10"`
 
+	// PgBouncerMode avoids the features which require a session, for connecting through PgBouncer in transaction
+	// pooling mode. Notifications are polled instead of listened to, and locks are leases stored in the database.
+	PgBouncerMode bool `mapstructure:"pgBouncerMode" json:"pgBouncerMode,omitempty" default:"false"`
+
 	Seed SeedConfigFile `mapstructure:"seed" json:"seed,omitempty"`
 
+	ReadReplica ReadReplicaConfigFile `mapstructure:"readReplica" json:"readReplica,omitempty"`
+
 	Logger shared.LoggerConfigFile `mapstructure:"logger" json:"logger,omitempty"`
 
 	LogQueries bool `mapstructure:"logQueries" json:"logQueries,omitempty" default:"false"`
 
 	CacheDuration time.Duration `mapstructure:"cacheDuration" json:"cacheDuration,omitempty" default:"60s"`
+
+	// CacheRedisURL caches the tenants, API tokens and workflow definitions in Redis if set, so the instances of
+	// Hatchet share the cached values instead of each reading them from the database.
+	CacheRedisURL string `mapstructure:"cacheRedisUrl" json:"cacheRedisUrl,omitempty"`
 }
 
 type SeedConfigFile struct {
@@ -45,6 +55,22 @@ type SeedConfigFile struct {
 	IsDevelopment bool `mapstructure:"isDevelopment" json:"isDevelopment,omitempty" default:"false"`
 }
 
+type ReadReplicaConfigFile struct {
+	// Enabled sends the reads of the query APIs, like the lists of runs and events and the run metrics, to the read
+	// replica. Writes and the reads of the engine are always sent to the primary.
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty" default:"false"`
+
+	// URL is the connection string of the read replica
+	URL string `mapstructure:"url" json:"url,omitempty"`
+
+	MaxConns int `mapstructure:"maxConns" json:"maxConns,omitempty" default:"50"`
+	MinConns int `mapstructure:"minConns" json:"minConns,omitempty" default:"0"`
+
+	// MaxLag is the lag behind the primary above which reads are sent to the primary instead of the replica, zero
+	// sends reads to the replica regardless of its lag
+	MaxLag time.Duration `mapstructure:"maxLag" json:"maxLag,omitempty" default:"30s"`
+}
+
 type Config struct {
 	Disconnect func() error
 
@@ -54,10 +80,16 @@ type Config struct {
 
 	QueuePool *pgxpool.Pool
 
+	// ReadReplicaPool is the pool of the read replica, which is nil if no read replica is configured
+	ReadReplicaPool *pgxpool.Pool
+
 	APIRepository repository.APIRepository
 
 	EngineRepository repository.EngineRepository
 
+	// SchedulerRepository is used by the schedulers, and uses the queue pool
+	SchedulerRepository repository.SchedulerRepository
+
 	EntitlementRepository repository.EntitlementsRepository
 
 	Seed SeedConfigFile
@@ -75,8 +107,16 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("minConns", "DATABASE_MIN_CONNS")
 	_ = v.BindEnv("maxQueueConns", "DATABASE_MAX_QUEUE_CONNS")
 	_ = v.BindEnv("minQueueConns", "DATABASE_MIN_QUEUE_CONNS")
+	_ = v.BindEnv("pgBouncerMode", "DATABASE_PGBOUNCER_MODE")
 
 	_ = v.BindEnv("cacheDuration", "CACHE_DURATION")
+	_ = v.BindEnv("cacheRedisUrl", "CACHE_REDIS_URL")
+
+	_ = v.BindEnv("readReplica.enabled", "DATABASE_READ_REPLICA_ENABLED")
+	_ = v.BindEnv("readReplica.url", "DATABASE_READ_REPLICA_URL")
+	_ = v.BindEnv("readReplica.maxConns", "DATABASE_READ_REPLICA_MAX_CONNS")
+	_ = v.BindEnv("readReplica.minConns", "DATABASE_READ_REPLICA_MIN_CONNS")
+	_ = v.BindEnv("readReplica.maxLag", "DATABASE_READ_REPLICA_MAX_LAG")
 
 	_ = v.BindEnv("seed.adminEmail", "ADMIN_EMAIL")
 	_ = v.BindEnv("seed.adminPassword", "ADMIN_PASSWORD")
@@ -4,29 +4,49 @@ package loader
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/exaring/otelpgx"
+	"github.com/google/uuid"
 	pgxzero "github.com/jackc/pgx-zerolog"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/tracelog"
+	"github.com/redis/go-redis/v9"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
 	"golang.org/x/oauth2"
 
 	"github.com/hatchet-dev/hatchet/internal/integrations/alerting"
+	"github.com/hatchet-dev/hatchet/internal/integrations/blobstore"
+	"github.com/hatchet-dev/hatchet/internal/integrations/blobstore/filesystem"
+	"github.com/hatchet-dev/hatchet/internal/integrations/blobstore/s3"
 	"github.com/hatchet-dev/hatchet/internal/integrations/email"
 	"github.com/hatchet-dev/hatchet/internal/integrations/email/postmark"
+	"github.com/hatchet-dev/hatchet/internal/integrations/secrets"
+	"github.com/hatchet-dev/hatchet/internal/integrations/secrets/awssm"
+	"github.com/hatchet-dev/hatchet/internal/integrations/secrets/vault"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
-	"github.com/hatchet-dev/hatchet/internal/msgqueue/rabbitmq"
+	_ "github.com/hatchet-dev/hatchet/internal/msgqueue/jetstream"
+	_ "github.com/hatchet-dev/hatchet/internal/msgqueue/postgres"
+	_ "github.com/hatchet-dev/hatchet/internal/msgqueue/rabbitmq"
 	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
 	"github.com/hatchet-dev/hatchet/pkg/analytics"
 	"github.com/hatchet-dev/hatchet/pkg/analytics/posthog"
 	"github.com/hatchet-dev/hatchet/pkg/auth/cookie"
 	"github.com/hatchet-dev/hatchet/pkg/auth/oauth"
+	"github.com/hatchet-dev/hatchet/pkg/auth/oidc"
 	"github.com/hatchet-dev/hatchet/pkg/auth/token"
 	"github.com/hatchet-dev/hatchet/pkg/config/database"
 	"github.com/hatchet-dev/hatchet/pkg/config/loader/loaderutils"
@@ -36,6 +56,7 @@ import (
 	"github.com/hatchet-dev/hatchet/pkg/errors/sentry"
 	"github.com/hatchet-dev/hatchet/pkg/logger"
 	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
+	"github.com/hatchet-dev/hatchet/pkg/repository/encrypted"
 	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
@@ -146,6 +167,14 @@ func GetDatabaseConfigFromConfigFile(cf *database.ConfigFile, runtime *server.Co
 		_ = os.Setenv("DATABASE_URL", databaseUrl)
 	}
 
+	if cf.PgBouncerMode {
+		databaseUrl, err = withPgBouncerParam(databaseUrl)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	c := db.NewClient()
 
 	if err := c.Prisma.Connect(); err != nil {
@@ -157,6 +186,11 @@ func GetDatabaseConfigFromConfigFile(cf *database.ConfigFile, runtime *server.Co
 		return nil, err
 	}
 
+	if cf.PgBouncerMode {
+		// prepared statements are held by the session, so statements are sent without being prepared
+		config.ConnConfig.DefaultQueryExecMode = pgx.QueryExecModeExec
+	}
+
 	if cf.LogQueries {
 		config.ConnConfig.Tracer = &tracelog.TraceLog{
 			Logger:   pgxzero.NewLogger(l),
@@ -214,13 +248,69 @@ func GetDatabaseConfigFromConfigFile(cf *database.ConfigFile, runtime *server.Co
 
 	meter := metered.NewMetered(entitlementRepo, &l)
 
-	cleanupEngine, engineRepo, err := prisma.NewEngineRepository(pool, essentialPool, runtime, prisma.WithLogger(&l), prisma.WithCache(ch), prisma.WithMetered(meter))
+	engineRepoOpts := []prisma.PrismaRepositoryOpt{prisma.WithLogger(&l), prisma.WithCache(ch), prisma.WithMetered(meter)}
+
+	if cf.PgBouncerMode {
+		engineRepoOpts = append(engineRepoOpts, prisma.WithPgBouncerMode())
+	}
+
+	var redisClient *redis.Client
+	var sharedCache *cache.RedisCache
+
+	if cf.CacheRedisURL != "" {
+		redisOpts, err := redis.ParseURL(cf.CacheRedisURL)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not parse redis url: %w", err)
+		}
+
+		redisClient = redis.NewClient(redisOpts)
+
+		if err := redisClient.Ping(context.Background()).Err(); err != nil {
+			return nil, fmt.Errorf("could not connect to the cache: %w", err)
+		}
+
+		sharedCache = cache.NewRedis(redisClient, "hatchet:cache:", cf.CacheDuration, &l)
+
+		engineRepoOpts = append(engineRepoOpts, prisma.WithSharedCache(sharedCache))
+	}
+
+	cleanupEngine, engineRepo, err := prisma.NewEngineRepository(pool, essentialPool, runtime, engineRepoOpts...)
 
 	if err != nil {
 		return nil, fmt.Errorf("could not create engine repository: %w", err)
 	}
 
-	apiRepo, cleanupApiRepo, err := prisma.NewAPIRepository(c, pool, runtime, prisma.WithLogger(&l), prisma.WithCache(ch), prisma.WithMetered(meter))
+	schedulerRepo, cleanupSchedulerRepo, err := prisma.NewSchedulerRepository(pool, validator.NewDefaultValidator(), &l, runtime.EventBuffer)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create scheduler repository: %w", err)
+	}
+
+	apiRepoOpts := []prisma.PrismaRepositoryOpt{prisma.WithLogger(&l), prisma.WithCache(ch), prisma.WithMetered(meter)}
+
+	if sharedCache != nil {
+		apiRepoOpts = append(apiRepoOpts, prisma.WithSharedCache(sharedCache))
+	}
+
+	var readReplicaPool *pgxpool.Pool
+	stopReadReplica := func() {}
+
+	if cf.ReadReplica.Enabled {
+		readReplicaPool, err = newReadReplicaPool(&cf.ReadReplica, cf.PgBouncerMode)
+
+		if err != nil {
+			return nil, err
+		}
+
+		var readReplica *prisma.ReadReplica
+
+		readReplica, stopReadReplica = prisma.NewReadReplica(pool, readReplicaPool, cf.ReadReplica.MaxLag, &l)
+
+		apiRepoOpts = append(apiRepoOpts, prisma.WithReadReplica(readReplica))
+	}
+
+	apiRepo, cleanupApiRepo, err := prisma.NewAPIRepository(c, pool, runtime, apiRepoOpts...)
 
 	if err != nil {
 		return nil, fmt.Errorf("could not create api repository: %w", err)
@@ -232,23 +322,96 @@ func GetDatabaseConfigFromConfigFile(cf *database.ConfigFile, runtime *server.Co
 				return err
 			}
 
+			if err := cleanupSchedulerRepo(); err != nil {
+				return err
+			}
+
 			ch.Stop()
 			meter.Stop()
 			if err = cleanupApiRepo(); err != nil {
 				return err
 			}
+
+			stopReadReplica()
+
+			if readReplicaPool != nil {
+				readReplicaPool.Close()
+			}
+
+			if redisClient != nil {
+				_ = redisClient.Close()
+			}
+
 			return c.Prisma.Disconnect()
 		},
 		Pool:                  pool,
 		EssentialPool:         essentialPool,
 		QueuePool:             pool,
+		ReadReplicaPool:       readReplicaPool,
 		APIRepository:         apiRepo,
 		EngineRepository:      engineRepo,
+		SchedulerRepository:   schedulerRepo,
 		EntitlementRepository: entitlementRepo,
 		Seed:                  cf.Seed,
 	}, nil
 }
 
+func newReadReplicaPool(cf *database.ReadReplicaConfigFile, pgBouncerMode bool) (*pgxpool.Pool, error) {
+	if cf.URL == "" {
+		return nil, fmt.Errorf("the read replica is enabled, but DATABASE_READ_REPLICA_URL is not set")
+	}
+
+	config, err := pgxpool.ParseConfig(cf.URL)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not parse read replica url: %w", err)
+	}
+
+	if pgBouncerMode {
+		config.ConnConfig.DefaultQueryExecMode = pgx.QueryExecModeExec
+	}
+
+	config.ConnConfig.Tracer = otelpgx.NewTracer()
+
+	if cf.MaxConns != 0 {
+		config.MaxConns = int32(cf.MaxConns) // nolint: gosec
+	}
+
+	config.MinConns = int32(cf.MinConns) // nolint: gosec
+
+	config.MaxConnLifetime = 15 * 60 * time.Second
+
+	pool, err := pgxpool.NewWithConfig(context.Background(), config)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to read replica: %w", err)
+	}
+
+	return pool, nil
+}
+
+// withPgBouncerParam sets the pgbouncer parameter of the database url for the Prisma client, which then doesn't
+// prepare statements, and returns the url without the parameter for pgx, which doesn't accept it.
+func withPgBouncerParam(databaseUrl string) (string, error) {
+	u, err := url.Parse(databaseUrl)
+
+	if err != nil {
+		return "", fmt.Errorf("could not parse database url: %w", err)
+	}
+
+	q := u.Query()
+	q.Set("pgbouncer", "true")
+	u.RawQuery = q.Encode()
+
+	// FIXME: needed for Prisma client, as db.WithDatasourceURL(databaseUrl) is not working
+	_ = os.Setenv("DATABASE_URL", u.String())
+
+	q.Del("pgbouncer")
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
 func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigFile, version string) (cleanup func() error, res *server.ServerConfig, err error) {
 	l := logger.NewStdErr(&cf.Logger, "server")
 	queueLogger := logger.NewStdErr(&cf.AdditionalLoggers.Queue, "queue")
@@ -259,6 +422,22 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 		return nil, nil, fmt.Errorf("could not load TLS config: %w", err)
 	}
 
+	encryptionSvc, err := loadEncryptionSvc(cf)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not load encryption service: %w", err)
+	}
+
+	var payloadEncryption *encryption.PayloadEncryption
+
+	// the repositories are wrapped before they are passed to any service, so that no payload is written in plaintext
+	if cf.Encryption.Payloads.Enabled {
+		payloadEncryption = encryption.NewPayloadEncryption(encryptionSvc, dc.EngineRepository.DataKey())
+
+		dc.EngineRepository = encrypted.NewEngineRepository(dc.EngineRepository, payloadEncryption)
+		dc.APIRepository = encrypted.NewAPIRepository(dc.APIRepository, payloadEncryption)
+	}
+
 	ss, err := cookie.NewUserSessionStore(
 		cookie.WithSessionRepository(dc.APIRepository.UserSession()),
 		cookie.WithCookieAllowInsecure(cf.Auth.Cookie.Insecure),
@@ -279,17 +458,24 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 	var ing ingestor.Ingestor
 
 	if cf.MessageQueue.Enabled {
-		cleanup1, mq = rabbitmq.New(
-			rabbitmq.WithURL(cf.MessageQueue.RabbitMQ.URL),
-			rabbitmq.WithLogger(&l),
-			rabbitmq.WithQos(cf.MessageQueue.RabbitMQ.Qos),
-			rabbitmq.WithDisableTenantExchangePubs(cf.Runtime.DisableTenantPubs),
-		)
+		cleanup1, mq, err = msgqueue.New(cf.MessageQueue.Kind, &msgqueue.DriverOpts{
+			Logger:            &l,
+			Config:            messageQueueDriverConfig(&cf.MessageQueue),
+			DisableTenantPubs: cf.Runtime.DisableTenantPubs,
+			Repository:        dc.EngineRepository,
+		})
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not create message queue: %w", err)
+		}
 
 		ing, err = ingestor.NewIngestor(
 			ingestor.WithEventRepository(dc.EngineRepository.Event()),
 			ingestor.WithStreamEventsRepository(dc.EngineRepository.StreamEvent()),
 			ingestor.WithLogRepository(dc.EngineRepository.Log()),
+			ingestor.WithWorkflowRepository(dc.EngineRepository.Workflow()),
+			ingestor.WithEventRoutingRepository(dc.EngineRepository.EventRouting()),
+			ingestor.WithTenantQuotaRepository(dc.EngineRepository.TenantQuota()),
 			ingestor.WithMessageQueue(mq),
 			ingestor.WithEntitlementsRepository(dc.EntitlementRepository),
 		)
@@ -401,10 +587,55 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 		})
 	}
 
-	encryptionSvc, err := loadEncryptionSvc(cf)
+	if cf.Auth.OIDC.Enabled {
+		oidcConfig := cf.Auth.OIDC
 
-	if err != nil {
-		return nil, nil, fmt.Errorf("could not load encryption service: %w", err)
+		switch oidcConfig.Preset {
+		case "":
+		case "google":
+			if oidcConfig.IssuerURL == "" {
+				oidcConfig.IssuerURL = "https://accounts.google.com"
+			}
+
+			// google doesn't include groups in id tokens, users are mapped by their workspace domain instead
+			if oidcConfig.GroupsClaim == "groups" {
+				oidcConfig.GroupsClaim = "hd"
+			}
+		case "okta":
+			if !slices.Contains(oidcConfig.Scopes, "groups") {
+				oidcConfig.Scopes = append(oidcConfig.Scopes, "groups")
+			}
+		default:
+			return nil, nil, fmt.Errorf("unknown oidc preset %q, expected google or okta", oidcConfig.Preset)
+		}
+
+		if oidcConfig.IssuerURL == "" {
+			return nil, nil, fmt.Errorf("oidc issuer url is required")
+		}
+
+		if oidcConfig.ClientID == "" {
+			return nil, nil, fmt.Errorf("oidc client id is required")
+		}
+
+		if oidcConfig.ClientSecret == "" {
+			return nil, nil, fmt.Errorf("oidc client secret is required")
+		}
+
+		auth.OIDCGroupMappings, err = oidc.ParseGroupMappings(oidcConfig.GroupMappings)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not parse oidc group mappings: %w", err)
+		}
+
+		auth.OIDCProvider = oidc.NewProvider(&oidc.Opts{
+			IssuerURL:            oidcConfig.IssuerURL,
+			ClientID:             oidcConfig.ClientID,
+			ClientSecret:         oidcConfig.ClientSecret,
+			Scopes:               oidcConfig.Scopes,
+			RedirectURL:          cf.Runtime.ServerURL + "/api/v1/users/oidc/callback",
+			GroupsClaim:          oidcConfig.GroupsClaim,
+			AllowUnverifiedEmail: oidcConfig.AllowUnverifiedEmail,
+		})
 	}
 
 	// create a new JWT manager
@@ -430,6 +661,24 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 		)
 	}
 
+	blobOffloader, err := loadBlobOffloader(cf)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not load blob storage: %w", err)
+	}
+
+	secretResolver, err := loadSecretResolver(cf)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not load secrets store: %w", err)
+	}
+
+	kafkaClient, err := loadKafkaClient(cf)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not load kafka client: %w", err)
+	}
+
 	additionalOAuthConfigs := make(map[string]*oauth2.Config)
 
 	if cf.TenantAlerting.Slack.Enabled {
@@ -443,18 +692,19 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 
 	v := validator.NewDefaultValidator()
 
-	schedulingPool, cleanupSchedulingPool, err := v2.NewSchedulingPool(
-		&queueLogger,
-		dc.QueuePool,
-		v,
-		cf.Runtime.SingleQueueLimit,
-		cf.Runtime.EventBuffer,
-	)
+	schedulingPoolOpts, err := getSchedulingPoolOpts(cf)
 
 	if err != nil {
-		return nil, nil, fmt.Errorf("could not create scheduling pool: %w", err)
+		return nil, nil, err
 	}
 
+	schedulingPool, cleanupSchedulingPool := v2.NewSchedulingPool(
+		&queueLogger,
+		dc.SchedulerRepository,
+		cf.Runtime.SingleQueueLimit,
+		schedulingPoolOpts...,
+	)
+
 	cleanup = func() error {
 		log.Printf("cleaning up server config")
 
@@ -463,7 +713,7 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 		}
 
 		if err := cleanup1(); err != nil {
-			return fmt.Errorf("error cleaning up rabbitmq: %w", err)
+			return fmt.Errorf("error cleaning up message queue: %w", err)
 		}
 		return nil
 	}
@@ -483,6 +733,7 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 		Runtime:                cf.Runtime,
 		Auth:                   auth,
 		Encryption:             encryptionSvc,
+		PayloadEncryption:      payloadEncryption,
 		Config:                 dc,
 		MessageQueue:           mq,
 		Services:               services,
@@ -498,10 +749,292 @@ func GetServerConfigFromConfigfile(dc *database.Config, cf *server.ServerConfigF
 		AdditionalLoggers:      cf.AdditionalLoggers,
 		EnableDataRetention:    cf.EnableDataRetention,
 		EnableWorkerRetention:  cf.EnableWorkerRetention,
+		Retention:              cf.Retention,
 		SchedulingPool:         schedulingPool,
+		BlobOffloader:          blobOffloader,
+		SecretResolver:         secretResolver,
+		KafkaClient:            kafkaClient,
+		Kafka:                  cf.Kafka,
+		Prometheus:             cf.Prometheus,
+		KedaScaler:             cf.KedaScaler,
 	}, nil
 }
 
+func loadBlobOffloader(cf *server.ServerConfigFile) (*blobstore.Offloader, error) {
+	var store blobstore.BlobStore
+
+	switch cf.BlobStorage.Driver {
+	case "":
+		return nil, nil
+	case "filesystem":
+		fsStore, err := filesystem.NewFilesystemStore(cf.BlobStorage.Filesystem.Dir)
+
+		if err != nil {
+			return nil, err
+		}
+
+		store = fsStore
+	case "s3", "gcs":
+		opts := s3.S3StoreOpts{
+			Endpoint:        cf.BlobStorage.S3.Endpoint,
+			Region:          cf.BlobStorage.S3.Region,
+			Bucket:          cf.BlobStorage.S3.Bucket,
+			Prefix:          cf.BlobStorage.S3.Prefix,
+			AccessKeyID:     cf.BlobStorage.S3.AccessKeyID,
+			SecretAccessKey: cf.BlobStorage.S3.SecretAccessKey,
+			SessionToken:    cf.BlobStorage.S3.SessionToken,
+			PathStyle:       cf.BlobStorage.S3.PathStyle,
+		}
+
+		if cf.BlobStorage.Driver == "gcs" {
+			if opts.Endpoint == "" {
+				opts.Endpoint = "https://storage.googleapis.com"
+			}
+
+			if opts.Region == "" {
+				opts.Region = "auto"
+			}
+
+			// the default credential chain only provides aws credentials
+			if opts.AccessKeyID == "" {
+				return nil, fmt.Errorf("the gcs driver requires an hmac key")
+			}
+		}
+
+		s3Store, err := s3.NewS3Store(context.Background(), opts)
+
+		if err != nil {
+			return nil, err
+		}
+
+		store = s3Store
+	default:
+		return nil, fmt.Errorf("unknown blob storage driver %q", cf.BlobStorage.Driver)
+	}
+
+	return &blobstore.Offloader{
+		Store:         store,
+		MaxInputSize:  cf.BlobStorage.MaxInputSize,
+		MaxOutputSize: cf.BlobStorage.MaxOutputSize,
+	}, nil
+}
+
+func loadSecretResolver(cf *server.ServerConfigFile) (*secrets.Resolver, error) {
+	var store secrets.SecretStore
+
+	switch cf.Secrets.Driver {
+	case "":
+		return nil, nil
+	case "vault":
+		vaultStore, err := vault.NewVaultStore(vault.VaultStoreOpts{
+			Address:   cf.Secrets.Vault.Address,
+			Token:     cf.Secrets.Vault.Token,
+			Namespace: cf.Secrets.Vault.Namespace,
+			Mount:     cf.Secrets.Vault.Mount,
+			Prefix:    cf.Secrets.Vault.Prefix,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		store = vaultStore
+	case "aws":
+		awsStore, err := awssm.NewAWSSecretsManagerStore(context.Background(), awssm.AWSSecretsManagerStoreOpts{
+			Endpoint:        cf.Secrets.AWS.Endpoint,
+			Region:          cf.Secrets.AWS.Region,
+			Prefix:          cf.Secrets.AWS.Prefix,
+			AccessKeyID:     cf.Secrets.AWS.AccessKeyID,
+			SecretAccessKey: cf.Secrets.AWS.SecretAccessKey,
+			SessionToken:    cf.Secrets.AWS.SessionToken,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		store = awsStore
+	default:
+		return nil, fmt.Errorf("unknown secrets driver %q", cf.Secrets.Driver)
+	}
+
+	return secrets.NewResolver(store, cf.Secrets.CacheTTL), nil
+}
+
+func loadKafkaClient(cf *server.ServerConfigFile) (*kgo.Client, error) {
+	if !cf.Kafka.Enabled {
+		return nil, nil
+	}
+
+	if len(cf.Kafka.Brokers) == 0 {
+		return nil, fmt.Errorf("at least one broker is required")
+	}
+
+	if len(cf.Kafka.Topics) == 0 {
+		return nil, fmt.Errorf("at least one topic is required")
+	}
+
+	if cf.Kafka.StartFrom != "earliest" && cf.Kafka.StartFrom != "latest" {
+		return nil, fmt.Errorf("invalid start from %q, must be \"earliest\" or \"latest\"", cf.Kafka.StartFrom)
+	}
+
+	topics := make(map[string]bool, len(cf.Kafka.Topics))
+
+	for _, t := range cf.Kafka.Topics {
+		if t.Topic == "" || t.EventKey == "" {
+			return nil, fmt.Errorf("topic and eventKey are required for every topic")
+		}
+
+		if _, err := uuid.Parse(t.TenantId); err != nil {
+			return nil, fmt.Errorf("invalid tenant id %q for topic %s", t.TenantId, t.Topic)
+		}
+
+		if topics[t.Topic] {
+			return nil, fmt.Errorf("topic %s is configured more than once", t.Topic)
+		}
+
+		topics[t.Topic] = true
+	}
+
+	var tlsConfig *tls.Config
+
+	switch cf.Kafka.TLS.TLSStrategy {
+	case "none":
+	case "tls", "mtls":
+		res, ca, err := loaderutils.LoadBaseTLSConfig(&cf.Kafka.TLS)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not load kafka TLS config: %w", err)
+		}
+
+		if ca != nil {
+			res.RootCAs = ca
+		}
+
+		// many brokers don't support TLS 1.3 yet
+		res.MinVersion = tls.VersionTLS12
+
+		tlsConfig = res
+	default:
+		return nil, fmt.Errorf("invalid TLS strategy: %s", cf.Kafka.TLS.TLSStrategy)
+	}
+
+	opts := []kgo.Opt{
+		kgo.SeedBrokers(cf.Kafka.Brokers...),
+		kgo.ClientID(cf.Kafka.ClientID),
+	}
+
+	if tlsConfig != nil {
+		opts = append(opts, kgo.DialTLSConfig(tlsConfig))
+	}
+
+	mechanism, err := loadKafkaSASLMechanism(&cf.Kafka.SASL)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if mechanism != nil {
+		opts = append(opts, kgo.SASL(mechanism))
+	}
+
+	return kgo.NewClient(opts...)
+}
+
+// loadKafkaSASLMechanism returns the SASL mechanism which connections to the brokers are authenticated with, or nil
+// if connections aren't authenticated.
+func loadKafkaSASLMechanism(cf *server.KafkaSASLConfigFile) (sasl.Mechanism, error) {
+	switch strings.ToUpper(cf.Mechanism) {
+	case "":
+		return nil, nil
+	case "PLAIN":
+		return plain.Auth{User: cf.Username, Pass: cf.Password}.AsMechanism(), nil
+	case "SCRAM-SHA-256":
+		return scram.Auth{User: cf.Username, Pass: cf.Password}.AsSha256Mechanism(), nil
+	case "SCRAM-SHA-512":
+		return scram.Auth{User: cf.Username, Pass: cf.Password}.AsSha512Mechanism(), nil
+	default:
+		return nil, fmt.Errorf("unsupported SASL mechanism %q, must be PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512", cf.Mechanism)
+	}
+}
+
+func getSchedulingPoolOpts(cf *server.ServerConfigFile) ([]v2.SchedulingPoolOpt, error) {
+	defaultPolicy, err := v2.ParseAssignmentPolicy(cf.Scheduler.AssignmentPolicy)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not parse scheduler assignment policy: %w", err)
+	}
+
+	tenantPolicies := make(map[string]v2.AssignmentPolicy, len(cf.Scheduler.TenantAssignmentPolicies))
+
+	for tenantId, policyStr := range cf.Scheduler.TenantAssignmentPolicies {
+		policy, err := v2.ParseAssignmentPolicy(policyStr)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not parse scheduler assignment policy for tenant %s: %w", tenantId, err)
+		}
+
+		tenantPolicies[tenantId] = policy
+	}
+
+	queuePolicies := make(map[string]v2.AssignmentPolicy, len(cf.Scheduler.QueueAssignmentPolicies))
+
+	for queueName, policyStr := range cf.Scheduler.QueueAssignmentPolicies {
+		policy, err := v2.ParseAssignmentPolicy(policyStr)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not parse scheduler assignment policy for queue %s: %w", queueName, err)
+		}
+
+		queuePolicies[queueName] = policy
+	}
+
+	opts := []v2.SchedulingPoolOpt{
+		v2.WithAssignmentPolicies(defaultPolicy, tenantPolicies),
+		v2.WithQueueAssignmentPolicies(queuePolicies),
+		v2.WithFairShareWeights(cf.Scheduler.FairShareWeights),
+		v2.WithQueueShards(cf.Scheduler.QueueShards),
+	}
+
+	if cf.Scheduler.AssignmentStrategy != "" {
+		strategy, err := v2.GetAssignmentStrategy(cf.Scheduler.AssignmentStrategy)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not get scheduler assignment strategy: %w", err)
+		}
+
+		opts = append(opts, v2.WithAssignmentStrategy(strategy))
+	}
+
+	if cf.Scheduler.WarmStandby {
+		opts = append(opts, v2.WithWarmStandby(cf.Scheduler.StandbyPollInterval))
+	}
+
+	if cf.Scheduler.DecisionLogSize > 0 {
+		opts = append(opts, v2.WithSchedulingDecisionLog(cf.Scheduler.DecisionLogSize))
+	}
+
+	if cf.Scheduler.TenantBudgetSchedulingTime > 0 || cf.Scheduler.TenantBudgetIterations > 0 {
+		opts = append(opts, v2.WithTenantBudget(
+			cf.Scheduler.TenantBudgetWindow,
+			cf.Scheduler.TenantBudgetSchedulingTime,
+			cf.Scheduler.TenantBudgetIterations,
+		))
+	}
+
+	if cf.Scheduler.MaxAssignedPerWorker > 0 || cf.Scheduler.MaxAssignedPerTick > 0 {
+		opts = append(opts, v2.WithAssignmentLimits(cf.Scheduler.MaxAssignedPerWorker, cf.Scheduler.MaxAssignedPerTick))
+	}
+
+	if cf.Scheduler.DryRun {
+		opts = append(opts, v2.WithDryRun(nil))
+	}
+
+	opts = append(opts, v2.WithDynamicRateLimitDefaults(cf.Scheduler.DynamicRateLimitDefault, cf.Scheduler.DynamicRateLimitTTL))
+
+	return opts, nil
+}
+
 func getStrArr(v string) []string {
 	return strings.Split(v, " ")
 }
@@ -592,3 +1125,34 @@ func loadEncryptionSvc(cf *server.ServerConfigFile) (encryption.EncryptionServic
 
 	return encryptionSvc, nil
 }
+
+// messageQueueDriverConfig returns the decoder of the config of the message queue driver of the given kind. The
+// config of the built in drivers is typed, and the config of other drivers is set under msgQueue.<kind>.
+func messageQueueDriverConfig(cf *server.MessageQueueConfigFile) func(v interface{}) error {
+	return func(v interface{}) error {
+		var driverCf interface{}
+
+		switch cf.Kind {
+		case "rabbitmq":
+			driverCf = cf.RabbitMQ
+		case "nats":
+			driverCf = cf.NATS
+		case "postgres":
+			driverCf = cf.Postgres
+		default:
+			driverCf = cf.Drivers[cf.Kind]
+		}
+
+		if driverCf == nil {
+			return nil
+		}
+
+		data, err := json.Marshal(driverCf)
+
+		if err != nil {
+			return err
+		}
+
+		return json.Unmarshal(data, v)
+	}
+}
@@ -6,14 +6,18 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/spf13/viper"
+	"github.com/twmb/franz-go/pkg/kgo"
 	"golang.org/x/oauth2"
 
 	"github.com/hatchet-dev/hatchet/internal/integrations/alerting"
+	"github.com/hatchet-dev/hatchet/internal/integrations/blobstore"
 	"github.com/hatchet-dev/hatchet/internal/integrations/email"
+	"github.com/hatchet-dev/hatchet/internal/integrations/secrets"
 	"github.com/hatchet-dev/hatchet/internal/msgqueue"
 	"github.com/hatchet-dev/hatchet/internal/services/ingestor"
 	"github.com/hatchet-dev/hatchet/pkg/analytics"
 	"github.com/hatchet-dev/hatchet/pkg/auth/cookie"
+	"github.com/hatchet-dev/hatchet/pkg/auth/oidc"
 	"github.com/hatchet-dev/hatchet/pkg/auth/token"
 	"github.com/hatchet-dev/hatchet/pkg/config/database"
 	"github.com/hatchet-dev/hatchet/pkg/config/shared"
@@ -48,6 +52,9 @@ type ServerConfigFile struct {
 
 	EnableWorkerRetention bool `mapstructure:"enableWorkerRetention" json:"enableWorkerRetention,omitempty" default:"false"`
 
+	// Retention configures the pruning of soft-deleted data, which is enabled by EnableDataRetention
+	Retention RetentionConfigFile `mapstructure:"retention" json:"retention,omitempty"`
+
 	TLS shared.TLSConfigFile `mapstructure:"tls" json:"tls,omitempty"`
 
 	Logger shared.LoggerConfigFile `mapstructure:"logger" json:"logger,omitempty"`
@@ -61,6 +68,18 @@ type ServerConfigFile struct {
 	TenantAlerting ConfigFileTenantAlerting `mapstructure:"tenantAlerting" json:"tenantAlerting,omitempty"`
 
 	Email ConfigFileEmail `mapstructure:"email" json:"email,omitempty"`
+
+	Scheduler ConfigFileScheduler `mapstructure:"scheduler" json:"scheduler,omitempty"`
+
+	BlobStorage ConfigFileBlobStorage `mapstructure:"blobStorage" json:"blobStorage,omitempty"`
+
+	Secrets ConfigFileSecrets `mapstructure:"secrets" json:"secrets,omitempty"`
+
+	Kafka ConfigFileKafka `mapstructure:"kafka" json:"kafka,omitempty"`
+
+	Prometheus ConfigFilePrometheus `mapstructure:"prometheus" json:"prometheus,omitempty"`
+
+	KedaScaler ConfigFileKedaScaler `mapstructure:"kedaScaler" json:"kedaScaler,omitempty"`
 }
 
 type ConfigFileAdditionalLoggers struct {
@@ -94,6 +113,10 @@ type ConfigFileRuntime struct {
 	// GRPCInsecure controls whether the grpc server is insecure or uses certs
 	GRPCInsecure bool `mapstructure:"grpcInsecure" json:"grpcInsecure,omitempty" default:"false"`
 
+	// GRPCClientCertAuth controls whether workers can authenticate to the grpc server with a client certificate instead of
+	// an api token. The certificate's SPIFFE ID is mapped to a tenant by the client CAs of the tenants.
+	GRPCClientCertAuth bool `mapstructure:"grpcClientCertAuth" json:"grpcClientCertAuth,omitempty" default:"false"`
+
 	// GRPCMaxMsgSize is the maximum message size that the grpc server will accept
 	GRPCMaxMsgSize int `mapstructure:"grpcMaxMsgSize" json:"grpcMaxMsgSize,omitempty" default:"4194304"`
 
@@ -103,12 +126,25 @@ type ConfigFileRuntime struct {
 	// ShutdownWait is the time between the readiness probe being offline when a shutdown is triggered and the actual start of cleaning up resources.
 	ShutdownWait time.Duration `mapstructure:"shutdownWait" json:"shutdownWait,omitempty" default:"20s"`
 
+	// ShutdownDrainTimeout is the time after ShutdownWait in which the engine stops accepting work and finishes the
+	// messages which it's handling. Once it passes, the remaining work is abandoned, and the engine releases its leases
+	// and flushes telemetry before it exits.
+	ShutdownDrainTimeout time.Duration `mapstructure:"shutdownDrainTimeout" json:"shutdownDrainTimeout,omitempty" default:"60s"`
+
+	// TenantPartitions is the number of partitions which the tenants are hashed into. Every engine claims a share of
+	// the partitions through leases and runs the controllers of their tenants. If 0, the tenants are spread across the
+	// engines by rebalancing instead.
+	TenantPartitions int `mapstructure:"tenantPartitions" json:"tenantPartitions,omitempty" default:"0"`
+
 	// Enforce limits controls whether the server enforces tenant limits
 	EnforceLimits bool `mapstructure:"enforceLimits" json:"enforceLimits,omitempty" default:"false"`
 
 	// Default limit values
 	Limits LimitConfigFile `mapstructure:"limits" json:"limits,omitempty"`
 
+	// Default quotas of tenants, which tenants can override with their own quotas
+	Quotas QuotaConfigFile `mapstructure:"quotas" json:"quotas,omitempty"`
+
 	// RequeueLimit is the number of times a message will be requeued in each attempt
 	RequeueLimit int `mapstructure:"requeueLimit" json:"requeueLimit,omitempty" default:"100"`
 
@@ -136,12 +172,24 @@ type ConfigFileRuntime struct {
 	// Buffer create workflow runs
 	BufferCreateWorkflowRuns bool `mapstructure:"bufferCreateWorkflowRuns" json:"bufferCreateWorkflowRuns,omitempty" default:"true"`
 
+	// IdempotencyKeyTTL is how long an idempotency key returns the workflow run it was first used for
+	IdempotencyKeyTTL time.Duration `mapstructure:"idempotencyKeyTTL" json:"idempotencyKeyTTL,omitempty" default:"24h"`
+
 	// DisableTenantPubs controls whether tenant pubsub is disabled
 	DisableTenantPubs bool `mapstructure:"disableTenantPubs" json:"disableTenantPubs,omitempty"`
 
 	// MaxInternalRetryCount is the maximum number of internal retries before a step run is considered failed (default: 3)
 	MaxInternalRetryCount int32 `mapstructure:"maxInternalRetryCount" json:"maxInternalRetryCount,omitempty" default:"3"`
 
+	// StepRunHeartbeatTimeout is how long a running step run can go without a heartbeat before it is reassigned.
+	// Workers send the ids of their running step runs with every worker heartbeat, so this requires workers to run an
+	// SDK which sends step run heartbeats. If 0, step runs are only reassigned when their worker becomes inactive.
+	StepRunHeartbeatTimeout time.Duration `mapstructure:"stepRunHeartbeatTimeout" json:"stepRunHeartbeatTimeout,omitempty" default:"0s"`
+
+	// MaxLogLinesPerStepRun is the maximum number of log lines which are stored for a single step run. Log lines over
+	// the limit are dropped. If 0, log lines are not limited.
+	MaxLogLinesPerStepRun int `mapstructure:"maxLogLinesPerStepRun" json:"maxLogLinesPerStepRun,omitempty" default:"10000"`
+
 	// WaitForFlush is the time to wait for the buffer to flush used for exerting some back pressure on writers
 	WaitForFlush time.Duration `mapstructure:"waitForFlush" json:"waitForFlush,omitempty" default:"1ms"`
 
@@ -168,6 +216,82 @@ type ConfigFileRuntime struct {
 
 	// QueueStepRunBuffer represents the buffer settings for inserting step runs into the queue
 	QueueStepRunBuffer buffer.ConfigFileBuffer `mapstructure:"queueStepRunBuffer" json:"queueStepRunBuffer,omitempty"`
+
+	// StreamEventBuffer represents the buffer settings for stream events
+	StreamEventBuffer buffer.ConfigFileBuffer `mapstructure:"streamEventBuffer" json:"streamEventBuffer,omitempty"`
+
+	// HeartbeatBuffer represents the settings of the buffer which coalesces worker heartbeats. Heartbeats which weren't
+	// flushed are lost if the engine crashes.
+	HeartbeatBuffer buffer.ConfigFileCoalescingBuffer `mapstructure:"heartbeatBuffer" json:"heartbeatBuffer,omitempty"`
+}
+
+type ConfigFileScheduler struct {
+	// AssignmentPolicy is the default policy for pulling items from a queue. Supported values are "fifo",
+	// "fair-share" and "edf" (earliest deadline first).
+	AssignmentPolicy string `mapstructure:"assignmentPolicy" json:"assignmentPolicy,omitempty" default:"fifo"`
+
+	// TenantAssignmentPolicies overrides the assignment policy for specific tenants, keyed by tenant id
+	TenantAssignmentPolicies map[string]string `mapstructure:"tenantAssignmentPolicies" json:"tenantAssignmentPolicies,omitempty"`
+
+	// QueueAssignmentPolicies overrides the assignment policy for specific queues, keyed by queue name. Queue
+	// overrides take precedence over tenant overrides.
+	QueueAssignmentPolicies map[string]string `mapstructure:"queueAssignmentPolicies" json:"queueAssignmentPolicies,omitempty"`
+
+	// FairShareWeights sets the weight of a workflow, keyed by workflow id, when using the fair-share policy.
+	// Workflows default to a weight of 1.
+	FairShareWeights map[string]int32 `mapstructure:"fairShareWeights" json:"fairShareWeights,omitempty"`
+
+	// QueueShards splits hot queues into the given number of shards, keyed by queue name. Each shard is leased
+	// separately, so that multiple schedulers can assign queue items from the same queue in parallel.
+	QueueShards map[string]int `mapstructure:"queueShards" json:"queueShards,omitempty"`
+
+	// AssignmentStrategy is the name of a registered strategy for assigning queue items to workers. Built-in
+	// strategies are "least-loaded" and "bin-packing". If empty, queue items are spread across workers in a
+	// round robin.
+	AssignmentStrategy string `mapstructure:"assignmentStrategy" json:"assignmentStrategy,omitempty"`
+
+	// WarmStandby runs the scheduler as a warm standby for all tenants. A standby scheduler keeps tenant state
+	// loaded while the leases are held by another scheduler, and takes over as soon as the leases are released
+	// or expire.
+	WarmStandby bool `mapstructure:"warmStandby" json:"warmStandby,omitempty" default:"false"`
+
+	// StandbyPollInterval is how often a warm standby checks for available leases
+	StandbyPollInterval time.Duration `mapstructure:"standbyPollInterval" json:"standbyPollInterval,omitempty" default:"100ms"`
+
+	// DecisionLogSize is the number of scheduling decisions to keep for each tenant. Scheduling decisions record
+	// why each step run was or wasn't assigned to a worker. If 0, scheduling decisions are not recorded.
+	DecisionLogSize int `mapstructure:"decisionLogSize" json:"decisionLogSize,omitempty" default:"0"`
+
+	// TenantBudgetWindow is the window over which tenant scheduling budgets are measured
+	TenantBudgetWindow time.Duration `mapstructure:"tenantBudgetWindow" json:"tenantBudgetWindow,omitempty" default:"1s"`
+
+	// TenantBudgetSchedulingTime is the maximum time a tenant's queues may spend processing queue items in each
+	// budget window. Tenants which exceed their budget are throttled until the next window. If 0, scheduling
+	// time is not limited.
+	TenantBudgetSchedulingTime time.Duration `mapstructure:"tenantBudgetSchedulingTime" json:"tenantBudgetSchedulingTime,omitempty" default:"0s"`
+
+	// TenantBudgetIterations is the maximum number of queue iterations for a tenant in each budget window. If 0,
+	// queue iterations are not limited.
+	TenantBudgetIterations int `mapstructure:"tenantBudgetIterations" json:"tenantBudgetIterations,omitempty" default:"0"`
+
+	// MaxAssignedPerWorker is the maximum number of step runs assigned to a single worker in each queue tick.
+	// Step runs over the limit are retried on the next tick. If 0, assignments per worker are not limited.
+	MaxAssignedPerWorker int `mapstructure:"maxAssignedPerWorker" json:"maxAssignedPerWorker,omitempty" default:"0"`
+
+	// MaxAssignedPerTick is the maximum number of step runs assigned in each queue tick. Step runs over the
+	// limit are retried on the next tick. If 0, assignments per tick are not limited.
+	MaxAssignedPerTick int `mapstructure:"maxAssignedPerTick" json:"maxAssignedPerTick,omitempty" default:"0"`
+
+	// DryRun runs the scheduler in dry-run mode. A dry-run scheduler computes assignments against the live
+	// queues and workers without acquiring leases or dispatching step runs, and logs each decision instead.
+	DryRun bool `mapstructure:"dryRun" json:"dryRun,omitempty" default:"false"`
+
+	// DynamicRateLimitDefault is the limit for dynamic rate limit keys which don't declare a limit expression.
+	DynamicRateLimitDefault int `mapstructure:"dynamicRateLimitDefault" json:"dynamicRateLimitDefault,omitempty" default:"10"`
+
+	// DynamicRateLimitTTL is how long dynamic rate limit keys are kept after they were last used. If 0, dynamic
+	// rate limit keys never expire.
+	DynamicRateLimitTTL time.Duration `mapstructure:"dynamicRateLimitTTL" json:"dynamicRateLimitTTL,omitempty" default:"24h"`
 }
 
 type SecurityCheckConfigFile struct {
@@ -196,6 +320,49 @@ type LimitConfigFile struct {
 	DefaultScheduleAlarmLimit int `mapstructure:"defaultScheduleAlarmLimit" json:"defaultScheduleAlarmLimit,omitempty" default:"750"`
 }
 
+// QuotaConfigFile holds the default quotas of tenants, which are enforced at the API and on ingestion regardless of
+// EnforceLimits. A quota of 0 is unlimited.
+type QuotaConfigFile struct {
+	// DefaultMaxQueuedRuns is the maximum number of workflow runs which are pending or queued at a time
+	DefaultMaxQueuedRuns int `mapstructure:"defaultMaxQueuedRuns" json:"defaultMaxQueuedRuns,omitempty" default:"0"`
+
+	// DefaultMaxWorkers is the maximum number of active workers
+	DefaultMaxWorkers int `mapstructure:"defaultMaxWorkers" json:"defaultMaxWorkers,omitempty" default:"0"`
+
+	// DefaultMaxEventsPerMinute is the maximum number of events which are ingested in a minute
+	DefaultMaxEventsPerMinute int `mapstructure:"defaultMaxEventsPerMinute" json:"defaultMaxEventsPerMinute,omitempty" default:"0"`
+
+	// DefaultMaxWorkflowVersions is the maximum number of workflow versions across the workflows of the tenant
+	DefaultMaxWorkflowVersions int `mapstructure:"defaultMaxWorkflowVersions" json:"defaultMaxWorkflowVersions,omitempty" default:"0"`
+}
+
+// RetentionConfigFile configures the pruner, which permanently deletes the workflow runs, step runs and events
+// which were soft-deleted by the data retention of their tenant.
+type RetentionConfigFile struct {
+	// PruneAfter is the time after which soft-deleted data is permanently deleted
+	PruneAfter time.Duration `mapstructure:"pruneAfter" json:"pruneAfter,omitempty" default:"24h"`
+
+	// PruneBatchSize is the maximum number of rows which are deleted in a single transaction
+	PruneBatchSize int `mapstructure:"pruneBatchSize" json:"pruneBatchSize,omitempty" default:"1000"`
+
+	// PruneWindow restricts pruning to a daily window in UTC, like 01:00-05:00. Data is pruned at any time if it's
+	// empty.
+	PruneWindow string `mapstructure:"pruneWindow" json:"pruneWindow,omitempty"`
+
+	// Archive writes the workflow runs and events to the blob storage before they're deleted, which requires blob
+	// storage to be configured
+	Archive bool `mapstructure:"archive" json:"archive,omitempty" default:"false"`
+
+	// ArchiveRunsAfter is the time after which the job runs, step runs, events and payloads of finished workflow
+	// runs are moved to the blob storage, which requires blob storage to be configured. Runs aren't archived if it's
+	// 0.
+	ArchiveRunsAfter time.Duration `mapstructure:"archiveRunsAfter" json:"archiveRunsAfter,omitempty"`
+
+	// ArchiveBatchSize is the maximum number of workflow runs of a tenant which are archived in a single run of the
+	// archiver
+	ArchiveBatchSize int `mapstructure:"archiveBatchSize" json:"archiveBatchSize,omitempty" default:"100"`
+}
+
 // Alerting options
 type AlertingConfigFile struct {
 	Sentry SentryConfigFile `mapstructure:"sentry" json:"sentry,omitempty"`
@@ -246,6 +413,14 @@ type EncryptionConfigFile struct {
 
 	// CloudKMS is the configuration for Google Cloud KMS. You must set either MasterKeyset or cloudKms.enabled.
 	CloudKMS EncryptionConfigFileCloudKMS `mapstructure:"cloudKms" json:"cloudKms,omitempty"`
+
+	Payloads EncryptionConfigFilePayloads `mapstructure:"payloads" json:"payloads,omitempty"`
+}
+
+type EncryptionConfigFilePayloads struct {
+	// Enabled controls whether step run inputs and outputs and event data are encrypted at rest with per-tenant
+	// data keys. Payloads which were stored before this was enabled stay readable.
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty" default:"false"`
 }
 
 type EncryptionConfigFileJWT struct {
@@ -294,6 +469,9 @@ type ConfigFileAuth struct {
 	Google ConfigFileAuthGoogle `mapstructure:"google" json:"google,omitempty"`
 
 	Github ConfigFileAuthGithub `mapstructure:"github" json:"github,omitempty"`
+
+	// OIDC configures sign in with an OpenID Connect provider
+	OIDC ConfigFileAuthOIDC `mapstructure:"oidc" json:"oidc,omitempty"`
 }
 
 type ConfigFileTenantAlerting struct {
@@ -324,6 +502,38 @@ type ConfigFileAuthGithub struct {
 	Scopes       []string `mapstructure:"scopes" json:"scopes,omitempty" default:"[\"read:user\", \"user:email\"]"`
 }
 
+type ConfigFileAuthOIDC struct {
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty" default:"false"`
+
+	// Preset sets the issuer and defaults of a known provider, either google or okta. Okta still requires the
+	// issuer URL of the Okta org or authorization server.
+	Preset string `mapstructure:"preset" json:"preset,omitempty"`
+
+	// Name is the name of the provider which is shown on the login page
+	Name string `mapstructure:"name" json:"name,omitempty" default:"SSO"`
+
+	// IssuerURL is the issuer of the provider, the discovery document is read from
+	// <IssuerURL>/.well-known/openid-configuration
+	IssuerURL string `mapstructure:"issuerURL" json:"issuerURL,omitempty"`
+
+	ClientID     string   `mapstructure:"clientID" json:"clientID,omitempty"`
+	ClientSecret string   `mapstructure:"clientSecret" json:"clientSecret,omitempty"`
+	Scopes       []string `mapstructure:"scopes" json:"scopes,omitempty" default:"[\"openid\", \"profile\", \"email\"]"`
+
+	// GroupsClaim is the claim of the ID token which contains the groups of the user
+	GroupsClaim string `mapstructure:"groupsClaim" json:"groupsClaim,omitempty" default:"groups"`
+
+	// GroupMappings grant tenant roles to the members of groups, in the format <group>=<tenant id>:<role>. The
+	// memberships are created or upgraded when a user signs in, they're never removed.
+	GroupMappings []string `mapstructure:"groupMappings" json:"groupMappings,omitempty"`
+
+	// JITProvisioning creates users on their first sign in. When disabled, users must already exist.
+	JITProvisioning bool `mapstructure:"jitProvisioning" json:"jitProvisioning,omitempty" default:"true"`
+
+	// AllowUnverifiedEmail signs in users whose email isn't verified by the provider
+	AllowUnverifiedEmail bool `mapstructure:"allowUnverifiedEmail" json:"allowUnverifiedEmail,omitempty" default:"false"`
+}
+
 type ConfigFileAuthCookie struct {
 	Name     string `mapstructure:"name" json:"name,omitempty" default:"hatchet"`
 	Domain   string `mapstructure:"domain" json:"domain,omitempty"`
@@ -334,9 +544,17 @@ type ConfigFileAuthCookie struct {
 type MessageQueueConfigFile struct {
 	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty" default:"true"`
 
-	Kind string `mapstructure:"kind" json:"kind,omitempty" validate:"required"`
+	// Kind is the name of the driver of the message queue. The built in drivers are rabbitmq, nats and postgres.
+	Kind string `mapstructure:"kind" json:"kind,omitempty" validate:"required" default:"rabbitmq"`
 
 	RabbitMQ RabbitMQConfigFile `mapstructure:"rabbitmq" json:"rabbitmq,omitempty" validate:"required"`
+
+	NATS NATSConfigFile `mapstructure:"nats" json:"nats,omitempty"`
+
+	Postgres PostgresMessageQueueConfigFile `mapstructure:"postgres" json:"postgres,omitempty"`
+
+	// Drivers is the config of the drivers which aren't built in, which is set under msgQueue.<kind>
+	Drivers map[string]interface{} `mapstructure:",remain" json:"drivers,omitempty"`
 }
 
 type RabbitMQConfigFile struct {
@@ -344,6 +562,22 @@ type RabbitMQConfigFile struct {
 	Qos int    `mapstructure:"qos" json:"qos,omitempty" default:"100"`
 }
 
+type NATSConfigFile struct {
+	URL string `mapstructure:"url" json:"url,omitempty" default:"nats://localhost:4222"`
+	Qos int    `mapstructure:"qos" json:"qos,omitempty" default:"100"`
+
+	// Prefix is the prefix of the subjects and streams of the queues
+	Prefix string `mapstructure:"prefix" json:"prefix,omitempty" default:"hatchet"`
+
+	// Replicas is the number of replicas of the streams in a clustered server
+	Replicas int `mapstructure:"replicas" json:"replicas,omitempty" default:"1"`
+}
+
+// PostgresMessageQueueConfigFile configures the message queue which stores messages in the database of the server.
+type PostgresMessageQueueConfigFile struct {
+	Qos int `mapstructure:"qos" json:"qos,omitempty" default:"100"`
+}
+
 type ConfigFileEmail struct {
 	Postmark PostmarkConfigFile `mapstructure:"postmark" json:"postmark,omitempty"`
 }
@@ -357,6 +591,171 @@ type PostmarkConfigFile struct {
 	SupportEmail string `mapstructure:"supportEmail" json:"supportEmail,omitempty"`
 }
 
+type ConfigFileBlobStorage struct {
+	// Driver is the blob store which large step run inputs and outputs are moved to. Supported values are
+	// "filesystem", "s3" and "gcs". If empty, all payloads are stored in the database.
+	Driver string `mapstructure:"driver" json:"driver,omitempty"`
+
+	// MaxInputSize is the size in bytes above which step run inputs are moved to the blob store. If 0, inputs are
+	// always stored in the database.
+	MaxInputSize int `mapstructure:"maxInputSize" json:"maxInputSize,omitempty" default:"262144"`
+
+	// MaxOutputSize is the size in bytes above which step run outputs are moved to the blob store. If 0, outputs
+	// are always stored in the database.
+	MaxOutputSize int `mapstructure:"maxOutputSize" json:"maxOutputSize,omitempty" default:"262144"`
+
+	Filesystem BlobStorageFilesystemConfigFile `mapstructure:"filesystem" json:"filesystem,omitempty"`
+
+	S3 BlobStorageS3ConfigFile `mapstructure:"s3" json:"s3,omitempty"`
+}
+
+type BlobStorageFilesystemConfigFile struct {
+	Dir string `mapstructure:"dir" json:"dir,omitempty" default:"./blobs"`
+}
+
+// BlobStorageS3ConfigFile configures the s3 and gcs drivers. The gcs driver uses the S3-compatible API of Google
+// Cloud Storage with HMAC keys.
+type BlobStorageS3ConfigFile struct {
+	// Endpoint defaults to the endpoint of the region for s3 and https://storage.googleapis.com for gcs
+	Endpoint string `mapstructure:"endpoint" json:"endpoint,omitempty"`
+
+	// Region defaults to the region of the environment of the AWS SDK for s3, and auto for gcs
+	Region string `mapstructure:"region" json:"region,omitempty"`
+
+	Bucket string `mapstructure:"bucket" json:"bucket,omitempty"`
+
+	Prefix string `mapstructure:"prefix" json:"prefix,omitempty"`
+
+	// AccessKeyID, SecretAccessKey and SessionToken are static credentials. If they aren't set, the default
+	// credential chain of the AWS SDK is used, like the IAM role of the service account or the instance.
+	AccessKeyID string `mapstructure:"accessKeyID" json:"accessKeyID,omitempty"`
+
+	SecretAccessKey string `mapstructure:"secretAccessKey" json:"secretAccessKey,omitempty"`
+
+	SessionToken string `mapstructure:"sessionToken" json:"sessionToken,omitempty"`
+
+	PathStyle bool `mapstructure:"pathStyle" json:"pathStyle,omitempty" default:"false"`
+}
+
+type ConfigFileSecrets struct {
+	// Driver is the secrets store which {{ secrets.NAME }} references in step run inputs are resolved from.
+	// Supported values are "vault" and "aws". If empty, secrets are disabled.
+	Driver string `mapstructure:"driver" json:"driver,omitempty"`
+
+	// CacheTTL is how long resolved secrets are cached in memory by the dispatcher. If 0, secrets are fetched for
+	// every step run.
+	CacheTTL time.Duration `mapstructure:"cacheTTL" json:"cacheTTL,omitempty" default:"30s"`
+
+	Vault SecretsVaultConfigFile `mapstructure:"vault" json:"vault,omitempty"`
+
+	AWS SecretsAWSConfigFile `mapstructure:"aws" json:"aws,omitempty"`
+}
+
+// SecretsVaultConfigFile configures the vault driver, which stores secrets in a KV version 2 secrets engine.
+type SecretsVaultConfigFile struct {
+	Address string `mapstructure:"address" json:"address,omitempty"`
+
+	// Token defaults to the VAULT_TOKEN environment variable
+	Token string `mapstructure:"token" json:"token,omitempty"`
+
+	Namespace string `mapstructure:"namespace" json:"namespace,omitempty"`
+
+	Mount string `mapstructure:"mount" json:"mount,omitempty" default:"secret"`
+
+	Prefix string `mapstructure:"prefix" json:"prefix,omitempty" default:"hatchet/"`
+}
+
+// SecretsAWSConfigFile configures the aws driver, which stores secrets in AWS Secrets Manager.
+type SecretsAWSConfigFile struct {
+	// Endpoint defaults to the endpoint of the region
+	Endpoint string `mapstructure:"endpoint" json:"endpoint,omitempty"`
+
+	// Region defaults to the region of the environment of the AWS SDK
+	Region string `mapstructure:"region" json:"region,omitempty"`
+
+	Prefix string `mapstructure:"prefix" json:"prefix,omitempty" default:"hatchet/"`
+
+	// AccessKeyID, SecretAccessKey and SessionToken are static credentials. If they aren't set, the default
+	// credential chain of the AWS SDK is used, like the IAM role of the service account or the instance.
+	AccessKeyID string `mapstructure:"accessKeyID" json:"accessKeyID,omitempty"`
+
+	SecretAccessKey string `mapstructure:"secretAccessKey" json:"secretAccessKey,omitempty"`
+
+	SessionToken string `mapstructure:"sessionToken" json:"sessionToken,omitempty"`
+}
+
+type ConfigFileKafka struct {
+	// Enabled starts a consumer in the grpc service which ingests the messages of the configured topics as events
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty" default:"false"`
+
+	// Brokers are the host:port addresses of the brokers which the consumer bootstraps from
+	Brokers []string `mapstructure:"brokers" json:"brokers,omitempty"`
+
+	ClientID string `mapstructure:"clientID" json:"clientID,omitempty" default:"hatchet"`
+
+	// ConsumerGroup is the name which offsets are stored under. Engines with the same consumer group share the
+	// partitions of the topics, and each partition is consumed by a single engine at a time.
+	ConsumerGroup string `mapstructure:"consumerGroup" json:"consumerGroup,omitempty" default:"hatchet"`
+
+	// StartFrom is where a partition without a stored offset is consumed from, either "earliest" or "latest". It's
+	// also used when the stored offset was deleted by the retention of the topic.
+	StartFrom string `mapstructure:"startFrom" json:"startFrom,omitempty" default:"latest"`
+
+	// FetchMaxBytes is the maximum number of bytes fetched from a partition in a single request
+	FetchMaxBytes int32 `mapstructure:"fetchMaxBytes" json:"fetchMaxBytes,omitempty" default:"1048576"`
+
+	// TLS configures TLS for the connections to the brokers. Set the strategy to "none" for plaintext listeners.
+	TLS shared.TLSConfigFile `mapstructure:"tls" json:"tls,omitempty"`
+
+	SASL KafkaSASLConfigFile `mapstructure:"sasl" json:"sasl,omitempty"`
+
+	// Topics map topics to the event keys which their messages are ingested as
+	Topics []KafkaTopicConfigFile `mapstructure:"topics" json:"topics,omitempty"`
+}
+
+type ConfigFilePrometheus struct {
+	// Enabled starts an http server in the engine which exposes the engine metrics in the Prometheus format
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty" default:"false"`
+
+	// Address is the address which the metrics server listens on
+	Address string `mapstructure:"address" json:"address,omitempty" default:":9999"`
+
+	// Path is the path which the metrics are served on
+	Path string `mapstructure:"path" json:"path,omitempty" default:"/metrics"`
+}
+
+type ConfigFileKedaScaler struct {
+	// Enabled starts a grpc server in the engine which implements the KEDA external scaler contract
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty" default:"false"`
+
+	// Address is the address which the scaler server listens on
+	Address string `mapstructure:"address" json:"address,omitempty" default:":9500"`
+}
+
+type KafkaSASLConfigFile struct {
+	// Mechanism is the SASL mechanism, either "PLAIN", "SCRAM-SHA-256" or "SCRAM-SHA-512". If empty, connections
+	// aren't authenticated.
+	Mechanism string `mapstructure:"mechanism" json:"mechanism,omitempty"`
+
+	Username string `mapstructure:"username" json:"username,omitempty"`
+
+	Password string `mapstructure:"password" json:"password,omitempty"`
+}
+
+type KafkaTopicConfigFile struct {
+	Topic string `mapstructure:"topic" json:"topic,omitempty"`
+
+	// TenantId is the tenant which the events are ingested into
+	TenantId string `mapstructure:"tenantId" json:"tenantId,omitempty"`
+
+	EventKey string `mapstructure:"eventKey" json:"eventKey,omitempty"`
+
+	// Transform is an optional CEL expression which returns the data of the event as a map. It has access to the
+	// payload, key, headers, topic, partition and offset of the message. If empty, the payload of the message is
+	// used as the data of the event, and must be a JSON object.
+	Transform string `mapstructure:"transform" json:"transform,omitempty"`
+}
+
 type AuthConfig struct {
 	RestrictedEmailDomains []string
 
@@ -366,6 +765,11 @@ type AuthConfig struct {
 
 	GithubOAuthConfig *oauth2.Config
 
+	// OIDCProvider is set when sign in with an OpenID Connect provider is enabled
+	OIDCProvider *oidc.Provider
+
+	OIDCGroupMappings []oidc.GroupMapping
+
 	JWTManager token.JWTManager
 }
 
@@ -395,6 +799,9 @@ type ServerConfig struct {
 
 	Encryption encryption.EncryptionService
 
+	// PayloadEncryption encrypts the payloads of tenants at rest, it's nil if payload encryption is disabled
+	PayloadEncryption *encryption.PayloadEncryption
+
 	Runtime ConfigFileRuntime
 
 	Services []string
@@ -403,6 +810,8 @@ type ServerConfig struct {
 
 	EnableWorkerRetention bool
 
+	Retention RetentionConfigFile
+
 	Namespaces []string
 
 	MessageQueue msgqueue.MessageQueue
@@ -428,6 +837,21 @@ type ServerConfig struct {
 	AdditionalOAuthConfigs map[string]*oauth2.Config
 
 	SchedulingPool *v2.SchedulingPool
+
+	BlobOffloader *blobstore.Offloader
+
+	// SecretResolver resolves secret references in step run inputs, it's nil if secrets are disabled
+	SecretResolver *secrets.Resolver
+
+	// KafkaClient is the client which the grpc service consumes Kafka topics with, it's nil if Kafka ingestion is
+	// disabled
+	KafkaClient *kgo.Client
+
+	Kafka ConfigFileKafka
+
+	Prometheus ConfigFilePrometheus
+
+	KedaScaler ConfigFileKedaScaler
 }
 
 func (c *ServerConfig) HasService(name string) bool {
@@ -449,20 +873,32 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("runtime.grpcBindAddress", "SERVER_GRPC_BIND_ADDRESS")
 	_ = v.BindEnv("runtime.grpcBroadcastAddress", "SERVER_GRPC_BROADCAST_ADDRESS")
 	_ = v.BindEnv("runtime.grpcInsecure", "SERVER_GRPC_INSECURE")
+	_ = v.BindEnv("runtime.grpcClientCertAuth", "SERVER_GRPC_CLIENT_CERT_AUTH")
 	_ = v.BindEnv("runtime.grpcMaxMsgSize", "SERVER_GRPC_MAX_MSG_SIZE")
 	_ = v.BindEnv("runtime.grpcRateLimit", "SERVER_GRPC_RATE_LIMIT")
 	_ = v.BindEnv("runtime.shutdownWait", "SERVER_SHUTDOWN_WAIT")
+	_ = v.BindEnv("runtime.shutdownDrainTimeout", "SERVER_SHUTDOWN_DRAIN_TIMEOUT")
+	_ = v.BindEnv("runtime.tenantPartitions", "SERVER_TENANT_PARTITIONS")
 	_ = v.BindEnv("servicesString", "SERVER_SERVICES")
 	_ = v.BindEnv("enableDataRetention", "SERVER_ENABLE_DATA_RETENTION")
 	_ = v.BindEnv("enableWorkerRetention", "SERVER_ENABLE_WORKER_RETENTION")
+	_ = v.BindEnv("retention.pruneAfter", "SERVER_RETENTION_PRUNE_AFTER")
+	_ = v.BindEnv("retention.pruneBatchSize", "SERVER_RETENTION_PRUNE_BATCH_SIZE")
+	_ = v.BindEnv("retention.pruneWindow", "SERVER_RETENTION_PRUNE_WINDOW")
+	_ = v.BindEnv("retention.archive", "SERVER_RETENTION_ARCHIVE")
+	_ = v.BindEnv("retention.archiveRunsAfter", "SERVER_RETENTION_ARCHIVE_RUNS_AFTER")
+	_ = v.BindEnv("retention.archiveBatchSize", "SERVER_RETENTION_ARCHIVE_BATCH_SIZE")
 	_ = v.BindEnv("runtime.enforceLimits", "SERVER_ENFORCE_LIMITS")
 	_ = v.BindEnv("runtime.allowSignup", "SERVER_ALLOW_SIGNUP")
 	_ = v.BindEnv("runtime.allowInvites", "SERVER_ALLOW_INVITES")
 	_ = v.BindEnv("runtime.allowCreateTenant", "SERVER_ALLOW_CREATE_TENANT")
 	_ = v.BindEnv("runtime.allowChangePassword", "SERVER_ALLOW_CHANGE_PASSWORD")
 	_ = v.BindEnv("runtime.bufferCreateWorkflowRuns", "SERVER_BUFFER_CREATE_WORKFLOW_RUNS")
+	_ = v.BindEnv("runtime.idempotencyKeyTTL", "SERVER_IDEMPOTENCY_KEY_TTL")
 	_ = v.BindEnv("runtime.disableTenantPubs", "SERVER_DISABLE_TENANT_PUBS")
 	_ = v.BindEnv("runtime.maxInternalRetryCount", "SERVER_MAX_INTERNAL_RETRY_COUNT")
+	_ = v.BindEnv("runtime.stepRunHeartbeatTimeout", "SERVER_STEP_RUN_HEARTBEAT_TIMEOUT")
+	_ = v.BindEnv("runtime.maxLogLinesPerStepRun", "SERVER_MAX_LOG_LINES_PER_STEP_RUN")
 
 	// security check options
 	_ = v.BindEnv("securityCheck.enabled", "SERVER_SECURITY_CHECK_ENABLED")
@@ -488,6 +924,12 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("runtime.limits.defaultScheduleLimit", "SERVER_LIMITS_DEFAULT_SCHEDULE_LIMIT")
 	_ = v.BindEnv("runtime.limits.defaultScheduleAlarmLimit", "SERVER_LIMITS_DEFAULT_SCHEDULE_ALARM_LIMIT")
 
+	// quota options
+	_ = v.BindEnv("runtime.quotas.defaultMaxQueuedRuns", "SERVER_QUOTAS_DEFAULT_MAX_QUEUED_RUNS")
+	_ = v.BindEnv("runtime.quotas.defaultMaxWorkers", "SERVER_QUOTAS_DEFAULT_MAX_WORKERS")
+	_ = v.BindEnv("runtime.quotas.defaultMaxEventsPerMinute", "SERVER_QUOTAS_DEFAULT_MAX_EVENTS_PER_MINUTE")
+	_ = v.BindEnv("runtime.quotas.defaultMaxWorkflowVersions", "SERVER_QUOTAS_DEFAULT_MAX_WORKFLOW_VERSIONS")
+
 	// buffer options
 	_ = v.BindEnv("runtime.workflowRunBuffer.waitForFlush", "SERVER_WORKFLOWRUNBUFFER_WAIT_FOR_FLUSH")
 	_ = v.BindEnv("runtime.workflowRunBuffer.maxConcurrent", "SERVER_WORKFLOWRUNBUFFER_MAX_CONCURRENT")
@@ -514,6 +956,15 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("runtime.queueStepRunBuffer.flushItemsThreshold", "SERVER_QUEUESTEPRUNBUFFER_FLUSH_ITEMS_THRESHOLD")
 	_ = v.BindEnv("runtime.queueStepRunBuffer.flushStrategy", "SERVER_QUEUESTEPRUNBUFFER_FLUSH_STRATEGY")
 
+	_ = v.BindEnv("runtime.streamEventBuffer.waitForFlush", "SERVER_STREAMEVENTBUFFER_WAIT_FOR_FLUSH")
+	_ = v.BindEnv("runtime.streamEventBuffer.maxConcurrent", "SERVER_STREAMEVENTBUFFER_MAX_CONCURRENT")
+	_ = v.BindEnv("runtime.streamEventBuffer.flushPeriodMilliseconds", "SERVER_STREAMEVENTBUFFER_FLUSH_PERIOD_MILLISECONDS")
+	_ = v.BindEnv("runtime.streamEventBuffer.flushItemsThreshold", "SERVER_STREAMEVENTBUFFER_FLUSH_ITEMS_THRESHOLD")
+	_ = v.BindEnv("runtime.streamEventBuffer.flushStrategy", "SERVER_STREAMEVENTBUFFER_FLUSH_STRATEGY")
+
+	_ = v.BindEnv("runtime.heartbeatBuffer.flushPeriodMilliseconds", "SERVER_HEARTBEATBUFFER_FLUSH_PERIOD_MILLISECONDS")
+	_ = v.BindEnv("runtime.heartbeatBuffer.flushItemsThreshold", "SERVER_HEARTBEATBUFFER_FLUSH_ITEMS_THRESHOLD")
+
 	_ = v.BindEnv("runtime.waitForFlush", "SERVER_WAIT_FOR_FLUSH")
 	_ = v.BindEnv("runtime.maxConcurrent", "SERVER_MAX_CONCURRENT")
 	_ = v.BindEnv("runtime.flushPeriodMilliseconds", "SERVER_FLUSH_PERIOD_MILLISECONDS")
@@ -547,6 +998,7 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("encryption.cloudKms.enabled", "SERVER_ENCRYPTION_CLOUDKMS_ENABLED")
 	_ = v.BindEnv("encryption.cloudKms.keyURI", "SERVER_ENCRYPTION_CLOUDKMS_KEY_URI")
 	_ = v.BindEnv("encryption.cloudKms.credentialsJSON", "SERVER_ENCRYPTION_CLOUDKMS_CREDENTIALS_JSON")
+	_ = v.BindEnv("encryption.payloads.enabled", "SERVER_ENCRYPTION_PAYLOADS_ENABLED")
 
 	// auth options
 	_ = v.BindEnv("auth.restrictedEmailDomains", "SERVER_AUTH_RESTRICTED_EMAIL_DOMAINS")
@@ -564,6 +1016,17 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("auth.github.clientID", "SERVER_AUTH_GITHUB_CLIENT_ID")
 	_ = v.BindEnv("auth.github.clientSecret", "SERVER_AUTH_GITHUB_CLIENT_SECRET")
 	_ = v.BindEnv("auth.github.scopes", "SERVER_AUTH_GITHUB_SCOPES")
+	_ = v.BindEnv("auth.oidc.enabled", "SERVER_AUTH_OIDC_ENABLED")
+	_ = v.BindEnv("auth.oidc.preset", "SERVER_AUTH_OIDC_PRESET")
+	_ = v.BindEnv("auth.oidc.name", "SERVER_AUTH_OIDC_NAME")
+	_ = v.BindEnv("auth.oidc.issuerURL", "SERVER_AUTH_OIDC_ISSUER_URL")
+	_ = v.BindEnv("auth.oidc.clientID", "SERVER_AUTH_OIDC_CLIENT_ID")
+	_ = v.BindEnv("auth.oidc.clientSecret", "SERVER_AUTH_OIDC_CLIENT_SECRET")
+	_ = v.BindEnv("auth.oidc.scopes", "SERVER_AUTH_OIDC_SCOPES")
+	_ = v.BindEnv("auth.oidc.groupsClaim", "SERVER_AUTH_OIDC_GROUPS_CLAIM")
+	_ = v.BindEnv("auth.oidc.groupMappings", "SERVER_AUTH_OIDC_GROUP_MAPPINGS")
+	_ = v.BindEnv("auth.oidc.jitProvisioning", "SERVER_AUTH_OIDC_JIT_PROVISIONING")
+	_ = v.BindEnv("auth.oidc.allowUnverifiedEmail", "SERVER_AUTH_OIDC_ALLOW_UNVERIFIED_EMAIL")
 
 	// task queue options
 	// legacy options
@@ -572,14 +1035,34 @@ func BindAllEnv(v *viper.Viper) {
 
 	_ = v.BindEnv("msgQueue.kind", "SERVER_MSGQUEUE_KIND")
 	_ = v.BindEnv("msgQueue.rabbitmq.url", "SERVER_MSGQUEUE_RABBITMQ_URL")
+	_ = v.BindEnv("msgQueue.nats.url", "SERVER_MSGQUEUE_NATS_URL")
+	_ = v.BindEnv("msgQueue.nats.prefix", "SERVER_MSGQUEUE_NATS_PREFIX")
+	_ = v.BindEnv("msgQueue.nats.replicas", "SERVER_MSGQUEUE_NATS_REPLICAS")
 
 	// throughput options
 	_ = v.BindEnv("msgQueue.rabbitmq.qos", "SERVER_MSGQUEUE_RABBITMQ_QOS")
+	_ = v.BindEnv("msgQueue.nats.qos", "SERVER_MSGQUEUE_NATS_QOS")
+	_ = v.BindEnv("msgQueue.postgres.qos", "SERVER_MSGQUEUE_POSTGRES_QOS")
 	_ = v.BindEnv("runtime.requeueLimit", "SERVER_REQUEUE_LIMIT")
 	_ = v.BindEnv("runtime.singleQueueLimit", "SERVER_SINGLE_QUEUE_LIMIT")
 	_ = v.BindEnv("runtime.updateHashFactor", "SERVER_UPDATE_HASH_FACTOR")
 	_ = v.BindEnv("runtime.updateConcurrentFactor", "SERVER_UPDATE_CONCURRENT_FACTOR")
 
+	// scheduler options
+	_ = v.BindEnv("scheduler.assignmentPolicy", "SERVER_SCHEDULER_ASSIGNMENT_POLICY")
+	_ = v.BindEnv("scheduler.assignmentStrategy", "SERVER_SCHEDULER_ASSIGNMENT_STRATEGY")
+	_ = v.BindEnv("scheduler.warmStandby", "SERVER_SCHEDULER_WARM_STANDBY")
+	_ = v.BindEnv("scheduler.standbyPollInterval", "SERVER_SCHEDULER_STANDBY_POLL_INTERVAL")
+	_ = v.BindEnv("scheduler.decisionLogSize", "SERVER_SCHEDULER_DECISION_LOG_SIZE")
+	_ = v.BindEnv("scheduler.tenantBudgetWindow", "SERVER_SCHEDULER_TENANT_BUDGET_WINDOW")
+	_ = v.BindEnv("scheduler.tenantBudgetSchedulingTime", "SERVER_SCHEDULER_TENANT_BUDGET_SCHEDULING_TIME")
+	_ = v.BindEnv("scheduler.tenantBudgetIterations", "SERVER_SCHEDULER_TENANT_BUDGET_ITERATIONS")
+	_ = v.BindEnv("scheduler.maxAssignedPerWorker", "SERVER_SCHEDULER_MAX_ASSIGNED_PER_WORKER")
+	_ = v.BindEnv("scheduler.maxAssignedPerTick", "SERVER_SCHEDULER_MAX_ASSIGNED_PER_TICK")
+	_ = v.BindEnv("scheduler.dryRun", "SERVER_SCHEDULER_DRY_RUN")
+	_ = v.BindEnv("scheduler.dynamicRateLimitDefault", "SERVER_SCHEDULER_DYNAMIC_RATE_LIMIT_DEFAULT")
+	_ = v.BindEnv("scheduler.dynamicRateLimitTTL", "SERVER_SCHEDULER_DYNAMIC_RATE_LIMIT_TTL")
+
 	// tls options
 	_ = v.BindEnv("tls.tlsStrategy", "SERVER_TLS_STRATEGY")
 	_ = v.BindEnv("tls.tlsCert", "SERVER_TLS_CERT")
@@ -619,4 +1102,57 @@ func BindAllEnv(v *viper.Viper) {
 	_ = v.BindEnv("email.postmark.fromName", "SERVER_EMAIL_POSTMARK_FROM_NAME")
 	_ = v.BindEnv("email.postmark.supportEmail", "SERVER_EMAIL_POSTMARK_SUPPORT_EMAIL")
 
+	// blob storage options
+	_ = v.BindEnv("blobStorage.driver", "SERVER_BLOB_STORAGE_DRIVER")
+	_ = v.BindEnv("blobStorage.maxInputSize", "SERVER_BLOB_STORAGE_MAX_INPUT_SIZE")
+	_ = v.BindEnv("blobStorage.maxOutputSize", "SERVER_BLOB_STORAGE_MAX_OUTPUT_SIZE")
+	_ = v.BindEnv("blobStorage.filesystem.dir", "SERVER_BLOB_STORAGE_FILESYSTEM_DIR")
+	_ = v.BindEnv("blobStorage.s3.endpoint", "SERVER_BLOB_STORAGE_S3_ENDPOINT")
+	_ = v.BindEnv("blobStorage.s3.region", "SERVER_BLOB_STORAGE_S3_REGION")
+	_ = v.BindEnv("blobStorage.s3.bucket", "SERVER_BLOB_STORAGE_S3_BUCKET")
+	_ = v.BindEnv("blobStorage.s3.prefix", "SERVER_BLOB_STORAGE_S3_PREFIX")
+	_ = v.BindEnv("blobStorage.s3.accessKeyID", "SERVER_BLOB_STORAGE_S3_ACCESS_KEY_ID")
+	_ = v.BindEnv("blobStorage.s3.secretAccessKey", "SERVER_BLOB_STORAGE_S3_SECRET_ACCESS_KEY")
+	_ = v.BindEnv("blobStorage.s3.sessionToken", "SERVER_BLOB_STORAGE_S3_SESSION_TOKEN")
+	_ = v.BindEnv("blobStorage.s3.pathStyle", "SERVER_BLOB_STORAGE_S3_PATH_STYLE")
+
+	// secrets options
+	_ = v.BindEnv("secrets.driver", "SERVER_SECRETS_DRIVER")
+	_ = v.BindEnv("secrets.cacheTTL", "SERVER_SECRETS_CACHE_TTL")
+	_ = v.BindEnv("secrets.vault.address", "SERVER_SECRETS_VAULT_ADDRESS")
+	_ = v.BindEnv("secrets.vault.token", "SERVER_SECRETS_VAULT_TOKEN")
+	_ = v.BindEnv("secrets.vault.namespace", "SERVER_SECRETS_VAULT_NAMESPACE")
+	_ = v.BindEnv("secrets.vault.mount", "SERVER_SECRETS_VAULT_MOUNT")
+	_ = v.BindEnv("secrets.vault.prefix", "SERVER_SECRETS_VAULT_PREFIX")
+	_ = v.BindEnv("secrets.aws.endpoint", "SERVER_SECRETS_AWS_ENDPOINT")
+	_ = v.BindEnv("secrets.aws.region", "SERVER_SECRETS_AWS_REGION")
+	_ = v.BindEnv("secrets.aws.prefix", "SERVER_SECRETS_AWS_PREFIX")
+	_ = v.BindEnv("secrets.aws.accessKeyID", "SERVER_SECRETS_AWS_ACCESS_KEY_ID")
+	_ = v.BindEnv("secrets.aws.secretAccessKey", "SERVER_SECRETS_AWS_SECRET_ACCESS_KEY")
+	_ = v.BindEnv("secrets.aws.sessionToken", "SERVER_SECRETS_AWS_SESSION_TOKEN")
+
+	// kafka options
+	_ = v.BindEnv("kafka.enabled", "SERVER_KAFKA_ENABLED")
+	_ = v.BindEnv("kafka.brokers", "SERVER_KAFKA_BROKERS")
+	_ = v.BindEnv("kafka.clientID", "SERVER_KAFKA_CLIENT_ID")
+	_ = v.BindEnv("kafka.consumerGroup", "SERVER_KAFKA_CONSUMER_GROUP")
+	_ = v.BindEnv("kafka.startFrom", "SERVER_KAFKA_START_FROM")
+	_ = v.BindEnv("kafka.fetchMaxBytes", "SERVER_KAFKA_FETCH_MAX_BYTES")
+	_ = v.BindEnv("kafka.tls.tlsStrategy", "SERVER_KAFKA_TLS_STRATEGY")
+	_ = v.BindEnv("kafka.tls.tlsCertFile", "SERVER_KAFKA_TLS_CERT_FILE")
+	_ = v.BindEnv("kafka.tls.tlsKeyFile", "SERVER_KAFKA_TLS_KEY_FILE")
+	_ = v.BindEnv("kafka.tls.tlsRootCAFile", "SERVER_KAFKA_TLS_ROOT_CA_FILE")
+	_ = v.BindEnv("kafka.sasl.mechanism", "SERVER_KAFKA_SASL_MECHANISM")
+	_ = v.BindEnv("kafka.sasl.username", "SERVER_KAFKA_SASL_USERNAME")
+	_ = v.BindEnv("kafka.sasl.password", "SERVER_KAFKA_SASL_PASSWORD")
+
+	// prometheus options
+	_ = v.BindEnv("prometheus.enabled", "SERVER_PROMETHEUS_ENABLED")
+	_ = v.BindEnv("prometheus.address", "SERVER_PROMETHEUS_ADDRESS")
+	_ = v.BindEnv("prometheus.path", "SERVER_PROMETHEUS_PATH")
+
+	// keda scaler options
+	_ = v.BindEnv("kedaScaler.enabled", "SERVER_KEDA_SCALER_ENABLED")
+	_ = v.BindEnv("kedaScaler.address", "SERVER_KEDA_SCALER_ADDRESS")
+
 }
@@ -0,0 +1,418 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tink-crypto/tink-go/aead"
+	"github.com/tink-crypto/tink-go/insecurecleartextkeyset"
+	"github.com/tink-crypto/tink-go/keyset"
+	"github.com/tink-crypto/tink-go/tink"
+)
+
+// EncryptedField is the only field of the JSON object which replaces an encrypted payload.
+const EncryptedField = "__hatchet_encrypted"
+
+// EncryptedPayload is a payload which was encrypted with a data key of a tenant.
+type EncryptedPayload struct {
+	KeyId string `json:"key_id"`
+
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+type encryptedPayloadWrapper struct {
+	Encrypted *EncryptedPayload `json:"__hatchet_encrypted"`
+}
+
+// DataKeyStore persists the data keys of tenants. Data keys are stored as keysets which are encrypted with the
+// master key, so the store never sees them in plaintext.
+type DataKeyStore interface {
+	// GetActiveDataKey returns the id and the encrypted keyset of the data key which new payloads of the tenant are
+	// encrypted with. The id is empty if the tenant doesn't have a data key yet.
+	GetActiveDataKey(ctx context.Context, tenantId string) (string, []byte, error)
+
+	// GetDataKey returns the encrypted keyset of a data key of the tenant.
+	GetDataKey(ctx context.Context, tenantId, keyId string) ([]byte, error)
+
+	// CreateDataKey creates the active data key of the tenant if it doesn't have one. It returns the id and the
+	// encrypted keyset of the active data key, which may have been created concurrently.
+	CreateDataKey(ctx context.Context, tenantId, keyId string, encryptedKeyset []byte) (string, []byte, error)
+
+	// RotateDataKey makes a new data key the active data key of the tenant. Previous data keys are kept to decrypt
+	// existing payloads.
+	RotateDataKey(ctx context.Context, tenantId, keyId string, encryptedKeyset []byte) error
+}
+
+// activeDataKeyTTL is how long the active data key of a tenant is cached, which bounds how long other instances
+// keep encrypting with a data key after it was rotated
+const activeDataKeyTTL = time.Minute
+
+type activeDataKey struct {
+	keyId     string
+	fetchedAt time.Time
+}
+
+// PayloadEncryption encrypts the payloads of a tenant, like step run inputs and outputs, with envelope encryption.
+// Each tenant has its own data keys, which are encrypted with the master key of the encryption service. A nil
+// PayloadEncryption leaves all payloads in plaintext.
+type PayloadEncryption struct {
+	svc  EncryptionService
+	keys DataKeyStore
+
+	mu     sync.Mutex
+	aeads  map[string]tink.AEAD
+	active map[string]activeDataKey
+}
+
+func NewPayloadEncryption(svc EncryptionService, keys DataKeyStore) *PayloadEncryption {
+	return &PayloadEncryption{
+		svc:    svc,
+		keys:   keys,
+		aeads:  make(map[string]tink.AEAD),
+		active: make(map[string]activeDataKey),
+	}
+}
+
+// IsEncrypted returns true if the payload was encrypted with a data key.
+func IsEncrypted(data []byte) bool {
+	_, ok := parseEncryptedPayload(data)
+	return ok
+}
+
+// Encrypt encrypts a JSON payload with the active data key of the tenant, and returns the JSON object which should
+// be persisted instead. Empty and already encrypted payloads are returned as is.
+func (p *PayloadEncryption) Encrypt(ctx context.Context, tenantId string, data []byte) ([]byte, error) {
+	if p == nil || len(data) == 0 || IsEncrypted(data) {
+		return data, nil
+	}
+
+	keyId, a, err := p.getActiveAEAD(ctx, tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := a.Encrypt(data, []byte(tenantId))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not encrypt payload: %w", err)
+	}
+
+	return json.Marshal(encryptedPayloadWrapper{
+		Encrypted: &EncryptedPayload{
+			KeyId:      keyId,
+			Ciphertext: ciphertext,
+		},
+	})
+}
+
+// Decrypt decrypts a payload which was encrypted with a data key of the tenant, along with any encrypted payloads in
+// its JSON objects and arrays, for example the outputs of the parents in the input of a step run. Payloads which
+// aren't encrypted are returned as is.
+func (p *PayloadEncryption) Decrypt(ctx context.Context, tenantId string, data []byte) ([]byte, error) {
+	if p == nil || !bytes.Contains(data, []byte(EncryptedField)) {
+		return data, nil
+	}
+
+	if payload, ok := parseEncryptedPayload(data); ok {
+		plaintext, err := p.decryptPayload(ctx, tenantId, payload)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return p.Decrypt(ctx, tenantId, plaintext)
+	}
+
+	v, err := unmarshalPayload(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted, err := p.decryptValue(ctx, tenantId, v)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(decrypted)
+}
+
+// RotateDataKey creates a new active data key for the tenant. New payloads are encrypted with the new data key,
+// while existing payloads can still be decrypted with the previous data keys.
+func (p *PayloadEncryption) RotateDataKey(ctx context.Context, tenantId string) error {
+	if p == nil {
+		return fmt.Errorf("payload encryption is not enabled")
+	}
+
+	keyId, encryptedKeyset, a, err := p.newDataKey(tenantId)
+
+	if err != nil {
+		return err
+	}
+
+	if err := p.keys.RotateDataKey(ctx, tenantId, keyId, encryptedKeyset); err != nil {
+		return fmt.Errorf("could not rotate data key: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.aeads[keyId] = a
+	p.active[tenantId] = activeDataKey{
+		keyId:     keyId,
+		fetchedAt: time.Now(),
+	}
+
+	return nil
+}
+
+func (p *PayloadEncryption) decryptValue(ctx context.Context, tenantId string, v interface{}) (interface{}, error) {
+	if payload, ok := encryptedPayloadFromValue(v); ok {
+		plaintext, err := p.decryptPayload(ctx, tenantId, payload)
+
+		if err != nil {
+			return nil, err
+		}
+
+		decoded, err := unmarshalPayload(plaintext)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not unmarshal decrypted payload: %w", err)
+		}
+
+		return p.decryptValue(ctx, tenantId, decoded)
+	}
+
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, child := range t {
+			decrypted, err := p.decryptValue(ctx, tenantId, child)
+
+			if err != nil {
+				return nil, err
+			}
+
+			t[k] = decrypted
+		}
+	case []interface{}:
+		for i, child := range t {
+			decrypted, err := p.decryptValue(ctx, tenantId, child)
+
+			if err != nil {
+				return nil, err
+			}
+
+			t[i] = decrypted
+		}
+	}
+
+	return v, nil
+}
+
+func (p *PayloadEncryption) decryptPayload(ctx context.Context, tenantId string, payload *EncryptedPayload) ([]byte, error) {
+	a, err := p.getAEAD(ctx, tenantId, payload.KeyId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := a.Decrypt(payload.Ciphertext, []byte(tenantId))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt payload: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+// getActiveAEAD returns the active data key of the tenant, creating it if the tenant doesn't have one yet.
+func (p *PayloadEncryption) getActiveAEAD(ctx context.Context, tenantId string) (string, tink.AEAD, error) {
+	p.mu.Lock()
+	active, ok := p.active[tenantId]
+	p.mu.Unlock()
+
+	if ok && time.Since(active.fetchedAt) < activeDataKeyTTL {
+		a, err := p.getAEAD(ctx, tenantId, active.keyId)
+		return active.keyId, a, err
+	}
+
+	keyId, encryptedKeyset, err := p.keys.GetActiveDataKey(ctx, tenantId)
+
+	if err != nil {
+		return "", nil, fmt.Errorf("could not get active data key: %w", err)
+	}
+
+	if keyId == "" {
+		newKeyId, newEncryptedKeyset, _, err := p.newDataKey(tenantId)
+
+		if err != nil {
+			return "", nil, err
+		}
+
+		keyId, encryptedKeyset, err = p.keys.CreateDataKey(ctx, tenantId, newKeyId, newEncryptedKeyset)
+
+		if err != nil {
+			return "", nil, fmt.Errorf("could not create data key: %w", err)
+		}
+	}
+
+	a, err := p.loadAEAD(tenantId, keyId, encryptedKeyset)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.active[tenantId] = activeDataKey{
+		keyId:     keyId,
+		fetchedAt: time.Now(),
+	}
+
+	return keyId, a, nil
+}
+
+// getAEAD returns a data key of the tenant by id. Data keys never change, so they are cached indefinitely.
+func (p *PayloadEncryption) getAEAD(ctx context.Context, tenantId, keyId string) (tink.AEAD, error) {
+	p.mu.Lock()
+	a, ok := p.aeads[keyId]
+	p.mu.Unlock()
+
+	if ok {
+		return a, nil
+	}
+
+	encryptedKeyset, err := p.keys.GetDataKey(ctx, tenantId, keyId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get data key %s: %w", keyId, err)
+	}
+
+	return p.loadAEAD(tenantId, keyId, encryptedKeyset)
+}
+
+func (p *PayloadEncryption) loadAEAD(tenantId, keyId string, encryptedKeyset []byte) (tink.AEAD, error) {
+	keysetBytes, err := p.svc.Decrypt(encryptedKeyset, dataKeyDataId(tenantId, keyId))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt data key %s: %w", keyId, err)
+	}
+
+	handle, err := insecurecleartextkeyset.Read(keyset.NewJSONReader(bytes.NewReader(keysetBytes)))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read data key %s: %w", keyId, err)
+	}
+
+	a, err := aead.New(handle)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.aeads[keyId] = a
+
+	return a, nil
+}
+
+// newDataKey generates a new data key and encrypts it with the master key.
+func (p *PayloadEncryption) newDataKey(tenantId string) (string, []byte, tink.AEAD, error) {
+	handle, err := keyset.NewHandle(aead.AES256GCMKeyTemplate())
+
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("could not generate data key: %w", err)
+	}
+
+	buf := new(bytes.Buffer)
+
+	if err := insecurecleartextkeyset.Write(handle, keyset.NewJSONWriter(buf)); err != nil {
+		return "", nil, nil, fmt.Errorf("could not write data key: %w", err)
+	}
+
+	keyId := uuid.New().String()
+
+	encryptedKeyset, err := p.svc.Encrypt(buf.Bytes(), dataKeyDataId(tenantId, keyId))
+
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("could not encrypt data key: %w", err)
+	}
+
+	a, err := aead.New(handle)
+
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	return keyId, encryptedKeyset, a, nil
+}
+
+// dataKeyDataId binds the encrypted keyset of a data key to its tenant, so that it can't be used for another tenant
+func dataKeyDataId(tenantId, keyId string) string {
+	return fmt.Sprintf("data-key:%s:%s", tenantId, keyId)
+}
+
+func parseEncryptedPayload(data []byte) (*EncryptedPayload, bool) {
+	if !bytes.Contains(data, []byte(EncryptedField)) {
+		return nil, false
+	}
+
+	v, err := unmarshalPayload(data)
+
+	if err != nil {
+		return nil, false
+	}
+
+	return encryptedPayloadFromValue(v)
+}
+
+func encryptedPayloadFromValue(v interface{}) (*EncryptedPayload, bool) {
+	m, ok := v.(map[string]interface{})
+
+	if !ok || len(m) != 1 {
+		return nil, false
+	}
+
+	if _, ok := m[EncryptedField].(map[string]interface{}); !ok {
+		return nil, false
+	}
+
+	// round trip through JSON to decode the base64-encoded ciphertext
+	b, err := json.Marshal(m)
+
+	if err != nil {
+		return nil, false
+	}
+
+	wrapper := encryptedPayloadWrapper{}
+
+	if err := json.Unmarshal(b, &wrapper); err != nil || wrapper.Encrypted == nil || wrapper.Encrypted.KeyId == "" {
+		return nil, false
+	}
+
+	return wrapper.Encrypted, true
+}
+
+// unmarshalPayload decodes a JSON payload, keeping numbers as they are so that large integers don't lose precision
+func unmarshalPayload(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v interface{}
+
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
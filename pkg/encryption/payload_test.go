@@ -0,0 +1,184 @@
+package encryption
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryDataKeyStore struct {
+	mu     sync.Mutex
+	keys   map[string][]byte
+	active map[string]string
+}
+
+func newMemoryDataKeyStore() *memoryDataKeyStore {
+	return &memoryDataKeyStore{
+		keys:   make(map[string][]byte),
+		active: make(map[string]string),
+	}
+}
+
+func (s *memoryDataKeyStore) GetActiveDataKey(ctx context.Context, tenantId string) (string, []byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keyId := s.active[tenantId]
+
+	return keyId, s.keys[keyId], nil
+}
+
+func (s *memoryDataKeyStore) GetDataKey(ctx context.Context, tenantId, keyId string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	encryptedKeyset, ok := s.keys[keyId]
+
+	if !ok {
+		return nil, fmt.Errorf("data key not found")
+	}
+
+	return encryptedKeyset, nil
+}
+
+func (s *memoryDataKeyStore) CreateDataKey(ctx context.Context, tenantId, keyId string, encryptedKeyset []byte) (string, []byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if activeId, ok := s.active[tenantId]; ok {
+		return activeId, s.keys[activeId], nil
+	}
+
+	s.keys[keyId] = encryptedKeyset
+	s.active[tenantId] = keyId
+
+	return keyId, encryptedKeyset, nil
+}
+
+func (s *memoryDataKeyStore) RotateDataKey(ctx context.Context, tenantId, keyId string, encryptedKeyset []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys[keyId] = encryptedKeyset
+	s.active[tenantId] = keyId
+
+	return nil
+}
+
+func newTestPayloadEncryption(t *testing.T) (*PayloadEncryption, *memoryDataKeyStore) {
+	aes256Gcm, privateEc256, publicEc256, err := GenerateLocalKeys()
+	require.NoError(t, err)
+
+	svc, err := NewLocalEncryption(aes256Gcm, privateEc256, publicEc256)
+	require.NoError(t, err)
+
+	store := newMemoryDataKeyStore()
+
+	return NewPayloadEncryption(svc, store), store
+}
+
+func TestPayloadEncryptDecrypt(t *testing.T) {
+	p, _ := newTestPayloadEncryption(t)
+	ctx := context.Background()
+
+	plaintext := []byte(`{"ssn":"123-45-6789","amount":12345678901234567890}`)
+
+	ciphertext, err := p.Encrypt(ctx, "tenant-1", plaintext)
+	require.NoError(t, err)
+
+	assert.True(t, IsEncrypted(ciphertext))
+	assert.NotContains(t, string(ciphertext), "123-45-6789")
+
+	// encrypting twice is a no-op
+	again, err := p.Encrypt(ctx, "tenant-1", ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, ciphertext, again)
+
+	decrypted, err := p.Decrypt(ctx, "tenant-1", ciphertext)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(plaintext), string(decrypted))
+	assert.Contains(t, string(decrypted), "12345678901234567890")
+}
+
+func TestPayloadDecryptWrongTenant(t *testing.T) {
+	p, _ := newTestPayloadEncryption(t)
+	ctx := context.Background()
+
+	ciphertext, err := p.Encrypt(ctx, "tenant-1", []byte(`{"a":1}`))
+	require.NoError(t, err)
+
+	_, err = p.Decrypt(ctx, "tenant-2", ciphertext)
+	assert.Error(t, err)
+}
+
+func TestPayloadDecryptNested(t *testing.T) {
+	p, _ := newTestPayloadEncryption(t)
+	ctx := context.Background()
+
+	parent, err := p.Encrypt(ctx, "tenant-1", []byte(`{"result":"secret"}`))
+	require.NoError(t, err)
+
+	input, err := p.Encrypt(ctx, "tenant-1", []byte(`{"name":"alice"}`))
+	require.NoError(t, err)
+
+	data := []byte(fmt.Sprintf(`{"input":%s,"parents":{"step-1":%s},"triggered_by":"manual"}`, input, parent))
+
+	decrypted, err := p.Decrypt(ctx, "tenant-1", data)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"input":{"name":"alice"},"parents":{"step-1":{"result":"secret"}},"triggered_by":"manual"}`, string(decrypted))
+}
+
+func TestPayloadDecryptPlaintext(t *testing.T) {
+	p, _ := newTestPayloadEncryption(t)
+
+	plaintext := []byte(`{"a":1}`)
+
+	decrypted, err := p.Decrypt(context.Background(), "tenant-1", plaintext)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, decrypted)
+}
+
+func TestPayloadRotateDataKey(t *testing.T) {
+	p, store := newTestPayloadEncryption(t)
+	ctx := context.Background()
+
+	before, err := p.Encrypt(ctx, "tenant-1", []byte(`{"a":1}`))
+	require.NoError(t, err)
+
+	require.NoError(t, p.RotateDataKey(ctx, "tenant-1"))
+
+	after, err := p.Encrypt(ctx, "tenant-1", []byte(`{"a":2}`))
+	require.NoError(t, err)
+
+	beforePayload, ok := parseEncryptedPayload(before)
+	require.True(t, ok)
+
+	afterPayload, ok := parseEncryptedPayload(after)
+	require.True(t, ok)
+
+	assert.NotEqual(t, beforePayload.KeyId, afterPayload.KeyId)
+	assert.Equal(t, afterPayload.KeyId, store.active["tenant-1"])
+
+	// payloads encrypted with the previous data key can still be decrypted
+	decrypted, err := p.Decrypt(ctx, "tenant-1", before)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"a":1}`, string(decrypted))
+}
+
+func TestNilPayloadEncryption(t *testing.T) {
+	var p *PayloadEncryption
+
+	data := []byte(`{"a":1}`)
+
+	encrypted, err := p.Encrypt(context.Background(), "tenant-1", data)
+	require.NoError(t, err)
+	assert.Equal(t, data, encrypted)
+
+	decrypted, err := p.Decrypt(context.Background(), "tenant-1", data)
+	require.NoError(t, err)
+	assert.Equal(t, data, decrypted)
+}
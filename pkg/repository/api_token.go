@@ -22,6 +22,20 @@ type CreateAPITokenOpts struct {
 	Name *string `validate:"omitempty,max=255"`
 
 	Internal bool
+
+	// (optional) The APIs which the token can call, defaults to ADMIN
+	Scope *string `validate:"omitnil,oneof=ADMIN WORKER READ_ONLY"`
+}
+
+type RotateAPITokenOpts struct {
+	// When the rotated token expires, which is the end of the grace period of the rotation
+	ExpiresAt time.Time
+
+	// The id of the token which replaces the rotated token
+	NewID string `validate:"required,uuid"`
+
+	// When the token which replaces the rotated token expires
+	NewExpiresAt time.Time
 }
 
 type APITokenRepository interface {
@@ -33,4 +47,11 @@ type APITokenRepository interface {
 type EngineTokenRepository interface {
 	CreateAPIToken(ctx context.Context, opts *CreateAPITokenOpts) (*dbsqlc.APIToken, error)
 	GetAPITokenById(ctx context.Context, id string) (*dbsqlc.APIToken, error)
+	ListAPITokensByTenant(ctx context.Context, tenantId string) ([]*dbsqlc.APIToken, error)
+
+	// RotateAPIToken creates the token which replaces the token, and marks the token as rotated and shortens its
+	// expiry so that it stays valid until the clients switch to the new token. Both happen in one transaction, so a
+	// token is never revoked without its replacement being issued. The new token has the tenant, name and scope of
+	// the rotated token. It returns the new token, or pgx.ErrNoRows if the token was already rotated or revoked.
+	RotateAPIToken(ctx context.Context, id string, opts *RotateAPITokenOpts) (*dbsqlc.APIToken, error)
 }
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+var ErrApprovalNotPending = fmt.Errorf("approval is not pending")
+
+type ListApprovalsOpts struct {
+	// (optional) only list approvals with these statuses
+	Statuses []dbsqlc.StepRunApprovalStatus
+
+	// (optional) number of approvals to skip
+	Offset *int
+
+	// (optional) number of approvals to return
+	Limit *int
+}
+
+type ListApprovalsResult struct {
+	Rows  []*dbsqlc.StepRunApproval
+	Count int
+}
+
+type DecideApprovalOpts struct {
+	// (required) whether the step run is approved
+	Approved bool
+
+	// (optional) the payload of the approver. When approved, it becomes the output of the step run.
+	Payload []byte `validate:"omitempty,json"`
+}
+
+type ApprovalRepository interface {
+	// CreateApproval creates the pending approval of an approval step run, with a new token.
+	CreateApproval(ctx context.Context, tenantId, workflowRunId, stepRunId string) (*dbsqlc.StepRunApproval, error)
+
+	// ListApprovals lists the approvals of a tenant, newest first.
+	ListApprovals(ctx context.Context, tenantId string, opts *ListApprovalsOpts) (*ListApprovalsResult, error)
+
+	// GetApprovalById returns an approval by its id.
+	GetApprovalById(ctx context.Context, id string) (*dbsqlc.StepRunApproval, error)
+
+	// GetApprovalByToken returns an approval by its token.
+	GetApprovalByToken(ctx context.Context, token string) (*dbsqlc.StepRunApproval, error)
+
+	// DecideApproval approves or rejects a pending approval and removes the timeout timer of its step run. It
+	// returns ErrApprovalNotPending if the approval was already decided or timed out.
+	DecideApproval(ctx context.Context, tenantId, approvalId string, opts *DecideApprovalOpts) (*dbsqlc.StepRunApproval, error)
+
+	// TimeOutApproval marks the pending approval of a step run as timed out. It returns false if the approval
+	// was already decided.
+	TimeOutApproval(ctx context.Context, tenantId, stepRunId string) (bool, error)
+}
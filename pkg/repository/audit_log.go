@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type CreateAuditLogOpts struct {
+	// (required) whether the API was called by a user or an API token
+	ActorKind dbsqlc.AuditLogActorKind `validate:"required,oneof=USER API_TOKEN"`
+
+	// (required) the id of the user or API token
+	ActorId string `validate:"required,uuid"`
+
+	// (optional) the email of the user or the name of the API token
+	ActorName *string
+
+	// (required) the operation id of the API call
+	Operation string `validate:"required"`
+
+	// (required) the method and path of the request
+	Method string `validate:"required"`
+	Path   string `validate:"required"`
+
+	// (optional) the resource of the request path which the call operated on
+	ResourceType *string
+	ResourceId   *string
+
+	// (required) the status code of the response
+	StatusCode int32 `validate:"required"`
+
+	// (optional) the address and user agent of the client
+	IpAddress *string
+	UserAgent *string
+}
+
+type ListAuditLogsOpts struct {
+	// (optional) only list the entries of a user or API token
+	ActorId *string `validate:"omitnil,uuid"`
+
+	// (optional) only list the entries of an operation
+	Operation *string
+
+	// (optional) only list the entries of a resource type, and of a resource if the resource id is set
+	ResourceType *string
+	ResourceId   *string
+
+	// (optional) only list the entries which were created in the time range, the until bound is exclusive
+	Since *time.Time
+	Until *time.Time
+
+	// (optional) number of entries to skip
+	Offset *int
+
+	// (optional) number of entries to return
+	Limit *int
+}
+
+type ListAuditLogsResult struct {
+	Rows  []*dbsqlc.AuditLog
+	Count int
+}
+
+type AuditLogRepository interface {
+	// CreateAuditLog appends an entry to the audit log of a tenant. Entries can't be updated or deleted.
+	CreateAuditLog(ctx context.Context, tenantId string, opts *CreateAuditLogOpts) (*dbsqlc.AuditLog, error)
+
+	// ListAuditLogs lists the entries of the audit log of a tenant, newest first.
+	ListAuditLogs(ctx context.Context, tenantId string, opts *ListAuditLogsOpts) (*ListAuditLogsResult, error)
+}
@@ -0,0 +1,186 @@
+package buffer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// ErrCoalescingBufferClosed is returned when a write is put in a buffer which was cleaned up
+var ErrCoalescingBufferClosed = errors.New("coalescing buffer is closed")
+
+const (
+	defaultCoalescingFlushPeriod = 500 * time.Millisecond
+	defaultCoalescingMaxItems    = 1000
+
+	// coalescingFlushTimeout is how long a flush of a coalescing buffer can take
+	coalescingFlushTimeout = 30 * time.Second
+)
+
+// CoalescingBuffer holds high-frequency small writes and flushes them periodically in batches. Unlike the
+// IngestBuf, writers don't wait for the flush: a write is only held in memory until the next flush, so writes which
+// weren't flushed are lost if the process crashes, and writes which fail to flush are logged and dropped. Writes with
+// the same key are coalesced, so only the latest write per key is flushed.
+//
+// It's meant for writes which are repeated often enough that losing one is harmless, like heartbeats.
+type CoalescingBuffer[K comparable, T any] struct {
+	name        string
+	flushPeriod time.Duration
+	maxItems    int
+	merge       func(pending, item T) T
+	flushFunc   func(ctx context.Context, items []T) error
+	l           *zerolog.Logger
+
+	mu      sync.Mutex
+	keys    []K
+	pending map[K]T
+	closed  bool
+
+	full     chan struct{}
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+type CoalescingBufferOpts[K comparable, T any] struct {
+	Name string
+
+	// Config sets how often the buffer is flushed and how many writes it holds before it's flushed early
+	Config ConfigFileCoalescingBuffer
+
+	// Merge merges a write with the pending write of the same key. If it's nil, the pending write is replaced.
+	Merge func(pending, item T) T
+
+	// FlushFunc writes a batch of writes to the database
+	FlushFunc func(ctx context.Context, items []T) error
+
+	L *zerolog.Logger
+}
+
+// NewCoalescingBuffer creates a coalescing buffer and starts flushing it.
+func NewCoalescingBuffer[K comparable, T any](opts CoalescingBufferOpts[K, T]) *CoalescingBuffer[K, T] {
+	flushPeriod := time.Duration(opts.Config.FlushPeriodMilliseconds) * time.Millisecond
+
+	if flushPeriod <= 0 {
+		flushPeriod = defaultCoalescingFlushPeriod
+	}
+
+	maxItems := opts.Config.FlushItemsThreshold
+
+	if maxItems <= 0 {
+		maxItems = defaultCoalescingMaxItems
+	}
+
+	l := opts.L.With().Str("buffer", opts.Name).Logger()
+
+	b := &CoalescingBuffer[K, T]{
+		name:        opts.Name,
+		flushPeriod: flushPeriod,
+		maxItems:    maxItems,
+		merge:       opts.Merge,
+		flushFunc:   opts.FlushFunc,
+		l:           &l,
+		pending:     make(map[K]T),
+		full:        make(chan struct{}, 1),
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+
+	go b.run()
+
+	return b
+}
+
+// Put holds a write until the next flush, merging it with the pending write of the same key. It doesn't block on the
+// database.
+func (b *CoalescingBuffer[K, T]) Put(key K, item T) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return ErrCoalescingBufferClosed
+	}
+
+	if pending, ok := b.pending[key]; ok {
+		if b.merge != nil {
+			item = b.merge(pending, item)
+		}
+	} else {
+		b.keys = append(b.keys, key)
+	}
+
+	b.pending[key] = item
+
+	if len(b.keys) >= b.maxItems {
+		select {
+		case b.full <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+// Cleanup stops the buffer and flushes the pending writes.
+func (b *CoalescingBuffer[K, T]) Cleanup() error {
+	b.stopOnce.Do(func() {
+		b.mu.Lock()
+		b.closed = true
+		b.mu.Unlock()
+
+		close(b.stop)
+	})
+
+	<-b.done
+
+	return nil
+}
+
+func (b *CoalescingBuffer[K, T]) run() {
+	defer close(b.done)
+
+	ticker := time.NewTicker(b.flushPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.stop:
+			b.flush()
+			return
+		case <-ticker.C:
+			b.flush()
+		case <-b.full:
+			b.flush()
+		}
+	}
+}
+
+func (b *CoalescingBuffer[K, T]) flush() {
+	b.mu.Lock()
+	keys, pending := b.keys, b.pending
+	b.keys, b.pending = nil, make(map[K]T, len(pending))
+	b.mu.Unlock()
+
+	if len(keys) == 0 {
+		return
+	}
+
+	items := make([]T, 0, len(keys))
+
+	for _, key := range keys {
+		items = append(items, pending[key])
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), coalescingFlushTimeout)
+	defer cancel()
+
+	if err := b.flushFunc(ctx, items); err != nil {
+		b.l.Error().Err(err).Msgf("could not flush %d writes, dropping them", len(items))
+		return
+	}
+
+	b.l.Debug().Msgf("flushed %d writes", len(items))
+}
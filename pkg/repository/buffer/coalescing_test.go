@@ -0,0 +1,131 @@
+package buffer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type flushRecorder struct {
+	mu      sync.Mutex
+	batches [][]mockItem
+	err     error
+}
+
+func (r *flushRecorder) flush(ctx context.Context, items []mockItem) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.batches = append(r.batches, items)
+
+	return r.err
+}
+
+func (r *flushRecorder) flushed() []mockItem {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var res []mockItem
+
+	for _, batch := range r.batches {
+		res = append(res, batch...)
+	}
+
+	return res
+}
+
+func newTestCoalescingBuffer(r *flushRecorder, periodMs, threshold int, merge func(pending, item mockItem) mockItem) *CoalescingBuffer[int, mockItem] {
+	logger := zerolog.New(nil).Level(zerolog.Disabled)
+
+	return NewCoalescingBuffer(CoalescingBufferOpts[int, mockItem]{
+		Name: "test",
+		Config: ConfigFileCoalescingBuffer{
+			FlushPeriodMilliseconds: periodMs,
+			FlushItemsThreshold:     threshold,
+		},
+		Merge:     merge,
+		FlushFunc: r.flush,
+		L:         &logger,
+	})
+}
+
+func TestCoalescingBufferCoalescesByKey(t *testing.T) {
+	r := &flushRecorder{}
+	buf := newTestCoalescingBuffer(r, 60000, 100, nil)
+
+	require.NoError(t, buf.Put(1, mockItem{ID: 1, Value: "a"}))
+	require.NoError(t, buf.Put(2, mockItem{ID: 2, Value: "b"}))
+	require.NoError(t, buf.Put(1, mockItem{ID: 1, Value: "c"}))
+
+	require.NoError(t, buf.Cleanup())
+
+	assert.Equal(t, []mockItem{{ID: 1, Value: "c"}, {ID: 2, Value: "b"}}, r.flushed())
+}
+
+func TestCoalescingBufferMerge(t *testing.T) {
+	r := &flushRecorder{}
+	buf := newTestCoalescingBuffer(r, 60000, 100, func(pending, item mockItem) mockItem {
+		item.Size += pending.Size
+		return item
+	})
+
+	require.NoError(t, buf.Put(1, mockItem{ID: 1, Size: 1}))
+	require.NoError(t, buf.Put(1, mockItem{ID: 1, Size: 2}))
+
+	require.NoError(t, buf.Cleanup())
+
+	assert.Equal(t, []mockItem{{ID: 1, Size: 3}}, r.flushed())
+}
+
+func TestCoalescingBufferFlushesPeriodically(t *testing.T) {
+	r := &flushRecorder{}
+	buf := newTestCoalescingBuffer(r, 10, 100, nil)
+	defer buf.Cleanup() // nolint: errcheck
+
+	require.NoError(t, buf.Put(1, mockItem{ID: 1}))
+
+	assert.Eventually(t, func() bool {
+		return len(r.flushed()) == 1
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestCoalescingBufferFlushesAtThreshold(t *testing.T) {
+	r := &flushRecorder{}
+	buf := newTestCoalescingBuffer(r, 60000, 3, nil)
+	defer buf.Cleanup() // nolint: errcheck
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, buf.Put(i, mockItem{ID: i}))
+	}
+
+	assert.Eventually(t, func() bool {
+		return len(r.flushed()) == 3
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestCoalescingBufferDropsFailedFlushes(t *testing.T) {
+	r := &flushRecorder{err: errors.New("database is down")}
+	buf := newTestCoalescingBuffer(r, 60000, 100, nil)
+
+	require.NoError(t, buf.Put(1, mockItem{ID: 1}))
+	require.NoError(t, buf.Cleanup())
+
+	// the failed write isn't retried
+	assert.Len(t, r.batches, 1)
+}
+
+func TestCoalescingBufferPutAfterCleanup(t *testing.T) {
+	r := &flushRecorder{}
+	buf := newTestCoalescingBuffer(r, 60000, 100, nil)
+
+	require.NoError(t, buf.Cleanup())
+	require.NoError(t, buf.Cleanup())
+
+	assert.ErrorIs(t, buf.Put(1, mockItem{ID: 1}), ErrCoalescingBufferClosed)
+}
@@ -23,3 +23,13 @@ type ConfigFileBuffer struct {
 	// FlushStrategy is the strategy to use for flushing the buffer
 	FlushStrategy BuffStrategy `mapstructure:"flushStrategy" json:"flushStrategy" default:"DYNAMIC"`
 }
+
+// ConfigFileCoalescingBuffer is the configuration for a buffer which coalesces writes without waiting for them to be
+// flushed
+type ConfigFileCoalescingBuffer struct {
+	// FlushPeriodMilliseconds is the number of milliseconds between flushes
+	FlushPeriodMilliseconds int `mapstructure:"flushPeriodMilliseconds" json:"flushPeriodMilliseconds,omitempty" default:"500"`
+
+	// FlushItemsThreshold is the number of coalesced writes to hold in memory before flushing early
+	FlushItemsThreshold int `mapstructure:"flushItemsThreshold" json:"flushItemsThreshold,omitempty" default:"1000"`
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/hatchet-dev/hatchet/internal/services/shared/defaults"
+	"github.com/hatchet-dev/hatchet/internal/statemachine"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/pkg/validator"
@@ -121,34 +122,54 @@ func (w *BulkStepRunQueuer) BulkQueueStepRuns(ctx context.Context, opts []BulkQu
 			}
 		}
 
+		// step runs which can't transition to pending assignment, like step runs which were cancelled after they
+		// were buffered, aren't queued
+		fromStatuses := statemachine.StepRunSources(dbsqlc.StepRunStatusPENDINGASSIGNMENT)
+		queued := make(map[string]struct{}, len(orderedOpts))
+
 		if len(stepRunIdWithInputs) > 0 {
-			err = w.queries.QueueStepRunBulkWithInput(ctx, tx, dbsqlc.QueueStepRunBulkWithInputParams{
-				Ids:         stepRunIdWithInputs,
-				Inputs:      inputs,
-				Retrycounts: retryCountsWithInputs,
+			ids, err := w.queries.QueueStepRunBulkWithInput(ctx, tx, dbsqlc.QueueStepRunBulkWithInputParams{
+				Ids:          stepRunIdWithInputs,
+				Inputs:       inputs,
+				Retrycounts:  retryCountsWithInputs,
+				Fromstatuses: fromStatuses,
 			})
 
 			if err != nil {
 				return err
 			}
+
+			for _, id := range ids {
+				queued[sqlchelpers.UUIDToStr(id)] = struct{}{}
+			}
 		}
 
 		if len(stepRunIdWithoutInputs) > 0 {
-			err = w.queries.QueueStepRunBulkNoInput(ctx, tx, dbsqlc.QueueStepRunBulkNoInputParams{
-				Ids:         stepRunIdWithoutInputs,
-				Retrycounts: retryCountsWithoutInputs,
+			ids, err := w.queries.QueueStepRunBulkNoInput(ctx, tx, dbsqlc.QueueStepRunBulkNoInputParams{
+				Ids:          stepRunIdWithoutInputs,
+				Retrycounts:  retryCountsWithoutInputs,
+				Fromstatuses: fromStatuses,
 			})
 
 			if err != nil {
 				return err
 			}
+
+			for _, id := range ids {
+				queued[sqlchelpers.UUIDToStr(id)] = struct{}{}
+			}
 		}
 
-		// next, insert the queue items
-		params := make([]dbsqlc.CreateQueueItemsBulkParams, 0, len(orderedOpts))
+		// next, insert the queue items of the queued step runs
+		params := make([]dbsqlc.CreateQueueItemsBulkParams, 0, len(queued))
 
 		for _, o := range orderedOpts {
 			innerStepRun := o.GetStepRunForEngineRow
+
+			if _, ok := queued[sqlchelpers.UUIDToStr(innerStepRun.SRID)]; !ok {
+				w.l.Debug().Msgf("step run %s was not queued, as it can't transition to %s from its status", sqlchelpers.UUIDToStr(innerStepRun.SRID), dbsqlc.StepRunStatusPENDINGASSIGNMENT)
+				continue
+			}
 			tenantId := o.GetStepRunForEngineRow.SRTenantId
 
 			params = append(params, dbsqlc.CreateQueueItemsBulkParams{
@@ -163,6 +184,7 @@ func (w *BulkStepRunQueuer) BulkQueueStepRuns(ctx context.Context, opts []BulkQu
 				Sticky:            innerStepRun.StickyStrategy,
 				DesiredWorkerId:   innerStepRun.DesiredWorkerId,
 				ScheduleTimeoutAt: getScheduleTimeout(innerStepRun),
+				Deadline:          innerStepRun.SRDeadline,
 			})
 		}
 
@@ -172,8 +194,8 @@ func (w *BulkStepRunQueuer) BulkQueueStepRuns(ctx context.Context, opts []BulkQu
 			return err
 		}
 
-		if int(n) != len(orderedOpts) {
-			return fmt.Errorf("expected %d queue items to be inserted, but only %d were", len(orderedOpts), n)
+		if int(n) != len(params) {
+			return fmt.Errorf("expected %d queue items to be inserted, but only %d were", len(params), n)
 		}
 
 		return commit(ctx)
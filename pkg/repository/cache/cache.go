@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/hatchet-dev/hatchet/internal/cache"
@@ -13,6 +14,9 @@ type Cacheable interface {
 	// Get gets a value from the cache with the given key
 	Get(key string) (interface{}, bool)
 
+	// Delete deletes the value with the given key, so it's read again the next time it's used
+	Delete(key string)
+
 	// Stop stops the cache and clears any goroutines
 	Stop()
 }
@@ -30,6 +34,10 @@ func (c *Cache) Get(key string) (interface{}, bool) {
 	return c.cache.Get(key)
 }
 
+func (c *Cache) Delete(key string) {
+	c.cache.Remove(key)
+}
+
 func (c *Cache) Stop() {
 	c.cache.Stop()
 }
@@ -45,9 +53,36 @@ func New(duration time.Duration) *Cache {
 	}
 }
 
+// Encoded is a value which a shared cache returns encoded as JSON, since it doesn't know the type of the value.
+type Encoded []byte
+
+// GetTyped gets a value of type T from the cache, decoding it if the cache is shared. Values which can't be decoded
+// are treated as missing.
+func GetTyped[T any](cache Cacheable, key string) (T, bool) {
+	var t T
+
+	v, ok := cache.Get(key)
+
+	if !ok {
+		return t, false
+	}
+
+	if encoded, ok := v.(Encoded); ok {
+		if err := json.Unmarshal(encoded, &t); err != nil {
+			return t, false
+		}
+
+		return t, true
+	}
+
+	t, ok = v.(T)
+
+	return t, ok
+}
+
 func MakeCacheable[T any](cache Cacheable, id string, f func() (*T, error)) (*T, error) {
-	if v, ok := cache.Get(id); ok {
-		return v.(*T), nil
+	if v, ok := GetTyped[*T](cache, id); ok {
+		return v, nil
 	}
 
 	v, err := f()
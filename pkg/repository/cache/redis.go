@@ -0,0 +1,77 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+)
+
+// RedisCache is a cache which is shared by the instances of Hatchet, so a value which one instance read from the
+// database isn't read again by the others. Values are stored as JSON, and Get returns them as Encoded, which GetTyped
+// and MakeCacheable decode. Errors of Redis are logged and treated as cache misses.
+type RedisCache struct {
+	client     *redis.Client
+	prefix     string
+	expiration time.Duration
+	l          *zerolog.Logger
+}
+
+func NewRedis(client *redis.Client, prefix string, duration time.Duration, l *zerolog.Logger) *RedisCache {
+	if duration == 0 {
+		// consider a duration of 0 a very short expiry instead of no expiry
+		duration = 1 * time.Millisecond
+	}
+
+	return &RedisCache{
+		client:     client,
+		prefix:     prefix,
+		expiration: duration,
+		l:          l,
+	}
+}
+
+// WithExpiration returns a cache which stores its values in the same Redis with another expiration.
+func (c *RedisCache) WithExpiration(duration time.Duration) *RedisCache {
+	return NewRedis(c.client, c.prefix, duration, c.l)
+}
+
+func (c *RedisCache) Set(key string, value interface{}) {
+	b, err := json.Marshal(value)
+
+	if err != nil {
+		c.l.Error().Err(err).Msgf("could not encode cache value %s", key)
+		return
+	}
+
+	if err := c.client.Set(context.Background(), c.prefix+key, b, c.expiration).Err(); err != nil {
+		c.l.Error().Err(err).Msgf("could not set cache value %s", key)
+	}
+}
+
+func (c *RedisCache) Get(key string) (interface{}, bool) {
+	b, err := c.client.Get(context.Background(), c.prefix+key).Bytes()
+
+	if errors.Is(err, redis.Nil) {
+		return nil, false
+	}
+
+	if err != nil {
+		c.l.Error().Err(err).Msgf("could not get cache value %s", key)
+		return nil, false
+	}
+
+	return Encoded(b), true
+}
+
+func (c *RedisCache) Delete(key string) {
+	if err := c.client.Del(context.Background(), c.prefix+key).Err(); err != nil {
+		c.l.Error().Err(err).Msgf("could not delete cache value %s", key)
+	}
+}
+
+// Stop doesn't close the client, which is shared by the caches which use the same Redis.
+func (c *RedisCache) Stop() {}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// ErrSpiffeIdPrefixTaken is returned when the SPIFFE ID prefix of a client CA overlaps with the prefix of a client CA
+// of another tenant
+var ErrSpiffeIdPrefixTaken = fmt.Errorf("spiffe id prefix overlaps with a prefix of another tenant")
+
+type CreateClientCAOpts struct {
+	// (required) the name of the CA, which is unique within the tenant
+	Name string `validate:"required,hatchetName"`
+
+	// (required) the PEM encoded CA certificate
+	Certificate string `validate:"required"`
+
+	// (required) the prefix of the SPIFFE IDs which authenticate as the tenant
+	SpiffeIdPrefix string `validate:"required,startswith=spiffe://"`
+
+	// (required) when the CA certificate expires
+	ExpiresAt time.Time `validate:"required"`
+}
+
+type ClientCARepository interface {
+	// CreateClientCA creates a client CA of a tenant. It returns ErrSpiffeIdPrefixTaken if the SPIFFE ID prefix
+	// overlaps with a prefix of another tenant, and ErrDuplicateKey if the tenant has a CA with the same name.
+	CreateClientCA(ctx context.Context, tenantId string, opts *CreateClientCAOpts) (*dbsqlc.TenantClientCA, error)
+
+	// ListClientCAs lists the client CAs of a tenant.
+	ListClientCAs(ctx context.Context, tenantId string) ([]*dbsqlc.TenantClientCA, error)
+
+	// GetClientCAById returns a client CA by its id.
+	GetClientCAById(ctx context.Context, id string) (*dbsqlc.TenantClientCA, error)
+
+	// ListClientCAsForSpiffeId lists the unexpired client CAs whose SPIFFE ID prefix matches a SPIFFE ID. The CAs
+	// are cached, so changes to CAs may take a short time to apply on other instances.
+	ListClientCAsForSpiffeId(ctx context.Context, spiffeId string) ([]*dbsqlc.TenantClientCA, error)
+
+	// DeleteClientCA deletes a client CA. Client certificates which were issued by the CA can no longer
+	// authenticate.
+	DeleteClientCA(ctx context.Context, id string) error
+}
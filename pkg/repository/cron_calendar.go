@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type CreateCronExclusionCalendarOpts struct {
+	// (required) the name of the calendar, which is unique within the tenant
+	Name string `validate:"required,hatchetName"`
+
+	// (optional) the dates which are excluded. Only the year, month and day of each date are used.
+	Dates []time.Time
+
+	// (optional) an iCal feed whose events are excluded
+	ICalURL *string `validate:"omitnil,url"`
+}
+
+type CronCalendarRepository interface {
+	// CreateCalendar creates an exclusion calendar which cron triggers can reference.
+	CreateCalendar(ctx context.Context, tenantId string, opts *CreateCronExclusionCalendarOpts) (*dbsqlc.CronExclusionCalendar, error)
+
+	// ListCalendars lists the exclusion calendars of a tenant, ordered by name.
+	ListCalendars(ctx context.Context, tenantId string) ([]*dbsqlc.CronExclusionCalendar, error)
+
+	// GetCalendarById returns an exclusion calendar by its id.
+	GetCalendarById(ctx context.Context, id string) (*dbsqlc.CronExclusionCalendar, error)
+
+	// GetCalendarByName returns an exclusion calendar of a tenant by its name.
+	GetCalendarByName(ctx context.Context, tenantId, name string) (*dbsqlc.CronExclusionCalendar, error)
+
+	// DeleteCalendar deletes an exclusion calendar. Crons which reference the calendar are no longer excluded
+	// on any date.
+	DeleteCalendar(ctx context.Context, id string) error
+}
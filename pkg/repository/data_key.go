@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+)
+
+// DataKeyRepository stores the data keys which the payloads of tenants are encrypted with. The keysets of data keys
+// are encrypted with the master key before they are stored.
+type DataKeyRepository interface {
+	// GetActiveDataKey returns the id and the encrypted keyset of the active data key of the tenant. The id is empty
+	// if the tenant doesn't have a data key.
+	GetActiveDataKey(ctx context.Context, tenantId string) (string, []byte, error)
+
+	// GetDataKey returns the encrypted keyset of a data key of the tenant.
+	GetDataKey(ctx context.Context, tenantId, keyId string) ([]byte, error)
+
+	// CreateDataKey creates the active data key of the tenant if it doesn't have one, and returns the id and the
+	// encrypted keyset of the active data key.
+	CreateDataKey(ctx context.Context, tenantId, keyId string, encryptedKeyset []byte) (string, []byte, error)
+
+	// RotateDataKey deactivates the active data key of the tenant and creates a new active data key.
+	RotateDataKey(ctx context.Context, tenantId, keyId string, encryptedKeyset []byte) error
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type ListDeadLetterQueueItemsOpts struct {
+	// (optional) number of items to skip
+	Offset *int
+
+	// (optional) number of items to return
+	Limit *int
+}
+
+type ListDeadLetterQueueItemsResult struct {
+	Rows  []*dbsqlc.DeadLetterQueueItem
+	Count int
+}
+
+type DeleteDeadLetterQueueItemsOpts struct {
+	// (optional) the ids of the items to delete. If not set, all items are deleted.
+	Ids []string `validate:"omitempty,dive,uuid"`
+
+	// (optional) only delete items which were added before this time
+	CreatedBefore *time.Time
+}
+
+type DeadLetterQueueRepository interface {
+	// CreateDeadLetterQueueItem adds a step run which failed after exhausting its retries to the dead-letter queue.
+	CreateDeadLetterQueueItem(ctx context.Context, tenantId, stepRunId, errStr string, retryCount int) error
+
+	// ListDeadLetterQueueItems lists the dead-letter queue of a tenant, newest first.
+	ListDeadLetterQueueItems(ctx context.Context, tenantId string, opts *ListDeadLetterQueueItemsOpts) (*ListDeadLetterQueueItemsResult, error)
+
+	// GetDeadLetterQueueItemsByIds returns the dead-letter queue items of a tenant with the given ids.
+	GetDeadLetterQueueItemsByIds(ctx context.Context, tenantId string, ids []string) ([]*dbsqlc.DeadLetterQueueItem, error)
+
+	// DeleteDeadLetterQueueItems deletes dead-letter queue items of a tenant and returns the number of deleted items.
+	DeleteDeadLetterQueueItems(ctx context.Context, tenantId string, opts *DeleteDeadLetterQueueItemsOpts) (int, error)
+}
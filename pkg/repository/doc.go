@@ -0,0 +1,30 @@
+// Package repository defines the persistence layer of Hatchet as a set of interfaces, so the storage backend can be
+// replaced without changing the engine, the API or the services which use it.
+//
+// A backend implements four entry points:
+//
+//   - APIRepository, which is used by the REST API and is read-heavy.
+//   - EngineRepository, which is used by the engine services and is write-heavy.
+//   - SchedulerRepository, which is used by the schedulers in pkg/scheduling/v2. Its writes for a queue shard are
+//     fenced by the lease of the shard, and are rejected with ErrLeaseLost once the lease is lost.
+//   - EntitlementsRepository, which stores the limits of the tenants.
+//
+// Each entry point returns a repository per resource, like TenantEngineRepository or WorkerEngineRepository, and the
+// services only depend on these interfaces. The loader in pkg/config/loader creates the repositories of the backend
+// and passes them to the services through database.Config.
+//
+// The Postgres backend in pkg/repository/prisma is the reference implementation. The repositories return the row
+// types of its generated queries in pkg/repository/prisma/dbsqlc and the models in pkg/repository/prisma/db. These
+// are plain structs, so other backends return them too, and the contracts of the implementations are the same:
+//
+//   - The options are validated with the validate tags of their fields, and invalid options are rejected before
+//     anything is written.
+//   - The engine repositories return pgx.ErrNoRows, and the API repositories return db.ErrNotFound, when a resource
+//     doesn't exist.
+//   - Writes of one call are atomic, and are visible to the reads of both entry points once the call returns, unless
+//     the method documents that it's buffered or eventually consistent.
+//
+// The package repositorytest contains a conformance suite of these contracts, which a backend runs against its own
+// repositories. It covers tenants, API tokens, workers, events, workflows, workflow runs, rate limits, and the leases
+// and scheduling decisions of the schedulers.
+package repository
@@ -0,0 +1,210 @@
+package encrypted
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/pkg/encryption"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+type eventEngineRepository struct {
+	repository.EventEngineRepository
+
+	enc *encryption.PayloadEncryption
+}
+
+func (r *eventEngineRepository) CreateEvent(ctx context.Context, opts *repository.CreateEventOpts) (*dbsqlc.Event, error) {
+	encryptedOpts, err := r.encryptEventOpts(ctx, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := r.EventEngineRepository.CreateEvent(ctx, encryptedOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the created event is sent to the message queue, which expects the data in plaintext
+	if err := decryptEvent(ctx, r.enc, event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func (r *eventEngineRepository) BulkCreateEvent(ctx context.Context, opts *repository.BulkCreateEventOpts) (*repository.BulkCreateEventResult, error) {
+	encryptedOpts := *opts
+	encryptedOpts.Events = make([]*repository.CreateEventOpts, len(opts.Events))
+
+	for i, eventOpts := range opts.Events {
+		var err error
+
+		if encryptedOpts.Events[i], err = r.encryptEventOpts(ctx, eventOpts); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := r.EventEngineRepository.BulkCreateEvent(ctx, &encryptedOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range res.Events {
+		if err := decryptEvent(ctx, r.enc, event); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+func (r *eventEngineRepository) BulkCreateEventSharedTenant(ctx context.Context, opts []*repository.CreateEventOpts) ([]*dbsqlc.Event, error) {
+	encryptedOpts := make([]*repository.CreateEventOpts, len(opts))
+
+	for i, eventOpts := range opts {
+		var err error
+
+		if encryptedOpts[i], err = r.encryptEventOpts(ctx, eventOpts); err != nil {
+			return nil, err
+		}
+	}
+
+	events, err := r.EventEngineRepository.BulkCreateEventSharedTenant(ctx, encryptedOpts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		if err := decryptEvent(ctx, r.enc, event); err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+func (r *eventEngineRepository) GetEventForEngine(ctx context.Context, tenantId, id string) (*dbsqlc.Event, error) {
+	event, err := r.EventEngineRepository.GetEventForEngine(ctx, tenantId, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decryptEvent(ctx, r.enc, event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func (r *eventEngineRepository) ListEventsByIds(ctx context.Context, tenantId string, ids []string) ([]*dbsqlc.Event, error) {
+	events, err := r.EventEngineRepository.ListEventsByIds(ctx, tenantId, ids)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		if err := decryptEvent(ctx, r.enc, event); err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+func (r *eventEngineRepository) ListEventsForReplay(ctx context.Context, tenantId string, opts *repository.ListEventsForReplayOpts) ([]*dbsqlc.Event, error) {
+	events, err := r.EventEngineRepository.ListEventsForReplay(ctx, tenantId, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		if err := decryptEvent(ctx, r.enc, event); err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+func (r *eventEngineRepository) encryptEventOpts(ctx context.Context, opts *repository.CreateEventOpts) (*repository.CreateEventOpts, error) {
+	encryptedOpts := *opts
+
+	var err error
+
+	if encryptedOpts.Data, err = r.enc.Encrypt(ctx, opts.TenantId, opts.Data); err != nil {
+		return nil, err
+	}
+
+	return &encryptedOpts, nil
+}
+
+func decryptEvent(ctx context.Context, enc *encryption.PayloadEncryption, event *dbsqlc.Event) error {
+	var err error
+
+	event.Data, err = enc.Decrypt(ctx, sqlchelpers.UUIDToStr(event.TenantId), event.Data)
+
+	return err
+}
+
+type eventAPIRepository struct {
+	repository.EventAPIRepository
+
+	enc *encryption.PayloadEncryption
+}
+
+func (r *eventAPIRepository) GetEventById(id string) (*db.EventModel, error) {
+	event, err := r.EventAPIRepository.GetEventById(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decryptEventModel(r.enc, event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func (r *eventAPIRepository) ListEventsById(tenantId string, ids []string) ([]db.EventModel, error) {
+	events, err := r.EventAPIRepository.ListEventsById(tenantId, ids)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range events {
+		if err := decryptEventModel(r.enc, &events[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+func decryptEventModel(enc *encryption.PayloadEncryption, event *db.EventModel) error {
+	if event.InnerEvent.Data == nil {
+		return nil
+	}
+
+	data, err := enc.Decrypt(context.Background(), event.TenantID, *event.InnerEvent.Data)
+
+	if err != nil {
+		return err
+	}
+
+	decrypted := db.JSON(data)
+	event.InnerEvent.Data = &decrypted
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package encrypted
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/pkg/encryption"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type getGroupKeyRunEngineRepository struct {
+	repository.GetGroupKeyRunEngineRepository
+
+	enc *encryption.PayloadEncryption
+}
+
+func (r *getGroupKeyRunEngineRepository) UpdateGetGroupKeyRun(ctx context.Context, tenantId, getGroupKeyRunId string, opts *repository.UpdateGetGroupKeyRunOpts) (*dbsqlc.GetGroupKeyRunForEngineRow, error) {
+	row, err := r.GetGroupKeyRunEngineRepository.UpdateGetGroupKeyRun(ctx, tenantId, getGroupKeyRunId, opts)
+
+	if err != nil || row == nil {
+		return row, err
+	}
+
+	if row.GetGroupKeyRun.Input, err = r.enc.Decrypt(ctx, tenantId, row.GetGroupKeyRun.Input); err != nil {
+		return nil, err
+	}
+
+	return row, nil
+}
+
+func (r *getGroupKeyRunEngineRepository) GetGroupKeyRunForEngine(ctx context.Context, tenantId, getGroupKeyRunId string) (*dbsqlc.GetGroupKeyRunForEngineRow, error) {
+	row, err := r.GetGroupKeyRunEngineRepository.GetGroupKeyRunForEngine(ctx, tenantId, getGroupKeyRunId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if row.GetGroupKeyRun.Input, err = r.enc.Decrypt(ctx, tenantId, row.GetGroupKeyRun.Input); err != nil {
+		return nil, err
+	}
+
+	return row, nil
+}
@@ -0,0 +1,50 @@
+package encrypted
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/pkg/encryption"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type mapEngineRepository struct {
+	repository.MapEngineRepository
+
+	enc *encryption.PayloadEncryption
+}
+
+func (r *mapEngineRepository) CreateMapItems(ctx context.Context, tenantId, mapStepRunId string, opts *repository.CreateMapItemsOpts) ([]*dbsqlc.GetStepRunForEngineRow, error) {
+	encryptedOpts := *opts
+	encryptedOpts.Inputs = make([][]byte, len(opts.Inputs))
+
+	for i, input := range opts.Inputs {
+		var err error
+
+		if encryptedOpts.Inputs[i], err = encryptStepRunInput(ctx, r.enc, tenantId, input); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.MapEngineRepository.CreateMapItems(ctx, tenantId, mapStepRunId, &encryptedOpts)
+}
+
+func (r *mapEngineRepository) FinishMapItem(ctx context.Context, tenantId, stepRunId string, output []byte, concurrency int) (*repository.FinishMapItemResult, error) {
+	encryptedOutput, err := encryptValue(ctx, r.enc, tenantId, output)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.MapEngineRepository.FinishMapItem(ctx, tenantId, stepRunId, encryptedOutput, concurrency)
+
+	if err != nil || res == nil {
+		return res, err
+	}
+
+	if res.Output, err = r.enc.Decrypt(ctx, tenantId, res.Output); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
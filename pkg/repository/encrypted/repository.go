@@ -0,0 +1,86 @@
+// Package encrypted wraps repositories so that the payloads of tenants, like step run inputs and outputs and event
+// data, are encrypted before they are written to the database and decrypted after they are read.
+package encrypted
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/encryption"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+type engineRepository struct {
+	repository.EngineRepository
+
+	event          repository.EventEngineRepository
+	getGroupKeyRun repository.GetGroupKeyRunEngineRepository
+	stepRun        repository.StepRunEngineRepository
+	workflowRun    repository.WorkflowRunEngineRepository
+	mapItems       repository.MapEngineRepository
+	stepRunCache   repository.StepRunCacheRepository
+}
+
+// NewEngineRepository returns an engine repository which encrypts payloads with enc.
+func NewEngineRepository(repo repository.EngineRepository, enc *encryption.PayloadEncryption) repository.EngineRepository {
+	return &engineRepository{
+		EngineRepository: repo,
+		event:            &eventEngineRepository{EventEngineRepository: repo.Event(), enc: enc},
+		getGroupKeyRun:   &getGroupKeyRunEngineRepository{GetGroupKeyRunEngineRepository: repo.GetGroupKeyRun(), enc: enc},
+		stepRun:          &stepRunEngineRepository{StepRunEngineRepository: repo.StepRun(), enc: enc},
+		workflowRun:      &workflowRunEngineRepository{WorkflowRunEngineRepository: repo.WorkflowRun(), enc: enc},
+		mapItems:         &mapEngineRepository{MapEngineRepository: repo.Map(), enc: enc},
+		stepRunCache:     &stepRunCacheRepository{StepRunCacheRepository: repo.StepRunCache(), enc: enc},
+	}
+}
+
+func (r *engineRepository) Event() repository.EventEngineRepository {
+	return r.event
+}
+
+func (r *engineRepository) GetGroupKeyRun() repository.GetGroupKeyRunEngineRepository {
+	return r.getGroupKeyRun
+}
+
+func (r *engineRepository) StepRun() repository.StepRunEngineRepository {
+	return r.stepRun
+}
+
+func (r *engineRepository) WorkflowRun() repository.WorkflowRunEngineRepository {
+	return r.workflowRun
+}
+
+func (r *engineRepository) Map() repository.MapEngineRepository {
+	return r.mapItems
+}
+
+func (r *engineRepository) StepRunCache() repository.StepRunCacheRepository {
+	return r.stepRunCache
+}
+
+type apiRepository struct {
+	repository.APIRepository
+
+	event       repository.EventAPIRepository
+	stepRun     repository.StepRunAPIRepository
+	workflowRun repository.WorkflowRunAPIRepository
+}
+
+// NewAPIRepository returns an API repository which encrypts payloads with enc.
+func NewAPIRepository(repo repository.APIRepository, enc *encryption.PayloadEncryption) repository.APIRepository {
+	return &apiRepository{
+		APIRepository: repo,
+		event:         &eventAPIRepository{EventAPIRepository: repo.Event(), enc: enc},
+		stepRun:       &stepRunAPIRepository{StepRunAPIRepository: repo.StepRun(), enc: enc},
+		workflowRun:   &workflowRunAPIRepository{WorkflowRunAPIRepository: repo.WorkflowRun(), enc: enc},
+	}
+}
+
+func (r *apiRepository) Event() repository.EventAPIRepository {
+	return r.event
+}
+
+func (r *apiRepository) StepRun() repository.StepRunAPIRepository {
+	return r.stepRun
+}
+
+func (r *apiRepository) WorkflowRun() repository.WorkflowRunAPIRepository {
+	return r.workflowRun
+}
@@ -0,0 +1,224 @@
+package encrypted
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/integrations/blobstore"
+	"github.com/hatchet-dev/hatchet/pkg/encryption"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+type stepRunEngineRepository struct {
+	repository.StepRunEngineRepository
+
+	enc *encryption.PayloadEncryption
+}
+
+func (r *stepRunEngineRepository) StepRunSucceeded(ctx context.Context, tenantId, workflowRunId, stepRunId string, finishedAt time.Time, output []byte) error {
+	encryptedOutput, err := encryptValue(ctx, r.enc, tenantId, output)
+
+	if err != nil {
+		return err
+	}
+
+	return r.StepRunEngineRepository.StepRunSucceeded(ctx, tenantId, workflowRunId, stepRunId, finishedAt, encryptedOutput)
+}
+
+func (r *stepRunEngineRepository) ReplayStepRun(ctx context.Context, tenantId, stepRunId string, input []byte) (*dbsqlc.GetStepRunForEngineRow, error) {
+	encryptedInput, err := encryptStepRunInput(ctx, r.enc, tenantId, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.StepRunEngineRepository.ReplayStepRun(ctx, tenantId, stepRunId, encryptedInput)
+}
+
+func (r *stepRunEngineRepository) QueueStepRun(ctx context.Context, tenantId, stepRunId string, opts *repository.QueueStepRunOpts) (*dbsqlc.GetStepRunForEngineRow, error) {
+	encryptedOpts := *opts
+
+	var err error
+
+	if encryptedOpts.Input, err = encryptStepRunInput(ctx, r.enc, tenantId, opts.Input); err != nil {
+		return nil, err
+	}
+
+	return r.StepRunEngineRepository.QueueStepRun(ctx, tenantId, stepRunId, &encryptedOpts)
+}
+
+func (r *stepRunEngineRepository) UpdateStepRunOverridesData(ctx context.Context, tenantId, stepRunId string, opts *repository.UpdateStepRunOverridesDataOpts) ([]byte, error) {
+	input, err := r.StepRunEngineRepository.UpdateStepRunOverridesData(ctx, tenantId, stepRunId, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.enc.Decrypt(ctx, tenantId, input)
+}
+
+func (r *stepRunEngineRepository) GetStepRunDataForEngine(ctx context.Context, tenantId, stepRunId string) (*dbsqlc.GetStepRunDataForEngineRow, error) {
+	data, err := r.StepRunEngineRepository.GetStepRunDataForEngine(ctx, tenantId, stepRunId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Input, err = r.enc.Decrypt(ctx, tenantId, data.Input); err != nil {
+		return nil, err
+	}
+
+	if data.Output, err = r.enc.Decrypt(ctx, tenantId, data.Output); err != nil {
+		return nil, err
+	}
+
+	if data.JobRunLookupData, err = r.enc.Decrypt(ctx, tenantId, data.JobRunLookupData); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (r *stepRunEngineRepository) GetStepRunBulkDataForEngine(ctx context.Context, tenantId string, stepRunIds []string) ([]*dbsqlc.GetStepRunBulkDataForEngineRow, error) {
+	rows, err := r.StepRunEngineRepository.GetStepRunBulkDataForEngine(ctx, tenantId, stepRunIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		if row.Input, err = r.enc.Decrypt(ctx, tenantId, row.Input); err != nil {
+			return nil, err
+		}
+
+		if row.Output, err = r.enc.Decrypt(ctx, tenantId, row.Output); err != nil {
+			return nil, err
+		}
+
+		if row.JobRunLookupData, err = r.enc.Decrypt(ctx, tenantId, row.JobRunLookupData); err != nil {
+			return nil, err
+		}
+	}
+
+	return rows, nil
+}
+
+type stepRunAPIRepository struct {
+	repository.StepRunAPIRepository
+
+	enc *encryption.PayloadEncryption
+}
+
+func (r *stepRunAPIRepository) GetStepRunById(stepRunId string) (*repository.GetStepRunFull, error) {
+	stepRun, err := r.StepRunAPIRepository.GetStepRunById(stepRunId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	tenantId := sqlchelpers.UUIDToStr(stepRun.TenantId)
+
+	if stepRun.Input, err = r.enc.Decrypt(ctx, tenantId, stepRun.Input); err != nil {
+		return nil, err
+	}
+
+	if stepRun.Output, err = r.enc.Decrypt(ctx, tenantId, stepRun.Output); err != nil {
+		return nil, err
+	}
+
+	return stepRun, nil
+}
+
+func (r *stepRunAPIRepository) ListStepRunArchives(tenantId, stepRunId string, opts *repository.ListStepRunArchivesOpts) (*repository.ListStepRunArchivesResult, error) {
+	res, err := r.StepRunAPIRepository.ListStepRunArchives(tenantId, stepRunId, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+
+	for _, archive := range res.Rows {
+		if archive.Input, err = r.enc.Decrypt(ctx, tenantId, archive.Input); err != nil {
+			return nil, err
+		}
+
+		if archive.Output, err = r.enc.Decrypt(ctx, tenantId, archive.Output); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// encryptStepRunInput encrypts the payloads in the input of a step run: the input of the workflow run, the outputs
+// of the parents and the element of a map step run. The other fields stay in plaintext, since some of them are
+// updated in place by the database, for example the overrides from the playground.
+func encryptStepRunInput(ctx context.Context, enc *encryption.PayloadEncryption, tenantId string, input []byte) ([]byte, error) {
+	if len(input) == 0 {
+		return input, nil
+	}
+
+	if _, ok := blobstore.ParseRef(input); ok {
+		return input, nil
+	}
+
+	data := map[string]json.RawMessage{}
+
+	if err := json.Unmarshal(input, &data); err != nil {
+		return nil, fmt.Errorf("could not unmarshal step run input: %w", err)
+	}
+
+	for _, field := range []string{"input", "map_item"} {
+		if v, ok := data[field]; ok {
+			encrypted, err := encryptValue(ctx, enc, tenantId, v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			data[field] = encrypted
+		}
+	}
+
+	if v, ok := data["parents"]; ok {
+		parents := map[string]json.RawMessage{}
+
+		if err := json.Unmarshal(v, &parents); err == nil {
+			for stepReadableId, output := range parents {
+				encrypted, err := encryptValue(ctx, enc, tenantId, output)
+
+				if err != nil {
+					return nil, err
+				}
+
+				parents[stepReadableId] = encrypted
+			}
+
+			if data["parents"], err = json.Marshal(parents); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return json.Marshal(data)
+}
+
+// encryptValue encrypts a JSON payload, unless it is null or was moved to a blob store
+func encryptValue(ctx context.Context, enc *encryption.PayloadEncryption, tenantId string, v []byte) ([]byte, error) {
+	if len(v) == 0 || bytes.Equal(bytes.TrimSpace(v), []byte("null")) {
+		return v, nil
+	}
+
+	if _, ok := blobstore.ParseRef(v); ok {
+		return v, nil
+	}
+
+	return enc.Encrypt(ctx, tenantId, v)
+}
@@ -0,0 +1,36 @@
+package encrypted
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/pkg/encryption"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+type stepRunCacheRepository struct {
+	repository.StepRunCacheRepository
+
+	enc *encryption.PayloadEncryption
+}
+
+func (r *stepRunCacheRepository) GetCachedOutput(ctx context.Context, tenantId, actionId, inputHash string) ([]byte, error) {
+	output, err := r.StepRunCacheRepository.GetCachedOutput(ctx, tenantId, actionId, inputHash)
+
+	if err != nil || output == nil {
+		return output, err
+	}
+
+	return r.enc.Decrypt(ctx, tenantId, output)
+}
+
+func (r *stepRunCacheRepository) CacheOutput(ctx context.Context, tenantId string, opts *repository.CacheStepRunOutputOpts) error {
+	encryptedOpts := *opts
+
+	var err error
+
+	if encryptedOpts.Output, err = encryptValue(ctx, r.enc, tenantId, opts.Output); err != nil {
+		return err
+	}
+
+	return r.StepRunCacheRepository.CacheOutput(ctx, tenantId, &encryptedOpts)
+}
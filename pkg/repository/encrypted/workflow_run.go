@@ -0,0 +1,150 @@
+package encrypted
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hatchet-dev/hatchet/pkg/encryption"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type workflowRunEngineRepository struct {
+	repository.WorkflowRunEngineRepository
+
+	enc *encryption.PayloadEncryption
+}
+
+func (r *workflowRunEngineRepository) CreateNewWorkflowRun(ctx context.Context, tenantId string, opts *repository.CreateWorkflowRunOpts) (*dbsqlc.WorkflowRun, error) {
+	encryptedOpts, err := encryptWorkflowRunOpts(ctx, r.enc, tenantId, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.WorkflowRunEngineRepository.CreateNewWorkflowRun(ctx, tenantId, encryptedOpts)
+}
+
+func (r *workflowRunEngineRepository) CreateNewWorkflowRuns(ctx context.Context, tenantId string, opts []*repository.CreateWorkflowRunOpts) ([]*dbsqlc.WorkflowRun, error) {
+	encryptedOpts := make([]*repository.CreateWorkflowRunOpts, len(opts))
+
+	for i, o := range opts {
+		var err error
+
+		if encryptedOpts[i], err = encryptWorkflowRunOpts(ctx, r.enc, tenantId, o); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.WorkflowRunEngineRepository.CreateNewWorkflowRuns(ctx, tenantId, encryptedOpts)
+}
+
+func (r *workflowRunEngineRepository) GetWorkflowRunInputData(tenantId, workflowRunId string) (map[string]interface{}, error) {
+	input, err := r.WorkflowRunEngineRepository.GetWorkflowRunInputData(tenantId, workflowRunId)
+
+	if err != nil || input == nil {
+		return input, err
+	}
+
+	if _, ok := input[encryption.EncryptedField]; !ok {
+		return input, nil
+	}
+
+	inputBytes, err := json.Marshal(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted, err := r.enc.Decrypt(context.Background(), tenantId, inputBytes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]interface{}{}
+
+	if err := json.Unmarshal(decrypted, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *workflowRunEngineRepository) ListWorkflowRunsForOutput(ctx context.Context, tenantId string, workflowRunIds []string) ([]*repository.WorkflowRunForOutput, error) {
+	workflowRuns, err := r.WorkflowRunEngineRepository.ListWorkflowRunsForOutput(ctx, tenantId, workflowRunIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, workflowRun := range workflowRuns {
+		if workflowRun.Input, err = r.enc.Decrypt(ctx, tenantId, workflowRun.Input); err != nil {
+			return nil, err
+		}
+
+		for stepReadableId, output := range workflowRun.StepOutputs {
+			if workflowRun.StepOutputs[stepReadableId], err = r.enc.Decrypt(ctx, tenantId, output); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return workflowRuns, nil
+}
+
+type workflowRunAPIRepository struct {
+	repository.WorkflowRunAPIRepository
+
+	enc *encryption.PayloadEncryption
+}
+
+func (r *workflowRunAPIRepository) CreateNewWorkflowRun(ctx context.Context, tenantId string, opts *repository.CreateWorkflowRunOpts) (*dbsqlc.WorkflowRun, error) {
+	encryptedOpts, err := encryptWorkflowRunOpts(ctx, r.enc, tenantId, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.WorkflowRunAPIRepository.CreateNewWorkflowRun(ctx, tenantId, encryptedOpts)
+}
+
+func (r *workflowRunAPIRepository) GetStepRunsForJobRuns(ctx context.Context, tenantId string, jobRunIds []string) ([]*repository.StepRunForJobRun, error) {
+	stepRuns, err := r.WorkflowRunAPIRepository.GetStepRunsForJobRuns(ctx, tenantId, jobRunIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stepRun := range stepRuns {
+		if stepRun.Output, err = r.enc.Decrypt(ctx, tenantId, stepRun.Output); err != nil {
+			return nil, err
+		}
+	}
+
+	return stepRuns, nil
+}
+
+// encryptWorkflowRunOpts returns a copy of the options with the input of the workflow run encrypted, which is also
+// the input of the get group key run
+func encryptWorkflowRunOpts(ctx context.Context, enc *encryption.PayloadEncryption, tenantId string, opts *repository.CreateWorkflowRunOpts) (*repository.CreateWorkflowRunOpts, error) {
+	encryptedOpts := *opts
+
+	var err error
+
+	if encryptedOpts.InputData, err = encryptValue(ctx, enc, tenantId, opts.InputData); err != nil {
+		return nil, err
+	}
+
+	if opts.GetGroupKeyRun != nil {
+		getGroupKeyRun := *opts.GetGroupKeyRun
+
+		if getGroupKeyRun.Input, err = encryptValue(ctx, enc, tenantId, opts.GetGroupKeyRun.Input); err != nil {
+			return nil, err
+		}
+
+		encryptedOpts.GetGroupKeyRun = &getGroupKeyRun
+	}
+
+	return &encryptedOpts, nil
+}
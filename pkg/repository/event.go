@@ -28,6 +28,27 @@ type CreateEventOpts struct {
 
 	// (optional) the event metadata
 	AdditionalMetadata []byte
+
+	// (optional) the idempotency key for the workflow runs triggered by the event. This is not stored on the event.
+	IdempotencyKey *string
+
+	// (optional) the time after which the event triggers workflows. The event is stored right away, but it is only
+	// delivered once this time has passed. This is not stored on the event.
+	DeliverAfter *time.Time
+}
+
+type CreateDelayedEventOpts struct {
+	// (required) the id of the event which is delivered later
+	EventId string `validate:"required,uuid"`
+
+	// (required) the time after which the event triggers workflows
+	DeliverAfter time.Time `validate:"required"`
+
+	// (optional) the idempotency key for the workflow runs triggered by the event
+	IdempotencyKey *string
+
+	// (optional) the workflows which the event is routed to by the routing rules of the tenant
+	RoutedWorkflowIds []string `validate:"dive,uuid"`
 }
 
 type ListEventOpts struct {
@@ -65,6 +86,23 @@ type ListEventOpts struct {
 	Ids []string
 }
 
+type ListEventsForReplayOpts struct {
+	// (required) the start of the time window, inclusive
+	Since time.Time `validate:"required"`
+
+	// (required) the end of the time window, exclusive
+	Until time.Time `validate:"required,gtfield=Since"`
+
+	// (optional) a list of event keys to filter by
+	Keys []string
+
+	// (optional) the additional metadata which the events must contain
+	AdditionalMetadata []byte
+
+	// (required) the maximum number of events to return
+	Limit int `validate:"required,min=1"`
+}
+
 type ListEventResult struct {
 	Rows  []*dbsqlc.ListEventsRow
 	Count int
@@ -100,11 +138,19 @@ type EventEngineRepository interface {
 	// BulkCreateEventSharedTenant creates new events for multiple tenants.
 	BulkCreateEventSharedTenant(ctx context.Context, opts []*CreateEventOpts) ([]*dbsqlc.Event, error)
 
+	// CreateDelayedEvents stores events which trigger workflows once their delivery time has passed. The events
+	// must already have been created.
+	CreateDelayedEvents(ctx context.Context, tenantId string, opts []*CreateDelayedEventOpts) error
+
 	// GetEventForEngine returns an event for the engine by id.
 	GetEventForEngine(ctx context.Context, tenantId, id string) (*dbsqlc.Event, error)
 
 	ListEventsByIds(ctx context.Context, tenantId string, ids []string) ([]*dbsqlc.Event, error)
 
+	// ListEventsForReplay returns the events of a tenant which were created in a time window, ordered by their creation
+	// time. Events which are replays of other events aren't returned, so a window isn't replayed twice.
+	ListEventsForReplay(ctx context.Context, tenantId string, opts *ListEventsForReplayOpts) ([]*dbsqlc.Event, error)
+
 	// DeleteExpiredEvents deletes events that were created before the given time. It returns the number of deleted events
 	// and the number of non-deleted events that match the conditions.
 	SoftDeleteExpiredEvents(ctx context.Context, tenantId string, before time.Time) (bool, error)
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type UpsertEventDedupRuleOpts struct {
+	// (required) the key of the events which are deduplicated
+	EventKey string `validate:"required"`
+
+	// (required) the CEL expression which evaluates to the dedup key of an event
+	Expression string `validate:"required"`
+
+	// (required) the number of seconds after the first event with a dedup key in which events with the same dedup
+	// key are duplicates
+	WindowSeconds int32 `validate:"required,min=1,max=604800"`
+}
+
+type EventDedupRepository interface {
+	// UpsertEventDedupRule creates the dedup rule of an event key, or replaces the existing rule of the key.
+	UpsertEventDedupRule(ctx context.Context, tenantId string, opts *UpsertEventDedupRuleOpts) (*dbsqlc.EventDedupRule, error)
+
+	// ListEventDedupRules lists the dedup rules of a tenant.
+	ListEventDedupRules(ctx context.Context, tenantId string) ([]*dbsqlc.EventDedupRule, error)
+
+	// GetEventDedupRuleById returns a dedup rule by its id.
+	GetEventDedupRuleById(ctx context.Context, id string) (*dbsqlc.EventDedupRule, error)
+
+	// GetEventDedupRuleForKey returns the dedup rule of an event key, or nil if events with the key aren't
+	// deduplicated. Rules are cached, so changes to rules may take a short time to apply.
+	GetEventDedupRuleForKey(ctx context.Context, tenantId, eventKey string) (*dbsqlc.EventDedupRule, error)
+
+	// DeleteEventDedupRule deletes a dedup rule. The dedup keys which were claimed under the rule expire on their own.
+	DeleteEventDedupRule(ctx context.Context, id string) error
+
+	// ClaimEventDedupKey claims a dedup key of an event key for the window. It returns false if the key is held by a
+	// different event whose window hasn't ended, in which case the event is a duplicate.
+	ClaimEventDedupKey(ctx context.Context, tenantId, eventKey, dedupKey, eventId string, window time.Duration) (bool, error)
+
+	// DeleteExpiredEventDedupKeys deletes the dedup keys whose window has ended.
+	DeleteExpiredEventDedupKeys(ctx context.Context, tenantId string) error
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type UpsertEventRoutingRuleOpts struct {
+	// (required) the name of the rule, which is unique within the tenant
+	Name string `validate:"required,hatchetName"`
+
+	// (optional) the key of the events the rule applies to. The rule applies to events with any key if not set.
+	EventKey *string
+
+	// (required) the CEL predicate over the data and the additional metadata of an event
+	Expression string `validate:"required"`
+
+	// (required) the workflow which is triggered by the events which match the predicate
+	WorkflowId string `validate:"required,uuid"`
+}
+
+type EventRoutingRepository interface {
+	// UpsertEventRoutingRule creates a routing rule, or replaces the existing rule with the same name.
+	UpsertEventRoutingRule(ctx context.Context, tenantId string, opts *UpsertEventRoutingRuleOpts) (*dbsqlc.EventRoutingRule, error)
+
+	// ListEventRoutingRules lists the routing rules of a tenant along with the names of their workflows.
+	ListEventRoutingRules(ctx context.Context, tenantId string) ([]*dbsqlc.ListEventRoutingRulesRow, error)
+
+	// ListEventRoutingRulesForEngine lists the routing rules of a tenant whose workflows haven't been deleted. Rules
+	// are cached, so changes to rules may take a short time to apply.
+	ListEventRoutingRulesForEngine(ctx context.Context, tenantId string) ([]*dbsqlc.EventRoutingRule, error)
+
+	// GetEventRoutingRuleById returns a routing rule by its id.
+	GetEventRoutingRuleById(ctx context.Context, id string) (*dbsqlc.EventRoutingRule, error)
+
+	// DeleteEventRoutingRule deletes a routing rule.
+	DeleteEventRoutingRule(ctx context.Context, id string) error
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type CreateEventSinkOpts struct {
+	// (required) the name of the sink, unique per tenant
+	Name string `validate:"required,hatchetName"`
+
+	// (required) the kind of the sink
+	Kind dbsqlc.EventSinkKind `validate:"required,oneof=KAFKA WEBHOOK NATS"`
+
+	// (optional) the url of the webhook or the NATS server
+	URL *string `validate:"omitnil,url"`
+
+	// (optional) the brokers of the Kafka cluster
+	Brokers []string `validate:"dive,hostname_port"`
+
+	// (optional) the Kafka topic or the prefix of the NATS subjects which records are published to
+	Topic *string
+
+	// (optional) whether the connection to the Kafka brokers uses TLS
+	TLS bool
+
+	// (optional) the encrypted secret which webhook requests are signed with
+	Secret *string
+
+	// (optional) the name of the tenant secret which stores the username of the Kafka or NATS connection
+	UsernameSecret *string
+
+	// (optional) the name of the tenant secret which stores the password of the Kafka or NATS connection, or the
+	// NATS token if no username is set
+	PasswordSecret *string
+
+	// (optional) the types of the records which are delivered to the sink, all records are delivered if empty
+	EventTypes []string
+
+	// (required) the number of attempts after which a delivery is dead-lettered
+	MaxAttempts int32 `validate:"required,min=1,max=100"`
+
+	// (required) the format of the records which are delivered to the sink
+	Format dbsqlc.EventSinkFormat `validate:"required,oneof=HATCHET CLOUDEVENTS"`
+}
+
+type CreateEventSinkDeliveryOpts struct {
+	// (required) the id of the sink which the record is delivered to
+	SinkId string `validate:"required,uuid"`
+
+	// (required) the JSON encoded record
+	Payload []byte `validate:"required"`
+}
+
+type EventSinkRepository interface {
+	// CreateEventSink creates a sink which the lifecycle events of the runs of a tenant are delivered to.
+	CreateEventSink(ctx context.Context, tenantId string, opts *CreateEventSinkOpts) (*dbsqlc.EventSink, error)
+
+	// ListEventSinks lists the event sinks of a tenant.
+	ListEventSinks(ctx context.Context, tenantId string) ([]*dbsqlc.EventSink, error)
+
+	// ListEventSinksByPartitionId lists the event sinks of the tenants which are assigned to a worker partition.
+	ListEventSinksByPartitionId(ctx context.Context, partitionId string) ([]*dbsqlc.EventSink, error)
+
+	// GetEventSinkById returns an event sink by its id.
+	GetEventSinkById(ctx context.Context, id string) (*dbsqlc.EventSink, error)
+
+	// DeleteEventSink deletes an event sink and its pending and dead-lettered deliveries.
+	DeleteEventSink(ctx context.Context, id string) error
+
+	// CountEventSinkDeliveries counts the pending and dead-lettered deliveries of the event sinks of a tenant.
+	CountEventSinkDeliveries(ctx context.Context, tenantId string) ([]*dbsqlc.CountEventSinkDeliveriesRow, error)
+
+	// CreateEventSinkDeliveries queues records for delivery to the event sinks of a tenant.
+	CreateEventSinkDeliveries(ctx context.Context, tenantId string, opts []*CreateEventSinkDeliveryOpts) error
+
+	// ListDueEventSinkDeliveries lists the pending deliveries of a sink whose next attempt is due, oldest first.
+	ListDueEventSinkDeliveries(ctx context.Context, sinkId string, limit int) ([]*dbsqlc.EventSinkDelivery, error)
+
+	// DeleteEventSinkDeliveries deletes deliveries which were delivered.
+	DeleteEventSinkDeliveries(ctx context.Context, ids []int64) error
+
+	// FailEventSinkDeliveries schedules the next attempt of deliveries which failed, and dead-letters the deliveries
+	// which reached the maximum number of attempts.
+	FailEventSinkDeliveries(ctx context.Context, ids []int64, maxAttempts int32, lastError string) error
+
+	// ReplayEventSinkDeliveries moves the dead-lettered deliveries of a sink back to pending, and returns the number
+	// of deliveries which were moved.
+	ReplayEventSinkDeliveries(ctx context.Context, sinkId string) (int64, error)
+}
@@ -1,8 +1,23 @@
 package repository
 
-import "github.com/jackc/pgx/v5/pgxpool"
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
 
 type HealthRepository interface {
 	IsHealthy() bool
 	PgStat() *pgxpool.Stat
+
+	// Ping returns an error if the database doesn't accept queries.
+	Ping(ctx context.Context) error
+
+	// CheckLease acquires and releases an engine lease which is only used by the holder, and returns an error if it
+	// can't be acquired, like on a read-only database.
+	CheckLease(ctx context.Context, holderId string) error
+
+	// ListPendingMigrations returns the names of the migrations which are embedded in the binary, but weren't applied
+	// to the database.
+	ListPendingMigrations(ctx context.Context) ([]string, error)
 }
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type CreateInboundWebhookOpts struct {
+	// (required) the name of the webhook, unique per tenant
+	Name string `validate:"required,hatchetName"`
+
+	// (required) the scheme which signatures of requests are verified with
+	SignatureScheme dbsqlc.InboundWebhookSignatureScheme `validate:"required,oneof=HMAC_SHA256 GITHUB STRIPE SVIX"`
+
+	// (optional) the header which contains the signature, required for the HMAC_SHA256 scheme
+	SignatureHeader *string
+
+	// (required) the encrypted signing secret
+	Secret string `validate:"required"`
+
+	// (optional) the static key of the events, exactly one of the event key and the event key expression must be set
+	EventKey *string
+
+	// (optional) a CEL expression which evaluates to the key of the events
+	EventKeyExpression *string
+
+	// (optional) a CEL expression which evaluates to the data of the events
+	DataExpression *string
+}
+
+type InboundWebhookRepository interface {
+	// CreateInboundWebhook creates an endpoint which ingests the requests it receives as events.
+	CreateInboundWebhook(ctx context.Context, tenantId string, opts *CreateInboundWebhookOpts) (*dbsqlc.InboundWebhook, error)
+
+	// ListInboundWebhooks lists the inbound webhooks of a tenant.
+	ListInboundWebhooks(ctx context.Context, tenantId string) ([]*dbsqlc.InboundWebhook, error)
+
+	// GetInboundWebhookById returns an inbound webhook by its id.
+	GetInboundWebhookById(ctx context.Context, id string) (*dbsqlc.InboundWebhook, error)
+
+	// DeleteInboundWebhook deletes an inbound webhook, so its URL stops accepting requests.
+	DeleteInboundWebhook(ctx context.Context, id string) error
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// ErrKafkaPartitionLeaseLost is returned when a consumer commits the offset of a partition which it no longer holds
+// a lease on.
+var ErrKafkaPartitionLeaseLost = fmt.Errorf("lease on kafka partition was lost")
+
+type KafkaPartition struct {
+	Topic string
+
+	Partition int32
+}
+
+type AcquireKafkaPartitionsOpts struct {
+	// (required) the consumer group which the offsets are tracked for
+	ConsumerGroup string `validate:"required"`
+
+	// (required) the id of the consumer which acquires the leases
+	ConsumerId string `validate:"required,uuid"`
+
+	// (required) the partitions to acquire
+	Partitions []KafkaPartition `validate:"required,min=1"`
+
+	// (required) how long the leases are held for if they aren't extended
+	LeaseDuration time.Duration `validate:"required"`
+}
+
+// KafkaOffsetRepository tracks the offsets of the Kafka partitions which events are ingested from. Each partition is
+// consumed by a single engine at a time, which holds a lease on the partition.
+type KafkaOffsetRepository interface {
+	// AcquirePartitions acquires the leases on the partitions which aren't leased by another consumer, and extends the
+	// leases which the consumer already holds. Returns the partitions which the consumer holds a lease on, along with
+	// their offsets.
+	AcquirePartitions(ctx context.Context, opts *AcquireKafkaPartitionsOpts) ([]*dbsqlc.KafkaPartitionOffset, error)
+
+	// CommitOffset stores the offset of the next record to ingest from a partition. Returns ErrKafkaPartitionLeaseLost
+	// if the consumer no longer holds the lease on the partition.
+	CommitOffset(ctx context.Context, consumerGroup, consumerId, topic string, partition int32, offset int64) error
+
+	// ReleasePartitions releases all leases of the consumer, so other consumers can take over its partitions.
+	ReleasePartitions(ctx context.Context, consumerGroup, consumerId string) error
+}
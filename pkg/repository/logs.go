@@ -40,6 +40,10 @@ type ListLogsOpts struct {
 	// (optional) a search query
 	Search *string
 
+	// (optional) only return log lines with an id greater than this id, which is used to tail the logs. Log lines are
+	// returned in the order they were stored.
+	AfterId *int64 `validate:"omitnil,min=0"`
+
 	// (optional) the order by field
 	OrderBy *string `validate:"omitempty,oneof=createdAt"`
 
@@ -58,6 +62,15 @@ type LogsAPIRepository interface {
 }
 
 type LogsEngineRepository interface {
-	// PutLog creates a new log line.
+	// PutLog creates a new log line. It returns nil if the log line was dropped because the step run doesn't exist or
+	// already has the maximum number of log lines.
 	PutLog(ctx context.Context, tenantId string, opts *CreateLogLineOpts) (*dbsqlc.LogLine, error)
+
+	// PutLogs creates log lines in bulk, and returns the number of log lines which were stored. Log lines of step runs
+	// which don't exist or already have the maximum number of log lines are dropped.
+	PutLogs(ctx context.Context, tenantId string, opts []*CreateLogLineOpts) (int, error)
+
+	// DeleteExpiredLogLines deletes log lines which were created before the given time. It returns true if there are
+	// more log lines to delete.
+	DeleteExpiredLogLines(ctx context.Context, tenantId string, before time.Time) (bool, error)
 }
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type CreateMapItemsOpts struct {
+	// (required) the input of each element of the map step run, in order
+	Inputs [][]byte
+
+	// (optional) the maximum number of elements which run at the same time. Zero means no limit.
+	Concurrency int `validate:"min=0"`
+}
+
+type FinishMapItemResult struct {
+	// the id of the map step run which the element belongs to
+	MapStepRunId string
+
+	// (optional) the output of the map step run, set once all elements have succeeded
+	Output []byte
+
+	// the step runs of the elements which should be queued next
+	QueuedStepRuns []*dbsqlc.GetStepRunForEngineRow
+}
+
+type FailMapItemResult struct {
+	// the id of the map step run which the element belongs to
+	MapStepRunId string
+
+	// the index of the element which failed
+	Index int
+
+	// the ids of the step runs of elements which have not finished yet
+	UnfinishedStepRunIds []string
+}
+
+type MapEngineRepository interface {
+	// CreateMapItems creates a step run for each element of a map step run, replacing the elements of a previous
+	// attempt. It returns the step runs which should be queued right away.
+	CreateMapItems(ctx context.Context, tenantId, mapStepRunId string, opts *CreateMapItemsOpts) ([]*dbsqlc.GetStepRunForEngineRow, error)
+
+	// IsMapItem returns whether a step run is an element of a map step run.
+	IsMapItem(ctx context.Context, tenantId, stepRunId string) (bool, error)
+
+	// FinishMapItem stores the output of an element of a map step run and queues the next pending elements. It
+	// returns nil if the step run is not a queued element.
+	FinishMapItem(ctx context.Context, tenantId, stepRunId string, output []byte, concurrency int) (*FinishMapItemResult, error)
+
+	// FailMapItem marks an element of a map step run as failed. It returns nil if the step run is not a queued
+	// element.
+	FailMapItem(ctx context.Context, tenantId, stepRunId string) (*FailMapItemResult, error)
+}
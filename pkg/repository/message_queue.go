@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// MessageQueueNotification is sent to the listeners of the message queue when a message is added to a queue.
+type MessageQueueNotification struct {
+	QueueName string `json:"queue"`
+
+	MessageId int64 `json:"id"`
+}
+
+// MessageQueueRepository stores the messages of the internal message queue in Postgres.
+type MessageQueueRepository interface {
+	// AddMessage adds a message to a queue and notifies the listeners of the message queue. Messages with an expiry
+	// are dropped if they aren't read before they expire.
+	AddMessage(ctx context.Context, queueName string, payload []byte, expiresAt *time.Time) (int64, error)
+
+	// ReadMessages reads up to limit messages of a queue, and hides them from other readers for the visibility
+	// timeout. Messages which aren't acknowledged before the timeout are read again.
+	ReadMessages(ctx context.Context, queueName string, limit int, visibilityTimeout time.Duration) ([]*dbsqlc.MessageQueueItem, error)
+
+	// ListMessagesByIds lists messages which haven't expired, without hiding them from other readers.
+	ListMessagesByIds(ctx context.Context, ids []int64) ([]*dbsqlc.MessageQueueItem, error)
+
+	// AckMessage deletes a message which has been handled.
+	AckMessage(ctx context.Context, id int64) error
+
+	// NackMessage makes a message visible again after the delay.
+	NackMessage(ctx context.Context, id int64, delay time.Duration) error
+
+	// DeleteExpiredMessages deletes the messages which have expired, and returns how many were deleted.
+	DeleteExpiredMessages(ctx context.Context) (int64, error)
+
+	// Listen calls the handler with the notifications of the message queue until the context is canceled or the
+	// connection fails. The handler is called on the listening goroutine, so it must not block.
+	Listen(ctx context.Context, handler func(notification *MessageQueueNotification)) error
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type ListLeasesOpts struct {
+	// (optional) only list the leases of a tenant
+	TenantId *string `validate:"omitnil,uuid"`
+
+	// (optional) only list the leases of a kind
+	Kind *dbsqlc.LeaseKind `validate:"omitnil,oneof=QUEUE WORKER"`
+}
+
+type ListStuckStepRunsOpts struct {
+	// (required) only list step runs which weren't updated since then
+	UpdatedBefore time.Time `validate:"required"`
+
+	// (optional) only list step runs which are assigned to a worker
+	WorkerId *string `validate:"omitnil,uuid"`
+
+	// (optional) the maximum number of step runs to list, defaults to 1000
+	Limit *int `validate:"omitnil,min=1"`
+}
+
+type RequeuedStepRun struct {
+	StepRunId string
+
+	// WorkerId is the worker which the step run was assigned to, it's nil if the step run wasn't assigned
+	WorkerId *string
+}
+
+// EngineHealth is a snapshot of the engines of an instance, as seen from the database.
+type EngineHealth struct {
+	Partitions  []*dbsqlc.ListEnginePartitionsRow
+	Dispatchers []*dbsqlc.ListDispatchersWithWorkerCountsRow
+	Leases      []*dbsqlc.EngineLease
+}
+
+// OperationsRepository contains the operations of hatchet-admin which repair the state of an instance, which
+// otherwise require writing SQL against the database.
+type OperationsRepository interface {
+	// ListLeases returns the leases of the schedulers on queues and workers.
+	ListLeases(ctx context.Context, opts *ListLeasesOpts) ([]*dbsqlc.Lease, error)
+
+	// ReleaseLeases releases leases of the schedulers by id, so their queues and workers are picked up by the next
+	// scheduler which acquires leases. It returns the released leases.
+	ReleaseLeases(ctx context.Context, ids []int64) ([]*dbsqlc.Lease, error)
+
+	// ListEngineLeases returns the leases which are held by a single engine at a time, like partition maintenance.
+	ListEngineLeases(ctx context.Context) ([]*dbsqlc.EngineLease, error)
+
+	// ReleaseEngineLease releases an engine lease regardless of its holder. It returns false if the lease isn't held.
+	ReleaseEngineLease(ctx context.Context, name string) (bool, error)
+
+	// ListStuckStepRuns returns the step runs of a tenant which are pending assignment or assigned and weren't
+	// updated since opts.UpdatedBefore, oldest first.
+	ListStuckStepRuns(ctx context.Context, tenantId string, opts *ListStuckStepRunsOpts) ([]*dbsqlc.ListStuckStepRunsRow, error)
+
+	// RequeueStepRuns moves step runs which are pending assignment or assigned back to their queue, and releases
+	// their worker slots. Step runs which are in another status are skipped. It returns the requeued step runs.
+	RequeueStepRuns(ctx context.Context, tenantId string, stepRunIds []string) ([]*RequeuedStepRun, error)
+
+	// GetEngineHealth returns the partitions, dispatchers and leases of the engines.
+	GetEngineHealth(ctx context.Context) (*EngineHealth, error)
+}
@@ -2,6 +2,7 @@ package prisma
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -29,8 +30,18 @@ func NewAPITokenRepository(client *db.PrismaClient, v validator.Validator, cache
 	}
 }
 
+// the keys of the API tokens in the cache, which differ between the API and the engine repositories since they cache
+// different models of the tokens
+func apiTokenCacheKey(id string) string {
+	return "prisma-api-token-" + id
+}
+
+func engineTokenCacheKey(id string) string {
+	return "api-token-" + id
+}
+
 func (a *apiTokenRepository) GetAPITokenById(id string) (*db.APITokenModel, error) {
-	return cache.MakeCacheable[db.APITokenModel](a.cache, id, func() (*db.APITokenModel, error) {
+	return cache.MakeCacheable[db.APITokenModel](a.cache, apiTokenCacheKey(id), func() (*db.APITokenModel, error) {
 		return a.client.APIToken.FindUnique(
 			db.APIToken.ID.Equals(id),
 		).Exec(context.Background())
@@ -71,7 +82,15 @@ func (a *apiTokenRepository) RevokeAPIToken(id string) error {
 		db.APIToken.Revoked.Set(true),
 	).Exec(context.Background())
 
-	return err
+	if err != nil {
+		return err
+	}
+
+	// the token mustn't be accepted until the cached token expires
+	a.cache.Delete(apiTokenCacheKey(id))
+	a.cache.Delete(engineTokenCacheKey(id))
+
+	return nil
 }
 
 func (a *apiTokenRepository) ListAPITokensByTenant(tenantId string) ([]db.APITokenModel, error) {
@@ -121,11 +140,73 @@ func (a *engineTokenRepository) CreateAPIToken(ctx context.Context, opts *reposi
 		createParams.Name = sqlchelpers.TextFromStr(*opts.Name)
 	}
 
+	if opts.Scope != nil {
+		createParams.Scope = dbsqlc.NullAPITokenScope{
+			APITokenScope: dbsqlc.APITokenScope(*opts.Scope),
+			Valid:         true,
+		}
+	}
+
 	return a.queries.CreateAPIToken(ctx, a.pool, createParams)
 }
 
 func (a *engineTokenRepository) GetAPITokenById(ctx context.Context, id string) (*dbsqlc.APIToken, error) {
-	return cache.MakeCacheable[dbsqlc.APIToken](a.cache, id, func() (*dbsqlc.APIToken, error) {
+	return cache.MakeCacheable[dbsqlc.APIToken](a.cache, engineTokenCacheKey(id), func() (*dbsqlc.APIToken, error) {
 		return a.queries.GetAPITokenById(ctx, a.pool, sqlchelpers.UUIDFromStr(id))
 	})
 }
+
+func (a *engineTokenRepository) ListAPITokensByTenant(ctx context.Context, tenantId string) ([]*dbsqlc.APIToken, error) {
+	return a.queries.ListAPITokensByTenant(ctx, a.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (a *engineTokenRepository) RotateAPIToken(ctx context.Context, id string, opts *repository.RotateAPITokenOpts) (*dbsqlc.APIToken, error) {
+	if err := a.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, a.pool, a.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	// the old token is marked as rotated first, which locks it, so concurrent rotations of the same token can't
+	// issue more than one new token
+	rotated, err := a.queries.RotateAPIToken(ctx, tx, dbsqlc.RotateAPITokenParams{
+		ID:        sqlchelpers.UUIDFromStr(id),
+		Expiresat: sqlchelpers.TimestampFromTime(opts.ExpiresAt),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not rotate API token: %w", err)
+	}
+
+	token, err := a.queries.CreateAPIToken(ctx, tx, dbsqlc.CreateAPITokenParams{
+		ID:        sqlchelpers.UUIDFromStr(opts.NewID),
+		Expiresat: sqlchelpers.TimestampFromTime(opts.NewExpiresAt),
+		TenantId:  rotated.TenantId,
+		Name:      rotated.Name,
+		Internal:  sqlchelpers.BoolFromBoolean(rotated.Internal),
+		Scope: dbsqlc.NullAPITokenScope{
+			APITokenScope: rotated.Scope,
+			Valid:         true,
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create API token: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	// the previous expiry of the token mustn't be used until the cached token expires
+	a.cache.Delete(apiTokenCacheKey(id))
+	a.cache.Delete(engineTokenCacheKey(id))
+
+	return token, nil
+}
@@ -0,0 +1,193 @@
+package prisma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type approvalRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewApprovalRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.ApprovalRepository {
+	queries := dbsqlc.New()
+
+	return &approvalRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *approvalRepository) CreateApproval(ctx context.Context, tenantId, workflowRunId, stepRunId string) (*dbsqlc.StepRunApproval, error) {
+	token, err := random.Generate(48)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not generate approval token: %w", err)
+	}
+
+	approval, err := r.queries.CreateStepRunApproval(ctx, r.pool, dbsqlc.CreateStepRunApprovalParams{
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Steprunid:     sqlchelpers.UUIDFromStr(stepRunId),
+		Workflowrunid: sqlchelpers.UUIDFromStr(workflowRunId),
+		Token:         token,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create approval: %w", err)
+	}
+
+	return approval, nil
+}
+
+func (r *approvalRepository) ListApprovals(ctx context.Context, tenantId string, opts *repository.ListApprovalsOpts) (*repository.ListApprovalsResult, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	queryParams := dbsqlc.ListStepRunApprovalsParams{
+		Tenantid: pgTenantId,
+	}
+
+	countParams := dbsqlc.CountStepRunApprovalsParams{
+		Tenantid: pgTenantId,
+	}
+
+	if len(opts.Statuses) > 0 {
+		statuses := make([]string, len(opts.Statuses))
+
+		for i, status := range opts.Statuses {
+			statuses[i] = string(status)
+		}
+
+		queryParams.Statuses = statuses
+		countParams.Statuses = statuses
+	}
+
+	if opts.Offset != nil {
+		queryParams.Offset = *opts.Offset
+	}
+
+	if opts.Limit != nil {
+		queryParams.Limit = *opts.Limit
+	}
+
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer sqlchelpers.DeferRollback(ctx, r.l, tx.Rollback)
+
+	approvals, err := r.queries.ListStepRunApprovals(ctx, tx, queryParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list approvals: %w", err)
+	}
+
+	count, err := r.queries.CountStepRunApprovals(ctx, tx, countParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not count approvals: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.ListApprovalsResult{
+		Rows:  approvals,
+		Count: int(count),
+	}, nil
+}
+
+func (r *approvalRepository) GetApprovalById(ctx context.Context, id string) (*dbsqlc.StepRunApproval, error) {
+	return r.queries.GetStepRunApprovalById(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
+
+func (r *approvalRepository) GetApprovalByToken(ctx context.Context, token string) (*dbsqlc.StepRunApproval, error) {
+	return r.queries.GetStepRunApprovalByToken(ctx, r.pool, token)
+}
+
+func (r *approvalRepository) DecideApproval(ctx context.Context, tenantId, approvalId string, opts *repository.DecideApprovalOpts) (*dbsqlc.StepRunApproval, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	status := dbsqlc.StepRunApprovalStatusREJECTED
+
+	if opts.Approved {
+		status = dbsqlc.StepRunApprovalStatusAPPROVED
+	}
+
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer sqlchelpers.DeferRollback(ctx, r.l, tx.Rollback)
+
+	approval, err := r.queries.DecideStepRunApproval(ctx, tx, dbsqlc.DecideStepRunApprovalParams{
+		Status:   status,
+		Payload:  opts.Payload,
+		ID:       sqlchelpers.UUIDFromStr(approvalId),
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrApprovalNotPending
+		}
+
+		return nil, fmt.Errorf("could not decide approval: %w", err)
+	}
+
+	err = r.queries.DeleteStepRunTimers(ctx, tx, []pgtype.UUID{approval.StepRunId})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not delete step run timer: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return approval, nil
+}
+
+func (r *approvalRepository) TimeOutApproval(ctx context.Context, tenantId, stepRunId string) (bool, error) {
+	updated, err := r.queries.TimeOutStepRunApproval(ctx, r.pool, dbsqlc.TimeOutStepRunApprovalParams{
+		Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not time out approval: %w", err)
+	}
+
+	return updated > 0, nil
+}
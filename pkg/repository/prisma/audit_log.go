@@ -0,0 +1,157 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type auditLogRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewAuditLogRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.AuditLogRepository {
+	queries := dbsqlc.New()
+
+	return &auditLogRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *auditLogRepository) CreateAuditLog(ctx context.Context, tenantId string, opts *repository.CreateAuditLogOpts) (*dbsqlc.AuditLog, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.CreateAuditLogParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Actorkind:  opts.ActorKind,
+		Actorid:    sqlchelpers.UUIDFromStr(opts.ActorId),
+		Operation:  opts.Operation,
+		Method:     opts.Method,
+		Path:       opts.Path,
+		Statuscode: opts.StatusCode,
+	}
+
+	if opts.ActorName != nil {
+		params.ActorName = sqlchelpers.TextFromStr(*opts.ActorName)
+	}
+
+	if opts.ResourceType != nil {
+		params.ResourceType = sqlchelpers.TextFromStr(*opts.ResourceType)
+	}
+
+	if opts.ResourceId != nil {
+		params.ResourceId = sqlchelpers.TextFromStr(*opts.ResourceId)
+	}
+
+	if opts.IpAddress != nil {
+		params.IpAddress = sqlchelpers.TextFromStr(*opts.IpAddress)
+	}
+
+	if opts.UserAgent != nil {
+		params.UserAgent = sqlchelpers.TextFromStr(*opts.UserAgent)
+	}
+
+	entry, err := r.queries.CreateAuditLog(ctx, r.pool, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create audit log: %w", err)
+	}
+
+	return entry, nil
+}
+
+func (r *auditLogRepository) ListAuditLogs(ctx context.Context, tenantId string, opts *repository.ListAuditLogsOpts) (*repository.ListAuditLogsResult, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	countParams := dbsqlc.CountAuditLogsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	}
+
+	if opts.ActorId != nil {
+		countParams.ActorId = sqlchelpers.UUIDFromStr(*opts.ActorId)
+	}
+
+	if opts.Operation != nil {
+		countParams.Operation = sqlchelpers.TextFromStr(*opts.Operation)
+	}
+
+	if opts.ResourceType != nil {
+		countParams.ResourceType = sqlchelpers.TextFromStr(*opts.ResourceType)
+	}
+
+	if opts.ResourceId != nil {
+		countParams.ResourceId = sqlchelpers.TextFromStr(*opts.ResourceId)
+	}
+
+	if opts.Since != nil {
+		countParams.Since = sqlchelpers.TimestampFromTime(opts.Since.UTC())
+	}
+
+	if opts.Until != nil {
+		countParams.Until = sqlchelpers.TimestampFromTime(opts.Until.UTC())
+	}
+
+	listParams := dbsqlc.ListAuditLogsParams{
+		Tenantid:     countParams.Tenantid,
+		ActorId:      countParams.ActorId,
+		Operation:    countParams.Operation,
+		ResourceType: countParams.ResourceType,
+		ResourceId:   countParams.ResourceId,
+		Since:        countParams.Since,
+		Until:        countParams.Until,
+	}
+
+	if opts.Offset != nil {
+		listParams.Offset = *opts.Offset
+	}
+
+	if opts.Limit != nil {
+		listParams.Limit = *opts.Limit
+	}
+
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer sqlchelpers.DeferRollback(ctx, r.l, tx.Rollback)
+
+	rows, err := r.queries.ListAuditLogs(ctx, tx, listParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list audit logs: %w", err)
+	}
+
+	count, err := r.queries.CountAuditLogs(ctx, tx, countParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not count audit logs: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return &repository.ListAuditLogsResult{
+		Rows:  rows,
+		Count: int(count),
+	}, nil
+}
@@ -0,0 +1,137 @@
+package prisma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+// clientCAsCacheKey is the cache key of the unexpired client CAs of all tenants
+const clientCAsCacheKey = "client-cas"
+
+type clientCARepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+	cache   cache.Cacheable
+}
+
+func NewClientCARepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, cache cache.Cacheable) repository.ClientCARepository {
+	queries := dbsqlc.New()
+
+	return &clientCARepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+		cache:   cache,
+	}
+}
+
+func (r *clientCARepository) CreateClientCA(ctx context.Context, tenantId string, opts *repository.CreateClientCAOpts) (*dbsqlc.TenantClientCA, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer sqlchelpers.DeferRollback(ctx, r.l, tx.Rollback)
+
+	// the overlap check only sees committed CAs, so concurrent creations are serialized
+	if err := r.queries.LockTenantClientCAs(ctx, tx); err != nil {
+		return nil, fmt.Errorf("could not lock client CAs: %w", err)
+	}
+
+	ca, err := r.queries.CreateTenantClientCA(ctx, tx, dbsqlc.CreateTenantClientCAParams{
+		Tenantid:       sqlchelpers.UUIDFromStr(tenantId),
+		Name:           opts.Name,
+		Certificate:    opts.Certificate,
+		Spiffeidprefix: opts.SpiffeIdPrefix,
+		Expiresat:      sqlchelpers.TimestampFromTime(opts.ExpiresAt),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrSpiffeIdPrefixTaken
+		}
+
+		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == "23505" {
+			return nil, repository.ErrDuplicateKey
+		}
+
+		return nil, fmt.Errorf("could not create client CA: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("could not commit client CA: %w", err)
+	}
+
+	r.cache.Delete(clientCAsCacheKey)
+
+	return ca, nil
+}
+
+func (r *clientCARepository) ListClientCAs(ctx context.Context, tenantId string) ([]*dbsqlc.TenantClientCA, error) {
+	return r.queries.ListTenantClientCAs(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *clientCARepository) GetClientCAById(ctx context.Context, id string) (*dbsqlc.TenantClientCA, error) {
+	return r.queries.GetTenantClientCAById(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
+
+func (r *clientCARepository) ListClientCAsForSpiffeId(ctx context.Context, spiffeId string) ([]*dbsqlc.TenantClientCA, error) {
+	// the CAs of all tenants are cached under a single key, since the SPIFFE IDs are chosen by the clients and can't
+	// be used as cache keys
+	cas, err := cache.MakeCacheable(r.cache, clientCAsCacheKey, func() (*[]*dbsqlc.TenantClientCA, error) {
+		cas, err := r.queries.ListUnexpiredClientCAs(ctx, r.pool)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not list client CAs: %w", err)
+		}
+
+		return &cas, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	res := make([]*dbsqlc.TenantClientCA, 0)
+
+	for _, ca := range *cas {
+		if strings.HasPrefix(spiffeId, ca.SpiffeIdPrefix) && ca.ExpiresAt.Time.After(now) {
+			res = append(res, ca)
+		}
+	}
+
+	return res, nil
+}
+
+func (r *clientCARepository) DeleteClientCA(ctx context.Context, id string) error {
+	if err := r.queries.DeleteTenantClientCA(ctx, r.pool, sqlchelpers.UUIDFromStr(id)); err != nil {
+		return err
+	}
+
+	r.cache.Delete(clientCAsCacheKey)
+
+	return nil
+}
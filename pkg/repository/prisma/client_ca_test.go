@@ -0,0 +1,78 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+func TestCreateClientCAOverlappingPrefixes(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+
+		suffix, err := random.Generate(8)
+		require.NoError(t, err)
+
+		// the tenants create a CA with the same prefix, so only one of the concurrent creations can succeed
+		prefix := fmt.Sprintf("spiffe://%s.example.org/", suffix)
+
+		tenantIds := make([]string, 8)
+
+		for i := range tenantIds {
+			tenantIds[i] = createTestTenant(t, conf)
+		}
+
+		errs := make([]error, len(tenantIds))
+
+		var wg sync.WaitGroup
+
+		for i, tenantId := range tenantIds {
+			wg.Add(1)
+
+			go func(i int, tenantId string) {
+				defer wg.Done()
+
+				_, errs[i] = conf.EngineRepository.ClientCA().CreateClientCA(ctx, tenantId, &repository.CreateClientCAOpts{
+					Name:           "workers",
+					Certificate:    "certificate",
+					SpiffeIdPrefix: prefix + "workers/",
+					ExpiresAt:      time.Now().Add(time.Hour),
+				})
+			}(i, tenantId)
+		}
+
+		wg.Wait()
+
+		created := 0
+
+		for _, err := range errs {
+			if err == nil {
+				created++
+				continue
+			}
+
+			assert.True(t, errors.Is(err, repository.ErrSpiffeIdPrefixTaken), "unexpected error: %v", err)
+		}
+
+		assert.Equal(t, 1, created)
+
+		cas, err := conf.EngineRepository.ClientCA().ListClientCAsForSpiffeId(ctx, prefix+"workers/a")
+		require.NoError(t, err)
+		assert.Len(t, cas, 1)
+
+		return nil
+	})
+}
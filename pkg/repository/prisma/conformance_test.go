@@ -0,0 +1,23 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"testing"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository/repositorytest"
+)
+
+func TestConformance(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		repositorytest.RunConformance(t, repositorytest.Backend{
+			API:       conf.APIRepository,
+			Engine:    conf.EngineRepository,
+			Scheduler: conf.SchedulerRepository,
+		})
+
+		return nil
+	})
+}
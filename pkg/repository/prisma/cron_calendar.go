@@ -0,0 +1,87 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type cronCalendarRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewCronCalendarRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.CronCalendarRepository {
+	queries := dbsqlc.New()
+
+	return &cronCalendarRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *cronCalendarRepository) CreateCalendar(ctx context.Context, tenantId string, opts *repository.CreateCronExclusionCalendarOpts) (*dbsqlc.CronExclusionCalendar, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	dates := make([]pgtype.Date, len(opts.Dates))
+
+	for i, date := range opts.Dates {
+		dates[i] = pgtype.Date{
+			Time:  date,
+			Valid: true,
+		}
+	}
+
+	params := dbsqlc.CreateCronExclusionCalendarParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Name:     opts.Name,
+		Dates:    dates,
+	}
+
+	if opts.ICalURL != nil {
+		params.IcalUrl = sqlchelpers.TextFromStr(*opts.ICalURL)
+	}
+
+	calendar, err := r.queries.CreateCronExclusionCalendar(ctx, r.pool, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create cron exclusion calendar: %w", err)
+	}
+
+	return calendar, nil
+}
+
+func (r *cronCalendarRepository) ListCalendars(ctx context.Context, tenantId string) ([]*dbsqlc.CronExclusionCalendar, error) {
+	return r.queries.ListCronExclusionCalendars(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *cronCalendarRepository) GetCalendarById(ctx context.Context, id string) (*dbsqlc.CronExclusionCalendar, error) {
+	return r.queries.GetCronExclusionCalendarById(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
+
+func (r *cronCalendarRepository) GetCalendarByName(ctx context.Context, tenantId, name string) (*dbsqlc.CronExclusionCalendar, error) {
+	return r.queries.GetCronExclusionCalendarByName(ctx, r.pool, dbsqlc.GetCronExclusionCalendarByNameParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Name:     name,
+	})
+}
+
+func (r *cronCalendarRepository) DeleteCalendar(ctx context.Context, id string) error {
+	_, err := r.queries.DeleteCronExclusionCalendar(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+
+	return err
+}
@@ -0,0 +1,118 @@
+package prisma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type dataKeyRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewDataKeyRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.DataKeyRepository {
+	queries := dbsqlc.New()
+
+	return &dataKeyRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *dataKeyRepository) GetActiveDataKey(ctx context.Context, tenantId string) (string, []byte, error) {
+	key, err := r.queries.GetActiveTenantDataKey(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil, nil
+		}
+
+		return "", nil, fmt.Errorf("could not get active data key: %w", err)
+	}
+
+	return sqlchelpers.UUIDToStr(key.ID), key.EncryptedKeyset, nil
+}
+
+func (r *dataKeyRepository) GetDataKey(ctx context.Context, tenantId, keyId string) ([]byte, error) {
+	key, err := r.queries.GetTenantDataKey(ctx, r.pool, dbsqlc.GetTenantDataKeyParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		ID:       sqlchelpers.UUIDFromStr(keyId),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get data key: %w", err)
+	}
+
+	return key.EncryptedKeyset, nil
+}
+
+func (r *dataKeyRepository) CreateDataKey(ctx context.Context, tenantId, keyId string, encryptedKeyset []byte) (string, []byte, error) {
+	key, err := r.queries.CreateTenantDataKeyIfNotExists(ctx, r.pool, dbsqlc.CreateTenantDataKeyIfNotExistsParams{
+		ID:              sqlchelpers.UUIDFromStr(keyId),
+		Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+		Encryptedkeyset: encryptedKeyset,
+	})
+
+	if err == nil {
+		return sqlchelpers.UUIDToStr(key.ID), key.EncryptedKeyset, nil
+	}
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return "", nil, fmt.Errorf("could not create data key: %w", err)
+	}
+
+	// the active data key was created concurrently
+	activeKeyId, activeEncryptedKeyset, err := r.GetActiveDataKey(ctx, tenantId)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if activeKeyId == "" {
+		return "", nil, fmt.Errorf("could not create data key: no active data key")
+	}
+
+	return activeKeyId, activeEncryptedKeyset, nil
+}
+
+func (r *dataKeyRepository) RotateDataKey(ctx context.Context, tenantId, keyId string, encryptedKeyset []byte) error {
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	defer sqlchelpers.DeferRollback(ctx, r.l, tx.Rollback)
+
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	if err := r.queries.DeactivateTenantDataKeys(ctx, tx, pgTenantId); err != nil {
+		return fmt.Errorf("could not deactivate data keys: %w", err)
+	}
+
+	_, err = r.queries.CreateTenantDataKeyIfNotExists(ctx, tx, dbsqlc.CreateTenantDataKeyIfNotExistsParams{
+		ID:              sqlchelpers.UUIDFromStr(keyId),
+		Tenantid:        pgTenantId,
+		Encryptedkeyset: encryptedKeyset,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not create data key: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
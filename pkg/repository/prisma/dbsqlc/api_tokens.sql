@@ -14,7 +14,8 @@ INSERT INTO "APIToken" (
     "tenantId",
     "name",
     "expiresAt",
-    "internal"
+    "internal",
+    "scope"
 ) VALUES (
     coalesce(@id::uuid, gen_random_uuid()),
     CURRENT_TIMESTAMP,
@@ -22,5 +23,33 @@ INSERT INTO "APIToken" (
     sqlc.narg('tenantId')::uuid,
     sqlc.narg('name')::text,
     @expiresAt::timestamp,
-    COALESCE(sqlc.narg('internal')::boolean, FALSE)
+    COALESCE(sqlc.narg('internal')::boolean, FALSE),
+    COALESCE(sqlc.narg('scope')::"APITokenScope", 'ADMIN')
 ) RETURNING *;
+
+-- name: ListAPITokensByTenant :many
+SELECT
+    *
+FROM
+    "APIToken"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "revoked" = false
+    AND "internal" = false
+ORDER BY
+    "createdAt" ASC;
+
+-- name: RotateAPIToken :one
+-- Marks the token as rotated and shortens its expiry to the end of the grace period of the rotation. Tokens
+-- which were already rotated or revoked are not returned.
+UPDATE
+    "APIToken"
+SET
+    "rotatedAt" = CURRENT_TIMESTAMP,
+    "expiresAt" = LEAST("expiresAt", @expiresAt::timestamp),
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @id::uuid
+    AND "revoked" = false
+    AND "rotatedAt" IS NULL
+RETURNING *;
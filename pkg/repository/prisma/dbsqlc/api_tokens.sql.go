@@ -19,7 +19,8 @@ INSERT INTO "APIToken" (
     "tenantId",
     "name",
     "expiresAt",
-    "internal"
+    "internal",
+    "scope"
 ) VALUES (
     coalesce($1::uuid, gen_random_uuid()),
     CURRENT_TIMESTAMP,
@@ -27,16 +28,18 @@ INSERT INTO "APIToken" (
     $2::uuid,
     $3::text,
     $4::timestamp,
-    COALESCE($5::boolean, FALSE)
-) RETURNING id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal
+    COALESCE($5::boolean, FALSE),
+    COALESCE($6::"APITokenScope", 'ADMIN')
+) RETURNING id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal, scope, "rotatedAt"
 `
 
 type CreateAPITokenParams struct {
-	ID        pgtype.UUID      `json:"id"`
-	TenantId  pgtype.UUID      `json:"tenantId"`
-	Name      pgtype.Text      `json:"name"`
-	Expiresat pgtype.Timestamp `json:"expiresat"`
-	Internal  pgtype.Bool      `json:"internal"`
+	ID        pgtype.UUID       `json:"id"`
+	TenantId  pgtype.UUID       `json:"tenantId"`
+	Name      pgtype.Text       `json:"name"`
+	Expiresat pgtype.Timestamp  `json:"expiresat"`
+	Internal  pgtype.Bool       `json:"internal"`
+	Scope     NullAPITokenScope `json:"scope"`
 }
 
 func (q *Queries) CreateAPIToken(ctx context.Context, db DBTX, arg CreateAPITokenParams) (*APIToken, error) {
@@ -46,6 +49,7 @@ func (q *Queries) CreateAPIToken(ctx context.Context, db DBTX, arg CreateAPIToke
 		arg.Name,
 		arg.Expiresat,
 		arg.Internal,
+		arg.Scope,
 	)
 	var i APIToken
 	err := row.Scan(
@@ -58,13 +62,15 @@ func (q *Queries) CreateAPIToken(ctx context.Context, db DBTX, arg CreateAPIToke
 		&i.TenantId,
 		&i.NextAlertAt,
 		&i.Internal,
+		&i.Scope,
+		&i.RotatedAt,
 	)
 	return &i, err
 }
 
 const getAPITokenById = `-- name: GetAPITokenById :one
 SELECT
-    id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal
+    id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal, scope, "rotatedAt"
 FROM
     "APIToken"
 WHERE
@@ -84,6 +90,93 @@ func (q *Queries) GetAPITokenById(ctx context.Context, db DBTX, id pgtype.UUID)
 		&i.TenantId,
 		&i.NextAlertAt,
 		&i.Internal,
+		&i.Scope,
+		&i.RotatedAt,
+	)
+	return &i, err
+}
+
+const listAPITokensByTenant = `-- name: ListAPITokensByTenant :many
+SELECT
+    id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal, scope, "rotatedAt"
+FROM
+    "APIToken"
+WHERE
+    "tenantId" = $1::uuid
+    AND "revoked" = false
+    AND "internal" = false
+ORDER BY
+    "createdAt" ASC
+`
+
+func (q *Queries) ListAPITokensByTenant(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*APIToken, error) {
+	rows, err := db.Query(ctx, listAPITokensByTenant, tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*APIToken
+	for rows.Next() {
+		var i APIToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ExpiresAt,
+			&i.Revoked,
+			&i.Name,
+			&i.TenantId,
+			&i.NextAlertAt,
+			&i.Internal,
+			&i.Scope,
+			&i.RotatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const rotateAPIToken = `-- name: RotateAPIToken :one
+UPDATE
+    "APIToken"
+SET
+    "rotatedAt" = CURRENT_TIMESTAMP,
+    "expiresAt" = LEAST("expiresAt", $1::timestamp),
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = $2::uuid
+    AND "revoked" = false
+    AND "rotatedAt" IS NULL
+RETURNING id, "createdAt", "updatedAt", "expiresAt", revoked, name, "tenantId", "nextAlertAt", internal, scope, "rotatedAt"
+`
+
+type RotateAPITokenParams struct {
+	Expiresat pgtype.Timestamp `json:"expiresat"`
+	ID        pgtype.UUID      `json:"id"`
+}
+
+// Marks the token as rotated and shortens its expiry to the end of the grace period of the rotation. Tokens
+// which were already rotated or revoked are not returned.
+func (q *Queries) RotateAPIToken(ctx context.Context, db DBTX, arg RotateAPITokenParams) (*APIToken, error) {
+	row := db.QueryRow(ctx, rotateAPIToken, arg.Expiresat, arg.ID)
+	var i APIToken
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExpiresAt,
+		&i.Revoked,
+		&i.Name,
+		&i.TenantId,
+		&i.NextAlertAt,
+		&i.Internal,
+		&i.Scope,
+		&i.RotatedAt,
 	)
 	return &i, err
 }
@@ -0,0 +1,95 @@
+-- name: CreateStepRunApproval :one
+-- Creates the pending approval of a step run. If the step run already has an approval (for example, when it is
+-- retried or replayed), the approval is reset with a new token.
+INSERT INTO "StepRunApproval" (
+    "tenantId",
+    "stepRunId",
+    "workflowRunId",
+    "token"
+) VALUES (
+    @tenantId::uuid,
+    @stepRunId::uuid,
+    @workflowRunId::uuid,
+    @token::text
+)
+ON CONFLICT ("stepRunId") DO UPDATE
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "token" = EXCLUDED."token",
+    "status" = 'PENDING',
+    "payload" = NULL,
+    "decidedAt" = NULL
+RETURNING *;
+
+-- name: GetStepRunApprovalById :one
+SELECT
+    *
+FROM
+    "StepRunApproval"
+WHERE
+    "id" = @id::uuid;
+
+-- name: GetStepRunApprovalByToken :one
+SELECT
+    *
+FROM
+    "StepRunApproval"
+WHERE
+    "token" = @token::text;
+
+-- name: ListStepRunApprovals :many
+SELECT
+    *
+FROM
+    "StepRunApproval"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND (
+        sqlc.narg('statuses')::text[] IS NULL OR
+        "status" = ANY(cast(sqlc.narg('statuses')::text[] as "StepRunApprovalStatus"[]))
+    )
+ORDER BY
+    "createdAt" DESC, "id" DESC
+OFFSET
+    COALESCE(sqlc.narg('offset'), 0)
+LIMIT
+    COALESCE(sqlc.narg('limit'), 50);
+
+-- name: CountStepRunApprovals :one
+SELECT
+    COUNT(*) AS total
+FROM
+    "StepRunApproval"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND (
+        sqlc.narg('statuses')::text[] IS NULL OR
+        "status" = ANY(cast(sqlc.narg('statuses')::text[] as "StepRunApprovalStatus"[]))
+    );
+
+-- name: DecideStepRunApproval :one
+-- Approves or rejects a pending approval. Returns no rows if the approval was already decided or timed out.
+UPDATE
+    "StepRunApproval"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "status" = @status::"StepRunApprovalStatus",
+    "payload" = sqlc.narg('payload')::jsonb,
+    "decidedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @id::uuid
+    AND "tenantId" = @tenantId::uuid
+    AND "status" = 'PENDING'
+RETURNING *;
+
+-- name: TimeOutStepRunApproval :execrows
+UPDATE
+    "StepRunApproval"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "status" = 'TIMED_OUT',
+    "decidedAt" = CURRENT_TIMESTAMP
+WHERE
+    "stepRunId" = @stepRunId::uuid
+    AND "tenantId" = @tenantId::uuid
+    AND "status" = 'PENDING';
@@ -0,0 +1,63 @@
+-- name: CreateAuditLog :one
+INSERT INTO "AuditLog" (
+    "tenantId",
+    "actorKind",
+    "actorId",
+    "actorName",
+    "operation",
+    "method",
+    "path",
+    "resourceType",
+    "resourceId",
+    "statusCode",
+    "ipAddress",
+    "userAgent"
+) VALUES (
+    @tenantId::uuid,
+    @actorKind::"AuditLogActorKind",
+    @actorId::uuid,
+    sqlc.narg('actorName')::text,
+    @operation::text,
+    @method::text,
+    @path::text,
+    sqlc.narg('resourceType')::text,
+    sqlc.narg('resourceId')::text,
+    @statusCode::integer,
+    sqlc.narg('ipAddress')::text,
+    sqlc.narg('userAgent')::text
+)
+RETURNING *;
+
+-- name: CountAuditLogs :one
+SELECT
+    count(*) AS total
+FROM
+    "AuditLog"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND (sqlc.narg('actorId')::uuid IS NULL OR "actorId" = sqlc.narg('actorId')::uuid)
+    AND (sqlc.narg('operation')::text IS NULL OR "operation" = sqlc.narg('operation')::text)
+    AND (sqlc.narg('resourceType')::text IS NULL OR "resourceType" = sqlc.narg('resourceType')::text)
+    AND (sqlc.narg('resourceId')::text IS NULL OR "resourceId" = sqlc.narg('resourceId')::text)
+    AND (sqlc.narg('since')::timestamp IS NULL OR "createdAt" >= sqlc.narg('since')::timestamp)
+    AND (sqlc.narg('until')::timestamp IS NULL OR "createdAt" < sqlc.narg('until')::timestamp);
+
+-- name: ListAuditLogs :many
+SELECT
+    *
+FROM
+    "AuditLog"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND (sqlc.narg('actorId')::uuid IS NULL OR "actorId" = sqlc.narg('actorId')::uuid)
+    AND (sqlc.narg('operation')::text IS NULL OR "operation" = sqlc.narg('operation')::text)
+    AND (sqlc.narg('resourceType')::text IS NULL OR "resourceType" = sqlc.narg('resourceType')::text)
+    AND (sqlc.narg('resourceId')::text IS NULL OR "resourceId" = sqlc.narg('resourceId')::text)
+    AND (sqlc.narg('since')::timestamp IS NULL OR "createdAt" >= sqlc.narg('since')::timestamp)
+    AND (sqlc.narg('until')::timestamp IS NULL OR "createdAt" < sqlc.narg('until')::timestamp)
+ORDER BY
+    "createdAt" DESC, "id" DESC
+OFFSET
+    COALESCE(sqlc.narg('offset'), 0)
+LIMIT
+    COALESCE(sqlc.narg('limit'), 50);
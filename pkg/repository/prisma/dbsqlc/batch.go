@@ -19,7 +19,7 @@ var (
 
 const listQueueItems = `-- name: ListQueueItems :batchmany
 SELECT
-    id, "stepRunId", "stepId", "actionId", "scheduleTimeoutAt", "stepTimeout", priority, "isQueued", "tenantId", queue, sticky, "desiredWorkerId"
+    id, "stepRunId", "stepId", "actionId", "scheduleTimeoutAt", "stepTimeout", priority, "isQueued", "tenantId", queue, sticky, "desiredWorkerId", deadline, "createdAt"
 FROM
     "QueueItem" qi
 WHERE
@@ -99,6 +99,8 @@ func (b *ListQueueItemsBatchResults) Query(f func(int, []*QueueItem, error)) {
 					&i.Queue,
 					&i.Sticky,
 					&i.DesiredWorkerId,
+					&i.Deadline,
+					&i.CreatedAt,
 				); err != nil {
 					return err
 				}
@@ -116,3 +118,75 @@ func (b *ListQueueItemsBatchResults) Close() error {
 	b.closed = true
 	return b.br.Close()
 }
+
+const updateWorkerHeartbeats = `-- name: UpdateWorkerHeartbeats :batchexec
+UPDATE
+    "Worker"
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "lastHeartbeatAt" = $1::timestamp,
+    "cpuUtilization" = CASE WHEN $2::boolean THEN $3::float8 ELSE "cpuUtilization" END,
+    "memoryUtilization" = CASE WHEN $2::boolean THEN $4::float8 ELSE "memoryUtilization" END,
+    "activeSlots" = CASE WHEN $2::boolean THEN $5::int ELSE "activeSlots" END,
+    "gauges" = CASE WHEN $2::boolean THEN $6::jsonb ELSE "gauges" END,
+    "lastHealthReportAt" = CASE WHEN $2::boolean THEN $1::timestamp ELSE "lastHealthReportAt" END
+WHERE
+    "id" = $7::uuid
+`
+
+type UpdateWorkerHeartbeatsBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type UpdateWorkerHeartbeatsParams struct {
+	LastHeartbeatAt   pgtype.Timestamp `json:"lastHeartbeatAt"`
+	Hashealth         bool             `json:"hashealth"`
+	CpuUtilization    pgtype.Float8    `json:"cpuUtilization"`
+	MemoryUtilization pgtype.Float8    `json:"memoryUtilization"`
+	ActiveSlots       pgtype.Int4      `json:"activeSlots"`
+	Gauges            []byte           `json:"gauges"`
+	ID                pgtype.UUID      `json:"id"`
+}
+
+// The health columns are only written when the heartbeat carries a health report, so they keep the last report of
+// workers which don't send one with every heartbeat.
+func (q *Queries) UpdateWorkerHeartbeats(ctx context.Context, db DBTX, arg []UpdateWorkerHeartbeatsParams) *UpdateWorkerHeartbeatsBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.LastHeartbeatAt,
+			a.Hashealth,
+			a.CpuUtilization,
+			a.MemoryUtilization,
+			a.ActiveSlots,
+			a.Gauges,
+			a.ID,
+		}
+		batch.Queue(updateWorkerHeartbeats, vals...)
+	}
+	br := db.SendBatch(ctx, batch)
+	return &UpdateWorkerHeartbeatsBatchResults{br, len(arg), false}
+}
+
+func (b *UpdateWorkerHeartbeatsBatchResults) Exec(f func(int, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		_, err := b.br.Exec()
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+
+func (b *UpdateWorkerHeartbeatsBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
@@ -0,0 +1,68 @@
+-- name: LockTenantClientCAs :exec
+-- Serializes the creation of client CAs until the end of the transaction, as the overlap check of
+-- CreateTenantClientCA can't see the CAs which are created by concurrent transactions.
+SELECT pg_advisory_xact_lock(hashtext('tenant-client-cas'));
+
+-- name: CreateTenantClientCA :one
+-- Creates a client CA of a tenant. Returns no rows if the SPIFFE ID prefix overlaps with a prefix of a CA of
+-- another tenant, so that a SPIFFE ID always maps to a single tenant. It must run after LockTenantClientCAs in the
+-- same transaction.
+INSERT INTO "TenantClientCA" (
+    "tenantId",
+    "name",
+    "certificate",
+    "spiffeIdPrefix",
+    "expiresAt"
+)
+SELECT
+    @tenantId::uuid,
+    @name::text,
+    @certificate::text,
+    @spiffeIdPrefix::text,
+    @expiresAt::timestamp
+WHERE NOT EXISTS (
+    SELECT
+        1
+    FROM
+        "TenantClientCA"
+    WHERE
+        "tenantId" != @tenantId::uuid
+        AND (
+            starts_with(@spiffeIdPrefix::text, "spiffeIdPrefix")
+            OR starts_with("spiffeIdPrefix", @spiffeIdPrefix::text)
+        )
+)
+RETURNING *;
+
+-- name: GetTenantClientCAById :one
+SELECT
+    *
+FROM
+    "TenantClientCA"
+WHERE
+    "id" = @id::uuid;
+
+-- name: ListTenantClientCAs :many
+SELECT
+    *
+FROM
+    "TenantClientCA"
+WHERE
+    "tenantId" = @tenantId::uuid
+ORDER BY
+    "name" ASC;
+
+-- name: ListUnexpiredClientCAs :many
+-- Lists the unexpired client CAs of all tenants, which are matched against the SPIFFE IDs of client certificates.
+SELECT
+    *
+FROM
+    "TenantClientCA"
+WHERE
+    "expiresAt" > CURRENT_TIMESTAMP;
+
+-- name: DeleteTenantClientCA :exec
+DELETE FROM
+    "TenantClientCA"
+WHERE
+    "id" = @id::uuid;
@@ -9,6 +9,42 @@ import (
 	"context"
 )
 
+// iteratorForCreateDelayedEvents implements pgx.CopyFromSource.
+type iteratorForCreateDelayedEvents struct {
+	rows                 []CreateDelayedEventsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateDelayedEvents) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateDelayedEvents) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].EventId,
+		r.rows[0].TenantId,
+		r.rows[0].DeliverAfter,
+		r.rows[0].IdempotencyKey,
+		r.rows[0].RoutedWorkflowIds,
+	}, nil
+}
+
+func (r iteratorForCreateDelayedEvents) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateDelayedEvents(ctx context.Context, db DBTX, arg []CreateDelayedEventsParams) (int64, error) {
+	return db.CopyFrom(ctx, []string{"DelayedEvent"}, []string{"eventId", "tenantId", "deliverAfter", "idempotencyKey", "routedWorkflowIds"}, &iteratorForCreateDelayedEvents{rows: arg})
+}
+
 // iteratorForCreateEvents implements pgx.CopyFromSource.
 type iteratorForCreateEvents struct {
 	rows                 []CreateEventsParams
@@ -116,6 +152,7 @@ func (r iteratorForCreateQueueItemsBulk) Values() ([]interface{}, error) {
 		r.rows[0].Queue,
 		r.rows[0].Sticky,
 		r.rows[0].DesiredWorkerId,
+		r.rows[0].Deadline,
 	}, nil
 }
 
@@ -124,7 +161,40 @@ func (r iteratorForCreateQueueItemsBulk) Err() error {
 }
 
 func (q *Queries) CreateQueueItemsBulk(ctx context.Context, db DBTX, arg []CreateQueueItemsBulkParams) (int64, error) {
-	return db.CopyFrom(ctx, []string{"QueueItem"}, []string{"stepRunId", "stepId", "actionId", "scheduleTimeoutAt", "stepTimeout", "priority", "isQueued", "tenantId", "queue", "sticky", "desiredWorkerId"}, &iteratorForCreateQueueItemsBulk{rows: arg})
+	return db.CopyFrom(ctx, []string{"QueueItem"}, []string{"stepRunId", "stepId", "actionId", "scheduleTimeoutAt", "stepTimeout", "priority", "isQueued", "tenantId", "queue", "sticky", "desiredWorkerId", "deadline"}, &iteratorForCreateQueueItemsBulk{rows: arg})
+}
+
+// iteratorForCreateStepRunOrders implements pgx.CopyFromSource.
+type iteratorForCreateStepRunOrders struct {
+	rows                 []CreateStepRunOrdersParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateStepRunOrders) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateStepRunOrders) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].A,
+		r.rows[0].B,
+	}, nil
+}
+
+func (r iteratorForCreateStepRunOrders) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateStepRunOrders(ctx context.Context, db DBTX, arg []CreateStepRunOrdersParams) (int64, error) {
+	return db.CopyFrom(ctx, []string{"_StepRunOrder"}, []string{"A", "B"}, &iteratorForCreateStepRunOrders{rows: arg})
 }
 
 // iteratorForCreateStepRuns implements pgx.CopyFromSource.
@@ -0,0 +1,47 @@
+-- name: CreateCronExclusionCalendar :one
+INSERT INTO "CronExclusionCalendar" (
+    "tenantId",
+    "name",
+    "dates",
+    "icalUrl"
+) VALUES (
+    @tenantId::uuid,
+    @name::text,
+    @dates::date[],
+    sqlc.narg('icalUrl')::text
+) RETURNING *;
+
+-- name: GetCronExclusionCalendarById :one
+SELECT
+    *
+FROM
+    "CronExclusionCalendar"
+WHERE
+    "id" = @id::uuid;
+
+-- name: GetCronExclusionCalendarByName :one
+SELECT
+    *
+FROM
+    "CronExclusionCalendar"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "name" = @name::text;
+
+-- name: ListCronExclusionCalendars :many
+SELECT
+    *
+FROM
+    "CronExclusionCalendar"
+WHERE
+    "tenantId" = @tenantId::uuid
+ORDER BY
+    "name" ASC;
+
+-- name: DeleteCronExclusionCalendar :one
+-- Deletes a calendar. Crons which reference the calendar are no longer excluded on any date.
+DELETE FROM
+    "CronExclusionCalendar"
+WHERE
+    "id" = @id::uuid
+RETURNING *;
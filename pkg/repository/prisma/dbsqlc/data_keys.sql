@@ -0,0 +1,44 @@
+-- name: GetActiveTenantDataKey :one
+SELECT
+    *
+FROM
+    "TenantDataKey"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "isActive";
+
+-- name: GetTenantDataKey :one
+SELECT
+    *
+FROM
+    "TenantDataKey"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "id" = @id::uuid;
+
+-- name: CreateTenantDataKeyIfNotExists :one
+-- Creates the active data key of a tenant. If the tenant already has an active data key, no rows are returned.
+INSERT INTO "TenantDataKey" (
+    "id",
+    "createdAt",
+    "tenantId",
+    "encryptedKeyset",
+    "isActive"
+) VALUES (
+    @id::uuid,
+    CURRENT_TIMESTAMP,
+    @tenantId::uuid,
+    @encryptedKeyset::bytea,
+    true
+)
+ON CONFLICT ("tenantId") WHERE "isActive" DO NOTHING
+RETURNING *;
+
+-- name: DeactivateTenantDataKeys :exec
+UPDATE
+    "TenantDataKey"
+SET
+    "isActive" = false
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "isActive";
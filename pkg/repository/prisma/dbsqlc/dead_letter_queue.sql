@@ -0,0 +1,94 @@
+-- name: CreateDeadLetterQueueItem :exec
+-- Adds a step run which failed after exhausting its retries to the dead-letter queue, along with the errors of
+-- its previous attempts. If the step run is already in the dead-letter queue, the entry is replaced.
+INSERT INTO "DeadLetterQueueItem" (
+    "tenantId",
+    "stepRunId",
+    "stepId",
+    "workflowRunId",
+    "retryCount",
+    "input",
+    "error",
+    "errorChain"
+)
+SELECT
+    sr."tenantId",
+    sr."id",
+    sr."stepId",
+    jr."workflowRunId",
+    @retryCount::int,
+    sr."input",
+    @error::text,
+    COALESCE(
+        (
+            SELECT
+                array_agg(a."error" ORDER BY a."order" ASC)
+            FROM
+                "StepRunResultArchive" a
+            WHERE
+                a."stepRunId" = sr."id"
+                AND a."error" IS NOT NULL
+        ),
+        '{}'
+    )
+FROM
+    "StepRun" sr
+JOIN
+    "JobRun" jr ON sr."jobRunId" = jr."id"
+WHERE
+    sr."id" = @stepRunId::uuid
+    AND sr."tenantId" = @tenantId::uuid
+ON CONFLICT ("stepRunId") DO UPDATE
+SET
+    "createdAt" = CURRENT_TIMESTAMP,
+    "retryCount" = EXCLUDED."retryCount",
+    "input" = EXCLUDED."input",
+    "error" = EXCLUDED."error",
+    "errorChain" = EXCLUDED."errorChain";
+
+-- name: ListDeadLetterQueueItems :many
+SELECT
+    *
+FROM
+    "DeadLetterQueueItem"
+WHERE
+    "tenantId" = @tenantId::uuid
+ORDER BY
+    "createdAt" DESC, "id" DESC
+OFFSET
+    COALESCE(sqlc.narg('offset'), 0)
+LIMIT
+    COALESCE(sqlc.narg('limit'), 50);
+
+-- name: CountDeadLetterQueueItems :one
+SELECT
+    COUNT(*) AS total
+FROM
+    "DeadLetterQueueItem"
+WHERE
+    "tenantId" = @tenantId::uuid;
+
+-- name: ListDeadLetterQueueItemsByIds :many
+SELECT
+    *
+FROM
+    "DeadLetterQueueItem"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "id" = ANY(@ids::uuid[]);
+
+-- name: DeleteDeadLetterQueueItems :execrows
+-- Deletes dead-letter queue items of a tenant. If ids is null, all items are deleted, optionally limited to items
+-- created before createdBefore.
+DELETE FROM
+    "DeadLetterQueueItem"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND (
+        sqlc.narg('ids')::uuid[] IS NULL
+        OR "id" = ANY(sqlc.narg('ids')::uuid[])
+    )
+    AND (
+        sqlc.narg('createdBefore')::timestamp IS NULL
+        OR "createdAt" < sqlc.narg('createdBefore')::timestamp
+    );
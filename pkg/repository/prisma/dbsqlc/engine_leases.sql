@@ -0,0 +1,66 @@
+-- name: AcquireEngineLease :one
+-- Acquires the lease if it's not held or has expired, or extends it if it's held by the holder. Returns whether the
+-- holder holds the lease.
+WITH acquired AS (
+    INSERT INTO "EngineLease" (
+        "name",
+        "holderId",
+        "expiresAt"
+    ) VALUES (
+        @name::text,
+        @holderId::text,
+        now() + make_interval(secs => @durationSeconds::integer)
+    )
+    ON CONFLICT ("name") DO UPDATE
+    SET
+        "holderId" = EXCLUDED."holderId",
+        "expiresAt" = EXCLUDED."expiresAt"
+    WHERE
+        "EngineLease"."expiresAt" < now()
+        OR "EngineLease"."holderId" = EXCLUDED."holderId"
+    RETURNING "name"
+)
+SELECT
+    COUNT(*) > 0 AS "acquired"
+FROM
+    acquired;
+
+-- name: ReleaseEngineLease :exec
+DELETE FROM
+    "EngineLease"
+WHERE
+    "name" = @name::text
+    AND "holderId" = @holderId::text;
+
+-- name: AcquireEngineLeases :many
+-- Acquires the leases which aren't held or have expired, and extends the leases which are held by the holder.
+-- Returns the names of the leases which the holder holds.
+INSERT INTO "EngineLease" (
+    "name",
+    "holderId",
+    "expiresAt"
+)
+SELECT
+    names."name",
+    @holderId::text,
+    now() + make_interval(secs => @durationSeconds::integer)
+FROM
+    unnest(@names::text[]) AS names("name")
+    -- lock the leases in the same order, so concurrent holders don't deadlock
+ORDER BY
+    names."name"
+ON CONFLICT ("name") DO UPDATE
+SET
+    "holderId" = EXCLUDED."holderId",
+    "expiresAt" = EXCLUDED."expiresAt"
+WHERE
+    "EngineLease"."expiresAt" < now()
+    OR "EngineLease"."holderId" = EXCLUDED."holderId"
+RETURNING "name";
+
+-- name: ReleaseEngineLeases :exec
+DELETE FROM
+    "EngineLease"
+WHERE
+    "name" = ANY(@names::text[])
+    AND "holderId" = @holderId::text;
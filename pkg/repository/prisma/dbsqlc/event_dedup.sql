@@ -0,0 +1,86 @@
+-- name: UpsertEventDedupRule :one
+INSERT INTO "EventDedupRule" (
+    "tenantId",
+    "eventKey",
+    "expression",
+    "windowSeconds"
+) VALUES (
+    @tenantId::uuid,
+    @eventKey::text,
+    @expression::text,
+    @windowSeconds::int
+)
+ON CONFLICT ("tenantId", "eventKey") DO UPDATE
+SET
+    "expression" = EXCLUDED."expression",
+    "windowSeconds" = EXCLUDED."windowSeconds",
+    "updatedAt" = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: GetEventDedupRuleById :one
+SELECT
+    *
+FROM
+    "EventDedupRule"
+WHERE
+    "id" = @id::uuid;
+
+-- name: GetEventDedupRuleForKey :one
+SELECT
+    *
+FROM
+    "EventDedupRule"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "eventKey" = @eventKey::text;
+
+-- name: ListEventDedupRules :many
+SELECT
+    *
+FROM
+    "EventDedupRule"
+WHERE
+    "tenantId" = @tenantId::uuid
+ORDER BY
+    "eventKey" ASC;
+
+-- name: DeleteEventDedupRule :exec
+DELETE FROM
+    "EventDedupRule"
+WHERE
+    "id" = @id::uuid;
+
+-- name: ClaimEventDedupKey :one
+-- Claims the dedup key of an event. Returns no rows if the key is held by another event which has not expired
+-- yet. An event may claim its own key again, so an event whose processing is retried isn't a duplicate of itself.
+INSERT INTO "EventDedupKey" (
+    "tenantId",
+    "eventKey",
+    "dedupKey",
+    "eventId",
+    "createdAt",
+    "expiresAt"
+) VALUES (
+    @tenantId::uuid,
+    @eventKey::text,
+    @dedupKey::text,
+    @eventId::uuid,
+    CURRENT_TIMESTAMP,
+    @expiresAt::timestamp
+)
+ON CONFLICT ("tenantId", "eventKey", "dedupKey") DO UPDATE
+SET
+    "eventId" = EXCLUDED."eventId",
+    "createdAt" = EXCLUDED."createdAt",
+    "expiresAt" = EXCLUDED."expiresAt"
+WHERE
+    "EventDedupKey"."expiresAt" <= CURRENT_TIMESTAMP
+    OR "EventDedupKey"."eventId" = EXCLUDED."eventId"
+RETURNING *;
+
+-- name: DeleteExpiredEventDedupKeys :execrows
+DELETE FROM
+    "EventDedupKey"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "expiresAt" < NOW();
@@ -0,0 +1,62 @@
+-- name: UpsertEventRoutingRule :one
+INSERT INTO "EventRoutingRule" (
+    "tenantId",
+    "name",
+    "eventKey",
+    "expression",
+    "workflowId"
+) VALUES (
+    @tenantId::uuid,
+    @name::text,
+    sqlc.narg('eventKey')::text,
+    @expression::text,
+    @workflowId::uuid
+)
+ON CONFLICT ("tenantId", "name") DO UPDATE
+SET
+    "eventKey" = EXCLUDED."eventKey",
+    "expression" = EXCLUDED."expression",
+    "workflowId" = EXCLUDED."workflowId",
+    "updatedAt" = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: GetEventRoutingRuleById :one
+SELECT
+    *
+FROM
+    "EventRoutingRule"
+WHERE
+    "id" = @id::uuid;
+
+-- name: ListEventRoutingRules :many
+SELECT
+    sqlc.embed(rules),
+    w."name" AS "workflowName"
+FROM
+    "EventRoutingRule" rules
+JOIN
+    "Workflow" w ON w."id" = rules."workflowId"
+WHERE
+    rules."tenantId" = @tenantId::uuid
+ORDER BY
+    rules."name" ASC;
+
+-- name: ListEventRoutingRulesForEngine :many
+-- Lists the rules of a tenant whose workflow hasn't been deleted.
+SELECT
+    rules.*
+FROM
+    "EventRoutingRule" rules
+JOIN
+    "Workflow" w ON w."id" = rules."workflowId"
+WHERE
+    rules."tenantId" = @tenantId::uuid
+    AND w."deletedAt" IS NULL
+ORDER BY
+    rules."name" ASC;
+
+-- name: DeleteEventRoutingRule :exec
+DELETE FROM
+    "EventRoutingRule"
+WHERE
+    "id" = @id::uuid;
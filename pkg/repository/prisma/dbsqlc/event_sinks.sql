@@ -0,0 +1,142 @@
+-- name: CreateEventSink :one
+INSERT INTO "EventSink" (
+    "tenantId",
+    "name",
+    "kind",
+    "url",
+    "brokers",
+    "topic",
+    "tls",
+    "secret",
+    "usernameSecret",
+    "passwordSecret",
+    "eventTypes",
+    "maxAttempts",
+    "format"
+) VALUES (
+    @tenantId::uuid,
+    @name::text,
+    @kind::"EventSinkKind",
+    sqlc.narg('url')::text,
+    @brokers::text[],
+    sqlc.narg('topic')::text,
+    @tls::boolean,
+    sqlc.narg('secret')::text,
+    sqlc.narg('usernameSecret')::text,
+    sqlc.narg('passwordSecret')::text,
+    @eventTypes::text[],
+    @maxAttempts::int,
+    @format::"EventSinkFormat"
+) RETURNING *;
+
+-- name: GetEventSinkById :one
+SELECT
+    *
+FROM
+    "EventSink"
+WHERE
+    "id" = @id::uuid;
+
+-- name: ListEventSinks :many
+SELECT
+    *
+FROM
+    "EventSink"
+WHERE
+    "tenantId" = @tenantId::uuid
+ORDER BY
+    "createdAt" ASC;
+
+-- name: ListEventSinksByPartitionId :many
+-- Lists the event sinks of the tenants which are assigned to a worker partition.
+SELECT
+    s.*
+FROM
+    "EventSink" s
+JOIN
+    "Tenant" t ON t."id" = s."tenantId"
+WHERE
+    t."workerPartitionId" = @workerPartitionId::text
+    OR t."workerPartitionId" IS NULL;
+
+-- name: DeleteEventSink :exec
+DELETE FROM
+    "EventSink"
+WHERE
+    "id" = @id::uuid;
+
+-- name: CountEventSinkDeliveries :many
+-- Counts the pending and dead-lettered deliveries of the event sinks of a tenant.
+SELECT
+    d."sinkId",
+    d."status",
+    COUNT(*) AS "count"
+FROM
+    "EventSinkDelivery" d
+JOIN
+    "EventSink" s ON s."id" = d."sinkId"
+WHERE
+    s."tenantId" = @tenantId::uuid
+GROUP BY
+    d."sinkId",
+    d."status";
+
+-- name: CreateEventSinkDeliveries :exec
+INSERT INTO "EventSinkDelivery" (
+    "sinkId",
+    "tenantId",
+    "payload"
+)
+SELECT
+    unnest(@sinkIds::uuid[]),
+    @tenantId::uuid,
+    unnest(@payloads::jsonb[]);
+
+-- name: ListDueEventSinkDeliveries :many
+-- Lists the pending deliveries of a sink whose next attempt is due, oldest first.
+SELECT
+    *
+FROM
+    "EventSinkDelivery"
+WHERE
+    "sinkId" = @sinkId::uuid
+    AND "status" = 'PENDING'
+    AND "nextAttemptAt" <= NOW()
+ORDER BY
+    "id" ASC
+LIMIT
+    sqlc.arg('limit')::int;
+
+-- name: DeleteEventSinkDeliveries :exec
+DELETE FROM
+    "EventSinkDelivery"
+WHERE
+    "id" = ANY(@ids::bigint[]);
+
+-- name: FailEventSinkDeliveries :exec
+-- Schedules the next attempt of deliveries with an exponential backoff of up to an hour, and dead-letters the
+-- deliveries which reached the maximum number of attempts.
+UPDATE
+    "EventSinkDelivery"
+SET
+    "attempts" = "attempts" + 1,
+    "lastError" = @lastError::text,
+    "nextAttemptAt" = NOW() + LEAST(POWER(2, "attempts"), 3600) * INTERVAL '1 second',
+    "status" = CASE
+        WHEN "attempts" + 1 >= @maxAttempts::int THEN 'DEAD'::"EventSinkDeliveryStatus"
+        ELSE 'PENDING'::"EventSinkDeliveryStatus"
+    END
+WHERE
+    "id" = ANY(@ids::bigint[]);
+
+-- name: ReplayEventSinkDeliveries :execrows
+-- Moves the dead-lettered deliveries of a sink back to pending, so they're delivered again.
+UPDATE
+    "EventSinkDelivery"
+SET
+    "status" = 'PENDING',
+    "attempts" = 0,
+    "nextAttemptAt" = NOW()
+WHERE
+    "sinkId" = @sinkId::uuid
+    AND "status" = 'DEAD';
@@ -121,6 +121,21 @@ INSERT INTO "Event" (
     $7
 );
 
+-- name: CreateDelayedEvents :copyfrom
+INSERT INTO "DelayedEvent" (
+    "eventId",
+    "tenantId",
+    "deliverAfter",
+    "idempotencyKey",
+    "routedWorkflowIds"
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+);
+
 
 -- name: GetInsertedEvents :many
 SELECT * FROM "Event"
@@ -238,6 +253,30 @@ WHERE
     "tenantId" = @tenantId::uuid AND
     "id" = ANY (sqlc.arg('ids')::uuid[]);
 
+-- name: ListEventsForReplay :many
+SELECT
+    *
+FROM
+    "Event" as events
+WHERE
+    events."deletedAt" IS NULL AND
+    events."tenantId" = @tenantId::uuid AND
+    events."createdAt" >= @since::timestamp AND
+    events."createdAt" < @until::timestamp AND
+    events."replayedFromId" IS NULL AND
+    (
+        sqlc.narg('keys')::text[] IS NULL OR
+        events."key" = ANY(sqlc.narg('keys')::text[])
+    ) AND
+    (
+        sqlc.narg('additionalMetadata')::jsonb IS NULL OR
+        events."additionalMetadata" @> sqlc.narg('additionalMetadata')::jsonb
+    )
+ORDER BY
+    events."createdAt" ASC, events."id" ASC
+LIMIT
+    sqlc.arg('limit')::int;
+
 -- name: SoftDeleteExpiredEvents :one
 WITH for_delete AS (
     SELECT
@@ -138,6 +138,14 @@ func (q *Queries) CountEvents(ctx context.Context, db DBTX, arg CountEventsParam
 	return total, err
 }
 
+type CreateDelayedEventsParams struct {
+	EventId           pgtype.UUID      `json:"eventId"`
+	TenantId          pgtype.UUID      `json:"tenantId"`
+	DeliverAfter      pgtype.Timestamp `json:"deliverAfter"`
+	IdempotencyKey    pgtype.Text      `json:"idempotencyKey"`
+	RoutedWorkflowIds []pgtype.UUID    `json:"routedWorkflowIds"`
+}
+
 const createEvent = `-- name: CreateEvent :one
 INSERT INTO "Event" (
     "id",
@@ -572,6 +580,78 @@ func (q *Queries) ListEventsByIDs(ctx context.Context, db DBTX, arg ListEventsBy
 	return items, nil
 }
 
+const listEventsForReplay = `-- name: ListEventsForReplay :many
+SELECT
+    id, "createdAt", "updatedAt", "deletedAt", key, "tenantId", "replayedFromId", data, "additionalMetadata", "insertOrder"
+FROM
+    "Event" as events
+WHERE
+    events."deletedAt" IS NULL AND
+    events."tenantId" = $1::uuid AND
+    events."createdAt" >= $2::timestamp AND
+    events."createdAt" < $3::timestamp AND
+    events."replayedFromId" IS NULL AND
+    (
+        $4::text[] IS NULL OR
+        events."key" = ANY($4::text[])
+    ) AND
+    (
+        $5::jsonb IS NULL OR
+        events."additionalMetadata" @> $5::jsonb
+    )
+ORDER BY
+    events."createdAt" ASC, events."id" ASC
+LIMIT
+    $6::int
+`
+
+type ListEventsForReplayParams struct {
+	Tenantid           pgtype.UUID      `json:"tenantid"`
+	Since              pgtype.Timestamp `json:"since"`
+	Until              pgtype.Timestamp `json:"until"`
+	Keys               []string         `json:"keys"`
+	AdditionalMetadata []byte           `json:"additionalMetadata"`
+	Limit              int32            `json:"limit"`
+}
+
+func (q *Queries) ListEventsForReplay(ctx context.Context, db DBTX, arg ListEventsForReplayParams) ([]*Event, error) {
+	rows, err := db.Query(ctx, listEventsForReplay,
+		arg.Tenantid,
+		arg.Since,
+		arg.Until,
+		arg.Keys,
+		arg.AdditionalMetadata,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Event
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Key,
+			&i.TenantId,
+			&i.ReplayedFromId,
+			&i.Data,
+			&i.AdditionalMetadata,
+			&i.InsertOrder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const softDeleteExpiredEvents = `-- name: SoftDeleteExpiredEvents :one
 WITH for_delete AS (
     SELECT
@@ -1,3 +1,12 @@
+-- name: GetReplicationLag :one
+-- Returns the time since the last transaction which a replica replayed in seconds. It's zero if the replica replayed
+-- all the WAL it received, so that an idle primary isn't reported as lag, or if the database isn't a replica.
+SELECT
+    CASE
+        WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+        ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+    END::float8 AS "lagSeconds";
+
 -- name: Health :many
 SELECT id
 FROM "User"
@@ -11,6 +11,23 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const getReplicationLag = `-- name: GetReplicationLag :one
+SELECT
+    CASE
+        WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+        ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+    END::float8 AS "lagSeconds"
+`
+
+// Returns the time since the last transaction which a replica replayed in seconds. It's zero if the replica replayed
+// all the WAL it received, so that an idle primary isn't reported as lag, or if the database isn't a replica.
+func (q *Queries) GetReplicationLag(ctx context.Context, db DBTX) (float64, error) {
+	row := db.QueryRow(ctx, getReplicationLag)
+	var lagSeconds float64
+	err := row.Scan(&lagSeconds)
+	return lagSeconds, err
+}
+
 const health = `-- name: Health :many
 SELECT id
 FROM "User"
@@ -0,0 +1,58 @@
+-- name: ClaimWorkflowRunIdempotencyKey :one
+-- Claims the idempotency key for a new workflow run. Returns no rows if the key is held by a workflow run
+-- which has not expired yet.
+WITH workflow_id AS (
+    SELECT wv."workflowId" AS "id"
+    FROM "WorkflowVersion" wv
+    WHERE wv."id" = @workflowVersionId::uuid
+)
+INSERT INTO "WorkflowRunIdempotencyKey" (
+    "tenantId",
+    "workflowId",
+    "key",
+    "workflowRunId",
+    "createdAt",
+    "expiresAt"
+) VALUES (
+    @tenantId::uuid,
+    (SELECT "id" FROM workflow_id),
+    @key::text,
+    @workflowRunId::uuid,
+    CURRENT_TIMESTAMP,
+    @expiresAt::timestamp
+)
+ON CONFLICT ("tenantId", "workflowId", "key") DO UPDATE
+SET
+    "workflowRunId" = EXCLUDED."workflowRunId",
+    "createdAt" = EXCLUDED."createdAt",
+    "expiresAt" = EXCLUDED."expiresAt"
+WHERE
+    "WorkflowRunIdempotencyKey"."expiresAt" <= CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: GetWorkflowRunIdempotencyKey :one
+SELECT
+    k.*
+FROM
+    "WorkflowRunIdempotencyKey" k
+JOIN
+    "WorkflowVersion" wv ON wv."workflowId" = k."workflowId"
+WHERE
+    k."tenantId" = @tenantId::uuid
+    AND wv."id" = @workflowVersionId::uuid
+    AND k."key" = @key::text
+    AND k."expiresAt" > CURRENT_TIMESTAMP;
+
+-- name: ReleaseWorkflowRunIdempotencyKeys :exec
+DELETE FROM
+    "WorkflowRunIdempotencyKey"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "workflowRunId" = ANY(@workflowRunIds::uuid[]);
+
+-- name: DeleteExpiredWorkflowRunIdempotencyKeys :execrows
+DELETE FROM
+    "WorkflowRunIdempotencyKey"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "expiresAt" < NOW();
@@ -0,0 +1,44 @@
+-- name: CreateInboundWebhook :one
+INSERT INTO "InboundWebhook" (
+    "tenantId",
+    "name",
+    "signatureScheme",
+    "signatureHeader",
+    "secret",
+    "eventKey",
+    "eventKeyExpression",
+    "dataExpression"
+) VALUES (
+    @tenantId::uuid,
+    @name::text,
+    @signatureScheme::"InboundWebhookSignatureScheme",
+    sqlc.narg('signatureHeader')::text,
+    @secret::text,
+    sqlc.narg('eventKey')::text,
+    sqlc.narg('eventKeyExpression')::text,
+    sqlc.narg('dataExpression')::text
+) RETURNING *;
+
+-- name: GetInboundWebhookById :one
+SELECT
+    *
+FROM
+    "InboundWebhook"
+WHERE
+    "id" = @id::uuid;
+
+-- name: ListInboundWebhooks :many
+SELECT
+    *
+FROM
+    "InboundWebhook"
+WHERE
+    "tenantId" = @tenantId::uuid
+ORDER BY
+    "createdAt" ASC;
+
+-- name: DeleteInboundWebhook :exec
+DELETE FROM
+    "InboundWebhook"
+WHERE
+    "id" = @id::uuid;
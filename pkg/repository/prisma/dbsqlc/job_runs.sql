@@ -1,16 +1,24 @@
 -- name: UpdateJobRunStatus :one
+-- Updates the status of the job run if it's in one of the given statuses.
 UPDATE "JobRun"
 SET "status" = @status::"JobRunStatus"
-WHERE "id" = @id::uuid AND "tenantId" = @tenantId::uuid
+WHERE
+    "id" = @id::uuid
+    AND "tenantId" = @tenantId::uuid
+    AND "status" = ANY(@fromStatuses::"JobRunStatus"[])
 RETURNING *;
 
 -- name: ResolveJobRunStatus :many
+-- Resolves the status of the job runs of the step runs from the statuses of their step runs. The status is only
+-- written if the job run is allowed to transition to it, which is the case if the transition is one of the pairs of
+-- transitionFroms and transitionTos at the same index.
 WITH stepRuns AS (
     SELECT
         runs."jobRunId",
         sum(case when runs."status" IN ('PENDING', 'PENDING_ASSIGNMENT') then 1 else 0 end) AS pendingRuns,
         sum(case when runs."status" IN ('RUNNING', 'ASSIGNED') then 1 else 0 end) AS runningRuns,
-        sum(case when runs."status" = 'SUCCEEDED' then 1 else 0 end) AS succeededRuns,
+        -- skipped step runs count as succeeded, as skipping a step does not fail the job
+        sum(case when runs."status" IN ('SUCCEEDED', 'SKIPPED') then 1 else 0 end) AS succeededRuns,
         sum(case when runs."status" = 'FAILED' then 1 else 0 end) AS failedRuns,
         sum(case when runs."status" = 'CANCELLED' then 1 else 0 end) AS cancelledRuns
     FROM "StepRun" as runs
@@ -21,22 +29,33 @@ WITH stepRuns AS (
             WHERE "id" = ANY(@stepRunIds::uuid[])
         )
     GROUP BY runs."jobRunId"
+), resolved AS (
+    SELECT
+        s.*,
+        CASE
+            -- NOTE: Order of the following conditions is important
+            -- When one step run is running, then the job is running
+            WHEN (s.runningRuns > 0 OR s.pendingRuns > 0) THEN 'RUNNING'
+            -- When one step run has failed, then the job is failed
+            WHEN s.failedRuns > 0 THEN 'FAILED'
+            -- When one step run has been cancelled, then the job is cancelled
+            WHEN s.cancelledRuns > 0 THEN 'CANCELLED'
+            -- When no step runs exist that are not succeeded, then the job is succeeded
+            WHEN s.succeededRuns > 0 AND s.pendingRuns = 0 AND s.runningRuns = 0 AND s.failedRuns = 0 AND s.cancelledRuns = 0 THEN 'SUCCEEDED'
+            ELSE jr."status"
+        END::"JobRunStatus" AS "status"
+    FROM
+        stepRuns s
+    JOIN
+        "JobRun" jr ON jr."id" = s."jobRunId"
+), transitions AS (
+    SELECT
+        unnest(@transitionFroms::"JobRunStatus"[]) AS "from",
+        unnest(@transitionTos::"JobRunStatus"[]) AS "to"
 )
 UPDATE "JobRun"
-SET "status" = CASE
-    -- Final states are final, cannot be updated
-    WHEN "status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN "status"
-    -- NOTE: Order of the following conditions is important
-    -- When one step run is running, then the job is running
-    WHEN (s.runningRuns > 0 OR s.pendingRuns > 0) THEN 'RUNNING'
-    -- When one step run has failed, then the job is failed
-    WHEN s.failedRuns > 0 THEN 'FAILED'
-    -- When one step run has been cancelled, then the job is cancelled
-    WHEN s.cancelledRuns > 0 THEN 'CANCELLED'
-    -- When no step runs exist that are not succeeded, then the job is succeeded
-    WHEN s.succeededRuns > 0 AND s.pendingRuns = 0 AND s.runningRuns = 0 AND s.failedRuns = 0 AND s.cancelledRuns = 0 THEN 'SUCCEEDED'
-    ELSE "status"
-END, "finishedAt" = CASE
+SET "status" = s."status",
+"finishedAt" = CASE
     -- Final states are final, cannot be updated
     WHEN "finishedAt" IS NOT NULL THEN "finishedAt"
     WHEN s.runningRuns > 0 THEN NULL
@@ -52,9 +71,17 @@ END, "startedAt" = CASE
     WHEN s.runningRuns > 0 OR s.succeededRuns > 0 OR s.failedRuns > 0 AND s.cancelledRuns > 0 THEN NOW()
     ELSE "startedAt"
 END
-FROM stepRuns s
+FROM resolved s
 WHERE
-    "id" = s."jobRunId"
+    "JobRun"."id" = s."jobRunId"
+    AND (
+        "JobRun"."status" = s."status"
+        OR EXISTS (
+            SELECT 1
+            FROM transitions t
+            WHERE t."from" = "JobRun"."status" AND t."to" = s."status"
+        )
+    )
 RETURNING "JobRun"."id";
 
 -- name: UpsertJobRunLookupData :exec
@@ -79,41 +106,88 @@ SET
     "data" = jsonb_set("JobRunLookupData"."data", @fieldPath::text[], @jsonData::jsonb, true),
     "updatedAt" = CURRENT_TIMESTAMP;
 
--- name: UpdateJobRunLookupDataWithStepRun :exec
-WITH readable_id AS (
-    SELECT "readableId"
-    FROM "Step"
-    WHERE "id" = (
-        SELECT "stepId"
-        FROM "StepRun"
-        WHERE "id" = @stepRunId::uuid
+-- name: AppendJobRunLookupDataEntry :one
+-- Appends the output of a step run to the lookup data of its job run, where a null output removes the step. The
+-- entries are read together with the lookup data until they're merged into it by CompactJobRunLookupData, so the
+-- lookup data isn't rewritten for every step run. Returns the number of entries of the job run.
+WITH step_run AS (
+    SELECT
+        sr."jobRunId",
+        s."readableId"
+    FROM
+        "StepRun" sr
+    JOIN
+        "Step" s ON sr."stepId" = s."id"
+    WHERE
+        sr."id" = @stepRunId::uuid
+        AND sr."tenantId" = @tenantId::uuid
+), inserted AS (
+    INSERT INTO "JobRunLookupDataEntry" (
+        "jobRunId",
+        "tenantId",
+        "readableId",
+        "data"
     )
+    SELECT
+        step_run."jobRunId",
+        @tenantId::uuid,
+        step_run."readableId",
+        @jsonData::jsonb
+    FROM
+        step_run
+    RETURNING "jobRunId"
 )
-UPDATE "JobRunLookupData"
+SELECT
+    inserted."jobRunId" AS "jobRunId",
+    -- the inserted entry isn't visible to the statement
+    ((
+        SELECT COUNT(*)
+        FROM "JobRunLookupDataEntry" e
+        WHERE e."jobRunId" = inserted."jobRunId"
+    ) + 1)::int AS "tailLength"
+FROM
+    inserted;
+
+-- name: CompactJobRunLookupData :exec
+-- Merges the entries of a job run into its lookup data and deletes them, where the latest entry of a step wins.
+WITH deleted AS (
+    DELETE FROM "JobRunLookupDataEntry"
+    WHERE
+        "jobRunId" = @jobRunId::uuid
+        AND "tenantId" = @tenantId::uuid
+    RETURNING "id", "readableId", "data"
+), latest AS (
+    SELECT DISTINCT ON ("readableId")
+        "readableId",
+        "data"
+    FROM
+        deleted
+    ORDER BY
+        "readableId", "id" DESC
+)
+UPDATE "JobRunLookupData" jrld
 SET
-    "data" = CASE
-        WHEN @jsonData::jsonb IS NULL THEN
-            jsonb_set(
-                "data",
-                '{steps}',
-                ("data"->'steps') - (SELECT "readableId" FROM readable_id),
-                true
-            )
-        ELSE
-            jsonb_set(
-                "data",
-                ARRAY['steps', (SELECT "readableId" FROM readable_id)],
-                @jsonData::jsonb,
-                true
-            )
-    END,
+    "data" = jsonb_set(
+        jrld."data",
+        '{steps}',
+        (COALESCE(jrld."data"->'steps', '{}'::jsonb) - ARRAY(SELECT "readableId" FROM latest))
+            || COALESCE((SELECT jsonb_object_agg("readableId", "data") FROM latest WHERE "data" IS NOT NULL), '{}'::jsonb),
+        true
+    ),
     "updatedAt" = CURRENT_TIMESTAMP
 WHERE
-    "jobRunId" = (
-        SELECT "jobRunId"
-        FROM "StepRun"
-        WHERE "id" = @stepRunId::uuid
-    )
+    jrld."jobRunId" = @jobRunId::uuid
+    AND jrld."tenantId" = @tenantId::uuid
+    AND jrld."data" IS NOT NULL
+    AND EXISTS (SELECT 1 FROM latest);
+
+-- name: GetJobRunStatus :one
+SELECT
+    "status"
+FROM
+    "JobRun"
+WHERE
+    "id" = @jobRunId::uuid
     AND "tenantId" = @tenantId::uuid;
 
 -- name: ListJobRunsForWorkflowRun :many
@@ -173,7 +247,8 @@ WHERE
 -- name: ClearJobRunLookupData :one
 WITH for_delete AS (
     SELECT
-        jrld2."id" as "id"
+        jrld2."id" as "id",
+        jrld2."jobRunId" as "jobRunId"
     FROM "JobRun" jr2
     LEFT JOIN "JobRunLookupData" jrld2 ON jr2."id" = jrld2."jobRunId"
     WHERE
@@ -185,10 +260,15 @@ WITH for_delete AS (
 ),
 deleted_with_limit AS (
     SELECT
-        for_delete."id" as "id"
+        for_delete."id" as "id",
+        for_delete."jobRunId" as "jobRunId"
     FROM for_delete
     LIMIT sqlc.arg('limit')
 ),
+deleted_entries AS (
+    DELETE FROM "JobRunLookupDataEntry"
+    WHERE "jobRunId" IN (SELECT "jobRunId" FROM deleted_with_limit)
+),
 has_more AS (
     SELECT
         CASE
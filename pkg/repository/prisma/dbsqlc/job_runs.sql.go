@@ -11,10 +11,72 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const appendJobRunLookupDataEntry = `-- name: AppendJobRunLookupDataEntry :one
+WITH step_run AS (
+    SELECT
+        sr."jobRunId",
+        s."readableId"
+    FROM
+        "StepRun" sr
+    JOIN
+        "Step" s ON sr."stepId" = s."id"
+    WHERE
+        sr."id" = $1::uuid
+        AND sr."tenantId" = $2::uuid
+), inserted AS (
+    INSERT INTO "JobRunLookupDataEntry" (
+        "jobRunId",
+        "tenantId",
+        "readableId",
+        "data"
+    )
+    SELECT
+        step_run."jobRunId",
+        $2::uuid,
+        step_run."readableId",
+        $3::jsonb
+    FROM
+        step_run
+    RETURNING "jobRunId"
+)
+SELECT
+    inserted."jobRunId" AS "jobRunId",
+    -- the inserted entry isn't visible to the statement
+    ((
+        SELECT COUNT(*)
+        FROM "JobRunLookupDataEntry" e
+        WHERE e."jobRunId" = inserted."jobRunId"
+    ) + 1)::int AS "tailLength"
+FROM
+    inserted
+`
+
+type AppendJobRunLookupDataEntryParams struct {
+	Steprunid pgtype.UUID `json:"steprunid"`
+	Tenantid  pgtype.UUID `json:"tenantid"`
+	Jsondata  []byte      `json:"jsondata"`
+}
+
+type AppendJobRunLookupDataEntryRow struct {
+	JobRunId   pgtype.UUID `json:"jobRunId"`
+	TailLength int32       `json:"tailLength"`
+}
+
+// Appends the output of a step run to the lookup data of its job run, where a null output removes the step. The
+// entries are read together with the lookup data until they're merged into it by CompactJobRunLookupData, so the
+// lookup data isn't rewritten for every step run. Returns the number of entries of the job run.
+func (q *Queries) AppendJobRunLookupDataEntry(ctx context.Context, db DBTX, arg AppendJobRunLookupDataEntryParams) (*AppendJobRunLookupDataEntryRow, error) {
+	row := db.QueryRow(ctx, appendJobRunLookupDataEntry, arg.Steprunid, arg.Tenantid, arg.Jsondata)
+	var i AppendJobRunLookupDataEntryRow
+	err := row.Scan(&i.JobRunId, &i.TailLength)
+	return &i, err
+}
+
 const clearJobRunLookupData = `-- name: ClearJobRunLookupData :one
 WITH for_delete AS (
     SELECT
-        jrld2."id" as "id"
+        jrld2."id" as "id",
+        jrld2."jobRunId" as "jobRunId"
     FROM "JobRun" jr2
     LEFT JOIN "JobRunLookupData" jrld2 ON jr2."id" = jrld2."jobRunId"
     WHERE
@@ -26,10 +88,15 @@ WITH for_delete AS (
 ),
 deleted_with_limit AS (
     SELECT
-        for_delete."id" as "id"
+        for_delete."id" as "id",
+        for_delete."jobRunId" as "jobRunId"
     FROM for_delete
     LIMIT $2
 ),
+deleted_entries AS (
+    DELETE FROM "JobRunLookupDataEntry"
+    WHERE "jobRunId" IN (SELECT "jobRunId" FROM deleted_with_limit)
+),
 has_more AS (
     SELECT
         CASE
@@ -60,6 +127,50 @@ func (q *Queries) ClearJobRunLookupData(ctx context.Context, db DBTX, arg ClearJ
 	return has_more, err
 }
 
+const compactJobRunLookupData = `-- name: CompactJobRunLookupData :exec
+WITH deleted AS (
+    DELETE FROM "JobRunLookupDataEntry"
+    WHERE
+        "jobRunId" = $1::uuid
+        AND "tenantId" = $2::uuid
+    RETURNING "id", "readableId", "data"
+), latest AS (
+    SELECT DISTINCT ON ("readableId")
+        "readableId",
+        "data"
+    FROM
+        deleted
+    ORDER BY
+        "readableId", "id" DESC
+)
+UPDATE "JobRunLookupData" jrld
+SET
+    "data" = jsonb_set(
+        jrld."data",
+        '{steps}',
+        (COALESCE(jrld."data"->'steps', '{}'::jsonb) - ARRAY(SELECT "readableId" FROM latest))
+            || COALESCE((SELECT jsonb_object_agg("readableId", "data") FROM latest WHERE "data" IS NOT NULL), '{}'::jsonb),
+        true
+    ),
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    jrld."jobRunId" = $1::uuid
+    AND jrld."tenantId" = $2::uuid
+    AND jrld."data" IS NOT NULL
+    AND EXISTS (SELECT 1 FROM latest)
+`
+
+type CompactJobRunLookupDataParams struct {
+	Jobrunid pgtype.UUID `json:"jobrunid"`
+	Tenantid pgtype.UUID `json:"tenantid"`
+}
+
+// Merges the entries of a job run into its lookup data and deletes them, where the latest entry of a step wins.
+func (q *Queries) CompactJobRunLookupData(ctx context.Context, db DBTX, arg CompactJobRunLookupDataParams) error {
+	_, err := db.Exec(ctx, compactJobRunLookupData, arg.Jobrunid, arg.Tenantid)
+	return err
+}
+
 const getJobRunByWorkflowRunIdAndJobId = `-- name: GetJobRunByWorkflowRunIdAndJobId :one
 SELECT
     "id",
@@ -92,6 +203,28 @@ func (q *Queries) GetJobRunByWorkflowRunIdAndJobId(ctx context.Context, db DBTX,
 	return &i, err
 }
 
+const getJobRunStatus = `-- name: GetJobRunStatus :one
+SELECT
+    "status"
+FROM
+    "JobRun"
+WHERE
+    "id" = $1::uuid
+    AND "tenantId" = $2::uuid
+`
+
+type GetJobRunStatusParams struct {
+	Jobrunid pgtype.UUID `json:"jobrunid"`
+	Tenantid pgtype.UUID `json:"tenantid"`
+}
+
+func (q *Queries) GetJobRunStatus(ctx context.Context, db DBTX, arg GetJobRunStatusParams) (JobRunStatus, error) {
+	row := db.QueryRow(ctx, getJobRunStatus, arg.Jobrunid, arg.Tenantid)
+	var status JobRunStatus
+	err := row.Scan(&status)
+	return status, err
+}
+
 const getJobRunsByWorkflowRunId = `-- name: GetJobRunsByWorkflowRunId :many
 
 SELECT
@@ -270,7 +403,8 @@ WITH stepRuns AS (
         runs."jobRunId",
         sum(case when runs."status" IN ('PENDING', 'PENDING_ASSIGNMENT') then 1 else 0 end) AS pendingRuns,
         sum(case when runs."status" IN ('RUNNING', 'ASSIGNED') then 1 else 0 end) AS runningRuns,
-        sum(case when runs."status" = 'SUCCEEDED' then 1 else 0 end) AS succeededRuns,
+        -- skipped step runs count as succeeded, as skipping a step does not fail the job
+        sum(case when runs."status" IN ('SUCCEEDED', 'SKIPPED') then 1 else 0 end) AS succeededRuns,
         sum(case when runs."status" = 'FAILED' then 1 else 0 end) AS failedRuns,
         sum(case when runs."status" = 'CANCELLED' then 1 else 0 end) AS cancelledRuns
     FROM "StepRun" as runs
@@ -281,22 +415,33 @@ WITH stepRuns AS (
             WHERE "id" = ANY($1::uuid[])
         )
     GROUP BY runs."jobRunId"
+), resolved AS (
+    SELECT
+        s."jobRunId", s.pendingruns, s.runningruns, s.succeededruns, s.failedruns, s.cancelledruns,
+        CASE
+            -- NOTE: Order of the following conditions is important
+            -- When one step run is running, then the job is running
+            WHEN (s.runningRuns > 0 OR s.pendingRuns > 0) THEN 'RUNNING'
+            -- When one step run has failed, then the job is failed
+            WHEN s.failedRuns > 0 THEN 'FAILED'
+            -- When one step run has been cancelled, then the job is cancelled
+            WHEN s.cancelledRuns > 0 THEN 'CANCELLED'
+            -- When no step runs exist that are not succeeded, then the job is succeeded
+            WHEN s.succeededRuns > 0 AND s.pendingRuns = 0 AND s.runningRuns = 0 AND s.failedRuns = 0 AND s.cancelledRuns = 0 THEN 'SUCCEEDED'
+            ELSE jr."status"
+        END::"JobRunStatus" AS "status"
+    FROM
+        stepRuns s
+    JOIN
+        "JobRun" jr ON jr."id" = s."jobRunId"
+), transitions AS (
+    SELECT
+        unnest($2::"JobRunStatus"[]) AS "from",
+        unnest($3::"JobRunStatus"[]) AS "to"
 )
 UPDATE "JobRun"
-SET "status" = CASE
-    -- Final states are final, cannot be updated
-    WHEN "status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN "status"
-    -- NOTE: Order of the following conditions is important
-    -- When one step run is running, then the job is running
-    WHEN (s.runningRuns > 0 OR s.pendingRuns > 0) THEN 'RUNNING'
-    -- When one step run has failed, then the job is failed
-    WHEN s.failedRuns > 0 THEN 'FAILED'
-    -- When one step run has been cancelled, then the job is cancelled
-    WHEN s.cancelledRuns > 0 THEN 'CANCELLED'
-    -- When no step runs exist that are not succeeded, then the job is succeeded
-    WHEN s.succeededRuns > 0 AND s.pendingRuns = 0 AND s.runningRuns = 0 AND s.failedRuns = 0 AND s.cancelledRuns = 0 THEN 'SUCCEEDED'
-    ELSE "status"
-END, "finishedAt" = CASE
+SET "status" = s."status",
+"finishedAt" = CASE
     -- Final states are final, cannot be updated
     WHEN "finishedAt" IS NOT NULL THEN "finishedAt"
     WHEN s.runningRuns > 0 THEN NULL
@@ -312,14 +457,31 @@ END, "startedAt" = CASE
     WHEN s.runningRuns > 0 OR s.succeededRuns > 0 OR s.failedRuns > 0 AND s.cancelledRuns > 0 THEN NOW()
     ELSE "startedAt"
 END
-FROM stepRuns s
+FROM resolved s
 WHERE
-    "id" = s."jobRunId"
+    "JobRun"."id" = s."jobRunId"
+    AND (
+        "JobRun"."status" = s."status"
+        OR EXISTS (
+            SELECT 1
+            FROM transitions t
+            WHERE t."from" = "JobRun"."status" AND t."to" = s."status"
+        )
+    )
 RETURNING "JobRun"."id"
 `
 
-func (q *Queries) ResolveJobRunStatus(ctx context.Context, db DBTX, steprunids []pgtype.UUID) ([]pgtype.UUID, error) {
-	rows, err := db.Query(ctx, resolveJobRunStatus, steprunids)
+type ResolveJobRunStatusParams struct {
+	Steprunids      []pgtype.UUID  `json:"steprunids"`
+	Transitionfroms []JobRunStatus `json:"transitionfroms"`
+	Transitiontos   []JobRunStatus `json:"transitiontos"`
+}
+
+// Resolves the status of the job runs of the step runs from the statuses of their step runs. The status is only
+// written if the job run is allowed to transition to it, which is the case if the transition is one of the pairs of
+// transitionFroms and transitionTos at the same index.
+func (q *Queries) ResolveJobRunStatus(ctx context.Context, db DBTX, arg ResolveJobRunStatusParams) ([]pgtype.UUID, error) {
+	rows, err := db.Query(ctx, resolveJobRunStatus, arg.Steprunids, arg.Transitionfroms, arg.Transitiontos)
 	if err != nil {
 		return nil, err
 	}
@@ -338,70 +500,31 @@ func (q *Queries) ResolveJobRunStatus(ctx context.Context, db DBTX, steprunids [
 	return items, nil
 }
 
-const updateJobRunLookupDataWithStepRun = `-- name: UpdateJobRunLookupDataWithStepRun :exec
-WITH readable_id AS (
-    SELECT "readableId"
-    FROM "Step"
-    WHERE "id" = (
-        SELECT "stepId"
-        FROM "StepRun"
-        WHERE "id" = $2::uuid
-    )
-)
-UPDATE "JobRunLookupData"
-SET
-    "data" = CASE
-        WHEN $1::jsonb IS NULL THEN
-            jsonb_set(
-                "data",
-                '{steps}',
-                ("data"->'steps') - (SELECT "readableId" FROM readable_id),
-                true
-            )
-        ELSE
-            jsonb_set(
-                "data",
-                ARRAY['steps', (SELECT "readableId" FROM readable_id)],
-                $1::jsonb,
-                true
-            )
-    END,
-    "updatedAt" = CURRENT_TIMESTAMP
-WHERE
-    "jobRunId" = (
-        SELECT "jobRunId"
-        FROM "StepRun"
-        WHERE "id" = $2::uuid
-    )
-    AND "tenantId" = $3::uuid
-`
-
-type UpdateJobRunLookupDataWithStepRunParams struct {
-	Jsondata  []byte      `json:"jsondata"`
-	Steprunid pgtype.UUID `json:"steprunid"`
-	Tenantid  pgtype.UUID `json:"tenantid"`
-}
-
-func (q *Queries) UpdateJobRunLookupDataWithStepRun(ctx context.Context, db DBTX, arg UpdateJobRunLookupDataWithStepRunParams) error {
-	_, err := db.Exec(ctx, updateJobRunLookupDataWithStepRun, arg.Jsondata, arg.Steprunid, arg.Tenantid)
-	return err
-}
-
 const updateJobRunStatus = `-- name: UpdateJobRunStatus :one
 UPDATE "JobRun"
 SET "status" = $1::"JobRunStatus"
-WHERE "id" = $2::uuid AND "tenantId" = $3::uuid
+WHERE
+    "id" = $2::uuid
+    AND "tenantId" = $3::uuid
+    AND "status" = ANY($4::"JobRunStatus"[])
 RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "jobId", "tickerId", status, result, "startedAt", "finishedAt", "timeoutAt", "cancelledAt", "cancelledReason", "cancelledError", "workflowRunId"
 `
 
 type UpdateJobRunStatusParams struct {
-	Status   JobRunStatus `json:"status"`
-	ID       pgtype.UUID  `json:"id"`
-	Tenantid pgtype.UUID  `json:"tenantid"`
+	Status       JobRunStatus   `json:"status"`
+	ID           pgtype.UUID    `json:"id"`
+	Tenantid     pgtype.UUID    `json:"tenantid"`
+	Fromstatuses []JobRunStatus `json:"fromstatuses"`
 }
 
+// Updates the status of the job run if it's in one of the given statuses.
 func (q *Queries) UpdateJobRunStatus(ctx context.Context, db DBTX, arg UpdateJobRunStatusParams) (*JobRun, error) {
-	row := db.QueryRow(ctx, updateJobRunStatus, arg.Status, arg.ID, arg.Tenantid)
+	row := db.QueryRow(ctx, updateJobRunStatus,
+		arg.Status,
+		arg.ID,
+		arg.Tenantid,
+		arg.Fromstatuses,
+	)
 	var i JobRun
 	err := row.Scan(
 		&i.ID,
@@ -0,0 +1,58 @@
+-- name: AcquireKafkaPartitionLeases :many
+-- Acquires the leases on the partitions which aren't leased by another consumer, and extends the leases which the
+-- consumer already holds. Returns the partitions which the consumer holds a lease on.
+INSERT INTO "KafkaPartitionOffset" (
+    "consumerGroup",
+    "topic",
+    "partition",
+    "leaseOwner",
+    "leaseExpiresAt",
+    "updatedAt"
+)
+SELECT
+    @consumerGroup::text,
+    input."topic",
+    input."partition",
+    @consumerId::uuid,
+    now() + @leaseDuration::interval,
+    now()
+FROM (
+    SELECT
+        unnest(@topics::text[]) AS "topic",
+        unnest(@partitions::integer[]) AS "partition"
+    ) AS input
+ON CONFLICT ("consumerGroup", "topic", "partition") DO UPDATE
+SET
+    "leaseOwner" = EXCLUDED."leaseOwner",
+    "leaseExpiresAt" = EXCLUDED."leaseExpiresAt",
+    "updatedAt" = now()
+WHERE
+    "KafkaPartitionOffset"."leaseOwner" IS NULL
+    OR "KafkaPartitionOffset"."leaseOwner" = EXCLUDED."leaseOwner"
+    OR "KafkaPartitionOffset"."leaseExpiresAt" < now()
+RETURNING *;
+
+-- name: CommitKafkaPartitionOffset :execrows
+-- Stores the offset of the next record to ingest from a partition, if the consumer still holds the lease on it.
+UPDATE
+    "KafkaPartitionOffset"
+SET
+    "offset" = sqlc.arg('offset')::bigint,
+    "updatedAt" = now()
+WHERE
+    "consumerGroup" = @consumerGroup::text
+    AND "topic" = @topic::text
+    AND "partition" = @partition::integer
+    AND "leaseOwner" = @consumerId::uuid
+    AND "leaseExpiresAt" > now();
+
+-- name: ReleaseKafkaPartitionLeases :exec
+UPDATE
+    "KafkaPartitionOffset"
+SET
+    "leaseOwner" = NULL,
+    "leaseExpiresAt" = NULL,
+    "updatedAt" = now()
+WHERE
+    "consumerGroup" = @consumerGroup::text
+    AND "leaseOwner" = @consumerId::uuid;
@@ -29,14 +29,50 @@ FROM (
         unnest(@resourceIds::text[]) AS "resourceId"
     ) AS input
 -- On conflict, acquire the lease if the existing lease has expired.
+-- Extending a lease keeps its fencing token, while acquiring an expired lease takes a new one.
 ON CONFLICT ("tenantId", "kind", "resourceId") DO UPDATE
 SET
-    "expiresAt" = EXCLUDED."expiresAt"
+    "expiresAt" = EXCLUDED."expiresAt",
+    "fencingToken" = CASE
+        WHEN "Lease"."id" = ANY(@existingLeaseIds::bigint[]) THEN "Lease"."fencingToken"
+        ELSE EXCLUDED."fencingToken"
+    END
 WHERE
     "Lease"."expiresAt" < now() OR
     "Lease"."id" = ANY(@existingLeaseIds::bigint[])
 RETURNING *;
 
+-- name: GetLeaseFencingToken :one
+-- Gets the fencing token of an active lease, and holds a share lock on the lease until the end of the
+-- transaction so that it can't be acquired by another scheduler in the meantime.
+SELECT
+    "fencingToken"
+FROM
+    "Lease"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "kind" = @kind::"LeaseKind"
+    AND "resourceId" = @resourceId::text
+    AND "expiresAt" > now()
+FOR SHARE;
+
+-- name: ListAvailableLeaseResources :many
+-- Lists the resources which do not have an active lease. This does not acquire any leases.
+SELECT
+    input."resourceId"::text AS "resourceId"
+FROM (
+    SELECT
+        unnest(@resourceIds::text[]) AS "resourceId"
+    ) AS input
+LEFT JOIN
+    "Lease" l ON
+        l."tenantId" = @tenantId::uuid
+        AND l."kind" = @kind::"LeaseKind"
+        AND l."resourceId" = input."resourceId"
+WHERE
+    l."id" IS NULL
+    OR l."expiresAt" < now();
+
 -- name: ReleaseLeases :many
 -- Releases a set of leases by their IDs. Returns the released leases.
 DELETE FROM "Lease" l
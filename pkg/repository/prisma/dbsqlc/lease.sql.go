@@ -29,11 +29,15 @@ FROM (
     ) AS input
 ON CONFLICT ("tenantId", "kind", "resourceId") DO UPDATE
 SET
-    "expiresAt" = EXCLUDED."expiresAt"
+    "expiresAt" = EXCLUDED."expiresAt",
+    "fencingToken" = CASE
+        WHEN "Lease"."id" = ANY($5::bigint[]) THEN "Lease"."fencingToken"
+        ELSE EXCLUDED."fencingToken"
+    END
 WHERE
     "Lease"."expiresAt" < now() OR
     "Lease"."id" = ANY($5::bigint[])
-RETURNING id, "expiresAt", "tenantId", "resourceId", kind
+RETURNING id, "expiresAt", "tenantId", "resourceId", kind, "fencingToken"
 `
 
 type AcquireOrExtendLeasesParams struct {
@@ -47,6 +51,7 @@ type AcquireOrExtendLeasesParams struct {
 // Attempts to acquire leases for a set of resources, and extends the leases if we already have them.
 // Returns the acquired leases.
 // On conflict, acquire the lease if the existing lease has expired.
+// Extending a lease keeps its fencing token, while acquiring an expired lease takes a new one.
 func (q *Queries) AcquireOrExtendLeases(ctx context.Context, db DBTX, arg AcquireOrExtendLeasesParams) ([]*Lease, error) {
 	rows, err := db.Query(ctx, acquireOrExtendLeases,
 		arg.LeaseDuration,
@@ -68,6 +73,7 @@ func (q *Queries) AcquireOrExtendLeases(ctx context.Context, db DBTX, arg Acquir
 			&i.TenantId,
 			&i.ResourceId,
 			&i.Kind,
+			&i.FencingToken,
 		); err != nil {
 			return nil, err
 		}
@@ -79,9 +85,37 @@ func (q *Queries) AcquireOrExtendLeases(ctx context.Context, db DBTX, arg Acquir
 	return items, nil
 }
 
+const getLeaseFencingToken = `-- name: GetLeaseFencingToken :one
+SELECT
+    "fencingToken"
+FROM
+    "Lease"
+WHERE
+    "tenantId" = $1::uuid
+    AND "kind" = $2::"LeaseKind"
+    AND "resourceId" = $3::text
+    AND "expiresAt" > now()
+FOR SHARE
+`
+
+type GetLeaseFencingTokenParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Kind       LeaseKind   `json:"kind"`
+	Resourceid string      `json:"resourceid"`
+}
+
+// Gets the fencing token of an active lease, and holds a share lock on the lease until the end of the
+// transaction so that it can't be acquired by another scheduler in the meantime.
+func (q *Queries) GetLeaseFencingToken(ctx context.Context, db DBTX, arg GetLeaseFencingTokenParams) (int64, error) {
+	row := db.QueryRow(ctx, getLeaseFencingToken, arg.Tenantid, arg.Kind, arg.Resourceid)
+	var fencingToken int64
+	err := row.Scan(&fencingToken)
+	return fencingToken, err
+}
+
 const getLeasesToAcquire = `-- name: GetLeasesToAcquire :exec
 SELECT
-    id, "expiresAt", "tenantId", "resourceId", kind
+    id, "expiresAt", "tenantId", "resourceId", kind, "fencingToken"
 FROM
     "Lease"
 WHERE
@@ -103,6 +137,50 @@ func (q *Queries) GetLeasesToAcquire(ctx context.Context, db DBTX, arg GetLeases
 	return err
 }
 
+const listAvailableLeaseResources = `-- name: ListAvailableLeaseResources :many
+SELECT
+    input."resourceId"::text AS "resourceId"
+FROM (
+    SELECT
+        unnest($1::text[]) AS "resourceId"
+    ) AS input
+LEFT JOIN
+    "Lease" l ON
+        l."tenantId" = $2::uuid
+        AND l."kind" = $3::"LeaseKind"
+        AND l."resourceId" = input."resourceId"
+WHERE
+    l."id" IS NULL
+    OR l."expiresAt" < now()
+`
+
+type ListAvailableLeaseResourcesParams struct {
+	Resourceids []string    `json:"resourceids"`
+	Tenantid    pgtype.UUID `json:"tenantid"`
+	Kind        LeaseKind   `json:"kind"`
+}
+
+// Lists the resources which do not have an active lease. This does not acquire any leases.
+func (q *Queries) ListAvailableLeaseResources(ctx context.Context, db DBTX, arg ListAvailableLeaseResourcesParams) ([]string, error) {
+	rows, err := db.Query(ctx, listAvailableLeaseResources, arg.Resourceids, arg.Tenantid, arg.Kind)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var resourceId string
+		if err := rows.Scan(&resourceId); err != nil {
+			return nil, err
+		}
+		items = append(items, resourceId)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const releaseLeases = `-- name: ReleaseLeases :many
 DELETE FROM "Lease" l
 USING (
@@ -111,7 +189,7 @@ USING (
     ) AS input
 WHERE
     l."id" = input."id"
-RETURNING l.id, l."expiresAt", l."tenantId", l."resourceId", l.kind
+RETURNING l.id, l."expiresAt", l."tenantId", l."resourceId", l.kind, l."fencingToken"
 `
 
 // Releases a set of leases by their IDs. Returns the released leases.
@@ -130,6 +208,7 @@ func (q *Queries) ReleaseLeases(ctx context.Context, db DBTX, leaseids []int64)
 			&i.TenantId,
 			&i.ResourceId,
 			&i.Kind,
+			&i.FencingToken,
 		); err != nil {
 			return nil, err
 		}
@@ -17,16 +17,83 @@ SELECT
 FROM "StepRun"
 WHERE "StepRun"."id" = @stepRunId::uuid
 AND "StepRun"."tenantId" = @tenantId::uuid
+AND (
+    @maxLines::int = 0 OR
+    (SELECT COUNT(*) FROM "LogLine" WHERE "tenantId" = @tenantId::uuid AND "stepRunId" = @stepRunId::uuid) < @maxLines::int
+)
 RETURNING *;
 
+-- name: BulkCreateLogLines :execrows
+-- Creates log lines in the given order. Log lines of step runs which don't exist, or which would exceed the maximum
+-- number of log lines of their step run, are dropped. If the maximum is 0, log lines are not limited.
+WITH unnested AS (
+    SELECT
+        unnest(@createdAts::timestamp[]) AS "createdAt",
+        unnest(@stepRunIds::uuid[]) AS "stepRunId",
+        unnest(@messages::text[]) AS "message",
+        unnest(@levels::"LogLineLevel"[]) AS "level",
+        unnest(@metadatas::jsonb[]) AS "metadata",
+        generate_series(1, cardinality(@createdAts::timestamp[])) AS "ord"
+), input AS (
+    SELECT
+        t."createdAt",
+        t."stepRunId",
+        t."message",
+        t."level",
+        t."metadata",
+        t."ord",
+        row_number() OVER (PARTITION BY t."stepRunId" ORDER BY t."ord") AS "position"
+    FROM
+        unnested t
+), step_runs AS (
+    SELECT
+        sr."id",
+        (
+            SELECT COUNT(*)
+            FROM "LogLine" ll
+            WHERE ll."tenantId" = @tenantId::uuid AND ll."stepRunId" = sr."id"
+        ) AS "count"
+    FROM
+        "StepRun" sr
+    WHERE
+        sr."id" IN (SELECT DISTINCT "stepRunId" FROM input)
+        AND sr."tenantId" = @tenantId::uuid
+)
+INSERT INTO "LogLine" (
+    "createdAt",
+    "tenantId",
+    "stepRunId",
+    "message",
+    "level",
+    "metadata"
+)
+SELECT
+    i."createdAt",
+    @tenantId::uuid,
+    i."stepRunId",
+    i."message",
+    i."level",
+    coalesce(i."metadata", '{}'::jsonb)
+FROM
+    input i
+JOIN
+    step_runs sr ON sr."id" = i."stepRunId"
+WHERE
+    @maxLines::int = 0
+    OR sr."count" + i."position" <= @maxLines::int
+ORDER BY
+    i."ord";
+
 -- name: ListLogLines :many
 SELECT * FROM "LogLine"
 WHERE
   "tenantId" = @tenantId::uuid AND
   (sqlc.narg('stepRunId')::uuid IS NULL OR "stepRunId" = sqlc.narg('stepRunId')::uuid) AND
   (sqlc.narg('search')::text IS NULL OR "message" LIKE concat('%', sqlc.narg('search')::text, '%')) AND
-  (sqlc.narg('levels')::"LogLineLevel"[] IS NULL OR "level" = ANY(sqlc.narg('levels')::"LogLineLevel"[]))
+  (sqlc.narg('levels')::"LogLineLevel"[] IS NULL OR "level" = ANY(sqlc.narg('levels')::"LogLineLevel"[])) AND
+  (sqlc.narg('afterId')::bigint IS NULL OR "id" > sqlc.narg('afterId')::bigint)
 ORDER BY
+  CASE WHEN sqlc.narg('orderBy')::text = 'id ASC' THEN "id" END ASC,
   CASE WHEN sqlc.narg('orderBy')::text = 'createdAt ASC' THEN "createdAt" END ASC,
   CASE WHEN sqlc.narg('orderBy')::text = 'createdAt DESC' THEN "createdAt" END DESC,
   -- add order by id to make sure the order is deterministic
@@ -42,4 +109,36 @@ WHERE
   "tenantId" = @tenantId::uuid AND
   (sqlc.narg('stepRunId')::uuid IS NULL OR "stepRunId" = sqlc.narg('stepRunId')::uuid) AND
   (sqlc.narg('search')::text IS NULL OR "message" LIKE concat('%', sqlc.narg('search')::text, '%')) AND
-  (sqlc.narg('levels')::"LogLineLevel"[] IS NULL OR "level" = ANY(sqlc.narg('levels')::"LogLineLevel"[]));
+  (sqlc.narg('levels')::"LogLineLevel"[] IS NULL OR "level" = ANY(sqlc.narg('levels')::"LogLineLevel"[])) AND
+  (sqlc.narg('afterId')::bigint IS NULL OR "id" > sqlc.narg('afterId')::bigint);
+
+-- name: DeleteExpiredLogLines :one
+WITH for_delete AS (
+    SELECT
+        "id"
+    FROM "LogLine" l
+    WHERE
+        l."tenantId" = @tenantId::uuid AND
+        l."createdAt" < @createdBefore::timestamp
+    ORDER BY l."createdAt" ASC
+    LIMIT sqlc.arg('limit') +1
+    FOR UPDATE SKIP LOCKED
+),expired_with_limit AS (
+    SELECT
+        for_delete."id" as "id"
+    FROM for_delete
+    LIMIT sqlc.arg('limit')
+), has_more AS (
+    SELECT
+        CASE
+            WHEN COUNT(*) > sqlc.arg('limit') THEN TRUE
+            ELSE FALSE
+        END as has_more
+    FROM for_delete
+)
+DELETE FROM
+    "LogLine"
+WHERE
+    "id" IN (SELECT "id" FROM expired_with_limit)
+RETURNING
+    (SELECT has_more FROM has_more) as has_more;
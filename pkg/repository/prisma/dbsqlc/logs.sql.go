@@ -11,6 +11,94 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const bulkCreateLogLines = `-- name: BulkCreateLogLines :execrows
+WITH unnested AS (
+    SELECT
+        unnest($3::timestamp[]) AS "createdAt",
+        unnest($4::uuid[]) AS "stepRunId",
+        unnest($5::text[]) AS "message",
+        unnest($6::"LogLineLevel"[]) AS "level",
+        unnest($7::jsonb[]) AS "metadata",
+        generate_series(1, cardinality($3::timestamp[])) AS "ord"
+), input AS (
+    SELECT
+        t."createdAt",
+        t."stepRunId",
+        t."message",
+        t."level",
+        t."metadata",
+        t."ord",
+        row_number() OVER (PARTITION BY t."stepRunId" ORDER BY t."ord") AS "position"
+    FROM
+        unnested t
+), step_runs AS (
+    SELECT
+        sr."id",
+        (
+            SELECT COUNT(*)
+            FROM "LogLine" ll
+            WHERE ll."tenantId" = $1::uuid AND ll."stepRunId" = sr."id"
+        ) AS "count"
+    FROM
+        "StepRun" sr
+    WHERE
+        sr."id" IN (SELECT DISTINCT "stepRunId" FROM input)
+        AND sr."tenantId" = $1::uuid
+)
+INSERT INTO "LogLine" (
+    "createdAt",
+    "tenantId",
+    "stepRunId",
+    "message",
+    "level",
+    "metadata"
+)
+SELECT
+    i."createdAt",
+    $1::uuid,
+    i."stepRunId",
+    i."message",
+    i."level",
+    coalesce(i."metadata", '{}'::jsonb)
+FROM
+    input i
+JOIN
+    step_runs sr ON sr."id" = i."stepRunId"
+WHERE
+    $2::int = 0
+    OR sr."count" + i."position" <= $2::int
+ORDER BY
+    i."ord"
+`
+
+type BulkCreateLogLinesParams struct {
+	Tenantid   pgtype.UUID        `json:"tenantid"`
+	Maxlines   int32              `json:"maxlines"`
+	Createdats []pgtype.Timestamp `json:"createdats"`
+	Steprunids []pgtype.UUID      `json:"steprunids"`
+	Messages   []string           `json:"messages"`
+	Levels     []LogLineLevel     `json:"levels"`
+	Metadatas  [][]byte           `json:"metadatas"`
+}
+
+// Creates log lines in the given order. Log lines of step runs which don't exist, or which would exceed the maximum
+// number of log lines of their step run, are dropped. If the maximum is 0, log lines are not limited.
+func (q *Queries) BulkCreateLogLines(ctx context.Context, db DBTX, arg BulkCreateLogLinesParams) (int64, error) {
+	result, err := db.Exec(ctx, bulkCreateLogLines,
+		arg.Tenantid,
+		arg.Maxlines,
+		arg.Createdats,
+		arg.Steprunids,
+		arg.Messages,
+		arg.Levels,
+		arg.Metadatas,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const countLogLines = `-- name: CountLogLines :one
 SELECT COUNT(*) AS total
 FROM "LogLine"
@@ -18,7 +106,8 @@ WHERE
   "tenantId" = $1::uuid AND
   ($2::uuid IS NULL OR "stepRunId" = $2::uuid) AND
   ($3::text IS NULL OR "message" LIKE concat('%', $3::text, '%')) AND
-  ($4::"LogLineLevel"[] IS NULL OR "level" = ANY($4::"LogLineLevel"[]))
+  ($4::"LogLineLevel"[] IS NULL OR "level" = ANY($4::"LogLineLevel"[])) AND
+  ($5::bigint IS NULL OR "id" > $5::bigint)
 `
 
 type CountLogLinesParams struct {
@@ -26,6 +115,7 @@ type CountLogLinesParams struct {
 	StepRunId pgtype.UUID    `json:"stepRunId"`
 	Search    pgtype.Text    `json:"search"`
 	Levels    []LogLineLevel `json:"levels"`
+	AfterId   pgtype.Int8    `json:"afterId"`
 }
 
 func (q *Queries) CountLogLines(ctx context.Context, db DBTX, arg CountLogLinesParams) (int64, error) {
@@ -34,6 +124,7 @@ func (q *Queries) CountLogLines(ctx context.Context, db DBTX, arg CountLogLinesP
 		arg.StepRunId,
 		arg.Search,
 		arg.Levels,
+		arg.AfterId,
 	)
 	var total int64
 	err := row.Scan(&total)
@@ -59,6 +150,10 @@ SELECT
 FROM "StepRun"
 WHERE "StepRun"."id" = $3::uuid
 AND "StepRun"."tenantId" = $2::uuid
+AND (
+    $7::int = 0 OR
+    (SELECT COUNT(*) FROM "LogLine" WHERE "tenantId" = $2::uuid AND "stepRunId" = $3::uuid) < $7::int
+)
 RETURNING id, "createdAt", "tenantId", "stepRunId", message, level, metadata
 `
 
@@ -69,6 +164,7 @@ type CreateLogLineParams struct {
 	Message   string           `json:"message"`
 	Level     NullLogLineLevel `json:"level"`
 	Metadata  []byte           `json:"metadata"`
+	Maxlines  int32            `json:"maxlines"`
 }
 
 func (q *Queries) CreateLogLine(ctx context.Context, db DBTX, arg CreateLogLineParams) (*LogLine, error) {
@@ -79,6 +175,7 @@ func (q *Queries) CreateLogLine(ctx context.Context, db DBTX, arg CreateLogLineP
 		arg.Message,
 		arg.Level,
 		arg.Metadata,
+		arg.Maxlines,
 	)
 	var i LogLine
 	err := row.Scan(
@@ -93,21 +190,68 @@ func (q *Queries) CreateLogLine(ctx context.Context, db DBTX, arg CreateLogLineP
 	return &i, err
 }
 
+const deleteExpiredLogLines = `-- name: DeleteExpiredLogLines :one
+WITH for_delete AS (
+    SELECT
+        "id"
+    FROM "LogLine" l
+    WHERE
+        l."tenantId" = $1::uuid AND
+        l."createdAt" < $2::timestamp
+    ORDER BY l."createdAt" ASC
+    LIMIT $3 +1
+    FOR UPDATE SKIP LOCKED
+),expired_with_limit AS (
+    SELECT
+        for_delete."id" as "id"
+    FROM for_delete
+    LIMIT $3
+), has_more AS (
+    SELECT
+        CASE
+            WHEN COUNT(*) > $3 THEN TRUE
+            ELSE FALSE
+        END as has_more
+    FROM for_delete
+)
+DELETE FROM
+    "LogLine"
+WHERE
+    "id" IN (SELECT "id" FROM expired_with_limit)
+RETURNING
+    (SELECT has_more FROM has_more) as has_more
+`
+
+type DeleteExpiredLogLinesParams struct {
+	Tenantid      pgtype.UUID      `json:"tenantid"`
+	Createdbefore pgtype.Timestamp `json:"createdbefore"`
+	Limit         interface{}      `json:"limit"`
+}
+
+func (q *Queries) DeleteExpiredLogLines(ctx context.Context, db DBTX, arg DeleteExpiredLogLinesParams) (bool, error) {
+	row := db.QueryRow(ctx, deleteExpiredLogLines, arg.Tenantid, arg.Createdbefore, arg.Limit)
+	var has_more bool
+	err := row.Scan(&has_more)
+	return has_more, err
+}
+
 const listLogLines = `-- name: ListLogLines :many
 SELECT id, "createdAt", "tenantId", "stepRunId", message, level, metadata FROM "LogLine"
 WHERE
   "tenantId" = $1::uuid AND
   ($2::uuid IS NULL OR "stepRunId" = $2::uuid) AND
   ($3::text IS NULL OR "message" LIKE concat('%', $3::text, '%')) AND
-  ($4::"LogLineLevel"[] IS NULL OR "level" = ANY($4::"LogLineLevel"[]))
+  ($4::"LogLineLevel"[] IS NULL OR "level" = ANY($4::"LogLineLevel"[])) AND
+  ($5::bigint IS NULL OR "id" > $5::bigint)
 ORDER BY
-  CASE WHEN $5::text = 'createdAt ASC' THEN "createdAt" END ASC,
-  CASE WHEN $5::text = 'createdAt DESC' THEN "createdAt" END DESC,
+  CASE WHEN $6::text = 'id ASC' THEN "id" END ASC,
+  CASE WHEN $6::text = 'createdAt ASC' THEN "createdAt" END ASC,
+  CASE WHEN $6::text = 'createdAt DESC' THEN "createdAt" END DESC,
   -- add order by id to make sure the order is deterministic
-  CASE WHEN $5::text = 'createdAt ASC' THEN "id" END ASC,
-  CASE WHEN $5::text = 'createdAt DESC' THEN "id" END DESC
-LIMIT COALESCE($7, 50)
-OFFSET COALESCE($6, 0)
+  CASE WHEN $6::text = 'createdAt ASC' THEN "id" END ASC,
+  CASE WHEN $6::text = 'createdAt DESC' THEN "id" END DESC
+LIMIT COALESCE($8, 50)
+OFFSET COALESCE($7, 0)
 `
 
 type ListLogLinesParams struct {
@@ -115,6 +259,7 @@ type ListLogLinesParams struct {
 	StepRunId pgtype.UUID    `json:"stepRunId"`
 	Search    pgtype.Text    `json:"search"`
 	Levels    []LogLineLevel `json:"levels"`
+	AfterId   pgtype.Int8    `json:"afterId"`
 	OrderBy   pgtype.Text    `json:"orderBy"`
 	Offset    interface{}    `json:"offset"`
 	Limit     interface{}    `json:"limit"`
@@ -126,6 +271,7 @@ func (q *Queries) ListLogLines(ctx context.Context, db DBTX, arg ListLogLinesPar
 		arg.StepRunId,
 		arg.Search,
 		arg.Levels,
+		arg.AfterId,
 		arg.OrderBy,
 		arg.Offset,
 		arg.Limit,
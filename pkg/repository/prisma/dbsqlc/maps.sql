@@ -0,0 +1,172 @@
+-- name: DeleteStepRunMapItems :exec
+-- Deletes the elements of a map step run, for example when the map step run is replayed. The step runs of the
+-- elements are kept.
+DELETE FROM
+    "StepRunMapItem"
+WHERE
+    "mapStepRunId" = @mapStepRunId::uuid
+    AND "tenantId" = @tenantId::uuid;
+
+-- name: CreateStepRunMapItems :exec
+-- Creates a step run for each element of a map step run. The step runs are copied from the map step run, and have
+-- no parents or children.
+WITH map_step_run AS (
+    SELECT
+        "jobRunId",
+        "stepId",
+        "queue",
+        "priority"
+    FROM
+        "StepRun"
+    WHERE
+        "id" = @mapStepRunId::uuid
+        AND "tenantId" = @tenantId::uuid
+), input AS (
+    SELECT
+        unnest(@stepRunIds::uuid[]) AS "stepRunId",
+        unnest(@indices::integer[]) AS "index",
+        unnest(@inputs::jsonb[]) AS "input"
+), step_runs AS (
+    INSERT INTO "StepRun" (
+        "id",
+        "createdAt",
+        "updatedAt",
+        "tenantId",
+        "jobRunId",
+        "stepId",
+        "status",
+        "requeueAfter",
+        "queue",
+        "priority",
+        "input"
+    )
+    SELECT
+        input."stepRunId",
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP,
+        @tenantId::uuid,
+        msr."jobRunId",
+        msr."stepId",
+        'PENDING',
+        CURRENT_TIMESTAMP + INTERVAL '5 seconds',
+        msr."queue",
+        msr."priority",
+        input."input"
+    FROM
+        input, map_step_run msr
+    RETURNING "id"
+)
+INSERT INTO "StepRunMapItem" (
+    "stepRunId",
+    "tenantId",
+    "mapStepRunId",
+    "index"
+)
+SELECT
+    input."stepRunId",
+    @tenantId::uuid,
+    @mapStepRunId::uuid,
+    input."index"
+FROM
+    input
+JOIN
+    step_runs sr ON sr."id" = input."stepRunId";
+
+-- name: GetStepRunMapItem :one
+SELECT
+    *
+FROM
+    "StepRunMapItem"
+WHERE
+    "stepRunId" = @stepRunId::uuid
+    AND "tenantId" = @tenantId::uuid;
+
+-- name: LockStepRunMapItems :many
+-- Locks the elements of a map step run, so that elements which finish at the same time are processed one by one.
+SELECT
+    "stepRunId"
+FROM
+    "StepRunMapItem"
+WHERE
+    "mapStepRunId" = @mapStepRunId::uuid
+ORDER BY
+    "index"
+FOR UPDATE;
+
+-- name: QueueStepRunMapItems :many
+-- Marks the next pending elements of a map step run as queued, in order of their index.
+WITH next_items AS (
+    SELECT
+        "stepRunId"
+    FROM
+        "StepRunMapItem"
+    WHERE
+        "mapStepRunId" = @mapStepRunId::uuid
+        AND "status" = 'PENDING'
+    ORDER BY
+        "index"
+    LIMIT
+        sqlc.arg('limit')::integer
+)
+UPDATE
+    "StepRunMapItem" mi
+SET
+    "status" = 'QUEUED'
+FROM
+    next_items
+WHERE
+    mi."stepRunId" = next_items."stepRunId"
+RETURNING
+    mi."stepRunId";
+
+-- name: FinishStepRunMapItem :one
+UPDATE
+    "StepRunMapItem"
+SET
+    "status" = 'SUCCEEDED',
+    "output" = @output::jsonb
+WHERE
+    "stepRunId" = @stepRunId::uuid
+    AND "tenantId" = @tenantId::uuid
+    AND "status" = 'QUEUED'
+RETURNING *;
+
+-- name: FailStepRunMapItem :one
+UPDATE
+    "StepRunMapItem"
+SET
+    "status" = 'FAILED'
+WHERE
+    "stepRunId" = @stepRunId::uuid
+    AND "tenantId" = @tenantId::uuid
+    AND "status" = 'QUEUED'
+RETURNING *;
+
+-- name: CountStepRunMapItems :one
+SELECT
+    COUNT(*) AS "total",
+    COUNT(*) FILTER (WHERE "status" = 'QUEUED') AS "queued",
+    COUNT(*) FILTER (WHERE "status" = 'SUCCEEDED') AS "succeeded"
+FROM
+    "StepRunMapItem"
+WHERE
+    "mapStepRunId" = @mapStepRunId::uuid;
+
+-- name: ListStepRunMapItemOutputs :many
+SELECT
+    "output"
+FROM
+    "StepRunMapItem"
+WHERE
+    "mapStepRunId" = @mapStepRunId::uuid
+ORDER BY
+    "index";
+
+-- name: ListUnfinishedStepRunMapItems :many
+SELECT
+    "stepRunId"
+FROM
+    "StepRunMapItem"
+WHERE
+    "mapStepRunId" = @mapStepRunId::uuid
+    AND "status" IN ('PENDING', 'QUEUED');
@@ -0,0 +1,97 @@
+-- name: CreateMessageQueueItem :one
+INSERT INTO "MessageQueueItem" (
+    "queueName",
+    "payload",
+    "expiresAt"
+)
+VALUES (
+    @queueName::text,
+    @payload::jsonb,
+    sqlc.narg('expiresAt')::timestamp
+)
+RETURNING "id";
+
+-- name: NotifyMessageQueue :exec
+-- Notifies the listeners of the message queue. Notifications are only sent once the transaction commits.
+SELECT pg_notify(@channel::text, @payload::text);
+
+-- name: ReadMessageQueueItems :many
+-- Reads the messages of a queue which are visible, oldest first, and hides them for the visibility timeout so other
+-- subscribers don't read them. Messages which aren't deleted before the timeout are read again.
+WITH items AS (
+    SELECT
+        "id"
+    FROM
+        "MessageQueueItem"
+    WHERE
+        "queueName" = @queueName::text
+        AND "readAfter" <= NOW()
+        AND ("expiresAt" IS NULL OR "expiresAt" > NOW())
+    ORDER BY
+        "id" ASC
+    LIMIT
+        sqlc.arg('limit')::int
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE
+    "MessageQueueItem" m
+SET
+    "readAfter" = NOW() + (sqlc.arg('visibilityTimeoutSeconds')::int * INTERVAL '1 second'),
+    "attempts" = m."attempts" + 1
+FROM
+    items
+WHERE
+    m."id" = items."id"
+RETURNING m.*;
+
+-- name: ListMessageQueueItemsByIds :many
+-- Lists messages which haven't expired without hiding them, which is how the messages of tenants are read by all
+-- of their subscribers.
+SELECT
+    *
+FROM
+    "MessageQueueItem"
+WHERE
+    "id" = ANY(@ids::bigint[])
+    AND ("expiresAt" IS NULL OR "expiresAt" > NOW());
+
+-- name: DelayMessageQueueItem :exec
+UPDATE
+    "MessageQueueItem"
+SET
+    "readAfter" = NOW() + (sqlc.arg('delaySeconds')::int * INTERVAL '1 second')
+WHERE
+    "id" = @id::bigint;
+
+-- name: DeleteMessageQueueItems :exec
+DELETE FROM
+    "MessageQueueItem"
+WHERE
+    "id" = ANY(@ids::bigint[]);
+
+-- name: DeleteExpiredMessageQueueItems :execrows
+DELETE FROM
+    "MessageQueueItem"
+WHERE
+    "expiresAt" <= NOW();
+
+-- name: GetLatestMessageQueueItemId :one
+SELECT
+    COALESCE(MAX("id"), 0)::bigint AS "id"
+FROM
+    "MessageQueueItem";
+
+-- name: ListMessageQueueItemsAfterId :many
+-- Lists the messages which were added after a message, which is how notifications are polled when the engine can't
+-- listen to them.
+SELECT
+    "id",
+    "queueName"
+FROM
+    "MessageQueueItem"
+WHERE
+    "id" > @afterId::bigint
+ORDER BY
+    "id" ASC
+LIMIT
+    sqlc.arg('limit')::int;
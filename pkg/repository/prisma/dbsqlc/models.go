@@ -11,6 +11,91 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type APITokenScope string
+
+const (
+	APITokenScopeADMIN    APITokenScope = "ADMIN"
+	APITokenScopeWORKER   APITokenScope = "WORKER"
+	APITokenScopeREADONLY APITokenScope = "READ_ONLY"
+)
+
+func (e *APITokenScope) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = APITokenScope(s)
+	case string:
+		*e = APITokenScope(s)
+	default:
+		return fmt.Errorf("unsupported scan type for APITokenScope: %T", src)
+	}
+	return nil
+}
+
+type NullAPITokenScope struct {
+	APITokenScope APITokenScope `json:"APITokenScope"`
+	Valid         bool          `json:"valid"` // Valid is true if APITokenScope is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAPITokenScope) Scan(value interface{}) error {
+	if value == nil {
+		ns.APITokenScope, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.APITokenScope.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAPITokenScope) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.APITokenScope), nil
+}
+
+type AuditLogActorKind string
+
+const (
+	AuditLogActorKindUSER     AuditLogActorKind = "USER"
+	AuditLogActorKindAPITOKEN AuditLogActorKind = "API_TOKEN"
+)
+
+func (e *AuditLogActorKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AuditLogActorKind(s)
+	case string:
+		*e = AuditLogActorKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AuditLogActorKind: %T", src)
+	}
+	return nil
+}
+
+type NullAuditLogActorKind struct {
+	AuditLogActorKind AuditLogActorKind `json:"AuditLogActorKind"`
+	Valid             bool              `json:"valid"` // Valid is true if AuditLogActorKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAuditLogActorKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.AuditLogActorKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AuditLogActorKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAuditLogActorKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AuditLogActorKind), nil
+}
+
 type ConcurrencyLimitStrategy string
 
 const (
@@ -18,6 +103,7 @@ const (
 	ConcurrencyLimitStrategyDROPNEWEST       ConcurrencyLimitStrategy = "DROP_NEWEST"
 	ConcurrencyLimitStrategyQUEUENEWEST      ConcurrencyLimitStrategy = "QUEUE_NEWEST"
 	ConcurrencyLimitStrategyGROUPROUNDROBIN  ConcurrencyLimitStrategy = "GROUP_ROUND_ROBIN"
+	ConcurrencyLimitStrategyQUEUENEWESTONLY  ConcurrencyLimitStrategy = "QUEUE_NEWEST_ONLY"
 )
 
 func (e *ConcurrencyLimitStrategy) Scan(src interface{}) error {
@@ -55,6 +141,177 @@ func (ns NullConcurrencyLimitStrategy) Value() (driver.Value, error) {
 	return string(ns.ConcurrencyLimitStrategy), nil
 }
 
+type EventSinkDeliveryStatus string
+
+const (
+	EventSinkDeliveryStatusPENDING EventSinkDeliveryStatus = "PENDING"
+	EventSinkDeliveryStatusDEAD    EventSinkDeliveryStatus = "DEAD"
+)
+
+func (e *EventSinkDeliveryStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EventSinkDeliveryStatus(s)
+	case string:
+		*e = EventSinkDeliveryStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EventSinkDeliveryStatus: %T", src)
+	}
+	return nil
+}
+
+type NullEventSinkDeliveryStatus struct {
+	EventSinkDeliveryStatus EventSinkDeliveryStatus `json:"EventSinkDeliveryStatus"`
+	Valid                   bool                    `json:"valid"` // Valid is true if EventSinkDeliveryStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEventSinkDeliveryStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.EventSinkDeliveryStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EventSinkDeliveryStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEventSinkDeliveryStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EventSinkDeliveryStatus), nil
+}
+
+type EventSinkFormat string
+
+const (
+	EventSinkFormatHATCHET     EventSinkFormat = "HATCHET"
+	EventSinkFormatCLOUDEVENTS EventSinkFormat = "CLOUDEVENTS"
+)
+
+func (e *EventSinkFormat) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EventSinkFormat(s)
+	case string:
+		*e = EventSinkFormat(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EventSinkFormat: %T", src)
+	}
+	return nil
+}
+
+type NullEventSinkFormat struct {
+	EventSinkFormat EventSinkFormat `json:"EventSinkFormat"`
+	Valid           bool            `json:"valid"` // Valid is true if EventSinkFormat is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEventSinkFormat) Scan(value interface{}) error {
+	if value == nil {
+		ns.EventSinkFormat, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EventSinkFormat.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEventSinkFormat) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EventSinkFormat), nil
+}
+
+type EventSinkKind string
+
+const (
+	EventSinkKindKAFKA   EventSinkKind = "KAFKA"
+	EventSinkKindWEBHOOK EventSinkKind = "WEBHOOK"
+	EventSinkKindNATS    EventSinkKind = "NATS"
+)
+
+func (e *EventSinkKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EventSinkKind(s)
+	case string:
+		*e = EventSinkKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EventSinkKind: %T", src)
+	}
+	return nil
+}
+
+type NullEventSinkKind struct {
+	EventSinkKind EventSinkKind `json:"EventSinkKind"`
+	Valid         bool          `json:"valid"` // Valid is true if EventSinkKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEventSinkKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.EventSinkKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EventSinkKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEventSinkKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EventSinkKind), nil
+}
+
+type InboundWebhookSignatureScheme string
+
+const (
+	InboundWebhookSignatureSchemeHMACSHA256 InboundWebhookSignatureScheme = "HMAC_SHA256"
+	InboundWebhookSignatureSchemeGITHUB     InboundWebhookSignatureScheme = "GITHUB"
+	InboundWebhookSignatureSchemeSTRIPE     InboundWebhookSignatureScheme = "STRIPE"
+	InboundWebhookSignatureSchemeSVIX       InboundWebhookSignatureScheme = "SVIX"
+)
+
+func (e *InboundWebhookSignatureScheme) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = InboundWebhookSignatureScheme(s)
+	case string:
+		*e = InboundWebhookSignatureScheme(s)
+	default:
+		return fmt.Errorf("unsupported scan type for InboundWebhookSignatureScheme: %T", src)
+	}
+	return nil
+}
+
+type NullInboundWebhookSignatureScheme struct {
+	InboundWebhookSignatureScheme InboundWebhookSignatureScheme `json:"InboundWebhookSignatureScheme"`
+	Valid                         bool                          `json:"valid"` // Valid is true if InboundWebhookSignatureScheme is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullInboundWebhookSignatureScheme) Scan(value interface{}) error {
+	if value == nil {
+		ns.InboundWebhookSignatureScheme, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.InboundWebhookSignatureScheme.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullInboundWebhookSignatureScheme) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.InboundWebhookSignatureScheme), nil
+}
+
 type InternalQueue string
 
 const (
@@ -361,6 +618,135 @@ func (ns NullLogLineLevel) Value() (driver.Value, error) {
 	return string(ns.LogLineLevel), nil
 }
 
+type RateLimitAlgorithm string
+
+const (
+	RateLimitAlgorithmFIXEDWINDOW   RateLimitAlgorithm = "FIXED_WINDOW"
+	RateLimitAlgorithmSLIDINGWINDOW RateLimitAlgorithm = "SLIDING_WINDOW"
+	RateLimitAlgorithmTOKENBUCKET   RateLimitAlgorithm = "TOKEN_BUCKET"
+	RateLimitAlgorithmLEAKYBUCKET   RateLimitAlgorithm = "LEAKY_BUCKET"
+)
+
+func (e *RateLimitAlgorithm) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RateLimitAlgorithm(s)
+	case string:
+		*e = RateLimitAlgorithm(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RateLimitAlgorithm: %T", src)
+	}
+	return nil
+}
+
+type NullRateLimitAlgorithm struct {
+	RateLimitAlgorithm RateLimitAlgorithm `json:"RateLimitAlgorithm"`
+	Valid              bool               `json:"valid"` // Valid is true if RateLimitAlgorithm is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRateLimitAlgorithm) Scan(value interface{}) error {
+	if value == nil {
+		ns.RateLimitAlgorithm, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RateLimitAlgorithm.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRateLimitAlgorithm) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RateLimitAlgorithm), nil
+}
+
+type RegionStrategy string
+
+const (
+	RegionStrategyPIN    RegionStrategy = "PIN"
+	RegionStrategyPREFER RegionStrategy = "PREFER"
+)
+
+func (e *RegionStrategy) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RegionStrategy(s)
+	case string:
+		*e = RegionStrategy(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RegionStrategy: %T", src)
+	}
+	return nil
+}
+
+type NullRegionStrategy struct {
+	RegionStrategy RegionStrategy `json:"RegionStrategy"`
+	Valid          bool           `json:"valid"` // Valid is true if RegionStrategy is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRegionStrategy) Scan(value interface{}) error {
+	if value == nil {
+		ns.RegionStrategy, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RegionStrategy.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRegionStrategy) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RegionStrategy), nil
+}
+
+type SchedulingDecisionOutcome string
+
+const (
+	SchedulingDecisionOutcomeASSIGNED    SchedulingDecisionOutcome = "ASSIGNED"
+	SchedulingDecisionOutcomeNOSLOTS     SchedulingDecisionOutcome = "NO_SLOTS"
+	SchedulingDecisionOutcomeRATELIMITED SchedulingDecisionOutcome = "RATE_LIMITED"
+)
+
+func (e *SchedulingDecisionOutcome) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SchedulingDecisionOutcome(s)
+	case string:
+		*e = SchedulingDecisionOutcome(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SchedulingDecisionOutcome: %T", src)
+	}
+	return nil
+}
+
+type NullSchedulingDecisionOutcome struct {
+	SchedulingDecisionOutcome SchedulingDecisionOutcome `json:"SchedulingDecisionOutcome"`
+	Valid                     bool                      `json:"valid"` // Valid is true if SchedulingDecisionOutcome is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSchedulingDecisionOutcome) Scan(value interface{}) error {
+	if value == nil {
+		ns.SchedulingDecisionOutcome, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SchedulingDecisionOutcome.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSchedulingDecisionOutcome) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SchedulingDecisionOutcome), nil
+}
+
 type StepExpressionKind string
 
 const (
@@ -447,6 +833,50 @@ func (ns NullStepRateLimitKind) Value() (driver.Value, error) {
 	return string(ns.StepRateLimitKind), nil
 }
 
+type StepRunApprovalStatus string
+
+const (
+	StepRunApprovalStatusPENDING  StepRunApprovalStatus = "PENDING"
+	StepRunApprovalStatusAPPROVED StepRunApprovalStatus = "APPROVED"
+	StepRunApprovalStatusREJECTED StepRunApprovalStatus = "REJECTED"
+	StepRunApprovalStatusTIMEDOUT StepRunApprovalStatus = "TIMED_OUT"
+)
+
+func (e *StepRunApprovalStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StepRunApprovalStatus(s)
+	case string:
+		*e = StepRunApprovalStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StepRunApprovalStatus: %T", src)
+	}
+	return nil
+}
+
+type NullStepRunApprovalStatus struct {
+	StepRunApprovalStatus StepRunApprovalStatus `json:"StepRunApprovalStatus"`
+	Valid                 bool                  `json:"valid"` // Valid is true if StepRunApprovalStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStepRunApprovalStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.StepRunApprovalStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StepRunApprovalStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStepRunApprovalStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StepRunApprovalStatus), nil
+}
+
 type StepRunEventReason string
 
 const (
@@ -469,6 +899,7 @@ const (
 	StepRunEventReasonWORKFLOWRUNGROUPKEYFAILED    StepRunEventReason = "WORKFLOW_RUN_GROUP_KEY_FAILED"
 	StepRunEventReasonRATELIMITERROR               StepRunEventReason = "RATE_LIMIT_ERROR"
 	StepRunEventReasonACKNOWLEDGED                 StepRunEventReason = "ACKNOWLEDGED"
+	StepRunEventReasonSPECULATIVEATTEMPT           StepRunEventReason = "SPECULATIVE_ATTEMPT"
 )
 
 func (e *StepRunEventReason) Scan(src interface{}) error {
@@ -549,6 +980,50 @@ func (ns NullStepRunEventSeverity) Value() (driver.Value, error) {
 	return string(ns.StepRunEventSeverity), nil
 }
 
+type StepRunMapItemStatus string
+
+const (
+	StepRunMapItemStatusPENDING   StepRunMapItemStatus = "PENDING"
+	StepRunMapItemStatusQUEUED    StepRunMapItemStatus = "QUEUED"
+	StepRunMapItemStatusSUCCEEDED StepRunMapItemStatus = "SUCCEEDED"
+	StepRunMapItemStatusFAILED    StepRunMapItemStatus = "FAILED"
+)
+
+func (e *StepRunMapItemStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StepRunMapItemStatus(s)
+	case string:
+		*e = StepRunMapItemStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StepRunMapItemStatus: %T", src)
+	}
+	return nil
+}
+
+type NullStepRunMapItemStatus struct {
+	StepRunMapItemStatus StepRunMapItemStatus `json:"StepRunMapItemStatus"`
+	Valid                bool                 `json:"valid"` // Valid is true if StepRunMapItemStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStepRunMapItemStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.StepRunMapItemStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StepRunMapItemStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStepRunMapItemStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StepRunMapItemStatus), nil
+}
+
 type StepRunStatus string
 
 const (
@@ -560,6 +1035,7 @@ const (
 	StepRunStatusFAILED            StepRunStatus = "FAILED"
 	StepRunStatusCANCELLED         StepRunStatus = "CANCELLED"
 	StepRunStatusCANCELLING        StepRunStatus = "CANCELLING"
+	StepRunStatusSKIPPED           StepRunStatus = "SKIPPED"
 )
 
 func (e *StepRunStatus) Scan(src interface{}) error {
@@ -992,6 +1468,7 @@ const (
 	WorkflowRunStatusSUCCEEDED WorkflowRunStatus = "SUCCEEDED"
 	WorkflowRunStatusFAILED    WorkflowRunStatus = "FAILED"
 	WorkflowRunStatusQUEUED    WorkflowRunStatus = "QUEUED"
+	WorkflowRunStatusPAUSED    WorkflowRunStatus = "PAUSED"
 )
 
 func (e *WorkflowRunStatus) Scan(src interface{}) error {
@@ -1123,6 +1600,8 @@ type APIToken struct {
 	TenantId    pgtype.UUID      `json:"tenantId"`
 	NextAlertAt pgtype.Timestamp `json:"nextAlertAt"`
 	Internal    bool             `json:"internal"`
+	Scope       APITokenScope    `json:"scope"`
+	RotatedAt   pgtype.Timestamp `json:"rotatedAt"`
 }
 
 type Action struct {
@@ -1137,6 +1616,23 @@ type ActionToWorker struct {
 	A pgtype.UUID `json:"A"`
 }
 
+type AuditLog struct {
+	ID           pgtype.UUID       `json:"id"`
+	CreatedAt    pgtype.Timestamp  `json:"createdAt"`
+	TenantId     pgtype.UUID       `json:"tenantId"`
+	ActorKind    AuditLogActorKind `json:"actorKind"`
+	ActorId      pgtype.UUID       `json:"actorId"`
+	ActorName    pgtype.Text       `json:"actorName"`
+	Operation    string            `json:"operation"`
+	Method       string            `json:"method"`
+	Path         string            `json:"path"`
+	ResourceType pgtype.Text       `json:"resourceType"`
+	ResourceId   pgtype.Text       `json:"resourceId"`
+	StatusCode   int32             `json:"statusCode"`
+	IpAddress    pgtype.Text       `json:"ipAddress"`
+	UserAgent    pgtype.Text       `json:"userAgent"`
+}
+
 type ControllerPartition struct {
 	ID            string           `json:"id"`
 	CreatedAt     pgtype.Timestamp `json:"createdAt"`
@@ -1145,6 +1641,38 @@ type ControllerPartition struct {
 	Name          pgtype.Text      `json:"name"`
 }
 
+type CronExclusionCalendar struct {
+	ID        pgtype.UUID      `json:"id"`
+	CreatedAt pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt pgtype.Timestamp `json:"updatedAt"`
+	TenantId  pgtype.UUID      `json:"tenantId"`
+	Name      string           `json:"name"`
+	Dates     []pgtype.Date    `json:"dates"`
+	IcalUrl   pgtype.Text      `json:"icalUrl"`
+}
+
+type DeadLetterQueueItem struct {
+	ID            pgtype.UUID      `json:"id"`
+	CreatedAt     pgtype.Timestamp `json:"createdAt"`
+	TenantId      pgtype.UUID      `json:"tenantId"`
+	StepRunId     pgtype.UUID      `json:"stepRunId"`
+	StepId        pgtype.UUID      `json:"stepId"`
+	WorkflowRunId pgtype.UUID      `json:"workflowRunId"`
+	RetryCount    int32            `json:"retryCount"`
+	Input         []byte           `json:"input"`
+	Error         pgtype.Text      `json:"error"`
+	ErrorChain    []string         `json:"errorChain"`
+}
+
+type DelayedEvent struct {
+	EventId           pgtype.UUID      `json:"eventId"`
+	TenantId          pgtype.UUID      `json:"tenantId"`
+	DeliverAfter      pgtype.Timestamp `json:"deliverAfter"`
+	IdempotencyKey    pgtype.Text      `json:"idempotencyKey"`
+	RoutedWorkflowIds []pgtype.UUID    `json:"routedWorkflowIds"`
+	TickerId          pgtype.UUID      `json:"tickerId"`
+}
+
 type Dispatcher struct {
 	ID              pgtype.UUID      `json:"id"`
 	CreatedAt       pgtype.Timestamp `json:"createdAt"`
@@ -1154,6 +1682,12 @@ type Dispatcher struct {
 	IsActive        bool             `json:"isActive"`
 }
 
+type EngineLease struct {
+	Name      string           `json:"name"`
+	HolderId  string           `json:"holderId"`
+	ExpiresAt pgtype.Timestamp `json:"expiresAt"`
+}
+
 type Event struct {
 	ID                 pgtype.UUID      `json:"id"`
 	CreatedAt          pgtype.Timestamp `json:"createdAt"`
@@ -1167,12 +1701,73 @@ type Event struct {
 	InsertOrder        pgtype.Int4      `json:"insertOrder"`
 }
 
+type EventDedupKey struct {
+	TenantId  pgtype.UUID      `json:"tenantId"`
+	EventKey  string           `json:"eventKey"`
+	DedupKey  string           `json:"dedupKey"`
+	EventId   pgtype.UUID      `json:"eventId"`
+	CreatedAt pgtype.Timestamp `json:"createdAt"`
+	ExpiresAt pgtype.Timestamp `json:"expiresAt"`
+}
+
+type EventDedupRule struct {
+	ID            pgtype.UUID      `json:"id"`
+	CreatedAt     pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt     pgtype.Timestamp `json:"updatedAt"`
+	TenantId      pgtype.UUID      `json:"tenantId"`
+	EventKey      string           `json:"eventKey"`
+	Expression    string           `json:"expression"`
+	WindowSeconds int32            `json:"windowSeconds"`
+}
+
 type EventKey struct {
 	Key      string      `json:"key"`
 	TenantId pgtype.UUID `json:"tenantId"`
 	ID       int64       `json:"id"`
 }
 
+type EventRoutingRule struct {
+	ID         pgtype.UUID      `json:"id"`
+	CreatedAt  pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt  pgtype.Timestamp `json:"updatedAt"`
+	TenantId   pgtype.UUID      `json:"tenantId"`
+	Name       string           `json:"name"`
+	EventKey   pgtype.Text      `json:"eventKey"`
+	Expression string           `json:"expression"`
+	WorkflowId pgtype.UUID      `json:"workflowId"`
+}
+
+type EventSink struct {
+	ID             pgtype.UUID      `json:"id"`
+	CreatedAt      pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt      pgtype.Timestamp `json:"updatedAt"`
+	TenantId       pgtype.UUID      `json:"tenantId"`
+	Name           string           `json:"name"`
+	Kind           EventSinkKind    `json:"kind"`
+	Url            pgtype.Text      `json:"url"`
+	Brokers        []string         `json:"brokers"`
+	Topic          pgtype.Text      `json:"topic"`
+	Tls            bool             `json:"tls"`
+	Secret         pgtype.Text      `json:"secret"`
+	UsernameSecret pgtype.Text      `json:"usernameSecret"`
+	PasswordSecret pgtype.Text      `json:"passwordSecret"`
+	EventTypes     []string         `json:"eventTypes"`
+	MaxAttempts    int32            `json:"maxAttempts"`
+	Format         EventSinkFormat  `json:"format"`
+}
+
+type EventSinkDelivery struct {
+	ID            int64                   `json:"id"`
+	CreatedAt     pgtype.Timestamp        `json:"createdAt"`
+	SinkId        pgtype.UUID             `json:"sinkId"`
+	TenantId      pgtype.UUID             `json:"tenantId"`
+	Payload       []byte                  `json:"payload"`
+	Status        EventSinkDeliveryStatus `json:"status"`
+	Attempts      int32                   `json:"attempts"`
+	NextAttemptAt pgtype.Timestamp        `json:"nextAttemptAt"`
+	LastError     pgtype.Text             `json:"lastError"`
+}
+
 type GetGroupKeyRun struct {
 	ID                pgtype.UUID      `json:"id"`
 	CreatedAt         pgtype.Timestamp `json:"createdAt"`
@@ -1196,6 +1791,28 @@ type GetGroupKeyRun struct {
 	ScheduleTimeoutAt pgtype.Timestamp `json:"scheduleTimeoutAt"`
 }
 
+type GlobalRateLimit struct {
+	Key        string           `json:"key"`
+	LimitValue int32            `json:"limitValue"`
+	Value      int32            `json:"value"`
+	Window     string           `json:"window"`
+	LastRefill pgtype.Timestamp `json:"lastRefill"`
+}
+
+type InboundWebhook struct {
+	ID                 pgtype.UUID                   `json:"id"`
+	CreatedAt          pgtype.Timestamp              `json:"createdAt"`
+	UpdatedAt          pgtype.Timestamp              `json:"updatedAt"`
+	TenantId           pgtype.UUID                   `json:"tenantId"`
+	Name               string                        `json:"name"`
+	SignatureScheme    InboundWebhookSignatureScheme `json:"signatureScheme"`
+	SignatureHeader    pgtype.Text                   `json:"signatureHeader"`
+	Secret             string                        `json:"secret"`
+	EventKey           pgtype.Text                   `json:"eventKey"`
+	EventKeyExpression pgtype.Text                   `json:"eventKeyExpression"`
+	DataExpression     pgtype.Text                   `json:"dataExpression"`
+}
+
 type InternalQueueItem struct {
 	ID        int64         `json:"id"`
 	Queue     InternalQueue `json:"queue"`
@@ -1248,12 +1865,32 @@ type JobRunLookupData struct {
 	Data      []byte           `json:"data"`
 }
 
-type Lease struct {
+type JobRunLookupDataEntry struct {
 	ID         int64            `json:"id"`
-	ExpiresAt  pgtype.Timestamp `json:"expiresAt"`
+	JobRunId   pgtype.UUID      `json:"jobRunId"`
 	TenantId   pgtype.UUID      `json:"tenantId"`
-	ResourceId string           `json:"resourceId"`
-	Kind       LeaseKind        `json:"kind"`
+	ReadableId string           `json:"readableId"`
+	Data       []byte           `json:"data"`
+	CreatedAt  pgtype.Timestamp `json:"createdAt"`
+}
+
+type KafkaPartitionOffset struct {
+	ConsumerGroup  string           `json:"consumerGroup"`
+	Topic          string           `json:"topic"`
+	Partition      int32            `json:"partition"`
+	Offset         pgtype.Int8      `json:"offset"`
+	LeaseOwner     pgtype.UUID      `json:"leaseOwner"`
+	LeaseExpiresAt pgtype.Timestamp `json:"leaseExpiresAt"`
+	UpdatedAt      pgtype.Timestamp `json:"updatedAt"`
+}
+
+type Lease struct {
+	ID           int64            `json:"id"`
+	ExpiresAt    pgtype.Timestamp `json:"expiresAt"`
+	TenantId     pgtype.UUID      `json:"tenantId"`
+	ResourceId   string           `json:"resourceId"`
+	Kind         LeaseKind        `json:"kind"`
+	FencingToken int64            `json:"fencingToken"`
 }
 
 type LogLine struct {
@@ -1266,11 +1903,21 @@ type LogLine struct {
 	Metadata  []byte           `json:"metadata"`
 }
 
+type MessageQueueItem struct {
+	ID        int64            `json:"id"`
+	QueueName string           `json:"queueName"`
+	Payload   []byte           `json:"payload"`
+	ReadAfter pgtype.Timestamp `json:"readAfter"`
+	ExpiresAt pgtype.Timestamp `json:"expiresAt"`
+	Attempts  int32            `json:"attempts"`
+}
+
 type Queue struct {
 	ID         int64            `json:"id"`
 	TenantId   pgtype.UUID      `json:"tenantId"`
 	Name       string           `json:"name"`
 	LastActive pgtype.Timestamp `json:"lastActive"`
+	IsPaused   bool             `json:"isPaused"`
 }
 
 type QueueItem struct {
@@ -1286,15 +1933,32 @@ type QueueItem struct {
 	Queue             string             `json:"queue"`
 	Sticky            NullStickyStrategy `json:"sticky"`
 	DesiredWorkerId   pgtype.UUID        `json:"desiredWorkerId"`
+	Deadline          pgtype.Timestamp   `json:"deadline"`
+	CreatedAt         pgtype.Timestamp   `json:"createdAt"`
+}
+
+type QueueMetricsSnapshot struct {
+	ID        int64            `json:"id"`
+	TenantId  pgtype.UUID      `json:"tenantId"`
+	Queue     string           `json:"queue"`
+	CreatedAt pgtype.Timestamp `json:"createdAt"`
+	Depth     int32            `json:"depth"`
+	LatencyMs int64            `json:"latencyMs"`
+	Slots     int32            `json:"slots"`
+	UsedSlots int32            `json:"usedSlots"`
 }
 
 type RateLimit struct {
-	TenantId   pgtype.UUID      `json:"tenantId"`
-	Key        string           `json:"key"`
-	LimitValue int32            `json:"limitValue"`
-	Value      int32            `json:"value"`
-	Window     string           `json:"window"`
-	LastRefill pgtype.Timestamp `json:"lastRefill"`
+	TenantId       pgtype.UUID        `json:"tenantId"`
+	Key            string             `json:"key"`
+	LimitValue     int32              `json:"limitValue"`
+	Value          int32              `json:"value"`
+	Window         string             `json:"window"`
+	LastRefill     pgtype.Timestamp   `json:"lastRefill"`
+	Algorithm      RateLimitAlgorithm `json:"algorithm"`
+	Burst          pgtype.Int4        `json:"burst"`
+	PrevWindowUsed int32              `json:"prevWindowUsed"`
+	ExpiresAt      pgtype.Timestamp   `json:"expiresAt"`
 }
 
 type RetryQueueItem struct {
@@ -1313,6 +1977,19 @@ type SNSIntegration struct {
 	TopicArn  string           `json:"topicArn"`
 }
 
+type SQSIntegration struct {
+	ID                    pgtype.UUID      `json:"id"`
+	CreatedAt             pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt             pgtype.Timestamp `json:"updatedAt"`
+	TenantId              pgtype.UUID      `json:"tenantId"`
+	QueueUrl              string           `json:"queueUrl"`
+	Region                string           `json:"region"`
+	EventKey              string           `json:"eventKey"`
+	AccessKeyIdSecret     string           `json:"accessKeyIdSecret"`
+	SecretAccessKeySecret string           `json:"secretAccessKeySecret"`
+	SessionTokenSecret    pgtype.Text      `json:"sessionTokenSecret"`
+}
+
 type SchedulerPartition struct {
 	ID            string           `json:"id"`
 	CreatedAt     pgtype.Timestamp `json:"createdAt"`
@@ -1321,6 +1998,18 @@ type SchedulerPartition struct {
 	Name          pgtype.Text      `json:"name"`
 }
 
+type SchedulingDecision struct {
+	ID        int64                     `json:"id"`
+	CreatedAt pgtype.Timestamp          `json:"createdAt"`
+	TenantId  pgtype.UUID               `json:"tenantId"`
+	StepRunId pgtype.UUID               `json:"stepRunId"`
+	Queue     string                    `json:"queue"`
+	Outcome   SchedulingDecisionOutcome `json:"outcome"`
+	WorkerId  pgtype.UUID               `json:"workerId"`
+	Message   string                    `json:"message"`
+	Data      []byte                    `json:"data"`
+}
+
 type SecurityCheckIdent struct {
 	ID pgtype.UUID `json:"id"`
 }
@@ -1329,6 +2018,7 @@ type SemaphoreQueueItem struct {
 	StepRunId pgtype.UUID `json:"stepRunId"`
 	WorkerId  pgtype.UUID `json:"workerId"`
 	TenantId  pgtype.UUID `json:"tenantId"`
+	SlotType  string      `json:"slotType"`
 }
 
 type Service struct {
@@ -1359,21 +2049,43 @@ type SlackAppWebhook struct {
 	WebhookURL  []byte           `json:"webhookURL"`
 }
 
+type SlotReservation struct {
+	WorkflowRunId pgtype.UUID      `json:"workflowRunId"`
+	TenantId      pgtype.UUID      `json:"tenantId"`
+	Slots         int32            `json:"slots"`
+	ExpiresAt     pgtype.Timestamp `json:"expiresAt"`
+	CreatedAt     pgtype.Timestamp `json:"createdAt"`
+}
+
 type Step struct {
-	ID                 pgtype.UUID      `json:"id"`
-	CreatedAt          pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt          pgtype.Timestamp `json:"updatedAt"`
-	DeletedAt          pgtype.Timestamp `json:"deletedAt"`
-	ReadableId         pgtype.Text      `json:"readableId"`
-	TenantId           pgtype.UUID      `json:"tenantId"`
-	JobId              pgtype.UUID      `json:"jobId"`
-	ActionId           string           `json:"actionId"`
-	Timeout            pgtype.Text      `json:"timeout"`
-	CustomUserData     []byte           `json:"customUserData"`
-	Retries            int32            `json:"retries"`
-	RetryBackoffFactor pgtype.Float8    `json:"retryBackoffFactor"`
-	RetryMaxBackoff    pgtype.Int4      `json:"retryMaxBackoff"`
-	ScheduleTimeout    string           `json:"scheduleTimeout"`
+	ID                      pgtype.UUID      `json:"id"`
+	CreatedAt               pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt               pgtype.Timestamp `json:"updatedAt"`
+	DeletedAt               pgtype.Timestamp `json:"deletedAt"`
+	ReadableId              pgtype.Text      `json:"readableId"`
+	TenantId                pgtype.UUID      `json:"tenantId"`
+	JobId                   pgtype.UUID      `json:"jobId"`
+	ActionId                string           `json:"actionId"`
+	Timeout                 pgtype.Text      `json:"timeout"`
+	CustomUserData          []byte           `json:"customUserData"`
+	Retries                 int32            `json:"retries"`
+	RetryBackoffFactor      pgtype.Float8    `json:"retryBackoffFactor"`
+	RetryMaxBackoff         pgtype.Int4      `json:"retryMaxBackoff"`
+	ScheduleTimeout         string           `json:"scheduleTimeout"`
+	SpeculativePercentile   pgtype.Int4      `json:"speculativePercentile"`
+	SlotType                pgtype.Text      `json:"slotType"`
+	HeartbeatTimeout        pgtype.Text      `json:"heartbeatTimeout"`
+	RetryInitialBackoff     pgtype.Int4      `json:"retryInitialBackoff"`
+	RetryJitter             pgtype.Float8    `json:"retryJitter"`
+	SleepFor                pgtype.Text      `json:"sleepFor"`
+	SleepUntil              pgtype.Text      `json:"sleepUntil"`
+	WaitForEvent            pgtype.Text      `json:"waitForEvent"`
+	WaitForEventCorrelation pgtype.Text      `json:"waitForEventCorrelation"`
+	Condition               pgtype.Text      `json:"condition"`
+	MapOver                 pgtype.Text      `json:"mapOver"`
+	MapConcurrency          pgtype.Int4      `json:"mapConcurrency"`
+	CacheTtl                pgtype.Text      `json:"cacheTtl"`
+	Pool                    pgtype.Text      `json:"pool"`
 }
 
 type StepDesiredWorkerLabel struct {
@@ -1396,6 +2108,13 @@ type StepExpression struct {
 	Kind       StepExpressionKind `json:"kind"`
 }
 
+type StepGlobalRateLimit struct {
+	Units        int32       `json:"units"`
+	StepId       pgtype.UUID `json:"stepId"`
+	RateLimitKey string      `json:"rateLimitKey"`
+	TenantId     pgtype.UUID `json:"tenantId"`
+}
+
 type StepOrder struct {
 	A pgtype.UUID `json:"A"`
 	B pgtype.UUID `json:"B"`
@@ -1440,6 +2159,28 @@ type StepRun struct {
 	Queue              string           `json:"queue"`
 	Priority           pgtype.Int4      `json:"priority"`
 	InternalRetryCount int32            `json:"internalRetryCount"`
+	Deadline           pgtype.Timestamp `json:"deadline"`
+}
+
+type StepRunAck struct {
+	StepRunId pgtype.UUID      `json:"stepRunId"`
+	AttemptId string           `json:"attemptId"`
+	TenantId  pgtype.UUID      `json:"tenantId"`
+	EventType string           `json:"eventType"`
+	CreatedAt pgtype.Timestamp `json:"createdAt"`
+}
+
+type StepRunApproval struct {
+	ID            pgtype.UUID           `json:"id"`
+	CreatedAt     pgtype.Timestamp      `json:"createdAt"`
+	UpdatedAt     pgtype.Timestamp      `json:"updatedAt"`
+	TenantId      pgtype.UUID           `json:"tenantId"`
+	StepRunId     pgtype.UUID           `json:"stepRunId"`
+	WorkflowRunId pgtype.UUID           `json:"workflowRunId"`
+	Token         string                `json:"token"`
+	Status        StepRunApprovalStatus `json:"status"`
+	Payload       []byte                `json:"payload"`
+	DecidedAt     pgtype.Timestamp      `json:"decidedAt"`
 }
 
 type StepRunEvent struct {
@@ -1463,11 +2204,37 @@ type StepRunExpressionEval struct {
 	Kind      StepExpressionKind `json:"kind"`
 }
 
+type StepRunHeartbeat struct {
+	StepRunId       pgtype.UUID      `json:"stepRunId"`
+	TenantId        pgtype.UUID      `json:"tenantId"`
+	WorkerId        pgtype.UUID      `json:"workerId"`
+	LastHeartbeatAt pgtype.Timestamp `json:"lastHeartbeatAt"`
+}
+
+type StepRunMapItem struct {
+	StepRunId    pgtype.UUID          `json:"stepRunId"`
+	CreatedAt    pgtype.Timestamp     `json:"createdAt"`
+	TenantId     pgtype.UUID          `json:"tenantId"`
+	MapStepRunId pgtype.UUID          `json:"mapStepRunId"`
+	Index        int32                `json:"index"`
+	Status       StepRunMapItemStatus `json:"status"`
+	Output       []byte               `json:"output"`
+}
+
 type StepRunOrder struct {
 	A pgtype.UUID `json:"A"`
 	B pgtype.UUID `json:"B"`
 }
 
+type StepRunProgress struct {
+	StepRunId pgtype.UUID      `json:"stepRunId"`
+	TenantId  pgtype.UUID      `json:"tenantId"`
+	Percent   float64          `json:"percent"`
+	Message   pgtype.Text      `json:"message"`
+	Data      []byte           `json:"data"`
+	UpdatedAt pgtype.Timestamp `json:"updatedAt"`
+}
+
 type StepRunResultArchive struct {
 	ID              pgtype.UUID      `json:"id"`
 	CreatedAt       pgtype.Timestamp `json:"createdAt"`
@@ -1487,6 +2254,43 @@ type StepRunResultArchive struct {
 	RetryCount      int32            `json:"retryCount"`
 }
 
+type StepRunResultCache struct {
+	TenantId  pgtype.UUID      `json:"tenantId"`
+	ActionId  string           `json:"actionId"`
+	InputHash string           `json:"inputHash"`
+	Output    []byte           `json:"output"`
+	StepRunId pgtype.UUID      `json:"stepRunId"`
+	CreatedAt pgtype.Timestamp `json:"createdAt"`
+	ExpiresAt pgtype.Timestamp `json:"expiresAt"`
+}
+
+type StepRunSignalWait struct {
+	StepRunId        pgtype.UUID      `json:"stepRunId"`
+	CreatedAt        pgtype.Timestamp `json:"createdAt"`
+	TenantId         pgtype.UUID      `json:"tenantId"`
+	EventKey         string           `json:"eventKey"`
+	CorrelationExpr  pgtype.Text      `json:"correlationExpr"`
+	CorrelationValue pgtype.Text      `json:"correlationValue"`
+}
+
+type StepRunSpeculativeAttempt struct {
+	StepRunId       pgtype.UUID      `json:"stepRunId"`
+	RetryCount      int32            `json:"retryCount"`
+	TenantId        pgtype.UUID      `json:"tenantId"`
+	CreatedAt       pgtype.Timestamp `json:"createdAt"`
+	PrimaryWorkerId pgtype.UUID      `json:"primaryWorkerId"`
+	WorkerId        pgtype.UUID      `json:"workerId"`
+	WinnerWorkerId  pgtype.UUID      `json:"winnerWorkerId"`
+	FailedAttempts  int32            `json:"failedAttempts"`
+}
+
+type StepRunTimer struct {
+	StepRunId pgtype.UUID      `json:"stepRunId"`
+	TenantId  pgtype.UUID      `json:"tenantId"`
+	WakeAt    pgtype.Timestamp `json:"wakeAt"`
+	TickerId  pgtype.UUID      `json:"tickerId"`
+}
+
 type StreamEvent struct {
 	ID        int64            `json:"id"`
 	CreatedAt pgtype.Timestamp `json:"createdAt"`
@@ -1534,6 +2338,25 @@ type TenantAlertingSettings struct {
 	EnableTenantResourceLimitAlerts bool             `json:"enableTenantResourceLimitAlerts"`
 }
 
+type TenantClientCA struct {
+	ID             pgtype.UUID      `json:"id"`
+	CreatedAt      pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt      pgtype.Timestamp `json:"updatedAt"`
+	TenantId       pgtype.UUID      `json:"tenantId"`
+	Name           string           `json:"name"`
+	Certificate    string           `json:"certificate"`
+	SpiffeIdPrefix string           `json:"spiffeIdPrefix"`
+	ExpiresAt      pgtype.Timestamp `json:"expiresAt"`
+}
+
+type TenantDataKey struct {
+	ID              pgtype.UUID      `json:"id"`
+	CreatedAt       pgtype.Timestamp `json:"createdAt"`
+	TenantId        pgtype.UUID      `json:"tenantId"`
+	EncryptedKeyset []byte           `json:"encryptedKeyset"`
+	IsActive        bool             `json:"isActive"`
+}
+
 type TenantInviteLink struct {
 	ID           pgtype.UUID      `json:"id"`
 	CreatedAt    pgtype.Timestamp `json:"createdAt"`
@@ -1555,6 +2378,16 @@ type TenantMember struct {
 	Role      TenantMemberRole `json:"role"`
 }
 
+type TenantQuota struct {
+	TenantId            pgtype.UUID      `json:"tenantId"`
+	CreatedAt           pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt           pgtype.Timestamp `json:"updatedAt"`
+	MaxQueuedRuns       pgtype.Int4      `json:"maxQueuedRuns"`
+	MaxWorkers          pgtype.Int4      `json:"maxWorkers"`
+	MaxEventsPerMinute  pgtype.Int4      `json:"maxEventsPerMinute"`
+	MaxWorkflowVersions pgtype.Int4      `json:"maxWorkflowVersions"`
+}
+
 type TenantResourceLimit struct {
 	ID               pgtype.UUID      `json:"id"`
 	CreatedAt        pgtype.Timestamp `json:"createdAt"`
@@ -1581,6 +2414,14 @@ type TenantResourceLimitAlert struct {
 	Limit           int32                        `json:"limit"`
 }
 
+type TenantRetentionPolicy struct {
+	TenantId                   pgtype.UUID      `json:"tenantId"`
+	CreatedAt                  pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt                  pgtype.Timestamp `json:"updatedAt"`
+	WorkflowRunRetentionPeriod pgtype.Text      `json:"workflowRunRetentionPeriod"`
+	EventRetentionPeriod       pgtype.Text      `json:"eventRetentionPeriod"`
+}
+
 type TenantVcsProvider struct {
 	ID          pgtype.UUID      `json:"id"`
 	CreatedAt   pgtype.Timestamp `json:"createdAt"`
@@ -1699,6 +2540,14 @@ type Worker struct {
 	Os                      pgtype.Text      `json:"os"`
 	RuntimeExtra            pgtype.Text      `json:"runtimeExtra"`
 	SdkVersion              pgtype.Text      `json:"sdkVersion"`
+	IsDraining              bool             `json:"isDraining"`
+	Region                  pgtype.Text      `json:"region"`
+	CpuUtilization          pgtype.Float8    `json:"cpuUtilization"`
+	MemoryUtilization       pgtype.Float8    `json:"memoryUtilization"`
+	ActiveSlots             pgtype.Int4      `json:"activeSlots"`
+	Gauges                  []byte           `json:"gauges"`
+	LastHealthReportAt      pgtype.Timestamp `json:"lastHealthReportAt"`
+	Pool                    pgtype.Text      `json:"pool"`
 }
 
 type WorkerAssignEvent struct {
@@ -1717,6 +2566,12 @@ type WorkerLabel struct {
 	IntValue  pgtype.Int4      `json:"intValue"`
 }
 
+type WorkerSlotPool struct {
+	WorkerId pgtype.UUID `json:"workerId"`
+	SlotType string      `json:"slotType"`
+	MaxRuns  int32       `json:"maxRuns"`
+}
+
 type Workflow struct {
 	ID          pgtype.UUID      `json:"id"`
 	CreatedAt   pgtype.Timestamp `json:"createdAt"`
@@ -1739,6 +2594,15 @@ type WorkflowConcurrency struct {
 	ConcurrencyGroupExpression pgtype.Text              `json:"concurrencyGroupExpression"`
 }
 
+type WorkflowRollout struct {
+	ID               pgtype.UUID      `json:"id"`
+	CreatedAt        pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt        pgtype.Timestamp `json:"updatedAt"`
+	WorkflowId       pgtype.UUID      `json:"workflowId"`
+	StableVersionId  pgtype.UUID      `json:"stableVersionId"`
+	CanaryPercentage int32            `json:"canaryPercentage"`
+}
+
 type WorkflowRun struct {
 	CreatedAt          pgtype.Timestamp  `json:"createdAt"`
 	UpdatedAt          pgtype.Timestamp  `json:"updatedAt"`
@@ -1760,6 +2624,18 @@ type WorkflowRun struct {
 	Duration           pgtype.Int8       `json:"duration"`
 	Priority           pgtype.Int4       `json:"priority"`
 	InsertOrder        pgtype.Int4       `json:"insertOrder"`
+	Output             []byte            `json:"output"`
+	ReplayedFromId     pgtype.UUID       `json:"replayedFromId"`
+	ReplayedFromStepId pgtype.UUID       `json:"replayedFromStepId"`
+	TraceContext       []byte            `json:"traceContext"`
+}
+
+type WorkflowRunArchive struct {
+	WorkflowRunId pgtype.UUID      `json:"workflowRunId"`
+	TenantId      pgtype.UUID      `json:"tenantId"`
+	Key           string           `json:"key"`
+	Size          int32            `json:"size"`
+	ArchivedAt    pgtype.Timestamp `json:"archivedAt"`
 }
 
 type WorkflowRunDedupe struct {
@@ -1772,6 +2648,24 @@ type WorkflowRunDedupe struct {
 	Value         string           `json:"value"`
 }
 
+type WorkflowRunEventLog struct {
+	ID            int64            `json:"id"`
+	TenantId      pgtype.UUID      `json:"tenantId"`
+	WorkflowRunId pgtype.UUID      `json:"workflowRunId"`
+	Key           string           `json:"key"`
+	Message       []byte           `json:"message"`
+	CreatedAt     pgtype.Timestamp `json:"createdAt"`
+}
+
+type WorkflowRunIdempotencyKey struct {
+	TenantId      pgtype.UUID      `json:"tenantId"`
+	WorkflowId    pgtype.UUID      `json:"workflowId"`
+	Key           string           `json:"key"`
+	WorkflowRunId pgtype.UUID      `json:"workflowRunId"`
+	CreatedAt     pgtype.Timestamp `json:"createdAt"`
+	ExpiresAt     pgtype.Timestamp `json:"expiresAt"`
+}
+
 type WorkflowRunStickyState struct {
 	ID              int64            `json:"id"`
 	CreatedAt       pgtype.Timestamp `json:"createdAt"`
@@ -1812,18 +2706,21 @@ type WorkflowToWorkflowTag struct {
 }
 
 type WorkflowTriggerCronRef struct {
-	ParentId           pgtype.UUID                   `json:"parentId"`
-	Cron               string                        `json:"cron"`
-	TickerId           pgtype.UUID                   `json:"tickerId"`
-	Input              []byte                        `json:"input"`
-	Enabled            bool                          `json:"enabled"`
-	AdditionalMetadata []byte                        `json:"additionalMetadata"`
-	CreatedAt          pgtype.Timestamp              `json:"createdAt"`
-	DeletedAt          pgtype.Timestamp              `json:"deletedAt"`
-	UpdatedAt          pgtype.Timestamp              `json:"updatedAt"`
-	Name               pgtype.Text                   `json:"name"`
-	ID                 pgtype.UUID                   `json:"id"`
-	Method             WorkflowTriggerCronRefMethods `json:"method"`
+	ParentId            pgtype.UUID                   `json:"parentId"`
+	Cron                string                        `json:"cron"`
+	TickerId            pgtype.UUID                   `json:"tickerId"`
+	Input               []byte                        `json:"input"`
+	Enabled             bool                          `json:"enabled"`
+	AdditionalMetadata  []byte                        `json:"additionalMetadata"`
+	CreatedAt           pgtype.Timestamp              `json:"createdAt"`
+	DeletedAt           pgtype.Timestamp              `json:"deletedAt"`
+	UpdatedAt           pgtype.Timestamp              `json:"updatedAt"`
+	Name                pgtype.Text                   `json:"name"`
+	ID                  pgtype.UUID                   `json:"id"`
+	Method              WorkflowTriggerCronRefMethods `json:"method"`
+	Timezone            string                        `json:"timezone"`
+	JitterSeconds       int32                         `json:"jitterSeconds"`
+	ExclusionCalendarId pgtype.UUID                   `json:"exclusionCalendarId"`
 }
 
 type WorkflowTriggerEventRef struct {
@@ -1858,17 +2755,28 @@ type WorkflowTriggers struct {
 }
 
 type WorkflowVersion struct {
-	ID              pgtype.UUID        `json:"id"`
-	CreatedAt       pgtype.Timestamp   `json:"createdAt"`
-	UpdatedAt       pgtype.Timestamp   `json:"updatedAt"`
-	DeletedAt       pgtype.Timestamp   `json:"deletedAt"`
-	Version         pgtype.Text        `json:"version"`
-	Order           int64              `json:"order"`
-	WorkflowId      pgtype.UUID        `json:"workflowId"`
-	Checksum        string             `json:"checksum"`
-	ScheduleTimeout string             `json:"scheduleTimeout"`
-	OnFailureJobId  pgtype.UUID        `json:"onFailureJobId"`
-	Sticky          NullStickyStrategy `json:"sticky"`
-	Kind            WorkflowKind       `json:"kind"`
-	DefaultPriority pgtype.Int4        `json:"defaultPriority"`
+	ID               pgtype.UUID        `json:"id"`
+	CreatedAt        pgtype.Timestamp   `json:"createdAt"`
+	UpdatedAt        pgtype.Timestamp   `json:"updatedAt"`
+	DeletedAt        pgtype.Timestamp   `json:"deletedAt"`
+	Version          pgtype.Text        `json:"version"`
+	Order            int64              `json:"order"`
+	WorkflowId       pgtype.UUID        `json:"workflowId"`
+	Checksum         string             `json:"checksum"`
+	ScheduleTimeout  string             `json:"scheduleTimeout"`
+	OnFailureJobId   pgtype.UUID        `json:"onFailureJobId"`
+	Sticky           NullStickyStrategy `json:"sticky"`
+	Kind             WorkflowKind       `json:"kind"`
+	DefaultPriority  pgtype.Int4        `json:"defaultPriority"`
+	Region           pgtype.Text        `json:"region"`
+	RegionStrategy   NullRegionStrategy `json:"regionStrategy"`
+	OutputExpression pgtype.Text        `json:"outputExpression"`
+	InputSchema      []byte             `json:"inputSchema"`
+	ReservedSlots    pgtype.Int4        `json:"reservedSlots"`
+}
+
+type WorkflowVersionDefinition struct {
+	WorkflowVersionId pgtype.UUID      `json:"workflowVersionId"`
+	CreatedAt         pgtype.Timestamp `json:"createdAt"`
+	Definition        []byte           `json:"definition"`
 }
@@ -0,0 +1,223 @@
+-- name: ListLeases :many
+-- Lists the leases of the schedulers on queues and workers, optionally of a single tenant or kind.
+SELECT
+    *
+FROM
+    "Lease"
+WHERE
+    (
+        sqlc.narg('tenantId')::uuid IS NULL
+        OR "tenantId" = sqlc.narg('tenantId')::uuid
+    )
+    AND (
+        sqlc.narg('kind')::"LeaseKind" IS NULL
+        OR "kind" = sqlc.narg('kind')::"LeaseKind"
+    )
+ORDER BY
+    "tenantId" ASC, "kind" ASC, "resourceId" ASC;
+
+-- name: ListEngineLeases :many
+SELECT
+    *
+FROM
+    "EngineLease"
+ORDER BY
+    "name" ASC;
+
+-- name: ForceReleaseEngineLease :execrows
+-- Releases an engine lease regardless of its holder.
+DELETE FROM
+    "EngineLease"
+WHERE
+    "name" = @name::text;
+
+-- name: ListEnginePartitions :many
+-- Lists the partitions of the controllers, workers and schedulers of the engines, along with the number of tenants
+-- which are assigned to them.
+SELECT
+    'CONTROLLER'::text AS "kind",
+    p."id",
+    p."name",
+    p."lastHeartbeat",
+    (SELECT COUNT(*) FROM "Tenant" t WHERE t."controllerPartitionId" = p."id") AS "tenants"
+FROM
+    "ControllerPartition" p
+UNION ALL
+SELECT
+    'WORKER'::text AS "kind",
+    p."id",
+    p."name",
+    p."lastHeartbeat",
+    (SELECT COUNT(*) FROM "Tenant" t WHERE t."workerPartitionId" = p."id") AS "tenants"
+FROM
+    "TenantWorkerPartition" p
+UNION ALL
+SELECT
+    'SCHEDULER'::text AS "kind",
+    p."id",
+    p."name",
+    p."lastHeartbeat",
+    (SELECT COUNT(*) FROM "Tenant" t WHERE t."schedulerPartitionId" = p."id") AS "tenants"
+FROM
+    "SchedulerPartition" p
+ORDER BY
+    "kind" ASC, "id" ASC;
+
+-- name: ListDispatchersWithWorkerCounts :many
+-- Lists the dispatchers along with the number of active workers which are connected to them.
+SELECT
+    d."id",
+    d."lastHeartbeatAt",
+    d."isActive",
+    (
+        SELECT
+            COUNT(*)
+        FROM
+            "Worker" w
+        WHERE
+            w."dispatcherId" = d."id"
+            AND w."isActive" = true
+            AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
+    ) AS "workers"
+FROM
+    "Dispatcher" d
+ORDER BY
+    d."lastHeartbeatAt" DESC;
+
+-- name: ListStuckStepRuns :many
+-- Lists the step runs of a tenant which are pending assignment or assigned, and weren't updated since updatedBefore,
+-- optionally only the step runs which are assigned to a worker.
+SELECT
+    sr."id",
+    sr."status",
+    sr."updatedAt",
+    sr."retryCount",
+    s."actionId",
+    sqi."workerId",
+    EXISTS (
+        SELECT
+            1
+        FROM
+            "QueueItem" qi
+        WHERE
+            qi."stepRunId" = sr."id"
+            AND qi."isQueued" = true
+    ) AS "isQueued"
+FROM
+    "StepRun" sr
+JOIN
+    "Step" s ON s."id" = sr."stepId"
+LEFT JOIN
+    "SemaphoreQueueItem" sqi ON sqi."stepRunId" = sr."id"
+WHERE
+    sr."tenantId" = @tenantId::uuid
+    AND sr."deletedAt" IS NULL
+    AND sr."status" IN ('PENDING_ASSIGNMENT', 'ASSIGNED')
+    AND sr."updatedAt" < @updatedBefore::timestamp
+    AND (
+        sqlc.narg('workerId')::uuid IS NULL
+        OR sqi."workerId" = sqlc.narg('workerId')::uuid
+    )
+ORDER BY
+    sr."updatedAt" ASC
+LIMIT
+    sqlc.arg('limit')::int;
+
+-- name: RequeueStepRuns :many
+-- Moves step runs which are pending assignment or assigned back to their queue with the highest priority, releasing
+-- their worker slots. Unlike reassignment, requeueing doesn't count as an internal retry. Returns the requeued step
+-- runs along with the workers which they were assigned to.
+WITH step_runs AS (
+    SELECT
+        sr."id",
+        sr."tenantId",
+        sr."retryCount",
+        sr."deadline",
+        sqi."workerId",
+        s."actionId",
+        s."id" AS "stepId",
+        s."timeout" AS "stepTimeout",
+        s."scheduleTimeout" AS "scheduleTimeout"
+    FROM
+        "StepRun" sr
+    JOIN
+        "Step" s ON sr."stepId" = s."id"
+    LEFT JOIN
+        "SemaphoreQueueItem" sqi ON sqi."stepRunId" = sr."id"
+    WHERE
+        sr."tenantId" = @tenantId::uuid
+        AND sr."id" = ANY(@stepRunIds::uuid[])
+        AND sr."status" IN ('PENDING_ASSIGNMENT', 'ASSIGNED')
+    FOR UPDATE OF sr
+),
+deleted_sqis AS (
+    DELETE FROM
+        "SemaphoreQueueItem" sqi
+    USING
+        step_runs srs
+    WHERE
+        sqi."stepRunId" = srs."id"
+),
+deleted_tqis AS (
+    DELETE FROM
+        "TimeoutQueueItem" tqi
+    USING
+        step_runs srs
+    WHERE
+        tqi."stepRunId" = srs."id"
+        AND tqi."retryCount" = srs."retryCount"
+),
+dequeued_queue_items AS (
+    UPDATE
+        "QueueItem" qi
+    SET
+        "isQueued" = false
+    FROM
+        step_runs srs
+    WHERE
+        qi."stepRunId" = srs."id"
+        AND qi."isQueued" = true
+),
+inserted_queue_items AS (
+    INSERT INTO "QueueItem" (
+        "stepRunId",
+        "stepId",
+        "actionId",
+        "scheduleTimeoutAt",
+        "stepTimeout",
+        "priority",
+        "isQueued",
+        "tenantId",
+        "queue",
+        "deadline"
+    )
+    SELECT
+        srs."id",
+        srs."stepId",
+        srs."actionId",
+        CURRENT_TIMESTAMP + COALESCE(convert_duration_to_interval(srs."scheduleTimeout"), INTERVAL '5 minutes'),
+        srs."stepTimeout",
+        -- Queue with priority 4 so that requeued step runs are assigned first
+        4,
+        true,
+        srs."tenantId",
+        srs."actionId",
+        srs."deadline"
+    FROM
+        step_runs srs
+),
+updated_step_runs AS (
+    UPDATE "StepRun" sr
+    SET
+        "status" = 'PENDING_ASSIGNMENT',
+        "scheduleTimeoutAt" = CURRENT_TIMESTAMP + COALESCE(convert_duration_to_interval(srs."scheduleTimeout"), INTERVAL '5 minutes'),
+        "updatedAt" = CURRENT_TIMESTAMP
+    FROM step_runs srs
+    WHERE sr."id" = srs."id"
+    RETURNING sr."id"
+)
+SELECT
+    srs."id",
+    srs."workerId"
+FROM
+    step_runs srs;
@@ -57,6 +57,25 @@ WHERE
     "tenantId" = @tenantId::uuid
     AND "lastActive" > NOW() - INTERVAL '1 day';
 
+-- name: GetQueue :one
+SELECT
+    *
+FROM
+    "Queue"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "name" = @name::text;
+
+-- name: SetQueuePaused :one
+UPDATE
+    "Queue"
+SET
+    "isPaused" = @isPaused::boolean
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "name" = @name::text
+RETURNING *;
+
 -- name: CreateQueueItem :exec
 INSERT INTO
     "QueueItem" (
@@ -100,7 +119,8 @@ INSERT INTO
         "tenantId",
         "queue",
         "sticky",
-        "desiredWorkerId"
+        "desiredWorkerId",
+        "deadline"
     )
 VALUES
     (
@@ -114,7 +134,8 @@ VALUES
         $8,
         $9,
         $10,
-        $11
+        $11,
+        $12
     );
 
 -- name: GetQueuedCounts :many
@@ -129,6 +150,18 @@ WHERE
 GROUP BY
     qi."queue";
 
+-- name: ListQueuedCountsForAllTenants :many
+SELECT
+    qi."tenantId",
+    qi."queue",
+    COUNT(*) AS "count"
+FROM
+    "QueueItem" qi
+WHERE
+    qi."isQueued" = true
+GROUP BY
+    qi."tenantId", qi."queue";
+
 -- name: GetMinUnprocessedQueueItemId :one
 WITH priority_1 AS (
     SELECT
@@ -272,9 +305,180 @@ WHERE
         sqlc.narg('gtId')::bigint IS NULL OR
         qi."id" >= sqlc.narg('gtId')::bigint
     )
+    -- when the queue is sharded, only list the queue items which hash to this shard
+    AND (
+        sqlc.narg('shardCount')::integer IS NULL OR
+        mod(hashtext(qi."stepRunId"::text)::bigint + 2147483648, sqlc.narg('shardCount')::integer) = sqlc.narg('shardIndex')::integer
+    )
+    -- the queue items of paused workflow runs are held until the workflow run is resumed
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "StepRun" psr
+        JOIN "JobRun" pjr ON psr."jobRunId" = pjr."id"
+        JOIN "WorkflowRun" pwr ON pjr."workflowRunId" = pwr."id"
+        WHERE psr."id" = qi."stepRunId" AND pwr."status" = 'PAUSED'
+    )
+    -- Added to ensure that the index is used
+    AND qi."priority" >= 1 AND qi."priority" <= 4
+ORDER BY
+    qi."priority" DESC,
+    qi."id" ASC
+LIMIT
+    COALESCE(sqlc.narg('limit')::integer, 100);
+
+-- name: ListQueueItemsForQueueFairShare :many
+-- Lists queue items using a weighted round robin across workflows. The steps with queued items are found with a
+-- loose index scan, and each step contributes at most limit queue items, so a workflow with a large backlog isn't
+-- scanned in full.
+WITH RECURSIVE queued_steps AS (
+    (
+        SELECT
+            qi."stepId"
+        FROM
+            "QueueItem" qi
+        WHERE
+            qi."isQueued" = true
+            AND qi."tenantId" = @tenantId::uuid
+            AND qi."queue" = @queue::text
+            AND qi."stepId" IS NOT NULL
+        ORDER BY
+            qi."stepId" ASC
+        LIMIT 1
+    )
+    UNION ALL
+    SELECT
+        (
+            SELECT
+                qi."stepId"
+            FROM
+                "QueueItem" qi
+            WHERE
+                qi."isQueued" = true
+                AND qi."tenantId" = @tenantId::uuid
+                AND qi."queue" = @queue::text
+                AND qi."stepId" > queued_steps."stepId"
+            ORDER BY
+                qi."stepId" ASC
+            LIMIT 1
+        )
+    FROM
+        queued_steps
+    WHERE
+        queued_steps."stepId" IS NOT NULL
+), step_qis AS (
+    SELECT
+        step_qi."id",
+        step_qi."priority",
+        wv."workflowId"
+    FROM
+        queued_steps
+    JOIN
+        "Step" s ON s."id" = queued_steps."stepId"
+    JOIN
+        "Job" j ON s."jobId" = j."id"
+    JOIN
+        "WorkflowVersion" wv ON j."workflowVersionId" = wv."id"
+    CROSS JOIN LATERAL (
+        SELECT
+            qi."id",
+            qi."priority"
+        FROM
+            "QueueItem" qi
+        WHERE
+            qi."isQueued" = true
+            AND qi."tenantId" = @tenantId::uuid
+            AND qi."queue" = @queue::text
+            AND qi."stepId" = queued_steps."stepId"
+            -- when the queue is sharded, only list the queue items which hash to this shard
+            AND (
+                sqlc.narg('shardCount')::integer IS NULL OR
+                mod(hashtext(qi."stepRunId"::text)::bigint + 2147483648, sqlc.narg('shardCount')::integer) = sqlc.narg('shardIndex')::integer
+            )
+            -- the queue items of paused workflow runs are held until the workflow run is resumed
+            AND NOT EXISTS (
+                SELECT 1
+                FROM "StepRun" psr
+                JOIN "JobRun" pjr ON psr."jobRunId" = pjr."id"
+                JOIN "WorkflowRun" pwr ON pjr."workflowRunId" = pwr."id"
+                WHERE psr."id" = qi."stepRunId" AND pwr."status" = 'PAUSED'
+            )
+            -- Added to ensure that the index is used
+            AND qi."priority" >= 1 AND qi."priority" <= 4
+        ORDER BY
+            qi."priority" DESC,
+            qi."id" ASC
+        LIMIT
+            COALESCE(sqlc.narg('limit')::integer, 100)
+    ) step_qi
+), ranked_qis AS (
+    SELECT
+        step_qis."id",
+        step_qis."workflowId",
+        ROW_NUMBER() OVER (PARTITION BY step_qis."workflowId" ORDER BY step_qis."priority" DESC, step_qis."id" ASC) AS "rank"
+    FROM
+        step_qis
+), workflow_weights AS (
+    SELECT
+        unnest(@workflowIds::uuid[]) AS "workflowId",
+        unnest(@weights::integer[]) AS "weight"
+)
+SELECT
+    sqlc.embed(qi),
+    sr."status",
+    ranked_qis."workflowId"
+FROM
+    ranked_qis
+JOIN
+    "QueueItem" qi ON qi."id" = ranked_qis."id"
+JOIN
+    "StepRun" sr ON qi."stepRunId" = sr."id"
+LEFT JOIN
+    workflow_weights ww ON ww."workflowId" = ranked_qis."workflowId"
+WHERE
+    ranked_qis."rank" <= COALESCE(sqlc.narg('limit')::integer, 100)
+ORDER BY
+    -- each workflow contributes up to "weight" items per round
+    (ranked_qis."rank" - 1) / GREATEST(COALESCE(ww."weight", 1), 1) ASC,
+    qi."priority" DESC,
+    qi."id" ASC
+LIMIT
+    COALESCE(sqlc.narg('limit')::integer, 100);
+
+-- name: ListQueueItemsForQueueEDF :many
+-- Lists queue items in earliest-deadline-first order. Queue items without a deadline are pulled after
+-- queue items with a deadline, in priority and insertion order.
+SELECT
+    sqlc.embed(qi),
+    sr."status"
+FROM
+    "QueueItem" qi
+JOIN
+    "StepRun" sr ON qi."stepRunId" = sr."id"
+WHERE
+    qi."isQueued" = true
+    AND qi."tenantId" = @tenantId::uuid
+    AND qi."queue" = @queue::text
+    AND (
+        sqlc.narg('gtId')::bigint IS NULL OR
+        qi."id" >= sqlc.narg('gtId')::bigint
+    )
+    -- when the queue is sharded, only list the queue items which hash to this shard
+    AND (
+        sqlc.narg('shardCount')::integer IS NULL OR
+        mod(hashtext(qi."stepRunId"::text)::bigint + 2147483648, sqlc.narg('shardCount')::integer) = sqlc.narg('shardIndex')::integer
+    )
+    -- the queue items of paused workflow runs are held until the workflow run is resumed
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "StepRun" psr
+        JOIN "JobRun" pjr ON psr."jobRunId" = pjr."id"
+        JOIN "WorkflowRun" pwr ON pjr."workflowRunId" = pwr."id"
+        WHERE psr."id" = qi."stepRunId" AND pwr."status" = 'PAUSED'
+    )
     -- Added to ensure that the index is used
     AND qi."priority" >= 1 AND qi."priority" <= 4
 ORDER BY
+    qi."deadline" ASC NULLS LAST,
     qi."priority" DESC,
     qi."id" ASC
 LIMIT
@@ -288,6 +492,36 @@ SET
 WHERE
     qi."id" = ANY(@ids::bigint[]);
 
+-- name: DequeueStepRunQueueItems :exec
+-- Removes the queue items for step runs which were cancelled before they were assigned.
+UPDATE
+    "QueueItem" qi
+SET
+    "isQueued" = false
+WHERE
+    qi."tenantId" = @tenantId::uuid
+    AND qi."stepRunId" = ANY(@stepRunIds::uuid[])
+    AND qi."isQueued" = true;
+
+-- name: ResetQueueItemScheduleTimeoutsForWorkflowRun :exec
+-- Restarts the scheduling timeouts of the queued step runs of a workflow run, so that step runs which were queued
+-- while the workflow run was paused don't time out as soon as it's resumed.
+UPDATE
+    "QueueItem" qi
+SET
+    "scheduleTimeoutAt" = CURRENT_TIMESTAMP + COALESCE(convert_duration_to_interval(s."scheduleTimeout"), INTERVAL '5 minutes')
+FROM
+    "StepRun" sr
+JOIN
+    "JobRun" jr ON sr."jobRunId" = jr."id"
+JOIN
+    "Step" s ON sr."stepId" = s."id"
+WHERE
+    qi."stepRunId" = sr."id"
+    AND qi."tenantId" = @tenantId::uuid
+    AND qi."isQueued" = true
+    AND jr."workflowRunId" = @workflowRunId::uuid;
+
 -- name: ListInternalQueueItems :many
 SELECT
     *
@@ -439,15 +673,29 @@ AND
 WITH worker_max_runs AS (
     SELECT
         "id",
+        'default'::text AS "slotType",
         "maxRuns"
     FROM
         "Worker"
     WHERE
         "tenantId" = @tenantId::uuid
         AND "id" = ANY(@workerIds::uuid[])
+    UNION ALL
+    SELECT
+        wsp."workerId" AS "id",
+        wsp."slotType",
+        wsp."maxRuns"
+    FROM
+        "WorkerSlotPool" wsp
+    JOIN
+        "Worker" w ON w."id" = wsp."workerId"
+    WHERE
+        w."tenantId" = @tenantId::uuid
+        AND wsp."workerId" = ANY(@workerIds::uuid[])
 ), worker_filled_slots AS (
     SELECT
         "workerId",
+        "slotType",
         COUNT("stepRunId") AS "filledSlots"
     FROM
         "SemaphoreQueueItem"
@@ -455,16 +703,17 @@ WITH worker_max_runs AS (
         "tenantId" = @tenantId::uuid
         AND "workerId" = ANY(@workerIds::uuid[])
     GROUP BY
-        "workerId"
+        "workerId", "slotType"
 )
--- subtract the filled slots from the max runs to get the available slots
+-- subtract the filled slots from the max runs to get the available slots for each slot pool
 SELECT
     wmr."id",
+    wmr."slotType",
     wmr."maxRuns" - COALESCE(wfs."filledSlots", 0) AS "availableSlots"
 FROM
     worker_max_runs wmr
 LEFT JOIN
-    worker_filled_slots wfs ON wmr."id" = wfs."workerId";
+    worker_filled_slots wfs ON wmr."id" = wfs."workerId" AND wmr."slotType" = wfs."slotType";
 
 -- name: ListAllAvailableSlotsForWorkers :many
 WITH worker_max_runs AS (
@@ -483,6 +732,7 @@ WITH worker_max_runs AS (
         "SemaphoreQueueItem"
     WHERE
         "tenantId" = @tenantId::uuid
+        AND "slotType" = 'default'
     GROUP BY
         "workerId"
 )
@@ -543,7 +793,10 @@ WHERE
 -- name: ListActiveWorkers :many
 SELECT
     w."id",
-    w."maxRuns"
+    w."maxRuns",
+    w."isDraining",
+    w."region",
+    w."pool"
 FROM
     "Worker" w
 WHERE
@@ -604,6 +857,16 @@ VALUES
         true
     );
 
+-- name: GetStepRunNextRetryAfter :one
+-- Returns the time of the pending retry of a step run, or null if the step run isn't waiting on a retry.
+SELECT
+    MIN("retryAfter")::timestamp AS "retryAfter"
+FROM
+    "RetryQueueItem"
+WHERE
+    "stepRunId" = @stepRunId::uuid
+    AND "isQueued" = true;
+
 -- name: GetMinMaxProcessedRetryQueueItems :one
 SELECT
     COALESCE(MIN("retryAfter"), NOW())::timestamp AS "minRetryAfter",
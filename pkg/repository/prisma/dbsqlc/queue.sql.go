@@ -212,6 +212,7 @@ type CreateQueueItemsBulkParams struct {
 	Queue             string             `json:"queue"`
 	Sticky            NullStickyStrategy `json:"sticky"`
 	DesiredWorkerId   pgtype.UUID        `json:"desiredWorkerId"`
+	Deadline          pgtype.Timestamp   `json:"deadline"`
 }
 
 const createRetryQueueItem = `-- name: CreateRetryQueueItem :exec
@@ -319,6 +320,28 @@ func (q *Queries) CreateUniqueInternalQueueItemsBulk(ctx context.Context, db DBT
 	return err
 }
 
+const dequeueStepRunQueueItems = `-- name: DequeueStepRunQueueItems :exec
+UPDATE
+    "QueueItem" qi
+SET
+    "isQueued" = false
+WHERE
+    qi."tenantId" = $1::uuid
+    AND qi."stepRunId" = ANY($2::uuid[])
+    AND qi."isQueued" = true
+`
+
+type DequeueStepRunQueueItemsParams struct {
+	Tenantid   pgtype.UUID   `json:"tenantid"`
+	Steprunids []pgtype.UUID `json:"steprunids"`
+}
+
+// Removes the queue items for step runs which were cancelled before they were assigned.
+func (q *Queries) DequeueStepRunQueueItems(ctx context.Context, db DBTX, arg DequeueStepRunQueueItemsParams) error {
+	_, err := db.Exec(ctx, dequeueStepRunQueueItems, arg.Tenantid, arg.Steprunids)
+	return err
+}
+
 const getMinMaxProcessedInternalQueueItems = `-- name: GetMinMaxProcessedInternalQueueItems :one
 SELECT
     COALESCE(MIN("id"), 0)::bigint AS "minId",
@@ -493,6 +516,34 @@ func (q *Queries) GetMinUnprocessedQueueItemId(ctx context.Context, db DBTX, arg
 	return minId, err
 }
 
+const getQueue = `-- name: GetQueue :one
+SELECT
+    id, "tenantId", name, "lastActive", "isPaused"
+FROM
+    "Queue"
+WHERE
+    "tenantId" = $1::uuid
+    AND "name" = $2::text
+`
+
+type GetQueueParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Name     string      `json:"name"`
+}
+
+func (q *Queries) GetQueue(ctx context.Context, db DBTX, arg GetQueueParams) (*Queue, error) {
+	row := db.QueryRow(ctx, getQueue, arg.Tenantid, arg.Name)
+	var i Queue
+	err := row.Scan(
+		&i.ID,
+		&i.TenantId,
+		&i.Name,
+		&i.LastActive,
+		&i.IsPaused,
+	)
+	return &i, err
+}
+
 const getQueuedCounts = `-- name: GetQueuedCounts :many
 SELECT
     "queue",
@@ -531,6 +582,24 @@ func (q *Queries) GetQueuedCounts(ctx context.Context, db DBTX, tenantid pgtype.
 	return items, nil
 }
 
+const getStepRunNextRetryAfter = `-- name: GetStepRunNextRetryAfter :one
+SELECT
+    MIN("retryAfter")::timestamp AS "retryAfter"
+FROM
+    "RetryQueueItem"
+WHERE
+    "stepRunId" = $1::uuid
+    AND "isQueued" = true
+`
+
+// Returns the time of the pending retry of a step run, or null if the step run isn't waiting on a retry.
+func (q *Queries) GetStepRunNextRetryAfter(ctx context.Context, db DBTX, steprunid pgtype.UUID) (pgtype.Timestamp, error) {
+	row := db.QueryRow(ctx, getStepRunNextRetryAfter, steprunid)
+	var retryAfter pgtype.Timestamp
+	err := row.Scan(&retryAfter)
+	return retryAfter, err
+}
+
 const listActionsForAvailableWorkers = `-- name: ListActionsForAvailableWorkers :many
 SELECT
     w."id" as "workerId",
@@ -626,7 +695,10 @@ func (q *Queries) ListActionsForWorkers(ctx context.Context, db DBTX, arg ListAc
 const listActiveWorkers = `-- name: ListActiveWorkers :many
 SELECT
     w."id",
-    w."maxRuns"
+    w."maxRuns",
+    w."isDraining",
+    w."region",
+    w."pool"
 FROM
     "Worker" w
 WHERE
@@ -638,8 +710,11 @@ WHERE
 `
 
 type ListActiveWorkersRow struct {
-	ID      pgtype.UUID `json:"id"`
-	MaxRuns int32       `json:"maxRuns"`
+	ID         pgtype.UUID `json:"id"`
+	MaxRuns    int32       `json:"maxRuns"`
+	IsDraining bool        `json:"isDraining"`
+	Region     pgtype.Text `json:"region"`
+	Pool       pgtype.Text `json:"pool"`
 }
 
 func (q *Queries) ListActiveWorkers(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*ListActiveWorkersRow, error) {
@@ -651,7 +726,13 @@ func (q *Queries) ListActiveWorkers(ctx context.Context, db DBTX, tenantid pgtyp
 	var items []*ListActiveWorkersRow
 	for rows.Next() {
 		var i ListActiveWorkersRow
-		if err := rows.Scan(&i.ID, &i.MaxRuns); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.MaxRuns,
+			&i.IsDraining,
+			&i.Region,
+			&i.Pool,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -679,6 +760,7 @@ WITH worker_max_runs AS (
         "SemaphoreQueueItem"
     WHERE
         "tenantId" = $1::uuid
+        AND "slotType" = 'default'
     GROUP BY
         "workerId"
 )
@@ -757,15 +839,29 @@ const listAvailableSlotsForWorkers = `-- name: ListAvailableSlotsForWorkers :man
 WITH worker_max_runs AS (
     SELECT
         "id",
+        'default'::text AS "slotType",
         "maxRuns"
     FROM
         "Worker"
     WHERE
         "tenantId" = $1::uuid
         AND "id" = ANY($2::uuid[])
+    UNION ALL
+    SELECT
+        wsp."workerId" AS "id",
+        wsp."slotType",
+        wsp."maxRuns"
+    FROM
+        "WorkerSlotPool" wsp
+    JOIN
+        "Worker" w ON w."id" = wsp."workerId"
+    WHERE
+        w."tenantId" = $1::uuid
+        AND wsp."workerId" = ANY($2::uuid[])
 ), worker_filled_slots AS (
     SELECT
         "workerId",
+        "slotType",
         COUNT("stepRunId") AS "filledSlots"
     FROM
         "SemaphoreQueueItem"
@@ -773,15 +869,16 @@ WITH worker_max_runs AS (
         "tenantId" = $1::uuid
         AND "workerId" = ANY($2::uuid[])
     GROUP BY
-        "workerId"
+        "workerId", "slotType"
 )
 SELECT
     wmr."id",
+    wmr."slotType",
     wmr."maxRuns" - COALESCE(wfs."filledSlots", 0) AS "availableSlots"
 FROM
     worker_max_runs wmr
 LEFT JOIN
-    worker_filled_slots wfs ON wmr."id" = wfs."workerId"
+    worker_filled_slots wfs ON wmr."id" = wfs."workerId" AND wmr."slotType" = wfs."slotType"
 `
 
 type ListAvailableSlotsForWorkersParams struct {
@@ -791,10 +888,11 @@ type ListAvailableSlotsForWorkersParams struct {
 
 type ListAvailableSlotsForWorkersRow struct {
 	ID             pgtype.UUID `json:"id"`
+	SlotType       string      `json:"slotType"`
 	AvailableSlots int32       `json:"availableSlots"`
 }
 
-// subtract the filled slots from the max runs to get the available slots
+// subtract the filled slots from the max runs to get the available slots for each slot pool
 func (q *Queries) ListAvailableSlotsForWorkers(ctx context.Context, db DBTX, arg ListAvailableSlotsForWorkersParams) ([]*ListAvailableSlotsForWorkersRow, error) {
 	rows, err := db.Query(ctx, listAvailableSlotsForWorkers, arg.Tenantid, arg.Workerids)
 	if err != nil {
@@ -804,7 +902,7 @@ func (q *Queries) ListAvailableSlotsForWorkers(ctx context.Context, db DBTX, arg
 	var items []*ListAvailableSlotsForWorkersRow
 	for rows.Next() {
 		var i ListAvailableSlotsForWorkersRow
-		if err := rows.Scan(&i.ID, &i.AvailableSlots); err != nil {
+		if err := rows.Scan(&i.ID, &i.SlotType, &i.AvailableSlots); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -880,7 +978,7 @@ func (q *Queries) ListInternalQueueItems(ctx context.Context, db DBTX, arg ListI
 
 const listQueueItemsForQueue = `-- name: ListQueueItemsForQueue :many
 SELECT
-    qi.id, qi."stepRunId", qi."stepId", qi."actionId", qi."scheduleTimeoutAt", qi."stepTimeout", qi.priority, qi."isQueued", qi."tenantId", qi.queue, qi.sticky, qi."desiredWorkerId",
+    qi.id, qi."stepRunId", qi."stepId", qi."actionId", qi."scheduleTimeoutAt", qi."stepTimeout", qi.priority, qi."isQueued", qi."tenantId", qi.queue, qi.sticky, qi."desiredWorkerId", qi.deadline, qi."createdAt",
     sr."status"
 FROM
     "QueueItem" qi
@@ -894,20 +992,35 @@ WHERE
         $3::bigint IS NULL OR
         qi."id" >= $3::bigint
     )
+    -- when the queue is sharded, only list the queue items which hash to this shard
+    AND (
+        $4::integer IS NULL OR
+        mod(hashtext(qi."stepRunId"::text)::bigint + 2147483648, $4::integer) = $5::integer
+    )
+    -- the queue items of paused workflow runs are held until the workflow run is resumed
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "StepRun" psr
+        JOIN "JobRun" pjr ON psr."jobRunId" = pjr."id"
+        JOIN "WorkflowRun" pwr ON pjr."workflowRunId" = pwr."id"
+        WHERE psr."id" = qi."stepRunId" AND pwr."status" = 'PAUSED'
+    )
     -- Added to ensure that the index is used
     AND qi."priority" >= 1 AND qi."priority" <= 4
 ORDER BY
     qi."priority" DESC,
     qi."id" ASC
 LIMIT
-    COALESCE($4::integer, 100)
+    COALESCE($6::integer, 100)
 `
 
 type ListQueueItemsForQueueParams struct {
-	Tenantid pgtype.UUID `json:"tenantid"`
-	Queue    string      `json:"queue"`
-	GtId     pgtype.Int8 `json:"gtId"`
-	Limit    pgtype.Int4 `json:"limit"`
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Queue      string      `json:"queue"`
+	GtId       pgtype.Int8 `json:"gtId"`
+	ShardCount pgtype.Int4 `json:"shardCount"`
+	ShardIndex pgtype.Int4 `json:"shardIndex"`
+	Limit      pgtype.Int4 `json:"limit"`
 }
 
 type ListQueueItemsForQueueRow struct {
@@ -920,6 +1033,8 @@ func (q *Queries) ListQueueItemsForQueue(ctx context.Context, db DBTX, arg ListQ
 		arg.Tenantid,
 		arg.Queue,
 		arg.GtId,
+		arg.ShardCount,
+		arg.ShardIndex,
 		arg.Limit,
 	)
 	if err != nil {
@@ -942,6 +1057,106 @@ func (q *Queries) ListQueueItemsForQueue(ctx context.Context, db DBTX, arg ListQ
 			&i.QueueItem.Queue,
 			&i.QueueItem.Sticky,
 			&i.QueueItem.DesiredWorkerId,
+			&i.QueueItem.Deadline,
+			&i.QueueItem.CreatedAt,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listQueueItemsForQueueEDF = `-- name: ListQueueItemsForQueueEDF :many
+SELECT
+    qi.id, qi."stepRunId", qi."stepId", qi."actionId", qi."scheduleTimeoutAt", qi."stepTimeout", qi.priority, qi."isQueued", qi."tenantId", qi.queue, qi.sticky, qi."desiredWorkerId", qi.deadline, qi."createdAt",
+    sr."status"
+FROM
+    "QueueItem" qi
+JOIN
+    "StepRun" sr ON qi."stepRunId" = sr."id"
+WHERE
+    qi."isQueued" = true
+    AND qi."tenantId" = $1::uuid
+    AND qi."queue" = $2::text
+    AND (
+        $3::bigint IS NULL OR
+        qi."id" >= $3::bigint
+    )
+    -- when the queue is sharded, only list the queue items which hash to this shard
+    AND (
+        $4::integer IS NULL OR
+        mod(hashtext(qi."stepRunId"::text)::bigint + 2147483648, $4::integer) = $5::integer
+    )
+    -- the queue items of paused workflow runs are held until the workflow run is resumed
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "StepRun" psr
+        JOIN "JobRun" pjr ON psr."jobRunId" = pjr."id"
+        JOIN "WorkflowRun" pwr ON pjr."workflowRunId" = pwr."id"
+        WHERE psr."id" = qi."stepRunId" AND pwr."status" = 'PAUSED'
+    )
+    -- Added to ensure that the index is used
+    AND qi."priority" >= 1 AND qi."priority" <= 4
+ORDER BY
+    qi."deadline" ASC NULLS LAST,
+    qi."priority" DESC,
+    qi."id" ASC
+LIMIT
+    COALESCE($6::integer, 100)
+`
+
+type ListQueueItemsForQueueEDFParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Queue      string      `json:"queue"`
+	GtId       pgtype.Int8 `json:"gtId"`
+	ShardCount pgtype.Int4 `json:"shardCount"`
+	ShardIndex pgtype.Int4 `json:"shardIndex"`
+	Limit      pgtype.Int4 `json:"limit"`
+}
+
+type ListQueueItemsForQueueEDFRow struct {
+	QueueItem QueueItem     `json:"queue_item"`
+	Status    StepRunStatus `json:"status"`
+}
+
+// Lists queue items in earliest-deadline-first order. Queue items without a deadline are pulled after
+// queue items with a deadline, in priority and insertion order.
+func (q *Queries) ListQueueItemsForQueueEDF(ctx context.Context, db DBTX, arg ListQueueItemsForQueueEDFParams) ([]*ListQueueItemsForQueueEDFRow, error) {
+	rows, err := db.Query(ctx, listQueueItemsForQueueEDF,
+		arg.Tenantid,
+		arg.Queue,
+		arg.GtId,
+		arg.ShardCount,
+		arg.ShardIndex,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListQueueItemsForQueueEDFRow
+	for rows.Next() {
+		var i ListQueueItemsForQueueEDFRow
+		if err := rows.Scan(
+			&i.QueueItem.ID,
+			&i.QueueItem.StepRunId,
+			&i.QueueItem.StepId,
+			&i.QueueItem.ActionId,
+			&i.QueueItem.ScheduleTimeoutAt,
+			&i.QueueItem.StepTimeout,
+			&i.QueueItem.Priority,
+			&i.QueueItem.IsQueued,
+			&i.QueueItem.TenantId,
+			&i.QueueItem.Queue,
+			&i.QueueItem.Sticky,
+			&i.QueueItem.DesiredWorkerId,
+			&i.QueueItem.Deadline,
+			&i.QueueItem.CreatedAt,
 			&i.Status,
 		); err != nil {
 			return nil, err
@@ -954,9 +1169,228 @@ func (q *Queries) ListQueueItemsForQueue(ctx context.Context, db DBTX, arg ListQ
 	return items, nil
 }
 
+const listQueueItemsForQueueFairShare = `-- name: ListQueueItemsForQueueFairShare :many
+WITH RECURSIVE queued_steps AS (
+    (
+        SELECT
+            qi."stepId"
+        FROM
+            "QueueItem" qi
+        WHERE
+            qi."isQueued" = true
+            AND qi."tenantId" = $2::uuid
+            AND qi."queue" = $3::text
+            AND qi."stepId" IS NOT NULL
+        ORDER BY
+            qi."stepId" ASC
+        LIMIT 1
+    )
+    UNION ALL
+    SELECT
+        (
+            SELECT
+                qi."stepId"
+            FROM
+                "QueueItem" qi
+            WHERE
+                qi."isQueued" = true
+                AND qi."tenantId" = $2::uuid
+                AND qi."queue" = $3::text
+                AND qi."stepId" > queued_steps."stepId"
+            ORDER BY
+                qi."stepId" ASC
+            LIMIT 1
+        )
+    FROM
+        queued_steps
+    WHERE
+        queued_steps."stepId" IS NOT NULL
+), step_qis AS (
+    SELECT
+        step_qi."id",
+        step_qi."priority",
+        wv."workflowId"
+    FROM
+        queued_steps
+    JOIN
+        "Step" s ON s."id" = queued_steps."stepId"
+    JOIN
+        "Job" j ON s."jobId" = j."id"
+    JOIN
+        "WorkflowVersion" wv ON j."workflowVersionId" = wv."id"
+    CROSS JOIN LATERAL (
+        SELECT
+            qi."id",
+            qi."priority"
+        FROM
+            "QueueItem" qi
+        WHERE
+            qi."isQueued" = true
+            AND qi."tenantId" = $2::uuid
+            AND qi."queue" = $3::text
+            AND qi."stepId" = queued_steps."stepId"
+            -- when the queue is sharded, only list the queue items which hash to this shard
+            AND (
+                $4::integer IS NULL OR
+                mod(hashtext(qi."stepRunId"::text)::bigint + 2147483648, $4::integer) = $5::integer
+            )
+            -- the queue items of paused workflow runs are held until the workflow run is resumed
+            AND NOT EXISTS (
+                SELECT 1
+                FROM "StepRun" psr
+                JOIN "JobRun" pjr ON psr."jobRunId" = pjr."id"
+                JOIN "WorkflowRun" pwr ON pjr."workflowRunId" = pwr."id"
+                WHERE psr."id" = qi."stepRunId" AND pwr."status" = 'PAUSED'
+            )
+            -- Added to ensure that the index is used
+            AND qi."priority" >= 1 AND qi."priority" <= 4
+        ORDER BY
+            qi."priority" DESC,
+            qi."id" ASC
+        LIMIT
+            COALESCE($1::integer, 100)
+    ) step_qi
+), ranked_qis AS (
+    SELECT
+        step_qis."id",
+        step_qis."workflowId",
+        ROW_NUMBER() OVER (PARTITION BY step_qis."workflowId" ORDER BY step_qis."priority" DESC, step_qis."id" ASC) AS "rank"
+    FROM
+        step_qis
+), workflow_weights AS (
+    SELECT
+        unnest($6::uuid[]) AS "workflowId",
+        unnest($7::integer[]) AS "weight"
+)
+SELECT
+    qi.id, qi."stepRunId", qi."stepId", qi."actionId", qi."scheduleTimeoutAt", qi."stepTimeout", qi.priority, qi."isQueued", qi."tenantId", qi.queue, qi.sticky, qi."desiredWorkerId", qi.deadline, qi."createdAt",
+    sr."status",
+    ranked_qis."workflowId"
+FROM
+    ranked_qis
+JOIN
+    "QueueItem" qi ON qi."id" = ranked_qis."id"
+JOIN
+    "StepRun" sr ON qi."stepRunId" = sr."id"
+LEFT JOIN
+    workflow_weights ww ON ww."workflowId" = ranked_qis."workflowId"
+WHERE
+    ranked_qis."rank" <= COALESCE($1::integer, 100)
+ORDER BY
+    -- each workflow contributes up to "weight" items per round
+    (ranked_qis."rank" - 1) / GREATEST(COALESCE(ww."weight", 1), 1) ASC,
+    qi."priority" DESC,
+    qi."id" ASC
+LIMIT
+    COALESCE($1::integer, 100)
+`
+
+type ListQueueItemsForQueueFairShareParams struct {
+	Limit       pgtype.Int4   `json:"limit"`
+	Tenantid    pgtype.UUID   `json:"tenantid"`
+	Queue       string        `json:"queue"`
+	ShardCount  pgtype.Int4   `json:"shardCount"`
+	ShardIndex  pgtype.Int4   `json:"shardIndex"`
+	Workflowids []pgtype.UUID `json:"workflowids"`
+	Weights     []int32       `json:"weights"`
+}
+
+type ListQueueItemsForQueueFairShareRow struct {
+	QueueItem  QueueItem     `json:"queue_item"`
+	Status     StepRunStatus `json:"status"`
+	WorkflowId pgtype.UUID   `json:"workflowId"`
+}
+
+// Lists queue items using a weighted round robin across workflows. The steps with queued items are found with a
+// loose index scan, and each step contributes at most limit queue items, so a workflow with a large backlog isn't
+// scanned in full.
+func (q *Queries) ListQueueItemsForQueueFairShare(ctx context.Context, db DBTX, arg ListQueueItemsForQueueFairShareParams) ([]*ListQueueItemsForQueueFairShareRow, error) {
+	rows, err := db.Query(ctx, listQueueItemsForQueueFairShare,
+		arg.Limit,
+		arg.Tenantid,
+		arg.Queue,
+		arg.ShardCount,
+		arg.ShardIndex,
+		arg.Workflowids,
+		arg.Weights,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListQueueItemsForQueueFairShareRow
+	for rows.Next() {
+		var i ListQueueItemsForQueueFairShareRow
+		if err := rows.Scan(
+			&i.QueueItem.ID,
+			&i.QueueItem.StepRunId,
+			&i.QueueItem.StepId,
+			&i.QueueItem.ActionId,
+			&i.QueueItem.ScheduleTimeoutAt,
+			&i.QueueItem.StepTimeout,
+			&i.QueueItem.Priority,
+			&i.QueueItem.IsQueued,
+			&i.QueueItem.TenantId,
+			&i.QueueItem.Queue,
+			&i.QueueItem.Sticky,
+			&i.QueueItem.DesiredWorkerId,
+			&i.QueueItem.Deadline,
+			&i.QueueItem.CreatedAt,
+			&i.Status,
+			&i.WorkflowId,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listQueuedCountsForAllTenants = `-- name: ListQueuedCountsForAllTenants :many
+SELECT
+    qi."tenantId",
+    qi."queue",
+    COUNT(*) AS "count"
+FROM
+    "QueueItem" qi
+WHERE
+    qi."isQueued" = true
+GROUP BY
+    qi."tenantId", qi."queue"
+`
+
+type ListQueuedCountsForAllTenantsRow struct {
+	TenantId pgtype.UUID `json:"tenantId"`
+	Queue    string      `json:"queue"`
+	Count    int64       `json:"count"`
+}
+
+func (q *Queries) ListQueuedCountsForAllTenants(ctx context.Context, db DBTX) ([]*ListQueuedCountsForAllTenantsRow, error) {
+	rows, err := db.Query(ctx, listQueuedCountsForAllTenants)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListQueuedCountsForAllTenantsRow
+	for rows.Next() {
+		var i ListQueuedCountsForAllTenantsRow
+		if err := rows.Scan(&i.TenantId, &i.Queue, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listQueues = `-- name: ListQueues :many
 SELECT
-    id, "tenantId", name, "lastActive"
+    id, "tenantId", name, "lastActive", "isPaused"
 FROM
     "Queue"
 WHERE
@@ -978,6 +1412,7 @@ func (q *Queries) ListQueues(ctx context.Context, db DBTX, tenantid pgtype.UUID)
 			&i.TenantId,
 			&i.Name,
 			&i.LastActive,
+			&i.IsPaused,
 		); err != nil {
 			return nil, err
 		}
@@ -1145,6 +1580,66 @@ func (q *Queries) RemoveTimeoutQueueItem(ctx context.Context, db DBTX, arg Remov
 	return err
 }
 
+const resetQueueItemScheduleTimeoutsForWorkflowRun = `-- name: ResetQueueItemScheduleTimeoutsForWorkflowRun :exec
+UPDATE
+    "QueueItem" qi
+SET
+    "scheduleTimeoutAt" = CURRENT_TIMESTAMP + COALESCE(convert_duration_to_interval(s."scheduleTimeout"), INTERVAL '5 minutes')
+FROM
+    "StepRun" sr
+JOIN
+    "JobRun" jr ON sr."jobRunId" = jr."id"
+JOIN
+    "Step" s ON sr."stepId" = s."id"
+WHERE
+    qi."stepRunId" = sr."id"
+    AND qi."tenantId" = $1::uuid
+    AND qi."isQueued" = true
+    AND jr."workflowRunId" = $2::uuid
+`
+
+type ResetQueueItemScheduleTimeoutsForWorkflowRunParams struct {
+	Tenantid      pgtype.UUID `json:"tenantid"`
+	Workflowrunid pgtype.UUID `json:"workflowrunid"`
+}
+
+// Restarts the scheduling timeouts of the queued step runs of a workflow run, so that step runs which were queued
+// while the workflow run was paused don't time out as soon as it's resumed.
+func (q *Queries) ResetQueueItemScheduleTimeoutsForWorkflowRun(ctx context.Context, db DBTX, arg ResetQueueItemScheduleTimeoutsForWorkflowRunParams) error {
+	_, err := db.Exec(ctx, resetQueueItemScheduleTimeoutsForWorkflowRun, arg.Tenantid, arg.Workflowrunid)
+	return err
+}
+
+const setQueuePaused = `-- name: SetQueuePaused :one
+UPDATE
+    "Queue"
+SET
+    "isPaused" = $1::boolean
+WHERE
+    "tenantId" = $2::uuid
+    AND "name" = $3::text
+RETURNING id, "tenantId", name, "lastActive", "isPaused"
+`
+
+type SetQueuePausedParams struct {
+	Ispaused bool        `json:"ispaused"`
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Name     string      `json:"name"`
+}
+
+func (q *Queries) SetQueuePaused(ctx context.Context, db DBTX, arg SetQueuePausedParams) (*Queue, error) {
+	row := db.QueryRow(ctx, setQueuePaused, arg.Ispaused, arg.Tenantid, arg.Name)
+	var i Queue
+	err := row.Scan(
+		&i.ID,
+		&i.TenantId,
+		&i.Name,
+		&i.LastActive,
+		&i.IsPaused,
+	)
+	return &i, err
+}
+
 const upsertQueue = `-- name: UpsertQueue :exec
 WITH queue_exists AS (
     SELECT
@@ -1156,7 +1651,7 @@ WITH queue_exists AS (
         AND "name" = $2::text
 ), queue_to_update AS (
     SELECT
-        id, "tenantId", name, "lastActive"
+        id, "tenantId", name, "lastActive", "isPaused"
     FROM
         "Queue"
     WHERE
@@ -0,0 +1,103 @@
+-- name: CreateQueueMetricsSnapshots :exec
+-- Snapshots the depth, the wait time of the oldest queued item and the worker slot utilization of the queues of a
+-- tenant which have queued items, workers or were recently active.
+WITH queue_depths AS (
+    SELECT
+        qi."queue",
+        COUNT(*) AS "depth",
+        MIN(qi."createdAt") AS "oldestQueuedAt"
+    FROM
+        "QueueItem" qi
+    WHERE
+        qi."isQueued" = true
+        AND qi."tenantId" = @tenantId::uuid
+    GROUP BY
+        qi."queue"
+), active_workers AS (
+    SELECT
+        w."id",
+        w."maxRuns"
+    FROM
+        "Worker" w
+    WHERE
+        w."tenantId" = @tenantId::uuid
+        AND w."dispatcherId" IS NOT NULL
+        AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
+        AND w."isActive" = true
+        AND w."isPaused" = false
+), worker_used_slots AS (
+    SELECT
+        "workerId",
+        COUNT("stepRunId") AS "usedSlots"
+    FROM
+        "SemaphoreQueueItem"
+    WHERE
+        "tenantId" = @tenantId::uuid
+        AND "slotType" = 'default'
+    GROUP BY
+        "workerId"
+), queue_slots AS (
+    -- queues are named after the actions which they run, so the slots of a queue are the slots of the workers
+    -- which registered its action
+    SELECT
+        a."actionId" AS "queue",
+        SUM(aw."maxRuns") AS "slots",
+        SUM(COALESCE(wus."usedSlots", 0)) AS "usedSlots"
+    FROM
+        active_workers aw
+    JOIN
+        "_ActionToWorker" atw ON atw."B" = aw."id"
+    JOIN
+        "Action" a ON a."id" = atw."A"
+    LEFT JOIN
+        worker_used_slots wus ON wus."workerId" = aw."id"
+    GROUP BY
+        a."actionId"
+)
+INSERT INTO "QueueMetricsSnapshot" (
+    "tenantId",
+    "queue",
+    "depth",
+    "latencyMs",
+    "slots",
+    "usedSlots"
+)
+SELECT
+    @tenantId::uuid,
+    q."name",
+    COALESCE(qd."depth", 0),
+    COALESCE((EXTRACT(EPOCH FROM (NOW() - qd."oldestQueuedAt")) * 1000)::bigint, 0),
+    COALESCE(qs."slots", 0),
+    COALESCE(qs."usedSlots", 0)
+FROM
+    "Queue" q
+LEFT JOIN
+    queue_depths qd ON qd."queue" = q."name"
+LEFT JOIN
+    queue_slots qs ON qs."queue" = q."name"
+WHERE
+    q."tenantId" = @tenantId::uuid
+    AND (
+        qd."depth" IS NOT NULL
+        OR qs."slots" IS NOT NULL
+        OR q."lastActive" > NOW() - INTERVAL '1 hour'
+    );
+
+-- name: ListQueueMetricsSnapshots :many
+SELECT
+    *
+FROM
+    "QueueMetricsSnapshot"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "createdAt" >= @createdAfter::timestamp
+ORDER BY
+    "queue" ASC,
+    "createdAt" ASC;
+
+-- name: DeleteExpiredQueueMetricsSnapshots :exec
+DELETE FROM
+    "QueueMetricsSnapshot"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "createdAt" < @createdBefore::timestamp;
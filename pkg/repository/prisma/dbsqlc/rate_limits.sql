@@ -4,16 +4,23 @@ INSERT INTO "RateLimit" (
     "key",
     "limitValue",
     "value",
-    "window"
+    "window",
+    "algorithm",
+    "burst"
 ) VALUES (
     @tenantId::uuid,
     @key::text,
     sqlc.arg('limit')::int,
     sqlc.arg('limit')::int,
-    COALESCE(sqlc.narg('window')::text, '1 minute')
+    COALESCE(sqlc.narg('window')::text, '1 minute'),
+    COALESCE(sqlc.narg('algorithm')::"RateLimitAlgorithm", 'FIXED_WINDOW'),
+    sqlc.narg('burst')::int
 ) ON CONFLICT ("tenantId", "key") DO UPDATE SET
     "limitValue" = sqlc.arg('limit')::int,
     "window" = COALESCE(sqlc.narg('window')::text, '1 minute'),
+    "algorithm" = COALESCE(sqlc.narg('algorithm')::"RateLimitAlgorithm", 'FIXED_WINDOW'),
+    "burst" = sqlc.narg('burst')::int,
+    "expiresAt" = NULL,
     "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END
 RETURNING *;
 
@@ -29,20 +36,24 @@ INSERT INTO "RateLimit" (
     "key",
     "limitValue",
     "value",
-    "window"
+    "window",
+    "expiresAt"
 )
 SELECT
     @tenantId::uuid,
     iv."key",
     iv."limitValue",
     iv."limitValue",
-    iv."window"
+    iv."window",
+    sqlc.narg('expiresAt')::timestamp
 FROM
     input_values iv
 ON CONFLICT ("tenantId", "key") DO UPDATE SET
     "limitValue" = EXCLUDED."limitValue",
     "window" = EXCLUDED."window",
-    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END;
+    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END,
+    -- rate limits which were created statically never expire
+    "expiresAt" = CASE WHEN "RateLimit"."expiresAt" IS NULL THEN NULL ELSE EXCLUDED."expiresAt" END;
 
 -- name: CountRateLimits :one
 WITH rate_limits AS (
@@ -77,19 +88,9 @@ SELECT
     "tenantId",
     "key",
     "limitValue",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            get_refill_value(rl)
-        ELSE
-            rl."value"
-    END)::int AS "value",
+    get_available_value(rl)::int AS "value",
     "window",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END)::timestamp AS "lastRefill"
+    get_refill_time(rl)::timestamp AS "lastRefill"
 FROM
     "RateLimit" rl
 WHERE
@@ -116,24 +117,27 @@ WITH refill AS (
     UPDATE
         "RateLimit" rl
     SET
-        "value" = CASE
-            WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-                get_refill_value(rl)
-            ELSE
-                rl."value"
-        END,
-        "lastRefill" = CASE
-            WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-                CURRENT_TIMESTAMP
-            ELSE
-                rl."lastRefill"
-        END
+        "value" = get_refill_value(rl),
+        "prevWindowUsed" = get_prev_window_used(rl),
+        "lastRefill" = get_refill_time(rl)
     WHERE
         rl."tenantId" = @tenantId::uuid
-    RETURNING *
+    RETURNING
+        rl."tenantId",
+        rl."key",
+        rl."limitValue",
+        -- the stored value is refilled, so this only subtracts the weighted usage of sliding windows
+        get_available_value(rl) AS "value",
+        rl."window",
+        rl."lastRefill"
 )
 SELECT
-    refill.*,
+    refill."tenantId",
+    refill."key",
+    refill."limitValue",
+    refill."value"::int AS "value",
+    refill."window",
+    refill."lastRefill",
     -- return the next refill time
     (refill."lastRefill" + refill."window"::INTERVAL)::timestamp AS "nextRefillAt"
 FROM
@@ -152,7 +156,102 @@ WHERE
 UPDATE
     "RateLimit" rl
 SET
-    "value" = get_refill_value(rl) - input."units",
+    -- returned units are negative, so cap the value at the limit
+    "value" = LEAST(get_refill_value(rl) - input."units", get_rate_limit_capacity(rl)),
+    "prevWindowUsed" = get_prev_window_used(rl),
+    "lastRefill" = get_refill_time(rl)
+FROM
+    (
+        SELECT
+            unnest(@keys::text[]) AS "key",
+            unnest(@units::int[]) AS "units"
+    ) AS input
+WHERE
+    rl."key" = input."key"
+    AND rl."tenantId" = @tenantId::uuid
+RETURNING rl.*;
+
+-- name: UpsertGlobalRateLimit :one
+INSERT INTO "GlobalRateLimit" (
+    "key",
+    "limitValue",
+    "value",
+    "window"
+) VALUES (
+    @key::text,
+    sqlc.arg('limit')::int,
+    sqlc.arg('limit')::int,
+    COALESCE(sqlc.narg('window')::text, '1 minute')
+) ON CONFLICT ("key") DO UPDATE SET
+    "limitValue" = sqlc.arg('limit')::int,
+    "window" = COALESCE(sqlc.narg('window')::text, '1 minute'),
+    "value" = CASE WHEN EXCLUDED."limitValue" < "GlobalRateLimit"."value" THEN EXCLUDED."limitValue" ELSE "GlobalRateLimit"."value" END
+RETURNING *;
+
+-- name: ListGlobalRateLimits :many
+-- Returns all global rate limits with their refilled values, without updating them
+SELECT
+    "key",
+    "limitValue",
+    (CASE
+        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
+            rl."limitValue"
+        ELSE
+            rl."value"
+    END)::int AS "value",
+    "window",
+    (CASE
+        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
+            CURRENT_TIMESTAMP
+        ELSE
+            rl."lastRefill"
+    END)::timestamp AS "lastRefill"
+FROM
+    "GlobalRateLimit" rl
+ORDER BY
+    rl."key" ASC;
+
+-- name: ListGlobalRateLimitsWithMutate :many
+UPDATE
+    "GlobalRateLimit" rl
+SET
+    "value" = CASE
+        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
+            rl."limitValue"
+        ELSE
+            rl."value"
+    END,
+    "lastRefill" = CASE
+        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
+            CURRENT_TIMESTAMP
+        ELSE
+            rl."lastRefill"
+    END
+RETURNING *;
+
+-- name: ListGlobalRateLimitsForSteps :many
+SELECT
+    *
+FROM
+    "StepGlobalRateLimit" srl
+WHERE
+    srl."stepId" = ANY(@stepIds::uuid[])
+    AND srl."tenantId" = @tenantId::uuid;
+
+-- name: BulkUpdateGlobalRateLimits :many
+UPDATE
+    "GlobalRateLimit" rl
+SET
+    -- returned units are negative, so cap the value at the limit
+    "value" = LEAST(
+        (CASE
+            WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
+                rl."limitValue"
+            ELSE
+                rl."value"
+        END) - input."units",
+        rl."limitValue"
+    ),
     "lastRefill" = CASE
         WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
             CURRENT_TIMESTAMP
@@ -167,5 +266,18 @@ FROM
     ) AS input
 WHERE
     rl."key" = input."key"
-    AND rl."tenantId" = @tenantId::uuid
 RETURNING rl.*;
+
+-- name: DeleteExpiredRateLimits :execrows
+DELETE FROM
+    "RateLimit" rl
+WHERE
+    rl."tenantId" = @tenantId::uuid
+    AND rl."expiresAt" < NOW()
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "StepRateLimit" srl
+        WHERE
+            srl."tenantId" = rl."tenantId"
+            AND srl."rateLimitKey" = rl."key"
+    );
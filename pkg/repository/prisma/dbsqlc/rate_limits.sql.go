@@ -11,17 +11,76 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const bulkUpdateRateLimits = `-- name: BulkUpdateRateLimits :many
+const bulkUpdateGlobalRateLimits = `-- name: BulkUpdateGlobalRateLimits :many
 UPDATE
-    "RateLimit" rl
+    "GlobalRateLimit" rl
 SET
-    "value" = get_refill_value(rl) - input."units",
+    -- returned units are negative, so cap the value at the limit
+    "value" = LEAST(
+        (CASE
+            WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
+                rl."limitValue"
+            ELSE
+                rl."value"
+        END) - input."units",
+        rl."limitValue"
+    ),
     "lastRefill" = CASE
         WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
             CURRENT_TIMESTAMP
         ELSE
             rl."lastRefill"
     END
+FROM
+    (
+        SELECT
+            unnest($1::text[]) AS "key",
+            unnest($2::int[]) AS "units"
+    ) AS input
+WHERE
+    rl."key" = input."key"
+RETURNING rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
+`
+
+type BulkUpdateGlobalRateLimitsParams struct {
+	Keys  []string `json:"keys"`
+	Units []int32  `json:"units"`
+}
+
+func (q *Queries) BulkUpdateGlobalRateLimits(ctx context.Context, db DBTX, arg BulkUpdateGlobalRateLimitsParams) ([]*GlobalRateLimit, error) {
+	rows, err := db.Query(ctx, bulkUpdateGlobalRateLimits, arg.Keys, arg.Units)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GlobalRateLimit
+	for rows.Next() {
+		var i GlobalRateLimit
+		if err := rows.Scan(
+			&i.Key,
+			&i.LimitValue,
+			&i.Value,
+			&i.Window,
+			&i.LastRefill,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const bulkUpdateRateLimits = `-- name: BulkUpdateRateLimits :many
+UPDATE
+    "RateLimit" rl
+SET
+    -- returned units are negative, so cap the value at the limit
+    "value" = LEAST(get_refill_value(rl) - input."units", get_rate_limit_capacity(rl)),
+    "prevWindowUsed" = get_prev_window_used(rl),
+    "lastRefill" = get_refill_time(rl)
 FROM
     (
         SELECT
@@ -31,7 +90,7 @@ FROM
 WHERE
     rl."key" = input."key"
     AND rl."tenantId" = $1::uuid
-RETURNING rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill"
+RETURNING rl."tenantId", rl.key, rl."limitValue", rl.value, rl."window", rl."lastRefill", rl.algorithm, rl.burst, rl."prevWindowUsed", rl."expiresAt"
 `
 
 type BulkUpdateRateLimitsParams struct {
@@ -56,6 +115,10 @@ func (q *Queries) BulkUpdateRateLimits(ctx context.Context, db DBTX, arg BulkUpd
 			&i.Value,
 			&i.Window,
 			&i.LastRefill,
+			&i.Algorithm,
+			&i.Burst,
+			&i.PrevWindowUsed,
+			&i.ExpiresAt,
 		); err != nil {
 			return nil, err
 		}
@@ -108,6 +171,172 @@ func (q *Queries) CountRateLimits(ctx context.Context, db DBTX, arg CountRateLim
 	return total, err
 }
 
+const deleteExpiredRateLimits = `-- name: DeleteExpiredRateLimits :execrows
+DELETE FROM
+    "RateLimit" rl
+WHERE
+    rl."tenantId" = $1::uuid
+    AND rl."expiresAt" < NOW()
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "StepRateLimit" srl
+        WHERE
+            srl."tenantId" = rl."tenantId"
+            AND srl."rateLimitKey" = rl."key"
+    )
+`
+
+func (q *Queries) DeleteExpiredRateLimits(ctx context.Context, db DBTX, tenantid pgtype.UUID) (int64, error) {
+	result, err := db.Exec(ctx, deleteExpiredRateLimits, tenantid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listGlobalRateLimits = `-- name: ListGlobalRateLimits :many
+SELECT
+    "key",
+    "limitValue",
+    (CASE
+        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
+            rl."limitValue"
+        ELSE
+            rl."value"
+    END)::int AS "value",
+    "window",
+    (CASE
+        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
+            CURRENT_TIMESTAMP
+        ELSE
+            rl."lastRefill"
+    END)::timestamp AS "lastRefill"
+FROM
+    "GlobalRateLimit" rl
+ORDER BY
+    rl."key" ASC
+`
+
+type ListGlobalRateLimitsRow struct {
+	Key        string           `json:"key"`
+	LimitValue int32            `json:"limitValue"`
+	Value      int32            `json:"value"`
+	Window     string           `json:"window"`
+	LastRefill pgtype.Timestamp `json:"lastRefill"`
+}
+
+// Returns all global rate limits with their refilled values, without updating them
+func (q *Queries) ListGlobalRateLimits(ctx context.Context, db DBTX) ([]*ListGlobalRateLimitsRow, error) {
+	rows, err := db.Query(ctx, listGlobalRateLimits)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListGlobalRateLimitsRow
+	for rows.Next() {
+		var i ListGlobalRateLimitsRow
+		if err := rows.Scan(
+			&i.Key,
+			&i.LimitValue,
+			&i.Value,
+			&i.Window,
+			&i.LastRefill,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGlobalRateLimitsForSteps = `-- name: ListGlobalRateLimitsForSteps :many
+SELECT
+    units, "stepId", "rateLimitKey", "tenantId"
+FROM
+    "StepGlobalRateLimit" srl
+WHERE
+    srl."stepId" = ANY($1::uuid[])
+    AND srl."tenantId" = $2::uuid
+`
+
+type ListGlobalRateLimitsForStepsParams struct {
+	Stepids  []pgtype.UUID `json:"stepids"`
+	Tenantid pgtype.UUID   `json:"tenantid"`
+}
+
+func (q *Queries) ListGlobalRateLimitsForSteps(ctx context.Context, db DBTX, arg ListGlobalRateLimitsForStepsParams) ([]*StepGlobalRateLimit, error) {
+	rows, err := db.Query(ctx, listGlobalRateLimitsForSteps, arg.Stepids, arg.Tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*StepGlobalRateLimit
+	for rows.Next() {
+		var i StepGlobalRateLimit
+		if err := rows.Scan(
+			&i.Units,
+			&i.StepId,
+			&i.RateLimitKey,
+			&i.TenantId,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGlobalRateLimitsWithMutate = `-- name: ListGlobalRateLimitsWithMutate :many
+UPDATE
+    "GlobalRateLimit" rl
+SET
+    "value" = CASE
+        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
+            rl."limitValue"
+        ELSE
+            rl."value"
+    END,
+    "lastRefill" = CASE
+        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
+            CURRENT_TIMESTAMP
+        ELSE
+            rl."lastRefill"
+    END
+RETURNING key, "limitValue", value, "window", "lastRefill"
+`
+
+func (q *Queries) ListGlobalRateLimitsWithMutate(ctx context.Context, db DBTX) ([]*GlobalRateLimit, error) {
+	rows, err := db.Query(ctx, listGlobalRateLimitsWithMutate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GlobalRateLimit
+	for rows.Next() {
+		var i GlobalRateLimit
+		if err := rows.Scan(
+			&i.Key,
+			&i.LimitValue,
+			&i.Value,
+			&i.Window,
+			&i.LastRefill,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listRateLimitsForSteps = `-- name: ListRateLimitsForSteps :many
 SELECT
     units, "stepId", "rateLimitKey", "tenantId", kind
@@ -154,19 +383,9 @@ SELECT
     "tenantId",
     "key",
     "limitValue",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            get_refill_value(rl)
-        ELSE
-            rl."value"
-    END)::int AS "value",
+    get_available_value(rl)::int AS "value",
     "window",
-    (CASE
-        WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-            CURRENT_TIMESTAMP
-        ELSE
-            rl."lastRefill"
-    END)::timestamp AS "lastRefill"
+    get_refill_time(rl)::timestamp AS "lastRefill"
 FROM
     "RateLimit" rl
 WHERE
@@ -245,24 +464,27 @@ WITH refill AS (
     UPDATE
         "RateLimit" rl
     SET
-        "value" = CASE
-            WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-                get_refill_value(rl)
-            ELSE
-                rl."value"
-        END,
-        "lastRefill" = CASE
-            WHEN NOW() - rl."lastRefill" >= rl."window"::INTERVAL THEN
-                CURRENT_TIMESTAMP
-            ELSE
-                rl."lastRefill"
-        END
+        "value" = get_refill_value(rl),
+        "prevWindowUsed" = get_prev_window_used(rl),
+        "lastRefill" = get_refill_time(rl)
     WHERE
         rl."tenantId" = $1::uuid
-    RETURNING "tenantId", key, "limitValue", value, "window", "lastRefill"
+    RETURNING
+        rl."tenantId",
+        rl."key",
+        rl."limitValue",
+        -- the stored value is refilled, so this only subtracts the weighted usage of sliding windows
+        get_available_value(rl) AS "value",
+        rl."window",
+        rl."lastRefill"
 )
 SELECT
-    refill."tenantId", refill.key, refill."limitValue", refill.value, refill."window", refill."lastRefill",
+    refill."tenantId",
+    refill."key",
+    refill."limitValue",
+    refill."value"::int AS "value",
+    refill."window",
+    refill."lastRefill",
     -- return the next refill time
     (refill."lastRefill" + refill."window"::INTERVAL)::timestamp AS "nextRefillAt"
 FROM
@@ -307,31 +529,77 @@ func (q *Queries) ListRateLimitsForTenantWithMutate(ctx context.Context, db DBTX
 	return items, nil
 }
 
+const upsertGlobalRateLimit = `-- name: UpsertGlobalRateLimit :one
+INSERT INTO "GlobalRateLimit" (
+    "key",
+    "limitValue",
+    "value",
+    "window"
+) VALUES (
+    $1::text,
+    $2::int,
+    $2::int,
+    COALESCE($3::text, '1 minute')
+) ON CONFLICT ("key") DO UPDATE SET
+    "limitValue" = $2::int,
+    "window" = COALESCE($3::text, '1 minute'),
+    "value" = CASE WHEN EXCLUDED."limitValue" < "GlobalRateLimit"."value" THEN EXCLUDED."limitValue" ELSE "GlobalRateLimit"."value" END
+RETURNING key, "limitValue", value, "window", "lastRefill"
+`
+
+type UpsertGlobalRateLimitParams struct {
+	Key    string      `json:"key"`
+	Limit  int32       `json:"limit"`
+	Window pgtype.Text `json:"window"`
+}
+
+func (q *Queries) UpsertGlobalRateLimit(ctx context.Context, db DBTX, arg UpsertGlobalRateLimitParams) (*GlobalRateLimit, error) {
+	row := db.QueryRow(ctx, upsertGlobalRateLimit, arg.Key, arg.Limit, arg.Window)
+	var i GlobalRateLimit
+	err := row.Scan(
+		&i.Key,
+		&i.LimitValue,
+		&i.Value,
+		&i.Window,
+		&i.LastRefill,
+	)
+	return &i, err
+}
+
 const upsertRateLimit = `-- name: UpsertRateLimit :one
 INSERT INTO "RateLimit" (
     "tenantId",
     "key",
     "limitValue",
     "value",
-    "window"
+    "window",
+    "algorithm",
+    "burst"
 ) VALUES (
     $1::uuid,
     $2::text,
     $3::int,
     $3::int,
-    COALESCE($4::text, '1 minute')
+    COALESCE($4::text, '1 minute'),
+    COALESCE($5::"RateLimitAlgorithm", 'FIXED_WINDOW'),
+    $6::int
 ) ON CONFLICT ("tenantId", "key") DO UPDATE SET
     "limitValue" = $3::int,
     "window" = COALESCE($4::text, '1 minute'),
+    "algorithm" = COALESCE($5::"RateLimitAlgorithm", 'FIXED_WINDOW'),
+    "burst" = $6::int,
+    "expiresAt" = NULL,
     "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END
-RETURNING "tenantId", key, "limitValue", value, "window", "lastRefill"
+RETURNING "tenantId", key, "limitValue", value, "window", "lastRefill", algorithm, burst, "prevWindowUsed", "expiresAt"
 `
 
 type UpsertRateLimitParams struct {
-	Tenantid pgtype.UUID `json:"tenantid"`
-	Key      string      `json:"key"`
-	Limit    int32       `json:"limit"`
-	Window   pgtype.Text `json:"window"`
+	Tenantid  pgtype.UUID            `json:"tenantid"`
+	Key       string                 `json:"key"`
+	Limit     int32                  `json:"limit"`
+	Window    pgtype.Text            `json:"window"`
+	Algorithm NullRateLimitAlgorithm `json:"algorithm"`
+	Burst     pgtype.Int4            `json:"burst"`
 }
 
 func (q *Queries) UpsertRateLimit(ctx context.Context, db DBTX, arg UpsertRateLimitParams) (*RateLimit, error) {
@@ -340,6 +608,8 @@ func (q *Queries) UpsertRateLimit(ctx context.Context, db DBTX, arg UpsertRateLi
 		arg.Key,
 		arg.Limit,
 		arg.Window,
+		arg.Algorithm,
+		arg.Burst,
 	)
 	var i RateLimit
 	err := row.Scan(
@@ -349,6 +619,10 @@ func (q *Queries) UpsertRateLimit(ctx context.Context, db DBTX, arg UpsertRateLi
 		&i.Value,
 		&i.Window,
 		&i.LastRefill,
+		&i.Algorithm,
+		&i.Burst,
+		&i.PrevWindowUsed,
+		&i.ExpiresAt,
 	)
 	return &i, err
 }
@@ -356,41 +630,47 @@ func (q *Queries) UpsertRateLimit(ctx context.Context, db DBTX, arg UpsertRateLi
 const upsertRateLimitsBulk = `-- name: UpsertRateLimitsBulk :exec
 WITH input_values AS (
     SELECT
-        unnest($2::text[]) AS "key",
-        unnest($3::int[]) AS "limitValue",
-        unnest($4::text[]) AS "window"
+        unnest($3::text[]) AS "key",
+        unnest($4::int[]) AS "limitValue",
+        unnest($5::text[]) AS "window"
 )
 INSERT INTO "RateLimit" (
     "tenantId",
     "key",
     "limitValue",
     "value",
-    "window"
+    "window",
+    "expiresAt"
 )
 SELECT
     $1::uuid,
     iv."key",
     iv."limitValue",
     iv."limitValue",
-    iv."window"
+    iv."window",
+    $2::timestamp
 FROM
     input_values iv
 ON CONFLICT ("tenantId", "key") DO UPDATE SET
     "limitValue" = EXCLUDED."limitValue",
     "window" = EXCLUDED."window",
-    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END
+    "value" = CASE WHEN EXCLUDED."limitValue" < "RateLimit"."value" THEN EXCLUDED."limitValue" ELSE "RateLimit"."value" END,
+    -- rate limits which were created statically never expire
+    "expiresAt" = CASE WHEN "RateLimit"."expiresAt" IS NULL THEN NULL ELSE EXCLUDED."expiresAt" END
 `
 
 type UpsertRateLimitsBulkParams struct {
-	Tenantid    pgtype.UUID `json:"tenantid"`
-	Keys        []string    `json:"keys"`
-	Limitvalues []int32     `json:"limitvalues"`
-	Windows     []string    `json:"windows"`
+	Tenantid    pgtype.UUID      `json:"tenantid"`
+	ExpiresAt   pgtype.Timestamp `json:"expiresAt"`
+	Keys        []string         `json:"keys"`
+	Limitvalues []int32          `json:"limitvalues"`
+	Windows     []string         `json:"windows"`
 }
 
 func (q *Queries) UpsertRateLimitsBulk(ctx context.Context, db DBTX, arg UpsertRateLimitsBulkParams) error {
 	_, err := db.Exec(ctx, upsertRateLimitsBulk,
 		arg.Tenantid,
+		arg.ExpiresAt,
 		arg.Keys,
 		arg.Limitvalues,
 		arg.Windows,
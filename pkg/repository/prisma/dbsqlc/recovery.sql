@@ -0,0 +1,36 @@
+-- name: DequeueOrphanedQueueItems :execrows
+-- Removes the queue items of step runs which are finished or no longer exist.
+UPDATE
+    "QueueItem" qi
+SET
+    "isQueued" = false
+WHERE
+    qi."tenantId" = @tenantId::uuid
+    AND qi."isQueued" = true
+    AND qi."stepRunId" IS NOT NULL
+    AND NOT EXISTS (
+        SELECT
+            1
+        FROM
+            "StepRun" sr
+        WHERE
+            sr."id" = qi."stepRunId"
+            AND sr."status" != ALL(ARRAY['SUCCEEDED', 'FAILED', 'CANCELLED', 'SKIPPED']::"StepRunStatus"[])
+    );
+
+-- name: ReleaseDanglingSemaphoreQueueItems :execrows
+-- Deletes the semaphore queue items of step runs which are finished or no longer exist, which would otherwise keep
+-- holding a slot of their worker.
+DELETE FROM
+    "SemaphoreQueueItem" sqi
+WHERE
+    sqi."tenantId" = @tenantId::uuid
+    AND NOT EXISTS (
+        SELECT
+            1
+        FROM
+            "StepRun" sr
+        WHERE
+            sr."id" = sqi."stepRunId"
+            AND sr."status" != ALL(ARRAY['SUCCEEDED', 'FAILED', 'CANCELLED', 'SKIPPED']::"StepRunStatus"[])
+    );
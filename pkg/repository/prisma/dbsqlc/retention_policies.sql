@@ -0,0 +1,84 @@
+-- name: GetTenantRetentionPolicy :one
+SELECT
+    *
+FROM
+    "TenantRetentionPolicy"
+WHERE
+    "tenantId" = @tenantId::uuid;
+
+-- name: UpsertTenantRetentionPolicy :one
+INSERT INTO "TenantRetentionPolicy" (
+    "tenantId",
+    "workflowRunRetentionPeriod",
+    "eventRetentionPeriod"
+) VALUES (
+    @tenantId::uuid,
+    sqlc.narg('workflowRunRetentionPeriod')::text,
+    sqlc.narg('eventRetentionPeriod')::text
+)
+ON CONFLICT ("tenantId") DO UPDATE
+SET
+    "workflowRunRetentionPeriod" = sqlc.narg('workflowRunRetentionPeriod')::text,
+    "eventRetentionPeriod" = sqlc.narg('eventRetentionPeriod')::text,
+    "updatedAt" = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: PruneWorkflowRuns :many
+-- Permanently deletes a batch of workflow runs which were soft-deleted before the cutoff. Their job runs are deleted
+-- by the foreign keys, the input of the runs is returned so that the runs can be archived, along with the key of
+-- the archive of their details if their details were archived.
+WITH workflow_runs AS (
+    SELECT
+        "id"
+    FROM
+        "WorkflowRun"
+    WHERE
+        "tenantId" = @tenantId::uuid
+        AND "deletedAt" < @deletedBefore::timestamp
+    LIMIT @batchSize::integer
+    FOR UPDATE SKIP LOCKED
+), deleted_triggers AS (
+    DELETE FROM
+        "WorkflowRunTriggeredBy"
+    WHERE
+        "parentId" IN (SELECT "id" FROM workflow_runs)
+    RETURNING "parentId", "input"
+), deleted_workflow_runs AS (
+    DELETE FROM
+        "WorkflowRun"
+    WHERE
+        "id" IN (SELECT "id" FROM workflow_runs)
+    RETURNING
+        "id",
+        "createdAt",
+        "deletedAt",
+        "workflowVersionId",
+        "status",
+        "displayName",
+        "error",
+        "startedAt",
+        "finishedAt",
+        "additionalMetadata",
+        "output"
+)
+SELECT
+    wr."id",
+    wr."createdAt",
+    wr."deletedAt",
+    wr."workflowVersionId",
+    wr."status",
+    wr."displayName",
+    wr."error",
+    wr."startedAt",
+    wr."finishedAt",
+    wr."additionalMetadata",
+    wr."output",
+    t."input",
+    -- the archives are deleted by the foreign keys, but the statement reads the rows from before the deletion
+    a."key" AS "archiveKey"
+FROM
+    deleted_workflow_runs wr
+LEFT JOIN
+    deleted_triggers t ON t."parentId" = wr."id"
+LEFT JOIN
+    "WorkflowRunArchive" a ON a."workflowRunId" = wr."id";
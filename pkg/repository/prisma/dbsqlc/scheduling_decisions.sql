@@ -0,0 +1,74 @@
+-- name: BulkCreateSchedulingDecisions :exec
+INSERT INTO "SchedulingDecision" (
+    "createdAt",
+    "tenantId",
+    "stepRunId",
+    "queue",
+    "outcome",
+    "workerId",
+    "message",
+    "data"
+)
+SELECT
+    input."createdAt",
+    @tenantId::uuid,
+    input."stepRunId",
+    input."queue",
+    input."outcome",
+    input."workerId",
+    input."message",
+    input."data"
+FROM (
+    SELECT
+        unnest(@createdAts::timestamp[]) AS "createdAt",
+        unnest(@stepRunIds::uuid[]) AS "stepRunId",
+        unnest(@queues::text[]) AS "queue",
+        unnest(cast(@outcomes::text[] as "SchedulingDecisionOutcome"[])) AS "outcome",
+        unnest(@workerIds::uuid[]) AS "workerId",
+        unnest(@messages::text[]) AS "message",
+        unnest(@data::jsonb[]) AS "data"
+    ) AS input;
+
+-- name: TrimSchedulingDecisions :exec
+-- Deletes the oldest scheduling decisions for a tenant, so that at most @maxDecisions are kept.
+DELETE FROM
+    "SchedulingDecision"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "id" <= (
+        SELECT
+            "id"
+        FROM
+            "SchedulingDecision"
+        WHERE
+            "tenantId" = @tenantId::uuid
+        ORDER BY
+            "id" DESC
+        OFFSET
+            @maxDecisions::integer
+        LIMIT 1
+    );
+
+-- name: CountSchedulingDecisionsForStepRun :one
+SELECT
+    count(*) AS total
+FROM
+    "SchedulingDecision"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "stepRunId" = @stepRunId::uuid;
+
+-- name: ListSchedulingDecisionsForStepRun :many
+SELECT
+    *
+FROM
+    "SchedulingDecision"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "stepRunId" = @stepRunId::uuid
+ORDER BY
+    "id" DESC
+OFFSET
+    COALESCE(sqlc.narg('offset'), 0)
+LIMIT
+    COALESCE(sqlc.narg('limit'), 50);
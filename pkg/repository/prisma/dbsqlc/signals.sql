@@ -0,0 +1,76 @@
+-- name: CreateStepRunSignalWait :exec
+-- Registers a step run as waiting for an event. If the step run is already waiting (for example, when it is
+-- replayed), the wait is reset.
+INSERT INTO "StepRunSignalWait" (
+    "stepRunId",
+    "tenantId",
+    "eventKey",
+    "correlationExpr",
+    "correlationValue"
+) VALUES (
+    @stepRunId::uuid,
+    @tenantId::uuid,
+    @eventKey::text,
+    sqlc.narg('correlationExpr')::text,
+    sqlc.narg('correlationValue')::text
+)
+ON CONFLICT ("stepRunId") DO UPDATE
+SET
+    "createdAt" = CURRENT_TIMESTAMP,
+    "eventKey" = EXCLUDED."eventKey",
+    "correlationExpr" = EXCLUDED."correlationExpr",
+    "correlationValue" = EXCLUDED."correlationValue";
+
+-- name: ListStepRunSignalWaitsForEvent :many
+-- Lists the step runs which are waiting for an event with the given key. Waits of step runs which have already
+-- reached a final state (for example, because they were cancelled) are deleted.
+WITH waits AS (
+    SELECT
+        w."stepRunId"
+    FROM
+        "StepRunSignalWait" w
+    WHERE
+        w."tenantId" = @tenantId::uuid
+        AND w."eventKey" = @eventKey::text
+), stale_waits AS (
+    DELETE FROM
+        "StepRunSignalWait" w
+    USING
+        waits
+    WHERE
+        w."stepRunId" = waits."stepRunId"
+        AND NOT EXISTS (
+            SELECT 1
+            FROM "StepRun" sr
+            WHERE
+                sr."id" = waits."stepRunId"
+                AND sr."status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED', 'CANCELLING')
+        )
+    RETURNING w."stepRunId"
+)
+SELECT
+    w."stepRunId",
+    w."tenantId",
+    w."eventKey",
+    w."correlationExpr",
+    w."correlationValue",
+    jr."workflowRunId"
+FROM
+    "StepRunSignalWait" w
+JOIN
+    waits ON waits."stepRunId" = w."stepRunId"
+JOIN
+    "StepRun" sr ON sr."id" = w."stepRunId"
+JOIN
+    "JobRun" jr ON jr."id" = sr."jobRunId"
+WHERE
+    sr."status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED', 'CANCELLING')
+ORDER BY
+    w."createdAt" ASC;
+
+-- name: DeleteStepRunSignalWait :execrows
+DELETE FROM
+    "StepRunSignalWait"
+WHERE
+    "stepRunId" = @stepRunId::uuid
+    AND "tenantId" = @tenantId::uuid;
@@ -0,0 +1,77 @@
+-- name: CreateSlotReservations :exec
+INSERT INTO "SlotReservation" (
+    "workflowRunId",
+    "tenantId",
+    "slots",
+    "expiresAt"
+)
+SELECT
+    input."workflowRunId",
+    input."tenantId",
+    input."slots",
+    input."expiresAt"
+FROM (
+    SELECT
+        unnest(@workflowRunIds::uuid[]) AS "workflowRunId",
+        unnest(@tenantIds::uuid[]) AS "tenantId",
+        unnest(@slots::integer[]) AS "slots",
+        unnest(@expiresAts::timestamp[]) AS "expiresAt"
+    ) AS input
+ON CONFLICT ("workflowRunId") DO NOTHING;
+
+-- name: ListActiveSlotReservations :many
+-- Lists the reservations for workflow runs which have not finished, along with the number of
+-- step runs in the workflow run which are currently occupying a slot.
+SELECT
+    sr."workflowRunId",
+    sr."slots",
+    (
+        SELECT
+            COUNT(*)
+        FROM
+            "JobRun" jr
+        JOIN
+            "StepRun" s ON s."jobRunId" = jr."id"
+        WHERE
+            jr."workflowRunId" = sr."workflowRunId"
+            AND s."deletedAt" IS NULL
+            AND s."status" IN ('ASSIGNED', 'RUNNING')
+    )::integer AS "usedSlots"
+FROM
+    "SlotReservation" sr
+JOIN
+    "WorkflowRun" wr ON wr."id" = sr."workflowRunId"
+WHERE
+    sr."tenantId" = @tenantId::uuid
+    AND sr."expiresAt" > NOW()
+    AND wr."status" NOT IN ('SUCCEEDED', 'FAILED');
+
+-- name: ListQueuedStepRunsForSlotReservations :many
+SELECT
+    s."id" AS "stepRunId",
+    jr."workflowRunId"
+FROM
+    "SlotReservation" sr
+JOIN
+    "JobRun" jr ON jr."workflowRunId" = sr."workflowRunId"
+JOIN
+    "StepRun" s ON s."jobRunId" = jr."id"
+WHERE
+    sr."tenantId" = @tenantId::uuid
+    AND sr."expiresAt" > NOW()
+    AND s."deletedAt" IS NULL
+    AND s."status" = 'PENDING_ASSIGNMENT';
+
+-- name: DeleteInactiveSlotReservations :exec
+-- Releases reservations which have expired or whose workflow run has finished.
+DELETE FROM
+    "SlotReservation" sr
+USING
+    "WorkflowRun" wr
+WHERE
+    sr."tenantId" = @tenantId::uuid
+    AND wr."id" = sr."workflowRunId"
+    AND (
+        sr."expiresAt" <= NOW()
+        OR wr."status" IN ('SUCCEEDED', 'FAILED')
+    );
@@ -0,0 +1,151 @@
+-- name: ListStepRunsToSpeculate :many
+-- Lists running step runs which have been running for longer than the configured percentile of recent
+-- successful durations for their step, and which don't have a speculative attempt yet.
+WITH candidates AS (
+    SELECT
+        sr."id",
+        sr."stepId",
+        sr."workerId",
+        sr."retryCount",
+        sr."startedAt",
+        s."actionId",
+        s."speculativePercentile"
+    FROM
+        "StepRun" sr
+    JOIN
+        "Step" s ON s."id" = sr."stepId"
+    WHERE
+        sr."tenantId" = @tenantId::uuid
+        AND sr."status" = 'RUNNING'
+        AND sr."deletedAt" IS NULL
+        AND sr."workerId" IS NOT NULL
+        AND sr."startedAt" IS NOT NULL
+        AND s."speculativePercentile" IS NOT NULL
+        AND NOT EXISTS (
+            SELECT 1
+            FROM "StepRunSpeculativeAttempt" sa
+            WHERE sa."stepRunId" = sr."id" AND sa."retryCount" = sr."retryCount"
+        )
+    LIMIT
+        sqlc.arg('limit')::integer
+)
+SELECT
+    c."id" AS "stepRunId",
+    c."workerId",
+    c."actionId",
+    c."retryCount",
+    durations."threshold"::float8 AS "threshold"
+FROM
+    candidates c
+JOIN LATERAL (
+    SELECT
+        percentile_cont(c."speculativePercentile"::float8 / 100) WITHIN GROUP (ORDER BY recent."duration") AS "threshold",
+        count(*) AS "samples"
+    FROM (
+        SELECT
+            EXTRACT(EPOCH FROM (sr2."finishedAt" - sr2."startedAt")) AS "duration"
+        FROM
+            "StepRun" sr2
+        WHERE
+            sr2."tenantId" = @tenantId::uuid
+            AND sr2."stepId" = c."stepId"
+            AND sr2."status" = 'SUCCEEDED'
+            AND sr2."startedAt" IS NOT NULL
+            AND sr2."finishedAt" IS NOT NULL
+        ORDER BY
+            sr2."finishedAt" DESC
+        LIMIT 100
+    ) recent
+) durations ON true
+WHERE
+    -- don't speculate until there are enough successful step runs to compute a meaningful percentile
+    durations."samples" >= @minSamples::integer
+    AND c."startedAt" < NOW() - make_interval(secs => durations."threshold");
+
+-- name: ListSpeculativeWorkerCandidates :many
+-- Lists the active workers which can run the action, other than the worker running the primary attempt,
+-- with the most available slots first.
+WITH workers AS (
+    SELECT
+        w."id",
+        w."dispatcherId",
+        w."maxRuns"
+    FROM
+        "Worker" w
+    JOIN
+        "_ActionToWorker" atw ON w."id" = atw."B"
+    JOIN
+        "Action" a ON atw."A" = a."id"
+    WHERE
+        w."tenantId" = @tenantId::uuid
+        AND a."tenantId" = @tenantId::uuid
+        AND a."actionId" = @actionId::text
+        AND w."id" != @excludeWorkerId::uuid
+        AND w."dispatcherId" IS NOT NULL
+        AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
+        AND w."isActive" = true
+        AND w."isPaused" = false
+        AND w."isDraining" = false
+), filled_slots AS (
+    SELECT
+        "workerId",
+        COUNT("stepRunId") AS "filledSlots"
+    FROM
+        "SemaphoreQueueItem"
+    WHERE
+        "tenantId" = @tenantId::uuid
+        AND "workerId" IN (SELECT "id" FROM workers)
+        AND "slotType" = 'default'
+    GROUP BY
+        "workerId"
+)
+SELECT
+    workers."id",
+    workers."dispatcherId",
+    workers."maxRuns" - COALESCE(fs."filledSlots", 0) AS "availableSlots"
+FROM
+    workers
+LEFT JOIN
+    filled_slots fs ON workers."id" = fs."workerId"
+WHERE
+    workers."maxRuns" - COALESCE(fs."filledSlots", 0) > 0
+ORDER BY
+    "availableSlots" DESC;
+
+-- name: CreateSpeculativeAttempt :execrows
+INSERT INTO "StepRunSpeculativeAttempt" (
+    "stepRunId",
+    "retryCount",
+    "tenantId",
+    "primaryWorkerId",
+    "workerId"
+) VALUES (
+    @stepRunId::uuid,
+    @retryCount::integer,
+    @tenantId::uuid,
+    @primaryWorkerId::uuid,
+    @workerId::uuid
+)
+ON CONFLICT ("stepRunId", "retryCount") DO NOTHING;
+
+-- name: GetSpeculativeAttemptForUpdate :one
+SELECT
+    *
+FROM
+    "StepRunSpeculativeAttempt"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "stepRunId" = @stepRunId::uuid
+    AND "retryCount" = @retryCount::integer
+FOR UPDATE;
+
+-- name: UpdateSpeculativeAttempt :exec
+UPDATE
+    "StepRunSpeculativeAttempt"
+SET
+    "winnerWorkerId" = COALESCE(sqlc.narg('winnerWorkerId')::uuid, "winnerWorkerId"),
+    "failedAttempts" = @failedAttempts::integer
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "stepRunId" = @stepRunId::uuid
+    AND "retryCount" = @retryCount::integer;
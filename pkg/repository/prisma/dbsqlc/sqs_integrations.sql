@@ -0,0 +1,54 @@
+-- name: CreateSQSIntegration :one
+INSERT INTO "SQSIntegration" (
+    "tenantId",
+    "queueUrl",
+    "region",
+    "eventKey",
+    "accessKeyIdSecret",
+    "secretAccessKeySecret",
+    "sessionTokenSecret"
+) VALUES (
+    @tenantId::uuid,
+    @queueUrl::text,
+    @region::text,
+    @eventKey::text,
+    @accessKeyIdSecret::text,
+    @secretAccessKeySecret::text,
+    sqlc.narg('sessionTokenSecret')::text
+) RETURNING *;
+
+-- name: GetSQSIntegrationById :one
+SELECT
+    *
+FROM
+    "SQSIntegration"
+WHERE
+    "id" = @id::uuid;
+
+-- name: ListSQSIntegrations :many
+SELECT
+    *
+FROM
+    "SQSIntegration"
+WHERE
+    "tenantId" = @tenantId::uuid
+ORDER BY
+    "createdAt" ASC;
+
+-- name: ListSQSIntegrationsByPartitionId :many
+-- Lists the SQS integrations of the tenants which are assigned to a worker partition.
+SELECT
+    i.*
+FROM
+    "SQSIntegration" i
+JOIN
+    "Tenant" t ON t."id" = i."tenantId"
+WHERE
+    t."workerPartitionId" = @workerPartitionId::text
+    OR t."workerPartitionId" IS NULL;
+
+-- name: DeleteSQSIntegration :exec
+DELETE FROM
+    "SQSIntegration"
+WHERE
+    "id" = @id::uuid;
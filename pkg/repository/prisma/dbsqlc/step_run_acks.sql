@@ -0,0 +1,31 @@
+-- name: AckStepRunAttempt :execrows
+-- Records the completion or failure event of an attempt of a step run. Nothing is written if an event of the attempt
+-- was already recorded, in which case the event is a duplicate.
+INSERT INTO "StepRunAck" (
+    "stepRunId",
+    "attemptId",
+    "tenantId",
+    "eventType"
+) VALUES (
+    @stepRunId::uuid,
+    @attemptId::text,
+    @tenantId::uuid,
+    @eventType::text
+)
+ON CONFLICT ("stepRunId", "attemptId") DO NOTHING;
+
+-- name: UnackStepRunAttempt :exec
+-- Deletes the recorded event of an attempt of a step run, so the event is processed when it's sent again.
+DELETE FROM
+    "StepRunAck"
+WHERE
+    "stepRunId" = @stepRunId::uuid
+    AND "attemptId" = @attemptId::text
+    AND "tenantId" = @tenantId::uuid;
+
+-- name: DeleteExpiredStepRunAcks :execrows
+DELETE FROM
+    "StepRunAck"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "createdAt" < @createdBefore::timestamp;
@@ -0,0 +1,46 @@
+-- name: GetCachedStepRunOutput :one
+SELECT
+    c.*
+FROM
+    "StepRunResultCache" c
+WHERE
+    c."tenantId" = @tenantId::uuid
+    AND c."actionId" = @actionId::text
+    AND c."inputHash" = @inputHash::text
+    AND c."expiresAt" > CURRENT_TIMESTAMP;
+
+-- name: CacheStepRunOutput :exec
+-- Caches the output of a step run. An existing output is only overwritten once it has expired, so that step runs
+-- which reuse a cached output don't extend its lifetime.
+INSERT INTO "StepRunResultCache" (
+    "tenantId",
+    "actionId",
+    "inputHash",
+    "output",
+    "stepRunId",
+    "createdAt",
+    "expiresAt"
+) VALUES (
+    @tenantId::uuid,
+    @actionId::text,
+    @inputHash::text,
+    @output::jsonb,
+    @stepRunId::uuid,
+    CURRENT_TIMESTAMP,
+    @expiresAt::timestamp
+)
+ON CONFLICT ("tenantId", "actionId", "inputHash") DO UPDATE
+SET
+    "output" = EXCLUDED."output",
+    "stepRunId" = EXCLUDED."stepRunId",
+    "createdAt" = EXCLUDED."createdAt",
+    "expiresAt" = EXCLUDED."expiresAt"
+WHERE
+    "StepRunResultCache"."expiresAt" <= CURRENT_TIMESTAMP;
+
+-- name: DeleteExpiredStepRunResultCache :execrows
+DELETE FROM
+    "StepRunResultCache"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "expiresAt" < NOW();
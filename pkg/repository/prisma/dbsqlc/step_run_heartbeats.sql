@@ -0,0 +1,171 @@
+-- name: DeleteExpiredStepRunHeartbeats :execrows
+DELETE FROM
+    "StepRunHeartbeat"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "lastHeartbeatAt" < @heartbeatBefore::timestamp;
+
+-- name: ReassignLostStepRuns :many
+-- Reassigns or fails a batch of running step runs which haven't had a heartbeat since lostBefore, ordered by step
+-- run id. Step runs which never had a heartbeat are measured from when they started. Pass the last step run id of the
+-- previous batch as the cursor to continue.
+WITH lost_step_runs AS (
+    SELECT
+        sr."id",
+        sr."tenantId",
+        sr."scheduleTimeoutAt",
+        sr."retryCount",
+        sr."internalRetryCount",
+        sr."deadline",
+        sr."workerId",
+        s."actionId",
+        s."id" AS "stepId",
+        s."timeout" AS "stepTimeout",
+        s."scheduleTimeout" AS "scheduleTimeout"
+    FROM
+        "StepRun" sr
+    JOIN
+        "Step" s ON sr."stepId" = s."id"
+    LEFT JOIN
+        "StepRunHeartbeat" hb ON hb."stepRunId" = sr."id"
+    WHERE
+        sr."tenantId" = @tenantId::uuid
+        AND sr."status" = 'RUNNING'
+        -- step runs which don't run on a worker, like sleep and approval step runs, don't send heartbeats
+        AND sr."workerId" IS NOT NULL
+        AND sr."deletedAt" IS NULL
+        -- a heartbeat of a previous attempt of the step run is older than the start of the current attempt
+        AND GREATEST(hb."lastHeartbeatAt", sr."startedAt") < @lostBefore::timestamp
+        AND (
+            sqlc.narg('cursor')::uuid IS NULL
+            OR sr."id" > sqlc.narg('cursor')::uuid
+        )
+    ORDER BY
+        sr."id" ASC
+    LIMIT
+        @batchSize::int
+),
+step_runs_to_reassign AS (
+    SELECT
+        *
+    FROM
+        lost_step_runs
+    WHERE
+        "internalRetryCount" < @maxInternalRetryCount::int
+),
+step_runs_to_fail AS (
+    SELECT
+        *
+    FROM
+        lost_step_runs
+    WHERE
+        "internalRetryCount" >= @maxInternalRetryCount::int
+),
+deleted_sqis AS (
+    DELETE FROM
+        "SemaphoreQueueItem" sqi
+    -- delete when step run id AND worker id tuples match
+    USING
+        lost_step_runs srs
+    WHERE
+        sqi."stepRunId" = srs."id"
+        AND sqi."workerId" = srs."workerId"
+),
+deleted_tqis AS (
+    DELETE FROM
+        "TimeoutQueueItem" tqi
+    -- delete when step run id AND retry count tuples match
+    USING
+        lost_step_runs srs
+    WHERE
+        tqi."stepRunId" = srs."id"
+        AND tqi."retryCount" = srs."retryCount"
+),
+deleted_heartbeats AS (
+    DELETE FROM
+        "StepRunHeartbeat" hb
+    USING
+        step_runs_to_reassign srs
+    WHERE
+        hb."stepRunId" = srs."id"
+),
+inserted_queue_items AS (
+    INSERT INTO "QueueItem" (
+        "stepRunId",
+        "stepId",
+        "actionId",
+        "scheduleTimeoutAt",
+        "stepTimeout",
+        "priority",
+        "isQueued",
+        "tenantId",
+        "queue",
+        "deadline"
+    )
+    SELECT
+        srs."id",
+        srs."stepId",
+        srs."actionId",
+        CURRENT_TIMESTAMP + COALESCE(convert_duration_to_interval(srs."scheduleTimeout"), INTERVAL '5 minutes'),
+        srs."stepTimeout",
+        -- Queue with priority 4 so that reassignment gets highest priority
+        4,
+        true,
+        srs."tenantId",
+        srs."actionId",
+        srs."deadline"
+    FROM
+        step_runs_to_reassign srs
+),
+updated_step_runs AS (
+    UPDATE "StepRun" sr
+    SET
+        "status" = 'PENDING_ASSIGNMENT',
+        "scheduleTimeoutAt" = CURRENT_TIMESTAMP + COALESCE(convert_duration_to_interval(srs."scheduleTimeout"), INTERVAL '5 minutes'),
+        "updatedAt" = CURRENT_TIMESTAMP,
+        "internalRetryCount" = sr."internalRetryCount" + 1
+    FROM step_runs_to_reassign srs
+    WHERE sr."id" = srs."id"
+    RETURNING sr."id"
+)
+SELECT
+    srs1."id",
+    srs1."workerId",
+    srs1."retryCount",
+    'REASSIGNED' AS "operation"
+FROM
+    step_runs_to_reassign srs1
+UNION ALL
+SELECT
+    srs2."id",
+    srs2."workerId",
+    srs2."retryCount",
+    'FAILED' AS "operation"
+FROM
+    step_runs_to_fail srs2;
+
+-- name: UpsertStepRunHeartbeats :exec
+-- Records a heartbeat for the step runs which are running on the worker. Step runs which are no longer running on
+-- the worker, for example because they were reassigned, are skipped.
+INSERT INTO "StepRunHeartbeat" (
+    "stepRunId",
+    "tenantId",
+    "workerId",
+    "lastHeartbeatAt"
+)
+SELECT
+    sr."id",
+    sr."tenantId",
+    sr."workerId",
+    @heartbeatAt::timestamp
+FROM
+    "StepRun" sr
+WHERE
+    sr."id" = ANY(@stepRunIds::uuid[])
+    AND sr."tenantId" = @tenantId::uuid
+    AND sr."workerId" = @workerId::uuid
+    AND sr."status" = 'RUNNING'
+ON CONFLICT ("stepRunId") DO UPDATE
+SET
+    "workerId" = EXCLUDED."workerId",
+    "lastHeartbeatAt" = EXCLUDED."lastHeartbeatAt";
@@ -0,0 +1,32 @@
+-- name: UpsertStepRunProgress :one
+-- Stores the latest progress of a step run, replacing the progress which it reported before.
+INSERT INTO "StepRunProgress" (
+    "stepRunId",
+    "tenantId",
+    "percent",
+    "message",
+    "data",
+    "updatedAt"
+) VALUES (
+    @stepRunId::uuid,
+    @tenantId::uuid,
+    @percent::double precision,
+    sqlc.narg('message')::text,
+    sqlc.narg('data')::jsonb,
+    CURRENT_TIMESTAMP
+)
+ON CONFLICT ("stepRunId") DO UPDATE
+SET
+    "percent" = EXCLUDED."percent",
+    "message" = EXCLUDED."message",
+    "data" = EXCLUDED."data",
+    "updatedAt" = EXCLUDED."updatedAt"
+RETURNING *;
+
+-- name: GetStepRunProgress :one
+SELECT
+    *
+FROM
+    "StepRunProgress"
+WHERE
+    "stepRunId" = @stepRunId::uuid;
@@ -27,11 +27,21 @@ SELECT
     sr."input",
     sr."output",
     sr."error",
-    jrld."data" AS "jobRunLookupData",
+    -- the lookup data is merged with the entries which weren't compacted yet
+    (CASE
+        WHEN tail."removed" IS NULL OR jrld."data" IS NULL THEN jrld."data"
+        ELSE jsonb_set(
+            jrld."data",
+            '{steps}',
+            (COALESCE(jrld."data"->'steps', '{}'::jsonb) - tail."removed") || tail."steps",
+            true
+        )
+    END)::jsonb AS "jobRunLookupData",
     wr."additionalMetadata",
     wr."childIndex",
     wr."childKey",
     wr."parentId",
+    wr."traceContext",
     COALESCE(ec."exprCount", 0) AS "exprCount"
 FROM
     "StepRun" sr
@@ -39,6 +49,22 @@ JOIN
     "JobRun" jr ON sr."jobRunId" = jr."id"
 JOIN
     "JobRunLookupData" jrld ON jr."id" = jrld."jobRunId"
+LEFT JOIN LATERAL (
+    SELECT
+        ARRAY_AGG(latest."readableId") AS "removed",
+        COALESCE(jsonb_object_agg(latest."readableId", latest."data") FILTER (WHERE latest."data" IS NOT NULL), '{}'::jsonb) AS "steps"
+    FROM (
+        SELECT DISTINCT ON (e."readableId")
+            e."readableId",
+            e."data"
+        FROM
+            "JobRunLookupDataEntry" e
+        WHERE
+            e."jobRunId" = jr."id"
+        ORDER BY
+            e."readableId", e."id" DESC
+    ) latest
+) tail ON TRUE
 JOIN
     -- Take advantage of composite index on "JobRun"("workflowRunId", "tenantId")
     "WorkflowRun" wr ON jr."workflowRunId" = wr."id" AND wr."tenantId" = @tenantId::uuid
@@ -60,11 +86,21 @@ SELECT
     jr."status" AS "jobRunStatus",
     jr."status" AS "jobRunStatus",
     jr."workflowRunId" AS "workflowRunId",
-    jrld."data" AS "jobRunLookupData",
+    -- the lookup data is merged with the entries which weren't compacted yet
+    (CASE
+        WHEN tail."removed" IS NULL OR jrld."data" IS NULL THEN jrld."data"
+        ELSE jsonb_set(
+            jrld."data",
+            '{steps}',
+            (COALESCE(jrld."data"->'steps', '{}'::jsonb) - tail."removed") || tail."steps",
+            true
+        )
+    END)::jsonb AS "jobRunLookupData",
     wr."additionalMetadata",
     wr."childIndex",
     wr."childKey",
     wr."parentId",
+    wr."traceContext",
     jr."id" AS "jobRunId",
     s."id" AS "stepId",
     s."retries" AS "stepRetries",
@@ -89,6 +125,22 @@ JOIN
     "Job" j ON jr."jobId" = j."id"
 JOIN
     "JobRunLookupData" jrld ON jr."id" = jrld."jobRunId"
+LEFT JOIN LATERAL (
+    SELECT
+        ARRAY_AGG(latest."readableId") AS "removed",
+        COALESCE(jsonb_object_agg(latest."readableId", latest."data") FILTER (WHERE latest."data" IS NOT NULL), '{}'::jsonb) AS "steps"
+    FROM (
+        SELECT DISTINCT ON (e."readableId")
+            e."readableId",
+            e."data"
+        FROM
+            "JobRunLookupDataEntry" e
+        WHERE
+            e."jobRunId" = jr."id"
+        ORDER BY
+            e."readableId", e."id" DESC
+    ) latest
+) tail ON TRUE
 JOIN
     -- Take advantage of composite index on "JobRun"("workflowRunId", "tenantId")
     "WorkflowRun" wr ON jr."workflowRunId" = wr."id" AND wr."tenantId" = @tenantId::uuid
@@ -195,6 +247,7 @@ SELECT
     sr."retryCount" AS "SR_retryCount",
     sr."semaphoreReleased" AS "SR_semaphoreReleased",
     sr."priority" AS "SR_priority",
+    sr."deadline" AS "SR_deadline",
     COALESCE(cc."childCount", 0) AS "SR_childCount",
     -- TODO: everything below this line is cacheable and should be moved to a separate query
     jr."id" AS "jobRunId",
@@ -206,6 +259,17 @@ SELECT
     s."customUserData" AS "stepCustomUserData",
     s."retryBackoffFactor" AS "stepRetryBackoffFactor",
     s."retryMaxBackoff" AS "stepRetryMaxBackoff",
+    s."retryInitialBackoff" AS "stepRetryInitialBackoff",
+    s."retryJitter" AS "stepRetryJitter",
+    s."heartbeatTimeout" AS "stepHeartbeatTimeout",
+    s."sleepFor" AS "stepSleepFor",
+    s."sleepUntil" AS "stepSleepUntil",
+    s."waitForEvent" AS "stepWaitForEvent",
+    s."waitForEventCorrelation" AS "stepWaitForEventCorrelation",
+    s."condition" AS "stepCondition",
+    s."mapOver" AS "stepMapOver",
+    s."mapConcurrency" AS "stepMapConcurrency",
+    s."cacheTtl" AS "stepCacheTtl",
     j."name" AS "jobName",
     j."id" AS "jobId",
     j."kind" AS "jobKind",
@@ -241,17 +305,23 @@ WHERE
     );
 
 -- name: ListInitialStepRuns :many
+-- Lists the pending step runs whose parent step runs have all succeeded or been skipped. These are the step runs
+-- without parents, and the step runs after the reused step runs of a workflow run replayed from a step.
 SELECT
-    DISTINCT ON (child_run."id")
     child_run."id" AS "id"
 FROM
     "StepRun" AS child_run
-LEFT JOIN
-    "_StepRunOrder" AS step_run_order ON step_run_order."B" = child_run."id"
 WHERE
     child_run."jobRunId" = @jobRunId::uuid
     AND child_run."status" = 'PENDING'
-    AND step_run_order."A" IS NULL;
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "_StepRunOrder" AS parent_order
+        JOIN "StepRun" AS parent_run ON parent_order."A" = parent_run."id"
+        WHERE
+            parent_order."B" = child_run."id"
+            AND parent_run."status" NOT IN ('SUCCEEDED', 'SKIPPED')
+    );
 
 -- name: ListStartableStepRunsManyParents :many
 SELECT
@@ -266,14 +336,15 @@ JOIN
 WHERE
     parent_run."id" = @parentStepRunId::uuid
     AND child_run."status" = 'PENDING'
-    -- we look for whether the step run is startable by ensuring that all parent step runs have succeeded
+    -- we look for whether the step run is startable by ensuring that all parent step runs have succeeded or
+    -- have been skipped
     AND NOT EXISTS (
         SELECT 1
         FROM "_StepRunOrder" AS parent_order
         JOIN "StepRun" AS parent_run ON parent_order."A" = parent_run."id"
         WHERE
             parent_order."B" = child_run."id"
-            AND parent_run."status" != 'SUCCEEDED'
+            AND parent_run."status" NOT IN ('SUCCEEDED', 'SKIPPED')
     )
     -- AND we ensure that there's at least 2 parent step runs
     AND EXISTS (
@@ -357,6 +428,7 @@ WHERE
     "StepRun"."status" = ANY(ARRAY['PENDING', 'PENDING_ASSIGNMENT', 'ASSIGNED', 'RUNNING']::"StepRunStatus"[]);
 
 -- name: QueueStepRun :exec
+-- Queues the step run if it's in one of the given statuses.
 UPDATE
     "StepRun"
 SET
@@ -374,9 +446,11 @@ SET
     "semaphoreReleased" = false
 WHERE
   "id" = @id::uuid AND
-  "tenantId" = @tenantId::uuid;
+  "tenantId" = @tenantId::uuid AND
+  "status" = ANY(@fromStatuses::"StepRunStatus"[]);
 
--- name: QueueStepRunBulkWithInput :exec
+-- name: QueueStepRunBulkWithInput :many
+-- Queues the step runs which are in one of the given statuses, and returns the ids of the queued step runs.
 WITH input AS (
     SELECT
         unnest(@ids::uuid[]) AS "id",
@@ -398,9 +472,12 @@ SET
 FROM
     input
 WHERE
-    sr."id" = input."id";
+    sr."id" = input."id"
+    AND sr."status" = ANY(@fromStatuses::"StepRunStatus"[])
+RETURNING sr."id";
 
--- name: QueueStepRunBulkNoInput :exec
+-- name: QueueStepRunBulkNoInput :many
+-- Queues the step runs which are in one of the given statuses, and returns the ids of the queued step runs.
 WITH input AS (
     SELECT
         unnest(@ids::uuid[]) AS "id",
@@ -420,7 +497,22 @@ SET
 FROM
     input
 WHERE
-    sr."id" = input."id";
+    sr."id" = input."id"
+    AND sr."status" = ANY(@fromStatuses::"StepRunStatus"[])
+RETURNING sr."id";
+
+-- name: QueueWorkerlessStepRun :execrows
+-- Moves a step run which doesn't run on a worker to pending assignment if it's in one of the given statuses. Pending
+-- step runs aren't allowed to start or finish, so this is written before the step run is started without being
+-- assigned.
+UPDATE
+    "StepRun"
+SET
+    "status" = 'PENDING_ASSIGNMENT'
+WHERE
+    "id" = @stepRunId::uuid
+    AND "tenantId" = @tenantId::uuid
+    AND "status" = ANY(@fromStatuses::"StepRunStatus"[]);
 
 -- name: ManualReleaseSemaphore :exec
 UPDATE
@@ -432,15 +524,12 @@ WHERE
     "id" = @stepRunId::uuid AND
     "tenantId" = @tenantId::uuid;
 
--- name: BulkStartStepRun :exec
+-- name: BulkStartStepRun :many
+-- Starts the step runs which are in one of the given statuses, and returns the ids of the started step runs.
 UPDATE
     "StepRun"
 SET
-    "status" = CASE
-        -- Final states are final, cannot be updated, and we cannot go from cancelling to a non-final state
-        WHEN "status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED', 'CANCELLING') THEN "status"
-        ELSE 'RUNNING'
-    END,
+    "status" = 'RUNNING',
     "startedAt" = input."startedAt"
 FROM (
     SELECT
@@ -448,19 +537,19 @@ FROM (
         unnest(@startedAts::timestamp[]) AS "startedAt"
     ) AS input
 WHERE
-    "StepRun"."id" = input."id";
+    "StepRun"."id" = input."id"
+    AND "StepRun"."status" = ANY(@fromStatuses::"StepRunStatus"[])
+RETURNING "StepRun"."id";
 
 -- name: ValidatesAsJson :exec
 SELECT @input::jsonb AS "is_valid";
 
--- name: BulkFinishStepRun :exec
+-- name: BulkFinishStepRun :many
+-- Finishes the step runs which are in one of the given statuses, and returns the ids of the finished step runs.
 UPDATE
     "StepRun"
 SET
-    "status" = CASE
-        WHEN "status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN "status"
-        ELSE 'SUCCEEDED'
-    END,
+    "status" = 'SUCCEEDED',
     "finishedAt" = input."finishedAt",
     "output" = input."output"::jsonb
 FROM (
@@ -470,9 +559,12 @@ FROM (
         unnest(@outputs::jsonb[]) AS "output"
     ) AS input
 WHERE
-    "StepRun"."id" = input."id";
+    "StepRun"."id" = input."id"
+    AND "StepRun"."status" = ANY(@fromStatuses::"StepRunStatus"[])
+RETURNING "StepRun"."id";
 
--- name: BulkCancelStepRun :exec
+-- name: BulkCancelStepRun :many
+-- Cancels the step runs which are in one of the given statuses, and returns the ids of the cancelled step runs.
 WITH input AS (
     SELECT
         unnest(@stepRunIds::uuid[]) AS "id",
@@ -483,28 +575,24 @@ WITH input AS (
 )
 UPDATE "StepRun"
 SET
-    "status" = CASE
-        -- Final states are final, cannot be updated
-        WHEN "status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN "status"
-        ELSE 'CANCELLED'
-    END,
+    "status" = 'CANCELLED',
     "finishedAt" = input."finishedAt",
     "cancelledAt" = input."cancelledAt",
     "cancelledReason" = input."cancelledReason",
     "cancelledError" = input."cancelledError"
 FROM input
-WHERE "StepRun"."id" = input."id";
+WHERE
+    "StepRun"."id" = input."id"
+    AND "StepRun"."status" = ANY(@fromStatuses::"StepRunStatus"[])
+RETURNING "StepRun"."id";
 
 
--- name: BulkFailStepRun :exec
+-- name: BulkFailStepRun :many
+-- Fails the step runs which are in one of the given statuses, and returns the ids of the failed step runs.
 UPDATE
     "StepRun"
 SET
-    "status" = CASE
-        -- Final states are final, cannot be updated
-        WHEN "status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN "status"
-        ELSE 'FAILED'
-    END,
+    "status" = 'FAILED',
     "finishedAt" = input."finishedAt",
     "error" = input."error"::text
 FROM (
@@ -514,9 +602,12 @@ FROM (
         unnest(@errors::text[]) AS "error"
     ) AS input
 WHERE
-    "StepRun"."id" = input."id";
+    "StepRun"."id" = input."id"
+    AND "StepRun"."status" = ANY(@fromStatuses::"StepRunStatus"[])
+RETURNING "StepRun"."id";
 
 -- name: ResolveLaterStepRuns :many
+-- Cancels the later step runs of a failed or cancelled step run which are in one of the given statuses.
 WITH RECURSIVE currStepRun AS (
   SELECT "id", "status", "cancelledReason"
   FROM "StepRun"
@@ -538,16 +629,16 @@ WITH RECURSIVE currStepRun AS (
 UPDATE
     "StepRun" as sr
 SET  "status" = CASE
-    -- When the step is in a final state, it cannot be updated
-    WHEN sr."status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN sr."status"
+    -- When the step run can't be cancelled, like when it's in a final state, it isn't updated
+    WHEN NOT sr."status" = ANY(@fromStatuses::"StepRunStatus"[]) THEN sr."status"
     -- When the given step run has failed or been cancelled, then all child step runs are cancelled
     WHEN @status::"StepRunStatus" IN ('FAILED', 'CANCELLED') THEN 'CANCELLED'
     ELSE sr."status"
     END,
     -- When the previous step run timed out, the cancelled reason is set
     "cancelledReason" = CASE
-    -- When the step is in a final state, it cannot be updated
-    WHEN sr."status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN sr."cancelledReason"
+    -- When the step run can't be cancelled, like when it's in a final state, it isn't updated
+    WHEN NOT sr."status" = ANY(@fromStatuses::"StepRunStatus"[]) THEN sr."cancelledReason"
     WHEN @status::"StepRunStatus" = 'CANCELLED' AND (SELECT "cancelledReason" FROM currStepRun) = 'TIMED_OUT'::text THEN 'PREVIOUS_STEP_TIMED_OUT'
     WHEN @status::"StepRunStatus" = 'FAILED' THEN 'PREVIOUS_STEP_FAILED'
     WHEN @status::"StepRunStatus" = 'CANCELLED' THEN 'PREVIOUS_STEP_CANCELLED'
@@ -642,30 +733,49 @@ SELECT
 FROM step_run_data
 RETURNING *;
 
--- name: ListStepRunsToReassign :many
-WITH step_runs_on_inactive_workers AS (
+-- name: BulkReassignStepRuns :many
+-- Reassigns or fails a batch of step runs on inactive workers, ordered by step run id. Step runs which can't be
+-- reassigned, since they're out of internal retries or aren't in one of the given statuses, are returned to be
+-- failed. Pass the last step run id of the previous batch as the cursor to continue.
+WITH inactive_workers AS (
+    SELECT
+        w."id"
+    FROM
+        "Worker" w
+    WHERE
+        w."tenantId" = @tenantId::uuid
+        AND w."lastHeartbeatAt" < NOW() - INTERVAL '30 seconds'
+),
+step_runs_on_inactive_workers AS (
     SELECT
         sr."id",
         sr."tenantId",
+        sr."status",
         sr."scheduleTimeoutAt",
         sr."retryCount",
         sr."internalRetryCount",
+        sr."deadline",
         sqi."workerId",
         s."actionId",
         s."id" AS "stepId",
         s."timeout" AS "stepTimeout",
         s."scheduleTimeout" AS "scheduleTimeout"
     FROM
-        "Worker" w
-    LEFT JOIN
+        inactive_workers w
+    JOIN
         "SemaphoreQueueItem" sqi ON w."id" = sqi."workerId"
     JOIN
         "StepRun" sr ON sr."id" = sqi."stepRunId"
     JOIN
         "Step" s ON sr."stepId" = s."id"
     WHERE
-        w."tenantId" = @tenantId::uuid
-        AND w."lastHeartbeatAt" < NOW() - INTERVAL '30 seconds'),
+        sqlc.narg('cursor')::uuid IS NULL
+        OR sqi."stepRunId" > sqlc.narg('cursor')::uuid
+    ORDER BY
+        sqi."stepRunId" ASC
+    LIMIT
+        @batchSize::int
+),
 step_runs_to_reassign AS (
     SELECT
         *
@@ -673,6 +783,7 @@ step_runs_to_reassign AS (
         step_runs_on_inactive_workers
     WHERE
         "internalRetryCount" < @maxInternalRetryCount::int
+        AND "status" = ANY(@fromStatuses::"StepRunStatus"[])
 ),
 step_runs_to_fail AS (
     SELECT
@@ -681,6 +792,7 @@ step_runs_to_fail AS (
         step_runs_on_inactive_workers
     WHERE
         "internalRetryCount" >= @maxInternalRetryCount::int
+        OR NOT "status" = ANY(@fromStatuses::"StepRunStatus"[])
 ),
 deleted_sqis AS (
     DELETE FROM
@@ -712,7 +824,8 @@ inserted_queue_items AS (
         "priority",
         "isQueued",
         "tenantId",
-        "queue"
+        "queue",
+        "deadline"
     )
     SELECT
         srs."id",
@@ -724,7 +837,8 @@ inserted_queue_items AS (
         4,
         true,
         srs."tenantId",
-        srs."actionId"
+        srs."actionId",
+        srs."deadline"
     FROM
         step_runs_to_reassign srs
 ),
@@ -764,6 +878,41 @@ WHERE
     AND "tenantId" = @tenantId::uuid
 LIMIT 100;
 
+-- name: PopStepRunsToHeartbeatTimeout :many
+-- Marks running step runs as cancelling when their worker hasn't sent a heartbeat within the step's
+-- heartbeat timeout, and returns their ids.
+WITH step_runs AS (
+    SELECT
+        sr."id"
+    FROM
+        "StepRun" sr
+    JOIN
+        "Step" s ON sr."stepId" = s."id"
+    JOIN
+        "SemaphoreQueueItem" sqi ON sr."id" = sqi."stepRunId"
+    JOIN
+        "Worker" w ON sqi."workerId" = w."id"
+    WHERE
+        sr."tenantId" = @tenantId::uuid
+        AND sr."status" = 'RUNNING'
+        AND s."heartbeatTimeout" IS NOT NULL
+        AND w."lastHeartbeatAt" < NOW() - convert_duration_to_interval(s."heartbeatTimeout")
+    LIMIT
+        COALESCE(sqlc.narg('limit')::integer, 100)
+    FOR UPDATE OF sr SKIP LOCKED
+)
+UPDATE
+    "StepRun" sr
+SET
+    "status" = 'CANCELLING',
+    "updatedAt" = CURRENT_TIMESTAMP
+FROM
+    step_runs
+WHERE
+    sr."id" = step_runs."id"
+RETURNING
+    sr."id";
+
 -- name: RefreshTimeoutBy :one
 WITH step_run AS (
     SELECT
@@ -920,24 +1069,31 @@ WITH input AS (
         sr."id",
         sr."retryCount",
         sr."tenantId",
-        CURRENT_TIMESTAMP + convert_duration_to_interval(input."stepTimeout") AS "timeoutAt"
+        CURRENT_TIMESTAMP + convert_duration_to_interval(input."stepTimeout") AS "timeoutAt",
+        COALESCE(s."slotType", 'default') AS "slotType"
     FROM
         input
     JOIN
         "StepRun" sr ON sr."id" = input."id"
+    JOIN
+        "Step" s ON s."id" = sr."stepId"
     ORDER BY sr."id"
 ), assigned_step_runs AS (
     INSERT INTO "SemaphoreQueueItem" (
         "stepRunId",
         "workerId",
-        "tenantId"
+        "tenantId",
+        "slotType"
     )
     SELECT
         input."id",
         input."workerId",
-        @tenantId::uuid
+        @tenantId::uuid,
+        COALESCE(usr."slotType", 'default')
     FROM
         input
+    LEFT JOIN
+        updated_step_runs usr ON usr."id" = input."id"
     ON CONFLICT ("stepRunId") DO NOTHING
     -- only return the step run ids that were successfully assigned
     RETURNING "stepRunId", "workerId"
@@ -980,17 +1136,15 @@ FROM
     "StepRun"
 WHERE
     "id" = ANY(@stepRunIds::uuid[])
-    AND "status" = ANY(ARRAY['SUCCEEDED', 'FAILED', 'CANCELLED', 'CANCELLING']::"StepRunStatus"[]);
+    AND "status" = ANY(ARRAY['SUCCEEDED', 'FAILED', 'CANCELLED', 'SKIPPED', 'CANCELLING']::"StepRunStatus"[]);
 
 -- name: BulkMarkStepRunsAsCancelling :many
+-- Marks the step runs which are in one of the given statuses as cancelling, and returns the ids of the step runs
+-- which were marked.
 UPDATE
     "StepRun" sr
 SET
-    "status" = CASE
-        -- Final states are final, we cannot go from a final state to cancelling
-        WHEN "status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN "status"
-        ELSE 'CANCELLING'
-    END,
+    "status" = 'CANCELLING',
     "updatedAt" = CURRENT_TIMESTAMP
 FROM (
     SELECT
@@ -998,6 +1152,7 @@ FROM (
     ) AS input
 WHERE
     sr."id" = input."id"
+    AND sr."status" = ANY(@fromStatuses::"StepRunStatus"[])
 RETURNING sr."id";
 
 -- name: GetDesiredLabels :many
@@ -1014,6 +1169,41 @@ FROM
 WHERE
     "stepId" = ANY(@stepIds::uuid[]);
 
+-- name: GetDesiredRegions :many
+SELECT
+    s."id" AS "stepId",
+    wv."region",
+    wv."regionStrategy"
+FROM
+    "Step" s
+JOIN
+    "Job" j ON j."id" = s."jobId"
+JOIN
+    "WorkflowVersion" wv ON wv."id" = j."workflowVersionId"
+WHERE
+    s."id" = ANY(@stepIds::uuid[])
+    AND wv."region" IS NOT NULL;
+
+-- name: GetDesiredSlotTypes :many
+SELECT
+    s."id" AS "stepId",
+    s."slotType"
+FROM
+    "Step" s
+WHERE
+    s."id" = ANY(@stepIds::uuid[])
+    AND s."slotType" IS NOT NULL;
+
+-- name: GetDesiredPools :many
+SELECT
+    s."id" AS "stepId",
+    s."pool"
+FROM
+    "Step" s
+WHERE
+    s."id" = ANY(@stepIds::uuid[])
+    AND s."pool" IS NOT NULL;
+
 -- name: GetWorkerLabels :many
 SELECT
     "key",
@@ -1404,7 +1594,7 @@ JOIN
     childStepRuns csr ON sr."id" = csr."id"
 WHERE
     sr."deletedAt" IS NULL AND
-    sr."status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED');
+    sr."status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED', 'SKIPPED');
 
 -- name: ListStepRunArchives :many
 SELECT
@@ -1510,3 +1700,53 @@ WHERE
     "parentStepRunId" = @stepRun::uuid
     AND "tenantId" = @tenantId::uuid
     AND "deletedAt" IS NULL;
+
+-- name: CreateStepRunTimer :exec
+-- Creates the timer of a sleeping step run. If the step run already has a timer (for example, when it is
+-- replayed), the timer is reset.
+INSERT INTO "StepRunTimer" (
+    "stepRunId",
+    "tenantId",
+    "wakeAt"
+) VALUES (
+    @stepRunId::uuid,
+    @tenantId::uuid,
+    @wakeAt::timestamp
+)
+ON CONFLICT ("stepRunId") DO UPDATE
+SET
+    "wakeAt" = EXCLUDED."wakeAt",
+    "tickerId" = NULL;
+
+-- name: CountStepRunParents :one
+-- Counts the parents of a step run, and how many of them have been skipped.
+SELECT
+    COUNT(*) AS "total",
+    COUNT(*) FILTER (WHERE parent_run."status" = 'SKIPPED') AS "skipped"
+FROM
+    "_StepRunOrder" AS parent_order
+JOIN
+    "StepRun" AS parent_run ON parent_order."A" = parent_run."id"
+WHERE
+    parent_order."B" = @stepRunId::uuid;
+
+-- name: SkipStepRun :execrows
+-- Marks a pending step run as skipped. Step runs which have already been queued are not updated.
+UPDATE
+    "StepRun"
+SET
+    "status" = 'SKIPPED',
+    "finishedAt" = @skippedAt::timestamp,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @stepRunId::uuid
+    AND "tenantId" = @tenantId::uuid
+    AND "status" = 'PENDING';
+
+-- name: GetStepRunStatuses :many
+SELECT
+    "id", "status"
+FROM
+    "StepRun"
+WHERE
+    "id" = ANY(@stepRunIds::uuid[]);
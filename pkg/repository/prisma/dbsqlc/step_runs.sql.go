@@ -108,31 +108,31 @@ func (q *Queries) ArchiveStepRunResultFromStepRun(ctx context.Context, db DBTX,
 	return &i, err
 }
 
-const bulkCancelStepRun = `-- name: BulkCancelStepRun :exec
+const bulkCancelStepRun = `-- name: BulkCancelStepRun :many
 WITH input AS (
     SELECT
-        unnest($1::uuid[]) AS "id",
-        unnest($2::timestamp[]) AS "finishedAt",
-        unnest($3::timestamp[]) AS "cancelledAt",
-        unnest($4::text[]) AS "cancelledReason",
-        unnest($5::text[]) AS "cancelledError"
+        unnest($2::uuid[]) AS "id",
+        unnest($3::timestamp[]) AS "finishedAt",
+        unnest($4::timestamp[]) AS "cancelledAt",
+        unnest($5::text[]) AS "cancelledReason",
+        unnest($6::text[]) AS "cancelledError"
 )
 UPDATE "StepRun"
 SET
-    "status" = CASE
-        -- Final states are final, cannot be updated
-        WHEN "status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN "status"
-        ELSE 'CANCELLED'
-    END,
+    "status" = 'CANCELLED',
     "finishedAt" = input."finishedAt",
     "cancelledAt" = input."cancelledAt",
     "cancelledReason" = input."cancelledReason",
     "cancelledError" = input."cancelledError"
 FROM input
-WHERE "StepRun"."id" = input."id"
+WHERE
+    "StepRun"."id" = input."id"
+    AND "StepRun"."status" = ANY($1::"StepRunStatus"[])
+RETURNING "StepRun"."id"
 `
 
 type BulkCancelStepRunParams struct {
+	Fromstatuses     []StepRunStatus    `json:"fromstatuses"`
 	Steprunids       []pgtype.UUID      `json:"steprunids"`
 	Finishedats      []pgtype.Timestamp `json:"finishedats"`
 	Cancelledats     []pgtype.Timestamp `json:"cancelledats"`
@@ -140,15 +140,32 @@ type BulkCancelStepRunParams struct {
 	Cancellederrors  []string           `json:"cancellederrors"`
 }
 
-func (q *Queries) BulkCancelStepRun(ctx context.Context, db DBTX, arg BulkCancelStepRunParams) error {
-	_, err := db.Exec(ctx, bulkCancelStepRun,
+// Cancels the step runs which are in one of the given statuses, and returns the ids of the cancelled step runs.
+func (q *Queries) BulkCancelStepRun(ctx context.Context, db DBTX, arg BulkCancelStepRunParams) ([]pgtype.UUID, error) {
+	rows, err := db.Query(ctx, bulkCancelStepRun,
+		arg.Fromstatuses,
 		arg.Steprunids,
 		arg.Finishedats,
 		arg.Cancelledats,
 		arg.Cancelledreasons,
 		arg.Cancellederrors,
 	)
-	return err
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const bulkCreateStepRunEvent = `-- name: BulkCreateStepRunEvent :exec
@@ -239,90 +256,135 @@ func (q *Queries) BulkCreateStepRunEvent(ctx context.Context, db DBTX, arg BulkC
 	return err
 }
 
-const bulkFailStepRun = `-- name: BulkFailStepRun :exec
+const bulkFailStepRun = `-- name: BulkFailStepRun :many
 UPDATE
     "StepRun"
 SET
-    "status" = CASE
-        -- Final states are final, cannot be updated
-        WHEN "status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN "status"
-        ELSE 'FAILED'
-    END,
+    "status" = 'FAILED',
     "finishedAt" = input."finishedAt",
     "error" = input."error"::text
 FROM (
     SELECT
-        unnest($1::uuid[]) AS "id",
-        unnest($2::timestamp[]) AS "finishedAt",
-        unnest($3::text[]) AS "error"
+        unnest($2::uuid[]) AS "id",
+        unnest($3::timestamp[]) AS "finishedAt",
+        unnest($4::text[]) AS "error"
     ) AS input
 WHERE
     "StepRun"."id" = input."id"
+    AND "StepRun"."status" = ANY($1::"StepRunStatus"[])
+RETURNING "StepRun"."id"
 `
 
 type BulkFailStepRunParams struct {
-	Steprunids  []pgtype.UUID      `json:"steprunids"`
-	Finishedats []pgtype.Timestamp `json:"finishedats"`
-	Errors      []string           `json:"errors"`
+	Fromstatuses []StepRunStatus    `json:"fromstatuses"`
+	Steprunids   []pgtype.UUID      `json:"steprunids"`
+	Finishedats  []pgtype.Timestamp `json:"finishedats"`
+	Errors       []string           `json:"errors"`
 }
 
-func (q *Queries) BulkFailStepRun(ctx context.Context, db DBTX, arg BulkFailStepRunParams) error {
-	_, err := db.Exec(ctx, bulkFailStepRun, arg.Steprunids, arg.Finishedats, arg.Errors)
-	return err
+// Fails the step runs which are in one of the given statuses, and returns the ids of the failed step runs.
+func (q *Queries) BulkFailStepRun(ctx context.Context, db DBTX, arg BulkFailStepRunParams) ([]pgtype.UUID, error) {
+	rows, err := db.Query(ctx, bulkFailStepRun,
+		arg.Fromstatuses,
+		arg.Steprunids,
+		arg.Finishedats,
+		arg.Errors,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const bulkFinishStepRun = `-- name: BulkFinishStepRun :exec
+const bulkFinishStepRun = `-- name: BulkFinishStepRun :many
 UPDATE
     "StepRun"
 SET
-    "status" = CASE
-        WHEN "status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN "status"
-        ELSE 'SUCCEEDED'
-    END,
+    "status" = 'SUCCEEDED',
     "finishedAt" = input."finishedAt",
     "output" = input."output"::jsonb
 FROM (
     SELECT
-        unnest($1::uuid[]) AS "id",
-        unnest($2::timestamp[]) AS "finishedAt",
-        unnest($3::jsonb[]) AS "output"
+        unnest($2::uuid[]) AS "id",
+        unnest($3::timestamp[]) AS "finishedAt",
+        unnest($4::jsonb[]) AS "output"
     ) AS input
 WHERE
     "StepRun"."id" = input."id"
+    AND "StepRun"."status" = ANY($1::"StepRunStatus"[])
+RETURNING "StepRun"."id"
 `
 
 type BulkFinishStepRunParams struct {
-	Steprunids  []pgtype.UUID      `json:"steprunids"`
-	Finishedats []pgtype.Timestamp `json:"finishedats"`
-	Outputs     [][]byte           `json:"outputs"`
+	Fromstatuses []StepRunStatus    `json:"fromstatuses"`
+	Steprunids   []pgtype.UUID      `json:"steprunids"`
+	Finishedats  []pgtype.Timestamp `json:"finishedats"`
+	Outputs      [][]byte           `json:"outputs"`
 }
 
-func (q *Queries) BulkFinishStepRun(ctx context.Context, db DBTX, arg BulkFinishStepRunParams) error {
-	_, err := db.Exec(ctx, bulkFinishStepRun, arg.Steprunids, arg.Finishedats, arg.Outputs)
-	return err
+// Finishes the step runs which are in one of the given statuses, and returns the ids of the finished step runs.
+func (q *Queries) BulkFinishStepRun(ctx context.Context, db DBTX, arg BulkFinishStepRunParams) ([]pgtype.UUID, error) {
+	rows, err := db.Query(ctx, bulkFinishStepRun,
+		arg.Fromstatuses,
+		arg.Steprunids,
+		arg.Finishedats,
+		arg.Outputs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const bulkMarkStepRunsAsCancelling = `-- name: BulkMarkStepRunsAsCancelling :many
 UPDATE
     "StepRun" sr
 SET
-    "status" = CASE
-        -- Final states are final, we cannot go from a final state to cancelling
-        WHEN "status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN "status"
-        ELSE 'CANCELLING'
-    END,
+    "status" = 'CANCELLING',
     "updatedAt" = CURRENT_TIMESTAMP
 FROM (
     SELECT
-        unnest($1::uuid[]) AS "id"
+        unnest($2::uuid[]) AS "id"
     ) AS input
 WHERE
     sr."id" = input."id"
+    AND sr."status" = ANY($1::"StepRunStatus"[])
 RETURNING sr."id"
 `
 
-func (q *Queries) BulkMarkStepRunsAsCancelling(ctx context.Context, db DBTX, steprunids []pgtype.UUID) ([]pgtype.UUID, error) {
-	rows, err := db.Query(ctx, bulkMarkStepRunsAsCancelling, steprunids)
+type BulkMarkStepRunsAsCancellingParams struct {
+	Fromstatuses []StepRunStatus `json:"fromstatuses"`
+	Steprunids   []pgtype.UUID   `json:"steprunids"`
+}
+
+// Marks the step runs which are in one of the given statuses as cancelling, and returns the ids of the step runs
+// which were marked.
+func (q *Queries) BulkMarkStepRunsAsCancelling(ctx context.Context, db DBTX, arg BulkMarkStepRunsAsCancellingParams) ([]pgtype.UUID, error) {
+	rows, err := db.Query(ctx, bulkMarkStepRunsAsCancelling, arg.Fromstatuses, arg.Steprunids)
 	if err != nil {
 		return nil, err
 	}
@@ -341,33 +403,231 @@ func (q *Queries) BulkMarkStepRunsAsCancelling(ctx context.Context, db DBTX, ste
 	return items, nil
 }
 
-const bulkStartStepRun = `-- name: BulkStartStepRun :exec
+const bulkReassignStepRuns = `-- name: BulkReassignStepRuns :many
+WITH inactive_workers AS (
+    SELECT
+        w."id"
+    FROM
+        "Worker" w
+    WHERE
+        w."tenantId" = $1::uuid
+        AND w."lastHeartbeatAt" < NOW() - INTERVAL '30 seconds'
+),
+step_runs_on_inactive_workers AS (
+    SELECT
+        sr."id",
+        sr."tenantId",
+        sr."status",
+        sr."scheduleTimeoutAt",
+        sr."retryCount",
+        sr."internalRetryCount",
+        sr."deadline",
+        sqi."workerId",
+        s."actionId",
+        s."id" AS "stepId",
+        s."timeout" AS "stepTimeout",
+        s."scheduleTimeout" AS "scheduleTimeout"
+    FROM
+        inactive_workers w
+    JOIN
+        "SemaphoreQueueItem" sqi ON w."id" = sqi."workerId"
+    JOIN
+        "StepRun" sr ON sr."id" = sqi."stepRunId"
+    JOIN
+        "Step" s ON sr."stepId" = s."id"
+    WHERE
+        $2::uuid IS NULL
+        OR sqi."stepRunId" > $2::uuid
+    ORDER BY
+        sqi."stepRunId" ASC
+    LIMIT
+        $3::int
+),
+step_runs_to_reassign AS (
+    SELECT
+        id, "tenantId", status, "scheduleTimeoutAt", "retryCount", "internalRetryCount", deadline, "workerId", "actionId", "stepId", "stepTimeout", "scheduleTimeout"
+    FROM
+        step_runs_on_inactive_workers
+    WHERE
+        "internalRetryCount" < $4::int
+        AND "status" = ANY($5::"StepRunStatus"[])
+),
+step_runs_to_fail AS (
+    SELECT
+        id, "tenantId", status, "scheduleTimeoutAt", "retryCount", "internalRetryCount", deadline, "workerId", "actionId", "stepId", "stepTimeout", "scheduleTimeout"
+    FROM
+        step_runs_on_inactive_workers
+    WHERE
+        "internalRetryCount" >= $4::int
+        OR NOT "status" = ANY($5::"StepRunStatus"[])
+),
+deleted_sqis AS (
+    DELETE FROM
+        "SemaphoreQueueItem" sqi
+    -- delete when step run id AND worker id tuples match
+    USING
+        step_runs_on_inactive_workers srs
+    WHERE
+        sqi."stepRunId" = srs."id"
+        AND sqi."workerId" = srs."workerId"
+),
+deleted_tqis AS (
+    DELETE FROM
+        "TimeoutQueueItem" tqi
+    -- delete when step run id AND retry count tuples match
+    USING
+        step_runs_on_inactive_workers srs
+    WHERE
+        tqi."stepRunId" = srs."id"
+        AND tqi."retryCount" = srs."retryCount"
+),
+inserted_queue_items AS (
+    INSERT INTO "QueueItem" (
+        "stepRunId",
+        "stepId",
+        "actionId",
+        "scheduleTimeoutAt",
+        "stepTimeout",
+        "priority",
+        "isQueued",
+        "tenantId",
+        "queue",
+        "deadline"
+    )
+    SELECT
+        srs."id",
+        srs."stepId",
+        srs."actionId",
+        CURRENT_TIMESTAMP + COALESCE(convert_duration_to_interval(srs."scheduleTimeout"), INTERVAL '5 minutes'),
+        srs."stepTimeout",
+        -- Queue with priority 4 so that reassignment gets highest priority
+        4,
+        true,
+        srs."tenantId",
+        srs."actionId",
+        srs."deadline"
+    FROM
+        step_runs_to_reassign srs
+),
+updated_step_runs AS (
+    UPDATE "StepRun" sr
+    SET
+        "status" = 'PENDING_ASSIGNMENT',
+        "scheduleTimeoutAt" = CURRENT_TIMESTAMP + COALESCE(convert_duration_to_interval(srs."scheduleTimeout"), INTERVAL '5 minutes'),
+        "updatedAt" = CURRENT_TIMESTAMP,
+        "internalRetryCount" = sr."internalRetryCount" + 1
+    FROM step_runs_to_reassign srs
+    WHERE sr."id" = srs."id"
+    RETURNING sr."id"
+)
+SELECT
+    srs1."id",
+    srs1."workerId",
+    srs1."retryCount",
+    'REASSIGNED' AS "operation"
+FROM
+    step_runs_to_reassign srs1
+UNION ALL
+SELECT
+    srs2."id",
+    srs2."workerId",
+    srs2."retryCount",
+    'FAILED' AS "operation"
+FROM
+    step_runs_to_fail srs2
+`
+
+type BulkReassignStepRunsParams struct {
+	Tenantid              pgtype.UUID     `json:"tenantid"`
+	Cursor                pgtype.UUID     `json:"cursor"`
+	Batchsize             int32           `json:"batchsize"`
+	Maxinternalretrycount int32           `json:"maxinternalretrycount"`
+	Fromstatuses          []StepRunStatus `json:"fromstatuses"`
+}
+
+type BulkReassignStepRunsRow struct {
+	ID         pgtype.UUID `json:"id"`
+	WorkerId   pgtype.UUID `json:"workerId"`
+	RetryCount int32       `json:"retryCount"`
+	Operation  string      `json:"operation"`
+}
+
+// Reassigns or fails a batch of step runs on inactive workers, ordered by step run id. Step runs which can't be
+// reassigned, since they're out of internal retries or aren't in one of the given statuses, are returned to be
+// failed. Pass the last step run id of the previous batch as the cursor to continue.
+func (q *Queries) BulkReassignStepRuns(ctx context.Context, db DBTX, arg BulkReassignStepRunsParams) ([]*BulkReassignStepRunsRow, error) {
+	rows, err := db.Query(ctx, bulkReassignStepRuns,
+		arg.Tenantid,
+		arg.Cursor,
+		arg.Batchsize,
+		arg.Maxinternalretrycount,
+		arg.Fromstatuses,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*BulkReassignStepRunsRow
+	for rows.Next() {
+		var i BulkReassignStepRunsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkerId,
+			&i.RetryCount,
+			&i.Operation,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const bulkStartStepRun = `-- name: BulkStartStepRun :many
 UPDATE
     "StepRun"
 SET
-    "status" = CASE
-        -- Final states are final, cannot be updated, and we cannot go from cancelling to a non-final state
-        WHEN "status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED', 'CANCELLING') THEN "status"
-        ELSE 'RUNNING'
-    END,
+    "status" = 'RUNNING',
     "startedAt" = input."startedAt"
 FROM (
     SELECT
-        unnest($1::uuid[]) AS "id",
-        unnest($2::timestamp[]) AS "startedAt"
+        unnest($2::uuid[]) AS "id",
+        unnest($3::timestamp[]) AS "startedAt"
     ) AS input
 WHERE
     "StepRun"."id" = input."id"
+    AND "StepRun"."status" = ANY($1::"StepRunStatus"[])
+RETURNING "StepRun"."id"
 `
 
 type BulkStartStepRunParams struct {
-	Steprunids []pgtype.UUID      `json:"steprunids"`
-	Startedats []pgtype.Timestamp `json:"startedats"`
+	Fromstatuses []StepRunStatus    `json:"fromstatuses"`
+	Steprunids   []pgtype.UUID      `json:"steprunids"`
+	Startedats   []pgtype.Timestamp `json:"startedats"`
 }
 
-func (q *Queries) BulkStartStepRun(ctx context.Context, db DBTX, arg BulkStartStepRunParams) error {
-	_, err := db.Exec(ctx, bulkStartStepRun, arg.Steprunids, arg.Startedats)
-	return err
+// Starts the step runs which are in one of the given statuses, and returns the ids of the started step runs.
+func (q *Queries) BulkStartStepRun(ctx context.Context, db DBTX, arg BulkStartStepRunParams) ([]pgtype.UUID, error) {
+	rows, err := db.Query(ctx, bulkStartStepRun, arg.Fromstatuses, arg.Steprunids, arg.Startedats)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const checkWorker = `-- name: CheckWorker :one
@@ -494,6 +754,31 @@ func (q *Queries) CountStepRunEvents(ctx context.Context, db DBTX, steprunid pgt
 	return total, err
 }
 
+const countStepRunParents = `-- name: CountStepRunParents :one
+SELECT
+    COUNT(*) AS "total",
+    COUNT(*) FILTER (WHERE parent_run."status" = 'SKIPPED') AS "skipped"
+FROM
+    "_StepRunOrder" AS parent_order
+JOIN
+    "StepRun" AS parent_run ON parent_order."A" = parent_run."id"
+WHERE
+    parent_order."B" = $1::uuid
+`
+
+type CountStepRunParentsRow struct {
+	Total   int64 `json:"total"`
+	Skipped int64 `json:"skipped"`
+}
+
+// Counts the parents of a step run, and how many of them have been skipped.
+func (q *Queries) CountStepRunParents(ctx context.Context, db DBTX, steprunid pgtype.UUID) (*CountStepRunParentsRow, error) {
+	row := db.QueryRow(ctx, countStepRunParents, steprunid)
+	var i CountStepRunParentsRow
+	err := row.Scan(&i.Total, &i.Skipped)
+	return &i, err
+}
+
 const createStepRunEvent = `-- name: CreateStepRunEvent :exec
 WITH input_values AS (
     SELECT
@@ -642,6 +927,35 @@ func (q *Queries) CreateStepRunExpressionEvalStrs(ctx context.Context, db DBTX,
 	return err
 }
 
+const createStepRunTimer = `-- name: CreateStepRunTimer :exec
+INSERT INTO "StepRunTimer" (
+    "stepRunId",
+    "tenantId",
+    "wakeAt"
+) VALUES (
+    $1::uuid,
+    $2::uuid,
+    $3::timestamp
+)
+ON CONFLICT ("stepRunId") DO UPDATE
+SET
+    "wakeAt" = EXCLUDED."wakeAt",
+    "tickerId" = NULL
+`
+
+type CreateStepRunTimerParams struct {
+	Steprunid pgtype.UUID      `json:"steprunid"`
+	Tenantid  pgtype.UUID      `json:"tenantid"`
+	Wakeat    pgtype.Timestamp `json:"wakeat"`
+}
+
+// Creates the timer of a sleeping step run. If the step run already has a timer (for example, when it is
+// replayed), the timer is reset.
+func (q *Queries) CreateStepRunTimer(ctx context.Context, db DBTX, arg CreateStepRunTimerParams) error {
+	_, err := db.Exec(ctx, createStepRunTimer, arg.Steprunid, arg.Tenantid, arg.Wakeat)
+	return err
+}
+
 const createWorkerAssignEvents = `-- name: CreateWorkerAssignEvents :exec
 INSERT INTO "WorkerAssignEvent" (
     "workerId",
@@ -721,6 +1035,120 @@ func (q *Queries) GetDesiredLabels(ctx context.Context, db DBTX, stepids []pgtyp
 	return items, nil
 }
 
+const getDesiredPools = `-- name: GetDesiredPools :many
+SELECT
+    s."id" AS "stepId",
+    s."pool"
+FROM
+    "Step" s
+WHERE
+    s."id" = ANY($1::uuid[])
+    AND s."pool" IS NOT NULL
+`
+
+type GetDesiredPoolsRow struct {
+	StepId pgtype.UUID `json:"stepId"`
+	Pool   pgtype.Text `json:"pool"`
+}
+
+func (q *Queries) GetDesiredPools(ctx context.Context, db DBTX, stepids []pgtype.UUID) ([]*GetDesiredPoolsRow, error) {
+	rows, err := db.Query(ctx, getDesiredPools, stepids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetDesiredPoolsRow
+	for rows.Next() {
+		var i GetDesiredPoolsRow
+		if err := rows.Scan(&i.StepId, &i.Pool); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDesiredRegions = `-- name: GetDesiredRegions :many
+SELECT
+    s."id" AS "stepId",
+    wv."region",
+    wv."regionStrategy"
+FROM
+    "Step" s
+JOIN
+    "Job" j ON j."id" = s."jobId"
+JOIN
+    "WorkflowVersion" wv ON wv."id" = j."workflowVersionId"
+WHERE
+    s."id" = ANY($1::uuid[])
+    AND wv."region" IS NOT NULL
+`
+
+type GetDesiredRegionsRow struct {
+	StepId         pgtype.UUID        `json:"stepId"`
+	Region         pgtype.Text        `json:"region"`
+	RegionStrategy NullRegionStrategy `json:"regionStrategy"`
+}
+
+func (q *Queries) GetDesiredRegions(ctx context.Context, db DBTX, stepids []pgtype.UUID) ([]*GetDesiredRegionsRow, error) {
+	rows, err := db.Query(ctx, getDesiredRegions, stepids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetDesiredRegionsRow
+	for rows.Next() {
+		var i GetDesiredRegionsRow
+		if err := rows.Scan(&i.StepId, &i.Region, &i.RegionStrategy); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDesiredSlotTypes = `-- name: GetDesiredSlotTypes :many
+SELECT
+    s."id" AS "stepId",
+    s."slotType"
+FROM
+    "Step" s
+WHERE
+    s."id" = ANY($1::uuid[])
+    AND s."slotType" IS NOT NULL
+`
+
+type GetDesiredSlotTypesRow struct {
+	StepId   pgtype.UUID `json:"stepId"`
+	SlotType pgtype.Text `json:"slotType"`
+}
+
+func (q *Queries) GetDesiredSlotTypes(ctx context.Context, db DBTX, stepids []pgtype.UUID) ([]*GetDesiredSlotTypesRow, error) {
+	rows, err := db.Query(ctx, getDesiredSlotTypes, stepids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetDesiredSlotTypesRow
+	for rows.Next() {
+		var i GetDesiredSlotTypesRow
+		if err := rows.Scan(&i.StepId, &i.SlotType); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getFinalizedStepRuns = `-- name: GetFinalizedStepRuns :many
 SELECT
     "id", "status"
@@ -728,7 +1156,7 @@ FROM
     "StepRun"
 WHERE
     "id" = ANY($1::uuid[])
-    AND "status" = ANY(ARRAY['SUCCEEDED', 'FAILED', 'CANCELLED', 'CANCELLING']::"StepRunStatus"[])
+    AND "status" = ANY(ARRAY['SUCCEEDED', 'FAILED', 'CANCELLED', 'SKIPPED', 'CANCELLING']::"StepRunStatus"[])
 `
 
 type GetFinalizedStepRunsRow struct {
@@ -758,7 +1186,7 @@ func (q *Queries) GetFinalizedStepRuns(ctx context.Context, db DBTX, steprunids
 
 const getLaterStepRuns = `-- name: GetLaterStepRuns :many
 WITH RECURSIVE currStepRun AS (
-    SELECT id, "createdAt", "updatedAt", "deletedAt", "tenantId", "jobRunId", "stepId", "order", "workerId", "tickerId", status, input, output, "requeueAfter", "scheduleTimeoutAt", error, "startedAt", "finishedAt", "timeoutAt", "cancelledAt", "cancelledReason", "cancelledError", "inputSchema", "callerFiles", "gitRepoBranch", "retryCount", "semaphoreReleased", queue, priority, "internalRetryCount"
+    SELECT id, "createdAt", "updatedAt", "deletedAt", "tenantId", "jobRunId", "stepId", "order", "workerId", "tickerId", status, input, output, "requeueAfter", "scheduleTimeoutAt", error, "startedAt", "finishedAt", "timeoutAt", "cancelledAt", "cancelledReason", "cancelledError", "inputSchema", "callerFiles", "gitRepoBranch", "retryCount", "semaphoreReleased", queue, priority, "internalRetryCount", deadline
     FROM "StepRun"
     WHERE
         "id" = $1::uuid
@@ -776,7 +1204,7 @@ WITH RECURSIVE currStepRun AS (
     JOIN childStepRuns csr ON sro."A" = csr."id"
 )
 SELECT
-    sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount", sr."semaphoreReleased", sr.queue, sr.priority, sr."internalRetryCount"
+    sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount", sr."semaphoreReleased", sr.queue, sr.priority, sr."internalRetryCount", sr.deadline
 FROM
     "StepRun" sr
 JOIN
@@ -823,6 +1251,7 @@ func (q *Queries) GetLaterStepRuns(ctx context.Context, db DBTX, steprunid pgtyp
 			&i.Queue,
 			&i.Priority,
 			&i.InternalRetryCount,
+			&i.Deadline,
 		); err != nil {
 			return nil, err
 		}
@@ -896,7 +1325,7 @@ func (q *Queries) GetStepExpressions(ctx context.Context, db DBTX, stepid pgtype
 
 const getStepRun = `-- name: GetStepRun :one
 SELECT
-    "StepRun".id, "StepRun"."createdAt", "StepRun"."updatedAt", "StepRun"."deletedAt", "StepRun"."tenantId", "StepRun"."jobRunId", "StepRun"."stepId", "StepRun"."order", "StepRun"."workerId", "StepRun"."tickerId", "StepRun".status, "StepRun".input, "StepRun".output, "StepRun"."requeueAfter", "StepRun"."scheduleTimeoutAt", "StepRun".error, "StepRun"."startedAt", "StepRun"."finishedAt", "StepRun"."timeoutAt", "StepRun"."cancelledAt", "StepRun"."cancelledReason", "StepRun"."cancelledError", "StepRun"."inputSchema", "StepRun"."callerFiles", "StepRun"."gitRepoBranch", "StepRun"."retryCount", "StepRun"."semaphoreReleased", "StepRun".queue, "StepRun".priority, "StepRun"."internalRetryCount"
+    "StepRun".id, "StepRun"."createdAt", "StepRun"."updatedAt", "StepRun"."deletedAt", "StepRun"."tenantId", "StepRun"."jobRunId", "StepRun"."stepId", "StepRun"."order", "StepRun"."workerId", "StepRun"."tickerId", "StepRun".status, "StepRun".input, "StepRun".output, "StepRun"."requeueAfter", "StepRun"."scheduleTimeoutAt", "StepRun".error, "StepRun"."startedAt", "StepRun"."finishedAt", "StepRun"."timeoutAt", "StepRun"."cancelledAt", "StepRun"."cancelledReason", "StepRun"."cancelledError", "StepRun"."inputSchema", "StepRun"."callerFiles", "StepRun"."gitRepoBranch", "StepRun"."retryCount", "StepRun"."semaphoreReleased", "StepRun".queue, "StepRun".priority, "StepRun"."internalRetryCount", "StepRun".deadline
 FROM
     "StepRun"
 WHERE
@@ -938,6 +1367,7 @@ func (q *Queries) GetStepRun(ctx context.Context, db DBTX, id pgtype.UUID) (*Ste
 		&i.Queue,
 		&i.Priority,
 		&i.InternalRetryCount,
+		&i.Deadline,
 	)
 	return &i, err
 }
@@ -954,11 +1384,21 @@ SELECT
     jr."status" AS "jobRunStatus",
     jr."status" AS "jobRunStatus",
     jr."workflowRunId" AS "workflowRunId",
-    jrld."data" AS "jobRunLookupData",
+    -- the lookup data is merged with the entries which weren't compacted yet
+    (CASE
+        WHEN tail."removed" IS NULL OR jrld."data" IS NULL THEN jrld."data"
+        ELSE jsonb_set(
+            jrld."data",
+            '{steps}',
+            (COALESCE(jrld."data"->'steps', '{}'::jsonb) - tail."removed") || tail."steps",
+            true
+        )
+    END)::jsonb AS "jobRunLookupData",
     wr."additionalMetadata",
     wr."childIndex",
     wr."childKey",
     wr."parentId",
+    wr."traceContext",
     jr."id" AS "jobRunId",
     s."id" AS "stepId",
     s."retries" AS "stepRetries",
@@ -983,6 +1423,22 @@ JOIN
     "Job" j ON jr."jobId" = j."id"
 JOIN
     "JobRunLookupData" jrld ON jr."id" = jrld."jobRunId"
+LEFT JOIN LATERAL (
+    SELECT
+        ARRAY_AGG(latest."readableId") AS "removed",
+        COALESCE(jsonb_object_agg(latest."readableId", latest."data") FILTER (WHERE latest."data" IS NOT NULL), '{}'::jsonb) AS "steps"
+    FROM (
+        SELECT DISTINCT ON (e."readableId")
+            e."readableId",
+            e."data"
+        FROM
+            "JobRunLookupDataEntry" e
+        WHERE
+            e."jobRunId" = jr."id"
+        ORDER BY
+            e."readableId", e."id" DESC
+    ) latest
+) tail ON TRUE
 JOIN
     -- Take advantage of composite index on "JobRun"("workflowRunId", "tenantId")
     "WorkflowRun" wr ON jr."workflowRunId" = wr."id" AND wr."tenantId" = $1::uuid
@@ -1012,6 +1468,7 @@ type GetStepRunBulkDataForEngineRow struct {
 	ChildIndex          pgtype.Int4   `json:"childIndex"`
 	ChildKey            pgtype.Text   `json:"childKey"`
 	ParentId            pgtype.UUID   `json:"parentId"`
+	TraceContext        []byte        `json:"traceContext"`
 	JobRunId_2          pgtype.UUID   `json:"jobRunId_2"`
 	StepId              pgtype.UUID   `json:"stepId"`
 	StepRetries         int32         `json:"stepRetries"`
@@ -1051,6 +1508,7 @@ func (q *Queries) GetStepRunBulkDataForEngine(ctx context.Context, db DBTX, arg
 			&i.ChildIndex,
 			&i.ChildKey,
 			&i.ParentId,
+			&i.TraceContext,
 			&i.JobRunId_2,
 			&i.StepId,
 			&i.StepRetries,
@@ -1094,11 +1552,21 @@ SELECT
     sr."input",
     sr."output",
     sr."error",
-    jrld."data" AS "jobRunLookupData",
+    -- the lookup data is merged with the entries which weren't compacted yet
+    (CASE
+        WHEN tail."removed" IS NULL OR jrld."data" IS NULL THEN jrld."data"
+        ELSE jsonb_set(
+            jrld."data",
+            '{steps}',
+            (COALESCE(jrld."data"->'steps', '{}'::jsonb) - tail."removed") || tail."steps",
+            true
+        )
+    END)::jsonb AS "jobRunLookupData",
     wr."additionalMetadata",
     wr."childIndex",
     wr."childKey",
     wr."parentId",
+    wr."traceContext",
     COALESCE(ec."exprCount", 0) AS "exprCount"
 FROM
     "StepRun" sr
@@ -1106,6 +1574,22 @@ JOIN
     "JobRun" jr ON sr."jobRunId" = jr."id"
 JOIN
     "JobRunLookupData" jrld ON jr."id" = jrld."jobRunId"
+LEFT JOIN LATERAL (
+    SELECT
+        ARRAY_AGG(latest."readableId") AS "removed",
+        COALESCE(jsonb_object_agg(latest."readableId", latest."data") FILTER (WHERE latest."data" IS NOT NULL), '{}'::jsonb) AS "steps"
+    FROM (
+        SELECT DISTINCT ON (e."readableId")
+            e."readableId",
+            e."data"
+        FROM
+            "JobRunLookupDataEntry" e
+        WHERE
+            e."jobRunId" = jr."id"
+        ORDER BY
+            e."readableId", e."id" DESC
+    ) latest
+) tail ON TRUE
 JOIN
     -- Take advantage of composite index on "JobRun"("workflowRunId", "tenantId")
     "WorkflowRun" wr ON jr."workflowRunId" = wr."id" AND wr."tenantId" = $1::uuid
@@ -1130,6 +1614,7 @@ type GetStepRunDataForEngineRow struct {
 	ChildIndex         pgtype.Int4 `json:"childIndex"`
 	ChildKey           pgtype.Text `json:"childKey"`
 	ParentId           pgtype.UUID `json:"parentId"`
+	TraceContext       []byte      `json:"traceContext"`
 	ExprCount          int64       `json:"exprCount"`
 }
 
@@ -1145,6 +1630,7 @@ func (q *Queries) GetStepRunDataForEngine(ctx context.Context, db DBTX, arg GetS
 		&i.ChildIndex,
 		&i.ChildKey,
 		&i.ParentId,
+		&i.TraceContext,
 		&i.ExprCount,
 	)
 	return &i, err
@@ -1190,6 +1676,7 @@ SELECT
     sr."retryCount" AS "SR_retryCount",
     sr."semaphoreReleased" AS "SR_semaphoreReleased",
     sr."priority" AS "SR_priority",
+    sr."deadline" AS "SR_deadline",
     COALESCE(cc."childCount", 0) AS "SR_childCount",
     -- TODO: everything below this line is cacheable and should be moved to a separate query
     jr."id" AS "jobRunId",
@@ -1201,6 +1688,17 @@ SELECT
     s."customUserData" AS "stepCustomUserData",
     s."retryBackoffFactor" AS "stepRetryBackoffFactor",
     s."retryMaxBackoff" AS "stepRetryMaxBackoff",
+    s."retryInitialBackoff" AS "stepRetryInitialBackoff",
+    s."retryJitter" AS "stepRetryJitter",
+    s."heartbeatTimeout" AS "stepHeartbeatTimeout",
+    s."sleepFor" AS "stepSleepFor",
+    s."sleepUntil" AS "stepSleepUntil",
+    s."waitForEvent" AS "stepWaitForEvent",
+    s."waitForEventCorrelation" AS "stepWaitForEventCorrelation",
+    s."condition" AS "stepCondition",
+    s."mapOver" AS "stepMapOver",
+    s."mapConcurrency" AS "stepMapConcurrency",
+    s."cacheTtl" AS "stepCacheTtl",
     j."name" AS "jobName",
     j."id" AS "jobId",
     j."kind" AS "jobKind",
@@ -1242,48 +1740,60 @@ type GetStepRunForEngineParams struct {
 }
 
 type GetStepRunForEngineRow struct {
-	SRID                   pgtype.UUID        `json:"SR_id"`
-	SRCreatedAt            pgtype.Timestamp   `json:"SR_createdAt"`
-	SRUpdatedAt            pgtype.Timestamp   `json:"SR_updatedAt"`
-	SRDeletedAt            pgtype.Timestamp   `json:"SR_deletedAt"`
-	SRTenantId             pgtype.UUID        `json:"SR_tenantId"`
-	SRQueue                string             `json:"SR_queue"`
-	SROrder                int64              `json:"SR_order"`
-	SRWorkerId             pgtype.UUID        `json:"SR_workerId"`
-	SRTickerId             pgtype.UUID        `json:"SR_tickerId"`
-	SRStatus               StepRunStatus      `json:"SR_status"`
-	SRRequeueAfter         pgtype.Timestamp   `json:"SR_requeueAfter"`
-	SRScheduleTimeoutAt    pgtype.Timestamp   `json:"SR_scheduleTimeoutAt"`
-	SRStartedAt            pgtype.Timestamp   `json:"SR_startedAt"`
-	SRFinishedAt           pgtype.Timestamp   `json:"SR_finishedAt"`
-	SRTimeoutAt            pgtype.Timestamp   `json:"SR_timeoutAt"`
-	SRCancelledAt          pgtype.Timestamp   `json:"SR_cancelledAt"`
-	SRCancelledReason      pgtype.Text        `json:"SR_cancelledReason"`
-	SRCancelledError       pgtype.Text        `json:"SR_cancelledError"`
-	SRCallerFiles          []byte             `json:"SR_callerFiles"`
-	SRGitRepoBranch        pgtype.Text        `json:"SR_gitRepoBranch"`
-	SRRetryCount           int32              `json:"SR_retryCount"`
-	SRSemaphoreReleased    bool               `json:"SR_semaphoreReleased"`
-	SRPriority             pgtype.Int4        `json:"SR_priority"`
-	SRChildCount           int64              `json:"SR_childCount"`
-	JobRunId               pgtype.UUID        `json:"jobRunId"`
-	StepId                 pgtype.UUID        `json:"stepId"`
-	StepRetries            int32              `json:"stepRetries"`
-	StepTimeout            pgtype.Text        `json:"stepTimeout"`
-	StepScheduleTimeout    string             `json:"stepScheduleTimeout"`
-	StepReadableId         pgtype.Text        `json:"stepReadableId"`
-	StepCustomUserData     []byte             `json:"stepCustomUserData"`
-	StepRetryBackoffFactor pgtype.Float8      `json:"stepRetryBackoffFactor"`
-	StepRetryMaxBackoff    pgtype.Int4        `json:"stepRetryMaxBackoff"`
-	JobName                string             `json:"jobName"`
-	JobId                  pgtype.UUID        `json:"jobId"`
-	JobKind                JobKind            `json:"jobKind"`
-	WorkflowVersionId      pgtype.UUID        `json:"workflowVersionId"`
-	JobRunStatus           JobRunStatus       `json:"jobRunStatus"`
-	WorkflowRunId          pgtype.UUID        `json:"workflowRunId"`
-	ActionId               string             `json:"actionId"`
-	StickyStrategy         NullStickyStrategy `json:"stickyStrategy"`
-	DesiredWorkerId        pgtype.UUID        `json:"desiredWorkerId"`
+	SRID                        pgtype.UUID        `json:"SR_id"`
+	SRCreatedAt                 pgtype.Timestamp   `json:"SR_createdAt"`
+	SRUpdatedAt                 pgtype.Timestamp   `json:"SR_updatedAt"`
+	SRDeletedAt                 pgtype.Timestamp   `json:"SR_deletedAt"`
+	SRTenantId                  pgtype.UUID        `json:"SR_tenantId"`
+	SRQueue                     string             `json:"SR_queue"`
+	SROrder                     int64              `json:"SR_order"`
+	SRWorkerId                  pgtype.UUID        `json:"SR_workerId"`
+	SRTickerId                  pgtype.UUID        `json:"SR_tickerId"`
+	SRStatus                    StepRunStatus      `json:"SR_status"`
+	SRRequeueAfter              pgtype.Timestamp   `json:"SR_requeueAfter"`
+	SRScheduleTimeoutAt         pgtype.Timestamp   `json:"SR_scheduleTimeoutAt"`
+	SRStartedAt                 pgtype.Timestamp   `json:"SR_startedAt"`
+	SRFinishedAt                pgtype.Timestamp   `json:"SR_finishedAt"`
+	SRTimeoutAt                 pgtype.Timestamp   `json:"SR_timeoutAt"`
+	SRCancelledAt               pgtype.Timestamp   `json:"SR_cancelledAt"`
+	SRCancelledReason           pgtype.Text        `json:"SR_cancelledReason"`
+	SRCancelledError            pgtype.Text        `json:"SR_cancelledError"`
+	SRCallerFiles               []byte             `json:"SR_callerFiles"`
+	SRGitRepoBranch             pgtype.Text        `json:"SR_gitRepoBranch"`
+	SRRetryCount                int32              `json:"SR_retryCount"`
+	SRSemaphoreReleased         bool               `json:"SR_semaphoreReleased"`
+	SRPriority                  pgtype.Int4        `json:"SR_priority"`
+	SRDeadline                  pgtype.Timestamp   `json:"SR_deadline"`
+	SRChildCount                int64              `json:"SR_childCount"`
+	JobRunId                    pgtype.UUID        `json:"jobRunId"`
+	StepId                      pgtype.UUID        `json:"stepId"`
+	StepRetries                 int32              `json:"stepRetries"`
+	StepTimeout                 pgtype.Text        `json:"stepTimeout"`
+	StepScheduleTimeout         string             `json:"stepScheduleTimeout"`
+	StepReadableId              pgtype.Text        `json:"stepReadableId"`
+	StepCustomUserData          []byte             `json:"stepCustomUserData"`
+	StepRetryBackoffFactor      pgtype.Float8      `json:"stepRetryBackoffFactor"`
+	StepRetryMaxBackoff         pgtype.Int4        `json:"stepRetryMaxBackoff"`
+	StepRetryInitialBackoff     pgtype.Int4        `json:"stepRetryInitialBackoff"`
+	StepRetryJitter             pgtype.Float8      `json:"stepRetryJitter"`
+	StepHeartbeatTimeout        pgtype.Text        `json:"stepHeartbeatTimeout"`
+	StepSleepFor                pgtype.Text        `json:"stepSleepFor"`
+	StepSleepUntil              pgtype.Text        `json:"stepSleepUntil"`
+	StepWaitForEvent            pgtype.Text        `json:"stepWaitForEvent"`
+	StepWaitForEventCorrelation pgtype.Text        `json:"stepWaitForEventCorrelation"`
+	StepCondition               pgtype.Text        `json:"stepCondition"`
+	StepMapOver                 pgtype.Text        `json:"stepMapOver"`
+	StepMapConcurrency          pgtype.Int4        `json:"stepMapConcurrency"`
+	StepCacheTtl                pgtype.Text        `json:"stepCacheTtl"`
+	JobName                     string             `json:"jobName"`
+	JobId                       pgtype.UUID        `json:"jobId"`
+	JobKind                     JobKind            `json:"jobKind"`
+	WorkflowVersionId           pgtype.UUID        `json:"workflowVersionId"`
+	JobRunStatus                JobRunStatus       `json:"jobRunStatus"`
+	WorkflowRunId               pgtype.UUID        `json:"workflowRunId"`
+	ActionId                    string             `json:"actionId"`
+	StickyStrategy              NullStickyStrategy `json:"stickyStrategy"`
+	DesiredWorkerId             pgtype.UUID        `json:"desiredWorkerId"`
 }
 
 func (q *Queries) GetStepRunForEngine(ctx context.Context, db DBTX, arg GetStepRunForEngineParams) ([]*GetStepRunForEngineRow, error) {
@@ -1319,6 +1829,7 @@ func (q *Queries) GetStepRunForEngine(ctx context.Context, db DBTX, arg GetStepR
 			&i.SRRetryCount,
 			&i.SRSemaphoreReleased,
 			&i.SRPriority,
+			&i.SRDeadline,
 			&i.SRChildCount,
 			&i.JobRunId,
 			&i.StepId,
@@ -1329,6 +1840,17 @@ func (q *Queries) GetStepRunForEngine(ctx context.Context, db DBTX, arg GetStepR
 			&i.StepCustomUserData,
 			&i.StepRetryBackoffFactor,
 			&i.StepRetryMaxBackoff,
+			&i.StepRetryInitialBackoff,
+			&i.StepRetryJitter,
+			&i.StepHeartbeatTimeout,
+			&i.StepSleepFor,
+			&i.StepSleepUntil,
+			&i.StepWaitForEvent,
+			&i.StepWaitForEventCorrelation,
+			&i.StepCondition,
+			&i.StepMapOver,
+			&i.StepMapConcurrency,
+			&i.StepCacheTtl,
 			&i.JobName,
 			&i.JobId,
 			&i.JobKind,
@@ -1379,6 +1901,40 @@ func (q *Queries) GetStepRunMeta(ctx context.Context, db DBTX, arg GetStepRunMet
 	return &i, err
 }
 
+const getStepRunStatuses = `-- name: GetStepRunStatuses :many
+SELECT
+    "id", "status"
+FROM
+    "StepRun"
+WHERE
+    "id" = ANY($1::uuid[])
+`
+
+type GetStepRunStatusesRow struct {
+	ID     pgtype.UUID   `json:"id"`
+	Status StepRunStatus `json:"status"`
+}
+
+func (q *Queries) GetStepRunStatuses(ctx context.Context, db DBTX, steprunids []pgtype.UUID) ([]*GetStepRunStatusesRow, error) {
+	rows, err := db.Query(ctx, getStepRunStatuses, steprunids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetStepRunStatusesRow
+	for rows.Next() {
+		var i GetStepRunStatusesRow
+		if err := rows.Scan(&i.ID, &i.Status); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getWorkerDispatcherActions = `-- name: GetWorkerDispatcherActions :many
 WITH actions AS (
     SELECT
@@ -1542,18 +2098,24 @@ func (q *Queries) ListChildWorkflowRunIds(ctx context.Context, db DBTX, arg List
 
 const listInitialStepRuns = `-- name: ListInitialStepRuns :many
 SELECT
-    DISTINCT ON (child_run."id")
     child_run."id" AS "id"
 FROM
     "StepRun" AS child_run
-LEFT JOIN
-    "_StepRunOrder" AS step_run_order ON step_run_order."B" = child_run."id"
 WHERE
     child_run."jobRunId" = $1::uuid
     AND child_run."status" = 'PENDING'
-    AND step_run_order."A" IS NULL
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "_StepRunOrder" AS parent_order
+        JOIN "StepRun" AS parent_run ON parent_order."A" = parent_run."id"
+        WHERE
+            parent_order."B" = child_run."id"
+            AND parent_run."status" NOT IN ('SUCCEEDED', 'SKIPPED')
+    )
 `
 
+// Lists the pending step runs whose parent step runs have all succeeded or been skipped. These are the step runs
+// without parents, and the step runs after the reused step runs of a workflow run replayed from a step.
 func (q *Queries) ListInitialStepRuns(ctx context.Context, db DBTX, jobrunid pgtype.UUID) ([]pgtype.UUID, error) {
 	rows, err := db.Query(ctx, listInitialStepRuns, jobrunid)
 	if err != nil {
@@ -1576,7 +2138,7 @@ func (q *Queries) ListInitialStepRuns(ctx context.Context, db DBTX, jobrunid pgt
 
 const listNonFinalChildStepRuns = `-- name: ListNonFinalChildStepRuns :many
 WITH RECURSIVE currStepRun AS (
-    SELECT id, "createdAt", "updatedAt", "deletedAt", "tenantId", "jobRunId", "stepId", "order", "workerId", "tickerId", status, input, output, "requeueAfter", "scheduleTimeoutAt", error, "startedAt", "finishedAt", "timeoutAt", "cancelledAt", "cancelledReason", "cancelledError", "inputSchema", "callerFiles", "gitRepoBranch", "retryCount", "semaphoreReleased", queue, priority, "internalRetryCount"
+    SELECT id, "createdAt", "updatedAt", "deletedAt", "tenantId", "jobRunId", "stepId", "order", "workerId", "tickerId", status, input, output, "requeueAfter", "scheduleTimeoutAt", error, "startedAt", "finishedAt", "timeoutAt", "cancelledAt", "cancelledReason", "cancelledError", "inputSchema", "callerFiles", "gitRepoBranch", "retryCount", "semaphoreReleased", queue, priority, "internalRetryCount", deadline
     FROM "StepRun"
     WHERE
         "id" = $1::uuid
@@ -1595,14 +2157,14 @@ WITH RECURSIVE currStepRun AS (
     JOIN childStepRuns csr ON sro."A" = csr."id"
 )
 SELECT
-    sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount", sr."semaphoreReleased", sr.queue, sr.priority, sr."internalRetryCount"
+    sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount", sr."semaphoreReleased", sr.queue, sr.priority, sr."internalRetryCount", sr.deadline
 FROM
     "StepRun" sr
 JOIN
     childStepRuns csr ON sr."id" = csr."id"
 WHERE
     sr."deletedAt" IS NULL AND
-    sr."status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED')
+    sr."status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED', 'SKIPPED')
 `
 
 // Select all child step runs that are not in a final state
@@ -1646,6 +2208,7 @@ func (q *Queries) ListNonFinalChildStepRuns(ctx context.Context, db DBTX, stepru
 			&i.Queue,
 			&i.Priority,
 			&i.InternalRetryCount,
+			&i.Deadline,
 		); err != nil {
 			return nil, err
 		}
@@ -1670,14 +2233,15 @@ JOIN
 WHERE
     parent_run."id" = $1::uuid
     AND child_run."status" = 'PENDING'
-    -- we look for whether the step run is startable by ensuring that all parent step runs have succeeded
+    -- we look for whether the step run is startable by ensuring that all parent step runs have succeeded or
+    -- have been skipped
     AND NOT EXISTS (
         SELECT 1
         FROM "_StepRunOrder" AS parent_order
         JOIN "StepRun" AS parent_run ON parent_order."A" = parent_run."id"
         WHERE
             parent_order."B" = child_run."id"
-            AND parent_run."status" != 'SUCCEEDED'
+            AND parent_run."status" NOT IN ('SUCCEEDED', 'SKIPPED')
     )
     -- AND we ensure that there's at least 2 parent step runs
     AND EXISTS (
@@ -2073,157 +2637,6 @@ func (q *Queries) ListStepRunsToCancel(ctx context.Context, db DBTX, arg ListSte
 	return items, nil
 }
 
-const listStepRunsToReassign = `-- name: ListStepRunsToReassign :many
-WITH step_runs_on_inactive_workers AS (
-    SELECT
-        sr."id",
-        sr."tenantId",
-        sr."scheduleTimeoutAt",
-        sr."retryCount",
-        sr."internalRetryCount",
-        sqi."workerId",
-        s."actionId",
-        s."id" AS "stepId",
-        s."timeout" AS "stepTimeout",
-        s."scheduleTimeout" AS "scheduleTimeout"
-    FROM
-        "Worker" w
-    LEFT JOIN
-        "SemaphoreQueueItem" sqi ON w."id" = sqi."workerId"
-    JOIN
-        "StepRun" sr ON sr."id" = sqi."stepRunId"
-    JOIN
-        "Step" s ON sr."stepId" = s."id"
-    WHERE
-        w."tenantId" = $1::uuid
-        AND w."lastHeartbeatAt" < NOW() - INTERVAL '30 seconds'),
-step_runs_to_reassign AS (
-    SELECT
-        id, "tenantId", "scheduleTimeoutAt", "retryCount", "internalRetryCount", "workerId", "actionId", "stepId", "stepTimeout", "scheduleTimeout"
-    FROM
-        step_runs_on_inactive_workers
-    WHERE
-        "internalRetryCount" < $2::int
-),
-step_runs_to_fail AS (
-    SELECT
-        id, "tenantId", "scheduleTimeoutAt", "retryCount", "internalRetryCount", "workerId", "actionId", "stepId", "stepTimeout", "scheduleTimeout"
-    FROM
-        step_runs_on_inactive_workers
-    WHERE
-        "internalRetryCount" >= $2::int
-),
-deleted_sqis AS (
-    DELETE FROM
-        "SemaphoreQueueItem" sqi
-    -- delete when step run id AND worker id tuples match
-    USING
-        step_runs_on_inactive_workers srs
-    WHERE
-        sqi."stepRunId" = srs."id"
-        AND sqi."workerId" = srs."workerId"
-),
-deleted_tqis AS (
-    DELETE FROM
-        "TimeoutQueueItem" tqi
-    -- delete when step run id AND retry count tuples match
-    USING
-        step_runs_on_inactive_workers srs
-    WHERE
-        tqi."stepRunId" = srs."id"
-        AND tqi."retryCount" = srs."retryCount"
-),
-inserted_queue_items AS (
-    INSERT INTO "QueueItem" (
-        "stepRunId",
-        "stepId",
-        "actionId",
-        "scheduleTimeoutAt",
-        "stepTimeout",
-        "priority",
-        "isQueued",
-        "tenantId",
-        "queue"
-    )
-    SELECT
-        srs."id",
-        srs."stepId",
-        srs."actionId",
-        CURRENT_TIMESTAMP + COALESCE(convert_duration_to_interval(srs."scheduleTimeout"), INTERVAL '5 minutes'),
-        srs."stepTimeout",
-        -- Queue with priority 4 so that reassignment gets highest priority
-        4,
-        true,
-        srs."tenantId",
-        srs."actionId"
-    FROM
-        step_runs_to_reassign srs
-),
-updated_step_runs AS (
-    UPDATE "StepRun" sr
-    SET
-        "status" = 'PENDING_ASSIGNMENT',
-        "scheduleTimeoutAt" = CURRENT_TIMESTAMP + COALESCE(convert_duration_to_interval(srs."scheduleTimeout"), INTERVAL '5 minutes'),
-        "updatedAt" = CURRENT_TIMESTAMP,
-        "internalRetryCount" = sr."internalRetryCount" + 1
-    FROM step_runs_to_reassign srs
-    WHERE sr."id" = srs."id"
-    RETURNING sr."id"
-)
-SELECT
-    srs1."id",
-    srs1."workerId",
-    srs1."retryCount",
-    'REASSIGNED' AS "operation"
-FROM
-    step_runs_to_reassign srs1
-UNION ALL
-SELECT
-    srs2."id",
-    srs2."workerId",
-    srs2."retryCount",
-    'FAILED' AS "operation"
-FROM
-    step_runs_to_fail srs2
-`
-
-type ListStepRunsToReassignParams struct {
-	Tenantid              pgtype.UUID `json:"tenantid"`
-	Maxinternalretrycount int32       `json:"maxinternalretrycount"`
-}
-
-type ListStepRunsToReassignRow struct {
-	ID         pgtype.UUID `json:"id"`
-	WorkerId   pgtype.UUID `json:"workerId"`
-	RetryCount int32       `json:"retryCount"`
-	Operation  string      `json:"operation"`
-}
-
-func (q *Queries) ListStepRunsToReassign(ctx context.Context, db DBTX, arg ListStepRunsToReassignParams) ([]*ListStepRunsToReassignRow, error) {
-	rows, err := db.Query(ctx, listStepRunsToReassign, arg.Tenantid, arg.Maxinternalretrycount)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []*ListStepRunsToReassignRow
-	for rows.Next() {
-		var i ListStepRunsToReassignRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.WorkerId,
-			&i.RetryCount,
-			&i.Operation,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, &i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const listStepRunsToTimeout = `-- name: ListStepRunsToTimeout :many
 SELECT "id"
 FROM "StepRun"
@@ -2275,6 +2688,67 @@ func (q *Queries) ManualReleaseSemaphore(ctx context.Context, db DBTX, arg Manua
 	return err
 }
 
+const popStepRunsToHeartbeatTimeout = `-- name: PopStepRunsToHeartbeatTimeout :many
+WITH step_runs AS (
+    SELECT
+        sr."id"
+    FROM
+        "StepRun" sr
+    JOIN
+        "Step" s ON sr."stepId" = s."id"
+    JOIN
+        "SemaphoreQueueItem" sqi ON sr."id" = sqi."stepRunId"
+    JOIN
+        "Worker" w ON sqi."workerId" = w."id"
+    WHERE
+        sr."tenantId" = $1::uuid
+        AND sr."status" = 'RUNNING'
+        AND s."heartbeatTimeout" IS NOT NULL
+        AND w."lastHeartbeatAt" < NOW() - convert_duration_to_interval(s."heartbeatTimeout")
+    LIMIT
+        COALESCE($2::integer, 100)
+    FOR UPDATE OF sr SKIP LOCKED
+)
+UPDATE
+    "StepRun" sr
+SET
+    "status" = 'CANCELLING',
+    "updatedAt" = CURRENT_TIMESTAMP
+FROM
+    step_runs
+WHERE
+    sr."id" = step_runs."id"
+RETURNING
+    sr."id"
+`
+
+type PopStepRunsToHeartbeatTimeoutParams struct {
+	Tenantid pgtype.UUID `json:"tenantid"`
+	Limit    pgtype.Int4 `json:"limit"`
+}
+
+// Marks running step runs as cancelling when their worker hasn't sent a heartbeat within the step's
+// heartbeat timeout, and returns their ids.
+func (q *Queries) PopStepRunsToHeartbeatTimeout(ctx context.Context, db DBTX, arg PopStepRunsToHeartbeatTimeoutParams) ([]pgtype.UUID, error) {
+	rows, err := db.Query(ctx, popStepRunsToHeartbeatTimeout, arg.Tenantid, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const queueStepRun = `-- name: QueueStepRun :exec
 UPDATE
     "StepRun"
@@ -2293,31 +2767,35 @@ SET
     "semaphoreReleased" = false
 WHERE
   "id" = $3::uuid AND
-  "tenantId" = $4::uuid
+  "tenantId" = $4::uuid AND
+  "status" = ANY($5::"StepRunStatus"[])
 `
 
 type QueueStepRunParams struct {
-	Input    []byte      `json:"input"`
-	IsRetry  pgtype.Bool `json:"isRetry"`
-	ID       pgtype.UUID `json:"id"`
-	Tenantid pgtype.UUID `json:"tenantid"`
+	Input        []byte          `json:"input"`
+	IsRetry      pgtype.Bool     `json:"isRetry"`
+	ID           pgtype.UUID     `json:"id"`
+	Tenantid     pgtype.UUID     `json:"tenantid"`
+	Fromstatuses []StepRunStatus `json:"fromstatuses"`
 }
 
+// Queues the step run if it's in one of the given statuses.
 func (q *Queries) QueueStepRun(ctx context.Context, db DBTX, arg QueueStepRunParams) error {
 	_, err := db.Exec(ctx, queueStepRun,
 		arg.Input,
 		arg.IsRetry,
 		arg.ID,
 		arg.Tenantid,
+		arg.Fromstatuses,
 	)
 	return err
 }
 
-const queueStepRunBulkNoInput = `-- name: QueueStepRunBulkNoInput :exec
+const queueStepRunBulkNoInput = `-- name: QueueStepRunBulkNoInput :many
 WITH input AS (
     SELECT
-        unnest($1::uuid[]) AS "id",
-        unnest($2::int[]) AS "retryCount"
+        unnest($2::uuid[]) AS "id",
+        unnest($3::int[]) AS "retryCount"
 )
 UPDATE
     "StepRun" sr
@@ -2334,24 +2812,43 @@ FROM
     input
 WHERE
     sr."id" = input."id"
+    AND sr."status" = ANY($1::"StepRunStatus"[])
+RETURNING sr."id"
 `
 
 type QueueStepRunBulkNoInputParams struct {
-	Ids         []pgtype.UUID `json:"ids"`
-	Retrycounts []int32       `json:"retrycounts"`
+	Fromstatuses []StepRunStatus `json:"fromstatuses"`
+	Ids          []pgtype.UUID   `json:"ids"`
+	Retrycounts  []int32         `json:"retrycounts"`
 }
 
-func (q *Queries) QueueStepRunBulkNoInput(ctx context.Context, db DBTX, arg QueueStepRunBulkNoInputParams) error {
-	_, err := db.Exec(ctx, queueStepRunBulkNoInput, arg.Ids, arg.Retrycounts)
-	return err
+// Queues the step runs which are in one of the given statuses, and returns the ids of the queued step runs.
+func (q *Queries) QueueStepRunBulkNoInput(ctx context.Context, db DBTX, arg QueueStepRunBulkNoInputParams) ([]pgtype.UUID, error) {
+	rows, err := db.Query(ctx, queueStepRunBulkNoInput, arg.Fromstatuses, arg.Ids, arg.Retrycounts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const queueStepRunBulkWithInput = `-- name: QueueStepRunBulkWithInput :exec
+const queueStepRunBulkWithInput = `-- name: QueueStepRunBulkWithInput :many
 WITH input AS (
     SELECT
-        unnest($1::uuid[]) AS "id",
-        unnest($2::jsonb[]) AS "input",
-        unnest($3::int[]) AS "retryCount"
+        unnest($2::uuid[]) AS "id",
+        unnest($3::jsonb[]) AS "input",
+        unnest($4::int[]) AS "retryCount"
 )
 UPDATE
     "StepRun" sr
@@ -2369,17 +2866,69 @@ FROM
     input
 WHERE
     sr."id" = input."id"
+    AND sr."status" = ANY($1::"StepRunStatus"[])
+RETURNING sr."id"
 `
 
 type QueueStepRunBulkWithInputParams struct {
-	Ids         []pgtype.UUID `json:"ids"`
-	Inputs      [][]byte      `json:"inputs"`
-	Retrycounts []int32       `json:"retrycounts"`
+	Fromstatuses []StepRunStatus `json:"fromstatuses"`
+	Ids          []pgtype.UUID   `json:"ids"`
+	Inputs       [][]byte        `json:"inputs"`
+	Retrycounts  []int32         `json:"retrycounts"`
+}
+
+// Queues the step runs which are in one of the given statuses, and returns the ids of the queued step runs.
+func (q *Queries) QueueStepRunBulkWithInput(ctx context.Context, db DBTX, arg QueueStepRunBulkWithInputParams) ([]pgtype.UUID, error) {
+	rows, err := db.Query(ctx, queueStepRunBulkWithInput,
+		arg.Fromstatuses,
+		arg.Ids,
+		arg.Inputs,
+		arg.Retrycounts,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) QueueStepRunBulkWithInput(ctx context.Context, db DBTX, arg QueueStepRunBulkWithInputParams) error {
-	_, err := db.Exec(ctx, queueStepRunBulkWithInput, arg.Ids, arg.Inputs, arg.Retrycounts)
-	return err
+const queueWorkerlessStepRun = `-- name: QueueWorkerlessStepRun :execrows
+UPDATE
+    "StepRun"
+SET
+    "status" = 'PENDING_ASSIGNMENT'
+WHERE
+    "id" = $1::uuid
+    AND "tenantId" = $2::uuid
+    AND "status" = ANY($3::"StepRunStatus"[])
+`
+
+type QueueWorkerlessStepRunParams struct {
+	Steprunid    pgtype.UUID     `json:"steprunid"`
+	Tenantid     pgtype.UUID     `json:"tenantid"`
+	Fromstatuses []StepRunStatus `json:"fromstatuses"`
+}
+
+// Moves a step run which doesn't run on a worker to pending assignment if it's in one of the given statuses. Pending
+// step runs aren't allowed to start or finish, so this is written before the step run is started without being
+// assigned.
+func (q *Queries) QueueWorkerlessStepRun(ctx context.Context, db DBTX, arg QueueWorkerlessStepRunParams) (int64, error) {
+	result, err := db.Exec(ctx, queueWorkerlessStepRun, arg.Steprunid, arg.Tenantid, arg.Fromstatuses)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const refreshTimeoutBy = `-- name: RefreshTimeoutBy :one
@@ -2484,7 +3033,7 @@ func (q *Queries) ReplayStepRunResetJobRun(ctx context.Context, db DBTX, jobruni
 
 const replayStepRunResetStepRuns = `-- name: ReplayStepRunResetStepRuns :many
 WITH RECURSIVE currStepRun AS (
-    SELECT id, "createdAt", "updatedAt", "deletedAt", "tenantId", "jobRunId", "stepId", "order", "workerId", "tickerId", status, input, output, "requeueAfter", "scheduleTimeoutAt", error, "startedAt", "finishedAt", "timeoutAt", "cancelledAt", "cancelledReason", "cancelledError", "inputSchema", "callerFiles", "gitRepoBranch", "retryCount", "semaphoreReleased", queue, priority, "internalRetryCount"
+    SELECT id, "createdAt", "updatedAt", "deletedAt", "tenantId", "jobRunId", "stepId", "order", "workerId", "tickerId", status, input, output, "requeueAfter", "scheduleTimeoutAt", error, "startedAt", "finishedAt", "timeoutAt", "cancelledAt", "cancelledReason", "cancelledError", "inputSchema", "callerFiles", "gitRepoBranch", "retryCount", "semaphoreReleased", queue, priority, "internalRetryCount", deadline
     FROM "StepRun"
     WHERE
         "id" = $1::uuid
@@ -2522,7 +3071,7 @@ FROM
 WHERE
     sr."id" = csr."id" OR
     sr."id" = $1::uuid
-RETURNING sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount", sr."semaphoreReleased", sr.queue, sr.priority, sr."internalRetryCount"
+RETURNING sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount", sr."semaphoreReleased", sr.queue, sr.priority, sr."internalRetryCount", sr.deadline
 `
 
 type ReplayStepRunResetStepRunsParams struct {
@@ -2570,6 +3119,7 @@ func (q *Queries) ReplayStepRunResetStepRuns(ctx context.Context, db DBTX, arg R
 			&i.Queue,
 			&i.Priority,
 			&i.InternalRetryCount,
+			&i.Deadline,
 		); err != nil {
 			return nil, err
 		}
@@ -2594,7 +3144,7 @@ SET
     "error" = NULL
 WHERE
     "id" =  $1::uuid
-RETURNING "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", status, error, "startedAt", "finishedAt", "concurrencyGroupId", "displayName", id, "childIndex", "childKey", "parentId", "parentStepRunId", "additionalMetadata", duration, priority, "insertOrder"
+RETURNING "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", status, error, "startedAt", "finishedAt", "concurrencyGroupId", "displayName", id, "childIndex", "childKey", "parentId", "parentStepRunId", "additionalMetadata", duration, priority, "insertOrder", output, "replayedFromId", "replayedFromStepId", "traceContext"
 `
 
 func (q *Queries) ReplayStepRunResetWorkflowRun(ctx context.Context, db DBTX, workflowrunid pgtype.UUID) (*WorkflowRun, error) {
@@ -2621,6 +3171,10 @@ func (q *Queries) ReplayStepRunResetWorkflowRun(ctx context.Context, db DBTX, wo
 		&i.Duration,
 		&i.Priority,
 		&i.InsertOrder,
+		&i.Output,
+		&i.ReplayedFromId,
+		&i.ReplayedFromStepId,
+		&i.TraceContext,
 	)
 	return &i, err
 }
@@ -2689,7 +3243,7 @@ SET
 WHERE
     sr."id" = ANY($1::uuid[]) AND
     sr."tenantId" = $2::uuid
-RETURNING sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount", sr."semaphoreReleased", sr.queue, sr.priority, sr."internalRetryCount"
+RETURNING sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount", sr."semaphoreReleased", sr.queue, sr.priority, sr."internalRetryCount", sr.deadline
 `
 
 type ResetStepRunsByIdsParams struct {
@@ -2737,6 +3291,7 @@ func (q *Queries) ResetStepRunsByIds(ctx context.Context, db DBTX, arg ResetStep
 			&i.Queue,
 			&i.Priority,
 			&i.InternalRetryCount,
+			&i.Deadline,
 		); err != nil {
 			return nil, err
 		}
@@ -2753,7 +3308,7 @@ WITH RECURSIVE currStepRun AS (
   SELECT "id", "status", "cancelledReason"
   FROM "StepRun"
   WHERE
-    "id" = $2::uuid
+    "id" = $3::uuid
 ), childStepRuns AS (
   SELECT sr."id", sr."status"
   FROM "StepRun" sr
@@ -2770,35 +3325,37 @@ WITH RECURSIVE currStepRun AS (
 UPDATE
     "StepRun" as sr
 SET  "status" = CASE
-    -- When the step is in a final state, it cannot be updated
-    WHEN sr."status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN sr."status"
+    -- When the step run can't be cancelled, like when it's in a final state, it isn't updated
+    WHEN NOT sr."status" = ANY($1::"StepRunStatus"[]) THEN sr."status"
     -- When the given step run has failed or been cancelled, then all child step runs are cancelled
-    WHEN $1::"StepRunStatus" IN ('FAILED', 'CANCELLED') THEN 'CANCELLED'
+    WHEN $2::"StepRunStatus" IN ('FAILED', 'CANCELLED') THEN 'CANCELLED'
     ELSE sr."status"
     END,
     -- When the previous step run timed out, the cancelled reason is set
     "cancelledReason" = CASE
-    -- When the step is in a final state, it cannot be updated
-    WHEN sr."status" IN ('SUCCEEDED', 'FAILED', 'CANCELLED') THEN sr."cancelledReason"
-    WHEN $1::"StepRunStatus" = 'CANCELLED' AND (SELECT "cancelledReason" FROM currStepRun) = 'TIMED_OUT'::text THEN 'PREVIOUS_STEP_TIMED_OUT'
-    WHEN $1::"StepRunStatus" = 'FAILED' THEN 'PREVIOUS_STEP_FAILED'
-    WHEN $1::"StepRunStatus" = 'CANCELLED' THEN 'PREVIOUS_STEP_CANCELLED'
+    -- When the step run can't be cancelled, like when it's in a final state, it isn't updated
+    WHEN NOT sr."status" = ANY($1::"StepRunStatus"[]) THEN sr."cancelledReason"
+    WHEN $2::"StepRunStatus" = 'CANCELLED' AND (SELECT "cancelledReason" FROM currStepRun) = 'TIMED_OUT'::text THEN 'PREVIOUS_STEP_TIMED_OUT'
+    WHEN $2::"StepRunStatus" = 'FAILED' THEN 'PREVIOUS_STEP_FAILED'
+    WHEN $2::"StepRunStatus" = 'CANCELLED' THEN 'PREVIOUS_STEP_CANCELLED'
     ELSE NULL
     END
 FROM
     childStepRuns csr
 WHERE
     sr."id" = csr."id"
-RETURNING sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount", sr."semaphoreReleased", sr.queue, sr.priority, sr."internalRetryCount"
+RETURNING sr.id, sr."createdAt", sr."updatedAt", sr."deletedAt", sr."tenantId", sr."jobRunId", sr."stepId", sr."order", sr."workerId", sr."tickerId", sr.status, sr.input, sr.output, sr."requeueAfter", sr."scheduleTimeoutAt", sr.error, sr."startedAt", sr."finishedAt", sr."timeoutAt", sr."cancelledAt", sr."cancelledReason", sr."cancelledError", sr."inputSchema", sr."callerFiles", sr."gitRepoBranch", sr."retryCount", sr."semaphoreReleased", sr.queue, sr.priority, sr."internalRetryCount", sr.deadline
 `
 
 type ResolveLaterStepRunsParams struct {
-	Status    StepRunStatus `json:"status"`
-	Steprunid pgtype.UUID   `json:"steprunid"`
+	Fromstatuses []StepRunStatus `json:"fromstatuses"`
+	Status       StepRunStatus   `json:"status"`
+	Steprunid    pgtype.UUID     `json:"steprunid"`
 }
 
+// Cancels the later step runs of a failed or cancelled step run which are in one of the given statuses.
 func (q *Queries) ResolveLaterStepRuns(ctx context.Context, db DBTX, arg ResolveLaterStepRunsParams) ([]*StepRun, error) {
-	rows, err := db.Query(ctx, resolveLaterStepRuns, arg.Status, arg.Steprunid)
+	rows, err := db.Query(ctx, resolveLaterStepRuns, arg.Fromstatuses, arg.Status, arg.Steprunid)
 	if err != nil {
 		return nil, err
 	}
@@ -2837,6 +3394,7 @@ func (q *Queries) ResolveLaterStepRuns(ctx context.Context, db DBTX, arg Resolve
 			&i.Queue,
 			&i.Priority,
 			&i.InternalRetryCount,
+			&i.Deadline,
 		); err != nil {
 			return nil, err
 		}
@@ -2848,6 +3406,34 @@ func (q *Queries) ResolveLaterStepRuns(ctx context.Context, db DBTX, arg Resolve
 	return items, nil
 }
 
+const skipStepRun = `-- name: SkipStepRun :execrows
+UPDATE
+    "StepRun"
+SET
+    "status" = 'SKIPPED',
+    "finishedAt" = $1::timestamp,
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = $2::uuid
+    AND "tenantId" = $3::uuid
+    AND "status" = 'PENDING'
+`
+
+type SkipStepRunParams struct {
+	Skippedat pgtype.Timestamp `json:"skippedat"`
+	Steprunid pgtype.UUID      `json:"steprunid"`
+	Tenantid  pgtype.UUID      `json:"tenantid"`
+}
+
+// Marks a pending step run as skipped. Step runs which have already been queued are not updated.
+func (q *Queries) SkipStepRun(ctx context.Context, db DBTX, arg SkipStepRunParams) (int64, error) {
+	result, err := db.Exec(ctx, skipStepRun, arg.Skippedat, arg.Steprunid, arg.Tenantid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateStepRunInputSchema = `-- name: UpdateStepRunInputSchema :one
 UPDATE
     "StepRun" sr
@@ -2981,24 +3567,31 @@ WITH input AS (
         sr."id",
         sr."retryCount",
         sr."tenantId",
-        CURRENT_TIMESTAMP + convert_duration_to_interval(input."stepTimeout") AS "timeoutAt"
+        CURRENT_TIMESTAMP + convert_duration_to_interval(input."stepTimeout") AS "timeoutAt",
+        COALESCE(s."slotType", 'default') AS "slotType"
     FROM
         input
     JOIN
         "StepRun" sr ON sr."id" = input."id"
+    JOIN
+        "Step" s ON s."id" = sr."stepId"
     ORDER BY sr."id"
 ), assigned_step_runs AS (
     INSERT INTO "SemaphoreQueueItem" (
         "stepRunId",
         "workerId",
-        "tenantId"
+        "tenantId",
+        "slotType"
     )
     SELECT
         input."id",
         input."workerId",
-        $4::uuid
+        $4::uuid,
+        COALESCE(usr."slotType", 'default')
     FROM
         input
+    LEFT JOIN
+        updated_step_runs usr ON usr."id" = input."id"
     ON CONFLICT ("stepRunId") DO NOTHING
     -- only return the step run ids that were successfully assigned
     RETURNING "stepRunId", "workerId"
@@ -9,7 +9,17 @@ JOIN "JobRun" jr ON sr."jobRunId" = jr."id"
 WHERE sr."id" = @stepRunId::uuid
 AND sr."tenantId" = @tenantId::uuid;
 
--- name: CreateStreamEvent :one
+-- name: CreateStreamEvents :many
+-- Stream events of step runs which don't exist in the tenant are skipped. The events are inserted in the order of the
+-- input, so the ids of the events of a step run are in the order of the input.
+WITH input AS (
+    SELECT
+        unnest(@createdAts::timestamp[]) AS "createdAt",
+        unnest(@stepRunIds::uuid[]) AS "stepRunId",
+        unnest(@messages::bytea[]) AS "message",
+        unnest(@metadatas::jsonb[]) AS "metadata",
+        generate_series(1, cardinality(@createdAts::timestamp[])) AS "index"
+)
 INSERT INTO "StreamEvent" (
     "createdAt",
     "tenantId",
@@ -18,14 +28,17 @@ INSERT INTO "StreamEvent" (
     "metadata"
 )
 SELECT
-    coalesce(sqlc.narg('createdAt')::timestamp, now()),
+    i."createdAt",
     @tenantId::uuid,
-    @stepRunId::uuid,
-    @message::bytea,
-    coalesce(sqlc.narg('metadata')::jsonb, '{}'::jsonb)
-FROM "StepRun"
-WHERE "StepRun"."id" = @stepRunId::uuid
-AND "StepRun"."tenantId" = @tenantId::uuid
+    i."stepRunId",
+    i."message",
+    i."metadata"
+FROM
+    input i
+JOIN
+    "StepRun" sr ON sr."id" = i."stepRunId" AND sr."tenantId" = @tenantId::uuid
+ORDER BY
+    i."index"
 RETURNING *;
 
 -- name: GetStreamEvent :one
@@ -23,7 +23,15 @@ func (q *Queries) CleanupStreamEvents(ctx context.Context, db DBTX) error {
 	return err
 }
 
-const createStreamEvent = `-- name: CreateStreamEvent :one
+const createStreamEvents = `-- name: CreateStreamEvents :many
+WITH input AS (
+    SELECT
+        unnest($2::timestamp[]) AS "createdAt",
+        unnest($3::uuid[]) AS "stepRunId",
+        unnest($4::bytea[]) AS "message",
+        unnest($5::jsonb[]) AS "metadata",
+        generate_series(1, cardinality($2::timestamp[])) AS "index"
+)
 INSERT INTO "StreamEvent" (
     "createdAt",
     "tenantId",
@@ -32,43 +40,61 @@ INSERT INTO "StreamEvent" (
     "metadata"
 )
 SELECT
-    coalesce($1::timestamp, now()),
-    $2::uuid,
-    $3::uuid,
-    $4::bytea,
-    coalesce($5::jsonb, '{}'::jsonb)
-FROM "StepRun"
-WHERE "StepRun"."id" = $3::uuid
-AND "StepRun"."tenantId" = $2::uuid
+    i."createdAt",
+    $1::uuid,
+    i."stepRunId",
+    i."message",
+    i."metadata"
+FROM
+    input i
+JOIN
+    "StepRun" sr ON sr."id" = i."stepRunId" AND sr."tenantId" = $1::uuid
+ORDER BY
+    i."index"
 RETURNING id, "createdAt", "tenantId", "stepRunId", message, metadata
 `
 
-type CreateStreamEventParams struct {
-	CreatedAt pgtype.Timestamp `json:"createdAt"`
-	Tenantid  pgtype.UUID      `json:"tenantid"`
-	Steprunid pgtype.UUID      `json:"steprunid"`
-	Message   []byte           `json:"message"`
-	Metadata  []byte           `json:"metadata"`
+type CreateStreamEventsParams struct {
+	Tenantid   pgtype.UUID        `json:"tenantid"`
+	Createdats []pgtype.Timestamp `json:"createdats"`
+	Steprunids []pgtype.UUID      `json:"steprunids"`
+	Messages   [][]byte           `json:"messages"`
+	Metadatas  [][]byte           `json:"metadatas"`
 }
 
-func (q *Queries) CreateStreamEvent(ctx context.Context, db DBTX, arg CreateStreamEventParams) (*StreamEvent, error) {
-	row := db.QueryRow(ctx, createStreamEvent,
-		arg.CreatedAt,
+// Stream events of step runs which don't exist in the tenant are skipped. The events are inserted in the order of the
+// input, so the ids of the events of a step run are in the order of the input.
+func (q *Queries) CreateStreamEvents(ctx context.Context, db DBTX, arg CreateStreamEventsParams) ([]*StreamEvent, error) {
+	rows, err := db.Query(ctx, createStreamEvents,
 		arg.Tenantid,
-		arg.Steprunid,
-		arg.Message,
-		arg.Metadata,
+		arg.Createdats,
+		arg.Steprunids,
+		arg.Messages,
+		arg.Metadatas,
 	)
-	var i StreamEvent
-	err := row.Scan(
-		&i.ID,
-		&i.CreatedAt,
-		&i.TenantId,
-		&i.StepRunId,
-		&i.Message,
-		&i.Metadata,
-	)
-	return &i, err
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*StreamEvent
+	for rows.Next() {
+		var i StreamEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.TenantId,
+			&i.StepRunId,
+			&i.Message,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const getStreamEvent = `-- name: GetStreamEvent :one
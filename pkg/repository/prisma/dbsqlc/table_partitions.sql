@@ -0,0 +1,114 @@
+-- name: ListTablePartitions :many
+-- Lists the partitions of a partitioned table, with the end of their range of "createdAt", ordered by the end of
+-- their range.
+SELECT
+    c."relname"::text AS "name",
+    substring(pg_get_expr(c."relpartbound", c."oid") FROM 'TO \(''([^'']+)''\)')::timestamp AS "rangeEnd"
+FROM
+    pg_catalog.pg_inherits i
+JOIN
+    pg_catalog.pg_class c ON c."oid" = i."inhrelid"
+WHERE
+    i."inhparent" = quote_ident(@tableName::text)::regclass
+ORDER BY
+    "rangeEnd" ASC;
+
+-- name: TryAcquirePartitionMaintenanceLock :one
+SELECT
+    pg_try_advisory_lock(hashtext('partition-maintenance')) AS "acquired";
+
+-- name: ReleasePartitionMaintenanceLock :exec
+SELECT
+    pg_advisory_unlock(hashtext('partition-maintenance'));
+
+-- name: HasLiveStepRuns :one
+-- Returns whether there are step runs created before the cutoff which aren't deleted, or which were deleted after
+-- deletedAfter.
+SELECT
+    (EXISTS (
+        SELECT 1 FROM "StepRun" WHERE "createdAt" < @createdBefore::timestamp AND "deletedAt" IS NULL
+    ) OR EXISTS (
+        SELECT 1 FROM "StepRun" WHERE "createdAt" < @createdBefore::timestamp AND "deletedAt" >= @deletedAfter::timestamp
+    ))::boolean AS "exists";
+
+-- name: HasLiveEvents :one
+-- Returns whether there are events created before the cutoff which aren't deleted, or which were deleted after
+-- deletedAfter.
+SELECT
+    (EXISTS (
+        SELECT 1 FROM "Event" WHERE "createdAt" < @createdBefore::timestamp AND "deletedAt" IS NULL
+    ) OR EXISTS (
+        SELECT 1 FROM "Event" WHERE "createdAt" < @createdBefore::timestamp AND "deletedAt" >= @deletedAfter::timestamp
+    ))::boolean AS "exists";
+
+-- name: HasQueuedQueueItems :one
+SELECT
+    EXISTS (
+        SELECT 1 FROM "QueueItem" WHERE "createdAt" < @createdBefore::timestamp AND "isQueued"
+    ) AS "exists";
+
+-- name: DeleteStepRunDependencies :many
+-- Deletes the events and archived results of a batch of the step runs created before the cutoff, and returns the
+-- step runs of the batch ordered by id.
+WITH step_runs AS (
+    SELECT
+        "id",
+        "tenantId"
+    FROM
+        "StepRun"
+    WHERE
+        "createdAt" < @createdBefore::timestamp
+        AND "id" > @afterId::uuid
+    ORDER BY
+        "id" ASC
+    LIMIT @batchSize::integer
+), deleted_events AS (
+    DELETE FROM
+        "StepRunEvent"
+    WHERE
+        "stepRunId" IN (SELECT "id" FROM step_runs)
+), deleted_results AS (
+    DELETE FROM
+        "StepRunResultArchive"
+    WHERE
+        "stepRunId" IN (SELECT "id" FROM step_runs)
+)
+SELECT
+    "id",
+    "tenantId"
+FROM
+    step_runs
+ORDER BY
+    "id" ASC;
+
+-- name: DeleteEventDependencies :many
+-- Deletes the delayed deliveries of a batch of the events created before the cutoff, and returns the events of the
+-- batch ordered by id.
+WITH events AS (
+    SELECT
+        "id",
+        "tenantId",
+        "key",
+        "additionalMetadata",
+        "createdAt",
+        "deletedAt"
+    FROM
+        "Event"
+    WHERE
+        "createdAt" < @createdBefore::timestamp
+        AND "id" > @afterId::uuid
+    ORDER BY
+        "id" ASC
+    LIMIT @batchSize::integer
+), deleted_delayed_events AS (
+    DELETE FROM
+        "DelayedEvent"
+    WHERE
+        "eventId" IN (SELECT "id" FROM events)
+)
+SELECT
+    *
+FROM
+    events
+ORDER BY
+    "id" ASC;
@@ -0,0 +1,148 @@
+-- name: CreateWorkflowVersionDefinition :exec
+INSERT INTO "WorkflowVersionDefinition" (
+    "workflowVersionId",
+    "definition"
+) VALUES (
+    @workflowVersionId::uuid,
+    @definition::jsonb
+);
+
+-- name: ListWorkflowDefinitions :many
+-- The latest version of every workflow of the tenant, with the definition it was registered with
+SELECT DISTINCT ON (w."name")
+    w."name" AS "workflowName",
+    w."isPaused",
+    wv."id" AS "workflowVersionId",
+    d."definition"
+FROM
+    "Workflow" w
+JOIN
+    "WorkflowVersion" wv ON wv."workflowId" = w."id"
+LEFT JOIN
+    "WorkflowVersionDefinition" d ON d."workflowVersionId" = wv."id"
+WHERE
+    w."tenantId" = @tenantId::uuid
+    AND w."deletedAt" IS NULL
+    AND wv."deletedAt" IS NULL
+ORDER BY
+    w."name", wv."order" DESC;
+
+-- name: ListCronsForExport :many
+-- The enabled crons of the tenant which were created through the API, the crons which are declared by the
+-- workflows are part of the definitions
+WITH latest_versions AS (
+    SELECT DISTINCT ON (wv."workflowId")
+        wv."id" AS "workflowVersionId",
+        wv."workflowId"
+    FROM
+        "WorkflowVersion" wv
+    JOIN
+        "Workflow" w ON w."id" = wv."workflowId"
+    WHERE
+        w."tenantId" = @tenantId::uuid
+        AND w."deletedAt" IS NULL
+        AND wv."deletedAt" IS NULL
+    ORDER BY
+        wv."workflowId", wv."order" DESC
+)
+SELECT
+    w."name" AS "workflowName",
+    c."name",
+    c."cron",
+    c."timezone",
+    c."jitterSeconds",
+    cal."name" AS "exclusionCalendarName",
+    c."input",
+    c."additionalMetadata"
+FROM
+    latest_versions
+JOIN
+    "Workflow" w ON w."id" = latest_versions."workflowId"
+JOIN
+    "WorkflowTriggers" t ON t."workflowVersionId" = latest_versions."workflowVersionId"
+JOIN
+    "WorkflowTriggerCronRef" c ON c."parentId" = t."id"
+LEFT JOIN
+    "CronExclusionCalendar" cal ON cal."id" = c."exclusionCalendarId"
+WHERE
+    t."deletedAt" IS NULL
+    AND c."deletedAt" IS NULL
+    AND c."method" = 'API'
+    AND c."enabled" = TRUE
+ORDER BY
+    w."name", c."createdAt";
+
+-- name: ListScheduledRunsForExport :many
+-- The scheduled runs of the tenant which were created through the API and haven't been triggered yet
+SELECT
+    w."name" AS "workflowName",
+    s."triggerAt",
+    s."input",
+    s."additionalMetadata"
+FROM
+    "WorkflowTriggerScheduledRef" s
+JOIN
+    "WorkflowVersion" wv ON wv."id" = s."parentId"
+JOIN
+    "Workflow" w ON w."id" = wv."workflowId"
+WHERE
+    w."tenantId" = @tenantId::uuid
+    AND w."deletedAt" IS NULL
+    AND s."deletedAt" IS NULL
+    AND s."method" = 'API'
+    AND s."triggerAt" > NOW()
+    AND NOT EXISTS (
+        SELECT 1 FROM "WorkflowRunTriggeredBy" tb WHERE tb."scheduledId" = s."id"
+    )
+ORDER BY
+    s."triggerAt";
+
+-- name: ListRateLimitsForExport :many
+-- The static rate limits of the tenant, dynamic rate limits expire and are recreated by the steps which use them
+SELECT
+    "key",
+    "limitValue",
+    "window",
+    "algorithm",
+    "burst"
+FROM
+    "RateLimit"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "expiresAt" IS NULL
+ORDER BY
+    "key";
+
+-- name: ListWorkflowRunsForExport :many
+SELECT
+    r."id",
+    r."createdAt",
+    w."name" AS "workflowName",
+    wv."version" AS "workflowVersion",
+    r."status",
+    r."displayName",
+    tb."input",
+    r."output",
+    r."error",
+    r."additionalMetadata",
+    r."startedAt",
+    r."finishedAt"
+FROM
+    "WorkflowRun" r
+JOIN
+    "WorkflowVersion" wv ON wv."id" = r."workflowVersionId"
+JOIN
+    "Workflow" w ON w."id" = wv."workflowId"
+LEFT JOIN
+    "WorkflowRunTriggeredBy" tb ON tb."parentId" = r."id"
+WHERE
+    r."tenantId" = @tenantId::uuid
+    AND r."deletedAt" IS NULL
+    AND (
+        sqlc.narg('afterCreatedAt')::timestamp IS NULL
+        OR (r."createdAt", r."id") > (sqlc.narg('afterCreatedAt')::timestamp, sqlc.narg('afterId')::uuid)
+    )
+ORDER BY
+    r."createdAt", r."id"
+LIMIT
+    sqlc.arg('limit')::integer;
@@ -0,0 +1,61 @@
+-- name: GetTenantQuota :one
+SELECT
+    *
+FROM
+    "TenantQuota"
+WHERE
+    "tenantId" = @tenantId::uuid;
+
+-- name: UpsertTenantQuota :one
+INSERT INTO "TenantQuota" (
+    "tenantId",
+    "maxQueuedRuns",
+    "maxWorkers",
+    "maxEventsPerMinute",
+    "maxWorkflowVersions"
+) VALUES (
+    @tenantId::uuid,
+    sqlc.narg('maxQueuedRuns')::integer,
+    sqlc.narg('maxWorkers')::integer,
+    sqlc.narg('maxEventsPerMinute')::integer,
+    sqlc.narg('maxWorkflowVersions')::integer
+)
+ON CONFLICT ("tenantId") DO UPDATE
+SET
+    "maxQueuedRuns" = sqlc.narg('maxQueuedRuns')::integer,
+    "maxWorkers" = sqlc.narg('maxWorkers')::integer,
+    "maxEventsPerMinute" = sqlc.narg('maxEventsPerMinute')::integer,
+    "maxWorkflowVersions" = sqlc.narg('maxWorkflowVersions')::integer,
+    "updatedAt" = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: CountQueuedWorkflowRuns :one
+SELECT
+    COUNT(*) AS "count"
+FROM
+    "WorkflowRun"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "status" IN ('PENDING', 'QUEUED')
+    AND "deletedAt" IS NULL;
+
+-- name: CountEventsSince :one
+SELECT
+    COUNT(*) AS "count"
+FROM
+    "Event"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "createdAt" >= @since::timestamp;
+
+-- name: CountTenantWorkflowVersions :one
+SELECT
+    COUNT(*) AS "count"
+FROM
+    "WorkflowVersion" wv
+JOIN
+    "Workflow" w ON w."id" = wv."workflowId"
+WHERE
+    w."tenantId" = @tenantId::uuid
+    AND w."deletedAt" IS NULL
+    AND wv."deletedAt" IS NULL;
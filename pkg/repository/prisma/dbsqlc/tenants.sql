@@ -465,3 +465,52 @@ FROM
     "Tenant" as tenants
 WHERE
     "schedulerPartitionId" = sqlc.arg('schedulerPartitionId')::text;
+
+-- name: CountActiveControllerPartitions :one
+SELECT
+    COUNT(*) AS "count"
+FROM
+    "ControllerPartition"
+WHERE
+    "lastHeartbeat" > NOW() - INTERVAL '1 minute';
+
+-- name: AssignTenantPartitions :execrows
+-- Assigns the tenants which hash to the given tenant partitions to the controller partition.
+UPDATE
+    "Tenant" AS tenants
+SET
+    "controllerPartitionId" = @controllerPartitionId::text
+WHERE
+    tenants."slug" != 'internal'
+    AND mod(hashtext(tenants."id"::text)::bigint + 2147483648, @partitionCount::integer)::integer = ANY(@partitions::integer[])
+    AND tenants."controllerPartitionId" IS DISTINCT FROM @controllerPartitionId::text;
+
+-- name: ListTenantPartitions :many
+-- Lists the tenant partitions along with the controller partition which holds their lease and their number of tenants.
+WITH tenant_counts AS (
+    SELECT
+        mod(hashtext(tenants."id"::text)::bigint + 2147483648, @partitionCount::integer)::integer AS "partition",
+        COUNT(*) AS "tenants"
+    FROM
+        "Tenant" AS tenants
+    WHERE
+        tenants."slug" != 'internal'
+    GROUP BY
+        1
+)
+SELECT
+    partitions."partition"::integer AS "partition",
+    leases."holderId" AS "controllerPartitionId",
+    cp."name" AS "controllerPartitionName",
+    leases."expiresAt" AS "expiresAt",
+    COALESCE(tenant_counts."tenants", 0)::bigint AS "tenants"
+FROM
+    generate_series(0, sqlc.arg('partitioncount')::integer - 1) AS partitions("partition")
+LEFT JOIN
+    "EngineLease" AS leases ON leases."name" = 'tenant-partition:' || partitions."partition" AND leases."expiresAt" > NOW()
+LEFT JOIN
+    "ControllerPartition" AS cp ON cp."id" = leases."holderId"
+LEFT JOIN
+    tenant_counts ON tenant_counts."partition" = partitions."partition"
+ORDER BY
+    partitions."partition";
@@ -11,6 +11,32 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const assignTenantPartitions = `-- name: AssignTenantPartitions :execrows
+UPDATE
+    "Tenant" AS tenants
+SET
+    "controllerPartitionId" = $1::text
+WHERE
+    tenants."slug" != 'internal'
+    AND mod(hashtext(tenants."id"::text)::bigint + 2147483648, $2::integer)::integer = ANY($3::integer[])
+    AND tenants."controllerPartitionId" IS DISTINCT FROM $1::text
+`
+
+type AssignTenantPartitionsParams struct {
+	Controllerpartitionid string  `json:"controllerpartitionid"`
+	Partitioncount        int32   `json:"partitioncount"`
+	Partitions            []int32 `json:"partitions"`
+}
+
+// Assigns the tenants which hash to the given tenant partitions to the controller partition.
+func (q *Queries) AssignTenantPartitions(ctx context.Context, db DBTX, arg AssignTenantPartitionsParams) (int64, error) {
+	result, err := db.Exec(ctx, assignTenantPartitions, arg.Controllerpartitionid, arg.Partitioncount, arg.Partitions)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const controllerPartitionHeartbeat = `-- name: ControllerPartitionHeartbeat :one
 UPDATE
     "ControllerPartition" p
@@ -34,6 +60,22 @@ func (q *Queries) ControllerPartitionHeartbeat(ctx context.Context, db DBTX, con
 	return &i, err
 }
 
+const countActiveControllerPartitions = `-- name: CountActiveControllerPartitions :one
+SELECT
+    COUNT(*) AS "count"
+FROM
+    "ControllerPartition"
+WHERE
+    "lastHeartbeat" > NOW() - INTERVAL '1 minute'
+`
+
+func (q *Queries) CountActiveControllerPartitions(ctx context.Context, db DBTX) (int64, error) {
+	row := db.QueryRow(ctx, countActiveControllerPartitions)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createControllerPartition = `-- name: CreateControllerPartition :one
 INSERT INTO "ControllerPartition" ("id", "createdAt", "lastHeartbeat", "name")
 VALUES (gen_random_uuid()::text, NOW(), NOW(), $1::text)
@@ -533,6 +575,71 @@ func (q *Queries) GetTenantWorkflowQueueMetrics(ctx context.Context, db DBTX, ar
 	return items, nil
 }
 
+const listTenantPartitions = `-- name: ListTenantPartitions :many
+WITH tenant_counts AS (
+    SELECT
+        mod(hashtext(tenants."id"::text)::bigint + 2147483648, $1::integer)::integer AS "partition",
+        COUNT(*) AS "tenants"
+    FROM
+        "Tenant" AS tenants
+    WHERE
+        tenants."slug" != 'internal'
+    GROUP BY
+        1
+)
+SELECT
+    partitions."partition"::integer AS "partition",
+    leases."holderId" AS "controllerPartitionId",
+    cp."name" AS "controllerPartitionName",
+    leases."expiresAt" AS "expiresAt",
+    COALESCE(tenant_counts."tenants", 0)::bigint AS "tenants"
+FROM
+    generate_series(0, $1::integer - 1) AS partitions("partition")
+LEFT JOIN
+    "EngineLease" AS leases ON leases."name" = 'tenant-partition:' || partitions."partition" AND leases."expiresAt" > NOW()
+LEFT JOIN
+    "ControllerPartition" AS cp ON cp."id" = leases."holderId"
+LEFT JOIN
+    tenant_counts ON tenant_counts."partition" = partitions."partition"
+ORDER BY
+    partitions."partition"
+`
+
+type ListTenantPartitionsRow struct {
+	Partition               int32            `json:"partition"`
+	ControllerPartitionId   pgtype.Text      `json:"controllerPartitionId"`
+	ControllerPartitionName pgtype.Text      `json:"controllerPartitionName"`
+	ExpiresAt               pgtype.Timestamp `json:"expiresAt"`
+	Tenants                 int64            `json:"tenants"`
+}
+
+// Lists the tenant partitions along with the controller partition which holds their lease and their number of tenants.
+func (q *Queries) ListTenantPartitions(ctx context.Context, db DBTX, partitioncount int32) ([]*ListTenantPartitionsRow, error) {
+	rows, err := db.Query(ctx, listTenantPartitions, partitioncount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListTenantPartitionsRow
+	for rows.Next() {
+		var i ListTenantPartitionsRow
+		if err := rows.Scan(
+			&i.Partition,
+			&i.ControllerPartitionId,
+			&i.ControllerPartitionName,
+			&i.ExpiresAt,
+			&i.Tenants,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTenants = `-- name: ListTenants :many
 SELECT
     id, "createdAt", "updatedAt", "deletedAt", name, slug, "analyticsOptOut", "alertMemberEmails", "controllerPartitionId", "workerPartitionId", "dataRetentionPeriod", "schedulerPartitionId"
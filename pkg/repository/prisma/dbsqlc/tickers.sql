@@ -97,6 +97,18 @@ WHERE
     getGroupKeyRuns."id" = getGroupKeyRunsToTimeout."id"
 RETURNING getGroupKeyRuns.*;
 
+-- name: DeleteDelayedEvents :exec
+DELETE FROM
+    "DelayedEvent"
+WHERE
+    "eventId" = ANY(@eventIds::uuid[]);
+
+-- name: DeleteStepRunTimers :exec
+DELETE FROM
+    "StepRunTimer"
+WHERE
+    "stepRunId" = ANY(@stepRunIds::uuid[]);
+
 -- name: PollCronSchedules :many
 WITH latest_workflow_versions AS (
     SELECT
@@ -251,6 +263,8 @@ WITH expiring_tokens AS (
         "APIToken" as t0
     WHERE
         t0."revoked" = false
+        -- rotated tokens expire at the end of the grace period of the rotation on purpose
+        AND t0."rotatedAt" IS NULL
         AND t0."expiresAt" <= NOW() + INTERVAL '7 days'
         AND t0."expiresAt" >= NOW()
         AND (
@@ -362,3 +376,92 @@ WHERE
 	)
 	AND sr."updatedAt" < CURRENT_TIMESTAMP - INTERVAL '5 seconds'
 ;
+
+-- name: PollStepRunTimers :many
+-- Claims the timers of sleeping step runs which are due. Timers of step runs which have already reached a final
+-- state (for example, because they were cancelled) are deleted.
+WITH due_timers AS (
+    SELECT
+        t."stepRunId"
+    FROM
+        "StepRunTimer" t
+    WHERE
+        t."wakeAt" <= NOW()
+        AND (
+            NOT EXISTS (
+                SELECT 1 FROM "Ticker" WHERE "id" = t."tickerId" AND "isActive" = true AND "lastHeartbeatAt" >= NOW() - INTERVAL '10 seconds'
+            )
+            OR t."tickerId" IS NULL
+        )
+    ORDER BY
+        t."wakeAt" ASC
+    LIMIT 1000
+    FOR UPDATE SKIP LOCKED
+), stale_timers AS (
+    DELETE FROM
+        "StepRunTimer" t
+    USING
+        due_timers dt
+    WHERE
+        t."stepRunId" = dt."stepRunId"
+        AND NOT EXISTS (
+            SELECT 1
+            FROM "StepRun" sr
+            WHERE
+                sr."id" = dt."stepRunId"
+                AND sr."status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED', 'CANCELLING')
+        )
+    RETURNING t."stepRunId"
+)
+UPDATE
+    "StepRunTimer" t
+SET
+    "tickerId" = @tickerId::uuid
+FROM
+    due_timers dt
+JOIN
+    "StepRun" sr ON sr."id" = dt."stepRunId"
+JOIN
+    "JobRun" jr ON jr."id" = sr."jobRunId"
+JOIN
+    "Step" s ON s."id" = sr."stepId"
+WHERE
+    t."stepRunId" = dt."stepRunId"
+    AND sr."status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED', 'CANCELLING')
+RETURNING
+    t."stepRunId",
+    t."tenantId",
+    t."wakeAt",
+    jr."workflowRunId",
+    (s."waitForEvent" IS NOT NULL)::boolean AS "isEventWait",
+    s."actionId";
+
+-- name: PollDelayedEvents :many
+-- Claims the delayed events which are due.
+WITH due_events AS (
+    SELECT
+        d."eventId"
+    FROM
+        "DelayedEvent" d
+    WHERE
+        d."deliverAfter" <= NOW()
+        AND (
+            NOT EXISTS (
+                SELECT 1 FROM "Ticker" WHERE "id" = d."tickerId" AND "isActive" = true AND "lastHeartbeatAt" >= NOW() - INTERVAL '10 seconds'
+            )
+            OR d."tickerId" IS NULL
+        )
+    ORDER BY
+        d."deliverAfter" ASC
+    LIMIT 1000
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE
+    "DelayedEvent" d
+SET
+    "tickerId" = @tickerId::uuid
+FROM
+    due_events de
+WHERE
+    d."eventId" = de."eventId"
+RETURNING d.*;
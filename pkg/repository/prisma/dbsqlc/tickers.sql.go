@@ -55,6 +55,30 @@ func (q *Queries) DeactivateTicker(ctx context.Context, db DBTX, id pgtype.UUID)
 	return &i, err
 }
 
+const deleteDelayedEvents = `-- name: DeleteDelayedEvents :exec
+DELETE FROM
+    "DelayedEvent"
+WHERE
+    "eventId" = ANY($1::uuid[])
+`
+
+func (q *Queries) DeleteDelayedEvents(ctx context.Context, db DBTX, eventids []pgtype.UUID) error {
+	_, err := db.Exec(ctx, deleteDelayedEvents, eventids)
+	return err
+}
+
+const deleteStepRunTimers = `-- name: DeleteStepRunTimers :exec
+DELETE FROM
+    "StepRunTimer"
+WHERE
+    "stepRunId" = ANY($1::uuid[])
+`
+
+func (q *Queries) DeleteStepRunTimers(ctx context.Context, db DBTX, steprunids []pgtype.UUID) error {
+	_, err := db.Exec(ctx, deleteStepRunTimers, steprunids)
+	return err
+}
+
 const listActiveTickers = `-- name: ListActiveTickers :many
 SELECT
     tickers.id, tickers."createdAt", tickers."updatedAt", tickers."lastHeartbeatAt", tickers."isActive"
@@ -227,24 +251,27 @@ FROM
     active_cron_schedules
 WHERE
     cronSchedules."parentId" = active_cron_schedules."parentId"
-RETURNING cronschedules."parentId", cronschedules.cron, cronschedules."tickerId", cronschedules.input, cronschedules.enabled, cronschedules."additionalMetadata", cronschedules."createdAt", cronschedules."deletedAt", cronschedules."updatedAt", cronschedules.name, cronschedules.id, cronschedules.method, active_cron_schedules."workflowVersionId", active_cron_schedules."tenantId"
+RETURNING cronschedules."parentId", cronschedules.cron, cronschedules."tickerId", cronschedules.input, cronschedules.enabled, cronschedules."additionalMetadata", cronschedules."createdAt", cronschedules."deletedAt", cronschedules."updatedAt", cronschedules.name, cronschedules.id, cronschedules.method, cronschedules.timezone, cronschedules."jitterSeconds", cronschedules."exclusionCalendarId", active_cron_schedules."workflowVersionId", active_cron_schedules."tenantId"
 `
 
 type PollCronSchedulesRow struct {
-	ParentId           pgtype.UUID                   `json:"parentId"`
-	Cron               string                        `json:"cron"`
-	TickerId           pgtype.UUID                   `json:"tickerId"`
-	Input              []byte                        `json:"input"`
-	Enabled            bool                          `json:"enabled"`
-	AdditionalMetadata []byte                        `json:"additionalMetadata"`
-	CreatedAt          pgtype.Timestamp              `json:"createdAt"`
-	DeletedAt          pgtype.Timestamp              `json:"deletedAt"`
-	UpdatedAt          pgtype.Timestamp              `json:"updatedAt"`
-	Name               pgtype.Text                   `json:"name"`
-	ID                 pgtype.UUID                   `json:"id"`
-	Method             WorkflowTriggerCronRefMethods `json:"method"`
-	WorkflowVersionId  pgtype.UUID                   `json:"workflowVersionId"`
-	TenantId           pgtype.UUID                   `json:"tenantId"`
+	ParentId            pgtype.UUID                   `json:"parentId"`
+	Cron                string                        `json:"cron"`
+	TickerId            pgtype.UUID                   `json:"tickerId"`
+	Input               []byte                        `json:"input"`
+	Enabled             bool                          `json:"enabled"`
+	AdditionalMetadata  []byte                        `json:"additionalMetadata"`
+	CreatedAt           pgtype.Timestamp              `json:"createdAt"`
+	DeletedAt           pgtype.Timestamp              `json:"deletedAt"`
+	UpdatedAt           pgtype.Timestamp              `json:"updatedAt"`
+	Name                pgtype.Text                   `json:"name"`
+	ID                  pgtype.UUID                   `json:"id"`
+	Method              WorkflowTriggerCronRefMethods `json:"method"`
+	Timezone            string                        `json:"timezone"`
+	JitterSeconds       int32                         `json:"jitterSeconds"`
+	ExclusionCalendarId pgtype.UUID                   `json:"exclusionCalendarId"`
+	WorkflowVersionId   pgtype.UUID                   `json:"workflowVersionId"`
+	TenantId            pgtype.UUID                   `json:"tenantId"`
 }
 
 func (q *Queries) PollCronSchedules(ctx context.Context, db DBTX, tickerid pgtype.UUID) ([]*PollCronSchedulesRow, error) {
@@ -269,6 +296,9 @@ func (q *Queries) PollCronSchedules(ctx context.Context, db DBTX, tickerid pgtyp
 			&i.Name,
 			&i.ID,
 			&i.Method,
+			&i.Timezone,
+			&i.JitterSeconds,
+			&i.ExclusionCalendarId,
 			&i.WorkflowVersionId,
 			&i.TenantId,
 		); err != nil {
@@ -282,6 +312,64 @@ func (q *Queries) PollCronSchedules(ctx context.Context, db DBTX, tickerid pgtyp
 	return items, nil
 }
 
+const pollDelayedEvents = `-- name: PollDelayedEvents :many
+WITH due_events AS (
+    SELECT
+        d."eventId"
+    FROM
+        "DelayedEvent" d
+    WHERE
+        d."deliverAfter" <= NOW()
+        AND (
+            NOT EXISTS (
+                SELECT 1 FROM "Ticker" WHERE "id" = d."tickerId" AND "isActive" = true AND "lastHeartbeatAt" >= NOW() - INTERVAL '10 seconds'
+            )
+            OR d."tickerId" IS NULL
+        )
+    ORDER BY
+        d."deliverAfter" ASC
+    LIMIT 1000
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE
+    "DelayedEvent" d
+SET
+    "tickerId" = $1::uuid
+FROM
+    due_events de
+WHERE
+    d."eventId" = de."eventId"
+RETURNING d."eventId", d."tenantId", d."deliverAfter", d."idempotencyKey", d."routedWorkflowIds", d."tickerId"
+`
+
+// Claims the delayed events which are due.
+func (q *Queries) PollDelayedEvents(ctx context.Context, db DBTX, tickerid pgtype.UUID) ([]*DelayedEvent, error) {
+	rows, err := db.Query(ctx, pollDelayedEvents, tickerid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*DelayedEvent
+	for rows.Next() {
+		var i DelayedEvent
+		if err := rows.Scan(
+			&i.EventId,
+			&i.TenantId,
+			&i.DeliverAfter,
+			&i.IdempotencyKey,
+			&i.RoutedWorkflowIds,
+			&i.TickerId,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const pollExpiringTokens = `-- name: PollExpiringTokens :many
 WITH expiring_tokens AS (
     SELECT
@@ -290,6 +378,8 @@ WITH expiring_tokens AS (
         "APIToken" as t0
     WHERE
         t0."revoked" = false
+        -- rotated tokens expire at the end of the grace period of the rotation on purpose
+        AND t0."rotatedAt" IS NULL
         AND t0."expiresAt" <= NOW() + INTERVAL '7 days'
         AND t0."expiresAt" >= NOW()
         AND (
@@ -530,6 +620,102 @@ func (q *Queries) PollScheduledWorkflows(ctx context.Context, db DBTX, tickerid
 	return items, nil
 }
 
+const pollStepRunTimers = `-- name: PollStepRunTimers :many
+WITH due_timers AS (
+    SELECT
+        t."stepRunId"
+    FROM
+        "StepRunTimer" t
+    WHERE
+        t."wakeAt" <= NOW()
+        AND (
+            NOT EXISTS (
+                SELECT 1 FROM "Ticker" WHERE "id" = t."tickerId" AND "isActive" = true AND "lastHeartbeatAt" >= NOW() - INTERVAL '10 seconds'
+            )
+            OR t."tickerId" IS NULL
+        )
+    ORDER BY
+        t."wakeAt" ASC
+    LIMIT 1000
+    FOR UPDATE SKIP LOCKED
+), stale_timers AS (
+    DELETE FROM
+        "StepRunTimer" t
+    USING
+        due_timers dt
+    WHERE
+        t."stepRunId" = dt."stepRunId"
+        AND NOT EXISTS (
+            SELECT 1
+            FROM "StepRun" sr
+            WHERE
+                sr."id" = dt."stepRunId"
+                AND sr."status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED', 'CANCELLING')
+        )
+    RETURNING t."stepRunId"
+)
+UPDATE
+    "StepRunTimer" t
+SET
+    "tickerId" = $1::uuid
+FROM
+    due_timers dt
+JOIN
+    "StepRun" sr ON sr."id" = dt."stepRunId"
+JOIN
+    "JobRun" jr ON jr."id" = sr."jobRunId"
+JOIN
+    "Step" s ON s."id" = sr."stepId"
+WHERE
+    t."stepRunId" = dt."stepRunId"
+    AND sr."status" NOT IN ('SUCCEEDED', 'FAILED', 'CANCELLED', 'CANCELLING')
+RETURNING
+    t."stepRunId",
+    t."tenantId",
+    t."wakeAt",
+    jr."workflowRunId",
+    (s."waitForEvent" IS NOT NULL)::boolean AS "isEventWait",
+    s."actionId"
+`
+
+type PollStepRunTimersRow struct {
+	StepRunId     pgtype.UUID      `json:"stepRunId"`
+	TenantId      pgtype.UUID      `json:"tenantId"`
+	WakeAt        pgtype.Timestamp `json:"wakeAt"`
+	WorkflowRunId pgtype.UUID      `json:"workflowRunId"`
+	IsEventWait   bool             `json:"isEventWait"`
+	ActionId      string           `json:"actionId"`
+}
+
+// Claims the timers of sleeping step runs which are due. Timers of step runs which have already reached a final
+// state (for example, because they were cancelled) are deleted.
+func (q *Queries) PollStepRunTimers(ctx context.Context, db DBTX, tickerid pgtype.UUID) ([]*PollStepRunTimersRow, error) {
+	rows, err := db.Query(ctx, pollStepRunTimers, tickerid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*PollStepRunTimersRow
+	for rows.Next() {
+		var i PollStepRunTimersRow
+		if err := rows.Scan(
+			&i.StepRunId,
+			&i.TenantId,
+			&i.WakeAt,
+			&i.WorkflowRunId,
+			&i.IsEventWait,
+			&i.ActionId,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const pollTenantAlerts = `-- name: PollTenantAlerts :many
 WITH active_tenant_alerts AS (
     SELECT
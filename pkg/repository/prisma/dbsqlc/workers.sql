@@ -8,7 +8,8 @@ SELECT
         FROM "SemaphoreQueueItem" sqi
         WHERE
             sqi."tenantId" = workers."tenantId" AND
-            sqi."workerId" = workers."id"
+            sqi."workerId" = workers."id" AND
+            sqi."slotType" = 'default'
     ) AS "remainingSlots"
 FROM
     "Worker" workers
@@ -50,7 +51,8 @@ SELECT
         FROM "SemaphoreQueueItem" sqi
         WHERE
             sqi."tenantId" = w."tenantId" AND
-            sqi."workerId" = w."id"
+            sqi."workerId" = w."id" AND
+            sqi."slotType" = 'default'
     ) AS "remainingSlots"
 FROM
     "Worker" w
@@ -136,7 +138,9 @@ INSERT INTO "Worker" (
     "language",
     "languageVersion",
     "os",
-    "runtimeExtra"
+    "runtimeExtra",
+    "region",
+    "pool"
 ) VALUES (
     gen_random_uuid(),
     CURRENT_TIMESTAMP,
@@ -151,7 +155,9 @@ INSERT INTO "Worker" (
     sqlc.narg('language')::"WorkerSDKS",
     sqlc.narg('languageVersion')::text,
     sqlc.narg('os')::text,
-    sqlc.narg('runtimeExtra')::text
+    sqlc.narg('runtimeExtra')::text,
+    sqlc.narg('region')::text,
+    sqlc.narg('pool')::text
 ) RETURNING *;
 
 -- name: GetWorkerByWebhookId :one
@@ -163,15 +169,21 @@ WHERE
     "webhookId" = @webhookId::uuid
     AND "tenantId" = @tenantId::uuid;
 
--- name: UpdateWorkerHeartbeat :one
+-- name: UpdateWorkerHeartbeats :batchexec
+-- The health columns are only written when the heartbeat carries a health report, so they keep the last report of
+-- workers which don't send one with every heartbeat.
 UPDATE
     "Worker"
 SET
     "updatedAt" = CURRENT_TIMESTAMP,
-    "lastHeartbeatAt" = sqlc.narg('lastHeartbeatAt')::timestamp
+    "lastHeartbeatAt" = sqlc.narg('lastHeartbeatAt')::timestamp,
+    "cpuUtilization" = CASE WHEN @hasHealth::boolean THEN sqlc.narg('cpuUtilization')::float8 ELSE "cpuUtilization" END,
+    "memoryUtilization" = CASE WHEN @hasHealth::boolean THEN sqlc.narg('memoryUtilization')::float8 ELSE "memoryUtilization" END,
+    "activeSlots" = CASE WHEN @hasHealth::boolean THEN sqlc.narg('activeSlots')::int ELSE "activeSlots" END,
+    "gauges" = CASE WHEN @hasHealth::boolean THEN sqlc.narg('gauges')::jsonb ELSE "gauges" END,
+    "lastHealthReportAt" = CASE WHEN @hasHealth::boolean THEN sqlc.narg('lastHeartbeatAt')::timestamp ELSE "lastHealthReportAt" END
 WHERE
-    "id" = @id::uuid
-RETURNING *;
+    "id" = @id::uuid;
 
 -- name: UpdateWorker :one
 UPDATE
@@ -182,7 +194,8 @@ SET
     "maxRuns" = coalesce(sqlc.narg('maxRuns')::int, "maxRuns"),
     "lastHeartbeatAt" = coalesce(sqlc.narg('lastHeartbeatAt')::timestamp, "lastHeartbeatAt"),
     "isActive" = coalesce(sqlc.narg('isActive')::boolean, "isActive"),
-    "isPaused" = coalesce(sqlc.narg('isPaused')::boolean, "isPaused")
+    "isPaused" = coalesce(sqlc.narg('isPaused')::boolean, "isPaused"),
+    "isDraining" = coalesce(sqlc.narg('isDraining')::boolean, "isDraining")
 WHERE
     "id" = @id::uuid
 RETURNING *;
@@ -290,6 +303,25 @@ SET
     "strValue" = sqlc.narg('strValue')::text
 RETURNING *;
 
+-- name: UpsertWorkerSlotPools :exec
+INSERT INTO "WorkerSlotPool" (
+    "workerId",
+    "slotType",
+    "maxRuns"
+)
+SELECT
+    @workerId::uuid,
+    input."slotType",
+    input."maxRuns"
+FROM (
+    SELECT
+        unnest(@slotTypes::text[]) AS "slotType",
+        unnest(@maxRuns::integer[]) AS "maxRuns"
+    ) AS input
+ON CONFLICT ("workerId", "slotType") DO UPDATE
+SET
+    "maxRuns" = EXCLUDED."maxRuns";
+
 -- name: DeleteOldWorkers :one
 WITH for_delete AS (
     SELECT
@@ -366,3 +398,35 @@ SELECT
     "workerId"
 FROM "WorkerLabel" wl
 WHERE wl."workerId" = ANY(@workerIds::uuid[]);
+
+-- name: ListWorkerPools :many
+SELECT
+    w."pool"::text AS "pool",
+    COUNT(*) AS "workers",
+    SUM(w."maxRuns")::bigint AS "slots",
+    COALESCE(SUM(used."count"), 0)::bigint AS "usedSlots"
+FROM
+    "Worker" w
+LEFT JOIN (
+    SELECT
+        sqi."workerId",
+        COUNT(*) AS "count"
+    FROM
+        "SemaphoreQueueItem" sqi
+    WHERE
+        sqi."tenantId" = @tenantId::uuid
+        AND sqi."slotType" = 'default'
+    GROUP BY
+        sqi."workerId"
+) used ON used."workerId" = w."id"
+WHERE
+    w."tenantId" = @tenantId::uuid
+    AND w."pool" IS NOT NULL
+    AND w."dispatcherId" IS NOT NULL
+    AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
+    AND w."isActive" = true
+    AND w."isPaused" = false
+GROUP BY
+    w."pool"
+ORDER BY
+    w."pool";
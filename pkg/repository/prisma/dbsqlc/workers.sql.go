@@ -26,7 +26,9 @@ INSERT INTO "Worker" (
     "language",
     "languageVersion",
     "os",
-    "runtimeExtra"
+    "runtimeExtra",
+    "region",
+    "pool"
 ) VALUES (
     gen_random_uuid(),
     CURRENT_TIMESTAMP,
@@ -41,8 +43,10 @@ INSERT INTO "Worker" (
     $8::"WorkerSDKS",
     $9::text,
     $10::text,
-    $11::text
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion"
+    $11::text,
+    $12::text,
+    $13::text
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion", "isDraining", region, "cpuUtilization", "memoryUtilization", "activeSlots", gauges, "lastHealthReportAt", pool
 `
 
 type CreateWorkerParams struct {
@@ -57,6 +61,8 @@ type CreateWorkerParams struct {
 	LanguageVersion pgtype.Text    `json:"languageVersion"`
 	Os              pgtype.Text    `json:"os"`
 	RuntimeExtra    pgtype.Text    `json:"runtimeExtra"`
+	Region          pgtype.Text    `json:"region"`
+	Pool            pgtype.Text    `json:"pool"`
 }
 
 func (q *Queries) CreateWorker(ctx context.Context, db DBTX, arg CreateWorkerParams) (*Worker, error) {
@@ -72,6 +78,8 @@ func (q *Queries) CreateWorker(ctx context.Context, db DBTX, arg CreateWorkerPar
 		arg.LanguageVersion,
 		arg.Os,
 		arg.RuntimeExtra,
+		arg.Region,
+		arg.Pool,
 	)
 	var i Worker
 	err := row.Scan(
@@ -94,6 +102,14 @@ func (q *Queries) CreateWorker(ctx context.Context, db DBTX, arg CreateWorkerPar
 		&i.Os,
 		&i.RuntimeExtra,
 		&i.SdkVersion,
+		&i.IsDraining,
+		&i.Region,
+		&i.CpuUtilization,
+		&i.MemoryUtilization,
+		&i.ActiveSlots,
+		&i.Gauges,
+		&i.LastHealthReportAt,
+		&i.Pool,
 	)
 	return &i, err
 }
@@ -186,7 +202,7 @@ DELETE FROM
   "Worker"
 WHERE
   "id" = $1::uuid
-RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion"
+RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion", "isDraining", region, "cpuUtilization", "memoryUtilization", "activeSlots", gauges, "lastHealthReportAt", pool
 `
 
 func (q *Queries) DeleteWorker(ctx context.Context, db DBTX, id pgtype.UUID) (*Worker, error) {
@@ -212,6 +228,14 @@ func (q *Queries) DeleteWorker(ctx context.Context, db DBTX, id pgtype.UUID) (*W
 		&i.Os,
 		&i.RuntimeExtra,
 		&i.SdkVersion,
+		&i.IsDraining,
+		&i.Region,
+		&i.CpuUtilization,
+		&i.MemoryUtilization,
+		&i.ActiveSlots,
+		&i.Gauges,
+		&i.LastHealthReportAt,
+		&i.Pool,
 	)
 	return &i, err
 }
@@ -254,14 +278,15 @@ func (q *Queries) GetWorkerActionsByWorkerId(ctx context.Context, db DBTX, arg G
 
 const getWorkerById = `-- name: GetWorkerById :one
 SELECT
-    w.id, w."createdAt", w."updatedAt", w."deletedAt", w."tenantId", w."lastHeartbeatAt", w.name, w."dispatcherId", w."maxRuns", w."isActive", w."lastListenerEstablished", w."isPaused", w.type, w."webhookId", w.language, w."languageVersion", w.os, w."runtimeExtra", w."sdkVersion",
+    w.id, w."createdAt", w."updatedAt", w."deletedAt", w."tenantId", w."lastHeartbeatAt", w.name, w."dispatcherId", w."maxRuns", w."isActive", w."lastListenerEstablished", w."isPaused", w.type, w."webhookId", w.language, w."languageVersion", w.os, w."runtimeExtra", w."sdkVersion", w."isDraining", w.region, w."cpuUtilization", w."memoryUtilization", w."activeSlots", w.gauges, w."lastHealthReportAt", w.pool,
     ww."url" AS "webhookUrl",
     w."maxRuns" - (
         SELECT COUNT(*)
         FROM "SemaphoreQueueItem" sqi
         WHERE
             sqi."tenantId" = w."tenantId" AND
-            sqi."workerId" = w."id"
+            sqi."workerId" = w."id" AND
+            sqi."slotType" = 'default'
     ) AS "remainingSlots"
 FROM
     "Worker" w
@@ -300,6 +325,14 @@ func (q *Queries) GetWorkerById(ctx context.Context, db DBTX, id pgtype.UUID) (*
 		&i.Worker.Os,
 		&i.Worker.RuntimeExtra,
 		&i.Worker.SdkVersion,
+		&i.Worker.IsDraining,
+		&i.Worker.Region,
+		&i.Worker.CpuUtilization,
+		&i.Worker.MemoryUtilization,
+		&i.Worker.ActiveSlots,
+		&i.Worker.Gauges,
+		&i.Worker.LastHealthReportAt,
+		&i.Worker.Pool,
 		&i.WebhookUrl,
 		&i.RemainingSlots,
 	)
@@ -308,7 +341,7 @@ func (q *Queries) GetWorkerById(ctx context.Context, db DBTX, id pgtype.UUID) (*
 
 const getWorkerByWebhookId = `-- name: GetWorkerByWebhookId :one
 SELECT
-    id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion"
+    id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion", "isDraining", region, "cpuUtilization", "memoryUtilization", "activeSlots", gauges, "lastHealthReportAt", pool
 FROM
     "Worker"
 WHERE
@@ -344,6 +377,14 @@ func (q *Queries) GetWorkerByWebhookId(ctx context.Context, db DBTX, arg GetWork
 		&i.Os,
 		&i.RuntimeExtra,
 		&i.SdkVersion,
+		&i.IsDraining,
+		&i.Region,
+		&i.CpuUtilization,
+		&i.MemoryUtilization,
+		&i.ActiveSlots,
+		&i.Gauges,
+		&i.LastHealthReportAt,
+		&i.Pool,
 	)
 	return &i, err
 }
@@ -685,9 +726,74 @@ func (q *Queries) ListWorkerLabels(ctx context.Context, db DBTX, workerid pgtype
 	return items, nil
 }
 
+const listWorkerPools = `-- name: ListWorkerPools :many
+SELECT
+    w."pool"::text AS "pool",
+    COUNT(*) AS "workers",
+    SUM(w."maxRuns")::bigint AS "slots",
+    COALESCE(SUM(used."count"), 0)::bigint AS "usedSlots"
+FROM
+    "Worker" w
+LEFT JOIN (
+    SELECT
+        sqi."workerId",
+        COUNT(*) AS "count"
+    FROM
+        "SemaphoreQueueItem" sqi
+    WHERE
+        sqi."tenantId" = $1::uuid
+        AND sqi."slotType" = 'default'
+    GROUP BY
+        sqi."workerId"
+) used ON used."workerId" = w."id"
+WHERE
+    w."tenantId" = $1::uuid
+    AND w."pool" IS NOT NULL
+    AND w."dispatcherId" IS NOT NULL
+    AND w."lastHeartbeatAt" > NOW() - INTERVAL '5 seconds'
+    AND w."isActive" = true
+    AND w."isPaused" = false
+GROUP BY
+    w."pool"
+ORDER BY
+    w."pool"
+`
+
+type ListWorkerPoolsRow struct {
+	Pool      string `json:"pool"`
+	Workers   int64  `json:"workers"`
+	Slots     int64  `json:"slots"`
+	UsedSlots int64  `json:"usedSlots"`
+}
+
+func (q *Queries) ListWorkerPools(ctx context.Context, db DBTX, tenantid pgtype.UUID) ([]*ListWorkerPoolsRow, error) {
+	rows, err := db.Query(ctx, listWorkerPools, tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkerPoolsRow
+	for rows.Next() {
+		var i ListWorkerPoolsRow
+		if err := rows.Scan(
+			&i.Pool,
+			&i.Workers,
+			&i.Slots,
+			&i.UsedSlots,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWorkersWithSlotCount = `-- name: ListWorkersWithSlotCount :many
 SELECT
-    workers.id, workers."createdAt", workers."updatedAt", workers."deletedAt", workers."tenantId", workers."lastHeartbeatAt", workers.name, workers."dispatcherId", workers."maxRuns", workers."isActive", workers."lastListenerEstablished", workers."isPaused", workers.type, workers."webhookId", workers.language, workers."languageVersion", workers.os, workers."runtimeExtra", workers."sdkVersion",
+    workers.id, workers."createdAt", workers."updatedAt", workers."deletedAt", workers."tenantId", workers."lastHeartbeatAt", workers.name, workers."dispatcherId", workers."maxRuns", workers."isActive", workers."lastListenerEstablished", workers."isPaused", workers.type, workers."webhookId", workers.language, workers."languageVersion", workers.os, workers."runtimeExtra", workers."sdkVersion", workers."isDraining", workers.region, workers."cpuUtilization", workers."memoryUtilization", workers."activeSlots", workers.gauges, workers."lastHealthReportAt", workers.pool,
     ww."url" AS "webhookUrl",
     ww."id" AS "webhookId",
     workers."maxRuns" - (
@@ -695,7 +801,8 @@ SELECT
         FROM "SemaphoreQueueItem" sqi
         WHERE
             sqi."tenantId" = workers."tenantId" AND
-            sqi."workerId" = workers."id"
+            sqi."workerId" = workers."id" AND
+            sqi."slotType" = 'default'
     ) AS "remainingSlots"
 FROM
     "Worker" workers
@@ -777,6 +884,14 @@ func (q *Queries) ListWorkersWithSlotCount(ctx context.Context, db DBTX, arg Lis
 			&i.Worker.Os,
 			&i.Worker.RuntimeExtra,
 			&i.Worker.SdkVersion,
+			&i.Worker.IsDraining,
+			&i.Worker.Region,
+			&i.Worker.CpuUtilization,
+			&i.Worker.MemoryUtilization,
+			&i.Worker.ActiveSlots,
+			&i.Worker.Gauges,
+			&i.Worker.LastHealthReportAt,
+			&i.Worker.Pool,
 			&i.WebhookUrl,
 			&i.WebhookId,
 			&i.RemainingSlots,
@@ -800,10 +915,11 @@ SET
     "maxRuns" = coalesce($2::int, "maxRuns"),
     "lastHeartbeatAt" = coalesce($3::timestamp, "lastHeartbeatAt"),
     "isActive" = coalesce($4::boolean, "isActive"),
-    "isPaused" = coalesce($5::boolean, "isPaused")
+    "isPaused" = coalesce($5::boolean, "isPaused"),
+    "isDraining" = coalesce($6::boolean, "isDraining")
 WHERE
-    "id" = $6::uuid
-RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion"
+    "id" = $7::uuid
+RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion", "isDraining", region, "cpuUtilization", "memoryUtilization", "activeSlots", gauges, "lastHealthReportAt", pool
 `
 
 type UpdateWorkerParams struct {
@@ -812,6 +928,7 @@ type UpdateWorkerParams struct {
 	LastHeartbeatAt pgtype.Timestamp `json:"lastHeartbeatAt"`
 	IsActive        pgtype.Bool      `json:"isActive"`
 	IsPaused        pgtype.Bool      `json:"isPaused"`
+	IsDraining      pgtype.Bool      `json:"isDraining"`
 	ID              pgtype.UUID      `json:"id"`
 }
 
@@ -822,6 +939,7 @@ func (q *Queries) UpdateWorker(ctx context.Context, db DBTX, arg UpdateWorkerPar
 		arg.LastHeartbeatAt,
 		arg.IsActive,
 		arg.IsPaused,
+		arg.IsDraining,
 		arg.ID,
 	)
 	var i Worker
@@ -845,6 +963,14 @@ func (q *Queries) UpdateWorker(ctx context.Context, db DBTX, arg UpdateWorkerPar
 		&i.Os,
 		&i.RuntimeExtra,
 		&i.SdkVersion,
+		&i.IsDraining,
+		&i.Region,
+		&i.CpuUtilization,
+		&i.MemoryUtilization,
+		&i.ActiveSlots,
+		&i.Gauges,
+		&i.LastHealthReportAt,
+		&i.Pool,
 	)
 	return &i, err
 }
@@ -860,7 +986,7 @@ WHERE
         "lastListenerEstablished" IS NULL
         OR "lastListenerEstablished" <= $2::timestamp
         )
-RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion"
+RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion", "isDraining", region, "cpuUtilization", "memoryUtilization", "activeSlots", gauges, "lastHealthReportAt", pool
 `
 
 type UpdateWorkerActiveStatusParams struct {
@@ -892,49 +1018,14 @@ func (q *Queries) UpdateWorkerActiveStatus(ctx context.Context, db DBTX, arg Upd
 		&i.Os,
 		&i.RuntimeExtra,
 		&i.SdkVersion,
-	)
-	return &i, err
-}
-
-const updateWorkerHeartbeat = `-- name: UpdateWorkerHeartbeat :one
-UPDATE
-    "Worker"
-SET
-    "updatedAt" = CURRENT_TIMESTAMP,
-    "lastHeartbeatAt" = $1::timestamp
-WHERE
-    "id" = $2::uuid
-RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion"
-`
-
-type UpdateWorkerHeartbeatParams struct {
-	LastHeartbeatAt pgtype.Timestamp `json:"lastHeartbeatAt"`
-	ID              pgtype.UUID      `json:"id"`
-}
-
-func (q *Queries) UpdateWorkerHeartbeat(ctx context.Context, db DBTX, arg UpdateWorkerHeartbeatParams) (*Worker, error) {
-	row := db.QueryRow(ctx, updateWorkerHeartbeat, arg.LastHeartbeatAt, arg.ID)
-	var i Worker
-	err := row.Scan(
-		&i.ID,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.DeletedAt,
-		&i.TenantId,
-		&i.LastHeartbeatAt,
-		&i.Name,
-		&i.DispatcherId,
-		&i.MaxRuns,
-		&i.IsActive,
-		&i.LastListenerEstablished,
-		&i.IsPaused,
-		&i.Type,
-		&i.WebhookId,
-		&i.Language,
-		&i.LanguageVersion,
-		&i.Os,
-		&i.RuntimeExtra,
-		&i.SdkVersion,
+		&i.IsDraining,
+		&i.Region,
+		&i.CpuUtilization,
+		&i.MemoryUtilization,
+		&i.ActiveSlots,
+		&i.Gauges,
+		&i.LastHealthReportAt,
+		&i.Pool,
 	)
 	return &i, err
 }
@@ -945,7 +1036,7 @@ SET "isActive" = $1::boolean
 WHERE
   "tenantId" = $2::uuid AND
   "webhookId" = $3::uuid
-RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion"
+RETURNING id, "createdAt", "updatedAt", "deletedAt", "tenantId", "lastHeartbeatAt", name, "dispatcherId", "maxRuns", "isActive", "lastListenerEstablished", "isPaused", type, "webhookId", language, "languageVersion", os, "runtimeExtra", "sdkVersion", "isDraining", region, "cpuUtilization", "memoryUtilization", "activeSlots", gauges, "lastHealthReportAt", pool
 `
 
 type UpdateWorkersByWebhookIdParams struct {
@@ -983,6 +1074,14 @@ func (q *Queries) UpdateWorkersByWebhookId(ctx context.Context, db DBTX, arg Upd
 			&i.Os,
 			&i.RuntimeExtra,
 			&i.SdkVersion,
+			&i.IsDraining,
+			&i.Region,
+			&i.CpuUtilization,
+			&i.MemoryUtilization,
+			&i.ActiveSlots,
+			&i.Gauges,
+			&i.LastHealthReportAt,
+			&i.Pool,
 		); err != nil {
 			return nil, err
 		}
@@ -1086,3 +1185,34 @@ func (q *Queries) UpsertWorkerLabel(ctx context.Context, db DBTX, arg UpsertWork
 	)
 	return &i, err
 }
+
+const upsertWorkerSlotPools = `-- name: UpsertWorkerSlotPools :exec
+INSERT INTO "WorkerSlotPool" (
+    "workerId",
+    "slotType",
+    "maxRuns"
+)
+SELECT
+    $1::uuid,
+    input."slotType",
+    input."maxRuns"
+FROM (
+    SELECT
+        unnest($2::text[]) AS "slotType",
+        unnest($3::integer[]) AS "maxRuns"
+    ) AS input
+ON CONFLICT ("workerId", "slotType") DO UPDATE
+SET
+    "maxRuns" = EXCLUDED."maxRuns"
+`
+
+type UpsertWorkerSlotPoolsParams struct {
+	Workerid  pgtype.UUID `json:"workerid"`
+	Slottypes []string    `json:"slottypes"`
+	Maxruns   []int32     `json:"maxruns"`
+}
+
+func (q *Queries) UpsertWorkerSlotPools(ctx context.Context, db DBTX, arg UpsertWorkerSlotPoolsParams) error {
+	_, err := db.Exec(ctx, upsertWorkerSlotPools, arg.Workerid, arg.Slottypes, arg.Maxruns)
+	return err
+}
@@ -0,0 +1,39 @@
+-- name: UpsertWorkflowRollout :one
+INSERT INTO "WorkflowRollout" (
+    "workflowId",
+    "stableVersionId",
+    "canaryPercentage"
+) VALUES (
+    @workflowId::uuid,
+    @stableVersionId::uuid,
+    @canaryPercentage::int
+) ON CONFLICT ("workflowId") DO UPDATE
+SET
+    "updatedAt" = CURRENT_TIMESTAMP,
+    "stableVersionId" = EXCLUDED."stableVersionId",
+    "canaryPercentage" = EXCLUDED."canaryPercentage"
+RETURNING *;
+
+-- name: GetWorkflowRollout :one
+SELECT
+    *
+FROM
+    "WorkflowRollout"
+WHERE
+    "workflowId" = @workflowId::uuid;
+
+-- name: ListWorkflowRolloutsForWorkflows :many
+SELECT
+    *
+FROM
+    "WorkflowRollout"
+WHERE
+    "workflowId" = ANY(@workflowIds::uuid[]);
+
+-- name: DeleteWorkflowRollout :one
+-- Deletes the rollout of a workflow. New runs of the workflow are triggered on its latest version again.
+DELETE FROM
+    "WorkflowRollout"
+WHERE
+    "workflowId" = @workflowId::uuid
+RETURNING *;
@@ -0,0 +1,118 @@
+-- name: ListWorkflowRunsToArchive :many
+-- Lists the finished workflow runs of a tenant which finished before the cutoff and haven't been archived, oldest
+-- first.
+SELECT
+    wr."id"
+FROM
+    "WorkflowRun" wr
+WHERE
+    wr."tenantId" = @tenantId::uuid
+    AND wr."status" IN ('SUCCEEDED', 'FAILED')
+    AND wr."finishedAt" < @finishedBefore::timestamp
+    AND wr."deletedAt" IS NULL
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "WorkflowRunArchive" a
+        WHERE a."workflowRunId" = wr."id"
+    )
+ORDER BY
+    wr."finishedAt" ASC
+LIMIT @batchSize::integer;
+
+-- name: LockWorkflowRunForArchive :one
+-- Locks a finished workflow run which hasn't been archived, and returns its payloads. Returns no rows if the run is
+-- locked by another transaction, isn't finished or was archived.
+SELECT
+    wr."id",
+    wr."output",
+    -- the input of the workflow run is stored in the lookup data of its job runs
+    (
+        SELECT
+            jld."data" -> 'input'
+        FROM
+            "JobRun" jr
+        JOIN
+            "JobRunLookupData" jld ON jld."jobRunId" = jr."id"
+        WHERE
+            jr."workflowRunId" = wr."id"
+            AND jld."data" ? 'input'
+        LIMIT 1
+    )::jsonb AS "input"
+FROM
+    "WorkflowRun" wr
+WHERE
+    wr."id" = @workflowRunId::uuid
+    AND wr."tenantId" = @tenantId::uuid
+    AND wr."status" IN ('SUCCEEDED', 'FAILED')
+    AND wr."deletedAt" IS NULL
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "WorkflowRunArchive" a
+        WHERE a."workflowRunId" = wr."id"
+    )
+FOR UPDATE OF wr SKIP LOCKED;
+
+-- name: CreateWorkflowRunArchive :one
+-- Deletes the job runs, step runs, step run events and payloads of a workflow run which were written to the archive,
+-- and stores where the archive is. The workflow run and its trigger are kept, so the run is still listed.
+WITH job_runs AS (
+    SELECT
+        "id"
+    FROM
+        "JobRun"
+    WHERE
+        "workflowRunId" = @workflowRunId::uuid
+        AND "tenantId" = @tenantId::uuid
+), deleted_step_runs AS (
+    DELETE FROM
+        "StepRun"
+    WHERE
+        "jobRunId" IN (SELECT "id" FROM job_runs)
+        AND "tenantId" = @tenantId::uuid
+    RETURNING "id"
+), deleted_result_archives AS (
+    DELETE FROM
+        "StepRunResultArchive"
+    WHERE
+        "stepRunId" IN (SELECT "id" FROM deleted_step_runs)
+), deleted_events AS (
+    DELETE FROM
+        "StepRunEvent"
+    WHERE
+        "workflowRunId" = @workflowRunId::uuid
+        OR "stepRunId" IN (SELECT "id" FROM deleted_step_runs)
+), deleted_job_runs AS (
+    -- the lookup data of the job runs is deleted by the foreign keys
+    DELETE FROM
+        "JobRun"
+    WHERE
+        "id" IN (SELECT "id" FROM job_runs)
+), cleared_workflow_runs AS (
+    UPDATE
+        "WorkflowRun"
+    SET
+        "output" = NULL
+    WHERE
+        "id" = @workflowRunId::uuid
+)
+INSERT INTO "WorkflowRunArchive" (
+    "workflowRunId",
+    "tenantId",
+    "key",
+    "size"
+) VALUES (
+    @workflowRunId::uuid,
+    @tenantId::uuid,
+    @key::text,
+    @size::integer
+)
+RETURNING *;
+
+-- name: GetWorkflowRunArchive :one
+SELECT
+    *
+FROM
+    "WorkflowRunArchive"
+WHERE
+    "workflowRunId" = @workflowRunId::uuid
+    AND "tenantId" = @tenantId::uuid;
@@ -0,0 +1,41 @@
+-- name: AppendWorkflowRunEventLog :one
+-- Stores an event of a workflow run. If the event is already stored, the sequence of the stored event is returned.
+INSERT INTO "WorkflowRunEventLog" (
+    "tenantId",
+    "workflowRunId",
+    "key",
+    "message"
+) VALUES (
+    @tenantId::uuid,
+    @workflowRunId::uuid,
+    @key::text,
+    @message::jsonb
+)
+ON CONFLICT ("workflowRunId", "key") DO UPDATE
+SET
+    "key" = EXCLUDED."key"
+RETURNING "id";
+
+-- name: ListWorkflowRunEventLogs :many
+SELECT
+    *
+FROM
+    "WorkflowRunEventLog"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "id" > @afterId::bigint
+    AND (
+        sqlc.narg('workflowRunIds')::uuid[] IS NULL
+        OR "workflowRunId" = ANY(sqlc.narg('workflowRunIds')::uuid[])
+    )
+ORDER BY
+    "id" ASC
+LIMIT
+    sqlc.arg('limit')::int;
+
+-- name: DeleteExpiredWorkflowRunEventLogs :execrows
+DELETE FROM
+    "WorkflowRunEventLog"
+WHERE
+    "tenantId" = @tenantId::uuid
+    AND "createdAt" < @createdBefore::timestamp;
@@ -75,6 +75,14 @@ WITH runs AS (
         (
             sqlc.narg('finishedBefore')::timestamp IS NULL OR
             runs."finishedAt" <= sqlc.narg('finishedBefore')::timestamp
+        ) AND
+        (
+            sqlc.narg('workflowIds')::uuid[] IS NULL OR
+            workflow."id" = ANY(sqlc.narg('workflowIds')::uuid[])
+        ) AND
+        (
+            sqlc.narg('eventKey')::text IS NULL OR
+            events."key" = sqlc.narg('eventKey')::text
         )
     ORDER BY
         case when @orderBy = 'createdAt ASC' THEN runs."createdAt" END ASC ,
@@ -230,6 +238,57 @@ WHERE
     (
         sqlc.narg('finishedBefore')::timestamp IS NULL OR
         runs."finishedAt" <= sqlc.narg('finishedBefore')::timestamp
+    ) AND
+    (
+        sqlc.narg('workflowIds')::uuid[] IS NULL OR
+        workflow."id" = ANY(sqlc.narg('workflowIds')::uuid[])
+    ) AND
+    (
+        sqlc.narg('eventKey')::text IS NULL OR
+        events."key" = sqlc.narg('eventKey')::text
+    ) AND
+    -- only return the runs after the cursor in the sort order. Runs with the same sort value are ordered by id, and
+    -- null start and finish times are sorted last in ascending order, like in the order by.
+    (
+        sqlc.narg('cursorId')::uuid IS NULL OR
+        (@orderBy = 'createdAt ASC' AND (
+            runs."createdAt" > sqlc.narg('cursorTime')::timestamp OR
+            (runs."createdAt" = sqlc.narg('cursorTime')::timestamp AND runs."id" > sqlc.narg('cursorId')::uuid)
+        )) OR
+        (@orderBy = 'createdAt DESC' AND (
+            runs."createdAt" < sqlc.narg('cursorTime')::timestamp OR
+            (runs."createdAt" = sqlc.narg('cursorTime')::timestamp AND runs."id" > sqlc.narg('cursorId')::uuid)
+        )) OR
+        (@orderBy = 'finishedAt ASC' AND (
+            runs."finishedAt" > sqlc.narg('cursorTime')::timestamp OR
+            (runs."finishedAt" IS NULL AND sqlc.narg('cursorTime')::timestamp IS NOT NULL) OR
+            (runs."finishedAt" IS NOT DISTINCT FROM sqlc.narg('cursorTime')::timestamp AND runs."id" > sqlc.narg('cursorId')::uuid)
+        )) OR
+        (@orderBy = 'finishedAt DESC' AND (
+            runs."finishedAt" < sqlc.narg('cursorTime')::timestamp OR
+            (runs."finishedAt" IS NOT NULL AND sqlc.narg('cursorTime')::timestamp IS NULL) OR
+            (runs."finishedAt" IS NOT DISTINCT FROM sqlc.narg('cursorTime')::timestamp AND runs."id" > sqlc.narg('cursorId')::uuid)
+        )) OR
+        (@orderBy = 'startedAt ASC' AND (
+            runs."startedAt" > sqlc.narg('cursorTime')::timestamp OR
+            (runs."startedAt" IS NULL AND sqlc.narg('cursorTime')::timestamp IS NOT NULL) OR
+            (runs."startedAt" IS NOT DISTINCT FROM sqlc.narg('cursorTime')::timestamp AND runs."id" > sqlc.narg('cursorId')::uuid)
+        )) OR
+        (@orderBy = 'startedAt DESC' AND (
+            runs."startedAt" < sqlc.narg('cursorTime')::timestamp OR
+            (runs."startedAt" IS NOT NULL AND sqlc.narg('cursorTime')::timestamp IS NULL) OR
+            (runs."startedAt" IS NOT DISTINCT FROM sqlc.narg('cursorTime')::timestamp AND runs."id" > sqlc.narg('cursorId')::uuid)
+        )) OR
+        (@orderBy = 'duration ASC' AND (
+            runs."duration" > sqlc.narg('cursorDuration')::bigint OR
+            (runs."duration" IS NOT NULL AND sqlc.narg('cursorDuration')::bigint IS NULL) OR
+            (runs."duration" IS NOT DISTINCT FROM sqlc.narg('cursorDuration')::bigint AND runs."id" > sqlc.narg('cursorId')::uuid)
+        )) OR
+        (@orderBy = 'duration DESC' AND (
+            runs."duration" < sqlc.narg('cursorDuration')::bigint OR
+            (runs."duration" IS NULL AND sqlc.narg('cursorDuration')::bigint IS NOT NULL) OR
+            (runs."duration" IS NOT DISTINCT FROM sqlc.narg('cursorDuration')::bigint AND runs."id" > sqlc.narg('cursorId')::uuid)
+        ))
     )
 ORDER BY
     case when @orderBy = 'createdAt ASC' THEN runs."createdAt" END ASC ,
@@ -310,20 +369,22 @@ RETURNING
     "WorkflowRun".*;
 
 -- name: UpdateWorkflowRunGroupKeyFromExpr :one
+-- The workflow run is only failed if it's in one of failedFromStatuses, and only queued if it's in one of
+-- queuedFromStatuses. A queued workflow run already has its group key, so it's never updated.
 UPDATE "WorkflowRun" wr
 SET "error" = CASE
-    -- Final states are final, cannot be updated. We also can't move out of a queued state
-    WHEN "status" IN ('SUCCEEDED', 'FAILED', 'QUEUED') THEN "error"
-    WHEN sqlc.narg('error')::text IS NOT NULL THEN sqlc.narg('error')::text
+    WHEN "status" = 'QUEUED' THEN "error"
+    WHEN sqlc.narg('error')::text IS NOT NULL AND "status" = ANY(@failedFromStatuses::"WorkflowRunStatus"[]) THEN sqlc.narg('error')::text
     ELSE "error"
 END,
 "status" = CASE
-    -- Final states are final, cannot be updated. We also can't move out of a queued state
-    WHEN "status" IN ('SUCCEEDED', 'FAILED', 'QUEUED') THEN "status"
+    WHEN "status" = 'QUEUED' THEN "status"
     -- When the concurrency expression errored, then the workflow is failed
-    WHEN sqlc.narg('error')::text IS NOT NULL THEN 'FAILED'
+    WHEN sqlc.narg('error')::text IS NOT NULL THEN
+        CASE WHEN "status" = ANY(@failedFromStatuses::"WorkflowRunStatus"[]) THEN 'FAILED' ELSE "status" END
     -- When the expression evaluated successfully, then queue the workflow run
-    ELSE 'QUEUED'
+    WHEN "status" = ANY(@queuedFromStatuses::"WorkflowRunStatus"[]) THEN 'QUEUED'
+    ELSE "status"
 END,
 "concurrencyGroupId" = CASE
     WHEN sqlc.narg('concurrencyGroupId')::text IS NOT NULL THEN sqlc.narg('concurrencyGroupId')::text
@@ -334,6 +395,8 @@ WHERE
 RETURNING wr."id";
 
 -- name: UpdateWorkflowRunGroupKeyFromRun :one
+-- The workflow run is only failed if it's in one of failedFromStatuses, and only queued if it's in one of
+-- queuedFromStatuses. A queued workflow run already has its group key, so it's never updated.
 WITH groupKeyRun AS (
     SELECT "id", "status" as groupKeyRunStatus, "output", "workflowRunId"
     FROM "GetGroupKeyRun" as groupKeyRun
@@ -344,24 +407,24 @@ WITH groupKeyRun AS (
 )
 UPDATE "WorkflowRun" workflowRun
 SET "status" = CASE
-    -- Final states are final, cannot be updated. We also can't move out of a queued state
-    WHEN "status" IN ('SUCCEEDED', 'FAILED', 'QUEUED') THEN "status"
+    WHEN "status" = 'QUEUED' THEN "status"
     -- When the GetGroupKeyRun failed or been cancelled, then the workflow is failed
-    WHEN groupKeyRun.groupKeyRunStatus IN ('FAILED', 'CANCELLED') THEN 'FAILED'
-    WHEN groupKeyRun.output IS NOT NULL THEN 'QUEUED'
+    WHEN groupKeyRun.groupKeyRunStatus IN ('FAILED', 'CANCELLED') THEN
+        CASE WHEN "status" = ANY(@failedFromStatuses::"WorkflowRunStatus"[]) THEN 'FAILED' ELSE "status" END
+    WHEN groupKeyRun.output IS NOT NULL AND "status" = ANY(@queuedFromStatuses::"WorkflowRunStatus"[]) THEN 'QUEUED'
     ELSE "status"
 END,
 "finishedAt" = CASE
     -- Final states are final, cannot be updated
     WHEN "finishedAt" IS NOT NULL THEN "finishedAt"
     -- When one job run has failed or been cancelled, then the workflow is failed
-    WHEN groupKeyRun.groupKeyRunStatus IN ('FAILED', 'CANCELLED') THEN NOW()
+    WHEN groupKeyRun.groupKeyRunStatus IN ('FAILED', 'CANCELLED') AND "status" = ANY(@failedFromStatuses::"WorkflowRunStatus"[]) THEN NOW()
     ELSE "finishedAt"
 END,
 "duration" = CASE
     -- duration is final, cannot be changed
     WHEN "duration" IS NOT NULL THEN "duration"
-    WHEN "startedAt" IS NOT NULL AND groupKeyRun.groupKeyRunStatus IN ('FAILED', 'CANCELLED') THEN
+    WHEN "startedAt" IS NOT NULL AND groupKeyRun.groupKeyRunStatus IN ('FAILED', 'CANCELLED') AND "status" = ANY(@failedFromStatuses::"WorkflowRunStatus"[]) THEN
                 EXTRACT(EPOCH FROM (NOW() - "startedAt")) * 1000
     ELSE "duration"
 END,
@@ -374,6 +437,9 @@ workflowRun."tenantId" = @tenantId::uuid
 RETURNING workflowRun.*;
 
 -- name: ResolveWorkflowRunStatus :many
+-- Resolves the status of the workflow runs of the job runs from the statuses of their job runs. The status is only
+-- written if the workflow run is allowed to transition to it, which is the case if the transition is one of the pairs
+-- of transitionFroms and transitionTos at the same index.
 WITH jobRuns AS (
     SELECT
         runs."workflowRunId",
@@ -395,19 +461,31 @@ WITH jobRuns AS (
         -- we should not include onFailure jobs in the calculation
         job."kind" = 'DEFAULT'
     GROUP BY runs."workflowRunId"
+), resolved AS (
+    SELECT
+        j.*,
+        CASE
+            -- Paused workflow runs stay paused while job runs are running, until they are resumed
+            WHEN wr."status" = 'PAUSED' AND j.runningRuns > 0 THEN wr."status"
+            -- We check for running first, because if a job run is running, then the workflow is running
+            WHEN j.runningRuns > 0 THEN 'RUNNING'
+            -- When at least one job run has failed or been cancelled, then the workflow is failed
+            WHEN j.failedRuns > 0 OR j.cancelledRuns > 0 THEN 'FAILED'
+            -- When all job runs have succeeded, then the workflow is succeeded
+            WHEN j.succeededRuns > 0 AND j.pendingRuns = 0 AND j.runningRuns = 0 AND j.failedRuns = 0 AND j.cancelledRuns = 0 THEN 'SUCCEEDED'
+            ELSE wr."status"
+        END::"WorkflowRunStatus" AS "status"
+    FROM
+        jobRuns j
+    JOIN
+        "WorkflowRun" wr ON wr."id" = j."workflowRunId"
+), transitions AS (
+    SELECT
+        unnest(@transitionFroms::"WorkflowRunStatus"[]) AS "from",
+        unnest(@transitionTos::"WorkflowRunStatus"[]) AS "to"
 ), updated_workflow_runs AS (
     UPDATE "WorkflowRun" wr
-    SET "status" = CASE
-        -- Final states are final, cannot be updated
-        WHEN "status" IN ('SUCCEEDED', 'FAILED') THEN "status"
-        -- We check for running first, because if a job run is running, then the workflow is running
-        WHEN j.runningRuns > 0 THEN 'RUNNING'
-        -- When at least one job run has failed or been cancelled, then the workflow is failed
-        WHEN j.failedRuns > 0 OR j.cancelledRuns > 0 THEN 'FAILED'
-        -- When all job runs have succeeded, then the workflow is succeeded
-        WHEN j.succeededRuns > 0 AND j.pendingRuns = 0 AND j.runningRuns = 0 AND j.failedRuns = 0 AND j.cancelledRuns = 0 THEN 'SUCCEEDED'
-        ELSE "status"
-    END,
+    SET "status" = j."status",
     "finishedAt" = CASE
         -- Final states are final, cannot be updated
         WHEN "finishedAt" IS NOT NULL THEN "finishedAt"
@@ -435,10 +513,18 @@ WITH jobRuns AS (
         ELSE "duration"
     END
     FROM
-        jobRuns j
+        resolved j
     WHERE
         wr."id" = j."workflowRunId"
-        AND "tenantId" = @tenantId::uuid
+        AND wr."tenantId" = @tenantId::uuid
+        AND (
+            wr."status" = j."status"
+            OR EXISTS (
+                SELECT 1
+                FROM transitions t
+                WHERE t."from" = wr."status" AND t."to" = j."status"
+            )
+        )
     RETURNING wr."id", wr."status", wr."tenantId"
 )
 -- Return distinct workflow run ids in a final state
@@ -447,12 +533,14 @@ FROM updated_workflow_runs
 WHERE "status" IN ('SUCCEEDED', 'FAILED');
 
 -- name: UpdateWorkflowRun :one
+-- Updates the workflow run. If the status is set, the workflow run is only updated if it's in one of the given
+-- statuses.
 UPDATE
     "WorkflowRun"
 SET
     "status" = CASE
-    -- Final states are final, cannot be updated
-        WHEN "status" IN ('SUCCEEDED', 'FAILED') THEN "status"
+        -- Paused workflow runs are only set to running when they are resumed
+        WHEN "status" = 'PAUSED' AND sqlc.narg('status')::"WorkflowRunStatus" = 'RUNNING' THEN "status"
         ELSE COALESCE(sqlc.narg('status')::"WorkflowRunStatus", "status")
     END,
     "error" = COALESCE(sqlc.narg('error')::text, "error"),
@@ -463,10 +551,16 @@ SET
 
 WHERE
     "id" = @id::uuid AND
-    "tenantId" = @tenantId::uuid
+    "tenantId" = @tenantId::uuid AND
+    (
+        sqlc.narg('status')::"WorkflowRunStatus" IS NULL OR
+        "status" = ANY(@fromStatuses::"WorkflowRunStatus"[])
+    )
 RETURNING "WorkflowRun".*;
 
 -- name: UpdateManyWorkflowRun :many
+-- Updates the workflow runs. If the status is set, only the workflow runs which are in one of the given statuses are
+-- updated.
 UPDATE
     "WorkflowRun"
 SET
@@ -477,9 +571,42 @@ SET
     "duration" = COALESCE(sqlc.narg('finishedAt')::timestamp, "finishedAt") - COALESCE(sqlc.narg('startedAt')::timestamp, "startedAt")
 WHERE
     "tenantId" = @tenantId::uuid AND
-    "id" = ANY(@ids::uuid[])
+    "id" = ANY(@ids::uuid[]) AND
+    (
+        sqlc.narg('status')::"WorkflowRunStatus" IS NULL OR
+        "status" = ANY(@fromStatuses::"WorkflowRunStatus"[])
+    )
 RETURNING "WorkflowRun".*;
 
+-- name: PauseWorkflowRun :one
+-- Pauses a running workflow run. The queue items of a paused workflow run are not listed by the scheduler until
+-- the workflow run is resumed.
+UPDATE
+    "WorkflowRun"
+SET
+    "status" = 'PAUSED',
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @workflowRunId::uuid
+    AND "tenantId" = @tenantId::uuid
+    AND "status" = 'RUNNING'
+    AND "deletedAt" IS NULL
+RETURNING "id";
+
+-- name: ResumeWorkflowRun :one
+-- Resumes a paused workflow run.
+UPDATE
+    "WorkflowRun"
+SET
+    "status" = 'RUNNING',
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = @workflowRunId::uuid
+    AND "tenantId" = @tenantId::uuid
+    AND "status" = 'PAUSED'
+    AND "deletedAt" IS NULL
+RETURNING "id";
+
 -- name: CreateWorkflowRun :one
 INSERT INTO "WorkflowRun" (
     "id",
@@ -976,67 +1103,178 @@ FROM
 WHERE
     s."jobId" = job_id."jobId";
 
--- name: CreateStepRunsForJobRunIds :many
-WITH job_ids AS (
-    SELECT DISTINCT "jobId", "id" as jobRunId, "tenantId"
-    FROM "JobRun"
-    WHERE "id" = ANY(@jobRunIds::uuid[])
-),
-steps AS (
-    SELECT
-        s."id" as step_id,
-        s."actionId",
-        s."jobId",
-        j.jobRunId,
-        j."tenantId"
-    FROM "Step" s
-    JOIN job_ids j ON s."jobId" = j."jobId"
-)
-INSERT INTO "StepRun" (
-    "id",
-    "tenantId",
-    "priority",
-    "status",
-    "jobRunId",
-    "stepId",
-    "queue"
-)
+-- name: ListStepsForJobRuns :many
+-- Lists the steps of the jobs of job runs, which a step run is created for in each job run.
 SELECT
-    gen_random_uuid() as id,
-    s."tenantId" as tenantId,
-    @priority::int4 as priority,
-    'PENDING' as status,
-    s.jobRunId as jobRunId,
-    step_id as stepId,
-    s."actionId" as queue
-FROM steps s
-RETURNING
-    "id";
+    jr."id" AS "jobRunId",
+    jr."tenantId",
+    s."id" AS "stepId",
+    s."actionId"
+FROM
+    "JobRun" jr
+JOIN
+    "Step" s ON s."jobId" = jr."jobId"
+WHERE
+    jr."id" = ANY(@jobRunIds::uuid[]);
 
 
 
--- name: LinkStepRunParents :exec
-WITH step_runs AS (
-    SELECT "id", "stepId", "jobRunId"
-    FROM "StepRun"
-    WHERE "id" = ANY(@stepRunIds::uuid[])
-), parent_child_step_runs AS (
+-- name: SetStepRunDeadlinesForWorkflowRuns :exec
+UPDATE
+    "StepRun" sr
+SET
+    "deadline" = input."deadline"
+FROM (
     SELECT
-        parent_run."id" AS "A",
-        child_run."id" AS "B"
-    FROM
-        "_StepOrder" AS step_order
-    JOIN
-        step_runs AS parent_run ON parent_run."stepId" = step_order."A"
-    JOIN
-        step_runs AS child_run ON child_run."stepId" = step_order."B" AND child_run."jobRunId" = parent_run."jobRunId"
+        unnest(@workflowRunIds::uuid[]) AS "workflowRunId",
+        unnest(@deadlines::timestamp[]) AS "deadline"
+    ) AS input
+JOIN
+    "JobRun" jr ON jr."workflowRunId" = input."workflowRunId"
+WHERE
+    sr."jobRunId" = jr."id";
+
+-- name: SetWorkflowRunsReplayedFrom :exec
+UPDATE
+    "WorkflowRun" wr
+SET
+    "replayedFromId" = input."replayedFromId",
+    "replayedFromStepId" = input."replayedFromStepId"
+FROM (
+    SELECT
+        unnest(@workflowRunIds::uuid[]) AS "workflowRunId",
+        unnest(@replayedFromIds::uuid[]) AS "replayedFromId",
+        unnest(@replayedFromStepIds::uuid[]) AS "replayedFromStepId"
+    ) AS input
+WHERE
+    wr."id" = input."workflowRunId";
+
+-- name: SetWorkflowRunsTraceContext :exec
+UPDATE
+    "WorkflowRun" wr
+SET
+    "traceContext" = input."traceContext"
+FROM (
+    SELECT
+        unnest(@workflowRunIds::uuid[]) AS "workflowRunId",
+        unnest(@traceContexts::jsonb[]) AS "traceContext"
+    ) AS input
+WHERE
+    wr."id" = input."workflowRunId";
+
+-- name: PreflightCheckReplayFromStep :one
+-- Checks whether the workflow run has a step run for the step, and counts the steps upstream of the step which did
+-- not succeed in the workflow run
+WITH RECURSIVE upstream AS (
+    SELECT so."A" AS "stepId"
+    FROM "_StepOrder" so
+    WHERE so."B" = @stepId::uuid
+    UNION
+    SELECT so."A"
+    FROM "_StepOrder" so
+    JOIN upstream u ON so."B" = u."stepId"
+), step_runs AS (
+    SELECT sr."stepId", sr."status"
+    FROM "StepRun" sr
+    JOIN "JobRun" jr ON jr."id" = sr."jobRunId"
+    WHERE
+        jr."workflowRunId" = @workflowRunId::uuid
+        AND jr."tenantId" = @tenantId::uuid
+        AND sr."deletedAt" IS NULL
+)
+SELECT
+    EXISTS (
+        SELECT 1
+        FROM step_runs
+        WHERE "stepId" = @stepId::uuid
+    ) AS "hasStepRun",
+    (
+        SELECT COUNT(*)
+        FROM upstream u
+        WHERE NOT EXISTS (
+            SELECT 1
+            FROM step_runs sr
+            WHERE sr."stepId" = u."stepId" AND sr."status" = 'SUCCEEDED'
+        )
+    ) AS "unsucceededUpstreamSteps";
+
+-- name: ReuseUpstreamStepRunOutputs :many
+-- Marks the step runs of a replayed workflow run which are upstream of the step it is replayed from as succeeded,
+-- reusing the outputs of the step runs of the workflow run it replays
+WITH RECURSIVE upstream AS (
+    SELECT so."A" AS "stepId"
+    FROM "_StepOrder" so
+    WHERE so."B" = @stepId::uuid
+    UNION
+    SELECT so."A"
+    FROM "_StepOrder" so
+    JOIN upstream u ON so."B" = u."stepId"
+), replayed_step_runs AS (
+    SELECT
+        sr."stepId",
+        sr."output"
+    FROM "StepRun" sr
+    JOIN "JobRun" jr ON jr."id" = sr."jobRunId"
+    WHERE
+        jr."workflowRunId" = @replayedFromId::uuid
+        AND sr."stepId" IN (SELECT "stepId" FROM upstream)
+        AND sr."status" = 'SUCCEEDED'
+        AND sr."deletedAt" IS NULL
+        AND NOT EXISTS (
+            SELECT 1
+            FROM "StepRunMapItem" mi
+            WHERE mi."stepRunId" = sr."id"
+        )
 )
-INSERT INTO "_StepRunOrder" ("A", "B")
+UPDATE
+    "StepRun" sr
+SET
+    "status" = 'SUCCEEDED',
+    "output" = rsr."output",
+    "startedAt" = CURRENT_TIMESTAMP,
+    "finishedAt" = CURRENT_TIMESTAMP,
+    "updatedAt" = CURRENT_TIMESTAMP
+FROM
+    replayed_step_runs rsr,
+    "JobRun" jr
+WHERE
+    sr."jobRunId" = jr."id"
+    AND jr."workflowRunId" = @workflowRunId::uuid
+    AND sr."stepId" = rsr."stepId"
+RETURNING
+    sr."id",
+    sr."output";
+
+-- name: SetReplayedStepRunInput :exec
+UPDATE
+    "StepRun" sr
+SET
+    "input" = @input::jsonb
+FROM
+    "JobRun" jr
+WHERE
+    sr."jobRunId" = jr."id"
+    AND jr."workflowRunId" = @workflowRunId::uuid
+    AND sr."stepId" = @stepId::uuid;
+
+-- name: ListStepOrders :many
+-- Lists the parents of steps, which are the parents of the step runs of the steps in the same job run.
 SELECT
-    parent_child_step_runs."A" AS "A",
-    parent_child_step_runs."B" AS "B"
+    "A",
+    "B"
 FROM
-    parent_child_step_runs;
+    "_StepOrder"
+WHERE
+    "B" = ANY(@stepIds::uuid[]);
+
+-- name: CreateStepRunOrders :copyfrom
+INSERT INTO "_StepRunOrder" (
+    "A",
+    "B"
+) VALUES (
+    $1,
+    $2
+);
 
 -- name: GetWorkflowRun :many
 SELECT
@@ -1520,3 +1758,72 @@ WHERE
 DELETE FROM "WorkflowTriggerScheduledRef"
 WHERE
     "id" = @scheduleId::uuid;
+
+-- name: ListWorkflowRunsForOutput :many
+-- Lists the data used to evaluate the output expression of each workflow run. Workflow runs whose workflow
+-- version has no output expression are skipped.
+SELECT
+    wr."id" AS "workflowRunId",
+    wv."outputExpression",
+    wv."checksum",
+    wr."additionalMetadata",
+    tb."input"
+FROM
+    "WorkflowRun" wr
+JOIN
+    "WorkflowVersion" wv ON wv."id" = wr."workflowVersionId"
+JOIN
+    "WorkflowRunTriggeredBy" tb ON tb."parentId" = wr."id"
+WHERE
+    wr."id" = ANY(@workflowRunIds::uuid[])
+    AND wr."tenantId" = @tenantId::uuid
+    AND wv."outputExpression" IS NOT NULL;
+
+-- name: ListStepRunOutputsForWorkflowRuns :many
+-- Lists the outputs of the succeeded step runs of each workflow run. The step runs of map step elements are
+-- skipped, as their outputs are gathered in the output of the map step run.
+SELECT
+    jr."workflowRunId",
+    s."readableId" AS "stepReadableId",
+    sr."output"
+FROM
+    "StepRun" sr
+JOIN
+    "JobRun" jr ON jr."id" = sr."jobRunId"
+JOIN
+    "Step" s ON s."id" = sr."stepId"
+WHERE
+    jr."workflowRunId" = ANY(@workflowRunIds::uuid[])
+    AND sr."tenantId" = @tenantId::uuid
+    AND sr."status" = 'SUCCEEDED'
+    AND sr."deletedAt" IS NULL
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "StepRunMapItem" mi
+        WHERE mi."stepRunId" = sr."id"
+    );
+
+-- name: UpdateWorkflowRunOutputs :exec
+WITH input AS (
+    SELECT
+        unnest(@workflowRunIds::uuid[]) AS "id",
+        unnest(@outputs::jsonb[]) AS "output"
+)
+UPDATE
+    "WorkflowRun" wr
+SET
+    "output" = input."output"
+FROM
+    input
+WHERE
+    wr."id" = input."id"
+    AND wr."tenantId" = @tenantId::uuid;
+
+-- name: GetWorkflowRunStatus :one
+SELECT
+    "status"
+FROM
+    "WorkflowRun"
+WHERE
+    "id" = @workflowRunId::uuid
+    AND "tenantId" = @tenantId::uuid;
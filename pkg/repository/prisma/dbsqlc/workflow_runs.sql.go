@@ -219,16 +219,24 @@ WITH runs AS (
         (
             $15::timestamp IS NULL OR
             runs."finishedAt" <= $15::timestamp
+        ) AND
+        (
+            $16::uuid[] IS NULL OR
+            workflow."id" = ANY($16::uuid[])
+        ) AND
+        (
+            $17::text IS NULL OR
+            events."key" = $17::text
         )
     ORDER BY
-        case when $16 = 'createdAt ASC' THEN runs."createdAt" END ASC ,
-        case when $16 = 'createdAt DESC' THEN runs."createdAt" END DESC,
-        case when $16 = 'finishedAt ASC' THEN runs."finishedAt" END ASC ,
-        case when $16 = 'finishedAt DESC' THEN runs."finishedAt" END DESC,
-        case when $16 = 'startedAt ASC' THEN runs."startedAt" END ASC ,
-        case when $16 = 'startedAt DESC' THEN runs."startedAt" END DESC,
-        case when $16 = 'duration ASC' THEN runs."duration" END ASC NULLS FIRST,
-        case when $16 = 'duration DESC' THEN runs."duration" END DESC NULLS LAST,
+        case when $18 = 'createdAt ASC' THEN runs."createdAt" END ASC ,
+        case when $18 = 'createdAt DESC' THEN runs."createdAt" END DESC,
+        case when $18 = 'finishedAt ASC' THEN runs."finishedAt" END ASC ,
+        case when $18 = 'finishedAt DESC' THEN runs."finishedAt" END DESC,
+        case when $18 = 'startedAt ASC' THEN runs."startedAt" END ASC ,
+        case when $18 = 'startedAt DESC' THEN runs."startedAt" END DESC,
+        case when $18 = 'duration ASC' THEN runs."duration" END ASC NULLS FIRST,
+        case when $18 = 'duration DESC' THEN runs."duration" END DESC NULLS LAST,
         runs."id" ASC
     LIMIT 10000
 )
@@ -254,6 +262,8 @@ type CountWorkflowRunsParams struct {
 	CreatedBefore      pgtype.Timestamp `json:"createdBefore"`
 	FinishedAfter      pgtype.Timestamp `json:"finishedAfter"`
 	FinishedBefore     pgtype.Timestamp `json:"finishedBefore"`
+	WorkflowIds        []pgtype.UUID    `json:"workflowIds"`
+	EventKey           pgtype.Text      `json:"eventKey"`
 	Orderby            interface{}      `json:"orderby"`
 }
 
@@ -274,6 +284,8 @@ func (q *Queries) CountWorkflowRuns(ctx context.Context, db DBTX, arg CountWorkf
 		arg.CreatedBefore,
 		arg.FinishedAfter,
 		arg.FinishedBefore,
+		arg.WorkflowIds,
+		arg.EventKey,
 		arg.Orderby,
 	)
 	var total int64
@@ -732,6 +744,11 @@ func (q *Queries) CreateStepRun(ctx context.Context, db DBTX, arg CreateStepRunP
 	return id, err
 }
 
+type CreateStepRunOrdersParams struct {
+	A pgtype.UUID `json:"A"`
+	B pgtype.UUID `json:"B"`
+}
+
 type CreateStepRunsParams struct {
 	ID           pgtype.UUID      `json:"id"`
 	TenantId     pgtype.UUID      `json:"tenantId"`
@@ -743,69 +760,6 @@ type CreateStepRunsParams struct {
 	Priority     pgtype.Int4      `json:"priority"`
 }
 
-const createStepRunsForJobRunIds = `-- name: CreateStepRunsForJobRunIds :many
-WITH job_ids AS (
-    SELECT DISTINCT "jobId", "id" as jobRunId, "tenantId"
-    FROM "JobRun"
-    WHERE "id" = ANY($2::uuid[])
-),
-steps AS (
-    SELECT
-        s."id" as step_id,
-        s."actionId",
-        s."jobId",
-        j.jobRunId,
-        j."tenantId"
-    FROM "Step" s
-    JOIN job_ids j ON s."jobId" = j."jobId"
-)
-INSERT INTO "StepRun" (
-    "id",
-    "tenantId",
-    "priority",
-    "status",
-    "jobRunId",
-    "stepId",
-    "queue"
-)
-SELECT
-    gen_random_uuid() as id,
-    s."tenantId" as tenantId,
-    $1::int4 as priority,
-    'PENDING' as status,
-    s.jobRunId as jobRunId,
-    step_id as stepId,
-    s."actionId" as queue
-FROM steps s
-RETURNING
-    "id"
-`
-
-type CreateStepRunsForJobRunIdsParams struct {
-	Priority  int32         `json:"priority"`
-	Jobrunids []pgtype.UUID `json:"jobrunids"`
-}
-
-func (q *Queries) CreateStepRunsForJobRunIds(ctx context.Context, db DBTX, arg CreateStepRunsForJobRunIdsParams) ([]pgtype.UUID, error) {
-	rows, err := db.Query(ctx, createStepRunsForJobRunIds, arg.Priority, arg.Jobrunids)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []pgtype.UUID
-	for rows.Next() {
-		var id pgtype.UUID
-		if err := rows.Scan(&id); err != nil {
-			return nil, err
-		}
-		items = append(items, id)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const createWorkflowRun = `-- name: CreateWorkflowRun :one
 INSERT INTO "WorkflowRun" (
     "id",
@@ -843,7 +797,7 @@ INSERT INTO "WorkflowRun" (
     $8::uuid,
     $9::jsonb,
     $10::int
-) RETURNING "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", status, error, "startedAt", "finishedAt", "concurrencyGroupId", "displayName", id, "childIndex", "childKey", "parentId", "parentStepRunId", "additionalMetadata", duration, priority, "insertOrder"
+) RETURNING "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", status, error, "startedAt", "finishedAt", "concurrencyGroupId", "displayName", id, "childIndex", "childKey", "parentId", "parentStepRunId", "additionalMetadata", duration, priority, "insertOrder", output, "replayedFromId", "replayedFromStepId", "traceContext"
 `
 
 type CreateWorkflowRunParams struct {
@@ -894,6 +848,10 @@ func (q *Queries) CreateWorkflowRun(ctx context.Context, db DBTX, arg CreateWork
 		&i.Duration,
 		&i.Priority,
 		&i.InsertOrder,
+		&i.Output,
+		&i.ReplayedFromId,
+		&i.ReplayedFromStepId,
+		&i.TraceContext,
 	)
 	return &i, err
 }
@@ -1106,7 +1064,7 @@ func (q *Queries) DeleteScheduledWorkflow(ctx context.Context, db DBTX, schedule
 
 const getChildWorkflowRun = `-- name: GetChildWorkflowRun :one
 SELECT
-    "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", status, error, "startedAt", "finishedAt", "concurrencyGroupId", "displayName", id, "childIndex", "childKey", "parentId", "parentStepRunId", "additionalMetadata", duration, priority, "insertOrder"
+    "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", status, error, "startedAt", "finishedAt", "concurrencyGroupId", "displayName", id, "childIndex", "childKey", "parentId", "parentStepRunId", "additionalMetadata", duration, priority, "insertOrder", output, "replayedFromId", "replayedFromStepId", "traceContext"
 FROM
     "WorkflowRun"
 WHERE
@@ -1156,13 +1114,17 @@ func (q *Queries) GetChildWorkflowRun(ctx context.Context, db DBTX, arg GetChild
 		&i.Duration,
 		&i.Priority,
 		&i.InsertOrder,
+		&i.Output,
+		&i.ReplayedFromId,
+		&i.ReplayedFromStepId,
+		&i.TraceContext,
 	)
 	return &i, err
 }
 
 const getChildWorkflowRunsByIndex = `-- name: GetChildWorkflowRunsByIndex :many
 SELECT
-    wr."createdAt", wr."updatedAt", wr."deletedAt", wr."tenantId", wr."workflowVersionId", wr.status, wr.error, wr."startedAt", wr."finishedAt", wr."concurrencyGroupId", wr."displayName", wr.id, wr."childIndex", wr."childKey", wr."parentId", wr."parentStepRunId", wr."additionalMetadata", wr.duration, wr.priority, wr."insertOrder"
+    wr."createdAt", wr."updatedAt", wr."deletedAt", wr."tenantId", wr."workflowVersionId", wr.status, wr.error, wr."startedAt", wr."finishedAt", wr."concurrencyGroupId", wr."displayName", wr.id, wr."childIndex", wr."childKey", wr."parentId", wr."parentStepRunId", wr."additionalMetadata", wr.duration, wr.priority, wr."insertOrder", wr.output, wr."replayedFromId", wr."replayedFromStepId", wr."traceContext"
 FROM
     "WorkflowRun" wr
 WHERE
@@ -1211,6 +1173,10 @@ func (q *Queries) GetChildWorkflowRunsByIndex(ctx context.Context, db DBTX, arg
 			&i.Duration,
 			&i.Priority,
 			&i.InsertOrder,
+			&i.Output,
+			&i.ReplayedFromId,
+			&i.ReplayedFromStepId,
+			&i.TraceContext,
 		); err != nil {
 			return nil, err
 		}
@@ -1224,7 +1190,7 @@ func (q *Queries) GetChildWorkflowRunsByIndex(ctx context.Context, db DBTX, arg
 
 const getChildWorkflowRunsByKey = `-- name: GetChildWorkflowRunsByKey :many
 SELECT
-    wr."createdAt", wr."updatedAt", wr."deletedAt", wr."tenantId", wr."workflowVersionId", wr.status, wr.error, wr."startedAt", wr."finishedAt", wr."concurrencyGroupId", wr."displayName", wr.id, wr."childIndex", wr."childKey", wr."parentId", wr."parentStepRunId", wr."additionalMetadata", wr.duration, wr.priority, wr."insertOrder"
+    wr."createdAt", wr."updatedAt", wr."deletedAt", wr."tenantId", wr."workflowVersionId", wr.status, wr.error, wr."startedAt", wr."finishedAt", wr."concurrencyGroupId", wr."displayName", wr.id, wr."childIndex", wr."childKey", wr."parentId", wr."parentStepRunId", wr."additionalMetadata", wr.duration, wr.priority, wr."insertOrder", wr.output, wr."replayedFromId", wr."replayedFromStepId", wr."traceContext"
 FROM
     "WorkflowRun" wr
 WHERE
@@ -1273,6 +1239,10 @@ func (q *Queries) GetChildWorkflowRunsByKey(ctx context.Context, db DBTX, arg Ge
 			&i.Duration,
 			&i.Priority,
 			&i.InsertOrder,
+			&i.Output,
+			&i.ReplayedFromId,
+			&i.ReplayedFromStepId,
+			&i.TraceContext,
 		); err != nil {
 			return nil, err
 		}
@@ -1490,7 +1460,7 @@ func (q *Queries) GetStepRunsForJobRunsWithOutput(ctx context.Context, db DBTX,
 const getStepsForJobs = `-- name: GetStepsForJobs :many
 SELECT
 	j."id" as "jobId",
-    s.id, s."createdAt", s."updatedAt", s."deletedAt", s."readableId", s."tenantId", s."jobId", s."actionId", s.timeout, s."customUserData", s.retries, s."retryBackoffFactor", s."retryMaxBackoff", s."scheduleTimeout",
+    s.id, s."createdAt", s."updatedAt", s."deletedAt", s."readableId", s."tenantId", s."jobId", s."actionId", s.timeout, s."customUserData", s.retries, s."retryBackoffFactor", s."retryMaxBackoff", s."scheduleTimeout", s."speculativePercentile", s."slotType", s."heartbeatTimeout", s."retryInitialBackoff", s."retryJitter", s."sleepFor", s."sleepUntil", s."waitForEvent", s."waitForEventCorrelation", s.condition, s."mapOver", s."mapConcurrency", s."cacheTtl", s.pool,
     (
         SELECT array_agg(so."A")::uuid[]  -- Casting the array_agg result to uuid[]
         FROM "_StepOrder" so
@@ -1540,6 +1510,20 @@ func (q *Queries) GetStepsForJobs(ctx context.Context, db DBTX, arg GetStepsForJ
 			&i.Step.RetryBackoffFactor,
 			&i.Step.RetryMaxBackoff,
 			&i.Step.ScheduleTimeout,
+			&i.Step.SpeculativePercentile,
+			&i.Step.SlotType,
+			&i.Step.HeartbeatTimeout,
+			&i.Step.RetryInitialBackoff,
+			&i.Step.RetryJitter,
+			&i.Step.SleepFor,
+			&i.Step.SleepUntil,
+			&i.Step.WaitForEvent,
+			&i.Step.WaitForEventCorrelation,
+			&i.Step.Condition,
+			&i.Step.MapOver,
+			&i.Step.MapConcurrency,
+			&i.Step.CacheTtl,
+			&i.Step.Pool,
 			&i.Parents,
 		); err != nil {
 			return nil, err
@@ -1555,7 +1539,7 @@ func (q *Queries) GetStepsForJobs(ctx context.Context, db DBTX, arg GetStepsForJ
 const getStepsForWorkflowVersion = `-- name: GetStepsForWorkflowVersion :many
 
 SELECT
-    "Step".id, "Step"."createdAt", "Step"."updatedAt", "Step"."deletedAt", "Step"."readableId", "Step"."tenantId", "Step"."jobId", "Step"."actionId", "Step".timeout, "Step"."customUserData", "Step".retries, "Step"."retryBackoffFactor", "Step"."retryMaxBackoff", "Step"."scheduleTimeout"  from "Step"
+    "Step".id, "Step"."createdAt", "Step"."updatedAt", "Step"."deletedAt", "Step"."readableId", "Step"."tenantId", "Step"."jobId", "Step"."actionId", "Step".timeout, "Step"."customUserData", "Step".retries, "Step"."retryBackoffFactor", "Step"."retryMaxBackoff", "Step"."scheduleTimeout", "Step"."speculativePercentile", "Step"."slotType", "Step"."heartbeatTimeout", "Step"."retryInitialBackoff", "Step"."retryJitter", "Step"."sleepFor", "Step"."sleepUntil", "Step"."waitForEvent", "Step"."waitForEventCorrelation", "Step".condition, "Step"."mapOver", "Step"."mapConcurrency", "Step"."cacheTtl", "Step".pool  from "Step"
 JOIN "Job" j ON "Step"."jobId" = j."id"
 WHERE
     j."workflowVersionId" = ANY($1::uuid[])
@@ -1585,6 +1569,20 @@ func (q *Queries) GetStepsForWorkflowVersion(ctx context.Context, db DBTX, workf
 			&i.RetryBackoffFactor,
 			&i.RetryMaxBackoff,
 			&i.ScheduleTimeout,
+			&i.SpeculativePercentile,
+			&i.SlotType,
+			&i.HeartbeatTimeout,
+			&i.RetryInitialBackoff,
+			&i.RetryJitter,
+			&i.SleepFor,
+			&i.SleepUntil,
+			&i.WaitForEvent,
+			&i.WaitForEventCorrelation,
+			&i.Condition,
+			&i.MapOver,
+			&i.MapConcurrency,
+			&i.CacheTtl,
+			&i.Pool,
 		); err != nil {
 			return nil, err
 		}
@@ -1598,9 +1596,9 @@ func (q *Queries) GetStepsForWorkflowVersion(ctx context.Context, db DBTX, workf
 
 const getWorkflowRun = `-- name: GetWorkflowRun :many
 SELECT
-    runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."childIndex", runs."childKey", runs."parentId", runs."parentStepRunId", runs."additionalMetadata", runs.duration, runs.priority, runs."insertOrder",
+    runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."childIndex", runs."childKey", runs."parentId", runs."parentStepRunId", runs."additionalMetadata", runs.duration, runs.priority, runs."insertOrder", runs.output, runs."replayedFromId", runs."replayedFromStepId", runs."traceContext",
     runtriggers.id, runtriggers."createdAt", runtriggers."updatedAt", runtriggers."deletedAt", runtriggers."tenantId", runtriggers."eventId", runtriggers."cronParentId", runtriggers."cronSchedule", runtriggers."scheduledId", runtriggers.input, runtriggers."parentId", runtriggers."cronName",
-    workflowversion.id, workflowversion."createdAt", workflowversion."updatedAt", workflowversion."deletedAt", workflowversion.version, workflowversion."order", workflowversion."workflowId", workflowversion.checksum, workflowversion."scheduleTimeout", workflowversion."onFailureJobId", workflowversion.sticky, workflowversion.kind, workflowversion."defaultPriority",
+    workflowversion.id, workflowversion."createdAt", workflowversion."updatedAt", workflowversion."deletedAt", workflowversion.version, workflowversion."order", workflowversion."workflowId", workflowversion.checksum, workflowversion."scheduleTimeout", workflowversion."onFailureJobId", workflowversion.sticky, workflowversion.kind, workflowversion."defaultPriority", workflowversion.region, workflowversion."regionStrategy", workflowversion."outputExpression", workflowversion."inputSchema", workflowversion."reservedSlots",
     workflow."name" as "workflowName",
     -- waiting on https://github.com/sqlc-dev/sqlc/pull/2858 for nullable fields
     wc."limitStrategy" as "concurrencyLimitStrategy",
@@ -1675,6 +1673,10 @@ func (q *Queries) GetWorkflowRun(ctx context.Context, db DBTX, arg GetWorkflowRu
 			&i.WorkflowRun.Duration,
 			&i.WorkflowRun.Priority,
 			&i.WorkflowRun.InsertOrder,
+			&i.WorkflowRun.Output,
+			&i.WorkflowRun.ReplayedFromId,
+			&i.WorkflowRun.ReplayedFromStepId,
+			&i.WorkflowRun.TraceContext,
 			&i.WorkflowRunTriggeredBy.ID,
 			&i.WorkflowRunTriggeredBy.CreatedAt,
 			&i.WorkflowRunTriggeredBy.UpdatedAt,
@@ -1700,6 +1702,11 @@ func (q *Queries) GetWorkflowRun(ctx context.Context, db DBTX, arg GetWorkflowRu
 			&i.WorkflowVersion.Sticky,
 			&i.WorkflowVersion.Kind,
 			&i.WorkflowVersion.DefaultPriority,
+			&i.WorkflowVersion.Region,
+			&i.WorkflowVersion.RegionStrategy,
+			&i.WorkflowVersion.OutputExpression,
+			&i.WorkflowVersion.InputSchema,
+			&i.WorkflowVersion.ReservedSlots,
 			&i.WorkflowName,
 			&i.ConcurrencyLimitStrategy,
 			&i.ConcurrencyMaxRuns,
@@ -1747,8 +1754,8 @@ func (q *Queries) GetWorkflowRunAdditionalMeta(ctx context.Context, db DBTX, arg
 
 const getWorkflowRunById = `-- name: GetWorkflowRunById :one
 SELECT
-    r."createdAt", r."updatedAt", r."deletedAt", r."tenantId", r."workflowVersionId", r.status, r.error, r."startedAt", r."finishedAt", r."concurrencyGroupId", r."displayName", r.id, r."childIndex", r."childKey", r."parentId", r."parentStepRunId", r."additionalMetadata", r.duration, r.priority, r."insertOrder",
-    wv.id, wv."createdAt", wv."updatedAt", wv."deletedAt", wv.version, wv."order", wv."workflowId", wv.checksum, wv."scheduleTimeout", wv."onFailureJobId", wv.sticky, wv.kind, wv."defaultPriority",
+    r."createdAt", r."updatedAt", r."deletedAt", r."tenantId", r."workflowVersionId", r.status, r.error, r."startedAt", r."finishedAt", r."concurrencyGroupId", r."displayName", r.id, r."childIndex", r."childKey", r."parentId", r."parentStepRunId", r."additionalMetadata", r.duration, r.priority, r."insertOrder", r.output, r."replayedFromId", r."replayedFromStepId", r."traceContext",
+    wv.id, wv."createdAt", wv."updatedAt", wv."deletedAt", wv.version, wv."order", wv."workflowId", wv.checksum, wv."scheduleTimeout", wv."onFailureJobId", wv.sticky, wv.kind, wv."defaultPriority", wv.region, wv."regionStrategy", wv."outputExpression", wv."inputSchema", wv."reservedSlots",
     w.id, w."createdAt", w."updatedAt", w."deletedAt", w."tenantId", w.name, w.description, w."isPaused",
     tb.id, tb."createdAt", tb."updatedAt", tb."deletedAt", tb."tenantId", tb."eventId", tb."cronParentId", tb."cronSchedule", tb."scheduledId", tb.input, tb."parentId", tb."cronName"
 FROM
@@ -1791,6 +1798,10 @@ type GetWorkflowRunByIdRow struct {
 	Duration               pgtype.Int8            `json:"duration"`
 	Priority               pgtype.Int4            `json:"priority"`
 	InsertOrder            pgtype.Int4            `json:"insertOrder"`
+	Output                 []byte                 `json:"output"`
+	ReplayedFromId         pgtype.UUID            `json:"replayedFromId"`
+	ReplayedFromStepId     pgtype.UUID            `json:"replayedFromStepId"`
+	TraceContext           []byte                 `json:"traceContext"`
 	WorkflowVersion        WorkflowVersion        `json:"workflow_version"`
 	Workflow               Workflow               `json:"workflow"`
 	WorkflowRunTriggeredBy WorkflowRunTriggeredBy `json:"workflow_run_triggered_by"`
@@ -1820,6 +1831,10 @@ func (q *Queries) GetWorkflowRunById(ctx context.Context, db DBTX, arg GetWorkfl
 		&i.Duration,
 		&i.Priority,
 		&i.InsertOrder,
+		&i.Output,
+		&i.ReplayedFromId,
+		&i.ReplayedFromStepId,
+		&i.TraceContext,
 		&i.WorkflowVersion.ID,
 		&i.WorkflowVersion.CreatedAt,
 		&i.WorkflowVersion.UpdatedAt,
@@ -1833,6 +1848,11 @@ func (q *Queries) GetWorkflowRunById(ctx context.Context, db DBTX, arg GetWorkfl
 		&i.WorkflowVersion.Sticky,
 		&i.WorkflowVersion.Kind,
 		&i.WorkflowVersion.DefaultPriority,
+		&i.WorkflowVersion.Region,
+		&i.WorkflowVersion.RegionStrategy,
+		&i.WorkflowVersion.OutputExpression,
+		&i.WorkflowVersion.InputSchema,
+		&i.WorkflowVersion.ReservedSlots,
 		&i.Workflow.ID,
 		&i.Workflow.CreatedAt,
 		&i.Workflow.UpdatedAt,
@@ -1859,8 +1879,8 @@ func (q *Queries) GetWorkflowRunById(ctx context.Context, db DBTX, arg GetWorkfl
 
 const getWorkflowRunByIds = `-- name: GetWorkflowRunByIds :many
 SELECT
-    r."createdAt", r."updatedAt", r."deletedAt", r."tenantId", r."workflowVersionId", r.status, r.error, r."startedAt", r."finishedAt", r."concurrencyGroupId", r."displayName", r.id, r."childIndex", r."childKey", r."parentId", r."parentStepRunId", r."additionalMetadata", r.duration, r.priority, r."insertOrder",
-    wv.id, wv."createdAt", wv."updatedAt", wv."deletedAt", wv.version, wv."order", wv."workflowId", wv.checksum, wv."scheduleTimeout", wv."onFailureJobId", wv.sticky, wv.kind, wv."defaultPriority",
+    r."createdAt", r."updatedAt", r."deletedAt", r."tenantId", r."workflowVersionId", r.status, r.error, r."startedAt", r."finishedAt", r."concurrencyGroupId", r."displayName", r.id, r."childIndex", r."childKey", r."parentId", r."parentStepRunId", r."additionalMetadata", r.duration, r.priority, r."insertOrder", r.output, r."replayedFromId", r."replayedFromStepId", r."traceContext",
+    wv.id, wv."createdAt", wv."updatedAt", wv."deletedAt", wv.version, wv."order", wv."workflowId", wv.checksum, wv."scheduleTimeout", wv."onFailureJobId", wv.sticky, wv.kind, wv."defaultPriority", wv.region, wv."regionStrategy", wv."outputExpression", wv."inputSchema", wv."reservedSlots",
     w.id, w."createdAt", w."updatedAt", w."deletedAt", w."tenantId", w.name, w.description, w."isPaused",
     tb.id, tb."createdAt", tb."updatedAt", tb."deletedAt", tb."tenantId", tb."eventId", tb."cronParentId", tb."cronSchedule", tb."scheduledId", tb.input, tb."parentId", tb."cronName"
 FROM
@@ -1903,6 +1923,10 @@ type GetWorkflowRunByIdsRow struct {
 	Duration               pgtype.Int8            `json:"duration"`
 	Priority               pgtype.Int4            `json:"priority"`
 	InsertOrder            pgtype.Int4            `json:"insertOrder"`
+	Output                 []byte                 `json:"output"`
+	ReplayedFromId         pgtype.UUID            `json:"replayedFromId"`
+	ReplayedFromStepId     pgtype.UUID            `json:"replayedFromStepId"`
+	TraceContext           []byte                 `json:"traceContext"`
 	WorkflowVersion        WorkflowVersion        `json:"workflow_version"`
 	Workflow               Workflow               `json:"workflow"`
 	WorkflowRunTriggeredBy WorkflowRunTriggeredBy `json:"workflow_run_triggered_by"`
@@ -1938,6 +1962,10 @@ func (q *Queries) GetWorkflowRunByIds(ctx context.Context, db DBTX, arg GetWorkf
 			&i.Duration,
 			&i.Priority,
 			&i.InsertOrder,
+			&i.Output,
+			&i.ReplayedFromId,
+			&i.ReplayedFromStepId,
+			&i.TraceContext,
 			&i.WorkflowVersion.ID,
 			&i.WorkflowVersion.CreatedAt,
 			&i.WorkflowVersion.UpdatedAt,
@@ -1951,6 +1979,11 @@ func (q *Queries) GetWorkflowRunByIds(ctx context.Context, db DBTX, arg GetWorkf
 			&i.WorkflowVersion.Sticky,
 			&i.WorkflowVersion.Kind,
 			&i.WorkflowVersion.DefaultPriority,
+			&i.WorkflowVersion.Region,
+			&i.WorkflowVersion.RegionStrategy,
+			&i.WorkflowVersion.OutputExpression,
+			&i.WorkflowVersion.InputSchema,
+			&i.WorkflowVersion.ReservedSlots,
 			&i.Workflow.ID,
 			&i.Workflow.CreatedAt,
 			&i.Workflow.UpdatedAt,
@@ -1997,6 +2030,28 @@ func (q *Queries) GetWorkflowRunInput(ctx context.Context, db DBTX, workflowruni
 	return lookupdata, err
 }
 
+const getWorkflowRunStatus = `-- name: GetWorkflowRunStatus :one
+SELECT
+    "status"
+FROM
+    "WorkflowRun"
+WHERE
+    "id" = $1::uuid
+    AND "tenantId" = $2::uuid
+`
+
+type GetWorkflowRunStatusParams struct {
+	Workflowrunid pgtype.UUID `json:"workflowrunid"`
+	Tenantid      pgtype.UUID `json:"tenantid"`
+}
+
+func (q *Queries) GetWorkflowRunStatus(ctx context.Context, db DBTX, arg GetWorkflowRunStatusParams) (WorkflowRunStatus, error) {
+	row := db.QueryRow(ctx, getWorkflowRunStatus, arg.Workflowrunid, arg.Tenantid)
+	var status WorkflowRunStatus
+	err := row.Scan(&status)
+	return status, err
+}
+
 const getWorkflowRunStickyStateForUpdate = `-- name: GetWorkflowRunStickyStateForUpdate :one
 SELECT
     id, "createdAt", "updatedAt", "tenantId", "workflowRunId", "desiredWorkerId", strategy
@@ -2063,7 +2118,7 @@ func (q *Queries) GetWorkflowRunTrigger(ctx context.Context, db DBTX, arg GetWor
 }
 
 const getWorkflowRunsInsertedInThisTxn = `-- name: GetWorkflowRunsInsertedInThisTxn :many
-SELECT "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", status, error, "startedAt", "finishedAt", "concurrencyGroupId", "displayName", id, "childIndex", "childKey", "parentId", "parentStepRunId", "additionalMetadata", duration, priority, "insertOrder" FROM "WorkflowRun"
+SELECT "createdAt", "updatedAt", "deletedAt", "tenantId", "workflowVersionId", status, error, "startedAt", "finishedAt", "concurrencyGroupId", "displayName", id, "childIndex", "childKey", "parentId", "parentStepRunId", "additionalMetadata", duration, priority, "insertOrder", output, "replayedFromId", "replayedFromStepId", "traceContext" FROM "WorkflowRun"
 WHERE xmin::text = (txid_current() % (2^32)::bigint)::text
 AND ("createdAt" = CURRENT_TIMESTAMP::timestamp(3))
 ORDER BY "insertOrder" ASC
@@ -2099,6 +2154,10 @@ func (q *Queries) GetWorkflowRunsInsertedInThisTxn(ctx context.Context, db DBTX)
 			&i.Duration,
 			&i.Priority,
 			&i.InsertOrder,
+			&i.Output,
+			&i.ReplayedFromId,
+			&i.ReplayedFromStepId,
+			&i.TraceContext,
 		); err != nil {
 			return nil, err
 		}
@@ -2110,35 +2169,6 @@ func (q *Queries) GetWorkflowRunsInsertedInThisTxn(ctx context.Context, db DBTX)
 	return items, nil
 }
 
-const linkStepRunParents = `-- name: LinkStepRunParents :exec
-WITH step_runs AS (
-    SELECT "id", "stepId", "jobRunId"
-    FROM "StepRun"
-    WHERE "id" = ANY($1::uuid[])
-), parent_child_step_runs AS (
-    SELECT
-        parent_run."id" AS "A",
-        child_run."id" AS "B"
-    FROM
-        "_StepOrder" AS step_order
-    JOIN
-        step_runs AS parent_run ON parent_run."stepId" = step_order."A"
-    JOIN
-        step_runs AS child_run ON child_run."stepId" = step_order."B" AND child_run."jobRunId" = parent_run."jobRunId"
-)
-INSERT INTO "_StepRunOrder" ("A", "B")
-SELECT
-    parent_child_step_runs."A" AS "A",
-    parent_child_step_runs."B" AS "B"
-FROM
-    parent_child_step_runs
-`
-
-func (q *Queries) LinkStepRunParents(ctx context.Context, db DBTX, steprunids []pgtype.UUID) error {
-	_, err := db.Exec(ctx, linkStepRunParents, steprunids)
-	return err
-}
-
 const listActiveQueuedWorkflowVersions = `-- name: ListActiveQueuedWorkflowVersions :many
 WITH QueuedRuns AS (
     SELECT DISTINCT ON (wr."workflowVersionId")
@@ -2377,6 +2407,93 @@ func (q *Queries) ListScheduledWorkflows(ctx context.Context, db DBTX, arg ListS
 	return items, nil
 }
 
+const listStepOrders = `-- name: ListStepOrders :many
+SELECT
+    "A",
+    "B"
+FROM
+    "_StepOrder"
+WHERE
+    "B" = ANY($1::uuid[])
+`
+
+// Lists the parents of steps, which are the parents of the step runs of the steps in the same job run.
+func (q *Queries) ListStepOrders(ctx context.Context, db DBTX, stepids []pgtype.UUID) ([]*StepOrder, error) {
+	rows, err := db.Query(ctx, listStepOrders, stepids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*StepOrder
+	for rows.Next() {
+		var i StepOrder
+		if err := rows.Scan(&i.A, &i.B); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStepRunOutputsForWorkflowRuns = `-- name: ListStepRunOutputsForWorkflowRuns :many
+SELECT
+    jr."workflowRunId",
+    s."readableId" AS "stepReadableId",
+    sr."output"
+FROM
+    "StepRun" sr
+JOIN
+    "JobRun" jr ON jr."id" = sr."jobRunId"
+JOIN
+    "Step" s ON s."id" = sr."stepId"
+WHERE
+    jr."workflowRunId" = ANY($1::uuid[])
+    AND sr."tenantId" = $2::uuid
+    AND sr."status" = 'SUCCEEDED'
+    AND sr."deletedAt" IS NULL
+    AND NOT EXISTS (
+        SELECT 1
+        FROM "StepRunMapItem" mi
+        WHERE mi."stepRunId" = sr."id"
+    )
+`
+
+type ListStepRunOutputsForWorkflowRunsParams struct {
+	Workflowrunids []pgtype.UUID `json:"workflowrunids"`
+	Tenantid       pgtype.UUID   `json:"tenantid"`
+}
+
+type ListStepRunOutputsForWorkflowRunsRow struct {
+	WorkflowRunId  pgtype.UUID `json:"workflowRunId"`
+	StepReadableId pgtype.Text `json:"stepReadableId"`
+	Output         []byte      `json:"output"`
+}
+
+// Lists the outputs of the succeeded step runs of each workflow run. The step runs of map step elements are
+// skipped, as their outputs are gathered in the output of the map step run.
+func (q *Queries) ListStepRunOutputsForWorkflowRuns(ctx context.Context, db DBTX, arg ListStepRunOutputsForWorkflowRunsParams) ([]*ListStepRunOutputsForWorkflowRunsRow, error) {
+	rows, err := db.Query(ctx, listStepRunOutputsForWorkflowRuns, arg.Workflowrunids, arg.Tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListStepRunOutputsForWorkflowRunsRow
+	for rows.Next() {
+		var i ListStepRunOutputsForWorkflowRunsRow
+		if err := rows.Scan(&i.WorkflowRunId, &i.StepReadableId, &i.Output); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listStepsForJob = `-- name: ListStepsForJob :many
 WITH job_id AS (
     SELECT "jobId"
@@ -2417,6 +2534,53 @@ func (q *Queries) ListStepsForJob(ctx context.Context, db DBTX, jobrunid pgtype.
 	return items, nil
 }
 
+const listStepsForJobRuns = `-- name: ListStepsForJobRuns :many
+SELECT
+    jr."id" AS "jobRunId",
+    jr."tenantId",
+    s."id" AS "stepId",
+    s."actionId"
+FROM
+    "JobRun" jr
+JOIN
+    "Step" s ON s."jobId" = jr."jobId"
+WHERE
+    jr."id" = ANY($1::uuid[])
+`
+
+type ListStepsForJobRunsRow struct {
+	JobRunId pgtype.UUID `json:"jobRunId"`
+	TenantId pgtype.UUID `json:"tenantId"`
+	StepId   pgtype.UUID `json:"stepId"`
+	ActionId string      `json:"actionId"`
+}
+
+// Lists the steps of the jobs of job runs, which a step run is created for in each job run.
+func (q *Queries) ListStepsForJobRuns(ctx context.Context, db DBTX, jobrunids []pgtype.UUID) ([]*ListStepsForJobRunsRow, error) {
+	rows, err := db.Query(ctx, listStepsForJobRuns, jobrunids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListStepsForJobRunsRow
+	for rows.Next() {
+		var i ListStepsForJobRunsRow
+		if err := rows.Scan(
+			&i.JobRunId,
+			&i.TenantId,
+			&i.StepId,
+			&i.ActionId,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWorkflowRunEventsByWorkflowRunId = `-- name: ListWorkflowRunEventsByWorkflowRunId :many
 SELECT
     sre.id, sre."timeFirstSeen", sre."timeLastSeen", sre."stepRunId", sre.reason, sre.severity, sre.message, sre.count, sre.data, sre."workflowRunId"
@@ -2461,10 +2625,10 @@ func (q *Queries) ListWorkflowRunEventsByWorkflowRunId(ctx context.Context, db D
 
 const listWorkflowRuns = `-- name: ListWorkflowRuns :many
 SELECT
-    runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."childIndex", runs."childKey", runs."parentId", runs."parentStepRunId", runs."additionalMetadata", runs.duration, runs.priority, runs."insertOrder",
+    runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."childIndex", runs."childKey", runs."parentId", runs."parentStepRunId", runs."additionalMetadata", runs.duration, runs.priority, runs."insertOrder", runs.output, runs."replayedFromId", runs."replayedFromStepId", runs."traceContext",
     workflow.id, workflow."createdAt", workflow."updatedAt", workflow."deletedAt", workflow."tenantId", workflow.name, workflow.description, workflow."isPaused",
     runtriggers.id, runtriggers."createdAt", runtriggers."updatedAt", runtriggers."deletedAt", runtriggers."tenantId", runtriggers."eventId", runtriggers."cronParentId", runtriggers."cronSchedule", runtriggers."scheduledId", runtriggers.input, runtriggers."parentId", runtriggers."cronName",
-    workflowversion.id, workflowversion."createdAt", workflowversion."updatedAt", workflowversion."deletedAt", workflowversion.version, workflowversion."order", workflowversion."workflowId", workflowversion.checksum, workflowversion."scheduleTimeout", workflowversion."onFailureJobId", workflowversion.sticky, workflowversion.kind, workflowversion."defaultPriority",
+    workflowversion.id, workflowversion."createdAt", workflowversion."updatedAt", workflowversion."deletedAt", workflowversion.version, workflowversion."order", workflowversion."workflowId", workflowversion.checksum, workflowversion."scheduleTimeout", workflowversion."onFailureJobId", workflowversion.sticky, workflowversion.kind, workflowversion."defaultPriority", workflowversion.region, workflowversion."regionStrategy", workflowversion."outputExpression", workflowversion."inputSchema", workflowversion."reservedSlots",
     -- waiting on https://github.com/sqlc-dev/sqlc/pull/2858 for nullable events field
     events.id, events.key, events."createdAt", events."updatedAt"
 FROM
@@ -2545,21 +2709,72 @@ WHERE
     (
         $15::timestamp IS NULL OR
         runs."finishedAt" <= $15::timestamp
+    ) AND
+    (
+        $16::uuid[] IS NULL OR
+        workflow."id" = ANY($16::uuid[])
+    ) AND
+    (
+        $17::text IS NULL OR
+        events."key" = $17::text
+    ) AND
+    -- only return the runs after the cursor in the sort order. Runs with the same sort value are ordered by id, and
+    -- null start and finish times are sorted last in ascending order, like in the order by.
+    (
+        $18::uuid IS NULL OR
+        ($19 = 'createdAt ASC' AND (
+            runs."createdAt" > $20::timestamp OR
+            (runs."createdAt" = $20::timestamp AND runs."id" > $18::uuid)
+        )) OR
+        ($19 = 'createdAt DESC' AND (
+            runs."createdAt" < $20::timestamp OR
+            (runs."createdAt" = $20::timestamp AND runs."id" > $18::uuid)
+        )) OR
+        ($19 = 'finishedAt ASC' AND (
+            runs."finishedAt" > $20::timestamp OR
+            (runs."finishedAt" IS NULL AND $20::timestamp IS NOT NULL) OR
+            (runs."finishedAt" IS NOT DISTINCT FROM $20::timestamp AND runs."id" > $18::uuid)
+        )) OR
+        ($19 = 'finishedAt DESC' AND (
+            runs."finishedAt" < $20::timestamp OR
+            (runs."finishedAt" IS NOT NULL AND $20::timestamp IS NULL) OR
+            (runs."finishedAt" IS NOT DISTINCT FROM $20::timestamp AND runs."id" > $18::uuid)
+        )) OR
+        ($19 = 'startedAt ASC' AND (
+            runs."startedAt" > $20::timestamp OR
+            (runs."startedAt" IS NULL AND $20::timestamp IS NOT NULL) OR
+            (runs."startedAt" IS NOT DISTINCT FROM $20::timestamp AND runs."id" > $18::uuid)
+        )) OR
+        ($19 = 'startedAt DESC' AND (
+            runs."startedAt" < $20::timestamp OR
+            (runs."startedAt" IS NOT NULL AND $20::timestamp IS NULL) OR
+            (runs."startedAt" IS NOT DISTINCT FROM $20::timestamp AND runs."id" > $18::uuid)
+        )) OR
+        ($19 = 'duration ASC' AND (
+            runs."duration" > $21::bigint OR
+            (runs."duration" IS NOT NULL AND $21::bigint IS NULL) OR
+            (runs."duration" IS NOT DISTINCT FROM $21::bigint AND runs."id" > $18::uuid)
+        )) OR
+        ($19 = 'duration DESC' AND (
+            runs."duration" < $21::bigint OR
+            (runs."duration" IS NULL AND $21::bigint IS NOT NULL) OR
+            (runs."duration" IS NOT DISTINCT FROM $21::bigint AND runs."id" > $18::uuid)
+        ))
     )
 ORDER BY
-    case when $16 = 'createdAt ASC' THEN runs."createdAt" END ASC ,
-    case when $16 = 'createdAt DESC' THEN runs."createdAt" END DESC,
-    case when $16 = 'finishedAt ASC' THEN runs."finishedAt" END ASC ,
-    case when $16 = 'finishedAt DESC' THEN runs."finishedAt" END DESC,
-    case when $16 = 'startedAt ASC' THEN runs."startedAt" END ASC ,
-    case when $16 = 'startedAt DESC' THEN runs."startedAt" END DESC,
-    case when $16 = 'duration ASC' THEN runs."duration" END ASC NULLS FIRST,
-    case when $16 = 'duration DESC' THEN runs."duration" END DESC NULLS LAST,
+    case when $19 = 'createdAt ASC' THEN runs."createdAt" END ASC ,
+    case when $19 = 'createdAt DESC' THEN runs."createdAt" END DESC,
+    case when $19 = 'finishedAt ASC' THEN runs."finishedAt" END ASC ,
+    case when $19 = 'finishedAt DESC' THEN runs."finishedAt" END DESC,
+    case when $19 = 'startedAt ASC' THEN runs."startedAt" END ASC ,
+    case when $19 = 'startedAt DESC' THEN runs."startedAt" END DESC,
+    case when $19 = 'duration ASC' THEN runs."duration" END ASC NULLS FIRST,
+    case when $19 = 'duration DESC' THEN runs."duration" END DESC NULLS LAST,
     runs."id" ASC
 OFFSET
-    COALESCE($17, 0)
+    COALESCE($22, 0)
 LIMIT
-    COALESCE($18, 50)
+    COALESCE($23, 50)
 `
 
 type ListWorkflowRunsParams struct {
@@ -2578,7 +2793,12 @@ type ListWorkflowRunsParams struct {
 	CreatedBefore      pgtype.Timestamp `json:"createdBefore"`
 	FinishedAfter      pgtype.Timestamp `json:"finishedAfter"`
 	FinishedBefore     pgtype.Timestamp `json:"finishedBefore"`
+	WorkflowIds        []pgtype.UUID    `json:"workflowIds"`
+	EventKey           pgtype.Text      `json:"eventKey"`
+	CursorId           pgtype.UUID      `json:"cursorId"`
 	Orderby            interface{}      `json:"orderby"`
+	CursorTime         pgtype.Timestamp `json:"cursorTime"`
+	CursorDuration     pgtype.Int8      `json:"cursorDuration"`
 	Offset             interface{}      `json:"offset"`
 	Limit              interface{}      `json:"limit"`
 }
@@ -2611,7 +2831,12 @@ func (q *Queries) ListWorkflowRuns(ctx context.Context, db DBTX, arg ListWorkflo
 		arg.CreatedBefore,
 		arg.FinishedAfter,
 		arg.FinishedBefore,
+		arg.WorkflowIds,
+		arg.EventKey,
+		arg.CursorId,
 		arg.Orderby,
+		arg.CursorTime,
+		arg.CursorDuration,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -2643,6 +2868,10 @@ func (q *Queries) ListWorkflowRuns(ctx context.Context, db DBTX, arg ListWorkflo
 			&i.WorkflowRun.Duration,
 			&i.WorkflowRun.Priority,
 			&i.WorkflowRun.InsertOrder,
+			&i.WorkflowRun.Output,
+			&i.WorkflowRun.ReplayedFromId,
+			&i.WorkflowRun.ReplayedFromStepId,
+			&i.WorkflowRun.TraceContext,
 			&i.Workflow.ID,
 			&i.Workflow.CreatedAt,
 			&i.Workflow.UpdatedAt,
@@ -2676,6 +2905,11 @@ func (q *Queries) ListWorkflowRuns(ctx context.Context, db DBTX, arg ListWorkflo
 			&i.WorkflowVersion.Sticky,
 			&i.WorkflowVersion.Kind,
 			&i.WorkflowVersion.DefaultPriority,
+			&i.WorkflowVersion.Region,
+			&i.WorkflowVersion.RegionStrategy,
+			&i.WorkflowVersion.OutputExpression,
+			&i.WorkflowVersion.InputSchema,
+			&i.WorkflowVersion.ReservedSlots,
 			&i.ID,
 			&i.Key,
 			&i.CreatedAt,
@@ -2691,6 +2925,94 @@ func (q *Queries) ListWorkflowRuns(ctx context.Context, db DBTX, arg ListWorkflo
 	return items, nil
 }
 
+const listWorkflowRunsForOutput = `-- name: ListWorkflowRunsForOutput :many
+SELECT
+    wr."id" AS "workflowRunId",
+    wv."outputExpression",
+    wv."checksum",
+    wr."additionalMetadata",
+    tb."input"
+FROM
+    "WorkflowRun" wr
+JOIN
+    "WorkflowVersion" wv ON wv."id" = wr."workflowVersionId"
+JOIN
+    "WorkflowRunTriggeredBy" tb ON tb."parentId" = wr."id"
+WHERE
+    wr."id" = ANY($1::uuid[])
+    AND wr."tenantId" = $2::uuid
+    AND wv."outputExpression" IS NOT NULL
+`
+
+type ListWorkflowRunsForOutputParams struct {
+	Workflowrunids []pgtype.UUID `json:"workflowrunids"`
+	Tenantid       pgtype.UUID   `json:"tenantid"`
+}
+
+type ListWorkflowRunsForOutputRow struct {
+	WorkflowRunId      pgtype.UUID `json:"workflowRunId"`
+	OutputExpression   pgtype.Text `json:"outputExpression"`
+	Checksum           string      `json:"checksum"`
+	AdditionalMetadata []byte      `json:"additionalMetadata"`
+	Input              []byte      `json:"input"`
+}
+
+// Lists the data used to evaluate the output expression of each workflow run. Workflow runs whose workflow
+// version has no output expression are skipped.
+func (q *Queries) ListWorkflowRunsForOutput(ctx context.Context, db DBTX, arg ListWorkflowRunsForOutputParams) ([]*ListWorkflowRunsForOutputRow, error) {
+	rows, err := db.Query(ctx, listWorkflowRunsForOutput, arg.Workflowrunids, arg.Tenantid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkflowRunsForOutputRow
+	for rows.Next() {
+		var i ListWorkflowRunsForOutputRow
+		if err := rows.Scan(
+			&i.WorkflowRunId,
+			&i.OutputExpression,
+			&i.Checksum,
+			&i.AdditionalMetadata,
+			&i.Input,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const pauseWorkflowRun = `-- name: PauseWorkflowRun :one
+UPDATE
+    "WorkflowRun"
+SET
+    "status" = 'PAUSED',
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = $1::uuid
+    AND "tenantId" = $2::uuid
+    AND "status" = 'RUNNING'
+    AND "deletedAt" IS NULL
+RETURNING "id"
+`
+
+type PauseWorkflowRunParams struct {
+	Workflowrunid pgtype.UUID `json:"workflowrunid"`
+	Tenantid      pgtype.UUID `json:"tenantid"`
+}
+
+// Pauses a running workflow run. The queue items of a paused workflow run are not listed by the scheduler until
+// the workflow run is resumed.
+func (q *Queries) PauseWorkflowRun(ctx context.Context, db DBTX, arg PauseWorkflowRunParams) (pgtype.UUID, error) {
+	row := db.QueryRow(ctx, pauseWorkflowRun, arg.Workflowrunid, arg.Tenantid)
+	var id pgtype.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
 const popWorkflowRunsRoundRobin = `-- name: PopWorkflowRunsRoundRobin :many
 WITH workflow_runs AS (
     SELECT
@@ -2752,7 +3074,7 @@ WHERE
     "WorkflowRun".id = eligible_runs.id AND
     "WorkflowRun"."status" = 'QUEUED'
 RETURNING
-    "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."childIndex", "WorkflowRun"."childKey", "WorkflowRun"."parentId", "WorkflowRun"."parentStepRunId", "WorkflowRun"."additionalMetadata", "WorkflowRun".duration, "WorkflowRun".priority, "WorkflowRun"."insertOrder"
+    "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."childIndex", "WorkflowRun"."childKey", "WorkflowRun"."parentId", "WorkflowRun"."parentStepRunId", "WorkflowRun"."additionalMetadata", "WorkflowRun".duration, "WorkflowRun".priority, "WorkflowRun"."insertOrder", "WorkflowRun".output, "WorkflowRun"."replayedFromId", "WorkflowRun"."replayedFromStepId", "WorkflowRun"."traceContext"
 `
 
 type PopWorkflowRunsRoundRobinParams struct {
@@ -2791,6 +3113,10 @@ func (q *Queries) PopWorkflowRunsRoundRobin(ctx context.Context, db DBTX, arg Po
 			&i.Duration,
 			&i.Priority,
 			&i.InsertOrder,
+			&i.Output,
+			&i.ReplayedFromId,
+			&i.ReplayedFromStepId,
+			&i.TraceContext,
 		); err != nil {
 			return nil, err
 		}
@@ -2802,6 +3128,61 @@ func (q *Queries) PopWorkflowRunsRoundRobin(ctx context.Context, db DBTX, arg Po
 	return items, nil
 }
 
+const preflightCheckReplayFromStep = `-- name: PreflightCheckReplayFromStep :one
+WITH RECURSIVE upstream AS (
+    SELECT so."A" AS "stepId"
+    FROM "_StepOrder" so
+    WHERE so."B" = $1::uuid
+    UNION
+    SELECT so."A"
+    FROM "_StepOrder" so
+    JOIN upstream u ON so."B" = u."stepId"
+), step_runs AS (
+    SELECT sr."stepId", sr."status"
+    FROM "StepRun" sr
+    JOIN "JobRun" jr ON jr."id" = sr."jobRunId"
+    WHERE
+        jr."workflowRunId" = $2::uuid
+        AND jr."tenantId" = $3::uuid
+        AND sr."deletedAt" IS NULL
+)
+SELECT
+    EXISTS (
+        SELECT 1
+        FROM step_runs
+        WHERE "stepId" = $1::uuid
+    ) AS "hasStepRun",
+    (
+        SELECT COUNT(*)
+        FROM upstream u
+        WHERE NOT EXISTS (
+            SELECT 1
+            FROM step_runs sr
+            WHERE sr."stepId" = u."stepId" AND sr."status" = 'SUCCEEDED'
+        )
+    ) AS "unsucceededUpstreamSteps"
+`
+
+type PreflightCheckReplayFromStepParams struct {
+	Stepid        pgtype.UUID `json:"stepid"`
+	Workflowrunid pgtype.UUID `json:"workflowrunid"`
+	Tenantid      pgtype.UUID `json:"tenantid"`
+}
+
+type PreflightCheckReplayFromStepRow struct {
+	HasStepRun               bool  `json:"hasStepRun"`
+	UnsucceededUpstreamSteps int64 `json:"unsucceededUpstreamSteps"`
+}
+
+// Checks whether the workflow run has a step run for the step, and counts the steps upstream of the step which did
+// not succeed in the workflow run
+func (q *Queries) PreflightCheckReplayFromStep(ctx context.Context, db DBTX, arg PreflightCheckReplayFromStepParams) (*PreflightCheckReplayFromStepRow, error) {
+	row := db.QueryRow(ctx, preflightCheckReplayFromStep, arg.Stepid, arg.Workflowrunid, arg.Tenantid)
+	var i PreflightCheckReplayFromStepRow
+	err := row.Scan(&i.HasStepRun, &i.UnsucceededUpstreamSteps)
+	return &i, err
+}
+
 const replayWorkflowRunResetJobRun = `-- name: ReplayWorkflowRunResetJobRun :one
 UPDATE
     "JobRun"
@@ -2867,19 +3248,31 @@ WITH jobRuns AS (
         -- we should not include onFailure jobs in the calculation
         job."kind" = 'DEFAULT'
     GROUP BY runs."workflowRunId"
+), resolved AS (
+    SELECT
+        j."workflowRunId", j.pendingruns, j.runningruns, j.succeededruns, j.failedruns, j.cancelledruns,
+        CASE
+            -- Paused workflow runs stay paused while job runs are running, until they are resumed
+            WHEN wr."status" = 'PAUSED' AND j.runningRuns > 0 THEN wr."status"
+            -- We check for running first, because if a job run is running, then the workflow is running
+            WHEN j.runningRuns > 0 THEN 'RUNNING'
+            -- When at least one job run has failed or been cancelled, then the workflow is failed
+            WHEN j.failedRuns > 0 OR j.cancelledRuns > 0 THEN 'FAILED'
+            -- When all job runs have succeeded, then the workflow is succeeded
+            WHEN j.succeededRuns > 0 AND j.pendingRuns = 0 AND j.runningRuns = 0 AND j.failedRuns = 0 AND j.cancelledRuns = 0 THEN 'SUCCEEDED'
+            ELSE wr."status"
+        END::"WorkflowRunStatus" AS "status"
+    FROM
+        jobRuns j
+    JOIN
+        "WorkflowRun" wr ON wr."id" = j."workflowRunId"
+), transitions AS (
+    SELECT
+        unnest($3::"WorkflowRunStatus"[]) AS "from",
+        unnest($4::"WorkflowRunStatus"[]) AS "to"
 ), updated_workflow_runs AS (
     UPDATE "WorkflowRun" wr
-    SET "status" = CASE
-        -- Final states are final, cannot be updated
-        WHEN "status" IN ('SUCCEEDED', 'FAILED') THEN "status"
-        -- We check for running first, because if a job run is running, then the workflow is running
-        WHEN j.runningRuns > 0 THEN 'RUNNING'
-        -- When at least one job run has failed or been cancelled, then the workflow is failed
-        WHEN j.failedRuns > 0 OR j.cancelledRuns > 0 THEN 'FAILED'
-        -- When all job runs have succeeded, then the workflow is succeeded
-        WHEN j.succeededRuns > 0 AND j.pendingRuns = 0 AND j.runningRuns = 0 AND j.failedRuns = 0 AND j.cancelledRuns = 0 THEN 'SUCCEEDED'
-        ELSE "status"
-    END,
+    SET "status" = j."status",
     "finishedAt" = CASE
         -- Final states are final, cannot be updated
         WHEN "finishedAt" IS NOT NULL THEN "finishedAt"
@@ -2907,10 +3300,18 @@ WITH jobRuns AS (
         ELSE "duration"
     END
     FROM
-        jobRuns j
+        resolved j
     WHERE
         wr."id" = j."workflowRunId"
-        AND "tenantId" = $2::uuid
+        AND wr."tenantId" = $2::uuid
+        AND (
+            wr."status" = j."status"
+            OR EXISTS (
+                SELECT 1
+                FROM transitions t
+                WHERE t."from" = wr."status" AND t."to" = j."status"
+            )
+        )
     RETURNING wr."id", wr."status", wr."tenantId"
 )
 SELECT DISTINCT "id", "status", "tenantId"
@@ -2919,8 +3320,10 @@ WHERE "status" IN ('SUCCEEDED', 'FAILED')
 `
 
 type ResolveWorkflowRunStatusParams struct {
-	Jobrunids []pgtype.UUID `json:"jobrunids"`
-	Tenantid  pgtype.UUID   `json:"tenantid"`
+	Jobrunids       []pgtype.UUID       `json:"jobrunids"`
+	Tenantid        pgtype.UUID         `json:"tenantid"`
+	Transitionfroms []WorkflowRunStatus `json:"transitionfroms"`
+	Transitiontos   []WorkflowRunStatus `json:"transitiontos"`
 }
 
 type ResolveWorkflowRunStatusRow struct {
@@ -2929,9 +3332,17 @@ type ResolveWorkflowRunStatusRow struct {
 	TenantId pgtype.UUID       `json:"tenantId"`
 }
 
+// Resolves the status of the workflow runs of the job runs from the statuses of their job runs. The status is only
+// written if the workflow run is allowed to transition to it, which is the case if the transition is one of the pairs
+// of transitionFroms and transitionTos at the same index.
 // Return distinct workflow run ids in a final state
 func (q *Queries) ResolveWorkflowRunStatus(ctx context.Context, db DBTX, arg ResolveWorkflowRunStatusParams) ([]*ResolveWorkflowRunStatusRow, error) {
-	rows, err := db.Query(ctx, resolveWorkflowRunStatus, arg.Jobrunids, arg.Tenantid)
+	rows, err := db.Query(ctx, resolveWorkflowRunStatus,
+		arg.Jobrunids,
+		arg.Tenantid,
+		arg.Transitionfroms,
+		arg.Transitiontos,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -2950,6 +3361,213 @@ func (q *Queries) ResolveWorkflowRunStatus(ctx context.Context, db DBTX, arg Res
 	return items, nil
 }
 
+const resumeWorkflowRun = `-- name: ResumeWorkflowRun :one
+UPDATE
+    "WorkflowRun"
+SET
+    "status" = 'RUNNING',
+    "updatedAt" = CURRENT_TIMESTAMP
+WHERE
+    "id" = $1::uuid
+    AND "tenantId" = $2::uuid
+    AND "status" = 'PAUSED'
+    AND "deletedAt" IS NULL
+RETURNING "id"
+`
+
+type ResumeWorkflowRunParams struct {
+	Workflowrunid pgtype.UUID `json:"workflowrunid"`
+	Tenantid      pgtype.UUID `json:"tenantid"`
+}
+
+// Resumes a paused workflow run.
+func (q *Queries) ResumeWorkflowRun(ctx context.Context, db DBTX, arg ResumeWorkflowRunParams) (pgtype.UUID, error) {
+	row := db.QueryRow(ctx, resumeWorkflowRun, arg.Workflowrunid, arg.Tenantid)
+	var id pgtype.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const reuseUpstreamStepRunOutputs = `-- name: ReuseUpstreamStepRunOutputs :many
+WITH RECURSIVE upstream AS (
+    SELECT so."A" AS "stepId"
+    FROM "_StepOrder" so
+    WHERE so."B" = $2::uuid
+    UNION
+    SELECT so."A"
+    FROM "_StepOrder" so
+    JOIN upstream u ON so."B" = u."stepId"
+), replayed_step_runs AS (
+    SELECT
+        sr."stepId",
+        sr."output"
+    FROM "StepRun" sr
+    JOIN "JobRun" jr ON jr."id" = sr."jobRunId"
+    WHERE
+        jr."workflowRunId" = $3::uuid
+        AND sr."stepId" IN (SELECT "stepId" FROM upstream)
+        AND sr."status" = 'SUCCEEDED'
+        AND sr."deletedAt" IS NULL
+        AND NOT EXISTS (
+            SELECT 1
+            FROM "StepRunMapItem" mi
+            WHERE mi."stepRunId" = sr."id"
+        )
+)
+UPDATE
+    "StepRun" sr
+SET
+    "status" = 'SUCCEEDED',
+    "output" = rsr."output",
+    "startedAt" = CURRENT_TIMESTAMP,
+    "finishedAt" = CURRENT_TIMESTAMP,
+    "updatedAt" = CURRENT_TIMESTAMP
+FROM
+    replayed_step_runs rsr,
+    "JobRun" jr
+WHERE
+    sr."jobRunId" = jr."id"
+    AND jr."workflowRunId" = $1::uuid
+    AND sr."stepId" = rsr."stepId"
+RETURNING
+    sr."id",
+    sr."output"
+`
+
+type ReuseUpstreamStepRunOutputsParams struct {
+	Workflowrunid  pgtype.UUID `json:"workflowrunid"`
+	Stepid         pgtype.UUID `json:"stepid"`
+	Replayedfromid pgtype.UUID `json:"replayedfromid"`
+}
+
+type ReuseUpstreamStepRunOutputsRow struct {
+	ID     pgtype.UUID `json:"id"`
+	Output []byte      `json:"output"`
+}
+
+// Marks the step runs of a replayed workflow run which are upstream of the step it is replayed from as succeeded,
+// reusing the outputs of the step runs of the workflow run it replays
+func (q *Queries) ReuseUpstreamStepRunOutputs(ctx context.Context, db DBTX, arg ReuseUpstreamStepRunOutputsParams) ([]*ReuseUpstreamStepRunOutputsRow, error) {
+	rows, err := db.Query(ctx, reuseUpstreamStepRunOutputs, arg.Workflowrunid, arg.Stepid, arg.Replayedfromid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ReuseUpstreamStepRunOutputsRow
+	for rows.Next() {
+		var i ReuseUpstreamStepRunOutputsRow
+		if err := rows.Scan(&i.ID, &i.Output); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setReplayedStepRunInput = `-- name: SetReplayedStepRunInput :exec
+UPDATE
+    "StepRun" sr
+SET
+    "input" = $1::jsonb
+FROM
+    "JobRun" jr
+WHERE
+    sr."jobRunId" = jr."id"
+    AND jr."workflowRunId" = $2::uuid
+    AND sr."stepId" = $3::uuid
+`
+
+type SetReplayedStepRunInputParams struct {
+	Input         []byte      `json:"input"`
+	Workflowrunid pgtype.UUID `json:"workflowrunid"`
+	Stepid        pgtype.UUID `json:"stepid"`
+}
+
+func (q *Queries) SetReplayedStepRunInput(ctx context.Context, db DBTX, arg SetReplayedStepRunInputParams) error {
+	_, err := db.Exec(ctx, setReplayedStepRunInput, arg.Input, arg.Workflowrunid, arg.Stepid)
+	return err
+}
+
+const setStepRunDeadlinesForWorkflowRuns = `-- name: SetStepRunDeadlinesForWorkflowRuns :exec
+UPDATE
+    "StepRun" sr
+SET
+    "deadline" = input."deadline"
+FROM (
+    SELECT
+        unnest($1::uuid[]) AS "workflowRunId",
+        unnest($2::timestamp[]) AS "deadline"
+    ) AS input
+JOIN
+    "JobRun" jr ON jr."workflowRunId" = input."workflowRunId"
+WHERE
+    sr."jobRunId" = jr."id"
+`
+
+type SetStepRunDeadlinesForWorkflowRunsParams struct {
+	Workflowrunids []pgtype.UUID      `json:"workflowrunids"`
+	Deadlines      []pgtype.Timestamp `json:"deadlines"`
+}
+
+func (q *Queries) SetStepRunDeadlinesForWorkflowRuns(ctx context.Context, db DBTX, arg SetStepRunDeadlinesForWorkflowRunsParams) error {
+	_, err := db.Exec(ctx, setStepRunDeadlinesForWorkflowRuns, arg.Workflowrunids, arg.Deadlines)
+	return err
+}
+
+const setWorkflowRunsReplayedFrom = `-- name: SetWorkflowRunsReplayedFrom :exec
+UPDATE
+    "WorkflowRun" wr
+SET
+    "replayedFromId" = input."replayedFromId",
+    "replayedFromStepId" = input."replayedFromStepId"
+FROM (
+    SELECT
+        unnest($1::uuid[]) AS "workflowRunId",
+        unnest($2::uuid[]) AS "replayedFromId",
+        unnest($3::uuid[]) AS "replayedFromStepId"
+    ) AS input
+WHERE
+    wr."id" = input."workflowRunId"
+`
+
+type SetWorkflowRunsReplayedFromParams struct {
+	Workflowrunids      []pgtype.UUID `json:"workflowrunids"`
+	Replayedfromids     []pgtype.UUID `json:"replayedfromids"`
+	Replayedfromstepids []pgtype.UUID `json:"replayedfromstepids"`
+}
+
+func (q *Queries) SetWorkflowRunsReplayedFrom(ctx context.Context, db DBTX, arg SetWorkflowRunsReplayedFromParams) error {
+	_, err := db.Exec(ctx, setWorkflowRunsReplayedFrom, arg.Workflowrunids, arg.Replayedfromids, arg.Replayedfromstepids)
+	return err
+}
+
+const setWorkflowRunsTraceContext = `-- name: SetWorkflowRunsTraceContext :exec
+UPDATE
+    "WorkflowRun" wr
+SET
+    "traceContext" = input."traceContext"
+FROM (
+    SELECT
+        unnest($1::uuid[]) AS "workflowRunId",
+        unnest($2::jsonb[]) AS "traceContext"
+    ) AS input
+WHERE
+    wr."id" = input."workflowRunId"
+`
+
+type SetWorkflowRunsTraceContextParams struct {
+	Workflowrunids []pgtype.UUID `json:"workflowrunids"`
+	Tracecontexts  [][]byte      `json:"tracecontexts"`
+}
+
+func (q *Queries) SetWorkflowRunsTraceContext(ctx context.Context, db DBTX, arg SetWorkflowRunsTraceContextParams) error {
+	_, err := db.Exec(ctx, setWorkflowRunsTraceContext, arg.Workflowrunids, arg.Tracecontexts)
+	return err
+}
+
 const softDeleteExpiredWorkflowRunsWithDependencies = `-- name: SoftDeleteExpiredWorkflowRunsWithDependencies :one
 WITH for_delete AS (
     SELECT
@@ -3050,19 +3668,26 @@ SET
     "duration" = COALESCE($4::timestamp, "finishedAt") - COALESCE($3::timestamp, "startedAt")
 WHERE
     "tenantId" = $5::uuid AND
-    "id" = ANY($6::uuid[])
-RETURNING "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."childIndex", "WorkflowRun"."childKey", "WorkflowRun"."parentId", "WorkflowRun"."parentStepRunId", "WorkflowRun"."additionalMetadata", "WorkflowRun".duration, "WorkflowRun".priority, "WorkflowRun"."insertOrder"
+    "id" = ANY($6::uuid[]) AND
+    (
+        $1::"WorkflowRunStatus" IS NULL OR
+        "status" = ANY($7::"WorkflowRunStatus"[])
+    )
+RETURNING "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."childIndex", "WorkflowRun"."childKey", "WorkflowRun"."parentId", "WorkflowRun"."parentStepRunId", "WorkflowRun"."additionalMetadata", "WorkflowRun".duration, "WorkflowRun".priority, "WorkflowRun"."insertOrder", "WorkflowRun".output, "WorkflowRun"."replayedFromId", "WorkflowRun"."replayedFromStepId", "WorkflowRun"."traceContext"
 `
 
 type UpdateManyWorkflowRunParams struct {
-	Status     NullWorkflowRunStatus `json:"status"`
-	Error      pgtype.Text           `json:"error"`
-	StartedAt  pgtype.Timestamp      `json:"startedAt"`
-	FinishedAt pgtype.Timestamp      `json:"finishedAt"`
-	Tenantid   pgtype.UUID           `json:"tenantid"`
-	Ids        []pgtype.UUID         `json:"ids"`
+	Status       NullWorkflowRunStatus `json:"status"`
+	Error        pgtype.Text           `json:"error"`
+	StartedAt    pgtype.Timestamp      `json:"startedAt"`
+	FinishedAt   pgtype.Timestamp      `json:"finishedAt"`
+	Tenantid     pgtype.UUID           `json:"tenantid"`
+	Ids          []pgtype.UUID         `json:"ids"`
+	Fromstatuses []WorkflowRunStatus   `json:"fromstatuses"`
 }
 
+// Updates the workflow runs. If the status is set, only the workflow runs which are in one of the given statuses are
+// updated.
 func (q *Queries) UpdateManyWorkflowRun(ctx context.Context, db DBTX, arg UpdateManyWorkflowRunParams) ([]*WorkflowRun, error) {
 	rows, err := db.Query(ctx, updateManyWorkflowRun,
 		arg.Status,
@@ -3071,6 +3696,7 @@ func (q *Queries) UpdateManyWorkflowRun(ctx context.Context, db DBTX, arg Update
 		arg.FinishedAt,
 		arg.Tenantid,
 		arg.Ids,
+		arg.Fromstatuses,
 	)
 	if err != nil {
 		return nil, err
@@ -3100,6 +3726,10 @@ func (q *Queries) UpdateManyWorkflowRun(ctx context.Context, db DBTX, arg Update
 			&i.Duration,
 			&i.Priority,
 			&i.InsertOrder,
+			&i.Output,
+			&i.ReplayedFromId,
+			&i.ReplayedFromStepId,
+			&i.TraceContext,
 		); err != nil {
 			return nil, err
 		}
@@ -3133,8 +3763,8 @@ UPDATE
     "WorkflowRun"
 SET
     "status" = CASE
-    -- Final states are final, cannot be updated
-        WHEN "status" IN ('SUCCEEDED', 'FAILED') THEN "status"
+        -- Paused workflow runs are only set to running when they are resumed
+        WHEN "status" = 'PAUSED' AND $1::"WorkflowRunStatus" = 'RUNNING' THEN "status"
         ELSE COALESCE($1::"WorkflowRunStatus", "status")
     END,
     "error" = COALESCE($2::text, "error"),
@@ -3145,19 +3775,26 @@ SET
 
 WHERE
     "id" = $5::uuid AND
-    "tenantId" = $6::uuid
-RETURNING "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."childIndex", "WorkflowRun"."childKey", "WorkflowRun"."parentId", "WorkflowRun"."parentStepRunId", "WorkflowRun"."additionalMetadata", "WorkflowRun".duration, "WorkflowRun".priority, "WorkflowRun"."insertOrder"
+    "tenantId" = $6::uuid AND
+    (
+        $1::"WorkflowRunStatus" IS NULL OR
+        "status" = ANY($7::"WorkflowRunStatus"[])
+    )
+RETURNING "WorkflowRun"."createdAt", "WorkflowRun"."updatedAt", "WorkflowRun"."deletedAt", "WorkflowRun"."tenantId", "WorkflowRun"."workflowVersionId", "WorkflowRun".status, "WorkflowRun".error, "WorkflowRun"."startedAt", "WorkflowRun"."finishedAt", "WorkflowRun"."concurrencyGroupId", "WorkflowRun"."displayName", "WorkflowRun".id, "WorkflowRun"."childIndex", "WorkflowRun"."childKey", "WorkflowRun"."parentId", "WorkflowRun"."parentStepRunId", "WorkflowRun"."additionalMetadata", "WorkflowRun".duration, "WorkflowRun".priority, "WorkflowRun"."insertOrder", "WorkflowRun".output, "WorkflowRun"."replayedFromId", "WorkflowRun"."replayedFromStepId", "WorkflowRun"."traceContext"
 `
 
 type UpdateWorkflowRunParams struct {
-	Status     NullWorkflowRunStatus `json:"status"`
-	Error      pgtype.Text           `json:"error"`
-	StartedAt  pgtype.Timestamp      `json:"startedAt"`
-	FinishedAt pgtype.Timestamp      `json:"finishedAt"`
-	ID         pgtype.UUID           `json:"id"`
-	Tenantid   pgtype.UUID           `json:"tenantid"`
+	Status       NullWorkflowRunStatus `json:"status"`
+	Error        pgtype.Text           `json:"error"`
+	StartedAt    pgtype.Timestamp      `json:"startedAt"`
+	FinishedAt   pgtype.Timestamp      `json:"finishedAt"`
+	ID           pgtype.UUID           `json:"id"`
+	Tenantid     pgtype.UUID           `json:"tenantid"`
+	Fromstatuses []WorkflowRunStatus   `json:"fromstatuses"`
 }
 
+// Updates the workflow run. If the status is set, the workflow run is only updated if it's in one of the given
+// statuses.
 func (q *Queries) UpdateWorkflowRun(ctx context.Context, db DBTX, arg UpdateWorkflowRunParams) (*WorkflowRun, error) {
 	row := db.QueryRow(ctx, updateWorkflowRun,
 		arg.Status,
@@ -3166,6 +3803,7 @@ func (q *Queries) UpdateWorkflowRun(ctx context.Context, db DBTX, arg UpdateWork
 		arg.FinishedAt,
 		arg.ID,
 		arg.Tenantid,
+		arg.Fromstatuses,
 	)
 	var i WorkflowRun
 	err := row.Scan(
@@ -3189,6 +3827,10 @@ func (q *Queries) UpdateWorkflowRun(ctx context.Context, db DBTX, arg UpdateWork
 		&i.Duration,
 		&i.Priority,
 		&i.InsertOrder,
+		&i.Output,
+		&i.ReplayedFromId,
+		&i.ReplayedFromStepId,
+		&i.TraceContext,
 	)
 	return &i, err
 }
@@ -3196,36 +3838,46 @@ func (q *Queries) UpdateWorkflowRun(ctx context.Context, db DBTX, arg UpdateWork
 const updateWorkflowRunGroupKeyFromExpr = `-- name: UpdateWorkflowRunGroupKeyFromExpr :one
 UPDATE "WorkflowRun" wr
 SET "error" = CASE
-    -- Final states are final, cannot be updated. We also can't move out of a queued state
-    WHEN "status" IN ('SUCCEEDED', 'FAILED', 'QUEUED') THEN "error"
-    WHEN $1::text IS NOT NULL THEN $1::text
+    WHEN "status" = 'QUEUED' THEN "error"
+    WHEN $1::text IS NOT NULL AND "status" = ANY($2::"WorkflowRunStatus"[]) THEN $1::text
     ELSE "error"
 END,
 "status" = CASE
-    -- Final states are final, cannot be updated. We also can't move out of a queued state
-    WHEN "status" IN ('SUCCEEDED', 'FAILED', 'QUEUED') THEN "status"
+    WHEN "status" = 'QUEUED' THEN "status"
     -- When the concurrency expression errored, then the workflow is failed
-    WHEN $1::text IS NOT NULL THEN 'FAILED'
+    WHEN $1::text IS NOT NULL THEN
+        CASE WHEN "status" = ANY($2::"WorkflowRunStatus"[]) THEN 'FAILED' ELSE "status" END
     -- When the expression evaluated successfully, then queue the workflow run
-    ELSE 'QUEUED'
+    WHEN "status" = ANY($3::"WorkflowRunStatus"[]) THEN 'QUEUED'
+    ELSE "status"
 END,
 "concurrencyGroupId" = CASE
-    WHEN $2::text IS NOT NULL THEN $2::text
+    WHEN $4::text IS NOT NULL THEN $4::text
     ELSE "concurrencyGroupId"
 END
 WHERE
-    wr."id" = $3::uuid
+    wr."id" = $5::uuid
 RETURNING wr."id"
 `
 
 type UpdateWorkflowRunGroupKeyFromExprParams struct {
-	Error              pgtype.Text `json:"error"`
-	ConcurrencyGroupId pgtype.Text `json:"concurrencyGroupId"`
-	Workflowrunid      pgtype.UUID `json:"workflowrunid"`
+	Error              pgtype.Text         `json:"error"`
+	Failedfromstatuses []WorkflowRunStatus `json:"failedfromstatuses"`
+	Queuedfromstatuses []WorkflowRunStatus `json:"queuedfromstatuses"`
+	ConcurrencyGroupId pgtype.Text         `json:"concurrencyGroupId"`
+	Workflowrunid      pgtype.UUID         `json:"workflowrunid"`
 }
 
+// The workflow run is only failed if it's in one of failedFromStatuses, and only queued if it's in one of
+// queuedFromStatuses. A queued workflow run already has its group key, so it's never updated.
 func (q *Queries) UpdateWorkflowRunGroupKeyFromExpr(ctx context.Context, db DBTX, arg UpdateWorkflowRunGroupKeyFromExprParams) (pgtype.UUID, error) {
-	row := db.QueryRow(ctx, updateWorkflowRunGroupKeyFromExpr, arg.Error, arg.ConcurrencyGroupId, arg.Workflowrunid)
+	row := db.QueryRow(ctx, updateWorkflowRunGroupKeyFromExpr,
+		arg.Error,
+		arg.Failedfromstatuses,
+		arg.Queuedfromstatuses,
+		arg.ConcurrencyGroupId,
+		arg.Workflowrunid,
+	)
 	var id pgtype.UUID
 	err := row.Scan(&id)
 	return id, err
@@ -3236,30 +3888,30 @@ WITH groupKeyRun AS (
     SELECT "id", "status" as groupKeyRunStatus, "output", "workflowRunId"
     FROM "GetGroupKeyRun" as groupKeyRun
     WHERE
-        "id" = $2::uuid AND
-        "tenantId" = $1::uuid AND
+        "id" = $4::uuid AND
+        "tenantId" = $3::uuid AND
         "deletedAt" IS NULL
 )
 UPDATE "WorkflowRun" workflowRun
 SET "status" = CASE
-    -- Final states are final, cannot be updated. We also can't move out of a queued state
-    WHEN "status" IN ('SUCCEEDED', 'FAILED', 'QUEUED') THEN "status"
+    WHEN "status" = 'QUEUED' THEN "status"
     -- When the GetGroupKeyRun failed or been cancelled, then the workflow is failed
-    WHEN groupKeyRun.groupKeyRunStatus IN ('FAILED', 'CANCELLED') THEN 'FAILED'
-    WHEN groupKeyRun.output IS NOT NULL THEN 'QUEUED'
+    WHEN groupKeyRun.groupKeyRunStatus IN ('FAILED', 'CANCELLED') THEN
+        CASE WHEN "status" = ANY($1::"WorkflowRunStatus"[]) THEN 'FAILED' ELSE "status" END
+    WHEN groupKeyRun.output IS NOT NULL AND "status" = ANY($2::"WorkflowRunStatus"[]) THEN 'QUEUED'
     ELSE "status"
 END,
 "finishedAt" = CASE
     -- Final states are final, cannot be updated
     WHEN "finishedAt" IS NOT NULL THEN "finishedAt"
     -- When one job run has failed or been cancelled, then the workflow is failed
-    WHEN groupKeyRun.groupKeyRunStatus IN ('FAILED', 'CANCELLED') THEN NOW()
+    WHEN groupKeyRun.groupKeyRunStatus IN ('FAILED', 'CANCELLED') AND "status" = ANY($1::"WorkflowRunStatus"[]) THEN NOW()
     ELSE "finishedAt"
 END,
 "duration" = CASE
     -- duration is final, cannot be changed
     WHEN "duration" IS NOT NULL THEN "duration"
-    WHEN "startedAt" IS NOT NULL AND groupKeyRun.groupKeyRunStatus IN ('FAILED', 'CANCELLED') THEN
+    WHEN "startedAt" IS NOT NULL AND groupKeyRun.groupKeyRunStatus IN ('FAILED', 'CANCELLED') AND "status" = ANY($1::"WorkflowRunStatus"[]) THEN
                 EXTRACT(EPOCH FROM (NOW() - "startedAt")) * 1000
     ELSE "duration"
 END,
@@ -3268,17 +3920,26 @@ FROM
     groupKeyRun
 WHERE
 workflowRun."id" = groupKeyRun."workflowRunId" AND
-workflowRun."tenantId" = $1::uuid
-RETURNING workflowrun."createdAt", workflowrun."updatedAt", workflowrun."deletedAt", workflowrun."tenantId", workflowrun."workflowVersionId", workflowrun.status, workflowrun.error, workflowrun."startedAt", workflowrun."finishedAt", workflowrun."concurrencyGroupId", workflowrun."displayName", workflowrun.id, workflowrun."childIndex", workflowrun."childKey", workflowrun."parentId", workflowrun."parentStepRunId", workflowrun."additionalMetadata", workflowrun.duration, workflowrun.priority, workflowrun."insertOrder"
+workflowRun."tenantId" = $3::uuid
+RETURNING workflowrun."createdAt", workflowrun."updatedAt", workflowrun."deletedAt", workflowrun."tenantId", workflowrun."workflowVersionId", workflowrun.status, workflowrun.error, workflowrun."startedAt", workflowrun."finishedAt", workflowrun."concurrencyGroupId", workflowrun."displayName", workflowrun.id, workflowrun."childIndex", workflowrun."childKey", workflowrun."parentId", workflowrun."parentStepRunId", workflowrun."additionalMetadata", workflowrun.duration, workflowrun.priority, workflowrun."insertOrder", workflowrun.output, workflowrun."replayedFromId", workflowrun."replayedFromStepId", workflowrun."traceContext"
 `
 
 type UpdateWorkflowRunGroupKeyFromRunParams struct {
-	Tenantid      pgtype.UUID `json:"tenantid"`
-	Groupkeyrunid pgtype.UUID `json:"groupkeyrunid"`
+	Failedfromstatuses []WorkflowRunStatus `json:"failedfromstatuses"`
+	Queuedfromstatuses []WorkflowRunStatus `json:"queuedfromstatuses"`
+	Tenantid           pgtype.UUID         `json:"tenantid"`
+	Groupkeyrunid      pgtype.UUID         `json:"groupkeyrunid"`
 }
 
+// The workflow run is only failed if it's in one of failedFromStatuses, and only queued if it's in one of
+// queuedFromStatuses. A queued workflow run already has its group key, so it's never updated.
 func (q *Queries) UpdateWorkflowRunGroupKeyFromRun(ctx context.Context, db DBTX, arg UpdateWorkflowRunGroupKeyFromRunParams) (*WorkflowRun, error) {
-	row := db.QueryRow(ctx, updateWorkflowRunGroupKeyFromRun, arg.Tenantid, arg.Groupkeyrunid)
+	row := db.QueryRow(ctx, updateWorkflowRunGroupKeyFromRun,
+		arg.Failedfromstatuses,
+		arg.Queuedfromstatuses,
+		arg.Tenantid,
+		arg.Groupkeyrunid,
+	)
 	var i WorkflowRun
 	err := row.Scan(
 		&i.CreatedAt,
@@ -3301,10 +3962,42 @@ func (q *Queries) UpdateWorkflowRunGroupKeyFromRun(ctx context.Context, db DBTX,
 		&i.Duration,
 		&i.Priority,
 		&i.InsertOrder,
+		&i.Output,
+		&i.ReplayedFromId,
+		&i.ReplayedFromStepId,
+		&i.TraceContext,
 	)
 	return &i, err
 }
 
+const updateWorkflowRunOutputs = `-- name: UpdateWorkflowRunOutputs :exec
+WITH input AS (
+    SELECT
+        unnest($2::uuid[]) AS "id",
+        unnest($3::jsonb[]) AS "output"
+)
+UPDATE
+    "WorkflowRun" wr
+SET
+    "output" = input."output"
+FROM
+    input
+WHERE
+    wr."id" = input."id"
+    AND wr."tenantId" = $1::uuid
+`
+
+type UpdateWorkflowRunOutputsParams struct {
+	Tenantid       pgtype.UUID   `json:"tenantid"`
+	Workflowrunids []pgtype.UUID `json:"workflowrunids"`
+	Outputs        [][]byte      `json:"outputs"`
+}
+
+func (q *Queries) UpdateWorkflowRunOutputs(ctx context.Context, db DBTX, arg UpdateWorkflowRunOutputsParams) error {
+	_, err := db.Exec(ctx, updateWorkflowRunOutputs, arg.Tenantid, arg.Workflowrunids, arg.Outputs)
+	return err
+}
+
 const updateWorkflowRunStickyState = `-- name: UpdateWorkflowRunStickyState :exec
 UPDATE "WorkflowRunStickyState"
 SET
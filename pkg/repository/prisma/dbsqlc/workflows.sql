@@ -123,7 +123,12 @@ INSERT INTO "WorkflowVersion" (
     "scheduleTimeout",
     "sticky",
     "kind",
-    "defaultPriority"
+    "defaultPriority",
+    "region",
+    "regionStrategy",
+    "outputExpression",
+    "inputSchema",
+    "reservedSlots"
 ) VALUES (
     @id::uuid,
     coalesce(sqlc.narg('createdAt')::timestamp, CURRENT_TIMESTAMP),
@@ -135,7 +140,12 @@ INSERT INTO "WorkflowVersion" (
     coalesce(sqlc.narg('scheduleTimeout')::text, '5m'),
     sqlc.narg('sticky')::"StickyStrategy",
     coalesce(sqlc.narg('kind')::"WorkflowKind", 'DAG'),
-    sqlc.narg('defaultPriority')::integer
+    sqlc.narg('defaultPriority')::integer,
+    sqlc.narg('region')::text,
+    sqlc.narg('regionStrategy')::"RegionStrategy",
+    sqlc.narg('outputExpression')::text,
+    sqlc.narg('inputSchema')::jsonb,
+    sqlc.narg('reservedSlots')::integer
 ) RETURNING *;
 
 -- name: MoveCronTriggerToNewWorkflowTriggers :exec
@@ -227,7 +237,21 @@ INSERT INTO "Step" (
     "retries",
     "scheduleTimeout",
     "retryBackoffFactor",
-    "retryMaxBackoff"
+    "retryMaxBackoff",
+    "speculativePercentile",
+    "slotType",
+    "heartbeatTimeout",
+    "retryInitialBackoff",
+    "retryJitter",
+    "sleepFor",
+    "sleepUntil",
+    "waitForEvent",
+    "waitForEventCorrelation",
+    "condition",
+    "mapOver",
+    "mapConcurrency",
+    "cacheTtl",
+    "pool"
 ) VALUES (
     @id::uuid,
     coalesce(sqlc.narg('createdAt')::timestamp, CURRENT_TIMESTAMP),
@@ -242,7 +266,21 @@ INSERT INTO "Step" (
     coalesce(sqlc.narg('retries')::integer, 0),
     coalesce(sqlc.narg('scheduleTimeout')::text, '5m'),
     sqlc.narg('retryBackoffFactor'),
-    sqlc.narg('retryMaxBackoff')
+    sqlc.narg('retryMaxBackoff'),
+    sqlc.narg('speculativePercentile')::integer,
+    sqlc.narg('slotType')::text,
+    sqlc.narg('heartbeatTimeout')::text,
+    sqlc.narg('retryInitialBackoff')::integer,
+    sqlc.narg('retryJitter')::float,
+    sqlc.narg('sleepFor')::text,
+    sqlc.narg('sleepUntil')::text,
+    sqlc.narg('waitForEvent')::text,
+    sqlc.narg('waitForEventCorrelation')::text,
+    sqlc.narg('condition')::text,
+    sqlc.narg('mapOver')::text,
+    sqlc.narg('mapConcurrency')::integer,
+    sqlc.narg('cacheTtl')::text,
+    sqlc.narg('pool')::text
 ) RETURNING *;
 
 -- name: AddStepParents :exec
@@ -270,6 +308,19 @@ INSERT INTO "StepRateLimit" (
     @kind
 ) RETURNING *;
 
+-- name: CreateStepGlobalRateLimit :one
+INSERT INTO "StepGlobalRateLimit" (
+    "units",
+    "stepId",
+    "rateLimitKey",
+    "tenantId"
+) VALUES (
+    @units::integer,
+    @stepId::uuid,
+    @rateLimitKey::text,
+    @tenantId::uuid
+) RETURNING *;
+
 -- name: CreateStepExpressions :exec
 INSERT INTO "StepExpression" (
     "key",
@@ -361,7 +412,10 @@ INSERT INTO "WorkflowTriggerCronRef" (
     "input",
     "additionalMetadata",
     "id",
-    "method"
+    "method",
+    "timezone",
+    "jitterSeconds",
+    "exclusionCalendarId"
 ) VALUES (
     @workflowTriggersId::uuid,
     @cronTrigger::text,
@@ -369,7 +423,10 @@ INSERT INTO "WorkflowTriggerCronRef" (
     sqlc.narg('input')::jsonb,
     sqlc.narg('additionalMetadata')::jsonb,
     gen_random_uuid(),
-    COALESCE(sqlc.narg('method')::"WorkflowTriggerCronRefMethods", 'DEFAULT')
+    COALESCE(sqlc.narg('method')::"WorkflowTriggerCronRefMethods", 'DEFAULT'),
+    COALESCE(sqlc.narg('timezone')::text, 'UTC'),
+    COALESCE(sqlc.narg('jitterSeconds')::integer, 0),
+    sqlc.narg('exclusionCalendarId')::uuid
 ) RETURNING *;
 
 
@@ -393,7 +450,10 @@ INSERT INTO "WorkflowTriggerCronRef" (
     "input",
     "additionalMetadata",
     "id",
-    "method"
+    "method",
+    "timezone",
+    "jitterSeconds",
+    "exclusionCalendarId"
 ) VALUES (
     (SELECT "id" FROM latest_trigger),
     @cronTrigger::text,
@@ -401,7 +461,10 @@ INSERT INTO "WorkflowTriggerCronRef" (
     sqlc.narg('input')::jsonb,
     sqlc.narg('additionalMetadata')::jsonb,
     gen_random_uuid(),
-    COALESCE(sqlc.narg('method')::"WorkflowTriggerCronRefMethods", 'DEFAULT')
+    COALESCE(sqlc.narg('method')::"WorkflowTriggerCronRefMethods", 'DEFAULT'),
+    COALESCE(sqlc.narg('timezone')::text, 'UTC'),
+    COALESCE(sqlc.narg('jitterSeconds')::integer, 0),
+    sqlc.narg('exclusionCalendarId')::uuid
 ) RETURNING *;
 
 -- name: CreateWorkflowTriggerScheduledRefForWorkflow :one
@@ -610,6 +673,32 @@ WHERE
     ) AND
     workflowVersion."workflowId" = @workflowId::uuid;
 
+-- name: ListWorkflowConcurrencyGroups :many
+-- Lists the concurrency groups of a workflow which have running or queued runs, with the number of runs in
+-- each state.
+SELECT
+    r1."concurrencyGroupId"::text AS "groupKey",
+    COUNT(*) FILTER (WHERE r1."status" = 'RUNNING') AS "runningCount",
+    COUNT(*) FILTER (WHERE r1."status" = 'QUEUED') AS "queuedCount"
+FROM
+    "WorkflowRun" r1
+JOIN
+    "WorkflowVersion" workflowVersion ON r1."workflowVersionId" = workflowVersion."id"
+WHERE
+    r1."tenantId" = @tenantId::uuid AND
+    workflowVersion."deletedAt" IS NULL AND
+    r1."deletedAt" IS NULL AND
+    workflowVersion."workflowId" = @workflowId::uuid AND
+    r1."concurrencyGroupId" IS NOT NULL AND
+    r1."status" IN ('RUNNING', 'QUEUED')
+GROUP BY
+    r1."concurrencyGroupId"
+ORDER BY
+    "runningCount" DESC,
+    "groupKey" ASC
+LIMIT
+    COALESCE(sqlc.narg('limit')::int, 1000);
+
 
 -- name: SoftDeleteWorkflow :one
 WITH versions AS (
@@ -696,6 +785,7 @@ slots AS (
     SELECT COUNT(*) AS usedSlotCount
     FROM "SemaphoreQueueItem" sqi
     WHERE sqi."workerId" IN (SELECT workerId FROM UniqueWorkers)
+        AND sqi."slotType" = 'default'
 )
 SELECT
     COALESCE(maxR, 0) AS totalSlotCount,
@@ -368,7 +368,21 @@ INSERT INTO "Step" (
     "retries",
     "scheduleTimeout",
     "retryBackoffFactor",
-    "retryMaxBackoff"
+    "retryMaxBackoff",
+    "speculativePercentile",
+    "slotType",
+    "heartbeatTimeout",
+    "retryInitialBackoff",
+    "retryJitter",
+    "sleepFor",
+    "sleepUntil",
+    "waitForEvent",
+    "waitForEventCorrelation",
+    "condition",
+    "mapOver",
+    "mapConcurrency",
+    "cacheTtl",
+    "pool"
 ) VALUES (
     $1::uuid,
     coalesce($2::timestamp, CURRENT_TIMESTAMP),
@@ -383,25 +397,53 @@ INSERT INTO "Step" (
     coalesce($11::integer, 0),
     coalesce($12::text, '5m'),
     $13,
-    $14
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", "readableId", "tenantId", "jobId", "actionId", timeout, "customUserData", retries, "retryBackoffFactor", "retryMaxBackoff", "scheduleTimeout"
+    $14,
+    $15::integer,
+    $16::text,
+    $17::text,
+    $18::integer,
+    $19::float,
+    $20::text,
+    $21::text,
+    $22::text,
+    $23::text,
+    $24::text,
+    $25::text,
+    $26::integer,
+    $27::text,
+    $28::text
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", "readableId", "tenantId", "jobId", "actionId", timeout, "customUserData", retries, "retryBackoffFactor", "retryMaxBackoff", "scheduleTimeout", "speculativePercentile", "slotType", "heartbeatTimeout", "retryInitialBackoff", "retryJitter", "sleepFor", "sleepUntil", "waitForEvent", "waitForEventCorrelation", condition, "mapOver", "mapConcurrency", "cacheTtl", pool
 `
 
 type CreateStepParams struct {
-	ID                 pgtype.UUID      `json:"id"`
-	CreatedAt          pgtype.Timestamp `json:"createdAt"`
-	UpdatedAt          pgtype.Timestamp `json:"updatedAt"`
-	Deletedat          pgtype.Timestamp `json:"deletedat"`
-	Readableid         string           `json:"readableid"`
-	Tenantid           pgtype.UUID      `json:"tenantid"`
-	Jobid              pgtype.UUID      `json:"jobid"`
-	Actionid           string           `json:"actionid"`
-	Timeout            pgtype.Text      `json:"timeout"`
-	CustomUserData     []byte           `json:"customUserData"`
-	Retries            pgtype.Int4      `json:"retries"`
-	ScheduleTimeout    pgtype.Text      `json:"scheduleTimeout"`
-	RetryBackoffFactor pgtype.Float8    `json:"retryBackoffFactor"`
-	RetryMaxBackoff    pgtype.Int4      `json:"retryMaxBackoff"`
+	ID                      pgtype.UUID      `json:"id"`
+	CreatedAt               pgtype.Timestamp `json:"createdAt"`
+	UpdatedAt               pgtype.Timestamp `json:"updatedAt"`
+	Deletedat               pgtype.Timestamp `json:"deletedat"`
+	Readableid              string           `json:"readableid"`
+	Tenantid                pgtype.UUID      `json:"tenantid"`
+	Jobid                   pgtype.UUID      `json:"jobid"`
+	Actionid                string           `json:"actionid"`
+	Timeout                 pgtype.Text      `json:"timeout"`
+	CustomUserData          []byte           `json:"customUserData"`
+	Retries                 pgtype.Int4      `json:"retries"`
+	ScheduleTimeout         pgtype.Text      `json:"scheduleTimeout"`
+	RetryBackoffFactor      pgtype.Float8    `json:"retryBackoffFactor"`
+	RetryMaxBackoff         pgtype.Int4      `json:"retryMaxBackoff"`
+	SpeculativePercentile   pgtype.Int4      `json:"speculativePercentile"`
+	SlotType                pgtype.Text      `json:"slotType"`
+	HeartbeatTimeout        pgtype.Text      `json:"heartbeatTimeout"`
+	RetryInitialBackoff     pgtype.Int4      `json:"retryInitialBackoff"`
+	RetryJitter             pgtype.Float8    `json:"retryJitter"`
+	SleepFor                pgtype.Text      `json:"sleepFor"`
+	SleepUntil              pgtype.Text      `json:"sleepUntil"`
+	WaitForEvent            pgtype.Text      `json:"waitForEvent"`
+	WaitForEventCorrelation pgtype.Text      `json:"waitForEventCorrelation"`
+	Condition               pgtype.Text      `json:"condition"`
+	MapOver                 pgtype.Text      `json:"mapOver"`
+	MapConcurrency          pgtype.Int4      `json:"mapConcurrency"`
+	CacheTtl                pgtype.Text      `json:"cacheTtl"`
+	Pool                    pgtype.Text      `json:"pool"`
 }
 
 func (q *Queries) CreateStep(ctx context.Context, db DBTX, arg CreateStepParams) (*Step, error) {
@@ -420,6 +462,20 @@ func (q *Queries) CreateStep(ctx context.Context, db DBTX, arg CreateStepParams)
 		arg.ScheduleTimeout,
 		arg.RetryBackoffFactor,
 		arg.RetryMaxBackoff,
+		arg.SpeculativePercentile,
+		arg.SlotType,
+		arg.HeartbeatTimeout,
+		arg.RetryInitialBackoff,
+		arg.RetryJitter,
+		arg.SleepFor,
+		arg.SleepUntil,
+		arg.WaitForEvent,
+		arg.WaitForEventCorrelation,
+		arg.Condition,
+		arg.MapOver,
+		arg.MapConcurrency,
+		arg.CacheTtl,
+		arg.Pool,
 	)
 	var i Step
 	err := row.Scan(
@@ -437,6 +493,20 @@ func (q *Queries) CreateStep(ctx context.Context, db DBTX, arg CreateStepParams)
 		&i.RetryBackoffFactor,
 		&i.RetryMaxBackoff,
 		&i.ScheduleTimeout,
+		&i.SpeculativePercentile,
+		&i.SlotType,
+		&i.HeartbeatTimeout,
+		&i.RetryInitialBackoff,
+		&i.RetryJitter,
+		&i.SleepFor,
+		&i.SleepUntil,
+		&i.WaitForEvent,
+		&i.WaitForEventCorrelation,
+		&i.Condition,
+		&i.MapOver,
+		&i.MapConcurrency,
+		&i.CacheTtl,
+		&i.Pool,
 	)
 	return &i, err
 }
@@ -474,6 +544,44 @@ func (q *Queries) CreateStepExpressions(ctx context.Context, db DBTX, arg Create
 	return err
 }
 
+const createStepGlobalRateLimit = `-- name: CreateStepGlobalRateLimit :one
+INSERT INTO "StepGlobalRateLimit" (
+    "units",
+    "stepId",
+    "rateLimitKey",
+    "tenantId"
+) VALUES (
+    $1::integer,
+    $2::uuid,
+    $3::text,
+    $4::uuid
+) RETURNING units, "stepId", "rateLimitKey", "tenantId"
+`
+
+type CreateStepGlobalRateLimitParams struct {
+	Units        int32       `json:"units"`
+	Stepid       pgtype.UUID `json:"stepid"`
+	Ratelimitkey string      `json:"ratelimitkey"`
+	Tenantid     pgtype.UUID `json:"tenantid"`
+}
+
+func (q *Queries) CreateStepGlobalRateLimit(ctx context.Context, db DBTX, arg CreateStepGlobalRateLimitParams) (*StepGlobalRateLimit, error) {
+	row := db.QueryRow(ctx, createStepGlobalRateLimit,
+		arg.Units,
+		arg.Stepid,
+		arg.Ratelimitkey,
+		arg.Tenantid,
+	)
+	var i StepGlobalRateLimit
+	err := row.Scan(
+		&i.Units,
+		&i.StepId,
+		&i.RateLimitKey,
+		&i.TenantId,
+	)
+	return &i, err
+}
+
 const createStepRateLimit = `-- name: CreateStepRateLimit :one
 INSERT INTO "StepRateLimit" (
     "units",
@@ -635,7 +743,10 @@ INSERT INTO "WorkflowTriggerCronRef" (
     "input",
     "additionalMetadata",
     "id",
-    "method"
+    "method",
+    "timezone",
+    "jitterSeconds",
+    "exclusionCalendarId"
 ) VALUES (
     $1::uuid,
     $2::text,
@@ -643,17 +754,23 @@ INSERT INTO "WorkflowTriggerCronRef" (
     $4::jsonb,
     $5::jsonb,
     gen_random_uuid(),
-    COALESCE($6::"WorkflowTriggerCronRefMethods", 'DEFAULT')
-) RETURNING "parentId", cron, "tickerId", input, enabled, "additionalMetadata", "createdAt", "deletedAt", "updatedAt", name, id, method
+    COALESCE($6::"WorkflowTriggerCronRefMethods", 'DEFAULT'),
+    COALESCE($7::text, 'UTC'),
+    COALESCE($8::integer, 0),
+    $9::uuid
+) RETURNING "parentId", cron, "tickerId", input, enabled, "additionalMetadata", "createdAt", "deletedAt", "updatedAt", name, id, method, timezone, "jitterSeconds", "exclusionCalendarId"
 `
 
 type CreateWorkflowTriggerCronRefParams struct {
-	Workflowtriggersid pgtype.UUID                       `json:"workflowtriggersid"`
-	Crontrigger        string                            `json:"crontrigger"`
-	Name               pgtype.Text                       `json:"name"`
-	Input              []byte                            `json:"input"`
-	AdditionalMetadata []byte                            `json:"additionalMetadata"`
-	Method             NullWorkflowTriggerCronRefMethods `json:"method"`
+	Workflowtriggersid  pgtype.UUID                       `json:"workflowtriggersid"`
+	Crontrigger         string                            `json:"crontrigger"`
+	Name                pgtype.Text                       `json:"name"`
+	Input               []byte                            `json:"input"`
+	AdditionalMetadata  []byte                            `json:"additionalMetadata"`
+	Method              NullWorkflowTriggerCronRefMethods `json:"method"`
+	Timezone            pgtype.Text                       `json:"timezone"`
+	JitterSeconds       pgtype.Int4                       `json:"jitterSeconds"`
+	ExclusionCalendarId pgtype.UUID                       `json:"exclusionCalendarId"`
 }
 
 func (q *Queries) CreateWorkflowTriggerCronRef(ctx context.Context, db DBTX, arg CreateWorkflowTriggerCronRefParams) (*WorkflowTriggerCronRef, error) {
@@ -664,6 +781,9 @@ func (q *Queries) CreateWorkflowTriggerCronRef(ctx context.Context, db DBTX, arg
 		arg.Input,
 		arg.AdditionalMetadata,
 		arg.Method,
+		arg.Timezone,
+		arg.JitterSeconds,
+		arg.ExclusionCalendarId,
 	)
 	var i WorkflowTriggerCronRef
 	err := row.Scan(
@@ -679,6 +799,9 @@ func (q *Queries) CreateWorkflowTriggerCronRef(ctx context.Context, db DBTX, arg
 		&i.Name,
 		&i.ID,
 		&i.Method,
+		&i.Timezone,
+		&i.JitterSeconds,
+		&i.ExclusionCalendarId,
 	)
 	return &i, err
 }
@@ -686,7 +809,7 @@ func (q *Queries) CreateWorkflowTriggerCronRef(ctx context.Context, db DBTX, arg
 const createWorkflowTriggerCronRefForWorkflow = `-- name: CreateWorkflowTriggerCronRefForWorkflow :one
 WITH latest_version AS (
     SELECT "id" FROM "WorkflowVersion"
-    WHERE "workflowId" = $6::uuid
+    WHERE "workflowId" = $9::uuid
     ORDER BY "order" DESC
     LIMIT 1
 ),
@@ -703,7 +826,10 @@ INSERT INTO "WorkflowTriggerCronRef" (
     "input",
     "additionalMetadata",
     "id",
-    "method"
+    "method",
+    "timezone",
+    "jitterSeconds",
+    "exclusionCalendarId"
 ) VALUES (
     (SELECT "id" FROM latest_trigger),
     $1::text,
@@ -711,17 +837,23 @@ INSERT INTO "WorkflowTriggerCronRef" (
     $3::jsonb,
     $4::jsonb,
     gen_random_uuid(),
-    COALESCE($5::"WorkflowTriggerCronRefMethods", 'DEFAULT')
-) RETURNING "parentId", cron, "tickerId", input, enabled, "additionalMetadata", "createdAt", "deletedAt", "updatedAt", name, id, method
+    COALESCE($5::"WorkflowTriggerCronRefMethods", 'DEFAULT'),
+    COALESCE($6::text, 'UTC'),
+    COALESCE($7::integer, 0),
+    $8::uuid
+) RETURNING "parentId", cron, "tickerId", input, enabled, "additionalMetadata", "createdAt", "deletedAt", "updatedAt", name, id, method, timezone, "jitterSeconds", "exclusionCalendarId"
 `
 
 type CreateWorkflowTriggerCronRefForWorkflowParams struct {
-	Crontrigger        string                            `json:"crontrigger"`
-	Name               pgtype.Text                       `json:"name"`
-	Input              []byte                            `json:"input"`
-	AdditionalMetadata []byte                            `json:"additionalMetadata"`
-	Method             NullWorkflowTriggerCronRefMethods `json:"method"`
-	Workflowid         pgtype.UUID                       `json:"workflowid"`
+	Crontrigger         string                            `json:"crontrigger"`
+	Name                pgtype.Text                       `json:"name"`
+	Input               []byte                            `json:"input"`
+	AdditionalMetadata  []byte                            `json:"additionalMetadata"`
+	Method              NullWorkflowTriggerCronRefMethods `json:"method"`
+	Timezone            pgtype.Text                       `json:"timezone"`
+	JitterSeconds       pgtype.Int4                       `json:"jitterSeconds"`
+	ExclusionCalendarId pgtype.UUID                       `json:"exclusionCalendarId"`
+	Workflowid          pgtype.UUID                       `json:"workflowid"`
 }
 
 func (q *Queries) CreateWorkflowTriggerCronRefForWorkflow(ctx context.Context, db DBTX, arg CreateWorkflowTriggerCronRefForWorkflowParams) (*WorkflowTriggerCronRef, error) {
@@ -731,6 +863,9 @@ func (q *Queries) CreateWorkflowTriggerCronRefForWorkflow(ctx context.Context, d
 		arg.Input,
 		arg.AdditionalMetadata,
 		arg.Method,
+		arg.Timezone,
+		arg.JitterSeconds,
+		arg.ExclusionCalendarId,
 		arg.Workflowid,
 	)
 	var i WorkflowTriggerCronRef
@@ -747,6 +882,9 @@ func (q *Queries) CreateWorkflowTriggerCronRefForWorkflow(ctx context.Context, d
 		&i.Name,
 		&i.ID,
 		&i.Method,
+		&i.Timezone,
+		&i.JitterSeconds,
+		&i.ExclusionCalendarId,
 	)
 	return &i, err
 }
@@ -939,7 +1077,12 @@ INSERT INTO "WorkflowVersion" (
     "scheduleTimeout",
     "sticky",
     "kind",
-    "defaultPriority"
+    "defaultPriority",
+    "region",
+    "regionStrategy",
+    "outputExpression",
+    "inputSchema",
+    "reservedSlots"
 ) VALUES (
     $1::uuid,
     coalesce($2::timestamp, CURRENT_TIMESTAMP),
@@ -951,22 +1094,32 @@ INSERT INTO "WorkflowVersion" (
     coalesce($8::text, '5m'),
     $9::"StickyStrategy",
     coalesce($10::"WorkflowKind", 'DAG'),
-    $11::integer
-) RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "onFailureJobId", sticky, kind, "defaultPriority"
+    $11::integer,
+    $12::text,
+    $13::"RegionStrategy",
+    $14::text,
+    $15::jsonb,
+    $16::integer
+) RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "onFailureJobId", sticky, kind, "defaultPriority", region, "regionStrategy", "outputExpression", "inputSchema", "reservedSlots"
 `
 
 type CreateWorkflowVersionParams struct {
-	ID              pgtype.UUID        `json:"id"`
-	CreatedAt       pgtype.Timestamp   `json:"createdAt"`
-	UpdatedAt       pgtype.Timestamp   `json:"updatedAt"`
-	Deletedat       pgtype.Timestamp   `json:"deletedat"`
-	Checksum        string             `json:"checksum"`
-	Version         pgtype.Text        `json:"version"`
-	Workflowid      pgtype.UUID        `json:"workflowid"`
-	ScheduleTimeout pgtype.Text        `json:"scheduleTimeout"`
-	Sticky          NullStickyStrategy `json:"sticky"`
-	Kind            NullWorkflowKind   `json:"kind"`
-	DefaultPriority pgtype.Int4        `json:"defaultPriority"`
+	ID               pgtype.UUID        `json:"id"`
+	CreatedAt        pgtype.Timestamp   `json:"createdAt"`
+	UpdatedAt        pgtype.Timestamp   `json:"updatedAt"`
+	Deletedat        pgtype.Timestamp   `json:"deletedat"`
+	Checksum         string             `json:"checksum"`
+	Version          pgtype.Text        `json:"version"`
+	Workflowid       pgtype.UUID        `json:"workflowid"`
+	ScheduleTimeout  pgtype.Text        `json:"scheduleTimeout"`
+	Sticky           NullStickyStrategy `json:"sticky"`
+	Kind             NullWorkflowKind   `json:"kind"`
+	DefaultPriority  pgtype.Int4        `json:"defaultPriority"`
+	Region           pgtype.Text        `json:"region"`
+	RegionStrategy   NullRegionStrategy `json:"regionStrategy"`
+	OutputExpression pgtype.Text        `json:"outputExpression"`
+	InputSchema      []byte             `json:"inputSchema"`
+	ReservedSlots    pgtype.Int4        `json:"reservedSlots"`
 }
 
 func (q *Queries) CreateWorkflowVersion(ctx context.Context, db DBTX, arg CreateWorkflowVersionParams) (*WorkflowVersion, error) {
@@ -982,6 +1135,11 @@ func (q *Queries) CreateWorkflowVersion(ctx context.Context, db DBTX, arg Create
 		arg.Sticky,
 		arg.Kind,
 		arg.DefaultPriority,
+		arg.Region,
+		arg.RegionStrategy,
+		arg.OutputExpression,
+		arg.InputSchema,
+		arg.ReservedSlots,
 	)
 	var i WorkflowVersion
 	err := row.Scan(
@@ -998,6 +1156,11 @@ func (q *Queries) CreateWorkflowVersion(ctx context.Context, db DBTX, arg Create
 		&i.Sticky,
 		&i.Kind,
 		&i.DefaultPriority,
+		&i.Region,
+		&i.RegionStrategy,
+		&i.OutputExpression,
+		&i.InputSchema,
+		&i.ReservedSlots,
 	)
 	return &i, err
 }
@@ -1159,7 +1322,7 @@ func (q *Queries) GetWorkflowLatestVersion(ctx context.Context, db DBTX, workflo
 
 const getWorkflowVersionById = `-- name: GetWorkflowVersionById :one
 SELECT
-    wv.id, wv."createdAt", wv."updatedAt", wv."deletedAt", wv.version, wv."order", wv."workflowId", wv.checksum, wv."scheduleTimeout", wv."onFailureJobId", wv.sticky, wv.kind, wv."defaultPriority",
+    wv.id, wv."createdAt", wv."updatedAt", wv."deletedAt", wv.version, wv."order", wv."workflowId", wv.checksum, wv."scheduleTimeout", wv."onFailureJobId", wv.sticky, wv.kind, wv."defaultPriority", wv.region, wv."regionStrategy", wv."outputExpression", wv."inputSchema", wv."reservedSlots",
     w.id, w."createdAt", w."updatedAt", w."deletedAt", w."tenantId", w.name, w.description, w."isPaused",
     wc."id" as "concurrencyId",
     wc."maxRuns" as "concurrencyMaxRuns",
@@ -1201,6 +1364,11 @@ func (q *Queries) GetWorkflowVersionById(ctx context.Context, db DBTX, id pgtype
 		&i.WorkflowVersion.Sticky,
 		&i.WorkflowVersion.Kind,
 		&i.WorkflowVersion.DefaultPriority,
+		&i.WorkflowVersion.Region,
+		&i.WorkflowVersion.RegionStrategy,
+		&i.WorkflowVersion.OutputExpression,
+		&i.WorkflowVersion.InputSchema,
+		&i.WorkflowVersion.ReservedSlots,
 		&i.Workflow.ID,
 		&i.Workflow.CreatedAt,
 		&i.Workflow.UpdatedAt,
@@ -1219,7 +1387,7 @@ func (q *Queries) GetWorkflowVersionById(ctx context.Context, db DBTX, id pgtype
 
 const getWorkflowVersionCronTriggerRefs = `-- name: GetWorkflowVersionCronTriggerRefs :many
 SELECT
-    wtc."parentId", wtc.cron, wtc."tickerId", wtc.input, wtc.enabled, wtc."additionalMetadata", wtc."createdAt", wtc."deletedAt", wtc."updatedAt", wtc.name, wtc.id, wtc.method
+    wtc."parentId", wtc.cron, wtc."tickerId", wtc.input, wtc.enabled, wtc."additionalMetadata", wtc."createdAt", wtc."deletedAt", wtc."updatedAt", wtc.name, wtc.id, wtc.method, wtc.timezone, wtc."jitterSeconds", wtc."exclusionCalendarId"
 FROM
     "WorkflowTriggerCronRef" as wtc
 JOIN "WorkflowTriggers" as wt ON wt."id" = wtc."parentId"
@@ -1249,6 +1417,9 @@ func (q *Queries) GetWorkflowVersionCronTriggerRefs(ctx context.Context, db DBTX
 			&i.Name,
 			&i.ID,
 			&i.Method,
+			&i.Timezone,
+			&i.JitterSeconds,
+			&i.ExclusionCalendarId,
 		); err != nil {
 			return nil, err
 		}
@@ -1292,7 +1463,7 @@ func (q *Queries) GetWorkflowVersionEventTriggerRefs(ctx context.Context, db DBT
 
 const getWorkflowVersionForEngine = `-- name: GetWorkflowVersionForEngine :many
 SELECT
-    workflowversions.id, workflowversions."createdAt", workflowversions."updatedAt", workflowversions."deletedAt", workflowversions.version, workflowversions."order", workflowversions."workflowId", workflowversions.checksum, workflowversions."scheduleTimeout", workflowversions."onFailureJobId", workflowversions.sticky, workflowversions.kind, workflowversions."defaultPriority",
+    workflowversions.id, workflowversions."createdAt", workflowversions."updatedAt", workflowversions."deletedAt", workflowversions.version, workflowversions."order", workflowversions."workflowId", workflowversions.checksum, workflowversions."scheduleTimeout", workflowversions."onFailureJobId", workflowversions.sticky, workflowversions.kind, workflowversions."defaultPriority", workflowversions.region, workflowversions."regionStrategy", workflowversions."outputExpression", workflowversions."inputSchema", workflowversions."reservedSlots",
     w."name" as "workflowName",
     wc."limitStrategy" as "concurrencyLimitStrategy",
     wc."maxRuns" as "concurrencyMaxRuns",
@@ -1348,6 +1519,11 @@ func (q *Queries) GetWorkflowVersionForEngine(ctx context.Context, db DBTX, arg
 			&i.WorkflowVersion.Sticky,
 			&i.WorkflowVersion.Kind,
 			&i.WorkflowVersion.DefaultPriority,
+			&i.WorkflowVersion.Region,
+			&i.WorkflowVersion.RegionStrategy,
+			&i.WorkflowVersion.OutputExpression,
+			&i.WorkflowVersion.InputSchema,
+			&i.WorkflowVersion.ReservedSlots,
 			&i.WorkflowName,
 			&i.ConcurrencyLimitStrategy,
 			&i.ConcurrencyMaxRuns,
@@ -1437,6 +1613,7 @@ slots AS (
     SELECT COUNT(*) AS usedSlotCount
     FROM "SemaphoreQueueItem" sqi
     WHERE sqi."workerId" IN (SELECT workerId FROM UniqueWorkers)
+        AND sqi."slotType" = 'default'
 )
 SELECT
     COALESCE(maxR, 0) AS totalSlotCount,
@@ -1546,7 +1723,7 @@ const linkOnFailureJob = `-- name: LinkOnFailureJob :one
 UPDATE "WorkflowVersion"
 SET "onFailureJobId" = $1::uuid
 WHERE "id" = $2::uuid
-RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "onFailureJobId", sticky, kind, "defaultPriority"
+RETURNING id, "createdAt", "updatedAt", "deletedAt", version, "order", "workflowId", checksum, "scheduleTimeout", "onFailureJobId", sticky, kind, "defaultPriority", region, "regionStrategy", "outputExpression", "inputSchema", "reservedSlots"
 `
 
 type LinkOnFailureJobParams struct {
@@ -1571,6 +1748,11 @@ func (q *Queries) LinkOnFailureJob(ctx context.Context, db DBTX, arg LinkOnFailu
 		&i.Sticky,
 		&i.Kind,
 		&i.DefaultPriority,
+		&i.Region,
+		&i.RegionStrategy,
+		&i.OutputExpression,
+		&i.InputSchema,
+		&i.ReservedSlots,
 	)
 	return &i, err
 }
@@ -1597,7 +1779,7 @@ SELECT
     t."id" as "triggerId",
     c."id" as "cronId",
     t.id, t."createdAt", t."updatedAt", t."deletedAt", t."workflowVersionId", t."tenantId",
-    c."parentId", c.cron, c."tickerId", c.input, c.enabled, c."additionalMetadata", c."createdAt", c."deletedAt", c."updatedAt", c.name, c.id, c.method
+    c."parentId", c.cron, c."tickerId", c.input, c.enabled, c."additionalMetadata", c."createdAt", c."deletedAt", c."updatedAt", c.name, c.id, c.method, c.timezone, c."jitterSeconds", c."exclusionCalendarId"
 FROM
     latest_versions
 JOIN
@@ -1660,6 +1842,9 @@ type ListCronWorkflowsRow struct {
 	Name                pgtype.Text                   `json:"name"`
 	ID_2                pgtype.UUID                   `json:"id_2"`
 	Method              WorkflowTriggerCronRefMethods `json:"method"`
+	Timezone            string                        `json:"timezone"`
+	JitterSeconds       int32                         `json:"jitterSeconds"`
+	ExclusionCalendarId pgtype.UUID                   `json:"exclusionCalendarId"`
 }
 
 // Get all of the latest workflow versions for the tenant
@@ -1705,6 +1890,9 @@ func (q *Queries) ListCronWorkflows(ctx context.Context, db DBTX, arg ListCronWo
 			&i.Name,
 			&i.ID_2,
 			&i.Method,
+			&i.Timezone,
+			&i.JitterSeconds,
+			&i.ExclusionCalendarId,
 		); err != nil {
 			return nil, err
 		}
@@ -1747,6 +1935,65 @@ func (q *Queries) ListPausedWorkflows(ctx context.Context, db DBTX, tenantid pgt
 	return items, nil
 }
 
+const listWorkflowConcurrencyGroups = `-- name: ListWorkflowConcurrencyGroups :many
+SELECT
+    r1."concurrencyGroupId"::text AS "groupKey",
+    COUNT(*) FILTER (WHERE r1."status" = 'RUNNING') AS "runningCount",
+    COUNT(*) FILTER (WHERE r1."status" = 'QUEUED') AS "queuedCount"
+FROM
+    "WorkflowRun" r1
+JOIN
+    "WorkflowVersion" workflowVersion ON r1."workflowVersionId" = workflowVersion."id"
+WHERE
+    r1."tenantId" = $1::uuid AND
+    workflowVersion."deletedAt" IS NULL AND
+    r1."deletedAt" IS NULL AND
+    workflowVersion."workflowId" = $2::uuid AND
+    r1."concurrencyGroupId" IS NOT NULL AND
+    r1."status" IN ('RUNNING', 'QUEUED')
+GROUP BY
+    r1."concurrencyGroupId"
+ORDER BY
+    "runningCount" DESC,
+    "groupKey" ASC
+LIMIT
+    COALESCE($3::int, 1000)
+`
+
+type ListWorkflowConcurrencyGroupsParams struct {
+	Tenantid   pgtype.UUID `json:"tenantid"`
+	Workflowid pgtype.UUID `json:"workflowid"`
+	Limit      pgtype.Int4 `json:"limit"`
+}
+
+type ListWorkflowConcurrencyGroupsRow struct {
+	GroupKey     string `json:"groupKey"`
+	RunningCount int64  `json:"runningCount"`
+	QueuedCount  int64  `json:"queuedCount"`
+}
+
+// Lists the concurrency groups of a workflow which have running or queued runs, with the number of runs in
+// each state.
+func (q *Queries) ListWorkflowConcurrencyGroups(ctx context.Context, db DBTX, arg ListWorkflowConcurrencyGroupsParams) ([]*ListWorkflowConcurrencyGroupsRow, error) {
+	rows, err := db.Query(ctx, listWorkflowConcurrencyGroups, arg.Tenantid, arg.Workflowid, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ListWorkflowConcurrencyGroupsRow
+	for rows.Next() {
+		var i ListWorkflowConcurrencyGroupsRow
+		if err := rows.Scan(&i.GroupKey, &i.RunningCount, &i.QueuedCount); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWorkflows = `-- name: ListWorkflows :many
 SELECT
     workflows.id, workflows."createdAt", workflows."updatedAt", workflows."deletedAt", workflows."tenantId", workflows.name, workflows.description, workflows."isPaused"
@@ -1863,7 +2110,7 @@ func (q *Queries) ListWorkflowsForEvent(ctx context.Context, db DBTX, arg ListWo
 
 const listWorkflowsLatestRuns = `-- name: ListWorkflowsLatestRuns :many
 SELECT
-    DISTINCT ON (workflow."id") runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."childIndex", runs."childKey", runs."parentId", runs."parentStepRunId", runs."additionalMetadata", runs.duration, runs.priority, runs."insertOrder", workflow."id" as "workflowId"
+    DISTINCT ON (workflow."id") runs."createdAt", runs."updatedAt", runs."deletedAt", runs."tenantId", runs."workflowVersionId", runs.status, runs.error, runs."startedAt", runs."finishedAt", runs."concurrencyGroupId", runs."displayName", runs.id, runs."childIndex", runs."childKey", runs."parentId", runs."parentStepRunId", runs."additionalMetadata", runs.duration, runs.priority, runs."insertOrder", runs.output, runs."replayedFromId", runs."replayedFromStepId", runs."traceContext", workflow."id" as "workflowId"
 FROM
     "WorkflowRun" as runs
 LEFT JOIN
@@ -1945,6 +2192,10 @@ func (q *Queries) ListWorkflowsLatestRuns(ctx context.Context, db DBTX, arg List
 			&i.WorkflowRun.Duration,
 			&i.WorkflowRun.Priority,
 			&i.WorkflowRun.InsertOrder,
+			&i.WorkflowRun.Output,
+			&i.WorkflowRun.ReplayedFromId,
+			&i.WorkflowRun.ReplayedFromStepId,
+			&i.WorkflowRun.TraceContext,
 			&i.WorkflowId,
 		); err != nil {
 			return nil, err
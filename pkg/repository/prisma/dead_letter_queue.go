@@ -0,0 +1,142 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type deadLetterQueueRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewDeadLetterQueueRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.DeadLetterQueueRepository {
+	queries := dbsqlc.New()
+
+	return &deadLetterQueueRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *deadLetterQueueRepository) CreateDeadLetterQueueItem(ctx context.Context, tenantId, stepRunId, errStr string, retryCount int) error {
+	err := r.queries.CreateDeadLetterQueueItem(ctx, r.pool, dbsqlc.CreateDeadLetterQueueItemParams{
+		Retrycount: int32(retryCount), // nolint: gosec
+		Error:      errStr,
+		Steprunid:  sqlchelpers.UUIDFromStr(stepRunId),
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not create dead-letter queue item: %w", err)
+	}
+
+	return nil
+}
+
+func (r *deadLetterQueueRepository) ListDeadLetterQueueItems(ctx context.Context, tenantId string, opts *repository.ListDeadLetterQueueItemsOpts) (*repository.ListDeadLetterQueueItemsResult, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	queryParams := dbsqlc.ListDeadLetterQueueItemsParams{
+		Tenantid: pgTenantId,
+	}
+
+	if opts.Offset != nil {
+		queryParams.Offset = *opts.Offset
+	}
+
+	if opts.Limit != nil {
+		queryParams.Limit = *opts.Limit
+	}
+
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer sqlchelpers.DeferRollback(ctx, r.l, tx.Rollback)
+
+	items, err := r.queries.ListDeadLetterQueueItems(ctx, tx, queryParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list dead-letter queue items: %w", err)
+	}
+
+	count, err := r.queries.CountDeadLetterQueueItems(ctx, tx, pgTenantId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not count dead-letter queue items: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return &repository.ListDeadLetterQueueItemsResult{
+		Rows:  items,
+		Count: int(count),
+	}, nil
+}
+
+func (r *deadLetterQueueRepository) GetDeadLetterQueueItemsByIds(ctx context.Context, tenantId string, ids []string) ([]*dbsqlc.DeadLetterQueueItem, error) {
+	pgIds := make([]pgtype.UUID, len(ids))
+
+	for i, id := range ids {
+		pgIds[i] = sqlchelpers.UUIDFromStr(id)
+	}
+
+	return r.queries.ListDeadLetterQueueItemsByIds(ctx, r.pool, dbsqlc.ListDeadLetterQueueItemsByIdsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Ids:      pgIds,
+	})
+}
+
+func (r *deadLetterQueueRepository) DeleteDeadLetterQueueItems(ctx context.Context, tenantId string, opts *repository.DeleteDeadLetterQueueItemsOpts) (int, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return 0, err
+	}
+
+	params := dbsqlc.DeleteDeadLetterQueueItemsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+	}
+
+	if opts.Ids != nil {
+		params.Ids = make([]pgtype.UUID, len(opts.Ids))
+
+		for i, id := range opts.Ids {
+			params.Ids[i] = sqlchelpers.UUIDFromStr(id)
+		}
+	}
+
+	if opts.CreatedBefore != nil {
+		params.CreatedBefore = sqlchelpers.TimestampFromTime(opts.CreatedBefore.UTC())
+	}
+
+	deleted, err := r.queries.DeleteDeadLetterQueueItems(ctx, r.pool, params)
+
+	if err != nil {
+		return 0, fmt.Errorf("could not delete dead-letter queue items: %w", err)
+	}
+
+	return int(deleted), nil
+}
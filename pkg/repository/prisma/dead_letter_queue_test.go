@@ -0,0 +1,192 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestCreateDeadLetterQueueItem(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		version := createTestWorkflow(t, conf, tenantId, "dead-letter-queue")
+		run := createTestWorkflowRun(t, conf, tenantId, version)
+		stepRunId := getTestStepRunId(t, conf, run.ID)
+
+		// the errors of the previous attempts of the step run
+		for _, errStr := range []string{"first attempt", "second attempt"} {
+			_, err := conf.Pool.Exec(
+				ctx,
+				`INSERT INTO "StepRunResultArchive" ("id", "stepRunId", "error") VALUES (gen_random_uuid(), $1, $2)`,
+				stepRunId, errStr,
+			)
+
+			require.NoError(t, err)
+		}
+
+		// an attempt without an error isn't part of the error chain
+		_, err := conf.Pool.Exec(ctx, `INSERT INTO "StepRunResultArchive" ("id", "stepRunId") VALUES (gen_random_uuid(), $1)`, stepRunId)
+		require.NoError(t, err)
+
+		dlq := conf.EngineRepository.DeadLetterQueue()
+
+		err = dlq.CreateDeadLetterQueueItem(ctx, tenantId, sqlchelpers.UUIDToStr(stepRunId), "third attempt", 2)
+		require.NoError(t, err)
+
+		list, err := dlq.ListDeadLetterQueueItems(ctx, tenantId, &repository.ListDeadLetterQueueItemsOpts{})
+		require.NoError(t, err)
+		require.Len(t, list.Rows, 1)
+		assert.Equal(t, 1, list.Count)
+
+		item := list.Rows[0]
+
+		assert.Equal(t, sqlchelpers.UUIDToStr(stepRunId), sqlchelpers.UUIDToStr(item.StepRunId))
+		assert.Equal(t, sqlchelpers.UUIDToStr(run.ID), sqlchelpers.UUIDToStr(item.WorkflowRunId))
+		assert.Equal(t, int32(2), item.RetryCount)
+		assert.Equal(t, "third attempt", item.Error.String)
+		assert.Equal(t, []string{"first attempt", "second attempt"}, item.ErrorChain)
+
+		// the step run failed again after a replay, so its entry is replaced instead of duplicated
+		err = dlq.CreateDeadLetterQueueItem(ctx, tenantId, sqlchelpers.UUIDToStr(stepRunId), "fourth attempt", 3)
+		require.NoError(t, err)
+
+		list, err = dlq.ListDeadLetterQueueItems(ctx, tenantId, &repository.ListDeadLetterQueueItemsOpts{})
+		require.NoError(t, err)
+		require.Len(t, list.Rows, 1)
+
+		assert.Equal(t, sqlchelpers.UUIDToStr(item.ID), sqlchelpers.UUIDToStr(list.Rows[0].ID))
+		assert.Equal(t, int32(3), list.Rows[0].RetryCount)
+		assert.Equal(t, "fourth attempt", list.Rows[0].Error.String)
+
+		// a step run of another tenant isn't added
+		err = dlq.CreateDeadLetterQueueItem(ctx, createTestTenant(t, conf), sqlchelpers.UUIDToStr(stepRunId), "other tenant", 0)
+		require.NoError(t, err)
+
+		list, err = dlq.ListDeadLetterQueueItems(ctx, tenantId, &repository.ListDeadLetterQueueItemsOpts{})
+		require.NoError(t, err)
+		assert.Equal(t, 1, list.Count)
+
+		return nil
+	})
+}
+
+func TestListAndDeleteDeadLetterQueueItems(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		otherTenantId := createTestTenant(t, conf)
+		version := createTestWorkflow(t, conf, tenantId, "dead-letter-queue")
+
+		dlq := conf.EngineRepository.DeadLetterQueue()
+		now := time.Now().UTC()
+
+		// items which were added 4, 3, 2 and 1 days ago
+		ids := make([]string, 4)
+
+		for i := range ids {
+			stepRunId := getTestStepRunId(t, conf, createTestWorkflowRun(t, conf, tenantId, version).ID)
+
+			err := dlq.CreateDeadLetterQueueItem(ctx, tenantId, sqlchelpers.UUIDToStr(stepRunId), "failed", 0)
+			require.NoError(t, err)
+
+			var id pgtype.UUID
+
+			err = conf.Pool.QueryRow(
+				ctx,
+				`UPDATE "DeadLetterQueueItem" SET "createdAt" = $2 WHERE "stepRunId" = $1 RETURNING "id"`,
+				stepRunId, sqlchelpers.TimestampFromTime(now.Add(-time.Duration(4-i)*24*time.Hour)),
+			).Scan(&id)
+
+			require.NoError(t, err)
+
+			ids[i] = sqlchelpers.UUIDToStr(id)
+		}
+
+		listIds := func(items []*dbsqlc.DeadLetterQueueItem) []string {
+			res := make([]string, len(items))
+
+			for i, item := range items {
+				res[i] = sqlchelpers.UUIDToStr(item.ID)
+			}
+
+			return res
+		}
+
+		limit := 2
+		offset := 1
+
+		list, err := dlq.ListDeadLetterQueueItems(ctx, tenantId, &repository.ListDeadLetterQueueItemsOpts{
+			Limit:  &limit,
+			Offset: &offset,
+		})
+
+		require.NoError(t, err)
+
+		// newest first, and the count is the count of all items of the tenant
+		assert.Equal(t, []string{ids[2], ids[1]}, listIds(list.Rows))
+		assert.Equal(t, 4, list.Count)
+
+		// only the items of the tenant are returned by id
+		items, err := dlq.GetDeadLetterQueueItemsByIds(ctx, otherTenantId, ids)
+		require.NoError(t, err)
+		assert.Empty(t, items)
+
+		items, err = dlq.GetDeadLetterQueueItemsByIds(ctx, tenantId, []string{ids[0], uuid.New().String()})
+		require.NoError(t, err)
+		assert.Equal(t, []string{ids[0]}, listIds(items))
+
+		// deleting by id ignores the items of other tenants
+		deleted, err := dlq.DeleteDeadLetterQueueItems(ctx, otherTenantId, &repository.DeleteDeadLetterQueueItemsOpts{
+			Ids: []string{ids[3]},
+		})
+
+		require.NoError(t, err)
+		assert.Zero(t, deleted)
+
+		deleted, err = dlq.DeleteDeadLetterQueueItems(ctx, tenantId, &repository.DeleteDeadLetterQueueItemsOpts{
+			Ids: []string{ids[3]},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, deleted)
+
+		// the items which expired are deleted by the retention controller
+		createdBefore := now.Add(-60 * time.Hour)
+
+		deleted, err = dlq.DeleteDeadLetterQueueItems(ctx, tenantId, &repository.DeleteDeadLetterQueueItemsOpts{
+			CreatedBefore: &createdBefore,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, deleted)
+
+		list, err = dlq.ListDeadLetterQueueItems(ctx, tenantId, &repository.ListDeadLetterQueueItemsOpts{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{ids[2]}, listIds(list.Rows))
+
+		// purging deletes all items of the tenant
+		deleted, err = dlq.DeleteDeadLetterQueueItems(ctx, tenantId, &repository.DeleteDeadLetterQueueItemsOpts{})
+		require.NoError(t, err)
+		assert.Equal(t, 1, deleted)
+
+		list, err = dlq.ListDeadLetterQueueItems(ctx, tenantId, &repository.ListDeadLetterQueueItemsOpts{})
+		require.NoError(t, err)
+		assert.Zero(t, list.Count)
+
+		return nil
+	})
+}
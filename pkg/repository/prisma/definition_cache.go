@@ -0,0 +1,224 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
+)
+
+const (
+	// workflowDefinitionsChannel is the channel which Postgres notifies with the tenant id when a workflow, workflow
+	// version or workflow concurrency of the tenant is written
+	workflowDefinitionsChannel = "workflow_definitions"
+
+	// definitionCacheTTL is how long a definition is cached while the engine listens to the updates
+	definitionCacheTTL = 5 * time.Minute
+
+	// definitionCachePgBouncerTTL is how long a definition is cached when the engine can't listen to the updates, which
+	// is how long an updated definition can be stale
+	definitionCachePgBouncerTTL = 5 * time.Second
+
+	// definitionCacheRelistenInterval is how long to wait before listening again when the connection is lost
+	definitionCacheRelistenInterval = 5 * time.Second
+)
+
+// definitionCache caches the definitions of workflows, which are read on the hot paths of the engine but rarely
+// change. The entries are stored under the version of their tenant when they were read, and the version of a tenant is
+// replaced when Postgres notifies that a workflow of the tenant was updated, so the entries read before the update
+// aren't used anymore. The entries can be stored in a cache which is shared by the engines, which all replace the
+// version when they're notified.
+//
+// The definitions aren't cached while the engine isn't listening to the updates, except in pgbouncer mode, where
+// the engine can't listen and the entries expire after a short time instead.
+type definitionCache struct {
+	// connect opens the connection which listens to the updates. The connection is dedicated to listening, since a
+	// listening connection can't be shared or returned to a pool.
+	connect       func(ctx context.Context) (*pgx.Conn, error)
+	l             *zerolog.Logger
+	pgBouncerMode bool
+	entries       cache.Cacheable
+
+	mu        sync.RWMutex
+	listening bool
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// definitionEpochKey is the key of the version of all tenants, which is replaced when the updates may have been missed
+const definitionEpochKey = "definition-epoch"
+
+func definitionVersionKey(tenantId string) string {
+	return fmt.Sprintf("definition-version-%s", tenantId)
+}
+
+// newDefinitionCache creates a definition cache, which stores the entries in the shared cache if it's set.
+func newDefinitionCache(pool *pgxpool.Pool, l *zerolog.Logger, pgBouncerMode bool, shared *cache.RedisCache) *definitionCache {
+	ttl := definitionCacheTTL
+
+	if pgBouncerMode {
+		ttl = definitionCachePgBouncerTTL
+	}
+
+	var entries cache.Cacheable = cache.New(ttl)
+
+	if shared != nil {
+		entries = shared.WithExpiration(ttl)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c := &definitionCache{
+		connect: func(ctx context.Context) (*pgx.Conn, error) {
+			return pgx.ConnectConfig(ctx, pool.Config().ConnConfig.Copy())
+		},
+		l:             l,
+		pgBouncerMode: pgBouncerMode,
+		entries:       entries,
+		cancel:        cancel,
+		done:          make(chan struct{}),
+	}
+
+	if pgBouncerMode {
+		close(c.done)
+	} else {
+		go c.listen(ctx)
+	}
+
+	return c
+}
+
+// getCachedDefinition returns the cached definition of the tenant with the key, or reads it with f and caches it. The
+// cache can be nil, in which case the definition is always read.
+func getCachedDefinition[T any](c *definitionCache, tenantId, key string, f func() (T, error)) (T, error) {
+	if c == nil {
+		return f()
+	}
+
+	version, ok := c.version(tenantId)
+
+	if !ok {
+		return f()
+	}
+
+	cacheKey := fmt.Sprintf("definition-%s-%s-%s", tenantId, version, key)
+
+	if v, ok := cache.GetTyped[T](c.entries, cacheKey); ok {
+		return v, nil
+	}
+
+	// the version is read before the definition, so a definition which is updated while it's read is stored under
+	// the previous version
+	value, err := f()
+
+	if err != nil {
+		return value, err
+	}
+
+	c.entries.Set(cacheKey, value)
+
+	return value, nil
+}
+
+// version returns the current version of the definitions of the tenant, and false if they can't be cached.
+func (c *definitionCache) version(tenantId string) (string, bool) {
+	c.mu.RLock()
+	cacheable := c.listening || c.pgBouncerMode
+	c.mu.RUnlock()
+
+	if !cacheable {
+		return "", false
+	}
+
+	return c.token(definitionEpochKey) + "-" + c.token(definitionVersionKey(tenantId)), true
+}
+
+// token returns the version stored with the key, and stores a new version if there's none.
+func (c *definitionCache) token(key string) string {
+	if token, ok := cache.GetTyped[string](c.entries, key); ok {
+		return token
+	}
+
+	token := uuid.New().String()
+	c.entries.Set(key, token)
+
+	return token
+}
+
+func (c *definitionCache) invalidate(tenantId string) {
+	c.entries.Set(definitionVersionKey(tenantId), uuid.New().String())
+}
+
+func (c *definitionCache) setListening(listening bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// the updates which were written while the engine wasn't listening weren't received
+	if listening && !c.listening {
+		c.entries.Set(definitionEpochKey, uuid.New().String())
+	}
+
+	c.listening = listening
+}
+
+func (c *definitionCache) stop() {
+	c.cancel()
+	<-c.done
+	c.entries.Stop()
+}
+
+func (c *definitionCache) listen(ctx context.Context) {
+	defer close(c.done)
+
+	for {
+		err := c.listenOnce(ctx)
+
+		c.setListening(false)
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		c.l.Error().Err(err).Msg("could not listen to workflow definition updates, definitions aren't cached")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(definitionCacheRelistenInterval):
+		}
+	}
+}
+
+func (c *definitionCache) listenOnce(ctx context.Context) error {
+	conn, err := c.connect(ctx)
+
+	if err != nil {
+		return fmt.Errorf("could not connect: %w", err)
+	}
+
+	defer conn.Close(context.Background()) // nolint: errcheck
+
+	if _, err := conn.Exec(ctx, "LISTEN "+workflowDefinitionsChannel); err != nil {
+		return fmt.Errorf("could not listen to workflow definition updates: %w", err)
+	}
+
+	c.setListening(true)
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+
+		if err != nil {
+			return fmt.Errorf("could not wait for workflow definition update: %w", err)
+		}
+
+		c.invalidate(n.Payload)
+	}
+}
@@ -0,0 +1,168 @@
+package prisma
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
+)
+
+func newTestDefinitionCache(t *testing.T, pgBouncerMode bool) *definitionCache {
+	t.Helper()
+
+	l := zerolog.Nop()
+
+	c := &definitionCache{
+		l:             &l,
+		pgBouncerMode: pgBouncerMode,
+		entries:       cache.New(time.Minute),
+		cancel:        func() {},
+		done:          make(chan struct{}),
+	}
+
+	close(c.done)
+	t.Cleanup(c.stop)
+
+	return c
+}
+
+// countingRead returns a read which returns the number of times it was called.
+func countingRead() (func() (int, error), *atomic.Int32) {
+	var reads atomic.Int32
+
+	return func() (int, error) {
+		return int(reads.Add(1)), nil
+	}, &reads
+}
+
+func TestDefinitionCacheInvalidate(t *testing.T) {
+	c := newTestDefinitionCache(t, false)
+	c.setListening(true)
+
+	read, reads := countingRead()
+
+	v, err := getCachedDefinition(c, "tenant-a", "workflow", read)
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+
+	v, err = getCachedDefinition(c, "tenant-a", "workflow", read)
+	require.NoError(t, err)
+	assert.Equal(t, 1, v, "the definition is cached")
+
+	_, err = getCachedDefinition(c, "tenant-b", "workflow", read)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), reads.Load())
+
+	c.invalidate("tenant-a")
+
+	v, err = getCachedDefinition(c, "tenant-a", "workflow", read)
+	require.NoError(t, err)
+	assert.Equal(t, 3, v, "the definition is read again after an update of the tenant")
+
+	_, err = getCachedDefinition(c, "tenant-b", "workflow", read)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), reads.Load(), "the definitions of other tenants stay cached")
+}
+
+func TestDefinitionCacheNotListening(t *testing.T) {
+	c := newTestDefinitionCache(t, false)
+
+	read, reads := countingRead()
+
+	for range 2 {
+		_, err := getCachedDefinition(c, "tenant-a", "workflow", read)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, int32(2), reads.Load(), "definitions aren't cached while the engine isn't listening")
+
+	c.setListening(true)
+
+	_, err := getCachedDefinition(c, "tenant-a", "workflow", read)
+	require.NoError(t, err)
+
+	// the updates can be missed while the connection is lost, so all definitions are read again after listening again
+	c.setListening(false)
+	c.setListening(true)
+
+	_, err = getCachedDefinition(c, "tenant-a", "workflow", read)
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), reads.Load())
+}
+
+func TestDefinitionCachePgBouncerMode(t *testing.T) {
+	c := newTestDefinitionCache(t, true)
+
+	read, reads := countingRead()
+
+	for range 2 {
+		_, err := getCachedDefinition(c, "tenant-a", "workflow", read)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, int32(1), reads.Load())
+}
+
+func TestDefinitionCacheNil(t *testing.T) {
+	read, reads := countingRead()
+
+	for range 2 {
+		_, err := getCachedDefinition[int](nil, "tenant-a", "workflow", read)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, int32(2), reads.Load())
+}
+
+func TestDefinitionCacheReadError(t *testing.T) {
+	c := newTestDefinitionCache(t, false)
+	c.setListening(true)
+
+	_, err := getCachedDefinition(c, "tenant-a", "workflow", func() (int, error) {
+		return 0, errors.New("read failed")
+	})
+	require.Error(t, err)
+
+	read, reads := countingRead()
+
+	_, err = getCachedDefinition(c, "tenant-a", "workflow", read)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), reads.Load(), "errors aren't cached")
+}
+
+func TestDefinitionCacheConnectError(t *testing.T) {
+	l := zerolog.Nop()
+
+	var connects atomic.Int32
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c := &definitionCache{
+		connect: func(ctx context.Context) (*pgx.Conn, error) {
+			connects.Add(1)
+			return nil, errors.New("connection refused")
+		},
+		l:       &l,
+		entries: cache.New(time.Minute),
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+
+	go c.listen(ctx)
+
+	require.Eventually(t, func() bool { return connects.Load() == 1 }, time.Second, 10*time.Millisecond)
+
+	_, ok := c.version("tenant-a")
+	assert.False(t, ok, "definitions aren't cached if the engine can't listen")
+
+	// the listener stops while it waits to connect again
+	c.stop()
+}
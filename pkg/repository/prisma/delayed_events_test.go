@@ -0,0 +1,126 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestPollDelayedEvents(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+
+		createEvent := func() string {
+			event, err := conf.EngineRepository.Event().CreateEvent(ctx, &repository.CreateEventOpts{
+				TenantId: tenantId,
+				Key:      "user:created",
+				Data:     []byte(`{}`),
+			})
+
+			require.NoError(t, err)
+
+			return sqlchelpers.UUIDToStr(event.ID)
+		}
+
+		dueEventId := createEvent()
+		laterEventId := createEvent()
+		routedWorkflowId := uuid.New().String()
+
+		err := conf.EngineRepository.Event().CreateDelayedEvents(ctx, tenantId, []*repository.CreateDelayedEventOpts{
+			{
+				EventId:           dueEventId,
+				DeliverAfter:      time.Now().UTC().Add(-time.Second),
+				IdempotencyKey:    repository.StringPtr("key"),
+				RoutedWorkflowIds: []string{routedWorkflowId},
+			},
+			{
+				EventId:      laterEventId,
+				DeliverAfter: time.Now().UTC().Add(time.Hour),
+			},
+		})
+
+		require.NoError(t, err)
+
+		createTicker := func() string {
+			ticker, err := conf.EngineRepository.Ticker().CreateNewTicker(ctx, &repository.CreateTickerOpts{
+				ID: uuid.New().String(),
+			})
+
+			require.NoError(t, err)
+
+			return sqlchelpers.UUIDToStr(ticker.ID)
+		}
+
+		// other tests may have delayed events which are due, so only the events of the tenant are compared
+		pollTenantEvents := func(tickerId string) []*dbsqlc.DelayedEvent {
+			delayedEvents, err := conf.EngineRepository.Ticker().PollDelayedEvents(ctx, tickerId)
+			require.NoError(t, err)
+
+			res := make([]*dbsqlc.DelayedEvent, 0)
+
+			for _, delayedEvent := range delayedEvents {
+				if sqlchelpers.UUIDToStr(delayedEvent.TenantId) == tenantId {
+					res = append(res, delayedEvent)
+				}
+			}
+
+			return res
+		}
+
+		tickerId := createTicker()
+
+		// only the events whose delivery time has passed are polled
+		delayedEvents := pollTenantEvents(tickerId)
+		require.Len(t, delayedEvents, 1)
+
+		assert.Equal(t, dueEventId, sqlchelpers.UUIDToStr(delayedEvents[0].EventId))
+		assert.Equal(t, tickerId, sqlchelpers.UUIDToStr(delayedEvents[0].TickerId))
+		assert.Equal(t, "key", delayedEvents[0].IdempotencyKey.String)
+		require.Len(t, delayedEvents[0].RoutedWorkflowIds, 1)
+		assert.Equal(t, routedWorkflowId, sqlchelpers.UUIDToStr(delayedEvents[0].RoutedWorkflowIds[0]))
+
+		// the event is assigned to an active ticker, so another ticker doesn't poll it
+		assert.Empty(t, pollTenantEvents(createTicker()))
+
+		// once the ticker goes down, the event is polled by another ticker
+		_, err = conf.Pool.Exec(ctx, `UPDATE "Ticker" SET "isActive" = false WHERE "id" = $1::uuid`, tickerId)
+		require.NoError(t, err)
+
+		otherTickerId := createTicker()
+
+		delayedEvents = pollTenantEvents(otherTickerId)
+		require.Len(t, delayedEvents, 1)
+		assert.Equal(t, otherTickerId, sqlchelpers.UUIDToStr(delayedEvents[0].TickerId))
+
+		require.NoError(t, conf.EngineRepository.Ticker().DeleteDelayedEvents(ctx, []string{dueEventId}))
+
+		var eventIds []string
+
+		rows, err := conf.Pool.Query(ctx, `SELECT "eventId"::text FROM "DelayedEvent" WHERE "tenantId" = $1::uuid`, tenantId)
+		require.NoError(t, err)
+
+		for rows.Next() {
+			var eventId string
+			require.NoError(t, rows.Scan(&eventId))
+			eventIds = append(eventIds, eventId)
+		}
+
+		require.NoError(t, rows.Err())
+		assert.Equal(t, []string{laterEventId}, eventIds)
+
+		return nil
+	})
+}
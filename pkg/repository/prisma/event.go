@@ -25,22 +25,24 @@ import (
 )
 
 type eventAPIRepository struct {
-	client  *db.PrismaClient
-	pool    *pgxpool.Pool
-	v       validator.Validator
-	queries *dbsqlc.Queries
-	l       *zerolog.Logger
+	client      *db.PrismaClient
+	pool        *pgxpool.Pool
+	readReplica *ReadReplica
+	v           validator.Validator
+	queries     *dbsqlc.Queries
+	l           *zerolog.Logger
 }
 
-func NewEventAPIRepository(client *db.PrismaClient, pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.EventAPIRepository {
+func NewEventAPIRepository(client *db.PrismaClient, pool *pgxpool.Pool, readReplica *ReadReplica, v validator.Validator, l *zerolog.Logger) repository.EventAPIRepository {
 	queries := dbsqlc.New()
 
 	return &eventAPIRepository{
-		client:  client,
-		pool:    pool,
-		v:       v,
-		queries: queries,
-		l:       l,
+		client:      client,
+		pool:        pool,
+		readReplica: readReplica,
+		v:           v,
+		queries:     queries,
+		l:           l,
 	}
 }
 
@@ -128,7 +130,7 @@ func (r *eventAPIRepository) ListEvents(ctx context.Context, tenantId string, op
 	queryParams.Orderby = orderByField + " " + orderByDirection
 	countParams.Orderby = orderByField + " " + orderByDirection
 
-	tx, err := r.pool.Begin(context.Background())
+	tx, err := r.readReplica.Pool(ctx).Begin(context.Background())
 
 	if err != nil {
 		return nil, err
@@ -251,6 +253,38 @@ func (r *eventEngineRepository) RegisterCreateCallback(callback repository.Tenan
 	r.callbacks = append(r.callbacks, callback)
 }
 
+func (r *eventEngineRepository) CreateDelayedEvents(ctx context.Context, tenantId string, opts []*repository.CreateDelayedEventOpts) error {
+	ctx, span := telemetry.NewSpan(ctx, "db-create-delayed-events")
+	defer span.End()
+
+	params := make([]dbsqlc.CreateDelayedEventsParams, len(opts))
+
+	for i, o := range opts {
+		if err := r.v.Validate(o); err != nil {
+			return err
+		}
+
+		params[i] = dbsqlc.CreateDelayedEventsParams{
+			EventId:           sqlchelpers.UUIDFromStr(o.EventId),
+			TenantId:          sqlchelpers.UUIDFromStr(tenantId),
+			DeliverAfter:      sqlchelpers.TimestampFromTime(o.DeliverAfter.UTC()),
+			RoutedWorkflowIds: make([]pgtype.UUID, len(o.RoutedWorkflowIds)),
+		}
+
+		if o.IdempotencyKey != nil {
+			params[i].IdempotencyKey = sqlchelpers.TextFromStr(*o.IdempotencyKey)
+		}
+
+		for j, workflowId := range o.RoutedWorkflowIds {
+			params[i].RoutedWorkflowIds[j] = sqlchelpers.UUIDFromStr(workflowId)
+		}
+	}
+
+	_, err := r.queries.CreateDelayedEvents(ctx, r.pool, params)
+
+	return err
+}
+
 func (r *eventEngineRepository) GetEventForEngine(ctx context.Context, tenantId, id string) (*dbsqlc.Event, error) {
 	return r.queries.GetEventForEngine(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
 }
@@ -377,6 +411,7 @@ func (r *eventEngineRepository) BulkCreateEvent(ctx context.Context, opts *repos
 				TenantId:           sqlchelpers.UUIDFromStr(event.TenantId),
 				Data:               event.Data,
 				AdditionalMetadata: event.AdditionalMetadata,
+				InsertOrder:        sqlchelpers.ToInt(int32(i)), // nolint: gosec
 			}
 
 			if event.ReplayedEvent != nil {
@@ -559,6 +594,21 @@ func (r *eventEngineRepository) ListEventsByIds(ctx context.Context, tenantId st
 	})
 }
 
+func (r *eventEngineRepository) ListEventsForReplay(ctx context.Context, tenantId string, opts *repository.ListEventsForReplayOpts) ([]*dbsqlc.Event, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	return r.queries.ListEventsForReplay(ctx, r.pool, dbsqlc.ListEventsForReplayParams{
+		Tenantid:           sqlchelpers.UUIDFromStr(tenantId),
+		Since:              sqlchelpers.TimestampFromTime(opts.Since),
+		Until:              sqlchelpers.TimestampFromTime(opts.Until),
+		Keys:               opts.Keys,
+		AdditionalMetadata: opts.AdditionalMetadata,
+		Limit:              int32(opts.Limit), // nolint: gosec
+	})
+}
+
 func (r *eventEngineRepository) SoftDeleteExpiredEvents(ctx context.Context, tenantId string, before time.Time) (bool, error) {
 	hasMore, err := r.queries.SoftDeleteExpiredEvents(ctx, r.pool, dbsqlc.SoftDeleteExpiredEventsParams{
 		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
@@ -0,0 +1,115 @@
+package prisma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type eventDedupRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+	cache   cache.Cacheable
+}
+
+func NewEventDedupRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, cache cache.Cacheable) repository.EventDedupRepository {
+	queries := dbsqlc.New()
+
+	return &eventDedupRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+		cache:   cache,
+	}
+}
+
+func (r *eventDedupRepository) UpsertEventDedupRule(ctx context.Context, tenantId string, opts *repository.UpsertEventDedupRuleOpts) (*dbsqlc.EventDedupRule, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	rule, err := r.queries.UpsertEventDedupRule(ctx, r.pool, dbsqlc.UpsertEventDedupRuleParams{
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Eventkey:      opts.EventKey,
+		Expression:    opts.Expression,
+		Windowseconds: opts.WindowSeconds,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not upsert event dedup rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+func (r *eventDedupRepository) ListEventDedupRules(ctx context.Context, tenantId string) ([]*dbsqlc.EventDedupRule, error) {
+	return r.queries.ListEventDedupRules(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *eventDedupRepository) GetEventDedupRuleById(ctx context.Context, id string) (*dbsqlc.EventDedupRule, error) {
+	return r.queries.GetEventDedupRuleById(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
+
+func (r *eventDedupRepository) GetEventDedupRuleForKey(ctx context.Context, tenantId, eventKey string) (*dbsqlc.EventDedupRule, error) {
+	return cache.MakeCacheable(r.cache, fmt.Sprintf("event-dedup-rule-%s-%s", tenantId, eventKey), func() (*dbsqlc.EventDedupRule, error) {
+		rule, err := r.queries.GetEventDedupRuleForKey(ctx, r.pool, dbsqlc.GetEventDedupRuleForKeyParams{
+			Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+			Eventkey: eventKey,
+		})
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("could not get event dedup rule: %w", err)
+		}
+
+		return rule, nil
+	})
+}
+
+func (r *eventDedupRepository) DeleteEventDedupRule(ctx context.Context, id string) error {
+	return r.queries.DeleteEventDedupRule(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
+
+func (r *eventDedupRepository) ClaimEventDedupKey(ctx context.Context, tenantId, eventKey, dedupKey, eventId string, window time.Duration) (bool, error) {
+	_, err := r.queries.ClaimEventDedupKey(ctx, r.pool, dbsqlc.ClaimEventDedupKeyParams{
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Eventkey:  eventKey,
+		Dedupkey:  dedupKey,
+		Eventid:   sqlchelpers.UUIDFromStr(eventId),
+		Expiresat: sqlchelpers.TimestampFromTime(time.Now().UTC().Add(window)),
+	})
+
+	if err == nil {
+		return true, nil
+	}
+
+	// the key is held by a different event which has not expired
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+
+	return false, fmt.Errorf("could not claim event dedup key: %w", err)
+}
+
+func (r *eventDedupRepository) DeleteExpiredEventDedupKeys(ctx context.Context, tenantId string) error {
+	_, err := r.queries.DeleteExpiredEventDedupKeys(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+
+	return err
+}
@@ -0,0 +1,136 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestUpsertEventDedupRule(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		repo := conf.EngineRepository.EventDedup()
+
+		rule, err := repo.UpsertEventDedupRule(ctx, tenantId, &repository.UpsertEventDedupRuleOpts{
+			EventKey:      "order:created",
+			Expression:    "input.orderId",
+			WindowSeconds: 60,
+		})
+
+		require.NoError(t, err)
+
+		// the rule of an event key is replaced
+		updated, err := repo.UpsertEventDedupRule(ctx, tenantId, &repository.UpsertEventDedupRuleOpts{
+			EventKey:      "order:created",
+			Expression:    "input.customerId",
+			WindowSeconds: 120,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, sqlchelpers.UUIDToStr(rule.ID), sqlchelpers.UUIDToStr(updated.ID))
+
+		rules, err := repo.ListEventDedupRules(ctx, tenantId)
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		assert.Equal(t, "input.customerId", rules[0].Expression)
+		assert.Equal(t, int32(120), rules[0].WindowSeconds)
+
+		_, err = repo.UpsertEventDedupRule(ctx, tenantId, &repository.UpsertEventDedupRuleOpts{
+			EventKey:   "order:created",
+			Expression: "input.orderId",
+		})
+
+		assert.Error(t, err, "the window is required")
+
+		require.NoError(t, repo.DeleteEventDedupRule(ctx, sqlchelpers.UUIDToStr(rule.ID)))
+
+		rules, err = repo.ListEventDedupRules(ctx, tenantId)
+		require.NoError(t, err)
+		assert.Empty(t, rules)
+
+		return nil
+	})
+}
+
+func TestClaimEventDedupKey(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		repo := conf.EngineRepository.EventDedup()
+
+		firstEventId := uuid.New().String()
+
+		claimed, err := repo.ClaimEventDedupKey(ctx, tenantId, "order:created", "1", firstEventId, time.Minute)
+		require.NoError(t, err)
+		assert.True(t, claimed, "the first event claims the dedup key")
+
+		// the processing of the event is retried, so it isn't a duplicate of itself
+		claimed, err = repo.ClaimEventDedupKey(ctx, tenantId, "order:created", "1", firstEventId, time.Minute)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+
+		claimed, err = repo.ClaimEventDedupKey(ctx, tenantId, "order:created", "1", uuid.New().String(), time.Minute)
+		require.NoError(t, err)
+		assert.False(t, claimed, "an event with the same dedup key in the window is a duplicate")
+
+		// dedup keys are scoped to the event key and the tenant
+		claimed, err = repo.ClaimEventDedupKey(ctx, tenantId, "order:updated", "1", uuid.New().String(), time.Minute)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+
+		claimed, err = repo.ClaimEventDedupKey(ctx, createTestTenant(t, conf), "order:created", "1", uuid.New().String(), time.Minute)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+
+		// once the window has ended, the dedup key can be claimed by another event
+		_, err = conf.Pool.Exec(
+			ctx,
+			`UPDATE "EventDedupKey" SET "expiresAt" = NOW() - INTERVAL '1 second' WHERE "tenantId" = $1::uuid AND "eventKey" = 'order:created'`,
+			tenantId,
+		)
+
+		require.NoError(t, err)
+
+		claimed, err = repo.ClaimEventDedupKey(ctx, tenantId, "order:created", "1", uuid.New().String(), time.Minute)
+		require.NoError(t, err)
+		assert.True(t, claimed)
+
+		// the expired dedup keys are deleted by the retention controller
+		_, err = conf.Pool.Exec(
+			ctx,
+			`UPDATE "EventDedupKey" SET "expiresAt" = NOW() - INTERVAL '1 second' WHERE "tenantId" = $1::uuid AND "eventKey" = 'order:updated'`,
+			tenantId,
+		)
+
+		require.NoError(t, err)
+		require.NoError(t, repo.DeleteExpiredEventDedupKeys(ctx, tenantId))
+
+		var eventKeys []string
+
+		rows, err := conf.Pool.Query(ctx, `SELECT "eventKey" FROM "EventDedupKey" WHERE "tenantId" = $1::uuid`, tenantId)
+		require.NoError(t, err)
+
+		for rows.Next() {
+			var eventKey string
+			require.NoError(t, rows.Scan(&eventKey))
+			eventKeys = append(eventKeys, eventKey)
+		}
+
+		require.NoError(t, rows.Err())
+		assert.Equal(t, []string{"order:created"}, eventKeys)
+
+		return nil
+	})
+}
@@ -0,0 +1,108 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestListEventsForReplay(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		repo := conf.EngineRepository.Event()
+
+		createEvent := func(key string, additionalMetadata []byte, replayedEvent *string) *dbsqlc.Event {
+			event, err := repo.CreateEvent(ctx, &repository.CreateEventOpts{
+				TenantId:           tenantId,
+				Key:                key,
+				Data:               []byte(`{}`),
+				AdditionalMetadata: additionalMetadata,
+				ReplayedEvent:      replayedEvent,
+			})
+
+			require.NoError(t, err)
+
+			return event
+		}
+
+		first := createEvent("order:created", nil, nil)
+		second := createEvent("user:created", []byte(`{"region":"eu","source":"signup"}`), nil)
+		third := createEvent("order:created", nil, nil)
+
+		// a replay of an event isn't replayed again
+		firstId := sqlchelpers.UUIDToStr(first.ID)
+		createEvent("order:created", nil, &firstId)
+
+		eventIds := func(events []*dbsqlc.Event) []string {
+			ids := make([]string, len(events))
+
+			for i, event := range events {
+				ids[i] = sqlchelpers.UUIDToStr(event.ID)
+			}
+
+			return ids
+		}
+
+		since := first.CreatedAt.Time
+		until := time.Now().UTC().Add(time.Minute)
+
+		tests := []struct {
+			name     string
+			opts     *repository.ListEventsForReplayOpts
+			expected []*dbsqlc.Event
+		}{
+			{
+				name:     "all events in the window",
+				opts:     &repository.ListEventsForReplayOpts{Since: since, Until: until, Limit: 10},
+				expected: []*dbsqlc.Event{first, second, third},
+			},
+			{
+				name:     "the end of the window is exclusive",
+				opts:     &repository.ListEventsForReplayOpts{Since: since, Until: third.CreatedAt.Time, Limit: 10},
+				expected: []*dbsqlc.Event{first, second},
+			},
+			{
+				name:     "keys",
+				opts:     &repository.ListEventsForReplayOpts{Since: since, Until: until, Keys: []string{"order:created"}, Limit: 10},
+				expected: []*dbsqlc.Event{first, third},
+			},
+			{
+				name:     "additional metadata",
+				opts:     &repository.ListEventsForReplayOpts{Since: since, Until: until, AdditionalMetadata: []byte(`{"region":"eu"}`), Limit: 10},
+				expected: []*dbsqlc.Event{second},
+			},
+			{
+				name:     "limit",
+				opts:     &repository.ListEventsForReplayOpts{Since: since, Until: until, Limit: 2},
+				expected: []*dbsqlc.Event{first, second},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				events, err := repo.ListEventsForReplay(ctx, tenantId, tt.opts)
+				require.NoError(t, err)
+
+				assert.Equal(t, eventIds(tt.expected), eventIds(events))
+			})
+		}
+
+		// the window must not be empty
+		_, err := repo.ListEventsForReplay(ctx, tenantId, &repository.ListEventsForReplayOpts{Since: until, Until: since, Limit: 10})
+		assert.Error(t, err)
+
+		return nil
+	})
+}
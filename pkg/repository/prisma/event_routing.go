@@ -0,0 +1,90 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type eventRoutingRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+	cache   cache.Cacheable
+}
+
+func NewEventRoutingRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, cache cache.Cacheable) repository.EventRoutingRepository {
+	queries := dbsqlc.New()
+
+	return &eventRoutingRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+		cache:   cache,
+	}
+}
+
+func (r *eventRoutingRepository) UpsertEventRoutingRule(ctx context.Context, tenantId string, opts *repository.UpsertEventRoutingRuleOpts) (*dbsqlc.EventRoutingRule, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.UpsertEventRoutingRuleParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Name:       opts.Name,
+		Expression: opts.Expression,
+		Workflowid: sqlchelpers.UUIDFromStr(opts.WorkflowId),
+	}
+
+	if opts.EventKey != nil {
+		params.EventKey = sqlchelpers.TextFromStr(*opts.EventKey)
+	}
+
+	rule, err := r.queries.UpsertEventRoutingRule(ctx, r.pool, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not upsert event routing rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+func (r *eventRoutingRepository) ListEventRoutingRules(ctx context.Context, tenantId string) ([]*dbsqlc.ListEventRoutingRulesRow, error) {
+	return r.queries.ListEventRoutingRules(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *eventRoutingRepository) ListEventRoutingRulesForEngine(ctx context.Context, tenantId string) ([]*dbsqlc.EventRoutingRule, error) {
+	rules, err := cache.MakeCacheable(r.cache, fmt.Sprintf("event-routing-rules-%s", tenantId), func() (*[]*dbsqlc.EventRoutingRule, error) {
+		rules, err := r.queries.ListEventRoutingRulesForEngine(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+
+		if err != nil {
+			return nil, fmt.Errorf("could not list event routing rules: %w", err)
+		}
+
+		return &rules, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return *rules, nil
+}
+
+func (r *eventRoutingRepository) GetEventRoutingRuleById(ctx context.Context, id string) (*dbsqlc.EventRoutingRule, error) {
+	return r.queries.GetEventRoutingRuleById(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
+
+func (r *eventRoutingRepository) DeleteEventRoutingRule(ctx context.Context, id string) error {
+	return r.queries.DeleteEventRoutingRule(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
@@ -0,0 +1,167 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type eventSinkRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewEventSinkRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.EventSinkRepository {
+	queries := dbsqlc.New()
+
+	return &eventSinkRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *eventSinkRepository) CreateEventSink(ctx context.Context, tenantId string, opts *repository.CreateEventSinkOpts) (*dbsqlc.EventSink, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.CreateEventSinkParams{
+		Tenantid:    sqlchelpers.UUIDFromStr(tenantId),
+		Name:        opts.Name,
+		Kind:        opts.Kind,
+		Brokers:     opts.Brokers,
+		Tls:         opts.TLS,
+		Eventtypes:  opts.EventTypes,
+		Maxattempts: opts.MaxAttempts,
+		Format:      opts.Format,
+	}
+
+	if params.Brokers == nil {
+		params.Brokers = []string{}
+	}
+
+	if params.Eventtypes == nil {
+		params.Eventtypes = []string{}
+	}
+
+	if opts.URL != nil {
+		params.Url = sqlchelpers.TextFromStr(*opts.URL)
+	}
+
+	if opts.Topic != nil {
+		params.Topic = sqlchelpers.TextFromStr(*opts.Topic)
+	}
+
+	if opts.Secret != nil {
+		params.Secret = sqlchelpers.TextFromStr(*opts.Secret)
+	}
+
+	if opts.UsernameSecret != nil {
+		params.UsernameSecret = sqlchelpers.TextFromStr(*opts.UsernameSecret)
+	}
+
+	if opts.PasswordSecret != nil {
+		params.PasswordSecret = sqlchelpers.TextFromStr(*opts.PasswordSecret)
+	}
+
+	sink, err := r.queries.CreateEventSink(ctx, r.pool, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create event sink: %w", err)
+	}
+
+	return sink, nil
+}
+
+func (r *eventSinkRepository) ListEventSinks(ctx context.Context, tenantId string) ([]*dbsqlc.EventSink, error) {
+	return r.queries.ListEventSinks(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *eventSinkRepository) ListEventSinksByPartitionId(ctx context.Context, partitionId string) ([]*dbsqlc.EventSink, error) {
+	return r.queries.ListEventSinksByPartitionId(ctx, r.pool, partitionId)
+}
+
+func (r *eventSinkRepository) GetEventSinkById(ctx context.Context, id string) (*dbsqlc.EventSink, error) {
+	return r.queries.GetEventSinkById(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
+
+func (r *eventSinkRepository) DeleteEventSink(ctx context.Context, id string) error {
+	return r.queries.DeleteEventSink(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
+
+func (r *eventSinkRepository) CountEventSinkDeliveries(ctx context.Context, tenantId string) ([]*dbsqlc.CountEventSinkDeliveriesRow, error) {
+	return r.queries.CountEventSinkDeliveries(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *eventSinkRepository) CreateEventSinkDeliveries(ctx context.Context, tenantId string, opts []*repository.CreateEventSinkDeliveryOpts) error {
+	if len(opts) == 0 {
+		return nil
+	}
+
+	sinkIds := make([]pgtype.UUID, len(opts))
+	payloads := make([][]byte, len(opts))
+
+	for i, opt := range opts {
+		if err := r.v.Validate(opt); err != nil {
+			return err
+		}
+
+		sinkIds[i] = sqlchelpers.UUIDFromStr(opt.SinkId)
+		payloads[i] = opt.Payload
+	}
+
+	err := r.queries.CreateEventSinkDeliveries(ctx, r.pool, dbsqlc.CreateEventSinkDeliveriesParams{
+		Sinkids:  sinkIds,
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Payloads: payloads,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not create event sink deliveries: %w", err)
+	}
+
+	return nil
+}
+
+func (r *eventSinkRepository) ListDueEventSinkDeliveries(ctx context.Context, sinkId string, limit int) ([]*dbsqlc.EventSinkDelivery, error) {
+	return r.queries.ListDueEventSinkDeliveries(ctx, r.pool, dbsqlc.ListDueEventSinkDeliveriesParams{
+		Sinkid: sqlchelpers.UUIDFromStr(sinkId),
+		Limit:  int32(limit), // nolint: gosec
+	})
+}
+
+func (r *eventSinkRepository) DeleteEventSinkDeliveries(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return r.queries.DeleteEventSinkDeliveries(ctx, r.pool, ids)
+}
+
+func (r *eventSinkRepository) FailEventSinkDeliveries(ctx context.Context, ids []int64, maxAttempts int32, lastError string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return r.queries.FailEventSinkDeliveries(ctx, r.pool, dbsqlc.FailEventSinkDeliveriesParams{
+		Lasterror:   lastError,
+		Maxattempts: maxAttempts,
+		Ids:         ids,
+	})
+}
+
+func (r *eventSinkRepository) ReplayEventSinkDeliveries(ctx context.Context, sinkId string) (int64, error) {
+	return r.queries.ReplayEventSinkDeliveries(ctx, r.pool, sqlchelpers.UUIDFromStr(sinkId))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 
+	"github.com/hatchet-dev/hatchet/internal/statemachine"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
@@ -112,8 +113,10 @@ func (s *getGroupKeyRunRepository) UpdateGetGroupKeyRun(ctx context.Context, ten
 	}
 
 	updateWorkflowRunParams := dbsqlc.UpdateWorkflowRunGroupKeyFromRunParams{
-		Tenantid:      pgTenantId,
-		Groupkeyrunid: sqlchelpers.UUIDFromStr(getGroupKeyRunId),
+		Tenantid:           pgTenantId,
+		Groupkeyrunid:      sqlchelpers.UUIDFromStr(getGroupKeyRunId),
+		Failedfromstatuses: statemachine.WorkflowRunSources(dbsqlc.WorkflowRunStatusFAILED),
+		Queuedfromstatuses: statemachine.WorkflowRunSources(dbsqlc.WorkflowRunStatusQUEUED),
 	}
 
 	if opts.RequeueAfter != nil {
@@ -2,12 +2,16 @@ package prisma
 
 import (
 	"context"
+	"fmt"
+	"sync"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/hatchet-dev/hatchet/internal/migrate"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/sql/migrations"
 )
 
 type healthAPIRepository struct {
@@ -45,6 +49,18 @@ func (a *healthAPIRepository) PgStat() *pgxpool.Stat {
 	return stat
 }
 
+func (a *healthAPIRepository) Ping(ctx context.Context) error {
+	return ping(ctx, a.queries, a.pool)
+}
+
+func (a *healthAPIRepository) CheckLease(ctx context.Context, holderId string) error {
+	return checkLease(ctx, a.queries, a.pool, holderId)
+}
+
+func (a *healthAPIRepository) ListPendingMigrations(ctx context.Context) ([]string, error) {
+	return listPendingMigrations(ctx, a.pool)
+}
+
 type healthEngineRepository struct {
 	queries *dbsqlc.Queries
 	pool    *pgxpool.Pool
@@ -73,3 +89,78 @@ func (a *healthEngineRepository) PgStat() *pgxpool.Stat {
 	stat := a.pool.Stat()
 	return stat
 }
+
+func (a *healthEngineRepository) Ping(ctx context.Context) error {
+	return ping(ctx, a.queries, a.pool)
+}
+
+func (a *healthEngineRepository) CheckLease(ctx context.Context, holderId string) error {
+	return checkLease(ctx, a.queries, a.pool, holderId)
+}
+
+func (a *healthEngineRepository) ListPendingMigrations(ctx context.Context) ([]string, error) {
+	return listPendingMigrations(ctx, a.pool)
+}
+
+func ping(ctx context.Context, queries *dbsqlc.Queries, pool *pgxpool.Pool) error {
+	_, err := queries.Health(ctx, pool)
+
+	return err
+}
+
+func checkLease(ctx context.Context, queries *dbsqlc.Queries, pool *pgxpool.Pool, holderId string) error {
+	// the lease is named after its holder, so the checks of the engines don't contend for it
+	name := "healthcheck:" + holderId
+
+	acquired, err := queries.AcquireEngineLease(ctx, pool, dbsqlc.AcquireEngineLeaseParams{
+		Name:            name,
+		Holderid:        holderId,
+		Durationseconds: 10,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not acquire lease: %w", err)
+	}
+
+	if !acquired {
+		return fmt.Errorf("lease %s is held by another holder", name)
+	}
+
+	err = queries.ReleaseEngineLease(ctx, pool, dbsqlc.ReleaseEngineLeaseParams{
+		Name:     name,
+		Holderid: holderId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not release lease: %w", err)
+	}
+
+	return nil
+}
+
+// loadMigrations parses the embedded migrations once, since they don't change while the binary runs.
+var loadMigrations = sync.OnceValues(func() ([]*migrate.Migration, error) {
+	return migrate.LoadFS(migrations.FS)
+})
+
+func listPendingMigrations(ctx context.Context, pool *pgxpool.Pool) ([]string, error) {
+	all, err := loadMigrations()
+
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := migrate.ListPending(ctx, pool, all)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, len(pending))
+
+	for i, m := range pending {
+		res[i] = m.File
+	}
+
+	return res, nil
+}
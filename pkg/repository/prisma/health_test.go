@@ -0,0 +1,43 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+)
+
+func TestHealthProbes(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		repo := conf.EngineRepository.Health()
+
+		require.NoError(t, repo.Ping(ctx))
+
+		// the lease is released after the check, so it can be checked again
+		holderId := uuid.New().String()
+
+		require.NoError(t, repo.CheckLease(ctx, holderId))
+		require.NoError(t, repo.CheckLease(ctx, holderId))
+
+		var leases int
+
+		err := conf.Pool.QueryRow(ctx, `SELECT COUNT(*) FROM "EngineLease" WHERE "holderId" = $1`, holderId).Scan(&leases)
+		require.NoError(t, err)
+		assert.Zero(t, leases)
+
+		// the database of the tests is migrated to the embedded migrations
+		pending, err := repo.ListPendingMigrations(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+
+		return nil
+	})
+}
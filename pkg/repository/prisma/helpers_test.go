@@ -0,0 +1,91 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// createTestTenant creates a tenant with a unique slug, so the tests can run against a database which is used by
+// other tests.
+func createTestTenant(t *testing.T, conf *database.Config) string {
+	t.Helper()
+
+	slugSuffix, err := random.Generate(8)
+	require.NoError(t, err)
+
+	tenant, err := conf.APIRepository.Tenant().CreateTenant(&repository.CreateTenantOpts{
+		Name: "test-tenant",
+		Slug: fmt.Sprintf("test-tenant-%s", slugSuffix),
+	})
+
+	require.NoError(t, err)
+
+	return sqlchelpers.UUIDToStr(tenant.ID)
+}
+
+// createTestWorkflow creates a workflow with a single step.
+func createTestWorkflow(t *testing.T, conf *database.Config, tenantId, name string) *dbsqlc.GetWorkflowVersionForEngineRow {
+	t.Helper()
+
+	version, err := conf.EngineRepository.Workflow().CreateNewWorkflow(context.Background(), tenantId, &repository.CreateWorkflowVersionOpts{
+		Name: name,
+		Jobs: []repository.CreateWorkflowJobOpts{
+			{
+				Name: "job",
+				Kind: "DEFAULT",
+				Steps: []repository.CreateWorkflowStepOpts{
+					{
+						ReadableId: "step",
+						Action:     "test:step",
+					},
+				},
+			},
+		},
+	})
+
+	require.NoError(t, err)
+
+	return version
+}
+
+// createTestWorkflowRun creates a manually triggered run of the workflow version.
+func createTestWorkflowRun(t *testing.T, conf *database.Config, tenantId string, version *dbsqlc.GetWorkflowVersionForEngineRow) *dbsqlc.WorkflowRun {
+	t.Helper()
+
+	opts, err := repository.GetCreateWorkflowRunOptsFromManual(version, []byte(`{}`), nil)
+	require.NoError(t, err)
+
+	run, err := conf.EngineRepository.WorkflowRun().CreateNewWorkflowRun(context.Background(), tenantId, opts)
+	require.NoError(t, err)
+
+	return run
+}
+
+// getTestStepRunId returns the id of the step run of a run of a workflow with a single step.
+func getTestStepRunId(t *testing.T, conf *database.Config, workflowRunId pgtype.UUID) pgtype.UUID {
+	t.Helper()
+
+	var stepRunId pgtype.UUID
+
+	err := conf.Pool.QueryRow(
+		context.Background(),
+		`SELECT sr."id" FROM "StepRun" sr JOIN "JobRun" jr ON jr."id" = sr."jobRunId" WHERE jr."workflowRunId" = $1`,
+		workflowRunId,
+	).Scan(&stepRunId)
+
+	require.NoError(t, err)
+
+	return stepRunId
+}
@@ -0,0 +1,130 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestClaimIdempotencyKey(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		version := createTestWorkflow(t, conf, tenantId, "idempotency")
+		otherVersion := createTestWorkflow(t, conf, tenantId, "idempotency-other")
+
+		versionId := sqlchelpers.UUIDToStr(version.WorkflowVersion.ID)
+		otherVersionId := sqlchelpers.UUIDToStr(otherVersion.WorkflowVersion.ID)
+
+		repo := conf.EngineRepository.WorkflowRun()
+
+		firstRunId := uuid.New().String()
+
+		existing, err := repo.ClaimIdempotencyKey(ctx, tenantId, versionId, "key", firstRunId)
+		require.NoError(t, err)
+		assert.Nil(t, existing, "the first trigger claims the key")
+
+		// a duplicate trigger returns the workflow run which claimed the key
+		existing, err = repo.ClaimIdempotencyKey(ctx, tenantId, versionId, "key", uuid.New().String())
+		require.NoError(t, err)
+		require.NotNil(t, existing)
+		assert.Equal(t, firstRunId, *existing)
+
+		// keys are scoped to the workflow and the tenant
+		existing, err = repo.ClaimIdempotencyKey(ctx, tenantId, otherVersionId, "key", uuid.New().String())
+		require.NoError(t, err)
+		assert.Nil(t, existing)
+
+		otherTenantId := createTestTenant(t, conf)
+		otherTenantVersion := createTestWorkflow(t, conf, otherTenantId, "idempotency")
+
+		existing, err = repo.ClaimIdempotencyKey(ctx, otherTenantId, sqlchelpers.UUIDToStr(otherTenantVersion.WorkflowVersion.ID), "key", uuid.New().String())
+		require.NoError(t, err)
+		assert.Nil(t, existing)
+
+		// the workflow run could not be created, so the key is released and the trigger can be retried
+		require.NoError(t, repo.ReleaseIdempotencyKeys(ctx, tenantId, []string{firstRunId}))
+
+		retryRunId := uuid.New().String()
+
+		existing, err = repo.ClaimIdempotencyKey(ctx, tenantId, versionId, "key", retryRunId)
+		require.NoError(t, err)
+		assert.Nil(t, existing)
+
+		// an expired key can be claimed by a new workflow run
+		_, err = conf.Pool.Exec(
+			ctx,
+			`UPDATE "WorkflowRunIdempotencyKey" SET "expiresAt" = NOW() - INTERVAL '1 minute' WHERE "workflowRunId" = $1::uuid`,
+			retryRunId,
+		)
+
+		require.NoError(t, err)
+
+		newRunId := uuid.New().String()
+
+		existing, err = repo.ClaimIdempotencyKey(ctx, tenantId, versionId, "key", newRunId)
+		require.NoError(t, err)
+		assert.Nil(t, existing)
+
+		existing, err = repo.ClaimIdempotencyKey(ctx, tenantId, versionId, "key", uuid.New().String())
+		require.NoError(t, err)
+		require.NotNil(t, existing)
+		assert.Equal(t, newRunId, *existing)
+
+		return nil
+	})
+}
+
+func TestDeleteExpiredIdempotencyKeys(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		versionId := sqlchelpers.UUIDToStr(createTestWorkflow(t, conf, tenantId, "idempotency").WorkflowVersion.ID)
+
+		repo := conf.EngineRepository.WorkflowRun()
+
+		expiredRunId := uuid.New().String()
+		activeRunId := uuid.New().String()
+
+		for key, runId := range map[string]string{"expired": expiredRunId, "active": activeRunId} {
+			existing, err := repo.ClaimIdempotencyKey(ctx, tenantId, versionId, key, runId)
+			require.NoError(t, err)
+			require.Nil(t, existing)
+		}
+
+		_, err := conf.Pool.Exec(
+			ctx,
+			`UPDATE "WorkflowRunIdempotencyKey" SET "expiresAt" = NOW() - INTERVAL '1 minute' WHERE "workflowRunId" = $1::uuid`,
+			expiredRunId,
+		)
+
+		require.NoError(t, err)
+
+		require.NoError(t, repo.DeleteExpiredIdempotencyKeys(ctx, tenantId))
+
+		var keys []string
+
+		rows, err := conf.Pool.Query(ctx, `SELECT "key" FROM "WorkflowRunIdempotencyKey" WHERE "tenantId" = $1::uuid`, tenantId)
+		require.NoError(t, err)
+
+		for rows.Next() {
+			var key string
+			require.NoError(t, rows.Scan(&key))
+			keys = append(keys, key)
+		}
+
+		require.NoError(t, rows.Err())
+		assert.Equal(t, []string{"active"}, keys)
+
+		return nil
+	})
+}
@@ -0,0 +1,81 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type inboundWebhookRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewInboundWebhookRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.InboundWebhookRepository {
+	queries := dbsqlc.New()
+
+	return &inboundWebhookRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *inboundWebhookRepository) CreateInboundWebhook(ctx context.Context, tenantId string, opts *repository.CreateInboundWebhookOpts) (*dbsqlc.InboundWebhook, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.CreateInboundWebhookParams{
+		Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+		Name:            opts.Name,
+		Signaturescheme: opts.SignatureScheme,
+		Secret:          opts.Secret,
+	}
+
+	if opts.SignatureHeader != nil {
+		params.SignatureHeader = sqlchelpers.TextFromStr(*opts.SignatureHeader)
+	}
+
+	if opts.EventKey != nil {
+		params.EventKey = sqlchelpers.TextFromStr(*opts.EventKey)
+	}
+
+	if opts.EventKeyExpression != nil {
+		params.EventKeyExpression = sqlchelpers.TextFromStr(*opts.EventKeyExpression)
+	}
+
+	if opts.DataExpression != nil {
+		params.DataExpression = sqlchelpers.TextFromStr(*opts.DataExpression)
+	}
+
+	webhook, err := r.queries.CreateInboundWebhook(ctx, r.pool, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create inbound webhook: %w", err)
+	}
+
+	return webhook, nil
+}
+
+func (r *inboundWebhookRepository) ListInboundWebhooks(ctx context.Context, tenantId string) ([]*dbsqlc.InboundWebhook, error) {
+	return r.queries.ListInboundWebhooks(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *inboundWebhookRepository) GetInboundWebhookById(ctx context.Context, id string) (*dbsqlc.InboundWebhook, error) {
+	return r.queries.GetInboundWebhookById(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
+
+func (r *inboundWebhookRepository) DeleteInboundWebhook(ctx context.Context, id string) error {
+	return r.queries.DeleteInboundWebhook(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
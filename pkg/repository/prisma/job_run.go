@@ -9,6 +9,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 
+	"github.com/hatchet-dev/hatchet/internal/statemachine"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
@@ -49,7 +50,7 @@ func (j *jobRunAPIRepository) RegisterWorkflowRunRunningCallback(callback reposi
 func (j *jobRunAPIRepository) SetJobRunStatusRunning(tenantId, jobRunId string) error {
 	wrId, err := setJobRunStatusRunning(context.Background(), j.pool, j.queries, j.l, tenantId, jobRunId)
 
-	if err != nil {
+	if err != nil || wrId == nil {
 		return err
 	}
 
@@ -100,7 +101,7 @@ func (j *jobRunEngineRepository) RegisterWorkflowRunRunningCallback(callback rep
 func (j *jobRunEngineRepository) SetJobRunStatusRunning(ctx context.Context, tenantId, jobRunId string) error {
 	wrId, err := setJobRunStatusRunning(ctx, j.pool, j.queries, j.l, tenantId, jobRunId)
 
-	if err != nil {
+	if err != nil || wrId == nil {
 		return err
 	}
 
@@ -140,16 +141,28 @@ func setJobRunStatusRunning(ctx context.Context, pool *pgxpool.Pool, queries *db
 	defer sqlchelpers.DeferRollback(context.Background(), l, tx.Rollback)
 
 	jobRun, err := queries.UpdateJobRunStatus(context.Background(), tx, dbsqlc.UpdateJobRunStatusParams{
-		ID:       sqlchelpers.UUIDFromStr(jobRunId),
-		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
-		Status:   dbsqlc.JobRunStatusRUNNING,
+		ID:           sqlchelpers.UUIDFromStr(jobRunId),
+		Tenantid:     sqlchelpers.UUIDFromStr(tenantId),
+		Status:       dbsqlc.JobRunStatusRUNNING,
+		Fromstatuses: statemachine.JobRunSources(dbsqlc.JobRunStatusRUNNING),
 	})
 
+	// a job run which already finished, like a cancelled job run, isn't started again
+	if errors.Is(err, pgx.ErrNoRows) {
+		recordRejectedJobRunTransition(
+			ctx, queries, tx, l,
+			sqlchelpers.UUIDFromStr(tenantId), sqlchelpers.UUIDFromStr(jobRunId),
+			dbsqlc.JobRunStatusRUNNING,
+		)
+
+		return nil, nil
+	}
+
 	if err != nil {
 		return nil, err
 	}
 
-	wr, err := queries.UpdateWorkflowRun(
+	_, err = queries.UpdateWorkflowRun(
 		context.Background(),
 		tx,
 		dbsqlc.UpdateWorkflowRunParams{
@@ -159,18 +172,24 @@ func setJobRunStatusRunning(ctx context.Context, pool *pgxpool.Pool, queries *db
 				WorkflowRunStatus: dbsqlc.WorkflowRunStatusRUNNING,
 				Valid:             true,
 			},
+			Fromstatuses: statemachine.WorkflowRunSources(dbsqlc.WorkflowRunStatusRUNNING),
 		},
 	)
 
-	if err != nil {
+	// the workflow run isn't updated if it can't transition to running, but the job run is still started
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
 		return nil, err
 	}
 
+	if err != nil {
+		recordRejectedWorkflowRunTransition(ctx, queries, tx, l, jobRun.TenantId, jobRun.WorkflowRunId, dbsqlc.WorkflowRunStatusRUNNING)
+	}
+
 	if err := tx.Commit(context.Background()); err != nil {
 		return nil, err
 	}
 
-	return &wr.ID, nil
+	return &jobRun.WorkflowRunId, nil
 }
 
 func (r *jobRunEngineRepository) ClearJobRunPayloadData(ctx context.Context, tenantId string) (bool, error) {
@@ -189,3 +208,35 @@ func (r *jobRunEngineRepository) ClearJobRunPayloadData(ctx context.Context, ten
 
 	return hasMore, nil
 }
+
+// jobRunLookupDataMaxTail is the number of entries of a job run after which they're compacted into its lookup data
+const jobRunLookupDataMaxTail = 100
+
+// updateJobRunLookupDataWithStepRun sets the output of the step run in the lookup data of its job run, or removes it
+// if the output is nil. The output is appended as an entry, and the entries are compacted into the lookup data once
+// there are jobRunLookupDataMaxTail of them, so long-running job runs don't rewrite all outputs for each step run.
+func updateJobRunLookupDataWithStepRun(ctx context.Context, queries *dbsqlc.Queries, tx dbsqlc.DBTX, tenantId, stepRunId pgtype.UUID, output []byte) error {
+	entry, err := queries.AppendJobRunLookupDataEntry(ctx, tx, dbsqlc.AppendJobRunLookupDataEntryParams{
+		Steprunid: stepRunId,
+		Tenantid:  tenantId,
+		Jsondata:  output,
+	})
+
+	if err != nil {
+		// the step run was deleted
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+
+	if entry.TailLength < jobRunLookupDataMaxTail {
+		return nil
+	}
+
+	return queries.CompactJobRunLookupData(ctx, tx, dbsqlc.CompactJobRunLookupDataParams{
+		Jobrunid: entry.JobRunId,
+		Tenantid: tenantId,
+	})
+}
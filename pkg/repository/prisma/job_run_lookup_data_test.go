@@ -0,0 +1,102 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestJobRunLookupDataEntries(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		version := createTestWorkflow(t, conf, tenantId, "lookup-data")
+		run := createTestWorkflowRun(t, conf, tenantId, version)
+		stepRunId := getTestStepRunId(t, conf, run.ID)
+		queries := dbsqlc.New()
+
+		appendEntry := func(output []byte) *dbsqlc.AppendJobRunLookupDataEntryRow {
+			entry, err := queries.AppendJobRunLookupDataEntry(ctx, conf.Pool, dbsqlc.AppendJobRunLookupDataEntryParams{
+				Steprunid: stepRunId,
+				Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+				Jsondata:  output,
+			})
+
+			require.NoError(t, err)
+
+			return entry
+		}
+
+		compact := func(jobRunId pgtype.UUID) {
+			err := queries.CompactJobRunLookupData(ctx, conf.Pool, dbsqlc.CompactJobRunLookupDataParams{
+				Jobrunid: jobRunId,
+				Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+			})
+
+			require.NoError(t, err)
+		}
+
+		// stepOutputs returns the outputs of the steps which are read by the engine, which merges the lookup data
+		// with the entries which weren't compacted yet
+		stepOutputs := func() map[string]json.RawMessage {
+			data, err := conf.EngineRepository.StepRun().GetStepRunDataForEngine(ctx, tenantId, sqlchelpers.UUIDToStr(stepRunId))
+			require.NoError(t, err)
+
+			var lookupData struct {
+				Steps map[string]json.RawMessage `json:"steps"`
+			}
+
+			require.NoError(t, json.Unmarshal(data.JobRunLookupData, &lookupData))
+
+			return lookupData.Steps
+		}
+
+		first := appendEntry([]byte(`{"attempt":1}`))
+		assert.Equal(t, int32(1), first.TailLength)
+
+		second := appendEntry([]byte(`{"attempt":2}`))
+		assert.Equal(t, int32(2), second.TailLength)
+		assert.Equal(t, first.JobRunId, second.JobRunId)
+
+		// the latest entry of the step wins
+		assert.JSONEq(t, `{"attempt":2}`, string(stepOutputs()["step"]))
+
+		compact(second.JobRunId)
+
+		assert.JSONEq(t, `{"attempt":2}`, string(stepOutputs()["step"]))
+
+		// the entries were merged into the lookup data, so the tail starts over
+		assert.Equal(t, int32(1), appendEntry(nil).TailLength)
+
+		// a null output removes the step, before and after the entries are compacted
+		assert.NotContains(t, stepOutputs(), "step")
+
+		compact(second.JobRunId)
+
+		assert.NotContains(t, stepOutputs(), "step")
+
+		// the output of a step run which doesn't exist isn't appended
+		_, err := queries.AppendJobRunLookupDataEntry(ctx, conf.Pool, dbsqlc.AppendJobRunLookupDataEntryParams{
+			Steprunid: sqlchelpers.UUIDFromStr(uuid.New().String()),
+			Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+			Jsondata:  []byte(`{}`),
+		})
+
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		return nil
+	})
+}
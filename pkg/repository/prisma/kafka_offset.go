@@ -0,0 +1,93 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type kafkaOffsetRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewKafkaOffsetRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.KafkaOffsetRepository {
+	queries := dbsqlc.New()
+
+	return &kafkaOffsetRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *kafkaOffsetRepository) AcquirePartitions(ctx context.Context, opts *repository.AcquireKafkaPartitionsOpts) ([]*dbsqlc.KafkaPartitionOffset, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	topics := make([]string, len(opts.Partitions))
+	partitions := make([]int32, len(opts.Partitions))
+
+	for i, p := range opts.Partitions {
+		topics[i] = p.Topic
+		partitions[i] = p.Partition
+	}
+
+	offsets, err := r.queries.AcquireKafkaPartitionLeases(ctx, r.pool, dbsqlc.AcquireKafkaPartitionLeasesParams{
+		Consumergroup: opts.ConsumerGroup,
+		Consumerid:    sqlchelpers.UUIDFromStr(opts.ConsumerId),
+		Leaseduration: sqlchelpers.DurationToPgInterval(opts.LeaseDuration),
+		Topics:        topics,
+		Partitions:    partitions,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not acquire kafka partition leases: %w", err)
+	}
+
+	return offsets, nil
+}
+
+func (r *kafkaOffsetRepository) CommitOffset(ctx context.Context, consumerGroup, consumerId, topic string, partition int32, offset int64) error {
+	updated, err := r.queries.CommitKafkaPartitionOffset(ctx, r.pool, dbsqlc.CommitKafkaPartitionOffsetParams{
+		Offset:        offset,
+		Consumergroup: consumerGroup,
+		Topic:         topic,
+		Partition:     partition,
+		Consumerid:    sqlchelpers.UUIDFromStr(consumerId),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not commit kafka partition offset: %w", err)
+	}
+
+	if updated == 0 {
+		return repository.ErrKafkaPartitionLeaseLost
+	}
+
+	return nil
+}
+
+func (r *kafkaOffsetRepository) ReleasePartitions(ctx context.Context, consumerGroup, consumerId string) error {
+	err := r.queries.ReleaseKafkaPartitionLeases(ctx, r.pool, dbsqlc.ReleaseKafkaPartitionLeasesParams{
+		Consumergroup: consumerGroup,
+		Consumerid:    sqlchelpers.UUIDFromStr(consumerId),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not release kafka partition leases: %w", err)
+	}
+
+	return nil
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 
@@ -90,6 +92,16 @@ func (r *logAPIRepository) ListLogLines(tenantId string, opts *repository.ListLo
 		orderByDirection = *opts.OrderDirection
 	}
 
+	if opts.AfterId != nil {
+		queryParams.AfterId = pgtype.Int8{Int64: *opts.AfterId, Valid: true}
+		countParams.AfterId = pgtype.Int8{Int64: *opts.AfterId, Valid: true}
+
+		// log lines which are tailed are returned in the order they were stored, so the last id can be used as the
+		// cursor of the next page
+		orderByField = "id"
+		orderByDirection = "ASC"
+	}
+
 	queryParams.OrderBy = sqlchelpers.TextFromStr(orderByField + " " + orderByDirection)
 
 	tx, err := r.pool.Begin(context.Background())
@@ -137,16 +149,20 @@ type logEngineRepository struct {
 	v       validator.Validator
 	queries *dbsqlc.Queries
 	l       *zerolog.Logger
+
+	// maxLinesPerStepRun is the maximum number of log lines of a step run, or 0 if log lines are not limited
+	maxLinesPerStepRun int
 }
 
-func NewLogEngineRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.LogsEngineRepository {
+func NewLogEngineRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, maxLinesPerStepRun int) repository.LogsEngineRepository {
 	queries := dbsqlc.New()
 
 	return &logEngineRepository{
-		pool:    pool,
-		v:       v,
-		queries: queries,
-		l:       l,
+		pool:               pool,
+		v:                  v,
+		queries:            queries,
+		l:                  l,
+		maxLinesPerStepRun: maxLinesPerStepRun,
 	}
 }
 
@@ -159,6 +175,7 @@ func (r *logEngineRepository) PutLog(ctx context.Context, tenantId string, opts
 		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
 		Message:   opts.Message,
 		Steprunid: sqlchelpers.UUIDFromStr(opts.StepRunId),
+		Maxlines:  int32(r.maxLinesPerStepRun), // nolint: gosec
 	}
 
 	if opts.CreatedAt != nil {
@@ -192,6 +209,10 @@ func (r *logEngineRepository) PutLog(ctx context.Context, tenantId string, opts
 	)
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
 		return nil, fmt.Errorf("could not create log line: %w", err)
 	}
 
@@ -203,3 +224,71 @@ func (r *logEngineRepository) PutLog(ctx context.Context, tenantId string, opts
 
 	return logLine, nil
 }
+
+func (r *logEngineRepository) PutLogs(ctx context.Context, tenantId string, opts []*repository.CreateLogLineOpts) (int, error) {
+	if len(opts) == 0 {
+		return 0, nil
+	}
+
+	params := dbsqlc.BulkCreateLogLinesParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Maxlines:   int32(r.maxLinesPerStepRun), // nolint: gosec
+		Createdats: make([]pgtype.Timestamp, 0, len(opts)),
+		Steprunids: make([]pgtype.UUID, 0, len(opts)),
+		Messages:   make([]string, 0, len(opts)),
+		Levels:     make([]dbsqlc.LogLineLevel, 0, len(opts)),
+		Metadatas:  make([][]byte, 0, len(opts)),
+	}
+
+	now := time.Now().UTC()
+
+	for _, opt := range opts {
+		if err := r.v.Validate(opt); err != nil {
+			return 0, err
+		}
+
+		createdAt := now
+
+		if opt.CreatedAt != nil {
+			createdAt = opt.CreatedAt.UTC()
+		}
+
+		level := dbsqlc.LogLineLevelINFO
+
+		if opt.Level != nil {
+			level = dbsqlc.LogLineLevel(*opt.Level)
+		}
+
+		params.Createdats = append(params.Createdats, sqlchelpers.TimestampFromTime(createdAt))
+		params.Steprunids = append(params.Steprunids, sqlchelpers.UUIDFromStr(opt.StepRunId))
+		params.Messages = append(params.Messages, opt.Message)
+		params.Levels = append(params.Levels, level)
+		params.Metadatas = append(params.Metadatas, opt.Metadata)
+	}
+
+	stored, err := r.queries.BulkCreateLogLines(ctx, r.pool, params)
+
+	if err != nil {
+		return 0, fmt.Errorf("could not create log lines: %w", err)
+	}
+
+	return int(stored), nil
+}
+
+func (r *logEngineRepository) DeleteExpiredLogLines(ctx context.Context, tenantId string, before time.Time) (bool, error) {
+	hasMore, err := r.queries.DeleteExpiredLogLines(ctx, r.pool, dbsqlc.DeleteExpiredLogLinesParams{
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Createdbefore: sqlchelpers.TimestampFromTime(before),
+		Limit:         1000,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return hasMore, nil
+}
@@ -0,0 +1,313 @@
+package prisma
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type mapEngineRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewMapEngineRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.MapEngineRepository {
+	queries := dbsqlc.New()
+
+	return &mapEngineRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *mapEngineRepository) CreateMapItems(ctx context.Context, tenantId, mapStepRunId string, opts *repository.CreateMapItemsOpts) ([]*dbsqlc.GetStepRunForEngineRow, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+	pgMapStepRunId := sqlchelpers.UUIDFromStr(mapStepRunId)
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	// elements of a previous attempt are replaced, so that a retried map step run starts over
+	err = r.queries.DeleteStepRunMapItems(ctx, tx, dbsqlc.DeleteStepRunMapItemsParams{
+		Mapsteprunid: pgMapStepRunId,
+		Tenantid:     pgTenantId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not delete map items: %w", err)
+	}
+
+	stepRunIds := make([]pgtype.UUID, len(opts.Inputs))
+	indices := make([]int32, len(opts.Inputs))
+
+	for i := range opts.Inputs {
+		stepRunIds[i] = sqlchelpers.UUIDFromStr(uuid.New().String())
+		indices[i] = int32(i) // nolint: gosec
+	}
+
+	err = r.queries.CreateStepRunMapItems(ctx, tx, dbsqlc.CreateStepRunMapItemsParams{
+		Mapsteprunid: pgMapStepRunId,
+		Tenantid:     pgTenantId,
+		Steprunids:   stepRunIds,
+		Indices:      indices,
+		Inputs:       opts.Inputs,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create map items: %w", err)
+	}
+
+	limit := len(opts.Inputs)
+
+	if opts.Concurrency > 0 && opts.Concurrency < limit {
+		limit = opts.Concurrency
+	}
+
+	queuedIds, err := r.queries.QueueStepRunMapItems(ctx, tx, dbsqlc.QueueStepRunMapItemsParams{
+		Mapsteprunid: pgMapStepRunId,
+		Limit:        int32(limit), // nolint: gosec
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not queue map items: %w", err)
+	}
+
+	queued, err := r.getStepRuns(ctx, tx, pgTenantId, queuedIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return queued, nil
+}
+
+func (r *mapEngineRepository) IsMapItem(ctx context.Context, tenantId, stepRunId string) (bool, error) {
+	_, err := r.queries.GetStepRunMapItem(ctx, r.pool, dbsqlc.GetStepRunMapItemParams{
+		Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("could not get map item: %w", err)
+	}
+
+	return true, nil
+}
+
+func (r *mapEngineRepository) FinishMapItem(ctx context.Context, tenantId, stepRunId string, output []byte, concurrency int) (*repository.FinishMapItemResult, error) {
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+	pgStepRunId := sqlchelpers.UUIDFromStr(stepRunId)
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	mapStepRunId, err := r.lockMapItems(ctx, tx, pgTenantId, pgStepRunId)
+
+	if err != nil || mapStepRunId == nil {
+		return nil, err
+	}
+
+	if output == nil {
+		output = []byte("null")
+	}
+
+	_, err = r.queries.FinishStepRunMapItem(ctx, tx, dbsqlc.FinishStepRunMapItemParams{
+		Output:    output,
+		Steprunid: pgStepRunId,
+		Tenantid:  pgTenantId,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("could not finish map item: %w", err)
+	}
+
+	counts, err := r.queries.CountStepRunMapItems(ctx, tx, *mapStepRunId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not count map items: %w", err)
+	}
+
+	res := &repository.FinishMapItemResult{
+		MapStepRunId: sqlchelpers.UUIDToStr(*mapStepRunId),
+	}
+
+	switch {
+	case counts.Succeeded == counts.Total:
+		outputs, err := r.queries.ListStepRunMapItemOutputs(ctx, tx, *mapStepRunId)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not list map item outputs: %w", err)
+		}
+
+		results := make([]json.RawMessage, len(outputs))
+
+		for i, o := range outputs {
+			results[i] = o
+		}
+
+		res.Output, err = json.Marshal(map[string]interface{}{
+			"results": results,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal map output: %w", err)
+		}
+	case concurrency > 0 && counts.Queued < int64(concurrency):
+		queuedIds, err := r.queries.QueueStepRunMapItems(ctx, tx, dbsqlc.QueueStepRunMapItemsParams{
+			Mapsteprunid: *mapStepRunId,
+			Limit:        int32(int64(concurrency) - counts.Queued), // nolint: gosec
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not queue map items: %w", err)
+		}
+
+		res.QueuedStepRuns, err = r.getStepRuns(ctx, tx, pgTenantId, queuedIds)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return res, nil
+}
+
+func (r *mapEngineRepository) FailMapItem(ctx context.Context, tenantId, stepRunId string) (*repository.FailMapItemResult, error) {
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+	pgStepRunId := sqlchelpers.UUIDFromStr(stepRunId)
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	mapStepRunId, err := r.lockMapItems(ctx, tx, pgTenantId, pgStepRunId)
+
+	if err != nil || mapStepRunId == nil {
+		return nil, err
+	}
+
+	item, err := r.queries.FailStepRunMapItem(ctx, tx, dbsqlc.FailStepRunMapItemParams{
+		Steprunid: pgStepRunId,
+		Tenantid:  pgTenantId,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("could not fail map item: %w", err)
+	}
+
+	unfinished, err := r.queries.ListUnfinishedStepRunMapItems(ctx, tx, *mapStepRunId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list unfinished map items: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	res := &repository.FailMapItemResult{
+		MapStepRunId:         sqlchelpers.UUIDToStr(*mapStepRunId),
+		Index:                int(item.Index),
+		UnfinishedStepRunIds: make([]string, len(unfinished)),
+	}
+
+	for i, id := range unfinished {
+		res.UnfinishedStepRunIds[i] = sqlchelpers.UUIDToStr(id)
+	}
+
+	return res, nil
+}
+
+// lockMapItems locks the elements of the map step run which a step run belongs to, so that elements which finish
+// at the same time do not queue the same pending elements. It returns nil if the step run is not an element.
+func (r *mapEngineRepository) lockMapItems(ctx context.Context, tx pgx.Tx, tenantId, stepRunId pgtype.UUID) (*pgtype.UUID, error) {
+	item, err := r.queries.GetStepRunMapItem(ctx, tx, dbsqlc.GetStepRunMapItemParams{
+		Steprunid: stepRunId,
+		Tenantid:  tenantId,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("could not get map item: %w", err)
+	}
+
+	if _, err := r.queries.LockStepRunMapItems(ctx, tx, item.MapStepRunId); err != nil {
+		return nil, fmt.Errorf("could not lock map items: %w", err)
+	}
+
+	return &item.MapStepRunId, nil
+}
+
+func (r *mapEngineRepository) getStepRuns(ctx context.Context, tx pgx.Tx, tenantId pgtype.UUID, stepRunIds []pgtype.UUID) ([]*dbsqlc.GetStepRunForEngineRow, error) {
+	if len(stepRunIds) == 0 {
+		return nil, nil
+	}
+
+	stepRuns, err := r.queries.GetStepRunForEngine(ctx, tx, dbsqlc.GetStepRunForEngineParams{
+		Ids:      stepRunIds,
+		TenantId: tenantId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get map item step runs: %w", err)
+	}
+
+	return stepRuns, nil
+}
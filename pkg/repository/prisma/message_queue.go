@@ -0,0 +1,228 @@
+package prisma
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+// messageQueueChannel is the channel which the notifications of the message queue are sent on
+const messageQueueChannel = "hatchet_message_queue"
+
+// messageQueuePollInterval is how often the messages which were added are polled when the engine can't listen to
+// notifications
+const messageQueuePollInterval = 200 * time.Millisecond
+
+// messageQueuePollLimit is the maximum number of messages which are read per poll
+const messageQueuePollLimit = 1000
+
+type messageQueueRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+
+	// pgBouncerMode polls the messages which were added instead of listening to notifications, since listening
+	// requires a session
+	pgBouncerMode bool
+}
+
+func NewMessageQueueRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, pgBouncerMode bool) repository.MessageQueueRepository {
+	queries := dbsqlc.New()
+
+	return &messageQueueRepository{
+		pool:          pool,
+		v:             v,
+		queries:       queries,
+		l:             l,
+		pgBouncerMode: pgBouncerMode,
+	}
+}
+
+func (r *messageQueueRepository) AddMessage(ctx context.Context, queueName string, payload []byte, expiresAt *time.Time) (int64, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer rollback()
+
+	params := dbsqlc.CreateMessageQueueItemParams{
+		Queuename: queueName,
+		Payload:   payload,
+	}
+
+	if expiresAt != nil {
+		params.ExpiresAt = sqlchelpers.TimestampFromTime(*expiresAt)
+	}
+
+	id, err := r.queries.CreateMessageQueueItem(ctx, tx, params)
+
+	if err != nil {
+		return 0, fmt.Errorf("could not create message queue item: %w", err)
+	}
+
+	if r.pgBouncerMode {
+		if err := commit(ctx); err != nil {
+			return 0, err
+		}
+
+		return id, nil
+	}
+
+	notification, err := json.Marshal(&repository.MessageQueueNotification{
+		QueueName: queueName,
+		MessageId: id,
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	err = r.queries.NotifyMessageQueue(ctx, tx, dbsqlc.NotifyMessageQueueParams{
+		Channel: messageQueueChannel,
+		Payload: string(notification),
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("could not notify message queue: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *messageQueueRepository) ReadMessages(ctx context.Context, queueName string, limit int, visibilityTimeout time.Duration) ([]*dbsqlc.MessageQueueItem, error) {
+	return r.queries.ReadMessageQueueItems(ctx, r.pool, dbsqlc.ReadMessageQueueItemsParams{
+		Queuename:                queueName,
+		Limit:                    int32(limit),                       // nolint: gosec
+		VisibilityTimeoutSeconds: int32(visibilityTimeout.Seconds()), // nolint: gosec
+	})
+}
+
+func (r *messageQueueRepository) ListMessagesByIds(ctx context.Context, ids []int64) ([]*dbsqlc.MessageQueueItem, error) {
+	return r.queries.ListMessageQueueItemsByIds(ctx, r.pool, ids)
+}
+
+func (r *messageQueueRepository) AckMessage(ctx context.Context, id int64) error {
+	return r.queries.DeleteMessageQueueItems(ctx, r.pool, []int64{id})
+}
+
+func (r *messageQueueRepository) NackMessage(ctx context.Context, id int64, delay time.Duration) error {
+	return r.queries.DelayMessageQueueItem(ctx, r.pool, dbsqlc.DelayMessageQueueItemParams{
+		DelaySeconds: int32(delay.Seconds()), // nolint: gosec
+		ID:           id,
+	})
+}
+
+func (r *messageQueueRepository) DeleteExpiredMessages(ctx context.Context) (int64, error) {
+	return r.queries.DeleteExpiredMessageQueueItems(ctx, r.pool)
+}
+
+func (r *messageQueueRepository) Listen(ctx context.Context, handler func(notification *repository.MessageQueueNotification)) error {
+	if r.pgBouncerMode {
+		return r.poll(ctx, handler)
+	}
+
+	conn, err := r.pool.Acquire(ctx)
+
+	if err != nil {
+		return fmt.Errorf("could not acquire connection: %w", err)
+	}
+
+	// the connection is listening for as long as it's open, so it's closed instead of being returned to the pool
+	pgConn := conn.Hijack()
+	defer pgConn.Close(context.Background()) // nolint: errcheck
+
+	if _, err := pgConn.Exec(ctx, "LISTEN "+messageQueueChannel); err != nil {
+		return fmt.Errorf("could not listen to message queue notifications: %w", err)
+	}
+
+	for {
+		n, err := pgConn.WaitForNotification(ctx)
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("could not wait for message queue notification: %w", err)
+		}
+
+		notification := &repository.MessageQueueNotification{}
+
+		if err := json.Unmarshal([]byte(n.Payload), notification); err != nil {
+			r.l.Error().Err(err).Msg("could not decode message queue notification")
+			continue
+		}
+
+		handler(notification)
+	}
+}
+
+// poll calls the handler with the messages which are added until the context is canceled, which replaces the
+// notifications when the engine can't listen to them.
+func (r *messageQueueRepository) poll(ctx context.Context, handler func(notification *repository.MessageQueueNotification)) error {
+	afterId, err := r.queries.GetLatestMessageQueueItemId(ctx, r.pool)
+
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		return fmt.Errorf("could not get latest message queue item: %w", err)
+	}
+
+	ticker := time.NewTicker(messageQueuePollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		// read until there are no more messages, so a burst of messages isn't delayed by the poll interval
+		for {
+			items, err := r.queries.ListMessageQueueItemsAfterId(ctx, r.pool, dbsqlc.ListMessageQueueItemsAfterIdParams{
+				Afterid: afterId,
+				Limit:   messageQueuePollLimit,
+			})
+
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+
+				return fmt.Errorf("could not poll message queue: %w", err)
+			}
+
+			for _, item := range items {
+				handler(&repository.MessageQueueNotification{
+					QueueName: item.QueueName,
+					MessageId: item.ID,
+				})
+
+				afterId = item.ID
+			}
+
+			if len(items) < messageQueuePollLimit {
+				break
+			}
+		}
+	}
+}
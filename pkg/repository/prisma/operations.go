@@ -0,0 +1,190 @@
+package prisma
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type operationsRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewOperationsRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.OperationsRepository {
+	queries := dbsqlc.New()
+
+	return &operationsRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *operationsRepository) ListLeases(ctx context.Context, opts *repository.ListLeasesOpts) ([]*dbsqlc.Lease, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.ListLeasesParams{}
+
+	if opts.TenantId != nil {
+		params.TenantId = sqlchelpers.UUIDFromStr(*opts.TenantId)
+	}
+
+	if opts.Kind != nil {
+		params.Kind = dbsqlc.NullLeaseKind{
+			LeaseKind: *opts.Kind,
+			Valid:     true,
+		}
+	}
+
+	return r.queries.ListLeases(ctx, r.pool, params)
+}
+
+func (r *operationsRepository) ReleaseLeases(ctx context.Context, ids []int64) ([]*dbsqlc.Lease, error) {
+	return r.queries.ReleaseLeases(ctx, r.pool, ids)
+}
+
+func (r *operationsRepository) ListEngineLeases(ctx context.Context) ([]*dbsqlc.EngineLease, error) {
+	return r.queries.ListEngineLeases(ctx, r.pool)
+}
+
+func (r *operationsRepository) ReleaseEngineLease(ctx context.Context, name string) (bool, error) {
+	released, err := r.queries.ForceReleaseEngineLease(ctx, r.pool, name)
+
+	if err != nil {
+		return false, err
+	}
+
+	return released > 0, nil
+}
+
+func (r *operationsRepository) ListStuckStepRuns(ctx context.Context, tenantId string, opts *repository.ListStuckStepRunsOpts) ([]*dbsqlc.ListStuckStepRunsRow, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	limit := 1000
+
+	if opts.Limit != nil {
+		limit = *opts.Limit
+	}
+
+	params := dbsqlc.ListStuckStepRunsParams{
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Updatedbefore: sqlchelpers.TimestampFromTime(opts.UpdatedBefore),
+		Limit:         int32(limit), // nolint: gosec
+	}
+
+	if opts.WorkerId != nil {
+		params.WorkerId = sqlchelpers.UUIDFromStr(*opts.WorkerId)
+	}
+
+	return r.queries.ListStuckStepRuns(ctx, r.pool, params)
+}
+
+func (r *operationsRepository) RequeueStepRuns(ctx context.Context, tenantId string, stepRunIds []string) ([]*repository.RequeuedStepRun, error) {
+	ids := make([]pgtype.UUID, len(stepRunIds))
+
+	for i, id := range stepRunIds {
+		ids[i] = sqlchelpers.UUIDFromStr(id)
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 30000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	rows, err := r.queries.RequeueStepRuns(ctx, tx, dbsqlc.RequeueStepRunsParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Steprunids: ids,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not requeue step runs: %w", err)
+	}
+
+	res := make([]*repository.RequeuedStepRun, 0, len(rows))
+
+	for _, row := range rows {
+		requeued := &repository.RequeuedStepRun{
+			StepRunId: sqlchelpers.UUIDToStr(row.ID),
+		}
+
+		data := map[string]interface{}{}
+
+		if row.WorkerId.Valid {
+			workerId := sqlchelpers.UUIDToStr(row.WorkerId)
+			requeued.WorkerId = &workerId
+			data["worker_id"] = workerId
+		}
+
+		dataBytes, err := json.Marshal(data)
+
+		if err != nil {
+			return nil, err
+		}
+
+		err = r.queries.CreateStepRunEvent(ctx, tx, dbsqlc.CreateStepRunEventParams{
+			Steprunid: row.ID,
+			Reason:    dbsqlc.StepRunEventReasonREASSIGNED,
+			Severity:  dbsqlc.StepRunEventSeverityWARNING,
+			Message:   "Requeued by an administrator",
+			Data:      dataBytes,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("could not create step run event: %w", err)
+		}
+
+		res = append(res, requeued)
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *operationsRepository) GetEngineHealth(ctx context.Context) (*repository.EngineHealth, error) {
+	partitions, err := r.queries.ListEnginePartitions(ctx, r.pool)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list partitions: %w", err)
+	}
+
+	dispatchers, err := r.queries.ListDispatchersWithWorkerCounts(ctx, r.pool)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list dispatchers: %w", err)
+	}
+
+	leases, err := r.queries.ListEngineLeases(ctx, r.pool)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list engine leases: %w", err)
+	}
+
+	return &repository.EngineHealth{
+		Partitions:  partitions,
+		Dispatchers: dispatchers,
+		Leases:      leases,
+	}, nil
+}
@@ -0,0 +1,168 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestOperationsLeases(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		otherTenantId := createTestTenant(t, conf)
+		repo := conf.EngineRepository.Operations()
+
+		_, err := conf.Pool.Exec(
+			ctx,
+			`INSERT INTO "Lease" ("tenantId", "resourceId", "kind", "expiresAt") VALUES
+				($1::uuid, 'test:step', 'QUEUE', NOW() + INTERVAL '1 minute'),
+				($1::uuid, 'worker', 'WORKER', NOW() + INTERVAL '1 minute'),
+				($2::uuid, 'test:step', 'QUEUE', NOW() + INTERVAL '1 minute')`,
+			tenantId,
+			otherTenantId,
+		)
+
+		require.NoError(t, err)
+
+		queueKind := dbsqlc.LeaseKindQUEUE
+
+		leases, err := repo.ListLeases(ctx, &repository.ListLeasesOpts{TenantId: &tenantId})
+		require.NoError(t, err)
+		require.Len(t, leases, 2)
+
+		leases, err = repo.ListLeases(ctx, &repository.ListLeasesOpts{TenantId: &tenantId, Kind: &queueKind})
+		require.NoError(t, err)
+		require.Len(t, leases, 1)
+		assert.Equal(t, "test:step", leases[0].ResourceId)
+
+		// leases which don't exist are skipped
+		released, err := repo.ReleaseLeases(ctx, []int64{leases[0].ID, -1})
+		require.NoError(t, err)
+		require.Len(t, released, 1)
+		assert.Equal(t, leases[0].ID, released[0].ID)
+
+		leases, err = repo.ListLeases(ctx, &repository.ListLeasesOpts{TenantId: &tenantId})
+		require.NoError(t, err)
+		require.Len(t, leases, 1)
+		assert.Equal(t, dbsqlc.LeaseKindWORKER, leases[0].Kind)
+
+		// engine leases are released regardless of their holder
+		_, err = conf.Pool.Exec(
+			ctx,
+			`INSERT INTO "EngineLease" ("name", "holderId", "expiresAt") VALUES ('operations-test', 'stuck-engine', NOW() + INTERVAL '1 hour')`,
+		)
+
+		require.NoError(t, err)
+
+		engineLeases, err := repo.ListEngineLeases(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, engineLeaseNames(engineLeases), "operations-test")
+
+		ok, err := repo.ReleaseEngineLease(ctx, "operations-test")
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = repo.ReleaseEngineLease(ctx, "operations-test")
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		health, err := repo.GetEngineHealth(ctx)
+		require.NoError(t, err)
+		assert.NotContains(t, engineLeaseNames(health.Leases), "operations-test")
+
+		return nil
+	})
+}
+
+func TestOperationsRequeueStepRuns(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		version := createTestWorkflow(t, conf, tenantId, "requeue")
+		repo := conf.EngineRepository.Operations()
+
+		stuck := getTestStepRunId(t, conf, createTestWorkflowRun(t, conf, tenantId, version).ID)
+		recent := getTestStepRunId(t, conf, createTestWorkflowRun(t, conf, tenantId, version).ID)
+		running := getTestStepRunId(t, conf, createTestWorkflowRun(t, conf, tenantId, version).ID)
+
+		exec := func(sql string, args ...interface{}) {
+			_, err := conf.Pool.Exec(ctx, sql, args...)
+			require.NoError(t, err)
+		}
+
+		exec(`UPDATE "StepRun" SET "status" = 'ASSIGNED', "updatedAt" = NOW() - INTERVAL '1 hour' WHERE "id" = $1::uuid`, stuck)
+		exec(`UPDATE "StepRun" SET "status" = 'ASSIGNED', "updatedAt" = NOW() WHERE "id" = $1::uuid`, recent)
+		exec(`UPDATE "StepRun" SET "status" = 'RUNNING', "updatedAt" = NOW() - INTERVAL '1 hour' WHERE "id" = $1::uuid`, running)
+
+		// only the step runs which weren't updated for a while and haven't started are stuck
+		stepRuns, err := repo.ListStuckStepRuns(ctx, tenantId, &repository.ListStuckStepRunsOpts{
+			UpdatedBefore: time.Now().UTC().Add(-10 * time.Minute),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, stepRuns, 1)
+		assert.Equal(t, stuck, stepRuns[0].ID)
+		assert.Equal(t, dbsqlc.StepRunStatusASSIGNED, stepRuns[0].Status)
+
+		// step runs which have started are skipped
+		requeued, err := repo.RequeueStepRuns(ctx, tenantId, []string{
+			sqlchelpers.UUIDToStr(stuck),
+			sqlchelpers.UUIDToStr(running),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, requeued, 1)
+		assert.Equal(t, sqlchelpers.UUIDToStr(stuck), requeued[0].StepRunId)
+		assert.Nil(t, requeued[0].WorkerId)
+
+		var status string
+		var queued, events int
+
+		err = conf.Pool.QueryRow(
+			ctx,
+			`SELECT
+				sr."status"::text,
+				(SELECT COUNT(*) FROM "QueueItem" qi WHERE qi."stepRunId" = sr."id" AND qi."isQueued" AND qi."priority" = 4),
+				(SELECT COUNT(*) FROM "StepRunEvent" e WHERE e."stepRunId" = sr."id" AND e."reason" = 'REASSIGNED')
+			FROM "StepRun" sr WHERE sr."id" = $1::uuid`,
+			stuck,
+		).Scan(&status, &queued, &events)
+
+		require.NoError(t, err)
+		assert.Equal(t, "PENDING_ASSIGNMENT", status)
+		assert.Equal(t, 1, queued, "the step run is queued with the highest priority")
+		assert.Equal(t, 1, events)
+
+		// the requeued step run was updated, so it isn't stuck anymore
+		stepRuns, err = repo.ListStuckStepRuns(ctx, tenantId, &repository.ListStuckStepRunsOpts{
+			UpdatedBefore: time.Now().UTC().Add(-10 * time.Minute),
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, stepRuns)
+
+		return nil
+	})
+}
+
+func engineLeaseNames(leases []*dbsqlc.EngineLease) []string {
+	names := make([]string, len(leases))
+
+	for i, l := range leases {
+		names[i] = l.Name
+	}
+
+	return names
+}
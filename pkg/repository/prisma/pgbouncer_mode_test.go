@@ -0,0 +1,110 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+func TestMessageQueuePgBouncerMode(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		l := zerolog.Nop()
+		repo := prisma.NewMessageQueueRepository(conf.Pool, validator.NewDefaultValidator(), &l, true)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		// the messages which were added before listening aren't polled
+		_, err := repo.AddMessage(ctx, "pgbouncer-mode", []byte(`{}`), nil)
+		require.NoError(t, err)
+
+		notifications := make(chan *repository.MessageQueueNotification, 10)
+		done := make(chan error)
+
+		go func() {
+			done <- repo.Listen(ctx, func(notification *repository.MessageQueueNotification) {
+				if notification.QueueName == "pgbouncer-mode" {
+					notifications <- notification
+				}
+			})
+		}()
+
+		// wait for the listener to read the latest message before adding the messages which are polled
+		time.Sleep(time.Second)
+
+		first, err := repo.AddMessage(ctx, "pgbouncer-mode", []byte(`{}`), nil)
+		require.NoError(t, err)
+
+		second, err := repo.AddMessage(ctx, "pgbouncer-mode", []byte(`{}`), nil)
+		require.NoError(t, err)
+
+		for _, id := range []int64{first, second} {
+			select {
+			case notification := <-notifications:
+				assert.Equal(t, id, notification.MessageId)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("message %d wasn't polled", id)
+			}
+		}
+
+		cancel()
+
+		assert.NoError(t, <-done)
+		assert.Empty(t, notifications)
+
+		return nil
+	})
+}
+
+func TestMaintenanceLeasePgBouncerMode(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		l := zerolog.Nop()
+		v := validator.NewDefaultValidator()
+
+		engine := prisma.NewTablePartitionRepository(conf.Pool, v, &l, true)
+		otherEngine := prisma.NewTablePartitionRepository(conf.Pool, v, &l, true)
+
+		acquired, err := engine.WithMaintenanceLock(ctx, func(ctx context.Context) error {
+			// the lease is held, so another engine can't run the maintenance
+			acquired, err := otherEngine.WithMaintenanceLock(ctx, func(ctx context.Context) error {
+				t.Fatal("the maintenance ran while the lease was held")
+				return nil
+			})
+
+			require.NoError(t, err)
+			assert.False(t, acquired)
+
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.True(t, acquired)
+
+		// the lease was released, so another engine can run the maintenance
+		ran := false
+
+		acquired, err = otherEngine.WithMaintenanceLock(ctx, func(ctx context.Context) error {
+			ran = true
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.True(t, acquired)
+		assert.True(t, ran)
+
+		return nil
+	})
+}
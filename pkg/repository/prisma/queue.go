@@ -0,0 +1,50 @@
+package prisma
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type queueAPIRepository struct {
+	v       validator.Validator
+	pool    *pgxpool.Pool
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewQueueAPIRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.QueueAPIRepository {
+	queries := dbsqlc.New()
+
+	return &queueAPIRepository{
+		pool:    pool,
+		v:       v,
+		l:       l,
+		queries: queries,
+	}
+}
+
+func (q *queueAPIRepository) GetQueue(ctx context.Context, tenantId, name string) (*dbsqlc.Queue, error) {
+	return q.queries.GetQueue(ctx, q.pool, dbsqlc.GetQueueParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Name:     name,
+	})
+}
+
+func (q *queueAPIRepository) ListQueues(ctx context.Context, tenantId string) ([]*dbsqlc.Queue, error) {
+	return q.queries.ListQueues(ctx, q.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (q *queueAPIRepository) SetQueuePaused(ctx context.Context, tenantId, name string, isPaused bool) (*dbsqlc.Queue, error) {
+	return q.queries.SetQueuePaused(ctx, q.pool, dbsqlc.SetQueuePausedParams{
+		Ispaused: isPaused,
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Name:     name,
+	})
+}
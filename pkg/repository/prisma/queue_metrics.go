@@ -0,0 +1,57 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type queueMetricsRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewQueueMetricsRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.QueueMetricsRepository {
+	queries := dbsqlc.New()
+
+	return &queueMetricsRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *queueMetricsRepository) SnapshotQueueMetrics(ctx context.Context, tenantId string) error {
+	err := r.queries.CreateQueueMetricsSnapshots(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+
+	if err != nil {
+		return fmt.Errorf("could not snapshot queue metrics: %w", err)
+	}
+
+	return nil
+}
+
+func (r *queueMetricsRepository) ListQueueMetricsSnapshots(ctx context.Context, tenantId string, createdAfter time.Time) ([]*dbsqlc.QueueMetricsSnapshot, error) {
+	return r.queries.ListQueueMetricsSnapshots(ctx, r.pool, dbsqlc.ListQueueMetricsSnapshotsParams{
+		Tenantid:     sqlchelpers.UUIDFromStr(tenantId),
+		Createdafter: sqlchelpers.TimestampFromTime(createdAfter.UTC()),
+	})
+}
+
+func (r *queueMetricsRepository) DeleteExpiredQueueMetricsSnapshots(ctx context.Context, tenantId string, createdBefore time.Time) error {
+	return r.queries.DeleteExpiredQueueMetricsSnapshots(ctx, r.pool, dbsqlc.DeleteExpiredQueueMetricsSnapshotsParams{
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Createdbefore: sqlchelpers.TimestampFromTime(createdBefore.UTC()),
+	})
+}
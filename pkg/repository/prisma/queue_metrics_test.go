@@ -0,0 +1,91 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func TestQueueMetricsSnapshots(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		repo := conf.EngineRepository.QueueMetrics()
+
+		exec := func(sql string, args ...interface{}) {
+			_, err := conf.Pool.Exec(ctx, sql, args...)
+			require.NoError(t, err)
+		}
+
+		// the busy queue has queued items, the idle queue was recently active, and the stale queue wasn't active for
+		// a long time, so it isn't snapshotted
+		exec(
+			`INSERT INTO "Queue" ("tenantId", "name", "lastActive") VALUES
+				($1::uuid, 'busy', NOW()),
+				($1::uuid, 'idle', NOW()),
+				($1::uuid, 'stale', NOW() - INTERVAL '1 day')`,
+			tenantId,
+		)
+
+		exec(
+			`INSERT INTO "QueueItem" ("isQueued", "tenantId", "queue", "createdAt") VALUES
+				(true, $1::uuid, 'busy', NOW() - INTERVAL '10 seconds'),
+				(true, $1::uuid, 'busy', NOW()),
+				(false, $1::uuid, 'busy', NOW() - INTERVAL '1 minute')`,
+			tenantId,
+		)
+
+		require.NoError(t, repo.SnapshotQueueMetrics(ctx, tenantId))
+
+		snapshots, err := repo.ListQueueMetricsSnapshots(ctx, tenantId, time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+
+		// the snapshots are ordered by queue
+		require.Len(t, snapshots, 2)
+
+		busy := snapshots[0]
+		assert.Equal(t, "busy", busy.Queue)
+		assert.Equal(t, int32(2), busy.Depth)
+		assert.GreaterOrEqual(t, busy.LatencyMs, int64(10000), "the latency is the wait time of the oldest queued item")
+		assert.Zero(t, busy.Slots)
+
+		idle := snapshots[1]
+		assert.Equal(t, "idle", idle.Queue)
+		assert.Zero(t, idle.Depth)
+		assert.Zero(t, idle.LatencyMs)
+
+		// snapshots before the window aren't listed
+		exec(`UPDATE "QueueMetricsSnapshot" SET "createdAt" = NOW() - INTERVAL '2 hours' WHERE "tenantId" = $1::uuid AND "queue" = 'idle'`, tenantId)
+
+		snapshots, err = repo.ListQueueMetricsSnapshots(ctx, tenantId, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"busy"}, snapshotQueues(snapshots))
+
+		require.NoError(t, repo.DeleteExpiredQueueMetricsSnapshots(ctx, tenantId, time.Now().Add(-time.Hour)))
+
+		snapshots, err = repo.ListQueueMetricsSnapshots(ctx, tenantId, time.Now().Add(-3*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"busy"}, snapshotQueues(snapshots))
+
+		return nil
+	})
+}
+
+func snapshotQueues(snapshots []*dbsqlc.QueueMetricsSnapshot) []string {
+	queues := make([]string, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		queues[i] = snapshot.Queue
+	}
+
+	return queues
+}
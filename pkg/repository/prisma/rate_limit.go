@@ -132,6 +132,17 @@ func (r *rateLimitEngineRepository) UpsertRateLimit(ctx context.Context, tenantI
 		upsertParams.Window = sqlchelpers.TextFromStr(getWindowParamFromDurString(*opts.Duration))
 	}
 
+	if opts.Algorithm != nil {
+		upsertParams.Algorithm = dbsqlc.NullRateLimitAlgorithm{
+			RateLimitAlgorithm: dbsqlc.RateLimitAlgorithm(*opts.Algorithm),
+			Valid:              true,
+		}
+	}
+
+	if opts.Burst != nil {
+		upsertParams.Burst = sqlchelpers.ToInt(int32(*opts.Burst)) // nolint: gosec
+	}
+
 	rateLimit, err := r.queries.UpsertRateLimit(ctx, r.pool, upsertParams)
 
 	if err != nil {
@@ -141,6 +152,49 @@ func (r *rateLimitEngineRepository) UpsertRateLimit(ctx context.Context, tenantI
 	return rateLimit, nil
 }
 
+func (r *rateLimitEngineRepository) DeleteExpiredRateLimits(ctx context.Context, tenantId string) error {
+	_, err := r.queries.DeleteExpiredRateLimits(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+
+	if err != nil {
+		return fmt.Errorf("could not delete expired rate limits: %w", err)
+	}
+
+	return nil
+}
+
+func (r *rateLimitEngineRepository) ListGlobalRateLimits(ctx context.Context) ([]*dbsqlc.ListGlobalRateLimitsRow, error) {
+	rls, err := r.queries.ListGlobalRateLimits(ctx, r.pool)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list global rate limits: %w", err)
+	}
+
+	return rls, nil
+}
+
+func (r *rateLimitEngineRepository) UpsertGlobalRateLimit(ctx context.Context, key string, opts *repository.UpsertRateLimitOpts) (*dbsqlc.GlobalRateLimit, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	upsertParams := dbsqlc.UpsertGlobalRateLimitParams{
+		Key:   key,
+		Limit: int32(opts.Limit), // nolint: gosec
+	}
+
+	if opts.Duration != nil {
+		upsertParams.Window = sqlchelpers.TextFromStr(getWindowParamFromDurString(*opts.Duration))
+	}
+
+	rateLimit, err := r.queries.UpsertGlobalRateLimit(ctx, r.pool, upsertParams)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not upsert global rate limit: %w", err)
+	}
+
+	return rateLimit, nil
+}
+
 var durationStrings = []string{
 	"SECOND",
 	"MINUTE",
@@ -0,0 +1,173 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+func TestRateLimitAlgorithms(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		tenantId := createTestTenant(t, conf)
+
+		duration := "MINUTE"
+
+		tests := []struct {
+			name      string
+			algorithm string
+			burst     *int
+
+			// the value and the time since the last refill, as a postgres interval
+			value     int
+			elapsed   string
+			prevUsed  int
+			available int
+		}{
+			{
+				name:      "fixed window which hasn't ended",
+				algorithm: "FIXED_WINDOW",
+				value:     2,
+				elapsed:   "30 seconds",
+				available: 2,
+			},
+			{
+				name:      "fixed window which ended is refilled to the limit",
+				algorithm: "FIXED_WINDOW",
+				value:     2,
+				elapsed:   "2 minutes",
+				available: 10,
+			},
+			{
+				// 10 units per minute, so half a window adds 5 units
+				name:      "token bucket refills by the elapsed time",
+				algorithm: "TOKEN_BUCKET",
+				value:     0,
+				elapsed:   "30 seconds",
+				available: 5,
+			},
+			{
+				name:      "token bucket is capped at its burst",
+				algorithm: "TOKEN_BUCKET",
+				burst:     intPtr(3),
+				value:     0,
+				elapsed:   "1 hour",
+				available: 3,
+			},
+			{
+				name:      "token bucket without a burst is capped at the limit",
+				algorithm: "TOKEN_BUCKET",
+				value:     0,
+				elapsed:   "1 hour",
+				available: 10,
+			},
+			{
+				name:      "leaky bucket holds a single unit by default",
+				algorithm: "LEAKY_BUCKET",
+				value:     0,
+				elapsed:   "1 hour",
+				available: 1,
+			},
+			{
+				// 6 units were used in the previous window, which overlaps half of the sliding window
+				name:      "sliding window weighs the usage of the previous window",
+				algorithm: "SLIDING_WINDOW",
+				value:     4,
+				elapsed:   "90 seconds",
+				available: 7,
+			},
+			{
+				name:      "sliding window subtracts the usage of the previous window while the window lasts",
+				algorithm: "SLIDING_WINDOW",
+				value:     8,
+				elapsed:   "30 seconds",
+				prevUsed:  4,
+				available: 6,
+			},
+			{
+				name:      "sliding window forgets the previous window after two windows",
+				algorithm: "SLIDING_WINDOW",
+				value:     0,
+				elapsed:   "3 minutes",
+				available: 10,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				ctx := context.Background()
+				algorithm := tt.algorithm
+
+				_, err := conf.EngineRepository.RateLimit().UpsertRateLimit(ctx, tenantId, tt.name, &repository.UpsertRateLimitOpts{
+					Limit:     10,
+					Duration:  &duration,
+					Algorithm: &algorithm,
+					Burst:     tt.burst,
+				})
+
+				require.NoError(t, err)
+
+				// now() is fixed within a transaction, so the elapsed time is exact
+				tx, err := conf.Pool.Begin(ctx)
+				require.NoError(t, err)
+
+				defer tx.Rollback(ctx) // nolint: errcheck
+
+				_, err = tx.Exec(
+					ctx,
+					`UPDATE "RateLimit" SET "value" = $3, "prevWindowUsed" = $4, "lastRefill" = CURRENT_TIMESTAMP - $5::interval WHERE "tenantId" = $1::uuid AND "key" = $2`,
+					tenantId,
+					tt.name,
+					tt.value,
+					tt.prevUsed,
+					tt.elapsed,
+				)
+
+				require.NoError(t, err)
+
+				var available int
+
+				err = tx.QueryRow(
+					ctx,
+					`SELECT get_available_value(rl) FROM "RateLimit" rl WHERE rl."tenantId" = $1::uuid AND rl."key" = $2`,
+					tenantId,
+					tt.name,
+				).Scan(&available)
+
+				require.NoError(t, err)
+				assert.Equal(t, tt.available, available)
+			})
+		}
+
+		t.Run("invalid options are rejected", func(t *testing.T) {
+			algorithm := "UNKNOWN"
+
+			_, err := conf.EngineRepository.RateLimit().UpsertRateLimit(context.Background(), tenantId, "invalid", &repository.UpsertRateLimitOpts{
+				Limit:     10,
+				Algorithm: &algorithm,
+			})
+
+			assert.Error(t, err)
+
+			_, err = conf.EngineRepository.RateLimit().UpsertRateLimit(context.Background(), tenantId, "invalid", &repository.UpsertRateLimitOpts{
+				Limit: 10,
+				Burst: intPtr(0),
+			})
+
+			assert.Error(t, err)
+		})
+
+		return nil
+	})
+}
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,113 @@
+package prisma
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// readReplicaPollInterval is the interval at which the lag of the read replica is measured
+const readReplicaPollInterval = 5 * time.Second
+
+// ReadReplica routes the reads of the query APIs, like the lists of runs and events, to a read replica. Reads are sent
+// to the primary instead while the replica can't be reached or lags behind the primary by more than the max lag.
+type ReadReplica struct {
+	primary *pgxpool.Pool
+	replica *pgxpool.Pool
+	maxLag  time.Duration
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+
+	mu      sync.RWMutex
+	healthy bool
+	lag     time.Duration
+}
+
+// NewReadReplica starts measuring the lag of the replica, and returns a function which stops it. A max lag of zero
+// sends the reads to the replica regardless of its lag.
+func NewReadReplica(primary, replica *pgxpool.Pool, maxLag time.Duration, l *zerolog.Logger) (*ReadReplica, func()) {
+	r := &ReadReplica{
+		primary: primary,
+		replica: replica,
+		maxLag:  maxLag,
+		queries: dbsqlc.New(),
+		l:       l,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	r.poll(ctx)
+
+	go func() {
+		ticker := time.NewTicker(readReplicaPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.poll(ctx)
+			}
+		}
+	}()
+
+	return r, cancel
+}
+
+func (r *ReadReplica) poll(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, readReplicaPollInterval)
+	defer cancel()
+
+	lagSeconds, err := r.queries.GetReplicationLag(ctx, r.replica)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	wasHealthy := r.healthy
+
+	if err != nil {
+		r.healthy = false
+
+		if wasHealthy && ctx.Err() == nil {
+			r.l.Warn().Err(err).Msg("could not measure the lag of the read replica, sending reads to the primary")
+		}
+
+		return
+	}
+
+	r.lag = time.Duration(lagSeconds * float64(time.Second))
+	r.healthy = r.maxLag == 0 || r.lag <= r.maxLag
+
+	if wasHealthy && !r.healthy {
+		r.l.Warn().Msgf("read replica lags behind the primary by %s, sending reads to the primary", r.lag)
+	} else if !wasHealthy && r.healthy {
+		r.l.Info().Msgf("read replica lags behind the primary by %s, sending reads to the replica", r.lag)
+	}
+}
+
+// Pool returns the pool which a read of a query API is sent to. If it's the replica, the read is recorded in the read
+// staleness of the context.
+func (r *ReadReplica) Pool(ctx context.Context) *pgxpool.Pool {
+	if r.replica == nil {
+		return r.primary
+	}
+
+	r.mu.RLock()
+	healthy, lag := r.healthy, r.lag
+	r.mu.RUnlock()
+
+	if !healthy {
+		return r.primary
+	}
+
+	repository.RecordReplicaRead(ctx, lag)
+
+	return r.replica
+}
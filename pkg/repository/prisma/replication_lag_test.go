@@ -0,0 +1,37 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma"
+)
+
+func TestReplicationLag(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		l := zerolog.Nop()
+
+		// the primary isn't in recovery, so it's a replica without lag
+		readReplica, stop := prisma.NewReadReplica(conf.Pool, conf.Pool, time.Second, &l)
+		defer stop()
+
+		ctx, staleness := repository.WithReadStaleness(context.Background())
+
+		assert.Same(t, conf.Pool, readReplica.Pool(ctx))
+
+		lag, fromReplica := staleness.Lag()
+		assert.True(t, fromReplica)
+		assert.Zero(t, lag)
+
+		return nil
+	})
+}
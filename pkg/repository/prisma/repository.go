@@ -30,6 +30,7 @@ type apiRepository struct {
 	slack          repository.SlackRepository
 	sns            repository.SNSRepository
 	worker         repository.WorkerAPIRepository
+	queue          repository.QueueAPIRepository
 	userSession    repository.UserSessionRepository
 	user           repository.UserRepository
 	health         repository.HealthRepository
@@ -40,10 +41,16 @@ type apiRepository struct {
 type PrismaRepositoryOpt func(*PrismaRepositoryOpts)
 
 type PrismaRepositoryOpts struct {
-	v       validator.Validator
-	l       *zerolog.Logger
-	cache   cache.Cacheable
-	metered *metered.Metered
+	v           validator.Validator
+	l           *zerolog.Logger
+	cache       cache.Cacheable
+	metered     *metered.Metered
+	readReplica *ReadReplica
+
+	// sharedCache is the cache of the tenants, API tokens and workflow definitions, which is shared by the instances
+	sharedCache *cache.RedisCache
+
+	pgBouncerMode bool
 }
 
 func defaultPrismaRepositoryOpts() *PrismaRepositoryOpts {
@@ -76,6 +83,38 @@ func WithMetered(metered *metered.Metered) PrismaRepositoryOpt {
 	}
 }
 
+// WithSharedCache caches the tenants, API tokens and workflow definitions in a cache which is shared by the instances,
+// instead of the cache of each instance.
+func WithSharedCache(sharedCache *cache.RedisCache) PrismaRepositoryOpt {
+	return func(opts *PrismaRepositoryOpts) {
+		opts.sharedCache = sharedCache
+	}
+}
+
+// lookupCache returns the cache of the tenants and API tokens.
+func (opts *PrismaRepositoryOpts) lookupCache() cache.Cacheable {
+	if opts.sharedCache != nil {
+		return opts.sharedCache
+	}
+
+	return opts.cache
+}
+
+// WithReadReplica sends the reads of the query APIs of the API repository to a read replica.
+func WithReadReplica(readReplica *ReadReplica) PrismaRepositoryOpt {
+	return func(opts *PrismaRepositoryOpts) {
+		opts.readReplica = readReplica
+	}
+}
+
+// WithPgBouncerMode avoids the features which require a session, like listening to notifications and advisory locks,
+// as the connections are shared between sessions when they go through PgBouncer in transaction pooling mode.
+func WithPgBouncerMode() PrismaRepositoryOpt {
+	return func(opts *PrismaRepositoryOpts) {
+		opts.pgBouncerMode = true
+	}
+}
+
 func NewAPIRepository(client *db.PrismaClient, pool *pgxpool.Pool, cf *server.ConfigFileRuntime, fs ...PrismaRepositoryOpt) (repository.APIRepository, func() error, error) {
 	opts := defaultPrismaRepositoryOpts()
 
@@ -89,23 +128,30 @@ func NewAPIRepository(client *db.PrismaClient, pool *pgxpool.Pool, cf *server.Co
 	if opts.cache == nil {
 		opts.cache = cache.New(1 * time.Millisecond)
 	}
-	workflowRunRepository, cleanupWorkflowRunRepository, err := NewWorkflowRunRepository(client, pool, opts.v, opts.l, opts.metered, cf)
+
+	// without a read replica, the reads of the query APIs are sent to the primary
+	if opts.readReplica == nil {
+		opts.readReplica = &ReadReplica{primary: pool}
+	}
+
+	workflowRunRepository, cleanupWorkflowRunRepository, err := NewWorkflowRunRepository(client, pool, opts.readReplica, opts.v, opts.l, opts.metered, cf)
 
 	return &apiRepository{
-		apiToken:       NewAPITokenRepository(client, opts.v, opts.cache),
-		event:          NewEventAPIRepository(client, pool, opts.v, opts.l),
+		apiToken:       NewAPITokenRepository(client, opts.v, opts.lookupCache()),
+		event:          NewEventAPIRepository(client, pool, opts.readReplica, opts.v, opts.l),
 		log:            NewLogAPIRepository(pool, opts.v, opts.l),
-		tenant:         NewTenantAPIRepository(pool, client, opts.v, opts.l, opts.cache),
+		tenant:         NewTenantAPIRepository(pool, client, opts.v, opts.l, opts.lookupCache()),
 		tenantAlerting: NewTenantAlertingAPIRepository(client, opts.v, opts.cache),
 		tenantInvite:   NewTenantInviteRepository(client, opts.v),
 		workflow:       NewWorkflowRepository(client, pool, opts.v, opts.l),
 		workflowRun:    workflowRunRepository,
 		jobRun:         NewJobRunAPIRepository(client, pool, opts.v, opts.l),
-		stepRun:        NewStepRunAPIRepository(client, pool, opts.v, opts.l),
-		step:           NewStepRepository(pool, opts.v, opts.l),
+		stepRun:        NewStepRunAPIRepository(client, pool, opts.readReplica, opts.v, opts.l),
+		step:           NewStepRepository(pool, opts.v, opts.l, nil),
 		slack:          NewSlackRepository(client, opts.v),
 		sns:            NewSNSRepository(client, opts.v),
 		worker:         NewWorkerAPIRepository(client, pool, opts.v, opts.l, opts.metered),
+		queue:          NewQueueAPIRepository(pool, opts.v, opts.l),
 		userSession:    NewUserSessionRepository(client, opts.v),
 		user:           NewUserRepository(client, opts.l, opts.v),
 		health:         NewHealthAPIRepository(client, pool),
@@ -174,6 +220,10 @@ func (r *apiRepository) Worker() repository.WorkerAPIRepository {
 	return r.worker
 }
 
+func (r *apiRepository) Queue() repository.QueueAPIRepository {
+	return r.queue
+}
+
 func (r *apiRepository) UserSession() repository.UserSessionRepository {
 	return r.userSession
 }
@@ -191,24 +241,49 @@ func (r *apiRepository) WebhookWorker() repository.WebhookWorkerRepository {
 }
 
 type engineRepository struct {
-	health         repository.HealthRepository
-	apiToken       repository.EngineTokenRepository
-	dispatcher     repository.DispatcherEngineRepository
-	event          repository.EventEngineRepository
-	getGroupKeyRun repository.GetGroupKeyRunEngineRepository
-	jobRun         repository.JobRunEngineRepository
-	step           repository.StepRepository
-	stepRun        repository.StepRunEngineRepository
-	tenant         repository.TenantEngineRepository
-	tenantAlerting repository.TenantAlertingEngineRepository
-	ticker         repository.TickerEngineRepository
-	worker         repository.WorkerEngineRepository
-	workflow       repository.WorkflowEngineRepository
-	workflowRun    repository.WorkflowRunEngineRepository
-	streamEvent    repository.StreamEventsEngineRepository
-	log            repository.LogsEngineRepository
-	rateLimit      repository.RateLimitEngineRepository
-	webhookWorker  repository.WebhookWorkerEngineRepository
+	health              repository.HealthRepository
+	apiToken            repository.EngineTokenRepository
+	dispatcher          repository.DispatcherEngineRepository
+	event               repository.EventEngineRepository
+	getGroupKeyRun      repository.GetGroupKeyRunEngineRepository
+	jobRun              repository.JobRunEngineRepository
+	step                repository.StepRepository
+	stepRun             repository.StepRunEngineRepository
+	tenant              repository.TenantEngineRepository
+	tenantAlerting      repository.TenantAlertingEngineRepository
+	ticker              repository.TickerEngineRepository
+	worker              repository.WorkerEngineRepository
+	workflow            repository.WorkflowEngineRepository
+	workflowRun         repository.WorkflowRunEngineRepository
+	streamEvent         repository.StreamEventsEngineRepository
+	log                 repository.LogsEngineRepository
+	rateLimit           repository.RateLimitEngineRepository
+	webhookWorker       repository.WebhookWorkerEngineRepository
+	deadLetterQueue     repository.DeadLetterQueueRepository
+	signal              repository.SignalEngineRepository
+	approval            repository.ApprovalRepository
+	cronCalendar        repository.CronCalendarRepository
+	mapItems            repository.MapEngineRepository
+	stepRunCache        repository.StepRunCacheRepository
+	dataKey             repository.DataKeyRepository
+	kafkaOffset         repository.KafkaOffsetRepository
+	sqsIntegration      repository.SQSIntegrationRepository
+	inboundWebhook      repository.InboundWebhookRepository
+	eventSink           repository.EventSinkRepository
+	eventDedup          repository.EventDedupRepository
+	eventRouting        repository.EventRoutingRepository
+	messageQueue        repository.MessageQueueRepository
+	workflowRunEventLog repository.WorkflowRunEventLogRepository
+	stepRunProgress     repository.StepRunProgressRepository
+	queueMetrics        repository.QueueMetricsRepository
+	clientCA            repository.ClientCARepository
+	auditLog            repository.AuditLogRepository
+	tenantQuota         repository.TenantQuotaRepository
+	tenantExport        repository.TenantExportRepository
+	retentionPolicy     repository.RetentionPolicyRepository
+	tablePartition      repository.TablePartitionRepository
+	workflowRunArchive  repository.WorkflowRunArchiveRepository
+	operations          repository.OperationsRepository
 }
 
 func (r *engineRepository) Health() repository.HealthRepository {
@@ -283,6 +358,106 @@ func (r *engineRepository) WebhookWorker() repository.WebhookWorkerEngineReposit
 	return r.webhookWorker
 }
 
+func (r *engineRepository) DeadLetterQueue() repository.DeadLetterQueueRepository {
+	return r.deadLetterQueue
+}
+
+func (r *engineRepository) Signal() repository.SignalEngineRepository {
+	return r.signal
+}
+
+func (r *engineRepository) Approval() repository.ApprovalRepository {
+	return r.approval
+}
+
+func (r *engineRepository) CronCalendar() repository.CronCalendarRepository {
+	return r.cronCalendar
+}
+
+func (r *engineRepository) Map() repository.MapEngineRepository {
+	return r.mapItems
+}
+
+func (r *engineRepository) StepRunCache() repository.StepRunCacheRepository {
+	return r.stepRunCache
+}
+
+func (r *engineRepository) DataKey() repository.DataKeyRepository {
+	return r.dataKey
+}
+
+func (r *engineRepository) KafkaOffset() repository.KafkaOffsetRepository {
+	return r.kafkaOffset
+}
+
+func (r *engineRepository) SQSIntegration() repository.SQSIntegrationRepository {
+	return r.sqsIntegration
+}
+
+func (r *engineRepository) InboundWebhook() repository.InboundWebhookRepository {
+	return r.inboundWebhook
+}
+
+func (r *engineRepository) EventSink() repository.EventSinkRepository {
+	return r.eventSink
+}
+
+func (r *engineRepository) EventDedup() repository.EventDedupRepository {
+	return r.eventDedup
+}
+
+func (r *engineRepository) EventRouting() repository.EventRoutingRepository {
+	return r.eventRouting
+}
+
+func (r *engineRepository) MessageQueue() repository.MessageQueueRepository {
+	return r.messageQueue
+}
+
+func (r *engineRepository) WorkflowRunEventLog() repository.WorkflowRunEventLogRepository {
+	return r.workflowRunEventLog
+}
+
+func (r *engineRepository) StepRunProgress() repository.StepRunProgressRepository {
+	return r.stepRunProgress
+}
+
+func (r *engineRepository) QueueMetrics() repository.QueueMetricsRepository {
+	return r.queueMetrics
+}
+
+func (r *engineRepository) ClientCA() repository.ClientCARepository {
+	return r.clientCA
+}
+
+func (r *engineRepository) AuditLog() repository.AuditLogRepository {
+	return r.auditLog
+}
+
+func (r *engineRepository) TenantQuota() repository.TenantQuotaRepository {
+	return r.tenantQuota
+}
+
+func (r *engineRepository) TenantExport() repository.TenantExportRepository {
+	return r.tenantExport
+}
+
+func (r *engineRepository) RetentionPolicy() repository.RetentionPolicyRepository {
+	return r.retentionPolicy
+}
+
+func (r *engineRepository) TablePartition() repository.TablePartitionRepository {
+	return r.tablePartition
+}
+
+func (r *engineRepository) WorkflowRunArchive() repository.WorkflowRunArchiveRepository {
+	return r.workflowRunArchive
+}
+
+func (r *engineRepository) Operations() repository.OperationsRepository {
+	return r.operations
+}
+
 func NewEngineRepository(pool *pgxpool.Pool, essentialPool *pgxpool.Pool, cf *server.ConfigFileRuntime, fs ...PrismaRepositoryOpt) (func() error, repository.EngineRepository, error) {
 	opts := defaultPrismaRepositoryOpts()
 
@@ -301,6 +476,8 @@ func NewEngineRepository(pool *pgxpool.Pool, essentialPool *pgxpool.Pool, cf *se
 
 	rlCache := cache.New(5 * time.Minute)
 	queueCache := cache.New(5 * time.Minute)
+	quotaCache := cache.New(5 * time.Second)
+	definitionCache := newDefinitionCache(pool, opts.l, opts.pgBouncerMode, opts.sharedCache)
 
 	eventEngine, cleanupEventEngine, err := NewEventEngineRepository(pool, opts.v, opts.l, opts.metered, cf.EventBuffer)
 
@@ -319,9 +496,19 @@ func NewEngineRepository(pool *pgxpool.Pool, essentialPool *pgxpool.Pool, cf *se
 		return nil, nil, err
 	}
 
+	streamEventEngine, cleanupStreamEventEngine, err := NewStreamEventsEngineRepository(pool, opts.v, opts.l, cf.StreamEventBuffer)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	workerEngine, cleanupWorkerEngine := NewWorkerEngineRepository(pool, essentialPool, opts.v, opts.l, opts.metered, cf.HeartbeatBuffer)
+
 	return func() error {
 			rlCache.Stop()
 			queueCache.Stop()
+			quotaCache.Stop()
+			definitionCache.stop()
 
 			if err := cleanupStepRunEngine(); err != nil {
 				return err
@@ -329,28 +516,59 @@ func NewEngineRepository(pool *pgxpool.Pool, essentialPool *pgxpool.Pool, cf *se
 			if err := cleanupWorkflowRunEngine(); err != nil {
 				return err
 			}
+			if err := cleanupStreamEventEngine(); err != nil {
+				return err
+			}
+			if err := cleanupWorkerEngine(); err != nil {
+				return err
+			}
 
 			return cleanupEventEngine()
 
 		}, &engineRepository{
-			health:         NewHealthEngineRepository(pool),
-			apiToken:       NewEngineTokenRepository(pool, opts.v, opts.l, opts.cache),
-			dispatcher:     NewDispatcherRepository(pool, essentialPool, opts.v, opts.l),
-			event:          eventEngine,
-			getGroupKeyRun: NewGetGroupKeyRunRepository(pool, opts.v, opts.l),
-			jobRun:         NewJobRunEngineRepository(pool, opts.v, opts.l),
-			stepRun:        stepRunEngine,
-			step:           NewStepRepository(pool, opts.v, opts.l),
-			tenant:         NewTenantEngineRepository(pool, opts.v, opts.l, opts.cache),
-			tenantAlerting: NewTenantAlertingEngineRepository(pool, opts.v, opts.l, opts.cache),
-			ticker:         NewTickerRepository(pool, opts.v, opts.l),
-			worker:         NewWorkerEngineRepository(pool, essentialPool, opts.v, opts.l, opts.metered),
-			workflow:       NewWorkflowEngineRepository(pool, opts.v, opts.l, opts.metered, opts.cache),
-			workflowRun:    workflowRunEngine,
-			streamEvent:    NewStreamEventsEngineRepository(pool, opts.v, opts.l),
-			log:            NewLogEngineRepository(pool, opts.v, opts.l),
-			rateLimit:      NewRateLimitEngineRepository(pool, opts.v, opts.l),
-			webhookWorker:  NewWebhookWorkerEngineRepository(pool, opts.v, opts.l),
+			health:              NewHealthEngineRepository(pool),
+			apiToken:            NewEngineTokenRepository(pool, opts.v, opts.l, opts.lookupCache()),
+			dispatcher:          NewDispatcherRepository(pool, essentialPool, opts.v, opts.l),
+			event:               eventEngine,
+			getGroupKeyRun:      NewGetGroupKeyRunRepository(pool, opts.v, opts.l),
+			jobRun:              NewJobRunEngineRepository(pool, opts.v, opts.l),
+			stepRun:             stepRunEngine,
+			step:                NewStepRepository(pool, opts.v, opts.l, definitionCache),
+			tenant:              NewTenantEngineRepository(pool, opts.v, opts.l, opts.lookupCache()),
+			tenantAlerting:      NewTenantAlertingEngineRepository(pool, opts.v, opts.l, opts.cache),
+			ticker:              NewTickerRepository(pool, opts.v, opts.l),
+			worker:              workerEngine,
+			workflow:            NewWorkflowEngineRepository(pool, opts.v, opts.l, opts.metered, opts.cache, definitionCache),
+			workflowRun:         workflowRunEngine,
+			streamEvent:         streamEventEngine,
+			log:                 NewLogEngineRepository(pool, opts.v, opts.l, cf.MaxLogLinesPerStepRun),
+			rateLimit:           NewRateLimitEngineRepository(pool, opts.v, opts.l),
+			webhookWorker:       NewWebhookWorkerEngineRepository(pool, opts.v, opts.l),
+			deadLetterQueue:     NewDeadLetterQueueRepository(pool, opts.v, opts.l),
+			signal:              NewSignalEngineRepository(pool, opts.v, opts.l),
+			approval:            NewApprovalRepository(pool, opts.v, opts.l),
+			cronCalendar:        NewCronCalendarRepository(pool, opts.v, opts.l),
+			mapItems:            NewMapEngineRepository(pool, opts.v, opts.l),
+			stepRunCache:        NewStepRunCacheRepository(pool, opts.v, opts.l),
+			dataKey:             NewDataKeyRepository(pool, opts.v, opts.l),
+			kafkaOffset:         NewKafkaOffsetRepository(pool, opts.v, opts.l),
+			sqsIntegration:      NewSQSIntegrationRepository(pool, opts.v, opts.l),
+			inboundWebhook:      NewInboundWebhookRepository(pool, opts.v, opts.l),
+			eventSink:           NewEventSinkRepository(pool, opts.v, opts.l),
+			eventDedup:          NewEventDedupRepository(pool, opts.v, opts.l, opts.cache),
+			eventRouting:        NewEventRoutingRepository(pool, opts.v, opts.l, opts.cache),
+			messageQueue:        NewMessageQueueRepository(pool, opts.v, opts.l, opts.pgBouncerMode),
+			workflowRunEventLog: NewWorkflowRunEventLogRepository(pool, opts.v, opts.l),
+			stepRunProgress:     NewStepRunProgressRepository(pool, opts.v, opts.l),
+			queueMetrics:        NewQueueMetricsRepository(pool, opts.v, opts.l),
+			clientCA:            NewClientCARepository(pool, opts.v, opts.l, opts.cache),
+			auditLog:            NewAuditLogRepository(pool, opts.v, opts.l),
+			tenantQuota:         NewTenantQuotaRepository(pool, opts.v, opts.l, cf, quotaCache),
+			tenantExport:        NewTenantExportRepository(pool, opts.v, opts.l),
+			retentionPolicy:     NewRetentionPolicyRepository(pool, opts.v, opts.l),
+			tablePartition:      NewTablePartitionRepository(pool, opts.v, opts.l, opts.pgBouncerMode),
+			workflowRunArchive:  NewWorkflowRunArchiveRepository(pool, opts.v, opts.l),
+			operations:          NewOperationsRepository(pool, opts.v, opts.l),
 		},
 		err
 }
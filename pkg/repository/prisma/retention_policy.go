@@ -0,0 +1,93 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+// pruneTimeoutMs is the statement timeout of a prune batch, the deletes of workflow runs cascade to their job runs
+const pruneTimeoutMs = 60000
+
+type retentionPolicyRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewRetentionPolicyRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.RetentionPolicyRepository {
+	queries := dbsqlc.New()
+
+	return &retentionPolicyRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *retentionPolicyRepository) GetRetentionPolicy(ctx context.Context, tenantId string) (*dbsqlc.TenantRetentionPolicy, error) {
+	return r.queries.GetTenantRetentionPolicy(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *retentionPolicyRepository) UpsertRetentionPolicy(ctx context.Context, tenantId string, opts *repository.UpsertRetentionPolicyOpts) (*dbsqlc.TenantRetentionPolicy, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	return r.queries.UpsertTenantRetentionPolicy(ctx, r.pool, dbsqlc.UpsertTenantRetentionPolicyParams{
+		Tenantid:                   sqlchelpers.UUIDFromStr(tenantId),
+		WorkflowRunRetentionPeriod: textFromPtr(opts.WorkflowRunRetentionPeriod),
+		EventRetentionPeriod:       textFromPtr(opts.EventRetentionPeriod),
+	})
+}
+
+func (r *retentionPolicyRepository) PruneWorkflowRuns(ctx context.Context, tenantId string, deletedBefore time.Time, limit int, archive func(runs []*dbsqlc.PruneWorkflowRunsRow) error) (int, error) {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, pruneTimeoutMs)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer rollback()
+
+	runs, err := r.queries.PruneWorkflowRuns(ctx, tx, dbsqlc.PruneWorkflowRunsParams{
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Deletedbefore: sqlchelpers.TimestampFromTime(deletedBefore),
+		Batchsize:     int32(limit), // nolint: gosec
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("could not prune workflow runs: %w", err)
+	}
+
+	if archive != nil && len(runs) > 0 {
+		if err := archive(runs); err != nil {
+			return 0, fmt.Errorf("could not archive workflow runs: %w", err)
+		}
+	}
+
+	if err := commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return len(runs), nil
+}
+
+func textFromPtr(s *string) pgtype.Text {
+	if s == nil {
+		return pgtype.Text{}
+	}
+
+	return sqlchelpers.TextFromStr(*s)
+}
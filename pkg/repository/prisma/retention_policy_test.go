@@ -0,0 +1,134 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestRetentionPolicy(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		repo := conf.EngineRepository.RetentionPolicy()
+
+		_, err := repo.GetRetentionPolicy(ctx, tenantId)
+		require.ErrorIs(t, err, pgx.ErrNoRows)
+
+		policy, err := repo.UpsertRetentionPolicy(ctx, tenantId, &repository.UpsertRetentionPolicyOpts{
+			WorkflowRunRetentionPeriod: repository.StringPtr("2160h"),
+			EventRetentionPeriod:       repository.StringPtr("24h"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "2160h", policy.WorkflowRunRetentionPeriod.String)
+		assert.Equal(t, "24h", policy.EventRetentionPeriod.String)
+
+		// the policy is replaced, so the retention periods which aren't set are cleared
+		_, err = repo.UpsertRetentionPolicy(ctx, tenantId, &repository.UpsertRetentionPolicyOpts{
+			EventRetentionPeriod: repository.StringPtr("48h"),
+		})
+
+		require.NoError(t, err)
+
+		policy, err = repo.GetRetentionPolicy(ctx, tenantId)
+		require.NoError(t, err)
+		assert.False(t, policy.WorkflowRunRetentionPeriod.Valid)
+		assert.Equal(t, "48h", policy.EventRetentionPeriod.String)
+
+		_, err = repo.UpsertRetentionPolicy(ctx, tenantId, &repository.UpsertRetentionPolicyOpts{
+			EventRetentionPeriod: repository.StringPtr("2 days"),
+		})
+
+		assert.Error(t, err)
+
+		return nil
+	})
+}
+
+func TestPruneWorkflowRuns(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		version := createTestWorkflow(t, conf, tenantId, "prune")
+		repo := conf.EngineRepository.RetentionPolicy()
+
+		prunable := createTestWorkflowRun(t, conf, tenantId, version)
+		recentlyDeleted := createTestWorkflowRun(t, conf, tenantId, version)
+		notDeleted := createTestWorkflowRun(t, conf, tenantId, version)
+
+		_, err := conf.Pool.Exec(
+			ctx,
+			`UPDATE "WorkflowRun" SET "deletedAt" = CASE WHEN "id" = $1::uuid THEN NOW() - INTERVAL '2 days' ELSE NOW() END WHERE "id" = ANY($2::uuid[])`,
+			prunable.ID,
+			[]string{sqlchelpers.UUIDToStr(prunable.ID), sqlchelpers.UUIDToStr(recentlyDeleted.ID)},
+		)
+
+		require.NoError(t, err)
+
+		deletedBefore := time.Now().UTC().Add(-24 * time.Hour)
+
+		// the runs aren't deleted if they couldn't be archived
+		count, err := repo.PruneWorkflowRuns(ctx, tenantId, deletedBefore, 10, func(runs []*dbsqlc.PruneWorkflowRunsRow) error {
+			return errors.New("unavailable")
+		})
+
+		require.Error(t, err)
+		assert.Zero(t, count)
+		assert.ElementsMatch(t, []string{
+			sqlchelpers.UUIDToStr(prunable.ID),
+			sqlchelpers.UUIDToStr(recentlyDeleted.ID),
+			sqlchelpers.UUIDToStr(notDeleted.ID),
+		}, listWorkflowRunIds(t, conf, tenantId))
+
+		var archived []*dbsqlc.PruneWorkflowRunsRow
+
+		count, err = repo.PruneWorkflowRuns(ctx, tenantId, deletedBefore, 10, func(runs []*dbsqlc.PruneWorkflowRunsRow) error {
+			archived = runs
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		require.Len(t, archived, 1)
+		assert.Equal(t, prunable.ID, archived[0].ID)
+		assert.JSONEq(t, `{}`, string(archived[0].Input))
+
+		assert.ElementsMatch(t, []string{
+			sqlchelpers.UUIDToStr(recentlyDeleted.ID),
+			sqlchelpers.UUIDToStr(notDeleted.ID),
+		}, listWorkflowRunIds(t, conf, tenantId))
+
+		// there's nothing left to prune
+		count, err = repo.PruneWorkflowRuns(ctx, tenantId, deletedBefore, 10, nil)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+
+		return nil
+	})
+}
+
+func listWorkflowRunIds(t *testing.T, conf *database.Config, tenantId string) []string {
+	t.Helper()
+
+	rows, err := conf.Pool.Query(context.Background(), `SELECT "id"::text FROM "WorkflowRun" WHERE "tenantId" = $1::uuid`, tenantId)
+	require.NoError(t, err)
+
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	require.NoError(t, err)
+
+	return ids
+}
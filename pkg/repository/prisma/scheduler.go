@@ -0,0 +1,661 @@
+package prisma
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/statemachine"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/buffer"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type sharedSchedulerRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+type schedulerRepository struct {
+	lease      *schedulerLeaseRepository
+	queue      *schedulerQueueRepository
+	rateLimit  *schedulerRateLimitRepository
+	assignment *schedulerAssignmentRepository
+}
+
+// NewSchedulerRepository creates the repository of the schedulers. The step run events of the schedulers are
+// buffered and written in the background, so the returned cleanup function must be called to flush them.
+func NewSchedulerRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, bufferConf buffer.ConfigFileBuffer) (repository.SchedulerRepository, func() error, error) {
+	shared := &sharedSchedulerRepository{
+		pool:    pool,
+		v:       v,
+		queries: dbsqlc.New(),
+		l:       l,
+	}
+
+	eventBuffer, err := buffer.NewBulkEventWriter(pool, v, l, bufferConf)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &schedulerRepository{
+		lease:     &schedulerLeaseRepository{shared},
+		queue:     &schedulerQueueRepository{shared},
+		rateLimit: &schedulerRateLimitRepository{shared},
+		assignment: &schedulerAssignmentRepository{
+			sharedSchedulerRepository: shared,
+			eventBuffer:               eventBuffer,
+		},
+	}, eventBuffer.Cleanup, nil
+}
+
+func (r *schedulerRepository) Lease() repository.SchedulerLeaseRepository {
+	return r.lease
+}
+
+func (r *schedulerRepository) Queue() repository.SchedulerQueueRepository {
+	return r.queue
+}
+
+func (r *schedulerRepository) RateLimit() repository.SchedulerRateLimitRepository {
+	return r.rateLimit
+}
+
+func (r *schedulerRepository) Assignment() repository.SchedulerAssignmentRepository {
+	return r.assignment
+}
+
+type schedulerLeaseRepository struct {
+	*sharedSchedulerRepository
+}
+
+func (r *schedulerLeaseRepository) ListQueues(ctx context.Context, tenantId string) ([]*dbsqlc.Queue, error) {
+	return r.queries.ListQueues(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *schedulerLeaseRepository) ListActiveWorkers(ctx context.Context, tenantId string) ([]*dbsqlc.ListActiveWorkersRow, error) {
+	return r.queries.ListActiveWorkers(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *schedulerLeaseRepository) ListWorkerLabels(ctx context.Context, workerIds []pgtype.UUID) ([]*dbsqlc.ListManyWorkerLabelsRow, error) {
+	labels, err := r.queries.ListManyWorkerLabels(ctx, r.pool, workerIds)
+
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	return labels, nil
+}
+
+func (r *schedulerLeaseRepository) AcquireOrExtendLeases(ctx context.Context, tenantId string, kind dbsqlc.LeaseKind, resourceIds []string, existingLeaseIds []int64) ([]*dbsqlc.Lease, error) {
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	err = r.queries.GetLeasesToAcquire(ctx, tx, dbsqlc.GetLeasesToAcquireParams{
+		Kind:        kind,
+		Resourceids: resourceIds,
+		Tenantid:    pgTenantId,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	leases, err := r.queries.AcquireOrExtendLeases(ctx, tx, dbsqlc.AcquireOrExtendLeasesParams{
+		Kind:             kind,
+		Resourceids:      resourceIds,
+		Tenantid:         pgTenantId,
+		Existingleaseids: existingLeaseIds,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return leases, nil
+}
+
+func (r *schedulerLeaseRepository) ReleaseLeases(ctx context.Context, leaseIds []int64) error {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return err
+	}
+
+	defer rollback()
+
+	_, err = r.queries.ReleaseLeases(ctx, tx, leaseIds)
+
+	if err != nil {
+		return err
+	}
+
+	return commit(ctx)
+}
+
+func (r *schedulerLeaseRepository) ListAvailableLeaseResources(ctx context.Context, tenantId string, kind dbsqlc.LeaseKind, resourceIds []string) ([]string, error) {
+	return r.queries.ListAvailableLeaseResources(ctx, r.pool, dbsqlc.ListAvailableLeaseResourcesParams{
+		Kind:        kind,
+		Resourceids: resourceIds,
+		Tenantid:    sqlchelpers.UUIDFromStr(tenantId),
+	})
+}
+
+type schedulerQueueRepository struct {
+	*sharedSchedulerRepository
+}
+
+func listQueueItemsParams(opts *repository.ListQueueItemsOpts) (gtId pgtype.Int8, shardCount pgtype.Int4, shardIndex pgtype.Int4, limit pgtype.Int4) {
+	if opts.GtId != nil {
+		gtId = pgtype.Int8{
+			Int64: *opts.GtId,
+			Valid: true,
+		}
+	}
+
+	if opts.ShardCount != nil && opts.ShardIndex != nil {
+		shardCount = sqlchelpers.ToInt(int32(*opts.ShardCount)) // nolint: gosec
+		shardIndex = sqlchelpers.ToInt(int32(*opts.ShardIndex)) // nolint: gosec
+	}
+
+	limit = sqlchelpers.ToInt(int32(opts.Limit)) // nolint: gosec
+
+	return gtId, shardCount, shardIndex, limit
+}
+
+func (r *schedulerQueueRepository) ListQueueItems(ctx context.Context, tenantId string, opts *repository.ListQueueItemsOpts) ([]*dbsqlc.ListQueueItemsForQueueRow, error) {
+	gtId, shardCount, shardIndex, limit := listQueueItemsParams(opts)
+
+	return r.queries.ListQueueItemsForQueue(ctx, r.pool, dbsqlc.ListQueueItemsForQueueParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Queue:      opts.Queue,
+		GtId:       gtId,
+		ShardCount: shardCount,
+		ShardIndex: shardIndex,
+		Limit:      limit,
+	})
+}
+
+func (r *schedulerQueueRepository) ListQueueItemsFairShare(ctx context.Context, tenantId string, opts *repository.ListQueueItemsOpts, weights map[string]int32) ([]*dbsqlc.ListQueueItemsForQueueRow, error) {
+	_, shardCount, shardIndex, limit := listQueueItemsParams(opts)
+
+	params := dbsqlc.ListQueueItemsForQueueFairShareParams{
+		Tenantid:    sqlchelpers.UUIDFromStr(tenantId),
+		Queue:       opts.Queue,
+		ShardCount:  shardCount,
+		ShardIndex:  shardIndex,
+		Workflowids: make([]pgtype.UUID, 0, len(weights)),
+		Weights:     make([]int32, 0, len(weights)),
+		Limit:       limit,
+	}
+
+	for workflowId, weight := range weights {
+		params.Workflowids = append(params.Workflowids, sqlchelpers.UUIDFromStr(workflowId))
+		params.Weights = append(params.Weights, weight)
+	}
+
+	rows, err := r.queries.ListQueueItemsForQueueFairShare(ctx, r.pool, params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*dbsqlc.ListQueueItemsForQueueRow, 0, len(rows))
+
+	for _, row := range rows {
+		res = append(res, &dbsqlc.ListQueueItemsForQueueRow{
+			QueueItem: row.QueueItem,
+			Status:    row.Status,
+		})
+	}
+
+	return res, nil
+}
+
+func (r *schedulerQueueRepository) ListQueueItemsEDF(ctx context.Context, tenantId string, opts *repository.ListQueueItemsOpts) ([]*dbsqlc.ListQueueItemsForQueueRow, error) {
+	gtId, shardCount, shardIndex, limit := listQueueItemsParams(opts)
+
+	rows, err := r.queries.ListQueueItemsForQueueEDF(ctx, r.pool, dbsqlc.ListQueueItemsForQueueEDFParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Queue:      opts.Queue,
+		GtId:       gtId,
+		ShardCount: shardCount,
+		ShardIndex: shardIndex,
+		Limit:      limit,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*dbsqlc.ListQueueItemsForQueueRow, 0, len(rows))
+
+	for _, row := range rows {
+		res = append(res, &dbsqlc.ListQueueItemsForQueueRow{
+			QueueItem: row.QueueItem,
+			Status:    row.Status,
+		})
+	}
+
+	return res, nil
+}
+
+func (r *schedulerQueueRepository) GetMinUnprocessedQueueItemId(ctx context.Context, tenantId, queue string) (int64, error) {
+	return r.queries.GetMinUnprocessedQueueItemId(ctx, r.pool, dbsqlc.GetMinUnprocessedQueueItemIdParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Queue:    queue,
+	})
+}
+
+// checkFencingToken verifies that the queue lease still has the fencing token of the fence. The lease is
+// share-locked until the transaction ends, so it can't change hands before the writes are committed.
+func (r *schedulerQueueRepository) checkFencingToken(ctx context.Context, tx dbsqlc.DBTX, tenantId string, fence *repository.QueueFence) error {
+	if fence == nil {
+		return nil
+	}
+
+	currToken, err := r.queries.GetLeaseFencingToken(ctx, tx, dbsqlc.GetLeaseFencingTokenParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Kind:       dbsqlc.LeaseKindQUEUE,
+		Resourceid: fence.ResourceId,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.ErrLeaseLost
+		}
+
+		return fmt.Errorf("could not get lease fencing token: %w", err)
+	}
+
+	if currToken != fence.FencingToken {
+		return repository.ErrLeaseLost
+	}
+
+	return nil
+}
+
+func (r *schedulerQueueRepository) RemoveQueueItems(ctx context.Context, tenantId string, fence *repository.QueueFence, queueItemIds []int64) error {
+	// we prepare a transaction in order to set a statement timeout
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return err
+	}
+
+	defer rollback()
+
+	if err := r.checkFencingToken(ctx, tx, tenantId, fence); err != nil {
+		return err
+	}
+
+	err = r.queries.BulkQueueItems(ctx, tx, queueItemIds)
+
+	if err != nil {
+		return err
+	}
+
+	return commit(ctx)
+}
+
+func (r *schedulerQueueRepository) MarkQueueItemsProcessed(ctx context.Context, tenantId string, opts *repository.MarkQueueItemsProcessedOpts) ([]*dbsqlc.UpdateStepRunsToAssignedRow, error) {
+	idsToUnqueue := make([]int64, 0, len(opts.Assigned)+len(opts.SchedulingTimedOut))
+	stepRunIds := make([]pgtype.UUID, 0, len(opts.Assigned))
+	workerIds := make([]pgtype.UUID, 0, len(opts.Assigned))
+	stepTimeouts := make([]string, 0, len(opts.Assigned))
+
+	for _, assigned := range opts.Assigned {
+		idsToUnqueue = append(idsToUnqueue, assigned.QueueItemId)
+		stepRunIds = append(stepRunIds, assigned.StepRunId)
+		workerIds = append(workerIds, assigned.WorkerId)
+		stepTimeouts = append(stepTimeouts, assigned.StepTimeout)
+	}
+
+	timedOutStepRuns := make([]pgtype.UUID, 0, len(opts.SchedulingTimedOut))
+
+	for _, qi := range opts.SchedulingTimedOut {
+		idsToUnqueue = append(idsToUnqueue, qi.ID)
+		timedOutStepRuns = append(timedOutStepRuns, qi.StepRunId)
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	if err := r.checkFencingToken(ctx, tx, tenantId, opts.Fence); err != nil {
+		return nil, err
+	}
+
+	_, err = r.queries.BulkMarkStepRunsAsCancelling(ctx, tx, dbsqlc.BulkMarkStepRunsAsCancellingParams{
+		Steprunids:   timedOutStepRuns,
+		Fromstatuses: statemachine.StepRunSources(dbsqlc.StepRunStatusCANCELLING),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not bulk mark step runs as cancelling: %w", err)
+	}
+
+	updatedStepRuns, err := r.queries.UpdateStepRunsToAssigned(ctx, tx, dbsqlc.UpdateStepRunsToAssignedParams{
+		Steprunids:      stepRunIds,
+		Workerids:       workerIds,
+		Stepruntimeouts: stepTimeouts,
+		Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.queries.BulkQueueItems(ctx, tx, idsToUnqueue)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return updatedStepRuns, nil
+}
+
+type schedulerRateLimitRepository struct {
+	*sharedSchedulerRepository
+}
+
+func (r *schedulerRateLimitRepository) ListCandidateRateLimits(ctx context.Context, tenantId string) ([]string, error) {
+	rls, err := r.queries.ListRateLimitsForTenantNoMutate(ctx, r.pool, dbsqlc.ListRateLimitsForTenantNoMutateParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Limit:    10000,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, len(rls))
+
+	for i, rl := range rls {
+		keys[i] = rl.Key
+	}
+
+	return keys, nil
+}
+
+func (r *schedulerRateLimitRepository) UpdateRateLimits(ctx context.Context, tenantId string, updates map[string]int, globalUpdates map[string]int) (map[string]int, map[string]int, error) {
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	params := dbsqlc.BulkUpdateRateLimitsParams{
+		Tenantid: pgTenantId,
+		Keys:     make([]string, 0, len(updates)),
+		Units:    make([]int32, 0, len(updates)),
+	}
+
+	for k, v := range updates {
+		params.Keys = append(params.Keys, k)
+		params.Units = append(params.Units, int32(v)) // nolint: gosec
+	}
+
+	globalParams := dbsqlc.BulkUpdateGlobalRateLimitsParams{
+		Keys:  make([]string, 0, len(globalUpdates)),
+		Units: make([]int32, 0, len(globalUpdates)),
+	}
+
+	for k, v := range globalUpdates {
+		globalParams.Keys = append(globalParams.Keys, k)
+		globalParams.Units = append(globalParams.Units, int32(v)) // nolint: gosec
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer rollback()
+
+	_, err = r.queries.BulkUpdateRateLimits(ctx, tx, params)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(globalParams.Keys) > 0 {
+		_, err = r.queries.BulkUpdateGlobalRateLimits(ctx, tx, globalParams)
+
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	newRls, err := r.queries.ListRateLimitsForTenantWithMutate(ctx, tx, pgTenantId)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// global rate limits are read without refilling them, so that every tenant's flush doesn't write to
+	// the shared rows. Refills are written when units are consumed.
+	globalRls, err := r.queries.ListGlobalRateLimits(ctx, tx)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	rateLimits := make(map[string]int, len(newRls))
+
+	for _, rl := range newRls {
+		rateLimits[rl.Key] = int(rl.Value)
+	}
+
+	globalRateLimits := make(map[string]int, len(globalRls))
+
+	for _, rl := range globalRls {
+		globalRateLimits[rl.Key] = int(rl.Value)
+	}
+
+	return rateLimits, globalRateLimits, nil
+}
+
+func (r *schedulerRateLimitRepository) ListStepRunExpressionEvals(ctx context.Context, stepRunIds []pgtype.UUID) ([]*dbsqlc.StepRunExpressionEval, error) {
+	return r.queries.ListStepRunExpressionEvals(ctx, r.pool, stepRunIds)
+}
+
+func (r *schedulerRateLimitRepository) UpsertDynamicRateLimits(ctx context.Context, tenantId string, opts *repository.UpsertDynamicRateLimitsOpts) error {
+	params := dbsqlc.UpsertRateLimitsBulkParams{
+		Tenantid:    sqlchelpers.UUIDFromStr(tenantId),
+		Keys:        opts.Keys,
+		Windows:     opts.Windows,
+		Limitvalues: opts.LimitValues,
+	}
+
+	if opts.ExpiresAt != nil {
+		params.ExpiresAt = sqlchelpers.TimestampFromTime(opts.ExpiresAt.UTC())
+	}
+
+	return r.queries.UpsertRateLimitsBulk(ctx, r.pool, params)
+}
+
+func (r *schedulerRateLimitRepository) ListRateLimitsForSteps(ctx context.Context, tenantId string, stepIds []pgtype.UUID) ([]*dbsqlc.StepRateLimit, error) {
+	return r.queries.ListRateLimitsForSteps(ctx, r.pool, dbsqlc.ListRateLimitsForStepsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Stepids:  stepIds,
+	})
+}
+
+func (r *schedulerRateLimitRepository) ListGlobalRateLimitsForSteps(ctx context.Context, tenantId string, stepIds []pgtype.UUID) ([]*dbsqlc.StepGlobalRateLimit, error) {
+	return r.queries.ListGlobalRateLimitsForSteps(ctx, r.pool, dbsqlc.ListGlobalRateLimitsForStepsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Stepids:  stepIds,
+	})
+}
+
+type schedulerAssignmentRepository struct {
+	*sharedSchedulerRepository
+
+	eventBuffer *buffer.BulkEventWriter
+}
+
+func (r *schedulerAssignmentRepository) ListActionsForWorkers(ctx context.Context, tenantId string, workerIds []pgtype.UUID) ([]*dbsqlc.ListActionsForWorkersRow, error) {
+	return r.queries.ListActionsForWorkers(ctx, r.pool, dbsqlc.ListActionsForWorkersParams{
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Workerids: workerIds,
+	})
+}
+
+func (r *schedulerAssignmentRepository) ListAvailableSlotsForWorkers(ctx context.Context, tenantId string, workerIds []pgtype.UUID) ([]*dbsqlc.ListAvailableSlotsForWorkersRow, error) {
+	return r.queries.ListAvailableSlotsForWorkers(ctx, r.pool, dbsqlc.ListAvailableSlotsForWorkersParams{
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Workerids: workerIds,
+	})
+}
+
+func (r *schedulerAssignmentRepository) ListActiveSlotReservations(ctx context.Context, tenantId string) ([]*dbsqlc.ListActiveSlotReservationsRow, error) {
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	if err := r.queries.DeleteInactiveSlotReservations(ctx, r.pool, pgTenantId); err != nil {
+		return nil, err
+	}
+
+	return r.queries.ListActiveSlotReservations(ctx, r.pool, pgTenantId)
+}
+
+func (r *schedulerAssignmentRepository) ListQueuedStepRunsForSlotReservations(ctx context.Context, tenantId string) ([]*dbsqlc.ListQueuedStepRunsForSlotReservationsRow, error) {
+	return r.queries.ListQueuedStepRunsForSlotReservations(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *schedulerAssignmentRepository) GetDesiredLabels(ctx context.Context, stepIds []pgtype.UUID) ([]*dbsqlc.GetDesiredLabelsRow, error) {
+	return r.queries.GetDesiredLabels(ctx, r.pool, stepIds)
+}
+
+func (r *schedulerAssignmentRepository) GetDesiredRegions(ctx context.Context, stepIds []pgtype.UUID) ([]*dbsqlc.GetDesiredRegionsRow, error) {
+	return r.queries.GetDesiredRegions(ctx, r.pool, stepIds)
+}
+
+func (r *schedulerAssignmentRepository) GetDesiredSlotTypes(ctx context.Context, stepIds []pgtype.UUID) ([]*dbsqlc.GetDesiredSlotTypesRow, error) {
+	return r.queries.GetDesiredSlotTypes(ctx, r.pool, stepIds)
+}
+
+func (r *schedulerAssignmentRepository) GetDesiredPools(ctx context.Context, stepIds []pgtype.UUID) ([]*dbsqlc.GetDesiredPoolsRow, error) {
+	return r.queries.GetDesiredPools(ctx, r.pool, stepIds)
+}
+
+func (r *schedulerAssignmentRepository) CreateWorkerAssignEvents(ctx context.Context, workerIdsToStepRunIds map[string][]string) error {
+	params := dbsqlc.CreateWorkerAssignEventsParams{
+		Workerids:        make([]pgtype.UUID, 0, len(workerIdsToStepRunIds)),
+		Assignedstepruns: make([][]byte, 0, len(workerIdsToStepRunIds)),
+	}
+
+	for workerId, stepRunIds := range workerIdsToStepRunIds {
+		assignedStepRuns, err := json.Marshal(stepRunIds)
+
+		if err != nil {
+			return err
+		}
+
+		params.Workerids = append(params.Workerids, sqlchelpers.UUIDFromStr(workerId))
+		params.Assignedstepruns = append(params.Assignedstepruns, assignedStepRuns)
+	}
+
+	return r.queries.CreateWorkerAssignEvents(ctx, r.pool, params)
+}
+
+func (r *schedulerAssignmentRepository) CreateSchedulingDecisions(ctx context.Context, tenantId string, opts []*repository.CreateSchedulingDecisionOpts, maxDecisions int) error {
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	params := dbsqlc.BulkCreateSchedulingDecisionsParams{
+		Tenantid:   pgTenantId,
+		Createdats: make([]pgtype.Timestamp, 0, len(opts)),
+		Steprunids: make([]pgtype.UUID, 0, len(opts)),
+		Queues:     make([]string, 0, len(opts)),
+		Outcomes:   make([]string, 0, len(opts)),
+		Workerids:  make([]pgtype.UUID, 0, len(opts)),
+		Messages:   make([]string, 0, len(opts)),
+		Data:       make([][]byte, 0, len(opts)),
+	}
+
+	for _, decision := range opts {
+		data, err := json.Marshal(decision.Data)
+
+		if err != nil {
+			return fmt.Errorf("could not marshal scheduling decision data: %w", err)
+		}
+
+		params.Createdats = append(params.Createdats, sqlchelpers.TimestampFromTime(decision.CreatedAt))
+		params.Steprunids = append(params.Steprunids, decision.StepRunId)
+		params.Queues = append(params.Queues, decision.Queue)
+		params.Outcomes = append(params.Outcomes, string(decision.Outcome))
+		params.Workerids = append(params.Workerids, decision.WorkerId)
+		params.Messages = append(params.Messages, decision.Message)
+		params.Data = append(params.Data, data)
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, 5000)
+
+	if err != nil {
+		return err
+	}
+
+	defer rollback()
+
+	err = r.queries.BulkCreateSchedulingDecisions(ctx, tx, params)
+
+	if err != nil {
+		return fmt.Errorf("could not create scheduling decisions: %w", err)
+	}
+
+	err = r.queries.TrimSchedulingDecisions(ctx, tx, dbsqlc.TrimSchedulingDecisionsParams{
+		Tenantid:     pgTenantId,
+		Maxdecisions: int32(maxDecisions), // nolint: gosec
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not trim scheduling decisions: %w", err)
+	}
+
+	return commit(ctx)
+}
+
+func (r *schedulerAssignmentRepository) DeferredStepRunEvent(tenantId string, opts repository.CreateStepRunEventOpts) {
+	if err := r.v.Validate(opts); err != nil {
+		r.l.Err(err).Msg("could not validate step run event")
+		return
+	}
+
+	// fire-and-forget for events
+	_, err := r.eventBuffer.BuffItem(tenantId, &opts)
+
+	if err != nil {
+		r.l.Err(err).Msg("could not buffer step run event")
+	}
+}
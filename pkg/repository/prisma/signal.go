@@ -0,0 +1,107 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type signalEngineRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewSignalEngineRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.SignalEngineRepository {
+	queries := dbsqlc.New()
+
+	return &signalEngineRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *signalEngineRepository) CreateSignalWait(ctx context.Context, tenantId, stepRunId string, opts *repository.CreateSignalWaitOpts) error {
+	if err := r.v.Validate(opts); err != nil {
+		return err
+	}
+
+	params := dbsqlc.CreateStepRunSignalWaitParams{
+		Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Eventkey:  opts.EventKey,
+	}
+
+	if opts.CorrelationExpr != nil {
+		params.CorrelationExpr = sqlchelpers.TextFromStr(*opts.CorrelationExpr)
+	}
+
+	if opts.CorrelationValue != nil {
+		params.CorrelationValue = sqlchelpers.TextFromStr(*opts.CorrelationValue)
+	}
+
+	err := r.queries.CreateStepRunSignalWait(ctx, r.pool, params)
+
+	if err != nil {
+		return fmt.Errorf("could not create signal wait: %w", err)
+	}
+
+	return nil
+}
+
+func (r *signalEngineRepository) ListSignalWaitsForEvent(ctx context.Context, tenantId, eventKey string) ([]*dbsqlc.ListStepRunSignalWaitsForEventRow, error) {
+	return r.queries.ListStepRunSignalWaitsForEvent(ctx, r.pool, dbsqlc.ListStepRunSignalWaitsForEventParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Eventkey: eventKey,
+	})
+}
+
+func (r *signalEngineRepository) ClaimSignalWait(ctx context.Context, tenantId, stepRunId string) (bool, error) {
+	tx, err := r.pool.Begin(ctx)
+
+	if err != nil {
+		return false, err
+	}
+
+	defer sqlchelpers.DeferRollback(ctx, r.l, tx.Rollback)
+
+	pgStepRunId := sqlchelpers.UUIDFromStr(stepRunId)
+
+	deleted, err := r.queries.DeleteStepRunSignalWait(ctx, tx, dbsqlc.DeleteStepRunSignalWaitParams{
+		Steprunid: pgStepRunId,
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not delete signal wait: %w", err)
+	}
+
+	if deleted == 0 {
+		return false, nil
+	}
+
+	err = r.queries.DeleteStepRunTimers(ctx, tx, []pgtype.UUID{pgStepRunId})
+
+	if err != nil {
+		return false, fmt.Errorf("could not delete step run timer: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -30,13 +30,6 @@ func PrepareTx(ctx context.Context, pool *pgxpool.Pool, l *zerolog.Logger, timeo
 	}
 
 	commit := func(ctx context.Context) error {
-		// reset statement timeout
-		_, err = tx.Exec(ctx, "SET statement_timeout=0")
-
-		if err != nil {
-			return err
-		}
-
 		return tx.Commit(ctx)
 	}
 
@@ -44,8 +37,9 @@ func PrepareTx(ctx context.Context, pool *pgxpool.Pool, l *zerolog.Logger, timeo
 		DeferRollback(ctx, l, tx.Rollback)
 	}
 
-	// set tx timeout to 5 seconds to avoid deadlocks
-	_, err = tx.Exec(ctx, fmt.Sprintf("SET statement_timeout=%d", timeoutMs))
+	// set tx timeout to avoid deadlocks. The timeout is local to the transaction, so it doesn't need to be reset on the
+	// session, which may be shared with other clients when connecting through PgBouncer.
+	_, err = tx.Exec(ctx, fmt.Sprintf("SET LOCAL statement_timeout=%d", timeoutMs))
 
 	if err != nil {
 		return nil, nil, nil, err
@@ -0,0 +1,75 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type sqsIntegrationRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewSQSIntegrationRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.SQSIntegrationRepository {
+	queries := dbsqlc.New()
+
+	return &sqsIntegrationRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *sqsIntegrationRepository) CreateSQSIntegration(ctx context.Context, tenantId string, opts *repository.CreateSQSIntegrationOpts) (*dbsqlc.SQSIntegration, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.CreateSQSIntegrationParams{
+		Tenantid:              sqlchelpers.UUIDFromStr(tenantId),
+		Queueurl:              opts.QueueUrl,
+		Region:                opts.Region,
+		Eventkey:              opts.EventKey,
+		Accesskeyidsecret:     opts.AccessKeyIdSecret,
+		Secretaccesskeysecret: opts.SecretAccessKeySecret,
+	}
+
+	if opts.SessionTokenSecret != nil {
+		params.SessionTokenSecret = sqlchelpers.TextFromStr(*opts.SessionTokenSecret)
+	}
+
+	integration, err := r.queries.CreateSQSIntegration(ctx, r.pool, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create sqs integration: %w", err)
+	}
+
+	return integration, nil
+}
+
+func (r *sqsIntegrationRepository) ListSQSIntegrations(ctx context.Context, tenantId string) ([]*dbsqlc.SQSIntegration, error) {
+	return r.queries.ListSQSIntegrations(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *sqsIntegrationRepository) ListSQSIntegrationsByPartitionId(ctx context.Context, partitionId string) ([]*dbsqlc.SQSIntegration, error) {
+	return r.queries.ListSQSIntegrationsByPartitionId(ctx, r.pool, partitionId)
+}
+
+func (r *sqsIntegrationRepository) GetSQSIntegrationById(ctx context.Context, id string) (*dbsqlc.SQSIntegration, error) {
+	return r.queries.GetSQSIntegrationById(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
+
+func (r *sqsIntegrationRepository) DeleteSQSIntegration(ctx context.Context, id string) error {
+	return r.queries.DeleteSQSIntegration(ctx, r.pool, sqlchelpers.UUIDFromStr(id))
+}
@@ -13,23 +13,28 @@ import (
 )
 
 type stepRepository struct {
-	v       validator.Validator
-	pool    *pgxpool.Pool
-	queries *dbsqlc.Queries
-	l       *zerolog.Logger
+	v               validator.Validator
+	pool            *pgxpool.Pool
+	queries         *dbsqlc.Queries
+	l               *zerolog.Logger
+	definitionCache *definitionCache
 }
 
-func NewStepRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.StepRepository {
+func NewStepRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, definitionCache *definitionCache) repository.StepRepository {
 	queries := dbsqlc.New()
 
 	return &stepRepository{
-		pool:    pool,
-		v:       v,
-		l:       l,
-		queries: queries,
+		pool:            pool,
+		v:               v,
+		l:               l,
+		queries:         queries,
+		definitionCache: definitionCache,
 	}
 }
 
 func (j *stepRepository) ListStepExpressions(ctx context.Context, stepId string) ([]*dbsqlc.StepExpression, error) {
-	return j.queries.GetStepExpressions(ctx, j.pool, sqlchelpers.UUIDFromStr(stepId))
+	// steps aren't updated once they're created, so their expressions aren't cached per tenant
+	return getCachedDefinition(j.definitionCache, "", "step-expressions-"+stepId, func() ([]*dbsqlc.StepExpression, error) {
+		return j.queries.GetStepExpressions(ctx, j.pool, sqlchelpers.UUIDFromStr(stepId))
+	})
 }
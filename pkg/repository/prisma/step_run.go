@@ -19,6 +19,8 @@ import (
 	"github.com/rs/zerolog"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/hatchet-dev/hatchet/internal/metrics"
+	"github.com/hatchet-dev/hatchet/internal/statemachine"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
@@ -32,22 +34,24 @@ import (
 )
 
 type stepRunAPIRepository struct {
-	client  *db.PrismaClient
-	pool    *pgxpool.Pool
-	v       validator.Validator
-	l       *zerolog.Logger
-	queries *dbsqlc.Queries
+	client      *db.PrismaClient
+	pool        *pgxpool.Pool
+	readReplica *ReadReplica
+	v           validator.Validator
+	l           *zerolog.Logger
+	queries     *dbsqlc.Queries
 }
 
-func NewStepRunAPIRepository(client *db.PrismaClient, pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.StepRunAPIRepository {
+func NewStepRunAPIRepository(client *db.PrismaClient, pool *pgxpool.Pool, readReplica *ReadReplica, v validator.Validator, l *zerolog.Logger) repository.StepRunAPIRepository {
 	queries := dbsqlc.New()
 
 	return &stepRunAPIRepository{
-		client:  client,
-		pool:    pool,
-		v:       v,
-		l:       l,
-		queries: queries,
+		client:      client,
+		pool:        pool,
+		readReplica: readReplica,
+		v:           v,
+		l:           l,
+		queries:     queries,
 	}
 }
 
@@ -73,10 +77,32 @@ func (s *stepRunAPIRepository) GetStepRunById(stepRunId string) (*repository.Get
 		childWorkflowRuns[i] = sqlchelpers.UUIDToStr(id)
 	}
 
-	return &repository.GetStepRunFull{
+	res := &repository.GetStepRunFull{
 		StepRun:           stepRun,
 		ChildWorkflowRuns: childWorkflowRuns,
-	}, nil
+	}
+
+	nextRetryAfter, err := s.queries.GetStepRunNextRetryAfter(context.Background(), s.pool, sqlchelpers.UUIDFromStr(stepRunId))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get next retry time: %w", err)
+	}
+
+	if nextRetryAfter.Valid {
+		res.NextRetryAt = &nextRetryAfter.Time
+	}
+
+	progress, err := s.queries.GetStepRunProgress(context.Background(), s.pool, sqlchelpers.UUIDFromStr(stepRunId))
+
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("could not get step run progress: %w", err)
+	}
+
+	if err == nil {
+		res.Progress = progress
+	}
+
+	return res, nil
 }
 
 func (s *stepRunAPIRepository) ListStepRunEvents(stepRunId string, opts *repository.ListStepRunEventOpts) (*repository.ListStepRunEventResult, error) {
@@ -138,8 +164,72 @@ func (s *stepRunAPIRepository) ListStepRunEvents(stepRunId string, opts *reposit
 	}, nil
 }
 
+func (s *stepRunAPIRepository) ListSchedulingDecisions(tenantId, stepRunId string, opts *repository.ListSchedulingDecisionsOpts) (*repository.ListSchedulingDecisionsResult, error) {
+	if err := s.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.pool.Begin(context.Background())
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer sqlchelpers.DeferRollback(context.Background(), s.l, tx.Rollback)
+
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+	pgStepRunId := sqlchelpers.UUIDFromStr(stepRunId)
+
+	listParams := dbsqlc.ListSchedulingDecisionsForStepRunParams{
+		Tenantid:  pgTenantId,
+		Steprunid: pgStepRunId,
+	}
+
+	if opts.Offset != nil {
+		listParams.Offset = *opts.Offset
+	}
+
+	if opts.Limit != nil {
+		listParams.Limit = *opts.Limit
+	}
+
+	decisions, err := s.queries.ListSchedulingDecisionsForStepRun(context.Background(), tx, listParams)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			decisions = make([]*dbsqlc.SchedulingDecision, 0)
+		} else {
+			return nil, fmt.Errorf("could not list scheduling decisions: %w", err)
+		}
+	}
+
+	count, err := s.queries.CountSchedulingDecisionsForStepRun(context.Background(), tx, dbsqlc.CountSchedulingDecisionsForStepRunParams{
+		Tenantid:  pgTenantId,
+		Steprunid: pgStepRunId,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			count = 0
+		} else {
+			return nil, fmt.Errorf("could not count scheduling decisions: %w", err)
+		}
+	}
+
+	err = tx.Commit(context.Background())
+
+	if err != nil {
+		return nil, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return &repository.ListSchedulingDecisionsResult{
+		Rows:  decisions,
+		Count: int(count),
+	}, nil
+}
+
 func (s *stepRunAPIRepository) ListStepRunEventsByWorkflowRunId(ctx context.Context, tenantId, workflowRunId string, lastId *int32) (*repository.ListStepRunEventResult, error) {
-	tx, err := s.pool.Begin(ctx)
+	tx, err := s.readReplica.Pool(ctx).Begin(ctx)
 
 	if err != nil {
 		return nil, err
@@ -409,6 +499,9 @@ func (s *stepRunEngineRepository) bulkUpdateStepRunStatuses(ctx context.Context,
 			if item.Error != nil && *item.Error == "TIMED_OUT" {
 				eventReason = dbsqlc.StepRunEventReasonTIMEDOUT
 				eventMessage = "Step exceeded timeout duration"
+			} else if item.Error != nil && *item.Error == "HEARTBEAT_TIMED_OUT" {
+				eventReason = dbsqlc.StepRunEventReasonTIMEDOUT
+				eventMessage = "Step exceeded heartbeat timeout duration"
 			}
 
 			eventReasons = append(eventReasons, eventReason)
@@ -496,6 +589,12 @@ func (s *stepRunEngineRepository) bulkUpdateStepRunStatuses(ctx context.Context,
 		return nil, err
 	}
 
+	for _, item := range opts {
+		if item.Status != nil {
+			metrics.StepRunTransitions.WithLabelValues(*item.Status).Inc()
+		}
+	}
+
 	return stepRunIds, nil
 }
 
@@ -637,33 +736,126 @@ func (s *stepRunEngineRepository) ListStepRunsToCancel(ctx context.Context, tena
 	return res, err
 }
 
-func (s *stepRunEngineRepository) ListStepRunsToReassign(ctx context.Context, tenantId string) ([]string, []*dbsqlc.GetStepRunForEngineRow, error) {
+func (s *stepRunEngineRepository) BulkReassignStepRuns(ctx context.Context, tenantId string, opts *repository.BulkReassignStepRunsOpts) (*repository.BulkReassignStepRunsResult, error) {
+	if err := s.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
 	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
 
+	limit := 1000
+
+	if opts.Limit != nil {
+		limit = *opts.Limit
+	}
+
+	params := dbsqlc.BulkReassignStepRunsParams{
+		Tenantid:              pgTenantId,
+		Batchsize:             int32(limit), // nolint: gosec
+		Maxinternalretrycount: s.cf.MaxInternalRetryCount,
+		// step runs which can't transition to pending assignment are failed instead
+		Fromstatuses: statemachine.StepRunSources(dbsqlc.StepRunStatusPENDINGASSIGNMENT),
+	}
+
+	if opts.Cursor != nil {
+		params.Cursor = sqlchelpers.UUIDFromStr(*opts.Cursor)
+	}
+
 	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, s.pool, s.l, 5000)
 
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	defer rollback()
 
-	// get the step run and make sure it's still in pending
-	results, err := s.queries.ListStepRunsToReassign(ctx, tx, dbsqlc.ListStepRunsToReassignParams{
+	results, err := s.queries.BulkReassignStepRuns(ctx, tx, params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.finishReassignStepRuns(ctx, tx, commit, tenantId, results, limit, "Worker has become inactive")
+}
+
+func (s *stepRunEngineRepository) ReassignLostStepRuns(ctx context.Context, tenantId string, opts *repository.BulkReassignStepRunsOpts) (*repository.BulkReassignStepRunsResult, error) {
+	if err := s.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	if s.cf.StepRunHeartbeatTimeout <= 0 {
+		return &repository.BulkReassignStepRunsResult{}, nil
+	}
+
+	limit := 1000
+
+	if opts.Limit != nil {
+		limit = *opts.Limit
+	}
+
+	params := dbsqlc.ReassignLostStepRunsParams{
+		Tenantid:              sqlchelpers.UUIDFromStr(tenantId),
+		Lostbefore:            sqlchelpers.TimestampFromTime(time.Now().UTC().Add(-s.cf.StepRunHeartbeatTimeout)),
+		Batchsize:             int32(limit), // nolint: gosec
 		Maxinternalretrycount: s.cf.MaxInternalRetryCount,
-		Tenantid:              pgTenantId,
-	})
+	}
+
+	if opts.Cursor != nil {
+		params.Cursor = sqlchelpers.UUIDFromStr(*opts.Cursor)
+	}
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, s.pool, s.l, 5000)
 
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+
+	defer rollback()
+
+	lostStepRuns, err := s.queries.ReassignLostStepRuns(ctx, tx, params)
+
+	if err != nil {
+		return nil, err
 	}
 
+	results := make([]*dbsqlc.BulkReassignStepRunsRow, 0, len(lostStepRuns))
+
+	for _, sr := range lostStepRuns {
+		results = append(results, (*dbsqlc.BulkReassignStepRunsRow)(sr))
+	}
+
+	return s.finishReassignStepRuns(ctx, tx, commit, tenantId, results, limit, "Step run has missed its heartbeats")
+}
+
+func (s *stepRunEngineRepository) ReleaseDanglingSemaphoreSlots(ctx context.Context, tenantId string) (int64, error) {
+	return s.queries.ReleaseDanglingSemaphoreQueueItems(ctx, s.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (s *stepRunEngineRepository) DequeueOrphanedQueueItems(ctx context.Context, tenantId string) (int64, error) {
+	return s.queries.DequeueOrphanedQueueItems(ctx, s.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+// finishReassignStepRuns looks up the step runs which were failed by a reassign statement, commits the transaction and
+// writes the events of the reassigned step runs.
+func (s *stepRunEngineRepository) finishReassignStepRuns(
+	ctx context.Context,
+	tx pgx.Tx,
+	commit func(context.Context) error,
+	tenantId string,
+	results []*dbsqlc.BulkReassignStepRunsRow,
+	limit int,
+	message string,
+) (*repository.BulkReassignStepRunsResult, error) {
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
 	stepRunIds := make([]pgtype.UUID, 0, len(results))
 	stepRunIdsStr := make([]string, 0, len(results))
 	workerIds := make([]pgtype.UUID, 0, len(results))
 
 	failedStepRunIds := make([]pgtype.UUID, 0, len(results))
 
+	var lastStepRunId pgtype.UUID
+
 	for _, sr := range results {
 		if sr.Operation == "REASSIGNED" {
 			stepRunIds = append(stepRunIds, sr.ID)
@@ -672,6 +864,11 @@ func (s *stepRunEngineRepository) ListStepRunsToReassign(ctx context.Context, te
 		} else if sr.Operation == "FAILED" {
 			failedStepRunIds = append(failedStepRunIds, sr.ID)
 		}
+
+		// results are not ordered across operations, so track the largest step run id for the cursor
+		if !lastStepRunId.Valid || sqlchelpers.UUIDToStr(sr.ID) > sqlchelpers.UUIDToStr(lastStepRunId) {
+			lastStepRunId = sr.ID
+		}
 	}
 
 	failedStepRunResults, err := s.queries.GetStepRunForEngine(ctx, tx, dbsqlc.GetStepRunForEngineParams{
@@ -680,18 +877,17 @@ func (s *stepRunEngineRepository) ListStepRunsToReassign(ctx context.Context, te
 	})
 
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	err = commit(ctx)
 
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	for i, stepRunIdUUID := range stepRunIds {
 		workerId := sqlchelpers.UUIDToStr(workerIds[i])
-		message := "Worker has become inactive"
 		reason := dbsqlc.StepRunEventReasonREASSIGNED
 		severity := dbsqlc.StepRunEventSeverityCRITICAL
 		timeSeen := time.Now().UTC()
@@ -710,7 +906,16 @@ func (s *stepRunEngineRepository) ListStepRunsToReassign(ctx context.Context, te
 		}
 	}
 
-	return stepRunIdsStr, failedStepRunResults, nil
+	res := &repository.BulkReassignStepRunsResult{
+		ReassignedStepRunIds: stepRunIdsStr,
+		FailedStepRuns:       failedStepRunResults,
+	}
+
+	if len(results) == limit {
+		res.NextCursor = repository.StringPtr(sqlchelpers.UUIDToStr(lastStepRunId))
+	}
+
+	return res, nil
 }
 
 func (s *stepRunEngineRepository) ListStepRunsToTimeout(ctx context.Context, tenantId string) (bool, []*dbsqlc.GetStepRunForEngineRow, error) {
@@ -745,7 +950,10 @@ func (s *stepRunEngineRepository) ListStepRunsToTimeout(ctx context.Context, ten
 
 	// mark the step runs as cancelling
 	defer func() {
-		_, err = s.queries.BulkMarkStepRunsAsCancelling(ctx, s.pool, stepRunIds)
+		_, err = s.queries.BulkMarkStepRunsAsCancelling(ctx, s.pool, dbsqlc.BulkMarkStepRunsAsCancellingParams{
+			Steprunids:   stepRunIds,
+			Fromstatuses: statemachine.StepRunSources(dbsqlc.StepRunStatusCANCELLING),
+		})
 
 		if err != nil {
 			s.l.Err(err).Msg("could not bulk mark step runs as cancelling")
@@ -770,6 +978,263 @@ func (s *stepRunEngineRepository) ListStepRunsToTimeout(ctx context.Context, ten
 	return len(stepRunIds) == limit, stepRuns, nil
 }
 
+func (s *stepRunEngineRepository) ListStepRunsToHeartbeatTimeout(ctx context.Context, tenantId string) (bool, []*dbsqlc.GetStepRunForEngineRow, error) {
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	limit := 100
+
+	if s.cf.SingleQueueLimit != 0 {
+		limit = s.cf.SingleQueueLimit
+	}
+
+	stepRunIds, err := s.queries.PopStepRunsToHeartbeatTimeout(ctx, s.pool, dbsqlc.PopStepRunsToHeartbeatTimeoutParams{
+		Tenantid: pgTenantId,
+		Limit: pgtype.Int4{
+			Int32: int32(limit), // nolint: gosec
+			Valid: true,
+		},
+	})
+
+	if err != nil {
+		return false, nil, fmt.Errorf("could not pop step runs to heartbeat timeout: %w", err)
+	}
+
+	if len(stepRunIds) == 0 {
+		return false, nil, nil
+	}
+
+	stepRuns, err := s.queries.GetStepRunForEngine(ctx, s.pool, dbsqlc.GetStepRunForEngineParams{
+		Ids:      stepRunIds,
+		TenantId: pgTenantId,
+	})
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return len(stepRunIds) == limit, stepRuns, nil
+}
+
+func (s *stepRunEngineRepository) ListStepRunsToSpeculate(ctx context.Context, tenantId string) (bool, []*dbsqlc.ListStepRunsToSpeculateRow, error) {
+	limit := 100
+
+	if s.cf.SingleQueueLimit != 0 {
+		limit = s.cf.SingleQueueLimit
+	}
+
+	stepRuns, err := s.queries.ListStepRunsToSpeculate(ctx, s.pool, dbsqlc.ListStepRunsToSpeculateParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Limit:    int32(limit), // nolint: gosec
+		// the minimum number of successful step runs before the percentile is used
+		Minsamples: 10,
+	})
+
+	if err != nil {
+		return false, nil, fmt.Errorf("could not list step runs to speculate: %w", err)
+	}
+
+	return len(stepRuns) == limit, stepRuns, nil
+}
+
+func (s *stepRunEngineRepository) CreateSpeculativeAttempt(ctx context.Context, tenantId string, stepRun *dbsqlc.ListStepRunsToSpeculateRow) (*repository.SpeculativeAttempt, error) {
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, s.pool, s.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	workers, err := s.queries.ListSpeculativeWorkerCandidates(ctx, tx, dbsqlc.ListSpeculativeWorkerCandidatesParams{
+		Tenantid:        pgTenantId,
+		Actionid:        stepRun.ActionId,
+		Excludeworkerid: stepRun.WorkerId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list speculative worker candidates: %w", err)
+	}
+
+	if len(workers) == 0 {
+		return nil, repository.ErrNoSpeculativeWorker
+	}
+
+	worker := workers[0]
+
+	created, err := s.queries.CreateSpeculativeAttempt(ctx, tx, dbsqlc.CreateSpeculativeAttemptParams{
+		Steprunid:       stepRun.StepRunId,
+		Retrycount:      stepRun.RetryCount,
+		Tenantid:        pgTenantId,
+		Primaryworkerid: stepRun.WorkerId,
+		Workerid:        worker.ID,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not create speculative attempt: %w", err)
+	}
+
+	// another controller has already created the speculative attempt
+	if created == 0 {
+		return nil, repository.ErrNoSpeculativeWorker
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return &repository.SpeculativeAttempt{
+		WorkerId:     sqlchelpers.UUIDToStr(worker.ID),
+		DispatcherId: sqlchelpers.UUIDToStr(worker.DispatcherId),
+	}, nil
+}
+
+func (s *stepRunEngineRepository) ResolveSpeculativeAttempt(ctx context.Context, tenantId, stepRunId string, retryCount int32, workerId string, succeeded bool) (*repository.ResolveSpeculativeAttemptResult, error) {
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+	pgStepRunId := sqlchelpers.UUIDFromStr(stepRunId)
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, s.pool, s.l, 5000)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rollback()
+
+	attempt, err := s.queries.GetSpeculativeAttemptForUpdate(ctx, tx, dbsqlc.GetSpeculativeAttemptForUpdateParams{
+		Tenantid:   pgTenantId,
+		Steprunid:  pgStepRunId,
+		Retrycount: retryCount,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &repository.ResolveSpeculativeAttemptResult{
+				Resolution: repository.SpeculativeAttemptNone,
+			}, nil
+		}
+
+		return nil, fmt.Errorf("could not get speculative attempt: %w", err)
+	}
+
+	// another attempt has already succeeded
+	if attempt.WinnerWorkerId.Valid {
+		return &repository.ResolveSpeculativeAttemptResult{
+			Resolution: repository.SpeculativeAttemptLost,
+		}, nil
+	}
+
+	params := dbsqlc.UpdateSpeculativeAttemptParams{
+		Failedattempts: attempt.FailedAttempts,
+		Tenantid:       pgTenantId,
+		Steprunid:      pgStepRunId,
+		Retrycount:     retryCount,
+	}
+
+	res := &repository.ResolveSpeculativeAttemptResult{}
+
+	if succeeded {
+		params.WinnerWorkerId = sqlchelpers.UUIDFromStr(workerId)
+		res.Resolution = repository.SpeculativeAttemptWon
+
+		if workerId == sqlchelpers.UUIDToStr(attempt.WorkerId) {
+			res.LoserWorkerId = sqlchelpers.UUIDToStr(attempt.PrimaryWorkerId)
+		} else {
+			res.LoserWorkerId = sqlchelpers.UUIDToStr(attempt.WorkerId)
+		}
+	} else {
+		params.Failedattempts++
+
+		// the step run only fails once both attempts have failed
+		if params.Failedattempts < 2 {
+			res.Resolution = repository.SpeculativeAttemptPending
+		} else {
+			res.Resolution = repository.SpeculativeAttemptNone
+		}
+	}
+
+	err = s.queries.UpdateSpeculativeAttempt(ctx, tx, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not update speculative attempt: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *stepRunEngineRepository) AckStepRunAttempt(ctx context.Context, tenantId, stepRunId, attemptId, eventType string) (bool, error) {
+	// the ack is committed on its own, so concurrent acks of the same attempt don't wait on a transaction which
+	// processes the event
+	inserted, err := s.queries.AckStepRunAttempt(ctx, s.pool, dbsqlc.AckStepRunAttemptParams{
+		Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
+		Attemptid: attemptId,
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Eventtype: eventType,
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not ack step run attempt: %w", err)
+	}
+
+	return inserted > 0, nil
+}
+
+func (s *stepRunEngineRepository) UnackStepRunAttempt(ctx context.Context, tenantId, stepRunId, attemptId string) error {
+	err := s.queries.UnackStepRunAttempt(ctx, s.pool, dbsqlc.UnackStepRunAttemptParams{
+		Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
+		Attemptid: attemptId,
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not unack step run attempt: %w", err)
+	}
+
+	return nil
+}
+
+func (s *stepRunEngineRepository) DeleteExpiredStepRunAcks(ctx context.Context, tenantId string, before time.Time) error {
+	_, err := s.queries.DeleteExpiredStepRunAcks(ctx, s.pool, dbsqlc.DeleteExpiredStepRunAcksParams{
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Createdbefore: sqlchelpers.TimestampFromTime(before),
+	})
+
+	return err
+}
+
+func (s *stepRunEngineRepository) UpdateStepRunHeartbeats(ctx context.Context, tenantId, workerId string, stepRunIds []string, heartbeatAt time.Time) error {
+	if len(stepRunIds) == 0 {
+		return nil
+	}
+
+	pgStepRunIds := make([]pgtype.UUID, 0, len(stepRunIds))
+
+	for _, id := range stepRunIds {
+		pgStepRunIds = append(pgStepRunIds, sqlchelpers.UUIDFromStr(id))
+	}
+
+	return s.queries.UpsertStepRunHeartbeats(ctx, s.pool, dbsqlc.UpsertStepRunHeartbeatsParams{
+		Heartbeatat: sqlchelpers.TimestampFromTime(heartbeatAt),
+		Steprunids:  pgStepRunIds,
+		Tenantid:    sqlchelpers.UUIDFromStr(tenantId),
+		Workerid:    sqlchelpers.UUIDFromStr(workerId),
+	})
+}
+
+func (s *stepRunEngineRepository) DeleteExpiredStepRunHeartbeats(ctx context.Context, tenantId string, before time.Time) error {
+	_, err := s.queries.DeleteExpiredStepRunHeartbeats(ctx, s.pool, dbsqlc.DeleteExpiredStepRunHeartbeatsParams{
+		Tenantid:        sqlchelpers.UUIDFromStr(tenantId),
+		Heartbeatbefore: sqlchelpers.TimestampFromTime(before),
+	})
+
+	return err
+}
+
 func (s *stepRunEngineRepository) ReleaseStepRunSemaphore(ctx context.Context, tenantId, stepRunId string, isUserTriggered bool) error {
 	err := s.releaseWorkerSemaphoreSlot(ctx, tenantId, stepRunId)
 
@@ -1387,7 +1852,10 @@ func (s *stepRunEngineRepository) QueueStepRuns(ctx context.Context, qlp *zerolo
 
 	// if there are step runs to place in a cancelling state, do so
 	if len(plan.TimedOutStepRuns) > 0 {
-		_, err = s.queries.BulkMarkStepRunsAsCancelling(ctx, tx, plan.TimedOutStepRuns)
+		_, err = s.queries.BulkMarkStepRunsAsCancelling(ctx, tx, dbsqlc.BulkMarkStepRunsAsCancellingParams{
+			Steprunids:   plan.TimedOutStepRuns,
+			Fromstatuses: statemachine.StepRunSources(dbsqlc.StepRunStatusCANCELLING),
+		})
 
 		if err != nil {
 			return emptyRes, fmt.Errorf("could not bulk mark step runs as cancelling: %w", err)
@@ -1707,6 +2175,32 @@ func (s *stepRunEngineRepository) GetQueueCounts(ctx context.Context, tenantId s
 	return res, nil
 }
 
+func (s *stepRunEngineRepository) ListQueueCountsForAllTenants(ctx context.Context) (map[string]map[string]int, error) {
+	counts, err := s.queries.ListQueuedCountsForAllTenants(ctx, s.pool)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return map[string]map[string]int{}, nil
+		}
+
+		return nil, err
+	}
+
+	res := make(map[string]map[string]int)
+
+	for _, count := range counts {
+		tenantId := sqlchelpers.UUIDToStr(count.TenantId)
+
+		if _, ok := res[tenantId]; !ok {
+			res[tenantId] = make(map[string]int)
+		}
+
+		res[tenantId][count.Queue] = int(count.Count)
+	}
+
+	return res, nil
+}
+
 func (s *stepRunEngineRepository) ProcessStepRunUpdates(ctx context.Context, qlp *zerolog.Logger, tenantId string) (repository.ProcessStepRunUpdatesResult, error) {
 	ql := qlp.With().Str("tenant_id", tenantId).Logger()
 	// startedAt := time.Now().UTC()
@@ -1986,6 +2480,9 @@ func (s *stepRunEngineRepository) processStepRunUpdates(
 			if item.Error != nil && *item.Error == "TIMED_OUT" {
 				eventReason = dbsqlc.StepRunEventReasonTIMEDOUT
 				eventMessage = "Step exceeded timeout duration"
+			} else if item.Error != nil && *item.Error == "HEARTBEAT_TIMED_OUT" {
+				eventReason = dbsqlc.StepRunEventReasonTIMEDOUT
+				eventMessage = "Step exceeded heartbeat timeout duration"
 			}
 
 			eventReasons = append(eventReasons, eventReason)
@@ -2019,35 +2516,54 @@ func (s *stepRunEngineRepository) processStepRunUpdates(
 	}
 
 	if len(startParams.Steprunids) > 0 {
-		err = s.queries.BulkStartStepRun(ctx, tx, startParams)
+		startParams.Fromstatuses = statemachine.StepRunSources(dbsqlc.StepRunStatusRUNNING)
+
+		started, err := s.queries.BulkStartStepRun(ctx, tx, startParams)
 
 		if err != nil {
 			return nil, nil, fmt.Errorf("could not start step runs: %w", err)
 		}
+
+		recordRejectedStepRunTransitions(ctx, s.queries, tx, s.l, dbsqlc.StepRunStatusRUNNING, startParams.Steprunids, started)
 	}
 
 	if len(failParams.Steprunids) > 0 {
-		err = s.queries.BulkFailStepRun(ctx, tx, failParams)
+		failParams.Fromstatuses = statemachine.StepRunSources(dbsqlc.StepRunStatusFAILED)
+
+		failed, err := s.queries.BulkFailStepRun(ctx, tx, failParams)
 
 		if err != nil {
 			return nil, nil, fmt.Errorf("could not fail step runs: %w", err)
 		}
+
+		recordRejectedStepRunTransitions(ctx, s.queries, tx, s.l, dbsqlc.StepRunStatusFAILED, failParams.Steprunids, failed)
 	}
 
 	if len(cancelParams.Steprunids) > 0 {
-		err = s.queries.BulkCancelStepRun(ctx, tx, cancelParams)
+		cancelParams.Fromstatuses = statemachine.StepRunSources(dbsqlc.StepRunStatusCANCELLED)
+
+		cancelled, err := s.queries.BulkCancelStepRun(ctx, tx, cancelParams)
 
 		if err != nil {
 			return nil, nil, fmt.Errorf("could not cancel step runs: %w", err)
 		}
+
+		recordRejectedStepRunTransitions(ctx, s.queries, tx, s.l, dbsqlc.StepRunStatusCANCELLED, cancelParams.Steprunids, cancelled)
 	}
 
+	// only the step runs which were finished start their child step runs
+	finishedStepRunIds := []pgtype.UUID{}
+
 	if len(finishParams.Steprunids) > 0 {
-		err = s.queries.BulkFinishStepRun(ctx, tx, finishParams)
+		finishParams.Fromstatuses = statemachine.StepRunSources(dbsqlc.StepRunStatusSUCCEEDED)
+
+		finishedStepRunIds, err = s.queries.BulkFinishStepRun(ctx, tx, finishParams)
 
 		if err != nil {
 			return nil, nil, fmt.Errorf("could not finish step runs: %w", err)
 		}
+
+		recordRejectedStepRunTransitions(ctx, s.queries, tx, s.l, dbsqlc.StepRunStatusSUCCEEDED, finishParams.Steprunids, finishedStepRunIds)
 	}
 
 	// durationUpdateStepRuns := time.Since(startedAt)
@@ -2055,9 +2571,7 @@ func (s *stepRunEngineRepository) processStepRunUpdates(
 	// startResolveJobRunStatus := time.Now()
 
 	// update the job runs and workflow runs as well
-	jobRunIds, err := s.queries.ResolveJobRunStatus(ctx, tx,
-		stepRunIds,
-	)
+	jobRunIds, err := s.queries.ResolveJobRunStatus(ctx, tx, resolveJobRunStatusParams(stepRunIds))
 
 	if err != nil {
 		return nil, nil, fmt.Errorf("could not resolve job run status: %w", err)
@@ -2068,7 +2582,7 @@ func (s *stepRunEngineRepository) processStepRunUpdates(
 	// startResolveWorkflowRuns := time.Now()
 
 	succeededStepRuns, err = s.queries.GetStepRunForEngine(ctx, tx, dbsqlc.GetStepRunForEngineParams{
-		Ids:      finishParams.Steprunids,
+		Ids:      finishedStepRunIds,
 		TenantId: pgTenantId,
 	})
 
@@ -2076,10 +2590,7 @@ func (s *stepRunEngineRepository) processStepRunUpdates(
 		return nil, nil, fmt.Errorf("could not get succeeded step runs: %w", err)
 	}
 
-	completedWorkflowRuns, err = s.queries.ResolveWorkflowRunStatus(ctx, tx, dbsqlc.ResolveWorkflowRunStatusParams{
-		Jobrunids: jobRunIds,
-		Tenantid:  pgTenantId,
-	})
+	completedWorkflowRuns, err = s.queries.ResolveWorkflowRunStatus(ctx, tx, resolveWorkflowRunStatusParams(pgTenantId, jobRunIds))
 
 	if err != nil {
 		return nil, nil, fmt.Errorf("could not resolve workflow run status: %w", err)
@@ -2166,7 +2677,7 @@ func (s *stepRunEngineRepository) processStepRunUpdatesV2(
 				}
 			}
 
-			innerCompletedWorkflowRuns, err := s.bulkProcessStepRunUpdates(ctx, startParams, failParams, cancelParams, finishParams, batchStepRunIds, pgTenantId)
+			finishedStepRunIds, innerCompletedWorkflowRuns, err := s.bulkProcessStepRunUpdates(ctx, startParams, failParams, cancelParams, finishParams, batchStepRunIds, pgTenantId)
 
 			if err != nil && strings.Contains(err.Error(), "SQLSTATE 22P02") {
 				// attempt to validate json for outputs
@@ -2193,7 +2704,7 @@ func (s *stepRunEngineRepository) processStepRunUpdatesV2(
 					}
 				}
 
-				innerCompletedWorkflowRuns, err = s.bulkProcessStepRunUpdates(ctx, startParams, failParams, cancelParams, finishParams, batchStepRunIds, pgTenantId)
+				finishedStepRunIds, innerCompletedWorkflowRuns, err = s.bulkProcessStepRunUpdates(ctx, startParams, failParams, cancelParams, finishParams, batchStepRunIds, pgTenantId)
 
 				if err != nil {
 					return fmt.Errorf("could not process step run updates: %w", err)
@@ -2204,7 +2715,7 @@ func (s *stepRunEngineRepository) processStepRunUpdatesV2(
 
 			wrMu.Lock()
 			completedWorkflowRuns = append(completedWorkflowRuns, innerCompletedWorkflowRuns...)
-			completedStepRunIds = append(completedStepRunIds, finishParams.Steprunids...)
+			completedStepRunIds = append(completedStepRunIds, finishedStepRunIds...)
 			wrMu.Unlock()
 
 			return nil
@@ -2238,72 +2749,84 @@ func (s *stepRunEngineRepository) bulkProcessStepRunUpdates(ctx context.Context,
 	finishParams dbsqlc.BulkFinishStepRunParams,
 	batchStepRunIds []pgtype.UUID,
 	pgTenantId pgtype.UUID,
-) (completedWorkflowRuns []*dbsqlc.ResolveWorkflowRunStatusRow, err error) {
+) (finishedStepRunIds []pgtype.UUID, completedWorkflowRuns []*dbsqlc.ResolveWorkflowRunStatusRow, err error) {
 
 	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, s.pool, s.l, 25000)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer rollback()
 
 	if len(finishParams.Steprunids) > 0 {
-		err = s.queries.BulkFinishStepRun(ctx, tx, finishParams)
+		finishParams.Fromstatuses = statemachine.StepRunSources(dbsqlc.StepRunStatusSUCCEEDED)
+
+		finishedStepRunIds, err = s.queries.BulkFinishStepRun(ctx, tx, finishParams)
 
 		if err != nil {
-			return nil, fmt.Errorf("could not finish step runs: %w", err)
+			return nil, nil, fmt.Errorf("could not finish step runs: %w", err)
 		}
+
+		recordRejectedStepRunTransitions(ctx, s.queries, tx, s.l, dbsqlc.StepRunStatusSUCCEEDED, finishParams.Steprunids, finishedStepRunIds)
 	}
 
 	if len(startParams.Steprunids) > 0 {
-		err = s.queries.BulkStartStepRun(ctx, tx, startParams)
+		startParams.Fromstatuses = statemachine.StepRunSources(dbsqlc.StepRunStatusRUNNING)
+
+		started, err := s.queries.BulkStartStepRun(ctx, tx, startParams)
 
 		if err != nil {
-			return nil, fmt.Errorf("could not start step runs: %w", err)
+			return nil, nil, fmt.Errorf("could not start step runs: %w", err)
 		}
+
+		recordRejectedStepRunTransitions(ctx, s.queries, tx, s.l, dbsqlc.StepRunStatusRUNNING, startParams.Steprunids, started)
 	}
 
 	if len(failParams.Steprunids) > 0 {
-		err = s.queries.BulkFailStepRun(ctx, tx, failParams)
+		failParams.Fromstatuses = statemachine.StepRunSources(dbsqlc.StepRunStatusFAILED)
+
+		failed, err := s.queries.BulkFailStepRun(ctx, tx, failParams)
 
 		if err != nil {
-			return nil, fmt.Errorf("could not fail step runs: %w", err)
+			return nil, nil, fmt.Errorf("could not fail step runs: %w", err)
 		}
+
+		recordRejectedStepRunTransitions(ctx, s.queries, tx, s.l, dbsqlc.StepRunStatusFAILED, failParams.Steprunids, failed)
 	}
 
 	if len(cancelParams.Steprunids) > 0 {
+		cancelParams.Fromstatuses = statemachine.StepRunSources(dbsqlc.StepRunStatusCANCELLED)
 
-		err = s.queries.BulkCancelStepRun(ctx, tx, cancelParams)
+		cancelled, err := s.queries.BulkCancelStepRun(ctx, tx, cancelParams)
 
 		if err != nil {
-			return nil, fmt.Errorf("could not cancel step runs: %w", err)
+			return nil, nil, fmt.Errorf("could not cancel step runs: %w", err)
 		}
+
+		recordRejectedStepRunTransitions(ctx, s.queries, tx, s.l, dbsqlc.StepRunStatusCANCELLED, cancelParams.Steprunids, cancelled)
 	}
 
 	// update the job runs and workflow runs as well
-	jobRunIds, err := s.queries.ResolveJobRunStatus(ctx, tx, batchStepRunIds)
+	jobRunIds, err := s.queries.ResolveJobRunStatus(ctx, tx, resolveJobRunStatusParams(batchStepRunIds))
 
 	if err != nil {
-		return nil, fmt.Errorf("could not resolve job run status: %w", err)
+		return nil, nil, fmt.Errorf("could not resolve job run status: %w", err)
 	}
 
-	innerCompletedWorkflowRuns, err := s.queries.ResolveWorkflowRunStatus(ctx, tx, dbsqlc.ResolveWorkflowRunStatusParams{
-		Jobrunids: jobRunIds,
-		Tenantid:  pgTenantId,
-	})
+	innerCompletedWorkflowRuns, err := s.queries.ResolveWorkflowRunStatus(ctx, tx, resolveWorkflowRunStatusParams(pgTenantId, jobRunIds))
 
 	if err != nil {
-		return nil, fmt.Errorf("could not resolve workflow run status: %w", err)
+		return nil, nil, fmt.Errorf("could not resolve workflow run status: %w", err)
 	}
 
 	err = commit(ctx)
 
 	if err != nil {
-		return nil, fmt.Errorf("could not commit transaction: %w", err)
+		return nil, nil, fmt.Errorf("could not commit transaction: %w", err)
 	}
 
-	return innerCompletedWorkflowRuns, nil
+	return finishedStepRunIds, innerCompletedWorkflowRuns, nil
 }
 
 func (s *stepRunEngineRepository) ValidateOutputs(ctx context.Context, output []byte) error {
@@ -2517,6 +3040,23 @@ func (s *stepRunEngineRepository) StepRunStarted(ctx context.Context, tenantId,
 	return nil
 }
 
+func (s *stepRunEngineRepository) StepRunSleeping(ctx context.Context, tenantId, workflowRunId, stepRunId string, startedAt, wakeAt time.Time) error {
+	ctx, span := telemetry.NewSpan(ctx, "step-run-sleeping-db")
+	defer span.End()
+
+	err := s.queries.CreateStepRunTimer(ctx, s.pool, dbsqlc.CreateStepRunTimerParams{
+		Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Wakeat:    sqlchelpers.TimestampFromTime(wakeAt.UTC()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not create step run timer: %w", err)
+	}
+
+	return s.StepRunStarted(ctx, tenantId, workflowRunId, stepRunId, startedAt)
+}
+
 func (s *stepRunEngineRepository) StepRunAcked(ctx context.Context, tenantId, workflowRunId, stepRunId string, startedAt time.Time) error {
 	_, span := telemetry.NewSpan(ctx, "step-run-acked-db")
 	defer span.End()
@@ -2585,11 +3125,7 @@ func (s *stepRunEngineRepository) StepRunSucceeded(ctx context.Context, tenantId
 	defer sqlchelpers.DeferRollback(ctx, s.l, tx.Rollback)
 
 	// update the job run lookup data
-	err = s.queries.UpdateJobRunLookupDataWithStepRun(ctx, tx, dbsqlc.UpdateJobRunLookupDataWithStepRunParams{
-		Steprunid: sqlchelpers.UUIDFromStr(stepRunId),
-		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
-		Jsondata:  output,
-	})
+	err = updateJobRunLookupDataWithStepRun(ctx, s.queries, tx, sqlchelpers.UUIDFromStr(tenantId), sqlchelpers.UUIDFromStr(stepRunId), output)
 
 	if err != nil && strings.Contains(err.Error(), "SQLSTATE 22P02") {
 		s.l.Err(err).Msg("update job run lookup data with step run failed due to invalid json")
@@ -2623,6 +3159,17 @@ func (s *stepRunEngineRepository) StepRunCancelled(ctx context.Context, tenantId
 		return fmt.Errorf("could not release worker semaphore queue items: %w", err)
 	}
 
+	// remove the queue item eagerly, so the step run does not wait in the queue until the status update
+	// is flushed
+	err = s.queries.DequeueStepRunQueueItems(ctx, s.pool, dbsqlc.DequeueStepRunQueueItemsParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Steprunids: []pgtype.UUID{sqlchelpers.UUIDFromStr(stepRunId)},
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not dequeue step run queue items: %w", err)
+	}
+
 	cancelled := string(dbsqlc.StepRunStatusCANCELLED)
 
 	data := &updateStepRunQueueData{
@@ -2674,6 +3221,74 @@ func (s *stepRunEngineRepository) StepRunCancelled(ctx context.Context, tenantId
 	return nil
 }
 
+func (s *stepRunEngineRepository) StepRunSkipped(ctx context.Context, tenantId, stepRunId string, skippedAt time.Time) (bool, error) {
+	ctx, span := telemetry.NewSpan(ctx, "step-run-skipped-db")
+	defer span.End()
+
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+	pgStepRunId := sqlchelpers.UUIDFromStr(stepRunId)
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, s.pool, s.l, 5000)
+
+	if err != nil {
+		return false, err
+	}
+
+	defer rollback()
+
+	skipped, err := s.queries.SkipStepRun(ctx, tx, dbsqlc.SkipStepRunParams{
+		Skippedat: sqlchelpers.TimestampFromTime(skippedAt.UTC()),
+		Steprunid: pgStepRunId,
+		Tenantid:  pgTenantId,
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not skip step run: %w", err)
+	}
+
+	if skipped == 0 {
+		return false, nil
+	}
+
+	// skipping the last step run of a job can finish the job run and the workflow run
+	jobRunIds, err := s.queries.ResolveJobRunStatus(ctx, tx, resolveJobRunStatusParams([]pgtype.UUID{pgStepRunId}))
+
+	if err != nil {
+		return false, fmt.Errorf("could not resolve job run status: %w", err)
+	}
+
+	completedWorkflowRuns, err := s.queries.ResolveWorkflowRunStatus(ctx, tx, resolveWorkflowRunStatusParams(pgTenantId, jobRunIds))
+
+	if err != nil {
+		return false, fmt.Errorf("could not resolve workflow run status: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return false, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	metrics.StepRunTransitions.WithLabelValues(string(dbsqlc.StepRunStatusSKIPPED)).Inc()
+
+	for _, cb := range s.callbacks {
+		for _, wr := range completedWorkflowRuns {
+			wrCp := wr
+			cb.Do(s.l, tenantId, wrCp)
+		}
+	}
+
+	return true, nil
+}
+
+func (s *stepRunEngineRepository) HasOnlySkippedParents(ctx context.Context, stepRunId string) (bool, error) {
+	counts, err := s.queries.CountStepRunParents(ctx, s.pool, sqlchelpers.UUIDFromStr(stepRunId))
+
+	if err != nil {
+		return false, fmt.Errorf("could not count step run parents: %w", err)
+	}
+
+	return counts.Total > 0 && counts.Total == counts.Skipped, nil
+}
+
 func (s *stepRunEngineRepository) StepRunFailed(ctx context.Context, tenantId, workflowRunId, stepRunId string, failedAt time.Time, errStr string, retryCount int) error {
 	ctx, span := telemetry.NewSpan(ctx, "step-run-failed-db")
 	defer span.End()
@@ -2717,7 +3332,7 @@ func (s *stepRunEngineRepository) StepRunFailed(ctx context.Context, tenantId, w
 
 		reason := "PREVIOUS_STEP_FAILED"
 
-		if errStr == "TIMED_OUT" {
+		if errStr == "TIMED_OUT" || errStr == "HEARTBEAT_TIMED_OUT" {
 			reason = "PREVIOUS_STEP_TIMED_OUT"
 		}
 
@@ -2840,14 +3455,7 @@ func (s *stepRunEngineRepository) ReplayStepRun(ctx context.Context, tenantId, s
 		}
 
 		// remove the previous step run result from the job lookup data
-		err = s.queries.UpdateJobRunLookupDataWithStepRun(
-			ctx,
-			tx,
-			dbsqlc.UpdateJobRunLookupDataWithStepRunParams{
-				Steprunid: sqlchelpers.UUIDFromStr(laterStepRunId),
-				Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
-			},
-		)
+		err = updateJobRunLookupDataWithStepRun(ctx, s.queries, tx, sqlchelpers.UUIDFromStr(tenantId), sqlchelpers.UUIDFromStr(laterStepRunId), nil)
 
 		if err != nil {
 			return nil, err
@@ -3146,6 +3754,23 @@ func (s *stepRunEngineRepository) QueueStepRun(ctx context.Context, tenantId, st
 	return innerStepRun, nil
 }
 
+func (s *stepRunEngineRepository) QueueWorkerlessStepRun(ctx context.Context, tenantId, stepRunId string) error {
+	ctx, span := telemetry.NewSpan(ctx, "queue-workerless-step-run-database")
+	defer span.End()
+
+	_, err := s.queries.QueueWorkerlessStepRun(ctx, s.pool, dbsqlc.QueueWorkerlessStepRunParams{
+		Steprunid:    sqlchelpers.UUIDFromStr(stepRunId),
+		Tenantid:     sqlchelpers.UUIDFromStr(tenantId),
+		Fromstatuses: statemachine.StepRunSources(dbsqlc.StepRunStatusPENDINGASSIGNMENT),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not queue workerless step run: %w", err)
+	}
+
+	return nil
+}
+
 func (s *stepRunEngineRepository) createExpressionEvals(ctx context.Context, dbtx dbsqlc.DBTX, stepRunId string, opts []repository.CreateExpressionEvalOpt) error {
 	if len(opts) == 0 {
 		return nil
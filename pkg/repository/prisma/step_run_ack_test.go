@@ -0,0 +1,69 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+)
+
+func TestAckStepRunAttempt(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		stepRunId := uuid.New().String()
+		attemptId := uuid.New().String() + ".0"
+
+		stepRuns := conf.EngineRepository.StepRun()
+
+		// only one of the concurrent acks of the same attempt records the event
+		var acked atomic.Int32
+		var wg sync.WaitGroup
+
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				ok, err := stepRuns.AckStepRunAttempt(ctx, tenantId, stepRunId, attemptId, "COMPLETED")
+				assert.NoError(t, err)
+
+				if ok {
+					acked.Add(1)
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		assert.Equal(t, int32(1), acked.Load())
+
+		// a duplicate ack of the attempt isn't recorded, while another attempt of the step run is
+		ok, err := stepRuns.AckStepRunAttempt(ctx, tenantId, stepRunId, attemptId, "FAILED")
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		ok, err = stepRuns.AckStepRunAttempt(ctx, tenantId, stepRunId, uuid.New().String()+".0", "COMPLETED")
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		// once the ack is deleted, the event of the attempt is recorded again
+		require.NoError(t, stepRuns.UnackStepRunAttempt(ctx, tenantId, stepRunId, attemptId))
+
+		ok, err = stepRuns.AckStepRunAttempt(ctx, tenantId, stepRunId, attemptId, "COMPLETED")
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		return nil
+	})
+}
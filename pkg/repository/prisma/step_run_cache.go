@@ -0,0 +1,79 @@
+package prisma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type stepRunCacheRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewStepRunCacheRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.StepRunCacheRepository {
+	queries := dbsqlc.New()
+
+	return &stepRunCacheRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *stepRunCacheRepository) GetCachedOutput(ctx context.Context, tenantId, actionId, inputHash string) ([]byte, error) {
+	cached, err := r.queries.GetCachedStepRunOutput(ctx, r.pool, dbsqlc.GetCachedStepRunOutputParams{
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Actionid:  actionId,
+		Inputhash: inputHash,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("could not get cached step run output: %w", err)
+	}
+
+	return cached.Output, nil
+}
+
+func (r *stepRunCacheRepository) CacheOutput(ctx context.Context, tenantId string, opts *repository.CacheStepRunOutputOpts) error {
+	if err := r.v.Validate(opts); err != nil {
+		return err
+	}
+
+	err := r.queries.CacheStepRunOutput(ctx, r.pool, dbsqlc.CacheStepRunOutputParams{
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Actionid:  opts.ActionId,
+		Inputhash: opts.InputHash,
+		Output:    opts.Output,
+		Steprunid: sqlchelpers.UUIDFromStr(opts.StepRunId),
+		Expiresat: sqlchelpers.TimestampFromTime(time.Now().UTC().Add(opts.TTL)),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not cache step run output: %w", err)
+	}
+
+	return nil
+}
+
+func (r *stepRunCacheRepository) DeleteExpiredOutputs(ctx context.Context, tenantId string) error {
+	_, err := r.queries.DeleteExpiredStepRunResultCache(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+	return err
+}
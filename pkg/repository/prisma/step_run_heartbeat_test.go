@@ -0,0 +1,84 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestReassignLostStepRuns(t *testing.T) {
+	t.Setenv("SERVER_STEP_RUN_HEARTBEAT_TIMEOUT", "1m")
+
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		version := createTestWorkflow(t, conf, tenantId, "heartbeats")
+		workerId := uuid.New().String()
+
+		heartbeating := getTestStepRunId(t, conf, createTestWorkflowRun(t, conf, tenantId, version).ID)
+		lost := getTestStepRunId(t, conf, createTestWorkflowRun(t, conf, tenantId, version).ID)
+		workerless := getTestStepRunId(t, conf, createTestWorkflowRun(t, conf, tenantId, version).ID)
+
+		startedAt := sqlchelpers.TimestampFromTime(time.Now().UTC().Add(-10 * time.Minute))
+
+		// all step runs started before the heartbeat timeout, but only two of them run on a worker
+		for _, stepRunId := range []pgtype.UUID{heartbeating, lost} {
+			_, err := conf.Pool.Exec(
+				ctx,
+				`UPDATE "StepRun" SET "status" = 'RUNNING', "startedAt" = $2, "workerId" = $3 WHERE "id" = $1`,
+				stepRunId, startedAt, sqlchelpers.UUIDFromStr(workerId),
+			)
+			require.NoError(t, err)
+		}
+
+		_, err := conf.Pool.Exec(
+			ctx,
+			`UPDATE "StepRun" SET "status" = 'RUNNING', "startedAt" = $2, "workerId" = NULL WHERE "id" = $1`,
+			workerless, startedAt,
+		)
+		require.NoError(t, err)
+
+		err = conf.EngineRepository.StepRun().UpdateStepRunHeartbeats(
+			ctx,
+			tenantId,
+			workerId,
+			[]string{sqlchelpers.UUIDToStr(heartbeating)},
+			time.Now().UTC(),
+		)
+		require.NoError(t, err)
+
+		res, err := conf.EngineRepository.StepRun().ReassignLostStepRuns(ctx, tenantId, &repository.BulkReassignStepRunsOpts{})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{sqlchelpers.UUIDToStr(lost)}, res.ReassignedStepRunIds)
+		assert.Empty(t, res.FailedStepRuns)
+
+		for stepRunId, status := range map[pgtype.UUID]dbsqlc.StepRunStatus{
+			heartbeating: dbsqlc.StepRunStatusRUNNING,
+			lost:         dbsqlc.StepRunStatusPENDINGASSIGNMENT,
+			workerless:   dbsqlc.StepRunStatusRUNNING,
+		} {
+			var actual dbsqlc.StepRunStatus
+
+			err := conf.Pool.QueryRow(ctx, `SELECT "status" FROM "StepRun" WHERE "id" = $1`, stepRunId).Scan(&actual)
+			require.NoError(t, err)
+
+			assert.Equal(t, status, actual, "step run %s", sqlchelpers.UUIDToStr(stepRunId))
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,57 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type stepRunProgressRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewStepRunProgressRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.StepRunProgressRepository {
+	queries := dbsqlc.New()
+
+	return &stepRunProgressRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *stepRunProgressRepository) ReportProgress(ctx context.Context, tenantId string, opts *repository.ReportStepRunProgressOpts) (*dbsqlc.StepRunProgress, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.UpsertStepRunProgressParams{
+		Steprunid: sqlchelpers.UUIDFromStr(opts.StepRunId),
+		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
+		Percent:   opts.Percent,
+		Data:      opts.Data,
+	}
+
+	if opts.Message != nil {
+		params.Message = sqlchelpers.TextFromStr(*opts.Message)
+	}
+
+	progress, err := r.queries.UpsertStepRunProgress(ctx, r.pool, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not report step run progress: %w", err)
+	}
+
+	return progress, nil
+}
@@ -0,0 +1,154 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestBulkReassignStepRunsInBatches(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		version := createTestWorkflow(t, conf, tenantId, "reassign")
+
+		createWorker := func(lastHeartbeatAt time.Time) pgtype.UUID {
+			workerId := sqlchelpers.UUIDFromStr(uuid.New().String())
+
+			_, err := conf.Pool.Exec(
+				ctx,
+				`INSERT INTO "Worker" ("id", "tenantId", "name", "lastHeartbeatAt", "isActive") VALUES ($1, $2::uuid, 'worker', $3, true)`,
+				workerId, tenantId, sqlchelpers.TimestampFromTime(lastHeartbeatAt),
+			)
+
+			require.NoError(t, err)
+
+			return workerId
+		}
+
+		inactiveWorkerId := createWorker(time.Now().UTC().Add(-time.Minute))
+		activeWorkerId := createWorker(time.Now().UTC())
+
+		assign := func(workerId pgtype.UUID, internalRetryCount int) pgtype.UUID {
+			stepRunId := getTestStepRunId(t, conf, createTestWorkflowRun(t, conf, tenantId, version).ID)
+
+			_, err := conf.Pool.Exec(
+				ctx,
+				`UPDATE "StepRun" SET "status" = 'RUNNING', "workerId" = $2, "internalRetryCount" = $3 WHERE "id" = $1`,
+				stepRunId, workerId, internalRetryCount,
+			)
+
+			require.NoError(t, err)
+
+			_, err = conf.Pool.Exec(
+				ctx,
+				`INSERT INTO "SemaphoreQueueItem" ("stepRunId", "workerId", "tenantId") VALUES ($1, $2, $3::uuid)`,
+				stepRunId, workerId, tenantId,
+			)
+
+			require.NoError(t, err)
+
+			return stepRunId
+		}
+
+		reassignable := make(map[string]bool)
+
+		for i := 0; i < 4; i++ {
+			reassignable[sqlchelpers.UUIDToStr(assign(inactiveWorkerId, 0))] = true
+		}
+
+		// the step run is out of internal retries, so it's failed instead of reassigned
+		exhausted := assign(inactiveWorkerId, 3)
+
+		running := assign(activeWorkerId, 0)
+
+		limit := 2
+		var cursor *string
+
+		reassigned := make(map[string]int)
+		var failed []string
+		batches := 0
+
+		for {
+			res, err := conf.EngineRepository.StepRun().BulkReassignStepRuns(ctx, tenantId, &repository.BulkReassignStepRunsOpts{
+				Limit:  &limit,
+				Cursor: cursor,
+			})
+
+			require.NoError(t, err)
+
+			batches++
+			assert.LessOrEqual(t, len(res.ReassignedStepRunIds)+len(res.FailedStepRuns), limit, "a batch must not exceed the limit")
+
+			for _, id := range res.ReassignedStepRunIds {
+				reassigned[id]++
+			}
+
+			for _, sr := range res.FailedStepRuns {
+				failed = append(failed, sqlchelpers.UUIDToStr(sr.SRID))
+			}
+
+			if res.NextCursor == nil {
+				break
+			}
+
+			require.Less(t, batches, 10, "the cursor must advance")
+			cursor = res.NextCursor
+		}
+
+		assert.Equal(t, 3, batches, "5 step runs in batches of 2 take 3 batches")
+		assert.Equal(t, []string{sqlchelpers.UUIDToStr(exhausted)}, failed)
+		assert.Len(t, reassigned, len(reassignable))
+
+		for id, count := range reassigned {
+			assert.True(t, reassignable[id], "step run %s must not be reassigned", id)
+			assert.Equal(t, 1, count, "step run %s must be reassigned once", id)
+		}
+
+		for id := range reassignable {
+			var status dbsqlc.StepRunStatus
+			var queued bool
+
+			err := conf.Pool.QueryRow(
+				ctx,
+				`SELECT sr."status", EXISTS (SELECT 1 FROM "QueueItem" qi WHERE qi."stepRunId" = sr."id" AND qi."isQueued")
+				FROM "StepRun" sr WHERE sr."id" = $1::uuid`,
+				id,
+			).Scan(&status, &queued)
+
+			require.NoError(t, err)
+			assert.Equal(t, dbsqlc.StepRunStatusPENDINGASSIGNMENT, status, "step run %s", id)
+			assert.True(t, queued, "step run %s must be requeued", id)
+		}
+
+		// only the slot of the step run on the active worker is kept
+		var slots []pgtype.UUID
+
+		rows, err := conf.Pool.Query(ctx, `SELECT "stepRunId" FROM "SemaphoreQueueItem" WHERE "tenantId" = $1::uuid`, tenantId)
+		require.NoError(t, err)
+
+		for rows.Next() {
+			var id pgtype.UUID
+			require.NoError(t, rows.Scan(&id))
+			slots = append(slots, id)
+		}
+
+		require.NoError(t, rows.Err())
+		assert.Equal(t, []pgtype.UUID{running}, slots)
+
+		return nil
+	})
+}
@@ -0,0 +1,100 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestRecoveryReleasesDanglingState(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		version := createTestWorkflow(t, conf, tenantId, "recovery")
+		workerId := sqlchelpers.UUIDFromStr(uuid.New().String())
+
+		running := getTestStepRunId(t, conf, createTestWorkflowRun(t, conf, tenantId, version).ID)
+		succeeded := getTestStepRunId(t, conf, createTestWorkflowRun(t, conf, tenantId, version).ID)
+		cancelled := getTestStepRunId(t, conf, createTestWorkflowRun(t, conf, tenantId, version).ID)
+
+		// the step run of a run which was deleted while the engine was down
+		missing := sqlchelpers.UUIDFromStr(uuid.New().String())
+
+		for stepRunId, status := range map[pgtype.UUID]string{
+			running:   "RUNNING",
+			succeeded: "SUCCEEDED",
+			cancelled: "CANCELLED",
+		} {
+			_, err := conf.Pool.Exec(ctx, `UPDATE "StepRun" SET "status" = $2::"StepRunStatus" WHERE "id" = $1`, stepRunId, status)
+			require.NoError(t, err)
+		}
+
+		// start from a known state, in case creating the runs queued their step runs
+		_, err := conf.Pool.Exec(ctx, `DELETE FROM "SemaphoreQueueItem" WHERE "tenantId" = $1::uuid`, tenantId)
+		require.NoError(t, err)
+
+		_, err = conf.Pool.Exec(ctx, `DELETE FROM "QueueItem" WHERE "tenantId" = $1::uuid`, tenantId)
+		require.NoError(t, err)
+
+		for _, stepRunId := range []pgtype.UUID{running, succeeded, cancelled, missing} {
+			_, err := conf.Pool.Exec(
+				ctx,
+				`INSERT INTO "SemaphoreQueueItem" ("stepRunId", "workerId", "tenantId") VALUES ($1, $2, $3::uuid)`,
+				stepRunId, workerId, tenantId,
+			)
+			require.NoError(t, err)
+
+			_, err = conf.Pool.Exec(
+				ctx,
+				`INSERT INTO "QueueItem" ("stepRunId", "isQueued", "tenantId", "queue") VALUES ($1, true, $2::uuid, 'recovery')`,
+				stepRunId, tenantId,
+			)
+			require.NoError(t, err)
+		}
+
+		released, err := conf.EngineRepository.StepRun().ReleaseDanglingSemaphoreSlots(ctx, tenantId)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), released)
+
+		dequeued, err := conf.EngineRepository.StepRun().DequeueOrphanedQueueItems(ctx, tenantId)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), dequeued)
+
+		// only the slot and queue item of the running step run are kept
+		slots, err := conf.Pool.Query(ctx, `SELECT "stepRunId"::text FROM "SemaphoreQueueItem" WHERE "tenantId" = $1::uuid`, tenantId)
+		require.NoError(t, err)
+
+		slotStepRunIds, err := pgx.CollectRows(slots, pgx.RowTo[string])
+		require.NoError(t, err)
+		assert.Equal(t, []string{sqlchelpers.UUIDToStr(running)}, slotStepRunIds)
+
+		queued, err := conf.Pool.Query(ctx, `SELECT "stepRunId"::text FROM "QueueItem" WHERE "tenantId" = $1::uuid AND "isQueued"`, tenantId)
+		require.NoError(t, err)
+
+		queuedStepRunIds, err := pgx.CollectRows(queued, pgx.RowTo[string])
+		require.NoError(t, err)
+		assert.Equal(t, []string{sqlchelpers.UUIDToStr(running)}, queuedStepRunIds)
+
+		// a second pass has nothing left to repair
+		released, err = conf.EngineRepository.StepRun().ReleaseDanglingSemaphoreSlots(ctx, tenantId)
+		require.NoError(t, err)
+		assert.Zero(t, released)
+
+		dequeued, err = conf.EngineRepository.StepRun().DequeueOrphanedQueueItems(ctx, tenantId)
+		require.NoError(t, err)
+		assert.Zero(t, dequeued)
+
+		return nil
+	})
+}
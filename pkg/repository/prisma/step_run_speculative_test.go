@@ -0,0 +1,187 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestListStepRunsToSpeculate(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		workerId := sqlchelpers.UUIDFromStr(uuid.New().String())
+
+		percentile := int32(50)
+
+		version, err := conf.EngineRepository.Workflow().CreateNewWorkflow(ctx, tenantId, &repository.CreateWorkflowVersionOpts{
+			Name: "speculative",
+			Jobs: []repository.CreateWorkflowJobOpts{
+				{
+					Name: "job",
+					Kind: "DEFAULT",
+					Steps: []repository.CreateWorkflowStepOpts{
+						{
+							ReadableId:            "step",
+							Action:                "test:step",
+							SpeculativePercentile: &percentile,
+						},
+					},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+
+		newStepRun := func(status string, startedAt, finishedAt time.Time) pgtype.UUID {
+			stepRunId := getTestStepRunId(t, conf, createTestWorkflowRun(t, conf, tenantId, version).ID)
+
+			_, err := conf.Pool.Exec(
+				ctx,
+				`UPDATE "StepRun" SET "status" = $2::"StepRunStatus", "startedAt" = $3, "finishedAt" = $4, "workerId" = $5 WHERE "id" = $1`,
+				stepRunId,
+				status,
+				sqlchelpers.TimestampFromTime(startedAt),
+				pgtype.Timestamp{Time: finishedAt, Valid: status == "SUCCEEDED"},
+				workerId,
+			)
+
+			require.NoError(t, err)
+
+			return stepRunId
+		}
+
+		now := time.Now().UTC()
+
+		straggler := newStepRun("RUNNING", now.Add(-time.Minute), time.Time{})
+		newStepRun("RUNNING", now, time.Time{})
+
+		// step runs aren't speculated until there are enough successful step runs to compute the percentile
+		_, stepRuns, err := conf.EngineRepository.StepRun().ListStepRunsToSpeculate(ctx, tenantId)
+		require.NoError(t, err)
+		assert.Empty(t, stepRuns)
+
+		// the successful step runs took 1 to 10 seconds, so the median is 5.5 seconds
+		for i := 1; i <= 10; i++ {
+			startedAt := now.Add(-time.Hour)
+			newStepRun("SUCCEEDED", startedAt, startedAt.Add(time.Duration(i)*time.Second))
+		}
+
+		_, stepRuns, err = conf.EngineRepository.StepRun().ListStepRunsToSpeculate(ctx, tenantId)
+		require.NoError(t, err)
+		require.Len(t, stepRuns, 1, "only the step run which is running for longer than the percentile is speculated")
+
+		assert.Equal(t, sqlchelpers.UUIDToStr(straggler), sqlchelpers.UUIDToStr(stepRuns[0].StepRunId))
+		assert.Equal(t, sqlchelpers.UUIDToStr(workerId), sqlchelpers.UUIDToStr(stepRuns[0].WorkerId))
+		assert.Equal(t, "test:step", stepRuns[0].ActionId)
+		assert.InDelta(t, 5.5, stepRuns[0].Threshold, 0.01)
+
+		// a step run is only speculated once per retry
+		_, err = conf.Pool.Exec(
+			ctx,
+			`INSERT INTO "StepRunSpeculativeAttempt" ("stepRunId", "retryCount", "tenantId", "primaryWorkerId", "workerId") VALUES ($1, 0, $2::uuid, $3, $4)`,
+			straggler, tenantId, workerId, sqlchelpers.UUIDFromStr(uuid.New().String()),
+		)
+
+		require.NoError(t, err)
+
+		_, stepRuns, err = conf.EngineRepository.StepRun().ListStepRunsToSpeculate(ctx, tenantId)
+		require.NoError(t, err)
+		assert.Empty(t, stepRuns)
+
+		return nil
+	})
+}
+
+func TestResolveSpeculativeAttempt(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+
+		primary := uuid.New().String()
+		speculative := uuid.New().String()
+
+		type attemptResult struct {
+			workerId  string
+			succeeded bool
+
+			resolution    repository.SpeculativeAttemptResolution
+			loserWorkerId string
+		}
+
+		tests := []struct {
+			name    string
+			results []attemptResult
+		}{
+			{
+				name: "primary succeeds first",
+				results: []attemptResult{
+					{workerId: primary, succeeded: true, resolution: repository.SpeculativeAttemptWon, loserWorkerId: speculative},
+					{workerId: speculative, succeeded: true, resolution: repository.SpeculativeAttemptLost},
+				},
+			},
+			{
+				name: "speculative attempt succeeds first",
+				results: []attemptResult{
+					{workerId: speculative, succeeded: true, resolution: repository.SpeculativeAttemptWon, loserWorkerId: primary},
+					{workerId: primary, succeeded: false, resolution: repository.SpeculativeAttemptLost},
+				},
+			},
+			{
+				name: "failure while the other attempt is running",
+				results: []attemptResult{
+					{workerId: primary, succeeded: false, resolution: repository.SpeculativeAttemptPending},
+					{workerId: speculative, succeeded: true, resolution: repository.SpeculativeAttemptWon, loserWorkerId: primary},
+				},
+			},
+			{
+				name: "step run fails once both attempts failed",
+				results: []attemptResult{
+					{workerId: speculative, succeeded: false, resolution: repository.SpeculativeAttemptPending},
+					{workerId: primary, succeeded: false, resolution: repository.SpeculativeAttemptNone},
+				},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				stepRunId := uuid.New().String()
+
+				_, err := conf.Pool.Exec(
+					ctx,
+					`INSERT INTO "StepRunSpeculativeAttempt" ("stepRunId", "retryCount", "tenantId", "primaryWorkerId", "workerId") VALUES ($1::uuid, 1, $2::uuid, $3::uuid, $4::uuid)`,
+					stepRunId, tenantId, primary, speculative,
+				)
+
+				require.NoError(t, err)
+
+				for _, r := range tt.results {
+					res, err := conf.EngineRepository.StepRun().ResolveSpeculativeAttempt(ctx, tenantId, stepRunId, 1, r.workerId, r.succeeded)
+					require.NoError(t, err)
+
+					assert.Equal(t, r.resolution, res.Resolution)
+					assert.Equal(t, r.loserWorkerId, res.LoserWorkerId)
+				}
+
+				// the attempts of other retries aren't speculative
+				res, err := conf.EngineRepository.StepRun().ResolveSpeculativeAttempt(ctx, tenantId, stepRunId, 2, primary, true)
+				require.NoError(t, err)
+				assert.Equal(t, repository.SpeculativeAttemptNone, res.Resolution)
+			})
+		}
+
+		return nil
+	})
+}
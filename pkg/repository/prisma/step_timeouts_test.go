@@ -0,0 +1,184 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestStepTimeouts(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+
+		version, err := conf.EngineRepository.Workflow().CreateNewWorkflow(ctx, tenantId, &repository.CreateWorkflowVersionOpts{
+			Name:            "step-timeouts",
+			ScheduleTimeout: repository.StringPtr("2m"),
+			Jobs: []repository.CreateWorkflowJobOpts{
+				{
+					Name: "job",
+					Kind: "DEFAULT",
+					Steps: []repository.CreateWorkflowStepOpts{
+						{
+							ReadableId:       "with-timeouts",
+							Action:           "test:with-timeouts",
+							ScheduleTimeout:  repository.StringPtr("30s"),
+							HeartbeatTimeout: repository.StringPtr("10s"),
+						},
+						{
+							ReadableId: "without-timeouts",
+							Action:     "test:without-timeouts",
+						},
+					},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+
+		rows, err := conf.Pool.Query(
+			ctx,
+			`SELECT s."readableId", s."scheduleTimeout", s."heartbeatTimeout"
+			FROM "Step" s JOIN "Job" j ON j."id" = s."jobId"
+			WHERE j."workflowVersionId" = $1`,
+			version.WorkflowVersion.ID,
+		)
+
+		require.NoError(t, err)
+
+		type timeouts struct {
+			schedule  string
+			heartbeat pgtype.Text
+		}
+
+		steps := make(map[string]timeouts)
+
+		for rows.Next() {
+			var readableId string
+			var ts timeouts
+
+			require.NoError(t, rows.Scan(&readableId, &ts.schedule, &ts.heartbeat))
+
+			steps[readableId] = ts
+		}
+
+		require.NoError(t, rows.Err())
+
+		// the schedule timeout of a step overrides the schedule timeout of the workflow
+		assert.Equal(t, timeouts{schedule: "30s", heartbeat: sqlchelpers.TextFromStr("10s")}, steps["with-timeouts"])
+		assert.Equal(t, timeouts{schedule: "2m"}, steps["without-timeouts"])
+
+		return nil
+	})
+}
+
+func TestListStepRunsToHeartbeatTimeout(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+
+		createVersion := func(name string, heartbeatTimeout *string) *dbsqlc.GetWorkflowVersionForEngineRow {
+			version, err := conf.EngineRepository.Workflow().CreateNewWorkflow(ctx, tenantId, &repository.CreateWorkflowVersionOpts{
+				Name: name,
+				Jobs: []repository.CreateWorkflowJobOpts{
+					{
+						Name: "job",
+						Kind: "DEFAULT",
+						Steps: []repository.CreateWorkflowStepOpts{
+							{
+								ReadableId:       "step",
+								Action:           "test:step",
+								HeartbeatTimeout: heartbeatTimeout,
+							},
+						},
+					},
+				},
+			})
+
+			require.NoError(t, err)
+
+			return version
+		}
+
+		withHeartbeat := createVersion("with-heartbeat-timeout", repository.StringPtr("10s"))
+		withoutHeartbeat := createVersion("without-heartbeat-timeout", nil)
+
+		createWorker := func(lastHeartbeatAt time.Time) pgtype.UUID {
+			workerId := sqlchelpers.UUIDFromStr(uuid.New().String())
+
+			_, err := conf.Pool.Exec(
+				ctx,
+				`INSERT INTO "Worker" ("id", "tenantId", "name", "lastHeartbeatAt", "isActive") VALUES ($1, $2::uuid, 'worker', $3, true)`,
+				workerId, tenantId, sqlchelpers.TimestampFromTime(lastHeartbeatAt),
+			)
+
+			require.NoError(t, err)
+
+			return workerId
+		}
+
+		silentWorkerId := createWorker(time.Now().UTC().Add(-time.Minute))
+		heartbeatingWorkerId := createWorker(time.Now().UTC())
+
+		start := func(version *dbsqlc.GetWorkflowVersionForEngineRow, workerId pgtype.UUID) pgtype.UUID {
+			stepRunId := getTestStepRunId(t, conf, createTestWorkflowRun(t, conf, tenantId, version).ID)
+
+			_, err := conf.Pool.Exec(ctx, `UPDATE "StepRun" SET "status" = 'RUNNING', "workerId" = $2 WHERE "id" = $1`, stepRunId, workerId)
+			require.NoError(t, err)
+
+			_, err = conf.Pool.Exec(
+				ctx,
+				`INSERT INTO "SemaphoreQueueItem" ("stepRunId", "workerId", "tenantId") VALUES ($1, $2, $3::uuid)`,
+				stepRunId, workerId, tenantId,
+			)
+
+			require.NoError(t, err)
+
+			return stepRunId
+		}
+
+		timedOut := start(withHeartbeat, silentWorkerId)
+		heartbeating := start(withHeartbeat, heartbeatingWorkerId)
+		noHeartbeatTimeout := start(withoutHeartbeat, silentWorkerId)
+
+		_, stepRuns, err := conf.EngineRepository.StepRun().ListStepRunsToHeartbeatTimeout(ctx, tenantId)
+		require.NoError(t, err)
+
+		require.Len(t, stepRuns, 1)
+		assert.Equal(t, sqlchelpers.UUIDToStr(timedOut), sqlchelpers.UUIDToStr(stepRuns[0].SRID))
+		assert.Equal(t, "10s", stepRuns[0].StepHeartbeatTimeout.String)
+
+		for stepRunId, status := range map[pgtype.UUID]dbsqlc.StepRunStatus{
+			timedOut:           dbsqlc.StepRunStatusCANCELLING,
+			heartbeating:       dbsqlc.StepRunStatusRUNNING,
+			noHeartbeatTimeout: dbsqlc.StepRunStatusRUNNING,
+		} {
+			var actual dbsqlc.StepRunStatus
+
+			err := conf.Pool.QueryRow(ctx, `SELECT "status" FROM "StepRun" WHERE "id" = $1`, stepRunId).Scan(&actual)
+			require.NoError(t, err)
+
+			assert.Equal(t, status, actual, "step run %s", sqlchelpers.UUIDToStr(stepRunId))
+		}
+
+		// the step run which timed out is cancelling, so it isn't returned again
+		_, stepRuns, err = conf.EngineRepository.StepRun().ListStepRunsToHeartbeatTimeout(ctx, tenantId)
+		require.NoError(t, err)
+		assert.Empty(t, stepRuns)
+
+		return nil
+	})
+}
@@ -4,33 +4,65 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 
 	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/buffer"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 	"github.com/hatchet-dev/hatchet/pkg/validator"
 )
 
 type streamEventEngineRepository struct {
-	pool    *pgxpool.Pool
-	v       validator.Validator
-	queries *dbsqlc.Queries
-	l       *zerolog.Logger
+	pool             *pgxpool.Pool
+	v                validator.Validator
+	queries          *dbsqlc.Queries
+	l                *zerolog.Logger
+	bulkCreateBuffer *buffer.TenantBufferManager[*createStreamEventItem, *dbsqlc.StreamEvent]
 }
 
-func NewStreamEventsEngineRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.StreamEventsEngineRepository {
+// createStreamEventItem is a stream event waiting in the buffer of its tenant
+type createStreamEventItem struct {
+	tenantId string
+	opts     *repository.CreateStreamEventOpts
+}
+
+func NewStreamEventsEngineRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, bufferConf buffer.ConfigFileBuffer) (repository.StreamEventsEngineRepository, func() error, error) {
 	queries := dbsqlc.New()
 
-	return &streamEventEngineRepository{
+	r := &streamEventEngineRepository{
 		pool:    pool,
 		v:       v,
 		queries: queries,
 		l:       l,
 	}
+
+	var err error
+
+	r.bulkCreateBuffer, err = buffer.NewTenantBufManager(buffer.TenantBufManagerOpts[*createStreamEventItem, *dbsqlc.StreamEvent]{
+		Name:       "create_stream_events",
+		OutputFunc: r.bulkCreateStreamEvents,
+		SizeFunc:   sizeOfStreamEvent,
+		L:          l,
+		V:          v,
+		Config:     bufferConf,
+	})
+
+	return r, r.cleanup, err
+}
+
+func (r *streamEventEngineRepository) cleanup() error {
+	return r.bulkCreateBuffer.Cleanup()
+}
+
+func sizeOfStreamEvent(item *createStreamEventItem) int {
+	return len(item.opts.Message) + len(item.opts.Metadata)
 }
 
 func (r *streamEventEngineRepository) GetStreamEventMeta(ctx context.Context, tenantId string, stepRunId string) (*dbsqlc.GetStreamEventMetaRow, error) {
@@ -40,57 +72,115 @@ func (r *streamEventEngineRepository) GetStreamEventMeta(ctx context.Context, te
 	})
 }
 
+// PutStreamEvent buffers the stream event and waits until it's written with the other stream events of the tenant,
+// so the stream event is stored once it's returned.
 func (r *streamEventEngineRepository) PutStreamEvent(ctx context.Context, tenantId string, opts *repository.CreateStreamEventOpts) (*dbsqlc.StreamEvent, error) {
 	if err := r.v.Validate(opts); err != nil {
 		return nil, err
 	}
 
-	message := opts.Message
+	done, err := r.bulkCreateBuffer.BuffItem(tenantId, &createStreamEventItem{
+		tenantId: tenantId,
+		opts:     opts,
+	})
 
-	if message == nil {
-		message = []byte("")
+	if err != nil {
+		return nil, fmt.Errorf("could not buffer stream event: %w", err)
 	}
 
-	createParams := dbsqlc.CreateStreamEventParams{
-		Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
-		Message:   message,
-		Steprunid: sqlchelpers.UUIDFromStr(opts.StepRunId),
+	var response *buffer.FlushResponse[*dbsqlc.StreamEvent]
+
+	select {
+	case response = <-done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(20 * time.Second):
+		return nil, fmt.Errorf("timeout waiting for stream event to be flushed to db")
 	}
 
-	if opts.CreatedAt != nil {
-		utcTime := opts.CreatedAt.UTC()
-		createParams.CreatedAt = sqlchelpers.TimestampFromTime(utcTime)
+	if response.Err != nil {
+		return nil, fmt.Errorf("could not create stream event: %w", response.Err)
 	}
 
-	if opts.Metadata != nil {
-		createParams.Metadata = opts.Metadata
+	if response.Result == nil {
+		return nil, fmt.Errorf("could not create stream event: %w", pgx.ErrNoRows)
 	}
 
-	tx, err := r.pool.Begin(ctx)
+	return response.Result, nil
+}
 
-	if err != nil {
-		return nil, err
+// bulkCreateStreamEvents writes the stream events of a tenant, and returns nil for the stream events whose step run
+// doesn't exist.
+func (r *streamEventEngineRepository) bulkCreateStreamEvents(ctx context.Context, items []*createStreamEventItem) ([]*dbsqlc.StreamEvent, error) {
+	if len(items) == 0 {
+		return nil, nil
 	}
 
-	defer sqlchelpers.DeferRollback(ctx, r.l, tx.Rollback)
+	params := dbsqlc.CreateStreamEventsParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(items[0].tenantId),
+		Createdats: make([]pgtype.Timestamp, len(items)),
+		Steprunids: make([]pgtype.UUID, len(items)),
+		Messages:   make([][]byte, len(items)),
+		Metadatas:  make([][]byte, len(items)),
+	}
 
-	streamEvent, err := r.queries.CreateStreamEvent(
-		ctx,
-		tx,
-		createParams,
-	)
+	// the indexes of the items of each step run, in order
+	stepRunItems := make(map[string][]int)
+	now := time.Now().UTC()
 
-	if err != nil {
-		return nil, fmt.Errorf("could not create stream event: %w", err)
+	for i, item := range items {
+		createdAt := now
+
+		if item.opts.CreatedAt != nil {
+			createdAt = item.opts.CreatedAt.UTC()
+		}
+
+		message := item.opts.Message
+
+		if message == nil {
+			message = []byte("")
+		}
+
+		metadata := item.opts.Metadata
+
+		if metadata == nil {
+			metadata = []byte("{}")
+		}
+
+		params.Createdats[i] = sqlchelpers.TimestampFromTime(createdAt)
+		params.Steprunids[i] = sqlchelpers.UUIDFromStr(item.opts.StepRunId)
+		params.Messages[i] = message
+		params.Metadatas[i] = metadata
+
+		stepRunItems[item.opts.StepRunId] = append(stepRunItems[item.opts.StepRunId], i)
 	}
 
-	err = tx.Commit(ctx)
+	streamEvents, err := r.queries.CreateStreamEvents(ctx, r.pool, params)
 
 	if err != nil {
-		return nil, fmt.Errorf("could not commit transaction: %w", err)
+		return nil, fmt.Errorf("could not create stream events: %w", err)
 	}
 
-	return streamEvent, nil
+	sort.Slice(streamEvents, func(i, j int) bool {
+		return streamEvents[i].ID < streamEvents[j].ID
+	})
+
+	res := make([]*dbsqlc.StreamEvent, len(items))
+
+	// the ids of the stream events of a step run are in the order of its items
+	for _, streamEvent := range streamEvents {
+		stepRunId := sqlchelpers.UUIDToStr(streamEvent.StepRunId)
+		indexes := stepRunItems[stepRunId]
+
+		if len(indexes) == 0 {
+			continue
+		}
+
+		res[indexes[0]] = streamEvent
+		stepRunItems[stepRunId] = indexes[1:]
+	}
+
+	return res, nil
 }
 
 func (r *streamEventEngineRepository) GetStreamEvent(ctx context.Context, tenantId string, streamEventId int64) (*dbsqlc.StreamEvent, error) {
@@ -0,0 +1,218 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+// partitionMaintenanceLease is the name of the lease which replaces the partition maintenance lock when advisory
+// locks can't be used
+const partitionMaintenanceLease = "partition-maintenance"
+
+// partitionMaintenanceLeaseDuration is how long the lease is held if the engine holding it stops before releasing it,
+// which must be longer than the maintenance takes
+const partitionMaintenanceLeaseDuration = time.Hour
+
+type tablePartitionRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+
+	// pgBouncerMode uses a lease instead of an advisory lock, since advisory locks are held by the session
+	pgBouncerMode bool
+}
+
+func NewTablePartitionRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, pgBouncerMode bool) repository.TablePartitionRepository {
+	queries := dbsqlc.New()
+
+	return &tablePartitionRepository{
+		pool:          pool,
+		v:             v,
+		queries:       queries,
+		l:             l,
+		pgBouncerMode: pgBouncerMode,
+	}
+}
+
+func (r *tablePartitionRepository) WithMaintenanceLock(ctx context.Context, f func(ctx context.Context) error) (bool, error) {
+	if r.pgBouncerMode {
+		return r.withMaintenanceLease(ctx, f)
+	}
+
+	// advisory locks are held by the session, so the lock is acquired and released on the same connection
+	conn, err := r.pool.Acquire(ctx)
+
+	if err != nil {
+		return false, fmt.Errorf("could not acquire connection: %w", err)
+	}
+
+	defer conn.Release()
+
+	acquired, err := r.queries.TryAcquirePartitionMaintenanceLock(ctx, conn)
+
+	if err != nil {
+		return false, fmt.Errorf("could not acquire partition maintenance lock: %w", err)
+	}
+
+	if !acquired {
+		return false, nil
+	}
+
+	defer func() {
+		if err := r.queries.ReleasePartitionMaintenanceLock(context.Background(), conn); err != nil {
+			r.l.Err(err).Msg("could not release partition maintenance lock, closing the connection")
+
+			// closing the session releases the lock, and the closed connection isn't returned to the pool
+			conn.Conn().Close(context.Background()) // nolint: errcheck
+		}
+	}()
+
+	return true, f(ctx)
+}
+
+func (r *tablePartitionRepository) withMaintenanceLease(ctx context.Context, f func(ctx context.Context) error) (bool, error) {
+	holderId := uuid.New().String()
+
+	acquired, err := r.queries.AcquireEngineLease(ctx, r.pool, dbsqlc.AcquireEngineLeaseParams{
+		Name:            partitionMaintenanceLease,
+		Holderid:        holderId,
+		Durationseconds: int32(partitionMaintenanceLeaseDuration.Seconds()),
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not acquire partition maintenance lease: %w", err)
+	}
+
+	if !acquired {
+		return false, nil
+	}
+
+	defer func() {
+		err := r.queries.ReleaseEngineLease(context.Background(), r.pool, dbsqlc.ReleaseEngineLeaseParams{
+			Name:     partitionMaintenanceLease,
+			Holderid: holderId,
+		})
+
+		// the lease expires if it can't be released
+		if err != nil {
+			r.l.Err(err).Msg("could not release partition maintenance lease")
+		}
+	}()
+
+	return true, f(ctx)
+}
+
+func (r *tablePartitionRepository) ListPartitions(ctx context.Context, table repository.PartitionedTable) ([]*repository.TablePartition, error) {
+	rows, err := r.queries.ListTablePartitions(ctx, r.pool, string(table))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list partitions of %s: %w", table, err)
+	}
+
+	res := make([]*repository.TablePartition, 0, len(rows))
+
+	for _, row := range rows {
+		res = append(res, &repository.TablePartition{
+			Name:     row.Name,
+			RangeEnd: row.RangeEnd.Time,
+		})
+	}
+
+	return res, nil
+}
+
+func (r *tablePartitionRepository) CreatePartition(ctx context.Context, table repository.PartitionedTable, day time.Time) error {
+	day = day.UTC().Truncate(24 * time.Hour)
+
+	partition := fmt.Sprintf("%s_%s", table, day.Format("20060102"))
+
+	// DDL statements can't have parameters, the bounds are formatted dates and the names are quoted
+	_, err := r.pool.Exec(
+		ctx,
+		fmt.Sprintf(
+			"CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM ('%s') TO ('%s')",
+			pgx.Identifier{partition}.Sanitize(),
+			pgx.Identifier{string(table)}.Sanitize(),
+			day.Format(time.DateOnly),
+			day.AddDate(0, 0, 1).Format(time.DateOnly),
+		),
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not create partition %s: %w", partition, err)
+	}
+
+	return nil
+}
+
+func (r *tablePartitionRepository) HasLiveRows(ctx context.Context, table repository.PartitionedTable, createdBefore, deletedBefore time.Time) (bool, error) {
+	switch table {
+	case repository.PartitionedTableStepRun:
+		return r.queries.HasLiveStepRuns(ctx, r.pool, dbsqlc.HasLiveStepRunsParams{
+			Createdbefore: sqlchelpers.TimestampFromTime(createdBefore),
+			Deletedafter:  sqlchelpers.TimestampFromTime(deletedBefore),
+		})
+	case repository.PartitionedTableEvent:
+		return r.queries.HasLiveEvents(ctx, r.pool, dbsqlc.HasLiveEventsParams{
+			Createdbefore: sqlchelpers.TimestampFromTime(createdBefore),
+			Deletedafter:  sqlchelpers.TimestampFromTime(deletedBefore),
+		})
+	case repository.PartitionedTableQueueItem:
+		return r.queries.HasQueuedQueueItems(ctx, r.pool, sqlchelpers.TimestampFromTime(createdBefore))
+	default:
+		return false, fmt.Errorf("%s is not a partitioned table", table)
+	}
+}
+
+func (r *tablePartitionRepository) DeleteStepRunDependencies(ctx context.Context, createdBefore time.Time, afterId string, limit int) ([]*dbsqlc.DeleteStepRunDependenciesRow, error) {
+	return r.queries.DeleteStepRunDependencies(ctx, r.pool, dbsqlc.DeleteStepRunDependenciesParams{
+		Createdbefore: sqlchelpers.TimestampFromTime(createdBefore),
+		Afterid:       sqlchelpers.UUIDFromStr(afterId),
+		Batchsize:     int32(limit), // nolint: gosec
+	})
+}
+
+func (r *tablePartitionRepository) DeleteEventDependencies(ctx context.Context, createdBefore time.Time, afterId string, limit int) ([]*dbsqlc.DeleteEventDependenciesRow, error) {
+	return r.queries.DeleteEventDependencies(ctx, r.pool, dbsqlc.DeleteEventDependenciesParams{
+		Createdbefore: sqlchelpers.TimestampFromTime(createdBefore),
+		Afterid:       sqlchelpers.UUIDFromStr(afterId),
+		Batchsize:     int32(limit), // nolint: gosec
+	})
+}
+
+func (r *tablePartitionRepository) DropPartition(ctx context.Context, table repository.PartitionedTable, partition string) error {
+	// detaching concurrently only takes a weak lock on the table, but it can't run in a transaction, so the
+	// statements are run one at a time on the pool
+	_, err := r.pool.Exec(
+		ctx,
+		fmt.Sprintf(
+			"ALTER TABLE %s DETACH PARTITION %s CONCURRENTLY",
+			pgx.Identifier{string(table)}.Sanitize(),
+			pgx.Identifier{partition}.Sanitize(),
+		),
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not detach partition %s: %w", partition, err)
+	}
+
+	_, err = r.pool.Exec(ctx, fmt.Sprintf("DROP TABLE %s", pgx.Identifier{partition}.Sanitize()))
+
+	if err != nil {
+		return fmt.Errorf("could not drop partition %s: %w", partition, err)
+	}
+
+	return nil
+}
@@ -6,6 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -92,22 +95,41 @@ func (r *tenantAPIRepository) CreateTenant(opts *repository.CreateTenantOpts) (*
 	return createTenant, nil
 }
 
+// the keys of the tenants in the cache, which differ between the API and the engine repositories since they cache
+// different models of the tenants
+func tenantAPICacheKey(id string) string {
+	return "prisma-tenant-" + id
+}
+
+func tenantEngineCacheKey(id string) string {
+	return "tenant-" + id
+}
+
 func (r *tenantAPIRepository) UpdateTenant(id string, opts *repository.UpdateTenantOpts) (*db.TenantModel, error) {
 	if err := r.v.Validate(opts); err != nil {
 		return nil, err
 	}
 
-	return r.client.Tenant.FindUnique(
+	tenant, err := r.client.Tenant.FindUnique(
 		db.Tenant.ID.Equals(id),
 	).Update(
 		db.Tenant.Name.SetIfPresent(opts.Name),
 		db.Tenant.AnalyticsOptOut.SetIfPresent(opts.AnalyticsOptOut),
 		db.Tenant.AlertMemberEmails.SetIfPresent(opts.AlertMemberEmails),
 	).Exec(context.Background())
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Delete(tenantAPICacheKey(id))
+	r.cache.Delete(tenantEngineCacheKey(id))
+
+	return tenant, nil
 }
 
 func (r *tenantAPIRepository) GetTenantByID(id string) (*db.TenantModel, error) {
-	return cache.MakeCacheable[db.TenantModel](r.cache, "prisma"+id, func() (*db.TenantModel, error) {
+	return cache.MakeCacheable[db.TenantModel](r.cache, tenantAPICacheKey(id), func() (*db.TenantModel, error) {
 		return r.client.Tenant.FindUnique(
 			db.Tenant.ID.Equals(id),
 		).Exec(context.Background())
@@ -302,7 +324,7 @@ func (r *tenantEngineRepository) ListTenants(ctx context.Context) ([]*dbsqlc.Ten
 }
 
 func (r *tenantEngineRepository) GetTenantByID(ctx context.Context, tenantId string) (*dbsqlc.Tenant, error) {
-	return cache.MakeCacheable[dbsqlc.Tenant](r.cache, tenantId, func() (*dbsqlc.Tenant, error) {
+	return cache.MakeCacheable[dbsqlc.Tenant](r.cache, tenantEngineCacheKey(tenantId), func() (*dbsqlc.Tenant, error) {
 		return r.queries.GetTenantByID(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
 	})
 }
@@ -317,7 +339,7 @@ func (r *tenantEngineRepository) UpdateControllerPartitionHeartbeat(ctx context.
 	defer sqlchelpers.DeferRollback(ctx, r.l, tx.Rollback)
 
 	// set tx timeout to 5 seconds to avoid deadlocks
-	_, err = tx.Exec(ctx, "SET statement_timeout=5000")
+	_, err = tx.Exec(ctx, "SET LOCAL statement_timeout=5000")
 
 	if err != nil {
 		return "", err
@@ -355,7 +377,7 @@ func (r *tenantEngineRepository) UpdateWorkerPartitionHeartbeat(ctx context.Cont
 	defer sqlchelpers.DeferRollback(ctx, r.l, tx.Rollback)
 
 	// set tx timeout to 5 seconds to avoid deadlocks
-	_, err = tx.Exec(ctx, "SET statement_timeout=5000")
+	_, err = tx.Exec(ctx, "SET LOCAL statement_timeout=5000")
 
 	if err != nil {
 		return "", err
@@ -423,6 +445,85 @@ func (r *tenantEngineRepository) RebalanceInactiveControllerPartitions(ctx conte
 	return r.queries.RebalanceInactiveControllerPartitions(ctx, r.pool)
 }
 
+func (r *tenantEngineRepository) CountActiveControllerPartitions(ctx context.Context) (int64, error) {
+	return r.queries.CountActiveControllerPartitions(ctx, r.pool)
+}
+
+// tenantPartitionLeasePrefix is the prefix of the engine leases on tenant partitions, which is also used by
+// ListTenantPartitions.
+const tenantPartitionLeasePrefix = "tenant-partition:"
+
+func tenantPartitionLeaseNames(partitions []int32) []string {
+	names := make([]string, len(partitions))
+
+	for i, partition := range partitions {
+		names[i] = tenantPartitionLeasePrefix + strconv.Itoa(int(partition))
+	}
+
+	return names
+}
+
+func (r *tenantEngineRepository) AcquireTenantPartitions(ctx context.Context, controllerPartitionId string, partitions []int32, leaseDuration time.Duration) ([]int32, error) {
+	if controllerPartitionId == "" {
+		return nil, fmt.Errorf("partitionId is required")
+	}
+
+	if len(partitions) == 0 {
+		return nil, nil
+	}
+
+	names, err := r.queries.AcquireEngineLeases(ctx, r.pool, dbsqlc.AcquireEngineLeasesParams{
+		Holderid:        controllerPartitionId,
+		Durationseconds: int32(leaseDuration.Seconds()),
+		Names:           tenantPartitionLeaseNames(partitions),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not acquire tenant partition leases: %w", err)
+	}
+
+	res := make([]int32, 0, len(names))
+
+	for _, name := range names {
+		partition, err := strconv.Atoi(strings.TrimPrefix(name, tenantPartitionLeasePrefix))
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid tenant partition lease %s: %w", name, err)
+		}
+
+		res = append(res, int32(partition)) // nolint: gosec
+	}
+
+	return res, nil
+}
+
+func (r *tenantEngineRepository) ReleaseTenantPartitions(ctx context.Context, controllerPartitionId string, partitions []int32) error {
+	if len(partitions) == 0 {
+		return nil
+	}
+
+	return r.queries.ReleaseEngineLeases(ctx, r.pool, dbsqlc.ReleaseEngineLeasesParams{
+		Names:    tenantPartitionLeaseNames(partitions),
+		Holderid: controllerPartitionId,
+	})
+}
+
+func (r *tenantEngineRepository) AssignTenantPartitions(ctx context.Context, controllerPartitionId string, partitionCount int32, partitions []int32) (int64, error) {
+	if len(partitions) == 0 {
+		return 0, nil
+	}
+
+	return r.queries.AssignTenantPartitions(ctx, r.pool, dbsqlc.AssignTenantPartitionsParams{
+		Controllerpartitionid: controllerPartitionId,
+		Partitioncount:        partitionCount,
+		Partitions:            partitions,
+	})
+}
+
+func (r *tenantEngineRepository) ListTenantPartitions(ctx context.Context, partitionCount int32) ([]*dbsqlc.ListTenantPartitionsRow, error) {
+	return r.queries.ListTenantPartitions(ctx, r.pool, partitionCount)
+}
+
 func (r *tenantEngineRepository) CreateTenantWorkerPartition(ctx context.Context) (string, error) {
 	partition, err := r.queries.CreateTenantWorkerPartition(ctx, r.pool, getPartitionName())
 
@@ -456,7 +557,7 @@ func (r *tenantEngineRepository) UpdateSchedulerPartitionHeartbeat(ctx context.C
 	defer sqlchelpers.DeferRollback(ctx, r.l, tx.Rollback)
 
 	// set tx timeout to 5 seconds to avoid deadlocks
-	_, err = tx.Exec(ctx, "SET statement_timeout=5000")
+	_, err = tx.Exec(ctx, "SET LOCAL statement_timeout=5000")
 
 	if err != nil {
 		return "", err
@@ -0,0 +1,72 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type tenantExportRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewTenantExportRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.TenantExportRepository {
+	queries := dbsqlc.New()
+
+	return &tenantExportRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *tenantExportRepository) ListWorkflowDefinitions(ctx context.Context, tenantId string) ([]*dbsqlc.ListWorkflowDefinitionsRow, error) {
+	return r.queries.ListWorkflowDefinitions(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *tenantExportRepository) ListCronsForExport(ctx context.Context, tenantId string) ([]*dbsqlc.ListCronsForExportRow, error) {
+	return r.queries.ListCronsForExport(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *tenantExportRepository) ListScheduledRunsForExport(ctx context.Context, tenantId string) ([]*dbsqlc.ListScheduledRunsForExportRow, error) {
+	return r.queries.ListScheduledRunsForExport(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *tenantExportRepository) ListRateLimitsForExport(ctx context.Context, tenantId string) ([]*dbsqlc.ListRateLimitsForExportRow, error) {
+	return r.queries.ListRateLimitsForExport(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *tenantExportRepository) ListWorkflowRunsForExport(ctx context.Context, tenantId string, opts *repository.ListWorkflowRunsForExportOpts) ([]*dbsqlc.ListWorkflowRunsForExportRow, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.ListWorkflowRunsForExportParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Limit:    int32(opts.Limit), // nolint: gosec
+	}
+
+	if opts.AfterCreatedAt != nil && opts.AfterId != nil {
+		params.AfterCreatedAt = sqlchelpers.TimestampFromTime(*opts.AfterCreatedAt)
+		params.AfterId = sqlchelpers.UUIDFromStr(*opts.AfterId)
+	}
+
+	runs, err := r.queries.ListWorkflowRunsForExport(ctx, r.pool, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list workflow runs: %w", err)
+	}
+
+	return runs, nil
+}
@@ -0,0 +1,213 @@
+package prisma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/config/server"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type tenantQuotaRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+	config  *server.ConfigFileRuntime
+	cache   cache.Cacheable
+}
+
+func NewTenantQuotaRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, cf *server.ConfigFileRuntime, c cache.Cacheable) repository.TenantQuotaRepository {
+	queries := dbsqlc.New()
+
+	return &tenantQuotaRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+		config:  cf,
+		cache:   c,
+	}
+}
+
+func (r *tenantQuotaRepository) GetTenantQuota(ctx context.Context, tenantId string) (*dbsqlc.TenantQuota, error) {
+	return r.queries.GetTenantQuota(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
+func (r *tenantQuotaRepository) UpsertTenantQuota(ctx context.Context, tenantId string, opts *repository.UpsertTenantQuotaOpts) (*dbsqlc.TenantQuota, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	return r.queries.UpsertTenantQuota(ctx, r.pool, dbsqlc.UpsertTenantQuotaParams{
+		Tenantid:            sqlchelpers.UUIDFromStr(tenantId),
+		MaxQueuedRuns:       int4FromPtr(opts.MaxQueuedRuns),
+		MaxWorkers:          int4FromPtr(opts.MaxWorkers),
+		MaxEventsPerMinute:  int4FromPtr(opts.MaxEventsPerMinute),
+		MaxWorkflowVersions: int4FromPtr(opts.MaxWorkflowVersions),
+	})
+}
+
+func (r *tenantQuotaRepository) ListQuotaUsage(ctx context.Context, tenantId string) ([]*repository.TenantQuotaUsage, error) {
+	limits, err := r.limits(ctx, tenantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*repository.TenantQuotaUsage, 0, len(repository.TenantQuotaResources))
+
+	for _, resource := range repository.TenantQuotaResources {
+		usage, err := r.count(ctx, tenantId, resource)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, &repository.TenantQuotaUsage{
+			Resource: resource,
+			Limit:    limits[resource],
+			Usage:    usage,
+		})
+	}
+
+	return res, nil
+}
+
+func (r *tenantQuotaRepository) CheckQuota(ctx context.Context, tenantId string, resource repository.TenantQuotaResource, n int32) error {
+	limits, err := r.limits(ctx, tenantId)
+
+	if err != nil {
+		return err
+	}
+
+	limit := limits[resource]
+
+	if limit <= 0 {
+		return nil
+	}
+
+	usage, err := cache.MakeCacheable(r.cache, fmt.Sprintf("quota-usage-%s-%s", tenantId, resource), func() (*atomic.Int32, error) {
+		count, err := r.count(ctx, tenantId, resource)
+
+		if err != nil {
+			return nil, err
+		}
+
+		usage := &atomic.Int32{}
+		usage.Store(count)
+
+		return usage, nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// the cached usage is incremented on every check which passes, so bursts within the cache period are counted
+	if curr := usage.Add(n); curr > limit {
+		usage.Add(-n)
+
+		return repository.ErrQuotaExceeded{
+			Resource: resource,
+			Limit:    limit,
+			Usage:    curr - n,
+		}
+	}
+
+	return nil
+}
+
+type tenantQuotaLimits map[repository.TenantQuotaResource]int32
+
+// limits returns the quotas which apply to the tenant, which are the quotas set on the tenant or the server defaults
+func (r *tenantQuotaRepository) limits(ctx context.Context, tenantId string) (tenantQuotaLimits, error) {
+	limits, err := cache.MakeCacheable(r.cache, "quota-limits-"+tenantId, func() (*tenantQuotaLimits, error) {
+		defaults := r.config.Quotas
+
+		limits := tenantQuotaLimits{
+			repository.TenantQuotaResourceQueuedRuns:       int32(defaults.DefaultMaxQueuedRuns),       // nolint: gosec
+			repository.TenantQuotaResourceWorkers:          int32(defaults.DefaultMaxWorkers),          // nolint: gosec
+			repository.TenantQuotaResourceEventsPerMinute:  int32(defaults.DefaultMaxEventsPerMinute),  // nolint: gosec
+			repository.TenantQuotaResourceWorkflowVersions: int32(defaults.DefaultMaxWorkflowVersions), // nolint: gosec
+		}
+
+		quota, err := r.queries.GetTenantQuota(ctx, r.pool, sqlchelpers.UUIDFromStr(tenantId))
+
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("could not get tenant quota: %w", err)
+		}
+
+		if quota != nil && err == nil {
+			overrides := map[repository.TenantQuotaResource]pgtype.Int4{
+				repository.TenantQuotaResourceQueuedRuns:       quota.MaxQueuedRuns,
+				repository.TenantQuotaResourceWorkers:          quota.MaxWorkers,
+				repository.TenantQuotaResourceEventsPerMinute:  quota.MaxEventsPerMinute,
+				repository.TenantQuotaResourceWorkflowVersions: quota.MaxWorkflowVersions,
+			}
+
+			for resource, override := range overrides {
+				if override.Valid {
+					limits[resource] = override.Int32
+				}
+			}
+		}
+
+		return &limits, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return *limits, nil
+}
+
+func (r *tenantQuotaRepository) count(ctx context.Context, tenantId string, resource repository.TenantQuotaResource) (int32, error) {
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+
+	var count int64
+	var err error
+
+	switch resource {
+	case repository.TenantQuotaResourceQueuedRuns:
+		count, err = r.queries.CountQueuedWorkflowRuns(ctx, r.pool, pgTenantId)
+	case repository.TenantQuotaResourceWorkers:
+		count, err = r.queries.CountTenantWorkers(ctx, r.pool, pgTenantId)
+	case repository.TenantQuotaResourceEventsPerMinute:
+		count, err = r.queries.CountEventsSince(ctx, r.pool, dbsqlc.CountEventsSinceParams{
+			Tenantid: pgTenantId,
+			Since:    sqlchelpers.TimestampFromTime(time.Now().Add(-time.Minute)),
+		})
+	case repository.TenantQuotaResourceWorkflowVersions:
+		count, err = r.queries.CountTenantWorkflowVersions(ctx, r.pool, pgTenantId)
+	default:
+		return 0, fmt.Errorf("unknown quota resource %s", resource)
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("could not count %s: %w", resource, err)
+	}
+
+	return int32(count), nil // nolint: gosec
+}
+
+func int4FromPtr(i *int32) pgtype.Int4 {
+	if i == nil {
+		return pgtype.Int4{}
+	}
+
+	return sqlchelpers.ToInt(*i)
+}
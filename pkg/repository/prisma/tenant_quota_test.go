@@ -0,0 +1,81 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+func TestTenantQuota(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		repo := conf.EngineRepository.TenantQuota()
+
+		maxWorkflowVersions := int32(2)
+
+		// the quotas are set before they're checked, since the quotas of a tenant are cached
+		_, err := repo.UpsertTenantQuota(ctx, tenantId, &repository.UpsertTenantQuotaOpts{
+			MaxWorkflowVersions: &maxWorkflowVersions,
+		})
+
+		require.NoError(t, err)
+
+		createTestWorkflow(t, conf, tenantId, "quota")
+
+		usages, err := repo.ListQuotaUsage(ctx, tenantId)
+		require.NoError(t, err)
+		require.Len(t, usages, len(repository.TenantQuotaResources))
+
+		for _, usage := range usages {
+			if usage.Resource == repository.TenantQuotaResourceWorkflowVersions {
+				assert.Equal(t, int32(2), usage.Limit)
+				assert.Equal(t, int32(1), usage.Usage)
+			}
+		}
+
+		require.NoError(t, repo.CheckQuota(ctx, tenantId, repository.TenantQuotaResourceWorkflowVersions, 1))
+
+		// the check which passed is counted, so bursts within the cache period don't exceed the quota
+		err = repo.CheckQuota(ctx, tenantId, repository.TenantQuotaResourceWorkflowVersions, 1)
+
+		var quotaErr repository.ErrQuotaExceeded
+
+		require.ErrorAs(t, err, &quotaErr)
+		assert.Equal(t, repository.TenantQuotaResourceWorkflowVersions, quotaErr.Resource)
+		assert.Equal(t, int32(2), quotaErr.Limit)
+		assert.Equal(t, int32(2), quotaErr.Usage)
+
+		// the quotas of the tenant are replaced, so quotas which aren't set fall back to the server defaults
+		maxWorkers := int32(5)
+
+		_, err = repo.UpsertTenantQuota(ctx, tenantId, &repository.UpsertTenantQuotaOpts{
+			MaxWorkers: &maxWorkers,
+		})
+
+		require.NoError(t, err)
+
+		quota, err := repo.GetTenantQuota(ctx, tenantId)
+		require.NoError(t, err)
+		assert.Equal(t, int32(5), quota.MaxWorkers.Int32)
+		assert.False(t, quota.MaxWorkflowVersions.Valid)
+
+		negative := int32(-1)
+
+		_, err = repo.UpsertTenantQuota(ctx, tenantId, &repository.UpsertTenantQuotaOpts{
+			MaxQueuedRuns: &negative,
+		})
+
+		assert.Error(t, err)
+
+		return nil
+	})
+}
@@ -3,6 +3,7 @@ package prisma
 import (
 	"context"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 
@@ -109,6 +110,34 @@ func (t *tickerRepository) PollTenantResourceLimitAlerts(ctx context.Context) ([
 	return t.queries.PollTenantResourceLimitAlerts(ctx, t.pool)
 }
 
+func (t *tickerRepository) PollStepRunTimers(ctx context.Context, tickerId string) ([]*dbsqlc.PollStepRunTimersRow, error) {
+	return t.queries.PollStepRunTimers(ctx, t.pool, sqlchelpers.UUIDFromStr(tickerId))
+}
+
+func (t *tickerRepository) DeleteStepRunTimers(ctx context.Context, stepRunIds []string) error {
+	pgStepRunIds := make([]pgtype.UUID, len(stepRunIds))
+
+	for i, id := range stepRunIds {
+		pgStepRunIds[i] = sqlchelpers.UUIDFromStr(id)
+	}
+
+	return t.queries.DeleteStepRunTimers(ctx, t.pool, pgStepRunIds)
+}
+
+func (t *tickerRepository) PollDelayedEvents(ctx context.Context, tickerId string) ([]*dbsqlc.DelayedEvent, error) {
+	return t.queries.PollDelayedEvents(ctx, t.pool, sqlchelpers.UUIDFromStr(tickerId))
+}
+
+func (t *tickerRepository) DeleteDelayedEvents(ctx context.Context, eventIds []string) error {
+	pgEventIds := make([]pgtype.UUID, len(eventIds))
+
+	for i, id := range eventIds {
+		pgEventIds[i] = sqlchelpers.UUIDFromStr(id)
+	}
+
+	return t.queries.DeleteDelayedEvents(ctx, t.pool, pgEventIds)
+}
+
 func (t *tickerRepository) PollUnresolvedFailedStepRuns(ctx context.Context) ([]*dbsqlc.PollUnresolvedFailedStepRunsRow, error) {
 	return t.queries.PollUnresolvedFailedStepRuns(ctx, t.pool)
 }
@@ -0,0 +1,155 @@
+package prisma
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/internal/metrics"
+	"github.com/hatchet-dev/hatchet/internal/statemachine"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// recordRejectedStepRunTransitions records the step runs which weren't moved to the status, given the step runs which
+// the update was written for and the step runs which it updated. Step runs which are already in the status, like on a
+// redelivered event, aren't counted.
+func recordRejectedStepRunTransitions(
+	ctx context.Context,
+	queries *dbsqlc.Queries,
+	tx dbsqlc.DBTX,
+	l *zerolog.Logger,
+	to dbsqlc.StepRunStatus,
+	requested, updated []pgtype.UUID,
+) {
+	if len(requested) == len(updated) {
+		return
+	}
+
+	updatedIds := make(map[string]struct{}, len(updated))
+
+	for _, id := range updated {
+		updatedIds[sqlchelpers.UUIDToStr(id)] = struct{}{}
+	}
+
+	rejected := make([]pgtype.UUID, 0, len(requested)-len(updated))
+
+	for _, id := range requested {
+		if _, ok := updatedIds[sqlchelpers.UUIDToStr(id)]; !ok {
+			rejected = append(rejected, id)
+		}
+	}
+
+	if len(rejected) == 0 {
+		return
+	}
+
+	statuses, err := queries.GetStepRunStatuses(ctx, tx, rejected)
+
+	if err != nil {
+		l.Err(err).Msg("could not get statuses of step runs with rejected transitions")
+		return
+	}
+
+	for _, sr := range statuses {
+		// the step run could have moved to a source status after the update
+		if sr.Status == to || statemachine.CanTransitionStepRun(sr.Status, to) {
+			continue
+		}
+
+		metrics.RejectedTransitions.WithLabelValues("step_run", string(sr.Status), string(to)).Inc()
+
+		l.Debug().Msgf(
+			"rejected transition of step run %s from %s to %s",
+			sqlchelpers.UUIDToStr(sr.ID), sr.Status, to,
+		)
+	}
+}
+
+// recordRejectedWorkflowRunTransition records a workflow run which wasn't moved to the status.
+func recordRejectedWorkflowRunTransition(
+	ctx context.Context,
+	queries *dbsqlc.Queries,
+	tx dbsqlc.DBTX,
+	l *zerolog.Logger,
+	tenantId, workflowRunId pgtype.UUID,
+	to dbsqlc.WorkflowRunStatus,
+) {
+	from, err := queries.GetWorkflowRunStatus(ctx, tx, dbsqlc.GetWorkflowRunStatusParams{
+		Workflowrunid: workflowRunId,
+		Tenantid:      tenantId,
+	})
+
+	if err != nil {
+		l.Err(err).Msg("could not get status of workflow run with rejected transition")
+		return
+	}
+
+	if from == to || statemachine.CanTransitionWorkflowRun(from, to) {
+		return
+	}
+
+	metrics.RejectedTransitions.WithLabelValues("workflow_run", string(from), string(to)).Inc()
+
+	l.Debug().Msgf(
+		"rejected transition of workflow run %s from %s to %s",
+		sqlchelpers.UUIDToStr(workflowRunId), from, to,
+	)
+}
+
+// recordRejectedJobRunTransition records a job run which wasn't moved to the status.
+func recordRejectedJobRunTransition(
+	ctx context.Context,
+	queries *dbsqlc.Queries,
+	tx dbsqlc.DBTX,
+	l *zerolog.Logger,
+	tenantId, jobRunId pgtype.UUID,
+	to dbsqlc.JobRunStatus,
+) {
+	from, err := queries.GetJobRunStatus(ctx, tx, dbsqlc.GetJobRunStatusParams{
+		Jobrunid: jobRunId,
+		Tenantid: tenantId,
+	})
+
+	if err != nil {
+		l.Err(err).Msg("could not get status of job run with rejected transition")
+		return
+	}
+
+	if from == to || statemachine.CanTransitionJobRun(from, to) {
+		return
+	}
+
+	metrics.RejectedTransitions.WithLabelValues("job_run", string(from), string(to)).Inc()
+
+	l.Debug().Msgf(
+		"rejected transition of job run %s from %s to %s",
+		sqlchelpers.UUIDToStr(jobRunId), from, to,
+	)
+}
+
+// resolveJobRunStatusParams returns the params to resolve the job runs of the step runs, which only allow the
+// transitions of the state machine.
+func resolveJobRunStatusParams(stepRunIds []pgtype.UUID) dbsqlc.ResolveJobRunStatusParams {
+	froms, tos := statemachine.JobRunTransitionPairs()
+
+	return dbsqlc.ResolveJobRunStatusParams{
+		Steprunids:      stepRunIds,
+		Transitionfroms: froms,
+		Transitiontos:   tos,
+	}
+}
+
+// resolveWorkflowRunStatusParams returns the params to resolve the workflow runs of the job runs, which only allow
+// the transitions of the state machine.
+func resolveWorkflowRunStatusParams(tenantId pgtype.UUID, jobRunIds []pgtype.UUID) dbsqlc.ResolveWorkflowRunStatusParams {
+	froms, tos := statemachine.WorkflowRunTransitionPairs()
+
+	return dbsqlc.ResolveWorkflowRunStatusParams{
+		Jobrunids:       jobRunIds,
+		Tenantid:        tenantId,
+		Transitionfroms: froms,
+		Transitiontos:   tos,
+	}
+}
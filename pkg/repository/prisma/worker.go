@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/hatchet-dev/hatchet/internal/services/dispatcher/contracts"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/buffer"
 	"github.com/hatchet-dev/hatchet/pkg/repository/metered"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
@@ -167,6 +169,10 @@ func (w *workerAPIRepository) ListWorkerLabels(tenantId, workerId string) ([]*db
 	return w.queries.ListWorkerLabels(context.Background(), w.pool, sqlchelpers.UUIDFromStr(workerId))
 }
 
+func (w *workerAPIRepository) ListWorkerPools(tenantId string) ([]*dbsqlc.ListWorkerPoolsRow, error) {
+	return w.queries.ListWorkerPools(context.Background(), w.pool, sqlchelpers.UUIDFromStr(tenantId))
+}
+
 func (w *workerAPIRepository) UpdateWorker(tenantId, workerId string, opts repository.ApiUpdateWorkerOpts) (*dbsqlc.Worker, error) {
 	if err := w.v.Validate(opts); err != nil {
 		return nil, err
@@ -193,18 +199,19 @@ func (w *workerAPIRepository) UpdateWorker(tenantId, workerId string, opts repos
 }
 
 type workerEngineRepository struct {
-	pool          *pgxpool.Pool
-	essentialPool *pgxpool.Pool
-	v             validator.Validator
-	queries       *dbsqlc.Queries
-	l             *zerolog.Logger
-	m             *metered.Metered
+	pool            *pgxpool.Pool
+	essentialPool   *pgxpool.Pool
+	v               validator.Validator
+	queries         *dbsqlc.Queries
+	l               *zerolog.Logger
+	m               *metered.Metered
+	heartbeatBuffer *buffer.CoalescingBuffer[string, dbsqlc.UpdateWorkerHeartbeatsParams]
 }
 
-func NewWorkerEngineRepository(pool *pgxpool.Pool, essentialPool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, m *metered.Metered) repository.WorkerEngineRepository {
+func NewWorkerEngineRepository(pool *pgxpool.Pool, essentialPool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, m *metered.Metered, heartbeatBufferConf buffer.ConfigFileCoalescingBuffer) (repository.WorkerEngineRepository, func() error) {
 	queries := dbsqlc.New()
 
-	return &workerEngineRepository{
+	w := &workerEngineRepository{
 		pool:          pool,
 		essentialPool: essentialPool,
 		v:             v,
@@ -212,6 +219,16 @@ func NewWorkerEngineRepository(pool *pgxpool.Pool, essentialPool *pgxpool.Pool,
 		l:             l,
 		m:             m,
 	}
+
+	w.heartbeatBuffer = buffer.NewCoalescingBuffer(buffer.CoalescingBufferOpts[string, dbsqlc.UpdateWorkerHeartbeatsParams]{
+		Name:      "worker_heartbeats",
+		Config:    heartbeatBufferConf,
+		Merge:     mergeWorkerHeartbeats,
+		FlushFunc: w.flushWorkerHeartbeats,
+		L:         l,
+	})
+
+	return w, w.heartbeatBuffer.Cleanup
 }
 
 func (w *workerEngineRepository) GetWorkerForEngine(ctx context.Context, tenantId, workerId string) (*dbsqlc.GetWorkerForEngineRow, error) {
@@ -323,6 +340,14 @@ func (w *workerEngineRepository) CreateNewWorker(ctx context.Context, tenantId s
 			}
 		}
 
+		if opts.Region != nil && *opts.Region != "" {
+			createParams.Region = sqlchelpers.TextFromStr(*opts.Region)
+		}
+
+		if opts.Pool != nil && *opts.Pool != "" {
+			createParams.Pool = sqlchelpers.TextFromStr(*opts.Pool)
+		}
+
 		if worker == nil {
 			worker, err = w.queries.CreateWorker(ctx, tx, createParams)
 
@@ -379,6 +404,26 @@ func (w *workerEngineRepository) CreateNewWorker(ctx context.Context, tenantId s
 			return nil, nil, fmt.Errorf("could not link actions to worker: %w", err)
 		}
 
+		if len(opts.SlotPools) > 0 {
+			slotTypes := make([]string, 0, len(opts.SlotPools))
+			maxRuns := make([]int32, 0, len(opts.SlotPools))
+
+			for slotType, size := range opts.SlotPools {
+				slotTypes = append(slotTypes, slotType)
+				maxRuns = append(maxRuns, int32(size)) // nolint: gosec
+			}
+
+			err = w.queries.UpsertWorkerSlotPools(ctx, tx, dbsqlc.UpsertWorkerSlotPoolsParams{
+				Workerid:  worker.ID,
+				Slottypes: slotTypes,
+				Maxruns:   maxRuns,
+			})
+
+			if err != nil {
+				return nil, nil, fmt.Errorf("could not upsert worker slot pools: %w", err)
+			}
+		}
+
 		err = tx.Commit(ctx)
 
 		if err != nil {
@@ -427,6 +472,13 @@ func (w *workerEngineRepository) UpdateWorker(ctx context.Context, tenantId, wor
 		}
 	}
 
+	if opts.IsDraining != nil {
+		updateParams.IsDraining = pgtype.Bool{
+			Bool:  *opts.IsDraining,
+			Valid: true,
+		}
+	}
+
 	worker, err := w.queries.UpdateWorker(ctx, tx, updateParams)
 
 	if err != nil {
@@ -468,18 +520,67 @@ func (w *workerEngineRepository) UpdateWorker(ctx context.Context, tenantId, wor
 	return worker, nil
 }
 
-func (w *workerEngineRepository) UpdateWorkerHeartbeat(ctx context.Context, tenantId, workerId string, lastHeartbeat time.Time) error {
-
-	_, err := w.queries.UpdateWorkerHeartbeat(ctx, w.essentialPool, dbsqlc.UpdateWorkerHeartbeatParams{
+func (w *workerEngineRepository) UpdateWorkerHeartbeat(ctx context.Context, tenantId, workerId string, lastHeartbeat time.Time, health *repository.WorkerHealth) error {
+	params := dbsqlc.UpdateWorkerHeartbeatsParams{
 		ID:              sqlchelpers.UUIDFromStr(workerId),
 		LastHeartbeatAt: sqlchelpers.TimestampFromTime(lastHeartbeat),
-	})
+	}
 
-	if err != nil {
-		return fmt.Errorf("could not update worker heartbeat: %w", err)
+	if health != nil {
+		if err := w.v.Validate(health); err != nil {
+			return err
+		}
+
+		params.Hashealth = true
+
+		if health.CpuUtilization != nil {
+			params.CpuUtilization = pgtype.Float8{Float64: *health.CpuUtilization, Valid: true}
+		}
+
+		if health.MemoryUtilization != nil {
+			params.MemoryUtilization = pgtype.Float8{Float64: *health.MemoryUtilization, Valid: true}
+		}
+
+		if health.ActiveSlots != nil {
+			params.ActiveSlots = sqlchelpers.ToInt(int32(*health.ActiveSlots)) // nolint: gosec
+		}
+
+		if len(health.Gauges) > 0 {
+			gauges, err := json.Marshal(health.Gauges)
+
+			if err != nil {
+				return fmt.Errorf("could not marshal worker gauges: %w", err)
+			}
+
+			params.Gauges = gauges
+		}
 	}
 
-	return nil
+	// heartbeats are coalesced and written in batches, a heartbeat which wasn't written is lost if the engine crashes
+	// but the worker sends another one
+	return w.heartbeatBuffer.Put(workerId, params)
+}
+
+// mergeWorkerHeartbeats keeps the health report of the pending heartbeat if the new heartbeat doesn't carry one.
+func mergeWorkerHeartbeats(pending, heartbeat dbsqlc.UpdateWorkerHeartbeatsParams) dbsqlc.UpdateWorkerHeartbeatsParams {
+	if !heartbeat.Hashealth && pending.Hashealth {
+		pending.LastHeartbeatAt = heartbeat.LastHeartbeatAt
+		return pending
+	}
+
+	return heartbeat
+}
+
+func (w *workerEngineRepository) flushWorkerHeartbeats(ctx context.Context, heartbeats []dbsqlc.UpdateWorkerHeartbeatsParams) error {
+	var err error
+
+	w.queries.UpdateWorkerHeartbeats(ctx, w.essentialPool, heartbeats).Exec(func(i int, execErr error) {
+		if execErr != nil {
+			err = multierror.Append(err, fmt.Errorf("could not update heartbeat of worker %s: %w", sqlchelpers.UUIDToStr(heartbeats[i].ID), execErr))
+		}
+	})
+
+	return err
 }
 
 func (w *workerEngineRepository) DeleteWorker(ctx context.Context, tenantId, workerId string) error {
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/rand"
 	"strings"
 	"time"
 
@@ -281,6 +282,28 @@ func (r *workflowAPIRepository) GetWorkflowMetrics(tenantId, workflowId string,
 	}, nil
 }
 
+func (r *workflowAPIRepository) ListWorkflowConcurrencyGroups(ctx context.Context, tenantId, workflowId string, limit *int) ([]*dbsqlc.ListWorkflowConcurrencyGroupsRow, error) {
+	params := dbsqlc.ListWorkflowConcurrencyGroupsParams{
+		Tenantid:   sqlchelpers.UUIDFromStr(tenantId),
+		Workflowid: sqlchelpers.UUIDFromStr(workflowId),
+	}
+
+	if limit != nil {
+		params.Limit = pgtype.Int4{
+			Int32: int32(*limit), // nolint: gosec
+			Valid: true,
+		}
+	}
+
+	groups, err := r.queries.ListWorkflowConcurrencyGroups(ctx, r.pool, params)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list concurrency groups: %w", err)
+	}
+
+	return groups, nil
+}
+
 func (w *workflowAPIRepository) ListCronWorkflows(ctx context.Context, tenantId string, opts *repository.ListCronWorkflowsOpts) ([]*dbsqlc.ListCronWorkflowsRow, int64, error) {
 	if err := w.v.Validate(opts); err != nil {
 		return nil, 0, err
@@ -380,6 +403,9 @@ func (w *workflowAPIRepository) DeleteCronWorkflow(ctx context.Context, tenantId
 }
 
 func (w *workflowAPIRepository) CreateCronWorkflow(ctx context.Context, tenantId string, opts *repository.CreateCronWorkflowTriggerOpts) (*dbsqlc.ListCronWorkflowsRow, error) {
+	if err := w.v.Validate(opts); err != nil {
+		return nil, err
+	}
 
 	var input, additionalMetadata []byte
 	var err error
@@ -412,6 +438,18 @@ func (w *workflowAPIRepository) CreateCronWorkflow(ctx context.Context, tenantId
 		},
 	}
 
+	if opts.Timezone != nil {
+		createParams.Timezone = sqlchelpers.TextFromStr(*opts.Timezone)
+	}
+
+	if opts.JitterSeconds != nil {
+		createParams.JitterSeconds = pgtype.Int4{Int32: *opts.JitterSeconds, Valid: true}
+	}
+
+	if opts.ExclusionCalendarId != nil {
+		createParams.ExclusionCalendarId = sqlchelpers.UUIDFromStr(*opts.ExclusionCalendarId)
+	}
+
 	cronTrigger, err := w.queries.CreateWorkflowTriggerCronRefForWorkflow(ctx, w.pool, createParams)
 
 	if err != nil {
@@ -442,19 +480,21 @@ type workflowEngineRepository struct {
 	l       *zerolog.Logger
 	m       *metered.Metered
 
-	cache cache.Cacheable
+	cache           cache.Cacheable
+	definitionCache *definitionCache
 }
 
-func NewWorkflowEngineRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, m *metered.Metered, cache cache.Cacheable) repository.WorkflowEngineRepository {
+func NewWorkflowEngineRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, m *metered.Metered, cache cache.Cacheable, definitionCache *definitionCache) repository.WorkflowEngineRepository {
 	queries := dbsqlc.New()
 
 	return &workflowEngineRepository{
-		v:       v,
-		queries: queries,
-		pool:    pool,
-		l:       l,
-		m:       m,
-		cache:   cache,
+		v:               v,
+		queries:         queries,
+		pool:            pool,
+		l:               l,
+		m:               m,
+		cache:           cache,
+		definitionCache: definitionCache,
 	}
 }
 
@@ -740,6 +780,12 @@ func (r *workflowEngineRepository) GetLatestWorkflowVersions(ctx context.Context
 }
 
 func (r *workflowEngineRepository) GetLatestWorkflowVersion(ctx context.Context, tenantId, workflowId string) (*dbsqlc.GetWorkflowVersionForEngineRow, error) {
+	return getCachedDefinition(r.definitionCache, tenantId, "latest-"+workflowId, func() (*dbsqlc.GetWorkflowVersionForEngineRow, error) {
+		return r.getLatestWorkflowVersion(ctx, tenantId, workflowId)
+	})
+}
+
+func (r *workflowEngineRepository) getLatestWorkflowVersion(ctx context.Context, tenantId, workflowId string) (*dbsqlc.GetWorkflowVersionForEngineRow, error) {
 	versionId, err := r.queries.GetWorkflowLatestVersion(ctx, r.pool, sqlchelpers.UUIDFromStr(workflowId))
 
 	if err != nil {
@@ -762,6 +808,128 @@ func (r *workflowEngineRepository) GetLatestWorkflowVersion(ctx context.Context,
 	return versions[0], nil
 }
 
+func (r *workflowEngineRepository) GetWorkflowVersionsForTrigger(ctx context.Context, tenantId string, workflowIds []string) ([]*dbsqlc.GetWorkflowVersionForEngineRow, error) {
+	distinctWorkflowIds := make([]string, 0, len(workflowIds))
+	seen := make(map[string]bool)
+
+	for _, id := range workflowIds {
+		if !seen[id] {
+			seen[id] = true
+			distinctWorkflowIds = append(distinctWorkflowIds, id)
+		}
+	}
+
+	latestVersions, err := r.GetLatestWorkflowVersions(ctx, tenantId, distinctWorkflowIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	latestVersionMap := make(map[string]*dbsqlc.GetWorkflowVersionForEngineRow, len(latestVersions))
+
+	for _, version := range latestVersions {
+		latestVersionMap[sqlchelpers.UUIDToStr(version.WorkflowVersion.WorkflowId)] = version
+	}
+
+	triggerVersions := make([]*dbsqlc.GetWorkflowVersionForEngineRow, len(workflowIds))
+
+	for i, id := range workflowIds {
+		triggerVersions[i] = latestVersionMap[sqlchelpers.UUIDToStr(sqlchelpers.UUIDFromStr(id))]
+
+		if triggerVersions[i] == nil {
+			return nil, fmt.Errorf("could not find latest version of workflow %s", id)
+		}
+	}
+
+	return r.applyRollouts(ctx, tenantId, triggerVersions)
+}
+
+// applyRollouts resolves the latest workflow versions which runs would be triggered on against the rollouts of
+// their workflows. Each run which isn't part of the canary percentage of its workflow's rollout is triggered on
+// the stable version instead. If the stable version was deleted, the latest version is used.
+func (r *workflowEngineRepository) applyRollouts(ctx context.Context, tenantId string, latestVersions []*dbsqlc.GetWorkflowVersionForEngineRow) ([]*dbsqlc.GetWorkflowVersionForEngineRow, error) {
+	if len(latestVersions) == 0 {
+		return latestVersions, nil
+	}
+
+	workflowIds := make([]pgtype.UUID, 0, len(latestVersions))
+
+	for _, version := range latestVersions {
+		workflowIds = append(workflowIds, version.WorkflowVersion.WorkflowId)
+	}
+
+	rollouts, err := r.queries.ListWorkflowRolloutsForWorkflows(ctx, r.pool, workflowIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow rollouts: %w", err)
+	}
+
+	if len(rollouts) == 0 {
+		return latestVersions, nil
+	}
+
+	rolloutMap := make(map[string]*dbsqlc.WorkflowRollout, len(rollouts))
+
+	for _, rollout := range rollouts {
+		rolloutMap[sqlchelpers.UUIDToStr(rollout.WorkflowId)] = rollout
+	}
+
+	resolved := make([]*dbsqlc.GetWorkflowVersionForEngineRow, len(latestVersions))
+	stableVersionIds := make([]pgtype.UUID, 0)
+
+	for i, version := range latestVersions {
+		resolved[i] = version
+
+		rollout, ok := rolloutMap[sqlchelpers.UUIDToStr(version.WorkflowVersion.WorkflowId)]
+
+		if !ok || rollout.StableVersionId == version.WorkflowVersion.ID || isCanaryTrigger(rollout.CanaryPercentage) {
+			continue
+		}
+
+		// mark the run to be resolved to the stable version after it has been fetched
+		resolved[i] = nil
+		stableVersionIds = append(stableVersionIds, rollout.StableVersionId)
+	}
+
+	if len(stableVersionIds) == 0 {
+		return resolved, nil
+	}
+
+	stableVersions, err := r.queries.GetWorkflowVersionForEngine(ctx, r.pool, dbsqlc.GetWorkflowVersionForEngineParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Ids:      stableVersionIds,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch stable workflow versions: %w", err)
+	}
+
+	stableVersionMap := make(map[string]*dbsqlc.GetWorkflowVersionForEngineRow, len(stableVersions))
+
+	for _, version := range stableVersions {
+		stableVersionMap[sqlchelpers.UUIDToStr(version.WorkflowVersion.WorkflowId)] = version
+	}
+
+	for i, version := range latestVersions {
+		if resolved[i] != nil {
+			continue
+		}
+
+		if stableVersion, ok := stableVersionMap[sqlchelpers.UUIDToStr(version.WorkflowVersion.WorkflowId)]; ok {
+			resolved[i] = stableVersion
+		} else {
+			resolved[i] = version
+		}
+	}
+
+	return resolved, nil
+}
+
+// isCanaryTrigger randomly decides whether a run is part of the canary percentage of a rollout.
+func isCanaryTrigger(canaryPercentage int32) bool {
+	return rand.Int31n(100) < canaryPercentage // nolint: gosec
+}
+
 func (r *workflowEngineRepository) GetWorkflowByName(ctx context.Context, tenantId, workflowName string) (*dbsqlc.Workflow, error) {
 	return r.queries.GetWorkflowByName(ctx, r.pool, dbsqlc.GetWorkflowByNameParams{
 		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
@@ -821,20 +989,22 @@ func (r *workflowEngineRepository) GetWorkflowsByNames(ctx context.Context, tena
 }
 
 func (r *workflowEngineRepository) GetWorkflowVersionById(ctx context.Context, tenantId, workflowId string) (*dbsqlc.GetWorkflowVersionForEngineRow, error) {
-	versions, err := r.queries.GetWorkflowVersionForEngine(ctx, r.pool, dbsqlc.GetWorkflowVersionForEngineParams{
-		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
-		Ids:      []pgtype.UUID{sqlchelpers.UUIDFromStr(workflowId)},
-	})
+	return getCachedDefinition(r.definitionCache, tenantId, "version-"+workflowId, func() (*dbsqlc.GetWorkflowVersionForEngineRow, error) {
+		versions, err := r.queries.GetWorkflowVersionForEngine(ctx, r.pool, dbsqlc.GetWorkflowVersionForEngineParams{
+			Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+			Ids:      []pgtype.UUID{sqlchelpers.UUIDFromStr(workflowId)},
+		})
 
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch workflow version: %w", err)
-	}
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch workflow version: %w", err)
+		}
 
-	if len(versions) != 1 {
-		return nil, fmt.Errorf("expected 1 workflow version when getting by id, got %d", len(versions))
-	}
+		if len(versions) != 1 {
+			return nil, fmt.Errorf("expected 1 workflow version when getting by id, got %d", len(versions))
+		}
 
-	return versions[0], nil
+		return versions[0], nil
+	})
 }
 
 func (r *workflowEngineRepository) ListWorkflowsForEvent(ctx context.Context, tenantId, eventKey string) ([]*dbsqlc.GetWorkflowVersionForEngineRow, error) {
@@ -879,7 +1049,36 @@ func (r *workflowEngineRepository) ListWorkflowsForEvent(ctx context.Context, te
 		return nil, err
 	}
 
-	return *cachedArr, nil
+	// rollouts are applied to every event, as the versions of the workflows are resolved per triggered run
+	return r.applyRollouts(ctx, tenantId, *cachedArr)
+}
+
+func (r *workflowAPIRepository) GetWorkflowRollout(ctx context.Context, workflowId string) (*dbsqlc.WorkflowRollout, error) {
+	return r.queries.GetWorkflowRollout(ctx, r.pool, sqlchelpers.UUIDFromStr(workflowId))
+}
+
+func (r *workflowAPIRepository) UpsertWorkflowRollout(ctx context.Context, workflowId string, opts *repository.UpsertWorkflowRolloutOpts) (*dbsqlc.WorkflowRollout, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	rollout, err := r.queries.UpsertWorkflowRollout(ctx, r.pool, dbsqlc.UpsertWorkflowRolloutParams{
+		Workflowid:       sqlchelpers.UUIDFromStr(workflowId),
+		Stableversionid:  sqlchelpers.UUIDFromStr(opts.StableVersionId),
+		Canarypercentage: int32(opts.CanaryPercentage), // nolint: gosec
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not upsert workflow rollout: %w", err)
+	}
+
+	return rollout, nil
+}
+
+func (r *workflowAPIRepository) DeleteWorkflowRollout(ctx context.Context, workflowId string) error {
+	_, err := r.queries.DeleteWorkflowRollout(ctx, r.pool, sqlchelpers.UUIDFromStr(workflowId))
+
+	return err
 }
 
 func (r *workflowAPIRepository) GetWorkflowWorkerCount(tenantId, workflowId string) (int, int, error) {
@@ -948,6 +1147,30 @@ func (r *workflowEngineRepository) createWorkflowVersionTxs(ctx context.Context,
 		}
 	}
 
+	if opts.Region != nil && *opts.Region != "" {
+		createParams.Region = sqlchelpers.TextFromStr(*opts.Region)
+		createParams.RegionStrategy = dbsqlc.NullRegionStrategy{
+			RegionStrategy: dbsqlc.RegionStrategyPREFER,
+			Valid:          true,
+		}
+
+		if opts.RegionStrategy != nil {
+			createParams.RegionStrategy.RegionStrategy = dbsqlc.RegionStrategy(*opts.RegionStrategy)
+		}
+	}
+
+	if opts.Output != nil {
+		createParams.OutputExpression = sqlchelpers.TextFromStr(*opts.Output)
+	}
+
+	if len(opts.InputSchema) > 0 {
+		createParams.InputSchema = opts.InputSchema
+	}
+
+	if opts.ReservedSlots != nil {
+		createParams.ReservedSlots = sqlchelpers.ToInt(*opts.ReservedSlots)
+	}
+
 	sqlcWorkflowVersion, err := r.queries.CreateWorkflowVersion(
 		ctx,
 		tx,
@@ -958,6 +1181,22 @@ func (r *workflowEngineRepository) createWorkflowVersionTxs(ctx context.Context,
 		return "", err
 	}
 
+	// store the definition which the version was registered with, so that it can be exported and recreated
+	definition, err := json.Marshal(opts)
+
+	if err != nil {
+		return "", fmt.Errorf("could not marshal workflow definition: %w", err)
+	}
+
+	err = r.queries.CreateWorkflowVersionDefinition(ctx, tx, dbsqlc.CreateWorkflowVersionDefinitionParams{
+		Workflowversionid: sqlcWorkflowVersion.ID,
+		Definition:        definition,
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("could not store workflow definition: %w", err)
+	}
+
 	// create concurrency group
 	if opts.Concurrency != nil {
 		params := dbsqlc.CreateWorkflowConcurrencyParams{
@@ -1077,16 +1316,42 @@ func (r *workflowEngineRepository) createWorkflowVersionTxs(ctx context.Context,
 		}
 	}
 
+	var cronExclusionCalendarId pgtype.UUID
+
+	if opts.CronExclusionCalendar != nil && len(opts.CronTriggers) > 0 {
+		calendar, err := r.queries.GetCronExclusionCalendarByName(ctx, tx, dbsqlc.GetCronExclusionCalendarByNameParams{
+			Tenantid: tenantId,
+			Name:     *opts.CronExclusionCalendar,
+		})
+
+		if err != nil {
+			return "", fmt.Errorf("could not get cron exclusion calendar %s: %w", *opts.CronExclusionCalendar, err)
+		}
+
+		cronExclusionCalendarId = calendar.ID
+	}
+
 	for _, cronTrigger := range opts.CronTriggers {
+		createCronParams := dbsqlc.CreateWorkflowTriggerCronRefParams{
+			Workflowtriggersid: sqlcWorkflowTriggers.ID,
+			Crontrigger:        cronTrigger,
+			Input:              opts.CronInput,
+		}
+
+		if opts.CronTimezone != nil {
+			createCronParams.Timezone = sqlchelpers.TextFromStr(*opts.CronTimezone)
+		}
+
+		if opts.CronJitterSeconds != nil {
+			createCronParams.JitterSeconds = pgtype.Int4{Int32: *opts.CronJitterSeconds, Valid: true}
+		}
+
+		createCronParams.ExclusionCalendarId = cronExclusionCalendarId
 
 		_, err := r.queries.CreateWorkflowTriggerCronRef(
 			ctx,
 			tx,
-			dbsqlc.CreateWorkflowTriggerCronRefParams{
-				Workflowtriggersid: sqlcWorkflowTriggers.ID,
-				Crontrigger:        cronTrigger,
-				Input:              opts.CronInput,
-			},
+			createCronParams,
 		)
 
 		if err != nil {
@@ -1220,10 +1485,16 @@ func (r *workflowEngineRepository) createJobTx(ctx context.Context, tx pgx.Tx, t
 			Retries:        retries,
 		}
 
-		if opts.ScheduleTimeout != nil {
+		if stepOpts.ScheduleTimeout != nil {
+			createStepParams.ScheduleTimeout = sqlchelpers.TextFromStr(*stepOpts.ScheduleTimeout)
+		} else if opts.ScheduleTimeout != nil {
 			createStepParams.ScheduleTimeout = sqlchelpers.TextFromStr(*opts.ScheduleTimeout)
 		}
 
+		if stepOpts.HeartbeatTimeout != nil {
+			createStepParams.HeartbeatTimeout = sqlchelpers.TextFromStr(*stepOpts.HeartbeatTimeout)
+		}
+
 		if stepOpts.RetryBackoffFactor != nil {
 			createStepParams.RetryBackoffFactor = pgtype.Float8{
 				Float64: *stepOpts.RetryBackoffFactor,
@@ -1238,6 +1509,70 @@ func (r *workflowEngineRepository) createJobTx(ctx context.Context, tx pgx.Tx, t
 			}
 		}
 
+		if stepOpts.RetryBackoffInitialSeconds != nil {
+			createStepParams.RetryInitialBackoff = pgtype.Int4{
+				Int32: int32(*stepOpts.RetryBackoffInitialSeconds), // nolint: gosec
+				Valid: true,
+			}
+		}
+
+		if stepOpts.RetryBackoffJitter != nil {
+			createStepParams.RetryJitter = pgtype.Float8{
+				Float64: *stepOpts.RetryBackoffJitter,
+				Valid:   true,
+			}
+		}
+
+		if stepOpts.SpeculativePercentile != nil {
+			createStepParams.SpeculativePercentile = pgtype.Int4{
+				Int32: *stepOpts.SpeculativePercentile,
+				Valid: true,
+			}
+		}
+
+		if stepOpts.SlotType != nil && *stepOpts.SlotType != "" && *stepOpts.SlotType != repository.DefaultSlotType {
+			createStepParams.SlotType = sqlchelpers.TextFromStr(*stepOpts.SlotType)
+		}
+
+		if stepOpts.SleepFor != nil {
+			createStepParams.SleepFor = sqlchelpers.TextFromStr(*stepOpts.SleepFor)
+		}
+
+		if stepOpts.SleepUntil != nil {
+			createStepParams.SleepUntil = sqlchelpers.TextFromStr(*stepOpts.SleepUntil)
+		}
+
+		if stepOpts.WaitForEvent != nil {
+			createStepParams.WaitForEvent = sqlchelpers.TextFromStr(*stepOpts.WaitForEvent)
+		}
+
+		if stepOpts.WaitForEventCorrelation != nil {
+			createStepParams.WaitForEventCorrelation = sqlchelpers.TextFromStr(*stepOpts.WaitForEventCorrelation)
+		}
+
+		if stepOpts.Condition != nil {
+			createStepParams.Condition = sqlchelpers.TextFromStr(*stepOpts.Condition)
+		}
+
+		if stepOpts.MapOver != nil {
+			createStepParams.MapOver = sqlchelpers.TextFromStr(*stepOpts.MapOver)
+		}
+
+		if stepOpts.MapConcurrency != nil {
+			createStepParams.MapConcurrency = pgtype.Int4{Int32: *stepOpts.MapConcurrency, Valid: true}
+		}
+
+		if stepOpts.CacheTTL != nil {
+			createStepParams.CacheTtl = sqlchelpers.TextFromStr(*stepOpts.CacheTTL)
+		}
+
+		// steps without their own pool run in the pool of the workflow
+		if stepOpts.Pool != nil && *stepOpts.Pool != "" {
+			createStepParams.Pool = sqlchelpers.TextFromStr(*stepOpts.Pool)
+		} else if opts.Pool != nil && *opts.Pool != "" {
+			createStepParams.Pool = sqlchelpers.TextFromStr(*opts.Pool)
+		}
+
 		_, err = r.queries.CreateStep(
 			ctx,
 			tx,
@@ -1319,6 +1654,30 @@ func (r *workflowEngineRepository) createJobTx(ctx context.Context, tx pgx.Tx, t
 			}
 
 			for _, rateLimit := range stepOpts.RateLimits {
+				// global rate limits are shared by all tenants, so they are only referenced by static keys
+				if rateLimit.Scope != nil && *rateLimit.Scope == "GLOBAL" {
+					if rateLimit.KeyExpr != nil || rateLimit.LimitExpr != nil || rateLimit.UnitsExpr != nil {
+						return "", fmt.Errorf("global rate limit %s cannot use expressions", rateLimit.Key)
+					}
+
+					_, err := r.queries.CreateStepGlobalRateLimit(
+						ctx,
+						tx,
+						dbsqlc.CreateStepGlobalRateLimitParams{
+							Stepid:       sqlchelpers.UUIDFromStr(stepId),
+							Ratelimitkey: rateLimit.Key,
+							Units:        int32(*rateLimit.Units), // nolint: gosec
+							Tenantid:     tenantId,
+						},
+					)
+
+					if err != nil {
+						return "", fmt.Errorf("could not create step global rate limit: %w", err)
+					}
+
+					continue
+				}
+
 				// if ANY of the step expressions are not nil, we create ALL options as expressions, but with static
 				// keys for any nil expressions.
 				if rateLimit.KeyExpr != nil || rateLimit.LimitExpr != nil || rateLimit.UnitsExpr != nil {
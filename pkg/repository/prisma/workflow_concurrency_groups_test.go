@@ -0,0 +1,92 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestListWorkflowConcurrencyGroups(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		version := createTestWorkflow(t, conf, tenantId, "concurrency-groups")
+		other := createTestWorkflow(t, conf, tenantId, "other-concurrency-groups")
+
+		workflowId := sqlchelpers.UUIDToStr(version.WorkflowVersion.WorkflowId)
+
+		runs := []struct {
+			groupKey *string
+			status   string
+		}{
+			{groupKey: repository.StringPtr("a"), status: "RUNNING"},
+			{groupKey: repository.StringPtr("a"), status: "QUEUED"},
+			{groupKey: repository.StringPtr("a"), status: "QUEUED"},
+			{groupKey: repository.StringPtr("b"), status: "RUNNING"},
+			{groupKey: repository.StringPtr("b"), status: "RUNNING"},
+			{groupKey: repository.StringPtr("c"), status: "QUEUED"},
+			// finished runs and runs without a group key aren't counted
+			{groupKey: repository.StringPtr("a"), status: "SUCCEEDED"},
+			{groupKey: repository.StringPtr("d"), status: "FAILED"},
+			{status: "RUNNING"},
+		}
+
+		for _, r := range runs {
+			run := createTestWorkflowRun(t, conf, tenantId, version)
+
+			_, err := conf.Pool.Exec(
+				ctx,
+				`UPDATE "WorkflowRun" SET "status" = $2::"WorkflowRunStatus", "concurrencyGroupId" = $3 WHERE "id" = $1`,
+				run.ID, r.status, r.groupKey,
+			)
+
+			require.NoError(t, err)
+		}
+
+		// the runs of other workflows aren't counted
+		otherRun := createTestWorkflowRun(t, conf, tenantId, other)
+
+		_, err := conf.Pool.Exec(ctx, `UPDATE "WorkflowRun" SET "status" = 'RUNNING', "concurrencyGroupId" = 'a' WHERE "id" = $1`, otherRun.ID)
+		require.NoError(t, err)
+
+		groups, err := conf.APIRepository.Workflow().ListWorkflowConcurrencyGroups(ctx, tenantId, workflowId, nil)
+		require.NoError(t, err)
+
+		type group struct {
+			key     string
+			running int64
+			queued  int64
+		}
+
+		actual := make([]group, 0, len(groups))
+
+		for _, g := range groups {
+			actual = append(actual, group{key: g.GroupKey, running: g.RunningCount, queued: g.QueuedCount})
+		}
+
+		// the groups with the most running runs come first
+		assert.Equal(t, []group{
+			{key: "b", running: 2, queued: 0},
+			{key: "a", running: 1, queued: 2},
+			{key: "c", running: 0, queued: 1},
+		}, actual)
+
+		limit := 1
+
+		groups, err = conf.APIRepository.Workflow().ListWorkflowConcurrencyGroups(ctx, tenantId, workflowId, &limit)
+		require.NoError(t, err)
+		require.Len(t, groups, 1)
+		assert.Equal(t, "b", groups[0].GroupKey)
+
+		return nil
+	})
+}
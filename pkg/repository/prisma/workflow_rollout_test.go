@@ -0,0 +1,151 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestWorkflowRollouts(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+
+		stable := createTestWorkflow(t, conf, tenantId, "rollout")
+
+		version := "v2"
+
+		latest, err := conf.EngineRepository.Workflow().CreateWorkflowVersion(ctx, tenantId, &repository.CreateWorkflowVersionOpts{
+			Name:    "rollout",
+			Version: &version,
+			Jobs: []repository.CreateWorkflowJobOpts{
+				{
+					Name: "job",
+					Kind: "DEFAULT",
+					Steps: []repository.CreateWorkflowStepOpts{
+						{
+							ReadableId: "step",
+							Action:     "test:step-v2",
+						},
+					},
+				},
+			},
+		}, stable)
+
+		require.NoError(t, err)
+
+		workflowId := sqlchelpers.UUIDToStr(stable.WorkflowVersion.WorkflowId)
+		stableId := sqlchelpers.UUIDToStr(stable.WorkflowVersion.ID)
+		latestId := sqlchelpers.UUIDToStr(latest.WorkflowVersion.ID)
+
+		// resolve triggers the workflow n times, and returns the number of runs per version id
+		resolve := func(t *testing.T, n int) map[string]int {
+			t.Helper()
+
+			workflowIds := make([]string, n)
+
+			for i := range workflowIds {
+				workflowIds[i] = workflowId
+			}
+
+			versions, err := conf.EngineRepository.Workflow().GetWorkflowVersionsForTrigger(ctx, tenantId, workflowIds)
+			require.NoError(t, err)
+			require.Len(t, versions, n, "one version must be returned per workflow id")
+
+			counts := make(map[string]int)
+
+			for _, v := range versions {
+				counts[sqlchelpers.UUIDToStr(v.WorkflowVersion.ID)]++
+			}
+
+			return counts
+		}
+
+		upsert := func(t *testing.T, stableVersionId string, canaryPercentage int) {
+			t.Helper()
+
+			_, err := conf.APIRepository.Workflow().UpsertWorkflowRollout(ctx, workflowId, &repository.UpsertWorkflowRolloutOpts{
+				StableVersionId:  stableVersionId,
+				CanaryPercentage: canaryPercentage,
+			})
+
+			require.NoError(t, err)
+		}
+
+		t.Run("without a rollout runs are triggered on the latest version", func(t *testing.T) {
+			assert.Equal(t, map[string]int{latestId: 20}, resolve(t, 20))
+		})
+
+		t.Run("runs outside of the canary are triggered on the stable version", func(t *testing.T) {
+			upsert(t, stableId, 0)
+			assert.Equal(t, map[string]int{stableId: 20}, resolve(t, 20))
+
+			rollout, err := conf.APIRepository.Workflow().GetWorkflowRollout(ctx, workflowId)
+			require.NoError(t, err)
+			assert.Equal(t, stableId, sqlchelpers.UUIDToStr(rollout.StableVersionId))
+			assert.Equal(t, int32(0), rollout.CanaryPercentage)
+		})
+
+		t.Run("a full canary triggers runs on the latest version", func(t *testing.T) {
+			upsert(t, stableId, 100)
+			assert.Equal(t, map[string]int{latestId: 20}, resolve(t, 20))
+		})
+
+		t.Run("runs are split between the versions", func(t *testing.T) {
+			upsert(t, stableId, 50)
+
+			// repeated workflow ids are resolved independently, so both versions are triggered
+			counts := resolve(t, 200)
+
+			assert.Greater(t, counts[stableId], 0)
+			assert.Greater(t, counts[latestId], 0)
+			assert.Equal(t, 200, counts[stableId]+counts[latestId])
+		})
+
+		t.Run("a stable version which is the latest version triggers the latest version", func(t *testing.T) {
+			upsert(t, latestId, 0)
+			assert.Equal(t, map[string]int{latestId: 20}, resolve(t, 20))
+		})
+
+		t.Run("invalid rollouts are rejected", func(t *testing.T) {
+			_, err := conf.APIRepository.Workflow().UpsertWorkflowRollout(ctx, workflowId, &repository.UpsertWorkflowRolloutOpts{
+				StableVersionId:  stableId,
+				CanaryPercentage: 101,
+			})
+
+			assert.Error(t, err)
+
+			_, err = conf.APIRepository.Workflow().UpsertWorkflowRollout(ctx, workflowId, &repository.UpsertWorkflowRolloutOpts{
+				StableVersionId: "not-a-uuid",
+			})
+
+			assert.Error(t, err)
+		})
+
+		t.Run("deleting the rollout triggers runs on the latest version again", func(t *testing.T) {
+			upsert(t, stableId, 0)
+
+			require.NoError(t, conf.APIRepository.Workflow().DeleteWorkflowRollout(ctx, workflowId))
+			assert.Equal(t, map[string]int{latestId: 20}, resolve(t, 20))
+
+			err := conf.APIRepository.Workflow().DeleteWorkflowRollout(ctx, workflowId)
+			assert.True(t, errors.Is(err, pgx.ErrNoRows), "deleting a missing rollout must return pgx.ErrNoRows, got %v", err)
+
+			_, err = conf.APIRepository.Workflow().GetWorkflowRollout(ctx, workflowId)
+			assert.True(t, errors.Is(err, pgx.ErrNoRows), "getting a missing rollout must return pgx.ErrNoRows, got %v", err)
+		})
+
+		return nil
+	})
+}
@@ -2,6 +2,7 @@ package prisma
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -18,6 +19,7 @@ import (
 
 	"github.com/hatchet-dev/hatchet/internal/datautils"
 	"github.com/hatchet-dev/hatchet/internal/services/shared/defaults"
+	"github.com/hatchet-dev/hatchet/internal/statemachine"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
 	"github.com/hatchet-dev/hatchet/pkg/config/server"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
@@ -30,30 +32,32 @@ import (
 )
 
 type workflowRunAPIRepository struct {
-	client  *db.PrismaClient
-	pool    *pgxpool.Pool
-	v       validator.Validator
-	queries *dbsqlc.Queries
-	l       *zerolog.Logger
-	m       *metered.Metered
-	cf      *server.ConfigFileRuntime
+	client      *db.PrismaClient
+	pool        *pgxpool.Pool
+	readReplica *ReadReplica
+	v           validator.Validator
+	queries     *dbsqlc.Queries
+	l           *zerolog.Logger
+	m           *metered.Metered
+	cf          *server.ConfigFileRuntime
 
 	createCallbacks []repository.TenantScopedCallback[*dbsqlc.WorkflowRun]
 
 	bulkCreateBuffer *buffer.TenantBufferManager[*repository.CreateWorkflowRunOpts, *dbsqlc.WorkflowRun]
 }
 
-func NewWorkflowRunRepository(client *db.PrismaClient, pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger, m *metered.Metered, cf *server.ConfigFileRuntime) (repository.WorkflowRunAPIRepository, func() error, error) {
+func NewWorkflowRunRepository(client *db.PrismaClient, pool *pgxpool.Pool, readReplica *ReadReplica, v validator.Validator, l *zerolog.Logger, m *metered.Metered, cf *server.ConfigFileRuntime) (repository.WorkflowRunAPIRepository, func() error, error) {
 	queries := dbsqlc.New()
 
 	w := workflowRunAPIRepository{
-		client:  client,
-		v:       v,
-		pool:    pool,
-		queries: queries,
-		l:       l,
-		m:       m,
-		cf:      cf,
+		client:      client,
+		v:           v,
+		pool:        pool,
+		readReplica: readReplica,
+		queries:     queries,
+		l:           l,
+		m:           m,
+		cf:          cf,
 	}
 
 	err := w.startBuffer(cf.WorkflowRunBuffer)
@@ -105,7 +109,7 @@ func (w *workflowRunAPIRepository) ListWorkflowRuns(ctx context.Context, tenantI
 		return nil, err
 	}
 
-	return listWorkflowRuns(ctx, w.pool, w.queries, w.l, tenantId, opts)
+	return listWorkflowRuns(ctx, w.readReplica.Pool(ctx), w.queries, w.l, tenantId, opts)
 }
 
 func (w *workflowRunAPIRepository) WorkflowRunMetricsCount(ctx context.Context, tenantId string, opts *repository.WorkflowRunsMetricsOpts) (*dbsqlc.WorkflowRunsMetricsCountRow, error) {
@@ -113,7 +117,7 @@ func (w *workflowRunAPIRepository) WorkflowRunMetricsCount(ctx context.Context,
 		return nil, err
 	}
 
-	return workflowRunMetricsCount(context.Background(), w.pool, w.queries, tenantId, opts)
+	return workflowRunMetricsCount(context.Background(), w.readReplica.Pool(ctx), w.queries, tenantId, opts)
 }
 
 func (w *workflowRunAPIRepository) ListScheduledWorkflows(ctx context.Context, tenantId string, opts *repository.ListScheduledWorkflowsOpts) ([]*dbsqlc.ListScheduledWorkflowsRow, int64, error) {
@@ -274,6 +278,8 @@ func (w *workflowRunEngineRepository) GetWorkflowRunInputData(tenantId, workflow
 }
 
 func (w *workflowRunAPIRepository) CreateNewWorkflowRun(ctx context.Context, tenantId string, opts *repository.CreateWorkflowRunOpts) (*dbsqlc.WorkflowRun, error) {
+	setTraceContext(ctx, opts)
+
 	return metered.MakeMetered(ctx, w.m, dbsqlc.LimitResourceWORKFLOWRUN, tenantId, 1, func() (*string, *dbsqlc.WorkflowRun, error) {
 		opts.TenantId = tenantId
 
@@ -632,6 +638,85 @@ func (w *workflowRunAPIRepository) GetStepRunsForJobRuns(ctx context.Context, te
 	return res, nil
 }
 
+func (w *workflowRunAPIRepository) PauseWorkflowRun(ctx context.Context, tenantId, workflowRunId string) error {
+	_, err := w.queries.PauseWorkflowRun(ctx, w.pool, dbsqlc.PauseWorkflowRunParams{
+		Workflowrunid: sqlchelpers.UUIDFromStr(workflowRunId),
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.ErrWorkflowRunNotRunning
+		}
+
+		return fmt.Errorf("could not pause workflow run: %w", err)
+	}
+
+	return nil
+}
+
+func (w *workflowRunAPIRepository) ResumeWorkflowRun(ctx context.Context, tenantId, workflowRunId string) error {
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, w.pool, w.l, 15000)
+
+	if err != nil {
+		return err
+	}
+
+	defer rollback()
+
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+	pgWorkflowRunId := sqlchelpers.UUIDFromStr(workflowRunId)
+
+	_, err = w.queries.ResumeWorkflowRun(ctx, tx, dbsqlc.ResumeWorkflowRunParams{
+		Workflowrunid: pgWorkflowRunId,
+		Tenantid:      pgTenantId,
+	})
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.ErrWorkflowRunNotPaused
+		}
+
+		return fmt.Errorf("could not resume workflow run: %w", err)
+	}
+
+	// the scheduler doesn't list the queue items of paused workflow runs, so their scheduling timeouts are
+	// restarted to give them the full timeout once the workflow run is resumed
+	err = w.queries.ResetQueueItemScheduleTimeoutsForWorkflowRun(ctx, tx, dbsqlc.ResetQueueItemScheduleTimeoutsForWorkflowRunParams{
+		Tenantid:      pgTenantId,
+		Workflowrunid: pgWorkflowRunId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not reset schedule timeouts of queue items: %w", err)
+	}
+
+	return commit(ctx)
+}
+
+func (w *workflowRunAPIRepository) PreflightCheckReplayFromStep(ctx context.Context, tenantId, workflowRunId, stepId string) error {
+	res, err := w.queries.PreflightCheckReplayFromStep(ctx, w.pool, dbsqlc.PreflightCheckReplayFromStepParams{
+		Stepid:        sqlchelpers.UUIDFromStr(stepId),
+		Workflowrunid: sqlchelpers.UUIDFromStr(workflowRunId),
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not preflight check replay from step: %w", err)
+	}
+
+	if !res.HasStepRun {
+		return repository.ErrPreflightReplayStepNotInWorkflowRun
+	}
+
+	// the outputs of all upstream steps are reused, so they must have succeeded
+	if res.UnsucceededUpstreamSteps > 0 {
+		return repository.ErrPreflightReplayUpstreamStepRunNotSucceeded
+	}
+
+	return nil
+}
+
 type workflowRunEngineRepository struct {
 	pool              *pgxpool.Pool
 	v                 validator.Validator
@@ -801,6 +886,75 @@ func (w *workflowRunEngineRepository) GetWorkflowRunAdditionalMeta(ctx context.C
 	})
 }
 
+func (w *workflowRunEngineRepository) ListWorkflowRunsForOutput(ctx context.Context, tenantId string, workflowRunIds []string) ([]*repository.WorkflowRunForOutput, error) {
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+	pgWorkflowRunIds := make([]pgtype.UUID, len(workflowRunIds))
+
+	for i := range workflowRunIds {
+		pgWorkflowRunIds[i] = sqlchelpers.UUIDFromStr(workflowRunIds[i])
+	}
+
+	workflowRuns, err := w.queries.ListWorkflowRunsForOutput(ctx, w.pool, dbsqlc.ListWorkflowRunsForOutputParams{
+		Workflowrunids: pgWorkflowRunIds,
+		Tenantid:       pgTenantId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list workflow runs for output: %w", err)
+	}
+
+	if len(workflowRuns) == 0 {
+		return nil, nil
+	}
+
+	res := make([]*repository.WorkflowRunForOutput, len(workflowRuns))
+	workflowRunIdToRes := make(map[string]*repository.WorkflowRunForOutput, len(workflowRuns))
+	pgWorkflowRunIds = make([]pgtype.UUID, len(workflowRuns))
+
+	for i, workflowRun := range workflowRuns {
+		res[i] = &repository.WorkflowRunForOutput{
+			ListWorkflowRunsForOutputRow: workflowRun,
+			StepOutputs:                  map[string][]byte{},
+		}
+
+		workflowRunIdToRes[sqlchelpers.UUIDToStr(workflowRun.WorkflowRunId)] = res[i]
+		pgWorkflowRunIds[i] = workflowRun.WorkflowRunId
+	}
+
+	stepRunOutputs, err := w.queries.ListStepRunOutputsForWorkflowRuns(ctx, w.pool, dbsqlc.ListStepRunOutputsForWorkflowRunsParams{
+		Workflowrunids: pgWorkflowRunIds,
+		Tenantid:       pgTenantId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list step run outputs: %w", err)
+	}
+
+	for _, stepRunOutput := range stepRunOutputs {
+		if workflowRun, ok := workflowRunIdToRes[sqlchelpers.UUIDToStr(stepRunOutput.WorkflowRunId)]; ok {
+			workflowRun.StepOutputs[stepRunOutput.StepReadableId.String] = stepRunOutput.Output
+		}
+	}
+
+	return res, nil
+}
+
+func (w *workflowRunEngineRepository) UpdateWorkflowRunOutputs(ctx context.Context, tenantId string, outputs map[string][]byte) error {
+	pgWorkflowRunIds := make([]pgtype.UUID, 0, len(outputs))
+	outputBytes := make([][]byte, 0, len(outputs))
+
+	for workflowRunId, output := range outputs {
+		pgWorkflowRunIds = append(pgWorkflowRunIds, sqlchelpers.UUIDFromStr(workflowRunId))
+		outputBytes = append(outputBytes, output)
+	}
+
+	return w.queries.UpdateWorkflowRunOutputs(ctx, w.pool, dbsqlc.UpdateWorkflowRunOutputsParams{
+		Workflowrunids: pgWorkflowRunIds,
+		Outputs:        outputBytes,
+		Tenantid:       sqlchelpers.UUIDFromStr(tenantId),
+	})
+}
+
 func (w *workflowRunEngineRepository) ListWorkflowRuns(ctx context.Context, tenantId string, opts *repository.ListWorkflowRunsOpts) (*repository.ListWorkflowRunsResult, error) {
 	if err := w.v.Validate(opts); err != nil {
 		return nil, err
@@ -897,6 +1051,61 @@ func (w *workflowRunEngineRepository) CreateDeDupeKey(ctx context.Context, tenan
 	return err
 }
 
+func (w *workflowRunEngineRepository) ClaimIdempotencyKey(ctx context.Context, tenantId, workflowVersionId, key, workflowRunId string) (*string, error) {
+	_, err := w.queries.ClaimWorkflowRunIdempotencyKey(ctx, w.pool, dbsqlc.ClaimWorkflowRunIdempotencyKeyParams{
+		Tenantid:          sqlchelpers.UUIDFromStr(tenantId),
+		Key:               key,
+		Workflowrunid:     sqlchelpers.UUIDFromStr(workflowRunId),
+		Expiresat:         sqlchelpers.TimestampFromTime(time.Now().UTC().Add(w.cf.IdempotencyKeyTTL)),
+		Workflowversionid: sqlchelpers.UUIDFromStr(workflowVersionId),
+	})
+
+	if err == nil {
+		return nil, nil
+	}
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("could not claim idempotency key: %w", err)
+	}
+
+	// the key is held by a workflow run which has not expired
+	existing, err := w.queries.GetWorkflowRunIdempotencyKey(ctx, w.pool, dbsqlc.GetWorkflowRunIdempotencyKeyParams{
+		Tenantid:          sqlchelpers.UUIDFromStr(tenantId),
+		Workflowversionid: sqlchelpers.UUIDFromStr(workflowVersionId),
+		Key:               key,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not get idempotency key: %w", err)
+	}
+
+	existingRunId := sqlchelpers.UUIDToStr(existing.WorkflowRunId)
+
+	return &existingRunId, nil
+}
+
+func (w *workflowRunEngineRepository) ReleaseIdempotencyKeys(ctx context.Context, tenantId string, workflowRunIds []string) error {
+	if len(workflowRunIds) == 0 {
+		return nil
+	}
+
+	pgWorkflowRunIds := make([]pgtype.UUID, len(workflowRunIds))
+
+	for i := range workflowRunIds {
+		pgWorkflowRunIds[i] = sqlchelpers.UUIDFromStr(workflowRunIds[i])
+	}
+
+	return w.queries.ReleaseWorkflowRunIdempotencyKeys(ctx, w.pool, dbsqlc.ReleaseWorkflowRunIdempotencyKeysParams{
+		Tenantid:       sqlchelpers.UUIDFromStr(tenantId),
+		Workflowrunids: pgWorkflowRunIds,
+	})
+}
+
+func (w *workflowRunEngineRepository) DeleteExpiredIdempotencyKeys(ctx context.Context, tenantId string) error {
+	_, err := w.queries.DeleteExpiredWorkflowRunIdempotencyKeys(ctx, w.pool, sqlchelpers.UUIDFromStr(tenantId))
+	return err
+}
+
 func (w *workflowRunEngineRepository) GetScheduledChildWorkflowRun(ctx context.Context, parentId, parentStepRunId string, childIndex int, childkey *string) (*dbsqlc.WorkflowTriggerScheduledRef, error) {
 	childParams := dbsqlc.GetScheduledChildWorkflowRunParams{
 		Parentid:        sqlchelpers.UUIDFromStr(parentId),
@@ -980,6 +1189,8 @@ func (w *workflowRunEngineRepository) CreateNewWorkflowRuns(ctx context.Context,
 		opt.TenantId = tenantId
 	}
 
+	setTraceContext(ctx, opts...)
+
 	wfrs, err := metered.MakeMetered(ctx, w.m, dbsqlc.LimitResourceWORKFLOWRUN, tenantId, int32(meteredAmount), func() (*string, *[]*dbsqlc.WorkflowRun, error) { // nolint: gosec
 
 		wfrs, err := createNewWorkflowRuns(ctx, w.pool, w.queries, w.l, opts)
@@ -1015,6 +1226,8 @@ func (w *workflowRunEngineRepository) CreateNewWorkflowRuns(ctx context.Context,
 }
 
 func (w *workflowRunEngineRepository) CreateNewWorkflowRun(ctx context.Context, tenantId string, opts *repository.CreateWorkflowRunOpts) (*dbsqlc.WorkflowRun, error) {
+	setTraceContext(ctx, opts)
+
 	wfr, err := metered.MakeMetered(ctx, w.m, dbsqlc.LimitResourceWORKFLOWRUN, tenantId, 1, func() (*string, *dbsqlc.WorkflowRun, error) {
 		opts.TenantId = tenantId
 
@@ -1150,14 +1363,7 @@ func (s *workflowRunEngineRepository) ReplayWorkflowRun(ctx context.Context, ten
 			}
 
 			// remove the previous step run result from the job lookup data
-			err = s.queries.UpdateJobRunLookupDataWithStepRun(
-				ctx,
-				tx,
-				dbsqlc.UpdateJobRunLookupDataWithStepRunParams{
-					Steprunid: stepRunId,
-					Tenantid:  sqlchelpers.UUIDFromStr(tenantId),
-				},
-			)
+			err = updateJobRunLookupDataWithStepRun(ctx, s.queries, tx, sqlchelpers.UUIDFromStr(tenantId), stepRunId, nil)
 
 			if err != nil {
 				return fmt.Errorf("error updating job run lookup data: %w", err)
@@ -1223,7 +1429,9 @@ func (s *workflowRunEngineRepository) UpdateWorkflowRunFromGroupKeyEval(ctx cont
 	pgWorkflowRunId := sqlchelpers.UUIDFromStr(workflowRunId)
 
 	updateParams := dbsqlc.UpdateWorkflowRunGroupKeyFromExprParams{
-		Workflowrunid: pgWorkflowRunId,
+		Workflowrunid:      pgWorkflowRunId,
+		Failedfromstatuses: statemachine.WorkflowRunSources(dbsqlc.WorkflowRunStatusFAILED),
+		Queuedfromstatuses: statemachine.WorkflowRunSources(dbsqlc.WorkflowRunStatusQUEUED),
 	}
 
 	eventParams := repository.CreateStepRunEventOpts{}
@@ -1306,6 +1514,17 @@ func listWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbsqlc.Q
 		countParams.WorkflowId = pgWorkflowId
 	}
 
+	if len(opts.WorkflowIds) > 0 {
+		pgWorkflowIds := make([]pgtype.UUID, len(opts.WorkflowIds))
+
+		for i, id := range opts.WorkflowIds {
+			pgWorkflowIds[i] = sqlchelpers.UUIDFromStr(id)
+		}
+
+		queryParams.WorkflowIds = pgWorkflowIds
+		countParams.WorkflowIds = pgWorkflowIds
+	}
+
 	if opts.WorkflowVersionId != nil {
 		pgWorkflowVersionId := sqlchelpers.UUIDFromStr(*opts.WorkflowVersionId)
 
@@ -1355,6 +1574,11 @@ func listWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbsqlc.Q
 		countParams.EventId = pgEventId
 	}
 
+	if opts.EventKey != nil {
+		queryParams.EventKey = sqlchelpers.TextFromStr(*opts.EventKey)
+		countParams.EventKey = sqlchelpers.TextFromStr(*opts.EventKey)
+	}
+
 	if opts.GroupKey != nil {
 		queryParams.GroupKey = sqlchelpers.TextFromStr(*opts.GroupKey)
 		countParams.GroupKey = sqlchelpers.TextFromStr(*opts.GroupKey)
@@ -1414,8 +1638,23 @@ func listWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbsqlc.Q
 		orderByDirection = *opts.OrderDirection
 	}
 
-	queryParams.Orderby = orderByField + " " + orderByDirection
-	countParams.Orderby = orderByField + " " + orderByDirection
+	orderBy := orderByField + " " + orderByDirection
+
+	queryParams.Orderby = orderBy
+	countParams.Orderby = orderBy
+
+	if opts.Cursor != nil {
+		cursor, err := decodeWorkflowRunCursor(*opts.Cursor, orderBy)
+
+		if err != nil {
+			return nil, err
+		}
+
+		queryParams.CursorId = cursor.Id
+		queryParams.CursorTime = cursor.Time
+		queryParams.CursorDuration = cursor.Duration
+		queryParams.Offset = 0
+	}
 
 	tx, err := pool.Begin(ctx)
 
@@ -1431,10 +1670,16 @@ func listWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbsqlc.Q
 		return nil, err
 	}
 
-	count, err := queries.CountWorkflowRuns(ctx, tx, countParams)
+	// the runs aren't counted when paging through them with a cursor, so the count is left unset
+	if opts.Cursor == nil {
+		count, err := queries.CountWorkflowRuns(ctx, tx, countParams)
 
-	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
-		return nil, err
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+
+		countInt := int(count)
+		res.Count = &countInt
 	}
 
 	err = tx.Commit(ctx)
@@ -1444,11 +1689,87 @@ func listWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbsqlc.Q
 	}
 
 	res.Rows = workflowRuns
-	res.Count = int(count)
+
+	limit := 50
+
+	if opts.Limit != nil {
+		limit = *opts.Limit
+	}
+
+	if len(workflowRuns) > 0 && len(workflowRuns) == limit {
+		nextCursor, err := encodeWorkflowRunCursor(&workflowRuns[len(workflowRuns)-1].WorkflowRun, orderBy)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res.NextCursor = &nextCursor
+	}
 
 	return res, nil
 }
 
+// workflowRunCursor is the position of a workflow run in a sort order of workflow runs. It is encoded as base64 json,
+// which is opaque to clients.
+type workflowRunCursor struct {
+	OrderBy string `json:"o"`
+
+	Id pgtype.UUID `json:"i"`
+
+	// the sort value of the workflow run when sorting by a time, which is null if the time isn't set
+	Time pgtype.Timestamp `json:"t"`
+
+	// the sort value of the workflow run when sorting by duration, which is null if the duration isn't set
+	Duration pgtype.Int8 `json:"d"`
+}
+
+func encodeWorkflowRunCursor(run *dbsqlc.WorkflowRun, orderBy string) (string, error) {
+	cursor := workflowRunCursor{
+		OrderBy: orderBy,
+		Id:      run.ID,
+	}
+
+	switch field, _, _ := strings.Cut(orderBy, " "); field {
+	case "createdAt":
+		cursor.Time = run.CreatedAt
+	case "finishedAt":
+		cursor.Time = run.FinishedAt
+	case "startedAt":
+		cursor.Time = run.StartedAt
+	case "duration":
+		cursor.Duration = run.Duration
+	}
+
+	cursorBytes, err := json.Marshal(cursor)
+
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(cursorBytes), nil
+}
+
+func decodeWorkflowRunCursor(encoded, orderBy string) (*workflowRunCursor, error) {
+	cursorBytes, err := base64.RawURLEncoding.DecodeString(encoded)
+
+	if err != nil {
+		return nil, repository.ErrInvalidWorkflowRunCursor
+	}
+
+	cursor := &workflowRunCursor{}
+
+	if err := json.Unmarshal(cursorBytes, cursor); err != nil || !cursor.Id.Valid {
+		return nil, repository.ErrInvalidWorkflowRunCursor
+	}
+
+	// a cursor is only meaningful in the sort order which it was returned for
+	if cursor.OrderBy != orderBy {
+		return nil, repository.ErrInvalidWorkflowRunCursor
+	}
+
+	return cursor, nil
+}
+
 func workflowRunMetricsCount(ctx context.Context, pool *pgxpool.Pool, queries *dbsqlc.Queries, tenantId string, opts *repository.WorkflowRunsMetricsOpts) (*dbsqlc.WorkflowRunsMetricsCountRow, error) {
 
 	pgTenantId := &pgtype.UUID{}
@@ -1541,12 +1862,20 @@ func createNewWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbs
 		var triggeredByParams []dbsqlc.CreateWorkflowRunTriggeredBysParams
 		var groupKeyParams []dbsqlc.CreateGetGroupKeyRunsParams
 		var jobRunParams []dbsqlc.CreateJobRunsParams
+		var reservationParams dbsqlc.CreateSlotReservationsParams
+		var deadlineParams dbsqlc.SetStepRunDeadlinesForWorkflowRunsParams
+		var replayedFromParams dbsqlc.SetWorkflowRunsReplayedFromParams
+		var traceContextParams dbsqlc.SetWorkflowRunsTraceContextParams
 
 		for order, opt := range inputOpts {
 
 			// begin a transaction
 			workflowRunId := uuid.New().String()
 
+			if opt.Id != nil {
+				workflowRunId = *opt.Id
+			}
+
 			workflowRunOptsMap[workflowRunId] = opt
 
 			defer rollback()
@@ -1625,6 +1954,35 @@ func createNewWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbs
 
 			createRunsParams = append(createRunsParams, crp)
 
+			if opt.ReservedSlots != nil {
+				reservationParams.Workflowrunids = append(reservationParams.Workflowrunids, sqlchelpers.UUIDFromStr(workflowRunId))
+				reservationParams.Tenantids = append(reservationParams.Tenantids, sqlchelpers.UUIDFromStr(opt.TenantId))
+				reservationParams.Slots = append(reservationParams.Slots, *opt.ReservedSlots)
+				reservationParams.Expiresats = append(reservationParams.Expiresats, sqlchelpers.TimestampFromTime(time.Now().UTC().Add(defaults.DefaultSlotReservationTimeout)))
+			}
+
+			if opt.Deadline != nil {
+				deadlineParams.Workflowrunids = append(deadlineParams.Workflowrunids, sqlchelpers.UUIDFromStr(workflowRunId))
+				deadlineParams.Deadlines = append(deadlineParams.Deadlines, sqlchelpers.TimestampFromTime(opt.Deadline.UTC()))
+			}
+
+			if opt.ReplayedFromId != nil {
+				replayedFromParams.Workflowrunids = append(replayedFromParams.Workflowrunids, sqlchelpers.UUIDFromStr(workflowRunId))
+				replayedFromParams.Replayedfromids = append(replayedFromParams.Replayedfromids, sqlchelpers.UUIDFromStr(*opt.ReplayedFromId))
+				replayedFromParams.Replayedfromstepids = append(replayedFromParams.Replayedfromstepids, sqlchelpers.UUIDFromStr(*opt.ReplayedFromStepId))
+			}
+
+			if len(opt.TraceContext) > 0 {
+				traceContextBytes, err := json.Marshal(opt.TraceContext)
+
+				if err != nil {
+					return nil, err
+				}
+
+				traceContextParams.Workflowrunids = append(traceContextParams.Workflowrunids, sqlchelpers.UUIDFromStr(workflowRunId))
+				traceContextParams.Tracecontexts = append(traceContextParams.Tracecontexts, traceContextBytes)
+			}
+
 			var desiredWorkerId pgtype.UUID
 
 			if opt.DesiredWorkerId != nil {
@@ -1708,6 +2066,22 @@ func createNewWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbs
 			return nil, err
 		}
 
+		if len(replayedFromParams.Workflowrunids) > 0 {
+			err = queries.SetWorkflowRunsReplayedFrom(tx1Ctx, tx, replayedFromParams)
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to set replayed from workflow runs: %w", err)
+			}
+		}
+
+		if len(traceContextParams.Workflowrunids) > 0 {
+			err = queries.SetWorkflowRunsTraceContext(tx1Ctx, tx, traceContextParams)
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to set trace context of workflow runs: %w", err)
+			}
+		}
+
 		workflowRuns, err := queries.GetWorkflowRunsInsertedInThisTxn(tx1Ctx, tx)
 
 		if err != nil {
@@ -1766,6 +2140,14 @@ func createNewWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbs
 
 		}
 
+		if len(reservationParams.Workflowrunids) > 0 {
+			err = queries.CreateSlotReservations(tx1Ctx, tx, reservationParams)
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to create slot reservations: %w", err)
+			}
+		}
+
 		if len(groupKeyParams) > 0 {
 
 			_, err = queries.CreateGetGroupKeyRuns(
@@ -1863,26 +2245,35 @@ func createNewWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbs
 				return nil, err
 			}
 
-			stepRunIds, err := queries.CreateStepRunsForJobRunIds(tx1Ctx, tx, dbsqlc.CreateStepRunsForJobRunIdsParams{
-				Jobrunids: jobRunIds,
-				Priority:  1,
-			},
-			)
+			err = createStepRuns(tx1Ctx, queries, tx, jobRunIds, 1)
 
 			if err != nil {
 				l.Error().Err(err).Msg("failed to create step runs")
 				return nil, err
 			}
 
-			err = queries.LinkStepRunParents(
-				tx1Ctx,
-				tx,
-				stepRunIds,
-			)
+			if len(deadlineParams.Workflowrunids) > 0 {
+				err = queries.SetStepRunDeadlinesForWorkflowRuns(tx1Ctx, tx, deadlineParams)
 
-			if err != nil {
-				l.Err(err).Msg("failed to link step run parents")
-				return nil, err
+				if err != nil {
+					l.Err(err).Msg("failed to set step run deadlines")
+					return nil, err
+				}
+			}
+
+			for i := range replayedFromParams.Workflowrunids {
+				err = reuseUpstreamStepRunOutputs(
+					tx1Ctx,
+					queries,
+					tx,
+					replayedFromParams.Workflowrunids[i],
+					workflowRunOptsMap[sqlchelpers.UUIDToStr(replayedFromParams.Workflowrunids[i])],
+				)
+
+				if err != nil {
+					l.Err(err).Msg("failed to reuse upstream step run outputs")
+					return nil, err
+				}
 			}
 
 		}
@@ -1904,6 +2295,152 @@ func createNewWorkflowRuns(ctx context.Context, pool *pgxpool.Pool, queries *dbs
 	return sqlcWorkflowRuns, nil
 }
 
+// createStepRuns creates the step runs of job runs and links them to their parents. The rows are copied in bulk
+// instead of being inserted by a query, which is much faster for job runs with many steps.
+func createStepRuns(ctx context.Context, queries *dbsqlc.Queries, tx dbsqlc.DBTX, jobRunIds []pgtype.UUID, priority int32) error {
+	steps, err := queries.ListStepsForJobRuns(ctx, tx, jobRunIds)
+
+	if err != nil {
+		return fmt.Errorf("could not list steps of job runs: %w", err)
+	}
+
+	if len(steps) == 0 {
+		return nil
+	}
+
+	stepRunParams := make([]dbsqlc.CreateStepRunsParams, 0, len(steps))
+	stepIds := make([]pgtype.UUID, 0, len(steps))
+	seenStepIds := make(map[string]bool)
+
+	// the ids of the step runs by job run and step
+	stepRunIds := make(map[string]map[string]pgtype.UUID)
+
+	for _, step := range steps {
+		jobRunId := sqlchelpers.UUIDToStr(step.JobRunId)
+		stepId := sqlchelpers.UUIDToStr(step.StepId)
+		stepRunId := sqlchelpers.UUIDFromStr(uuid.New().String())
+
+		stepRunParams = append(stepRunParams, dbsqlc.CreateStepRunsParams{
+			ID:       stepRunId,
+			TenantId: step.TenantId,
+			JobRunId: step.JobRunId,
+			StepId:   step.StepId,
+			Status:   dbsqlc.StepRunStatusPENDING,
+			Queue:    step.ActionId,
+			Priority: sqlchelpers.ToInt(priority),
+		})
+
+		if stepRunIds[jobRunId] == nil {
+			stepRunIds[jobRunId] = make(map[string]pgtype.UUID)
+		}
+
+		stepRunIds[jobRunId][stepId] = stepRunId
+
+		if !seenStepIds[stepId] {
+			seenStepIds[stepId] = true
+			stepIds = append(stepIds, step.StepId)
+		}
+	}
+
+	if _, err := queries.CreateStepRuns(ctx, tx, stepRunParams); err != nil {
+		return fmt.Errorf("could not copy step runs: %w", err)
+	}
+
+	stepOrders, err := queries.ListStepOrders(ctx, tx, stepIds)
+
+	if err != nil {
+		return fmt.Errorf("could not list step orders: %w", err)
+	}
+
+	if len(stepOrders) == 0 {
+		return nil
+	}
+
+	orderParams := make([]dbsqlc.CreateStepRunOrdersParams, 0, len(stepOrders)*len(stepRunIds))
+
+	for _, jobRunStepRunIds := range stepRunIds {
+		for _, order := range stepOrders {
+			parentId, ok := jobRunStepRunIds[sqlchelpers.UUIDToStr(order.A)]
+
+			if !ok {
+				continue
+			}
+
+			childId, ok := jobRunStepRunIds[sqlchelpers.UUIDToStr(order.B)]
+
+			if !ok {
+				continue
+			}
+
+			orderParams = append(orderParams, dbsqlc.CreateStepRunOrdersParams{
+				A: parentId,
+				B: childId,
+			})
+		}
+	}
+
+	if _, err := queries.CreateStepRunOrders(ctx, tx, orderParams); err != nil {
+		return fmt.Errorf("could not copy step run orders: %w", err)
+	}
+
+	return nil
+}
+
+// setTraceContext sets the trace context of ctx on the options which don't have one. This happens before workflow
+// runs are buffered, since the buffer creates them without the context of their callers.
+func setTraceContext(ctx context.Context, opts ...*repository.CreateWorkflowRunOpts) {
+	traceContext := telemetry.GetTraceContext(ctx)
+
+	if traceContext == nil {
+		return
+	}
+
+	for _, opt := range opts {
+		if opt.TraceContext == nil {
+			opt.TraceContext = traceContext
+		}
+	}
+}
+
+// reuseUpstreamStepRunOutputs marks the step runs upstream of the step which a workflow run is replayed from as
+// succeeded with the outputs of the replayed workflow run, and adds the outputs to the job run lookup data so the
+// step is started with them.
+func reuseUpstreamStepRunOutputs(ctx context.Context, queries *dbsqlc.Queries, tx pgx.Tx, workflowRunId pgtype.UUID, opt *repository.CreateWorkflowRunOpts) error {
+	stepId := sqlchelpers.UUIDFromStr(*opt.ReplayedFromStepId)
+
+	reused, err := queries.ReuseUpstreamStepRunOutputs(ctx, tx, dbsqlc.ReuseUpstreamStepRunOutputsParams{
+		Stepid:         stepId,
+		Replayedfromid: sqlchelpers.UUIDFromStr(*opt.ReplayedFromId),
+		Workflowrunid:  workflowRunId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("could not reuse upstream step run outputs: %w", err)
+	}
+
+	for _, stepRun := range reused {
+		err = updateJobRunLookupDataWithStepRun(ctx, queries, tx, sqlchelpers.UUIDFromStr(opt.TenantId), stepRun.ID, stepRun.Output)
+
+		if err != nil {
+			return fmt.Errorf("could not update job run lookup data: %w", err)
+		}
+	}
+
+	if len(opt.ReplayedFromStepInput) > 0 {
+		err = queries.SetReplayedStepRunInput(ctx, tx, dbsqlc.SetReplayedStepRunInputParams{
+			Input:         opt.ReplayedFromStepInput,
+			Workflowrunid: workflowRunId,
+			Stepid:        stepId,
+		})
+
+		if err != nil {
+			return fmt.Errorf("could not set input of replayed step run: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func isUniqueViolationOnDedupe(err error) bool {
 	if err == nil {
 		return false
@@ -0,0 +1,218 @@
+package prisma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+// archiveTimeoutMs is the statement timeout of archiving a workflow run, which includes writing the archive
+const archiveTimeoutMs = 60000
+
+type workflowRunArchiveRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewWorkflowRunArchiveRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.WorkflowRunArchiveRepository {
+	queries := dbsqlc.New()
+
+	return &workflowRunArchiveRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *workflowRunArchiveRepository) ListWorkflowRunsToArchive(ctx context.Context, tenantId string, finishedBefore time.Time, limit int) ([]string, error) {
+	ids, err := r.queries.ListWorkflowRunsToArchive(ctx, r.pool, dbsqlc.ListWorkflowRunsToArchiveParams{
+		Tenantid:       sqlchelpers.UUIDFromStr(tenantId),
+		Finishedbefore: sqlchelpers.TimestampFromTime(finishedBefore),
+		Batchsize:      int32(limit), // nolint: gosec
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, len(ids))
+
+	for i, id := range ids {
+		res[i] = sqlchelpers.UUIDToStr(id)
+	}
+
+	return res, nil
+}
+
+func (r *workflowRunArchiveRepository) ArchiveWorkflowRun(ctx context.Context, tenantId, workflowRunId string, write func(details *repository.WorkflowRunDetails) (string, int, error)) (bool, error) {
+	pgTenantId := sqlchelpers.UUIDFromStr(tenantId)
+	pgWorkflowRunId := sqlchelpers.UUIDFromStr(workflowRunId)
+
+	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, r.pool, r.l, archiveTimeoutMs)
+
+	if err != nil {
+		return false, err
+	}
+
+	defer rollback()
+
+	// the workflow run is locked until the transaction ends, so it's only archived by one engine
+	run, err := r.queries.LockWorkflowRunForArchive(ctx, tx, dbsqlc.LockWorkflowRunForArchiveParams{
+		Workflowrunid: pgWorkflowRunId,
+		Tenantid:      pgTenantId,
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("could not lock workflow run: %w", err)
+	}
+
+	details, err := r.getDetails(ctx, tx, pgTenantId, pgWorkflowRunId)
+
+	if err != nil {
+		return false, err
+	}
+
+	details.TenantId = tenantId
+	details.WorkflowRunId = workflowRunId
+	details.Input = run.Input
+	details.Output = run.Output
+
+	key, size, err := write(details)
+
+	if err != nil {
+		return false, fmt.Errorf("could not write archive: %w", err)
+	}
+
+	_, err = r.queries.CreateWorkflowRunArchive(ctx, tx, dbsqlc.CreateWorkflowRunArchiveParams{
+		Workflowrunid: pgWorkflowRunId,
+		Tenantid:      pgTenantId,
+		Key:           key,
+		Size:          int32(size), // nolint: gosec
+	})
+
+	if err != nil {
+		return false, fmt.Errorf("could not delete archived details: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// getDetails reads the details of a workflow run, as they're returned by the API.
+func (r *workflowRunArchiveRepository) getDetails(ctx context.Context, tx pgx.Tx, tenantId, workflowRunId pgtype.UUID) (*repository.WorkflowRunDetails, error) {
+	jobRuns, err := r.queries.ListJobRunsForWorkflowRunFull(ctx, tx, dbsqlc.ListJobRunsForWorkflowRunFullParams{
+		Tenantid:      tenantId,
+		Workflowrunid: workflowRunId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list job runs: %w", err)
+	}
+
+	jobRunIds := make([]pgtype.UUID, len(jobRuns))
+
+	for i, jobRun := range jobRuns {
+		jobRunIds[i] = jobRun.ID
+	}
+
+	stepRuns, err := r.queries.GetStepRunsForJobRunsWithOutput(ctx, tx, dbsqlc.GetStepRunsForJobRunsWithOutputParams{
+		Tenantid: tenantId,
+		Jobids:   jobRunIds,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list step runs: %w", err)
+	}
+
+	stepRunIds := make([]pgtype.UUID, len(stepRuns))
+
+	for i, stepRun := range stepRuns {
+		stepRunIds[i] = stepRun.ID
+	}
+
+	childCounts, err := r.queries.ListChildWorkflowRunCounts(ctx, tx, stepRunIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not count child workflow runs: %w", err)
+	}
+
+	stepRunIdToChildCount := make(map[string]int)
+
+	for _, childCount := range childCounts {
+		stepRunIdToChildCount[sqlchelpers.UUIDToStr(childCount.ParentStepRunId)] = int(childCount.Count)
+	}
+
+	stepRunsForJobRuns := make([]*repository.StepRunForJobRun, len(stepRuns))
+
+	for i, stepRun := range stepRuns {
+		stepRunsForJobRuns[i] = &repository.StepRunForJobRun{
+			GetStepRunsForJobRunsWithOutputRow: stepRun,
+			ChildWorkflowsCount:                stepRunIdToChildCount[sqlchelpers.UUIDToStr(stepRun.ID)],
+		}
+	}
+
+	workflowRunEvents, err := r.queries.ListWorkflowRunEventsByWorkflowRunId(ctx, tx, workflowRunId)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list workflow run events: %w", err)
+	}
+
+	stepRunEvents, err := r.queries.ListStepRunEventsByWorkflowRunId(ctx, tx, dbsqlc.ListStepRunEventsByWorkflowRunIdParams{
+		Workflowrunid: workflowRunId,
+		Tenantid:      tenantId,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list step run events: %w", err)
+	}
+
+	// an event of a step run can reference the workflow run as well
+	events := make([]*dbsqlc.StepRunEvent, 0, len(workflowRunEvents)+len(stepRunEvents))
+	seen := make(map[int64]bool)
+
+	for _, event := range append(workflowRunEvents, stepRunEvents...) {
+		if seen[event.ID] {
+			continue
+		}
+
+		seen[event.ID] = true
+		events = append(events, event)
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].ID > events[j].ID
+	})
+
+	return &repository.WorkflowRunDetails{
+		JobRuns:       jobRuns,
+		StepRuns:      stepRunsForJobRuns,
+		StepRunEvents: events,
+	}, nil
+}
+
+func (r *workflowRunArchiveRepository) GetWorkflowRunArchive(ctx context.Context, tenantId, workflowRunId string) (*dbsqlc.WorkflowRunArchive, error) {
+	return r.queries.GetWorkflowRunArchive(ctx, r.pool, dbsqlc.GetWorkflowRunArchiveParams{
+		Workflowrunid: sqlchelpers.UUIDFromStr(workflowRunId),
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+	})
+}
@@ -0,0 +1,85 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestArchiveWorkflowRun(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		version := createTestWorkflow(t, conf, tenantId, "archive")
+
+		finished := createTestWorkflowRun(t, conf, tenantId, version)
+		running := createTestWorkflowRun(t, conf, tenantId, version)
+
+		finishedId := sqlchelpers.UUIDToStr(finished.ID)
+		runningId := sqlchelpers.UUIDToStr(running.ID)
+
+		_, err := conf.Pool.Exec(
+			ctx,
+			`UPDATE "WorkflowRun" SET "status" = 'SUCCEEDED', "finishedAt" = NOW() - INTERVAL '2 hours' WHERE "id" = $1`,
+			finished.ID,
+		)
+		require.NoError(t, err)
+
+		_, err = conf.Pool.Exec(ctx, `UPDATE "WorkflowRun" SET "status" = 'RUNNING' WHERE "id" = $1`, running.ID)
+		require.NoError(t, err)
+
+		archives := conf.EngineRepository.WorkflowRunArchive()
+
+		// only the finished run is listed
+		ids, err := archives.ListWorkflowRunsToArchive(ctx, tenantId, time.Now().Add(-time.Hour), 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{finishedId}, ids)
+
+		var written *repository.WorkflowRunDetails
+
+		write := func(details *repository.WorkflowRunDetails) (string, int, error) {
+			written = details
+			return "archives/" + details.WorkflowRunId, 42, nil
+		}
+
+		ok, err := archives.ArchiveWorkflowRun(ctx, tenantId, finishedId, write)
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		require.NotNil(t, written)
+		assert.Equal(t, finishedId, written.WorkflowRunId)
+		assert.Len(t, written.JobRuns, 1)
+		assert.Len(t, written.StepRuns, 1)
+
+		archive, err := archives.GetWorkflowRunArchive(ctx, tenantId, finishedId)
+		require.NoError(t, err)
+		assert.Equal(t, "archives/"+finishedId, archive.Key)
+		assert.Equal(t, int32(42), archive.Size)
+
+		// archived runs aren't listed or archived again
+		ids, err = archives.ListWorkflowRunsToArchive(ctx, tenantId, time.Now().Add(-time.Hour), 10)
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+
+		ok, err = archives.ArchiveWorkflowRun(ctx, tenantId, finishedId, write)
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		// runs which aren't finished aren't archived
+		ok, err = archives.ArchiveWorkflowRun(ctx, tenantId, runningId, write)
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		return nil
+	})
+}
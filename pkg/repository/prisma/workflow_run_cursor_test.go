@@ -0,0 +1,103 @@
+package prisma
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestWorkflowRunCursor(t *testing.T) {
+	createdAt := time.Date(2024, 10, 1, 12, 0, 0, 123456000, time.UTC)
+	startedAt := createdAt.Add(time.Second)
+	finishedAt := createdAt.Add(time.Minute)
+
+	finished := &dbsqlc.WorkflowRun{
+		ID:         sqlchelpers.UUIDFromStr(uuid.New().String()),
+		CreatedAt:  sqlchelpers.TimestampFromTime(createdAt),
+		StartedAt:  sqlchelpers.TimestampFromTime(startedAt),
+		FinishedAt: sqlchelpers.TimestampFromTime(finishedAt),
+		Duration:   pgtype.Int8{Int64: finishedAt.Sub(startedAt).Milliseconds(), Valid: true},
+	}
+
+	// a run which hasn't started has no start or finish time and no duration
+	queued := &dbsqlc.WorkflowRun{
+		ID:        sqlchelpers.UUIDFromStr(uuid.New().String()),
+		CreatedAt: sqlchelpers.TimestampFromTime(createdAt),
+	}
+
+	tests := []struct {
+		name     string
+		run      *dbsqlc.WorkflowRun
+		orderBy  string
+		time     pgtype.Timestamp
+		duration pgtype.Int8
+	}{
+		{name: "created at ascending", run: finished, orderBy: "createdAt ASC", time: finished.CreatedAt},
+		{name: "created at descending", run: finished, orderBy: "createdAt DESC", time: finished.CreatedAt},
+		{name: "finished at", run: finished, orderBy: "finishedAt DESC", time: finished.FinishedAt},
+		{name: "null finished at", run: queued, orderBy: "finishedAt ASC"},
+		{name: "started at", run: finished, orderBy: "startedAt ASC", time: finished.StartedAt},
+		{name: "null started at", run: queued, orderBy: "startedAt DESC"},
+		{name: "duration", run: finished, orderBy: "duration DESC", duration: finished.Duration},
+		{name: "null duration", run: queued, orderBy: "duration ASC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := encodeWorkflowRunCursor(tt.run, tt.orderBy)
+			require.NoError(t, err)
+
+			cursor, err := decodeWorkflowRunCursor(encoded, tt.orderBy)
+			require.NoError(t, err)
+
+			assert.Equal(t, &workflowRunCursor{
+				OrderBy:  tt.orderBy,
+				Id:       tt.run.ID,
+				Time:     tt.time,
+				Duration: tt.duration,
+			}, cursor)
+
+			// the cursor is rejected in the other direction and in the other sort orders
+			for _, orderBy := range []string{
+				"createdAt ASC", "createdAt DESC",
+				"finishedAt ASC", "finishedAt DESC",
+				"startedAt ASC", "startedAt DESC",
+				"duration ASC", "duration DESC",
+			} {
+				if orderBy == tt.orderBy {
+					continue
+				}
+
+				_, err := decodeWorkflowRunCursor(encoded, orderBy)
+				assert.ErrorIs(t, err, repository.ErrInvalidWorkflowRunCursor, "cursor for %s accepted for %s", tt.orderBy, orderBy)
+			}
+		})
+	}
+}
+
+func TestWorkflowRunCursor_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		encoded string
+	}{
+		{name: "not base64", encoded: "not a cursor!"},
+		{name: "not json", encoded: base64.RawURLEncoding.EncodeToString([]byte("cursor"))},
+		{name: "without id", encoded: base64.RawURLEncoding.EncodeToString([]byte(`{"o":"createdAt DESC"}`))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := decodeWorkflowRunCursor(tt.encoded, "createdAt DESC")
+			assert.ErrorIs(t, err, repository.ErrInvalidWorkflowRunCursor)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package prisma
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+	"github.com/hatchet-dev/hatchet/pkg/validator"
+)
+
+type workflowRunEventLogRepository struct {
+	pool    *pgxpool.Pool
+	v       validator.Validator
+	queries *dbsqlc.Queries
+	l       *zerolog.Logger
+}
+
+func NewWorkflowRunEventLogRepository(pool *pgxpool.Pool, v validator.Validator, l *zerolog.Logger) repository.WorkflowRunEventLogRepository {
+	queries := dbsqlc.New()
+
+	return &workflowRunEventLogRepository{
+		pool:    pool,
+		v:       v,
+		queries: queries,
+		l:       l,
+	}
+}
+
+func (r *workflowRunEventLogRepository) AppendWorkflowRunEvent(ctx context.Context, tenantId, workflowRunId, key string, message []byte) (int64, error) {
+	id, err := r.queries.AppendWorkflowRunEventLog(ctx, r.pool, dbsqlc.AppendWorkflowRunEventLogParams{
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Workflowrunid: sqlchelpers.UUIDFromStr(workflowRunId),
+		Key:           key,
+		Message:       message,
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("could not append workflow run event: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *workflowRunEventLogRepository) ListWorkflowRunEvents(ctx context.Context, tenantId string, opts *repository.ListWorkflowRunEventLogsOpts) ([]*dbsqlc.WorkflowRunEventLog, error) {
+	if err := r.v.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	params := dbsqlc.ListWorkflowRunEventLogsParams{
+		Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+		Afterid:  opts.AfterId,
+		Limit:    1000,
+	}
+
+	if opts.WorkflowRunIds != nil {
+		params.WorkflowRunIds = make([]pgtype.UUID, len(opts.WorkflowRunIds))
+
+		for i, id := range opts.WorkflowRunIds {
+			params.WorkflowRunIds[i] = sqlchelpers.UUIDFromStr(id)
+		}
+	}
+
+	if opts.Limit != nil {
+		params.Limit = int32(*opts.Limit) // nolint: gosec
+	}
+
+	return r.queries.ListWorkflowRunEventLogs(ctx, r.pool, params)
+}
+
+func (r *workflowRunEventLogRepository) DeleteExpiredWorkflowRunEvents(ctx context.Context, tenantId string) error {
+	_, err := r.queries.DeleteExpiredWorkflowRunEventLogs(ctx, r.pool, dbsqlc.DeleteExpiredWorkflowRunEventLogsParams{
+		Tenantid:      sqlchelpers.UUIDFromStr(tenantId),
+		Createdbefore: sqlchelpers.TimestampFromTime(time.Now().UTC().Add(-repository.WorkflowRunEventLogRetention)),
+	})
+
+	return err
+}
@@ -0,0 +1,113 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func TestWorkflowRunEventLog(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		repo := conf.EngineRepository.WorkflowRunEventLog()
+
+		runA := uuid.New().String()
+		runB := uuid.New().String()
+
+		appendEvent := func(tenantId, workflowRunId, key string) int64 {
+			seq, err := repo.AppendWorkflowRunEvent(ctx, tenantId, workflowRunId, key, []byte(`{"id":"`+key+`"}`))
+			require.NoError(t, err)
+
+			return seq
+		}
+
+		first := appendEvent(tenantId, runA, "started")
+		second := appendEvent(tenantId, runB, "started")
+		third := appendEvent(tenantId, runA, "finished")
+
+		assert.Less(t, first, second)
+		assert.Less(t, second, third)
+
+		// an event which is already stored keeps its sequence, so it isn't sent twice
+		assert.Equal(t, first, appendEvent(tenantId, runA, "started"))
+
+		// events of other tenants aren't listed
+		appendEvent(createTestTenant(t, conf), uuid.New().String(), "started")
+
+		seqs := func(entries []*dbsqlc.WorkflowRunEventLog) []int64 {
+			res := make([]int64, len(entries))
+
+			for i, entry := range entries {
+				res[i] = entry.ID
+			}
+
+			return res
+		}
+
+		limit := 1
+
+		tests := []struct {
+			name     string
+			opts     *repository.ListWorkflowRunEventLogsOpts
+			expected []int64
+		}{
+			{
+				name:     "all events",
+				opts:     &repository.ListWorkflowRunEventLogsOpts{},
+				expected: []int64{first, second, third},
+			},
+			{
+				name:     "after a sequence",
+				opts:     &repository.ListWorkflowRunEventLogsOpts{AfterId: first},
+				expected: []int64{second, third},
+			},
+			{
+				name:     "workflow runs",
+				opts:     &repository.ListWorkflowRunEventLogsOpts{WorkflowRunIds: []string{runA}},
+				expected: []int64{first, third},
+			},
+			{
+				name:     "limit",
+				opts:     &repository.ListWorkflowRunEventLogsOpts{AfterId: first, Limit: &limit},
+				expected: []int64{second},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				entries, err := repo.ListWorkflowRunEvents(ctx, tenantId, tt.opts)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expected, seqs(entries))
+			})
+		}
+
+		// the events which are older than the retention period are deleted
+		_, err := conf.Pool.Exec(
+			ctx,
+			`UPDATE "WorkflowRunEventLog" SET "createdAt" = NOW() - INTERVAL '1 hour' WHERE "tenantId" = $1::uuid AND "workflowRunId" = $2::uuid`,
+			tenantId,
+			runA,
+		)
+
+		require.NoError(t, err)
+		require.NoError(t, repo.DeleteExpiredWorkflowRunEvents(ctx, tenantId))
+
+		entries, err := repo.ListWorkflowRunEvents(ctx, tenantId, &repository.ListWorkflowRunEventLogsOpts{})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{second}, seqs(entries))
+
+		return nil
+	})
+}
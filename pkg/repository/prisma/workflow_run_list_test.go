@@ -0,0 +1,121 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestListWorkflowRunsCursor(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		version := createTestWorkflow(t, conf, tenantId, "cursor")
+
+		createdAt := time.Now().UTC().Truncate(time.Second)
+		startedAt := createdAt.Add(time.Second)
+
+		// the runs share their creation time, and have tied and null start and finish times and durations. A run with
+		// a zero duration hasn't started.
+		durations := []time.Duration{time.Second, time.Second, time.Second, time.Minute, 0, 0, time.Minute, 0}
+
+		for _, duration := range durations {
+			run := createTestWorkflowRun(t, conf, tenantId, version)
+
+			if duration == 0 {
+				_, err := conf.Pool.Exec(ctx, `UPDATE "WorkflowRun" SET "createdAt" = $2 WHERE "id" = $1`, run.ID, createdAt)
+				require.NoError(t, err)
+
+				continue
+			}
+
+			_, err := conf.Pool.Exec(
+				ctx,
+				`UPDATE "WorkflowRun" SET "createdAt" = $2, "startedAt" = $3, "finishedAt" = $4, "duration" = $5 WHERE "id" = $1`,
+				run.ID,
+				createdAt,
+				startedAt,
+				startedAt.Add(duration),
+				duration.Milliseconds(),
+			)
+
+			require.NoError(t, err)
+		}
+
+		runs := conf.APIRepository.WorkflowRun()
+
+		for _, orderBy := range []string{"createdAt", "finishedAt", "startedAt", "duration"} {
+			for _, orderDirection := range []string{"ASC", "DESC"} {
+				t.Run(fmt.Sprintf("%s %s", orderBy, orderDirection), func(t *testing.T) {
+					// all runs fit on a single page, which is the order the pages have to follow
+					all, err := runs.ListWorkflowRuns(ctx, tenantId, &repository.ListWorkflowRunsOpts{
+						OrderBy:        &orderBy,
+						OrderDirection: &orderDirection,
+					})
+
+					require.NoError(t, err)
+					require.Len(t, all.Rows, len(durations))
+					assert.Nil(t, all.NextCursor)
+					require.NotNil(t, all.Count)
+					assert.Equal(t, len(durations), *all.Count)
+
+					expected := make([]string, 0, len(all.Rows))
+
+					for _, row := range all.Rows {
+						expected = append(expected, sqlchelpers.UUIDToStr(row.WorkflowRun.ID))
+					}
+
+					limit := 3
+					paged := make([]string, 0, len(expected))
+					seen := make(map[string]bool, len(expected))
+
+					var cursor *string
+
+					for page := 0; page <= len(expected)/limit; page++ {
+						res, err := runs.ListWorkflowRuns(ctx, tenantId, &repository.ListWorkflowRunsOpts{
+							OrderBy:        &orderBy,
+							OrderDirection: &orderDirection,
+							Limit:          &limit,
+							Cursor:         cursor,
+						})
+
+						require.NoError(t, err)
+
+						// only the first page, which isn't requested with a cursor, is counted
+						assert.Equal(t, cursor == nil, res.Count != nil)
+
+						for _, row := range res.Rows {
+							id := sqlchelpers.UUIDToStr(row.WorkflowRun.ID)
+
+							assert.False(t, seen[id], "run %s is listed twice", id)
+							seen[id] = true
+
+							paged = append(paged, id)
+						}
+
+						if res.NextCursor == nil {
+							break
+						}
+
+						cursor = res.NextCursor
+					}
+
+					assert.Equal(t, expected, paged)
+				})
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,115 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestPauseAndResumeWorkflowRun(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		version := createTestWorkflow(t, conf, tenantId, "pause")
+
+		run := createTestWorkflowRun(t, conf, tenantId, version)
+		workflowRunId := sqlchelpers.UUIDToStr(run.ID)
+		stepRunId := getTestStepRunId(t, conf, run.ID)
+
+		otherRun := createTestWorkflowRun(t, conf, tenantId, version)
+		otherStepRunId := getTestStepRunId(t, conf, otherRun.ID)
+
+		_, err := conf.Pool.Exec(ctx, `UPDATE "WorkflowRun" SET "status" = 'RUNNING' WHERE "id" = ANY($1::uuid[])`, []string{workflowRunId, sqlchelpers.UUIDToStr(otherRun.ID)})
+		require.NoError(t, err)
+
+		// start from a known state, in case creating the runs queued their step runs
+		_, err = conf.Pool.Exec(ctx, `DELETE FROM "QueueItem" WHERE "tenantId" = $1::uuid`, tenantId)
+		require.NoError(t, err)
+
+		// the queue item of the step run was about to time out
+		for _, id := range []string{sqlchelpers.UUIDToStr(stepRunId), sqlchelpers.UUIDToStr(otherStepRunId)} {
+			_, err = conf.Pool.Exec(
+				ctx,
+				`INSERT INTO "QueueItem" ("stepRunId", "stepId", "isQueued", "tenantId", "queue", "priority", "scheduleTimeoutAt")
+				SELECT sr."id", sr."stepId", true, sr."tenantId", 'pause', 1, NOW() + INTERVAL '1 second'
+				FROM "StepRun" sr WHERE sr."id" = $1::uuid`,
+				id,
+			)
+
+			require.NoError(t, err)
+		}
+
+		queries := dbsqlc.New()
+
+		listQueued := func() []string {
+			rows, err := queries.ListQueueItemsForQueue(ctx, conf.Pool, dbsqlc.ListQueueItemsForQueueParams{
+				Tenantid: sqlchelpers.UUIDFromStr(tenantId),
+				Queue:    "pause",
+			})
+
+			require.NoError(t, err)
+
+			ids := make([]string, len(rows))
+
+			for i, row := range rows {
+				ids[i] = sqlchelpers.UUIDToStr(row.QueueItem.StepRunId)
+			}
+
+			return ids
+		}
+
+		workflowRunStatus := func() dbsqlc.WorkflowRunStatus {
+			var status dbsqlc.WorkflowRunStatus
+
+			err := conf.Pool.QueryRow(ctx, `SELECT "status" FROM "WorkflowRun" WHERE "id" = $1::uuid`, workflowRunId).Scan(&status)
+			require.NoError(t, err)
+
+			return status
+		}
+
+		assert.ElementsMatch(t, []string{sqlchelpers.UUIDToStr(stepRunId), sqlchelpers.UUIDToStr(otherStepRunId)}, listQueued())
+
+		err = conf.APIRepository.WorkflowRun().ResumeWorkflowRun(ctx, tenantId, workflowRunId)
+		assert.ErrorIs(t, err, repository.ErrWorkflowRunNotPaused)
+
+		require.NoError(t, conf.APIRepository.WorkflowRun().PauseWorkflowRun(ctx, tenantId, workflowRunId))
+		assert.Equal(t, dbsqlc.WorkflowRunStatusPAUSED, workflowRunStatus())
+
+		// the scheduler doesn't list the queue items of the paused workflow run
+		assert.Equal(t, []string{sqlchelpers.UUIDToStr(otherStepRunId)}, listQueued())
+
+		err = conf.APIRepository.WorkflowRun().PauseWorkflowRun(ctx, tenantId, workflowRunId)
+		assert.ErrorIs(t, err, repository.ErrWorkflowRunNotRunning)
+
+		// a workflow run can only be resumed by its tenant
+		err = conf.APIRepository.WorkflowRun().ResumeWorkflowRun(ctx, createTestTenant(t, conf), workflowRunId)
+		assert.ErrorIs(t, err, repository.ErrWorkflowRunNotPaused)
+
+		require.NoError(t, conf.APIRepository.WorkflowRun().ResumeWorkflowRun(ctx, tenantId, workflowRunId))
+		assert.Equal(t, dbsqlc.WorkflowRunStatusRUNNING, workflowRunStatus())
+		assert.ElementsMatch(t, []string{sqlchelpers.UUIDToStr(stepRunId), sqlchelpers.UUIDToStr(otherStepRunId)}, listQueued())
+
+		// the queue item gets the full scheduling timeout of the step again
+		var scheduleTimeoutAt time.Time
+
+		err = conf.Pool.QueryRow(ctx, `SELECT "scheduleTimeoutAt" FROM "QueueItem" WHERE "stepRunId" = $1`, stepRunId).Scan(&scheduleTimeoutAt)
+		require.NoError(t, err)
+		assert.True(t, scheduleTimeoutAt.After(time.Now().UTC().Add(time.Minute)), "the schedule timeout must be restarted")
+
+		// a paused workflow run isn't in a final state
+		assert.False(t, repository.IsFinalWorkflowRunStatus(dbsqlc.WorkflowRunStatusPAUSED))
+
+		return nil
+	})
+}
@@ -0,0 +1,168 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestReplayWorkflowRunFromStep(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+
+		// the steps run one after the other: first -> second -> third
+		version, err := conf.EngineRepository.Workflow().CreateNewWorkflow(ctx, tenantId, &repository.CreateWorkflowVersionOpts{
+			Name: "replay-from-step",
+			Jobs: []repository.CreateWorkflowJobOpts{
+				{
+					Name: "job",
+					Kind: "DEFAULT",
+					Steps: []repository.CreateWorkflowStepOpts{
+						{ReadableId: "first", Action: "test:first"},
+						{ReadableId: "second", Action: "test:second", Parents: []string{"first"}},
+						{ReadableId: "third", Action: "test:third", Parents: []string{"second"}},
+					},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+
+		type stepRun struct {
+			id     pgtype.UUID
+			status dbsqlc.StepRunStatus
+			output []byte
+			input  []byte
+		}
+
+		// listStepRuns returns the step runs of a workflow run by the readable id of their step
+		listStepRuns := func(workflowRunId pgtype.UUID) (map[string]pgtype.UUID, map[string]stepRun) {
+			rows, err := conf.Pool.Query(
+				ctx,
+				`SELECT s."readableId", s."id", sr."id", sr."status", sr."output", sr."input"
+				FROM "StepRun" sr
+				JOIN "Step" s ON s."id" = sr."stepId"
+				JOIN "JobRun" jr ON jr."id" = sr."jobRunId"
+				WHERE jr."workflowRunId" = $1`,
+				workflowRunId,
+			)
+
+			require.NoError(t, err)
+
+			stepIds := make(map[string]pgtype.UUID)
+			stepRuns := make(map[string]stepRun)
+
+			for rows.Next() {
+				var readableId string
+				var stepId pgtype.UUID
+				var sr stepRun
+
+				require.NoError(t, rows.Scan(&readableId, &stepId, &sr.id, &sr.status, &sr.output, &sr.input))
+
+				stepIds[readableId] = stepId
+				stepRuns[readableId] = sr
+			}
+
+			require.NoError(t, rows.Err())
+
+			return stepIds, stepRuns
+		}
+
+		// finish sets the status and output of the step runs of a workflow run, and finishes the workflow run
+		finish := func(workflowRunId pgtype.UUID, statuses map[string]string) {
+			for readableId, status := range statuses {
+				_, err := conf.Pool.Exec(
+					ctx,
+					`UPDATE "StepRun" sr SET "status" = $3::"StepRunStatus", "output" = jsonb_build_object('step', s."readableId")
+					FROM "Step" s, "JobRun" jr
+					WHERE sr."stepId" = s."id" AND sr."jobRunId" = jr."id" AND jr."workflowRunId" = $1 AND s."readableId" = $2`,
+					workflowRunId, readableId, status,
+				)
+
+				require.NoError(t, err)
+			}
+
+			_, err := conf.Pool.Exec(ctx, `UPDATE "WorkflowRun" SET "status" = 'FAILED' WHERE "id" = $1`, workflowRunId)
+			require.NoError(t, err)
+		}
+
+		run := createTestWorkflowRun(t, conf, tenantId, version)
+		runId := sqlchelpers.UUIDToStr(run.ID)
+		finish(run.ID, map[string]string{"first": "SUCCEEDED", "second": "SUCCEEDED", "third": "FAILED"})
+
+		stepIds, _ := listStepRuns(run.ID)
+		thirdStepId := sqlchelpers.UUIDToStr(stepIds["third"])
+
+		apiRepo := conf.APIRepository.WorkflowRun()
+
+		require.NoError(t, apiRepo.PreflightCheckReplayFromStep(ctx, tenantId, runId, thirdStepId))
+
+		err = apiRepo.PreflightCheckReplayFromStep(ctx, tenantId, runId, uuid.New().String())
+		assert.ErrorIs(t, err, repository.ErrPreflightReplayStepNotInWorkflowRun)
+
+		err = apiRepo.PreflightCheckReplayFromStep(ctx, createTestTenant(t, conf), runId, thirdStepId)
+		assert.ErrorIs(t, err, repository.ErrPreflightReplayStepNotInWorkflowRun)
+
+		// a workflow run can't be replayed from a step with an upstream step run which didn't succeed
+		failedRun := createTestWorkflowRun(t, conf, tenantId, version)
+		finish(failedRun.ID, map[string]string{"first": "SUCCEEDED", "second": "FAILED", "third": "CANCELLED"})
+
+		err = apiRepo.PreflightCheckReplayFromStep(ctx, tenantId, sqlchelpers.UUIDToStr(failedRun.ID), thirdStepId)
+		assert.ErrorIs(t, err, repository.ErrPreflightReplayUpstreamStepRunNotSucceeded)
+
+		// replay the workflow run from the third step with a new input
+		opts, err := repository.GetCreateWorkflowRunOptsFromManual(version, []byte(`{}`), nil)
+		require.NoError(t, err)
+
+		opts.ReplayedFromId = &runId
+		opts.ReplayedFromStepId = &thirdStepId
+		opts.ReplayedFromStepInput = []byte(`{"input":{"override":true}}`)
+
+		replayed, err := conf.EngineRepository.WorkflowRun().CreateNewWorkflowRun(ctx, tenantId, opts)
+		require.NoError(t, err)
+
+		var replayedFromId, replayedFromStepId pgtype.UUID
+
+		err = conf.Pool.QueryRow(ctx, `SELECT "replayedFromId", "replayedFromStepId" FROM "WorkflowRun" WHERE "id" = $1`, replayed.ID).Scan(&replayedFromId, &replayedFromStepId)
+		require.NoError(t, err)
+
+		assert.Equal(t, runId, sqlchelpers.UUIDToStr(replayedFromId))
+		assert.Equal(t, thirdStepId, sqlchelpers.UUIDToStr(replayedFromStepId))
+
+		_, stepRuns := listStepRuns(replayed.ID)
+
+		// the upstream step runs reuse the outputs of the replayed workflow run
+		for _, readableId := range []string{"first", "second"} {
+			assert.Equal(t, dbsqlc.StepRunStatusSUCCEEDED, stepRuns[readableId].status, readableId)
+			assert.JSONEq(t, `{"step":"`+readableId+`"}`, string(stepRuns[readableId].output), readableId)
+		}
+
+		assert.Equal(t, dbsqlc.StepRunStatusPENDING, stepRuns["third"].status)
+		assert.JSONEq(t, `{"input":{"override":true}}`, string(stepRuns["third"].input))
+
+		// the replayed step is the step run which is started first
+		var jobRunId pgtype.UUID
+
+		err = conf.Pool.QueryRow(ctx, `SELECT "id" FROM "JobRun" WHERE "workflowRunId" = $1`, replayed.ID).Scan(&jobRunId)
+		require.NoError(t, err)
+
+		initial, err := dbsqlc.New().ListInitialStepRuns(ctx, conf.Pool, jobRunId)
+		require.NoError(t, err)
+		assert.Equal(t, []pgtype.UUID{stepRuns["third"].id}, initial)
+
+		return nil
+	})
+}
@@ -0,0 +1,126 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+)
+
+func TestCreateWorkflowRunsLinksStepRunParents(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+
+		// every job is a diamond, where a is the parent of b and c, and d is the child of b and c
+		steps := []repository.CreateWorkflowStepOpts{
+			{ReadableId: "a", Action: "test:a"},
+			{ReadableId: "b", Action: "test:b", Parents: []string{"a"}},
+			{ReadableId: "c", Action: "test:c", Parents: []string{"a"}},
+			{ReadableId: "d", Action: "test:d", Parents: []string{"b", "c"}},
+		}
+
+		version, err := conf.EngineRepository.Workflow().CreateNewWorkflow(ctx, tenantId, &repository.CreateWorkflowVersionOpts{
+			Name: "dag",
+			Jobs: []repository.CreateWorkflowJobOpts{
+				{Name: "job-a", Kind: "DEFAULT", Steps: steps},
+				{Name: "job-b", Kind: "DEFAULT", Steps: steps},
+			},
+		})
+
+		require.NoError(t, err)
+
+		// the runs are created in bulk, so the step runs of all their job runs are copied at once
+		runCount := 3
+		opts := make([]*repository.CreateWorkflowRunOpts, 0, runCount)
+
+		for i := 0; i < runCount; i++ {
+			opt, err := repository.GetCreateWorkflowRunOptsFromManual(version, []byte(`{}`), nil)
+			require.NoError(t, err)
+
+			opts = append(opts, opt)
+		}
+
+		runs, err := conf.EngineRepository.WorkflowRun().CreateNewWorkflowRuns(ctx, tenantId, opts)
+		require.NoError(t, err)
+		require.Len(t, runs, runCount)
+
+		runIds := make([]pgtype.UUID, 0, len(runs))
+
+		for _, run := range runs {
+			runIds = append(runIds, run.ID)
+		}
+
+		var stepRunCount int
+
+		err = conf.Pool.QueryRow(
+			ctx,
+			`SELECT count(*) FROM "StepRun" sr JOIN "JobRun" jr ON jr."id" = sr."jobRunId" WHERE jr."workflowRunId" = ANY($1::uuid[])`,
+			runIds,
+		).Scan(&stepRunCount)
+
+		require.NoError(t, err)
+		assert.Equal(t, runCount*2*len(steps), stepRunCount)
+
+		rows, err := conf.Pool.Query(
+			ctx,
+			`SELECT
+				parent_run."jobRunId"::text,
+				child_run."jobRunId"::text,
+				parent_step."readableId",
+				child_step."readableId"
+			FROM "_StepRunOrder" sro
+			JOIN "StepRun" parent_run ON parent_run."id" = sro."A"
+			JOIN "StepRun" child_run ON child_run."id" = sro."B"
+			JOIN "Step" parent_step ON parent_step."id" = parent_run."stepId"
+			JOIN "Step" child_step ON child_step."id" = child_run."stepId"
+			JOIN "JobRun" jr ON jr."id" = child_run."jobRunId"
+			WHERE jr."workflowRunId" = ANY($1::uuid[])`,
+			runIds,
+		)
+
+		require.NoError(t, err)
+
+		type edge struct {
+			parentJobRunId string
+			childJobRunId  string
+			parent         string
+			child          string
+		}
+
+		edges, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (edge, error) {
+			var e edge
+			err := row.Scan(&e.parentJobRunId, &e.childJobRunId, &e.parent, &e.child)
+			return e, err
+		})
+
+		require.NoError(t, err)
+
+		// the edges of each job run, which must only link the step runs of the same job run
+		edgesByJobRun := make(map[string][]string)
+
+		for _, e := range edges {
+			assert.Equal(t, e.parentJobRunId, e.childJobRunId, "step runs must only be linked within their job run")
+
+			edgesByJobRun[e.childJobRunId] = append(edgesByJobRun[e.childJobRunId], fmt.Sprintf("%s->%s", e.parent, e.child))
+		}
+
+		assert.Len(t, edgesByJobRun, runCount*2)
+
+		for jobRunId, jobRunEdges := range edgesByJobRun {
+			assert.ElementsMatch(t, []string{"a->b", "a->c", "b->d", "c->d"}, jobRunEdges, "job run %s", jobRunId)
+		}
+
+		return nil
+	})
+}
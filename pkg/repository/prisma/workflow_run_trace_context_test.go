@@ -0,0 +1,79 @@
+//go:build integration
+
+package prisma_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestWorkflowRunTraceContext(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		ctx := context.Background()
+		tenantId := createTestTenant(t, conf)
+		version := createTestWorkflow(t, conf, tenantId, "trace-context")
+
+		traceId, err := trace.TraceIDFromHex("0af7651916cd43dd8448eb211c80319c")
+		require.NoError(t, err)
+
+		spanId, err := trace.SpanIDFromHex("b7ad6b7169203331")
+		require.NoError(t, err)
+
+		tracedCtx := trace.ContextWithRemoteSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceId,
+			SpanID:     spanId,
+			TraceFlags: trace.FlagsSampled,
+			Remote:     true,
+		}))
+
+		getTraceContext := func(runCtx context.Context, traceContext map[string]string) map[string]string {
+			opts, err := repository.GetCreateWorkflowRunOptsFromManual(version, []byte(`{}`), nil)
+			require.NoError(t, err)
+
+			opts.TraceContext = traceContext
+
+			run, err := conf.EngineRepository.WorkflowRun().CreateNewWorkflowRun(runCtx, tenantId, opts)
+			require.NoError(t, err)
+
+			// the trace context of the workflow run is sent to workers with its step runs
+			data, err := conf.EngineRepository.StepRun().GetStepRunDataForEngine(
+				ctx,
+				tenantId,
+				sqlchelpers.UUIDToStr(getTestStepRunId(t, conf, run.ID)),
+			)
+
+			require.NoError(t, err)
+
+			if len(data.TraceContext) == 0 {
+				return nil
+			}
+
+			res := map[string]string{}
+			require.NoError(t, json.Unmarshal(data.TraceContext, &res))
+
+			return res
+		}
+
+		// the trace context is set from the context of the caller
+		assert.Equal(t, "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01", getTraceContext(tracedCtx, nil)["traceparent"])
+
+		// a trace context which is set on the options isn't overridden, e.g. the trace of a parent step run
+		explicit := map[string]string{"traceparent": "00-11111111111111111111111111111111-1111111111111111-01"}
+		assert.Equal(t, explicit, getTraceContext(tracedCtx, explicit))
+
+		// a workflow run which isn't triggered in a trace has no trace context
+		assert.Nil(t, getTraceContext(ctx, nil))
+
+		return nil
+	})
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type QueueAPIRepository interface {
+	// GetQueue returns the queue with the given name
+	GetQueue(ctx context.Context, tenantId, name string) (*dbsqlc.Queue, error)
+
+	// ListQueues returns the queues of a tenant which were active in the last day
+	ListQueues(ctx context.Context, tenantId string) ([]*dbsqlc.Queue, error)
+
+	// SetQueuePaused pauses or resumes a queue. Paused queues stay leased by the scheduler, but no
+	// new step runs are assigned from them until they are resumed.
+	SetQueuePaused(ctx context.Context, tenantId, name string, isPaused bool) (*dbsqlc.Queue, error)
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// QueueMetricsRetention is how long queue metrics snapshots are kept, which is also the longest window which they
+// can be listed for.
+const QueueMetricsRetention = 24 * time.Hour
+
+// QueueMetricsRepository stores periodic snapshots of the depth, latency and worker slot utilization of the queues of
+// a tenant, so external autoscalers can scale workers on the backlog of a queue.
+type QueueMetricsRepository interface {
+	// SnapshotQueueMetrics stores a snapshot of the current metrics of the queues of a tenant.
+	SnapshotQueueMetrics(ctx context.Context, tenantId string) error
+
+	// ListQueueMetricsSnapshots lists the snapshots of a tenant which were taken after createdAfter, ordered by queue
+	// and the time they were taken.
+	ListQueueMetricsSnapshots(ctx context.Context, tenantId string, createdAfter time.Time) ([]*dbsqlc.QueueMetricsSnapshot, error)
+
+	// DeleteExpiredQueueMetricsSnapshots deletes the snapshots of a tenant which were taken before createdBefore.
+	DeleteExpiredQueueMetricsSnapshots(ctx context.Context, tenantId string, createdBefore time.Time) error
+}
@@ -34,6 +34,13 @@ type UpsertRateLimitOpts struct {
 
 	// The rate limit duration
 	Duration *string `validate:"omitnil,oneof=SECOND MINUTE HOUR DAY WEEK MONTH YEAR"`
+
+	// (optional) the algorithm used to refill the rate limit, defaults to FIXED_WINDOW
+	Algorithm *string `validate:"omitnil,oneof=FIXED_WINDOW SLIDING_WINDOW TOKEN_BUCKET LEAKY_BUCKET"`
+
+	// (optional) the bucket capacity for TOKEN_BUCKET and LEAKY_BUCKET rate limits. Defaults to the limit for
+	// token buckets and to 1 for leaky buckets.
+	Burst *int `validate:"omitnil,min=1"`
 }
 
 type RateLimitEngineRepository interface {
@@ -41,4 +48,14 @@ type RateLimitEngineRepository interface {
 
 	// CreateRateLimit creates a new rate limit record
 	UpsertRateLimit(ctx context.Context, tenantId string, key string, opts *UpsertRateLimitOpts) (*dbsqlc.RateLimit, error)
+
+	// DeleteExpiredRateLimits deletes dynamic rate limit keys which haven't been used before they expired
+	DeleteExpiredRateLimits(ctx context.Context, tenantId string) error
+
+	// ListGlobalRateLimits lists the rate limits which are shared by all tenants
+	ListGlobalRateLimits(ctx context.Context) ([]*dbsqlc.ListGlobalRateLimitsRow, error)
+
+	// UpsertGlobalRateLimit creates or updates a rate limit which is shared by all tenants. Steps reference
+	// global rate limits by setting the GLOBAL scope on the step rate limit.
+	UpsertGlobalRateLimit(ctx context.Context, key string, opts *UpsertRateLimitOpts) (*dbsqlc.GlobalRateLimit, error)
 }
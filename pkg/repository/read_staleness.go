@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type readStalenessKey struct{}
+
+// ReadStaleness records whether the reads of a request were served by a read replica, and how far the replica lagged
+// behind the primary.
+type ReadStaleness struct {
+	mu          sync.Mutex
+	fromReplica bool
+	lag         time.Duration
+}
+
+// WithReadStaleness returns a context which records the reads served by a read replica in the returned ReadStaleness.
+func WithReadStaleness(ctx context.Context) (context.Context, *ReadStaleness) {
+	s := &ReadStaleness{}
+
+	return context.WithValue(ctx, readStalenessKey{}, s), s
+}
+
+// RecordReplicaRead records that a read made with the context was served by a read replica which lagged behind the
+// primary by lag. It's a no-op if the context doesn't record reads.
+func RecordReplicaRead(ctx context.Context, lag time.Duration) {
+	s, ok := ctx.Value(readStalenessKey{}).(*ReadStaleness)
+
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.fromReplica = true
+
+	if lag > s.lag {
+		s.lag = lag
+	}
+}
+
+// Lag returns the largest lag of the reads which were served by a read replica, and false if no read was.
+func (s *ReadStaleness) Lag() (time.Duration, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.lag, s.fromReplica
+}
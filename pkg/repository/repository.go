@@ -20,6 +20,7 @@ type APIRepository interface {
 	SNS() SNSRepository
 	Step() StepRepository
 	Worker() WorkerAPIRepository
+	Queue() QueueAPIRepository
 	UserSession() UserSessionRepository
 	User() UserRepository
 	SecurityCheck() SecurityCheckRepository
@@ -45,6 +46,31 @@ type EngineRepository interface {
 	Log() LogsEngineRepository
 	RateLimit() RateLimitEngineRepository
 	WebhookWorker() WebhookWorkerEngineRepository
+	DeadLetterQueue() DeadLetterQueueRepository
+	Signal() SignalEngineRepository
+	Approval() ApprovalRepository
+	CronCalendar() CronCalendarRepository
+	Map() MapEngineRepository
+	StepRunCache() StepRunCacheRepository
+	DataKey() DataKeyRepository
+	KafkaOffset() KafkaOffsetRepository
+	SQSIntegration() SQSIntegrationRepository
+	InboundWebhook() InboundWebhookRepository
+	EventSink() EventSinkRepository
+	EventDedup() EventDedupRepository
+	EventRouting() EventRoutingRepository
+	MessageQueue() MessageQueueRepository
+	WorkflowRunEventLog() WorkflowRunEventLogRepository
+	StepRunProgress() StepRunProgressRepository
+	QueueMetrics() QueueMetricsRepository
+	ClientCA() ClientCARepository
+	AuditLog() AuditLogRepository
+	TenantQuota() TenantQuotaRepository
+	TenantExport() TenantExportRepository
+	RetentionPolicy() RetentionPolicyRepository
+	TablePartition() TablePartitionRepository
+	WorkflowRunArchive() WorkflowRunArchiveRepository
+	Operations() OperationsRepository
 }
 
 type EntitlementsRepository interface {
@@ -0,0 +1,535 @@
+// Package repositorytest contains a conformance suite for the implementations of the repositories, which checks the
+// contracts that the engine and the API rely on. A backend runs the suite from its own tests:
+//
+//	func TestConformance(t *testing.T) {
+//		repositorytest.RunConformance(t, repositorytest.Backend{
+//			API:       apiRepo,
+//			Engine:    engineRepo,
+//			Scheduler: schedulerRepo,
+//		})
+//	}
+//
+// The suite creates its own tenants, so it can run against a database which is used by other tests.
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// Backend is an implementation of the repositories which is tested by the suite.
+type Backend struct {
+	API       repository.APIRepository
+	Engine    repository.EngineRepository
+	Scheduler repository.SchedulerRepository
+}
+
+// RunConformance runs the conformance suite against the backend, with a subtest per resource.
+func RunConformance(t *testing.T, backend Backend) {
+	t.Helper()
+
+	t.Run("Tenants", func(t *testing.T) {
+		testTenants(t, backend)
+	})
+
+	t.Run("APITokens", func(t *testing.T) {
+		testAPITokens(t, backend)
+	})
+
+	t.Run("Workers", func(t *testing.T) {
+		testWorkers(t, backend)
+	})
+
+	t.Run("Events", func(t *testing.T) {
+		testEvents(t, backend)
+	})
+
+	t.Run("Workflows", func(t *testing.T) {
+		testWorkflows(t, backend)
+	})
+
+	t.Run("WorkflowRuns", func(t *testing.T) {
+		testWorkflowRuns(t, backend)
+	})
+
+	t.Run("RateLimits", func(t *testing.T) {
+		testRateLimits(t, backend)
+	})
+
+	t.Run("SchedulerLeases", func(t *testing.T) {
+		testSchedulerLeases(t, backend)
+	})
+
+	t.Run("SchedulingDecisions", func(t *testing.T) {
+		testSchedulingDecisions(t, backend)
+	})
+}
+
+// createTenant creates a tenant with a unique slug.
+func createTenant(t *testing.T, backend Backend) string {
+	t.Helper()
+
+	slugSuffix, err := random.Generate(8)
+	require.NoError(t, err)
+
+	tenantId := uuid.New().String()
+
+	_, err = backend.API.Tenant().CreateTenant(&repository.CreateTenantOpts{
+		ID:   &tenantId,
+		Name: "conformance",
+		Slug: fmt.Sprintf("conformance-%s", slugSuffix),
+	})
+
+	require.NoError(t, err)
+
+	return tenantId
+}
+
+func testTenants(t *testing.T, backend Backend) {
+	ctx := context.Background()
+
+	slugSuffix, err := random.Generate(8)
+	require.NoError(t, err)
+
+	slug := fmt.Sprintf("conformance-%s", slugSuffix)
+
+	created, err := backend.API.Tenant().CreateTenant(&repository.CreateTenantOpts{
+		Name: "conformance",
+		Slug: slug,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, slug, created.Slug)
+
+	tenantId := sqlchelpers.UUIDToStr(created.ID)
+
+	_, err = backend.API.Tenant().CreateTenant(&repository.CreateTenantOpts{
+		Name: "conformance",
+		Slug: "not a valid slug",
+	})
+
+	assert.Error(t, err, "invalid options must be rejected")
+
+	bySlug, err := backend.API.Tenant().GetTenantBySlug(slug)
+	require.NoError(t, err)
+	assert.Equal(t, tenantId, bySlug.ID)
+
+	name := "renamed"
+
+	_, err = backend.API.Tenant().UpdateTenant(tenantId, &repository.UpdateTenantOpts{
+		Name: &name,
+	})
+
+	require.NoError(t, err)
+
+	// the update must be visible to both entry points, including the cached reads
+	byId, err := backend.API.Tenant().GetTenantByID(tenantId)
+	require.NoError(t, err)
+	assert.Equal(t, name, byId.Name)
+
+	engineTenant, err := backend.Engine.Tenant().GetTenantByID(ctx, tenantId)
+	require.NoError(t, err)
+	assert.Equal(t, name, engineTenant.Name)
+
+	_, err = backend.API.Tenant().GetTenantByID(uuid.New().String())
+	assert.True(t, errors.Is(err, db.ErrNotFound), "the API repository must return db.ErrNotFound, got %v", err)
+
+	_, err = backend.Engine.Tenant().GetTenantByID(ctx, uuid.New().String())
+	assert.True(t, errors.Is(err, pgx.ErrNoRows), "the engine repository must return pgx.ErrNoRows, got %v", err)
+}
+
+func testAPITokens(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	tenantId := createTenant(t, backend)
+
+	tokenId := uuid.New().String()
+	name := "conformance"
+	expiresAt := time.Now().Add(time.Hour).UTC()
+
+	created, err := backend.Engine.APIToken().CreateAPIToken(ctx, &repository.CreateAPITokenOpts{
+		ID:        tokenId,
+		ExpiresAt: expiresAt,
+		TenantId:  &tenantId,
+		Name:      &name,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, tokenId, sqlchelpers.UUIDToStr(created.ID))
+	assert.WithinDuration(t, expiresAt, created.ExpiresAt.Time, time.Second)
+	assert.False(t, created.Revoked)
+
+	tokens, err := backend.Engine.APIToken().ListAPITokensByTenant(ctx, tenantId)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, tokenId, sqlchelpers.UUIDToStr(tokens[0].ID))
+
+	// read the token before revoking it, so a cached token must be invalidated
+	_, err = backend.Engine.APIToken().GetAPITokenById(ctx, tokenId)
+	require.NoError(t, err)
+
+	_, err = backend.API.APIToken().GetAPITokenById(tokenId)
+	require.NoError(t, err)
+
+	require.NoError(t, backend.API.APIToken().RevokeAPIToken(tokenId))
+
+	revoked, err := backend.Engine.APIToken().GetAPITokenById(ctx, tokenId)
+	require.NoError(t, err)
+	assert.True(t, revoked.Revoked, "a revoked token must be returned as revoked")
+
+	apiRevoked, err := backend.API.APIToken().GetAPITokenById(tokenId)
+	require.NoError(t, err)
+	assert.True(t, apiRevoked.Revoked, "a revoked token must be returned as revoked")
+
+	_, err = backend.Engine.APIToken().GetAPITokenById(ctx, uuid.New().String())
+	assert.True(t, errors.Is(err, pgx.ErrNoRows), "the engine repository must return pgx.ErrNoRows, got %v", err)
+}
+
+func testWorkers(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	tenantId := createTenant(t, backend)
+
+	dispatcherId := uuid.New().String()
+
+	_, err := backend.Engine.Dispatcher().CreateNewDispatcher(ctx, &repository.CreateDispatcherOpts{
+		ID: dispatcherId,
+	})
+
+	require.NoError(t, err)
+
+	defer backend.Engine.Dispatcher().Delete(ctx, dispatcherId) // nolint: errcheck
+
+	maxRuns := 5
+
+	worker, err := backend.Engine.Worker().CreateNewWorker(ctx, tenantId, &repository.CreateWorkerOpts{
+		DispatcherId: dispatcherId,
+		MaxRuns:      &maxRuns,
+		Name:         "conformance",
+		Actions:      []string{"conformance:step"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(maxRuns), worker.MaxRuns)
+
+	workerId := sqlchelpers.UUIDToStr(worker.ID)
+
+	forEngine, err := backend.Engine.Worker().GetWorkerForEngine(ctx, tenantId, workerId)
+	require.NoError(t, err)
+	assert.Equal(t, dispatcherId, sqlchelpers.UUIDToStr(forEngine.DispatcherId))
+
+	// a worker must only be returned for its own tenant
+	_, err = backend.Engine.Worker().GetWorkerForEngine(ctx, createTenant(t, backend), workerId)
+	assert.True(t, errors.Is(err, pgx.ErrNoRows), "the engine repository must return pgx.ErrNoRows, got %v", err)
+
+	actions, err := backend.API.Worker().GetWorkerActionsByWorkerId(tenantId, workerId)
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	assert.Equal(t, "conformance:step", actions[0].String)
+
+	require.NoError(t, backend.Engine.Worker().DeleteWorker(ctx, tenantId, workerId))
+
+	_, err = backend.Engine.Worker().GetWorkerForEngine(ctx, tenantId, workerId)
+	assert.True(t, errors.Is(err, pgx.ErrNoRows), "the engine repository must return pgx.ErrNoRows, got %v", err)
+}
+
+func testEvents(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	tenantId := createTenant(t, backend)
+
+	created, err := backend.Engine.Event().CreateEvent(ctx, &repository.CreateEventOpts{
+		TenantId:           tenantId,
+		Key:                "conformance:event",
+		Data:               []byte(`{"hello":"world"}`),
+		AdditionalMetadata: []byte(`{"source":"conformance"}`),
+	})
+
+	require.NoError(t, err)
+
+	eventId := sqlchelpers.UUIDToStr(created.ID)
+
+	event, err := backend.Engine.Event().GetEventForEngine(ctx, tenantId, eventId)
+	require.NoError(t, err)
+	assert.Equal(t, "conformance:event", event.Key)
+	assert.JSONEq(t, `{"hello":"world"}`, string(event.Data))
+	assert.JSONEq(t, `{"source":"conformance"}`, string(event.AdditionalMetadata))
+
+	events, err := backend.Engine.Event().ListEventsByIds(ctx, tenantId, []string{eventId, uuid.New().String()})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, eventId, sqlchelpers.UUIDToStr(events[0].ID))
+
+	// an event must only be listed for its own tenant
+	events, err = backend.Engine.Event().ListEventsByIds(ctx, createTenant(t, backend), []string{eventId})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+// createWorkflow creates a workflow with a single step.
+func createWorkflow(t *testing.T, backend Backend, tenantId, name string) *dbsqlc.GetWorkflowVersionForEngineRow {
+	t.Helper()
+
+	version, err := backend.Engine.Workflow().CreateNewWorkflow(context.Background(), tenantId, &repository.CreateWorkflowVersionOpts{
+		Name: name,
+		Jobs: []repository.CreateWorkflowJobOpts{
+			{
+				Name: "job",
+				Kind: "DEFAULT",
+				Steps: []repository.CreateWorkflowStepOpts{
+					{
+						ReadableId: "step",
+						Action:     "conformance:step",
+					},
+				},
+			},
+		},
+	})
+
+	require.NoError(t, err)
+
+	return version
+}
+
+func testWorkflows(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	tenantId := createTenant(t, backend)
+
+	_, err := backend.Engine.Workflow().CreateNewWorkflow(ctx, tenantId, &repository.CreateWorkflowVersionOpts{
+		Name: "not a valid name",
+	})
+
+	assert.Error(t, err, "invalid options must be rejected")
+
+	version := createWorkflow(t, backend, tenantId, "conformance")
+	workflowId := sqlchelpers.UUIDToStr(version.WorkflowVersion.WorkflowId)
+	versionId := sqlchelpers.UUIDToStr(version.WorkflowVersion.ID)
+
+	byName, err := backend.Engine.Workflow().GetWorkflowByName(ctx, tenantId, "conformance")
+	require.NoError(t, err)
+	assert.Equal(t, workflowId, sqlchelpers.UUIDToStr(byName.ID))
+
+	byVersion, err := backend.Engine.Workflow().GetWorkflowVersionById(ctx, tenantId, versionId)
+	require.NoError(t, err)
+	assert.Equal(t, workflowId, sqlchelpers.UUIDToStr(byVersion.WorkflowVersion.WorkflowId))
+
+	workflows, err := backend.API.Workflow().ListWorkflows(tenantId, &repository.ListWorkflowsOpts{})
+	require.NoError(t, err)
+	require.Len(t, workflows.Rows, 1)
+	assert.Equal(t, 1, workflows.Count)
+	assert.Equal(t, workflowId, sqlchelpers.UUIDToStr(workflows.Rows[0].ID))
+
+	// a workflow must only be returned for its own tenant
+	otherTenantId := createTenant(t, backend)
+
+	_, err = backend.Engine.Workflow().GetWorkflowByName(ctx, otherTenantId, "conformance")
+	assert.True(t, errors.Is(err, pgx.ErrNoRows), "the engine repository must return pgx.ErrNoRows, got %v", err)
+
+	workflows, err = backend.API.Workflow().ListWorkflows(otherTenantId, &repository.ListWorkflowsOpts{})
+	require.NoError(t, err)
+	assert.Empty(t, workflows.Rows)
+}
+
+func testWorkflowRuns(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	tenantId := createTenant(t, backend)
+
+	version := createWorkflow(t, backend, tenantId, "conformance")
+
+	opts, err := repository.GetCreateWorkflowRunOptsFromManual(version, []byte(`{"hello":"world"}`), nil)
+	require.NoError(t, err)
+
+	created, err := backend.Engine.WorkflowRun().CreateNewWorkflowRun(ctx, tenantId, opts)
+	require.NoError(t, err)
+
+	runId := sqlchelpers.UUIDToStr(created.ID)
+
+	run, err := backend.Engine.WorkflowRun().GetWorkflowRunById(ctx, tenantId, runId)
+	require.NoError(t, err)
+	assert.Equal(t, sqlchelpers.UUIDToStr(version.WorkflowVersion.ID), sqlchelpers.UUIDToStr(run.WorkflowRun.WorkflowVersionId))
+
+	// the step runs of the workflow run must be created along with it
+	stepRuns, err := backend.Engine.StepRun().ListStepRuns(ctx, tenantId, &repository.ListStepRunsOpts{
+		WorkflowRunIds: []string{runId},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, stepRuns, 1)
+
+	// a workflow run must only be returned for its own tenant
+	_, err = backend.Engine.WorkflowRun().GetWorkflowRunById(ctx, createTenant(t, backend), runId)
+	assert.True(t, errors.Is(err, repository.ErrWorkflowRunNotFound), "the engine repository must return repository.ErrWorkflowRunNotFound, got %v", err)
+}
+
+func testRateLimits(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	tenantId := createTenant(t, backend)
+
+	duration := "HOUR"
+
+	_, err := backend.Engine.RateLimit().UpsertRateLimit(ctx, tenantId, "conformance:static", &repository.UpsertRateLimitOpts{
+		Limit:    10,
+		Duration: &duration,
+	})
+
+	require.NoError(t, err)
+
+	expiresAt := time.Now().Add(time.Hour)
+
+	err = backend.Scheduler.RateLimit().UpsertDynamicRateLimits(ctx, tenantId, &repository.UpsertDynamicRateLimitsOpts{
+		Keys:        []string{"conformance:dynamic"},
+		Windows:     []string{"1 hour"},
+		LimitValues: []int32{5},
+		ExpiresAt:   &expiresAt,
+	})
+
+	require.NoError(t, err)
+
+	keys, err := backend.Scheduler.RateLimit().ListCandidateRateLimits(ctx, tenantId)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"conformance:static", "conformance:dynamic"}, keys)
+
+	rateLimits, _, err := backend.Scheduler.RateLimit().UpdateRateLimits(ctx, tenantId, map[string]int{
+		"conformance:static":  3,
+		"conformance:dynamic": 5,
+	}, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"conformance:static": 7, "conformance:dynamic": 0}, rateLimits)
+
+	// returned units are negative, and the value is capped at the limit
+	rateLimits, _, err = backend.Scheduler.RateLimit().UpdateRateLimits(ctx, tenantId, map[string]int{
+		"conformance:static": -5,
+	}, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, 10, rateLimits["conformance:static"])
+
+	// rate limits must only be returned for their own tenant
+	keys, err = backend.Scheduler.RateLimit().ListCandidateRateLimits(ctx, createTenant(t, backend))
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
+
+func testSchedulerLeases(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	tenantId := createTenant(t, backend)
+
+	leases := backend.Scheduler.Lease()
+	resourceIds := []string{"queue-a", "queue-b"}
+
+	acquired, err := leases.AcquireOrExtendLeases(ctx, tenantId, dbsqlc.LeaseKindQUEUE, resourceIds, nil)
+	require.NoError(t, err)
+	require.Len(t, acquired, 2)
+
+	// the leases are held, so another scheduler can't acquire them
+	other, err := leases.AcquireOrExtendLeases(ctx, tenantId, dbsqlc.LeaseKindQUEUE, resourceIds, nil)
+	require.NoError(t, err)
+	assert.Empty(t, other)
+
+	available, err := leases.ListAvailableLeaseResources(ctx, tenantId, dbsqlc.LeaseKindQUEUE, append(resourceIds, "queue-c"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"queue-c"}, available)
+
+	var leaseA *dbsqlc.Lease
+
+	for _, lease := range acquired {
+		if lease.ResourceId == "queue-a" {
+			leaseA = lease
+		}
+	}
+
+	require.NotNil(t, leaseA)
+
+	// extending a lease keeps its fencing token
+	extended, err := leases.AcquireOrExtendLeases(ctx, tenantId, dbsqlc.LeaseKindQUEUE, []string{"queue-a"}, []int64{leaseA.ID})
+	require.NoError(t, err)
+	require.Len(t, extended, 1)
+	assert.Equal(t, leaseA.FencingToken, extended[0].FencingToken)
+
+	// fenced writes are accepted with the fencing token of the lease, and rejected with another one
+	err = backend.Scheduler.Queue().RemoveQueueItems(ctx, tenantId, &repository.QueueFence{
+		ResourceId:   "queue-a",
+		FencingToken: leaseA.FencingToken,
+	}, []int64{})
+
+	assert.NoError(t, err)
+
+	err = backend.Scheduler.Queue().RemoveQueueItems(ctx, tenantId, &repository.QueueFence{
+		ResourceId:   "queue-a",
+		FencingToken: leaseA.FencingToken + 1,
+	}, []int64{})
+
+	assert.True(t, errors.Is(err, repository.ErrLeaseLost), "fenced writes must return repository.ErrLeaseLost, got %v", err)
+
+	acquiredIds := make([]int64, 0, len(acquired))
+
+	for _, lease := range acquired {
+		acquiredIds = append(acquiredIds, lease.ID)
+	}
+
+	require.NoError(t, leases.ReleaseLeases(ctx, acquiredIds))
+
+	available, err = leases.ListAvailableLeaseResources(ctx, tenantId, dbsqlc.LeaseKindQUEUE, resourceIds)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, resourceIds, available)
+
+	// writes which are fenced by a released lease are rejected
+	err = backend.Scheduler.Queue().RemoveQueueItems(ctx, tenantId, &repository.QueueFence{
+		ResourceId:   "queue-a",
+		FencingToken: leaseA.FencingToken,
+	}, []int64{})
+
+	assert.True(t, errors.Is(err, repository.ErrLeaseLost), "fenced writes must return repository.ErrLeaseLost, got %v", err)
+
+	// leases are tenant-scoped, so other tenants can lease resources with the same ids
+	otherTenant, err := leases.AcquireOrExtendLeases(ctx, createTenant(t, backend), dbsqlc.LeaseKindQUEUE, resourceIds, nil)
+	require.NoError(t, err)
+	assert.Len(t, otherTenant, 2)
+}
+
+func testSchedulingDecisions(t *testing.T, backend Backend) {
+	ctx := context.Background()
+	tenantId := createTenant(t, backend)
+
+	stepRunId := uuid.New().String()
+	createdAt := time.Now().UTC().Add(-time.Minute)
+
+	opts := make([]*repository.CreateSchedulingDecisionOpts, 0, 3)
+
+	for i := 0; i < 3; i++ {
+		opts = append(opts, &repository.CreateSchedulingDecisionOpts{
+			StepRunId: sqlchelpers.UUIDFromStr(stepRunId),
+			Queue:     "conformance",
+			Outcome:   dbsqlc.SchedulingDecisionOutcomeNOSLOTS,
+			Message:   fmt.Sprintf("decision %d", i),
+			Data:      map[string]interface{}{"index": i},
+			CreatedAt: createdAt.Add(time.Duration(i) * time.Second),
+		})
+	}
+
+	// only the newest decisions of the tenant are kept
+	require.NoError(t, backend.Scheduler.Assignment().CreateSchedulingDecisions(ctx, tenantId, opts, 2))
+
+	decisions, err := backend.API.StepRun().ListSchedulingDecisions(tenantId, stepRunId, &repository.ListSchedulingDecisionsOpts{})
+	require.NoError(t, err)
+	require.Len(t, decisions.Rows, 2)
+	assert.Equal(t, "decision 2", decisions.Rows[0].Message, "decisions must be listed newest first")
+	assert.Equal(t, "decision 1", decisions.Rows[1].Message)
+
+	// decisions must only be listed for their own tenant
+	decisions, err = backend.API.StepRun().ListSchedulingDecisions(createTenant(t, backend), stepRunId, &repository.ListSchedulingDecisionsOpts{})
+	require.NoError(t, err)
+	assert.Empty(t, decisions.Rows)
+}
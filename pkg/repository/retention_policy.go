@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type UpsertRetentionPolicyOpts struct {
+	// (optional) the retention periods of the tenant as Go durations, the data retention period of the tenant
+	// applies to the periods which are nil
+	WorkflowRunRetentionPeriod *string `validate:"omitnil,duration"`
+	EventRetentionPeriod       *string `validate:"omitnil,duration"`
+}
+
+type RetentionPolicyRepository interface {
+	// GetRetentionPolicy returns the retention policy of the tenant, it returns pgx.ErrNoRows if none is set.
+	GetRetentionPolicy(ctx context.Context, tenantId string) (*dbsqlc.TenantRetentionPolicy, error)
+
+	// UpsertRetentionPolicy sets the retention policy of the tenant, replacing the policy which was set before.
+	UpsertRetentionPolicy(ctx context.Context, tenantId string, opts *UpsertRetentionPolicyOpts) (*dbsqlc.TenantRetentionPolicy, error)
+
+	// PruneWorkflowRuns permanently deletes up to limit workflow runs which were soft-deleted before deletedBefore,
+	// and returns the number of deleted workflow runs. If archive is not nil, it's called with the deleted runs
+	// before the deletion is committed, and the deletion is rolled back if it returns an error.
+	PruneWorkflowRuns(ctx context.Context, tenantId string, deletedBefore time.Time, limit int, archive func(runs []*dbsqlc.PruneWorkflowRunsRow) error) (int, error)
+}
@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// ErrLeaseLost is returned when a scheduler writes for a queue shard whose lease it no longer holds, for example
+// because the lease expired during a long pause and was acquired by another scheduler.
+var ErrLeaseLost = errors.New("queue lease was lost")
+
+// QueueFence identifies the lease a scheduler held when it read the queue items it writes for. Fenced writes are
+// rejected with ErrLeaseLost if the lease was released or acquired by another scheduler since.
+type QueueFence struct {
+	// ResourceId is the resource id of the queue lease
+	ResourceId string
+
+	// FencingToken is the fencing token of the lease when it was acquired
+	FencingToken int64
+}
+
+type ListQueueItemsOpts struct {
+	Queue string
+
+	// (optional) only list queue items with a greater id
+	GtId *int64
+
+	// (optional) the number of shards of the queue and the shard to list, the queue isn't sharded if unset
+	ShardCount *int
+	ShardIndex *int
+
+	Limit int
+}
+
+type AssignQueueItemOpts struct {
+	QueueItemId int64
+
+	StepRunId pgtype.UUID
+
+	WorkerId pgtype.UUID
+
+	StepTimeout string
+}
+
+type MarkQueueItemsProcessedOpts struct {
+	// (optional) the lease of the queue shard, the write isn't fenced if unset
+	Fence *QueueFence
+
+	// Assigned are the queue items which are assigned to a worker
+	Assigned []*AssignQueueItemOpts
+
+	// SchedulingTimedOut are the queue items whose scheduling timeout passed, their step runs are cancelled
+	SchedulingTimedOut []*dbsqlc.QueueItem
+}
+
+type UpsertDynamicRateLimitsOpts struct {
+	Keys []string
+
+	Windows []string
+
+	LimitValues []int32
+
+	// (optional) when the keys expire if they aren't used again
+	ExpiresAt *time.Time
+}
+
+type CreateSchedulingDecisionOpts struct {
+	StepRunId pgtype.UUID
+
+	Queue string
+
+	Outcome dbsqlc.SchedulingDecisionOutcome
+
+	// (optional) the worker the step run was assigned to
+	WorkerId pgtype.UUID
+
+	Message string
+
+	Data map[string]interface{}
+
+	CreatedAt time.Time
+}
+
+// SchedulerRepository is used by the schedulers in pkg/scheduling/v2. Schedulers acquire leases on the queues and
+// workers of a tenant, and only the scheduler which holds the lease of a queue shard writes its assignments.
+type SchedulerRepository interface {
+	Lease() SchedulerLeaseRepository
+	Queue() SchedulerQueueRepository
+	RateLimit() SchedulerRateLimitRepository
+	Assignment() SchedulerAssignmentRepository
+}
+
+type SchedulerLeaseRepository interface {
+	ListQueues(ctx context.Context, tenantId string) ([]*dbsqlc.Queue, error)
+
+	ListActiveWorkers(ctx context.Context, tenantId string) ([]*dbsqlc.ListActiveWorkersRow, error)
+
+	ListWorkerLabels(ctx context.Context, workerIds []pgtype.UUID) ([]*dbsqlc.ListManyWorkerLabelsRow, error)
+
+	// AcquireOrExtendLeases extends the existing leases, and acquires the leases of the resources which are not
+	// leased or whose lease expired. It returns the leases which are held after the call.
+	AcquireOrExtendLeases(ctx context.Context, tenantId string, kind dbsqlc.LeaseKind, resourceIds []string, existingLeaseIds []int64) ([]*dbsqlc.Lease, error)
+
+	ReleaseLeases(ctx context.Context, leaseIds []int64) error
+
+	// ListAvailableLeaseResources returns the resources which are not leased by another scheduler
+	ListAvailableLeaseResources(ctx context.Context, tenantId string, kind dbsqlc.LeaseKind, resourceIds []string) ([]string, error)
+}
+
+type SchedulerQueueRepository interface {
+	// ListQueueItems lists the unprocessed queue items of a queue in id order, along with the status of their step runs
+	ListQueueItems(ctx context.Context, tenantId string, opts *ListQueueItemsOpts) ([]*dbsqlc.ListQueueItemsForQueueRow, error)
+
+	// ListQueueItemsFairShare lists the unprocessed queue items of a queue in a weighted round robin across workflows,
+	// keyed by workflow id. Workflows without a weight have a weight of 1. GtId is ignored and at most Limit queue
+	// items are listed per step.
+	ListQueueItemsFairShare(ctx context.Context, tenantId string, opts *ListQueueItemsOpts, weights map[string]int32) ([]*dbsqlc.ListQueueItemsForQueueRow, error)
+
+	// ListQueueItemsEDF lists the unprocessed queue items of a queue with the earliest deadline first
+	ListQueueItemsEDF(ctx context.Context, tenantId string, opts *ListQueueItemsOpts) ([]*dbsqlc.ListQueueItemsForQueueRow, error)
+
+	// GetMinUnprocessedQueueItemId returns the lowest id of the unprocessed queue items of a queue, or 0 if there are none
+	GetMinUnprocessedQueueItemId(ctx context.Context, tenantId, queue string) (int64, error)
+
+	// RemoveQueueItems marks queue items as processed without assigning them
+	RemoveQueueItems(ctx context.Context, tenantId string, fence *QueueFence, queueItemIds []int64) error
+
+	// MarkQueueItemsProcessed assigns step runs to workers and cancels the step runs which timed out in a single
+	// transaction. It returns the step runs which were assigned, the others were no longer assignable.
+	MarkQueueItemsProcessed(ctx context.Context, tenantId string, opts *MarkQueueItemsProcessedOpts) ([]*dbsqlc.UpdateStepRunsToAssignedRow, error)
+}
+
+type SchedulerRateLimitRepository interface {
+	// ListCandidateRateLimits returns the keys of the rate limits of a tenant
+	ListCandidateRateLimits(ctx context.Context, tenantId string) ([]string, error)
+
+	// UpdateRateLimits consumes units of the rate limits of a tenant and of the global rate limits, and returns the
+	// remaining units of all of them, keyed by rate limit key. Negative units are returned to the rate limit.
+	UpdateRateLimits(ctx context.Context, tenantId string, updates map[string]int, globalUpdates map[string]int) (rateLimits map[string]int, globalRateLimits map[string]int, err error)
+
+	// ListStepRunExpressionEvals lists the evaluated rate limit expressions of step runs
+	ListStepRunExpressionEvals(ctx context.Context, stepRunIds []pgtype.UUID) ([]*dbsqlc.StepRunExpressionEval, error)
+
+	// UpsertDynamicRateLimits creates the rate limits of dynamic keys, and updates the limits and windows of existing keys
+	UpsertDynamicRateLimits(ctx context.Context, tenantId string, opts *UpsertDynamicRateLimitsOpts) error
+
+	ListRateLimitsForSteps(ctx context.Context, tenantId string, stepIds []pgtype.UUID) ([]*dbsqlc.StepRateLimit, error)
+
+	ListGlobalRateLimitsForSteps(ctx context.Context, tenantId string, stepIds []pgtype.UUID) ([]*dbsqlc.StepGlobalRateLimit, error)
+}
+
+type SchedulerAssignmentRepository interface {
+	ListActionsForWorkers(ctx context.Context, tenantId string, workerIds []pgtype.UUID) ([]*dbsqlc.ListActionsForWorkersRow, error)
+
+	ListAvailableSlotsForWorkers(ctx context.Context, tenantId string, workerIds []pgtype.UUID) ([]*dbsqlc.ListAvailableSlotsForWorkersRow, error)
+
+	// ListActiveSlotReservations deletes the slot reservations of finished workflow runs and lists the others
+	ListActiveSlotReservations(ctx context.Context, tenantId string) ([]*dbsqlc.ListActiveSlotReservationsRow, error)
+
+	ListQueuedStepRunsForSlotReservations(ctx context.Context, tenantId string) ([]*dbsqlc.ListQueuedStepRunsForSlotReservationsRow, error)
+
+	GetDesiredLabels(ctx context.Context, stepIds []pgtype.UUID) ([]*dbsqlc.GetDesiredLabelsRow, error)
+
+	GetDesiredRegions(ctx context.Context, stepIds []pgtype.UUID) ([]*dbsqlc.GetDesiredRegionsRow, error)
+
+	GetDesiredSlotTypes(ctx context.Context, stepIds []pgtype.UUID) ([]*dbsqlc.GetDesiredSlotTypesRow, error)
+
+	GetDesiredPools(ctx context.Context, stepIds []pgtype.UUID) ([]*dbsqlc.GetDesiredPoolsRow, error)
+
+	// CreateWorkerAssignEvents records the step runs which were assigned to each worker, keyed by worker id
+	CreateWorkerAssignEvents(ctx context.Context, workerIdsToStepRunIds map[string][]string) error
+
+	// CreateSchedulingDecisions records scheduling decisions and keeps the newest maxDecisions of the tenant
+	CreateSchedulingDecisions(ctx context.Context, tenantId string, opts []*CreateSchedulingDecisionOpts, maxDecisions int) error
+
+	// DeferredStepRunEvent buffers a step run event, which is written in the background
+	DeferredStepRunEvent(tenantId string, opts CreateStepRunEventOpts)
+}
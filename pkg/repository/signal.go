@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type CreateSignalWaitOpts struct {
+	// (required) the key of the event which resumes the step run
+	EventKey string `validate:"required,min=1"`
+
+	// (optional) the CEL expression which is evaluated against the event payload
+	CorrelationExpr *string
+
+	// (optional) the value of the correlation expression for the run input
+	CorrelationValue *string
+}
+
+type SignalEngineRepository interface {
+	// CreateSignalWait registers a step run as waiting for an event.
+	CreateSignalWait(ctx context.Context, tenantId, stepRunId string, opts *CreateSignalWaitOpts) error
+
+	// ListSignalWaitsForEvent lists the step runs of a tenant which are waiting for an event with the given key.
+	ListSignalWaitsForEvent(ctx context.Context, tenantId, eventKey string) ([]*dbsqlc.ListStepRunSignalWaitsForEventRow, error)
+
+	// ClaimSignalWait removes the wait of a step run along with its timeout timer. It returns false if the wait
+	// was already claimed, either by another event or because it timed out.
+	ClaimSignalWait(ctx context.Context, tenantId, stepRunId string) (bool, error)
+}
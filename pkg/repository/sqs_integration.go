@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type CreateSQSIntegrationOpts struct {
+	// (required) the url of the queue which messages are received from
+	QueueUrl string `validate:"required,url"`
+
+	// (required) the region of the queue
+	Region string `validate:"required"`
+
+	// (required) the key of the events which messages are ingested as
+	EventKey string `validate:"required"`
+
+	// (required) the name of the tenant secret which stores the AWS access key id
+	AccessKeyIdSecret string `validate:"required"`
+
+	// (required) the name of the tenant secret which stores the AWS secret access key
+	SecretAccessKeySecret string `validate:"required"`
+
+	// (optional) the name of the tenant secret which stores an AWS session token
+	SessionTokenSecret *string
+}
+
+type SQSIntegrationRepository interface {
+	// CreateSQSIntegration creates an integration which polls an SQS queue and ingests its messages as events.
+	CreateSQSIntegration(ctx context.Context, tenantId string, opts *CreateSQSIntegrationOpts) (*dbsqlc.SQSIntegration, error)
+
+	// ListSQSIntegrations lists the SQS integrations of a tenant.
+	ListSQSIntegrations(ctx context.Context, tenantId string) ([]*dbsqlc.SQSIntegration, error)
+
+	// ListSQSIntegrationsByPartitionId lists the SQS integrations of the tenants which are assigned to a worker
+	// partition.
+	ListSQSIntegrationsByPartitionId(ctx context.Context, partitionId string) ([]*dbsqlc.SQSIntegration, error)
+
+	// GetSQSIntegrationById returns an SQS integration by its id.
+	GetSQSIntegrationById(ctx context.Context, id string) (*dbsqlc.SQSIntegration, error)
+
+	// DeleteSQSIntegration deletes an SQS integration. The queue stops being polled within a few seconds.
+	DeleteSQSIntegration(ctx context.Context, id string) error
+}
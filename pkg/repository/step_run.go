@@ -36,7 +36,8 @@ func IsFinalJobRunStatus(status dbsqlc.JobRunStatus) bool {
 func IsFinalWorkflowRunStatus(status dbsqlc.WorkflowRunStatus) bool {
 	return status != dbsqlc.WorkflowRunStatusPENDING &&
 		status != dbsqlc.WorkflowRunStatusRUNNING &&
-		status != dbsqlc.WorkflowRunStatusQUEUED
+		status != dbsqlc.WorkflowRunStatusQUEUED &&
+		status != dbsqlc.WorkflowRunStatusPAUSED
 }
 
 type CreateStepRunEventOpts struct {
@@ -128,9 +129,29 @@ type ListStepRunArchivesResult struct {
 	Count int
 }
 
+type ListSchedulingDecisionsOpts struct {
+	// (optional) number of decisions to skip
+	Offset *int
+
+	// (optional) number of decisions to return
+	Limit *int
+}
+
+type ListSchedulingDecisionsResult struct {
+	Rows  []*dbsqlc.SchedulingDecision
+	Count int
+}
+
 type GetStepRunFull struct {
 	*dbsqlc.StepRun
 	ChildWorkflowRuns []string
+
+	// NextRetryAt is the time of the pending retry of the step run, if the step run is waiting on a retry
+	// backoff
+	NextRetryAt *time.Time
+
+	// Progress is the latest progress which the step run reported, if it reported progress
+	Progress *dbsqlc.StepRunProgress
 }
 
 type RefreshTimeoutBy struct {
@@ -148,6 +169,9 @@ type StepRunAPIRepository interface {
 	ListStepRunEventsByWorkflowRunId(ctx context.Context, tenantId, workflowRunId string, lastId *int32) (*ListStepRunEventResult, error)
 
 	ListStepRunArchives(tenantId, stepRunId string, opts *ListStepRunArchivesOpts) (*ListStepRunArchivesResult, error)
+
+	// ListSchedulingDecisions lists the recorded scheduling decisions for a step run, newest first
+	ListSchedulingDecisions(tenantId, stepRunId string, opts *ListSchedulingDecisionsOpts) (*ListSchedulingDecisionsResult, error)
 }
 
 type QueuedStepRun struct {
@@ -174,6 +198,65 @@ type ProcessStepRunUpdatesResultV2 struct {
 	Continue              bool
 }
 
+type BulkReassignStepRunsOpts struct {
+	// (optional) the maximum number of step runs to reassign, defaults to 1000
+	Limit *int `validate:"omitnil,min=1"`
+
+	// (optional) the cursor returned by the previous batch
+	Cursor *string `validate:"omitnil,uuid"`
+}
+
+type BulkReassignStepRunsResult struct {
+	ReassignedStepRunIds []string
+
+	FailedStepRuns []*dbsqlc.GetStepRunForEngineRow
+
+	// NextCursor is set if the batch was full, and there may be more step runs to reassign
+	NextCursor *string
+}
+
+// ErrNoSpeculativeWorker is returned when there is no worker available to run a speculative attempt.
+var ErrNoSpeculativeWorker = fmt.Errorf("no worker available for speculative attempt")
+
+type SpeculativeAttempt struct {
+	WorkerId     string
+	DispatcherId string
+}
+
+type SpeculativeAttemptResolution string
+
+const (
+	// SpeculativeAttemptNone means that the event should be processed as usual, either because the step run
+	// has no speculative attempt or because all attempts have failed.
+	SpeculativeAttemptNone SpeculativeAttemptResolution = "NONE"
+
+	// SpeculativeAttemptWon means that the attempt was the first to succeed, and the other attempt should be
+	// cancelled.
+	SpeculativeAttemptWon SpeculativeAttemptResolution = "WON"
+
+	// SpeculativeAttemptLost means that another attempt has already succeeded, and the event should be ignored.
+	SpeculativeAttemptLost SpeculativeAttemptResolution = "LOST"
+
+	// SpeculativeAttemptPending means that the attempt failed while another attempt is still running, and the
+	// failure should be ignored.
+	SpeculativeAttemptPending SpeculativeAttemptResolution = "PENDING"
+)
+
+type ResolveSpeculativeAttemptResult struct {
+	Resolution SpeculativeAttemptResolution
+
+	// the worker running the other attempt, set when the resolution is SpeculativeAttemptWon
+	LoserWorkerId string
+}
+
+// StepRunAckRetention is how long the completion and failure events of step run attempts are kept, which is the longest
+// time after which an event which is sent again is still detected as a duplicate.
+const StepRunAckRetention = 24 * time.Hour
+
+// StepRunHeartbeatRetention is how long the last heartbeat of a step run is kept. Running step runs whose heartbeat
+// was deleted are measured from when they started, so this should be longer than the step run heartbeat timeout.
+const StepRunHeartbeatRetention = 24 * time.Hour
+
 type StepRunEngineRepository interface {
 	RegisterWorkflowRunCompletedCallback(callback TenantScopedCallback[*dbsqlc.ResolveWorkflowRunStatusRow])
 
@@ -181,19 +264,76 @@ type StepRunEngineRepository interface {
 
 	ListStepRunsToCancel(ctx context.Context, tenantId, jobRunId string) ([]*dbsqlc.GetStepRunForEngineRow, error)
 
-	// ListStepRunsToReassign returns a list of step runs which are in a reassignable state.
-	ListStepRunsToReassign(ctx context.Context, tenantId string) (reassignedStepRunIds []string, failedStepRuns []*dbsqlc.GetStepRunForEngineRow, err error)
+	// BulkReassignStepRuns reassigns a batch of step runs on inactive workers in a single statement. Step runs
+	// which have exhausted their internal retries are returned so that they can be failed.
+	BulkReassignStepRuns(ctx context.Context, tenantId string, opts *BulkReassignStepRunsOpts) (*BulkReassignStepRunsResult, error)
+
+	// ReassignLostStepRuns reassigns a batch of running step runs which haven't had a heartbeat within the step run
+	// heartbeat timeout. It returns an empty result if the step run heartbeat timeout isn't set.
+	ReassignLostStepRuns(ctx context.Context, tenantId string, opts *BulkReassignStepRunsOpts) (*BulkReassignStepRunsResult, error)
+
+	// ReleaseDanglingSemaphoreSlots releases the worker slots which are held by step runs which are finished or no
+	// longer exist, and returns the number of released slots.
+	ReleaseDanglingSemaphoreSlots(ctx context.Context, tenantId string) (int64, error)
+
+	// DequeueOrphanedQueueItems removes the queue items of step runs which are finished or no longer exist, and
+	// returns the number of removed queue items.
+	DequeueOrphanedQueueItems(ctx context.Context, tenantId string) (int64, error)
 
 	ListStepRunsToTimeout(ctx context.Context, tenantId string) (bool, []*dbsqlc.GetStepRunForEngineRow, error)
 
+	// ListStepRunsToHeartbeatTimeout returns running step runs whose worker hasn't sent a heartbeat within the
+	// step's heartbeat timeout. The returned step runs are marked as cancelling.
+	ListStepRunsToHeartbeatTimeout(ctx context.Context, tenantId string) (bool, []*dbsqlc.GetStepRunForEngineRow, error)
+
+	// ListStepRunsToSpeculate returns running step runs which have been running for longer than the speculative
+	// percentile of recent durations for their step, and which don't have a speculative attempt yet.
+	ListStepRunsToSpeculate(ctx context.Context, tenantId string) (bool, []*dbsqlc.ListStepRunsToSpeculateRow, error)
+
+	// CreateSpeculativeAttempt picks a worker for a speculative attempt of a step run and records the attempt.
+	// It returns ErrNoSpeculativeWorker if no other worker has an available slot.
+	CreateSpeculativeAttempt(ctx context.Context, tenantId string, stepRun *dbsqlc.ListStepRunsToSpeculateRow) (*SpeculativeAttempt, error)
+
+	// ResolveSpeculativeAttempt records that the attempt of a step run on a worker has finished, and returns how
+	// the event should be processed.
+	ResolveSpeculativeAttempt(ctx context.Context, tenantId, stepRunId string, retryCount int32, workerId string, succeeded bool) (*ResolveSpeculativeAttemptResult, error)
+
+	// AckStepRunAttempt records the completion or failure event of an attempt of a step run. It returns false if an
+	// event of the attempt was already recorded, in which case the event is a duplicate.
+	AckStepRunAttempt(ctx context.Context, tenantId, stepRunId, attemptId, eventType string) (bool, error)
+
+	// UnackStepRunAttempt deletes the recorded event of an attempt of a step run, so the event is processed when it's
+	// sent again. It's called when the event was recorded but couldn't be processed.
+	UnackStepRunAttempt(ctx context.Context, tenantId, stepRunId, attemptId string) error
+
+	// DeleteExpiredStepRunAcks deletes the events of step run attempts which were recorded before the given time.
+	DeleteExpiredStepRunAcks(ctx context.Context, tenantId string, before time.Time) error
+
+	// UpdateStepRunHeartbeats records a heartbeat for the step runs which are running on the worker.
+	UpdateStepRunHeartbeats(ctx context.Context, tenantId, workerId string, stepRunIds []string, heartbeatAt time.Time) error
+
+	// DeleteExpiredStepRunHeartbeats deletes the heartbeats of step runs which were last sent before the given time.
+	DeleteExpiredStepRunHeartbeats(ctx context.Context, tenantId string, before time.Time) error
+
 	StepRunAcked(ctx context.Context, tenantId, workflowRunId, stepRunId string, ackedAt time.Time) error
 
 	StepRunStarted(ctx context.Context, tenantId, workflowRunId, stepRunId string, startedAt time.Time) error
 
+	// StepRunSleeping marks a step run which does not run on a worker as running and creates a timer which wakes
+	// it up at wakeAt.
+	StepRunSleeping(ctx context.Context, tenantId, workflowRunId, stepRunId string, startedAt, wakeAt time.Time) error
+
 	StepRunSucceeded(ctx context.Context, tenantId, workflowRunId, stepRunId string, finishedAt time.Time, output []byte) error
 
 	StepRunCancelled(ctx context.Context, tenantId, workflowRunId, stepRunId string, cancelledAt time.Time, cancelledReason string, propagate bool) error
 
+	// StepRunSkipped marks a pending step run as skipped and resolves the status of its job run and workflow run.
+	// It returns false if the step run is no longer pending.
+	StepRunSkipped(ctx context.Context, tenantId, stepRunId string, skippedAt time.Time) (bool, error)
+
+	// HasOnlySkippedParents returns true if the step run has parents and all of them have been skipped.
+	HasOnlySkippedParents(ctx context.Context, stepRunId string) (bool, error)
+
 	StepRunFailed(ctx context.Context, tenantId, workflowRunId, stepRunId string, failedAt time.Time, errStr string, retryCount int) error
 
 	StepRunRetryBackoff(ctx context.Context, tenantId, stepRunId string, retryAfter time.Time) error
@@ -225,8 +365,15 @@ type StepRunEngineRepository interface {
 	// a pending state.
 	QueueStepRun(ctx context.Context, tenantId, stepRunId string, opts *QueueStepRunOpts) (*dbsqlc.GetStepRunForEngineRow, error)
 
+	// QueueWorkerlessStepRun moves a step run which doesn't run on a worker, like a sleep or approval step run, to
+	// pending assignment, so it can be started and finished without being assigned to a worker.
+	QueueWorkerlessStepRun(ctx context.Context, tenantId, stepRunId string) error
+
 	GetQueueCounts(ctx context.Context, tenantId string) (map[string]int, error)
 
+	// ListQueueCountsForAllTenants returns the number of queued step runs keyed by tenant id and queue
+	ListQueueCountsForAllTenants(ctx context.Context) (map[string]map[string]int, error)
+
 	ProcessStepRunUpdates(ctx context.Context, qlp *zerolog.Logger, tenantId string) (ProcessStepRunUpdatesResult, error)
 
 	ProcessStepRunUpdatesV2(ctx context.Context, qlp *zerolog.Logger, tenantId string) (ProcessStepRunUpdatesResultV2, error)
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+type CacheStepRunOutputOpts struct {
+	// (required) the action id of the step
+	ActionId string `validate:"required"`
+
+	// (required) the hash of the input of the step run
+	InputHash string `validate:"required"`
+
+	// (required) the step run which the output is cached from
+	StepRunId string `validate:"required,uuid"`
+
+	// (required) the output of the step run
+	Output []byte `validate:"required"`
+
+	// (required) how long the output is cached for
+	TTL time.Duration `validate:"required,gt=0"`
+}
+
+type StepRunCacheRepository interface {
+	// GetCachedOutput returns the cached output for the action and input hash, or nil if there is no unexpired
+	// output.
+	GetCachedOutput(ctx context.Context, tenantId, actionId, inputHash string) ([]byte, error)
+
+	// CacheOutput caches the output of a step run. An unexpired output for the same action and input hash is kept.
+	CacheOutput(ctx context.Context, tenantId string, opts *CacheStepRunOutputOpts) error
+
+	// DeleteExpiredOutputs deletes the cached outputs which have expired.
+	DeleteExpiredOutputs(ctx context.Context, tenantId string) error
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type ReportStepRunProgressOpts struct {
+	// (required) the step run which reports its progress
+	StepRunId string `validate:"required,uuid"`
+
+	// (required) the percentage of the step run which is done, between 0 and 100
+	Percent float64 `validate:"gte=0,lte=100"`
+
+	// (optional) a message which describes the progress
+	Message *string `validate:"omitnil,max=1000"`
+
+	// (optional) arbitrary JSON data which is reported along with the progress
+	Data []byte `validate:"omitempty,max=65536"`
+}
+
+// StepRunProgressRepository stores the progress which long-running step runs report, so it can be shown while they
+// run.
+type StepRunProgressRepository interface {
+	// ReportProgress stores the latest progress of a step run, and replaces the progress which it reported before.
+	ReportProgress(ctx context.Context, tenantId string, opts *ReportStepRunProgressOpts) (*dbsqlc.StepRunProgress, error)
+}
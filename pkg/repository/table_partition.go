@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// PartitionedTable is a table which is partitioned by range of "createdAt", with a partition per day.
+type PartitionedTable string
+
+const (
+	PartitionedTableStepRun   PartitionedTable = "StepRun"
+	PartitionedTableEvent     PartitionedTable = "Event"
+	PartitionedTableQueueItem PartitionedTable = "QueueItem"
+)
+
+var PartitionedTables = []PartitionedTable{
+	PartitionedTableStepRun,
+	PartitionedTableEvent,
+	PartitionedTableQueueItem,
+}
+
+type TablePartition struct {
+	Name string
+
+	// RangeEnd is the exclusive end of the range of "createdAt" of the partition
+	RangeEnd time.Time
+}
+
+type TablePartitionRepository interface {
+	// WithMaintenanceLock calls f while holding a lock which is held by a single engine at a time. It returns false
+	// without calling f if the lock is held by another engine.
+	WithMaintenanceLock(ctx context.Context, f func(ctx context.Context) error) (bool, error)
+
+	// ListPartitions returns the partitions of the table, ordered by the end of their range.
+	ListPartitions(ctx context.Context, table PartitionedTable) ([]*TablePartition, error)
+
+	// CreatePartition creates the partition of the table for the day which starts at day, if it doesn't exist.
+	CreatePartition(ctx context.Context, table PartitionedTable, day time.Time) error
+
+	// HasLiveRows returns whether the table has rows created before createdBefore which can't be dropped yet: step
+	// runs and events which aren't deleted or which were deleted after deletedBefore, and queued queue items.
+	HasLiveRows(ctx context.Context, table PartitionedTable, createdBefore, deletedBefore time.Time) (bool, error)
+
+	// DeleteStepRunDependencies deletes the rows which reference up to limit of the step runs created before
+	// createdBefore with an id after afterId, and returns these step runs.
+	DeleteStepRunDependencies(ctx context.Context, createdBefore time.Time, afterId string, limit int) ([]*dbsqlc.DeleteStepRunDependenciesRow, error)
+
+	// DeleteEventDependencies deletes the rows which reference up to limit of the events created before
+	// createdBefore with an id after afterId, and returns these events.
+	DeleteEventDependencies(ctx context.Context, createdBefore time.Time, afterId string, limit int) ([]*dbsqlc.DeleteEventDependenciesRow, error)
+
+	// DropPartition detaches the partition from the table without blocking the queries on the table, and drops it.
+	DropPartition(ctx context.Context, table PartitionedTable, partition string) error
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
@@ -125,6 +126,24 @@ type TenantEngineRepository interface {
 
 	RebalanceInactiveControllerPartitions(ctx context.Context) error
 
+	// CountActiveControllerPartitions returns the number of controller partitions which sent a heartbeat in the last
+	// minute.
+	CountActiveControllerPartitions(ctx context.Context) (int64, error)
+
+	// AcquireTenantPartitions acquires the leases on the tenant partitions for the controller partition, or extends them
+	// if it already holds them. It returns the tenant partitions which the controller partition holds.
+	AcquireTenantPartitions(ctx context.Context, controllerPartitionId string, partitions []int32, leaseDuration time.Duration) ([]int32, error)
+
+	// ReleaseTenantPartitions releases the leases on the tenant partitions which are held by the controller partition.
+	ReleaseTenantPartitions(ctx context.Context, controllerPartitionId string, partitions []int32) error
+
+	// AssignTenantPartitions assigns the tenants of the tenant partitions to the controller partition, and returns the
+	// number of tenants which were moved from another controller partition.
+	AssignTenantPartitions(ctx context.Context, controllerPartitionId string, partitionCount int32, partitions []int32) (int64, error)
+
+	// ListTenantPartitions returns the tenant partitions along with the controller partitions which hold them.
+	ListTenantPartitions(ctx context.Context, partitionCount int32) ([]*dbsqlc.ListTenantPartitionsRow, error)
+
 	CreateSchedulerPartition(ctx context.Context) (string, error)
 
 	UpdateSchedulerPartitionHeartbeat(ctx context.Context, partitionId string) (string, error)
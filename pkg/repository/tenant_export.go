@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type ListWorkflowRunsForExportOpts struct {
+	// (optional) the creation time and id of the last run of the previous page, runs are returned in order of
+	// creation
+	AfterCreatedAt *time.Time
+	AfterId        *string `validate:"omitnil,uuid"`
+
+	// (required) the max number of runs to return
+	Limit int `validate:"required,min=1,max=10000"`
+}
+
+type TenantExportRepository interface {
+	// ListWorkflowDefinitions returns the latest version of every workflow of the tenant, with the definition which
+	// the version was registered with. The definition is nil for versions which were registered before definitions
+	// were stored.
+	ListWorkflowDefinitions(ctx context.Context, tenantId string) ([]*dbsqlc.ListWorkflowDefinitionsRow, error)
+
+	// ListCronsForExport returns the enabled crons of the tenant which were created through the API.
+	ListCronsForExport(ctx context.Context, tenantId string) ([]*dbsqlc.ListCronsForExportRow, error)
+
+	// ListScheduledRunsForExport returns the scheduled runs of the tenant which were created through the API and
+	// haven't been triggered yet.
+	ListScheduledRunsForExport(ctx context.Context, tenantId string) ([]*dbsqlc.ListScheduledRunsForExportRow, error)
+
+	// ListRateLimitsForExport returns the static rate limits of the tenant.
+	ListRateLimitsForExport(ctx context.Context, tenantId string) ([]*dbsqlc.ListRateLimitsForExportRow, error)
+
+	// ListWorkflowRunsForExport returns a page of the workflow runs of the tenant, in order of creation.
+	ListWorkflowRunsForExport(ctx context.Context, tenantId string, opts *ListWorkflowRunsForExportOpts) ([]*dbsqlc.ListWorkflowRunsForExportRow, error)
+}
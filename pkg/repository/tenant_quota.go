@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+type TenantQuotaResource string
+
+const (
+	TenantQuotaResourceQueuedRuns       TenantQuotaResource = "QUEUED_RUNS"
+	TenantQuotaResourceWorkers          TenantQuotaResource = "WORKERS"
+	TenantQuotaResourceEventsPerMinute  TenantQuotaResource = "EVENTS_PER_MINUTE"
+	TenantQuotaResourceWorkflowVersions TenantQuotaResource = "WORKFLOW_VERSIONS"
+)
+
+var TenantQuotaResources = []TenantQuotaResource{
+	TenantQuotaResourceQueuedRuns,
+	TenantQuotaResourceWorkers,
+	TenantQuotaResourceEventsPerMinute,
+	TenantQuotaResourceWorkflowVersions,
+}
+
+// ErrQuotaExceeded is returned when creating a resource would exceed the quota of the tenant.
+type ErrQuotaExceeded struct {
+	Resource TenantQuotaResource
+	Limit    int32
+	Usage    int32
+}
+
+func (e ErrQuotaExceeded) Error() string {
+	return fmt.Sprintf("tenant quota exceeded for %s: the quota is %d and %d are in use", e.Resource, e.Limit, e.Usage)
+}
+
+type UpsertTenantQuotaOpts struct {
+	// (optional) the quotas of the tenant, the server default applies to the quotas which are nil and 0 is unlimited
+	MaxQueuedRuns       *int32 `validate:"omitnil,min=0"`
+	MaxWorkers          *int32 `validate:"omitnil,min=0"`
+	MaxEventsPerMinute  *int32 `validate:"omitnil,min=0"`
+	MaxWorkflowVersions *int32 `validate:"omitnil,min=0"`
+}
+
+type TenantQuotaUsage struct {
+	Resource TenantQuotaResource
+
+	// the quota which applies to the tenant, 0 is unlimited
+	Limit int32
+
+	Usage int32
+}
+
+type TenantQuotaRepository interface {
+	// GetTenantQuota returns the quotas which are set on the tenant, it returns pgx.ErrNoRows if none are set.
+	GetTenantQuota(ctx context.Context, tenantId string) (*dbsqlc.TenantQuota, error)
+
+	// UpsertTenantQuota sets the quotas of the tenant, replacing the quotas which were set before.
+	UpsertTenantQuota(ctx context.Context, tenantId string, opts *UpsertTenantQuotaOpts) (*dbsqlc.TenantQuota, error)
+
+	// ListQuotaUsage returns the quota which applies to the tenant and the current usage of every resource.
+	ListQuotaUsage(ctx context.Context, tenantId string) ([]*TenantQuotaUsage, error)
+
+	// CheckQuota returns an ErrQuotaExceeded if creating n of the resource would exceed the quota of the tenant. The
+	// quotas and usage are cached for a few seconds, so the quotas are enforced approximately.
+	CheckQuota(ctx context.Context, tenantId string, resource TenantQuotaResource, n int32) error
+}
@@ -51,6 +51,18 @@ type TickerEngineRepository interface {
 
 	PollUnresolvedFailedStepRuns(ctx context.Context) ([]*dbsqlc.PollUnresolvedFailedStepRunsRow, error)
 
+	// PollStepRunTimers returns the timers of sleeping step runs which are due and assigns them to the ticker
+	PollStepRunTimers(ctx context.Context, tickerId string) ([]*dbsqlc.PollStepRunTimersRow, error)
+
+	// DeleteStepRunTimers deletes the timers of step runs which have been woken up
+	DeleteStepRunTimers(ctx context.Context, stepRunIds []string) error
+
+	// PollDelayedEvents returns the delayed events which are due and assigns them to the ticker
+	PollDelayedEvents(ctx context.Context, tickerId string) ([]*dbsqlc.DelayedEvent, error)
+
+	// DeleteDelayedEvents deletes the delayed events which have been delivered
+	DeleteDelayedEvents(ctx context.Context, eventIds []string) error
+
 	// // AddJobRun assigns a job run to a ticker.
 	// AddJobRun(tickerId string, jobRun *db.JobRunModel) (*db.TickerModel, error)
 
@@ -11,6 +11,9 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// DefaultSlotType is the slot type of a worker's default slot pool, which is sized by the worker's max runs.
+const DefaultSlotType = "default"
+
 type RuntimeInfo struct {
 	SdkVersion      *string         `validate:"omitempty"`
 	Language        *contracts.SDKS `validate:"omitempty"`
@@ -40,6 +43,16 @@ type CreateWorkerOpts struct {
 
 	// (optional) Runtime info for the worker
 	RuntimeInfo *RuntimeInfo `validate:"omitempty"`
+
+	// (optional) The region the worker runs in
+	Region *string `validate:"omitempty,max=64"`
+
+	// (optional) The worker pool the worker joins, workflows which target the pool are only assigned to its workers
+	Pool *string `validate:"omitempty,max=64"`
+
+	// (optional) Additional slot pools on the worker, keyed by slot type. MaxRuns is the size of the
+	// default slot pool.
+	SlotPools map[string]int `validate:"omitempty,dive,keys,required,max=64,ne=default,endkeys,gte=1"`
 }
 
 type UpdateWorkerOpts struct {
@@ -52,10 +65,28 @@ type UpdateWorkerOpts struct {
 	// If the worker is active and accepting new runs
 	IsActive *bool
 
+	// If the worker is draining and should not be assigned new runs
+	IsDraining *bool
+
 	// A list of actions this worker can run
 	Actions []string `validate:"dive,actionId"`
 }
 
+// WorkerHealth is the health which a worker reports with its heartbeat
+type WorkerHealth struct {
+	// (optional) the cpu utilization of the worker process, between 0 and 1
+	CpuUtilization *float64 `validate:"omitempty,min=0,max=1"`
+
+	// (optional) the memory utilization of the worker process relative to its memory limit, between 0 and 1
+	MemoryUtilization *float64 `validate:"omitempty,min=0,max=1"`
+
+	// (optional) the number of slots which are running a step
+	ActiveSlots *int `validate:"omitempty,min=0"`
+
+	// (optional) custom gauges of the worker, keyed by name
+	Gauges map[string]float64 `validate:"omitempty,max=32,dive,keys,required,max=64,endkeys"`
+}
+
 type WorkerWithStepCount struct {
 	Worker       *db.WorkerModel
 	StepRunCount int
@@ -95,6 +126,9 @@ type WorkerAPIRepository interface {
 	// ListWorkerLabels returns a list of labels config for a worker
 	ListWorkerLabels(tenantId, workerId string) ([]*dbsqlc.ListWorkerLabelsRow, error)
 
+	// ListWorkerPools returns the number of active workers and the slot capacity of each worker pool of the tenant
+	ListWorkerPools(tenantId string) ([]*dbsqlc.ListWorkerPoolsRow, error)
+
 	// UpdateWorker updates a worker for a given tenant.
 	UpdateWorker(tenantId string, workerId string, opts ApiUpdateWorkerOpts) (*dbsqlc.Worker, error)
 }
@@ -108,7 +142,9 @@ type WorkerEngineRepository interface {
 
 	// UpdateWorker updates a worker in the repository.
 	// It will only update the worker if there is no lock on the worker, else it will skip.
-	UpdateWorkerHeartbeat(ctx context.Context, tenantId, workerId string, lastHeartbeatAt time.Time) error
+	// The health of the worker is only updated if it's not nil. The heartbeats are buffered, so they may be written
+	// after the call returns.
+	UpdateWorkerHeartbeat(ctx context.Context, tenantId, workerId string, lastHeartbeatAt time.Time, health *WorkerHealth) error
 
 	// DeleteWorker removes the worker from the database
 	DeleteWorker(ctx context.Context, tenantId, workerId string) error
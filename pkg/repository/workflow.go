@@ -34,6 +34,15 @@ type CreateWorkflowVersionOpts struct {
 	// (optional) the input bytes for the cron triggers
 	CronInput []byte
 
+	// (optional) the IANA timezone the cron triggers are evaluated in, defaults to UTC
+	CronTimezone *string `validate:"omitnil,timezone"`
+
+	// (optional) the maximum number of seconds each cron trigger is randomly delayed by
+	CronJitterSeconds *int32 `validate:"omitnil,min=0,max=3600"`
+
+	// (optional) the name of the exclusion calendar of the cron triggers, which skip the dates of the calendar
+	CronExclusionCalendar *string `validate:"omitnil,hatchetName"`
+
 	// (optional) scheduled triggers for the workflow
 	ScheduledTriggers []time.Time
 
@@ -56,6 +65,26 @@ type CreateWorkflowVersionOpts struct {
 
 	// (optional) the default priority for steps in the workflow (1-3)
 	DefaultPriority *int32 `validate:"omitempty,min=1,max=3"`
+
+	// (optional) the region to run the workflow's steps in
+	Region *string `validate:"omitempty,max=64"`
+
+	// (optional) whether workers outside of the region may be used when the region has no capacity,
+	// defaults to PREFER
+	RegionStrategy *string `validate:"omitempty,oneof=PIN PREFER"`
+
+	// (optional) the worker pool which the workflow's steps run in. Steps without a pool can run on any worker.
+	Pool *string `validate:"omitnil,max=64"`
+
+	// (optional) a CEL expression over the step outputs which is stored as the output of the workflow run
+	Output *string `validate:"omitnil,celworkflowrunoutputstr"`
+
+	// (optional) a JSON schema which the input of the workflow runs is validated against when they're triggered
+	InputSchema []byte `validate:"omitempty,jsonschema"`
+
+	// (optional) the number of slots which the workflow runs reserve up front, so that a DAG isn't starved halfway
+	// through when workers fill up
+	ReservedSlots *int32 `validate:"omitnil,min=1"`
 }
 
 type CreateCronWorkflowTriggerOpts struct {
@@ -67,6 +96,15 @@ type CreateCronWorkflowTriggerOpts struct {
 
 	Cron string `validate:"required,cron"`
 
+	// (optional) the IANA timezone the cron expression is evaluated in, defaults to UTC
+	Timezone *string `validate:"omitnil,timezone"`
+
+	// (optional) the maximum number of seconds each trigger is randomly delayed by
+	JitterSeconds *int32 `validate:"omitnil,min=0,max=3600"`
+
+	// (optional) the id of the exclusion calendar, whose dates the cron skips
+	ExclusionCalendarId *string `validate:"omitnil,uuid"`
+
 	Input              map[string]interface{}
 	AdditionalMetadata map[string]interface{}
 }
@@ -79,7 +117,7 @@ type CreateWorkflowConcurrencyOpts struct {
 	MaxRuns *int32
 
 	// (optional) the strategy to use when the concurrency limit is reached, default CANCEL_IN_PROGRESS
-	LimitStrategy *string `validate:"omitnil,oneof=CANCEL_IN_PROGRESS DROP_NEWEST QUEUE_NEWEST GROUP_ROUND_ROBIN"`
+	LimitStrategy *string `validate:"omitnil,oneof=CANCEL_IN_PROGRESS DROP_NEWEST QUEUE_NEWEST GROUP_ROUND_ROBIN QUEUE_NEWEST_ONLY"`
 
 	// (optional) a concurrency expression for evaluating the concurrency key
 	Expression *string `validate:"omitempty,celworkflowrunstr"`
@@ -146,9 +184,16 @@ type CreateWorkflowStepOpts struct {
 	// (required) the step action id
 	Action string `validate:"required,actionId"`
 
-	// (optional) the step timeout
+	// (optional) the step timeout, which is the maximum execution time of a step run once it has started
 	Timeout *string `validate:"omitnil,duration"`
 
+	// (optional) the maximum time a step run can wait in the queue before it is assigned. If not set, the
+	// workflow's schedule timeout is used.
+	ScheduleTimeout *string `validate:"omitnil,duration"`
+
+	// (optional) the maximum time between heartbeats from the worker running the step
+	HeartbeatTimeout *string `validate:"omitnil,duration"`
+
 	// (optional) the parents that this step depends on
 	Parents []string `validate:"dive,hatchetName"`
 
@@ -169,8 +214,65 @@ type CreateWorkflowStepOpts struct {
 
 	// (optional) the step retry backoff max seconds (can't be greater than 86400)
 	RetryBackoffMaxSeconds *int `validate:"omitnil,min=1,max=86400"`
+
+	// (optional) the delay in seconds before the first retry, which is multiplied by the backoff factor
+	// for each subsequent retry
+	RetryBackoffInitialSeconds *int `validate:"omitnil,min=1,max=86400"`
+
+	// (optional) the fraction of the retry delay which is randomized, between 0 and 1
+	RetryBackoffJitter *float64 `validate:"omitnil,min=0,max=1"`
+
+	// (optional) the percentile of recent step run durations after which a speculative attempt is launched
+	// on another worker. This should only be set for idempotent steps.
+	SpeculativePercentile *int32 `validate:"omitnil,min=1,max=99"`
+
+	// (optional) the worker slot pool which the step consumes a slot from. If not set, the step uses
+	// the worker's default slot pool.
+	SlotType *string `validate:"omitnil,max=64"`
+
+	// (optional) if set, the step is a sleep step which waits for this duration instead of running on a worker
+	SleepFor *string `validate:"omitnil,duration,excluded_with=SleepUntil"`
+
+	// (optional) if set, the step is a sleep step which waits until the timestamp returned by this CEL
+	// expression. The expression must return an RFC3339 timestamp.
+	SleepUntil *string `validate:"omitnil,celsteprunstr"`
+
+	// (optional) if set, the step waits until an event with this key is pushed instead of running on a worker.
+	// The payload of the event becomes the output of the step.
+	WaitForEvent *string `validate:"omitnil,min=1,excluded_with=SleepFor SleepUntil"`
+
+	// (optional) a CEL expression which is evaluated against both the run input and the payload of the event.
+	// The event only resumes the step if both evaluate to the same value.
+	WaitForEventCorrelation *string `validate:"omitnil,celworkflowrunstr,excluded_without=WaitForEvent"`
+
+	// (optional) a CEL expression which is evaluated against the input and the outputs of the parent steps
+	// before the step is queued. If it returns false, the step is skipped.
+	Condition *string `validate:"omitnil,celsteprunstr"`
+
+	// (optional) a CEL expression which returns a list. If set, the step runs once for each element of the list,
+	// and its output is the list of the outputs of the elements.
+	MapOver *string `validate:"omitnil,celsteprunstr,excluded_with=SleepFor SleepUntil WaitForEvent"`
+
+	// (optional) the maximum number of elements of a map step which run at the same time
+	MapConcurrency *int32 `validate:"omitnil,min=1,excluded_without=MapOver"`
+
+	// (optional) if set, the output of the step is cached for this duration, keyed by the action and the input of
+	// the step run. Step runs with the same action and input reuse the cached output instead of running.
+	CacheTTL *string `validate:"omitnil,duration"`
+
+	// (optional) the worker pool which the step runs in. If not set, the step runs in the pool of the workflow.
+	Pool *string `validate:"omitnil,max=64"`
 }
 
+// SleepStepAction is the action id of sleep steps, which are not run on a worker.
+const SleepStepAction = "hatchet:sleep"
+
+// WaitForEventStepAction is the action id of wait-for-event steps, which are not run on a worker.
+const WaitForEventStepAction = "hatchet:wait-for-event"
+
+// ApprovalStepAction is the action id of approval steps, which are not run on a worker.
+const ApprovalStepAction = "hatchet:approval"
+
 type DesiredWorkerLabelOpts struct {
 	// (required) the label key
 	Key string `validate:"required"`
@@ -209,6 +311,10 @@ type CreateWorkflowStepRateLimitOpts struct {
 
 	// (optional) the rate limit duration, defaults to MINUTE
 	Duration *string `validate:"omitnil,oneof=SECOND MINUTE HOUR DAY WEEK MONTH YEAR"`
+
+	// (optional) the scope of the rate limit key, either TENANT or GLOBAL, defaults to TENANT. Global rate
+	// limits are shared by all tenants, must already exist and don't support expressions.
+	Scope *string `validate:"omitnil,oneof=TENANT GLOBAL"`
 }
 
 type ListWorkflowsOpts struct {
@@ -267,6 +373,14 @@ type UpdateWorkflowOpts struct {
 	IsPaused *bool
 }
 
+type UpsertWorkflowRolloutOpts struct {
+	// (required) the version which new runs are triggered on, unless they're part of the canary
+	StableVersionId string `validate:"required,uuid"`
+
+	// (required) the percentage of new runs which are triggered on the latest version of the workflow
+	CanaryPercentage int `validate:"min=0,max=100"`
+}
+
 type WorkflowAPIRepository interface {
 	// ListWorkflows returns all workflows for a given tenant.
 	ListWorkflows(tenantId string, opts *ListWorkflowsOpts) (*ListWorkflowsResult, error)
@@ -288,9 +402,25 @@ type WorkflowAPIRepository interface {
 	// GetWorkflowVersionMetrics returns the metrics for a given workflow version.
 	GetWorkflowMetrics(tenantId, workflowId string, opts *GetWorkflowMetricsOpts) (*WorkflowMetrics, error)
 
+	// ListWorkflowConcurrencyGroups returns the concurrency groups of a workflow which have running or
+	// queued runs, along with the number of runs in each state.
+	ListWorkflowConcurrencyGroups(ctx context.Context, tenantId, workflowId string, limit *int) ([]*dbsqlc.ListWorkflowConcurrencyGroupsRow, error)
+
 	// UpdateWorkflow updates a workflow for a given tenant.
 	UpdateWorkflow(ctx context.Context, tenantId, workflowId string, opts *UpdateWorkflowOpts) (*dbsqlc.Workflow, error)
 
+	// GetWorkflowRollout returns the rollout of a workflow. It will return pgx.ErrNoRows if the workflow has
+	// no rollout.
+	GetWorkflowRollout(ctx context.Context, workflowId string) (*dbsqlc.WorkflowRollout, error)
+
+	// UpsertWorkflowRollout creates or updates the rollout of a workflow, which splits new runs of the workflow
+	// between a stable version and the latest version.
+	UpsertWorkflowRollout(ctx context.Context, workflowId string, opts *UpsertWorkflowRolloutOpts) (*dbsqlc.WorkflowRollout, error)
+
+	// DeleteWorkflowRollout deletes the rollout of a workflow, so that new runs are triggered on the latest
+	// version again. It will return pgx.ErrNoRows if the workflow has no rollout.
+	DeleteWorkflowRollout(ctx context.Context, workflowId string) error
+
 	// GetWorkflowWorkerCount returns the number of workers for a given workflow.
 	GetWorkflowWorkerCount(tenantId, workflowId string) (int, int, error)
 
@@ -335,8 +465,15 @@ type WorkflowEngineRepository interface {
 	// GetWorkflowsByName returns all workflows by their name. It will return db.ErrNotFound if the workflow does not exist.
 	GetWorkflowsByNames(ctx context.Context, tenantId string, workflowNames []string) ([]*dbsqlc.Workflow, error)
 
-	// ListWorkflowsForEvent returns the latest workflow versions for a given tenant that are triggered by the
-	// given event.
+	// GetWorkflowVersionsForTrigger returns the version which a new run of each of the workflows is triggered on.
+	// This is the latest version, unless the workflow has a rollout, in which case only the canary percentage
+	// of runs is triggered on the latest version and the rest on the stable version of the rollout. The result
+	// contains one version per workflow id in the same order, and repeated workflow ids are resolved independently.
+	GetWorkflowVersionsForTrigger(ctx context.Context, tenantId string, workflowIds []string) ([]*dbsqlc.GetWorkflowVersionForEngineRow, error)
+
+	// ListWorkflowsForEvent returns the workflow versions for a given tenant that are triggered by the
+	// given event. The event triggers of the latest versions are matched, and the versions are resolved like
+	// GetWorkflowVersionsForTrigger.
 	ListWorkflowsForEvent(ctx context.Context, tenantId, eventKey string) ([]*dbsqlc.GetWorkflowVersionForEngineRow, error)
 
 	// GetWorkflowVersionById returns a workflow version by its id. It will return db.ErrNotFound if the workflow
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/hatchet-dev/hatchet/internal/datautils"
+	"github.com/hatchet-dev/hatchet/internal/schema"
 	"github.com/hatchet-dev/hatchet/pkg/random"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/db"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
@@ -15,6 +16,10 @@ import (
 )
 
 type CreateWorkflowRunOpts struct {
+	// (optional) the id of the workflow run, generated if not set. This is set when the id needs to be known
+	// before the workflow run is created, for example to claim an idempotency key.
+	Id *string `validate:"omitnil,uuid"`
+
 	// (optional) the workflow run display name
 	DisplayName *string
 
@@ -66,6 +71,30 @@ type CreateWorkflowRunOpts struct {
 
 	// (optional) the priority of the workflow run
 	Priority *int32 `validate:"omitempty,min=1,max=3"`
+
+	// (optional) the number of slots to reserve up front for the workflow run. Reserved slots are held
+	// for the workflow run until it completes or the reservation times out. Defaults to the reserved slots
+	// of the workflow version.
+	ReservedSlots *int32 `validate:"omitempty,min=1"`
+
+	// (optional) the deadline for the step runs in the workflow run. Queues which use the earliest-deadline-first
+	// policy pull step runs with the earliest deadline first.
+	Deadline *time.Time
+
+	// (optional) the workflow run which this workflow run replays from a step
+	ReplayedFromId *string `validate:"omitnil,uuid"`
+
+	// (optional) the step which the workflow run is replayed from. The steps upstream of it reuse the outputs of
+	// the replayed workflow run instead of running again.
+	ReplayedFromStepId *string `validate:"omitnil,uuid,required_with=ReplayedFromId"`
+
+	// (optional) the input of the step which the workflow run is replayed from, which overrides the input built
+	// from the outputs of the upstream steps
+	ReplayedFromStepInput []byte
+
+	// (optional) the W3C trace context of the caller which triggered the workflow run. The step runs of the workflow
+	// run are sent to workers in this trace. Set from the context of the caller if it isn't set.
+	TraceContext map[string]string
 }
 
 type CreateGroupKeyRunOpts struct {
@@ -101,6 +130,58 @@ func WithParent(
 	}
 }
 
+func WithReservedSlots(slots int32) CreateWorkflowRunOpt {
+	return func(opts *CreateWorkflowRunOpts) {
+		opts.ReservedSlots = &slots
+	}
+}
+
+func WithDeadline(deadline time.Time) CreateWorkflowRunOpt {
+	return func(opts *CreateWorkflowRunOpts) {
+		opts.Deadline = &deadline
+	}
+}
+
+// WorkflowRunInputValidationError is returned when the input of a workflow run doesn't match the input schema of
+// the workflow version.
+type WorkflowRunInputValidationError struct {
+	WorkflowName string
+
+	Errors []schema.ValidationError
+}
+
+func (e *WorkflowRunInputValidationError) Error() string {
+	return fmt.Sprintf("input does not match the input schema of workflow %s: %s", e.WorkflowName, schema.FormatValidationErrors(e.Errors))
+}
+
+// ValidateWorkflowRunInput validates the input of a workflow run against the input schema of the workflow version,
+// and returns a *WorkflowRunInputValidationError if it doesn't match. Workflow versions without an input schema
+// accept any input.
+func ValidateWorkflowRunInput(workflowVersion *dbsqlc.GetWorkflowVersionForEngineRow, input []byte) error {
+	if len(workflowVersion.WorkflowVersion.InputSchema) == 0 {
+		return nil
+	}
+
+	if input == nil {
+		input = []byte("{}")
+	}
+
+	errs, err := schema.ValidateJSON(workflowVersion.WorkflowVersion.InputSchema, input)
+
+	if err != nil {
+		return fmt.Errorf("could not validate input of workflow %s: %w", workflowVersion.WorkflowName, err)
+	}
+
+	if len(errs) > 0 {
+		return &WorkflowRunInputValidationError{
+			WorkflowName: workflowVersion.WorkflowName,
+			Errors:       errs,
+		}
+	}
+
+	return nil
+}
+
 func GetCreateWorkflowRunOptsFromManual(
 	workflowVersion *dbsqlc.GetWorkflowVersionForEngineRow,
 	input []byte,
@@ -110,6 +191,10 @@ func GetCreateWorkflowRunOptsFromManual(
 		input = []byte("{}")
 	}
 
+	if err := ValidateWorkflowRunInput(workflowVersion, input); err != nil {
+		return nil, err
+	}
+
 	opts := &CreateWorkflowRunOpts{
 		DisplayName:        StringPtr(getWorkflowRunDisplayName(workflowVersion.WorkflowName)),
 		WorkflowVersionId:  sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.ID),
@@ -125,6 +210,10 @@ func GetCreateWorkflowRunOptsFromManual(
 		}
 	}
 
+	if workflowVersion.WorkflowVersion.ReservedSlots.Valid {
+		WithReservedSlots(workflowVersion.WorkflowVersion.ReservedSlots.Int32)(opts)
+	}
+
 	return opts, nil
 }
 
@@ -141,6 +230,10 @@ func GetCreateWorkflowRunOptsFromParent(
 		input = []byte("{}")
 	}
 
+	if err := ValidateWorkflowRunInput(workflowVersion, input); err != nil {
+		return nil, err
+	}
+
 	opts := &CreateWorkflowRunOpts{
 		DisplayName:        StringPtr(getWorkflowRunDisplayName(workflowVersion.WorkflowName)),
 		WorkflowVersionId:  sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.ID),
@@ -157,6 +250,10 @@ func GetCreateWorkflowRunOptsFromParent(
 		}
 	}
 
+	if workflowVersion.WorkflowVersion.ReservedSlots.Valid {
+		WithReservedSlots(workflowVersion.WorkflowVersion.ReservedSlots.Int32)(opts)
+	}
+
 	return opts, nil
 }
 
@@ -170,6 +267,10 @@ func GetCreateWorkflowRunOptsFromEvent(
 		input = []byte("{}")
 	}
 
+	if err := ValidateWorkflowRunInput(workflowVersion, input); err != nil {
+		return nil, err
+	}
+
 	opts := &CreateWorkflowRunOpts{
 		DisplayName:        StringPtr(getWorkflowRunDisplayName(workflowVersion.WorkflowName)),
 		WorkflowVersionId:  sqlchelpers.UUIDToStr(workflowVersion.WorkflowVersion.ID),
@@ -185,6 +286,10 @@ func GetCreateWorkflowRunOptsFromEvent(
 		}
 	}
 
+	if workflowVersion.WorkflowVersion.ReservedSlots.Valid {
+		WithReservedSlots(workflowVersion.WorkflowVersion.ReservedSlots.Int32)(opts)
+	}
+
 	return opts, nil
 }
 
@@ -217,6 +322,10 @@ func GetCreateWorkflowRunOptsFromCron(
 		}
 	}
 
+	if workflowVersion.WorkflowVersion.ReservedSlots.Valid {
+		WithReservedSlots(workflowVersion.WorkflowVersion.ReservedSlots.Int32)(opts)
+	}
+
 	return opts, nil
 }
 
@@ -246,6 +355,10 @@ func GetCreateWorkflowRunOptsFromSchedule(
 		}
 	}
 
+	if workflowVersion.WorkflowVersion.ReservedSlots.Valid {
+		WithReservedSlots(workflowVersion.WorkflowVersion.ReservedSlots.Int32)(opts)
+	}
+
 	for _, f := range fs {
 		f(opts)
 	}
@@ -263,6 +376,9 @@ type ListWorkflowRunsOpts struct {
 	// (optional) the workflow id
 	WorkflowId *string `validate:"omitempty,uuid"`
 
+	// (optional) a list of workflow ids, runs of any of the workflows are returned
+	WorkflowIds []string `validate:"omitempty,dive,uuid"`
+
 	// (optional) the workflow version id
 	WorkflowVersionId *string `validate:"omitempty,uuid"`
 
@@ -278,6 +394,9 @@ type ListWorkflowRunsOpts struct {
 	// (optional) the event id that triggered the workflow run
 	EventId *string `validate:"omitempty,uuid"`
 
+	// (optional) the key of the event that triggered the workflow run
+	EventKey *string
+
 	// (optional) the group key for the workflow run
 	GroupKey *string
 
@@ -290,6 +409,10 @@ type ListWorkflowRunsOpts struct {
 	// (optional) number of events to skip
 	Offset *int
 
+	// (optional) the cursor returned with the previous page. Only the runs after the cursor in the sort order are
+	// returned, in which case the offset is ignored and the runs aren't counted.
+	Cursor *string
+
 	// (optional) number of events to return
 	Limit *int
 
@@ -342,8 +465,14 @@ type WorkflowRunsMetricsOpts struct {
 }
 
 type ListWorkflowRunsResult struct {
-	Rows  []*dbsqlc.ListWorkflowRunsRow
-	Count int
+	Rows []*dbsqlc.ListWorkflowRunsRow
+
+	// Count is the number of runs which match the filters, which is nil if the page was requested with a cursor,
+	// since the runs aren't counted then
+	Count *int
+
+	// NextCursor is set if the page was full, and there may be more runs
+	NextCursor *string
 }
 
 type CreateWorkflowRunPullRequestOpts struct {
@@ -391,6 +520,13 @@ type StepRunForJobRun struct {
 	ChildWorkflowsCount int
 }
 
+type WorkflowRunForOutput struct {
+	*dbsqlc.ListWorkflowRunsForOutputRow
+
+	// StepOutputs are the outputs of the succeeded step runs of the workflow run, keyed by the readable id of the step
+	StepOutputs map[string][]byte
+}
+
 type ListScheduledWorkflowsOpts struct {
 	// (optional) number of events to skip
 	Offset *int
@@ -477,10 +613,32 @@ type WorkflowRunAPIRepository interface {
 	GetStepsForJobs(ctx context.Context, tenantId string, jobIds []string) ([]*dbsqlc.GetStepsForJobsRow, error)
 
 	GetStepRunsForJobRuns(ctx context.Context, tenantId string, jobRunIds []string) ([]*StepRunForJobRun, error)
+
+	// PauseWorkflowRun pauses a running workflow run. Step runs which were already assigned to a worker run to
+	// completion, but no new step runs of the workflow run are assigned until it is resumed.
+	PauseWorkflowRun(ctx context.Context, tenantId, workflowRunId string) error
+
+	// ResumeWorkflowRun resumes a paused workflow run. The step runs which were queued while the workflow run was
+	// paused are assigned from where the workflow run left off.
+	ResumeWorkflowRun(ctx context.Context, tenantId, workflowRunId string) error
+
+	// PreflightCheckReplayFromStep checks if a workflow run can be replayed from a step. If it can, it will return nil.
+	PreflightCheckReplayFromStep(ctx context.Context, tenantId, workflowRunId, stepId string) error
 }
 
 var (
 	ErrWorkflowRunNotFound = fmt.Errorf("workflow run not found")
+
+	ErrWorkflowRunNotRunning = fmt.Errorf("workflow run is not running")
+
+	ErrWorkflowRunNotPaused = fmt.Errorf("workflow run is not paused")
+
+	// ErrInvalidWorkflowRunCursor is returned when a cursor can't be decoded, or was returned for a different sort order
+	ErrInvalidWorkflowRunCursor = fmt.Errorf("invalid workflow run cursor")
+
+	ErrPreflightReplayStepNotInWorkflowRun = fmt.Errorf("step is not part of the workflow run")
+
+	ErrPreflightReplayUpstreamStepRunNotSucceeded = fmt.Errorf("upstream step run did not succeed")
 )
 
 type ErrDedupeValueExists struct {
@@ -526,6 +684,17 @@ type WorkflowRunEngineRepository interface {
 
 	CreateDeDupeKey(ctx context.Context, tenantId, workflowRunId, worrkflowVersionId, dedupeValue string) error
 
+	// ClaimIdempotencyKey claims an idempotency key for a workflow run of the workflow version which has not been created
+	// yet. If the key is held by a different workflow run within the retention window, the id of that workflow run is
+	// returned instead.
+	ClaimIdempotencyKey(ctx context.Context, tenantId, workflowVersionId, key, workflowRunId string) (*string, error)
+
+	// ReleaseIdempotencyKeys releases the idempotency keys claimed by workflow runs which could not be created.
+	ReleaseIdempotencyKeys(ctx context.Context, tenantId string, workflowRunIds []string) error
+
+	// DeleteExpiredIdempotencyKeys deletes the idempotency keys which are past their retention window.
+	DeleteExpiredIdempotencyKeys(ctx context.Context, tenantId string) error
+
 	GetWorkflowRunInputData(tenantId, workflowRunId string) (map[string]interface{}, error)
 
 	ProcessWorkflowRunUpdates(ctx context.Context, tenantId string) (bool, error)
@@ -544,6 +713,13 @@ type WorkflowRunEngineRepository interface {
 
 	GetWorkflowRunAdditionalMeta(ctx context.Context, tenantId, workflowRunId string) (*dbsqlc.GetWorkflowRunAdditionalMetaRow, error)
 
+	// ListWorkflowRunsForOutput returns the data used to evaluate the output expressions of the given workflow runs.
+	// Workflow runs whose workflow version has no output expression are skipped.
+	ListWorkflowRunsForOutput(ctx context.Context, tenantId string, workflowRunIds []string) ([]*WorkflowRunForOutput, error)
+
+	// UpdateWorkflowRunOutputs stores the outputs of workflow runs, keyed by workflow run id.
+	UpdateWorkflowRunOutputs(ctx context.Context, tenantId string, outputs map[string][]byte) error
+
 	ReplayWorkflowRun(ctx context.Context, tenantId, workflowRunId string) (*dbsqlc.GetWorkflowRunRow, error)
 
 	ListActiveQueuedWorkflowVersions(ctx context.Context, tenantId string) ([]*dbsqlc.ListActiveQueuedWorkflowVersionsRow, error)
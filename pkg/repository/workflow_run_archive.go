@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// WorkflowRunDetails are the details of a finished workflow run which are moved to the archive. The workflow run and
+// its trigger are kept in the database as a stub, so archived runs are still listed.
+type WorkflowRunDetails struct {
+	WorkflowRunId string
+	TenantId      string
+
+	Input  []byte
+	Output []byte
+
+	JobRuns       []*dbsqlc.ListJobRunsForWorkflowRunFullRow
+	StepRuns      []*StepRunForJobRun
+	StepRunEvents []*dbsqlc.StepRunEvent
+}
+
+type WorkflowRunArchiveRepository interface {
+	// ListWorkflowRunsToArchive returns the ids of up to limit workflow runs which finished before finishedBefore
+	// and weren't archived, oldest first.
+	ListWorkflowRunsToArchive(ctx context.Context, tenantId string, finishedBefore time.Time, limit int) ([]string, error)
+
+	// ArchiveWorkflowRun moves the details of a finished workflow run to the archive. The details are passed to write,
+	// which writes them to the archive and returns its key and size, and are deleted from the database once the
+	// archive was written. It returns false if the workflow run is being archived by another engine, isn't finished
+	// or was archived.
+	ArchiveWorkflowRun(ctx context.Context, tenantId, workflowRunId string, write func(details *WorkflowRunDetails) (key string, size int, err error)) (bool, error)
+
+	// GetWorkflowRunArchive returns the archive of a workflow run, it returns pgx.ErrNoRows if the run wasn't
+	// archived.
+	GetWorkflowRunArchive(ctx context.Context, tenantId, workflowRunId string) (*dbsqlc.WorkflowRunArchive, error)
+}
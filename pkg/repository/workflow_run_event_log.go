@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// WorkflowRunEventLogRetention is how long the events of workflow runs are kept in the event log, so clients which
+// reconnect to a run status subscription within this period receive the events which they missed.
+const WorkflowRunEventLogRetention = 15 * time.Minute
+
+type ListWorkflowRunEventLogsOpts struct {
+	// (required) only events with a higher sequence are listed
+	AfterId int64 `validate:"min=0"`
+
+	// (optional) the workflow runs whose events are listed, all events of the tenant are listed if unset
+	WorkflowRunIds []string `validate:"omitempty,dive,uuid"`
+
+	// (optional) the maximum number of events, defaults to 1000
+	Limit *int `validate:"omitnil,min=1,max=10000"`
+}
+
+// WorkflowRunEventLogRepository stores the lifecycle events of workflow runs for a short time, so subscriptions to the
+// status of runs can be resumed without losing events.
+type WorkflowRunEventLogRepository interface {
+	// AppendWorkflowRunEvent stores an event of a workflow run, and returns its sequence. Events are identified by
+	// their key, so appending an event which is already stored returns the sequence of the stored event.
+	AppendWorkflowRunEvent(ctx context.Context, tenantId, workflowRunId, key string, message []byte) (int64, error)
+
+	// ListWorkflowRunEvents lists the events of a tenant in the order of their sequence.
+	ListWorkflowRunEvents(ctx context.Context, tenantId string, opts *ListWorkflowRunEventLogsOpts) ([]*dbsqlc.WorkflowRunEventLog, error)
+
+	// DeleteExpiredWorkflowRunEvents deletes the events which are older than the retention period of the event log.
+	DeleteExpiredWorkflowRunEvents(ctx context.Context, tenantId string) error
+}
@@ -0,0 +1,88 @@
+package v2
+
+import (
+	"sync"
+)
+
+// WithAssignmentLimits caps how many step runs a single assignment round (one tick of a queuer) can push to
+// each worker, and how many it can assign in total. Lower limits spread bursts of queue items over several
+// ticks, trading latency for fairness across workers. A limit of 0 is not enforced. Queue items which hit a
+// limit stay in the queue and are retried on the next tick.
+func WithAssignmentLimits(maxPerWorker, maxPerTick int) SchedulingPoolOpt {
+	return func(cf *sharedConfig) {
+		cf.maxAssignedPerWorker = maxPerWorker
+		cf.maxAssignedPerTick = maxPerTick
+	}
+}
+
+// assignmentLimits tracks the assignments made in a single assignment round. A nil *assignmentLimits
+// is valid and does not enforce any limits.
+type assignmentLimits struct {
+	mu sync.Mutex
+
+	maxPerWorker int
+	maxTotal     int
+
+	workerCounts map[string]int
+	total        int
+}
+
+func newAssignmentLimits(maxPerWorker, maxTotal int) *assignmentLimits {
+	if maxPerWorker <= 0 && maxTotal <= 0 {
+		return nil
+	}
+
+	return &assignmentLimits{
+		maxPerWorker: maxPerWorker,
+		maxTotal:     maxTotal,
+		workerCounts: make(map[string]int),
+	}
+}
+
+// reserve reserves an assignment to the worker, returning false if either limit has been reached. Callers
+// must release the reservation if the assignment does not go through.
+func (a *assignmentLimits) reserve(workerId string) bool {
+	if a == nil {
+		return true
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.maxTotal > 0 && a.total >= a.maxTotal {
+		return false
+	}
+
+	if a.maxPerWorker > 0 && a.workerCounts[workerId] >= a.maxPerWorker {
+		return false
+	}
+
+	a.total++
+	a.workerCounts[workerId]++
+
+	return true
+}
+
+func (a *assignmentLimits) release(workerId string) {
+	if a == nil {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.total--
+	a.workerCounts[workerId]--
+}
+
+// exhausted returns true if no more assignments can be made in this round.
+func (a *assignmentLimits) exhausted() bool {
+	if a == nil {
+		return false
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.maxTotal > 0 && a.total >= a.maxTotal
+}
@@ -0,0 +1,37 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssignmentLimits_PerWorker(t *testing.T) {
+	a := newAssignmentLimits(2, 0)
+
+	assert.True(t, a.reserve("worker-1"))
+	assert.True(t, a.reserve("worker-1"))
+	assert.False(t, a.reserve("worker-1"))
+	assert.True(t, a.reserve("worker-2"))
+
+	a.release("worker-1")
+	assert.True(t, a.reserve("worker-1"))
+	assert.False(t, a.exhausted())
+}
+
+func TestAssignmentLimits_PerTick(t *testing.T) {
+	a := newAssignmentLimits(0, 2)
+
+	assert.True(t, a.reserve("worker-1"))
+	assert.True(t, a.reserve("worker-2"))
+	assert.False(t, a.reserve("worker-3"))
+	assert.True(t, a.exhausted())
+}
+
+func TestAssignmentLimits_Unlimited(t *testing.T) {
+	a := newAssignmentLimits(0, 0)
+
+	assert.Nil(t, a)
+	assert.True(t, a.reserve("worker-1"))
+	assert.False(t, a.exhausted())
+}
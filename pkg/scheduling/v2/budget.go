@@ -0,0 +1,150 @@
+package v2
+
+import (
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// WithTenantBudget limits how much of the scheduling loop a single tenant can use, so that a tenant with
+// pathological queue churn can't starve the other tenants in the same process. In each window, a tenant's
+// queuers may spend at most maxTime processing queue items and run at most maxIterations times. A limit
+// of 0 is not enforced. Throttled tenants resume when the next window starts.
+func WithTenantBudget(window, maxTime time.Duration, maxIterations int) SchedulingPoolOpt {
+	return func(cf *sharedConfig) {
+		cf.budgetWindow = window
+		cf.budgetMaxTime = maxTime
+		cf.budgetMaxIterations = maxIterations
+	}
+}
+
+// TenantBudgetUsage is a snapshot of a tenant's scheduling budget.
+type TenantBudgetUsage struct {
+	// SchedulingTime is the time spent processing queue items in the current window
+	SchedulingTime time.Duration
+
+	// Iterations is the number of queue iterations in the current window
+	Iterations int
+
+	// Throttled is the total number of queue iterations which were skipped because the tenant exceeded
+	// its budget
+	Throttled int64
+}
+
+// tenantBudget tracks the scheduling time and queue iterations used by a tenant in the current window.
+// It is shared by all queuers for the tenant.
+type tenantBudget struct {
+	tenantId pgtype.UUID
+	l        *zerolog.Logger
+
+	window        time.Duration
+	maxTime       time.Duration
+	maxIterations int
+
+	mu              sync.Mutex
+	windowStart     time.Time
+	spent           time.Duration
+	iterations      int
+	throttled       int64
+	windowThrottled int64
+}
+
+func newTenantBudget(cf *sharedConfig, tenantId pgtype.UUID) *tenantBudget {
+	if cf.budgetMaxTime <= 0 && cf.budgetMaxIterations <= 0 {
+		return nil
+	}
+
+	window := cf.budgetWindow
+
+	if window <= 0 {
+		window = time.Second
+	}
+
+	return &tenantBudget{
+		tenantId:      tenantId,
+		l:             cf.l,
+		window:        window,
+		maxTime:       cf.budgetMaxTime,
+		maxIterations: cf.budgetMaxIterations,
+		windowStart:   time.Now(),
+	}
+}
+
+// allow returns true if the tenant may run a queue iteration, and counts the iteration against the budget.
+// If the tenant is throttled, it returns the time until the next window starts.
+func (b *tenantBudget) allow() (bool, time.Duration) {
+	if b == nil {
+		return true, 0
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.rotate(now)
+
+	if (b.maxTime > 0 && b.spent >= b.maxTime) || (b.maxIterations > 0 && b.iterations >= b.maxIterations) {
+		b.throttled++
+		b.windowThrottled++
+
+		return false, b.windowStart.Add(b.window).Sub(now)
+	}
+
+	b.iterations++
+
+	return true, 0
+}
+
+// spend counts scheduling time against the budget.
+func (b *tenantBudget) spend(d time.Duration) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.rotate(time.Now())
+	b.spent += d
+}
+
+func (b *tenantBudget) usage() TenantBudgetUsage {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.rotate(time.Now())
+
+	return TenantBudgetUsage{
+		SchedulingTime: b.spent,
+		Iterations:     b.iterations,
+		Throttled:      b.throttled,
+	}
+}
+
+// rotate starts a new window if the current window has ended. Caller must hold b.mu.
+func (b *tenantBudget) rotate(now time.Time) {
+	if now.Sub(b.windowStart) < b.window {
+		return
+	}
+
+	if b.windowThrottled > 0 {
+		b.l.Warn().Str(
+			"tenant_id", sqlchelpers.UUIDToStr(b.tenantId),
+		).Dur(
+			"scheduling_time", b.spent,
+		).Int(
+			"iterations", b.iterations,
+		).Int64(
+			"throttled", b.windowThrottled,
+		).Msg("tenant exceeded its scheduling budget")
+	}
+
+	b.windowStart = now
+	b.spent = 0
+	b.iterations = 0
+	b.windowThrottled = 0
+}
@@ -0,0 +1,57 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTenantBudget_Iterations(t *testing.T) {
+	l := zerolog.Nop()
+
+	b := newTenantBudget(&sharedConfig{l: &l, budgetWindow: time.Hour, budgetMaxIterations: 2}, pgtype.UUID{})
+
+	ok, _ := b.allow()
+	assert.True(t, ok)
+	ok, _ = b.allow()
+	assert.True(t, ok)
+
+	ok, wait := b.allow()
+	assert.False(t, ok)
+	assert.Greater(t, wait, time.Duration(0))
+
+	usage := b.usage()
+	assert.Equal(t, 2, usage.Iterations)
+	assert.Equal(t, int64(1), usage.Throttled)
+}
+
+func TestTenantBudget_SchedulingTime(t *testing.T) {
+	l := zerolog.Nop()
+
+	b := newTenantBudget(&sharedConfig{l: &l, budgetWindow: 50 * time.Millisecond, budgetMaxTime: 10 * time.Millisecond}, pgtype.UUID{})
+
+	ok, _ := b.allow()
+	assert.True(t, ok)
+
+	b.spend(20 * time.Millisecond)
+
+	ok, _ = b.allow()
+	assert.False(t, ok)
+
+	// the budget resets in the next window
+	time.Sleep(60 * time.Millisecond)
+
+	ok, _ = b.allow()
+	assert.True(t, ok)
+}
+
+func TestTenantBudget_Disabled(t *testing.T) {
+	b := newTenantBudget(&sharedConfig{}, pgtype.UUID{})
+	assert.Nil(t, b)
+
+	ok, _ := b.allow()
+	assert.True(t, ok)
+}
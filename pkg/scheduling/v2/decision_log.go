@@ -0,0 +1,230 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// WithSchedulingDecisionLog records why each queue item was or was not assigned, and keeps the most recent
+// decisions for each tenant. If size is 0, scheduling decisions are not recorded.
+func WithSchedulingDecisionLog(size int) SchedulingPoolOpt {
+	return func(cf *sharedConfig) {
+		cf.decisionLogSize = size
+	}
+}
+
+type schedulingDecision struct {
+	stepRunId pgtype.UUID
+	queue     string
+	outcome   dbsqlc.SchedulingDecisionOutcome
+	workerId  pgtype.UUID
+	message   string
+	data      map[string]interface{}
+	createdAt time.Time
+}
+
+// decisionLog is a ring buffer of scheduling decisions for a tenant. Decisions are flushed to the database
+// periodically, and the database keeps at most size decisions per tenant. If decisions are recorded faster
+// than they can be flushed, the oldest unflushed decisions are overwritten.
+type decisionLog struct {
+	tenantId pgtype.UUID
+
+	repo repository.SchedulerRepository
+	l    *zerolog.Logger
+
+	mu    sync.Mutex
+	ring  []*schedulingDecision
+	next  int
+	count int
+}
+
+func newDecisionLog(cf *sharedConfig, tenantId pgtype.UUID) *decisionLog {
+	// dry-run pools do not write decisions to the database
+	if cf.decisionLogSize <= 0 || cf.dryRunSink != nil {
+		return nil
+	}
+
+	return &decisionLog{
+		tenantId: tenantId,
+		repo:     cf.repo,
+		l:        cf.l,
+		ring:     make([]*schedulingDecision, cf.decisionLogSize),
+	}
+}
+
+func (d *decisionLog) record(decisions ...*schedulingDecision) {
+	if d == nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, decision := range decisions {
+		d.ring[d.next] = decision
+		d.next = (d.next + 1) % len(d.ring)
+
+		if d.count < len(d.ring) {
+			d.count++
+		}
+	}
+}
+
+// drain returns the unflushed decisions from oldest to newest and empties the buffer.
+func (d *decisionLog) drain() []*schedulingDecision {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	res := make([]*schedulingDecision, 0, d.count)
+	start := (d.next - d.count + len(d.ring)) % len(d.ring)
+
+	for i := 0; i < d.count; i++ {
+		index := (start + i) % len(d.ring)
+		res = append(res, d.ring[index])
+		d.ring[index] = nil
+	}
+
+	d.count = 0
+
+	return res
+}
+
+func (d *decisionLog) loopFlush(ctx context.Context) {
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := d.flushToDatabase(ctx)
+
+			if err != nil {
+				d.l.Error().Err(err).Msg("error flushing scheduling decisions to database")
+			}
+		}
+	}
+}
+
+func (d *decisionLog) flushToDatabase(ctx context.Context) error {
+	decisions := d.drain()
+
+	if len(decisions) == 0 {
+		return nil
+	}
+
+	opts := make([]*repository.CreateSchedulingDecisionOpts, 0, len(decisions))
+
+	for _, decision := range decisions {
+		opts = append(opts, &repository.CreateSchedulingDecisionOpts{
+			StepRunId: decision.stepRunId,
+			Queue:     decision.queue,
+			Outcome:   decision.outcome,
+			WorkerId:  decision.workerId,
+			Message:   decision.message,
+			Data:      decision.data,
+			CreatedAt: decision.createdAt,
+		})
+	}
+
+	return d.repo.Assignment().CreateSchedulingDecisions(ctx, sqlchelpers.UUIDToStr(d.tenantId), opts, len(d.ring))
+}
+
+// countActiveWorkers returns the number of distinct workers with an active slot.
+func countActiveWorkers(slots []*slot) int {
+	workerIds := make(map[string]struct{})
+
+	for _, slot := range slots {
+		if slot.active() {
+			workerIds[slot.getWorkerId()] = struct{}{}
+		}
+	}
+
+	return len(workerIds)
+}
+
+// recordDecisions records the outcome of assigning a batch of queue items for an action.
+func (s *Scheduler) recordDecisions(actionId string, results []*assignSingleResult, stepIdsToLabels map[string][]*dbsqlc.GetDesiredLabelsRow) {
+	if s.decisions == nil {
+		return
+	}
+
+	now := time.Now().UTC()
+	decisions := make([]*schedulingDecision, 0, len(results))
+
+	for _, res := range results {
+		data := map[string]interface{}{
+			"action_id":         actionId,
+			"candidate_workers": res.candidateWorkers,
+		}
+
+		labels := stepIdsToLabels[sqlchelpers.UUIDToStr(res.qi.StepId)]
+
+		if len(labels) > 0 {
+			desiredLabels := make([]map[string]interface{}, 0, len(labels))
+
+			for _, label := range labels {
+				desiredLabels = append(desiredLabels, map[string]interface{}{
+					"key":        label.Key,
+					"comparator": string(label.Comparator),
+					"required":   label.Required,
+				})
+			}
+
+			data["desired_labels"] = desiredLabels
+		}
+
+		if res.qi.Sticky.Valid {
+			data["sticky"] = string(res.qi.Sticky.StickyStrategy)
+		}
+
+		decision := &schedulingDecision{
+			stepRunId: res.qi.StepRunId,
+			queue:     res.qi.Queue,
+			data:      data,
+			createdAt: now,
+		}
+
+		switch {
+		case res.succeeded:
+			decision.outcome = dbsqlc.SchedulingDecisionOutcomeASSIGNED
+			decision.workerId = res.workerId
+			decision.message = fmt.Sprintf("Assigned to worker %s", sqlchelpers.UUIDToStr(res.workerId))
+		case res.rateLimitResult != nil:
+			decision.outcome = dbsqlc.SchedulingDecisionOutcomeRATELIMITED
+			decision.message = fmt.Sprintf(
+				"Rate limit exceeded for key %s, attempting to consume %d units, but only had %d remaining",
+				res.rateLimitResult.exceededKey,
+				res.rateLimitResult.exceededUnits,
+				res.rateLimitResult.exceededVal,
+			)
+
+			data["rate_limit_key"] = res.rateLimitResult.exceededKey
+		default:
+			decision.outcome = dbsqlc.SchedulingDecisionOutcomeNOSLOTS
+
+			switch {
+			case res.candidateWorkers == 0:
+				decision.message = fmt.Sprintf("No workers with available slots for action %s", actionId)
+			case len(labels) > 0 || res.qi.Sticky.Valid:
+				decision.message = fmt.Sprintf("None of the %d candidate workers had an available slot matching the desired labels or sticky strategy", res.candidateWorkers)
+			default:
+				decision.message = fmt.Sprintf("All slots on the %d candidate workers are in use", res.candidateWorkers)
+			}
+		}
+
+		decisions = append(decisions, decision)
+	}
+
+	s.decisions.record(decisions...)
+}
@@ -0,0 +1,44 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecisionLog_DrainOrder(t *testing.T) {
+	d := newDecisionLog(&sharedConfig{decisionLogSize: 3}, pgtype.UUID{})
+
+	d.record(&schedulingDecision{queue: "q1"}, &schedulingDecision{queue: "q2"})
+
+	drained := d.drain()
+	assert.Len(t, drained, 2)
+	assert.Equal(t, "q1", drained[0].queue)
+	assert.Equal(t, "q2", drained[1].queue)
+
+	assert.Empty(t, d.drain())
+}
+
+func TestDecisionLog_OverwritesOldest(t *testing.T) {
+	d := newDecisionLog(&sharedConfig{decisionLogSize: 3}, pgtype.UUID{})
+
+	for _, queue := range []string{"q1", "q2", "q3", "q4", "q5"} {
+		d.record(&schedulingDecision{queue: queue})
+	}
+
+	drained := d.drain()
+	assert.Len(t, drained, 3)
+	assert.Equal(t, "q3", drained[0].queue)
+	assert.Equal(t, "q4", drained[1].queue)
+	assert.Equal(t, "q5", drained[2].queue)
+}
+
+func TestDecisionLog_Disabled(t *testing.T) {
+	d := newDecisionLog(&sharedConfig{}, pgtype.UUID{})
+	assert.Nil(t, d)
+
+	// recording on a disabled log is a no-op
+	d.record(&schedulingDecision{queue: "q1"})
+}
@@ -0,0 +1,207 @@
+package v2
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// WithDryRun runs the scheduling pool in dry-run mode. A dry-run pool reads the same workers, slots and queue
+// items as the schedulers which hold the leases, and computes assignments as usual, but it never acquires
+// leases, writes assignments or dispatches step runs. Instead, each decision is emitted to the sink. This
+// makes it possible to validate new affinity rules or assignment strategies against production load before
+// enabling them. If sink is nil, decisions are logged.
+func WithDryRun(sink DryRunSink) SchedulingPoolOpt {
+	return func(cf *sharedConfig) {
+		if sink == nil {
+			sink = &logDryRunSink{l: cf.l}
+		}
+
+		cf.dryRunSink = sink
+	}
+}
+
+// IsDryRun returns true if the scheduling pool is running in dry-run mode.
+func (p *SchedulingPool) IsDryRun() bool {
+	return p.cf.dryRunSink != nil
+}
+
+// DryRunDecision is an assignment decision made by a scheduling pool in dry-run mode.
+type DryRunDecision struct {
+	TenantId  string
+	Queue     string
+	StepRunId string
+	ActionId  string
+	Outcome   dbsqlc.SchedulingDecisionOutcome
+
+	// WorkerId is the worker the step run would have been assigned to. It is only set when the outcome
+	// is ASSIGNED.
+	WorkerId string
+
+	CreatedAt time.Time
+}
+
+// DryRunSink receives the decisions of a scheduling pool in dry-run mode. Emit is called from the scheduling
+// loop, so implementations should not block.
+type DryRunSink interface {
+	Emit(ctx context.Context, decisions []*DryRunDecision)
+}
+
+type logDryRunSink struct {
+	l *zerolog.Logger
+}
+
+func (s *logDryRunSink) Emit(ctx context.Context, decisions []*DryRunDecision) {
+	for _, d := range decisions {
+		s.l.Info().
+			Str("tenant_id", d.TenantId).
+			Str("queue", d.Queue).
+			Str("step_run_id", d.StepRunId).
+			Str("action_id", d.ActionId).
+			Str("outcome", string(d.Outcome)).
+			Str("worker_id", d.WorkerId).
+			Msg("dry-run scheduling decision")
+	}
+}
+
+// dryRunEmitted tracks the last outcome emitted for each queue item. Queue items stay in the queue in
+// dry-run mode, so they are reassigned on every tick, and we only emit a decision when the outcome changes.
+type dryRunEmitted struct {
+	mu       sync.Mutex
+	outcomes map[int64]dbsqlc.SchedulingDecisionOutcome
+}
+
+func newDryRunEmitted() *dryRunEmitted {
+	return &dryRunEmitted{
+		outcomes: make(map[int64]dbsqlc.SchedulingDecisionOutcome),
+	}
+}
+
+// changed records the outcome for the queue item and returns true if it differs from the last outcome.
+func (e *dryRunEmitted) changed(qiId int64, outcome dbsqlc.SchedulingDecisionOutcome) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if prev, ok := e.outcomes[qiId]; ok && prev == outcome {
+		return false
+	}
+
+	e.outcomes[qiId] = outcome
+
+	return true
+}
+
+// prune forgets the queue items which are no longer in the queue.
+func (e *dryRunEmitted) prune(qis []*dbsqlc.QueueItem) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	current := make(map[int64]struct{}, len(qis))
+
+	for _, qi := range qis {
+		current[qi.ID] = struct{}{}
+	}
+
+	for id := range e.outcomes {
+		if _, ok := current[id]; !ok {
+			delete(e.outcomes, id)
+		}
+	}
+}
+
+// flushDryRun emits the assignment results to the dry-run sink instead of writing them to the database.
+// Assigned slots and rate limit units are released, and all queue items are retried on the next tick.
+func (q *Queuer) flushDryRun(ctx context.Context, r *assignResults) int {
+	nackIds := make([]int, 0, len(r.assigned))
+
+	for _, assignedItem := range r.assigned {
+		nackIds = append(nackIds, assignedItem.AckId)
+	}
+
+	q.s.nack(nackIds)
+
+	now := time.Now().UTC()
+	tenantId := sqlchelpers.UUIDToStr(q.tenantId)
+	decisions := make([]*DryRunDecision, 0, len(r.assigned)+len(r.unassigned)+len(r.rateLimited))
+	items := make([]*dbsqlc.QueueItem, 0, len(r.assigned)+len(r.unassigned)+len(r.rateLimited)+len(r.schedulingTimedOut))
+
+	newDecision := func(qi *dbsqlc.QueueItem, outcome dbsqlc.SchedulingDecisionOutcome) *DryRunDecision {
+		return &DryRunDecision{
+			TenantId:  tenantId,
+			Queue:     qi.Queue,
+			StepRunId: sqlchelpers.UUIDToStr(qi.StepRunId),
+			ActionId:  qi.ActionId.String,
+			Outcome:   outcome,
+			CreatedAt: now,
+		}
+	}
+
+	for _, assignedItem := range r.assigned {
+		items = append(items, assignedItem.QueueItem)
+
+		if q.dryRunEmitted.changed(assignedItem.QueueItem.ID, dbsqlc.SchedulingDecisionOutcomeASSIGNED) {
+			d := newDecision(assignedItem.QueueItem, dbsqlc.SchedulingDecisionOutcomeASSIGNED)
+			d.WorkerId = sqlchelpers.UUIDToStr(assignedItem.WorkerId)
+			decisions = append(decisions, d)
+		}
+	}
+
+	for _, qi := range r.unassigned {
+		items = append(items, qi)
+
+		if q.dryRunEmitted.changed(qi.ID, dbsqlc.SchedulingDecisionOutcomeNOSLOTS) {
+			decisions = append(decisions, newDecision(qi, dbsqlc.SchedulingDecisionOutcomeNOSLOTS))
+		}
+	}
+
+	for _, rateLimitedItem := range r.rateLimited {
+		items = append(items, rateLimitedItem.qi)
+
+		if q.dryRunEmitted.changed(rateLimitedItem.qi.ID, dbsqlc.SchedulingDecisionOutcomeRATELIMITED) {
+			decisions = append(decisions, newDecision(rateLimitedItem.qi, dbsqlc.SchedulingDecisionOutcomeRATELIMITED))
+		}
+	}
+
+	// scheduling timeouts are left to the schedulers which hold the leases
+	items = append(items, r.schedulingTimedOut...)
+
+	if len(decisions) > 0 {
+		q.dryRunSink.Emit(ctx, decisions)
+	}
+
+	q.unackedToUnassigned(items)
+
+	// nothing was flushed, so the queue is not immediately re-queued
+	return 0
+}
+
+// observeWorkers sends all active workers to the scheduler without leasing them. The caller must hold the
+// worker leases mutex.
+func (l *LeaseManager) observeWorkers(activeWorkers []*ListActiveWorkersResult) {
+	l.sendWorkerIds(activeWorkers)
+}
+
+// observeQueues sends all queue shards to the tenant manager without leasing them. The caller must hold the
+// queue leases mutex.
+func (l *LeaseManager) observeQueues(queues []*dbsqlc.Queue) {
+	resourceIds := make([]string, 0, len(queues))
+	pausedQueues := make(map[string]struct{})
+
+	for _, q := range queues {
+		if q.IsPaused {
+			pausedQueues[q.Name] = struct{}{}
+		}
+
+		for _, shard := range l.conf.getQueueShards(q.Name) {
+			resourceIds = append(resourceIds, shard.resourceId())
+		}
+	}
+
+	l.setPausedQueues(pausedQueues)
+	l.sendQueues(resourceIds)
+}
@@ -0,0 +1,30 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+func TestDryRunEmitted_OnlyEmitsChanges(t *testing.T) {
+	e := newDryRunEmitted()
+
+	assert.True(t, e.changed(1, dbsqlc.SchedulingDecisionOutcomeNOSLOTS))
+	assert.False(t, e.changed(1, dbsqlc.SchedulingDecisionOutcomeNOSLOTS))
+	assert.True(t, e.changed(1, dbsqlc.SchedulingDecisionOutcomeASSIGNED))
+	assert.True(t, e.changed(2, dbsqlc.SchedulingDecisionOutcomeASSIGNED))
+}
+
+func TestDryRunEmitted_Prune(t *testing.T) {
+	e := newDryRunEmitted()
+
+	e.changed(1, dbsqlc.SchedulingDecisionOutcomeASSIGNED)
+	e.changed(2, dbsqlc.SchedulingDecisionOutcomeASSIGNED)
+
+	e.prune([]*dbsqlc.QueueItem{{ID: 2}})
+
+	assert.True(t, e.changed(1, dbsqlc.SchedulingDecisionOutcomeASSIGNED))
+	assert.False(t, e.changed(2, dbsqlc.SchedulingDecisionOutcomeASSIGNED))
+}
@@ -0,0 +1,53 @@
+package v2
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// setQueueFencingTokens stores the fencing tokens of the queue leases, keyed by resource id.
+func (l *LeaseManager) setQueueFencingTokens(leases []*dbsqlc.Lease) {
+	tokens := make(map[string]int64, len(leases))
+
+	for _, lease := range leases {
+		tokens[lease.ResourceId] = lease.FencingToken
+	}
+
+	l.fencingTokensMu.Lock()
+	defer l.fencingTokensMu.Unlock()
+
+	l.queueFencingTokens = tokens
+}
+
+// queueFencingToken returns the fencing token of the lease for a queue shard, as of the last time queue
+// leases were acquired. It returns false if the lease is not held.
+func (l *LeaseManager) queueFencingToken(resourceId string) (int64, bool) {
+	l.fencingTokensMu.RLock()
+	defer l.fencingTokensMu.RUnlock()
+
+	token, ok := l.queueFencingTokens[resourceId]
+
+	return token, ok
+}
+
+// fence returns the fence of the queuer's writes, with the fencing token of the queue lease the queuer was
+// given. The repository rejects the writes if the lease changed hands since. It returns repository.ErrLeaseLost
+// if the lease is not held anymore, and nil if the queuer's writes are not fenced.
+func (d *queuerDbQueries) fence() (*repository.QueueFence, error) {
+	if d.fencingToken == nil {
+		return nil, nil
+	}
+
+	resourceId := d.shard.resourceId()
+
+	token, ok := d.fencingToken(resourceId)
+
+	if !ok {
+		return nil, repository.ErrLeaseLost
+	}
+
+	return &repository.QueueFence{
+		ResourceId:   resourceId,
+		FencingToken: token,
+	}, nil
+}
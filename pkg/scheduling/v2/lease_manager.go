@@ -2,25 +2,42 @@ package v2
 
 import (
 	"context"
-	"errors"
 	"sync"
 	"time"
 
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/rs/zerolog"
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/hatchet-dev/hatchet/internal/metrics"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 )
 
+// WorkerStatus is the scheduling status of an active worker.
+type WorkerStatus string
+
+const (
+	// WorkerStatusActive means the worker can be assigned new runs.
+	WorkerStatusActive WorkerStatus = "ACTIVE"
+
+	// WorkerStatusDraining means the worker is shutting down and should not be assigned new runs,
+	// though runs which are already assigned to it will continue.
+	WorkerStatusDraining WorkerStatus = "DRAINING"
+)
+
 type ListActiveWorkersResult struct {
 	ID     pgtype.UUID
 	Labels []*dbsqlc.ListManyWorkerLabelsRow
+	Status WorkerStatus
+
+	// Region is the region the worker registered with, or empty if the worker has no region.
+	Region string
+
+	// Pool is the worker pool the worker registered with, or empty if the worker is not in a pool.
+	Pool string
 }
 
 type leaseRepo interface {
@@ -29,25 +46,20 @@ type leaseRepo interface {
 
 	AcquireOrExtendLeases(ctx context.Context, kind dbsqlc.LeaseKind, resourceIds []string, existingLeases []*dbsqlc.Lease) ([]*dbsqlc.Lease, error)
 	ReleaseLeases(ctx context.Context, leases []*dbsqlc.Lease) error
+
+	ListAvailableLeaseResources(ctx context.Context, kind dbsqlc.LeaseKind, resourceIds []string) ([]string, error)
 }
 
 type leaseDbQueries struct {
 	tenantId pgtype.UUID
 
-	queries *dbsqlc.Queries
-	pool    *pgxpool.Pool
-
-	leaseDuration pgtype.Interval
-
-	l *zerolog.Logger
+	repo repository.SchedulerLeaseRepository
 }
 
-func newLeaseDbQueries(tenantId pgtype.UUID, queries *dbsqlc.Queries, pool *pgxpool.Pool, l *zerolog.Logger) *leaseDbQueries {
+func newLeaseDbQueries(tenantId pgtype.UUID, repo repository.SchedulerLeaseRepository) *leaseDbQueries {
 	return &leaseDbQueries{
 		tenantId: tenantId,
-		queries:  queries,
-		pool:     pool,
-		l:        l,
+		repo:     repo,
 	}
 }
 
@@ -61,41 +73,7 @@ func (d *leaseDbQueries) AcquireOrExtendLeases(ctx context.Context, kind dbsqlc.
 		leaseIds[i] = lease.ID
 	}
 
-	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, d.pool, d.l, 5000)
-
-	if err != nil {
-		return nil, err
-	}
-
-	defer rollback()
-
-	err = d.queries.GetLeasesToAcquire(ctx, tx, dbsqlc.GetLeasesToAcquireParams{
-		Kind:        kind,
-		Resourceids: resourceIds,
-		Tenantid:    d.tenantId,
-	})
-
-	if err != nil {
-		return nil, err
-	}
-
-	leases, err := d.queries.AcquireOrExtendLeases(ctx, tx, dbsqlc.AcquireOrExtendLeasesParams{
-		Kind:             kind,
-		LeaseDuration:    d.leaseDuration,
-		Resourceids:      resourceIds,
-		Tenantid:         d.tenantId,
-		Existingleaseids: leaseIds,
-	})
-
-	if err != nil {
-		return nil, err
-	}
-
-	if err := commit(ctx); err != nil {
-		return nil, err
-	}
-
-	return leases, nil
+	return d.repo.AcquireOrExtendLeases(ctx, sqlchelpers.UUIDToStr(d.tenantId), kind, resourceIds, leaseIds)
 }
 
 func (d *leaseDbQueries) ReleaseLeases(ctx context.Context, leases []*dbsqlc.Lease) error {
@@ -108,39 +86,28 @@ func (d *leaseDbQueries) ReleaseLeases(ctx context.Context, leases []*dbsqlc.Lea
 		leaseIds[i] = lease.ID
 	}
 
-	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, d.pool, d.l, 5000)
-
-	if err != nil {
-		return err
-	}
-
-	defer rollback()
-
-	_, err = d.queries.ReleaseLeases(ctx, tx, leaseIds)
-
-	if err != nil {
-		return err
-	}
+	return d.repo.ReleaseLeases(ctx, leaseIds)
+}
 
-	if err := commit(ctx); err != nil {
-		return err
-	}
+func (d *leaseDbQueries) ListAvailableLeaseResources(ctx context.Context, kind dbsqlc.LeaseKind, resourceIds []string) ([]string, error) {
+	ctx, span := telemetry.NewSpan(ctx, "list-available-lease-resources")
+	defer span.End()
 
-	return nil
+	return d.repo.ListAvailableLeaseResources(ctx, sqlchelpers.UUIDToStr(d.tenantId), kind, resourceIds)
 }
 
 func (d *leaseDbQueries) ListQueues(ctx context.Context, tenantId pgtype.UUID) ([]*dbsqlc.Queue, error) {
 	ctx, span := telemetry.NewSpan(ctx, "list-queues")
 	defer span.End()
 
-	return d.queries.ListQueues(ctx, d.pool, tenantId)
+	return d.repo.ListQueues(ctx, sqlchelpers.UUIDToStr(tenantId))
 }
 
 func (d *leaseDbQueries) ListActiveWorkers(ctx context.Context, tenantId pgtype.UUID) ([]*ListActiveWorkersResult, error) {
 	ctx, span := telemetry.NewSpan(ctx, "list-active-workers")
 	defer span.End()
 
-	activeWorkers, err := d.queries.ListActiveWorkers(ctx, d.pool, tenantId)
+	activeWorkers, err := d.repo.ListActiveWorkers(ctx, sqlchelpers.UUIDToStr(tenantId))
 
 	if err != nil {
 		return nil, err
@@ -152,9 +119,9 @@ func (d *leaseDbQueries) ListActiveWorkers(ctx context.Context, tenantId pgtype.
 		workerIds = append(workerIds, worker.ID)
 	}
 
-	labels, err := d.queries.ListManyWorkerLabels(ctx, d.pool, workerIds)
+	labels, err := d.repo.ListWorkerLabels(ctx, workerIds)
 
-	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+	if err != nil {
 		return nil, err
 	}
 
@@ -174,9 +141,19 @@ func (d *leaseDbQueries) ListActiveWorkers(ctx context.Context, tenantId pgtype.
 
 	for _, worker := range activeWorkers {
 		wId := sqlchelpers.UUIDToStr(worker.ID)
+
+		status := WorkerStatusActive
+
+		if worker.IsDraining {
+			status = WorkerStatusDraining
+		}
+
 		res = append(res, &ListActiveWorkersResult{
 			ID:     worker.ID,
 			Labels: workerIdsToLabels[wId],
+			Status: status,
+			Region: worker.Region.String,
+			Pool:   worker.Pool.String,
 		})
 	}
 
@@ -200,6 +177,24 @@ type LeaseManager struct {
 	queueLeases   []*dbsqlc.Lease
 	queuesCh      chan<- []string
 
+	// when running as a warm standby, we keep the last read of the active workers and queues, along with
+	// the resources which are leased by another instance. these are protected by the lease mutexes.
+	activeWorkers    []*ListActiveWorkersResult
+	standbyWorkerIds []string
+	activeQueues     []*dbsqlc.Queue
+	standbyQueues    []string
+
+	// pausedQueues contains the leased queues which are paused. Paused queues keep their lease, but
+	// their queuers do not pull new queue items until the queue is resumed.
+	pausedQueuesMu sync.RWMutex
+	pausedQueues   map[string]struct{}
+
+	// queueFencingTokens contains the fencing tokens of the queue leases, keyed by resource id. Queuers
+	// include the token when writing assignments, so that writes from a scheduler which lost its lease
+	// are rejected.
+	fencingTokensMu    sync.RWMutex
+	queueFencingTokens map[string]int64
+
 	cleanedUp bool
 	cleanupMu sync.Mutex
 }
@@ -209,7 +204,7 @@ func newLeaseManager(conf *sharedConfig, tenantId pgtype.UUID) (*LeaseManager, <
 	queuesCh := make(chan []string)
 
 	return &LeaseManager{
-		lr:        newLeaseDbQueries(tenantId, conf.queries, conf.pool, conf.l),
+		lr:        newLeaseDbQueries(tenantId, conf.repo.Lease()),
 		conf:      conf,
 		tenantId:  tenantId,
 		workersCh: workersCh,
@@ -272,6 +267,17 @@ func (l *LeaseManager) acquireWorkerLeases(ctx context.Context) error {
 		return err
 	}
 
+	if l.conf.dryRunSink != nil {
+		l.observeWorkers(activeWorkers)
+		return nil
+	}
+
+	return l.leaseWorkers(ctx, activeWorkers)
+}
+
+// leaseWorkers acquires or extends leases for the active workers and releases leases for workers which
+// are no longer active. The caller must hold the worker leases mutex.
+func (l *LeaseManager) leaseWorkers(ctx context.Context, activeWorkers []*ListActiveWorkersResult) error {
 	currResourceIdsToLease := make(map[string]*dbsqlc.Lease, len(l.workerLeases))
 
 	for _, lease := range l.workerLeases {
@@ -313,6 +319,15 @@ func (l *LeaseManager) acquireWorkerLeases(ctx context.Context) error {
 		for _, lease := range workerLeases {
 			successfullyAcquiredWorkerIds = append(successfullyAcquiredWorkerIds, activeWorkerIdsToResults[lease.ResourceId])
 		}
+
+		metrics.Leases.WithLabelValues(sqlchelpers.UUIDToStr(l.tenantId), "worker").Set(float64(len(workerLeases)))
+	} else {
+		metrics.Leases.WithLabelValues(sqlchelpers.UUIDToStr(l.tenantId), "worker").Set(0)
+	}
+
+	if l.conf.warmStandby {
+		l.activeWorkers = activeWorkers
+		l.standbyWorkerIds = unleasedResourceIds(workerIdsStr, l.workerLeases)
 	}
 
 	l.sendWorkerIds(successfullyAcquiredWorkerIds)
@@ -339,22 +354,42 @@ func (l *LeaseManager) acquireQueueLeases(ctx context.Context) error {
 		return err
 	}
 
+	if l.conf.dryRunSink != nil {
+		l.observeQueues(queues)
+		return nil
+	}
+
+	return l.leaseQueues(ctx, queues)
+}
+
+// leaseQueues acquires or extends leases for the queues and releases leases for queues which no longer
+// exist. The caller must hold the queue leases mutex.
+func (l *LeaseManager) leaseQueues(ctx context.Context, queues []*dbsqlc.Queue) error {
 	currResourceIdsToLease := make(map[string]*dbsqlc.Lease, len(l.queueLeases))
 
 	for _, lease := range l.queueLeases {
 		currResourceIdsToLease[lease.ResourceId] = lease
 	}
 
-	queueIdsStr := make([]string, len(queues))
+	queueIdsStr := make([]string, 0, len(queues))
+	pausedQueues := make(map[string]struct{})
 	leasesToExtend := make([]*dbsqlc.Lease, 0, len(queues))
 	leasesToRelease := make([]*dbsqlc.Lease, 0, len(currResourceIdsToLease))
 
-	for i, q := range queues {
-		queueIdsStr[i] = q.Name
+	for _, q := range queues {
+		if q.IsPaused {
+			pausedQueues[q.Name] = struct{}{}
+		}
+
+		// sharded queues are leased per shard
+		for _, shard := range l.conf.getQueueShards(q.Name) {
+			resourceId := shard.resourceId()
+			queueIdsStr = append(queueIdsStr, resourceId)
 
-		if lease, ok := currResourceIdsToLease[queueIdsStr[i]]; ok {
-			leasesToExtend = append(leasesToExtend, lease)
-			delete(currResourceIdsToLease, queueIdsStr[i])
+			if lease, ok := currResourceIdsToLease[resourceId]; ok {
+				leasesToExtend = append(leasesToExtend, lease)
+				delete(currResourceIdsToLease, resourceId)
+			}
 		}
 	}
 
@@ -362,6 +397,8 @@ func (l *LeaseManager) acquireQueueLeases(ctx context.Context) error {
 		leasesToRelease = append(leasesToRelease, lease)
 	}
 
+	l.setPausedQueues(pausedQueues)
+
 	successfullyAcquiredQueues := []string{}
 
 	if len(queueIdsStr) != 0 {
@@ -373,10 +410,20 @@ func (l *LeaseManager) acquireQueueLeases(ctx context.Context) error {
 		}
 
 		l.queueLeases = queueLeases
+		l.setQueueFencingTokens(queueLeases)
 
 		for _, lease := range queueLeases {
 			successfullyAcquiredQueues = append(successfullyAcquiredQueues, lease.ResourceId)
 		}
+
+		metrics.Leases.WithLabelValues(sqlchelpers.UUIDToStr(l.tenantId), "queue").Set(float64(len(queueLeases)))
+	} else {
+		metrics.Leases.WithLabelValues(sqlchelpers.UUIDToStr(l.tenantId), "queue").Set(0)
+	}
+
+	if l.conf.warmStandby {
+		l.activeQueues = queues
+		l.standbyQueues = unleasedResourceIds(queueIdsStr, l.queueLeases)
 	}
 
 	l.sendQueues(successfullyAcquiredQueues)
@@ -390,6 +437,23 @@ func (l *LeaseManager) acquireQueueLeases(ctx context.Context) error {
 	return nil
 }
 
+func (l *LeaseManager) setPausedQueues(pausedQueues map[string]struct{}) {
+	l.pausedQueuesMu.Lock()
+	defer l.pausedQueuesMu.Unlock()
+
+	l.pausedQueues = pausedQueues
+}
+
+// isQueuePaused returns true if the queue was paused as of the last time queue leases were acquired.
+func (l *LeaseManager) isQueuePaused(queueName string) bool {
+	l.pausedQueuesMu.RLock()
+	defer l.pausedQueuesMu.RUnlock()
+
+	_, ok := l.pausedQueues[queueName]
+
+	return ok
+}
+
 // loopForLeases acquires new leases every 1 second for workers and queues
 func (l *LeaseManager) loopForLeases(ctx context.Context) {
 	ticker := time.NewTicker(1 * time.Second)
@@ -436,6 +500,11 @@ func (l *LeaseManager) cleanup(ctx context.Context) error {
 	defer close(l.workersCh)
 	defer close(l.queuesCh)
 
+	tenantId := sqlchelpers.UUIDToStr(l.tenantId)
+
+	metrics.Leases.DeleteLabelValues(tenantId, "worker")
+	metrics.Leases.DeleteLabelValues(tenantId, "queue")
+
 	eg := errgroup.Group{}
 
 	eg.Go(func() error {
@@ -449,6 +518,8 @@ func (l *LeaseManager) cleanup(ctx context.Context) error {
 		l.queueLeasesMu.Lock()
 		defer l.queueLeasesMu.Unlock()
 
+		l.setQueueFencingTokens(nil)
+
 		return l.lr.ReleaseLeases(ctx, l.queueLeases)
 	})
 
@@ -461,4 +532,8 @@ func (l *LeaseManager) cleanup(ctx context.Context) error {
 
 func (l *LeaseManager) start(ctx context.Context) {
 	go l.loopForLeases(ctx)
+
+	if l.conf.warmStandby && l.conf.dryRunSink == nil {
+		go l.loopForStandbyLeases(ctx)
+	}
 }
@@ -42,6 +42,11 @@ func (m *mockLeaseRepo) ReleaseLeases(ctx context.Context, leases []*dbsqlc.Leas
 	return args.Error(0)
 }
 
+func (m *mockLeaseRepo) ListAvailableLeaseResources(ctx context.Context, kind dbsqlc.LeaseKind, resourceIds []string) ([]string, error) {
+	args := m.Called(ctx, kind, resourceIds)
+	return args.Get(0).([]string), args.Error(1)
+}
+
 func TestLeaseManager_AcquireWorkerLeases(t *testing.T) {
 	l := zerolog.Nop()
 	tenantId := pgtype.UUID{}
@@ -96,6 +101,139 @@ func TestLeaseManager_AcquireQueueLeases(t *testing.T) {
 	assert.Len(t, leaseManager.queueLeases, 2)
 }
 
+func TestLeaseManager_QueueFencingTokens(t *testing.T) {
+	l := zerolog.Nop()
+	tenantId := pgtype.UUID{}
+	mockLeaseRepo := &mockLeaseRepo{}
+	leaseManager := &LeaseManager{
+		lr:       mockLeaseRepo,
+		conf:     &sharedConfig{l: &l},
+		tenantId: tenantId,
+	}
+
+	mockQueues := []*dbsqlc.Queue{
+		{Name: "queue-1"},
+	}
+	mockLeases := []*dbsqlc.Lease{
+		{ID: 1, ResourceId: "queue-1", FencingToken: 42},
+	}
+
+	mockLeaseRepo.On("ListQueues", mock.Anything, tenantId).Return(mockQueues, nil)
+	mockLeaseRepo.On("AcquireOrExtendLeases", mock.Anything, dbsqlc.LeaseKindQUEUE, mock.Anything, mock.Anything).Return(mockLeases, nil)
+
+	err := leaseManager.acquireQueueLeases(context.Background())
+	assert.NoError(t, err)
+
+	token, ok := leaseManager.queueFencingToken("queue-1")
+	assert.True(t, ok)
+	assert.Equal(t, int64(42), token)
+
+	_, ok = leaseManager.queueFencingToken("queue-2")
+	assert.False(t, ok)
+}
+
+func TestLeaseManager_AcquireQueueLeasesPaused(t *testing.T) {
+	l := zerolog.Nop()
+	tenantId := pgtype.UUID{}
+	mockLeaseRepo := &mockLeaseRepo{}
+	leaseManager := &LeaseManager{
+		lr:       mockLeaseRepo,
+		conf:     &sharedConfig{l: &l},
+		tenantId: tenantId,
+	}
+
+	mockQueues := []*dbsqlc.Queue{
+		{Name: "queue-1"},
+		{Name: "queue-2", IsPaused: true},
+	}
+	mockLeases := []*dbsqlc.Lease{
+		{ID: 1, ResourceId: "queue-1"},
+		{ID: 2, ResourceId: "queue-2"},
+	}
+
+	mockLeaseRepo.On("ListQueues", mock.Anything, tenantId).Return(mockQueues, nil)
+	mockLeaseRepo.On("AcquireOrExtendLeases", mock.Anything, dbsqlc.LeaseKindQUEUE, []string{"queue-1", "queue-2"}, mock.Anything).Return(mockLeases, nil)
+
+	err := leaseManager.acquireQueueLeases(context.Background())
+	assert.NoError(t, err)
+
+	// paused queues keep their lease
+	assert.Len(t, leaseManager.queueLeases, 2)
+	assert.False(t, leaseManager.isQueuePaused("queue-1"))
+	assert.True(t, leaseManager.isQueuePaused("queue-2"))
+}
+
+func TestLeaseManager_AcquireShardedQueueLeases(t *testing.T) {
+	l := zerolog.Nop()
+	tenantId := pgtype.UUID{}
+	mockLeaseRepo := &mockLeaseRepo{}
+	leaseManager := &LeaseManager{
+		lr:       mockLeaseRepo,
+		conf:     &sharedConfig{l: &l, queueShards: map[string]int{"queue-2": 2}},
+		tenantId: tenantId,
+	}
+
+	mockQueues := []*dbsqlc.Queue{
+		{Name: "queue-1"},
+		{Name: "queue-2"},
+	}
+
+	// another scheduler holds the lease on the second shard of queue-2
+	mockLeases := []*dbsqlc.Lease{
+		{ID: 1, ResourceId: "queue-1"},
+		{ID: 2, ResourceId: "queue-2#0"},
+	}
+
+	mockLeaseRepo.On("ListQueues", mock.Anything, tenantId).Return(mockQueues, nil)
+	mockLeaseRepo.On("AcquireOrExtendLeases", mock.Anything, dbsqlc.LeaseKindQUEUE, []string{"queue-1", "queue-2#0", "queue-2#1"}, mock.Anything).Return(mockLeases, nil)
+
+	err := leaseManager.acquireQueueLeases(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, leaseManager.queueLeases, 2)
+}
+
+func TestLeaseManager_AcquireStandbyQueueLeases(t *testing.T) {
+	l := zerolog.Nop()
+	tenantId := pgtype.UUID{}
+	mockLeaseRepo := &mockLeaseRepo{}
+	leaseManager := &LeaseManager{
+		lr:       mockLeaseRepo,
+		conf:     &sharedConfig{l: &l, warmStandby: true},
+		tenantId: tenantId,
+	}
+
+	mockQueues := []*dbsqlc.Queue{
+		{Name: "queue-1"},
+		{Name: "queue-2"},
+	}
+
+	// queue-2 is leased by another scheduler
+	mockLeaseRepo.On("ListQueues", mock.Anything, tenantId).Return(mockQueues, nil).Once()
+	mockLeaseRepo.On("AcquireOrExtendLeases", mock.Anything, dbsqlc.LeaseKindQUEUE, mock.Anything, mock.Anything).Return([]*dbsqlc.Lease{
+		{ID: 1, ResourceId: "queue-1"},
+	}, nil).Once()
+
+	err := leaseManager.acquireQueueLeases(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, leaseManager.queueLeases, 1)
+	assert.Equal(t, []string{"queue-2"}, leaseManager.standbyQueues)
+
+	// the other scheduler releases the lease on queue-2
+	mockLeaseRepo.On("ListAvailableLeaseResources", mock.Anything, dbsqlc.LeaseKindQUEUE, []string{"queue-2"}).Return([]string{"queue-2"}, nil).Once()
+	mockLeaseRepo.On("AcquireOrExtendLeases", mock.Anything, dbsqlc.LeaseKindQUEUE, mock.Anything, mock.Anything).Return([]*dbsqlc.Lease{
+		{ID: 1, ResourceId: "queue-1"},
+		{ID: 2, ResourceId: "queue-2"},
+	}, nil).Once()
+
+	err = leaseManager.acquireStandbyQueueLeases(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, leaseManager.queueLeases, 2)
+	assert.Empty(t, leaseManager.standbyQueues)
+
+	// the standby uses the pre-loaded queues rather than listing them again
+	mockLeaseRepo.AssertNumberOfCalls(t, "ListQueues", 1)
+}
+
 func TestLeaseManager_SendWorkerIds(t *testing.T) {
 	tenantId := pgtype.UUID{}
 	workersCh := make(chan []*ListActiveWorkersResult)
@@ -0,0 +1,77 @@
+package v2
+
+import (
+	"fmt"
+)
+
+// AssignmentPolicy determines the order in which queue items are pulled from a queue.
+type AssignmentPolicy string
+
+const (
+	// AssignmentPolicyFIFO pulls queue items in priority order, then insertion order.
+	AssignmentPolicyFIFO AssignmentPolicy = "fifo"
+
+	// AssignmentPolicyFairShare pulls queue items using a weighted round robin across workflow ids, so
+	// that a single workflow with a large backlog cannot starve the other workflows in the queue.
+	AssignmentPolicyFairShare AssignmentPolicy = "fair-share"
+
+	// AssignmentPolicyEDF pulls queue items with the earliest deadline first. Queue items without a deadline
+	// are pulled after queue items with a deadline, in priority and insertion order.
+	AssignmentPolicyEDF AssignmentPolicy = "edf"
+)
+
+func ParseAssignmentPolicy(s string) (AssignmentPolicy, error) {
+	switch AssignmentPolicy(s) {
+	case "", AssignmentPolicyFIFO:
+		return AssignmentPolicyFIFO, nil
+	case AssignmentPolicyFairShare:
+		return AssignmentPolicyFairShare, nil
+	case AssignmentPolicyEDF:
+		return AssignmentPolicyEDF, nil
+	default:
+		return "", fmt.Errorf("invalid assignment policy: %s", s)
+	}
+}
+
+type SchedulingPoolOpt func(*sharedConfig)
+
+// WithAssignmentPolicies sets the default assignment policy, along with any tenant-specific overrides
+// keyed by tenant id.
+func WithAssignmentPolicies(defaultPolicy AssignmentPolicy, tenantPolicies map[string]AssignmentPolicy) SchedulingPoolOpt {
+	return func(cf *sharedConfig) {
+		cf.defaultPolicy = defaultPolicy
+		cf.tenantPolicies = tenantPolicies
+	}
+}
+
+// WithQueueAssignmentPolicies overrides the assignment policy for specific queues, keyed by queue name. Queue
+// overrides take precedence over tenant overrides.
+func WithQueueAssignmentPolicies(queuePolicies map[string]AssignmentPolicy) SchedulingPoolOpt {
+	return func(cf *sharedConfig) {
+		cf.queuePolicies = queuePolicies
+	}
+}
+
+// WithFairShareWeights sets the weight of each workflow id when using the fair-share policy. Workflows which
+// are not in the map have a weight of 1.
+func WithFairShareWeights(weights map[string]int32) SchedulingPoolOpt {
+	return func(cf *sharedConfig) {
+		cf.fairShareWeights = weights
+	}
+}
+
+func (cf *sharedConfig) getAssignmentPolicy(tenantId, queueName string) AssignmentPolicy {
+	if p, ok := cf.queuePolicies[queueName]; ok {
+		return p
+	}
+
+	if p, ok := cf.tenantPolicies[tenantId]; ok {
+		return p
+	}
+
+	if cf.defaultPolicy == "" {
+		return AssignmentPolicyFIFO
+	}
+
+	return cf.defaultPolicy
+}
@@ -0,0 +1,154 @@
+package v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// mockPolicyRepo only implements the queue repository, which lists the queue items of the assignment policies
+type mockPolicyRepo struct {
+	repository.SchedulerRepository
+
+	queue *mockQueueRepo
+}
+
+func (m *mockPolicyRepo) Queue() repository.SchedulerQueueRepository {
+	return m.queue
+}
+
+type mockQueueRepo struct {
+	repository.SchedulerQueueRepository
+	mock.Mock
+}
+
+func (m *mockQueueRepo) ListQueueItems(ctx context.Context, tenantId string, opts *repository.ListQueueItemsOpts) ([]*dbsqlc.ListQueueItemsForQueueRow, error) {
+	args := m.Called(ctx, tenantId, opts)
+	return args.Get(0).([]*dbsqlc.ListQueueItemsForQueueRow), args.Error(1)
+}
+
+func (m *mockQueueRepo) ListQueueItemsFairShare(ctx context.Context, tenantId string, opts *repository.ListQueueItemsOpts, weights map[string]int32) ([]*dbsqlc.ListQueueItemsForQueueRow, error) {
+	args := m.Called(ctx, tenantId, opts, weights)
+	return args.Get(0).([]*dbsqlc.ListQueueItemsForQueueRow), args.Error(1)
+}
+
+func (m *mockQueueRepo) ListQueueItemsEDF(ctx context.Context, tenantId string, opts *repository.ListQueueItemsOpts) ([]*dbsqlc.ListQueueItemsForQueueRow, error) {
+	args := m.Called(ctx, tenantId, opts)
+	return args.Get(0).([]*dbsqlc.ListQueueItemsForQueueRow), args.Error(1)
+}
+
+func TestParseAssignmentPolicy(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected AssignmentPolicy
+		wantErr  bool
+	}{
+		{input: "", expected: AssignmentPolicyFIFO},
+		{input: "fifo", expected: AssignmentPolicyFIFO},
+		{input: "fair-share", expected: AssignmentPolicyFairShare},
+		{input: "edf", expected: AssignmentPolicyEDF},
+		{input: "EDF", wantErr: true},
+		{input: "lifo", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			policy, err := ParseAssignmentPolicy(tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, policy)
+		})
+	}
+}
+
+func TestGetAssignmentPolicy(t *testing.T) {
+	cf := &sharedConfig{}
+	assert.Equal(t, AssignmentPolicyFIFO, cf.getAssignmentPolicy("tenant", "queue"), "the default policy is fifo")
+
+	WithAssignmentPolicies(AssignmentPolicyFairShare, map[string]AssignmentPolicy{
+		"edf-tenant": AssignmentPolicyEDF,
+	})(cf)
+
+	WithQueueAssignmentPolicies(map[string]AssignmentPolicy{
+		"fifo-queue": AssignmentPolicyFIFO,
+		"edf-queue":  AssignmentPolicyEDF,
+	})(cf)
+
+	assert.Equal(t, AssignmentPolicyFairShare, cf.getAssignmentPolicy("tenant", "queue"))
+	assert.Equal(t, AssignmentPolicyEDF, cf.getAssignmentPolicy("edf-tenant", "queue"))
+	assert.Equal(t, AssignmentPolicyEDF, cf.getAssignmentPolicy("tenant", "edf-queue"))
+	assert.Equal(t, AssignmentPolicyFIFO, cf.getAssignmentPolicy("edf-tenant", "fifo-queue"), "queue overrides take precedence over tenant overrides")
+}
+
+func newTestQueueItemRows(ids ...int64) []*dbsqlc.ListQueueItemsForQueueRow {
+	rows := make([]*dbsqlc.ListQueueItemsForQueueRow, 0, len(ids))
+
+	for _, id := range ids {
+		rows = append(rows, &dbsqlc.ListQueueItemsForQueueRow{
+			QueueItem: dbsqlc.QueueItem{
+				ID:        id,
+				StepRunId: sqlchelpers.UUIDFromStr(uuid.New().String()),
+			},
+			Status: dbsqlc.StepRunStatusPENDINGASSIGNMENT,
+		})
+	}
+
+	return rows
+}
+
+func TestListQueueItemsEDF(t *testing.T) {
+	tenantId := uuid.New().String()
+	l := zerolog.Nop()
+
+	queueRepo := &mockQueueRepo{}
+
+	d := &queuerDbQueries{
+		tenantId:  sqlchelpers.UUIDFromStr(tenantId),
+		queueName: "queue",
+		shard:     queueShard{queueName: "queue", index: 1, count: 2},
+		repo:      &mockPolicyRepo{queue: queueRepo},
+		l:         &l,
+		policy:    AssignmentPolicyEDF,
+	}
+
+	d.setMinId(5)
+
+	// the repository lists the queue items by deadline, so they aren't in id order, and the order is kept
+	rows := newTestQueueItemRows(9, 6, 8, 5)
+
+	queueRepo.On("ListQueueItemsEDF", mock.Anything, tenantId, mock.MatchedBy(func(opts *repository.ListQueueItemsOpts) bool {
+		return opts.Queue == "queue" && opts.Limit == 10 &&
+			opts.GtId != nil && *opts.GtId == 5 &&
+			opts.ShardCount != nil && *opts.ShardCount == 2 &&
+			opts.ShardIndex != nil && *opts.ShardIndex == 1
+	})).Return(rows, nil)
+
+	qis, err := d.ListQueueItems(context.Background(), 10)
+	require.NoError(t, err)
+
+	ids := make([]int64, 0, len(qis))
+
+	for _, qi := range qis {
+		ids = append(ids, qi.ID)
+	}
+
+	assert.Equal(t, []int64{9, 6, 8, 5}, ids)
+
+	queueRepo.AssertExpectations(t)
+	queueRepo.AssertNotCalled(t, "ListQueueItems", mock.Anything, mock.Anything, mock.Anything)
+	queueRepo.AssertNotCalled(t, "ListQueueItemsFairShare", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
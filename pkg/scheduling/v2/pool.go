@@ -3,21 +3,45 @@ package v2
 import (
 	"context"
 	"sync"
+	"time"
 
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 
-	"github.com/hatchet-dev/hatchet/pkg/repository/buffer"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
-	"github.com/hatchet-dev/hatchet/pkg/validator"
 )
 
 type sharedConfig struct {
-	queries          *dbsqlc.Queries
-	pool             *pgxpool.Pool
+	repo             repository.SchedulerRepository
 	l                *zerolog.Logger
 	singleQueueLimit int
+
+	defaultPolicy    AssignmentPolicy
+	tenantPolicies   map[string]AssignmentPolicy
+	queuePolicies    map[string]AssignmentPolicy
+	fairShareWeights map[string]int32
+
+	queueShards map[string]int
+
+	decisionLogSize int
+
+	budgetWindow        time.Duration
+	budgetMaxTime       time.Duration
+	budgetMaxIterations int
+
+	strategy AssignmentStrategy
+
+	maxAssignedPerWorker int
+	maxAssignedPerTick   int
+
+	warmStandby         bool
+	standbyPollInterval time.Duration
+
+	dryRunSink DryRunSink
+
+	dynamicRateLimitDefault int
+	dynamicRateLimitTTL     time.Duration
 }
 
 // SchedulingPool is responsible for managing a pool of tenantManagers.
@@ -28,39 +52,29 @@ type SchedulingPool struct {
 	cf *sharedConfig
 
 	resultsCh chan *QueueResults
-
-	eventBuffer *buffer.BulkEventWriter
 }
 
-func NewSchedulingPool(l *zerolog.Logger, p *pgxpool.Pool, v validator.Validator, singleQueueLimit int, buffSettings buffer.ConfigFileBuffer) (*SchedulingPool, func() error, error) {
+func NewSchedulingPool(l *zerolog.Logger, repo repository.SchedulerRepository, singleQueueLimit int, fs ...SchedulingPoolOpt) (*SchedulingPool, func() error) {
 	resultsCh := make(chan *QueueResults, 1000)
 
-	eventBuffer, err := buffer.NewBulkEventWriter(p, v, l, buffSettings)
-
-	if err != nil {
-		return nil, nil, err
-	}
-
 	s := &SchedulingPool{
 		cf: &sharedConfig{
-			queries:          dbsqlc.New(),
-			pool:             p,
+			repo:             repo,
 			l:                l,
 			singleQueueLimit: singleQueueLimit,
 		},
-		resultsCh:   resultsCh,
-		eventBuffer: eventBuffer,
-		setMu:       newMu(l),
+		resultsCh: resultsCh,
+		setMu:     newMu(l),
 	}
 
-	return s, func() error {
-		if err := eventBuffer.Cleanup(); err != nil {
-			return err
-		}
+	for _, f := range fs {
+		f(s.cf)
+	}
 
+	return s, func() error {
 		s.cleanup()
 		return nil
-	}, nil
+	}
 }
 
 func (p *SchedulingPool) GetResultsCh() chan *QueueResults {
@@ -158,12 +172,36 @@ func (p *SchedulingPool) Queue(ctx context.Context, tenantId string, queueName s
 	}
 }
 
+// ReturnRateLimits returns the rate limit units consumed by assigned queue items which could not be
+// dispatched, so that other step runs can use them.
+func (p *SchedulingPool) ReturnRateLimits(ctx context.Context, tenantId string, assigned ...*AssignedQueueItem) {
+	if tm := p.getTenantManager(tenantId, false); tm != nil {
+		tm.returnRateLimits(assigned)
+	}
+}
+
+// TenantBudgetUsage returns the scheduling budget usage for each tenant, keyed by tenant id. It returns
+// an empty map if tenant budgets are not enabled.
+func (p *SchedulingPool) TenantBudgetUsage() map[string]TenantBudgetUsage {
+	res := make(map[string]TenantBudgetUsage)
+
+	p.tenants.Range(func(key, value interface{}) bool {
+		if budget := value.(*tenantManager).budget; budget != nil {
+			res[key.(string)] = budget.usage()
+		}
+
+		return true
+	})
+
+	return res
+}
+
 func (p *SchedulingPool) getTenantManager(tenantId string, storeIfNotFound bool) *tenantManager {
 	tm, ok := p.tenants.Load(tenantId)
 
 	if !ok {
 		if storeIfNotFound {
-			tm = newTenantManager(p.cf, tenantId, p.eventBuffer, p.resultsCh)
+			tm = newTenantManager(p.cf, tenantId, p.resultsCh)
 			p.tenants.Store(tenantId, tm)
 		} else {
 			return nil
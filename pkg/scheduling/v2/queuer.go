@@ -2,18 +2,17 @@ package v2
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 
+	"github.com/hatchet-dev/hatchet/internal/metrics"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
 	"github.com/hatchet-dev/hatchet/pkg/repository"
-	"github.com/hatchet-dev/hatchet/pkg/repository/buffer"
 	"github.com/hatchet-dev/hatchet/pkg/repository/cache"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
@@ -29,30 +28,53 @@ type queuerRepo interface {
 type queuerDbQueries struct {
 	tenantId  pgtype.UUID
 	queueName string
+	shard     queueShard
 
-	queries *dbsqlc.Queries
-	pool    *pgxpool.Pool
-	l       *zerolog.Logger
+	repo repository.SchedulerRepository
+	l    *zerolog.Logger
 
 	gtId   pgtype.Int8
 	gtIdMu sync.RWMutex
 
-	eventBuffer              *buffer.BulkEventWriter
 	cachedStepIdHasRateLimit *cache.Cache
+	cachedStepIdLabels       *cache.Cache
+
+	policy           AssignmentPolicy
+	fairShareWeights map[string]int32
+
+	dryRun bool
+
+	fencingToken func(resourceId string) (int64, bool)
+
+	dynamicRateLimitDefault int
+	dynamicRateLimitTTL     time.Duration
 }
 
-func newQueueItemDbQueries(cf *sharedConfig, tenantId pgtype.UUID, eventBuffer *buffer.BulkEventWriter, queueName string,
+func newQueueItemDbQueries(cf *sharedConfig, tenantId pgtype.UUID, shard queueShard,
+	fencingToken func(resourceId string) (int64, bool),
 ) (*queuerDbQueries, func()) {
 	c := cache.New(5 * time.Minute)
+	labelsCache := cache.New(5 * time.Minute)
+	queueName := shard.queueName
+
 	return &queuerDbQueries{
 		tenantId:                 tenantId,
 		queueName:                queueName,
-		queries:                  cf.queries,
-		pool:                     cf.pool,
+		shard:                    shard,
+		repo:                     cf.repo,
 		l:                        cf.l,
-		eventBuffer:              eventBuffer,
 		cachedStepIdHasRateLimit: c,
-	}, c.Stop
+		cachedStepIdLabels:       labelsCache,
+		policy:                   cf.getAssignmentPolicy(sqlchelpers.UUIDToStr(tenantId), queueName),
+		fairShareWeights:         cf.fairShareWeights,
+		dryRun:                   cf.dryRunSink != nil,
+		fencingToken:             fencingToken,
+		dynamicRateLimitDefault:  cf.dynamicRateLimitDefault,
+		dynamicRateLimitTTL:      cf.dynamicRateLimitTTL,
+	}, func() {
+		c.Stop()
+		labelsCache.Stop()
+	}
 }
 
 func (d *queuerDbQueries) setMinId(id int64) {
@@ -81,15 +103,17 @@ func (d *queuerDbQueries) ListQueueItems(ctx context.Context, limit int) ([]*dbs
 	start := time.Now()
 	checkpoint := start
 
-	qis, err := d.queries.ListQueueItemsForQueue(ctx, d.pool, dbsqlc.ListQueueItemsForQueueParams{
-		Tenantid: d.tenantId,
-		Queue:    d.queueName,
-		GtId:     d.getMinId(),
-		Limit: pgtype.Int4{
-			Int32: int32(limit), // nolint: gosec
-			Valid: true,
-		},
-	})
+	var qis []*dbsqlc.ListQueueItemsForQueueRow
+	var err error
+
+	switch d.policy {
+	case AssignmentPolicyFairShare:
+		qis, err = d.listFairShareQueueItems(ctx, limit)
+	case AssignmentPolicyEDF:
+		qis, err = d.listEDFQueueItems(ctx, limit)
+	default:
+		qis, err = d.repo.Queue().ListQueueItems(ctx, sqlchelpers.UUIDToStr(d.tenantId), d.listQueueItemsOpts(limit))
+	}
 
 	if err != nil {
 		return nil, err
@@ -123,6 +147,35 @@ func (d *queuerDbQueries) ListQueueItems(ctx context.Context, limit int) ([]*dbs
 	return resQis, nil
 }
 
+// listQueueItemsOpts returns the options to list at most limit queue items of the queue shard, after the min id.
+func (d *queuerDbQueries) listQueueItemsOpts(limit int) *repository.ListQueueItemsOpts {
+	opts := &repository.ListQueueItemsOpts{
+		Queue: d.queueName,
+		Limit: limit,
+	}
+
+	if minId := d.getMinId(); minId.Valid {
+		opts.GtId = &minId.Int64
+	}
+
+	opts.ShardCount, opts.ShardIndex = d.shard.params()
+
+	return opts
+}
+
+// listFairShareQueueItems lists queue items using a weighted round robin across workflow ids. The returned
+// rows are already ordered by round, so they can be used in place of the FIFO ordering. Queue items aren't
+// assigned in id order with this policy, so the min id cursor of the FIFO ordering doesn't apply: the query is
+// bounded by listing at most limit queue items per step instead.
+func (d *queuerDbQueries) listFairShareQueueItems(ctx context.Context, limit int) ([]*dbsqlc.ListQueueItemsForQueueRow, error) {
+	return d.repo.Queue().ListQueueItemsFairShare(ctx, sqlchelpers.UUIDToStr(d.tenantId), d.listQueueItemsOpts(limit), d.fairShareWeights)
+}
+
+// listEDFQueueItems lists queue items with the earliest deadline first.
+func (d *queuerDbQueries) listEDFQueueItems(ctx context.Context, limit int) ([]*dbsqlc.ListQueueItemsForQueueRow, error) {
+	return d.repo.Queue().ListQueueItemsEDF(ctx, sqlchelpers.UUIDToStr(d.tenantId), d.listQueueItemsOpts(limit))
+}
+
 // removeInvalidStepRuns removes all duplicate step runs and step runs which are in a finalized state from
 // the queue. It returns the remaining queue items and an error if one occurred.
 func (s *queuerDbQueries) removeInvalidStepRuns(ctx context.Context, qis []*dbsqlc.ListQueueItemsForQueueRow) ([]*dbsqlc.QueueItem, error) {
@@ -169,30 +222,23 @@ func (s *queuerDbQueries) removeInvalidStepRuns(ctx context.Context, qis []*dbsq
 		remaining2 = append(remaining2, qi)
 	}
 
-	if len(cancelled) == 0 {
+	// dry-run pools leave cancelled queue items to the schedulers which hold the leases
+	if len(cancelled) == 0 || s.dryRun {
 		return remaining2, nil
 	}
 
-	// If we've reached this point, we have queue items to cancel. We prepare a transaction in order
-	// to set a statement timeout.
-	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, s.pool, s.l, 5000)
+	fence, err := s.fence()
 
 	if err != nil {
 		return nil, err
 	}
 
-	defer rollback()
-
-	err = s.queries.BulkQueueItems(ctx, tx, cancelled)
+	err = s.repo.Queue().RemoveQueueItems(ctx, sqlchelpers.UUIDToStr(s.tenantId), fence, cancelled)
 
 	if err != nil {
 		return nil, err
 	}
 
-	if err := commit(ctx); err != nil {
-		return nil, err
-	}
-
 	return remaining2, nil
 }
 
@@ -221,7 +267,7 @@ func (s *queuerDbQueries) bulkStepRunsAssigned(
 		severity := dbsqlc.StepRunEventSeverityINFO
 		data := map[string]interface{}{"worker_id": workerId}
 
-		_, err := s.eventBuffer.BuffItem(tenantId, &repository.CreateStepRunEventOpts{
+		s.repo.Assignment().DeferredStepRunEvent(tenantId, repository.CreateStepRunEventOpts{
 			StepRunId:     sqlchelpers.UUIDToStr(stepRunIds[i]),
 			EventMessage:  &message,
 			EventReason:   &reasons,
@@ -229,25 +275,9 @@ func (s *queuerDbQueries) bulkStepRunsAssigned(
 			Timestamp:     &timeSeen,
 			EventData:     data,
 		})
-
-		if err != nil {
-			s.l.Err(err).Msg("could not buffer step run event")
-		}
-	}
-
-	orderedWorkerIds := make([]pgtype.UUID, 0)
-	assignedStepRuns := make([][]byte, 0)
-
-	for workerId, stepRunIds := range workerIdToStepRunIds {
-		orderedWorkerIds = append(orderedWorkerIds, sqlchelpers.UUIDFromStr(workerId))
-		assignedStepRunsBytes, _ := json.Marshal(stepRunIds) // nolint: errcheck
-		assignedStepRuns = append(assignedStepRuns, assignedStepRunsBytes)
 	}
 
-	err := s.queries.CreateWorkerAssignEvents(ctx, s.pool, dbsqlc.CreateWorkerAssignEventsParams{
-		Workerids:        orderedWorkerIds,
-		Assignedstepruns: assignedStepRuns,
-	})
+	err := s.repo.Assignment().CreateWorkerAssignEvents(ctx, workerIdToStepRunIds)
 
 	if err != nil {
 		s.l.Err(err).Msg("could not create worker assign events")
@@ -265,7 +295,7 @@ func (s *queuerDbQueries) bulkStepRunsUnassigned(
 		reason := dbsqlc.StepRunEventReasonREQUEUEDNOWORKER
 		data := map[string]interface{}{}
 
-		_, err := s.eventBuffer.BuffItem(tenantId, &repository.CreateStepRunEventOpts{
+		s.repo.Assignment().DeferredStepRunEvent(tenantId, repository.CreateStepRunEventOpts{
 			StepRunId:     sqlchelpers.UUIDToStr(stepRunId),
 			EventMessage:  &message,
 			EventReason:   &reason,
@@ -273,10 +303,6 @@ func (s *queuerDbQueries) bulkStepRunsUnassigned(
 			Timestamp:     &timeSeen,
 			EventData:     data,
 		})
-
-		if err != nil {
-			s.l.Err(err).Msg("could not buffer step run event")
-		}
 	}
 }
 
@@ -299,7 +325,7 @@ func (s *queuerDbQueries) bulkStepRunsRateLimited(
 			"rate_limit_key": rlResult.exceededKey,
 		}
 
-		_, err := s.eventBuffer.BuffItem(tenantId, &repository.CreateStepRunEventOpts{
+		s.repo.Assignment().DeferredStepRunEvent(tenantId, repository.CreateStepRunEventOpts{
 			StepRunId:     rlResult.stepRunId,
 			EventMessage:  &message,
 			EventReason:   &reason,
@@ -307,10 +333,6 @@ func (s *queuerDbQueries) bulkStepRunsRateLimited(
 			Timestamp:     &timeSeen,
 			EventData:     data,
 		})
-
-		if err != nil {
-			s.l.Err(err).Msg("could not buffer step run event")
-		}
 	}
 }
 
@@ -318,10 +340,7 @@ func (d *queuerDbQueries) updateMinId() {
 	dbCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	minId, err := d.queries.GetMinUnprocessedQueueItemId(dbCtx, d.pool, dbsqlc.GetMinUnprocessedQueueItemIdParams{
-		Tenantid: d.tenantId,
-		Queue:    d.queueName,
-	})
+	minId, err := d.repo.Queue().GetMinUnprocessedQueueItemId(dbCtx, sqlchelpers.UUIDToStr(d.tenantId), d.queueName)
 
 	if err != nil {
 		d.l.Error().Err(err).Msg("error getting min id")
@@ -340,29 +359,26 @@ func (d *queuerDbQueries) MarkQueueItemsProcessed(ctx context.Context, r *assign
 	defer span.End()
 
 	start := time.Now()
-	checkpoint := start
 
-	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, d.pool, d.l, 5000)
+	fence, err := d.fence()
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	defer rollback()
-
-	durPrepare := time.Since(checkpoint)
-	checkpoint = time.Now()
-
-	idsToUnqueue := make([]int64, len(r.assigned))
-	stepRunIds := make([]pgtype.UUID, len(r.assigned))
-	workerIds := make([]pgtype.UUID, len(r.assigned))
-	stepTimeouts := make([]string, len(r.assigned))
+	opts := &repository.MarkQueueItemsProcessedOpts{
+		Fence:              fence,
+		Assigned:           make([]*repository.AssignQueueItemOpts, 0, len(r.assigned)),
+		SchedulingTimedOut: r.schedulingTimedOut,
+	}
 
-	for i, assignedItem := range r.assigned {
-		idsToUnqueue[i] = assignedItem.QueueItem.ID
-		stepRunIds[i] = assignedItem.QueueItem.StepRunId
-		workerIds[i] = assignedItem.WorkerId
-		stepTimeouts[i] = assignedItem.QueueItem.StepTimeout.String
+	for _, assignedItem := range r.assigned {
+		opts.Assigned = append(opts.Assigned, &repository.AssignQueueItemOpts{
+			QueueItemId: assignedItem.QueueItem.ID,
+			StepRunId:   assignedItem.QueueItem.StepRunId,
+			WorkerId:    assignedItem.WorkerId,
+			StepTimeout: assignedItem.QueueItem.StepTimeout.String,
+		})
 	}
 
 	unassignedStepRunIds := make([]pgtype.UUID, 0, len(r.unassigned))
@@ -371,44 +387,13 @@ func (d *queuerDbQueries) MarkQueueItemsProcessed(ctx context.Context, r *assign
 		unassignedStepRunIds = append(unassignedStepRunIds, id.StepRunId)
 	}
 
-	timedOutStepRuns := make([]pgtype.UUID, 0, len(r.schedulingTimedOut))
-
-	for _, id := range r.schedulingTimedOut {
-		idsToUnqueue = append(idsToUnqueue, id.ID)
-		timedOutStepRuns = append(timedOutStepRuns, id.StepRunId)
-	}
-
-	_, err = d.queries.BulkMarkStepRunsAsCancelling(ctx, tx, timedOutStepRuns)
-
-	if err != nil {
-		return nil, nil, fmt.Errorf("could not bulk mark step runs as cancelling: %w", err)
-	}
-
-	updatedStepRuns, err := d.queries.UpdateStepRunsToAssigned(ctx, tx, dbsqlc.UpdateStepRunsToAssignedParams{
-		Steprunids:      stepRunIds,
-		Workerids:       workerIds,
-		Stepruntimeouts: stepTimeouts,
-		Tenantid:        d.tenantId,
-	})
+	updatedStepRuns, err := d.repo.Queue().MarkQueueItemsProcessed(ctx, sqlchelpers.UUIDToStr(d.tenantId), opts)
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	timeAfterUpdateStepRuns := time.Since(checkpoint)
-	checkpoint = time.Now()
-
-	err = d.queries.BulkQueueItems(ctx, tx, idsToUnqueue)
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	timeAfterBulkQueueItems := time.Since(checkpoint)
-
-	if err := commit(ctx); err != nil {
-		return nil, nil, err
-	}
+	durUpdate := time.Since(start)
 
 	go func() {
 		// if we committed, we can update the min id
@@ -449,11 +434,7 @@ func (d *queuerDbQueries) MarkQueueItemsProcessed(ctx context.Context, r *assign
 		d.l.Warn().Dur(
 			"duration", sinceStart,
 		).Dur(
-			"prepare", durPrepare,
-		).Dur(
-			"update", timeAfterUpdateStepRuns,
-		).Dur(
-			"bulkqueue", timeAfterBulkQueueItems,
+			"update", durUpdate,
 		).Int(
 			"assigned", len(succeeded),
 		).Int(
@@ -461,7 +442,7 @@ func (d *queuerDbQueries) MarkQueueItemsProcessed(ctx context.Context, r *assign
 		).Int(
 			"unassigned", len(unassignedStepRunIds),
 		).Int(
-			"timed_out", len(timedOutStepRuns),
+			"timed_out", len(r.schedulingTimedOut),
 		).Msgf(
 			"marking queue items processed took longer than 100ms",
 		)
@@ -513,7 +494,7 @@ func (d *queuerDbQueries) GetStepRunRateLimits(ctx context.Context, queueItems [
 	}
 
 	// get all step run expression evals which correspond to rate limits, grouped by step run id
-	expressionEvals, err := d.queries.ListStepRunExpressionEvals(ctx, d.pool, stepRunIds)
+	expressionEvals, err := d.repo.RateLimit().ListStepRunExpressionEvals(ctx, stepRunIds)
 
 	if err != nil {
 		return nil, err
@@ -557,8 +538,12 @@ func (d *queuerDbQueries) GetStepRunRateLimits(ctx context.Context, queueItems [
 		rateLimitKeyToEvals[k] = append(rateLimitKeyToEvals[k], eval)
 	}
 
-	upsertRateLimitBulkParams := dbsqlc.UpsertRateLimitsBulkParams{
-		Tenantid: d.tenantId,
+	upsertRateLimitBulkOpts := &repository.UpsertDynamicRateLimitsOpts{}
+
+	// dynamic keys expire once they haven't been used for the ttl
+	if d.dynamicRateLimitTTL > 0 {
+		expiresAt := time.Now().Add(d.dynamicRateLimitTTL).UTC()
+		upsertRateLimitBulkOpts.ExpiresAt = &expiresAt
 	}
 
 	stepRunToKeyToUnits := make(map[string]map[string]int32)
@@ -590,7 +575,7 @@ func (d *queuerDbQueries) GetStepRunRateLimits(ctx context.Context, queueItems [
 					severity := dbsqlc.StepRunEventSeverityWARNING
 					data := map[string]interface{}{}
 
-					_, buffErr := d.eventBuffer.BuffItem(sqlchelpers.UUIDToStr(d.tenantId), &repository.CreateStepRunEventOpts{
+					d.repo.Assignment().DeferredStepRunEvent(sqlchelpers.UUIDToStr(d.tenantId), repository.CreateStepRunEventOpts{
 						StepRunId:     sqlchelpers.UUIDToStr(eval.StepRunId),
 						EventMessage:  &message,
 						EventReason:   &reason,
@@ -599,10 +584,6 @@ func (d *queuerDbQueries) GetStepRunRateLimits(ctx context.Context, queueItems [
 						EventData:     data,
 					})
 
-					if buffErr != nil {
-						d.l.Err(buffErr).Msg("could not buffer step run event")
-					}
-
 					duration = largerDuration
 				}
 			}
@@ -617,7 +598,7 @@ func (d *queuerDbQueries) GetStepRunRateLimits(ctx context.Context, queueItems [
 					severity := dbsqlc.StepRunEventSeverityWARNING
 					data := map[string]interface{}{}
 
-					_, buffErr := d.eventBuffer.BuffItem(sqlchelpers.UUIDToStr(d.tenantId), &repository.CreateStepRunEventOpts{
+					d.repo.Assignment().DeferredStepRunEvent(sqlchelpers.UUIDToStr(d.tenantId), repository.CreateStepRunEventOpts{
 						StepRunId:     sqlchelpers.UUIDToStr(eval.StepRunId),
 						EventMessage:  &message,
 						EventReason:   &reason,
@@ -626,10 +607,6 @@ func (d *queuerDbQueries) GetStepRunRateLimits(ctx context.Context, queueItems [
 						EventData:     data,
 					})
 
-					if buffErr != nil {
-						d.l.Err(buffErr).Msg("could not buffer step run event")
-					}
-
 					limitValue = min(limitValue, int(eval.ValueInt.Int32))
 				}
 			}
@@ -647,16 +624,21 @@ func (d *queuerDbQueries) GetStepRunRateLimits(ctx context.Context, queueItems [
 			continue
 		}
 
-		upsertRateLimitBulkParams.Keys = append(upsertRateLimitBulkParams.Keys, key)
-		upsertRateLimitBulkParams.Windows = append(upsertRateLimitBulkParams.Windows, getWindowParamFromDurString(duration))
-		upsertRateLimitBulkParams.Limitvalues = append(upsertRateLimitBulkParams.Limitvalues, int32(limitValue)) // nolint: gosec
+		// dynamic keys without a limit expression use the default limit
+		if limitValue == 0 {
+			limitValue = d.dynamicRateLimitDefault
+		}
+
+		upsertRateLimitBulkOpts.Keys = append(upsertRateLimitBulkOpts.Keys, key)
+		upsertRateLimitBulkOpts.Windows = append(upsertRateLimitBulkOpts.Windows, getWindowParamFromDurString(duration))
+		upsertRateLimitBulkOpts.LimitValues = append(upsertRateLimitBulkOpts.LimitValues, int32(limitValue)) // nolint: gosec
 	}
 
 	var stepRateLimits []*dbsqlc.StepRateLimit
 
-	if len(upsertRateLimitBulkParams.Keys) > 0 {
+	if len(upsertRateLimitBulkOpts.Keys) > 0 {
 		// upsert all rate limits based on the keys, limit values, and durations
-		err = d.queries.UpsertRateLimitsBulk(ctx, d.pool, upsertRateLimitBulkParams)
+		err = d.repo.RateLimit().UpsertDynamicRateLimits(ctx, sqlchelpers.UUIDToStr(d.tenantId), upsertRateLimitBulkOpts)
 
 		if err != nil {
 			return nil, fmt.Errorf("could not bulk upsert dynamic rate limits: %w", err)
@@ -670,10 +652,7 @@ func (d *queuerDbQueries) GetStepRunRateLimits(ctx context.Context, queueItems [
 		uniqueStepIds = append(uniqueStepIds, sqlchelpers.UUIDFromStr(stepId))
 	}
 
-	stepRateLimits, err = d.queries.ListRateLimitsForSteps(ctx, d.pool, dbsqlc.ListRateLimitsForStepsParams{
-		Tenantid: d.tenantId,
-		Stepids:  uniqueStepIds,
-	})
+	stepRateLimits, err = d.repo.RateLimit().ListRateLimitsForSteps(ctx, sqlchelpers.UUIDToStr(d.tenantId), uniqueStepIds)
 
 	if err != nil {
 		return nil, fmt.Errorf("could not list rate limits for steps: %w", err)
@@ -693,6 +672,25 @@ func (d *queuerDbQueries) GetStepRunRateLimits(ctx context.Context, queueItems [
 		}
 	}
 
+	globalStepRateLimits, err := d.repo.RateLimit().ListGlobalRateLimitsForSteps(ctx, sqlchelpers.UUIDToStr(d.tenantId), uniqueStepIds)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not list global rate limits for steps: %w", err)
+	}
+
+	for _, row := range globalStepRateLimits {
+		stepId := sqlchelpers.UUIDToStr(row.StepId)
+		stepsWithRateLimits[stepId] = true
+
+		for _, stepRunId := range stepIdToStepRuns[stepId] {
+			if _, ok := stepRunToKeyToUnits[stepRunId]; !ok {
+				stepRunToKeyToUnits[stepRunId] = make(map[string]int32)
+			}
+
+			stepRunToKeyToUnits[stepRunId][globalRateLimitKey(row.RateLimitKey)] = row.Units
+		}
+	}
+
 	// store all step ids in the cache, so we can skip rate limiting for steps without rate limits
 	for stepId := range stepIdToStepRuns {
 		hasRateLimit := stepsWithRateLimits[stepId]
@@ -708,22 +706,89 @@ func (d *queuerDbQueries) GetDesiredLabels(ctx context.Context, stepIds []pgtype
 
 	uniqueStepIds := sqlchelpers.UniqueSet(stepIds)
 
-	labels, err := d.queries.GetDesiredLabels(ctx, d.pool, uniqueStepIds)
+	stepIdToLabels := make(map[string][]*dbsqlc.GetDesiredLabelsRow)
+
+	// steps aren't updated once they're created, so their labels are only read once
+	uncachedStepIds := make([]pgtype.UUID, 0, len(uniqueStepIds))
+
+	for _, stepId := range uniqueStepIds {
+		if labels, ok := d.cachedStepIdLabels.Get(sqlchelpers.UUIDToStr(stepId)); ok {
+			if labels := labels.([]*dbsqlc.GetDesiredLabelsRow); len(labels) > 0 {
+				stepIdToLabels[sqlchelpers.UUIDToStr(stepId)] = labels
+			}
+
+			continue
+		}
+
+		uncachedStepIds = append(uncachedStepIds, stepId)
+	}
+
+	if len(uncachedStepIds) == 0 {
+		return stepIdToLabels, nil
+	}
+
+	labels, err := d.repo.Assignment().GetDesiredLabels(ctx, uncachedStepIds)
 
 	if err != nil {
 		return nil, err
 	}
 
-	stepIdToLabels := make(map[string][]*dbsqlc.GetDesiredLabelsRow)
+	uncachedLabels := make(map[string][]*dbsqlc.GetDesiredLabelsRow)
 
 	for _, label := range labels {
 		stepId := sqlchelpers.UUIDToStr(label.StepId)
 
-		if _, ok := stepIdToLabels[stepId]; !ok {
-			stepIdToLabels[stepId] = make([]*dbsqlc.GetDesiredLabelsRow, 0)
+		if _, ok := uncachedLabels[stepId]; !ok {
+			uncachedLabels[stepId] = make([]*dbsqlc.GetDesiredLabelsRow, 0)
 		}
 
-		stepIdToLabels[stepId] = append(stepIdToLabels[stepId], label)
+		uncachedLabels[stepId] = append(uncachedLabels[stepId], label)
+	}
+
+	regions, err := d.repo.Assignment().GetDesiredRegions(ctx, uncachedStepIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, region := range regions {
+		stepId := sqlchelpers.UUIDToStr(region.StepId)
+
+		uncachedLabels[stepId] = append(uncachedLabels[stepId], toRegionLabel(region))
+	}
+
+	slotTypes, err := d.repo.Assignment().GetDesiredSlotTypes(ctx, uncachedStepIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, slotType := range slotTypes {
+		stepId := sqlchelpers.UUIDToStr(slotType.StepId)
+
+		uncachedLabels[stepId] = append(uncachedLabels[stepId], toSlotTypeLabel(slotType))
+	}
+
+	pools, err := d.repo.Assignment().GetDesiredPools(ctx, uncachedStepIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pool := range pools {
+		stepId := sqlchelpers.UUIDToStr(pool.StepId)
+
+		uncachedLabels[stepId] = append(uncachedLabels[stepId], toPoolLabel(pool))
+	}
+
+	// store all step ids in the cache, so steps without labels aren't read again
+	for _, stepId := range uncachedStepIds {
+		stepIdStr := sqlchelpers.UUIDToStr(stepId)
+		d.cachedStepIdLabels.Set(stepIdStr, uncachedLabels[stepIdStr])
+
+		if len(uncachedLabels[stepIdStr]) > 0 {
+			stepIdToLabels[stepIdStr] = uncachedLabels[stepIdStr]
+		}
 	}
 
 	return stepIdToLabels, nil
@@ -733,6 +798,7 @@ type Queuer struct {
 	repo      queuerRepo
 	tenantId  pgtype.UUID
 	queueName string
+	shard     queueShard
 
 	l *zerolog.Logger
 
@@ -757,23 +823,33 @@ type Queuer struct {
 
 	unassigned   map[int64]*dbsqlc.QueueItem
 	unassignedMu mutex
+
+	isPaused func(queueName string) bool
+
+	budget *tenantBudget
+
+	tombstones *tombstones
+
+	dryRunSink    DryRunSink
+	dryRunEmitted *dryRunEmitted
 }
 
-func newQueuer(conf *sharedConfig, tenantId pgtype.UUID, queueName string, s *Scheduler, eventBuffer *buffer.BulkEventWriter, resultsCh chan<- *QueueResults) *Queuer {
+func newQueuer(conf *sharedConfig, tenantId pgtype.UUID, shard queueShard, s *Scheduler, resultsCh chan<- *QueueResults, isPaused func(queueName string) bool, budget *tenantBudget, tombstones *tombstones, fencingToken func(resourceId string) (int64, bool)) *Queuer {
 	defaultLimit := 100
 
 	if conf.singleQueueLimit > 0 {
 		defaultLimit = conf.singleQueueLimit
 	}
 
-	repo, cleanupRepo := newQueueItemDbQueries(conf, tenantId, eventBuffer, queueName)
+	repo, cleanupRepo := newQueueItemDbQueries(conf, tenantId, shard, fencingToken)
 
 	notifyQueueCh := make(chan struct{}, 1)
 
 	q := &Queuer{
 		repo:          repo,
 		tenantId:      tenantId,
-		queueName:     queueName,
+		queueName:     shard.queueName,
+		shard:         shard,
 		l:             conf.l,
 		s:             s,
 		limit:         defaultLimit,
@@ -784,6 +860,14 @@ func newQueuer(conf *sharedConfig, tenantId pgtype.UUID, queueName string, s *Sc
 		unacked:       make(map[int64]struct{}),
 		unassigned:    make(map[int64]*dbsqlc.QueueItem),
 		unassignedMu:  newMu(conf.l),
+		isPaused:      isPaused,
+		budget:        budget,
+		tombstones:    tombstones,
+		dryRunSink:    conf.dryRunSink,
+	}
+
+	if q.dryRunSink != nil {
+		q.dryRunEmitted = newDryRunEmitted()
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -833,6 +917,17 @@ func (q *Queuer) loopQueue(ctx context.Context) {
 		case <-q.notifyQueueCh:
 		}
 
+		// paused queues keep their lease, but don't pull new queue items until they're resumed
+		if q.isPaused != nil && q.isPaused(q.queueName) {
+			continue
+		}
+
+		// tenants which have exceeded their scheduling budget are throttled until the next budget window
+		if ok, wait := q.budget.allow(); !ok {
+			time.AfterFunc(wait, q.queue)
+			continue
+		}
+
 		ctx, span := telemetry.NewSpan(ctx, "queue")
 
 		telemetry.WithAttributes(span, telemetry.AttributeKV{
@@ -847,12 +942,14 @@ func (q *Queuer) loopQueue(ctx context.Context) {
 
 		if err != nil {
 			span.End()
+			q.budget.spend(time.Since(start))
 			q.l.Error().Err(err).Msg("error refilling queue")
 			continue
 		}
 
 		if len(qis) == 0 {
 			span.End()
+			q.budget.spend(time.Since(start))
 			continue
 		}
 
@@ -865,6 +962,7 @@ func (q *Queuer) loopQueue(ctx context.Context) {
 			q.l.Error().Err(err).Msg("error getting rate limits")
 
 			q.unackedToUnassigned(qis)
+			q.budget.spend(time.Since(start))
 			continue
 		}
 
@@ -883,6 +981,7 @@ func (q *Queuer) loopQueue(ctx context.Context) {
 			q.l.Error().Err(err).Msg("error getting desired labels")
 
 			q.unackedToUnassigned(qis)
+			q.budget.spend(time.Since(start))
 			continue
 		}
 
@@ -909,6 +1008,12 @@ func (q *Queuer) loopQueue(ctx context.Context) {
 
 				numFlushed := q.flushToDatabase(ctx, ar)
 
+				if numFlushed > 0 {
+					// the duration is observed once per batch of assignments, so large batches don't skew the histogram
+					metrics.AssignmentDuration.WithLabelValues(sqlchelpers.UUIDToStr(q.tenantId)).Observe(time.Since(start).Seconds())
+					metrics.StepRunTransitions.WithLabelValues(string(dbsqlc.StepRunStatusASSIGNED)).Add(float64(numFlushed))
+				}
+
 				countMu.Lock()
 				count += numFlushed
 				processedQiLength += len(ar.assigned) + len(ar.unassigned) + len(ar.schedulingTimedOut) + len(ar.rateLimited)
@@ -923,6 +1028,8 @@ func (q *Queuer) loopQueue(ctx context.Context) {
 		assignTime := time.Since(checkpoint)
 		elapsed := time.Since(start)
 
+		q.budget.spend(elapsed)
+
 		if elapsed > 100*time.Millisecond {
 			q.l.Warn().Dur(
 				"refill_time", refillTime,
@@ -999,11 +1106,21 @@ func (q *Queuer) refillQueue(ctx context.Context) ([]*dbsqlc.QueueItem, error) {
 		if err != nil {
 			return nil, err
 		}
+
+		if q.dryRunEmitted != nil {
+			q.dryRunEmitted.prune(curr)
+		}
 	}
 
 	newCurr := make([]*dbsqlc.QueueItem, 0, len(curr))
 
 	for _, qi := range curr {
+		// drop queue items for cancelled step runs which were read before they were dequeued
+		if q.tombstones.hasQueueItem(qi) {
+			delete(q.unassigned, qi.ID)
+			continue
+		}
+
 		if _, ok := q.unacked[qi.ID]; !ok {
 			newCurr = append(newCurr, qi)
 		}
@@ -1068,6 +1185,12 @@ func (q *Queuer) unackedToUnassigned(items []*dbsqlc.QueueItem) {
 }
 
 func (q *Queuer) flushToDatabase(ctx context.Context, r *assignResults) int {
+	r.assigned = q.releaseTombstoned(r.assigned)
+
+	if q.dryRunSink != nil {
+		return q.flushDryRun(ctx, r)
+	}
+
 	// no matter what, we always ack the items in the queuer
 	defer q.ack(r)
 
@@ -1083,7 +1206,11 @@ func (q *Queuer) flushToDatabase(ctx context.Context, r *assignResults) int {
 	succeeded, failed, err := q.repo.MarkQueueItemsProcessed(ctx, r)
 
 	if err != nil {
-		q.l.Error().Err(err).Msg("error marking queue items processed")
+		if errors.Is(err, repository.ErrLeaseLost) {
+			q.l.Warn().Str("queue", q.shard.resourceId()).Msg("queue lease was lost, releasing assignments")
+		} else {
+			q.l.Error().Err(err).Msg("error marking queue items processed")
+		}
 
 		nackIds := make([]int, 0, len(r.assigned))
 
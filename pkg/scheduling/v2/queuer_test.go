@@ -0,0 +1,245 @@
+//go:build integration
+
+package v2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hatchet-dev/hatchet/internal/testutils"
+	"github.com/hatchet-dev/hatchet/pkg/config/database"
+	"github.com/hatchet-dev/hatchet/pkg/random"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// the action of the test workflows, which is also the name of their queue
+const testAction = "test:step"
+
+func TestFairShareDoesNotStarveWorkflows(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		tenantId := createTestTenant(t, conf)
+
+		backlog := createTestWorkflow(t, conf, tenantId, "backlog")
+		other := createTestWorkflow(t, conf, tenantId, "other")
+
+		b := sqlchelpers.UUIDToStr(backlog.WorkflowVersion.WorkflowId)
+		o := sqlchelpers.UUIDToStr(other.WorkflowVersion.WorkflowId)
+
+		stepRunIdsToWorkflowIds := make(map[string]string)
+
+		// the workflow with the backlog queues all of its step runs before the other workflow
+		for i := 0; i < 50; i++ {
+			stepRunIdsToWorkflowIds[queueTestStepRun(t, conf, tenantId, backlog)] = b
+		}
+
+		for i := 0; i < 2; i++ {
+			stepRunIdsToWorkflowIds[queueTestStepRun(t, conf, tenantId, other)] = o
+		}
+
+		tests := []struct {
+			name     string
+			weights  map[string]int32
+			expected []string
+		}{
+			{
+				name:     "workflows take turns",
+				expected: []string{b, o, b, o, b, b, b, b, b, b},
+			},
+			{
+				name:     "weighted workflows take more items per turn",
+				weights:  map[string]int32{b: 3},
+				expected: []string{b, b, b, o, b, b, b, o, b, b},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				rows, err := conf.SchedulerRepository.Queue().ListQueueItemsFairShare(context.Background(), tenantId, &repository.ListQueueItemsOpts{
+					Queue: testAction,
+					Limit: 10,
+				}, tt.weights)
+
+				require.NoError(t, err)
+
+				workflowIds := make([]string, 0, len(rows))
+				seen := make(map[int64]bool, len(rows))
+
+				for _, row := range rows {
+					workflowIds = append(workflowIds, stepRunIdsToWorkflowIds[sqlchelpers.UUIDToStr(row.QueueItem.StepRunId)])
+
+					assert.False(t, seen[row.QueueItem.ID], "queue item %d is listed twice", row.QueueItem.ID)
+					seen[row.QueueItem.ID] = true
+				}
+
+				assert.Equal(t, tt.expected, workflowIds)
+			})
+		}
+
+		return nil
+	})
+}
+
+func TestEDFOrdersByDeadline(t *testing.T) {
+	testutils.RunTestWithDatabase(t, func(conf *database.Config) error {
+		tenantId := createTestTenant(t, conf)
+		version := createTestWorkflow(t, conf, tenantId, "edf")
+
+		now := time.Now().UTC()
+		inOneMinute := now.Add(time.Minute)
+		inTwoMinutes := now.Add(2 * time.Minute)
+		inThreeMinutes := now.Add(3 * time.Minute)
+
+		// the queue items in insertion order, named by their expected position
+		items := []struct {
+			name     string
+			priority int32
+			deadline *time.Time
+		}{
+			{name: "no deadline, low priority", priority: 1},
+			{name: "latest deadline", priority: 1, deadline: &inThreeMinutes},
+			{name: "no deadline, high priority", priority: 4},
+			{name: "earliest deadline, low priority", priority: 1, deadline: &inOneMinute},
+			{name: "earliest deadline, high priority", priority: 3, deadline: &inOneMinute},
+			{name: "middle deadline", priority: 1, deadline: &inTwoMinutes},
+		}
+
+		stepRunIdsToNames := make(map[string]string, len(items))
+
+		for _, item := range items {
+			stepRunIdsToNames[queueTestStepRunWithDeadline(t, conf, tenantId, version, item.priority, item.deadline)] = item.name
+		}
+
+		rows, err := conf.SchedulerRepository.Queue().ListQueueItemsEDF(context.Background(), tenantId, &repository.ListQueueItemsOpts{
+			Queue: testAction,
+			Limit: 10,
+		})
+
+		require.NoError(t, err)
+
+		names := make([]string, 0, len(rows))
+
+		for _, row := range rows {
+			names = append(names, stepRunIdsToNames[sqlchelpers.UUIDToStr(row.QueueItem.StepRunId)])
+		}
+
+		// queue items are ordered by deadline, then priority, and the queue items without a deadline come last
+		assert.Equal(t, []string{
+			"earliest deadline, high priority",
+			"earliest deadline, low priority",
+			"middle deadline",
+			"latest deadline",
+			"no deadline, high priority",
+			"no deadline, low priority",
+		}, names)
+
+		// the limit applies after ordering, so the earliest deadlines are listed first
+		rows, err = conf.SchedulerRepository.Queue().ListQueueItemsEDF(context.Background(), tenantId, &repository.ListQueueItemsOpts{
+			Queue: testAction,
+			Limit: 2,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		assert.Equal(t, "earliest deadline, high priority", stepRunIdsToNames[sqlchelpers.UUIDToStr(rows[0].QueueItem.StepRunId)])
+		assert.Equal(t, "earliest deadline, low priority", stepRunIdsToNames[sqlchelpers.UUIDToStr(rows[1].QueueItem.StepRunId)])
+
+		return nil
+	})
+}
+
+func createTestTenant(t *testing.T, conf *database.Config) string {
+	t.Helper()
+
+	slugSuffix, err := random.Generate(8)
+	require.NoError(t, err)
+
+	tenant, err := conf.APIRepository.Tenant().CreateTenant(&repository.CreateTenantOpts{
+		Name: "test-tenant",
+		Slug: fmt.Sprintf("test-tenant-%s", slugSuffix),
+	})
+
+	require.NoError(t, err)
+
+	return sqlchelpers.UUIDToStr(tenant.ID)
+}
+
+// createTestWorkflow creates a workflow with a single step, whose step runs are queued in the testAction queue.
+func createTestWorkflow(t *testing.T, conf *database.Config, tenantId, name string) *dbsqlc.GetWorkflowVersionForEngineRow {
+	t.Helper()
+
+	version, err := conf.EngineRepository.Workflow().CreateNewWorkflow(context.Background(), tenantId, &repository.CreateWorkflowVersionOpts{
+		Name: name,
+		Jobs: []repository.CreateWorkflowJobOpts{
+			{
+				Name: "job",
+				Kind: "DEFAULT",
+				Steps: []repository.CreateWorkflowStepOpts{
+					{
+						ReadableId: "step",
+						Action:     testAction,
+					},
+				},
+			},
+		},
+	})
+
+	require.NoError(t, err)
+
+	return version
+}
+
+// queueTestStepRun creates a run of the workflow version and a queue item for its step run, and returns the
+// step run id.
+func queueTestStepRun(t *testing.T, conf *database.Config, tenantId string, version *dbsqlc.GetWorkflowVersionForEngineRow) string {
+	t.Helper()
+
+	return queueTestStepRunWithDeadline(t, conf, tenantId, version, 1, nil)
+}
+
+// queueTestStepRunWithDeadline is queueTestStepRun with the priority and the (optional) deadline of the queue item.
+func queueTestStepRunWithDeadline(t *testing.T, conf *database.Config, tenantId string, version *dbsqlc.GetWorkflowVersionForEngineRow, priority int32, deadline *time.Time) string {
+	t.Helper()
+
+	ctx := context.Background()
+
+	opts, err := repository.GetCreateWorkflowRunOptsFromManual(version, []byte(`{}`), nil)
+	require.NoError(t, err)
+
+	run, err := conf.EngineRepository.WorkflowRun().CreateNewWorkflowRun(ctx, tenantId, opts)
+	require.NoError(t, err)
+
+	var stepRunId, stepId pgtype.UUID
+
+	err = conf.Pool.QueryRow(
+		ctx,
+		`SELECT sr."id", sr."stepId" FROM "StepRun" sr JOIN "JobRun" jr ON jr."id" = sr."jobRunId" WHERE jr."workflowRunId" = $1`,
+		run.ID,
+	).Scan(&stepRunId, &stepId)
+
+	require.NoError(t, err)
+
+	// queue items are written directly, as the step run engine repository buffers its writes to the queue
+	_, err = conf.Pool.Exec(
+		ctx,
+		`INSERT INTO "QueueItem" ("stepRunId", "stepId", "actionId", "priority", "isQueued", "tenantId", "queue", "deadline")
+		VALUES ($1, $2, $3, $4, true, $5, $3, $6)`,
+		stepRunId,
+		stepId,
+		testAction,
+		priority,
+		sqlchelpers.UUIDFromStr(tenantId),
+		deadline,
+	)
+
+	require.NoError(t, err)
+
+	return sqlchelpers.UUIDToStr(stepRunId)
+}
@@ -2,99 +2,84 @@ package v2
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 
-	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 )
 
+// globalRateLimitPrefix namespaces the keys of global rate limits, which are shared by all tenants, so they
+// can be tracked by each tenant's rate limiter alongside the tenant's own rate limits.
+const globalRateLimitPrefix = "hatchet__global:"
+
+func globalRateLimitKey(key string) string {
+	return globalRateLimitPrefix + key
+}
+
+// WithDynamicRateLimitDefaults sets the limit for dynamic rate limit keys which don't declare a limit
+// expression, and how long dynamic rate limit keys are kept after they were last used. Expired keys are
+// deleted by the retention controller. A ttl of 0 keeps dynamic keys forever.
+func WithDynamicRateLimitDefaults(limit int, ttl time.Duration) SchedulingPoolOpt {
+	return func(cf *sharedConfig) {
+		cf.dynamicRateLimitDefault = limit
+		cf.dynamicRateLimitTTL = ttl
+	}
+}
+
 type rateLimitRepo interface {
 	ListCandidateRateLimits(ctx context.Context, tenantId pgtype.UUID) ([]string, error)
 	UpdateRateLimits(ctx context.Context, tenantId pgtype.UUID, updates map[string]int) (map[string]int, error)
 }
 
 type rateLimitDbQueries struct {
-	queries *dbsqlc.Queries
-	pool    *pgxpool.Pool
-
-	l *zerolog.Logger
+	repo repository.SchedulerRateLimitRepository
 }
 
-func newRateLimitDbQueries(queries *dbsqlc.Queries, pool *pgxpool.Pool, l *zerolog.Logger) *rateLimitDbQueries {
+func newRateLimitDbQueries(repo repository.SchedulerRateLimitRepository) *rateLimitDbQueries {
 	return &rateLimitDbQueries{
-		queries: queries,
-		pool:    pool,
-		l:       l,
+		repo: repo,
 	}
 }
 
 func (d *rateLimitDbQueries) ListCandidateRateLimits(ctx context.Context, tenantId pgtype.UUID) ([]string, error) {
-	rls, err := d.queries.ListRateLimitsForTenantNoMutate(ctx, d.pool, dbsqlc.ListRateLimitsForTenantNoMutateParams{
-		Tenantid: tenantId,
-		Limit:    10000,
-	})
-
-	if err != nil {
-		return nil, err
-	}
-
-	ids := make([]string, len(rls))
-
-	for i, rl := range rls {
-		ids[i] = rl.Key
-	}
-
-	return ids, nil
+	return d.repo.ListCandidateRateLimits(ctx, sqlchelpers.UUIDToStr(tenantId))
 }
 
 func (d *rateLimitDbQueries) UpdateRateLimits(ctx context.Context, tenantId pgtype.UUID, updates map[string]int) (map[string]int, error) {
-	tx, commit, rollback, err := sqlchelpers.PrepareTx(ctx, d.pool, d.l, 5000)
-
-	if err != nil {
-		return nil, err
-	}
-
-	defer rollback()
-
-	params := dbsqlc.BulkUpdateRateLimitsParams{
-		Tenantid: tenantId,
-		Keys:     make([]string, 0, len(updates)),
-		Units:    make([]int32, 0, len(updates)),
-	}
+	tenantUpdates := make(map[string]int, len(updates))
+	globalUpdates := make(map[string]int)
 
 	for k, v := range updates {
-		params.Keys = append(params.Keys, k)
-		params.Units = append(params.Units, int32(v)) // nolint: gosec
-	}
-
-	_, err = d.queries.BulkUpdateRateLimits(ctx, tx, params)
+		if globalKey, ok := strings.CutPrefix(k, globalRateLimitPrefix); ok {
+			globalUpdates[globalKey] = v
+			continue
+		}
 
-	if err != nil {
-		return nil, err
+		tenantUpdates[k] = v
 	}
 
-	newRls, err := d.queries.ListRateLimitsForTenantWithMutate(ctx, tx, tenantId)
+	rls, globalRls, err := d.repo.UpdateRateLimits(ctx, sqlchelpers.UUIDToStr(tenantId), tenantUpdates, globalUpdates)
 
 	if err != nil {
 		return nil, err
 	}
 
-	if err := commit(ctx); err != nil {
-		return nil, err
-	}
+	res := make(map[string]int, len(rls)+len(globalRls))
 
-	res := make(map[string]int, len(newRls))
+	for k, v := range rls {
+		res[k] = v
+	}
 
-	for _, rl := range newRls {
-		res[rl.Key] = int(rl.Value)
+	for k, v := range globalRls {
+		res[globalRateLimitKey(k)] = v
 	}
 
-	return res, err
+	return res, nil
 }
 
 type rateLimit struct {
@@ -125,7 +110,7 @@ type rateLimiter struct {
 }
 
 func newRateLimiter(conf *sharedConfig, tenantId pgtype.UUID) *rateLimiter {
-	repo := newRateLimitDbQueries(conf.queries, conf.pool, conf.l)
+	repo := newRateLimitDbQueries(conf.repo.RateLimit())
 
 	rl := &rateLimiter{
 		rateLimitRepo: repo,
@@ -196,6 +181,11 @@ func (r *rateLimiter) use(ctx context.Context, stepRunId string, rls map[string]
 		}
 	}
 
+	// hold the unacked lock while checking and consuming the rate limits, so that concurrent callers
+	// cannot consume the same units
+	r.unackedMu.Lock()
+	defer r.unackedMu.Unlock()
+
 	currRls := r.copyDbRateLimits()
 
 	// we need to subtract any relevant unacked and unflushed rate limits for updates
@@ -218,6 +208,7 @@ func (r *rateLimiter) use(ctx context.Context, stepRunId string, rls map[string]
 
 	return rateLimitResult{
 		succeeded: true,
+		stepRunId: stepRunId,
 		ack: func() {
 			r.ack(stepRunId)
 		},
@@ -256,10 +247,9 @@ func (r *rateLimiter) copyDbRateLimits() rateLimitSet {
 	return rls
 }
 
+// subtractUnacked subtracts the unacked rate limits from the current rate limits. The caller must hold
+// the unacked lock.
 func (r *rateLimiter) subtractUnacked(candidateRls map[string]int32, currRls rateLimitSet) {
-	r.unackedMu.RLock()
-	defer r.unackedMu.RUnlock()
-
 	for _, set := range r.unacked {
 		for k, v := range set {
 			if _, ok := candidateRls[k]; ok {
@@ -285,10 +275,9 @@ func (r *rateLimiter) subtractUnflushed(candidateRls map[string]int32, currRls r
 	}
 }
 
+// addToUnacked adds the rate limits for a step run to the unacked set. The caller must hold the unacked
+// lock.
 func (r *rateLimiter) addToUnacked(stepRunId string, rls map[string]int32) {
-	r.unackedMu.Lock()
-	defer r.unackedMu.Unlock()
-
 	for k, v := range rls {
 		if _, ok := r.unacked[stepRunId]; !ok {
 			r.unacked[stepRunId] = make(rateLimitSet)
@@ -333,6 +322,24 @@ func (r *rateLimiter) nack(stepRunId string) {
 	delete(r.unacked, stepRunId)
 }
 
+// returnUnits returns units which were consumed by a step run that could not be dispatched. The units
+// are added back on the next flush.
+func (r *rateLimiter) returnUnits(rls map[string]int32) {
+	r.unflushedMu.Lock()
+	defer r.unflushedMu.Unlock()
+
+	for k, v := range rls {
+		if _, ok := r.unflushed[k]; !ok {
+			r.unflushed[k] = &rateLimit{
+				key: k,
+				val: 0,
+			}
+		}
+
+		r.unflushed[k].val -= int(v)
+	}
+}
+
 // flushToDatabase involves writing the rate limits and reading new rate limits from the
 // database
 func (r *rateLimiter) flushToDatabase(ctx context.Context) error {
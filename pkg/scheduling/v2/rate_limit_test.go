@@ -149,6 +149,78 @@ func TestRateLimiter_Concurrency(t *testing.T) {
 	assert.Equal(t, numUsers*useAmount, rateLimiter.unflushed["key1"].val)
 }
 
+func TestRateLimiter_ConcurrencyNoOversubscription(t *testing.T) {
+	l := zerolog.Nop()
+
+	mockRateLimitRepo := &mockRateLimitRepo{}
+
+	rateLimiter := &rateLimiter{
+		dbRateLimits: rateLimitSet{
+			"key1": {key: "key1", val: 10},
+		},
+		unacked:       make(map[string]rateLimitSet),
+		unflushed:     make(rateLimitSet),
+		l:             &l,
+		rateLimitRepo: mockRateLimitRepo,
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	succeeded := 0
+
+	wg.Add(100)
+	for i := 0; i < 100; i++ {
+		go func(stepRunId string) {
+			defer wg.Done()
+
+			res := rateLimiter.use(context.Background(), stepRunId, map[string]int32{"key1": 1})
+
+			if res.succeeded {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}(
+			"step" + strconv.Itoa(i),
+		)
+	}
+
+	wg.Wait()
+
+	// only the available units can be consumed, even when the step runs are not acked yet
+	assert.Equal(t, 10, succeeded)
+}
+
+func TestRateLimiter_ReturnUnits(t *testing.T) {
+	l := zerolog.Nop()
+
+	mockRateLimitRepo := &mockRateLimitRepo{}
+
+	rateLimiter := &rateLimiter{
+		dbRateLimits: rateLimitSet{
+			"key1": {key: "key1", val: 10},
+		},
+		unacked:       make(map[string]rateLimitSet),
+		unflushed:     make(rateLimitSet),
+		l:             &l,
+		rateLimitRepo: mockRateLimitRepo,
+	}
+
+	res := rateLimiter.use(context.Background(), "step1", map[string]int32{"key1": 10})
+	assert.True(t, res.succeeded)
+	rateLimiter.ack("step1")
+
+	res = rateLimiter.use(context.Background(), "step2", map[string]int32{"key1": 5})
+	assert.False(t, res.succeeded)
+
+	// step1 failed to dispatch, so its units are returned
+	rateLimiter.returnUnits(map[string]int32{"key1": 10})
+
+	res = rateLimiter.use(context.Background(), "step2", map[string]int32{"key1": 5})
+	assert.True(t, res.succeeded)
+	assert.Equal(t, 0, rateLimiter.unflushed["key1"].val)
+}
+
 func TestRateLimiter_FlushToDatabase(t *testing.T) {
 	l := zerolog.Nop()
 
@@ -221,3 +293,31 @@ func BenchmarkRateLimiter(b *testing.B) {
 		}
 	})
 }
+
+func TestRateLimiter_GlobalKeys(t *testing.T) {
+	l := zerolog.Nop()
+
+	mockRateLimitRepo := &mockRateLimitRepo{}
+
+	// a tenant rate limit and a global rate limit can share a key without sharing units
+	rateLimiter := &rateLimiter{
+		dbRateLimits: rateLimitSet{
+			"key1":                     {key: "key1", val: 10},
+			globalRateLimitKey("key1"): {key: globalRateLimitKey("key1"), val: 3},
+		},
+		unacked:       make(map[string]rateLimitSet),
+		unflushed:     make(rateLimitSet),
+		l:             &l,
+		rateLimitRepo: mockRateLimitRepo,
+	}
+
+	res := rateLimiter.use(context.Background(), "step1", map[string]int32{"key1": 5, globalRateLimitKey("key1"): 3})
+	assert.True(t, res.succeeded)
+
+	res = rateLimiter.use(context.Background(), "step2", map[string]int32{"key1": 5})
+	assert.True(t, res.succeeded)
+
+	res = rateLimiter.use(context.Background(), "step3", map[string]int32{globalRateLimitKey("key1"): 1})
+	assert.False(t, res.succeeded)
+	assert.Equal(t, globalRateLimitKey("key1"), res.exceededKey)
+}
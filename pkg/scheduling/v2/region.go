@@ -0,0 +1,41 @@
+package v2
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// RegionLabelKey is the reserved desired label key for a workflow's region. Region constraints are evaluated
+// alongside the step's desired labels, against the region the worker registered with rather than its labels.
+const RegionLabelKey = "hatchet.region"
+
+// regionPreferenceWeight is the weight of a worker in the preferred region. It is higher than any realistic
+// sum of label weights, so that workers in another region are only used when the preferred region has no
+// available slots.
+const regionPreferenceWeight = 1 << 20
+
+// toRegionLabel converts a workflow's region into a desired label. Pinned regions are required, while
+// preferred regions are weighted.
+func toRegionLabel(row *dbsqlc.GetDesiredRegionsRow) *dbsqlc.GetDesiredLabelsRow {
+	return &dbsqlc.GetDesiredLabelsRow{
+		Key:        RegionLabelKey,
+		StrValue:   row.Region,
+		Required:   row.RegionStrategy.Valid && row.RegionStrategy.RegionStrategy == dbsqlc.RegionStrategyPIN,
+		Weight:     regionPreferenceWeight,
+		Comparator: dbsqlc.WorkerLabelComparatorEQUAL,
+		StepId:     row.StepId,
+	}
+}
+
+// computeRegionWeight computes the weight of a worker for a region label. If the worker is not in a
+// required region, the weight is -1.
+func (w *worker) computeRegionWeight(desiredLabel *dbsqlc.GetDesiredLabelsRow) int {
+	if w.Region != "" && desiredLabel.StrValue.Valid && w.Region == desiredLabel.StrValue.String {
+		return int(desiredLabel.Weight)
+	}
+
+	if desiredLabel.Required {
+		return -1
+	}
+
+	return 0
+}
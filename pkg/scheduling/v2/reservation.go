@@ -0,0 +1,155 @@
+package v2
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func (d *schedulerDbQueries) ListActiveSlotReservations(ctx context.Context) ([]*dbsqlc.ListActiveSlotReservationsRow, error) {
+	ctx, span := telemetry.NewSpan(ctx, "list-active-slot-reservations")
+	defer span.End()
+
+	return d.repo.ListActiveSlotReservations(ctx, sqlchelpers.UUIDToStr(d.tenantId))
+}
+
+func (d *schedulerDbQueries) ListQueuedStepRunsForSlotReservations(ctx context.Context) ([]*dbsqlc.ListQueuedStepRunsForSlotReservationsRow, error) {
+	ctx, span := telemetry.NewSpan(ctx, "list-queued-step-runs-for-slot-reservations")
+	defer span.End()
+
+	return d.repo.ListQueuedStepRunsForSlotReservations(ctx, sqlchelpers.UUIDToStr(d.tenantId))
+}
+
+// slotReservations tracks slots which are held for workflow runs that reserved slots up front. A
+// reservation holds the reserved slots which are not already occupied by a step run in the workflow
+// run, so that the workflow run does not get starved halfway through a DAG.
+type slotReservations struct {
+	mu sync.RWMutex
+
+	// held is the total number of slots held across all reservations
+	held int
+
+	// stepRunIdsToWorkflowRunIds contains the queued step runs which belong to a reserved workflow run
+	stepRunIdsToWorkflowRunIds map[string]string
+}
+
+func newSlotReservations() *slotReservations {
+	return &slotReservations{
+		stepRunIdsToWorkflowRunIds: make(map[string]string),
+	}
+}
+
+func (r *slotReservations) set(reservations []*dbsqlc.ListActiveSlotReservationsRow, queued []*dbsqlc.ListQueuedStepRunsForSlotReservationsRow) {
+	held := 0
+
+	for _, reservation := range reservations {
+		if remaining := int(reservation.Slots - reservation.UsedSlots); remaining > 0 {
+			held += remaining
+		}
+	}
+
+	stepRunIdsToWorkflowRunIds := make(map[string]string, len(queued))
+
+	for _, row := range queued {
+		stepRunIdsToWorkflowRunIds[sqlchelpers.UUIDToStr(row.StepRunId)] = sqlchelpers.UUIDToStr(row.WorkflowRunId)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.held = held
+	r.stepRunIdsToWorkflowRunIds = stepRunIdsToWorkflowRunIds
+}
+
+// isReserved returns true if the queue item belongs to a workflow run with a reservation.
+func (r *slotReservations) isReserved(qi *dbsqlc.QueueItem) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.stepRunIdsToWorkflowRunIds[sqlchelpers.UUIDToStr(qi.StepRunId)]
+
+	return ok
+}
+
+func (r *slotReservations) getHeld() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.held
+}
+
+func (s *Scheduler) refreshReservations(ctx context.Context) error {
+	reservations, err := s.repo.ListActiveSlotReservations(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	var queued []*dbsqlc.ListQueuedStepRunsForSlotReservationsRow
+
+	if len(reservations) > 0 {
+		queued, err = s.repo.ListQueuedStepRunsForSlotReservations(ctx)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	s.reservations.set(reservations, queued)
+
+	return nil
+}
+
+func (s *Scheduler) loopReservations(ctx context.Context) {
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := s.refreshReservations(ctx)
+
+			if err != nil {
+				s.l.Error().Err(err).Msg("error refreshing slot reservations")
+			}
+		}
+	}
+}
+
+// applyReservations marks queue items which do not belong to a reserved workflow run as having no slots
+// once the unreserved capacity of the action has been used up. The caller must hold the action lock.
+func (s *Scheduler) applyReservations(qis []*dbsqlc.QueueItem, res []*assignSingleResult, candidateSlots []*slot, rlNacks []func()) {
+	held := s.reservations.getHeld()
+
+	if held == 0 {
+		return
+	}
+
+	unreserved := -held
+
+	for _, slot := range candidateSlots {
+		if slot.active() {
+			unreserved++
+		}
+	}
+
+	for i, qi := range qis {
+		if res[i].rateLimitResult != nil || s.reservations.isReserved(qi) {
+			continue
+		}
+
+		if unreserved > 0 {
+			unreserved--
+			continue
+		}
+
+		res[i].noSlots = true
+		rlNacks[i]()
+	}
+}
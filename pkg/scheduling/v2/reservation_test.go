@@ -0,0 +1,184 @@
+package v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+type mockSchedulerRepo struct {
+	mock.Mock
+}
+
+func (m *mockSchedulerRepo) ListActionsForWorkers(ctx context.Context, workerIds []pgtype.UUID) ([]*dbsqlc.ListActionsForWorkersRow, error) {
+	args := m.Called(ctx, workerIds)
+	return args.Get(0).([]*dbsqlc.ListActionsForWorkersRow), args.Error(1)
+}
+
+func (m *mockSchedulerRepo) ListAvailableSlotsForWorkers(ctx context.Context, workerIds []pgtype.UUID) ([]*dbsqlc.ListAvailableSlotsForWorkersRow, error) {
+	args := m.Called(ctx, workerIds)
+	return args.Get(0).([]*dbsqlc.ListAvailableSlotsForWorkersRow), args.Error(1)
+}
+
+func (m *mockSchedulerRepo) ListActiveSlotReservations(ctx context.Context) ([]*dbsqlc.ListActiveSlotReservationsRow, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*dbsqlc.ListActiveSlotReservationsRow), args.Error(1)
+}
+
+func (m *mockSchedulerRepo) ListQueuedStepRunsForSlotReservations(ctx context.Context) ([]*dbsqlc.ListQueuedStepRunsForSlotReservationsRow, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*dbsqlc.ListQueuedStepRunsForSlotReservationsRow), args.Error(1)
+}
+
+func newTestSlots(n int) []*slot {
+	slots := make([]*slot, n)
+
+	for i := range slots {
+		slots[i] = newSlot(&worker{ListActiveWorkersResult: &ListActiveWorkersResult{ID: sqlchelpers.UUIDFromStr(uuid.New().String())}}, []string{})
+	}
+
+	return slots
+}
+
+func newTestQueueItems(n int) []*dbsqlc.QueueItem {
+	qis := make([]*dbsqlc.QueueItem, n)
+
+	for i := range qis {
+		qis[i] = &dbsqlc.QueueItem{
+			ID:        int64(i + 1),
+			StepRunId: sqlchelpers.UUIDFromStr(uuid.New().String()),
+		}
+	}
+
+	return qis
+}
+
+// applyTestReservations applies the reservations of the scheduler to the queue items, and returns which of them
+// were marked as having no slots and which had their rate limits returned
+func applyTestReservations(s *Scheduler, qis []*dbsqlc.QueueItem, slots []*slot) ([]bool, []bool) {
+	res := make([]*assignSingleResult, len(qis))
+	nacked := make([]bool, len(qis))
+	rlNacks := make([]func(), len(qis))
+
+	for i := range qis {
+		res[i] = &assignSingleResult{qi: qis[i]}
+		rlNacks[i] = func() { nacked[i] = true }
+	}
+
+	s.applyReservations(qis, res, slots, rlNacks)
+
+	noSlots := make([]bool, len(qis))
+
+	for i := range res {
+		noSlots[i] = res[i].noSlots
+	}
+
+	return noSlots, nacked
+}
+
+func TestSlotReservations_Held(t *testing.T) {
+	workflowRunId := sqlchelpers.UUIDFromStr(uuid.New().String())
+
+	// the first three queue items don't belong to a reserved workflow run, the last one does
+	qis := newTestQueueItems(4)
+
+	repo := &mockSchedulerRepo{}
+	repo.On("ListActiveSlotReservations", mock.Anything).Return([]*dbsqlc.ListActiveSlotReservationsRow{
+		{WorkflowRunId: workflowRunId, Slots: 3, UsedSlots: 1},
+	}, nil)
+	repo.On("ListQueuedStepRunsForSlotReservations", mock.Anything).Return([]*dbsqlc.ListQueuedStepRunsForSlotReservationsRow{
+		{StepRunId: qis[3].StepRunId, WorkflowRunId: workflowRunId},
+	}, nil)
+
+	s := &Scheduler{
+		repo:         repo,
+		reservations: newSlotReservations(),
+	}
+
+	assert.NoError(t, s.refreshReservations(context.Background()))
+
+	// one of the three reserved slots is already used by a step run of the workflow run
+	assert.Equal(t, 2, s.reservations.getHeld())
+	assert.True(t, s.reservations.isReserved(qis[3]))
+	assert.False(t, s.reservations.isReserved(qis[0]))
+
+	// of three slots two are held, so only the first of the unreserved queue items can be assigned, while the queue
+	// item of the reserved workflow run can use a held slot
+	noSlots, nacked := applyTestReservations(s, qis, newTestSlots(3))
+
+	assert.Equal(t, []bool{false, true, true, false}, noSlots)
+	assert.Equal(t, noSlots, nacked)
+
+	repo.AssertExpectations(t)
+}
+
+func TestSlotReservations_UsedSlotsAreNotHeld(t *testing.T) {
+	workflowRunId := sqlchelpers.UUIDFromStr(uuid.New().String())
+
+	repo := &mockSchedulerRepo{}
+	repo.On("ListActiveSlotReservations", mock.Anything).Return([]*dbsqlc.ListActiveSlotReservationsRow{
+		{WorkflowRunId: workflowRunId, Slots: 2, UsedSlots: 2},
+	}, nil)
+	repo.On("ListQueuedStepRunsForSlotReservations", mock.Anything).Return([]*dbsqlc.ListQueuedStepRunsForSlotReservationsRow{}, nil)
+
+	s := &Scheduler{
+		repo:         repo,
+		reservations: newSlotReservations(),
+	}
+
+	assert.NoError(t, s.refreshReservations(context.Background()))
+	assert.Equal(t, 0, s.reservations.getHeld())
+
+	noSlots, _ := applyTestReservations(s, newTestQueueItems(2), newTestSlots(2))
+
+	assert.Equal(t, []bool{false, false}, noSlots)
+}
+
+func TestSlotReservations_Released(t *testing.T) {
+	workflowRunId := sqlchelpers.UUIDFromStr(uuid.New().String())
+
+	qis := newTestQueueItems(3)
+
+	repo := &mockSchedulerRepo{}
+	repo.On("ListActiveSlotReservations", mock.Anything).Return([]*dbsqlc.ListActiveSlotReservationsRow{
+		{WorkflowRunId: workflowRunId, Slots: 2},
+	}, nil).Once()
+	repo.On("ListQueuedStepRunsForSlotReservations", mock.Anything).Return([]*dbsqlc.ListQueuedStepRunsForSlotReservationsRow{
+		{StepRunId: qis[2].StepRunId, WorkflowRunId: workflowRunId},
+	}, nil).Once()
+
+	s := &Scheduler{
+		repo:         repo,
+		reservations: newSlotReservations(),
+	}
+
+	assert.NoError(t, s.refreshReservations(context.Background()))
+	assert.Equal(t, 2, s.reservations.getHeld())
+
+	// both slots are held for the reserved workflow run
+	noSlots, _ := applyTestReservations(s, qis[:2], newTestSlots(2))
+
+	assert.Equal(t, []bool{true, true}, noSlots)
+
+	// once the workflow run finishes or the reservation expires, the reservation is no longer active and its slots
+	// are released
+	repo.On("ListActiveSlotReservations", mock.Anything).Return([]*dbsqlc.ListActiveSlotReservationsRow{}, nil).Once()
+
+	assert.NoError(t, s.refreshReservations(context.Background()))
+	assert.Equal(t, 0, s.reservations.getHeld())
+	assert.False(t, s.reservations.isReserved(qis[2]))
+
+	noSlots, _ = applyTestReservations(s, qis[:2], newTestSlots(2))
+
+	assert.Equal(t, []bool{false, false}, noSlots)
+
+	// the queued step runs aren't listed when there are no reservations
+	repo.AssertNumberOfCalls(t, "ListQueuedStepRunsForSlotReservations", 1)
+}
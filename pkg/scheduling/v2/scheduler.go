@@ -7,31 +7,31 @@ import (
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 
 	"github.com/hatchet-dev/hatchet/internal/queueutils"
 	"github.com/hatchet-dev/hatchet/internal/telemetry"
+	"github.com/hatchet-dev/hatchet/pkg/repository"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 )
 
 type schedulerRepo interface {
 	ListActionsForWorkers(ctx context.Context, workerIds []pgtype.UUID) ([]*dbsqlc.ListActionsForWorkersRow, error)
-	ListAvailableSlotsForWorkers(ctx context.Context, params dbsqlc.ListAvailableSlotsForWorkersParams) ([]*dbsqlc.ListAvailableSlotsForWorkersRow, error)
+	ListAvailableSlotsForWorkers(ctx context.Context, workerIds []pgtype.UUID) ([]*dbsqlc.ListAvailableSlotsForWorkersRow, error)
+	ListActiveSlotReservations(ctx context.Context) ([]*dbsqlc.ListActiveSlotReservationsRow, error)
+	ListQueuedStepRunsForSlotReservations(ctx context.Context) ([]*dbsqlc.ListQueuedStepRunsForSlotReservationsRow, error)
 }
 
 type schedulerDbQueries struct {
-	queries *dbsqlc.Queries
-	pool    *pgxpool.Pool
+	repo repository.SchedulerAssignmentRepository
 
 	tenantId pgtype.UUID
 }
 
-func newSchedulerDbQueries(queries *dbsqlc.Queries, pool *pgxpool.Pool, tenantId pgtype.UUID) *schedulerDbQueries {
+func newSchedulerDbQueries(repo repository.SchedulerAssignmentRepository, tenantId pgtype.UUID) *schedulerDbQueries {
 	return &schedulerDbQueries{
-		queries:  queries,
-		pool:     pool,
+		repo:     repo,
 		tenantId: tenantId,
 	}
 }
@@ -40,17 +40,14 @@ func (d *schedulerDbQueries) ListActionsForWorkers(ctx context.Context, workerId
 	ctx, span := telemetry.NewSpan(ctx, "list-actions-for-workers")
 	defer span.End()
 
-	return d.queries.ListActionsForWorkers(ctx, d.pool, dbsqlc.ListActionsForWorkersParams{
-		Tenantid:  d.tenantId,
-		Workerids: workerIds,
-	})
+	return d.repo.ListActionsForWorkers(ctx, sqlchelpers.UUIDToStr(d.tenantId), workerIds)
 }
 
-func (d *schedulerDbQueries) ListAvailableSlotsForWorkers(ctx context.Context, params dbsqlc.ListAvailableSlotsForWorkersParams) ([]*dbsqlc.ListAvailableSlotsForWorkersRow, error) {
+func (d *schedulerDbQueries) ListAvailableSlotsForWorkers(ctx context.Context, workerIds []pgtype.UUID) ([]*dbsqlc.ListAvailableSlotsForWorkersRow, error) {
 	ctx, span := telemetry.NewSpan(ctx, "list-available-slots-for-workers")
 	defer span.End()
 
-	return d.queries.ListAvailableSlotsForWorkers(ctx, d.pool, params)
+	return d.repo.ListAvailableSlotsForWorkers(ctx, sqlchelpers.UUIDToStr(d.tenantId), workerIds)
 }
 
 // Scheduler is responsible for scheduling steps to workers as efficiently as possible.
@@ -77,23 +74,37 @@ type Scheduler struct {
 	unackedMu    mutex
 
 	rl *rateLimiter
+
+	strategy AssignmentStrategy
+
+	maxAssignedPerWorker int
+	maxAssignedPerTick   int
+
+	reservations *slotReservations
+
+	decisions *decisionLog
 }
 
 func newScheduler(cf *sharedConfig, tenantId pgtype.UUID, rl *rateLimiter) *Scheduler {
 	l := cf.l.With().Str("tenant_id", sqlchelpers.UUIDToStr(tenantId)).Logger()
 
 	return &Scheduler{
-		repo:            newSchedulerDbQueries(cf.queries, cf.pool, tenantId),
-		tenantId:        tenantId,
-		l:               &l,
-		actions:         make(map[string]*action),
-		unackedSlots:    make(map[int]*slot),
-		rl:              rl,
-		actionsMu:       newRWMu(cf.l),
-		replenishMu:     newMu(cf.l),
-		workersMu:       newMu(cf.l),
-		assignedCountMu: newMu(cf.l),
-		unackedMu:       newMu(cf.l),
+		repo:                 newSchedulerDbQueries(cf.repo.Assignment(), tenantId),
+		tenantId:             tenantId,
+		l:                    &l,
+		actions:              make(map[string]*action),
+		unackedSlots:         make(map[int]*slot),
+		rl:                   rl,
+		strategy:             cf.strategy,
+		maxAssignedPerWorker: cf.maxAssignedPerWorker,
+		maxAssignedPerTick:   cf.maxAssignedPerTick,
+		reservations:         newSlotReservations(),
+		decisions:            newDecisionLog(cf, tenantId),
+		actionsMu:            newRWMu(cf.l),
+		replenishMu:          newMu(cf.l),
+		workersMu:            newMu(cf.l),
+		assignedCountMu:      newMu(cf.l),
+		unackedMu:            newMu(cf.l),
 	}
 }
 
@@ -128,9 +139,8 @@ func (s *Scheduler) setWorkers(workers []*ListActiveWorkersResult) {
 	newWorkers := make(map[string]*worker, len(workers))
 
 	for i := range workers {
-		newWorkers[sqlchelpers.UUIDToStr(workers[i].ID)] = &worker{
-			ListActiveWorkersResult: workers[i],
-		}
+		workerId := sqlchelpers.UUIDToStr(workers[i].ID)
+		newWorkers[workerId] = newWorker(workers[i], s.workers[workerId])
 	}
 
 	s.workers = newWorkers
@@ -159,7 +169,12 @@ func (s *Scheduler) replenish(ctx context.Context, mustReplenish bool) error {
 	workers := s.getWorkers()
 	workerIds := make([]pgtype.UUID, 0)
 
-	for workerIdStr := range workers {
+	for workerIdStr, w := range workers {
+		// draining workers do not receive new slots
+		if w.isDraining() {
+			continue
+		}
+
 		workerIds = append(workerIds, sqlchelpers.UUIDFromStr(workerIdStr))
 	}
 
@@ -290,10 +305,7 @@ func (s *Scheduler) replenish(ctx context.Context, mustReplenish bool) error {
 	s.unackedMu.Lock()
 	defer s.unackedMu.Unlock()
 
-	availableSlots, err := s.repo.ListAvailableSlotsForWorkers(ctx, dbsqlc.ListAvailableSlotsForWorkersParams{
-		Tenantid:  s.tenantId,
-		Workerids: workerUUIDs,
-	})
+	availableSlots, err := s.repo.ListAvailableSlotsForWorkers(ctx, workerUUIDs)
 
 	if err != nil {
 		return err
@@ -302,17 +314,17 @@ func (s *Scheduler) replenish(ctx context.Context, mustReplenish bool) error {
 	s.l.Debug().Msgf("loading available slots took %s", time.Since(checkpoint))
 
 	// FUNCTION 3: list unacked slots (so they're not counted towards the worker slot count)
-	workersToUnackedSlots := make(map[string][]*slot)
+	workersToUnackedSlots := make(map[workerSlotPool][]*slot)
 
 	for _, unackedSlot := range s.unackedSlots {
 		s := unackedSlot
-		workerId := s.getWorkerId()
+		pool := workerSlotPool{workerId: s.getWorkerId(), slotType: s.slotType}
 
-		if _, ok := workersToUnackedSlots[workerId]; !ok {
-			workersToUnackedSlots[workerId] = make([]*slot, 0)
+		if _, ok := workersToUnackedSlots[pool]; !ok {
+			workersToUnackedSlots[pool] = make([]*slot, 0)
 		}
 
-		workersToUnackedSlots[workerId] = append(workersToUnackedSlots[workerId], s)
+		workersToUnackedSlots[pool] = append(workersToUnackedSlots[pool], s)
 	}
 
 	// FUNCTION 4: write the new slots to the scheduler and clean up expired slots
@@ -322,13 +334,17 @@ func (s *Scheduler) replenish(ctx context.Context, mustReplenish bool) error {
 	for _, worker := range availableSlots {
 		workerId := sqlchelpers.UUIDToStr(worker.ID)
 		actions := workerIdsToActions[workerId]
-		unackedSlots := workersToUnackedSlots[workerId]
+		slotType := toSchedulerSlotType(worker.SlotType)
+		unackedSlots := workersToUnackedSlots[workerSlotPool{workerId: workerId, slotType: slotType}]
 
 		// create a slot for each available slot
 		slots := make([]*slot, 0)
 
 		for i := 0; i < int(worker.AvailableSlots)-len(unackedSlots); i++ {
-			slots = append(slots, newSlot(workers[workerId], actions))
+			workerSlot := newSlot(workers[workerId], actions)
+			workerSlot.slotType = slotType
+
+			slots = append(slots, workerSlot)
 		}
 
 		// extend expiry of all unacked slots
@@ -336,7 +352,7 @@ func (s *Scheduler) replenish(ctx context.Context, mustReplenish bool) error {
 			unackedSlot.extendExpiry()
 		}
 
-		s.l.Debug().Msgf("worker %s has %d total slots, %d unacked slots", workerId, worker.AvailableSlots, len(unackedSlots))
+		s.l.Debug().Msgf("worker %s has %d total slots, %d unacked slots in slot pool %s", workerId, worker.AvailableSlots, len(unackedSlots), worker.SlotType)
 
 		slots = append(slots, unackedSlots...)
 
@@ -417,6 +433,11 @@ func (s *Scheduler) loopReplenish(ctx context.Context) {
 
 func (s *Scheduler) start(ctx context.Context) {
 	go s.loopReplenish(ctx)
+	go s.loopReservations(ctx)
+
+	if s.decisions != nil {
+		go s.decisions.loopFlush(ctx)
+	}
 }
 
 type scheduleRateLimitResult struct {
@@ -435,6 +456,10 @@ type assignSingleResult struct {
 	succeeded bool
 
 	rateLimitResult *scheduleRateLimitResult
+
+	// candidateWorkers is the number of workers with an available slot for the action when the queue
+	// item was considered. It is only set when scheduling decisions are recorded.
+	candidateWorkers int
 }
 
 func (s *Scheduler) tryAssignBatch(
@@ -448,6 +473,7 @@ func (s *Scheduler) tryAssignBatch(
 	ringOffset int,
 	stepIdsToLabels map[string][]*dbsqlc.GetDesiredLabelsRow,
 	stepRunIdsToRateLimits map[string]map[string]int32,
+	limits *assignmentLimits,
 ) (
 	res []*assignSingleResult, newRingOffset int, err error,
 ) {
@@ -534,10 +560,28 @@ func (s *Scheduler) tryAssignBatch(
 
 	candidateSlots := action.slots
 
+	if s.decisions != nil {
+		candidateWorkers := countActiveWorkers(candidateSlots)
+
+		defer func() {
+			for i := range res {
+				res[i].candidateWorkers = candidateWorkers
+			}
+		}()
+	}
+
+	s.applyReservations(qis, res, candidateSlots, rlNacks)
+
+	if s.strategy != nil {
+		s.tryAssignWithStrategy(ctx, actionId, qis, res, candidateSlots, stepIdsToLabels, limits, rlAcks, rlNacks)
+
+		return res, newRingOffset, nil
+	}
+
 	wg := sync.WaitGroup{}
 
 	for i := range res {
-		if res[i].rateLimitResult != nil {
+		if res[i].rateLimitResult != nil || res[i].noSlots {
 			continue
 		}
 
@@ -566,6 +610,7 @@ func (s *Scheduler) tryAssignBatch(
 				candidateSlots,
 				childRingOffset,
 				stepIdsToLabels[sqlchelpers.UUIDToStr(qi.StepId)],
+				limits,
 				rlAcks[i],
 				rlNacks[i],
 			)
@@ -592,17 +637,24 @@ func (s *Scheduler) tryAssignBatch(
 
 func findSlot(
 	candidateSlots []*slot,
+	slotType string,
+	limits *assignmentLimits,
 	rateLimitAck func(),
 	rateLimitNack func(),
 ) *slot {
 	var assignedSlot *slot
 
 	for _, slot := range candidateSlots {
-		if !slot.active() {
+		if slot.slotType != slotType || !slot.active() {
+			continue
+		}
+
+		if !limits.reserve(slot.getWorkerId()) {
 			continue
 		}
 
 		if !slot.use([]func(){rateLimitAck}, []func(){rateLimitNack}) {
+			limits.release(slot.getWorkerId())
 			continue
 		}
 
@@ -620,6 +672,7 @@ func (s *Scheduler) tryAssignSingleton(
 	candidateSlots []*slot,
 	ringOffset int,
 	labels []*dbsqlc.GetDesiredLabelsRow,
+	limits *assignmentLimits,
 	rateLimitAck func(),
 	rateLimitNack func(),
 ) (
@@ -633,10 +686,12 @@ func (s *Scheduler) tryAssignSingleton(
 		candidateSlots = getRankedSlots(qi, labels, candidateSlots)
 	}
 
-	assignedSlot := findSlot(candidateSlots[ringOffset:], rateLimitAck, rateLimitNack)
+	slotType := getSlotType(labels)
+
+	assignedSlot := findSlot(candidateSlots[ringOffset:], slotType, limits, rateLimitAck, rateLimitNack)
 
 	if assignedSlot == nil {
-		assignedSlot = findSlot(candidateSlots[:ringOffset], rateLimitAck, rateLimitNack)
+		assignedSlot = findSlot(candidateSlots[:ringOffset], slotType, limits, rateLimitAck, rateLimitNack)
 	}
 
 	if assignedSlot == nil {
@@ -644,6 +699,13 @@ func (s *Scheduler) tryAssignSingleton(
 		return res, nil
 	}
 
+	s.markAssigned(&res, assignedSlot)
+
+	return res, nil
+}
+
+// markAssigned records the slot as unacked and sets the ack id and worker id on the result.
+func (s *Scheduler) markAssigned(res *assignSingleResult, assignedSlot *slot) {
 	s.assignedCountMu.Lock()
 	s.assignedCount++
 	res.ackId = s.assignedCount
@@ -655,8 +717,110 @@ func (s *Scheduler) tryAssignSingleton(
 
 	res.workerId = sqlchelpers.UUIDFromStr(assignedSlot.getWorkerId())
 	res.succeeded = true
+}
 
-	return res, nil
+// tryAssignWithStrategy assigns the queue items using the configured assignment strategy. The caller must
+// hold the action lock.
+func (s *Scheduler) tryAssignWithStrategy(
+	ctx context.Context,
+	actionId string,
+	qis []*dbsqlc.QueueItem,
+	res []*assignSingleResult,
+	candidateSlots []*slot,
+	stepIdsToLabels map[string][]*dbsqlc.GetDesiredLabelsRow,
+	limits *assignmentLimits,
+	rlAcks []func(),
+	rlNacks []func(),
+) {
+	ctx, span := telemetry.NewSpan(ctx, "try-assign-with-strategy")
+	defer span.End()
+
+	workerIdsToSlots := make(map[string][]*slot)
+	workers := make([]*StrategyWorker, 0)
+	workersById := make(map[string]*worker)
+
+	for _, slot := range candidateSlots {
+		if !slot.active() {
+			continue
+		}
+
+		workerId := slot.getWorkerId()
+
+		if _, ok := workerIdsToSlots[workerId]; !ok {
+			workers = append(workers, &StrategyWorker{
+				WorkerId: workerId,
+				Labels:   slot.worker.Labels,
+				Region:   slot.worker.Region,
+				Pool:     slot.worker.Pool,
+			})
+
+			workersById[workerId] = slot.worker
+		}
+
+		workerIdsToSlots[workerId] = append(workerIdsToSlots[workerId], slot)
+	}
+
+	for _, w := range workers {
+		w.AvailableSlots = len(workerIdsToSlots[w.WorkerId])
+	}
+
+	input := &AssignmentInput{
+		ActionId:   actionId,
+		Workers:    workers,
+		QueueItems: make([]*StrategyQueueItem, 0, len(qis)),
+	}
+
+	for i, qi := range qis {
+		if res[i].rateLimitResult != nil || res[i].noSlots {
+			continue
+		}
+
+		input.QueueItems = append(input.QueueItems, &StrategyQueueItem{
+			QueueItem:     qi,
+			DesiredLabels: stepIdsToLabels[sqlchelpers.UUIDToStr(qi.StepId)],
+		})
+	}
+
+	qiIdsToWorkerIds := make(map[int64][]string, len(input.QueueItems))
+
+	if len(workers) > 0 && len(input.QueueItems) > 0 {
+		for _, assignment := range s.strategy.Assign(ctx, input) {
+			qiIdsToWorkerIds[assignment.QueueItemId] = assignment.WorkerIds
+		}
+	}
+
+	for i, qi := range qis {
+		if res[i].rateLimitResult != nil || res[i].noSlots {
+			continue
+		}
+
+		labels := stepIdsToLabels[sqlchelpers.UUIDToStr(qi.StepId)]
+
+		var assignedSlot *slot
+
+		for _, workerId := range qiIdsToWorkerIds[qi.ID] {
+			w, ok := workersById[workerId]
+
+			if !ok || !isEligibleWorker(qi, labels, w) {
+				continue
+			}
+
+			assignedSlot = findSlot(workerIdsToSlots[workerId], getSlotType(labels), limits, rlAcks[i], rlNacks[i])
+
+			if assignedSlot != nil {
+				break
+			}
+		}
+
+		if assignedSlot == nil {
+			res[i].noSlots = true
+			rlNacks[i]()
+
+			continue
+		}
+
+		s.markAssigned(res[i], assignedSlot)
+	}
 }
 
 type AssignedQueueItem struct {
@@ -664,6 +828,10 @@ type AssignedQueueItem struct {
 	WorkerId pgtype.UUID
 
 	QueueItem *dbsqlc.QueueItem
+
+	// RateLimits are the rate limit units which were consumed to assign the queue item, keyed by rate
+	// limit key. These units can be returned if the step run is not dispatched.
+	RateLimits map[string]int32
 }
 
 type assignResults struct {
@@ -698,6 +866,9 @@ func (s *Scheduler) tryAssign(
 
 	resultsCh := make(chan *assignResults, len(actionIdToQueueItems))
 
+	// limits are shared across actions, since a worker may accept more than one action
+	limits := newAssignmentLimits(s.maxAssignedPerWorker, s.maxAssignedPerTick)
+
 	go func() {
 		wg := sync.WaitGroup{}
 		startTotal := time.Now()
@@ -730,13 +901,22 @@ func (s *Scheduler) tryAssign(
 				}
 
 				err := queueutils.BatchLinear(50, batched, func(batchQis []*dbsqlc.QueueItem) error {
+					// once the round is exhausted, the remaining queue items are retried on the next tick
+					if limits.exhausted() {
+						resultsCh <- &assignResults{
+							unassigned: batchQis,
+						}
+
+						return nil
+					}
+
 					batchAssigned := make([]*AssignedQueueItem, 0, len(batchQis))
 					batchRateLimited := make([]*scheduleRateLimitResult, 0, len(batchQis))
 					batchUnassigned := make([]*dbsqlc.QueueItem, 0, len(batchQis))
 
 					batchStart := time.Now()
 
-					results, newRingOffset, err := s.tryAssignBatch(ctx, actionId, batchQis, ringOffset, stepIdsToLabels, stepRunIdsToRateLimits)
+					results, newRingOffset, err := s.tryAssignBatch(ctx, actionId, batchQis, ringOffset, stepIdsToLabels, stepRunIdsToRateLimits, limits)
 
 					if err != nil {
 						return err
@@ -744,6 +924,8 @@ func (s *Scheduler) tryAssign(
 
 					ringOffset = newRingOffset
 
+					s.recordDecisions(actionId, results, stepIdsToLabels)
+
 					for _, singleRes := range results {
 						if !singleRes.succeeded {
 							if singleRes.rateLimitResult != nil {
@@ -760,9 +942,10 @@ func (s *Scheduler) tryAssign(
 						}
 
 						batchAssigned = append(batchAssigned, &AssignedQueueItem{
-							WorkerId:  singleRes.workerId,
-							QueueItem: singleRes.qi,
-							AckId:     singleRes.ackId,
+							WorkerId:   singleRes.workerId,
+							QueueItem:  singleRes.qi,
+							AckId:      singleRes.ackId,
+							RateLimits: stepRunIdsToRateLimits[sqlchelpers.UUIDToStr(singleRes.qi.StepRunId)],
 						})
 					}
 
@@ -0,0 +1,99 @@
+package v2
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// shardSeparator separates the queue name from the shard index in the lease resource id of a sharded queue.
+const shardSeparator = "#"
+
+// WithQueueShards splits hot queues into sub-queues, keyed by queue name. Each queue item is assigned to a
+// shard by a hash of its step run id, and each shard is leased separately, so that multiple schedulers can
+// drain a single queue in parallel. Queues which are not in the map, or which have fewer than 2 shards, are
+// not sharded.
+func WithQueueShards(queueShards map[string]int) SchedulingPoolOpt {
+	return func(cf *sharedConfig) {
+		cf.queueShards = queueShards
+	}
+}
+
+// queueShard is a leasable part of a queue. An unsharded queue has a single shard which covers all of its
+// queue items.
+type queueShard struct {
+	queueName string
+	index     int
+	count     int
+}
+
+func (s queueShard) isSharded() bool {
+	return s.count > 1
+}
+
+// resourceId returns the id which is used to lease the shard. Unsharded queues are leased by queue name.
+func (s queueShard) resourceId() string {
+	if !s.isSharded() {
+		return s.queueName
+	}
+
+	return fmt.Sprintf("%s%s%d", s.queueName, shardSeparator, s.index)
+}
+
+// params returns the shard count and index to filter queue items by, which are nil when the queue is
+// not sharded.
+func (s queueShard) params() (count *int, index *int) {
+	if !s.isSharded() {
+		return nil, nil
+	}
+
+	return &s.count, &s.index
+}
+
+func (cf *sharedConfig) getShardCount(queueName string) int {
+	if count, ok := cf.queueShards[queueName]; ok && count > 1 {
+		return count
+	}
+
+	return 1
+}
+
+// getQueueShards returns the shards for a queue.
+func (cf *sharedConfig) getQueueShards(queueName string) []queueShard {
+	count := cf.getShardCount(queueName)
+
+	res := make([]queueShard, 0, count)
+
+	for i := 0; i < count; i++ {
+		res = append(res, queueShard{
+			queueName: queueName,
+			index:     i,
+			count:     count,
+		})
+	}
+
+	return res
+}
+
+// parseQueueShard returns the shard for a lease resource id. The resource id is only treated as a shard
+// if the queue is configured with shards, so queue names containing the separator are left intact.
+func (cf *sharedConfig) parseQueueShard(resourceId string) queueShard {
+	if i := strings.LastIndex(resourceId, shardSeparator); i > 0 {
+		queueName := resourceId[:i]
+
+		if count := cf.getShardCount(queueName); count > 1 {
+			if index, err := strconv.Atoi(resourceId[i+1:]); err == nil && index >= 0 && index < count {
+				return queueShard{
+					queueName: queueName,
+					index:     index,
+					count:     count,
+				}
+			}
+		}
+	}
+
+	return queueShard{
+		queueName: resourceId,
+		count:     1,
+	}
+}
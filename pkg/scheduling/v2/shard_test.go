@@ -0,0 +1,38 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueueShardResourceIds(t *testing.T) {
+	cf := &sharedConfig{
+		queueShards: map[string]int{
+			"hot-queue": 3,
+			"one-shard": 1,
+		},
+	}
+
+	shards := cf.getQueueShards("hot-queue")
+	assert.Len(t, shards, 3)
+
+	for i, shard := range shards {
+		parsed := cf.parseQueueShard(shard.resourceId())
+
+		assert.Equal(t, shard, parsed)
+		assert.Equal(t, "hot-queue", parsed.queueName)
+		assert.Equal(t, i, parsed.index)
+	}
+
+	// unsharded queues are leased by queue name
+	for _, queueName := range []string{"one-shard", "other-queue", "queue#1", "hot-queue#3"} {
+		shards := cf.getQueueShards(queueName)
+		assert.Len(t, shards, 1)
+		assert.Equal(t, queueName, shards[0].resourceId())
+
+		parsed := cf.parseQueueShard(queueName)
+		assert.Equal(t, queueName, parsed.queueName)
+		assert.False(t, parsed.isSharded())
+	}
+}
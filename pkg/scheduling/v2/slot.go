@@ -17,6 +17,9 @@ type slot struct {
 	worker  *worker
 	actions []string
 
+	// slotType is the worker slot pool the slot belongs to. It is empty for the default slot pool.
+	slotType string
+
 	// expiresAt is when the slot is no longer valid, but has not been cleaned up yet
 	expiresAt *time.Time
 	used      bool
@@ -55,7 +58,7 @@ func (s *slot) active() bool {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	return !s.used && s.expiresAt != nil && s.expiresAt.After(time.Now())
+	return !s.used && s.expiresAt != nil && s.expiresAt.After(time.Now()) && !s.worker.isDraining()
 }
 
 func (s *slot) expired() bool {
@@ -162,6 +165,11 @@ func (r *rankedValidSlots) order() []*slot {
 	for i := len(sortedRanks) - 1; i >= 0; i-- {
 		rank := sortedRanks[i]
 
+		// workers which don't meet the required labels have a negative rank
+		if rank < 0 {
+			continue
+		}
+
 		if r.ranksToSlots[rank] != nil {
 			nonNegativeSlots = append(nonNegativeSlots, r.ranksToSlots[rank]...)
 		}
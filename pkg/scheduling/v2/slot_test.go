@@ -93,6 +93,52 @@ func TestGetRankedSlots(t *testing.T) {
 			},
 			expectedWorker: []string{stableWorkerId2, stableWorkerId1},
 		},
+		{
+			name: "Preferred region ranks workers in the region first",
+			qi:   &dbsqlc.QueueItem{},
+			labels: []*dbsqlc.GetDesiredLabelsRow{
+				toRegionLabel(&dbsqlc.GetDesiredRegionsRow{
+					Region:         sqlchelpers.TextFromStr("us-east-1"),
+					RegionStrategy: dbsqlc.NullRegionStrategy{Valid: true, RegionStrategy: dbsqlc.RegionStrategyPREFER},
+				}),
+			},
+			slots: []*slot{
+				newSlot(&worker{ListActiveWorkersResult: &ListActiveWorkersResult{ID: sqlchelpers.UUIDFromStr(stableWorkerId2), Region: "eu-west-1"}}, []string{}),
+				newSlot(&worker{ListActiveWorkersResult: &ListActiveWorkersResult{ID: sqlchelpers.UUIDFromStr(stableWorkerId1), Region: "us-east-1"}}, []string{}),
+			},
+			expectedWorker: []string{stableWorkerId1, stableWorkerId2},
+		},
+		{
+			name: "Pinned region excludes workers in other regions",
+			qi:   &dbsqlc.QueueItem{},
+			labels: []*dbsqlc.GetDesiredLabelsRow{
+				toRegionLabel(&dbsqlc.GetDesiredRegionsRow{
+					Region:         sqlchelpers.TextFromStr("us-east-1"),
+					RegionStrategy: dbsqlc.NullRegionStrategy{Valid: true, RegionStrategy: dbsqlc.RegionStrategyPIN},
+				}),
+			},
+			slots: []*slot{
+				newSlot(&worker{ListActiveWorkersResult: &ListActiveWorkersResult{ID: sqlchelpers.UUIDFromStr(stableWorkerId2), Region: "eu-west-1"}}, []string{}),
+				newSlot(&worker{ListActiveWorkersResult: &ListActiveWorkersResult{ID: sqlchelpers.UUIDFromStr(uuid.New().String())}}, []string{}),
+				newSlot(&worker{ListActiveWorkersResult: &ListActiveWorkersResult{ID: sqlchelpers.UUIDFromStr(stableWorkerId1), Region: "us-east-1"}}, []string{}),
+			},
+			expectedWorker: []string{stableWorkerId1},
+		},
+		{
+			name: "Pool excludes workers outside of the pool",
+			qi:   &dbsqlc.QueueItem{},
+			labels: []*dbsqlc.GetDesiredLabelsRow{
+				toPoolLabel(&dbsqlc.GetDesiredPoolsRow{
+					Pool: sqlchelpers.TextFromStr("gpu"),
+				}),
+			},
+			slots: []*slot{
+				newSlot(&worker{ListActiveWorkersResult: &ListActiveWorkersResult{ID: sqlchelpers.UUIDFromStr(stableWorkerId2), Pool: "cpu"}}, []string{}),
+				newSlot(&worker{ListActiveWorkersResult: &ListActiveWorkersResult{ID: sqlchelpers.UUIDFromStr(uuid.New().String())}}, []string{}),
+				newSlot(&worker{ListActiveWorkersResult: &ListActiveWorkersResult{ID: sqlchelpers.UUIDFromStr(stableWorkerId1), Pool: "gpu"}}, []string{}),
+			},
+			expectedWorker: []string{stableWorkerId1},
+		},
 	}
 
 	for _, tt := range tests {
@@ -107,3 +153,33 @@ func TestGetRankedSlots(t *testing.T) {
 		})
 	}
 }
+
+func TestSlotInactiveWhenWorkerDraining(t *testing.T) {
+	workerId := sqlchelpers.UUIDFromStr(stableWorkerId1)
+
+	w := newWorker(&ListActiveWorkersResult{ID: workerId, Status: WorkerStatusActive}, nil)
+	s := newSlot(w, []string{})
+
+	assert.True(t, s.active())
+
+	// the next generation of the worker shares the draining state with existing slots
+	newWorker(&ListActiveWorkersResult{ID: workerId, Status: WorkerStatusDraining}, w)
+
+	assert.False(t, s.active())
+}
+
+func TestFindSlotMatchesSlotType(t *testing.T) {
+	w := newWorker(&ListActiveWorkersResult{ID: sqlchelpers.UUIDFromStr(stableWorkerId1)}, nil)
+
+	cpuSlot := newSlot(w, []string{})
+	gpuSlot := newSlot(w, []string{})
+	gpuSlot.slotType = "gpu"
+
+	slotType := getSlotType([]*dbsqlc.GetDesiredLabelsRow{
+		toSlotTypeLabel(&dbsqlc.GetDesiredSlotTypesRow{SlotType: pgtype.Text{String: "gpu", Valid: true}}),
+	})
+
+	assert.Equal(t, gpuSlot, findSlot([]*slot{cpuSlot, gpuSlot}, slotType, nil, nil, nil))
+	assert.Nil(t, findSlot([]*slot{cpuSlot, gpuSlot}, slotType, nil, nil, nil))
+	assert.Equal(t, cpuSlot, findSlot([]*slot{cpuSlot, gpuSlot}, getSlotType(nil), nil, nil, nil))
+}
@@ -0,0 +1,49 @@
+package v2
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/repository"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// SlotTypeLabelKey is the reserved desired label key for the slot pool a step consumes. Unlike other desired
+// labels, it is matched against the slot pool of each slot rather than the worker's labels.
+const SlotTypeLabelKey = "hatchet.slot_type"
+
+// workerSlotPool identifies a slot pool on a worker.
+type workerSlotPool struct {
+	workerId string
+	slotType string
+}
+
+// toSlotTypeLabel converts a step's slot type into a desired label.
+func toSlotTypeLabel(row *dbsqlc.GetDesiredSlotTypesRow) *dbsqlc.GetDesiredLabelsRow {
+	return &dbsqlc.GetDesiredLabelsRow{
+		Key:        SlotTypeLabelKey,
+		StrValue:   row.SlotType,
+		Required:   true,
+		Comparator: dbsqlc.WorkerLabelComparatorEQUAL,
+		StepId:     row.StepId,
+	}
+}
+
+// getSlotType returns the slot type from a step's desired labels. It returns an empty string for steps which
+// use the default slot pool.
+func getSlotType(labels []*dbsqlc.GetDesiredLabelsRow) string {
+	for _, label := range labels {
+		if label.Key == SlotTypeLabelKey && label.StrValue.Valid {
+			return toSchedulerSlotType(label.StrValue.String)
+		}
+	}
+
+	return ""
+}
+
+// toSchedulerSlotType converts a slot type from the database, where the default slot pool is named, to the
+// slot type of the scheduler's slots.
+func toSchedulerSlotType(slotType string) string {
+	if slotType == repository.DefaultSlotType {
+		return ""
+	}
+
+	return slotType
+}
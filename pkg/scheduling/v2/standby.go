@@ -0,0 +1,136 @@
+package v2
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+const defaultStandbyPollInterval = 100 * time.Millisecond
+
+// WithWarmStandby runs the scheduling pool as a warm standby. The pool keeps the state for its tenants
+// loaded even when the leases are held by another scheduler, and checks for available leases on every
+// poll interval so that it can take over within one tick of the leases becoming available, instead of
+// waiting for the next lease acquisition loop.
+func WithWarmStandby(pollInterval time.Duration) SchedulingPoolOpt {
+	return func(cf *sharedConfig) {
+		if pollInterval <= 0 {
+			pollInterval = defaultStandbyPollInterval
+		}
+
+		cf.warmStandby = true
+		cf.standbyPollInterval = pollInterval
+	}
+}
+
+// IsStandby returns true if the scheduling pool is running as a warm standby.
+func (p *SchedulingPool) IsStandby() bool {
+	return p.cf.warmStandby
+}
+
+// loopForStandbyLeases checks whether the leases held by other schedulers have become available, and
+// acquires them using the last known state of the workers and queues.
+func (l *LeaseManager) loopForStandbyLeases(ctx context.Context) {
+	ticker := time.NewTicker(l.conf.standbyPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			wg := sync.WaitGroup{}
+
+			wg.Add(2)
+
+			go func() {
+				defer wg.Done()
+				if err := l.acquireStandbyWorkerLeases(ctx); err != nil {
+					l.conf.l.Error().Err(err).Msg("error acquiring standby worker leases")
+				}
+			}()
+
+			go func() {
+				defer wg.Done()
+				if err := l.acquireStandbyQueueLeases(ctx); err != nil {
+					l.conf.l.Error().Err(err).Msg("error acquiring standby queue leases")
+				}
+			}()
+
+			wg.Wait()
+		}
+	}
+}
+
+func (l *LeaseManager) acquireStandbyWorkerLeases(ctx context.Context) error {
+	if ok := l.workerLeasesMu.TryLock(); !ok {
+		return nil
+	}
+
+	defer l.workerLeasesMu.Unlock()
+
+	if len(l.standbyWorkerIds) == 0 {
+		return nil
+	}
+
+	available, err := l.lr.ListAvailableLeaseResources(ctx, dbsqlc.LeaseKindWORKER, l.standbyWorkerIds)
+
+	if err != nil {
+		return err
+	}
+
+	if len(available) == 0 {
+		return nil
+	}
+
+	l.conf.l.Info().Msgf("%d worker leases are available, promoting standby workers to active", len(available))
+
+	return l.leaseWorkers(ctx, l.activeWorkers)
+}
+
+func (l *LeaseManager) acquireStandbyQueueLeases(ctx context.Context) error {
+	if ok := l.queueLeasesMu.TryLock(); !ok {
+		return nil
+	}
+
+	defer l.queueLeasesMu.Unlock()
+
+	if len(l.standbyQueues) == 0 {
+		return nil
+	}
+
+	available, err := l.lr.ListAvailableLeaseResources(ctx, dbsqlc.LeaseKindQUEUE, l.standbyQueues)
+
+	if err != nil {
+		return err
+	}
+
+	if len(available) == 0 {
+		return nil
+	}
+
+	l.conf.l.Info().Msgf("%d queue leases are available, promoting standby queues to active", len(available))
+
+	return l.leaseQueues(ctx, l.activeQueues)
+}
+
+// unleasedResourceIds returns the resource ids which are not part of the given leases.
+func unleasedResourceIds(resourceIds []string, leases []*dbsqlc.Lease) []string {
+	leased := make(map[string]struct{}, len(leases))
+
+	for _, lease := range leases {
+		leased[lease.ResourceId] = struct{}{}
+	}
+
+	res := make([]string, 0)
+
+	for _, resourceId := range resourceIds {
+		if _, ok := leased[resourceId]; !ok {
+			res = append(res, resourceId)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,214 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// AssignmentStrategy decides which workers a batch of queue items should be assigned to. A strategy is
+// called once per action per scheduling loop with the workers that have available slots for that action.
+//
+// The scheduler still enforces HARD sticky strategies and required labels on the returned workers, and
+// it is safe for a strategy to return more workers than there are slots: the scheduler tries each worker
+// in order until a slot is found.
+type AssignmentStrategy interface {
+	Assign(ctx context.Context, input *AssignmentInput) []*Assignment
+}
+
+// AssignmentInput is the set of workers and queue items for a single action.
+type AssignmentInput struct {
+	ActionId string
+
+	Workers    []*StrategyWorker
+	QueueItems []*StrategyQueueItem
+}
+
+// StrategyWorker is a worker which has at least one available slot for the action.
+type StrategyWorker struct {
+	WorkerId       string
+	Labels         []*dbsqlc.ListManyWorkerLabelsRow
+	Region         string
+	Pool           string
+	AvailableSlots int
+}
+
+// Weight returns the affinity weight of the worker for the desired labels, or -1 if the worker does not
+// meet the required labels.
+func (w *StrategyWorker) Weight(labels []*dbsqlc.GetDesiredLabelsRow) int {
+	wr := &worker{
+		ListActiveWorkersResult: &ListActiveWorkersResult{
+			ID:     sqlchelpers.UUIDFromStr(w.WorkerId),
+			Labels: w.Labels,
+			Region: w.Region,
+			Pool:   w.Pool,
+		},
+	}
+
+	return wr.computeWeight(labels)
+}
+
+type StrategyQueueItem struct {
+	QueueItem     *dbsqlc.QueueItem
+	DesiredLabels []*dbsqlc.GetDesiredLabelsRow
+}
+
+// Assignment is the ordered list of preferred workers for a queue item. Queue items without an
+// assignment, or with an empty list of workers, are left in the queue.
+type Assignment struct {
+	QueueItemId int64
+	WorkerIds   []string
+}
+
+var (
+	strategiesMu sync.RWMutex
+	strategies   = map[string]AssignmentStrategy{
+		"least-loaded": &LeastLoadedStrategy{},
+		"bin-packing":  &BinPackingStrategy{},
+	}
+)
+
+// RegisterAssignmentStrategy registers a strategy under the given name, so that it can be selected
+// with the scheduler.assignmentStrategy config option. It is meant to be called from an init function
+// in a custom server build.
+func RegisterAssignmentStrategy(name string, s AssignmentStrategy) {
+	strategiesMu.Lock()
+	defer strategiesMu.Unlock()
+
+	strategies[name] = s
+}
+
+// GetAssignmentStrategy returns the strategy registered under the given name.
+func GetAssignmentStrategy(name string) (AssignmentStrategy, error) {
+	strategiesMu.RLock()
+	defer strategiesMu.RUnlock()
+
+	s, ok := strategies[name]
+
+	if !ok {
+		return nil, fmt.Errorf("assignment strategy %s is not registered", name)
+	}
+
+	return s, nil
+}
+
+// WithAssignmentStrategy sets the strategy used to assign queue items to workers. If unset, the scheduler
+// spreads queue items across slots in a round robin.
+func WithAssignmentStrategy(s AssignmentStrategy) SchedulingPoolOpt {
+	return func(cf *sharedConfig) {
+		cf.strategy = s
+	}
+}
+
+// LeastLoadedStrategy assigns each queue item to the worker with the most available slots.
+type LeastLoadedStrategy struct{}
+
+func (s *LeastLoadedStrategy) Assign(ctx context.Context, input *AssignmentInput) []*Assignment {
+	return assignByLoad(input, func(a, b int) bool {
+		return a > b
+	})
+}
+
+// BinPackingStrategy assigns each queue item to the worker with the fewest available slots, so that
+// workers are filled before new workers are used.
+type BinPackingStrategy struct{}
+
+func (s *BinPackingStrategy) Assign(ctx context.Context, input *AssignmentInput) []*Assignment {
+	return assignByLoad(input, func(a, b int) bool {
+		return a < b
+	})
+}
+
+// assignByLoad orders workers for each queue item by sticky preference, then label weight, then by the
+// number of remaining slots using the given comparison. Remaining slots are decremented as queue items are
+// assigned, so later queue items in the batch see the load of earlier ones.
+func assignByLoad(input *AssignmentInput, preferSlots func(a, b int) bool) []*Assignment {
+	remaining := make(map[string]int, len(input.Workers))
+
+	for _, w := range input.Workers {
+		remaining[w.WorkerId] = w.AvailableSlots
+	}
+
+	res := make([]*Assignment, 0, len(input.QueueItems))
+
+	for _, item := range input.QueueItems {
+		qi := item.QueueItem
+
+		desiredWorkerId := ""
+
+		if qi.Sticky.Valid && qi.DesiredWorkerId.Valid {
+			desiredWorkerId = sqlchelpers.UUIDToStr(qi.DesiredWorkerId)
+		}
+
+		candidates := make([]*StrategyWorker, 0, len(input.Workers))
+		weights := make(map[string]int, len(input.Workers))
+
+		for _, w := range input.Workers {
+			if remaining[w.WorkerId] <= 0 {
+				continue
+			}
+
+			weight := 0
+
+			if len(item.DesiredLabels) > 0 {
+				weight = w.Weight(item.DesiredLabels)
+
+				if weight < 0 {
+					continue
+				}
+			}
+
+			weights[w.WorkerId] = weight
+			candidates = append(candidates, w)
+		}
+
+		sort.SliceStable(candidates, func(i, j int) bool {
+			a, b := candidates[i].WorkerId, candidates[j].WorkerId
+
+			if (a == desiredWorkerId) != (b == desiredWorkerId) {
+				return a == desiredWorkerId
+			}
+
+			if weights[a] != weights[b] {
+				return weights[a] > weights[b]
+			}
+
+			return preferSlots(remaining[a], remaining[b])
+		})
+
+		workerIds := make([]string, 0, len(candidates))
+
+		for _, w := range candidates {
+			workerIds = append(workerIds, w.WorkerId)
+		}
+
+		if len(workerIds) > 0 {
+			remaining[workerIds[0]]--
+		}
+
+		res = append(res, &Assignment{
+			QueueItemId: qi.ID,
+			WorkerIds:   workerIds,
+		})
+	}
+
+	return res
+}
+
+// isEligibleWorker returns false if the queue item can never be assigned to the worker, because of a HARD
+// sticky strategy or a required label.
+func isEligibleWorker(qi *dbsqlc.QueueItem, labels []*dbsqlc.GetDesiredLabelsRow, w *worker) bool {
+	if qi.Sticky.Valid && qi.Sticky.StickyStrategy == dbsqlc.StickyStrategyHARD {
+		return qi.DesiredWorkerId.Valid && sqlchelpers.UUIDToStr(qi.DesiredWorkerId) == sqlchelpers.UUIDToStr(w.ID)
+	}
+
+	if len(labels) > 0 {
+		return w.computeWeight(labels) >= 0
+	}
+
+	return true
+}
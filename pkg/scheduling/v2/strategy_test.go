@@ -0,0 +1,75 @@
+package v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+func TestBuiltInStrategies(t *testing.T) {
+	workers := []*StrategyWorker{
+		{WorkerId: stableWorkerId1, AvailableSlots: 1},
+		{WorkerId: stableWorkerId2, AvailableSlots: 3},
+	}
+
+	input := &AssignmentInput{
+		Workers: workers,
+		QueueItems: []*StrategyQueueItem{
+			{QueueItem: &dbsqlc.QueueItem{ID: 1}},
+			{QueueItem: &dbsqlc.QueueItem{ID: 2}},
+		},
+	}
+
+	leastLoaded := (&LeastLoadedStrategy{}).Assign(context.Background(), input)
+
+	assert.Len(t, leastLoaded, 2)
+	assert.Equal(t, []string{stableWorkerId2, stableWorkerId1}, leastLoaded[0].WorkerIds)
+	assert.Equal(t, []string{stableWorkerId2, stableWorkerId1}, leastLoaded[1].WorkerIds)
+
+	binPacking := (&BinPackingStrategy{}).Assign(context.Background(), input)
+
+	assert.Len(t, binPacking, 2)
+	assert.Equal(t, []string{stableWorkerId1, stableWorkerId2}, binPacking[0].WorkerIds)
+
+	// the first worker is full after the first assignment
+	assert.Equal(t, []string{stableWorkerId2}, binPacking[1].WorkerIds)
+}
+
+func TestBuiltInStrategiesPreferStickyWorker(t *testing.T) {
+	input := &AssignmentInput{
+		Workers: []*StrategyWorker{
+			{WorkerId: stableWorkerId1, AvailableSlots: 1},
+			{WorkerId: stableWorkerId2, AvailableSlots: 3},
+		},
+		QueueItems: []*StrategyQueueItem{
+			{
+				QueueItem: &dbsqlc.QueueItem{
+					ID:              1,
+					Sticky:          dbsqlc.NullStickyStrategy{Valid: true, StickyStrategy: dbsqlc.StickyStrategySOFT},
+					DesiredWorkerId: sqlchelpers.UUIDFromStr(stableWorkerId1),
+				},
+			},
+		},
+	}
+
+	res := (&LeastLoadedStrategy{}).Assign(context.Background(), input)
+
+	assert.Equal(t, []string{stableWorkerId1, stableWorkerId2}, res[0].WorkerIds)
+}
+
+func TestGetAssignmentStrategy(t *testing.T) {
+	_, err := GetAssignmentStrategy("least-loaded")
+	assert.NoError(t, err)
+
+	_, err = GetAssignmentStrategy("does-not-exist")
+	assert.Error(t, err)
+
+	RegisterAssignmentStrategy("custom", &BinPackingStrategy{})
+
+	_, err = GetAssignmentStrategy("custom")
+	assert.NoError(t, err)
+}
@@ -9,7 +9,6 @@ import (
 
 	"golang.org/x/sync/errgroup"
 
-	"github.com/hatchet-dev/hatchet/pkg/repository/buffer"
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
 )
 
@@ -21,6 +20,9 @@ type tenantManager struct {
 
 	scheduler *Scheduler
 	rl        *rateLimiter
+	budget    *tenantBudget
+
+	tombstones *tombstones
 
 	queuers   []*Queuer
 	queuersMu sync.RWMutex
@@ -32,11 +34,9 @@ type tenantManager struct {
 	resultsCh chan *QueueResults
 
 	cleanup func()
-
-	eventBuffer *buffer.BulkEventWriter
 }
 
-func newTenantManager(cf *sharedConfig, tenantId string, eventBuffer *buffer.BulkEventWriter, resultsCh chan *QueueResults) *tenantManager {
+func newTenantManager(cf *sharedConfig, tenantId string, resultsCh chan *QueueResults) *tenantManager {
 	tenantIdUUID := sqlchelpers.UUIDFromStr(tenantId)
 
 	rl := newRateLimiter(cf, tenantIdUUID)
@@ -52,7 +52,8 @@ func newTenantManager(cf *sharedConfig, tenantId string, eventBuffer *buffer.Bul
 		queuesCh:     queuesCh,
 		resultsCh:    resultsCh,
 		rl:           rl,
-		eventBuffer:  eventBuffer,
+		budget:       newTenantBudget(cf, tenantIdUUID),
+		tombstones:   newTombstones(),
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -94,7 +95,15 @@ func (t *tenantManager) listenForWorkerLeases(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case workerIds := <-t.workersCh:
+			prevCount := len(t.scheduler.getWorkers())
+
 			t.scheduler.setWorkers(workerIds)
+
+			// a warm standby which has just acquired worker leases should load slots immediately,
+			// rather than waiting for the next replenish
+			if t.cf.warmStandby && len(workerIds) > prevCount {
+				go t.replenish(ctx)
+			}
 		}
 	}
 }
@@ -104,38 +113,45 @@ func (t *tenantManager) listenForQueueLeases(ctx context.Context) {
 		select {
 		case <-ctx.Done():
 			return
-		case queueNames := <-t.queuesCh:
-			t.setQueuers(queueNames)
+		case resourceIds := <-t.queuesCh:
+			t.setQueuers(resourceIds)
 		}
 	}
 }
 
-func (t *tenantManager) setQueuers(queueNames []string) {
+func (t *tenantManager) setQueuers(resourceIds []string) {
 	t.queuersMu.Lock()
 	defer t.queuersMu.Unlock()
 
-	queueNamesSet := make(map[string]struct{}, len(queueNames))
+	resourceIdsSet := make(map[string]struct{}, len(resourceIds))
 
-	for _, queueName := range queueNames {
-		queueNamesSet[queueName] = struct{}{}
+	for _, resourceId := range resourceIds {
+		resourceIdsSet[resourceId] = struct{}{}
 	}
 
-	newQueueArr := make([]*Queuer, 0, len(queueNames))
+	newQueueArr := make([]*Queuer, 0, len(resourceIds))
 
 	for _, q := range t.queuers {
-		if _, ok := queueNamesSet[q.queueName]; ok {
+		if _, ok := resourceIdsSet[q.shard.resourceId()]; ok {
 			newQueueArr = append(newQueueArr, q)
 
 			// delete from set
-			delete(queueNamesSet, q.queueName)
+			delete(resourceIdsSet, q.shard.resourceId())
 		} else {
 			// if not in new set, cleanup
 			go q.Cleanup()
 		}
 	}
 
-	for queueName := range queueNamesSet {
-		newQueueArr = append(newQueueArr, newQueuer(t.cf, t.tenantId, queueName, t.scheduler, t.eventBuffer, t.resultsCh))
+	for resourceId := range resourceIdsSet {
+		q := newQueuer(t.cf, t.tenantId, t.cf.parseQueueShard(resourceId), t.scheduler, t.resultsCh, t.leaseManager.isQueuePaused, t.budget, t.tombstones, t.leaseManager.queueFencingToken)
+
+		// a warm standby which has just acquired queue leases should start queueing immediately
+		if t.cf.warmStandby {
+			q.queue()
+		}
+
+		newQueueArr = append(newQueueArr, q)
 	}
 
 	t.queuers = newQueueArr
@@ -171,16 +187,22 @@ func (t *tenantManager) replenish(ctx context.Context) {
 	}
 }
 
+func (t *tenantManager) returnRateLimits(assigned []*AssignedQueueItem) {
+	for _, a := range assigned {
+		if len(a.RateLimits) > 0 {
+			t.rl.returnUnits(a.RateLimits)
+		}
+	}
+}
+
+// queue notifies the queuers for a queue. A sharded queue may have queuers for multiple shards.
 func (t *tenantManager) queue(queueName string) {
 	t.queuersMu.RLock()
+	defer t.queuersMu.RUnlock()
 
 	for _, q := range t.queuers {
 		if q.queueName == queueName {
-			t.queuersMu.RUnlock()
 			q.queue()
-			return
 		}
 	}
-
-	t.queuersMu.RUnlock()
 }
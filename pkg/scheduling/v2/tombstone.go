@@ -0,0 +1,125 @@
+package v2
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/sqlchelpers"
+)
+
+// tombstoneTTL is how long a tombstone is kept. It only needs to outlive queue items which were read from
+// the database before the step run was cancelled, and assignments which are in flight.
+const tombstoneTTL = time.Minute
+
+// Tombstone drops cancelled step runs from the tenant's queues. Queued items for the step runs are removed
+// from the in-memory queues, and slots and rate limit units which were assigned to them but not yet written
+// to the database are released. If queueName is empty, all queues for the tenant are checked.
+func (p *SchedulingPool) Tombstone(ctx context.Context, tenantId, queueName string, stepRunIds ...string) {
+	if tm := p.getTenantManager(tenantId, false); tm != nil {
+		tm.tombstone(queueName, stepRunIds)
+	}
+}
+
+func (t *tenantManager) tombstone(queueName string, stepRunIds []string) {
+	t.tombstones.add(stepRunIds)
+
+	t.queuersMu.RLock()
+	defer t.queuersMu.RUnlock()
+
+	for _, q := range t.queuers {
+		if queueName == "" || q.queueName == queueName {
+			q.dropTombstoned()
+		}
+	}
+}
+
+// tombstones tracks step runs which were cancelled while queued.
+type tombstones struct {
+	mu         sync.RWMutex
+	stepRunIds map[string]time.Time
+}
+
+func newTombstones() *tombstones {
+	return &tombstones{
+		stepRunIds: make(map[string]time.Time),
+	}
+}
+
+func (t *tombstones) add(stepRunIds []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+
+	// prune expired tombstones whenever new ones are added, so the map stays bounded
+	for id, expiresAt := range t.stepRunIds {
+		if now.After(expiresAt) {
+			delete(t.stepRunIds, id)
+		}
+	}
+
+	for _, id := range stepRunIds {
+		t.stepRunIds[id] = now.Add(tombstoneTTL)
+	}
+}
+
+func (t *tombstones) has(stepRunId string) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	expiresAt, ok := t.stepRunIds[stepRunId]
+
+	return ok && time.Now().Before(expiresAt)
+}
+
+func (t *tombstones) hasQueueItem(qi *dbsqlc.QueueItem) bool {
+	return t.has(sqlchelpers.UUIDToStr(qi.StepRunId))
+}
+
+// dropTombstoned removes tombstoned queue items which are waiting in the queuer. Items which are currently
+// being assigned are released when they are flushed.
+func (q *Queuer) dropTombstoned() {
+	q.unassignedMu.Lock()
+	defer q.unassignedMu.Unlock()
+
+	for id, qi := range q.unassigned {
+		if q.tombstones.hasQueueItem(qi) {
+			delete(q.unassigned, id)
+		}
+	}
+}
+
+// releaseTombstoned releases the slots and rate limit units of assigned queue items which were tombstoned
+// during the assignment, and returns the remaining assigned items.
+func (q *Queuer) releaseTombstoned(assigned []*AssignedQueueItem) []*AssignedQueueItem {
+	remaining := make([]*AssignedQueueItem, 0, len(assigned))
+	nackIds := make([]int, 0)
+	tombstoned := make([]int64, 0)
+
+	for _, assignedItem := range assigned {
+		if q.tombstones.hasQueueItem(assignedItem.QueueItem) {
+			nackIds = append(nackIds, assignedItem.AckId)
+			tombstoned = append(tombstoned, assignedItem.QueueItem.ID)
+			continue
+		}
+
+		remaining = append(remaining, assignedItem)
+	}
+
+	if len(tombstoned) == 0 {
+		return assigned
+	}
+
+	q.s.nack(nackIds)
+
+	q.unackedMu.Lock()
+	defer q.unackedMu.Unlock()
+
+	for _, id := range tombstoned {
+		delete(q.unacked, id)
+	}
+
+	return remaining
+}
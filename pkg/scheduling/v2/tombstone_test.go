@@ -0,0 +1,30 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTombstones(t *testing.T) {
+	ts := newTombstones()
+
+	ts.add([]string{"step-run-1"})
+
+	assert.True(t, ts.has("step-run-1"))
+	assert.False(t, ts.has("step-run-2"))
+}
+
+func TestTombstones_Expire(t *testing.T) {
+	ts := newTombstones()
+
+	ts.stepRunIds["step-run-1"] = time.Now().Add(-time.Second)
+
+	assert.False(t, ts.has("step-run-1"))
+
+	ts.add([]string{"step-run-2"})
+
+	assert.NotContains(t, ts.stepRunIds, "step-run-1")
+	assert.True(t, ts.has("step-run-2"))
+}
@@ -1,11 +1,44 @@
 package v2
 
 import (
+	"sync/atomic"
+
 	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
 )
 
 type worker struct {
 	*ListActiveWorkersResult
+
+	// draining is shared between all generations of the same worker, so that slots which were
+	// created before the worker started draining stop being assigned as soon as the worker list
+	// is refreshed.
+	draining *atomic.Bool
+}
+
+func newWorker(w *ListActiveWorkersResult, prev *worker) *worker {
+	var draining *atomic.Bool
+
+	if prev != nil && prev.draining != nil {
+		draining = prev.draining
+	} else {
+		draining = &atomic.Bool{}
+	}
+
+	draining.Store(w.Status == WorkerStatusDraining)
+
+	return &worker{
+		ListActiveWorkersResult: w,
+		draining:                draining,
+	}
+}
+
+// isDraining returns true if the worker is draining and should not be assigned new runs.
+func (w *worker) isDraining() bool {
+	if w == nil || w.draining == nil {
+		return false
+	}
+
+	return w.draining.Load()
 }
 
 // computeWeight computes the weight of a worker based on the desired labels. If the worker does not
@@ -14,6 +47,33 @@ func (w *worker) computeWeight(s []*dbsqlc.GetDesiredLabelsRow) int {
 	totalWeight := 0
 
 	for _, desiredLabel := range s {
+		if desiredLabel.Key == RegionLabelKey {
+			weight := w.computeRegionWeight(desiredLabel)
+
+			if weight < 0 {
+				return -1
+			}
+
+			totalWeight += weight
+			continue
+		}
+
+		if desiredLabel.Key == PoolLabelKey {
+			weight := w.computePoolWeight(desiredLabel)
+
+			if weight < 0 {
+				return -1
+			}
+
+			totalWeight += weight
+			continue
+		}
+
+		// slot types are matched against each slot rather than the worker
+		if desiredLabel.Key == SlotTypeLabelKey {
+			continue
+		}
+
 		labelFound := false
 
 		for _, workerLabel := range w.Labels {
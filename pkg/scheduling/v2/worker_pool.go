@@ -0,0 +1,31 @@
+package v2
+
+import (
+	"github.com/hatchet-dev/hatchet/pkg/repository/prisma/dbsqlc"
+)
+
+// PoolLabelKey is the reserved desired label key for the worker pool a step runs in. It is matched against the
+// pool the worker registered with rather than its labels.
+const PoolLabelKey = "hatchet.pool"
+
+// toPoolLabel converts a step's pool into a desired label. Pools are always required, so steps are never
+// assigned to workers outside of their pool.
+func toPoolLabel(row *dbsqlc.GetDesiredPoolsRow) *dbsqlc.GetDesiredLabelsRow {
+	return &dbsqlc.GetDesiredLabelsRow{
+		Key:        PoolLabelKey,
+		StrValue:   row.Pool,
+		Required:   true,
+		Comparator: dbsqlc.WorkerLabelComparatorEQUAL,
+		StepId:     row.StepId,
+	}
+}
+
+// computePoolWeight computes the weight of a worker for a pool label. If the worker is not in the pool, the
+// weight is -1.
+func (w *worker) computePoolWeight(desiredLabel *dbsqlc.GetDesiredLabelsRow) int {
+	if w.Pool != "" && desiredLabel.StrValue.Valid && w.Pool == desiredLabel.StrValue.String {
+		return int(desiredLabel.Weight)
+	}
+
+	return -1
+}
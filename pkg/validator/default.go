@@ -22,6 +22,8 @@ const (
 	CronErr        = "Invalid cron expression"
 	DurationErr    = "Invalid duration. Durations must be in the format <number><unit>, where unit is one of: 's', 'm', 'h'"
 	CELExprErr     = "Invalid CEL expression"
+	TimezoneErr    = "Invalid timezone. Timezones must be IANA timezone names, for example 'America/New_York'"
+	JSONSchemaErr  = "Invalid JSON schema"
 )
 
 type APIErrors gen.APIErrors
@@ -135,10 +137,16 @@ func getErrorStr(errObj *ValidationErrObject) string {
 		return errObj.SafeExternalError(CronErr)
 	case "duration":
 		return errObj.SafeExternalError(DurationErr)
+	case "timezone":
+		return errObj.SafeExternalError(TimezoneErr)
 	case "celworkflowrunstr":
 		return errObj.SafeExternalError(CELExprErr)
 	case "celsteprunstr":
 		return errObj.SafeExternalError(CELExprErr)
+	case "celworkflowrunoutputstr":
+		return errObj.SafeExternalError(CELExprErr)
+	case "jsonschema":
+		return errObj.SafeExternalError(JSONSchemaErr)
 	default:
 		return errObj.SafeExternalError("")
 	}
@@ -2,6 +2,7 @@ package validator
 
 import (
 	"encoding/json"
+	"reflect"
 	"regexp"
 	"time"
 	"unicode"
@@ -11,6 +12,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/hatchet-dev/hatchet/internal/cel"
+	"github.com/hatchet-dev/hatchet/internal/schema"
 	"github.com/hatchet-dev/hatchet/pkg/client/types"
 )
 
@@ -77,6 +79,26 @@ func newValidator() *validator.Validate {
 		return err == nil
 	})
 
+	_ = validate.RegisterValidation("celworkflowrunoutputstr", func(fl validator.FieldLevel) bool {
+		_, err := celParser.ParseWorkflowRunOutput(fl.Field().String())
+
+		return err == nil
+	})
+
+	_ = validate.RegisterValidation("jsonschema", func(fl validator.FieldLevel) bool {
+		var schemaBytes []byte
+
+		if fl.Field().Kind() == reflect.String {
+			schemaBytes = []byte(fl.Field().String())
+		} else {
+			schemaBytes = fl.Field().Bytes()
+		}
+
+		_, err := schema.ParseJSONSchema(schemaBytes)
+
+		return err == nil
+	})
+
 	return validate
 }
 
@@ -77,3 +77,47 @@ func TestValidatorInvalidDuration(t *testing.T) {
 
 	assert.ErrorContains(t, err, "validation for 'Duration' failed on the 'duration' tag", "should throw error on invalid duration")
 }
+
+func TestValidatorWorkflowRunOutput(t *testing.T) {
+	v := newValidator()
+
+	type outputResource struct {
+		Output string `validate:"celworkflowrunoutputstr"`
+	}
+
+	err := v.Struct(&outputResource{
+		Output: `{"tracking": steps.ship.tracking}`,
+	})
+
+	assert.NoError(t, err, "no error")
+
+	err = v.Struct(&outputResource{
+		Output: `parents.ship.tracking`,
+	})
+
+	assert.ErrorContains(t, err, "validation for 'Output' failed on the 'celworkflowrunoutputstr' tag", "should throw error on undeclared variable")
+}
+
+func TestValidatorJSONSchema(t *testing.T) {
+	v := newValidator()
+
+	type schemaResource struct {
+		Schema []byte `validate:"omitempty,jsonschema"`
+	}
+
+	err := v.Struct(&schemaResource{
+		Schema: []byte(`{"type": "object", "properties": {"name": {"type": "string"}}}`),
+	})
+
+	assert.NoError(t, err, "no error")
+
+	err = v.Struct(&schemaResource{})
+
+	assert.NoError(t, err, "no error on empty schema")
+
+	err = v.Struct(&schemaResource{
+		Schema: []byte(`{"type": "object", "properties": {"name": {"type": "strng"}}}`),
+	})
+
+	assert.ErrorContains(t, err, "validation for 'Schema' failed on the 'jsonschema' tag", "should throw error on invalid type")
+}
@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/rs/zerolog"
 
+	"github.com/hatchet-dev/hatchet/internal/integrations/blobstore"
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
 	"github.com/hatchet-dev/hatchet/pkg/client"
 )
 
@@ -51,8 +54,14 @@ type HatchetContext interface {
 
 	Log(message string)
 
+	LogWithFields(level client.LogLevel, message string, fields map[string]interface{})
+
 	StreamEvent(message []byte)
 
+	StreamChunk(message []byte) error
+
+	ReportProgress(percent float64, message string, data any) error
+
 	SpawnWorkflow(workflowName string, input any, opts *SpawnWorkflowOpts) (*client.Workflow, error)
 
 	SpawnWorkflows(childWorkflows []*SpawnWorkflowsOpts) ([]*client.Workflow, error)
@@ -63,10 +72,18 @@ type HatchetContext interface {
 
 	RetryCount() int
 
+	MapItem(target interface{}) error
+
+	MapIndex() int
+
 	client() client.Client
 
 	action() *client.Action
 
+	closeStreamChunks()
+
+	closeLogs()
+
 	index() int
 	inc()
 }
@@ -91,6 +108,8 @@ type StepRunData struct {
 	TriggeredBy        TriggeredBy            `json:"triggered_by"`
 	Parents            map[string]StepData    `json:"parents"`
 	AdditionalMetadata map[string]string      `json:"additional_metadata"`
+	MapItem            interface{}            `json:"map_item,omitempty"`
+	MapIndex           *int                   `json:"map_index,omitempty"`
 }
 
 type StepData map[string]interface{}
@@ -109,6 +128,15 @@ type hatchetContext struct {
 	indexMu    sync.Mutex
 	listener   *client.WorkflowRunsListener
 	listenerMu sync.Mutex
+
+	// guards the parent outputs in stepData, which are replaced when they're fetched from the blob store
+	parentsMu sync.Mutex
+
+	chunks   *chunkStreamer
+	chunksMu sync.Mutex
+
+	logs   *logBatcher
+	logsMu sync.Mutex
 }
 
 type hatchetWorkerContext struct {
@@ -174,11 +202,34 @@ func (h *hatchetContext) GetContext() context.Context {
 }
 
 func (h *hatchetContext) StepOutput(step string, target interface{}) error {
-	if val, ok := h.stepData.Parents[step]; ok {
-		return toTarget(val, target)
+	h.parentsMu.Lock()
+	defer h.parentsMu.Unlock()
+
+	val, ok := h.stepData.Parents[step]
+
+	if !ok {
+		return fmt.Errorf("step %s not found in action payload", step)
+	}
+
+	// large outputs are replaced with a reference to the blob store by the engine, and are only fetched when read
+	if ref, isRef := blobstore.RefFromValue(map[string]interface{}(val)); isRef {
+		dataBytes, err := h.c.Dispatcher().GetBlob(h, ref.Key)
+
+		if err != nil {
+			return fmt.Errorf("could not get output of step %s from blob store: %w", step, err)
+		}
+
+		output := StepData{}
+
+		if err := json.Unmarshal(dataBytes, &output); err != nil {
+			return fmt.Errorf("could not unmarshal output of step %s: %w", step, err)
+		}
+
+		h.stepData.Parents[step] = output
+		val = output
 	}
 
-	return fmt.Errorf("step %s not found in action payload", step)
+	return toTarget(val, target)
 }
 
 func (h *hatchetContext) TriggeredByEvent() bool {
@@ -213,6 +264,29 @@ func (h *hatchetContext) Log(message string) {
 	}
 }
 
+// LogWithFields puts a structured log line of the step run with the given level and fields, which can be queried
+// and tailed through the API. Log lines are sent in batches in the background, and the step run completes after its
+// log lines were sent.
+func (h *hatchetContext) LogWithFields(level client.LogLevel, message string, fields map[string]interface{}) {
+	h.logsMu.Lock()
+
+	if h.logs == nil {
+		h.logs = newLogBatcher(h.c, h.l)
+	}
+
+	logs := h.logs
+
+	h.logsMu.Unlock()
+
+	logs.add(&client.LogLine{
+		StepRunId: h.a.StepRunId,
+		CreatedAt: time.Now().UTC(),
+		Level:     level,
+		Message:   message,
+		Fields:    fields,
+	})
+}
+
 func (h *hatchetContext) ReleaseSlot() error {
 	err := h.c.Dispatcher().ReleaseSlot(h, h.a.StepRunId)
 
@@ -241,10 +315,85 @@ func (h *hatchetContext) StreamEvent(message []byte) {
 	}
 }
 
+// StreamChunk sends a piece of the incremental output of the step run, such as the tokens of an LLM response, to the
+// clients which are subscribed to the workflow run. Chunks are sent in the background and received by the clients
+// in order. When the dispatcher can't keep up, StreamChunk blocks or drops the oldest buffered chunk depending on the
+// stream chunk policy of the worker. The step run completes after its chunks were sent.
+func (h *hatchetContext) StreamChunk(message []byte) error {
+	h.chunksMu.Lock()
+
+	if h.chunks == nil {
+		h.chunks = newChunkStreamer(h.Context, h.c, h.l, h.a.StepRunId, h.w.worker)
+	}
+
+	chunks := h.chunks
+
+	h.chunksMu.Unlock()
+
+	return chunks.add(message)
+}
+
+// ReportProgress reports the progress of a long-running step run as a percentage between 0 and 100, along with an
+// optional message and optional data which is marshalled to JSON. The latest progress is stored with the step run
+// and sent to the clients which are subscribed to the workflow run, so they can show it while the step runs.
+func (h *hatchetContext) ReportProgress(percent float64, message string, data any) error {
+	progress := &client.StepRunProgress{
+		Percent: percent,
+		Data:    data,
+	}
+
+	if message != "" {
+		progress.Message = &message
+	}
+
+	return h.c.Dispatcher().ReportProgress(h, h.a.StepRunId, progress)
+}
+
+// closeStreamChunks waits until the stream chunks of the step run were sent.
+func (h *hatchetContext) closeStreamChunks() {
+	h.chunksMu.Lock()
+	chunks := h.chunks
+	h.chunksMu.Unlock()
+
+	if chunks != nil {
+		chunks.close()
+	}
+}
+
+// closeLogs sends the buffered log lines of the step run.
+func (h *hatchetContext) closeLogs() {
+	h.logsMu.Lock()
+	logs := h.logs
+	h.logsMu.Unlock()
+
+	if logs != nil {
+		logs.close()
+	}
+}
+
 func (h *hatchetContext) RetryCount() int {
 	return int(h.a.RetryCount)
 }
 
+// MapItem reads the element of the list which a run of a map step was created for into target.
+func (h *hatchetContext) MapItem(target interface{}) error {
+	if h.stepData.MapIndex == nil {
+		return fmt.Errorf("step %s is not a map step", h.a.StepName)
+	}
+
+	return toTarget(h.stepData.MapItem, target)
+}
+
+// MapIndex returns the index of the element of the list which a run of a map step was created for, or -1 if the
+// step is not a map step.
+func (h *hatchetContext) MapIndex() int {
+	if h.stepData.MapIndex == nil {
+		return -1
+	}
+
+	return *h.stepData.MapIndex
+}
+
 func (h *hatchetContext) index() int {
 	return h.i
 }
@@ -315,6 +464,7 @@ func (h *hatchetContext) SpawnWorkflow(workflowName string, input any, opts *Spa
 			ChildKey:           opts.Key,
 			DesiredWorkerId:    desiredWorker,
 			AdditionalMetadata: opts.AdditionalMetadata,
+			TraceContext:       telemetry.GetTraceContext(h),
 		},
 	)
 
@@ -375,6 +525,7 @@ func (h *hatchetContext) SpawnWorkflows(childWorkflows []*SpawnWorkflowsOpts) ([
 				ChildKey:           c.Key,
 				DesiredWorkerId:    desiredWorker,
 				AdditionalMetadata: c.AdditionalMetadata,
+				TraceContext:       telemetry.GetTraceContext(h),
 			},
 		}
 	}
@@ -441,6 +592,17 @@ func (h *hatchetContext) populateStepData() error {
 		jsonBytes = []byte("{}")
 	}
 
+	// large inputs are replaced with a reference to the blob store by the engine
+	if ref, ok := blobstore.ParseRef(jsonBytes); ok {
+		dataBytes, err := h.c.Dispatcher().GetBlob(h, ref.Key)
+
+		if err != nil {
+			return fmt.Errorf("could not get input from blob store: %w", err)
+		}
+
+		jsonBytes = dataBytes
+	}
+
 	err := json.Unmarshal(jsonBytes, h.stepData)
 
 	if err != nil {
@@ -0,0 +1,73 @@
+package worker
+
+import (
+	"math"
+	"runtime/debug"
+	"runtime/metrics"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+// HealthReporter adds to the health which the worker reports with its heartbeats, for example the cpu utilization
+// of the container or custom gauges. The active slots and, if a memory limit is set, the memory utilization are
+// already set when it's called.
+type HealthReporter func(health *client.WorkerHealth)
+
+// WithHealthReporter sets a function which adds to the health which the worker reports with its heartbeats.
+func WithHealthReporter(reporter HealthReporter) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.healthReporter = reporter
+	}
+}
+
+const totalMemoryMetric = "/memory/classes/total:bytes"
+
+func (w *Worker) health() *client.WorkerHealth {
+	activeSlots := int(w.activeRuns.Load())
+
+	health := &client.WorkerHealth{
+		ActiveSlots: &activeSlots,
+	}
+
+	if memoryUtilization, ok := memoryUtilization(); ok {
+		health.MemoryUtilization = &memoryUtilization
+	}
+
+	if w.healthReporter != nil {
+		w.healthReporter(health)
+	}
+
+	return health
+}
+
+// stepRunIds returns the ids of the step runs which are running on the worker.
+func (w *Worker) stepRunIds() []string {
+	ids := []string{}
+
+	w.activeStepRuns.Range(func(key, _ any) bool {
+		ids = append(ids, key.(string))
+		return true
+	})
+
+	return ids
+}
+
+// memoryUtilization returns the memory which is mapped by the Go runtime relative to the memory limit of the
+// process, which is only known if it was set with GOMEMLIMIT or debug.SetMemoryLimit.
+func memoryUtilization() (float64, bool) {
+	limit := debug.SetMemoryLimit(-1)
+
+	if limit <= 0 || limit == math.MaxInt64 {
+		return 0, false
+	}
+
+	samples := []metrics.Sample{{Name: totalMemoryMetric}}
+
+	metrics.Read(samples)
+
+	if samples[0].Value.Kind() != metrics.KindUint64 {
+		return 0, false
+	}
+
+	return min(float64(samples[0].Value.Uint64())/float64(limit), 1), true
+}
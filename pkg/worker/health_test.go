@@ -0,0 +1,65 @@
+package worker
+
+import (
+	"runtime/debug"
+	"testing"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+func TestHealth(t *testing.T) {
+	w := &Worker{
+		healthReporter: func(health *client.WorkerHealth) {
+			cpuUtilization := 0.5
+			health.CpuUtilization = &cpuUtilization
+			health.Gauges = map[string]float64{"connections": 3}
+		},
+	}
+
+	w.activeRuns.Add(2)
+
+	health := w.health()
+
+	if health.ActiveSlots == nil || *health.ActiveSlots != 2 {
+		t.Errorf("expected 2 active slots, got %v", health.ActiveSlots)
+	}
+
+	if health.CpuUtilization == nil || *health.CpuUtilization != 0.5 {
+		t.Errorf("expected the cpu utilization of the reporter, got %v", health.CpuUtilization)
+	}
+
+	if health.Gauges["connections"] != 3 {
+		t.Errorf("expected the gauges of the reporter, got %v", health.Gauges)
+	}
+}
+
+func TestStepRunIds(t *testing.T) {
+	w := &Worker{}
+
+	w.activeStepRuns.Store("a", struct{}{})
+	w.activeStepRuns.Store("b", struct{}{})
+	w.activeStepRuns.Delete("a")
+
+	ids := w.stepRunIds()
+
+	if len(ids) != 1 || ids[0] != "b" {
+		t.Errorf("expected the running step run, got %v", ids)
+	}
+}
+
+func TestMemoryUtilization(t *testing.T) {
+	prev := debug.SetMemoryLimit(-1)
+	defer debug.SetMemoryLimit(prev)
+
+	debug.SetMemoryLimit(1 << 40)
+
+	utilization, ok := memoryUtilization()
+
+	if !ok {
+		t.Fatal("expected the memory utilization to be known when a memory limit is set")
+	}
+
+	if utilization <= 0 || utilization > 1 {
+		t.Errorf("expected a memory utilization between 0 and 1, got %f", utilization)
+	}
+}
@@ -0,0 +1,130 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+const (
+	// logBatchSize is the number of structured log lines which are sent in a single request.
+	logBatchSize = 100
+
+	// logFlushInterval is how often the buffered structured log lines of a step run are sent.
+	logFlushInterval = time.Second
+)
+
+// logBatcher sends the structured log lines of a step run to the engine in batches.
+type logBatcher struct {
+	c client.Client
+	l *zerolog.Logger
+
+	// mu guards lines and closed
+	mu     sync.Mutex
+	lines  []*client.LogLine
+	closed bool
+
+	// sendMu serializes sending batches, so the log lines are stored in the order they were logged
+	sendMu sync.Mutex
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func newLogBatcher(c client.Client, l *zerolog.Logger) *logBatcher {
+	b := &logBatcher{
+		c:    c,
+		l:    l,
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+
+	go b.run()
+
+	return b
+}
+
+func (b *logBatcher) add(line *client.LogLine) {
+	b.mu.Lock()
+
+	b.lines = append(b.lines, line)
+
+	// once the step run has finished, log lines are sent right away
+	flush := b.closed || len(b.lines) >= logBatchSize
+
+	b.mu.Unlock()
+
+	if flush {
+		b.flush()
+	}
+}
+
+func (b *logBatcher) run() {
+	defer close(b.done)
+
+	ticker := time.NewTicker(logFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			b.flush()
+		case <-b.stop:
+			return
+		}
+	}
+}
+
+func (b *logBatcher) flush() {
+	b.sendMu.Lock()
+	defer b.sendMu.Unlock()
+
+	b.mu.Lock()
+	lines := b.lines
+	b.lines = nil
+	b.mu.Unlock()
+
+	if len(lines) > 0 {
+		b.sendLocked(lines)
+	}
+}
+
+func (b *logBatcher) sendLocked(lines []*client.LogLine) {
+	// the log lines are sent after the step run was cancelled as well, since they're most useful to debug it
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stored, err := b.c.Event().PutLogs(ctx, lines)
+
+	if err != nil {
+		b.l.Err(err).Msgf("could not put %d log lines", len(lines))
+		return
+	}
+
+	if stored < len(lines) {
+		b.l.Warn().Msgf("%d log lines were dropped, since the step run has reached the maximum number of log lines", len(lines)-stored)
+	}
+}
+
+// close sends the buffered log lines, and stops sending them periodically.
+func (b *logBatcher) close() {
+	b.mu.Lock()
+
+	if b.closed {
+		b.mu.Unlock()
+		return
+	}
+
+	b.closed = true
+
+	b.mu.Unlock()
+
+	close(b.stop)
+	<-b.done
+
+	b.flush()
+}
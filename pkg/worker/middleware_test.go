@@ -67,14 +67,34 @@ func (c *testHatchetContext) RefreshTimeout(incrementIntervalBy string) error {
 	panic("not implemented")
 }
 
+func (c *testHatchetContext) LogWithFields(level client.LogLevel, message string, fields map[string]interface{}) {
+	panic("not implemented")
+}
+
 func (c *testHatchetContext) StreamEvent(message []byte) {
 	panic("not implemented")
 }
 
+func (c *testHatchetContext) StreamChunk(message []byte) error {
+	panic("not implemented")
+}
+
+func (c *testHatchetContext) ReportProgress(percent float64, message string, data any) error {
+	panic("not implemented")
+}
+
 func (c *testHatchetContext) RetryCount() int {
 	panic("not implemented")
 }
 
+func (c *testHatchetContext) MapItem(target interface{}) error {
+	panic("not implemented")
+}
+
+func (c *testHatchetContext) MapIndex() int {
+	panic("not implemented")
+}
+
 func (c *testHatchetContext) action() *client.Action {
 	panic("not implemented")
 }
@@ -83,6 +103,14 @@ func (c *testHatchetContext) index() int {
 	panic("not implemented")
 }
 
+func (c *testHatchetContext) closeStreamChunks() {
+	panic("not implemented")
+}
+
+func (c *testHatchetContext) closeLogs() {
+	panic("not implemented")
+}
+
 func (c *testHatchetContext) inc() {
 	panic("not implemented")
 }
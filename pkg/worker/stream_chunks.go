@@ -0,0 +1,177 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/hatchet-dev/hatchet/pkg/client"
+)
+
+// StreamChunkPolicy decides what happens to a stream chunk when the buffer of the step run is full, because the
+// dispatcher can't keep up with the chunks.
+type StreamChunkPolicy string
+
+const (
+	// StreamChunkPolicyBlock blocks StreamChunk until there's room in the buffer, which slows down the step run to
+	// the pace of its subscribers.
+	StreamChunkPolicyBlock StreamChunkPolicy = "block"
+
+	// StreamChunkPolicyDropOldest drops the oldest chunk of the buffer to make room for the new chunk, so the step run
+	// is never slowed down.
+	StreamChunkPolicyDropOldest StreamChunkPolicy = "drop_oldest"
+)
+
+// DefaultStreamChunkBufferSize is the number of stream chunks which are buffered per step run by default.
+const DefaultStreamChunkBufferSize = 100
+
+// chunkStreamer sends the stream chunks of a step run to the dispatcher in the background.
+type chunkStreamer struct {
+	ctx context.Context
+
+	c         client.Client
+	l         *zerolog.Logger
+	stepRunId string
+	policy    StreamChunkPolicy
+	persist   bool
+
+	// mu serializes adding chunks to the buffer, so they're buffered in the order of their index, and guards closed
+	// and next
+	mu     sync.Mutex
+	closed bool
+	next   int64
+
+	chunks chan *client.StreamChunk
+	done   chan struct{}
+}
+
+func newChunkStreamer(ctx context.Context, c client.Client, l *zerolog.Logger, stepRunId string, w *Worker) *chunkStreamer {
+	bufferSize := w.streamChunkBufferSize
+
+	if bufferSize <= 0 {
+		bufferSize = DefaultStreamChunkBufferSize
+	}
+
+	policy := w.streamChunkPolicy
+
+	if policy == "" {
+		policy = StreamChunkPolicyBlock
+	}
+
+	s := &chunkStreamer{
+		ctx:       ctx,
+		c:         c,
+		l:         l,
+		stepRunId: stepRunId,
+		policy:    policy,
+		persist:   w.persistStreamChunks,
+		chunks:    make(chan *client.StreamChunk, bufferSize),
+		done:      make(chan struct{}),
+	}
+
+	go s.run()
+
+	return s
+}
+
+func (s *chunkStreamer) add(message []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return fmt.Errorf("step run %s has finished streaming", s.stepRunId)
+	}
+
+	chunk := &client.StreamChunk{
+		StepRunId: s.stepRunId,
+		Index:     s.next,
+		CreatedAt: time.Now().UTC(),
+		Message:   bytes.Clone(message),
+		Persist:   s.persist,
+	}
+
+	s.next++
+
+	switch s.policy {
+	case StreamChunkPolicyDropOldest:
+		for {
+			select {
+			case s.chunks <- chunk:
+				return nil
+			default:
+			}
+
+			select {
+			case dropped := <-s.chunks:
+				s.l.Warn().Msgf("stream chunk buffer of step run %s is full, dropped chunk %d", s.stepRunId, dropped.Index)
+			default:
+			}
+		}
+	default:
+		select {
+		case s.chunks <- chunk:
+			return nil
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		}
+	}
+}
+
+func (s *chunkStreamer) run() {
+	defer close(s.done)
+
+	var sender client.StreamChunkSender
+
+	for {
+		select {
+		case chunk, ok := <-s.chunks:
+			if !ok {
+				if sender != nil {
+					if _, err := sender.Close(); err != nil {
+						s.l.Error().Err(err).Msgf("could not close stream chunks of step run %s", s.stepRunId)
+					}
+				}
+
+				return
+			}
+
+			if sender == nil {
+				var err error
+
+				sender, err = s.c.Dispatcher().PutStreamChunks(s.ctx)
+
+				if err != nil {
+					s.l.Error().Err(err).Msgf("could not stream chunk %d of step run %s", chunk.Index, s.stepRunId)
+					continue
+				}
+			}
+
+			if err := sender.Send(chunk); err != nil {
+				s.l.Error().Err(err).Msgf("could not stream chunk %d of step run %s", chunk.Index, s.stepRunId)
+
+				// a new stream is opened for the next chunk
+				sender = nil
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// close waits until the buffered chunks were received by the dispatcher.
+func (s *chunkStreamer) close() {
+	s.mu.Lock()
+
+	if !s.closed {
+		s.closed = true
+		close(s.chunks)
+	}
+
+	s.mu.Unlock()
+
+	<-s.done
+}
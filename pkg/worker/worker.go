@@ -8,10 +8,14 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 
+	"github.com/hatchet-dev/hatchet/internal/telemetry"
 	"github.com/hatchet-dev/hatchet/pkg/client"
 	"github.com/hatchet-dev/hatchet/pkg/client/compute"
 	"github.com/hatchet-dev/hatchet/pkg/client/types"
@@ -103,6 +107,26 @@ type Worker struct {
 
 	labels map[string]interface{}
 
+	region *string
+
+	pool *string
+
+	slotPools map[string]int
+
+	streamChunkBufferSize int
+
+	streamChunkPolicy StreamChunkPolicy
+
+	persistStreamChunks bool
+
+	healthReporter HealthReporter
+
+	// the number of step runs which are running on the worker
+	activeRuns atomic.Int64
+
+	// the ids of the step runs which are running on the worker, which are sent with every heartbeat
+	activeStepRuns sync.Map
+
 	id *string
 }
 
@@ -120,6 +144,18 @@ type WorkerOpts struct {
 	actions []string
 
 	labels map[string]interface{}
+
+	region *string
+
+	pool *string
+
+	slotPools map[string]int
+
+	streamChunkBufferSize int
+	streamChunkPolicy     StreamChunkPolicy
+	persistStreamChunks   bool
+
+	healthReporter HealthReporter
 }
 
 func defaultWorkerOpts() *WorkerOpts {
@@ -188,6 +224,49 @@ func WithLabels(labels map[string]interface{}) WorkerOpt {
 	}
 }
 
+// WithRegion sets the region the worker runs in. Workflows which pin or prefer a region are assigned to
+// workers in that region.
+func WithRegion(region string) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.region = &region
+	}
+}
+
+// WithPool adds the worker to a worker pool. Workflows and steps which target the pool are only assigned to
+// workers in the pool, while workers in a pool can still run steps which don't target any pool.
+func WithPool(pool string) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.pool = &pool
+	}
+}
+
+// WithSlotPools registers additional slot pools on the worker, keyed by slot type (for example, {"gpu": 1}).
+// Steps which set a slot type only consume slots from the matching pool, while all other steps consume slots
+// from the default pool, which is sized by WithMaxRuns.
+func WithSlotPools(slotPools map[string]int) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.slotPools = slotPools
+	}
+}
+
+// WithStreamChunkBuffer sets how many stream chunks are buffered per step run while they're sent to the dispatcher,
+// and what happens to a chunk when the buffer is full. Defaults to DefaultStreamChunkBufferSize and
+// StreamChunkPolicyBlock.
+func WithStreamChunkBuffer(size int, policy StreamChunkPolicy) WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.streamChunkBufferSize = size
+		opts.streamChunkPolicy = policy
+	}
+}
+
+// WithPersistedStreamChunks stores the stream chunks of step runs as their logs, in addition to sending them to the
+// subscribed clients. Chunks are split into log lines at newlines.
+func WithPersistedStreamChunks() WorkerOpt {
+	return func(opts *WorkerOpts) {
+		opts.persistStreamChunks = true
+	}
+}
+
 // NewWorker creates a new worker instance
 func NewWorker(fs ...WorkerOpt) (*Worker, error) {
 	opts := defaultWorkerOpts()
@@ -207,16 +286,23 @@ func NewWorker(fs ...WorkerOpt) (*Worker, error) {
 	}
 
 	w := &Worker{
-		client:               opts.client,
-		name:                 opts.name,
-		l:                    opts.l,
-		actions:              ActionRegistry{},
-		alerter:              opts.alerter,
-		middlewares:          mws,
-		maxRuns:              opts.maxRuns,
-		initActionNames:      opts.actions,
-		labels:               opts.labels,
-		registered_workflows: map[string]bool{},
+		client:                opts.client,
+		name:                  opts.name,
+		l:                     opts.l,
+		actions:               ActionRegistry{},
+		alerter:               opts.alerter,
+		middlewares:           mws,
+		maxRuns:               opts.maxRuns,
+		initActionNames:       opts.actions,
+		labels:                opts.labels,
+		region:                opts.region,
+		pool:                  opts.pool,
+		slotPools:             opts.slotPools,
+		streamChunkBufferSize: opts.streamChunkBufferSize,
+		streamChunkPolicy:     opts.streamChunkPolicy,
+		persistStreamChunks:   opts.persistStreamChunks,
+		healthReporter:        opts.healthReporter,
+		registered_workflows:  map[string]bool{},
 	}
 
 	mws.add(w.panicMiddleware)
@@ -372,6 +458,11 @@ func (w *Worker) Start() (func() error, error) {
 		Actions:    actionNames,
 		MaxRuns:    w.maxRuns,
 		Labels:     w.labels,
+		Region:     w.region,
+		Pool:       w.pool,
+		SlotPools:  w.slotPools,
+		Health:     w.health,
+		StepRunIds: w.stepRunIds,
 	})
 
 	w.id = id
@@ -409,6 +500,14 @@ func (w *Worker) Start() (func() error, error) {
 	}()
 
 	cleanup := func() error {
+		// mark the worker as draining before stopping the listener, so that the engine stops
+		// assigning new runs to it. this is best-effort, as older engines do not support draining.
+		if w.id != nil {
+			if err := w.client.Dispatcher().DrainWorker(context.Background(), *w.id); err != nil {
+				w.l.Warn().Err(err).Msgf("could not drain worker %s", w.name)
+			}
+		}
+
 		cancel()
 
 		w.l.Debug().Msgf("worker %s is stopping...", w.name)
@@ -440,6 +539,13 @@ func (w *Worker) executeAction(ctx context.Context, assignedAction *client.Actio
 }
 
 func (w *Worker) startStepRun(ctx context.Context, assignedAction *client.Action) error {
+	// the step run continues the trace of its workflow run, so the spans which are started from the step context and
+	// the requests which the step run sends to the engine are part of it
+	ctx = telemetry.ExtractTraceContext(ctx, propagation.MapCarrier(assignedAction.TraceContext))
+
+	ctx, span := telemetry.NewSpan(ctx, "step-run")
+	defer span.End()
+
 	// send a message that the step run started
 	_, err := w.client.Dispatcher().SendStepActionEvent(
 		ctx,
@@ -462,10 +568,16 @@ func (w *Worker) startStepRun(ctx context.Context, assignedAction *client.Action
 		return fmt.Errorf("could not decode args to interface: %w", err)
 	}
 
-	runContext, cancel := context.WithCancel(context.Background())
+	runContext, cancel := context.WithCancel(trace.ContextWithSpan(context.Background(), span))
 
 	w.cancelMap.Store(assignedAction.StepRunId, cancel)
 
+	w.activeRuns.Add(1)
+	defer w.activeRuns.Add(-1)
+
+	w.activeStepRuns.Store(assignedAction.StepRunId, struct{}{})
+	defer w.activeStepRuns.Delete(assignedAction.StepRunId)
+
 	hCtx, err := newHatchetContext(runContext, assignedAction, w.client, w.l, w)
 
 	if err != nil {
@@ -495,6 +607,10 @@ func (w *Worker) startStepRun(ctx context.Context, assignedAction *client.Action
 
 			runResults := action.Run(args...)
 
+			// the stream chunks and log lines of the step run are sent before it completes
+			ctx.closeStreamChunks()
+			ctx.closeLogs()
+
 			// check whether run context was cancelled while action was running
 			select {
 			case <-ctx.Done():
@@ -661,6 +777,9 @@ func (w *Worker) getGroupKeyActionFinishedEvent(action *client.Action, output st
 func (w *Worker) sendFailureEvent(ctx HatchetContext, err error) error {
 	assignedAction := ctx.action()
 
+	ctx.closeStreamChunks()
+	ctx.closeLogs()
+
 	failureEvent := w.getActionEvent(assignedAction, client.ActionEventTypeFailed)
 
 	w.alerter.SendAlert(context.Background(), err, map[string]interface{}{
@@ -43,6 +43,68 @@ func (c cronArr) ToWorkflowTriggers(wt *types.WorkflowTriggers, namespace string
 	wt.Cron = append(wt.Cron, c...)
 }
 
+// In evaluates the cron in the given IANA timezone, for example "America/New_York", instead of UTC.
+func (c cron) In(timezone string) *cronSchedule {
+	return (&cronSchedule{crons: []string{string(c)}}).In(timezone)
+}
+
+// WithJitter delays each trigger of the cron by a random duration of up to jitter.
+func (c cron) WithJitter(jitter time.Duration) *cronSchedule {
+	return (&cronSchedule{crons: []string{string(c)}}).WithJitter(jitter)
+}
+
+// Excluding skips the cron on the dates of the exclusion calendar with the given name, for example holidays.
+func (c cron) Excluding(calendar string) *cronSchedule {
+	return (&cronSchedule{crons: []string{string(c)}}).Excluding(calendar)
+}
+
+// In evaluates the crons in the given IANA timezone, for example "America/New_York", instead of UTC.
+func (c cronArr) In(timezone string) *cronSchedule {
+	return (&cronSchedule{crons: c}).In(timezone)
+}
+
+// WithJitter delays each trigger of the crons by a random duration of up to jitter.
+func (c cronArr) WithJitter(jitter time.Duration) *cronSchedule {
+	return (&cronSchedule{crons: c}).WithJitter(jitter)
+}
+
+// Excluding skips the crons on the dates of the exclusion calendar with the given name, for example holidays.
+func (c cronArr) Excluding(calendar string) *cronSchedule {
+	return (&cronSchedule{crons: c}).Excluding(calendar)
+}
+
+// cronSchedule is a set of crons with a timezone, jitter or exclusion calendar, so that daily crons can run at
+// local time, crons shared by many workflows don't all trigger at the same second and crons can skip holidays.
+type cronSchedule struct {
+	crons             []string
+	timezone          *string
+	jitter            *time.Duration
+	exclusionCalendar *string
+}
+
+func (c *cronSchedule) In(timezone string) *cronSchedule {
+	c.timezone = &timezone
+	return c
+}
+
+func (c *cronSchedule) WithJitter(jitter time.Duration) *cronSchedule {
+	c.jitter = &jitter
+	return c
+}
+
+func (c *cronSchedule) Excluding(calendar string) *cronSchedule {
+	c.exclusionCalendar = &calendar
+	return c
+}
+
+func (c *cronSchedule) ToWorkflowTriggers(wt *types.WorkflowTriggers, namespace string) {
+	cronArr(c.crons).ToWorkflowTriggers(wt, namespace)
+
+	wt.CronTimezone = c.timezone
+	wt.CronJitter = c.jitter
+	wt.CronExclusionCalendar = c.exclusionCalendar
+}
+
 type noTrigger struct{}
 
 func NoTrigger() noTrigger {
@@ -154,6 +216,27 @@ type WorkflowJob struct {
 	ScheduleTimeout string
 
 	StickyStrategy *types.StickyStrategy
+
+	// (optional) the region to run the job's steps in
+	Region *string
+
+	// (optional) whether the region is preferred or required, defaults to preferred
+	RegionStrategy *types.RegionStrategy
+
+	// (optional) the worker pool to run the job's steps in, steps can override it with SetPool
+	Pool *string
+
+	// (optional) a CEL expression over the step outputs which becomes the output of the workflow run, for
+	// example `steps.ship` or `{"tracking": steps.ship.tracking}`
+	Output *string
+
+	// (optional) a JSON schema for the input of the workflow. Workflow runs and events whose input doesn't match
+	// the schema are rejected when they're triggered.
+	InputSchema *string
+
+	// (optional) the number of slots which each workflow run reserves up front. The reserved slots are held for the
+	// workflow run until it finishes, so that a DAG isn't starved halfway through when workers fill up.
+	ReservedSlots *int32
 }
 
 type WorkflowConcurrency struct {
@@ -238,6 +321,27 @@ func (j *WorkflowJob) ToWorkflow(svcName string, namespace string) types.Workflo
 		w.StickyStrategy = j.StickyStrategy
 	}
 
+	if j.Region != nil {
+		w.Region = j.Region
+		w.RegionStrategy = j.RegionStrategy
+	}
+
+	if j.Pool != nil {
+		w.Pool = j.Pool
+	}
+
+	if j.Output != nil {
+		w.Output = j.Output
+	}
+
+	if j.InputSchema != nil {
+		w.InputSchema = j.InputSchema
+	}
+
+	if j.ReservedSlots != nil {
+		w.ReservedSlots = j.ReservedSlots
+	}
+
 	return w
 }
 
@@ -276,6 +380,11 @@ func (j *WorkflowJob) ToActionMap(svcName string) ActionMap {
 	res := ActionMap{}
 
 	for i, step := range j.Steps {
+		// sleep, wait-for-event and approval steps are not run on the worker
+		if step.isServerStep() {
+			continue
+		}
+
 		actionId := step.GetActionId(svcName, i)
 
 		res[actionId] = ActionWithCompute{
@@ -303,9 +412,17 @@ func (j *WorkflowJob) ToActionMap(svcName string) ActionMap {
 }
 
 type WorkflowStep struct {
-	// The step timeout
+	// The step timeout, which is the maximum execution time once the step run has started
 	Timeout string
 
+	// The maximum time the step run can wait to be assigned to a worker. If not set, the workflow's
+	// schedule timeout is used. Step runs which exceed it are cancelled and not retried.
+	ScheduleTimeout *string
+
+	// The maximum time between heartbeats from the worker running the step. Step runs which exceed it
+	// are failed and retried like execution timeouts.
+	HeartbeatTimeout *string
+
 	// The executed function
 	Function any
 
@@ -321,6 +438,55 @@ type WorkflowStep struct {
 
 	RetryMaxBackoffSeconds *int32
 
+	// The delay before the first retry, which is multiplied by the backoff factor for each subsequent retry
+	RetryInitialBackoffSeconds *int32
+
+	// The fraction of the retry delay which is randomized, between 0 and 1
+	RetryBackoffJitter *float32
+
+	// If set, a second attempt of the step run is started on another worker once it has been running for
+	// longer than this percentile of recent durations. The step must be idempotent.
+	SpeculativePercentile *int32
+
+	// The worker slot pool the step consumes a slot from. If not set, the step uses the default pool.
+	SlotType *string
+
+	// If set, the step is a sleep step which waits for this duration without running on a worker
+	SleepFor *string
+
+	// If set, the step is a sleep step which waits until the RFC3339 timestamp returned by this CEL expression
+	SleepUntil *string
+
+	// If set, the step waits until an event with this key is pushed without running on a worker
+	WaitForEvent *string
+
+	// A CEL expression which must evaluate to the same value for the workflow input and the event payload
+	WaitForEventCorrelation *string
+
+	// If set, the step waits until it is approved or rejected through the API without running on a worker
+	Approval bool
+
+	// A CEL expression which is evaluated against the workflow input and the outputs of the parents before the
+	// step runs. If it returns false, the step is skipped.
+	Condition *string
+
+	// A CEL expression which returns a list. If set, the step runs once for each element of the list and its
+	// output is the list of the outputs of the elements.
+	MapOver *string
+
+	// The maximum number of elements of a map step which run at the same time
+	MapConcurrency *int32
+
+	// The duration the output of the step is cached for, keyed by the step action and its input
+	CacheTTL *string
+
+	// The JSON schemas of the input and output of the step, which are only used to validate the workflow
+	InputSchema  *string
+	OutputSchema *string
+
+	// The worker pool the step runs in, which defaults to the pool of the workflow
+	Pool *string
+
 	RateLimit []RateLimit
 
 	DesiredLabels map[string]*types.DesiredWorkerLabel
@@ -337,6 +503,10 @@ type RateLimit struct {
 	Units          *int    `yaml:"units,omitempty"`
 	UnitsExpr      *string `yaml:"unitsExpr,omitempty"`
 	LimitValueExpr *string `yaml:"limitValueExpr,omitempty"`
+
+	// Global references a rate limit which is shared by all tenants on the server, rather than a rate
+	// limit of the tenant. Global rate limits are created by the server operator.
+	Global bool `yaml:"global,omitempty"`
 }
 
 func Fn(f any) *WorkflowStep {
@@ -347,6 +517,114 @@ func Fn(f any) *WorkflowStep {
 	}
 }
 
+// Sleep creates a step which waits for the given duration, such as "1h", without occupying a worker slot.
+func Sleep(duration string) *WorkflowStep {
+	return &WorkflowStep{
+		SleepFor:  &duration,
+		Parents:   []string{},
+		RateLimit: []RateLimit{},
+	}
+}
+
+// SleepUntil creates a step which waits until the RFC3339 timestamp returned by the given CEL expression,
+// without occupying a worker slot. The expression can reference the workflow input, parent step outputs and
+// additional metadata, for example `input.remind_at`.
+func SleepUntil(expr string) *WorkflowStep {
+	return &WorkflowStep{
+		SleepUntil: &expr,
+		Parents:    []string{},
+		RateLimit:  []RateLimit{},
+	}
+}
+
+// WaitForEvent creates a step which waits until an event with the given key is pushed, without occupying a
+// worker slot. The payload of the event becomes the output of the step. Use SetTimeout to fail the step if no
+// event arrives in time.
+func WaitForEvent(eventKey string) *WorkflowStep {
+	return &WorkflowStep{
+		WaitForEvent: &eventKey,
+		Parents:      []string{},
+		RateLimit:    []RateLimit{},
+	}
+}
+
+// SetEventCorrelation sets a CEL expression which must evaluate to the same value for the workflow input and
+// the event payload, for example `input.order_id`. Only matching events resume the step.
+func (w *WorkflowStep) SetEventCorrelation(expr string) *WorkflowStep {
+	w.WaitForEventCorrelation = &expr
+	return w
+}
+
+// Approval creates a step which waits until it is approved or rejected through the API, without occupying a
+// worker slot. When approved, the payload of the approver becomes the output of the step. Rejected steps fail.
+// Use SetTimeout to fail the step if it isn't decided in time.
+func Approval() *WorkflowStep {
+	return &WorkflowStep{
+		Approval:  true,
+		Parents:   []string{},
+		RateLimit: []RateLimit{},
+	}
+}
+
+// SetCondition sets a CEL expression which is evaluated against the workflow input and the outputs of the parents
+// before the step runs, for example `parents.check.approved == true`. If it returns false, the step is skipped,
+// as are its children unless one of their other parents runs. Steps with complementary conditions on the same
+// parent act as if/else or switch branches.
+func (w *WorkflowStep) SetCondition(expr string) *WorkflowStep {
+	w.Condition = &expr
+	return w
+}
+
+// SetMapOver sets a CEL expression which returns a list, for example `parents.fetch.items`. The step runs once for
+// each element of the list, and each run can read its element with ctx.MapItem. The output of the step is
+// {"results": [...]}, with the outputs of the elements in the order of the list. If an element fails after its
+// retries, the step fails.
+func (w *WorkflowStep) SetMapOver(expr string) *WorkflowStep {
+	w.MapOver = &expr
+	return w
+}
+
+// SetMapConcurrency sets the maximum number of elements of a map step which run at the same time.
+func (w *WorkflowStep) SetMapConcurrency(concurrency int32) *WorkflowStep {
+	w.MapConcurrency = &concurrency
+	return w
+}
+
+// SetCacheTTL caches the output of the step for a duration, for example "1h". Step runs of the step with the same
+// input reuse the cached output instead of running, for example when a workflow run is replayed or triggered again
+// with the same input. Only cache steps which always return the same output for the same input.
+func (w *WorkflowStep) SetCacheTTL(ttl string) *WorkflowStep {
+	w.CacheTTL = &ttl
+	return w
+}
+
+// SetInputSchema sets the JSON schema of the input of the step. The outputs the step expects from its parents are
+// declared under properties.parents.properties.<parent>, and are checked against the output schemas of the parents
+// by AdminClient.ValidateWorkflow.
+func (w *WorkflowStep) SetInputSchema(schema string) *WorkflowStep {
+	w.InputSchema = &schema
+	return w
+}
+
+// SetOutputSchema sets the JSON schema of the output of the step.
+func (w *WorkflowStep) SetOutputSchema(schema string) *WorkflowStep {
+	w.OutputSchema = &schema
+	return w
+}
+
+func (w *WorkflowStep) isSleep() bool {
+	return w.SleepFor != nil || w.SleepUntil != nil
+}
+
+func (w *WorkflowStep) isWaitForEvent() bool {
+	return w.WaitForEvent != nil
+}
+
+// isServerStep returns true for steps which are run by the engine rather than on a worker.
+func (w *WorkflowStep) isServerStep() bool {
+	return w.isSleep() || w.isWaitForEvent() || w.Approval
+}
+
 func (w *WorkflowStep) SetName(name string) *WorkflowStep {
 	w.Name = name
 	return w
@@ -372,6 +650,18 @@ func (w *WorkflowStep) SetTimeout(timeout string) *WorkflowStep {
 	return w
 }
 
+// SetScheduleTimeout sets the maximum time the step run can wait to be assigned to a worker.
+func (w *WorkflowStep) SetScheduleTimeout(timeout string) *WorkflowStep {
+	w.ScheduleTimeout = &timeout
+	return w
+}
+
+// SetHeartbeatTimeout sets the maximum time between heartbeats from the worker running the step.
+func (w *WorkflowStep) SetHeartbeatTimeout(timeout string) *WorkflowStep {
+	w.HeartbeatTimeout = &timeout
+	return w
+}
+
 func (w *WorkflowStep) SetRetries(retries int) *WorkflowStep {
 	w.Retries = retries
 	return w
@@ -387,6 +677,41 @@ func (w *WorkflowStep) SetRetryMaxBackoffSeconds(retryMaxBackoffSeconds int32) *
 	return w
 }
 
+// SetRetryInitialBackoffSeconds sets the delay before the first retry. Each subsequent retry waits for the
+// previous delay multiplied by the backoff factor, which defaults to 2.
+func (w *WorkflowStep) SetRetryInitialBackoffSeconds(retryInitialBackoffSeconds int32) *WorkflowStep {
+	w.RetryInitialBackoffSeconds = &retryInitialBackoffSeconds
+	return w
+}
+
+// SetRetryBackoffJitter randomizes the given fraction of the retry delay, so that retries of many step runs
+// which failed at the same time are spread out.
+func (w *WorkflowStep) SetRetryBackoffJitter(jitter float32) *WorkflowStep {
+	w.RetryBackoffJitter = &jitter
+	return w
+}
+
+// SetSpeculativeExecution starts a second attempt of straggling step runs on another worker, keeping the
+// result of whichever attempt finishes first. Only use this for idempotent steps.
+func (w *WorkflowStep) SetSpeculativeExecution(percentile int32) *WorkflowStep {
+	w.SpeculativePercentile = &percentile
+	return w
+}
+
+// SetSlotType sets the worker slot pool the step consumes a slot from, such as "gpu". The step is only
+// assigned to workers which registered a slot pool of this type.
+func (w *WorkflowStep) SetSlotType(slotType string) *WorkflowStep {
+	w.SlotType = &slotType
+	return w
+}
+
+// SetPool sets the worker pool the step runs in, such as "gpu". The step is only assigned to workers which
+// joined the pool with WithPool, and it overrides the pool of the workflow.
+func (w *WorkflowStep) SetPool(pool string) *WorkflowStep {
+	w.Pool = &pool
+	return w
+}
+
 func (w *WorkflowStep) AddParents(parents ...string) *WorkflowStep {
 	w.Parents = append(w.Parents, parents...)
 	return w
@@ -413,6 +738,10 @@ func (w *WorkflowStep) ToWorkflow(svcName string, namespace string) types.Workfl
 }
 
 func (w *WorkflowStep) ToActionMap(svcName string) ActionMap {
+	if w.isServerStep() {
+		return ActionMap{}
+	}
+
 	step := *w
 
 	return ActionMap{
@@ -443,15 +772,33 @@ func (w *WorkflowStep) ToWorkflowStep(svcName string, index int, namespace strin
 	res.Id = w.GetStepId(index)
 
 	res.APIStep = types.WorkflowStep{
-		Name:                   res.Id,
-		ID:                     w.GetStepId(index),
-		Timeout:                w.Timeout,
-		ActionID:               w.GetActionId(svcName, index),
-		Parents:                []string{},
-		Retries:                w.Retries,
-		DesiredLabels:          w.DesiredLabels,
-		RetryBackoffFactor:     w.RetryBackoffFactor,
-		RetryMaxBackoffSeconds: w.RetryMaxBackoffSeconds,
+		Name:                       res.Id,
+		ID:                         w.GetStepId(index),
+		Timeout:                    w.Timeout,
+		ActionID:                   w.GetActionId(svcName, index),
+		Parents:                    []string{},
+		Retries:                    w.Retries,
+		DesiredLabels:              w.DesiredLabels,
+		RetryBackoffFactor:         w.RetryBackoffFactor,
+		RetryMaxBackoffSeconds:     w.RetryMaxBackoffSeconds,
+		RetryInitialBackoffSeconds: w.RetryInitialBackoffSeconds,
+		RetryBackoffJitter:         w.RetryBackoffJitter,
+		SpeculativePercentile:      w.SpeculativePercentile,
+		SlotType:                   w.SlotType,
+		ScheduleTimeout:            w.ScheduleTimeout,
+		HeartbeatTimeout:           w.HeartbeatTimeout,
+		SleepFor:                   w.SleepFor,
+		SleepUntil:                 w.SleepUntil,
+		WaitForEvent:               w.WaitForEvent,
+		WaitForEventCorrelation:    w.WaitForEventCorrelation,
+		Approval:                   w.Approval,
+		Condition:                  w.Condition,
+		MapOver:                    w.MapOver,
+		MapConcurrency:             w.MapConcurrency,
+		CacheTTL:                   w.CacheTTL,
+		InputSchema:                w.InputSchema,
+		OutputSchema:               w.OutputSchema,
+		Pool:                       w.Pool,
 	}
 
 	for _, rateLimit := range w.RateLimit {
@@ -461,27 +808,33 @@ func (w *WorkflowStep) ToWorkflowStep(svcName string, index int, namespace strin
 			Units:          rateLimit.Units,
 			UnitsExpr:      rateLimit.UnitsExpr,
 			LimitValueExpr: rateLimit.LimitValueExpr,
+			Global:         rateLimit.Global,
 		})
 	}
 
-	inputs, err := decodeFnArgTypes(fnType)
+	if w.isServerStep() {
+		// sleep, wait-for-event and approval steps have no function, and are not run on a worker
+		res.APIStep.ActionID = ""
+	} else {
+		inputs, err := decodeFnArgTypes(fnType)
 
-	if err != nil {
-		return nil, err
-	}
+		if err != nil {
+			return nil, err
+		}
 
-	if len(inputs) > 1 {
-		res.NonCtxInput = inputs[1]
-	}
+		if len(inputs) > 1 {
+			res.NonCtxInput = inputs[1]
+		}
 
-	outputs, err := decodeFnReturnTypes(fnType)
+		outputs, err := decodeFnReturnTypes(fnType)
 
-	if err != nil {
-		return nil, err
-	}
+		if err != nil {
+			return nil, err
+		}
 
-	if len(outputs) > 1 {
-		res.NonErrOutput = &outputs[0]
+		if len(outputs) > 1 {
+			res.NonErrOutput = &outputs[0]
+		}
 	}
 
 	for _, parent := range w.Parents {
@@ -510,6 +863,18 @@ func (w *WorkflowStep) GetStepId(index int) string {
 		return w.Name
 	}
 
+	if w.isSleep() {
+		return fmt.Sprintf("sleep%d", index)
+	}
+
+	if w.isWaitForEvent() {
+		return fmt.Sprintf("wait%d", index)
+	}
+
+	if w.Approval {
+		return fmt.Sprintf("approval%d", index)
+	}
+
 	stepId := getFnName(w.Function)
 
 	// this can happen if the function is anonymous
@@ -3,8 +3,11 @@ package worker
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/hatchet-dev/hatchet/pkg/client/types"
 )
 
 func namedFunction() {}
@@ -73,3 +76,247 @@ func TestFnToWorkflow(t *testing.T) {
 
 	assert.Equal(t, "TestFnToWorkflow-func1", workflow.Name)
 }
+
+func TestSleepStepToWorkflowJob(t *testing.T) {
+	testJob := WorkflowJob{
+		Name:        "test",
+		Description: "test",
+		Steps: []*WorkflowStep{
+			Fn(func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+				return nil, nil
+			}).SetName("step-one"),
+			Sleep("1h").SetName("wait").AddParents("step-one"),
+		},
+	}
+
+	apiJob, err := testJob.ToWorkflowJob("default", "")
+
+	assert.NoError(t, err)
+	assert.Len(t, apiJob.Steps, 2)
+	assert.Equal(t, "", apiJob.Steps[1].ActionID)
+	assert.Equal(t, "1h", *apiJob.Steps[1].SleepFor)
+
+	actionMap := testJob.ToActionMap("default")
+
+	assert.Len(t, actionMap, 1)
+	assert.Contains(t, actionMap, "default:step-one")
+}
+
+func TestWaitForEventStepToWorkflowJob(t *testing.T) {
+	testJob := WorkflowJob{
+		Name:        "test",
+		Description: "test",
+		Steps: []*WorkflowStep{
+			Fn(func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+				return nil, nil
+			}).SetName("step-one"),
+			WaitForEvent("order:approved").SetEventCorrelation("input.order_id").AddParents("step-one"),
+		},
+	}
+
+	apiJob, err := testJob.ToWorkflowJob("default", "")
+
+	assert.NoError(t, err)
+	assert.Len(t, apiJob.Steps, 2)
+	assert.Equal(t, "wait1", apiJob.Steps[1].ID)
+	assert.Equal(t, "", apiJob.Steps[1].ActionID)
+	assert.Equal(t, "order:approved", *apiJob.Steps[1].WaitForEvent)
+	assert.Equal(t, "input.order_id", *apiJob.Steps[1].WaitForEventCorrelation)
+
+	actionMap := testJob.ToActionMap("default")
+
+	assert.Len(t, actionMap, 1)
+	assert.Contains(t, actionMap, "default:step-one")
+}
+
+func TestApprovalStepToWorkflowJob(t *testing.T) {
+	testJob := WorkflowJob{
+		Name:        "test",
+		Description: "test",
+		Steps: []*WorkflowStep{
+			Fn(func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+				return nil, nil
+			}).SetName("step-one"),
+			Approval().SetTimeout("24h").AddParents("step-one"),
+		},
+	}
+
+	apiJob, err := testJob.ToWorkflowJob("default", "")
+
+	assert.NoError(t, err)
+	assert.Len(t, apiJob.Steps, 2)
+	assert.Equal(t, "approval1", apiJob.Steps[1].ID)
+	assert.Equal(t, "", apiJob.Steps[1].ActionID)
+	assert.True(t, apiJob.Steps[1].Approval)
+
+	actionMap := testJob.ToActionMap("default")
+
+	assert.Len(t, actionMap, 1)
+	assert.Contains(t, actionMap, "default:step-one")
+}
+
+func TestConditionalStepsToWorkflowJob(t *testing.T) {
+	fn := func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+		return nil, nil
+	}
+
+	testJob := WorkflowJob{
+		Name:        "test",
+		Description: "test",
+		Steps: []*WorkflowStep{
+			Fn(fn).SetName("check"),
+			Fn(fn).SetName("approved").AddParents("check").SetCondition("parents.check.approved == true"),
+			Fn(fn).SetName("rejected").AddParents("check").SetCondition("parents.check.approved != true"),
+		},
+	}
+
+	apiJob, err := testJob.ToWorkflowJob("default", "")
+
+	assert.NoError(t, err)
+	assert.Len(t, apiJob.Steps, 3)
+	assert.Nil(t, apiJob.Steps[0].Condition)
+	assert.Equal(t, "parents.check.approved == true", *apiJob.Steps[1].Condition)
+	assert.Equal(t, "parents.check.approved != true", *apiJob.Steps[2].Condition)
+}
+
+func TestMapStepsToWorkflowJob(t *testing.T) {
+	fn := func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+		return nil, nil
+	}
+
+	testJob := WorkflowJob{
+		Name:        "test",
+		Description: "test",
+		Steps: []*WorkflowStep{
+			Fn(fn).SetName("fetch"),
+			Fn(fn).SetName("process").AddParents("fetch").SetMapOver("parents.fetch.items").SetMapConcurrency(5),
+		},
+	}
+
+	apiJob, err := testJob.ToWorkflowJob("default", "")
+
+	assert.NoError(t, err)
+	assert.Len(t, apiJob.Steps, 2)
+	assert.Nil(t, apiJob.Steps[0].MapOver)
+	assert.Equal(t, "parents.fetch.items", *apiJob.Steps[1].MapOver)
+	assert.Equal(t, int32(5), *apiJob.Steps[1].MapConcurrency)
+}
+
+func TestCachedStepsToWorkflowJob(t *testing.T) {
+	fn := func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+		return nil, nil
+	}
+
+	testJob := WorkflowJob{
+		Name:        "test",
+		Description: "test",
+		Steps: []*WorkflowStep{
+			Fn(fn).SetName("fetch"),
+			Fn(fn).SetName("embed").AddParents("fetch").SetCacheTTL("24h"),
+		},
+	}
+
+	apiJob, err := testJob.ToWorkflowJob("default", "")
+
+	assert.NoError(t, err)
+	assert.Len(t, apiJob.Steps, 2)
+	assert.Nil(t, apiJob.Steps[0].CacheTTL)
+	assert.Equal(t, "24h", *apiJob.Steps[1].CacheTTL)
+}
+
+func TestStepSchemasToWorkflowJob(t *testing.T) {
+	fn := func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+		return nil, nil
+	}
+
+	testJob := WorkflowJob{
+		Name:        "test",
+		Description: "test",
+		Steps: []*WorkflowStep{
+			Fn(fn).SetName("fetch").SetOutputSchema(`{"type":"object"}`),
+			Fn(fn).SetName("process").AddParents("fetch").SetInputSchema(`{"type":"object","properties":{"parents":{"type":"object"}}}`),
+		},
+	}
+
+	apiJob, err := testJob.ToWorkflowJob("default", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, `{"type":"object"}`, *apiJob.Steps[0].OutputSchema)
+	assert.Nil(t, apiJob.Steps[0].InputSchema)
+	assert.Equal(t, `{"type":"object","properties":{"parents":{"type":"object"}}}`, *apiJob.Steps[1].InputSchema)
+}
+
+func TestWorkflowOutputToWorkflow(t *testing.T) {
+	fn := func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+		return nil, nil
+	}
+
+	output := `{"message": steps.step_one.message}`
+
+	testJob := WorkflowJob{
+		Name:        "test",
+		Description: "test",
+		Steps: []*WorkflowStep{
+			Fn(fn).SetName("step_one"),
+		},
+		Output: &output,
+	}
+
+	workflow := testJob.ToWorkflow("default", "")
+
+	assert.Equal(t, output, *workflow.Output)
+}
+
+func TestWorkflowInputSchemaToWorkflow(t *testing.T) {
+	fn := func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+		return nil, nil
+	}
+
+	inputSchema := `{"type": "object", "required": ["message"], "properties": {"message": {"type": "string"}}}`
+
+	testJob := WorkflowJob{
+		Name:        "test",
+		Description: "test",
+		Steps: []*WorkflowStep{
+			Fn(fn).SetName("step_one"),
+		},
+		InputSchema: &inputSchema,
+	}
+
+	workflow := testJob.ToWorkflow("default", "")
+
+	assert.Equal(t, inputSchema, *workflow.InputSchema)
+}
+
+func TestWorkflowReservedSlotsToWorkflow(t *testing.T) {
+	fn := func(ctx context.Context, input *actionInput) (result *stepOneOutput, err error) {
+		return nil, nil
+	}
+
+	reservedSlots := int32(3)
+
+	testJob := WorkflowJob{
+		Name:        "test",
+		Description: "test",
+		Steps: []*WorkflowStep{
+			Fn(fn).SetName("step_one"),
+			Fn(fn).SetName("step_two").AddParents("step_one"),
+		},
+		ReservedSlots: &reservedSlots,
+	}
+
+	workflow := testJob.ToWorkflow("default", "")
+
+	assert.Equal(t, reservedSlots, *workflow.ReservedSlots)
+}
+
+func TestCronScheduleToWorkflowTriggers(t *testing.T) {
+	triggers := &types.WorkflowTriggers{}
+
+	Cron("0 9 * * *").In("America/New_York").WithJitter(5*time.Minute).Excluding("us-holidays").ToWorkflowTriggers(triggers, "")
+
+	assert.Equal(t, []string{"0 9 * * *"}, triggers.Cron)
+	assert.Equal(t, "America/New_York", *triggers.CronTimezone)
+	assert.Equal(t, 5*time.Minute, *triggers.CronJitter)
+	assert.Equal(t, "us-holidays", *triggers.CronExclusionCalendar)
+}
@@ -0,0 +1,4 @@
+-- Create "SlotReservation" table
+CREATE TABLE "SlotReservation" ("workflowRunId" uuid NOT NULL, "tenantId" uuid NOT NULL, "slots" integer NOT NULL, "expiresAt" timestamp(3) NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, PRIMARY KEY ("workflowRunId"));
+-- Create index "SlotReservation_tenantId_expiresAt_idx" to table: "SlotReservation"
+CREATE INDEX "SlotReservation_tenantId_expiresAt_idx" ON "SlotReservation" ("tenantId", "expiresAt");
@@ -0,0 +1,2 @@
+-- Modify "Queue" table
+ALTER TABLE "Queue" ADD COLUMN "isPaused" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,6 @@
+-- Modify "QueueItem" table
+ALTER TABLE "QueueItem" ADD COLUMN "deadline" timestamp(3) NULL;
+-- Create index "QueueItem_isQueued_tenantId_queue_deadline_id_idx" to table: "QueueItem"
+CREATE INDEX "QueueItem_isQueued_tenantId_queue_deadline_id_idx" ON "QueueItem" ("isQueued", "tenantId", "queue", "deadline", "id");
+-- Modify "StepRun" table
+ALTER TABLE "StepRun" ADD COLUMN "deadline" timestamp(3) NULL;
@@ -0,0 +1,2 @@
+-- Modify "Worker" table
+ALTER TABLE "Worker" ADD COLUMN "isDraining" boolean NOT NULL DEFAULT false;
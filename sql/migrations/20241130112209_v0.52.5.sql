@@ -0,0 +1,8 @@
+-- Create enum type "SchedulingDecisionOutcome"
+CREATE TYPE "SchedulingDecisionOutcome" AS ENUM ('ASSIGNED', 'NO_SLOTS', 'RATE_LIMITED');
+-- Create "SchedulingDecision" table
+CREATE TABLE "SchedulingDecision" ("id" bigserial NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "tenantId" uuid NOT NULL, "stepRunId" uuid NOT NULL, "queue" text NOT NULL, "outcome" "SchedulingDecisionOutcome" NOT NULL, "workerId" uuid NULL, "message" text NOT NULL, "data" jsonb NULL, PRIMARY KEY ("id"));
+-- Create index "SchedulingDecision_tenantId_id_idx" to table: "SchedulingDecision"
+CREATE INDEX "SchedulingDecision_tenantId_id_idx" ON "SchedulingDecision" ("tenantId", "id");
+-- Create index "SchedulingDecision_tenantId_stepRunId_id_idx" to table: "SchedulingDecision"
+CREATE INDEX "SchedulingDecision_tenantId_stepRunId_id_idx" ON "SchedulingDecision" ("tenantId", "stepRunId", "id");
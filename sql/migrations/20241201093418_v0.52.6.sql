@@ -0,0 +1,8 @@
+-- Add value to enum type: "StepRunEventReason"
+ALTER TYPE "StepRunEventReason" ADD VALUE 'SPECULATIVE_ATTEMPT';
+-- Modify "Step" table
+ALTER TABLE "Step" ADD COLUMN "speculativePercentile" integer NULL;
+-- Create "StepRunSpeculativeAttempt" table
+CREATE TABLE "StepRunSpeculativeAttempt" ("stepRunId" uuid NOT NULL, "retryCount" integer NOT NULL, "tenantId" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "primaryWorkerId" uuid NOT NULL, "workerId" uuid NOT NULL, "winnerWorkerId" uuid NULL, "failedAttempts" integer NOT NULL DEFAULT 0, PRIMARY KEY ("stepRunId", "retryCount"));
+-- Create index "StepRunSpeculativeAttempt_tenantId_createdAt_idx" to table: "StepRunSpeculativeAttempt"
+CREATE INDEX "StepRunSpeculativeAttempt_tenantId_createdAt_idx" ON "StepRunSpeculativeAttempt" ("tenantId", "createdAt");
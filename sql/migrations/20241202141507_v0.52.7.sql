@@ -0,0 +1,6 @@
+-- Create enum type "RegionStrategy"
+CREATE TYPE "RegionStrategy" AS ENUM ('PIN', 'PREFER');
+-- Modify "Worker" table
+ALTER TABLE "Worker" ADD COLUMN "region" text NULL;
+-- Modify "WorkflowVersion" table
+ALTER TABLE "WorkflowVersion" ADD COLUMN "region" text NULL, ADD COLUMN "regionStrategy" "RegionStrategy" NULL;
@@ -0,0 +1,6 @@
+-- Modify "SemaphoreQueueItem" table
+ALTER TABLE "SemaphoreQueueItem" ADD COLUMN "slotType" text NOT NULL DEFAULT 'default';
+-- Modify "Step" table
+ALTER TABLE "Step" ADD COLUMN "slotType" text NULL;
+-- Create "WorkerSlotPool" table
+CREATE TABLE "WorkerSlotPool" ("workerId" uuid NOT NULL, "slotType" text NOT NULL, "maxRuns" integer NOT NULL, PRIMARY KEY ("workerId", "slotType"), CONSTRAINT "WorkerSlotPool_workerId_fkey" FOREIGN KEY ("workerId") REFERENCES "Worker" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
@@ -0,0 +1,2 @@
+-- Create index "QueueItem_tenantId_stepRunId_idx" to table: "QueueItem"
+CREATE INDEX "QueueItem_tenantId_stepRunId_idx" ON "QueueItem" ("tenantId", "stepRunId");
@@ -0,0 +1,2 @@
+-- Modify "Lease" table
+ALTER TABLE "Lease" ADD COLUMN "fencingToken" bigserial NOT NULL;
@@ -0,0 +1,2 @@
+-- Add value to enum type: "ConcurrencyLimitStrategy"
+ALTER TYPE "ConcurrencyLimitStrategy" ADD VALUE 'QUEUE_NEWEST_ONLY';
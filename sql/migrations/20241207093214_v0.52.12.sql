@@ -0,0 +1,4 @@
+-- Create "GlobalRateLimit" table
+CREATE TABLE "GlobalRateLimit" ("key" text NOT NULL, "limitValue" integer NOT NULL, "value" integer NOT NULL, "window" text NOT NULL, "lastRefill" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, PRIMARY KEY ("key"));
+-- Create "StepGlobalRateLimit" table
+CREATE TABLE "StepGlobalRateLimit" ("units" integer NOT NULL, "stepId" uuid NOT NULL, "rateLimitKey" text NOT NULL, "tenantId" uuid NOT NULL, PRIMARY KEY ("stepId", "rateLimitKey"), CONSTRAINT "StepGlobalRateLimit_rateLimitKey_fkey" FOREIGN KEY ("rateLimitKey") REFERENCES "GlobalRateLimit" ("key") ON UPDATE CASCADE ON DELETE RESTRICT, CONSTRAINT "StepGlobalRateLimit_stepId_fkey" FOREIGN KEY ("stepId") REFERENCES "Step" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
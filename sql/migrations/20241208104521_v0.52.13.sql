@@ -0,0 +1,146 @@
+-- Create enum type "RateLimitAlgorithm"
+CREATE TYPE "RateLimitAlgorithm" AS ENUM ('FIXED_WINDOW', 'SLIDING_WINDOW', 'TOKEN_BUCKET', 'LEAKY_BUCKET');
+-- Modify "RateLimit" table
+ALTER TABLE "RateLimit" ADD COLUMN "algorithm" "RateLimitAlgorithm" NOT NULL DEFAULT 'FIXED_WINDOW', ADD COLUMN "burst" integer NULL, ADD COLUMN "prevWindowUsed" integer NOT NULL DEFAULT 0;
+
+-- get_rate_limit_capacity returns the maximum number of units which can be available at once
+CREATE OR REPLACE FUNCTION get_rate_limit_capacity(rate_limit "RateLimit")
+RETURNS INTEGER AS $$
+BEGIN
+    CASE rate_limit."algorithm"
+        WHEN 'TOKEN_BUCKET' THEN
+            RETURN COALESCE(rate_limit."burst", rate_limit."limitValue");
+        WHEN 'LEAKY_BUCKET' THEN
+            RETURN COALESCE(rate_limit."burst", 1);
+        ELSE
+            RETURN rate_limit."limitValue";
+    END CASE;
+END;
+$$ LANGUAGE plpgsql;
+
+-- get_bucket_refill_units returns the number of whole units which have been added to a token or leaky
+-- bucket since the last refill, at a rate of limitValue units per window
+CREATE OR REPLACE FUNCTION get_bucket_refill_units(rate_limit "RateLimit")
+RETURNS BIGINT AS $$
+BEGIN
+    -- cap the units at the amount needed to fill the bucket, so long idle periods can't overflow
+    RETURN GREATEST(
+        LEAST(
+            FLOOR(
+                EXTRACT(EPOCH FROM (NOW() - rate_limit."lastRefill"))
+                / EXTRACT(EPOCH FROM rate_limit."window"::INTERVAL)
+                * rate_limit."limitValue"
+            ),
+            get_rate_limit_capacity(rate_limit)::BIGINT - LEAST(rate_limit."value", 0)
+        ),
+        0
+    )::BIGINT;
+END;
+$$ LANGUAGE plpgsql;
+
+-- get_refill_value returns the stored value of the rate limit after refilling it
+CREATE OR REPLACE FUNCTION get_refill_value(rate_limit "RateLimit")
+RETURNS INTEGER AS $$
+DECLARE
+    refill_amount INTEGER;
+BEGIN
+    IF rate_limit."algorithm" IN ('TOKEN_BUCKET', 'LEAKY_BUCKET') THEN
+        RETURN LEAST(
+            rate_limit."value"::BIGINT + get_bucket_refill_units(rate_limit),
+            get_rate_limit_capacity(rate_limit)
+        )::INTEGER;
+    END IF;
+
+    IF NOW() - rate_limit."lastRefill" >= rate_limit."window"::INTERVAL THEN
+        refill_amount := rate_limit."limitValue";
+    ELSE
+        refill_amount := rate_limit."value";
+    END IF;
+    RETURN refill_amount;
+END;
+$$ LANGUAGE plpgsql;
+
+-- get_refill_time returns the last refill time of the rate limit after refilling it
+CREATE OR REPLACE FUNCTION get_refill_time(rate_limit "RateLimit")
+RETURNS TIMESTAMP AS $$
+DECLARE
+    refill_units BIGINT;
+    elapsed_windows DOUBLE PRECISION;
+BEGIN
+    IF rate_limit."algorithm" IN ('TOKEN_BUCKET', 'LEAKY_BUCKET') THEN
+        refill_units := get_bucket_refill_units(rate_limit);
+
+        IF refill_units <= 0 THEN
+            RETURN rate_limit."lastRefill";
+        END IF;
+
+        -- a full bucket doesn't accumulate time towards the next unit
+        IF rate_limit."value"::BIGINT + refill_units >= get_rate_limit_capacity(rate_limit) THEN
+            RETURN CURRENT_TIMESTAMP;
+        END IF;
+
+        -- only advance by the time which was used to add whole units, so fractional units carry over
+        RETURN rate_limit."lastRefill" + rate_limit."window"::INTERVAL * (refill_units::DOUBLE PRECISION / rate_limit."limitValue");
+    END IF;
+
+    IF NOW() - rate_limit."lastRefill" < rate_limit."window"::INTERVAL THEN
+        RETURN rate_limit."lastRefill";
+    END IF;
+
+    IF rate_limit."algorithm" = 'SLIDING_WINDOW' THEN
+        -- sliding windows stay aligned, so the weight of the previous window is accurate
+        elapsed_windows := FLOOR(
+            EXTRACT(EPOCH FROM (NOW() - rate_limit."lastRefill"))
+            / EXTRACT(EPOCH FROM rate_limit."window"::INTERVAL)
+        );
+
+        RETURN rate_limit."lastRefill" + rate_limit."window"::INTERVAL * elapsed_windows;
+    END IF;
+
+    RETURN CURRENT_TIMESTAMP;
+END;
+$$ LANGUAGE plpgsql;
+
+-- get_prev_window_used returns the units used in the previous window of a sliding window rate limit after
+-- refilling it
+CREATE OR REPLACE FUNCTION get_prev_window_used(rate_limit "RateLimit")
+RETURNS INTEGER AS $$
+BEGIN
+    IF rate_limit."algorithm" <> 'SLIDING_WINDOW' THEN
+        RETURN 0;
+    END IF;
+
+    IF NOW() - rate_limit."lastRefill" >= rate_limit."window"::INTERVAL * 2 THEN
+        RETURN 0;
+    END IF;
+
+    IF NOW() - rate_limit."lastRefill" >= rate_limit."window"::INTERVAL THEN
+        RETURN GREATEST(rate_limit."limitValue" - rate_limit."value", 0);
+    END IF;
+
+    RETURN rate_limit."prevWindowUsed";
+END;
+$$ LANGUAGE plpgsql;
+
+-- get_available_value returns the number of units which can be consumed right now. For sliding windows,
+-- the usage of the previous window is weighted by how much of it overlaps the sliding window.
+CREATE OR REPLACE FUNCTION get_available_value(rate_limit "RateLimit")
+RETURNS INTEGER AS $$
+DECLARE
+    refill_time TIMESTAMP;
+    overlap DOUBLE PRECISION;
+BEGIN
+    IF rate_limit."algorithm" <> 'SLIDING_WINDOW' THEN
+        RETURN get_refill_value(rate_limit);
+    END IF;
+
+    refill_time := get_refill_time(rate_limit);
+
+    overlap := GREATEST(
+        1 - EXTRACT(EPOCH FROM (NOW() - refill_time)) / EXTRACT(EPOCH FROM rate_limit."window"::INTERVAL),
+        0
+    );
+
+    RETURN get_refill_value(rate_limit) - CEIL(get_prev_window_used(rate_limit) * overlap)::INTEGER;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,4 @@
+-- Modify "RateLimit" table
+ALTER TABLE "RateLimit" ADD COLUMN "expiresAt" timestamp(3) NULL;
+-- Create index "RateLimit_tenantId_expiresAt_idx" to table: "RateLimit"
+CREATE INDEX "RateLimit_tenantId_expiresAt_idx" ON "RateLimit" ("tenantId", "expiresAt") WHERE ("expiresAt" IS NOT NULL);
@@ -0,0 +1,2 @@
+-- Modify "Step" table
+ALTER TABLE "Step" ADD COLUMN "retryInitialBackoff" integer NULL, ADD COLUMN "retryJitter" double precision NULL;
@@ -0,0 +1,6 @@
+-- Create "DeadLetterQueueItem" table
+CREATE TABLE "DeadLetterQueueItem" ("id" uuid NOT NULL DEFAULT gen_random_uuid(), "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "tenantId" uuid NOT NULL, "stepRunId" uuid NOT NULL, "stepId" uuid NOT NULL, "workflowRunId" uuid NOT NULL, "retryCount" integer NOT NULL, "input" jsonb NULL, "error" text NULL, "errorChain" text[] NOT NULL DEFAULT '{}', PRIMARY KEY ("id"), CONSTRAINT "DeadLetterQueueItem_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "DeadLetterQueueItem_stepRunId_key" to table: "DeadLetterQueueItem"
+CREATE UNIQUE INDEX "DeadLetterQueueItem_stepRunId_key" ON "DeadLetterQueueItem" ("stepRunId");
+-- Create index "DeadLetterQueueItem_tenantId_createdAt_idx" to table: "DeadLetterQueueItem"
+CREATE INDEX "DeadLetterQueueItem_tenantId_createdAt_idx" ON "DeadLetterQueueItem" ("tenantId", "createdAt");
@@ -0,0 +1,6 @@
+-- Modify "Step" table
+ALTER TABLE "Step" ADD COLUMN "sleepFor" text NULL, ADD COLUMN "sleepUntil" text NULL;
+-- Create "StepRunTimer" table
+CREATE TABLE "StepRunTimer" ("stepRunId" uuid NOT NULL, "tenantId" uuid NOT NULL, "wakeAt" timestamp(3) NOT NULL, "tickerId" uuid NULL, PRIMARY KEY ("stepRunId"), CONSTRAINT "StepRunTimer_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "StepRunTimer_wakeAt_idx" to table: "StepRunTimer"
+CREATE INDEX "StepRunTimer_wakeAt_idx" ON "StepRunTimer" ("wakeAt");
@@ -0,0 +1,6 @@
+-- Modify "Step" table
+ALTER TABLE "Step" ADD COLUMN "waitForEvent" text NULL, ADD COLUMN "waitForEventCorrelation" text NULL;
+-- Create "StepRunSignalWait" table
+CREATE TABLE "StepRunSignalWait" ("stepRunId" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "tenantId" uuid NOT NULL, "eventKey" text NOT NULL, "correlationExpr" text NULL, "correlationValue" text NULL, PRIMARY KEY ("stepRunId"), CONSTRAINT "StepRunSignalWait_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "StepRunSignalWait_tenantId_eventKey_idx" to table: "StepRunSignalWait"
+CREATE INDEX "StepRunSignalWait_tenantId_eventKey_idx" ON "StepRunSignalWait" ("tenantId", "eventKey");
@@ -0,0 +1,10 @@
+-- Create enum type "StepRunApprovalStatus"
+CREATE TYPE "StepRunApprovalStatus" AS ENUM ('PENDING', 'APPROVED', 'REJECTED', 'TIMED_OUT');
+-- Create "StepRunApproval" table
+CREATE TABLE "StepRunApproval" ("id" uuid NOT NULL DEFAULT gen_random_uuid(), "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "updatedAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "tenantId" uuid NOT NULL, "stepRunId" uuid NOT NULL, "workflowRunId" uuid NOT NULL, "token" text NOT NULL, "status" "StepRunApprovalStatus" NOT NULL DEFAULT 'PENDING', "payload" jsonb NULL, "decidedAt" timestamp(3) NULL, PRIMARY KEY ("id"), CONSTRAINT "StepRunApproval_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "StepRunApproval_stepRunId_key" to table: "StepRunApproval"
+CREATE UNIQUE INDEX "StepRunApproval_stepRunId_key" ON "StepRunApproval" ("stepRunId");
+-- Create index "StepRunApproval_tenantId_createdAt_idx" to table: "StepRunApproval"
+CREATE INDEX "StepRunApproval_tenantId_createdAt_idx" ON "StepRunApproval" ("tenantId", "createdAt");
+-- Create index "StepRunApproval_token_key" to table: "StepRunApproval"
+CREATE UNIQUE INDEX "StepRunApproval_token_key" ON "StepRunApproval" ("token");
@@ -0,0 +1,4 @@
+-- Add value to enum type: "StepRunStatus"
+ALTER TYPE "StepRunStatus" ADD VALUE 'SKIPPED';
+-- Modify "Step" table
+ALTER TABLE "Step" ADD COLUMN "condition" text NULL;
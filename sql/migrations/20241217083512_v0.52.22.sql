@@ -0,0 +1,8 @@
+-- Create enum type "StepRunMapItemStatus"
+CREATE TYPE "StepRunMapItemStatus" AS ENUM ('PENDING', 'QUEUED', 'SUCCEEDED', 'FAILED');
+-- Modify "Step" table
+ALTER TABLE "Step" ADD COLUMN "mapOver" text NULL, ADD COLUMN "mapConcurrency" integer NULL;
+-- Create "StepRunMapItem" table
+CREATE TABLE "StepRunMapItem" ("stepRunId" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "tenantId" uuid NOT NULL, "mapStepRunId" uuid NOT NULL, "index" integer NOT NULL, "status" "StepRunMapItemStatus" NOT NULL DEFAULT 'PENDING', "output" jsonb NULL, PRIMARY KEY ("stepRunId"), CONSTRAINT "StepRunMapItem_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "StepRunMapItem_mapStepRunId_index_key" to table: "StepRunMapItem"
+CREATE UNIQUE INDEX "StepRunMapItem_mapStepRunId_index_key" ON "StepRunMapItem" ("mapStepRunId", "index");
@@ -0,0 +1,4 @@
+-- Modify "WorkflowRun" table
+ALTER TABLE "WorkflowRun" ADD COLUMN "output" jsonb NULL;
+-- Modify "WorkflowVersion" table
+ALTER TABLE "WorkflowVersion" ADD COLUMN "outputExpression" text NULL;
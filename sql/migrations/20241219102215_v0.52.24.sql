@@ -0,0 +1,4 @@
+-- atlas:txmode none
+
+-- Create index "WorkflowRun_additionalMetadata_idx" to table: "WorkflowRun"
+CREATE INDEX CONCURRENTLY IF NOT EXISTS "WorkflowRun_additionalMetadata_idx" ON "WorkflowRun" USING GIN ("additionalMetadata" jsonb_path_ops);
@@ -0,0 +1,6 @@
+-- Create "WorkflowRunIdempotencyKey" table
+CREATE TABLE "WorkflowRunIdempotencyKey" ("tenantId" uuid NOT NULL, "workflowId" uuid NOT NULL, "key" text NOT NULL, "workflowRunId" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "expiresAt" timestamp(3) NOT NULL, PRIMARY KEY ("tenantId", "workflowId", "key"), CONSTRAINT "WorkflowRunIdempotencyKey_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "WorkflowRunIdempotencyKey_expiresAt_idx" to table: "WorkflowRunIdempotencyKey"
+CREATE INDEX "WorkflowRunIdempotencyKey_expiresAt_idx" ON "WorkflowRunIdempotencyKey" ("expiresAt");
+-- Create index "WorkflowRunIdempotencyKey_workflowRunId_idx" to table: "WorkflowRunIdempotencyKey"
+CREATE INDEX "WorkflowRunIdempotencyKey_workflowRunId_idx" ON "WorkflowRunIdempotencyKey" ("workflowRunId");
@@ -0,0 +1,2 @@
+-- Modify "WorkflowTriggerCronRef" table
+ALTER TABLE "WorkflowTriggerCronRef" ADD COLUMN "timezone" text NOT NULL DEFAULT 'UTC', ADD COLUMN "jitterSeconds" integer NOT NULL DEFAULT 0;
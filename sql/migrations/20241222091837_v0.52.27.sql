@@ -0,0 +1,6 @@
+-- Create "CronExclusionCalendar" table
+CREATE TABLE "CronExclusionCalendar" ("id" uuid NOT NULL DEFAULT gen_random_uuid(), "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "updatedAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "tenantId" uuid NOT NULL, "name" text NOT NULL, "dates" date[] NOT NULL DEFAULT ARRAY[]::date[], "icalUrl" text NULL, PRIMARY KEY ("id"), CONSTRAINT "CronExclusionCalendar_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "CronExclusionCalendar_tenantId_name_key" to table: "CronExclusionCalendar"
+CREATE UNIQUE INDEX "CronExclusionCalendar_tenantId_name_key" ON "CronExclusionCalendar" ("tenantId", "name");
+-- Modify "WorkflowTriggerCronRef" table
+ALTER TABLE "WorkflowTriggerCronRef" ADD COLUMN "exclusionCalendarId" uuid NULL, ADD CONSTRAINT "WorkflowTriggerCronRef_exclusionCalendarId_fkey" FOREIGN KEY ("exclusionCalendarId") REFERENCES "CronExclusionCalendar" ("id") ON UPDATE CASCADE ON DELETE SET NULL;
@@ -0,0 +1,2 @@
+-- Add value to enum type: "WorkflowRunStatus"
+ALTER TYPE "WorkflowRunStatus" ADD VALUE 'PAUSED';
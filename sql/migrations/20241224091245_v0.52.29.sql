@@ -0,0 +1,2 @@
+-- Modify "WorkflowRun" table
+ALTER TABLE "WorkflowRun" ADD COLUMN "replayedFromId" uuid NULL, ADD COLUMN "replayedFromStepId" uuid NULL, ADD CONSTRAINT "WorkflowRun_replayedFromId_fkey" FOREIGN KEY ("replayedFromId") REFERENCES "WorkflowRun" ("id") ON UPDATE CASCADE ON DELETE SET NULL;
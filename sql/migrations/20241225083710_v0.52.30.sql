@@ -0,0 +1,4 @@
+-- Create "WorkflowRollout" table
+CREATE TABLE "WorkflowRollout" ("id" uuid NOT NULL DEFAULT gen_random_uuid(), "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "updatedAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "workflowId" uuid NOT NULL, "stableVersionId" uuid NOT NULL, "canaryPercentage" integer NOT NULL DEFAULT 0, PRIMARY KEY ("id"), CONSTRAINT "WorkflowRollout_stableVersionId_fkey" FOREIGN KEY ("stableVersionId") REFERENCES "WorkflowVersion" ("id") ON UPDATE CASCADE ON DELETE CASCADE, CONSTRAINT "WorkflowRollout_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "WorkflowRollout_workflowId_key" to table: "WorkflowRollout"
+CREATE UNIQUE INDEX "WorkflowRollout_workflowId_key" ON "WorkflowRollout" ("workflowId");
@@ -0,0 +1,6 @@
+-- Modify "Step" table
+ALTER TABLE "Step" ADD COLUMN "cacheTtl" text NULL;
+-- Create "StepRunResultCache" table
+CREATE TABLE "StepRunResultCache" ("tenantId" uuid NOT NULL, "actionId" text NOT NULL, "inputHash" text NOT NULL, "output" jsonb NOT NULL, "stepRunId" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "expiresAt" timestamp(3) NOT NULL, PRIMARY KEY ("tenantId", "actionId", "inputHash"), CONSTRAINT "StepRunResultCache_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "StepRunResultCache_expiresAt_idx" to table: "StepRunResultCache"
+CREATE INDEX "StepRunResultCache_expiresAt_idx" ON "StepRunResultCache" ("expiresAt");
@@ -0,0 +1,4 @@
+-- Create "TenantDataKey" table
+CREATE TABLE "TenantDataKey" ("id" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "tenantId" uuid NOT NULL, "encryptedKeyset" bytea NOT NULL, "isActive" boolean NOT NULL DEFAULT true, PRIMARY KEY ("id"), CONSTRAINT "TenantDataKey_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "TenantDataKey_tenantId_isActive_key" to table: "TenantDataKey"
+CREATE UNIQUE INDEX "TenantDataKey_tenantId_isActive_key" ON "TenantDataKey" ("tenantId") WHERE "isActive";
@@ -0,0 +1,2 @@
+-- Create "KafkaPartitionOffset" table
+CREATE TABLE "KafkaPartitionOffset" ("consumerGroup" text NOT NULL, "topic" text NOT NULL, "partition" integer NOT NULL, "offset" bigint NULL, "leaseOwner" uuid NULL, "leaseExpiresAt" timestamp(3) NULL, "updatedAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, PRIMARY KEY ("consumerGroup", "topic", "partition"));
@@ -0,0 +1,4 @@
+-- Create "SQSIntegration" table
+CREATE TABLE "SQSIntegration" ("id" uuid NOT NULL DEFAULT gen_random_uuid(), "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "updatedAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "tenantId" uuid NOT NULL, "queueUrl" text NOT NULL, "region" text NOT NULL, "eventKey" text NOT NULL, "accessKeyIdSecret" text NOT NULL, "secretAccessKeySecret" text NOT NULL, "sessionTokenSecret" text NULL, PRIMARY KEY ("id"), CONSTRAINT "SQSIntegration_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "SQSIntegration_tenantId_queueUrl_key" to table: "SQSIntegration"
+CREATE UNIQUE INDEX "SQSIntegration_tenantId_queueUrl_key" ON "SQSIntegration" ("tenantId", "queueUrl");
@@ -0,0 +1,6 @@
+-- Create enum type "InboundWebhookSignatureScheme"
+CREATE TYPE "InboundWebhookSignatureScheme" AS ENUM ('HMAC_SHA256', 'GITHUB', 'STRIPE', 'SVIX');
+-- Create "InboundWebhook" table
+CREATE TABLE "InboundWebhook" ("id" uuid NOT NULL DEFAULT gen_random_uuid(), "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "updatedAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "tenantId" uuid NOT NULL, "name" text NOT NULL, "signatureScheme" "InboundWebhookSignatureScheme" NOT NULL, "signatureHeader" text NULL, "secret" text NOT NULL, "eventKey" text NULL, "eventKeyExpression" text NULL, "dataExpression" text NULL, PRIMARY KEY ("id"), CONSTRAINT "InboundWebhook_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "InboundWebhook_tenantId_name_key" to table: "InboundWebhook"
+CREATE UNIQUE INDEX "InboundWebhook_tenantId_name_key" ON "InboundWebhook" ("tenantId", "name");
@@ -0,0 +1,12 @@
+-- Create enum type "EventSinkKind"
+CREATE TYPE "EventSinkKind" AS ENUM ('KAFKA', 'WEBHOOK', 'NATS');
+-- Create enum type "EventSinkDeliveryStatus"
+CREATE TYPE "EventSinkDeliveryStatus" AS ENUM ('PENDING', 'DEAD');
+-- Create "EventSink" table
+CREATE TABLE "EventSink" ("id" uuid NOT NULL DEFAULT gen_random_uuid(), "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "updatedAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "tenantId" uuid NOT NULL, "name" text NOT NULL, "kind" "EventSinkKind" NOT NULL, "url" text NULL, "brokers" text[] NOT NULL DEFAULT '{}', "topic" text NULL, "tls" boolean NOT NULL DEFAULT false, "secret" text NULL, "usernameSecret" text NULL, "passwordSecret" text NULL, "eventTypes" text[] NOT NULL DEFAULT '{}', "maxAttempts" integer NOT NULL DEFAULT 10, PRIMARY KEY ("id"), CONSTRAINT "EventSink_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "EventSink_tenantId_name_key" to table: "EventSink"
+CREATE UNIQUE INDEX "EventSink_tenantId_name_key" ON "EventSink" ("tenantId", "name");
+-- Create "EventSinkDelivery" table
+CREATE TABLE "EventSinkDelivery" ("id" bigserial NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "sinkId" uuid NOT NULL, "tenantId" uuid NOT NULL, "payload" jsonb NOT NULL, "status" "EventSinkDeliveryStatus" NOT NULL DEFAULT 'PENDING', "attempts" integer NOT NULL DEFAULT 0, "nextAttemptAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "lastError" text NULL, PRIMARY KEY ("id"), CONSTRAINT "EventSinkDelivery_sinkId_fkey" FOREIGN KEY ("sinkId") REFERENCES "EventSink" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "EventSinkDelivery_sinkId_status_nextAttemptAt_idx" to table: "EventSinkDelivery"
+CREATE INDEX "EventSinkDelivery_sinkId_status_nextAttemptAt_idx" ON "EventSinkDelivery" ("sinkId", "status", "nextAttemptAt");
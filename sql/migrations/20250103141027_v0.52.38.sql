@@ -0,0 +1,4 @@
+-- Create enum type "EventSinkFormat"
+CREATE TYPE "EventSinkFormat" AS ENUM ('HATCHET', 'CLOUDEVENTS');
+-- Modify "EventSink" table
+ALTER TABLE "EventSink" ADD COLUMN "format" "EventSinkFormat" NOT NULL DEFAULT 'HATCHET';
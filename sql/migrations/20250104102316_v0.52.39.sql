@@ -0,0 +1,8 @@
+-- Create "EventDedupRule" table
+CREATE TABLE "EventDedupRule" ("id" uuid NOT NULL DEFAULT gen_random_uuid(), "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "updatedAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "tenantId" uuid NOT NULL, "eventKey" text NOT NULL, "expression" text NOT NULL, "windowSeconds" integer NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "EventDedupRule_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "EventDedupRule_tenantId_eventKey_key" to table: "EventDedupRule"
+CREATE UNIQUE INDEX "EventDedupRule_tenantId_eventKey_key" ON "EventDedupRule" ("tenantId", "eventKey");
+-- Create "EventDedupKey" table
+CREATE TABLE "EventDedupKey" ("tenantId" uuid NOT NULL, "eventKey" text NOT NULL, "dedupKey" text NOT NULL, "eventId" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "expiresAt" timestamp(3) NOT NULL, PRIMARY KEY ("tenantId", "eventKey", "dedupKey"), CONSTRAINT "EventDedupKey_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "EventDedupKey_expiresAt_idx" to table: "EventDedupKey"
+CREATE INDEX "EventDedupKey_expiresAt_idx" ON "EventDedupKey" ("expiresAt");
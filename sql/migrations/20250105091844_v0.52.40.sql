@@ -0,0 +1,4 @@
+-- Create "EventRoutingRule" table
+CREATE TABLE "EventRoutingRule" ("id" uuid NOT NULL DEFAULT gen_random_uuid(), "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "updatedAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "tenantId" uuid NOT NULL, "name" text NOT NULL, "eventKey" text NULL, "expression" text NOT NULL, "workflowId" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "EventRoutingRule_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE, CONSTRAINT "EventRoutingRule_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "EventRoutingRule_tenantId_name_key" to table: "EventRoutingRule"
+CREATE UNIQUE INDEX "EventRoutingRule_tenantId_name_key" ON "EventRoutingRule" ("tenantId", "name");
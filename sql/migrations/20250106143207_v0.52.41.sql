@@ -0,0 +1,4 @@
+-- Create "DelayedEvent" table
+CREATE TABLE "DelayedEvent" ("eventId" uuid NOT NULL, "tenantId" uuid NOT NULL, "deliverAfter" timestamp(3) NOT NULL, "idempotencyKey" text NULL, "routedWorkflowIds" uuid[] NULL, "tickerId" uuid NULL, PRIMARY KEY ("eventId"), CONSTRAINT "DelayedEvent_eventId_fkey" FOREIGN KEY ("eventId") REFERENCES "Event" ("id") ON UPDATE CASCADE ON DELETE CASCADE, CONSTRAINT "DelayedEvent_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "DelayedEvent_deliverAfter_idx" to table: "DelayedEvent"
+CREATE INDEX "DelayedEvent_deliverAfter_idx" ON "DelayedEvent" ("deliverAfter");
@@ -0,0 +1,6 @@
+-- Create "MessageQueueItem" table
+CREATE TABLE "MessageQueueItem" ("id" bigserial NOT NULL, "queueName" text NOT NULL, "payload" jsonb NOT NULL, "readAfter" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "expiresAt" timestamp(3) NULL, "attempts" integer NOT NULL DEFAULT 0, PRIMARY KEY ("id"));
+-- Create index "MessageQueueItem_expiresAt_idx" to table: "MessageQueueItem"
+CREATE INDEX "MessageQueueItem_expiresAt_idx" ON "MessageQueueItem" ("expiresAt");
+-- Create index "MessageQueueItem_queueName_readAfter_idx" to table: "MessageQueueItem"
+CREATE INDEX "MessageQueueItem_queueName_readAfter_idx" ON "MessageQueueItem" ("queueName", "readAfter");
@@ -0,0 +1,8 @@
+-- Create "WorkflowRunEventLog" table
+CREATE TABLE "WorkflowRunEventLog" ("id" bigserial NOT NULL, "tenantId" uuid NOT NULL, "workflowRunId" uuid NOT NULL, "key" text NOT NULL, "message" jsonb NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, PRIMARY KEY ("id"), CONSTRAINT "WorkflowRunEventLog_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "WorkflowRunEventLog_tenantId_createdAt_idx" to table: "WorkflowRunEventLog"
+CREATE INDEX "WorkflowRunEventLog_tenantId_createdAt_idx" ON "WorkflowRunEventLog" ("tenantId", "createdAt");
+-- Create index "WorkflowRunEventLog_tenantId_id_idx" to table: "WorkflowRunEventLog"
+CREATE INDEX "WorkflowRunEventLog_tenantId_id_idx" ON "WorkflowRunEventLog" ("tenantId", "id");
+-- Create index "WorkflowRunEventLog_workflowRunId_key_key" to table: "WorkflowRunEventLog"
+CREATE UNIQUE INDEX "WorkflowRunEventLog_workflowRunId_key_key" ON "WorkflowRunEventLog" ("workflowRunId", "key");
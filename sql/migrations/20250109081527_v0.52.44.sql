@@ -0,0 +1,4 @@
+-- Create "StepRunProgress" table
+CREATE TABLE "StepRunProgress" ("stepRunId" uuid NOT NULL, "tenantId" uuid NOT NULL, "percent" double precision NOT NULL, "message" text NULL, "data" jsonb NULL, "updatedAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, PRIMARY KEY ("stepRunId"), CONSTRAINT "StepRunProgress_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "StepRunProgress_tenantId_idx" to table: "StepRunProgress"
+CREATE INDEX "StepRunProgress_tenantId_idx" ON "StepRunProgress" ("tenantId");
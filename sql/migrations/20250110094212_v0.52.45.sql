@@ -0,0 +1,4 @@
+-- Create index "LogLine_tenantId_createdAt_idx" to table: "LogLine"
+CREATE INDEX "LogLine_tenantId_createdAt_idx" ON "LogLine" ("tenantId", "createdAt");
+-- Create index "LogLine_tenantId_stepRunId_id_idx" to table: "LogLine"
+CREATE INDEX "LogLine_tenantId_stepRunId_id_idx" ON "LogLine" ("tenantId", "stepRunId", "id");
@@ -0,0 +1,6 @@
+-- Modify "QueueItem" table
+ALTER TABLE "QueueItem" ADD COLUMN "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP;
+-- Create "QueueMetricsSnapshot" table
+CREATE TABLE "QueueMetricsSnapshot" ("id" bigserial NOT NULL, "tenantId" uuid NOT NULL, "queue" text NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "depth" integer NOT NULL, "latencyMs" bigint NOT NULL, "slots" integer NOT NULL, "usedSlots" integer NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "QueueMetricsSnapshot_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "QueueMetricsSnapshot_tenantId_createdAt_idx" to table: "QueueMetricsSnapshot"
+CREATE INDEX "QueueMetricsSnapshot_tenantId_createdAt_idx" ON "QueueMetricsSnapshot" ("tenantId", "createdAt");
@@ -0,0 +1,2 @@
+-- Modify "Worker" table
+ALTER TABLE "Worker" ADD COLUMN "cpuUtilization" double precision NULL, ADD COLUMN "memoryUtilization" double precision NULL, ADD COLUMN "activeSlots" integer NULL, ADD COLUMN "gauges" jsonb NULL, ADD COLUMN "lastHealthReportAt" timestamp(3) NULL;
@@ -0,0 +1,4 @@
+-- Modify "Step" table
+ALTER TABLE "Step" ADD COLUMN "pool" text NULL;
+-- Modify "Worker" table
+ALTER TABLE "Worker" ADD COLUMN "pool" text NULL;
@@ -0,0 +1,4 @@
+-- Create enum type "APITokenScope"
+CREATE TYPE "APITokenScope" AS ENUM ('ADMIN', 'WORKER', 'READ_ONLY');
+-- Modify "APIToken" table
+ALTER TABLE "APIToken" ADD COLUMN "scope" "APITokenScope" NOT NULL DEFAULT 'ADMIN', ADD COLUMN "rotatedAt" timestamp(3) NULL;
@@ -0,0 +1,6 @@
+-- Create "TenantClientCA" table
+CREATE TABLE "TenantClientCA" ("id" uuid NOT NULL DEFAULT gen_random_uuid(), "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "updatedAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "tenantId" uuid NOT NULL, "name" text NOT NULL, "certificate" text NOT NULL, "spiffeIdPrefix" text NOT NULL, "expiresAt" timestamp(3) NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "TenantClientCA_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "TenantClientCA_tenantId_name_key" to table: "TenantClientCA"
+CREATE UNIQUE INDEX "TenantClientCA_tenantId_name_key" ON "TenantClientCA" ("tenantId", "name");
+-- Create index "TenantClientCA_spiffeIdPrefix_idx" to table: "TenantClientCA"
+CREATE INDEX "TenantClientCA_spiffeIdPrefix_idx" ON "TenantClientCA" ("spiffeIdPrefix");
@@ -0,0 +1,19 @@
+-- Create enum type "AuditLogActorKind"
+CREATE TYPE "AuditLogActorKind" AS ENUM ('USER', 'API_TOKEN');
+-- Create "AuditLog" table
+CREATE TABLE "AuditLog" ("id" uuid NOT NULL DEFAULT gen_random_uuid(), "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "tenantId" uuid NOT NULL, "actorKind" "AuditLogActorKind" NOT NULL, "actorId" uuid NOT NULL, "actorName" text NULL, "operation" text NOT NULL, "method" text NOT NULL, "path" text NOT NULL, "resourceType" text NULL, "resourceId" text NULL, "statusCode" integer NOT NULL, "ipAddress" text NULL, "userAgent" text NULL, PRIMARY KEY ("id"), CONSTRAINT "AuditLog_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "AuditLog_tenantId_createdAt_idx" to table: "AuditLog"
+CREATE INDEX "AuditLog_tenantId_createdAt_idx" ON "AuditLog" ("tenantId", "createdAt" DESC);
+-- Create index "AuditLog_tenantId_actorId_createdAt_idx" to table: "AuditLog"
+CREATE INDEX "AuditLog_tenantId_actorId_createdAt_idx" ON "AuditLog" ("tenantId", "actorId", "createdAt" DESC);
+-- Audit log entries are append-only, they're only deleted with their tenant
+CREATE OR REPLACE FUNCTION prevent_audit_log_update()
+RETURNS trigger AS $$
+BEGIN
+  RAISE EXCEPTION 'Audit log entries cannot be updated.';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER prevent_audit_log_update_before_update
+BEFORE UPDATE ON "AuditLog"
+FOR EACH ROW EXECUTE FUNCTION prevent_audit_log_update();
@@ -0,0 +1,4 @@
+-- Create "TenantQuota" table
+CREATE TABLE "TenantQuota" ("tenantId" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "updatedAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "maxQueuedRuns" integer NULL, "maxWorkers" integer NULL, "maxEventsPerMinute" integer NULL, "maxWorkflowVersions" integer NULL, PRIMARY KEY ("tenantId"), CONSTRAINT "TenantQuota_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "WorkflowRun_tenantId_queued_idx" to table: "WorkflowRun"
+CREATE INDEX "WorkflowRun_tenantId_queued_idx" ON "WorkflowRun" ("tenantId") WHERE (("status" = ANY (ARRAY['PENDING'::"WorkflowRunStatus", 'QUEUED'::"WorkflowRunStatus"])) AND ("deletedAt" IS NULL));
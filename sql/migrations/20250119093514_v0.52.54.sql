@@ -0,0 +1,2 @@
+-- Create "WorkflowVersionDefinition" table
+CREATE TABLE "WorkflowVersionDefinition" ("workflowVersionId" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "definition" jsonb NOT NULL, PRIMARY KEY ("workflowVersionId"), CONSTRAINT "WorkflowVersionDefinition_workflowVersionId_fkey" FOREIGN KEY ("workflowVersionId") REFERENCES "WorkflowVersion" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
@@ -0,0 +1,4 @@
+-- Create "TenantRetentionPolicy" table
+CREATE TABLE "TenantRetentionPolicy" ("tenantId" uuid NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "updatedAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, "workflowRunRetentionPeriod" text NULL, "eventRetentionPeriod" text NULL, PRIMARY KEY ("tenantId"), CONSTRAINT "TenantRetentionPolicy_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "Event_tenantId_deletedAt_idx" to table: "Event"
+CREATE INDEX "Event_tenantId_deletedAt_idx" ON "Event" ("tenantId", "deletedAt") WHERE ("deletedAt" IS NOT NULL);
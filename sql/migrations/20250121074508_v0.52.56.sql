@@ -0,0 +1,67 @@
+-- Partition "StepRun", "Event" and "QueueItem" by range of "createdAt", with a partition per day. The existing tables
+-- are attached as the first partition of the new tables, which holds the rows created before tomorrow, so no rows are
+-- copied. The engine creates the partitions of the next days and drops the partitions whose rows were pruned.
+
+-- Modify "Event" table, foreign keys can't reference the id of a partitioned table
+ALTER TABLE "Event" DROP CONSTRAINT "Event_replayedFromId_fkey";
+-- Modify "DelayedEvent" table
+ALTER TABLE "DelayedEvent" DROP CONSTRAINT "DelayedEvent_eventId_fkey";
+-- Rename the existing tables and their indexes, which frees the names for the partitioned tables
+DO $$
+DECLARE
+    target_table text;
+    idx record;
+BEGIN
+    FOREACH target_table IN ARRAY ARRAY['StepRun', 'Event', 'QueueItem'] LOOP
+        EXECUTE format('ALTER TABLE %I RENAME TO %I', target_table, target_table || '_legacy');
+
+        FOR idx IN SELECT indexname FROM pg_indexes WHERE schemaname = current_schema() AND tablename = target_table || '_legacy' LOOP
+            EXECUTE format('ALTER INDEX %I RENAME TO %I', idx.indexname, 'legacy_' || idx.indexname);
+        END LOOP;
+    END LOOP;
+END $$;
+-- The unique indexes on the id alone can't be kept on partitioned tables
+DROP INDEX "legacy_StepRun_id_key";
+DROP INDEX "legacy_Event_id_key";
+DROP INDEX "legacy_Event_tenantId_deletedAt_idx";
+-- Create "StepRun" table
+CREATE TABLE "StepRun" (LIKE "StepRun_legacy" INCLUDING DEFAULTS INCLUDING CONSTRAINTS, CONSTRAINT "StepRun_pkey" PRIMARY KEY ("id", "createdAt")) PARTITION BY RANGE ("createdAt");
+ALTER SEQUENCE "StepRun_order_seq" OWNED BY "StepRun"."order";
+CREATE INDEX "StepRun_createdAt_idx" ON "StepRun" ("createdAt" ASC);
+CREATE INDEX "StepRun_deletedAt_idx" ON "StepRun" ("deletedAt" ASC);
+CREATE INDEX "StepRun_id_tenantId_idx" ON "StepRun" ("id" ASC, "tenantId" ASC);
+CREATE INDEX "StepRun_jobRunId_status_idx" ON "StepRun" ("jobRunId" ASC, "status" ASC);
+CREATE INDEX "StepRun_jobRunId_tenantId_order_idx" ON "StepRun" ("jobRunId" ASC, "tenantId" ASC, "order" ASC);
+CREATE INDEX "StepRun_stepId_idx" ON "StepRun" ("stepId" ASC);
+CREATE INDEX "StepRun_tenantId_idx" ON "StepRun" ("tenantId" ASC);
+CREATE INDEX "StepRun_workerId_idx" ON "StepRun" ("workerId" ASC);
+CREATE INDEX "StepRun_jobRunId_status_tenantId_idx" ON "StepRun" ("jobRunId", "status", "tenantId") WHERE "status" = 'PENDING';
+CREATE INDEX "StepRun_status_tenantId_idx" ON "StepRun" ("status", "tenantId");
+-- Create "Event" table
+CREATE TABLE "Event" (LIKE "Event_legacy" INCLUDING DEFAULTS INCLUDING CONSTRAINTS, CONSTRAINT "Event_pkey" PRIMARY KEY ("id", "createdAt")) PARTITION BY RANGE ("createdAt");
+CREATE INDEX "Event_createdAt_idx" ON "Event" ("createdAt" ASC);
+CREATE INDEX "Event_deletedAt_idx" ON "Event" ("deletedAt" ASC);
+CREATE INDEX "Event_tenantId_createdAt_idx" ON "Event" ("tenantId" ASC, "createdAt" ASC);
+CREATE INDEX "Event_tenantId_idx" ON "Event" ("tenantId" ASC);
+-- Create "QueueItem" table
+CREATE TABLE "QueueItem" (LIKE "QueueItem_legacy" INCLUDING DEFAULTS INCLUDING CONSTRAINTS, CONSTRAINT "QueueItem_pkey" PRIMARY KEY ("id", "createdAt")) PARTITION BY RANGE ("createdAt");
+ALTER SEQUENCE "QueueItem_id_seq" OWNED BY "QueueItem"."id";
+CREATE INDEX "QueueItem_isQueued_priority_tenantId_queue_id_idx_2" ON "QueueItem" ("isQueued" ASC, "tenantId" ASC, "queue" ASC, "priority" DESC, "id" ASC);
+CREATE INDEX "QueueItem_isQueued_tenantId_queue_deadline_id_idx" ON "QueueItem" ("isQueued" ASC, "tenantId" ASC, "queue" ASC, "deadline" ASC, "id" ASC);
+CREATE INDEX "QueueItem_tenantId_stepRunId_idx" ON "QueueItem" ("tenantId" ASC, "stepRunId" ASC);
+-- Attach the existing tables as the partition of the rows created before tomorrow, and create the partitions of the
+-- next week. Attaching reuses the existing indexes of the tables and builds the primary keys.
+DO $$
+DECLARE
+    target_table text;
+    legacy_end date := (now() AT TIME ZONE 'UTC')::date + 1;
+    day date;
+BEGIN
+    FOREACH target_table IN ARRAY ARRAY['StepRun', 'Event', 'QueueItem'] LOOP
+        EXECUTE format('ALTER TABLE %I ATTACH PARTITION %I FOR VALUES FROM (MINVALUE) TO (%L)', target_table, target_table || '_legacy', legacy_end);
+
+        FOR day IN SELECT generate_series(legacy_end, legacy_end + 6, interval '1 day')::date LOOP
+            EXECUTE format('CREATE TABLE %I PARTITION OF %I FOR VALUES FROM (%L) TO (%L)', target_table || '_' || to_char(day, 'YYYYMMDD'), target_table, day, day + 1);
+        END LOOP;
+    END LOOP;
+END $$;
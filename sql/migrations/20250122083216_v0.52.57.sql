@@ -0,0 +1,2 @@
+-- Create "EngineLease" table
+CREATE TABLE "EngineLease" ("name" text NOT NULL, "holderId" text NOT NULL, "expiresAt" timestamp(3) NOT NULL, PRIMARY KEY ("name"));
@@ -0,0 +1,44 @@
+-- Notify the engines with the tenant id when a workflow definition of the tenant is written, so they invalidate the
+-- definitions which they cached. Notifications with the same payload are sent once per transaction.
+CREATE OR REPLACE FUNCTION notify_workflow_definition_update()
+RETURNS trigger AS $$
+DECLARE
+    row_data record;
+    tenant_id uuid;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        row_data := OLD;
+    ELSE
+        row_data := NEW;
+    END IF;
+
+    IF TG_TABLE_NAME = 'Workflow' THEN
+        tenant_id := row_data."tenantId";
+    ELSIF TG_TABLE_NAME = 'WorkflowVersion' THEN
+        SELECT w."tenantId" INTO tenant_id FROM "Workflow" w WHERE w."id" = row_data."workflowId";
+    ELSE
+        SELECT w."tenantId" INTO tenant_id
+        FROM "WorkflowVersion" wv
+        JOIN "Workflow" w ON w."id" = wv."workflowId"
+        WHERE wv."id" = row_data."workflowVersionId";
+    END IF;
+
+    IF tenant_id IS NOT NULL THEN
+        PERFORM pg_notify('workflow_definitions', tenant_id::text);
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER notify_workflow_definition_update_after_write
+AFTER INSERT OR UPDATE OR DELETE ON "Workflow"
+FOR EACH ROW EXECUTE FUNCTION notify_workflow_definition_update();
+
+CREATE TRIGGER notify_workflow_version_definition_update_after_write
+AFTER INSERT OR UPDATE OR DELETE ON "WorkflowVersion"
+FOR EACH ROW EXECUTE FUNCTION notify_workflow_definition_update();
+
+CREATE TRIGGER notify_workflow_concurrency_definition_update_after_write
+AFTER INSERT OR UPDATE OR DELETE ON "WorkflowConcurrency"
+FOR EACH ROW EXECUTE FUNCTION notify_workflow_definition_update();
@@ -0,0 +1,4 @@
+-- Create "JobRunLookupDataEntry" table
+CREATE TABLE "JobRunLookupDataEntry" ("id" bigserial NOT NULL, "jobRunId" uuid NOT NULL, "tenantId" uuid NOT NULL, "readableId" text NOT NULL, "data" jsonb NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, PRIMARY KEY ("id"), CONSTRAINT "JobRunLookupDataEntry_jobRunId_fkey" FOREIGN KEY ("jobRunId") REFERENCES "JobRun" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
+-- Create index "JobRunLookupDataEntry_jobRunId_id_idx" to table: "JobRunLookupDataEntry"
+CREATE INDEX "JobRunLookupDataEntry_jobRunId_id_idx" ON "JobRunLookupDataEntry" ("jobRunId", "id");
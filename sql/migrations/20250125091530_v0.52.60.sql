@@ -0,0 +1,2 @@
+-- Create "WorkflowRunArchive" table
+CREATE TABLE "WorkflowRunArchive" ("workflowRunId" uuid NOT NULL, "tenantId" uuid NOT NULL, "key" text NOT NULL, "size" integer NOT NULL, "archivedAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, PRIMARY KEY ("workflowRunId"), CONSTRAINT "WorkflowRunArchive_workflowRunId_fkey" FOREIGN KEY ("workflowRunId") REFERENCES "WorkflowRun" ("id") ON UPDATE CASCADE ON DELETE CASCADE);
@@ -0,0 +1,4 @@
+-- Create "StepRunAck" table
+CREATE TABLE "StepRunAck" ("stepRunId" uuid NOT NULL, "attemptId" text NOT NULL, "tenantId" uuid NOT NULL, "eventType" text NOT NULL, "createdAt" timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP, PRIMARY KEY ("stepRunId", "attemptId"));
+-- Create index "StepRunAck_tenantId_createdAt_idx" to table: "StepRunAck"
+CREATE INDEX "StepRunAck_tenantId_createdAt_idx" ON "StepRunAck" ("tenantId", "createdAt");
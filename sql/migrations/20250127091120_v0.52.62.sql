@@ -0,0 +1,4 @@
+-- Create "StepRunHeartbeat" table
+CREATE TABLE "StepRunHeartbeat" ("stepRunId" uuid NOT NULL, "tenantId" uuid NOT NULL, "workerId" uuid NOT NULL, "lastHeartbeatAt" timestamp(3) NOT NULL, PRIMARY KEY ("stepRunId"));
+-- Create index "StepRunHeartbeat_tenantId_lastHeartbeatAt_idx" to table: "StepRunHeartbeat"
+CREATE INDEX "StepRunHeartbeat_tenantId_lastHeartbeatAt_idx" ON "StepRunHeartbeat" ("tenantId", "lastHeartbeatAt");
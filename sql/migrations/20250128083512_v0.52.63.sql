@@ -0,0 +1,10 @@
+-- Create index "Event_tenantId_key_idx" to table: "Event"
+CREATE INDEX "Event_tenantId_key_idx" ON "Event" ("tenantId", "key");
+-- Create index "WorkflowRun_tenantId_createdAt_id_idx" to table: "WorkflowRun"
+CREATE INDEX "WorkflowRun_tenantId_createdAt_id_idx" ON "WorkflowRun" ("tenantId", "createdAt", "id");
+-- Create index "WorkflowRun_tenantId_finishedAt_id_idx" to table: "WorkflowRun"
+CREATE INDEX "WorkflowRun_tenantId_finishedAt_id_idx" ON "WorkflowRun" ("tenantId", "finishedAt", "id");
+-- Create index "WorkflowRun_tenantId_startedAt_id_idx" to table: "WorkflowRun"
+CREATE INDEX "WorkflowRun_tenantId_startedAt_id_idx" ON "WorkflowRun" ("tenantId", "startedAt", "id");
+-- Create index "WorkflowRun_tenantId_status_createdAt_idx" to table: "WorkflowRun"
+CREATE INDEX "WorkflowRun_tenantId_status_createdAt_idx" ON "WorkflowRun" ("tenantId", "status", "createdAt");
@@ -0,0 +1,2 @@
+-- Modify "WorkflowVersion" table
+ALTER TABLE "WorkflowVersion" ADD COLUMN "reservedSlots" integer NULL;
@@ -0,0 +1,2 @@
+-- Create index "QueueItem_isQueued_tenantId_queue_stepId_priority_id_idx" to table: "QueueItem"
+CREATE INDEX "QueueItem_isQueued_tenantId_queue_stepId_priority_id_idx" ON "QueueItem" ("isQueued", "tenantId", "queue", "stepId", "priority" DESC, "id");
@@ -0,0 +1,8 @@
+// Package migrations embeds the migrations of the database schema, so the engines can check that the schema of their
+// database is up to date.
+package migrations
+
+import "embed"
+
+//go:embed *.sql atlas.sum
+var FS embed.FS
@@ -1,9 +1,13 @@
+-- CreateEnum
+CREATE TYPE "APITokenScope" AS ENUM ('ADMIN', 'WORKER', 'READ_ONLY');
+
 -- CreateEnum
 CREATE TYPE "ConcurrencyLimitStrategy" AS ENUM (
     'CANCEL_IN_PROGRESS',
     'DROP_NEWEST',
     'QUEUE_NEWEST',
-    'GROUP_ROUND_ROBIN'
+    'GROUP_ROUND_ROBIN',
+    'QUEUE_NEWEST_ONLY'
 );
 
 
@@ -46,6 +50,12 @@ CREATE TYPE "LimitResource" AS ENUM (
 -- CreateEnum
 CREATE TYPE "LogLineLevel" AS ENUM ('DEBUG', 'INFO', 'WARN', 'ERROR');
 
+-- CreateEnum
+CREATE TYPE "RateLimitAlgorithm" AS ENUM ('FIXED_WINDOW', 'SLIDING_WINDOW', 'TOKEN_BUCKET', 'LEAKY_BUCKET');
+
+-- CreateEnum
+CREATE TYPE "RegionStrategy" AS ENUM ('PIN', 'PREFER');
+
 -- CreateEnum
 CREATE TYPE "StepExpressionKind" AS ENUM (
     'DYNAMIC_RATE_LIMIT_KEY',
@@ -77,7 +87,8 @@ CREATE TYPE "StepRunEventReason" AS ENUM (
     'WORKFLOW_RUN_GROUP_KEY_SUCCEEDED',
     'WORKFLOW_RUN_GROUP_KEY_FAILED',
     'RATE_LIMIT_ERROR',
-    'ACKNOWLEDGED'
+    'ACKNOWLEDGED',
+    'SPECULATIVE_ATTEMPT'
 );
 
 -- CreateEnum
@@ -92,7 +103,8 @@ CREATE TYPE "StepRunStatus" AS ENUM (
     'SUCCEEDED',
     'FAILED',
     'CANCELLED',
-    'CANCELLING'
+    'CANCELLING',
+    'SKIPPED'
 );
 
 -- CreateEnum
@@ -130,7 +142,7 @@ CREATE TYPE "WorkerType" AS ENUM ('WEBHOOK', 'MANAGED', 'SELFHOSTED');
 CREATE TYPE "WorkflowKind" AS ENUM ('FUNCTION', 'DURABLE', 'DAG');
 
 -- CreateEnum
-CREATE TYPE "WorkflowRunStatus" AS ENUM ('PENDING', 'RUNNING', 'SUCCEEDED', 'FAILED', 'QUEUED');
+CREATE TYPE "WorkflowRunStatus" AS ENUM ('PENDING', 'RUNNING', 'SUCCEEDED', 'FAILED', 'QUEUED', 'PAUSED');
 
 -- CreateTable
 CREATE TABLE "APIToken" (
@@ -143,6 +155,10 @@ CREATE TABLE "APIToken" (
     "tenantId" UUID,
     "nextAlertAt" TIMESTAMP(3),
     "internal" BOOLEAN NOT NULL DEFAULT false,
+    -- the APIs which the token can call
+    "scope" "APITokenScope" NOT NULL DEFAULT 'ADMIN',
+    -- when the token was replaced by a new token, after which it only stays valid for the grace period of the rotation
+    "rotatedAt" TIMESTAMP(3),
 
     CONSTRAINT "APIToken_pkey" PRIMARY KEY ("id")
 );
@@ -181,6 +197,7 @@ CREATE TABLE "Dispatcher" (
 );
 
 -- CreateTable
+-- Partitioned by "createdAt", so "id" is only unique along with "createdAt"
 CREATE TABLE "Event" (
     "id" UUID NOT NULL,
     "createdAt" TIMESTAMP(6) NOT NULL DEFAULT clock_timestamp(),
@@ -193,8 +210,8 @@ CREATE TABLE "Event" (
     "additionalMetadata" JSONB,
     "insertOrder" INTEGER,
 
-    CONSTRAINT "Event_pkey" PRIMARY KEY ("id")
-);
+    CONSTRAINT "Event_pkey" PRIMARY KEY ("id", "createdAt")
+) PARTITION BY RANGE ("createdAt");
 
 -- CreateTable
 CREATE TABLE "EventKey" (
@@ -302,6 +319,7 @@ CREATE TABLE "Lease" (
     "tenantId" UUID NOT NULL,
     "resourceId" TEXT NOT NULL,
     "kind" "LeaseKind" NOT NULL,
+    "fencingToken" BIGSERIAL NOT NULL,
 
     CONSTRAINT "Lease_pkey" PRIMARY KEY ("id")
 );
@@ -325,11 +343,13 @@ CREATE TABLE "Queue" (
     "tenantId" UUID NOT NULL,
     "name" TEXT NOT NULL,
     "lastActive" TIMESTAMP(3),
+    "isPaused" BOOLEAN NOT NULL DEFAULT false,
 
     CONSTRAINT "Queue_pkey" PRIMARY KEY ("id")
 );
 
 -- CreateTable
+-- Partitioned by "createdAt", so "id" is only unique along with "createdAt"
 CREATE TABLE "QueueItem" (
     "id" BIGSERIAL NOT NULL,
     "stepRunId" UUID,
@@ -343,9 +363,11 @@ CREATE TABLE "QueueItem" (
     "queue" TEXT NOT NULL,
     "sticky" "StickyStrategy",
     "desiredWorkerId" UUID,
+    "deadline" TIMESTAMP(3),
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
 
-    CONSTRAINT "QueueItem_pkey" PRIMARY KEY ("id")
-);
+    CONSTRAINT "QueueItem_pkey" PRIMARY KEY ("id", "createdAt")
+) PARTITION BY RANGE ("createdAt");
 
 -- CreateTable
 CREATE TABLE "RateLimit" (
@@ -354,7 +376,11 @@ CREATE TABLE "RateLimit" (
     "limitValue" INTEGER NOT NULL,
     "value" INTEGER NOT NULL,
     "window" TEXT NOT NULL,
-    "lastRefill" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
+    "lastRefill" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "algorithm" "RateLimitAlgorithm" NOT NULL DEFAULT 'FIXED_WINDOW',
+    "burst" INTEGER,
+    "prevWindowUsed" INTEGER NOT NULL DEFAULT 0,
+    "expiresAt" TIMESTAMP(3)
 );
 
 -- CreateTable
@@ -391,6 +417,7 @@ CREATE TABLE "SemaphoreQueueItem" (
     "stepRunId" UUID NOT NULL,
     "workerId" UUID NOT NULL,
     "tenantId" UUID NOT NULL,
+    "slotType" TEXT NOT NULL DEFAULT 'default',
 
     CONSTRAINT "SemaphoreQueueItem_pkey" PRIMARY KEY ("stepRunId")
 );
@@ -442,6 +469,36 @@ CREATE TABLE "Step" (
     -- the maximum amount of time in seconds to wait between retries
     "retryMaxBackoff" INTEGER,
     "scheduleTimeout" TEXT NOT NULL DEFAULT '5m',
+    -- the percentile of recent step run durations after which a speculative attempt is launched on another worker
+    "speculativePercentile" INTEGER,
+    -- the worker slot pool which the step consumes a slot from. If null, the step uses the default pool.
+    "slotType" TEXT,
+    -- the maximum time between worker heartbeats while the step is running. If null, only the worker's
+    -- inactivity reassignment applies.
+    "heartbeatTimeout" TEXT,
+    -- the delay in seconds before the first retry. If set, retries wait retryInitialBackoff * retryBackoffFactor^(retryCount - 1)
+    "retryInitialBackoff" INTEGER,
+    -- the fraction (0 to 1) of the retry delay which is randomized
+    "retryJitter" DOUBLE PRECISION,
+    -- if set, the step is a sleep step which waits for this duration instead of running on a worker
+    "sleepFor" TEXT,
+    -- if set, the step is a sleep step which waits until the timestamp returned by this CEL expression
+    "sleepUntil" TEXT,
+    -- if set, the step waits until an event with this key is pushed, instead of running on a worker
+    "waitForEvent" TEXT,
+    -- a CEL expression which is evaluated against both the run input and the event payload. The event only
+    -- matches if both evaluate to the same value.
+    "waitForEventCorrelation" TEXT,
+    -- a CEL expression evaluated against the outputs of the parent steps. If it returns false, the step is skipped.
+    "condition" TEXT,
+    -- if set, the step is a map step which runs once for each element of the list returned by this CEL expression
+    "mapOver" TEXT,
+    -- the maximum number of elements of a map step which run at the same time. If null, all elements run at once.
+    "mapConcurrency" INTEGER,
+    -- if set, the output of the step is cached for this duration, keyed by the action and the input of the step run
+    "cacheTtl" TEXT,
+    -- the worker pool which the step runs in. If null, the step can run on a worker in any pool.
+    "pool" TEXT,
 
     CONSTRAINT "Step_pkey" PRIMARY KEY ("id")
 );
@@ -484,6 +541,7 @@ CREATE TABLE "StepRateLimit" (
 CREATE SEQUENCE steprun_identity_id_seq START 1;
 
 -- CreateTable
+-- Partitioned by "createdAt", so "id" is only unique along with "createdAt"
 CREATE TABLE "StepRun" (
     "id" UUID NOT NULL,
     "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
@@ -515,8 +573,9 @@ CREATE TABLE "StepRun" (
     "queue" TEXT NOT NULL DEFAULT 'default',
     "priority" INTEGER,
     "internalRetryCount" INTEGER NOT NULL DEFAULT 0,
-    CONSTRAINT "StepRun_pkey" PRIMARY KEY ("id")
-);
+    "deadline" TIMESTAMP(3),
+    CONSTRAINT "StepRun_pkey" PRIMARY KEY ("id", "createdAt")
+) PARTITION BY RANGE ("createdAt");
 
 -- CreateTable
 CREATE TABLE "StepRunEvent" (
@@ -834,6 +893,14 @@ CREATE TABLE "Worker" (
     "os" TEXT,
     "runtimeExtra" TEXT,
     "sdkVersion" TEXT,
+    "isDraining" BOOLEAN NOT NULL DEFAULT false,
+    "region" TEXT,
+    "cpuUtilization" DOUBLE PRECISION,
+    "memoryUtilization" DOUBLE PRECISION,
+    "activeSlots" INTEGER,
+    "gauges" JSONB,
+    "lastHealthReportAt" TIMESTAMP(3),
+    "pool" TEXT,
 
     CONSTRAINT "Worker_pkey" PRIMARY KEY ("id")
 );
@@ -910,6 +977,10 @@ CREATE TABLE "WorkflowRun" (
     "duration" BIGINT,
     "priority" INTEGER,
     "insertOrder" INTEGER,
+    "output" JSONB,
+    "replayedFromId" UUID,
+    "replayedFromStepId" UUID,
+    "traceContext" JSONB,
 
     CONSTRAINT "WorkflowRun_pkey" PRIMARY KEY ("id")
 );
@@ -988,7 +1059,11 @@ CREATE TABLE "WorkflowTriggerCronRef" (
     "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
     "name" TEXT,
     "id" UUID NOT NULL,
-    "method" "WorkflowTriggerCronRefMethods" NOT NULL DEFAULT 'DEFAULT'
+    "method" "WorkflowTriggerCronRefMethods" NOT NULL DEFAULT 'DEFAULT',
+    "timezone" TEXT NOT NULL DEFAULT 'UTC',
+    "jitterSeconds" INTEGER NOT NULL DEFAULT 0,
+    -- the calendar of dates on which the cron doesn't trigger
+    "exclusionCalendarId" UUID
 );
 
 -- CreateTable
@@ -1050,6 +1125,13 @@ CREATE TABLE
         "sticky" "StickyStrategy",
         "kind" "WorkflowKind" NOT NULL DEFAULT 'DAG',
         "defaultPriority" INTEGER,
+        "region" TEXT,
+        "regionStrategy" "RegionStrategy",
+        "outputExpression" TEXT,
+        -- a JSON schema which the input of the workflow runs is validated against when they're triggered
+        "inputSchema" JSONB,
+        -- the number of slots which the workflow runs reserve up front, so a DAG isn't starved halfway through
+        "reservedSlots" INTEGER,
         CONSTRAINT "WorkflowVersion_pkey" PRIMARY KEY ("id")
     );
 
@@ -1092,7 +1174,7 @@ CREATE UNIQUE INDEX "Dispatcher_id_key" ON "Dispatcher" ("id" ASC);
 CREATE INDEX "Event_createdAt_idx" ON "Event" ("createdAt" ASC);
 
 -- CreateIndex
-CREATE UNIQUE INDEX "Event_id_key" ON "Event" ("id" ASC);
+CREATE INDEX "Event_deletedAt_idx" ON "Event" ("deletedAt" ASC);
 
 -- CreateIndex
 CREATE INDEX "Event_tenantId_createdAt_idx" ON "Event" ("tenantId" ASC, "createdAt" ASC);
@@ -1100,6 +1182,9 @@ CREATE INDEX "Event_tenantId_createdAt_idx" ON "Event" ("tenantId" ASC, "created
 -- CreateIndex
 CREATE INDEX "Event_tenantId_idx" ON "Event" ("tenantId" ASC);
 
+-- CreateIndex
+CREATE INDEX "Event_tenantId_key_idx" ON "Event" ("tenantId" ASC, "key" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "EventKey_key_tenantId_key" ON "EventKey" ("key" ASC, "tenantId" ASC);
 
@@ -1166,6 +1251,12 @@ CREATE UNIQUE INDEX "JobRunLookupData_jobRunId_tenantId_key" ON "JobRunLookupDat
 -- CreateIndex
 CREATE UNIQUE INDEX "Lease_tenantId_kind_resourceId_key" ON "Lease" ("tenantId" ASC, "kind" ASC, "resourceId" ASC);
 
+-- CreateIndex
+CREATE INDEX "LogLine_tenantId_createdAt_idx" ON "LogLine" ("tenantId" ASC, "createdAt" ASC);
+
+-- CreateIndex
+CREATE INDEX "LogLine_tenantId_stepRunId_id_idx" ON "LogLine" ("tenantId" ASC, "stepRunId" ASC, "id" ASC);
+
 -- CreateIndex
 CREATE INDEX "Queue_tenantId_lastActive_idx" ON "Queue" ("tenantId" ASC, "lastActive" ASC);
 
@@ -1181,9 +1272,34 @@ CREATE INDEX "QueueItem_isQueued_priority_tenantId_queue_id_idx_2" ON "QueueItem
     "id" ASC
 );
 
+-- CreateIndex
+CREATE INDEX "QueueItem_isQueued_tenantId_queue_deadline_id_idx" ON "QueueItem" (
+    "isQueued" ASC,
+    "tenantId" ASC,
+    "queue" ASC,
+    "deadline" ASC,
+    "id" ASC
+);
+
+-- CreateIndex
+CREATE INDEX "QueueItem_isQueued_tenantId_queue_stepId_priority_id_idx" ON "QueueItem" (
+    "isQueued" ASC,
+    "tenantId" ASC,
+    "queue" ASC,
+    "stepId" ASC,
+    "priority" DESC,
+    "id" ASC
+);
+
+-- CreateIndex
+CREATE INDEX "QueueItem_tenantId_stepRunId_idx" ON "QueueItem" ("tenantId" ASC, "stepRunId" ASC);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "RateLimit_tenantId_key_key" ON "RateLimit" ("tenantId" ASC, "key" ASC);
 
+-- CreateIndex
+CREATE INDEX "RateLimit_tenantId_expiresAt_idx" ON "RateLimit" ("tenantId" ASC, "expiresAt" ASC) WHERE ("expiresAt" IS NOT NULL);
+
 -- CreateIndex
 CREATE UNIQUE INDEX "SNSIntegration_id_key" ON "SNSIntegration" ("id" ASC);
 
@@ -1235,9 +1351,6 @@ CREATE INDEX "StepRun_createdAt_idx" ON "StepRun" ("createdAt" ASC);
 -- CreateIndex
 CREATE INDEX "StepRun_deletedAt_idx" ON "StepRun" ("deletedAt" ASC);
 
--- CreateIndex
-CREATE UNIQUE INDEX "StepRun_id_key" ON "StepRun" ("id" ASC, "status" ASC);
-
 -- CreateIndex
 CREATE INDEX "StepRun_id_tenantId_idx" ON "StepRun" ("id" ASC, "tenantId" ASC);
 
@@ -1416,12 +1529,27 @@ CREATE UNIQUE INDEX "WorkflowRun_parentId_parentStepRunId_childKey_key" ON "Work
 -- CreateIndex
 CREATE INDEX "WorkflowRun_status_idx" ON "WorkflowRun" ("status" ASC);
 
+-- CreateIndex
+CREATE INDEX "WorkflowRun_tenantId_createdAt_id_idx" ON "WorkflowRun" ("tenantId" ASC, "createdAt" ASC, "id" ASC);
+
 -- CreateIndex
 CREATE INDEX "WorkflowRun_tenantId_createdAt_idx" ON "WorkflowRun" ("tenantId" ASC, "createdAt" ASC);
 
+-- CreateIndex
+CREATE INDEX "WorkflowRun_tenantId_finishedAt_id_idx" ON "WorkflowRun" ("tenantId" ASC, "finishedAt" ASC, "id" ASC);
+
 -- CreateIndex
 CREATE INDEX "WorkflowRun_tenantId_idx" ON "WorkflowRun" ("tenantId" ASC);
 
+-- CreateIndex
+CREATE INDEX "WorkflowRun_tenantId_queued_idx" ON "WorkflowRun" ("tenantId") WHERE ("status" IN ('PENDING', 'QUEUED') AND "deletedAt" IS NULL);
+
+-- CreateIndex
+CREATE INDEX "WorkflowRun_tenantId_startedAt_id_idx" ON "WorkflowRun" ("tenantId" ASC, "startedAt" ASC, "id" ASC);
+
+-- CreateIndex
+CREATE INDEX "WorkflowRun_tenantId_status_createdAt_idx" ON "WorkflowRun" ("tenantId" ASC, "status" ASC, "createdAt" ASC);
+
 -- CreateIndex
 CREATE INDEX "WorkflowRun_workflowVersionId_idx" ON "WorkflowRun" ("workflowVersionId" ASC);
 
@@ -1525,9 +1653,6 @@ ALTER TABLE "APIToken" ADD CONSTRAINT "APIToken_tenantId_fkey" FOREIGN KEY ("ten
 -- AddForeignKey
 ALTER TABLE "Action" ADD CONSTRAINT "Action_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
--- AddForeignKey
-ALTER TABLE "Event" ADD CONSTRAINT "Event_replayedFromId_fkey" FOREIGN KEY ("replayedFromId") REFERENCES "Event" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
-
 -- AddForeignKey
 ALTER TABLE "GetGroupKeyRun" ADD CONSTRAINT "GetGroupKeyRun_tickerId_fkey" FOREIGN KEY ("tickerId") REFERENCES "Ticker" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
 
@@ -1651,6 +1776,9 @@ ALTER TABLE "WorkflowConcurrency" ADD CONSTRAINT "WorkflowConcurrency_workflowVe
 -- AddForeignKey
 ALTER TABLE "WorkflowRun" ADD CONSTRAINT "WorkflowRun_parentId_fkey" FOREIGN KEY ("parentId") REFERENCES "WorkflowRun" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
 
+-- AddForeignKey
+ALTER TABLE "WorkflowRun" ADD CONSTRAINT "WorkflowRun_replayedFromId_fkey" FOREIGN KEY ("replayedFromId") REFERENCES "WorkflowRun" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
 -- AddForeignKey
 ALTER TABLE "WorkflowRunStickyState" ADD CONSTRAINT "WorkflowRunStickyState_workflowRunId_fkey" FOREIGN KEY ("workflowRunId") REFERENCES "WorkflowRun" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
 
@@ -1767,6 +1895,8 @@ CREATE INDEX IF NOT EXISTS "WorkflowRun_parentId_parentStepRunId_childIndex_key"
 WHERE
     "deletedAt" IS NULL;
 
+CREATE INDEX IF NOT EXISTS "WorkflowRun_additionalMetadata_idx" ON "WorkflowRun" USING GIN ("additionalMetadata" jsonb_path_ops);
+
 CREATE INDEX IF NOT EXISTS "StepRun_status_tenantId_idx" ON "StepRun" ("status", "tenantId");
 
 -- CreateTable
@@ -1780,3 +1910,820 @@ CREATE TABLE "RetryQueueItem" (
 
 -- CreateIndex
 CREATE INDEX "RetryQueueItem_isQueued_tenantId_retryAfter_idx" ON "RetryQueueItem" ("isQueued" ASC, "tenantId" ASC, "retryAfter" ASC);
+
+-- CreateTable
+CREATE TABLE "SlotReservation" (
+    "workflowRunId" UUID NOT NULL,
+    "tenantId" UUID NOT NULL,
+    "slots" INTEGER NOT NULL,
+    "expiresAt" TIMESTAMP(3) NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "SlotReservation_pkey" PRIMARY KEY ("workflowRunId")
+);
+
+-- CreateIndex
+CREATE INDEX "SlotReservation_tenantId_expiresAt_idx" ON "SlotReservation" ("tenantId" ASC, "expiresAt" ASC);
+
+-- CreateEnum
+CREATE TYPE "SchedulingDecisionOutcome" AS ENUM ('ASSIGNED', 'NO_SLOTS', 'RATE_LIMITED');
+
+-- CreateTable
+CREATE TABLE "SchedulingDecision" (
+    "id" BIGSERIAL NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "stepRunId" UUID NOT NULL,
+    "queue" TEXT NOT NULL,
+    "outcome" "SchedulingDecisionOutcome" NOT NULL,
+    "workerId" UUID,
+    "message" TEXT NOT NULL,
+    "data" JSONB,
+
+    CONSTRAINT "SchedulingDecision_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "SchedulingDecision_tenantId_id_idx" ON "SchedulingDecision" ("tenantId" ASC, "id" ASC);
+
+-- CreateIndex
+CREATE INDEX "SchedulingDecision_tenantId_stepRunId_id_idx" ON "SchedulingDecision" ("tenantId" ASC, "stepRunId" ASC, "id" ASC);
+
+-- CreateTable
+CREATE TABLE "StepRunSpeculativeAttempt" (
+    "stepRunId" UUID NOT NULL,
+    "retryCount" INTEGER NOT NULL,
+    "tenantId" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "primaryWorkerId" UUID NOT NULL,
+    "workerId" UUID NOT NULL,
+    "winnerWorkerId" UUID,
+    "failedAttempts" INTEGER NOT NULL DEFAULT 0,
+
+    CONSTRAINT "StepRunSpeculativeAttempt_pkey" PRIMARY KEY ("stepRunId", "retryCount")
+);
+
+-- CreateIndex
+CREATE INDEX "StepRunSpeculativeAttempt_tenantId_createdAt_idx" ON "StepRunSpeculativeAttempt" ("tenantId" ASC, "createdAt" ASC);
+
+-- CreateTable
+CREATE TABLE "WorkerSlotPool" (
+    "workerId" UUID NOT NULL,
+    "slotType" TEXT NOT NULL,
+    "maxRuns" INTEGER NOT NULL,
+
+    CONSTRAINT "WorkerSlotPool_pkey" PRIMARY KEY ("workerId", "slotType")
+);
+
+-- AddForeignKey
+ALTER TABLE "WorkerSlotPool" ADD CONSTRAINT "WorkerSlotPool_workerId_fkey" FOREIGN KEY ("workerId") REFERENCES "Worker" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "GlobalRateLimit" (
+    "key" TEXT NOT NULL,
+    "limitValue" INTEGER NOT NULL,
+    "value" INTEGER NOT NULL,
+    "window" TEXT NOT NULL,
+    "lastRefill" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "GlobalRateLimit_pkey" PRIMARY KEY ("key")
+);
+
+-- CreateTable
+CREATE TABLE "StepGlobalRateLimit" (
+    "units" INTEGER NOT NULL,
+    "stepId" UUID NOT NULL,
+    "rateLimitKey" TEXT NOT NULL,
+    "tenantId" UUID NOT NULL,
+
+    CONSTRAINT "StepGlobalRateLimit_pkey" PRIMARY KEY ("stepId", "rateLimitKey")
+);
+
+-- AddForeignKey
+ALTER TABLE "StepGlobalRateLimit" ADD CONSTRAINT "StepGlobalRateLimit_rateLimitKey_fkey" FOREIGN KEY ("rateLimitKey") REFERENCES "GlobalRateLimit" ("key") ON DELETE RESTRICT ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "StepGlobalRateLimit" ADD CONSTRAINT "StepGlobalRateLimit_stepId_fkey" FOREIGN KEY ("stepId") REFERENCES "Step" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "DeadLetterQueueItem" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "stepRunId" UUID NOT NULL,
+    "stepId" UUID NOT NULL,
+    "workflowRunId" UUID NOT NULL,
+    "retryCount" INTEGER NOT NULL,
+    "input" JSONB,
+    "error" TEXT,
+    -- the errors of the previous attempts of the step run, oldest first
+    "errorChain" TEXT[] NOT NULL DEFAULT '{}',
+
+    CONSTRAINT "DeadLetterQueueItem_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "DeadLetterQueueItem_stepRunId_key" ON "DeadLetterQueueItem" ("stepRunId" ASC);
+
+-- CreateIndex
+CREATE INDEX "DeadLetterQueueItem_tenantId_createdAt_idx" ON "DeadLetterQueueItem" ("tenantId" ASC, "createdAt" ASC);
+
+-- AddForeignKey
+ALTER TABLE "DeadLetterQueueItem" ADD CONSTRAINT "DeadLetterQueueItem_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "StepRunTimer" (
+    "stepRunId" UUID NOT NULL,
+    "tenantId" UUID NOT NULL,
+    "wakeAt" TIMESTAMP(3) NOT NULL,
+    "tickerId" UUID,
+
+    CONSTRAINT "StepRunTimer_pkey" PRIMARY KEY ("stepRunId")
+);
+
+-- CreateIndex
+CREATE INDEX "StepRunTimer_wakeAt_idx" ON "StepRunTimer" ("wakeAt" ASC);
+
+-- AddForeignKey
+ALTER TABLE "StepRunTimer" ADD CONSTRAINT "StepRunTimer_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "StepRunSignalWait" (
+    "stepRunId" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "eventKey" TEXT NOT NULL,
+    "correlationExpr" TEXT,
+    -- the value of the correlation expression for the run input
+    "correlationValue" TEXT,
+
+    CONSTRAINT "StepRunSignalWait_pkey" PRIMARY KEY ("stepRunId")
+);
+
+-- CreateIndex
+CREATE INDEX "StepRunSignalWait_tenantId_eventKey_idx" ON "StepRunSignalWait" ("tenantId" ASC, "eventKey" ASC);
+
+-- AddForeignKey
+ALTER TABLE "StepRunSignalWait" ADD CONSTRAINT "StepRunSignalWait_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateEnum
+CREATE TYPE "StepRunApprovalStatus" AS ENUM ('PENDING', 'APPROVED', 'REJECTED', 'TIMED_OUT');
+
+-- CreateTable
+CREATE TABLE "StepRunApproval" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "stepRunId" UUID NOT NULL,
+    "workflowRunId" UUID NOT NULL,
+    -- the secret which allows the approval to be decided without an API token
+    "token" TEXT NOT NULL,
+    "status" "StepRunApprovalStatus" NOT NULL DEFAULT 'PENDING',
+    -- the payload of the approver, which becomes the output of the step run when approved
+    "payload" JSONB,
+    "decidedAt" TIMESTAMP(3),
+
+    CONSTRAINT "StepRunApproval_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "StepRunApproval_stepRunId_key" ON "StepRunApproval" ("stepRunId" ASC);
+
+-- CreateIndex
+CREATE INDEX "StepRunApproval_tenantId_createdAt_idx" ON "StepRunApproval" ("tenantId" ASC, "createdAt" ASC);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "StepRunApproval_token_key" ON "StepRunApproval" ("token" ASC);
+
+-- AddForeignKey
+ALTER TABLE "StepRunApproval" ADD CONSTRAINT "StepRunApproval_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateEnum
+CREATE TYPE "StepRunMapItemStatus" AS ENUM ('PENDING', 'QUEUED', 'SUCCEEDED', 'FAILED');
+
+-- CreateTable
+CREATE TABLE "StepRunMapItem" (
+    -- the step run which runs the element
+    "stepRunId" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    -- the step run of the map step, which finishes once all elements have succeeded
+    "mapStepRunId" UUID NOT NULL,
+    "index" INTEGER NOT NULL,
+    "status" "StepRunMapItemStatus" NOT NULL DEFAULT 'PENDING',
+    "output" JSONB,
+
+    CONSTRAINT "StepRunMapItem_pkey" PRIMARY KEY ("stepRunId")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "StepRunMapItem_mapStepRunId_index_key" ON "StepRunMapItem" ("mapStepRunId" ASC, "index" ASC);
+
+-- AddForeignKey
+ALTER TABLE "StepRunMapItem" ADD CONSTRAINT "StepRunMapItem_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "WorkflowRunIdempotencyKey" (
+    "tenantId" UUID NOT NULL,
+    "workflowId" UUID NOT NULL,
+    "key" TEXT NOT NULL,
+    -- the workflow run which was created for the key
+    "workflowRunId" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- after this time, the key may be claimed by a new workflow run
+    "expiresAt" TIMESTAMP(3) NOT NULL,
+
+    CONSTRAINT "WorkflowRunIdempotencyKey_pkey" PRIMARY KEY ("tenantId", "workflowId", "key")
+);
+
+-- CreateIndex
+CREATE INDEX "WorkflowRunIdempotencyKey_expiresAt_idx" ON "WorkflowRunIdempotencyKey" ("expiresAt" ASC);
+
+-- CreateIndex
+CREATE INDEX "WorkflowRunIdempotencyKey_workflowRunId_idx" ON "WorkflowRunIdempotencyKey" ("workflowRunId" ASC);
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunIdempotencyKey" ADD CONSTRAINT "WorkflowRunIdempotencyKey_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "CronExclusionCalendar" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    -- the dates which are excluded, evaluated in the timezone of the cron
+    "dates" DATE[] NOT NULL DEFAULT ARRAY[]::DATE[],
+    -- an iCal feed whose events are excluded, in addition to the dates
+    "icalUrl" TEXT,
+
+    CONSTRAINT "CronExclusionCalendar_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "CronExclusionCalendar_tenantId_name_key" ON "CronExclusionCalendar" ("tenantId" ASC, "name" ASC);
+
+-- AddForeignKey
+ALTER TABLE "CronExclusionCalendar" ADD CONSTRAINT "CronExclusionCalendar_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowTriggerCronRef" ADD CONSTRAINT "WorkflowTriggerCronRef_exclusionCalendarId_fkey" FOREIGN KEY ("exclusionCalendarId") REFERENCES "CronExclusionCalendar" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "WorkflowRollout" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "workflowId" UUID NOT NULL,
+    -- the version which new runs are triggered on, unless they're part of the canary
+    "stableVersionId" UUID NOT NULL,
+    -- the percentage of new runs which are triggered on the latest version of the workflow
+    "canaryPercentage" INTEGER NOT NULL DEFAULT 0,
+
+    CONSTRAINT "WorkflowRollout_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowRollout_workflowId_key" ON "WorkflowRollout" ("workflowId" ASC);
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRollout" ADD CONSTRAINT "WorkflowRollout_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRollout" ADD CONSTRAINT "WorkflowRollout_stableVersionId_fkey" FOREIGN KEY ("stableVersionId") REFERENCES "WorkflowVersion" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "StepRunResultCache" (
+    "tenantId" UUID NOT NULL,
+    "actionId" TEXT NOT NULL,
+    -- the sha256 hash of the input of the step run
+    "inputHash" TEXT NOT NULL,
+    "output" JSONB NOT NULL,
+    -- the step run which the output was cached from
+    "stepRunId" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- after this time, the output is not used anymore and is overwritten by the next step run which succeeds
+    "expiresAt" TIMESTAMP(3) NOT NULL,
+
+    CONSTRAINT "StepRunResultCache_pkey" PRIMARY KEY ("tenantId", "actionId", "inputHash")
+);
+
+-- CreateIndex
+CREATE INDEX "StepRunResultCache_expiresAt_idx" ON "StepRunResultCache" ("expiresAt" ASC);
+
+-- AddForeignKey
+ALTER TABLE "StepRunResultCache" ADD CONSTRAINT "StepRunResultCache_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "TenantDataKey" (
+    "id" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    -- the keyset which payloads of the tenant are encrypted with, encrypted with the master key
+    "encryptedKeyset" BYTEA NOT NULL,
+    -- new payloads are encrypted with the active data key. inactive data keys are kept to decrypt existing payloads.
+    "isActive" BOOLEAN NOT NULL DEFAULT true,
+
+    CONSTRAINT "TenantDataKey_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "TenantDataKey_tenantId_isActive_key" ON "TenantDataKey" ("tenantId") WHERE "isActive";
+
+-- AddForeignKey
+ALTER TABLE "TenantDataKey" ADD CONSTRAINT "TenantDataKey_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "KafkaPartitionOffset" (
+    "consumerGroup" TEXT NOT NULL,
+    "topic" TEXT NOT NULL,
+    "partition" INTEGER NOT NULL,
+    -- the offset of the next record to ingest from the partition, null if no records have been ingested yet
+    "offset" BIGINT,
+    -- the engine which consumes the partition, and when its lease on the partition expires
+    "leaseOwner" UUID,
+    "leaseExpiresAt" TIMESTAMP(3),
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "KafkaPartitionOffset_pkey" PRIMARY KEY ("consumerGroup","topic","partition")
+);
+
+-- CreateTable
+CREATE TABLE "SQSIntegration" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "queueUrl" TEXT NOT NULL,
+    "region" TEXT NOT NULL,
+    -- the key of the events which messages are ingested as
+    "eventKey" TEXT NOT NULL,
+    -- the names of the tenant secrets which the AWS credentials are stored in
+    "accessKeyIdSecret" TEXT NOT NULL,
+    "secretAccessKeySecret" TEXT NOT NULL,
+    "sessionTokenSecret" TEXT,
+
+    CONSTRAINT "SQSIntegration_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "SQSIntegration_tenantId_queueUrl_key" ON "SQSIntegration" ("tenantId", "queueUrl");
+
+-- AddForeignKey
+ALTER TABLE "SQSIntegration" ADD CONSTRAINT "SQSIntegration_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateEnum
+CREATE TYPE "InboundWebhookSignatureScheme" AS ENUM ('HMAC_SHA256', 'GITHUB', 'STRIPE', 'SVIX');
+
+-- CreateTable
+CREATE TABLE "InboundWebhook" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "signatureScheme" "InboundWebhookSignatureScheme" NOT NULL,
+    -- the header which contains the signature, only used by the HMAC_SHA256 scheme
+    "signatureHeader" TEXT,
+    -- the signing secret, encrypted with the tenant id as the associated data
+    "secret" TEXT NOT NULL,
+    -- either a static event key or a CEL expression which evaluates to the event key
+    "eventKey" TEXT,
+    "eventKeyExpression" TEXT,
+    -- a CEL expression which evaluates to the data of the event, the body is used if it's not set
+    "dataExpression" TEXT,
+
+    CONSTRAINT "InboundWebhook_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "InboundWebhook_tenantId_name_key" ON "InboundWebhook" ("tenantId", "name");
+
+-- AddForeignKey
+ALTER TABLE "InboundWebhook" ADD CONSTRAINT "InboundWebhook_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateEnum
+CREATE TYPE "EventSinkKind" AS ENUM ('KAFKA', 'WEBHOOK', 'NATS');
+
+-- CreateEnum
+CREATE TYPE "EventSinkDeliveryStatus" AS ENUM ('PENDING', 'DEAD');
+
+-- CreateEnum
+CREATE TYPE "EventSinkFormat" AS ENUM ('HATCHET', 'CLOUDEVENTS');
+
+-- CreateTable
+CREATE TABLE "EventSink" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    "kind" "EventSinkKind" NOT NULL,
+    -- the URL of webhook sinks, or the server URL of NATS sinks
+    "url" TEXT,
+    -- the brokers of Kafka sinks
+    "brokers" TEXT[] NOT NULL DEFAULT '{}',
+    -- the topic of Kafka sinks, or the subject of NATS sinks
+    "topic" TEXT,
+    "tls" BOOLEAN NOT NULL DEFAULT false,
+    -- the signing secret of webhook sinks, encrypted with the tenant id as the associated data
+    "secret" TEXT,
+    -- the names of the tenant secrets which the credentials of Kafka and NATS sinks are stored in
+    "usernameSecret" TEXT,
+    "passwordSecret" TEXT,
+    -- the types of the records which are delivered to the sink, all records are delivered if it's empty
+    "eventTypes" TEXT[] NOT NULL DEFAULT '{}',
+    "maxAttempts" INTEGER NOT NULL DEFAULT 10,
+    -- the format of the records which are delivered to the sink
+    "format" "EventSinkFormat" NOT NULL DEFAULT 'HATCHET',
+
+    CONSTRAINT "EventSink_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "EventSink_tenantId_name_key" ON "EventSink" ("tenantId", "name");
+
+-- AddForeignKey
+ALTER TABLE "EventSink" ADD CONSTRAINT "EventSink_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "EventSinkDelivery" (
+    "id" BIGSERIAL NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "sinkId" UUID NOT NULL,
+    "tenantId" UUID NOT NULL,
+    "payload" JSONB NOT NULL,
+    "status" "EventSinkDeliveryStatus" NOT NULL DEFAULT 'PENDING',
+    "attempts" INTEGER NOT NULL DEFAULT 0,
+    "nextAttemptAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "lastError" TEXT,
+
+    CONSTRAINT "EventSinkDelivery_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "EventSinkDelivery_sinkId_status_nextAttemptAt_idx" ON "EventSinkDelivery" ("sinkId", "status", "nextAttemptAt");
+
+-- AddForeignKey
+ALTER TABLE "EventSinkDelivery" ADD CONSTRAINT "EventSinkDelivery_sinkId_fkey" FOREIGN KEY ("sinkId") REFERENCES "EventSink" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "EventDedupRule" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "eventKey" TEXT NOT NULL,
+    -- a CEL expression which evaluates to the dedup key of an event
+    "expression" TEXT NOT NULL,
+    -- events with the same dedup key which are processed within the window of the first event are duplicates
+    "windowSeconds" INTEGER NOT NULL,
+
+    CONSTRAINT "EventDedupRule_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "EventDedupRule_tenantId_eventKey_key" ON "EventDedupRule" ("tenantId", "eventKey");
+
+-- AddForeignKey
+ALTER TABLE "EventDedupRule" ADD CONSTRAINT "EventDedupRule_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "EventDedupKey" (
+    "tenantId" UUID NOT NULL,
+    "eventKey" TEXT NOT NULL,
+    "dedupKey" TEXT NOT NULL,
+    -- the event which triggered workflows for the key
+    "eventId" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- after this time, the key may be claimed by a new event
+    "expiresAt" TIMESTAMP(3) NOT NULL,
+
+    CONSTRAINT "EventDedupKey_pkey" PRIMARY KEY ("tenantId", "eventKey", "dedupKey")
+);
+
+-- CreateIndex
+CREATE INDEX "EventDedupKey_expiresAt_idx" ON "EventDedupKey" ("expiresAt" ASC);
+
+-- AddForeignKey
+ALTER TABLE "EventDedupKey" ADD CONSTRAINT "EventDedupKey_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "EventRoutingRule" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    -- the key of the events the rule applies to, or null if the rule applies to events with any key
+    "eventKey" TEXT,
+    -- a CEL predicate over the data and the additional metadata of an event
+    "expression" TEXT NOT NULL,
+    -- the workflow which is triggered by the events which match the predicate
+    "workflowId" UUID NOT NULL,
+
+    CONSTRAINT "EventRoutingRule_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "EventRoutingRule_tenantId_name_key" ON "EventRoutingRule" ("tenantId", "name");
+
+-- AddForeignKey
+ALTER TABLE "EventRoutingRule" ADD CONSTRAINT "EventRoutingRule_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "EventRoutingRule" ADD CONSTRAINT "EventRoutingRule_workflowId_fkey" FOREIGN KEY ("workflowId") REFERENCES "Workflow" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "DelayedEvent" (
+    "eventId" UUID NOT NULL,
+    "tenantId" UUID NOT NULL,
+    -- the time after which the event triggers workflows
+    "deliverAfter" TIMESTAMP(3) NOT NULL,
+    -- the idempotency key for the workflow runs triggered by the event
+    "idempotencyKey" TEXT,
+    -- the workflows which the event is routed to by the routing rules of the tenant
+    "routedWorkflowIds" UUID[],
+    "tickerId" UUID,
+
+    CONSTRAINT "DelayedEvent_pkey" PRIMARY KEY ("eventId")
+);
+
+-- CreateIndex
+CREATE INDEX "DelayedEvent_deliverAfter_idx" ON "DelayedEvent" ("deliverAfter" ASC);
+
+-- AddForeignKey
+ALTER TABLE "DelayedEvent" ADD CONSTRAINT "DelayedEvent_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "MessageQueueItem" (
+    "id" BIGSERIAL NOT NULL,
+    "queueName" TEXT NOT NULL,
+    "payload" JSONB NOT NULL,
+    -- the time after which the message can be read, which is pushed back while a subscriber handles the message
+    "readAfter" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- messages of queues which don't survive restarts are dropped once they expire
+    "expiresAt" TIMESTAMP(3),
+    "attempts" INTEGER NOT NULL DEFAULT 0,
+
+    CONSTRAINT "MessageQueueItem_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "MessageQueueItem_queueName_readAfter_idx" ON "MessageQueueItem" ("queueName" ASC, "readAfter" ASC);
+
+-- CreateIndex
+CREATE INDEX "MessageQueueItem_expiresAt_idx" ON "MessageQueueItem" ("expiresAt" ASC);
+
+-- CreateTable
+CREATE TABLE "WorkflowRunEventLog" (
+    -- the id is the sequence of the event, which resume tokens of run status subscriptions refer to
+    "id" BIGSERIAL NOT NULL,
+    "tenantId" UUID NOT NULL,
+    "workflowRunId" UUID NOT NULL,
+    -- the hash of the message of the event, so the event is stored once by every dispatcher which receives it
+    "key" TEXT NOT NULL,
+    "message" JSONB NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "WorkflowRunEventLog_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "WorkflowRunEventLog_workflowRunId_key_key" ON "WorkflowRunEventLog" ("workflowRunId", "key");
+
+-- CreateIndex
+CREATE INDEX "WorkflowRunEventLog_tenantId_id_idx" ON "WorkflowRunEventLog" ("tenantId" ASC, "id" ASC);
+
+-- CreateIndex
+CREATE INDEX "WorkflowRunEventLog_tenantId_createdAt_idx" ON "WorkflowRunEventLog" ("tenantId" ASC, "createdAt" ASC);
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunEventLog" ADD CONSTRAINT "WorkflowRunEventLog_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "StepRunProgress" (
+    "stepRunId" UUID NOT NULL,
+    "tenantId" UUID NOT NULL,
+    -- the percentage of the step run which is done, between 0 and 100
+    "percent" DOUBLE PRECISION NOT NULL,
+    "message" TEXT,
+    -- arbitrary data which the step run reports along with its progress
+    "data" JSONB,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "StepRunProgress_pkey" PRIMARY KEY ("stepRunId")
+);
+
+-- CreateIndex
+CREATE INDEX "StepRunProgress_tenantId_idx" ON "StepRunProgress" ("tenantId" ASC);
+
+-- AddForeignKey
+ALTER TABLE "StepRunProgress" ADD CONSTRAINT "StepRunProgress_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "QueueMetricsSnapshot" (
+    "id" BIGSERIAL NOT NULL,
+    "tenantId" UUID NOT NULL,
+    "queue" TEXT NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- the number of queued items
+    "depth" INTEGER NOT NULL,
+    -- the time in milliseconds which the oldest queued item has waited
+    "latencyMs" BIGINT NOT NULL,
+    -- the slots of the active workers which can run the actions of the queue, and how many of them are used
+    "slots" INTEGER NOT NULL,
+    "usedSlots" INTEGER NOT NULL,
+
+    CONSTRAINT "QueueMetricsSnapshot_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "QueueMetricsSnapshot_tenantId_createdAt_idx" ON "QueueMetricsSnapshot" ("tenantId" ASC, "createdAt" ASC);
+
+-- AddForeignKey
+ALTER TABLE "QueueMetricsSnapshot" ADD CONSTRAINT "QueueMetricsSnapshot_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "TenantClientCA" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "name" TEXT NOT NULL,
+    -- the PEM encoded CA certificate which the client certificates of the tenant are verified with
+    "certificate" TEXT NOT NULL,
+    -- client certificates whose SPIFFE ID starts with the prefix authenticate as the tenant
+    "spiffeIdPrefix" TEXT NOT NULL,
+    -- when the CA certificate expires
+    "expiresAt" TIMESTAMP(3) NOT NULL,
+
+    CONSTRAINT "TenantClientCA_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "TenantClientCA_tenantId_name_key" ON "TenantClientCA" ("tenantId", "name");
+
+-- CreateIndex
+CREATE INDEX "TenantClientCA_spiffeIdPrefix_idx" ON "TenantClientCA" ("spiffeIdPrefix");
+
+-- AddForeignKey
+ALTER TABLE "TenantClientCA" ADD CONSTRAINT "TenantClientCA_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateEnum
+CREATE TYPE "AuditLogActorKind" AS ENUM ('USER', 'API_TOKEN');
+
+-- CreateTable
+CREATE TABLE "AuditLog" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "tenantId" UUID NOT NULL,
+    "actorKind" "AuditLogActorKind" NOT NULL,
+    -- the id of the user or API token which called the API
+    "actorId" UUID NOT NULL,
+    -- the email of the user or the name of the API token when the API was called
+    "actorName" TEXT,
+    -- the operation id of the API call
+    "operation" TEXT NOT NULL,
+    "method" TEXT NOT NULL,
+    "path" TEXT NOT NULL,
+    -- the resource of the request path which the call operated on
+    "resourceType" TEXT,
+    "resourceId" TEXT,
+    "statusCode" INTEGER NOT NULL,
+    "ipAddress" TEXT,
+    "userAgent" TEXT,
+
+    CONSTRAINT "AuditLog_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "AuditLog_tenantId_createdAt_idx" ON "AuditLog" ("tenantId", "createdAt" DESC);
+
+-- CreateIndex
+CREATE INDEX "AuditLog_tenantId_actorId_createdAt_idx" ON "AuditLog" ("tenantId", "actorId", "createdAt" DESC);
+
+-- AddForeignKey
+ALTER TABLE "AuditLog" ADD CONSTRAINT "AuditLog_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "TenantQuota" (
+    "tenantId" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- the quotas of the tenant, the server defaults apply to the quotas which are null and 0 is unlimited
+    "maxQueuedRuns" INTEGER,
+    "maxWorkers" INTEGER,
+    "maxEventsPerMinute" INTEGER,
+    "maxWorkflowVersions" INTEGER,
+
+    CONSTRAINT "TenantQuota_pkey" PRIMARY KEY ("tenantId")
+);
+
+-- AddForeignKey
+ALTER TABLE "TenantQuota" ADD CONSTRAINT "TenantQuota_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "WorkflowVersionDefinition" (
+    "workflowVersionId" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- the definition which the workflow version was registered with, which tenant exports recreate the version from
+    "definition" JSONB NOT NULL,
+
+    CONSTRAINT "WorkflowVersionDefinition_pkey" PRIMARY KEY ("workflowVersionId")
+);
+
+-- AddForeignKey
+ALTER TABLE "WorkflowVersionDefinition" ADD CONSTRAINT "WorkflowVersionDefinition_workflowVersionId_fkey" FOREIGN KEY ("workflowVersionId") REFERENCES "WorkflowVersion" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "TenantRetentionPolicy" (
+    "tenantId" UUID NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- the retention periods of the tenant as Go durations, the data retention period of the tenant applies to the
+    -- periods which are null
+    "workflowRunRetentionPeriod" TEXT,
+    "eventRetentionPeriod" TEXT,
+
+    CONSTRAINT "TenantRetentionPolicy_pkey" PRIMARY KEY ("tenantId")
+);
+
+-- AddForeignKey
+ALTER TABLE "TenantRetentionPolicy" ADD CONSTRAINT "TenantRetentionPolicy_tenantId_fkey" FOREIGN KEY ("tenantId") REFERENCES "Tenant" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "EngineLease" (
+    "name" TEXT NOT NULL,
+    "holderId" TEXT NOT NULL,
+    "expiresAt" TIMESTAMP(3) NOT NULL,
+
+    CONSTRAINT "EngineLease_pkey" PRIMARY KEY ("name")
+);
+
+-- CreateTable
+CREATE TABLE "JobRunLookupDataEntry" (
+    -- the order of the entries, later entries replace earlier entries of the same step
+    "id" BIGSERIAL NOT NULL,
+    "jobRunId" UUID NOT NULL,
+    "tenantId" UUID NOT NULL,
+    "readableId" TEXT NOT NULL,
+    -- the output of the step, or null if the output of the step was removed
+    "data" JSONB,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "JobRunLookupDataEntry_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "JobRunLookupDataEntry_jobRunId_id_idx" ON "JobRunLookupDataEntry" ("jobRunId" ASC, "id" ASC);
+
+-- AddForeignKey
+ALTER TABLE "JobRunLookupDataEntry" ADD CONSTRAINT "JobRunLookupDataEntry_jobRunId_fkey" FOREIGN KEY ("jobRunId") REFERENCES "JobRun" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "WorkflowRunArchive" (
+    "workflowRunId" UUID NOT NULL,
+    "tenantId" UUID NOT NULL,
+    -- the key of the archive of the job runs, step runs and payloads of the workflow run in the blob storage
+    "key" TEXT NOT NULL,
+    -- the size of the compressed archive in bytes
+    "size" INTEGER NOT NULL,
+    "archivedAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "WorkflowRunArchive_pkey" PRIMARY KEY ("workflowRunId")
+);
+
+-- AddForeignKey
+ALTER TABLE "WorkflowRunArchive" ADD CONSTRAINT "WorkflowRunArchive_workflowRunId_fkey" FOREIGN KEY ("workflowRunId") REFERENCES "WorkflowRun" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateTable
+CREATE TABLE "StepRunAck" (
+    "stepRunId" UUID NOT NULL,
+    -- the attempt id of the dispatch of the step run which the worker acked
+    "attemptId" TEXT NOT NULL,
+    "tenantId" UUID NOT NULL,
+    -- the type of the first event of the attempt, COMPLETED or FAILED
+    "eventType" TEXT NOT NULL,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "StepRunAck_pkey" PRIMARY KEY ("stepRunId", "attemptId")
+);
+
+-- CreateIndex
+CREATE INDEX "StepRunAck_tenantId_createdAt_idx" ON "StepRunAck" ("tenantId" ASC, "createdAt" ASC);
+
+-- CreateTable
+CREATE TABLE "StepRunHeartbeat" (
+    "stepRunId" UUID NOT NULL,
+    "tenantId" UUID NOT NULL,
+    -- the worker which sent the last heartbeat of the step run
+    "workerId" UUID NOT NULL,
+    "lastHeartbeatAt" TIMESTAMP(3) NOT NULL,
+
+    CONSTRAINT "StepRunHeartbeat_pkey" PRIMARY KEY ("stepRunId")
+);
+
+-- CreateIndex
+CREATE INDEX "StepRunHeartbeat_tenantId_lastHeartbeatAt_idx" ON "StepRunHeartbeat" ("tenantId" ASC, "lastHeartbeatAt" ASC);